@@ -3,6 +3,9 @@ package main
 import (
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestSformatHCL(t *testing.T) {
@@ -96,3 +99,19 @@ func TestContainsBigSymbol(t *testing.T) {
 		}
 	}
 }
+
+func TestGetTimeouts(t *testing.T) {
+	if s := getTimeouts(nil); s != "" {
+		t.Errorf("expect empty timeouts, got %s", s)
+	}
+
+	timeouts := &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(60 * time.Minute),
+		Delete: schema.DefaultTimeout(90 * time.Second),
+	}
+	exp := "* `create` - (Defaults to `1h`) Used when creating the resource.\n" +
+		"* `delete` - (Defaults to `1m30s`) Used when destroying the resource."
+	if s := getTimeouts(timeouts); s != exp {
+		t.Errorf("format timeouts failed, got %s", s)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"sort"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/hashicorp/hcl/v2/hclwrite"
@@ -130,6 +131,7 @@ func genDoc(product, dtype, fpath, name string, resource *schema.Resource) {
 		"description":       "",
 		"description_short": "",
 		"import":            "",
+		"timeouts":          "",
 	}
 
 	filename := fmt.Sprintf("%s_%s_%s.go", dtype, cloudMarkShort, data["resource"])
@@ -276,6 +278,7 @@ func genDoc(product, dtype, fpath, name string, resource *schema.Resource) {
 	if dtype == "resource" {
 		idAttribute := "* `id` - ID of the resource.\n"
 		data["attributes"] = idAttribute + data["attributes"]
+		data["timeouts"] = getTimeouts(resource.Timeouts)
 	}
 
 	filename = filepath.Join(docRoot, dtype[:1], fmt.Sprintf("%s.html.markdown", data["resource"]))
@@ -297,6 +300,44 @@ func genDoc(product, dtype, fpath, name string, resource *schema.Resource) {
 	message("[SUCC.]write doc to file success: %s", filename)
 }
 
+// getTimeouts get the default timeouts of the resource
+func getTimeouts(timeouts *schema.ResourceTimeout) string {
+	if timeouts == nil {
+		return ""
+	}
+
+	var rr []string
+	for _, v := range []struct {
+		name    string
+		timeout *time.Duration
+		usage   string
+	}{
+		{"create", timeouts.Create, "creating"},
+		{"read", timeouts.Read, "reading"},
+		{"update", timeouts.Update, "updating"},
+		{"delete", timeouts.Delete, "destroying"},
+	} {
+		if v.timeout == nil {
+			continue
+		}
+		rr = append(rr, fmt.Sprintf("* `%s` - (Defaults to `%s`) Used when %s the resource.", v.name, formatDuration(*v.timeout), v.usage))
+	}
+
+	return strings.Join(rr, "\n")
+}
+
+// formatDuration format duration as the timeouts block accepts, such as `10m` or `90s`
+func formatDuration(d time.Duration) string {
+	switch {
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	default:
+		return d.String()
+	}
+}
+
 // getAttributes get attributes from schema
 func getAttributes(step int, k string, v *schema.Schema) []string {
 	var attributes []string
@@ -29,6 +29,12 @@ The following arguments are supported:
 In addition to all arguments above, the following attributes are exported:
 
 {{.attributes}}
+{{end}}{{if ne .timeouts ""}}
+## Timeouts
+
+The ` + "`timeouts`" + ` block allows you to specify [timeouts](https://www.terraform.io/docs/configuration/resources.html#timeouts) for certain actions:
+
+{{.timeouts}}
 {{end}}
 {{if ne .import ""}}
 ## Import
@@ -1,9 +1,19 @@
 package tencentcloud
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"os"
+	"reflect"
 	"regexp"
+	"strings"
+	"testing"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 /*
@@ -32,6 +42,61 @@ func isResourcePersist(name string, createdTime *time.Time) bool {
 	return persistResource.MatchString(name) || createdWithin30Minutes
 }
 
+// testAccImportStateIdFunc returns the import ID joined by FILED_SP from the attributes of the
+// resource name, for the resources imported by a composite ID other than the ID in state.
+func testAccImportStateIdFunc(name string, keys ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s is not found", name)
+		}
+		items := make([]string, 0, len(keys))
+		for _, key := range keys {
+			if key == "id" {
+				items = append(items, rs.Primary.ID)
+				continue
+			}
+			items = append(items, rs.Primary.Attributes[key])
+		}
+		return strings.Join(items, FILED_SP), nil
+	}
+}
+
+// testStateUpgrade runs the StateUpgrader of the version of r with the raw state in JSON, and
+// checks that the upgraded state is the expected one and can be read by the current schema.
+func testStateUpgrade(t *testing.T, r *schema.Resource, version int, rawState, expected string) {
+	t.Helper()
+
+	var upgrade schema.StateUpgradeFunc
+	for _, u := range r.StateUpgraders {
+		if u.Version == version {
+			upgrade = u.Upgrade
+		}
+	}
+	if upgrade == nil {
+		t.Fatalf("no StateUpgrader of version %d", version)
+	}
+
+	var state, expectedState map[string]interface{}
+	if err := json.Unmarshal([]byte(rawState), &state); err != nil {
+		t.Fatalf("invalid raw state: %v", err)
+	}
+	if err := json.Unmarshal([]byte(expected), &expectedState); err != nil {
+		t.Fatalf("invalid expected state: %v", err)
+	}
+
+	state, err := upgrade(context.Background(), state, nil)
+	if err != nil {
+		t.Fatalf("upgrade state of version %d: %v", version, err)
+	}
+	if !reflect.DeepEqual(state, expectedState) {
+		t.Fatalf("upgrade state of version %d:\nexpected: %v\ngot: %v", version, expectedState, state)
+	}
+	if _, err := schema.JSONMapToStateValue(state, r.CoreConfigSchema()); err != nil {
+		t.Fatalf("upgraded state of version %d does not fit the schema: %v", version, err)
+	}
+}
+
 // vpn
 const defaultVpnDataSource = `
 data "tencentcloud_vpn_gateways" "foo" {
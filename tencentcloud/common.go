@@ -15,6 +15,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mitchellh/go-homedir"
@@ -191,24 +192,24 @@ func retryError(err error, additionRetryableError ...string) *resource.RetryErro
 	case *sdkErrors.TencentCloudSDKError:
 		if isExpectError(realErr, retryableErrorCode) {
 			log.Printf("[CRITAL] Retryable defined error: %v", err)
-			return resource.RetryableError(err)
+			return apiRetryableError(err)
 		}
 
 		if len(additionRetryableError) > 0 {
 			if isExpectError(realErr, additionRetryableError) {
 				log.Printf("[CRITAL] Retryable addition error: %v", err)
-				return resource.RetryableError(err)
+				return apiRetryableError(err)
 			}
 		}
 	case *cos.ErrorResponse:
 		if isCosExpectedError(realErr, retryableCosErrorCode) {
 			log.Printf("[CRITAL] Retryable defined error: %v", err)
-			return resource.RetryableError(err)
+			return apiRetryableError(err)
 		}
 		if len(additionRetryableError) > 0 {
 			if isCosExpectedError(realErr, additionRetryableError) {
 				log.Printf("[CRITAL] Retryable additional error: %v", err)
-				return resource.RetryableError(err)
+				return apiRetryableError(err)
 			}
 		}
 	default:
@@ -218,6 +219,16 @@ func retryError(err error, additionRetryableError ...string) *resource.RetryErro
 	return resource.NonRetryableError(err)
 }
 
+// retryableErrorFunc returns the Retryable of helper.StateWaiter, which tells if err is retryable as
+// retryContext does with the retry policy of ctx. InternalError is retryable as well since the refreshes
+// of the waiters only describe the resources.
+func retryableErrorFunc(ctx context.Context) func(error) bool {
+	policy := retryPolicyFromContext(ctx)
+	return func(err error) bool {
+		return retryError(err, InternalError).Retryable || policy.retryable(err)
+	}
+}
+
 // RetryWhenContext retries the function `f` when the error it returns satisfies `predicate`.
 // `f` is retried until `timeout` expires.
 func RetryWithContext(
@@ -227,7 +238,7 @@ func RetryWithContext(
 	additionRetryableError ...string) (interface{}, error) {
 	var output interface{}
 
-	retryErr := resource.Retry(timeout, func() *resource.RetryError {
+	retryErr := retryContext(ctx, timeout, func() *resource.RetryError {
 		var err error
 		output, err = f(ctx)
 
@@ -243,6 +254,36 @@ func RetryWithContext(
 	return output, nil
 }
 
+// diagnosticsError returns the errors of diags as an error, nil if diags has no error
+func diagnosticsError(diags diag.Diagnostics) error {
+	var messages []string
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		message := d.Summary
+		if d.Detail != "" {
+			message = fmt.Sprintf("%s: %s", d.Summary, d.Detail)
+		}
+		messages = append(messages, message)
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(messages, "; "))
+}
+
+// warningDiagnostics downgrades the errors of diags to warnings, it is used when
+// the failure should be reported but doesn't fail the operation, such as the read after create.
+func warningDiagnostics(diags diag.Diagnostics) diag.Diagnostics {
+	result := make(diag.Diagnostics, 0, len(diags))
+	for _, d := range diags {
+		d.Severity = diag.Warning
+		result = append(result, d)
+	}
+	return result
+}
+
 // isCosExpectedError returns whether error is expected error when using COS SDK
 func isCosExpectedError(err error, expectedError []string) bool {
 	e, ok := err.(*cos.ErrorResponse)
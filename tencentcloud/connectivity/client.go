@@ -6,7 +6,6 @@ import (
 	"net/url"
 	"os"
 	"strconv"
-	"time"
 
 	cfw "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfw/v20190904"
 
@@ -19,6 +18,8 @@ import (
 
 	"github.com/tencentyun/cos-go-sdk-v5"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -43,7 +44,6 @@ import (
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
 	audit "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cloudaudit/v20190319"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
-	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/profile"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 	cwp "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cwp/v20180228"
@@ -104,10 +104,20 @@ const (
 
 // TencentCloudClient is client for all TencentCloud service
 type TencentCloudClient struct {
-	Credential *common.Credential
+	Credential *Credential
 	Region     string
 	Protocol   string
 	Domain     string
+	// Endpoints overrides the endpoint of the services, keyed by the names in EndpointServices
+	Endpoints map[string]string
+	// LogConfig controls how the requests of the clients are written to the debug log
+	LogConfig LogConfig
+	// HttpConfig controls the transport of the requests, it is set by SetHttpConfig
+	HttpConfig HttpConfig
+	// RateLimiter limits the requests of the provider, the requests are checked with the context carrying it
+	RateLimiter *ratelimit.Limiter
+
+	transport http.RoundTripper
 
 	cosConn            *s3.S3
 	tencentCosConn     *cos.Client
@@ -188,63 +198,100 @@ type TencentCloudClient struct {
 	trocketConn        *trocket.Client
 }
 
-// NewClientProfile returns a new ClientProfile
-func (me *TencentCloudClient) NewClientProfile(timeout int) *profile.ClientProfile {
+// NewClientProfile returns a new ClientProfile of service
+func (me *TencentCloudClient) NewClientProfile(service string, timeout int) *profile.ClientProfile {
 	cpf := profile.NewClientProfile()
 
 	// all request use method POST
 	cpf.HttpProfile.ReqMethod = "POST"
 	// request timeout
-	cpf.HttpProfile.ReqTimeout = timeout
+	cpf.HttpProfile.ReqTimeout = me.requestTimeout(timeout)
 	// request protocol
 	cpf.HttpProfile.Scheme = me.Protocol
 	// request domain
 	cpf.HttpProfile.RootDomain = me.Domain
+	// request endpoint of the service, which takes precedence over the domain
+	if scheme, host := me.resolveEndpoint(service, ""); host != "" {
+		cpf.HttpProfile.Scheme = scheme
+		cpf.HttpProfile.Endpoint = host
+	}
 	// default language
 	cpf.Language = "en-US"
 
 	return cpf
 }
 
-// NewClientIntlProfile returns a new ClientProfile
-func (me *TencentCloudClient) NewClientIntlProfile(timeout int) *intlProfile.ClientProfile {
+// NewClientIntlProfile returns a new ClientProfile of service
+func (me *TencentCloudClient) NewClientIntlProfile(service string, timeout int) *intlProfile.ClientProfile {
 	cpf := intlProfile.NewClientProfile()
 
 	// all request use method POST
 	cpf.HttpProfile.ReqMethod = "POST"
 	// request timeout
-	cpf.HttpProfile.ReqTimeout = timeout
+	cpf.HttpProfile.ReqTimeout = me.requestTimeout(timeout)
 	// request protocol
 	cpf.HttpProfile.Scheme = me.Protocol
 	// request domain
 	cpf.HttpProfile.RootDomain = me.Domain
+	// request endpoint of the service, which takes precedence over the domain
+	if scheme, host := me.resolveEndpoint(service, ""); host != "" {
+		cpf.HttpProfile.Scheme = scheme
+		cpf.HttpProfile.Endpoint = host
+	}
 	// default language
 	cpf.Language = "en-US"
 
 	return cpf
 }
 
+// newLogRoundTripper returns the LogRoundTripper of the clients of service with the LogConfig and transport of me
+func (me *TencentCloudClient) newLogRoundTripper(service string) *LogRoundTripper {
+	tripper := NewLogRoundTripper(me.LogConfig)
+	tripper.service = service
+	tripper.transport = me.httpTransport()
+	return tripper
+}
+
+// WithCredential returns a client with credential, which has the same region, endpoints and settings as me
+func (me *TencentCloudClient) WithCredential(credential *Credential) *TencentCloudClient {
+	return &TencentCloudClient{
+		Credential:  credential,
+		Region:      me.Region,
+		Protocol:    me.Protocol,
+		Domain:      me.Domain,
+		Endpoints:   me.Endpoints,
+		LogConfig:   me.LogConfig,
+		HttpConfig:  me.HttpConfig,
+		RateLimiter: me.RateLimiter,
+		transport:   me.transport,
+	}
+}
+
 // UseCosClient returns cos client for service
 func (me *TencentCloudClient) UseCosClient() *s3.S3 {
 	if me.cosConn != nil {
 		return me.cosConn
 	}
 
+	scheme, host := me.resolveEndpoint(ENDPOINT_COS, fmt.Sprintf("cos.%s.myqcloud.com", me.Region))
 	resolver := func(service, region string, optFns ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
 		if service == endpoints.S3ServiceID {
 			return endpoints.ResolvedEndpoint{
-				URL:           fmt.Sprintf("https://cos.%s.myqcloud.com", region),
+				URL:           fmt.Sprintf("%s://%s", scheme, host),
 				SigningRegion: region,
 			}, nil
 		}
 		return endpoints.DefaultResolver().EndpointFor(service, region, optFns...)
 	}
 
-	creds := credentials.NewStaticCredentials(me.Credential.SecretId, me.Credential.SecretKey, me.Credential.Token)
+	// the credential may be refreshed during the apply, retrieve it on expiry instead of a static one
+	creds := credentials.NewCredentials(&cosCredentialProvider{credential: me.Credential})
 	sess := session.Must(session.NewSession(&aws.Config{
 		Credentials:      creds,
 		Region:           aws.String(me.Region),
 		EndpointResolver: endpoints.ResolverFunc(resolver),
+		HTTPClient:       me.newHttpClient(DefaultCosRequestTimeout),
+		S3ForcePathStyle: aws.Bool(isIPHost(host)),
 	}))
 
 	return s3.New(sess)
@@ -252,7 +299,8 @@ func (me *TencentCloudClient) UseCosClient() *s3.S3 {
 
 // UseTencentCosClient tencent cloud own client for service instead of aws
 func (me *TencentCloudClient) UseTencentCosClient(bucket string) *cos.Client {
-	u, _ := url.Parse(fmt.Sprintf("https://%s.cos.%s.myqcloud.com", bucket, me.Region))
+	scheme, host := me.resolveEndpoint(ENDPOINT_COS, fmt.Sprintf("cos.%s.myqcloud.com", me.Region))
+	u, pathStyle := bucketEndpoint(scheme, host, bucket)
 
 	if me.tencentCosConn != nil && me.tencentCosConn.BaseURL.BucketURL == u {
 		return me.tencentCosConn
@@ -262,14 +310,7 @@ func (me *TencentCloudClient) UseTencentCosClient(bucket string) *cos.Client {
 		BucketURL: u,
 	}
 
-	me.tencentCosConn = cos.NewClient(baseUrl, &http.Client{
-		Timeout: 100 * time.Second,
-		Transport: &cos.AuthorizationTransport{
-			SecretID:     me.Credential.SecretId,
-			SecretKey:    me.Credential.SecretKey,
-			SessionToken: me.Credential.Token,
-		},
-	})
+	me.tencentCosConn = cos.NewClient(baseUrl, me.newCosBucketHttpClient(bucket, pathStyle))
 
 	return me.tencentCosConn
 }
@@ -280,9 +321,9 @@ func (me *TencentCloudClient) UseMysqlClient() *cdb.Client {
 		return me.mysqlConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cdb", 300)
 	me.mysqlConn, _ = cdb.NewClient(me.Credential, me.Region, cpf)
-	me.mysqlConn.WithHttpTransport(&LogRoundTripper{})
+	me.mysqlConn.WithHttpTransport(me.newLogRoundTripper("cdb"))
 
 	return me.mysqlConn
 }
@@ -293,9 +334,9 @@ func (me *TencentCloudClient) UseRedisClient() *redis.Client {
 		return me.redisConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("redis", 300)
 	me.redisConn, _ = redis.NewClient(me.Credential, me.Region, cpf)
-	me.redisConn.WithHttpTransport(&LogRoundTripper{})
+	me.redisConn.WithHttpTransport(me.newLogRoundTripper("redis"))
 
 	return me.redisConn
 }
@@ -306,9 +347,9 @@ func (me *TencentCloudClient) UseAsClient() *as.Client {
 		return me.asConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("as", 300)
 	me.asConn, _ = as.NewClient(me.Credential, me.Region, cpf)
-	me.asConn.WithHttpTransport(&LogRoundTripper{})
+	me.asConn.WithHttpTransport(me.newLogRoundTripper("as"))
 
 	return me.asConn
 }
@@ -319,9 +360,9 @@ func (me *TencentCloudClient) UseVpcClient() *vpc.Client {
 		return me.vpcConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("vpc", 300)
 	me.vpcConn, _ = vpc.NewClient(me.Credential, me.Region, cpf)
-	me.vpcConn.WithHttpTransport(&LogRoundTripper{})
+	me.vpcConn.WithHttpTransport(me.newLogRoundTripper("vpc"))
 
 	return me.vpcConn
 }
@@ -333,9 +374,9 @@ func (me *TencentCloudClient) UseCbsClient() *cbs.Client {
 	}
 
 	var reqTimeout = getEnvDefault(PROVIDER_CBS_REQUEST_TIMEOUT, 300)
-	cpf := me.NewClientProfile(reqTimeout)
+	cpf := me.NewClientProfile("cbs", reqTimeout)
 	me.cbsConn, _ = cbs.NewClient(me.Credential, me.Region, cpf)
-	me.cbsConn.WithHttpTransport(&LogRoundTripper{})
+	me.cbsConn.WithHttpTransport(me.newLogRoundTripper("cbs"))
 
 	return me.cbsConn
 }
@@ -346,9 +387,9 @@ func (me *TencentCloudClient) UseDcClient() *dc.Client {
 		return me.dcConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("dc", 300)
 	me.dcConn, _ = dc.NewClient(me.Credential, me.Region, cpf)
-	me.dcConn.WithHttpTransport(&LogRoundTripper{})
+	me.dcConn.WithHttpTransport(me.newLogRoundTripper("dc"))
 
 	return me.dcConn
 }
@@ -359,9 +400,9 @@ func (me *TencentCloudClient) UseMongodbClient() *mongodb.Client {
 		return me.mongodbConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("mongodb", 300)
 	me.mongodbConn, _ = mongodb.NewClient(me.Credential, me.Region, cpf)
-	me.mongodbConn.WithHttpTransport(&LogRoundTripper{})
+	me.mongodbConn.WithHttpTransport(me.newLogRoundTripper("mongodb"))
 
 	return me.mongodbConn
 }
@@ -372,9 +413,9 @@ func (me *TencentCloudClient) UseClbClient() *clb.Client {
 		return me.clbConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("clb", 300)
 	me.clbConn, _ = clb.NewClient(me.Credential, me.Region, cpf)
-	me.clbConn.WithHttpTransport(&LogRoundTripper{})
+	me.clbConn.WithHttpTransport(me.newLogRoundTripper("clb"))
 
 	return me.clbConn
 }
@@ -386,9 +427,9 @@ func (me *TencentCloudClient) UseCvmClient() *cvm.Client {
 	}
 
 	var reqTimeout = getEnvDefault(PROVIDER_CVM_REQUEST_TIMEOUT, 300)
-	cpf := me.NewClientProfile(reqTimeout)
+	cpf := me.NewClientProfile("cvm", reqTimeout)
 	me.cvmConn, _ = cvm.NewClient(me.Credential, me.Region, cpf)
-	me.cvmConn.WithHttpTransport(&LogRoundTripper{})
+	me.cvmConn.WithHttpTransport(me.newLogRoundTripper("cvm"))
 
 	return me.cvmConn
 }
@@ -399,9 +440,9 @@ func (me *TencentCloudClient) UseTagClient() *tag.Client {
 		return me.tagConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("tag", 300)
 	me.tagConn, _ = tag.NewClient(me.Credential, me.Region, cpf)
-	me.tagConn.WithHttpTransport(&LogRoundTripper{})
+	me.tagConn.WithHttpTransport(me.newLogRoundTripper("tag"))
 
 	return me.tagConn
 }
@@ -412,9 +453,9 @@ func (me *TencentCloudClient) UseTkeClient() *tke.Client {
 		return me.tkeConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("tke", 300)
 	me.tkeConn, _ = tke.NewClient(me.Credential, me.Region, cpf)
-	me.tkeConn.WithHttpTransport(&LogRoundTripper{})
+	me.tkeConn.WithHttpTransport(me.newLogRoundTripper("tke"))
 
 	return me.tkeConn
 }
@@ -425,9 +466,9 @@ func (me *TencentCloudClient) UseTdmqClient() *tdmq.Client {
 		return me.tdmqConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("tdmq", 300)
 	me.tdmqConn, _ = tdmq.NewClient(me.Credential, me.Region, cpf)
-	me.tdmqConn.WithHttpTransport(&LogRoundTripper{})
+	me.tdmqConn.WithHttpTransport(me.newLogRoundTripper("tdmq"))
 
 	return me.tdmqConn
 }
@@ -438,9 +479,9 @@ func (me *TencentCloudClient) UseGaapClient() *gaap.Client {
 		return me.gaapConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("gaap", 300)
 	me.gaapConn, _ = gaap.NewClient(me.Credential, me.Region, cpf)
-	me.gaapConn.WithHttpTransport(&LogRoundTripper{})
+	me.gaapConn.WithHttpTransport(me.newLogRoundTripper("gaap"))
 
 	return me.gaapConn
 }
@@ -451,9 +492,11 @@ func (me *TencentCloudClient) UseSslClient() *ssl.Client {
 		return me.sslConn
 	}
 
-	cpf := me.NewClientProfile(300)
-	me.sslConn, _ = ssl.NewClient(me.Credential, me.Region, cpf)
-	me.sslConn.WithHttpTransport(&LogRoundTripper{})
+	cpf := me.NewClientProfile("wss", 300)
+	// NewClient of this legacy SDK only accepts *common.Credential
+	me.sslConn = &ssl.Client{}
+	me.sslConn.Init(me.Region).WithCredential(me.Credential).WithProfile(cpf)
+	me.sslConn.WithHttpTransport(me.newLogRoundTripper("wss"))
 
 	return me.sslConn
 }
@@ -464,9 +507,9 @@ func (me *TencentCloudClient) UseCamClient() *cam.Client {
 		return me.camConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cam", 300)
 	me.camConn, _ = cam.NewClient(me.Credential, me.Region, cpf)
-	me.camConn.WithHttpTransport(&LogRoundTripper{})
+	me.camConn.WithHttpTransport(me.newLogRoundTripper("cam"))
 
 	return me.camConn
 }
@@ -480,9 +523,9 @@ func (me *TencentCloudClient) UseStsClient() *sts.Client {
 		}
 	*/
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("sts", 300)
 	me.stsConn, _ = sts.NewClient(me.Credential, me.Region, cpf)
-	me.stsConn.WithHttpTransport(&LogRoundTripper{})
+	me.stsConn.WithHttpTransport(me.newLogRoundTripper("sts"))
 
 	return me.stsConn
 }
@@ -493,9 +536,9 @@ func (me *TencentCloudClient) UseCfsClient() *cfs.Client {
 		return me.cfsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cfs", 300)
 	me.cfsConn, _ = cfs.NewClient(me.Credential, me.Region, cpf)
-	me.cfsConn.WithHttpTransport(&LogRoundTripper{})
+	me.cfsConn.WithHttpTransport(me.newLogRoundTripper("cfs"))
 
 	return me.cfsConn
 }
@@ -506,9 +549,9 @@ func (me *TencentCloudClient) UseScfClient() *scf.Client {
 		return me.scfConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("scf", 300)
 	me.scfConn, _ = scf.NewClient(me.Credential, me.Region, cpf)
-	me.scfConn.WithHttpTransport(&LogRoundTripper{})
+	me.scfConn.WithHttpTransport(me.newLogRoundTripper("scf"))
 
 	return me.scfConn
 }
@@ -519,9 +562,11 @@ func (me *TencentCloudClient) UseTcaplusClient() *tcaplusdb.Client {
 		return me.tcaplusConn
 	}
 
-	cpf := me.NewClientProfile(300)
-	me.tcaplusConn, _ = tcaplusdb.NewClient(me.Credential, me.Region, cpf)
-	me.tcaplusConn.WithHttpTransport(&LogRoundTripper{})
+	cpf := me.NewClientProfile("tcaplusdb", 300)
+	// NewClient of this legacy SDK only accepts *common.Credential
+	me.tcaplusConn = &tcaplusdb.Client{}
+	me.tcaplusConn.Init(me.Region).WithCredential(me.Credential).WithProfile(cpf)
+	me.tcaplusConn.WithHttpTransport(me.newLogRoundTripper("tcaplusdb"))
 
 	return me.tcaplusConn
 }
@@ -532,9 +577,9 @@ func (me *TencentCloudClient) UseDayuClient() *dayu.Client {
 		return me.dayuConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("dayu", 300)
 	me.dayuConn, _ = dayu.NewClient(me.Credential, me.Region, cpf)
-	me.dayuConn.WithHttpTransport(&LogRoundTripper{})
+	me.dayuConn.WithHttpTransport(me.newLogRoundTripper("dayu"))
 
 	return me.dayuConn
 }
@@ -545,9 +590,9 @@ func (me *TencentCloudClient) UseCdnClient() *cdn.Client {
 		return me.cdnConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cdn", 300)
 	me.cdnConn, _ = cdn.NewClient(me.Credential, me.Region, cpf)
-	me.cdnConn.WithHttpTransport(&LogRoundTripper{})
+	me.cdnConn.WithHttpTransport(me.newLogRoundTripper("cdn"))
 
 	return me.cdnConn
 }
@@ -558,9 +603,9 @@ func (me *TencentCloudClient) UseMonitorClient() *monitor.Client {
 		return me.monitorConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("monitor", 300)
 	me.monitorConn, _ = monitor.NewClient(me.Credential, me.Region, cpf)
-	me.monitorConn.WithHttpTransport(&LogRoundTripper{})
+	me.monitorConn.WithHttpTransport(me.newLogRoundTripper("monitor"))
 
 	return me.monitorConn
 }
@@ -571,10 +616,10 @@ func (me *TencentCloudClient) UseEsClient() *es.Client {
 		return me.esConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("es", 300)
 	cpf.Language = "zh-CN"
 	me.esConn, _ = es.NewClient(me.Credential, me.Region, cpf)
-	me.esConn.WithHttpTransport(&LogRoundTripper{})
+	me.esConn.WithHttpTransport(me.newLogRoundTripper("es"))
 
 	return me.esConn
 }
@@ -585,9 +630,9 @@ func (me *TencentCloudClient) UsePostgresqlClient() *postgre.Client {
 		return me.postgreConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("postgres", 300)
 	me.postgreConn, _ = postgre.NewClient(me.Credential, me.Region, cpf)
-	me.postgreConn.WithHttpTransport(&LogRoundTripper{})
+	me.postgreConn.WithHttpTransport(me.newLogRoundTripper("postgres"))
 
 	return me.postgreConn
 }
@@ -598,9 +643,9 @@ func (me *TencentCloudClient) UseSqlserverClient() *sqlserver.Client {
 		return me.sqlserverConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("sqlserver", 300)
 	me.sqlserverConn, _ = sqlserver.NewClient(me.Credential, me.Region, cpf)
-	me.sqlserverConn.WithHttpTransport(&LogRoundTripper{})
+	me.sqlserverConn.WithHttpTransport(me.newLogRoundTripper("sqlserver"))
 
 	return me.sqlserverConn
 }
@@ -611,9 +656,9 @@ func (me *TencentCloudClient) UseCkafkaClient() *ckafka.Client {
 		return me.ckafkaConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("ckafka", 300)
 	me.ckafkaConn, _ = ckafka.NewClient(me.Credential, me.Region, cpf)
-	me.ckafkaConn.WithHttpTransport(&LogRoundTripper{})
+	me.ckafkaConn.WithHttpTransport(me.newLogRoundTripper("ckafka"))
 
 	return me.ckafkaConn
 }
@@ -624,9 +669,9 @@ func (me *TencentCloudClient) UseAuditClient() *audit.Client {
 		return me.auditConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cloudaudit", 300)
 	me.auditConn, _ = audit.NewClient(me.Credential, me.Region, cpf)
-	me.auditConn.WithHttpTransport(&LogRoundTripper{})
+	me.auditConn.WithHttpTransport(me.newLogRoundTripper("cloudaudit"))
 
 	return me.auditConn
 }
@@ -637,9 +682,9 @@ func (me *TencentCloudClient) UseCynosdbClient() *cynosdb.Client {
 		return me.cynosConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cynosdb", 300)
 	me.cynosConn, _ = cynosdb.NewClient(me.Credential, me.Region, cpf)
-	me.cynosConn.WithHttpTransport(&LogRoundTripper{})
+	me.cynosConn.WithHttpTransport(me.newLogRoundTripper("cynosdb"))
 
 	return me.cynosConn
 }
@@ -650,9 +695,11 @@ func (me *TencentCloudClient) UseVodClient() *vod.Client {
 		return me.vodConn
 	}
 
-	cpf := me.NewClientProfile(300)
-	me.vodConn, _ = vod.NewClient(me.Credential, me.Region, cpf)
-	me.vodConn.WithHttpTransport(&LogRoundTripper{})
+	cpf := me.NewClientProfile("vod", 300)
+	// NewClient of this legacy SDK only accepts *common.Credential
+	me.vodConn = &vod.Client{}
+	me.vodConn.Init(me.Region).WithCredential(me.Credential).WithProfile(cpf)
+	me.vodConn.WithHttpTransport(me.newLogRoundTripper("vod"))
 
 	return me.vodConn
 }
@@ -663,9 +710,9 @@ func (me *TencentCloudClient) UseAPIGatewayClient() *apigateway.Client {
 		return me.apiGatewayConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("apigateway", 300)
 	me.apiGatewayConn, _ = apigateway.NewClient(me.Credential, me.Region, cpf)
-	me.apiGatewayConn.WithHttpTransport(&LogRoundTripper{})
+	me.apiGatewayConn.WithHttpTransport(me.newLogRoundTripper("apigateway"))
 
 	return me.apiGatewayConn
 }
@@ -676,9 +723,9 @@ func (me *TencentCloudClient) UseTCRClient() *tcr.Client {
 		return me.tcrConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("tcr", 300)
 	me.tcrConn, _ = tcr.NewClient(me.Credential, me.Region, cpf)
-	me.tcrConn.WithHttpTransport(&LogRoundTripper{})
+	me.tcrConn.WithHttpTransport(me.newLogRoundTripper("tcr"))
 
 	return me.tcrConn
 }
@@ -689,9 +736,9 @@ func (me *TencentCloudClient) UseSSLCertificateClient() *sslCertificate.Client {
 		return me.sslCertificateConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("ssl", 300)
 	me.sslCertificateConn, _ = sslCertificate.NewClient(me.Credential, me.Region, cpf)
-	me.sslCertificateConn.WithHttpTransport(&LogRoundTripper{})
+	me.sslCertificateConn.WithHttpTransport(me.newLogRoundTripper("ssl"))
 
 	return me.sslCertificateConn
 }
@@ -702,9 +749,9 @@ func (me *TencentCloudClient) UseKmsClient() *kms.Client {
 		return me.kmsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("kms", 300)
 	me.kmsConn, _ = kms.NewClient(me.Credential, me.Region, cpf)
-	me.kmsConn.WithHttpTransport(&LogRoundTripper{})
+	me.kmsConn.WithHttpTransport(me.newLogRoundTripper("kms"))
 
 	return me.kmsConn
 }
@@ -715,9 +762,9 @@ func (me *TencentCloudClient) UseSsmClient() *ssm.Client {
 		return me.ssmConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("ssm", 300)
 	me.ssmConn, _ = ssm.NewClient(me.Credential, me.Region, cpf)
-	me.ssmConn.WithHttpTransport(&LogRoundTripper{})
+	me.ssmConn.WithHttpTransport(me.newLogRoundTripper("ssm"))
 
 	return me.ssmConn
 }
@@ -727,9 +774,9 @@ func (me *TencentCloudClient) UseApiClient() *api.Client {
 	if me.apiConn != nil {
 		return me.apiConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("api", 300)
 	me.apiConn, _ = api.NewClient(me.Credential, me.Region, cpf)
-	me.apiConn.WithHttpTransport(&LogRoundTripper{})
+	me.apiConn.WithHttpTransport(me.newLogRoundTripper("api"))
 
 	return me.apiConn
 }
@@ -739,9 +786,9 @@ func (me *TencentCloudClient) UseEmrClient() *emr.Client {
 	if me.emrConn != nil {
 		return me.emrConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("emr", 300)
 	me.emrConn, _ = emr.NewClient(me.Credential, me.Region, cpf)
-	me.emrConn.WithHttpTransport(&LogRoundTripper{})
+	me.emrConn.WithHttpTransport(me.newLogRoundTripper("emr"))
 
 	return me.emrConn
 }
@@ -751,9 +798,9 @@ func (me *TencentCloudClient) UseClsClient() *cls.Client {
 	if me.clsConn != nil {
 		return me.clsConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cls", 300)
 	me.clsConn, _ = cls.NewClient(me.Credential, me.Region, cpf)
-	me.clsConn.WithHttpTransport(&LogRoundTripper{})
+	me.clsConn.WithHttpTransport(me.newLogRoundTripper("cls"))
 
 	return me.clsConn
 }
@@ -763,9 +810,9 @@ func (me *TencentCloudClient) UseLighthouseClient() *lighthouse.Client {
 	if me.lighthouseConn != nil {
 		return me.lighthouseConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("lighthouse", 300)
 	me.lighthouseConn, _ = lighthouse.NewClient(me.Credential, me.Region, cpf)
-	me.lighthouseConn.WithHttpTransport(&LogRoundTripper{})
+	me.lighthouseConn.WithHttpTransport(me.newLogRoundTripper("lighthouse"))
 
 	return me.lighthouseConn
 }
@@ -775,9 +822,9 @@ func (me *TencentCloudClient) UseDnsPodClient() *dnspod.Client {
 	if me.dnsPodConn != nil {
 		return me.dnsPodConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("dnspod", 300)
 	me.dnsPodConn, _ = dnspod.NewClient(me.Credential, me.Region, cpf)
-	me.dnsPodConn.WithHttpTransport(&LogRoundTripper{})
+	me.dnsPodConn.WithHttpTransport(me.newLogRoundTripper("dnspod"))
 
 	return me.dnsPodConn
 }
@@ -787,9 +834,9 @@ func (me *TencentCloudClient) UsePrivateDnsClient() *privatedns.Client {
 	if me.privateDnsConn != nil {
 		return me.privateDnsConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("privatedns", 300)
 	me.privateDnsConn, _ = privatedns.NewClient(me.Credential, me.Region, cpf)
-	me.privateDnsConn.WithHttpTransport(&LogRoundTripper{})
+	me.privateDnsConn.WithHttpTransport(me.newLogRoundTripper("privatedns"))
 
 	return me.privateDnsConn
 }
@@ -799,9 +846,9 @@ func (me *TencentCloudClient) UseDomainClient() *domain.Client {
 	if me.domainConn != nil {
 		return me.domainConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("domain", 300)
 	me.domainConn, _ = domain.NewClient(me.Credential, me.Region, cpf)
-	me.domainConn.WithHttpTransport(&LogRoundTripper{})
+	me.domainConn.WithHttpTransport(me.newLogRoundTripper("domain"))
 
 	return me.domainConn
 }
@@ -812,9 +859,9 @@ func (me *TencentCloudClient) UseAntiddosClient() *antiddos.Client {
 		return me.antiddosConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("antiddos", 300)
 	me.antiddosConn, _ = antiddos.NewClient(me.Credential, me.Region, cpf)
-	me.antiddosConn.WithHttpTransport(&LogRoundTripper{})
+	me.antiddosConn.WithHttpTransport(me.newLogRoundTripper("antiddos"))
 
 	return me.antiddosConn
 }
@@ -825,9 +872,9 @@ func (me *TencentCloudClient) UseTemClient() *tem.Client {
 		return me.temConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("tem", 300)
 	me.temConn, _ = tem.NewClient(me.Credential, me.Region, cpf)
-	me.temConn.WithHttpTransport(&LogRoundTripper{})
+	me.temConn.WithHttpTransport(me.newLogRoundTripper("tem"))
 
 	return me.temConn
 }
@@ -838,9 +885,9 @@ func (me *TencentCloudClient) UseTeoClient() *teo.Client {
 		return me.teoConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("teo", 300)
 	me.teoConn, _ = teo.NewClient(me.Credential, me.Region, cpf)
-	me.teoConn.WithHttpTransport(&LogRoundTripper{})
+	me.teoConn.WithHttpTransport(me.newLogRoundTripper("teo"))
 
 	return me.teoConn
 }
@@ -851,9 +898,9 @@ func (me *TencentCloudClient) UseTcmClient() *tcm.Client {
 		return me.tcmConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("tcm", 300)
 	me.tcmConn, _ = tcm.NewClient(me.Credential, me.Region, cpf)
-	me.tcmConn.WithHttpTransport(&LogRoundTripper{})
+	me.tcmConn.WithHttpTransport(me.newLogRoundTripper("tcm"))
 
 	return me.tcmConn
 }
@@ -864,9 +911,9 @@ func (me *TencentCloudClient) UseCssClient() *css.Client {
 		return me.cssConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("live", 300)
 	me.cssConn, _ = css.NewClient(me.Credential, me.Region, cpf)
-	me.cssConn.WithHttpTransport(&LogRoundTripper{})
+	me.cssConn.WithHttpTransport(me.newLogRoundTripper("live"))
 
 	return me.cssConn
 }
@@ -877,9 +924,9 @@ func (me *TencentCloudClient) UseSesClient() *ses.Client {
 		return me.sesConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("ses", 300)
 	me.sesConn, _ = ses.NewClient(me.Credential, me.Region, cpf)
-	me.sesConn.WithHttpTransport(&LogRoundTripper{})
+	me.sesConn.WithHttpTransport(me.newLogRoundTripper("ses"))
 
 	return me.sesConn
 }
@@ -890,9 +937,9 @@ func (me *TencentCloudClient) UseDcdbClient() *dcdb.Client {
 		return me.dcdbConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("dcdb", 300)
 	me.dcdbConn, _ = dcdb.NewClient(me.Credential, me.Region, cpf)
-	me.dcdbConn.WithHttpTransport(&LogRoundTripper{})
+	me.dcdbConn.WithHttpTransport(me.newLogRoundTripper("dcdb"))
 
 	return me.dcdbConn
 }
@@ -903,9 +950,9 @@ func (me *TencentCloudClient) UseSmsClient() *sms.Client {
 		return me.smsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("sms", 300)
 	me.smsConn, _ = sms.NewClient(me.Credential, me.Region, cpf)
-	me.smsConn.WithHttpTransport(&LogRoundTripper{})
+	me.smsConn.WithHttpTransport(me.newLogRoundTripper("sms"))
 
 	return me.smsConn
 }
@@ -916,9 +963,9 @@ func (me *TencentCloudClient) UseCatClient() *cat.Client {
 		return me.catConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cat", 300)
 	me.catConn, _ = cat.NewClient(me.Credential, me.Region, cpf)
-	me.catConn.WithHttpTransport(&LogRoundTripper{})
+	me.catConn.WithHttpTransport(me.newLogRoundTripper("cat"))
 
 	return me.catConn
 }
@@ -929,9 +976,9 @@ func (me *TencentCloudClient) UseMariadbClient() *mariadb.Client {
 		return me.mariadbConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("mariadb", 300)
 	me.mariadbConn, _ = mariadb.NewClient(me.Credential, me.Region, cpf)
-	me.mariadbConn.WithHttpTransport(&LogRoundTripper{})
+	me.mariadbConn.WithHttpTransport(me.newLogRoundTripper("mariadb"))
 
 	return me.mariadbConn
 }
@@ -942,9 +989,9 @@ func (me *TencentCloudClient) UsePtsClient() *pts.Client {
 		return me.ptsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("pts", 300)
 	me.ptsConn, _ = pts.NewClient(me.Credential, me.Region, cpf)
-	me.ptsConn.WithHttpTransport(&LogRoundTripper{})
+	me.ptsConn.WithHttpTransport(me.newLogRoundTripper("pts"))
 
 	return me.ptsConn
 }
@@ -955,9 +1002,9 @@ func (me *TencentCloudClient) UseTatClient() *tat.Client {
 		return me.tatConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("tat", 300)
 	me.tatConn, _ = tat.NewClient(me.Credential, me.Region, cpf)
-	me.tatConn.WithHttpTransport(&LogRoundTripper{})
+	me.tatConn.WithHttpTransport(me.newLogRoundTripper("tat"))
 
 	return me.tatConn
 }
@@ -968,9 +1015,9 @@ func (me *TencentCloudClient) UseOrganizationClient() *organization.Client {
 		return me.organizationConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("organization", 300)
 	me.organizationConn, _ = organization.NewClient(me.Credential, me.Region, cpf)
-	me.organizationConn.WithHttpTransport(&LogRoundTripper{})
+	me.organizationConn.WithHttpTransport(me.newLogRoundTripper("organization"))
 
 	return me.organizationConn
 }
@@ -981,9 +1028,9 @@ func (me *TencentCloudClient) UseTdcpgClient() *tdcpg.Client {
 		return me.tdcpgConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("tdcpg", 300)
 	me.tdcpgConn, _ = tdcpg.NewClient(me.Credential, me.Region, cpf)
-	me.tdcpgConn.WithHttpTransport(&LogRoundTripper{})
+	me.tdcpgConn.WithHttpTransport(me.newLogRoundTripper("tdcpg"))
 
 	return me.tdcpgConn
 }
@@ -994,10 +1041,10 @@ func (me *TencentCloudClient) UseDbbrainClient() *dbbrain.Client {
 		return me.dbbrainConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("dbbrain", 300)
 	cpf.Language = "zh-CN"
 	me.dbbrainConn, _ = dbbrain.NewClient(me.Credential, me.Region, cpf)
-	me.dbbrainConn.WithHttpTransport(&LogRoundTripper{})
+	me.dbbrainConn.WithHttpTransport(me.newLogRoundTripper("dbbrain"))
 
 	return me.dbbrainConn
 }
@@ -1008,9 +1055,9 @@ func (me *TencentCloudClient) UseRumClient() *rum.Client {
 		return me.rumConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("rum", 300)
 	me.rumConn, _ = rum.NewClient(me.Credential, me.Region, cpf)
-	me.rumConn.WithHttpTransport(&LogRoundTripper{})
+	me.rumConn.WithHttpTransport(me.newLogRoundTripper("rum"))
 
 	return me.rumConn
 }
@@ -1021,16 +1068,17 @@ func (me *TencentCloudClient) UseDtsClient() *dts.Client {
 		return me.dtsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("dts", 300)
 	me.dtsConn, _ = dts.NewClient(me.Credential, me.Region, cpf)
-	me.dtsConn.WithHttpTransport(&LogRoundTripper{})
+	me.dtsConn.WithHttpTransport(me.newLogRoundTripper("dts"))
 
 	return me.dtsConn
 }
 
 // UseCosBatchClient returns ci client for service
 func (me *TencentCloudClient) UseCosBatchClient(uin string) *cos.Client {
-	u, _ := url.Parse(fmt.Sprintf("https://%s.cos-control.%s.myqcloud.com", uin, me.Region))
+	scheme, host := me.resolveEndpoint(ENDPOINT_COS_CONTROL, fmt.Sprintf("cos-control.%s.myqcloud.com", me.Region))
+	u, _ := url.Parse(fmt.Sprintf("%s://%s.%s", scheme, uin, host))
 
 	if me.cosBatchConn != nil && me.cosBatchConn.BaseURL.BatchURL == u {
 		return me.cosBatchConn
@@ -1040,21 +1088,15 @@ func (me *TencentCloudClient) UseCosBatchClient(uin string) *cos.Client {
 		BatchURL: u,
 	}
 
-	me.cosBatchConn = cos.NewClient(baseUrl, &http.Client{
-		Timeout: 100 * time.Second,
-		Transport: &cos.AuthorizationTransport{
-			SecretID:     me.Credential.SecretId,
-			SecretKey:    me.Credential.SecretKey,
-			SessionToken: me.Credential.Token,
-		},
-	})
+	me.cosBatchConn = cos.NewClient(baseUrl, me.newCosHttpClient(me.Credential))
 
 	return me.cosBatchConn
 }
 
 // UseCiClient returns ci client for service
 func (me *TencentCloudClient) UseCiClient(bucket string) *cos.Client {
-	u, _ := url.Parse(fmt.Sprintf("https://%s.ci.%s.myqcloud.com", bucket, me.Region))
+	scheme, host := me.resolveEndpoint(ENDPOINT_CI, fmt.Sprintf("ci.%s.myqcloud.com", me.Region))
+	u, pathStyle := bucketEndpoint(scheme, host, bucket)
 
 	if me.ciConn != nil && me.ciConn.BaseURL.CIURL == u {
 		return me.ciConn
@@ -1064,21 +1106,15 @@ func (me *TencentCloudClient) UseCiClient(bucket string) *cos.Client {
 		CIURL: u,
 	}
 
-	me.ciConn = cos.NewClient(baseUrl, &http.Client{
-		Timeout: 100 * time.Second,
-		Transport: &cos.AuthorizationTransport{
-			SecretID:     me.Credential.SecretId,
-			SecretKey:    me.Credential.SecretKey,
-			SessionToken: me.Credential.Token,
-		},
-	})
+	me.ciConn = cos.NewClient(baseUrl, me.newCosBucketHttpClient(bucket, pathStyle))
 
 	return me.ciConn
 }
 
 // UsePicClient returns pic client for service
 func (me *TencentCloudClient) UsePicClient(bucket string) *cos.Client {
-	u, _ := url.Parse(fmt.Sprintf("https://%s.pic.%s.myqcloud.com", bucket, me.Region))
+	scheme, host := me.resolveEndpoint(ENDPOINT_PIC, fmt.Sprintf("pic.%s.myqcloud.com", me.Region))
+	u, pathStyle := bucketEndpoint(scheme, host, bucket)
 
 	if me.ciConn != nil && me.ciConn.BaseURL.CIURL == u {
 		return me.ciConn
@@ -1088,14 +1124,7 @@ func (me *TencentCloudClient) UsePicClient(bucket string) *cos.Client {
 		CIURL: u,
 	}
 
-	me.ciConn = cos.NewClient(baseUrl, &http.Client{
-		Timeout: 100 * time.Second,
-		Transport: &cos.AuthorizationTransport{
-			SecretID:     me.Credential.SecretId,
-			SecretKey:    me.Credential.SecretKey,
-			SessionToken: me.Credential.Token,
-		},
-	})
+	me.ciConn = cos.NewClient(baseUrl, me.newCosBucketHttpClient(bucket, pathStyle))
 
 	return me.ciConn
 }
@@ -1106,10 +1135,10 @@ func (me *TencentCloudClient) UseTsfClient() *tsf.Client {
 		return me.tsfConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("tsf", 300)
 	cpf.Language = "zh-CN"
 	me.tsfConn, _ = tsf.NewClient(me.Credential, me.Region, cpf)
-	me.tsfConn.WithHttpTransport(&LogRoundTripper{})
+	me.tsfConn.WithHttpTransport(me.newLogRoundTripper("tsf"))
 
 	return me.tsfConn
 }
@@ -1120,10 +1149,10 @@ func (me *TencentCloudClient) UseMpsClient() *mps.Client {
 		return me.mpsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("mps", 300)
 	cpf.Language = "zh-CN"
 	me.mpsConn, _ = mps.NewClient(me.Credential, me.Region, cpf)
-	me.mpsConn.WithHttpTransport(&LogRoundTripper{})
+	me.mpsConn.WithHttpTransport(me.newLogRoundTripper("mps"))
 
 	return me.mpsConn
 }
@@ -1134,9 +1163,9 @@ func (me *TencentCloudClient) UseCwpClient() *cwp.Client {
 		return me.cwpConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cwp", 300)
 	me.cwpConn, _ = cwp.NewClient(me.Credential, me.Region, cpf)
-	me.cwpConn.WithHttpTransport(&LogRoundTripper{})
+	me.cwpConn.WithHttpTransport(me.newLogRoundTripper("cwp"))
 
 	return me.cwpConn
 }
@@ -1147,10 +1176,10 @@ func (me *TencentCloudClient) UseChdfsClient() *chdfs.Client {
 		return me.chdfsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("chdfs", 300)
 	cpf.Language = "zh-CN"
 	me.chdfsConn, _ = chdfs.NewClient(me.Credential, me.Region, cpf)
-	me.chdfsConn.WithHttpTransport(&LogRoundTripper{})
+	me.chdfsConn.WithHttpTransport(me.newLogRoundTripper("chdfs"))
 
 	return me.chdfsConn
 }
@@ -1161,10 +1190,10 @@ func (me *TencentCloudClient) UseMdlClient() *mdl.Client {
 		return me.mdlConn
 	}
 
-	cpf := me.NewClientIntlProfile(300)
+	cpf := me.NewClientIntlProfile("mdl", 300)
 	cpf.Language = "zh-CN"
 	me.mdlConn, _ = mdl.NewClient(me.Credential, me.Region, cpf)
-	me.mdlConn.WithHttpTransport(&LogRoundTripper{})
+	me.mdlConn.WithHttpTransport(me.newLogRoundTripper("mdl"))
 
 	return me.mdlConn
 }
@@ -1175,10 +1204,10 @@ func (me *TencentCloudClient) UseApmClient() *apm.Client {
 		return me.apmConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("apm", 300)
 	cpf.Language = "zh-CN"
 	me.apmConn, _ = apm.NewClient(me.Credential, me.Region, cpf)
-	me.apmConn.WithHttpTransport(&LogRoundTripper{})
+	me.apmConn.WithHttpTransport(me.newLogRoundTripper("apm"))
 
 	return me.apmConn
 }
@@ -1189,10 +1218,10 @@ func (me *TencentCloudClient) UseCiamClient() *ciam.Client {
 		return me.ciamConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("ciam", 300)
 	cpf.Language = "zh-CN"
 	me.ciamConn, _ = ciam.NewClient(me.Credential, me.Region, cpf)
-	me.ciamConn.WithHttpTransport(&LogRoundTripper{})
+	me.ciamConn.WithHttpTransport(me.newLogRoundTripper("ciam"))
 
 	return me.ciamConn
 }
@@ -1203,10 +1232,10 @@ func (me *TencentCloudClient) UseTseClient() *tse.Client {
 		return me.tseConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("tse", 300)
 	cpf.Language = "zh-CN"
 	me.tseConn, _ = tse.NewClient(me.Credential, me.Region, cpf)
-	me.tseConn.WithHttpTransport(&LogRoundTripper{})
+	me.tseConn.WithHttpTransport(me.newLogRoundTripper("tse"))
 
 	return me.tseConn
 }
@@ -1217,10 +1246,10 @@ func (me *TencentCloudClient) UseCdwchClient() *cdwch.Client {
 		return me.cdwchConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cdwch", 300)
 	cpf.Language = "zh-CN"
 	me.cdwchConn, _ = cdwch.NewClient(me.Credential, me.Region, cpf)
-	me.cdwchConn.WithHttpTransport(&LogRoundTripper{})
+	me.cdwchConn.WithHttpTransport(me.newLogRoundTripper("cdwch"))
 
 	return me.cdwchConn
 }
@@ -1231,10 +1260,10 @@ func (me *TencentCloudClient) UseEbClient() *eb.Client {
 		return me.ebConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("eb", 300)
 	cpf.Language = "zh-CN"
 	me.ebConn, _ = eb.NewClient(me.Credential, me.Region, cpf)
-	me.ebConn.WithHttpTransport(&LogRoundTripper{})
+	me.ebConn.WithHttpTransport(me.newLogRoundTripper("eb"))
 
 	return me.ebConn
 }
@@ -1245,10 +1274,10 @@ func (me *TencentCloudClient) UseDlcClient() *dlc.Client {
 		return me.dlcConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("dlc", 300)
 	cpf.Language = "zh-CN"
 	me.dlcConn, _ = dlc.NewClient(me.Credential, me.Region, cpf)
-	me.dlcConn.WithHttpTransport(&LogRoundTripper{})
+	me.dlcConn.WithHttpTransport(me.newLogRoundTripper("dlc"))
 
 	return me.dlcConn
 }
@@ -1259,10 +1288,10 @@ func (me *TencentCloudClient) UseWedataClient() *wedata.Client {
 		return me.wedataConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("wedata", 300)
 	cpf.Language = "zh-CN"
 	me.wedataConn, _ = wedata.NewClient(me.Credential, me.Region, cpf)
-	me.wedataConn.WithHttpTransport(&LogRoundTripper{})
+	me.wedataConn.WithHttpTransport(me.newLogRoundTripper("wedata"))
 
 	return me.wedataConn
 }
@@ -1272,10 +1301,10 @@ func (me *TencentCloudClient) UseWafClient() *waf.Client {
 		return me.wafConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("waf", 300)
 	cpf.Language = "zh-CN"
 	me.wafConn, _ = waf.NewClient(me.Credential, me.Region, cpf)
-	me.wafConn.WithHttpTransport(&LogRoundTripper{})
+	me.wafConn.WithHttpTransport(me.newLogRoundTripper("waf"))
 
 	return me.wafConn
 }
@@ -1285,10 +1314,10 @@ func (me *TencentCloudClient) UseCfwClient() *cfw.Client {
 		return me.cfwConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("cfw", 300)
 	cpf.Language = "zh-CN"
 	me.cfwConn, _ = cfw.NewClient(me.Credential, me.Region, cpf)
-	me.cfwConn.WithHttpTransport(&LogRoundTripper{})
+	me.cfwConn.WithHttpTransport(me.newLogRoundTripper("cfw"))
 
 	return me.cfwConn
 }
@@ -1299,10 +1328,10 @@ func (me *TencentCloudClient) UseTrocketClient() *trocket.Client {
 		return me.trocketConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.NewClientProfile("trocket", 300)
 	cpf.Language = "zh-CN"
 	me.trocketConn, _ = trocket.NewClient(me.Credential, me.Region, cpf)
-	me.trocketConn.WithHttpTransport(&LogRoundTripper{})
+	me.trocketConn.WithHttpTransport(me.newLogRoundTripper("trocket"))
 
 	return me.trocketConn
 }
@@ -0,0 +1,407 @@
+package connectivity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
+
+	sts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sts/v20180813"
+)
+
+const (
+	// DefaultMetadataEndpoint is the endpoint of the CVM instance metadata service
+	DefaultMetadataEndpoint = "http://metadata.tencentyun.com/latest/meta-data/"
+
+	// refresh the temporary credential before it expires, in seconds
+	credentialRefreshAhead = 300
+)
+
+// TemporaryCredential is the temporary credential which expires at ExpiredTime(unix timestamp).
+type TemporaryCredential struct {
+	SecretId    string
+	SecretKey   string
+	Token       string
+	ExpiredTime int64
+}
+
+// CredentialProvider provides temporary credential for Credential.
+type CredentialProvider interface {
+	GetCredential() (*TemporaryCredential, error)
+}
+
+// Credential is shared by all the clients of TencentCloudClient and is safe for concurrent use.
+// If it is created with a CredentialProvider, the temporary credential is refreshed before it expires.
+type Credential struct {
+	lock        sync.RWMutex
+	secretId    string
+	secretKey   string
+	token       string
+	expiredTime int64
+	provider    CredentialProvider
+}
+
+// NewCredential returns a static credential
+func NewCredential(secretId, secretKey, token string) *Credential {
+	return &Credential{
+		secretId:  secretId,
+		secretKey: secretKey,
+		token:     token,
+	}
+}
+
+// NewProviderCredential returns a credential which is refreshed through the provider
+func NewProviderCredential(provider CredentialProvider) (*Credential, error) {
+	c := &Credential{provider: provider}
+	if err := c.refresh(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Credential) GetSecretId() string {
+	c.refreshIfNeeded()
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.secretId
+}
+
+func (c *Credential) GetSecretKey() string {
+	c.refreshIfNeeded()
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.secretKey
+}
+
+func (c *Credential) GetToken() string {
+	c.refreshIfNeeded()
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.token
+}
+
+// values returns the secret id, secret key and token which are of the same temporary credential
+func (c *Credential) values() (string, string, string) {
+	c.refreshIfNeeded()
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.secretId, c.secretKey, c.token
+}
+
+// ExpiredTime returns the expired time of the temporary credential, 0 means never expires
+func (c *Credential) ExpiredTime() int64 {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.expiredTime
+}
+
+func (c *Credential) needRefresh() bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.provider != nil && c.expiredTime-credentialRefreshAhead <= time.Now().Unix()
+}
+
+func (c *Credential) refreshIfNeeded() {
+	if !c.needRefresh() {
+		return
+	}
+	if err := c.refresh(); err != nil {
+		log.Printf("[CRITAL] refresh temporary credential failed, reason: %v", err)
+	}
+}
+
+func (c *Credential) refresh() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// refreshed by others while waiting for the lock
+	if c.secretId != "" && c.expiredTime-credentialRefreshAhead > time.Now().Unix() {
+		return nil
+	}
+
+	credential, err := c.provider.GetCredential()
+	if err != nil {
+		return err
+	}
+	c.secretId = credential.SecretId
+	c.secretKey = credential.SecretKey
+	c.token = credential.Token
+	c.expiredTime = credential.ExpiredTime
+	log.Printf("[DEBUG] temporary credential refreshed, expired at %s", time.Unix(c.expiredTime, 0).Format(time.RFC3339))
+	return nil
+}
+
+// CvmRoleProvider gets the temporary credential of the CAM role bound to the CVM instance
+// from the metadata service, more info: https://cloud.tencent.com/document/product/213/4934
+type CvmRoleProvider struct {
+	RoleName string
+	Endpoint string
+}
+
+// NewCvmRoleProvider returns a CvmRoleProvider, endpoint is DefaultMetadataEndpoint if empty
+func NewCvmRoleProvider(roleName, endpoint string) *CvmRoleProvider {
+	if endpoint == "" {
+		endpoint = DefaultMetadataEndpoint
+	}
+	return &CvmRoleProvider{
+		RoleName: roleName,
+		Endpoint: endpoint,
+	}
+}
+
+type cvmRoleResponse struct {
+	TmpSecretId  string `json:"TmpSecretId"`
+	TmpSecretKey string `json:"TmpSecretKey"`
+	Token        string `json:"Token"`
+	ExpiredTime  int64  `json:"ExpiredTime"`
+	Code         string `json:"Code"`
+}
+
+func (me *CvmRoleProvider) GetCredential() (*TemporaryCredential, error) {
+	url := strings.TrimRight(me.Endpoint, "/") + "/cam/security-credentials/" + me.RoleName
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	response, err := client.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("get credential of cam role `%s` from metadata service failed, reason: %v", me.RoleName, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("cam role `%s` is not bound to the instance", me.RoleName)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get credential of cam role `%s` from metadata service failed, status code: %d", me.RoleName, response.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result cvmRoleResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("parse credential of cam role `%s` failed, reason: %v", me.RoleName, err)
+	}
+	if result.Code != "Success" {
+		return nil, fmt.Errorf("get credential of cam role `%s` from metadata service failed, code: %s", me.RoleName, result.Code)
+	}
+
+	return &TemporaryCredential{
+		SecretId:    result.TmpSecretId,
+		SecretKey:   result.TmpSecretKey,
+		Token:       result.Token,
+		ExpiredTime: result.ExpiredTime,
+	}, nil
+}
+
+// AssumeRoleProvider gets the temporary credential by assuming the role with the credential of Client,
+// more info: https://cloud.tencent.com/document/product/1312/48197
+type AssumeRoleProvider struct {
+	Client          *TencentCloudClient
+	RoleArn         string
+	RoleSessionName string
+	DurationSeconds int
+	Policy          string
+	ExternalId      string
+	SourceIdentity  string
+	SerialNumber    string
+	TokenCode       string
+}
+
+// assumeRoleRequest adds the MFA parameters which are missing in the AssumeRoleRequest of the sdk
+type assumeRoleRequest struct {
+	*sts.AssumeRoleRequest
+	SerialNumber *string `json:"SerialNumber,omitempty" name:"SerialNumber"`
+	TokenCode    *string `json:"TokenCode,omitempty" name:"TokenCode"`
+}
+
+// NewAssumeRoleProvider returns an AssumeRoleProvider, client is used to call sts with the source credential
+func NewAssumeRoleProvider(client *TencentCloudClient, roleArn, sessionName string, duration int, policy string) *AssumeRoleProvider {
+	return &AssumeRoleProvider{
+		Client:          client,
+		RoleArn:         roleArn,
+		RoleSessionName: sessionName,
+		DurationSeconds: duration,
+		Policy:          policy,
+	}
+}
+
+func (me *AssumeRoleProvider) GetCredential() (*TemporaryCredential, error) {
+	request := sts.NewAssumeRoleRequest()
+	request.RoleArn = &me.RoleArn
+	request.RoleSessionName = &me.RoleSessionName
+	duration := uint64(me.DurationSeconds)
+	request.DurationSeconds = &duration
+	if me.Policy != "" {
+		policy := url.QueryEscape(me.Policy)
+		request.Policy = &policy
+	}
+	if me.ExternalId != "" {
+		request.ExternalId = &me.ExternalId
+	}
+	if me.SourceIdentity != "" {
+		request.SourceIdentity = &me.SourceIdentity
+	}
+
+	ctx := ratelimit.NewContext(context.Background(), me.Client.RateLimiter)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return nil, err
+	}
+	var response *sts.AssumeRoleResponse
+	var err error
+	if me.SerialNumber != "" {
+		// the token code can only be used once, the credential assumed with it can't be refreshed
+		mfaRequest := &assumeRoleRequest{
+			AssumeRoleRequest: request,
+			SerialNumber:      &me.SerialNumber,
+			TokenCode:         &me.TokenCode,
+		}
+		mfaRequest.SetContext(ctx)
+		response = sts.NewAssumeRoleResponse()
+		err = me.Client.UseStsClient().Send(mfaRequest, response)
+	} else {
+		response, err = me.Client.UseStsClient().AssumeRoleWithContext(ctx, request)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("assume role `%s` failed, reason: %v", me.RoleArn, err)
+	}
+	if response.Response == nil || response.Response.Credentials == nil {
+		return nil, fmt.Errorf("assume role `%s` failed, reason: empty credentials returned", me.RoleArn)
+	}
+
+	credentials := response.Response.Credentials
+	credential := &TemporaryCredential{}
+	if credentials.TmpSecretId != nil {
+		credential.SecretId = *credentials.TmpSecretId
+	}
+	if credentials.TmpSecretKey != nil {
+		credential.SecretKey = *credentials.TmpSecretKey
+	}
+	if credentials.Token != nil {
+		credential.Token = *credentials.Token
+	}
+	if response.Response.ExpiredTime != nil {
+		credential.ExpiredTime = *response.Response.ExpiredTime
+	} else {
+		credential.ExpiredTime = time.Now().Unix() + int64(me.DurationSeconds)
+	}
+	return credential, nil
+}
+
+// WebIdentityProvider gets the temporary credential by exchanging the OIDC token issued by the identity provider,
+// more info: https://cloud.tencent.com/document/product/1312/60508
+type WebIdentityProvider struct {
+	Client          *TencentCloudClient
+	RoleArn         string
+	RoleSessionName string
+	DurationSeconds int
+	ProviderId      string
+	// the token is read from TokenFile every time if it is set, so the token rotated by the CI runner is used
+	Token     string
+	TokenFile string
+}
+
+// NewWebIdentityProvider returns a WebIdentityProvider, the request to sts is not signed so client needs no credential
+func NewWebIdentityProvider(client *TencentCloudClient, roleArn, sessionName string, duration int, providerId string) *WebIdentityProvider {
+	return &WebIdentityProvider{
+		Client:          client,
+		RoleArn:         roleArn,
+		RoleSessionName: sessionName,
+		DurationSeconds: duration,
+		ProviderId:      providerId,
+	}
+}
+
+func (me *WebIdentityProvider) token() (string, error) {
+	if me.TokenFile == "" {
+		if me.Token == "" {
+			return "", fmt.Errorf("web identity token of role `%s` is empty", me.RoleArn)
+		}
+		return me.Token, nil
+	}
+	body, err := ioutil.ReadFile(me.TokenFile)
+	if err != nil {
+		return "", fmt.Errorf("read web identity token file `%s` failed, reason: %v", me.TokenFile, err)
+	}
+	token := strings.TrimSpace(string(body))
+	if token == "" {
+		return "", fmt.Errorf("web identity token file `%s` is empty", me.TokenFile)
+	}
+	return token, nil
+}
+
+func (me *WebIdentityProvider) GetCredential() (*TemporaryCredential, error) {
+	token, err := me.token()
+	if err != nil {
+		return nil, err
+	}
+
+	request := sts.NewAssumeRoleWithWebIdentityRequest()
+	request.ProviderId = &me.ProviderId
+	request.WebIdentityToken = &token
+	request.RoleArn = &me.RoleArn
+	request.RoleSessionName = &me.RoleSessionName
+	duration := int64(me.DurationSeconds)
+	request.DurationSeconds = &duration
+	// the OIDC token authenticates the request, it is sent without signature
+	request.SetSkipSign(true)
+
+	ctx := ratelimit.NewContext(context.Background(), me.Client.RateLimiter)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return nil, err
+	}
+	response, err := me.Client.UseStsClient().AssumeRoleWithWebIdentityWithContext(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("assume role `%s` with web identity failed, reason: %v", me.RoleArn, err)
+	}
+	if response.Response == nil || response.Response.Credentials == nil {
+		return nil, fmt.Errorf("assume role `%s` with web identity failed, reason: empty credentials returned", me.RoleArn)
+	}
+
+	credentials := response.Response.Credentials
+	credential := &TemporaryCredential{}
+	if credentials.TmpSecretId != nil {
+		credential.SecretId = *credentials.TmpSecretId
+	}
+	if credentials.TmpSecretKey != nil {
+		credential.SecretKey = *credentials.TmpSecretKey
+	}
+	if credentials.Token != nil {
+		credential.Token = *credentials.Token
+	}
+	if response.Response.ExpiredTime != nil {
+		credential.ExpiredTime = int64(*response.Response.ExpiredTime)
+	} else {
+		credential.ExpiredTime = time.Now().Unix() + int64(me.DurationSeconds)
+	}
+	return credential, nil
+}
+
+// cosCredentialProvider makes the aws s3 client retrieve the refreshed credential
+type cosCredentialProvider struct {
+	credential *Credential
+}
+
+func (me *cosCredentialProvider) Retrieve() (credentials.Value, error) {
+	secretId, secretKey, token := me.credential.values()
+	return credentials.Value{
+		AccessKeyID:     secretId,
+		SecretAccessKey: secretKey,
+		SessionToken:    token,
+		ProviderName:    "TencentCloudCredentialProvider",
+	}, nil
+}
+
+func (me *cosCredentialProvider) IsExpired() bool {
+	return me.credential.needRefresh()
+}
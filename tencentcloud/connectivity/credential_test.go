@@ -0,0 +1,198 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sts/v20180813"
+)
+
+// newTestMetadataServer is a local stand-in for the CVM instance metadata service
+func newTestMetadataServer(t *testing.T, roleName string, ttl time.Duration) (*httptest.Server, *int32) {
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cam/security-credentials/"+roleName {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		n := atomic.AddInt32(&count, 1)
+		_, _ = fmt.Fprintf(w, `{"TmpSecretId":"AKID%d","TmpSecretKey":"key%d","Token":"token%d","ExpiredTime":%d,"Expiration":"","Code":"Success"}`,
+			n, n, n, time.Now().Add(ttl).Unix())
+	}))
+	t.Cleanup(server.Close)
+	return server, &count
+}
+
+func TestCvmRoleProvider(t *testing.T) {
+	server, _ := newTestMetadataServer(t, "ops", time.Hour)
+
+	credential, err := NewCvmRoleProvider("ops", server.URL).GetCredential()
+	if err != nil {
+		t.Fatalf("get credential failed: %v", err)
+	}
+	if credential.SecretId != "AKID1" || credential.SecretKey != "key1" || credential.Token != "token1" {
+		t.Errorf("unexpected credential: %+v", credential)
+	}
+
+	if _, err := NewCvmRoleProvider("unbound", server.URL).GetCredential(); err == nil {
+		t.Errorf("expect error for unbound role")
+	}
+}
+
+func TestProviderCredentialRefresh(t *testing.T) {
+	// expires within the refresh window, so every access refreshes it
+	server, count := newTestMetadataServer(t, "ops", time.Minute)
+
+	credential, err := NewProviderCredential(NewCvmRoleProvider("ops", server.URL))
+	if err != nil {
+		t.Fatalf("create credential failed: %v", err)
+	}
+	if atomic.LoadInt32(count) != 1 {
+		t.Errorf("expect credential fetched once, got %d", *count)
+	}
+	if id := credential.GetSecretId(); id != "AKID2" {
+		t.Errorf("expect refreshed secret id AKID2, got %s", id)
+	}
+
+	// valid for long enough, no refresh
+	server, count = newTestMetadataServer(t, "ops", time.Hour)
+	credential, err = NewProviderCredential(NewCvmRoleProvider("ops", server.URL))
+	if err != nil {
+		t.Fatalf("create credential failed: %v", err)
+	}
+	_ = credential.GetSecretId()
+	_ = credential.GetSecretKey()
+	_ = credential.GetToken()
+	if atomic.LoadInt32(count) != 1 {
+		t.Errorf("expect credential fetched once, got %d", *count)
+	}
+}
+
+func TestStaticCredential(t *testing.T) {
+	credential := NewCredential("AKID", "key", "token")
+	if credential.GetSecretId() != "AKID" || credential.GetSecretKey() != "key" || credential.GetToken() != "token" {
+		t.Errorf("unexpected credential")
+	}
+	if credential.ExpiredTime() != 0 {
+		t.Errorf("static credential should never expire")
+	}
+}
+
+type testCredentialProvider struct {
+	count int
+	ttl   time.Duration
+}
+
+func (me *testCredentialProvider) GetCredential() (*TemporaryCredential, error) {
+	me.count++
+	return &TemporaryCredential{
+		SecretId:    fmt.Sprintf("AKID%d", me.count),
+		SecretKey:   fmt.Sprintf("key%d", me.count),
+		Token:       fmt.Sprintf("token%d", me.count),
+		ExpiredTime: time.Now().Add(me.ttl).Unix(),
+	}, nil
+}
+
+func TestCosCredentialProvider(t *testing.T) {
+	provider := &testCredentialProvider{ttl: time.Hour}
+	credential, err := NewProviderCredential(provider)
+	if err != nil {
+		t.Fatalf("create credential failed: %v", err)
+	}
+
+	cosProvider := &cosCredentialProvider{credential: credential}
+	if cosProvider.IsExpired() {
+		t.Errorf("credential should not be expired")
+	}
+	value, err := cosProvider.Retrieve()
+	if err != nil {
+		t.Fatalf("retrieve credential failed: %v", err)
+	}
+	if value.AccessKeyID != "AKID1" || value.SecretAccessKey != "key1" || value.SessionToken != "token1" {
+		t.Errorf("unexpected credential: %+v", value)
+	}
+
+	// about to expire, the s3 client retrieves the refreshed one
+	provider.ttl = time.Minute
+	credential.expiredTime = time.Now().Add(time.Minute).Unix()
+	if !cosProvider.IsExpired() {
+		t.Errorf("credential should be expired")
+	}
+	value, _ = cosProvider.Retrieve()
+	if value.AccessKeyID != "AKID2" || value.SessionToken != "token2" {
+		t.Errorf("expect refreshed credential, got %+v", value)
+	}
+
+	if (&cosCredentialProvider{credential: NewCredential("AKID", "key", "")}).IsExpired() {
+		t.Errorf("static credential should never expire")
+	}
+}
+
+func TestAssumeRoleRequestWithMFA(t *testing.T) {
+	request := &assumeRoleRequest{
+		AssumeRoleRequest: sts.NewAssumeRoleRequest(),
+		SerialNumber:      helperString("qcs::cam:uin/100000000001::mfa/softToken"),
+		TokenCode:         helperString("123456"),
+	}
+	request.RoleArn = helperString("qcs::cam::uin/100000000002:roleName/workload")
+	request.ExternalId = helperString("external")
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		t.Fatalf("marshal request failed: %v", err)
+	}
+	params := map[string]interface{}{}
+	if err := json.Unmarshal(body, &params); err != nil {
+		t.Fatalf("unmarshal request failed: %v", err)
+	}
+	for key, expected := range map[string]string{
+		"RoleArn":      "qcs::cam::uin/100000000002:roleName/workload",
+		"ExternalId":   "external",
+		"SerialNumber": "qcs::cam:uin/100000000001::mfa/softToken",
+		"TokenCode":    "123456",
+	} {
+		if params[key] != expected {
+			t.Errorf("expect %s to be %s, got %v", key, expected, params[key])
+		}
+	}
+	if request.GetAction() != "AssumeRole" {
+		t.Errorf("unexpected action %s", request.GetAction())
+	}
+}
+
+func TestWebIdentityProviderToken(t *testing.T) {
+	provider := NewWebIdentityProvider(nil, "qcs::cam::uin/100000000001:roleName/ci", "ci", 7200, "github")
+	if _, err := provider.token(); err == nil {
+		t.Errorf("expect error for empty token")
+	}
+
+	provider.Token = "token-from-env"
+	if token, _ := provider.token(); token != "token-from-env" {
+		t.Errorf("expect token-from-env, got %s", token)
+	}
+
+	// the token file takes precedence and is read every time
+	provider.TokenFile = filepath.Join(t.TempDir(), "token")
+	if _, err := provider.token(); err == nil {
+		t.Errorf("expect error for missing token file")
+	}
+	for _, expected := range []string{"token1", "token2"} {
+		if err := os.WriteFile(provider.TokenFile, []byte(expected+"\n"), 0600); err != nil {
+			t.Fatalf("write token file failed: %v", err)
+		}
+		if token, err := provider.token(); err != nil || token != expected {
+			t.Errorf("expect %s, got %s, error: %v", expected, token, err)
+		}
+	}
+}
+
+func helperString(s string) *string {
+	return &s
+}
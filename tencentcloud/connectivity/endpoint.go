@@ -0,0 +1,65 @@
+package connectivity
+
+import (
+	"net"
+	"net/url"
+	"strings"
+)
+
+// the COS services, whose endpoints are prefixed with the bucket or uin
+const (
+	ENDPOINT_COS         = "cos"
+	ENDPOINT_COS_CONTROL = "cos_control"
+	ENDPOINT_CI          = "ci"
+	ENDPOINT_PIC         = "pic"
+)
+
+// EndpointServices are the services whose endpoint can be overridden,
+// the API services are named after the subdomain of their endpoint, such as `cvm` of `cvm.tencentcloudapi.com`.
+var EndpointServices = []string{
+	"antiddos", "api", "apigateway", "apm", "as", "cam", "cat", "cbs", "cdb", "cdn", "cdwch", "cfs", "cfw",
+	"chdfs", ENDPOINT_CI, "ciam", "ckafka", "clb", "cloudaudit", "cls", ENDPOINT_COS, ENDPOINT_COS_CONTROL,
+	"cvm", "cwp", "cynosdb", "dayu", "dbbrain", "dc", "dcdb", "dlc", "dnspod", "domain", "dts", "eb", "emr",
+	"es", "gaap", "kms", "lighthouse", "live", "mariadb", "mdl", "mongodb", "monitor", "mps", "organization",
+	ENDPOINT_PIC, "postgres", "privatedns", "pts", "redis", "rum", "scf", "ses", "sms", "sqlserver", "ssl",
+	"ssm", "sts", "tag", "tat", "tcaplusdb", "tcm", "tcr", "tdcpg", "tdmq", "tem", "teo", "tke", "trocket",
+	"tse", "tsf", "vod", "vpc", "waf", "wedata", "wss",
+}
+
+// resolveEndpoint returns the scheme and host of the requests to service, defaultHost is returned if the endpoint
+// of service is not overridden. The endpoint may start with the scheme, such as `http://127.0.0.1:8080`,
+// otherwise the protocol of the client is used.
+func (me *TencentCloudClient) resolveEndpoint(service, defaultHost string) (scheme, host string) {
+	scheme = strings.ToLower(me.Protocol)
+	if scheme == "" {
+		scheme = "https"
+	}
+
+	endpoint := strings.TrimSpace(me.Endpoints[service])
+	if endpoint == "" {
+		return scheme, defaultHost
+	}
+	if strings.Contains(endpoint, "://") {
+		if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+			return strings.ToLower(u.Scheme), u.Host
+		}
+	}
+	return scheme, strings.TrimSuffix(endpoint, "/")
+}
+
+// bucketEndpoint returns the URL of bucket on host and whether the bucket is addressed in the path,
+// the bucket is prefixed to host unless host is an IP, such as a local mock of COS, which has no subdomains.
+func bucketEndpoint(scheme, host, bucket string) (u *url.URL, pathStyle bool) {
+	if isIPHost(host) {
+		return &url.URL{Scheme: scheme, Host: host}, true
+	}
+	return &url.URL{Scheme: scheme, Host: bucket + "." + host}, false
+}
+
+// isIPHost returns whether host, which may have a port, is an IP address
+func isIPHost(host string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return net.ParseIP(strings.Trim(host, "[]")) != nil
+}
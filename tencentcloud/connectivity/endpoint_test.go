@@ -0,0 +1,107 @@
+package connectivity
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+)
+
+func TestResolveEndpoint(t *testing.T) {
+	client := &TencentCloudClient{
+		Protocol: "HTTPS",
+		Endpoints: map[string]string{
+			"cvm":        "cvm.internal.tencentcloudapi.com",
+			"vpc":        "http://127.0.0.1:8080/",
+			ENDPOINT_COS: "cos-internal.ap-guangzhou.tencentcos.cn/",
+		},
+	}
+
+	cases := []struct {
+		service, defaultHost, scheme, host string
+	}{
+		{"cvm", "", "https", "cvm.internal.tencentcloudapi.com"},
+		{"vpc", "", "http", "127.0.0.1:8080"},
+		{"cbs", "", "https", ""},
+		{ENDPOINT_COS, "cos.ap-guangzhou.myqcloud.com", "https", "cos-internal.ap-guangzhou.tencentcos.cn"},
+		{ENDPOINT_CI, "ci.ap-guangzhou.myqcloud.com", "https", "ci.ap-guangzhou.myqcloud.com"},
+	}
+	for _, c := range cases {
+		scheme, host := client.resolveEndpoint(c.service, c.defaultHost)
+		if scheme != c.scheme || host != c.host {
+			t.Errorf("service %s: expect %s://%s, got %s://%s", c.service, c.scheme, c.host, scheme, host)
+		}
+	}
+
+	client.Protocol = "HTTP"
+	if scheme, _ := client.resolveEndpoint("cbs", ""); scheme != "http" {
+		t.Errorf("expect the protocol of the client, got %s", scheme)
+	}
+	if u := client.UseTencentCosClient("bucket-1250000000").BaseURL.BucketURL.String(); u != "http://bucket-1250000000.cos-internal.ap-guangzhou.tencentcos.cn" {
+		t.Errorf("unexpected bucket url %s", u)
+	}
+}
+
+func TestClientEndpoint(t *testing.T) {
+	var host string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host = r.Host
+		_, _ = w.Write([]byte(`{"Response":{"TotalCount":0,"VpcSet":[],"RequestId":"req-1"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := &TencentCloudClient{
+		Credential: NewCredential("AKID", "key", ""),
+		Region:     "ap-guangzhou",
+		Protocol:   "HTTPS",
+		Endpoints:  map[string]string{"vpc": server.URL},
+	}
+	response, err := client.UseVpcClient().DescribeVpcs(vpc.NewDescribeVpcsRequest())
+	if err != nil {
+		t.Fatalf("describe vpcs failed: %v", err)
+	}
+	if *response.Response.RequestId != "req-1" || host != server.Listener.Addr().String() {
+		t.Errorf("expect the request sent to %s, got %s", server.Listener.Addr().String(), host)
+	}
+}
+
+func TestBucketEndpoint(t *testing.T) {
+	cases := []struct {
+		host, url string
+		pathStyle bool
+	}{
+		{"cos.ap-guangzhou.myqcloud.com", "https://bucket-1250000000.cos.ap-guangzhou.myqcloud.com", false},
+		{"localhost:9000", "https://bucket-1250000000.localhost:9000", false},
+		{"127.0.0.1:9000", "https://127.0.0.1:9000", true},
+		{"10.0.0.1", "https://10.0.0.1", true},
+		{"[::1]:9000", "https://[::1]:9000", true},
+	}
+	for _, c := range cases {
+		u, pathStyle := bucketEndpoint("https", c.host, "bucket-1250000000")
+		if u.String() != c.url || pathStyle != c.pathStyle {
+			t.Errorf("host %s: expect %s and path style %t, got %s and %t", c.host, c.url, c.pathStyle, u, pathStyle)
+		}
+	}
+}
+
+func TestCosClientPathStyle(t *testing.T) {
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+	}))
+	t.Cleanup(server.Close)
+
+	client := &TencentCloudClient{
+		Credential: NewCredential("AKID", "key", ""),
+		Region:     "ap-guangzhou",
+		Endpoints:  map[string]string{ENDPOINT_COS: server.URL},
+	}
+	if _, err := client.UseTencentCosClient("bucket-1250000000").Object.Head(context.Background(), "dir/key", nil); err != nil {
+		t.Fatalf("head object failed: %v", err)
+	}
+	if path != "/bucket-1250000000/dir/key" {
+		t.Errorf("expect the bucket addressed in the path, got %s", path)
+	}
+}
@@ -0,0 +1,165 @@
+package connectivity
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/tencentyun/cos-go-sdk-v5"
+)
+
+// default timeout of the requests to the COS services, in seconds
+const DefaultCosRequestTimeout = 100
+
+// HttpConfig controls the transport of the requests to the API and COS services
+type HttpConfig struct {
+	// Proxy is the url of the proxy, such as `http://127.0.0.1:3128`,
+	// the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables are used if empty
+	Proxy string
+	// CaBundleFile is the PEM file of the CA certificates trusted besides the system ones
+	CaBundleFile string
+	// ClientCertFile and ClientKeyFile are the PEM files of the client certificate for mutual TLS
+	ClientCertFile string
+	ClientKeyFile  string
+	// Insecure skips the verification of the server certificate
+	Insecure bool
+	// ConnectTimeout is the timeout of establishing the connection, in seconds, 0 means the default
+	ConnectTimeout int
+	// RequestTimeout is the timeout of a request, in seconds, 0 means the default of each service
+	RequestTimeout int
+}
+
+// SetHttpConfig makes the clients of me send the requests with config, which overrides the default transport
+func (me *TencentCloudClient) SetHttpConfig(config HttpConfig) error {
+	transport, err := newHttpTransport(config)
+	if err != nil {
+		return err
+	}
+	me.HttpConfig = config
+	me.transport = transport
+	return nil
+}
+
+func (me *TencentCloudClient) httpTransport() http.RoundTripper {
+	if me.transport == nil {
+		return http.DefaultTransport
+	}
+	return me.transport
+}
+
+// requestTimeout returns the configured request timeout, or defaultTimeout if it is not set
+func (me *TencentCloudClient) requestTimeout(defaultTimeout int) int {
+	if me.HttpConfig.RequestTimeout > 0 {
+		return me.HttpConfig.RequestTimeout
+	}
+	return defaultTimeout
+}
+
+func newHttpTransport(config HttpConfig) (http.RoundTripper, error) {
+	if config == (HttpConfig{RequestTimeout: config.RequestTimeout}) {
+		return http.DefaultTransport, nil
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.Proxy != "" {
+		u, err := url.Parse(config.Proxy)
+		if err != nil || u.Host == "" {
+			return nil, fmt.Errorf("invalid http proxy `%s`", config.Proxy)
+		}
+		transport.Proxy = http.ProxyURL(u)
+	}
+
+	if config.ConnectTimeout > 0 {
+		dialer := &net.Dialer{
+			Timeout:   time.Duration(config.ConnectTimeout) * time.Second,
+			KeepAlive: 30 * time.Second,
+		}
+		transport.DialContext = dialer.DialContext
+		transport.TLSHandshakeTimeout = time.Duration(config.ConnectTimeout) * time.Second
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.Insecure, //nolint:gosec
+	}
+
+	if config.CaBundleFile != "" {
+		pem, err := ioutil.ReadFile(config.CaBundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("read ca bundle file `%s` failed, reason: %v", config.CaBundleFile, err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate is found in ca bundle file `%s`", config.CaBundleFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCertFile != "" || config.ClientKeyFile != "" {
+		if config.ClientCertFile == "" || config.ClientKeyFile == "" {
+			return nil, fmt.Errorf("client cert file and client key file must be provided together")
+		}
+		cert, err := tls.LoadX509KeyPair(config.ClientCertFile, config.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load client certificate failed, reason: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
+
+// newCosHttpClient returns the http client of the COS services, which signs the requests with credential
+func (me *TencentCloudClient) newCosHttpClient(credential *Credential) *http.Client {
+	return &http.Client{
+		Timeout: time.Duration(me.requestTimeout(DefaultCosRequestTimeout)) * time.Second,
+		Transport: &cos.CredentialTransport{
+			Credential: credential,
+			Transport:  me.httpTransport(),
+		},
+	}
+}
+
+// newHttpClient returns the http client with the configured transport and request timeout
+func (me *TencentCloudClient) newHttpClient(defaultTimeout int) *http.Client {
+	return &http.Client{
+		Timeout:   time.Duration(me.requestTimeout(defaultTimeout)) * time.Second,
+		Transport: me.httpTransport(),
+	}
+}
+
+// newCosBucketHttpClient returns the http client of the COS services for bucket,
+// the requests are prefixed with the bucket if it's addressed in the path.
+func (me *TencentCloudClient) newCosBucketHttpClient(bucket string, pathStyle bool) *http.Client {
+	client := me.newCosHttpClient(me.Credential)
+	if pathStyle {
+		client.Transport = &pathStyleTransport{bucket: bucket, transport: client.Transport}
+	}
+	return client
+}
+
+// pathStyleTransport prefixes the path of the requests with the bucket before they're signed,
+// since the COS SDK only addresses the bucket in the subdomain.
+type pathStyleTransport struct {
+	bucket    string
+	transport http.RoundTripper
+}
+
+func (me *pathStyleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Path = "/" + me.bucket + req.URL.Path
+	if req.URL.RawPath != "" {
+		req.URL.RawPath = "/" + me.bucket + req.URL.RawPath
+	}
+	return me.transport.RoundTrip(req)
+}
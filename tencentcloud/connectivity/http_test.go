@@ -0,0 +1,75 @@
+package connectivity
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewHttpTransport(t *testing.T) {
+	transport, err := newHttpTransport(HttpConfig{RequestTimeout: 60})
+	if err != nil || transport != http.DefaultTransport {
+		t.Fatalf("expect the default transport, got %v, %v", transport, err)
+	}
+
+	transport, err = newHttpTransport(HttpConfig{Proxy: "http://127.0.0.1:3128", Insecure: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpTransport := transport.(*http.Transport)
+	request, _ := http.NewRequest("POST", "https://cvm.tencentcloudapi.com", nil)
+	if proxy, _ := httpTransport.Proxy(request); proxy == nil || proxy.Host != "127.0.0.1:3128" {
+		t.Errorf("expect proxy 127.0.0.1:3128, got %v", proxy)
+	}
+	if !httpTransport.TLSClientConfig.InsecureSkipVerify {
+		t.Errorf("expect insecure skip verify")
+	}
+
+	if _, err := newHttpTransport(HttpConfig{Proxy: "127.0.0.1:3128"}); err == nil {
+		t.Errorf("expect error of the proxy without scheme")
+	}
+
+	dir, err := ioutil.TempDir("", "ca")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	caFile := filepath.Join(dir, "ca.pem")
+	if err := ioutil.WriteFile(caFile, []byte("not a certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newHttpTransport(HttpConfig{CaBundleFile: caFile}); err == nil {
+		t.Errorf("expect error of the invalid ca bundle file")
+	}
+	if _, err := newHttpTransport(HttpConfig{CaBundleFile: filepath.Join(dir, "missing.pem")}); err == nil {
+		t.Errorf("expect error of the missing ca bundle file")
+	}
+	if _, err := newHttpTransport(HttpConfig{ClientCertFile: caFile}); err == nil {
+		t.Errorf("expect error of the client cert file without key file")
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	client := &TencentCloudClient{}
+	if timeout := client.requestTimeout(300); timeout != 300 {
+		t.Errorf("expect 300, got %d", timeout)
+	}
+	if err := client.SetHttpConfig(HttpConfig{RequestTimeout: 60}); err != nil {
+		t.Fatal(err)
+	}
+	if timeout := client.requestTimeout(300); timeout != 60 {
+		t.Errorf("expect 60, got %d", timeout)
+	}
+	// the clients derived from client have the same settings, but the other clients do not
+	if timeout := client.WithCredential(nil).requestTimeout(300); timeout != 60 {
+		t.Errorf("expect 60 of the derived client, got %d", timeout)
+	}
+	if timeout := (&TencentCloudClient{}).requestTimeout(300); timeout != 300 {
+		t.Errorf("expect 300 of another client, got %d", timeout)
+	}
+	if client := client.newHttpClient(DefaultCosRequestTimeout); client.Timeout.Seconds() != 60 {
+		t.Errorf("expect 60s, got %v", client.Timeout)
+	}
+}
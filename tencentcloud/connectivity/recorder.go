@@ -0,0 +1,244 @@
+package connectivity
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// RecorderMode tells the Recorder to record the requests to the cassette or replay them from it
+type RecorderMode string
+
+const (
+	RecorderModeRecord RecorderMode = "record"
+	RecorderModeReplay RecorderMode = "replay"
+)
+
+// Interaction is a request to the API and its response, the sensitive fields of both are redacted
+// as the LogRoundTripper does.
+type Interaction struct {
+	Action     string `json:"action"`
+	Host       string `json:"host"`
+	Region     string `json:"region"`
+	Request    string `json:"request"`
+	StatusCode int    `json:"status_code"`
+	Response   string `json:"response"`
+}
+
+// Recorder records the requests sent by the LogRoundTripper to a cassette file, or replays the
+// responses from it without any request sent, it is started by StartRecorder and shared by all the clients.
+//
+// A request is replayed by the first unused interaction with the same action and request, or the first
+// unused one with the same action and resource IDs if none, such as the request with a random name. The last
+// interaction of the action is replayed again once all of them are used, for the polling longer than the recording.
+// The resource IDs are the fields named with the suffix `Id` or `Ids`, so the request of another resource fails.
+type Recorder struct {
+	Path string
+	Mode RecorderMode
+
+	lock         sync.Mutex
+	interactions []*Interaction
+	used         map[*Interaction]bool
+	last         map[string]*Interaction
+}
+
+var (
+	recorderLock sync.RWMutex
+	recorder     *Recorder
+	recorderName string
+)
+
+// NewRecorder returns a Recorder of the cassette file path, the file is read for replaying.
+func NewRecorder(path string, mode RecorderMode) (*Recorder, error) {
+	me := &Recorder{
+		Path: path,
+		Mode: mode,
+		used: make(map[*Interaction]bool),
+		last: make(map[string]*Interaction),
+	}
+	switch mode {
+	case RecorderModeRecord:
+	case RecorderModeReplay:
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read cassette `%s` failed, reason: %v", path, err)
+		}
+		if err = json.Unmarshal(content, &me.interactions); err != nil {
+			return nil, fmt.Errorf("parse cassette `%s` failed, reason: %v", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported recorder mode `%s`, expected %s or %s", mode, RecorderModeRecord, RecorderModeReplay)
+	}
+	return me, nil
+}
+
+// StartRecorder makes all the LogRoundTripper use the Recorder r for the test name, it returns false if the
+// Recorder of the test is used already. Only one Recorder is used at a time, as the requests of the tests
+// running together can not be told apart, so it fails if the Recorder of another test is used.
+func StartRecorder(name string, r *Recorder) (bool, error) {
+	recorderLock.Lock()
+	defer recorderLock.Unlock()
+	if recorder != nil {
+		if recorderName == name {
+			return false, nil
+		}
+		return false, fmt.Errorf("the cassette of %s is used, %s can not record or replay its cassette at the same time", recorderName, name)
+	}
+	recorder, recorderName = r, name
+	return true, nil
+}
+
+// StopRecorder makes the LogRoundTripper send the requests as usual if the Recorder of the test name is used.
+func StopRecorder(name string) {
+	recorderLock.Lock()
+	defer recorderLock.Unlock()
+	if recorderName == name {
+		recorder, recorderName = nil, ""
+	}
+}
+
+func getRecorder() *Recorder {
+	recorderLock.RLock()
+	defer recorderLock.RUnlock()
+	return recorder
+}
+
+// Save writes the interactions recorded to the cassette file, it does nothing for replaying.
+func (me *Recorder) Save() error {
+	if me.Mode != RecorderModeRecord {
+		return nil
+	}
+	me.lock.Lock()
+	defer me.lock.Unlock()
+
+	content, err := json.MarshalIndent(me.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(me.Path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(me.Path, append(content, '\n'), 0644)
+}
+
+// RoundTrip sends the request with transport and records it, or replays its response,
+// the fields are redacted from both of them.
+func (me *Recorder) RoundTrip(request *http.Request, requestBody []byte, transport http.RoundTripper, fields map[string]bool) (*http.Response, error) {
+	interaction := &Interaction{
+		Action:  apiHeader(request, "X-TC-Action"),
+		Host:    request.URL.Host,
+		Region:  apiHeader(request, "X-TC-Region"),
+		Request: string(requestBody),
+	}
+	if redacted, ok := redactBody(requestBody, fields); ok {
+		interaction.Request = string(redacted)
+	}
+
+	if me.Mode == RecorderModeReplay {
+		return me.replay(request, interaction)
+	}
+
+	response, err := transport.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = ioutil.NopCloser(bytes.NewBuffer(responseBody))
+
+	interaction.StatusCode = response.StatusCode
+	interaction.Response = string(responseBody)
+	if redacted, ok := redactBody(responseBody, fields); ok {
+		interaction.Response = string(redacted)
+	}
+
+	me.lock.Lock()
+	defer me.lock.Unlock()
+	me.interactions = append(me.interactions, interaction)
+	return response, nil
+}
+
+func (me *Recorder) replay(request *http.Request, expected *Interaction) (*http.Response, error) {
+	me.lock.Lock()
+	defer me.lock.Unlock()
+
+	var matched *Interaction
+	for _, v := range me.interactions {
+		if !me.used[v] && v.Action == expected.Action && v.Request == expected.Request {
+			matched = v
+			break
+		}
+	}
+	if matched == nil {
+		ids := resourceIds(expected.Request)
+		for _, v := range me.interactions {
+			if !me.used[v] && v.Action == expected.Action && reflect.DeepEqual(resourceIds(v.Request), ids) {
+				matched = v
+				break
+			}
+		}
+		if last := me.last[expected.Action]; matched == nil && last != nil && reflect.DeepEqual(resourceIds(last.Request), ids) {
+			matched = last
+		}
+	}
+	if matched == nil {
+		return nil, fmt.Errorf("no interaction of action %s with the same resource IDs is recorded in cassette `%s`, request: %s", expected.Action, me.Path, expected.Request)
+	}
+	me.used[matched] = true
+	me.last[expected.Action] = matched
+
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", matched.StatusCode, http.StatusText(matched.StatusCode)),
+		StatusCode: matched.StatusCode,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(bytes.NewBufferString(matched.Response)),
+		Request:    request,
+	}, nil
+}
+
+// resourceIds returns the fields of the request body named with the suffix `Id` or `Ids` by their paths,
+// such as `InstanceIds` and `Filters.0.VpcId`.
+func resourceIds(body string) map[string]string {
+	var request interface{}
+	if err := json.Unmarshal([]byte(body), &request); err != nil {
+		return nil
+	}
+	ids := make(map[string]string)
+	collectResourceIds(request, "", ids)
+	return ids
+}
+
+func collectResourceIds(value interface{}, path string, ids map[string]string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			name := key
+			if path != "" {
+				name = path + "." + key
+			}
+			if strings.HasSuffix(key, "Id") || strings.HasSuffix(key, "Ids") {
+				content, _ := json.Marshal(item)
+				ids[name] = string(content)
+				continue
+			}
+			collectResourceIds(item, name, ids)
+		}
+	case []interface{}:
+		for i, item := range v {
+			collectResourceIds(item, fmt.Sprintf("%s.%d", path, i), ids)
+		}
+	}
+}
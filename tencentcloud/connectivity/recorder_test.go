@@ -0,0 +1,126 @@
+package connectivity
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+func newApiRequest(t *testing.T, action, body string) *http.Request {
+	request, err := http.NewRequest("POST", "https://cvm.tencentcloudapi.com/", bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the SDK sets the headers without canonicalizing their keys
+	request.Header["X-TC-Action"] = []string{action}
+	request.Header["X-TC-Region"] = []string{"ap-guangzhou"}
+	return request
+}
+
+func roundTrip(t *testing.T, r *Recorder, action, body string, transport http.RoundTripper) string {
+	response, err := r.RoundTrip(newApiRequest(t, action, body), []byte(body), transport, newRedactFields(nil))
+	if err != nil {
+		t.Fatalf("%s: %v", action, err)
+	}
+	content, _ := ioutil.ReadAll(response.Body)
+	return string(content)
+}
+
+func TestRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassettes", "TestRecorder.json")
+
+	var count int
+	online := roundTripFunc(func(request *http.Request) (*http.Response, error) {
+		count++
+		body, _ := ioutil.ReadAll(request.Body)
+		response := fmt.Sprintf(`{"Response":{"Count":%d,"Request":%s,"Password":"p@ss"}}`, count, body)
+		return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewBufferString(response))}, nil
+	})
+	offline := roundTripFunc(func(request *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected request %s in replay", apiHeader(request, "X-TC-Action"))
+		return nil, nil
+	})
+
+	r, err := NewRecorder(path, RecorderModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roundTrip(t, r, "RunInstances", `{"InstanceName":"foo-1"}`, online)
+	roundTrip(t, r, "DescribeInstances", `{"InstanceIds":["ins-1"]}`, online)
+	roundTrip(t, r, "DescribeInstances", `{"InstanceIds":["ins-1"]}`, online)
+	if err = r.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	content, _ := ioutil.ReadFile(path)
+	if strings.Contains(string(content), "p@ss") {
+		t.Errorf("expect the password redacted in the cassette: %s", content)
+	}
+
+	r, err = NewRecorder(path, RecorderModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the random name is replayed by the action
+	if body := roundTrip(t, r, "RunInstances", `{"InstanceName":"foo-2"}`, offline); !strings.Contains(body, `"Count":1`) {
+		t.Errorf("unexpected response %s", body)
+	}
+	for _, expected := range []string{`"Count":2`, `"Count":3`, `"Count":3`} {
+		if body := roundTrip(t, r, "DescribeInstances", `{"InstanceIds":["ins-1"]}`, offline); !strings.Contains(body, expected) {
+			t.Errorf("expect %s in response %s", expected, body)
+		}
+	}
+	if _, err = r.RoundTrip(newApiRequest(t, "TerminateInstances", "{}"), []byte("{}"), offline, newRedactFields(nil)); err == nil {
+		t.Errorf("expect error for the action not recorded")
+	}
+	// the request of another resource is not replayed by the action
+	body := `{"InstanceIds":["ins-2"]}`
+	if _, err = r.RoundTrip(newApiRequest(t, "DescribeInstances", body), []byte(body), offline, newRedactFields(nil)); err == nil {
+		t.Errorf("expect error for the resource IDs not recorded")
+	}
+}
+
+func TestStartRecorder(t *testing.T) {
+	r, err := NewRecorder(filepath.Join(t.TempDir(), "TestStartRecorder.json"), RecorderModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if started, err := StartRecorder("TestA", r); !started || err != nil {
+		t.Fatalf("expect the recorder started, got %v, %v", started, err)
+	}
+	defer StopRecorder("TestA")
+
+	if started, err := StartRecorder("TestA", r); started || err != nil {
+		t.Errorf("expect the recorder of the same test kept, got %v, %v", started, err)
+	}
+	if _, err := StartRecorder("TestB", r); err == nil {
+		t.Errorf("expect error for the recorder of another test")
+	}
+	StopRecorder("TestB")
+	if getRecorder() != r {
+		t.Errorf("expect the recorder kept by the stop of another test")
+	}
+	StopRecorder("TestA")
+	if getRecorder() != nil {
+		t.Errorf("expect the recorder stopped")
+	}
+}
+
+func TestResourceIds(t *testing.T) {
+	ids := resourceIds(`{"InstanceIds":["ins-1"],"VpcName":"foo","Filters":[{"Name":"zone","Values":["ap-guangzhou-3"]}],"Placement":{"ProjectId":0,"Zone":"ap-guangzhou-3"}}`)
+	expected := map[string]string{"InstanceIds": `["ins-1"]`, "Placement.ProjectId": "0"}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("expect %v, got %v", expected, ids)
+	}
+}
@@ -0,0 +1,72 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+)
+
+// maxRequestInfos is the number of failed requests remembered for the diagnostics.
+const maxRequestInfos = 1024
+
+// RequestInfo is the API request which failed with a request id.
+type RequestInfo struct {
+	Service string
+	Action  string
+	Region  string
+}
+
+var requestInfos = struct {
+	sync.Mutex
+	ids   []string
+	infos map[string]RequestInfo
+}{infos: make(map[string]RequestInfo)}
+
+// GetRequestInfo returns the API request of the request id, it only knows the requests failed with an error.
+func GetRequestInfo(requestId string) (RequestInfo, bool) {
+	requestInfos.Lock()
+	defer requestInfos.Unlock()
+
+	info, ok := requestInfos.infos[requestId]
+	return info, ok
+}
+
+func recordRequestInfo(requestId string, info RequestInfo) {
+	requestInfos.Lock()
+	defer requestInfos.Unlock()
+
+	if _, ok := requestInfos.infos[requestId]; ok {
+		return
+	}
+	if len(requestInfos.ids) >= maxRequestInfos {
+		delete(requestInfos.infos, requestInfos.ids[0])
+		requestInfos.ids = requestInfos.ids[1:]
+	}
+	requestInfos.ids = append(requestInfos.ids, requestId)
+	requestInfos.infos[requestId] = info
+}
+
+// recordFailedRequest remembers the service, action and region of the request if its response is an error,
+// the service is passed by the client since the host may be an overridden endpoint.
+func recordFailedRequest(service string, request *http.Request, body []byte) {
+	var response struct {
+		Response struct {
+			Error *struct {
+				Code string
+			}
+			RequestId string
+		}
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return
+	}
+	if response.Response.Error == nil || response.Response.RequestId == "" {
+		return
+	}
+
+	recordRequestInfo(response.Response.RequestId, RequestInfo{
+		Service: service,
+		Action:  apiHeader(request, "X-TC-Action"),
+		Region:  apiHeader(request, "X-TC-Region"),
+	})
+}
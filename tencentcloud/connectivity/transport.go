@@ -8,6 +8,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 )
 
@@ -22,7 +23,133 @@ func SetReqClient(name string) {
 	ReqClient = name
 }
 
+// DefaultRedactFields are the sensitive fields of the API whose values are never written to the log,
+// any field whose name contains `password` is redacted as well.
+var DefaultRedactFields = []string{
+	"SecretKey",
+	"TmpSecretKey",
+	"Token",
+	"WebIdentityToken",
+	"TokenCode",
+	"SecretString",
+	"SecretBinary",
+	"Plaintext",
+	"PrivateKey",
+	"CertificatePrivateKey",
+	"KeyContent",
+}
+
+const redactedValue = "******"
+
+// LogConfig controls how the request and response are written to the debug log
+type LogConfig struct {
+	// RedactFields are redacted besides DefaultRedactFields, the names are case insensitive
+	RedactFields []string
+	// MaxBodySize caps the size of the body written to the log, 0 means no limit
+	MaxBodySize int
+	// HeaderOnly writes the action, host and region of the request without the body
+	HeaderOnly bool
+}
+
+func newRedactFields(fields []string) map[string]bool {
+	result := make(map[string]bool, len(DefaultRedactFields)+len(fields))
+	for _, field := range DefaultRedactFields {
+		result[strings.ToLower(field)] = true
+	}
+	for _, field := range fields {
+		result[strings.ToLower(field)] = true
+	}
+	return result
+}
+
+func isRedactField(fields map[string]bool, name string) bool {
+	name = strings.ToLower(name)
+	return fields[name] || strings.Contains(name, "password")
+}
+
+// redact replaces the values of the sensitive fields in place
+func redact(value interface{}, fields map[string]bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if item != nil && isRedactField(fields, key) {
+				v[key] = redactedValue
+				continue
+			}
+			redact(item, fields)
+		}
+	case []interface{}:
+		for _, item := range v {
+			redact(item, fields)
+		}
+	}
+}
+
+// redactBody returns the redacted and compacted json body, false if body is not json
+func redactBody(body []byte, fields map[string]bool) ([]byte, bool) {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	// keep the precision of the large integers such as the ids
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, false
+	}
+	redact(value, fields)
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return nil, false
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), true
+}
+
+// formatBody returns the redacted and compacted body to write to the log
+func (me *LogRoundTripper) formatBody(body []byte) []byte {
+	config := me.config
+	if config.HeaderOnly {
+		return []byte(fmt.Sprintf("(%d bytes omitted)", len(body)))
+	}
+
+	if redacted, ok := redactBody(body, me.redactFields); ok {
+		body = redacted
+	} else {
+		body = bytes.Replace(body, []byte("\n"), []byte(""), -1)
+		body = bytes.Replace(body, []byte(" "), []byte(""), -1)
+	}
+
+	if config.MaxBodySize > 0 && len(body) > config.MaxBodySize {
+		truncated := append([]byte{}, body[:config.MaxBodySize]...)
+		body = append(truncated, []byte(fmt.Sprintf("...(%d bytes truncated)", len(body)-config.MaxBodySize))...)
+	}
+	return body
+}
+
+// apiHeader returns the header key of the API request, the SDK sets the headers such as `X-TC-Action`
+// without canonicalizing their keys.
+func apiHeader(request *http.Request, key string) string {
+	if v := request.Header[key]; len(v) > 0 {
+		return v[0]
+	}
+	return request.Header.Get(key)
+}
+
+// LogRoundTripper writes the requests and responses to the debug log as its LogConfig
 type LogRoundTripper struct {
+	// service is the name of the API service of the requests, as in the endpoints
+	service      string
+	config       LogConfig
+	redactFields map[string]bool
+	// transport sends the requests, http.DefaultTransport if nil
+	transport http.RoundTripper
+}
+
+// NewLogRoundTripper returns a LogRoundTripper with config
+func NewLogRoundTripper(config LogConfig) *LogRoundTripper {
+	return &LogRoundTripper{
+		config:       config,
+		redactFields: newRedactFields(config.RedactFields),
+	}
 }
 
 func (me *LogRoundTripper) RoundTrip(request *http.Request) (response *http.Response, errRet error) {
@@ -49,7 +176,7 @@ func (me *LogRoundTripper) RoundTrip(request *http.Request) (response *http.Resp
 	if errRet != nil {
 		return
 	}
-	inBytes = append(inBytes, requestBody...)
+	inBytes = append(inBytes, me.formatBody(requestBody)...)
 
 	headName = "X-TC-Region"
 	appendMessage := []byte(fmt.Sprintf(
@@ -60,7 +187,15 @@ func (me *LogRoundTripper) RoundTrip(request *http.Request) (response *http.Resp
 
 	inBytes = append(inBytes, appendMessage...)
 
-	response, errRet = http.DefaultTransport.RoundTrip(request)
+	transport := me.transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if recorder := getRecorder(); recorder != nil {
+		response, errRet = recorder.RoundTrip(request, requestBody, transport, me.redactFields)
+	} else {
+		response, errRet = transport.RoundTrip(request)
+	}
 	if errRet != nil {
 		return
 	}
@@ -69,6 +204,7 @@ func (me *LogRoundTripper) RoundTrip(request *http.Request) (response *http.Resp
 		return
 	}
 	response.Body = ioutil.NopCloser(bytes.NewBuffer(outBytes))
+	recordFailedRequest(me.service, request, outBytes)
 	return
 }
 
@@ -86,18 +222,7 @@ func (me *LogRoundTripper) log(in []byte, out []byte, err error, start time.Time
 	}
 	if len(out) > 0 {
 		buf.WriteString("; response:")
-		err := json.Compact(&buf, out)
-		if err != nil {
-			out := bytes.Replace(out,
-				[]byte("\n"),
-				[]byte(""),
-				-1)
-			out = bytes.Replace(out,
-				[]byte(" "),
-				[]byte(""),
-				-1)
-			buf.Write(out)
-		}
+		buf.Write(me.formatBody(out))
 	}
 
 	if err != nil {
@@ -0,0 +1,81 @@
+package connectivity
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestFormatBody(t *testing.T) {
+	body := []byte(`{"InstanceId": "cdb-1", "Password": "p@ss", "Config": {"RootPassword": "root", "Vip": null},
+		"Credentials": {"TmpSecretId": "AKID", "TmpSecretKey": "key", "Token": "token"}, "Uin": 100000000001,
+		"Items": [{"SecretString": "secret", "Name": "<name>"}], "MyField": "custom"}`)
+
+	tripper := NewLogRoundTripper(LogConfig{})
+	result := string(tripper.formatBody(body))
+	for _, expected := range []string{`"InstanceId":"cdb-1"`, `"TmpSecretId":"AKID"`, `"Uin":100000000001`, `"Name":"<name>"`, `"Vip":null`, `"MyField":"custom"`} {
+		if !strings.Contains(result, expected) {
+			t.Errorf("expect %s in %s", expected, result)
+		}
+	}
+	for _, secret := range []string{"p@ss", "root", `"key"`, `"token"`, `"secret"`} {
+		if strings.Contains(result, secret) {
+			t.Errorf("expect %s redacted in %s", secret, result)
+		}
+	}
+
+	tripper = NewLogRoundTripper(LogConfig{RedactFields: []string{"myfield"}})
+	if result := string(tripper.formatBody(body)); strings.Contains(result, "custom") {
+		t.Errorf("expect custom field redacted in %s", result)
+	}
+
+	tripper = NewLogRoundTripper(LogConfig{MaxBodySize: 10})
+	if result := string(tripper.formatBody(body)); !strings.HasPrefix(result, `{"Config":`) || !strings.HasSuffix(result, "bytes truncated)") {
+		t.Errorf("expect truncated body, got %s", result)
+	}
+
+	tripper = NewLogRoundTripper(LogConfig{HeaderOnly: true})
+	if result := string(tripper.formatBody(body)); result != fmt.Sprintf("(%d bytes omitted)", len(body)) {
+		t.Errorf("expect body omitted, got %s", result)
+	}
+
+	// not json
+	tripper = NewLogRoundTripper(LogConfig{})
+	if result := string(tripper.formatBody([]byte("bad gateway\n"))); result != "badgateway" {
+		t.Errorf("unexpected body %s", result)
+	}
+}
+
+func TestRecordFailedRequest(t *testing.T) {
+	// the request sent to an overridden endpoint
+	request, _ := http.NewRequest("POST", "http://127.0.0.1:8080/", nil)
+	request.Header.Set("Host", "127.0.0.1:8080")
+	// the SDK sets the headers without canonicalizing their keys
+	request.Header["X-TC-Action"] = []string{"CreateVpc"}
+	request.Header["X-TC-Region"] = []string{"ap-guangzhou"}
+
+	recordFailedRequest("vpc", request, []byte(`{"Response": {"VpcId": "vpc-1", "RequestId": "request-ok"}}`))
+	if _, ok := GetRequestInfo("request-ok"); ok {
+		t.Errorf("expect the succeeded request not recorded")
+	}
+
+	recordFailedRequest("vpc", request, []byte(`{"Response": {"Error": {"Code": "LimitExceeded", "Message": "quota"}, "RequestId": "request-failed"}}`))
+	info, ok := GetRequestInfo("request-failed")
+	if !ok {
+		t.Fatalf("expect the failed request recorded")
+	}
+	if info != (RequestInfo{Service: "vpc", Action: "CreateVpc", Region: "ap-guangzhou"}) {
+		t.Errorf("unexpected request info %+v", info)
+	}
+
+	for i := 0; i < maxRequestInfos; i++ {
+		recordRequestInfo(fmt.Sprintf("request-%d", i), info)
+	}
+	if _, ok := GetRequestInfo("request-failed"); ok {
+		t.Errorf("expect the oldest request evicted")
+	}
+	if _, ok := GetRequestInfo(fmt.Sprintf("request-%d", maxRequestInfos-1)); !ok {
+		t.Errorf("expect the latest request recorded")
+	}
+}
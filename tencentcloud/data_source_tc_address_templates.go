@@ -15,6 +15,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudAddressTemplates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAddressTemplatesRead,
+		ReadContext: dataSourceTencentCloudAddressTemplatesRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -74,11 +75,11 @@ func dataSourceTencentCloudAddressTemplates() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAddressTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAddressTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_address_templates.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var name, templateId string
 	var filters = make([]*vpc.Filter, 0)
@@ -96,7 +97,7 @@ func dataSourceTencentCloudAddressTemplatesRead(d *schema.ResourceData, meta int
 	var outErr, inErr error
 	templates, outErr := vpcService.DescribeAddressTemplates(ctx, filters)
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			templates, inErr = vpcService.DescribeAddressTemplates(ctx, filters)
 			if inErr != nil {
 				return retryError(inErr)
@@ -106,7 +107,7 @@ func dataSourceTencentCloudAddressTemplatesRead(d *schema.ResourceData, meta int
 	}
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	ids := make([]string, 0, len(templates))
@@ -123,13 +124,13 @@ func dataSourceTencentCloudAddressTemplatesRead(d *schema.ResourceData, meta int
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("template_list", templateList); e != nil {
 		log.Printf("[CRITAL]%s provider set address template list fail, reason:%s\n", logId, e)
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), templateList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -57,6 +57,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
@@ -65,7 +66,7 @@ import (
 
 func dataSourceTencentCloudAPIGatewayApiAppService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAPIGatewayApiAppServicesRead,
+		ReadContext: dataSourceTencentCloudAPIGatewayApiAppServicesRead,
 		Schema: map[string]*schema.Schema{
 			"service_id": {
 				Required:    true,
@@ -331,17 +332,17 @@ func dataSourceTencentCloudAPIGatewayApiAppService() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAPIGatewayApiAppServicesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAPIGatewayApiAppServicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_api_gateway_api_app_services.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		service       = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		apiAppService *apigateway.DescribeServiceForApiAppResponseParams
 		serviceId     string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("service_id"); ok {
@@ -353,7 +354,7 @@ func dataSourceTencentCloudAPIGatewayApiAppServicesRead(d *schema.ResourceData,
 		paramMap["ApiRegion"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeAPIGatewayApiAppServiceByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -364,7 +365,7 @@ func dataSourceTencentCloudAPIGatewayApiAppServicesRead(d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if apiAppService.ApiIdStatusSet != nil {
@@ -572,7 +573,7 @@ func dataSourceTencentCloudAPIGatewayApiAppServicesRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -16,6 +16,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudAPIGatewayAPIApps() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAPIGatewayAPIAppsRead,
+		ReadContext: dataSourceTencentCloudAPIGatewayAPIAppsRead,
 		Schema: map[string]*schema.Schema{
 			"result_output_file": {
 				Type:        schema.TypeString,
@@ -92,17 +93,17 @@ func dataSourceTencentCloudAPIGatewayAPIApps() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAPIGatewayAPIAppsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAPIGatewayAPIAppsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_api_gateway_api_apps.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId                = getLogId(contextNil)
-		ctx                  = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService    = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		apiAppId, apiAppName string
 		apiApps              []*apigateway.ApiAppInfo
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("api_app_id"); ok {
 		apiAppId = v.(string)
@@ -112,7 +113,7 @@ func dataSourceTencentCloudAPIGatewayAPIAppsRead(d *schema.ResourceData, meta in
 		apiAppName = v.(string)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := apiGatewayService.DescribeApiAppList(ctx, apiAppId, apiAppName)
 		if e != nil {
 			return retryError(e)
@@ -123,7 +124,7 @@ func dataSourceTencentCloudAPIGatewayAPIAppsRead(d *schema.ResourceData, meta in
 
 	if err != nil {
 		log.Printf("[CRITAL]%s read api_gateway apiApps failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	apiAppList := []interface{}{}
@@ -162,7 +163,7 @@ func dataSourceTencentCloudAPIGatewayAPIAppsRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), apiAppList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
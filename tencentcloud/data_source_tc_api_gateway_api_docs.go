@@ -12,6 +12,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudAPIGatewayAPIDocs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAPIGatewayAPIDocsRead,
+		ReadContext: dataSourceTencentCloudAPIGatewayAPIDocsRead,
 		Schema: map[string]*schema.Schema{
 			"result_output_file": {
 				Type:        schema.TypeString,
@@ -56,18 +57,18 @@ func dataSourceTencentCloudAPIGatewayAPIDocs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAPIGatewayAPIDocsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAPIGatewayAPIDocsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_api_gateway_api_docs.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		apiDoc            []*apigateway.APIDoc
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := apiGatewayService.DescribeApiDocList(ctx)
 		if e != nil {
 			return retryError(e)
@@ -79,7 +80,7 @@ func dataSourceTencentCloudAPIGatewayAPIDocsRead(d *schema.ResourceData, meta in
 
 	if err != nil {
 		log.Printf("[CRITAL]%s read api_gateway apiDocs failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	apiDocList := []interface{}{}
@@ -106,7 +107,7 @@ func dataSourceTencentCloudAPIGatewayAPIDocsRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), apiDocList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
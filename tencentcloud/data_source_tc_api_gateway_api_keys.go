@@ -23,16 +23,17 @@ package tencentcloud
 import (
 	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudAPIGatewayAPIKeys() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAPIGatewayAPIKeysRead,
+		ReadContext: dataSourceTencentCloudAPIGatewayAPIKeysRead,
 
 		Schema: map[string]*schema.Schema{
 			"secret_name": {
@@ -89,17 +90,17 @@ func dataSourceTencentCloudAPIGatewayAPIKeys() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAPIGatewayAPIKeysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAPIGatewayAPIKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_api_gateway_api_keys.read")()
 
 	var (
 		logId                   = getLogId(contextNil)
-		ctx                     = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService       = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		apiKeySet               []*apigateway.ApiKey
 		secretName, accessKeyId string
 		err                     error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("secret_name"); ok {
 		secretName = v.(string)
@@ -108,14 +109,14 @@ func dataSourceTencentCloudAPIGatewayAPIKeysRead(d *schema.ResourceData, meta in
 		accessKeyId = v.(string)
 	}
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		apiKeySet, err = apiGatewayService.DescribeApiKeysStatus(ctx, secretName, accessKeyId)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	list := make([]map[string]interface{}, 0, len(apiKeySet))
@@ -131,13 +132,13 @@ func dataSourceTencentCloudAPIGatewayAPIKeysRead(d *schema.ResourceData, meta in
 
 	if err := d.Set("list", list); err != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{secretName, accessKeyId}, FILED_SP))
+	d.SetId(helper.IdFormat(secretName, accessKeyId))
 
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 }
@@ -71,6 +71,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
@@ -79,7 +80,7 @@ import (
 
 func dataSourceTencentCloudAPIGatewayApiUsagePlans() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAPIGatewayApiUsagePlanRead,
+		ReadContext: dataSourceTencentCloudAPIGatewayApiUsagePlanRead,
 		Schema: map[string]*schema.Schema{
 			"service_id": {
 				Required:    true,
@@ -179,23 +180,23 @@ func dataSourceTencentCloudAPIGatewayApiUsagePlans() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAPIGatewayApiUsagePlanRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAPIGatewayApiUsagePlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_api_gateway_api_usage_plans.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		result  []*apigateway.ApiUsagePlan
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("service_id"); ok {
 		paramMap["ServiceId"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeAPIGatewayApiUsagePlanByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -206,7 +207,7 @@ func dataSourceTencentCloudAPIGatewayApiUsagePlanRead(d *schema.ResourceData, me
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(result))
@@ -286,7 +287,7 @@ func dataSourceTencentCloudAPIGatewayApiUsagePlanRead(d *schema.ResourceData, me
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -47,16 +47,17 @@ package tencentcloud
 import (
 	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudAPIGatewayAPIs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAPIGatewayAPIsRead,
+		ReadContext: dataSourceTencentCloudAPIGatewayAPIsRead,
 
 		Schema: map[string]*schema.Schema{
 			"service_id": {
@@ -287,12 +288,11 @@ func dataSourceTencentCloudAPIGatewayAPIs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAPIGatewayAPIsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAPIGatewayAPIsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_api_gateway_apis.read")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		apiName           = d.Get("api_name").(string)
 		apiId             = d.Get("api_id").(string)
@@ -300,15 +300,16 @@ func dataSourceTencentCloudAPIGatewayAPIsRead(d *schema.ResourceData, meta inter
 		apiSet            []*apigateway.DescribeApisStatusResultApiIdStatusSetInfo
 		err               error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		apiSet, err = apiGatewayService.DescribeApisStatus(ctx, serviceId, apiName, apiId)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	list := make([]map[string]interface{}, 0, len(apiSet))
@@ -318,14 +319,14 @@ func dataSourceTencentCloudAPIGatewayAPIsRead(d *schema.ResourceData, meta inter
 			has  bool
 			item = make(map[string]interface{})
 		)
-		if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			info, has, err = apiGatewayService.DescribeApi(ctx, *apiKey.ServiceId, *apiKey.ApiId)
 			if err != nil {
 				return retryError(err, InternalError)
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if !has {
 			continue
@@ -405,13 +406,13 @@ func dataSourceTencentCloudAPIGatewayAPIsRead(d *schema.ResourceData, meta inter
 
 	if err = d.Set("list", list); err != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{apiName, apiId}, FILED_SP))
+	d.SetId(helper.IdFormat(apiName, apiId))
 
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 }
@@ -25,6 +25,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
@@ -32,7 +33,7 @@ import (
 
 func dataSourceTencentCloudAPIGatewayCustomerDomains() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAPIGatewayCustomerDomainRead,
+		ReadContext: dataSourceTencentCloudAPIGatewayCustomerDomainRead,
 
 		Schema: map[string]*schema.Schema{
 			"service_id": {
@@ -108,26 +109,26 @@ func dataSourceTencentCloudAPIGatewayCustomerDomains() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAPIGatewayCustomerDomainRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAPIGatewayCustomerDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_api_gateway_customer_domains.read")
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		serviceId         = d.Get("service_id").(string)
 		infos             []*apigateway.DomainSetList
 		list              []map[string]interface{}
 		err               error
 	)
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		infos, err = apiGatewayService.DescribeServiceSubDomains(ctx, serviceId)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, info := range infos {
@@ -139,7 +140,7 @@ func dataSourceTencentCloudAPIGatewayCustomerDomainRead(d *schema.ResourceData,
 			var mappings *apigateway.ServiceSubDomainMappings
 			mappings, err = apiGatewayService.DescribeServiceSubDomainMappings(ctx, serviceId, *info.DomainName)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			for _, v := range mappings.PathMappingSet {
@@ -165,13 +166,13 @@ func dataSourceTencentCloudAPIGatewayCustomerDomainRead(d *schema.ResourceData,
 
 	if err = d.Set("list", list); err != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(serviceId)
 
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 }
@@ -34,16 +34,17 @@ package tencentcloud
 import (
 	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudAPIGatewayIpStrategy() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAPIGatewayIpStrategyRead,
+		ReadContext: dataSourceTencentCloudAPIGatewayIpStrategyRead,
 
 		Schema: map[string]*schema.Schema{
 			"service_id": {
@@ -216,12 +217,11 @@ func dataSourceTencentCloudAPIGatewayIpStrategy() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAPIGatewayIpStrategyRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAPIGatewayIpStrategyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_api_gateway_ip_strategy.read")
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		serviceId         = d.Get("service_id").(string)
 		infos             []*apigateway.IPStrategy
@@ -229,18 +229,19 @@ func dataSourceTencentCloudAPIGatewayIpStrategyRead(d *schema.ResourceData, meta
 		strategyName      string
 		err               error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if v, ok := d.GetOk("strategy_name"); ok {
 		strategyName = v.(string)
 	}
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		infos, err = apiGatewayService.DescribeIPStrategysStatus(ctx, serviceId, strategyName)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, info := range infos {
@@ -248,14 +249,14 @@ func dataSourceTencentCloudAPIGatewayIpStrategyRead(d *schema.ResourceData, meta
 
 		for _, env := range API_GATEWAY_SERVICE_ENVS {
 			var strategy *apigateway.IPStrategy
-			if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 				strategy, err = apiGatewayService.DescribeIPStrategies(ctx, serviceId, *info.StrategyId, env)
 				if err != nil {
 					return retryError(err, InternalError)
 				}
 				return nil
 			}); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			for _, api := range strategy.BindApis {
@@ -299,13 +300,13 @@ func dataSourceTencentCloudAPIGatewayIpStrategyRead(d *schema.ResourceData, meta
 
 	if err = d.Set("list", list); err != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{serviceId, strategyName}, FILED_SP))
+	d.SetId(helper.IdFormat(serviceId, strategyName))
 
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 }
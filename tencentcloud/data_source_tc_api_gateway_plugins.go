@@ -83,6 +83,7 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
@@ -90,7 +91,7 @@ import (
 
 func dataSourceTencentCloudAPIGatewayPlugins() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAPIGatewayPluginRead,
+		ReadContext: dataSourceTencentCloudAPIGatewayPluginRead,
 		Schema: map[string]*schema.Schema{
 			"service_id": {
 				Required:    true,
@@ -160,16 +161,16 @@ func dataSourceTencentCloudAPIGatewayPlugins() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAPIGatewayPluginRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAPIGatewayPluginRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_api_gateway_plugins.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		infos   []*apigateway.AvailableApiInfo
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("service_id"); ok {
@@ -184,7 +185,7 @@ func dataSourceTencentCloudAPIGatewayPluginRead(d *schema.ResourceData, meta int
 		paramMap["EnvironmentName"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeAPIGatewayPluginByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -195,7 +196,7 @@ func dataSourceTencentCloudAPIGatewayPluginRead(d *schema.ResourceData, meta int
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(infos))
@@ -242,7 +243,7 @@ func dataSourceTencentCloudAPIGatewayPluginRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -26,16 +26,17 @@ package tencentcloud
 import (
 	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudAPIGatewayServices() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAPIGatewayServicesRead,
+		ReadContext: dataSourceTencentCloudAPIGatewayServicesRead,
 
 		Schema: map[string]*schema.Schema{
 			"service_name": {
@@ -165,18 +166,18 @@ func dataSourceTencentCloudAPIGatewayServices() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAPIGatewayServicesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAPIGatewayServicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_api_gateway_services.read")()
 
 	var (
 		logId                  = getLogId(contextNil)
-		ctx                    = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService      = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		services               []*apigateway.Service
 		serviceName, serviceId string
 		has                    bool
 		err                    error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("service_name"); ok {
 		serviceName = v.(string)
@@ -185,28 +186,28 @@ func dataSourceTencentCloudAPIGatewayServicesRead(d *schema.ResourceData, meta i
 		serviceId = v.(string)
 	}
 
-	if outErr := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if outErr := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		services, err = apiGatewayService.DescribeServicesStatus(ctx, serviceId, serviceName)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	list := make([]map[string]interface{}, 0, len(services))
 
 	for _, service := range services {
 		var info apigateway.DescribeServiceResponse
-		if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			info, has, err = apiGatewayService.DescribeService(ctx, *service.ServiceId)
 			if err != nil {
 				return retryError(err, InternalError)
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if !has {
 			continue
@@ -218,14 +219,14 @@ func dataSourceTencentCloudAPIGatewayServicesRead(d *schema.ResourceData, meta i
 		var hasContains = make(map[string]bool, len(info.Response.ApiIdStatusSet))
 
 		//from service
-		if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			plans, err = apiGatewayService.DescribeServiceUsagePlan(ctx, *service.ServiceId)
 			if err != nil {
 				return retryError(err, InternalError)
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		for _, item := range plans {
@@ -243,14 +244,14 @@ func dataSourceTencentCloudAPIGatewayServicesRead(d *schema.ResourceData, meta i
 		}
 
 		//from api
-		if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			plans, err = apiGatewayService.DescribeApiUsagePlan(ctx, *service.ServiceId)
 			if err != nil {
 				return retryError(err, InternalError)
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		for _, item := range plans {
 			planList = append(
@@ -282,13 +283,13 @@ func dataSourceTencentCloudAPIGatewayServicesRead(d *schema.ResourceData, meta i
 
 	if err = d.Set("list", list); err != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{serviceName, serviceId}, FILED_SP))
+	d.SetId(helper.IdFormat(serviceName, serviceId))
 
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 }
@@ -66,6 +66,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
@@ -74,7 +75,7 @@ import (
 
 func dataSourceTencentCloudAPIGatewayThrottlingApis() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAPIGatewayThrottlingApisRead,
+		ReadContext: dataSourceTencentCloudAPIGatewayThrottlingApisRead,
 
 		Schema: map[string]*schema.Schema{
 			"service_id": {
@@ -160,12 +161,11 @@ func dataSourceTencentCloudAPIGatewayThrottlingApis() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAPIGatewayThrottlingApisRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAPIGatewayThrottlingApisRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_api_gateway_throttling_apis.read")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		infos             []*apigateway.Service
 		serviceID         string
@@ -175,6 +175,7 @@ func dataSourceTencentCloudAPIGatewayThrottlingApisRead(d *schema.ResourceData,
 		resultLists       = make([]map[string]interface{}, 0)
 		ids               = make([]string, 0)
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if v, ok := d.GetOk("service_id"); ok {
 		serviceID = v.(string)
 	}
@@ -183,7 +184,7 @@ func dataSourceTencentCloudAPIGatewayThrottlingApisRead(d *schema.ResourceData,
 	}
 
 	if serviceID == "" {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			infos, err = apiGatewayService.DescribeServicesStatus(ctx, "", "")
 			if err != nil {
 				return retryError(err, InternalError)
@@ -191,7 +192,7 @@ func dataSourceTencentCloudAPIGatewayThrottlingApisRead(d *schema.ResourceData,
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		for _, result := range infos {
@@ -207,7 +208,7 @@ func dataSourceTencentCloudAPIGatewayThrottlingApisRead(d *schema.ResourceData,
 	for _, serviceIdTmp := range serviceIds {
 		environmentList, err := apiGatewayService.DescribeApiEnvironmentStrategyList(ctx, serviceIdTmp, environmentNames, "")
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		environmentResults := make([]map[string]interface{}, 0, len(environmentList))
@@ -243,13 +244,13 @@ func dataSourceTencentCloudAPIGatewayThrottlingApisRead(d *schema.ResourceData,
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if err = d.Set("list", resultLists); err != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), resultLists); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -26,6 +26,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
@@ -34,7 +35,7 @@ import (
 
 func dataSourceTencentCloudAPIGatewayThrottlingServices() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAPIGatewayThrottlingServicesRead,
+		ReadContext: dataSourceTencentCloudAPIGatewayThrottlingServicesRead,
 
 		Schema: map[string]*schema.Schema{
 			"service_id": {
@@ -100,12 +101,11 @@ func dataSourceTencentCloudAPIGatewayThrottlingServices() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAPIGatewayThrottlingServicesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAPIGatewayThrottlingServicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_api_gateway_throttling_services.read")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		infos             []*apigateway.Service
 		serviceID         string
@@ -114,12 +114,13 @@ func dataSourceTencentCloudAPIGatewayThrottlingServicesRead(d *schema.ResourceDa
 		resultLists       = make([]map[string]interface{}, 0)
 		ids               = make([]string, 0)
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if v, ok := d.GetOk("service_id"); ok {
 		serviceID = v.(string)
 	}
 
 	if serviceID == "" {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			infos, err = apiGatewayService.DescribeServicesStatus(ctx, "", "")
 			if err != nil {
 				return retryError(err, InternalError)
@@ -127,7 +128,7 @@ func dataSourceTencentCloudAPIGatewayThrottlingServicesRead(d *schema.ResourceDa
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		for _, result := range infos {
@@ -143,7 +144,7 @@ func dataSourceTencentCloudAPIGatewayThrottlingServicesRead(d *schema.ResourceDa
 	for _, serviceIdTmp := range serviceIds {
 		environmentList, err := apiGatewayService.DescribeServiceEnvironmentStrategyList(ctx, serviceIdTmp)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		environmentResults := make([]map[string]interface{}, 0, len(environmentList))
@@ -171,13 +172,13 @@ func dataSourceTencentCloudAPIGatewayThrottlingServicesRead(d *schema.ResourceDa
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if err = d.Set("list", resultLists); err != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), resultLists); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
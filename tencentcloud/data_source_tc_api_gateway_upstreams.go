@@ -27,6 +27,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
@@ -35,7 +36,7 @@ import (
 
 func dataSourceTencentCloudAPIGatewayUpstreams() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAPIGatewayUpstreamRead,
+		ReadContext: dataSourceTencentCloudAPIGatewayUpstreamRead,
 		Schema: map[string]*schema.Schema{
 			"upstream_id": {
 				Required:    true,
@@ -105,16 +106,16 @@ func dataSourceTencentCloudAPIGatewayUpstreams() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAPIGatewayUpstreamRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAPIGatewayUpstreamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_api_gateway_upstreams.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		result  []*apigateway.BindApiInfo
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("upstream_id"); ok {
@@ -141,7 +142,7 @@ func dataSourceTencentCloudAPIGatewayUpstreamRead(d *schema.ResourceData, meta i
 		paramMap["filters"] = tmpSet
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeAPIGatewayUpstreamByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -152,7 +153,7 @@ func dataSourceTencentCloudAPIGatewayUpstreamRead(d *schema.ResourceData, meta i
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(result))
@@ -192,7 +193,7 @@ func dataSourceTencentCloudAPIGatewayUpstreamRead(d *schema.ResourceData, meta i
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -37,16 +37,17 @@ package tencentcloud
 import (
 	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudAPIGatewayUsagePlanEnvironments() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudUsagePlanEnvironmentRead,
+		ReadContext: dataSourceTencentCloudUsagePlanEnvironmentRead,
 
 		Schema: map[string]*schema.Schema{
 			"usage_plan_id": {
@@ -125,12 +126,11 @@ func dataSourceTencentCloudAPIGatewayUsagePlanEnvironments() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudUsagePlanEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudUsagePlanEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_api_gateway_usage_plans.read")
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		usagePlanId       = d.Get("usage_plan_id").(string)
 		bindType          = d.Get("bind_type").(string)
@@ -138,15 +138,16 @@ func dataSourceTencentCloudUsagePlanEnvironmentRead(d *schema.ResourceData, meta
 		list              []map[string]interface{}
 		err               error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		infos, err = apiGatewayService.DescribeUsagePlanEnvironments(ctx, usagePlanId, bindType)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, info := range infos {
@@ -165,13 +166,13 @@ func dataSourceTencentCloudUsagePlanEnvironmentRead(d *schema.ResourceData, meta
 
 	if err = d.Set("list", list); err != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{usagePlanId, bindType}, FILED_SP))
+	d.SetId(helper.IdFormat(usagePlanId, bindType))
 
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 }
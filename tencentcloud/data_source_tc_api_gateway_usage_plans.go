@@ -25,16 +25,17 @@ package tencentcloud
 import (
 	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudAPIGatewayUsagePlans() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAPIGatewayUsagePlansRead,
+		ReadContext: dataSourceTencentCloudAPIGatewayUsagePlansRead,
 
 		Schema: map[string]*schema.Schema{
 			"usage_plan_id": {
@@ -101,18 +102,18 @@ func dataSourceTencentCloudAPIGatewayUsagePlans() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAPIGatewayUsagePlansRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAPIGatewayUsagePlansRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_api_gateway_usage_plans.read")
 
 	var (
 		logId                      = getLogId(contextNil)
-		ctx                        = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService          = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		infos                      []*apigateway.UsagePlanStatusInfo
 		list                       []map[string]interface{}
 		usagePlanId, usagePlanName string
 		err                        error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("usage_plan_id"); ok {
 		usagePlanId = v.(string)
@@ -121,14 +122,14 @@ func dataSourceTencentCloudAPIGatewayUsagePlansRead(d *schema.ResourceData, meta
 		usagePlanName = v.(string)
 	}
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		infos, err = apiGatewayService.DescribeUsagePlansStatus(ctx, usagePlanId, usagePlanName)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, info := range infos {
@@ -146,13 +147,13 @@ func dataSourceTencentCloudAPIGatewayUsagePlansRead(d *schema.ResourceData, meta
 
 	if err = d.Set("list", list); err != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{usagePlanId, usagePlanName}, FILED_SP))
+	d.SetId(helper.IdFormat(usagePlanId, usagePlanName))
 
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 }
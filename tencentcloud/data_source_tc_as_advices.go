@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudAsAdvices() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAsAdvicesRead,
+		ReadContext: dataSourceTencentCloudAsAdvicesRead,
 		Schema: map[string]*schema.Schema{
 			"auto_scaling_group_ids": {
 				Required: true,
@@ -86,13 +87,13 @@ func dataSourceTencentCloudAsAdvices() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAsAdvicesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAsAdvicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_as_advices.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("auto_scaling_group_ids"); ok {
@@ -104,7 +105,7 @@ func dataSourceTencentCloudAsAdvicesRead(d *schema.ResourceData, meta interface{
 
 	var autoScalingAdviceSet []*as.AutoScalingAdvice
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeAsAdvices(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -113,7 +114,7 @@ func dataSourceTencentCloudAsAdvicesRead(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(autoScalingAdviceSet))
@@ -165,7 +166,7 @@ func dataSourceTencentCloudAsAdvicesRead(d *schema.ResourceData, meta interface{
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -30,6 +30,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -38,7 +39,7 @@ import (
 
 func dataSourceTencentCloudAsInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAsInstancesRead,
+		ReadContext: dataSourceTencentCloudAsInstancesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_ids": {
 				Optional: true,
@@ -156,13 +157,13 @@ func dataSourceTencentCloudAsInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAsInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAsInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_as_instances.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_ids"); ok {
@@ -194,7 +195,7 @@ func dataSourceTencentCloudAsInstancesRead(d *schema.ResourceData, meta interfac
 
 	var instanceList []*as.Instance
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeAsInstancesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -203,7 +204,7 @@ func dataSourceTencentCloudAsInstancesRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(instanceList))
@@ -276,7 +277,7 @@ func dataSourceTencentCloudAsInstancesRead(d *schema.ResourceData, meta interfac
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
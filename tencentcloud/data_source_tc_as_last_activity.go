@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudAsLastActivity() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAsLastActivityRead,
+		ReadContext: dataSourceTencentCloudAsLastActivityRead,
 		Schema: map[string]*schema.Schema{
 			"auto_scaling_group_ids": {
 				Required: true,
@@ -253,13 +254,13 @@ func dataSourceTencentCloudAsLastActivity() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAsLastActivityRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAsLastActivityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_as_last_activity.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("auto_scaling_group_ids"); ok {
@@ -271,7 +272,7 @@ func dataSourceTencentCloudAsLastActivityRead(d *schema.ResourceData, meta inter
 
 	var activitySet []*as.Activity
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeAsLastActivity(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -280,7 +281,7 @@ func dataSourceTencentCloudAsLastActivityRead(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(activitySet))
@@ -477,7 +478,7 @@ func dataSourceTencentCloudAsLastActivityRead(d *schema.ResourceData, meta inter
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudAsLimits() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAsLimitsRead,
+		ReadContext: dataSourceTencentCloudAsLimitsRead,
 		Schema: map[string]*schema.Schema{
 			"max_number_of_launch_configurations": {
 				Computed:    true,
@@ -55,19 +56,19 @@ func dataSourceTencentCloudAsLimits() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAsLimitsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAsLimitsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_as_limits.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := AsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var limit *as.DescribeAccountLimitsResponseParams
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeAsLimits(ctx)
 		if e != nil {
 			return retryError(e)
@@ -76,7 +77,7 @@ func dataSourceTencentCloudAsLimitsRead(d *schema.ResourceData, meta interface{}
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0)
@@ -105,7 +106,7 @@ func dataSourceTencentCloudAsLimitsRead(d *schema.ResourceData, meta interface{}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), asLimitMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
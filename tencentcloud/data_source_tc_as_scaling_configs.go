@@ -16,13 +16,14 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudAsScalingConfigs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAsScalingConfigRead,
+		ReadContext: dataSourceTencentCloudAsScalingConfigRead,
 
 		Schema: map[string]*schema.Schema{
 			"configuration_id": {
@@ -180,11 +181,11 @@ func dataSourceTencentCloudAsScalingConfigs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAsScalingConfigRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAsScalingConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_as_scaling_configs.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	asService := AsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -200,7 +201,7 @@ func dataSourceTencentCloudAsScalingConfigRead(d *schema.ResourceData, meta inte
 
 	configs, err := asService.DescribeLaunchConfigurationByFilter(ctx, configurationId, configurationName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	configurationList := make([]map[string]interface{}, 0, len(configs))
@@ -241,7 +242,7 @@ func dataSourceTencentCloudAsScalingConfigRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err = writeToFile(output.(string), configurationList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
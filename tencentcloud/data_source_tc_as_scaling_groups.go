@@ -17,13 +17,14 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudAsScalingGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAsScalingGroupRead,
+		ReadContext: dataSourceTencentCloudAsScalingGroupRead,
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
@@ -208,11 +209,11 @@ func dataSourceTencentCloudAsScalingGroups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAsScalingGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAsScalingGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_as_scaling_groups.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	asService := AsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -235,7 +236,7 @@ func dataSourceTencentCloudAsScalingGroupRead(d *schema.ResourceData, meta inter
 
 	scalingGroups, err := asService.DescribeAutoScalingGroupByFilter(ctx, scalingGroupId, configurationId, scalingGroupName, tags)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	scalingGroupList := make([]map[string]interface{}, 0, len(scalingGroups))
@@ -298,7 +299,7 @@ func dataSourceTencentCloudAsScalingGroupRead(d *schema.ResourceData, meta inter
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), scalingGroupList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
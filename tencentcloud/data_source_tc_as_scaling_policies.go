@@ -16,13 +16,14 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudAsScalingPolicies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAsScalingPolicyRead,
+		ReadContext: dataSourceTencentCloudAsScalingPolicyRead,
 
 		Schema: map[string]*schema.Schema{
 			"scaling_policy_id": {
@@ -119,11 +120,11 @@ func dataSourceTencentCloudAsScalingPolicies() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAsScalingPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAsScalingPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_as_scaling_policies.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	asService := AsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -143,7 +144,7 @@ func dataSourceTencentCloudAsScalingPolicyRead(d *schema.ResourceData, meta inte
 
 	scalingPolicies, err := asService.DescribeScalingPolicyByFilter(ctx, scalingPolicyId, policyName, scalingGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	scalingPolicyList := make([]map[string]interface{}, 0, len(scalingPolicies))
@@ -168,13 +169,13 @@ func dataSourceTencentCloudAsScalingPolicyRead(d *schema.ResourceData, meta inte
 	err = d.Set("scaling_policy_list", scalingPolicyList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set configuration list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err = writeToFile(output.(string), scalingPolicyList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
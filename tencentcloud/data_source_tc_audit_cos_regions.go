@@ -13,6 +13,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	audit "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cloudaudit/v20190319"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudAuditCosRegions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAuditCosRegionsRead,
+		ReadContext: dataSourceTencentCloudAuditCosRegionsRead,
 
 		Schema: map[string]*schema.Schema{
 			"result_output_file": {
@@ -52,18 +53,18 @@ func dataSourceTencentCloudAuditCosRegions() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAuditCosRegionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAuditCosRegionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_audit_cos_regions.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	auditService := AuditService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
 	var regions []*audit.CosRegionInfo
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		regions, errRet = auditService.DescribeAuditCosRegions(ctx)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -71,7 +72,7 @@ func dataSourceTencentCloudAuditCosRegionsRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	regionList := make([]map[string]interface{}, 0, len(regions))
@@ -88,13 +89,13 @@ func dataSourceTencentCloudAuditCosRegionsRead(d *schema.ResourceData, meta inte
 	err = d.Set("audit_cos_region_list", regionList)
 	if err != nil {
 		log.Printf("[CRITAL]%s audit cos read regions list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), regionList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
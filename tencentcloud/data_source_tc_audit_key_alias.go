@@ -14,6 +14,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	audit "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cloudaudit/v20190319"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudAuditKeyAlias() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAuditKeyAliasRead,
+		ReadContext: dataSourceTencentCloudAuditKeyAliasRead,
 
 		Schema: map[string]*schema.Schema{
 			"region": {
@@ -58,11 +59,11 @@ func dataSourceTencentCloudAuditKeyAlias() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAuditKeyAliasRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAuditKeyAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_audit_cmq_regions.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	auditService := AuditService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -70,7 +71,7 @@ func dataSourceTencentCloudAuditKeyAliasRead(d *schema.ResourceData, meta interf
 	region := d.Get("region").(string)
 	var keyAlias []*audit.KeyMetadata
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		keyAlias, errRet = auditService.DescribeKeyAlias(ctx, region)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -78,7 +79,7 @@ func dataSourceTencentCloudAuditKeyAliasRead(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	keyList := make([]map[string]interface{}, 0, len(keyAlias))
@@ -95,13 +96,13 @@ func dataSourceTencentCloudAuditKeyAliasRead(d *schema.ResourceData, meta interf
 	err = d.Set("audit_key_alias_list", keyList)
 	if err != nil {
 		log.Printf("[CRITAL]%s audit read key alias list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), keyList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
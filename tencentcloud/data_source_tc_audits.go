@@ -12,8 +12,10 @@ data "tencentcloud_audits" "audits" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	audit "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cloudaudit/v20190319"
@@ -23,7 +25,7 @@ import (
 
 func dataSourceTencentCloudAudits() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAuditsRead,
+		ReadContext: dataSourceTencentCloudAuditsRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -76,7 +78,7 @@ func dataSourceTencentCloudAudits() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAuditsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAuditsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_audits.read")()
 
 	logId := getLogId(contextNil)
@@ -85,9 +87,11 @@ func dataSourceTencentCloudAuditsRead(d *schema.ResourceData, meta interface{})
 	request := audit.NewListAuditsRequest()
 
 	var response *audit.ListAuditsResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().ListAudits(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().ListAuditsWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s %s fail, reason:%s\n", logId, request.GetAction(), e.Error())
 			return retryError(e)
@@ -96,7 +100,7 @@ func dataSourceTencentCloudAuditsRead(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	result := response.Response.AuditSummarys
@@ -120,13 +124,13 @@ func dataSourceTencentCloudAuditsRead(d *schema.ResourceData, meta interface{})
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("audit_list", auditList); e != nil {
 		log.Printf("[CRITAL]%s provider set audit list fail, reason:%s\n", logId, e)
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), auditList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -15,6 +15,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudAvailabilityRegions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAvailabilityRegionsRead,
+		ReadContext: dataSourceTencentCloudAvailabilityRegionsRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -71,11 +72,11 @@ func dataSourceTencentCloudAvailabilityRegions() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAvailabilityRegionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAvailabilityRegionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_availability_regions.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cvmService := CvmService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -94,7 +95,7 @@ func dataSourceTencentCloudAvailabilityRegionsRead(d *schema.ResourceData, meta
 
 	var regions []*cvm.RegionInfo
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		regions, errRet = cvmService.DescribeRegions(ctx)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -102,7 +103,7 @@ func dataSourceTencentCloudAvailabilityRegionsRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	regionList := make([]map[string]interface{}, 0, len(regions))
@@ -127,13 +128,13 @@ func dataSourceTencentCloudAvailabilityRegionsRead(d *schema.ResourceData, meta
 	err = d.Set("regions", regionList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set regions list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), regionList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -17,6 +17,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -27,7 +28,7 @@ func dataSourceTencentCloudAvailabilityZones() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This data source will been deprecated in Terraform TencentCloud provider later version. Please use `tencentcloud_availability_zones_by_product` instead.",
 
-		Read: dataSourceTencentCloudAvailabilityZonesRead,
+		ReadContext: dataSourceTencentCloudAvailabilityZonesRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -80,11 +81,11 @@ func dataSourceTencentCloudAvailabilityZones() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAvailabilityZonesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAvailabilityZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_availability_zones.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cvmService := CvmService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -100,7 +101,7 @@ func dataSourceTencentCloudAvailabilityZonesRead(d *schema.ResourceData, meta in
 
 	var zones []*cvm.ZoneInfo
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		zones, errRet = cvmService.DescribeZones(ctx)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -108,7 +109,7 @@ func dataSourceTencentCloudAvailabilityZonesRead(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	zoneList := make([]map[string]interface{}, 0, len(zones))
@@ -134,13 +135,13 @@ func dataSourceTencentCloudAvailabilityZonesRead(d *schema.ResourceData, meta in
 	err = d.Set("zones", zoneList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set zones list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), zoneList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -15,6 +15,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	api "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/api/v20201106"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudAvailabilityZonesByProduct() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudAvailabilityZonesByProductRead,
+		ReadContext: dataSourceTencentCloudAvailabilityZonesByProductRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -81,11 +82,11 @@ func dataSourceTencentCloudAvailabilityZonesByProduct() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudAvailabilityZonesByProductRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudAvailabilityZonesByProductRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_availability_zones.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	apiService := APIService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -105,7 +106,7 @@ func dataSourceTencentCloudAvailabilityZonesByProductRead(d *schema.ResourceData
 
 	var zones []*api.ZoneInfo
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		zones, errRet = apiService.DescribeZonesWithProduct(ctx, product)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -113,7 +114,7 @@ func dataSourceTencentCloudAvailabilityZonesByProductRead(d *schema.ResourceData
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	zoneList := make([]map[string]interface{}, 0, len(zones))
@@ -139,13 +140,13 @@ func dataSourceTencentCloudAvailabilityZonesByProductRead(d *schema.ResourceData
 	err = d.Set("zones", zoneList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set zones list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), zoneList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -15,6 +15,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudCamGroupMemberships() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCamGroupMembershipsRead,
+		ReadContext: dataSourceTencentCloudCamGroupMembershipsRead,
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -70,18 +71,18 @@ func dataSourceTencentCloudCamGroupMemberships() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCamGroupMembershipsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCamGroupMembershipsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cam_group_memberships.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	groupId := d.Get("group_id").(string)
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var memberships []*string
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := camService.DescribeGroupMembershipById(ctx, groupId)
 		if e != nil {
 			return retryError(e)
@@ -91,7 +92,7 @@ func dataSourceTencentCloudCamGroupMembershipsRead(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM group memberships failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	groupList := make([]map[string]interface{}, 0, 1)
 	ids := make([]string, 0, 1)
@@ -106,13 +107,13 @@ func dataSourceTencentCloudCamGroupMembershipsRead(d *schema.ResourceData, meta
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("membership_list", groupList); e != nil {
 		log.Printf("[CRITAL]%s provider set membership list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), groupList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
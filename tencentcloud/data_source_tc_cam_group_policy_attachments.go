@@ -23,6 +23,7 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -31,7 +32,7 @@ import (
 
 func dataSourceTencentCloudCamGroupPolicyAttachments() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCamGroupPolicyAttachmentsRead,
+		ReadContext: dataSourceTencentCloudCamGroupPolicyAttachmentsRead,
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -104,11 +105,11 @@ func dataSourceTencentCloudCamGroupPolicyAttachments() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCamGroupPolicyAttachmentsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCamGroupPolicyAttachmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cam_group_policy_attachments.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params := make(map[string]interface{})
 	groupId := d.Get("group_id").(string)
@@ -116,7 +117,7 @@ func dataSourceTencentCloudCamGroupPolicyAttachmentsRead(d *schema.ResourceData,
 	if v, ok := d.GetOk("policy_id"); ok {
 		policyId, err := strconv.Atoi(v.(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		params["policy_id"] = uint64(policyId)
 	}
@@ -131,7 +132,7 @@ func dataSourceTencentCloudCamGroupPolicyAttachmentsRead(d *schema.ResourceData,
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var policyOfGroups []*cam.AttachPolicyInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := camService.DescribeGroupPolicyAttachmentsByFilter(ctx, params)
 		if e != nil {
 			return retryError(e)
@@ -141,7 +142,7 @@ func dataSourceTencentCloudCamGroupPolicyAttachmentsRead(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM group policy attachments failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	policyOfGroupList := make([]map[string]interface{}, 0, len(policyOfGroups))
 	ids := make([]string, 0, len(policyOfGroups))
@@ -161,13 +162,13 @@ func dataSourceTencentCloudCamGroupPolicyAttachmentsRead(d *schema.ResourceData,
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("group_policy_attachment_list", policyOfGroupList); e != nil {
 		log.Printf("[CRITAL]%s provider set group polilcy attachment list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), policyOfGroupList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -22,6 +22,7 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -30,7 +31,7 @@ import (
 
 func dataSourceTencentCloudCamGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCamGroupsRead,
+		ReadContext: dataSourceTencentCloudCamGroupsRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -86,17 +87,17 @@ func dataSourceTencentCloudCamGroups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCamGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCamGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cam_groups.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params := make(map[string]interface{})
 	if v, ok := d.GetOk("group_id"); ok {
 		groupId, e := strconv.Atoi(v.(string))
 		if e != nil {
-			return e
+			return diag.FromErr(e)
 		} else {
 			params["group_id"] = groupId
 		}
@@ -112,7 +113,7 @@ func dataSourceTencentCloudCamGroupsRead(d *schema.ResourceData, meta interface{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var groups []*cam.GroupInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := camService.DescribeGroupsByFilter(ctx, params)
 		if e != nil {
 			return retryError(e)
@@ -122,7 +123,7 @@ func dataSourceTencentCloudCamGroupsRead(d *schema.ResourceData, meta interface{
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM groups failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	groupList := make([]map[string]interface{}, 0, len(groups))
 	ids := make([]string, 0, len(groups))
@@ -142,13 +143,13 @@ func dataSourceTencentCloudCamGroupsRead(d *schema.ResourceData, meta interface{
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("group_list", groupList); e != nil {
 		log.Printf("[CRITAL]%s provider set group list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), groupList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -23,6 +23,7 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -31,7 +32,7 @@ import (
 
 func dataSourceTencentCloudCamPolicies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCamPoliciesRead,
+		ReadContext: dataSourceTencentCloudCamPoliciesRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -119,17 +120,17 @@ func dataSourceTencentCloudCamPolicies() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCamPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCamPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cam_policies.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params := make(map[string]interface{})
 	if v, ok := d.GetOk("policy_id"); ok {
 		policyId, e := strconv.Atoi(v.(string))
 		if e != nil {
-			return e
+			return diag.FromErr(e)
 		} else {
 			params["policy_id"] = policyId
 		}
@@ -151,7 +152,7 @@ func dataSourceTencentCloudCamPoliciesRead(d *schema.ResourceData, meta interfac
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var policies []*cam.StrategyInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := camService.DescribePoliciesByFilter(ctx, params)
 		if e != nil {
 			return retryError(e, InternalError)
@@ -161,7 +162,7 @@ func dataSourceTencentCloudCamPoliciesRead(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM policies failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	policyList := make([]map[string]interface{}, 0, len(policies))
 	ids := make([]string, 0, len(policies))
@@ -185,13 +186,13 @@ func dataSourceTencentCloudCamPoliciesRead(d *schema.ResourceData, meta interfac
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("policy_list", policyList); e != nil {
 		log.Printf("[CRITAL]%s provider set policy list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), policyList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -21,6 +21,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -29,7 +30,7 @@ import (
 
 func dataSourceTencentCloudCamRoles() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCamRolesRead,
+		ReadContext: dataSourceTencentCloudCamRolesRead,
 
 		Schema: map[string]*schema.Schema{
 			"role_id": {
@@ -100,11 +101,11 @@ func dataSourceTencentCloudCamRoles() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCamRolesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCamRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cam_roles.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params := make(map[string]interface{})
 	if v, ok := d.GetOk("role_id"); ok {
@@ -121,7 +122,7 @@ func dataSourceTencentCloudCamRolesRead(d *schema.ResourceData, meta interface{}
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var roles []*cam.RoleInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := camService.DescribeRolesByFilter(ctx, params)
 		if e != nil {
 			return retryError(e)
@@ -131,7 +132,7 @@ func dataSourceTencentCloudCamRolesRead(d *schema.ResourceData, meta interface{}
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM roles failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	roleList := make([]map[string]interface{}, 0, len(roles))
 	ids := make([]string, 0, len(roles))
@@ -156,13 +157,13 @@ func dataSourceTencentCloudCamRolesRead(d *schema.ResourceData, meta interface{}
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("role_list", roleList); e != nil {
 		log.Printf("[CRITAL]%s provider set CAM role list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), roleList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -15,6 +15,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudCamSAMLProviders() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCamSAMLProvidersRead,
+		ReadContext: dataSourceTencentCloudCamSAMLProvidersRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -74,11 +75,11 @@ func dataSourceTencentCloudCamSAMLProviders() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCamSAMLProvidersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCamSAMLProvidersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cam_saml_providers.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params := make(map[string]interface{})
 	if v, ok := d.GetOk("name"); ok {
@@ -92,7 +93,7 @@ func dataSourceTencentCloudCamSAMLProvidersRead(d *schema.ResourceData, meta int
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var providers []*cam.SAMLProviderInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := camService.DescribeSAMLProvidersByFilter(ctx, params)
 		if e != nil {
 			return retryError(e)
@@ -102,7 +103,7 @@ func dataSourceTencentCloudCamSAMLProvidersRead(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM groups failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	providerList := make([]map[string]interface{}, 0, len(providers))
 	ids := make([]string, 0, len(providers))
@@ -120,13 +121,13 @@ func dataSourceTencentCloudCamSAMLProvidersRead(d *schema.ResourceData, meta int
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("provider_list", providerList); e != nil {
 		log.Printf("[CRITAL]%s provider set provider list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), providerList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -23,6 +23,7 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -31,7 +32,7 @@ import (
 
 func dataSourceTencentCloudCamUserPolicyAttachments() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCamUserPolicyAttachmentsRead,
+		ReadContext: dataSourceTencentCloudCamUserPolicyAttachmentsRead,
 
 		Schema: map[string]*schema.Schema{
 			"user_id": {
@@ -118,22 +119,22 @@ func dataSourceTencentCloudCamUserPolicyAttachments() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCamUserPolicyAttachmentsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCamUserPolicyAttachmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cam_user_policy_attachments.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params := make(map[string]interface{})
 	userId, _, err := getUserId(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	params["user_id"] = userId
 	if v, ok := d.GetOk("policy_id"); ok {
 		policyId, err := strconv.Atoi(v.(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		params["policy_id"] = uint64(policyId)
 	}
@@ -148,7 +149,7 @@ func dataSourceTencentCloudCamUserPolicyAttachmentsRead(d *schema.ResourceData,
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var policyOfUsers []*cam.AttachPolicyInfo
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := camService.DescribeUserPolicyAttachmentsByFilter(ctx, params)
 		if e != nil {
 			return retryError(e)
@@ -158,7 +159,7 @@ func dataSourceTencentCloudCamUserPolicyAttachmentsRead(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM user policy attachments failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	policyOfUserList := make([]map[string]interface{}, 0, len(policyOfUsers))
 	ids := make([]string, 0, len(policyOfUsers))
@@ -179,13 +180,13 @@ func dataSourceTencentCloudCamUserPolicyAttachmentsRead(d *schema.ResourceData,
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("user_policy_attachment_list", policyOfUserList); e != nil {
 		log.Printf("[CRITAL]%s provider set CAM user polilcy attachment list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), policyOfUserList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -26,6 +26,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -34,7 +35,7 @@ import (
 
 func dataSourceTencentCloudCamUsers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCamUsersRead,
+		ReadContext: dataSourceTencentCloudCamUsersRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -140,11 +141,11 @@ func dataSourceTencentCloudCamUsers() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCamUsersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCamUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cam_users.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params := make(map[string]interface{})
 	if v, ok := d.GetOk("name"); ok {
@@ -181,7 +182,7 @@ func dataSourceTencentCloudCamUsersRead(d *schema.ResourceData, meta interface{}
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var users []*cam.SubAccountInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := camService.DescribeUsersByFilter(ctx, params)
 		if e != nil {
 			return retryError(e)
@@ -191,7 +192,7 @@ func dataSourceTencentCloudCamUsersRead(d *schema.ResourceData, meta interface{}
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM users failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	userList := make([]map[string]interface{}, 0, len(users))
 	ids := make([]string, 0, len(users))
@@ -218,13 +219,13 @@ func dataSourceTencentCloudCamUsersRead(d *schema.ResourceData, meta interface{}
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("user_list", userList); e != nil {
 		log.Printf("[CRITAL]%s provider set CAM user list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), userList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -17,6 +17,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cat/v20180409"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudCatNode() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCatNodeRead,
+		ReadContext: dataSourceTencentCloudCatNodeRead,
 		Schema: map[string]*schema.Schema{
 			"node_type": {
 				Type:        schema.TypeInt,
@@ -126,12 +127,12 @@ func dataSourceTencentCloudCatNode() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCatNodeRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCatNodeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cat_node.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, _ := d.GetOk("node_type"); v != nil {
@@ -157,7 +158,7 @@ func dataSourceTencentCloudCatNodeRead(d *schema.ResourceData, meta interface{})
 	catService := CatService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var nodeSets []*cat.NodeDefine
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := catService.DescribeCatNodeByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -167,7 +168,7 @@ func dataSourceTencentCloudCatNodeRead(d *schema.ResourceData, meta interface{})
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Cat nodeSet failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(nodeSets))
@@ -211,14 +212,14 @@ func dataSourceTencentCloudCatNodeRead(d *schema.ResourceData, meta interface{})
 		d.SetId(helper.DataResourceIdsHash(ids))
 		err = d.Set("node_define", nodeSetList)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), nodeSetList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
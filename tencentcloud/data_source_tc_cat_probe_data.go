@@ -23,6 +23,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cat/v20180409"
@@ -31,7 +32,7 @@ import (
 
 func dataSourceTencentCloudCatProbeData() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCatProbedataRead,
+		ReadContext: dataSourceTencentCloudCatProbedataRead,
 		Schema: map[string]*schema.Schema{
 			"begin_time": {
 				Type:        schema.TypeInt,
@@ -210,12 +211,12 @@ func dataSourceTencentCloudCatProbeData() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCatProbedataRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCatProbedataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cat_probedata.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, _ := d.GetOk("begin_time"); v != nil {
@@ -303,7 +304,7 @@ func dataSourceTencentCloudCatProbedataRead(d *schema.ResourceData, meta interfa
 	catService := CatService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var dataSets []*cat.DetailedSingleDataDefine
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := catService.DescribeCatProbeDataByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -313,7 +314,7 @@ func dataSourceTencentCloudCatProbedataRead(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Cat dataSet failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(dataSets))
@@ -372,7 +373,7 @@ func dataSourceTencentCloudCatProbedataRead(d *schema.ResourceData, meta interfa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), dataSetList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -16,6 +16,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudCbsSnapshotPolicies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCbsSnapshotPoliciesRead,
+		ReadContext: dataSourceTencentCloudCbsSnapshotPoliciesRead,
 
 		Schema: map[string]*schema.Schema{
 			"snapshot_policy_id": {
@@ -98,10 +99,10 @@ func dataSourceTencentCloudCbsSnapshotPolicies() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCbsSnapshotPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCbsSnapshotPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cbs_snapshot_policies.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var policyId string
 	var policyName string
@@ -116,7 +117,7 @@ func dataSourceTencentCloudCbsSnapshotPoliciesRead(d *schema.ResourceData, meta
 	}
 	var policies []*cbs.AutoSnapshotPolicy
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		policies, errRet = cbsService.DescribeSnapshotPolicy(ctx, policyId, policyName)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -125,7 +126,7 @@ func dataSourceTencentCloudCbsSnapshotPoliciesRead(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read cbs snapshot policies failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(policies))
@@ -150,13 +151,13 @@ func dataSourceTencentCloudCbsSnapshotPoliciesRead(d *schema.ResourceData, meta
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if err = d.Set("snapshot_policy_list", policyList); err != nil {
 		log.Printf("[CRITAL]%s provider set snapshot policy list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err = writeToFile(output.(string), policyList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -16,6 +16,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudCbsSnapshots() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCbsSnapshotsRead,
+		ReadContext: dataSourceTencentCloudCbsSnapshotsRead,
 
 		Schema: map[string]*schema.Schema{
 			"snapshot_id": {
@@ -125,11 +126,11 @@ func dataSourceTencentCloudCbsSnapshots() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCbsSnapshotsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCbsSnapshotsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cbs_snapshots.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params := make(map[string]string)
 	if v, ok := d.GetOk("snapshot_id"); ok {
@@ -155,7 +156,7 @@ func dataSourceTencentCloudCbsSnapshotsRead(d *schema.ResourceData, meta interfa
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		snapshots, e := cbsService.DescribeSnapshotsByFilter(ctx, params)
 		if e != nil {
 			return retryError(e)
@@ -196,7 +197,7 @@ func dataSourceTencentCloudCbsSnapshotsRead(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read cbs snapshots failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -32,6 +32,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -39,7 +40,7 @@ import (
 
 func dataSourceTencentCloudCbsStorages() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCbsStoragesRead,
+		ReadContext: dataSourceTencentCloudCbsStoragesRead,
 
 		Schema: map[string]*schema.Schema{
 			"storage_id": {
@@ -212,11 +213,11 @@ func dataSourceTencentCloudCbsStorages() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCbsStoragesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCbsStoragesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cbs_storages.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params := make(map[string]interface{})
 	if v, ok := d.GetOk("storage_id"); ok {
@@ -270,7 +271,7 @@ func dataSourceTencentCloudCbsStoragesRead(d *schema.ResourceData, meta interfac
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		storages, e := cbsService.DescribeDisksByFilter(ctx, params)
 		if e != nil {
 			return retryError(e)
@@ -323,7 +324,7 @@ func dataSourceTencentCloudCbsStoragesRead(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read cbs storages failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -17,13 +17,14 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudCbsStoragesSet() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCbsStoragesSetRead,
+		ReadContext: dataSourceTencentCloudCbsStoragesSetRead,
 
 		Schema: map[string]*schema.Schema{
 			"storage_id": {
@@ -196,11 +197,11 @@ func dataSourceTencentCloudCbsStoragesSet() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCbsStoragesSetRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCbsStoragesSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cbs_storages.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params := make(map[string]interface{})
 	if v, ok := d.GetOk("storage_id"); ok {
@@ -256,7 +257,7 @@ func dataSourceTencentCloudCbsStoragesSetRead(d *schema.ResourceData, meta inter
 
 	storages, e := cbsService.DescribeDisksInParallelByFilter(ctx, params)
 	if e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 	ids := make([]string, 0, len(storages))
 	storageList := make([]map[string]interface{}, 0, len(storages))
@@ -292,13 +293,13 @@ func dataSourceTencentCloudCbsStoragesSetRead(d *schema.ResourceData, meta inter
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e = d.Set("storage_list", storageList); e != nil {
 		log.Printf("[CRITAL]%s provider set storage list fail, reason:%s\n ", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), storageList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -31,12 +31,13 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTencentCloudCcnBandwidthLimits() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCcnBandwidthLimitsRead,
+		ReadContext: dataSourceTencentCloudCcnBandwidthLimitsRead,
 
 		Schema: map[string]*schema.Schema{
 			"ccn_id": {
@@ -78,11 +79,11 @@ func dataSourceTencentCloudCcnBandwidthLimits() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCcnBandwidthLimitsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCcnBandwidthLimitsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ccn_bandwidth_limit.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -92,7 +93,7 @@ func dataSourceTencentCloudCcnBandwidthLimitsRead(d *schema.ResourceData, meta i
 
 	var infos, err = service.GetCcnRegionBandwidthLimits(ctx, ccnId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var infoList = make([]map[string]interface{}, 0, len(infos))
@@ -106,7 +107,7 @@ func dataSourceTencentCloudCcnBandwidthLimitsRead(d *schema.ResourceData, meta i
 	}
 	if err := d.Set("limits", infoList); err != nil {
 		log.Printf("[CRITAL]%s provider set  ccn  bandwidth limits fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(ccnId)
@@ -115,7 +116,7 @@ func dataSourceTencentCloudCcnBandwidthLimitsRead(d *schema.ResourceData, meta i
 		if err := writeToFile(output.(string), infoList); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
 				logId, output.(string), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
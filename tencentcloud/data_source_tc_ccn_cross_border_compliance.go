@@ -19,6 +19,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -27,7 +28,7 @@ import (
 
 func dataSourceTencentCloudCcnCrossBorderCompliance() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCcnCrossBorderComplianceRead,
+		ReadContext: dataSourceTencentCloudCcnCrossBorderComplianceRead,
 		Schema: map[string]*schema.Schema{
 			"service_provider": {
 				Optional:    true,
@@ -134,13 +135,13 @@ func dataSourceTencentCloudCcnCrossBorderCompliance() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCcnCrossBorderComplianceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCcnCrossBorderComplianceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ccn_cross_border_compliance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("service_provider"); ok {
@@ -211,7 +212,7 @@ func dataSourceTencentCloudCcnCrossBorderComplianceRead(d *schema.ResourceData,
 
 	var crossBorderComplianceSet []*vpc.CrossBorderCompliance
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCcnCrossBorderComplianceByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -220,7 +221,7 @@ func dataSourceTencentCloudCcnCrossBorderComplianceRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(crossBorderComplianceSet))
@@ -230,7 +231,7 @@ func dataSourceTencentCloudCcnCrossBorderComplianceRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -20,6 +20,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -28,7 +29,7 @@ import (
 
 func dataSourceTencentCloudCcnCrossBorderFlowMonitor() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcCrossBorderFlowMonitorRead,
+		ReadContext: dataSourceTencentCloudVpcCrossBorderFlowMonitorRead,
 		Schema: map[string]*schema.Schema{
 			"source_region": {
 				Required:    true,
@@ -123,13 +124,13 @@ func dataSourceTencentCloudCcnCrossBorderFlowMonitor() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcCrossBorderFlowMonitorRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcCrossBorderFlowMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ccn_cross_border_flow_monitor.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var ccnId string
 	paramMap := make(map[string]interface{})
@@ -166,7 +167,7 @@ func dataSourceTencentCloudVpcCrossBorderFlowMonitorRead(d *schema.ResourceData,
 
 	var crossBorderFlowMonitorData []*vpc.CrossBorderFlowMonitorData
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCcnCrossBorderFlowMonitorByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -175,7 +176,7 @@ func dataSourceTencentCloudVpcCrossBorderFlowMonitorRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(crossBorderFlowMonitorData))
@@ -210,7 +211,7 @@ func dataSourceTencentCloudVpcCrossBorderFlowMonitorRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -24,6 +24,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -32,7 +33,7 @@ import (
 
 func dataSourceTencentCloudCcnCrossBorderRegionBandwidthLimits() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCcnCrossBorderRegionBandwidthLimitsRead,
+		ReadContext: dataSourceTencentCloudCcnCrossBorderRegionBandwidthLimitsRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
 				Optional:    true,
@@ -155,13 +156,13 @@ func dataSourceTencentCloudCcnCrossBorderRegionBandwidthLimits() *schema.Resourc
 	}
 }
 
-func dataSourceTencentCloudCcnCrossBorderRegionBandwidthLimitsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCcnCrossBorderRegionBandwidthLimitsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ccn_cross_border_region_bandwidth_limits.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("filters"); ok {
@@ -188,7 +189,7 @@ func dataSourceTencentCloudCcnCrossBorderRegionBandwidthLimitsRead(d *schema.Res
 
 	var ccnBandwidthSet []*vpc.CcnBandwidth
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcCcnRegionBandwidthLimitsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -197,7 +198,7 @@ func dataSourceTencentCloudCcnCrossBorderRegionBandwidthLimitsRead(d *schema.Res
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(ccnBandwidthSet))
@@ -280,7 +281,7 @@ func dataSourceTencentCloudCcnCrossBorderRegionBandwidthLimitsRead(d *schema.Res
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -28,12 +28,13 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTencentCloudCcnInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCcnInstancesRead,
+		ReadContext: dataSourceTencentCloudCcnInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"ccn_id": {
@@ -148,11 +149,11 @@ func dataSourceTencentCloudCcnInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCcnInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCcnInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ccn_instances.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -175,7 +176,7 @@ func dataSourceTencentCloudCcnInstancesRead(d *schema.ResourceData, meta interfa
 
 	var infos, err = service.DescribeCcns(ctx, ccnId, name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var infoList = make([]map[string]interface{}, 0, len(infos))
@@ -194,7 +195,7 @@ func dataSourceTencentCloudCcnInstancesRead(d *schema.ResourceData, meta interfa
 
 		instances, err := service.DescribeCcnAttachedInstances(ctx, item.ccnId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		attachmentList := make([]interface{}, 0, len(instances))
 
@@ -217,13 +218,13 @@ func dataSourceTencentCloudCcnInstancesRead(d *schema.ResourceData, meta interfa
 	}
 	if err := d.Set("instance_list", infoList); err != nil {
 		log.Printf("[CRITAL]%s provider set  ccn instances fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	m := md5.New()
 	_, err = m.Write([]byte("ccn_instances" + ccnId + "_" + name))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%x", m.Sum(nil)))
 
@@ -231,7 +232,7 @@ func dataSourceTencentCloudCcnInstancesRead(d *schema.ResourceData, meta interfa
 		if err := writeToFile(output.(string), infoList); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
 				logId, output.(string), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudCcnTenantInstance() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTenantCcnRead,
+		ReadContext: dataSourceTencentCloudTenantCcnRead,
 		Schema: map[string]*schema.Schema{
 			"ccn_ids": {
 				Optional: true,
@@ -62,13 +63,13 @@ func dataSourceTencentCloudCcnTenantInstance() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTenantCcnRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTenantCcnRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tenant_ccn.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 
@@ -90,7 +91,7 @@ func dataSourceTencentCloudTenantCcnRead(d *schema.ResourceData, meta interface{
 
 	var ccnSet []*vpc.CcnInstanceInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTenantCcnByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -99,7 +100,7 @@ func dataSourceTencentCloudTenantCcnRead(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(ccnSet))
@@ -109,7 +110,7 @@ func dataSourceTencentCloudTenantCcnRead(d *schema.ResourceData, meta interface{
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -20,6 +20,7 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -28,7 +29,7 @@ import (
 
 func dataSourceTencentCloudCdhInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCdhInstancesRead,
+		ReadContext: dataSourceTencentCloudCdhInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"host_id": {
@@ -183,10 +184,10 @@ func dataSourceTencentCloudCdhInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCdhInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCdhInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cdh_instances.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cdhService := CdhService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -210,7 +211,7 @@ func dataSourceTencentCloudCdhInstancesRead(d *schema.ResourceData, meta interfa
 
 	var instances []*cvm.HostItem
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		instances, errRet = cdhService.DescribeCdhInstanceByFilter(ctx, filter)
 		if errRet != nil {
 			return retryError(errRet)
@@ -218,7 +219,7 @@ func dataSourceTencentCloudCdhInstancesRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceList := make([]map[string]interface{}, 0, len(instances))
@@ -256,13 +257,13 @@ func dataSourceTencentCloudCdhInstancesRead(d *schema.ResourceData, meta interfa
 	err = d.Set("cdh_instance_list", instanceList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set cdh instance list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), instanceList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -27,13 +27,14 @@ import (
 	"github.com/hashicorp/go-multierror"
 	sdkError "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdn "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdn/v20180606"
 )
 
 func dataSourceTencentCloudCdnDomainVerifyRecord() *schema.Resource {
 	return &schema.Resource{
-		Read: resourceTencentCloudCdnDomainVerifyRecordRead,
+		ReadContext: resourceTencentCloudCdnDomainVerifyRecordRead,
 		Schema: map[string]*schema.Schema{
 			"domain": {
 				Type:        schema.TypeString,
@@ -105,12 +106,12 @@ func dataSourceTencentCloudCdnDomainVerifyRecord() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCdnDomainVerifyRecordRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCdnDomainVerifyRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cdn_domain_verifier.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CdnService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -124,7 +125,7 @@ func resourceTencentCloudCdnDomainVerifyRecordRead(d *schema.ResourceData, meta
 		canContinue, reason := checkCdnDomainVerifyErrReason(err)
 		if !canContinue {
 			d.SetId("")
-			return err
+			return diag.FromErr(err)
 		}
 		_ = d.Set("failed_reason", reason)
 	}
@@ -138,7 +139,7 @@ func resourceTencentCloudCdnDomainVerifyRecordRead(d *schema.ResourceData, meta
 	response, err := service.CreateVerifyRecord(ctx, domainName)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var errResults *multierror.Error
@@ -153,11 +154,11 @@ func resourceTencentCloudCdnDomainVerifyRecordRead(d *schema.ResourceData, meta
 	errResults = multierror.Append(errResults, d.Set("file_verify_url", response.FileVerifyUrl))
 
 	if e := errResults.ErrorOrNil(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
@@ -174,7 +175,7 @@ func resourceTencentCloudCdnDomainVerifyRecordRead(d *schema.ResourceData, meta
 		if err := writeToFile(output.(string), result); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%v]",
 				logId, output.(string), err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -19,6 +19,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdn "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdn/v20180606"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudCdnDomains() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCdnDomainsRead,
+		ReadContext: dataSourceTencentCloudCdnDomainsRead,
 		Schema: map[string]*schema.Schema{
 			"domain": {
 				Type:        schema.TypeString,
@@ -330,11 +331,10 @@ func dataSourceTencentCloudCdnDomains() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCdnDomainsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCdnDomainsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cdn_domain.read")()
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		domainConfigs []*cdn.DetailDomain
 		err           error
 
@@ -343,6 +343,7 @@ func dataSourceTencentCloudCdnDomainsRead(d *schema.ResourceData, meta interface
 		cdnService = CdnService{client: client}
 		tagService = TagService{client: client}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var domainFilterMap = make(map[string]interface{}, 5)
 	if v, ok := d.GetOk("domain"); ok {
@@ -371,7 +372,7 @@ func dataSourceTencentCloudCdnDomainsRead(d *schema.ResourceData, meta interface
 	domainConfigs, err = cdnService.DescribeDomainsConfigByFilters(ctx, domainFilterMap)
 	if err != nil {
 		log.Printf("[CRITAL]%s describeDomainsConfigByFilters fail, reason:%v ", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	cdnDomainList := make([]map[string]interface{}, 0, len(domainConfigs))
@@ -442,7 +443,7 @@ func dataSourceTencentCloudCdnDomainsRead(d *schema.ResourceData, meta interface
 
 		tags, errRet := tagService.DescribeResourceTags(ctx, CDN_SERVICE_NAME, CDN_RESOURCE_NAME_DOMAIN, region, *detailDomain.Domain)
 		if errRet != nil {
-			return errRet
+			return diag.FromErr(errRet)
 		}
 
 		mapping := map[string]interface{}{
@@ -472,12 +473,12 @@ func dataSourceTencentCloudCdnDomainsRead(d *schema.ResourceData, meta interface
 	err = d.Set("domain_list", cdnDomainList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set cdn domain list fail, reason:%v ", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), cdnDomainList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
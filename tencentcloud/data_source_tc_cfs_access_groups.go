@@ -16,6 +16,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfs/v20190719"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudCfsAccessGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCfsAccessGroupsRead,
+		ReadContext: dataSourceTencentCloudCfsAccessGroupsRead,
 
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
@@ -76,10 +77,10 @@ func dataSourceTencentCloudCfsAccessGroups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCfsAccessGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCfsAccessGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cfs_access_groups.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cfsService := CfsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -95,7 +96,7 @@ func dataSourceTencentCloudCfsAccessGroupsRead(d *schema.ResourceData, meta inte
 
 	var accessGroups []*cfs.PGroupInfo
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		accessGroups, errRet = cfsService.DescribeAccessGroup(ctx, accessGroupId, name)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -103,7 +104,7 @@ func dataSourceTencentCloudCfsAccessGroupsRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	accessGroupList := make([]map[string]interface{}, 0, len(accessGroups))
@@ -123,12 +124,12 @@ func dataSourceTencentCloudCfsAccessGroupsRead(d *schema.ResourceData, meta inte
 	err = d.Set("access_group_list", accessGroupList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set cfs access group list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), accessGroupList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
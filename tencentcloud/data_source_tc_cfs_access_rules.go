@@ -16,6 +16,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfs/v20190719"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudCfsAccessRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCfsAccessRulesRead,
+		ReadContext: dataSourceTencentCloudCfsAccessRulesRead,
 
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
@@ -81,10 +82,10 @@ func dataSourceTencentCloudCfsAccessRules() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCfsAccessRulesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCfsAccessRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cfs_access_rules.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cfsService := CfsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -97,7 +98,7 @@ func dataSourceTencentCloudCfsAccessRulesRead(d *schema.ResourceData, meta inter
 
 	var accessRules []*cfs.PGroupRuleInfo
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		accessRules, errRet = cfsService.DescribeAccessRule(ctx, accessGroupId, accessRuleId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -105,7 +106,7 @@ func dataSourceTencentCloudCfsAccessRulesRead(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	accessRuleList := make([]map[string]interface{}, 0, len(accessRules))
@@ -126,12 +127,12 @@ func dataSourceTencentCloudCfsAccessRulesRead(d *schema.ResourceData, meta inter
 	err = d.Set("access_rule_list", accessRuleList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set cfs access rule list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), accessRuleList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
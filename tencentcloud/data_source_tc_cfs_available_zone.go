@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfs/v20190719"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudCfsAvailableZone() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCfsAvailableZoneRead,
+		ReadContext: dataSourceTencentCloudCfsAvailableZoneRead,
 		Schema: map[string]*schema.Schema{
 			"region_zones": {
 				Computed:    true,
@@ -128,19 +129,19 @@ func dataSourceTencentCloudCfsAvailableZone() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCfsAvailableZoneRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCfsAvailableZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cfs_available_zone.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var regionZones []*cfs.AvailableRegion
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCfsAvailableZoneByFilter(ctx)
 		if e != nil {
 			return retryError(e)
@@ -149,7 +150,7 @@ func dataSourceTencentCloudCfsAvailableZoneRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(regionZones))
@@ -245,12 +246,12 @@ func dataSourceTencentCloudCfsAvailableZoneRead(d *schema.ResourceData, meta int
 	d.SetId(helper.DataResourceIdsHash(ids))
 	err = d.Set("region_zones", tmpList)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
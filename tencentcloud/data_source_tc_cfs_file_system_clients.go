@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfs/v20190719"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudCfsFileSystemClients() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCfsFileSystemClientsRead,
+		ReadContext: dataSourceTencentCloudCfsFileSystemClientsRead,
 		Schema: map[string]*schema.Schema{
 			"file_system_id": {
 				Required:    true,
@@ -78,13 +79,13 @@ func dataSourceTencentCloudCfsFileSystemClients() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCfsFileSystemClientsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCfsFileSystemClientsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cfs_file_system_clients.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	fsId := d.Get("file_system_id").(string)
 
@@ -92,7 +93,7 @@ func dataSourceTencentCloudCfsFileSystemClientsRead(d *schema.ResourceData, meta
 
 	var clientList []*cfs.FileSystemClient
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCfsFileSystemClientsById(ctx, fsId)
 		if e != nil {
 			return retryError(e)
@@ -101,7 +102,7 @@ func dataSourceTencentCloudCfsFileSystemClientsRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(clientList))
@@ -144,7 +145,7 @@ func dataSourceTencentCloudCfsFileSystemClientsRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
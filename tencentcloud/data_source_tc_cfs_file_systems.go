@@ -17,6 +17,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfs/v20190719"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudCfsFileSystems() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCfsFileSystemsRead,
+		ReadContext: dataSourceTencentCloudCfsFileSystemsRead,
 
 		Schema: map[string]*schema.Schema{
 			"file_system_id": {
@@ -132,10 +133,10 @@ func dataSourceTencentCloudCfsFileSystems() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCfsFileSystemsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCfsFileSystemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cfs_file_systems.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cfsService := CfsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -163,7 +164,7 @@ func dataSourceTencentCloudCfsFileSystemsRead(d *schema.ResourceData, meta inter
 
 	var fileSystems []*cfs.FileSystemInfo
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		fileSystems, errRet = cfsService.DescribeFileSystem(ctx, fileSystemId, vpcId, subnetId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -171,7 +172,7 @@ func dataSourceTencentCloudCfsFileSystemsRead(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	fileSystemList := make([]map[string]interface{}, 0, len(fileSystems))
@@ -197,7 +198,7 @@ func dataSourceTencentCloudCfsFileSystemsRead(d *schema.ResourceData, meta inter
 		}
 		targets, err := cfsService.DescribeMountTargets(ctx, *fileSystem.FileSystemId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		var mountTarget *cfs.MountInfo
 		if len(targets) > 0 {
@@ -215,12 +216,12 @@ func dataSourceTencentCloudCfsFileSystemsRead(d *schema.ResourceData, meta inter
 	err = d.Set("file_system_list", fileSystemList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set cfs file system list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), fileSystemList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
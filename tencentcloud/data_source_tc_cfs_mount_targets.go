@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfs/v20190719"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudCfsMountTargets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCfsMountTargetsRead,
+		ReadContext: dataSourceTencentCloudCfsMountTargetsRead,
 		Schema: map[string]*schema.Schema{
 			"file_system_id": {
 				Required:    true,
@@ -108,20 +109,20 @@ func dataSourceTencentCloudCfsMountTargets() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCfsMountTargetsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCfsMountTargetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cfs_mount_targets.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var mountTargets []*cfs.MountInfo
 
 	fsId := d.Get("file_system_id").(string)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCfsMountTargetsById(ctx, fsId)
 		if e != nil {
 			return retryError(e)
@@ -130,7 +131,7 @@ func dataSourceTencentCloudCfsMountTargetsRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(mountTargets))
@@ -197,7 +198,7 @@ func dataSourceTencentCloudCfsMountTargetsRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfw "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfw/v20190904"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudCfwEdgeFwSwitches() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCfwEdgeFwSwitchesRead,
+		ReadContext: dataSourceTencentCloudCfwEdgeFwSwitchesRead,
 		Schema: map[string]*schema.Schema{
 			"data": {
 				Computed:    true,
@@ -91,18 +92,18 @@ func dataSourceTencentCloudCfwEdgeFwSwitches() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCfwEdgeFwSwitchesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCfwEdgeFwSwitchesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cfw_edge_fw_switches.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		data    []*cfw.EdgeIpInfo
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCfwEdgeFwSwitchesByFilter(ctx)
 		if e != nil {
 			return retryError(e)
@@ -113,7 +114,7 @@ func dataSourceTencentCloudCfwEdgeFwSwitchesRead(d *schema.ResourceData, meta in
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(data))
@@ -172,7 +173,7 @@ func dataSourceTencentCloudCfwEdgeFwSwitchesRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -25,6 +25,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfw "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfw/v20190904"
@@ -33,7 +34,7 @@ import (
 
 func dataSourceTencentCloudCfwNatFwSwitches() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCfwNatFwSwitchesRead,
+		ReadContext: dataSourceTencentCloudCfwNatFwSwitchesRead,
 		Schema: map[string]*schema.Schema{
 			"nat_ins_id": {
 				Optional:    true,
@@ -148,17 +149,17 @@ func dataSourceTencentCloudCfwNatFwSwitches() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCfwNatFwSwitchesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCfwNatFwSwitchesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cfw_nat_fw_switches.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId    = getLogId(contextNil)
-		ctx      = context.WithValue(context.TODO(), logIdKey, logId)
 		service  = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		data     []*cfw.NatSwitchListData
 		natInsId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("nat_ins_id"); ok {
@@ -170,7 +171,7 @@ func dataSourceTencentCloudCfwNatFwSwitchesRead(d *schema.ResourceData, meta int
 		paramMap["Status"] = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCfwNatFwSwitchesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -181,7 +182,7 @@ func dataSourceTencentCloudCfwNatFwSwitchesRead(d *schema.ResourceData, meta int
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(data))
@@ -268,7 +269,7 @@ func dataSourceTencentCloudCfwNatFwSwitchesRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfw "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfw/v20190904"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudCfwVpcFwSwitches() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCfwVpcFwSwitchesRead,
+		ReadContext: dataSourceTencentCloudCfwVpcFwSwitchesRead,
 		Schema: map[string]*schema.Schema{
 			"vpc_ins_id": {
 				Required:    true,
@@ -71,23 +72,23 @@ func dataSourceTencentCloudCfwVpcFwSwitches() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCfwVpcFwSwitchesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCfwVpcFwSwitchesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cfw_vpc_fw_switches.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		switchList []*cfw.FwGroupSwitchShow
 		vpcInsId   string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("vpc_ins_id"); ok {
 		vpcInsId = v.(string)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCfwVpcFwSwitchesByFilter(ctx, vpcInsId)
 		if e != nil {
 			return retryError(e)
@@ -98,7 +99,7 @@ func dataSourceTencentCloudCfwVpcFwSwitchesRead(d *schema.ResourceData, meta int
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(switchList))
@@ -137,7 +138,7 @@ func dataSourceTencentCloudCfwVpcFwSwitchesRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
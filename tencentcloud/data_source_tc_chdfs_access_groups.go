@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	chdfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/chdfs/v20201112"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudChdfsAccessGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudChdfsAccessGroupsRead,
+		ReadContext: dataSourceTencentCloudChdfsAccessGroupsRead,
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Optional:    true,
@@ -85,13 +86,13 @@ func dataSourceTencentCloudChdfsAccessGroups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudChdfsAccessGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudChdfsAccessGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_chdfs_access_groups.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("vpc_id"); ok {
@@ -106,7 +107,7 @@ func dataSourceTencentCloudChdfsAccessGroupsRead(d *schema.ResourceData, meta in
 
 	var accessGroups []*chdfs.AccessGroup
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeChdfsAccessGroupsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -115,7 +116,7 @@ func dataSourceTencentCloudChdfsAccessGroupsRead(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(accessGroups))
@@ -160,7 +161,7 @@ func dataSourceTencentCloudChdfsAccessGroupsRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
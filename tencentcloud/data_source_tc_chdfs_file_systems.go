@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	chdfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/chdfs/v20201112"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudChdfsFileSystems() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudChdfsFileSystemsRead,
+		ReadContext: dataSourceTencentCloudChdfsFileSystemsRead,
 		Schema: map[string]*schema.Schema{
 			"file_systems": {
 				Computed:    true,
@@ -112,19 +113,19 @@ func dataSourceTencentCloudChdfsFileSystems() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudChdfsFileSystemsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudChdfsFileSystemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_chdfs_file_systems.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ChdfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var fileSystems []*chdfs.FileSystem
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeChdfsFileSystems(ctx)
 		if e != nil {
 			return retryError(e)
@@ -133,7 +134,7 @@ func dataSourceTencentCloudChdfsFileSystemsRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(fileSystems))
@@ -206,7 +207,7 @@ func dataSourceTencentCloudChdfsFileSystemsRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
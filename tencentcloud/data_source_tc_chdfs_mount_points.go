@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	chdfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/chdfs/v20201112"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudChdfsMountPoints() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudChdfsMountPointsRead,
+		ReadContext: dataSourceTencentCloudChdfsMountPointsRead,
 		Schema: map[string]*schema.Schema{
 			"file_system_id": {
 				Optional:    true,
@@ -94,13 +95,13 @@ func dataSourceTencentCloudChdfsMountPoints() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudChdfsMountPointsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudChdfsMountPointsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_chdfs_mount_points.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("file_system_id"); ok {
@@ -119,7 +120,7 @@ func dataSourceTencentCloudChdfsMountPointsRead(d *schema.ResourceData, meta int
 
 	var mountPoints []*chdfs.MountPoint
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeChdfsMountPointsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -128,7 +129,7 @@ func dataSourceTencentCloudChdfsMountPointsRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(mountPoints))
@@ -173,7 +174,7 @@ func dataSourceTencentCloudChdfsMountPointsRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -18,13 +18,14 @@ import (
 	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudCkafkaAcls() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCkafkaAclsRead,
+		ReadContext: dataSourceTencentCloudCkafkaAclsRead,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -95,11 +96,11 @@ func dataSourceTencentCloudCkafkaAcls() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCkafkaAclsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCkafkaAclsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ckafka_acls.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params := make(map[string]interface{})
 	params["instance_id"] = d.Get("instance_id").(string)
@@ -114,7 +115,7 @@ func dataSourceTencentCloudCkafkaAclsRead(d *schema.ResourceData, meta interface
 	}
 	aclInfos, err := ckafkaService.DescribeAclByFilter(ctx, params)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	aclList := make([]map[string]interface{}, 0, len(aclInfos))
 	ids := make([]string, 0, len(aclInfos))
@@ -128,10 +129,10 @@ func dataSourceTencentCloudCkafkaAclsRead(d *schema.ResourceData, meta interface
 			"principal":       strings.TrimLeft(*acl.Principal, CKAFKA_ACL_PRINCIPAL_STR),
 		})
 
-		ids = append(ids, params["instance_id"].(string)+FILED_SP+CKAFKA_PERMISSION_TYPE_TO_STRING[*acl.PermissionType]+
-			FILED_SP+strings.TrimLeft(*acl.Principal, CKAFKA_ACL_PRINCIPAL_STR)+FILED_SP+*acl.Host+FILED_SP+
-			CKAFKA_ACL_OPERATION_TO_STRING[*acl.Operation]+FILED_SP+CKAFKA_ACL_RESOURCE_TYPE_TO_STRING[*acl.ResourceType]+
-			FILED_SP+*acl.ResourceName)
+		ids = append(ids, helper.IdFormat(params["instance_id"].(string), CKAFKA_PERMISSION_TYPE_TO_STRING[*acl.PermissionType],
+			strings.TrimLeft(*acl.Principal, CKAFKA_ACL_PRINCIPAL_STR), *acl.Host,
+			CKAFKA_ACL_OPERATION_TO_STRING[*acl.Operation], CKAFKA_ACL_RESOURCE_TYPE_TO_STRING[*acl.ResourceType],
+			*acl.ResourceName))
 	}
 
 	d.SetId(helper.DataResourceIdsHash(ids))
@@ -140,7 +141,7 @@ func dataSourceTencentCloudCkafkaAclsRead(d *schema.ResourceData, meta interface
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), aclList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
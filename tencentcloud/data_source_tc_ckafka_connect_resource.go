@@ -13,6 +13,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCkafkaConnectResourceRead,
+		ReadContext: dataSourceTencentCloudCkafkaConnectResourceRead,
 		Schema: map[string]*schema.Schema{
 			"type": {
 				Optional:    true,
@@ -660,13 +661,13 @@ func dataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCkafkaConnectResourceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCkafkaConnectResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ckafka_connect_resource.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("type"); ok {
@@ -693,7 +694,7 @@ func dataSourceTencentCloudCkafkaConnectResourceRead(d *schema.ResourceData, met
 
 	var result *ckafka.DescribeConnectResourcesResp
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeCkafkaConnectResourceByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -702,7 +703,7 @@ func dataSourceTencentCloudCkafkaConnectResourceRead(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0)
@@ -1175,7 +1176,7 @@ func dataSourceTencentCloudCkafkaConnectResourceRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), describeConnectResourcesRespMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
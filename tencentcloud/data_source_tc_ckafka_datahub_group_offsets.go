@@ -13,6 +13,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudCkafkaDatahubGroupOffsets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCkafkaDatahubGroupOffsetsRead,
+		ReadContext: dataSourceTencentCloudCkafkaDatahubGroupOffsetsRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Required:    true,
@@ -104,13 +105,13 @@ func dataSourceTencentCloudCkafkaDatahubGroupOffsets() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCkafkaDatahubGroupOffsetsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCkafkaDatahubGroupOffsetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ckafka_datahub_group_offsets.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("name"); ok {
@@ -129,7 +130,7 @@ func dataSourceTencentCloudCkafkaDatahubGroupOffsetsRead(d *schema.ResourceData,
 
 	var result []*ckafka.GroupOffsetTopic
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		groupOffsetTopics, e := service.DescribeCkafkaDatahubGroupOffsetsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -138,7 +139,7 @@ func dataSourceTencentCloudCkafkaDatahubGroupOffsetsRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(result))
@@ -197,7 +198,7 @@ func dataSourceTencentCloudCkafkaDatahubGroupOffsetsRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), topicList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
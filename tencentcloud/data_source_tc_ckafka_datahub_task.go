@@ -13,6 +13,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCkafkaDatahubTaskRead,
+		ReadContext: dataSourceTencentCloudCkafkaDatahubTaskRead,
 		Schema: map[string]*schema.Schema{
 			"search_word": {
 				Optional:    true,
@@ -2889,13 +2890,13 @@ func dataSourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCkafkaDatahubTaskRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCkafkaDatahubTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ckafka_datahub_task.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 
@@ -2923,7 +2924,7 @@ func dataSourceTencentCloudCkafkaDatahubTaskRead(d *schema.ResourceData, meta in
 
 	var datahubTaskInfos []*ckafka.DatahubTaskInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCkafkaDatahubTaskByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -2932,7 +2933,7 @@ func dataSourceTencentCloudCkafkaDatahubTaskRead(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(datahubTaskInfos))
@@ -5186,7 +5187,7 @@ func dataSourceTencentCloudCkafkaDatahubTaskRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), taskList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -13,6 +13,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudCkafkaDatahubTopic() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCkafkaDatahubTopicRead,
+		ReadContext: dataSourceTencentCloudCkafkaDatahubTopicRead,
 		Schema: map[string]*schema.Schema{
 			"search_word": {
 				Optional:    true,
@@ -97,13 +98,13 @@ func dataSourceTencentCloudCkafkaDatahubTopic() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCkafkaDatahubTopicRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCkafkaDatahubTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ckafka_datahub_topic.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("search_word"); ok {
@@ -122,7 +123,7 @@ func dataSourceTencentCloudCkafkaDatahubTopicRead(d *schema.ResourceData, meta i
 
 	var describeDatahubTopicsResp *ckafka.DescribeDatahubTopicsResp
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeCkafkaDatahubTopicByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -131,7 +132,7 @@ func dataSourceTencentCloudCkafkaDatahubTopicRead(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0)
@@ -183,7 +184,7 @@ func dataSourceTencentCloudCkafkaDatahubTopicRead(d *schema.ResourceData, meta i
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), topicList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
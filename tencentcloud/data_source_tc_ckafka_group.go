@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudCkafkaGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCkafkaGroupRead,
+		ReadContext: dataSourceTencentCloudCkafkaGroupRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -66,13 +67,13 @@ func dataSourceTencentCloudCkafkaGroup() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCkafkaGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCkafkaGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ckafka_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -87,7 +88,7 @@ func dataSourceTencentCloudCkafkaGroupRead(d *schema.ResourceData, meta interfac
 
 	var groups []*ckafka.DescribeGroup
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCkafkaGroupByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -96,7 +97,7 @@ func dataSourceTencentCloudCkafkaGroupRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(groups))
@@ -122,7 +123,7 @@ func dataSourceTencentCloudCkafkaGroupRead(d *schema.ResourceData, meta interfac
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), groupMapList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudCkafkaGroupInfo() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCkafkaGroupInfoRead,
+		ReadContext: dataSourceTencentCloudCkafkaGroupInfoRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -144,13 +145,13 @@ func dataSourceTencentCloudCkafkaGroupInfo() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCkafkaGroupInfoRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCkafkaGroupInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ckafka_group_info.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -166,7 +167,7 @@ func dataSourceTencentCloudCkafkaGroupInfoRead(d *schema.ResourceData, meta inte
 
 	var result []*ckafka.GroupInfoResponse
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		groupInfo, e := service.DescribeCkafkaGroupInfoByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -175,7 +176,7 @@ func dataSourceTencentCloudCkafkaGroupInfoRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(result))
@@ -268,7 +269,7 @@ func dataSourceTencentCloudCkafkaGroupInfoRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
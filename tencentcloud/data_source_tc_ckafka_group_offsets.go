@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudCkafkaGroupOffsets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCkafkaGroupOffsetsRead,
+		ReadContext: dataSourceTencentCloudCkafkaGroupOffsetsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -115,13 +116,13 @@ func dataSourceTencentCloudCkafkaGroupOffsets() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCkafkaGroupOffsetsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCkafkaGroupOffsetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ckafka_group_offsets.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -145,7 +146,7 @@ func dataSourceTencentCloudCkafkaGroupOffsetsRead(d *schema.ResourceData, meta i
 
 	var groupOffsetTopics []*ckafka.GroupOffsetTopic
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCkafkaGroupOffsetsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -154,7 +155,7 @@ func dataSourceTencentCloudCkafkaGroupOffsetsRead(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(groupOffsetTopics))
@@ -214,7 +215,7 @@ func dataSourceTencentCloudCkafkaGroupOffsetsRead(d *schema.ResourceData, meta i
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), groupOffsetResponseMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
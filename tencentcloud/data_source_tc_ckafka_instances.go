@@ -12,6 +12,8 @@ data "tencentcloud_ckafka_instances" "foo" {
 package tencentcloud
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -19,7 +21,7 @@ import (
 
 func dataSourceTencentCloudCkafkaInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCkafkaInstancesRead,
+		ReadContext: dataSourceTencentCloudCkafkaInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"instance_ids": {
@@ -277,7 +279,7 @@ func dataSourceTencentCloudCkafkaInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCkafkaInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCkafkaInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ckafka_instances.read")()
 
 	ckafkaService := CkafkaService{
@@ -315,9 +317,9 @@ func dataSourceTencentCloudCkafkaInstancesRead(d *schema.ResourceData, meta inte
 		request.Limit = helper.IntInt64(v.(int))
 	}
 
-	response, err := ckafkaService.client.UseCkafkaClient().DescribeInstancesDetail(request)
+	response, err := ckafkaService.client.UseCkafkaClient().DescribeInstancesDetailWithContext(ctx, request)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var kafkaInstanceDetails []*ckafka.InstanceDetail
 	if response.Response.Result != nil {
@@ -387,7 +389,7 @@ func dataSourceTencentCloudCkafkaInstancesRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), result); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -11,9 +11,11 @@ data "tencentcloud_ckafka_region" "region" {
 package tencentcloud
 
 import (
+	"context"
 	"errors"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -22,7 +24,7 @@ import (
 
 func dataSourceTencentCloudCkafkaRegion() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCkafkaRegionRead,
+		ReadContext: dataSourceTencentCloudCkafkaRegionRead,
 		Schema: map[string]*schema.Schema{
 			"result": {
 				Computed:    true,
@@ -83,14 +85,14 @@ func dataSourceTencentCloudCkafkaRegion() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCkafkaRegionRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCkafkaRegionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ckafka_region.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var result []*ckafka.Region
 	request := ckafka.NewDescribeRegionRequest()
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		response, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().DescribeRegion(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		response, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().DescribeRegionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -101,7 +103,7 @@ func dataSourceTencentCloudCkafkaRegionRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(result))
@@ -154,7 +156,7 @@ func dataSourceTencentCloudCkafkaRegionRead(d *schema.ResourceData, meta interfa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
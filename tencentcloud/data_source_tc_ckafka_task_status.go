@@ -15,6 +15,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudCkafkaTaskStatus() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCkafkaTaskStatusRead,
+		ReadContext: dataSourceTencentCloudCkafkaTaskStatusRead,
 		Schema: map[string]*schema.Schema{
 			"flow_id": {
 				Required:    true,
@@ -59,13 +60,13 @@ func dataSourceTencentCloudCkafkaTaskStatus() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCkafkaTaskStatusRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCkafkaTaskStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ckafka_task_status.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	flowId := d.Get("flow_id").(int)
 
@@ -73,7 +74,7 @@ func dataSourceTencentCloudCkafkaTaskStatusRead(d *schema.ResourceData, meta int
 
 	var result *ckafka.TaskStatusResponse
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		taskStatus, e := service.DescribeCkafkaTaskStatusByFilter(ctx, flowId)
 		if e != nil {
 			return retryError(e)
@@ -82,7 +83,7 @@ func dataSourceTencentCloudCkafkaTaskStatusRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	taskStatusResponseMapList := make([]interface{}, 0)
 	if result != nil {
@@ -102,7 +103,7 @@ func dataSourceTencentCloudCkafkaTaskStatusRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), taskStatusResponseMapList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudCkafkaTopicFlowRanking() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCkafkaTopicFlowRankingRead,
+		ReadContext: dataSourceTencentCloudCkafkaTopicFlowRankingRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -167,13 +168,13 @@ func dataSourceTencentCloudCkafkaTopicFlowRanking() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCkafkaTopicFlowRankingRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCkafkaTopicFlowRankingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ckafka_topic_flow_ranking.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var instanceId string
 
 	paramMap := make(map[string]interface{})
@@ -198,7 +199,7 @@ func dataSourceTencentCloudCkafkaTopicFlowRankingRead(d *schema.ResourceData, me
 
 	var result *ckafka.TopicFlowRankingResult
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		topicFlowRanking, e := service.DescribeCkafkaTopicFlowRankingByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -207,7 +208,7 @@ func dataSourceTencentCloudCkafkaTopicFlowRankingRead(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	topicFlowRankingResultMapList := make([]interface{}, 0)
 	if result != nil {
@@ -309,7 +310,7 @@ func dataSourceTencentCloudCkafkaTopicFlowRankingRead(d *schema.ResourceData, me
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), topicFlowRankingResultMapList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudCkafkaTopicProduceConnection() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCkafkaTopicProduceConnectionRead,
+		ReadContext: dataSourceTencentCloudCkafkaTopicProduceConnectionRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -71,13 +72,13 @@ func dataSourceTencentCloudCkafkaTopicProduceConnection() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCkafkaTopicProduceConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCkafkaTopicProduceConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ckafka_topic_produce_connection.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var (
 		instanceId string
 		topicName  string
@@ -97,7 +98,7 @@ func dataSourceTencentCloudCkafkaTopicProduceConnectionRead(d *schema.ResourceDa
 
 	var result []*ckafka.DescribeConnectInfoResultDTO
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		topicProduceConnection, e := service.DescribeCkafkaTopicProduceConnectionByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -106,7 +107,7 @@ func dataSourceTencentCloudCkafkaTopicProduceConnectionRead(d *schema.ResourceDa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0)
@@ -133,12 +134,12 @@ func dataSourceTencentCloudCkafkaTopicProduceConnectionRead(d *schema.ResourceDa
 		_ = d.Set("result", tmpList)
 	}
 
-	d.SetId(instanceId + FILED_SP + topicName)
+	d.SetId(helper.IdFormat(instanceId, topicName))
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudCkafkaTopicSubscribeGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCkafkaTopicSubscribeGroupRead,
+		ReadContext: dataSourceTencentCloudCkafkaTopicSubscribeGroupRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -141,13 +142,13 @@ func dataSourceTencentCloudCkafkaTopicSubscribeGroup() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCkafkaTopicSubscribeGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCkafkaTopicSubscribeGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ckafka_topic_subscribe_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -162,7 +163,7 @@ func dataSourceTencentCloudCkafkaTopicSubscribeGroupRead(d *schema.ResourceData,
 
 	var result []*ckafka.GroupInfoResponse
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		groupInfos, e := service.DescribeCkafkaTopicSubscribeGroupByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -171,7 +172,7 @@ func dataSourceTencentCloudCkafkaTopicSubscribeGroupRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(result))
@@ -263,7 +264,7 @@ func dataSourceTencentCloudCkafkaTopicSubscribeGroupRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), groupsInfoList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
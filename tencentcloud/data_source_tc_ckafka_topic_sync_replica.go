@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudCkafkaTopicSyncReplica() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCkafkaTopicSyncReplicaRead,
+		ReadContext: dataSourceTencentCloudCkafkaTopicSyncReplicaRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -102,13 +103,13 @@ func dataSourceTencentCloudCkafkaTopicSyncReplica() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCkafkaTopicSyncReplicaRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCkafkaTopicSyncReplicaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ckafka_topic_sync_replica.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
 		paramMap["instance_id"] = helper.String(v.(string))
@@ -126,7 +127,7 @@ func dataSourceTencentCloudCkafkaTopicSyncReplicaRead(d *schema.ResourceData, me
 
 	var result []*ckafka.TopicInSyncReplicaInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		topicInSyncReplicaInfos, e := service.DescribeCkafkaTopicSyncReplicaByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -135,7 +136,7 @@ func dataSourceTencentCloudCkafkaTopicSyncReplicaRead(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(result))
@@ -186,7 +187,7 @@ func dataSourceTencentCloudCkafkaTopicSyncReplicaRead(d *schema.ResourceData, me
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), topicInSyncReplicaList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -27,13 +27,14 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudCkafkaTopics() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCkafkaTopicsRead,
+		ReadContext: dataSourceTencentCloudCkafkaTopicsRead,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -155,11 +156,11 @@ func dataSourceTencentCloudCkafkaTopics() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCkafkaTopicsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCkafkaTopicsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ckafka_topics.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	instanceId := d.Get("instance_id").(string)
 	topicName := d.Get("topic_name").(string)
 	ckafkcService := CkafkaService{
@@ -167,7 +168,7 @@ func dataSourceTencentCloudCkafkaTopicsRead(d *schema.ResourceData, meta interfa
 	}
 	topicDetails, err := ckafkcService.DescribeCkafkaTopics(ctx, instanceId, topicName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceList := make([]map[string]interface{}, 0, len(topicDetails))
@@ -198,7 +199,7 @@ func dataSourceTencentCloudCkafkaTopicsRead(d *schema.ResourceData, meta interfa
 			"segment":                        topic.Config.SegmentMs,
 			"segment_bytes":                  topic.Config.SegmentBytes,
 		}
-		resourceId := instanceId + FILED_SP + *topic.TopicName
+		resourceId := helper.IdFormat(instanceId, *topic.TopicName)
 		instanceList = append(instanceList, instance)
 		ids = append(ids, resourceId)
 	}
@@ -206,13 +207,13 @@ func dataSourceTencentCloudCkafkaTopicsRead(d *schema.ResourceData, meta interfa
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if err = d.Set("instance_list", instanceList); err != nil {
 		log.Printf("[CRITAL]%s provider set ckafka topic list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), instanceList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -15,13 +15,14 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudCkafkaUsers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCkafkaUsersRead,
+		ReadContext: dataSourceTencentCloudCkafkaUsersRead,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -67,11 +68,11 @@ func dataSourceTencentCloudCkafkaUsers() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCkafkaUsersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCkafkaUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ckafka_users.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params := make(map[string]interface{})
 	params["instance_id"] = d.Get("instance_id").(string)
@@ -84,7 +85,7 @@ func dataSourceTencentCloudCkafkaUsersRead(d *schema.ResourceData, meta interfac
 	}
 	userInfos, err := ckafkaService.DescribeUserByFilter(ctx, params)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	userList := make([]map[string]interface{}, 0, len(userInfos))
 	ids := make([]string, 0, len(userInfos))
@@ -95,7 +96,7 @@ func dataSourceTencentCloudCkafkaUsersRead(d *schema.ResourceData, meta interfac
 			"update_time":  *user.UpdateTime,
 		})
 
-		ids = append(ids, params["instance_id"].(string)+FILED_SP+*user.Name)
+		ids = append(ids, helper.IdFormat(params["instance_id"].(string), *user.Name))
 	}
 
 	d.SetId(helper.DataResourceIdsHash(ids))
@@ -104,7 +105,7 @@ func dataSourceTencentCloudCkafkaUsersRead(d *schema.ResourceData, meta interfac
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), userList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -13,6 +13,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudCkafkaZone() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCkafkaZoneRead,
+		ReadContext: dataSourceTencentCloudCkafkaZoneRead,
 		Schema: map[string]*schema.Schema{
 			"cdc_id": {
 				Optional:    true,
@@ -256,13 +257,13 @@ func dataSourceTencentCloudCkafkaZone() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCkafkaZoneRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCkafkaZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ckafka_zone.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cdc_id"); ok {
@@ -273,7 +274,7 @@ func dataSourceTencentCloudCkafkaZoneRead(d *schema.ResourceData, meta interface
 
 	var result *ckafka.ZoneResponse
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeCkafkaCkafkaZoneByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -282,7 +283,7 @@ func dataSourceTencentCloudCkafkaZoneRead(d *schema.ResourceData, meta interface
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0)
@@ -471,7 +472,7 @@ func dataSourceTencentCloudCkafkaZoneRead(d *schema.ResourceData, meta interface
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), zoneResponseMapList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
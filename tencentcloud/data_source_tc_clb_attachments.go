@@ -17,6 +17,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudClbServerAttachments() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClbServerAttachmentsRead,
+		ReadContext: dataSourceTencentCloudClbServerAttachmentsRead,
 
 		Schema: map[string]*schema.Schema{
 			"clb_id": {
@@ -105,25 +106,25 @@ func dataSourceTencentCloudClbServerAttachments() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClbServerAttachmentsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClbServerAttachmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_clb_attachments.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params := make(map[string]string)
 	clbId := d.Get("clb_id").(string)
 	listenerId := d.Get("listener_id").(string)
 	checkErr := ListenerIdCheck(listenerId)
 	if checkErr != nil {
-		return checkErr
+		return diag.FromErr(checkErr)
 	}
 	locationId := ""
 	if v, ok := d.GetOk("rule_id"); ok {
 		locationId = v.(string)
 		checkErr := RuleIdCheck(locationId)
 		if checkErr != nil {
-			return checkErr
+			return diag.FromErr(checkErr)
 		}
 		params["rule_id"] = locationId
 	}
@@ -134,7 +135,7 @@ func dataSourceTencentCloudClbServerAttachmentsRead(d *schema.ResourceData, meta
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var attachments []*clb.ListenerBackend
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := clbService.DescribeAttachmentsByFilter(ctx, params)
 		if e != nil {
 			return retryError(e)
@@ -144,7 +145,7 @@ func dataSourceTencentCloudClbServerAttachmentsRead(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CLB attachments failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	attachmentList := make([]map[string]interface{}, 0, len(attachments))
 	ids := make([]string, 0, len(attachments))
@@ -176,13 +177,13 @@ func dataSourceTencentCloudClbServerAttachmentsRead(d *schema.ResourceData, meta
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("attachment_list", attachmentList); e != nil {
 		log.Printf("[CRITAL]%s provider set attachment list fail, reason:%+v", logId, e)
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), attachmentList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
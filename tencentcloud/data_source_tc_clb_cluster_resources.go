@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudClbClusterResources() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClbClusterResourcesRead,
+		ReadContext: dataSourceTencentCloudClbClusterResourcesRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
 				Optional:    true,
@@ -124,13 +125,13 @@ func dataSourceTencentCloudClbClusterResources() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClbClusterResourcesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClbClusterResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_clb_cluster_resources.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("filters"); ok {
@@ -157,7 +158,7 @@ func dataSourceTencentCloudClbClusterResourcesRead(d *schema.ResourceData, meta
 
 	var clusterResourceSet []*clb.ClusterResource
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeClbClusterResourcesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -166,7 +167,7 @@ func dataSourceTencentCloudClbClusterResourcesRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(clusterResourceSet))
@@ -225,7 +226,7 @@ func dataSourceTencentCloudClbClusterResourcesRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudClbCrossTargets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClbCrossTargetsRead,
+		ReadContext: dataSourceTencentCloudClbCrossTargetsRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
 				Optional:    true,
@@ -109,13 +110,13 @@ func dataSourceTencentCloudClbCrossTargets() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClbCrossTargetsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClbCrossTargetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_clb_cross_targets.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("filters"); ok {
@@ -142,7 +143,7 @@ func dataSourceTencentCloudClbCrossTargetsRead(d *schema.ResourceData, meta inte
 
 	var crossTargetSet []*clb.CrossTargets
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeClbCrossTargetsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -151,7 +152,7 @@ func dataSourceTencentCloudClbCrossTargetsRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(crossTargetSet))
@@ -204,7 +205,7 @@ func dataSourceTencentCloudClbCrossTargetsRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudClbExclusiveClusters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClbExclusiveClustersRead,
+		ReadContext: dataSourceTencentCloudClbExclusiveClustersRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
 				Optional:    true,
@@ -204,13 +205,13 @@ func dataSourceTencentCloudClbExclusiveClusters() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClbExclusiveClustersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClbExclusiveClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_clb_exclusive_clusters.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("filters"); ok {
@@ -237,7 +238,7 @@ func dataSourceTencentCloudClbExclusiveClustersRead(d *schema.ResourceData, meta
 
 	var clusterSet []*clb.Cluster
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeClbExclusiveClustersByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -246,7 +247,7 @@ func dataSourceTencentCloudClbExclusiveClustersRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(clusterSet))
@@ -369,7 +370,7 @@ func dataSourceTencentCloudClbExclusiveClustersRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
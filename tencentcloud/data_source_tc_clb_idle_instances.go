@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudClbIdleInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClbIdleInstancesRead,
+		ReadContext: dataSourceTencentCloudClbIdleInstancesRead,
 		Schema: map[string]*schema.Schema{
 			"load_balancer_region": {
 				Optional:    true,
@@ -89,13 +90,13 @@ func dataSourceTencentCloudClbIdleInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClbIdleInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClbIdleInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_clb_idle_loadbalancers.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("load_balancer_region"); ok {
@@ -106,7 +107,7 @@ func dataSourceTencentCloudClbIdleInstancesRead(d *schema.ResourceData, meta int
 
 	var idleLoadBalancers []*clb.IdleLoadBalancer
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeClbIdleInstancesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -115,7 +116,7 @@ func dataSourceTencentCloudClbIdleInstancesRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(idleLoadBalancers))
@@ -168,7 +169,7 @@ func dataSourceTencentCloudClbIdleInstancesRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudClbInstanceByCertId() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClbInstanceByCertIdRead,
+		ReadContext: dataSourceTencentCloudClbInstanceByCertIdRead,
 		Schema: map[string]*schema.Schema{
 			"cert_ids": {
 				Required: true,
@@ -584,13 +585,13 @@ func dataSourceTencentCloudClbInstanceByCertId() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClbInstanceByCertIdRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClbInstanceByCertIdRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_clb_instance_by_cert_id.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cert_ids"); ok {
@@ -602,7 +603,7 @@ func dataSourceTencentCloudClbInstanceByCertIdRead(d *schema.ResourceData, meta
 
 	var certSet []*clb.CertIdRelatedWithLoadBalancers
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeClbInstanceByCertId(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -611,7 +612,7 @@ func dataSourceTencentCloudClbInstanceByCertIdRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(certSet))
@@ -1060,7 +1061,7 @@ func dataSourceTencentCloudClbInstanceByCertIdRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudClbInstanceDetail() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClbInstanceDetailRead,
+		ReadContext: dataSourceTencentCloudClbInstanceDetailRead,
 		Schema: map[string]*schema.Schema{
 			"fields": {
 				Optional: true,
@@ -341,13 +342,13 @@ func dataSourceTencentCloudClbInstanceDetail() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClbInstanceDetailRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClbInstanceDetailRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_clb_instance_detail.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("fields"); ok {
@@ -383,7 +384,7 @@ func dataSourceTencentCloudClbInstanceDetailRead(d *schema.ResourceData, meta in
 
 	var loadBalancerDetailSet []*clb.LoadBalancerDetail
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeClbInstanceDetailByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -392,7 +393,7 @@ func dataSourceTencentCloudClbInstanceDetailRead(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(loadBalancerDetailSet))
@@ -614,7 +615,7 @@ func dataSourceTencentCloudClbInstanceDetailRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
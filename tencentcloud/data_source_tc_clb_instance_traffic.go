@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudClbInstanceTraffic() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClbInstanceTrafficRead,
+		ReadContext: dataSourceTencentCloudClbInstanceTrafficRead,
 		Schema: map[string]*schema.Schema{
 			"load_balancer_region": {
 				Optional:    true,
@@ -79,13 +80,13 @@ func dataSourceTencentCloudClbInstanceTraffic() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClbInstanceTrafficRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClbInstanceTrafficRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_clb_instance_traffic.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("load_balancer_region"); ok {
@@ -96,7 +97,7 @@ func dataSourceTencentCloudClbInstanceTrafficRead(d *schema.ResourceData, meta i
 
 	var loadBalancerTraffic []*clb.LoadBalancerTraffic
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeClbInstanceTraffic(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -105,7 +106,7 @@ func dataSourceTencentCloudClbInstanceTrafficRead(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(loadBalancerTraffic))
@@ -150,7 +151,7 @@ func dataSourceTencentCloudClbInstanceTrafficRead(d *schema.ResourceData, meta i
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -19,6 +19,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -27,7 +28,7 @@ import (
 
 func dataSourceTencentCloudClbInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClbInstancesRead,
+		ReadContext: dataSourceTencentCloudClbInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"clb_id": {
@@ -191,11 +192,11 @@ func dataSourceTencentCloudClbInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClbInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClbInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_clb_instances.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params := make(map[string]interface{})
 	if v, ok := d.GetOk("clb_id"); ok {
@@ -218,7 +219,7 @@ func dataSourceTencentCloudClbInstancesRead(d *schema.ResourceData, meta interfa
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var clbs []*clb.LoadBalancer
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := clbService.DescribeLoadBalancerByFilter(ctx, params)
 		if e != nil {
 			return retryError(e)
@@ -228,7 +229,7 @@ func dataSourceTencentCloudClbInstancesRead(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CLB instances failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	clbList := make([]map[string]interface{}, 0, len(clbs))
 	ids := make([]string, 0, len(clbs))
@@ -276,13 +277,13 @@ func dataSourceTencentCloudClbInstancesRead(d *schema.ResourceData, meta interfa
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("clb_list", clbList); e != nil {
 		log.Printf("[CRITAL]%s provider set CLB list fail, reason:%+v", logId, e)
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), clbList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -20,6 +20,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -28,7 +29,7 @@ import (
 
 func dataSourceTencentCloudClbListenerRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClbListenerRulesRead,
+		ReadContext: dataSourceTencentCloudClbListenerRulesRead,
 
 		Schema: map[string]*schema.Schema{
 			"clb_id": {
@@ -175,16 +176,16 @@ func dataSourceTencentCloudClbListenerRules() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClbListenerRulesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClbListenerRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_clb_listener_rules.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	listenerId := d.Get("listener_id").(string)
 	checkErr := ListenerIdCheck(listenerId)
 	if checkErr != nil {
-		return checkErr
+		return diag.FromErr(checkErr)
 	}
 	clbId := d.Get("clb_id").(string)
 	params := make(map[string]string)
@@ -200,7 +201,7 @@ func dataSourceTencentCloudClbListenerRulesRead(d *schema.ResourceData, meta int
 		params["rule_id"] = v.(string)
 		checkErr := RuleIdCheck(params["rule_id"])
 		if checkErr != nil {
-			return checkErr
+			return diag.FromErr(checkErr)
 		}
 	}
 	if v, ok := d.GetOk("domain"); ok {
@@ -214,7 +215,7 @@ func dataSourceTencentCloudClbListenerRulesRead(d *schema.ResourceData, meta int
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var rules []*clb.RuleOutput
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := clbService.DescribeRulesByFilter(ctx, params)
 		if e != nil {
 			return retryError(e)
@@ -224,7 +225,7 @@ func dataSourceTencentCloudClbListenerRulesRead(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CLB listener rules failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	ruleList := make([]map[string]interface{}, 0, len(rules))
 	ids := make([]string, 0, len(rules))
@@ -267,13 +268,13 @@ func dataSourceTencentCloudClbListenerRulesRead(d *schema.ResourceData, meta int
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("rule_list", ruleList); e != nil {
 		log.Printf("[CRITAL]%s provider set CLB listener rule list fail, reason:%+v", logId, e)
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), ruleList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
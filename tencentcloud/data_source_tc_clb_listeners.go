@@ -18,6 +18,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudClbListeners() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClbListenersRead,
+		ReadContext: dataSourceTencentCloudClbListenersRead,
 
 		Schema: map[string]*schema.Schema{
 			"clb_id": {
@@ -199,11 +200,11 @@ func dataSourceTencentCloudClbListeners() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClbListenersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClbListenersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_clb_listeners.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	clbId := d.Get("clb_id").(string)
 
@@ -214,7 +215,7 @@ func dataSourceTencentCloudClbListenersRead(d *schema.ResourceData, meta interfa
 		params["listener_id"] = listenerId
 		checkErr := ListenerIdCheck(listenerId)
 		if checkErr != nil {
-			return checkErr
+			return diag.FromErr(checkErr)
 		}
 	}
 	if v, ok := d.GetOk("port"); ok {
@@ -228,7 +229,7 @@ func dataSourceTencentCloudClbListenersRead(d *schema.ResourceData, meta interfa
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var listeners []*clb.Listener
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := clbService.DescribeListenersByFilter(ctx, params)
 		if e != nil {
 			return retryError(e)
@@ -238,7 +239,7 @@ func dataSourceTencentCloudClbListenersRead(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CLB listeners failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	listenerList := make([]map[string]interface{}, 0, len(listeners))
 	ids := make([]string, 0, len(listeners))
@@ -296,13 +297,13 @@ func dataSourceTencentCloudClbListenersRead(d *schema.ResourceData, meta interfa
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("listener_list", listenerList); e != nil {
 		log.Printf("[CRITAL]%s provider set CLB listener list fail, reason:%+v", logId, e)
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), listenerList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
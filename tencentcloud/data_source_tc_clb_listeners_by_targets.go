@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudClbListenersByTargets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClbListenersByTargetsRead,
+		ReadContext: dataSourceTencentCloudClbListenersByTargetsRead,
 		Schema: map[string]*schema.Schema{
 			"backends": {
 				Required:    true,
@@ -202,13 +203,13 @@ func dataSourceTencentCloudClbListenersByTargets() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClbListenersByTargetsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClbListenersByTargetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_clb_listeners_by_targets.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("backends"); ok {
@@ -234,7 +235,7 @@ func dataSourceTencentCloudClbListenersByTargetsRead(d *schema.ResourceData, met
 
 	var loadBalancers []*clb.LBItem
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeClbListenersByTargets(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -243,7 +244,7 @@ func dataSourceTencentCloudClbListenersByTargetsRead(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(loadBalancers))
@@ -388,7 +389,7 @@ func dataSourceTencentCloudClbListenersByTargetsRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -20,6 +20,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -27,7 +28,7 @@ import (
 
 func dataSourceTencentCloudClbRedirections() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClbRedirectionsRead,
+		ReadContext: dataSourceTencentCloudClbRedirectionsRead,
 
 		Schema: map[string]*schema.Schema{
 			"clb_id": {
@@ -98,36 +99,36 @@ func dataSourceTencentCloudClbRedirections() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClbRedirectionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClbRedirectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_clb_redirections.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params := make(map[string]string)
 	params["clb_id"] = d.Get("clb_id").(string)
 	params["source_listener_id"] = d.Get("source_listener_id").(string)
 	checkErr := ListenerIdCheck(params["source_listener_id"])
 	if checkErr != nil {
-		return checkErr
+		return diag.FromErr(checkErr)
 	}
 	params["source_rule_id"] = d.Get("source_rule_id").(string)
 	checkErr = RuleIdCheck(params["source_rule_id"])
 	if checkErr != nil {
-		return checkErr
+		return diag.FromErr(checkErr)
 	}
 	if v, ok := d.GetOk("target_listener_id"); ok {
 		params["target_listener_id"] = v.(string)
 		checkErr := ListenerIdCheck(params["target_listener_id"])
 		if checkErr != nil {
-			return checkErr
+			return diag.FromErr(checkErr)
 		}
 	}
 	if v, ok := d.GetOk("target_rule_id"); ok {
 		params["target_rule_id"] = v.(string)
 		checkErr = RuleIdCheck(params["target_rule_id"])
 		if checkErr != nil {
-			return checkErr
+			return diag.FromErr(checkErr)
 		}
 	}
 
@@ -135,7 +136,7 @@ func dataSourceTencentCloudClbRedirectionsRead(d *schema.ResourceData, meta inte
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var redirections []*map[string]string
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := clbService.DescribeRedirectionsByFilter(ctx, params)
 		if e != nil {
 			return retryError(e)
@@ -145,7 +146,7 @@ func dataSourceTencentCloudClbRedirectionsRead(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CLB redirections failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	redirectionList := make([]map[string]interface{}, 0, len(redirections))
 	ids := make([]string, 0, len(redirections))
@@ -165,13 +166,13 @@ func dataSourceTencentCloudClbRedirectionsRead(d *schema.ResourceData, meta inte
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("redirection_list", redirectionList); e != nil {
 		log.Printf("[CRITAL]%s provider set CLB redirection list fail, reason:%+v", logId, e)
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), redirectionList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudClbResources() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClbResourcesRead,
+		ReadContext: dataSourceTencentCloudClbResourcesRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
 				Optional:    true,
@@ -145,13 +146,13 @@ func dataSourceTencentCloudClbResources() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClbResourcesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClbResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_clb_resources.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("filters"); ok {
@@ -178,7 +179,7 @@ func dataSourceTencentCloudClbResourcesRead(d *schema.ResourceData, meta interfa
 
 	var zoneResourceSet []*clb.ZoneResource
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeClbResourcesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -187,7 +188,7 @@ func dataSourceTencentCloudClbResourcesRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(zoneResourceSet))
@@ -274,7 +275,7 @@ func dataSourceTencentCloudClbResourcesRead(d *schema.ResourceData, meta interfa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudClbTargetGroupList() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClbTargetGroupListRead,
+		ReadContext: dataSourceTencentCloudClbTargetGroupListRead,
 		Schema: map[string]*schema.Schema{
 			"target_group_ids": {
 				Optional: true,
@@ -162,13 +163,13 @@ func dataSourceTencentCloudClbTargetGroupList() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClbTargetGroupListRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClbTargetGroupListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_clb_target_group_list.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("target_group_ids"); ok {
@@ -200,7 +201,7 @@ func dataSourceTencentCloudClbTargetGroupListRead(d *schema.ResourceData, meta i
 
 	var targetGroupSet []*clb.TargetGroupInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeClbTargetGroupListByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -209,7 +210,7 @@ func dataSourceTencentCloudClbTargetGroupListRead(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(targetGroupSet))
@@ -301,7 +302,7 @@ func dataSourceTencentCloudClbTargetGroupListRead(d *schema.ResourceData, meta i
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
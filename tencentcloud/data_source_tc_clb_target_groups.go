@@ -48,6 +48,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -56,7 +57,7 @@ import (
 
 func dataSourceTencentCloudClbTargetGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClbTargetGroupRead,
+		ReadContext: dataSourceTencentCloudClbTargetGroupRead,
 
 		Schema: map[string]*schema.Schema{
 			"target_group_id": {
@@ -236,7 +237,7 @@ func dataSourceTencentCloudClbTargetGroups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClbTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClbTargetGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_clb_target_groups.read")()
 
 	var (
@@ -244,7 +245,6 @@ func dataSourceTencentCloudClbTargetGroupRead(d *schema.ResourceData, meta inter
 			client: meta.(*TencentCloudClient).apiV3Conn,
 		}
 		logId                = getLogId(contextNil)
-		ctx                  = context.WithValue(context.TODO(), logIdKey, logId)
 		instances            []*clb.TargetGroupBackend
 		targetInfos          []*clb.TargetGroupInfo
 		filters              = make(map[string]string, 2)
@@ -252,6 +252,7 @@ func dataSourceTencentCloudClbTargetGroupRead(d *schema.ResourceData, meta inter
 		targetGroupId        string
 		err                  error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if id, ok := d.GetOk("target_group_id"); ok {
 		targetGroupId = id.(string)
@@ -263,7 +264,7 @@ func dataSourceTencentCloudClbTargetGroupRead(d *schema.ResourceData, meta inter
 		filters["TargetGroupName"] = name.(string)
 	}
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		targetInfos, err = clbService.DescribeTargetGroups(ctx, targetGroupId, filters)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -271,7 +272,7 @@ func dataSourceTencentCloudClbTargetGroupRead(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var (
 		list    = make([]map[string]interface{}, 0, len(targetInfos))
@@ -286,7 +287,7 @@ func dataSourceTencentCloudClbTargetGroupRead(d *schema.ResourceData, meta inter
 			instances = []*clb.TargetGroupBackend{}
 			targetGroupInstances = []map[string]interface{}{}
 
-			err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 				instances, err = clbService.DescribeTargetGroupInstances(ctx, map[string]string{
 					"TargetGroupId": *info.TargetGroupId,
 				})
@@ -296,7 +297,7 @@ func dataSourceTencentCloudClbTargetGroupRead(d *schema.ResourceData, meta inter
 				return nil
 			})
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			isExist[targetId] = true
@@ -350,7 +351,7 @@ func dataSourceTencentCloudClbTargetGroupRead(d *schema.ResourceData, meta inter
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), list); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudClbTargetHealth() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClbTargetHealthRead,
+		ReadContext: dataSourceTencentCloudClbTargetHealthRead,
 		Schema: map[string]*schema.Schema{
 			"load_balancer_ids": {
 				Required: true,
@@ -149,13 +150,13 @@ func dataSourceTencentCloudClbTargetHealth() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClbTargetHealthRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClbTargetHealthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_clb_target_health.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("load_balancer_ids"); ok {
@@ -167,7 +168,7 @@ func dataSourceTencentCloudClbTargetHealthRead(d *schema.ResourceData, meta inte
 
 	var loadBalancers []*clb.LoadBalancerHealth
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeClbTargetHealthByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -176,7 +177,7 @@ func dataSourceTencentCloudClbTargetHealthRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(loadBalancers))
@@ -286,7 +287,7 @@ func dataSourceTencentCloudClbTargetHealthRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
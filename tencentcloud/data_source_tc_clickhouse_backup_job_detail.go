@@ -13,6 +13,8 @@ data "tencentcloud_clickhouse_backup_job_detail" "backup_job_detail" {
 package tencentcloud
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clickhouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdwch/v20200915"
@@ -22,7 +24,7 @@ import (
 
 func dataSourceTencentCloudClickhouseBackupJobDetail() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClickhouseBackupJobDetailRead,
+		ReadContext: dataSourceTencentCloudClickhouseBackupJobDetailRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -90,7 +92,7 @@ func dataSourceTencentCloudClickhouseBackupJobDetail() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClickhouseBackupJobDetailRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClickhouseBackupJobDetailRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_clickhouse_backup_job_detail.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -111,9 +113,11 @@ func dataSourceTencentCloudClickhouseBackupJobDetailRead(d *schema.ResourceData,
 
 	var tableContents []*clickhouse.BackupTableContent
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, e := meta.(*TencentCloudClient).apiV3Conn.UseCdwchClient().DescribeBackUpJobDetail(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, e := meta.(*TencentCloudClient).apiV3Conn.UseCdwchClient().DescribeBackUpJobDetailWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -121,7 +125,7 @@ func dataSourceTencentCloudClickhouseBackupJobDetailRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(tableContents))
@@ -168,7 +172,7 @@ func dataSourceTencentCloudClickhouseBackupJobDetailRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -15,6 +15,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clickhouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdwch/v20200915"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudClickhouseBackupJobs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClickhouseBackupJobsRead,
+		ReadContext: dataSourceTencentCloudClickhouseBackupJobsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -97,13 +98,13 @@ func dataSourceTencentCloudClickhouseBackupJobs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClickhouseBackupJobsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClickhouseBackupJobsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_clickhouse_backup_jobs.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -122,7 +123,7 @@ func dataSourceTencentCloudClickhouseBackupJobsRead(d *schema.ResourceData, meta
 
 	var backUpJobs []*clickhouse.BackUpJobDisplay
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeClickhouseBackupJobsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -131,7 +132,7 @@ func dataSourceTencentCloudClickhouseBackupJobsRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(backUpJobs))
@@ -180,7 +181,7 @@ func dataSourceTencentCloudClickhouseBackupJobsRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clickhouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdwch/v20200915"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudClickhouseBackupTables() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClickhouseBackupTablesRead,
+		ReadContext: dataSourceTencentCloudClickhouseBackupTablesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -84,12 +85,12 @@ func dataSourceTencentCloudClickhouseBackupTables() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClickhouseBackupTablesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClickhouseBackupTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_clickhouse_backup_tables.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Get("instance_id").(string)
 
@@ -97,7 +98,7 @@ func dataSourceTencentCloudClickhouseBackupTablesRead(d *schema.ResourceData, me
 
 	var availableTables []*clickhouse.BackupTableContent
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeClickhouseBackupTablesByFilter(ctx, instanceId)
 		if e != nil {
 			return retryError(e)
@@ -106,7 +107,7 @@ func dataSourceTencentCloudClickhouseBackupTablesRead(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(availableTables))
@@ -144,7 +145,7 @@ func dataSourceTencentCloudClickhouseBackupTablesRead(d *schema.ResourceData, me
 				backupTableContentMap["rip"] = backupTableContent.Rip
 			}
 
-			ids = append(ids, *backupTableContent.Database+FILED_SP+*backupTableContent.Table)
+			ids = append(ids, helper.IdFormat(*backupTableContent.Database, *backupTableContent.Table))
 			tmpList = append(tmpList, backupTableContentMap)
 		}
 
@@ -155,7 +156,7 @@ func dataSourceTencentCloudClickhouseBackupTablesRead(d *schema.ResourceData, me
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
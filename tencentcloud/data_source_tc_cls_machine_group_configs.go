@@ -30,6 +30,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
@@ -38,7 +39,7 @@ import (
 
 func dataSourceTencentCloudClsMachineGroupConfigs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClsMachineGroupConfigsRead,
+		ReadContext: dataSourceTencentCloudClsMachineGroupConfigsRead,
 		Schema: map[string]*schema.Schema{
 			"group_id": {
 				Required:    true,
@@ -259,13 +260,13 @@ func dataSourceTencentCloudClsMachineGroupConfigs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClsMachineGroupConfigsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClsMachineGroupConfigsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cls_machine_group_configs.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("group_id"); ok {
@@ -276,7 +277,7 @@ func dataSourceTencentCloudClsMachineGroupConfigsRead(d *schema.ResourceData, me
 
 	var configs []*cls.ConfigInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeClsMachineGroupConfigsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -285,7 +286,7 @@ func dataSourceTencentCloudClsMachineGroupConfigsRead(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(configs))
@@ -469,7 +470,7 @@ func dataSourceTencentCloudClsMachineGroupConfigsRead(d *schema.ResourceData, me
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -30,6 +30,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
@@ -38,7 +39,7 @@ import (
 
 func dataSourceTencentCloudClsMachines() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClsMachinesRead,
+		ReadContext: dataSourceTencentCloudClsMachinesRead,
 		Schema: map[string]*schema.Schema{
 			"group_id": {
 				Required:    true,
@@ -105,13 +106,13 @@ func dataSourceTencentCloudClsMachines() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClsMachinesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClsMachinesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cls_machines.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("group_id"); ok {
@@ -122,7 +123,7 @@ func dataSourceTencentCloudClsMachinesRead(d *schema.ResourceData, meta interfac
 
 	var machines []*cls.MachineInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeClsMachinesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -131,7 +132,7 @@ func dataSourceTencentCloudClsMachinesRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(machines))
@@ -184,7 +185,7 @@ func dataSourceTencentCloudClsMachinesRead(d *schema.ResourceData, meta interfac
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
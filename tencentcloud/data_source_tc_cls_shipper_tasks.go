@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudClsShipperTasks() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudClsShipperTasksRead,
+		ReadContext: dataSourceTencentCloudClsShipperTasksRead,
 		Schema: map[string]*schema.Schema{
 			"shipper_id": {
 				Required:    true,
@@ -108,13 +109,13 @@ func dataSourceTencentCloudClsShipperTasks() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudClsShipperTasksRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudClsShipperTasksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cls_shipper_tasks.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("shipper_id"); ok {
@@ -133,7 +134,7 @@ func dataSourceTencentCloudClsShipperTasksRead(d *schema.ResourceData, meta inte
 
 	var tasks []*cls.ShipperTaskInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeClsShipperTasksByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -142,7 +143,7 @@ func dataSourceTencentCloudClsShipperTasksRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(tasks))
@@ -199,7 +200,7 @@ func dataSourceTencentCloudClsShipperTasksRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -16,8 +16,10 @@ data "tencentcloud_container_cluster_instances" "foo_instance" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
@@ -29,7 +31,7 @@ import (
 func dataSourceTencentCloudContainerClusterInstances() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This data source has been deprecated in Terraform TencentCloud provider version 1.16.0. Please use `tencentcloud_kubernetes_clusters` instead.",
-		Read:               dataSourceTencentCloudContainerClusterInstancesRead,
+		ReadContext:        dataSourceTencentCloudContainerClusterInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -95,7 +97,7 @@ func dataSourceTencentCloudContainerClusterInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudContainerClusterInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudContainerClusterInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_container_cluster_instances.read")()
 
 	logId := getLogId(contextNil)
@@ -110,8 +112,8 @@ func dataSourceTencentCloudContainerClusterInstancesRead(d *schema.ResourceData,
 	}
 
 	var response *tke.DescribeClusterInstancesResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTkeClient().DescribeClusterInstances(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTkeClient().DescribeClusterInstancesWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -122,7 +124,7 @@ func dataSourceTencentCloudContainerClusterInstancesRead(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s DescribeClusterInstances failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	nodes := make([]map[string]interface{}, 0, *response.Response.TotalCount)
@@ -146,8 +148,8 @@ func dataSourceTencentCloudContainerClusterInstancesRead(d *schema.ResourceData,
 		describeInstancesreq := cvm.NewDescribeInstancesRequest()
 		describeInstancesreq.InstanceIds = []*string{node.InstanceId}
 		var describeInstancesResponse *cvm.DescribeInstancesResponse
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().DescribeInstances(describeInstancesreq)
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().DescribeInstancesWithContext(ctx, describeInstancesreq)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, describeInstancesreq.GetAction(), describeInstancesreq.ToJsonString(), e.Error())
@@ -158,7 +160,7 @@ func dataSourceTencentCloudContainerClusterInstancesRead(d *schema.ResourceData,
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s DescribeInstances failed, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 		if len(describeInstancesResponse.Response.InstanceSet) > 0 {
@@ -15,8 +15,10 @@ data "tencentcloud_container_clusters" "foo" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
@@ -28,7 +30,7 @@ import (
 func dataSourceTencentCloudContainerClusters() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This data source has been deprecated in Terraform TencentCloud provider version 1.16.0. Please use `tencentcloud_kubernetes_clusters` instead.",
-		Read:               dataSourceTencentCloudContainerClustersRead,
+		ReadContext:        dataSourceTencentCloudContainerClustersRead,
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -119,7 +121,7 @@ func dataSourceTencentCloudContainerClusters() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudContainerClustersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudContainerClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_container_clusters.read")()
 
 	logId := getLogId(contextNil)
@@ -134,8 +136,8 @@ func dataSourceTencentCloudContainerClustersRead(d *schema.ResourceData, meta in
 	}
 
 	var response *tke.DescribeClustersResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTkeClient().DescribeClusters(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTkeClient().DescribeClustersWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -146,7 +148,7 @@ func dataSourceTencentCloudContainerClustersRead(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s DescribeClusters failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, *response.Response.TotalCount)
@@ -168,8 +170,8 @@ func dataSourceTencentCloudContainerClustersRead(d *schema.ResourceData, meta in
 		describeClusterInstancesreq.ClusterId = cluster.ClusterId
 		describeClusterInstancesreq.Limit = common.Int64Ptr(100)
 		var describeClusterInstancesResponse *tke.DescribeClusterInstancesResponse
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTkeClient().DescribeClusterInstances(describeClusterInstancesreq)
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTkeClient().DescribeClusterInstancesWithContext(ctx, describeClusterInstancesreq)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, describeClusterInstancesreq.GetAction(), describeClusterInstancesreq.ToJsonString(), e.Error())
@@ -191,8 +193,8 @@ func dataSourceTencentCloudContainerClustersRead(d *schema.ResourceData, meta in
 			describeInstancesreq := cvm.NewDescribeInstancesRequest()
 			describeInstancesreq.InstanceIds = instanceIds
 			var describeInstancesResponse *cvm.DescribeInstancesResponse
-			err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().DescribeInstances(describeInstancesreq)
+			err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().DescribeInstancesWithContext(ctx, describeInstancesreq)
 				if e != nil {
 					log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 						logId, describeInstancesreq.GetAction(), describeInstancesreq.ToJsonString(), e.Error())
@@ -203,7 +205,7 @@ func dataSourceTencentCloudContainerClustersRead(d *schema.ResourceData, meta in
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s DescribeInstances failed, reason:%s\n ", logId, err.Error())
-				return err
+				return diag.FromErr(err)
 			}
 
 			for _, v := range describeInstancesResponse.Response.InstanceSet {
@@ -215,8 +217,8 @@ func dataSourceTencentCloudContainerClustersRead(d *schema.ResourceData, meta in
 		describeClusterSecurityreq := tke.NewDescribeClusterSecurityRequest()
 		describeClusterSecurityreq.ClusterId = cluster.ClusterId
 		var securityResponse *tke.DescribeClusterSecurityResponse
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTkeClient().DescribeClusterSecurity(describeClusterSecurityreq)
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTkeClient().DescribeClusterSecurityWithContext(ctx, describeClusterSecurityreq)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, describeClusterSecurityreq.GetAction(), describeClusterSecurityreq.ToJsonString(), e.Error())
@@ -227,7 +229,7 @@ func dataSourceTencentCloudContainerClustersRead(d *schema.ResourceData, meta in
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s DescribeClusterSecurity failed, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 		clusterInfo["security_certification_authority"] = *securityResponse.Response.CertificationAuthority
@@ -17,6 +17,7 @@ import (
 	"encoding/json"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	"github.com/tencentyun/cos-go-sdk-v5"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudCosBatchs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCosBatchsRead,
+		ReadContext: dataSourceTencentCloudCosBatchsRead,
 
 		Schema: map[string]*schema.Schema{
 			"uin": {
@@ -119,11 +120,11 @@ func dataSourceTencentCloudCosBatchs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCosBatchsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCosBatchsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cos_batchs.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	uin := d.Get("uin").(string)
 	appid := d.Get("appid").(int)
 	jobs := make([]map[string]interface{}, 0)
@@ -142,7 +143,7 @@ func dataSourceTencentCloudCosBatchsRead(d *schema.ResourceData, meta interface{
 		responseBody, _ := json.Marshal(response.Body)
 		log.Printf("[DEBUG]%s api[ListJobs] success, request body [%s], response body [%v]\n", logId, req, responseBody)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		for _, item := range result.Jobs.Members {
 			jobItem := make(map[string]interface{})
@@ -174,7 +175,7 @@ func dataSourceTencentCloudCosBatchsRead(d *schema.ResourceData, meta interface{
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), jobs); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
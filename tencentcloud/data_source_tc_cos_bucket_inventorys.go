@@ -17,13 +17,14 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudCosBucketInventorys() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCosBucketInventorysRead,
+		ReadContext: dataSourceTencentCloudCosBucketInventorysRead,
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -171,11 +172,11 @@ func dataSourceTencentCloudCosBucketInventorys() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCosBucketInventorysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCosBucketInventorysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cos_bucket_inventorys.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	bucket := d.Get("bucket").(string)
 	inventoryConfigurations := make([]map[string]interface{}, 0)
 	token := ""
@@ -185,7 +186,7 @@ func dataSourceTencentCloudCosBucketInventorysRead(d *schema.ResourceData, meta
 		responseBody, _ := json.Marshal(response.Body)
 		log.Printf("[DEBUG]%s api[ListInventoryConfigurations] success, response body [%s]\n", logId, responseBody)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		for _, item := range result.InventoryConfigurations {
@@ -255,7 +256,7 @@ func dataSourceTencentCloudCosBucketInventorysRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), inventoryConfigurations); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
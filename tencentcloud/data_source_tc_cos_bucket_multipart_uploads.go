@@ -16,6 +16,7 @@ import (
 	"encoding/json"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	"github.com/tencentyun/cos-go-sdk-v5"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudCosBucketMultipartUploads() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCosBucketMultipartUploadsRead,
+		ReadContext: dataSourceTencentCloudCosBucketMultipartUploadsRead,
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -122,11 +123,11 @@ func dataSourceTencentCloudCosBucketMultipartUploads() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCosBucketMultipartUploadsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCosBucketMultipartUploadsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cos_bucket_multipart_uploads.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	bucket := d.Get("bucket").(string)
 	multipartUploads := make([]map[string]interface{}, 0)
 	opt := &cos.ListMultipartUploadsOptions{}
@@ -144,7 +145,7 @@ func dataSourceTencentCloudCosBucketMultipartUploadsRead(d *schema.ResourceData,
 		result, response, err := meta.(*TencentCloudClient).apiV3Conn.UseTencentCosClient(bucket).Bucket.ListMultipartUploads(ctx, opt)
 		responseBody, _ := json.Marshal(response.Body)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		log.Printf("[DEBUG]%s api[ListMultipartUploads] success, response body [%s]\n", logId, responseBody)
 		for _, item := range result.Uploads {
@@ -183,7 +184,7 @@ func dataSourceTencentCloudCosBucketMultipartUploadsRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), multipartUploads); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
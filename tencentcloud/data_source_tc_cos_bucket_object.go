@@ -19,13 +19,14 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudCosBucketObject() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCosBucketObjectsRead,
+		ReadContext: dataSourceTencentCloudCosBucketObjectsRead,
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -82,11 +83,11 @@ func dataSourceTencentCloudCosBucketObject() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCosBucketObjectsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCosBucketObjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cos_bucket_object.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
@@ -98,7 +99,7 @@ func dataSourceTencentCloudCosBucketObjectsRead(d *schema.ResourceData, meta int
 	}
 	info, err := cosService.HeadObject(ctx, bucket, key)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := []string{bucket, key}
@@ -126,7 +127,7 @@ func dataSourceTencentCloudCosBucketObjectsRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err = writeToFile(output.(string), outputMap); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
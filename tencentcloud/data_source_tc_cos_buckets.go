@@ -19,13 +19,14 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudCosBuckets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCosBucketsRead,
+		ReadContext: dataSourceTencentCloudCosBucketsRead,
 
 		Schema: map[string]*schema.Schema{
 			"bucket_prefix": {
@@ -333,17 +334,17 @@ func dataSourceTencentCloudCosBuckets() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCosBucketsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCosBucketsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cos_buckets.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	cosService := CosService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	buckets, err := cosService.ListBuckets(ctx)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	prefix := d.Get("bucket_prefix").(string)
@@ -359,7 +360,7 @@ func dataSourceTencentCloudCosBucketsRead(d *schema.ResourceData, meta interface
 
 		respTags, err := cosService.GetBucketTags(ctx, *v.Name)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		var matchTags bool
@@ -379,25 +380,25 @@ func dataSourceTencentCloudCosBucketsRead(d *schema.ResourceData, meta interface
 
 		corsRules, err := cosService.GetBucketCors(ctx, *v.Name)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		bucket["cors_rules"] = corsRules
 
 		lifecycleRules, err := cosService.GetDataSourceBucketLifecycle(ctx, *v.Name)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		bucket["lifecycle_rules"] = lifecycleRules
 
 		website, err := cosService.GetBucketWebsite(ctx, *v.Name)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		bucket["website"] = website
 
 		originRules, err := cosService.GetBucketPullOrigin(ctx, *v.Name)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		bucket["origin_pull_rules"] = originRules
 
@@ -409,7 +410,7 @@ func dataSourceTencentCloudCosBucketsRead(d *schema.ResourceData, meta interface
 		aclBody, err := cosService.GetBucketACL(ctx, *v.Name)
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		aclXML, err := xml.Marshal(aclBody)
@@ -431,13 +432,13 @@ func dataSourceTencentCloudCosBucketsRead(d *schema.ResourceData, meta interface
 	ids[1] = prefix
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if err := d.Set("bucket_list", bucketList); err != nil {
-		return fmt.Errorf("setting bucket list error: %s", err.Error())
+		return diag.Errorf("setting bucket list error: %s", err.Error())
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err = writeToFile(output.(string), bucketList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	css "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/live/v20180801"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudCssDomains() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCssDomainsRead,
+		ReadContext: dataSourceTencentCloudCssDomainsRead,
 		Schema: map[string]*schema.Schema{
 			"domain_status": {
 				Optional:    true,
@@ -135,13 +136,13 @@ func dataSourceTencentCloudCssDomains() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCssDomainsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCssDomainsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_css_domains.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOkExists("domain_status"); ok {
@@ -168,7 +169,7 @@ func dataSourceTencentCloudCssDomainsRead(d *schema.ResourceData, meta interface
 
 	var domainList []*css.DomainInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCssDomainsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -177,7 +178,7 @@ func dataSourceTencentCloudCssDomainsRead(d *schema.ResourceData, meta interface
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(domainList))
@@ -246,7 +247,7 @@ func dataSourceTencentCloudCssDomainsRead(d *schema.ResourceData, meta interface
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
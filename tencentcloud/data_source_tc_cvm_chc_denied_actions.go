@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudCvmChcDeniedActions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCvmChcDeniedActionsRead,
+		ReadContext: dataSourceTencentCloudCvmChcDeniedActionsRead,
 		Schema: map[string]*schema.Schema{
 			"chc_ids": {
 				Required: true,
@@ -70,13 +71,13 @@ func dataSourceTencentCloudCvmChcDeniedActions() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCvmChcDeniedActionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCvmChcDeniedActionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cvm_chc_denied_actions.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("chc_ids"); ok {
@@ -88,7 +89,7 @@ func dataSourceTencentCloudCvmChcDeniedActionsRead(d *schema.ResourceData, meta
 
 	var chcHostDeniedActionSet []*cvm.ChcHostDeniedActions
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCvmChcDeniedActionsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -97,7 +98,7 @@ func dataSourceTencentCloudCvmChcDeniedActionsRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(chcHostDeniedActionSet))
@@ -130,7 +131,7 @@ func dataSourceTencentCloudCvmChcDeniedActionsRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -18,6 +18,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudCvmChcHosts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCvmChcHostsRead,
+		ReadContext: dataSourceTencentCloudCvmChcHostsRead,
 		Schema: map[string]*schema.Schema{
 			"chc_ids": {
 				Optional: true,
@@ -294,13 +295,13 @@ func dataSourceTencentCloudCvmChcHosts() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCvmChcHostsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCvmChcHostsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cvm_chc_hosts.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("chc_ids"); ok {
@@ -332,7 +333,7 @@ func dataSourceTencentCloudCvmChcHostsRead(d *schema.ResourceData, meta interfac
 
 	var chcHostSet []*cvm.ChcHost
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCvmChcHostsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -341,7 +342,7 @@ func dataSourceTencentCloudCvmChcHostsRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(chcHostSet))
@@ -512,7 +513,7 @@ func dataSourceTencentCloudCvmChcHostsRead(d *schema.ResourceData, meta interfac
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
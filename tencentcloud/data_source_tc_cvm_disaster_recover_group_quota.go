@@ -11,8 +11,9 @@ data "tencentcloud_cvm_disaster_recover_group_quota" "disaster_recover_group_quo
 package tencentcloud
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudCvmDisasterRecoverGroupQuota() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCvmDisasterRecoverGroupQuotaRead,
+		ReadContext: dataSourceTencentCloudCvmDisasterRecoverGroupQuotaRead,
 		Schema: map[string]*schema.Schema{
 			"group_quota": {
 				Computed:    true,
@@ -63,16 +64,18 @@ func dataSourceTencentCloudCvmDisasterRecoverGroupQuota() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCvmDisasterRecoverGroupQuotaRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCvmDisasterRecoverGroupQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cvm_disaster_recover_group_quota.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var response *cvm.DescribeDisasterRecoverGroupQuotaResponse
 
 	request := cvm.NewDescribeDisasterRecoverGroupQuotaRequest()
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().DescribeDisasterRecoverGroupQuota(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().DescribeDisasterRecoverGroupQuotaWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -80,11 +83,11 @@ func dataSourceTencentCloudCvmDisasterRecoverGroupQuotaRead(d *schema.ResourceDa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if response == nil || response.Response == nil {
 		d.SetId("")
-		return fmt.Errorf("Response is nil")
+		return diag.Errorf("Response is nil")
 	}
 	_ = d.Set("group_quota", response.Response.GroupQuota)
 	_ = d.Set("current_num", response.Response.CurrentNum)
@@ -102,7 +105,7 @@ func dataSourceTencentCloudCvmDisasterRecoverGroupQuotaRead(d *schema.ResourceDa
 			"cvm_in_sw_group_quota":   response.Response.CvmInSwGroupQuota,
 			"cvm_in_rack_group_quota": response.Response.CvmInRackGroupQuota,
 		}); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
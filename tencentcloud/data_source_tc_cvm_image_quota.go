@@ -13,6 +13,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudCvmImageQuota() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCvmImageQuotaRead,
+		ReadContext: dataSourceTencentCloudCvmImageQuotaRead,
 		Schema: map[string]*schema.Schema{
 			"image_num_quota": {
 				Computed:    true,
@@ -37,19 +38,19 @@ func dataSourceTencentCloudCvmImageQuota() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCvmImageQuotaRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCvmImageQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cvm_image_quota.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
 	var imageNumQuota int64
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	service := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCvmImageQuotaByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -58,7 +59,7 @@ func dataSourceTencentCloudCvmImageQuotaRead(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("image_num_quota", imageNumQuota)
@@ -69,7 +70,7 @@ func dataSourceTencentCloudCvmImageQuotaRead(d *schema.ResourceData, meta interf
 		if e := writeToFile(output.(string), map[string]interface{}{
 			"image_num_quota": imageNumQuota,
 		}); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
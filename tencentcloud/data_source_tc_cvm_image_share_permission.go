@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudCvmImageSharePermission() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCvmImageSharePermissionRead,
+		ReadContext: dataSourceTencentCloudCvmImageSharePermissionRead,
 		Schema: map[string]*schema.Schema{
 			"image_id": {
 				Required:    true,
@@ -59,13 +60,13 @@ func dataSourceTencentCloudCvmImageSharePermission() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCvmImageSharePermissionRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCvmImageSharePermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cvm_image_share_permission.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("image_id"); ok {
@@ -76,7 +77,7 @@ func dataSourceTencentCloudCvmImageSharePermissionRead(d *schema.ResourceData, m
 
 	var sharePermissionSet []*cvm.SharePermission
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCvmImageSharePermissionByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -85,7 +86,7 @@ func dataSourceTencentCloudCvmImageSharePermissionRead(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(sharePermissionSet))
@@ -114,7 +115,7 @@ func dataSourceTencentCloudCvmImageSharePermissionRead(d *schema.ResourceData, m
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
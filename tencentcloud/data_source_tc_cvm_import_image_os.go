@@ -11,8 +11,9 @@ data "tencentcloud_cvm_import_image_os" "import_image_os" {
 package tencentcloud
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudCvmImportImageOs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCvmImportImageOsRead,
+		ReadContext: dataSourceTencentCloudCvmImportImageOsRead,
 		Schema: map[string]*schema.Schema{
 			"import_image_os_list_supported": {
 				Computed:    true,
@@ -89,7 +90,7 @@ func dataSourceTencentCloudCvmImportImageOs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCvmImportImageOsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCvmImportImageOsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cvm_import_image_os.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -97,8 +98,8 @@ func dataSourceTencentCloudCvmImportImageOsRead(d *schema.ResourceData, meta int
 		request  = cvm.NewDescribeImportImageOsRequest()
 		response = cvm.NewDescribeImportImageOsResponse()
 	)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		resule, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().DescribeImportImageOs(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		resule, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().DescribeImportImageOsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -106,12 +107,12 @@ func dataSourceTencentCloudCvmImportImageOsRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if response == nil || response.Response == nil {
 		d.SetId("")
-		return fmt.Errorf("Response is null")
+		return diag.Errorf("Response is null")
 	}
 	imageOsList := response.Response.ImportImageOsListSupported
 	importImageOsVersionSet := response.Response.ImportImageOsVersionSet
@@ -165,7 +166,7 @@ func dataSourceTencentCloudCvmImportImageOsRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), result); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -12,8 +12,9 @@ data "tencentcloud_cvm_instance_vnc_url" "instance_vnc_url" {
 package tencentcloud
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudCvmInstanceVncUrl() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCvmInstanceVncUrlRead,
+		ReadContext: dataSourceTencentCloudCvmInstanceVncUrlRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -45,7 +46,7 @@ func dataSourceTencentCloudCvmInstanceVncUrl() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCvmInstanceVncUrlRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCvmInstanceVncUrlRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cvm_instance_vnc_url.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -53,9 +54,9 @@ func dataSourceTencentCloudCvmInstanceVncUrlRead(d *schema.ResourceData, meta in
 	request := cvm.NewDescribeInstanceVncUrlRequest()
 	instanceId := d.Get("instance_id").(string)
 	request.InstanceId = helper.String(instanceId)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().DescribeInstanceVncUrl(request)
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().DescribeInstanceVncUrlWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -63,12 +64,12 @@ func dataSourceTencentCloudCvmInstanceVncUrlRead(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if response == nil || response.Response == nil {
 		d.SetId("")
-		return fmt.Errorf("Response is nil")
+		return diag.Errorf("Response is nil")
 
 	}
 	d.SetId(instanceId)
@@ -79,7 +80,7 @@ func dataSourceTencentCloudCvmInstanceVncUrlRead(d *schema.ResourceData, meta in
 		if e := writeToFile(output.(string), map[string]interface{}{
 			"instance_vnc_url": *response.Response.InstanceVncUrl,
 		}); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
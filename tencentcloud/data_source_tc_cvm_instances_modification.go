@@ -12,8 +12,10 @@ data "tencentcloud_cvm_instances_modification" "foo" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -22,7 +24,7 @@ import (
 
 func dataSourceTencentCloudCvmInstancesModification() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCvmInstancesModificationRead,
+		ReadContext: dataSourceTencentCloudCvmInstancesModificationRead,
 
 		Schema: map[string]*schema.Schema{
 			"instance_ids": {
@@ -129,7 +131,7 @@ func dataSourceTencentCloudCvmInstancesModification() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCvmInstancesModificationRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCvmInstancesModificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cvm_instances_modification.read")()
 	logId := getLogId(contextNil)
 
@@ -157,15 +159,15 @@ func dataSourceTencentCloudCvmInstancesModificationRead(d *schema.ResourceData,
 	instanceTypeConfigStatusList := make([]map[string]interface{}, 0)
 
 	var innerErr error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		response, innerErr = meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().DescribeInstancesModification(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		response, innerErr = meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().DescribeInstancesModificationWithContext(ctx, request)
 		if innerErr != nil {
 			return retryError(innerErr)
 		}
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0)
@@ -195,13 +197,13 @@ func dataSourceTencentCloudCvmInstancesModificationRead(d *schema.ResourceData,
 	err = d.Set("instance_type_config_status_list", instanceTypeConfigStatusList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set instance list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), instanceTypeConfigStatusList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -18,6 +18,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudCynosdbAccountAllGrantPrivileges() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbAccountAllGrantPrivilegesRead,
+		ReadContext: dataSourceTencentCloudCynosdbAccountAllGrantPrivilegesRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -121,17 +122,17 @@ func dataSourceTencentCloudCynosdbAccountAllGrantPrivileges() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbAccountAllGrantPrivilegesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbAccountAllGrantPrivilegesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_account_all_grant_privileges.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId                     = getLogId(contextNil)
-		ctx                       = context.WithValue(context.TODO(), logIdKey, logId)
 		service                   = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		accountAllGrantPrivileges *cynosdb.DescribeAccountAllGrantPrivilegesResponseParams
 		clusterId                 string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cluster_id"); ok {
@@ -150,7 +151,7 @@ func dataSourceTencentCloudCynosdbAccountAllGrantPrivilegesRead(d *schema.Resour
 		paramMap["Account"] = &inputAccount
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCynosdbAccountAllGrantPrivilegesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -161,7 +162,7 @@ func dataSourceTencentCloudCynosdbAccountAllGrantPrivilegesRead(d *schema.Resour
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if accountAllGrantPrivileges.PrivilegeStatements != nil {
@@ -218,7 +219,7 @@ func dataSourceTencentCloudCynosdbAccountAllGrantPrivilegesRead(d *schema.Resour
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudCynosdbAccounts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbAccountsRead,
+		ReadContext: dataSourceTencentCloudCynosdbAccountsRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -98,12 +99,12 @@ func dataSourceTencentCloudCynosdbAccounts() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbAccountsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_accounts.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var clusterId string
 
@@ -126,7 +127,7 @@ func dataSourceTencentCloudCynosdbAccountsRead(d *schema.ResourceData, meta inte
 
 	var accountSet []*cynosdb.Account
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeCynosdbAccountsByFilter(ctx, clusterId, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -135,7 +136,7 @@ func dataSourceTencentCloudCynosdbAccountsRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(accountSet))
@@ -157,7 +158,7 @@ func dataSourceTencentCloudCynosdbAccountsRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
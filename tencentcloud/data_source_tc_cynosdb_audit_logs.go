@@ -25,6 +25,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -33,7 +34,7 @@ import (
 
 func dataSourceTencentCloudCynosdbAuditLogs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbAuditLogsRead,
+		ReadContext: dataSourceTencentCloudCynosdbAuditLogsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -231,17 +232,17 @@ func dataSourceTencentCloudCynosdbAuditLogs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbAuditLogsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbAuditLogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_audit_logs.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		items      []*cynosdb.AuditLog
 		instanceId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -317,7 +318,7 @@ func dataSourceTencentCloudCynosdbAuditLogsRead(d *schema.ResourceData, meta int
 		paramMap["filter"] = &auditLogFilter
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCynosdbAuditLogsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -328,7 +329,7 @@ func dataSourceTencentCloudCynosdbAuditLogsRead(d *schema.ResourceData, meta int
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(items))
@@ -403,7 +404,7 @@ func dataSourceTencentCloudCynosdbAuditLogsRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
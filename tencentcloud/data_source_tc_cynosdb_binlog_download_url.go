@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudCynosdbBinlogDownloadUrl() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbBinlogDownloadUrlRead,
+		ReadContext: dataSourceTencentCloudCynosdbBinlogDownloadUrlRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -49,17 +50,17 @@ func dataSourceTencentCloudCynosdbBinlogDownloadUrl() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbBinlogDownloadUrlRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbBinlogDownloadUrlRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_binlog_download_url.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId       = getLogId(contextNil)
-		ctx         = context.WithValue(context.TODO(), logIdKey, logId)
 		service     = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		downloadUrl *cynosdb.DescribeBinlogDownloadUrlResponseParams
 		clusterId   string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cluster_id"); ok {
@@ -71,7 +72,7 @@ func dataSourceTencentCloudCynosdbBinlogDownloadUrlRead(d *schema.ResourceData,
 		paramMap["BinlogId"] = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCynosdbBinlogDownloadUrlByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -82,7 +83,7 @@ func dataSourceTencentCloudCynosdbBinlogDownloadUrlRead(d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if downloadUrl.DownloadUrl != nil {
@@ -93,7 +94,7 @@ func dataSourceTencentCloudCynosdbBinlogDownloadUrlRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
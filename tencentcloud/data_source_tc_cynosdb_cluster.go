@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudCynosdbCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbClusterRead,
+		ReadContext: dataSourceTencentCloudCynosdbClusterRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -76,17 +77,17 @@ func dataSourceTencentCloudCynosdbCluster() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbClusterRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_cluster.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		tables    []*cynosdb.DatabaseTables
 		clusterId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cluster_id"); ok {
@@ -106,7 +107,7 @@ func dataSourceTencentCloudCynosdbClusterRead(d *schema.ResourceData, meta inter
 		paramMap["TableType"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCynosdbClusterByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -117,7 +118,7 @@ func dataSourceTencentCloudCynosdbClusterRead(d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(tables))
@@ -144,7 +145,7 @@ func dataSourceTencentCloudCynosdbClusterRead(d *schema.ResourceData, meta inter
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
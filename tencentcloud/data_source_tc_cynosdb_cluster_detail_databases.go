@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudCynosdbClusterDetailDatabases() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbClusterDetailDatabasesRead,
+		ReadContext: dataSourceTencentCloudCynosdbClusterDetailDatabasesRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -132,17 +133,17 @@ func dataSourceTencentCloudCynosdbClusterDetailDatabases() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbClusterDetailDatabasesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbClusterDetailDatabasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_cluster_detail_databases.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		dbInfos   []*cynosdb.DbInfo
 		clusterId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cluster_id"); ok {
@@ -154,7 +155,7 @@ func dataSourceTencentCloudCynosdbClusterDetailDatabasesRead(d *schema.ResourceD
 		paramMap["DbName"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCynosdbClusterDetailDatabasesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -165,7 +166,7 @@ func dataSourceTencentCloudCynosdbClusterDetailDatabasesRead(d *schema.ResourceD
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(dbInfos))
@@ -251,7 +252,7 @@ func dataSourceTencentCloudCynosdbClusterDetailDatabasesRead(d *schema.ResourceD
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
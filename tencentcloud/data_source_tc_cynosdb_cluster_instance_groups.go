@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudCynosdbClusterInstanceGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbClusterInstanceGroupsRead,
+		ReadContext: dataSourceTencentCloudCynosdbClusterInstanceGroupsRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -406,13 +407,13 @@ func dataSourceTencentCloudCynosdbClusterInstanceGroups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbClusterInstanceGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbClusterInstanceGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_cluster_instance_groups.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var clusterId string
 	if v, ok := d.GetOk("cluster_id"); ok {
@@ -423,7 +424,7 @@ func dataSourceTencentCloudCynosdbClusterInstanceGroupsRead(d *schema.ResourceDa
 
 	var instanceGrpInfoList []*cynosdb.CynosdbInstanceGrp
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeClusterInstanceGrps(ctx, clusterId)
 		if e != nil {
 			return retryError(e)
@@ -432,7 +433,7 @@ func dataSourceTencentCloudCynosdbClusterInstanceGroupsRead(d *schema.ResourceDa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(instanceGrpInfoList))
@@ -540,7 +541,7 @@ func dataSourceTencentCloudCynosdbClusterInstanceGroupsRead(d *schema.ResourceDa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
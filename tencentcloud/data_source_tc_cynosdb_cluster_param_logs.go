@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudCynosdbClusterParamLogs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbClusterParamLogsRead,
+		ReadContext: dataSourceTencentCloudCynosdbClusterParamLogsRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -108,17 +109,17 @@ func dataSourceTencentCloudCynosdbClusterParamLogs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbClusterParamLogsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbClusterParamLogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_cluster_param_logs.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId            = getLogId(contextNil)
-		ctx              = context.WithValue(context.TODO(), logIdKey, logId)
 		service          = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		clusterParamLogs []*cynosdb.ClusterParamModifyLog
 		clusterId        string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cluster_id"); ok {
@@ -139,7 +140,7 @@ func dataSourceTencentCloudCynosdbClusterParamLogsRead(d *schema.ResourceData, m
 		paramMap["OrderByType"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCynosdbClusterParamLogsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -150,7 +151,7 @@ func dataSourceTencentCloudCynosdbClusterParamLogsRead(d *schema.ResourceData, m
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(clusterParamLogs))
@@ -201,7 +202,7 @@ func dataSourceTencentCloudCynosdbClusterParamLogsRead(d *schema.ResourceData, m
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
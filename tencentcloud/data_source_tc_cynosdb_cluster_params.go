@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudCynosdbClusterParams() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbClusterParamsRead,
+		ReadContext: dataSourceTencentCloudCynosdbClusterParamsRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -129,13 +130,13 @@ func dataSourceTencentCloudCynosdbClusterParams() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbClusterParamsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbClusterParamsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_cluster_params.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var clusterId string
 	paramMap := make(map[string]interface{})
@@ -151,7 +152,7 @@ func dataSourceTencentCloudCynosdbClusterParamsRead(d *schema.ResourceData, meta
 
 	var items []*cynosdb.ParamInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeClusterParamsByFilter(ctx, clusterId, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -160,7 +161,7 @@ func dataSourceTencentCloudCynosdbClusterParamsRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(items))
@@ -195,7 +196,7 @@ func dataSourceTencentCloudCynosdbClusterParamsRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -27,7 +28,7 @@ import (
 
 func dataSourceTencentCloudCynosdbClusters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbClustersRead,
+		ReadContext: dataSourceTencentCloudCynosdbClustersRead,
 
 		Schema: map[string]*schema.Schema{
 			"db_type": {
@@ -139,11 +140,11 @@ func dataSourceTencentCloudCynosdbClusters() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbClustersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_clusters.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params := make(map[string]string)
 	if v, ok := d.GetOk("cluster_id"); ok {
@@ -166,7 +167,7 @@ func dataSourceTencentCloudCynosdbClustersRead(d *schema.ResourceData, meta inte
 
 	var clusters []*cynosdb.CynosdbCluster
 	var err error
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		clusters, err = cynosdbService.DescribeClusters(ctx, params)
 		if err != nil {
 			return retryError(err)
@@ -175,7 +176,7 @@ func dataSourceTencentCloudCynosdbClustersRead(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read cynosdb clusters failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(clusters))
@@ -211,7 +212,7 @@ func dataSourceTencentCloudCynosdbClustersRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err = writeToFile(output.(string), clusterList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -20,6 +20,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -28,7 +29,7 @@ import (
 
 func dataSourceTencentCloudCynosdbDescribeInstanceErrorLogs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbDescribeInstanceErrorLogsRead,
+		ReadContext: dataSourceTencentCloudCynosdbDescribeInstanceErrorLogsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -100,17 +101,17 @@ func dataSourceTencentCloudCynosdbDescribeInstanceErrorLogs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbDescribeInstanceErrorLogsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbDescribeInstanceErrorLogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_describe_instance_error_logs.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		errorLogs  []*cynosdb.CynosdbErrorLogItem
 		instanceId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -144,7 +145,7 @@ func dataSourceTencentCloudCynosdbDescribeInstanceErrorLogsRead(d *schema.Resour
 		paramMap["KeyWords"] = helper.InterfacesStringsPoint(keyWordsSet)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCynosdbDescribeInstanceErrorLogsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -155,7 +156,7 @@ func dataSourceTencentCloudCynosdbDescribeInstanceErrorLogsRead(d *schema.Resour
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(errorLogs))
@@ -186,7 +187,7 @@ func dataSourceTencentCloudCynosdbDescribeInstanceErrorLogsRead(d *schema.Resour
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
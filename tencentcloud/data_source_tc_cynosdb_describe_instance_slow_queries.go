@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudCynosdbDescribeInstanceSlowQueries() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbDescribeInstanceSlowQueriesRead,
+		ReadContext: dataSourceTencentCloudCynosdbDescribeInstanceSlowQueriesRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -84,17 +85,17 @@ func dataSourceTencentCloudCynosdbDescribeInstanceSlowQueries() *schema.Resource
 	}
 }
 
-func dataSourceTencentCloudCynosdbDescribeInstanceSlowQueriesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbDescribeInstanceSlowQueriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_describe_instance_slow_queries.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		binlogs   []*cynosdb.BinlogItem
 		clusterId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cluster_id"); ok {
@@ -110,7 +111,7 @@ func dataSourceTencentCloudCynosdbDescribeInstanceSlowQueriesRead(d *schema.Reso
 		paramMap["EndTime"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCynosdbDescribeInstanceSlowQueriesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -121,7 +122,7 @@ func dataSourceTencentCloudCynosdbDescribeInstanceSlowQueriesRead(d *schema.Reso
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(binlogs))
@@ -160,7 +161,7 @@ func dataSourceTencentCloudCynosdbDescribeInstanceSlowQueriesRead(d *schema.Reso
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
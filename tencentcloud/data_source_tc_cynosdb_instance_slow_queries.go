@@ -57,6 +57,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -65,7 +66,7 @@ import (
 
 func dataSourceTencentCloudCynosdbInstanceSlowQueries() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbInstanceSlowQueriesRead,
+		ReadContext: dataSourceTencentCloudCynosdbInstanceSlowQueriesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -189,13 +190,13 @@ func dataSourceTencentCloudCynosdbInstanceSlowQueries() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbInstanceSlowQueriesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbInstanceSlowQueriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_instance_slow_queries.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -234,7 +235,7 @@ func dataSourceTencentCloudCynosdbInstanceSlowQueriesRead(d *schema.ResourceData
 
 	var slowQueries []*cynosdb.SlowQueriesItem
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCynosdbInstanceSlowQueriesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -243,7 +244,7 @@ func dataSourceTencentCloudCynosdbInstanceSlowQueriesRead(d *schema.ResourceData
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(slowQueries))
@@ -308,7 +309,7 @@ func dataSourceTencentCloudCynosdbInstanceSlowQueriesRead(d *schema.ResourceData
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudCynosdbInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbInstancesRead,
+		ReadContext: dataSourceTencentCloudCynosdbInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"db_type": {
@@ -117,11 +118,11 @@ func dataSourceTencentCloudCynosdbInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_instances.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params := make(map[string]string)
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -142,7 +143,7 @@ func dataSourceTencentCloudCynosdbInstancesRead(d *schema.ResourceData, meta int
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		instances, e := cynosdbService.DescribeInstances(ctx, params)
 		if e != nil {
 			return retryError(e)
@@ -187,7 +188,7 @@ func dataSourceTencentCloudCynosdbInstancesRead(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read cynosdb instances failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
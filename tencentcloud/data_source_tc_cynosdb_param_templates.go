@@ -14,6 +14,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudCynosdbParamTemplates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbParamTemplatesRead,
+		ReadContext: dataSourceTencentCloudCynosdbParamTemplatesRead,
 		Schema: map[string]*schema.Schema{
 			"engine_versions": {
 				Optional: true,
@@ -214,13 +215,13 @@ func dataSourceTencentCloudCynosdbParamTemplates() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbParamTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbParamTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_param_templates.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("engine_versions"); ok {
@@ -282,7 +283,7 @@ func dataSourceTencentCloudCynosdbParamTemplatesRead(d *schema.ResourceData, met
 
 	var items []*cynosdb.ParamTemplateListInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCynosdbParamTemplatesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -291,7 +292,7 @@ func dataSourceTencentCloudCynosdbParamTemplatesRead(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(items))
@@ -336,7 +337,7 @@ func dataSourceTencentCloudCynosdbParamTemplatesRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
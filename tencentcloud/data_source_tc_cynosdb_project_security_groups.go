@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudCynosdbProjectSecurityGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbProjectSecurityGroupsRead,
+		ReadContext: dataSourceTencentCloudCynosdbProjectSecurityGroupsRead,
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Optional:    true,
@@ -176,16 +177,16 @@ func dataSourceTencentCloudCynosdbProjectSecurityGroups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbProjectSecurityGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbProjectSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_project_security_groups.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		groups  []*cynosdb.SecurityGroup
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, _ := d.GetOk("project_id"); v != nil {
@@ -196,7 +197,7 @@ func dataSourceTencentCloudCynosdbProjectSecurityGroupsRead(d *schema.ResourceDa
 		paramMap["SearchKey"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCynosdbProjectSecurityGroupsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -207,7 +208,7 @@ func dataSourceTencentCloudCynosdbProjectSecurityGroupsRead(d *schema.ResourceDa
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(groups))
@@ -334,7 +335,7 @@ func dataSourceTencentCloudCynosdbProjectSecurityGroupsRead(d *schema.ResourceDa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
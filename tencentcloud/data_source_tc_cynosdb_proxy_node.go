@@ -21,6 +21,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -29,7 +30,7 @@ import (
 
 func dataSourceTencentCloudCynosdbProxyNode() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbProxyNodeRead,
+		ReadContext: dataSourceTencentCloudCynosdbProxyNodeRead,
 		Schema: map[string]*schema.Schema{
 			"order_by": {
 				Optional:    true,
@@ -145,16 +146,16 @@ func dataSourceTencentCloudCynosdbProxyNode() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbProxyNodeRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbProxyNodeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_proxy_node.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId          = getLogId(contextNil)
-		ctx            = context.WithValue(context.TODO(), logIdKey, logId)
 		service        = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		proxyNodeInfos []*cynosdb.ProxyNodeInfo
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("order_by"); ok {
@@ -195,7 +196,7 @@ func dataSourceTencentCloudCynosdbProxyNodeRead(d *schema.ResourceData, meta int
 		paramMap["filters"] = tmpSet
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCynosdbProxyNodeByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -206,7 +207,7 @@ func dataSourceTencentCloudCynosdbProxyNodeRead(d *schema.ResourceData, meta int
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(proxyNodeInfos))
@@ -267,7 +268,7 @@ func dataSourceTencentCloudCynosdbProxyNodeRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -16,6 +16,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudCynosdbProxyVersion() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbProxyVersionRead,
+		ReadContext: dataSourceTencentCloudCynosdbProxyVersionRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -56,17 +57,17 @@ func dataSourceTencentCloudCynosdbProxyVersion() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbProxyVersionRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbProxyVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_proxy_version.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId        = getLogId(contextNil)
-		ctx          = context.WithValue(context.TODO(), logIdKey, logId)
 		service      = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		proxyVersion *cynosdb.DescribeSupportProxyVersionResponseParams
 		clusterId    string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cluster_id"); ok {
@@ -78,7 +79,7 @@ func dataSourceTencentCloudCynosdbProxyVersionRead(d *schema.ResourceData, meta
 		paramMap["ProxyGroupId"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCynosdbProxyVersionByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -94,7 +95,7 @@ func dataSourceTencentCloudCynosdbProxyVersionRead(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if proxyVersion.SupportProxyVersions != nil {
@@ -109,7 +110,7 @@ func dataSourceTencentCloudCynosdbProxyVersionRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -20,6 +20,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -28,7 +29,7 @@ import (
 
 func dataSourceTencentCloudCynosdbResourcePackageList() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbResourcePackageListRead,
+		ReadContext: dataSourceTencentCloudCynosdbResourcePackageListRead,
 		Schema: map[string]*schema.Schema{
 			"package_id": {
 				Optional:    true,
@@ -168,16 +169,16 @@ func dataSourceTencentCloudCynosdbResourcePackageList() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbResourcePackageListRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbResourcePackageListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_resource_package_list.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		detailList []*cynosdb.Package
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("package_id"); ok {
@@ -214,7 +215,7 @@ func dataSourceTencentCloudCynosdbResourcePackageListRead(d *schema.ResourceData
 		paramMap["OrderDirection"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCynosdbResourcePackageListByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -225,7 +226,7 @@ func dataSourceTencentCloudCynosdbResourcePackageListRead(d *schema.ResourceData
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(detailList))
@@ -309,7 +310,7 @@ func dataSourceTencentCloudCynosdbResourcePackageListRead(d *schema.ResourceData
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudCynosdbResourcePackageSaleSpecs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbResourcePackageSaleSpecsRead,
+		ReadContext: dataSourceTencentCloudCynosdbResourcePackageSaleSpecsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_type": {
 				Required:    true,
@@ -89,19 +90,19 @@ func dataSourceTencentCloudCynosdbResourcePackageSaleSpecs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbResourcePackageSaleSpecsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbResourcePackageSaleSpecsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_resource_package_sale_specs.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		service       = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		detail        []*cynosdb.SalePackageSpec
 		instanceType  string
 		packageRegion string
 		packageType   string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_type"); ok {
@@ -119,7 +120,7 @@ func dataSourceTencentCloudCynosdbResourcePackageSaleSpecsRead(d *schema.Resourc
 		packageType = v.(string)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCynosdbResourcePackageSaleSpecsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -130,7 +131,7 @@ func dataSourceTencentCloudCynosdbResourcePackageSaleSpecsRead(d *schema.Resourc
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(detail))
@@ -175,7 +176,7 @@ func dataSourceTencentCloudCynosdbResourcePackageSaleSpecsRead(d *schema.Resourc
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
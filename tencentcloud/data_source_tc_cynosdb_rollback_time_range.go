@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudCynosdbRollbackTimeRange() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbRollbackTimeRangeRead,
+		ReadContext: dataSourceTencentCloudCynosdbRollbackTimeRangeRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -67,17 +68,17 @@ func dataSourceTencentCloudCynosdbRollbackTimeRange() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbRollbackTimeRangeRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbRollbackTimeRangeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_rollback_time_range.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		service           = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		rollbackTimeRange *cynosdb.DescribeRollbackTimeRangeResponseParams
 		clusterId         string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cluster_id"); ok {
@@ -85,7 +86,7 @@ func dataSourceTencentCloudCynosdbRollbackTimeRangeRead(d *schema.ResourceData,
 		clusterId = v.(string)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCynosdbRollbackTimeRangeByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -96,7 +97,7 @@ func dataSourceTencentCloudCynosdbRollbackTimeRangeRead(d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if rollbackTimeRange.TimeRangeStart != nil {
@@ -130,7 +131,7 @@ func dataSourceTencentCloudCynosdbRollbackTimeRangeRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudCynosdbZone() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudCynosdbZoneRead,
+		ReadContext: dataSourceTencentCloudCynosdbZoneRead,
 		Schema: map[string]*schema.Schema{
 			"include_virtual_zones": {
 				Optional:    true,
@@ -144,12 +145,12 @@ func dataSourceTencentCloudCynosdbZone() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudCynosdbZoneRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudCynosdbZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_zone.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, _ := d.GetOk("include_virtual_zones"); v != nil {
@@ -163,7 +164,7 @@ func dataSourceTencentCloudCynosdbZoneRead(d *schema.ResourceData, meta interfac
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var regionSet []*cynosdb.SaleRegion
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCynosdbZoneByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -172,7 +173,7 @@ func dataSourceTencentCloudCynosdbZoneRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(regionSet))
@@ -270,7 +271,7 @@ func dataSourceTencentCloudCynosdbZoneRead(d *schema.ResourceData, meta interfac
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -12,15 +12,15 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTencentCynosdbZoneConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCynosdbZoneConfigRead,
+		ReadContext: dataSourceTencentCynosdbZoneConfigRead,
 		Schema: map[string]*schema.Schema{
 			"result_output_file": {
 				Type:        schema.TypeString,
@@ -91,18 +91,18 @@ func dataSourceTencentCynosdbZoneConfig() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCynosdbZoneConfigRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCynosdbZoneConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cynosdb_zone_config.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	region := meta.(*TencentCloudClient).apiV3Conn.Region
 
 	instanceSpecSet, err := service.DescribeRedisZoneConfig(ctx)
 	if err != nil {
-		return fmt.Errorf("api[DescribeRedisZoneConfig]fail, return %s", err.Error())
+		return diag.Errorf("api[DescribeRedisZoneConfig]fail, return %s", err.Error())
 	}
 
 	result := make([]map[string]interface{}, 0)
@@ -136,7 +136,7 @@ func dataSourceTencentCynosdbZoneConfigRead(d *schema.ResourceData, meta interfa
 		if err := writeToFile(output.(string), result); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
 				logId, output.(string), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
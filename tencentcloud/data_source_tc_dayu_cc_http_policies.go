@@ -22,6 +22,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dayu "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dayu/v20180709"
@@ -30,7 +31,7 @@ import (
 
 func dataSourceTencentCloudDayuCCHttpPolicies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDayuCCHttpPoliciesRead,
+		ReadContext: dataSourceTencentCloudDayuCCHttpPoliciesRead,
 		Schema: map[string]*schema.Schema{
 			"resource_type": {
 				Type:         schema.TypeString,
@@ -147,11 +148,11 @@ func dataSourceTencentCloudDayuCCHttpPolicies() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDayuCCHttpPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDayuCCHttpPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dayu_cc_http_policies.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DayuService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -163,7 +164,7 @@ func dataSourceTencentCloudDayuCCHttpPoliciesRead(d *schema.ResourceData, meta i
 	name := d.Get("name").(string)
 
 	ccPolicies := make([]*dayu.CCPolicy, 0)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, _, err := service.DescribeCCSelfdefinePolicies(ctx, resourceType, resourceId, name, policyId)
 		if err != nil {
 			return retryError(err)
@@ -173,7 +174,7 @@ func dataSourceTencentCloudDayuCCHttpPoliciesRead(d *schema.ResourceData, meta i
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	list := make([]map[string]interface{}, 0, len(ccPolicies))
@@ -201,11 +202,11 @@ func dataSourceTencentCloudDayuCCHttpPoliciesRead(d *schema.ResourceData, meta i
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("list", list); e != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 
@@ -22,6 +22,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dayu "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dayu/v20180709"
@@ -30,7 +31,7 @@ import (
 
 func dataSourceTencentCloudDayuCCHttpsPolicies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDayuCCHttpsPoliciesRead,
+		ReadContext: dataSourceTencentCloudDayuCCHttpsPoliciesRead,
 		Schema: map[string]*schema.Schema{
 			"resource_type": {
 				Type:         schema.TypeString,
@@ -147,11 +148,11 @@ func dataSourceTencentCloudDayuCCHttpsPolicies() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDayuCCHttpsPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDayuCCHttpsPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dayu_cc_https_policies.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DayuService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -163,7 +164,7 @@ func dataSourceTencentCloudDayuCCHttpsPoliciesRead(d *schema.ResourceData, meta
 	name := d.Get("name").(string)
 
 	ccPolicies := make([]*dayu.CCPolicy, 0)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, _, err := service.DescribeCCSelfdefinePolicies(ctx, resourceType, resourceId, name, policyId)
 		if err != nil {
 			return retryError(err)
@@ -172,7 +173,7 @@ func dataSourceTencentCloudDayuCCHttpsPoliciesRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	list := make([]map[string]interface{}, 0, len(ccPolicies))
@@ -197,11 +198,11 @@ func dataSourceTencentCloudDayuCCHttpsPoliciesRead(d *schema.ResourceData, meta
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("list", list); e != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 
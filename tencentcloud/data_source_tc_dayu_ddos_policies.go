@@ -16,6 +16,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dayu "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dayu/v20180709"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudDayuDdosPolicies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDayuDdosPoliciesRead,
+		ReadContext: dataSourceTencentCloudDayuDdosPoliciesRead,
 		Schema: map[string]*schema.Schema{
 			"resource_type": {
 				Type:         schema.TypeString,
@@ -379,11 +380,11 @@ func dataSourceTencentCloudDayuDdosPolicies() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDayuDdosPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDayuDdosPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dayu_ddos_policies.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DayuService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -393,7 +394,7 @@ func dataSourceTencentCloudDayuDdosPoliciesRead(d *schema.ResourceData, meta int
 	policyId := d.Get("policy_id").(string)
 
 	policies := make([]*dayu.DDosPolicy, 0)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, err := service.DescribeDdosPolicies(ctx, resourceType, policyId)
 		if err != nil {
 			return retryError(err)
@@ -403,7 +404,7 @@ func dataSourceTencentCloudDayuDdosPoliciesRead(d *schema.ResourceData, meta int
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	list := make([]map[string]interface{}, 0, len(policies))
@@ -427,11 +428,11 @@ func dataSourceTencentCloudDayuDdosPoliciesRead(d *schema.ResourceData, meta int
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("list", list); e != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 
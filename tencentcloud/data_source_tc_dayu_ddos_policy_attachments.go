@@ -23,6 +23,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -30,7 +31,7 @@ import (
 
 func dataSourceTencentCloudDayuDdosPolicyAttachments() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDayuDdosPolicyAttachmentsRead,
+		ReadContext: dataSourceTencentCloudDayuDdosPolicyAttachmentsRead,
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -82,11 +83,11 @@ func dataSourceTencentCloudDayuDdosPolicyAttachments() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDayuDdosPolicyAttachmentsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDayuDdosPolicyAttachmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dayu_ddos_policy_attachments.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Get("resource_id").(string)
 	resourceType := d.Get("resource_type").(string)
@@ -97,7 +98,7 @@ func dataSourceTencentCloudDayuDdosPolicyAttachmentsRead(d *schema.ResourceData,
 	}
 	attachments, _, err := dayuService.DescribeDdosPolicyAttachments(ctx, resourceId, resourceType, policyId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			attachments, _, err = dayuService.DescribeDdosPolicyAttachments(ctx, resourceId, resourceType, policyId)
 			if err != nil {
 				return retryError(err, "ClientError.NetworkError")
@@ -107,7 +108,7 @@ func dataSourceTencentCloudDayuDdosPolicyAttachmentsRead(d *schema.ResourceData,
 	}
 	if err != nil {
 		log.Printf("[CRITAL]%s read DDoS Policy attachment failed, reason:%s\n", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ddosPolicyAttachmentList := make([]map[string]interface{}, 0, len(attachments))
@@ -120,13 +121,13 @@ func dataSourceTencentCloudDayuDdosPolicyAttachmentsRead(d *schema.ResourceData,
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("dayu_ddos_policy_attachment_list", ddosPolicyAttachmentList); e != nil {
 		log.Printf("[CRITAL]%s provider set DDoS policy attachment list fail, reason:%s\n", logId, e)
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), ddosPolicyAttachmentList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
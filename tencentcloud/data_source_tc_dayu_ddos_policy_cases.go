@@ -17,6 +17,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dayu "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dayu/v20180709"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudDayuDdosPolicyCases() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDayuDdosPolicyCasesRead,
+		ReadContext: dataSourceTencentCloudDayuDdosPolicyCasesRead,
 		Schema: map[string]*schema.Schema{
 			"resource_type": {
 				Type:         schema.TypeString,
@@ -188,11 +189,11 @@ func dataSourceTencentCloudDayuDdosPolicyCases() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDayuDdosPolicyCasesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDayuDdosPolicyCasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dayu_ddos_policy_cases.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DayuService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -203,7 +204,7 @@ func dataSourceTencentCloudDayuDdosPolicyCasesRead(d *schema.ResourceData, meta
 
 	var ddosPolicyCase dayu.KeyValueRecord
 	has := false
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, flag, err := service.DescribeDdosPolicyCase(ctx, resourceType, sceneId)
 		if err != nil {
 			return retryError(err)
@@ -214,7 +215,7 @@ func dataSourceTencentCloudDayuDdosPolicyCasesRead(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var list []map[string]interface{}
@@ -304,11 +305,11 @@ func dataSourceTencentCloudDayuDdosPolicyCasesRead(d *schema.ResourceData, meta
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("list", list); e != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 
@@ -14,13 +14,14 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudDayuEip() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDayuEipRead,
+		ReadContext: dataSourceTencentCloudDayuEipRead,
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
 				Type:        schema.TypeString,
@@ -124,11 +125,11 @@ func dataSourceTencentCloudDayuEip() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDayuEipRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDayuEipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dayu_l4_rules.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Get("resource_id").(string)
 	var bindStatus []string
@@ -146,7 +147,7 @@ func dataSourceTencentCloudDayuEipRead(d *schema.ResourceData, meta interface{})
 	antiddosService := AntiddosService{client: meta.(*TencentCloudClient).apiV3Conn}
 	result, err := antiddosService.DescribeListBGPIPInstances(ctx, resourceId, bindStatus, offset, limit)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	resultList := make([]map[string]interface{}, 0)
 	for _, eipItem := range result {
@@ -177,7 +178,7 @@ func dataSourceTencentCloudDayuEipRead(d *schema.ResourceData, meta interface{})
 	_ = d.Set("list", resultList)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), resultList)
+		return diag.FromErr(writeToFile(output.(string), resultList))
 	}
 	return nil
 
@@ -22,6 +22,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dayu "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dayu/v20180709"
@@ -30,7 +31,7 @@ import (
 
 func dataSourceTencentCloudDayuL4Rules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDayuL4RulesRead,
+		ReadContext: dataSourceTencentCloudDayuL4RulesRead,
 		Schema: map[string]*schema.Schema{
 			"resource_type": {
 				Type:         schema.TypeString,
@@ -160,11 +161,11 @@ func dataSourceTencentCloudDayuL4Rules() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDayuL4RulesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDayuL4RulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dayu_l4_rules.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DayuService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -177,7 +178,7 @@ func dataSourceTencentCloudDayuL4RulesRead(d *schema.ResourceData, meta interfac
 
 	rules := make([]*dayu.L4RuleEntry, 0)
 	healths := make([]*dayu.L4RuleHealth, 0)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, hResult, _, err := service.DescribeL4Rules(ctx, resourceType, resourceId, name, ruleId)
 		if err != nil {
 			return retryError(err)
@@ -188,7 +189,7 @@ func dataSourceTencentCloudDayuL4RulesRead(d *schema.ResourceData, meta interfac
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	list := make([]map[string]interface{}, 0, len(rules))
@@ -229,11 +230,11 @@ func dataSourceTencentCloudDayuL4RulesRead(d *schema.ResourceData, meta interfac
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("list", list); e != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 
@@ -15,6 +15,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dayu "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dayu/v20180709"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudDayuL4RulesV2() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDayuL4RulesReadV2,
+		ReadContext: dataSourceTencentCloudDayuL4RulesReadV2,
 		Schema: map[string]*schema.Schema{
 			"business": {
 				Type:         schema.TypeString,
@@ -148,10 +149,10 @@ func dataSourceTencentCloudDayuL4RulesV2() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDayuL4RulesReadV2(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDayuL4RulesReadV2(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dayu_l4_rules_v2.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DayuService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -167,7 +168,7 @@ func dataSourceTencentCloudDayuL4RulesReadV2(d *schema.ResourceData, meta interf
 	}
 
 	rules := make([]*dayu.NewL4RuleEntry, 0)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, err := service.DescribeNewL4Rules(ctx, business, extendParams)
 
 		if err != nil {
@@ -178,7 +179,7 @@ func dataSourceTencentCloudDayuL4RulesReadV2(d *schema.ResourceData, meta interf
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	list := make([]map[string]interface{}, 0)
 	for _, rule := range rules {
@@ -214,11 +215,11 @@ func dataSourceTencentCloudDayuL4RulesReadV2(d *schema.ResourceData, meta interf
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("list", list); e != nil {
 		log.Printf("[CRITAL]%s provider set rules fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 
@@ -22,6 +22,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dayu "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dayu/v20180709"
@@ -30,7 +31,7 @@ import (
 
 func dataSourceTencentCloudDayuL7Rules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDayuL7RulesRead,
+		ReadContext: dataSourceTencentCloudDayuL7RulesRead,
 		Schema: map[string]*schema.Schema{
 			"resource_type": {
 				Type:         schema.TypeString,
@@ -160,11 +161,11 @@ func dataSourceTencentCloudDayuL7Rules() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDayuL7RulesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDayuL7RulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dayu_l7_rules.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DayuService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -178,7 +179,7 @@ func dataSourceTencentCloudDayuL7RulesRead(d *schema.ResourceData, meta interfac
 
 	rules := make([]*dayu.L7RuleEntry, 0)
 	healths := make([]*dayu.L7RuleHealth, 0)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, hResult, _, err := service.DescribeL7Rules(ctx, resourceType, resourceId, domain, ruleId, protocol)
 		if err != nil {
 			return retryError(err)
@@ -188,7 +189,7 @@ func dataSourceTencentCloudDayuL7RulesRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	list := make([]map[string]interface{}, 0, len(rules))
@@ -249,11 +250,11 @@ func dataSourceTencentCloudDayuL7RulesRead(d *schema.ResourceData, meta interfac
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("list", list); e != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 
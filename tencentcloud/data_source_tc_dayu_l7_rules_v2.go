@@ -17,13 +17,14 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudDayuL7RulesV2() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDayuL7RulesReadV2,
+		ReadContext: dataSourceTencentCloudDayuL7RulesReadV2,
 		Schema: map[string]*schema.Schema{
 			"business": {
 				Type:         schema.TypeString,
@@ -197,10 +198,10 @@ func dataSourceTencentCloudDayuL7RulesV2() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDayuL7RulesReadV2(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDayuL7RulesReadV2(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dayu_l4_rules_v2.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DayuService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -218,7 +219,7 @@ func dataSourceTencentCloudDayuL7RulesReadV2(d *schema.ResourceData, meta interf
 
 	rules, _, err := service.DescribeL7RulesV2(ctx, business, extendParams)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	resultList := make([]map[string]interface{}, 0)
@@ -260,11 +261,11 @@ func dataSourceTencentCloudDayuL7RulesReadV2(d *schema.ResourceData, meta interf
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("list", resultList); e != nil {
 		log.Printf("[CRITAL]%s provider set rules fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), resultList)
+		return diag.FromErr(writeToFile(output.(string), resultList))
 	}
 	return nil
 }
@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudDbbrainDbSpaceStatus() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainDbSpaceStatusRead,
+		ReadContext: dataSourceTencentCloudDbbrainDbSpaceStatusRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -77,7 +78,7 @@ func dataSourceTencentCloudDbbrainDbSpaceStatus() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainDbSpaceStatusRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainDbSpaceStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_db_space_status.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -85,7 +86,7 @@ func dataSourceTencentCloudDbbrainDbSpaceStatusRead(d *schema.ResourceData, meta
 
 	var instanceId string
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -105,7 +106,7 @@ func dataSourceTencentCloudDbbrainDbSpaceStatusRead(d *schema.ResourceData, meta
 
 	var rows *dbbrain.DescribeDBSpaceStatusResponseParams
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDbbrainDbSpaceStatusByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -114,7 +115,7 @@ func dataSourceTencentCloudDbbrainDbSpaceStatusRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := []map[string]interface{}{}
@@ -149,7 +150,7 @@ func dataSourceTencentCloudDbbrainDbSpaceStatusRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
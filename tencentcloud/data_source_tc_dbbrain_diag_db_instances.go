@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudDbbrainDiagDbInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainDiagDbInstancesRead,
+		ReadContext: dataSourceTencentCloudDbbrainDiagDbInstancesRead,
 		Schema: map[string]*schema.Schema{
 			"is_supported": {
 				Required:    true,
@@ -267,13 +268,13 @@ func dataSourceTencentCloudDbbrainDiagDbInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainDiagDbInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainDiagDbInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_diag_db_instances.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, _ := d.GetOk("is_supported"); v != nil {
@@ -306,7 +307,7 @@ func dataSourceTencentCloudDbbrainDiagDbInstancesRead(d *schema.ResourceData, me
 		dbScanStatus *int64
 		e            error
 	)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		infos, dbScanStatus, e = service.DescribeDbbrainDiagDbInstancesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -314,7 +315,7 @@ func dataSourceTencentCloudDbbrainDiagDbInstancesRead(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(infos))
@@ -477,7 +478,7 @@ func dataSourceTencentCloudDbbrainDiagDbInstancesRead(d *schema.ResourceData, me
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -23,6 +23,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -31,7 +32,7 @@ import (
 
 func dataSourceTencentCloudDbbrainDiagEvent() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainDiagEventRead,
+		ReadContext: dataSourceTencentCloudDbbrainDiagEventRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -121,13 +122,13 @@ func dataSourceTencentCloudDbbrainDiagEvent() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainDiagEventRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainDiagEventRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_diag_event.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var id string
 
 	paramMap := make(map[string]interface{})
@@ -147,7 +148,7 @@ func dataSourceTencentCloudDbbrainDiagEventRead(d *schema.ResourceData, meta int
 	var result *dbbrain.DescribeDBDiagEventResponseParams
 	service := DbbrainService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		var e error
 		result, e = service.DescribeDbbrainDiagEventByFilter(ctx, paramMap)
 		if e != nil {
@@ -156,7 +157,7 @@ func dataSourceTencentCloudDbbrainDiagEventRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if result != nil {
@@ -210,7 +211,7 @@ func dataSourceTencentCloudDbbrainDiagEventRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), result); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
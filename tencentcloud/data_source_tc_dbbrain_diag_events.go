@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudDbbrainDiagEvents() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainDiagEventsRead,
+		ReadContext: dataSourceTencentCloudDbbrainDiagEventsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_ids": {
 				Optional: true,
@@ -126,13 +127,13 @@ func dataSourceTencentCloudDbbrainDiagEvents() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainDiagEventsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainDiagEventsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_diag_events.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_ids"); ok {
@@ -162,7 +163,7 @@ func dataSourceTencentCloudDbbrainDiagEventsRead(d *schema.ResourceData, meta in
 
 	var items []*dbbrain.DiagHistoryEventItem
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDbbrainDiagEventsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -171,7 +172,7 @@ func dataSourceTencentCloudDbbrainDiagEventsRead(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(items))
@@ -231,7 +232,7 @@ func dataSourceTencentCloudDbbrainDiagEventsRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudDbbrainDiagHistory() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainDiagHistoryRead,
+		ReadContext: dataSourceTencentCloudDbbrainDiagHistoryRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -120,13 +121,13 @@ func dataSourceTencentCloudDbbrainDiagHistory() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainDiagHistoryRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainDiagHistoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_diag_history.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -149,7 +150,7 @@ func dataSourceTencentCloudDbbrainDiagHistoryRead(d *schema.ResourceData, meta i
 
 	var events []*dbbrain.DiagHistoryEventItem
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDbbrainDiagHistoryByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -158,7 +159,7 @@ func dataSourceTencentCloudDbbrainDiagHistoryRead(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(events))
@@ -219,7 +220,7 @@ func dataSourceTencentCloudDbbrainDiagHistoryRead(d *schema.ResourceData, meta i
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudDbbrainHealthScores() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainHealthScoresRead,
+		ReadContext: dataSourceTencentCloudDbbrainHealthScoresRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -151,13 +152,13 @@ func dataSourceTencentCloudDbbrainHealthScores() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainHealthScoresRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainHealthScoresRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_health_scores.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 	var instanceId string
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -177,7 +178,7 @@ func dataSourceTencentCloudDbbrainHealthScoresRead(d *schema.ResourceData, meta
 
 	var data *dbbrain.HealthScoreInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDbbrainHealthScoresByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -186,7 +187,7 @@ func dataSourceTencentCloudDbbrainHealthScoresRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	healthScoreInfoMap := map[string]interface{}{}
@@ -276,7 +277,7 @@ func dataSourceTencentCloudDbbrainHealthScoresRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), healthScoreInfoMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,8 +14,8 @@ package tencentcloud
 
 import (
 	"context"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -24,7 +24,7 @@ import (
 
 func dataSourceTencentCloudDbbrainMysqlProcessList() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainMysqlProcessListRead,
+		ReadContext: dataSourceTencentCloudDbbrainMysqlProcessListRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -145,13 +145,13 @@ func dataSourceTencentCloudDbbrainMysqlProcessList() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainMysqlProcessListRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainMysqlProcessListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_mysql_process_list.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var (
 		instanceId string
 	)
@@ -202,7 +202,7 @@ func dataSourceTencentCloudDbbrainMysqlProcessListRead(d *schema.ResourceData, m
 
 	var processList []*dbbrain.MySqlProcess
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDbbrainMysqlProcessListByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -211,7 +211,7 @@ func dataSourceTencentCloudDbbrainMysqlProcessListRead(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(processList))
@@ -253,7 +253,7 @@ func dataSourceTencentCloudDbbrainMysqlProcessListRead(d *schema.ResourceData, m
 				mySqlProcessMap["info"] = mySqlProcess.Info
 			}
 
-			ids = append(ids, strings.Join([]string{instanceId, *mySqlProcess.ID}, FILED_SP))
+			ids = append(ids, helper.IdFormat(instanceId, *mySqlProcess.ID))
 			tmpList = append(tmpList, mySqlProcessMap)
 		}
 
@@ -264,7 +264,7 @@ func dataSourceTencentCloudDbbrainMysqlProcessListRead(d *schema.ResourceData, m
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -15,8 +15,8 @@ package tencentcloud
 
 import (
 	"context"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -25,7 +25,7 @@ import (
 
 func dataSourceTencentCloudDbbrainNoPrimaryKeyTables() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainNoPrimaryKeyTablesRead,
+		ReadContext: dataSourceTencentCloudDbbrainNoPrimaryKeyTablesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -101,13 +101,13 @@ func dataSourceTencentCloudDbbrainNoPrimaryKeyTables() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainNoPrimaryKeyTablesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainNoPrimaryKeyTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_no_primary_key_tables.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var (
 		instanceId string
 	)
@@ -133,7 +133,7 @@ func dataSourceTencentCloudDbbrainNoPrimaryKeyTablesRead(d *schema.ResourceData,
 		resp   *dbbrain.DescribeNoPrimaryKeyTablesResponseParams
 		e      error
 	)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		tables, resp, e = service.DescribeDbbrainNoPrimaryKeyTablesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -141,7 +141,7 @@ func dataSourceTencentCloudDbbrainNoPrimaryKeyTablesRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(tables))
@@ -181,7 +181,7 @@ func dataSourceTencentCloudDbbrainNoPrimaryKeyTablesRead(d *schema.ResourceData,
 				tableMap["total_length"] = table.TotalLength
 			}
 
-			ids = append(ids, strings.Join([]string{instanceId, *table.TableSchema, *table.TableName}, FILED_SP))
+			ids = append(ids, helper.IdFormat(instanceId, *table.TableSchema, *table.TableName))
 			tmpList = append(tmpList, tableMap)
 		}
 
@@ -192,7 +192,7 @@ func dataSourceTencentCloudDbbrainNoPrimaryKeyTablesRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -17,8 +17,8 @@ package tencentcloud
 
 import (
 	"context"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -27,7 +27,7 @@ import (
 
 func dataSourceTencentCloudDbbrainRedisTopBigKeys() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainRedisTopBigKeysRead,
+		ReadContext: dataSourceTencentCloudDbbrainRedisTopBigKeysRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -113,13 +113,13 @@ func dataSourceTencentCloudDbbrainRedisTopBigKeys() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainRedisTopBigKeysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainRedisTopBigKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_redis_top_big_keys.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var (
 		instanceId string
 	)
@@ -150,7 +150,7 @@ func dataSourceTencentCloudDbbrainRedisTopBigKeysRead(d *schema.ResourceData, me
 
 	var topKeys []*dbbrain.RedisKeySpaceData
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDbbrainRedisTopBigKeysByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -159,7 +159,7 @@ func dataSourceTencentCloudDbbrainRedisTopBigKeysRead(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(topKeys))
@@ -197,7 +197,7 @@ func dataSourceTencentCloudDbbrainRedisTopBigKeysRead(d *schema.ResourceData, me
 				redisKeySpaceDataMap["max_element_size"] = redisKeySpaceData.MaxElementSize
 			}
 
-			ids = append(ids, strings.Join([]string{instanceId, *redisKeySpaceData.Key}, FILED_SP))
+			ids = append(ids, helper.IdFormat(instanceId, *redisKeySpaceData.Key))
 			tmpList = append(tmpList, redisKeySpaceDataMap)
 		}
 
@@ -208,7 +208,7 @@ func dataSourceTencentCloudDbbrainRedisTopBigKeysRead(d *schema.ResourceData, me
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
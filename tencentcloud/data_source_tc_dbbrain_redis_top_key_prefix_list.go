@@ -15,8 +15,8 @@ package tencentcloud
 
 import (
 	"context"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -25,7 +25,7 @@ import (
 
 func dataSourceTencentCloudDbbrainRedisTopKeyPrefixList() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainRedisTopKeyPrefixListRead,
+		ReadContext: dataSourceTencentCloudDbbrainRedisTopKeyPrefixListRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -94,13 +94,13 @@ func dataSourceTencentCloudDbbrainRedisTopKeyPrefixList() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainRedisTopKeyPrefixListRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainRedisTopKeyPrefixListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_redis_top_key_prefix_list.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var (
 		instanceId string
 	)
@@ -123,7 +123,7 @@ func dataSourceTencentCloudDbbrainRedisTopKeyPrefixListRead(d *schema.ResourceDa
 
 	var items []*dbbrain.RedisPreKeySpaceData
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDbbrainRedisTopKeyPrefixListByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -132,7 +132,7 @@ func dataSourceTencentCloudDbbrainRedisTopKeyPrefixListRead(d *schema.ResourceDa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(items))
@@ -166,7 +166,7 @@ func dataSourceTencentCloudDbbrainRedisTopKeyPrefixListRead(d *schema.ResourceDa
 				redisPreKeySpaceDataMap["max_element_size"] = redisPreKeySpaceData.MaxElementSize
 			}
 
-			ids = append(ids, strings.Join([]string{instanceId, *redisPreKeySpaceData.KeyPreIndex}, FILED_SP))
+			ids = append(ids, helper.IdFormat(instanceId, *redisPreKeySpaceData.KeyPreIndex))
 			tmpList = append(tmpList, redisPreKeySpaceDataMap)
 		}
 
@@ -177,7 +177,7 @@ func dataSourceTencentCloudDbbrainRedisTopKeyPrefixListRead(d *schema.ResourceDa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
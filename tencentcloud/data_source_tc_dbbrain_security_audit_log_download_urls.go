@@ -23,8 +23,8 @@ package tencentcloud
 
 import (
 	"context"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -32,7 +32,7 @@ import (
 
 func dataSourceTencentCloudDbbrainSecurityAuditLogDownloadUrls() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainSecurityAuditLogDownloadUrlsRead,
+		ReadContext: dataSourceTencentCloudDbbrainSecurityAuditLogDownloadUrlsRead,
 		Schema: map[string]*schema.Schema{
 			"sec_audit_group_id": {
 				Required:    true,
@@ -70,7 +70,7 @@ func dataSourceTencentCloudDbbrainSecurityAuditLogDownloadUrls() *schema.Resourc
 	}
 }
 
-func dataSourceTencentCloudDbbrainSecurityAuditLogDownloadUrlsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainSecurityAuditLogDownloadUrlsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_security_audit_log_download_urls.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -78,7 +78,7 @@ func dataSourceTencentCloudDbbrainSecurityAuditLogDownloadUrlsRead(d *schema.Res
 
 	var sagId string
 	var asyncReqId int
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("sec_audit_group_id"); ok {
@@ -98,7 +98,7 @@ func dataSourceTencentCloudDbbrainSecurityAuditLogDownloadUrlsRead(d *schema.Res
 	service := DbbrainService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var urls []*string
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		var e error
 		urls, e = service.DescribeDbbrainSecurityAuditLogDownloadUrlsByFilter(ctx, paramMap)
 		if e != nil {
@@ -107,18 +107,18 @@ func dataSourceTencentCloudDbbrainSecurityAuditLogDownloadUrlsRead(d *schema.Res
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if urls != nil {
 		_ = d.Set("urls", urls)
 	}
 
-	d.SetId(strings.Join([]string{sagId, helper.Int64ToStr(int64(asyncReqId))}, FILED_SP))
+	d.SetId(helper.IdFormat(sagId, helper.Int64ToStr(int64(asyncReqId))))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), urls); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
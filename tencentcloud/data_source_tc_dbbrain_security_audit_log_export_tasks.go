@@ -25,6 +25,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -33,7 +34,7 @@ import (
 
 func dataSourceTencentCloudDbbrainSecurityAuditLogExportTasks() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainSecurityAuditLogExportTasksRead,
+		ReadContext: dataSourceTencentCloudDbbrainSecurityAuditLogExportTasksRead,
 		Schema: map[string]*schema.Schema{
 			"sec_audit_group_id": {
 				Type:        schema.TypeString,
@@ -128,12 +129,12 @@ func dataSourceTencentCloudDbbrainSecurityAuditLogExportTasks() *schema.Resource
 	}
 }
 
-func dataSourceTencentCloudDbbrainSecurityAuditLogExportTasksRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainSecurityAuditLogExportTasksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_security_audit_log_export_tasks.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var sag_id string
 
 	paramMap := make(map[string]interface{})
@@ -159,7 +160,7 @@ func dataSourceTencentCloudDbbrainSecurityAuditLogExportTasksRead(d *schema.Reso
 	dbbrainService := DbbrainService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var tasks []*dbbrain.SecLogExportTaskInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := dbbrainService.DescribeDbbrainSecurityAuditLogExportTasksByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -169,7 +170,7 @@ func dataSourceTencentCloudDbbrainSecurityAuditLogExportTasksRead(d *schema.Reso
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Dbbrain tasks failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(tasks))
@@ -209,7 +210,7 @@ func dataSourceTencentCloudDbbrainSecurityAuditLogExportTasksRead(d *schema.Reso
 			if task.DangerLevels != nil {
 				taskMap["danger_levels"] = task.DangerLevels
 			}
-			ids = append(ids, sag_id+FILED_SP+helper.UInt64ToStr(*task.AsyncRequestId))
+			ids = append(ids, helper.IdFormat(sag_id, helper.UInt64ToStr(*task.AsyncRequestId)))
 			taskList = append(taskList, taskMap)
 		}
 		d.SetId(helper.DataResourceIdsHash(ids))
@@ -219,7 +220,7 @@ func dataSourceTencentCloudDbbrainSecurityAuditLogExportTasksRead(d *schema.Reso
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), taskList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
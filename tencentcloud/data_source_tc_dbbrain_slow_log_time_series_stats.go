@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudDbbrainSlowLogTimeSeriesStats() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainSlowLogTimeSeriesStatsRead,
+		ReadContext: dataSourceTencentCloudDbbrainSlowLogTimeSeriesStatsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -132,14 +133,14 @@ func dataSourceTencentCloudDbbrainSlowLogTimeSeriesStats() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainSlowLogTimeSeriesStatsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainSlowLogTimeSeriesStatsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_slow_log_time_series_stats.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
 	var id string
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -162,7 +163,7 @@ func dataSourceTencentCloudDbbrainSlowLogTimeSeriesStatsRead(d *schema.ResourceD
 	var result *dbbrain.DescribeSlowLogTimeSeriesStatsResponseParams
 	service := DbbrainService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		var e error
 		result, e = service.DescribeDbbrainSlowLogTimeSeriesStatsByFilter(ctx, paramMap)
 		if e != nil {
@@ -171,7 +172,7 @@ func dataSourceTencentCloudDbbrainSlowLogTimeSeriesStatsRead(d *schema.ResourceD
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if result != nil {
@@ -236,7 +237,7 @@ func dataSourceTencentCloudDbbrainSlowLogTimeSeriesStatsRead(d *schema.ResourceD
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), result); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -19,6 +19,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -27,7 +28,7 @@ import (
 
 func dataSourceTencentCloudDbbrainSlowLogTopSqls() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainSlowLogTopSqlsRead,
+		ReadContext: dataSourceTencentCloudDbbrainSlowLogTopSqlsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -224,14 +225,14 @@ func dataSourceTencentCloudDbbrainSlowLogTopSqls() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainSlowLogTopSqlsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainSlowLogTopSqlsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_slow_log_top_sqls.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
 	var id string
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -279,7 +280,7 @@ func dataSourceTencentCloudDbbrainSlowLogTopSqlsRead(d *schema.ResourceData, met
 
 	var rows []*dbbrain.SlowLogTopSqlItem
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDbbrainSlowLogTopSqlsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -288,7 +289,7 @@ func dataSourceTencentCloudDbbrainSlowLogTopSqlsRead(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(rows))
@@ -407,7 +408,7 @@ func dataSourceTencentCloudDbbrainSlowLogTopSqlsRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudDbbrainSlowLogUserHostStats() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainSlowLogUserHostStatsRead,
+		ReadContext: dataSourceTencentCloudDbbrainSlowLogUserHostStatsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -91,14 +92,14 @@ func dataSourceTencentCloudDbbrainSlowLogUserHostStats() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainSlowLogUserHostStatsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainSlowLogUserHostStatsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_slow_log_user_host_stats.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
 	var id string
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -126,7 +127,7 @@ func dataSourceTencentCloudDbbrainSlowLogUserHostStatsRead(d *schema.ResourceDat
 
 	var items []*dbbrain.SlowLogHost
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDbbrainSlowLogUserHostStatsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -135,7 +136,7 @@ func dataSourceTencentCloudDbbrainSlowLogUserHostStatsRead(d *schema.ResourceDat
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(items))
@@ -166,7 +167,7 @@ func dataSourceTencentCloudDbbrainSlowLogUserHostStatsRead(d *schema.ResourceDat
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
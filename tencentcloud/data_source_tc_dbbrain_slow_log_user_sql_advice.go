@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudDbbrainSlowLogUserSqlAdvice() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainSlowLogUserSqlAdviceRead,
+		ReadContext: dataSourceTencentCloudDbbrainSlowLogUserSqlAdviceRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -96,14 +97,14 @@ func dataSourceTencentCloudDbbrainSlowLogUserSqlAdvice() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainSlowLogUserSqlAdviceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainSlowLogUserSqlAdviceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_slow_log_user_sql_advice.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
 	var id string
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -126,7 +127,7 @@ func dataSourceTencentCloudDbbrainSlowLogUserSqlAdviceRead(d *schema.ResourceDat
 	var result *dbbrain.DescribeUserSqlAdviceResponseParams
 	service := DbbrainService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		var e error
 		result, e = service.DescribeDbbrainSlowLogUserSqlAdviceByFilter(ctx, paramMap)
 		if e != nil {
@@ -135,7 +136,7 @@ func dataSourceTencentCloudDbbrainSlowLogUserSqlAdviceRead(d *schema.ResourceDat
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if result != nil {
@@ -173,7 +174,7 @@ func dataSourceTencentCloudDbbrainSlowLogUserSqlAdviceRead(d *schema.ResourceDat
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), result); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
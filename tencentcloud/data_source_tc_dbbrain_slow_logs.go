@@ -17,8 +17,8 @@ package tencentcloud
 
 import (
 	"context"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -27,7 +27,7 @@ import (
 
 func dataSourceTencentCloudDbbrainSlowLogs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainSlowLogsRead,
+		ReadContext: dataSourceTencentCloudDbbrainSlowLogsRead,
 		Schema: map[string]*schema.Schema{
 			"product": {
 				Required:    true,
@@ -168,7 +168,7 @@ func dataSourceTencentCloudDbbrainSlowLogs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainSlowLogsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainSlowLogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_slow_logs.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -179,7 +179,7 @@ func dataSourceTencentCloudDbbrainSlowLogsRead(d *schema.ResourceData, meta inte
 		product    string
 	)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("product"); ok {
@@ -241,7 +241,7 @@ func dataSourceTencentCloudDbbrainSlowLogsRead(d *schema.ResourceData, meta inte
 
 	var rows []*dbbrain.SlowLogInfoItem
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDbbrainSlowLogsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -250,7 +250,7 @@ func dataSourceTencentCloudDbbrainSlowLogsRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(rows))
@@ -296,7 +296,7 @@ func dataSourceTencentCloudDbbrainSlowLogsRead(d *schema.ResourceData, meta inte
 				slowLogInfoItemMap["rows_sent"] = slowLogInfoItem.RowsSent
 			}
 
-			ids = append(ids, strings.Join([]string{md5, instanceId, product}, FILED_SP))
+			ids = append(ids, helper.IdFormat(md5, instanceId, product))
 			tmpList = append(tmpList, slowLogInfoItemMap)
 		}
 
@@ -307,7 +307,7 @@ func dataSourceTencentCloudDbbrainSlowLogsRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -28,6 +28,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -36,7 +37,7 @@ import (
 
 func dataSourceTencentCloudDbbrainSqlFilters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainSqlFiltersRead,
+		ReadContext: dataSourceTencentCloudDbbrainSqlFiltersRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -136,12 +137,12 @@ func dataSourceTencentCloudDbbrainSqlFilters() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainSqlFiltersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainSqlFiltersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_sql_filters.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -171,7 +172,7 @@ func dataSourceTencentCloudDbbrainSqlFiltersRead(d *schema.ResourceData, meta in
 	dbbrainService := DbbrainService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var items []*dbbrain.SQLFilter
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := dbbrainService.DescribeDbbrainSqlFiltersByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -181,7 +182,7 @@ func dataSourceTencentCloudDbbrainSqlFiltersRead(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Dbbrain items failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(items))
@@ -233,7 +234,7 @@ func dataSourceTencentCloudDbbrainSqlFiltersRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), itemList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
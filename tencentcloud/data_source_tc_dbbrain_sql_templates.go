@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudDbbrainSqlTemplates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainSqlTemplatesRead,
+		ReadContext: dataSourceTencentCloudDbbrainSqlTemplatesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -78,7 +79,7 @@ func dataSourceTencentCloudDbbrainSqlTemplates() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainSqlTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainSqlTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_sql_templates.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -86,7 +87,7 @@ func dataSourceTencentCloudDbbrainSqlTemplatesRead(d *schema.ResourceData, meta
 
 	var instanceId string
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -110,7 +111,7 @@ func dataSourceTencentCloudDbbrainSqlTemplatesRead(d *schema.ResourceData, meta
 
 	var rows *dbbrain.DescribeSqlTemplateResponseParams
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDbbrainSqlTemplatesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -119,7 +120,7 @@ func dataSourceTencentCloudDbbrainSqlTemplatesRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := []map[string]interface{}{}
@@ -148,7 +149,7 @@ func dataSourceTencentCloudDbbrainSqlTemplatesRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
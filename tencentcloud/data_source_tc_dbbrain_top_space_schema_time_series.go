@@ -17,8 +17,8 @@ package tencentcloud
 
 import (
 	"context"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -27,7 +27,7 @@ import (
 
 func dataSourceTencentCloudDbbrainTopSpaceSchemaTimeSeries() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainTopSpaceSchemaTimeSeriesRead,
+		ReadContext: dataSourceTencentCloudDbbrainTopSpaceSchemaTimeSeriesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -134,13 +134,13 @@ func dataSourceTencentCloudDbbrainTopSpaceSchemaTimeSeries() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainTopSpaceSchemaTimeSeriesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainTopSpaceSchemaTimeSeriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_top_space_schema_time_series.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var instanceId string
 
 	paramMap := make(map[string]interface{})
@@ -173,7 +173,7 @@ func dataSourceTencentCloudDbbrainTopSpaceSchemaTimeSeriesRead(d *schema.Resourc
 
 	var topSpaceSchemaTimeSeries []*dbbrain.SchemaSpaceTimeSeries
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDbbrainTopSpaceSchemaTimeSeriesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -182,7 +182,7 @@ func dataSourceTencentCloudDbbrainTopSpaceSchemaTimeSeriesRead(d *schema.Resourc
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(topSpaceSchemaTimeSeries))
@@ -228,7 +228,7 @@ func dataSourceTencentCloudDbbrainTopSpaceSchemaTimeSeriesRead(d *schema.Resourc
 				schemaSpaceTimeSeriesMap["series_data"] = []interface{}{seriesDataMap}
 			}
 
-			ids = append(ids, strings.Join([]string{instanceId, *schemaSpaceTimeSeries.TableSchema}, FILED_SP))
+			ids = append(ids, helper.IdFormat(instanceId, *schemaSpaceTimeSeries.TableSchema))
 			tmpList = append(tmpList, schemaSpaceTimeSeriesMap)
 		}
 
@@ -239,7 +239,7 @@ func dataSourceTencentCloudDbbrainTopSpaceSchemaTimeSeriesRead(d *schema.Resourc
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
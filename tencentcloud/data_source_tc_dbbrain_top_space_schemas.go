@@ -15,8 +15,8 @@ package tencentcloud
 
 import (
 	"context"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -25,7 +25,7 @@ import (
 
 func dataSourceTencentCloudDbbrainTopSpaceSchemas() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainTopSpaceSchemasRead,
+		ReadContext: dataSourceTencentCloudDbbrainTopSpaceSchemasRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -117,13 +117,13 @@ func dataSourceTencentCloudDbbrainTopSpaceSchemas() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainTopSpaceSchemasRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainTopSpaceSchemasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_top_space_schemas.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var instanceId string
 
 	paramMap := make(map[string]interface{})
@@ -149,7 +149,7 @@ func dataSourceTencentCloudDbbrainTopSpaceSchemasRead(d *schema.ResourceData, me
 	var topSpaceSchemas []*dbbrain.SchemaSpaceData
 	var timestamp *int64
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, ts, e := service.DescribeDbbrainTopSpaceSchemasByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -159,7 +159,7 @@ func dataSourceTencentCloudDbbrainTopSpaceSchemasRead(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(topSpaceSchemas))
@@ -201,7 +201,7 @@ func dataSourceTencentCloudDbbrainTopSpaceSchemasRead(d *schema.ResourceData, me
 				schemaSpaceDataMap["physical_file_size"] = schemaSpaceData.PhysicalFileSize
 			}
 
-			ids = append(ids, strings.Join([]string{instanceId, *schemaSpaceData.TableSchema}, FILED_SP))
+			ids = append(ids, helper.IdFormat(instanceId, *schemaSpaceData.TableSchema))
 			tmpList = append(tmpList, schemaSpaceDataMap)
 		}
 
@@ -216,7 +216,7 @@ func dataSourceTencentCloudDbbrainTopSpaceSchemasRead(d *schema.ResourceData, me
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
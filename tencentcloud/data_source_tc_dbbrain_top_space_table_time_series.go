@@ -17,8 +17,8 @@ package tencentcloud
 
 import (
 	"context"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -27,7 +27,7 @@ import (
 
 func dataSourceTencentCloudDbbrainTopSpaceTableTimeSeries() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainTopSpaceTableTimeSeriesRead,
+		ReadContext: dataSourceTencentCloudDbbrainTopSpaceTableTimeSeriesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -144,13 +144,13 @@ func dataSourceTencentCloudDbbrainTopSpaceTableTimeSeries() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainTopSpaceTableTimeSeriesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainTopSpaceTableTimeSeriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_top_space_table_time_series.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var instanceId string
 
 	paramMap := make(map[string]interface{})
@@ -183,7 +183,7 @@ func dataSourceTencentCloudDbbrainTopSpaceTableTimeSeriesRead(d *schema.Resource
 
 	var topSpaceTableTimeSeries []*dbbrain.TableSpaceTimeSeries
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDbbrainTopSpaceTableTimeSeriesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -192,7 +192,7 @@ func dataSourceTencentCloudDbbrainTopSpaceTableTimeSeriesRead(d *schema.Resource
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(topSpaceTableTimeSeries))
@@ -247,7 +247,7 @@ func dataSourceTencentCloudDbbrainTopSpaceTableTimeSeriesRead(d *schema.Resource
 				tableSpaceTimeSeriesMap["series_data"] = []interface{}{seriesDataMap}
 			}
 
-			ids = append(ids, strings.Join([]string{instanceId, *tableSpaceTimeSeries.TableSchema, *tableSpaceTimeSeries.TableName}, FILED_SP))
+			ids = append(ids, helper.IdFormat(instanceId, *tableSpaceTimeSeries.TableSchema, *tableSpaceTimeSeries.TableName))
 			tmpList = append(tmpList, tableSpaceTimeSeriesMap)
 		}
 
@@ -258,7 +258,7 @@ func dataSourceTencentCloudDbbrainTopSpaceTableTimeSeriesRead(d *schema.Resource
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
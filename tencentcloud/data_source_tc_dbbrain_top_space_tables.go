@@ -25,8 +25,8 @@ package tencentcloud
 
 import (
 	"context"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -35,7 +35,7 @@ import (
 
 func dataSourceTencentCloudDbbrainTopSpaceTables() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDbbrainTopSpaceTablesRead,
+		ReadContext: dataSourceTencentCloudDbbrainTopSpaceTablesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -137,13 +137,13 @@ func dataSourceTencentCloudDbbrainTopSpaceTables() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDbbrainTopSpaceTablesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDbbrainTopSpaceTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dbbrain_top_space_tables.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var instanceId string
 
 	paramMap := make(map[string]interface{})
@@ -169,7 +169,7 @@ func dataSourceTencentCloudDbbrainTopSpaceTablesRead(d *schema.ResourceData, met
 	var topSpaceTables []*dbbrain.TableSpaceData
 	var timestamp *int64
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, ts, e := service.DescribeDbbrainTopSpaceTablesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -179,7 +179,7 @@ func dataSourceTencentCloudDbbrainTopSpaceTablesRead(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(topSpaceTables))
@@ -229,7 +229,7 @@ func dataSourceTencentCloudDbbrainTopSpaceTablesRead(d *schema.ResourceData, met
 				tableSpaceDataMap["physical_file_size"] = tableSpaceData.PhysicalFileSize
 			}
 
-			ids = append(ids, strings.Join([]string{instanceId, *tableSpaceData.TableSchema, *tableSpaceData.TableName}, FILED_SP))
+			ids = append(ids, helper.IdFormat(instanceId, *tableSpaceData.TableSchema, *tableSpaceData.TableName))
 			tmpList = append(tmpList, tableSpaceDataMap)
 		}
 
@@ -244,7 +244,7 @@ func dataSourceTencentCloudDbbrainTopSpaceTablesRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
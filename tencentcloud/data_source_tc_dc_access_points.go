@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dc/v20180410"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudDcAccessPoints() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcAccessPointsRead,
+		ReadContext: dataSourceTencentCloudDcAccessPointsRead,
 		Schema: map[string]*schema.Schema{
 			"region_id": {
 				Optional:    true,
@@ -124,13 +125,13 @@ func dataSourceTencentCloudDcAccessPoints() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcAccessPointsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcAccessPointsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dc_access_points.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("region_id"); ok {
@@ -141,7 +142,7 @@ func dataSourceTencentCloudDcAccessPointsRead(d *schema.ResourceData, meta inter
 
 	var accessPointSet []*dc.AccessPoint
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDcAccessPointsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -150,7 +151,7 @@ func dataSourceTencentCloudDcAccessPointsRead(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(accessPointSet))
@@ -225,7 +226,7 @@ func dataSourceTencentCloudDcAccessPointsRead(d *schema.ResourceData, meta inter
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
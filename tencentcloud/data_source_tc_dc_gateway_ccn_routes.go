@@ -39,12 +39,13 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTencentCloudDcGatewayCCNRoutes() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcGatewayCCNRoutesRead,
+		ReadContext: dataSourceTencentCloudDcGatewayCCNRoutesRead,
 		Schema: map[string]*schema.Schema{
 			"dcg_id": {
 				Type:        schema.TypeString,
@@ -93,11 +94,11 @@ func dataSourceTencentCloudDcGatewayCCNRoutes() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcGatewayCCNRoutesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcGatewayCCNRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dc_gateway_ccn_routes.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -107,7 +108,7 @@ func dataSourceTencentCloudDcGatewayCCNRoutesRead(d *schema.ResourceData, meta i
 
 	var infos, err = service.DescribeDirectConnectGatewayCcnRoutes(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var infoList = make([]map[string]interface{}, 0, len(infos))
@@ -124,7 +125,7 @@ func dataSourceTencentCloudDcGatewayCCNRoutesRead(d *schema.ResourceData, meta i
 		log.Printf("[CRITAL]%s provider set  dcg  ccn routes fail, reason:%s\n ",
 			logId,
 			err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
@@ -135,7 +136,7 @@ func dataSourceTencentCloudDcGatewayCCNRoutesRead(d *schema.ResourceData, meta i
 				logId,
 				output.(string),
 				err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -35,12 +35,13 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTencentCloudDcGatewayInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcGatewayInstancesRead,
+		ReadContext: dataSourceTencentCloudDcGatewayInstancesRead,
 		Schema: map[string]*schema.Schema{
 			"dcg_id": {
 				Type:        schema.TypeString,
@@ -117,11 +118,11 @@ func dataSourceTencentCloudDcGatewayInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcGatewayInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcGatewayInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dc_gateway_instances.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -143,7 +144,7 @@ func dataSourceTencentCloudDcGatewayInstancesRead(d *schema.ResourceData, meta i
 	}
 	var infos, err = service.DescribeDirectConnectGateways(ctx, id, name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var infoList = make([]map[string]interface{}, 0, len(infos))
@@ -165,13 +166,13 @@ func dataSourceTencentCloudDcGatewayInstancesRead(d *schema.ResourceData, meta i
 		log.Printf("[CRITAL]%s provider set  dcg instances fail, reason:%s\n ",
 			logId,
 			err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	m := md5.New()
 	_, err = m.Write([]byte("dcg_instances" + id + "_" + name))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%x", m.Sum(nil)))
 
@@ -181,7 +182,7 @@ func dataSourceTencentCloudDcGatewayInstancesRead(d *schema.ResourceData, meta i
 				logId,
 				output.(string),
 				err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -22,12 +22,13 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTencentCloudDcInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcInstancesRead,
+		ReadContext: dataSourceTencentCloudDcInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"dc_id": {
@@ -160,11 +161,11 @@ func dataSourceTencentCloudDcInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dc_instances.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -188,7 +189,7 @@ func dataSourceTencentCloudDcInstancesRead(d *schema.ResourceData, meta interfac
 	var infos, err = service.DescribeDirectConnects(ctx, id, name)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var instanceList = make([]map[string]interface{}, 0, len(infos))
 
@@ -227,13 +228,13 @@ func dataSourceTencentCloudDcInstancesRead(d *schema.ResourceData, meta interfac
 
 	if err := d.Set("instance_list", instanceList); err != nil {
 		log.Printf("[CRITAL]%s provider set  dc instances fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	m := md5.New()
 	_, err = m.Write([]byte(id + "_" + name))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%x", m.Sum(nil)))
 
@@ -241,7 +242,7 @@ func dataSourceTencentCloudDcInstancesRead(d *schema.ResourceData, meta interfac
 		if err := writeToFile(output.(string), instanceList); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
 				logId, output.(string), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
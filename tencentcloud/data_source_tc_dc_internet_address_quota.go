@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dc/v20180410"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudDcInternetAddressQuota() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcInternetAddressQuotaRead,
+		ReadContext: dataSourceTencentCloudDcInternetAddressQuotaRead,
 		Schema: map[string]*schema.Schema{
 			"ipv6_prefix_len": {
 				Computed:    true,
@@ -61,19 +62,19 @@ func dataSourceTencentCloudDcInternetAddressQuota() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcInternetAddressQuotaRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcInternetAddressQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dc_internet_address_quota.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var quota *dc.DescribeInternetAddressQuotaResponse
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDcInternetAddressQuota(ctx)
 		if e != nil {
 			return retryError(e)
@@ -82,7 +83,7 @@ func dataSourceTencentCloudDcInternetAddressQuotaRead(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if quota.Response.Ipv6PrefixLen != nil {
@@ -119,7 +120,7 @@ func dataSourceTencentCloudDcInternetAddressQuotaRead(d *schema.ResourceData, me
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
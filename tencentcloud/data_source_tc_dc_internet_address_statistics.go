@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dc/v20180410"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudDcInternetAddressStatistics() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcInternetAddressStatisticsRead,
+		ReadContext: dataSourceTencentCloudDcInternetAddressStatisticsRead,
 		Schema: map[string]*schema.Schema{
 			"internet_address_statistics": {
 				Computed:    true,
@@ -51,19 +52,19 @@ func dataSourceTencentCloudDcInternetAddressStatistics() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcInternetAddressStatisticsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcInternetAddressStatisticsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dc_internet_address_statistics.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var internetAddressStatistics []*dc.InternetAddressStatistics
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDcInternetAddressStatistics(ctx)
 		if e != nil {
 			return retryError(e)
@@ -72,7 +73,7 @@ func dataSourceTencentCloudDcInternetAddressStatisticsRead(d *schema.ResourceDat
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(internetAddressStatistics))
@@ -101,7 +102,7 @@ func dataSourceTencentCloudDcInternetAddressStatisticsRead(d *schema.ResourceDat
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
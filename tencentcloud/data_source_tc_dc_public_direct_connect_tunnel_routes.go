@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dc/v20180410"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudDcPublicDirectConnectTunnelRoutes() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcPublicDirectConnectTunnelRoutesRead,
+		ReadContext: dataSourceTencentCloudDcPublicDirectConnectTunnelRoutesRead,
 		Schema: map[string]*schema.Schema{
 			"direct_connect_tunnel_id": {
 				Required:    true,
@@ -105,13 +106,13 @@ func dataSourceTencentCloudDcPublicDirectConnectTunnelRoutes() *schema.Resource
 	}
 }
 
-func dataSourceTencentCloudDcPublicDirectConnectTunnelRoutesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcPublicDirectConnectTunnelRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dc_public_direct_connect_tunnel_routes.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("direct_connect_tunnel_id"); ok {
@@ -142,7 +143,7 @@ func dataSourceTencentCloudDcPublicDirectConnectTunnelRoutesRead(d *schema.Resou
 
 	var routes []*dc.DirectConnectTunnelRoute
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDcPublicDirectConnectTunnelRoutesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -151,7 +152,7 @@ func dataSourceTencentCloudDcPublicDirectConnectTunnelRoutesRead(d *schema.Resou
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(routes))
@@ -196,7 +197,7 @@ func dataSourceTencentCloudDcPublicDirectConnectTunnelRoutesRead(d *schema.Resou
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -15,6 +15,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudDcdbAccounts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcdbAccountsRead,
+		ReadContext: dataSourceTencentCloudDcdbAccountsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -88,12 +89,12 @@ func dataSourceTencentCloudDcdbAccounts() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcdbAccountsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcdbAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcdb_accounts.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -103,7 +104,7 @@ func dataSourceTencentCloudDcdbAccountsRead(d *schema.ResourceData, meta interfa
 	dcdbService := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var dbAccountList []*dcdb.DBAccount
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := dcdbService.DescribeDcdbAccountsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -113,7 +114,7 @@ func dataSourceTencentCloudDcdbAccountsRead(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Dcdb list failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	retList := []interface{}{}
@@ -145,7 +146,7 @@ func dataSourceTencentCloudDcdbAccountsRead(d *schema.ResourceData, meta interfa
 			if dbA.SlaveConst != nil {
 				listMap["slave_const"] = dbA.SlaveConst
 			}
-			ids = append(ids, *dbA.UserName+FILED_SP+*dbA.Host)
+			ids = append(ids, helper.IdFormat(*dbA.UserName, *dbA.Host))
 			retList = append(retList, listMap)
 		}
 
@@ -156,7 +157,7 @@ func dataSourceTencentCloudDcdbAccountsRead(d *schema.ResourceData, meta interfa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), retList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -14,8 +14,8 @@ package tencentcloud
 
 import (
 	"context"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -24,7 +24,7 @@ import (
 
 func dataSourceTencentCloudDcdbDatabaseObjects() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcdbDatabaseObjectsRead,
+		ReadContext: dataSourceTencentCloudDcdbDatabaseObjectsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -107,13 +107,13 @@ func dataSourceTencentCloudDcdbDatabaseObjects() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcdbDatabaseObjectsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcdbDatabaseObjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcdb_database_objects.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -127,7 +127,7 @@ func dataSourceTencentCloudDcdbDatabaseObjectsRead(d *schema.ResourceData, meta
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var result *dcdb.DescribeDatabaseObjectsResponseParams
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		var e error
 		result, e = service.DescribeDcdbDBObjectsByFilter(ctx, paramMap)
 		if e != nil {
@@ -136,7 +136,7 @@ func dataSourceTencentCloudDcdbDatabaseObjectsRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0)
@@ -204,13 +204,13 @@ func dataSourceTencentCloudDcdbDatabaseObjectsRead(d *schema.ResourceData, meta
 		}
 	}
 
-	ids = append(ids, strings.Join([]string{*result.InstanceId, *result.DbName}, FILED_SP))
+	ids = append(ids, helper.IdFormat(*result.InstanceId, *result.DbName))
 
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), data); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -21,8 +21,8 @@ package tencentcloud
 
 import (
 	"context"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -31,7 +31,7 @@ import (
 
 func dataSourceTencentCloudDcdbDatabaseTables() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcdbDatabaseTablesRead,
+		ReadContext: dataSourceTencentCloudDcdbDatabaseTablesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -80,13 +80,13 @@ func dataSourceTencentCloudDcdbDatabaseTables() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcdbDatabaseTablesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcdbDatabaseTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcdb_database_tables.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -104,7 +104,7 @@ func dataSourceTencentCloudDcdbDatabaseTablesRead(d *schema.ResourceData, meta i
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var result *dcdb.DescribeDatabaseTableResponseParams
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		var e error
 		result, e = service.DescribeDcdbDBTablesByFilter(ctx, paramMap)
 		if e != nil {
@@ -113,7 +113,7 @@ func dataSourceTencentCloudDcdbDatabaseTablesRead(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0)
@@ -139,13 +139,13 @@ func dataSourceTencentCloudDcdbDatabaseTablesRead(d *schema.ResourceData, meta i
 		}
 	}
 
-	ids = append(ids, strings.Join([]string{*result.InstanceId, *result.DbName}, FILED_SP))
+	ids = append(ids, helper.IdFormat(*result.InstanceId, *result.DbName))
 
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), data); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
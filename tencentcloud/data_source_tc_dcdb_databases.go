@@ -15,6 +15,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudDcdbDatabases() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcdbDatabasesRead,
+		ReadContext: dataSourceTencentCloudDcdbDatabasesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -55,12 +56,12 @@ func dataSourceTencentCloudDcdbDatabases() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcdbDatabasesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcdbDatabasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcdb_databases.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -70,7 +71,7 @@ func dataSourceTencentCloudDcdbDatabasesRead(d *schema.ResourceData, meta interf
 	dcdbService := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var dbs []*dcdb.Database
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := dcdbService.DescribeDcdbDatabasesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -80,7 +81,7 @@ func dataSourceTencentCloudDcdbDatabasesRead(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Dcdb list failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(dbs))
@@ -101,7 +102,7 @@ func dataSourceTencentCloudDcdbDatabasesRead(d *schema.ResourceData, meta interf
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), list); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
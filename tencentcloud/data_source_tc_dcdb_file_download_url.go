@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudDcdbFileDownloadUrl() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcdbFileDownloadUrlRead,
+		ReadContext: dataSourceTencentCloudDcdbFileDownloadUrlRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -58,13 +59,13 @@ func dataSourceTencentCloudDcdbFileDownloadUrl() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcdbFileDownloadUrlRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcdbFileDownloadUrlRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcdb_file_download_url.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var (
 		preSignedUrl *string
 		instanceId   string
@@ -86,7 +87,7 @@ func dataSourceTencentCloudDcdbFileDownloadUrlRead(d *schema.ResourceData, meta
 
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDcdbFileDownloadUrlByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -95,7 +96,7 @@ func dataSourceTencentCloudDcdbFileDownloadUrlRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if preSignedUrl != nil {
@@ -106,7 +107,7 @@ func dataSourceTencentCloudDcdbFileDownloadUrlRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), preSignedUrl); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudDcdbInstanceNodeInfo() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcdbInstanceNodeInfoRead,
+		ReadContext: dataSourceTencentCloudDcdbInstanceNodeInfoRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -64,13 +65,13 @@ func dataSourceTencentCloudDcdbInstanceNodeInfo() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcdbInstanceNodeInfoRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcdbInstanceNodeInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcdb_instance_node_info.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var (
 		instanceId string
 	)
@@ -85,7 +86,7 @@ func dataSourceTencentCloudDcdbInstanceNodeInfoRead(d *schema.ResourceData, meta
 
 	var nodesInfo []*dcdb.BriefNodeInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDcdbInstanceNodeInfoByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -94,7 +95,7 @@ func dataSourceTencentCloudDcdbInstanceNodeInfoRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(nodesInfo))
@@ -125,7 +126,7 @@ func dataSourceTencentCloudDcdbInstanceNodeInfoRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
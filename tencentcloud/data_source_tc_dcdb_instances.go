@@ -35,6 +35,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -43,7 +44,7 @@ import (
 
 func dataSourceTencentCloudDcdbInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcdbInstancesRead,
+		ReadContext: dataSourceTencentCloudDcdbInstancesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_ids": {
 				Type: schema.TypeSet,
@@ -353,12 +354,12 @@ func dataSourceTencentCloudDcdbInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcdbInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcdbInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcdb_instances.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_ids"); ok {
@@ -410,7 +411,7 @@ func dataSourceTencentCloudDcdbInstancesRead(d *schema.ResourceData, meta interf
 	dcdbService := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var instances []*dcdb.DCDBInstanceInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := dcdbService.DescribeDcdbInstancesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -420,7 +421,7 @@ func dataSourceTencentCloudDcdbInstancesRead(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Dcdb instances failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(instances))
@@ -582,7 +583,7 @@ func dataSourceTencentCloudDcdbInstancesRead(d *schema.ResourceData, meta interf
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), instanceList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudDcdbLogFiles() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcdbLogFilesRead,
+		ReadContext: dataSourceTencentCloudDcdbLogFilesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -95,13 +96,13 @@ func dataSourceTencentCloudDcdbLogFiles() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcdbLogFilesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcdbLogFilesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcdb_log_files.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var (
 		instanceId string
 		shardId    string
@@ -127,7 +128,7 @@ func dataSourceTencentCloudDcdbLogFilesRead(d *schema.ResourceData, meta interfa
 
 	var result *dcdb.DescribeDBLogFilesResponseParams
 	var e error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e = service.DescribeDcdbLogFilesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -135,7 +136,7 @@ func dataSourceTencentCloudDcdbLogFilesRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids = append(ids, instanceId)
@@ -179,7 +180,7 @@ func dataSourceTencentCloudDcdbLogFilesRead(d *schema.ResourceData, meta interfa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), result); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
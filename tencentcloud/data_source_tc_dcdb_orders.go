@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudDcdbOrders() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcdbOrdersRead,
+		ReadContext: dataSourceTencentCloudDcdbOrdersRead,
 		Schema: map[string]*schema.Schema{
 			"deal_names": {
 				Required: true,
@@ -85,13 +86,13 @@ func dataSourceTencentCloudDcdbOrders() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcdbOrdersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcdbOrdersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcdb_orders.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("deal_names"); ok {
@@ -103,7 +104,7 @@ func dataSourceTencentCloudDcdbOrdersRead(d *schema.ResourceData, meta interface
 
 	var deals []*dcdb.Deal
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDcdbOrdersByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -112,7 +113,7 @@ func dataSourceTencentCloudDcdbOrdersRead(d *schema.ResourceData, meta interface
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(deals))
@@ -157,7 +158,7 @@ func dataSourceTencentCloudDcdbOrdersRead(d *schema.ResourceData, meta interface
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -15,6 +15,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudDcdbParameters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcdbParametersRead,
+		ReadContext: dataSourceTencentCloudDcdbParametersRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -118,12 +119,12 @@ func dataSourceTencentCloudDcdbParameters() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcdbParametersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcdbParametersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcdb_parameters.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -133,7 +134,7 @@ func dataSourceTencentCloudDcdbParametersRead(d *schema.ResourceData, meta inter
 	dcdbService := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var params []*dcdb.ParamDesc
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := dcdbService.DescribeDcdbParametersByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -143,7 +144,7 @@ func dataSourceTencentCloudDcdbParametersRead(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Dcdb params failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	paramList := []interface{}{}
@@ -191,7 +192,7 @@ func dataSourceTencentCloudDcdbParametersRead(d *schema.ResourceData, meta inter
 			if param.NeedRestart != nil {
 				paramMap["need_restart"] = param.NeedRestart
 			}
-			ids = append(ids, *param.Param+FILED_SP+*param.Value)
+			ids = append(ids, helper.IdFormat(*param.Param, *param.Value))
 			paramList = append(paramList, paramMap)
 		}
 		d.SetId(helper.DataResourceIdsHash(ids))
@@ -201,7 +202,7 @@ func dataSourceTencentCloudDcdbParametersRead(d *schema.ResourceData, meta inter
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), paramList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
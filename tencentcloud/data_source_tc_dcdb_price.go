@@ -22,6 +22,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -30,7 +31,7 @@ import (
 
 func dataSourceTencentCloudDcdbPrice() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcdbPriceRead,
+		ReadContext: dataSourceTencentCloudDcdbPriceRead,
 		Schema: map[string]*schema.Schema{
 			"instance_count": {
 				Required:    true,
@@ -107,13 +108,13 @@ func dataSourceTencentCloudDcdbPrice() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcdbPriceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcdbPriceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcdb_price.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var (
 		ids []string
 	)
@@ -165,7 +166,7 @@ func dataSourceTencentCloudDcdbPriceRead(d *schema.ResourceData, meta interface{
 
 	var result *dcdb.DescribeDCDBPriceResponseParams
 	var e error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e = service.DescribeDcdbPriceByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -173,7 +174,7 @@ func dataSourceTencentCloudDcdbPriceRead(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if result != nil {
@@ -190,7 +191,7 @@ func dataSourceTencentCloudDcdbPriceRead(d *schema.ResourceData, meta interface{
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), result); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
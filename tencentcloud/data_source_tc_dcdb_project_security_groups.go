@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudDcdbProjectSecurityGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcdbProjectSecurityGroupsRead,
+		ReadContext: dataSourceTencentCloudDcdbProjectSecurityGroupsRead,
 		Schema: map[string]*schema.Schema{
 			"product": {
 				Required:    true,
@@ -139,13 +140,13 @@ func dataSourceTencentCloudDcdbProjectSecurityGroups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcdbProjectSecurityGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcdbProjectSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcdb_project_security_groups.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("product"); ok {
@@ -160,7 +161,7 @@ func dataSourceTencentCloudDcdbProjectSecurityGroupsRead(d *schema.ResourceData,
 
 	var groups []*dcdb.SecurityGroup
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDcdbProjectSecurityGroupsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -169,7 +170,7 @@ func dataSourceTencentCloudDcdbProjectSecurityGroupsRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(groups))
@@ -264,7 +265,7 @@ func dataSourceTencentCloudDcdbProjectSecurityGroupsRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudDcdbProjects() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcdbProjectsRead,
+		ReadContext: dataSourceTencentCloudDcdbProjectsRead,
 		Schema: map[string]*schema.Schema{
 			"projects": {
 				Computed:    true,
@@ -96,19 +97,19 @@ func dataSourceTencentCloudDcdbProjects() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcdbProjectsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcdbProjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcdb_projects.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var projects []*dcdb.Project
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDcdbProjectsByFilter(ctx)
 		if e != nil {
 			return retryError(e)
@@ -117,7 +118,7 @@ func dataSourceTencentCloudDcdbProjectsRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(projects))
@@ -182,7 +183,7 @@ func dataSourceTencentCloudDcdbProjectsRead(d *schema.ResourceData, meta interfa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
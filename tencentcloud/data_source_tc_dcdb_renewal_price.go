@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudDcdbRenewalPrice() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcdbRenewalPriceRead,
+		ReadContext: dataSourceTencentCloudDcdbRenewalPriceRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -65,13 +66,13 @@ func dataSourceTencentCloudDcdbRenewalPrice() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcdbRenewalPriceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcdbRenewalPriceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcdb_renewal_price.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var (
 		instanceId string
 	)
@@ -94,7 +95,7 @@ func dataSourceTencentCloudDcdbRenewalPriceRead(d *schema.ResourceData, meta int
 	var e error
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e = service.DescribeDcdbRenewalPriceByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -102,7 +103,7 @@ func dataSourceTencentCloudDcdbRenewalPriceRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if result != nil {
@@ -119,7 +120,7 @@ func dataSourceTencentCloudDcdbRenewalPriceRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), result); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
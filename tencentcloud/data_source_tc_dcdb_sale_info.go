@@ -13,6 +13,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudDcdbSaleInfo() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcdbSaleInfoRead,
+		ReadContext: dataSourceTencentCloudDcdbSaleInfoRead,
 		Schema: map[string]*schema.Schema{
 			"region_list": {
 				Computed:    true,
@@ -153,20 +154,20 @@ func dataSourceTencentCloudDcdbSaleInfo() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcdbSaleInfoRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcdbSaleInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcdb_sale_info.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var regionList []*dcdb.RegionInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDcdbSaleInfoByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -175,7 +176,7 @@ func dataSourceTencentCloudDcdbSaleInfoRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(regionList))
@@ -295,7 +296,7 @@ func dataSourceTencentCloudDcdbSaleInfoRead(d *schema.ResourceData, meta interfa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -15,6 +15,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudDcdbSecurityGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcdbSecurityGroupsRead,
+		ReadContext: dataSourceTencentCloudDcdbSecurityGroupsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -128,12 +129,12 @@ func dataSourceTencentCloudDcdbSecurityGroups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcdbSecurityGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcdbSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcdb_security_groups.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -143,7 +144,7 @@ func dataSourceTencentCloudDcdbSecurityGroupsRead(d *schema.ResourceData, meta i
 	dcdbService := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var groups []*dcdb.SecurityGroup
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := dcdbService.DescribeDcdbSecurityGroupsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -153,7 +154,7 @@ func dataSourceTencentCloudDcdbSecurityGroupsRead(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Dcdb groups failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	groupList := []interface{}{}
@@ -225,7 +226,7 @@ func dataSourceTencentCloudDcdbSecurityGroupsRead(d *schema.ResourceData, meta i
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), groupList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudDcdbShardSpec() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcdbShardSpecRead,
+		ReadContext: dataSourceTencentCloudDcdbShardSpecRead,
 		Schema: map[string]*schema.Schema{
 			"spec_config": {
 				Computed:    true,
@@ -95,20 +96,20 @@ func dataSourceTencentCloudDcdbShardSpec() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcdbShardSpecRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcdbShardSpecRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcdb_shard_spec.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var specConfig []*dcdb.SpecConfig
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDcdbShardSpecByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -117,7 +118,7 @@ func dataSourceTencentCloudDcdbShardSpecRead(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(specConfig))
@@ -185,7 +186,7 @@ func dataSourceTencentCloudDcdbShardSpecRead(d *schema.ResourceData, meta interf
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -16,6 +16,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudDcdbShards() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcdbShardsRead,
+		ReadContext: dataSourceTencentCloudDcdbShardsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -178,12 +179,12 @@ func dataSourceTencentCloudDcdbShards() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcdbShardsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcdbShardsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcdb_shards.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -202,7 +203,7 @@ func dataSourceTencentCloudDcdbShardsRead(d *schema.ResourceData, meta interface
 	dcdbService := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var shards []*dcdb.DCDBShardInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := dcdbService.DescribeDcdbShardsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -212,7 +213,7 @@ func dataSourceTencentCloudDcdbShardsRead(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Dcdb shards failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// shardList := []interface{}{}
@@ -298,14 +299,14 @@ func dataSourceTencentCloudDcdbShardsRead(d *schema.ResourceData, meta interface
 		err = d.Set("list", shardList)
 		if err != nil {
 			log.Printf("[CRITAL]%s set Dcdb shards failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), shardList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -22,6 +22,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -30,7 +31,7 @@ import (
 
 func dataSourceTencentCloudDcdbSlowLogs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcdbSlowLogsRead,
+		ReadContext: dataSourceTencentCloudDcdbSlowLogsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -212,13 +213,13 @@ func dataSourceTencentCloudDcdbSlowLogs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcdbSlowLogsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcdbSlowLogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcdb_slow_logs.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var (
 		instanceId string
 	)
@@ -264,7 +265,7 @@ func dataSourceTencentCloudDcdbSlowLogsRead(d *schema.ResourceData, meta interfa
 		slowLogs []*dcdb.SlowLogData
 		e        error
 	)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		slowLogs, resp, e = service.DescribeDcdbSlowLogsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -272,7 +273,7 @@ func dataSourceTencentCloudDcdbSlowLogsRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG]%s quey dcdb slow log success, slowLogs.len:%v, resp:[%v], \n ", //result.LockTimeSum:[%v], result.QueryTimeSum:[%v]
@@ -384,7 +385,7 @@ func dataSourceTencentCloudDcdbSlowLogsRead(d *schema.ResourceData, meta interfa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), slowLogs); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -47,6 +47,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -55,7 +56,7 @@ import (
 
 func dataSourceTencentCloudDcdbUpgradePrice() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcdbUpgradePriceRead,
+		ReadContext: dataSourceTencentCloudDcdbUpgradePriceRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -196,13 +197,13 @@ func dataSourceTencentCloudDcdbUpgradePrice() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcdbUpgradePriceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcdbUpgradePriceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcdb_upgrade_price.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var (
 		instanceId string
 	)
@@ -275,7 +276,7 @@ func dataSourceTencentCloudDcdbUpgradePriceRead(d *schema.ResourceData, meta int
 
 	var result *dcdb.DescribeDCDBUpgradePriceResponseParams
 	var e error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e = service.DescribeDcdbUpgradePriceByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -283,7 +284,7 @@ func dataSourceTencentCloudDcdbUpgradePriceRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if result != nil {
@@ -304,7 +305,7 @@ func dataSourceTencentCloudDcdbUpgradePriceRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), result); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
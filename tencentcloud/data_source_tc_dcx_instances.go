@@ -22,12 +22,13 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTencentCloudDcxInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDcxInstancesRead,
+		ReadContext: dataSourceTencentCloudDcxInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"dcx_id": {
@@ -148,11 +149,11 @@ func dataSourceTencentCloudDcxInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDcxInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDcxInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dcx_instances.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -176,7 +177,7 @@ func dataSourceTencentCloudDcxInstancesRead(d *schema.ResourceData, meta interfa
 	var infos, err = service.DescribeDirectConnectTunnels(ctx, id, name)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var instanceList = make([]map[string]interface{}, 0, len(infos))
 
@@ -223,13 +224,13 @@ func dataSourceTencentCloudDcxInstancesRead(d *schema.ResourceData, meta interfa
 
 	if err := d.Set("instance_list", instanceList); err != nil {
 		log.Printf("[CRITAL]%s provider set  dcx instances fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	m := md5.New()
 	_, err = m.Write([]byte(id + "_" + name))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%x", m.Sum(nil)))
 
@@ -237,7 +238,7 @@ func dataSourceTencentCloudDcxInstancesRead(d *schema.ResourceData, meta interfa
 		if err := writeToFile(output.(string), instanceList); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
 				logId, output.(string), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
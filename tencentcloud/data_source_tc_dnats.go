@@ -23,9 +23,11 @@ data "tencentcloud_dnats" "foo" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -34,7 +36,7 @@ import (
 
 func dataSourceTencentCloudDnats() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDnatsRead,
+		ReadContext: dataSourceTencentCloudDnatsRead,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -136,7 +138,7 @@ func dataSourceTencentCloudDnats() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDnatsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDnatsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dnats.read")()
 
 	logId := getLogId(contextNil)
@@ -179,8 +181,8 @@ func dataSourceTencentCloudDnatsRead(d *schema.ResourceData, meta interface{}) e
 	limit := uint64(NAT_DESCRIBE_LIMIT)
 	request.Limit = &limit
 	for {
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeNatGatewayDestinationIpPortTranslationNatRules(request)
+		err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeNatGatewayDestinationIpPortTranslationNatRulesWithContext(ctx, request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -191,7 +193,7 @@ func dataSourceTencentCloudDnatsRead(d *schema.ResourceData, meta interface{}) e
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s read DNAT failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		} else {
 			result = append(result, response.Response.NatGatewayDestinationIpPortTranslationNatRuleSet...)
 			if len(response.Response.NatGatewayDestinationIpPortTranslationNatRuleSet) < NAT_DESCRIBE_LIMIT {
@@ -224,13 +226,13 @@ func dataSourceTencentCloudDnatsRead(d *schema.ResourceData, meta interface{}) e
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("dnat_list", dnatList); e != nil {
 		log.Printf("[CRITAL]%s provider set DNAT list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), dnatList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
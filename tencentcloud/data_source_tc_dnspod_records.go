@@ -37,6 +37,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dnspod "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dnspod/v20210323"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -44,7 +45,7 @@ import (
 
 func dataSourceTencentCloudDnspodRecords() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDnspodRead,
+		ReadContext: dataSourceTencentCloudDnspodRead,
 		Schema: map[string]*schema.Schema{
 			"domain": {
 				Description:  "The domain for which DNS records are to be obtained.",
@@ -210,10 +211,10 @@ func dataSourceTencentCloudDnspodRecords() *schema.Resource {
 		},
 	}
 }
-func dataSourceTencentCloudDnspodRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDnspodRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("tencentcloud_dnspod_records.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := DnspodService{client}
 
@@ -258,7 +259,7 @@ func dataSourceTencentCloudDnspodRead(d *schema.ResourceData, meta interface{})
 	list, info, err := service.DescribeRecordList(ctx, request)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("dnspod_records" + helper.DataResourceIdHash(request.ToJsonString()))
@@ -289,12 +290,12 @@ func dataSourceTencentCloudDnspodRead(d *schema.ResourceData, meta interface{})
 		"total_count":     info.TotalCount,
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("result", result)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
@@ -304,7 +305,7 @@ func dataSourceTencentCloudDnspodRead(d *schema.ResourceData, meta interface{})
 			"result":            result,
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	domain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/domain/v20180808"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -22,9 +23,9 @@ import (
 
 func dataSourceTencentCloudDomains() *schema.Resource {
 	return &schema.Resource{
-		Read: datasourceTencentCloudDomainsRead,
+		ReadContext: datasourceTencentCloudDomainsRead,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"offset": {
@@ -102,12 +103,12 @@ func dataSourceTencentCloudDomains() *schema.Resource {
 	}
 }
 
-func datasourceTencentCloudDomainsRead(d *schema.ResourceData, meta interface{}) error {
+func datasourceTencentCloudDomainsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("datasource.tencentcloud_domains.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := DomainService{client}
@@ -125,7 +126,7 @@ func datasourceTencentCloudDomainsRead(d *schema.ResourceData, meta interface{})
 
 	if err != nil {
 		d.SetId("")
-		return err
+		return diag.FromErr(err)
 	}
 
 	list := make([]interface{}, 0, len(result))
@@ -149,11 +150,11 @@ func datasourceTencentCloudDomainsRead(d *schema.ResourceData, meta interface{})
 
 	d.SetId("domains-" + helper.DataResourceIdsHash(ids))
 	if err := d.Set("list", list); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if output, ok := d.GetOk("result_output_file"); ok {
-		return writeToFile(output.(string), result)
+		return diag.FromErr(writeToFile(output.(string), result))
 	}
 
 	return nil
@@ -15,6 +15,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudDtsCompareTasks() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDtsCompareTasksRead,
+		ReadContext: dataSourceTencentCloudDtsCompareTasksRead,
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Type:        schema.TypeString,
@@ -418,12 +419,12 @@ func dataSourceTencentCloudDtsCompareTasks() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDtsCompareTasksRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDtsCompareTasksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dts_compare_tasks.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("job_id"); ok {
@@ -433,7 +434,7 @@ func dataSourceTencentCloudDtsCompareTasksRead(d *schema.ResourceData, meta inte
 	dtsService := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var compareTaskItems []*dts.CompareTaskItem
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := dtsService.DescribeDtsCompareTasksByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -443,7 +444,7 @@ func dataSourceTencentCloudDtsCompareTasksRead(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Dts items failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(compareTaskItems))
@@ -714,7 +715,7 @@ func dataSourceTencentCloudDtsCompareTasksRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), itemList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
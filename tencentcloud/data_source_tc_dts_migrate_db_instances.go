@@ -23,6 +23,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
@@ -31,7 +32,7 @@ import (
 
 func dataSourceTencentCloudDtsMigrateDbInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDtsMigrateDbInstancesRead,
+		ReadContext: dataSourceTencentCloudDtsMigrateDbInstancesRead,
 		Schema: map[string]*schema.Schema{
 			"database_type": {
 				Required:    true,
@@ -148,13 +149,13 @@ func dataSourceTencentCloudDtsMigrateDbInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDtsMigrateDbInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDtsMigrateDbInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dts_migrate_db_instances.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("database_type"); ok {
@@ -193,7 +194,7 @@ func dataSourceTencentCloudDtsMigrateDbInstancesRead(d *schema.ResourceData, met
 
 	var instances []*dts.MigrateDBItem
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDtsMigrateDbInstancesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -202,7 +203,7 @@ func dataSourceTencentCloudDtsMigrateDbInstancesRead(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(instances))
@@ -247,7 +248,7 @@ func dataSourceTencentCloudDtsMigrateDbInstancesRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
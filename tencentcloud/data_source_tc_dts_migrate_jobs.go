@@ -47,6 +47,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
@@ -55,7 +56,7 @@ import (
 
 func dataSourceTencentCloudDtsMigrateJobs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDtsMigrateJobsRead,
+		ReadContext: dataSourceTencentCloudDtsMigrateJobsRead,
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Type:        schema.TypeString,
@@ -777,12 +778,12 @@ func dataSourceTencentCloudDtsMigrateJobs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDtsMigrateJobsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDtsMigrateJobsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dts_migrate_jobs.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("job_id"); ok {
@@ -886,7 +887,7 @@ func dataSourceTencentCloudDtsMigrateJobsRead(d *schema.ResourceData, meta inter
 	dtsService := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var jobItems []*dts.JobItem
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := dtsService.DescribeDtsMigrateJobsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -896,7 +897,7 @@ func dataSourceTencentCloudDtsMigrateJobsRead(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Dts jobList failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(jobItems))
@@ -1287,7 +1288,7 @@ func dataSourceTencentCloudDtsMigrateJobsRead(d *schema.ResourceData, meta inter
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), jobList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
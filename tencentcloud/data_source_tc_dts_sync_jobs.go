@@ -31,6 +31,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
@@ -39,7 +40,7 @@ import (
 
 func dataSourceTencentCloudDtsSyncJobs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudDtsSyncJobsRead,
+		ReadContext: dataSourceTencentCloudDtsSyncJobsRead,
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Type:        schema.TypeString,
@@ -848,12 +849,12 @@ func dataSourceTencentCloudDtsSyncJobs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudDtsSyncJobsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudDtsSyncJobsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_dts_sync_jobs.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("job_id"); ok {
@@ -915,7 +916,7 @@ func dataSourceTencentCloudDtsSyncJobsRead(d *schema.ResourceData, meta interfac
 	dtsService := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var jobInfos []*dts.SyncJobInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := dtsService.DescribeDtsSyncJobsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -925,7 +926,7 @@ func dataSourceTencentCloudDtsSyncJobsRead(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Dts jobList failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(jobInfos))
@@ -1387,7 +1388,7 @@ func dataSourceTencentCloudDtsSyncJobsRead(d *schema.ResourceData, meta interfac
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), jobList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
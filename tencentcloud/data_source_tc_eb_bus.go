@@ -30,6 +30,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	eb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/eb/v20210416"
@@ -38,7 +39,7 @@ import (
 
 func dataSourceTencentCloudEbBus() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudEbBusRead,
+		ReadContext: dataSourceTencentCloudEbBusRead,
 		Schema: map[string]*schema.Schema{
 			"order_by": {
 				Optional:    true,
@@ -167,13 +168,13 @@ func dataSourceTencentCloudEbBus() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudEbBusRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudEbBusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_eb_bus.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("order_by"); ok {
@@ -208,7 +209,7 @@ func dataSourceTencentCloudEbBusRead(d *schema.ResourceData, meta interface{}) e
 
 	var eventBuses []*eb.EventBus
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeEbBusByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -217,7 +218,7 @@ func dataSourceTencentCloudEbBusRead(d *schema.ResourceData, meta interface{}) e
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(eventBuses))
@@ -304,7 +305,7 @@ func dataSourceTencentCloudEbBusRead(d *schema.ResourceData, meta interface{}) e
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
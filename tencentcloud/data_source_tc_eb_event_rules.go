@@ -41,6 +41,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	eb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/eb/v20210416"
@@ -49,7 +50,7 @@ import (
 
 func dataSourceTencentCloudEbEventRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudEbEventRulesRead,
+		ReadContext: dataSourceTencentCloudEbEventRulesRead,
 		Schema: map[string]*schema.Schema{
 			"event_bus_id": {
 				Required:    true,
@@ -180,13 +181,13 @@ func dataSourceTencentCloudEbEventRules() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudEbEventRulesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudEbEventRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_eb_event_rules.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("event_bus_id"); ok {
@@ -205,7 +206,7 @@ func dataSourceTencentCloudEbEventRulesRead(d *schema.ResourceData, meta interfa
 
 	var rules []*eb.Rule
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeEbEventRulesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -214,7 +215,7 @@ func dataSourceTencentCloudEbEventRulesRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(rules))
@@ -310,7 +311,7 @@ func dataSourceTencentCloudEbEventRulesRead(d *schema.ResourceData, meta interfa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -67,6 +67,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	eb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/eb/v20210416"
@@ -75,7 +76,7 @@ import (
 
 func dataSourceTencentCloudEbSearch() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudEbSearchRead,
+		ReadContext: dataSourceTencentCloudEbSearchRead,
 		Schema: map[string]*schema.Schema{
 			"start_time": {
 				Required:    true,
@@ -238,12 +239,12 @@ func dataSourceTencentCloudEbSearch() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudEbSearchRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudEbSearchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_eb_search.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		startTime  string
@@ -327,7 +328,7 @@ func dataSourceTencentCloudEbSearchRead(d *schema.ResourceData, meta interface{}
 
 	if groupField != "" {
 		var searchResults []*string
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			response, e := service.DescribeEbSearchByFilter(ctx, paramMap)
 			if e != nil {
 				return retryError(e)
@@ -336,7 +337,7 @@ func dataSourceTencentCloudEbSearchRead(d *schema.ResourceData, meta interface{}
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if searchResults != nil {
@@ -345,7 +346,7 @@ func dataSourceTencentCloudEbSearchRead(d *schema.ResourceData, meta interface{}
 	}
 
 	var results []*eb.SearchLogResult
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeEbSearchLogByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -354,7 +355,7 @@ func dataSourceTencentCloudEbSearchRead(d *schema.ResourceData, meta interface{}
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(results))
@@ -404,7 +405,7 @@ func dataSourceTencentCloudEbSearchRead(d *schema.ResourceData, meta interface{}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
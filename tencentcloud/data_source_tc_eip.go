@@ -22,6 +22,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -34,7 +35,7 @@ var (
 func dataSourceTencentCloudEip() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This data source has been deprecated in Terraform TencentCloud provider version 1.20.0. Please use 'tencentcloud_eips' instead.",
-		Read:               dataSourceTencentCloudEipRead,
+		ReadContext:        dataSourceTencentCloudEipRead,
 
 		Schema: map[string]*schema.Schema{
 			"filter": {
@@ -86,11 +87,11 @@ func dataSourceTencentCloudEip() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudEipRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudEipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_eip.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	vpcService := VpcService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -111,7 +112,7 @@ func dataSourceTencentCloudEipRead(d *schema.ResourceData, meta interface{}) err
 
 	var eips []*vpc.Address
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		eips, errRet = vpcService.DescribeEipByFilter(ctx, filter)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -119,7 +120,7 @@ func dataSourceTencentCloudEipRead(d *schema.ResourceData, meta interface{}) err
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	includeArrears := false
@@ -132,7 +133,7 @@ func dataSourceTencentCloudEipRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if len(eips) == 0 {
-		return errEIPNotFound
+		return diag.FromErr(errEIPNotFound)
 	}
 
 	var filteredEips []*vpc.Address
@@ -147,7 +148,7 @@ func dataSourceTencentCloudEipRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if len(filteredEips) == 0 {
-		return errEIPNotFound
+		return diag.FromErr(errEIPNotFound)
 	}
 
 	eip := filteredEips[0]
@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudEipAddressQuota() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudEipAddressQuotaRead,
+		ReadContext: dataSourceTencentCloudEipAddressQuotaRead,
 		Schema: map[string]*schema.Schema{
 			"quota_set": {
 				Computed:    true,
@@ -56,19 +57,19 @@ func dataSourceTencentCloudEipAddressQuota() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudEipAddressQuotaRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudEipAddressQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_address_quota.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var quotaSet []*vpc.Quota
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeEipAddressQuota(ctx)
 		if e != nil {
 			return retryError(e)
@@ -77,7 +78,7 @@ func dataSourceTencentCloudEipAddressQuotaRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(quotaSet))
@@ -110,7 +111,7 @@ func dataSourceTencentCloudEipAddressQuotaRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
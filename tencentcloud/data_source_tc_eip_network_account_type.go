@@ -12,13 +12,14 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTencentCloudEipNetworkAccountType() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudEipNetworkAccountTypeRead,
+		ReadContext: dataSourceTencentCloudEipNetworkAccountTypeRead,
 		Schema: map[string]*schema.Schema{
 			"network_account_type": {
 				Computed:    true,
@@ -35,18 +36,18 @@ func dataSourceTencentCloudEipNetworkAccountType() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudEipNetworkAccountTypeRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudEipNetworkAccountTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_eip_network_account_type.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var networkAccountType *string
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeEipNetworkAccountType(ctx)
 		if e != nil {
 			return retryError(e)
@@ -55,7 +56,7 @@ func dataSourceTencentCloudEipNetworkAccountTypeRead(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if networkAccountType != nil {
@@ -66,7 +67,7 @@ func dataSourceTencentCloudEipNetworkAccountTypeRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), *networkAccountType); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
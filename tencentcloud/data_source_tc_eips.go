@@ -15,6 +15,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudEips() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudEipsRead,
+		ReadContext: dataSourceTencentCloudEipsRead,
 
 		Schema: map[string]*schema.Schema{
 			"eip_id": {
@@ -110,10 +111,10 @@ func dataSourceTencentCloudEips() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudEipsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudEipsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_eips.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := meta.(*TencentCloudClient).apiV3Conn
 	vpcService := VpcService{client: client}
@@ -135,7 +136,7 @@ func dataSourceTencentCloudEipsRead(d *schema.ResourceData, meta interface{}) er
 
 	var eips []*vpc.Address
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		eips, errRet = vpcService.DescribeEipByFilter(ctx, filter)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -143,7 +144,7 @@ func dataSourceTencentCloudEipsRead(d *schema.ResourceData, meta interface{}) er
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	eipList := make([]map[string]interface{}, 0, len(eips))
@@ -154,7 +155,7 @@ EIP_LOOP:
 		respTags, err := tagService.DescribeResourceTags(ctx, VPC_SERVICE_TYPE, EIP_RESOURCE_TYPE, region, *eip.AddressId)
 		if err != nil {
 			log.Printf("[CRITAL]%s describe eip tags failed: %+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		for k, v := range tags {
@@ -183,13 +184,13 @@ EIP_LOOP:
 	err = d.Set("eip_list", eipList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set eip list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), eipList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
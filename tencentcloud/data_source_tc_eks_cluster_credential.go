@@ -38,6 +38,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 )
@@ -45,10 +46,10 @@ import (
 func datasourceTencentCloudEksClusterCredential() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource was offline no longer supported.",
-		Read:               datasourceTencentCloudEksClusterCredentialRead,
+		ReadContext:        datasourceTencentCloudEksClusterCredentialRead,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -160,12 +161,12 @@ func datasourceTencentCloudEksClusterCredential() *schema.Resource {
 	}
 }
 
-func datasourceTencentCloudEksClusterCredentialRead(d *schema.ResourceData, meta interface{}) error {
+func datasourceTencentCloudEksClusterCredentialRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("datasource.tencentcloud_eks_cluster_credential.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := EksService{client: client}
 
@@ -178,7 +179,7 @@ func datasourceTencentCloudEksClusterCredentialRead(d *schema.ResourceData, meta
 
 	if err != nil {
 		d.SetId("")
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("eks-cluster-credential-" + clusterId)
@@ -242,7 +243,7 @@ func datasourceTencentCloudEksClusterCredentialRead(d *schema.ResourceData, meta
 	}
 
 	if output, ok := d.GetOk("result_output_file"); ok {
-		return writeToFile(output.(string), result)
+		return diag.FromErr(writeToFile(output.(string), result))
 	}
 
 	return nil
@@ -17,6 +17,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -25,7 +26,7 @@ import (
 func dataSourceTencentCloudEKSClusters() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource was offline and no longer supported.",
-		Read:               dataSourceTencentCloudEKSClustersRead,
+		ReadContext:        dataSourceTencentCloudEKSClustersRead,
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -139,11 +140,11 @@ func dataSourceTencentCloudEKSClusters() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudEKSClustersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudEKSClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_eks_clusters.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := EksService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -166,7 +167,7 @@ func dataSourceTencentCloudEKSClustersRead(d *schema.ResourceData, meta interfac
 
 	infos, err := service.DescribeEKSClusters(ctx, id, name)
 	if err != nil && id == "" {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			infos, err = service.DescribeEKSClusters(ctx, id, name)
 			if err != nil {
 				return retryError(err)
@@ -176,7 +177,7 @@ func dataSourceTencentCloudEKSClustersRead(d *schema.ResourceData, meta interfac
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	list := make([]map[string]interface{}, 0, len(infos))
@@ -214,13 +215,13 @@ LOOP:
 	err = d.Set("list", list)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set tencentcloud_eks_clusters list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err = writeToFile(output.(string), list); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -15,6 +15,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	es "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/es/v20180416"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudElasticsearchInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudElasticsearchInstancesRead,
+		ReadContext: dataSourceTencentCloudElasticsearchInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -199,11 +200,11 @@ func dataSourceTencentCloudElasticsearchInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudElasticsearchInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudElasticsearchInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_elasticsearch_instances.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	elasticsearchService := ElasticsearchService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -219,7 +220,7 @@ func dataSourceTencentCloudElasticsearchInstancesRead(d *schema.ResourceData, me
 	tags := helper.GetTags(d, "tags")
 	var instances []*es.InstanceInfo
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		instances, errRet = elasticsearchService.DescribeInstancesByFilter(ctx, instanceId, instanceName, tags)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -295,13 +296,13 @@ func dataSourceTencentCloudElasticsearchInstancesRead(d *schema.ResourceData, me
 	err = d.Set("instance_list", instanceList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set elasticsearch instance list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), instanceList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -18,6 +18,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	emr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/emr/v20190103"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudEmr() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudEmrRead,
+		ReadContext: dataSourceTencentCloudEmrRead,
 
 		Schema: map[string]*schema.Schema{
 			"display_strategy": {
@@ -123,11 +124,11 @@ func dataSourceTencentCloudEmr() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudEmrRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudEmrRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_emr.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	emrServer := EMRService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -145,7 +146,7 @@ func dataSourceTencentCloudEmrRead(d *schema.ResourceData, meta interface{}) err
 	}
 	var clusters []*emr.ClusterInstancesInfo
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		clusters, errRet = emrServer.DescribeInstances(ctx, filters)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -153,7 +154,7 @@ func dataSourceTencentCloudEmrRead(d *schema.ResourceData, meta interface{}) err
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	emr_instances := make([]map[string]interface{}, 0, len(clusters))
@@ -178,12 +179,12 @@ func dataSourceTencentCloudEmrRead(d *schema.ResourceData, meta interface{}) err
 	err = d.Set("clusters", emr_instances)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set cluster list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), emr_instances); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	emr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/emr/v20190103"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudEmrNodes() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudEmrNodesRead,
+		ReadContext: dataSourceTencentCloudEmrNodesRead,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -398,11 +399,11 @@ func dataSourceTencentCloudEmrNodes() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudEmrNodesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudEmrNodesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_emr_nodes.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Get("instance_id").(string)
 	nodeFlag := d.Get("node_flag").(string)
@@ -414,7 +415,7 @@ func dataSourceTencentCloudEmrNodesRead(d *schema.ResourceData, meta interface{}
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var nodes []*emr.NodeHardwareInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		var errRet error
 		nodes, errRet = emrServer.DescribeClusterNodes(ctx, instanceId, nodeFlag, hardwareResourceType, offset, limit)
 		if errRet != nil {
@@ -423,7 +424,7 @@ func dataSourceTencentCloudEmrNodesRead(d *schema.ResourceData, meta interface{}
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	emrNodes := make([]map[string]interface{}, 0)
@@ -517,7 +518,7 @@ func dataSourceTencentCloudEmrNodesRead(d *schema.ResourceData, meta interface{}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), emrNodes); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -15,6 +15,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudEnis() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudEnisRead,
+		ReadContext: dataSourceTencentCloudEnisRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:          schema.TypeSet,
@@ -185,10 +186,10 @@ func dataSourceTencentCloudEnis() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudEnisRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceTencentCloudEnisRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_enis.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: m.(*TencentCloudClient).apiV3Conn}
 
@@ -242,7 +243,7 @@ func dataSourceTencentCloudEnisRead(d *schema.ResourceData, m interface{}) error
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	enis := make([]map[string]interface{}, 0, len(respEnis))
@@ -299,7 +300,7 @@ func dataSourceTencentCloudEnisRead(d *schema.ResourceData, m interface{}) error
 		if err := writeToFile(output.(string), enis); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%v]",
 				logId, output.(string), err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
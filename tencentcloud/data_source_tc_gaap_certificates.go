@@ -19,9 +19,9 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -29,7 +29,7 @@ import (
 
 func dataSourceTencentCloudGaapCertificates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudGaapCertificatesRead,
+		ReadContext: dataSourceTencentCloudGaapCertificatesRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -107,10 +107,10 @@ func dataSourceTencentCloudGaapCertificates() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudGaapCertificatesRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceTencentCloudGaapCertificatesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_gaap_certificates.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		id              *string
@@ -134,7 +134,7 @@ func dataSourceTencentCloudGaapCertificatesRead(d *schema.ResourceData, m interf
 
 	respCertificates, err := service.DescribeCertificates(ctx, id, name, certificateType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids = make([]string, 0, len(respCertificates))
@@ -147,7 +147,7 @@ func dataSourceTencentCloudGaapCertificatesRead(d *schema.ResourceData, m interf
 			ok              bool
 		)
 		if certificateType, ok = gaapCertificateIntMap[int(*certificate.CertificateType)]; !ok {
-			return fmt.Errorf("unknown certificate type %d", *certificate.CertificateType)
+			return diag.Errorf("unknown certificate type %d", *certificate.CertificateType)
 		}
 
 		m := map[string]interface{}{
@@ -183,7 +183,7 @@ func dataSourceTencentCloudGaapCertificatesRead(d *schema.ResourceData, m interf
 		if err := writeToFile(output.(string), certificates); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
 				logId, output.(string), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
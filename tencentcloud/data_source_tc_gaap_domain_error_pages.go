@@ -49,13 +49,14 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudGaapDomainErrorPageInfoList() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudGaapDomainErrorPageInfoListRead,
+		ReadContext: dataSourceTencentCloudGaapDomainErrorPageInfoListRead,
 		Schema: map[string]*schema.Schema{
 			"listener_id": {
 				Type:        schema.TypeString,
@@ -136,10 +137,10 @@ func dataSourceTencentCloudGaapDomainErrorPageInfoList() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudGaapDomainErrorPageInfoListRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceTencentCloudGaapDomainErrorPageInfoListRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_gaap_domain_error_pages.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	listenerId := d.Get("listener_id").(string)
 	domain := d.Get("domain").(string)
@@ -154,7 +155,7 @@ func dataSourceTencentCloudGaapDomainErrorPageInfoListRead(d *schema.ResourceDat
 
 	respList, err := service.DescribeDomainErrorPageInfoList(ctx, listenerId, domain)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	list := make([]map[string]interface{}, 0, len(respList))
@@ -195,7 +196,7 @@ func dataSourceTencentCloudGaapDomainErrorPageInfoListRead(d *schema.ResourceDat
 		if err := writeToFile(output.(string), list); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%v]",
 				logId, output.(string), err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -36,13 +36,14 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudGaapHttpDomains() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudGaapHttpDomainsRead,
+		ReadContext: dataSourceTencentCloudGaapHttpDomainsRead,
 		Schema: map[string]*schema.Schema{
 			"listener_id": {
 				Type:        schema.TypeString,
@@ -138,10 +139,10 @@ func dataSourceTencentCloudGaapHttpDomains() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudGaapHttpDomainsRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceTencentCloudGaapHttpDomainsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_gaap_http_domains.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	listenerId := d.Get("listener_id").(string)
 	domain := d.Get("domain").(string)
@@ -150,7 +151,7 @@ func dataSourceTencentCloudGaapHttpDomainsRead(d *schema.ResourceData, m interfa
 
 	domainRules, err := service.DescribeDomains(ctx, listenerId, domain)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(domainRules))
@@ -233,7 +234,7 @@ func dataSourceTencentCloudGaapHttpDomainsRead(d *schema.ResourceData, m interfa
 		if err := writeToFile(output.(string), domains); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%v]",
 				logId, output.(string), err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
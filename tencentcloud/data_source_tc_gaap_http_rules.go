@@ -49,17 +49,17 @@ package tencentcloud
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log"
 	"net"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudGaapHttpRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudGaapHttpRulesRead,
+		ReadContext: dataSourceTencentCloudGaapHttpRulesRead,
 		Schema: map[string]*schema.Schema{
 			"listener_id": {
 				Type:        schema.TypeString,
@@ -217,10 +217,10 @@ func dataSourceTencentCloudGaapHttpRules() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudGaapHttpRulesRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceTencentCloudGaapHttpRulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_gaap_http_rules.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	listenerId := d.Get("listener_id").(string)
 
@@ -246,7 +246,7 @@ func dataSourceTencentCloudGaapHttpRulesRead(d *schema.ResourceData, m interface
 
 	domainRuleSets, err := service.DescribeDomains(ctx, listenerId, domain)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids = make([]string, 0, len(domainRuleSets))
@@ -263,7 +263,7 @@ func dataSourceTencentCloudGaapHttpRulesRead(d *schema.ResourceData, m interface
 				"CheckParams":    "health check params",
 				"ForwardHost":    "forward host",
 			}); len(nilFields) > 0 {
-				return fmt.Errorf("rule %v are nil", nilFields)
+				return diag.Errorf("rule %v are nil", nilFields)
 			}
 
 			checkParams := rule.CheckParams
@@ -274,11 +274,11 @@ func dataSourceTencentCloudGaapHttpRulesRead(d *schema.ResourceData, m interface
 				"Path":           "path",
 				"Method":         "method",
 			}); len(nilFields) > 0 {
-				return fmt.Errorf("rule health check %v are nil", nilFields)
+				return diag.Errorf("rule health check %v are nil", nilFields)
 			}
 
 			if len(checkParams.StatusCode) == 0 {
-				return errors.New("rule health check status codes set is empty")
+				return diag.FromErr(errors.New("rule health check status codes set is empty"))
 			}
 
 			if path != nil && *rule.Path != *path {
@@ -322,7 +322,7 @@ func dataSourceTencentCloudGaapHttpRulesRead(d *schema.ResourceData, m interface
 					"RealServerWeight": "weight",
 					"RealServerStatus": "status",
 				}); len(nilFields) > 0 {
-					return fmt.Errorf("realserver %v are nil", nilFields)
+					return diag.Errorf("realserver %v are nil", nilFields)
 				}
 
 				realserver := map[string]interface{}{
@@ -354,7 +354,7 @@ func dataSourceTencentCloudGaapHttpRulesRead(d *schema.ResourceData, m interface
 		if err := writeToFile(output.(string), rules); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%v]",
 				logId, output.(string), err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -48,6 +48,7 @@ import (
 	"errors"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -55,7 +56,7 @@ import (
 
 func dataSourceTencentCloudGaapLayer4Listeners() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudGaapLayer4ListenersRead,
+		ReadContext: dataSourceTencentCloudGaapLayer4ListenersRead,
 		Schema: map[string]*schema.Schema{
 			"protocol": {
 				Type:         schema.TypeString,
@@ -164,10 +165,10 @@ func dataSourceTencentCloudGaapLayer4Listeners() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudGaapLayer4ListenersRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceTencentCloudGaapLayer4ListenersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_gaap_layer4_listeners.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	protocol := d.Get("protocol").(string)
 
@@ -188,7 +189,7 @@ func dataSourceTencentCloudGaapLayer4ListenersRead(d *schema.ResourceData, m int
 	}
 
 	if proxyId == nil && listenerId == nil {
-		return errors.New("proxy_id or listener_id must be set")
+		return diag.FromErr(errors.New("proxy_id or listener_id must be set"))
 	}
 
 	if raw, ok := d.GetOk("listener_name"); ok {
@@ -204,7 +205,7 @@ func dataSourceTencentCloudGaapLayer4ListenersRead(d *schema.ResourceData, m int
 	case "TCP":
 		tcpListeners, err := service.DescribeTCPListeners(ctx, proxyId, listenerId, name, port)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		ids = make([]string, 0, len(tcpListeners))
@@ -238,7 +239,7 @@ func dataSourceTencentCloudGaapLayer4ListenersRead(d *schema.ResourceData, m int
 	case "UDP":
 		udpListeners, err := service.DescribeUDPListeners(ctx, proxyId, listenerId, name, port)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		ids = make([]string, 0, len(udpListeners))
@@ -270,7 +271,7 @@ func dataSourceTencentCloudGaapLayer4ListenersRead(d *schema.ResourceData, m int
 		if err := writeToFile(output.(string), listeners); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%v]",
 				logId, output.(string), err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -33,6 +33,7 @@ import (
 	"errors"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -40,7 +41,7 @@ import (
 
 func dataSourceTencentCloudGaapLayer7Listeners() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudGaapLayer7ListenersRead,
+		ReadContext: dataSourceTencentCloudGaapLayer7ListenersRead,
 		Schema: map[string]*schema.Schema{
 			"protocol": {
 				Type:         schema.TypeString,
@@ -151,10 +152,10 @@ func dataSourceTencentCloudGaapLayer7Listeners() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudGaapLayer7ListenersRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceTencentCloudGaapLayer7ListenersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_gaap_layer7_listeners.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	protocol := d.Get("protocol").(string)
 
@@ -175,7 +176,7 @@ func dataSourceTencentCloudGaapLayer7ListenersRead(d *schema.ResourceData, m int
 	}
 
 	if proxyId == nil && listenerId == nil {
-		return errors.New("proxy_id or listener_id must be set")
+		return diag.FromErr(errors.New("proxy_id or listener_id must be set"))
 	}
 
 	if raw, ok := d.GetOk("listener_name"); ok {
@@ -191,7 +192,7 @@ func dataSourceTencentCloudGaapLayer7ListenersRead(d *schema.ResourceData, m int
 	case "HTTP":
 		httpListeners, err := service.DescribeHTTPListeners(ctx, proxyId, listenerId, name, port)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		ids = make([]string, 0, len(httpListeners))
@@ -199,19 +200,19 @@ func dataSourceTencentCloudGaapLayer7ListenersRead(d *schema.ResourceData, m int
 
 		for _, ls := range httpListeners {
 			if ls.ListenerId == nil {
-				return errors.New("listener id is nil")
+				return diag.FromErr(errors.New("listener id is nil"))
 			}
 			if ls.ListenerName == nil {
-				return errors.New("listener name is nil")
+				return diag.FromErr(errors.New("listener name is nil"))
 			}
 			if ls.Port == nil {
-				return errors.New("listener port is nil")
+				return diag.FromErr(errors.New("listener port is nil"))
 			}
 			if ls.ListenerStatus == nil {
-				return errors.New("listener status is nil")
+				return diag.FromErr(errors.New("listener status is nil"))
 			}
 			if ls.CreateTime == nil {
-				return errors.New("listener create time is nil")
+				return diag.FromErr(errors.New("listener create time is nil"))
 			}
 
 			ids = append(ids, *ls.ListenerId)
@@ -230,7 +231,7 @@ func dataSourceTencentCloudGaapLayer7ListenersRead(d *schema.ResourceData, m int
 	case "HTTPS":
 		httpsListeners, err := service.DescribeHTTPSListeners(ctx, proxyId, listenerId, name, port)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		ids = make([]string, 0, len(httpsListeners))
@@ -238,28 +239,28 @@ func dataSourceTencentCloudGaapLayer7ListenersRead(d *schema.ResourceData, m int
 
 		for _, ls := range httpsListeners {
 			if ls.ListenerId == nil {
-				return errors.New("listener id is nil")
+				return diag.FromErr(errors.New("listener id is nil"))
 			}
 			if ls.ListenerName == nil {
-				return errors.New("listener name is nil")
+				return diag.FromErr(errors.New("listener name is nil"))
 			}
 			if ls.Port == nil {
-				return errors.New("listener port is nil")
+				return diag.FromErr(errors.New("listener port is nil"))
 			}
 			if ls.ListenerStatus == nil {
-				return errors.New("listener status is nil")
+				return diag.FromErr(errors.New("listener status is nil"))
 			}
 			if ls.CertificateId == nil {
-				return errors.New("listener certificate id is nil")
+				return diag.FromErr(errors.New("listener certificate id is nil"))
 			}
 			if ls.AuthType == nil {
-				return errors.New("listener auth type is nil")
+				return diag.FromErr(errors.New("listener auth type is nil"))
 			}
 			if ls.ForwardProtocol == nil {
-				return errors.New("listener forward protocol is nil")
+				return diag.FromErr(errors.New("listener forward protocol is nil"))
 			}
 			if ls.CreateTime == nil {
-				return errors.New("listener create time is nil")
+				return diag.FromErr(errors.New("listener create time is nil"))
 			}
 
 			ids = append(ids, *ls.ListenerId)
@@ -302,7 +303,7 @@ func dataSourceTencentCloudGaapLayer7ListenersRead(d *schema.ResourceData, m int
 		if err := writeToFile(output.(string), listeners); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%v]",
 				logId, output.(string), err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
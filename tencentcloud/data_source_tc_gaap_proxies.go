@@ -24,6 +24,7 @@ import (
 	"errors"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -31,7 +32,7 @@ import (
 
 func dataSourceTencentCloudGaapProxies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudGaapProxiesRead,
+		ReadContext: dataSourceTencentCloudGaapProxiesRead,
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:          schema.TypeSet,
@@ -171,10 +172,10 @@ func dataSourceTencentCloudGaapProxies() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudGaapProxiesRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceTencentCloudGaapProxiesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_gaap_proxies.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		ids              []string
@@ -209,56 +210,56 @@ func dataSourceTencentCloudGaapProxiesRead(d *schema.ResourceData, m interface{}
 
 	proxies, err := service.DescribeProxies(ctx, ids, projectId, accessRegion, realserverRegion, tags)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	proxyIds := make([]string, 0, len(proxies))
 	respProxies := make([]map[string]interface{}, 0, len(proxies))
 	for _, proxy := range proxies {
 		if proxy.ProxyId == nil {
-			return errors.New("proxy id is nil")
+			return diag.FromErr(errors.New("proxy id is nil"))
 		}
 		if proxy.ProxyName == nil {
-			return errors.New("proxy name is nil")
+			return diag.FromErr(errors.New("proxy name is nil"))
 		}
 		if proxy.Domain == nil {
-			return errors.New("proxy domain is nil")
+			return diag.FromErr(errors.New("proxy domain is nil"))
 		}
 		if proxy.IP == nil {
-			return errors.New("proxy ip is nil")
+			return diag.FromErr(errors.New("proxy ip is nil"))
 		}
 		if proxy.Bandwidth == nil {
-			return errors.New("proxy bandwidth is nil")
+			return diag.FromErr(errors.New("proxy bandwidth is nil"))
 		}
 		if proxy.Concurrent == nil {
-			return errors.New("proxy concurrent is nil")
+			return diag.FromErr(errors.New("proxy concurrent is nil"))
 		}
 		if proxy.AccessRegion == nil {
-			return errors.New("proxy access region is nil")
+			return diag.FromErr(errors.New("proxy access region is nil"))
 		}
 		if proxy.RealServerRegion == nil {
-			return errors.New("proxy realserver region is nil")
+			return diag.FromErr(errors.New("proxy realserver region is nil"))
 		}
 		if proxy.ProjectId == nil {
-			return errors.New("proxy project id is nil")
+			return diag.FromErr(errors.New("proxy project id is nil"))
 		}
 		if proxy.CreateTime == nil {
-			return errors.New("proxy create time is nil")
+			return diag.FromErr(errors.New("proxy create time is nil"))
 		}
 		if proxy.Status == nil {
-			return errors.New("proxy status is nil")
+			return diag.FromErr(errors.New("proxy status is nil"))
 		}
 		if proxy.Scalarable == nil {
-			return errors.New("proxy scalable is nil")
+			return diag.FromErr(errors.New("proxy scalable is nil"))
 		}
 		if proxy.SupportProtocols == nil {
-			return errors.New("proxy support protocols is nil")
+			return diag.FromErr(errors.New("proxy support protocols is nil"))
 		}
 		if proxy.ForwardIP == nil {
-			return errors.New("proxy forward ip is nil")
+			return diag.FromErr(errors.New("proxy forward ip is nil"))
 		}
 		if proxy.Version == nil {
-			return errors.New("proxy version is nil")
+			return diag.FromErr(errors.New("proxy version is nil"))
 		}
 
 		proxyIds = append(proxyIds, *proxy.ProxyId)
@@ -293,10 +294,10 @@ func dataSourceTencentCloudGaapProxiesRead(d *schema.ResourceData, m interface{}
 		tags := make(map[string]string, len(proxy.TagSet))
 		for _, t := range proxy.TagSet {
 			if t.TagKey == nil {
-				return errors.New("tag key is nil")
+				return diag.FromErr(errors.New("tag key is nil"))
 			}
 			if t.TagValue == nil {
-				return errors.New("tag value is nil")
+				return diag.FromErr(errors.New("tag value is nil"))
 			}
 			tags[*t.TagKey] = *t.TagValue
 		}
@@ -312,7 +313,7 @@ func dataSourceTencentCloudGaapProxiesRead(d *schema.ResourceData, m interface{}
 		if err := writeToFile(output.(string), respProxies); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
 				logId, output.(string), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
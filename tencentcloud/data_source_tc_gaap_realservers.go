@@ -22,13 +22,14 @@ import (
 	"log"
 	"net"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudGaapRealservers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudGaapRealserversRead,
+		ReadContext: dataSourceTencentCloudGaapRealserversRead,
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:        schema.TypeInt,
@@ -108,10 +109,10 @@ func dataSourceTencentCloudGaapRealservers() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudGaapRealserversRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceTencentCloudGaapRealserversRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_gaap_realservers.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	projectId := d.Get("project_id").(int)
 
@@ -135,23 +136,23 @@ func dataSourceTencentCloudGaapRealserversRead(d *schema.ResourceData, m interfa
 
 	realservers, err := service.DescribeRealservers(ctx, address, name, tags, projectId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(realservers))
 	realserverList := make([]map[string]interface{}, 0, len(realservers))
 	for _, rs := range realservers {
 		if rs.RealServerId == nil {
-			return errors.New("realserver id is nil")
+			return diag.FromErr(errors.New("realserver id is nil"))
 		}
 		if rs.RealServerName == nil {
-			return errors.New("realserver name is nil")
+			return diag.FromErr(errors.New("realserver name is nil"))
 		}
 		if rs.RealServerIP == nil {
-			return errors.New("realserver name is nil")
+			return diag.FromErr(errors.New("realserver name is nil"))
 		}
 		if rs.ProjectId == nil {
-			return errors.New("realserver project id is nil")
+			return diag.FromErr(errors.New("realserver project id is nil"))
 		}
 
 		ids = append(ids, *rs.RealServerId)
@@ -172,10 +173,10 @@ func dataSourceTencentCloudGaapRealserversRead(d *schema.ResourceData, m interfa
 			tags := make(map[string]string, len(rs.TagSet))
 			for _, tag := range rs.TagSet {
 				if tag.TagKey == nil {
-					return errors.New("tag key is nil")
+					return diag.FromErr(errors.New("tag key is nil"))
 				}
 				if tag.TagValue == nil {
-					return errors.New("tag value is nil")
+					return diag.FromErr(errors.New("tag value is nil"))
 				}
 				tags[*tag.TagKey] = *tag.TagValue
 			}
@@ -192,7 +193,7 @@ func dataSourceTencentCloudGaapRealserversRead(d *schema.ResourceData, m interfa
 		if err := writeToFile(output.(string), realserverList); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
 				logId, output.(string), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -28,12 +28,13 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTencentCloudGaapSecurityPolices() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudGaapSecurityPoliciesRead,
+		ReadContext: dataSourceTencentCloudGaapSecurityPoliciesRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -66,10 +67,10 @@ func dataSourceTencentCloudGaapSecurityPolices() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudGaapSecurityPoliciesRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceTencentCloudGaapSecurityPoliciesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_gaap_security_policies.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Get("id").(string)
 
@@ -77,7 +78,7 @@ func dataSourceTencentCloudGaapSecurityPoliciesRead(d *schema.ResourceData, m in
 
 	proxyId, status, action, exist, err := service.DescribeSecurityPolicy(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !exist {
@@ -102,7 +103,7 @@ func dataSourceTencentCloudGaapSecurityPoliciesRead(d *schema.ResourceData, m in
 		if err := writeToFile(output.(string), m); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
 				logId, output.(string), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
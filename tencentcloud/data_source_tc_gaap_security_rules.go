@@ -41,13 +41,14 @@ import (
 	"log"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudGaapSecurityRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudGaapSecurityRulesRead,
+		ReadContext: dataSourceTencentCloudGaapSecurityRulesRead,
 		Schema: map[string]*schema.Schema{
 			"policy_id": {
 				Type:        schema.TypeString,
@@ -147,10 +148,10 @@ func dataSourceTencentCloudGaapSecurityRules() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudGaapSecurityRulesRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceTencentCloudGaapSecurityRulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_gaap_security_rules.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	policyId := d.Get("policy_id").(string)
 	var (
@@ -187,7 +188,7 @@ func dataSourceTencentCloudGaapSecurityRulesRead(d *schema.ResourceData, m inter
 
 	securityRules, err := service.DescribeSecurityRules(ctx, policyId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids = make([]string, 0, len(securityRules))
@@ -195,22 +196,22 @@ func dataSourceTencentCloudGaapSecurityRulesRead(d *schema.ResourceData, m inter
 
 	for _, rule := range securityRules {
 		if rule.RuleId == nil {
-			return errors.New("rule id is nil")
+			return diag.FromErr(errors.New("rule id is nil"))
 		}
 		if rule.SourceCidr == nil {
-			return errors.New("rule cidr ip is nil")
+			return diag.FromErr(errors.New("rule cidr ip is nil"))
 		}
 		if rule.AliasName == nil {
-			return errors.New("rule name is nil")
+			return diag.FromErr(errors.New("rule name is nil"))
 		}
 		if rule.DestPortRange == nil {
-			return errors.New("rule port is nil")
+			return diag.FromErr(errors.New("rule port is nil"))
 		}
 		if rule.Protocol == nil {
-			return errors.New("rule protocol is nil")
+			return diag.FromErr(errors.New("rule protocol is nil"))
 		}
 		if rule.Action == nil {
-			return errors.New("rule action is nil")
+			return diag.FromErr(errors.New("rule action is nil"))
 		}
 
 		if ruleId != nil && *ruleId != *rule.RuleId {
@@ -250,7 +251,7 @@ func dataSourceTencentCloudGaapSecurityRulesRead(d *schema.ResourceData, m inter
 		if err := writeToFile(output.(string), rules); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
 				logId, output.(string), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
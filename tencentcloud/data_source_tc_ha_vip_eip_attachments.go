@@ -13,8 +13,10 @@ data "tencentcloud_ha_vip_eip_attachments" "foo" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -24,7 +26,7 @@ import (
 
 func dataSourceTencentCloudHaVipEipAttachments() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudHaVipEipAttachmentsRead,
+		ReadContext: dataSourceTencentCloudHaVipEipAttachmentsRead,
 
 		Schema: map[string]*schema.Schema{
 			"havip_id": {
@@ -65,7 +67,7 @@ func dataSourceTencentCloudHaVipEipAttachments() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudHaVipEipAttachmentsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudHaVipEipAttachmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ha_vip_eip_attachments.read")()
 
 	logId := getLogId(contextNil)
@@ -76,8 +78,8 @@ func dataSourceTencentCloudHaVipEipAttachmentsRead(d *schema.ResourceData, meta
 	request := vpc.NewDescribeHaVipsRequest()
 	request.HaVipIds = []*string{&haVipId}
 	var response *vpc.DescribeHaVipsResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeHaVips(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeHaVipsWithContext(ctx, request)
 		if e != nil {
 			return retryError(errors.WithStack(e))
 		}
@@ -86,7 +88,7 @@ func dataSourceTencentCloudHaVipEipAttachmentsRead(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read HA VIP failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	haVipEipAttachmentList := make([]map[string]interface{}, 0, len(response.Response.HaVipSet))
@@ -108,13 +110,13 @@ func dataSourceTencentCloudHaVipEipAttachmentsRead(d *schema.ResourceData, meta
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("ha_vip_eip_attachment_list", haVipEipAttachmentList); e != nil {
 		log.Printf("[CRITAL]%s provider set HA VIP EIP attachment list fail, reason:%s\n", logId, e)
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), haVipEipAttachmentList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
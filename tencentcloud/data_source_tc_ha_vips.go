@@ -16,8 +16,10 @@ data "tencentcloud_ha_vips" "havips" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -27,7 +29,7 @@ import (
 
 func dataSourceTencentCloudHaVips() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudHaVipsRead,
+		ReadContext: dataSourceTencentCloudHaVipsRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -127,7 +129,7 @@ func dataSourceTencentCloudHaVips() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudHaVipsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudHaVipsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ha_vips.read")()
 
 	logId := getLogId(contextNil)
@@ -165,8 +167,8 @@ func dataSourceTencentCloudHaVipsRead(d *schema.ResourceData, meta interface{})
 	request.Limit = &limit
 	for {
 		var response *vpc.DescribeHaVipsResponse
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeHaVips(request)
+		err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeHaVipsWithContext(ctx, request)
 			if e != nil {
 				return retryError(errors.WithStack(e))
 			}
@@ -175,7 +177,7 @@ func dataSourceTencentCloudHaVipsRead(d *schema.ResourceData, meta interface{})
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s read HA VIP failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		} else {
 			result = append(result, response.Response.HaVipSet...)
 			if len(response.Response.HaVipSet) < HAVIP_DESCRIBE_LIMIT {
@@ -212,13 +214,13 @@ func dataSourceTencentCloudHaVipsRead(d *schema.ResourceData, meta interface{})
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("ha_vip_list", haVipList); e != nil {
 		log.Printf("[CRITAL]%s provider set haVip list fail, reason:%s\n", logId, e)
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), haVipList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -23,10 +23,10 @@ package tencentcloud
 import (
 	"context"
 	"errors"
-	"fmt"
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -35,7 +35,7 @@ import (
 
 func dataSourceTencentCloudImage() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudImageRead,
+		ReadContext: dataSourceTencentCloudImageRead,
 
 		Schema: map[string]*schema.Schema{
 			"filter": {
@@ -89,11 +89,11 @@ func dataSourceTencentCloudImage() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudImageRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_image.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	cvmService := CvmService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -114,7 +114,7 @@ func dataSourceTencentCloudImageRead(d *schema.ResourceData, meta interface{}) e
 
 	var images []*cvm.Image
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		images, errRet = cvmService.DescribeImagesByFilter(ctx, filter, "")
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -122,11 +122,11 @@ func dataSourceTencentCloudImageRead(d *schema.ResourceData, meta interface{}) e
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(images) == 0 {
-		return errors.New("No image found")
+		return diag.FromErr(errors.New("No image found"))
 	}
 
 	var osName string
@@ -140,7 +140,7 @@ func dataSourceTencentCloudImageRead(d *schema.ResourceData, meta interface{}) e
 		regImageName = v.(string)
 		imageNameRegex, err = regexp.Compile(regImageName)
 		if err != nil {
-			return fmt.Errorf("image_name_regex format error,%s", err.Error())
+			return diag.Errorf("image_name_regex format error,%s", err.Error())
 		}
 	}
 
@@ -171,18 +171,18 @@ func dataSourceTencentCloudImageRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if resultImageId == "" {
-		return errors.New("No image found")
+		return diag.FromErr(errors.New("No image found"))
 	}
 
 	d.SetId(helper.DataResourceIdHash(resultImageId))
 	if err := d.Set("image_id", resultImageId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err = writeToFile(output.(string), resultImageId); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
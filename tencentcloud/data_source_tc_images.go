@@ -14,11 +14,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -27,7 +27,7 @@ import (
 
 func dataSourceTencentCloudImages() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudImagesRead,
+		ReadContext: dataSourceTencentCloudImagesRead,
 
 		Schema: map[string]*schema.Schema{
 			"image_id": {
@@ -177,11 +177,11 @@ func dataSourceTencentCloudImages() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudImagesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_images.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	cvmService := CvmService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -225,7 +225,7 @@ func dataSourceTencentCloudImagesRead(d *schema.ResourceData, meta interface{})
 		if imageName != "" {
 			imageNameRegex, err = regexp.Compile(imageName)
 			if err != nil {
-				return fmt.Errorf("image_name_regex format error,%s", err.Error())
+				return diag.Errorf("image_name_regex format error,%s", err.Error())
 			}
 		}
 	}
@@ -240,7 +240,7 @@ func dataSourceTencentCloudImagesRead(d *schema.ResourceData, meta interface{})
 	}
 
 	var images []*cvm.Image
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		var e error
 		images, e = cvmService.DescribeImagesByFilter(ctx, filter, instanceType)
 		if e != nil {
@@ -249,7 +249,7 @@ func dataSourceTencentCloudImagesRead(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var results []*cvm.Image
@@ -279,7 +279,7 @@ func dataSourceTencentCloudImagesRead(d *schema.ResourceData, meta interface{})
 	for _, image := range results {
 		snapshots, err := imagesReadSnapshotByIds(ctx, cbsService, image)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		mapping := map[string]interface{}{
@@ -307,13 +307,13 @@ func dataSourceTencentCloudImagesRead(d *schema.ResourceData, meta interface{})
 	err = d.Set("images", imageList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set image list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), imageList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -31,6 +31,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -39,7 +40,7 @@ import (
 
 func dataSourceInstanceTypes() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudInstanceTypesRead,
+		ReadContext: dataSourceTencentCloudInstanceTypesRead,
 
 		Schema: map[string]*schema.Schema{
 			"cpu_core_count": {
@@ -151,10 +152,10 @@ func dataSourceInstanceTypes() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudInstanceTypesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudInstanceTypesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_instance_types.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cvmService := CvmService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -190,7 +191,7 @@ func dataSourceTencentCloudInstanceTypesRead(d *schema.ResourceData, meta interf
 	if zone != "" {
 		filterMap["zone"] = []string{zone}
 	}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		instanceSellTypes, errRet = cvmService.DescribeInstancesSellTypeByFilter(ctx, filterMap)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -198,7 +199,7 @@ func dataSourceTencentCloudInstanceTypesRead(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, instanceType := range instanceSellTypes {
 		flag := true
@@ -235,13 +236,13 @@ func dataSourceTencentCloudInstanceTypesRead(d *schema.ResourceData, meta interf
 	err = d.Set("instance_types", typeList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set instance type list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), typeList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
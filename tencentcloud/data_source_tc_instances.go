@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudInstancesRead,
+		ReadContext: dataSourceTencentCloudInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -253,10 +254,10 @@ func dataSourceTencentCloudInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_instances.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cvmService := CvmService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -294,7 +295,7 @@ func dataSourceTencentCloudInstancesRead(d *schema.ResourceData, meta interface{
 
 	var instances []*cvm.Instance
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		instances, errRet = cvmService.DescribeInstanceByFilter(ctx, instanceSetIds, filter)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -302,7 +303,7 @@ func dataSourceTencentCloudInstancesRead(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceList := make([]map[string]interface{}, 0, len(instances))
@@ -359,13 +360,13 @@ func dataSourceTencentCloudInstancesRead(d *schema.ResourceData, meta interface{
 	err = d.Set("instance_list", instanceList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set instance list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), instanceList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
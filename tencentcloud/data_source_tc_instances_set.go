@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudInstancesSet() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudInstancesSetRead,
+		ReadContext: dataSourceTencentCloudInstancesSetRead,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -242,10 +243,10 @@ func dataSourceTencentCloudInstancesSet() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudInstancesSetRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudInstancesSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_instances_set.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cvmService := CvmService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -282,7 +283,7 @@ func dataSourceTencentCloudInstancesSetRead(d *schema.ResourceData, meta interfa
 	instances, errRet = cvmService.DescribeInstanceInParallelByFilter(ctx, filter)
 
 	if errRet != nil {
-		return errRet
+		return diag.FromErr(errRet)
 	}
 
 	instanceList := make([]map[string]interface{}, 0, len(instances))
@@ -339,13 +340,13 @@ func dataSourceTencentCloudInstancesSetRead(d *schema.ResourceData, meta interfa
 	err := d.Set("instance_list", instanceList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set instance list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), instanceList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
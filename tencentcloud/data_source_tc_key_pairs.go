@@ -17,11 +17,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -30,7 +30,7 @@ import (
 
 func dataSourceTencentCloudKeyPairs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudKeyPairsRead,
+		ReadContext: dataSourceTencentCloudKeyPairsRead,
 
 		Schema: map[string]*schema.Schema{
 			"key_id": {
@@ -96,10 +96,10 @@ func dataSourceTencentCloudKeyPairs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudKeyPairsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudKeyPairsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_key_pairs.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cvmService := CvmService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -118,7 +118,7 @@ func dataSourceTencentCloudKeyPairsRead(d *schema.ResourceData, meta interface{}
 
 		pattern := `^[a-zA-Z0-9_]+$`
 		if match, _ := regexp.MatchString(pattern, name); !match {
-			return fmt.Errorf("key_name only support letters, numbers, and _ : %s", keyName)
+			return diag.Errorf("key_name only support letters, numbers, and _ : %s", keyName)
 		}
 	}
 
@@ -130,7 +130,7 @@ func dataSourceTencentCloudKeyPairsRead(d *schema.ResourceData, meta interface{}
 
 	var keyPairs []*cvm.KeyPair
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		keyPairs, errRet = cvmService.DescribeKeyPairByFilter(ctx, keyId, name, projectId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -138,7 +138,7 @@ func dataSourceTencentCloudKeyPairsRead(d *schema.ResourceData, meta interface{}
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	keyPairList := make([]map[string]interface{}, 0, len(keyPairs))
@@ -168,13 +168,13 @@ func dataSourceTencentCloudKeyPairsRead(d *schema.ResourceData, meta interface{}
 	err = d.Set("key_pair_list", keyPairList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set key pair list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), keyPairList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
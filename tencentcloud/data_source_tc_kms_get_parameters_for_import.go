@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	kms "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/kms/v20190118"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudKmsGetParametersForImport() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudKmsGetParametersForImportRead,
+		ReadContext: dataSourceTencentCloudKmsGetParametersForImportRead,
 		Schema: map[string]*schema.Schema{
 			"key_id": {
 				Required:    true,
@@ -65,17 +66,17 @@ func dataSourceTencentCloudKmsGetParametersForImport() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudKmsGetParametersForImportRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudKmsGetParametersForImportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_kms_get_parameters_for_import.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId                  = getLogId(contextNil)
-		ctx                    = context.WithValue(context.TODO(), logIdKey, logId)
 		service                = KmsService{client: meta.(*TencentCloudClient).apiV3Conn}
 		getParametersForImport *kms.GetParametersForImportResponseParams
 		keyId                  string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("key_id"); ok {
@@ -91,7 +92,7 @@ func dataSourceTencentCloudKmsGetParametersForImportRead(d *schema.ResourceData,
 		paramMap["WrappingKeySpec"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeKmsGetParametersForImportByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -102,7 +103,7 @@ func dataSourceTencentCloudKmsGetParametersForImportRead(d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if getParametersForImport.KeyId != nil {
@@ -125,7 +126,7 @@ func dataSourceTencentCloudKmsGetParametersForImportRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -18,6 +18,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	kms "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/kms/v20190118"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudKmsKeys() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudKmsKeysRead,
+		ReadContext: dataSourceTencentCloudKmsKeysRead,
 		Schema: map[string]*schema.Schema{
 			"role": {
 				Type:        schema.TypeInt,
@@ -151,11 +152,11 @@ func dataSourceTencentCloudKmsKeys() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudKmsKeysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudKmsKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_kms_keys.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	param := make(map[string]interface{})
 	if v, ok := d.GetOk("role"); ok {
@@ -185,7 +186,7 @@ func dataSourceTencentCloudKmsKeysRead(d *schema.ResourceData, meta interface{})
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var keys []*kms.KeyMetadata
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := kmsService.DescribeKeysByFilter(ctx, param)
 		if e != nil {
 			return retryError(e)
@@ -195,7 +196,7 @@ func dataSourceTencentCloudKmsKeysRead(d *schema.ResourceData, meta interface{})
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read KMS keys failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	keyList := make([]map[string]interface{}, 0, len(keys))
 	ids := make([]string, 0, len(keys))
@@ -223,10 +224,10 @@ func dataSourceTencentCloudKmsKeysRead(d *schema.ResourceData, meta interface{})
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("key_list", keyList); e != nil {
 		log.Printf("[CRITAL]%s provider set KMS key list fail, reason:%+v", logId, e)
-		return e
+		return diag.FromErr(e)
 	}
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
-		return writeToFile(output.(string), keyList)
+		return diag.FromErr(writeToFile(output.(string), keyList))
 	}
 	return nil
 }
@@ -22,6 +22,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	kms "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/kms/v20190118"
@@ -30,7 +31,7 @@ import (
 
 func dataSourceTencentCloudKmsPublicKey() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudKmsPublicKeyRead,
+		ReadContext: dataSourceTencentCloudKmsPublicKeyRead,
 		Schema: map[string]*schema.Schema{
 			"key_id": {
 				Required:    true,
@@ -56,17 +57,17 @@ func dataSourceTencentCloudKmsPublicKey() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudKmsPublicKeyRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudKmsPublicKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_kms_public_key.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = KmsService{client: meta.(*TencentCloudClient).apiV3Conn}
 		publicKey *kms.GetPublicKeyResponseParams
 		keyId     string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("key_id"); ok {
@@ -74,7 +75,7 @@ func dataSourceTencentCloudKmsPublicKeyRead(d *schema.ResourceData, meta interfa
 		keyId = v.(string)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeKmsPublicKeyByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -85,7 +86,7 @@ func dataSourceTencentCloudKmsPublicKeyRead(d *schema.ResourceData, meta interfa
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if publicKey.KeyId != nil {
@@ -104,7 +105,7 @@ func dataSourceTencentCloudKmsPublicKeyRead(d *schema.ResourceData, meta interfa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
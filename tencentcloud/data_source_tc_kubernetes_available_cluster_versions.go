@@ -28,6 +28,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
@@ -36,7 +37,7 @@ import (
 
 func dataSourceTencentCloudKubernetesAvailableClusterVersions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudKubernetesAvailableClusterVersionsRead,
+		ReadContext: dataSourceTencentCloudKubernetesAvailableClusterVersionsRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Optional:    true,
@@ -94,13 +95,13 @@ func dataSourceTencentCloudKubernetesAvailableClusterVersions() *schema.Resource
 	}
 }
 
-func dataSourceTencentCloudKubernetesAvailableClusterVersionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudKubernetesAvailableClusterVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_kubernetes_available_cluster_versions.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		versions []*string
@@ -123,7 +124,7 @@ func dataSourceTencentCloudKubernetesAvailableClusterVersionsRead(d *schema.Reso
 
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeKubernetesAvailableClusterVersionsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -135,7 +136,7 @@ func dataSourceTencentCloudKubernetesAvailableClusterVersionsRead(d *schema.Reso
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if versions != nil {
@@ -173,7 +174,7 @@ func dataSourceTencentCloudKubernetesAvailableClusterVersionsRead(d *schema.Reso
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
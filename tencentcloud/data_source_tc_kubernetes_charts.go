@@ -13,6 +13,7 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudKubernetesCharts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudKubernetesChartsRead,
+		ReadContext: dataSourceTencentCloudKubernetesChartsRead,
 		Schema: map[string]*schema.Schema{
 			"kind": {
 				Type:        schema.TypeString,
@@ -70,11 +71,11 @@ func dataSourceTencentCloudKubernetesCharts() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudKubernetesChartsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudKubernetesChartsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_kubernetes_charts.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := TkeService{client: client}
 
@@ -99,7 +100,7 @@ func dataSourceTencentCloudKubernetesChartsRead(d *schema.ResourceData, meta int
 
 	response, err := service.GetTkeAppChartList(ctx, request)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	chartList := make([]interface{}, 0)
@@ -114,7 +115,7 @@ func dataSourceTencentCloudKubernetesChartsRead(d *schema.ResourceData, meta int
 		label := make(map[string]interface{})
 
 		if err := json.Unmarshal([]byte(*item.Label), &label); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		chart["label"] = label
@@ -125,14 +126,14 @@ func dataSourceTencentCloudKubernetesChartsRead(d *schema.ResourceData, meta int
 	err = d.Set("chart_list", chartList)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		err := writeToFile(output.(string), chartList)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	kubernetes "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudKubernetesClusterAuthenticationOptions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudKubernetesClusterAuthenticationOptionsRead,
+		ReadContext: dataSourceTencentCloudKubernetesClusterAuthenticationOptionsRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -102,13 +103,13 @@ func dataSourceTencentCloudKubernetesClusterAuthenticationOptions() *schema.Reso
 	}
 }
 
-func dataSourceTencentCloudKubernetesClusterAuthenticationOptionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudKubernetesClusterAuthenticationOptionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_kubernetes_cluster_authentication_options.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	clusterId := d.Get("cluster_id").(string)
 
@@ -121,7 +122,7 @@ func dataSourceTencentCloudKubernetesClusterAuthenticationOptionsRead(d *schema.
 		e          error
 	)
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		options, state, oidcConfig, e = service.DescribeClusterAuthenticationOptions(ctx, clusterId)
 		if e != nil {
 			return retryError(e)
@@ -129,7 +130,7 @@ func dataSourceTencentCloudKubernetesClusterAuthenticationOptionsRead(d *schema.
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0)
@@ -182,7 +183,7 @@ func dataSourceTencentCloudKubernetesClusterAuthenticationOptionsRead(d *schema.
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
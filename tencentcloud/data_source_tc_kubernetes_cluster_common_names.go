@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -24,9 +25,9 @@ import (
 
 func datasourceTencentCloudKubernetesClusterCommonNames() *schema.Resource {
 	return &schema.Resource{
-		Read: datasourceTencentCloudKubernetesClusterCommonNamesRead,
+		ReadContext: datasourceTencentCloudKubernetesClusterCommonNamesRead,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -74,12 +75,12 @@ func datasourceTencentCloudKubernetesClusterCommonNames() *schema.Resource {
 	}
 }
 
-func datasourceTencentCloudKubernetesClusterCommonNamesRead(d *schema.ResourceData, meta interface{}) error {
+func datasourceTencentCloudKubernetesClusterCommonNamesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("datasource.tencentcloud_kubernetes_cluster_common_names.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := TkeService{client}
 
@@ -97,7 +98,7 @@ func datasourceTencentCloudKubernetesClusterCommonNamesRead(d *schema.ResourceDa
 	names, err := service.DescribeClusterCommonNames(ctx, request)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	result := make([]interface{}, 0, len(names))
@@ -113,13 +114,13 @@ func datasourceTencentCloudKubernetesClusterCommonNamesRead(d *schema.ResourceDa
 	}
 
 	if err := d.Set("list", result); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(clusterId + FILED_SP + helper.DataResourceIdsHash(cns))
+	d.SetId(helper.IdFormat(clusterId, helper.DataResourceIdsHash(cns)))
 
 	if output, ok := d.GetOk("result_output_file"); ok {
-		return writeToFile(output.(string), result)
+		return diag.FromErr(writeToFile(output.(string), result))
 	}
 
 	return nil
@@ -20,12 +20,13 @@ import (
 	"fmt"
 	"math/rand"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func datasourceTencentCloudKubernetesClusterLevels() *schema.Resource {
 	return &schema.Resource{
-		Read: datasourceTencentCloudKubernetesClusterLevelsRead,
+		ReadContext: datasourceTencentCloudKubernetesClusterLevelsRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -90,19 +91,19 @@ func datasourceTencentCloudKubernetesClusterLevels() *schema.Resource {
 	}
 }
 
-func datasourceTencentCloudKubernetesClusterLevelsRead(d *schema.ResourceData, meta interface{}) error {
+func datasourceTencentCloudKubernetesClusterLevelsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("datasource.tencentcloud_kubernetes_cluster_levels.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TkeService{meta.(*TencentCloudClient).apiV3Conn}
 
 	clusterId := d.Get("cluster_id").(string)
 	result, err := service.DescribeClusterLevelAttribute(ctx, clusterId)
 	if err != nil {
 		d.SetId("")
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("cluster-level-%s__%d", clusterId, rand.Intn(4)))
 
@@ -126,7 +127,7 @@ func datasourceTencentCloudKubernetesClusterLevelsRead(d *schema.ResourceData, m
 	_ = d.Set("list", list)
 
 	if output, ok := d.GetOk("result_output_file"); ok {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 
 	return nil
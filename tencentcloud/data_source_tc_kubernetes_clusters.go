@@ -19,6 +19,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -210,7 +211,7 @@ func tkeClusterInfo() map[string]*schema.Schema {
 
 func dataSourceTencentCloudKubernetesClusters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudKubernetesClustersRead,
+		ReadContext: dataSourceTencentCloudKubernetesClustersRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:          schema.TypeString,
@@ -247,11 +248,11 @@ func dataSourceTencentCloudKubernetesClusters() *schema.Resource {
 	}
 
 }
-func dataSourceTencentCloudKubernetesClustersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudKubernetesClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_kubernetes_clusters.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TkeService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -274,7 +275,7 @@ func dataSourceTencentCloudKubernetesClustersRead(d *schema.ResourceData, meta i
 
 	infos, err := service.DescribeClusters(ctx, id, name)
 	if err != nil && id == "" {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			infos, err = service.DescribeClusters(ctx, id, name)
 			if err != nil {
 				return retryError(err)
@@ -284,7 +285,7 @@ func dataSourceTencentCloudKubernetesClustersRead(d *schema.ResourceData, meta i
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	list := make([]map[string]interface{}, 0, len(infos))
@@ -346,7 +347,7 @@ LOOP:
 		}
 		if err != nil {
 			log.Printf("[CRITAL]%s tencentcloud_kubernetes_clusters DescribeClusterInstances fail, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 		workerInstancesList := make([]map[string]interface{}, 0, len(workers))
@@ -368,7 +369,7 @@ LOOP:
 
 		if err != nil {
 			log.Printf("[CRITAL]%s tencentcloud_kubernetes_clusters DescribeClusterSecurity fail, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 		policies := make([]string, 0, len(securityRet.Response.SecurityPolicy))
@@ -386,7 +387,7 @@ LOOP:
 
 		config, err := service.DescribeClusterConfig(ctx, info.ClusterId, true)
 		if err != nil {
-			err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 				config, err = service.DescribeClusterConfig(ctx, d.Id(), true)
 				if err != nil {
 					return retryError(err)
@@ -396,12 +397,12 @@ LOOP:
 		}
 		if err != nil {
 			log.Printf("[CRITAL]%s tencentcloud_kubernetes_clusters DescribeClusterInstances fail, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 		intranetConfig, err := service.DescribeClusterConfig(ctx, info.ClusterId, false)
 		if err != nil {
-			err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 				config, err = service.DescribeClusterConfig(ctx, d.Id(), false)
 				if err != nil {
 					return retryError(err)
@@ -411,7 +412,7 @@ LOOP:
 		}
 		if err != nil {
 			log.Printf("[CRITAL]%s tencentcloud_kubernetes_clusters DescribeClusterInstances fail, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 		infoMap["kube_config"] = config
@@ -423,13 +424,13 @@ LOOP:
 	err = d.Set("list", list)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set tencentcloud_kubernetes_clusters list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err = writeToFile(output.(string), list); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
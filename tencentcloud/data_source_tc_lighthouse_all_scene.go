@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudLighthouseAllScene() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudLighthouseAllSceneRead,
+		ReadContext: dataSourceTencentCloudLighthouseAllSceneRead,
 		Schema: map[string]*schema.Schema{
 			"scene_ids": {
 				Optional: true,
@@ -82,13 +83,13 @@ func dataSourceTencentCloudLighthouseAllScene() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudLighthouseAllSceneRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudLighthouseAllSceneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_lighthouse_scene.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("scene_ids"); ok {
@@ -112,7 +113,7 @@ func dataSourceTencentCloudLighthouseAllSceneRead(d *schema.ResourceData, meta i
 
 	var sceneSet []*lighthouse.SceneInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeLighthouseAllSceneByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -121,7 +122,7 @@ func dataSourceTencentCloudLighthouseAllSceneRead(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(sceneSet))
@@ -140,7 +141,7 @@ func dataSourceTencentCloudLighthouseAllSceneRead(d *schema.ResourceData, meta i
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
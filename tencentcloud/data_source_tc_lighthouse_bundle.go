@@ -13,6 +13,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudLighthouseBundle() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudLighthouseBundleRead,
+		ReadContext: dataSourceTencentCloudLighthouseBundleRead,
 		Schema: map[string]*schema.Schema{
 			"bundle_ids": {
 				Optional: true,
@@ -208,13 +209,13 @@ func dataSourceTencentCloudLighthouseBundle() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudLighthouseBundleRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudLighthouseBundleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_lighthouse_bundle.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("bundle_ids"); ok {
@@ -265,7 +266,7 @@ func dataSourceTencentCloudLighthouseBundleRead(d *schema.ResourceData, meta int
 
 	var bundleSet []*lighthouse.Bundle
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeLighthouseBundleByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -274,7 +275,7 @@ func dataSourceTencentCloudLighthouseBundleRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(bundleSet))
@@ -378,7 +379,7 @@ func dataSourceTencentCloudLighthouseBundleRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudLighthouseDiskConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudLighthouseDiskConfigRead,
+		ReadContext: dataSourceTencentCloudLighthouseDiskConfigRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
 				Optional:    true,
@@ -99,13 +100,13 @@ func dataSourceTencentCloudLighthouseDiskConfig() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudLighthouseDiskConfigRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudLighthouseDiskConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_lighthouse_disk_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("filters"); ok {
@@ -132,7 +133,7 @@ func dataSourceTencentCloudLighthouseDiskConfigRead(d *schema.ResourceData, meta
 
 	var diskConfigSet []*lighthouse.DiskConfig
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeLighthouseDiskConfigByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -141,7 +142,7 @@ func dataSourceTencentCloudLighthouseDiskConfigRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(diskConfigSet))
@@ -183,7 +184,7 @@ func dataSourceTencentCloudLighthouseDiskConfigRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudLighthouseInstanceDisks() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudLighthouseInstanceDisksRead,
+		ReadContext: dataSourceTencentCloudLighthouseInstanceDisksRead,
 		Schema: map[string]*schema.Schema{
 			"disk_ids": {
 				Optional: true,
@@ -174,13 +175,13 @@ func dataSourceTencentCloudLighthouseInstanceDisks() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudLighthouseInstanceDisksRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudLighthouseInstanceDisksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_lighthouse_instance_disks.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	diskIds := make([]string, 0)
 	for _, diskId := range d.Get("disk_ids").(*schema.Set).List() {
@@ -207,7 +208,7 @@ func dataSourceTencentCloudLighthouseInstanceDisksRead(d *schema.ResourceData, m
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 	disks, err := service.DescribeLighthouseDisk(ctx, diskIds, filters)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0)
@@ -302,7 +303,7 @@ func dataSourceTencentCloudLighthouseInstanceDisksRead(d *schema.ResourceData, m
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), diskList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
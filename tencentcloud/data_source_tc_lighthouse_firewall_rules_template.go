@@ -14,6 +14,7 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudLighthouseFirewallRulesTemplate() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudLighthouseFirewallRulesTemplateRead,
+		ReadContext: dataSourceTencentCloudLighthouseFirewallRulesTemplateRead,
 		Schema: map[string]*schema.Schema{
 			"firewall_rule_set": {
 				Computed:    true,
@@ -73,19 +74,19 @@ func dataSourceTencentCloudLighthouseFirewallRulesTemplate() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudLighthouseFirewallRulesTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudLighthouseFirewallRulesTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_lighthouse_firewall_rules_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var firewallRuleSet []*lighthouse.FirewallRuleInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeLighthouseFirewallRulesTemplateByFilter(ctx)
 		if e != nil {
 			return retryError(e)
@@ -94,7 +95,7 @@ func dataSourceTencentCloudLighthouseFirewallRulesTemplateRead(d *schema.Resourc
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(firewallRuleSet))
@@ -129,7 +130,7 @@ func dataSourceTencentCloudLighthouseFirewallRulesTemplateRead(d *schema.Resourc
 			}
 			firewallRuleInfoJson, err := json.Marshal(*firewallRuleInfo)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			ids = append(ids, string(firewallRuleInfoJson))
 			tmpList = append(tmpList, firewallRuleInfoMap)
@@ -142,7 +143,7 @@ func dataSourceTencentCloudLighthouseFirewallRulesTemplateRead(d *schema.Resourc
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
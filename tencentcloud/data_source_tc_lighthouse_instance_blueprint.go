@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudLighthouseInstanceBlueprint() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudLighthouseInstanceBlueprintRead,
+		ReadContext: dataSourceTencentCloudLighthouseInstanceBlueprintRead,
 		Schema: map[string]*schema.Schema{
 			"instance_ids": {
 				Required: true,
@@ -222,13 +223,13 @@ func dataSourceTencentCloudLighthouseInstanceBlueprint() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudLighthouseInstanceBlueprintRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudLighthouseInstanceBlueprintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_lighthouse_instance_blueprint.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_ids"); ok {
@@ -243,7 +244,7 @@ func dataSourceTencentCloudLighthouseInstanceBlueprintRead(d *schema.ResourceDat
 	for _, instanceId := range d.Get("instance_ids").(*schema.Set).List() {
 		instanceIds = append(instanceIds, instanceId.(string))
 	}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeLighthouseInstanceBlueprintByFilter(ctx, instanceIds)
 		if e != nil {
 			return retryError(e)
@@ -252,7 +253,7 @@ func dataSourceTencentCloudLighthouseInstanceBlueprintRead(d *schema.ResourceDat
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(blueprintInstanceSet))
@@ -413,7 +414,7 @@ func dataSourceTencentCloudLighthouseInstanceBlueprintRead(d *schema.ResourceDat
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudLighthouseInstanceDiskNum() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudLighthouseInstanceDiskNumRead,
+		ReadContext: dataSourceTencentCloudLighthouseInstanceDiskNumRead,
 		Schema: map[string]*schema.Schema{
 			"instance_ids": {
 				Required: true,
@@ -67,13 +68,13 @@ func dataSourceTencentCloudLighthouseInstanceDiskNum() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudLighthouseInstanceDiskNumRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudLighthouseInstanceDiskNumRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_lighthouse_instance_disk_num.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceIds := make([]string, 0)
 	for _, instanceId := range d.Get("instance_ids").(*schema.Set).List() {
@@ -83,7 +84,7 @@ func dataSourceTencentCloudLighthouseInstanceDiskNumRead(d *schema.ResourceData,
 
 	var attachDetailSet []*lighthouse.AttachDetail
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeLighthouseInstanceDiskNum(ctx, instanceIds)
 		if e != nil {
 			return retryError(e)
@@ -92,7 +93,7 @@ func dataSourceTencentCloudLighthouseInstanceDiskNumRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(attachDetailSet))
@@ -125,7 +126,7 @@ func dataSourceTencentCloudLighthouseInstanceDiskNumRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
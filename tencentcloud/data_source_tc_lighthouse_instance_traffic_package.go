@@ -13,6 +13,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudLighthouseInstanceTrafficPackage() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudLighthouseInstanceTrafficPackageRead,
+		ReadContext: dataSourceTencentCloudLighthouseInstanceTrafficPackageRead,
 		Schema: map[string]*schema.Schema{
 			"instance_ids": {
 				Optional: true,
@@ -126,13 +127,13 @@ func dataSourceTencentCloudLighthouseInstanceTrafficPackage() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudLighthouseInstanceTrafficPackageRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudLighthouseInstanceTrafficPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_lighthouse_instance_traffic_package.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_ids"); ok {
@@ -156,7 +157,7 @@ func dataSourceTencentCloudLighthouseInstanceTrafficPackageRead(d *schema.Resour
 
 	var instanceTrafficPackageSet []*lighthouse.InstanceTrafficPackage
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeLighthouseInstanceTrafficPackageByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -165,7 +166,7 @@ func dataSourceTencentCloudLighthouseInstanceTrafficPackageRead(d *schema.Resour
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(instanceTrafficPackageSet))
@@ -237,7 +238,7 @@ func dataSourceTencentCloudLighthouseInstanceTrafficPackageRead(d *schema.Resour
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
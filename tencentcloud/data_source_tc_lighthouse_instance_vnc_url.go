@@ -12,8 +12,9 @@ data "tencentcloud_lighthouse_instance_vnc_url" "instance_vnc_url" {
 package tencentcloud
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudLighthouseInstanceVncUrl() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudLighthouseInstanceVncUrlRead,
+		ReadContext: dataSourceTencentCloudLighthouseInstanceVncUrlRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -45,7 +46,7 @@ func dataSourceTencentCloudLighthouseInstanceVncUrl() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudLighthouseInstanceVncUrlRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudLighthouseInstanceVncUrlRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_lighthouse_instance_vnc_url.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -54,9 +55,9 @@ func dataSourceTencentCloudLighthouseInstanceVncUrlRead(d *schema.ResourceData,
 	request := lighthouse.NewDescribeInstanceVncUrlRequest()
 	response := lighthouse.NewDescribeInstanceVncUrlResponse()
 	request.InstanceId = helper.String(instanceId)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().DescribeInstanceVncUrl(request)
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().DescribeInstanceVncUrlWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -64,12 +65,12 @@ func dataSourceTencentCloudLighthouseInstanceVncUrlRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if response == nil || response.Response == nil || response.Response.InstanceVncUrl == nil {
 		d.SetId("")
-		return fmt.Errorf("Response is nil")
+		return diag.Errorf("Response is nil")
 
 	}
 
@@ -81,7 +82,7 @@ func dataSourceTencentCloudLighthouseInstanceVncUrlRead(d *schema.ResourceData,
 		if e := writeToFile(output.(string), map[string]interface{}{
 			"instance_vnc_url": *response.Response.InstanceVncUrl,
 		}); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -18,8 +18,8 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -28,7 +28,7 @@ import (
 
 func dataSourceTencentCloudLighthouseModifyInstanceBundle() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudLighthouseModifyInstanceBundleRead,
+		ReadContext: dataSourceTencentCloudLighthouseModifyInstanceBundleRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -261,13 +261,13 @@ func dataSourceTencentCloudLighthouseModifyInstanceBundle() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudLighthouseModifyInstanceBundleRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudLighthouseModifyInstanceBundleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_lighthouse_modify_instance_bundle.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -298,7 +298,7 @@ func dataSourceTencentCloudLighthouseModifyInstanceBundleRead(d *schema.Resource
 
 	var modifyBundleSet []*lighthouse.ModifyBundle
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeLighthouseModifyInstanceBundleByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -307,12 +307,12 @@ func dataSourceTencentCloudLighthouseModifyInstanceBundleRead(d *schema.Resource
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(modifyBundleSet) == 0 {
 		d.SetId("")
-		return fmt.Errorf("Response is nil")
+		return diag.Errorf("Response is nil")
 	}
 
 	ids := make([]string, 0)
@@ -463,7 +463,7 @@ func dataSourceTencentCloudLighthouseModifyInstanceBundleRead(d *schema.Resource
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
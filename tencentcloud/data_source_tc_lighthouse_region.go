@@ -13,6 +13,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudLighthouseRegion() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudLighthouseRegionRead,
+		ReadContext: dataSourceTencentCloudLighthouseRegionRead,
 		Schema: map[string]*schema.Schema{
 			"region_set": {
 				Computed:    true,
@@ -62,20 +63,20 @@ func dataSourceTencentCloudLighthouseRegion() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudLighthouseRegionRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudLighthouseRegionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_lighthouse_region.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var regionSet []*lighthouse.RegionInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeLighthouseRegionByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -84,7 +85,7 @@ func dataSourceTencentCloudLighthouseRegionRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(regionSet))
@@ -121,7 +122,7 @@ func dataSourceTencentCloudLighthouseRegionRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
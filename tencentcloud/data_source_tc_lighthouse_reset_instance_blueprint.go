@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudLighthouseResetInstanceBlueprint() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudLighthouseResetInstanceBlueprintRead,
+		ReadContext: dataSourceTencentCloudLighthouseResetInstanceBlueprintRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -205,13 +206,13 @@ func dataSourceTencentCloudLighthouseResetInstanceBlueprint() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudLighthouseResetInstanceBlueprintRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudLighthouseResetInstanceBlueprintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_lighthouse_reset_instance_blueprint.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var instanceId string
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -251,7 +252,7 @@ func dataSourceTencentCloudLighthouseResetInstanceBlueprintRead(d *schema.Resour
 
 	var resetInstanceBlueprintSet []*lighthouse.ResetInstanceBlueprint
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeLighthouseResetInstanceBlueprintByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -260,7 +261,7 @@ func dataSourceTencentCloudLighthouseResetInstanceBlueprintRead(d *schema.Resour
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(resetInstanceBlueprintSet))
@@ -349,7 +350,7 @@ func dataSourceTencentCloudLighthouseResetInstanceBlueprintRead(d *schema.Resour
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
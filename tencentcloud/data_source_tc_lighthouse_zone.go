@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudLighthouseZone() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudLighthouseZoneRead,
+		ReadContext: dataSourceTencentCloudLighthouseZoneRead,
 		Schema: map[string]*schema.Schema{
 			"order_field": {
 				Optional: true,
@@ -76,13 +77,13 @@ func dataSourceTencentCloudLighthouseZone() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudLighthouseZoneRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudLighthouseZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_lighthouse_zone.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("order_field"); ok {
@@ -97,7 +98,7 @@ func dataSourceTencentCloudLighthouseZoneRead(d *schema.ResourceData, meta inter
 
 	var zoneInfoSet []*lighthouse.ZoneInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeLighthouseZoneByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -106,7 +107,7 @@ func dataSourceTencentCloudLighthouseZoneRead(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(zoneInfoSet))
@@ -124,7 +125,7 @@ func dataSourceTencentCloudLighthouseZoneRead(d *schema.ResourceData, meta inter
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
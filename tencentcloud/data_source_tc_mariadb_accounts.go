@@ -15,6 +15,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudMariadbAccounts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMariadbAccountsRead,
+		ReadContext: dataSourceTencentCloudMariadbAccountsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -90,12 +91,12 @@ func dataSourceTencentCloudMariadbAccounts() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMariadbAccountsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMariadbAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mariadb_accounts.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var instanceId string
 
 	paramMap := make(map[string]interface{})
@@ -107,7 +108,7 @@ func dataSourceTencentCloudMariadbAccountsRead(d *schema.ResourceData, meta inte
 	mariadbService := MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var users []*mariadb.DBAccount
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := mariadbService.DescribeMariadbAccountsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -117,7 +118,7 @@ func dataSourceTencentCloudMariadbAccountsRead(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Mariadb users failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	userList := []interface{}{}
@@ -154,7 +155,7 @@ func dataSourceTencentCloudMariadbAccountsRead(d *schema.ResourceData, meta inte
 		err = d.Set("list", userList)
 		if err != nil {
 			log.Printf("[CRITAL]%s provider set instances list fail, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	d.SetId(instanceId)
@@ -162,7 +163,7 @@ func dataSourceTencentCloudMariadbAccountsRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), userList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -15,14 +15,16 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudMariadbDatabaseObjects() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMariadbDatabaseObjectsRead,
+		ReadContext: dataSourceTencentCloudMariadbDatabaseObjectsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -105,13 +107,13 @@ func dataSourceTencentCloudMariadbDatabaseObjects() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMariadbDatabaseObjectsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMariadbDatabaseObjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mariadb_database_objects.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instanceId := ""
 	dbName := ""
@@ -125,7 +127,7 @@ func dataSourceTencentCloudMariadbDatabaseObjectsRead(d *schema.ResourceData, me
 	}
 
 	var databaseObjects *mariadb.DescribeDatabaseObjectsResponseParams
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMariadbDatabaseObjectsByFilter(ctx, instanceId, dbName)
 		if e != nil {
 			return retryError(e)
@@ -134,7 +136,7 @@ func dataSourceTencentCloudMariadbDatabaseObjectsRead(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("instance_id", instanceId)
@@ -199,11 +201,11 @@ func dataSourceTencentCloudMariadbDatabaseObjectsRead(d *schema.ResourceData, me
 		_ = d.Set("funcs", tmpList)
 	}
 
-	d.SetId(instanceId + FILED_SP + dbName)
+	d.SetId(helper.IdFormat(instanceId, dbName))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudMariadbDatabaseTable() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMariadbDatabaseTableRead,
+		ReadContext: dataSourceTencentCloudMariadbDatabaseTableRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -73,17 +74,17 @@ func dataSourceTencentCloudMariadbDatabaseTable() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMariadbDatabaseTableRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMariadbDatabaseTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mariadb_database_table.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		instanceId string
 		dbName     string
 		table      string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -103,7 +104,7 @@ func dataSourceTencentCloudMariadbDatabaseTableRead(d *schema.ResourceData, meta
 
 	service := MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var cols []*mariadb.TableColumn
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMariadbDatabaseTableByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -112,7 +113,7 @@ func dataSourceTencentCloudMariadbDatabaseTableRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if cols != nil {
@@ -134,11 +135,11 @@ func dataSourceTencentCloudMariadbDatabaseTableRead(d *schema.ResourceData, meta
 		_ = d.Set("cols", tmpList)
 	}
 
-	d.SetId(instanceId + FILED_SP + dbName + FILED_SP + table)
+	d.SetId(helper.IdFormat(instanceId, dbName, table))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
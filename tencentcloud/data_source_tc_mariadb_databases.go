@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudMariadbDatabases() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMariadbDatabasesRead,
+		ReadContext: dataSourceTencentCloudMariadbDatabasesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -53,13 +54,13 @@ func dataSourceTencentCloudMariadbDatabases() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMariadbDatabasesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMariadbDatabasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mariadb_databases.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := ""
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -70,7 +71,7 @@ func dataSourceTencentCloudMariadbDatabasesRead(d *schema.ResourceData, meta int
 
 	var databases []*mariadb.Database
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMariadbDatabasesByFilter(ctx, instanceId)
 		if e != nil {
 			return retryError(e)
@@ -79,7 +80,7 @@ func dataSourceTencentCloudMariadbDatabasesRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(databases))
@@ -101,7 +102,7 @@ func dataSourceTencentCloudMariadbDatabasesRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -19,6 +19,7 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -27,7 +28,7 @@ import (
 
 func dataSourceTencentCloudMariadbDbInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMariadbDbInstancesRead,
+		ReadContext: dataSourceTencentCloudMariadbDbInstancesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_ids": {
 				Type: schema.TypeSet,
@@ -153,12 +154,12 @@ func dataSourceTencentCloudMariadbDbInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMariadbDbInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMariadbDbInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mariadb_db_instances.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	ids := make([]string, 0)
 
 	paramMap := make(map[string]interface{})
@@ -195,7 +196,7 @@ func dataSourceTencentCloudMariadbDbInstancesRead(d *schema.ResourceData, meta i
 
 	var instances []*mariadb.DBInstance
 	mariadbService := MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := mariadbService.DescribeMariadbDbInstancesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -205,7 +206,7 @@ func dataSourceTencentCloudMariadbDbInstancesRead(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Mariadb instances failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceList := make([]map[string]interface{}, 0, len(instances))
@@ -264,7 +265,7 @@ func dataSourceTencentCloudMariadbDbInstancesRead(d *schema.ResourceData, meta i
 		err = d.Set("instances", instanceList)
 		if err != nil {
 			log.Printf("[CRITAL]%s provider set instances list fail, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -273,7 +274,7 @@ func dataSourceTencentCloudMariadbDbInstancesRead(d *schema.ResourceData, meta i
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), instanceList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudMariadbDcnDetail() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMariadbDcnDetailRead,
+		ReadContext: dataSourceTencentCloudMariadbDcnDetailRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -190,23 +191,23 @@ func dataSourceTencentCloudMariadbDcnDetail() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMariadbDcnDetailRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMariadbDcnDetailRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mariadb_dcn_detail.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		dcnDetails []*mariadb.DcnDetailItem
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
 		paramMap["InstanceId"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMariadbDcnDetailByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -217,7 +218,7 @@ func dataSourceTencentCloudMariadbDcnDetailRead(d *schema.ResourceData, meta int
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(dcnDetails))
@@ -350,7 +351,7 @@ func dataSourceTencentCloudMariadbDcnDetailRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudMariadbFileDownloadUrl() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMariadbFileDownloadUrlRead,
+		ReadContext: dataSourceTencentCloudMariadbFileDownloadUrlRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -49,17 +50,17 @@ func dataSourceTencentCloudMariadbFileDownloadUrl() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMariadbFileDownloadUrlRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMariadbFileDownloadUrlRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mariadb_file_download_url.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId          = getLogId(contextNil)
-		ctx            = context.WithValue(context.TODO(), logIdKey, logId)
 		service        = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		responseParams *mariadb.DescribeFileDownloadUrlResponseParams
 		instanceId     string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -71,7 +72,7 @@ func dataSourceTencentCloudMariadbFileDownloadUrlRead(d *schema.ResourceData, me
 		paramMap["FilePath"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMariadbFileDownloadUrlByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -82,7 +83,7 @@ func dataSourceTencentCloudMariadbFileDownloadUrlRead(d *schema.ResourceData, me
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if responseParams.PreSignedUrl != nil {
@@ -94,7 +95,7 @@ func dataSourceTencentCloudMariadbFileDownloadUrlRead(d *schema.ResourceData, me
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -15,6 +15,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudMariadbFlow() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMariadbFlowRead,
+		ReadContext: dataSourceTencentCloudMariadbFlowRead,
 		Schema: map[string]*schema.Schema{
 			"flow_id": {
 				Required:    true,
@@ -44,17 +45,17 @@ func dataSourceTencentCloudMariadbFlow() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMariadbFlowRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMariadbFlowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mariadb_flow.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		status  *mariadb.DescribeFlowResponseParams
 		flowId  int
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, _ := d.GetOk("flow_id"); v != nil {
@@ -62,7 +63,7 @@ func dataSourceTencentCloudMariadbFlowRead(d *schema.ResourceData, meta interfac
 		flowId = v.(int)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMariadbFlowByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -73,7 +74,7 @@ func dataSourceTencentCloudMariadbFlowRead(d *schema.ResourceData, meta interfac
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if status.Status != nil {
@@ -85,7 +86,7 @@ func dataSourceTencentCloudMariadbFlowRead(d *schema.ResourceData, meta interfac
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
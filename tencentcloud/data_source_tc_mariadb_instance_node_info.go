@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudMariadbInstanceNodeInfo() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMariadbInstanceNodeInfoRead,
+		ReadContext: dataSourceTencentCloudMariadbInstanceNodeInfoRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -57,17 +58,17 @@ func dataSourceTencentCloudMariadbInstanceNodeInfo() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMariadbInstanceNodeInfoRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMariadbInstanceNodeInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mariadb_instance_node_info.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		nodesInfo  []*mariadb.NodeInfo
 		instanceId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -75,7 +76,7 @@ func dataSourceTencentCloudMariadbInstanceNodeInfoRead(d *schema.ResourceData, m
 		instanceId = v.(string)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMariadbInstanceNodeInfoByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -86,7 +87,7 @@ func dataSourceTencentCloudMariadbInstanceNodeInfoRead(d *schema.ResourceData, m
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(nodesInfo))
@@ -113,7 +114,7 @@ func dataSourceTencentCloudMariadbInstanceNodeInfoRead(d *schema.ResourceData, m
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
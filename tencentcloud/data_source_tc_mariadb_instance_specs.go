@@ -13,6 +13,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudMariadbInstanceSpecs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMariadbInstanceSpecsRead,
+		ReadContext: dataSourceTencentCloudMariadbInstanceSpecsRead,
 		Schema: map[string]*schema.Schema{
 			"specs": {
 				Computed:    true,
@@ -100,18 +101,18 @@ func dataSourceTencentCloudMariadbInstanceSpecs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMariadbInstanceSpecsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMariadbInstanceSpecsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mariadb_instance_specs.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		specs   []*mariadb.InstanceSpec
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMariadbInstanceSpecsByFilter(ctx)
 		if e != nil {
 			return retryError(e)
@@ -122,7 +123,7 @@ func dataSourceTencentCloudMariadbInstanceSpecsRead(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(specs))
@@ -192,7 +193,7 @@ func dataSourceTencentCloudMariadbInstanceSpecsRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
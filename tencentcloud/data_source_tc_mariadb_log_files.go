@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudMariadbLogFiles() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMariadbLogFilesRead,
+		ReadContext: dataSourceTencentCloudMariadbLogFilesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -83,17 +84,17 @@ func dataSourceTencentCloudMariadbLogFiles() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMariadbLogFilesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMariadbLogFilesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mariadb_log_files.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		logFiles   *mariadb.DescribeDBLogFilesResponseParams
 		instanceId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -105,7 +106,7 @@ func dataSourceTencentCloudMariadbLogFilesRead(d *schema.ResourceData, meta inte
 		paramMap["Type"] = helper.IntUint64(v.(int))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMariadbLogFilesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -116,7 +117,7 @@ func dataSourceTencentCloudMariadbLogFilesRead(d *schema.ResourceData, meta inte
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0)
@@ -158,7 +159,7 @@ func dataSourceTencentCloudMariadbLogFilesRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
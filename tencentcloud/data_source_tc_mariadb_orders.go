@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudMariadbOrders() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMariadbOrdersRead,
+		ReadContext: dataSourceTencentCloudMariadbOrdersRead,
 		Schema: map[string]*schema.Schema{
 			"deal_name": {
 				Required:    true,
@@ -79,23 +80,23 @@ func dataSourceTencentCloudMariadbOrders() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMariadbOrdersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMariadbOrdersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mariadb_orders.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId    = getLogId(contextNil)
-		ctx      = context.WithValue(context.TODO(), logIdKey, logId)
 		service  = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		deals    []*mariadb.Deal
 		dealName string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("deal_name"); ok {
 		dealName = v.(string)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMariadbOrdersByFilter(ctx, dealName)
 		if e != nil {
 			return retryError(e)
@@ -106,7 +107,7 @@ func dataSourceTencentCloudMariadbOrdersRead(d *schema.ResourceData, meta interf
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(deals))
@@ -149,7 +150,7 @@ func dataSourceTencentCloudMariadbOrdersRead(d *schema.ResourceData, meta interf
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
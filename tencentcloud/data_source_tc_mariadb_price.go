@@ -21,6 +21,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -29,7 +30,7 @@ import (
 
 func dataSourceTencentCloudMariadbPrice() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMariadbPriceRead,
+		ReadContext: dataSourceTencentCloudMariadbPriceRead,
 		Schema: map[string]*schema.Schema{
 			"zone": {
 				Required:    true,
@@ -91,16 +92,16 @@ func dataSourceTencentCloudMariadbPrice() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMariadbPriceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMariadbPriceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mariadb_price.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		price   *mariadb.DescribePriceResponseParams
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("zone"); ok {
@@ -135,7 +136,7 @@ func dataSourceTencentCloudMariadbPriceRead(d *schema.ResourceData, meta interfa
 		paramMap["AmountUnit"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMariadbPriceByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -146,7 +147,7 @@ func dataSourceTencentCloudMariadbPriceRead(d *schema.ResourceData, meta interfa
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0)
@@ -164,7 +165,7 @@ func dataSourceTencentCloudMariadbPriceRead(d *schema.ResourceData, meta interfa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudMariadbProjectSecurityGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMariadbProjectSecurityGroupsRead,
+		ReadContext: dataSourceTencentCloudMariadbProjectSecurityGroupsRead,
 		Schema: map[string]*schema.Schema{
 			"product": {
 				Required:    true,
@@ -136,17 +137,17 @@ func dataSourceTencentCloudMariadbProjectSecurityGroups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMariadbProjectSecurityGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMariadbProjectSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mariadb_project_security_groups.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		groups  []*mariadb.SecurityGroup
 		Product string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("product"); ok {
@@ -158,7 +159,7 @@ func dataSourceTencentCloudMariadbProjectSecurityGroupsRead(d *schema.ResourceDa
 		paramMap["ProjectId"] = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMariadbProjectSecurityGroupsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -169,7 +170,7 @@ func dataSourceTencentCloudMariadbProjectSecurityGroupsRead(d *schema.ResourceDa
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(groups))
@@ -261,7 +262,7 @@ func dataSourceTencentCloudMariadbProjectSecurityGroupsRead(d *schema.ResourceDa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudMariadbRenewalPrice() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMariadbRenewalPriceRead,
+		ReadContext: dataSourceTencentCloudMariadbRenewalPriceRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -59,17 +60,17 @@ func dataSourceTencentCloudMariadbRenewalPrice() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMariadbRenewalPriceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMariadbRenewalPriceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mariadb_renewal_price.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		price      *mariadb.DescribeRenewalPriceResponseParams
 		instanceId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -85,7 +86,7 @@ func dataSourceTencentCloudMariadbRenewalPriceRead(d *schema.ResourceData, meta
 		paramMap["AmountUnit"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMariadbRenewalPriceByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -96,7 +97,7 @@ func dataSourceTencentCloudMariadbRenewalPriceRead(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if price.OriginalPrice != nil {
@@ -111,7 +112,7 @@ func dataSourceTencentCloudMariadbRenewalPriceRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
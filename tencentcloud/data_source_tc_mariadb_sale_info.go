@@ -13,6 +13,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudMariadbSaleInfo() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMariadbSaleInfoRead,
+		ReadContext: dataSourceTencentCloudMariadbSaleInfoRead,
 		Schema: map[string]*schema.Schema{
 			"region_list": {
 				Computed:    true,
@@ -152,18 +153,18 @@ func dataSourceTencentCloudMariadbSaleInfo() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMariadbSaleInfoRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMariadbSaleInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mariadb_sale_info.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		regionList []*mariadb.RegionInfo
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMariadbSaleInfoByFilter(ctx)
 		if e != nil {
 			return retryError(e)
@@ -174,7 +175,7 @@ func dataSourceTencentCloudMariadbSaleInfoRead(d *schema.ResourceData, meta inte
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(regionList))
@@ -296,7 +297,7 @@ func dataSourceTencentCloudMariadbSaleInfoRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -16,6 +16,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudMariadbSecurityGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMariadbSecurityGroupsRead,
+		ReadContext: dataSourceTencentCloudMariadbSecurityGroupsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -140,12 +141,12 @@ func dataSourceTencentCloudMariadbSecurityGroups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMariadbSecurityGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMariadbSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mariadb_security_groups.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var instanceId string
 	var product string
 
@@ -163,7 +164,7 @@ func dataSourceTencentCloudMariadbSecurityGroupsRead(d *schema.ResourceData, met
 	mariadbService := MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var groups []*mariadb.SecurityGroup
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := mariadbService.DescribeMariadbSecurityGroupsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -173,7 +174,7 @@ func dataSourceTencentCloudMariadbSecurityGroupsRead(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Mariadb groups failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	groupList := []interface{}{}
@@ -243,16 +244,16 @@ func dataSourceTencentCloudMariadbSecurityGroupsRead(d *schema.ResourceData, met
 		err = d.Set("list", groupList)
 		if err != nil {
 			log.Printf("[CRITAL]%s provider set instances list fail, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	d.SetId(instanceId + FILED_SP + product)
+	d.SetId(helper.IdFormat(instanceId, product))
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), groupList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
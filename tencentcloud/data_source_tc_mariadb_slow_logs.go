@@ -18,6 +18,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudMariadbSlowLogs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMariadbSlowLogsRead,
+		ReadContext: dataSourceTencentCloudMariadbSlowLogsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -191,17 +192,17 @@ func dataSourceTencentCloudMariadbSlowLogs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMariadbSlowLogsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMariadbSlowLogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mariadb_slow_logs.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		data       *mariadb.DescribeDBSlowLogsResponseParams
 		instanceId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -233,7 +234,7 @@ func dataSourceTencentCloudMariadbSlowLogsRead(d *schema.ResourceData, meta inte
 		paramMap["Slave"] = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMariadbSlowLogsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -244,7 +245,7 @@ func dataSourceTencentCloudMariadbSlowLogsRead(d *schema.ResourceData, meta inte
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0)
@@ -351,7 +352,7 @@ func dataSourceTencentCloudMariadbSlowLogsRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
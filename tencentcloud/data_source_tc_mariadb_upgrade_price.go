@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudMariadbUpgradePrice() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMariadbUpgradePriceRead,
+		ReadContext: dataSourceTencentCloudMariadbUpgradePriceRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -76,17 +77,17 @@ func dataSourceTencentCloudMariadbUpgradePrice() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMariadbUpgradePriceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMariadbUpgradePriceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mariadb_upgrade_price.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		price      *mariadb.DescribeUpgradePriceResponseParams
 		instanceId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -110,7 +111,7 @@ func dataSourceTencentCloudMariadbUpgradePriceRead(d *schema.ResourceData, meta
 		paramMap["AmountUnit"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMariadbUpgradePriceByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -121,7 +122,7 @@ func dataSourceTencentCloudMariadbUpgradePriceRead(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if price.OriginalPrice != nil {
@@ -140,7 +141,7 @@ func dataSourceTencentCloudMariadbUpgradePriceRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mongodb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mongodb/v20190725"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudMongodbInstanceBackups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMongodbInstanceBackupsRead,
+		ReadContext: dataSourceTencentCloudMongodbInstanceBackupsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -101,13 +102,13 @@ func dataSourceTencentCloudMongodbInstanceBackups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMongodbInstanceBackupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMongodbInstanceBackupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mongodb_instance_backups.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -122,7 +123,7 @@ func dataSourceTencentCloudMongodbInstanceBackupsRead(d *schema.ResourceData, me
 
 	var backupList []*mongodb.BackupInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMongodbInstanceBackupsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -131,7 +132,7 @@ func dataSourceTencentCloudMongodbInstanceBackupsRead(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(backupList))
@@ -188,7 +189,7 @@ func dataSourceTencentCloudMongodbInstanceBackupsRead(d *schema.ResourceData, me
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
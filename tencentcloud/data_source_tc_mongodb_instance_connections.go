@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mongodb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mongodb/v20190725"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudMongodbInstanceConnections() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMongodbInstanceConnectionsRead,
+		ReadContext: dataSourceTencentCloudMongodbInstanceConnectionsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -64,13 +65,13 @@ func dataSourceTencentCloudMongodbInstanceConnections() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMongodbInstanceConnectionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMongodbInstanceConnectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mongodb_instance_connections.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -81,7 +82,7 @@ func dataSourceTencentCloudMongodbInstanceConnectionsRead(d *schema.ResourceData
 
 	var clients []*mongodb.ClientConnection
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMongodbInstanceConnectionsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -90,7 +91,7 @@ func dataSourceTencentCloudMongodbInstanceConnectionsRead(d *schema.ResourceData
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(clients))
@@ -123,7 +124,7 @@ func dataSourceTencentCloudMongodbInstanceConnectionsRead(d *schema.ResourceData
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
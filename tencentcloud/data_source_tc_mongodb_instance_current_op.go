@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mongodb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mongodb/v20190725"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudMongodbInstanceCurrentOp() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMongodbInstanceCurrentOpRead,
+		ReadContext: dataSourceTencentCloudMongodbInstanceCurrentOpRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -138,13 +139,13 @@ func dataSourceTencentCloudMongodbInstanceCurrentOp() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMongodbInstanceCurrentOpRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMongodbInstanceCurrentOpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mongodb_instance_current_op.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -183,7 +184,7 @@ func dataSourceTencentCloudMongodbInstanceCurrentOpRead(d *schema.ResourceData,
 
 	var currentOps []*mongodb.CurrentOp
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMongodbInstanceCurrentOpByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -192,7 +193,7 @@ func dataSourceTencentCloudMongodbInstanceCurrentOpRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(currentOps))
@@ -249,7 +250,7 @@ func dataSourceTencentCloudMongodbInstanceCurrentOpRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
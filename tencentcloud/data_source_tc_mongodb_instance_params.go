@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mongodb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mongodb/v20190725"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudMongodbInstanceParams() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMongodbInstanceParamsRead,
+		ReadContext: dataSourceTencentCloudMongodbInstanceParamsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -262,13 +263,13 @@ func dataSourceTencentCloudMongodbInstanceParams() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMongodbInstanceParamsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMongodbInstanceParamsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mongodb_instance_params.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -279,7 +280,7 @@ func dataSourceTencentCloudMongodbInstanceParamsRead(d *schema.ResourceData, met
 
 	var instanceParam *mongodb.DescribeInstanceParamsResponseParams
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMongodbInstanceParams(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -288,7 +289,7 @@ func dataSourceTencentCloudMongodbInstanceParamsRead(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0)
@@ -483,7 +484,7 @@ func dataSourceTencentCloudMongodbInstanceParamsRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), paramList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -18,6 +18,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudMongodbInstanceSlowLog() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMongodbInstanceSlowLogRead,
+		ReadContext: dataSourceTencentCloudMongodbInstanceSlowLogRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -75,13 +76,13 @@ func dataSourceTencentCloudMongodbInstanceSlowLog() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMongodbInstanceSlowLogRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMongodbInstanceSlowLogRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mongodb_instance_slow_log.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -108,7 +109,7 @@ func dataSourceTencentCloudMongodbInstanceSlowLogRead(d *schema.ResourceData, me
 
 	var slowLogs []*string
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMongodbInstanceSlowLogByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -117,7 +118,7 @@ func dataSourceTencentCloudMongodbInstanceSlowLogRead(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(slowLogs))
@@ -133,7 +134,7 @@ func dataSourceTencentCloudMongodbInstanceSlowLogRead(d *schema.ResourceData, me
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), slowLogs); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -15,17 +15,17 @@ package tencentcloud
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudMongodbInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMongodbInstancesRead,
+		ReadContext: dataSourceTencentCloudMongodbInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -170,11 +170,11 @@ func dataSourceTencentCloudMongodbInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMongodbInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMongodbInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mongodb_instances.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := ""
 	clusterType := -1
@@ -199,7 +199,7 @@ func dataSourceTencentCloudMongodbInstancesRead(d *schema.ResourceData, meta int
 	mongodbService := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	mongodbs, err := mongodbService.DescribeInstancesByFilter(ctx, instanceId, clusterType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceList := make([]map[string]interface{}, 0, len(mongodbs))
@@ -226,7 +226,7 @@ instancesLoop:
 			"MachineType":       "machine type",
 			"ReplicationSetNum": "shard quantity",
 		}); len(nilFields) > 0 {
-			return fmt.Errorf("mongodb %v are nil", nilFields)
+			return diag.Errorf("mongodb %v are nil", nilFields)
 		}
 
 		if !strings.Contains(*mongo.InstanceName, name) {
@@ -236,10 +236,10 @@ instancesLoop:
 		respTags := make(map[string]string, len(mongo.Tags))
 		for _, t := range mongo.Tags {
 			if t.TagKey == nil {
-				return errors.New("mongodb tag key is nil")
+				return diag.FromErr(errors.New("mongodb tag key is nil"))
 			}
 			if t.TagValue == nil {
-				return errors.New("mongodb tag value is nil")
+				return diag.FromErr(errors.New("mongodb tag value is nil"))
 			}
 
 			respTags[*t.TagKey] = *t.TagValue
@@ -295,13 +295,13 @@ instancesLoop:
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if err = d.Set("instance_list", instanceList); err != nil {
 		log.Printf("[CRITAL]%s provider set mongodb instance list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), instanceList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
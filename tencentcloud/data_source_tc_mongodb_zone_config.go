@@ -15,12 +15,13 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTencentCloudMongodbZoneConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMongodbZoneConfigRead,
+		ReadContext: dataSourceTencentCloudMongodbZoneConfigRead,
 
 		Schema: map[string]*schema.Schema{
 			"available_zone": {
@@ -101,11 +102,11 @@ func dataSourceTencentCloudMongodbZoneConfig() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMongodbZoneConfigRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMongodbZoneConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mongodb_zone_config.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	zone := ""
 	if v, ok := d.GetOk("available_zone"); ok {
@@ -116,7 +117,7 @@ func dataSourceTencentCloudMongodbZoneConfigRead(d *schema.ResourceData, meta in
 	}
 	infos, err := mongodbService.DescribeSpecInfo(ctx, zone)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	configList := make([]map[string]interface{}, 0)
@@ -150,13 +151,13 @@ func dataSourceTencentCloudMongodbZoneConfigRead(d *schema.ResourceData, meta in
 	d.SetId(id)
 	if err = d.Set("list", configList); err != nil {
 		log.Printf("[CRITAL]%s provider set mongodb zone config list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), configList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
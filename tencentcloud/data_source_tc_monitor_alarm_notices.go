@@ -23,6 +23,7 @@ import (
 	"crypto/md5"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -30,7 +31,7 @@ import (
 
 func dataSourceTencentMonitorAlarmNotices() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentMonitorAlarmNoticesRead,
+		ReadContext: dataSourceTencentMonitorAlarmNoticesRead,
 		Schema: map[string]*schema.Schema{
 			"order": {
 				Type:        schema.TypeString,
@@ -275,7 +276,7 @@ func dataSourceTencentMonitorAlarmNotices() *schema.Resource {
 	}
 }
 
-func dataSourceTencentMonitorAlarmNoticesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentMonitorAlarmNoticesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_monitor_alarm_notices.read")()
 
 	var (
@@ -286,7 +287,7 @@ func dataSourceTencentMonitorAlarmNoticesRead(d *schema.ResourceData, meta inter
 	)
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	alarmNoticeMap := make(map[string]interface{})
 	alarmNoticeMap["order"] = helper.String(d.Get("order").(string))
@@ -330,7 +331,7 @@ func dataSourceTencentMonitorAlarmNoticesRead(d *schema.ResourceData, meta inter
 
 	alarmNotice, err = monitorService.DescribeAlarmNoticeById(ctx, alarmNoticeMap)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, noticesItem := range alarmNotice {
 		noticesItemMap := map[string]interface{}{
@@ -394,10 +395,10 @@ func dataSourceTencentMonitorAlarmNoticesRead(d *schema.ResourceData, meta inter
 	d.SetId(id)
 
 	if err = d.Set("alarm_notice", alarmNotices); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if output, ok := d.GetOk("result_output_file"); ok {
-		return writeToFile(output.(string), alarmNotices)
+		return diag.FromErr(writeToFile(output.(string), alarmNotices))
 	}
 	return nil
 }
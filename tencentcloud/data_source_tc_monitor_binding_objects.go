@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 )
@@ -27,7 +28,7 @@ import (
 func dataSourceTencentMonitorBindingObjects() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceTencentMonitorBindingObjectRead,
+		ReadContext: dataSourceTencentMonitorBindingObjectRead,
 		Schema: map[string]*schema.Schema{
 			"group_id": {
 				Type:        schema.TypeInt,
@@ -73,11 +74,11 @@ func dataSourceTencentMonitorBindingObjects() *schema.Resource {
 	}
 }
 
-func dataSourceTencentMonitorBindingObjectRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentMonitorBindingObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_monitor_binding_objects.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		monitorService = MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -91,7 +92,7 @@ func dataSourceTencentMonitorBindingObjectRead(d *schema.ResourceData, meta inte
 
 	objects, err = monitorService.DescribeBindingPolicyObjectList(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, event := range objects {
@@ -104,11 +105,11 @@ func dataSourceTencentMonitorBindingObjectRead(d *schema.ResourceData, meta inte
 		list = append(list, listItem)
 	}
 	if err = d.Set("list", list); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%d", id))
 	if output, ok := d.GetOk("result_output_file"); ok {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 }
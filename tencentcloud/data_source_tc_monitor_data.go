@@ -43,9 +43,11 @@ data "tencentcloud_monitor_data" "cos_monitor_data" {
 package tencentcloud
 
 import (
+	"context"
 	"crypto/md5"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -55,7 +57,7 @@ import (
 
 func dataSourceTencentMonitorData() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentMonitorDataRead,
+		ReadContext: dataSourceTencentMonitorDataRead,
 		Schema: map[string]*schema.Schema{
 			"namespace": {
 				Type:        schema.TypeString,
@@ -131,7 +133,7 @@ func dataSourceTencentMonitorData() *schema.Resource {
 	}
 }
 
-func dataSourceTencentMonitorDataRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentMonitorDataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_monitor_data.read")()
 
 	var (
@@ -158,14 +160,16 @@ func dataSourceTencentMonitorDataRead(d *schema.ResourceData, meta interface{})
 		})
 	}
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		if response, err = monitorService.client.UseMonitorClient().GetMonitorData(request); err != nil {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if response, err = monitorService.client.UseMonitorClient().GetMonitorDataWithContext(ctx, request); err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(response.Response.DataPoints) > 0 {
@@ -183,7 +187,7 @@ func dataSourceTencentMonitorDataRead(d *schema.ResourceData, meta interface{})
 	}
 
 	if err = d.Set("list", list); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	md := md5.New()
@@ -191,7 +195,7 @@ func dataSourceTencentMonitorDataRead(d *schema.ResourceData, meta interface{})
 	id := fmt.Sprintf("%x", md.Sum(nil))
 	d.SetId(id)
 	if output, ok := d.GetOk("result_output_file"); ok {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 }
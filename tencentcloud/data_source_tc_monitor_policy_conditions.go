@@ -14,8 +14,10 @@ data "tencentcloud_monitor_policy_conditions" "monitor_policy_conditions" {
 package tencentcloud
 
 import (
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -25,7 +27,7 @@ import (
 
 func dataSourceTencentMonitorPolicyConditions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentMonitorPolicyConditionRead,
+		ReadContext: dataSourceTencentMonitorPolicyConditionRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -244,7 +246,7 @@ func dataSourceTencentMonitorPolicyConditions() *schema.Resource {
 	}
 }
 
-func dataSourceTencentMonitorPolicyConditionRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentMonitorPolicyConditionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_monitor_policy_conditions.read")()
 
 	var (
@@ -258,14 +260,16 @@ func dataSourceTencentMonitorPolicyConditionRead(d *schema.ResourceData, meta in
 
 	request.Module = helper.String("monitor")
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		if response, err = monitorService.client.UseMonitorClient().DescribePolicyConditionList(request); err != nil {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if response, err = monitorService.client.UseMonitorClient().DescribePolicyConditionListWithContext(ctx, request); err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, policyViewItem := range response.Response.Conditions {
 		if name != "" && !strings.Contains(*policyViewItem.Name, name) {
@@ -355,10 +359,10 @@ func dataSourceTencentMonitorPolicyConditionRead(d *schema.ResourceData, meta in
 	}
 	d.SetId("policy_conditions_" + name)
 	if err = d.Set("list", policyViewList); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if output, ok := d.GetOk("result_output_file"); ok {
-		return writeToFile(output.(string), policyViewList)
+		return diag.FromErr(writeToFile(output.(string), policyViewList))
 	}
 	return nil
 }
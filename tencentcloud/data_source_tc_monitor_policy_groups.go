@@ -17,9 +17,11 @@ data "tencentcloud_monitor_policy_groups" "name" {
 package tencentcloud
 
 import (
+	"context"
 	"crypto/md5"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -29,7 +31,7 @@ import (
 
 func dataSourceTencentMonitorPolicyGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentMonitorPolicyGroupsRead,
+		ReadContext: dataSourceTencentMonitorPolicyGroupsRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -308,7 +310,7 @@ func dataSourceTencentMonitorPolicyGroups() *schema.Resource {
 		},
 	}
 }
-func dataSourceTencentMonitorPolicyGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentMonitorPolicyGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_monitor_policy_groups.read")()
 
 	var (
@@ -343,9 +345,11 @@ func dataSourceTencentMonitorPolicyGroupsRead(d *schema.ResourceData, meta inter
 		if finish {
 			break
 		}
-		if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			if response, err = monitorService.client.UseMonitorClient().DescribePolicyGroupList(request); err != nil {
+		if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if response, err = monitorService.client.UseMonitorClient().DescribePolicyGroupListWithContext(ctx, request); err != nil {
 				return retryError(err, InternalError)
 			}
 			groupList = append(groupList, response.Response.GroupList...)
@@ -354,7 +358,7 @@ func dataSourceTencentMonitorPolicyGroupsRead(d *schema.ResourceData, meta inter
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		offset = offset + limit
 	}
@@ -473,7 +477,7 @@ func dataSourceTencentMonitorPolicyGroupsRead(d *schema.ResourceData, meta inter
 		list = append(list, listItem)
 	}
 	if err = d.Set("list", list); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	md := md5.New()
@@ -482,7 +486,7 @@ func dataSourceTencentMonitorPolicyGroupsRead(d *schema.ResourceData, meta inter
 	d.SetId(id)
 
 	if output, ok := d.GetOk("result_output_file"); ok {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 }
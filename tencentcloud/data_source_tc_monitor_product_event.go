@@ -15,11 +15,13 @@ data "tencentcloud_monitor_product_event" "cvm_event_data" {
 package tencentcloud
 
 import (
+	"context"
 	"crypto/md5"
 	"fmt"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -32,7 +34,7 @@ func dataSourceTencentMonitorProductEvent() *schema.Resource {
 	defaultStartTime := time.Now().Unix() - 3600
 
 	return &schema.Resource{
-		Read: dataSourceTencentMonitorProductEventRead,
+		ReadContext: dataSourceTencentMonitorProductEventRead,
 		Schema: map[string]*schema.Schema{
 			"product_name": {
 				Type:        schema.TypeList,
@@ -284,7 +286,7 @@ func dataSourceTencentMonitorProductEvent() *schema.Resource {
 	}
 }
 
-func dataSourceTencentMonitorProductEventRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentMonitorProductEventRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_monitor_product_event.read")()
 
 	var (
@@ -302,7 +304,7 @@ func dataSourceTencentMonitorProductEventRead(d *schema.ResourceData, meta inter
 	request.EndTime = helper.IntInt64(d.Get("end_time").(int))
 
 	if *request.EndTime < *request.StartTime {
-		return fmt.Errorf("field `start_time` should >= `end_time`")
+		return diag.Errorf("field `start_time` should >= `end_time`")
 	}
 
 	for k, v := range map[string]*[]*string{
@@ -329,7 +331,7 @@ func dataSourceTencentMonitorProductEventRead(d *schema.ResourceData, meta inter
 			var mtrDimension monitor.DescribeProductEventListDimensions
 
 			if m["name"] == nil || m["value"] == nil {
-				return fmt.Errorf("miss `name` or `value` from field `dimensions`")
+				return diag.Errorf("miss `name` or `value` from field `dimensions`")
 			}
 			mtrDimension.Name = helper.String(m["name"].(string))
 			mtrDimension.Value = helper.String(m["value"].(string))
@@ -346,7 +348,7 @@ func dataSourceTencentMonitorProductEventRead(d *schema.ResourceData, meta inter
 		for _, strIface := range iface.([]interface{}) {
 			str := strIface.(string)
 			if !helper.StringsContain(monitorEventTypes, str) {
-				return fmt.Errorf("type `%s` no support now", str)
+				return diag.Errorf("type `%s` no support now", str)
 			} else {
 				request.Type = append(request.Type, &str)
 			}
@@ -358,7 +360,7 @@ func dataSourceTencentMonitorProductEventRead(d *schema.ResourceData, meta inter
 		for _, strIface := range iface.([]interface{}) {
 			str := strIface.(string)
 			if !helper.StringsContain(monitorEventStatus, str) {
-				return fmt.Errorf("status `%s` no support now", str)
+				return diag.Errorf("status `%s` no support now", str)
 			} else {
 				request.Status = append(request.Status, &str)
 			}
@@ -370,7 +372,7 @@ func dataSourceTencentMonitorProductEventRead(d *schema.ResourceData, meta inter
 		for _, strIface := range iface.([]interface{}) {
 			str := strIface.(string)
 			if _, err := strconv.ParseInt(str, 10, 64); err != nil {
-				return fmt.Errorf("each element of `project_id` must be an integer ,`%s` not fit the rule ", str)
+				return diag.Errorf("each element of `project_id` must be an integer ,`%s` not fit the rule ", str)
 			} else {
 				request.Project = append(request.Project, &str)
 			}
@@ -383,7 +385,7 @@ func dataSourceTencentMonitorProductEventRead(d *schema.ResourceData, meta inter
 	endTime := d.Get("end_time").(int)
 
 	if endTime < startTime {
-		return fmt.Errorf("`start_time` should <= `end_time`, config not fit the rule")
+		return diag.Errorf("`start_time` should <= `end_time`, config not fit the rule")
 	}
 
 	request.StartTime = helper.IntInt64(startTime)
@@ -399,9 +401,11 @@ func dataSourceTencentMonitorProductEventRead(d *schema.ResourceData, meta inter
 			break
 		}
 
-		if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			if response, err = monitorService.client.UseMonitorClient().DescribeProductEventList(request); err != nil {
+		if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if response, err = monitorService.client.UseMonitorClient().DescribeProductEventListWithContext(ctx, request); err != nil {
 				return retryError(err, InternalError)
 			}
 			events = append(events, response.Response.Events...)
@@ -410,7 +414,7 @@ func dataSourceTencentMonitorProductEventRead(d *schema.ResourceData, meta inter
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		offset = offset + limit
@@ -476,7 +480,7 @@ func dataSourceTencentMonitorProductEventRead(d *schema.ResourceData, meta inter
 	}
 
 	if err = d.Set("list", list); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	md := md5.New()
@@ -485,7 +489,7 @@ func dataSourceTencentMonitorProductEventRead(d *schema.ResourceData, meta inter
 	d.SetId(id)
 
 	if output, ok := d.GetOk("result_output_file"); ok {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 }
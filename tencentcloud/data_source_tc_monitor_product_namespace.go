@@ -13,9 +13,11 @@ data "tencentcloud_monitor_product_namespace" "instances" {
 package tencentcloud
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -25,7 +27,7 @@ import (
 
 func dataSourceTencentMonitorProductNamespace() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentMonitorProductNamespaceRead,
+		ReadContext: dataSourceTencentMonitorProductNamespaceRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -66,7 +68,7 @@ func dataSourceTencentMonitorProductNamespace() *schema.Resource {
 	}
 }
 
-func dataSourceTencentMonitorProductNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentMonitorProductNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_monitor_product_namespace.read")()
 
 	var (
@@ -91,9 +93,11 @@ func dataSourceTencentMonitorProductNamespaceRead(d *schema.ResourceData, meta i
 			break
 		}
 
-		if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			if response, err = monitorService.client.UseMonitorClient().DescribeProductList(request); err != nil {
+		if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if response, err = monitorService.client.UseMonitorClient().DescribeProductListWithContext(ctx, request); err != nil {
 				return retryError(err, InternalError)
 			}
 			products = append(products, response.Response.ProductList...)
@@ -102,7 +106,7 @@ func dataSourceTencentMonitorProductNamespaceRead(d *schema.ResourceData, meta i
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		offset = offset + limit
 	}
@@ -129,11 +133,11 @@ func dataSourceTencentMonitorProductNamespaceRead(d *schema.ResourceData, meta i
 
 	}
 	if err = d.Set("list", list); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("product_namespace_%s", filterName))
 	if output, ok := d.GetOk("result_output_file"); ok {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 }
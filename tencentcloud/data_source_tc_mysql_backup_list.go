@@ -19,6 +19,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
@@ -26,7 +27,7 @@ import (
 func dataSourceTencentMysqlBackupList() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceTencentMysqlBackupListRead,
+		ReadContext: dataSourceTencentMysqlBackupListRead,
 		Schema: map[string]*schema.Schema{
 			"mysql_id": {
 				Type:        schema.TypeString,
@@ -99,11 +100,11 @@ func dataSourceTencentMysqlBackupList() *schema.Resource {
 	}
 }
 
-func dataSourceTencentMysqlBackupListRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentMysqlBackupListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_backup_list.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -111,7 +112,7 @@ func dataSourceTencentMysqlBackupListRead(d *schema.ResourceData, meta interface
 	backInfoItems, err := mysqlService.DescribeBackupsByMysqlId(ctx, d.Get("mysql_id").(string), int64(max_number))
 
 	if err != nil {
-		return fmt.Errorf("api[DescribeBackups]fail, return %s", err.Error())
+		return diag.Errorf("api[DescribeBackups]fail, return %s", err.Error())
 	}
 
 	var itemShemas []map[string]interface{}
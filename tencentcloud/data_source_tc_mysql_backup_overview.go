@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudMysqlBackupOverview() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlBackupOverviewRead,
+		ReadContext: dataSourceTencentCloudMysqlBackupOverviewRead,
 		Schema: map[string]*schema.Schema{
 			"product": {
 				Required:    true,
@@ -81,12 +82,12 @@ func dataSourceTencentCloudMysqlBackupOverview() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlBackupOverviewRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlBackupOverviewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_backup_overview.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	product := ""
 	paramMap := make(map[string]interface{})
@@ -97,7 +98,7 @@ func dataSourceTencentCloudMysqlBackupOverviewRead(d *schema.ResourceData, meta
 
 	var backupCount *cdb.DescribeBackupOverviewResponseParams
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlBackupOverviewByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -106,7 +107,7 @@ func dataSourceTencentCloudMysqlBackupOverviewRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if backupCount.BackupCount != nil {
@@ -141,7 +142,7 @@ func dataSourceTencentCloudMysqlBackupOverviewRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), backupCount); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudMysqlBackupSummaries() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlBackupSummariesRead,
+		ReadContext: dataSourceTencentCloudMysqlBackupSummariesRead,
 		Schema: map[string]*schema.Schema{
 			"product": {
 				Required:    true,
@@ -113,13 +114,13 @@ func dataSourceTencentCloudMysqlBackupSummaries() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlBackupSummariesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlBackupSummariesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_backup_summaries.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("product"); ok {
@@ -136,7 +137,7 @@ func dataSourceTencentCloudMysqlBackupSummariesRead(d *schema.ResourceData, meta
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var backupSummaries []*cdb.BackupSummaryItem
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlBackupSummariesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -145,7 +146,7 @@ func dataSourceTencentCloudMysqlBackupSummariesRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(backupSummaries))
@@ -206,7 +207,7 @@ func dataSourceTencentCloudMysqlBackupSummariesRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudMysqlBinLog() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlBinLogRead,
+		ReadContext: dataSourceTencentCloudMysqlBinLogRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -151,13 +152,13 @@ func dataSourceTencentCloudMysqlBinLog() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlBinLogRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlBinLogRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_bin_log.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -166,7 +167,7 @@ func dataSourceTencentCloudMysqlBinLogRead(d *schema.ResourceData, meta interfac
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var binLog []*cdb.BinlogInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlBinLogByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -175,7 +176,7 @@ func dataSourceTencentCloudMysqlBinLogRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(binLog))
@@ -273,7 +274,7 @@ func dataSourceTencentCloudMysqlBinLogRead(d *schema.ResourceData, meta interfac
 
 		err = d.Set("items", tmpList)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -281,7 +282,7 @@ func dataSourceTencentCloudMysqlBinLogRead(d *schema.ResourceData, meta interfac
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
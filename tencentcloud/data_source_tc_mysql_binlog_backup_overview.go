@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudMysqlBinlogBackupOverview() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlBinlogBackupOverviewRead,
+		ReadContext: dataSourceTencentCloudMysqlBinlogBackupOverviewRead,
 		Schema: map[string]*schema.Schema{
 			"product": {
 				Required:    true,
@@ -87,13 +88,13 @@ func dataSourceTencentCloudMysqlBinlogBackupOverview() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlBinlogBackupOverviewRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlBinlogBackupOverviewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_binlog_backup_overview.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	product := ""
 	paramMap := make(map[string]interface{})
@@ -104,7 +105,7 @@ func dataSourceTencentCloudMysqlBinlogBackupOverviewRead(d *schema.ResourceData,
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var binlogBackupOverview *cdb.DescribeBinlogBackupOverviewResponseParams
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlBinlogBackupOverviewByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -113,7 +114,7 @@ func dataSourceTencentCloudMysqlBinlogBackupOverviewRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if binlogBackupOverview.BinlogBackupVolume != nil {
@@ -152,7 +153,7 @@ func dataSourceTencentCloudMysqlBinlogBackupOverviewRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), binlogBackupOverview); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
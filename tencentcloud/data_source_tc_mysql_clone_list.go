@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudMysqlCloneList() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlCloneListRead,
+		ReadContext: dataSourceTencentCloudMysqlCloneListRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -99,13 +100,13 @@ func dataSourceTencentCloudMysqlCloneList() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlCloneListRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlCloneListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_clone_list.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := ""
 	paramMap := make(map[string]interface{})
@@ -116,7 +117,7 @@ func dataSourceTencentCloudMysqlCloneListRead(d *schema.ResourceData, meta inter
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var cloneList []*cdb.CloneItem
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlCloneListByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -125,7 +126,7 @@ func dataSourceTencentCloudMysqlCloneListRead(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(cloneList))
@@ -178,7 +179,7 @@ func dataSourceTencentCloudMysqlCloneListRead(d *schema.ResourceData, meta inter
 
 		err = d.Set("items", tmpList)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -186,7 +187,7 @@ func dataSourceTencentCloudMysqlCloneListRead(d *schema.ResourceData, meta inter
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
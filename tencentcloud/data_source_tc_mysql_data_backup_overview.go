@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudMysqlDataBackupOverview() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlDataBackupOverviewRead,
+		ReadContext: dataSourceTencentCloudMysqlDataBackupOverviewRead,
 		Schema: map[string]*schema.Schema{
 			"product": {
 				Required:    true,
@@ -111,13 +112,13 @@ func dataSourceTencentCloudMysqlDataBackupOverview() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlDataBackupOverviewRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlDataBackupOverviewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_data_backup_overview.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	product := ""
 	paramMap := make(map[string]interface{})
@@ -128,7 +129,7 @@ func dataSourceTencentCloudMysqlDataBackupOverviewRead(d *schema.ResourceData, m
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var dataBackupOverview *cdb.DescribeDataBackupOverviewResponseParams
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlDataBackupOverviewByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -137,7 +138,7 @@ func dataSourceTencentCloudMysqlDataBackupOverviewRead(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if dataBackupOverview.DataBackupVolume != nil {
@@ -192,7 +193,7 @@ func dataSourceTencentCloudMysqlDataBackupOverviewRead(d *schema.ResourceData, m
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), dataBackupOverview); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
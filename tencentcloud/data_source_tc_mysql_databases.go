@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudMysqlDatabases() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlDatabasesRead,
+		ReadContext: dataSourceTencentCloudMysqlDatabasesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -87,13 +88,13 @@ func dataSourceTencentCloudMysqlDatabases() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlDatabasesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlDatabasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_databases.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -107,7 +108,7 @@ func dataSourceTencentCloudMysqlDatabasesRead(d *schema.ResourceData, meta inter
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var databases *cdb.DescribeDatabasesResponseParams
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlDatabasesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -116,7 +117,7 @@ func dataSourceTencentCloudMysqlDatabasesRead(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if databases.Items != nil {
@@ -151,7 +152,7 @@ func dataSourceTencentCloudMysqlDatabasesRead(d *schema.ResourceData, meta inter
 			"items":         databases.Items,
 			"database_list": tmpList,
 		}); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
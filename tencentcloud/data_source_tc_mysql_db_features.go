@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudMysqlDbFeatures() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlDbFeaturesRead,
+		ReadContext: dataSourceTencentCloudMysqlDbFeaturesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -93,12 +94,12 @@ func dataSourceTencentCloudMysqlDbFeatures() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlDbFeaturesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlDbFeaturesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_db_features.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var instanceId string
 	paramMap := make(map[string]interface{})
@@ -109,7 +110,7 @@ func dataSourceTencentCloudMysqlDbFeaturesRead(d *schema.ResourceData, meta inte
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var dbFeatures *cdb.DescribeDBFeaturesResponseParams
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlDbFeaturesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -118,7 +119,7 @@ func dataSourceTencentCloudMysqlDbFeaturesRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if dbFeatures.IsSupportAudit != nil {
@@ -161,7 +162,7 @@ func dataSourceTencentCloudMysqlDbFeaturesRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
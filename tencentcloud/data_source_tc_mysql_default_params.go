@@ -18,15 +18,16 @@ import (
 	"fmt"
 	"math/rand"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func datasourceTencentCloudMysqlDefaultParams() *schema.Resource {
 	return &schema.Resource{
-		Read: datasourceTencentCloudMysqlDefaultParamsRead,
+		ReadContext: datasourceTencentCloudMysqlDefaultParamsRead,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"db_version": {
@@ -103,12 +104,12 @@ func datasourceTencentCloudMysqlDefaultParams() *schema.Resource {
 	}
 }
 
-func datasourceTencentCloudMysqlDefaultParamsRead(d *schema.ResourceData, meta interface{}) error {
+func datasourceTencentCloudMysqlDefaultParamsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("datasource.tencentcloud_mysql_default_params.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := MysqlService{client: client}
@@ -119,7 +120,7 @@ func datasourceTencentCloudMysqlDefaultParamsRead(d *schema.ResourceData, meta i
 
 	if err != nil {
 		d.SetId("")
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("mysql-params-%d", rand.Intn(1000)))
@@ -148,14 +149,14 @@ func datasourceTencentCloudMysqlDefaultParamsRead(d *schema.ResourceData, meta i
 	if len(paramList) > 0 {
 		err = d.Set("param_list", paramList)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), params); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
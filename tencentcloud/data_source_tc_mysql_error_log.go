@@ -19,6 +19,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -27,7 +28,7 @@ import (
 
 func dataSourceTencentCloudMysqlErrorLog() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlErrorLogRead,
+		ReadContext: dataSourceTencentCloudMysqlErrorLogRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -91,13 +92,13 @@ func dataSourceTencentCloudMysqlErrorLog() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlErrorLogRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlErrorLogRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_error_log.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -123,7 +124,7 @@ func dataSourceTencentCloudMysqlErrorLogRead(d *schema.ResourceData, meta interf
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var result []*cdb.ErrlogItem
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeMysqlErrorLogByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -132,7 +133,7 @@ func dataSourceTencentCloudMysqlErrorLogRead(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(result))
@@ -160,7 +161,7 @@ func dataSourceTencentCloudMysqlErrorLogRead(d *schema.ResourceData, meta interf
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudMysqlInstTables() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlInstTablesRead,
+		ReadContext: dataSourceTencentCloudMysqlInstTablesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -61,13 +62,13 @@ func dataSourceTencentCloudMysqlInstTables() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlInstTablesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlInstTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_inst_tables.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -84,7 +85,7 @@ func dataSourceTencentCloudMysqlInstTablesRead(d *schema.ResourceData, meta inte
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var tables []*string
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlInstTablesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -93,7 +94,7 @@ func dataSourceTencentCloudMysqlInstTablesRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(tables))
@@ -105,7 +106,7 @@ func dataSourceTencentCloudMysqlInstTablesRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
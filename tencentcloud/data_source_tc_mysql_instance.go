@@ -12,9 +12,10 @@ data "tencentcloud_mysql_instance" "mysql" {
 package tencentcloud
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudMysqlInstance() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlInstanceRead,
+		ReadContext: dataSourceTencentCloudMysqlInstanceRead,
 		Schema: map[string]*schema.Schema{
 			"mysql_id": {
 				Type:        schema.TypeString,
@@ -295,7 +296,7 @@ func dataSourceTencentCloudMysqlInstance() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_instance.read")()
 
 	logId := getLogId(contextNil)
@@ -374,11 +375,11 @@ func dataSourceTencentCloudMysqlInstanceRead(d *schema.ResourceData, meta interf
 	request.Limit = &limitValue
 
 	client := meta.(*TencentCloudClient).apiV3Conn
-	response, err := client.UseMysqlClient().DescribeDBInstances(request)
+	response, err := client.UseMysqlClient().DescribeDBInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
-		return fmt.Errorf("api[DescribeDBInstances]fail, return %s", err.Error())
+		return diag.Errorf("api[DescribeDBInstances]fail, return %s", err.Error())
 	}
 
 	instanceDetails := response.Response.Items
@@ -456,7 +457,7 @@ func dataSourceTencentCloudMysqlInstanceRead(d *schema.ResourceData, meta interf
 	if ok && output.(string) != "" {
 		err = writeToFile(output.(string), instanceList)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudMysqlInstanceCharset() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlInstanceCharsetRead,
+		ReadContext: dataSourceTencentCloudMysqlInstanceCharsetRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -44,13 +45,13 @@ func dataSourceTencentCloudMysqlInstanceCharset() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlInstanceCharsetRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlInstanceCharsetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_instance_charset.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var instanceId string
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -59,7 +60,7 @@ func dataSourceTencentCloudMysqlInstanceCharsetRead(d *schema.ResourceData, meta
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var instanceCharset *cdb.DescribeDBInstanceCharsetResponseParams
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlInstanceCharsetByFilter(ctx, instanceId)
 		if e != nil {
 			return retryError(e)
@@ -68,7 +69,7 @@ func dataSourceTencentCloudMysqlInstanceCharsetRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instanceCharset != nil {
@@ -79,7 +80,7 @@ func dataSourceTencentCloudMysqlInstanceCharsetRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
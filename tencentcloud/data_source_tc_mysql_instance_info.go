@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudMysqlInstanceInfo() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlInstanceInfoRead,
+		ReadContext: dataSourceTencentCloudMysqlInstanceInfoRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -68,12 +69,12 @@ func dataSourceTencentCloudMysqlInstanceInfo() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlInstanceInfoRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlInstanceInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_instance_info.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var instanceId string
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -82,7 +83,7 @@ func dataSourceTencentCloudMysqlInstanceInfoRead(d *schema.ResourceData, meta in
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var instanceInfo *cdb.DescribeDBInstanceInfoResponseParams
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlInstanceInfoById(ctx, instanceId)
 		if e != nil {
 			return retryError(e)
@@ -91,7 +92,7 @@ func dataSourceTencentCloudMysqlInstanceInfoRead(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instanceInfo.InstanceName != nil {
@@ -118,7 +119,7 @@ func dataSourceTencentCloudMysqlInstanceInfoRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudMysqlInstanceParamRecord() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlInstanceParamRecordRead,
+		ReadContext: dataSourceTencentCloudMysqlInstanceParamRecordRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -79,13 +80,13 @@ func dataSourceTencentCloudMysqlInstanceParamRecord() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlInstanceParamRecordRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlInstanceParamRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_instance_param_record.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -94,7 +95,7 @@ func dataSourceTencentCloudMysqlInstanceParamRecordRead(d *schema.ResourceData,
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var instanceParamRecord []*cdb.ParamRecord
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlInstanceParamRecordByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -103,7 +104,7 @@ func dataSourceTencentCloudMysqlInstanceParamRecordRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(instanceParamRecord))
@@ -147,7 +148,7 @@ func dataSourceTencentCloudMysqlInstanceParamRecordRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudMysqlInstanceRebootTime() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlInstanceRebootTimeRead,
+		ReadContext: dataSourceTencentCloudMysqlInstanceRebootTimeRead,
 		Schema: map[string]*schema.Schema{
 			"instance_ids": {
 				Required: true,
@@ -62,13 +63,13 @@ func dataSourceTencentCloudMysqlInstanceRebootTime() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlInstanceRebootTimeRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlInstanceRebootTimeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_instance_reboot_time.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_ids"); ok {
@@ -78,7 +79,7 @@ func dataSourceTencentCloudMysqlInstanceRebootTimeRead(d *schema.ResourceData, m
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var instanceRebootTime []*cdb.InstanceRebootTime
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlInstanceRebootTimeByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -87,7 +88,7 @@ func dataSourceTencentCloudMysqlInstanceRebootTimeRead(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(instanceRebootTime))
@@ -115,7 +116,7 @@ func dataSourceTencentCloudMysqlInstanceRebootTimeRead(d *schema.ResourceData, m
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
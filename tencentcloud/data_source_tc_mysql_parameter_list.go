@@ -15,9 +15,9 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -78,7 +78,7 @@ func TencentCloudMysqlParameterDetail() map[string]*schema.Schema {
 
 func dataSourceTencentCloudMysqlParameterList() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentMysqlParameterListRead,
+		ReadContext: dataSourceTencentMysqlParameterListRead,
 		Schema: map[string]*schema.Schema{
 			"mysql_id": {
 				Type:        schema.TypeString,
@@ -108,11 +108,11 @@ func dataSourceTencentCloudMysqlParameterList() *schema.Resource {
 	}
 }
 
-func dataSourceTencentMysqlParameterListRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentMysqlParameterListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_parameter_list.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	mysqlService := MysqlService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -128,10 +128,10 @@ func dataSourceTencentMysqlParameterListRead(d *schema.ResourceData, meta interf
 		engineVersionString = engineVersion.(string)
 		parameterDetails, err = mysqlService.DescribeDefaultParameters(ctx, engineVersionString)
 	} else {
-		return fmt.Errorf("mysql_id and engine_version cannot be empty at the same time")
+		return diag.Errorf("mysql_id and engine_version cannot be empty at the same time")
 	}
 	if err != nil {
-		return fmt.Errorf("api[DescribeParameters]fail, return %s", err.Error())
+		return diag.Errorf("api[DescribeParameters]fail, return %s", err.Error())
 	}
 
 	parameterList := make([]map[string]interface{}, 0, len(parameterDetails))
@@ -162,7 +162,7 @@ func dataSourceTencentMysqlParameterListRead(d *schema.ResourceData, meta interf
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err = writeToFile(output.(string), parameterList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudMysqlProjectSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlProjectSecurityGroupRead,
+		ReadContext: dataSourceTencentCloudMysqlProjectSecurityGroupRead,
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Optional:    true,
@@ -152,13 +153,13 @@ func dataSourceTencentCloudMysqlProjectSecurityGroup() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlProjectSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlProjectSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_project_security_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, _ := d.GetOk("project_id"); v != nil {
@@ -167,7 +168,7 @@ func dataSourceTencentCloudMysqlProjectSecurityGroupRead(d *schema.ResourceData,
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var groups []*cdb.SecurityGroup
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlProjectSecurityGroupByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -176,7 +177,7 @@ func dataSourceTencentCloudMysqlProjectSecurityGroupRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(groups))
@@ -286,7 +287,7 @@ func dataSourceTencentCloudMysqlProjectSecurityGroupRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
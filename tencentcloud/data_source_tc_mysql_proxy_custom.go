@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudMysqlProxyCustom() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlProxyCustomRead,
+		ReadContext: dataSourceTencentCloudMysqlProxyCustomRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -93,13 +94,13 @@ func dataSourceTencentCloudMysqlProxyCustom() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlProxyCustomRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlProxyCustomRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_proxy_custom.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var instanceId string
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -108,7 +109,7 @@ func dataSourceTencentCloudMysqlProxyCustomRead(d *schema.ResourceData, meta int
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var proxyCustom *cdb.DescribeProxyCustomConfResponseParams
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlProxyCustomById(ctx, instanceId)
 		if e != nil {
 			return retryError(e)
@@ -117,7 +118,7 @@ func dataSourceTencentCloudMysqlProxyCustomRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if proxyCustom.CustomConf != nil {
@@ -164,7 +165,7 @@ func dataSourceTencentCloudMysqlProxyCustomRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
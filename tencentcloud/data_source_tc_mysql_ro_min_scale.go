@@ -16,6 +16,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudMysqlRoMinScale() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlRoMinScaleRead,
+		ReadContext: dataSourceTencentCloudMysqlRoMinScaleRead,
 		Schema: map[string]*schema.Schema{
 			"ro_instance_id": {
 				Optional:    true,
@@ -59,13 +60,13 @@ func dataSourceTencentCloudMysqlRoMinScale() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlRoMinScaleRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlRoMinScaleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_ro_min_scale.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("ro_instance_id"); ok {
@@ -78,7 +79,7 @@ func dataSourceTencentCloudMysqlRoMinScaleRead(d *schema.ResourceData, meta inte
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var minScale *cdb.DescribeRoMinScaleResponseParams
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlRoMinScaleByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -87,7 +88,7 @@ func dataSourceTencentCloudMysqlRoMinScaleRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if minScale.Memory != nil {
@@ -105,7 +106,7 @@ func dataSourceTencentCloudMysqlRoMinScaleRead(d *schema.ResourceData, meta inte
 			"memory": minScale.Memory,
 			"volume": minScale.Volume,
 		}); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudMysqlRollbackRangeTime() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlRollbackRangeTimeRead,
+		ReadContext: dataSourceTencentCloudMysqlRollbackRangeTimeRead,
 		Schema: map[string]*schema.Schema{
 			"instance_ids": {
 				Required: true,
@@ -98,13 +99,13 @@ func dataSourceTencentCloudMysqlRollbackRangeTime() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlRollbackRangeTimeRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlRollbackRangeTimeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_rollback_range_time.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_ids"); ok {
@@ -122,7 +123,7 @@ func dataSourceTencentCloudMysqlRollbackRangeTimeRead(d *schema.ResourceData, me
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var items []*cdb.InstanceRollbackRangeTime
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlRollbackRangeTimeByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -131,7 +132,7 @@ func dataSourceTencentCloudMysqlRollbackRangeTimeRead(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(items))
@@ -183,7 +184,7 @@ func dataSourceTencentCloudMysqlRollbackRangeTimeRead(d *schema.ResourceData, me
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
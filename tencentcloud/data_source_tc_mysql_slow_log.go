@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudMysqlSlowLog() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlSlowLogRead,
+		ReadContext: dataSourceTencentCloudMysqlSlowLogRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -79,13 +80,13 @@ func dataSourceTencentCloudMysqlSlowLog() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlSlowLogRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlSlowLogRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_slow_log.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var instanceId string
 	paramMap := make(map[string]interface{})
@@ -96,7 +97,7 @@ func dataSourceTencentCloudMysqlSlowLogRead(d *schema.ResourceData, meta interfa
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var items []*cdb.SlowLogInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlSlowLogByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -105,7 +106,7 @@ func dataSourceTencentCloudMysqlSlowLogRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(items))
@@ -147,7 +148,7 @@ func dataSourceTencentCloudMysqlSlowLogRead(d *schema.ResourceData, meta interfa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
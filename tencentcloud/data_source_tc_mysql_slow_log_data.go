@@ -22,6 +22,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -30,7 +31,7 @@ import (
 
 func dataSourceTencentCloudMysqlSlowLogData() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlSlowLogDataRead,
+		ReadContext: dataSourceTencentCloudMysqlSlowLogDataRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -169,12 +170,12 @@ func dataSourceTencentCloudMysqlSlowLogData() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlSlowLogDataRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlSlowLogDataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_slow_log_data.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var instanceId string
 	paramMap := make(map[string]interface{})
@@ -220,7 +221,7 @@ func dataSourceTencentCloudMysqlSlowLogDataRead(d *schema.ResourceData, meta int
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var items []*cdb.SlowLogItem
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlSlowLogDataByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -229,7 +230,7 @@ func dataSourceTencentCloudMysqlSlowLogDataRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(items))
@@ -291,7 +292,7 @@ func dataSourceTencentCloudMysqlSlowLogDataRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
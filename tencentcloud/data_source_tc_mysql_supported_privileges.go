@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudMysqlSupportedPrivileges() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlSupportedPrivilegesRead,
+		ReadContext: dataSourceTencentCloudMysqlSupportedPrivilegesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -74,12 +75,12 @@ func dataSourceTencentCloudMysqlSupportedPrivileges() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlSupportedPrivilegesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlSupportedPrivilegesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_supported_privileges.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var instanceId string
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -88,7 +89,7 @@ func dataSourceTencentCloudMysqlSupportedPrivilegesRead(d *schema.ResourceData,
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var supportedPrivileges *cdb.DescribeSupportedPrivilegesResponseParams
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlSupportedPrivilegesById(ctx, instanceId)
 		if e != nil {
 			return retryError(e)
@@ -97,7 +98,7 @@ func dataSourceTencentCloudMysqlSupportedPrivilegesRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if supportedPrivileges.GlobalSupportedPrivileges != nil {
@@ -120,7 +121,7 @@ func dataSourceTencentCloudMysqlSupportedPrivilegesRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudMysqlSwitchRecord() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlSwitchRecordRead,
+		ReadContext: dataSourceTencentCloudMysqlSwitchRecordRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -58,13 +59,13 @@ func dataSourceTencentCloudMysqlSwitchRecord() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlSwitchRecordRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlSwitchRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_switch_record.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var instanceId string
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -73,7 +74,7 @@ func dataSourceTencentCloudMysqlSwitchRecordRead(d *schema.ResourceData, meta in
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var items []*cdb.DBSwitchInfo
-	err := resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, 5*readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlSwitchRecordById(ctx, instanceId)
 		if e != nil {
 			return retryError(e)
@@ -82,7 +83,7 @@ func dataSourceTencentCloudMysqlSwitchRecordRead(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(items))
@@ -108,7 +109,7 @@ func dataSourceTencentCloudMysqlSwitchRecordRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
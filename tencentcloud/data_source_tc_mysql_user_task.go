@@ -20,6 +20,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -28,7 +29,7 @@ import (
 
 func dataSourceTencentCloudMysqlUserTask() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudMysqlUserTaskRead,
+		ReadContext: dataSourceTencentCloudMysqlUserTaskRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Optional:    true,
@@ -144,13 +145,13 @@ func dataSourceTencentCloudMysqlUserTask() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudMysqlUserTaskRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudMysqlUserTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_user_task.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -182,7 +183,7 @@ func dataSourceTencentCloudMysqlUserTaskRead(d *schema.ResourceData, meta interf
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var items []*cdb.TaskDetail
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeMysqlUserTaskByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -191,7 +192,7 @@ func dataSourceTencentCloudMysqlUserTaskRead(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(items))
@@ -251,7 +252,7 @@ func dataSourceTencentCloudMysqlUserTaskRead(d *schema.ResourceData, meta interf
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
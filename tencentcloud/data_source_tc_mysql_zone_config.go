@@ -18,6 +18,7 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
 )
@@ -154,7 +155,7 @@ func TencentMysqlZoneConfig() map[string]*schema.Schema {
 
 func dataSourceTencentMysqlZoneConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentMysqlZoneConfigRead,
+		ReadContext: dataSourceTencentMysqlZoneConfigRead,
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:        schema.TypeString,
@@ -179,11 +180,11 @@ func dataSourceTencentMysqlZoneConfig() *schema.Resource {
 	}
 }
 
-func dataSourceTencentMysqlZoneConfigRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentMysqlZoneConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mysql_zone_config.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	region := meta.(*TencentCloudClient).apiV3Conn.Region
@@ -195,7 +196,7 @@ func dataSourceTencentMysqlZoneConfigRead(d *schema.ResourceData, meta interface
 
 	sellConfigures, err := mysqlService.DescribeDBZoneConfig(ctx)
 	if err != nil {
-		return fmt.Errorf("api[DescribeBackups]fail, return %s", err.Error())
+		return diag.Errorf("api[DescribeBackups]fail, return %s", err.Error())
 	}
 	var regionItem *cdb.CdbRegionSellConf
 
@@ -246,7 +247,7 @@ func dataSourceTencentMysqlZoneConfigRead(d *schema.ResourceData, meta interface
 			if tempInt, err := strconv.ParseInt(*strPtr, 10, 64); err != nil {
 				errRet := fmt.Errorf("api[DescribeDBZoneConfig]return PayType error,not int")
 				log.Printf("[CRITAL]%s %s\n ", logId, errRet.Error())
-				return errRet
+				return diag.FromErr(errRet)
 			} else {
 				payTypes[index] = int(tempInt)
 			}
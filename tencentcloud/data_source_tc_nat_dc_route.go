@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudNatDcRoute() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudNatDcRouteRead,
+		ReadContext: dataSourceTencentCloudNatDcRouteRead,
 		Schema: map[string]*schema.Schema{
 			"nat_gateway_id": {
 				Required:    true,
@@ -81,13 +82,13 @@ func dataSourceTencentCloudNatDcRoute() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudNatDcRouteRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudNatDcRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_nat_dc_route.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("nat_gateway_id"); ok {
@@ -102,7 +103,7 @@ func dataSourceTencentCloudNatDcRouteRead(d *schema.ResourceData, meta interface
 
 	var natDirectConnectGatewayRouteSet []*vpc.NatDirectConnectGatewayRoute
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeNatDcRouteByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -111,7 +112,7 @@ func dataSourceTencentCloudNatDcRouteRead(d *schema.ResourceData, meta interface
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(natDirectConnectGatewayRouteSet))
@@ -152,7 +153,7 @@ func dataSourceTencentCloudNatDcRouteRead(d *schema.ResourceData, meta interface
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
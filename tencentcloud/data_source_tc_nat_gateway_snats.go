@@ -19,6 +19,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudNatGatewaySnats() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudNatGatewaySnatRead,
+		ReadContext: dataSourceTencentCloudNatGatewaySnatRead,
 
 		Schema: map[string]*schema.Schema{
 			"nat_gateway_id": {
@@ -74,15 +75,15 @@ func dataSourceTencentCloudNatGatewaySnats() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudNatGatewaySnatRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudNatGatewaySnatRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_nat_gateway_snats.read")()
 
 	var (
 		logId        = getLogId(contextNil)
-		ctx          = context.WithValue(context.TODO(), logIdKey, logId)
 		natGatewayId = d.Get("nat_gateway_id").(string)
 		vpcService   = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params := make(map[string]string)
 	if v, ok := d.GetOk("subnet_id"); ok {
@@ -109,7 +110,7 @@ func dataSourceTencentCloudNatGatewaySnatRead(d *schema.ResourceData, meta inter
 	err, result := vpcService.DescribeNatGatewaySnats(ctx, natGatewayId, filters)
 	if err != nil {
 		log.Printf("[CRITAL]%s read nat gateway snat failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	ids := make([]string, len(result))
 	snatList := make([]map[string]interface{}, len(result))
@@ -127,13 +128,13 @@ func dataSourceTencentCloudNatGatewaySnatRead(d *schema.ResourceData, meta inter
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("snat_list", snatList); e != nil {
 		log.Printf("[CRITAL]%s provider set nat gateway snat list fail, reason:%s\n ", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), snatList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
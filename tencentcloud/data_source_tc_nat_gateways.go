@@ -14,8 +14,10 @@ data "tencentcloud_nat_gateways" "foo" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -24,7 +26,7 @@ import (
 
 func dataSourceTencentCloudNatGateways() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudNatGatewaysRead,
+		ReadContext: dataSourceTencentCloudNatGatewaysRead,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -108,7 +110,7 @@ func dataSourceTencentCloudNatGateways() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudNatGatewaysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudNatGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_nat_gateways.read")()
 
 	logId := getLogId(contextNil)
@@ -139,8 +141,8 @@ func dataSourceTencentCloudNatGatewaysRead(d *schema.ResourceData, meta interfac
 	request.Limit = &limit
 	for {
 		var response *vpc.DescribeNatGatewaysResponse
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeNatGateways(request)
+		err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeNatGatewaysWithContext(ctx, request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -151,7 +153,7 @@ func dataSourceTencentCloudNatGatewaysRead(d *schema.ResourceData, meta interfac
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s read NAT gateway failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		} else {
 			result = append(result, response.Response.NatGatewaySet...)
 			if len(response.Response.NatGatewaySet) < NAT_DESCRIBE_LIMIT {
@@ -188,13 +190,13 @@ func dataSourceTencentCloudNatGatewaysRead(d *schema.ResourceData, meta interfac
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("nats", natList); e != nil {
 		log.Printf("[CRITAL]%s provider set NAT list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), natList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
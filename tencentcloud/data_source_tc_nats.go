@@ -28,8 +28,10 @@ data "tencentcloud_nats" "multi_nat" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -39,7 +41,7 @@ import (
 func dataSourceTencentCloudNats() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource has been deprecated in Terraform TencentCloud provider version 1.18.0. Please use 'tencentcloud_nat_gateways' instead.",
-		Read:               dataSourceTencentCloudNatsRead,
+		ReadContext:        dataSourceTencentCloudNatsRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -127,7 +129,7 @@ func dataSourceTencentCloudNats() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudNatsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudNatsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_nats.read")()
 
 	logId := getLogId(contextNil)
@@ -156,8 +158,8 @@ func dataSourceTencentCloudNatsRead(d *schema.ResourceData, meta interface{}) er
 	}
 
 	var response *vpc.DescribeNatGatewaysResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeNatGateways(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeNatGatewaysWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -168,7 +170,7 @@ func dataSourceTencentCloudNatsRead(d *schema.ResourceData, meta interface{}) er
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read nat gateway failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(response.Response.NatGatewaySet))
@@ -211,7 +213,7 @@ func dataSourceTencentCloudNatsRead(d *schema.ResourceData, meta interface{}) er
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("nats", natList); e != nil {
 		log.Printf("[CRITAL]%s provider set clb list fail, reason:%s\n ", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	return nil
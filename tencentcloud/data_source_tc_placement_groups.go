@@ -16,6 +16,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudPlacementGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudPlacementGroupsRead,
+		ReadContext: dataSourceTencentCloudPlacementGroupsRead,
 
 		Schema: map[string]*schema.Schema{
 			"placement_group_id": {
@@ -92,10 +93,10 @@ func dataSourceTencentCloudPlacementGroups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudPlacementGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudPlacementGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_placement_groups.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cvmService := CvmService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -111,7 +112,7 @@ func dataSourceTencentCloudPlacementGroupsRead(d *schema.ResourceData, meta inte
 
 	var placementGroups []*cvm.DisasterRecoverGroup
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		placementGroups, errRet = cvmService.DescribePlacementGroupByFilter(ctx, placementGroupId, name)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -119,7 +120,7 @@ func dataSourceTencentCloudPlacementGroupsRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	placementGroupList := make([]map[string]interface{}, 0, len(placementGroups))
@@ -142,13 +143,13 @@ func dataSourceTencentCloudPlacementGroupsRead(d *schema.ResourceData, meta inte
 	err = d.Set("placement_group_list", placementGroupList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set placement group list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), placementGroupList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -36,6 +36,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -44,7 +45,7 @@ import (
 
 func dataSourceTencentCloudPostgresqlBackupDownloadUrls() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudPostgresqlBackupDownloadUrlsRead,
+		ReadContext: dataSourceTencentCloudPostgresqlBackupDownloadUrlsRead,
 		Schema: map[string]*schema.Schema{
 			"db_instance_id": {
 				Required:    true,
@@ -127,14 +128,14 @@ func dataSourceTencentCloudPostgresqlBackupDownloadUrls() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudPostgresqlBackupDownloadUrlsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudPostgresqlBackupDownloadUrlsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_postgresql_backup_download_urls.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 	var id string
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("db_instance_id"); ok {
@@ -179,7 +180,7 @@ func dataSourceTencentCloudPostgresqlBackupDownloadUrlsRead(d *schema.ResourceDa
 	service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var backupDownloadURL *string
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribePostgresqlBackupDownloadUrlsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -188,7 +189,7 @@ func dataSourceTencentCloudPostgresqlBackupDownloadUrlsRead(d *schema.ResourceDa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if backupDownloadURL != nil {
@@ -199,7 +200,7 @@ func dataSourceTencentCloudPostgresqlBackupDownloadUrlsRead(d *schema.ResourceDa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), backupDownloadURL); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -28,6 +28,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -36,7 +37,7 @@ import (
 
 func dataSourceTencentCloudPostgresqlBaseBackups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudPostgresqlBaseBackupsRead,
+		ReadContext: dataSourceTencentCloudPostgresqlBaseBackupsRead,
 		Schema: map[string]*schema.Schema{
 			"min_finish_time": {
 				Optional:    true,
@@ -154,13 +155,13 @@ func dataSourceTencentCloudPostgresqlBaseBackups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudPostgresqlBaseBackupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudPostgresqlBaseBackupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_postgresql_base_backups.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("min_finish_time"); ok {
@@ -203,7 +204,7 @@ func dataSourceTencentCloudPostgresqlBaseBackupsRead(d *schema.ResourceData, met
 
 	var baseBackupSet []*postgresql.BaseBackup
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribePostgresqlBaseBackupsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -212,7 +213,7 @@ func dataSourceTencentCloudPostgresqlBaseBackupsRead(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(baseBackupSet))
@@ -273,7 +274,7 @@ func dataSourceTencentCloudPostgresqlBaseBackupsRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudPostgresqlDbInstanceClasses() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudPostgresqlDbInstanceClassesRead,
+		ReadContext: dataSourceTencentCloudPostgresqlDbInstanceClassesRead,
 		Schema: map[string]*schema.Schema{
 			"zone": {
 				Required:    true,
@@ -93,13 +94,13 @@ func dataSourceTencentCloudPostgresqlDbInstanceClasses() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudPostgresqlDbInstanceClassesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudPostgresqlDbInstanceClassesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_postgresql_db_instance_classes.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("zone"); ok {
@@ -118,7 +119,7 @@ func dataSourceTencentCloudPostgresqlDbInstanceClassesRead(d *schema.ResourceDat
 
 	var classInfoSet []*postgresql.ClassInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribePostgresqlDbInstanceClassesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -127,7 +128,7 @@ func dataSourceTencentCloudPostgresqlDbInstanceClassesRead(d *schema.ResourceDat
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(classInfoSet))
@@ -172,7 +173,7 @@ func dataSourceTencentCloudPostgresqlDbInstanceClassesRead(d *schema.ResourceDat
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudPostgresqlDbInstanceVersions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudPostgresqlDbInstanceVersionsRead,
+		ReadContext: dataSourceTencentCloudPostgresqlDbInstanceVersionsRead,
 		Schema: map[string]*schema.Schema{
 			"version_set": {
 				Computed:    true,
@@ -82,19 +83,19 @@ func dataSourceTencentCloudPostgresqlDbInstanceVersions() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudPostgresqlDbInstanceVersionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudPostgresqlDbInstanceVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_postgresql_db_instance_versions.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var versionSet []*postgresql.Version
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribePostgresqlDbInstanceVersionsByFilter(ctx)
 		if e != nil {
 			return retryError(e)
@@ -103,7 +104,7 @@ func dataSourceTencentCloudPostgresqlDbInstanceVersionsRead(d *schema.ResourceDa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(versionSet))
@@ -152,7 +153,7 @@ func dataSourceTencentCloudPostgresqlDbInstanceVersionsRead(d *schema.ResourceDa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudPostgresqlDefaultParameters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudPostgresqlDefaultParametersRead,
+		ReadContext: dataSourceTencentCloudPostgresqlDefaultParametersRead,
 		Schema: map[string]*schema.Schema{
 			"db_major_version": {
 				Required:    true,
@@ -243,13 +244,13 @@ func dataSourceTencentCloudPostgresqlDefaultParameters() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudPostgresqlDefaultParametersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudPostgresqlDefaultParametersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_postgresql_default_parameters.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("db_major_version"); ok {
@@ -264,7 +265,7 @@ func dataSourceTencentCloudPostgresqlDefaultParametersRead(d *schema.ResourceDat
 
 	var paramInfoSet []*postgresql.ParamInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribePostgresqlDefaultParametersByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -273,7 +274,7 @@ func dataSourceTencentCloudPostgresqlDefaultParametersRead(d *schema.ResourceDat
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(paramInfoSet))
@@ -444,7 +445,7 @@ func dataSourceTencentCloudPostgresqlDefaultParametersRead(d *schema.ResourceDat
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
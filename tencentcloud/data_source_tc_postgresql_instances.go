@@ -23,6 +23,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -30,7 +31,7 @@ import (
 
 func dataSourceTencentCloudPostgresqlInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudPostgresqlInstanceRead,
+		ReadContext: dataSourceTencentCloudPostgresqlInstanceRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -165,11 +166,11 @@ func dataSourceTencentCloudPostgresqlInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudPostgresqlInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_postgresql_instances.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -190,7 +191,7 @@ func dataSourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta i
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(instanceList))
@@ -218,7 +219,7 @@ func dataSourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta i
 		// rootUser
 		accounts, outErr := service.DescribeRootUser(ctx, *v.DBInstanceId)
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 		if len(accounts) > 0 {
 			listItem["root_user"] = accounts[0].UserName
@@ -247,7 +248,7 @@ func dataSourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta i
 		//the describe list API is delayed with argument `tag`
 		tagList, err := tagService.DescribeResourceTags(ctx, "postgres", "DBInstanceId", tcClient.Region, *v.DBInstanceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		listItem["tags"] = tagList
@@ -259,12 +260,12 @@ func dataSourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta i
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("instance_list", list); e != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 
 	return nil
@@ -21,6 +21,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -29,7 +30,7 @@ import (
 
 func dataSourceTencentCloudPostgresqlLogBackups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudPostgresqlLogBackupsRead,
+		ReadContext: dataSourceTencentCloudPostgresqlLogBackupsRead,
 		Schema: map[string]*schema.Schema{
 			"min_finish_time": {
 				Optional:    true,
@@ -147,13 +148,13 @@ func dataSourceTencentCloudPostgresqlLogBackups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudPostgresqlLogBackupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudPostgresqlLogBackupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_postgresql_log_backups.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("min_finish_time"); ok {
@@ -196,7 +197,7 @@ func dataSourceTencentCloudPostgresqlLogBackupsRead(d *schema.ResourceData, meta
 
 	var logBackupSet []*postgresql.LogBackup
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribePostgresqlLogBackupsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -205,7 +206,7 @@ func dataSourceTencentCloudPostgresqlLogBackupsRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(logBackupSet))
@@ -266,7 +267,7 @@ func dataSourceTencentCloudPostgresqlLogBackupsRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -23,6 +23,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -31,7 +32,7 @@ import (
 
 func dataSourceTencentCloudPostgresqlParameterTemplates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudPostgresqlParameterTemplatesRead,
+		ReadContext: dataSourceTencentCloudPostgresqlParameterTemplatesRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
 				Optional:    true,
@@ -112,13 +113,13 @@ func dataSourceTencentCloudPostgresqlParameterTemplates() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudPostgresqlParameterTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudPostgresqlParameterTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_postgresql_parameter_templates.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("filters"); ok {
@@ -153,7 +154,7 @@ func dataSourceTencentCloudPostgresqlParameterTemplatesRead(d *schema.ResourceDa
 
 	var parameterTemplateSet []*postgresql.ParameterTemplate
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribePostgresqlParameterTemplatesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -162,7 +163,7 @@ func dataSourceTencentCloudPostgresqlParameterTemplatesRead(d *schema.ResourceDa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	ids := make([]string, 0, len(parameterTemplateSet))
 	tmpList := make([]map[string]interface{}, 0, len(parameterTemplateSet))
@@ -202,7 +203,7 @@ func dataSourceTencentCloudPostgresqlParameterTemplatesRead(d *schema.ResourceDa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
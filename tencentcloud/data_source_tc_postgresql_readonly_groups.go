@@ -32,6 +32,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -40,7 +41,7 @@ import (
 
 func dataSourceTencentCloudPostgresqlReadonlyGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudPostgresqlReadonlyGroupsRead,
+		ReadContext: dataSourceTencentCloudPostgresqlReadonlyGroupsRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
 				Optional:    true,
@@ -589,13 +590,13 @@ func dataSourceTencentCloudPostgresqlReadonlyGroups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudPostgresqlReadonlyGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudPostgresqlReadonlyGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_postgresql_readonly_groups.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("filters"); ok {
@@ -630,7 +631,7 @@ func dataSourceTencentCloudPostgresqlReadonlyGroupsRead(d *schema.ResourceData,
 
 	var readOnlyGroupList []*postgresql.ReadOnlyGroup
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribePostgresqlReadonlyGroupsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -639,7 +640,7 @@ func dataSourceTencentCloudPostgresqlReadonlyGroupsRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(readOnlyGroupList))
@@ -1081,7 +1082,7 @@ func dataSourceTencentCloudPostgresqlReadonlyGroupsRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudPostgresqlRecoveryTime() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudPostgresqlRecoveryTimeRead,
+		ReadContext: dataSourceTencentCloudPostgresqlRecoveryTimeRead,
 		Schema: map[string]*schema.Schema{
 			"db_instance_id": {
 				Required:    true,
@@ -51,13 +52,13 @@ func dataSourceTencentCloudPostgresqlRecoveryTime() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudPostgresqlRecoveryTimeRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudPostgresqlRecoveryTimeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_postgresql_recovery_time.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var (
 		result       *postgresql.DescribeAvailableRecoveryTimeResponseParams
 		e            error
@@ -72,7 +73,7 @@ func dataSourceTencentCloudPostgresqlRecoveryTimeRead(d *schema.ResourceData, me
 
 	service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e = service.DescribePostgresqlRecoveryTimeByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -80,7 +81,7 @@ func dataSourceTencentCloudPostgresqlRecoveryTimeRead(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if result.RecoveryBeginTime != nil {
@@ -96,7 +97,7 @@ func dataSourceTencentCloudPostgresqlRecoveryTimeRead(d *schema.ResourceData, me
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), result); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
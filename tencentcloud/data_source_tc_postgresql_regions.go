@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudPostgresqlRegions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudPostgresqlRegionsRead,
+		ReadContext: dataSourceTencentCloudPostgresqlRegionsRead,
 		Schema: map[string]*schema.Schema{
 			"region_set": {
 				Computed:    true,
@@ -66,19 +67,19 @@ func dataSourceTencentCloudPostgresqlRegions() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudPostgresqlRegionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudPostgresqlRegionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_postgresql_regions.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var regionSet []*postgresql.RegionInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribePostgresqlRegionsByFilter(ctx)
 		if e != nil {
 			return retryError(e)
@@ -87,7 +88,7 @@ func dataSourceTencentCloudPostgresqlRegionsRead(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(regionSet))
@@ -128,7 +129,7 @@ func dataSourceTencentCloudPostgresqlRegionsRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -15,12 +15,13 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTencentCloudPostgresqlSpecinfos() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudPostgresqlSpecinfosRead,
+		ReadContext: dataSourceTencentCloudPostgresqlSpecinfosRead,
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
 				Type:        schema.TypeString,
@@ -85,11 +86,11 @@ func dataSourceTencentCloudPostgresqlSpecinfos() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudPostgresqlSpecinfosRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudPostgresqlSpecinfosRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_postgresql_specinfos.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PostgresqlService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -101,7 +102,7 @@ func dataSourceTencentCloudPostgresqlSpecinfosRead(d *schema.ResourceData, meta
 		speccodes, err = service.DescribeSpecinfos(ctx, zone)
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	list := make([]map[string]interface{}, 0, len(speccodes))
@@ -121,12 +122,12 @@ func dataSourceTencentCloudPostgresqlSpecinfosRead(d *schema.ResourceData, meta
 	d.SetId("speccode." + zone)
 	if e := d.Set("list", list); e != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 
 	return nil
@@ -21,15 +21,16 @@ import (
 
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func datasourceTencentCloudPostgresqlXlogs() *schema.Resource {
 	return &schema.Resource{
-		Read: datasourceTencentCloudPostgresqlXlogsRead,
+		ReadContext: datasourceTencentCloudPostgresqlXlogsRead,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -95,12 +96,12 @@ func datasourceTencentCloudPostgresqlXlogs() *schema.Resource {
 	}
 }
 
-func datasourceTencentCloudPostgresqlXlogsRead(d *schema.ResourceData, meta interface{}) error {
+func datasourceTencentCloudPostgresqlXlogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("datasource.tencentcloud_postgresql_xlogs.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := PostgresqlService{client}
 
@@ -130,7 +131,7 @@ func datasourceTencentCloudPostgresqlXlogsRead(d *schema.ResourceData, meta inte
 
 	if err != nil {
 		d.SetId("")
-		return err
+		return diag.FromErr(err)
 	}
 
 	list := make([]interface{}, 0, len(result))
@@ -152,11 +153,11 @@ func datasourceTencentCloudPostgresqlXlogsRead(d *schema.ResourceData, meta inte
 	d.SetId("postgres-xlog-" + id)
 
 	if err := d.Set("list", list); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if output, ok := d.GetOk("result_output_file"); ok {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 
 	return nil
@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudPostgresqlZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudPostgresqlZonesRead,
+		ReadContext: dataSourceTencentCloudPostgresqlZonesRead,
 		Schema: map[string]*schema.Schema{
 			"zone_set": {
 				Computed:    true,
@@ -74,19 +75,19 @@ func dataSourceTencentCloudPostgresqlZones() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudPostgresqlZonesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudPostgresqlZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_postgresql_zones.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var zoneSet []*postgresql.ZoneInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribePostgresqlZonesByFilter(ctx)
 		if e != nil {
 			return retryError(e)
@@ -95,7 +96,7 @@ func dataSourceTencentCloudPostgresqlZonesRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(zoneSet))
@@ -140,7 +141,7 @@ func dataSourceTencentCloudPostgresqlZonesRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -18,6 +18,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	privatedns "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/privatedns/v20201028"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudPrivateDnsRecords() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudPrivateDnsRecordsRead,
+		ReadContext: dataSourceTencentCloudPrivateDnsRecordsRead,
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Required:    true,
@@ -141,13 +142,13 @@ func dataSourceTencentCloudPrivateDnsRecords() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudPrivateDnsRecordsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudPrivateDnsRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_private_dns_records.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	zoneId := d.Get("zone_id").(string)
 	filterList := make([]*privatedns.Filter, 0)
@@ -174,7 +175,7 @@ func dataSourceTencentCloudPrivateDnsRecordsRead(d *schema.ResourceData, meta in
 
 	var recordSet []*privatedns.PrivateZoneRecord
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribePrivateDnsRecordByFilter(ctx, zoneId, filterList)
 		if e != nil {
 			return retryError(e)
@@ -183,7 +184,7 @@ func dataSourceTencentCloudPrivateDnsRecordsRead(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(recordSet))
@@ -256,7 +257,7 @@ func dataSourceTencentCloudPrivateDnsRecordsRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
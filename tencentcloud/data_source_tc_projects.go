@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tag "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tag/v20180813"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudProjects() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudProjectsRead,
+		ReadContext: dataSourceTencentCloudProjectsRead,
 		Schema: map[string]*schema.Schema{
 			"all_list": {
 				Required:    true,
@@ -74,13 +75,13 @@ func dataSourceTencentCloudProjects() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudProjectsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudProjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tag_project.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, _ := d.GetOk("all_list"); v != nil {
@@ -91,7 +92,7 @@ func dataSourceTencentCloudProjectsRead(d *schema.ResourceData, meta interface{}
 
 	var projects []*tag.Project
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeProjects(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -100,7 +101,7 @@ func dataSourceTencentCloudProjectsRead(d *schema.ResourceData, meta interface{}
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(projects))
@@ -141,7 +142,7 @@ func dataSourceTencentCloudProjectsRead(d *schema.ResourceData, meta interface{}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -18,6 +18,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudRedisBackup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudRedisBackupRead,
+		ReadContext: dataSourceTencentCloudRedisBackupRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Optional:    true,
@@ -155,13 +156,13 @@ func dataSourceTencentCloudRedisBackup() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudRedisBackupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudRedisBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_redis_backup.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -194,7 +195,7 @@ func dataSourceTencentCloudRedisBackupRead(d *schema.ResourceData, meta interfac
 
 	var backupSet []*redis.RedisBackupSet
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeRedisBackupByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -203,7 +204,7 @@ func dataSourceTencentCloudRedisBackupRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(backupSet))
@@ -284,7 +285,7 @@ func dataSourceTencentCloudRedisBackupRead(d *schema.ResourceData, meta interfac
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
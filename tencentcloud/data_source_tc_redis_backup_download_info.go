@@ -23,6 +23,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -32,7 +33,7 @@ import (
 
 func dataSourceTencentCloudRedisBackupDownloadInfo() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudRedisBackupDownloadInfoRead,
+		ReadContext: dataSourceTencentCloudRedisBackupDownloadInfoRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -135,13 +136,13 @@ func dataSourceTencentCloudRedisBackupDownloadInfo() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudRedisBackupDownloadInfoRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudRedisBackupDownloadInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_redis_backup_download_info.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -193,7 +194,7 @@ func dataSourceTencentCloudRedisBackupDownloadInfoRead(d *schema.ResourceData, m
 
 	var backupInfos []*redis.BackupDownloadInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeRedisBackupDownloadInfoByFilter(ctx, paramMap)
 		if e != nil {
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
@@ -207,7 +208,7 @@ func dataSourceTencentCloudRedisBackupDownloadInfoRead(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(backupInfos))
@@ -244,7 +245,7 @@ func dataSourceTencentCloudRedisBackupDownloadInfoRead(d *schema.ResourceData, m
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
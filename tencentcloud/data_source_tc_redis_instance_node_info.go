@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudRedisInstanceNodeInfo() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudRedisInstanceNodeInfoRead,
+		ReadContext: dataSourceTencentCloudRedisInstanceNodeInfoRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -102,12 +103,12 @@ func dataSourceTencentCloudRedisInstanceNodeInfo() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudRedisInstanceNodeInfoRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudRedisInstanceNodeInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_redis_instance_node_info.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var instanceId string
 	paramMap := make(map[string]interface{})
@@ -118,7 +119,7 @@ func dataSourceTencentCloudRedisInstanceNodeInfoRead(d *schema.ResourceData, met
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var instanceNodeInfo *redis.DescribeInstanceNodeInfoResponseParams
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeRedisInstanceNodeInfoByFilter(ctx, paramMap)
 		if e != nil {
 			if sdkerr, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
@@ -132,7 +133,7 @@ func dataSourceTencentCloudRedisInstanceNodeInfoRead(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instanceNodeInfo != nil {
@@ -195,7 +196,7 @@ func dataSourceTencentCloudRedisInstanceNodeInfoRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
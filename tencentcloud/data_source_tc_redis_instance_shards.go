@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudRedisInstanceShards() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudRedisInstanceShardsRead,
+		ReadContext: dataSourceTencentCloudRedisInstanceShardsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -101,12 +102,12 @@ func dataSourceTencentCloudRedisInstanceShards() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudRedisInstanceShardsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudRedisInstanceShardsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_redis_instance_shards.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var instanceId string
 
 	paramMap := make(map[string]interface{})
@@ -123,7 +124,7 @@ func dataSourceTencentCloudRedisInstanceShardsRead(d *schema.ResourceData, meta
 
 	var instanceShards []*redis.InstanceClusterShard
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeRedisInstanceShardsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -132,7 +133,7 @@ func dataSourceTencentCloudRedisInstanceShardsRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(instanceShards))
@@ -186,7 +187,7 @@ func dataSourceTencentCloudRedisInstanceShardsRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -23,6 +23,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
@@ -31,7 +32,7 @@ import (
 
 func dataSourceTencentCloudRedisInstanceTaskList() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudRedisInstanceTaskListRead,
+		ReadContext: dataSourceTencentCloudRedisInstanceTaskListRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Optional:    true,
@@ -166,13 +167,13 @@ func dataSourceTencentCloudRedisInstanceTaskList() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudRedisInstanceTaskListRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudRedisInstanceTaskListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_redis_instance_task_list.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -220,7 +221,7 @@ func dataSourceTencentCloudRedisInstanceTaskListRead(d *schema.ResourceData, met
 
 	var tasks []*redis.TaskInfoDetail
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeRedisInstanceTaskListByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -229,7 +230,7 @@ func dataSourceTencentCloudRedisInstanceTaskListRead(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(tasks))
@@ -286,7 +287,7 @@ func dataSourceTencentCloudRedisInstanceTaskListRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
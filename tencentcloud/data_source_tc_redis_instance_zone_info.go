@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudRedisInstanceZoneInfo() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudRedisInstanceZoneInfoRead,
+		ReadContext: dataSourceTencentCloudRedisInstanceZoneInfoRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Optional:    true,
@@ -104,12 +105,12 @@ func dataSourceTencentCloudRedisInstanceZoneInfo() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudRedisInstanceZoneInfoRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudRedisInstanceZoneInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_redis_instance_zone_info.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var instanceId string
 
 	paramMap := make(map[string]interface{})
@@ -122,7 +123,7 @@ func dataSourceTencentCloudRedisInstanceZoneInfoRead(d *schema.ResourceData, met
 
 	var replicaGroups []*redis.ReplicaGroup
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeRedisInstanceZoneInfoByFilter(ctx, paramMap)
 		if e != nil {
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
@@ -136,7 +137,7 @@ func dataSourceTencentCloudRedisInstanceZoneInfoRead(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(replicaGroups))
@@ -202,7 +203,7 @@ func dataSourceTencentCloudRedisInstanceZoneInfoRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -19,13 +19,14 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentRedisInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentRedisInstancesRead,
+		ReadContext: dataSourceTencentRedisInstancesRead,
 		Schema: map[string]*schema.Schema{
 			"zone": {
 				Type:        schema.TypeString,
@@ -183,11 +184,11 @@ func dataSourceTencentRedisInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentRedisInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentRedisInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_redis_instances.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := RedisService{client: client}
@@ -231,7 +232,7 @@ func dataSourceTencentRedisInstancesRead(d *schema.ResourceData, meta interface{
 
 	instances, err := service.DescribeInstances(ctx, zone, searchKey, projectId, limit)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var instanceList = make([]interface{}, 0, len(instances))
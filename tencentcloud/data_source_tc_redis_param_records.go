@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudRedisRecordsParam() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudRedisParamRecordsRead,
+		ReadContext: dataSourceTencentCloudRedisParamRecordsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -76,12 +77,12 @@ func dataSourceTencentCloudRedisRecordsParam() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudRedisParamRecordsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudRedisParamRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_redis_param_records.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var instanceId string
 
 	paramMap := make(map[string]interface{})
@@ -94,7 +95,7 @@ func dataSourceTencentCloudRedisParamRecordsRead(d *schema.ResourceData, meta in
 
 	var instanceParamHistory []*redis.InstanceParamHistory
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeRedisParamRecordsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -103,7 +104,7 @@ func dataSourceTencentCloudRedisParamRecordsRead(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(instanceParamHistory))
@@ -142,7 +143,7 @@ func dataSourceTencentCloudRedisParamRecordsRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
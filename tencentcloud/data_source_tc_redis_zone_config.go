@@ -18,13 +18,14 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 )
 
 func dataSourceTencentRedisZoneConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentRedisZoneConfigRead,
+		ReadContext: dataSourceTencentRedisZoneConfigRead,
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:        schema.TypeString,
@@ -102,11 +103,11 @@ func dataSourceTencentRedisZoneConfig() *schema.Resource {
 	}
 }
 
-func dataSourceTencentRedisZoneConfigRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentRedisZoneConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_redis_zone_config.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 	region := meta.(*TencentCloudClient).apiV3Conn.Region
@@ -121,7 +122,7 @@ func dataSourceTencentRedisZoneConfigRead(d *schema.ResourceData, meta interface
 
 	sellConfigures, err := service.DescribeRedisZoneConfig(ctx)
 	if err != nil {
-		return fmt.Errorf("api[DescribeRedisZoneConfig]fail, return %s", err.Error())
+		return diag.Errorf("api[DescribeRedisZoneConfig]fail, return %s", err.Error())
 	}
 
 	var regionItem *redis.RegionConf
@@ -186,7 +187,7 @@ func dataSourceTencentRedisZoneConfigRead(d *schema.ResourceData, meta interface
 			for _, v := range products.ShardNum {
 				int64Value, err := strconv.ParseInt(*v, 10, 64)
 				if err != nil {
-					return fmt.Errorf("api[DescribeRedisZoneConfig]return error `redis_shard_nums`,%s", err.Error())
+					return diag.Errorf("api[DescribeRedisZoneConfig]return error `redis_shard_nums`,%s", err.Error())
 				}
 				redisShardNums = append(redisShardNums, int64Value)
 			}
@@ -195,7 +196,7 @@ func dataSourceTencentRedisZoneConfigRead(d *schema.ResourceData, meta interface
 			for _, v := range products.ReplicaNum {
 				int64Value, err := strconv.ParseInt(*v, 10, 64)
 				if err != nil {
-					return fmt.Errorf("api[DescribeRedisZoneConfig]return error `redis_replicas_nums`,%s", err.Error())
+					return diag.Errorf("api[DescribeRedisZoneConfig]return error `redis_replicas_nums`,%s", err.Error())
 				}
 				redisReplicasNums = append(redisReplicasNums, int64Value)
 			}
@@ -207,7 +208,7 @@ func dataSourceTencentRedisZoneConfigRead(d *schema.ResourceData, meta interface
 
 	if err := d.Set("list", allZonesConfigs); err != nil {
 		log.Printf("[CRITAL]%s provider set  redis zoneConfigs fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := "redis_zoneconfig" + region
@@ -221,7 +222,7 @@ func dataSourceTencentRedisZoneConfigRead(d *schema.ResourceData, meta interface
 		if err := writeToFile(output.(string), allZonesConfigs); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
 				logId, output.(string), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -16,6 +16,7 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudReservedInstanceConfigs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudReservedInstanceConfigsRead,
+		ReadContext: dataSourceTencentCloudReservedInstanceConfigsRead,
 
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
@@ -118,12 +119,12 @@ func dataSourceTencentCloudReservedInstanceConfigs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudReservedInstanceConfigsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudReservedInstanceConfigsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_reserved_instance_configs.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cvmService := CvmService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -147,7 +148,7 @@ func dataSourceTencentCloudReservedInstanceConfigsRead(d *schema.ResourceData, m
 
 	var configs []*cvm.ReservedInstancesOffering
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		configs, errRet = cvmService.DescribeReservedInstanceConfigs(ctx, filter)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -155,7 +156,7 @@ func dataSourceTencentCloudReservedInstanceConfigsRead(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	configList := make([]map[string]interface{}, 0, len(configs))
@@ -180,13 +181,13 @@ func dataSourceTencentCloudReservedInstanceConfigsRead(d *schema.ResourceData, m
 	err = d.Set("config_list", configList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set config list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), configList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
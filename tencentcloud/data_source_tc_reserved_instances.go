@@ -16,6 +16,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudReservedInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudReservedInstancesRead,
+		ReadContext: dataSourceTencentCloudReservedInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"reserved_instance_id": {
@@ -97,10 +98,10 @@ func dataSourceTencentCloudReservedInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudReservedInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudReservedInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_reserved_instances.read")
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cvmService := CvmService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -118,7 +119,7 @@ func dataSourceTencentCloudReservedInstancesRead(d *schema.ResourceData, meta in
 
 	var instances []*cvm.ReservedInstances
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		instances, errRet = cvmService.DescribeReservedInstanceByFilter(ctx, filter)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -126,7 +127,7 @@ func dataSourceTencentCloudReservedInstancesRead(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceList := make([]map[string]interface{}, 0, len(instances))
@@ -148,13 +149,13 @@ func dataSourceTencentCloudReservedInstancesRead(d *schema.ResourceData, meta in
 	err = d.Set("reserved_instance_list", instanceList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set reserved instance list fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), instanceList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -30,13 +30,14 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTencentCloudRouteTable() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This data source has been deprecated in Terraform TencentCloud provider version 1.10.0. Please use 'tencentcloud_vpc_route_tables' instead.",
-		Read:               dataSourceTencentCloudRouteTableRead,
+		ReadContext:        dataSourceTencentCloudRouteTableRead,
 
 		Schema: map[string]*schema.Schema{
 			"route_table_id": {
@@ -104,11 +105,11 @@ func dataSourceTencentCloudRouteTable() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudRouteTableRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudRouteTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_route_table.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var (
@@ -130,11 +131,11 @@ func dataSourceTencentCloudRouteTableRead(d *schema.ResourceData, meta interface
 
 	var infos, err = service.DescribeRouteTables(ctx, routeTableId, name, "", map[string]string{}, nil, "")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(infos) == 0 {
-		return fmt.Errorf("route table route_table_id=%s, name=%s not found", routeTableId, name)
+		return diag.Errorf("route table route_table_id=%s, name=%s not found", routeTableId, name)
 	}
 
 	routetable := infos[0]
@@ -166,7 +167,7 @@ func dataSourceTencentCloudRouteTableRead(d *schema.ResourceData, meta interface
 	}
 
 	if err := d.Set("routes", routes); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
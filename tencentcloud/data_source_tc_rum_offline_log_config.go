@@ -13,9 +13,9 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	rum "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/rum/v20210622"
@@ -24,7 +24,7 @@ import (
 
 func dataSourceTencentCloudRumOfflineLogConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudRumOfflineLogConfigRead,
+		ReadContext: dataSourceTencentCloudRumOfflineLogConfigRead,
 		Schema: map[string]*schema.Schema{
 			"project_key": {
 				Type:        schema.TypeString,
@@ -56,12 +56,12 @@ func dataSourceTencentCloudRumOfflineLogConfig() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudRumOfflineLogConfigRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudRumOfflineLogConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_rum_offline_log_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var projectKey string
 
 	paramMap := make(map[string]interface{})
@@ -73,7 +73,7 @@ func dataSourceTencentCloudRumOfflineLogConfigRead(d *schema.ResourceData, meta
 	rumService := RumService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var logConfigs *rum.DescribeOfflineLogConfigsResponseParams
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := rumService.DescribeRumOfflineLogConfigByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -83,11 +83,11 @@ func dataSourceTencentCloudRumOfflineLogConfigRead(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Rum uniqueIDSet failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if logConfigs == nil {
-		return fmt.Errorf("Query by id %v is empty", projectKey)
+		return diag.Errorf("Query by id %v is empty", projectKey)
 	}
 
 	var uniqueID []string
@@ -111,7 +111,7 @@ func dataSourceTencentCloudRumOfflineLogConfigRead(d *schema.ResourceData, meta
 			"unique_id_set": uniqueID,
 			"msg":           *logConfigs.Msg,
 		}); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -16,6 +16,7 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	rum "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/rum/v20210622"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudRumProject() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudRumProjectRead,
+		ReadContext: dataSourceTencentCloudRumProjectRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -131,12 +132,12 @@ func dataSourceTencentCloudRumProject() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudRumProjectRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudRumProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_rum_project.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -146,7 +147,7 @@ func dataSourceTencentCloudRumProjectRead(d *schema.ResourceData, meta interface
 	rumService := RumService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var projectSet []*rum.RumProject
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := rumService.DescribeRumProjectByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -156,7 +157,7 @@ func dataSourceTencentCloudRumProjectRead(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Rum projectSet failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	projectSetList := []interface{}{}
@@ -225,7 +226,7 @@ func dataSourceTencentCloudRumProjectRead(d *schema.ResourceData, meta interface
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), projectSetList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
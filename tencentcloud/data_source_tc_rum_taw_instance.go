@@ -19,6 +19,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	rum "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/rum/v20210622"
@@ -27,7 +28,7 @@ import (
 
 func dataSourceTencentCloudRumTawInstance() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudRumTawInstanceRead,
+		ReadContext: dataSourceTencentCloudRumTawInstanceRead,
 		Schema: map[string]*schema.Schema{
 			"charge_statuses": {
 				Type: schema.TypeSet,
@@ -167,12 +168,12 @@ func dataSourceTencentCloudRumTawInstance() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudRumTawInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudRumTawInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_rum_taw_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("charge_statuses"); ok {
@@ -228,7 +229,7 @@ func dataSourceTencentCloudRumTawInstanceRead(d *schema.ResourceData, meta inter
 	rumService := RumService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var instanceSet []*rum.RumInstanceInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := rumService.DescribeRumTawInstanceByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -238,7 +239,7 @@ func dataSourceTencentCloudRumTawInstanceRead(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Rum instanceSet failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceSetList := []interface{}{}
@@ -306,7 +307,7 @@ func dataSourceTencentCloudRumTawInstanceRead(d *schema.ResourceData, meta inter
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), instanceSetList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -15,6 +15,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	rum "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/rum/v20210622"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudRumWhitelist() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudRumWhitelistRead,
+		ReadContext: dataSourceTencentCloudRumWhitelistRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -85,12 +86,12 @@ func dataSourceTencentCloudRumWhitelist() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudRumWhitelistRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudRumWhitelistRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_rum_whitelist.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -100,7 +101,7 @@ func dataSourceTencentCloudRumWhitelistRead(d *schema.ResourceData, meta interfa
 	rumService := RumService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var whitelistSet []*rum.Whitelist
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := rumService.DescribeRumWhitelistByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -110,7 +111,7 @@ func dataSourceTencentCloudRumWhitelistRead(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Rum whitelistSet failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	whitelistSetList := []interface{}{}
@@ -152,7 +153,7 @@ func dataSourceTencentCloudRumWhitelistRead(d *schema.ResourceData, meta interfa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), whitelistSetList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudScfAccountInfo() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudScfAccountInfoRead,
+		ReadContext: dataSourceTencentCloudScfAccountInfoRead,
 		Schema: map[string]*schema.Schema{
 			"account_usage": {
 				Computed:    true,
@@ -241,19 +242,19 @@ func dataSourceTencentCloudScfAccountInfo() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudScfAccountInfoRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudScfAccountInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_scf_account_info.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ScfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var accountInfo *scf.GetAccountResponseParams
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeScfAccountInfo(ctx)
 		if e != nil {
 			return retryError(e)
@@ -262,7 +263,7 @@ func dataSourceTencentCloudScfAccountInfoRead(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0)
@@ -446,7 +447,7 @@ func dataSourceTencentCloudScfAccountInfoRead(d *schema.ResourceData, meta inter
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), accountInfoMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -18,6 +18,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudScfAsyncEventManagement() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudScfAsyncEventManagementRead,
+		ReadContext: dataSourceTencentCloudScfAsyncEventManagementRead,
 		Schema: map[string]*schema.Schema{
 			"function_name": {
 				Required:    true,
@@ -131,13 +132,13 @@ func dataSourceTencentCloudScfAsyncEventManagement() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudScfAsyncEventManagementRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudScfAsyncEventManagementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_scf_async_event_management.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("function_name"); ok {
@@ -178,7 +179,7 @@ func dataSourceTencentCloudScfAsyncEventManagementRead(d *schema.ResourceData, m
 
 	var eventList []*scf.AsyncEvent
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeScfAsyncEventManagementByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -187,7 +188,7 @@ func dataSourceTencentCloudScfAsyncEventManagementRead(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(eventList))
@@ -232,7 +233,7 @@ func dataSourceTencentCloudScfAsyncEventManagementRead(d *schema.ResourceData, m
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudScfAsyncEventStatus() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudScfAsyncEventStatusRead,
+		ReadContext: dataSourceTencentCloudScfAsyncEventStatusRead,
 		Schema: map[string]*schema.Schema{
 			"invoke_request_id": {
 				Required:    true,
@@ -64,13 +65,13 @@ func dataSourceTencentCloudScfAsyncEventStatus() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudScfAsyncEventStatusRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudScfAsyncEventStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_scf_async_event_status.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		result          *scf.AsyncEventStatus
@@ -84,7 +85,7 @@ func dataSourceTencentCloudScfAsyncEventStatusRead(d *schema.ResourceData, meta
 	}
 
 	service := ScfService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		res, e := service.DescribeScfAsyncEventStatus(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -93,7 +94,7 @@ func dataSourceTencentCloudScfAsyncEventStatusRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	asyncEventStatusMap := map[string]interface{}{}
@@ -118,7 +119,7 @@ func dataSourceTencentCloudScfAsyncEventStatusRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), asyncEventStatusMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
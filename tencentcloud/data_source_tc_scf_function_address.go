@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudScfFunctionAddress() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudScfFunctionAddressRead,
+		ReadContext: dataSourceTencentCloudScfFunctionAddressRead,
 		Schema: map[string]*schema.Schema{
 			"function_name": {
 				Required:    true,
@@ -65,13 +66,13 @@ func dataSourceTencentCloudScfFunctionAddress() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudScfFunctionAddressRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudScfFunctionAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_scf_function_address.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("function_name"); ok {
@@ -90,7 +91,7 @@ func dataSourceTencentCloudScfFunctionAddressRead(d *schema.ResourceData, meta i
 
 	var res *scf.GetFunctionAddressResponseParams
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeScfFunctionAddress(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -99,7 +100,7 @@ func dataSourceTencentCloudScfFunctionAddressRead(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	resMap := make(map[string]interface{})
@@ -119,7 +120,7 @@ func dataSourceTencentCloudScfFunctionAddressRead(d *schema.ResourceData, meta i
 
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), resMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
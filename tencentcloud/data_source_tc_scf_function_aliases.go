@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudScfFunctionAliases() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudScfFunctionAliasesRead,
+		ReadContext: dataSourceTencentCloudScfFunctionAliasesRead,
 		Schema: map[string]*schema.Schema{
 			"function_name": {
 				Required:    true,
@@ -144,13 +145,13 @@ func dataSourceTencentCloudScfFunctionAliases() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudScfFunctionAliasesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudScfFunctionAliasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_scf_function_aliases.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("function_name"); ok {
@@ -169,7 +170,7 @@ func dataSourceTencentCloudScfFunctionAliasesRead(d *schema.ResourceData, meta i
 
 	var aliases []*scf.Alias
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeScfFunctionAliasesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -178,7 +179,7 @@ func dataSourceTencentCloudScfFunctionAliasesRead(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(aliases))
@@ -271,7 +272,7 @@ func dataSourceTencentCloudScfFunctionAliasesRead(d *schema.ResourceData, meta i
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
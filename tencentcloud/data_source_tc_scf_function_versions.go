@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudScfFunctionVersions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudScfFunctionVersionsRead,
+		ReadContext: dataSourceTencentCloudScfFunctionVersionsRead,
 		Schema: map[string]*schema.Schema{
 			"function_name": {
 				Required:    true,
@@ -93,13 +94,13 @@ func dataSourceTencentCloudScfFunctionVersions() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudScfFunctionVersionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudScfFunctionVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_scf_function_versions.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("function_name"); ok {
@@ -122,7 +123,7 @@ func dataSourceTencentCloudScfFunctionVersionsRead(d *schema.ResourceData, meta
 
 	var versions []*scf.FunctionVersion
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeScfFunctionVersionsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -131,7 +132,7 @@ func dataSourceTencentCloudScfFunctionVersionsRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(versions))
@@ -172,7 +173,7 @@ func dataSourceTencentCloudScfFunctionVersionsRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -27,6 +27,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -34,7 +35,7 @@ import (
 
 func dataSourceTencentCloudScfFunctions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudScfFunctionsRead,
+		ReadContext: dataSourceTencentCloudScfFunctionsRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -270,10 +271,10 @@ func dataSourceTencentCloudScfFunctions() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudScfFunctionsRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceTencentCloudScfFunctionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_scf_functions.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ScfService{client: m.(*TencentCloudClient).apiV3Conn}
 
@@ -295,13 +296,13 @@ func dataSourceTencentCloudScfFunctionsRead(d *schema.ResourceData, m interface{
 
 	tags := helper.GetTags(d, "tags")
 	if len(tags) > 10 {
-		return errors.Errorf("can't set more than 10 tags")
+		return diag.FromErr(errors.Errorf("can't set more than 10 tags"))
 	}
 
 	respFunctions, err := service.DescribeFunctions(ctx, name, namespace, desc, tags)
 	if err != nil {
 		log.Printf("[CRITAL]%s get function list failed: %+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	functions := make([]map[string]interface{}, 0, len(respFunctions))
@@ -324,7 +325,7 @@ func dataSourceTencentCloudScfFunctionsRead(d *schema.ResourceData, m interface{
 		rawResp, err := service.DescribeFunction(ctx, *fn.FunctionName, *fn.Namespace)
 		if err != nil {
 			log.Printf("[CRITAL]%s read function detail failed: %+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		// if function deleted, ignore it
@@ -400,7 +401,7 @@ func dataSourceTencentCloudScfFunctionsRead(d *schema.ResourceData, m interface{
 	}
 
 	if err := d.Set("functions", functions); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(helper.DataResourceIdsHash(ids))
 
@@ -408,7 +409,7 @@ func dataSourceTencentCloudScfFunctionsRead(d *schema.ResourceData, m interface{
 		if err := writeToFile(output.(string), functions); err != nil {
 			err = errors.WithStack(err)
 			log.Printf("[CRITAL]%s output file[%s] fail, reason: %+v", logId, output.(string), err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudScfLayerVersions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudScfLayerVersionsRead,
+		ReadContext: dataSourceTencentCloudScfLayerVersionsRead,
 		Schema: map[string]*schema.Schema{
 			"layer_name": {
 				Required:    true,
@@ -101,13 +102,13 @@ func dataSourceTencentCloudScfLayerVersions() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudScfLayerVersionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudScfLayerVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_scf_layer_versions.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("layer_name"); ok {
@@ -123,7 +124,7 @@ func dataSourceTencentCloudScfLayerVersionsRead(d *schema.ResourceData, meta int
 
 	var layerVersions []*scf.LayerVersionInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeScfLayerVersions(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -132,7 +133,7 @@ func dataSourceTencentCloudScfLayerVersionsRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(layerVersions))
@@ -185,7 +186,7 @@ func dataSourceTencentCloudScfLayerVersionsRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudScfLayers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudScfLayersRead,
+		ReadContext: dataSourceTencentCloudScfLayersRead,
 		Schema: map[string]*schema.Schema{
 			"compatible_runtime": {
 				Optional:    true,
@@ -96,13 +97,13 @@ func dataSourceTencentCloudScfLayers() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudScfLayersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudScfLayersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_scf_layers.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("compatible_runtime"); ok {
@@ -117,7 +118,7 @@ func dataSourceTencentCloudScfLayersRead(d *schema.ResourceData, meta interface{
 
 	var layers []*scf.LayerVersionInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeScfLayersByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -126,7 +127,7 @@ func dataSourceTencentCloudScfLayersRead(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(layers))
@@ -179,7 +180,7 @@ func dataSourceTencentCloudScfLayersRead(d *schema.ResourceData, meta interface{
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -26,6 +26,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -33,7 +34,7 @@ import (
 
 func dataSourceTencentCloudScfLogs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudScfLogsRead,
+		ReadContext: dataSourceTencentCloudScfLogsRead,
 		Schema: map[string]*schema.Schema{
 			"function_name": {
 				Type:        schema.TypeString,
@@ -175,10 +176,10 @@ func dataSourceTencentCloudScfLogs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudScfLogsRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceTencentCloudScfLogsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_scf_logs.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ScfService{client: m.(*TencentCloudClient).apiV3Conn}
 
@@ -188,7 +189,7 @@ func dataSourceTencentCloudScfLogsRead(d *schema.ResourceData, m interface{}) er
 	offset := d.Get("offset").(int)
 	limit := d.Get("limit").(int)
 	if offset+limit > 10000 {
-		return errors.New("offset + limit can't greater than 10000")
+		return diag.FromErr(errors.New("offset + limit can't greater than 10000"))
 	}
 
 	order := d.Get("order").(string)
@@ -215,7 +216,7 @@ func dataSourceTencentCloudScfLogsRead(d *schema.ResourceData, m interface{}) er
 		endTime = helper.String(raw.(string))
 	}
 	if err := helper.CheckIfSetTogether(d, "start_time", "end_time"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if startTime != nil && endTime != nil {
@@ -223,7 +224,7 @@ func dataSourceTencentCloudScfLogsRead(d *schema.ResourceData, m interface{}) er
 		endTime, _ := time.Parse(SCF_LOGS_DESCRIBE_TIME_FORMAT, *endTime)
 
 		if endTime.Sub(startTime) > 24*time.Hour {
-			return errors.New("end_time - start_time can't greater then 1 day")
+			return diag.FromErr(errors.New("end_time - start_time can't greater then 1 day"))
 		}
 	}
 
@@ -234,7 +235,7 @@ func dataSourceTencentCloudScfLogsRead(d *schema.ResourceData, m interface{}) er
 	)
 	if err != nil {
 		log.Printf("[CRITAL]%s read function logs failed: %+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	logs := make([]map[string]interface{}, 0, len(respLogs))
@@ -265,7 +266,7 @@ func dataSourceTencentCloudScfLogsRead(d *schema.ResourceData, m interface{}) er
 		if err := writeToFile(output.(string), logs); err != nil {
 			err = errors.WithStack(err)
 			log.Printf("[CRITAL]%s output file[%s] fail, reason: %+v", logId, output.(string), err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
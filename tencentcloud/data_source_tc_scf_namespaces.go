@@ -20,6 +20,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -27,7 +28,7 @@ import (
 
 func dataSourceTencentCloudScfNamespaces() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudScfNamespacesRead,
+		ReadContext: dataSourceTencentCloudScfNamespacesRead,
 		Schema: map[string]*schema.Schema{
 			"namespace": {
 				Type:        schema.TypeString,
@@ -84,10 +85,10 @@ func dataSourceTencentCloudScfNamespaces() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudScfNamespacesRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceTencentCloudScfNamespacesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_scf_namespaces.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ScfService{client: m.(*TencentCloudClient).apiV3Conn}
 
@@ -106,7 +107,7 @@ func dataSourceTencentCloudScfNamespacesRead(d *schema.ResourceData, m interface
 	nss, err := service.DescribeNamespaces(ctx)
 	if err != nil {
 		log.Printf("[CRITAL]%s read namespace list failed: %+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespaces := make([]map[string]*string, 0, len(nss))
@@ -138,7 +139,7 @@ func dataSourceTencentCloudScfNamespacesRead(d *schema.ResourceData, m interface
 		if err := writeToFile(output.(string), namespaces); err != nil {
 			err = errors.WithStack(err)
 			log.Printf("[CRITAL]%s output file[%s] fail, reason: %+v", logId, output.(string), err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
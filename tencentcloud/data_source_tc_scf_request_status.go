@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudScfRequestStatus() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudScfRequestStatusRead,
+		ReadContext: dataSourceTencentCloudScfRequestStatusRead,
 		Schema: map[string]*schema.Schema{
 			"function_name": {
 				Required:    true,
@@ -115,13 +116,13 @@ func dataSourceTencentCloudScfRequestStatus() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudScfRequestStatusRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudScfRequestStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_scf_request_status.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("function_name"); ok {
@@ -148,7 +149,7 @@ func dataSourceTencentCloudScfRequestStatusRead(d *schema.ResourceData, meta int
 
 	var data []*scf.RequestStatus
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeScfRequestStatusByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -157,7 +158,7 @@ func dataSourceTencentCloudScfRequestStatusRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(data))
@@ -210,7 +211,7 @@ func dataSourceTencentCloudScfRequestStatusRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudScfTriggers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudScfTriggersRead,
+		ReadContext: dataSourceTencentCloudScfTriggersRead,
 		Schema: map[string]*schema.Schema{
 			"function_name": {
 				Required:    true,
@@ -153,13 +154,13 @@ func dataSourceTencentCloudScfTriggers() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudScfTriggersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudScfTriggersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_scf_triggers.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("function_name"); ok {
@@ -202,7 +203,7 @@ func dataSourceTencentCloudScfTriggersRead(d *schema.ResourceData, meta interfac
 
 	var triggers []*scf.TriggerInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeScfTriggersByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -211,7 +212,7 @@ func dataSourceTencentCloudScfTriggersRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(triggers))
@@ -280,7 +281,7 @@ func dataSourceTencentCloudScfTriggersRead(d *schema.ResourceData, meta interfac
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
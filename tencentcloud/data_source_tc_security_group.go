@@ -16,9 +16,9 @@ package tencentcloud
 import (
 	"context"
 	"errors"
-	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 )
@@ -26,7 +26,7 @@ import (
 func dataSourceTencentCloudSecurityGroup() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This data source has been deprecated in Terraform TencentCloud provider version 1.14.0. Please use 'tencentcloud_security_groups' instead.",
-		Read:               dataSourceTencentCloudSecurityGroupRead,
+		ReadContext:        dataSourceTencentCloudSecurityGroupRead,
 		Schema: map[string]*schema.Schema{
 			"security_group_id": {
 				Type:          schema.TypeString,
@@ -65,11 +65,11 @@ func dataSourceTencentCloudSecurityGroup() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_security_group.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := meta.(*TencentCloudClient).apiV3Conn
 	vpcService := VpcService{client: client}
@@ -89,17 +89,17 @@ func dataSourceTencentCloudSecurityGroupRead(d *schema.ResourceData, meta interf
 
 	sgs, err := vpcService.DescribeSecurityGroups(ctx, sgId, sgName, nil, map[string]string{})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(sgs) == 0 {
-		return errors.New("security group not found with the query condition")
+		return diag.FromErr(errors.New("security group not found with the query condition"))
 	}
 
 	sg := sgs[0]
 	in, out, _, err := vpcService.DescribeSecurityGroupPolices(ctx, *sg.SecurityGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*sg.SecurityGroupId)
@@ -111,7 +111,7 @@ func dataSourceTencentCloudSecurityGroupRead(d *schema.ResourceData, meta interf
 
 	projectId, err := strconv.Atoi(*sg.ProjectId)
 	if err != nil {
-		return fmt.Errorf("project id is not valid number: %v", err)
+		return diag.Errorf("project id is not valid number: %v", err)
 	}
 
 	_ = d.Set("project_id", projectId)
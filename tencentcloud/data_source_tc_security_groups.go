@@ -14,11 +14,11 @@ package tencentcloud
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -27,7 +27,7 @@ import (
 
 func dataSourceTencentCloudSecurityGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSecurityGroupsRead,
+		ReadContext: dataSourceTencentCloudSecurityGroupsRead,
 		Schema: map[string]*schema.Schema{
 			"security_group_id": {
 				Type:          schema.TypeString,
@@ -121,11 +121,11 @@ func dataSourceTencentCloudSecurityGroups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSecurityGroupsRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceTencentCloudSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_security_groups.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := m.(*TencentCloudClient).apiV3Conn
 	vpcService := VpcService{client: client}
@@ -162,7 +162,7 @@ func dataSourceTencentCloudSecurityGroupsRead(d *schema.ResourceData, m interfac
 
 	sgs, err := vpcService.DescribeSecurityGroups(ctx, sgId, sgName, projectId, tags)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(sgs) == 0 {
@@ -174,7 +174,7 @@ func dataSourceTencentCloudSecurityGroupsRead(d *schema.ResourceData, m interfac
 	sgMap := make(map[string]*vpc.SecurityGroup, len(sgs))
 	for _, sg := range sgs {
 		if sg.SecurityGroupId == nil {
-			return errors.New("security group id is nil")
+			return diag.FromErr(errors.New("security group id is nil"))
 		}
 		sgMap[*sg.SecurityGroupId] = sg
 	}
@@ -186,13 +186,13 @@ func dataSourceTencentCloudSecurityGroupsRead(d *schema.ResourceData, m interfac
 
 	associateSet, err := vpcService.DescribeSecurityGroupsAssociate(ctx, sgIds)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	sgInstances := make([]map[string]interface{}, 0, len(sgs))
 	for _, associate := range associateSet {
 		if associate.SecurityGroupId == nil {
-			return errors.New("associate statistics security group id is nil")
+			return diag.FromErr(errors.New("associate statistics security group id is nil"))
 		}
 
 		if sg, ok := sgMap[*associate.SecurityGroupId]; ok {
@@ -200,17 +200,17 @@ func dataSourceTencentCloudSecurityGroupsRead(d *schema.ResourceData, m interfac
 
 			// normally projectId default value is 0
 			if sg.ProjectId == nil {
-				return errors.New("associate statistics project id is nil")
+				return diag.FromErr(errors.New("associate statistics project id is nil"))
 			}
 
 			projectId, err := strconv.Atoi(*sg.ProjectId)
 			if err != nil {
-				return fmt.Errorf("securtiy group %s project id invalid: %v", *sg.SecurityGroupId, err)
+				return diag.Errorf("securtiy group %s project id invalid: %v", *sg.SecurityGroupId, err)
 			}
 
 			respIngress, respEgress, exist, err := vpcService.DescribeSecurityGroupPolices(ctx, *sg.SecurityGroupId)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			if !exist {
@@ -220,7 +220,7 @@ func dataSourceTencentCloudSecurityGroupsRead(d *schema.ResourceData, m interfac
 
 			respTags, err := tagService.DescribeResourceTags(ctx, "cvm", "sg", region, *sg.SecurityGroupId)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			ingress := make([]string, 0, len(respIngress))
@@ -248,7 +248,7 @@ func dataSourceTencentCloudSecurityGroupsRead(d *schema.ResourceData, m interfac
 	}
 
 	if len(sgInstances) != len(sgs) {
-		return errors.New("security group associate statistics is not enough")
+		return diag.FromErr(errors.New("security group associate statistics is not enough"))
 	}
 
 	_ = d.Set("security_groups", sgInstances)
@@ -257,7 +257,7 @@ func dataSourceTencentCloudSecurityGroupsRead(d *schema.ResourceData, m interfac
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
 		if err := writeToFile(output.(string), sgInstances); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%v]", logId, output.(string), err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
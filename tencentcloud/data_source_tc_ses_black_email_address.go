@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ses "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ses/v20201002"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudSesBlackEmailAddress() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSesBlackEmailAddressRead,
+		ReadContext: dataSourceTencentCloudSesBlackEmailAddressRead,
 		Schema: map[string]*schema.Schema{
 			"start_date": {
 				Required:    true,
@@ -80,13 +81,13 @@ func dataSourceTencentCloudSesBlackEmailAddress() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSesBlackEmailAddressRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSesBlackEmailAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ses_black_email_address.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("start_date"); ok {
@@ -109,7 +110,7 @@ func dataSourceTencentCloudSesBlackEmailAddressRead(d *schema.ResourceData, meta
 
 	var blackList []*ses.BlackEmailAddress
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeSesBlackEmailAddressByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -118,7 +119,7 @@ func dataSourceTencentCloudSesBlackEmailAddressRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(blackList))
@@ -147,7 +148,7 @@ func dataSourceTencentCloudSesBlackEmailAddressRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
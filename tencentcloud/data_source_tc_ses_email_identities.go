@@ -13,6 +13,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ses "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ses/v20201002"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudSesEmailIdentities() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSesEmailIdentitiesRead,
+		ReadContext: dataSourceTencentCloudSesEmailIdentitiesRead,
 		Schema: map[string]*schema.Schema{
 			"email_identities": {
 				Computed:    true,
@@ -79,17 +80,17 @@ func dataSourceTencentCloudSesEmailIdentities() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSesEmailIdentitiesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSesEmailIdentitiesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ses_email_identities.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := SesService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var emailIdentities *ses.ListEmailIdentitiesResponseParams
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeSesEmailIdentitiesByFilter(ctx)
 		if e != nil {
 			return retryError(e)
@@ -98,7 +99,7 @@ func dataSourceTencentCloudSesEmailIdentitiesRead(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(emailIdentities.EmailIdentities))
@@ -147,7 +148,7 @@ func dataSourceTencentCloudSesEmailIdentitiesRead(d *schema.ResourceData, meta i
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -16,6 +16,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ses "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ses/v20201002"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudSesReceivers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSesReceiversRead,
+		ReadContext: dataSourceTencentCloudSesReceiversRead,
 		Schema: map[string]*schema.Schema{
 			"status": {
 				Optional:    true,
@@ -87,13 +88,13 @@ func dataSourceTencentCloudSesReceivers() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSesReceiversRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSesReceiversRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ses_receivers.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, _ := d.GetOk("status"); v != nil {
@@ -108,7 +109,7 @@ func dataSourceTencentCloudSesReceiversRead(d *schema.ResourceData, meta interfa
 
 	var data []*ses.ReceiverData
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeSesReceiversByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -117,7 +118,7 @@ func dataSourceTencentCloudSesReceiversRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(data))
@@ -162,7 +163,7 @@ func dataSourceTencentCloudSesReceiversRead(d *schema.ResourceData, meta interfa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ses "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ses/v20201002"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudSesSendEmailStatus() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSesSendEmailStatusRead,
+		ReadContext: dataSourceTencentCloudSesSendEmailStatusRead,
 		Schema: map[string]*schema.Schema{
 			"request_date": {
 				Required:    true,
@@ -123,13 +124,13 @@ func dataSourceTencentCloudSesSendEmailStatus() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSesSendEmailStatusRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSesSendEmailStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ses_send_email_status.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("request_date"); ok {
@@ -148,7 +149,7 @@ func dataSourceTencentCloudSesSendEmailStatusRead(d *schema.ResourceData, meta i
 
 	var emailStatusList []*ses.SendEmailStatus
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeSesSendEmailStatusByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -157,7 +158,7 @@ func dataSourceTencentCloudSesSendEmailStatusRead(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(emailStatusList))
@@ -226,7 +227,7 @@ func dataSourceTencentCloudSesSendEmailStatusRead(d *schema.ResourceData, meta i
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
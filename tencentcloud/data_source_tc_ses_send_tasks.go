@@ -17,6 +17,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ses "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ses/v20201002"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudSesSendTasks() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSesSendTasksRead,
+		ReadContext: dataSourceTencentCloudSesSendTasksRead,
 		Schema: map[string]*schema.Schema{
 			"status": {
 				Optional:    true,
@@ -186,13 +187,13 @@ func dataSourceTencentCloudSesSendTasks() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSesSendTasksRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSesSendTasksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ses_send_tasks.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, _ := d.GetOk("status"); v != nil {
@@ -211,7 +212,7 @@ func dataSourceTencentCloudSesSendTasksRead(d *schema.ResourceData, meta interfa
 
 	var data []*ses.SendTaskData
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeSesSendTasksByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -220,7 +221,7 @@ func dataSourceTencentCloudSesSendTasksRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(data))
@@ -335,7 +336,7 @@ func dataSourceTencentCloudSesSendTasksRead(d *schema.ResourceData, meta interfa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ses "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ses/v20201002"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudSesStatisticsReport() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSesStatisticsReportRead,
+		ReadContext: dataSourceTencentCloudSesStatisticsReportRead,
 		Schema: map[string]*schema.Schema{
 			"start_date": {
 				Required:    true,
@@ -160,13 +161,13 @@ func dataSourceTencentCloudSesStatisticsReport() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSesStatisticsReportRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSesStatisticsReportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ses_statistics_report.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("start_date"); ok {
@@ -188,7 +189,7 @@ func dataSourceTencentCloudSesStatisticsReportRead(d *schema.ResourceData, meta
 	service := SesService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var statisticsReport *ses.GetStatisticsReportResponseParams
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeSesStatisticsReportByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -197,7 +198,7 @@ func dataSourceTencentCloudSesStatisticsReportRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(statisticsReport.DailyVolumes))
@@ -289,7 +290,7 @@ func dataSourceTencentCloudSesStatisticsReportRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
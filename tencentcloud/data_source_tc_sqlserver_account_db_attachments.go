@@ -77,13 +77,14 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudSqlserverAccountDBAttachments() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentSqlserverAccountDBAttachmentsRead,
+		ReadContext: dataSourceTencentSqlserverAccountDBAttachmentsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -141,14 +142,14 @@ func dataSourceTencentCloudSqlserverAccountDBAttachments() *schema.Resource {
 	}
 }
 
-func dataSourceTencentSqlserverAccountDBAttachmentsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentSqlserverAccountDBAttachmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_account_db_attachments.read")()
 
 	var (
 		logId            = getLogId(contextNil)
-		ctx              = context.WithValue(context.TODO(), logIdKey, logId)
 		sqlserverService = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Get("instance_id").(string)
 	accountName := d.Get("account_name").(string)
@@ -157,7 +158,7 @@ func dataSourceTencentSqlserverAccountDBAttachmentsRead(d *schema.ResourceData,
 	attachments, err := sqlserverService.DescribeAccountDBAttachments(ctx, instanceId, accountName, dbName)
 
 	if err != nil {
-		return fmt.Errorf("api[DescribeAccountDBAttachments]fail, return %s", err.Error())
+		return diag.Errorf("api[DescribeAccountDBAttachments]fail, return %s", err.Error())
 	}
 
 	var list []map[string]interface{}
@@ -178,12 +179,12 @@ func dataSourceTencentSqlserverAccountDBAttachmentsRead(d *schema.ResourceData,
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("list", list); e != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 
 	return nil
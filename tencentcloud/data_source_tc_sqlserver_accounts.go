@@ -27,13 +27,14 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudSqlserverAccounts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentSqlserverAccountsRead,
+		ReadContext: dataSourceTencentSqlserverAccountsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -95,11 +96,11 @@ func dataSourceTencentCloudSqlserverAccounts() *schema.Resource {
 	}
 }
 
-func dataSourceTencentSqlserverAccountsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentSqlserverAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_accounts.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Get("instance_id").(string)
 
@@ -108,7 +109,7 @@ func dataSourceTencentSqlserverAccountsRead(d *schema.ResourceData, meta interfa
 	accounts, err := sqlserverService.DescribeSqlserverAccounts(ctx, instanceId)
 
 	if err != nil {
-		return fmt.Errorf("api[DescribeAccounts]fail, return %s", err.Error())
+		return diag.Errorf("api[DescribeAccounts]fail, return %s", err.Error())
 	}
 
 	var list []map[string]interface{}
@@ -133,11 +134,11 @@ func dataSourceTencentSqlserverAccountsRead(d *schema.ResourceData, meta interfa
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("list", list); e != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 
 	return nil
@@ -71,6 +71,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -78,7 +79,7 @@ import (
 
 func dataSourceTencentCloudSqlserverBackupByFlowId() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSqlserverBackupByFlowIdRead,
+		ReadContext: dataSourceTencentCloudSqlserverBackupByFlowIdRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -155,18 +156,18 @@ func dataSourceTencentCloudSqlserverBackupByFlowId() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSqlserverBackupByFlowIdRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSqlserverBackupByFlowIdRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_backup_by_flow_id.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		response   *sqlserver.DescribeBackupByFlowIdResponseParams
 		instanceId string
 		flowId     string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("instance_id"); ok {
 		instanceId = v.(string)
@@ -176,7 +177,7 @@ func dataSourceTencentCloudSqlserverBackupByFlowIdRead(d *schema.ResourceData, m
 		flowId = v.(string)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeBackupByFlowId(ctx, instanceId, flowId)
 		if e != nil {
 			return retryError(e)
@@ -187,7 +188,7 @@ func dataSourceTencentCloudSqlserverBackupByFlowIdRead(d *schema.ResourceData, m
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if response.FileName != nil {
@@ -239,7 +240,7 @@ func dataSourceTencentCloudSqlserverBackupByFlowIdRead(d *schema.ResourceData, m
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
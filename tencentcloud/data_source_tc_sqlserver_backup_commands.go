@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudSqlserverBackupCommands() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSqlserverBackupCommandsRead,
+		ReadContext: dataSourceTencentCloudSqlserverBackupCommandsRead,
 		Schema: map[string]*schema.Schema{
 			"backup_file_type": {
 				Required:    true,
@@ -76,15 +77,15 @@ func dataSourceTencentCloudSqlserverBackupCommands() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSqlserverBackupCommandsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSqlserverBackupCommandsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_backup_commands.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		command []*sqlserver.DescribeBackupCommandResponseParams
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("backup_file_type"); ok {
@@ -105,7 +106,7 @@ func dataSourceTencentCloudSqlserverBackupCommandsRead(d *schema.ResourceData, m
 
 	service := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, err := service.DescribeSqlserverBackupCommand(ctx, paramMap)
 		if err != nil {
 			return retryError(err)
@@ -116,7 +117,7 @@ func dataSourceTencentCloudSqlserverBackupCommandsRead(d *schema.ResourceData, m
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var list []map[string]interface{}
@@ -133,13 +134,13 @@ func dataSourceTencentCloudSqlserverBackupCommandsRead(d *schema.ResourceData, m
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("list", list); e != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), command); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudSqlserverBackupUploadSize() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSqlserverBackupUploadSizeRead,
+		ReadContext: dataSourceTencentCloudSqlserverBackupUploadSizeRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -68,16 +69,16 @@ func dataSourceTencentCloudSqlserverBackupUploadSize() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSqlserverBackupUploadSizeRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSqlserverBackupUploadSizeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_backup_upload_size.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId                  = getLogId(contextNil)
-		ctx                    = context.WithValue(context.TODO(), logIdKey, logId)
 		service                = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		cosUploadBackupFileSet []*sqlserver.CosUploadBackupFile
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -92,7 +93,7 @@ func dataSourceTencentCloudSqlserverBackupUploadSizeRead(d *schema.ResourceData,
 		paramMap["IncrementalMigrationId"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeSqlserverBackupUploadSizeByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -103,7 +104,7 @@ func dataSourceTencentCloudSqlserverBackupUploadSizeRead(d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(cosUploadBackupFileSet))
@@ -132,7 +133,7 @@ func dataSourceTencentCloudSqlserverBackupUploadSizeRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -86,6 +86,7 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
@@ -93,7 +94,7 @@ import (
 func dataSourceTencentCloudSqlserverBackups() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceTencentSqlserverBackupsRead,
+		ReadContext: dataSourceTencentSqlserverBackupsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -195,11 +196,11 @@ func dataSourceTencentCloudSqlserverBackups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentSqlserverBackupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentSqlserverBackupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_backups.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Get("instance_id").(string)
 	backupName := d.Get("backup_name").(string)
@@ -210,7 +211,7 @@ func dataSourceTencentSqlserverBackupsRead(d *schema.ResourceData, meta interfac
 	backInfoItems, err := sqlserverService.DescribeSqlserverBackups(ctx, instanceId, backupName, startTime, endTime)
 
 	if err != nil {
-		return fmt.Errorf("api[DescribeBackups]fail, return %s", err.Error())
+		return diag.Errorf("api[DescribeBackups]fail, return %s", err.Error())
 	}
 
 	var list []map[string]interface{}
@@ -237,11 +238,11 @@ func dataSourceTencentSqlserverBackupsRead(d *schema.ResourceData, meta interfac
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("list", list); e != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 
 	return nil
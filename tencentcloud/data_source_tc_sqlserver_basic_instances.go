@@ -34,13 +34,14 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudSqlserverBasicInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSqlserverBasicInstanceRead,
+		ReadContext: dataSourceTencentCloudSqlserverBasicInstanceRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -170,12 +171,11 @@ func dataSourceTencentCloudSqlserverBasicInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSqlserverBasicInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSqlserverBasicInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_basic_instances.read")()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		tcClient   = meta.(*TencentCloudClient).apiV3Conn
 		tagService = &TagService{client: tcClient}
 		service    = SqlserverService{client: tcClient}
@@ -185,6 +185,7 @@ func dataSourceTencentCloudSqlserverBasicInstanceRead(d *schema.ResourceData, me
 		vpcId      string
 		subnetId   string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if v, ok := d.GetOk("project_id"); ok {
 		projectId = v.(int)
 	}
@@ -196,7 +197,7 @@ func dataSourceTencentCloudSqlserverBasicInstanceRead(d *schema.ResourceData, me
 	}
 	instanceList, err := service.DescribeSqlserverInstances(ctx, id, name, projectId, vpcId, subnetId, 1)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(instanceList))
@@ -226,7 +227,7 @@ func dataSourceTencentCloudSqlserverBasicInstanceRead(d *schema.ResourceData, me
 		}
 		tagList, err := tagService.DescribeResourceTags(ctx, "sqlserver", "instance", tcClient.Region, *v.InstanceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		listItem["tags"] = tagList
@@ -237,11 +238,11 @@ func dataSourceTencentCloudSqlserverBasicInstanceRead(d *schema.ResourceData, me
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("instance_list", list); e != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 
 	return nil
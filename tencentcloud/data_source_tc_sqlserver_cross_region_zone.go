@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudSqlserverCrossRegionZone() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSqlserverCrossRegionZoneRead,
+		ReadContext: dataSourceTencentCloudSqlserverCrossRegionZoneRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -48,17 +49,17 @@ func dataSourceTencentCloudSqlserverCrossRegionZone() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSqlserverCrossRegionZoneRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSqlserverCrossRegionZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_cross_region_zone.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId       = getLogId(contextNil)
-		ctx         = context.WithValue(context.TODO(), logIdKey, logId)
 		service     = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		crossRegion *sqlserver.DescribeCrossRegionZoneResponseParams
 		instanceId  string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -66,7 +67,7 @@ func dataSourceTencentCloudSqlserverCrossRegionZoneRead(d *schema.ResourceData,
 		instanceId = v.(string)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeSqlserverCrossRegionZoneByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -77,7 +78,7 @@ func dataSourceTencentCloudSqlserverCrossRegionZoneRead(d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if crossRegion.Region != nil {
@@ -93,7 +94,7 @@ func dataSourceTencentCloudSqlserverCrossRegionZoneRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudSqlserverDBCharsets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSqlserverDBCharsetsRead,
+		ReadContext: dataSourceTencentCloudSqlserverDBCharsetsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -43,17 +44,17 @@ func dataSourceTencentCloudSqlserverDBCharsets() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSqlserverDBCharsetsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSqlserverDBCharsetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_db_charsets.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId            = getLogId(contextNil)
-		ctx              = context.WithValue(context.TODO(), logIdKey, logId)
 		service          = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId       string
 		databaseCharsets []*string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -61,7 +62,7 @@ func dataSourceTencentCloudSqlserverDBCharsetsRead(d *schema.ResourceData, meta
 		instanceId = v.(string)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeSqlserverDatasourceDBCharsetsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -72,7 +73,7 @@ func dataSourceTencentCloudSqlserverDBCharsetsRead(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if databaseCharsets != nil {
@@ -83,7 +84,7 @@ func dataSourceTencentCloudSqlserverDBCharsetsRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
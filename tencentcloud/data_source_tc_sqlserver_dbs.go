@@ -63,15 +63,15 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTencentSqlserverDBs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentSqlserverDBRead,
+		ReadContext: dataSourceTencentSqlserverDBRead,
 		Schema: map[string]*schema.Schema{
 			"result_output_file": {
 				Type:        schema.TypeString,
@@ -127,26 +127,26 @@ func dataSourceTencentSqlserverDBs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentSqlserverDBRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentSqlserverDBRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencent_sqlserver_dbs.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	// precheck
 	instanceId := d.Get("instance_id").(string)
 	_, has, err := sqlserverService.DescribeSqlserverInstanceById(ctx, instanceId)
 	if err != nil {
-		return fmt.Errorf("[CRITAL]%s DescribeSqlserverInstanceById fail, reason:%s\n", logId, err)
+		return diag.Errorf("[CRITAL]%s DescribeSqlserverInstanceById fail, reason:%s\n", logId, err)
 	}
 	if !has {
-		return fmt.Errorf("[CRITAL]%s SQL Server instance %s dose not exist", logId, instanceId)
+		return diag.Errorf("[CRITAL]%s SQL Server instance %s dose not exist", logId, instanceId)
 	}
 
 	dbInfos, err := sqlserverService.DescribeDBsOfInstance(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var dbList []map[string]interface{}
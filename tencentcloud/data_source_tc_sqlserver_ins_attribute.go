@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudSqlserverInsAttribute() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSqlserverInsAttributeRead,
+		ReadContext: dataSourceTencentCloudSqlserverInsAttributeRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -97,17 +98,17 @@ func dataSourceTencentCloudSqlserverInsAttribute() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSqlserverInsAttributeRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSqlserverInsAttributeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_ins_attribute.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId        = getLogId(contextNil)
-		ctx          = context.WithValue(context.TODO(), logIdKey, logId)
 		service      = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		insAttribute *sqlserver.DescribeDBInstancesAttributeResponseParams
 		instanceId   string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -115,7 +116,7 @@ func dataSourceTencentCloudSqlserverInsAttributeRead(d *schema.ResourceData, met
 		instanceId = v.(string)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeSqlserverInsAttributeByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -126,7 +127,7 @@ func dataSourceTencentCloudSqlserverInsAttributeRead(d *schema.ResourceData, met
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if insAttribute.InstanceId != nil {
@@ -185,7 +186,7 @@ func dataSourceTencentCloudSqlserverInsAttributeRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
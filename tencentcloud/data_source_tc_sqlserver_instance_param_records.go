@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudSqlserverInstanceParamRecords() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSqlserverInstanceParamRecordsRead,
+		ReadContext: dataSourceTencentCloudSqlserverInstanceParamRecordsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -77,16 +78,16 @@ func dataSourceTencentCloudSqlserverInstanceParamRecords() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSqlserverInstanceParamRecordsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSqlserverInstanceParamRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_instance_param_records.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -96,7 +97,7 @@ func dataSourceTencentCloudSqlserverInstanceParamRecordsRead(d *schema.ResourceD
 
 	var items []*sqlserver.ParamRecord
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeSqlserverInstanceParamRecordsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -106,7 +107,7 @@ func dataSourceTencentCloudSqlserverInstanceParamRecordsRead(d *schema.ResourceD
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(items))
@@ -149,7 +150,7 @@ func dataSourceTencentCloudSqlserverInstanceParamRecordsRead(d *schema.ResourceD
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
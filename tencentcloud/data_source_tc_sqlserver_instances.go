@@ -34,13 +34,14 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudSqlserverInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSqlserverInstanceRead,
+		ReadContext: dataSourceTencentCloudSqlserverInstanceRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -175,11 +176,11 @@ func dataSourceTencentCloudSqlserverInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSqlserverInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSqlserverInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_instances.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcClient := meta.(*TencentCloudClient).apiV3Conn
 	tagService := &TagService{client: tcClient}
@@ -204,7 +205,7 @@ func dataSourceTencentCloudSqlserverInstanceRead(d *schema.ResourceData, meta in
 		instanceList, err = service.DescribeSqlserverInstances(ctx, id, name, projectId, vpcId, subnetId, 1)
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(instanceList))
@@ -236,7 +237,7 @@ func dataSourceTencentCloudSqlserverInstanceRead(d *schema.ResourceData, meta in
 
 		tagList, err := tagService.DescribeResourceTags(ctx, "sqlserver", "instance", tcClient.Region, *v.InstanceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		listItem["tags"] = tagList
@@ -247,11 +248,11 @@ func dataSourceTencentCloudSqlserverInstanceRead(d *schema.ResourceData, meta in
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("instance_list", list); e != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 
 	return nil
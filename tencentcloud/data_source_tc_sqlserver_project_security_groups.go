@@ -15,6 +15,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudSqlserverProjectSecurityGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSqlserverProjectSecurityGroupsRead,
+		ReadContext: dataSourceTencentCloudSqlserverProjectSecurityGroupsRead,
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Required:    true,
@@ -141,16 +142,16 @@ func dataSourceTencentCloudSqlserverProjectSecurityGroups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSqlserverProjectSecurityGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSqlserverProjectSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_project_security_groups.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		projectId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, _ := d.GetOk("project_id"); v != nil {
@@ -160,7 +161,7 @@ func dataSourceTencentCloudSqlserverProjectSecurityGroupsRead(d *schema.Resource
 
 	var securityGroupSet []*sqlserver.SecurityGroup
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeSqlserverProjectSecurityGroupsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -171,7 +172,7 @@ func dataSourceTencentCloudSqlserverProjectSecurityGroupsRead(d *schema.Resource
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(securityGroupSet))
@@ -272,7 +273,7 @@ func dataSourceTencentCloudSqlserverProjectSecurityGroupsRead(d *schema.Resource
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -110,13 +110,14 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentSqlserverPublishSubscribes() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentSqlserverPublishSubscribesRead,
+		ReadContext: dataSourceTencentSqlserverPublishSubscribesRead,
 		Schema: map[string]*schema.Schema{
 			"result_output_file": {
 				Type:        schema.TypeString,
@@ -240,11 +241,11 @@ func dataSourceTencentSqlserverPublishSubscribes() *schema.Resource {
 	}
 }
 
-func dataSourceTencentSqlserverPublishSubscribesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentSqlserverPublishSubscribesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_publish_subscribes.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	paramMap := make(map[string]interface{})
 	paramMap["instanceId"] = d.Get("instance_id").(string)
@@ -270,7 +271,7 @@ func dataSourceTencentSqlserverPublishSubscribesRead(d *schema.ResourceData, met
 
 	publishSubscribes, err := sqlserverService.DescribeSqlserverPublishSubscribes(ctx, paramMap)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceList := make([]map[string]interface{}, 0, len(publishSubscribes))
 	ids := make([]string, 0, len(publishSubscribes))
@@ -297,7 +298,7 @@ func dataSourceTencentSqlserverPublishSubscribesRead(d *schema.ResourceData, met
 			"subscribe_instance_name": publishSubscribe.SubscribeInstanceName,
 			"database_tuples":         databaseTupleStatus,
 		}
-		resourceId := *publishSubscribe.PublishInstanceId + FILED_SP + *publishSubscribe.SubscribeInstanceId
+		resourceId := helper.IdFormat(*publishSubscribe.PublishInstanceId, *publishSubscribe.SubscribeInstanceId)
 		instanceList = append(instanceList, instance)
 		ids = append(ids, resourceId)
 	}
@@ -305,13 +306,13 @@ func dataSourceTencentSqlserverPublishSubscribesRead(d *schema.ResourceData, met
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if err = d.Set("publish_subscribe_list", instanceList); err != nil {
 		log.Printf("[CRITAL]%s provider set sql server publish and subscribe list fail, reason:%s ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), instanceList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudSqlserverQueryXevent() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSqlserverQueryXeventRead,
+		ReadContext: dataSourceTencentCloudSqlserverQueryXeventRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -110,17 +111,17 @@ func dataSourceTencentCloudSqlserverQueryXevent() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSqlserverQueryXeventRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSqlserverQueryXeventRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_query_xevent.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		events     []*sqlserver.Events
 		instanceId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -140,7 +141,7 @@ func dataSourceTencentCloudSqlserverQueryXeventRead(d *schema.ResourceData, meta
 		paramMap["EndTime"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeSqlserverQueryXeventByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -151,7 +152,7 @@ func dataSourceTencentCloudSqlserverQueryXeventRead(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(events))
@@ -206,7 +207,7 @@ func dataSourceTencentCloudSqlserverQueryXeventRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -13,9 +13,9 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
@@ -23,7 +23,7 @@ import (
 func dataSourceTencentCloudSqlserverReadonlyGroups() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceTencentSqlserverReadonlyGroups,
+		ReadContext: dataSourceTencentSqlserverReadonlyGroups,
 		Schema: map[string]*schema.Schema{
 			"master_instance_id": {
 				Type:        schema.TypeString,
@@ -100,11 +100,11 @@ func dataSourceTencentCloudSqlserverReadonlyGroups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentSqlserverReadonlyGroups(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentSqlserverReadonlyGroups(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_readonly_groups.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Get("master_instance_id").(string)
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -112,7 +112,7 @@ func dataSourceTencentSqlserverReadonlyGroups(d *schema.ResourceData, meta inter
 	groupList, err := sqlserverService.DescribeReadonlyGroupList(ctx, instanceId)
 
 	if err != nil {
-		return fmt.Errorf("api[DescribeReadOnlyGroupList]fail, return %s", err.Error())
+		return diag.Errorf("api[DescribeReadOnlyGroupList]fail, return %s", err.Error())
 	}
 
 	var list []map[string]interface{}
@@ -142,11 +142,11 @@ func dataSourceTencentSqlserverReadonlyGroups(d *schema.ResourceData, meta inter
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("list", list); e != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 
 	return nil
@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudSqlserverRegions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSqlserverRegionsRead,
+		ReadContext: dataSourceTencentCloudSqlserverRegionsRead,
 		Schema: map[string]*schema.Schema{
 			"region_set": {
 				Computed:    true,
@@ -61,18 +62,18 @@ func dataSourceTencentCloudSqlserverRegions() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSqlserverRegionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSqlserverRegionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_regions.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		regionSet []*sqlserver.RegionInfo
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeSqlserverDatasourceRegionsByFilter(ctx)
 		if e != nil {
 			return retryError(e)
@@ -83,7 +84,7 @@ func dataSourceTencentCloudSqlserverRegionsRead(d *schema.ResourceData, meta int
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(regionSet))
@@ -120,7 +121,7 @@ func dataSourceTencentCloudSqlserverRegionsRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudSqlserverRollbackTime() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSqlserverRollbackTimeRead,
+		ReadContext: dataSourceTencentCloudSqlserverRollbackTimeRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -71,17 +72,17 @@ func dataSourceTencentCloudSqlserverRollbackTime() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSqlserverRollbackTimeRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSqlserverRollbackTimeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_rollback_time.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		details    []*sqlserver.DbRollbackTimeInfo
 		instanceId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -94,7 +95,7 @@ func dataSourceTencentCloudSqlserverRollbackTimeRead(d *schema.ResourceData, met
 		paramMap["DBs"] = helper.InterfacesStringsPoint(dBsSet)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeSqlserverRollbackTimeByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -105,7 +106,7 @@ func dataSourceTencentCloudSqlserverRollbackTimeRead(d *schema.ResourceData, met
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(details))
@@ -136,7 +137,7 @@ func dataSourceTencentCloudSqlserverRollbackTimeRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudSqlserverSlowlogs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSqlserverSlowlogsRead,
+		ReadContext: dataSourceTencentCloudSqlserverSlowlogsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -99,16 +100,16 @@ func dataSourceTencentCloudSqlserverSlowlogs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSqlserverSlowlogsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSqlserverSlowlogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_slowlogs.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -126,7 +127,7 @@ func dataSourceTencentCloudSqlserverSlowlogsRead(d *schema.ResourceData, meta in
 
 	var slowlogs []*sqlserver.SlowlogInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeSqlserverSlowlogsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -137,7 +138,7 @@ func dataSourceTencentCloudSqlserverSlowlogsRead(d *schema.ResourceData, meta in
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(slowlogs))
@@ -188,7 +189,7 @@ func dataSourceTencentCloudSqlserverSlowlogsRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudSqlserverUploadBackupInfo() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSqlserverUploadBackupInfoRead,
+		ReadContext: dataSourceTencentCloudSqlserverUploadBackupInfoRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -84,17 +85,17 @@ func dataSourceTencentCloudSqlserverUploadBackupInfo() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSqlserverUploadBackupInfoRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSqlserverUploadBackupInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_upload_backup_info.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId            = getLogId(contextNil)
-		ctx              = context.WithValue(context.TODO(), logIdKey, logId)
 		service          = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		uploadBackupInfo *sqlserver.DescribeUploadBackupInfoResponseParams
 		instanceId       string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -106,7 +107,7 @@ func dataSourceTencentCloudSqlserverUploadBackupInfoRead(d *schema.ResourceData,
 		paramMap["BackupMigrationId"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeSqlserverUploadBackupInfoByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -117,7 +118,7 @@ func dataSourceTencentCloudSqlserverUploadBackupInfoRead(d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if uploadBackupInfo.BucketName != nil {
@@ -156,7 +157,7 @@ func dataSourceTencentCloudSqlserverUploadBackupInfoRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudSqlserverUploadIncrementalInfo() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSqlserverUploadIncrementalInfoRead,
+		ReadContext: dataSourceTencentCloudSqlserverUploadIncrementalInfoRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -90,17 +91,17 @@ func dataSourceTencentCloudSqlserverUploadIncrementalInfo() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSqlserverUploadIncrementalInfoRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSqlserverUploadIncrementalInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sqlserver_upload_incremental_info.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId                 = getLogId(contextNil)
-		ctx                   = context.WithValue(context.TODO(), logIdKey, logId)
 		service               = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		uploadIncrementalInfo *sqlserver.DescribeUploadIncrementalInfoResponseParams
 		instanceId            string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -116,7 +117,7 @@ func dataSourceTencentCloudSqlserverUploadIncrementalInfoRead(d *schema.Resource
 		paramMap["IncrementalMigrationId"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeSqlserverUploadIncrementalInfoByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -127,7 +128,7 @@ func dataSourceTencentCloudSqlserverUploadIncrementalInfoRead(d *schema.Resource
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if uploadIncrementalInfo.BucketName != nil {
@@ -166,7 +167,7 @@ func dataSourceTencentCloudSqlserverUploadIncrementalInfoRead(d *schema.Resource
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -11,15 +11,15 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTencentSqlserverZoneConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentSqlserverZoneConfigRead,
+		ReadContext: dataSourceTencentSqlserverZoneConfigRead,
 		Schema: map[string]*schema.Schema{
 			"result_output_file": {
 				Type:        schema.TypeString,
@@ -108,18 +108,18 @@ func dataSourceTencentSqlserverZoneConfig() *schema.Resource {
 	}
 }
 
-func dataSourceTencentSqlserverZoneConfigRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentSqlserverZoneConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencent_sqlserver_zone_config.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	// get zoneinfo
 	zoneInfoList, err := sqlserverService.DescribeZones(ctx)
 	if err != nil {
-		return fmt.Errorf("api[DescribeZones]fail, return %s", err.Error())
+		return diag.Errorf("api[DescribeZones]fail, return %s", err.Error())
 	}
 	zoneSet := make(map[string]map[string]interface{})
 	for _, zoneInfo := range zoneInfoList {
@@ -137,7 +137,7 @@ func dataSourceTencentSqlserverZoneConfigRead(d *schema.ResourceData, meta inter
 		// get specinfo for each zone
 		specinfoList, err := sqlserverService.DescribeProductConfig(ctx, k)
 		if err != nil {
-			return fmt.Errorf("api[DescribeProductConfig]fail, return %s", err.Error())
+			return diag.Errorf("api[DescribeProductConfig]fail, return %s", err.Error())
 		}
 		var specinfoConfigs []interface{}
 		for _, specinfoItem := range specinfoList {
@@ -161,7 +161,7 @@ func dataSourceTencentSqlserverZoneConfigRead(d *schema.ResourceData, meta inter
 
 	// set zone_list
 	if err := d.Set("zone_list", zoneList); err != nil {
-		return fmt.Errorf("[CRITAL]%s provider set zone_list fail, reason:%s\n ", logId, err.Error())
+		return diag.Errorf("[CRITAL]%s provider set zone_list fail, reason:%s\n ", logId, err.Error())
 	}
 
 	d.SetId("zone_config")
@@ -13,10 +13,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ssl "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ssl/v20191205"
@@ -25,7 +25,7 @@ import (
 
 func dataSourceTencentCloudSslCertificates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSslCertificatesRead,
+		ReadContext: dataSourceTencentCloudSslCertificatesRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -157,10 +157,10 @@ func dataSourceTencentCloudSslCertificates() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSslCertificatesRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceTencentCloudSslCertificatesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ssl_certificates.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		name     *string
@@ -183,7 +183,7 @@ func dataSourceTencentCloudSslCertificatesRead(d *schema.ResourceData, m interfa
 	sslService := SSLService{client: m.(*TencentCloudClient).apiV3Conn}
 	certificateList, err := GetCertificateList(ctx, sslService, id, name, certType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	certificates := make([]map[string]interface{}, 0, len(certificateList))
@@ -201,14 +201,14 @@ func dataSourceTencentCloudSslCertificatesRead(d *schema.ResourceData, m interfa
 			"CertEndTime":     "end time",
 			"InsertTime":      "create time",
 		}); len(nilNames) > 0 {
-			return fmt.Errorf("certificate %v are nil", nilNames)
+			return diag.Errorf("certificate %v are nil", nilNames)
 		}
 
 		ids = append(ids, *certificate.CertificateId)
 
 		projectId, err := strconv.Atoi(*certificate.ProjectId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		m := map[string]interface{}{
@@ -236,7 +236,7 @@ func dataSourceTencentCloudSslCertificatesRead(d *schema.ResourceData, m interfa
 		describeRequest.CertificateId = certificate.CertificateId
 		var outErr, inErr error
 		var describeResponse *ssl.DescribeCertificateDetailResponse
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			describeResponse, inErr = sslService.DescribeCertificateDetail(ctx, describeRequest)
 			if inErr != nil {
 				return retryError(inErr)
@@ -245,7 +245,7 @@ func dataSourceTencentCloudSslCertificatesRead(d *schema.ResourceData, m interfa
 		})
 		if outErr != nil {
 			log.Printf("[CRITAL]%s read certificate failed, reason: %v", logId, outErr)
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 		if describeResponse != nil && describeResponse.Response != nil {
@@ -281,7 +281,7 @@ func dataSourceTencentCloudSslCertificatesRead(d *schema.ResourceData, m interfa
 		if err := writeToFile(output.(string), certificates); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]",
 				logId, output.(string), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -299,7 +299,7 @@ func GetCertificateList(ctx context.Context, sslService SSLService, id, name, ce
 	if id == nil && name == nil {
 		describeRequest := ssl.NewDescribeCertificatesRequest()
 		describeRequest.CertificateType = certType
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			certificateList, inErr = sslService.DescribeCertificates(ctx, describeRequest)
 			if inErr != nil {
 				return retryError(inErr)
@@ -318,7 +318,7 @@ func GetCertificateList(ctx context.Context, sslService SSLService, id, name, ce
 		describeRequest := ssl.NewDescribeCertificatesRequest()
 		describeRequest.CertificateType = certType
 		describeRequest.SearchKey = id
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			certificatesById, inErr = sslService.DescribeCertificates(ctx, describeRequest)
 			if inErr != nil {
 				return retryError(inErr)
@@ -335,7 +335,7 @@ func GetCertificateList(ctx context.Context, sslService SSLService, id, name, ce
 		describeRequest := ssl.NewDescribeCertificatesRequest()
 		describeRequest.CertificateType = certType
 		describeRequest.SearchKey = name
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			certificatesByName, inErr = sslService.DescribeCertificates(ctx, describeRequest)
 			if inErr != nil {
 				return retryError(inErr)
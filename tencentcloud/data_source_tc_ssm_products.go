@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -19,7 +20,7 @@ import (
 
 func dataSourceTencentCloudSsmProducts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSsmProductsRead,
+		ReadContext: dataSourceTencentCloudSsmProductsRead,
 		Schema: map[string]*schema.Schema{
 			"products": {
 				Computed:    true,
@@ -36,18 +37,18 @@ func dataSourceTencentCloudSsmProducts() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSsmProductsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSsmProductsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ssm_products.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId    = getLogId(contextNil)
-		ctx      = context.WithValue(context.TODO(), logIdKey, logId)
 		service  = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
 		products []*string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeSsmProductsByFilter(ctx)
 		if e != nil {
 			return retryError(e)
@@ -58,7 +59,7 @@ func dataSourceTencentCloudSsmProductsRead(d *schema.ResourceData, meta interfac
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if products != nil {
@@ -69,7 +70,7 @@ func dataSourceTencentCloudSsmProductsRead(d *schema.ResourceData, meta interfac
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), products); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -30,8 +30,8 @@ package tencentcloud
 import (
 	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkError "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -40,7 +40,7 @@ import (
 
 func dataSourceTencentCloudSsmSecretVersions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSsmSecretVersionsRead,
+		ReadContext: dataSourceTencentCloudSsmSecretVersionsRead,
 		Schema: map[string]*schema.Schema{
 			"secret_name": {
 				Type:        schema.TypeString,
@@ -85,19 +85,19 @@ func dataSourceTencentCloudSsmSecretVersions() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSsmSecretVersionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSsmSecretVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ssm_secret_versions.read")()
 
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		ssmService    = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
 		secretName    = d.Get("secret_name").(string)
 		outErr, inErr error
 		secretInfo    *SecretInfo
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		secretInfo, inErr = ssmService.DescribeSecretByName(ctx, secretName)
 		if inErr != nil {
 			return retryError(inErr)
@@ -115,7 +115,7 @@ func dataSourceTencentCloudSsmSecretVersionsRead(d *schema.ResourceData, meta in
 		}
 
 		log.Printf("[CRITAL]%s read SSM secret failed, reason:%+v", logId, outErr)
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if secretInfo.status != SSM_STATUS_ENABLED {
@@ -128,7 +128,7 @@ func dataSourceTencentCloudSsmSecretVersionsRead(d *schema.ResourceData, meta in
 	if v, ok := d.GetOk("version_id"); ok {
 		versionIds = append(versionIds, v.(string))
 	} else {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			versionIds, inErr = ssmService.DescribeSecretVersionIdsByName(ctx, secretName)
 			if inErr != nil {
 				return retryError(inErr)
@@ -137,12 +137,12 @@ func dataSourceTencentCloudSsmSecretVersionsRead(d *schema.ResourceData, meta in
 		})
 		if outErr != nil {
 			log.Printf("[CRITAL]%s read SSM secret versionId list failed, reason:%+v", logId, outErr)
-			return outErr
+			return diag.FromErr(outErr)
 		}
 	}
 
 	for _, versionId := range versionIds {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			secretVersionInfo, inErr := ssmService.DescribeSecretVersion(ctx, secretName, versionId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -152,7 +152,7 @@ func dataSourceTencentCloudSsmSecretVersionsRead(d *schema.ResourceData, meta in
 		})
 		if outErr != nil {
 			log.Printf("[CRITAL]%s read SSM secret version failed, reason:%+v", logId, outErr)
-			return outErr
+			return diag.FromErr(outErr)
 		}
 	}
 
@@ -166,17 +166,17 @@ func dataSourceTencentCloudSsmSecretVersionsRead(d *schema.ResourceData, meta in
 		}
 
 		secretVersionList = append(secretVersionList, mapping)
-		ids = append(ids, strings.Join([]string{secretVersionInfo.secretName, secretVersionInfo.versionId}, FILED_SP))
+		ids = append(ids, helper.IdFormat(secretVersionInfo.secretName, secretVersionInfo.versionId))
 	}
 
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("secret_version_list", secretVersionList); e != nil {
 		log.Printf("[CRITAL]%s provider set SSM secret version list fail, reason:%+v", logId, e)
-		return e
+		return diag.FromErr(e)
 	}
 
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
-		return writeToFile(output.(string), secretVersionList)
+		return diag.FromErr(writeToFile(output.(string), secretVersionList))
 	}
 
 	return nil
@@ -38,6 +38,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ssm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ssm/v20190923"
@@ -46,7 +47,7 @@ import (
 
 func dataSourceTencentCloudSsmSecrets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudSsmSecretsRead,
+		ReadContext: dataSourceTencentCloudSsmSecretsRead,
 		Schema: map[string]*schema.Schema{
 			"order_type": {
 				Type:        schema.TypeInt,
@@ -195,15 +196,15 @@ func dataSourceTencentCloudSsmSecrets() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSsmSecretsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSsmSecretsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_ssm_secrets.read")()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		ssmService = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
 		secrets    []*ssm.SecretMetadata
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	param := make(map[string]interface{})
 	if v, ok := d.GetOk("order_type"); ok {
@@ -230,7 +231,7 @@ func dataSourceTencentCloudSsmSecretsRead(d *schema.ResourceData, meta interface
 		param["product_name"] = v.(string)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := ssmService.DescribeSecretsByFilter(ctx, param)
 		if e != nil {
 			return retryError(e)
@@ -242,7 +243,7 @@ func dataSourceTencentCloudSsmSecretsRead(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		log.Printf("[CRITAL]%s read SSM secrets failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	secretList := make([]map[string]interface{}, 0, len(secrets))
@@ -277,11 +278,11 @@ func dataSourceTencentCloudSsmSecretsRead(d *schema.ResourceData, meta interface
 	d.SetId(helper.DataResourceIdsHash(secretNames))
 	if e := d.Set("secret_list", secretList); e != nil {
 		log.Printf("[CRITAL]%s provider set SSM secret list fail, reason:%+v", logId, e)
-		return e
+		return diag.FromErr(e)
 	}
 
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
-		return writeToFile(output.(string), secretList)
+		return diag.FromErr(writeToFile(output.(string), secretList))
 	}
 
 	return nil
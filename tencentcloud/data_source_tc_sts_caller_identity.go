@@ -14,6 +14,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sts/v20180813"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudStsCallerIdentity() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudStsCallerIdentityRead,
+		ReadContext: dataSourceTencentCloudStsCallerIdentityRead,
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:        schema.TypeString,
@@ -62,17 +63,17 @@ func dataSourceTencentCloudStsCallerIdentity() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudStsCallerIdentityRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudStsCallerIdentityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_sts_caller_identity.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	stsService := StsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var callerIdentity *sts.GetCallerIdentityResponseParams
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := stsService.DescribeStsCallerIdentityByFilter(ctx)
 		if e != nil {
 			return retryError(e)
@@ -82,7 +83,7 @@ func dataSourceTencentCloudStsCallerIdentityRead(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Sts instances failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if callerIdentity.Arn != nil {
@@ -116,7 +117,7 @@ func dataSourceTencentCloudStsCallerIdentityRead(d *schema.ResourceData, meta in
 			"principal_id": callerIdentity.PrincipalId,
 			"type":         callerIdentity.Type,
 		}); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -35,15 +35,15 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTencentCloudSubnet() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This data source has been deprecated in Terraform TencentCloud provider version 1.10.0. Please use 'tencentcloud_vpc_subnets' instead.",
-		Read:               dataSourceTencentCloudSubnetRead,
+		ReadContext:        dataSourceTencentCloudSubnetRead,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -80,11 +80,11 @@ func dataSourceTencentCloudSubnet() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudSubnetRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudSubnetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_subnet.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	vpcService := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	vpcId := d.Get("vpc_id").(string)
@@ -92,11 +92,11 @@ func dataSourceTencentCloudSubnetRead(d *schema.ResourceData, meta interface{})
 
 	infos, err := vpcService.DescribeSubnets(ctx, subnetId, vpcId, "", "", map[string]string{}, nil, nil, "", "")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(infos) == 0 {
-		return fmt.Errorf("subnet vpc_id=%s, subnet_id=%s not found", vpcId, subnetId)
+		return diag.Errorf("subnet vpc_id=%s, subnet_id=%s not found", vpcId, subnetId)
 	}
 
 	subnet := infos[0]
@@ -18,6 +18,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tat/v20201028"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudTatAgent() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTatAgentRead,
+		ReadContext: dataSourceTencentCloudTatAgentRead,
 		Schema: map[string]*schema.Schema{
 			"instance_ids": {
 				Optional: true,
@@ -112,13 +113,13 @@ func dataSourceTencentCloudTatAgent() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTatAgentRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTatAgentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tat_agent.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_ids"); ok {
@@ -150,7 +151,7 @@ func dataSourceTencentCloudTatAgentRead(d *schema.ResourceData, meta interface{}
 
 	var automationAgentSet []*tat.AutomationAgentInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTatAgentByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -159,7 +160,7 @@ func dataSourceTencentCloudTatAgentRead(d *schema.ResourceData, meta interface{}
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(automationAgentSet))
@@ -204,7 +205,7 @@ func dataSourceTencentCloudTatAgentRead(d *schema.ResourceData, meta interface{}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -18,6 +18,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tat/v20201028"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudTatCommand() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTatCommandRead,
+		ReadContext: dataSourceTencentCloudTatCommandRead,
 		Schema: map[string]*schema.Schema{
 			"command_id": {
 				Type:        schema.TypeString,
@@ -170,12 +171,12 @@ func dataSourceTencentCloudTatCommand() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTatCommandRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTatCommandRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tat_command.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("command_id"); ok {
@@ -197,7 +198,7 @@ func dataSourceTencentCloudTatCommandRead(d *schema.ResourceData, meta interface
 	tatService := TatService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var commandSet []*tat.Command
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := tatService.DescribeTatCommandByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -207,7 +208,7 @@ func dataSourceTencentCloudTatCommandRead(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Tat commandSet failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	commandSetList := []interface{}{}
@@ -295,7 +296,7 @@ func dataSourceTencentCloudTatCommandRead(d *schema.ResourceData, meta interface
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), commandSetList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -19,6 +19,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tat/v20201028"
@@ -27,7 +28,7 @@ import (
 
 func dataSourceTencentCloudTatInvocationTask() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTatInvocationTaskRead,
+		ReadContext: dataSourceTencentCloudTatInvocationTaskRead,
 		Schema: map[string]*schema.Schema{
 			"invocation_task_ids": {
 				Optional: true,
@@ -229,13 +230,13 @@ func dataSourceTencentCloudTatInvocationTask() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTatInvocationTaskRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTatInvocationTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tat_invocation_task.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("invocation_task_ids"); ok {
@@ -271,7 +272,7 @@ func dataSourceTencentCloudTatInvocationTaskRead(d *schema.ResourceData, meta in
 
 	var invocationTaskSet []*tat.InvocationTask
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTatInvocationTaskByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -280,7 +281,7 @@ func dataSourceTencentCloudTatInvocationTaskRead(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(invocationTaskSet))
@@ -413,7 +414,7 @@ func dataSourceTencentCloudTatInvocationTaskRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
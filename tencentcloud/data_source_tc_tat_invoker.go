@@ -17,6 +17,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tat/v20201028"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudTatInvoker() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTatInvokerRead,
+		ReadContext: dataSourceTencentCloudTatInvokerRead,
 		Schema: map[string]*schema.Schema{
 			"invoker_id": {
 				Type:        schema.TypeString,
@@ -141,12 +142,12 @@ func dataSourceTencentCloudTatInvoker() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTatInvokerRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTatInvokerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tat_invoker.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("invoker_id"); ok {
@@ -164,7 +165,7 @@ func dataSourceTencentCloudTatInvokerRead(d *schema.ResourceData, meta interface
 	tatService := TatService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var invokerSet []*tat.Invoker
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := tatService.DescribeTatInvokerByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -174,7 +175,7 @@ func dataSourceTencentCloudTatInvokerRead(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Tat invokerSet failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	invokerSetList := []interface{}{}
@@ -238,7 +239,7 @@ func dataSourceTencentCloudTatInvokerRead(d *schema.ResourceData, meta interface
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), invokerSetList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tat/v20201028"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudTatInvokerRecords() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTatInvokerRecordsRead,
+		ReadContext: dataSourceTencentCloudTatInvokerRecordsRead,
 		Schema: map[string]*schema.Schema{
 			"invoker_ids": {
 				Optional: true,
@@ -77,13 +78,13 @@ func dataSourceTencentCloudTatInvokerRecords() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTatInvokerRecordsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTatInvokerRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tat_invoker_records.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("invoker_ids"); ok {
@@ -95,7 +96,7 @@ func dataSourceTencentCloudTatInvokerRecordsRead(d *schema.ResourceData, meta in
 
 	var invokerRecordSet []*tat.InvokerRecord
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTatInvokerRecordsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -104,7 +105,7 @@ func dataSourceTencentCloudTatInvokerRecordsRead(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(invokerRecordSet))
@@ -145,7 +146,7 @@ func dataSourceTencentCloudTatInvokerRecordsRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
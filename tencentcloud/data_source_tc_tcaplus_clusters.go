@@ -23,12 +23,13 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTencentCloudTcaplusClusters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTcaplusClustersRead,
+		ReadContext: dataSourceTencentCloudTcaplusClustersRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_name": {
 				Type:        schema.TypeString,
@@ -123,11 +124,11 @@ func dataSourceTencentCloudTcaplusClusters() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTcaplusClustersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTcaplusClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tcaplus_clusters.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TcaplusService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -142,7 +143,7 @@ func dataSourceTencentCloudTcaplusClustersRead(d *schema.ResourceData, meta inte
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	list := make([]map[string]interface{}, 0, len(clusters))
@@ -168,11 +169,11 @@ func dataSourceTencentCloudTcaplusClustersRead(d *schema.ResourceData, meta inte
 	d.SetId("cluster." + clusterId + "." + clusterName)
 	if e := d.Set("list", list); e != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 
@@ -14,15 +14,15 @@ package tencentcloud
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTencentCloudTcaplusIdls() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTcaplusIdlsRead,
+		ReadContext: dataSourceTencentCloudTcaplusIdlsRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -52,11 +52,11 @@ func dataSourceTencentCloudTcaplusIdls() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTcaplusIdlsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTcaplusIdlsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tcaplus_idls.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TcaplusService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -69,7 +69,7 @@ func dataSourceTencentCloudTcaplusIdlsRead(d *schema.ResourceData, meta interfac
 		infos, err = service.DescribeIdlFileInfos(ctx, clusterId)
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	list := make([]map[string]interface{}, 0, len(infos))
@@ -85,7 +85,7 @@ func dataSourceTencentCloudTcaplusIdlsRead(d *schema.ResourceData, meta interfac
 		tcaplusIdlId.FileId = *info.FileId
 		id, err := json.Marshal(tcaplusIdlId)
 		if err != nil {
-			return fmt.Errorf("format idl id fail,%s", err.Error())
+			return diag.Errorf("format idl id fail,%s", err.Error())
 		}
 		listItem["idl_id"] = string(id)
 		list = append(list, listItem)
@@ -94,11 +94,11 @@ func dataSourceTencentCloudTcaplusIdlsRead(d *schema.ResourceData, meta interfac
 	d.SetId("idl." + clusterId)
 	if e := d.Set("list", list); e != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 
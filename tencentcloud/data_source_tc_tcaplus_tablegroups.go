@@ -29,12 +29,13 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTencentCloudTcaplusTableGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTcaplusTableGroupsRead,
+		ReadContext: dataSourceTencentCloudTcaplusTableGroupsRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -94,11 +95,11 @@ func dataSourceTencentCloudTcaplusTableGroups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTcaplusTableGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTcaplusTableGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tcaplus_tablegroups.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TcaplusService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -114,7 +115,7 @@ func dataSourceTencentCloudTcaplusTableGroupsRead(d *schema.ResourceData, meta i
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	list := make([]map[string]interface{}, 0, len(groups))
@@ -132,11 +133,11 @@ func dataSourceTencentCloudTcaplusTableGroupsRead(d *schema.ResourceData, meta i
 	d.SetId("group." + clusterId + "." + groupId + "." + groupName)
 	if e := d.Set("list", list); e != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 
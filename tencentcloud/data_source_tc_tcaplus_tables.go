@@ -39,12 +39,13 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTencentCloudTcaplusTables() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTcaplusTablesRead,
+		ReadContext: dataSourceTencentCloudTcaplusTablesRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -154,11 +155,11 @@ func dataSourceTencentCloudTcaplusTables() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTcaplusTablesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTcaplusTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tcaplus_tables.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TcaplusService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -174,7 +175,7 @@ func dataSourceTencentCloudTcaplusTablesRead(d *schema.ResourceData, meta interf
 		tables, err = service.DescribeTables(ctx, clusterId, groupId, tableId, tableName)
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	list := make([]map[string]interface{}, 0, len(tables))
@@ -197,7 +198,7 @@ func dataSourceTencentCloudTcaplusTablesRead(d *schema.ResourceData, meta interf
 			id, err := json.Marshal(tcaplusIdlId)
 
 			if err != nil {
-				return fmt.Errorf("format idl id fail,%s", err.Error())
+				return diag.Errorf("format idl id fail,%s", err.Error())
 			}
 			listItem["idl_id"] = string(id)
 		}
@@ -249,11 +250,11 @@ func dataSourceTencentCloudTcaplusTablesRead(d *schema.ResourceData, meta interf
 	d.SetId("table." + clusterId + "." + groupId + "." + tableId + "." + tableName)
 	if e := d.Set("list", list); e != nil {
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
+		return diag.FromErr(writeToFile(output.(string), list))
 	}
 	return nil
 
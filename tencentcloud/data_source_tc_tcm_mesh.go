@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcm/v20210413"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudTcmMesh() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTcmMeshRead,
+		ReadContext: dataSourceTencentCloudTcmMeshRead,
 		Schema: map[string]*schema.Schema{
 			"mesh_id": {
 				Optional: true,
@@ -183,13 +184,13 @@ func dataSourceTencentCloudTcmMesh() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTcmMeshRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTcmMeshRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tcm_mesh.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string][]*string)
 	if v, ok := d.GetOk("mesh_id"); ok {
@@ -216,7 +217,7 @@ func dataSourceTencentCloudTcmMeshRead(d *schema.ResourceData, meta interface{})
 
 	var meshList []*tcm.Mesh
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTcmMeshByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -225,7 +226,7 @@ func dataSourceTencentCloudTcmMeshRead(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(meshList))
@@ -322,7 +323,7 @@ func dataSourceTencentCloudTcmMeshRead(d *schema.ResourceData, meta interface{})
 
 		err := d.Set("mesh_list", tmpList)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -330,7 +331,7 @@ func dataSourceTencentCloudTcmMeshRead(d *schema.ResourceData, meta interface{})
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudTcmqQueue() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTcmqQueueRead,
+		ReadContext: dataSourceTencentCloudTcmqQueueRead,
 		Schema: map[string]*schema.Schema{
 			"offset": {
 				Default:     0,
@@ -322,13 +323,13 @@ func dataSourceTencentCloudTcmqQueue() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTcmqQueueRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTcmqQueueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tcmq_queue.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, _ := d.GetOk("offset"); v != nil {
@@ -378,7 +379,7 @@ func dataSourceTencentCloudTcmqQueueRead(d *schema.ResourceData, meta interface{
 	var queueList []*tcmq.CmqQueue
 	queueNames := make([]string, 0)
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTcmqQueueByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -387,7 +388,7 @@ func dataSourceTencentCloudTcmqQueueRead(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	result := make([]map[string]interface{}, 0)
 	for _, queue := range queueList {
@@ -517,7 +518,7 @@ func dataSourceTencentCloudTcmqQueueRead(d *schema.ResourceData, meta interface{
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), result); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
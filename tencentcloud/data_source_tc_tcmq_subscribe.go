@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudTcmqSubscribe() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTcmqSubscribeRead,
+		ReadContext: dataSourceTencentCloudTcmqSubscribeRead,
 		Schema: map[string]*schema.Schema{
 			"topic_name": {
 				Required:    true,
@@ -136,13 +137,13 @@ func dataSourceTencentCloudTcmqSubscribe() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTcmqSubscribeRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTcmqSubscribeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tcmq_subscribe.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("topic_name"); ok {
@@ -165,7 +166,7 @@ func dataSourceTencentCloudTcmqSubscribeRead(d *schema.ResourceData, meta interf
 
 	var subscriptionList []*tdmq.CmqSubscription
 	subscriptionNames := make([]string, 0)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTcmqSubscribeByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -174,7 +175,7 @@ func dataSourceTencentCloudTcmqSubscribeRead(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	result := make([]map[string]interface{}, 0)
 	for _, subscription := range subscriptionList {
@@ -232,7 +233,7 @@ func dataSourceTencentCloudTcmqSubscribeRead(d *schema.ResourceData, meta interf
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), result); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
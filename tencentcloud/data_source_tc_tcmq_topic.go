@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudTcmqTopic() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTcmqTopicRead,
+		ReadContext: dataSourceTencentCloudTcmqTopicRead,
 		Schema: map[string]*schema.Schema{
 			"offset": {
 				Default:     0,
@@ -195,13 +196,13 @@ func dataSourceTencentCloudTcmqTopic() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTcmqTopicRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTcmqTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tcmq_topic.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, _ := d.GetOk("offset"); v != nil {
@@ -252,7 +253,7 @@ func dataSourceTencentCloudTcmqTopicRead(d *schema.ResourceData, meta interface{
 	var topicList []*tdmq.CmqTopic
 	topicNames := make([]string, 0)
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTcmqTopicByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -261,7 +262,7 @@ func dataSourceTencentCloudTcmqTopicRead(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	result := make([]map[string]interface{}, 0)
@@ -333,7 +334,7 @@ func dataSourceTencentCloudTcmqTopicRead(d *schema.ResourceData, meta interface{
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), result); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
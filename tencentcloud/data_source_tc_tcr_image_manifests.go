@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudTcrImageManifests() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTcrImageManifestsRead,
+		ReadContext: dataSourceTencentCloudTcrImageManifestsRead,
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Required:    true,
@@ -71,13 +72,13 @@ func dataSourceTencentCloudTcrImageManifests() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTcrImageManifestsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTcrImageManifestsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tcr_image_manifests.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var (
 		registryId     string
 		namespaceName  string
@@ -113,7 +114,7 @@ func dataSourceTencentCloudTcrImageManifestsRead(d *schema.ResourceData, meta in
 		manifest *string
 	)
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTcrImageManifestsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -123,7 +124,7 @@ func dataSourceTencentCloudTcrImageManifestsRead(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if manifest != nil {
@@ -145,7 +146,7 @@ func dataSourceTencentCloudTcrImageManifestsRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -18,8 +18,8 @@ package tencentcloud
 
 import (
 	"context"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcr/v20190924"
@@ -28,7 +28,7 @@ import (
 
 func dataSourceTencentCloudTcrImages() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTcrImagesRead,
+		ReadContext: dataSourceTencentCloudTcrImagesRead,
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Required:    true,
@@ -115,17 +115,17 @@ func dataSourceTencentCloudTcrImages() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTcrImagesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTcrImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tcr_images.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		registryId    string
 		namespaceName string
 		repoName      string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("registry_id"); ok {
@@ -159,7 +159,7 @@ func dataSourceTencentCloudTcrImagesRead(d *schema.ResourceData, meta interface{
 
 	var imageInfoList []*tcr.TcrImageInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTcrImagesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -168,7 +168,7 @@ func dataSourceTencentCloudTcrImagesRead(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(imageInfoList))
@@ -202,7 +202,7 @@ func dataSourceTencentCloudTcrImagesRead(d *schema.ResourceData, meta interface{
 				tcrImageInfoMap["kms_signature"] = tcrImageInfo.KmsSignature
 			}
 
-			ids = append(ids, strings.Join([]string{registryId, namespaceName, repoName, *tcrImageInfo.ImageVersion}, FILED_SP))
+			ids = append(ids, helper.IdFormat(registryId, namespaceName, repoName, *tcrImageInfo.ImageVersion))
 			tmpList = append(tmpList, tcrImageInfoMap)
 		}
 
@@ -213,7 +213,7 @@ func dataSourceTencentCloudTcrImagesRead(d *schema.ResourceData, meta interface{
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
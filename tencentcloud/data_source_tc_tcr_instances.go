@@ -13,9 +13,9 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcr/v20190924"
@@ -24,7 +24,7 @@ import (
 
 func dataSourceTencentCloudTCRInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTCRInstancesRead,
+		ReadContext: dataSourceTencentCloudTCRInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -93,11 +93,11 @@ func dataSourceTencentCloudTCRInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTCRInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTCRInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tcr_instances.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var name, instanceId string
 	var filters = make([]*tcr.Filter, 0)
@@ -111,13 +111,13 @@ func dataSourceTencentCloudTCRInstancesRead(d *schema.ResourceData, meta interfa
 	}
 
 	if instanceId == "" && name == "" {
-		return fmt.Errorf("instance_id or name must be set at least one.")
+		return diag.Errorf("instance_id or name must be set at least one.")
 	}
 	tcrService := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var outErr, inErr error
 	instances, outErr := tcrService.DescribeTCRInstances(ctx, instanceId, filters)
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			instances, inErr = tcrService.DescribeTCRInstances(ctx, instanceId, filters)
 			if inErr != nil {
 				return retryError(inErr)
@@ -127,7 +127,7 @@ func dataSourceTencentCloudTCRInstancesRead(d *schema.ResourceData, meta interfa
 	}
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	ids := make([]string, 0, len(instances))
@@ -152,13 +152,13 @@ func dataSourceTencentCloudTCRInstancesRead(d *schema.ResourceData, meta interfa
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("instance_list", instanceList); e != nil {
 		log.Printf("[CRITAL]%s provider set TCR instance list fail, reason:%s\n", logId, e)
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), instanceList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
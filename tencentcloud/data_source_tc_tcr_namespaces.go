@@ -16,6 +16,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudTCRNamespaces() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTCRNamespacesRead,
+		ReadContext: dataSourceTencentCloudTCRNamespacesRead,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -70,11 +71,11 @@ func dataSourceTencentCloudTCRNamespaces() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTCRNamespacesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTCRNamespacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tcr_namespaces.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var name, instanceId string
 	instanceId = d.Get("instance_id").(string)
@@ -86,7 +87,7 @@ func dataSourceTencentCloudTCRNamespacesRead(d *schema.ResourceData, meta interf
 	var outErr, inErr error
 	namespaces, outErr := tcrService.DescribeTCRNameSpaces(ctx, instanceId, name)
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			namespaces, inErr = tcrService.DescribeTCRNameSpaces(ctx, instanceId, name)
 			if inErr != nil {
 				return retryError(inErr)
@@ -95,7 +96,7 @@ func dataSourceTencentCloudTCRNamespacesRead(d *schema.ResourceData, meta interf
 		})
 	}
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	ids := make([]string, 0, len(namespaces))
@@ -108,18 +109,18 @@ func dataSourceTencentCloudTCRNamespacesRead(d *schema.ResourceData, meta interf
 		}
 
 		namespaceList = append(namespaceList, mapping)
-		ids = append(ids, instanceId+FILED_SP+*namespace.Name)
+		ids = append(ids, helper.IdFormat(instanceId, *namespace.Name))
 	}
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("namespace_list", namespaceList); e != nil {
 		log.Printf("[CRITAL]%s provider set TCR namespace list fail, reason:%s\n", logId, e)
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), namespaceList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -21,6 +21,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcr/v20190924"
@@ -29,7 +30,7 @@ import (
 
 func dataSourceTencentCloudTcrReplicationInstanceCreateTasks() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTcrReplicationInstanceCreateTasksRead,
+		ReadContext: dataSourceTencentCloudTcrReplicationInstanceCreateTasksRead,
 		Schema: map[string]*schema.Schema{
 			"replication_registry_id": {
 				Required:    true,
@@ -98,13 +99,13 @@ func dataSourceTencentCloudTcrReplicationInstanceCreateTasks() *schema.Resource
 	}
 }
 
-func dataSourceTencentCloudTcrReplicationInstanceCreateTasksRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTcrReplicationInstanceCreateTasksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tcr_replication_instance_create_tasks.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("replication_registry_id"); ok {
@@ -122,7 +123,7 @@ func dataSourceTencentCloudTcrReplicationInstanceCreateTasksRead(d *schema.Resou
 		e      error
 	)
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e = service.DescribeTcrReplicationInstanceCreateTasksByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -130,7 +131,7 @@ func dataSourceTencentCloudTcrReplicationInstanceCreateTasksRead(d *schema.Resou
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	taskDetails := result.TaskDetail
@@ -181,7 +182,7 @@ func dataSourceTencentCloudTcrReplicationInstanceCreateTasksRead(d *schema.Resou
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), result); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
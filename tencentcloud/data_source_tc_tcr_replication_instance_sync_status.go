@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcr/v20190924"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudTcrReplicationInstanceSyncStatus() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTcrReplicationInstanceSyncStatusRead,
+		ReadContext: dataSourceTencentCloudTcrReplicationInstanceSyncStatusRead,
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Required:    true,
@@ -112,13 +113,13 @@ func dataSourceTencentCloudTcrReplicationInstanceSyncStatus() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTcrReplicationInstanceSyncStatusRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTcrReplicationInstanceSyncStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tcr_replication_instance_sync_status.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var replicationRegistryId string
 
 	paramMap := make(map[string]interface{})
@@ -145,7 +146,7 @@ func dataSourceTencentCloudTcrReplicationInstanceSyncStatusRead(d *schema.Resour
 		e      error
 	)
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e = service.DescribeTcrReplicationInstanceSyncStatusByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -153,7 +154,7 @@ func dataSourceTencentCloudTcrReplicationInstanceSyncStatusRead(d *schema.Resour
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if result.ReplicationStatus != nil {
@@ -197,7 +198,7 @@ func dataSourceTencentCloudTcrReplicationInstanceSyncStatusRead(d *schema.Resour
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), result); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -17,6 +17,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudTCRRepositories() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTCRRepositoriesRead,
+		ReadContext: dataSourceTencentCloudTCRRepositoriesRead,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -102,11 +103,11 @@ func dataSourceTencentCloudTCRRepositories() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTCRRepositoriesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTCRRepositoriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tcr_repositories.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var namespaceName, instanceId, repositoryName string
 	instanceId = d.Get("instance_id").(string)
@@ -121,7 +122,7 @@ func dataSourceTencentCloudTCRRepositoriesRead(d *schema.ResourceData, meta inte
 	var domain string
 	instance, has, outErr := tcrService.DescribeTCRInstanceById(ctx, instanceId)
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			instance, has, inErr = tcrService.DescribeTCRInstanceById(ctx, instanceId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -130,7 +131,7 @@ func dataSourceTencentCloudTCRRepositoriesRead(d *schema.ResourceData, meta inte
 		})
 	}
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	if has {
 		domain = *instance.PublicDomain
@@ -138,7 +139,7 @@ func dataSourceTencentCloudTCRRepositoriesRead(d *schema.ResourceData, meta inte
 
 	repositories, outErr := tcrService.DescribeTCRRepositories(ctx, instanceId, namespaceName, repositoryName)
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			repositories, inErr = tcrService.DescribeTCRRepositories(ctx, instanceId, namespaceName, repositoryName)
 			if inErr != nil {
 				return retryError(inErr)
@@ -147,7 +148,7 @@ func dataSourceTencentCloudTCRRepositoriesRead(d *schema.ResourceData, meta inte
 		})
 	}
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	ids := make([]string, 0, len(repositories))
@@ -166,18 +167,18 @@ func dataSourceTencentCloudTCRRepositoriesRead(d *schema.ResourceData, meta inte
 		}
 
 		repositoryList = append(repositoryList, mapping)
-		ids = append(ids, instanceId+FILED_SP+*repository.Namespace+FILED_SP+*repository.Name)
+		ids = append(ids, helper.IdFormat(instanceId, *repository.Namespace, *repository.Name))
 	}
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("repository_list", repositoryList); e != nil {
 		log.Printf("[CRITAL]%s provider set TCR repository list fail, reason:%s\n", logId, e)
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), repositoryList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
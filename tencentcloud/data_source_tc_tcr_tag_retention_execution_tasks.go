@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcr/v20190924"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudTcrTagRetentionExecutionTasks() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTcrTagRetentionExecutionTasksRead,
+		ReadContext: dataSourceTencentCloudTcrTagRetentionExecutionTasksRead,
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Required:    true,
@@ -103,7 +104,7 @@ func dataSourceTencentCloudTcrTagRetentionExecutionTasks() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTcrTagRetentionExecutionTasksRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTcrTagRetentionExecutionTasksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tcr_tag_retention_execution_tasks.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -115,7 +116,7 @@ func dataSourceTencentCloudTcrTagRetentionExecutionTasksRead(d *schema.ResourceD
 		executionId string
 	)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("registry_id"); ok {
@@ -137,7 +138,7 @@ func dataSourceTencentCloudTcrTagRetentionExecutionTasksRead(d *schema.ResourceD
 
 	var retentionTaskList []*tcr.RetentionTask
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTcrTagRetentionExecutionTasksByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -146,7 +147,7 @@ func dataSourceTencentCloudTcrTagRetentionExecutionTasksRead(d *schema.ResourceD
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(retentionTaskList))
@@ -197,7 +198,7 @@ func dataSourceTencentCloudTcrTagRetentionExecutionTasksRead(d *schema.ResourceD
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
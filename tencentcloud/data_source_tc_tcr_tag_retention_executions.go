@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcr/v20190924"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudTcrTagRetentionExecutions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTcrTagRetentionExecutionsRead,
+		ReadContext: dataSourceTencentCloudTcrTagRetentionExecutionsRead,
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Required:    true,
@@ -81,13 +82,13 @@ func dataSourceTencentCloudTcrTagRetentionExecutions() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTcrTagRetentionExecutionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTcrTagRetentionExecutionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tcr_tag_retention_executions.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("registry_id"); ok {
@@ -102,7 +103,7 @@ func dataSourceTencentCloudTcrTagRetentionExecutionsRead(d *schema.ResourceData,
 
 	var retentionExecutionList []*tcr.RetentionExecution
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTcrTagRetentionExecutionsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -111,7 +112,7 @@ func dataSourceTencentCloudTcrTagRetentionExecutionsRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(retentionExecutionList))
@@ -152,7 +153,7 @@ func dataSourceTencentCloudTcrTagRetentionExecutionsRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -15,6 +15,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudTCRTokens() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTCRTokensRead,
+		ReadContext: dataSourceTencentCloudTCRTokensRead,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -75,11 +76,11 @@ func dataSourceTencentCloudTCRTokens() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTCRTokensRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTCRTokensRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tcr_tokens.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var tokenId, instanceId string
 	instanceId = d.Get("instance_id").(string)
@@ -91,7 +92,7 @@ func dataSourceTencentCloudTCRTokensRead(d *schema.ResourceData, meta interface{
 	var outErr, inErr error
 	tokens, outErr := tcrService.DescribeTCRTokens(ctx, instanceId, tokenId)
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			tokens, inErr = tcrService.DescribeTCRTokens(ctx, instanceId, tokenId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -100,7 +101,7 @@ func dataSourceTencentCloudTCRTokensRead(d *schema.ResourceData, meta interface{
 		})
 	}
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	ids := make([]string, 0, len(tokens))
@@ -115,18 +116,18 @@ func dataSourceTencentCloudTCRTokensRead(d *schema.ResourceData, meta interface{
 		}
 
 		tokenList = append(tokenList, mapping)
-		ids = append(ids, instanceId+FILED_SP+*token.Id)
+		ids = append(ids, helper.IdFormat(instanceId, *token.Id))
 	}
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("token_list", tokenList); e != nil {
 		log.Printf("[CRITAL]%s provider set TCR token list fail, reason:%s\n", logId, e)
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tokenList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
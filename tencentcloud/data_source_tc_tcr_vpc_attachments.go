@@ -15,6 +15,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudTCRVPCAttachments() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTCRVPCAttachmentsRead,
+		ReadContext: dataSourceTencentCloudTCRVPCAttachmentsRead,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -89,11 +90,11 @@ func dataSourceTencentCloudTCRVPCAttachments() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTCRVPCAttachmentsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTCRVPCAttachmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tcr_vpc_attachments.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var vpcId, subnetId, instanceId string
 	instanceId = d.Get("instance_id").(string)
@@ -109,7 +110,7 @@ func dataSourceTencentCloudTCRVPCAttachmentsRead(d *schema.ResourceData, meta in
 	var outErr, inErr error
 	vpcAccesses, outErr := tcrService.DescribeTCRVPCAttachments(ctx, instanceId, vpcId, subnetId)
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			vpcAccesses, outErr = tcrService.DescribeTCRVPCAttachments(ctx, instanceId, vpcId, subnetId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -118,7 +119,7 @@ func dataSourceTencentCloudTCRVPCAttachmentsRead(d *schema.ResourceData, meta in
 		})
 	}
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	ids := make([]string, 0, len(vpcAccesses))
@@ -133,30 +134,30 @@ func dataSourceTencentCloudTCRVPCAttachmentsRead(d *schema.ResourceData, meta in
 		if *vpcAccess.AccessIp != "" {
 			publicDomainDnsStatus, err := GetDnsStatus(ctx, tcrService, instanceId, *vpcAccess.VpcId, *vpcAccess.AccessIp, true)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			mapping["enable_public_domain_dns"] = *publicDomainDnsStatus.Status == TCR_VPC_DNS_STATUS_ENABLED
 
 			vpcDomainDnsStatus, err := GetDnsStatus(ctx, tcrService, instanceId, *vpcAccess.VpcId, *vpcAccess.AccessIp, false)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			mapping["enable_vpc_domain_dns"] = *vpcDomainDnsStatus.Status == TCR_VPC_DNS_STATUS_ENABLED
 		}
 
 		vpcAccessList = append(vpcAccessList, mapping)
-		ids = append(ids, instanceId+FILED_SP+*vpcAccess.VpcId+FILED_SP+*vpcAccess.SubnetId)
+		ids = append(ids, helper.IdFormat(instanceId, *vpcAccess.VpcId, *vpcAccess.SubnetId))
 	}
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("vpc_attachment_list", vpcAccessList); e != nil {
 		log.Printf("[CRITAL]%s provider set TCR VPC access list fail, reason:%s\n", logId, e)
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), vpcAccessList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
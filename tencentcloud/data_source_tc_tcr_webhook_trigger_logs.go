@@ -19,6 +19,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcr/v20190924"
@@ -27,7 +28,7 @@ import (
 
 func dataSourceTencentCloudTcrWebhookTriggerLogs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTcrWebhookTriggerLogsRead,
+		ReadContext: dataSourceTencentCloudTcrWebhookTriggerLogsRead,
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Required:    true,
@@ -111,13 +112,13 @@ func dataSourceTencentCloudTcrWebhookTriggerLogs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTcrWebhookTriggerLogsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTcrWebhookTriggerLogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tcr_webhook_trigger_logs.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("registry_id"); ok {
@@ -136,7 +137,7 @@ func dataSourceTencentCloudTcrWebhookTriggerLogsRead(d *schema.ResourceData, met
 
 	var logs []*tcr.WebhookTriggerLog
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTcrWebhookTriggerLogByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -145,7 +146,7 @@ func dataSourceTencentCloudTcrWebhookTriggerLogsRead(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(logs))
@@ -198,7 +199,7 @@ func dataSourceTencentCloudTcrWebhookTriggerLogsRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -21,6 +21,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdcpg "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdcpg/v20211118"
@@ -29,7 +30,7 @@ import (
 
 func dataSourceTencentCloudTdcpgClusters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTdcpgClustersRead,
+		ReadContext: dataSourceTencentCloudTdcpgClustersRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -239,12 +240,12 @@ func dataSourceTencentCloudTdcpgClusters() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTdcpgClustersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTdcpgClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tdcpg_clusters.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cluster_id"); ok {
@@ -270,7 +271,7 @@ func dataSourceTencentCloudTdcpgClustersRead(d *schema.ResourceData, meta interf
 	tdcpgService := TdcpgService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var clusterSet []*tdcpg.Cluster
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := tdcpgService.DescribeTdcpgClustersByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -280,7 +281,7 @@ func dataSourceTencentCloudTdcpgClustersRead(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITICAL]%s read Tdcpg clusterSet failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(clusterSet))
@@ -394,14 +395,14 @@ func dataSourceTencentCloudTdcpgClustersRead(d *schema.ResourceData, meta interf
 		err := d.Set("list", clusterList)
 		if err != nil {
 			log.Printf("[CRITICAL]%s set tdcpg clusterList failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), clusterList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
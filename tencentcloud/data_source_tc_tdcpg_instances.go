@@ -21,6 +21,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdcpg "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdcpg/v20211118"
@@ -29,7 +30,7 @@ import (
 
 func dataSourceTencentCloudTdcpgInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTdcpgInstancesRead,
+		ReadContext: dataSourceTencentCloudTdcpgInstancesRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -165,15 +166,15 @@ func dataSourceTencentCloudTdcpgInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTdcpgInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTdcpgInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tdcpg_instances.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		clusterId *string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cluster_id"); ok {
@@ -199,7 +200,7 @@ func dataSourceTencentCloudTdcpgInstancesRead(d *schema.ResourceData, meta inter
 	tdcpgService := TdcpgService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var instanceSet []*tdcpg.Instance
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := tdcpgService.DescribeTdcpgInstancesByFilter(ctx, clusterId, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -209,7 +210,7 @@ func dataSourceTencentCloudTdcpgInstancesRead(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITICAL]%s read Tdcpg instanceSet failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(instanceSet))
@@ -268,21 +269,21 @@ func dataSourceTencentCloudTdcpgInstancesRead(d *schema.ResourceData, meta inter
 			if instance.DBKernelVersion != nil {
 				instanceSetMap["db_kernel_version"] = instance.DBKernelVersion
 			}
-			ids = append(ids, *instance.ClusterId+FILED_SP+*instance.InstanceId)
+			ids = append(ids, helper.IdFormat(*instance.ClusterId, *instance.InstanceId))
 			instanceList = append(instanceList, instanceSetMap)
 		}
 		d.SetId(helper.DataResourceIdsHash(ids))
 		err := d.Set("list", instanceList)
 		if err != nil {
 			log.Printf("[CRITICAL]%s set tdcpg instanceList failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), instanceList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -30,6 +30,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -38,7 +39,7 @@ import (
 
 func dataSourceTencentCloudTdmqEnvironmentAttributes() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTdmqEnvironmentAttributesRead,
+		ReadContext: dataSourceTencentCloudTdmqEnvironmentAttributesRead,
 		Schema: map[string]*schema.Schema{
 			"environment_id": {
 				Required:    true,
@@ -95,18 +96,18 @@ func dataSourceTencentCloudTdmqEnvironmentAttributes() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTdmqEnvironmentAttributesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTdmqEnvironmentAttributesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tdmq_environment_attributes.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		service       = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 		tdmqEnv       *tdmq.DescribeEnvironmentAttributesResponseParams
 		environmentId string
 		clusterId     string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("environment_id"); ok {
@@ -119,7 +120,7 @@ func dataSourceTencentCloudTdmqEnvironmentAttributesRead(d *schema.ResourceData,
 		clusterId = v.(string)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTdmqEnvironmentAttributesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -130,7 +131,7 @@ func dataSourceTencentCloudTdmqEnvironmentAttributesRead(d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0)
@@ -172,7 +173,7 @@ func dataSourceTencentCloudTdmqEnvironmentAttributesRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudTdmqProInstanceDetail() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTdmqProInstanceDetailRead,
+		ReadContext: dataSourceTencentCloudTdmqProInstanceDetailRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -184,17 +185,17 @@ func dataSourceTencentCloudTdmqProInstanceDetail() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTdmqProInstanceDetailRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTdmqProInstanceDetailRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tdmq_pro_instance_detail.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId       = getLogId(contextNil)
-		ctx         = context.WithValue(context.TODO(), logIdKey, logId)
 		service     = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 		clusterInfo *tdmq.DescribePulsarProInstanceDetailResponseParams
 		clusterId   string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cluster_id"); ok {
@@ -202,7 +203,7 @@ func dataSourceTencentCloudTdmqProInstanceDetailRead(d *schema.ResourceData, met
 		clusterId = v.(string)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTdmqProInstanceDetailByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -213,7 +214,7 @@ func dataSourceTencentCloudTdmqProInstanceDetailRead(d *schema.ResourceData, met
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if clusterInfo != nil {
@@ -346,7 +347,7 @@ func dataSourceTencentCloudTdmqProInstanceDetailRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
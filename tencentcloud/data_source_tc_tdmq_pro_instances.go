@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudTdmqProInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTdmqProInstancesRead,
+		ReadContext: dataSourceTencentCloudTdmqProInstancesRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
 				Optional:    true,
@@ -147,16 +148,16 @@ func dataSourceTencentCloudTdmqProInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTdmqProInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTdmqProInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tdmq_pro_instances.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instances []*tdmq.PulsarProInstance
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("filters"); ok {
@@ -179,7 +180,7 @@ func dataSourceTencentCloudTdmqProInstancesRead(d *schema.ResourceData, meta int
 		paramMap["filters"] = tmpSet
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTdmqProInstancesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -190,7 +191,7 @@ func dataSourceTencentCloudTdmqProInstancesRead(d *schema.ResourceData, meta int
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(instances))
@@ -275,7 +276,7 @@ func dataSourceTencentCloudTdmqProInstancesRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudTdmqPublisherSummary() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTdmqPublisherSummaryRead,
+		ReadContext: dataSourceTencentCloudTdmqPublisherSummaryRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -71,19 +72,19 @@ func dataSourceTencentCloudTdmqPublisherSummary() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTdmqPublisherSummaryRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTdmqPublisherSummaryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tdmq_publisher_summary.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId            = getLogId(contextNil)
-		ctx              = context.WithValue(context.TODO(), logIdKey, logId)
 		service          = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 		publisherSummary *tdmq.DescribePublisherSummaryResponseParams
 		clusterId        string
 		Namespace        string
 		Topic            string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cluster_id"); ok {
@@ -101,7 +102,7 @@ func dataSourceTencentCloudTdmqPublisherSummaryRead(d *schema.ResourceData, meta
 		Topic = v.(string)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTdmqPublisherSummaryByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -112,7 +113,7 @@ func dataSourceTencentCloudTdmqPublisherSummaryRead(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0)
@@ -140,7 +141,7 @@ func dataSourceTencentCloudTdmqPublisherSummaryRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
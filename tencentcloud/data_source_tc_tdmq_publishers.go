@@ -24,6 +24,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -32,7 +33,7 @@ import (
 
 func dataSourceTencentCloudTdmqPublishers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTdmqPublishersRead,
+		ReadContext: dataSourceTencentCloudTdmqPublishersRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -153,19 +154,19 @@ func dataSourceTencentCloudTdmqPublishers() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTdmqPublishersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTdmqPublishersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tdmq_publishers.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 		publishers []*tdmq.Publisher
 		clusterId  string
 		Namespace  string
 		Topic      string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cluster_id"); ok {
@@ -213,7 +214,7 @@ func dataSourceTencentCloudTdmqPublishersRead(d *schema.ResourceData, meta inter
 		paramMap["sort"] = &sort
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTdmqPublishersByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -224,7 +225,7 @@ func dataSourceTencentCloudTdmqPublishersRead(d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0)
@@ -283,7 +284,7 @@ func dataSourceTencentCloudTdmqPublishersRead(d *schema.ResourceData, meta inter
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
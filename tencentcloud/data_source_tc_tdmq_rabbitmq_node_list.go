@@ -21,6 +21,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -29,7 +30,7 @@ import (
 
 func dataSourceTencentCloudTdmqRabbitmqNodeList() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTdmqRabbitmqNodeListRead,
+		ReadContext: dataSourceTencentCloudTdmqRabbitmqNodeListRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -120,18 +121,18 @@ func dataSourceTencentCloudTdmqRabbitmqNodeList() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTdmqRabbitmqNodeListRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTdmqRabbitmqNodeListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tdmq_rabbitmq_node_list.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 		nodeList   []*tdmq.RabbitMQPrivateNode
 		instanceId string
 		nodeName   string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -172,7 +173,7 @@ func dataSourceTencentCloudTdmqRabbitmqNodeListRead(d *schema.ResourceData, meta
 		paramMap["SortOrder"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTdmqRabbitmqNodeListByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -183,7 +184,7 @@ func dataSourceTencentCloudTdmqRabbitmqNodeListRead(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0)
@@ -228,7 +229,7 @@ func dataSourceTencentCloudTdmqRabbitmqNodeListRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
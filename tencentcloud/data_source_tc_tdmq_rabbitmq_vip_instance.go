@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudTdmqRabbitmqVipInstance() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTdmqRabbitmqVipInstanceRead,
+		ReadContext: dataSourceTencentCloudTdmqRabbitmqVipInstanceRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
 				Optional:    true,
@@ -141,16 +142,16 @@ func dataSourceTencentCloudTdmqRabbitmqVipInstance() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTdmqRabbitmqVipInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTdmqRabbitmqVipInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tdmq_rabbitmq_vip_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instances []*tdmq.RabbitMQVipInstance
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("filters"); ok {
@@ -173,7 +174,7 @@ func dataSourceTencentCloudTdmqRabbitmqVipInstanceRead(d *schema.ResourceData, m
 		paramMap["filters"] = tmpSet
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTdmqRabbitmqVipInstanceByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -184,7 +185,7 @@ func dataSourceTencentCloudTdmqRabbitmqVipInstanceRead(d *schema.ResourceData, m
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(instances))
@@ -265,7 +266,7 @@ func dataSourceTencentCloudTdmqRabbitmqVipInstanceRead(d *schema.ResourceData, m
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -20,6 +20,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	rocketmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -28,7 +29,7 @@ import (
 
 func dataSourceTencentCloudTdmqRocketmqCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudRocketmqClusterRead,
+		ReadContext: dataSourceTencentCloudRocketmqClusterRead,
 		Schema: map[string]*schema.Schema{
 			"id_keyword": {
 				Type:        schema.TypeString,
@@ -207,12 +208,12 @@ func dataSourceTencentCloudTdmqRocketmqCluster() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudRocketmqClusterRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudRocketmqClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_rocketmq_cluster.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("id_keyword"); ok {
@@ -236,7 +237,7 @@ func dataSourceTencentCloudRocketmqClusterRead(d *schema.ResourceData, meta inte
 	service := TdmqRocketmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var clusterList []*rocketmq.RocketMQClusterDetail
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := service.DescribeRocketmqClusterByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -246,7 +247,7 @@ func dataSourceTencentCloudRocketmqClusterRead(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Rocketmq clusterList failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	clusterListList := []interface{}{}
 	ids := make([]string, 0)
@@ -346,7 +347,7 @@ func dataSourceTencentCloudRocketmqClusterRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), clusterListList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
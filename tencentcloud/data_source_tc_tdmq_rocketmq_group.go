@@ -37,6 +37,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmqRocketmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -45,7 +46,7 @@ import (
 
 func dataSourceTencentCloudTdmqRocketmqGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTdmqRocketmqGroupRead,
+		ReadContext: dataSourceTencentCloudTdmqRocketmqGroupRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -161,12 +162,12 @@ func dataSourceTencentCloudTdmqRocketmqGroup() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTdmqRocketmqGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTdmqRocketmqGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tdmqRocketmq_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cluster_id"); ok {
@@ -192,7 +193,7 @@ func dataSourceTencentCloudTdmqRocketmqGroupRead(d *schema.ResourceData, meta in
 	tdmqRocketmqService := TdmqRocketmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var groups []*tdmqRocketmq.RocketMQGroup
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := tdmqRocketmqService.DescribeTdmqRocketmqGroupByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -202,7 +203,7 @@ func dataSourceTencentCloudTdmqRocketmqGroupRead(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read TdmqRocketmq groups failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	groupList := []interface{}{}
@@ -257,7 +258,7 @@ func dataSourceTencentCloudTdmqRocketmqGroupRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), groupList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -18,6 +18,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudTdmqRocketmqMessages() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTdmqMessageRead,
+		ReadContext: dataSourceTencentCloudTdmqMessageRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -116,17 +117,17 @@ func dataSourceTencentCloudTdmqRocketmqMessages() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTdmqMessageRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTdmqMessageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tdmq_rocketmq_messages.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 		msgDetail *tdmq.DescribeRocketMQMsgResponseParams
 		clusterId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cluster_id"); ok {
@@ -150,7 +151,7 @@ func dataSourceTencentCloudTdmqMessageRead(d *schema.ResourceData, meta interfac
 		paramMap["QueryDlqMsg"] = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTdmqMessageByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -161,7 +162,7 @@ func dataSourceTencentCloudTdmqMessageRead(d *schema.ResourceData, meta interfac
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if msgDetail.Body != nil {
@@ -215,7 +216,7 @@ func dataSourceTencentCloudTdmqMessageRead(d *schema.ResourceData, meta interfac
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
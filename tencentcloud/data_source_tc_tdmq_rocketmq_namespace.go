@@ -27,6 +27,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmqRocketmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -35,7 +36,7 @@ import (
 
 func dataSourceTencentCloudTdmqRocketmqNamespace() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTdmqRocketmqNamespaceRead,
+		ReadContext: dataSourceTencentCloudTdmqRocketmqNamespaceRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -98,12 +99,12 @@ func dataSourceTencentCloudTdmqRocketmqNamespace() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTdmqRocketmqNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTdmqRocketmqNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tdmqRocketmq_namespace.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cluster_id"); ok {
@@ -117,7 +118,7 @@ func dataSourceTencentCloudTdmqRocketmqNamespaceRead(d *schema.ResourceData, met
 	tdmqRocketmqService := TdmqRocketmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 	ids := make([]string, 0)
 	var namespaces []*tdmqRocketmq.RocketMQNamespace
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := tdmqRocketmqService.DescribeTdmqRocketmqNamespaceByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -127,7 +128,7 @@ func dataSourceTencentCloudTdmqRocketmqNamespaceRead(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read TdmqRocketmq namespaces failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespaceList := []interface{}{}
@@ -160,7 +161,7 @@ func dataSourceTencentCloudTdmqRocketmqNamespaceRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), namespaceList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
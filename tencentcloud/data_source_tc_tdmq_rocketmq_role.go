@@ -27,6 +27,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmqRocketmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -35,7 +36,7 @@ import (
 
 func dataSourceTencentCloudTdmqRocketmqRole() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTdmqRocketmqRoleRead,
+		ReadContext: dataSourceTencentCloudTdmqRocketmqRoleRead,
 		Schema: map[string]*schema.Schema{
 			"role_name": {
 				Type:        schema.TypeString,
@@ -93,12 +94,12 @@ func dataSourceTencentCloudTdmqRocketmqRole() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTdmqRocketmqRoleRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTdmqRocketmqRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tdmqRocketmq_role.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("role_name"); ok {
@@ -112,7 +113,7 @@ func dataSourceTencentCloudTdmqRocketmqRoleRead(d *schema.ResourceData, meta int
 	tdmqRocketmqService := TdmqRocketmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var roleSets []*tdmqRocketmq.Role
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := tdmqRocketmqService.DescribeTdmqRocketmqRoleByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -122,7 +123,7 @@ func dataSourceTencentCloudTdmqRocketmqRoleRead(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read TdmqRocketmq roleSets failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	roleSetList := []interface{}{}
@@ -152,7 +153,7 @@ func dataSourceTencentCloudTdmqRocketmqRoleRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), roleSetList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
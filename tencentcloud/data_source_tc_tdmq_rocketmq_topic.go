@@ -36,6 +36,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmqRocketmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -44,7 +45,7 @@ import (
 
 func dataSourceTencentCloudTdmqRocketmqTopic() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTdmqRocketmqTopicRead,
+		ReadContext: dataSourceTencentCloudTdmqRocketmqTopicRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -117,12 +118,12 @@ func dataSourceTencentCloudTdmqRocketmqTopic() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTdmqRocketmqTopicRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTdmqRocketmqTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tdmqRocketmq_topic.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cluster_id"); ok {
@@ -150,7 +151,7 @@ func dataSourceTencentCloudTdmqRocketmqTopicRead(d *schema.ResourceData, meta in
 	tdmqRocketmqService := TdmqRocketmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var topics []*tdmqRocketmq.RocketMQTopic
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := tdmqRocketmqService.DescribeTdmqRocketmqTopicByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -160,7 +161,7 @@ func dataSourceTencentCloudTdmqRocketmqTopicRead(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read TdmqRocketmq topics failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0)
@@ -190,7 +191,7 @@ func dataSourceTencentCloudTdmqRocketmqTopicRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), topicList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudTdmqVipInstance() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTdmqVipInstanceRead,
+		ReadContext: dataSourceTencentCloudTdmqVipInstanceRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -239,23 +240,23 @@ func dataSourceTencentCloudTdmqVipInstance() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTdmqVipInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTdmqVipInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tdmq_vip_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId       = getLogId(contextNil)
-		ctx         = context.WithValue(context.TODO(), logIdKey, logId)
 		service     = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 		vipInstance *tdmq.DescribeRocketMQVipInstanceDetailResponseParams
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cluster_id"); ok {
 		paramMap["ClusterId"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTdmqVipInstanceByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -270,7 +271,7 @@ func dataSourceTencentCloudTdmqVipInstanceRead(d *schema.ResourceData, meta inte
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0)
@@ -448,7 +449,7 @@ func dataSourceTencentCloudTdmqVipInstanceRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
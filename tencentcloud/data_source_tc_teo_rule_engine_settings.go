@@ -14,6 +14,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	teo "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/teo/v20220901"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudTeoRuleEngineSettings() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTeoRuleEngineSettingsRead,
+		ReadContext: dataSourceTencentCloudTeoRuleEngineSettingsRead,
 		Schema: map[string]*schema.Schema{
 			"actions": {
 				Type:        schema.TypeList,
@@ -195,17 +196,17 @@ func dataSourceTencentCloudTeoRuleEngineSettings() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTeoRuleEngineSettingsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTeoRuleEngineSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_teo_rule_engine_settings.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	teoService := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var rules []*teo.RulesSettingAction
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := teoService.DescribeTeoRuleEngineSettingsByFilter(ctx)
 		if e != nil {
 			return retryError(e)
@@ -215,7 +216,7 @@ func dataSourceTencentCloudTeoRuleEngineSettingsRead(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Teo rules failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleList := []interface{}{}
@@ -324,7 +325,7 @@ func dataSourceTencentCloudTeoRuleEngineSettingsRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), ruleList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	teo "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/teo/v20220901"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudTeoZoneAvailablePlans() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTeoZoneAvailablePlansRead,
+		ReadContext: dataSourceTencentCloudTeoZoneAvailablePlansRead,
 
 		Schema: map[string]*schema.Schema{
 			"plan_info_list": {
@@ -83,17 +84,17 @@ func dataSourceTencentCloudTeoZoneAvailablePlans() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTeoZoneAvailablePlansRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTeoZoneAvailablePlansRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_teo_zone_available_plans.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	teoService := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var planInfos []*teo.PlanInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := teoService.DescribeTeoZoneAvailablePlansByFilter(ctx)
 		if e != nil {
 			return retryError(e)
@@ -103,7 +104,7 @@ func dataSourceTencentCloudTeoZoneAvailablePlansRead(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Teo planInfo failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	planInfoList := []interface{}{}
@@ -145,7 +146,7 @@ func dataSourceTencentCloudTeoZoneAvailablePlansRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), planInfoList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
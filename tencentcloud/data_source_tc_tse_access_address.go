@@ -18,6 +18,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudTseAccessAddress() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTseAccessAddressRead,
+		ReadContext: dataSourceTencentCloudTseAccessAddressRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -158,13 +159,13 @@ func dataSourceTencentCloudTseAccessAddress() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTseAccessAddressRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTseAccessAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tse_access_address.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -189,7 +190,7 @@ func dataSourceTencentCloudTseAccessAddressRead(d *schema.ResourceData, meta int
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var accessAddress *tse.DescribeSREInstanceAccessAddressResponseParams
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTseAccessAddressByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -198,7 +199,7 @@ func dataSourceTencentCloudTseAccessAddressRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := []string{""}
@@ -281,7 +282,7 @@ func dataSourceTencentCloudTseAccessAddressRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudTseGatewayCanaryRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTseGatewayCanaryRulesRead,
+		ReadContext: dataSourceTencentCloudTseGatewayCanaryRulesRead,
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
 				Required:    true,
@@ -163,12 +164,12 @@ func dataSourceTencentCloudTseGatewayCanaryRules() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTseGatewayCanaryRulesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTseGatewayCanaryRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tse_gateway_canary_rules.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var gatewayId string
 	paramMap := make(map[string]interface{})
@@ -185,7 +186,7 @@ func dataSourceTencentCloudTseGatewayCanaryRulesRead(d *schema.ResourceData, met
 
 	var result *tse.CloudAPIGatewayCanaryRuleList
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeTseGatewayCanaryRulesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -194,7 +195,7 @@ func dataSourceTencentCloudTseGatewayCanaryRulesRead(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	cloudAPIGatewayCanaryRuleListMap := map[string]interface{}{}
@@ -303,7 +304,7 @@ func dataSourceTencentCloudTseGatewayCanaryRulesRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), cloudAPIGatewayCanaryRuleListMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -19,6 +19,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -27,7 +28,7 @@ import (
 
 func dataSourceTencentCloudTseGatewayCertificates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTseGatewayCertificatesRead,
+		ReadContext: dataSourceTencentCloudTseGatewayCertificatesRead,
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
 				Required:    true,
@@ -146,13 +147,13 @@ func dataSourceTencentCloudTseGatewayCertificates() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTseGatewayCertificatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTseGatewayCertificatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tse_gateway_certificates.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("gateway_id"); ok {
@@ -181,7 +182,7 @@ func dataSourceTencentCloudTseGatewayCertificatesRead(d *schema.ResourceData, me
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var result *tse.KongCertificatesList
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeTseGatewayCertificatesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -190,7 +191,7 @@ func dataSourceTencentCloudTseGatewayCertificatesRead(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var ids []string
@@ -264,7 +265,7 @@ func dataSourceTencentCloudTseGatewayCertificatesRead(d *schema.ResourceData, me
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), kongCertificatesListMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
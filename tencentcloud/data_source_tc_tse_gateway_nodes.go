@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudTseGatewayNodes() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTseGatewayNodesRead,
+		ReadContext: dataSourceTencentCloudTseGatewayNodesRead,
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
 				Required:    true,
@@ -91,13 +92,13 @@ func dataSourceTencentCloudTseGatewayNodes() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTseGatewayNodesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTseGatewayNodesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tse_gateway_nodes.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("gateway_id"); ok {
@@ -111,7 +112,7 @@ func dataSourceTencentCloudTseGatewayNodesRead(d *schema.ResourceData, meta inte
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var result []*tse.CloudNativeAPIGatewayNode
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeTseGatewayNodesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -120,7 +121,7 @@ func dataSourceTencentCloudTseGatewayNodesRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(result))
@@ -168,7 +169,7 @@ func dataSourceTencentCloudTseGatewayNodesRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), nodeListList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudTseGatewayRoutes() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTseGatewayRoutesRead,
+		ReadContext: dataSourceTencentCloudTseGatewayRoutesRead,
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
 				Required:    true,
@@ -181,13 +182,13 @@ func dataSourceTencentCloudTseGatewayRoutes() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTseGatewayRoutesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTseGatewayRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tse_gateway_routes.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("gateway_id"); ok {
@@ -205,7 +206,7 @@ func dataSourceTencentCloudTseGatewayRoutesRead(d *schema.ResourceData, meta int
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var result *tse.KongServiceRouteList
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeTseGatewayRoutesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -214,7 +215,7 @@ func dataSourceTencentCloudTseGatewayRoutesRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(result.RouteList))
@@ -319,7 +320,7 @@ func dataSourceTencentCloudTseGatewayRoutesRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), kongServiceRouteListMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
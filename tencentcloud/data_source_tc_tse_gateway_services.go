@@ -18,6 +18,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudTseGatewayServices() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTseGatewayServicesRead,
+		ReadContext: dataSourceTencentCloudTseGatewayServicesRead,
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
 				Required:    true,
@@ -258,13 +259,13 @@ func dataSourceTencentCloudTseGatewayServices() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTseGatewayServicesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTseGatewayServicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tse_gateway_services.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("gateway_id"); ok {
@@ -293,7 +294,7 @@ func dataSourceTencentCloudTseGatewayServicesRead(d *schema.ResourceData, meta i
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var result *tse.KongServices
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeTseGatewayServicesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -302,7 +303,7 @@ func dataSourceTencentCloudTseGatewayServicesRead(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(result.ServiceList))
@@ -468,7 +469,7 @@ func dataSourceTencentCloudTseGatewayServicesRead(d *schema.ResourceData, meta i
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), kongServicesMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
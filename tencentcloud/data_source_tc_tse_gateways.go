@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudTseGateways() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTseGatewaysRead,
+		ReadContext: dataSourceTencentCloudTseGatewaysRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
 				Optional:    true,
@@ -267,12 +268,12 @@ func dataSourceTencentCloudTseGateways() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTseGatewaysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTseGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tse_gateways.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("filters"); ok {
@@ -298,7 +299,7 @@ func dataSourceTencentCloudTseGatewaysRead(d *schema.ResourceData, meta interfac
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var result *tse.ListCloudNativeAPIGatewayResult
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeTseGatewaysByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -307,7 +308,7 @@ func dataSourceTencentCloudTseGatewaysRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(result.GatewayList))
@@ -481,7 +482,7 @@ func dataSourceTencentCloudTseGatewaysRead(d *schema.ResourceData, meta interfac
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), listCloudNativeAPIGatewayResultMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
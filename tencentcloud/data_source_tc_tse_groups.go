@@ -18,6 +18,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudTseGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTseGroupsRead,
+		ReadContext: dataSourceTencentCloudTseGroupsRead,
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
 				Required:    true,
@@ -329,13 +330,13 @@ func dataSourceTencentCloudTseGroups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTseGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTseGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tse_groups.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("gateway_id"); ok {
@@ -365,7 +366,7 @@ func dataSourceTencentCloudTseGroupsRead(d *schema.ResourceData, meta interface{
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var result *tse.NativeGatewayServerGroups
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeTseGroupsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -374,7 +375,7 @@ func dataSourceTencentCloudTseGroupsRead(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(result.GatewayGroupList))
@@ -597,7 +598,7 @@ func dataSourceTencentCloudTseGroupsRead(d *schema.ResourceData, meta interface{
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), nativeGatewayServerGroupsMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
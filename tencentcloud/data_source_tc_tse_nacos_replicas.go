@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudTseNacosReplicas() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTseNacosReplicasRead,
+		ReadContext: dataSourceTencentCloudTseNacosReplicasRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -84,13 +85,13 @@ func dataSourceTencentCloudTseNacosReplicas() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTseNacosReplicasRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTseNacosReplicasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tse_nacos_replicas.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	instanceId := ""
 
 	paramMap := make(map[string]interface{})
@@ -103,7 +104,7 @@ func dataSourceTencentCloudTseNacosReplicasRead(d *schema.ResourceData, meta int
 
 	var replicas []*tse.NacosReplica
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTseNacosReplicasByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -112,7 +113,7 @@ func dataSourceTencentCloudTseNacosReplicasRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(replicas))
@@ -159,7 +160,7 @@ func dataSourceTencentCloudTseNacosReplicasRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudTseNacosServerInterfaces() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTseNacosServerInterfacesRead,
+		ReadContext: dataSourceTencentCloudTseNacosServerInterfacesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Optional:    true,
@@ -53,12 +54,12 @@ func dataSourceTencentCloudTseNacosServerInterfaces() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTseNacosServerInterfacesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTseNacosServerInterfacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tse_nacos_server_interfaces.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var instanceId string
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -67,7 +68,7 @@ func dataSourceTencentCloudTseNacosServerInterfacesRead(d *schema.ResourceData,
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var content []*tse.NacosServerInterface
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTseNacosServerInterfacesByFilter(ctx, instanceId)
 		if e != nil {
 			return retryError(e)
@@ -76,7 +77,7 @@ func dataSourceTencentCloudTseNacosServerInterfacesRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(content))
@@ -99,7 +100,7 @@ func dataSourceTencentCloudTseNacosServerInterfacesRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudTseZookeeperReplicas() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTseZookeeperReplicasRead,
+		ReadContext: dataSourceTencentCloudTseZookeeperReplicasRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -89,13 +90,13 @@ func dataSourceTencentCloudTseZookeeperReplicas() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTseZookeeperReplicasRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTseZookeeperReplicasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tse_zookeeper_replicas.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	instanceId := ""
 
 	paramMap := make(map[string]interface{})
@@ -108,7 +109,7 @@ func dataSourceTencentCloudTseZookeeperReplicasRead(d *schema.ResourceData, meta
 
 	var replicas []*tse.ZookeeperReplica
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTseZookeeperReplicasByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -117,7 +118,7 @@ func dataSourceTencentCloudTseZookeeperReplicasRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(replicas))
@@ -168,7 +169,7 @@ func dataSourceTencentCloudTseZookeeperReplicasRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudTseZookeeperServerInterfaces() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTseZookeeperServerInterfacesRead,
+		ReadContext: dataSourceTencentCloudTseZookeeperServerInterfacesRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -54,13 +55,13 @@ func dataSourceTencentCloudTseZookeeperServerInterfaces() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTseZookeeperServerInterfacesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTseZookeeperServerInterfacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tse_zookeeper_server_interfaces.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	instanceId := ""
 
 	paramMap := make(map[string]interface{})
@@ -73,7 +74,7 @@ func dataSourceTencentCloudTseZookeeperServerInterfacesRead(d *schema.ResourceDa
 
 	var content []*tse.ZookeeperServerInterface
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTseZookeeperServerInterfacesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -82,7 +83,7 @@ func dataSourceTencentCloudTseZookeeperServerInterfacesRead(d *schema.ResourceDa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(content))
@@ -105,7 +106,7 @@ func dataSourceTencentCloudTseZookeeperServerInterfacesRead(d *schema.ResourceDa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
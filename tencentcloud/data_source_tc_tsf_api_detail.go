@@ -17,8 +17,8 @@ package tencentcloud
 
 import (
 	"context"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -27,7 +27,7 @@ import (
 
 func dataSourceTencentCloudTsfApiDetail() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfApiDetailRead,
+		ReadContext: dataSourceTencentCloudTsfApiDetailRead,
 		Schema: map[string]*schema.Schema{
 			"microservice_id": {
 				Required:    true,
@@ -199,13 +199,13 @@ func dataSourceTencentCloudTsfApiDetail() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfApiDetailRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfApiDetailRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_api_detail.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var (
 		microserviceId string
 		path           string
@@ -243,7 +243,7 @@ func dataSourceTencentCloudTsfApiDetailRead(d *schema.ResourceData, meta interfa
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var apiDetail *tsf.ApiDetailResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTsfApiDetailByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -252,7 +252,7 @@ func dataSourceTencentCloudTsfApiDetailRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	apiDetailResponseMap := map[string]interface{}{}
@@ -372,11 +372,11 @@ func dataSourceTencentCloudTsfApiDetailRead(d *schema.ResourceData, meta interfa
 		_ = d.Set("result", []interface{}{apiDetailResponseMap})
 	}
 
-	d.SetId(strings.Join([]string{microserviceId, path, method, pkgVersion, applicationId}, FILED_SP))
+	d.SetId(helper.IdFormat(microserviceId, path, method, pkgVersion, applicationId))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), apiDetailResponseMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -20,6 +20,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -28,7 +29,7 @@ import (
 
 func dataSourceTencentCloudTsfApiGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfApiGroupRead,
+		ReadContext: dataSourceTencentCloudTsfApiGroupRead,
 		Schema: map[string]*schema.Schema{
 			"search_word": {
 				Optional:    true,
@@ -234,13 +235,13 @@ func dataSourceTencentCloudTsfApiGroup() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfApiGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfApiGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_api_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("search_word"); ok {
@@ -274,7 +275,7 @@ func dataSourceTencentCloudTsfApiGroupRead(d *schema.ResourceData, meta interfac
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var apiGroupInfo *tsf.TsfPageApiGroupInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTsfApiGroupByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -283,7 +284,7 @@ func dataSourceTencentCloudTsfApiGroupRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(apiGroupInfo.Content))
@@ -420,7 +421,7 @@ func dataSourceTencentCloudTsfApiGroupRead(d *schema.ResourceData, meta interfac
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tsfPageApiGroupInfoMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
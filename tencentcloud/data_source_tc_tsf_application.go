@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudTsfApplication() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfApplicationRead,
+		ReadContext: dataSourceTencentCloudTsfApplicationRead,
 		Schema: map[string]*schema.Schema{
 			"application_type": {
 				Optional:    true,
@@ -202,13 +203,13 @@ func dataSourceTencentCloudTsfApplication() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfApplicationRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_application.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("application_type"); ok {
@@ -232,7 +233,7 @@ func dataSourceTencentCloudTsfApplicationRead(d *schema.ResourceData, meta inter
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var application *tsf.TsfPageApplication
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTsfApplicationByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -241,7 +242,7 @@ func dataSourceTencentCloudTsfApplicationRead(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(application.Content))
@@ -366,7 +367,7 @@ func dataSourceTencentCloudTsfApplicationRead(d *schema.ResourceData, meta inter
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tsfPageApplicationMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
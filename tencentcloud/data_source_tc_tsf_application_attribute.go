@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudTsfApplicationAttribute() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfApplicationAttributeRead,
+		ReadContext: dataSourceTencentCloudTsfApplicationAttributeRead,
 		Schema: map[string]*schema.Schema{
 			"application_id": {
 				Required:    true,
@@ -64,12 +65,12 @@ func dataSourceTencentCloudTsfApplicationAttribute() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfApplicationAttributeRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfApplicationAttributeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_application_attribute.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	ids := ""
 
 	paramMap := make(map[string]interface{})
@@ -82,7 +83,7 @@ func dataSourceTencentCloudTsfApplicationAttributeRead(d *schema.ResourceData, m
 
 	var attribute *tsf.ApplicationAttribute
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTsfApplicationAttributeByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -91,7 +92,7 @@ func dataSourceTencentCloudTsfApplicationAttributeRead(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	applicationAttributeMap := map[string]interface{}{}
@@ -115,7 +116,7 @@ func dataSourceTencentCloudTsfApplicationAttributeRead(d *schema.ResourceData, m
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), applicationAttributeMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -18,6 +18,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudTsfApplicationConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfApplicationConfigRead,
+		ReadContext: dataSourceTencentCloudTsfApplicationConfigRead,
 		Schema: map[string]*schema.Schema{
 			"application_id": {
 				Optional:    true,
@@ -154,13 +155,13 @@ func dataSourceTencentCloudTsfApplicationConfig() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfApplicationConfigRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfApplicationConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_application_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("application_id"); ok {
@@ -187,7 +188,7 @@ func dataSourceTencentCloudTsfApplicationConfigRead(d *schema.ResourceData, meta
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var config *tsf.TsfPageConfig
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTsfApplicationConfigByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -196,7 +197,7 @@ func dataSourceTencentCloudTsfApplicationConfigRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(config.Content))
@@ -268,7 +269,7 @@ func dataSourceTencentCloudTsfApplicationConfigRead(d *schema.ResourceData, meta
 
 		err = d.Set("result", []interface{}{tsfPageConfigMap})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -276,7 +277,7 @@ func dataSourceTencentCloudTsfApplicationConfigRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tsfPageConfigMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -18,6 +18,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -26,7 +27,7 @@ import (
 
 func dataSourceTencentCloudTsfApplicationFileConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfApplicationFileConfigRead,
+		ReadContext: dataSourceTencentCloudTsfApplicationFileConfigRead,
 		Schema: map[string]*schema.Schema{
 			"config_id": {
 				Optional:    true,
@@ -174,13 +175,13 @@ func dataSourceTencentCloudTsfApplicationFileConfig() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfApplicationFileConfigRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfApplicationFileConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_application_file_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("config_id"); ok {
@@ -207,7 +208,7 @@ func dataSourceTencentCloudTsfApplicationFileConfigRead(d *schema.ResourceData,
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var config *tsf.TsfPageFileConfig
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTsfApplicationFileConfigByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -216,7 +217,7 @@ func dataSourceTencentCloudTsfApplicationFileConfigRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(config.Content))
@@ -309,7 +310,7 @@ func dataSourceTencentCloudTsfApplicationFileConfigRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tsfPageFileConfigMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudTsfApplicationPublicConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfApplicationPublicConfigRead,
+		ReadContext: dataSourceTencentCloudTsfApplicationPublicConfigRead,
 		Schema: map[string]*schema.Schema{
 			"config_id": {
 				Optional:    true,
@@ -147,13 +148,13 @@ func dataSourceTencentCloudTsfApplicationPublicConfig() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfApplicationPublicConfigRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfApplicationPublicConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_application_public_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("config_id"); ok {
@@ -176,7 +177,7 @@ func dataSourceTencentCloudTsfApplicationPublicConfigRead(d *schema.ResourceData
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var config *tsf.TsfPageConfig
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTsfApplicationPublicConfigByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -185,7 +186,7 @@ func dataSourceTencentCloudTsfApplicationPublicConfigRead(d *schema.ResourceData
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(config.Content))
@@ -262,7 +263,7 @@ func dataSourceTencentCloudTsfApplicationPublicConfigRead(d *schema.ResourceData
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tsfPageConfigMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
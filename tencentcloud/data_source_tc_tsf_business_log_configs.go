@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudTsfBusinessLogConfigs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfBusinessLogConfigsRead,
+		ReadContext: dataSourceTencentCloudTsfBusinessLogConfigsRead,
 		Schema: map[string]*schema.Schema{
 			"search_word": {
 				Optional:    true,
@@ -223,13 +224,13 @@ func dataSourceTencentCloudTsfBusinessLogConfigs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfBusinessLogConfigsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfBusinessLogConfigsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_business_log_configs.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("search_word"); ok {
@@ -248,7 +249,7 @@ func dataSourceTencentCloudTsfBusinessLogConfigsRead(d *schema.ResourceData, met
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var logConfig *tsf.TsfPageBusinessLogConfig
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTsfBusinessLogConfigsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -257,7 +258,7 @@ func dataSourceTencentCloudTsfBusinessLogConfigsRead(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(logConfig.Content))
@@ -403,7 +404,7 @@ func dataSourceTencentCloudTsfBusinessLogConfigsRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tsfPageBusinessLogConfigMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudTsfCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfClusterRead,
+		ReadContext: dataSourceTencentCloudTsfClusterRead,
 		Schema: map[string]*schema.Schema{
 			"cluster_id_list": {
 				Optional: true,
@@ -303,13 +304,13 @@ func dataSourceTencentCloudTsfCluster() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfClusterRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_cluster.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("cluster_id_list"); ok {
@@ -332,7 +333,7 @@ func dataSourceTencentCloudTsfClusterRead(d *schema.ResourceData, meta interface
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var cluster *tsf.TsfPageCluster
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTsfClusterByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -341,7 +342,7 @@ func dataSourceTencentCloudTsfClusterRead(d *schema.ResourceData, meta interface
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(cluster.Content))
@@ -538,7 +539,7 @@ func dataSourceTencentCloudTsfClusterRead(d *schema.ResourceData, meta interface
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tsfPageClusterMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
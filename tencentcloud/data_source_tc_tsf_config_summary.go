@@ -19,6 +19,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -27,7 +28,7 @@ import (
 
 func dataSourceTencentCloudTsfConfigSummary() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfConfigSummaryRead,
+		ReadContext: dataSourceTencentCloudTsfConfigSummaryRead,
 		Schema: map[string]*schema.Schema{
 			"application_id": {
 				Optional:    true,
@@ -170,13 +171,13 @@ func dataSourceTencentCloudTsfConfigSummary() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfConfigSummaryRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfConfigSummaryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_config_summary.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("application_id"); ok {
@@ -212,7 +213,7 @@ func dataSourceTencentCloudTsfConfigSummaryRead(d *schema.ResourceData, meta int
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var config *tsf.TsfPageConfig
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTsfConfigSummaryByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -221,7 +222,7 @@ func dataSourceTencentCloudTsfConfigSummaryRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(config.Content))
@@ -298,7 +299,7 @@ func dataSourceTencentCloudTsfConfigSummaryRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tsfPageConfigMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -19,6 +19,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -27,7 +28,7 @@ import (
 
 func dataSourceTencentCloudTsfContainerGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfContainerGroupRead,
+		ReadContext: dataSourceTencentCloudTsfContainerGroupRead,
 		Schema: map[string]*schema.Schema{
 			"search_word": {
 				Optional:    true,
@@ -183,13 +184,13 @@ func dataSourceTencentCloudTsfContainerGroup() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfContainerGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfContainerGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf__container_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("search_word"); ok {
@@ -219,7 +220,7 @@ func dataSourceTencentCloudTsfContainerGroupRead(d *schema.ResourceData, meta in
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var result *tsf.ContainGroupResult
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeTsfDescriptionContainerGroupByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -228,7 +229,7 @@ func dataSourceTencentCloudTsfContainerGroupRead(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(result.Content))
@@ -326,7 +327,7 @@ func dataSourceTencentCloudTsfContainerGroupRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), containGroupResultMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
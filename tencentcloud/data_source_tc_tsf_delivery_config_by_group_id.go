@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudTsfDeliveryConfigByGroupId() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfDeliveryConfigByGroupIdRead,
+		ReadContext: dataSourceTencentCloudTsfDeliveryConfigByGroupIdRead,
 		Schema: map[string]*schema.Schema{
 			"group_id": {
 				Required:    true,
@@ -59,12 +60,12 @@ func dataSourceTencentCloudTsfDeliveryConfigByGroupId() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfDeliveryConfigByGroupIdRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfDeliveryConfigByGroupIdRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_delivery_config_by_group_id.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var groupId string
 
 	paramMap := make(map[string]interface{})
@@ -76,7 +77,7 @@ func dataSourceTencentCloudTsfDeliveryConfigByGroupIdRead(d *schema.ResourceData
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var deliveryConfig *tsf.SimpleKafkaDeliveryConfig
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTsfDeliveryConfigByGroupIdByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -85,7 +86,7 @@ func dataSourceTencentCloudTsfDeliveryConfigByGroupIdRead(d *schema.ResourceData
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	simpleKafkaDeliveryConfigMap := map[string]interface{}{}
@@ -105,7 +106,7 @@ func dataSourceTencentCloudTsfDeliveryConfigByGroupIdRead(d *schema.ResourceData
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), simpleKafkaDeliveryConfigMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
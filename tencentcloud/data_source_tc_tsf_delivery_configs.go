@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudTsfDeliveryConfigs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfDeliveryConfigsRead,
+		ReadContext: dataSourceTencentCloudTsfDeliveryConfigsRead,
 		Schema: map[string]*schema.Schema{
 			"search_word": {
 				Optional:    true,
@@ -212,13 +213,13 @@ func dataSourceTencentCloudTsfDeliveryConfigs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfDeliveryConfigsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfDeliveryConfigsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_delivery_configs.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("search_word"); ok {
@@ -229,7 +230,7 @@ func dataSourceTencentCloudTsfDeliveryConfigsRead(d *schema.ResourceData, meta i
 
 	var bindGroups *tsf.DeliveryConfigBindGroups
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTsfDeliveryConfigsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -238,7 +239,7 @@ func dataSourceTencentCloudTsfDeliveryConfigsRead(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(bindGroups.Content))
@@ -389,7 +390,7 @@ func dataSourceTencentCloudTsfDeliveryConfigsRead(d *schema.ResourceData, meta i
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), deliveryConfigBindGroupsMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
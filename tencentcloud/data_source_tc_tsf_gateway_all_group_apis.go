@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudTsfGatewayAllGroupApis() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfGatewayAllGroupApisRead,
+		ReadContext: dataSourceTencentCloudTsfGatewayAllGroupApisRead,
 		Schema: map[string]*schema.Schema{
 			"gateway_deploy_group_id": {
 				Required:    true,
@@ -139,12 +140,12 @@ func dataSourceTencentCloudTsfGatewayAllGroupApis() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfGatewayAllGroupApisRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfGatewayAllGroupApisRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_gateway_all_group_apis.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var gatewayDeployGroupId string
 	paramMap := make(map[string]interface{})
@@ -160,7 +161,7 @@ func dataSourceTencentCloudTsfGatewayAllGroupApisRead(d *schema.ResourceData, me
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var result *tsf.GatewayVo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeTsfGatewayAllGroupApisByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -169,7 +170,7 @@ func dataSourceTencentCloudTsfGatewayAllGroupApisRead(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayVoMap := map[string]interface{}{}
@@ -256,7 +257,7 @@ func dataSourceTencentCloudTsfGatewayAllGroupApisRead(d *schema.ResourceData, me
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), gatewayVoMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
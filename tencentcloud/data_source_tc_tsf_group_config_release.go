@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudTsfGroupConfigRelease() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfGroupConfigReleaseRead,
+		ReadContext: dataSourceTencentCloudTsfGroupConfigReleaseRead,
 		Schema: map[string]*schema.Schema{
 			"group_id": {
 				Required:    true,
@@ -291,12 +292,12 @@ func dataSourceTencentCloudTsfGroupConfigRelease() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfGroupConfigReleaseRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfGroupConfigReleaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_group_config_release.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var groupId string
 	paramMap := make(map[string]interface{})
@@ -308,7 +309,7 @@ func dataSourceTencentCloudTsfGroupConfigReleaseRead(d *schema.ResourceData, met
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var result *tsf.GroupRelease
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeTsfGroupConfigReleaseByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -317,7 +318,7 @@ func dataSourceTencentCloudTsfGroupConfigReleaseRead(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	groupReleaseMap := map[string]interface{}{}
@@ -534,7 +535,7 @@ func dataSourceTencentCloudTsfGroupConfigReleaseRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), groupReleaseMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudTsfGroupGateways() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfGroupGatewaysRead,
+		ReadContext: dataSourceTencentCloudTsfGroupGatewaysRead,
 		Schema: map[string]*schema.Schema{
 			"gateway_deploy_group_id": {
 				Required:    true,
@@ -199,13 +200,13 @@ func dataSourceTencentCloudTsfGroupGateways() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfGroupGatewaysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfGroupGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_group_gateways.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("gateway_deploy_group_id"); ok {
@@ -219,7 +220,7 @@ func dataSourceTencentCloudTsfGroupGatewaysRead(d *schema.ResourceData, meta int
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var result *tsf.TsfPageApiGroupInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeTsfGroupGatewaysByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -228,7 +229,7 @@ func dataSourceTencentCloudTsfGroupGatewaysRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(result.Content))
@@ -365,7 +366,7 @@ func dataSourceTencentCloudTsfGroupGatewaysRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tsfPageApiGroupInfoMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudTsfGroupInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfGroupInstancesRead,
+		ReadContext: dataSourceTencentCloudTsfGroupInstancesRead,
 		Schema: map[string]*schema.Schema{
 			"group_id": {
 				Required:    true,
@@ -279,13 +280,13 @@ func dataSourceTencentCloudTsfGroupInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfGroupInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfGroupInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_group_instances.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("group_id"); ok {
@@ -307,7 +308,7 @@ func dataSourceTencentCloudTsfGroupInstancesRead(d *schema.ResourceData, meta in
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var result *tsf.TsfPageInstance
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeTsfGroupInstancesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -316,7 +317,7 @@ func dataSourceTencentCloudTsfGroupInstancesRead(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(result.Content))
@@ -501,7 +502,7 @@ func dataSourceTencentCloudTsfGroupInstancesRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tsfPageInstanceMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
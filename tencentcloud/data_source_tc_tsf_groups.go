@@ -22,6 +22,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -30,7 +31,7 @@ import (
 
 func dataSourceTencentCloudTsfGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfGroupsRead,
+		ReadContext: dataSourceTencentCloudTsfGroupsRead,
 		Schema: map[string]*schema.Schema{
 			"search_word": {
 				Optional:    true,
@@ -215,13 +216,13 @@ func dataSourceTencentCloudTsfGroups() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_groups.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("search_word"); ok {
@@ -265,7 +266,7 @@ func dataSourceTencentCloudTsfGroupsRead(d *schema.ResourceData, meta interface{
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var result *tsf.TsfPageVmGroup
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeTsfGroupsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -274,7 +275,7 @@ func dataSourceTencentCloudTsfGroupsRead(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(result.Content))
@@ -375,7 +376,7 @@ func dataSourceTencentCloudTsfGroupsRead(d *schema.ResourceData, meta interface{
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tsfPageVmGroupMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudTsfMicroservice() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfMicroserviceRead,
+		ReadContext: dataSourceTencentCloudTsfMicroserviceRead,
 		Schema: map[string]*schema.Schema{
 			"namespace_id": {
 				Required:    true,
@@ -133,13 +134,13 @@ func dataSourceTencentCloudTsfMicroservice() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfMicroserviceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfMicroserviceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_microservice.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("namespace_id"); ok {
@@ -164,7 +165,7 @@ func dataSourceTencentCloudTsfMicroserviceRead(d *schema.ResourceData, meta inte
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var microservice *tsf.TsfPageMicroservice
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTsfMicroserviceByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -173,7 +174,7 @@ func dataSourceTencentCloudTsfMicroserviceRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(microservice.Content))
@@ -229,7 +230,7 @@ func dataSourceTencentCloudTsfMicroserviceRead(d *schema.ResourceData, meta inte
 
 		err = d.Set("result", []interface{}{tsfPageMicroserviceMap})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -237,7 +238,7 @@ func dataSourceTencentCloudTsfMicroserviceRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tsfPageMicroserviceMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
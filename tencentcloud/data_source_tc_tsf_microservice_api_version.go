@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudTsfMicroserviceApiVersion() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfMicroserviceApiVersionRead,
+		ReadContext: dataSourceTencentCloudTsfMicroserviceApiVersionRead,
 		Schema: map[string]*schema.Schema{
 			"microservice_id": {
 				Required:    true,
@@ -78,13 +79,13 @@ func dataSourceTencentCloudTsfMicroserviceApiVersion() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfMicroserviceApiVersionRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfMicroserviceApiVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_microservice_api_version.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("microservice_id"); ok {
@@ -102,7 +103,7 @@ func dataSourceTencentCloudTsfMicroserviceApiVersionRead(d *schema.ResourceData,
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var apiVersion []*tsf.ApiVersionArray
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTsfMicroserviceApiVersionByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -111,7 +112,7 @@ func dataSourceTencentCloudTsfMicroserviceApiVersionRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(apiVersion))
@@ -143,7 +144,7 @@ func dataSourceTencentCloudTsfMicroserviceApiVersionRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudTsfMsApiList() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfMsApiListRead,
+		ReadContext: dataSourceTencentCloudTsfMsApiListRead,
 		Schema: map[string]*schema.Schema{
 			"microservice_id": {
 				Required:    true,
@@ -90,13 +91,13 @@ func dataSourceTencentCloudTsfMsApiList() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfMsApiListRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfMsApiListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_ms_api_list.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("microservice_id"); ok {
@@ -110,7 +111,7 @@ func dataSourceTencentCloudTsfMsApiListRead(d *schema.ResourceData, meta interfa
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var result *tsf.TsfApiListResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeTsfMsApiListByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -119,7 +120,7 @@ func dataSourceTencentCloudTsfMsApiListRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(result.Content))
@@ -165,7 +166,7 @@ func dataSourceTencentCloudTsfMsApiListRead(d *schema.ResourceData, meta interfa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tsfApiListResponseMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudTsfPodInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfPodInstancesRead,
+		ReadContext: dataSourceTencentCloudTsfPodInstancesRead,
 		Schema: map[string]*schema.Schema{
 			"group_id": {
 				Required:    true,
@@ -143,13 +144,13 @@ func dataSourceTencentCloudTsfPodInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfPodInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfPodInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_pod_instances.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("group_id"); ok {
@@ -163,7 +164,7 @@ func dataSourceTencentCloudTsfPodInstancesRead(d *schema.ResourceData, meta inte
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var groupPodResult *tsf.GroupPodResult
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTsfDescribePodInstancesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -172,7 +173,7 @@ func dataSourceTencentCloudTsfPodInstancesRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(groupPodResult.Content))
@@ -257,7 +258,7 @@ func dataSourceTencentCloudTsfPodInstancesRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), groupPodResultMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -19,6 +19,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -27,7 +28,7 @@ import (
 
 func dataSourceTencentCloudTsfPublicConfigSummary() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfPublicConfigSummaryRead,
+		ReadContext: dataSourceTencentCloudTsfPublicConfigSummaryRead,
 		Schema: map[string]*schema.Schema{
 			"search_word": {
 				Optional:    true,
@@ -164,13 +165,13 @@ func dataSourceTencentCloudTsfPublicConfigSummary() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfPublicConfigSummaryRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfPublicConfigSummaryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_public_config_summary.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("search_word"); ok {
@@ -202,7 +203,7 @@ func dataSourceTencentCloudTsfPublicConfigSummaryRead(d *schema.ResourceData, me
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var config *tsf.TsfPageConfig
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTsfPublicConfigSummaryByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -211,7 +212,7 @@ func dataSourceTencentCloudTsfPublicConfigSummaryRead(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(config.Content))
@@ -288,7 +289,7 @@ func dataSourceTencentCloudTsfPublicConfigSummaryRead(d *schema.ResourceData, me
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tsfPageConfigMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
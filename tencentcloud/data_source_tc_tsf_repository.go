@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudTsfRepository() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfRepositoryRead,
+		ReadContext: dataSourceTencentCloudTsfRepositoryRead,
 		Schema: map[string]*schema.Schema{
 			"search_word": {
 				Optional:    true,
@@ -115,13 +116,13 @@ func dataSourceTencentCloudTsfRepository() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfRepositoryRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfRepositoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_repository.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("search_word"); ok {
@@ -135,7 +136,7 @@ func dataSourceTencentCloudTsfRepositoryRead(d *schema.ResourceData, meta interf
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var repository *tsf.RepositoryList
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTsfRepositoryByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -144,7 +145,7 @@ func dataSourceTencentCloudTsfRepositoryRead(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(repository.Content))
@@ -209,7 +210,7 @@ func dataSourceTencentCloudTsfRepositoryRead(d *schema.ResourceData, meta interf
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), repositoryListMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudTsfUnitRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfUnitRulesRead,
+		ReadContext: dataSourceTencentCloudTsfUnitRulesRead,
 		Schema: map[string]*schema.Schema{
 			"gateway_instance_id": {
 				Required:    true,
@@ -193,13 +194,13 @@ func dataSourceTencentCloudTsfUnitRules() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfUnitRulesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfUnitRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_unit_rules.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("gateway_instance_id"); ok {
@@ -213,7 +214,7 @@ func dataSourceTencentCloudTsfUnitRulesRead(d *schema.ResourceData, meta interfa
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var unitRule *tsf.TsfPageUnitRuleV2
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeTsfUnitRulesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -222,7 +223,7 @@ func dataSourceTencentCloudTsfUnitRulesRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(unitRule.Content))
@@ -358,7 +359,7 @@ func dataSourceTencentCloudTsfUnitRulesRead(d *schema.ResourceData, meta interfa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
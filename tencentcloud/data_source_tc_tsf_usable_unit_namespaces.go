@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudTsfUsableUnitNamespaces() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudTsfUsableUnitNamespacesRead,
+		ReadContext: dataSourceTencentCloudTsfUsableUnitNamespacesRead,
 		Schema: map[string]*schema.Schema{
 			"search_word": {
 				Optional:    true,
@@ -93,13 +94,13 @@ func dataSourceTencentCloudTsfUsableUnitNamespaces() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudTsfUsableUnitNamespacesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudTsfUsableUnitNamespacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_tsf_usable_unit_namespaces.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("search_word"); ok {
@@ -109,7 +110,7 @@ func dataSourceTencentCloudTsfUsableUnitNamespacesRead(d *schema.ResourceData, m
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var result *tsf.TsfPageUnitNamespace
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, e := service.DescribeTsfUsableUnitNamespacesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -118,7 +119,7 @@ func dataSourceTencentCloudTsfUsableUnitNamespacesRead(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(result.Content))
@@ -172,7 +173,7 @@ func dataSourceTencentCloudTsfUsableUnitNamespacesRead(d *schema.ResourceData, m
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tsfPageUnitNamespaceMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
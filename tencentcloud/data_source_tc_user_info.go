@@ -21,15 +21,16 @@ import (
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func datasourceTencentCloudUserInfo() *schema.Resource {
 	return &schema.Resource{
-		Read: datasourceTencentCloudUserInfoRead,
+		ReadContext: datasourceTencentCloudUserInfoRead,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"app_id": {
@@ -63,38 +64,40 @@ func datasourceTencentCloudUserInfo() *schema.Resource {
 	}
 }
 
-func datasourceTencentCloudUserInfoRead(d *schema.ResourceData, meta interface{}) error {
+func datasourceTencentCloudUserInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("datasource.tencentcloud_user_info.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := meta.(*TencentCloudClient).apiV3Conn
 
 	logId = getLogId(ctx)
 	request := cam.NewGetUserAppIdRequest()
 
-	ratelimit.Check(request.GetAction())
-	response, err := client.UseCamClient().GetUserAppId(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return diag.FromErr(err)
+	}
+	response, err := client.UseCamClient().GetUserAppIdWithContext(ctx, request)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	result := response.Response
 
 	if result == nil {
-		return fmt.Errorf("get user appid error: empty response")
+		return diag.Errorf("get user appid error: empty response")
 	}
 
 	appId := strconv.FormatUint(*result.AppId, 10)
@@ -102,9 +105,9 @@ func datasourceTencentCloudUserInfoRead(d *schema.ResourceData, meta interface{}
 	ownerUin := *result.OwnerUin
 	accountInfoRequest := cam.NewDescribeSubAccountsRequest()
 	accountInfoRequest.FilterSubAccountUin = []*uint64{helper.Uint64(helper.StrToUInt64(uin))}
-	accountInfoResponse, err := client.UseCamClient().DescribeSubAccounts(accountInfoRequest)
+	accountInfoResponse, err := client.UseCamClient().DescribeSubAccountsWithContext(ctx, accountInfoRequest)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	subAccounts := accountInfoResponse.Response.SubAccounts
 	var name string
@@ -126,7 +129,7 @@ func datasourceTencentCloudUserInfoRead(d *schema.ResourceData, meta interface{}
 			"ownerUin": ownerUin,
 			"name":     name,
 		}); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
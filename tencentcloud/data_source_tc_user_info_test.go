@@ -44,6 +44,24 @@ func TestAccTencentCloudDataSourceUserInfoSubAccount(t *testing.T) {
 	})
 }
 
+// TestAccTencentCloudDataSourceUserInfoReplay replays the cassette in testdata/cassettes without credentials.
+func TestAccTencentCloudDataSourceUserInfoReplay(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckReplay(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataUserInfoBasic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.tencentcloud_user_info.info", "app_id", "1250000000"),
+					resource.TestCheckResourceAttr("data.tencentcloud_user_info.info", "uin", "100000000001"),
+					resource.TestCheckResourceAttr("data.tencentcloud_user_info.info", "owner_uin", "100000000001"),
+				),
+			},
+		},
+	})
+}
+
 const testAccDataUserInfoBasic = `
 data "tencentcloud_user_info" "info" {}
 `
@@ -59,13 +59,14 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudVodAdaptiveDynamicStreamingTemplates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVodAdaptiveDynamicStreamingTemplatesRead,
+		ReadContext: dataSourceTencentCloudVodAdaptiveDynamicStreamingTemplatesRead,
 
 		Schema: map[string]*schema.Schema{
 			"definition": {
@@ -238,11 +239,11 @@ func dataSourceTencentCloudVodAdaptiveDynamicStreamingTemplates() *schema.Resour
 	}
 }
 
-func dataSourceTencentCloudVodAdaptiveDynamicStreamingTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVodAdaptiveDynamicStreamingTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vod_adaptive_dynamic_streaming_templates.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	filter := make(map[string]interface{})
 	if v, ok := d.GetOk("definition"); ok {
@@ -260,7 +261,7 @@ func dataSourceTencentCloudVodAdaptiveDynamicStreamingTemplatesRead(d *schema.Re
 	}
 	templates, err := vodService.DescribeAdaptiveDynamicStreamingTemplatesByFilter(ctx, filter)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	templatesList := make([]map[string]interface{}, 0, len(templates))
@@ -319,7 +320,7 @@ func dataSourceTencentCloudVodAdaptiveDynamicStreamingTemplatesRead(d *schema.Re
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
 		if err := writeToFile(output.(string), templatesList); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]", logId, output.(string), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -30,13 +30,14 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudVodImageSpriteTemplates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVodImageSpriteTemplatesRead,
+		ReadContext: dataSourceTencentCloudVodImageSpriteTemplatesRead,
 
 		Schema: map[string]*schema.Schema{
 			"definition": {
@@ -142,11 +143,11 @@ func dataSourceTencentCloudVodImageSpriteTemplates() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVodImageSpriteTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVodImageSpriteTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vod_image_sprite_templates.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	filter := make(map[string]interface{})
 	if v, ok := d.GetOk("definition"); ok {
@@ -164,7 +165,7 @@ func dataSourceTencentCloudVodImageSpriteTemplatesRead(d *schema.ResourceData, m
 	}
 	templates, err := vodService.DescribeImageSpriteTemplatesByFilter(ctx, filter)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	templatesList := make([]map[string]interface{}, 0, len(templates))
@@ -198,7 +199,7 @@ func dataSourceTencentCloudVodImageSpriteTemplatesRead(d *schema.ResourceData, m
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
 		if err := writeToFile(output.(string), templatesList); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]", logId, output.(string), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -36,13 +36,14 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudVodProcedureTemplates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVodProcedureTemplatesRead,
+		ReadContext: dataSourceTencentCloudVodProcedureTemplatesRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -312,11 +313,11 @@ func dataSourceTencentCloudVodProcedureTemplates() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVodProcedureTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVodProcedureTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vod_procedure_templates.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	filter := make(map[string]interface{})
 	if v, ok := d.GetOk("name"); ok {
@@ -334,7 +335,7 @@ func dataSourceTencentCloudVodProcedureTemplatesRead(d *schema.ResourceData, met
 	}
 	templates, err := vodService.DescribeProcedureTemplatesByFilter(ctx, filter)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	templatesList := make([]map[string]interface{}, 0, len(templates))
@@ -547,7 +548,7 @@ func dataSourceTencentCloudVodProcedureTemplatesRead(d *schema.ResourceData, met
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
 		if err := writeToFile(output.(string), templatesList); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]", logId, output.(string), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
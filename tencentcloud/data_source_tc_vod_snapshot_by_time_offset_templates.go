@@ -27,13 +27,14 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudVodSnapshotByTimeOffsetTemplates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVodSnapshotByTimeOffsetTemplatesRead,
+		ReadContext: dataSourceTencentCloudVodSnapshotByTimeOffsetTemplatesRead,
 
 		Schema: map[string]*schema.Schema{
 			"definition": {
@@ -124,11 +125,11 @@ func dataSourceTencentCloudVodSnapshotByTimeOffsetTemplates() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVodSnapshotByTimeOffsetTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVodSnapshotByTimeOffsetTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vod_snapshot_by_time_offset_templates.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	filter := make(map[string]interface{})
 	if v, ok := d.GetOk("definition"); ok {
@@ -146,7 +147,7 @@ func dataSourceTencentCloudVodSnapshotByTimeOffsetTemplatesRead(d *schema.Resour
 	}
 	templates, err := vodService.DescribeSnapshotByTimeOffsetTemplatesByFilter(ctx, filter)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	templatesList := make([]map[string]interface{}, 0, len(templates))
@@ -177,7 +178,7 @@ func dataSourceTencentCloudVodSnapshotByTimeOffsetTemplatesRead(d *schema.Resour
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
 		if err := writeToFile(output.(string), templatesList); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]", logId, output.(string), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -37,13 +37,14 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudVodSuperPlayerConfigs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVodSuperPlayerConfigsRead,
+		ReadContext: dataSourceTencentCloudVodSuperPlayerConfigsRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -162,11 +163,11 @@ func dataSourceTencentCloudVodSuperPlayerConfigs() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVodSuperPlayerConfigsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVodSuperPlayerConfigsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vod_super_player_configs.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	filter := make(map[string]interface{})
 	if v, ok := d.GetOk("name"); ok {
@@ -184,7 +185,7 @@ func dataSourceTencentCloudVodSuperPlayerConfigsRead(d *schema.ResourceData, met
 	}
 	configs, err := vodService.DescribeSuperPlayerConfigsByFilter(ctx, filter)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	configsList := make([]map[string]interface{}, 0, len(configs))
@@ -238,7 +239,7 @@ func dataSourceTencentCloudVodSuperPlayerConfigsRead(d *schema.ResourceData, met
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
 		if err := writeToFile(output.(string), configsList); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]", logId, output.(string), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -26,15 +26,15 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTencentCloudVpc() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This data source has been deprecated in Terraform TencentCloud provider version 1.10.0. Please use 'tencentcloud_vpc_instances' instead.",
-		Read:               dataSourceTencentCloudVpcRead,
+		ReadContext:        dataSourceTencentCloudVpcRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -68,11 +68,11 @@ func dataSourceTencentCloudVpc() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var (
@@ -94,11 +94,11 @@ func dataSourceTencentCloudVpcRead(d *schema.ResourceData, meta interface{}) err
 
 	var vpcInfos, err = service.DescribeVpcs(ctx, vpcId, name, map[string]string{}, nil, "", "")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(vpcInfos) == 0 {
-		return fmt.Errorf("vpc id=%s, name=%s not found", vpcId, name)
+		return diag.Errorf("vpc id=%s, name=%s not found", vpcId, name)
 	}
 
 	vpc := vpcInfos[0]
@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudVpcAccountAttributes() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcAccountAttributesRead,
+		ReadContext: dataSourceTencentCloudVpcAccountAttributesRead,
 		Schema: map[string]*schema.Schema{
 			"account_attribute_set": {
 				Computed:    true,
@@ -54,19 +55,19 @@ func dataSourceTencentCloudVpcAccountAttributes() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcAccountAttributesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcAccountAttributesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_account_attributes.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var accountAttributeSet []*vpc.AccountAttribute
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcAccountAttributes(ctx)
 		if e != nil {
 			return retryError(e)
@@ -75,7 +76,7 @@ func dataSourceTencentCloudVpcAccountAttributesRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(accountAttributeSet))
@@ -104,7 +105,7 @@ func dataSourceTencentCloudVpcAccountAttributesRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -23,13 +23,14 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func dataSourceTencentCloudVpcAcls() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcACLRead,
+		ReadContext: dataSourceTencentCloudVpcACLRead,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -190,21 +191,21 @@ func dataSourceTencentCloudVpcAcls() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcACLRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcACLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_acls.read")()
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 		vpcID = d.Get("vpc_id").(string)
 		name  = d.Get("name").(string)
 		id    = d.Get("id").(string)
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	networkAcls, err := service.DescribeNetWorkAcls(ctx, id, vpcID, name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	aclList := make([]map[string]interface{}, 0, len(networkAcls))
@@ -276,13 +277,13 @@ func dataSourceTencentCloudVpcACLRead(d *schema.ResourceData, meta interface{})
 	err = d.Set("acl_list", aclList)
 	if err != nil {
 		log.Printf("[CRITAL]%s provider set ACL list fail, reason:%v \n ", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if err := writeToFile(output.(string), aclList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudVpcBandwidthPackageBillUsage() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcBandwidthPackageBillUsageRead,
+		ReadContext: dataSourceTencentCloudVpcBandwidthPackageBillUsageRead,
 		Schema: map[string]*schema.Schema{
 			"bandwidth_package_id": {
 				Required:    true,
@@ -55,13 +56,13 @@ func dataSourceTencentCloudVpcBandwidthPackageBillUsage() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcBandwidthPackageBillUsageRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcBandwidthPackageBillUsageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_bandwidth_package_bill_usage.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("bandwidth_package_id"); ok {
@@ -72,7 +73,7 @@ func dataSourceTencentCloudVpcBandwidthPackageBillUsageRead(d *schema.ResourceDa
 
 	var bandwidthPackageBillBandwidthSet []*vpc.BandwidthPackageBillBandwidth
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcBandwidthPackageBillUsageByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -81,7 +82,7 @@ func dataSourceTencentCloudVpcBandwidthPackageBillUsageRead(d *schema.ResourceDa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(bandwidthPackageBillBandwidthSet))
@@ -106,7 +107,7 @@ func dataSourceTencentCloudVpcBandwidthPackageBillUsageRead(d *schema.ResourceDa
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -13,6 +13,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -21,7 +22,7 @@ import (
 
 func dataSourceTencentCloudVpcBandwidthPackageQuota() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcBandwidthPackageQuotaRead,
+		ReadContext: dataSourceTencentCloudVpcBandwidthPackageQuotaRead,
 		Schema: map[string]*schema.Schema{
 			"quota_set": {
 				Computed:    true,
@@ -57,19 +58,19 @@ func dataSourceTencentCloudVpcBandwidthPackageQuota() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcBandwidthPackageQuotaRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcBandwidthPackageQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_bandwidth_package_quota.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var quotaSet []*vpc.Quota
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcBandwidthPackageQuota(ctx)
 		if e != nil {
 			return retryError(e)
@@ -78,7 +79,7 @@ func dataSourceTencentCloudVpcBandwidthPackageQuotaRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(quotaSet))
@@ -111,7 +112,7 @@ func dataSourceTencentCloudVpcBandwidthPackageQuotaRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
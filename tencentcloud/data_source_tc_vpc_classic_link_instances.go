@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudVpcClassicLinkInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcClassicLinkInstancesRead,
+		ReadContext: dataSourceTencentCloudVpcClassicLinkInstancesRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
 				Optional:    true,
@@ -79,13 +80,13 @@ func dataSourceTencentCloudVpcClassicLinkInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcClassicLinkInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcClassicLinkInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_classic_link_instances.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("filters"); ok {
@@ -112,7 +113,7 @@ func dataSourceTencentCloudVpcClassicLinkInstancesRead(d *schema.ResourceData, m
 
 	var classicLinkInstanceSet []*vpc.ClassicLinkInstance
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcClassicLinkInstancesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -121,7 +122,7 @@ func dataSourceTencentCloudVpcClassicLinkInstancesRead(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(classicLinkInstanceSet))
@@ -150,7 +151,7 @@ func dataSourceTencentCloudVpcClassicLinkInstancesRead(d *schema.ResourceData, m
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
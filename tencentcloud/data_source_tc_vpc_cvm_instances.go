@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudVpcCvmInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcCvmInstancesRead,
+		ReadContext: dataSourceTencentCloudVpcCvmInstancesRead,
 		Schema: map[string]*schema.Schema{
 			"filters": {
 				Required:    true,
@@ -129,13 +130,13 @@ func dataSourceTencentCloudVpcCvmInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcCvmInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcCvmInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_cvm_instances.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("filters"); ok {
@@ -162,7 +163,7 @@ func dataSourceTencentCloudVpcCvmInstancesRead(d *schema.ResourceData, meta inte
 
 	var instanceSet []*vpc.CvmInstance
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcCvmInstancesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -171,7 +172,7 @@ func dataSourceTencentCloudVpcCvmInstancesRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(instanceSet))
@@ -240,7 +241,7 @@ func dataSourceTencentCloudVpcCvmInstancesRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudVpcGatewayFlowMonitorDetail() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcGatewayFlowMonitorDetailRead,
+		ReadContext: dataSourceTencentCloudVpcGatewayFlowMonitorDetailRead,
 		Schema: map[string]*schema.Schema{
 			"time_point": {
 				Required:    true,
@@ -113,13 +114,13 @@ func dataSourceTencentCloudVpcGatewayFlowMonitorDetail() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcGatewayFlowMonitorDetailRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcGatewayFlowMonitorDetailRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_gateway_flow_monitor_detail.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("time_point"); ok {
@@ -154,7 +155,7 @@ func dataSourceTencentCloudVpcGatewayFlowMonitorDetailRead(d *schema.ResourceDat
 
 	var gatewayFlowMonitorDetailSet []*vpc.GatewayFlowMonitorDetail
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcGatewayFlowMonitorDetailByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -163,7 +164,7 @@ func dataSourceTencentCloudVpcGatewayFlowMonitorDetailRead(d *schema.ResourceDat
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(gatewayFlowMonitorDetailSet))
@@ -204,7 +205,7 @@ func dataSourceTencentCloudVpcGatewayFlowMonitorDetailRead(d *schema.ResourceDat
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
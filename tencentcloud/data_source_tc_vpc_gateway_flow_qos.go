@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudVpcGatewayFlowQos() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcGatewayFlowQosRead,
+		ReadContext: dataSourceTencentCloudVpcGatewayFlowQosRead,
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
 				Required:    true,
@@ -78,13 +79,13 @@ func dataSourceTencentCloudVpcGatewayFlowQos() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcGatewayFlowQosRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcGatewayFlowQosRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_gateway_flow_qos.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("gateway_id"); ok {
@@ -100,7 +101,7 @@ func dataSourceTencentCloudVpcGatewayFlowQosRead(d *schema.ResourceData, meta in
 
 	var gatewayQosSet []*vpc.GatewayQos
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcGatewayFlowQosByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -109,7 +110,7 @@ func dataSourceTencentCloudVpcGatewayFlowQosRead(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(gatewayQosSet))
@@ -146,7 +147,7 @@ func dataSourceTencentCloudVpcGatewayFlowQosRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -28,6 +28,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -35,7 +36,7 @@ import (
 
 func dataSourceTencentCloudVpcInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcInstancesRead,
+		ReadContext: dataSourceTencentCloudVpcInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -138,11 +139,11 @@ func dataSourceTencentCloudVpcInstances() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_instances.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -174,7 +175,7 @@ func dataSourceTencentCloudVpcInstancesRead(d *schema.ResourceData, meta interfa
 		vpcInfos []VpcBasicInfo
 		err      error
 	)
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		vpcInfos, err = service.DescribeVpcs(ctx, vpcId, name, tags, isDefault, tagKey, cidrBlock)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -183,7 +184,7 @@ func dataSourceTencentCloudVpcInstancesRead(d *schema.ResourceData, meta interfa
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var vpcInfoList = make([]map[string]interface{}, 0, len(vpcInfos))
@@ -201,10 +202,10 @@ func dataSourceTencentCloudVpcInstancesRead(d *schema.ResourceData, meta interfa
 		respTags := make(map[string]string, len(item.tags))
 		for _, tag := range item.tags {
 			if tag.Key == nil {
-				return errors.New("vpc tag key is nil")
+				return diag.FromErr(errors.New("vpc tag key is nil"))
 			}
 			if tag.Value == nil {
-				return errors.New("vpc tag value is nil")
+				return diag.FromErr(errors.New("vpc tag value is nil"))
 			}
 
 			respTags[*tag.Key] = *tag.Value
@@ -213,7 +214,7 @@ func dataSourceTencentCloudVpcInstancesRead(d *schema.ResourceData, meta interfa
 
 		subnetInfos, err := service.DescribeSubnets(ctx, "", item.vpcId, "", "", nil, nil, nil, "", "")
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		subnetIds := make([]string, 0, len(subnetInfos))
 		for _, v := range subnetInfos {
@@ -226,7 +227,7 @@ func dataSourceTencentCloudVpcInstancesRead(d *schema.ResourceData, meta interfa
 
 	if err := d.Set("instance_list", vpcInfoList); err != nil {
 		log.Printf("[CRITAL]%s provider set  vpc instances fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	idBytes, err := json.Marshal(map[string]interface{}{
@@ -239,7 +240,7 @@ func dataSourceTencentCloudVpcInstancesRead(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create data source id error, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	md := md5.New()
@@ -251,7 +252,7 @@ func dataSourceTencentCloudVpcInstancesRead(d *schema.ResourceData, meta interfa
 		if err := writeToFile(output.(string), vpcInfoList); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
 				logId, output.(string), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
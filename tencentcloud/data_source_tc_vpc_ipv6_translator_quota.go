@@ -0,0 +1,111 @@
+/*
+Use this data source to query the quota of IPv6 translators of the region, and the quota of rules of IPv6 translators.
+
+Example Usage
+
+```hcl
+data "tencentcloud_vpc_ipv6_translator_quota" "quota" {
+  ip6_translator_ids = ["ip6-xxxxxxxx"]
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudVpcIpv6TranslatorQuota() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTencentCloudVpcIpv6TranslatorQuotaRead,
+
+		Schema: map[string]*schema.Schema{
+			"ip6_translator_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the IPv6 translators to query the quota of rules for.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"quota_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Quota list. The quota of IPv6 translators of the region has the id `TOTAL_TRANSLATOR_QUOTA`, and the quota of rules of an IPv6 translator has the id of the translator.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"quota_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the quota.",
+						},
+						"quota_current": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Current usage of the quota.",
+						},
+						"quota_limit": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Limit of the quota.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudVpcIpv6TranslatorQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("data_source.tencentcloud_vpc_ipv6_translator_quota.read")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	var ip6TranslatorIds []*string
+	if v, ok := d.GetOk("ip6_translator_ids"); ok {
+		ip6TranslatorIds = helper.InterfacesStringsPoint(v.([]interface{}))
+	}
+
+	quotas, err := service.DescribeIp6TranslatorQuota(ctx, ip6TranslatorIds)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	quotaList := make([]map[string]interface{}, 0, len(quotas))
+	ids := make([]string, 0, len(quotas))
+	for _, item := range quotas {
+		quotaList = append(quotaList, map[string]interface{}{
+			"quota_id":      item.QuotaId,
+			"quota_current": item.QuotaCurrent,
+			"quota_limit":   item.QuotaLimit,
+		})
+		ids = append(ids, helper.PString(item.QuotaId))
+	}
+
+	d.SetId(helper.DataResourceIdsHash(ids))
+	if err := d.Set("quota_list", quotaList); err != nil {
+		log.Printf("[CRITAL]%s provider set vpc ipv6 translator quota list fail, reason:%s\n", logId, err.Error())
+		return diag.FromErr(err)
+	}
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), quotaList); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
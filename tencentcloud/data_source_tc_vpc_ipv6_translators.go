@@ -0,0 +1,234 @@
+/*
+Use this data source to query IPv6 translators and their rules.
+
+Example Usage
+
+```hcl
+data "tencentcloud_vpc_ipv6_translators" "by_name" {
+  ip6_translator_name = "tf-example"
+}
+
+data "tencentcloud_vpc_ipv6_translators" "running" {
+  status = "RUNNING"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudVpcIpv6Translators() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTencentCloudVpcIpv6TranslatorsRead,
+
+		Schema: map[string]*schema.Schema{
+			"ip6_translator_ids": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"ip6_translator_name", "vip6", "status"},
+				Description:   "IDs of the IPv6 translators to be queried. It can not be used together with the other filters.",
+			},
+			"ip6_translator_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the IPv6 translators to be queried.",
+			},
+			"vip6": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "IPv6 address of the IPv6 translators to be queried.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Status of the IPv6 translators to be queried, such as `CREATING`, `RUNNING`, `MODIFYING` and `DELETING`.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"ip6_translator_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Information list of the IPv6 translators.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip6_translator_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the IPv6 translator.",
+						},
+						"ip6_translator_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the IPv6 translator.",
+						},
+						"vip6": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IPv6 address of the translator.",
+						},
+						"internet_service_provider": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Internet service provider of the IPv6 address of the translator.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the IPv6 translator.",
+						},
+						"rule_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of the rules of the IPv6 translator.",
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Creation time of the IPv6 translator.",
+						},
+						"rules": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Rules of the IPv6 translator.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip6_rule_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "ID of the rule.",
+									},
+									"ip6_rule_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of the rule.",
+									},
+									"protocol": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Protocol of the rule.",
+									},
+									"ipv6_port": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Port of the IPv6 address of the translator.",
+									},
+									"ipv4_address": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "IPv4 address of the backend.",
+									},
+									"ipv4_port": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Port of the IPv4 backend.",
+									},
+									"status": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Status of the rule.",
+									},
+									"create_time": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Creation time of the rule.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudVpcIpv6TranslatorsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("data_source.tencentcloud_vpc_ipv6_translators.read")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	var (
+		ip6TranslatorIds []*string
+		filters          []*vpc.Filter
+	)
+	if v, ok := d.GetOk("ip6_translator_ids"); ok {
+		ip6TranslatorIds = helper.InterfacesStringsPoint(v.([]interface{}))
+	}
+	for key, name := range map[string]string{
+		"ip6_translator_name": "ip6-translator-name",
+		"vip6":                "ip6-translator-vip6",
+		"status":              "ip6-translator-status",
+	} {
+		if v, ok := d.GetOk(key); ok {
+			filters = append(filters, &vpc.Filter{
+				Name:   helper.String(name),
+				Values: []*string{helper.String(v.(string))},
+			})
+		}
+	}
+
+	ip6Translators, err := service.DescribeIp6Translators(ctx, ip6TranslatorIds, filters)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ip6TranslatorList := make([]map[string]interface{}, 0, len(ip6Translators))
+	ids := make([]string, 0, len(ip6Translators))
+	for _, item := range ip6Translators {
+		rules := make([]map[string]interface{}, 0, len(item.IP6RuleSet))
+		for _, rule := range item.IP6RuleSet {
+			rules = append(rules, map[string]interface{}{
+				"ip6_rule_id":   rule.Ip6RuleId,
+				"ip6_rule_name": rule.Ip6RuleName,
+				"protocol":      rule.Protocol,
+				"ipv6_port":     rule.Vport6,
+				"ipv4_address":  rule.Vip,
+				"ipv4_port":     rule.Vport,
+				"status":        rule.RuleStatus,
+				"create_time":   rule.CreatedTime,
+			})
+		}
+		ip6TranslatorList = append(ip6TranslatorList, map[string]interface{}{
+			"ip6_translator_id":         item.Ip6TranslatorId,
+			"ip6_translator_name":       item.Ip6TranslatorName,
+			"vip6":                      item.Vip6,
+			"internet_service_provider": item.IspName,
+			"status":                    item.TranslatorStatus,
+			"rule_count":                item.Ip6RuleCount,
+			"create_time":               item.CreatedTime,
+			"rules":                     rules,
+		})
+		ids = append(ids, helper.PString(item.Ip6TranslatorId))
+	}
+
+	d.SetId(helper.DataResourceIdsHash(ids))
+	if err := d.Set("ip6_translator_list", ip6TranslatorList); err != nil {
+		log.Printf("[CRITAL]%s provider set vpc ipv6 translator list fail, reason:%s\n", logId, err.Error())
+		return diag.FromErr(err)
+	}
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), ip6TranslatorList); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTencentCloudVpcIpv6TranslatorsDataSource_basic(t *testing.T) {
+	t.Parallel()
+	keyName := "data.tencentcloud_vpc_ipv6_translators.ip6_translators"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcIpv6TranslatorsDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID(keyName),
+					resource.TestCheckResourceAttr(keyName, "ip6_translator_list.#", "1"),
+					resource.TestCheckResourceAttr(keyName, "ip6_translator_list.0.ip6_translator_name", "tf-ci-test-ip6-translator-rule"),
+					resource.TestCheckResourceAttrSet(keyName, "ip6_translator_list.0.vip6"),
+					resource.TestCheckResourceAttr(keyName, "ip6_translator_list.0.rules.#", "1"),
+					resource.TestCheckResourceAttr(keyName, "ip6_translator_list.0.rules.0.ipv6_port", "443"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTencentCloudVpcIpv6TranslatorQuotaDataSource_basic(t *testing.T) {
+	t.Parallel()
+	keyName := "data.tencentcloud_vpc_ipv6_translator_quota.quota"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcIpv6TranslatorQuotaDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID(keyName),
+					resource.TestCheckResourceAttrSet(keyName, "quota_list.0.quota_id"),
+					resource.TestCheckResourceAttrSet(keyName, "quota_list.0.quota_limit"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVpcIpv6TranslatorsDataSource = testAccVpcIpv6TranslatorRule + `
+data "tencentcloud_vpc_ipv6_translators" "ip6_translators" {
+  ip6_translator_ids = [tencentcloud_vpc_ipv6_translator_rule.example.ip6_translator_id]
+}
+`
+
+const testAccVpcIpv6TranslatorQuotaDataSource = `
+data "tencentcloud_vpc_ipv6_translator_quota" "quota" {}
+`
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudVpcLimits() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcLimitsRead,
+		ReadContext: dataSourceTencentCloudVpcLimitsRead,
 		Schema: map[string]*schema.Schema{
 			"limit_types": {
 				Required: true,
@@ -62,13 +63,13 @@ func dataSourceTencentCloudVpcLimits() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcLimitsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcLimitsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_limits.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("limit_types"); ok {
@@ -80,7 +81,7 @@ func dataSourceTencentCloudVpcLimitsRead(d *schema.ResourceData, meta interface{
 
 	var vpcLimitSet []*vpc.VpcLimit
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcLimitsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -89,7 +90,7 @@ func dataSourceTencentCloudVpcLimitsRead(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(vpcLimitSet))
@@ -118,7 +119,7 @@ func dataSourceTencentCloudVpcLimitsRead(d *schema.ResourceData, meta interface{
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
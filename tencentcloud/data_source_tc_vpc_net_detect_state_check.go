@@ -20,6 +20,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -28,7 +29,7 @@ import (
 
 func dataSourceTencentCloudVpcNetDetectStateCheck() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcNetDetectStateCheckRead,
+		ReadContext: dataSourceTencentCloudVpcNetDetectStateCheckRead,
 		Schema: map[string]*schema.Schema{
 			"detect_destination_ip": {
 				Required: true,
@@ -114,13 +115,13 @@ func dataSourceTencentCloudVpcNetDetectStateCheck() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcNetDetectStateCheckRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcNetDetectStateCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_net_detect_state_check.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("detect_destination_ip"); ok {
@@ -156,7 +157,7 @@ func dataSourceTencentCloudVpcNetDetectStateCheckRead(d *schema.ResourceData, me
 
 	var netDetectIpStateSet []*vpc.NetDetectIpState
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcNetDetectStateCheck(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -165,7 +166,7 @@ func dataSourceTencentCloudVpcNetDetectStateCheckRead(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(netDetectIpStateSet))
@@ -202,7 +203,7 @@ func dataSourceTencentCloudVpcNetDetectStateCheckRead(d *schema.ResourceData, me
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
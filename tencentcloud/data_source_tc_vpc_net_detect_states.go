@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudVpcNetDetectStates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcNetDetectStatesRead,
+		ReadContext: dataSourceTencentCloudVpcNetDetectStatesRead,
 		Schema: map[string]*schema.Schema{
 			"net_detect_ids": {
 				Optional: true,
@@ -109,13 +110,13 @@ func dataSourceTencentCloudVpcNetDetectStates() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcNetDetectStatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcNetDetectStatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_net_detect_states.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("net_detect_ids"); ok {
@@ -147,7 +148,7 @@ func dataSourceTencentCloudVpcNetDetectStatesRead(d *schema.ResourceData, meta i
 
 	var netDetectStateSet []*vpc.NetDetectState
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcNetDetectStatesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -156,7 +157,7 @@ func dataSourceTencentCloudVpcNetDetectStatesRead(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(netDetectStateSet))
@@ -208,7 +209,7 @@ func dataSourceTencentCloudVpcNetDetectStatesRead(d *schema.ResourceData, meta i
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudVpcNetworkInterfaceLimit() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcNetworkInterfaceLimitRead,
+		ReadContext: dataSourceTencentCloudVpcNetworkInterfaceLimitRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -75,13 +76,13 @@ func dataSourceTencentCloudVpcNetworkInterfaceLimit() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcNetworkInterfaceLimitRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcNetworkInterfaceLimitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_network_interface_limit.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var instanceId string
 	paramMap := make(map[string]interface{})
@@ -94,7 +95,7 @@ func dataSourceTencentCloudVpcNetworkInterfaceLimitRead(d *schema.ResourceData,
 
 	var networkInterfaceLimit *vpc.DescribeNetworkInterfaceLimitResponseParams
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcNetworkInterfaceLimit(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -103,7 +104,7 @@ func dataSourceTencentCloudVpcNetworkInterfaceLimitRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	limitMap := map[string]interface{}{}
@@ -142,7 +143,7 @@ func dataSourceTencentCloudVpcNetworkInterfaceLimitRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), limitMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -0,0 +1,224 @@
+/*
+Use this data source to query VPC peering connections.
+
+Example Usage
+
+```hcl
+data "tencentcloud_vpc_peering_connections" "by_vpc" {
+  vpc_id = "vpc-xxxxxxxx"
+}
+
+data "tencentcloud_vpc_peering_connections" "pending" {
+  state = "PENDING"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudVpcPeeringConnections() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTencentCloudVpcPeeringConnectionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"peering_connection_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the peering connections to be queried.",
+			},
+			"peering_connection_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the peering connections to be queried.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the VPC, which is the source or peer VPC of the peering connections to be queried.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "State of the peering connections to be queried, such as `PENDING`, `ACTIVE`, `EXPIRED` and `REJECTED`.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+
+			// Computed values
+			"peering_connection_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Information list of the peering connections.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"peering_connection_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the peering connection.",
+						},
+						"peering_connection_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the peering connection.",
+						},
+						"source_vpc_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the source VPC.",
+						},
+						"source_region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Region of the source VPC.",
+						},
+						"source_uin": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "UIN of the account of the source VPC.",
+						},
+						"peer_vpc_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the peer VPC.",
+						},
+						"peer_region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Region of the peer VPC.",
+						},
+						"peer_uin": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "UIN of the account of the peer VPC.",
+						},
+						"bandwidth": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Bandwidth of the peering connection in Mbps.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the peering connection.",
+						},
+						"charge_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Billing mode of the peering connection.",
+						},
+						"qos_level": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Service quality of the peering connection.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the peering connection.",
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Creation time of the peering connection.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudVpcPeeringConnectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("data_source.tencentcloud_vpc_peering_connections.read")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	params := &DescribeVpcPeeringConnectionsParams{}
+	if v, ok := d.GetOk("peering_connection_ids"); ok {
+		params.PeeringConnectionIds = helper.InterfacesStringsPoint(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("peering_connection_name"); ok {
+		params.Filters = append(params.Filters, &vpc.Filter{
+			Name:   helper.String("peering-connection-name"),
+			Values: []*string{helper.String(v.(string))},
+		})
+	}
+	if v, ok := d.GetOk("vpc_id"); ok {
+		params.Filters = append(params.Filters, &vpc.Filter{
+			Name:   helper.String("vpc-id"),
+			Values: []*string{helper.String(v.(string))},
+		})
+	}
+	if v, ok := d.GetOk("state"); ok {
+		params.Filters = append(params.Filters, &vpc.Filter{
+			Name:   helper.String("state"),
+			Values: []*string{helper.String(v.(string))},
+		})
+	}
+
+	peeringConnections, err := service.DescribeVpcPeeringConnections(ctx, params)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	peeringConnectionList := make([]map[string]interface{}, 0, len(peeringConnections))
+	ids := make([]string, 0, len(peeringConnections))
+	for _, item := range peeringConnections {
+		if helper.PString(item.State) == VPC_PEERING_CONNECTION_STATE_DELETED {
+			continue
+		}
+		peeringConnectionMap := map[string]interface{}{
+			"peering_connection_id":   item.PeeringConnectionId,
+			"peering_connection_name": item.PeeringConnectionName,
+			"source_vpc_id":           item.SourceVpcId,
+			"source_region":           item.SourceRegion,
+			"peer_vpc_id":             item.PeerVpcId,
+			"peer_region":             item.DestinationRegion,
+			"bandwidth":               item.Bandwidth,
+			"type":                    item.Type,
+			"charge_type":             item.ChargeType,
+			"qos_level":               item.QosLevel,
+			"state":                   item.State,
+			"create_time":             item.CreateTime,
+		}
+		if item.SourceUin != nil {
+			peeringConnectionMap["source_uin"] = strconv.FormatInt(*item.SourceUin, 10)
+		}
+		if item.DestinationUin != nil {
+			peeringConnectionMap["peer_uin"] = strconv.FormatInt(*item.DestinationUin, 10)
+		}
+		peeringConnectionList = append(peeringConnectionList, peeringConnectionMap)
+		ids = append(ids, helper.PString(item.PeeringConnectionId))
+	}
+
+	d.SetId(helper.DataResourceIdsHash(ids))
+	if err := d.Set("peering_connection_list", peeringConnectionList); err != nil {
+		log.Printf("[CRITAL]%s provider set vpc peering connection list fail, reason:%s\n", logId, err.Error())
+		return diag.FromErr(err)
+	}
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), peeringConnectionList); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTencentCloudVpcPeeringConnectionsDataSource_basic(t *testing.T) {
+	t.Parallel()
+	keyName := "data.tencentcloud_vpc_peering_connections.peering_connections"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcPeeringConnectionsDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID(keyName),
+					resource.TestCheckResourceAttr(keyName, "peering_connection_list.#", "1"),
+					resource.TestCheckResourceAttr(keyName, "peering_connection_list.0.peering_connection_name", "tf-ci-test-peering"),
+					resource.TestCheckResourceAttr(keyName, "peering_connection_list.0.bandwidth", "10"),
+					resource.TestCheckResourceAttrSet(keyName, "peering_connection_list.0.peering_connection_id"),
+					resource.TestCheckResourceAttrSet(keyName, "peering_connection_list.0.source_vpc_id"),
+					resource.TestCheckResourceAttrSet(keyName, "peering_connection_list.0.peer_vpc_id"),
+					resource.TestCheckResourceAttrSet(keyName, "peering_connection_list.0.state"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVpcPeeringConnectionsDataSource = testAccVpcPeeringConnection + `
+data "tencentcloud_vpc_peering_connections" "peering_connections" {
+  peering_connection_ids = [tencentcloud_vpc_peering_connection.example.id]
+}
+`
@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudVpcPrivateIpAddresses() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcPrivateIpAddressesRead,
+		ReadContext: dataSourceTencentCloudVpcPrivateIpAddressesRead,
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Required:    true,
@@ -80,13 +81,13 @@ func dataSourceTencentCloudVpcPrivateIpAddresses() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcPrivateIpAddressesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcPrivateIpAddressesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_private_ip_addresses.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("vpc_id"); ok {
@@ -102,7 +103,7 @@ func dataSourceTencentCloudVpcPrivateIpAddressesRead(d *schema.ResourceData, met
 
 	var vpcPrivateIpAddressSet []*vpc.VpcPrivateIpAddress
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcPrivateIpAddresses(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -111,7 +112,7 @@ func dataSourceTencentCloudVpcPrivateIpAddressesRead(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(vpcPrivateIpAddressSet))
@@ -148,7 +149,7 @@ func dataSourceTencentCloudVpcPrivateIpAddressesRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudVpcProductQuota() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcProductQuotaRead,
+		ReadContext: dataSourceTencentCloudVpcProductQuotaRead,
 		Schema: map[string]*schema.Schema{
 			"product": {
 				Required:    true,
@@ -74,13 +75,13 @@ func dataSourceTencentCloudVpcProductQuota() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcProductQuotaRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcProductQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_product_quota.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("product"); ok {
@@ -91,7 +92,7 @@ func dataSourceTencentCloudVpcProductQuotaRead(d *schema.ResourceData, meta inte
 
 	var productQuotaSet []*vpc.ProductQuota
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcProductQuota(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -100,7 +101,7 @@ func dataSourceTencentCloudVpcProductQuotaRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(productQuotaSet))
@@ -141,7 +142,7 @@ func dataSourceTencentCloudVpcProductQuotaRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
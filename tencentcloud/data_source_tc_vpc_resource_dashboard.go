@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudVpcResourceDashboard() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcResourceDashboardRead,
+		ReadContext: dataSourceTencentCloudVpcResourceDashboardRead,
 		Schema: map[string]*schema.Schema{
 			"vpc_ids": {
 				Required: true,
@@ -262,13 +263,13 @@ func dataSourceTencentCloudVpcResourceDashboard() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcResourceDashboardRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcResourceDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_resource_dashboard.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("vpc_ids"); ok {
@@ -280,7 +281,7 @@ func dataSourceTencentCloudVpcResourceDashboardRead(d *schema.ResourceData, meta
 
 	var resourceDashboardSet []*vpc.ResourceDashboard
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcResourceDashboard(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -289,7 +290,7 @@ func dataSourceTencentCloudVpcResourceDashboardRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(resourceDashboardSet))
@@ -478,7 +479,7 @@ func dataSourceTencentCloudVpcResourceDashboardRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
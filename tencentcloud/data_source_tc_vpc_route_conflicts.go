@@ -15,6 +15,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -23,7 +24,7 @@ import (
 
 func dataSourceTencentCloudVpcRouteConflicts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcRouteConflictsRead,
+		ReadContext: dataSourceTencentCloudVpcRouteConflictsRead,
 		Schema: map[string]*schema.Schema{
 			"route_table_id": {
 				Required:    true,
@@ -138,13 +139,13 @@ func dataSourceTencentCloudVpcRouteConflicts() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcRouteConflictsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcRouteConflictsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_route_conflicts.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("route_table_id"); ok {
@@ -160,7 +161,7 @@ func dataSourceTencentCloudVpcRouteConflictsRead(d *schema.ResourceData, meta in
 
 	var routeConflictSet []*vpc.RouteConflict
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcRouteConflicts(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -169,7 +170,7 @@ func dataSourceTencentCloudVpcRouteConflictsRead(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(routeConflictSet))
@@ -257,7 +258,7 @@ func dataSourceTencentCloudVpcRouteConflictsRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
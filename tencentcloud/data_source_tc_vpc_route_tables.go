@@ -49,6 +49,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -56,7 +57,7 @@ import (
 
 func dataSourceTencentCloudVpcRouteTables() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcRouteTablesRead,
+		ReadContext: dataSourceTencentCloudVpcRouteTablesRead,
 
 		Schema: map[string]*schema.Schema{
 			"route_table_id": {
@@ -181,11 +182,11 @@ func dataSourceTencentCloudVpcRouteTables() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcRouteTablesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcRouteTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_route_tables.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -224,7 +225,7 @@ func dataSourceTencentCloudVpcRouteTablesRead(d *schema.ResourceData, meta inter
 		err   error
 	)
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		infos, err = service.DescribeRouteTables(ctx, routeTableId, name, vpcId, tags, associationMain, tagKey)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -250,7 +251,7 @@ func dataSourceTencentCloudVpcRouteTablesRead(d *schema.ResourceData, meta inter
 
 		respTags, err := tagService.DescribeResourceTags(ctx, "vpc", "rtb", region, item.routeTableId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		var infoMap = make(map[string]interface{})
@@ -269,7 +270,7 @@ func dataSourceTencentCloudVpcRouteTablesRead(d *schema.ResourceData, meta inter
 
 	if err := d.Set("instance_list", infoList); err != nil {
 		log.Printf("[CRITAL]%s provider set  route table instances fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	idBytes, err := json.Marshal(map[string]interface{}{
@@ -282,7 +283,7 @@ func dataSourceTencentCloudVpcRouteTablesRead(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create data source id error, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	md := md5.New()
@@ -294,7 +295,7 @@ func dataSourceTencentCloudVpcRouteTablesRead(d *schema.ResourceData, meta inter
 		if err := writeToFile(output.(string), infoList); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
 				logId, output.(string), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudVpcSecurityGroupLimits() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcSecurityGroupLimitsRead,
+		ReadContext: dataSourceTencentCloudVpcSecurityGroupLimitsRead,
 		Schema: map[string]*schema.Schema{
 			"security_group_limit_set": {
 				Computed:    true,
@@ -81,20 +82,20 @@ func dataSourceTencentCloudVpcSecurityGroupLimits() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcSecurityGroupLimitsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcSecurityGroupLimitsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_security_group_limits.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var securityGroupLimitSet *vpc.SecurityGroupLimitSet
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcSecurityGroupLimits(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -103,7 +104,7 @@ func dataSourceTencentCloudVpcSecurityGroupLimitsRead(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0)
@@ -150,7 +151,7 @@ func dataSourceTencentCloudVpcSecurityGroupLimitsRead(d *schema.ResourceData, me
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), securityGroupLimitSetMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudVpcSecurityGroupReferences() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcSecurityGroupReferencesRead,
+		ReadContext: dataSourceTencentCloudVpcSecurityGroupReferencesRead,
 		Schema: map[string]*schema.Schema{
 			"security_group_ids": {
 				Required: true,
@@ -65,13 +66,13 @@ func dataSourceTencentCloudVpcSecurityGroupReferences() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcSecurityGroupReferencesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcSecurityGroupReferencesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_security_group_references.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("security_group_ids"); ok {
@@ -83,7 +84,7 @@ func dataSourceTencentCloudVpcSecurityGroupReferencesRead(d *schema.ResourceData
 
 	var referredSecurityGroupSet []*vpc.ReferredSecurityGroup
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcSecurityGroupReferences(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -92,7 +93,7 @@ func dataSourceTencentCloudVpcSecurityGroupReferencesRead(d *schema.ResourceData
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(referredSecurityGroupSet))
@@ -121,7 +122,7 @@ func dataSourceTencentCloudVpcSecurityGroupReferencesRead(d *schema.ResourceData
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -16,6 +16,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -24,7 +25,7 @@ import (
 
 func dataSourceTencentCloudVpcSgSnapshotFileContent() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcSgSnapshotFileContentRead,
+		ReadContext: dataSourceTencentCloudVpcSgSnapshotFileContentRead,
 		Schema: map[string]*schema.Schema{
 			"snapshot_policy_id": {
 				Required:    true,
@@ -257,13 +258,13 @@ func dataSourceTencentCloudVpcSgSnapshotFileContent() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcSgSnapshotFileContentRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcSgSnapshotFileContentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_sg_snapshot_file_content.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("snapshot_policy_id"); ok {
@@ -282,7 +283,7 @@ func dataSourceTencentCloudVpcSgSnapshotFileContentRead(d *schema.ResourceData,
 
 	var content *vpc.DescribeSgSnapshotFileContentResponseParams
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcSgSnapshotFileContent(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -291,7 +292,7 @@ func dataSourceTencentCloudVpcSgSnapshotFileContentRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0)
@@ -463,7 +464,7 @@ func dataSourceTencentCloudVpcSgSnapshotFileContentRead(d *schema.ResourceData,
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudVpcSnapshotFiles() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcSnapshotFilesRead,
+		ReadContext: dataSourceTencentCloudVpcSnapshotFilesRead,
 		Schema: map[string]*schema.Schema{
 			"business_type": {
 				Required:    true,
@@ -95,13 +96,13 @@ func dataSourceTencentCloudVpcSnapshotFiles() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcSnapshotFilesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcSnapshotFilesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_snapshot_files.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("business_type"); ok {
@@ -124,7 +125,7 @@ func dataSourceTencentCloudVpcSnapshotFilesRead(d *schema.ResourceData, meta int
 
 	var snapshotFileSet []*vpc.SnapshotFileInfo
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcSnapshotFilesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -133,7 +134,7 @@ func dataSourceTencentCloudVpcSnapshotFilesRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(snapshotFileSet))
@@ -174,7 +175,7 @@ func dataSourceTencentCloudVpcSnapshotFilesRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudVpcSubnetResourceDashboard() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcSubnetResourceDashboardRead,
+		ReadContext: dataSourceTencentCloudVpcSubnetResourceDashboardRead,
 		Schema: map[string]*schema.Schema{
 			"subnet_ids": {
 				Required: true,
@@ -91,13 +92,13 @@ func dataSourceTencentCloudVpcSubnetResourceDashboard() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcSubnetResourceDashboardRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcSubnetResourceDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_subnet_resource_dashboard.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("subnet_ids"); ok {
@@ -109,7 +110,7 @@ func dataSourceTencentCloudVpcSubnetResourceDashboardRead(d *schema.ResourceData
 
 	var resourceStatisticsSet []*vpc.ResourceStatistics
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcSubnetResourceDashboardByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -118,7 +119,7 @@ func dataSourceTencentCloudVpcSubnetResourceDashboardRead(d *schema.ResourceData
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(resourceStatisticsSet))
@@ -174,7 +175,7 @@ func dataSourceTencentCloudVpcSubnetResourceDashboardRead(d *schema.ResourceData
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
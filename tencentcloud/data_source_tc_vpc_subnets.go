@@ -47,6 +47,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -54,7 +55,7 @@ import (
 
 func dataSourceTencentCloudVpcSubnets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcSubnetsRead,
+		ReadContext: dataSourceTencentCloudVpcSubnetsRead,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -176,11 +177,11 @@ func dataSourceTencentCloudVpcSubnets() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcSubnetsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcSubnetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_subnets.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	vpcService := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -234,7 +235,7 @@ func dataSourceTencentCloudVpcSubnetsRead(d *schema.ResourceData, meta interface
 		err   error
 	)
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		infos, err = vpcService.DescribeSubnets(ctx, subnetId, vpcId,
 			name, availabilityZone, tags,
 			isDefault, isRemoteVpcSNAT, tagKey,
@@ -246,7 +247,7 @@ func dataSourceTencentCloudVpcSubnetsRead(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var infoList = make([]map[string]interface{}, 0, len(infos))
@@ -254,7 +255,7 @@ func dataSourceTencentCloudVpcSubnetsRead(d *schema.ResourceData, meta interface
 	for _, item := range infos {
 		respTags, err := tagService.DescribeResourceTags(ctx, "vpc", "subnet", region, item.subnetId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		var infoMap = make(map[string]interface{})
@@ -276,7 +277,7 @@ func dataSourceTencentCloudVpcSubnetsRead(d *schema.ResourceData, meta interface
 
 	if err := d.Set("instance_list", infoList); err != nil {
 		log.Printf("[CRITAL]%s provider set  subnet instances fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	idBytes, err := json.Marshal(map[string]interface{}{
@@ -292,7 +293,7 @@ func dataSourceTencentCloudVpcSubnetsRead(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create data source id error, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	md := md5.New()
@@ -304,7 +305,7 @@ func dataSourceTencentCloudVpcSubnetsRead(d *schema.ResourceData, meta interface
 		if err := writeToFile(output.(string), infoList); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
 				logId, output.(string), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
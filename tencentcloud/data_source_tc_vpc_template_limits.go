@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudVpcTemplateLimits() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcTemplateLimitsRead,
+		ReadContext: dataSourceTencentCloudVpcTemplateLimitsRead,
 		Schema: map[string]*schema.Schema{
 			"template_limit": {
 				Computed:    true,
@@ -61,19 +62,19 @@ func dataSourceTencentCloudVpcTemplateLimits() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcTemplateLimitsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcTemplateLimitsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_template_limits.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var templateLimit *vpc.TemplateLimit
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcTemplateLimits(ctx)
 		if e != nil {
 			return retryError(e)
@@ -82,7 +83,7 @@ func dataSourceTencentCloudVpcTemplateLimitsRead(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0)
@@ -114,7 +115,7 @@ func dataSourceTencentCloudVpcTemplateLimitsRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), templateLimitMap); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
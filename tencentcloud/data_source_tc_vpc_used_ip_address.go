@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudVpcUsedIpAddress() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpcUsedIpAddressRead,
+		ReadContext: dataSourceTencentCloudVpcUsedIpAddressRead,
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Required:    true,
@@ -89,13 +90,13 @@ func dataSourceTencentCloudVpcUsedIpAddress() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpcUsedIpAddressRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpcUsedIpAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_used_ip_address.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("vpc_id"); ok {
@@ -115,7 +116,7 @@ func dataSourceTencentCloudVpcUsedIpAddressRead(d *schema.ResourceData, meta int
 
 	var ipAddressStates []*vpc.IpAddressStates
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpcUsedIpAddressByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -124,7 +125,7 @@ func dataSourceTencentCloudVpcUsedIpAddressRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(ipAddressStates))
@@ -165,7 +166,7 @@ func dataSourceTencentCloudVpcUsedIpAddressRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -23,6 +23,7 @@ import (
 	"log"
 	"reflect"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -31,7 +32,7 @@ import (
 
 func dataSourceTencentCloudVpnConnections() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpnConnectionsRead,
+		ReadContext: dataSourceTencentCloudVpnConnectionsRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -255,11 +256,11 @@ func dataSourceTencentCloudVpnConnections() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpnConnectionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpnConnectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpn_connections.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 	region := meta.(*TencentCloudClient).apiV3Conn.Region
@@ -300,8 +301,8 @@ func dataSourceTencentCloudVpnConnectionsRead(d *schema.ResourceData, meta inter
 	request.Limit = &limit
 	for {
 		var response *vpc.DescribeVpnConnectionsResponse
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnConnections(request)
+		err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnConnectionsWithContext(ctx, request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -312,7 +313,7 @@ func dataSourceTencentCloudVpnConnectionsRead(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s read VPN connection failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		} else {
 			result = append(result, response.Response.VpnConnectionSet...)
 			if len(response.Response.VpnConnectionSet) < VPN_DESCRIBE_LIMIT {
@@ -328,7 +329,7 @@ func dataSourceTencentCloudVpnConnectionsRead(d *schema.ResourceData, meta inter
 		//tags
 		respTags, err := tagService.DescribeResourceTags(ctx, "vpc", "vpnx", region, *connection.VpnConnectionId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if len(tags) > 0 {
 			if !reflect.DeepEqual(respTags, tags) {
@@ -373,13 +374,13 @@ func dataSourceTencentCloudVpnConnectionsRead(d *schema.ResourceData, meta inter
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("connection_list", connectionList); e != nil {
 		log.Printf("[CRITAL]%s provider set connection list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), connectionList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -21,6 +21,7 @@ import (
 	"log"
 	"reflect"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -29,7 +30,7 @@ import (
 
 func dataSourceTencentCloudVpnCustomerGateways() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpnCustomerGatewaysRead,
+		ReadContext: dataSourceTencentCloudVpnCustomerGatewaysRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -99,11 +100,11 @@ func dataSourceTencentCloudVpnCustomerGateways() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpnCustomerGatewaysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpnCustomerGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_customer_gateways.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 	region := meta.(*TencentCloudClient).apiV3Conn.Region
@@ -137,8 +138,8 @@ func dataSourceTencentCloudVpnCustomerGatewaysRead(d *schema.ResourceData, meta
 	request.Limit = &limit
 	for {
 		var response *vpc.DescribeCustomerGatewaysResponse
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeCustomerGateways(request)
+		err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeCustomerGatewaysWithContext(ctx, request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -149,7 +150,7 @@ func dataSourceTencentCloudVpnCustomerGatewaysRead(d *schema.ResourceData, meta
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s read VPN customer gateway failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		} else {
 			result = append(result, response.Response.CustomerGatewaySet...)
 			if len(response.Response.CustomerGatewaySet) < VPN_DESCRIBE_LIMIT {
@@ -165,7 +166,7 @@ func dataSourceTencentCloudVpnCustomerGatewaysRead(d *schema.ResourceData, meta
 		//tags
 		respTags, err := tagService.DescribeResourceTags(ctx, "vpc", "cgw", region, *gateway.CustomerGatewayId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if len(tags) > 0 {
 			if !reflect.DeepEqual(respTags, tags) {
@@ -186,13 +187,13 @@ func dataSourceTencentCloudVpnCustomerGatewaysRead(d *schema.ResourceData, meta
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("gateway_list", gatewayList); e != nil {
 		log.Printf("[CRITAL]%s provider set gateway list fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), gatewayList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudVpnDefaultHealthCheckIp() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpnDefaultHealthCheckIpRead,
+		ReadContext: dataSourceTencentCloudVpnDefaultHealthCheckIpRead,
 		Schema: map[string]*schema.Schema{
 			"vpn_gateway_id": {
 				Required:    true,
@@ -51,13 +52,13 @@ func dataSourceTencentCloudVpnDefaultHealthCheckIp() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpnDefaultHealthCheckIpRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpnDefaultHealthCheckIpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpn_default_health_check_ip.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var vpnGwId string
 	res := make(map[string]interface{})
@@ -72,7 +73,7 @@ func dataSourceTencentCloudVpnDefaultHealthCheckIpRead(d *schema.ResourceData, m
 
 	var defaultHealthCheck *vpc.GenerateVpnConnectionDefaultHealthCheckIpResponseParams
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeVpnDefaultHealthCheckIp(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -81,7 +82,7 @@ func dataSourceTencentCloudVpnDefaultHealthCheckIpRead(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if defaultHealthCheck.HealthCheckLocalIp != nil {
@@ -98,7 +99,7 @@ func dataSourceTencentCloudVpnDefaultHealthCheckIpRead(d *schema.ResourceData, m
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), res); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
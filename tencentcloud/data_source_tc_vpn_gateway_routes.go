@@ -21,6 +21,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -28,7 +29,7 @@ import (
 
 func dataSourceTencentCloudVpnGatewayRoutes() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpnGatewayRoutesRead,
+		ReadContext: dataSourceTencentCloudVpnGatewayRoutesRead,
 
 		Schema: map[string]*schema.Schema{
 			"vpn_gateway_id": {
@@ -70,15 +71,15 @@ func dataSourceTencentCloudVpnGatewayRoutes() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpnGatewayRoutesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpnGatewayRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpn_gateway_routes.read")()
 
 	var (
 		logId        = getLogId(contextNil)
-		ctx          = context.WithValue(context.TODO(), logIdKey, logId)
 		vpnGatewayId = d.Get("vpn_gateway_id").(string)
 		vpcService   = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params := make(map[string]string)
 	if v, ok := d.GetOk("destination_cidr"); ok {
@@ -102,7 +103,7 @@ func dataSourceTencentCloudVpnGatewayRoutesRead(d *schema.ResourceData, meta int
 	err, result := vpcService.DescribeVpnGatewayRoutes(ctx, vpnGatewayId, filters)
 	if err != nil {
 		log.Printf("[CRITAL]%s read VPN gateway routes failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	ids := make([]string, 0, len(result))
 	routeList := make([]map[string]interface{}, 0, len(result))
@@ -113,13 +114,13 @@ func dataSourceTencentCloudVpnGatewayRoutesRead(d *schema.ResourceData, meta int
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("vpn_gateway_route_list", routeList); e != nil {
 		log.Printf("[CRITAL]%s provider set vpn gateway route list fail, reason:%s\n ", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), routeList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
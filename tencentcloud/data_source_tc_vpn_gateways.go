@@ -23,6 +23,7 @@ import (
 	"log"
 	"reflect"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -31,7 +32,7 @@ import (
 
 func dataSourceTencentCloudVpnGateways() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudVpnGatewaysRead,
+		ReadContext: dataSourceTencentCloudVpnGatewaysRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -166,11 +167,11 @@ func dataSourceTencentCloudVpnGateways() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudVpnGatewaysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudVpnGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpn_gateways.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 	region := meta.(*TencentCloudClient).apiV3Conn.Region
@@ -211,8 +212,8 @@ func dataSourceTencentCloudVpnGatewaysRead(d *schema.ResourceData, meta interfac
 	request.Limit = &limit
 	for {
 		var response *vpc.DescribeVpnGatewaysResponse
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnGateways(request)
+		err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnGatewaysWithContext(ctx, request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -223,7 +224,7 @@ func dataSourceTencentCloudVpnGatewaysRead(d *schema.ResourceData, meta interfac
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s read VPN gateway failed, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		} else {
 			result = append(result, response.Response.VpnGatewaySet...)
 			if len(response.Response.VpnGatewaySet) < VPN_DESCRIBE_LIMIT {
@@ -239,7 +240,7 @@ func dataSourceTencentCloudVpnGatewaysRead(d *schema.ResourceData, meta interfac
 		//tags
 		respTags, err := tagService.DescribeResourceTags(ctx, "vpc", "vpngw", region, *gateway.VpnGatewayId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if len(tags) > 0 {
 			if !reflect.DeepEqual(respTags, tags) {
@@ -270,13 +271,13 @@ func dataSourceTencentCloudVpnGatewaysRead(d *schema.ResourceData, meta interfac
 	d.SetId(helper.DataResourceIdsHash(ids))
 	if e := d.Set("gateway_list", gatewayList); e != nil {
 		log.Printf("[CRITAL]%s provider set gateway list fail, reason:%s\n ", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), gatewayList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
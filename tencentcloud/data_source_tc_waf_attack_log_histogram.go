@@ -32,6 +32,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	waf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
@@ -40,7 +41,7 @@ import (
 
 func dataSourceTencentCloudWafAttackLogHistogram() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudWafAttackLogHistogramRead,
+		ReadContext: dataSourceTencentCloudWafAttackLogHistogramRead,
 		Schema: map[string]*schema.Schema{
 			"domain": {
 				Required:    true,
@@ -100,16 +101,16 @@ func dataSourceTencentCloudWafAttackLogHistogram() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudWafAttackLogHistogramRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudWafAttackLogHistogramRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_waf_attack_log_histogram.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 		data    *waf.GetAttackHistogramResponseParams
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("domain"); ok {
@@ -128,7 +129,7 @@ func dataSourceTencentCloudWafAttackLogHistogramRead(d *schema.ResourceData, met
 		paramMap["QueryString"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeWafAttackLogHistogramByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -139,7 +140,7 @@ func dataSourceTencentCloudWafAttackLogHistogramRead(d *schema.ResourceData, met
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if data.Period != nil {
@@ -174,7 +175,7 @@ func dataSourceTencentCloudWafAttackLogHistogramRead(d *schema.ResourceData, met
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -38,6 +38,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	waf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
@@ -46,7 +47,7 @@ import (
 
 func dataSourceTencentCloudWafAttackLogList() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudWafAttackLogListRead,
+		ReadContext: dataSourceTencentCloudWafAttackLogListRead,
 		Schema: map[string]*schema.Schema{
 			"domain": {
 				Required:    true,
@@ -124,16 +125,16 @@ func dataSourceTencentCloudWafAttackLogList() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudWafAttackLogListRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudWafAttackLogListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_waf_attack_log_list.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		service       = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 		attackLogList []*waf.AttackLogInfo
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("domain"); ok {
@@ -164,7 +165,7 @@ func dataSourceTencentCloudWafAttackLogListRead(d *schema.ResourceData, meta int
 		paramMap["Page"] = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeWafAttackLogListByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -175,7 +176,7 @@ func dataSourceTencentCloudWafAttackLogListRead(d *schema.ResourceData, meta int
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(attackLogList))
@@ -210,7 +211,7 @@ func dataSourceTencentCloudWafAttackLogListRead(d *schema.ResourceData, meta int
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -32,6 +32,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	waf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
@@ -40,7 +41,7 @@ import (
 
 func dataSourceTencentCloudWafAttackOverview() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudWafAttackOverviewRead,
+		ReadContext: dataSourceTencentCloudWafAttackOverviewRead,
 		Schema: map[string]*schema.Schema{
 			"from_time": {
 				Required:    true,
@@ -117,16 +118,16 @@ func dataSourceTencentCloudWafAttackOverview() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudWafAttackOverviewRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudWafAttackOverviewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_waf_attack_overview.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId          = getLogId(contextNil)
-		ctx            = context.WithValue(context.TODO(), logIdKey, logId)
 		service        = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 		attackOverview *waf.DescribeAttackOverviewResponseParams
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("from_time"); ok {
@@ -153,7 +154,7 @@ func dataSourceTencentCloudWafAttackOverviewRead(d *schema.ResourceData, meta in
 		paramMap["InstanceID"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeWafAttackOverviewByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -164,7 +165,7 @@ func dataSourceTencentCloudWafAttackOverviewRead(d *schema.ResourceData, meta in
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if attackOverview.AccessCount != nil {
@@ -199,7 +200,7 @@ func dataSourceTencentCloudWafAttackOverviewRead(d *schema.ResourceData, meta in
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -32,6 +32,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	waf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
@@ -40,7 +41,7 @@ import (
 
 func dataSourceTencentCloudWafAttackTotalCount() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudWafAttackTotalCountRead,
+		ReadContext: dataSourceTencentCloudWafAttackTotalCountRead,
 		Schema: map[string]*schema.Schema{
 			"start_time": {
 				Required:    true,
@@ -77,16 +78,16 @@ func dataSourceTencentCloudWafAttackTotalCount() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudWafAttackTotalCountRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudWafAttackTotalCountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_waf_attack_total_count.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId            = getLogId(contextNil)
-		ctx              = context.WithValue(context.TODO(), logIdKey, logId)
 		service          = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 		attackTotalCount *waf.GetAttackTotalCountResponseParams
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("start_time"); ok {
@@ -105,7 +106,7 @@ func dataSourceTencentCloudWafAttackTotalCountRead(d *schema.ResourceData, meta
 		paramMap["QueryString"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeWafAttackTotalCountByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -116,7 +117,7 @@ func dataSourceTencentCloudWafAttackTotalCountRead(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if attackTotalCount.TotalCount != nil {
@@ -127,7 +128,7 @@ func dataSourceTencentCloudWafAttackTotalCountRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	waf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudWafCiphers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudWafCiphersRead,
+		ReadContext: dataSourceTencentCloudWafCiphersRead,
 		Schema: map[string]*schema.Schema{
 			"ciphers": {
 				Computed:    true,
@@ -55,18 +56,18 @@ func dataSourceTencentCloudWafCiphers() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudWafCiphersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudWafCiphersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_waf_ciphers.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 		ciphers []*waf.TLSCiphers
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeWafCiphersByFilter(ctx)
 		if e != nil {
 			return retryError(e)
@@ -77,7 +78,7 @@ func dataSourceTencentCloudWafCiphersRead(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(ciphers))
@@ -110,7 +111,7 @@ func dataSourceTencentCloudWafCiphersRead(d *schema.ResourceData, meta interface
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -23,6 +23,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	waf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
@@ -31,7 +32,7 @@ import (
 
 func dataSourceTencentCloudWafDomains() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudWafDomainsRead,
+		ReadContext: dataSourceTencentCloudWafDomainsRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Optional:    true,
@@ -300,18 +301,18 @@ func dataSourceTencentCloudWafDomains() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudWafDomainsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudWafDomainsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_waf_domains.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 		domains    []*waf.DomainInfo
 		instanceID string
 		domain     string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("instance_id"); ok {
 		instanceID = v.(string)
@@ -321,7 +322,7 @@ func dataSourceTencentCloudWafDomainsRead(d *schema.ResourceData, meta interface
 		domain = v.(string)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeWafDomainsByFilter(ctx, instanceID, domain)
 		if e != nil {
 			return retryError(e)
@@ -332,7 +333,7 @@ func dataSourceTencentCloudWafDomainsRead(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(domains))
@@ -547,7 +548,7 @@ func dataSourceTencentCloudWafDomainsRead(d *schema.ResourceData, meta interface
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -25,6 +25,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	waf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
@@ -33,7 +34,7 @@ import (
 
 func dataSourceTencentCloudWafFindDomains() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudWafFindDomainsRead,
+		ReadContext: dataSourceTencentCloudWafFindDomainsRead,
 		Schema: map[string]*schema.Schema{
 			"key": {
 				Optional:    true,
@@ -115,16 +116,16 @@ func dataSourceTencentCloudWafFindDomains() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudWafFindDomainsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudWafFindDomainsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_waf_find_domains.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 		list    []*waf.FindAllDomainDetail
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("key"); ok {
@@ -147,7 +148,7 @@ func dataSourceTencentCloudWafFindDomainsRead(d *schema.ResourceData, meta inter
 		paramMap["Order"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeWafFindDomainsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -158,7 +159,7 @@ func dataSourceTencentCloudWafFindDomainsRead(d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(list))
@@ -211,7 +212,7 @@ func dataSourceTencentCloudWafFindDomainsRead(d *schema.ResourceData, meta inter
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -14,6 +14,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	waf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
@@ -22,7 +23,7 @@ import (
 
 func dataSourceTencentCloudWafInstanceQpsLimit() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudWafInstanceQpsLimitRead,
+		ReadContext: dataSourceTencentCloudWafInstanceQpsLimitRead,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -77,17 +78,17 @@ func dataSourceTencentCloudWafInstanceQpsLimit() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudWafInstanceQpsLimitRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudWafInstanceQpsLimitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_waf_instance_qps_limit.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 		qpsData    *waf.QpsData
 		instanceId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -99,7 +100,7 @@ func dataSourceTencentCloudWafInstanceQpsLimitRead(d *schema.ResourceData, meta
 		paramMap["Type"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeWafInstanceQpsLimitByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -110,7 +111,7 @@ func dataSourceTencentCloudWafInstanceQpsLimitRead(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if qpsData != nil {
@@ -145,7 +146,7 @@ func dataSourceTencentCloudWafInstanceQpsLimitRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -32,6 +32,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	waf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
@@ -40,7 +41,7 @@ import (
 
 func dataSourceTencentCloudWafPeakPoints() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudWafPeakPointsRead,
+		ReadContext: dataSourceTencentCloudWafPeakPointsRead,
 		Schema: map[string]*schema.Schema{
 			"from_time": {
 				Required:    true,
@@ -161,16 +162,16 @@ func dataSourceTencentCloudWafPeakPoints() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudWafPeakPointsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudWafPeakPointsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_waf_peak_points.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 		points  []*waf.PeakPointsItem
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("from_time"); ok {
@@ -197,7 +198,7 @@ func dataSourceTencentCloudWafPeakPointsRead(d *schema.ResourceData, meta interf
 		paramMap["MetricName"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeWafPeakPointsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -208,7 +209,7 @@ func dataSourceTencentCloudWafPeakPointsRead(d *schema.ResourceData, meta interf
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpList := make([]map[string]interface{}, 0, len(points))
@@ -283,7 +284,7 @@ func dataSourceTencentCloudWafPeakPointsRead(d *schema.ResourceData, meta interf
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -21,6 +21,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	waf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
@@ -29,7 +30,7 @@ import (
 
 func dataSourceTencentCloudWafPorts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudWafPortsRead,
+		ReadContext: dataSourceTencentCloudWafPortsRead,
 		Schema: map[string]*schema.Schema{
 			"edition": {
 				Optional:    true,
@@ -62,16 +63,16 @@ func dataSourceTencentCloudWafPorts() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudWafPortsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudWafPortsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_waf_ports.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 		ports   *waf.DescribePortsResponseParams
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("edition"); ok {
@@ -82,7 +83,7 @@ func dataSourceTencentCloudWafPortsRead(d *schema.ResourceData, meta interface{}
 		paramMap["InstanceID"] = helper.String(v.(string))
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeWafPortsByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -93,7 +94,7 @@ func dataSourceTencentCloudWafPortsRead(d *schema.ResourceData, meta interface{}
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ports.HttpPorts != nil {
@@ -108,7 +109,7 @@ func dataSourceTencentCloudWafPortsRead(d *schema.ResourceData, meta interface{}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), d); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	waf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudWafTlsVersions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudWafTlsVersionsRead,
+		ReadContext: dataSourceTencentCloudWafTlsVersionsRead,
 		Schema: map[string]*schema.Schema{
 			"tls": {
 				Computed:    true,
@@ -50,18 +51,18 @@ func dataSourceTencentCloudWafTlsVersions() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudWafTlsVersionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudWafTlsVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_waf_tls_versions.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 		tLS     []*waf.TLSVersion
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeWafTlsVersionsByFilter(ctx)
 		if e != nil {
 			return retryError(e)
@@ -72,7 +73,7 @@ func dataSourceTencentCloudWafTlsVersionsRead(d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(tLS))
@@ -101,7 +102,7 @@ func dataSourceTencentCloudWafTlsVersionsRead(d *schema.ResourceData, meta inter
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
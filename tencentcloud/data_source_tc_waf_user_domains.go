@@ -12,6 +12,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	waf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceTencentCloudWafUserDomains() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudWafUserDomainsRead,
+		ReadContext: dataSourceTencentCloudWafUserDomainsRead,
 		Schema: map[string]*schema.Schema{
 			"users_info": {
 				Computed:    true,
@@ -85,18 +86,18 @@ func dataSourceTencentCloudWafUserDomains() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudWafUserDomainsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudWafUserDomainsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_waf_user_domains.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 		usersInfo []*waf.UserDomainInfo
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeWafUserDomainsByFilter(ctx)
 		if e != nil {
 			return retryError(e)
@@ -107,7 +108,7 @@ func dataSourceTencentCloudWafUserDomainsRead(d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(usersInfo))
@@ -164,7 +165,7 @@ func dataSourceTencentCloudWafUserDomainsRead(d *schema.ResourceData, meta inter
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -28,6 +28,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	waf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
@@ -36,7 +37,7 @@ import (
 
 func dataSourceTencentCloudWafWafInfos() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudWafWafInfosRead,
+		ReadContext: dataSourceTencentCloudWafWafInfosRead,
 		Schema: map[string]*schema.Schema{
 			"params": {
 				Required:    true,
@@ -164,16 +165,16 @@ func dataSourceTencentCloudWafWafInfos() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudWafWafInfosRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudWafWafInfosRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_waf_waf_infos.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId    = getLogId(contextNil)
-		ctx      = context.WithValue(context.TODO(), logIdKey, logId)
 		service  = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 		hostList []*waf.ClbHostResult
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOk("params"); ok {
@@ -202,7 +203,7 @@ func dataSourceTencentCloudWafWafInfosRead(d *schema.ResourceData, meta interfac
 		paramMap["Params"] = tmpSet
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeWafWafInfosByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -213,7 +214,7 @@ func dataSourceTencentCloudWafWafInfosRead(d *schema.ResourceData, meta interfac
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(hostList))
@@ -300,7 +301,7 @@ func dataSourceTencentCloudWafWafInfosRead(d *schema.ResourceData, meta interfac
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
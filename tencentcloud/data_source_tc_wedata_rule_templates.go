@@ -17,6 +17,7 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	wedata "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/wedata/v20210820"
@@ -25,7 +26,7 @@ import (
 
 func dataSourceTencentCloudWedataRuleTemplates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTencentCloudWedataRuleTemplatesRead,
+		ReadContext: dataSourceTencentCloudWedataRuleTemplatesRead,
 		Schema: map[string]*schema.Schema{
 			"type": {
 				Optional:    true,
@@ -166,13 +167,13 @@ func dataSourceTencentCloudWedataRuleTemplates() *schema.Resource {
 	}
 }
 
-func dataSourceTencentCloudWedataRuleTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTencentCloudWedataRuleTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_wedata_rule_templates.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	if v, ok := d.GetOkExists("type"); ok {
@@ -201,7 +202,7 @@ func dataSourceTencentCloudWedataRuleTemplatesRead(d *schema.ResourceData, meta
 
 	var data []*wedata.RuleTemplate
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeWedataRuleTemplatesByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -210,7 +211,7 @@ func dataSourceTencentCloudWedataRuleTemplatesRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ids := make([]string, 0, len(data))
@@ -303,7 +304,7 @@ func dataSourceTencentCloudWedataRuleTemplatesRead(d *schema.ResourceData, meta
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
 		if e := writeToFile(output.(string), tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 	return nil
@@ -1,5 +1,7 @@
 package tencentcloud
 
+import "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+
 const (
 	CVM_CHARGE_TYPE_PREPAID  = "PREPAID"
 	CVM_CHARGE_TYPE_POSTPAID = "POSTPAID_BY_HOUR"
@@ -32,6 +34,7 @@ const (
 	CVM_DISK_TYPE_CLOUD_PREMIUM = "CLOUD_PREMIUM"
 	CVM_DISK_TYPE_CLOUD_BSSD    = "CLOUD_BSSD"
 	CVM_DISK_TYPE_CLOUD_HSSD    = "CLOUD_HSSD"
+	CVM_DISK_TYPE_CLOUD_TSSD    = "CLOUD_TSSD"
 
 	CVM_PLACEMENT_GROUP_TYPE_HOST = "HOST"
 	CVM_PLACEMENT_GROUP_TYPE_SW   = "SW"
@@ -104,6 +107,24 @@ var CVM_DISK_TYPE = []string{
 	CVM_DISK_TYPE_CLOUD_BSSD,
 }
 
+// the size ranges (GB) of the cloud disks, see https://intl.cloud.tencent.com/document/product/362/2353
+var CVM_SYSTEM_DISK_SIZE_RANGE = map[string]helper.IntRange{
+	CVM_DISK_TYPE_CLOUD_BASIC:   {Min: 20, Max: 1024},
+	CVM_DISK_TYPE_CLOUD_PREMIUM: {Min: 20, Max: 1024},
+	CVM_DISK_TYPE_CLOUD_SSD:     {Min: 20, Max: 1024},
+	CVM_DISK_TYPE_CLOUD_BSSD:    {Min: 20, Max: 1024},
+	CVM_DISK_TYPE_CLOUD_HSSD:    {Min: 20, Max: 1024},
+}
+
+var CVM_DATA_DISK_SIZE_RANGE = map[string]helper.IntRange{
+	CVM_DISK_TYPE_CLOUD_BASIC:   {Min: 10, Max: 32000},
+	CVM_DISK_TYPE_CLOUD_PREMIUM: {Min: 10, Max: 32000},
+	CVM_DISK_TYPE_CLOUD_SSD:     {Min: 20, Max: 32000},
+	CVM_DISK_TYPE_CLOUD_BSSD:    {Min: 20, Max: 32000},
+	CVM_DISK_TYPE_CLOUD_HSSD:    {Min: 20, Max: 32000},
+	CVM_DISK_TYPE_CLOUD_TSSD:    {Min: 20, Max: 32000},
+}
+
 var CVM_PLACEMENT_GROUP_TYPE = []string{
 	CVM_PLACEMENT_GROUP_TYPE_HOST,
 	CVM_PLACEMENT_GROUP_TYPE_SW,
@@ -325,3 +325,98 @@ var DPD_ACTIONS = []string{
 	DPD_ACTION_CLEAR,
 	DPD_ACTION_RESTART,
 }
+
+/*
+PEERING CONNECTION
+*/
+const (
+	VPC_PEERING_CONNECTION_STATE_PENDING  = "PENDING"
+	VPC_PEERING_CONNECTION_STATE_ACTIVE   = "ACTIVE"
+	VPC_PEERING_CONNECTION_STATE_EXPIRED  = "EXPIRED"
+	VPC_PEERING_CONNECTION_STATE_REJECTED = "REJECTED"
+	VPC_PEERING_CONNECTION_STATE_DELETED  = "DELETED"
+)
+
+// VPC_PEERING_CONNECTION_FAILED_STATES are the states from which the peering connection never gets active
+var VPC_PEERING_CONNECTION_FAILED_STATES = []string{
+	VPC_PEERING_CONNECTION_STATE_REJECTED,
+	VPC_PEERING_CONNECTION_STATE_EXPIRED,
+}
+
+const (
+	VPC_PEERING_CONNECTION_TYPE_VPC_PEER    = "VPC_PEER"
+	VPC_PEERING_CONNECTION_TYPE_VPC_BM_PEER = "VPC_BM_PEER"
+)
+
+var VPC_PEERING_CONNECTION_TYPES = []string{
+	VPC_PEERING_CONNECTION_TYPE_VPC_PEER,
+	VPC_PEERING_CONNECTION_TYPE_VPC_BM_PEER,
+}
+
+var VPC_PEERING_CONNECTION_CHARGE_TYPES = []string{
+	"POSTPAID_BY_DAY_MAX",
+	"POSTPAID_BY_MONTH_95",
+}
+
+var VPC_PEERING_CONNECTION_QOS_LEVELS = []string{
+	"PT",
+	"AU",
+	"AG",
+}
+
+const VPC_PEERING_CONNECTION_DESCRIBE_LIMIT = 100
+
+/*
+NETWORK ACL ENTRY
+*/
+const (
+	VPC_ACL_ENTRY_PROTOCOL_TCP  = "TCP"
+	VPC_ACL_ENTRY_PROTOCOL_UDP  = "UDP"
+	VPC_ACL_ENTRY_PROTOCOL_ICMP = "ICMP"
+	VPC_ACL_ENTRY_PROTOCOL_ALL  = "ALL"
+)
+
+var VPC_ACL_ENTRY_PROTOCOLS = []string{
+	VPC_ACL_ENTRY_PROTOCOL_TCP,
+	VPC_ACL_ENTRY_PROTOCOL_UDP,
+	VPC_ACL_ENTRY_PROTOCOL_ICMP,
+	VPC_ACL_ENTRY_PROTOCOL_ALL,
+}
+
+var VPC_ACL_ENTRY_ACTIONS = []string{
+	"ACCEPT",
+	"DROP",
+}
+
+const VPC_ACL_ENTRY_PORT_ALL = "ALL"
+
+/*
+IPV6 TRANSLATOR
+*/
+const (
+	VPC_IP6_TRANSLATOR_STATUS_CREATING  = "CREATING"
+	VPC_IP6_TRANSLATOR_STATUS_RUNNING   = "RUNNING"
+	VPC_IP6_TRANSLATOR_STATUS_DELETING  = "DELETING"
+	VPC_IP6_TRANSLATOR_STATUS_MODIFYING = "MODIFYING"
+)
+
+var VPC_IP6_TRANSLATOR_ISPS = []string{
+	"CMCC",
+	"CTCC",
+	"CUCC",
+	"BGP",
+}
+
+var VPC_IP6_RULE_PROTOCOLS = []string{
+	"TCP",
+	"UDP",
+}
+
+const VPC_IP6_TRANSLATOR_DESCRIBE_LIMIT = 100
+
+const (
+	VPC_IP6_TRANSLATOR_NOT_FOUND = "InvalidParameterValue.Ip6TranslatorNotFound"
+	VPC_IP6_RULE_NOT_FOUND       = "InvalidParameterValue.Ip6RuleNotFound"
+	// VPC_IP6_MUTEX_TASK_RUNNING is returned when the rules of the translator are being changed by another task
+	VPC_IP6_MUTEX_TASK_RUNNING = "OperationDenied.MutexTaskRunning"
+)
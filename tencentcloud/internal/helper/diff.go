@@ -0,0 +1,194 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ComposeCustomizeDiff runs all the fns and returns their errors together, so that
+// all the problems of a config are reported by one `terraform plan`.
+func ComposeCustomizeDiff(fns ...schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		var errs *multierror.Error
+		for _, fn := range fns {
+			if err := fn(ctx, d, meta); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}
+		return errs.ErrorOrNil()
+	}
+}
+
+// CustomizeDiffOnCreate runs fn only when the resource is going to be created.
+func CustomizeDiffOnCreate(fn schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() != "" {
+			return nil
+		}
+		return fn(ctx, d, meta)
+	}
+}
+
+// IsSetInConfig reports whether the top level argument key is set in the config,
+// the default and computed values are not taken into account. The values which
+// are unknown during plan are treated as set.
+func IsSetInConfig(d *schema.ResourceDiff, key string) bool {
+	if !d.NewValueKnown(key) {
+		return true
+	}
+	config := d.GetRawConfig()
+	if !strings.Contains(key, ".") && !config.IsNull() && config.IsKnown() && config.Type().HasAttribute(key) {
+		return !config.GetAttr(key).IsNull()
+	}
+	_, ok := d.GetOk(key)
+	return ok
+}
+
+// valueIs reports whether the planned value of key is known and equal to value.
+func valueIs(d *schema.ResourceDiff, key string, value interface{}) bool {
+	return d.NewValueKnown(key) && d.Get(key) == value
+}
+
+// RequiredWhen returns a CustomizeDiffFunc checking that args are set when key is value.
+func RequiredWhen(key string, value interface{}, args ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !valueIs(d, key, value) {
+			return nil
+		}
+		var errs *multierror.Error
+		for _, arg := range args {
+			if !IsSetInConfig(d, arg) {
+				errs = multierror.Append(errs, fmt.Errorf("`%s` must be set when `%s` is `%v`", arg, key, value))
+			}
+		}
+		return errs.ErrorOrNil()
+	}
+}
+
+// ConflictsWhen returns a CustomizeDiffFunc checking that args are not set when key is value.
+func ConflictsWhen(key string, value interface{}, args ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !valueIs(d, key, value) {
+			return nil
+		}
+		var errs *multierror.Error
+		for _, arg := range args {
+			if IsSetInConfig(d, arg) {
+				errs = multierror.Append(errs, fmt.Errorf("`%s` can not be set when `%s` is `%v`", arg, key, value))
+			}
+		}
+		return errs.ErrorOrNil()
+	}
+}
+
+// AllowedOnlyWhen returns a CustomizeDiffFunc checking that args are set only when key is value.
+func AllowedOnlyWhen(key string, value interface{}, args ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown(key) || d.Get(key) == value {
+			return nil
+		}
+		var errs *multierror.Error
+		for _, arg := range args {
+			if IsSetInConfig(d, arg) {
+				errs = multierror.Append(errs, fmt.Errorf("`%s` can only be set when `%s` is `%v`", arg, key, value))
+			}
+		}
+		return errs.ErrorOrNil()
+	}
+}
+
+// Immutable returns a CustomizeDiffFunc checking that args are not changed once the
+// resource is created, it is for the arguments which can neither be updated nor
+// replaced safely, otherwise use ForceNew in the schema instead.
+func Immutable(args ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" {
+			return nil
+		}
+		var errs *multierror.Error
+		for _, arg := range args {
+			if d.HasChange(arg) {
+				errs = multierror.Append(errs, fmt.Errorf("argument `%s` cannot be changed", arg))
+			}
+		}
+		return errs.ErrorOrNil()
+	}
+}
+
+// CannotDisable returns a CustomizeDiffFunc checking that the bool args are not
+// changed from true to false.
+func CannotDisable(args ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" {
+			return nil
+		}
+		var errs *multierror.Error
+		for _, arg := range args {
+			o, n := d.GetChange(arg)
+			if o.(bool) && !n.(bool) && d.NewValueKnown(arg) {
+				errs = multierror.Append(errs, fmt.Errorf("argument `%s` cannot be set to false once it is true", arg))
+			}
+		}
+		return errs.ErrorOrNil()
+	}
+}
+
+// IntRange is the inclusive range of an int value
+type IntRange struct {
+	Min int
+	Max int
+}
+
+func (me IntRange) check(key, typeKey, typ string, value int) error {
+	if value < me.Min || value > me.Max {
+		return fmt.Errorf("`%s` must be in [%d, %d] when `%s` is `%s`, got %d", key, me.Min, me.Max, typeKey, typ, value)
+	}
+	return nil
+}
+
+// IntInRangeOf returns a CustomizeDiffFunc checking that the int valueKey is in the
+// range of the type, which is the string typeKey. The types not in ranges are not checked.
+func IntInRangeOf(typeKey, valueKey string, ranges map[string]IntRange) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown(typeKey) || !d.NewValueKnown(valueKey) {
+			return nil
+		}
+		typ := d.Get(typeKey).(string)
+		if r, ok := ranges[typ]; ok {
+			return r.check(valueKey, typeKey, typ, d.Get(valueKey).(int))
+		}
+		return nil
+	}
+}
+
+// ListIntInRangeOf is the same as IntInRangeOf, but checks every element of the list listKey.
+func ListIntInRangeOf(listKey, typeKey, valueKey string, ranges map[string]IntRange) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown(listKey) {
+			return nil
+		}
+		var errs *multierror.Error
+		for i, item := range d.Get(listKey).([]interface{}) {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			key := fmt.Sprintf("%s.%d.%s", listKey, i, valueKey)
+			if !d.NewValueKnown(fmt.Sprintf("%s.%d.%s", listKey, i, typeKey)) || !d.NewValueKnown(key) {
+				continue
+			}
+			typ, _ := m[typeKey].(string)
+			value, _ := m[valueKey].(int)
+			if r, ok := ranges[typ]; ok {
+				if err := r.check(key, typeKey, typ, value); err != nil {
+					errs = multierror.Append(errs, err)
+				}
+			}
+		}
+		return errs.ErrorOrNil()
+	}
+}
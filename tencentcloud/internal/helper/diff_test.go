@@ -0,0 +1,123 @@
+package helper
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testDiffResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"charge_type": {Type: schema.TypeString, Optional: true, Default: "POSTPAID"},
+			"period":      {Type: schema.TypeInt, Optional: true},
+			"spot_price":  {Type: schema.TypeString, Optional: true},
+			"subnet_id":   {Type: schema.TypeString, Optional: true},
+			"enabled":     {Type: schema.TypeBool, Optional: true},
+			"disk_type":   {Type: schema.TypeString, Optional: true},
+			"disk_size":   {Type: schema.TypeInt, Optional: true},
+			"data_disks": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {Type: schema.TypeString, Optional: true},
+						"size": {Type: schema.TypeInt, Optional: true},
+					},
+				},
+			},
+		},
+		CustomizeDiff: ComposeCustomizeDiff(
+			RequiredWhen("charge_type", "PREPAID", "period"),
+			AllowedOnlyWhen("charge_type", "PREPAID", "period"),
+			ConflictsWhen("charge_type", "PREPAID", "spot_price"),
+			Immutable("subnet_id"),
+			CannotDisable("enabled"),
+			IntInRangeOf("disk_type", "disk_size", map[string]IntRange{"SSD": {Min: 20, Max: 100}}),
+			ListIntInRangeOf("data_disks", "type", "size", map[string]IntRange{"SSD": {Min: 20, Max: 100}}),
+		),
+	}
+}
+
+func TestCustomizeDiff(t *testing.T) {
+	cases := []struct {
+		name   string
+		state  map[string]string
+		config map[string]interface{}
+		errs   []string
+	}{
+		{
+			name:   "valid",
+			config: map[string]interface{}{"charge_type": "PREPAID", "period": 1, "disk_type": "SSD", "disk_size": 50},
+		},
+		{
+			name:   "required",
+			config: map[string]interface{}{"charge_type": "PREPAID"},
+			errs:   []string{"`period` must be set when `charge_type` is `PREPAID`"},
+		},
+		{
+			name:   "allowed only",
+			config: map[string]interface{}{"period": 1},
+			errs:   []string{"`period` can only be set when `charge_type` is `PREPAID`"},
+		},
+		{
+			name:   "conflicts",
+			config: map[string]interface{}{"charge_type": "PREPAID", "period": 1, "spot_price": "0.1"},
+			errs:   []string{"`spot_price` can not be set when `charge_type` is `PREPAID`"},
+		},
+		{
+			name:   "out of range",
+			config: map[string]interface{}{"disk_type": "SSD", "disk_size": 10, "data_disks": []interface{}{map[string]interface{}{"type": "SSD", "size": 200}}},
+			errs: []string{
+				"`disk_size` must be in [20, 100] when `disk_type` is `SSD`, got 10",
+				"`data_disks.0.size` must be in [20, 100] when `type` is `SSD`, got 200",
+			},
+		},
+		{
+			name:   "range of unknown type",
+			config: map[string]interface{}{"disk_type": "HDD", "disk_size": 10},
+		},
+		{
+			name:   "immutable",
+			state:  map[string]string{"subnet_id": "subnet-1", "charge_type": "POSTPAID"},
+			config: map[string]interface{}{"subnet_id": "subnet-2"},
+			errs:   []string{"argument `subnet_id` cannot be changed"},
+		},
+		{
+			name:   "immutable on create",
+			config: map[string]interface{}{"subnet_id": "subnet-2"},
+		},
+		{
+			name:   "cannot disable",
+			state:  map[string]string{"enabled": "true", "charge_type": "POSTPAID"},
+			config: map[string]interface{}{"enabled": false},
+			errs:   []string{"argument `enabled` cannot be set to false once it is true"},
+		},
+	}
+
+	for _, c := range cases {
+		var state *terraform.InstanceState
+		if c.state != nil {
+			state = &terraform.InstanceState{ID: "id", Attributes: c.state}
+		}
+		_, err := testDiffResource().Diff(context.Background(), state, terraform.NewResourceConfigRaw(c.config), nil)
+		if len(c.errs) == 0 {
+			if err != nil {
+				t.Errorf("%s: expect no error, got %v", c.name, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("%s: expect errors %v, got nil", c.name, c.errs)
+			continue
+		}
+		for _, e := range c.errs {
+			if !strings.Contains(err.Error(), e) {
+				t.Errorf("%s: expect error %q, got %v", c.name, e, err)
+			}
+		}
+	}
+}
@@ -2,6 +2,7 @@ package helper
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
@@ -9,6 +10,7 @@ import (
 	"hash/crc32"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -228,6 +230,40 @@ func ImportWithDefaultValue(defaultValues map[string]interface{}) schema.StateFu
 	}
 }
 
+// ImportWithArguments returns a StateContextFunc importing the resource by the ID
+// `<args[0]>#...#<id>`, the args which the read can not get from the ID are set from
+// the leading parts, and the last part is kept as the ID of the resource.
+func ImportWithArguments(args ...string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		items := strings.SplitN(d.Id(), connect, len(args)+1)
+		if len(items) != len(args)+1 {
+			return nil, fmt.Errorf("id is broken, expected format %s, got %s", IdFormat(append(args, "id")...), d.Id())
+		}
+		for i := range items {
+			if items[i] == "" {
+				return nil, &IdEmptyPartError{Id: d.Id(), Index: i}
+			}
+			if i < len(args) {
+				items[i] = idUnescaper.Replace(items[i])
+			}
+		}
+		for i, arg := range args {
+			if err := d.Set(arg, items[i]); err != nil {
+				// the int arguments
+				n, e := strconv.Atoi(items[i])
+				if e != nil {
+					return nil, err
+				}
+				if err = d.Set(arg, n); err != nil {
+					return nil, err
+				}
+			}
+		}
+		d.SetId(items[len(args)])
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
 func ImmutableArgsChek(d *schema.ResourceData, arguments ...string) error {
 	for _, v := range arguments {
 		if d.HasChange(v) {
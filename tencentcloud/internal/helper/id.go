@@ -1,13 +1,64 @@
 package helper
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+)
 
 const connect = "#"
 
+// the parts of the composite ID are escaped like the URL path, only `%` and the connect
+// are escaped so the IDs without them are the same as the legacy ones.
+var (
+	idEscaper   = strings.NewReplacer("%", "%25", connect, "%23")
+	idUnescaper = strings.NewReplacer("%25", "%", "%23", connect)
+)
+
+// IdPartsCountError is returned when the composite ID has the unexpected number of parts.
+type IdPartsCountError struct {
+	Id       string
+	Expected int
+	Got      int
+}
+
+func (me *IdPartsCountError) Error() string {
+	return fmt.Sprintf("id is broken, expected %d parts joined by `%s`, got %d: %s", me.Expected, connect, me.Got, me.Id)
+}
+
+// IdEmptyPartError is returned when a part of the composite ID is empty.
+type IdEmptyPartError struct {
+	Id    string
+	Index int
+}
+
+func (me *IdEmptyPartError) Error() string {
+	return fmt.Sprintf("id is broken, part %d is empty: %s", me.Index, me.Id)
+}
+
+// IdFormat joins the parts into a composite ID, escaping the `#` and `%` in the parts.
 func IdFormat(s ...string) string {
-	return strings.Join(s, connect)
+	parts := make([]string, len(s))
+	for i, v := range s {
+		parts[i] = idEscaper.Replace(v)
+	}
+	return strings.Join(parts, connect)
 }
 
+// IdParse splits the composite ID into the unescaped parts, it reads the legacy IDs
+// which were joined without escaping as well.
 func IdParse(s string) []string {
-	return strings.Split(s, connect)
+	parts := strings.Split(s, connect)
+	for i, v := range parts {
+		parts[i] = idUnescaper.Replace(v)
+	}
+	return parts
+}
+
+// IdParseN is the same as IdParse, but returns an error unless the ID has exactly n parts.
+func IdParseN(s string, n int) ([]string, error) {
+	parts := IdParse(s)
+	if len(parts) != n {
+		return nil, &IdPartsCountError{Id: s, Expected: n, Got: len(parts)}
+	}
+	return parts, nil
 }
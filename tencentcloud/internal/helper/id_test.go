@@ -0,0 +1,47 @@
+package helper
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestIdFormatAndParse(t *testing.T) {
+	cases := [][]string{
+		{"ins-1", "db"},
+		{"bucket", "path/to#key"},
+		{"topic#1", "50%", "%23"},
+		{"ins-1", ""},
+	}
+	for _, parts := range cases {
+		id := IdFormat(parts...)
+		if got := IdParse(id); !reflect.DeepEqual(got, parts) {
+			t.Errorf("parse %q: expect %q, got %q", id, parts, got)
+		}
+	}
+
+	// the legacy IDs joined without escaping
+	if got := IdParse("ins-1#10.%#db"); !reflect.DeepEqual(got, []string{"ins-1", "10.%", "db"}) {
+		t.Errorf("parse legacy id: got %q", got)
+	}
+	if id := IdFormat("ins-1", "db"); id != "ins-1#db" {
+		t.Errorf("format plain parts: got %q", id)
+	}
+}
+
+func TestIdParseN(t *testing.T) {
+	parts, err := IdParseN("a#b%23c", 2)
+	if err != nil || !reflect.DeepEqual(parts, []string{"a", "b#c"}) {
+		t.Errorf("expect [a b#c], got %q, %v", parts, err)
+	}
+
+	_, err = IdParseN("a#b#c", 2)
+	var countErr *IdPartsCountError
+	if !errors.As(err, &countErr) || countErr.Expected != 2 || countErr.Got != 3 {
+		t.Errorf("expect IdPartsCountError, got %v", err)
+	}
+
+	if _, err = IdParseN("a#", 2); err != nil {
+		t.Errorf("expect no error for the empty part, got %v", err)
+	}
+}
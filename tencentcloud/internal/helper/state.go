@@ -0,0 +1,28 @@
+package helper
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// WithStateUpgraders sets the SchemaVersion of r to the number of upgrades, upgrades[i]
+// upgrades the raw state of the schema version i to i+1. Terraform passes the raw JSON
+// state to the upgrades as it is, the Type of the earlier versions is only used to read
+// the flatmap state, so it is taken from the current schema. The schema change must
+// neither rename nor change the type of the attributes then, otherwise set the
+// StateUpgraders with the schema of the earlier version explicitly.
+//
+// The upgrades are named as resourceTencentCloudXxxStateUpgradeV<i>, and are tested with
+// the raw state of version i in JSON.
+func WithStateUpgraders(r *schema.Resource, upgrades ...schema.StateUpgradeFunc) *schema.Resource {
+	ty := r.CoreConfigSchema().ImpliedType()
+	r.SchemaVersion = len(upgrades)
+	r.StateUpgraders = make([]schema.StateUpgrader, 0, len(upgrades))
+	for i, upgrade := range upgrades {
+		r.StateUpgraders = append(r.StateUpgraders, schema.StateUpgrader{
+			Version: i,
+			Type:    ty,
+			Upgrade: upgrade,
+		})
+	}
+	return r
+}
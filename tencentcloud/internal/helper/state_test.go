@@ -0,0 +1,31 @@
+package helper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestWithStateUpgraders(t *testing.T) {
+	upgrade := func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		return rawState, nil
+	}
+	r := WithStateUpgraders(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Optional: true},
+		},
+	}, upgrade, upgrade)
+
+	if r.SchemaVersion != 2 {
+		t.Errorf("expect SchemaVersion 2, got %d", r.SchemaVersion)
+	}
+	for i, u := range r.StateUpgraders {
+		if u.Version != i || !u.Type.IsObjectType() || u.Upgrade == nil {
+			t.Errorf("StateUpgrader %d is broken: %#v", i, u)
+		}
+	}
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Errorf("expect valid resource, got %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// DefaultWaitTimeout is the timeout of the StateWaiter without one.
+	DefaultWaitTimeout = 10 * time.Minute
+	// DefaultWaitMinTimeout is the minimum interval between the refreshes.
+	DefaultWaitMinTimeout = 3 * time.Second
+)
+
+// NotFoundPolicy tells the StateWaiter what to do when the resource is not found.
+type NotFoundPolicy int
+
+const (
+	// NotFoundFail stops the waiting with a *resource.NotFoundError.
+	NotFoundFail NotFoundPolicy = iota
+	// NotFoundPending keeps waiting, it is for the resources which are not visible right
+	// after they are created.
+	NotFoundPending
+	// NotFoundTarget stops the waiting as the resource reaches the target, it is for deletion.
+	NotFoundTarget
+)
+
+// stateNotFound is the state passed to the StateChangeConf for the resource not found.
+const stateNotFound = "__NOT_FOUND__"
+
+// StateFailedError is returned when the resource turns into one of the failure states.
+type StateFailedError struct {
+	Name  string
+	State string
+}
+
+func (me *StateFailedError) Error() string {
+	return fmt.Sprintf("%s failed, state is %s", me.Name, me.State)
+}
+
+// StateWaiter waits for the state of a resource to reach one of the Target states, the service
+// layers use it instead of the loops of resource.Retry comparing the status.
+//
+// It is built on resource.StateChangeConf, so the interval between the refreshes starts from
+// MinTimeout and doubles up to 10 seconds, and the waiting always stops at Timeout.
+type StateWaiter struct {
+	// Name is what is waited for in the logs and errors, e.g. "cynosdb cluster cynosdbmysql-xxx".
+	Name string
+	// Pending are the states to keep waiting in, the other states fail the waiting with a
+	// *resource.UnexpectedStateError, any state but the Target and Failed ones is pending if empty.
+	Pending []string
+	Target  []string
+	// Failed are the states from which the resource never reaches the Target.
+	Failed []string
+	// Refresh returns the resource and its state, a nil resource means it is not found.
+	Refresh resource.StateRefreshFunc
+	// NotFound is how the resource not found is handled, NotFoundFail by default.
+	NotFound NotFoundPolicy
+	// Retryable tells if the error of Refresh is transient, the waiting stops at any error if nil.
+	Retryable func(error) bool
+
+	Timeout    time.Duration
+	Delay      time.Duration
+	MinTimeout time.Duration
+}
+
+// WaitForStateContext waits until the resource reaches the Target, and returns the last resource
+// refreshed, which is nil if the resource is not found.
+func (me *StateWaiter) WaitForStateContext(ctx context.Context) (interface{}, error) {
+	var (
+		start     = time.Now()
+		lastState string
+		target    = me.Target
+	)
+	if me.NotFound == NotFoundTarget {
+		target = append(append([]string{}, me.Target...), stateNotFound)
+	}
+
+	refresh := func() (interface{}, string, error) {
+		result, state, err := me.Refresh()
+		if err != nil {
+			if me.Retryable != nil && me.Retryable(err) {
+				log.Printf("[WARN] waiting for %s, retry the refresh error: %v", me.Name, err)
+				return struct{}{}, lastState, nil
+			}
+			return nil, "", err
+		}
+
+		if result == nil {
+			if me.NotFound == NotFoundFail {
+				return nil, "", &resource.NotFoundError{Message: fmt.Sprintf("%s not found", me.Name)}
+			}
+			result, state = struct{}{}, stateNotFound
+		}
+
+		if state != lastState {
+			log.Printf("[DEBUG] waiting for %s to be %s, state is %s after %s",
+				me.Name, strings.Join(me.Target, ","), state, time.Since(start).Truncate(time.Second))
+			lastState = state
+		}
+
+		if StringsContain(me.Failed, state) {
+			return nil, "", &StateFailedError{Name: me.Name, State: state}
+		}
+		if !StringsContain(target, state) && len(me.Pending) > 0 &&
+			!StringsContain(me.Pending, state) && !(state == stateNotFound && me.NotFound == NotFoundPending) {
+			return nil, "", &resource.UnexpectedStateError{
+				LastError:     fmt.Errorf("%s is %s", me.Name, state),
+				State:         state,
+				ExpectedState: me.Target,
+			}
+		}
+		return result, state, nil
+	}
+
+	conf := &resource.StateChangeConf{
+		Target:     target,
+		Refresh:    refresh,
+		Timeout:    me.Timeout,
+		Delay:      me.Delay,
+		MinTimeout: me.MinTimeout,
+	}
+	if conf.Timeout == 0 {
+		conf.Timeout = DefaultWaitTimeout
+	}
+	if conf.MinTimeout == 0 {
+		conf.MinTimeout = DefaultWaitMinTimeout
+	}
+
+	result, err := conf.WaitForStateContext(ctx)
+	if timeoutErr, ok := err.(*resource.TimeoutError); ok {
+		timeoutErr.ExpectedState = me.Target
+		if timeoutErr.LastState == stateNotFound {
+			timeoutErr.LastState = "not found"
+		}
+		return nil, fmt.Errorf("%s: %w", me.Name, timeoutErr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := result.(struct{}); ok {
+		return nil, nil
+	}
+	return result, nil
+}
@@ -0,0 +1,137 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// refreshStates returns a Refresh which reports the states in order, "" for not found,
+// and stays in the last state.
+func refreshStates(states ...string) resource.StateRefreshFunc {
+	i := 0
+	return func() (interface{}, string, error) {
+		state := states[i]
+		if i < len(states)-1 {
+			i++
+		}
+		if state == "" {
+			return nil, "", nil
+		}
+		return state, state, nil
+	}
+}
+
+func TestStateWaiter(t *testing.T) {
+	ctx := context.Background()
+
+	result, err := (&StateWaiter{
+		Name:       "task",
+		Pending:    []string{"INITIAL", "RUNNING"},
+		Target:     []string{"SUCCESS"},
+		Refresh:    refreshStates("INITIAL", "RUNNING", "SUCCESS"),
+		MinTimeout: time.Millisecond,
+	}).WaitForStateContext(ctx)
+	if err != nil || result != "SUCCESS" {
+		t.Errorf("expect SUCCESS, got %v, %v", result, err)
+	}
+
+	_, err = (&StateWaiter{
+		Name:       "task",
+		Target:     []string{"SUCCESS"},
+		Failed:     []string{"FAILED"},
+		Refresh:    refreshStates("RUNNING", "FAILED"),
+		MinTimeout: time.Millisecond,
+	}).WaitForStateContext(ctx)
+	var failedErr *StateFailedError
+	if !errors.As(err, &failedErr) || failedErr.State != "FAILED" {
+		t.Errorf("expect StateFailedError, got %v", err)
+	}
+
+	_, err = (&StateWaiter{
+		Name:       "task",
+		Pending:    []string{"RUNNING"},
+		Target:     []string{"SUCCESS"},
+		Refresh:    refreshStates("RUNNING", "PAUSED"),
+		MinTimeout: time.Millisecond,
+	}).WaitForStateContext(ctx)
+	var unexpectedErr *resource.UnexpectedStateError
+	if !errors.As(err, &unexpectedErr) || unexpectedErr.State != "PAUSED" {
+		t.Errorf("expect UnexpectedStateError, got %v", err)
+	}
+
+	_, err = (&StateWaiter{
+		Name:       "task",
+		Target:     []string{"SUCCESS"},
+		Refresh:    refreshStates("RUNNING"),
+		Timeout:    50 * time.Millisecond,
+		MinTimeout: time.Millisecond,
+	}).WaitForStateContext(ctx)
+	var timeoutErr *resource.TimeoutError
+	if !errors.As(err, &timeoutErr) || timeoutErr.LastState != "RUNNING" {
+		t.Errorf("expect TimeoutError, got %v", err)
+	}
+}
+
+func TestStateWaiterNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := (&StateWaiter{
+		Name:       "instance",
+		Target:     []string{"RUNNING"},
+		Refresh:    refreshStates(""),
+		MinTimeout: time.Millisecond,
+	}).WaitForStateContext(ctx)
+	var notFoundErr *resource.NotFoundError
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expect NotFoundError, got %v", err)
+	}
+
+	result, err := (&StateWaiter{
+		Name:       "instance",
+		Pending:    []string{"CREATING"},
+		Target:     []string{"RUNNING"},
+		Refresh:    refreshStates("", "CREATING", "RUNNING"),
+		NotFound:   NotFoundPending,
+		MinTimeout: time.Millisecond,
+	}).WaitForStateContext(ctx)
+	if err != nil || result != "RUNNING" {
+		t.Errorf("expect RUNNING, got %v, %v", result, err)
+	}
+
+	result, err = (&StateWaiter{
+		Name:       "instance",
+		Pending:    []string{"DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    refreshStates("DELETING", ""),
+		NotFound:   NotFoundTarget,
+		MinTimeout: time.Millisecond,
+	}).WaitForStateContext(ctx)
+	if err != nil || result != nil {
+		t.Errorf("expect nil, got %v, %v", result, err)
+	}
+}
+
+func TestStateWaiterRetryable(t *testing.T) {
+	transient := errors.New("transient")
+	i := 0
+	result, err := (&StateWaiter{
+		Name:   "task",
+		Target: []string{"SUCCESS"},
+		Refresh: func() (interface{}, string, error) {
+			i++
+			if i < 3 {
+				return nil, "", transient
+			}
+			return "SUCCESS", "SUCCESS", nil
+		},
+		Retryable:  func(err error) bool { return err == transient },
+		MinTimeout: time.Millisecond,
+	}).WaitForStateContext(context.Background())
+	if err != nil || result != "SUCCESS" {
+		t.Errorf("expect SUCCESS, got %v, %v", result, err)
+	}
+}
@@ -36,6 +36,21 @@ provider "tencentcloud" {
     policy           = var.policy
   }
 }
+
+#Configure the TencentCloud Provider with default tags
+provider "tencentcloud" {
+  secret_id  = var.secret_id
+  secret_key = var.secret_key
+  region     = var.region
+  default_tags {
+    tags = {
+      owner = "ops"
+    }
+  }
+  ignore_tags {
+    key_prefixes = ["tke-"]
+  }
+}
 ```
 
 Resources List
@@ -1003,6 +1018,9 @@ Virtual Private Cloud(VPC)
 	tencentcloud_nat_dc_route
 	tencentcloud_vpc_bandwidth_package_quota
 	tencentcloud_vpc_bandwidth_package_bill_usage
+	tencentcloud_vpc_peering_connections
+	tencentcloud_vpc_ipv6_translators
+	tencentcloud_vpc_ipv6_translator_quota
 
   Resource
     tencentcloud_eni
@@ -1011,6 +1029,7 @@ Virtual Private Cloud(VPC)
     tencentcloud_vpc
 	tencentcloud_vpc_acl
 	tencentcloud_vpc_acl_attachment
+	tencentcloud_vpc_acl_entries
 	tencentcloud_vpc_traffic_package
 	tencentcloud_vpc_snapshot_policy
 	tencentcloud_vpc_snapshot_policy_attachment
@@ -1020,13 +1039,19 @@ Virtual Private Cloud(VPC)
 	tencentcloud_vpc_ipv6_cidr_block
 	tencentcloud_vpc_ipv6_subnet_cidr_block
 	tencentcloud_vpc_ipv6_eni_address
+	tencentcloud_vpc_ipv6_translator
+	tencentcloud_vpc_ipv6_translator_rule
+	tencentcloud_vpc_peering_connection
+	tencentcloud_vpc_peering_connection_accepter
 	tencentcloud_vpc_local_gateway
 	tencentcloud_vpc_resume_snapshot_instance
+	tencentcloud_default_vpc
     tencentcloud_subnet
     tencentcloud_security_group
     tencentcloud_security_group_rule
     tencentcloud_security_group_rule_set
     tencentcloud_security_group_lite_rule
+	tencentcloud_default_security_group
 	tencentcloud_address_template
 	tencentcloud_address_template_group
 	tencentcloud_protocol_template
@@ -1713,13 +1738,12 @@ Cfw
 package tencentcloud
 
 import (
-	"net/url"
+	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
-	sts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sts/v20180813"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
@@ -1735,26 +1759,41 @@ const (
 	PROVIDER_ASSUME_ROLE_ARN              = "TENCENTCLOUD_ASSUME_ROLE_ARN"
 	PROVIDER_ASSUME_ROLE_SESSION_NAME     = "TENCENTCLOUD_ASSUME_ROLE_SESSION_NAME"
 	PROVIDER_ASSUME_ROLE_SESSION_DURATION = "TENCENTCLOUD_ASSUME_ROLE_SESSION_DURATION"
+	PROVIDER_SHARED_CREDENTIALS_DIR       = "TENCENTCLOUD_SHARED_CREDENTIALS_DIR"
+	PROVIDER_PROFILE                      = "TENCENTCLOUD_PROFILE"
+	PROVIDER_CAM_ROLE_NAME                = "TENCENTCLOUD_CAM_ROLE_NAME"
+	PROVIDER_WEB_IDENTITY_TOKEN           = "TENCENTCLOUD_WEB_IDENTITY_TOKEN"
+	PROVIDER_WEB_IDENTITY_TOKEN_FILE      = "TENCENTCLOUD_WEB_IDENTITY_TOKEN_FILE"
+	PROVIDER_HTTP_PROXY                   = "TENCENTCLOUD_HTTP_PROXY"
+	PROVIDER_CA_BUNDLE_FILE               = "TENCENTCLOUD_CA_BUNDLE_FILE"
+	PROVIDER_CLIENT_CERT_FILE             = "TENCENTCLOUD_CLIENT_CERT_FILE"
+	PROVIDER_CLIENT_KEY_FILE              = "TENCENTCLOUD_CLIENT_KEY_FILE"
+	PROVIDER_INSECURE                     = "TENCENTCLOUD_INSECURE"
+	PROVIDER_RATE_LIMIT_FILE              = "TENCENTCLOUD_RATE_LIMIT_FILE"
 )
 
 type TencentCloudClient struct {
 	apiV3Conn *connectivity.TencentCloudClient
+
+	defaultTags map[string]string
+	ignoreTags  *ignoreTagsConfig
+	retryPolicy retryPolicy
 }
 
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"secret_id": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_SECRET_ID, nil),
-				Description: "This is the TencentCloud access key. It must be provided, but it can also be sourced from the `TENCENTCLOUD_SECRET_ID` environment variable.",
+				Description: "This is the TencentCloud access key. It must be provided, but it can also be sourced from the `TENCENTCLOUD_SECRET_ID` environment variable, or the profile of `shared_credentials_dir`.",
 			},
 			"secret_key": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_SECRET_KEY, nil),
-				Description: "This is the TencentCloud secret key. It must be provided, but it can also be sourced from the `TENCENTCLOUD_SECRET_KEY` environment variable.",
+				Description: "This is the TencentCloud secret key. It must be provided, but it can also be sourced from the `TENCENTCLOUD_SECRET_KEY` environment variable, or the profile of `shared_credentials_dir`.",
 				Sensitive:   true,
 			},
 			"security_token": {
@@ -1766,9 +1805,9 @@ func Provider() *schema.Provider {
 			},
 			"region": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				DefaultFunc:  schema.EnvDefaultFunc(PROVIDER_REGION, nil),
-				Description:  "This is the TencentCloud region. It must be provided, but it can also be sourced from the `TENCENTCLOUD_REGION` environment variables. The default input value is ap-guangzhou.",
+				Description:  "This is the TencentCloud region. It must be provided, but it can also be sourced from the `TENCENTCLOUD_REGION` environment variables, or the profile of `shared_credentials_dir`. The default input value is ap-guangzhou.",
 				InputDefault: "ap-guangzhou",
 			},
 			"protocol": {
@@ -1784,11 +1823,119 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_DOMAIN, nil),
 				Description: "The root domain of the API request, Default is `tencentcloudapi.com`.",
 			},
-			"assume_role": {
-				Type:        schema.TypeSet,
+			"shared_credentials_dir": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_SHARED_CREDENTIALS_DIR, nil),
+				Description: "The directory of the shared credentials written by tccli, default is `~/.tccli`. It can also be sourced from the `TENCENTCLOUD_SHARED_CREDENTIALS_DIR` environment variable.",
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_PROFILE, nil),
+				Description: "The profile name of the shared credentials, `<profile>.credential` and `<profile>.configure` are read from `shared_credentials_dir`, default is `default`. It can also be sourced from the `TENCENTCLOUD_PROFILE` environment variable.",
+			},
+			"cam_role_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_CAM_ROLE_NAME, nil),
+				Description: "The name of the CAM role bound to the CVM instance, the temporary credential of the role is fetched from the instance metadata service and refreshed before it expires. It takes precedence over `secret_id` and `secret_key`, conflicts with `assume_role_with_web_identity`, and can also be sourced from the `TENCENTCLOUD_CAM_ROLE_NAME` environment variable.",
+			},
+			"http_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_HTTP_PROXY, nil),
+				Description: "The URL of the proxy of the API requests, such as `http://127.0.0.1:3128`. It can also be sourced from the `TENCENTCLOUD_HTTP_PROXY` environment variable, otherwise the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables are used.",
+			},
+			"ca_bundle_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_CA_BUNDLE_FILE, nil),
+				Description: "The path of the PEM file of the CA certificates trusted besides the system ones, such as the CA of a TLS intercepting proxy. It can also be sourced from the `TENCENTCLOUD_CA_BUNDLE_FILE` environment variable.",
+			},
+			"client_cert_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(PROVIDER_CLIENT_CERT_FILE, nil),
+				RequiredWith: []string{"client_key_file"},
+				Description:  "The path of the PEM file of the client certificate for mutual TLS, `client_key_file` must be provided as well. It can also be sourced from the `TENCENTCLOUD_CLIENT_CERT_FILE` environment variable.",
+			},
+			"client_key_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(PROVIDER_CLIENT_KEY_FILE, nil),
+				RequiredWith: []string{"client_cert_file"},
+				Description:  "The path of the PEM file of the private key of `client_cert_file`. It can also be sourced from the `TENCENTCLOUD_CLIENT_KEY_FILE` environment variable.",
+			},
+			"insecure": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_INSECURE, false),
+				Description: "Skip the verification of the server certificates, which is insecure and only for testing. Default is `false`. It can also be sourced from the `TENCENTCLOUD_INSECURE` environment variable.",
+			},
+			"connect_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validateIntegerMin(0),
+				Description:  "The timeout in seconds of establishing the connections to the services. Default is 0, which means 30 seconds.",
+			},
+			"request_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validateIntegerMin(0),
+				Description:  "The timeout in seconds of the API requests, it overrides the default timeout of each service. Default is 0, which means the default timeout of each service.",
+			},
+			"assume_role_with_web_identity": {
+				Type:        schema.TypeList,
 				Optional:    true,
 				MaxItems:    1,
-				Description: "The `assume_role` block. If provided, terraform will attempt to assume this role using the supplied credentials.",
+				Description: "The `assume_role_with_web_identity` block. If provided, terraform will exchange the OIDC token for the temporary credentials of the role, `secret_id` and `secret_key` are not needed. The roles of `assume_role` blocks are assumed with these credentials.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_arn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ARN of the role to assume.",
+						},
+						"provider_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the OIDC identity provider, which is the one managed by `tencentcloud_cam_oidc_sso` or created in the CAM console.",
+						},
+						"session_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The session name to use when making the AssumeRoleWithWebIdentity call.",
+						},
+						"session_duration": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      7200,
+							ValidateFunc: validateIntegerInRange(1, 43200),
+							Description:  "The duration of the session when making the AssumeRoleWithWebIdentity call. Its value ranges from 1 to 43200(seconds), and default is 7200 seconds.",
+						},
+						"web_identity_token": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							DefaultFunc: schema.EnvDefaultFunc(PROVIDER_WEB_IDENTITY_TOKEN, nil),
+							Description: "The OIDC token issued by the identity provider. It can be sourced from the `TENCENTCLOUD_WEB_IDENTITY_TOKEN` environment variable.",
+						},
+						"web_identity_token_file": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							DefaultFunc: schema.EnvDefaultFunc(PROVIDER_WEB_IDENTITY_TOKEN_FILE, nil),
+							Description: "The path of the file containing the OIDC token, it is read again when the temporary credentials are refreshed and takes precedence over `web_identity_token`. It can be sourced from the `TENCENTCLOUD_WEB_IDENTITY_TOKEN_FILE` environment variable.",
+						},
+					},
+				},
+			},
+			"assume_role": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The `assume_role` block. If provided, terraform will attempt to assume this role using the supplied credentials. Multiple blocks are assumed in order, each one with the credentials of the previous one.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"role_arn": {
@@ -1820,6 +1967,187 @@ func Provider() *schema.Provider {
 							Optional:    true,
 							Description: "A more restrictive policy when making the AssumeRole call. Its content must not contains `principal` elements. Notice: more syntax references, please refer to: [policies syntax logic](https://intl.cloud.tencent.com/document/product/598/10603).",
 						},
+						"external_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "The external ID required by the trust policy of the role when making the AssumeRole call.",
+						},
+						"source_identity": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The source identity passed through the role session when making the AssumeRole call.",
+						},
+						"serial_number": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The serial number of the MFA device bound to the source user when making the AssumeRole call. It is required if the trust policy of the role requires MFA.",
+						},
+						"token_code": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "The one-time code of the MFA device specified by `serial_number` when making the AssumeRole call.",
+						},
+					},
+				},
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The `default_tags` block. Tags configured here are merged into the `tags` of every resource managing tags through the tag service, tags of the resource take precedence over the same keys.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tags to apply to every taggable resource.",
+						},
+					},
+				},
+			},
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The `ignore_tags` block. Tags matching it are filtered out of the `tags` of every resource managing tags through the tag service, so tags managed outside of terraform won't cause drift.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tag keys to ignore.",
+						},
+						"key_prefixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tag key prefixes to ignore.",
+						},
+					},
+				},
+			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The `endpoints` block. It overrides the endpoints of the services, such as the internal endpoints or a local mock server.",
+				Elem: &schema.Resource{
+					Schema: providerEndpointsSchema(),
+				},
+			},
+			"rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The `rate_limit` block. It overrides the QPS limits of the API requests, such as when several pipelines share one account.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_qps": {
+							Type:        schema.TypeFloat,
+							Optional:    true,
+							Default:     0.0,
+							Description: "The QPS limit of the actions without any limit configured. Default is 0, which means 15.",
+						},
+						"limits": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeFloat},
+							Description: "The QPS limits keyed by `<service>` or `<service>.<Action>`, such as `mysql` or `mysql.CreateBackup`. The limit of an action takes precedence over the one of its service.",
+						},
+						"config_file": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The path of the YAML file of the limits, the `default_qps` and `limits` of the block take precedence over the file. It can also be sourced from the `TENCENTCLOUD_RATE_LIMIT_FILE` environment variable.",
+						},
+					},
+				},
+			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The `retry` block. It adds retryable error codes and controls the attempts and backoff of retrying the API requests.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"retryable_error_codes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The error codes to retry for all services besides the built-in ones. A code also matches the codes it prefixes, e.g. `ResourceBusy` matches `ResourceBusy.Locked`.",
+						},
+						"service": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The retryable error codes of a service.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The name of the service, the same as the one of `endpoints`, e.g. `cvm`, `vpc` or `cos`.",
+									},
+									"retryable_error_codes": {
+										Type:        schema.TypeSet,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "The error codes to retry for the service.",
+									},
+								},
+							},
+						},
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validateIntegerMin(0),
+							Description:  "The max number of attempts of a request, the retrying stops at either it or the timeout. Default is 0, which means only the timeout limits the retrying.",
+						},
+						"min_backoff": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validateIntegerMin(0),
+							Description:  "The wait in seconds before the first retry, it doubles with each retry up to `max_backoff` and is randomly jittered. Default is 0, which means 1 second when `max_attempts` or `max_backoff` is set.",
+						},
+						"max_backoff": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validateIntegerMin(0),
+							Description:  "The max wait in seconds between retries. Default is 0, which means 30 seconds when `max_attempts` or `min_backoff` is set.",
+						},
+					},
+				},
+			},
+			"debug_log": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The `debug_log` block. It controls how the API requests and responses are written to the debug log, the values of the sensitive fields such as passwords, secret keys and tokens are always redacted.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"redact_fields": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The names of the API fields to redact besides the default ones, case insensitive.",
+						},
+						"max_body_size": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "The max size in bytes of the request and response body written to the log, the rest is truncated. Default is 0, which means no limit.",
+						},
+						"header_only": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Only write the action, host and region of the request to the log without the body. Default is `false`.",
+						},
 					},
 				},
 			},
@@ -1848,6 +2176,9 @@ func Provider() *schema.Provider {
 			"tencentcloud_vpc_acls":                                  dataSourceTencentCloudVpcAcls(),
 			"tencentcloud_vpc_bandwidth_package_quota":               dataSourceTencentCloudVpcBandwidthPackageQuota(),
 			"tencentcloud_vpc_bandwidth_package_bill_usage":          dataSourceTencentCloudVpcBandwidthPackageBillUsage(),
+			"tencentcloud_vpc_peering_connections":                   dataSourceTencentCloudVpcPeeringConnections(),
+			"tencentcloud_vpc_ipv6_translators":                      dataSourceTencentCloudVpcIpv6Translators(),
+			"tencentcloud_vpc_ipv6_translator_quota":                 dataSourceTencentCloudVpcIpv6TranslatorQuota(),
 			"tencentcloud_vpc_account_attributes":                    dataSourceTencentCloudVpcAccountAttributes(),
 			"tencentcloud_vpc_classic_link_instances":                dataSourceTencentCloudVpcClassicLinkInstances(),
 			"tencentcloud_vpc_gateway_flow_monitor_detail":           dataSourceTencentCloudVpcGatewayFlowMonitorDetail(),
@@ -2397,8 +2728,10 @@ func Provider() *schema.Provider {
 			"tencentcloud_cbs_storage_set_attachment":                          resourceTencentCloudCbsStorageSetAttachment(),
 			"tencentcloud_cbs_snapshot_policy_attachment":                      resourceTencentCloudCbsSnapshotPolicyAttachment(),
 			"tencentcloud_vpc":                                                 resourceTencentCloudVpcInstance(),
+			"tencentcloud_default_vpc":                                         resourceTencentCloudDefaultVpc(),
 			"tencentcloud_vpc_acl":                                             resourceTencentCloudVpcACL(),
 			"tencentcloud_vpc_acl_attachment":                                  resourceTencentCloudVpcAclAttachment(),
+			"tencentcloud_vpc_acl_entries":                                     resourceTencentCloudVpcAclEntries(),
 			"tencentcloud_vpc_network_acl_quintuple":                           resourceTencentCloudVpcNetworkAclQuintuple(),
 			"tencentcloud_vpc_notify_routes":                                   resourceTencentCloudVpcNotifyRoutes(),
 			"tencentcloud_vpc_bandwidth_package":                               resourceTencentCloudVpcBandwidthPackage(),
@@ -2414,6 +2747,10 @@ func Provider() *schema.Provider {
 			"tencentcloud_vpc_ipv6_cidr_block":                                 resourceTencentCloudVpcIpv6CidrBlock(),
 			"tencentcloud_vpc_ipv6_subnet_cidr_block":                          resourceTencentCloudVpcIpv6SubnetCidrBlock(),
 			"tencentcloud_vpc_ipv6_eni_address":                                resourceTencentCloudVpcIpv6EniAddress(),
+			"tencentcloud_vpc_ipv6_translator":                                 resourceTencentCloudVpcIpv6Translator(),
+			"tencentcloud_vpc_ipv6_translator_rule":                            resourceTencentCloudVpcIpv6TranslatorRule(),
+			"tencentcloud_vpc_peering_connection":                              resourceTencentCloudVpcPeeringConnection(),
+			"tencentcloud_vpc_peering_connection_accepter":                     resourceTencentCloudVpcPeeringConnectionAccepter(),
 			"tencentcloud_vpc_dhcp_associate_address":                          resourceTencentCloudVpcDhcpAssociateAddress(),
 			"tencentcloud_vpc_local_gateway":                                   resourceTencentCloudVpcLocalGateway(),
 			"tencentcloud_vpc_resume_snapshot_instance":                        resourceTencentCloudVpcResumeSnapshotInstance(),
@@ -2465,6 +2802,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_ha_vip":                                              resourceTencentCloudHaVip(),
 			"tencentcloud_ha_vip_eip_attachment":                               resourceTencentCloudHaVipEipAttachment(),
 			"tencentcloud_security_group":                                      resourceTencentCloudSecurityGroup(),
+			"tencentcloud_default_security_group":                              resourceTencentCloudDefaultSecurityGroup(),
 			"tencentcloud_security_group_rule":                                 resourceTencentCloudSecurityGroupRule(),
 			"tencentcloud_security_group_rule_set":                             resourceTencentCloudSecurityGroupRuleSet(),
 			"tencentcloud_security_group_lite_rule":                            resourceTencentCloudSecurityGroupLiteRule(),
@@ -3157,6 +3495,12 @@ func Provider() *schema.Provider {
 
 		ConfigureFunc: providerConfigure,
 	}
+
+	applyProviderTags(provider.ResourcesMap)
+	applyProviderDiagnostics(provider.ResourcesMap, provider.DataSourcesMap)
+	applyProviderContext(provider.ResourcesMap, provider.DataSourcesMap)
+
+	return provider
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
@@ -3166,19 +3510,173 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	region := d.Get("region").(string)
 	protocol := d.Get("protocol").(string)
 	domain := d.Get("domain").(string)
+	camRoleName := d.Get("cam_role_name").(string)
+
+	// get the endpoints of the services from tf config
+	endpoints := make(map[string]string)
+	if v, ok := helper.InterfacesHeadMap(d, "endpoints"); ok {
+		for service, endpoint := range v {
+			if endpoint, ok := endpoint.(string); ok && endpoint != "" {
+				endpoints[service] = endpoint
+			}
+		}
+	}
+
+	// configure the debug log before any API request
+	logConfig := connectivity.LogConfig{}
+	if v, ok := helper.InterfacesHeadMap(d, "debug_log"); ok {
+		if fields, ok := v["redact_fields"].(*schema.Set); ok {
+			logConfig.RedactFields = helper.InterfacesStrings(fields.List())
+		}
+		logConfig.MaxBodySize = v["max_body_size"].(int)
+		logConfig.HeaderOnly = v["header_only"].(bool)
+	}
+
+	// configure the transport before any API request
+	httpConfig := connectivity.HttpConfig{
+		Proxy:          d.Get("http_proxy").(string),
+		CaBundleFile:   d.Get("ca_bundle_file").(string),
+		ClientCertFile: d.Get("client_cert_file").(string),
+		ClientKeyFile:  d.Get("client_key_file").(string),
+		Insecure:       d.Get("insecure").(bool),
+		ConnectTimeout: d.Get("connect_timeout").(int),
+		RequestTimeout: d.Get("request_timeout").(int),
+	}
+
+	// configure the rate limits before any API request
+	rateLimitFile := os.Getenv(PROVIDER_RATE_LIMIT_FILE)
+	rateLimitConfig := ratelimit.Config{}
+	rateLimit, useRateLimit := helper.InterfacesHeadMap(d, "rate_limit")
+	if useRateLimit && rateLimit["config_file"].(string) != "" {
+		rateLimitFile = rateLimit["config_file"].(string)
+	}
+	if rateLimitFile != "" {
+		var err error
+		if rateLimitConfig, err = ratelimit.LoadConfigFile(rateLimitFile); err != nil {
+			return nil, err
+		}
+	}
+	if useRateLimit {
+		override := ratelimit.Config{
+			Default: rateLimit["default_qps"].(float64),
+			Limits:  make(map[string]float64),
+		}
+		if limits, ok := rateLimit["limits"].(map[string]interface{}); ok {
+			for key, limit := range limits {
+				override.Limits[key] = limit.(float64)
+			}
+		}
+		rateLimitConfig = rateLimitConfig.Merge(override)
+	}
+	rateLimiter, err := ratelimit.NewLimiter(rateLimitConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	retryConfig := retryPolicy{}
+	if v, ok := helper.InterfacesHeadMap(d, "retry"); ok {
+		if codes, ok := v["retryable_error_codes"].(*schema.Set); ok {
+			retryConfig.ErrorCodes = helper.InterfacesStrings(codes.List())
+		}
+		if services, ok := v["service"].([]interface{}); ok && len(services) > 0 {
+			retryConfig.ServiceErrorCodes = make(map[string][]string, len(services))
+			for _, item := range services {
+				service := item.(map[string]interface{})
+				name := service["name"].(string)
+				codes := helper.InterfacesStrings(service["retryable_error_codes"].(*schema.Set).List())
+				retryConfig.ServiceErrorCodes[name] = append(retryConfig.ServiceErrorCodes[name], codes...)
+			}
+		}
+		retryConfig.MaxAttempts = v["max_attempts"].(int)
+		retryConfig.MinBackoff = time.Duration(v["min_backoff"].(int)) * time.Second
+		retryConfig.MaxBackoff = time.Duration(v["max_backoff"].(int)) * time.Second
+	}
+
+	// get credentials and region from the tccli profile if they are not provided
+	sharedCredentialsDir := d.Get("shared_credentials_dir").(string)
+	profileName := d.Get("profile").(string)
+	var profile *tccliProfile
+	if secretId == "" || secretKey == "" || region == "" || sharedCredentialsDir != "" || profileName != "" {
+		var err error
+		profile, err = loadTccliProfile(sharedCredentialsDir, profileName)
+		if err != nil {
+			return nil, err
+		}
+		if profile == nil && (sharedCredentialsDir != "" || profileName != "") {
+			if sharedCredentialsDir == "" {
+				sharedCredentialsDir = DEFAULT_SHARED_CREDENTIALS_DIR
+			}
+			if profileName == "" {
+				profileName = DEFAULT_PROFILE
+			}
+			return nil, fmt.Errorf("profile `%s` is not found in shared credentials dir `%s`", profileName, sharedCredentialsDir)
+		}
+	}
+	if profile != nil {
+		if secretId == "" && secretKey == "" {
+			secretId = profile.SecretId
+			secretKey = profile.SecretKey
+			if securityToken == "" {
+				securityToken = profile.Token
+			}
+		}
+		if region == "" {
+			region = profile.Region
+		}
+	}
+	webIdentity, useWebIdentity := helper.InterfacesHeadMap(d, "assume_role_with_web_identity")
+	if camRoleName != "" && useWebIdentity {
+		return nil, fmt.Errorf("`cam_role_name` and `assume_role_with_web_identity` can not be used together, `cam_role_name` may be sourced from the `%s` environment variable", PROVIDER_CAM_ROLE_NAME)
+	}
+	if camRoleName == "" && !useWebIdentity && (secretId == "" || secretKey == "") {
+		return nil, fmt.Errorf("`secret_id` and `secret_key` must be provided, they can also be sourced from the `%s` and `%s` environment variables or the tccli profile", PROVIDER_SECRET_ID, PROVIDER_SECRET_KEY)
+	}
+	if region == "" {
+		return nil, fmt.Errorf("`region` must be provided, it can also be sourced from the `%s` environment variable or the tccli profile", PROVIDER_REGION)
+	}
+
+	// the settings of the provider are kept by its client, and shared by the clients derived from it
+	apiV3Conn := &connectivity.TencentCloudClient{
+		Region:      region,
+		Protocol:    protocol,
+		Domain:      domain,
+		Endpoints:   endpoints,
+		LogConfig:   logConfig,
+		RateLimiter: rateLimiter,
+	}
+	if err := apiV3Conn.SetHttpConfig(httpConfig); err != nil {
+		return nil, err
+	}
+
+	credential := connectivity.NewCredential(secretId, secretKey, securityToken)
+	if camRoleName != "" {
+		// get credential from the CVM instance metadata service
+		var err error
+		credential, err = connectivity.NewProviderCredential(connectivity.NewCvmRoleProvider(camRoleName, ""))
+		if err != nil {
+			return nil, fmt.Errorf("get credential of cam role `%s` failed, reason: %v", camRoleName, err)
+		}
+	} else if useWebIdentity {
+		// exchange the OIDC token for the credential, the request to sts is not signed
+		roleArn := webIdentity["role_arn"].(string)
+		provider := connectivity.NewWebIdentityProvider(apiV3Conn.WithCredential(connectivity.NewCredential("", "", "")), roleArn, webIdentity["session_name"].(string), webIdentity["session_duration"].(int), webIdentity["provider_id"].(string))
+		provider.Token = webIdentity["web_identity_token"].(string)
+		provider.TokenFile = webIdentity["web_identity_token_file"].(string)
+		if provider.Token == "" && provider.TokenFile == "" {
+			return nil, fmt.Errorf("`web_identity_token` or `web_identity_token_file` must be provided, they can also be sourced from the `%s` and `%s` environment variables", PROVIDER_WEB_IDENTITY_TOKEN, PROVIDER_WEB_IDENTITY_TOKEN_FILE)
+		}
+		var err error
+		credential, err = connectivity.NewProviderCredential(provider)
+		if err != nil {
+			return nil, fmt.Errorf("get credential of role `%s` with web identity failed, reason: %v", roleArn, err)
+		}
+	}
 
 	// standard client
 	var tcClient TencentCloudClient
-	tcClient.apiV3Conn = &connectivity.TencentCloudClient{
-		Credential: common.NewTokenCredential(
-			secretId,
-			secretKey,
-			securityToken,
-		),
-		Region:   region,
-		Protocol: protocol,
-		Domain:   domain,
-	}
+	apiV3Conn.Credential = credential
+	tcClient.apiV3Conn = apiV3Conn
+	tcClient.retryPolicy = retryConfig
 
 	envRoleArn := os.Getenv(PROVIDER_ASSUME_ROLE_ARN)
 	envSessionName := os.Getenv(PROVIDER_ASSUME_ROLE_SESSION_NAME)
@@ -3197,42 +3695,103 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 			assumeRoleSessionDuration = 7200
 		}
 
-		_ = genClientWithSTS(&tcClient, envRoleArn, envSessionName, assumeRoleSessionDuration, "")
+		provider := connectivity.NewAssumeRoleProvider(nil, envRoleArn, envSessionName, assumeRoleSessionDuration, "")
+		if err := genClientWithSTS(&tcClient, provider); err != nil {
+			return nil, fmt.Errorf("get credentials through assume role of env failed, reason: %v", err)
+		}
 	}
 
 	// get assume role from tf config
-	assumeRoleList := d.Get("assume_role").(*schema.Set).List()
-	if len(assumeRoleList) == 1 {
-		assumeRole := assumeRoleList[0].(map[string]interface{})
+	assumeRoleList := d.Get("assume_role").([]interface{})
+	if envRoleArn == "" && len(assumeRoleList) == 0 && profile != nil && profile.RoleArn != "" {
+		// get assume role from tccli profile
+		sessionName := profile.RoleSessionName
+		if sessionName == "" {
+			sessionName = "terraform"
+		}
+		provider := connectivity.NewAssumeRoleProvider(nil, profile.RoleArn, sessionName, 7200, "")
+		if err := genClientWithSTS(&tcClient, provider); err != nil {
+			return nil, fmt.Errorf("assume role `%s` of profile failed, reason: %v", profile.RoleArn, err)
+		}
+	}
+	// the roles are assumed in order, each one with the credentials of the previous one
+	for _, v := range assumeRoleList {
+		assumeRole := v.(map[string]interface{})
 		assumeRoleArn := assumeRole["role_arn"].(string)
 		assumeRoleSessionName := assumeRole["session_name"].(string)
 		assumeRoleSessionDuration := assumeRole["session_duration"].(int)
 		assumeRolePolicy := assumeRole["policy"].(string)
 
-		_ = genClientWithSTS(&tcClient, assumeRoleArn, assumeRoleSessionName, assumeRoleSessionDuration, assumeRolePolicy)
+		provider := connectivity.NewAssumeRoleProvider(nil, assumeRoleArn, assumeRoleSessionName, assumeRoleSessionDuration, assumeRolePolicy)
+		provider.ExternalId = assumeRole["external_id"].(string)
+		provider.SourceIdentity = assumeRole["source_identity"].(string)
+		provider.SerialNumber = assumeRole["serial_number"].(string)
+		provider.TokenCode = assumeRole["token_code"].(string)
+		if (provider.SerialNumber == "") != (provider.TokenCode == "") {
+			return nil, fmt.Errorf("`serial_number` and `token_code` of assume role `%s` must be set together", assumeRoleArn)
+		}
+		if err := genClientWithSTS(&tcClient, provider); err != nil {
+			return nil, fmt.Errorf("get credentials through assume role `%s` failed, reason: %v", assumeRoleArn, err)
+		}
+	}
+
+	// get default tags and ignore tags from tf config
+	if v, ok := helper.InterfacesHeadMap(d, "default_tags"); ok {
+		if tags, ok := v["tags"].(map[string]interface{}); ok && len(tags) > 0 {
+			tcClient.defaultTags = make(map[string]string, len(tags))
+			for k, v := range tags {
+				tcClient.defaultTags[k] = v.(string)
+			}
+		}
+	}
+	if v, ok := helper.InterfacesHeadMap(d, "ignore_tags"); ok {
+		ignoreTags := &ignoreTagsConfig{}
+		if keys, ok := v["keys"].(*schema.Set); ok {
+			ignoreTags.keys = helper.InterfacesStrings(keys.List())
+		}
+		if keyPrefixes, ok := v["key_prefixes"].(*schema.Set); ok {
+			ignoreTags.keyPrefixes = helper.InterfacesStrings(keyPrefixes.List())
+		}
+		if len(ignoreTags.keys) > 0 || len(ignoreTags.keyPrefixes) > 0 {
+			tcClient.ignoreTags = ignoreTags
+		}
 	}
 	return &tcClient, nil
 }
 
-func genClientWithSTS(tcClient *TencentCloudClient, assumeRoleArn, assumeRoleSessionName string, assumeRoleSessionDuration int, assumeRolePolicy string) error {
-	// applying STS credentials
-	request := sts.NewAssumeRoleRequest()
-	request.RoleArn = helper.String(assumeRoleArn)
-	request.RoleSessionName = helper.String(assumeRoleSessionName)
-	request.DurationSeconds = helper.IntUint64(assumeRoleSessionDuration)
-	if assumeRolePolicy != "" {
-		request.Policy = helper.String(url.QueryEscape(assumeRolePolicy))
+// providerEndpointsSchema returns the schema of the `endpoints` block, one argument for each service.
+func providerEndpointsSchema() map[string]*schema.Schema {
+	endpointsSchema := make(map[string]*schema.Schema, len(connectivity.EndpointServices))
+	for _, service := range connectivity.EndpointServices {
+		endpointsSchema[service] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: fmt.Sprintf("The endpoint of the `%s` service.", service),
+		}
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := tcClient.apiV3Conn.UseStsClient().AssumeRole(request)
+	return endpointsSchema
+}
+
+// genClientWithSTS makes tcClient use the credentials of the role assumed by provider,
+// the sts client of provider uses the current credentials of tcClient.
+func genClientWithSTS(tcClient *TencentCloudClient, provider *connectivity.AssumeRoleProvider) error {
+	provider.Client = tcClient.apiV3Conn.WithCredential(tcClient.apiV3Conn.Credential)
+
+	// the MFA token code can only be used once, so the credentials assumed with it are never refreshed
+	if provider.SerialNumber != "" {
+		credential, err := provider.GetCredential()
+		if err != nil {
+			return err
+		}
+		tcClient.apiV3Conn.Credential = connectivity.NewCredential(credential.SecretId, credential.SecretKey, credential.Token)
+		return nil
+	}
+
+	// using STS credentials, which are assumed again before they expire
+	credential, err := connectivity.NewProviderCredential(provider)
 	if err != nil {
 		return err
 	}
-	// using STS credentials
-	tcClient.apiV3Conn.Credential = common.NewTokenCredential(
-		*response.Response.Credentials.TmpSecretId,
-		*response.Response.Credentials.TmpSecretKey,
-		*response.Response.Credentials.Token,
-	)
+	tcClient.apiV3Conn.Credential = credential
 	return nil
 }
@@ -0,0 +1,63 @@
+package tencentcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
+)
+
+// providerContext returns ctx carrying the settings of the provider of meta, which are read by the
+// API calls of the services that only get ctx, such as the rate limits and the retry policy.
+func providerContext(ctx context.Context, meta interface{}) context.Context {
+	client, ok := meta.(*TencentCloudClient)
+	if !ok || client == nil || client.apiV3Conn == nil {
+		return ctx
+	}
+	ctx = newRetryPolicyContext(ctx, client.retryPolicy)
+	return ratelimit.NewContext(ctx, client.apiV3Conn.RateLimiter)
+}
+
+// applyProviderContext makes the resources and data sources run with the settings of their own provider,
+// so the provider aliases configured differently don't share them.
+func applyProviderContext(resources, dataSources map[string]*schema.Resource) {
+	for _, r := range resources {
+		if r.CreateContext != nil {
+			r.CreateContext = wrapProviderContext(r.CreateContext)
+		}
+		if r.ReadContext != nil {
+			r.ReadContext = wrapProviderContext(r.ReadContext)
+		}
+		if r.UpdateContext != nil {
+			r.UpdateContext = wrapProviderContext(r.UpdateContext)
+		}
+		if r.DeleteContext != nil {
+			r.DeleteContext = wrapProviderContext(r.DeleteContext)
+		}
+		if r.CustomizeDiff != nil {
+			customizeDiff := r.CustomizeDiff
+			r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				return customizeDiff(providerContext(ctx, meta), d, meta)
+			}
+		}
+		if r.Importer != nil && r.Importer.StateContext != nil {
+			stateContext := r.Importer.StateContext
+			r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return stateContext(providerContext(ctx, meta), d, meta)
+			}
+		}
+	}
+	for _, r := range dataSources {
+		if r.ReadContext != nil {
+			r.ReadContext = wrapProviderContext(r.ReadContext)
+		}
+	}
+}
+
+func wrapProviderContext(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return f(providerContext(ctx, meta), d, meta)
+	}
+}
@@ -0,0 +1,33 @@
+package tencentcloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
+)
+
+func TestProviderContext(t *testing.T) {
+	limiter, err := ratelimit.NewLimiter(ratelimit.Config{Default: 1})
+	assert.NoError(t, err)
+	client := &TencentCloudClient{
+		apiV3Conn:   &connectivity.TencentCloudClient{RateLimiter: limiter},
+		retryPolicy: retryPolicy{MaxAttempts: 3},
+	}
+
+	ctx := providerContext(context.TODO(), client)
+	assert.Same(t, limiter, ratelimit.FromContext(ctx))
+	assert.Equal(t, 3, retryPolicyFromContext(ctx).MaxAttempts)
+
+	// another provider has its own limiter
+	other, err := ratelimit.NewLimiter(ratelimit.Config{Default: 2})
+	assert.NoError(t, err)
+	ctx = providerContext(ctx, &TencentCloudClient{apiV3Conn: &connectivity.TencentCloudClient{RateLimiter: other}})
+	assert.Same(t, other, ratelimit.FromContext(ctx))
+	assert.Equal(t, 0, retryPolicyFromContext(ctx).MaxAttempts)
+
+	assert.Equal(t, context.TODO(), providerContext(context.TODO(), nil))
+}
@@ -0,0 +1,207 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+)
+
+var (
+	sdkErrorRegexp          = regexp.MustCompile(`(?s)\[TencentCloudSDKError\] Code=([^,]+), Message=(.*?), RequestId=([0-9A-Za-z-]+)`)
+	sdkErrorNoRequestRegexp = regexp.MustCompile(`\[TencentCloudSDKError\] Code=([^,]+), Message=([^\n]*)`)
+)
+
+// apiError is the TencentCloud API error found in a diagnostic, with where it happened.
+type apiError struct {
+	Code      string
+	Message   string
+	RequestId string
+	Service   string
+	Action    string
+	Region    string
+}
+
+// parseApiError finds the TencentCloud API error in the message of an error, the SDK error
+// is usually wrapped by retry timeouts or helper.Error, so it is searched in the text.
+func parseApiError(message string) *apiError {
+	if m := sdkErrorRegexp.FindStringSubmatch(message); m != nil {
+		return &apiError{Code: m[1], Message: strings.TrimSpace(m[2]), RequestId: m[3]}
+	}
+	if m := sdkErrorNoRequestRegexp.FindStringSubmatch(message); m != nil {
+		return &apiError{Code: m[1], Message: strings.TrimSpace(m[2])}
+	}
+	return nil
+}
+
+// errorHints maps the error codes to what can be done about them, the code matches itself
+// and the codes it prefixes, e.g. `LimitExceeded` matches `LimitExceeded.VpcLimitExceeded`.
+var errorHints = []struct {
+	code string
+	hint func(e *apiError) string
+}{
+	{"AuthFailure.UnauthorizedOperation", unauthorizedHint},
+	{"UnauthorizedOperation", unauthorizedHint},
+	{"OperationDenied.AccessDenied", unauthorizedHint},
+	{"AuthFailure.SecretIdNotFound", credentialHint},
+	{"AuthFailure.SignatureFailure", credentialHint},
+	{"AuthFailure.InvalidSecretId", credentialHint},
+	{"AuthFailure.TokenFailure", func(e *apiError) string {
+		return "the security token is invalid or expired, refresh `security_token` or check the `assume_role` settings of the provider"
+	}},
+	{"AuthFailure.SignatureExpire", func(e *apiError) string {
+		return "the request signature is expired, check that the clock of this machine is in sync"
+	}},
+	{"ResourceInsufficient", func(e *apiError) string {
+		return "the resource is sold out or not enough in the region or zone, try another zone or specification, or retry later"
+	}},
+	{"ResourcesSoldOut", func(e *apiError) string {
+		return "the resource is sold out in the region or zone, try another zone or specification, or retry later"
+	}},
+	{"RequestLimitExceeded", func(e *apiError) string {
+		return "the API rate limit is reached, lower the `rate_limit` of the provider or the `-parallelism` of terraform"
+	}},
+	{"LimitExceeded", func(e *apiError) string {
+		return "a quota of the account is reached, release the unused resources or apply for a higher quota in the console"
+	}},
+	{"InvalidParameterValue.LimitExceeded", func(e *apiError) string {
+		return "a quota of the account is reached, release the unused resources or apply for a higher quota in the console"
+	}},
+	{"FailedOperation.BalanceInsufficient", balanceHint},
+	{"InvalidAccount.InsufficientBalance", balanceHint},
+	{"ResourceInsufficient.Balance", balanceHint},
+}
+
+func unauthorizedHint(e *apiError) string {
+	if e.Service != "" && e.Action != "" {
+		return fmt.Sprintf("grant the CAM action `%s:%s` to the caller, for example by attaching a policy with it to the user or role", e.Service, e.Action)
+	}
+	return "the caller is not authorized, grant the CAM action of the failed API to the user or role"
+}
+
+func credentialHint(e *apiError) string {
+	return "the credential is invalid, check `secret_id` and `secret_key` of the provider, or the profile and environment variables they are sourced from"
+}
+
+func balanceHint(e *apiError) string {
+	return "the balance of the account is not enough, top up the account or use postpaid billing"
+}
+
+// hint returns what can be done about the error, the most specific matching code wins.
+func (e *apiError) hint() string {
+	var hint func(e *apiError) string
+	matched := ""
+	for _, h := range errorHints {
+		if (e.Code == h.code || strings.HasPrefix(e.Code, h.code+".")) && len(h.code) > len(matched) {
+			hint = h.hint
+			matched = h.code
+		}
+	}
+	if hint == nil {
+		return ""
+	}
+	return hint(e)
+}
+
+// apiErrorDiagnostics converts the diagnostics of TencentCloud API errors to the ones
+// carrying the resource, action, region, request id and a hint, the others are kept.
+func apiErrorDiagnostics(diags diag.Diagnostics, resource, operation, id, region string) diag.Diagnostics {
+	result := make(diag.Diagnostics, 0, len(diags))
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			result = append(result, d)
+			continue
+		}
+		e := parseApiError(d.Summary + "\n" + d.Detail)
+		if e == nil {
+			result = append(result, d)
+			continue
+		}
+
+		e.Region = region
+		if info, ok := connectivity.GetRequestInfo(e.RequestId); e.RequestId != "" && ok {
+			e.Service = info.Service
+			e.Action = info.Action
+			if info.Region != "" {
+				e.Region = info.Region
+			}
+		}
+
+		var detail strings.Builder
+		if id != "" {
+			detail.WriteString(fmt.Sprintf("Resource: %s (id: %s)\n", resource, id))
+		} else {
+			detail.WriteString(fmt.Sprintf("Resource: %s\n", resource))
+		}
+		detail.WriteString(fmt.Sprintf("Operation: %s\n", operation))
+		if e.Action != "" {
+			detail.WriteString(fmt.Sprintf("Action: %s:%s\n", e.Service, e.Action))
+		}
+		if e.Region != "" {
+			detail.WriteString(fmt.Sprintf("Region: %s\n", e.Region))
+		}
+		if e.RequestId != "" {
+			detail.WriteString(fmt.Sprintf("RequestId: %s\n", e.RequestId))
+		}
+		if hint := e.hint(); hint != "" {
+			detail.WriteString(fmt.Sprintf("Hint: %s\n", hint))
+		}
+		detail.WriteString("\n")
+		detail.WriteString(d.Summary)
+		if d.Detail != "" {
+			detail.WriteString("\n")
+			detail.WriteString(d.Detail)
+		}
+
+		result = append(result, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("%s: [%s] %s", resource, e.Code, e.Message),
+			Detail:        detail.String(),
+			AttributePath: d.AttributePath,
+		})
+	}
+	return result
+}
+
+// applyProviderDiagnostics makes the resources and data sources report the TencentCloud API errors
+// as diagnostics with the resource, action, region, request id and a hint of how to fix it.
+func applyProviderDiagnostics(resources, dataSources map[string]*schema.Resource) {
+	for name, r := range resources {
+		if r.CreateContext != nil {
+			r.CreateContext = wrapApiErrorDiagnostics(name, "create", r.CreateContext)
+		}
+		if r.ReadContext != nil {
+			r.ReadContext = wrapApiErrorDiagnostics(name, "read", r.ReadContext)
+		}
+		if r.UpdateContext != nil {
+			r.UpdateContext = wrapApiErrorDiagnostics(name, "update", r.UpdateContext)
+		}
+		if r.DeleteContext != nil {
+			r.DeleteContext = wrapApiErrorDiagnostics(name, "delete", r.DeleteContext)
+		}
+	}
+	for name, r := range dataSources {
+		if r.ReadContext != nil {
+			r.ReadContext = wrapApiErrorDiagnostics("data."+name, "read", r.ReadContext)
+		}
+	}
+}
+
+func wrapApiErrorDiagnostics(resource, operation string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := f(ctx, d, meta)
+		if !diags.HasError() {
+			return diags
+		}
+		region := ""
+		if client, ok := meta.(*TencentCloudClient); ok && client != nil && client.apiV3Conn != nil {
+			region = client.apiV3Conn.Region
+		}
+		return apiErrorDiagnostics(diags, resource, operation, d.Id(), region)
+	}
+}
@@ -0,0 +1,82 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func TestParseApiError(t *testing.T) {
+	sdkErr := sdkErrors.NewTencentCloudSDKError("LimitExceeded.VpcLimitExceeded", "vpc quota, max 20", "request-1")
+
+	e := parseApiError(sdkErr.Error())
+	assert.Equal(t, &apiError{Code: "LimitExceeded.VpcLimitExceeded", Message: "vpc quota, max 20", RequestId: "request-1"}, e)
+
+	// wrapped by retry timeouts and helper.Error
+	timeoutErr := &resource.TimeoutError{LastError: sdkErr, Timeout: 1}
+	assert.Equal(t, "request-1", parseApiError(timeoutErr.Error()).RequestId)
+	assert.Equal(t, "request-1", parseApiError(helper.WrapError(sdkErr, "vpc-1", "").Error()).RequestId)
+
+	e = parseApiError(sdkErrors.NewTencentCloudSDKError("ClientError.NetworkError", "timeout", "").Error())
+	assert.Equal(t, &apiError{Code: "ClientError.NetworkError", Message: "timeout"}, e)
+
+	assert.Nil(t, parseApiError("vpc-1 not found"))
+}
+
+func TestApiErrorHint(t *testing.T) {
+	e := &apiError{Code: "AuthFailure.UnauthorizedOperation", Service: "vpc", Action: "CreateVpc"}
+	assert.Contains(t, e.hint(), "`vpc:CreateVpc`")
+
+	e = &apiError{Code: "UnauthorizedOperation.CamNoAuth"}
+	assert.Contains(t, e.hint(), "not authorized")
+
+	assert.Contains(t, (&apiError{Code: "LimitExceeded.VpcLimitExceeded"}).hint(), "quota")
+	assert.Contains(t, (&apiError{Code: "ResourceInsufficient"}).hint(), "sold out")
+	assert.Contains(t, (&apiError{Code: "ResourceInsufficient.Balance"}).hint(), "balance")
+	assert.Contains(t, (&apiError{Code: "RequestLimitExceeded"}).hint(), "rate_limit")
+	assert.Empty(t, (&apiError{Code: "InvalidParameter"}).hint())
+	assert.Empty(t, (&apiError{Code: "LimitExceededFoo"}).hint())
+}
+
+func TestApplyProviderDiagnostics(t *testing.T) {
+	requestId := "request-diagnostics"
+	sdkErr := sdkErrors.NewTencentCloudSDKError("AuthFailure.UnauthorizedOperation", "you are not authorized", requestId)
+
+	resources := map[string]*schema.Resource{
+		"tencentcloud_vpc": {
+			Schema: map[string]*schema.Schema{},
+			CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				return diag.FromErr(fmt.Errorf("create vpc failed: %w", sdkErr))
+			},
+			ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				return diag.Errorf("vpc not found")
+			},
+		},
+	}
+	applyProviderDiagnostics(resources, nil)
+
+	client := &TencentCloudClient{apiV3Conn: &connectivity.TencentCloudClient{Region: "ap-guangzhou"}}
+	d := resources["tencentcloud_vpc"].TestResourceData()
+
+	diags := resources["tencentcloud_vpc"].CreateContext(context.TODO(), d, client)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, "tencentcloud_vpc: [AuthFailure.UnauthorizedOperation] you are not authorized", diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, "Operation: create\n")
+	assert.Contains(t, diags[0].Detail, "Region: ap-guangzhou\n")
+	assert.Contains(t, diags[0].Detail, "RequestId: "+requestId+"\n")
+	assert.Contains(t, diags[0].Detail, "Hint: ")
+	assert.Contains(t, diags[0].Detail, "create vpc failed")
+
+	// the errors which are not from the API are kept
+	diags = resources["tencentcloud_vpc"].ReadContext(context.TODO(), d, client)
+	assert.Equal(t, diag.Errorf("vpc not found"), diags)
+}
@@ -0,0 +1,98 @@
+package tencentcloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	DEFAULT_PROFILE                = "default"
+	DEFAULT_SHARED_CREDENTIALS_DIR = "~/.tccli"
+)
+
+// tccliProfile is the profile written by tccli, it is made up of
+// `<profile>.credential` and `<profile>.configure` in the shared credentials dir.
+type tccliProfile struct {
+	SecretId        string
+	SecretKey       string
+	Token           string
+	RoleArn         string
+	RoleSessionName string
+	Region          string
+}
+
+type tccliCredential struct {
+	SecretId        string `json:"secretId"`
+	SecretKey       string `json:"secretKey"`
+	Token           string `json:"token"`
+	RoleArn         string `json:"role-arn"`
+	RoleSessionName string `json:"role-session-name"`
+}
+
+type tccliConfigure struct {
+	SysParam struct {
+		Region string `json:"region"`
+	} `json:"_sys_param"`
+}
+
+// loadTccliProfile reads the profile from the shared credentials dir,
+// returns nil if neither the credential file nor the configure file exists.
+func loadTccliProfile(sharedCredentialsDir, profile string) (*tccliProfile, error) {
+	if sharedCredentialsDir == "" {
+		sharedCredentialsDir = DEFAULT_SHARED_CREDENTIALS_DIR
+	}
+	if profile == "" {
+		profile = DEFAULT_PROFILE
+	}
+
+	dir, err := homedir.Expand(sharedCredentialsDir)
+	if err != nil {
+		return nil, fmt.Errorf("expand shared credentials dir %s failed, reason: %v", sharedCredentialsDir, err)
+	}
+
+	var (
+		credential tccliCredential
+		configure  tccliConfigure
+	)
+
+	credentialFound, err := readTccliFile(filepath.Join(dir, profile+".credential"), &credential)
+	if err != nil {
+		return nil, err
+	}
+	configureFound, err := readTccliFile(filepath.Join(dir, profile+".configure"), &configure)
+	if err != nil {
+		return nil, err
+	}
+	if !credentialFound && !configureFound {
+		return nil, nil
+	}
+
+	return &tccliProfile{
+		SecretId:        strings.TrimSpace(credential.SecretId),
+		SecretKey:       strings.TrimSpace(credential.SecretKey),
+		Token:           strings.TrimSpace(credential.Token),
+		RoleArn:         strings.TrimSpace(credential.RoleArn),
+		RoleSessionName: strings.TrimSpace(credential.RoleSessionName),
+		Region:          strings.TrimSpace(configure.SysParam.Region),
+	}, nil
+}
+
+func readTccliFile(path string, v interface{}) (bool, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("read tccli profile file %s failed, reason: %v", path, err)
+	}
+	if err := json.Unmarshal(content, v); err != nil {
+		return false, fmt.Errorf("parse tccli profile file %s failed, reason: %v", path, err)
+	}
+	return true, nil
+}
@@ -0,0 +1,96 @@
+package tencentcloud
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTestTccliProfile(t *testing.T, dir, profile, credential, configure string) {
+	if credential != "" {
+		if err := ioutil.WriteFile(filepath.Join(dir, profile+".credential"), []byte(credential), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if configure != "" {
+		if err := ioutil.WriteFile(filepath.Join(dir, profile+".configure"), []byte(configure), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestLoadTccliProfile(t *testing.T) {
+	dir := t.TempDir()
+	writeTestTccliProfile(t, dir, "dev",
+		`{"secretId": " AKIDxxx ", "secretKey": "xxx", "role-arn": "qcs::cam::uin/100000:roleName/ops", "role-session-name": "ci"}`,
+		`{"_sys_param": {"arrayCount": 10, "output": "json", "region": "ap-shanghai"}, "cvm": {"endpoint": "cvm.tencentcloudapi.com"}}`)
+
+	profile, err := loadTccliProfile(dir, "dev")
+	assert.Nil(t, err)
+	assert.Equal(t, &tccliProfile{
+		SecretId:        "AKIDxxx",
+		SecretKey:       "xxx",
+		RoleArn:         "qcs::cam::uin/100000:roleName/ops",
+		RoleSessionName: "ci",
+		Region:          "ap-shanghai",
+	}, profile)
+
+	profile, err = loadTccliProfile(dir, "missing")
+	assert.Nil(t, err)
+	assert.Nil(t, profile)
+
+	writeTestTccliProfile(t, dir, "broken", `{"secretId":`, "")
+	_, err = loadTccliProfile(dir, "broken")
+	assert.NotNil(t, err)
+}
+
+func TestProviderConfigureWithProfile(t *testing.T) {
+	for _, env := range []string{PROVIDER_SECRET_ID, PROVIDER_SECRET_KEY, PROVIDER_SECURITY_TOKEN, PROVIDER_REGION,
+		PROVIDER_ASSUME_ROLE_ARN, PROVIDER_ASSUME_ROLE_SESSION_NAME, PROVIDER_SHARED_CREDENTIALS_DIR, PROVIDER_PROFILE, PROVIDER_CAM_ROLE_NAME} {
+		if v, ok := os.LookupEnv(env); ok {
+			defer os.Setenv(env, v)
+			os.Unsetenv(env)
+		}
+	}
+
+	dir := t.TempDir()
+	writeTestTccliProfile(t, dir, DEFAULT_PROFILE,
+		`{"secretId": "AKIDxxx", "secretKey": "xxx"}`,
+		`{"_sys_param": {"region": "ap-shanghai"}}`)
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"shared_credentials_dir": dir,
+	})
+	meta, err := providerConfigure(d)
+	assert.Nil(t, err)
+
+	client := meta.(*TencentCloudClient).apiV3Conn
+	assert.Equal(t, "AKIDxxx", client.Credential.GetSecretId())
+	assert.Equal(t, "xxx", client.Credential.GetSecretKey())
+	assert.Equal(t, "ap-shanghai", client.Region)
+
+	// provider config takes precedence over the profile
+	d = schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"shared_credentials_dir": dir,
+		"secret_id":              "AKIDyyy",
+		"secret_key":             "yyy",
+		"region":                 "ap-guangzhou",
+	})
+	meta, err = providerConfigure(d)
+	assert.Nil(t, err)
+
+	client = meta.(*TencentCloudClient).apiV3Conn
+	assert.Equal(t, "AKIDyyy", client.Credential.GetSecretId())
+	assert.Equal(t, "ap-guangzhou", client.Region)
+
+	d = schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"shared_credentials_dir": dir,
+		"profile":                "missing",
+	})
+	_, err = providerConfigure(d)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,210 @@
+package tencentcloud
+
+import (
+	"context"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/pkg/errors"
+	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	"github.com/tencentyun/cos-go-sdk-v5"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+)
+
+const (
+	DEFAULT_RETRY_MIN_BACKOFF = 1 * time.Second
+	DEFAULT_RETRY_MAX_BACKOFF = 30 * time.Second
+
+	STATE_POLL_MIN_INTERVAL = 500 * time.Millisecond
+	STATE_POLL_MAX_INTERVAL = 10 * time.Second
+)
+
+// retryPolicy is the provider level `retry` setting, it is kept by the provider meta and carried by
+// the context of the operations, see providerContext.
+type retryPolicy struct {
+	// ErrorCodes are the retryable error codes besides retryableErrorCode and retryableCosErrorCode
+	ErrorCodes []string
+	// ServiceErrorCodes are the retryable error codes of the services, keyed by the service name
+	ServiceErrorCodes map[string][]string
+	// MaxAttempts is the max number of attempts of a retry loop, 0 means it is only limited by the timeout
+	MaxAttempts int
+	MinBackoff  time.Duration
+	MaxBackoff  time.Duration
+}
+
+type retryPolicyKey struct{}
+
+// newRetryPolicyContext returns ctx carrying the retry policy.
+func newRetryPolicyContext(ctx context.Context, policy retryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+// retryPolicyFromContext returns the retry policy carried by ctx, or the empty one without any setting.
+func retryPolicyFromContext(ctx context.Context) retryPolicy {
+	policy, _ := ctx.Value(retryPolicyKey{}).(retryPolicy)
+	return policy
+}
+
+// errorCodes returns the configured retryable error codes of the service.
+func (me retryPolicy) errorCodes(service string) []string {
+	serviceCodes := me.ServiceErrorCodes[service]
+	if len(serviceCodes) == 0 {
+		return me.ErrorCodes
+	}
+	codes := make([]string, 0, len(me.ErrorCodes)+len(serviceCodes))
+	codes = append(codes, me.ErrorCodes...)
+	return append(codes, serviceCodes...)
+}
+
+// retryable tells if err has one of the configured retryable error codes of its service.
+func (me retryPolicy) retryable(err error) bool {
+	switch realErr := errors.Cause(err).(type) {
+	case *sdkErrors.TencentCloudSDKError:
+		service := ""
+		if info, ok := connectivity.GetRequestInfo(realErr.RequestId); ok {
+			service = info.Service
+		}
+		return isExpectError(realErr, me.errorCodes(service))
+	case *cos.ErrorResponse:
+		return isCosExpectedError(realErr, me.errorCodes("cos"))
+	}
+	return false
+}
+
+// apply makes the non-retryable error of retryErr retryable if its code is configured retryable.
+func (me retryPolicy) apply(retryErr *resource.RetryError) *resource.RetryError {
+	if retryErr == nil || retryErr.Retryable || !me.retryable(retryErr.Err) {
+		return retryErr
+	}
+	log.Printf("[CRITAL] Retryable configured error: %v", retryErr.Err)
+	return apiRetryableError(retryErr.Err)
+}
+
+// customBackoff tells if the retry loops should use the backoff of the policy instead of resource.RetryContext.
+func (me retryPolicy) customBackoff() bool {
+	return me.MaxAttempts > 0 || me.MinBackoff > 0 || me.MaxBackoff > 0
+}
+
+// backoff returns the wait before the next attempt, it doubles with each attempt up to
+// MaxBackoff, and a random half of it is jittered to spread the retries of parallel resources.
+func (me retryPolicy) backoff(attempt int) time.Duration {
+	minBackoff, maxBackoff := me.MinBackoff, me.MaxBackoff
+	if minBackoff <= 0 {
+		minBackoff = DEFAULT_RETRY_MIN_BACKOFF
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = DEFAULT_RETRY_MAX_BACKOFF
+	}
+	if maxBackoff < minBackoff {
+		maxBackoff = minBackoff
+	}
+
+	delay := minBackoff
+	for i := 1; i < attempt && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+
+	half := delay / 2
+	return delay - half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// apiCallError marks the retryable errors of the API calls returned by retryError, only they are limited
+// by the max attempts and backoff of the provider `retry` block. The other retryable errors are the
+// ones of waiting for a state, which are only limited by the timeout.
+type apiCallError struct {
+	error
+}
+
+func (me *apiCallError) Unwrap() error {
+	return me.error
+}
+
+// apiRetryableError is resource.RetryableError for an error of an API call.
+func apiRetryableError(err error) *resource.RetryError {
+	return resource.RetryableError(&apiCallError{err})
+}
+
+// unwrapApiCallError returns the error marked by apiRetryableError, or err itself.
+func unwrapApiCallError(err error) (error, bool) {
+	if e, ok := err.(*apiCallError); ok {
+		return e.error, true
+	}
+	return err, false
+}
+
+// statePollInterval returns the wait before the next poll of a state, which is the same as
+// resource.RetryContext: it starts at 500ms and doubles up to 10s.
+func statePollInterval(poll int) time.Duration {
+	delay := STATE_POLL_MIN_INTERVAL
+	for i := 1; i < poll && delay < STATE_POLL_MAX_INTERVAL; i++ {
+		delay *= 2
+	}
+	if delay > STATE_POLL_MAX_INTERVAL {
+		delay = STATE_POLL_MAX_INTERVAL
+	}
+	return delay
+}
+
+// retryContext retries f until it succeeds like resource.RetryContext with the retry policy of ctx.
+// The errors with the configured codes are retryable, and the retryable errors of the API calls honor
+// the max attempts and backoff of the policy, while waiting for a state is only limited by the timeout.
+func retryContext(ctx context.Context, timeout time.Duration, f resource.RetryFunc) error {
+	policy := retryPolicyFromContext(ctx)
+	if !policy.customBackoff() {
+		return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+			retryErr := policy.apply(f())
+			if retryErr != nil {
+				retryErr.Err, _ = unwrapApiCallError(retryErr.Err)
+			}
+			return retryErr
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	attempts, polls := 0, 0
+	for {
+		retryErr := policy.apply(f())
+		if retryErr == nil {
+			return nil
+		}
+		err, isApiCallError := unwrapApiCallError(retryErr.Err)
+		if !retryErr.Retryable {
+			return err
+		}
+
+		var delay time.Duration
+		if isApiCallError {
+			attempts++
+			if policy.MaxAttempts > 0 && attempts >= policy.MaxAttempts {
+				log.Printf("[CRITAL] give up retrying after %d attempts, last error: %v", attempts, err)
+				return err
+			}
+			delay = policy.backoff(attempts)
+		} else {
+			// the API call succeeds, only the state is not the expected one yet
+			attempts = 0
+			polls++
+			delay = statePollInterval(polls)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			// the last error is more useful than the timeout, the same as resource.RetryContext
+			if err != nil {
+				return err
+			}
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
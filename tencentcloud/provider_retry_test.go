@@ -0,0 +1,134 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	"github.com/tencentyun/cos-go-sdk-v5"
+)
+
+func TestRetryPolicyErrorCodes(t *testing.T) {
+	policy := retryPolicy{
+		ErrorCodes:        []string{"InternalError.Busy"},
+		ServiceErrorCodes: map[string][]string{"vpc": {"UnsupportedOperation.Locked"}},
+	}
+	assert.Equal(t, []string{"InternalError.Busy", "UnsupportedOperation.Locked"}, policy.errorCodes("vpc"))
+	assert.Equal(t, []string{"InternalError.Busy"}, policy.errorCodes("cvm"))
+	assert.Empty(t, retryPolicy{}.errorCodes(""))
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := retryPolicy{MinBackoff: 2 * time.Second, MaxBackoff: 10 * time.Second}
+	for attempt, expected := range map[int]time.Duration{1: 2 * time.Second, 2: 4 * time.Second, 3: 8 * time.Second, 4: 10 * time.Second, 10: 10 * time.Second} {
+		for i := 0; i < 10; i++ {
+			backoff := policy.backoff(attempt)
+			assert.True(t, backoff >= expected/2 && backoff <= expected, "attempt %d backoff %s", attempt, backoff)
+		}
+	}
+
+	backoff := retryPolicy{MaxAttempts: 3}.backoff(1)
+	assert.True(t, backoff >= DEFAULT_RETRY_MIN_BACKOFF/2 && backoff <= DEFAULT_RETRY_MIN_BACKOFF)
+}
+
+func TestRetryPolicyRetryable(t *testing.T) {
+	sdkErr := sdkErrors.NewTencentCloudSDKError("InternalError.Busy", "busy", "request-1")
+	request, _ := http.NewRequest("GET", "https://examplebucket-1250000000.cos.ap-guangzhou.myqcloud.com/", nil)
+	cosErr := &cos.ErrorResponse{
+		Response:  &http.Response{Header: http.Header{}, Request: request},
+		Code:      "AccessDenied",
+		RequestID: "request-cos",
+		TraceID:   "trace-cos",
+	}
+	assert.False(t, retryError(sdkErr).Retryable)
+	assert.False(t, retryError(cosErr).Retryable)
+	assert.False(t, retryPolicy{}.retryable(sdkErr))
+
+	policy := retryPolicy{
+		ErrorCodes:        []string{"InternalError"},
+		ServiceErrorCodes: map[string][]string{"cos": {"AccessDenied"}},
+	}
+	assert.True(t, policy.retryable(sdkErr))
+	assert.True(t, policy.retryable(cosErr))
+	assert.False(t, policy.retryable(sdkErrors.NewTencentCloudSDKError("InvalidParameter", "invalid", "request-2")))
+	assert.True(t, policy.apply(retryError(sdkErr)).Retryable)
+	assert.True(t, retryableErrorFunc(newRetryPolicyContext(context.TODO(), policy))(cosErr))
+	assert.False(t, retryableErrorFunc(context.TODO())(cosErr))
+
+	// the configured codes are retried by retryContext with the policy of ctx only
+	attempts := 0
+	err := retryContext(newRetryPolicyContext(context.TODO(), policy), time.Minute, func() *resource.RetryError {
+		attempts++
+		if attempts < 2 {
+			return retryError(sdkErr)
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+
+	err = retryContext(context.TODO(), time.Minute, func() *resource.RetryError {
+		return retryError(sdkErr)
+	})
+	assert.Equal(t, sdkErr, err)
+}
+
+func TestRetryContext(t *testing.T) {
+	ctx := newRetryPolicyContext(context.TODO(), retryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond})
+
+	attempts := 0
+	err := retryContext(ctx, time.Minute, func() *resource.RetryError {
+		attempts++
+		return retryError(sdkErrors.NewTencentCloudSDKError(InternalError, fmt.Sprintf("attempt %d", attempts), "request-1"), InternalError)
+	})
+	sdkErr, ok := err.(*sdkErrors.TencentCloudSDKError)
+	assert.True(t, ok)
+	assert.Equal(t, "attempt 3", sdkErr.Message)
+	assert.Equal(t, 3, attempts)
+
+	attempts = 0
+	err = retryContext(ctx, time.Minute, func() *resource.RetryError {
+		attempts++
+		if attempts < 2 {
+			return apiRetryableError(fmt.Errorf("attempt %d", attempts))
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+
+	err = retryContext(ctx, time.Minute, func() *resource.RetryError {
+		return resource.NonRetryableError(fmt.Errorf("not retryable"))
+	})
+	assert.EqualError(t, err, "not retryable")
+
+	// waiting for a state is not limited by the max attempts
+	attempts = 0
+	err = retryContext(ctx, time.Minute, func() *resource.RetryError {
+		attempts++
+		if attempts < 5 {
+			return resource.RetryableError(fmt.Errorf("status is PENDING"))
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 5, attempts)
+
+	// the timeout still limits the retrying
+	ctx = newRetryPolicyContext(context.TODO(), retryPolicy{MinBackoff: time.Second})
+	err = retryContext(ctx, 10*time.Millisecond, func() *resource.RetryError {
+		return apiRetryableError(fmt.Errorf("timeout"))
+	})
+	assert.EqualError(t, err, "timeout")
+}
+
+func TestStatePollInterval(t *testing.T) {
+	assert.Equal(t, STATE_POLL_MIN_INTERVAL, statePollInterval(1))
+	assert.Equal(t, 2*STATE_POLL_MIN_INTERVAL, statePollInterval(2))
+	assert.Equal(t, STATE_POLL_MAX_INTERVAL, statePollInterval(100))
+}
@@ -0,0 +1,289 @@
+package tencentcloud
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// tagResources are the resources which manage `tags` through the tag service,
+// the provider level `default_tags` and `ignore_tags` take effect on them.
+var tagResources = []string{
+	"tencentcloud_api_gateway_api_app",
+	"tencentcloud_api_gateway_service",
+	"tencentcloud_apm_instance",
+	"tencentcloud_as_scaling_group",
+	"tencentcloud_cam_role",
+	"tencentcloud_cam_role_by_name",
+	"tencentcloud_cam_service_linked_role",
+	"tencentcloud_cam_user",
+	"tencentcloud_cat_task_set",
+	"tencentcloud_cbs_snapshot",
+	"tencentcloud_cbs_storage",
+	"tencentcloud_ccn",
+	"tencentcloud_cdn_domain",
+	"tencentcloud_cfs_file_system",
+	"tencentcloud_cfs_snapshot",
+	"tencentcloud_ckafka_datahub_topic",
+	"tencentcloud_clb_instance",
+	"tencentcloud_clickhouse_instance",
+	"tencentcloud_cls_alarm",
+	"tencentcloud_cls_alarm_notice",
+	"tencentcloud_cls_logset",
+	"tencentcloud_cynosdb_cluster",
+	"tencentcloud_default_security_group",
+	"tencentcloud_default_vpc",
+	"tencentcloud_eb_event_bus",
+	"tencentcloud_eb_event_rule",
+	"tencentcloud_eip",
+	"tencentcloud_eks_cluster",
+	"tencentcloud_elasticsearch_instance",
+	"tencentcloud_emr_cluster",
+	"tencentcloud_eni",
+	"tencentcloud_gaap_proxy",
+	"tencentcloud_gaap_realserver",
+	"tencentcloud_image",
+	"tencentcloud_instance",
+	"tencentcloud_key_pair",
+	"tencentcloud_kms_external_key",
+	"tencentcloud_kms_key",
+	"tencentcloud_kubernetes_cluster",
+	"tencentcloud_mariadb_dedicatedcluster_db_instance",
+	"tencentcloud_mariadb_hour_db_instance",
+	"tencentcloud_mariadb_instance",
+	"tencentcloud_mongodb_instance",
+	"tencentcloud_mongodb_sharding_instance",
+	"tencentcloud_mongodb_standby_instance",
+	"tencentcloud_monitor_grafana_instance",
+	"tencentcloud_monitor_tmp_instance",
+	"tencentcloud_mysql_instance",
+	"tencentcloud_mysql_readonly_instance",
+	"tencentcloud_nat_gateway",
+	"tencentcloud_postgresql_base_backup",
+	"tencentcloud_postgresql_instance",
+	"tencentcloud_postgresql_readonly_instance",
+	"tencentcloud_private_dns_zone",
+	"tencentcloud_redis_instance",
+	"tencentcloud_route_table",
+	"tencentcloud_rum_taw_instance",
+	"tencentcloud_scf_function",
+	"tencentcloud_security_group",
+	"tencentcloud_sqlserver_basic_instance",
+	"tencentcloud_sqlserver_general_cloud_ro_instance",
+	"tencentcloud_sqlserver_instance",
+	"tencentcloud_sqlserver_readonly_instance",
+	"tencentcloud_ssl_certificate",
+	"tencentcloud_ssm_product_secret",
+	"tencentcloud_ssm_secret",
+	"tencentcloud_ssm_ssh_key_pair_secret",
+	"tencentcloud_subnet",
+	"tencentcloud_tcr_customized_domain",
+	"tencentcloud_tcr_immutable_tag_rule",
+	"tencentcloud_tcr_instance",
+	"tencentcloud_tcr_service_account",
+	"tencentcloud_tcr_webhook_trigger",
+	"tencentcloud_tdmq_instance",
+	"tencentcloud_tem_application",
+	"tencentcloud_tem_environment",
+	"tencentcloud_teo_zone",
+	"tencentcloud_trocket_rocketmq_instance",
+	"tencentcloud_tse_cngw_canary_rule",
+	"tencentcloud_tse_cngw_gateway",
+	"tencentcloud_tse_cngw_service",
+	"tencentcloud_tse_instance",
+	"tencentcloud_tsf_cluster",
+	"tencentcloud_tsf_group",
+	"tencentcloud_tsf_microservice",
+	"tencentcloud_vpc",
+	"tencentcloud_vpc_acl",
+	"tencentcloud_vpc_bandwidth_package",
+	"tencentcloud_vpc_flow_log",
+	"tencentcloud_vpn_connection",
+	"tencentcloud_vpn_customer_gateway",
+	"tencentcloud_vpn_gateway",
+}
+
+// ignoreTagsConfig is the provider level `ignore_tags` setting.
+type ignoreTagsConfig struct {
+	keys        []string
+	keyPrefixes []string
+}
+
+// ignored returns whether the tag key should be ignored.
+func (me *ignoreTagsConfig) ignored(key string) bool {
+	if me == nil {
+		return false
+	}
+	for _, k := range me.keys {
+		if k == key {
+			return true
+		}
+	}
+	for _, prefix := range me.keyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeDefaultTags merges tags into default tags, tags of the resource take precedence
+// over the same keys, and the keys matching `ignore_tags` are removed.
+func mergeDefaultTags(defaultTags, tags map[string]string, ignoreTags *ignoreTagsConfig) map[string]string {
+	result := make(map[string]string, len(defaultTags)+len(tags))
+	for k, v := range defaultTags {
+		result[k] = v
+	}
+	for k, v := range tags {
+		result[k] = v
+	}
+	for k := range result {
+		if ignoreTags.ignored(k) {
+			delete(result, k)
+		}
+	}
+	return result
+}
+
+// applyProviderTags makes the `tags` of tagResources aware of `default_tags` and `ignore_tags`:
+// the merged tags are shown at plan time, and the ignored tags are removed after reading.
+func applyProviderTags(resources map[string]*schema.Resource) {
+	for _, name := range tagResources {
+		r, ok := resources[name]
+		if !ok {
+			continue
+		}
+		tagsSchema, ok := r.Schema["tags"]
+		if !ok || tagsSchema.Type != schema.TypeMap || !tagsSchema.Optional || tagsSchema.ForceNew || len(tagsSchema.ConflictsWith) > 0 {
+			continue
+		}
+
+		// the merged tags can only be set on computed attributes, copy the schema
+		// because some of them are shared by other resources or data sources.
+		s := *tagsSchema
+		s.Computed = true
+		r.Schema["tags"] = &s
+
+		r.CustomizeDiff = composeTagsCustomizeDiff(r.CustomizeDiff)
+
+		if r.Create != nil {
+			r.Create = wrapTagsFilter(r.Create)
+		}
+		if r.Read != nil {
+			r.Read = wrapTagsFilter(r.Read)
+		}
+		if r.Update != nil {
+			r.Update = wrapTagsFilter(r.Update)
+		}
+		if r.CreateContext != nil {
+			r.CreateContext = wrapTagsFilterContext(r.CreateContext)
+		}
+		if r.ReadContext != nil {
+			r.ReadContext = wrapTagsFilterContext(r.ReadContext)
+		}
+		if r.UpdateContext != nil {
+			r.UpdateContext = wrapTagsFilterContext(r.UpdateContext)
+		}
+	}
+}
+
+func composeTagsCustomizeDiff(customizeDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+		return providerTagsCustomizeDiff(ctx, d, meta)
+	}
+}
+
+// providerTagsCustomizeDiff sets the planned `tags` to configured tags merged with `default_tags`.
+func providerTagsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*TencentCloudClient)
+	if !ok || client == nil {
+		return nil
+	}
+
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	rawTags := rawConfig.GetAttr("tags")
+	if !rawTags.IsWhollyKnown() {
+		return d.SetNewComputed("tags")
+	}
+
+	configTags := make(map[string]string)
+	if !rawTags.IsNull() {
+		for k, v := range rawTags.AsValueMap() {
+			if v.IsNull() {
+				continue
+			}
+			configTags[k] = v.AsString()
+		}
+	}
+
+	tags := mergeDefaultTags(client.defaultTags, configTags, client.ignoreTags)
+	if len(tags) == 0 {
+		// keep the original behavior when there is nothing to set
+		if oldTags, _ := d.GetChange("tags"); len(oldTags.(map[string]interface{})) == 0 {
+			return nil
+		}
+	}
+
+	newTags := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		newTags[k] = v
+	}
+	return d.SetNew("tags", newTags)
+}
+
+// filterIgnoredTags removes the tags matching `ignore_tags` from the state.
+func filterIgnoredTags(d *schema.ResourceData, meta interface{}) error {
+	client, ok := meta.(*TencentCloudClient)
+	if !ok || client == nil || client.ignoreTags == nil || d.Id() == "" {
+		return nil
+	}
+
+	tags, ok := d.Get("tags").(map[string]interface{})
+	if !ok || len(tags) == 0 {
+		return nil
+	}
+
+	filtered := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		if client.ignoreTags.ignored(k) {
+			continue
+		}
+		filtered[k] = v
+	}
+	if len(filtered) == len(tags) {
+		return nil
+	}
+	return d.Set("tags", filtered)
+}
+
+func wrapTagsFilter(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if err := f(d, meta); err != nil {
+			return err
+		}
+		return filterIgnoredTags(d, meta)
+	}
+}
+
+func wrapTagsFilterContext(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := f(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+		if err := filterIgnoredTags(d, meta); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
@@ -0,0 +1,61 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIgnoreTagsConfig(t *testing.T) {
+	var nilConfig *ignoreTagsConfig
+	assert.False(t, nilConfig.ignored("owner"))
+
+	config := &ignoreTagsConfig{
+		keys:        []string{"created_by"},
+		keyPrefixes: []string{"tke-"},
+	}
+	assert.True(t, config.ignored("created_by"))
+	assert.True(t, config.ignored("tke-cluster"))
+	assert.False(t, config.ignored("owner"))
+	assert.False(t, config.ignored("created"))
+}
+
+func TestMergeDefaultTags(t *testing.T) {
+	defaultTags := map[string]string{
+		"owner":       "ops",
+		"cost_center": "infra",
+		"tke-managed": "true",
+	}
+	tags := map[string]string{
+		"owner": "dev",
+		"env":   "test",
+	}
+	ignoreTags := &ignoreTagsConfig{keyPrefixes: []string{"tke-"}}
+
+	assert.Equal(t, map[string]string{
+		"owner":       "dev",
+		"cost_center": "infra",
+		"env":         "test",
+	}, mergeDefaultTags(defaultTags, tags, ignoreTags))
+
+	assert.Equal(t, map[string]string{
+		"owner": "dev",
+		"env":   "test",
+	}, mergeDefaultTags(nil, tags, nil))
+
+	assert.Empty(t, mergeDefaultTags(nil, nil, nil))
+}
+
+func TestApplyProviderTags(t *testing.T) {
+	provider := Provider()
+
+	tagsSchema := provider.ResourcesMap["tencentcloud_vpc"].Schema["tags"]
+	assert.True(t, tagsSchema.Optional)
+	assert.True(t, tagsSchema.Computed)
+	assert.NotNil(t, provider.ResourcesMap["tencentcloud_vpc"].CustomizeDiff)
+
+	// data sources are not affected
+	if tags, ok := provider.DataSourcesMap["tencentcloud_vpc_instances"].Schema["tags"]; ok {
+		assert.False(t, tags.Computed)
+	}
+}
@@ -1,9 +1,12 @@
 package tencentcloud
 
 import (
+	"flag"
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
@@ -39,6 +42,12 @@ const (
 	SMS_PROVIDER_SECRET_KEY           = "TENCENTCLOUD_SECRET_KEY_SMS"
 	TSF_PROVIDER_SECRET_ID            = "TENCENTCLOUD_SECRET_ID_TSF"
 	TSF_PROVIDER_SECRET_KEY           = "TENCENTCLOUD_SECRET_KEY_TSF"
+
+	// ACC_CASSETTE_MODE is `record` to record the API requests of the acceptance tests to the
+	// cassettes, or `replay` to run them offline with the responses in the cassettes
+	ACC_CASSETTE_MODE = "TENCENTCLOUD_ACC_CASSETTE_MODE"
+	// ACC_CASSETTE_DIR is the directory of the cassettes, testdata/cassettes by default
+	ACC_CASSETTE_DIR = "TENCENTCLOUD_ACC_CASSETTE_DIR"
 )
 
 func init() {
@@ -63,7 +72,98 @@ func TestProviderImpl(t *testing.T) {
 	var _ = Provider()
 }
 
+func TestProviderConfigureCamRoleWithWebIdentity(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"region":        defaultRegion,
+		"cam_role_name": "my-cam-role-name",
+		"assume_role_with_web_identity": []interface{}{map[string]interface{}{
+			"role_arn":           "qcs::cam::uin/100000000001:roleName/my-role",
+			"session_name":       "my-session",
+			"session_duration":   3600,
+			"provider_id":        "OIDC",
+			"web_identity_token": "token",
+		}},
+	})
+	if _, err := providerConfigure(d); err == nil || !strings.Contains(err.Error(), "can not be used together") {
+		t.Errorf("expect the conflict of cam_role_name and assume_role_with_web_identity, got %v", err)
+	}
+}
+
+// testAccCassette records or replays the API requests of the test t with the cassette named by
+// the test if ACC_CASSETTE_MODE is set, and returns true for replaying, where no credential is
+// needed. The recorder is shared by all the clients, so the tests must not run in parallel then.
+func testAccCassette(t *testing.T) bool {
+	mode := connectivity.RecorderMode(os.Getenv(ACC_CASSETTE_MODE))
+	if mode == "" {
+		return false
+	}
+	if parallel := flag.Lookup("test.parallel"); parallel != nil && parallel.Value.String() != "1" {
+		t.Fatalf("%s is set, run the tests with -parallel 1 to record or replay the cassettes", ACC_CASSETTE_MODE)
+	}
+
+	return testAccStartCassette(t, mode)
+}
+
+// testAccPreCheckReplay replays the cassette of the test t in testdata/cassettes unless ACC_CASSETTE_MODE is set,
+// so the test runs without credentials. It must not run in parallel, as the recorder is shared by all the clients.
+func testAccPreCheckReplay(t *testing.T) {
+	if os.Getenv(ACC_CASSETTE_MODE) != "" {
+		testAccPreCheck(t)
+		return
+	}
+	// the credentials for replaying are not left to the other tests
+	for _, key := range []string{PROVIDER_SECRET_ID, PROVIDER_SECRET_KEY, PROVIDER_REGION} {
+		t.Setenv(key, os.Getenv(key))
+	}
+	testAccStartCassette(t, connectivity.RecorderModeReplay)
+}
+
+// testAccStartCassette records or replays the API requests of the test t with its cassette as mode.
+func testAccStartCassette(t *testing.T, mode connectivity.RecorderMode) bool {
+	dir := os.Getenv(ACC_CASSETTE_DIR)
+	if dir == "" {
+		dir = filepath.Join("testdata", "cassettes")
+	}
+	recorder, err := connectivity.NewRecorder(filepath.Join(dir, strings.ReplaceAll(t.Name(), "/", "_")+".json"), mode)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	started, err := connectivity.StartRecorder(t.Name(), recorder)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if !started {
+		return mode == connectivity.RecorderModeReplay
+	}
+	t.Cleanup(func() {
+		connectivity.StopRecorder(t.Name())
+		// keep the last cassette recorded if the test fails
+		if t.Failed() {
+			return
+		}
+		if err := recorder.Save(); err != nil {
+			t.Errorf("save cassette failed, reason: %v", err)
+		}
+	})
+
+	if mode != connectivity.RecorderModeReplay {
+		return false
+	}
+	for _, key := range []string{PROVIDER_SECRET_ID, PROVIDER_SECRET_KEY} {
+		if os.Getenv(key) == "" {
+			os.Setenv(key, "replay")
+		}
+	}
+	if v := os.Getenv(PROVIDER_REGION); v == "" {
+		os.Setenv(PROVIDER_REGION, defaultRegion)
+	}
+	return true
+}
+
 func testAccPreCheck(t *testing.T) {
+	if testAccCassette(t) {
+		return
+	}
 	if v := os.Getenv(PROVIDER_SECRET_ID); v == "" {
 		t.Fatalf("%v must be set for acceptance tests\n", PROVIDER_SECRET_ID)
 	}
@@ -93,6 +193,9 @@ func testAccStepSetRegion(t *testing.T, region string) {
 }
 
 func testAccPreCheckCommon(t *testing.T, accountType string) {
+	if testAccCassette(t) {
+		return
+	}
 	if v := os.Getenv(PROVIDER_REGION); v == "" {
 		log.Printf("[INFO] Testing: Using %s as test region", defaultRegion)
 		os.Setenv(PROVIDER_REGION, defaultRegion)
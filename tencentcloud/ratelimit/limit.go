@@ -1,73 +1,299 @@
 package ratelimit
 
 import (
+	"context"
 	"fmt"
+	"io/ioutil"
 	"log"
-	"math/rand"
+	"math"
 	"runtime"
 	"strings"
 	"sync"
 	"time"
 
-	"github.com/yangwenmai/ratelimit/simpleratelimit"
+	"gopkg.in/yaml.v2"
 )
 
+// the prefix of the service files, which is trimmed from the namespace to get the service name
+const servicePrefix = "service_tencentcloud_"
+
 var (
 	limitConfig = make(map[string]int64)
 
-	limitContainer = make(map[string]*simpleratelimit.RateLimiter)
-
-	locker sync.Mutex
+	// defaultLimiter limits the requests whose context carries no Limiter
+	defaultLimiter = &Limiter{buckets: make(map[string]*bucket)}
 )
 
-func ProCheck(namespace, action string) {
-
-	key := fmt.Sprintf("%s.%s", namespace, action)
+// Config overrides the builtin limits of the API requests, the limits are QPS
+type Config struct {
+	// Default is the limit of the actions without any limit configured, 0 means DefaultLimit
+	Default float64 `yaml:"default"`
+	// Limits are keyed by `<service>` or `<service>.<Action>`, the service is the name of the service file
+	// without the `service_tencentcloud_` prefix, such as `mysql` of `service_tencentcloud_mysql.go`,
+	// the resource and data source files sending the requests directly are mapped by callerServices
+	Limits map[string]float64 `yaml:"limits"`
+}
 
-	var limit *simpleratelimit.RateLimiter
+// LoadConfigFile reads the Config from a YAML file
+func LoadConfigFile(path string) (config Config, err error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return config, fmt.Errorf("read rate limit config file `%s` failed, reason: %v", path, err)
+	}
+	if err = yaml.UnmarshalStrict(content, &config); err != nil {
+		return config, fmt.Errorf("parse rate limit config file `%s` failed, reason: %v", path, err)
+	}
+	return config, nil
+}
 
-	locker.Lock()
+// Merge returns the Config with the limits of other taking precedence
+func (me Config) Merge(other Config) Config {
+	result := Config{
+		Default: me.Default,
+		Limits:  make(map[string]float64, len(me.Limits)+len(other.Limits)),
+	}
+	if other.Default > 0 {
+		result.Default = other.Default
+	}
+	for key, limit := range me.Limits {
+		result.Limits[key] = limit
+	}
+	for key, limit := range other.Limits {
+		result.Limits[key] = limit
+	}
+	return result
+}
 
-	limitNumber := limitConfig[key]
-	if limitNumber == 0 {
-		limitNumber = DefaultLimit
+// Limiter limits the API requests with its Config, each provider has its own one
+type Limiter struct {
+	lock    sync.Mutex
+	config  Config
+	buckets map[string]*bucket
+}
 
-		if limitConfig[namespace] != 0 {
-			limitNumber = limitConfig[namespace]
+// NewLimiter returns a Limiter of config
+func NewLimiter(config Config) (*Limiter, error) {
+	if config.Default < 0 {
+		return nil, fmt.Errorf("the default rate limit must not be negative")
+	}
+	for key, limit := range config.Limits {
+		if limit <= 0 {
+			return nil, fmt.Errorf("the rate limit of `%s` must be positive", key)
 		}
-		limitConfig[key] = limitNumber
 	}
+	return &Limiter{
+		config:  config,
+		buckets: make(map[string]*bucket),
+	}, nil
+}
 
-	if limitContainer[key] == nil {
-		limitContainer[key] = simpleratelimit.New(int(limitNumber), time.Second)
+type limiterKey struct{}
+
+// NewContext returns a context carrying limiter, the requests checked with it are limited by limiter
+func NewContext(ctx context.Context, limiter *Limiter) context.Context {
+	if limiter == nil {
+		return ctx
 	}
+	return context.WithValue(ctx, limiterKey{}, limiter)
+}
 
-	limit = limitContainer[key]
-	locker.Unlock()
+// FromContext returns the Limiter carried by ctx, or the default one with the builtin limits
+func FromContext(ctx context.Context) *Limiter {
+	if limiter, ok := ctx.Value(limiterKey{}).(*Limiter); ok {
+		return limiter
+	}
+	return defaultLimiter
+}
 
-	old := time.Now()
+// callerServices are the services of the files calling Check other than the service files,
+// so the limits of Config keyed by the service apply to their requests as well
+var callerServices = map[string]string{
+	"data_source_tc_audits":                               "audit",
+	"data_source_tc_clickhouse_backup_job_detail":         "cdwch",
+	"data_source_tc_cvm_disaster_recover_group_quota":     "cvm",
+	"data_source_tc_monitor_data":                         "monitor",
+	"data_source_tc_monitor_policy_conditions":            "monitor",
+	"data_source_tc_monitor_policy_groups":                "monitor",
+	"data_source_tc_monitor_product_event":                "monitor",
+	"data_source_tc_monitor_product_namespace":            "monitor",
+	"data_source_tc_user_info":                            "cam",
+	"resource_tc_api_gateway_api":                         "api_gateway",
+	"resource_tc_as_scaling_group":                        "as",
+	"resource_tc_audit":                                   "audit",
+	"resource_tc_ccn_attachment":                          "ccn",
+	"resource_tc_cdn_domain":                              "cdn",
+	"resource_tc_cfs_access_group":                        "cfs",
+	"resource_tc_cfs_access_rule":                         "cfs",
+	"resource_tc_cfs_file_system":                         "cfs",
+	"resource_tc_clb_attachment":                          "clb",
+	"resource_tc_cynosdb_audit_log_file":                  "cynosdb",
+	"resource_tc_cynosdb_cluster":                         "cynosdb",
+	"resource_tc_cynosdb_readonly_instance":               "cynosdb",
+	"resource_tc_eip":                                     "vpc",
+	"resource_tc_eip_association":                         "vpc",
+	"resource_tc_elasticsearch_instance":                  "elasticsearch",
+	"resource_tc_image":                                   "cvm",
+	"resource_tc_instance":                                "cvm",
+	"resource_tc_instance_set":                            "cvm",
+	"resource_tc_kubernetes_cluster_attachment":           "tke",
+	"resource_tc_mongodb_instance":                        "mongodb",
+	"resource_tc_mongodb_sharding_instance":               "mongodb",
+	"resource_tc_mongodb_standby_instance":                "mongodb",
+	"resource_tc_monitor_alarm_notice":                    "monitor",
+	"resource_tc_monitor_alarm_policy":                    "monitor",
+	"resource_tc_monitor_binding_object":                  "monitor",
+	"resource_tc_monitor_binding_receiver":                "monitor",
+	"resource_tc_monitor_policy_binding_object":           "monitor",
+	"resource_tc_monitor_policy_group":                    "monitor",
+	"resource_tc_mysql_privilege":                         "mysql",
+	"resource_tc_scf_layer":                               "scf",
+	"resource_tc_vod_adaptive_dynamic_streaming_template": "vod",
+	"resource_tc_vod_image_sprite_template":               "vod",
+	"resource_tc_vod_procedure_template":                  "vod",
+	"resource_tc_vod_snapshot_by_time_offset_template":    "vod",
+	"resource_tc_vod_sub_application":                     "vod",
+	"resource_tc_vod_super_player_config":                 "vod",
+	"resource_tc_vpn_ssl_client":                          "vpc",
+	"resource_tc_vpn_ssl_server":                          "vpc",
+	"service_tencent_ssl_certificate":                     "ssl",
+}
 
-	for limit.Limit() {
-		//Prevent wake at same time
-		sleepMs := 10 + rand.Intn(30)
-		time.Sleep(time.Duration(sleepMs) * time.Microsecond)
+// serviceOf returns the service of the requests from namespace, which keys the limits of Config
+func serviceOf(namespace string) string {
+	if service, ok := callerServices[namespace]; ok {
+		return service
+	}
+	return strings.TrimPrefix(namespace, servicePrefix)
+}
 
-		if time.Since(old) > 5*time.Minute {
-			log.Printf("[WARN] %s wait too long, we try to release it", key)
-			break
-		}
+// getLimit returns the limit of action and the key of its bucket, the user config takes precedence
+// over the builtin one. The requests limited by the user config share the bucket of the service,
+// wherever they are sent from.
+func (me *Limiter) getLimit(namespace, action string) (string, float64) {
+	service := serviceOf(namespace)
+	userConfig := me.config
+
+	if limit := userConfig.Limits[service+"."+action]; limit > 0 {
+		return service + "." + action, limit
+	}
+	if limit := userConfig.Limits[service]; limit > 0 {
+		return service + "." + action, limit
+	}
+
+	key := namespace + "." + action
+	if limit := limitConfig[key]; limit > 0 {
+		return key, float64(limit)
+	}
+	if limit := limitConfig[namespace]; limit > 0 {
+		return key, float64(limit)
+	}
+	if userConfig.Default > 0 {
+		return key, userConfig.Default
+	}
+	return key, float64(DefaultLimit)
+}
+
+// bucket is a token bucket which allows limit requests per second
+type bucket struct {
+	mutex  sync.Mutex
+	limit  float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newBucket(limit float64) *bucket {
+	burst := math.Max(1, math.Floor(limit))
+	return &bucket{
+		limit:  limit,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long to wait before it is available
+func (me *bucket) reserve(now time.Time) time.Duration {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+
+	if now.After(me.last) {
+		me.tokens = math.Min(me.burst, me.tokens+now.Sub(me.last).Seconds()*me.limit)
+		me.last = now
 	}
+	me.tokens--
+	if me.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-me.tokens / me.limit * float64(time.Second))
+}
+
+// cancel gives back the token of a reservation which is not used
+func (me *bucket) cancel() {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.tokens = math.Min(me.burst, me.tokens+1)
+}
 
+func (me *Limiter) getBucket(namespace, action string) (string, *bucket) {
+	me.lock.Lock()
+	defer me.lock.Unlock()
+
+	key, limit := me.getLimit(namespace, action)
+	if me.buckets[key] == nil {
+		me.buckets[key] = newBucket(limit)
+	}
+	return key, me.buckets[key]
+}
+
+// Wait blocks until the request of action is allowed, or ctx is done
+func (me *Limiter) Wait(ctx context.Context, namespace, action string) error {
+	key, limit := me.getBucket(namespace, action)
+
+	delay := limit.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+	if delay > time.Second {
+		log.Printf("[DEBUG] %s is rate limited, wait %s", key, delay)
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		limit.cancel()
+		return fmt.Errorf("%s is cancelled while waiting for the rate limit, reason: %v", key, ctx.Err())
+	}
+}
+
+// Wait blocks until the request of action is allowed by the Limiter of ctx, or ctx is done
+func Wait(ctx context.Context, namespace, action string) error {
+	return FromContext(ctx).Wait(ctx, namespace, action)
+}
+
+func ProCheck(namespace, action string) {
+	_ = Wait(context.Background(), namespace, action)
 }
 
 func Check(action string) {
+	ProCheck(callerFileName(), action)
+}
 
-	_, filePath, _, _ := runtime.Caller(1)
+// CheckContext is the same as Check with the Limiter of ctx, but it returns the error once ctx is done
+func CheckContext(ctx context.Context, action string) error {
+	return Wait(ctx, callerFileName(), action)
+}
+
+// callerFileName returns the file name of the caller of Check without the extension
+func callerFileName() string {
+	_, filePath, _, _ := runtime.Caller(2)
 
 	items := strings.Split(filePath, `/`)
 	items = strings.Split(items[len(items)-1], `\`)
 
-	fileName := strings.TrimSuffix(items[len(items)-1], ".go")
-
-	ProCheck(fileName, action)
+	return strings.TrimSuffix(items[len(items)-1], ".go")
 }
@@ -0,0 +1,138 @@
+package ratelimit
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestGetLimit(t *testing.T) {
+	cases := []struct {
+		namespace, action string
+		expected          float64
+	}{
+		{"service_tencentcloud_mysql", "CreateBackup", 5},
+		{"service_tencentcloud_mysql", "DescribeDBInstances", 50},
+		{"service_tencentcloud_cvm", "RunInstances", float64(DefaultLimit)},
+		{"resource_tc_instance", "RunInstances", 50},
+	}
+	for _, c := range cases {
+		if _, limit := defaultLimiter.getLimit(c.namespace, c.action); limit != c.expected {
+			t.Errorf("expect limit %v of %s.%s, got %v", c.expected, c.namespace, c.action, limit)
+		}
+	}
+
+	limiter, err := NewLimiter(Config{Default: 2, Limits: map[string]float64{"mysql": 10, "mysql.CreateBackup": 1, "cvm.RunInstances": 3}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases = []struct {
+		namespace, action string
+		expected          float64
+	}{
+		{"service_tencentcloud_mysql", "CreateBackup", 1},
+		{"service_tencentcloud_mysql", "CreateDBInstanceHour", 10},
+		{"service_tencentcloud_dc", "DescribeDirectConnects", 5},
+		{"service_tencentcloud_cvm", "RunInstances", 3},
+		{"service_tencentcloud_cvm", "DescribeInstances", 2},
+		{"resource_tc_instance", "RunInstances", 3},
+		{"resource_tc_instance", "DescribeInstances", 50},
+		{"resource_tc_mysql_privilege", "ModifyAccountPrivileges", 10},
+	}
+	for _, c := range cases {
+		if _, limit := limiter.getLimit(c.namespace, c.action); limit != c.expected {
+			t.Errorf("expect limit %v of %s.%s, got %v", c.expected, c.namespace, c.action, limit)
+		}
+	}
+
+	// the requests of the resource files share the bucket of the service configured
+	resourceKey, _ := limiter.getLimit("resource_tc_instance", "RunInstances")
+	serviceKey, _ := limiter.getLimit("service_tencentcloud_cvm", "RunInstances")
+	if resourceKey != "cvm.RunInstances" || serviceKey != resourceKey {
+		t.Errorf("expect the bucket cvm.RunInstances, got %s and %s", resourceKey, serviceKey)
+	}
+
+	if _, err := NewLimiter(Config{Limits: map[string]float64{"mysql": 0}}); err == nil {
+		t.Errorf("expect error of the zero limit")
+	}
+}
+
+func TestBucket(t *testing.T) {
+	now := time.Now()
+	limit := newBucket(2)
+	limit.last = now
+
+	for i := 0; i < 2; i++ {
+		if delay := limit.reserve(now); delay != 0 {
+			t.Errorf("expect no delay within the burst, got %s", delay)
+		}
+	}
+	if delay := limit.reserve(now); delay != 500*time.Millisecond {
+		t.Errorf("expect delay 500ms, got %s", delay)
+	}
+	limit.cancel()
+	if delay := limit.reserve(now.Add(500 * time.Millisecond)); delay != 0 {
+		t.Errorf("expect no delay after the token is refilled, got %s", delay)
+	}
+}
+
+func TestWaitCancelled(t *testing.T) {
+	limiter, err := NewLimiter(Config{Limits: map[string]float64{"test.Wait": 0.01}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := NewContext(context.Background(), limiter)
+	if err := Wait(ctx, "service_tencentcloud_test", "Wait"); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := Wait(ctx, "service_tencentcloud_test", "Wait"); err == nil {
+		t.Errorf("expect error once the context is done")
+	}
+
+	// the limiter of another provider is not affected
+	other, err := NewLimiter(Config{Limits: map[string]float64{"test.Wait": 0.01}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Wait(NewContext(context.Background(), other), "service_tencentcloud_test", "Wait"); err != nil {
+		t.Errorf("expect no wait of another limiter, got %v", err)
+	}
+	if FromContext(context.Background()) != defaultLimiter {
+		t.Errorf("expect the default limiter without one in the context")
+	}
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ratelimit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "limits.yaml")
+	content := "default: 5\nlimits:\n  cvm: 20\n  cvm.RunInstances: 2\n"
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	config, err := LoadConfigFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config = config.Merge(Config{Limits: map[string]float64{"cvm.RunInstances": 1}})
+	if config.Default != 5 || config.Limits["cvm"] != 20 || config.Limits["cvm.RunInstances"] != 1 {
+		t.Errorf("unexpected config %+v", config)
+	}
+
+	if err := ioutil.WriteFile(path, []byte("unknown: 1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadConfigFile(path); err == nil {
+		t.Errorf("expect error of the unknown field")
+	}
+}
@@ -23,19 +23,26 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTencentCloudAddressTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAddressTemplateCreate,
-		Read:   resourceTencentCloudAddressTemplateRead,
-		Update: resourceTencentCloudAddressTemplateUpdate,
-		Delete: resourceTencentCloudAddressTemplateDelete,
+		CreateContext: resourceTencentCloudAddressTemplateCreate,
+		ReadContext:   resourceTencentCloudAddressTemplateRead,
+		UpdateContext: resourceTencentCloudAddressTemplateUpdate,
+		DeleteContext: resourceTencentCloudAddressTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -57,10 +64,10 @@ func resourceTencentCloudAddressTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAddressTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAddressTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_address_template.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	name := d.Get("name").(string)
 	addresses := d.Get("addresses").(*schema.Set).List()
@@ -71,7 +78,7 @@ func resourceTencentCloudAddressTemplateCreate(d *schema.ResourceData, meta inte
 	var outErr, inErr error
 	var templateId string
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		templateId, inErr = vpcService.CreateAddressTemplate(ctx, name, addresses)
 		if inErr != nil {
 			return retryError(inErr)
@@ -79,27 +86,27 @@ func resourceTencentCloudAddressTemplateCreate(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	d.SetId(templateId)
 
-	return resourceTencentCloudAddressTemplateRead(d, meta)
+	return resourceTencentCloudAddressTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAddressTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAddressTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_address_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	templateId := d.Id()
 	var outErr, inErr error
 	vpcService := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	template, has, outErr := vpcService.DescribeAddressTemplateById(ctx, templateId)
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			template, has, inErr = vpcService.DescribeAddressTemplateById(ctx, templateId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -108,7 +115,7 @@ func resourceTencentCloudAddressTemplateRead(d *schema.ResourceData, meta interf
 		})
 	}
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	if !has {
 		d.SetId("")
@@ -121,11 +128,11 @@ func resourceTencentCloudAddressTemplateRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudAddressTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAddressTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_address_template.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	templateId := d.Id()
 
 	if d.HasChange("name") || d.HasChange("addresses") {
@@ -133,7 +140,7 @@ func resourceTencentCloudAddressTemplateUpdate(d *schema.ResourceData, meta inte
 		name := d.Get("name").(string)
 		addresses := d.Get("addresses").(*schema.Set).List()
 		vpcService := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = vpcService.ModifyAddressTemplate(ctx, templateId, name, addresses)
 			if inErr != nil {
 				return retryError(inErr, "UnsupportedOperation.MutexOperationTaskRunning")
@@ -141,26 +148,26 @@ func resourceTencentCloudAddressTemplateUpdate(d *schema.ResourceData, meta inte
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 	}
 
-	return resourceTencentCloudAddressTemplateRead(d, meta)
+	return resourceTencentCloudAddressTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAddressTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAddressTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_address_template.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	templateId := d.Id()
 	vpcService := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var outErr, inErr error
 
 	outErr = vpcService.DeleteAddressTemplate(ctx, templateId)
 	if outErr != nil {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			inErr = vpcService.DeleteAddressTemplate(ctx, templateId)
 			if inErr != nil {
 				return retryError(inErr, "UnsupportedOperation.MutexOperationTaskRunning")
@@ -170,11 +177,11 @@ func resourceTencentCloudAddressTemplateDelete(d *schema.ResourceData, meta inte
 	}
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	//check not exist
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, inErr := vpcService.DescribeAddressTemplateById(ctx, templateId)
 		if inErr != nil {
 			return retryError(inErr)
@@ -186,5 +193,5 @@ func resourceTencentCloudAddressTemplateDelete(d *schema.ResourceData, meta inte
 		}
 	})
 
-	return outErr
+	return diag.FromErr(outErr)
 }
@@ -23,19 +23,26 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTencentCloudAddressTemplateGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAddressTemplateGroupCreate,
-		Read:   resourceTencentCloudAddressTemplateGroupRead,
-		Update: resourceTencentCloudAddressTemplateGroupUpdate,
-		Delete: resourceTencentCloudAddressTemplateGroupDelete,
+		CreateContext: resourceTencentCloudAddressTemplateGroupCreate,
+		ReadContext:   resourceTencentCloudAddressTemplateGroupRead,
+		UpdateContext: resourceTencentCloudAddressTemplateGroupUpdate,
+		DeleteContext: resourceTencentCloudAddressTemplateGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -57,10 +64,10 @@ func resourceTencentCloudAddressTemplateGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAddressTemplateGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAddressTemplateGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_address_template_group.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	name := d.Get("name").(string)
 	addresses := d.Get("template_ids").(*schema.Set).List()
@@ -71,7 +78,7 @@ func resourceTencentCloudAddressTemplateGroupCreate(d *schema.ResourceData, meta
 	var outErr, inErr error
 	var templateGroupId string
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		templateGroupId, inErr = vpcService.CreateAddressTemplateGroup(ctx, name, addresses)
 		if inErr != nil {
 			return retryError(inErr)
@@ -79,27 +86,27 @@ func resourceTencentCloudAddressTemplateGroupCreate(d *schema.ResourceData, meta
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	d.SetId(templateGroupId)
 
-	return resourceTencentCloudAddressTemplateGroupRead(d, meta)
+	return resourceTencentCloudAddressTemplateGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAddressTemplateGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAddressTemplateGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_address_template_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	templateId := d.Id()
 	var outErr, inErr error
 	vpcService := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	templateGroup, has, outErr := vpcService.DescribeAddressTemplateGroupById(ctx, templateId)
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			templateGroup, has, inErr = vpcService.DescribeAddressTemplateGroupById(ctx, templateId)
 			if inErr != nil {
 				return retryError(inErr, "UnsupportedOperation.MutexOperationTaskRunning")
@@ -108,7 +115,7 @@ func resourceTencentCloudAddressTemplateGroupRead(d *schema.ResourceData, meta i
 		})
 	}
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	if !has {
 		d.SetId("")
@@ -121,11 +128,11 @@ func resourceTencentCloudAddressTemplateGroupRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudAddressTemplateGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAddressTemplateGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_address_template_group.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	templateGroupId := d.Id()
 
 	if d.HasChange("name") || d.HasChange("template_ids") {
@@ -133,7 +140,7 @@ func resourceTencentCloudAddressTemplateGroupUpdate(d *schema.ResourceData, meta
 		name := d.Get("name").(string)
 		templadteIds := d.Get("template_ids").(*schema.Set).List()
 		vpcService := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = vpcService.ModifyAddressTemplateGroup(ctx, templateGroupId, name, templadteIds)
 			if inErr != nil {
 				return retryError(inErr)
@@ -141,25 +148,25 @@ func resourceTencentCloudAddressTemplateGroupUpdate(d *schema.ResourceData, meta
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 	}
 
-	return resourceTencentCloudAddressTemplateGroupRead(d, meta)
+	return resourceTencentCloudAddressTemplateGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAddressTemplateGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAddressTemplateGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_address_template_group.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	templateGroupId := d.Id()
 	vpcService := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var outErr, inErr error
 
 	outErr = vpcService.DeleteAddressTemplateGroup(ctx, templateGroupId)
 	if outErr != nil {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			inErr = vpcService.DeleteAddressTemplateGroup(ctx, templateGroupId)
 			if inErr != nil {
 				return retryError(inErr, "UnsupportedOperation.MutexOperationTaskRunning")
@@ -169,11 +176,11 @@ func resourceTencentCloudAddressTemplateGroupDelete(d *schema.ResourceData, meta
 	}
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	//check not exist
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, inErr := vpcService.DescribeAddressTemplateGroupById(ctx, templateGroupId)
 		if inErr != nil {
 			return retryError(inErr)
@@ -185,5 +192,5 @@ func resourceTencentCloudAddressTemplateGroupDelete(d *schema.ResourceData, meta
 		}
 	})
 
-	return outErr
+	return diag.FromErr(outErr)
 }
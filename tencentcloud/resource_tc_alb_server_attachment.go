@@ -27,6 +27,14 @@ resource "tencentcloud_alb_server_attachment" "service1" {
   ]
 }
 ```
+
+Import
+
+ALB server attachment can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_alb_server_attachment.service1 lb-qk1dqox5:lbl-ghoke4tl:loc-i858qv1l
+```
 */
 package tencentcloud
 
@@ -35,7 +43,9 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -45,10 +55,17 @@ import (
 func resourceTencentCloudAlbServerAttachment() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource has been deprecated in Terraform TencentCloud provider version 1.15.0. Please use 'tencentcloud_clb_attachment' instead.",
-		Create:             resourceTencentCloudAlbServerAttachmentCreate,
-		Read:               resourceTencentCloudAlbServerAttachmentRead,
-		Delete:             resourceTencentCloudAlbServerAttachmentDelete,
-		Update:             resourceTencentCloudAlbServerAttachmentUpdate,
+		CreateContext:      resourceTencentCloudAlbServerAttachmentCreate,
+		ReadContext:        resourceTencentCloudAlbServerAttachmentRead,
+		DeleteContext:      resourceTencentCloudAlbServerAttachmentDelete,
+		UpdateContext:      resourceTencentCloudAlbServerAttachmentUpdate,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"loadbalancer_id": {
@@ -108,7 +125,7 @@ func resourceTencentCloudAlbServerAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAlbServerAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAlbServerAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_alb_server_attachment.create")()
 
 	clbActionMu.Lock()
@@ -135,9 +152,9 @@ func resourceTencentCloudAlbServerAttachmentCreate(d *schema.ResourceData, meta
 		request.Targets = append(request.Targets, clbNewTarget(inst["instance_id"], inst["eni_ip"], inst["port"], inst["weight"]))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		requestId := ""
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().RegisterTargets(request)
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().RegisterTargetsWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -146,7 +163,7 @@ func resourceTencentCloudAlbServerAttachmentCreate(d *schema.ResourceData, meta
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
 			requestId = *result.Response.RequestId
-			retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+			retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 			if retryErr != nil {
 				return resource.NonRetryableError(retryErr)
 			}
@@ -155,25 +172,25 @@ func resourceTencentCloudAlbServerAttachmentCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create alb attachment failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := fmt.Sprintf("%v:%v:%v", loadbalancerId, listenerId, location_id)
 	d.SetId(id)
 
-	return resourceTencentCloudAlbServerAttachmentRead(d, meta)
+	return resourceTencentCloudAlbServerAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAlbServerAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAlbServerAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_alb_server_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	items := strings.Split(d.Id(), ":")
 	if len(items) < 3 {
-		return fmt.Errorf("id of resource.tencentcloud_alb_server_attachment is wrong")
+		return diag.Errorf("id of resource.tencentcloud_alb_server_attachment is wrong")
 	}
 
 	clbId := items[0]
@@ -185,7 +202,7 @@ func resourceTencentCloudAlbServerAttachmentRead(d *schema.ResourceData, meta in
 	}
 
 	var instance *clb.ListenerBackend
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := clbService.DescribeAttachmentByPara(ctx, clbId, listenerId, locationId)
 		if e != nil {
 			return retryError(e)
@@ -195,7 +212,7 @@ func resourceTencentCloudAlbServerAttachmentRead(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read alb attachment failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if instance == nil {
 		d.SetId("")
@@ -223,18 +240,18 @@ func resourceTencentCloudAlbServerAttachmentRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTencentCloudAlbServerAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAlbServerAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_alb_server_attachment.delete")()
 
 	clbActionMu.Lock()
 	defer clbActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	items := strings.Split(d.Id(), ":")
 	if len(items) < 3 {
-		return fmt.Errorf("id of resource.tencentcloud_alb_server_attachment is wrong")
+		return diag.Errorf("id of resource.tencentcloud_alb_server_attachment is wrong")
 	}
 
 	clbId := items[0]
@@ -244,7 +261,7 @@ func resourceTencentCloudAlbServerAttachmentDelete(d *schema.ResourceData, meta
 	clbService := ClbService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := clbService.DeleteAttachmentById(ctx, clbId, listenerId, locationId, d.Get("backends").(*schema.Set).List())
 		if e != nil {
 			return retryError(e)
@@ -253,13 +270,13 @@ func resourceTencentCloudAlbServerAttachmentDelete(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s reason[%s]\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceTencentCloudAlbServerAttachementRemove(d *schema.ResourceData, meta interface{}, remove []interface{}) error {
+func resourceTencentCloudAlbServerAttachementRemove(ctx context.Context, d *schema.ResourceData, meta interface{}, remove []interface{}) error {
 	defer logElapsed("resource.tencentcloud_alb_server_attachment.remove")()
 
 	logId := getLogId(contextNil)
@@ -281,9 +298,9 @@ func resourceTencentCloudAlbServerAttachementRemove(d *schema.ResourceData, meta
 		request.Targets = append(request.Targets, clbNewTarget(inst["instance_id"], inst["eni_ip"], inst["port"], inst["weight"]))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		requestId := ""
-		response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().DeregisterTargets(request)
+		response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().DeregisterTargetsWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -292,7 +309,7 @@ func resourceTencentCloudAlbServerAttachementRemove(d *schema.ResourceData, meta
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 			requestId = *response.Response.RequestId
-			retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+			retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 			if retryErr != nil {
 				return resource.NonRetryableError(retryErr)
 			}
@@ -306,7 +323,7 @@ func resourceTencentCloudAlbServerAttachementRemove(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudAlbServerAttachementAdd(d *schema.ResourceData, meta interface{}, add []interface{}) error {
+func resourceTencentCloudAlbServerAttachementAdd(ctx context.Context, d *schema.ResourceData, meta interface{}, add []interface{}) error {
 	defer logElapsed("resource.tencentcloud_alb_server_attachment.add")()
 	logId := getLogId(contextNil)
 
@@ -328,9 +345,9 @@ func resourceTencentCloudAlbServerAttachementAdd(d *schema.ResourceData, meta in
 		inst := inst_.(map[string]interface{})
 		request.Targets = append(request.Targets, clbNewTarget(inst["instance_id"], inst["eni_ip"], inst["port"], inst["weight"]))
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		requestId := ""
-		response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().RegisterTargets(request)
+		response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().RegisterTargetsWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -339,7 +356,7 @@ func resourceTencentCloudAlbServerAttachementAdd(d *schema.ResourceData, meta in
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 			requestId = *response.Response.RequestId
-			retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+			retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 			if retryErr != nil {
 				return resource.NonRetryableError(retryErr)
 			}
@@ -353,7 +370,7 @@ func resourceTencentCloudAlbServerAttachementAdd(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTencentCloudAlbServerAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAlbServerAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_alb_server_attachment.update")()
 
 	clbActionMu.Lock()
@@ -366,18 +383,18 @@ func resourceTencentCloudAlbServerAttachmentUpdate(d *schema.ResourceData, meta
 		add := ns.Difference(os).List()
 		remove := os.Difference(ns).List()
 		if len(remove) > 0 {
-			err := resourceTencentCloudAlbServerAttachementRemove(d, meta, remove)
+			err := resourceTencentCloudAlbServerAttachementRemove(ctx, d, meta, remove)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		if len(add) > 0 {
-			err := resourceTencentCloudAlbServerAttachementAdd(d, meta, add)
+			err := resourceTencentCloudAlbServerAttachementAdd(ctx, d, meta, add)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
-		return resourceTencentCloudAlbServerAttachmentRead(d, meta)
+		return resourceTencentCloudAlbServerAttachmentRead(ctx, d, meta)
 	}
 
 	return nil
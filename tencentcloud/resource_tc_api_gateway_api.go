@@ -50,13 +50,22 @@ resource "tencentcloud_api_gateway_api" "api" {
   test_limit       = 500
 }
 ```
+
+Import
+
+API gateway API can be imported using the id `service_id#api_id`, e.g.
+
+```
+$ terraform import tencentcloud_api_gateway_api.api service-pkegyqmc#api-0cvmf4x4
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
@@ -66,10 +75,18 @@ import (
 
 func resourceTencentCloudAPIGatewayAPI() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayAPICreate,
-		Read:   resourceTencentCloudAPIGatewayAPIRead,
-		Update: resourceTencentCloudAPIGatewayAPIUpdate,
-		Delete: resourceTencentCloudAPIGatewayAPIDelete,
+		CreateContext: resourceTencentCloudAPIGatewayAPICreate,
+		ReadContext:   resourceTencentCloudAPIGatewayAPIRead,
+		UpdateContext: resourceTencentCloudAPIGatewayAPIUpdate,
+		DeleteContext: resourceTencentCloudAPIGatewayAPIDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: helper.ImportWithArguments("service_id"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"service_id": {
@@ -724,13 +741,12 @@ func resourceTencentCloudAPIGatewayAPI() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAPIGatewayAPICreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayAPICreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api.create")()
 
 	var (
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		err               error
 		response          = apigateway.NewCreateApiResponse()
 		request           = apigateway.NewCreateApiRequest()
@@ -741,6 +757,7 @@ func resourceTencentCloudAPIGatewayAPICreate(d *schema.ResourceData, meta interf
 		preLimit     int
 		testLimit    int
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request.ServiceId = &serviceId
 	request.ApiName = helper.String(d.Get("api_name").(string))
@@ -919,11 +936,11 @@ func resourceTencentCloudAPIGatewayAPICreate(d *schema.ResourceData, meta interf
 		serviceConfigUpstreamId := d.Get("service_config_upstream_id").(string)
 		if serviceConfigProduct != "" {
 			if serviceConfigVpcId == "" {
-				return fmt.Errorf("`service_config_product` need param `service_config_vpc_id`")
+				return diag.Errorf("`service_config_product` need param `service_config_vpc_id`")
 			}
 		}
 		if serviceConfigUrl == "" || serviceConfigPath == "" || serviceConfigMethod == "" {
-			return fmt.Errorf("`service_config_url`,`service_config_path`,`service_config_method` is needed if `service_config_type` is `WEBSOCKET` or `HTTP`")
+			return diag.Errorf("`service_config_url`,`service_config_path`,`service_config_method` is needed if `service_config_type` is `WEBSOCKET` or `HTTP`")
 		}
 		request.ServiceConfig = &apigateway.ServiceConfig{}
 		if serviceConfigProduct != "" {
@@ -943,7 +960,7 @@ func resourceTencentCloudAPIGatewayAPICreate(d *schema.ResourceData, meta interf
 	case API_GATEWAY_SERVICE_TYPE_MOCK:
 		serviceConfigMockReturnMessage := d.Get("service_config_mock_return_message").(string)
 		if serviceConfigMockReturnMessage == "" {
-			return fmt.Errorf("`service_config_mock_return_message` is needed if `service_config_type` is `MOCK`")
+			return diag.Errorf("`service_config_mock_return_message` is needed if `service_config_type` is `MOCK`")
 		}
 		request.ServiceMockReturnMessage = &serviceConfigMockReturnMessage
 
@@ -954,7 +971,7 @@ func resourceTencentCloudAPIGatewayAPICreate(d *schema.ResourceData, meta interf
 		scfFunctionType := d.Get("service_config_scf_function_type").(string)
 		scfFunctionIntegratedResponse := d.Get("service_config_scf_is_integrated_response").(bool)
 		if scfFunctionName == "" || scfFunctionNamespace == "" || scfFunctionQualifier == "" || scfFunctionType == "" {
-			return fmt.Errorf("`service_config_scf_function_name`,`service_config_scf_function_namespace`,`service_config_scf_function_qualifier`, `service_config_scf_function_type` is needed if `service_config_type` is `SCF`")
+			return diag.Errorf("`service_config_scf_function_name`,`service_config_scf_function_namespace`,`service_config_scf_function_qualifier`, `service_config_scf_function_type` is needed if `service_config_type` is `SCF`")
 		}
 		request.ServiceScfFunctionName = &scfFunctionName
 		request.ServiceScfFunctionNamespace = &scfFunctionNamespace
@@ -1087,7 +1104,7 @@ func resourceTencentCloudAPIGatewayAPICreate(d *schema.ResourceData, meta interf
 				codeReq.NeedConvert = helper.Bool(codeMap["need_convert"].(bool))
 			}
 			if *codeReq.NeedConvert && codeReq.ConvertedCode == nil {
-				return fmt.Errorf("`need_convert` need `converted_code`setted")
+				return diag.Errorf("`need_convert` need `converted_code`setted")
 			}
 			request.ResponseErrorCodes = append(request.ResponseErrorCodes, codeReq)
 		}
@@ -1145,69 +1162,70 @@ func resourceTencentCloudAPIGatewayAPICreate(d *schema.ResourceData, meta interf
 		testLimit = v.(int)
 	}
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		_, has, err = apiGatewayService.DescribeService(ctx, serviceId)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
-		return fmt.Errorf("service %s not exist on server", serviceId)
+		return diag.Errorf("service %s not exist on server", serviceId)
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = apiGatewayService.client.UseAPIGatewayClient().CreateApi(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = apiGatewayService.client.UseAPIGatewayClient().CreateApiWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if response == nil || response.Response.Result == nil || response.Response.Result.ApiId == nil {
-		return fmt.Errorf("create API fail, return nil response")
+		return diag.Errorf("create API fail, return nil response")
 	}
 
 	if preLimit != 0 {
 		_, err = apiGatewayService.ModifyApiEnvironmentStrategy(ctx, serviceId, int64(preLimit), "prepub", []string{*response.Response.Result.ApiId})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if releaseLimit != 0 {
 		_, err = apiGatewayService.ModifyApiEnvironmentStrategy(ctx, serviceId, int64(releaseLimit), "release", []string{*response.Response.Result.ApiId})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if testLimit != 0 {
 		_, err = apiGatewayService.ModifyApiEnvironmentStrategy(ctx, serviceId, int64(testLimit), "test", []string{*response.Response.Result.ApiId})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(*response.Response.Result.ApiId)
 
-	return resourceTencentCloudAPIGatewayAPIRead(d, meta)
+	return resourceTencentCloudAPIGatewayAPIRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayAPIRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayAPIRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiId             = d.Id()
 		serviceId         = d.Get("service_id").(string)
 		info              apigateway.ApiInfo
@@ -1217,15 +1235,16 @@ func resourceTencentCloudAPIGatewayAPIRead(d *schema.ResourceData, meta interfac
 		preLimit          int64
 		testLimit         int64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		info, has, err = apiGatewayService.DescribeApi(ctx, serviceId, apiId)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !has {
@@ -1544,13 +1563,12 @@ func resourceTencentCloudAPIGatewayAPIRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudAPIGatewayAPIUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayAPIUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api.update")()
 
 	var (
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		response          = apigateway.NewModifyApiResponse()
 		request           = apigateway.NewModifyApiRequest()
 		apiId             = d.Id()
@@ -1560,12 +1578,13 @@ func resourceTencentCloudAPIGatewayAPIUpdate(d *schema.ResourceData, meta interf
 		preLimit          int
 		testLimit         int
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	immutableArgs := []string{"target_services"}
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -1722,14 +1741,14 @@ func resourceTencentCloudAPIGatewayAPIUpdate(d *schema.ResourceData, meta interf
 		serviceConfigUpstreamId := d.Get("service_config_upstream_id").(string)
 		if serviceConfigProduct != "" {
 			if serviceConfigProduct != "clb" {
-				return fmt.Errorf("`service_config_product` only support `clb` now")
+				return diag.Errorf("`service_config_product` only support `clb` now")
 			}
 			if serviceConfigVpcId == "" {
-				return fmt.Errorf("`service_config_product` need param `service_config_vpc_id`")
+				return diag.Errorf("`service_config_product` need param `service_config_vpc_id`")
 			}
 		}
 		if serviceConfigUrl == "" || serviceConfigPath == "" || serviceConfigMethod == "" {
-			return fmt.Errorf("`service_config_url`,`service_config_path`,`service_config_method` is needed if `service_config_type` is `WEBSOCKET` or `HTTP`")
+			return diag.Errorf("`service_config_url`,`service_config_path`,`service_config_method` is needed if `service_config_type` is `WEBSOCKET` or `HTTP`")
 		}
 		request.ServiceConfig = &apigateway.ServiceConfig{}
 		if serviceConfigProduct != "" {
@@ -1748,7 +1767,7 @@ func resourceTencentCloudAPIGatewayAPIUpdate(d *schema.ResourceData, meta interf
 	case API_GATEWAY_SERVICE_TYPE_MOCK:
 		serviceConfigMockReturnMessage := d.Get("service_config_mock_return_message").(string)
 		if serviceConfigMockReturnMessage == "" {
-			return fmt.Errorf("`service_config_mock_return_message` is needed if `service_config_type` is `MOCK`")
+			return diag.Errorf("`service_config_mock_return_message` is needed if `service_config_type` is `MOCK`")
 		}
 		request.ServiceMockReturnMessage = &serviceConfigMockReturnMessage
 
@@ -1759,7 +1778,7 @@ func resourceTencentCloudAPIGatewayAPIUpdate(d *schema.ResourceData, meta interf
 		scfFunctionType := d.Get("service_config_scf_function_type").(string)
 		scfFunctionIntegratedResponse := d.Get("service_config_scf_is_integrated_response").(bool)
 		if scfFunctionName == "" || scfFunctionNamespace == "" || scfFunctionQualifier == "" || scfFunctionType == "" {
-			return fmt.Errorf("`service_config_scf_function_name`,`service_config_scf_function_namespace`,`service_config_scf_function_qualifier`, `service_config_scf_function_type` is needed if `service_config_type` is `SCF`")
+			return diag.Errorf("`service_config_scf_function_name`,`service_config_scf_function_namespace`,`service_config_scf_function_qualifier`, `service_config_scf_function_type` is needed if `service_config_type` is `SCF`")
 		}
 		request.ServiceScfFunctionName = &scfFunctionName
 		request.ServiceScfFunctionNamespace = &scfFunctionNamespace
@@ -1904,7 +1923,7 @@ func resourceTencentCloudAPIGatewayAPIUpdate(d *schema.ResourceData, meta interf
 	oldInterface, newInterface := d.GetChange("response_error_codes")
 
 	if oldInterface.(*schema.Set).Len() > 0 && newInterface.(*schema.Set).Len() == 0 {
-		return fmt.Errorf("`response_error_codes` must keep at least one after set")
+		return diag.Errorf("`response_error_codes` must keep at least one after set")
 	}
 
 	if object, ok := d.GetOk("response_error_codes"); ok {
@@ -1926,26 +1945,28 @@ func resourceTencentCloudAPIGatewayAPIUpdate(d *schema.ResourceData, meta interf
 				codeReq.NeedConvert = helper.Bool(codeMap["need_convert"].(bool))
 			}
 			if *codeReq.NeedConvert && codeReq.ConvertedCode == nil {
-				return fmt.Errorf("`need_convert` need `converted_code`setted")
+				return diag.Errorf("`need_convert` need `converted_code`setted")
 			}
 			request.ResponseErrorCodes = append(request.ResponseErrorCodes, codeReq)
 		}
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = apiGatewayService.client.UseAPIGatewayClient().ModifyApi(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = apiGatewayService.client.UseAPIGatewayClient().ModifyApiWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if response == nil {
-		return fmt.Errorf("modify API fail, return nil response")
+		return diag.Errorf("modify API fail, return nil response")
 	}
 
 	if d.HasChange("pre_limit") {
@@ -1955,7 +1976,7 @@ func resourceTencentCloudAPIGatewayAPIUpdate(d *schema.ResourceData, meta interf
 		if preLimit != 0 {
 			_, err = apiGatewayService.ModifyApiEnvironmentStrategy(ctx, serviceId, int64(preLimit), "prepub", []string{apiId})
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -1968,7 +1989,7 @@ func resourceTencentCloudAPIGatewayAPIUpdate(d *schema.ResourceData, meta interf
 		if releaseLimit != 0 {
 			_, err = apiGatewayService.ModifyApiEnvironmentStrategy(ctx, serviceId, int64(preLimit), "release", []string{apiId})
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -1981,41 +2002,41 @@ func resourceTencentCloudAPIGatewayAPIUpdate(d *schema.ResourceData, meta interf
 		if testLimit != 0 {
 			_, err = apiGatewayService.ModifyApiEnvironmentStrategy(ctx, serviceId, int64(preLimit), "test", []string{apiId})
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 	}
 
 	d.Partial(false)
-	return resourceTencentCloudAPIGatewayAPIRead(d, meta)
+	return resourceTencentCloudAPIGatewayAPIRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayAPIDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayAPIDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api.delete")()
 
 	var (
 		apiGatewayService       = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		logId                   = getLogId(contextNil)
-		ctx                     = context.WithValue(context.TODO(), logIdKey, logId)
 		apiId                   = d.Id()
 		serviceId               = d.Get("service_id").(string)
 		limitNumber       int64 = QUOTA
 		err               error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	for _, v := range API_GATEWAY_SERVICE_ENVS {
 		_, err = apiGatewayService.ModifyApiEnvironmentStrategy(ctx, serviceId, limitNumber, v, []string{apiId})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	return diag.FromErr(retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		err = apiGatewayService.DeleteApi(ctx, serviceId, apiId)
 		if err != nil {
 			return retryError(err)
 		}
 		return nil
-	})
+	}))
 }
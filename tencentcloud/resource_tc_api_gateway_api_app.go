@@ -39,7 +39,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apiGateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
@@ -48,12 +50,16 @@ import (
 
 func resourceTencentCloudAPIGatewayAPIApp() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayAPIAppCreate,
-		Read:   resourceTencentCloudAPIGatewayAPIAppRead,
-		Update: resourceTencentCloudAPIGatewayAPIAppUpdate,
-		Delete: resourceTencentCloudAPIGatewayAPIAppDelete,
+		CreateContext: resourceTencentCloudAPIGatewayAPIAppCreate,
+		ReadContext:   resourceTencentCloudAPIGatewayAPIAppRead,
+		UpdateContext: resourceTencentCloudAPIGatewayAPIAppUpdate,
+		DeleteContext: resourceTencentCloudAPIGatewayAPIAppDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"api_app_name": {
@@ -101,18 +107,18 @@ func resourceTencentCloudAPIGatewayAPIApp() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAPIGatewayAPIAppCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayAPIAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api_app.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId    = getLogId(contextNil)
-		ctx      = context.WithValue(context.TODO(), logIdKey, logId)
 		request  = apiGateway.NewCreateApiAppRequest()
 		response *apiGateway.CreateApiAppResponse
 		apiAppId string
 		err      error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("api_app_name"); ok {
 		request.ApiAppName = helper.String(v.(string))
@@ -122,8 +128,8 @@ func resourceTencentCloudAPIGatewayAPIAppCreate(d *schema.ResourceData, meta int
 		request.ApiAppDesc = helper.String(v.(string))
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, err := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().CreateApiApp(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, err := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().CreateApiAppWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		} else {
@@ -137,7 +143,7 @@ func resourceTencentCloudAPIGatewayAPIAppCreate(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create api_app failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	apiAppId = *response.Response.Result.ApiAppId
@@ -147,28 +153,28 @@ func resourceTencentCloudAPIGatewayAPIAppCreate(d *schema.ResourceData, meta int
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::apigateway:%s:uin/:apiAppId/%s", region, apiAppId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(apiAppId)
-	return resourceTencentCloudAPIGatewayAPIAppRead(d, meta)
+	return resourceTencentCloudAPIGatewayAPIAppRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayAPIAppRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayAPIAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api_app.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		apiAppId          = d.Id()
 		apiAppInfo        *apiGateway.ApiAppInfos
 		err               error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		apiAppInfo, err = apiGatewayService.DescribeApiApp(ctx, apiAppId)
 		if err != nil {
 			return retryError(err)
@@ -210,7 +216,7 @@ func resourceTencentCloudAPIGatewayAPIAppRead(d *schema.ResourceData, meta inter
 	if apiAppData.ApiAppDesc != nil {
 		err = d.Set("api_app_desc", apiAppData.ApiAppDesc)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -218,7 +224,7 @@ func resourceTencentCloudAPIGatewayAPIAppRead(d *schema.ResourceData, meta inter
 	tagService := &TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 	tags, err := tagService.DescribeResourceTags(ctx, "apigateway", "apiAppId", tcClient.Region, apiAppId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("tags", tags)
@@ -226,17 +232,17 @@ func resourceTencentCloudAPIGatewayAPIAppRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudAPIGatewayAPIAppUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayAPIAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api_app.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId    = getLogId(contextNil)
-		ctx      = context.WithValue(context.TODO(), logIdKey, logId)
 		request  = apiGateway.NewModifyApiAppRequest()
 		apiAppId = d.Id()
 		err      error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request.ApiAppId = &apiAppId
 	if d.HasChange("api_app_name") {
@@ -251,8 +257,8 @@ func resourceTencentCloudAPIGatewayAPIAppUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().ModifyApiApp(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().ModifyApiAppWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -264,7 +270,7 @@ func resourceTencentCloudAPIGatewayAPIAppUpdate(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update api_app failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
@@ -274,27 +280,27 @@ func resourceTencentCloudAPIGatewayAPIAppUpdate(d *schema.ResourceData, meta int
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("apigateway", "apiAppId", tcClient.Region, apiAppId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudAPIGatewayAPIAppRead(d, meta)
+	return resourceTencentCloudAPIGatewayAPIAppRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayAPIAppDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayAPIAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api_app.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		apiAppId          = d.Id()
 		err               error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if err = apiGatewayService.DeleteAPIGatewayAPIAppById(ctx, apiAppId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
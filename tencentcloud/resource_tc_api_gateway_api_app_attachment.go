@@ -76,8 +76,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
@@ -86,11 +87,14 @@ import (
 
 func resourceTencentCloudAPIGatewayApiAppAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayApiAppAttachmentCreate,
-		Read:   resourceTencentCloudAPIGatewayApiAppAttachmentRead,
-		Delete: resourceTencentCloudAPIGatewayApiAppAttachmentDelete,
+		CreateContext: resourceTencentCloudAPIGatewayApiAppAttachmentCreate,
+		ReadContext:   resourceTencentCloudAPIGatewayApiAppAttachmentRead,
+		DeleteContext: resourceTencentCloudAPIGatewayApiAppAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"api_app_id": {
@@ -121,7 +125,7 @@ func resourceTencentCloudAPIGatewayApiAppAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAPIGatewayApiAppAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayApiAppAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api_app_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -154,8 +158,8 @@ func resourceTencentCloudAPIGatewayApiAppAttachmentCreate(d *schema.ResourceData
 		apiId = v.(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().BindApiApp(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().BindApiAppWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -172,26 +176,26 @@ func resourceTencentCloudAPIGatewayApiAppAttachmentCreate(d *schema.ResourceData
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create apigateway apiAppAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{apiAppId, environment, serviceId, apiId}, FILED_SP))
-	return resourceTencentCloudAPIGatewayApiAppAttachmentRead(d, meta)
+	d.SetId(helper.IdFormat(apiAppId, environment, serviceId, apiId))
+	return resourceTencentCloudAPIGatewayApiAppAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayApiAppAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayApiAppAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api_app_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 4 {
-		return fmt.Errorf("api_gateway_api_app_attachment id is broken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	apiAppId := idSplit[0]
 	environment := idSplit[1]
@@ -200,7 +204,7 @@ func resourceTencentCloudAPIGatewayApiAppAttachmentRead(d *schema.ResourceData,
 
 	apiAppAttachment, err := service.DescribeAPIGatewayApiAppAttachmentById(ctx, apiAppId, environment, serviceId, apiId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if apiAppAttachment == nil {
@@ -228,19 +232,19 @@ func resourceTencentCloudAPIGatewayApiAppAttachmentRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudAPIGatewayApiAppAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayApiAppAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api_app_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 4 {
-		return fmt.Errorf("api_gateway_api_app_attachment id is broken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	apiAppId := idSplit[0]
 	environment := idSplit[1]
@@ -248,7 +252,7 @@ func resourceTencentCloudAPIGatewayApiAppAttachmentDelete(d *schema.ResourceData
 	apiId := idSplit[3]
 
 	if err := service.DeleteAPIGatewayApiAppAttachmentById(ctx, apiAppId, environment, serviceId, apiId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
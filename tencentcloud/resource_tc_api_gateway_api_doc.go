@@ -18,7 +18,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apiGateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
@@ -27,12 +29,16 @@ import (
 
 func resourceTencentCloudAPIGatewayAPIDoc() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayAPIDocCreate,
-		Read:   resourceTencentCloudAPIGatewayAPIDocRead,
-		Update: resourceTencentCloudAPIGatewayAPIDocUpdate,
-		Delete: resourceTencentCloudAPIGatewayAPIDocDelete,
+		CreateContext: resourceTencentCloudAPIGatewayAPIDocCreate,
+		ReadContext:   resourceTencentCloudAPIGatewayAPIDocRead,
+		UpdateContext: resourceTencentCloudAPIGatewayAPIDocUpdate,
+		DeleteContext: resourceTencentCloudAPIGatewayAPIDocDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"api_doc_name": {
@@ -111,19 +117,19 @@ func resourceTencentCloudAPIGatewayAPIDoc() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAPIGatewayAPIDocCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayAPIDocCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api_doc.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request           = apiGateway.NewCreateAPIDocRequest()
 		response          *apiGateway.CreateAPIDocResponse
 		apiDocId          string
 		err               error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("api_doc_name"); ok {
 		request.ApiDocName = helper.String(v.(string))
@@ -144,8 +150,8 @@ func resourceTencentCloudAPIGatewayAPIDocCreate(d *schema.ResourceData, meta int
 		}
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, err := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().CreateAPIDoc(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, err := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().CreateAPIDocWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		} else {
@@ -158,12 +164,12 @@ func resourceTencentCloudAPIGatewayAPIDocCreate(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create api_doc failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	apiDocId = *response.Response.Result.ApiDocId
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		apiDocInfo, err := apiGatewayService.DescribeApiDoc(ctx, apiDocId)
 		if err != nil {
 			return retryError(err)
@@ -187,30 +193,30 @@ func resourceTencentCloudAPIGatewayAPIDocCreate(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create api_doc task fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(apiDocId)
 
-	return resourceTencentCloudAPIGatewayAPIDocRead(d, meta)
+	return resourceTencentCloudAPIGatewayAPIDocRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayAPIDocRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayAPIDocRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api_doc.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		apiDocId          = d.Id()
 		apiDocInfo        *apiGateway.APIDocInfo
 		err               error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	apiDocInfo, err = apiGatewayService.DescribeApiDoc(ctx, apiDocId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if apiDocInfo == nil {
@@ -285,18 +291,18 @@ func resourceTencentCloudAPIGatewayAPIDocRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudAPIGatewayAPIDocUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayAPIDocUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api_doc.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request           = apiGateway.NewModifyAPIDocRequest()
 		apiDocId          = d.Id()
 		err               error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request.ApiDocId = &apiDocId
 
@@ -319,8 +325,8 @@ func resourceTencentCloudAPIGatewayAPIDocUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().ModifyAPIDoc(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().ModifyAPIDocWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -332,10 +338,10 @@ func resourceTencentCloudAPIGatewayAPIDocUpdate(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update api_doc failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		apiDocInfo, err := apiGatewayService.DescribeApiDoc(ctx, apiDocId)
 		if err != nil {
 			return retryError(err)
@@ -359,26 +365,26 @@ func resourceTencentCloudAPIGatewayAPIDocUpdate(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update api_doc task fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudAPIGatewayAPIDocRead(d, meta)
+	return resourceTencentCloudAPIGatewayAPIDocRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayAPIDocDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayAPIDocDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api_doc.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		apiDocId          = d.Id()
 		err               error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if err = apiGatewayService.DeleteAPIGatewayAPIDocById(ctx, apiDocId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -37,9 +37,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
@@ -47,12 +49,17 @@ import (
 
 func resourceTencentCloudAPIGatewayAPIKey() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayAPIKeyCreate,
-		Read:   resourceTencentCloudAPIGatewayAPIKeyRead,
-		Update: resourceTencentCloudAPIGatewayAPIKeyUpdate,
-		Delete: resourceTencentCloudAPIGatewayAPIKeyDelete,
+		CreateContext: resourceTencentCloudAPIGatewayAPIKeyCreate,
+		ReadContext:   resourceTencentCloudAPIGatewayAPIKeyRead,
+		UpdateContext: resourceTencentCloudAPIGatewayAPIKeyUpdate,
+		DeleteContext: resourceTencentCloudAPIGatewayAPIKeyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"secret_name": {
@@ -104,12 +111,11 @@ func resourceTencentCloudAPIGatewayAPIKey() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAPIGatewayAPIKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayAPIKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api_key.create")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request           = apigateway.NewCreateApiKeyRequest()
 		response          = apigateway.NewCreateApiKeyResponse()
@@ -118,6 +124,7 @@ func resourceTencentCloudAPIGatewayAPIKeyCreate(d *schema.ResourceData, meta int
 		accessKeyId       string
 		accessKeySecret   string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("secret_name"); ok {
 		request.SecretName = helper.String(v.(string))
@@ -143,15 +150,15 @@ func resourceTencentCloudAPIGatewayAPIKeyCreate(d *schema.ResourceData, meta int
 
 		if accessKeyId == "" || accessKeySecret == "" {
 			errRet := fmt.Errorf("`access_key_id`, `access_key_secret` required when access_key_type is `manual`")
-			return errRet
+			return diag.FromErr(errRet)
 		}
 
 		request.AccessKeyId = &accessKeyId
 		request.AccessKeySecret = &accessKeySecret
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().CreateApiKey(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().CreateApiKeyWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -164,48 +171,48 @@ func resourceTencentCloudAPIGatewayAPIKeyCreate(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create apigateway apiKey failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	//set status to disable
 	if statusStr == API_GATEWAY_KEY_DISABLED {
-		if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		if err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			if err = apiGatewayService.DisableApiKey(ctx, accessKeyId); err != nil {
 				return retryError(err)
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(*response.Response.Result.AccessKeyId)
 
-	return resourceTencentCloudAPIGatewayAPIKeyRead(d, meta)
+	return resourceTencentCloudAPIGatewayAPIKeyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayAPIKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayAPIKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api_key.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		accessKeyId       = d.Id()
 		apiKey            *apigateway.ApiKey
 		err               error
 		has               bool
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		apiKey, has, err = apiGatewayService.DescribeApiKey(ctx, accessKeyId)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !has {
@@ -224,28 +231,28 @@ func resourceTencentCloudAPIGatewayAPIKeyRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudAPIGatewayAPIKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api_key.update")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		request           = apigateway.NewUpdateApiKeyRequest()
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		accessKeyId       = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	immutableFields := []string{"access_key_id", "access_key_type"}
 	for _, f := range immutableFields {
 		if d.HasChange(f) {
-			return fmt.Errorf("cannot update argument `%s`", f)
+			return diag.Errorf("cannot update argument `%s`", f)
 		}
 	}
 
 	if d.HasChange("access_key_secret") {
 		if d.Get("access_key_type") == API_GATEWAY_KEY_TYPE_AUTO {
 			errRet := fmt.Errorf("`access_key_id`, `access_key_secret` updated when access_key_type is `auto`")
-			return errRet
+			return diag.FromErr(errRet)
 		}
 
 		request.AccessKeyId = &accessKeyId
@@ -253,8 +260,8 @@ func resourceTencentCloudAPIGatewayAPIKeyUpdate(d *schema.ResourceData, meta int
 			request.AccessKeySecret = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().UpdateApiKey(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().UpdateApiKeyWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -266,7 +273,7 @@ func resourceTencentCloudAPIGatewayAPIKeyUpdate(d *schema.ResourceData, meta int
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update apigateway apiKey failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -276,7 +283,7 @@ func resourceTencentCloudAPIGatewayAPIKeyUpdate(d *schema.ResourceData, meta int
 			err       error
 		)
 
-		if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		if err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			if statusStr == API_GATEWAY_KEY_DISABLED {
 				err = apiGatewayService.DisableApiKey(ctx, accessKeyId)
 			} else {
@@ -287,40 +294,40 @@ func resourceTencentCloudAPIGatewayAPIKeyUpdate(d *schema.ResourceData, meta int
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudAPIGatewayAPIKeyRead(d, meta)
+	return resourceTencentCloudAPIGatewayAPIKeyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayAPIKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayAPIKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api_key.delete")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		accessKeyId       = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	//set status to disable before delete
 	if d.Get("status") != API_GATEWAY_KEY_DISABLED {
-		if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		if err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			if err := apiGatewayService.DisableApiKey(ctx, accessKeyId); err != nil {
 				return retryError(err)
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	return diag.FromErr(retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		inErr := apiGatewayService.DeleteApiKey(ctx, accessKeyId)
 		if inErr != nil {
 			return retryError(inErr)
 		}
 		return nil
-	})
+	}))
 }
@@ -35,20 +35,26 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudAPIGatewayAPIKeyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayAPIKeyAttachmentCreate,
-		Read:   resourceTencentCloudAPIGatewayAPIKeyAttachmentRead,
-		Delete: resourceTencentCloudAPIGatewayAPIKeyAttachmentDelete,
+		CreateContext: resourceTencentCloudAPIGatewayAPIKeyAttachmentCreate,
+		ReadContext:   resourceTencentCloudAPIGatewayAPIKeyAttachmentRead,
+		DeleteContext: resourceTencentCloudAPIGatewayAPIKeyAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,61 +74,61 @@ func resourceTencentCloudAPIGatewayAPIKeyAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAPIGatewayAPIKeyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayAPIKeyAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api_key_attachment.create")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		apiKeyId          = d.Get("api_key_id").(string)
 		usagePlanId       = d.Get("usage_plan_id").(string)
 		has               bool
 		err               error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	//check usage plan is exist
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		_, has, err = apiGatewayService.DescribeUsagePlan(ctx, usagePlanId)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !has {
-		return fmt.Errorf("usage plan %s is not exist", usagePlanId)
+		return diag.Errorf("usage plan %s is not exist", usagePlanId)
 	}
 
 	//check API key is exist
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		_, has, err = apiGatewayService.DescribeApiKey(ctx, apiKeyId)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
-		return fmt.Errorf("API key %s is not exist", apiKeyId)
+		return diag.Errorf("API key %s is not exist", apiKeyId)
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		if err = apiGatewayService.BindSecretId(ctx, usagePlanId, apiKeyId); err != nil {
 			return retryError(err)
 		}
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//waiting bind success
 	var info apigateway.UsagePlanInfo
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		info, has, err = apiGatewayService.DescribeUsagePlan(ctx, usagePlanId)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -140,47 +146,47 @@ func resourceTencentCloudAPIGatewayAPIKeyAttachmentCreate(d *schema.ResourceData
 				apiKeyId, usagePlanId))
 
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
-		return fmt.Errorf("usage plan %s has been deleted", usagePlanId)
+		return diag.Errorf("usage plan %s has been deleted", usagePlanId)
 	}
-	d.SetId(strings.Join([]string{apiKeyId, usagePlanId}, FILED_SP))
+	d.SetId(helper.IdFormat(apiKeyId, usagePlanId))
 
-	return resourceTencentCloudAPIGatewayAPIKeyAttachmentRead(d, meta)
+	return resourceTencentCloudAPIGatewayAPIKeyAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayAPIKeyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayAPIKeyAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api_key_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		info              apigateway.UsagePlanInfo
 		err               error
 		has               bool
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	apiKeyId := idSplit[0]
 	usagePlanId := idSplit[1]
 	if apiKeyId == "" || usagePlanId == "" {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		info, has, err = apiGatewayService.DescribeUsagePlan(ctx, usagePlanId)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -197,39 +203,39 @@ func resourceTencentCloudAPIGatewayAPIKeyAttachmentRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudAPIGatewayAPIKeyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayAPIKeyAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_api_key_attachment.delete")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		info              apigateway.UsagePlanInfo
 		err               error
 		has               bool
 	)
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	apiKeyId := idSplit[0]
 	usagePlanId := idSplit[1]
 	if apiKeyId == "" || usagePlanId == "" {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 
-	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		err = apiGatewayService.UnBindSecretId(ctx, usagePlanId, apiKeyId)
 		if err != nil {
 			return retryError(err)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//waiting delete ok
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		info, has, err = apiGatewayService.DescribeUsagePlan(ctx, usagePlanId)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -247,7 +253,7 @@ func resourceTencentCloudAPIGatewayAPIKeyAttachmentDelete(d *schema.ResourceData
 
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
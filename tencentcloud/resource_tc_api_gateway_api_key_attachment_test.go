@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 var testAPIGatewayAPIKeyAttachmentResourceName = "tencentcloud_api_gateway_api_key_attachment"
@@ -45,7 +45,7 @@ func testAccCheckAPIGatewayAPIKeyAttachmentDestroy(s *terraform.State) error {
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		if len(idSplit) != 2 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
@@ -87,7 +87,7 @@ func testAccCheckAPIGatewayAPIKeyAttachmentExists(n string) resource.TestCheckFu
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		if len(idSplit) != 2 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
@@ -72,6 +72,27 @@ func TestAccTencentCloudAPIGateWayAPIResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(testAPIGatewayAPIResourceKey, "test_limit", "100"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_api_gateway_api.api",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccImportStateIdFunc("tencentcloud_api_gateway_api.api", "service_id", "id"),
+				ImportStateVerifyIgnore: []string{
+					"target_services",
+					"target_services_load_balance_conf",
+					"target_services_health_check_conf",
+					"service_config_scf_function_type",
+					"is_delete_response_error_codes",
+					"target_namespace_id",
+					"user_type",
+					"event_bus_id",
+					"eiam_app_type",
+					"eiam_auth_type",
+					"eiam_app_id",
+					"token_timeout",
+					"owner",
+				},
+			},
 		},
 	})
 }
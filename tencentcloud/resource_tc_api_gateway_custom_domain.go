@@ -14,24 +14,35 @@ resource "tencentcloud_api_gateway_custom_domain" "foo" {
 	path_mappings      = ["/good#test","/root#release"]
 }
 ```
+
+Import
+
+API gateway custom domain can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_api_gateway_custom_domain.foo service-ohxqslqe#tic-test.dnsv1.com
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudAPIGatewayCustomDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayCustomDomainCreate,
-		Read:   resourceTencentCloudAPIGatewayCustomDomainRead,
-		Update: resourceTencentCloudAPIGatewayCustomDomainUpdate,
-		Delete: resourceTencentCloudAPIGatewayCustomDomainDelete,
+		CreateContext: resourceTencentCloudAPIGatewayCustomDomainCreate,
+		ReadContext:   resourceTencentCloudAPIGatewayCustomDomainRead,
+		UpdateContext: resourceTencentCloudAPIGatewayCustomDomainUpdate,
+		DeleteContext: resourceTencentCloudAPIGatewayCustomDomainDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"service_id": {
@@ -99,12 +110,11 @@ func resourceTencentCloudAPIGatewayCustomDomain() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAPIGatewayCustomDomainCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayCustomDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_custom_domain.create")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		serviceId         = d.Get("service_id").(string)
 		subDomain         = d.Get("sub_domain").(string)
@@ -117,6 +127,7 @@ func resourceTencentCloudAPIGatewayCustomDomainCreate(d *schema.ResourceData, me
 		pathMappings      []string
 		err               error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("certificate_id"); ok {
 		certificateId = v.(string)
@@ -127,35 +138,35 @@ func resourceTencentCloudAPIGatewayCustomDomainCreate(d *schema.ResourceData, me
 
 	err = apiGatewayService.BindSubDomainService(ctx, serviceId, subDomain, protocol, netType, defaultDomain, isDefaultMapping, certificateId, pathMappings, isForcedHttps)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{serviceId, subDomain}, FILED_SP))
+	d.SetId(helper.IdFormat(serviceId, subDomain))
 
-	return resourceTencentCloudAPIGatewayCustomDomainRead(d, meta)
+	return resourceTencentCloudAPIGatewayCustomDomainRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayCustomDomainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayCustomDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_custom_domain.read")()
 
 	var (
 		logId = getLogId(contextNil)
-		ctx   = context.WithValue(context.TODO(), logIdKey, logId)
 
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id                = d.Id()
 		err               error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	results := strings.Split(id, FILED_SP)
-	if len(results) != 2 {
-		return fmt.Errorf("ids param is error. id:  %s", id)
+	results, err := helper.IdParseN(id, 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	serviceId := results[0]
 	subDomain := results[1]
 	resultList, err := apiGatewayService.DescribeServiceSubDomainsService(ctx, serviceId, subDomain)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(resultList) == 0 {
@@ -166,7 +177,7 @@ func resourceTencentCloudAPIGatewayCustomDomainRead(d *schema.ResourceData, meta
 	resultInfo := resultList[0]
 	info, err := apiGatewayService.DescribeServiceSubDomainMappings(ctx, serviceId, *resultInfo.DomainName)
 	if err != nil {
-		return fmt.Errorf("DescribeServiceSubDomainMappings err: %s", err.Error())
+		return diag.Errorf("DescribeServiceSubDomainMappings err: %s", err.Error())
 	}
 	pathMap := make([]string, 0, len(info.PathMappingSet))
 	for _, v := range info.PathMappingSet {
@@ -185,12 +196,11 @@ func resourceTencentCloudAPIGatewayCustomDomainRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudAPIGatewayCustomDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayCustomDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_custom_domain.update")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id                = d.Id()
 		subDomain         string
@@ -202,10 +212,11 @@ func resourceTencentCloudAPIGatewayCustomDomainUpdate(d *schema.ResourceData, me
 		isForcedHttps     bool
 		hasChange         bool
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	results := strings.Split(id, FILED_SP)
-	if len(results) != 2 {
-		return fmt.Errorf("ids param is error. setId:  %s", id)
+	results, err := helper.IdParseN(id, 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	serviceId := results[0]
 
@@ -252,29 +263,29 @@ func resourceTencentCloudAPIGatewayCustomDomainUpdate(d *schema.ResourceData, me
 	if hasChange {
 		err := apiGatewayService.ModifySubDomainService(ctx, serviceId, subDomain, isDefaultMapping, certificateId, protocol, netType, pathMappings, isForcedHttps)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudAPIGatewayCustomDomainRead(d, meta)
+	return resourceTencentCloudAPIGatewayCustomDomainRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayCustomDomainDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayCustomDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_custom_domain.delete")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		id                = d.Id()
 		apigatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	results := strings.Split(id, FILED_SP)
-	if len(results) != 2 {
-		return fmt.Errorf("ids param is error. setId:  %s", id)
+	results, err := helper.IdParseN(id, 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	serviceId := results[0]
 	subDomain := results[1]
 
-	return apigatewayService.UnBindSubDomainService(ctx, serviceId, subDomain)
+	return diag.FromErr(apigatewayService.UnBindSubDomainService(ctx, serviceId, subDomain))
 }
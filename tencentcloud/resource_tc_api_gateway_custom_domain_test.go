@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 // go test -i; go test -test.run TestAccTencentCloudAPIGateWayCustomDomain_basic -v
@@ -44,6 +44,12 @@ func TestAccTencentCloudAPIGateWayCustomDomain_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_api_gateway_custom_domain.foo", "path_mappings.#", "1"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_api_gateway_custom_domain.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"sub_domain", "default_domain"},
+			},
 		},
 	})
 }
@@ -59,7 +65,7 @@ func testAccCheckCustomDomainDestroy(s *terraform.State) error {
 			continue
 		}
 
-		params := strings.Split(rs.Primary.ID, FILED_SP)
+		params := helper.IdParse(rs.Primary.ID)
 		if len(params) != 2 {
 			return fmt.Errorf("ids param is error. id:  %s", rs.Primary.ID)
 		}
@@ -93,7 +99,7 @@ func testAccCheckCustomDomainExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("API getway custom domain id is not set")
 		}
 
-		params := strings.Split(rs.Primary.ID, FILED_SP)
+		params := helper.IdParse(rs.Primary.ID)
 		if len(params) != 2 {
 			return fmt.Errorf("ids param is error. id:  %s", rs.Primary.ID)
 		}
@@ -33,21 +33,28 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudAPIGatewayIPStrategy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayIPStrategyCreate,
-		Read:   resourceTencentCloudAPIGatewayIPStrategyRead,
-		Update: resourceTencentCloudAPIGatewayIPStrategyUpdate,
-		Delete: resourceTencentCloudAPIGatewayIPStrategyDelete,
+		CreateContext: resourceTencentCloudAPIGatewayIPStrategyCreate,
+		ReadContext:   resourceTencentCloudAPIGatewayIPStrategyRead,
+		UpdateContext: resourceTencentCloudAPIGatewayIPStrategyUpdate,
+		DeleteContext: resourceTencentCloudAPIGatewayIPStrategyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -93,12 +100,11 @@ func resourceTencentCloudAPIGatewayIPStrategy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAPIGatewayIPStrategyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayIPStrategyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_ip_strategy.create")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		serviceId         = d.Get("service_id").(string)
 		strategyName      = d.Get("strategy_name").(string)
@@ -107,7 +113,8 @@ func resourceTencentCloudAPIGatewayIPStrategyCreate(d *schema.ResourceData, meta
 		strategyId        string
 		err               error
 	)
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		strategyId, err = apiGatewayService.CreateIPStrategy(ctx, serviceId, strategyName, strategyType, strategyData)
 		if err != nil {
 			return retryError(err)
@@ -115,13 +122,13 @@ func resourceTencentCloudAPIGatewayIPStrategyCreate(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{serviceId, strategyId}, FILED_SP))
+	d.SetId(helper.IdFormat(serviceId, strategyId))
 
 	//wait ip strategy create ok
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		_, has, err := apiGatewayService.DescribeIPStrategyStatus(ctx, serviceId, strategyId)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -132,41 +139,41 @@ func resourceTencentCloudAPIGatewayIPStrategyCreate(d *schema.ResourceData, meta
 		return resource.RetryableError(fmt.Errorf("strategyID %s not found on server", strategyId))
 
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudAPIGatewayIPStrategyRead(d, meta)
+	return resourceTencentCloudAPIGatewayIPStrategyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayIPStrategyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayIPStrategyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_ip_strategy.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id                = d.Id()
 		IpStatus          *apigateway.IPStrategy
 		err               error
 		has               bool
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(id, FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("IP strategy is can't read, id is borken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(id, 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	serviceId := idSplit[0]
 	strategyId := idSplit[1]
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		IpStatus, has, err = apiGatewayService.DescribeIPStrategyStatus(ctx, serviceId, strategyId)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -183,19 +190,19 @@ func resourceTencentCloudAPIGatewayIPStrategyRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudAPIGatewayIPStrategyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayIPStrategyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_ip_strategy.update")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id                = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(id, FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("IP strategy is can't update, id is borken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(id, 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	serviceId := idSplit[0]
 	strategyId := idSplit[1]
@@ -206,7 +213,7 @@ func resourceTencentCloudAPIGatewayIPStrategyUpdate(d *schema.ResourceData, meta
 			err          error
 		)
 
-		if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		if err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			err = apiGatewayService.UpdateIPStrategy(ctx, serviceId, strategyId, strategyData)
 
 			if err != nil {
@@ -214,32 +221,32 @@ func resourceTencentCloudAPIGatewayIPStrategyUpdate(d *schema.ResourceData, meta
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudAPIGatewayIPStrategyRead(d, meta)
+	return resourceTencentCloudAPIGatewayIPStrategyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayIPStrategyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayIPStrategyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_ip_strategy.delete")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id                = d.Id()
 		err               error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(id, FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("IP strategy is can't delete, id is borken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(id, 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	serviceId := idSplit[0]
 	strategyId := idSplit[1]
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		err = apiGatewayService.DeleteIPStrategy(ctx, serviceId, strategyId)
 		if err != nil {
 			return retryError(err)
@@ -247,7 +254,7 @@ func resourceTencentCloudAPIGatewayIPStrategyDelete(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
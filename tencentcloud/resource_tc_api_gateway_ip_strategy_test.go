@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func TestAccTencentCloudAPIGateWayIPStrategy_basic(t *testing.T) {
@@ -55,7 +55,7 @@ func testApiIPStrategyDestroy(s *terraform.State) error {
 		if rs.Type != "tencentcloud_api_gateway_ip_strategy" {
 			continue
 		}
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		if len(idSplit) != 2 {
 			return fmt.Errorf("IP strategy id is borken, id is %s", rs.Primary.ID)
 		}
@@ -87,7 +87,7 @@ func testApiIPStrategyExists(n string) resource.TestCheckFunc {
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("[CHECK][IP strategy][Exists] check: id is not set")
 		}
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		if len(idSplit) != 2 {
 			return fmt.Errorf("IP strategy id is borken, id is %s", rs.Primary.ID)
 		}
@@ -27,9 +27,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apiGateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
@@ -38,12 +39,16 @@ import (
 
 func resourceTencentCloudAPIGatewayPlugin() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayPluginCreate,
-		Read:   resourceTencentCloudAPIGatewayPluginRead,
-		Update: resourceTencentCloudAPIGatewayPluginUpdate,
-		Delete: resourceTencentCloudAPIGatewayPluginDelete,
+		CreateContext: resourceTencentCloudAPIGatewayPluginCreate,
+		ReadContext:   resourceTencentCloudAPIGatewayPluginRead,
+		UpdateContext: resourceTencentCloudAPIGatewayPluginUpdate,
+		DeleteContext: resourceTencentCloudAPIGatewayPluginDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"plugin_name": {
@@ -70,7 +75,7 @@ func resourceTencentCloudAPIGatewayPlugin() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAPIGatewayPluginCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayPluginCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_plugin.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -96,8 +101,8 @@ func resourceTencentCloudAPIGatewayPluginCreate(d *schema.ResourceData, meta int
 		request.Description = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().CreatePlugin(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().CreatePluginWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -110,33 +115,33 @@ func resourceTencentCloudAPIGatewayPluginCreate(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create apiGateway plugin failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*response.Response.Result.PluginId)
 
-	return resourceTencentCloudAPIGatewayPluginRead(d, meta)
+	return resourceTencentCloudAPIGatewayPluginRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayPluginRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayPluginRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_plugin.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId    = getLogId(contextNil)
-		ctx      = context.WithValue(context.TODO(), logIdKey, logId)
 		service  = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		pluginId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	plugin, err := service.DescribeApiGatewayPluginById(ctx, pluginId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if plugin == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `ApiGatewayPlugin` %s does not exist", d.Id())
+		return diag.Errorf("resource `ApiGatewayPlugin` %s does not exist", d.Id())
 	}
 
 	if plugin.PluginName != nil {
@@ -158,7 +163,7 @@ func resourceTencentCloudAPIGatewayPluginRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudAPIGatewayPluginUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayPluginUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_plugin.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -171,7 +176,7 @@ func resourceTencentCloudAPIGatewayPluginUpdate(d *schema.ResourceData, meta int
 	unsupportedUpdateFields := []string{"plugin_type"}
 	for _, field := range unsupportedUpdateFields {
 		if d.HasChange(field) {
-			return fmt.Errorf("tencentcloud_api_gateway_plugin update on %s is not support yet", field)
+			return diag.Errorf("tencentcloud_api_gateway_plugin update on %s is not support yet", field)
 		}
 	}
 
@@ -195,8 +200,8 @@ func resourceTencentCloudAPIGatewayPluginUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().ModifyPlugin(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().ModifyPluginWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -207,25 +212,25 @@ func resourceTencentCloudAPIGatewayPluginUpdate(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update apiGateway plugin failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudAPIGatewayPluginRead(d, meta)
+	return resourceTencentCloudAPIGatewayPluginRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayPluginDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayPluginDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_plugin.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId    = getLogId(contextNil)
-		ctx      = context.WithValue(context.TODO(), logIdKey, logId)
 		service  = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		pluginId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if err := service.DeleteApiGatewayPluginById(ctx, pluginId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -87,8 +87,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apiGateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
@@ -97,11 +98,14 @@ import (
 
 func resourceTencentCloudAPIGatewayPluginAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayPluginAttachmentCreate,
-		Read:   resourceTencentCloudAPIGatewayPluginAttachmentRead,
-		Delete: resourceTencentCloudAPIGatewayPluginAttachmentDelete,
+		CreateContext: resourceTencentCloudAPIGatewayPluginAttachmentCreate,
+		ReadContext:   resourceTencentCloudAPIGatewayPluginAttachmentRead,
+		DeleteContext: resourceTencentCloudAPIGatewayPluginAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"plugin_id": {
@@ -132,7 +136,7 @@ func resourceTencentCloudAPIGatewayPluginAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAPIGatewayPluginAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayPluginAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_plugin_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -165,8 +169,8 @@ func resourceTencentCloudAPIGatewayPluginAttachmentCreate(d *schema.ResourceData
 		request.ApiIds = []*string{helper.String(v.(string))}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().AttachPlugin(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().AttachPluginWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -183,26 +187,26 @@ func resourceTencentCloudAPIGatewayPluginAttachmentCreate(d *schema.ResourceData
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create apiGateway pluginAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{pluginId, serviceId, environmentName, apiId}, FILED_SP))
-	return resourceTencentCloudAPIGatewayPluginAttachmentRead(d, meta)
+	d.SetId(helper.IdFormat(pluginId, serviceId, environmentName, apiId))
+	return resourceTencentCloudAPIGatewayPluginAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayPluginAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayPluginAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_plugin_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	pluginId := idSplit[0]
 	serviceId := idSplit[1]
@@ -211,12 +215,12 @@ func resourceTencentCloudAPIGatewayPluginAttachmentRead(d *schema.ResourceData,
 
 	pluginAttachment, err := service.DescribeApiGatewayPluginAttachmentById(ctx, pluginId, serviceId, environmentName, apiId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if pluginAttachment == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `APIGatewayPluginAttachment` %s does not exist", d.Id())
+		return diag.Errorf("resource `APIGatewayPluginAttachment` %s does not exist", d.Id())
 	}
 
 	_ = d.Set("plugin_id", pluginId)
@@ -236,19 +240,19 @@ func resourceTencentCloudAPIGatewayPluginAttachmentRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudAPIGatewayPluginAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayPluginAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_plugin_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	pluginId := idSplit[0]
 	serviceId := idSplit[1]
@@ -256,7 +260,7 @@ func resourceTencentCloudAPIGatewayPluginAttachmentDelete(d *schema.ResourceData
 	apiId := idSplit[3]
 
 	if err := service.DeleteApiGatewayPluginAttachmentById(ctx, pluginId, serviceId, environmentName, apiId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -66,7 +66,9 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
@@ -76,12 +78,17 @@ import (
 
 func resourceTencentCloudAPIGatewayService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayServiceCreate,
-		Read:   resourceTencentCloudAPIGatewayServiceRead,
-		Update: resourceTencentCloudAPIGatewayServiceUpdate,
-		Delete: resourceTencentCloudAPIGatewayServiceDelete,
+		CreateContext: resourceTencentCloudAPIGatewayServiceCreate,
+		ReadContext:   resourceTencentCloudAPIGatewayServiceRead,
+		UpdateContext: resourceTencentCloudAPIGatewayServiceUpdate,
+		DeleteContext: resourceTencentCloudAPIGatewayServiceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -259,12 +266,11 @@ func resourceTencentCloudAPIGatewayService() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAPIGatewayServiceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_service.create")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		serviceName       = d.Get("service_name").(string)
 		protocol          = d.Get("protocol").(string)
@@ -281,6 +287,7 @@ func resourceTencentCloudAPIGatewayServiceCreate(d *schema.ResourceData, meta in
 		preLimit     int
 		testLimit    int
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("instance_id"); ok {
 		instanceId = v.(string)
@@ -290,7 +297,7 @@ func resourceTencentCloudAPIGatewayServiceCreate(d *schema.ResourceData, meta in
 		vpcId = v.(string)
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		serviceId, err = apiGatewayService.CreateService(ctx,
 			serviceName,
 			protocol,
@@ -315,11 +322,11 @@ func resourceTencentCloudAPIGatewayServiceCreate(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//wait service create ok
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		_, has, inErr := apiGatewayService.DescribeService(ctx, serviceId)
 		if inErr != nil {
 			return retryError(inErr, InternalError)
@@ -330,7 +337,7 @@ func resourceTencentCloudAPIGatewayServiceCreate(d *schema.ResourceData, meta in
 		return resource.RetryableError(fmt.Errorf("service %s not found on server", serviceId))
 
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok := d.GetOk("pre_limit"); ok {
@@ -348,21 +355,21 @@ func resourceTencentCloudAPIGatewayServiceCreate(d *schema.ResourceData, meta in
 	if preLimit != 0 {
 		_, err = apiGatewayService.ModifyServiceEnvironmentStrategy(ctx, serviceId, int64(preLimit), []string{API_GATEWAY_SERVICE_ENV_PREPUB})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if releaseLimit != 0 {
 		_, err = apiGatewayService.ModifyServiceEnvironmentStrategy(ctx, serviceId, int64(releaseLimit), []string{API_GATEWAY_SERVICE_ENV_RELEASE})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if testLimit != 0 {
 		_, err = apiGatewayService.ModifyServiceEnvironmentStrategy(ctx, serviceId, int64(testLimit), []string{API_GATEWAY_SERVICE_ENV_TEST})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -371,23 +378,22 @@ func resourceTencentCloudAPIGatewayServiceCreate(d *schema.ResourceData, meta in
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::apigw:%s:uin/:service/%s", region, serviceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(serviceId)
 
-	return resourceTencentCloudAPIGatewayServiceRead(d, meta)
+	return resourceTencentCloudAPIGatewayServiceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayServiceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_service.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId             = getLogId(contextNil)
 		serviceId         = d.Id()
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		info              apigateway.DescribeServiceResponse
 		has               bool
@@ -397,15 +403,16 @@ func resourceTencentCloudAPIGatewayServiceRead(d *schema.ResourceData, meta inte
 		preLimit     int64
 		testLimit    int64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		info, has, err = apiGatewayService.DescribeService(ctx, serviceId)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -431,14 +438,14 @@ func resourceTencentCloudAPIGatewayServiceRead(d *schema.ResourceData, meta inte
 	var hasContains = make(map[string]bool)
 
 	//from service
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		plans, err = apiGatewayService.DescribeServiceUsagePlan(ctx, serviceId)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, item := range plans {
@@ -456,14 +463,14 @@ func resourceTencentCloudAPIGatewayServiceRead(d *schema.ResourceData, meta inte
 	}
 
 	//from API
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		plans, err = apiGatewayService.DescribeApiUsagePlan(ctx, serviceId)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, item := range plans {
@@ -532,7 +539,7 @@ func resourceTencentCloudAPIGatewayServiceRead(d *schema.ResourceData, meta inte
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "apigw", "service", tcClient.Region, serviceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("tags", tags)
@@ -540,12 +547,11 @@ func resourceTencentCloudAPIGatewayServiceRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudAPIGatewayServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_service.update")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		serviceName       = d.Get("service_name").(string)
 		protocol          = d.Get("protocol").(string)
@@ -558,8 +564,9 @@ func resourceTencentCloudAPIGatewayServiceUpdate(d *schema.ResourceData, meta in
 		preLimit     int
 		testLimit    int
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	d.Partial(true)
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		if err = apiGatewayService.ModifyService(ctx,
 			serviceId,
 			serviceName,
@@ -571,7 +578,7 @@ func resourceTencentCloudAPIGatewayServiceUpdate(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("pre_limit") {
@@ -581,7 +588,7 @@ func resourceTencentCloudAPIGatewayServiceUpdate(d *schema.ResourceData, meta in
 		if preLimit != 0 {
 			_, err = apiGatewayService.ModifyServiceEnvironmentStrategy(ctx, serviceId, int64(preLimit), []string{API_GATEWAY_SERVICE_ENV_PREPUB})
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -594,7 +601,7 @@ func resourceTencentCloudAPIGatewayServiceUpdate(d *schema.ResourceData, meta in
 		if releaseLimit != 0 {
 			_, err = apiGatewayService.ModifyServiceEnvironmentStrategy(ctx, serviceId, int64(releaseLimit), []string{API_GATEWAY_SERVICE_ENV_RELEASE})
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -607,7 +614,7 @@ func resourceTencentCloudAPIGatewayServiceUpdate(d *schema.ResourceData, meta in
 		if testLimit != 0 {
 			_, err = apiGatewayService.ModifyServiceEnvironmentStrategy(ctx, serviceId, int64(testLimit), []string{API_GATEWAY_SERVICE_ENV_TEST})
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -620,24 +627,24 @@ func resourceTencentCloudAPIGatewayServiceUpdate(d *schema.ResourceData, meta in
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("apigw", "service", tcClient.Region, serviceId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.Partial(false)
-	return resourceTencentCloudAPIGatewayServiceRead(d, meta)
+	return resourceTencentCloudAPIGatewayServiceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayServiceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_service.delete")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		serviceId         = d.Id()
 		err               error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	// del tags
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
@@ -649,30 +656,30 @@ func resourceTencentCloudAPIGatewayServiceDelete(d *schema.ResourceData, meta in
 			tmpList = append(tmpList, k)
 		}
 		if e := tagService.ModifyTags(ctx, resourceName, nil, tmpList); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
 	for _, env := range API_GATEWAY_SERVICE_ENVS {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			if err = apiGatewayService.UnReleaseService(ctx, serviceId, env); err != nil {
 				return retryError(err)
 			}
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if err = apiGatewayService.DeleteService(ctx, serviceId); err != nil {
 			return retryError(err)
 		}
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -66,20 +66,26 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudAPIGatewayServiceRelease() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayServiceReleaseCreate,
-		Read:   resourceTencentCloudAPIGatewayServiceReleaseRead,
-		Delete: resourceTencentCloudAPIGatewayServiceReleaseDelete,
+		CreateContext: resourceTencentCloudAPIGatewayServiceReleaseCreate,
+		ReadContext:   resourceTencentCloudAPIGatewayServiceReleaseRead,
+		DeleteContext: resourceTencentCloudAPIGatewayServiceReleaseDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -112,12 +118,11 @@ func resourceTencentCloudAPIGatewayServiceRelease() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAPIGatewayServiceReleaseCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayServiceReleaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_service_release.create")()
 
 	var (
 		logId                = getLogId(contextNil)
-		ctx                  = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService    = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		serviceId            = d.Get("service_id").(string)
 		environmentName      = d.Get("environment_name").(string)
@@ -128,9 +133,10 @@ func resourceTencentCloudAPIGatewayServiceReleaseCreate(d *schema.ResourceData,
 		serviceResponse      apigateway.DescribeServiceResponse
 		checkServiceResponse apigateway.DescribeServiceResponse
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	//check API gateway serviceid and service contains api
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		checkServiceResponse, has, err = apiGatewayService.DescribeService(ctx, serviceId)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -140,19 +146,19 @@ func resourceTencentCloudAPIGatewayServiceReleaseCreate(d *schema.ResourceData,
 		}
 		return resource.RetryableError(fmt.Errorf("API gateway service %s not found on server", serviceId))
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if *checkServiceResponse.Response.ApiTotalCount < 1 {
-		return fmt.Errorf("there is no API under the current service, please create an API before publishing")
+		return diag.Errorf("there is no API under the current service, please create an API before publishing")
 	}
 
 	// release API gateway service
 	if releaseResponse, err = apiGatewayService.ReleaseService(ctx, serviceId, environmentName, releaseDesc); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//wait service release ok
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		serviceResponse, has, err = apiGatewayService.DescribeService(ctx, serviceId)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -162,7 +168,7 @@ func resourceTencentCloudAPIGatewayServiceReleaseCreate(d *schema.ResourceData,
 		}
 		return resource.RetryableError(fmt.Errorf("API gateway service %s not found on server", serviceId))
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, v := range serviceResponse.Response.AvailableEnvironments {
@@ -173,30 +179,30 @@ func resourceTencentCloudAPIGatewayServiceReleaseCreate(d *schema.ResourceData,
 	}
 
 	if !existEnv {
-		return fmt.Errorf("API gateway service not release success")
+		return diag.Errorf("API gateway service not release success")
 	}
 
-	d.SetId(strings.Join([]string{serviceId, environmentName, *releaseResponse.Response.Result.ReleaseVersion}, FILED_SP))
+	d.SetId(helper.IdFormat(serviceId, environmentName, *releaseResponse.Response.Result.ReleaseVersion))
 
-	return resourceTencentCloudAPIGatewayServiceReleaseRead(d, meta)
+	return resourceTencentCloudAPIGatewayServiceReleaseRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayServiceReleaseRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayServiceReleaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_service_release.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id                = d.Id()
 		info              []*apigateway.ServiceReleaseHistoryInfo
 		err               error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	ids := strings.Split(id, FILED_SP)
-	if len(ids) != 3 {
-		return fmt.Errorf("id is broken, id is %s", id)
+	ids, err := helper.IdParseN(id, 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	var (
 		serviceId  = ids[0]
@@ -204,14 +210,14 @@ func resourceTencentCloudAPIGatewayServiceReleaseRead(d *schema.ResourceData, me
 		envVersion = ids[2]
 	)
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		info, _, err = apiGatewayService.DescribeServiceEnvironmentReleaseHistory(ctx, serviceId, envName)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(info) == 0 {
 		d.SetId("")
@@ -230,34 +236,34 @@ func resourceTencentCloudAPIGatewayServiceReleaseRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudAPIGatewayServiceReleaseDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayServiceReleaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_service_release.delete")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id                = d.Id()
 		err               error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	ids := strings.Split(id, FILED_SP)
-	if len(ids) != 3 {
-		return fmt.Errorf("id is broken, id is %s", id)
+	ids, err := helper.IdParseN(id, 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	var (
 		serviceId = ids[0]
 		envName   = ids[1]
 	)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if err = apiGatewayService.UnReleaseService(ctx, serviceId, envName); err != nil {
 			return retryError(err)
 		}
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -3,12 +3,12 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 var (
@@ -57,7 +57,7 @@ func testAccCheckAPIGatewayServiceReleaseDestroy(s *terraform.State) error {
 			has               bool
 		)
 
-		ids := strings.Split(rs.Primary.ID, FILED_SP)
+		ids := helper.IdParse(rs.Primary.ID)
 		if len(ids) != 3 {
 			return fmt.Errorf("id is broken, id is %s", rs.Primary.ID)
 		}
@@ -104,7 +104,7 @@ func testAccCheckAPIGatewayServiceReleaseExists(n string) resource.TestCheckFunc
 			has               bool
 		)
 
-		ids := strings.Split(rs.Primary.ID, FILED_SP)
+		ids := helper.IdParse(rs.Primary.ID)
 		if len(ids) != 3 {
 			return fmt.Errorf("id is broken, id is %s", rs.Primary.ID)
 		}
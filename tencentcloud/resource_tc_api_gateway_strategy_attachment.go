@@ -80,19 +80,24 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudAPIGatewayStrategyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayStrategyAttachmentCreate,
-		Read:   resourceTencentCloudAPIGatewayStrategyAttachmentRead,
-		Delete: resourceTencentCloudAPIGatewayStrategyAttachmentDelete,
+		CreateContext: resourceTencentCloudAPIGatewayStrategyAttachmentCreate,
+		ReadContext:   resourceTencentCloudAPIGatewayStrategyAttachmentRead,
+		DeleteContext: resourceTencentCloudAPIGatewayStrategyAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -128,12 +133,11 @@ func resourceTencentCloudAPIGatewayStrategyAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAPIGatewayStrategyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayStrategyAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_strategy_attachment.create")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		serviceId         = d.Get("service_id").(string)
 		strategyId        = d.Get("strategy_id").(string)
@@ -142,8 +146,9 @@ func resourceTencentCloudAPIGatewayStrategyAttachmentCreate(d *schema.ResourceDa
 		err               error
 		has               bool
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		_, err = apiGatewayService.CreateStrategyAttachment(ctx, serviceId, strategyId, envName, bindApiId)
 		if err != nil {
 			return retryError(err)
@@ -151,13 +156,13 @@ func resourceTencentCloudAPIGatewayStrategyAttachmentCreate(d *schema.ResourceDa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{serviceId, strategyId, bindApiId, envName}, FILED_SP))
+	d.SetId(helper.IdFormat(serviceId, strategyId, bindApiId, envName))
 
 	//wait IP strategy create ok
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		has, err = apiGatewayService.DescribeStrategyAttachment(ctx, serviceId, strategyId, bindApiId)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -165,44 +170,44 @@ func resourceTencentCloudAPIGatewayStrategyAttachmentCreate(d *schema.ResourceDa
 		if has {
 			return nil
 		}
-		return resource.RetryableError(fmt.Errorf("IP strategy attachment %s not found on server", strings.Join([]string{strategyId, bindApiId}, FILED_SP)))
+		return resource.RetryableError(fmt.Errorf("IP strategy attachment %s not found on server", helper.IdFormat(strategyId, bindApiId)))
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudAPIGatewayStrategyAttachmentRead(d, meta)
+	return resourceTencentCloudAPIGatewayStrategyAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayStrategyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayStrategyAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_strategy_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		attachmentId      = d.Id()
 		err               error
 		has               bool
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(attachmentId, FILED_SP)
-	if len(idSplit) != 4 {
-		return fmt.Errorf("IP strategy attachment id is broken, id is %s", attachmentId)
+	idSplit, err := helper.IdParseN(attachmentId, 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	serviceId := idSplit[0]
 	strategyId := idSplit[1]
 	bindApiId := idSplit[2]
 	envname := idSplit[3]
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		has, err = apiGatewayService.DescribeStrategyAttachment(ctx, serviceId, strategyId, bindApiId)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !has {
@@ -218,25 +223,25 @@ func resourceTencentCloudAPIGatewayStrategyAttachmentRead(d *schema.ResourceData
 	return nil
 }
 
-func resourceTencentCloudAPIGatewayStrategyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayStrategyAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_strategy_attachment.delete")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		serviceId         = d.Get("service_id").(string)
 		strategyId        = d.Get("strategy_id").(string)
 		envName           = d.Get("environment_name").(string)
 		bindApiId         = d.Get("bind_api_id").(string)
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	has, err := apiGatewayService.DeleteStrategyAttachment(ctx, serviceId, strategyId, envName, bindApiId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
-		return fmt.Errorf("IP strategy is still exist")
+		return diag.Errorf("IP strategy is still exist")
 	}
 
 	return nil
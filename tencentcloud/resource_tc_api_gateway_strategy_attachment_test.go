@@ -3,13 +3,13 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func TestAccTencentCloudAPIGateWayStrategyAttachment_basic(t *testing.T) {
@@ -47,7 +47,7 @@ func testApiStrategyAttachmentDestroy(s *terraform.State) error {
 		if rs.Type != "tencentcloud_api_gateway_strategy_attachment" {
 			continue
 		}
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		if len(idSplit) != 4 {
 			return fmt.Errorf("IP strategy attachment id is broken, id is %s", rs.Primary.ID)
 		}
@@ -85,7 +85,7 @@ func testApiStrategyAttachmentExists(n string) resource.TestCheckFunc {
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("[CHECK][IP strategy][Exists] check: id is not set")
 		}
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		if len(idSplit) != 4 {
 			return fmt.Errorf("IP strategy attachment id is broken, id is %s", rs.Primary.ID)
 		}
@@ -138,7 +138,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
@@ -147,12 +149,16 @@ import (
 
 func resourceTencentCloudAPIGatewayUpstream() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayUpstreamCreate,
-		Read:   resourceTencentCloudAPIGatewayUpstreamRead,
-		Update: resourceTencentCloudAPIGatewayUpstreamUpdate,
-		Delete: resourceTencentCloudAPIGatewayUpstreamDelete,
+		CreateContext: resourceTencentCloudAPIGatewayUpstreamCreate,
+		ReadContext:   resourceTencentCloudAPIGatewayUpstreamRead,
+		UpdateContext: resourceTencentCloudAPIGatewayUpstreamUpdate,
+		DeleteContext: resourceTencentCloudAPIGatewayUpstreamDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"scheme": {
@@ -394,7 +400,7 @@ func resourceTencentCloudAPIGatewayUpstream() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAPIGatewayUpstreamCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayUpstreamCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_upstream.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -595,8 +601,8 @@ func resourceTencentCloudAPIGatewayUpstreamCreate(d *schema.ResourceData, meta i
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().CreateUpstream(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().CreateUpstreamWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -609,40 +615,40 @@ func resourceTencentCloudAPIGatewayUpstreamCreate(d *schema.ResourceData, meta i
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create apigateway upstream failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	upstreamId = *response.Response.UpstreamId
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::apigateway:%s:uin/:upstreamId/%s", region, upstreamId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(upstreamId)
-	return resourceTencentCloudAPIGatewayUpstreamRead(d, meta)
+	return resourceTencentCloudAPIGatewayUpstreamRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayUpstreamRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayUpstreamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_upstream.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId        = getLogId(contextNil)
-		ctx          = context.WithValue(context.TODO(), logIdKey, logId)
 		service      = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		upstreamId   = d.Id()
 		upstreamType string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	upstreamInfo, err := service.DescribeApigatewayUpstreamById(ctx, upstreamId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if upstreamInfo == nil {
@@ -855,7 +861,7 @@ func resourceTencentCloudAPIGatewayUpstreamRead(d *schema.ResourceData, meta int
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "apigateway", "upstreamId", tcClient.Region, upstreamId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("tags", tags)
@@ -863,7 +869,7 @@ func resourceTencentCloudAPIGatewayUpstreamRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudAPIGatewayUpstreamUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayUpstreamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_upstream.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -1085,8 +1091,8 @@ func resourceTencentCloudAPIGatewayUpstreamUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().ModifyUpstream(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAPIGatewayClient().ModifyUpstreamWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1098,37 +1104,37 @@ func resourceTencentCloudAPIGatewayUpstreamUpdate(d *schema.ResourceData, meta i
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update apigateway upstream failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		oldTags, newTags := d.GetChange("tags")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("apigateway", "upstreamId", tcClient.Region, upstreamId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudAPIGatewayUpstreamRead(d, meta)
+	return resourceTencentCloudAPIGatewayUpstreamRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayUpstreamDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayUpstreamDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_upstream.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		upstreamId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if err := service.DeleteApigatewayUpstreamById(ctx, upstreamId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
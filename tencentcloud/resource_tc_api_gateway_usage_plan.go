@@ -25,7 +25,9 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
@@ -34,12 +36,17 @@ import (
 
 func resourceTencentCloudAPIGatewayUsagePlan() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayUsagePlanCreate,
-		Read:   resourceTencentCloudAPIGatewayUsagePlanRead,
-		Update: resourceTencentCloudAPIGatewayUsagePlanUpdate,
-		Delete: resourceTencentCloudAPIGatewayUsagePlanDelete,
+		CreateContext: resourceTencentCloudAPIGatewayUsagePlanCreate,
+		ReadContext:   resourceTencentCloudAPIGatewayUsagePlanRead,
+		UpdateContext: resourceTencentCloudAPIGatewayUsagePlanUpdate,
+		DeleteContext: resourceTencentCloudAPIGatewayUsagePlanDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -152,18 +159,18 @@ func resourceTencentCloudAPIGatewayUsagePlan() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAPIGatewayUsagePlanCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayUsagePlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_usage_plan.create")()
 
 	var (
 		logId               = getLogId(contextNil)
-		ctx                 = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService   = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		usagePlanName       = d.Get("usage_plan_name").(string)
 		maxRequestNum       = int64(d.Get("max_request_num").(int))
 		maxRequestNumPreSec = int64(d.Get("max_request_num_pre_sec").(int))
 		usagePlanDesc       *string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, has := d.GetOk("usage_plan_desc"); has {
 		usagePlanDesc = helper.String(v.(string))
@@ -171,13 +178,13 @@ func resourceTencentCloudAPIGatewayUsagePlanCreate(d *schema.ResourceData, meta
 
 	usagePlanId, err := apiGatewayService.CreateUsagePlan(ctx, usagePlanName, usagePlanDesc, maxRequestNum, maxRequestNumPreSec)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(usagePlanId)
 
 	//wait usage plan create ok
-	if outErr := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if outErr := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		_, has, inErr := apiGatewayService.DescribeUsagePlan(ctx, usagePlanId)
 		if inErr != nil {
 			return retryError(inErr, InternalError)
@@ -188,19 +195,18 @@ func resourceTencentCloudAPIGatewayUsagePlanCreate(d *schema.ResourceData, meta
 		return resource.RetryableError(fmt.Errorf(" usage plan  %s not found on server", usagePlanId))
 
 	}); outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	return resourceTencentCloudAPIGatewayUsagePlanRead(d, meta)
+	return resourceTencentCloudAPIGatewayUsagePlanRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayUsagePlanRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayUsagePlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_usage_plan.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		usagePlanId       = d.Id()
 		info              apigateway.UsagePlanInfo
@@ -208,15 +214,16 @@ func resourceTencentCloudAPIGatewayUsagePlanRead(d *schema.ResourceData, meta in
 		err               error
 		has               bool
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		info, has, err = apiGatewayService.DescribeUsagePlan(ctx, usagePlanId)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -225,7 +232,7 @@ func resourceTencentCloudAPIGatewayUsagePlanRead(d *schema.ResourceData, meta in
 
 	//service attach and API
 	for _, bindType := range API_GATEWAY_TYPES {
-		if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			list, inErr := apiGatewayService.DescribeUsagePlanEnvironments(ctx, usagePlanId, bindType)
 			if inErr != nil {
 				return retryError(inErr, InternalError)
@@ -233,7 +240,7 @@ func resourceTencentCloudAPIGatewayUsagePlanRead(d *schema.ResourceData, meta in
 			attachList = append(attachList, list...)
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -269,13 +276,12 @@ func resourceTencentCloudAPIGatewayUsagePlanRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTencentCloudAPIGatewayUsagePlanUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayUsagePlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_usage_plan.update")()
 
 	var (
 		logId               = getLogId(contextNil)
 		usagePlanId         = d.Id()
-		ctx                 = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService   = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		usagePlanName       = d.Get("usage_plan_name").(string)
 		maxRequestNum       = int64(d.Get("max_request_num").(int))
@@ -283,6 +289,7 @@ func resourceTencentCloudAPIGatewayUsagePlanUpdate(d *schema.ResourceData, meta
 		err                 error
 		usagePlanDesc       *string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, has := d.GetOk("usage_plan_desc"); has {
 		usagePlanDesc = helper.String(v.(string))
@@ -291,7 +298,7 @@ func resourceTencentCloudAPIGatewayUsagePlanUpdate(d *schema.ResourceData, meta
 	if d.HasChange("usage_plan_name") || d.HasChange("usage_plan_desc") ||
 		d.HasChange("max_request_num") || d.HasChange("max_request_num_pre_sec") {
 
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			err = apiGatewayService.ModifyUsagePlan(ctx,
 				usagePlanId,
 				usagePlanName,
@@ -305,28 +312,28 @@ func resourceTencentCloudAPIGatewayUsagePlanUpdate(d *schema.ResourceData, meta
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudAPIGatewayUsagePlanRead(d, meta)
+	return resourceTencentCloudAPIGatewayUsagePlanRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayUsagePlanDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayUsagePlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_usage_plan.delete")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		usagePlanId       = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	return diag.FromErr(retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		inErr := apiGatewayService.DeleteUsagePlan(ctx, usagePlanId)
 		if inErr != nil {
 			return retryError(inErr)
 		}
 		return nil
-	})
+	}))
 }
@@ -100,18 +100,20 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudAPIGatewayUsagePlanAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayUsagePlanAttachmentCreate,
-		Read:   resourceTencentCloudAPIGatewayUsagePlanAttachmentRead,
-		Delete: resourceTencentCloudAPIGatewayUsagePlanAttachmentDelete,
+		CreateContext: resourceTencentCloudAPIGatewayUsagePlanAttachmentCreate,
+		ReadContext:   resourceTencentCloudAPIGatewayUsagePlanAttachmentRead,
+		DeleteContext: resourceTencentCloudAPIGatewayUsagePlanAttachmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -159,12 +161,11 @@ func resourceTencentCloudAPIGatewayUsagePlanAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAPIGatewayUsagePlanAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayUsagePlanAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_usage_plan_attachment.create")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		usagePlanId       = d.Get("usage_plan_id").(string)
 		serviceId         = d.Get("service_id").(string)
@@ -174,6 +175,7 @@ func resourceTencentCloudAPIGatewayUsagePlanAttachmentCreate(d *schema.ResourceD
 		err               error
 		accessKeys        []string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("api_id"); ok {
 		apiId = v.(string)
@@ -188,57 +190,57 @@ func resourceTencentCloudAPIGatewayUsagePlanAttachmentCreate(d *schema.ResourceD
 	}
 
 	if bindType == API_GATEWAY_TYPE_API && apiId == "" {
-		return fmt.Errorf("parameter `api_ids` is required when `bind_type` is `API`")
+		return diag.Errorf("parameter `api_ids` is required when `bind_type` is `API`")
 	}
 
 	if bindType == API_GATEWAY_TYPE_API && apiId != "" && len(accessKeys) != 0 {
 		if err = checkApiAuthType(ctx, apiGatewayService, serviceId, apiId); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	//check usage plan
 	if err = checkUsagePlan(ctx, apiGatewayService, usagePlanId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//check service
 	if err = checkService(ctx, apiGatewayService, serviceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// BindEnvironment
 	if err = apiGatewayService.BindEnvironment(ctx, serviceId, environment, bindType, usagePlanId, apiId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// BindSecretIds
 	if bindType == API_GATEWAY_TYPE_API && apiId != "" && len(accessKeys) != 0 {
 		if err = apiGatewayService.BindSecretIds(ctx, usagePlanId, accessKeys); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	accessKeysStr := strings.Join(accessKeys, COMMA_SP)
-	d.SetId(strings.Join([]string{usagePlanId, serviceId, environment, bindType, apiId, accessKeysStr}, FILED_SP))
+	d.SetId(helper.IdFormat(usagePlanId, serviceId, environment, bindType, apiId, accessKeysStr))
 
-	return resourceTencentCloudAPIGatewayUsagePlanAttachmentRead(d, meta)
+	return resourceTencentCloudAPIGatewayUsagePlanAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAPIGatewayUsagePlanAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayUsagePlanAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_usage_plan_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id                = d.Id()
 		err               error
 	)
-	ids := strings.Split(id, FILED_SP)
-	if len(ids) != 6 {
-		return fmt.Errorf("id is broken, id is %s", id)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	ids, err := helper.IdParseN(id, 6)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	var (
@@ -251,42 +253,42 @@ func resourceTencentCloudAPIGatewayUsagePlanAttachmentRead(d *schema.ResourceDat
 	)
 
 	if usagePlanId == "" || serviceId == "" || environment == "" || bindType == "" {
-		return fmt.Errorf("id is broken, id is %s", id)
+		return diag.Errorf("id is broken, id is %s", id)
 	}
 	if bindType == API_GATEWAY_TYPE_API && apiId == "" {
-		return fmt.Errorf("id is broken, id is %s", id)
+		return diag.Errorf("id is broken, id is %s", id)
 	}
 
 	// check usage plan
 	if err = checkUsagePlan(ctx, apiGatewayService, usagePlanId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//check service
 	if err = checkService(ctx, apiGatewayService, serviceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	plans := make([]*apigateway.ApiUsagePlan, 0)
 	if bindType == API_GATEWAY_TYPE_API {
-		if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			plans, err = apiGatewayService.DescribeApiUsagePlan(ctx, serviceId)
 			if err != nil {
 				return retryError(err, InternalError)
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			plans, err = apiGatewayService.DescribeServiceUsagePlan(ctx, serviceId)
 			if err != nil {
 				return retryError(err, InternalError)
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -303,17 +305,17 @@ func resourceTencentCloudAPIGatewayUsagePlanAttachmentRead(d *schema.ResourceDat
 		if *plan.UsagePlanId == usagePlanId && *plan.Environment == environment {
 			if bindType == API_GATEWAY_TYPE_API {
 				if plan.ApiId != nil && *plan.ApiId == apiId {
-					return setData()
+					return diag.FromErr(setData())
 				}
 			} else {
-				return setData()
+				return diag.FromErr(setData())
 			}
 		}
 	}
 
 	if bindType == API_GATEWAY_TYPE_API && apiId != "" && accessKeysStr != "" {
 		var accessKeyList []*apigateway.UsagePlanBindSecret
-		if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			accessKeyList, err = apiGatewayService.DescribeApiUsagePlanSecretIds(ctx, usagePlanId)
 			if err != nil {
 				return retryError(err, InternalError)
@@ -321,7 +323,7 @@ func resourceTencentCloudAPIGatewayUsagePlanAttachmentRead(d *schema.ResourceDat
 
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if len(accessKeyList) != 0 {
@@ -336,20 +338,20 @@ func resourceTencentCloudAPIGatewayUsagePlanAttachmentRead(d *schema.ResourceDat
 	return nil
 }
 
-func resourceTencentCloudAPIGatewayUsagePlanAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAPIGatewayUsagePlanAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_api_gateway_usage_plan_attachment.delete")()
 
 	var (
 		logId             = getLogId(contextNil)
-		ctx               = context.WithValue(context.TODO(), logIdKey, logId)
 		apiGatewayService = APIGatewayService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id                = d.Id()
 		err               error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	ids := strings.Split(id, FILED_SP)
-	if len(ids) != 6 {
-		return fmt.Errorf("id is broken, id is %s", id)
+	ids, err := helper.IdParseN(id, 6)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	var (
@@ -362,23 +364,23 @@ func resourceTencentCloudAPIGatewayUsagePlanAttachmentDelete(d *schema.ResourceD
 	)
 
 	if usagePlanId == "" || serviceId == "" || environment == "" || bindType == "" {
-		return fmt.Errorf("id is broken, id is %s", id)
+		return diag.Errorf("id is broken, id is %s", id)
 	}
 	if bindType == API_GATEWAY_TYPE_API && apiId == "" {
-		return fmt.Errorf("id is broken, id is %s", id)
+		return diag.Errorf("id is broken, id is %s", id)
 	}
 
 	if bindType == API_GATEWAY_TYPE_API && apiId != "" && accessKeysStr != "" {
 		// UnBindSecretIds
 		accessKeyList := strings.Split(accessKeysStr, COMMA_SP)
 		if err = apiGatewayService.UnBindSecretIds(ctx, usagePlanId, accessKeyList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	// UnBindEnvironment
 	if err = apiGatewayService.UnBindEnvironment(ctx, serviceId, environment, bindType, usagePlanId, apiId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -389,7 +391,7 @@ func checkUsagePlan(ctx context.Context, api APIGatewayService, usagePlanId stri
 		err error
 		has bool
 	)
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		_, has, err = api.DescribeUsagePlan(ctx, usagePlanId)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -411,7 +413,7 @@ func checkService(ctx context.Context, api APIGatewayService, serviceId string)
 		err error
 		has bool
 	)
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		_, has, err = api.DescribeService(ctx, serviceId)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -434,7 +436,7 @@ func checkApiAuthType(ctx context.Context, api APIGatewayService, serviceId, api
 		err error
 		has bool
 	)
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		res, has, err = api.DescribeApi(ctx, serviceId, apiId)
 		if err != nil {
 			return retryError(err, InternalError)
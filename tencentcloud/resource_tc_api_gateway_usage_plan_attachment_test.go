@@ -3,12 +3,12 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 var testAPIGatewayUsagePlanAttachmentResourceName = "tencentcloud_api_gateway_usage_plan_attachment"
@@ -42,7 +42,7 @@ func TestAccTencentCloudAPIGateWayUsagePlanAttachmentResource(t *testing.T) {
 }
 
 func base(ctx context.Context, rs *terraform.ResourceState) (plans []*apigateway.ApiUsagePlan, err error) {
-	ids := strings.Split(rs.Primary.ID, FILED_SP)
+	ids := helper.IdParse(rs.Primary.ID)
 	if len(ids) != 6 {
 		return nil, fmt.Errorf("id is broken, id is %s", rs.Primary.ID)
 	}
@@ -123,7 +123,7 @@ func testAccCheckAPIGatewayUsagePlanAttachmentDestroy(s *terraform.State) error
 			return err
 		}
 
-		ids := strings.Split(rs.Primary.ID, FILED_SP)
+		ids := helper.IdParse(rs.Primary.ID)
 		var (
 			usagePlanId = ids[0]
 			environment = ids[2]
@@ -163,7 +163,7 @@ func testAccCheckAPIGatewayUsagePlanAttachmentExists(n string) resource.TestChec
 			return err
 		}
 
-		ids := strings.Split(rs.Primary.ID, FILED_SP)
+		ids := helper.IdParse(rs.Primary.ID)
 		var (
 			usagePlanId = ids[0]
 			environment = ids[2]
@@ -29,7 +29,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apm/v20210622"
@@ -38,12 +40,16 @@ import (
 
 func resourceTencentCloudApmInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudApmInstanceCreate,
-		Read:   resourceTencentCloudApmInstanceRead,
-		Update: resourceTencentCloudApmInstanceUpdate,
-		Delete: resourceTencentCloudApmInstanceDelete,
+		CreateContext: resourceTencentCloudApmInstanceCreate,
+		ReadContext:   resourceTencentCloudApmInstanceRead,
+		UpdateContext: resourceTencentCloudApmInstanceUpdate,
+		DeleteContext: resourceTencentCloudApmInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -79,7 +85,7 @@ func resourceTencentCloudApmInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudApmInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudApmInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_apm_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -106,8 +112,8 @@ func resourceTencentCloudApmInstanceCreate(d *schema.ResourceData, meta interfac
 		request.SpanDailyCounters = helper.IntUint64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseApmClient().CreateApmInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseApmClient().CreateApmInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -118,32 +124,32 @@ func resourceTencentCloudApmInstanceCreate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create apm instance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId = *response.Response.InstanceId
 	d.SetId(instanceId)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::apm:%s:uin/:apm-instance/%s", region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudApmInstanceRead(d, meta)
+	return resourceTencentCloudApmInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudApmInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudApmInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_apm_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ApmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -151,7 +157,7 @@ func resourceTencentCloudApmInstanceRead(d *schema.ResourceData, meta interface{
 
 	instance, err := service.DescribeApmInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -180,14 +186,14 @@ func resourceTencentCloudApmInstanceRead(d *schema.ResourceData, meta interface{
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "apm", "apm-instance", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudApmInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudApmInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_apm_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -228,8 +234,8 @@ func resourceTencentCloudApmInstanceUpdate(d *schema.ResourceData, meta interfac
 			request.SpanDailyCounters = helper.IntUint64(v.(int))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseApmClient().ModifyApmInstance(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseApmClient().ModifyApmInstanceWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -239,38 +245,38 @@ func resourceTencentCloudApmInstanceUpdate(d *schema.ResourceData, meta interfac
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update apm instance failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	if d.HasChange("tags") {
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		oldTags, newTags := d.GetChange("tags")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("apm", "apm-instance", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudApmInstanceRead(d, meta)
+	return resourceTencentCloudApmInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudApmInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudApmInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_apm_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ApmService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instanceId := d.Id()
 
 	if err := service.DeleteApmInstanceById(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -76,12 +76,22 @@ resource "tencentcloud_as_attachment" "attachment" {
   instance_ids     = [tencentcloud_instance.example.id]
 }
 ```
+
+Import
+
+AutoScaling attachment can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_as_attachment.attachment asg-n32ymck2
+```
 */
 package tencentcloud
 
 import (
 	"context"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -89,10 +99,16 @@ import (
 
 func resourceTencentCloudAsAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsAttachmentCreate,
-		Read:   resourceTencentCloudAsAttachmentRead,
-		Update: resourceTencentCloudAsAttachmentUpdate,
-		Delete: resourceTencentCloudAsAttachmentDelete,
+		CreateContext: resourceTencentCloudAsAttachmentCreate,
+		ReadContext:   resourceTencentCloudAsAttachmentRead,
+		UpdateContext: resourceTencentCloudAsAttachmentUpdate,
+		DeleteContext: resourceTencentCloudAsAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
@@ -112,11 +128,11 @@ func resourceTencentCloudAsAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAsAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_attachment.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	scalingGroupId := d.Get("scaling_group_id").(string)
 	instanceIds := helper.InterfacesStrings(d.Get("instance_ids").(*schema.Set).List())
@@ -125,26 +141,26 @@ func resourceTencentCloudAsAttachmentCreate(d *schema.ResourceData, meta interfa
 	}
 	err := asService.AttachInstances(ctx, scalingGroupId, instanceIds)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(scalingGroupId)
 
-	return resourceTencentCloudAsAttachmentRead(d, meta)
+	return resourceTencentCloudAsAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAsAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	scalingGroupId := d.Id()
 	asService := AsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var instanceIds []string
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, errRet := asService.DescribeAutoScalingAttachment(ctx, scalingGroupId, false)
 		if errRet != nil {
 			return retryError(errRet)
@@ -153,21 +169,22 @@ func resourceTencentCloudAsAttachmentRead(d *schema.ResourceData, meta interface
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(instanceIds) < 1 {
 		d.SetId("")
 		return nil
 	}
+	_ = d.Set("scaling_group_id", scalingGroupId)
 	_ = d.Set("instance_ids", instanceIds)
 	return nil
 }
 
-func resourceTencentCloudAsAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_attachment.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	scalingGroupId := d.Id()
 	if d.HasChange("instance_ids") {
@@ -183,13 +200,13 @@ func resourceTencentCloudAsAttachmentUpdate(d *schema.ResourceData, meta interfa
 		if len(add) > 0 {
 			err := asService.AttachInstances(ctx, scalingGroupId, add)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		if len(remove) > 0 {
 			err := asService.DetachInstances(ctx, scalingGroupId, remove)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -197,18 +214,18 @@ func resourceTencentCloudAsAttachmentUpdate(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudAsAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_attachment.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	scalingGroupId := d.Id()
 	asService := AsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var instanceIds []string
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		result, errRet := asService.DescribeAutoScalingAttachment(ctx, scalingGroupId, false)
 		if errRet != nil {
 			return retryError(errRet)
@@ -217,7 +234,7 @@ func resourceTencentCloudAsAttachmentDelete(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(instanceIds) < 1 {
 		return nil
@@ -225,7 +242,7 @@ func resourceTencentCloudAsAttachmentDelete(d *schema.ResourceData, meta interfa
 
 	err = asService.DetachInstances(ctx, scalingGroupId, instanceIds)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
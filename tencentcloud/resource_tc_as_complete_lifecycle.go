@@ -90,8 +90,11 @@ resource "tencentcloud_as_complete_lifecycle" "complete_lifecycle" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -100,11 +103,14 @@ import (
 
 func resourceTencentCloudAsCompleteLifecycle() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsCompleteLifecycleCreate,
-		Read:   resourceTencentCloudAsCompleteLifecycleRead,
-		Delete: resourceTencentCloudAsCompleteLifecycleDelete,
+		CreateContext: resourceTencentCloudAsCompleteLifecycleCreate,
+		ReadContext:   resourceTencentCloudAsCompleteLifecycleRead,
+		DeleteContext: resourceTencentCloudAsCompleteLifecycleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"lifecycle_hook_id": {
@@ -138,7 +144,7 @@ func resourceTencentCloudAsCompleteLifecycle() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAsCompleteLifecycleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsCompleteLifecycleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_complete_lifecycle.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -165,8 +171,8 @@ func resourceTencentCloudAsCompleteLifecycleCreate(d *schema.ResourceData, meta
 		request.LifecycleActionToken = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().CompleteLifecycleAction(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().CompleteLifecycleActionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -176,22 +182,22 @@ func resourceTencentCloudAsCompleteLifecycleCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate as completeLifecycle failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(lifecycleHookId)
 
-	return resourceTencentCloudAsCompleteLifecycleRead(d, meta)
+	return resourceTencentCloudAsCompleteLifecycleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAsCompleteLifecycleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsCompleteLifecycleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_complete_lifecycle.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudAsCompleteLifecycleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsCompleteLifecycleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_complete_lifecycle.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -76,8 +76,11 @@ terraform import tencentcloud_as_execute_scaling_policy.execute_scaling_policy e
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -86,11 +89,14 @@ import (
 
 func resourceTencentCloudAsExecuteScalingPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsExecuteScalingPolicyCreate,
-		Read:   resourceTencentCloudAsExecuteScalingPolicyRead,
-		Delete: resourceTencentCloudAsExecuteScalingPolicyDelete,
+		CreateContext: resourceTencentCloudAsExecuteScalingPolicyCreate,
+		ReadContext:   resourceTencentCloudAsExecuteScalingPolicyRead,
+		DeleteContext: resourceTencentCloudAsExecuteScalingPolicyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"auto_scaling_policy_id": {
@@ -117,7 +123,7 @@ func resourceTencentCloudAsExecuteScalingPolicy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAsExecuteScalingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsExecuteScalingPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_execute_scaling_policy.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -140,8 +146,8 @@ func resourceTencentCloudAsExecuteScalingPolicyCreate(d *schema.ResourceData, me
 		request.TriggerSource = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ExecuteScalingPolicy(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ExecuteScalingPolicyWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -152,23 +158,23 @@ func resourceTencentCloudAsExecuteScalingPolicyCreate(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate as executeScalingPolicy failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	activityId = *response.Response.ActivityId
 	d.SetId(activityId)
 
-	return resourceTencentCloudAsExecuteScalingPolicyRead(d, meta)
+	return resourceTencentCloudAsExecuteScalingPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAsExecuteScalingPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsExecuteScalingPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_execute_scaling_policy.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudAsExecuteScalingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsExecuteScalingPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_execute_scaling_policy.delete")()
 	defer inconsistentCheck(d, meta)()
 
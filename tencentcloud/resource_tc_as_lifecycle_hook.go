@@ -85,14 +85,21 @@ resource "tencentcloud_as_lifecycle_hook" "example" {
 }
 ```
 
+Import
+
+AutoScaling lifecycle hook can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_as_lifecycle_hook.example ash-2xsp8h5y
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -101,10 +108,13 @@ import (
 
 func resourceTencentCloudAsLifecycleHook() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsLifecycleHookCreate,
-		Read:   resourceTencentCloudAsLifecycleHookRead,
-		Update: resourceTencentCloudAsLifecycleHookUpdate,
-		Delete: resourceTencentCloudAsLifecycleHookDelete,
+		CreateContext: resourceTencentCloudAsLifecycleHookCreate,
+		ReadContext:   resourceTencentCloudAsLifecycleHookRead,
+		UpdateContext: resourceTencentCloudAsLifecycleHookUpdate,
+		DeleteContext: resourceTencentCloudAsLifecycleHookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
@@ -164,7 +174,7 @@ func resourceTencentCloudAsLifecycleHook() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAsLifecycleHookCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsLifecycleHookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_lifecycle_hook.create")()
 
 	logId := getLogId(contextNil)
@@ -191,46 +201,46 @@ func resourceTencentCloudAsLifecycleHookCreate(d *schema.ResourceData, meta inte
 			if vv, ok := d.GetOk("notification_queue_name"); ok {
 				request.NotificationTarget.QueueName = helper.String(vv.(string))
 			} else {
-				return fmt.Errorf("queue_name must not be null when target_type is CMQ_QUEUE")
+				return diag.Errorf("queue_name must not be null when target_type is CMQ_QUEUE")
 			}
 		} else if v.(string) == "CMQ_TOPIC" {
 			if vv, ok := d.GetOk("notification_topic_name"); ok {
 				request.NotificationTarget.TopicName = helper.String(vv.(string))
 			} else {
-				return fmt.Errorf("topic_name must ot be null when target_type is CMQ_TOPIC")
+				return diag.Errorf("topic_name must ot be null when target_type is CMQ_TOPIC")
 			}
 		}
 	}
 
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().CreateLifecycleHook(request)
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().CreateLifecycleHookWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
 	if response.Response.LifecycleHookId == nil {
-		return fmt.Errorf("lifecycle hook id is nil")
+		return diag.Errorf("lifecycle hook id is nil")
 	}
 	d.SetId(*response.Response.LifecycleHookId)
 
-	return resourceTencentCloudAsLifecycleHookRead(d, meta)
+	return resourceTencentCloudAsLifecycleHookRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAsLifecycleHookRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsLifecycleHookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_lifecycle_hook.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	lifecycleHookId := d.Id()
 	asService := AsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		lifecycleHook, has, e := asService.DescribeLifecycleHookById(ctx, lifecycleHookId)
 		if e != nil {
 			return retryError(e)
@@ -263,12 +273,12 @@ func resourceTencentCloudAsLifecycleHookRead(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudAsLifecycleHookUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsLifecycleHookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_lifecycle_hook.update")()
 
 	logId := getLogId(contextNil)
@@ -295,22 +305,22 @@ func resourceTencentCloudAsLifecycleHookUpdate(d *schema.ResourceData, meta inte
 			if vv, ok := d.GetOk("notification_queue_name"); ok {
 				request.NotificationTarget.QueueName = helper.String(vv.(string))
 			} else {
-				return fmt.Errorf("queue_name must not be null when target_type is CMQ_QUEUE")
+				return diag.Errorf("queue_name must not be null when target_type is CMQ_QUEUE")
 			}
 		} else if v.(string) == "CMQ_TOPIC" {
 			if vv, ok := d.GetOk("notification_topic_name"); ok {
 				request.NotificationTarget.TopicName = helper.String(vv.(string))
 			} else {
-				return fmt.Errorf("topic_name must ot be null when target_type is CMQ_TOPIC")
+				return diag.Errorf("topic_name must ot be null when target_type is CMQ_TOPIC")
 			}
 		}
 	}
 
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().UpgradeLifecycleHook(request)
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().UpgradeLifecycleHookWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
@@ -318,11 +328,11 @@ func resourceTencentCloudAsLifecycleHookUpdate(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudAsLifecycleHookDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsLifecycleHookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_lifecycle_hook.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	lifecycleHookId := d.Id()
 	asService := AsService{
@@ -330,7 +340,7 @@ func resourceTencentCloudAsLifecycleHookDelete(d *schema.ResourceData, meta inte
 	}
 	err := asService.DeleteLifecycleHook(ctx, lifecycleHookId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -111,9 +111,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -122,12 +123,16 @@ import (
 
 func resourceTencentCloudAsLoadBalancer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsLoadBalancerCreate,
-		Read:   resourceTencentCloudAsLoadBalancerRead,
-		Update: resourceTencentCloudAsLoadBalancerUpdate,
-		Delete: resourceTencentCloudAsLoadBalancerDelete,
+		CreateContext: resourceTencentCloudAsLoadBalancerCreate,
+		ReadContext:   resourceTencentCloudAsLoadBalancerRead,
+		UpdateContext: resourceTencentCloudAsLoadBalancerUpdate,
+		DeleteContext: resourceTencentCloudAsLoadBalancerDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"auto_scaling_group_id": {
@@ -197,7 +202,7 @@ func resourceTencentCloudAsLoadBalancer() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAsLoadBalancerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsLoadBalancerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_load_balancer.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -253,8 +258,8 @@ func resourceTencentCloudAsLoadBalancerCreate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().AttachLoadBalancers(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().AttachLoadBalancersWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -264,21 +269,21 @@ func resourceTencentCloudAsLoadBalancerCreate(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create as loadBalancer failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(autoScalingGroupId)
 
-	return resourceTencentCloudAsLoadBalancerRead(d, meta)
+	return resourceTencentCloudAsLoadBalancerRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAsLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsLoadBalancerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_load_balancer.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := AsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -286,7 +291,7 @@ func resourceTencentCloudAsLoadBalancerRead(d *schema.ResourceData, meta interfa
 
 	loadBalancer, err := service.DescribeAsLoadBalancerById(ctx, autoScalingGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if loadBalancer == nil {
@@ -353,7 +358,7 @@ func resourceTencentCloudAsLoadBalancerRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudAsLoadBalancerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_load_balancer.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -369,7 +374,7 @@ func resourceTencentCloudAsLoadBalancerUpdate(d *schema.ResourceData, meta inter
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -408,8 +413,8 @@ func resourceTencentCloudAsLoadBalancerUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ModifyLoadBalancerTargetAttributes(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ModifyLoadBalancerTargetAttributesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -419,24 +424,24 @@ func resourceTencentCloudAsLoadBalancerUpdate(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update as loadBalancer failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudAsLoadBalancerRead(d, meta)
+	return resourceTencentCloudAsLoadBalancerRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAsLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsLoadBalancerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_load_balancer.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := AsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	autoScalingGroupId := d.Id()
 
 	if err := service.DeleteAsLoadBalancerById(ctx, autoScalingGroupId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
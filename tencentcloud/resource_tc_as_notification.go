@@ -56,14 +56,22 @@ resource "tencentcloud_as_notification" "as_notification" {
   notification_user_group_ids = [tencentcloud_cam_group.example.id]
 }
 ```
+
+Import
+
+AutoScaling notification can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_as_notification.as_notification asn-2sestqbr
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -72,10 +80,13 @@ import (
 
 func resourceTencentCloudAsNotification() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsNotificationCreate,
-		Read:   resourceTencentCloudAsNotificationRead,
-		Update: resourceTencentCloudAsNotificationUpdate,
-		Delete: resourceTencentCloudAsNotificationDelete,
+		CreateContext: resourceTencentCloudAsNotificationCreate,
+		ReadContext:   resourceTencentCloudAsNotificationRead,
+		UpdateContext: resourceTencentCloudAsNotificationUpdate,
+		DeleteContext: resourceTencentCloudAsNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
@@ -105,7 +116,7 @@ func resourceTencentCloudAsNotification() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAsNotificationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsNotificationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_notification.create")()
 
 	logId := getLogId(contextNil)
@@ -123,34 +134,34 @@ func resourceTencentCloudAsNotificationCreate(d *schema.ResourceData, meta inter
 		request.NotificationUserGroupIds = append(request.NotificationUserGroupIds, helper.String(value.(string)))
 	}
 
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().CreateNotificationConfiguration(request)
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().CreateNotificationConfigurationWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
 	if response.Response.AutoScalingNotificationId == nil {
-		return fmt.Errorf("scaling policy id is nil")
+		return diag.Errorf("scaling policy id is nil")
 	}
 	d.SetId(*response.Response.AutoScalingNotificationId)
 
-	return resourceTencentCloudAsNotificationRead(d, meta)
+	return resourceTencentCloudAsNotificationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAsNotificationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsNotificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_notification.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	notificationId := d.Id()
 	asService := AsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		notification, has, e := asService.DescribeNotificationById(ctx, notificationId)
 		if e != nil {
 			return retryError(e)
@@ -165,12 +176,12 @@ func resourceTencentCloudAsNotificationRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudAsNotificationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsNotificationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_notification.update")()
 
 	logId := getLogId(contextNil)
@@ -193,11 +204,11 @@ func resourceTencentCloudAsNotificationUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ModifyNotificationConfiguration(request)
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ModifyNotificationConfigurationWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
@@ -205,11 +216,11 @@ func resourceTencentCloudAsNotificationUpdate(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudAsNotificationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsNotificationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_notification.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	notificationId := d.Id()
 	asService := AsService{
@@ -217,7 +228,7 @@ func resourceTencentCloudAsNotificationDelete(d *schema.ResourceData, meta inter
 	}
 	err := asService.DeleteNotification(ctx, notificationId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -99,8 +99,11 @@ resource "tencentcloud_as_protect_instances" "protect" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -109,9 +112,12 @@ import (
 
 func resourceTencentCloudAsProtectInstances() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsProtectInstancesCreate,
-		Read:   resourceTencentCloudAsProtectInstancesRead,
-		Delete: resourceTencentCloudAsProtectInstancesDelete,
+		CreateContext: resourceTencentCloudAsProtectInstancesCreate,
+		ReadContext:   resourceTencentCloudAsProtectInstancesRead,
+		DeleteContext: resourceTencentCloudAsProtectInstancesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"auto_scaling_group_id": {
 				Required:    true,
@@ -140,7 +146,7 @@ func resourceTencentCloudAsProtectInstances() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAsProtectInstancesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsProtectInstancesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_as_protect_instances.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -166,8 +172,8 @@ func resourceTencentCloudAsProtectInstancesCreate(d *schema.ResourceData, meta i
 		request.ProtectedFromScaleIn = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().SetInstancesProtection(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().SetInstancesProtectionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -183,17 +189,17 @@ func resourceTencentCloudAsProtectInstancesCreate(d *schema.ResourceData, meta i
 	// 需要 setId，可以通过InstancesId作为ID
 	d.SetId(helper.DataResourceIdsHash(ids))
 
-	return resourceTencentCloudAsProtectInstancesRead(d, meta)
+	return resourceTencentCloudAsProtectInstancesRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAsProtectInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsProtectInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_protect_instances.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudAsProtectInstancesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsProtectInstancesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_protect_instances.delete")()
 	defer inconsistentCheck(d, meta)()
 
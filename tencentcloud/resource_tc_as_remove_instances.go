@@ -87,8 +87,11 @@ resource "tencentcloud_as_remove_instances" "remove" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -97,9 +100,12 @@ import (
 
 func resourceTencentCloudAsRemoveInstances() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsRemoveInstancesCreate,
-		Read:   resourceTencentCloudAsRemoveInstancesRead,
-		Delete: resourceTencentCloudAsRemoveInstancesDelete,
+		CreateContext: resourceTencentCloudAsRemoveInstancesCreate,
+		ReadContext:   resourceTencentCloudAsRemoveInstancesRead,
+		DeleteContext: resourceTencentCloudAsRemoveInstancesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"auto_scaling_group_id": {
 				Required:    true,
@@ -121,7 +127,7 @@ func resourceTencentCloudAsRemoveInstances() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAsRemoveInstancesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsRemoveInstancesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_as_remove_instances.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -144,8 +150,8 @@ func resourceTencentCloudAsRemoveInstancesCreate(d *schema.ResourceData, meta in
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().RemoveInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().RemoveInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -162,17 +168,17 @@ func resourceTencentCloudAsRemoveInstancesCreate(d *schema.ResourceData, meta in
 	activityId = *response.Response.ActivityId
 	d.SetId(activityId)
 
-	return resourceTencentCloudAsRemoveInstancesRead(d, meta)
+	return resourceTencentCloudAsRemoveInstancesRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAsRemoveInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsRemoveInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_remove_instances.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudAsRemoveInstancesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsRemoveInstancesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_remove_instances.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -61,8 +61,11 @@ terraform import tencentcloud_as_scale_in_instances.scale_in_instances scale_in_
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -71,9 +74,12 @@ import (
 
 func resourceTencentCloudAsScaleInInstances() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsScaleInInstancesCreate,
-		Read:   resourceTencentCloudAsScaleInInstancesRead,
-		Delete: resourceTencentCloudAsScaleInInstancesDelete,
+		CreateContext: resourceTencentCloudAsScaleInInstancesCreate,
+		ReadContext:   resourceTencentCloudAsScaleInInstancesRead,
+		DeleteContext: resourceTencentCloudAsScaleInInstancesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"auto_scaling_group_id": {
 				Required:    true,
@@ -92,7 +98,7 @@ func resourceTencentCloudAsScaleInInstances() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAsScaleInInstancesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScaleInInstancesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scale_in_instances.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -111,8 +117,8 @@ func resourceTencentCloudAsScaleInInstancesCreate(d *schema.ResourceData, meta i
 		request.ScaleInNumber = helper.IntUint64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ScaleInInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ScaleInInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -123,23 +129,23 @@ func resourceTencentCloudAsScaleInInstancesCreate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate as scaleInInstances failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	activityId = *response.Response.ActivityId
 	d.SetId(activityId)
 
-	return resourceTencentCloudAsScaleInInstancesRead(d, meta)
+	return resourceTencentCloudAsScaleInInstancesRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAsScaleInInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScaleInInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scale_in_instances.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudAsScaleInInstancesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScaleInInstancesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scale_in_instances.delete")()
 	defer inconsistentCheck(d, meta)()
 
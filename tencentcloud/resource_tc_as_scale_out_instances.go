@@ -61,8 +61,11 @@ terraform import tencentcloud_as_scale_out_instances.scale_out_instances scale_o
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -71,11 +74,14 @@ import (
 
 func resourceTencentCloudAsScaleOutInstances() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsScaleOutInstancesCreate,
-		Read:   resourceTencentCloudAsScaleOutInstancesRead,
-		Delete: resourceTencentCloudAsScaleOutInstancesDelete,
+		CreateContext: resourceTencentCloudAsScaleOutInstancesCreate,
+		ReadContext:   resourceTencentCloudAsScaleOutInstancesRead,
+		DeleteContext: resourceTencentCloudAsScaleOutInstancesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"auto_scaling_group_id": {
@@ -95,7 +101,7 @@ func resourceTencentCloudAsScaleOutInstances() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAsScaleOutInstancesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScaleOutInstancesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scale_out_instances.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -114,8 +120,8 @@ func resourceTencentCloudAsScaleOutInstancesCreate(d *schema.ResourceData, meta
 		request.ScaleOutNumber = helper.IntUint64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ScaleOutInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ScaleOutInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -126,23 +132,23 @@ func resourceTencentCloudAsScaleOutInstancesCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate as scaleOutInstances failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	activityId = *response.Response.ActivityId
 	d.SetId(activityId)
 
-	return resourceTencentCloudAsScaleOutInstancesRead(d, meta)
+	return resourceTencentCloudAsScaleOutInstancesRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAsScaleOutInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScaleOutInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scale_out_instances.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudAsScaleOutInstancesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScaleOutInstancesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scale_out_instances.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -73,9 +73,9 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -84,12 +84,12 @@ import (
 
 func resourceTencentCloudAsScalingConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsScalingConfigCreate,
-		Read:   resourceTencentCloudAsScalingConfigRead,
-		Update: resourceTencentCloudAsScalingConfigUpdate,
-		Delete: resourceTencentCloudAsScalingConfigDelete,
+		CreateContext: resourceTencentCloudAsScalingConfigCreate,
+		ReadContext:   resourceTencentCloudAsScalingConfigRead,
+		UpdateContext: resourceTencentCloudAsScalingConfigUpdate,
+		DeleteContext: resourceTencentCloudAsScalingConfigDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -332,7 +332,7 @@ func resourceTencentCloudAsScalingConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAsScalingConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScalingConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scaling_config.create")()
 
 	logId := getLogId(contextNil)
@@ -529,35 +529,35 @@ func resourceTencentCloudAsScalingConfigCreate(d *schema.ResourceData, meta inte
 		request.InstanceNameSettings = settings[0]
 	}
 
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().CreateLaunchConfiguration(request)
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().CreateLaunchConfigurationWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
-		return err
+		return diag.FromErr(err)
 	} else {
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 	}
 	if response.Response.LaunchConfigurationId == nil {
-		return fmt.Errorf("Launch configuration id is nil")
+		return diag.Errorf("Launch configuration id is nil")
 	}
 	d.SetId(*response.Response.LaunchConfigurationId)
 
-	return resourceTencentCloudAsScalingConfigRead(d, meta)
+	return resourceTencentCloudAsScalingConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAsScalingConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScalingConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scaling_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	configurationId := d.Id()
 	asService := AsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		config, has, e := asService.DescribeLaunchConfigurationById(ctx, configurationId)
 		if e != nil {
 			return retryError(e)
@@ -635,12 +635,12 @@ func resourceTencentCloudAsScalingConfigRead(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudAsScalingConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScalingConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scaling_config.update")()
 
 	logId := getLogId(contextNil)
@@ -824,11 +824,11 @@ func resourceTencentCloudAsScalingConfigUpdate(d *schema.ResourceData, meta inte
 		request.InstanceNameSettings = settings[0]
 	}
 
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().UpgradeLaunchConfiguration(request)
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().UpgradeLaunchConfigurationWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
-		return err
+		return diag.FromErr(err)
 	} else {
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
@@ -852,11 +852,11 @@ func resourceTencentCloudAsScalingConfigUpdate(d *schema.ResourceData, meta inte
 		keepImageLogin := v.(bool)
 		loginSettingRequest.LoginSettings.KeepImageLogin = &keepImageLogin
 	}
-	loginSettingResponse, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ModifyLaunchConfigurationAttributes(loginSettingRequest)
+	loginSettingResponse, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ModifyLaunchConfigurationAttributesWithContext(ctx, loginSettingRequest)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, loginSettingRequest.GetAction(), loginSettingRequest.ToJsonString(), err.Error())
-		return err
+		return diag.FromErr(err)
 	} else {
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, loginSettingRequest.GetAction(), loginSettingRequest.ToJsonString(), loginSettingResponse.ToJsonString())
@@ -865,11 +865,11 @@ func resourceTencentCloudAsScalingConfigUpdate(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudAsScalingConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScalingConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scaling_config.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	configurationId := d.Id()
 	asService := AsService{
@@ -877,7 +877,7 @@ func resourceTencentCloudAsScalingConfigDelete(d *schema.ResourceData, meta inte
 	}
 	err := asService.DeleteLaunchConfiguration(ctx, configurationId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -119,7 +119,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -130,12 +132,17 @@ import (
 
 func resourceTencentCloudAsScalingGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsScalingGroupCreate,
-		Read:   resourceTencentCloudAsScalingGroupRead,
-		Update: resourceTencentCloudAsScalingGroupUpdate,
-		Delete: resourceTencentCloudAsScalingGroupDelete,
+		CreateContext: resourceTencentCloudAsScalingGroupCreate,
+		ReadContext:   resourceTencentCloudAsScalingGroupRead,
+		UpdateContext: resourceTencentCloudAsScalingGroupUpdate,
+		DeleteContext: resourceTencentCloudAsScalingGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -318,11 +325,11 @@ func resourceTencentCloudAsScalingGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAsScalingGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScalingGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scaling_group.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	request := as.NewCreateAutoScalingGroupRequest()
 
 	request.AutoScalingGroupName = helper.String(d.Get("scaling_group_name").(string))
@@ -434,10 +441,12 @@ func resourceTencentCloudAsScalingGroupCreate(d *schema.ResourceData, meta inter
 	}
 
 	var id string
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().CreateAutoScalingGroup(request)
+		response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().CreateAutoScalingGroupWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -456,7 +465,7 @@ func resourceTencentCloudAsScalingGroupCreate(d *schema.ResourceData, meta inter
 
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(id)
 
@@ -464,7 +473,7 @@ func resourceTencentCloudAsScalingGroupCreate(d *schema.ResourceData, meta inter
 	asService := AsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		scalingGroup, _, errRet := asService.DescribeAutoScalingGroupById(ctx, id)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -475,18 +484,18 @@ func resourceTencentCloudAsScalingGroupCreate(d *schema.ResourceData, meta inter
 		return resource.RetryableError(fmt.Errorf("scaling group status is %s, retry...", *scalingGroup.InActivityStatus))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudAsScalingGroupRead(d, meta)
+	return resourceTencentCloudAsScalingGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAsScalingGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScalingGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scaling_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	scalingGroupId := d.Id()
 	asService := AsService{
@@ -498,7 +507,7 @@ func resourceTencentCloudAsScalingGroupRead(d *schema.ResourceData, meta interfa
 		e            error
 		has          int
 	)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		scalingGroup, has, e = asService.DescribeAutoScalingGroupById(ctx, scalingGroupId)
 		if e != nil {
 			return retryError(e)
@@ -506,7 +515,7 @@ func resourceTencentCloudAsScalingGroupRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if has == 0 {
 		d.SetId("")
@@ -571,7 +580,7 @@ func resourceTencentCloudAsScalingGroupRead(d *schema.ResourceData, meta interfa
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "as", "auto-scaling-group", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("tags", tags)
@@ -579,11 +588,11 @@ func resourceTencentCloudAsScalingGroupRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScalingGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scaling_group.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := meta.(*TencentCloudClient).apiV3Conn
 	tagService := TagService{client: client}
@@ -679,10 +688,12 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.UseAsClient().ModifyAutoScalingGroup(request)
+		response, err := client.UseAsClient().ModifyAutoScalingGroupWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -694,7 +705,7 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateAttrs = updateAttrs[:0]
@@ -740,10 +751,12 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 	}
 
 	if len(updateAttrs) > 0 {
-		if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(balancerRequest.GetAction())
+		if err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, balancerRequest.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			balancerResponse, err := client.UseAsClient().ModifyLoadBalancers(balancerRequest)
+			balancerResponse, err := client.UseAsClient().ModifyLoadBalancersWithContext(ctx, balancerRequest)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, balancerRequest.GetAction(), balancerRequest.ToJsonString(), err.Error())
@@ -755,7 +768,7 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -766,20 +779,20 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 		resourceName := BuildTagResourceName("as", "auto-scaling-group", region, d.Id())
 		err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudAsScalingGroupRead(d, meta)
+	return resourceTencentCloudAsScalingGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAsScalingGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScalingGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scaling_group.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	scalingGroupId := d.Id()
 	asService := AsService{
@@ -790,24 +803,24 @@ func resourceTencentCloudAsScalingGroupDelete(d *schema.ResourceData, meta inter
 	// If so, we need to remove those first.
 	scalingGroup, has, err := asService.DescribeAutoScalingGroupById(ctx, scalingGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if has == 0 {
 		return nil
 	}
 	if *scalingGroup.InstanceCount > 0 || *scalingGroup.DesiredCapacity > 0 {
-		if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		if err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			inErr := asService.ClearScalingGroupInstance(ctx, scalingGroupId)
 			if inErr != nil {
 				return retryError(inErr)
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if errRet := asService.DeleteScalingGroup(ctx, scalingGroupId); errRet != nil {
 			if sdkErr, ok := errRet.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkErr.Code == AsScalingGroupNotFound {
@@ -821,7 +834,7 @@ func resourceTencentCloudAsScalingGroupDelete(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
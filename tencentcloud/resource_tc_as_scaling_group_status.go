@@ -73,7 +73,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -81,12 +83,15 @@ import (
 
 func resourceTencentCloudAsScalingGroupStatus() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsScalingGroupStatusCreate,
-		Read:   resourceTencentCloudAsScalingGroupStatusRead,
-		Update: resourceTencentCloudAsScalingGroupStatusUpdate,
-		Delete: resourceTencentCloudAsScalingGroupStatusDelete,
+		CreateContext: resourceTencentCloudAsScalingGroupStatusCreate,
+		ReadContext:   resourceTencentCloudAsScalingGroupStatusRead,
+		UpdateContext: resourceTencentCloudAsScalingGroupStatusUpdate,
+		DeleteContext: resourceTencentCloudAsScalingGroupStatusDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"auto_scaling_group_id": {
@@ -105,7 +110,7 @@ func resourceTencentCloudAsScalingGroupStatus() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAsScalingGroupStatusCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScalingGroupStatusCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scaling_group_status.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -113,16 +118,16 @@ func resourceTencentCloudAsScalingGroupStatusCreate(d *schema.ResourceData, meta
 
 	d.SetId(autoScalingGroupId)
 
-	return resourceTencentCloudAsScalingGroupStatusUpdate(d, meta)
+	return resourceTencentCloudAsScalingGroupStatusUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudAsScalingGroupStatusRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScalingGroupStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scaling_group_status.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := AsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -130,7 +135,7 @@ func resourceTencentCloudAsScalingGroupStatusRead(d *schema.ResourceData, meta i
 
 	scalingGroup, has, err := service.DescribeAutoScalingGroupById(ctx, autoScalingGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if has == 0 {
@@ -154,7 +159,7 @@ func resourceTencentCloudAsScalingGroupStatusRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudAsScalingGroupStatusUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScalingGroupStatusUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scaling_group_status.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -174,8 +179,8 @@ func resourceTencentCloudAsScalingGroupStatusUpdate(d *schema.ResourceData, meta
 
 	if enable {
 		enableAsRequest.AutoScalingGroupId = &autoScalingGroupId
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().EnableAutoScalingGroup(enableAsRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().EnableAutoScalingGroupWithContext(ctx, enableAsRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -185,12 +190,12 @@ func resourceTencentCloudAsScalingGroupStatusUpdate(d *schema.ResourceData, meta
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s enable vpc snapshotPolicyConfig failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		disableAsRequest.AutoScalingGroupId = &autoScalingGroupId
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().DisableAutoScalingGroup(disableAsRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().DisableAutoScalingGroupWithContext(ctx, disableAsRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -200,14 +205,14 @@ func resourceTencentCloudAsScalingGroupStatusUpdate(d *schema.ResourceData, meta
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s disable vpc snapshotPolicyConfig failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudAsScalingGroupStatusRead(d, meta)
+	return resourceTencentCloudAsScalingGroupStatusRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAsScalingGroupStatusDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScalingGroupStatusDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scaling_group_status.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -57,14 +57,22 @@ resource "tencentcloud_as_scaling_policy" "example" {
   cooldown            = 360
 }
 ```
+
+Import
+
+AutoScaling scaling policy can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_as_scaling_policy.example asp-519acdug
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -73,10 +81,13 @@ import (
 
 func resourceTencentCloudAsScalingPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsScalingPolicyCreate,
-		Read:   resourceTencentCloudAsScalingPolicyRead,
-		Update: resourceTencentCloudAsScalingPolicyUpdate,
-		Delete: resourceTencentCloudAsScalingPolicyDelete,
+		CreateContext: resourceTencentCloudAsScalingPolicyCreate,
+		ReadContext:   resourceTencentCloudAsScalingPolicyRead,
+		UpdateContext: resourceTencentCloudAsScalingPolicyUpdate,
+		DeleteContext: resourceTencentCloudAsScalingPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
@@ -153,7 +164,7 @@ func resourceTencentCloudAsScalingPolicy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAsScalingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScalingPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scaling_policy.create")()
 
 	logId := getLogId(contextNil)
@@ -185,35 +196,35 @@ func resourceTencentCloudAsScalingPolicyCreate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().CreateScalingPolicy(request)
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().CreateScalingPolicyWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
 	if response.Response.AutoScalingPolicyId == nil {
-		return fmt.Errorf("scaling policy id is nil")
+		return diag.Errorf("scaling policy id is nil")
 	}
 	d.SetId(*response.Response.AutoScalingPolicyId)
 
-	return resourceTencentCloudAsScalingPolicyRead(d, meta)
+	return resourceTencentCloudAsScalingPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAsScalingPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScalingPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scaling_policy.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	scalingPolicyId := d.Id()
 	asService := AsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		scalingPolicy, has, e := asService.DescribeScalingPolicyById(ctx, scalingPolicyId)
 		if e != nil {
 			return retryError(e)
@@ -239,11 +250,11 @@ func resourceTencentCloudAsScalingPolicyRead(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
-func resourceTencentCloudAsScalingPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScalingPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scaling_policy.update")()
 
 	logId := getLogId(contextNil)
@@ -282,11 +293,11 @@ func resourceTencentCloudAsScalingPolicyUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ModifyScalingPolicy(request)
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ModifyScalingPolicyWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
@@ -294,11 +305,11 @@ func resourceTencentCloudAsScalingPolicyUpdate(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudAsScalingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScalingPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_scaling_policy.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	scalingPolicyId := d.Id()
 	asService := AsService{
@@ -306,7 +317,7 @@ func resourceTencentCloudAsScalingPolicyDelete(d *schema.ResourceData, meta inte
 	}
 	err := asService.DeleteScalingPolicy(ctx, scalingPolicyId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
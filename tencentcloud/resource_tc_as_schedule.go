@@ -54,14 +54,22 @@ resource "tencentcloud_as_schedule" "example" {
   recurrence           = "0 0 * * *"
 }
 ```
+
+Import
+
+AutoScaling schedule can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_as_schedule.example asst-bu2yx23e
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -70,10 +78,13 @@ import (
 
 func resourceTencentCloudAsSchedule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsScheduleCreate,
-		Read:   resourceTencentCloudAsScheduleRead,
-		Update: resourceTencentCloudAsScheduleUpdate,
-		Delete: resourceTencentCloudAsScheduleDelete,
+		CreateContext: resourceTencentCloudAsScheduleCreate,
+		ReadContext:   resourceTencentCloudAsScheduleRead,
+		UpdateContext: resourceTencentCloudAsScheduleUpdate,
+		DeleteContext: resourceTencentCloudAsScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
@@ -124,7 +135,7 @@ func resourceTencentCloudAsSchedule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAsScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_schedule.create")()
 
 	logId := getLogId(contextNil)
@@ -143,43 +154,43 @@ func resourceTencentCloudAsScheduleCreate(d *schema.ResourceData, meta interface
 		if vv, ok := d.GetOk("recurrence"); ok {
 			request.Recurrence = helper.String(vv.(string))
 		} else {
-			return fmt.Errorf("end_time and recurrence must be specified at the same time.")
+			return diag.Errorf("end_time and recurrence must be specified at the same time.")
 		}
 	} else {
 		if _, ok := d.GetOk("recurrence"); ok {
-			return fmt.Errorf("end_time and recurrence must be specified at the same time.")
+			return diag.Errorf("end_time and recurrence must be specified at the same time.")
 		}
 	}
 
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().CreateScheduledAction(request)
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().CreateScheduledActionWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
 	if response.Response.ScheduledActionId == nil {
-		return fmt.Errorf("schedule action id is nil")
+		return diag.Errorf("schedule action id is nil")
 	}
 	d.SetId(*response.Response.ScheduledActionId)
 
-	return resourceTencentCloudAsScheduleRead(d, meta)
+	return resourceTencentCloudAsScheduleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAsScheduleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_schedule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	scheduledActionId := d.Id()
 	asService := AsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		scheduledAction, has, e := asService.DescribeScheduledActionById(ctx, scheduledActionId)
 		if e != nil {
 			return retryError(e)
@@ -205,12 +216,12 @@ func resourceTencentCloudAsScheduleRead(d *schema.ResourceData, meta interface{}
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudAsScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_schedule.update")()
 
 	logId := getLogId(contextNil)
@@ -242,11 +253,11 @@ func resourceTencentCloudAsScheduleUpdate(d *schema.ResourceData, meta interface
 		request.EndTime = helper.String(d.Get("end_time").(string))
 	}
 
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ModifyScheduledAction(request)
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().ModifyScheduledActionWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
@@ -254,11 +265,11 @@ func resourceTencentCloudAsScheduleUpdate(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudAsScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_schedule.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	scheduledActionId := d.Id()
 	asService := AsService{
@@ -266,7 +277,7 @@ func resourceTencentCloudAsScheduleDelete(d *schema.ResourceData, meta interface
 	}
 	err := asService.DeleteScheduledAction(ctx, scheduledActionId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -91,7 +91,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -100,9 +102,12 @@ import (
 
 func resourceTencentCloudAsStartInstances() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsStartInstancesCreate,
-		Read:   resourceTencentCloudAsStartInstancesRead,
-		Delete: resourceTencentCloudAsStartInstancesDelete,
+		CreateContext: resourceTencentCloudAsStartInstancesCreate,
+		ReadContext:   resourceTencentCloudAsStartInstancesRead,
+		DeleteContext: resourceTencentCloudAsStartInstancesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(12 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"auto_scaling_group_id": {
 				Required:    true,
@@ -124,7 +129,7 @@ func resourceTencentCloudAsStartInstances() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAsStartInstancesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsStartInstancesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_as_start_instances.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -147,8 +152,8 @@ func resourceTencentCloudAsStartInstancesCreate(d *schema.ResourceData, meta int
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().StartAutoScalingInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().StartAutoScalingInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -164,12 +169,12 @@ func resourceTencentCloudAsStartInstancesCreate(d *schema.ResourceData, meta int
 
 	activityId = *response.Response.ActivityId
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := AsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	err = resource.Retry(4*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		status, err := service.DescribeActivityById(ctx, activityId)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -183,22 +188,22 @@ func resourceTencentCloudAsStartInstancesCreate(d *schema.ResourceData, meta int
 		return resource.NonRetryableError(fmt.Errorf("remove status is failed(%s)", status))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(activityId)
 
-	return resourceTencentCloudAsStartInstancesRead(d, meta)
+	return resourceTencentCloudAsStartInstancesRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAsStartInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsStartInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_start_instances.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudAsStartInstancesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsStartInstancesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_start_instances.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -91,7 +91,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -100,9 +102,12 @@ import (
 
 func resourceTencentCloudAsStopInstances() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsStopInstancesCreate,
-		Read:   resourceTencentCloudAsStopInstancesRead,
-		Delete: resourceTencentCloudAsStopInstancesDelete,
+		CreateContext: resourceTencentCloudAsStopInstancesCreate,
+		ReadContext:   resourceTencentCloudAsStopInstancesRead,
+		DeleteContext: resourceTencentCloudAsStopInstancesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(12 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"auto_scaling_group_id": {
 				Required:    true,
@@ -131,7 +136,7 @@ func resourceTencentCloudAsStopInstances() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAsStopInstancesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsStopInstancesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_as_stop_instances.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -158,8 +163,8 @@ func resourceTencentCloudAsStopInstancesCreate(d *schema.ResourceData, meta inte
 		request.StoppedMode = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().StopAutoScalingInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().StopAutoScalingInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -175,12 +180,12 @@ func resourceTencentCloudAsStopInstancesCreate(d *schema.ResourceData, meta inte
 
 	activityId = *response.Response.ActivityId
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := AsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	err = resource.Retry(4*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		status, err := service.DescribeActivityById(ctx, activityId)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -194,22 +199,22 @@ func resourceTencentCloudAsStopInstancesCreate(d *schema.ResourceData, meta inte
 		return resource.NonRetryableError(fmt.Errorf("remove status is failed(%s)", status))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(activityId)
 
-	return resourceTencentCloudAsStopInstancesRead(d, meta)
+	return resourceTencentCloudAsStopInstancesRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAsStopInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsStopInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_stop_instances.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudAsStopInstancesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAsStopInstancesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_as_stop_instances.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -27,9 +27,12 @@ $ terraform import tencentcloud_audit.foo audit-test
 package tencentcloud
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	audit "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cloudaudit/v20190319"
@@ -40,12 +43,17 @@ import (
 func resourceTencentCloudAudit() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource has been deprecated in Terraform TencentCloud provider version 1.78.16. Please use 'tencentcloud_audit_track' instead.",
-		Create:             resourceTencentCloudAuditCreate,
-		Read:               resourceTencentCloudAuditRead,
-		Update:             resourceTencentCloudAuditUpdate,
-		Delete:             resourceTencentCloudAuditDelete,
+		CreateContext:      resourceTencentCloudAuditCreate,
+		ReadContext:        resourceTencentCloudAuditRead,
+		UpdateContext:      resourceTencentCloudAuditUpdate,
+		DeleteContext:      resourceTencentCloudAuditDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -96,7 +104,7 @@ func resourceTencentCloudAudit() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAuditCreate(d *schema.ResourceData, meta interface{}) (errRet error) {
+func resourceTencentCloudAuditCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_audit.create")()
 	request := audit.NewCreateAuditRequest()
 
@@ -112,13 +120,13 @@ func resourceTencentCloudAuditCreate(d *schema.ResourceData, meta interface{}) (
 	request.IsEnableKmsEncry = helper.BoolToInt64Ptr(isEnableKmsEncry)
 	if isEnableKmsEncry {
 		if keyId == "" {
-			return fmt.Errorf("`key_id` must be set with valid value when `enable_kms_encry` is true")
+			return diag.Errorf("`key_id` must be set with valid value when `enable_kms_encry` is true")
 		}
 		request.KmsRegion = &cosRegion
 		request.KeyId = &keyId
 	} else {
 		if keyId != "" {
-			return fmt.Errorf("`key_id` can not be set when `enable_kms_encry` is false")
+			return diag.Errorf("`key_id` can not be set when `enable_kms_encry` is false")
 		}
 	}
 
@@ -134,9 +142,11 @@ func resourceTencentCloudAuditCreate(d *schema.ResourceData, meta interface{}) (
 	request.ReadWriteAttribute = helper.IntInt64(readWriteAttribute)
 	request.LogFilePrefix = &logFilePrefix
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().CreateAudit(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().CreateAuditWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -154,16 +164,15 @@ func resourceTencentCloudAuditCreate(d *schema.ResourceData, meta interface{}) (
 
 	auditSwitch := d.Get("audit_switch").(bool)
 
-	err = modifyAuditSwitch(name, auditSwitch, meta)
+	err = modifyAuditSwitch(ctx, name, auditSwitch, meta)
 	if err != nil {
-		errRet = err
-		return
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudAuditRead(d, meta)
+	return resourceTencentCloudAuditRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAuditRead(d *schema.ResourceData, meta interface{}) (errRet error) {
+func resourceTencentCloudAuditRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_audit.read")()
 	defer inconsistentCheck(d, meta)()
 	logId := getLogId(contextNil)
@@ -173,11 +182,15 @@ func resourceTencentCloudAuditRead(d *schema.ResourceData, meta interface{}) (er
 
 	request.AuditName = &auditId
 
-	ratelimit.Check(request.GetAction())
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return diag.FromErr(err)
+	}
 	var response *audit.DescribeAuditResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().DescribeAudit(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().DescribeAuditWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s %s fail, reason:%s\n", logId, request.GetAction(), e.Error())
 			return retryError(e)
@@ -186,8 +199,7 @@ func resourceTencentCloudAuditRead(d *schema.ResourceData, meta interface{}) (er
 		return nil
 	})
 	if err != nil {
-		errRet = err
-		return
+		return diag.FromErr(err)
 	}
 
 	if response == nil || response.Response == nil || response.Response.AuditName == nil {
@@ -209,16 +221,16 @@ func resourceTencentCloudAuditRead(d *schema.ResourceData, meta interface{}) (er
 	return nil
 }
 
-func resourceTencentCloudAuditUpdate(d *schema.ResourceData, meta interface{}) (errRet error) {
+func resourceTencentCloudAuditUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	defer logElapsed("resource.tencentcloud_audit.update")()
 
 	logId := getLogId(contextNil)
 	request := audit.NewUpdateAuditRequest()
 
 	defer func() {
-		if errRet != nil {
+		if diags.HasError() {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), request.ToJsonString(), diagnosticsError(diags).Error())
 		}
 	}()
 
@@ -244,13 +256,13 @@ func resourceTencentCloudAuditUpdate(d *schema.ResourceData, meta interface{}) (
 		request.IsEnableKmsEncry = helper.BoolToInt64Ptr(isEnableKmsEncry)
 		if isEnableKmsEncry {
 			if keyId == "" {
-				return fmt.Errorf("`key_id` must be set with valid value when `enable_kms_encry` is true")
+				return diag.Errorf("`key_id` must be set with valid value when `enable_kms_encry` is true")
 			}
 			request.KmsRegion = &cosRegion
 			request.KeyId = &keyId
 		} else {
 			if keyId != "" {
-				return fmt.Errorf("`key_id` can not be set when `enable_kms_encry` is false")
+				return diag.Errorf("`key_id` can not be set when `enable_kms_encry` is false")
 			}
 		}
 		readWriteAttribute := d.Get("read_write_attribute").(int)
@@ -265,9 +277,11 @@ func resourceTencentCloudAuditUpdate(d *schema.ResourceData, meta interface{}) (
 		request.ReadWriteAttribute = helper.IntInt64(readWriteAttribute)
 		request.LogFilePrefix = &logFilePrefix
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			response, err := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().UpdateAudit(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			response, err := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().UpdateAuditWithContext(ctx, request)
 			if err != nil {
 				return retryError(err)
 			}
@@ -279,24 +293,23 @@ func resourceTencentCloudAuditUpdate(d *schema.ResourceData, meta interface{}) (
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s delete audit %s failed, reason:%s\n", logId, name, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if d.HasChange("audit_switch") {
 		auditSwitch := d.Get("audit_switch").(bool)
 
-		err := modifyAuditSwitch(d.Id(), auditSwitch, meta)
+		err := modifyAuditSwitch(ctx, d.Id(), auditSwitch, meta)
 		if err != nil {
-			errRet = err
-			return
+			return diag.FromErr(err)
 		}
 	}
 	d.Partial(false)
 
-	return resourceTencentCloudAuditRead(d, meta)
+	return resourceTencentCloudAuditRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAuditDelete(d *schema.ResourceData, meta interface{}) (errRet error) {
+func resourceTencentCloudAuditDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_audit.delete")()
 
 	logId := getLogId(contextNil)
@@ -305,8 +318,8 @@ func resourceTencentCloudAuditDelete(d *schema.ResourceData, meta interface{}) (
 	auditId := d.Id()
 
 	request.AuditName = &auditId
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().DeleteAudit(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().DeleteAuditWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s reason[%s]\n", logId, e.Error())
 			return retryError(e)
@@ -315,18 +328,20 @@ func resourceTencentCloudAuditDelete(d *schema.ResourceData, meta interface{}) (
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete audit %s failed, reason:%s\n", logId, auditId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func modifyAuditSwitch(auditname string, auditSwitch bool, meta interface{}) (errRet error) {
+func modifyAuditSwitch(ctx context.Context, auditname string, auditSwitch bool, meta interface{}) (errRet error) {
 	if auditSwitch {
 		request := audit.NewStartLoggingRequest()
 		request.AuditName = &auditname
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			response, err := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().StartLogging(request)
+		err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			response, err := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().StartLoggingWithContext(ctx, request)
 			if err != nil {
 				return retryError(err)
 			}
@@ -342,9 +357,11 @@ func modifyAuditSwitch(auditname string, auditSwitch bool, meta interface{}) (er
 	} else {
 		request := audit.NewStopLoggingRequest()
 		request.AuditName = &auditname
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			response, err := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().StopLogging(request)
+		err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			response, err := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().StopLoggingWithContext(ctx, request)
 			if err != nil {
 				return retryError(err)
 			}
@@ -34,9 +34,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	audit "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cloudaudit/v20190319"
@@ -45,12 +46,16 @@ import (
 
 func resourceTencentCloudAuditTrack() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudAuditTrackRead,
-		Create: resourceTencentCloudAuditTrackCreate,
-		Update: resourceTencentCloudAuditTrackUpdate,
-		Delete: resourceTencentCloudAuditTrackDelete,
+		ReadContext:   resourceTencentCloudAuditTrackRead,
+		CreateContext: resourceTencentCloudAuditTrackCreate,
+		UpdateContext: resourceTencentCloudAuditTrackUpdate,
+		DeleteContext: resourceTencentCloudAuditTrackDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -132,7 +137,7 @@ func resourceTencentCloudAuditTrack() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudAuditTrackCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAuditTrackCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_audit_track.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -189,8 +194,8 @@ func resourceTencentCloudAuditTrackCreate(d *schema.ResourceData, meta interface
 		request.TrackForAllMembers = helper.IntUint64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().CreateAuditTrack(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().CreateAuditTrackWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -203,21 +208,21 @@ func resourceTencentCloudAuditTrackCreate(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create audit track failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	trackId = *response.Response.TrackId
 
 	d.SetId(helper.UInt64ToStr(trackId))
-	return resourceTencentCloudAuditTrackRead(d, meta)
+	return resourceTencentCloudAuditTrackRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAuditTrackRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAuditTrackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_audit_track.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := AuditService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -226,12 +231,12 @@ func resourceTencentCloudAuditTrackRead(d *schema.ResourceData, meta interface{}
 	track, err := service.DescribeAuditTrackById(ctx, trackId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if track == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", trackId)
+		return diag.Errorf("resource `track` %s does not exist", trackId)
 	}
 
 	if track.Name != nil {
@@ -282,7 +287,7 @@ func resourceTencentCloudAuditTrackRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudAuditTrackUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAuditTrackUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_audit_track.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -353,8 +358,8 @@ func resourceTencentCloudAuditTrackUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().ModifyAuditTrack(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().ModifyAuditTrackWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -366,25 +371,25 @@ func resourceTencentCloudAuditTrackUpdate(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create audit track failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudAuditTrackRead(d, meta)
+	return resourceTencentCloudAuditTrackRead(ctx, d, meta)
 }
 
-func resourceTencentCloudAuditTrackDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudAuditTrackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_audit_track.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := AuditService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	trackId := d.Id()
 
 	if err := service.DeleteAuditTrackById(ctx, trackId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -28,6 +28,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -37,12 +38,17 @@ import (
 
 func resourceTencentCloudCamGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamGroupCreate,
-		Read:   resourceTencentCloudCamGroupRead,
-		Update: resourceTencentCloudCamGroupUpdate,
-		Delete: resourceTencentCloudCamGroupDelete,
+		CreateContext: resourceTencentCloudCamGroupCreate,
+		ReadContext:   resourceTencentCloudCamGroupRead,
+		UpdateContext: resourceTencentCloudCamGroupUpdate,
+		DeleteContext: resourceTencentCloudCamGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -65,7 +71,7 @@ func resourceTencentCloudCamGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCamGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_group.create")()
 
 	logId := getLogId(contextNil)
@@ -77,8 +83,8 @@ func resourceTencentCloudCamGroupCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	var response *cam.CreateGroupResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().CreateGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().CreateGroupWithContext(ctx, request)
 		if e != nil {
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 				errCode := ee.GetCode()
@@ -99,21 +105,21 @@ func resourceTencentCloudCamGroupCreate(d *schema.ResourceData, meta interface{}
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create CAM group failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if response.Response.GroupId == nil {
-		return fmt.Errorf("CAM group id is nil")
+		return diag.Errorf("CAM group id is nil")
 	}
 	d.SetId(strconv.Itoa(int(*response.Response.GroupId)))
 
 	//get really instance then read
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	groupId := d.Id()
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, e := camService.DescribeGroupById(ctx, groupId)
 		if e != nil {
 			return retryError(e)
@@ -125,25 +131,25 @@ func resourceTencentCloudCamGroupCreate(d *schema.ResourceData, meta interface{}
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM group failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	time.Sleep(10 * time.Second)
-	return resourceTencentCloudCamGroupRead(d, meta)
+	return resourceTencentCloudCamGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	groupId := d.Id()
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var instance *cam.GetGroupResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := camService.DescribeGroupById(ctx, groupId)
 		if e != nil {
 			return retryError(e)
@@ -153,7 +159,7 @@ func resourceTencentCloudCamGroupRead(d *schema.ResourceData, meta interface{})
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM group failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil || instance.Response == nil || instance.Response.GroupId == nil {
@@ -169,7 +175,7 @@ func resourceTencentCloudCamGroupRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudCamGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_group.update")()
 
 	logId := getLogId(contextNil)
@@ -177,7 +183,7 @@ func resourceTencentCloudCamGroupUpdate(d *schema.ResourceData, meta interface{}
 	groupId := d.Id()
 	groupIdInt, e := strconv.Atoi(groupId)
 	if e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 	groupIdInt64 := uint64(groupIdInt)
 	request := cam.NewUpdateGroupRequest()
@@ -195,8 +201,8 @@ func resourceTencentCloudCamGroupUpdate(d *schema.ResourceData, meta interface{}
 	}
 
 	if changeFlag {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			response, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateGroup(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			response, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateGroupWithContext(ctx, request)
 
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -210,14 +216,14 @@ func resourceTencentCloudCamGroupUpdate(d *schema.ResourceData, meta interface{}
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update CAM group description failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCamGroupRead(d, meta)
+	return resourceTencentCloudCamGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_group.delete")()
 
 	logId := getLogId(contextNil)
@@ -225,13 +231,13 @@ func resourceTencentCloudCamGroupDelete(d *schema.ResourceData, meta interface{}
 	groupId := d.Id()
 	groupIdInt, e := strconv.Atoi(groupId)
 	if e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 	groupIdInt64 := uint64(groupIdInt)
 	request := cam.NewDeleteGroupRequest()
 	request.GroupId = &groupIdInt64
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DeleteGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DeleteGroupWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s reason[%s]\n", logId, e.Error())
 			return retryError(e)
@@ -240,7 +246,7 @@ func resourceTencentCloudCamGroupDelete(d *schema.ResourceData, meta interface{}
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete CAM group failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
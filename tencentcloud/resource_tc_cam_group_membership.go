@@ -50,6 +50,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -58,12 +59,15 @@ import (
 
 func resourceTencentCloudCamGroupMembership() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamGroupMembershipCreate,
-		Read:   resourceTencentCloudCamGroupMembershipRead,
-		Update: resourceTencentCloudCamGroupMembershipUpdate,
-		Delete: resourceTencentCloudCamGroupMembershipDelete,
+		CreateContext: resourceTencentCloudCamGroupMembershipCreate,
+		ReadContext:   resourceTencentCloudCamGroupMembershipRead,
+		UpdateContext: resourceTencentCloudCamGroupMembershipUpdate,
+		DeleteContext: resourceTencentCloudCamGroupMembershipDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -95,7 +99,7 @@ func resourceTencentCloudCamGroupMembership() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCamGroupMembershipCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_group_membership.create")()
 
 	logId := getLogId(contextNil)
@@ -103,23 +107,23 @@ func resourceTencentCloudCamGroupMembershipCreate(d *schema.ResourceData, meta i
 	groupId := d.Get("group_id").(string)
 	members, _, err := getUserIds(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	err = addUsersToGroup(members.List(), groupId, meta)
+	err = addUsersToGroup(ctx, members.List(), groupId, meta)
 	if err != nil {
 		log.Printf("[CRITAL]%s create CAM group membership failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(groupId)
 
 	//get really instance then read
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, e := camService.DescribeGroupMembershipById(ctx, groupId)
 		if e != nil {
 			return retryError(e)
@@ -131,25 +135,25 @@ func resourceTencentCloudCamGroupMembershipCreate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM group membership failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	time.Sleep(10 * time.Second)
-	return resourceTencentCloudCamGroupMembershipRead(d, meta)
+	return resourceTencentCloudCamGroupMembershipRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamGroupMembershipRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamGroupMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_group_membership.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	groupId := d.Id()
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var members []*string
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := camService.DescribeGroupMembershipById(ctx, groupId)
 		if e != nil {
 			return retryError(e)
@@ -159,7 +163,7 @@ func resourceTencentCloudCamGroupMembershipRead(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM role failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(members) == 0 {
@@ -198,47 +202,47 @@ func resourceTencentCloudCamGroupMembershipRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudCamGroupMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamGroupMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_group_membership.update")()
 
 	logId := getLogId(contextNil)
 
 	groupId := d.Id()
 
-	if err := processChange(d, groupId, logId, meta); err != nil {
-		return err
+	if err := processChange(ctx, d, groupId, logId, meta); err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCamGroupMembershipRead(d, meta)
+	return resourceTencentCloudCamGroupMembershipRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamGroupMembershipDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamGroupMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_group_membership.delete")()
 
 	logId := getLogId(contextNil)
 	groupId := d.Get("group_id").(string)
 	userIds, _, err := getUserIds(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	members := userIds.List()
-	err = removeUsersFromGroup(members, groupId, meta)
+	err = removeUsersFromGroup(ctx, members, groupId, meta)
 	if err != nil {
 		log.Printf("[CRITAL]%s delete CAM group failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func getUidFromName(name string, meta interface{}) (uid *uint64, errRet error) {
+func getUidFromName(ctx context.Context, name string, meta interface{}) (uid *uint64, errRet error) {
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := camService.DescribeUserById(ctx, name)
 		if e != nil {
 			return retryError(e)
@@ -255,7 +259,7 @@ func getUidFromName(name string, meta interface{}) (uid *uint64, errRet error) {
 	return
 }
 
-func addUsersToGroup(members []interface{}, groupId string, meta interface{}) error {
+func addUsersToGroup(ctx context.Context, members []interface{}, groupId string, meta interface{}) error {
 	logId := getLogId(contextNil)
 
 	request := cam.NewAddUserToGroupRequest()
@@ -264,7 +268,7 @@ func addUsersToGroup(members []interface{}, groupId string, meta interface{}) er
 		var info cam.GroupIdOfUidInfo
 		//get uid from name
 
-		uId, e := getUidFromName(member.(string), meta)
+		uId, e := getUidFromName(ctx, member.(string), meta)
 		if e != nil {
 			return e
 		}
@@ -280,8 +284,8 @@ func addUsersToGroup(members []interface{}, groupId string, meta interface{}) er
 		info.GroupId = &groupIdInt64
 		request.Info = append(request.Info, &info)
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().AddUserToGroup(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().AddUserToGroupWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -299,14 +303,14 @@ func addUsersToGroup(members []interface{}, groupId string, meta interface{}) er
 	return nil
 }
 
-func removeUsersFromGroup(members []interface{}, groupId string, meta interface{}) error {
+func removeUsersFromGroup(ctx context.Context, members []interface{}, groupId string, meta interface{}) error {
 	logId := getLogId(contextNil)
 
 	request := cam.NewRemoveUserFromGroupRequest()
 	request.Info = make([]*cam.GroupIdOfUidInfo, 0)
 	for _, member := range members {
 		var info cam.GroupIdOfUidInfo
-		uId, e := getUidFromName(member.(string), meta)
+		uId, e := getUidFromName(ctx, member.(string), meta)
 		if e != nil {
 			//notice case when user is deleted, the uin is not found, and the membership is removed in the user module when deleted
 			ee, ok := e.(*errors.TencentCloudSDKError)
@@ -335,8 +339,8 @@ func removeUsersFromGroup(members []interface{}, groupId string, meta interface{
 	if len(request.Info) == 0 {
 		return nil
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().RemoveUserFromGroup(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().RemoveUserFromGroupWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -366,7 +370,7 @@ func getUserIds(d *schema.ResourceData) (data *schema.Set, usingNames bool, errR
 	return nil, true, fmt.Errorf("no user names provided")
 }
 
-func processChange(d *schema.ResourceData, groupId string, logId string, meta interface{}) error {
+func processChange(ctx context.Context, d *schema.ResourceData, groupId string, logId string, meta interface{}) error {
 	var (
 		o interface{}
 		n interface{}
@@ -384,14 +388,14 @@ func processChange(d *schema.ResourceData, groupId string, logId string, meta in
 	add := ns.Difference(os).List()
 	remove := os.Difference(ns).List()
 	if len(remove) > 0 {
-		oErr := removeUsersFromGroup(remove, groupId, meta)
+		oErr := removeUsersFromGroup(ctx, remove, groupId, meta)
 		if oErr != nil {
 			log.Printf("[CRITAL]%s update CAM group membership failed, reason:%s\n", logId, oErr.Error())
 			return oErr
 		}
 	}
 	if len(add) > 0 {
-		nErr := addUsersToGroup(add, groupId, meta)
+		nErr := addUsersToGroup(ctx, add, groupId, meta)
 		if nErr != nil {
 			log.Printf("[CRITAL]%s update CAM group membership failed, reason:%s\n", logId, nErr.Error())
 			return nErr
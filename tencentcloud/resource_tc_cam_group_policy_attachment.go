@@ -43,18 +43,24 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCamGroupPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamGroupPolicyAttachmentCreate,
-		Read:   resourceTencentCloudCamGroupPolicyAttachmentRead,
-		Delete: resourceTencentCloudCamGroupPolicyAttachmentDelete,
+		CreateContext: resourceTencentCloudCamGroupPolicyAttachmentCreate,
+		ReadContext:   resourceTencentCloudCamGroupPolicyAttachmentRead,
+		DeleteContext: resourceTencentCloudCamGroupPolicyAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -94,18 +100,18 @@ func resourceTencentCloudCamGroupPolicyAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCamGroupPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamGroupPolicyAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_group_policy_attachment.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	groupId := d.Get("group_id").(string)
 	policyId := d.Get("policy_id").(string)
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		e := camService.AddGroupPolicyAttachment(ctx, groupId, policyId)
 		if e != nil {
 			log.Printf("[CRITAL]%s reason[%s]\n", logId, e.Error())
@@ -115,14 +121,14 @@ func resourceTencentCloudCamGroupPolicyAttachmentCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create CAM group policy attachment failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(groupId + "#" + policyId)
+	d.SetId(helper.IdFormat(groupId, policyId))
 
 	//get really instance then read
 	groupPolicyAttachmentId := d.Id()
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, e := camService.DescribeGroupPolicyAttachmentById(ctx, groupPolicyAttachmentId)
 		if e != nil {
 			return retryError(e)
@@ -134,18 +140,18 @@ func resourceTencentCloudCamGroupPolicyAttachmentCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM group policy failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	time.Sleep(10 * time.Second)
-	return resourceTencentCloudCamGroupPolicyAttachmentRead(d, meta)
+	return resourceTencentCloudCamGroupPolicyAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamGroupPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamGroupPolicyAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_group_policy_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	groupPolicyAttachmentId := d.Id()
 
@@ -153,7 +159,7 @@ func resourceTencentCloudCamGroupPolicyAttachmentRead(d *schema.ResourceData, me
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var instance *cam.AttachPolicyInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := camService.DescribeGroupPolicyAttachmentById(ctx, groupPolicyAttachmentId)
 		if e != nil {
 			return retryError(e)
@@ -163,7 +169,7 @@ func resourceTencentCloudCamGroupPolicyAttachmentRead(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM group policy attachment failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -174,7 +180,7 @@ func resourceTencentCloudCamGroupPolicyAttachmentRead(d *schema.ResourceData, me
 	//split id
 	groupId, policyId, e := camService.decodeCamPolicyAttachmentId(groupPolicyAttachmentId)
 	if e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 	_ = d.Set("group_id", groupId)
 	_ = d.Set("policy_id", strconv.Itoa(int(policyId)))
@@ -185,18 +191,18 @@ func resourceTencentCloudCamGroupPolicyAttachmentRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudCamGroupPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamGroupPolicyAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_group_policy_attachment.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	groupPolicyAttachmentId := d.Id()
 
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := camService.DeleteGroupPolicyAttachmentById(ctx, groupPolicyAttachmentId)
 		if e != nil {
 			log.Printf("[CRITAL]%s reason[%s]\n", logId, e.Error())
@@ -206,7 +212,7 @@ func resourceTencentCloudCamGroupPolicyAttachmentDelete(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete CAM group policy attachment failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
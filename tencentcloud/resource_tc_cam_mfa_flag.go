@@ -36,7 +36,9 @@ import (
 	"context"
 	"log"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -46,12 +48,15 @@ import (
 
 func resourceTencentCloudCamMfaFlag() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamMfaFlagCreate,
-		Read:   resourceTencentCloudCamMfaFlagRead,
-		Update: resourceTencentCloudCamMfaFlagUpdate,
-		Delete: resourceTencentCloudCamMfaFlagDelete,
+		CreateContext: resourceTencentCloudCamMfaFlagCreate,
+		ReadContext:   resourceTencentCloudCamMfaFlagRead,
+		UpdateContext: resourceTencentCloudCamMfaFlagUpdate,
+		DeleteContext: resourceTencentCloudCamMfaFlagDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"op_uin": {
@@ -115,7 +120,7 @@ func resourceTencentCloudCamMfaFlag() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCamMfaFlagCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamMfaFlagCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_mfa_flag.create")()
 	defer inconsistentCheck(d, meta)()
 	var opUin int
@@ -124,26 +129,26 @@ func resourceTencentCloudCamMfaFlagCreate(d *schema.ResourceData, meta interface
 		opUin = v.(int)
 	}
 	d.SetId(strconv.Itoa(opUin))
-	return resourceTencentCloudCamMfaFlagUpdate(d, meta)
+	return resourceTencentCloudCamMfaFlagUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudCamMfaFlagRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamMfaFlagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_mfa_flag.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	opUin := d.Id()
 	uin, err := strconv.Atoi(opUin)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	service := CamService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	loginFlag, actionFlag, err := service.DescribeCamMfaFlagById(ctx, uint64(uin))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if loginFlag == nil && actionFlag == nil {
@@ -190,7 +195,7 @@ func resourceTencentCloudCamMfaFlagRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudCamMfaFlagUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamMfaFlagUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_mfa_flag.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -199,7 +204,7 @@ func resourceTencentCloudCamMfaFlagUpdate(d *schema.ResourceData, meta interface
 	request := cam.NewSetMfaFlagRequest()
 	uin, err := strconv.Atoi(opUin)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	request.OpUin = common.Uint64Ptr(uint64(uin))
 
@@ -235,8 +240,8 @@ func resourceTencentCloudCamMfaFlagUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().SetMfaFlag(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().SetMfaFlagWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -246,13 +251,13 @@ func resourceTencentCloudCamMfaFlagUpdate(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update cam mfaFlag failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCamMfaFlagRead(d, meta)
+	return resourceTencentCloudCamMfaFlagRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamMfaFlagDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamMfaFlagDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_mfa_flag.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -27,8 +27,11 @@ $ terraform import tencentcloud_cam_oidc_sso.foo xxxxxxxxxxx
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -37,12 +40,17 @@ import (
 
 func resourceTencentCloudCamOIDCSSO() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamOIDCSSOCreate,
-		Read:   resourceTencentCloudCamOIDCSSORead,
-		Update: resourceTencentCloudCamOIDCSSOUpdate,
-		Delete: resourceTencentCloudCamOIDCSSODelete,
+		CreateContext: resourceTencentCloudCamOIDCSSOCreate,
+		ReadContext:   resourceTencentCloudCamOIDCSSORead,
+		UpdateContext: resourceTencentCloudCamOIDCSSOUpdate,
+		DeleteContext: resourceTencentCloudCamOIDCSSODelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -91,7 +99,7 @@ func resourceTencentCloudCamOIDCSSO() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCamOIDCSSOCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamOIDCSSOCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_oidc_sso.create")()
 
 	logId := getLogId(contextNil)
@@ -110,8 +118,8 @@ func resourceTencentCloudCamOIDCSSOCreate(d *schema.ResourceData, meta interface
 		request.Scope = helper.InterfacesStringsPoint([]interface{}{"openid"})
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().CreateUserOIDCConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().CreateUserOIDCConfigWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -124,20 +132,20 @@ func resourceTencentCloudCamOIDCSSOCreate(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create CAM SSO failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(d.Get("client_id").(string))
-	return resourceTencentCloudCamOIDCSSORead(d, meta)
+	return resourceTencentCloudCamOIDCSSORead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamOIDCSSORead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamOIDCSSORead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_oidc_sso.read")()
 
 	logId := getLogId(contextNil)
 	request := cam.NewDescribeUserOIDCConfigRequest()
 	var response *cam.DescribeUserOIDCConfigResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DescribeUserOIDCConfig(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DescribeUserOIDCConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -146,7 +154,7 @@ func resourceTencentCloudCamOIDCSSORead(d *schema.ResourceData, meta interface{}
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM SSO failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if response.Response == nil {
@@ -170,7 +178,7 @@ func resourceTencentCloudCamOIDCSSORead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudCamOIDCSSOUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamOIDCSSOUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_oidc_sso.update")()
 	logId := getLogId(contextNil)
 	request := cam.NewUpdateUserOIDCConfigRequest()
@@ -189,8 +197,8 @@ func resourceTencentCloudCamOIDCSSOUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateUserOIDCConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateUserOIDCConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -198,18 +206,18 @@ func resourceTencentCloudCamOIDCSSOUpdate(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update CAM OIDC SSO failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCamOIDCSSORead(d, meta)
+	return resourceTencentCloudCamOIDCSSORead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamOIDCSSODelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamOIDCSSODelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_oidc_sso.delete")()
 	logId := getLogId(contextNil)
 	request := cam.NewDisableUserSSORequest()
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DisableUserSSO(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DisableUserSSOWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -217,7 +225,7 @@ func resourceTencentCloudCamOIDCSSODelete(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s disable cam sso failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -46,6 +46,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -55,12 +56,17 @@ import (
 
 func resourceTencentCloudCamPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamPolicyCreate,
-		Read:   resourceTencentCloudCamPolicyRead,
-		Update: resourceTencentCloudCamPolicyUpdate,
-		Delete: resourceTencentCloudCamPolicyDelete,
+		CreateContext: resourceTencentCloudCamPolicyCreate,
+		ReadContext:   resourceTencentCloudCamPolicyRead,
+		UpdateContext: resourceTencentCloudCamPolicyUpdate,
+		DeleteContext: resourceTencentCloudCamPolicyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -113,7 +119,7 @@ func resourceTencentCloudCamPolicy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCamPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_policy.create")()
 
 	logId := getLogId(contextNil)
@@ -126,7 +132,7 @@ func resourceTencentCloudCamPolicyCreate(d *schema.ResourceData, meta interface{
 	}
 	documentErr := camService.PolicyDocumentForceCheck(document)
 	if documentErr != nil {
-		return documentErr
+		return diag.FromErr(documentErr)
 	}
 	request := cam.NewCreatePolicyRequest()
 	request.PolicyName = &name
@@ -136,8 +142,8 @@ func resourceTencentCloudCamPolicyCreate(d *schema.ResourceData, meta interface{
 	}
 
 	var response *cam.CreatePolicyResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().CreatePolicy(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().CreatePolicyWithContext(ctx, request)
 		if e != nil {
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 				errCode := ee.GetCode()
@@ -158,18 +164,18 @@ func resourceTencentCloudCamPolicyCreate(d *schema.ResourceData, meta interface{
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create CAM policy failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if response.Response.PolicyId == nil {
-		return fmt.Errorf("CAM policy id is nil")
+		return diag.Errorf("CAM policy id is nil")
 	}
 	d.SetId(strconv.Itoa(int(*response.Response.PolicyId)))
 
 	//get really instance then read
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	policyId := d.Id()
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, e := camService.DescribePolicyById(ctx, policyId)
 		if e != nil {
 			return retryError(e)
@@ -181,25 +187,25 @@ func resourceTencentCloudCamPolicyCreate(d *schema.ResourceData, meta interface{
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM policy failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	time.Sleep(10 * time.Second)
-	return resourceTencentCloudCamPolicyRead(d, meta)
+	return resourceTencentCloudCamPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_policy.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	policyId := d.Id()
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var instance *cam.GetPolicyResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := camService.DescribePolicyById(ctx, policyId)
 		if e != nil {
 			return retryError(e)
@@ -209,7 +215,7 @@ func resourceTencentCloudCamPolicyRead(d *schema.ResourceData, meta interface{})
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM policy failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil || instance.Response == nil || instance.Response.PolicyName == nil {
@@ -229,7 +235,7 @@ func resourceTencentCloudCamPolicyRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudCamPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_policy.update")()
 
 	logId := getLogId(contextNil)
@@ -237,7 +243,7 @@ func resourceTencentCloudCamPolicyUpdate(d *schema.ResourceData, meta interface{
 	policyId := d.Id()
 	policyIdInt, e := strconv.Atoi(policyId)
 	if e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 	policyIdInt64 := uint64(policyIdInt)
 	request := cam.NewUpdatePolicyRequest()
@@ -261,15 +267,15 @@ func resourceTencentCloudCamPolicyUpdate(d *schema.ResourceData, meta interface{
 		}
 		documentErr := camService.PolicyDocumentForceCheck(document)
 		if documentErr != nil {
-			return documentErr
+			return diag.FromErr(documentErr)
 		}
 		request.PolicyDocument = &document
 		changeFlag = true
 
 	}
 	if changeFlag {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			response, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdatePolicy(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			response, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdatePolicyWithContext(ctx, request)
 
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -283,14 +289,14 @@ func resourceTencentCloudCamPolicyUpdate(d *schema.ResourceData, meta interface{
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update CAM policy description failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCamPolicyRead(d, meta)
+	return resourceTencentCloudCamPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_policy.delete")()
 
 	logId := getLogId(contextNil)
@@ -298,13 +304,13 @@ func resourceTencentCloudCamPolicyDelete(d *schema.ResourceData, meta interface{
 	policyId := d.Id()
 	policyIdInt, e := strconv.Atoi(policyId)
 	if e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 	policyIdInt64 := uint64(policyIdInt)
 	request := cam.NewDeletePolicyRequest()
 	request.PolicyId = []*uint64{&policyIdInt64}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DeletePolicy(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DeletePolicyWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s reason[%s]\n", logId, e.Error())
 			return retryError(e)
@@ -313,7 +319,7 @@ func resourceTencentCloudCamPolicyDelete(d *schema.ResourceData, meta interface{
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete CAM policy failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
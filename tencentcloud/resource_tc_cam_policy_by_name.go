@@ -46,6 +46,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -55,12 +56,17 @@ import (
 
 func resourceTencentCloudCamPolicyByName() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamPolicyByNameCreate,
-		Read:   resourceTencentCloudCamPolicyByNameRead,
-		Update: resourceTencentCloudCamPolicyByNameUpdate,
-		Delete: resourceTencentCloudCamPolicyByNameDelete,
+		CreateContext: resourceTencentCloudCamPolicyByNameCreate,
+		ReadContext:   resourceTencentCloudCamPolicyByNameRead,
+		UpdateContext: resourceTencentCloudCamPolicyByNameUpdate,
+		DeleteContext: resourceTencentCloudCamPolicyByNameDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -113,7 +119,7 @@ func resourceTencentCloudCamPolicyByName() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCamPolicyByNameCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamPolicyByNameCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_policy_by_name.create")()
 
 	logId := getLogId(contextNil)
@@ -126,7 +132,7 @@ func resourceTencentCloudCamPolicyByNameCreate(d *schema.ResourceData, meta inte
 	}
 	documentErr := camService.PolicyDocumentForceCheck(document)
 	if documentErr != nil {
-		return documentErr
+		return diag.FromErr(documentErr)
 	}
 	request := cam.NewCreatePolicyRequest()
 	request.PolicyName = &name
@@ -136,8 +142,8 @@ func resourceTencentCloudCamPolicyByNameCreate(d *schema.ResourceData, meta inte
 	}
 
 	var response *cam.CreatePolicyResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().CreatePolicy(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().CreatePolicyWithContext(ctx, request)
 		if e != nil {
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 				errCode := ee.GetCode()
@@ -158,17 +164,17 @@ func resourceTencentCloudCamPolicyByNameCreate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create CAM policy failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if response.Response.PolicyId == nil {
-		return fmt.Errorf("CAM policy id is nil")
+		return diag.Errorf("CAM policy id is nil")
 	}
 	d.SetId(name)
 
 	//get really instance then read
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		parmas := make(map[string]interface{})
 		parmas["name"] = name
 		instances, e := camService.DescribePoliciesByFilter(ctx, parmas)
@@ -182,18 +188,18 @@ func resourceTencentCloudCamPolicyByNameCreate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM policy failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	time.Sleep(10 * time.Second)
-	return resourceTencentCloudCamPolicyByNameRead(d, meta)
+	return resourceTencentCloudCamPolicyByNameRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamPolicyByNameRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamPolicyByNameRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_policy_by_name.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	policyName := d.Id()
 	camService := CamService{
@@ -202,7 +208,7 @@ func resourceTencentCloudCamPolicyByNameRead(d *schema.ResourceData, meta interf
 	var policies []*cam.StrategyInfo
 	params := make(map[string]interface{})
 	params["name"] = policyName
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		var innerErr error
 		policies, innerErr = camService.DescribePoliciesByFilter(ctx, params)
 		if innerErr != nil {
@@ -212,13 +218,13 @@ func resourceTencentCloudCamPolicyByNameRead(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM policy failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if len(policies) == 0 {
 		return nil
 	}
 	var instance *cam.GetPolicyResponse
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		policyId := strconv.Itoa(int(*policies[0].PolicyId))
 		result, e := camService.DescribePolicyById(ctx, policyId)
 		if e != nil {
@@ -229,7 +235,7 @@ func resourceTencentCloudCamPolicyByNameRead(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM policy failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil || instance.Response == nil || instance.Response.PolicyName == nil {
@@ -249,7 +255,7 @@ func resourceTencentCloudCamPolicyByNameRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudCamPolicyByNameUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamPolicyByNameUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_policy_by_name.update")()
 
 	logId := getLogId(contextNil)
@@ -276,15 +282,15 @@ func resourceTencentCloudCamPolicyByNameUpdate(d *schema.ResourceData, meta inte
 		}
 		documentErr := camService.PolicyDocumentForceCheck(document)
 		if documentErr != nil {
-			return documentErr
+			return diag.FromErr(documentErr)
 		}
 		request.PolicyDocument = &document
 		changeFlag = true
 
 	}
 	if changeFlag {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			response, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdatePolicy(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			response, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdatePolicyWithContext(ctx, request)
 
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -298,14 +304,14 @@ func resourceTencentCloudCamPolicyByNameUpdate(d *schema.ResourceData, meta inte
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update CAM policy description failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCamPolicyByNameRead(d, meta)
+	return resourceTencentCloudCamPolicyByNameRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamPolicyByNameDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamPolicyByNameDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_policy_by_name.delete")()
 
 	logId := getLogId(contextNil)
@@ -316,8 +322,8 @@ func resourceTencentCloudCamPolicyByNameDelete(d *schema.ResourceData, meta inte
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		var innerErr error
 		policies, innerErr = camService.DescribePoliciesByFilter(ctx, params)
 		if innerErr != nil {
@@ -327,7 +333,7 @@ func resourceTencentCloudCamPolicyByNameDelete(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM policy failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if len(policies) == 0 {
 		return nil
@@ -336,8 +342,8 @@ func resourceTencentCloudCamPolicyByNameDelete(d *schema.ResourceData, meta inte
 	policyId := policies[0].PolicyId
 	request := cam.NewDeletePolicyRequest()
 	request.PolicyId = []*uint64{policyId}
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DeletePolicy(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DeletePolicyWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s reason[%s]\n", logId, e.Error())
 			return retryError(e)
@@ -346,7 +352,7 @@ func resourceTencentCloudCamPolicyByNameDelete(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete CAM policy failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
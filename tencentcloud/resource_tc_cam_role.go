@@ -98,6 +98,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -107,12 +108,17 @@ import (
 
 func resourceTencentCloudCamRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamRoleCreate,
-		Read:   resourceTencentCloudCamRoleRead,
-		Update: resourceTencentCloudCamRoleUpdate,
-		Delete: resourceTencentCloudCamRoleDelete,
+		CreateContext: resourceTencentCloudCamRoleCreate,
+		ReadContext:   resourceTencentCloudCamRoleRead,
+		UpdateContext: resourceTencentCloudCamRoleUpdate,
+		DeleteContext: resourceTencentCloudCamRoleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -171,7 +177,7 @@ func resourceTencentCloudCamRole() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCamRoleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_role.create")()
 
 	logId := getLogId(contextNil)
@@ -202,8 +208,8 @@ func resourceTencentCloudCamRoleCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	var response *cam.CreateRoleResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().CreateRole(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().CreateRoleWithContext(ctx, request)
 		if e != nil {
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 				errCode := ee.GetCode()
@@ -224,18 +230,18 @@ func resourceTencentCloudCamRoleCreate(d *schema.ResourceData, meta interface{})
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create CAM role failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if response.Response.RoleId == nil {
-		return fmt.Errorf("CAM role id is nil")
+		return diag.Errorf("CAM role id is nil")
 	}
 	d.SetId(*response.Response.RoleId)
 
 	//get really instance then read
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	roleId := d.Id()
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, e := camService.DescribeRoleById(ctx, roleId)
 		if e != nil {
 			return retryError(e)
@@ -247,7 +253,7 @@ func resourceTencentCloudCamRoleCreate(d *schema.ResourceData, meta interface{})
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM role failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	//modify tags
@@ -255,26 +261,26 @@ func resourceTencentCloudCamRoleCreate(d *schema.ResourceData, meta interface{})
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		resourceName := BuildTagResourceName("cam", "role", "", roleId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	time.Sleep(10 * time.Second)
-	return resourceTencentCloudCamRoleRead(d, meta)
+	return resourceTencentCloudCamRoleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamRoleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_role.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	roleId := d.Id()
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var instance *cam.RoleInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := camService.DescribeRoleById(ctx, roleId)
 		if e != nil {
 			return retryError(e)
@@ -284,7 +290,7 @@ func resourceTencentCloudCamRoleRead(d *schema.ResourceData, meta interface{}) e
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM role failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -314,18 +320,18 @@ func resourceTencentCloudCamRoleRead(d *schema.ResourceData, meta interface{}) e
 	tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 	tags, err := tagService.DescribeResourceTags(ctx, "cam", "role", "", roleId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudCamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_role.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	d.Partial(true)
 
@@ -339,8 +345,8 @@ func resourceTencentCloudCamRoleUpdate(d *schema.ResourceData, meta interface{})
 		mDescRequest := cam.NewUpdateRoleDescriptionRequest()
 		mDescRequest.Description = &description
 		mDescRequest.RoleId = &roleId
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			response, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateRoleDescription(mDescRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			response, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateRoleDescriptionWithContext(ctx, mDescRequest)
 
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -354,7 +360,7 @@ func resourceTencentCloudCamRoleUpdate(d *schema.ResourceData, meta interface{})
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update CAM role description failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -367,13 +373,13 @@ func resourceTencentCloudCamRoleUpdate(d *schema.ResourceData, meta interface{})
 		}
 		documentErr := camService.PolicyDocumentForceCheck(document)
 		if documentErr != nil {
-			return documentErr
+			return diag.FromErr(documentErr)
 		}
 		mDocRequest := cam.NewUpdateAssumeRolePolicyRequest()
 		mDocRequest.PolicyDocument = &document
 		mDocRequest.RoleId = &roleId
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			response, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateAssumeRolePolicy(mDocRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			response, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateAssumeRolePolicyWithContext(ctx, mDocRequest)
 
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -387,7 +393,7 @@ func resourceTencentCloudCamRoleUpdate(d *schema.ResourceData, meta interface{})
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update CAM role document failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -404,25 +410,25 @@ func resourceTencentCloudCamRoleUpdate(d *schema.ResourceData, meta interface{})
 		resourceName := BuildTagResourceName("cam", "role", "", roleId)
 		err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
-	return resourceTencentCloudCamRoleRead(d, meta)
+	return resourceTencentCloudCamRoleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamRoleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_role.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	roleId := d.Id()
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := camService.DeleteRoleById(ctx, roleId)
 		if e != nil {
 			log.Printf("[CRITAL]%s reason[%s]\n", logId, e.Error())
@@ -432,7 +438,7 @@ func resourceTencentCloudCamRoleDelete(d *schema.ResourceData, meta interface{})
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete CAM role failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -73,6 +73,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -82,12 +83,17 @@ import (
 
 func resourceTencentCloudCamRoleByName() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamRoleByNameCreate,
-		Read:   resourceTencentCloudCamRoleByNameRead,
-		Update: resourceTencentCloudCamRoleByNameUpdate,
-		Delete: resourceTencentCloudCamRoleByNameDelete,
+		CreateContext: resourceTencentCloudCamRoleByNameCreate,
+		ReadContext:   resourceTencentCloudCamRoleByNameRead,
+		UpdateContext: resourceTencentCloudCamRoleByNameUpdate,
+		DeleteContext: resourceTencentCloudCamRoleByNameDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -146,7 +152,7 @@ func resourceTencentCloudCamRoleByName() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCamRoleByNameCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamRoleByNameCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_role_by_name.create")()
 
 	logId := getLogId(contextNil)
@@ -173,8 +179,8 @@ func resourceTencentCloudCamRoleByNameCreate(d *schema.ResourceData, meta interf
 		request.ConsoleLogin = &loginInt
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().CreateRole(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().CreateRoleWithContext(ctx, request)
 		if e != nil {
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 				errCode := ee.GetCode()
@@ -194,15 +200,15 @@ func resourceTencentCloudCamRoleByNameCreate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create CAM role failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(name)
 
 	//get really instance then read
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var instances []*cam.RoleInfo
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		params := make(map[string]interface{})
 		params["name"] = name
 		var innerErr error
@@ -217,7 +223,7 @@ func resourceTencentCloudCamRoleByNameCreate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM role failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	//modify tags
@@ -230,26 +236,26 @@ func resourceTencentCloudCamRoleByNameCreate(d *schema.ResourceData, meta interf
 		resourceName := BuildTagResourceName("cam", "role", "", *instance.RoleId)
 		log.Printf("resourceName: %v", resourceName)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	time.Sleep(10 * time.Second)
-	return resourceTencentCloudCamRoleByNameRead(d, meta)
+	return resourceTencentCloudCamRoleByNameRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamRoleByNameRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamRoleByNameRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_role_by_name.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	roleName := d.Id()
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var instance *cam.RoleInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		params := make(map[string]interface{})
 		params["name"] = roleName
 		instances, e := camService.DescribeRolesByFilter(ctx, params)
@@ -264,7 +270,7 @@ func resourceTencentCloudCamRoleByNameRead(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM role failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -294,18 +300,18 @@ func resourceTencentCloudCamRoleByNameRead(d *schema.ResourceData, meta interfac
 	tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 	tags, err := tagService.DescribeResourceTags(ctx, "cam", "role", "", *instance.RoleId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudCamRoleByNameUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamRoleByNameUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_role_by_name.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	d.Partial(true)
 
@@ -319,8 +325,8 @@ func resourceTencentCloudCamRoleByNameUpdate(d *schema.ResourceData, meta interf
 		mDescRequest := cam.NewUpdateRoleDescriptionRequest()
 		mDescRequest.Description = &description
 		mDescRequest.RoleName = &roleName
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			response, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateRoleDescription(mDescRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			response, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateRoleDescriptionWithContext(ctx, mDescRequest)
 
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -334,7 +340,7 @@ func resourceTencentCloudCamRoleByNameUpdate(d *schema.ResourceData, meta interf
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update CAM role description failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -347,13 +353,13 @@ func resourceTencentCloudCamRoleByNameUpdate(d *schema.ResourceData, meta interf
 		}
 		documentErr := camService.PolicyDocumentForceCheck(document)
 		if documentErr != nil {
-			return documentErr
+			return diag.FromErr(documentErr)
 		}
 		mDocRequest := cam.NewUpdateAssumeRolePolicyRequest()
 		mDocRequest.PolicyDocument = &document
 		mDocRequest.RoleName = &roleName
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			response, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateAssumeRolePolicy(mDocRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			response, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateAssumeRolePolicyWithContext(ctx, mDocRequest)
 
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -367,7 +373,7 @@ func resourceTencentCloudCamRoleByNameUpdate(d *schema.ResourceData, meta interf
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update CAM role document failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -382,7 +388,7 @@ func resourceTencentCloudCamRoleByNameUpdate(d *schema.ResourceData, meta interf
 			client: meta.(*TencentCloudClient).apiV3Conn,
 		}
 		var instance *cam.RoleInfo
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			params := make(map[string]interface{})
 			params["name"] = roleName
 			camService := CamService{
@@ -400,33 +406,33 @@ func resourceTencentCloudCamRoleByNameUpdate(d *schema.ResourceData, meta interf
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s read CAM role failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 		if instance == nil {
-			return fmt.Errorf("Instance can not find by name!")
+			return diag.Errorf("Instance can not find by name!")
 		}
 		resourceName := BuildTagResourceName("cam", "role", "", *instance.RoleId)
 		err = tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
-	return resourceTencentCloudCamRoleByNameRead(d, meta)
+	return resourceTencentCloudCamRoleByNameRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamRoleByNameDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamRoleByNameDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_role_by_name.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	roleName := d.Id()
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := camService.DeleteRoleByName(ctx, roleName)
 		if e != nil {
 			log.Printf("[CRITAL]%s reason[%s]\n", logId, e.Error())
@@ -436,7 +442,7 @@ func resourceTencentCloudCamRoleByNameDelete(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete CAM role failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
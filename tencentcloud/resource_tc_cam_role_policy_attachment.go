@@ -43,18 +43,24 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCamRolePolicyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamRolePolicyAttachmentCreate,
-		Read:   resourceTencentCloudCamRolePolicyAttachmentRead,
-		Delete: resourceTencentCloudCamRolePolicyAttachmentDelete,
+		CreateContext: resourceTencentCloudCamRolePolicyAttachmentCreate,
+		ReadContext:   resourceTencentCloudCamRolePolicyAttachmentRead,
+		DeleteContext: resourceTencentCloudCamRolePolicyAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -94,7 +100,7 @@ func resourceTencentCloudCamRolePolicyAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCamRolePolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamRolePolicyAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_role_policy_attachment.create")()
 
 	logId := getLogId(contextNil)
@@ -102,15 +108,15 @@ func resourceTencentCloudCamRolePolicyAttachmentCreate(d *schema.ResourceData, m
 	roleId := d.Get("role_id").(string)
 	policyId, e := strconv.Atoi(d.Get("policy_id").(string))
 	if e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 	policyId64 := uint64(policyId)
 	request := cam.NewAttachRolePolicyRequest()
 	request.AttachRoleId = &roleId
 	request.PolicyId = &policyId64
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().AttachRolePolicy(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().AttachRolePolicyWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -123,18 +129,18 @@ func resourceTencentCloudCamRolePolicyAttachmentCreate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create CAM role policy attachment failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(roleId + "#" + strconv.Itoa(policyId))
+	d.SetId(helper.IdFormat(roleId, strconv.Itoa(policyId)))
 
 	//get really instance then read
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	rolePolicyAttachmentId := d.Id()
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, e := camService.DescribeRolePolicyAttachmentById(ctx, rolePolicyAttachmentId)
 		if e != nil {
 			return retryError(e)
@@ -146,18 +152,18 @@ func resourceTencentCloudCamRolePolicyAttachmentCreate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM role policy attachment failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	time.Sleep(10 * time.Second)
-	return resourceTencentCloudCamRolePolicyAttachmentRead(d, meta)
+	return resourceTencentCloudCamRolePolicyAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamRolePolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamRolePolicyAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_role_policy_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	rolePolicyAttachmentId := d.Id()
 
@@ -165,7 +171,7 @@ func resourceTencentCloudCamRolePolicyAttachmentRead(d *schema.ResourceData, met
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var instance *cam.AttachedPolicyOfRole
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := camService.DescribeRolePolicyAttachmentById(ctx, rolePolicyAttachmentId)
 		if e != nil {
 			return retryError(e)
@@ -175,7 +181,7 @@ func resourceTencentCloudCamRolePolicyAttachmentRead(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM role policy attachment failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -185,7 +191,7 @@ func resourceTencentCloudCamRolePolicyAttachmentRead(d *schema.ResourceData, met
 
 	roleId, policyId, e := camService.decodeCamPolicyAttachmentId(rolePolicyAttachmentId)
 	if e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 	_ = d.Set("role_id", roleId)
 	_ = d.Set("policy_id", strconv.Itoa(int(policyId)))
@@ -197,18 +203,18 @@ func resourceTencentCloudCamRolePolicyAttachmentRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudCamRolePolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamRolePolicyAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_role_policy_attachment.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	rolePolicyAttachmentId := d.Id()
 
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := camService.DeleteRolePolicyAttachmentById(ctx, rolePolicyAttachmentId)
 		if e != nil {
 			log.Printf("[CRITAL]%s reason[%s]\n", logId, e.Error())
@@ -218,7 +224,7 @@ func resourceTencentCloudCamRolePolicyAttachmentDelete(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete CAM role policy attachment failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -40,9 +40,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -51,11 +51,15 @@ import (
 
 func resourceTencentCloudCamRolePolicyAttachmentByName() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamRolePolicyAttachmentByNameCreate,
-		Read:   resourceTencentCloudCamRolePolicyAttachmentByNameRead,
-		Delete: resourceTencentCloudCamRolePolicyAttachmentByNameDelete,
+		CreateContext: resourceTencentCloudCamRolePolicyAttachmentByNameCreate,
+		ReadContext:   resourceTencentCloudCamRolePolicyAttachmentByNameRead,
+		DeleteContext: resourceTencentCloudCamRolePolicyAttachmentByNameDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -90,7 +94,7 @@ func resourceTencentCloudCamRolePolicyAttachmentByName() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCamRolePolicyAttachmentByNameCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamRolePolicyAttachmentByNameCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_role_policy_attachment_by_name.create")()
 
 	logId := getLogId(contextNil)
@@ -102,8 +106,8 @@ func resourceTencentCloudCamRolePolicyAttachmentByNameCreate(d *schema.ResourceD
 	request.PolicyName = helper.String(policyName)
 	request.AttachRoleName = helper.String(roleName)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().AttachRolePolicy(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().AttachRolePolicyWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -116,19 +120,19 @@ func resourceTencentCloudCamRolePolicyAttachmentByNameCreate(d *schema.ResourceD
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create CAM role policy attachment failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(roleName + "#" + policyName)
+	d.SetId(helper.IdFormat(roleName, policyName))
 
 	//get really instance then read
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	params := make(map[string]interface{})
 	params["policy_name"] = policyName
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, e := camService.DescribeRolePolicyAttachmentByName(ctx, roleName, params)
 		if e != nil {
 			return retryError(e)
@@ -140,18 +144,18 @@ func resourceTencentCloudCamRolePolicyAttachmentByNameCreate(d *schema.ResourceD
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM role policy attachment failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	time.Sleep(10 * time.Second)
-	return resourceTencentCloudCamRolePolicyAttachmentByNameRead(d, meta)
+	return resourceTencentCloudCamRolePolicyAttachmentByNameRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamRolePolicyAttachmentByNameRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamRolePolicyAttachmentByNameRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_role_policy_attachment_by_name.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	rolePolicyAttachmentId := d.Id()
 
@@ -159,14 +163,14 @@ func resourceTencentCloudCamRolePolicyAttachmentByNameRead(d *schema.ResourceDat
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var instance *cam.AttachedPolicyOfRole
-	items := strings.Split(rolePolicyAttachmentId, "#")
+	items := helper.IdParse(rolePolicyAttachmentId)
 	if len(items) < 2 {
-		return fmt.Errorf("RolePolicyAttachmentId is invalid!")
+		return diag.Errorf("RolePolicyAttachmentId is invalid!")
 	}
 	roleName, policyName := items[0], items[1]
 	params := make(map[string]interface{})
 	params["policy_name"] = policyName
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := camService.DescribeRolePolicyAttachmentByName(ctx, roleName, params)
 		if e != nil {
 			return retryError(e)
@@ -176,7 +180,7 @@ func resourceTencentCloudCamRolePolicyAttachmentByNameRead(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM role policy attachment failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -192,23 +196,23 @@ func resourceTencentCloudCamRolePolicyAttachmentByNameRead(d *schema.ResourceDat
 	return nil
 }
 
-func resourceTencentCloudCamRolePolicyAttachmentByNameDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamRolePolicyAttachmentByNameDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_role_policy_attachment_by_name.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	rolePolicyAttachmentId := d.Id()
 
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	items := strings.Split(rolePolicyAttachmentId, "#")
+	items := helper.IdParse(rolePolicyAttachmentId)
 	if len(items) < 2 {
-		return fmt.Errorf("RolePolicyAttachmentId is invalid!")
+		return diag.Errorf("RolePolicyAttachmentId is invalid!")
 	}
 	roleName, policyName := items[0], items[1]
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := camService.DeleteRolePolicyAttachmentByName(ctx, roleName, policyName)
 		if e != nil {
 			log.Printf("[CRITAL]%s reason[%s]\n", logId, e.Error())
@@ -218,7 +222,7 @@ func resourceTencentCloudCamRolePolicyAttachmentByNameDelete(d *schema.ResourceD
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete CAM role policy attachment failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -24,9 +24,11 @@ $ terraform import tencentcloud_cam_role_sso.foo "test"
 package tencentcloud
 
 import (
-	"fmt"
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -35,12 +37,17 @@ import (
 
 func resourceTencentCloudCamRoleSSO() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamRoleSSOCreate,
-		Read:   resourceTencentCloudCamRoleSSORead,
-		Update: resourceTencentCloudCamRoleSSOUpdate,
-		Delete: resourceTencentCloudCamRoleSSODelete,
+		CreateContext: resourceTencentCloudCamRoleSSOCreate,
+		ReadContext:   resourceTencentCloudCamRoleSSORead,
+		UpdateContext: resourceTencentCloudCamRoleSSOUpdate,
+		DeleteContext: resourceTencentCloudCamRoleSSODelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,7 +81,7 @@ func resourceTencentCloudCamRoleSSO() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCamRoleSSOCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamRoleSSOCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_role_sso.create")()
 
 	logId := getLogId(contextNil)
@@ -86,8 +93,8 @@ func resourceTencentCloudCamRoleSSOCreate(d *schema.ResourceData, meta interface
 	request.Description = helper.String(d.Get("description").(string))
 	request.ClientId = helper.InterfacesStringsPoint(d.Get("client_ids").(*schema.Set).List())
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().CreateOIDCConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().CreateOIDCConfigWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -100,21 +107,21 @@ func resourceTencentCloudCamRoleSSOCreate(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create CAM role SSO failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(d.Get("name").(string))
-	return resourceTencentCloudCamRoleSSORead(d, meta)
+	return resourceTencentCloudCamRoleSSORead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamRoleSSORead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamRoleSSORead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_role_sso.read")()
 
 	logId := getLogId(contextNil)
 	request := cam.NewDescribeOIDCConfigRequest()
 	request.Name = helper.String(d.Id())
 	var response *cam.DescribeOIDCConfigResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DescribeOIDCConfig(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DescribeOIDCConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -123,7 +130,7 @@ func resourceTencentCloudCamRoleSSORead(d *schema.ResourceData, meta interface{}
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM role SSO failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if response.Response == nil {
@@ -144,12 +151,12 @@ func resourceTencentCloudCamRoleSSORead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudCamRoleSSOUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamRoleSSOUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_role_sso.update")()
 	logId := getLogId(contextNil)
 	request := cam.NewUpdateOIDCConfigRequest()
 	if d.HasChange("name") {
-		return fmt.Errorf("not support change name")
+		return diag.Errorf("not support change name")
 	}
 	request.Name = helper.String(d.Id())
 	if d.HasChange("identity_url") || d.HasChange("identity_key") || d.HasChange("description") || d.HasChange("client_ids") {
@@ -159,8 +166,8 @@ func resourceTencentCloudCamRoleSSOUpdate(d *schema.ResourceData, meta interface
 		request.ClientId = helper.InterfacesStringsPoint(d.Get("client_ids").(*schema.Set).List())
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateOIDCConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateOIDCConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -168,20 +175,20 @@ func resourceTencentCloudCamRoleSSOUpdate(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update CAM Role SSO failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCamRoleSSORead(d, meta)
+	return resourceTencentCloudCamRoleSSORead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamRoleSSODelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamRoleSSODelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_role_sso.delete")()
 	logId := getLogId(contextNil)
 	request := cam.NewDeleteOIDCConfigRequest()
 	name := d.Id()
 	request.Name = helper.String(name)
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DeleteOIDCConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DeleteOIDCConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -189,7 +196,7 @@ func resourceTencentCloudCamRoleSSODelete(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s disable cam sso failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -28,6 +28,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -37,12 +38,17 @@ import (
 
 func resourceTencentCloudCamSAMLProvider() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamSAMLProviderCreate,
-		Read:   resourceTencentCloudCamSAMLProviderRead,
-		Update: resourceTencentCloudCamSAMLProviderUpdate,
-		Delete: resourceTencentCloudCamSAMLProviderDelete,
+		CreateContext: resourceTencentCloudCamSAMLProviderCreate,
+		ReadContext:   resourceTencentCloudCamSAMLProviderRead,
+		UpdateContext: resourceTencentCloudCamSAMLProviderUpdate,
+		DeleteContext: resourceTencentCloudCamSAMLProviderDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -81,7 +87,7 @@ func resourceTencentCloudCamSAMLProvider() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCamSAMLProviderCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamSAMLProviderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_saml_provider.create")()
 
 	logId := getLogId(contextNil)
@@ -95,8 +101,8 @@ func resourceTencentCloudCamSAMLProviderCreate(d *schema.ResourceData, meta inte
 	}
 
 	var response *cam.CreateSAMLProviderResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().CreateSAMLProvider(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().CreateSAMLProviderWithContext(ctx, request)
 		if e != nil {
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 				errCode := ee.GetCode()
@@ -117,23 +123,23 @@ func resourceTencentCloudCamSAMLProviderCreate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create CAM SAML provider failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if response.Response.ProviderArn == nil {
-		return fmt.Errorf("CAM SAML provider id is nil")
+		return diag.Errorf("CAM SAML provider id is nil")
 	}
 
 	d.SetId(d.Get("name").(string))
 	_ = d.Set("provider_arn", *response.Response.ProviderArn)
 
 	//get really instance then read
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	samlProviderId := d.Id()
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, e := camService.DescribeSAMLProviderById(ctx, samlProviderId)
 		if e != nil {
 			return retryError(e)
@@ -145,26 +151,26 @@ func resourceTencentCloudCamSAMLProviderCreate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM SAML provider failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	time.Sleep(10 * time.Second)
 
-	return resourceTencentCloudCamSAMLProviderRead(d, meta)
+	return resourceTencentCloudCamSAMLProviderRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamSAMLProviderRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamSAMLProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_saml_provider.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	samlProviderId := d.Id()
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var instance *cam.GetSAMLProviderResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := camService.DescribeSAMLProviderById(ctx, samlProviderId)
 		if e != nil {
 			return retryError(e)
@@ -174,7 +180,7 @@ func resourceTencentCloudCamSAMLProviderRead(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM SAML provider failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil || instance.Response == nil || instance.Response.Name == nil {
@@ -192,7 +198,7 @@ func resourceTencentCloudCamSAMLProviderRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudCamSAMLProviderUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamSAMLProviderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_saml_provider.update")()
 
 	logId := getLogId(contextNil)
@@ -213,8 +219,8 @@ func resourceTencentCloudCamSAMLProviderUpdate(d *schema.ResourceData, meta inte
 	}
 
 	if changeFlag {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			response, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateSAMLProvider(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			response, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateSAMLProviderWithContext(ctx, request)
 
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -228,14 +234,14 @@ func resourceTencentCloudCamSAMLProviderUpdate(d *schema.ResourceData, meta inte
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update CAM SAML provider description failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCamSAMLProviderRead(d, meta)
+	return resourceTencentCloudCamSAMLProviderRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamSAMLProviderDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamSAMLProviderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_saml_provider.delete")()
 
 	logId := getLogId(contextNil)
@@ -243,8 +249,8 @@ func resourceTencentCloudCamSAMLProviderDelete(d *schema.ResourceData, meta inte
 	SAMLProviderId := d.Id()
 	request := cam.NewDeleteSAMLProviderRequest()
 	request.Name = &SAMLProviderId
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DeleteSAMLProvider(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DeleteSAMLProviderWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s reason[%s]\n", logId, e.Error())
 			return retryError(e)
@@ -253,7 +259,7 @@ func resourceTencentCloudCamSAMLProviderDelete(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete CAM SAML provider failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
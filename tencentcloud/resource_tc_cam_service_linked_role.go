@@ -13,6 +13,14 @@ resource "tencentcloud_cam_service_linked_role" "service_linked_role" {
   }
 }
 
+```
+
+Import
+
+cam service_linked_role can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_cam_service_linked_role.service_linked_role 4611686018441060141
 ```
 */
 package tencentcloud
@@ -21,7 +29,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -30,10 +40,18 @@ import (
 
 func resourceTencentCloudCamServiceLinkedRole() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudCamServiceLinkedRoleRead,
-		Create: resourceTencentCloudCamServiceLinkedRoleCreate,
-		Update: resourceTencentCloudCamServiceLinkedRoleUpdate,
-		Delete: resourceTencentCloudCamServiceLinkedRoleDelete,
+		ReadContext:   resourceTencentCloudCamServiceLinkedRoleRead,
+		CreateContext: resourceTencentCloudCamServiceLinkedRoleCreate,
+		UpdateContext: resourceTencentCloudCamServiceLinkedRoleUpdate,
+		DeleteContext: resourceTencentCloudCamServiceLinkedRoleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(9 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"qcs_service_name": {
 				Type:        schema.TypeSet,
@@ -63,7 +81,7 @@ func resourceTencentCloudCamServiceLinkedRole() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCamServiceLinkedRoleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamServiceLinkedRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_service_linked_role.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -103,8 +121,8 @@ func resourceTencentCloudCamServiceLinkedRoleCreate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().CreateServiceLinkedRole(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().CreateServiceLinkedRoleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -117,42 +135,42 @@ func resourceTencentCloudCamServiceLinkedRoleCreate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cam serviceLinkedRole failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	roleId = *response.Response.RoleId
 
 	d.SetId(roleId)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::cam:%s:uin/:RoleId/%s", region, roleId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudCamServiceLinkedRoleRead(d, meta)
+	return resourceTencentCloudCamServiceLinkedRoleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamServiceLinkedRoleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamServiceLinkedRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_service_linked_role.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := CamService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	roleId := d.Id()
 
 	serviceLinkedRole, err := service.DescribeCamServiceLinkedRole(ctx, roleId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if serviceLinkedRole == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `serviceLinkedRole` %s does not exist", roleId)
+		return diag.Errorf("resource `serviceLinkedRole` %s does not exist", roleId)
 	}
 
 	// if qcsServiceName != "" {
@@ -171,19 +189,19 @@ func resourceTencentCloudCamServiceLinkedRoleRead(d *schema.ResourceData, meta i
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "cam", "RoleId", tcClient.Region, roleId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudCamServiceLinkedRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamServiceLinkedRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_service_linked_role.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	request := cam.NewUpdateRoleDescriptionRequest()
 
 	roleId := d.Id()
@@ -191,11 +209,11 @@ func resourceTencentCloudCamServiceLinkedRoleUpdate(d *schema.ResourceData, meta
 	request.RoleId = &roleId
 
 	if d.HasChange("qcs_service_name") {
-		return fmt.Errorf("`qcs_service_name` do not support change now.")
+		return diag.Errorf("`qcs_service_name` do not support change now.")
 	}
 
 	if d.HasChange("custom_suffix") {
-		return fmt.Errorf("`custom_suffix` do not support change now.")
+		return diag.Errorf("`custom_suffix` do not support change now.")
 	}
 
 	if d.HasChange("description") {
@@ -204,8 +222,8 @@ func resourceTencentCloudCamServiceLinkedRoleUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateRoleDescription(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateRoleDescriptionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -216,7 +234,7 @@ func resourceTencentCloudCamServiceLinkedRoleUpdate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cam serviceLinkedRole failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
@@ -226,19 +244,19 @@ func resourceTencentCloudCamServiceLinkedRoleUpdate(d *schema.ResourceData, meta
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("cam", "RoleId", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCamServiceLinkedRoleRead(d, meta)
+	return resourceTencentCloudCamServiceLinkedRoleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamServiceLinkedRoleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamServiceLinkedRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_service_linked_role.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CamService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -246,18 +264,18 @@ func resourceTencentCloudCamServiceLinkedRoleDelete(d *schema.ResourceData, meta
 
 	serviceLinkedRole, err := service.DescribeCamServiceLinkedRole(ctx, roleId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if serviceLinkedRole == nil || serviceLinkedRole.RoleName == nil {
-		return fmt.Errorf("When querying serviceLinkedRole, an error occurs")
+		return diag.Errorf("When querying serviceLinkedRole, an error occurs")
 	}
 
 	deletionTaskId, err := service.DeleteCamServiceLinkedRoleById(ctx, *serviceLinkedRole.RoleName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, _ := service.DescribeCamServiceLinkedRoleDeleteStatus(ctx, deletionTaskId)
 		// if errRet != nil {
 		// 	return retryError(errRet, InternalError)
@@ -276,7 +294,7 @@ func resourceTencentCloudCamServiceLinkedRoleDelete(d *schema.ResourceData, meta
 		return resource.RetryableError(fmt.Errorf("serviceLinkedRole status is %v, retry...", *instance.Status))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
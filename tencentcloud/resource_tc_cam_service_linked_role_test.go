@@ -28,6 +28,12 @@ func TestAccTencentCloudCamServiceLinkedRoleResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_cam_service_linked_role.service_linked_role", "tags.createdBy", "terraform"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_cam_service_linked_role.service_linked_role",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"qcs_service_name", "custom_suffix"},
+			},
 		},
 	})
 }
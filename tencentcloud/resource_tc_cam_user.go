@@ -38,6 +38,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -47,10 +48,15 @@ import (
 
 func resourceTencentCloudCamUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamUserCreate,
-		Read:   resourceTencentCloudCamUserRead,
-		Update: resourceTencentCloudCamUserUpdate,
-		Delete: resourceTencentCloudCamUserDelete,
+		CreateContext: resourceTencentCloudCamUserCreate,
+		ReadContext:   resourceTencentCloudCamUserRead,
+		UpdateContext: resourceTencentCloudCamUserUpdate,
+		DeleteContext: resourceTencentCloudCamUserDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
 			State: helper.ImportWithDefaultValue(map[string]interface{}{
 				"remark":              "",
@@ -153,7 +159,7 @@ func resourceTencentCloudCamUser() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCamUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_user.create")()
 
 	logId := getLogId(contextNil)
@@ -202,8 +208,8 @@ func resourceTencentCloudCamUserCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	var response *cam.AddUserResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().AddUser(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().AddUserWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -223,10 +229,10 @@ func resourceTencentCloudCamUserCreate(d *schema.ResourceData, meta interface{})
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create user failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if response.Response.Uid == nil {
-		return fmt.Errorf("[CHECK][CAM user][Create] check: CAM user id returns nil")
+		return diag.Errorf("[CHECK][CAM user][Create] check: CAM user id returns nil")
 	}
 
 	d.SetId(*response.Response.Name)
@@ -235,11 +241,11 @@ func resourceTencentCloudCamUserCreate(d *schema.ResourceData, meta interface{})
 	_ = d.Set("secret_id", *response.Response.SecretId)
 
 	//get really instance then read
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, e := camService.DescribeUserById(ctx, *response.Response.Name)
 		if e != nil {
 			return retryError(e)
@@ -251,7 +257,7 @@ func resourceTencentCloudCamUserCreate(d *schema.ResourceData, meta interface{})
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s wait for CAM user ready failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	//modify tags
@@ -260,19 +266,19 @@ func resourceTencentCloudCamUserCreate(d *schema.ResourceData, meta interface{})
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := BuildTagResourceName("cam", "uin", region, helper.UInt64ToStr(*response.Response.Uin))
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	time.Sleep(10 * time.Second)
-	return resourceTencentCloudCamUserRead(d, meta)
+	return resourceTencentCloudCamUserRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_user.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	deleteForce := false
 	if v, ok := d.GetOkExists("force_delete"); ok {
@@ -285,7 +291,7 @@ func resourceTencentCloudCamUserRead(d *schema.ResourceData, meta interface{}) e
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var instance *cam.GetUserResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := camService.DescribeUserById(ctx, userId)
 		if e != nil {
 			return retryError(e)
@@ -295,7 +301,7 @@ func resourceTencentCloudCamUserRead(d *schema.ResourceData, meta interface{}) e
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM user failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil || instance.Response == nil || instance.Response.Uid == nil {
@@ -321,18 +327,18 @@ func resourceTencentCloudCamUserRead(d *schema.ResourceData, meta interface{}) e
 	region := meta.(*TencentCloudClient).apiV3Conn.Region
 	tags, err := tagService.DescribeResourceTags(ctx, "cam", "uin", region, helper.UInt64ToStr(*instance.Response.Uin))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudCamUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_user.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	userId := d.Id()
 
@@ -383,8 +389,8 @@ func resourceTencentCloudCamUserUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if len(updateAttrs) > 0 {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			response, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateUser(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			response, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateUserWithContext(ctx, request)
 
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -398,7 +404,7 @@ func resourceTencentCloudCamUserUpdate(d *schema.ResourceData, meta interface{})
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update CAM user description failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -409,7 +415,7 @@ func resourceTencentCloudCamUserUpdate(d *schema.ResourceData, meta interface{})
 		}
 
 		var instance *cam.GetUserResponse
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			result, e := camService.DescribeUserById(ctx, userId)
 			if e != nil {
 				return retryError(e)
@@ -419,7 +425,7 @@ func resourceTencentCloudCamUserUpdate(d *schema.ResourceData, meta interface{})
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s read CAM user failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 		if instance == nil || instance.Response == nil || instance.Response.Uid == nil {
@@ -436,7 +442,7 @@ func resourceTencentCloudCamUserUpdate(d *schema.ResourceData, meta interface{})
 		resourceName := BuildTagResourceName("cam", "uin", region, helper.UInt64ToStr(*instance.Response.Uin))
 		err = tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -444,7 +450,7 @@ func resourceTencentCloudCamUserUpdate(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudCamUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_user.delete")()
 
 	logId := getLogId(contextNil)
@@ -461,8 +467,8 @@ func resourceTencentCloudCamUserDelete(d *schema.ResourceData, meta interface{})
 
 	request.Force = helper.BoolToInt64Pointer(deleteForce)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DeleteUser(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().DeleteUserWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s reason[%s]\n", logId, e.Error())
 			return retryError(e)
@@ -471,7 +477,7 @@ func resourceTencentCloudCamUserDelete(d *schema.ResourceData, meta interface{})
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete CAM user failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
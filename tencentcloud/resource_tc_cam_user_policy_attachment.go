@@ -55,18 +55,24 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCamUserPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamUserPolicyAttachmentCreate,
-		Read:   resourceTencentCloudCamUserPolicyAttachmentRead,
-		Delete: resourceTencentCloudCamUserPolicyAttachmentDelete,
+		CreateContext: resourceTencentCloudCamUserPolicyAttachmentCreate,
+		ReadContext:   resourceTencentCloudCamUserPolicyAttachmentRead,
+		DeleteContext: resourceTencentCloudCamUserPolicyAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -115,21 +121,21 @@ func resourceTencentCloudCamUserPolicyAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCamUserPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamUserPolicyAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_user_policy_attachment.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	userId, _, err := getUserId(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	policyId := d.Get("policy_id").(string)
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		e := camService.AddUserPolicyAttachment(ctx, userId, policyId)
 		if e != nil {
 			log.Printf("[CRITAL]%s reason[%s]\n", logId, e.Error())
@@ -139,15 +145,15 @@ func resourceTencentCloudCamUserPolicyAttachmentCreate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create CAM user policy attachment failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(userId + "#" + policyId)
+	d.SetId(helper.IdFormat(userId, policyId))
 
 	//get really instance then read
 
 	userPolicyAttachmentId := d.Id()
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, e := camService.DescribeUserPolicyAttachmentById(ctx, userPolicyAttachmentId)
 		if e != nil {
 			return retryError(e)
@@ -159,18 +165,18 @@ func resourceTencentCloudCamUserPolicyAttachmentCreate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM user policy attachment failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	time.Sleep(10 * time.Second)
-	return resourceTencentCloudCamUserPolicyAttachmentRead(d, meta)
+	return resourceTencentCloudCamUserPolicyAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamUserPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamUserPolicyAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_user_policy_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	userPolicyAttachmentId := d.Id()
 
@@ -178,7 +184,7 @@ func resourceTencentCloudCamUserPolicyAttachmentRead(d *schema.ResourceData, met
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var instance *cam.AttachPolicyInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := camService.DescribeUserPolicyAttachmentById(ctx, userPolicyAttachmentId)
 		if e != nil {
 			return retryError(e)
@@ -188,7 +194,7 @@ func resourceTencentCloudCamUserPolicyAttachmentRead(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM user policy attachment failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -199,7 +205,7 @@ func resourceTencentCloudCamUserPolicyAttachmentRead(d *schema.ResourceData, met
 	//split id
 	userId, policyId, e := camService.decodeCamPolicyAttachmentId(userPolicyAttachmentId)
 	if e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 	_ = d.Set("user_name", userId)
 	_ = d.Set("policy_id", strconv.Itoa(int(policyId)))
@@ -210,18 +216,18 @@ func resourceTencentCloudCamUserPolicyAttachmentRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudCamUserPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamUserPolicyAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_user_policy_attachment.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	userPolicyAttachmentId := d.Id()
 
 	camService := CamService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := camService.DeleteUserPolicyAttachmentById(ctx, userPolicyAttachmentId)
 		if e != nil {
 			log.Printf("[CRITAL]%s reason[%s]\n", logId, e.Error())
@@ -231,7 +237,7 @@ func resourceTencentCloudCamUserPolicyAttachmentDelete(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete CAM user policy attachment failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
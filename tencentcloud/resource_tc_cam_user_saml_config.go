@@ -24,10 +24,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
@@ -36,12 +37,16 @@ import (
 
 func resourceTencentCloudCamUserSamlConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamUserSamlConfigCreate,
-		Read:   resourceTencentCloudCamUserSamlConfigRead,
-		Update: resourceTencentCloudCamUserSamlConfigUpdate,
-		Delete: resourceTencentCloudCamUserSamlConfigDelete,
+		CreateContext: resourceTencentCloudCamUserSamlConfigCreate,
+		ReadContext:   resourceTencentCloudCamUserSamlConfigRead,
+		UpdateContext: resourceTencentCloudCamUserSamlConfigUpdate,
+		DeleteContext: resourceTencentCloudCamUserSamlConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"saml_metadata_document": {
@@ -76,7 +81,7 @@ func resourceTencentCloudCamUserSamlConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCamUserSamlConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamUserSamlConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_user_saml_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -92,15 +97,15 @@ func resourceTencentCloudCamUserSamlConfigCreate(d *schema.ResourceData, meta in
 		if b {
 			metadata, err := ReadFromFile(v.(string))
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			saml = string(metadata)
 		}
 		request.SAMLMetadataDocument = helper.String(StringToBase64(saml))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().CreateUserSAMLConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().CreateUserSAMLConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -111,25 +116,25 @@ func resourceTencentCloudCamUserSamlConfigCreate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cam userSamlConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*response.Response.RequestId)
-	return resourceTencentCloudCamUserSamlConfigRead(d, meta)
+	return resourceTencentCloudCamUserSamlConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamUserSamlConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamUserSamlConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_user_saml_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CamService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	samlConfig, err := service.DescribeCamUserSamlConfigById(ctx)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if samlConfig == nil || samlConfig.Response == nil || *samlConfig.Response.Status == 2 {
@@ -142,7 +147,7 @@ func resourceTencentCloudCamUserSamlConfigRead(d *schema.ResourceData, meta inte
 	if userSamlConfig.SAMLMetadata != nil {
 		metadata, err := Base64ToString(*userSamlConfig.SAMLMetadata)
 		if err != nil {
-			return fmt.Errorf("`SamlConfig.SAMLMetadata` %s does not be decoded to xml", *userSamlConfig.SAMLMetadata)
+			return diag.Errorf("`SamlConfig.SAMLMetadata` %s does not be decoded to xml", *userSamlConfig.SAMLMetadata)
 		}
 		_ = d.Set("saml_metadata_document", metadata)
 	}
@@ -154,19 +159,19 @@ func resourceTencentCloudCamUserSamlConfigRead(d *schema.ResourceData, meta inte
 	output, ok := d.GetOk("metadata_document_files")
 	if ok && output.(string) != "" {
 		if err = writeToFile(output.(string), d.Get("saml_metadata_document")); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceTencentCloudCamUserSamlConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamUserSamlConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_user_saml_config.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := cam.NewUpdateUserSAMLConfigRequest()
 
@@ -177,7 +182,7 @@ func resourceTencentCloudCamUserSamlConfigUpdate(d *schema.ResourceData, meta in
 			if b {
 				metadata, err := ReadFromFile(v.(string))
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				saml = string(metadata)
 			}
@@ -188,7 +193,7 @@ func resourceTencentCloudCamUserSamlConfigUpdate(d *schema.ResourceData, meta in
 	service := CamService{client: meta.(*TencentCloudClient).apiV3Conn}
 	samlConfig, describeErr := service.DescribeCamUserSamlConfigById(ctx)
 	if describeErr != nil {
-		return describeErr
+		return diag.FromErr(describeErr)
 	}
 	if *samlConfig.Response.Status == 2 {
 		request.Operate = helper.String("enable")
@@ -196,8 +201,8 @@ func resourceTencentCloudCamUserSamlConfigUpdate(d *schema.ResourceData, meta in
 		request.Operate = helper.String("updateSAML")
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateUserSAMLConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCamClient().UpdateUserSAMLConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -207,23 +212,23 @@ func resourceTencentCloudCamUserSamlConfigUpdate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update cam userSamlConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCamUserSamlConfigRead(d, meta)
+	return resourceTencentCloudCamUserSamlConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCamUserSamlConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCamUserSamlConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cam_user_saml_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CamService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	if err := service.DeleteCamUserSamlConfigById(ctx); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -59,7 +59,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cat/v20180409"
@@ -68,12 +70,17 @@ import (
 
 func resourceTencentCloudCatTaskSet() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudCatTaskSetRead,
-		Create: resourceTencentCloudCatTaskSetCreate,
-		Update: resourceTencentCloudCatTaskSetUpdate,
-		Delete: resourceTencentCloudCatTaskSetDelete,
+		ReadContext:   resourceTencentCloudCatTaskSetRead,
+		CreateContext: resourceTencentCloudCatTaskSetCreate,
+		UpdateContext: resourceTencentCloudCatTaskSetUpdate,
+		DeleteContext: resourceTencentCloudCatTaskSetDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(21 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"batch_tasks": {
@@ -157,7 +164,7 @@ func resourceTencentCloudCatTaskSet() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCatTaskSetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCatTaskSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cat_task_set.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -211,8 +218,8 @@ func resourceTencentCloudCatTaskSetCreate(d *schema.ResourceData, meta interface
 		request.Cron = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCatClient().CreateProbeTasks(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCatClient().CreateProbeTasksWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -225,24 +232,24 @@ func resourceTencentCloudCatTaskSetCreate(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cat taskSet failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	taskId = *response.Response.TaskIDs[0]
 
 	service := CatService{client: meta.(*TencentCloudClient).apiV3Conn}
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::cat:%s:uin/:TaskId/%s", region, taskId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	err = resource.Retry(1*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, errRet := service.DescribeCatTaskSet(ctx, taskId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -256,20 +263,20 @@ func resourceTencentCloudCatTaskSetCreate(d *schema.ResourceData, meta interface
 		return resource.RetryableError(fmt.Errorf("taskSet status is %v, retry...", *instance.Status))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(taskId)
 
-	return resourceTencentCloudCatTaskSetRead(d, meta)
+	return resourceTencentCloudCatTaskSetRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCatTaskSetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCatTaskSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cat_task_set.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CatService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -278,12 +285,12 @@ func resourceTencentCloudCatTaskSetRead(d *schema.ResourceData, meta interface{}
 	taskSet, err := service.DescribeCatTaskSet(ctx, taskId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if taskSet == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `taskSet` %s does not exist", taskId)
+		return diag.Errorf("resource `taskSet` %s does not exist", taskId)
 	}
 
 	if taskSet != nil {
@@ -337,19 +344,19 @@ func resourceTencentCloudCatTaskSetRead(d *schema.ResourceData, meta interface{}
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "cat", "TaskId", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudCatTaskSetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCatTaskSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cat_task_set.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := cat.NewUpdateProbeTaskConfigurationListRequest()
 
@@ -380,19 +387,19 @@ func resourceTencentCloudCatTaskSetUpdate(d *schema.ResourceData, meta interface
 	}
 
 	if d.HasChange("batch_tasks") {
-		return fmt.Errorf("`batch_tasks` do not support change now.")
+		return diag.Errorf("`batch_tasks` do not support change now.")
 	}
 
 	if d.HasChange("task_type") {
-		return fmt.Errorf("`task_type` do not support change now.")
+		return diag.Errorf("`task_type` do not support change now.")
 	}
 
 	if d.HasChange("task_category") {
-		return fmt.Errorf("`task_category` do not support change now.")
+		return diag.Errorf("`task_category` do not support change now.")
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCatClient().UpdateProbeTaskConfigurationList(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCatClient().UpdateProbeTaskConfigurationListWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -404,7 +411,7 @@ func resourceTencentCloudCatTaskSetUpdate(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cat taskSet failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
@@ -414,29 +421,29 @@ func resourceTencentCloudCatTaskSetUpdate(d *schema.ResourceData, meta interface
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("cat", "TaskId", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCatTaskSetRead(d, meta)
+	return resourceTencentCloudCatTaskSetRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCatTaskSetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCatTaskSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cat_task_set.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CatService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	taskId := d.Id()
 
 	if err := service.DeleteCatTaskSetById(ctx, taskId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err := resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		instance, errRet := service.DescribeCatTaskSet(ctx, taskId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -453,7 +460,7 @@ func resourceTencentCloudCatTaskSetDelete(d *schema.ResourceData, meta interface
 		return resource.RetryableError(fmt.Errorf("taskSet status is %v, retry...", *instance.Status))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -28,7 +28,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -36,11 +38,15 @@ import (
 
 func resourceTencentCloudCbsDiskBackup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCbsDiskBackupCreate,
-		Read:   resourceTencentCloudCbsDiskBackupRead,
-		Delete: resourceTencentCloudCbsDiskBackupDelete,
+		CreateContext: resourceTencentCloudCbsDiskBackupCreate,
+		ReadContext:   resourceTencentCloudCbsDiskBackupRead,
+		DeleteContext: resourceTencentCloudCbsDiskBackupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"disk_id": {
@@ -60,12 +66,12 @@ func resourceTencentCloudCbsDiskBackup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCbsDiskBackupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsDiskBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_disk_backup.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		diskId         string
@@ -85,7 +91,7 @@ func resourceTencentCloudCbsDiskBackupCreate(d *schema.ResourceData, meta interf
 		return nil
 	}
 	d.SetId(diskBackupId)
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		diskBackup, e := service.DescribeCbsDiskBackupById(ctx, diskBackupId)
 		if e != nil {
 			return retryError(e)
@@ -97,19 +103,19 @@ func resourceTencentCloudCbsDiskBackupCreate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cbs DiskBackup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCbsDiskBackupRead(d, meta)
+	return resourceTencentCloudCbsDiskBackupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCbsDiskBackupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsDiskBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_disk_backup.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CbsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -117,7 +123,7 @@ func resourceTencentCloudCbsDiskBackupRead(d *schema.ResourceData, meta interfac
 
 	DiskBackup, err := service.DescribeCbsDiskBackupById(ctx, diskBackupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if DiskBackup == nil {
@@ -137,21 +143,21 @@ func resourceTencentCloudCbsDiskBackupRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudCbsDiskBackupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsDiskBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_disk_backup.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CbsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	diskBackupId := d.Id()
 
 	if err := service.DeleteCbsDiskBackupById(ctx, diskBackupId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		diskBackup, e := service.DescribeCbsDiskBackupById(ctx, diskBackupId)
 		if e != nil {
 			return retryError(e)
@@ -163,7 +169,7 @@ func resourceTencentCloudCbsDiskBackupDelete(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete cbs DiskBackup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
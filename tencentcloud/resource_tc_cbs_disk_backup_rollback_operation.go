@@ -14,20 +14,23 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCbsDiskBackupRollbackOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCbsDiskBackupRollbackOperationCreate,
-		Read:   resourceTencentCloudCbsDiskBackupRollbackOperationRead,
-		Delete: resourceTencentCloudCbsDiskBackupRollbackOperationDelete,
+		CreateContext: resourceTencentCloudCbsDiskBackupRollbackOperationCreate,
+		ReadContext:   resourceTencentCloudCbsDiskBackupRollbackOperationRead,
+		DeleteContext: resourceTencentCloudCbsDiskBackupRollbackOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"disk_backup_id": {
@@ -51,11 +54,11 @@ func resourceTencentCloudCbsDiskBackupRollbackOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCbsDiskBackupRollbackOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsDiskBackupRollbackOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_disk_backup_rollback_operation.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	diskBackupId := d.Get("disk_backup_id").(string)
 	diskId := d.Get("disk_id").(string)
@@ -65,11 +68,11 @@ func resourceTencentCloudCbsDiskBackupRollbackOperationCreate(d *schema.Resource
 	}
 
 	if err := cbsService.ApplyDiskBackup(ctx, diskBackupId, diskId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// deal with state sync delay
 	time.Sleep(time.Second * 1)
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		disk, e := cbsService.DescribeDiskById(ctx, diskId)
 		if e != nil {
 			return retryError(e)
@@ -80,39 +83,39 @@ func resourceTencentCloudCbsDiskBackupRollbackOperationCreate(d *schema.Resource
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(diskBackupId + FILED_SP + diskId)
+	d.SetId(helper.IdFormat(diskBackupId, diskId))
 
-	return resourceTencentCloudCbsDiskBackupRollbackOperationRead(d, meta)
+	return resourceTencentCloudCbsDiskBackupRollbackOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCbsDiskBackupRollbackOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsDiskBackupRollbackOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_disk_backup_rollback_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cbsService := CbsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	diskId := idSplit[1]
 
 	disk, err := cbsService.DescribeDiskById(ctx, diskId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("is_rollback_completed", !*disk.Rollbacking)
 	return nil
 }
 
-func resourceTencentCloudCbsDiskBackupRollbackOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsDiskBackupRollbackOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_disk_backup_rollback_operation.delete")()
 
 	return nil
@@ -24,9 +24,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
@@ -34,12 +36,17 @@ import (
 
 func resourceTencentCloudCbsSnapshot() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCbsSnapshotCreate,
-		Read:   resourceTencentCloudCbsSnapshotRead,
-		Update: resourceTencentCloudCbsSnapshotUpdate,
-		Delete: resourceTencentCloudCbsSnapshotDelete,
+		CreateContext: resourceTencentCloudCbsSnapshotCreate,
+		ReadContext:   resourceTencentCloudCbsSnapshotRead,
+		UpdateContext: resourceTencentCloudCbsSnapshotUpdate,
+		DeleteContext: resourceTencentCloudCbsSnapshotDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -90,11 +97,11 @@ func resourceTencentCloudCbsSnapshot() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCbsSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_snapshot.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	storageId := d.Get("storage_id").(string)
 	snapshotName := d.Get("snapshot_name").(string)
@@ -109,7 +116,7 @@ func resourceTencentCloudCbsSnapshotCreate(d *schema.ResourceData, meta interfac
 	}
 
 	snapshotId := ""
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		var e error
 		snapshotId, e = cbsService.CreateSnapshot(ctx, storageId, snapshotName, tags)
 		if e != nil {
@@ -120,7 +127,7 @@ func resourceTencentCloudCbsSnapshotCreate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cbs snapshot failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
@@ -128,11 +135,11 @@ func resourceTencentCloudCbsSnapshotCreate(d *schema.ResourceData, meta interfac
 		tagService := &TagService{client: tcClient}
 		resourceName := BuildTagResourceName("cvm", "volume", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	err = resource.Retry(20*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		snapshot, e := cbsService.DescribeSnapshotById(ctx, snapshotId)
 		if e != nil {
 			return retryError(e)
@@ -150,18 +157,18 @@ func resourceTencentCloudCbsSnapshotCreate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cbs snapshot failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCbsSnapshotRead(d, meta)
+	return resourceTencentCloudCbsSnapshotRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCbsSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_snapshot.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	snapshotId := d.Id()
 	cbsService := CbsService{
@@ -170,7 +177,7 @@ func resourceTencentCloudCbsSnapshotRead(d *schema.ResourceData, meta interface{
 
 	var snapshot *cbs.Snapshot
 	var e error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		snapshot, e = cbsService.DescribeSnapshotById(ctx, snapshotId)
 		if e != nil {
 			return retryError(e)
@@ -179,7 +186,7 @@ func resourceTencentCloudCbsSnapshotRead(d *schema.ResourceData, meta interface{
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read cbs snapshot failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if snapshot == nil {
 		d.SetId("")
@@ -197,7 +204,7 @@ func resourceTencentCloudCbsSnapshotRead(d *schema.ResourceData, meta interface{
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "cvm", "volume", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("tags", tags)
@@ -205,11 +212,11 @@ func resourceTencentCloudCbsSnapshotRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTencentCloudCbsSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_snapshot.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	snapshotId := d.Id()
 
@@ -218,7 +225,7 @@ func resourceTencentCloudCbsSnapshotUpdate(d *schema.ResourceData, meta interfac
 		cbsService := CbsService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
 		}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			e := cbsService.ModifySnapshotName(ctx, snapshotId, snapshotName)
 			if e != nil {
 				return retryError(e)
@@ -227,7 +234,7 @@ func resourceTencentCloudCbsSnapshotUpdate(d *schema.ResourceData, meta interfac
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update cbs snapshot failed, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -241,25 +248,25 @@ func resourceTencentCloudCbsSnapshotUpdate(d *schema.ResourceData, meta interfac
 		resourceName := BuildTagResourceName("cvm", "volume", tcClient.Region, d.Id())
 		err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceTencentCloudCbsSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_snapshot.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	snapshotId := d.Id()
 	cbsService := CbsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := cbsService.DeleteSnapshot(ctx, snapshotId)
 		if e != nil {
 			return retryError(e)
@@ -268,7 +275,7 @@ func resourceTencentCloudCbsSnapshotDelete(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete cbs snapshot failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -9,25 +9,41 @@ resource "tencentcloud_cbs_snapshot_policy_attachment" "foo" {
   snapshot_policy_id = tencentcloud_cbs_snapshot_policy.policy.id
 }
 ```
+
+Import
+
+CBS snapshot policy attachment can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_cbs_snapshot_policy_attachment.foo disk-4hsh1xzr#asp-78m1v0jp
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCbsSnapshotPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCbsSnapshotPolicyAttachmentCreate,
-		Read:   resourceTencentCloudCbsSnapshotPolicyAttachmentRead,
-		Delete: resourceTencentCloudCbsSnapshotPolicyAttachmentDelete,
+		CreateContext: resourceTencentCloudCbsSnapshotPolicyAttachmentCreate,
+		ReadContext:   resourceTencentCloudCbsSnapshotPolicyAttachmentRead,
+		DeleteContext: resourceTencentCloudCbsSnapshotPolicyAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"storage_id": {
@@ -46,17 +62,17 @@ func resourceTencentCloudCbsSnapshotPolicyAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCbsSnapshotPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsSnapshotPolicyAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_snapshot_policy_attachment.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	storageId := d.Get("storage_id").(string)
 	policyId := d.Get("snapshot_policy_id").(string)
 	cbsService := CbsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		errRet := cbsService.AttachSnapshotPolicy(ctx, storageId, policyId)
 		if errRet != nil {
 			return retryError(errRet)
@@ -65,24 +81,24 @@ func resourceTencentCloudCbsSnapshotPolicyAttachmentCreate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s cbs storage policy attach failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(storageId + FILED_SP + policyId)
-	return resourceTencentCloudCbsSnapshotPolicyAttachmentRead(d, meta)
+	d.SetId(helper.IdFormat(storageId, policyId))
+	return resourceTencentCloudCbsSnapshotPolicyAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCbsSnapshotPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsSnapshotPolicyAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_snapshot_policy_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
-	idSplit := strings.Split(id, FILED_SP)
+	idSplit := helper.IdParse(id)
 	if len(idSplit) != 2 {
-		return fmt.Errorf("tencentcloud_cbs_snapshot_policy_attachment id is illegal: %s", id)
+		return diag.Errorf("tencentcloud_cbs_snapshot_policy_attachment id is illegal: %s", id)
 	}
 	storageId := idSplit[0]
 	policyId := idSplit[1]
@@ -91,7 +107,7 @@ func resourceTencentCloudCbsSnapshotPolicyAttachmentRead(d *schema.ResourceData,
 	}
 	var policy *cbs.AutoSnapshotPolicy
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		policy, errRet = cbsService.DescribeAttachedSnapshotPolicy(ctx, storageId, policyId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -100,7 +116,7 @@ func resourceTencentCloudCbsSnapshotPolicyAttachmentRead(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s cbs storage policy attach failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if policy == nil {
 		d.SetId("")
@@ -112,22 +128,22 @@ func resourceTencentCloudCbsSnapshotPolicyAttachmentRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudCbsSnapshotPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsSnapshotPolicyAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_snapshot_policy_attachment.delete")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
-	idSplit := strings.Split(id, FILED_SP)
+	idSplit := helper.IdParse(id)
 	if len(idSplit) != 2 {
-		return fmt.Errorf("tencentcloud_cbs_snapshot_policy_attachment id is illegal: %s", id)
+		return diag.Errorf("tencentcloud_cbs_snapshot_policy_attachment id is illegal: %s", id)
 	}
 	storageId := idSplit[0]
 	policyId := idSplit[1]
 	cbsService := CbsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		errRet := cbsService.UnattachSnapshotPolicy(ctx, storageId, policyId)
 		if errRet != nil {
 			return retryError(errRet)
@@ -136,7 +152,7 @@ func resourceTencentCloudCbsSnapshotPolicyAttachmentDelete(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s cbs storage policy unattach failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -4,11 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func TestAccTencentCloudCbsSnapshotPolicyAttachment(t *testing.T) {
@@ -27,6 +27,11 @@ func TestAccTencentCloudCbsSnapshotPolicyAttachment(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_cbs_snapshot_policy_attachment.foo", "snapshot_policy_id"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_cbs_snapshot_policy_attachment.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -43,7 +48,7 @@ func testAccCheckCbsSnapshotPolicyAttachmentDestroy(s *terraform.State) error {
 			continue
 		}
 		id := rs.Primary.ID
-		idSplit := strings.Split(id, FILED_SP)
+		idSplit := helper.IdParse(id)
 		if len(idSplit) != 2 {
 			return fmt.Errorf("tencentcloud_cbs_snapshot_policy_attachment id is illegal: %s", id)
 		}
@@ -74,7 +79,7 @@ func testAccCheckCbsSnapshotPolicyAttachmentExists(n string) resource.TestCheckF
 			return errors.New("cbs snapshot policy attachment id is not set")
 		}
 		id := rs.Primary.ID
-		idSplit := strings.Split(id, FILED_SP)
+		idSplit := helper.IdParse(id)
 		if len(idSplit) != 2 {
 			return fmt.Errorf("tencentcloud_cbs_snapshot_policy_attachment id is illegal: %s", id)
 		}
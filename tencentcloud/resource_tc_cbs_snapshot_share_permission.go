@@ -23,18 +23,19 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCbsSnapshotSharePermission() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCbsSnapshotSharePermissionCreate,
-		Read:   resourceTencentCloudCbsSnapshotSharePermissionRead,
-		Update: resourceTencentCloudCbsSnapshotSharePermissionUpdate,
-		Delete: resourceTencentCloudCbsSnapshotSharePermissionDelete,
+		CreateContext: resourceTencentCloudCbsSnapshotSharePermissionCreate,
+		ReadContext:   resourceTencentCloudCbsSnapshotSharePermissionRead,
+		UpdateContext: resourceTencentCloudCbsSnapshotSharePermissionUpdate,
+		DeleteContext: resourceTencentCloudCbsSnapshotSharePermissionDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"account_ids": {
@@ -54,12 +55,12 @@ func resourceTencentCloudCbsSnapshotSharePermission() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCbsSnapshotSharePermissionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsSnapshotSharePermissionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_snapshot_share_permission.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var snapshotId string
 	var accountIdsSet []interface{}
@@ -74,20 +75,20 @@ func resourceTencentCloudCbsSnapshotSharePermissionCreate(d *schema.ResourceData
 	service := CbsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	err := service.ModifySnapshotsSharePermission(ctx, snapshotId, SNAPSHOT_SHARE_PERMISSION_SHARE, helper.InterfacesStrings(accountIdsSet))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(snapshotId)
 
-	return resourceTencentCloudCbsSnapshotSharePermissionRead(d, meta)
+	return resourceTencentCloudCbsSnapshotSharePermissionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCbsSnapshotSharePermissionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsSnapshotSharePermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_snapshot_share_permission.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CbsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -95,7 +96,7 @@ func resourceTencentCloudCbsSnapshotSharePermissionRead(d *schema.ResourceData,
 
 	snapshotSharePermissions, err := service.DescribeCbsSnapshotSharePermissionById(ctx, snapshotId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	accountIds := make([]string, 0)
@@ -108,13 +109,13 @@ func resourceTencentCloudCbsSnapshotSharePermissionRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudCbsSnapshotSharePermissionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsSnapshotSharePermissionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_snapshot_share_permission.update")()
 	defer inconsistentCheck(d, meta)()
 
 	snapshotId := d.Id()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := CbsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	if d.HasChange("account_ids") {
 		old, new := d.GetChange("account_ids")
@@ -125,32 +126,32 @@ func resourceTencentCloudCbsSnapshotSharePermissionUpdate(d *schema.ResourceData
 		if len(add) > 0 {
 			addError := service.ModifySnapshotsSharePermission(ctx, snapshotId, SNAPSHOT_SHARE_PERMISSION_SHARE, helper.InterfacesStrings(add))
 			if addError != nil {
-				return addError
+				return diag.FromErr(addError)
 			}
 		}
 		if len(remove) > 0 {
 			removeError := service.ModifySnapshotsSharePermission(ctx, snapshotId, SNAPSHOT_SHARE_PERMISSION_CANCEL, helper.InterfacesStrings(remove))
 			if removeError != nil {
-				return removeError
+				return diag.FromErr(removeError)
 			}
 		}
 	}
 
-	return resourceTencentCloudCbsSnapshotSharePermissionRead(d, meta)
+	return resourceTencentCloudCbsSnapshotSharePermissionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCbsSnapshotSharePermissionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsSnapshotSharePermissionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_snapshot_share_permission.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CbsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	snapshotId := d.Id()
 	snapshotSharePermissions, err := service.DescribeCbsSnapshotSharePermissionById(ctx, snapshotId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	accountIds := make([]string, 0)
@@ -158,7 +159,7 @@ func resourceTencentCloudCbsSnapshotSharePermissionDelete(d *schema.ResourceData
 		accountIds = append(accountIds, *snapshotSharePermission.AccountId)
 	}
 	if err := service.ModifySnapshotsSharePermission(ctx, snapshotId, SNAPSHOT_SHARE_PERMISSION_CANCEL, accountIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
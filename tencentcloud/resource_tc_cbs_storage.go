@@ -32,7 +32,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
@@ -41,12 +43,17 @@ import (
 
 func resourceTencentCloudCbsStorage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCbsStorageCreate,
-		Read:   resourceTencentCloudCbsStorageRead,
-		Update: resourceTencentCloudCbsStorageUpdate,
-		Delete: resourceTencentCloudCbsStorageDelete,
+		CreateContext: resourceTencentCloudCbsStorageCreate,
+		ReadContext:   resourceTencentCloudCbsStorageRead,
+		UpdateContext: resourceTencentCloudCbsStorageUpdate,
+		DeleteContext: resourceTencentCloudCbsStorageDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(6 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -157,11 +164,11 @@ func resourceTencentCloudCbsStorage() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCbsStorageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsStorageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_storage.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cbsService := CbsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -198,7 +205,7 @@ func resourceTencentCloudCbsStorageCreate(d *schema.ResourceData, meta interface
 			periodInt64 := uint64(period.(int))
 			request.DiskChargePrepaid.Period = &periodInt64
 		} else {
-			return fmt.Errorf("CBS instance charge type prepaid period can not be empty when charge type is %s",
+			return diag.Errorf("CBS instance charge type prepaid period can not be empty when charge type is %s",
 				chargeType)
 		}
 		if renewFlag, ok := d.GetOk("prepaid_renew_flag"); ok {
@@ -217,8 +224,8 @@ func resourceTencentCloudCbsStorageCreate(d *schema.ResourceData, meta interface
 	}
 
 	storageId := ""
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, e := meta.(*TencentCloudClient).apiV3Conn.UseCbsClient().CreateDisks(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		response, e := meta.(*TencentCloudClient).apiV3Conn.UseCbsClient().CreateDisksWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -234,14 +241,14 @@ func resourceTencentCloudCbsStorageCreate(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cbs failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(storageId)
 
 	if v, ok := d.GetOk("disk_backup_quota"); ok {
 		err = cbsService.ModifyDiskBackupQuota(ctx, storageId, v.(int))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -250,12 +257,12 @@ func resourceTencentCloudCbsStorageCreate(d *schema.ResourceData, meta interface
 		tagService := &TagService{client: tcClient}
 		resourceName := BuildTagResourceName("cvm", "volume", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	// must wait for finishing creating disk
-	err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		storage, e := cbsService.DescribeDiskById(ctx, storageId)
 		if e != nil {
 			return retryError(e, InternalError)
@@ -266,18 +273,18 @@ func resourceTencentCloudCbsStorageCreate(d *schema.ResourceData, meta interface
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCbsStorageRead(d, meta)
+	return resourceTencentCloudCbsStorageRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCbsStorageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsStorageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_storage.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	storageId := d.Id()
 	cbsService := CbsService{
@@ -286,7 +293,7 @@ func resourceTencentCloudCbsStorageRead(d *schema.ResourceData, meta interface{}
 
 	var storage *cbs.Disk
 	var e error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		storage, e = cbsService.DescribeDiskById(ctx, storageId)
 		if e != nil {
 			return retryError(e)
@@ -295,7 +302,7 @@ func resourceTencentCloudCbsStorageRead(d *schema.ResourceData, meta interface{}
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read cbs failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if storage == nil {
 		d.SetId("")
@@ -322,7 +329,7 @@ func resourceTencentCloudCbsStorageRead(d *schema.ResourceData, meta interface{}
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "cvm", "volume", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("tags", tags)
@@ -330,18 +337,18 @@ func resourceTencentCloudCbsStorageRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsStorageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_storage.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	//only support update prepaid_period when upgrade chargeType
 	if d.HasChange("prepaid_period") && (!d.HasChange("charge_type") && d.Get("charge_type").(string) == CBS_CHARGE_TYPE_PREPAID) {
-		return fmt.Errorf("tencentcloud_cbs_storage renew is not support yet")
+		return diag.Errorf("tencentcloud_cbs_storage renew is not support yet")
 	}
 	if d.HasChange("charge_type") && d.Get("charge_type").(string) != CBS_CHARGE_TYPE_PREPAID {
-		return fmt.Errorf("tencentcloud_cbs_storage do not support downgrade instance")
+		return diag.Errorf("tencentcloud_cbs_storage do not support downgrade instance")
 	}
 
 	cbsService := CbsService{
@@ -365,7 +372,7 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 	}
 
 	if changed {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			e := cbsService.ModifyDiskAttributes(ctx, storageId, storageName, projectId)
 			if e != nil {
 				return retryError(e)
@@ -374,7 +381,7 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update cbs failed, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -383,10 +390,10 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 		oldValue := oldInterface.(int)
 		newValue := newInterface.(int)
 		if oldValue > newValue {
-			return fmt.Errorf("storage size must be greater than current storage size")
+			return diag.Errorf("storage size must be greater than current storage size")
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			e := cbsService.ResizeDisk(ctx, storageId, newValue)
 			if e != nil {
 				return retryError(e)
@@ -395,10 +402,10 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update cbs failed, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			storage, e := cbsService.DescribeDiskById(ctx, storageId)
 			if e != nil {
 				return retryError(e)
@@ -414,14 +421,14 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update cbs failed, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	if d.HasChange("snapshot_id") {
 		snapshotId := d.Get("snapshot_id").(string)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			e := cbsService.ApplySnapshot(ctx, storageId, snapshotId)
 			if e != nil {
 				return retryError(e)
@@ -430,10 +437,10 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update cbs failed, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			storage, e := cbsService.DescribeDiskById(ctx, storageId)
 			if e != nil {
 				return retryError(e)
@@ -445,14 +452,14 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update cbs failed, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	if d.HasChange("throughput_performance") {
 		throughputPerformance := d.Get("throughput_performance").(int)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			e := cbsService.ModifyThroughputPerformance(ctx, storageId, throughputPerformance)
 			if e != nil {
 				return retryError(e)
@@ -461,7 +468,7 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update cbs failed, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -470,7 +477,7 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 		diskBackupQuota := d.Get("disk_backup_quota").(int)
 		err := cbsService.ModifyDiskBackupQuota(ctx, storageId, diskBackupQuota)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -484,7 +491,7 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 		resourceName := BuildTagResourceName("cvm", "volume", tcClient.Region, d.Id())
 		err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -498,11 +505,11 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 		//only support postpaid to prepaid
 		err := cbsService.ModifyDiskChargeType(ctx, storageId, chargeType, renewFlag, period)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		//check charge Type
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			storage, e := cbsService.DescribeDiskById(ctx, storageId)
 			if e != nil {
 				return retryError(e)
@@ -514,7 +521,7 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update cbs failed, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 	} else {
@@ -524,16 +531,16 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 			//check
 
 			if chargeType != CBS_CHARGE_TYPE_PREPAID {
-				return fmt.Errorf("tencentcloud_cbs_storage update on prepaid_period or prepaid_renew_flag is only supported with charge type PREPAID")
+				return diag.Errorf("tencentcloud_cbs_storage update on prepaid_period or prepaid_renew_flag is only supported with charge type PREPAID")
 			}
 
 			//renew api
 			err := cbsService.ModifyDisksRenewFlag(ctx, storageId, renewFlag)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			//check renew flag
-			err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				storage, e := cbsService.DescribeDiskById(ctx, storageId)
 				if e != nil {
 					return retryError(e)
@@ -545,7 +552,7 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s update cbs failed, reason:%s\n ", logId, err.Error())
-				return err
+				return diag.FromErr(err)
 			}
 
 		}
@@ -556,11 +563,11 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudCbsStorageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsStorageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_storage.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	storageId := d.Id()
 	//check is force delete or not
@@ -570,7 +577,7 @@ func resourceTencentCloudCbsStorageDelete(d *schema.ResourceData, meta interface
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := cbsService.DeleteDiskById(ctx, storageId)
 		if e != nil {
 			return retryError(e, InternalError)
@@ -579,11 +586,11 @@ func resourceTencentCloudCbsStorageDelete(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete cbs failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	//check exist
-	err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		storage, errRet := cbsService.DescribeDiskById(ctx, storageId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -600,7 +607,7 @@ func resourceTencentCloudCbsStorageDelete(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if notExist || !forceDelete {
@@ -610,7 +617,7 @@ func resourceTencentCloudCbsStorageDelete(d *schema.ResourceData, meta interface
 	//exist in recycle
 
 	//delete again
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		errRet := cbsService.DeleteDiskById(ctx, storageId)
 		//when state is terminating, do not delete but check exist
 		if errRet != nil {
@@ -620,11 +627,11 @@ func resourceTencentCloudCbsStorageDelete(d *schema.ResourceData, meta interface
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//describe and check not exist
-	err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		storage, errRet := cbsService.DescribeDiskById(ctx, storageId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -636,7 +643,7 @@ func resourceTencentCloudCbsStorageDelete(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -24,7 +24,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
@@ -32,11 +34,15 @@ import (
 
 func resourceTencentCloudCbsStorageAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCbsStorageAttachmentCreate,
-		Read:   resourceTencentCloudCbsStorageAttachmentRead,
-		Delete: resourceTencentCloudCbsStorageAttachmentDelete,
+		CreateContext: resourceTencentCloudCbsStorageAttachmentCreate,
+		ReadContext:   resourceTencentCloudCbsStorageAttachmentRead,
+		DeleteContext: resourceTencentCloudCbsStorageAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -56,11 +62,11 @@ func resourceTencentCloudCbsStorageAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCbsStorageAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsStorageAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_storage_attachment.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	storageId := d.Get("storage_id").(string)
 	instanceId := d.Get("instance_id").(string)
@@ -69,7 +75,7 @@ func resourceTencentCloudCbsStorageAttachmentCreate(d *schema.ResourceData, meta
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		e := cbsService.AttachDisk(ctx, storageId, instanceId)
 		if e != nil {
 			return retryError(e)
@@ -78,12 +84,12 @@ func resourceTencentCloudCbsStorageAttachmentCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s cbs storage attach failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(storageId)
 
-	err = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		storage, e := cbsService.DescribeDiskById(ctx, storageId)
 		if e != nil {
 			return retryError(e)
@@ -102,18 +108,18 @@ func resourceTencentCloudCbsStorageAttachmentCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s cbs storage attach failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCbsStorageAttachmentRead(d, meta)
+	return resourceTencentCloudCbsStorageAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCbsStorageAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsStorageAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_storage_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	storageId := d.Id()
 	cbsService := CbsService{
@@ -122,7 +128,7 @@ func resourceTencentCloudCbsStorageAttachmentRead(d *schema.ResourceData, meta i
 
 	var storage *cbs.Disk
 	var e error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		storage, e = cbsService.DescribeDiskById(ctx, storageId)
 		if e != nil {
 			return retryError(e)
@@ -131,7 +137,7 @@ func resourceTencentCloudCbsStorageAttachmentRead(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s describe cbs storage attach failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if storage == nil || !*storage.Attached {
@@ -144,11 +150,11 @@ func resourceTencentCloudCbsStorageAttachmentRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudCbsStorageAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsStorageAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_storage_attachment.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	storageId := d.Id()
 	cbsService := CbsService{
@@ -156,7 +162,7 @@ func resourceTencentCloudCbsStorageAttachmentDelete(d *schema.ResourceData, meta
 	}
 
 	instanceId := ""
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		storage, e := cbsService.DescribeDiskById(ctx, storageId)
 		if e != nil {
 			return retryError(e)
@@ -170,14 +176,14 @@ func resourceTencentCloudCbsStorageAttachmentDelete(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s describe cbs storage attach failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instanceId == "" {
 		return nil
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := cbsService.DetachDisk(ctx, storageId, instanceId)
 		if e != nil {
 			return retryError(e)
@@ -186,10 +192,10 @@ func resourceTencentCloudCbsStorageAttachmentDelete(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s cbs storage detach failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		storage, e := cbsService.DescribeDiskById(ctx, storageId)
 		if e != nil {
 			return retryError(e)
@@ -204,7 +210,7 @@ func resourceTencentCloudCbsStorageAttachmentDelete(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s cbs storage detach failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -27,6 +27,7 @@ import (
 
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
@@ -35,10 +36,14 @@ import (
 
 func resourceTencentCloudCbsStorageSet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCbsStorageSetCreate,
-		Read:   resourceTencentCloudCbsStorageSetRead,
-		Update: resourceTencentCloudCbsStorageSetUpdate,
-		Delete: resourceTencentCloudCbsStorageSetDelete,
+		CreateContext: resourceTencentCloudCbsStorageSetCreate,
+		ReadContext:   resourceTencentCloudCbsStorageSetRead,
+		UpdateContext: resourceTencentCloudCbsStorageSetUpdate,
+		DeleteContext: resourceTencentCloudCbsStorageSetDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"storage_type": {
@@ -124,7 +129,7 @@ func resourceTencentCloudCbsStorageSet() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCbsStorageSetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsStorageSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_storage_set.create")()
 
 	logId := getLogId(contextNil)
@@ -161,8 +166,8 @@ func resourceTencentCloudCbsStorageSetCreate(d *schema.ResourceData, meta interf
 	request.DiskChargeType = &chargeType
 
 	storageIds := make([]*string, 0)
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, e := meta.(*TencentCloudClient).apiV3Conn.UseCbsClient().CreateDisks(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		response, e := meta.(*TencentCloudClient).apiV3Conn.UseCbsClient().CreateDisksWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -185,7 +190,7 @@ func resourceTencentCloudCbsStorageSetCreate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cbs failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("disk_ids", storageIds)
@@ -194,12 +199,12 @@ func resourceTencentCloudCbsStorageSetCreate(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudCbsStorageSetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsStorageSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_storage_set.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	storageId := d.Id()
 	cbsService := CbsService{
@@ -211,7 +216,7 @@ func resourceTencentCloudCbsStorageSetRead(d *schema.ResourceData, meta interfac
 
 	storageSet, errRet = cbsService.DescribeDiskSetByIds(ctx, storageId)
 	if errRet != nil {
-		return errRet
+		return diag.FromErr(errRet)
 	}
 	if storageSet == nil {
 		d.SetId("")
@@ -235,17 +240,17 @@ func resourceTencentCloudCbsStorageSetRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudCbsStorageSetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsStorageSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_storage_set.update")()
 
-	return fmt.Errorf("`tencentcloud_cbs_storage_set` do not support change now.")
+	return diag.Errorf("`tencentcloud_cbs_storage_set` do not support change now.")
 }
 
-func resourceTencentCloudCbsStorageSetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsStorageSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_storage_set.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	storageId := d.Id()
 
@@ -253,7 +258,7 @@ func resourceTencentCloudCbsStorageSetDelete(d *schema.ResourceData, meta interf
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := cbsService.DeleteDiskSetByIds(ctx, storageId)
 		if e != nil {
 			log.Printf("[CRITAL][first delete]%s api[%s] fail, reason[%s]\n",
@@ -270,7 +275,7 @@ func resourceTencentCloudCbsStorageSetDelete(d *schema.ResourceData, meta interf
 
 	if err != nil {
 		log.Printf("[CRITAL]%s delete cbs failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -21,6 +21,7 @@ import (
 
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
@@ -28,11 +29,15 @@ import (
 
 func resourceTencentCloudCbsStorageSetAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCbsStorageSetAttachmentCreate,
-		Read:   resourceTencentCloudCbsStorageSetAttachmentRead,
-		Delete: resourceTencentCloudCbsStorageSetAttachmentDelete,
+		CreateContext: resourceTencentCloudCbsStorageSetAttachmentCreate,
+		ReadContext:   resourceTencentCloudCbsStorageSetAttachmentRead,
+		DeleteContext: resourceTencentCloudCbsStorageSetAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -52,11 +57,11 @@ func resourceTencentCloudCbsStorageSetAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCbsStorageSetAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsStorageSetAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_storage_set_attachment.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	storageId := d.Get("storage_id").(string)
 	instanceId := d.Get("instance_id").(string)
@@ -65,7 +70,7 @@ func resourceTencentCloudCbsStorageSetAttachmentCreate(d *schema.ResourceData, m
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		e := cbsService.AttachDisk(ctx, storageId, instanceId)
 		if e != nil {
 			ee, ok := e.(*sdkErrors.TencentCloudSDKError)
@@ -79,7 +84,7 @@ func resourceTencentCloudCbsStorageSetAttachmentCreate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s cbs storage attach failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(storageId)
@@ -87,12 +92,12 @@ func resourceTencentCloudCbsStorageSetAttachmentCreate(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceTencentCloudCbsStorageSetAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsStorageSetAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_storage_set_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	storageId := d.Id()
 	cbsService := CbsService{
@@ -104,7 +109,7 @@ func resourceTencentCloudCbsStorageSetAttachmentRead(d *schema.ResourceData, met
 	storage, errRet = cbsService.DescribeDiskById(ctx, storageId)
 	if errRet != nil {
 		log.Printf("[CRITAL]%s describe cbs storage attach failed, reason:%s\n ", logId, errRet.Error())
-		return errRet
+		return diag.FromErr(errRet)
 	}
 
 	if storage == nil || !*storage.Attached {
@@ -117,11 +122,11 @@ func resourceTencentCloudCbsStorageSetAttachmentRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudCbsStorageSetAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCbsStorageSetAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cbs_storage_set_attachment.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	cbsService := CbsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -130,7 +135,7 @@ func resourceTencentCloudCbsStorageSetAttachmentDelete(d *schema.ResourceData, m
 	storageId := d.Id()
 	instanceId := d.Get("instance_id").(string)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		errRet := cbsService.DetachDisk(ctx, storageId, instanceId)
 		if errRet != nil {
 			log.Printf("[CRITAL][detach disk]%s api[%s] fail, reason[%s]\n",
@@ -146,7 +151,7 @@ func resourceTencentCloudCbsStorageSetAttachmentDelete(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s cbs storage detach failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -53,7 +53,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -61,12 +63,16 @@ import (
 
 func resourceTencentCloudCcn() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCcnCreate,
-		Read:   resourceTencentCloudCcnRead,
-		Update: resourceTencentCloudCcnUpdate,
-		Delete: resourceTencentCloudCcnDelete,
+		CreateContext: resourceTencentCloudCcnCreate,
+		ReadContext:   resourceTencentCloudCcnRead,
+		UpdateContext: resourceTencentCloudCcnUpdate,
+		DeleteContext: resourceTencentCloudCcnDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(6 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -137,11 +143,11 @@ func resourceTencentCloudCcn() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCcnCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -157,7 +163,7 @@ func resourceTencentCloudCcnCreate(d *schema.ResourceData, meta interface{}) err
 	}
 	info, err := service.CreateCcn(ctx, name, description, qos, chargeType, bandwidthLimitType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(info.ccnId)
 
@@ -166,22 +172,22 @@ func resourceTencentCloudCcnCreate(d *schema.ResourceData, meta interface{}) err
 		tagService := &TagService{client: tcClient}
 		resourceName := BuildTagResourceName("vpc", "ccn", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCcnRead(d, meta)
+	return resourceTencentCloudCcnRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCcnRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		info, has, e := service.DescribeCcn(ctx, d.Id())
 		if e != nil {
 			return retryError(e)
@@ -204,24 +210,24 @@ func resourceTencentCloudCcnRead(d *schema.ResourceData, meta interface{}) error
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	tcClient := meta.(*TencentCloudClient).apiV3Conn
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "vpc", "ccn", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("tags", tags)
 	return nil
 }
 
-func resourceTencentCloudCcnUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -240,7 +246,7 @@ func resourceTencentCloudCcnUpdate(d *schema.ResourceData, meta interface{}) err
 			description = temp.(string)
 		}
 		if description == "" {
-			return fmt.Errorf("can not set description='' ")
+			return diag.Errorf("can not set description='' ")
 		}
 		change = true
 	}
@@ -248,19 +254,19 @@ func resourceTencentCloudCcnUpdate(d *schema.ResourceData, meta interface{}) err
 	d.Partial(true)
 	if change {
 		if err := service.ModifyCcnAttribute(ctx, d.Id(), name, description); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	// modify band width limit type
 	if d.HasChange("bandwidth_limit_type") {
 		_, news := d.GetChange("bandwidth_limit_type")
-		if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		if err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			if err := service.ModifyCcnRegionBandwidthLimitsType(ctx, d.Id(), news.(string)); err != nil {
 				return retryError(err)
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -274,22 +280,22 @@ func resourceTencentCloudCcnUpdate(d *schema.ResourceData, meta interface{}) err
 		resourceName := BuildTagResourceName("vpc", "ccn", tcClient.Region, d.Id())
 		err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 	d.Partial(false)
-	return resourceTencentCloudCcnRead(d, meta)
+	return resourceTencentCloudCcnRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCcnDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, e := service.DescribeCcn(ctx, d.Id())
 		if e != nil {
 			return retryError(e)
@@ -301,14 +307,14 @@ func resourceTencentCloudCcnDelete(d *schema.ResourceData, meta interface{}) err
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = service.DeleteCcn(ctx, d.Id()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+	return diag.FromErr(retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, err := service.DescribeCcn(ctx, d.Id())
 		if err != nil {
 			return resource.RetryableError(err)
@@ -317,5 +323,5 @@ func resourceTencentCloudCcnDelete(d *schema.ResourceData, meta interface{}) err
 			return nil
 		}
 		return resource.RetryableError(fmt.Errorf("delete fail"))
-	})
+	}))
 }
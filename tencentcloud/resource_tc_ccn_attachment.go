@@ -44,6 +44,14 @@ resource "tencentcloud_ccn_attachment" "other_account" {
   ccn_uin         = var.otheruin
 }
 ```
+
+Import
+
+CCN attachment can be imported using the id `ccn_id#instance_type#instance_region#instance_id` (or with `#ccn_uin` appended when the CCN belongs to the other account), e.g.
+
+```
+$ terraform import tencentcloud_ccn_attachment.attachment ccn-gree226l#VPC#ap-guangzhou#vpc-r1ahb58d
+```
 */
 package tencentcloud
 
@@ -53,20 +61,30 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCcnAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCcnAttachmentCreate,
-		Read:   resourceTencentCloudCcnAttachmentRead,
-		Update: resourceTencentCloudCcnAttachmentUpdate,
-		Delete: resourceTencentCloudCcnAttachmentDelete,
+		CreateContext: resourceTencentCloudCcnAttachmentCreate,
+		ReadContext:   resourceTencentCloudCcnAttachmentRead,
+		UpdateContext: resourceTencentCloudCcnAttachmentUpdate,
+		DeleteContext: resourceTencentCloudCcnAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTencentCloudCcnAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"ccn_id": {
@@ -137,11 +155,11 @@ func resourceTencentCloudCcnAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCcnAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn_attachment.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -155,7 +173,7 @@ func resourceTencentCloudCcnAttachmentCreate(d *schema.ResourceData, meta interf
 	)
 
 	if len(ccnId) < 4 || len(instanceRegion) < 3 || len(instanceId) < 3 {
-		return fmt.Errorf("param ccn_id or instance_region or instance_id  error")
+		return diag.Errorf("param ccn_id or instance_region or instance_id  error")
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -165,38 +183,38 @@ func resourceTencentCloudCcnAttachmentCreate(d *schema.ResourceData, meta interf
 	if v, ok := d.GetOk("ccn_uin"); ok {
 		ccnUin = v.(string)
 		if ccnUin != "" && instanceType != CNN_INSTANCE_TYPE_VPC {
-			return fmt.Errorf("Other ccn account attachment %s only support instance type of `VPC`.", ccnId)
+			return diag.Errorf("Other ccn account attachment %s only support instance type of `VPC`.", ccnId)
 		}
 	} else {
 		_, has, err := service.DescribeCcn(ctx, ccnId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if has == 0 {
-			return fmt.Errorf("ccn[%s] doesn't exist", ccnId)
+			return diag.Errorf("ccn[%s] doesn't exist", ccnId)
 		}
 	}
 
 	if err := service.AttachCcnInstances(ctx, ccnId, instanceRegion, instanceType, instanceId, ccnUin, description); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	m := md5.New()
 	_, err := m.Write([]byte(ccnId + instanceType + instanceRegion + instanceId))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%x", m.Sum(nil)))
 
-	return resourceTencentCloudCcnAttachmentRead(d, meta)
+	return resourceTencentCloudCcnAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCcnAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -207,7 +225,7 @@ func resourceTencentCloudCcnAttachmentRead(d *schema.ResourceData, meta interfac
 		instanceRegion := d.Get("instance_region").(string)
 		instanceId := d.Get("instance_id").(string)
 
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			infos, e := service.DescribeCcnAttachmentsByInstance(ctx, instanceType, instanceId, instanceRegion)
 			if e != nil {
 				return retryError(e)
@@ -235,7 +253,7 @@ func resourceTencentCloudCcnAttachmentRead(d *schema.ResourceData, meta interfac
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		return nil
 	}
@@ -247,7 +265,7 @@ func resourceTencentCloudCcnAttachmentRead(d *schema.ResourceData, meta interfac
 		instanceId     = d.Get("instance_id").(string)
 		onlineHas      = true
 	)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		_, has, e := service.DescribeCcn(ctx, ccnId)
 		if e != nil {
 			return retryError(e)
@@ -261,12 +279,12 @@ func resourceTencentCloudCcnAttachmentRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !onlineHas {
 		return nil
 	}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		info, has, e := service.DescribeCcnAttachedInstance(ctx, ccnId, instanceRegion, instanceType, instanceId)
 		if e != nil {
 			return retryError(e)
@@ -283,14 +301,14 @@ func resourceTencentCloudCcnAttachmentRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		request := vpc.NewDescribeCcnRoutesRequest()
 		request.CcnId = &ccnId
 
-		response, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeCcnRoutes(request)
+		response, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeCcnRoutesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -306,13 +324,13 @@ func resourceTencentCloudCcnAttachmentRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceTencentCloudCcnAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn_attachment.create")()
 	logId := getLogId(contextNil)
 
@@ -336,9 +354,11 @@ func resourceTencentCloudCcnAttachmentUpdate(d *schema.ResourceData, meta interf
 
 		request.Instances = []*vpc.CcnInstance{&ccnInstance}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			response, err := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyCcnAttachedInstancesAttribute(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			response, err := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyCcnAttachedInstancesAttributeWithContext(ctx, request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -349,17 +369,17 @@ func resourceTencentCloudCcnAttachmentUpdate(d *schema.ResourceData, meta interf
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudCcnAttachmentRead(d, meta)
+	return resourceTencentCloudCcnAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCcnAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn_attachment.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -369,7 +389,7 @@ func resourceTencentCloudCcnAttachmentDelete(d *schema.ResourceData, meta interf
 		instanceRegion = d.Get("instance_region").(string)
 		instanceId     = d.Get("instance_id").(string)
 	)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, e := service.DescribeCcn(ctx, ccnId)
 		if e != nil {
 			return retryError(e)
@@ -380,13 +400,13 @@ func resourceTencentCloudCcnAttachmentDelete(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := service.DetachCcnInstances(ctx, ccnId, instanceRegion, instanceType, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+	return diag.FromErr(retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, err := service.DescribeCcnAttachedInstance(ctx, ccnId, instanceRegion, instanceType, instanceId)
 		if err != nil {
 			return resource.RetryableError(err)
@@ -395,5 +415,29 @@ func resourceTencentCloudCcnAttachmentDelete(d *schema.ResourceData, meta interf
 			return nil
 		}
 		return resource.RetryableError(fmt.Errorf("delete fail"))
-	})
+	}))
+}
+
+// resourceTencentCloudCcnAttachmentImport imports the attachment by `ccn_id#instance_type#instance_region#instance_id`,
+// and `#ccn_uin` is appended when the ccn belongs to the other account.
+func resourceTencentCloudCcnAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	items := helper.IdParse(d.Id())
+	if len(items) != 4 && len(items) != 5 {
+		return nil, fmt.Errorf("id is broken, expected format ccn_id#instance_type#instance_region#instance_id[#ccn_uin], got %s", d.Id())
+	}
+	ccnId, instanceType, instanceRegion, instanceId := items[0], items[1], items[2], items[3]
+	_ = d.Set("ccn_id", ccnId)
+	_ = d.Set("instance_type", instanceType)
+	_ = d.Set("instance_region", instanceRegion)
+	_ = d.Set("instance_id", instanceId)
+	if len(items) == 5 {
+		_ = d.Set("ccn_uin", items[4])
+	}
+
+	m := md5.New()
+	if _, err := m.Write([]byte(ccnId + instanceType + instanceRegion + instanceId)); err != nil {
+		return nil, err
+	}
+	d.SetId(fmt.Sprintf("%x", m.Sum(nil)))
+	return []*schema.ResourceData{d}, nil
 }
@@ -46,6 +46,12 @@ func TestAccTencentCloudCcnAttachmentResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet(keyNameVpngw, "route_ids.#"),
 				),
 			},
+			{
+				ResourceName:      keyNameVpngw,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccImportStateIdFunc(keyNameVpngw, "ccn_id", "instance_type", "instance_region", "instance_id"),
+			},
 		},
 	})
 }
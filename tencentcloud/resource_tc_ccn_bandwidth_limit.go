@@ -49,6 +49,13 @@ resource tencentcloud_ccn_bandwidth_limit limit1 {
 }
 ```
 
+Import
+
+CCN bandwidth limit can be imported using the id `ccn_id#region` (or with `#dst_region` appended for the limit between regions), e.g.
+
+```
+$ terraform import tencentcloud_ccn_bandwidth_limit.limit1 ccn-gree226l#ap-guangzhou#ap-shanghai
+```
 */
 package tencentcloud
 
@@ -56,16 +63,21 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCcnBandwidthLimit() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCcnBandwidthLimitCreate,
-		Read:   resourceTencentCloudCcnBandwidthLimitRead,
-		Update: resourceTencentCloudCcnBandwidthLimitUpdate,
-		Delete: resourceTencentCloudCcnBandwidthLimitDelete,
+		CreateContext: resourceTencentCloudCcnBandwidthLimitCreate,
+		ReadContext:   resourceTencentCloudCcnBandwidthLimitRead,
+		UpdateContext: resourceTencentCloudCcnBandwidthLimitUpdate,
+		DeleteContext: resourceTencentCloudCcnBandwidthLimitDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTencentCloudCcnBandwidthLimitImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"ccn_id": {
@@ -97,11 +109,11 @@ func resourceTencentCloudCcnBandwidthLimit() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCcnBandwidthLimitCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnBandwidthLimitCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn_bandwidth_limit.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -112,12 +124,12 @@ func resourceTencentCloudCcnBandwidthLimitCreate(d *schema.ResourceData, meta in
 
 	_, has, err := service.DescribeCcn(ctx, ccnId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if has == 0 {
-		return fmt.Errorf("ccn[%s] doesn't exist", ccnId)
+		return diag.Errorf("ccn[%s] doesn't exist", ccnId)
 	}
-	id := fmt.Sprintf("%s#%s", ccnId, region)
+	id := helper.IdFormat(ccnId, region)
 	var (
 		dstRegion string
 		limit     int64
@@ -129,18 +141,18 @@ func resourceTencentCloudCcnBandwidthLimitCreate(d *schema.ResourceData, meta in
 		limit = int64(v.(int))
 	}
 	if err := service.SetCcnRegionBandwidthLimits(ctx, ccnId, region, dstRegion, limit); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(id)
 
-	return resourceTencentCloudCcnBandwidthLimitRead(d, meta)
+	return resourceTencentCloudCcnBandwidthLimitRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCcnBandwidthLimitUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnBandwidthLimitUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn_bandwidth_limit.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -150,10 +162,10 @@ func resourceTencentCloudCcnBandwidthLimitUpdate(d *schema.ResourceData, meta in
 	)
 	_, has, err := service.DescribeCcn(ctx, ccnId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if has == 0 {
-		return fmt.Errorf("ccn[%s] doesn't exist", ccnId)
+		return diag.Errorf("ccn[%s] doesn't exist", ccnId)
 	}
 	if d.HasChange("bandwidth_limit") {
 		var limitTemp int64
@@ -162,18 +174,18 @@ func resourceTencentCloudCcnBandwidthLimitUpdate(d *schema.ResourceData, meta in
 		}
 		_, dstRegion := d.GetChange("dst_region")
 		if err := service.SetCcnRegionBandwidthLimits(ctx, ccnId, region, dstRegion.(string), limitTemp); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudCcnBandwidthLimitRead(d, meta)
+	return resourceTencentCloudCcnBandwidthLimitRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCcnBandwidthLimitRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnBandwidthLimitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn_bandwidth_limit.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -184,7 +196,7 @@ func resourceTencentCloudCcnBandwidthLimitRead(d *schema.ResourceData, meta inte
 		onlineHas = true
 		info      CcnBasicInfo
 	)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		infoTmp, has, e := service.DescribeCcn(ctx, ccnId)
 		if e != nil {
 			return retryError(e)
@@ -199,12 +211,12 @@ func resourceTencentCloudCcnBandwidthLimitRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !onlineHas {
 		return nil
 	}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		bandwidth, e := service.GetCcnRegionBandwidthLimit(ctx, ccnId, region, dstRegion, info.bandWithLimitType)
 		if e != nil {
 			return retryError(e)
@@ -214,15 +226,31 @@ func resourceTencentCloudCcnBandwidthLimitRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudCcnBandwidthLimitDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnBandwidthLimitDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn_bandwidth_limit.delete")()
 
 	_, _ = d, meta
 
 	return nil
 }
+
+// resourceTencentCloudCcnBandwidthLimitImport imports the limit by `ccn_id#region`, and `#dst_region`
+// is appended for the limit between regions.
+func resourceTencentCloudCcnBandwidthLimitImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	items := helper.IdParse(d.Id())
+	if len(items) != 2 && len(items) != 3 {
+		return nil, fmt.Errorf("id is broken, expected format ccn_id#region[#dst_region], got %s", d.Id())
+	}
+	_ = d.Set("ccn_id", items[0])
+	_ = d.Set("region", items[1])
+	if len(items) == 3 {
+		_ = d.Set("dst_region", items[2])
+	}
+	d.SetId(helper.IdFormat(items[0], items[1]))
+	return []*schema.ResourceData{d}, nil
+}
@@ -36,6 +36,12 @@ func TestAccTencentCloudCcnV3BandwidthLimitOuter(t *testing.T) {
 					resource.TestCheckResourceAttr(keyNameLimit1, "bandwidth_limit", "100"),
 				),
 			},
+			{
+				ResourceName:      keyNameLimit1,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccImportStateIdFunc(keyNameLimit1, "ccn_id", "region"),
+			},
 		},
 	})
 }
@@ -17,9 +17,11 @@ resource "tencentcloud_ccn_instances_accept_attach" "ccn_instances_accept_attach
 package tencentcloud
 
 import (
-	"fmt"
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -28,11 +30,14 @@ import (
 
 func resourceTencentCloudCcnInstancesAcceptAttach() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCcnInstancesAcceptAttachCreate,
-		Read:   resourceTencentCloudCcnInstancesAcceptAttachRead,
-		Delete: resourceTencentCloudCcnInstancesAcceptAttachDelete,
+		CreateContext: resourceTencentCloudCcnInstancesAcceptAttachCreate,
+		ReadContext:   resourceTencentCloudCcnInstancesAcceptAttachRead,
+		DeleteContext: resourceTencentCloudCcnInstancesAcceptAttachDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"ccn_id": {
@@ -81,7 +86,7 @@ func resourceTencentCloudCcnInstancesAcceptAttach() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCcnInstancesAcceptAttachCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnInstancesAcceptAttachCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn_instances_accept_attach.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -122,8 +127,8 @@ func resourceTencentCloudCcnInstancesAcceptAttachCreate(d *schema.ResourceData,
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AcceptAttachCcnInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AcceptAttachCcnInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -132,22 +137,22 @@ func resourceTencentCloudCcnInstancesAcceptAttachCreate(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("[CRITAL]%s operate vpc ccnInstancesAcceptAttach failed, reason:%+v", logId, err)
+		return diag.Errorf("[CRITAL]%s operate vpc ccnInstancesAcceptAttach failed, reason:%+v", logId, err)
 	}
 
 	d.SetId(ccnId)
 
-	return resourceTencentCloudCcnInstancesAcceptAttachRead(d, meta)
+	return resourceTencentCloudCcnInstancesAcceptAttachRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCcnInstancesAcceptAttachRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnInstancesAcceptAttachRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn_instances_accept_attach.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCcnInstancesAcceptAttachDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnInstancesAcceptAttachDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn_instances_accept_attach.delete")()
 	defer inconsistentCheck(d, meta)()
 
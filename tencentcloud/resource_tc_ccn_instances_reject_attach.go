@@ -17,9 +17,11 @@ resource "tencentcloud_ccn_instances_reject_attach" "ccn_instances_reject_attach
 package tencentcloud
 
 import (
-	"fmt"
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -28,11 +30,14 @@ import (
 
 func resourceTencentCloudCcnInstancesRejectAttach() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCcnInstancesRejectAttachCreate,
-		Read:   resourceTencentCloudCcnInstancesRejectAttachRead,
-		Delete: resourceTencentCloudCcnInstancesRejectAttachDelete,
+		CreateContext: resourceTencentCloudCcnInstancesRejectAttachCreate,
+		ReadContext:   resourceTencentCloudCcnInstancesRejectAttachRead,
+		DeleteContext: resourceTencentCloudCcnInstancesRejectAttachDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"ccn_id": {
@@ -81,7 +86,7 @@ func resourceTencentCloudCcnInstancesRejectAttach() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCcnInstancesRejectAttachCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnInstancesRejectAttachCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn_instances_reject_attach.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -122,8 +127,8 @@ func resourceTencentCloudCcnInstancesRejectAttachCreate(d *schema.ResourceData,
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().RejectAttachCcnInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().RejectAttachCcnInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -132,22 +137,22 @@ func resourceTencentCloudCcnInstancesRejectAttachCreate(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("[CRITAL]%s operate vpc ccnInstancesRejectAttach failed, reason:%+v", logId, err)
+		return diag.Errorf("[CRITAL]%s operate vpc ccnInstancesRejectAttach failed, reason:%+v", logId, err)
 	}
 
 	d.SetId(ccnId)
 
-	return resourceTencentCloudCcnInstancesRejectAttachRead(d, meta)
+	return resourceTencentCloudCcnInstancesRejectAttachRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCcnInstancesRejectAttachRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnInstancesRejectAttachRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn_instances_reject_attach.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCcnInstancesRejectAttachDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnInstancesRejectAttachDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn_instances_reject_attach.delete")()
 	defer inconsistentCheck(d, meta)()
 
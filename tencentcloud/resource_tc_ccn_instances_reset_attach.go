@@ -18,9 +18,11 @@ resource "tencentcloud_ccn_instances_reset_attach" "ccn_instances_reset_attach"
 package tencentcloud
 
 import (
-	"fmt"
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -29,9 +31,12 @@ import (
 
 func resourceTencentCloudCcnInstancesResetAttach() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCcnInstancesResetAttachCreate,
-		Read:   resourceTencentCloudCcnInstancesResetAttachRead,
-		Delete: resourceTencentCloudCcnInstancesResetAttachDelete,
+		CreateContext: resourceTencentCloudCcnInstancesResetAttachCreate,
+		ReadContext:   resourceTencentCloudCcnInstancesResetAttachRead,
+		DeleteContext: resourceTencentCloudCcnInstancesResetAttachDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"ccn_id": {
 				Required:    true,
@@ -86,7 +91,7 @@ func resourceTencentCloudCcnInstancesResetAttach() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCcnInstancesResetAttachCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnInstancesResetAttachCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpc_ccn_instances_reset_attach.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -131,8 +136,8 @@ func resourceTencentCloudCcnInstancesResetAttachCreate(d *schema.ResourceData, m
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ResetAttachCcnInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ResetAttachCcnInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -141,22 +146,22 @@ func resourceTencentCloudCcnInstancesResetAttachCreate(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("[CRITAL]%s operate vpc ccnInstancesResetAttach failed, reason:%+v", logId, err)
+		return diag.Errorf("[CRITAL]%s operate vpc ccnInstancesResetAttach failed, reason:%+v", logId, err)
 	}
 
 	d.SetId(ccnId)
 
-	return resourceTencentCloudCcnInstancesResetAttachRead(d, meta)
+	return resourceTencentCloudCcnInstancesResetAttachRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCcnInstancesResetAttachRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnInstancesResetAttachRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn_instances_reset_attach.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCcnInstancesResetAttachDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnInstancesResetAttachDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloudccn_instances_reset_attach.delete")()
 	defer inconsistentCheck(d, meta)()
 
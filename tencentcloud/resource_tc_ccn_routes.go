@@ -23,23 +23,27 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCcnRoutes() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCcnRoutesCreate,
-		Read:   resourceTencentCloudCcnRoutesRead,
-		Update: resourceTencentCloudCcnRoutesUpdate,
-		Delete: resourceTencentCloudCcnRoutesDelete,
+		CreateContext: resourceTencentCloudCcnRoutesCreate,
+		ReadContext:   resourceTencentCloudCcnRoutesRead,
+		UpdateContext: resourceTencentCloudCcnRoutesUpdate,
+		DeleteContext: resourceTencentCloudCcnRoutesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"ccn_id": {
@@ -65,38 +69,38 @@ func resourceTencentCloudCcnRoutes() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCcnRoutesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnRoutesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn_routes.create")()
 	defer inconsistentCheck(d, meta)()
 
 	ccnId := d.Get("ccn_id").(string)
 	routeId := d.Get("route_id").(string)
 
-	d.SetId(ccnId + FILED_SP + routeId)
+	d.SetId(helper.IdFormat(ccnId, routeId))
 
-	return resourceTencentCloudCcnRoutesUpdate(d, meta)
+	return resourceTencentCloudCcnRoutesUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudCcnRoutesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn_routes.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	ccnId := idSplit[0]
 	routeId := idSplit[1]
 
 	ccnRoutes, err := service.DescribeVpcCcnRoutesById(ctx, ccnId, routeId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ccnRoutes == nil {
@@ -119,15 +123,15 @@ func resourceTencentCloudCcnRoutesRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudCcnRoutesUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnRoutesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn_routes.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	ccnId := idSplit[0]
 	routeId := idSplit[1]
@@ -142,8 +146,8 @@ func resourceTencentCloudCcnRoutesUpdate(d *schema.ResourceData, meta interface{
 		request.CcnId = &ccnId
 		request.RouteIds = []*string{&routeId}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().EnableCcnRoutes(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().EnableCcnRoutesWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -153,7 +157,7 @@ func resourceTencentCloudCcnRoutesUpdate(d *schema.ResourceData, meta interface{
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update vpc ccnRoutes failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 
@@ -163,8 +167,8 @@ func resourceTencentCloudCcnRoutesUpdate(d *schema.ResourceData, meta interface{
 		request.CcnId = &ccnId
 		request.RouteIds = []*string{&routeId}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DisableCcnRoutes(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DisableCcnRoutesWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -174,15 +178,15 @@ func resourceTencentCloudCcnRoutesUpdate(d *schema.ResourceData, meta interface{
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update vpc ccnRoutes failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
-	return resourceTencentCloudCcnRoutesRead(d, meta)
+	return resourceTencentCloudCcnRoutesRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCcnRoutesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCcnRoutesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ccn_routes.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -31,8 +31,9 @@ package tencentcloud
 import (
 	"context"
 	"errors"
-	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -42,12 +43,16 @@ import (
 
 func resourceTencentCloudCdhInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCdhInstanceCreate,
-		Read:   resourceTencentCloudCdhInstanceRead,
-		Update: resourceTencentCloudCdhInstanceUpdate,
-		Delete: resourceTencentCloudCdhInstanceDelete,
+		CreateContext: resourceTencentCloudCdhInstanceCreate,
+		ReadContext:   resourceTencentCloudCdhInstanceRead,
+		UpdateContext: resourceTencentCloudCdhInstanceUpdate,
+		DeleteContext: resourceTencentCloudCdhInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -168,12 +173,11 @@ func resourceTencentCloudCdhInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCdhInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCdhInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cdh_instance.create")()
 	logId := getLogId(contextNil)
 
 	var (
-		ctx                  = context.WithValue(context.TODO(), logIdKey, logId)
 		cdhService           = CdhService{client: meta.(*TencentCloudClient).apiV3Conn}
 		hostType, chargeType string
 		placement            cvm.Placement
@@ -182,6 +186,7 @@ func resourceTencentCloudCdhInstanceCreate(d *schema.ResourceData, meta interfac
 		hostInstance         *cvm.HostItem
 		outErr, inErr        error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("host_type"); ok {
 		hostType = v.(string)
@@ -200,7 +205,7 @@ func resourceTencentCloudCdhInstanceCreate(d *schema.ResourceData, meta interfac
 		hostChargePrepaid.RenewFlag = helper.String(v.(string))
 	}
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		hostId, inErr = cdhService.CreateCdhInstance(ctx, &placement, &hostChargePrepaid, chargeType, hostType)
 		if inErr != nil {
 			if sdkErr, ok := inErr.(*sdkErrors.TencentCloudSDKError); ok && sdkErr.Code == CDH_ZONE_SOLD_OUT_FOR_SPECIFIED_INSTANCE_ERROR {
@@ -211,11 +216,11 @@ func resourceTencentCloudCdhInstanceCreate(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	d.SetId(hostId)
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		hostInstance, inErr = cdhService.DescribeCdhInstanceById(ctx, d.Id())
 		if inErr != nil {
 			return retryError(inErr)
@@ -229,12 +234,12 @@ func resourceTencentCloudCdhInstanceCreate(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if v, ok := d.GetOk("host_name"); ok {
 		hostName := v.(string)
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			inErr = cdhService.ModifyHostName(ctx, d.Id(), hostName)
 			if inErr != nil {
 				return retryError(inErr)
@@ -242,24 +247,24 @@ func resourceTencentCloudCdhInstanceCreate(d *schema.ResourceData, meta interfac
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 	}
-	return resourceTencentCloudCdhInstanceRead(d, meta)
+	return resourceTencentCloudCdhInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCdhInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCdhInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cdh_instance.read")()
 	logId := getLogId(contextNil)
 
 	var (
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		cdhService    = CdhService{client: meta.(*TencentCloudClient).apiV3Conn}
 		hostInstance  *cvm.HostItem
 		outErr, inErr error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		hostInstance, inErr = cdhService.DescribeCdhInstanceById(ctx, d.Id())
 		if inErr != nil {
 			return retryError(inErr)
@@ -267,7 +272,7 @@ func resourceTencentCloudCdhInstanceRead(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if hostInstance == nil {
@@ -300,15 +305,15 @@ func resourceTencentCloudCdhInstanceRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTencentCloudCdhInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCdhInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cdh_instance.update")()
 	logId := getLogId(contextNil)
 
 	var (
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		cdhService    = CdhService{client: meta.(*TencentCloudClient).apiV3Conn}
 		outErr, inErr error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	d.Partial(true)
 
@@ -317,12 +322,12 @@ func resourceTencentCloudCdhInstanceUpdate(d *schema.ResourceData, meta interfac
 	}
 	for _, field := range unsupportedUpdateFields {
 		if d.HasChange(field) {
-			return fmt.Errorf("tencentcloud_cdh_instance update on %s is not support yet", field)
+			return diag.Errorf("tencentcloud_cdh_instance update on %s is not support yet", field)
 		}
 	}
 
 	if d.HasChange("project_id") {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = cdhService.ModifyProject(ctx, d.Id(), d.Get("project_id").(int))
 			if inErr != nil {
 				return retryError(inErr)
@@ -330,13 +335,13 @@ func resourceTencentCloudCdhInstanceUpdate(d *schema.ResourceData, meta interfac
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 	}
 
 	if d.HasChange("host_name") {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = cdhService.ModifyHostName(ctx, d.Id(), d.Get("host_name").(string))
 			if inErr != nil {
 				return retryError(inErr)
@@ -344,13 +349,13 @@ func resourceTencentCloudCdhInstanceUpdate(d *schema.ResourceData, meta interfac
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 	}
 
 	if d.HasChange("prepaid_renew_flag") {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = cdhService.ModifyPrepaidRenewFlag(ctx, d.Id(), d.Get("prepaid_renew_flag").(string))
 			if inErr != nil {
 				return retryError(inErr)
@@ -358,18 +363,18 @@ func resourceTencentCloudCdhInstanceUpdate(d *schema.ResourceData, meta interfac
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudCdhInstanceRead(d, meta)
+	return resourceTencentCloudCdhInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCdhInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCdhInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cdh_instance.delete")()
 
-	return fmt.Errorf("PREPAID CDH instance do not support delete operation with terraform")
+	return diag.Errorf("PREPAID CDH instance do not support delete operation with terraform")
 }
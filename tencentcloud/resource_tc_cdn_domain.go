@@ -148,6 +148,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdn "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdn/v20180606"
@@ -158,10 +159,15 @@ import (
 
 func resourceTencentCloudCdnDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCdnDomainCreate,
-		Read:   resourceTencentCloudCdnDomainRead,
-		Update: resourceTencentCloudCdnDomainUpdate,
-		Delete: resourceTencentCloudCdnDomainDelete,
+		CreateContext: resourceTencentCloudCdnDomainCreate,
+		ReadContext:   resourceTencentCloudCdnDomainRead,
+		UpdateContext: resourceTencentCloudCdnDomainUpdate,
+		DeleteContext: resourceTencentCloudCdnDomainDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
 			//State: func(d *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
 			//	getDefaultSwitchOffMap := func() []interface{} {
@@ -1696,10 +1702,10 @@ func resourceTencentCloudCdnDomain() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCdnDomainCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCdnDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cdn_domain.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cdnService := CdnService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -1926,7 +1932,7 @@ func resourceTencentCloudCdnDomainCreate(d *schema.ResourceData, meta interface{
 	// origin
 	origins := d.Get("origin").([]interface{})
 	if len(origins) < 1 {
-		return fmt.Errorf("origin is required")
+		return diag.Errorf("origin is required")
 	}
 	origin := origins[0].(map[string]interface{})
 	request.Origin = &cdn.Origin{}
@@ -2356,7 +2362,7 @@ func resourceTencentCloudCdnDomainCreate(d *schema.ResourceData, meta interface{
 		configStruct := cdn.MainlandConfig{}
 		err := json.Unmarshal([]byte(v.(string)), &configStruct)
 		if err != nil {
-			return fmt.Errorf("unmarshal specific_config_mainland fail: %s", err.Error())
+			return diag.Errorf("unmarshal specific_config_mainland fail: %s", err.Error())
 		}
 		request.SpecificConfig.Mainland = &configStruct
 	}
@@ -2367,7 +2373,7 @@ func resourceTencentCloudCdnDomainCreate(d *schema.ResourceData, meta interface{
 		configStruct := cdn.OverseaConfig{}
 		err := json.Unmarshal([]byte(v.(string)), &configStruct)
 		if err != nil {
-			return fmt.Errorf("unmarshal specific_config_overseas fail: %s", err.Error())
+			return diag.Errorf("unmarshal specific_config_overseas fail: %s", err.Error())
 		}
 		request.SpecificConfig.Overseas = &configStruct
 	}
@@ -2530,9 +2536,11 @@ func resourceTencentCloudCdnDomainCreate(d *schema.ResourceData, meta interface{
 		return nil
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := meta.(*TencentCloudClient).apiV3Conn.UseCdnClient().AddCdnDomain(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := meta.(*TencentCloudClient).apiV3Conn.UseCdnClient().AddCdnDomainWithContext(ctx, request)
 		if err != nil {
 			if sdkErr, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkErr.Code == CDN_DOMAIN_CONFIG_ERROR || sdkErr.Code == CDN_HOST_EXISTS {
@@ -2544,12 +2552,12 @@ func resourceTencentCloudCdnDomainCreate(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(domain)
 
 	time.Sleep(1 * time.Second)
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		domainConfig, err := cdnService.DescribeDomainsConfigByDomain(ctx, domain)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -2560,11 +2568,11 @@ func resourceTencentCloudCdnDomainCreate(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := updateCdnModifyOnlyParams(d, meta, ctx); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// tags
@@ -2575,19 +2583,19 @@ func resourceTencentCloudCdnDomainCreate(d *schema.ResourceData, meta interface{
 		resourceName := BuildTagResourceName(CDN_SERVICE_NAME, CDN_RESOURCE_NAME_DOMAIN, region, domain)
 		err := tagService.ModifyTags(ctx, resourceName, tags, nil)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCdnDomainRead(d, meta)
+	return resourceTencentCloudCdnDomainRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCdnDomainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCdnDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cdn_domain.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	region := client.Region
 	cdnService := CdnService{client: client}
@@ -2602,7 +2610,7 @@ func resourceTencentCloudCdnDomainRead(d *schema.ResourceData, meta interface{})
 
 	var domainConfig *cdn.DetailDomain
 	var errRet error
-	err := resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, 5*readRetryTimeout, func() *resource.RetryError {
 		domainConfig, errRet = cdnService.DescribeDomainsConfigByDomain(ctx, domain)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -2610,7 +2618,7 @@ func resourceTencentCloudCdnDomainRead(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if domainConfig == nil {
 		d.SetId("")
@@ -3164,17 +3172,17 @@ func resourceTencentCloudCdnDomainRead(d *schema.ResourceData, meta interface{})
 
 	tags, errRet := tagService.DescribeResourceTags(ctx, CDN_SERVICE_NAME, CDN_RESOURCE_NAME_DOMAIN, region, domain)
 	if errRet != nil {
-		return errRet
+		return diag.FromErr(errRet)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudCdnDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCdnDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cdn_domain.update")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	cdnService := CdnService{client: client}
 
@@ -3216,7 +3224,7 @@ func resourceTencentCloudCdnDomainUpdate(d *schema.ResourceData, meta interface{
 		updateAttrs = append(updateAttrs, "origin")
 		origins := d.Get("origin").([]interface{})
 		if len(origins) < 1 {
-			return fmt.Errorf("origin is required")
+			return diag.Errorf("origin is required")
 		}
 		origin := origins[0].(map[string]interface{})
 		request.Origin = &cdn.Origin{}
@@ -3835,7 +3843,7 @@ func resourceTencentCloudCdnDomainUpdate(d *schema.ResourceData, meta interface{
 		configStruct := cdn.MainlandConfig{}
 		err := json.Unmarshal([]byte(v.(string)), &configStruct)
 		if err != nil {
-			return fmt.Errorf("unmarshal specific_config_mainland fail: %s", err.Error())
+			return diag.Errorf("unmarshal specific_config_mainland fail: %s", err.Error())
 		}
 		request.SpecificConfig.Mainland = &configStruct
 	}
@@ -3847,7 +3855,7 @@ func resourceTencentCloudCdnDomainUpdate(d *schema.ResourceData, meta interface{
 		configStruct := cdn.OverseaConfig{}
 		err := json.Unmarshal([]byte(v.(string)), &configStruct)
 		if err != nil {
-			return fmt.Errorf("unmarshal specific_config_overseas fail: %s", err.Error())
+			return diag.Errorf("unmarshal specific_config_overseas fail: %s", err.Error())
 		}
 		request.SpecificConfig.Overseas = &configStruct
 	}
@@ -4020,13 +4028,15 @@ func resourceTencentCloudCdnDomainUpdate(d *schema.ResourceData, meta interface{
 
 	if v := d.Get("explicit_using_dry_run").(bool); v {
 		_ = d.Set("dry_run_update_result", request.ToJsonString())
-		return resourceTencentCloudCdnDomainRead(d, meta)
+		return resourceTencentCloudCdnDomainRead(ctx, d, meta)
 	}
 
 	if len(updateAttrs) > 0 {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			_, err := meta.(*TencentCloudClient).apiV3Conn.UseCdnClient().UpdateDomainConfig(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			_, err := meta.(*TencentCloudClient).apiV3Conn.UseCdnClient().UpdateDomainConfigWithContext(ctx, request)
 			if err != nil {
 				if sdkErr, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 					if sdkErr.Code == CDN_DOMAIN_CONFIG_ERROR {
@@ -4038,10 +4048,10 @@ func resourceTencentCloudCdnDomainUpdate(d *schema.ResourceData, meta interface{
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			domainConfig, err := cdnService.DescribeDomainsConfigByDomain(ctx, domain)
 			if err != nil {
 				return retryError(err, InternalError)
@@ -4052,7 +4062,7 @@ func resourceTencentCloudCdnDomainUpdate(d *schema.ResourceData, meta interface{
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -4065,20 +4075,20 @@ func resourceTencentCloudCdnDomainUpdate(d *schema.ResourceData, meta interface{
 		resourceName := BuildTagResourceName(CDN_SERVICE_NAME, CDN_RESOURCE_NAME_DOMAIN, region, domain)
 		err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudCdnDomainRead(d, meta)
+	return resourceTencentCloudCdnDomainRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCdnDomainDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCdnDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cdn_domain.delete")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	cdnService := CdnService{client: client}
 
@@ -4099,13 +4109,13 @@ func resourceTencentCloudCdnDomainDelete(d *schema.ResourceData, meta interface{
 		}
 		err := tagService.ModifyTags(ctx, resourceName, nil, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	var domainConfig *cdn.DetailDomain
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		domainConfig, errRet = cdnService.DescribeDomainsConfigByDomain(ctx, domain)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -4113,14 +4123,14 @@ func resourceTencentCloudCdnDomainDelete(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if domainConfig == nil {
 		return nil
 	}
 
 	if *domainConfig.Status == CDN_DOMAIN_STATUS_ONLINE {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			errRet = cdnService.StopDomain(ctx, domain)
 			if errRet != nil {
 				return retryError(errRet)
@@ -4128,10 +4138,10 @@ func resourceTencentCloudCdnDomainDelete(d *schema.ResourceData, meta interface{
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			domainConfig, err := cdnService.DescribeDomainsConfigByDomain(ctx, domain)
 			if err != nil {
 				return retryError(err, InternalError)
@@ -4142,11 +4152,11 @@ func resourceTencentCloudCdnDomainDelete(d *schema.ResourceData, meta interface{
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		errRet = cdnService.DeleteDomain(ctx, domain)
 		if errRet != nil {
 			return retryError(errRet)
@@ -4154,7 +4164,7 @@ func resourceTencentCloudCdnDomainDelete(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -4181,7 +4191,7 @@ func updateCdnModifyOnlyParams(d *schema.ResourceData, meta interface{}, ctx con
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		err := service.UpdateDomainConfig(ctx, request)
 		if err != nil {
 			if sdkErr, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
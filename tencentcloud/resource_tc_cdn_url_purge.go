@@ -34,16 +34,17 @@ import (
 
 	cdn "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdn/v20180606"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudUrlPurge() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudUrlPurgeRead,
-		Create: resourceTencentCloudUrlPurgeCreate,
-		Update: resourceTencentCloudUrlPurgeUpdate,
-		Delete: resourceTencentCloudUrlPurgeDelete,
+		ReadContext:   resourceTencentCloudUrlPurgeRead,
+		CreateContext: resourceTencentCloudUrlPurgeCreate,
+		UpdateContext: resourceTencentCloudUrlPurgeUpdate,
+		DeleteContext: resourceTencentCloudUrlPurgeDelete,
 		Schema: map[string]*schema.Schema{
 			"urls": {
 				Type:        schema.TypeList,
@@ -115,19 +116,19 @@ func resourceTencentCloudUrlPurge() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudUrlPurgeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudUrlPurgeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cdn_url_purge.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := CdnService{client}
 
 	taskId, ok := d.Get("task_id").(string)
 
 	if !ok || taskId == "" {
-		return fmt.Errorf("no task id provided")
+		return diag.Errorf("no task id provided")
 	}
 
 	request := cdn.NewDescribePurgeTasksRequest()
@@ -138,7 +139,7 @@ func resourceTencentCloudUrlPurgeRead(d *schema.ResourceData, meta interface{})
 		err  error
 	)
 
-	err = resource.Retry(readRetryTimeout*2, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout*2, func() *resource.RetryError {
 		logs, err = service.DescribePurgeTasks(ctx, request)
 		if err != nil {
 			return retryError(err)
@@ -165,7 +166,7 @@ func resourceTencentCloudUrlPurgeRead(d *schema.ResourceData, meta interface{})
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	urls := d.Get("urls").([]interface{})
@@ -193,13 +194,13 @@ func resourceTencentCloudUrlPurgeRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudUrlPurgeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudUrlPurgeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cdn_url_purge.create")()
 
-	taskId, err := tencentcloudCdnUrlPurge(d, meta)
+	taskId, err := tencentcloudCdnUrlPurge(ctx, d, meta)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("task_id", taskId)
@@ -207,10 +208,10 @@ func resourceTencentCloudUrlPurgeCreate(d *schema.ResourceData, meta interface{}
 	urls := d.Get("urls").([]interface{})
 	d.SetId("purges-" + GetUrlsHash(helper.InterfacesStrings(urls)))
 
-	return resourceTencentCloudUrlPurgeRead(d, meta)
+	return resourceTencentCloudUrlPurgeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudUrlPurgeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudUrlPurgeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cdn_url_purge.update")()
 
 	redo, ok := d.GetOk("redo")
@@ -219,26 +220,26 @@ func resourceTencentCloudUrlPurgeUpdate(d *schema.ResourceData, meta interface{}
 		return nil
 	}
 
-	taskId, err := tencentcloudCdnUrlPurge(d, meta)
+	taskId, err := tencentcloudCdnUrlPurge(ctx, d, meta)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("task_id", taskId)
 
-	return resourceTencentCloudUrlPurgeRead(d, meta)
+	return resourceTencentCloudUrlPurgeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudUrlPurgeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudUrlPurgeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cdn_url_purge.delete")()
 	log.Printf("noop deleting resoruce %s", "tencentcloud_cdn_url_purge")
 	return nil
 }
 
-func tencentcloudCdnUrlPurge(d *schema.ResourceData, meta interface{}) (string, error) {
+func tencentcloudCdnUrlPurge(ctx context.Context, d *schema.ResourceData, meta interface{}) (string, error) {
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := CdnService{client}
@@ -32,16 +32,17 @@ import (
 
 	cdn "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdn/v20180606"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudUrlPush() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudUrlPushRead,
-		Create: resourceTencentCloudUrlPushCreate,
-		Update: resourceTencentCloudUrlPushUpdate,
-		Delete: resourceTencentCloudUrlPushDelete,
+		ReadContext:   resourceTencentCloudUrlPushRead,
+		CreateContext: resourceTencentCloudUrlPushCreate,
+		UpdateContext: resourceTencentCloudUrlPushUpdate,
+		DeleteContext: resourceTencentCloudUrlPushDelete,
 		Schema: map[string]*schema.Schema{
 			"urls": {
 				Type:        schema.TypeList,
@@ -134,19 +135,19 @@ func resourceTencentCloudUrlPush() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudUrlPushRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudUrlPushRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cdn_url_push.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := CdnService{client}
 
 	taskId, ok := d.Get("task_id").(string)
 
 	if !ok || taskId == "" {
-		return fmt.Errorf("no task id provided")
+		return diag.Errorf("no task id provided")
 	}
 
 	request := cdn.NewDescribePushTasksRequest()
@@ -157,7 +158,7 @@ func resourceTencentCloudUrlPushRead(d *schema.ResourceData, meta interface{}) e
 		err  error
 	)
 
-	err = resource.Retry(readRetryTimeout*2, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout*2, func() *resource.RetryError {
 		logs, err = service.DescribePushTasks(ctx, request)
 		if err != nil {
 			return retryError(err)
@@ -184,7 +185,7 @@ func resourceTencentCloudUrlPushRead(d *schema.ResourceData, meta interface{}) e
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	urls := d.Get("urls").([]interface{})
@@ -208,19 +209,19 @@ func resourceTencentCloudUrlPushRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if err := d.Set("push_history", pushHistory); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceTencentCloudUrlPushCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudUrlPushCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cdn_url_push.create")()
 
-	taskId, err := tencentcloudCdnUrlPush(d, meta)
+	taskId, err := tencentcloudCdnUrlPush(ctx, d, meta)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("task_id", taskId)
@@ -228,10 +229,10 @@ func resourceTencentCloudUrlPushCreate(d *schema.ResourceData, meta interface{})
 	urls := d.Get("urls").([]interface{})
 	d.SetId("pushes-" + GetUrlsHash(helper.InterfacesStrings(urls)))
 
-	return resourceTencentCloudUrlPushRead(d, meta)
+	return resourceTencentCloudUrlPushRead(ctx, d, meta)
 }
 
-func resourceTencentCloudUrlPushUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudUrlPushUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cdn_url_push.update")()
 
 	redo, ok := d.GetOk("redo")
@@ -240,27 +241,27 @@ func resourceTencentCloudUrlPushUpdate(d *schema.ResourceData, meta interface{})
 		return nil
 	}
 
-	taskId, err := tencentcloudCdnUrlPush(d, meta)
+	taskId, err := tencentcloudCdnUrlPush(ctx, d, meta)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("task_id", taskId)
 
-	return resourceTencentCloudUrlPushRead(d, meta)
+	return resourceTencentCloudUrlPushRead(ctx, d, meta)
 }
 
-func resourceTencentCloudUrlPushDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudUrlPushDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cdn_url_push.delete")()
 
 	log.Printf("noop deleting resoruce %s", "tencentcloud_cdn_url_push")
 	return nil
 }
 
-func tencentcloudCdnUrlPush(d *schema.ResourceData, meta interface{}) (string, error) {
+func tencentcloudCdnUrlPush(ctx context.Context, d *schema.ResourceData, meta interface{}) (string, error) {
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := CdnService{client}
@@ -23,7 +23,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfs/v20190719"
@@ -33,12 +35,17 @@ import (
 
 func resourceTencentCloudCfsAccessGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfsAccessGroupCreate,
-		Read:   resourceTencentCloudCfsAccessGroupRead,
-		Update: resourceTencentCloudCfsAccessGroupUpdate,
-		Delete: resourceTencentCloudCfsAccessGroupDelete,
+		CreateContext: resourceTencentCloudCfsAccessGroupCreate,
+		ReadContext:   resourceTencentCloudCfsAccessGroupRead,
+		UpdateContext: resourceTencentCloudCfsAccessGroupUpdate,
+		DeleteContext: resourceTencentCloudCfsAccessGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -64,10 +71,10 @@ func resourceTencentCloudCfsAccessGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCfsAccessGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsAccessGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_access_group.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cfsService := CfsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -78,7 +85,7 @@ func resourceTencentCloudCfsAccessGroupCreate(d *schema.ResourceData, meta inter
 		description = v.(string)
 	}
 	accessGroupId := ""
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		id, errRet := cfsService.CreateAccessGroup(ctx, name, description)
 		if errRet != nil {
 			return retryError(errRet)
@@ -87,26 +94,26 @@ func resourceTencentCloudCfsAccessGroupCreate(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(accessGroupId)
 
-	return resourceTencentCloudCfsAccessGroupRead(d, meta)
+	return resourceTencentCloudCfsAccessGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfsAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsAccessGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_access_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cfsService := CfsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
 	id := d.Id()
 	var accessGroup *cfs.PGroupInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		accessGroups, errRet := cfsService.DescribeAccessGroup(ctx, id, "")
 		if errRet != nil {
 			return retryError(errRet)
@@ -117,7 +124,7 @@ func resourceTencentCloudCfsAccessGroupRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if accessGroup == nil {
 		d.SetId("")
@@ -131,7 +138,7 @@ func resourceTencentCloudCfsAccessGroupRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudCfsAccessGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsAccessGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_access_group.update")()
 	logId := getLogId(contextNil)
 
@@ -144,9 +151,11 @@ func resourceTencentCloudCfsAccessGroupUpdate(d *schema.ResourceData, meta inter
 	}
 	id := d.Id()
 	request.PGroupId = &id
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().UpdateCfsPGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().UpdateCfsPGroupWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -157,22 +166,22 @@ func resourceTencentCloudCfsAccessGroupUpdate(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCfsAccessGroupRead(d, meta)
+	return resourceTencentCloudCfsAccessGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfsAccessGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsAccessGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_access_group.delete")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	cfsService := CfsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		errRet := cfsService.DeleteAccessGroup(ctx, id)
 		if errRet != nil {
 			return retryError(errRet)
@@ -180,7 +189,7 @@ func resourceTencentCloudCfsAccessGroupDelete(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
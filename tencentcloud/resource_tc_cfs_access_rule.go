@@ -12,6 +12,14 @@ resource "tencentcloud_cfs_access_rule" "foo" {
   user_permission = "root_squash"
 }
 ```
+
+Import
+
+CFS access rule can be imported using the id `access_group_id#rule_id`, e.g.
+
+```
+$ terraform import tencentcloud_cfs_access_rule.foo pgroup-7nx89k7l#rule-mqn1j6fb
+```
 */
 package tencentcloud
 
@@ -20,7 +28,9 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfs/v20190719"
@@ -30,10 +40,18 @@ import (
 
 func resourceTencentCloudCfsAccessRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfsAccessRuleCreate,
-		Read:   resourceTencentCloudCfsAccessRuleRead,
-		Update: resourceTencentCloudCfsAccessRuleUpdate,
-		Delete: resourceTencentCloudCfsAccessRuleDelete,
+		CreateContext: resourceTencentCloudCfsAccessRuleCreate,
+		ReadContext:   resourceTencentCloudCfsAccessRuleRead,
+		UpdateContext: resourceTencentCloudCfsAccessRuleUpdate,
+		DeleteContext: resourceTencentCloudCfsAccessRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: helper.ImportWithArguments("access_group_id"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
@@ -71,7 +89,7 @@ func resourceTencentCloudCfsAccessRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCfsAccessRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsAccessRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_access_rule.create")()
 	logId := getLogId(contextNil)
 
@@ -82,9 +100,11 @@ func resourceTencentCloudCfsAccessRuleCreate(d *schema.ResourceData, meta interf
 	request.RWPermission = helper.String(d.Get("rw_permission").(string))
 	request.UserPermission = helper.String(d.Get("user_permission").(string))
 	ruleId := ""
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().CreateCfsRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().CreateCfsRuleWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -100,19 +120,19 @@ func resourceTencentCloudCfsAccessRuleCreate(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(ruleId)
 
-	return resourceTencentCloudCfsAccessRuleRead(d, meta)
+	return resourceTencentCloudCfsAccessRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfsAccessRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsAccessRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_access_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	ruleId := d.Id()
 	groupId := d.Get("access_group_id").(string)
@@ -120,7 +140,7 @@ func resourceTencentCloudCfsAccessRuleRead(d *schema.ResourceData, meta interfac
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var accessRule *cfs.PGroupRuleInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		rules, errRet := cfsService.DescribeAccessRule(ctx, groupId, ruleId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -131,7 +151,7 @@ func resourceTencentCloudCfsAccessRuleRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if accessRule == nil {
 		d.SetId("")
@@ -148,7 +168,7 @@ func resourceTencentCloudCfsAccessRuleRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudCfsAccessRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsAccessRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_access_rule.update")()
 	logId := getLogId(contextNil)
 
@@ -168,9 +188,11 @@ func resourceTencentCloudCfsAccessRuleUpdate(d *schema.ResourceData, meta interf
 		request.Priority = helper.IntInt64(d.Get("priority").(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().UpdateCfsRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().UpdateCfsRuleWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -182,22 +204,22 @@ func resourceTencentCloudCfsAccessRuleUpdate(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCfsAccessRuleRead(d, meta)
+	return resourceTencentCloudCfsAccessRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfsAccessRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsAccessRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_access_rule.delete")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cfsService := CfsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	ruleId := d.Id()
 	groupId := d.Get("access_group_id").(string)
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		errRet := cfsService.DeleteAccessRule(ctx, groupId, ruleId)
 		if errRet != nil {
 			return retryError(errRet)
@@ -205,7 +227,7 @@ func resourceTencentCloudCfsAccessRuleDelete(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
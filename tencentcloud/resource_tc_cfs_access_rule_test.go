@@ -25,6 +25,12 @@ func TestAccTencentCloudCfsAccessRule(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_cfs_access_rule.foo", "access_group_id"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_cfs_access_rule.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccImportStateIdFunc("tencentcloud_cfs_access_rule.foo", "access_group_id", "id"),
+			},
 		},
 	})
 }
@@ -49,9 +49,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfs/v20190719"
@@ -60,12 +61,16 @@ import (
 
 func resourceTencentCloudCfsAutoSnapshotPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfsAutoSnapshotPolicyCreate,
-		Read:   resourceTencentCloudCfsAutoSnapshotPolicyRead,
-		Update: resourceTencentCloudCfsAutoSnapshotPolicyUpdate,
-		Delete: resourceTencentCloudCfsAutoSnapshotPolicyDelete,
+		CreateContext: resourceTencentCloudCfsAutoSnapshotPolicyCreate,
+		ReadContext:   resourceTencentCloudCfsAutoSnapshotPolicyRead,
+		UpdateContext: resourceTencentCloudCfsAutoSnapshotPolicyUpdate,
+		DeleteContext: resourceTencentCloudCfsAutoSnapshotPolicyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"hour": {
@@ -107,7 +112,7 @@ func resourceTencentCloudCfsAutoSnapshotPolicy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCfsAutoSnapshotPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsAutoSnapshotPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_auto_snapshot_policy.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -142,8 +147,8 @@ func resourceTencentCloudCfsAutoSnapshotPolicyCreate(d *schema.ResourceData, met
 		request.IntervalDays = helper.IntUint64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().CreateAutoSnapshotPolicy(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().CreateAutoSnapshotPolicyWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -154,22 +159,22 @@ func resourceTencentCloudCfsAutoSnapshotPolicyCreate(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cfs autoSnapshotPolicy failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	autoSnapshotPolicyId = *response.Response.AutoSnapshotPolicyId
 	d.SetId(autoSnapshotPolicyId)
 
-	return resourceTencentCloudCfsAutoSnapshotPolicyRead(d, meta)
+	return resourceTencentCloudCfsAutoSnapshotPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfsAutoSnapshotPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsAutoSnapshotPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_auto_snapshot_policy.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -177,12 +182,12 @@ func resourceTencentCloudCfsAutoSnapshotPolicyRead(d *schema.ResourceData, meta
 
 	autoSnapshotPolicy, err := service.DescribeCfsAutoSnapshotPolicyById(ctx, autoSnapshotPolicyId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if autoSnapshotPolicy == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `tencentcloud_cfs_auto_snapshot_policy` %s does not exist", d.Id())
+		return diag.Errorf("resource `tencentcloud_cfs_auto_snapshot_policy` %s does not exist", d.Id())
 	}
 
 	if autoSnapshotPolicy.DayOfWeek != nil {
@@ -212,7 +217,7 @@ func resourceTencentCloudCfsAutoSnapshotPolicyRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudCfsAutoSnapshotPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsAutoSnapshotPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_auto_snapshot_policy.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -259,8 +264,8 @@ func resourceTencentCloudCfsAutoSnapshotPolicyUpdate(d *schema.ResourceData, met
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().UpdateAutoSnapshotPolicy(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().UpdateAutoSnapshotPolicyWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -270,24 +275,24 @@ func resourceTencentCloudCfsAutoSnapshotPolicyUpdate(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cfs autoSnapshotPolicy failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCfsAutoSnapshotPolicyRead(d, meta)
+	return resourceTencentCloudCfsAutoSnapshotPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfsAutoSnapshotPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsAutoSnapshotPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_auto_snapshot_policy.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	autoSnapshotPolicyId := d.Id()
 
 	if err := service.DeleteCfsAutoSnapshotPolicyById(ctx, autoSnapshotPolicyId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -22,10 +22,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfs/v20190719"
@@ -34,11 +34,14 @@ import (
 
 func resourceTencentCloudCfsAutoSnapshotPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfsAutoSnapshotPolicyAttachmentCreate,
-		Read:   resourceTencentCloudCfsAutoSnapshotPolicyAttachmentRead,
-		Delete: resourceTencentCloudCfsAutoSnapshotPolicyAttachmentDelete,
+		CreateContext: resourceTencentCloudCfsAutoSnapshotPolicyAttachmentCreate,
+		ReadContext:   resourceTencentCloudCfsAutoSnapshotPolicyAttachmentRead,
+		DeleteContext: resourceTencentCloudCfsAutoSnapshotPolicyAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"auto_snapshot_policy_id": {
@@ -58,7 +61,7 @@ func resourceTencentCloudCfsAutoSnapshotPolicyAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCfsAutoSnapshotPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsAutoSnapshotPolicyAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_auto_snapshot_policy_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -79,8 +82,8 @@ func resourceTencentCloudCfsAutoSnapshotPolicyAttachmentCreate(d *schema.Resourc
 		request.FileSystemIds = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().BindAutoSnapshotPolicy(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().BindAutoSnapshotPolicyWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -90,39 +93,39 @@ func resourceTencentCloudCfsAutoSnapshotPolicyAttachmentCreate(d *schema.Resourc
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cfs autoSnapshotPolicyAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(autoSnapshotPolicyId + FILED_SP + fileSystemIds)
+	d.SetId(helper.IdFormat(autoSnapshotPolicyId, fileSystemIds))
 
-	return resourceTencentCloudCfsAutoSnapshotPolicyAttachmentRead(d, meta)
+	return resourceTencentCloudCfsAutoSnapshotPolicyAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfsAutoSnapshotPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsAutoSnapshotPolicyAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_auto_snapshot_policy_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	autoSnapshotPolicyId := idSplit[0]
 	fileSystemIds := idSplit[1]
 
 	autoSnapshotPolicyAttachment, err := service.DescribeCfsAutoSnapshotPolicyAttachmentById(ctx, autoSnapshotPolicyId, fileSystemIds)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if autoSnapshotPolicyAttachment == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `tencentcloud_cfs_auto_snapshot_policy_attachment` %s does not exist", d.Id())
+		return diag.Errorf("resource `tencentcloud_cfs_auto_snapshot_policy_attachment` %s does not exist", d.Id())
 	}
 
 	if autoSnapshotPolicyAttachment.AutoSnapshotPolicyId != nil {
@@ -136,23 +139,23 @@ func resourceTencentCloudCfsAutoSnapshotPolicyAttachmentRead(d *schema.ResourceD
 	return nil
 }
 
-func resourceTencentCloudCfsAutoSnapshotPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsAutoSnapshotPolicyAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_auto_snapshot_policy_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CfsService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	autoSnapshotPolicyId := idSplit[0]
 	fileSystemIds := idSplit[1]
 
 	if err := service.DeleteCfsAutoSnapshotPolicyAttachmentById(ctx, autoSnapshotPolicyId, fileSystemIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
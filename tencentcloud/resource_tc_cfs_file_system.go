@@ -76,7 +76,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfs/v20190719"
@@ -86,12 +88,17 @@ import (
 
 func resourceTencentCloudCfsFileSystem() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfsFileSystemCreate,
-		Read:   resourceTencentCloudCfsFileSystemRead,
-		Update: resourceTencentCloudCfsFileSystemUpdate,
-		Delete: resourceTencentCloudCfsFileSystemDelete,
+		CreateContext: resourceTencentCloudCfsFileSystemCreate,
+		ReadContext:   resourceTencentCloudCfsFileSystemRead,
+		UpdateContext: resourceTencentCloudCfsFileSystemUpdate,
+		DeleteContext: resourceTencentCloudCfsFileSystemDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -194,10 +201,10 @@ func resourceTencentCloudCfsFileSystem() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCfsFileSystemCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsFileSystemCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_file_system.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cfsService := CfsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -237,9 +244,11 @@ func resourceTencentCloudCfsFileSystemCreate(d *schema.ResourceData, meta interf
 	}
 
 	fsId := ""
-	err := resource.Retry(3*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().CreateCfsFileSystem(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().CreateCfsFileSystemWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -256,12 +265,12 @@ func resourceTencentCloudCfsFileSystemCreate(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fsId)
 
 	// wait for success status
-	err = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		fileSystems, errRet := cfsService.DescribeFileSystem(ctx, fsId, "", "")
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -275,33 +284,33 @@ func resourceTencentCloudCfsFileSystemCreate(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		resourceName := BuildTagResourceName("cfs", "filesystem", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCfsFileSystemRead(d, meta)
+	return resourceTencentCloudCfsFileSystemRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfsFileSystemRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsFileSystemRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_file_system.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	fsId := d.Id()
 	cfsService := CfsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var fileSystem *cfs.FileSystemInfo
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		fileSystems, errRet := cfsService.DescribeFileSystem(ctx, fsId, "", "")
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -312,7 +321,7 @@ func resourceTencentCloudCfsFileSystemRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if fileSystem == nil {
 		d.SetId("")
@@ -328,7 +337,7 @@ func resourceTencentCloudCfsFileSystemRead(d *schema.ResourceData, meta interfac
 	_ = d.Set("capacity", fileSystem.SizeLimit)
 
 	var mountTarget *cfs.MountInfo
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		targets, errRet := cfsService.DescribeMountTargets(ctx, fsId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -339,13 +348,13 @@ func resourceTencentCloudCfsFileSystemRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	tcClient := meta.(*TencentCloudClient).apiV3Conn
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "cfs", "filesystem", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("tags", tags)
@@ -362,10 +371,10 @@ func resourceTencentCloudCfsFileSystemRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudCfsFileSystemUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsFileSystemUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_file_system.update")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	fsId := d.Id()
 	cfsService := CfsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -375,14 +384,14 @@ func resourceTencentCloudCfsFileSystemUpdate(d *schema.ResourceData, meta interf
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
 	d.Partial(true)
 
 	if d.HasChange("name") {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			errRet := cfsService.ModifyFileSystemName(ctx, fsId, d.Get("name").(string))
 			if errRet != nil {
 				return retryError(errRet)
@@ -390,13 +399,13 @@ func resourceTencentCloudCfsFileSystemUpdate(d *schema.ResourceData, meta interf
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	if d.HasChange("access_group_id") {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			errRet := cfsService.ModifyFileSystemAccessGroup(ctx, fsId, d.Get("access_group_id").(string))
 			if errRet != nil {
 				return retryError(errRet)
@@ -404,7 +413,7 @@ func resourceTencentCloudCfsFileSystemUpdate(d *schema.ResourceData, meta interf
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -419,26 +428,26 @@ func resourceTencentCloudCfsFileSystemUpdate(d *schema.ResourceData, meta interf
 		resourceName := BuildTagResourceName("cfs", "filesystem", tcClient.Region, d.Id())
 		err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudCfsFileSystemRead(d, meta)
+	return resourceTencentCloudCfsFileSystemRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfsFileSystemDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsFileSystemDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_file_system.delete")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	fsId := d.Id()
 	cfsService := CfsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		errRet := cfsService.DeleteFileSystem(ctx, fsId)
 		if errRet != nil {
 			return retryError(errRet)
@@ -446,7 +455,7 @@ func resourceTencentCloudCfsFileSystemDelete(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
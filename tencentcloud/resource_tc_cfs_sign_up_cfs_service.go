@@ -10,8 +10,11 @@ resource "tencentcloud_cfs_sign_up_cfs_service" "sign_up_cfs_service" {}
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfs/v20190719"
@@ -19,11 +22,14 @@ import (
 
 func resourceTencentCloudCfsSignUpCfsService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfsSignUpCfsServiceCreate,
-		Read:   resourceTencentCloudCfsSignUpCfsServiceRead,
-		Delete: resourceTencentCloudCfsSignUpCfsServiceDelete,
+		CreateContext: resourceTencentCloudCfsSignUpCfsServiceCreate,
+		ReadContext:   resourceTencentCloudCfsSignUpCfsServiceRead,
+		DeleteContext: resourceTencentCloudCfsSignUpCfsServiceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cfs_service_status": {
@@ -35,7 +41,7 @@ func resourceTencentCloudCfsSignUpCfsService() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCfsSignUpCfsServiceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsSignUpCfsServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_cfs_sign_up_cfs_service.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -46,8 +52,8 @@ func resourceTencentCloudCfsSignUpCfsServiceCreate(d *schema.ResourceData, meta
 		response         = cfs.NewSignUpCfsServiceResponse()
 		cfsServiceStatus string
 	)
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().SignUpCfsService(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().SignUpCfsServiceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -64,10 +70,10 @@ func resourceTencentCloudCfsSignUpCfsServiceCreate(d *schema.ResourceData, meta
 	cfsServiceStatus = *response.Response.CfsServiceStatus
 	d.SetId(cfsServiceStatus)
 
-	return resourceTencentCloudCfsSignUpCfsServiceRead(d, meta)
+	return resourceTencentCloudCfsSignUpCfsServiceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfsSignUpCfsServiceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsSignUpCfsServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_sign_up_cfs_service.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -77,8 +83,8 @@ func resourceTencentCloudCfsSignUpCfsServiceRead(d *schema.ResourceData, meta in
 		request  = cfs.NewDescribeCfsServiceStatusRequest()
 		response = cfs.NewDescribeCfsServiceStatusResponse()
 	)
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().DescribeCfsServiceStatus(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().DescribeCfsServiceStatusWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -97,7 +103,7 @@ func resourceTencentCloudCfsSignUpCfsServiceRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTencentCloudCfsSignUpCfsServiceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsSignUpCfsServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_sign_up_cfs_service.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -29,6 +29,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfs/v20190719"
@@ -37,12 +38,17 @@ import (
 
 func resourceTencentCloudCfsSnapshot() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfsSnapshotCreate,
-		Read:   resourceTencentCloudCfsSnapshotRead,
-		Update: resourceTencentCloudCfsSnapshotUpdate,
-		Delete: resourceTencentCloudCfsSnapshotDelete,
+		CreateContext: resourceTencentCloudCfsSnapshotCreate,
+		ReadContext:   resourceTencentCloudCfsSnapshotRead,
+		UpdateContext: resourceTencentCloudCfsSnapshotUpdate,
+		DeleteContext: resourceTencentCloudCfsSnapshotDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(6 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"file_system_id": {
@@ -67,7 +73,7 @@ func resourceTencentCloudCfsSnapshot() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCfsSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_snapshot.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -96,8 +102,8 @@ func resourceTencentCloudCfsSnapshotCreate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().CreateCfsSnapshot(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().CreateCfsSnapshotWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -108,7 +114,7 @@ func resourceTencentCloudCfsSnapshotCreate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cfs snapshot failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	snapshotId = *response.Response.SnapshotId
@@ -116,32 +122,32 @@ func resourceTencentCloudCfsSnapshotCreate(d *schema.ResourceData, meta interfac
 
 	service := CfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"available"}, 2*readRetryTimeout, time.Second, service.CfsSnapshotStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"available"}, d.Timeout(schema.TimeoutCreate), time.Second, service.CfsSnapshotStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::cfs:%s:uin/:snap/%s", region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCfsSnapshotRead(d, meta)
+	return resourceTencentCloudCfsSnapshotRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfsSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_snapshot.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -149,7 +155,7 @@ func resourceTencentCloudCfsSnapshotRead(d *schema.ResourceData, meta interface{
 
 	snapshot, err := service.DescribeCfsSnapshotById(ctx, snapshotId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if snapshot == nil {
@@ -170,14 +176,14 @@ func resourceTencentCloudCfsSnapshotRead(d *schema.ResourceData, meta interface{
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "cfs", "snap", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudCfsSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_snapshot.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -198,8 +204,8 @@ func resourceTencentCloudCfsSnapshotUpdate(d *schema.ResourceData, meta interfac
 	}
 
 	if needChange {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().UpdateCfsSnapshotAttribute(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().UpdateCfsSnapshotAttributeWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -209,40 +215,40 @@ func resourceTencentCloudCfsSnapshotUpdate(d *schema.ResourceData, meta interfac
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update cfs snapshot failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("tags") {
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		oldTags, newTags := d.GetChange("tags")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("cfs", "snap", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCfsSnapshotRead(d, meta)
+	return resourceTencentCloudCfsSnapshotRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfsSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_snapshot.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	snapshotId := d.Id()
 
 	if err := service.DeleteCfsSnapshotById(ctx, snapshotId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err := resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		instance, errRet := service.DescribeCfsSnapshotById(ctx, snapshotId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -253,7 +259,7 @@ func resourceTencentCloudCfsSnapshotDelete(d *schema.ResourceData, meta interfac
 		return resource.RetryableError(fmt.Errorf("cfs snapshot status is %s, retry...", *instance.Status))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
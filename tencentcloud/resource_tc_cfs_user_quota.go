@@ -25,10 +25,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfs/v20190719"
@@ -37,12 +37,15 @@ import (
 
 func resourceTencentCloudCfsUserQuota() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfsUserQuotaCreate,
-		Read:   resourceTencentCloudCfsUserQuotaRead,
-		Update: resourceTencentCloudCfsUserQuotaUpdate,
-		Delete: resourceTencentCloudCfsUserQuotaDelete,
+		CreateContext: resourceTencentCloudCfsUserQuotaCreate,
+		ReadContext:   resourceTencentCloudCfsUserQuotaRead,
+		UpdateContext: resourceTencentCloudCfsUserQuotaUpdate,
+		DeleteContext: resourceTencentCloudCfsUserQuotaDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"file_system_id": {
@@ -78,7 +81,7 @@ func resourceTencentCloudCfsUserQuota() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCfsUserQuotaCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsUserQuotaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_user_quota.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -113,8 +116,8 @@ func resourceTencentCloudCfsUserQuotaCreate(d *schema.ResourceData, meta interfa
 		request.FileHardLimit = helper.IntUint64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().SetUserQuota(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().SetUserQuotaWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -124,27 +127,27 @@ func resourceTencentCloudCfsUserQuotaCreate(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cfs userQuota failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(fileSystemId + FILED_SP + userType + FILED_SP + userId)
+	d.SetId(helper.IdFormat(fileSystemId, userType, userId))
 
-	return resourceTencentCloudCfsUserQuotaRead(d, meta)
+	return resourceTencentCloudCfsUserQuotaRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfsUserQuotaRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsUserQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_user_quota.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	fileSystemId := idSplit[0]
 	userType := idSplit[1]
@@ -152,7 +155,7 @@ func resourceTencentCloudCfsUserQuotaRead(d *schema.ResourceData, meta interface
 
 	userQuota, err := service.DescribeCfsUserQuotaById(ctx, fileSystemId, userType, userId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if userQuota == nil {
@@ -184,7 +187,7 @@ func resourceTencentCloudCfsUserQuotaRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudCfsUserQuotaUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsUserQuotaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_user_quota.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -192,30 +195,30 @@ func resourceTencentCloudCfsUserQuotaUpdate(d *schema.ResourceData, meta interfa
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
-	return resourceTencentCloudCfsUserQuotaRead(d, meta)
+	return resourceTencentCloudCfsUserQuotaRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfsUserQuotaDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfsUserQuotaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfs_user_quota.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CfsService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	fileSystemId := idSplit[0]
 	userType := idSplit[1]
 	userId := idSplit[2]
 
 	if err := service.DeleteCfsUserQuotaById(ctx, fileSystemId, userType, userId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -37,7 +37,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfw "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfw/v20190904"
@@ -46,12 +48,16 @@ import (
 
 func resourceTencentCloudCfwAddressTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfwAddressTemplateCreate,
-		Read:   resourceTencentCloudCfwAddressTemplateRead,
-		Update: resourceTencentCloudCfwAddressTemplateUpdate,
-		Delete: resourceTencentCloudCfwAddressTemplateDelete,
+		CreateContext: resourceTencentCloudCfwAddressTemplateCreate,
+		ReadContext:   resourceTencentCloudCfwAddressTemplateRead,
+		UpdateContext: resourceTencentCloudCfwAddressTemplateUpdate,
+		DeleteContext: resourceTencentCloudCfwAddressTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -79,7 +85,7 @@ func resourceTencentCloudCfwAddressTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCfwAddressTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwAddressTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_address_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -106,8 +112,8 @@ func resourceTencentCloudCfwAddressTemplateCreate(d *schema.ResourceData, meta i
 		request.Type = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().CreateAddressTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().CreateAddressTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -120,29 +126,29 @@ func resourceTencentCloudCfwAddressTemplateCreate(d *schema.ResourceData, meta i
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cfw addressTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	uuid = *response.Response.Uuid
 	d.SetId(uuid)
 
-	return resourceTencentCloudCfwAddressTemplateRead(d, meta)
+	return resourceTencentCloudCfwAddressTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwAddressTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwAddressTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_address_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		uuid    = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	addressTemplate, err := service.DescribeCfwAddressTemplateById(ctx, uuid)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if addressTemplate == nil {
@@ -170,7 +176,7 @@ func resourceTencentCloudCfwAddressTemplateRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudCfwAddressTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwAddressTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_address_template.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -198,8 +204,8 @@ func resourceTencentCloudCfwAddressTemplateUpdate(d *schema.ResourceData, meta i
 		request.Type = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyAddressTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyAddressTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -211,25 +217,25 @@ func resourceTencentCloudCfwAddressTemplateUpdate(d *schema.ResourceData, meta i
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update cfw addressTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCfwAddressTemplateRead(d, meta)
+	return resourceTencentCloudCfwAddressTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwAddressTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwAddressTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_address_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		uuid    = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if err := service.DeleteCfwAddressTemplateById(ctx, uuid); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
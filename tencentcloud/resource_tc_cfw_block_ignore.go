@@ -51,11 +51,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfw "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfw/v20190904"
@@ -64,12 +64,16 @@ import (
 
 func resourceTencentCloudCfwBlockIgnore() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfwBlockIgnoreCreate,
-		Read:   resourceTencentCloudCfwBlockIgnoreRead,
-		Update: resourceTencentCloudCfwBlockIgnoreUpdate,
-		Delete: resourceTencentCloudCfwBlockIgnoreDelete,
+		CreateContext: resourceTencentCloudCfwBlockIgnoreCreate,
+		ReadContext:   resourceTencentCloudCfwBlockIgnoreRead,
+		UpdateContext: resourceTencentCloudCfwBlockIgnoreUpdate,
+		DeleteContext: resourceTencentCloudCfwBlockIgnoreDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"ip": {
@@ -116,7 +120,7 @@ func resourceTencentCloudCfwBlockIgnore() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCfwBlockIgnoreCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwBlockIgnoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_block_ignore.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -165,8 +169,8 @@ func resourceTencentCloudCfwBlockIgnoreCreate(d *schema.ResourceData, meta inter
 		ruleType = strconv.Itoa(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().CreateBlockIgnoreRuleList(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().CreateBlockIgnoreRuleListWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -178,27 +182,27 @@ func resourceTencentCloudCfwBlockIgnoreCreate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cfw blockIgnore failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{iP, domain, direction, ruleType}, FILED_SP))
+	d.SetId(helper.IdFormat(iP, domain, direction, ruleType))
 
-	return resourceTencentCloudCfwBlockIgnoreRead(d, meta)
+	return resourceTencentCloudCfwBlockIgnoreRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwBlockIgnoreRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwBlockIgnoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_block_ignore.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	iP := idSplit[0]
@@ -208,7 +212,7 @@ func resourceTencentCloudCfwBlockIgnoreRead(d *schema.ResourceData, meta interfa
 
 	blockIgnoreRule, err := service.DescribeCfwBlockIgnoreListById(ctx, iP, domain, direction, ruleType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if blockIgnoreRule == nil {
@@ -249,7 +253,7 @@ func resourceTencentCloudCfwBlockIgnoreRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudCfwBlockIgnoreUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwBlockIgnoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_block_ignore.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -263,13 +267,13 @@ func resourceTencentCloudCfwBlockIgnoreUpdate(d *schema.ResourceData, meta inter
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 
 	iP := idSplit[0]
@@ -302,8 +306,8 @@ func resourceTencentCloudCfwBlockIgnoreUpdate(d *schema.ResourceData, meta inter
 
 	request.Rule = &intrusionDefenseRule
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyBlockIgnoreRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyBlockIgnoreRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -314,25 +318,25 @@ func resourceTencentCloudCfwBlockIgnoreUpdate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update cfw blockIgnore failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCfwBlockIgnoreRead(d, meta)
+	return resourceTencentCloudCfwBlockIgnoreRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwBlockIgnoreDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwBlockIgnoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_block_ignore.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	iP := idSplit[0]
@@ -341,7 +345,7 @@ func resourceTencentCloudCfwBlockIgnoreDelete(d *schema.ResourceData, meta inter
 	ruleType := idSplit[3]
 
 	if err := service.DeleteCfwBlockIgnoreListById(ctx, iP, domain, direction, ruleType); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
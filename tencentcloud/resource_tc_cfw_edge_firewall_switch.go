@@ -27,6 +27,14 @@ resource "tencentcloud_cfw_edge_firewall_switch" "example" {
   enable      = 1
 }
 ```
+
+Import
+
+cfw edge_firewall_switch can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_cfw_edge_firewall_switch.example 1.1.1.1
+```
 */
 package tencentcloud
 
@@ -34,7 +42,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfw "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfw/v20190904"
@@ -43,10 +53,16 @@ import (
 
 func resourceTencentCloudCfwEdgeFirewallSwitch() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfwEdgeFirewallSwitchCreate,
-		Read:   resourceTencentCloudCfwEdgeFirewallSwitchRead,
-		Update: resourceTencentCloudCfwEdgeFirewallSwitchUpdate,
-		Delete: resourceTencentCloudCfwEdgeFirewallSwitchDelete,
+		CreateContext: resourceTencentCloudCfwEdgeFirewallSwitchCreate,
+		ReadContext:   resourceTencentCloudCfwEdgeFirewallSwitchRead,
+		UpdateContext: resourceTencentCloudCfwEdgeFirewallSwitchUpdate,
+		DeleteContext: resourceTencentCloudCfwEdgeFirewallSwitchDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"public_ip": {
@@ -74,30 +90,30 @@ func resourceTencentCloudCfwEdgeFirewallSwitch() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCfwEdgeFirewallSwitchCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwEdgeFirewallSwitchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_edge_firewall_switch.create")()
 	defer inconsistentCheck(d, meta)()
 
 	publicIp := d.Get("public_ip").(string)
 	d.SetId(publicIp)
 
-	return resourceTencentCloudCfwEdgeFirewallSwitchUpdate(d, meta)
+	return resourceTencentCloudCfwEdgeFirewallSwitchUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwEdgeFirewallSwitchRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwEdgeFirewallSwitchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_edge_firewall_switch.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId    = getLogId(contextNil)
-		ctx      = context.WithValue(context.TODO(), logIdKey, logId)
 		service  = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		publicIp = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	edgeFirewallSwitch, err := service.DescribeCfwEdgeFirewallSwitchById(ctx, publicIp)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if edgeFirewallSwitch == nil {
@@ -121,18 +137,18 @@ func resourceTencentCloudCfwEdgeFirewallSwitchRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudCfwEdgeFirewallSwitchUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwEdgeFirewallSwitchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_edge_firewall_switch.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId        = getLogId(contextNil)
-		ctx          = context.WithValue(context.TODO(), logIdKey, logId)
 		service      = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request      = cfw.NewModifyEdgeIpSwitchRequest()
 		edgeIpSwitch = cfw.EdgeIpSwitch{}
 		publicIp     = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	edgeIpSwitch.PublicIp = &publicIp
 
@@ -150,8 +166,8 @@ func resourceTencentCloudCfwEdgeFirewallSwitchUpdate(d *schema.ResourceData, met
 
 	request.EdgeIpSwitchLst = append(request.EdgeIpSwitchLst, &edgeIpSwitch)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyEdgeIpSwitch(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyEdgeIpSwitchWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -163,11 +179,11 @@ func resourceTencentCloudCfwEdgeFirewallSwitchUpdate(d *schema.ResourceData, met
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update cfw edgeFirewallSwitch failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// wait
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		switchDetail, e := service.DescribeCfwEdgeFirewallSwitchById(ctx, publicIp)
 		if e != nil {
 			return retryError(e)
@@ -181,13 +197,13 @@ func resourceTencentCloudCfwEdgeFirewallSwitchUpdate(d *schema.ResourceData, met
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCfwEdgeFirewallSwitchRead(d, meta)
+	return resourceTencentCloudCfwEdgeFirewallSwitchRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwEdgeFirewallSwitchDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwEdgeFirewallSwitchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_edge_firewall_switch.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -33,6 +33,12 @@ func TestAccTencentCloudNeedFixCfwEdgeFirewallSwitchResource_basic(t *testing.T)
 					resource.TestCheckResourceAttrSet("tencentcloud_cfw_edge_firewall_switch.example", "enable"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_cfw_edge_firewall_switch.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"subnet_id"},
+			},
 		},
 	})
 }
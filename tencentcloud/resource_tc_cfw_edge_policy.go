@@ -31,10 +31,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfw "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfw/v20190904"
@@ -43,12 +44,16 @@ import (
 
 func resourceTencentCloudCfwEdgePolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfwEdgePolicyCreate,
-		Read:   resourceTencentCloudCfwEdgePolicyRead,
-		Update: resourceTencentCloudCfwEdgePolicyUpdate,
-		Delete: resourceTencentCloudCfwEdgePolicyDelete,
+		CreateContext: resourceTencentCloudCfwEdgePolicyCreate,
+		ReadContext:   resourceTencentCloudCfwEdgePolicyRead,
+		UpdateContext: resourceTencentCloudCfwEdgePolicyUpdate,
+		DeleteContext: resourceTencentCloudCfwEdgePolicyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"source_content": {
@@ -120,7 +125,7 @@ func resourceTencentCloudCfwEdgePolicy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCfwEdgePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwEdgePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_edge_policy.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -178,8 +183,8 @@ func resourceTencentCloudCfwEdgePolicyCreate(d *schema.ResourceData, meta interf
 
 	request.Rules = append(request.Rules, &createRuleItem)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().AddAclRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().AddAclRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -192,30 +197,30 @@ func resourceTencentCloudCfwEdgePolicyCreate(d *schema.ResourceData, meta interf
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cfw edgePolicy failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleUuid := *response.Response.RuleUuid[0]
 	uuid = strconv.FormatInt(ruleUuid, 10)
 	d.SetId(uuid)
 
-	return resourceTencentCloudCfwEdgePolicyRead(d, meta)
+	return resourceTencentCloudCfwEdgePolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwEdgePolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwEdgePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_edge_policy.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId    = getLogId(contextNil)
-		ctx      = context.WithValue(context.TODO(), logIdKey, logId)
 		service  = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		ruleUuid = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	edgePolicy, err := service.DescribeCfwEdgePolicyById(ctx, ruleUuid)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if edgePolicy == nil {
@@ -271,7 +276,7 @@ func resourceTencentCloudCfwEdgePolicyRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudCfwEdgePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwEdgePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_edge_policy.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -286,7 +291,7 @@ func resourceTencentCloudCfwEdgePolicyUpdate(d *schema.ResourceData, meta interf
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -339,8 +344,8 @@ func resourceTencentCloudCfwEdgePolicyUpdate(d *schema.ResourceData, meta interf
 
 	request.Rules = append(request.Rules, &modifyRuleItem)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyAclRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyAclRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -352,25 +357,25 @@ func resourceTencentCloudCfwEdgePolicyUpdate(d *schema.ResourceData, meta interf
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update cfw edgePolicy failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCfwEdgePolicyRead(d, meta)
+	return resourceTencentCloudCfwEdgePolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwEdgePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwEdgePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_edge_policy.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		uuid    = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if err := service.DeleteCfwEdgePolicyById(ctx, uuid); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
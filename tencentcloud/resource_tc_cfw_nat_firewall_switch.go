@@ -45,8 +45,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfw "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfw/v20190904"
@@ -56,12 +57,15 @@ import (
 
 func resourceTencentCloudCfwNatFirewallSwitch() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfwNatFirewallSwitchCreate,
-		Read:   resourceTencentCloudCfwNatFirewallSwitchRead,
-		Update: resourceTencentCloudCfwNatFirewallSwitchUpdate,
-		Delete: resourceTencentCloudCfwNatFirewallSwitchDelete,
+		CreateContext: resourceTencentCloudCfwNatFirewallSwitchCreate,
+		ReadContext:   resourceTencentCloudCfwNatFirewallSwitchRead,
+		UpdateContext: resourceTencentCloudCfwNatFirewallSwitchUpdate,
+		DeleteContext: resourceTencentCloudCfwNatFirewallSwitchDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"nat_ins_id": {
@@ -85,38 +89,38 @@ func resourceTencentCloudCfwNatFirewallSwitch() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCfwNatFirewallSwitchCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwNatFirewallSwitchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_nat_firewall_switch.create")()
 	defer inconsistentCheck(d, meta)()
 
 	natInsId := d.Get("nat_ins_id").(string)
 	subnetId := d.Get("subnet_id").(string)
 
-	d.SetId(strings.Join([]string{natInsId, subnetId}, FILED_SP))
+	d.SetId(helper.IdFormat(natInsId, subnetId))
 
-	return resourceTencentCloudCfwNatFirewallSwitchUpdate(d, meta)
+	return resourceTencentCloudCfwNatFirewallSwitchUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwNatFirewallSwitchRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwNatFirewallSwitchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_nat_firewall_switch.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	natInsId := idSplit[0]
 	subnetId := idSplit[1]
 
 	natFirewallSwitch, err := service.DescribeCfwNatFirewallSwitchById(ctx, natInsId, subnetId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if natFirewallSwitch == nil {
@@ -132,20 +136,20 @@ func resourceTencentCloudCfwNatFirewallSwitchRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudCfwNatFirewallSwitchUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwNatFirewallSwitchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_nat_firewall_switch.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request = cfw.NewModifyNatFwSwitchRequest()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+		return diag.Errorf("id is broken,%s", idSplit)
 	}
 	natInsId := idSplit[0]
 	subnetId := idSplit[1]
@@ -156,8 +160,8 @@ func resourceTencentCloudCfwNatFirewallSwitchUpdate(d *schema.ResourceData, meta
 
 	request.SubnetIdList = common.StringPtrs([]string{subnetId})
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyNatFwSwitch(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyNatFwSwitchWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -169,11 +173,11 @@ func resourceTencentCloudCfwNatFirewallSwitchUpdate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update cfw natFirewallSwitch failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// wait
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		switchDetail, e := service.DescribeCfwNatFirewallSwitchById(ctx, natInsId, subnetId)
 		if e != nil {
 			return retryError(e)
@@ -187,13 +191,13 @@ func resourceTencentCloudCfwNatFirewallSwitchUpdate(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCfwNatFirewallSwitchRead(d, meta)
+	return resourceTencentCloudCfwNatFirewallSwitchRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwNatFirewallSwitchDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwNatFirewallSwitchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_nat_firewall_switch.delete")()
 	defer inconsistentCheck(d, meta)()
 
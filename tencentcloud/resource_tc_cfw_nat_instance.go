@@ -58,7 +58,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfw "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfw/v20190904"
@@ -67,12 +69,16 @@ import (
 
 func resourceTencentCloudCfwNatInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfwNatInstanceCreate,
-		Read:   resourceTencentCloudCfwNatInstanceRead,
-		Update: resourceTencentCloudCfwNatInstanceUpdate,
-		Delete: resourceTencentCloudCfwNatInstanceDelete,
+		CreateContext: resourceTencentCloudCfwNatInstanceCreate,
+		ReadContext:   resourceTencentCloudCfwNatInstanceRead,
+		UpdateContext: resourceTencentCloudCfwNatInstanceUpdate,
+		DeleteContext: resourceTencentCloudCfwNatInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -185,13 +191,12 @@ func resourceTencentCloudCfwNatInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCfwNatInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwNatInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_nat_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = cfw.NewCreateNatFwInstanceWithDomainRequest()
 		response   = cfw.NewCreateNatFwInstanceWithDomainResponse()
@@ -199,6 +204,7 @@ func resourceTencentCloudCfwNatInstanceCreate(d *schema.ResourceData, meta inter
 		mode       int
 		crossAZone int
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("name"); ok {
 		request.Name = helper.String(v.(string))
@@ -243,7 +249,7 @@ func resourceTencentCloudCfwNatInstanceCreate(d *schema.ResourceData, meta inter
 			}
 
 		} else {
-			return fmt.Errorf("If `mode` is 0, `new_mode_items` is required.")
+			return diag.Errorf("If `mode` is 0, `new_mode_items` is required.")
 		}
 
 	} else {
@@ -258,7 +264,7 @@ func resourceTencentCloudCfwNatInstanceCreate(d *schema.ResourceData, meta inter
 			request.NatGwList = tmqGwList
 
 		} else {
-			return fmt.Errorf("If `mode` is 1, `nat_gw_list` is required.")
+			return diag.Errorf("If `mode` is 1, `nat_gw_list` is required.")
 		}
 	}
 
@@ -268,14 +274,14 @@ func resourceTencentCloudCfwNatInstanceCreate(d *schema.ResourceData, meta inter
 			zoneList := v.(*schema.Set).List()
 			if crossAZone == CROSS_A_ZONE_0 {
 				if len(zoneList) != 1 {
-					return fmt.Errorf("If `cross_a_zone` is 0, `zone_set` only can be set one zone.")
+					return diag.Errorf("If `cross_a_zone` is 0, `zone_set` only can be set one zone.")
 				}
 
 				request.Zone = helper.String(zoneList[0].(string))
 
 			} else {
 				if len(zoneList) != 2 {
-					return fmt.Errorf("If `cross_a_zone` is 1, `zone_set` must be set tow zones.")
+					return diag.Errorf("If `cross_a_zone` is 1, `zone_set` must be set tow zones.")
 				}
 
 				request.Zone = helper.String(zoneList[0].(string))
@@ -331,8 +337,8 @@ func resourceTencentCloudCfwNatInstanceCreate(d *schema.ResourceData, meta inter
 	fwCidrInfo.ComFwCidr = helper.String("")
 	request.FwCidrInfo = &fwCidrInfo
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().CreateNatFwInstanceWithDomain(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().CreateNatFwInstanceWithDomainWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -345,14 +351,14 @@ func resourceTencentCloudCfwNatInstanceCreate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cfw natInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId = *response.Response.CfwInsId
 	d.SetId(instanceId)
 
 	// wait
-	err = resource.Retry(writeRetryTimeout*3, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		natInstance, e := service.DescribeCfwNatInstanceById(ctx, instanceId)
 		if e != nil {
 			return retryError(e)
@@ -372,28 +378,28 @@ func resourceTencentCloudCfwNatInstanceCreate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cfw natInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCfwNatInstanceRead(d, meta)
+	return resourceTencentCloudCfwNatInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwNatInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwNatInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_nat_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 		zone       string
 		zoneBak    string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	natInstance, err := service.DescribeCfwNatInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if natInstance == nil {
@@ -418,7 +424,7 @@ func resourceTencentCloudCfwNatInstanceRead(d *schema.ResourceData, meta interfa
 			newModeItemsMap := map[string]interface{}{}
 			vpcList, err := service.DescribeNatFwVpcDnsLstById(ctx, instanceId)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			if vpcList == nil {
@@ -440,7 +446,7 @@ func resourceTencentCloudCfwNatInstanceRead(d *schema.ResourceData, meta interfa
 		} else {
 			natGwList, err := service.DescribeCfwEipsById(ctx, instanceId)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			if natGwList == nil {
@@ -520,7 +526,7 @@ func resourceTencentCloudCfwNatInstanceRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudCfwNatInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwNatInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_nat_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -534,7 +540,7 @@ func resourceTencentCloudCfwNatInstanceUpdate(d *schema.ResourceData, meta inter
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -544,8 +550,8 @@ func resourceTencentCloudCfwNatInstanceUpdate(d *schema.ResourceData, meta inter
 		request.InstanceName = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyNatInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyNatInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -557,25 +563,25 @@ func resourceTencentCloudCfwNatInstanceUpdate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update cfw natInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCfwNatInstanceRead(d, meta)
+	return resourceTencentCloudCfwNatInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwNatInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwNatInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_nat_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if err := service.DeleteCfwNatInstanceById(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -30,10 +30,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfw "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfw/v20190904"
@@ -42,12 +43,16 @@ import (
 
 func resourceTencentCloudCfwNatPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfwNatPolicyCreate,
-		Read:   resourceTencentCloudCfwNatPolicyRead,
-		Update: resourceTencentCloudCfwNatPolicyUpdate,
-		Delete: resourceTencentCloudCfwNatPolicyDelete,
+		CreateContext: resourceTencentCloudCfwNatPolicyCreate,
+		ReadContext:   resourceTencentCloudCfwNatPolicyRead,
+		UpdateContext: resourceTencentCloudCfwNatPolicyUpdate,
+		DeleteContext: resourceTencentCloudCfwNatPolicyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"source_content": {
@@ -112,7 +117,7 @@ func resourceTencentCloudCfwNatPolicy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCfwNatPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwNatPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_nat_policy.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -166,8 +171,8 @@ func resourceTencentCloudCfwNatPolicyCreate(d *schema.ResourceData, meta interfa
 
 	request.Rules = append(request.Rules, &createNatRuleItem)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().AddNatAcRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().AddNatAcRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -180,30 +185,30 @@ func resourceTencentCloudCfwNatPolicyCreate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cfw natPolicy failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleUuid := *response.Response.RuleUuid[0]
 	uuid = strconv.FormatInt(ruleUuid, 10)
 	d.SetId(uuid)
 
-	return resourceTencentCloudCfwNatPolicyRead(d, meta)
+	return resourceTencentCloudCfwNatPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwNatPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwNatPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_nat_policy.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId    = getLogId(contextNil)
-		ctx      = context.WithValue(context.TODO(), logIdKey, logId)
 		service  = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		ruleUuid = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	natPolicy, err := service.DescribeCfwNatPolicyById(ctx, ruleUuid)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if natPolicy == nil {
@@ -259,7 +264,7 @@ func resourceTencentCloudCfwNatPolicyRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudCfwNatPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwNatPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_nat_policy.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -274,7 +279,7 @@ func resourceTencentCloudCfwNatPolicyUpdate(d *schema.ResourceData, meta interfa
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -323,8 +328,8 @@ func resourceTencentCloudCfwNatPolicyUpdate(d *schema.ResourceData, meta interfa
 
 	request.Rules = append(request.Rules, &modifyRuleItem)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyNatAcRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyNatAcRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -336,25 +341,25 @@ func resourceTencentCloudCfwNatPolicyUpdate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update cfw natPolicy failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCfwNatPolicyRead(d, meta)
+	return resourceTencentCloudCfwNatPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwNatPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwNatPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_nat_policy.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		uuid    = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if err := service.DeleteCfwNatPolicyById(ctx, uuid); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
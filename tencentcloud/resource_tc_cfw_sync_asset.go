@@ -10,11 +10,13 @@ resource "tencentcloud_cfw_sync_asset" "example" {}
 package tencentcloud
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfw "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfw/v20190904"
@@ -22,14 +24,17 @@ import (
 
 func resourceTencentCloudCfwSyncAsset() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfwSyncAssetCreate,
-		Read:   resourceTencentCloudCfwSyncAssetRead,
-		Delete: resourceTencentCloudCfwSyncAssetDelete,
+		CreateContext: resourceTencentCloudCfwSyncAssetCreate,
+		ReadContext:   resourceTencentCloudCfwSyncAssetRead,
+		DeleteContext: resourceTencentCloudCfwSyncAssetDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{},
 	}
 }
 
-func resourceTencentCloudCfwSyncAssetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwSyncAssetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_sync_asset.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -39,8 +44,8 @@ func resourceTencentCloudCfwSyncAssetCreate(d *schema.ResourceData, meta interfa
 		statusRequest = cfw.NewDescribeFwSyncStatusRequest()
 	)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyAssetSync(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyAssetSyncWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -52,12 +57,12 @@ func resourceTencentCloudCfwSyncAssetCreate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate cfw syncAsset failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// wait
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().DescribeFwSyncStatus(statusRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().DescribeFwSyncStatusWithContext(ctx, statusRequest)
 		if e != nil {
 			return retryError(e)
 		}
@@ -71,22 +76,22 @@ func resourceTencentCloudCfwSyncAssetCreate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate cfw syncAsset status failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
 
-	return resourceTencentCloudCfwSyncAssetRead(d, meta)
+	return resourceTencentCloudCfwSyncAssetRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwSyncAssetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwSyncAssetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_sync_asset.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCfwSyncAssetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwSyncAssetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_sync_asset.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -13,11 +13,13 @@ resource "tencentcloud_cfw_sync_route" "example" {
 package tencentcloud
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfw "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfw/v20190904"
@@ -26,9 +28,12 @@ import (
 
 func resourceTencentCloudCfwSyncRoute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfwSyncRouteCreate,
-		Read:   resourceTencentCloudCfwSyncRouteRead,
-		Delete: resourceTencentCloudCfwSyncRouteDelete,
+		CreateContext: resourceTencentCloudCfwSyncRouteCreate,
+		ReadContext:   resourceTencentCloudCfwSyncRouteRead,
+		DeleteContext: resourceTencentCloudCfwSyncRouteDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"sync_type": {
@@ -48,7 +53,7 @@ func resourceTencentCloudCfwSyncRoute() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCfwSyncRouteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwSyncRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_sync_route.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -66,8 +71,8 @@ func resourceTencentCloudCfwSyncRouteCreate(d *schema.ResourceData, meta interfa
 		request.FwType = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().SyncFwOperate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().SyncFwOperateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -79,12 +84,12 @@ func resourceTencentCloudCfwSyncRouteCreate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate cfw syncRoute failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// wait
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().DescribeFwSyncStatus(statusRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().DescribeFwSyncStatusWithContext(ctx, statusRequest)
 		if e != nil {
 			return retryError(e)
 		}
@@ -98,22 +103,22 @@ func resourceTencentCloudCfwSyncRouteCreate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate cfw syncAsset status failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
 
-	return resourceTencentCloudCfwSyncRouteRead(d, meta)
+	return resourceTencentCloudCfwSyncRouteRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwSyncRouteRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwSyncRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_sync_route.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCfwSyncRouteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwSyncRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_sync_route.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -44,8 +44,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfw "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfw/v20190904"
@@ -55,12 +56,15 @@ import (
 
 func resourceTencentCloudCfwVpcFirewallSwitch() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfwVpcFirewallSwitchCreate,
-		Read:   resourceTencentCloudCfwVpcFirewallSwitchRead,
-		Update: resourceTencentCloudCfwVpcFirewallSwitchUpdate,
-		Delete: resourceTencentCloudCfwVpcFirewallSwitchDelete,
+		CreateContext: resourceTencentCloudCfwVpcFirewallSwitchCreate,
+		ReadContext:   resourceTencentCloudCfwVpcFirewallSwitchRead,
+		UpdateContext: resourceTencentCloudCfwVpcFirewallSwitchUpdate,
+		DeleteContext: resourceTencentCloudCfwVpcFirewallSwitchDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"vpc_ins_id": {
@@ -84,38 +88,38 @@ func resourceTencentCloudCfwVpcFirewallSwitch() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCfwVpcFirewallSwitchCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwVpcFirewallSwitchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_vpc_firewall_switch.create")()
 	defer inconsistentCheck(d, meta)()
 
 	vpcInsId := d.Get("vpc_ins_id").(string)
 	switchId := d.Get("switch_id").(string)
 
-	d.SetId(strings.Join([]string{vpcInsId, switchId}, FILED_SP))
+	d.SetId(helper.IdFormat(vpcInsId, switchId))
 
-	return resourceTencentCloudCfwVpcFirewallSwitchUpdate(d, meta)
+	return resourceTencentCloudCfwVpcFirewallSwitchUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwVpcFirewallSwitchRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwVpcFirewallSwitchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_vpc_firewall_switch.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	vpcInsId := idSplit[0]
 	switchId := idSplit[1]
 
 	vpcFirewallSwitch, err := service.DescribeCfwVpcFirewallSwitchById(ctx, vpcInsId, switchId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if vpcFirewallSwitch == nil {
@@ -131,21 +135,21 @@ func resourceTencentCloudCfwVpcFirewallSwitchRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudCfwVpcFirewallSwitchUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwVpcFirewallSwitchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_vpc_firewall_switch.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = cfw.NewModifyFwGroupSwitchRequest()
 		switchMode int64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	vpcInsId := idSplit[0]
 	switchId := idSplit[1]
@@ -153,7 +157,7 @@ func resourceTencentCloudCfwVpcFirewallSwitchUpdate(d *schema.ResourceData, meta
 	// get switchMode
 	vpcFirewallSwitch, err := service.DescribeCfwVpcFirewallSwitchById(ctx, vpcInsId, switchId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if vpcFirewallSwitch == nil {
@@ -178,8 +182,8 @@ func resourceTencentCloudCfwVpcFirewallSwitchUpdate(d *schema.ResourceData, meta
 		},
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyFwGroupSwitch(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyFwGroupSwitchWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -191,11 +195,11 @@ func resourceTencentCloudCfwVpcFirewallSwitchUpdate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update cfw vpcFirewallSwitch failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// wait
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		switchDetail, e := service.DescribeCfwVpcFirewallSwitchById(ctx, vpcInsId, switchId)
 		if e != nil {
 			return retryError(e)
@@ -209,13 +213,13 @@ func resourceTencentCloudCfwVpcFirewallSwitchUpdate(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCfwVpcFirewallSwitchRead(d, meta)
+	return resourceTencentCloudCfwVpcFirewallSwitchRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwVpcFirewallSwitchDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwVpcFirewallSwitchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_vpc_firewall_switch.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -71,7 +71,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfw "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfw/v20190904"
@@ -80,12 +82,16 @@ import (
 
 func resourceTencentCloudCfwVpcInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfwVpcInstanceCreate,
-		Read:   resourceTencentCloudCfwVpcInstanceRead,
-		Update: resourceTencentCloudCfwVpcInstanceUpdate,
-		Delete: resourceTencentCloudCfwVpcInstanceDelete,
+		CreateContext: resourceTencentCloudCfwVpcInstanceCreate,
+		ReadContext:   resourceTencentCloudCfwVpcInstanceRead,
+		UpdateContext: resourceTencentCloudCfwVpcInstanceUpdate,
+		DeleteContext: resourceTencentCloudCfwVpcInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -180,19 +186,19 @@ func resourceTencentCloudCfwVpcInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCfwVpcInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwVpcInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_vpc_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request   = cfw.NewCreateVpcFwGroupRequest()
 		response  = cfw.NewCreateVpcFwGroupResponse()
 		fwGroupId string
 		mode      int
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("name"); ok {
 		request.Name = helper.String(v.(string))
@@ -215,7 +221,7 @@ func resourceTencentCloudCfwVpcInstanceCreate(d *schema.ResourceData, meta inter
 				if v, ok := dMap["vpc_ids"]; ok {
 					vpcIdsSet := v.(*schema.Set).List()
 					if len(vpcIdsSet) == 0 {
-						return fmt.Errorf("If `mode` is 0, `vpc_ids` is required.")
+						return diag.Errorf("If `mode` is 0, `vpc_ids` is required.")
 					}
 
 					for i := range vpcIdsSet {
@@ -240,14 +246,14 @@ func resourceTencentCloudCfwVpcInstanceCreate(d *schema.ResourceData, meta inter
 							zoneList := v.(*schema.Set).List()
 							if crossAZone == CROSS_A_ZONE_0 {
 								if len(zoneList) != 1 {
-									return fmt.Errorf("If `cross_a_zone` is 0, `zone_set` only can be set one zone.")
+									return diag.Errorf("If `cross_a_zone` is 0, `zone_set` only can be set one zone.")
 								}
 
 								fwDeploy.Zone = helper.String(zoneList[0].(string))
 
 							} else {
 								if len(zoneList) != 2 {
-									return fmt.Errorf("If `cross_a_zone` is 1, `zone_set` must be set tow zones.")
+									return diag.Errorf("If `cross_a_zone` is 1, `zone_set` must be set tow zones.")
 								}
 
 								fwDeploy.Zone = helper.String(zoneList[0].(string))
@@ -266,7 +272,7 @@ func resourceTencentCloudCfwVpcInstanceCreate(d *schema.ResourceData, meta inter
 		}
 
 		if _, ok := d.GetOk("ccn_id"); ok {
-			return fmt.Errorf("If `mode` is 0, `ccn_id` is not supported.")
+			return diag.Errorf("If `mode` is 0, `ccn_id` is not supported.")
 		}
 
 		if v, ok := d.GetOkExists("switch_mode"); ok {
@@ -290,7 +296,7 @@ func resourceTencentCloudCfwVpcInstanceCreate(d *schema.ResourceData, meta inter
 				if v, ok := dMap["vpc_ids"]; ok {
 					vpcIdsSet := v.(*schema.Set).List()
 					if len(vpcIdsSet) != 0 {
-						return fmt.Errorf("If `mode` is 1, `vpc_ids` is not supported.")
+						return diag.Errorf("If `mode` is 1, `vpc_ids` is not supported.")
 					}
 				}
 
@@ -310,14 +316,14 @@ func resourceTencentCloudCfwVpcInstanceCreate(d *schema.ResourceData, meta inter
 							zoneList := v.(*schema.Set).List()
 							if crossAZone == CROSS_A_ZONE_0 {
 								if len(zoneList) != 1 {
-									return fmt.Errorf("If `cross_a_zone` is 0, `zone_set` only can be set one zone.")
+									return diag.Errorf("If `cross_a_zone` is 0, `zone_set` only can be set one zone.")
 								}
 
 								fwDeploy.Zone = helper.String(zoneList[0].(string))
 
 							} else {
 								if len(zoneList) != 2 {
-									return fmt.Errorf("If `cross_a_zone` is 1, `zone_set` must be set tow zones.")
+									return diag.Errorf("If `cross_a_zone` is 1, `zone_set` must be set tow zones.")
 								}
 
 								fwDeploy.Zone = helper.String(zoneList[0].(string))
@@ -338,13 +344,13 @@ func resourceTencentCloudCfwVpcInstanceCreate(d *schema.ResourceData, meta inter
 		if v, ok := d.GetOk("ccn_id"); ok {
 			request.CcnId = helper.String(v.(string))
 		} else {
-			return fmt.Errorf("If `mode` is 1, `ccn_id` is required.")
+			return diag.Errorf("If `mode` is 1, `ccn_id` is required.")
 		}
 
 		if v, ok := d.GetOkExists("switch_mode"); ok {
 			switchMode := v.(int)
 			if switchMode == SWITCH_MODE_2 {
-				return fmt.Errorf("If `mode` is 1, `switch_mode` only support 1, 4.")
+				return diag.Errorf("If `mode` is 1, `switch_mode` only support 1, 4.")
 			}
 
 			request.SwitchMode = helper.IntInt64(v.(int))
@@ -360,8 +366,8 @@ func resourceTencentCloudCfwVpcInstanceCreate(d *schema.ResourceData, meta inter
 		request.FwVpcCidr = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().CreateVpcFwGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().CreateVpcFwGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -374,14 +380,14 @@ func resourceTencentCloudCfwVpcInstanceCreate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cfw vpcInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	fwGroupId = *response.Response.FwGroupId
 	d.SetId(fwGroupId)
 
 	// wait
-	err = resource.Retry(writeRetryTimeout*3, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		vpcFwGroupInfo, e := service.DescribeFwGroupInstanceInfoById(ctx, fwGroupId)
 		if e != nil {
 			return retryError(e)
@@ -401,27 +407,27 @@ func resourceTencentCloudCfwVpcInstanceCreate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cfw vpcInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCfwVpcInstanceRead(d, meta)
+	return resourceTencentCloudCfwVpcInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwVpcInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwVpcInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_vpc_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		fwGroupId = d.Id()
 		mode      int64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	vpcInstance, err := service.DescribeCfwVpcInstanceById(ctx, fwGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if vpcInstance == nil {
@@ -520,7 +526,7 @@ func resourceTencentCloudCfwVpcInstanceRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudCfwVpcInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwVpcInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_vpc_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -534,7 +540,7 @@ func resourceTencentCloudCfwVpcInstanceUpdate(d *schema.ResourceData, meta inter
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -546,8 +552,8 @@ func resourceTencentCloudCfwVpcInstanceUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyVpcFwGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyVpcFwGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -559,25 +565,25 @@ func resourceTencentCloudCfwVpcInstanceUpdate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update cfw vpcInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCfwVpcInstanceRead(d, meta)
+	return resourceTencentCloudCfwVpcInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwVpcInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwVpcInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_vpc_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		fwGroupId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if err := service.DeleteCfwVpcInstanceById(ctx, fwGroupId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
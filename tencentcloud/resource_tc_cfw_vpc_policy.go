@@ -33,7 +33,9 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfw "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfw/v20190904"
@@ -42,12 +44,16 @@ import (
 
 func resourceTencentCloudCfwVpcPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfwVpcPolicyCreate,
-		Read:   resourceTencentCloudCfwVpcPolicyRead,
-		Update: resourceTencentCloudCfwVpcPolicyUpdate,
-		Delete: resourceTencentCloudCfwVpcPolicyDelete,
+		CreateContext: resourceTencentCloudCfwVpcPolicyCreate,
+		ReadContext:   resourceTencentCloudCfwVpcPolicyRead,
+		UpdateContext: resourceTencentCloudCfwVpcPolicyUpdate,
+		DeleteContext: resourceTencentCloudCfwVpcPolicyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"source_content": {
@@ -123,7 +129,7 @@ func resourceTencentCloudCfwVpcPolicy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCfwVpcPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwVpcPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_vpc_policy.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -179,8 +185,8 @@ func resourceTencentCloudCfwVpcPolicyCreate(d *schema.ResourceData, meta interfa
 
 	request.Rules = append(request.Rules, &vpcRuleItem)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().AddVpcAcRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().AddVpcAcRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -198,30 +204,30 @@ func resourceTencentCloudCfwVpcPolicyCreate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cfw vpcPolicy failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleUuid := *response.Response.RuleUuids[0]
 	uuid = strconv.FormatInt(ruleUuid, 10)
 	d.SetId(uuid)
 
-	return resourceTencentCloudCfwVpcPolicyRead(d, meta)
+	return resourceTencentCloudCfwVpcPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwVpcPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwVpcPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_vpc_policy.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		uuid    = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	vpcPolicy, err := service.DescribeCfwVpcPolicyById(ctx, uuid)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if vpcPolicy == nil {
@@ -285,7 +291,7 @@ func resourceTencentCloudCfwVpcPolicyRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudCfwVpcPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwVpcPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_vpc_policy.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -343,8 +349,8 @@ func resourceTencentCloudCfwVpcPolicyUpdate(d *schema.ResourceData, meta interfa
 
 	request.Rules = append(request.Rules, &vpcRuleItem)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyVpcAcRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCfwClient().ModifyVpcAcRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -356,25 +362,25 @@ func resourceTencentCloudCfwVpcPolicyUpdate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update cfw vpcPolicy failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCfwVpcPolicyRead(d, meta)
+	return resourceTencentCloudCfwVpcPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCfwVpcPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCfwVpcPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cfw_vpc_policy.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = CfwService{client: meta.(*TencentCloudClient).apiV3Conn}
 		uuid    = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if err := service.DeleteCfwVpcPolicyById(ctx, uuid); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
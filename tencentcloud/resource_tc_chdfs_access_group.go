@@ -24,9 +24,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	chdfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/chdfs/v20201112"
@@ -35,12 +36,16 @@ import (
 
 func resourceTencentCloudChdfsAccessGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudChdfsAccessGroupCreate,
-		Read:   resourceTencentCloudChdfsAccessGroupRead,
-		Update: resourceTencentCloudChdfsAccessGroupUpdate,
-		Delete: resourceTencentCloudChdfsAccessGroupDelete,
+		CreateContext: resourceTencentCloudChdfsAccessGroupCreate,
+		ReadContext:   resourceTencentCloudChdfsAccessGroupRead,
+		UpdateContext: resourceTencentCloudChdfsAccessGroupUpdate,
+		DeleteContext: resourceTencentCloudChdfsAccessGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"access_group_name": {
@@ -70,7 +75,7 @@ func resourceTencentCloudChdfsAccessGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudChdfsAccessGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsAccessGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_access_group.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -97,8 +102,8 @@ func resourceTencentCloudChdfsAccessGroupCreate(d *schema.ResourceData, meta int
 		request.Description = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().CreateAccessGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().CreateAccessGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -109,22 +114,22 @@ func resourceTencentCloudChdfsAccessGroupCreate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create chdfs accessGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	accessGroupId = *response.Response.AccessGroup.AccessGroupId
 	d.SetId(accessGroupId)
 
-	return resourceTencentCloudChdfsAccessGroupRead(d, meta)
+	return resourceTencentCloudChdfsAccessGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudChdfsAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsAccessGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_access_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ChdfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -132,7 +137,7 @@ func resourceTencentCloudChdfsAccessGroupRead(d *schema.ResourceData, meta inter
 
 	accessGroup, err := service.DescribeChdfsAccessGroupById(ctx, accessGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if accessGroup == nil {
@@ -160,7 +165,7 @@ func resourceTencentCloudChdfsAccessGroupRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudChdfsAccessGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsAccessGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_access_group.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -176,7 +181,7 @@ func resourceTencentCloudChdfsAccessGroupUpdate(d *schema.ResourceData, meta int
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -192,8 +197,8 @@ func resourceTencentCloudChdfsAccessGroupUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().ModifyAccessGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().ModifyAccessGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -203,24 +208,24 @@ func resourceTencentCloudChdfsAccessGroupUpdate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update chdfs accessGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudChdfsAccessGroupRead(d, meta)
+	return resourceTencentCloudChdfsAccessGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudChdfsAccessGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsAccessGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_access_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ChdfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	accessGroupId := d.Id()
 
 	if err := service.DeleteChdfsAccessGroupById(ctx, accessGroupId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
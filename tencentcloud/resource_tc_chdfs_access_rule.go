@@ -27,10 +27,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	chdfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/chdfs/v20201112"
@@ -39,12 +39,16 @@ import (
 
 func resourceTencentCloudChdfsAccessRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudChdfsAccessRuleCreate,
-		Read:   resourceTencentCloudChdfsAccessRuleRead,
-		Update: resourceTencentCloudChdfsAccessRuleUpdate,
-		Delete: resourceTencentCloudChdfsAccessRuleDelete,
+		CreateContext: resourceTencentCloudChdfsAccessRuleCreate,
+		ReadContext:   resourceTencentCloudChdfsAccessRuleRead,
+		UpdateContext: resourceTencentCloudChdfsAccessRuleUpdate,
+		DeleteContext: resourceTencentCloudChdfsAccessRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"access_rule": {
@@ -93,7 +97,7 @@ func resourceTencentCloudChdfsAccessRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudChdfsAccessRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsAccessRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_access_rule.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -124,8 +128,8 @@ func resourceTencentCloudChdfsAccessRuleCreate(d *schema.ResourceData, meta inte
 		request.AccessGroupId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().CreateAccessRules(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().CreateAccessRulesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -136,39 +140,39 @@ func resourceTencentCloudChdfsAccessRuleCreate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create chdfs accessRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(response.Response.AccessRules) < 1 {
-		return fmt.Errorf("create chdfs accessRules failed")
+		return diag.Errorf("create chdfs accessRules failed")
 	}
 
 	accessRuleId = *response.Response.AccessRules[0].AccessRuleId
-	d.SetId(accessGroupId + FILED_SP + helper.UInt64ToStr(accessRuleId))
+	d.SetId(helper.IdFormat(accessGroupId, helper.UInt64ToStr(accessRuleId)))
 
-	return resourceTencentCloudChdfsAccessRuleRead(d, meta)
+	return resourceTencentCloudChdfsAccessRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudChdfsAccessRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsAccessRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_access_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ChdfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	accessGroupId := idSplit[0]
 	accessRuleId := idSplit[1]
 
 	accessRule, err := service.DescribeChdfsAccessRulesById(ctx, accessGroupId, accessRuleId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if accessRule == nil {
@@ -208,7 +212,7 @@ func resourceTencentCloudChdfsAccessRuleRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudChdfsAccessRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsAccessRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_access_rule.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -216,9 +220,9 @@ func resourceTencentCloudChdfsAccessRuleUpdate(d *schema.ResourceData, meta inte
 
 	request := chdfs.NewModifyAccessRulesRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 
 	accessRuleId := idSplit[1]
@@ -242,8 +246,8 @@ func resourceTencentCloudChdfsAccessRuleUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().ModifyAccessRules(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().ModifyAccessRulesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -253,28 +257,28 @@ func resourceTencentCloudChdfsAccessRuleUpdate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update chdfs accessRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudChdfsAccessRuleRead(d, meta)
+	return resourceTencentCloudChdfsAccessRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudChdfsAccessRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsAccessRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_access_rule.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ChdfsService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	accessRuleId := idSplit[1]
 
 	if err := service.DeleteChdfsAccessRulesById(ctx, accessRuleId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -36,6 +36,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	chdfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/chdfs/v20201112"
@@ -44,12 +45,16 @@ import (
 
 func resourceTencentCloudChdfsFileSystem() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudChdfsFileSystemCreate,
-		Read:   resourceTencentCloudChdfsFileSystemRead,
-		Update: resourceTencentCloudChdfsFileSystemUpdate,
-		Delete: resourceTencentCloudChdfsFileSystemDelete,
+		CreateContext: resourceTencentCloudChdfsFileSystemCreate,
+		ReadContext:   resourceTencentCloudChdfsFileSystemRead,
+		UpdateContext: resourceTencentCloudChdfsFileSystemUpdate,
+		DeleteContext: resourceTencentCloudChdfsFileSystemDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"file_system_name": {
@@ -103,7 +108,7 @@ func resourceTencentCloudChdfsFileSystem() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudChdfsFileSystemCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsFileSystemCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_file_system.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -150,8 +155,8 @@ func resourceTencentCloudChdfsFileSystemCreate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().CreateFileSystem(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().CreateFileSystemWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -162,7 +167,7 @@ func resourceTencentCloudChdfsFileSystemCreate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create chdfs fileSystem failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	fileSystemId = *response.Response.FileSystem.FileSystemId
@@ -170,22 +175,22 @@ func resourceTencentCloudChdfsFileSystemCreate(d *schema.ResourceData, meta inte
 
 	service := ChdfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"2"}, 2*readRetryTimeout, time.Second, service.ChdfsFileSystemStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"2"}, d.Timeout(schema.TimeoutCreate), time.Second, service.ChdfsFileSystemStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudChdfsFileSystemRead(d, meta)
+	return resourceTencentCloudChdfsFileSystemRead(ctx, d, meta)
 }
 
-func resourceTencentCloudChdfsFileSystemRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsFileSystemRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_file_system.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ChdfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -193,7 +198,7 @@ func resourceTencentCloudChdfsFileSystemRead(d *schema.ResourceData, meta interf
 
 	fileSystem, err := service.DescribeChdfsFileSystemById(ctx, fileSystemId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if fileSystem == nil {
@@ -233,7 +238,7 @@ func resourceTencentCloudChdfsFileSystemRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudChdfsFileSystemUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsFileSystemUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_file_system.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -295,8 +300,8 @@ func resourceTencentCloudChdfsFileSystemUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().ModifyFileSystem(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().ModifyFileSystemWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -306,24 +311,24 @@ func resourceTencentCloudChdfsFileSystemUpdate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update chdfs fileSystem failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudChdfsFileSystemRead(d, meta)
+	return resourceTencentCloudChdfsFileSystemRead(ctx, d, meta)
 }
 
-func resourceTencentCloudChdfsFileSystemDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsFileSystemDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_file_system.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ChdfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	fileSystemId := d.Id()
 
 	if err := service.DeleteChdfsFileSystemById(ctx, fileSystemId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
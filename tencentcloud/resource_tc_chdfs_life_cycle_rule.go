@@ -32,10 +32,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	chdfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/chdfs/v20201112"
@@ -44,12 +44,16 @@ import (
 
 func resourceTencentCloudChdfsLifeCycleRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudChdfsLifeCycleRuleCreate,
-		Read:   resourceTencentCloudChdfsLifeCycleRuleRead,
-		Update: resourceTencentCloudChdfsLifeCycleRuleUpdate,
-		Delete: resourceTencentCloudChdfsLifeCycleRuleDelete,
+		CreateContext: resourceTencentCloudChdfsLifeCycleRuleCreate,
+		ReadContext:   resourceTencentCloudChdfsLifeCycleRuleRead,
+		UpdateContext: resourceTencentCloudChdfsLifeCycleRuleUpdate,
+		DeleteContext: resourceTencentCloudChdfsLifeCycleRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"file_system_id": {
@@ -117,7 +121,7 @@ func resourceTencentCloudChdfsLifeCycleRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudChdfsLifeCycleRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsLifeCycleRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_life_cycle_rule.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -161,8 +165,8 @@ func resourceTencentCloudChdfsLifeCycleRuleCreate(d *schema.ResourceData, meta i
 		request.LifeCycleRules = append(request.LifeCycleRules, &lifeCycleRule)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().CreateLifeCycleRules(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().CreateLifeCycleRulesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -173,41 +177,41 @@ func resourceTencentCloudChdfsLifeCycleRuleCreate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create chdfs lifeCycleRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := ChdfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	lifeCycleRule, err := service.DescribeChdfsLifeCycleRuleByPath(ctx, fileSystemId, path)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(fileSystemId + FILED_SP + helper.UInt64ToStr(*lifeCycleRule.LifeCycleRuleId))
+	d.SetId(helper.IdFormat(fileSystemId, helper.UInt64ToStr(*lifeCycleRule.LifeCycleRuleId)))
 
-	return resourceTencentCloudChdfsLifeCycleRuleRead(d, meta)
+	return resourceTencentCloudChdfsLifeCycleRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudChdfsLifeCycleRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsLifeCycleRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_life_cycle_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ChdfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	fileSystemId := idSplit[0]
 	lifeCycleRuleId := idSplit[1]
 
 	lifeCycleRule, err := service.DescribeChdfsLifeCycleRuleById(ctx, fileSystemId, lifeCycleRuleId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if lifeCycleRule == nil {
@@ -266,7 +270,7 @@ func resourceTencentCloudChdfsLifeCycleRuleRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudChdfsLifeCycleRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsLifeCycleRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_life_cycle_rule.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -274,9 +278,9 @@ func resourceTencentCloudChdfsLifeCycleRuleUpdate(d *schema.ResourceData, meta i
 
 	request := chdfs.NewModifyLifeCycleRulesRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 
 	lifeCycleRuleId := idSplit[1]
@@ -312,8 +316,8 @@ func resourceTencentCloudChdfsLifeCycleRuleUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().ModifyLifeCycleRules(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().ModifyLifeCycleRulesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -323,29 +327,29 @@ func resourceTencentCloudChdfsLifeCycleRuleUpdate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update chdfs lifeCycleRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudChdfsLifeCycleRuleRead(d, meta)
+	return resourceTencentCloudChdfsLifeCycleRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudChdfsLifeCycleRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsLifeCycleRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_life_cycle_rule.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ChdfsService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	lifeCycleRuleId := idSplit[1]
 
 	if err := service.DeleteChdfsLifeCycleRuleById(ctx, lifeCycleRuleId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
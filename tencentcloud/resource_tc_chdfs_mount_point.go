@@ -24,7 +24,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	chdfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/chdfs/v20201112"
@@ -33,12 +35,16 @@ import (
 
 func resourceTencentCloudChdfsMountPoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudChdfsMountPointCreate,
-		Read:   resourceTencentCloudChdfsMountPointRead,
-		Update: resourceTencentCloudChdfsMountPointUpdate,
-		Delete: resourceTencentCloudChdfsMountPointDelete,
+		CreateContext: resourceTencentCloudChdfsMountPointCreate,
+		ReadContext:   resourceTencentCloudChdfsMountPointRead,
+		UpdateContext: resourceTencentCloudChdfsMountPointUpdate,
+		DeleteContext: resourceTencentCloudChdfsMountPointDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"mount_point_name": {
@@ -63,7 +69,7 @@ func resourceTencentCloudChdfsMountPoint() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudChdfsMountPointCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsMountPointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_mount_point.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -86,8 +92,8 @@ func resourceTencentCloudChdfsMountPointCreate(d *schema.ResourceData, meta inte
 		request.MountPointStatus = helper.IntUint64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().CreateMountPoint(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().CreateMountPointWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -98,22 +104,22 @@ func resourceTencentCloudChdfsMountPointCreate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create chdfs mountPoint failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	mountPointId = *response.Response.MountPoint.MountPointId
 	d.SetId(mountPointId)
 
-	return resourceTencentCloudChdfsMountPointRead(d, meta)
+	return resourceTencentCloudChdfsMountPointRead(ctx, d, meta)
 }
 
-func resourceTencentCloudChdfsMountPointRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsMountPointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_mount_point.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ChdfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -121,7 +127,7 @@ func resourceTencentCloudChdfsMountPointRead(d *schema.ResourceData, meta interf
 
 	mountPoint, err := service.DescribeChdfsMountPointById(ctx, mountPointId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if mountPoint == nil {
@@ -145,7 +151,7 @@ func resourceTencentCloudChdfsMountPointRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudChdfsMountPointUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsMountPointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_mount_point.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -169,8 +175,8 @@ func resourceTencentCloudChdfsMountPointUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().ModifyMountPoint(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().ModifyMountPointWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -180,24 +186,24 @@ func resourceTencentCloudChdfsMountPointUpdate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update chdfs mountPoint failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudChdfsMountPointRead(d, meta)
+	return resourceTencentCloudChdfsMountPointRead(ctx, d, meta)
 }
 
-func resourceTencentCloudChdfsMountPointDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsMountPointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_mount_point.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ChdfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	mountPointId := d.Id()
 
 	if err := service.DeleteChdfsMountPointById(ctx, mountPointId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -26,7 +26,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	chdfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/chdfs/v20201112"
@@ -35,11 +37,14 @@ import (
 
 func resourceTencentCloudChdfsMountPointAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudChdfsMountPointAttachmentCreate,
-		Read:   resourceTencentCloudChdfsMountPointAttachmentRead,
-		Delete: resourceTencentCloudChdfsMountPointAttachmentDelete,
+		CreateContext: resourceTencentCloudChdfsMountPointAttachmentCreate,
+		ReadContext:   resourceTencentCloudChdfsMountPointAttachmentRead,
+		DeleteContext: resourceTencentCloudChdfsMountPointAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"mount_point_id": {
@@ -62,7 +67,7 @@ func resourceTencentCloudChdfsMountPointAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudChdfsMountPointAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsMountPointAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_mount_point_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -85,8 +90,8 @@ func resourceTencentCloudChdfsMountPointAttachmentCreate(d *schema.ResourceData,
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().AssociateAccessGroups(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseChdfsClient().AssociateAccessGroupsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -96,21 +101,21 @@ func resourceTencentCloudChdfsMountPointAttachmentCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create chdfs mountPointAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(mountPointId)
 
-	return resourceTencentCloudChdfsMountPointAttachmentRead(d, meta)
+	return resourceTencentCloudChdfsMountPointAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudChdfsMountPointAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsMountPointAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_mount_point_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ChdfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -118,7 +123,7 @@ func resourceTencentCloudChdfsMountPointAttachmentRead(d *schema.ResourceData, m
 
 	mountPointAttachment, err := service.DescribeChdfsMountPointById(ctx, mountPointId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if mountPointAttachment == nil {
@@ -138,12 +143,12 @@ func resourceTencentCloudChdfsMountPointAttachmentRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceTencentCloudChdfsMountPointAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudChdfsMountPointAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_chdfs_mount_point_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ChdfsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -151,11 +156,11 @@ func resourceTencentCloudChdfsMountPointAttachmentDelete(d *schema.ResourceData,
 
 	mountPoint, err := service.DescribeChdfsMountPointById(ctx, mountPointId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := service.DeleteChdfsMountPointAttachmentById(ctx, mountPointId, mountPoint.AccessGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -23,7 +23,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -31,11 +33,15 @@ import (
 
 func resourceTencentCloudCiBucketAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiBucketAttachmentCreate,
-		Read:   resourceTencentCloudCiBucketAttachmentRead,
-		Delete: resourceTencentCloudCiBucketAttachmentDelete,
+		CreateContext: resourceTencentCloudCiBucketAttachmentCreate,
+		ReadContext:   resourceTencentCloudCiBucketAttachmentRead,
+		DeleteContext: resourceTencentCloudCiBucketAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -54,22 +60,22 @@ func resourceTencentCloudCiBucketAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCiBucketAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiBucketAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_bucket_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var bucket string
 	if v, ok := d.GetOk("bucket"); ok {
 		bucket = v.(string)
 	} else {
-		return errors.New("get bucket failed!")
+		return diag.FromErr(errors.New("get bucket failed!"))
 	}
 
 	ciClient := meta.(*TencentCloudClient).apiV3Conn.UsePicClient(bucket)
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := ciClient.CI.OpenCIService(ctx)
 		if e != nil {
 			return retryError(e)
@@ -80,21 +86,21 @@ func resourceTencentCloudCiBucketAttachmentCreate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci bucket failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(bucket)
 
-	return resourceTencentCloudCiBucketAttachmentRead(d, meta)
+	return resourceTencentCloudCiBucketAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiBucketAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiBucketAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_bucket_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -102,12 +108,12 @@ func resourceTencentCloudCiBucketAttachmentRead(d *schema.ResourceData, meta int
 
 	result, err := service.DescribeCiBucketById(ctx, bucket)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if result == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", d.Id())
+		return diag.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
 	_ = d.Set("bucket", bucket)
@@ -116,21 +122,21 @@ func resourceTencentCloudCiBucketAttachmentRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudCiBucketAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiBucketAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_bucket_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
 	bucket := d.Id()
 
 	if err := service.DeleteCiBucketById(ctx, bucket); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	retryErr := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	retryErr := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		result, e := service.DescribeCiBucketById(ctx, bucket)
 		if e != nil {
 			return retryError(e)
@@ -144,7 +150,7 @@ func resourceTencentCloudCiBucketAttachmentDelete(d *schema.ResourceData, meta i
 		return resource.RetryableError(fmt.Errorf("Binding bucket status is %s , retry...", result.CIStatus))
 	})
 	if retryErr != nil {
-		return retryErr
+		return diag.FromErr(retryErr)
 	}
 
 	return nil
@@ -23,24 +23,27 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	ci "github.com/tencentyun/cos-go-sdk-v5"
 )
 
 func resourceTencentCloudCiBucketPicStyle() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiBucketPicStyleCreate,
-		Read:   resourceTencentCloudCiBucketPicStyleRead,
-		Delete: resourceTencentCloudCiBucketPicStyleDelete,
+		CreateContext: resourceTencentCloudCiBucketPicStyleCreate,
+		ReadContext:   resourceTencentCloudCiBucketPicStyleRead,
+		DeleteContext: resourceTencentCloudCiBucketPicStyleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -67,12 +70,12 @@ func resourceTencentCloudCiBucketPicStyle() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCiBucketPicStyleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiBucketPicStyleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_bucket_pic_style.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		styleName string
@@ -82,7 +85,7 @@ func resourceTencentCloudCiBucketPicStyleCreate(d *schema.ResourceData, meta int
 	if v, ok := d.GetOk("bucket"); ok {
 		bucket = v.(string)
 	} else {
-		return errors.New("get bucket failed!")
+		return diag.FromErr(errors.New("get bucket failed!"))
 	}
 
 	if v, _ := d.GetOk("style_name"); v != nil {
@@ -94,7 +97,7 @@ func resourceTencentCloudCiBucketPicStyleCreate(d *schema.ResourceData, meta int
 	}
 
 	ciClient := meta.(*TencentCloudClient).apiV3Conn.UsePicClient(bucket)
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := ciClient.CI.AddStyle(ctx, &ci.AddStyleOptions{
 			StyleName: styleName,
 			StyleBody: styleBody,
@@ -110,39 +113,39 @@ func resourceTencentCloudCiBucketPicStyleCreate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci bucketPicStyle failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(bucket + FILED_SP + styleName)
+	d.SetId(helper.IdFormat(bucket, styleName))
 
-	return resourceTencentCloudCiBucketPicStyleRead(d, meta)
+	return resourceTencentCloudCiBucketPicStyleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiBucketPicStyleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiBucketPicStyleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_bucket_pic_style.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	styleName := idSplit[1]
 
 	bucketPicStyle, err := service.DescribeCiBucketPicStyleById(ctx, bucket, styleName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if bucketPicStyle == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", d.Id())
+		return diag.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
 	_ = d.Set("bucket", bucket)
@@ -152,23 +155,23 @@ func resourceTencentCloudCiBucketPicStyleRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudCiBucketPicStyleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiBucketPicStyleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_bucket_pic_style.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	styleName := idSplit[1]
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
 	if err := service.DeleteCiBucketPicStyleById(ctx, bucket, styleName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
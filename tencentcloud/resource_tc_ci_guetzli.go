@@ -27,18 +27,19 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceTencentCloudCIGuetzli() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCIGuetzliCreate,
-		Read:   resourceTencentCloudCIGuetzliRead,
-		Update: resourceTencentCloudCIGuetzliUpdate,
-		Delete: resourceTencentCloudCIGuetzliDelete,
+		CreateContext: resourceTencentCloudCIGuetzliCreate,
+		ReadContext:   resourceTencentCloudCIGuetzliRead,
+		UpdateContext: resourceTencentCloudCIGuetzliUpdate,
+		DeleteContext: resourceTencentCloudCIGuetzliDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -58,25 +59,25 @@ func resourceTencentCloudCIGuetzli() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCIGuetzliCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCIGuetzliCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_guetzli.create")()
 
 	d.SetId(d.Get("bucket").(string))
-	return resourceTencentCloudCIGuetzliUpdate(d, meta)
+	return resourceTencentCloudCIGuetzliUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudCIGuetzliRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCIGuetzliRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_guetzli.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	bucket := d.Id()
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
 	res, err := service.GetCiGuetzliById(ctx, bucket)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] bucket=%s status=%s", bucket, res.GuetzliStatus)
@@ -85,11 +86,11 @@ func resourceTencentCloudCIGuetzliRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudCIGuetzliUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCIGuetzliUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_guetzli.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	bucket := d.Id()
 	if d.HasChange("status") {
@@ -103,26 +104,26 @@ func resourceTencentCloudCIGuetzliUpdate(d *schema.ResourceData, meta interface{
 			err = service.CloseCiGuetzliById(ctx, bucket)
 		}
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		return resourceTencentCloudCIGuetzliRead(d, meta)
+		return resourceTencentCloudCIGuetzliRead(ctx, d, meta)
 
 	}
 	return nil
 }
 
-func resourceTencentCloudCIGuetzliDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCIGuetzliDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_guetzli.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	bucket := d.Get("bucket").(string)
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
 	err := service.CloseCiGuetzliById(ctx, bucket)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -24,9 +24,10 @@ package tencentcloud
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ci "github.com/tencentyun/cos-go-sdk-v5"
@@ -34,12 +35,15 @@ import (
 
 func resourceTencentCloudCiHotLink() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiHotLinkCreate,
-		Read:   resourceTencentCloudCiHotLinkRead,
-		Update: resourceTencentCloudCiHotLinkUpdate,
-		Delete: resourceTencentCloudCiHotLinkDelete,
+		CreateContext: resourceTencentCloudCiHotLinkCreate,
+		ReadContext:   resourceTencentCloudCiHotLinkRead,
+		UpdateContext: resourceTencentCloudCiHotLinkUpdate,
+		DeleteContext: resourceTencentCloudCiHotLinkDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -66,7 +70,7 @@ func resourceTencentCloudCiHotLink() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCiHotLinkCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiHotLinkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_hot_link.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -74,20 +78,20 @@ func resourceTencentCloudCiHotLinkCreate(d *schema.ResourceData, meta interface{
 	if v, ok := d.GetOk("bucket"); ok {
 		bucket = v.(string)
 	} else {
-		return errors.New("get bucket failed!")
+		return diag.FromErr(errors.New("get bucket failed!"))
 	}
 
 	d.SetId(bucket)
 
-	return resourceTencentCloudCiHotLinkUpdate(d, meta)
+	return resourceTencentCloudCiHotLinkUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudCiHotLinkRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiHotLinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_hot_link.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -95,12 +99,12 @@ func resourceTencentCloudCiHotLinkRead(d *schema.ResourceData, meta interface{})
 
 	hotLink, err := service.DescribeCiHotLinkById(ctx, bucket)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if hotLink == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `hotLink` %s does not exist", bucket)
+		return diag.Errorf("resource `hotLink` %s does not exist", bucket)
 	}
 
 	_ = d.Set("bucket", bucket)
@@ -116,12 +120,12 @@ func resourceTencentCloudCiHotLinkRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudCiHotLinkUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiHotLinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_hot_link.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	bucket := d.Id()
 
@@ -139,7 +143,7 @@ func resourceTencentCloudCiHotLinkUpdate(d *schema.ResourceData, meta interface{
 	}
 
 	ciClient := meta.(*TencentCloudClient).apiV3Conn.UsePicClient(bucket)
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, e := ciClient.CI.SetHotLink(ctx, &ci.HotLinkOptions{
 			Type: hotLinkType,
 			Url:  url,
@@ -153,18 +157,18 @@ func resourceTencentCloudCiHotLinkUpdate(d *schema.ResourceData, meta interface{
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci hotLink failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCiHotLinkRead(d, meta)
+	return resourceTencentCloudCiHotLinkRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiHotLinkDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiHotLinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_hot_link.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	bucket := d.Id()
 
@@ -174,7 +178,7 @@ func resourceTencentCloudCiHotLinkDelete(d *schema.ResourceData, meta interface{
 		Url:  []string{},
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -33,10 +33,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -46,10 +46,14 @@ import (
 
 func resourceTencentCloudCiMediaAnimationTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaAnimationTemplateCreate,
-		Read:   resourceTencentCloudCiMediaAnimationTemplateRead,
-		Update: resourceTencentCloudCiMediaAnimationTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaAnimationTemplateDelete,
+		CreateContext: resourceTencentCloudCiMediaAnimationTemplateCreate,
+		ReadContext:   resourceTencentCloudCiMediaAnimationTemplateRead,
+		UpdateContext: resourceTencentCloudCiMediaAnimationTemplateUpdate,
+		DeleteContext: resourceTencentCloudCiMediaAnimationTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		// Importer: &schema.ResourceImporter{
 		// 	State: schema.ImportStatePassthrough,
 		// },
@@ -157,12 +161,12 @@ func resourceTencentCloudCiMediaAnimationTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCiMediaAnimationTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaAnimationTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_animation_template.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request = cos.CreateMediaAnimationTemplateOptions{
@@ -175,7 +179,7 @@ func resourceTencentCloudCiMediaAnimationTemplateCreate(d *schema.ResourceData,
 	if v, ok := d.GetOk("bucket"); ok {
 		bucket = v.(string)
 	} else {
-		return errors.New("get bucket failed!")
+		return diag.FromErr(errors.New("get bucket failed!"))
 	}
 
 	if v, ok := d.GetOk("name"); ok {
@@ -231,7 +235,7 @@ func resourceTencentCloudCiMediaAnimationTemplateCreate(d *schema.ResourceData,
 	}
 
 	var response *cos.CreateMediaTemplateResult
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.CreateMediaAnimationTemplate(ctx, &request)
 		if e != nil {
 			return retryError(e)
@@ -243,40 +247,40 @@ func resourceTencentCloudCiMediaAnimationTemplateCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaAnimationTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	templateId = response.Template.TemplateId
-	d.SetId(bucket + FILED_SP + templateId)
+	d.SetId(helper.IdFormat(bucket, templateId))
 
-	return resourceTencentCloudCiMediaAnimationTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaAnimationTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaAnimationTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaAnimationTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_animation_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	template, err := service.DescribeCiMediaTemplateById(ctx, bucket, templateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if template == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", d.Id())
+		return diag.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
 	_ = d.Set("bucket", bucket)
@@ -334,7 +338,7 @@ func resourceTencentCloudCiMediaAnimationTemplateRead(d *schema.ResourceData, me
 
 			err = d.Set("video", []interface{}{videoMap})
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -351,7 +355,7 @@ func resourceTencentCloudCiMediaAnimationTemplateRead(d *schema.ResourceData, me
 
 			err = d.Set("time_interval", []interface{}{timeIntervalMap})
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -359,12 +363,12 @@ func resourceTencentCloudCiMediaAnimationTemplateRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudCiMediaAnimationTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaAnimationTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_animation_template.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := cos.CreateMediaAnimationTemplateOptions{
 		Tag: "Animation",
@@ -426,14 +430,14 @@ func resourceTencentCloudCiMediaAnimationTemplateUpdate(d *schema.ResourceData,
 		}
 	}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.UpdateMediaAnimationTemplate(ctx, &request, templateId)
 		if e != nil {
 			return retryError(e)
@@ -444,29 +448,29 @@ func resourceTencentCloudCiMediaAnimationTemplateUpdate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaAnimationTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCiMediaAnimationTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaAnimationTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaAnimationTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaAnimationTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_animation_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	if err := service.DeleteCiMediaTemplateById(ctx, bucket, templateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -64,10 +64,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -77,12 +77,16 @@ import (
 
 func resourceTencentCloudCiMediaConcatTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaConcatTemplateCreate,
-		Read:   resourceTencentCloudCiMediaConcatTemplateRead,
-		Update: resourceTencentCloudCiMediaConcatTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaConcatTemplateDelete,
+		CreateContext: resourceTencentCloudCiMediaConcatTemplateCreate,
+		ReadContext:   resourceTencentCloudCiMediaConcatTemplateRead,
+		UpdateContext: resourceTencentCloudCiMediaConcatTemplateUpdate,
+		DeleteContext: resourceTencentCloudCiMediaConcatTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -291,12 +295,12 @@ func resourceTencentCloudCiMediaConcatTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCiMediaConcatTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaConcatTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_concat_template.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request = cos.CreateMediaConcatTemplateOptions{
@@ -309,7 +313,7 @@ func resourceTencentCloudCiMediaConcatTemplateCreate(d *schema.ResourceData, met
 	if v, ok := d.GetOk("bucket"); ok {
 		bucket = v.(string)
 	} else {
-		return errors.New("get bucket failed!")
+		return diag.FromErr(errors.New("get bucket failed!"))
 	}
 
 	if v, ok := d.GetOk("name"); ok {
@@ -424,7 +428,7 @@ func resourceTencentCloudCiMediaConcatTemplateCreate(d *schema.ResourceData, met
 	}
 
 	var response *cos.CreateMediaTemplateResult
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.CreateMediaConcatTemplate(ctx, &request)
 		if e != nil {
 			return retryError(e)
@@ -436,39 +440,39 @@ func resourceTencentCloudCiMediaConcatTemplateCreate(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaConcatTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	templateId = response.Template.TemplateId
-	d.SetId(bucket + FILED_SP + templateId)
+	d.SetId(helper.IdFormat(bucket, templateId))
 
-	return resourceTencentCloudCiMediaConcatTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaConcatTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaConcatTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaConcatTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_concat_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	mediaConcatTemplate, err := service.DescribeCiMediaTemplateById(ctx, bucket, templateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if mediaConcatTemplate == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", d.Id())
+		return diag.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
 	_ = d.Set("bucket", bucket)
@@ -624,12 +628,12 @@ func resourceTencentCloudCiMediaConcatTemplateRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudCiMediaConcatTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaConcatTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_concat_template.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := cos.CreateMediaConcatTemplateOptions{
 		Tag: "Concat",
@@ -748,14 +752,14 @@ func resourceTencentCloudCiMediaConcatTemplateUpdate(d *schema.ResourceData, met
 		}
 	}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.UpdateMediaConcatTemplate(ctx, &request, templateId)
 		if e != nil {
 			return retryError(e)
@@ -766,29 +770,29 @@ func resourceTencentCloudCiMediaConcatTemplateUpdate(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaConcatTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCiMediaConcatTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaConcatTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaConcatTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaConcatTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_concat_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	if err := service.DeleteCiMediaTemplateById(ctx, bucket, templateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
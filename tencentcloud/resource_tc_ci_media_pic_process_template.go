@@ -27,10 +27,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -40,12 +40,16 @@ import (
 
 func resourceTencentCloudCiMediaPicProcessTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaPicProcessTemplateCreate,
-		Read:   resourceTencentCloudCiMediaPicProcessTemplateRead,
-		Update: resourceTencentCloudCiMediaPicProcessTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaPicProcessTemplateDelete,
+		CreateContext: resourceTencentCloudCiMediaPicProcessTemplateCreate,
+		ReadContext:   resourceTencentCloudCiMediaPicProcessTemplateRead,
+		UpdateContext: resourceTencentCloudCiMediaPicProcessTemplateUpdate,
+		DeleteContext: resourceTencentCloudCiMediaPicProcessTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -84,12 +88,12 @@ func resourceTencentCloudCiMediaPicProcessTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCiMediaPicProcessTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaPicProcessTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_pic_process_template.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request = cos.CreateMediaPicProcessTemplateOptions{
@@ -102,7 +106,7 @@ func resourceTencentCloudCiMediaPicProcessTemplateCreate(d *schema.ResourceData,
 	if v, ok := d.GetOk("bucket"); ok {
 		bucket = v.(string)
 	} else {
-		return errors.New("get bucket failed!")
+		return diag.FromErr(errors.New("get bucket failed!"))
 	}
 
 	if v, ok := d.GetOk("name"); ok {
@@ -121,7 +125,7 @@ func resourceTencentCloudCiMediaPicProcessTemplateCreate(d *schema.ResourceData,
 	}
 
 	var response *cos.CreateMediaTemplateResult
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.CreateMediaPicProcessTemplate(ctx, &request)
 		if e != nil {
 			return retryError(e)
@@ -133,39 +137,39 @@ func resourceTencentCloudCiMediaPicProcessTemplateCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaPicProcessTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	templateId = response.Template.TemplateId
-	d.SetId(bucket + FILED_SP + templateId)
+	d.SetId(helper.IdFormat(bucket, templateId))
 
-	return resourceTencentCloudCiMediaPicProcessTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaPicProcessTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaPicProcessTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaPicProcessTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_pic_process_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	mediaPicProcessTemplate, err := service.DescribeCiMediaTemplateById(ctx, bucket, templateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if mediaPicProcessTemplate == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", d.Id())
+		return diag.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
 	_ = d.Set("bucket", bucket)
@@ -187,19 +191,19 @@ func resourceTencentCloudCiMediaPicProcessTemplateRead(d *schema.ResourceData, m
 
 		err = d.Set("pic_process", []interface{}{picProcessMap})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceTencentCloudCiMediaPicProcessTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaPicProcessTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_pic_process_template.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := cos.CreateMediaPicProcessTemplateOptions{
 		Tag: "PicProcess",
@@ -222,14 +226,14 @@ func resourceTencentCloudCiMediaPicProcessTemplateUpdate(d *schema.ResourceData,
 		}
 	}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.UpdateMediaPicProcessTemplate(ctx, &request, templateId)
 		if e != nil {
 			return retryError(e)
@@ -240,29 +244,29 @@ func resourceTencentCloudCiMediaPicProcessTemplateUpdate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaPicProcessTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCiMediaPicProcessTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaPicProcessTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaPicProcessTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaPicProcessTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_pic_process_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	if err := service.DeleteCiMediaTemplateById(ctx, bucket, templateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
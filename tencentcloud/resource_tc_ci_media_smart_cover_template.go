@@ -29,10 +29,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -42,12 +42,16 @@ import (
 
 func resourceTencentCloudCiMediaSmartCoverTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaSmartCoverTemplateCreate,
-		Read:   resourceTencentCloudCiMediaSmartCoverTemplateRead,
-		Update: resourceTencentCloudCiMediaSmartCoverTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaSmartCoverTemplateDelete,
+		CreateContext: resourceTencentCloudCiMediaSmartCoverTemplateCreate,
+		ReadContext:   resourceTencentCloudCiMediaSmartCoverTemplateRead,
+		UpdateContext: resourceTencentCloudCiMediaSmartCoverTemplateUpdate,
+		DeleteContext: resourceTencentCloudCiMediaSmartCoverTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -101,12 +105,12 @@ func resourceTencentCloudCiMediaSmartCoverTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCiMediaSmartCoverTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaSmartCoverTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_smart_cover_template.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request = cos.CreateMediaSmartCoverTemplateOptions{
@@ -119,7 +123,7 @@ func resourceTencentCloudCiMediaSmartCoverTemplateCreate(d *schema.ResourceData,
 	if v, ok := d.GetOk("bucket"); ok {
 		bucket = v.(string)
 	} else {
-		return errors.New("get bucket failed!")
+		return diag.FromErr(errors.New("get bucket failed!"))
 	}
 
 	if v, ok := d.GetOk("name"); ok {
@@ -147,7 +151,7 @@ func resourceTencentCloudCiMediaSmartCoverTemplateCreate(d *schema.ResourceData,
 	}
 
 	var response *cos.CreateMediaTemplateResult
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.CreateMediaSmartCoverTemplate(ctx, &request)
 		if e != nil {
 			return retryError(e)
@@ -159,39 +163,39 @@ func resourceTencentCloudCiMediaSmartCoverTemplateCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaSmartCoverTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	templateId = response.Template.TemplateId
-	d.SetId(bucket + FILED_SP + templateId)
+	d.SetId(helper.IdFormat(bucket, templateId))
 
-	return resourceTencentCloudCiMediaSmartCoverTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaSmartCoverTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaSmartCoverTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaSmartCoverTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_smart_cover_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	mediaSmartCoverTemplate, err := service.DescribeCiMediaTemplateById(ctx, bucket, templateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if mediaSmartCoverTemplate == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", d.Id())
+		return diag.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
 	_ = d.Set("bucket", bucket)
@@ -229,12 +233,12 @@ func resourceTencentCloudCiMediaSmartCoverTemplateRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceTencentCloudCiMediaSmartCoverTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaSmartCoverTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_smart_cover_template.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := cos.CreateMediaSmartCoverTemplateOptions{
 		Tag: "SmartCover",
@@ -266,14 +270,14 @@ func resourceTencentCloudCiMediaSmartCoverTemplateUpdate(d *schema.ResourceData,
 		}
 	}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.UpdateMediaSmartCoverTemplate(ctx, &request, templateId)
 		if e != nil {
 			return retryError(e)
@@ -284,29 +288,29 @@ func resourceTencentCloudCiMediaSmartCoverTemplateUpdate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaSmartCoverTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCiMediaSmartCoverTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaSmartCoverTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaSmartCoverTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaSmartCoverTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_smart_cover_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	if err := service.DeleteCiMediaTemplateById(ctx, bucket, templateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
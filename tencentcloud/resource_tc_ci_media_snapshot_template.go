@@ -35,8 +35,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -46,12 +47,16 @@ import (
 
 func resourceTencentCloudCiMediaSnapshotTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaSnapshotTemplateCreate,
-		Read:   resourceTencentCloudCiMediaSnapshotTemplateRead,
-		Update: resourceTencentCloudCiMediaSnapshotTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaSnapshotTemplateDelete,
+		CreateContext: resourceTencentCloudCiMediaSnapshotTemplateCreate,
+		ReadContext:   resourceTencentCloudCiMediaSnapshotTemplateRead,
+		UpdateContext: resourceTencentCloudCiMediaSnapshotTemplateUpdate,
+		DeleteContext: resourceTencentCloudCiMediaSnapshotTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -218,19 +223,19 @@ func resourceTencentCloudCiMediaSnapshotTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCiMediaSnapshotTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaSnapshotTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_snapshot_template.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var templateId string
 	var bucket string
 	if v, ok := d.GetOk("bucket"); ok {
 		bucket = v.(string)
 	} else {
-		return errors.New("get bucket failed!")
+		return diag.FromErr(errors.New("get bucket failed!"))
 	}
 	request := cos.CreateMediaSnapshotTemplateOptions{
 		Tag: "Snapshot",
@@ -316,7 +321,7 @@ func resourceTencentCloudCiMediaSnapshotTemplateCreate(d *schema.ResourceData, m
 
 	var response *cos.CreateMediaTemplateResult
 	ciClient := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket)
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, _, e := ciClient.CI.CreateMediaSnapshotTemplate(ctx, &request)
 		if e != nil {
 			return retryError(e)
@@ -328,39 +333,39 @@ func resourceTencentCloudCiMediaSnapshotTemplateCreate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaSnapshotTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	templateId = response.Template.TemplateId
-	d.SetId(bucket + FILED_SP + templateId)
+	d.SetId(helper.IdFormat(bucket, templateId))
 
-	return resourceTencentCloudCiMediaSnapshotTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaSnapshotTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaSnapshotTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaSnapshotTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_snapshot_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	mediaSnapshotTemplate, err := service.DescribeCiMediaTemplateById(ctx, bucket, templateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if mediaSnapshotTemplate == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", d.Id())
+		return diag.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
 	_ = d.Set("bucket", bucket)
@@ -451,7 +456,7 @@ func resourceTencentCloudCiMediaSnapshotTemplateRead(d *schema.ResourceData, met
 
 		err = d.Set("snapshot", []interface{}{snapshotMap})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -470,16 +475,16 @@ func resourceTencentCloudCiMediaSnapshotTemplateRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudCiMediaSnapshotTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaSnapshotTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_snapshot_template.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
@@ -559,7 +564,7 @@ func resourceTencentCloudCiMediaSnapshotTemplateUpdate(d *schema.ResourceData, m
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.UpdateMediaSnapshotTemplate(ctx, &request, templateId)
 		if e != nil {
 			return retryError(e)
@@ -570,29 +575,29 @@ func resourceTencentCloudCiMediaSnapshotTemplateUpdate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaSnapshotTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCiMediaSnapshotTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaSnapshotTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaSnapshotTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaSnapshotTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_snapshot_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	if err := service.DeleteCiMediaTemplateById(ctx, bucket, templateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -34,10 +34,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -47,12 +47,16 @@ import (
 
 func resourceTencentCloudCiMediaSpeechRecognitionTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaSpeechRecognitionTemplateCreate,
-		Read:   resourceTencentCloudCiMediaSpeechRecognitionTemplateRead,
-		Update: resourceTencentCloudCiMediaSpeechRecognitionTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaSpeechRecognitionTemplateDelete,
+		CreateContext: resourceTencentCloudCiMediaSpeechRecognitionTemplateCreate,
+		ReadContext:   resourceTencentCloudCiMediaSpeechRecognitionTemplateRead,
+		UpdateContext: resourceTencentCloudCiMediaSpeechRecognitionTemplateUpdate,
+		DeleteContext: resourceTencentCloudCiMediaSpeechRecognitionTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -131,12 +135,12 @@ func resourceTencentCloudCiMediaSpeechRecognitionTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCiMediaSpeechRecognitionTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaSpeechRecognitionTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_speech_recognition_template.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request = cos.CreateMediaSpeechRecognitionTemplateOptions{
@@ -149,7 +153,7 @@ func resourceTencentCloudCiMediaSpeechRecognitionTemplateCreate(d *schema.Resour
 	if v, ok := d.GetOk("bucket"); ok {
 		bucket = v.(string)
 	} else {
-		return errors.New("get bucket failed!")
+		return diag.FromErr(errors.New("get bucket failed!"))
 	}
 
 	if v, ok := d.GetOk("name"); ok {
@@ -192,7 +196,7 @@ func resourceTencentCloudCiMediaSpeechRecognitionTemplateCreate(d *schema.Resour
 	}
 
 	var response *cos.CreateMediaTemplateResult
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.CreateMediaSpeechRecognitionTemplate(ctx, &request)
 		if e != nil {
 			return retryError(e)
@@ -204,39 +208,39 @@ func resourceTencentCloudCiMediaSpeechRecognitionTemplateCreate(d *schema.Resour
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaSpeechRecognitionTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	templateId = response.Template.TemplateId
-	d.SetId(bucket + FILED_SP + templateId)
+	d.SetId(helper.IdFormat(bucket, templateId))
 
-	return resourceTencentCloudCiMediaSpeechRecognitionTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaSpeechRecognitionTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaSpeechRecognitionTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaSpeechRecognitionTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_speech_recognition_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	mediaSpeechRecognitionTemplate, err := service.DescribeCiMediaTemplateById(ctx, bucket, templateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if mediaSpeechRecognitionTemplate == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", d.Id())
+		return diag.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
 	_ = d.Set("bucket", bucket)
@@ -294,12 +298,12 @@ func resourceTencentCloudCiMediaSpeechRecognitionTemplateRead(d *schema.Resource
 	return nil
 }
 
-func resourceTencentCloudCiMediaSpeechRecognitionTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaSpeechRecognitionTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_speech_recognition_template.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := cos.CreateMediaSpeechRecognitionTemplateOptions{
 		Tag: "SpeechRecognition",
@@ -346,14 +350,14 @@ func resourceTencentCloudCiMediaSpeechRecognitionTemplateUpdate(d *schema.Resour
 		}
 	}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.UpdateMediaSpeechRecognitionTemplate(ctx, &request, templateId)
 		if e != nil {
 			return retryError(e)
@@ -364,29 +368,29 @@ func resourceTencentCloudCiMediaSpeechRecognitionTemplateUpdate(d *schema.Resour
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaSpeechRecognitionTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCiMediaSpeechRecognitionTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaSpeechRecognitionTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaSpeechRecognitionTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaSpeechRecognitionTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_speech_recognition_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	if err := service.DeleteCiMediaTemplateById(ctx, bucket, templateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -25,24 +25,29 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	"github.com/tencentyun/cos-go-sdk-v5"
 )
 
 func resourceTencentCloudCiMediaSuperResolutionTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaSuperResolutionTemplateCreate,
-		Read:   resourceTencentCloudCiMediaSuperResolutionTemplateRead,
-		Update: resourceTencentCloudCiMediaSuperResolutionTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaSuperResolutionTemplateDelete,
+		CreateContext: resourceTencentCloudCiMediaSuperResolutionTemplateCreate,
+		ReadContext:   resourceTencentCloudCiMediaSuperResolutionTemplateRead,
+		UpdateContext: resourceTencentCloudCiMediaSuperResolutionTemplateUpdate,
+		DeleteContext: resourceTencentCloudCiMediaSuperResolutionTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -78,12 +83,12 @@ func resourceTencentCloudCiMediaSuperResolutionTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCiMediaSuperResolutionTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaSuperResolutionTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_super_resolution_template.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request = cos.CreateMediaSuperResolutionTemplateOptions{
@@ -96,7 +101,7 @@ func resourceTencentCloudCiMediaSuperResolutionTemplateCreate(d *schema.Resource
 	if v, ok := d.GetOk("bucket"); ok {
 		bucket = v.(string)
 	} else {
-		return errors.New("get bucket failed!")
+		return diag.FromErr(errors.New("get bucket failed!"))
 	}
 
 	if v, ok := d.GetOk("name"); ok {
@@ -116,7 +121,7 @@ func resourceTencentCloudCiMediaSuperResolutionTemplateCreate(d *schema.Resource
 	}
 
 	var response *cos.CreateMediaTemplateResult
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.CreateMediaSuperResolutionTemplate(ctx, &request)
 		if e != nil {
 			return retryError(e)
@@ -128,39 +133,39 @@ func resourceTencentCloudCiMediaSuperResolutionTemplateCreate(d *schema.Resource
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaSuperResolutionTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	templateId = response.Template.TemplateId
-	d.SetId(bucket + FILED_SP + templateId)
+	d.SetId(helper.IdFormat(bucket, templateId))
 
-	return resourceTencentCloudCiMediaSuperResolutionTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaSuperResolutionTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaSuperResolutionTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaSuperResolutionTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_super_resolution_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	template, err := service.DescribeCiMediaTemplateById(ctx, bucket, templateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if template == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", d.Id())
+		return diag.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
 	_ = d.Set("bucket", bucket)
@@ -187,20 +192,20 @@ func resourceTencentCloudCiMediaSuperResolutionTemplateRead(d *schema.ResourceDa
 	return nil
 }
 
-func resourceTencentCloudCiMediaSuperResolutionTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaSuperResolutionTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_super_resolution_template.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := cos.CreateMediaSuperResolutionTemplateOptions{
 		Tag: "SuperResolution",
 	}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
@@ -225,7 +230,7 @@ func resourceTencentCloudCiMediaSuperResolutionTemplateUpdate(d *schema.Resource
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.UpdateMediaSuperResolutionTemplate(ctx, &request, templateId)
 		if e != nil {
 			return retryError(e)
@@ -236,29 +241,29 @@ func resourceTencentCloudCiMediaSuperResolutionTemplateUpdate(d *schema.Resource
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaSuperResolutionTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCiMediaSuperResolutionTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaSuperResolutionTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaSuperResolutionTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaSuperResolutionTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_super_resolution_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	if err := service.DeleteCiMediaTemplateById(ctx, bucket, templateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
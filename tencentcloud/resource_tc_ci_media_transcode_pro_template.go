@@ -61,10 +61,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -74,10 +74,14 @@ import (
 
 func resourceTencentCloudCiMediaTranscodeProTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaTranscodeProTemplateCreate,
-		Read:   resourceTencentCloudCiMediaTranscodeProTemplateRead,
-		Update: resourceTencentCloudCiMediaTranscodeProTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaTranscodeProTemplateDelete,
+		CreateContext: resourceTencentCloudCiMediaTranscodeProTemplateCreate,
+		ReadContext:   resourceTencentCloudCiMediaTranscodeProTemplateRead,
+		UpdateContext: resourceTencentCloudCiMediaTranscodeProTemplateUpdate,
+		DeleteContext: resourceTencentCloudCiMediaTranscodeProTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		// Importer: &schema.ResourceImporter{
 		// 	State: schema.ImportStatePassthrough,
 		// },
@@ -277,12 +281,12 @@ func resourceTencentCloudCiMediaTranscodeProTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCiMediaTranscodeProTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaTranscodeProTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_transcode_pro_template.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request = cos.CreateMediaTranscodeProTemplateOptions{
@@ -295,7 +299,7 @@ func resourceTencentCloudCiMediaTranscodeProTemplateCreate(d *schema.ResourceDat
 	if v, ok := d.GetOk("bucket"); ok {
 		bucket = v.(string)
 	} else {
-		return errors.New("get bucket failed!")
+		return diag.FromErr(errors.New("get bucket failed!"))
 	}
 
 	if v, ok := d.GetOk("name"); ok {
@@ -401,7 +405,7 @@ func resourceTencentCloudCiMediaTranscodeProTemplateCreate(d *schema.ResourceDat
 	}
 
 	var response *cos.CreateMediaTemplateResult
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.CreateMediaTranscodeProTemplate(ctx, &request)
 		if e != nil {
 			return retryError(e)
@@ -413,39 +417,39 @@ func resourceTencentCloudCiMediaTranscodeProTemplateCreate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaTranscodeProTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	templateId = response.Template.TemplateId
-	d.SetId(bucket + FILED_SP + templateId)
+	d.SetId(helper.IdFormat(bucket, templateId))
 
-	return resourceTencentCloudCiMediaTranscodeProTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaTranscodeProTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaTranscodeProTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaTranscodeProTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_transcode_pro_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	template, err := service.DescribeCiMediaTemplateById(ctx, bucket, templateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if template == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", d.Id())
+		return diag.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
 	if template.Name != "" {
@@ -585,20 +589,20 @@ func resourceTencentCloudCiMediaTranscodeProTemplateRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudCiMediaTranscodeProTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaTranscodeProTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_transcode_pro_template.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := cos.CreateMediaTranscodeProTemplateOptions{
 		Tag: "TranscodePro",
 	}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
@@ -713,7 +717,7 @@ func resourceTencentCloudCiMediaTranscodeProTemplateUpdate(d *schema.ResourceDat
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.UpdateMediaTranscodeProTemplate(ctx, &request, templateId)
 		if e != nil {
 			return retryError(e)
@@ -724,29 +728,29 @@ func resourceTencentCloudCiMediaTranscodeProTemplateUpdate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaTranscodeProTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCiMediaTranscodeProTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaTranscodeProTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaTranscodeProTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaTranscodeProTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_transcode_pro_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	if err := service.DeleteCiMediaTemplateById(ctx, bucket, templateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
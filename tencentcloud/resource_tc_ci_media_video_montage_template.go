@@ -58,10 +58,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -71,12 +71,16 @@ import (
 
 func resourceTencentCloudCiMediaVideoMontageTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaVideoMontageTemplateCreate,
-		Read:   resourceTencentCloudCiMediaVideoMontageTemplateRead,
-		Update: resourceTencentCloudCiMediaVideoMontageTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaVideoMontageTemplateDelete,
+		CreateContext: resourceTencentCloudCiMediaVideoMontageTemplateCreate,
+		ReadContext:   resourceTencentCloudCiMediaVideoMontageTemplateRead,
+		UpdateContext: resourceTencentCloudCiMediaVideoMontageTemplateUpdate,
+		DeleteContext: resourceTencentCloudCiMediaVideoMontageTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -263,12 +267,12 @@ func resourceTencentCloudCiMediaVideoMontageTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCiMediaVideoMontageTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaVideoMontageTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_video_montage_template.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request = cos.CreateMediaVideoMontageTemplateOptions{
@@ -281,7 +285,7 @@ func resourceTencentCloudCiMediaVideoMontageTemplateCreate(d *schema.ResourceDat
 	if v, ok := d.GetOk("bucket"); ok {
 		bucket = v.(string)
 	} else {
-		return errors.New("get bucket failed!")
+		return diag.FromErr(errors.New("get bucket failed!"))
 	}
 
 	if v, ok := d.GetOk("name"); ok {
@@ -387,7 +391,7 @@ func resourceTencentCloudCiMediaVideoMontageTemplateCreate(d *schema.ResourceDat
 	}
 
 	var response *cos.CreateMediaTemplateResult
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.CreateMediaVideoMontageTemplate(ctx, &request)
 		if e != nil {
 			return retryError(e)
@@ -399,39 +403,39 @@ func resourceTencentCloudCiMediaVideoMontageTemplateCreate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaVideoMontageTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	templateId = response.Template.TemplateId
-	d.SetId(bucket + FILED_SP + templateId)
+	d.SetId(helper.IdFormat(bucket, templateId))
 
-	return resourceTencentCloudCiMediaVideoMontageTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaVideoMontageTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaVideoMontageTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaVideoMontageTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_video_montage_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	template, err := service.DescribeCiMediaTemplateById(ctx, bucket, templateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if template == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", d.Id())
+		return diag.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
 	_ = d.Set("bucket", bucket)
@@ -573,20 +577,20 @@ func resourceTencentCloudCiMediaVideoMontageTemplateRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudCiMediaVideoMontageTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaVideoMontageTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_video_montage_template.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := cos.CreateMediaVideoMontageTemplateOptions{
 		Tag: "VideoMontage",
 	}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
@@ -699,7 +703,7 @@ func resourceTencentCloudCiMediaVideoMontageTemplateUpdate(d *schema.ResourceDat
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.UpdateMediaVideoMontageTemplate(ctx, &request, templateId)
 		if e != nil {
 			return retryError(e)
@@ -710,29 +714,29 @@ func resourceTencentCloudCiMediaVideoMontageTemplateUpdate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaVideoMontageTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCiMediaVideoMontageTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaVideoMontageTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaVideoMontageTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaVideoMontageTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_video_montage_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	if err := service.DeleteCiMediaTemplateById(ctx, bucket, templateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
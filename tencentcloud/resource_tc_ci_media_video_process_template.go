@@ -35,10 +35,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -48,12 +48,16 @@ import (
 
 func resourceTencentCloudCiMediaVideoProcessTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaVideoProcessTemplateCreate,
-		Read:   resourceTencentCloudCiMediaVideoProcessTemplateRead,
-		Update: resourceTencentCloudCiMediaVideoProcessTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaVideoProcessTemplateDelete,
+		CreateContext: resourceTencentCloudCiMediaVideoProcessTemplateCreate,
+		ReadContext:   resourceTencentCloudCiMediaVideoProcessTemplateRead,
+		UpdateContext: resourceTencentCloudCiMediaVideoProcessTemplateUpdate,
+		DeleteContext: resourceTencentCloudCiMediaVideoProcessTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -123,12 +127,12 @@ func resourceTencentCloudCiMediaVideoProcessTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCiMediaVideoProcessTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaVideoProcessTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_video_process_template.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request = cos.CreateMediaVideoProcessTemplateOptions{
@@ -141,7 +145,7 @@ func resourceTencentCloudCiMediaVideoProcessTemplateCreate(d *schema.ResourceDat
 	if v, ok := d.GetOk("bucket"); ok {
 		bucket = v.(string)
 	} else {
-		return errors.New("get bucket failed!")
+		return diag.FromErr(errors.New("get bucket failed!"))
 	}
 
 	if v, ok := d.GetOk("name"); ok {
@@ -177,7 +181,7 @@ func resourceTencentCloudCiMediaVideoProcessTemplateCreate(d *schema.ResourceDat
 	}
 
 	var response *cos.CreateMediaTemplateResult
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.CreateMediaVideoProcessTemplate(ctx, &request)
 		if e != nil {
 			return retryError(e)
@@ -189,39 +193,39 @@ func resourceTencentCloudCiMediaVideoProcessTemplateCreate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaVideoProcessTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	templateId = response.Template.TemplateId
-	d.SetId(bucket + FILED_SP + templateId)
+	d.SetId(helper.IdFormat(bucket, templateId))
 
-	return resourceTencentCloudCiMediaVideoProcessTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaVideoProcessTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaVideoProcessTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaVideoProcessTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_video_process_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	template, err := service.DescribeCiMediaTemplateById(ctx, bucket, templateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if template == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", d.Id())
+		return diag.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
 	_ = d.Set("bucket", bucket)
@@ -272,20 +276,20 @@ func resourceTencentCloudCiMediaVideoProcessTemplateRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudCiMediaVideoProcessTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaVideoProcessTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_video_process_template.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := cos.CreateMediaVideoProcessTemplateOptions{
 		Tag: "VideoProcess",
 	}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
@@ -321,7 +325,7 @@ func resourceTencentCloudCiMediaVideoProcessTemplateUpdate(d *schema.ResourceDat
 		request.MsSharpen = &msSharpen
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.UpdateMediaVideoProcessTemplate(ctx, &request, templateId)
 		if e != nil {
 			return retryError(e)
@@ -332,29 +336,29 @@ func resourceTencentCloudCiMediaVideoProcessTemplateUpdate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaVideoProcessTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCiMediaVideoProcessTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaVideoProcessTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaVideoProcessTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaVideoProcessTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_video_process_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	if err := service.DeleteCiMediaTemplateById(ctx, bucket, templateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -29,10 +29,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -42,12 +42,16 @@ import (
 
 func resourceTencentCloudCiMediaVoiceSeparateTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaVoiceSeparateTemplateCreate,
-		Read:   resourceTencentCloudCiMediaVoiceSeparateTemplateRead,
-		Update: resourceTencentCloudCiMediaVoiceSeparateTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaVoiceSeparateTemplateDelete,
+		CreateContext: resourceTencentCloudCiMediaVoiceSeparateTemplateCreate,
+		ReadContext:   resourceTencentCloudCiMediaVoiceSeparateTemplateRead,
+		UpdateContext: resourceTencentCloudCiMediaVoiceSeparateTemplateUpdate,
+		DeleteContext: resourceTencentCloudCiMediaVoiceSeparateTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -102,12 +106,12 @@ func resourceTencentCloudCiMediaVoiceSeparateTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCiMediaVoiceSeparateTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaVoiceSeparateTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_voice_separate_template.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request = cos.CreateMediaVoiceSeparateTemplateOptions{
@@ -120,7 +124,7 @@ func resourceTencentCloudCiMediaVoiceSeparateTemplateCreate(d *schema.ResourceDa
 	if v, ok := d.GetOk("bucket"); ok {
 		bucket = v.(string)
 	} else {
-		return errors.New("get bucket failed!")
+		return diag.FromErr(errors.New("get bucket failed!"))
 	}
 
 	if v, ok := d.GetOk("name"); ok {
@@ -149,7 +153,7 @@ func resourceTencentCloudCiMediaVoiceSeparateTemplateCreate(d *schema.ResourceDa
 	}
 
 	var response *cos.CreateMediaTemplateResult
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.CreateMediaVoiceSeparateTemplate(ctx, &request)
 		if e != nil {
 			return retryError(e)
@@ -161,39 +165,39 @@ func resourceTencentCloudCiMediaVoiceSeparateTemplateCreate(d *schema.ResourceDa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaVoiceSeparateTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	templateId = response.Template.TemplateId
-	d.SetId(bucket + FILED_SP + templateId)
+	d.SetId(helper.IdFormat(bucket, templateId))
 
-	return resourceTencentCloudCiMediaVoiceSeparateTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaVoiceSeparateTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaVoiceSeparateTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaVoiceSeparateTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_voice_separate_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	template, err := service.DescribeCiMediaTemplateById(ctx, bucket, templateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if template == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", d.Id())
+		return diag.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
 	_ = d.Set("bucket", bucket)
@@ -234,12 +238,12 @@ func resourceTencentCloudCiMediaVoiceSeparateTemplateRead(d *schema.ResourceData
 	return nil
 }
 
-func resourceTencentCloudCiMediaVoiceSeparateTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaVoiceSeparateTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_voice_separate_template.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := cos.CreateMediaVoiceSeparateTemplateOptions{
 		Tag: "VoiceSeparate",
@@ -272,14 +276,14 @@ func resourceTencentCloudCiMediaVoiceSeparateTemplateUpdate(d *schema.ResourceDa
 		}
 	}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, _, e := meta.(*TencentCloudClient).apiV3Conn.UseCiClient(bucket).CI.UpdateMediaVoiceSeparateTemplate(ctx, &request, templateId)
 		if e != nil {
 			return retryError(e)
@@ -290,29 +294,29 @@ func resourceTencentCloudCiMediaVoiceSeparateTemplateUpdate(d *schema.ResourceDa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ci mediaVoiceSeparateTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCiMediaVoiceSeparateTemplateRead(d, meta)
+	return resourceTencentCloudCiMediaVoiceSeparateTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiMediaVoiceSeparateTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiMediaVoiceSeparateTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_media_voice_separate_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	templateId := idSplit[1]
 
 	if err := service.DeleteCiMediaTemplateById(ctx, bucket, templateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -27,18 +27,19 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceTencentCloudCIOriginalImageProtection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCIOriginalImageProtectionCreate,
-		Read:   resourceTencentCloudCIOriginalImageProtectionRead,
-		Update: resourceTencentCloudCIOriginalImageProtectionUpdate,
-		Delete: resourceTencentCloudCIOriginalImageProtectionDelete,
+		CreateContext: resourceTencentCloudCIOriginalImageProtectionCreate,
+		ReadContext:   resourceTencentCloudCIOriginalImageProtectionRead,
+		UpdateContext: resourceTencentCloudCIOriginalImageProtectionUpdate,
+		DeleteContext: resourceTencentCloudCIOriginalImageProtectionDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -58,26 +59,26 @@ func resourceTencentCloudCIOriginalImageProtection() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCIOriginalImageProtectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCIOriginalImageProtectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_original_image_protection.create")()
 
 	d.SetId(d.Get("bucket").(string))
-	return resourceTencentCloudCIOriginalImageProtectionUpdate(d, meta)
+	return resourceTencentCloudCIOriginalImageProtectionUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudCIOriginalImageProtectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCIOriginalImageProtectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_original_image_protection.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	bucket := d.Id()
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
 	res, err := service.GetCiOriginalImageProtectionById(ctx, bucket)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG] bucket=%s status=%s", bucket, res.OriginProtectStatus)
 	_ = d.Set("bucket", bucket)
@@ -85,11 +86,11 @@ func resourceTencentCloudCIOriginalImageProtectionRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceTencentCloudCIOriginalImageProtectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCIOriginalImageProtectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_original_image_protection.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	bucket := d.Id()
 	if d.HasChange("status") {
@@ -104,25 +105,25 @@ func resourceTencentCloudCIOriginalImageProtectionUpdate(d *schema.ResourceData,
 		}
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		return resourceTencentCloudCIOriginalImageProtectionRead(d, meta)
+		return resourceTencentCloudCIOriginalImageProtectionRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceTencentCloudCIOriginalImageProtectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCIOriginalImageProtectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ci_original_image_protection.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	bucket := d.Get("bucket").(string)
 	service := CiService{client: meta.(*TencentCloudClient).apiV3Conn}
 	err := service.CloseCiOriginalImageProtectionById(ctx, bucket)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -29,10 +29,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ciam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ciam/v20220331"
@@ -41,12 +41,16 @@ import (
 
 func resourceTencentCloudCiamUserGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiamUserGroupCreate,
-		Read:   resourceTencentCloudCiamUserGroupRead,
-		Update: resourceTencentCloudCiamUserGroupUpdate,
-		Delete: resourceTencentCloudCiamUserGroupDelete,
+		CreateContext: resourceTencentCloudCiamUserGroupCreate,
+		ReadContext:   resourceTencentCloudCiamUserGroupRead,
+		UpdateContext: resourceTencentCloudCiamUserGroupUpdate,
+		DeleteContext: resourceTencentCloudCiamUserGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"user_store_id": {
@@ -68,7 +72,7 @@ func resourceTencentCloudCiamUserGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCiamUserGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiamUserGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ciam_user_group.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -93,8 +97,8 @@ func resourceTencentCloudCiamUserGroupCreate(d *schema.ResourceData, meta interf
 		request.Description = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCiamClient().CreateUserGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCiamClient().CreateUserGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -105,36 +109,36 @@ func resourceTencentCloudCiamUserGroupCreate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ciam userGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	userGroupId = *response.Response.UserGroupId
 
-	d.SetId(userStoreId + FILED_SP + userGroupId)
+	d.SetId(helper.IdFormat(userStoreId, userGroupId))
 
-	return resourceTencentCloudCiamUserGroupRead(d, meta)
+	return resourceTencentCloudCiamUserGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiamUserGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiamUserGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ciam_user_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiamService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	userStoreId := idSplit[0]
 	userGroupId := idSplit[1]
 
 	userGroup, err := service.DescribeCiamUserGroupById(ctx, userStoreId, userGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if userGroup == nil {
@@ -158,7 +162,7 @@ func resourceTencentCloudCiamUserGroupRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudCiamUserGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiamUserGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ciam_user_group.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -166,9 +170,9 @@ func resourceTencentCloudCiamUserGroupUpdate(d *schema.ResourceData, meta interf
 
 	request := ciam.NewUpdateUserGroupRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	userStoreId := idSplit[0]
 	userGroupId := idSplit[1]
@@ -202,8 +206,8 @@ func resourceTencentCloudCiamUserGroupUpdate(d *schema.ResourceData, meta interf
 			request.Description = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCiamClient().UpdateUserGroup(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCiamClient().UpdateUserGroupWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -213,30 +217,30 @@ func resourceTencentCloudCiamUserGroupUpdate(d *schema.ResourceData, meta interf
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update ciam userGroup failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
-	return resourceTencentCloudCiamUserGroupRead(d, meta)
+	return resourceTencentCloudCiamUserGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiamUserGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiamUserGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ciam_user_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiamService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	userStoreId := idSplit[0]
 	userGroupId := idSplit[1]
 
 	if err := service.DeleteCiamUserGroupById(ctx, userStoreId, userGroupId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -24,7 +24,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ciam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ciam/v20220331"
@@ -33,12 +35,16 @@ import (
 
 func resourceTencentCloudCiamUserStore() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiamUserStoreCreate,
-		Read:   resourceTencentCloudCiamUserStoreRead,
-		Update: resourceTencentCloudCiamUserStoreUpdate,
-		Delete: resourceTencentCloudCiamUserStoreDelete,
+		CreateContext: resourceTencentCloudCiamUserStoreCreate,
+		ReadContext:   resourceTencentCloudCiamUserStoreRead,
+		UpdateContext: resourceTencentCloudCiamUserStoreUpdate,
+		DeleteContext: resourceTencentCloudCiamUserStoreDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"user_pool_name": {
@@ -62,7 +68,7 @@ func resourceTencentCloudCiamUserStore() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCiamUserStoreCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiamUserStoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ciam_user_store.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -85,8 +91,8 @@ func resourceTencentCloudCiamUserStoreCreate(d *schema.ResourceData, meta interf
 		request.UserPoolLogo = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCiamClient().CreateUserStore(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCiamClient().CreateUserStoreWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -97,22 +103,22 @@ func resourceTencentCloudCiamUserStoreCreate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ciam userStore failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	UserStoreId = *response.Response.UserStoreId
 	d.SetId(UserStoreId)
 
-	return resourceTencentCloudCiamUserStoreRead(d, meta)
+	return resourceTencentCloudCiamUserStoreRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiamUserStoreRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiamUserStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ciam_user_store.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiamService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -120,7 +126,7 @@ func resourceTencentCloudCiamUserStoreRead(d *schema.ResourceData, meta interfac
 
 	userStore, err := service.DescribeCiamUserStoreById(ctx, userStoreId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if userStore == nil {
@@ -144,7 +150,7 @@ func resourceTencentCloudCiamUserStoreRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudCiamUserStoreUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiamUserStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ciam_user_store.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -178,8 +184,8 @@ func resourceTencentCloudCiamUserStoreUpdate(d *schema.ResourceData, meta interf
 			request.UserPoolLogo = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCiamClient().UpdateUserStore(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCiamClient().UpdateUserStoreWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -189,25 +195,25 @@ func resourceTencentCloudCiamUserStoreUpdate(d *schema.ResourceData, meta interf
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update ciam userStore failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCiamUserStoreRead(d, meta)
+	return resourceTencentCloudCiamUserStoreRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCiamUserStoreDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCiamUserStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ciam_user_store.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CiamService{client: meta.(*TencentCloudClient).apiV3Conn}
 	userStoreId := d.Id()
 
 	if err := service.DeleteCiamUserStoreById(ctx, userStoreId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
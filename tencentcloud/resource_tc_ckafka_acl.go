@@ -29,19 +29,20 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCkafkaAcl() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCkafkaAclCreate,
-		Read:   resourceTencentCloudCkafkaAclRead,
-		Delete: resourceTencentCloudCkafkaAclDelete,
+		CreateContext: resourceTencentCloudCkafkaAclCreate,
+		ReadContext:   resourceTencentCloudCkafkaAclRead,
+		DeleteContext: resourceTencentCloudCkafkaAclDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -95,11 +96,11 @@ func resourceTencentCloudCkafkaAcl() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCkafkaAclCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaAclCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_acl.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Get("instance_id").(string)
 	resourceType := d.Get("resource_type").(string)
@@ -113,19 +114,19 @@ func resourceTencentCloudCkafkaAclCreate(d *schema.ResourceData, meta interface{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	if err := ckafkaService.CreateAcl(ctx, instanceId, resourceType, resourceName, operation, permissionType, host, principal); err != nil {
-		return fmt.Errorf("[CRITAL]%s create ckafka user failed, reason:%+v", logId, err)
+		return diag.Errorf("[CRITAL]%s create ckafka user failed, reason:%+v", logId, err)
 	}
-	d.SetId(instanceId + FILED_SP + permissionType + FILED_SP + principal + FILED_SP + host + FILED_SP + operation + FILED_SP + resourceType + FILED_SP + resourceName)
+	d.SetId(helper.IdFormat(instanceId, permissionType, principal, host, operation, resourceType, resourceName))
 
-	return resourceTencentCloudCkafkaAclRead(d, meta)
+	return resourceTencentCloudCkafkaAclRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCkafkaAclRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaAclRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_acl.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	ckafkaService := CkafkaService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -133,13 +134,13 @@ func resourceTencentCloudCkafkaAclRead(d *schema.ResourceData, meta interface{})
 	id := d.Id()
 	info, has, err := ckafkaService.DescribeAclByAclId(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
 		return nil
 	}
-	items := strings.Split(id, FILED_SP)
+	items := helper.IdParse(id)
 	_ = d.Set("instance_id", items[0])
 	_ = d.Set("resource_type", CKAFKA_ACL_RESOURCE_TYPE_TO_STRING[*info.ResourceType])
 	_ = d.Set("resource_name", info.ResourceName)
@@ -151,17 +152,17 @@ func resourceTencentCloudCkafkaAclRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudCkafkaAclDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaAclDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_user.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	ckafkaService := CkafkaService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
 	if err := ckafkaService.DeleteAcl(ctx, d.Id()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
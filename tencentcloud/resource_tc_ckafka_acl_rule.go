@@ -34,10 +34,10 @@ package tencentcloud
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -46,12 +46,16 @@ import (
 
 func resourceTencentCloudCkafkaAclRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCkafkaAclRuleCreate,
-		Read:   resourceTencentCloudCkafkaAclRuleRead,
-		Update: resourceTencentCloudCkafkaAclRuleUpdate,
-		Delete: resourceTencentCloudCkafkaAclRuleDelete,
+		CreateContext: resourceTencentCloudCkafkaAclRuleCreate,
+		ReadContext:   resourceTencentCloudCkafkaAclRuleRead,
+		UpdateContext: resourceTencentCloudCkafkaAclRuleUpdate,
+		DeleteContext: resourceTencentCloudCkafkaAclRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -133,7 +137,7 @@ func resourceTencentCloudCkafkaAclRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCkafkaAclRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaAclRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_acl_rule.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -190,8 +194,8 @@ func resourceTencentCloudCkafkaAclRuleCreate(d *schema.ResourceData, meta interf
 		request.IsApplied = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().CreateAclRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().CreateAclRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -201,34 +205,34 @@ func resourceTencentCloudCkafkaAclRuleCreate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ckafka aclRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(instanceId + FILED_SP + ruleName)
+	d.SetId(helper.IdFormat(instanceId, ruleName))
 
-	return resourceTencentCloudCkafkaAclRuleRead(d, meta)
+	return resourceTencentCloudCkafkaAclRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCkafkaAclRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaAclRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_acl_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CkafkaService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	ruleName := idSplit[1]
 
 	aclRule, err := service.DescribeCkafkaAclRuleById(ctx, instanceId, ruleName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if aclRule == nil {
@@ -258,7 +262,7 @@ func resourceTencentCloudCkafkaAclRuleRead(d *schema.ResourceData, meta interfac
 		aclList := make([]*ckafka.AclRuleInfo, 0)
 		err := json.Unmarshal([]byte(*aclRule.AclList), &aclList)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		for _, acl := range aclList {
 			AclListMap := map[string]interface{}{}
@@ -297,7 +301,7 @@ func resourceTencentCloudCkafkaAclRuleRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudCkafkaAclRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaAclRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_acl_rule.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -305,9 +309,9 @@ func resourceTencentCloudCkafkaAclRuleUpdate(d *schema.ResourceData, meta interf
 
 	request := ckafka.NewModifyAclRuleRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	instanceId := idSplit[0]
 	ruleName := idSplit[1]
@@ -325,10 +329,10 @@ func resourceTencentCloudCkafkaAclRuleUpdate(d *schema.ResourceData, meta interf
 	}
 
 	if !hasChange {
-		return resourceTencentCloudCkafkaAclRuleRead(d, meta)
+		return resourceTencentCloudCkafkaAclRuleRead(ctx, d, meta)
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().ModifyAclRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().ModifyAclRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -338,29 +342,29 @@ func resourceTencentCloudCkafkaAclRuleUpdate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update ckafka aclRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCkafkaAclRuleRead(d, meta)
+	return resourceTencentCloudCkafkaAclRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCkafkaAclRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaAclRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_acl_rule.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CkafkaService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	ruleName := idSplit[1]
 
 	if err := service.DeleteCkafkaAclRuleById(ctx, instanceId, ruleName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
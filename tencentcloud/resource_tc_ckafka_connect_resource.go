@@ -37,6 +37,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -45,12 +46,16 @@ import (
 
 func resourceTencentCloudCkafkaConnectResource() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCkafkaConnectResourceCreate,
-		Read:   resourceTencentCloudCkafkaConnectResourceRead,
-		Update: resourceTencentCloudCkafkaConnectResourceUpdate,
-		Delete: resourceTencentCloudCkafkaConnectResourceDelete,
+		CreateContext: resourceTencentCloudCkafkaConnectResourceCreate,
+		ReadContext:   resourceTencentCloudCkafkaConnectResourceRead,
+		UpdateContext: resourceTencentCloudCkafkaConnectResourceUpdate,
+		DeleteContext: resourceTencentCloudCkafkaConnectResourceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"resource_name": {
@@ -584,7 +589,7 @@ func resourceTencentCloudCkafkaConnectResource() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCkafkaConnectResourceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaConnectResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_connect_resource.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -888,8 +893,8 @@ func resourceTencentCloudCkafkaConnectResourceCreate(d *schema.ResourceData, met
 		request.KafkaConnectParam = &kafkaConnectParam
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().CreateConnectResource(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().CreateConnectResourceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -900,7 +905,7 @@ func resourceTencentCloudCkafkaConnectResourceCreate(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ckafka connectResource failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	resourceId = *response.Response.Result.ResourceId
@@ -908,22 +913,22 @@ func resourceTencentCloudCkafkaConnectResourceCreate(d *schema.ResourceData, met
 
 	service := CkafkaService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"1"}, 2*readRetryTimeout, time.Second, service.CkafkaConnectResourceStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"1"}, d.Timeout(schema.TimeoutCreate), time.Second, service.CkafkaConnectResourceStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudCkafkaConnectResourceRead(d, meta)
+	return resourceTencentCloudCkafkaConnectResourceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCkafkaConnectResourceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaConnectResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_connect_resource.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CkafkaService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -931,7 +936,7 @@ func resourceTencentCloudCkafkaConnectResourceRead(d *schema.ResourceData, meta
 
 	connectResource, err := service.DescribeCkafkaConnectResourceById(ctx, resourceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if connectResource == nil {
@@ -1376,7 +1381,7 @@ func resourceTencentCloudCkafkaConnectResourceRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudCkafkaConnectResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaConnectResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_connect_resource.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -1701,8 +1706,8 @@ func resourceTencentCloudCkafkaConnectResourceUpdate(d *schema.ResourceData, met
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().ModifyConnectResource(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().ModifyConnectResourceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1712,32 +1717,32 @@ func resourceTencentCloudCkafkaConnectResourceUpdate(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update ckafka connectResource failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	service := CkafkaService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"1"}, 2*readRetryTimeout, time.Second, service.CkafkaConnectResourceStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"1"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.CkafkaConnectResourceStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudCkafkaConnectResourceRead(d, meta)
+	return resourceTencentCloudCkafkaConnectResourceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCkafkaConnectResourceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaConnectResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_connect_resource.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CkafkaService{client: meta.(*TencentCloudClient).apiV3Conn}
 	resourceId := d.Id()
 
 	if err := service.DeleteCkafkaConnectResourceById(ctx, resourceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
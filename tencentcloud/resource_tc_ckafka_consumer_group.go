@@ -23,10 +23,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -35,11 +35,14 @@ import (
 
 func resourceTencentCloudCkafkaConsumerGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCkafkaConsumerGroupCreate,
-		Read:   resourceTencentCloudCkafkaConsumerGroupRead,
-		Delete: resourceTencentCloudCkafkaConsumerGroupDelete,
+		CreateContext: resourceTencentCloudCkafkaConsumerGroupCreate,
+		ReadContext:   resourceTencentCloudCkafkaConsumerGroupRead,
+		DeleteContext: resourceTencentCloudCkafkaConsumerGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -69,7 +72,7 @@ func resourceTencentCloudCkafkaConsumerGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCkafkaConsumerGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaConsumerGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_consumer_group.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -98,8 +101,8 @@ func resourceTencentCloudCkafkaConsumerGroupCreate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().CreateConsumer(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().CreateConsumerWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -109,34 +112,34 @@ func resourceTencentCloudCkafkaConsumerGroupCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ckafka consumerGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(instanceId + FILED_SP + groupName)
+	d.SetId(helper.IdFormat(instanceId, groupName))
 
-	return resourceTencentCloudCkafkaConsumerGroupRead(d, meta)
+	return resourceTencentCloudCkafkaConsumerGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCkafkaConsumerGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaConsumerGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_consumer_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CkafkaService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	groupName := idSplit[1]
 
 	consumerGroup, err := service.DescribeCkafkaConsumerGroupById(ctx, instanceId, groupName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if consumerGroup == nil {
@@ -159,23 +162,23 @@ func resourceTencentCloudCkafkaConsumerGroupRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTencentCloudCkafkaConsumerGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaConsumerGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_consumer_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CkafkaService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	groupName := idSplit[1]
 
 	if err := service.DeleteCkafkaConsumerGroupById(ctx, instanceId, groupName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -16,8 +16,11 @@ resource "tencentcloud_ckafka_consumer_group_modify_offset" "consumer_group_modi
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -26,9 +29,12 @@ import (
 
 func resourceTencentCloudCkafkaConsumerGroupModifyOffset() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCkafkaConsumerGroupModifyOffsetCreate,
-		Read:   resourceTencentCloudCkafkaConsumerGroupModifyOffsetRead,
-		Delete: resourceTencentCloudCkafkaConsumerGroupModifyOffsetDelete,
+		CreateContext: resourceTencentCloudCkafkaConsumerGroupModifyOffsetCreate,
+		ReadContext:   resourceTencentCloudCkafkaConsumerGroupModifyOffsetRead,
+		DeleteContext: resourceTencentCloudCkafkaConsumerGroupModifyOffsetDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -98,7 +104,7 @@ func resourceTencentCloudCkafkaConsumerGroupModifyOffset() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCkafkaConsumerGroupModifyOffsetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaConsumerGroupModifyOffsetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_consumer_group_modify_offset.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -151,8 +157,8 @@ func resourceTencentCloudCkafkaConsumerGroupModifyOffsetCreate(d *schema.Resourc
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().ModifyGroupOffsets(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().ModifyGroupOffsetsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -162,22 +168,22 @@ func resourceTencentCloudCkafkaConsumerGroupModifyOffsetCreate(d *schema.Resourc
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate ckafka consumerGroupModifyOffset failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(instanceId + FILED_SP + group)
+	d.SetId(helper.IdFormat(instanceId, group))
 
-	return resourceTencentCloudCkafkaConsumerGroupModifyOffsetRead(d, meta)
+	return resourceTencentCloudCkafkaConsumerGroupModifyOffsetRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCkafkaConsumerGroupModifyOffsetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaConsumerGroupModifyOffsetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_consumer_group_modify_offset.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCkafkaConsumerGroupModifyOffsetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaConsumerGroupModifyOffsetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_consumer_group_modify_offset.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -46,6 +46,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -54,12 +55,17 @@ import (
 
 func resourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCkafkaDatahubTaskCreate,
-		Read:   resourceTencentCloudCkafkaDatahubTaskRead,
-		Update: resourceTencentCloudCkafkaDatahubTaskUpdate,
-		Delete: resourceTencentCloudCkafkaDatahubTaskDelete,
+		CreateContext: resourceTencentCloudCkafkaDatahubTaskCreate,
+		ReadContext:   resourceTencentCloudCkafkaDatahubTaskRead,
+		UpdateContext: resourceTencentCloudCkafkaDatahubTaskUpdate,
+		DeleteContext: resourceTencentCloudCkafkaDatahubTaskDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(6 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"task_name": {
@@ -4716,7 +4722,7 @@ func resourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCkafkaDatahubTaskCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaDatahubTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_datahub_task.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -7339,8 +7345,8 @@ func resourceTencentCloudCkafkaDatahubTaskCreate(d *schema.ResourceData, meta in
 		request.TaskId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().CreateDatahubTask(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().CreateDatahubTaskWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -7351,7 +7357,7 @@ func resourceTencentCloudCkafkaDatahubTaskCreate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ckafka datahubTask failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	taskId = *response.Response.Result.TaskId
@@ -7359,22 +7365,22 @@ func resourceTencentCloudCkafkaDatahubTaskCreate(d *schema.ResourceData, meta in
 
 	service := CkafkaService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"1"}, 2*readRetryTimeout, time.Second, service.CkafkaDatahubTaskStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"1"}, d.Timeout(schema.TimeoutCreate), time.Second, service.CkafkaDatahubTaskStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudCkafkaDatahubTaskRead(d, meta)
+	return resourceTencentCloudCkafkaDatahubTaskRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCkafkaDatahubTaskRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaDatahubTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_datahub_task.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CkafkaService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -7382,7 +7388,7 @@ func resourceTencentCloudCkafkaDatahubTaskRead(d *schema.ResourceData, meta inte
 
 	datahubTask, err := service.DescribeDatahubTask(ctx, taskId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if datahubTask == nil {
@@ -10971,7 +10977,7 @@ func resourceTencentCloudCkafkaDatahubTaskRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudCkafkaDatahubTaskUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaDatahubTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_datahub_task.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -10989,10 +10995,10 @@ func resourceTencentCloudCkafkaDatahubTaskUpdate(d *schema.ResourceData, meta in
 	}
 
 	if !hasChange {
-		return resourceTencentCloudCkafkaDatahubTaskRead(d, meta)
+		return resourceTencentCloudCkafkaDatahubTaskRead(ctx, d, meta)
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().ModifyDatahubTask(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().ModifyDatahubTaskWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -11002,38 +11008,38 @@ func resourceTencentCloudCkafkaDatahubTaskUpdate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update ckafka datahubTask failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	service := CkafkaService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"1"}, 2*readRetryTimeout, time.Second, service.CkafkaDatahubTaskStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"1"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.CkafkaDatahubTaskStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudCkafkaDatahubTaskRead(d, meta)
+	return resourceTencentCloudCkafkaDatahubTaskRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCkafkaDatahubTaskDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaDatahubTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_datahub_task.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CkafkaService{client: meta.(*TencentCloudClient).apiV3Conn}
 	taskId := d.Id()
 
 	if err := service.DeleteCkafkaDatahubTaskById(ctx, taskId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	conf := BuildStateChangeConf([]string{}, []string{"3"}, 2*readRetryTimeout, time.Second, service.CkafkaDatahubTaskStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"3"}, d.Timeout(schema.TimeoutDelete), time.Second, service.CkafkaDatahubTaskStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	return nil
@@ -31,7 +31,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -40,12 +42,16 @@ import (
 
 func resourceTencentCloudCkafkaDatahubTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCkafkaDatahubTopicCreate,
-		Read:   resourceTencentCloudCkafkaDatahubTopicRead,
-		Update: resourceTencentCloudCkafkaDatahubTopicUpdate,
-		Delete: resourceTencentCloudCkafkaDatahubTopicDelete,
+		CreateContext: resourceTencentCloudCkafkaDatahubTopicCreate,
+		ReadContext:   resourceTencentCloudCkafkaDatahubTopicRead,
+		UpdateContext: resourceTencentCloudCkafkaDatahubTopicUpdate,
+		DeleteContext: resourceTencentCloudCkafkaDatahubTopicDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -82,7 +88,7 @@ func resourceTencentCloudCkafkaDatahubTopic() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCkafkaDatahubTopicCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaDatahubTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_datahub_topic.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -119,8 +125,8 @@ func resourceTencentCloudCkafkaDatahubTopicCreate(d *schema.ResourceData, meta i
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().CreateDatahubTopic(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().CreateDatahubTopicWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -130,32 +136,32 @@ func resourceTencentCloudCkafkaDatahubTopicCreate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ckafka datahubTopic failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(topicName)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::ckafka:%s:uin/:dipTopic/%s", region, topicName)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCkafkaDatahubTopicRead(d, meta)
+	return resourceTencentCloudCkafkaDatahubTopicRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCkafkaDatahubTopicRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaDatahubTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_datahub_topic.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CkafkaService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -163,7 +169,7 @@ func resourceTencentCloudCkafkaDatahubTopicRead(d *schema.ResourceData, meta int
 
 	datahubTopic, err := service.DescribeCkafkaDatahubTopicById(ctx, topicName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if datahubTopic == nil {
@@ -192,14 +198,14 @@ func resourceTencentCloudCkafkaDatahubTopicRead(d *schema.ResourceData, meta int
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "ckafka", "dipTopic", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudCkafkaDatahubTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaDatahubTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_datahub_topic.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -215,7 +221,7 @@ func resourceTencentCloudCkafkaDatahubTopicUpdate(d *schema.ResourceData, meta i
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -239,8 +245,8 @@ func resourceTencentCloudCkafkaDatahubTopicUpdate(d *schema.ResourceData, meta i
 			request.Note = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().ModifyDatahubTopic(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().ModifyDatahubTopicWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -250,11 +256,11 @@ func resourceTencentCloudCkafkaDatahubTopicUpdate(d *schema.ResourceData, meta i
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update ckafka datahubTopic failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if d.HasChange("tags") {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
@@ -263,25 +269,25 @@ func resourceTencentCloudCkafkaDatahubTopicUpdate(d *schema.ResourceData, meta i
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("ckafka", "dipTopic", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCkafkaDatahubTopicRead(d, meta)
+	return resourceTencentCloudCkafkaDatahubTopicRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCkafkaDatahubTopicDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaDatahubTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_datahub_topic.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CkafkaService{client: meta.(*TencentCloudClient).apiV3Conn}
 	topicName := d.Id()
 
 	if err := service.DeleteCkafkaDatahubTopicById(ctx, topicName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -137,7 +137,9 @@ import (
 	"log"
 	"reflect"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -146,12 +148,17 @@ import (
 
 func resourceTencentCloudCkafkaInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCkafkaInstanceCreate,
-		Read:   resourceTencentCloudCkafkaInstanceRead,
-		Update: resourceTencentCloudCkafkaInstanceUpdate,
-		Delete: resourceTencentCLoudCkafkaInstanceDelete,
+		CreateContext: resourceTencentCloudCkafkaInstanceCreate,
+		ReadContext:   resourceTencentCloudCkafkaInstanceRead,
+		UpdateContext: resourceTencentCloudCkafkaInstanceUpdate,
+		DeleteContext: resourceTencentCLoudCkafkaInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -488,7 +495,7 @@ func createCkafkaInstancePostPaid(ctx context.Context, d *schema.ResourceData, m
 	logId := getLogId(ctx)
 	request := ckafka.NewCreatePostPaidInstanceRequest()
 	ckafkaRequestSetParams(request, d)
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().CreatePostPaidInstance(request)
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().CreatePostPaidInstanceWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s create ckafka instance failed, reason:%s\n", logId, err.Error())
 		return
@@ -504,7 +511,7 @@ func createCkafkaInstancePrePaid(ctx context.Context, d *schema.ResourceData, me
 	logId := getLogId(ctx)
 	request := ckafka.NewCreateInstancePreRequest()
 	ckafkaRequestSetParams(request, d)
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().CreateInstancePre(request)
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().CreateInstancePreWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s create ckafka instance failed, reason:%s\n", logId, err.Error())
 		return
@@ -517,7 +524,7 @@ func createCkafkaInstancePrePaid(ctx context.Context, d *schema.ResourceData, me
 	return
 }
 
-func resourceTencentCloudCkafkaInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_instance.create")()
 	var (
 		instanceId *string
@@ -526,8 +533,8 @@ func resourceTencentCloudCkafkaInstanceCreate(d *schema.ResourceData, meta inter
 		service    = CkafkaService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
 		}
-		ctx = context.WithValue(context.TODO(), logIdKey, logId)
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	chargeType := d.Get("charge_type").(string)
 	if chargeType == CKAFKA_CHARGE_TYPE_POSTPAID {
@@ -535,15 +542,15 @@ func resourceTencentCloudCkafkaInstanceCreate(d *schema.ResourceData, meta inter
 	} else if chargeType == CKAFKA_CHARGE_TYPE_PREPAID {
 		instanceId, createErr = createCkafkaInstancePrePaid(ctx, d, meta)
 	} else {
-		return fmt.Errorf("invalid `charge_type` value")
+		return diag.Errorf("invalid `charge_type` value")
 	}
 	if createErr != nil {
-		return createErr
+		return diag.FromErr(createErr)
 	}
 	if instanceId == nil {
-		return fmt.Errorf("instanceId is nil")
+		return diag.Errorf("instanceId is nil")
 	}
-	err := resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		has, ready, err := service.CheckCkafkaInstanceReady(ctx, *instanceId)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -557,7 +564,7 @@ func resourceTencentCloudCkafkaInstanceCreate(d *schema.ResourceData, meta inter
 		return resource.RetryableError(fmt.Errorf("create ckafka instance task is processing"))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(*instanceId)
 
@@ -658,7 +665,7 @@ func resourceTencentCloudCkafkaInstanceCreate(d *schema.ResourceData, meta inter
 	if needModify {
 		err := service.ModifyCkafkaInstanceAttributes(ctx, modifyRequest)
 		if err != nil {
-			return fmt.Errorf("[API]Set kafka instance attributes fail, reason:%s", err.Error())
+			return diag.Errorf("[API]Set kafka instance attributes fail, reason:%s", err.Error())
 		}
 	}
 
@@ -669,19 +676,19 @@ func resourceTencentCloudCkafkaInstanceCreate(d *schema.ResourceData, meta inter
 	if tags := helper.GetTags(d, "tag_set"); len(tags) > 0 {
 		resourceName := BuildTagResourceName("ckafka", "ckafkaId", region, *instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCkafkaInstanceRead(d, meta)
+	return resourceTencentCloudCkafkaInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCkafkaInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var service = CkafkaService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -691,7 +698,7 @@ func resourceTencentCloudCkafkaInstanceRead(d *schema.ResourceData, meta interfa
 	var info *ckafka.InstanceDetail
 	var isExist = true
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		res, has, e := service.DescribeCkafkaInstanceById(ctx, instanceId)
 		if e != nil {
 			return retryError(e)
@@ -705,7 +712,7 @@ func resourceTencentCloudCkafkaInstanceRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("[API]Describe kafka instance fail, reason:%s", err.Error())
+		return diag.Errorf("[API]Describe kafka instance fail, reason:%s", err.Error())
 	}
 
 	if !isExist {
@@ -756,7 +763,7 @@ func resourceTencentCloudCkafkaInstanceRead(d *schema.ResourceData, meta interfa
 
 	tags, err := tagService.DescribeResourceTags(ctx, "ckafka", "ckafkaId", region, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tag_set", tags)
 
@@ -768,8 +775,8 @@ func resourceTencentCloudCkafkaInstanceRead(d *schema.ResourceData, meta interfa
 		response = ckafka.NewDescribeInstanceAttributesResponse()
 	)
 	request.InstanceId = &instanceId
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := service.client.UseCkafkaClient().DescribeInstanceAttributes(request)
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		result, e := service.client.UseCkafkaClient().DescribeInstanceAttributesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -810,15 +817,15 @@ func resourceTencentCloudCkafkaInstanceRead(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read Ckafka Instance Attributes failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudCkafkaInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_instance.update")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := CkafkaService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -832,7 +839,7 @@ func resourceTencentCloudCkafkaInstanceUpdate(d *schema.ResourceData, meta inter
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -910,7 +917,7 @@ func resourceTencentCloudCkafkaInstanceUpdate(d *schema.ResourceData, meta inter
 
 	err := service.ModifyCkafkaInstanceAttributes(ctx, request)
 	if err != nil {
-		return fmt.Errorf("[API]Set kafka instance attributes fail, reason:%s", err.Error())
+		return diag.Errorf("[API]Set kafka instance attributes fail, reason:%s", err.Error())
 	}
 
 	if d.HasChange("band_width") || d.HasChange("disk_size") || d.HasChange("partition") {
@@ -926,13 +933,13 @@ func resourceTencentCloudCkafkaInstanceUpdate(d *schema.ResourceData, meta inter
 			request.Partition = helper.Int64(int64(v.(int)))
 		}
 
-		_, err := service.client.UseCkafkaClient().ModifyInstancePre(request)
+		_, err := service.client.UseCkafkaClient().ModifyInstancePreWithContext(ctx, request)
 		if err != nil {
-			return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId,
+			return diag.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId,
 				request.GetAction(), request.ToJsonString(), err.Error())
 		}
 
-		err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			_, ready, err := service.CheckCkafkaInstanceReady(ctx, instanceId)
 			if err != nil {
 				return resource.NonRetryableError(err)
@@ -944,7 +951,7 @@ func resourceTencentCloudCkafkaInstanceUpdate(d *schema.ResourceData, meta inter
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -959,30 +966,30 @@ func resourceTencentCloudCkafkaInstanceUpdate(d *schema.ResourceData, meta inter
 
 		resourceName := BuildTagResourceName("ckafka", "ckafkaId", region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCkafkaInstanceRead(d, meta)
+	return resourceTencentCloudCkafkaInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCLoudCkafkaInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCLoudCkafkaInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_instance.delete")()
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = CkafkaService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
 		}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	instanceId := d.Id()
 	chargeType := d.Get("charge_type").(string)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if chargeType == CKAFKA_CHARGE_TYPE_POSTPAID {
 			request := ckafka.NewDeleteInstancePostRequest()
 			request.InstanceId = &instanceId
-			_, err := service.client.UseCkafkaClient().DeleteInstancePost(request)
+			_, err := service.client.UseCkafkaClient().DeleteInstancePostWithContext(ctx, request)
 			if err != nil {
 				return retryError(err, "UnsupportedOperation")
 			}
@@ -990,7 +997,7 @@ func resourceTencentCLoudCkafkaInstanceDelete(d *schema.ResourceData, meta inter
 		} else if chargeType == CKAFKA_CHARGE_TYPE_PREPAID {
 			request := ckafka.NewDeleteInstancePreRequest()
 			request.InstanceId = &instanceId
-			_, err := service.client.UseCkafkaClient().DeleteInstancePre(request)
+			_, err := service.client.UseCkafkaClient().DeleteInstancePreWithContext(ctx, request)
 			if err != nil {
 				return retryError(err, "UnsupportedOperation")
 			}
@@ -1000,10 +1007,10 @@ func resourceTencentCLoudCkafkaInstanceDelete(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		has, _, err := service.CheckCkafkaInstanceReady(ctx, instanceId)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -1014,7 +1021,7 @@ func resourceTencentCLoudCkafkaInstanceDelete(d *schema.ResourceData, meta inter
 		return resource.RetryableError(fmt.Errorf("delete ckafka instance task is processing"))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
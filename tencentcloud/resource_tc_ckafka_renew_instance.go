@@ -13,8 +13,11 @@ resource "tencentcloud_ckafka_renew_instance" "renew_ckafka_instance" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -23,9 +26,12 @@ import (
 
 func resourceTencentCloudCkafkaRenewInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCkafkaRenewInstanceCreate,
-		Read:   resourceTencentCloudCkafkaRenewInstanceRead,
-		Delete: resourceTencentCloudCkafkaRenewInstanceDelete,
+		CreateContext: resourceTencentCloudCkafkaRenewInstanceCreate,
+		ReadContext:   resourceTencentCloudCkafkaRenewInstanceRead,
+		DeleteContext: resourceTencentCloudCkafkaRenewInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -44,7 +50,7 @@ func resourceTencentCloudCkafkaRenewInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCkafkaRenewInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaRenewInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_renew_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -63,8 +69,8 @@ func resourceTencentCloudCkafkaRenewInstanceCreate(d *schema.ResourceData, meta
 		request.TimeSpan = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().RenewCkafkaInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().RenewCkafkaInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -74,22 +80,22 @@ func resourceTencentCloudCkafkaRenewInstanceCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate ckafka RenewInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudCkafkaRenewInstanceRead(d, meta)
+	return resourceTencentCloudCkafkaRenewInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCkafkaRenewInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaRenewInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_renew_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCkafkaRenewInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaRenewInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_renew_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
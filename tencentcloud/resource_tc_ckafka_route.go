@@ -18,12 +18,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -32,12 +31,15 @@ import (
 
 func resourceTencentCloudCkafkaRoute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCkafkaRouteCreate,
-		Read:   resourceTencentCloudCkafkaRouteRead,
-		Update: resourceTencentCloudCkafkaRouteUpdate,
-		Delete: resourceTencentCloudCkafkaRouteDelete,
+		CreateContext: resourceTencentCloudCkafkaRouteCreate,
+		ReadContext:   resourceTencentCloudCkafkaRouteRead,
+		UpdateContext: resourceTencentCloudCkafkaRouteUpdate,
+		DeleteContext: resourceTencentCloudCkafkaRouteDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -143,7 +145,7 @@ func resourceTencentCloudCkafkaRoute() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCkafkaRouteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_route.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -191,8 +193,8 @@ func resourceTencentCloudCkafkaRouteCreate(d *schema.ResourceData, meta interfac
 		request.Ip = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().CreateRoute(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCkafkaClient().CreateRouteWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -203,48 +205,48 @@ func resourceTencentCloudCkafkaRouteCreate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ckafka route failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("Result.Data: %+v", response.Response.Result.Data)
 	routeIdInt64 := *response.Response.Result.Data.RouteDTO.RouteId
 	flowIdInt64 := *response.Response.Result.Data.FlowId
 	reouteIdString := strconv.FormatInt(routeIdInt64, 10)
-	d.SetId(instanceId + FILED_SP + reouteIdString)
+	d.SetId(helper.IdFormat(instanceId, reouteIdString))
 
 	service := CkafkaService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"0"}, 1*readRetryTimeout, time.Second, service.CkafkaRouteStateRefreshFunc(flowIdInt64, []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"0"}, d.Timeout(schema.TimeoutCreate), time.Second, service.CkafkaRouteStateRefreshFunc(ctx, flowIdInt64, []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudCkafkaRouteRead(d, meta)
+	return resourceTencentCloudCkafkaRouteRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCkafkaRouteRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_route.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CkafkaService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	instanceId := items[0]
 	routeId := items[1]
 	routeIdInt64, err := strconv.ParseInt(routeId, 10, 64)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	route, err := service.DescribeCkafkaRouteById(ctx, instanceId, routeIdInt64)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("instance_id", instanceId)
 	if route == nil {
@@ -292,7 +294,7 @@ func resourceTencentCloudCkafkaRouteRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTencentCloudCkafkaRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_route.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -300,32 +302,32 @@ func resourceTencentCloudCkafkaRouteUpdate(d *schema.ResourceData, meta interfac
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
-	return resourceTencentCloudCkafkaRouteRead(d, meta)
+	return resourceTencentCloudCkafkaRouteRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCkafkaRouteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_route.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CkafkaService{client: meta.(*TencentCloudClient).apiV3Conn}
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	instanceId := items[0]
 	routeId := items[1]
 	routeIdInt64, err := strconv.ParseInt(routeId, 10, 64)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := service.DeleteCkafkaRouteById(ctx, instanceId, routeIdInt64); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
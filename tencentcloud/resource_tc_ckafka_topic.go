@@ -34,8 +34,8 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -44,12 +44,12 @@ import (
 
 func resourceTencentCloudCkafkaTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCkafkaTopicCreate,
-		Read:   resourceTencentCloudCkafkaTopicRead,
-		Update: resourceTencentCloudCkafkaTopicUpdate,
-		Delete: resourceTencentCLoudCkafkaTopicDelete,
+		CreateContext: resourceTencentCloudCkafkaTopicCreate,
+		ReadContext:   resourceTencentCloudCkafkaTopicRead,
+		UpdateContext: resourceTencentCloudCkafkaTopicUpdate,
+		DeleteContext: resourceTencentCLoudCkafkaTopicDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -163,11 +163,10 @@ func resourceTencentCloudCkafkaTopic() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCkafkaTopicCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_topic.create")()
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		ckafkcService = CkafkaService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
 		}
@@ -183,13 +182,14 @@ func resourceTencentCloudCkafkaTopicCreate(d *schema.ResourceData, meta interfac
 		whiteListSwitch       = d.Get("enable_white_list").(bool)
 		retention             = d.Get("retention").(int)
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	for _, value := range ipWhiteLists {
 		ipWhiteList = append(ipWhiteList, helper.String(value.(string)))
 	}
 	request.EnableWhiteList = helper.BoolToInt64Ptr(whiteListSwitch)
 	if whiteListSwitch {
 		if len(ipWhiteList) == 0 {
-			return fmt.Errorf("this Topic %s Create Failed, reason: ip whitelist switch is on, ip whitelist cannot be empty", topicName)
+			return diag.Errorf("this Topic %s Create Failed, reason: ip whitelist switch is on, ip whitelist cannot be empty", topicName)
 		}
 		request.IpWhiteList = ipWhiteList
 	}
@@ -216,50 +216,50 @@ func resourceTencentCloudCkafkaTopicCreate(d *schema.ResourceData, meta interfac
 	//Before create topic,Check if kafka exists
 	_, has, error := ckafkcService.DescribeInstanceById(ctx, instanceId)
 	if error != nil {
-		return error
+		return diag.FromErr(error)
 	}
 	if !has {
-		return fmt.Errorf("ckafka %s does not exist", instanceId)
+		return diag.Errorf("ckafka %s does not exist", instanceId)
 	}
 	errCreate := ckafkcService.CreateCkafkaTopic(ctx, request)
 	if errCreate != nil {
-		return errCreate
+		return diag.FromErr(errCreate)
 	}
 	_, hasExist, err := ckafkcService.DescribeCkafkaTopicByName(ctx, instanceId, topicName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !hasExist {
-		return fmt.Errorf("this Topic %s Create Failed", topicName)
+		return diag.Errorf("this Topic %s Create Failed", topicName)
 	}
 	if len(ipWhiteList) > 0 && whiteListSwitch {
 		err = ckafkcService.AddCkafkaTopicIpWhiteList(ctx, instanceId, topicName, ipWhiteList)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	resourceId := instanceId + FILED_SP + topicName
+	resourceId := helper.IdFormat(instanceId, topicName)
 	d.SetId(resourceId)
-	return resourceTencentCloudCkafkaTopicRead(d, meta)
+	return resourceTencentCloudCkafkaTopicRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCkafkaTopicRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_topic.read")()
 	defer inconsistentCheck(d, meta)()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	ckafkcService := CkafkaService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	instanceId := items[0]
 	topicName := items[1]
 	topicListInfo, hasExist, e := ckafkcService.DescribeCkafkaTopicByName(ctx, instanceId, topicName)
 	if e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 	if !hasExist {
 		d.SetId("")
@@ -267,7 +267,7 @@ func resourceTencentCloudCkafkaTopicRead(d *schema.ResourceData, meta interface{
 	}
 
 	var topicinfo *ckafka.TopicAttributesResponse
-	errInfo := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	errInfo := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		topicDetail, e := ckafkcService.DescribeCkafkaTopicAttributes(ctx, instanceId, topicName)
 		if e != nil {
 			return retryError(e)
@@ -280,7 +280,7 @@ func resourceTencentCloudCkafkaTopicRead(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if errInfo != nil {
-		return fmt.Errorf("[API]Describe kafka topic fail,reason:%s", errInfo.Error())
+		return diag.Errorf("[API]Describe kafka topic fail,reason:%s", errInfo.Error())
 	}
 	_ = d.Set("instance_id", instanceId)
 	_ = d.Set("note", topicinfo.Note)
@@ -305,17 +305,17 @@ func resourceTencentCloudCkafkaTopicRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTencentCloudCkafkaTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_topic.update")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	ckafkcService := CkafkaService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	instanceId := items[0]
 	topicName := items[1]
@@ -355,60 +355,60 @@ func resourceTencentCloudCkafkaTopicUpdate(d *schema.ResourceData, meta interfac
 			newIpWhiteList = append(newIpWhiteList, helper.String(value.(string)))
 		}
 		if len(newIpWhiteList) == 0 {
-			return fmt.Errorf("this Topic %s Create Failed, reason: ip whitelist switch is on, ip whitelist cannot be empty", topicName)
+			return diag.Errorf("this Topic %s Create Failed, reason: ip whitelist switch is on, ip whitelist cannot be empty", topicName)
 		}
 		request.IpWhiteList = newIpWhiteList
 	} else {
 		//IP whiteList Switch not turned on, and the ip whitelist cannot be modified
 		if d.HasChange("ip_white_list") {
-			return fmt.Errorf("this Topic %s IP whitelist Modification failed, reason: The Ip Whitelist Switch is not turned on", topicName)
+			return diag.Errorf("this Topic %s IP whitelist Modification failed, reason: The Ip Whitelist Switch is not turned on", topicName)
 		}
 	}
 	//Update partition num
 	oldPartitionNum, newPartitionNum := d.GetChange("partition_num")
 	if newPartitionNum.(int) < oldPartitionNum.(int) {
-		return fmt.Errorf("this Topic %s partition Modification failed, reason: The partitonNum must more than current partitionNum", topicName)
+		return diag.Errorf("this Topic %s partition Modification failed, reason: The partitonNum must more than current partitionNum", topicName)
 	} else {
 		if newPartitionNum.(int) > oldPartitionNum.(int) {
 			err := ckafkcService.AddCkafkaTopicPartition(ctx, instanceId, topicName, int64(newPartitionNum.(int)))
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 	err := ckafkcService.ModifyCkafkaTopicAttribute(ctx, request)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_, has, errDes := ckafkcService.DescribeCkafkaTopicByName(ctx, instanceId, topicName)
 	if errDes != nil {
-		return errDes
+		return diag.FromErr(errDes)
 	}
 	if !has {
 		errDes = fmt.Errorf("this Topic %s Update Failed", topicName)
-		return errDes
+		return diag.FromErr(errDes)
 	}
 
-	return resourceTencentCloudCkafkaTopicRead(d, meta)
+	return resourceTencentCloudCkafkaTopicRead(ctx, d, meta)
 }
 
-func resourceTencentCLoudCkafkaTopicDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCLoudCkafkaTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_topic.delete")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	ckafkcService := CkafkaService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	instanceId := items[0]
 	topicName := items[1]
 
 	err := ckafkcService.DeleteCkafkaTopic(ctx, instanceId, topicName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
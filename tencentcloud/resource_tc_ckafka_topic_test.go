@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func init() {
@@ -119,7 +120,7 @@ func testAccTencentCloudKafkaTopicDestory(s *terraform.State) error {
 		if r.Type != "tencentcloud_ckafka_topic" {
 			continue
 		}
-		split := strings.Split(r.Primary.ID, FILED_SP)
+		split := helper.IdParse(r.Primary.ID)
 		if len(split) < 2 {
 			continue
 		}
@@ -150,7 +151,7 @@ func testAccCheckKafkaTopicInstanceExists(n string) resource.TestCheckFunc {
 		ckafkcService := CkafkaService{
 			client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn,
 		}
-		split := strings.Split(rs.Primary.ID, FILED_SP)
+		split := helper.IdParse(rs.Primary.ID)
 		if len(split) < 2 {
 			return fmt.Errorf("ckafka topic is not set: %s", rs.Primary.ID)
 		}
@@ -25,20 +25,20 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCkafkaUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCkafkaUserCreate,
-		Read:   resourceTencentCloudCkafkaUserRead,
-		Update: resourceTencentCloudCkafkaUserUpdate,
-		Delete: resourceTencentCloudCkafkaUserDelete,
+		CreateContext: resourceTencentCloudCkafkaUserCreate,
+		ReadContext:   resourceTencentCloudCkafkaUserRead,
+		UpdateContext: resourceTencentCloudCkafkaUserUpdate,
+		DeleteContext: resourceTencentCloudCkafkaUserDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -75,11 +75,11 @@ func resourceTencentCloudCkafkaUser() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCkafkaUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_user.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Get("instance_id").(string)
 	accountName := d.Get("account_name").(string)
@@ -89,19 +89,19 @@ func resourceTencentCloudCkafkaUserCreate(d *schema.ResourceData, meta interface
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	if err := ckafkaService.CreateUser(ctx, instanceId, accountName, password); err != nil {
-		return fmt.Errorf("[CRITAL]%s create ckafka user failed, reason:%+v", logId, err)
+		return diag.Errorf("[CRITAL]%s create ckafka user failed, reason:%+v", logId, err)
 	}
-	d.SetId(instanceId + FILED_SP + accountName)
+	d.SetId(helper.IdFormat(instanceId, accountName))
 
-	return resourceTencentCloudCkafkaUserRead(d, meta)
+	return resourceTencentCloudCkafkaUserRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCkafkaUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_user.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	ckafkaService := CkafkaService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -109,13 +109,13 @@ func resourceTencentCloudCkafkaUserRead(d *schema.ResourceData, meta interface{}
 	id := d.Id()
 	info, has, err := ckafkaService.DescribeUserByUserId(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
 		return nil
 	}
-	items := strings.Split(id, FILED_SP)
+	items := helper.IdParse(id)
 	_ = d.Set("instance_id", items[0])
 	_ = d.Set("account_name", info.Name)
 	_ = d.Set("create_time", info.CreateTime)
@@ -124,11 +124,11 @@ func resourceTencentCloudCkafkaUserRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudCkafkaUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_user.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	ckafkaService := CkafkaService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -138,25 +138,25 @@ func resourceTencentCloudCkafkaUserUpdate(d *schema.ResourceData, meta interface
 	if d.HasChange("password") {
 		old, new := d.GetChange("password")
 		if err := ckafkaService.ModifyPassword(ctx, instanceId, user, old.(string), new.(string)); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
-	return resourceTencentCloudCkafkaUserRead(d, meta)
+	return resourceTencentCloudCkafkaUserRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCkafkaUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCkafkaUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ckafka_user.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	ckafkaService := CkafkaService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
 	if err := ckafkaService.DeleteUser(ctx, d.Id()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
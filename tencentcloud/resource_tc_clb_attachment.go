@@ -29,10 +29,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -44,12 +44,16 @@ import (
 
 func resourceTencentCloudClbServerAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbServerAttachmentCreate,
-		Read:   resourceTencentCloudClbServerAttachmentRead,
-		Delete: resourceTencentCloudClbServerAttachmentDelete,
-		Update: resourceTencentCloudClbServerAttachmentUpdate,
+		CreateContext: resourceTencentCloudClbServerAttachmentCreate,
+		ReadContext:   resourceTencentCloudClbServerAttachmentRead,
+		DeleteContext: resourceTencentCloudClbServerAttachmentDelete,
+		UpdateContext: resourceTencentCloudClbServerAttachmentUpdate,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"clb_id": {
@@ -113,7 +117,7 @@ func resourceTencentCloudClbServerAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClbServerAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbServerAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_attachment.create")()
 
 	clbActionMu.Lock()
@@ -123,7 +127,7 @@ func resourceTencentCloudClbServerAttachmentCreate(d *schema.ResourceData, meta
 	listenerId := d.Get("listener_id").(string)
 	checkErr := ListenerIdCheck(listenerId)
 	if checkErr != nil {
-		return checkErr
+		return diag.FromErr(checkErr)
 	}
 	clbId := d.Get("clb_id").(string)
 	locationId := ""
@@ -134,7 +138,7 @@ func resourceTencentCloudClbServerAttachmentCreate(d *schema.ResourceData, meta
 		locationId = v.(string)
 		checkErr := RuleIdCheck(locationId)
 		if checkErr != nil {
-			return checkErr
+			return diag.FromErr(checkErr)
 		}
 		if locationId != "" {
 			request.LocationId = helper.String(locationId)
@@ -155,17 +159,19 @@ func resourceTencentCloudClbServerAttachmentCreate(d *schema.ResourceData, meta
 			request.Targets = append(request.Targets, clbNewTarget(inst["instance_id"], inst["eni_ip"], inst["port"], inst["weight"]))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			requestId := ""
-			ratelimit.Check(request.GetAction())
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().RegisterTargets(request)
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().RegisterTargetsWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]",
 					logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
 				requestId = *result.Response.RequestId
-				retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+				retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 				if retryErr != nil {
 					return resource.NonRetryableError(errors.WithStack(retryErr))
 				}
@@ -174,30 +180,29 @@ func resourceTencentCloudClbServerAttachmentCreate(d *schema.ResourceData, meta
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s create CLB attachment failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	id := fmt.Sprintf("%s#%v#%v", locationId, d.Get("listener_id"), d.Get("clb_id"))
-	d.SetId(id)
+	d.SetId(helper.IdFormat(locationId, d.Get("listener_id").(string), d.Get("clb_id").(string)))
 
-	return resourceTencentCloudClbServerAttachmentRead(d, meta)
+	return resourceTencentCloudClbServerAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbServerAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbServerAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_attachment.delete")()
 
 	clbActionMu.Lock()
 	defer clbActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	clbService := ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	attachmentId := d.Id()
 
-	items := strings.Split(attachmentId, "#")
-	if len(items) < 3 {
-		return fmt.Errorf("[CHECK][CLB attachment][Delete] check: id %s of resource.tencentcloud_clb_attachment is not match loc-xxx#lbl-xxx#lb-xxx", attachmentId)
+	items, err := helper.IdParseN(attachmentId, 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	locationId := items[0]
@@ -228,10 +233,12 @@ func resourceTencentCloudClbServerAttachmentDelete(d *schema.ResourceData, meta
 			request.Targets = append(request.Targets, clbNewTarget(inst["instance_id"], inst["eni_ip"], inst["port"], inst["weight"]))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			requestId := ""
-			ratelimit.Check(request.GetAction())
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().DeregisterTargets(request)
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().DeregisterTargetsWithContext(ctx, request)
 			if e != nil {
 
 				ee, ok := e.(*sdkErrors.TencentCloudSDKError)
@@ -244,7 +251,7 @@ func resourceTencentCloudClbServerAttachmentDelete(d *schema.ResourceData, meta
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]",
 					logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
 				requestId = *result.Response.RequestId
-				retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+				retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 				if retryErr != nil {
 					return resource.NonRetryableError(errors.WithStack(retryErr))
 				}
@@ -253,22 +260,22 @@ func resourceTencentCloudClbServerAttachmentDelete(d *schema.ResourceData, meta
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s create CLB attachment failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceTencentCloudClbServerAttachmentRemove(d *schema.ResourceData, meta interface{}, remove []interface{}) error {
+func resourceTencentCloudClbServerAttachmentRemove(ctx context.Context, d *schema.ResourceData, meta interface{}, remove []interface{}) error {
 	defer logElapsed("resource.tencentcloud_clb_attachment.remove")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	attachmentId := d.Id()
-	items := strings.Split(attachmentId, "#")
-	if len(items) < 3 {
-		return fmt.Errorf("[CHECK][CLB attachment][Remove] check: id %s of resource.tencentcloud_clb_attachment is not match loc-xxx#lbl-xxx#lb-xxx", attachmentId)
+	items, err := helper.IdParseN(attachmentId, 3)
+	if err != nil {
+		return err
 	}
 	locationId := items[0]
 	listenerId := items[1]
@@ -285,7 +292,7 @@ func resourceTencentCloudClbServerAttachmentRemove(d *schema.ResourceData, meta
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		removeCandidates := getRemoveCandidates(ctx, clbService, clbId, listenerId, locationId, remove)
 		if len(removeCandidates) == 0 {
 			return nil
@@ -305,7 +312,7 @@ func resourceTencentCloudClbServerAttachmentRemove(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudClbServerAttachmentAdd(d *schema.ResourceData, meta interface{}, add []interface{}) error {
+func resourceTencentCloudClbServerAttachmentAdd(ctx context.Context, d *schema.ResourceData, meta interface{}, add []interface{}) error {
 	defer logElapsed("resource.tencentcloud_clb_attachment.add")()
 	logId := getLogId(contextNil)
 
@@ -327,16 +334,16 @@ func resourceTencentCloudClbServerAttachmentAdd(d *schema.ResourceData, meta int
 		inst := v.(map[string]interface{})
 		request.Targets = append(request.Targets, clbNewTarget(inst["instance_id"], inst["eni_ip"], inst["port"], inst["weight"]))
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		requestId := ""
-		response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().RegisterTargets(request)
+		response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().RegisterTargetsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 			requestId = *response.Response.RequestId
-			retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+			retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 			if retryErr != nil {
 				return resource.NonRetryableError(errors.WithStack(retryErr))
 			}
@@ -350,7 +357,7 @@ func resourceTencentCloudClbServerAttachmentAdd(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudClbServerAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbServerAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_attachment.update")()
 
 	clbActionMu.Lock()
@@ -374,9 +381,9 @@ func resourceTencentCloudClbServerAttachmentUpdate(d *schema.ResourceData, meta
 					}
 					removeList = append(removeList, remove[index])
 				}
-				err := resourceTencentCloudClbServerAttachmentRemove(d, meta, removeList)
+				err := resourceTencentCloudClbServerAttachmentRemove(ctx, d, meta, removeList)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -390,26 +397,29 @@ func resourceTencentCloudClbServerAttachmentUpdate(d *schema.ResourceData, meta
 					}
 					addList = append(addList, add[index])
 				}
-				err := resourceTencentCloudClbServerAttachmentAdd(d, meta, addList)
+				err := resourceTencentCloudClbServerAttachmentAdd(ctx, d, meta, addList)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
-		return resourceTencentCloudClbServerAttachmentRead(d, meta)
+		return resourceTencentCloudClbServerAttachmentRead(ctx, d, meta)
 	}
 
 	return nil
 }
 
-func resourceTencentCloudClbServerAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbServerAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), "#")
+	items, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	locationId := items[0]
 	listenerId := items[1]
 	clbId := items[2]
@@ -418,7 +428,7 @@ func resourceTencentCloudClbServerAttachmentRead(d *schema.ResourceData, meta in
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var instance *clb.ListenerBackend
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := clbService.DescribeAttachmentByPara(ctx, clbId, listenerId, locationId)
 		if e != nil {
 			return retryError(e)
@@ -428,7 +438,7 @@ func resourceTencentCloudClbServerAttachmentRead(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CLB attachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	//see if read empty
 
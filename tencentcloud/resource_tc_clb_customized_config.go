@@ -26,7 +26,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -36,12 +38,17 @@ import (
 
 func resourceTencentCloudClbCustomizedConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbCustomizedConfigCreate,
-		Read:   resourceTencentCloudClbCustomizedConfigRead,
-		Update: resourceTencentCloudClbCustomizedConfigUpdate,
-		Delete: resourceTencentCloudClbCustomizedConfigDelete,
+		CreateContext: resourceTencentCloudClbCustomizedConfigCreate,
+		ReadContext:   resourceTencentCloudClbCustomizedConfigRead,
+		UpdateContext: resourceTencentCloudClbCustomizedConfigUpdate,
+		DeleteContext: resourceTencentCloudClbCustomizedConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -76,10 +83,10 @@ func resourceTencentCloudClbCustomizedConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClbCustomizedConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbCustomizedConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_customized_config.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	configName := d.Get("config_name").(string)
 	configContent := d.Get("config_content").(string)
@@ -90,15 +97,15 @@ func resourceTencentCloudClbCustomizedConfigCreate(d *schema.ResourceData, meta
 	request.ConfigContent = helper.String(configContent)
 
 	var response *clb.SetCustomizedConfigForLoadBalancerResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().SetCustomizedConfigForLoadBalancer(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().SetCustomizedConfigForLoadBalancerWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
 			requestId := *result.Response.RequestId
-			retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+			retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 			if retryErr != nil {
 				return retryError(errors.WithStack(retryErr))
 			}
@@ -108,7 +115,7 @@ func resourceTencentCloudClbCustomizedConfigCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s Create CLB Customized Config Failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(*response.Response.ConfigId)
 
@@ -121,25 +128,25 @@ func resourceTencentCloudClbCustomizedConfigCreate(d *schema.ResourceData, meta
 			"BIND", *response.Response.ConfigId, loadBalancerIds)
 		if err != nil {
 			log.Printf("[CRITAL]%s Binding LB Customized Config Failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudClbCustomizedConfigRead(d, meta)
+	return resourceTencentCloudClbCustomizedConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbCustomizedConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbCustomizedConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_customized_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	configId := d.Id()
 	clbService := ClbService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var config *clb.ConfigListItem
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := clbService.DescribeLbCustomizedConfigById(ctx, configId)
 		if e != nil {
 			return retryError(e)
@@ -149,7 +156,7 @@ func resourceTencentCloudClbCustomizedConfigRead(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CLB customized config failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	if config == nil {
 		d.SetId("")
@@ -164,8 +171,8 @@ func resourceTencentCloudClbCustomizedConfigRead(d *schema.ResourceData, meta in
 	request := clb.NewDescribeCustomizedConfigAssociateListRequest()
 	request.UconfigId = &configId
 	var response *clb.DescribeCustomizedConfigAssociateListResponse
-	assErr := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().DescribeCustomizedConfigAssociateList(request)
+	assErr := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().DescribeCustomizedConfigAssociateListWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -177,14 +184,14 @@ func resourceTencentCloudClbCustomizedConfigRead(d *schema.ResourceData, meta in
 	})
 	if assErr != nil {
 		log.Printf("[CRITAL]%s Describe CLB Customized Config Associate List Failed, reason:%+v", logId, assErr)
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("load_balancer_ids", extractBindClbList(response.Response.BindList))
 
 	return nil
 }
 
-func resourceTencentCloudClbCustomizedConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbCustomizedConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_customized_config.update")()
 
 	logId := getLogId(contextNil)
@@ -206,15 +213,15 @@ func resourceTencentCloudClbCustomizedConfigUpdate(d *schema.ResourceData, meta
 		request.ConfigContent = &configContent
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().SetCustomizedConfigForLoadBalancer(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().SetCustomizedConfigForLoadBalancerWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
 			requestId := *result.Response.RequestId
-			retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+			retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 			if retryErr != nil {
 				return retryError(errors.WithStack(retryErr))
 			}
@@ -223,11 +230,11 @@ func resourceTencentCloudClbCustomizedConfigUpdate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s Update CLB Customized Config Failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("load_balancer_ids") {
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		clbService := ClbService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
 		}
@@ -241,7 +248,7 @@ func resourceTencentCloudClbCustomizedConfigUpdate(d *schema.ResourceData, meta
 				"UNBIND", configId, remove)
 			if err != nil {
 				log.Printf("[CRITAL]%s UnBinding LB Customized Config Failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		if len(add) > 0 {
@@ -249,25 +256,25 @@ func resourceTencentCloudClbCustomizedConfigUpdate(d *schema.ResourceData, meta
 				"BIND", configId, add)
 			if err != nil {
 				log.Printf("[CRITAL]%s Binding LB Customized Config Failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 	return nil
 }
 
-func resourceTencentCloudClbCustomizedConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbCustomizedConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_customized_config.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	configId := d.Id()
 	clbService := ClbService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := clbService.DeleteLbCustomizedConfigById(ctx, configId)
 		if e != nil {
 			return retryError(e)
@@ -276,7 +283,7 @@ func resourceTencentCloudClbCustomizedConfigDelete(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete CLB customized config failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
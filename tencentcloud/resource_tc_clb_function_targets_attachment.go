@@ -35,10 +35,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -48,12 +48,17 @@ import (
 
 func resourceTencentCloudClbFunctionTargetsAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbFunctionTargetsAttachmentCreate,
-		Read:   resourceTencentCloudClbFunctionTargetsAttachmentRead,
-		Update: resourceTencentCloudClbFunctionTargetsAttachmentUpdate,
-		Delete: resourceTencentCloudClbFunctionTargetsAttachmentDelete,
+		CreateContext: resourceTencentCloudClbFunctionTargetsAttachmentCreate,
+		ReadContext:   resourceTencentCloudClbFunctionTargetsAttachmentRead,
+		UpdateContext: resourceTencentCloudClbFunctionTargetsAttachmentUpdate,
+		DeleteContext: resourceTencentCloudClbFunctionTargetsAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -147,7 +152,7 @@ func resourceTencentCloudClbFunctionTargetsAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClbFunctionTargetsAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbFunctionTargetsAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_function_targets_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -213,14 +218,14 @@ func resourceTencentCloudClbFunctionTargetsAttachmentCreate(d *schema.ResourceDa
 		request.Url = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().RegisterFunctionTargets(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().RegisterFunctionTargetsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
 			requestId := *result.Response.RequestId
-			retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+			retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 			if retryErr != nil {
 				return resource.NonRetryableError(errors.WithStack(retryErr))
 			}
@@ -229,29 +234,29 @@ func resourceTencentCloudClbFunctionTargetsAttachmentCreate(d *schema.ResourceDa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create clb functionTargetsAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if locationId != "" {
-		d.SetId(loadBalancerId + FILED_SP + listenerId + FILED_SP + locationId)
+		d.SetId(helper.IdFormat(loadBalancerId, listenerId, locationId))
 	} else {
-		d.SetId(loadBalancerId + FILED_SP + listenerId + FILED_SP + domain + FILED_SP + url)
+		d.SetId(helper.IdFormat(loadBalancerId, listenerId, domain, url))
 	}
 
-	return resourceTencentCloudClbFunctionTargetsAttachmentRead(d, meta)
+	return resourceTencentCloudClbFunctionTargetsAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbFunctionTargetsAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbFunctionTargetsAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_function_targets_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 
 	var (
 		loadBalancerId string
@@ -270,12 +275,12 @@ func resourceTencentCloudClbFunctionTargetsAttachmentRead(d *schema.ResourceData
 		domain = idSplit[2]
 		url = idSplit[3]
 	} else {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 
 	functionTargets, locationId, domain, url, err := service.DescribeClbFunctionTargetsAttachmentById(ctx, loadBalancerId, listenerId, locationId, domain, url)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if functionTargets == nil {
@@ -341,13 +346,13 @@ func resourceTencentCloudClbFunctionTargetsAttachmentRead(d *schema.ResourceData
 	return nil
 }
 
-func resourceTencentCloudClbFunctionTargetsAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbFunctionTargetsAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_function_targets_attachment.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	var (
 		request        = clb.NewModifyFunctionTargetsRequest()
 		loadBalancerId string
@@ -367,7 +372,7 @@ func resourceTencentCloudClbFunctionTargetsAttachmentUpdate(d *schema.ResourceDa
 		domain = idSplit[2]
 		url = idSplit[3]
 	} else {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 
 	request.LoadBalancerId = helper.String(loadBalancerId)
@@ -410,14 +415,14 @@ func resourceTencentCloudClbFunctionTargetsAttachmentUpdate(d *schema.ResourceDa
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyFunctionTargets(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyFunctionTargetsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
 			requestId := *result.Response.RequestId
-			retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+			retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 			if retryErr != nil {
 				return resource.NonRetryableError(errors.WithStack(retryErr))
 			}
@@ -426,19 +431,19 @@ func resourceTencentCloudClbFunctionTargetsAttachmentUpdate(d *schema.ResourceDa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update clb functionTargetsAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudClbFunctionTargetsAttachmentRead(d, meta)
+	return resourceTencentCloudClbFunctionTargetsAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbFunctionTargetsAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbFunctionTargetsAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_function_targets_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	var (
 		request        = clb.NewDeregisterFunctionTargetsRequest()
 		loadBalancerId string
@@ -458,7 +463,7 @@ func resourceTencentCloudClbFunctionTargetsAttachmentDelete(d *schema.ResourceDa
 		domain = idSplit[2]
 		url = idSplit[3]
 	} else {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 
 	request.LoadBalancerId = helper.String(loadBalancerId)
@@ -498,14 +503,14 @@ func resourceTencentCloudClbFunctionTargetsAttachmentDelete(d *schema.ResourceDa
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().DeregisterFunctionTargets(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().DeregisterFunctionTargetsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
 			requestId := *result.Response.RequestId
-			retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+			retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 			if retryErr != nil {
 				return resource.NonRetryableError(errors.WithStack(retryErr))
 			}
@@ -514,7 +519,7 @@ func resourceTencentCloudClbFunctionTargetsAttachmentDelete(d *schema.ResourceDa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create clb functionTargetsAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
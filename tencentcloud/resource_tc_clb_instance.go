@@ -181,10 +181,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -196,12 +197,17 @@ var clbActionMu = &sync.Mutex{}
 
 func resourceTencentCloudClbInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbInstanceCreate,
-		Read:   resourceTencentCloudClbInstanceRead,
-		Update: resourceTencentCloudClbInstanceUpdate,
-		Delete: resourceTencentCloudClbInstanceDelete,
+		CreateContext: resourceTencentCloudClbInstanceCreate,
+		ReadContext:   resourceTencentCloudClbInstanceRead,
+		UpdateContext: resourceTencentCloudClbInstanceUpdate,
+		DeleteContext: resourceTencentCloudClbInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -370,7 +376,7 @@ func resourceTencentCloudClbInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_instance.create")()
 
 	clbActionMu.Lock()
@@ -380,29 +386,29 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 
 	networkType := d.Get("network_type").(string)
 	clbName := d.Get("clb_name").(string)
-	flag, e := checkSameName(clbName, meta)
+	flag, e := checkSameName(ctx, clbName, meta)
 	if e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 	if flag {
-		return fmt.Errorf("[CHECK][CLB instance][Create] check: Same CLB name %s exists!", clbName)
+		return diag.Errorf("[CHECK][CLB instance][Create] check: Same CLB name %s exists!", clbName)
 	}
 	targetRegionInfoRegion := ""
 	targetRegionInfoVpcId := ""
 	if v, ok := d.GetOk("target_region_info_region"); ok {
 		targetRegionInfoRegion = v.(string)
 		if networkType == CLB_NETWORK_TYPE_INTERNAL {
-			return fmt.Errorf("[CHECK][CLB instance][Create] check: INTERNAL network_type do not support this operation with target_region_info")
+			return diag.Errorf("[CHECK][CLB instance][Create] check: INTERNAL network_type do not support this operation with target_region_info")
 		}
 	}
 	if v, ok := d.GetOk("target_region_info_vpc_id"); ok {
 		targetRegionInfoVpcId = v.(string)
 		if networkType == CLB_NETWORK_TYPE_INTERNAL {
-			return fmt.Errorf("[CHECK][CLB instance][Create] check: INTERNAL network_type do not support this operation with target_region_info")
+			return diag.Errorf("[CHECK][CLB instance][Create] check: INTERNAL network_type do not support this operation with target_region_info")
 		}
 	}
 	if (targetRegionInfoRegion != "" && targetRegionInfoVpcId == "") || (targetRegionInfoRegion == "" && targetRegionInfoVpcId != "") {
-		return fmt.Errorf("[CHECK][CLB instance][Create] check: region and vpc_id must be set at same time")
+		return diag.Errorf("[CHECK][CLB instance][Create] check: region and vpc_id must be set at same time")
 	}
 
 	request := clb.NewCreateLoadBalancerRequest()
@@ -417,7 +423,7 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 	}
 	if v, ok := d.GetOk("subnet_id"); ok {
 		if networkType == CLB_NETWORK_TYPE_OPEN {
-			return fmt.Errorf("[CHECK][CLB instance][Create] check: OPEN network_type do not support this operation with subnet_id")
+			return diag.Errorf("[CHECK][CLB instance][Create] check: OPEN network_type do not support this operation with subnet_id")
 		}
 		request.SubnetId = helper.String(v.(string))
 	}
@@ -425,7 +431,7 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 	//vip
 	if v, ok := d.GetOk("vip_isp"); ok {
 		if networkType == CLB_NETWORK_TYPE_INTERNAL {
-			return fmt.Errorf("[CHECK][CLB instance][Create] check: INTERNAL network_type do not support vip ISP setting")
+			return diag.Errorf("[CHECK][CLB instance][Create] check: INTERNAL network_type do not support vip ISP setting")
 		}
 		request.VipIsp = helper.String(v.(string))
 	}
@@ -433,7 +439,7 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 	//ip version
 	if v, ok := d.GetOk("address_ip_version"); ok {
 		if networkType == CLB_NETWORK_TYPE_INTERNAL {
-			return fmt.Errorf("[CHECK][CLB instance][Create] check: INTERNAL network_type do not support IP version setting")
+			return diag.Errorf("[CHECK][CLB instance][Create] check: INTERNAL network_type do not support IP version setting")
 		}
 		request.AddressIPVersion = helper.String(v.(string))
 	}
@@ -465,7 +471,7 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 	//internet charge type
 	if ok || bok {
 		if networkType == CLB_NETWORK_TYPE_INTERNAL {
-			return fmt.Errorf("[CHECK][CLB instance][Create] check: INTERNAL network_type do not support internet charge type setting")
+			return diag.Errorf("[CHECK][CLB instance][Create] check: INTERNAL network_type do not support internet charge type setting")
 		}
 		request.InternetAccessible = &clb.InternetAccessible{}
 		if ok {
@@ -476,31 +482,31 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 		}
 		if pok {
 			if pok && chargeType != INTERNET_CHARGE_TYPE_BANDWIDTH_PACKAGE {
-				return fmt.Errorf("[CHECK][CLB instance][Create] check: internet_charge_type must `BANDWIDTH_PACKAGE` when bandwidth_package_id was set")
+				return diag.Errorf("[CHECK][CLB instance][Create] check: internet_charge_type must `BANDWIDTH_PACKAGE` when bandwidth_package_id was set")
 			}
 			request.BandwidthPackageId = helper.String(pv.(string))
 		} else if chargeType == INTERNET_CHARGE_TYPE_BANDWIDTH_PACKAGE {
-			return fmt.Errorf("[CHECK][CLB instance][Create] check: the `bandwidth_package_id` must be specified if internet_charge_type was `BANDWIDTH_PACKAGE`")
+			return diag.Errorf("[CHECK][CLB instance][Create] check: the `bandwidth_package_id` must be specified if internet_charge_type was `BANDWIDTH_PACKAGE`")
 		}
 	}
 
 	if v, ok := d.GetOk("master_zone_id"); ok {
 		if networkType == CLB_NETWORK_TYPE_INTERNAL {
-			return fmt.Errorf("[CHECK][CLB instance][Create] check: INTERNAL network_type do not support master zone id setting")
+			return diag.Errorf("[CHECK][CLB instance][Create] check: INTERNAL network_type do not support master zone id setting")
 		}
 		request.MasterZoneId = helper.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("zone_id"); ok {
 		if networkType == CLB_NETWORK_TYPE_INTERNAL {
-			return fmt.Errorf("[CHECK][CLB instance][Create] check: INTERNAL network_type do not support zone id setting")
+			return diag.Errorf("[CHECK][CLB instance][Create] check: INTERNAL network_type do not support zone id setting")
 		}
 		request.ZoneId = helper.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("slave_zone_id"); ok {
 		if networkType == CLB_NETWORK_TYPE_INTERNAL {
-			return fmt.Errorf("[CHECK][CLB instance][Create] check: INTERNAL network_type do not support slave zone id setting")
+			return diag.Errorf("[CHECK][CLB instance][Create] check: INTERNAL network_type do not support slave zone id setting")
 		}
 		request.SlaveZoneId = helper.String(v.(string))
 	}
@@ -526,15 +532,15 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 
 	clbId := ""
 	var response *clb.CreateLoadBalancerResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().CreateLoadBalancer(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().CreateLoadBalancerWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
 			requestId := *result.Response.RequestId
-			retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+			retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 			if retryErr != nil {
 				return retryError(errors.WithStack(retryErr))
 			}
@@ -544,10 +550,10 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create CLB instance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	if len(response.Response.LoadBalancerIds) < 1 {
-		return fmt.Errorf("[CHECK][CLB instance][Create] check: response error, load balancer id is nil")
+		return diag.Errorf("[CHECK][CLB instance][Create] check: response error, load balancer id is nil")
 	}
 	d.SetId(*response.Response.LoadBalancerIds[0])
 	clbId = *response.Response.LoadBalancerIds[0]
@@ -563,8 +569,8 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 				sgRequest.SecurityGroups = append(sgRequest.SecurityGroups, &securityGroup)
 			}
 		}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			sgResponse, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().SetLoadBalancerSecurityGroups(sgRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			sgResponse, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().SetLoadBalancerSecurityGroupsWithContext(ctx, sgRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -572,7 +578,7 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 					logId, sgRequest.GetAction(), sgRequest.ToJsonString(), sgResponse.ToJsonString())
 				requestId := *sgResponse.Response.RequestId
 
-				retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+				retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 				if retryErr != nil {
 					return retryError(errors.WithStack(retryErr))
 				}
@@ -581,7 +587,7 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s create CLB instance security_groups failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -591,8 +597,8 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 			logRequest.LoadBalancerId = helper.String(clbId)
 			logRequest.LogSetId = helper.String(v.(string))
 			logRequest.LogTopicId = helper.String(u.(string))
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				logResponse, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().SetLoadBalancerClsLog(logRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+				logResponse, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().SetLoadBalancerClsLogWithContext(ctx, logRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -600,7 +606,7 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 						logId, logRequest.GetAction(), logRequest.ToJsonString(), logResponse.ToJsonString())
 					requestId := *logResponse.Response.RequestId
 
-					retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+					retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 					if retryErr != nil {
 						return retryError(errors.WithStack(retryErr))
 					}
@@ -609,11 +615,11 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s set CLB instance log failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 
 		} else {
-			return fmt.Errorf("log_topic_id and log_set_id must be set together.")
+			return diag.Errorf("log_topic_id and log_set_id must be set together.")
 		}
 	}
 
@@ -627,15 +633,15 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 		mRequest.LoadBalancerId = helper.String(clbId)
 		mRequest.TargetRegionInfo = &targetRegionInfo
 		mRequest.LoadBalancerPassToTarget = &isLoadBalancePassToTgt
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			mResponse, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyLoadBalancerAttributes(mRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			mResponse, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyLoadBalancerAttributesWithContext(ctx, mRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 					logId, mRequest.GetAction(), mRequest.ToJsonString(), mResponse.ToJsonString())
 				requestId := *mResponse.Response.RequestId
-				retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+				retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 				if retryErr != nil {
 					return retryError(errors.WithStack(retryErr))
 				}
@@ -644,7 +650,7 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s create CLB instance failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if v, ok := d.GetOkExists("delete_protect"); ok {
@@ -653,15 +659,15 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 			mRequest := clb.NewModifyLoadBalancerAttributesRequest()
 			mRequest.LoadBalancerId = helper.String(clbId)
 			mRequest.DeleteProtect = &isDeleteProect
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				mResponse, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyLoadBalancerAttributes(mRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+				mResponse, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyLoadBalancerAttributesWithContext(ctx, mRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
 					log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 						logId, mRequest.GetAction(), mRequest.ToJsonString(), mResponse.ToJsonString())
 					requestId := *mResponse.Response.RequestId
-					retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+					retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 					if retryErr != nil {
 						return retryError(errors.WithStack(retryErr))
 					}
@@ -670,26 +676,26 @@ func resourceTencentCloudClbInstanceCreate(d *schema.ResourceData, meta interfac
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s create CLB instance failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
-	return resourceTencentCloudClbInstanceRead(d, meta)
+	return resourceTencentCloudClbInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	clbId := d.Id()
 	clbService := ClbService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var instance *clb.LoadBalancer
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := clbService.DescribeLoadBalancerById(ctx, clbId)
 		if e != nil {
 			return retryError(e)
@@ -699,7 +705,7 @@ func resourceTencentCloudClbInstanceRead(d *schema.ResourceData, meta interface{
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CLB instance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -744,21 +750,21 @@ func resourceTencentCloudClbInstanceRead(d *schema.ResourceData, meta interface{
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "clb", "clb", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("tags", tags)
 	return nil
 }
 
-func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_instance.update")()
 
 	clbActionMu.Lock()
 	defer clbActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	d.Partial(true)
 
@@ -776,19 +782,19 @@ func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interfac
 	if d.HasChange("clb_name") {
 		changed = true
 		clbName = d.Get("clb_name").(string)
-		flag, err := checkSameName(clbName, meta)
+		flag, err := checkSameName(ctx, clbName, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if flag {
-			return fmt.Errorf("[CHECK][CLB instance][Update] check: Same CLB name %s exists!", clbName)
+			return diag.Errorf("[CHECK][CLB instance][Update] check: Same CLB name %s exists!", clbName)
 		}
 		request.LoadBalancerName = helper.String(clbName)
 	}
 
 	if d.HasChange("target_region_info_region") || d.HasChange("target_region_info_vpc_id") {
 		if d.Get("network_type") == CLB_NETWORK_TYPE_INTERNAL {
-			return fmt.Errorf("[CHECK][CLB instance %s][Update] check: INTERNAL network_type do not support this operation with target_region_info", clbId)
+			return diag.Errorf("[CHECK][CLB instance %s][Update] check: INTERNAL network_type do not support this operation with target_region_info", clbId)
 		}
 		changed = true
 		region := d.Get("target_region_info_region").(string)
@@ -802,7 +808,7 @@ func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interfac
 
 	if d.HasChange("internet_charge_type") || d.HasChange("internet_bandwidth_max_out") {
 		if d.Get("network_type") == CLB_NETWORK_TYPE_INTERNAL {
-			return fmt.Errorf("[CHECK][CLB instance %s][Update] check: INTERNAL network_type do not support this operation with internet setting", clbId)
+			return diag.Errorf("[CHECK][CLB instance %s][Update] check: INTERNAL network_type do not support this operation with internet setting", clbId)
 		}
 		changed = true
 		chargeType := d.Get("internet_charge_type").(string)
@@ -836,20 +842,20 @@ func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interfac
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
 	if changed {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyLoadBalancerAttributes(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyLoadBalancerAttributesWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 				requestId := *response.Response.RequestId
-				retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+				retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 				if retryErr != nil {
 					return retryError(retryErr)
 				}
@@ -858,7 +864,7 @@ func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interfac
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update CLB instance failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -872,15 +878,15 @@ func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interfac
 			securityGroup := securityGroups[i].(string)
 			sgRequest.SecurityGroups = append(sgRequest.SecurityGroups, &securityGroup)
 		}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			sgResponse, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().SetLoadBalancerSecurityGroups(sgRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			sgResponse, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().SetLoadBalancerSecurityGroupsWithContext(ctx, sgRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 					logId, sgRequest.GetAction(), sgRequest.ToJsonString(), sgResponse.ToJsonString())
 				requestId := *sgResponse.Response.RequestId
-				retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+				retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 				if retryErr != nil {
 					return retryError(errors.WithStack(retryErr))
 				}
@@ -889,7 +895,7 @@ func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interfac
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update CLB instance security_group failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -901,8 +907,8 @@ func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interfac
 		logRequest.LoadBalancerId = helper.String(clbId)
 		logRequest.LogSetId = helper.String(logSetId.(string))
 		logRequest.LogTopicId = helper.String(logTopicId.(string))
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			logResponse, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().SetLoadBalancerClsLog(logRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			logResponse, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().SetLoadBalancerClsLogWithContext(ctx, logRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -910,7 +916,7 @@ func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interfac
 					logId, logRequest.GetAction(), logRequest.ToJsonString(), logResponse.ToJsonString())
 				requestId := *logResponse.Response.RequestId
 
-				retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+				retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 				if retryErr != nil {
 					return retryError(errors.WithStack(retryErr))
 				}
@@ -919,7 +925,7 @@ func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interfac
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s set CLB instance log failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -933,7 +939,7 @@ func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interfac
 		resourceName := BuildTagResourceName("clb", "clb", tcClient.Region, d.Id())
 		err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -942,21 +948,21 @@ func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudClbInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_instance.delete")()
 
 	clbActionMu.Lock()
 	defer clbActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	clbId := d.Id()
 	clbService := ClbService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := clbService.DeleteLoadBalancerById(ctx, clbId)
 		if e != nil {
 			return retryError(e)
@@ -965,22 +971,22 @@ func resourceTencentCloudClbInstanceDelete(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete CLB instance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func checkSameName(name string, meta interface{}) (flag bool, errRet error) {
+func checkSameName(ctx context.Context, name string, meta interface{}) (flag bool, errRet error) {
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	flag = false
 	clbService := ClbService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	params := make(map[string]interface{})
 	params["clb_name"] = name
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		clbs, e := clbService.DescribeLoadBalancerByFilter(ctx, params)
 		if e != nil {
 			return retryError(e)
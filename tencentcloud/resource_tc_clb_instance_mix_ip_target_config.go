@@ -23,7 +23,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -32,12 +34,15 @@ import (
 
 func resourceTencentCloudClbInstanceMixIpTargetConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbInstanceMixIpTargetConfigCreate,
-		Read:   resourceTencentCloudClbInstanceMixIpTargetConfigRead,
-		Update: resourceTencentCloudClbInstanceMixIpTargetConfigUpdate,
-		Delete: resourceTencentCloudClbInstanceMixIpTargetConfigDelete,
+		CreateContext: resourceTencentCloudClbInstanceMixIpTargetConfigCreate,
+		ReadContext:   resourceTencentCloudClbInstanceMixIpTargetConfigRead,
+		UpdateContext: resourceTencentCloudClbInstanceMixIpTargetConfigUpdate,
+		DeleteContext: resourceTencentCloudClbInstanceMixIpTargetConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -56,7 +61,7 @@ func resourceTencentCloudClbInstanceMixIpTargetConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClbInstanceMixIpTargetConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbInstanceMixIpTargetConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_instance_mix_ip_target_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -64,16 +69,16 @@ func resourceTencentCloudClbInstanceMixIpTargetConfigCreate(d *schema.ResourceDa
 
 	d.SetId(lbId)
 
-	return resourceTencentCloudClbInstanceMixIpTargetConfigUpdate(d, meta)
+	return resourceTencentCloudClbInstanceMixIpTargetConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudClbInstanceMixIpTargetConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbInstanceMixIpTargetConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_instance_mix_ip_target_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -81,7 +86,7 @@ func resourceTencentCloudClbInstanceMixIpTargetConfigRead(d *schema.ResourceData
 
 	instance, err := service.DescribeLoadBalancerById(ctx, lbId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -101,7 +106,7 @@ func resourceTencentCloudClbInstanceMixIpTargetConfigRead(d *schema.ResourceData
 	return nil
 }
 
-func resourceTencentCloudClbInstanceMixIpTargetConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbInstanceMixIpTargetConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_instance_mix_ip_target_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -118,8 +123,8 @@ func resourceTencentCloudClbInstanceMixIpTargetConfigUpdate(d *schema.ResourceDa
 	}
 
 	var taskId string
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyLoadBalancerMixIpTarget(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyLoadBalancerMixIpTargetWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -130,18 +135,18 @@ func resourceTencentCloudClbInstanceMixIpTargetConfigUpdate(d *schema.ResourceDa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update clb instanceMixIpTargetConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	retryErr := waitForTaskFinish(taskId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+	retryErr := waitForTaskFinish(ctx, taskId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 	if retryErr != nil {
-		return retryErr
+		return diag.FromErr(retryErr)
 	}
 
-	return resourceTencentCloudClbInstanceMixIpTargetConfigRead(d, meta)
+	return resourceTencentCloudClbInstanceMixIpTargetConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbInstanceMixIpTargetConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbInstanceMixIpTargetConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_instance_mix_ip_target_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -23,7 +23,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -32,12 +34,15 @@ import (
 
 func resourceTencentCloudClbInstanceSlaConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbInstanceSlaConfigCreate,
-		Read:   resourceTencentCloudClbInstanceSlaConfigRead,
-		Update: resourceTencentCloudClbInstanceSlaConfigUpdate,
-		Delete: resourceTencentCloudClbInstanceSlaConfigDelete,
+		CreateContext: resourceTencentCloudClbInstanceSlaConfigCreate,
+		ReadContext:   resourceTencentCloudClbInstanceSlaConfigRead,
+		UpdateContext: resourceTencentCloudClbInstanceSlaConfigUpdate,
+		DeleteContext: resourceTencentCloudClbInstanceSlaConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -54,23 +59,23 @@ func resourceTencentCloudClbInstanceSlaConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClbInstanceSlaConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbInstanceSlaConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_instance_sla_config.create")()
 	defer inconsistentCheck(d, meta)()
 
 	lbId := d.Get("load_balancer_id").(string)
 	d.SetId(lbId)
 
-	return resourceTencentCloudClbInstanceSlaConfigUpdate(d, meta)
+	return resourceTencentCloudClbInstanceSlaConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudClbInstanceSlaConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbInstanceSlaConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_instance_sla_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -78,7 +83,7 @@ func resourceTencentCloudClbInstanceSlaConfigRead(d *schema.ResourceData, meta i
 
 	instance, err := service.DescribeLoadBalancerById(ctx, lbId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -98,7 +103,7 @@ func resourceTencentCloudClbInstanceSlaConfigRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudClbInstanceSlaConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbInstanceSlaConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_instance_sla_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -115,8 +120,8 @@ func resourceTencentCloudClbInstanceSlaConfigUpdate(d *schema.ResourceData, meta
 	request.LoadBalancerSla = []*clb.SlaUpdateParam{&param}
 
 	var taskId string
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyLoadBalancerSla(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyLoadBalancerSlaWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -127,18 +132,18 @@ func resourceTencentCloudClbInstanceSlaConfigUpdate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update clb instanceSlaConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	retryErr := waitForTaskFinish(taskId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+	retryErr := waitForTaskFinish(ctx, taskId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 	if retryErr != nil {
-		return retryErr
+		return diag.FromErr(retryErr)
 	}
 
-	return resourceTencentCloudClbInstanceSlaConfigRead(d, meta)
+	return resourceTencentCloudClbInstanceSlaConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbInstanceSlaConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbInstanceSlaConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_instance_sla_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
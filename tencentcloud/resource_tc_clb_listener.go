@@ -171,8 +171,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -182,15 +183,26 @@ import (
 
 func resourceTencentCloudClbListener() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbListenerCreate,
-		Read:   resourceTencentCloudClbListenerRead,
-		Update: resourceTencentCloudClbListenerUpdate,
-		Delete: resourceTencentCloudClbListenerDelete,
+		CreateContext: resourceTencentCloudClbListenerCreate,
+		ReadContext:   resourceTencentCloudClbListenerRead,
+		UpdateContext: resourceTencentCloudClbListenerUpdate,
+		DeleteContext: resourceTencentCloudClbListenerDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
 			State: helper.ImportWithDefaultValue(map[string]interface{}{
 				"scheduler": CLB_LISTENER_SCHEDULER_WRR,
 			}),
 		},
+		CustomizeDiff: helper.ComposeCustomizeDiff(
+			helper.RequiredWhen("protocol", CLB_LISTENER_PROTOCOL_TCPSSL, "certificate_id"),
+			helper.RequiredWhen("certificate_ssl_mode", CERT_SSL_MODE_MUT, "certificate_ca_id"),
+			helper.AllowedOnlyWhen("protocol", CLB_LISTENER_PROTOCOL_HTTPS, "sni_switch"),
+			resourceTencentCloudClbListenerSchedulerCustomizeDiff,
+		),
 		Schema: map[string]*schema.Schema{
 			"clb_id": {
 				Type:         schema.TypeString,
@@ -395,14 +407,14 @@ func resourceTencentCloudClbListener() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClbListenerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbListenerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_listener.create")()
 
 	clbActionMu.Lock()
 	defer clbActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	clbId := d.Get("clb_id").(string)
 	listenerName := d.Get("listener_name").(string)
@@ -419,7 +431,7 @@ func resourceTencentCloudClbListenerCreate(d *schema.ResourceData, meta interfac
 
 	healthSetFlag, healthCheck, healthErr := checkHealthCheckPara(ctx, d, protocol, HEALTH_APPLY_TYPE_LISTENER)
 	if healthErr != nil {
-		return healthErr
+		return diag.FromErr(healthErr)
 	}
 	if healthSetFlag {
 		request.HealthCheck = healthCheck
@@ -428,19 +440,19 @@ func resourceTencentCloudClbListenerCreate(d *schema.ResourceData, meta interfac
 	certificateSetFlag, certificateInput, certErr := checkCertificateInputPara(ctx, d, meta)
 
 	if certErr != nil {
-		return certErr
+		return diag.FromErr(certErr)
 	}
 	if certificateSetFlag {
 		request.Certificate = certificateInput
 	} else {
 		if protocol == CLB_LISTENER_PROTOCOL_TCPSSL {
-			return fmt.Errorf("[CHECK][CLB listener][Create] check: certificated need to be set when protocol is TCPSSL")
+			return diag.Errorf("[CHECK][CLB listener][Create] check: certificated need to be set when protocol is TCPSSL")
 		}
 	}
 	scheduler := ""
 	if v, ok := d.GetOk("scheduler"); ok {
 		if v == CLB_LISTENER_SCHEDULER_IP_HASH {
-			return fmt.Errorf("[CHECK][CLB listener][Create] check: Scheduler 'IP_HASH' can only be set with rule of listener HTTP/HTTPS")
+			return diag.Errorf("[CHECK][CLB listener][Create] check: Scheduler 'IP_HASH' can only be set with rule of listener HTTP/HTTPS")
 		}
 		scheduler = v.(string)
 		request.Scheduler = helper.String(scheduler)
@@ -456,17 +468,17 @@ func resourceTencentCloudClbListenerCreate(d *schema.ResourceData, meta interfac
 
 	if v, ok := d.GetOk("session_expire_time"); ok {
 		if !(protocol == CLB_LISTENER_PROTOCOL_TCP || protocol == CLB_LISTENER_PROTOCOL_UDP) {
-			return fmt.Errorf("[CHECK][CLB listener][Create] check: session_expire_time can only be set with protocol TCP/UDP or rule of listener HTTP/HTTPS")
+			return diag.Errorf("[CHECK][CLB listener][Create] check: session_expire_time can only be set with protocol TCP/UDP or rule of listener HTTP/HTTPS")
 		}
 		if scheduler != CLB_LISTENER_SCHEDULER_WRR && scheduler != "" {
-			return fmt.Errorf("[CHECK][CLB listener][Create] check: session_expire_time can only be set when scheduler is WRR ")
+			return diag.Errorf("[CHECK][CLB listener][Create] check: session_expire_time can only be set when scheduler is WRR ")
 		}
 		vv := int64(v.(int))
 		request.SessionExpireTime = &vv
 	}
 	if v, ok := d.GetOkExists("sni_switch"); ok {
 		if protocol != CLB_LISTENER_PROTOCOL_HTTPS {
-			return fmt.Errorf("[CHECK][CLB listener][Create] check: sni_switch can only be set with protocol HTTPS ")
+			return diag.Errorf("[CHECK][CLB listener][Create] check: sni_switch can only be set with protocol HTTPS ")
 		} else {
 			vv := v.(bool)
 			vvv := int64(0)
@@ -474,7 +486,7 @@ func resourceTencentCloudClbListenerCreate(d *schema.ResourceData, meta interfac
 				vvv = 1
 			} else {
 				if !certificateSetFlag {
-					return fmt.Errorf("[CHECK][CLB listener][Create] check: certificated need to be set when protocol is HTTPS")
+					return diag.Errorf("[CHECK][CLB listener][Create] check: certificated need to be set when protocol is HTTPS")
 				}
 			}
 			request.SniSwitch = &vvv
@@ -485,15 +497,15 @@ func resourceTencentCloudClbListenerCreate(d *schema.ResourceData, meta interfac
 	}
 
 	var response *clb.CreateListenerResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().CreateListener(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().CreateListenerWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
 			requestId := *result.Response.RequestId
-			retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+			retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 			if retryErr != nil {
 				return resource.NonRetryableError(errors.WithStack(retryErr))
 			}
@@ -503,46 +515,46 @@ func resourceTencentCloudClbListenerCreate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create CLB listener failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	if len(response.Response.ListenerIds) < 1 {
-		return fmt.Errorf("[CHECK][CLB listener][Create] check: Response error, listener id is null")
+		return diag.Errorf("[CHECK][CLB listener][Create] check: Response error, listener id is null")
 	}
 	listenerId := *response.Response.ListenerIds[0]
 
 	//this ID style changes since terraform 1.47.0
-	d.SetId(clbId + FILED_SP + listenerId)
-	return resourceTencentCloudClbListenerRead(d, meta)
+	d.SetId(helper.IdFormat(clbId, listenerId))
+	return resourceTencentCloudClbListenerRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbListenerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbListenerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_listener.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	clbService := ClbService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	resourceId := d.Id()
 	var listenerId = resourceId
-	items := strings.Split(resourceId, FILED_SP)
+	items := helper.IdParse(resourceId)
 	itemLength := len(items)
 	clbId := d.Get("clb_id").(string)
 
 	if itemLength == 1 && clbId == "" {
-		return fmt.Errorf("the old style listenerId %s does not support import, please use clbId#listenerId style", resourceId)
+		return diag.Errorf("the old style listenerId %s does not support import, please use clbId#listenerId style", resourceId)
 	} else if itemLength == 2 && clbId == "" {
 		listenerId = items[1]
 		clbId = items[0]
 	} else if itemLength == 2 && clbId != "" {
 		listenerId = items[1]
 	} else if itemLength != 1 && itemLength != 2 {
-		return fmt.Errorf("broken ID %s", resourceId)
+		return diag.Errorf("broken ID %s", resourceId)
 	}
 
 	var instance *clb.Listener
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := clbService.DescribeListenerById(ctx, listenerId, clbId)
 		if e != nil {
 			return retryError(e)
@@ -552,7 +564,7 @@ func resourceTencentCloudClbListenerRead(d *schema.ResourceData, meta interface{
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CLB listener failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -645,16 +657,16 @@ func resourceTencentCloudClbListenerRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTencentCloudClbListenerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbListenerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_listener.update")()
 
 	clbActionMu.Lock()
 	defer clbActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	resourceId := d.Id()
-	items := strings.Split(resourceId, FILED_SP)
+	items := helper.IdParse(resourceId)
 	itemLength := len(items)
 	listenerId := items[itemLength-1]
 	clbId := d.Get("clb_id").(string)
@@ -678,10 +690,10 @@ func resourceTencentCloudClbListenerUpdate(d *schema.ResourceData, meta interfac
 		scheduler = d.Get("scheduler").(string)
 		if !(protocol == CLB_LISTENER_PROTOCOL_TCP || protocol == CLB_LISTENER_PROTOCOL_UDP ||
 			protocol == CLB_LISTENER_PROTOCOL_TCPSSL || protocol == CLB_LISTENER_PROTOCOL_QUIC) {
-			return fmt.Errorf("[CHECK][CLB listener %s][Update] check: Scheduler can only be set with listener protocol TCP/UDP/TCP_SSL/QUIC or rule of listener HTTP/HTTPS", listenerId)
+			return diag.Errorf("[CHECK][CLB listener %s][Update] check: Scheduler can only be set with listener protocol TCP/UDP/TCP_SSL/QUIC or rule of listener HTTP/HTTPS", listenerId)
 		}
 		if scheduler == CLB_LISTENER_SCHEDULER_IP_HASH {
-			return fmt.Errorf("[CHECK][CLB listener %s][Update] check: Scheduler 'IP_HASH' can only be set with rule of listener HTTP/HTTPS", listenerId)
+			return diag.Errorf("[CHECK][CLB listener %s][Update] check: Scheduler 'IP_HASH' can only be set with rule of listener HTTP/HTTPS", listenerId)
 		}
 		request.Scheduler = helper.String(scheduler)
 	}
@@ -690,10 +702,10 @@ func resourceTencentCloudClbListenerUpdate(d *schema.ResourceData, meta interfac
 		changed = true
 		sessionExpireTime = d.Get("session_expire_time").(int)
 		if !(protocol == CLB_LISTENER_PROTOCOL_TCP || protocol == CLB_LISTENER_PROTOCOL_UDP) {
-			return fmt.Errorf("[CHECK][CLB listener %s][Update] check: session_expire_time can only be set with protocol TCP/UDP or rule of listener HTTP/HTTPS", listenerId)
+			return diag.Errorf("[CHECK][CLB listener %s][Update] check: session_expire_time can only be set with protocol TCP/UDP or rule of listener HTTP/HTTPS", listenerId)
 		}
 		if scheduler != CLB_LISTENER_SCHEDULER_WRR && scheduler != "" {
-			return fmt.Errorf("[CHECK][CLB listener %s][Update] check: session_expire_time can only be set when scheduler is WRR", listenerId)
+			return diag.Errorf("[CHECK][CLB listener %s][Update] check: session_expire_time can only be set when scheduler is WRR", listenerId)
 		}
 		sessionExpireTime64 := int64(sessionExpireTime)
 		request.SessionExpireTime = &sessionExpireTime64
@@ -701,7 +713,7 @@ func resourceTencentCloudClbListenerUpdate(d *schema.ResourceData, meta interfac
 
 	healthSetFlag, healthCheck, healthErr := checkHealthCheckPara(ctx, d, protocol, HEALTH_APPLY_TYPE_LISTENER)
 	if healthErr != nil {
-		return healthErr
+		return diag.FromErr(healthErr)
 	}
 	if healthSetFlag {
 		changed = true
@@ -710,7 +722,7 @@ func resourceTencentCloudClbListenerUpdate(d *schema.ResourceData, meta interfac
 
 	certificateSetFlag, certificateInput, certErr := checkCertificateInputPara(ctx, d, meta)
 	if certErr != nil {
-		return certErr
+		return diag.FromErr(certErr)
 	}
 	if certificateSetFlag {
 		changed = true
@@ -724,15 +736,15 @@ func resourceTencentCloudClbListenerUpdate(d *schema.ResourceData, meta interfac
 	}
 
 	if changed {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyListener(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyListenerWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 				requestId := *response.Response.RequestId
-				retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+				retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 				if retryErr != nil {
 					return resource.NonRetryableError(errors.WithStack(retryErr))
 				}
@@ -741,22 +753,22 @@ func resourceTencentCloudClbListenerUpdate(d *schema.ResourceData, meta interfac
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update CLB listener failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudClbListenerRead(d, meta)
+	return resourceTencentCloudClbListenerRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbListenerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbListenerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_listener.delete")()
 	clbActionMu.Lock()
 	defer clbActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	resourceId := d.Id()
-	items := strings.Split(resourceId, FILED_SP)
+	items := helper.IdParse(resourceId)
 	itemLength := len(items)
 	listenerId := items[itemLength-1]
 	clbId := d.Get("clb_id").(string)
@@ -764,7 +776,7 @@ func resourceTencentCloudClbListenerDelete(d *schema.ResourceData, meta interfac
 	clbService := ClbService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := clbService.DeleteListenerById(ctx, clbId, listenerId)
 		if e != nil {
 			return retryError(e)
@@ -773,8 +785,33 @@ func resourceTencentCloudClbListenerDelete(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete CLB listener failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceTencentCloudClbListenerSchedulerCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("protocol") || !d.NewValueKnown("scheduler") {
+		return nil
 	}
+	protocol := d.Get("protocol").(string)
+	scheduler := d.Get("scheduler").(string)
 
+	if scheduler == CLB_LISTENER_SCHEDULER_IP_HASH {
+		return fmt.Errorf("scheduler `IP_HASH` can only be set with rule of listener HTTP/HTTPS")
+	}
+	if d.Id() != "" && d.HasChange("scheduler") && !(protocol == CLB_LISTENER_PROTOCOL_TCP || protocol == CLB_LISTENER_PROTOCOL_UDP ||
+		protocol == CLB_LISTENER_PROTOCOL_TCPSSL || protocol == CLB_LISTENER_PROTOCOL_QUIC) {
+		return fmt.Errorf("`scheduler` can only be changed with listener protocol TCP/UDP/TCP_SSL/QUIC, got %s", protocol)
+	}
+	if _, ok := d.GetOk("session_expire_time"); ok {
+		if !(protocol == CLB_LISTENER_PROTOCOL_TCP || protocol == CLB_LISTENER_PROTOCOL_UDP) {
+			return fmt.Errorf("`session_expire_time` can only be set with protocol TCP/UDP or rule of listener HTTP/HTTPS")
+		}
+		if scheduler != CLB_LISTENER_SCHEDULER_WRR && scheduler != "" {
+			return fmt.Errorf("`session_expire_time` can only be set when `scheduler` is WRR")
+		}
+	}
 	return nil
 }
@@ -38,10 +38,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -51,12 +52,17 @@ import (
 
 func resourceTencentCloudClbListenerRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbListenerRuleCreate,
-		Read:   resourceTencentCloudClbListenerRuleRead,
-		Update: resourceTencentCloudClbListenerRuleUpdate,
-		Delete: resourceTencentCloudClbListenerRuleDelete,
+		CreateContext: resourceTencentCloudClbListenerRuleCreate,
+		ReadContext:   resourceTencentCloudClbListenerRuleRead,
+		UpdateContext: resourceTencentCloudClbListenerRuleUpdate,
+		DeleteContext: resourceTencentCloudClbListenerRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -209,18 +215,18 @@ func resourceTencentCloudClbListenerRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClbListenerRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbListenerRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_listener_rule.create")()
 
 	clbActionMu.Lock()
 	defer clbActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	listenerId := d.Get("listener_id").(string)
 	checkErr := ListenerIdCheck(listenerId)
 	if checkErr != nil {
-		return checkErr
+		return diag.FromErr(checkErr)
 	}
 	clbId := d.Get("clb_id").(string)
 	protocol := ""
@@ -228,7 +234,7 @@ func resourceTencentCloudClbListenerRuleCreate(d *schema.ResourceData, meta inte
 	clbService := ClbService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, e := clbService.DescribeListenerById(ctx, listenerId, clbId)
 		if e != nil {
 			return retryError(e)
@@ -240,11 +246,11 @@ func resourceTencentCloudClbListenerRuleCreate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s get CLB listener failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !(protocol == CLB_LISTENER_PROTOCOL_HTTP || protocol == CLB_LISTENER_PROTOCOL_HTTPS) {
-		return fmt.Errorf("[CHECK][CLB listener rule][Create] check: The rule can only be created/modified with listeners of protocol HTTP/HTTPS")
+		return diag.Errorf("[CHECK][CLB listener rule][Create] check: The rule can only be created/modified with listeners of protocol HTTP/HTTPS")
 	}
 	request := clb.NewCreateRuleRequest()
 	request.LoadBalancerId = helper.String(clbId)
@@ -264,7 +270,7 @@ func resourceTencentCloudClbListenerRuleCreate(d *schema.ResourceData, meta inte
 	scheduler := ""
 	if v, ok := d.GetOk("scheduler"); ok {
 		if !(protocol == CLB_LISTENER_PROTOCOL_HTTP || protocol == CLB_LISTENER_PROTOCOL_HTTPS) {
-			return fmt.Errorf("[CHECK][CLB listener rule][Create] check: Scheduler can only be set with listener protocol TCP/UDP/TCP_SSL or rule of listener HTTP/HTTPS")
+			return diag.Errorf("[CHECK][CLB listener rule][Create] check: Scheduler can only be set with listener protocol TCP/UDP/TCP_SSL or rule of listener HTTP/HTTPS")
 		}
 
 		scheduler = v.(string)
@@ -273,17 +279,17 @@ func resourceTencentCloudClbListenerRuleCreate(d *schema.ResourceData, meta inte
 
 	if v, ok := d.GetOk("session_expire_time"); ok {
 		if !(protocol == CLB_LISTENER_PROTOCOL_HTTP || protocol == CLB_LISTENER_PROTOCOL_HTTPS) {
-			return fmt.Errorf("[CHECK][CLB listener rule][Create] check: session_expire_time can only be set with protocol TCP/UDP or rule of listener HTTP/HTTPS")
+			return diag.Errorf("[CHECK][CLB listener rule][Create] check: session_expire_time can only be set with protocol TCP/UDP or rule of listener HTTP/HTTPS")
 		}
 		if scheduler != CLB_LISTENER_SCHEDULER_WRR && scheduler != "" {
-			return fmt.Errorf("[CHECK][CLB listener rule][Create] check: session_expire_time can only be set when scheduler is WRR ")
+			return diag.Errorf("[CHECK][CLB listener rule][Create] check: session_expire_time can only be set when scheduler is WRR ")
 		}
 		vv := int64(v.(int))
 		rule.SessionExpireTime = &vv
 	}
 	healthSetFlag, healthCheck, healthErr := checkHealthCheckPara(ctx, d, protocol, HEALTH_APPLY_TYPE_RULE)
 	if healthErr != nil {
-		return healthErr
+		return diag.FromErr(healthErr)
 	}
 	if healthSetFlag {
 		rule.HealthCheck = healthCheck
@@ -291,27 +297,27 @@ func resourceTencentCloudClbListenerRuleCreate(d *schema.ResourceData, meta inte
 
 	certificateSetFlag, certificateInput, certErr := checkCertificateInputPara(ctx, d, meta)
 	if certErr != nil {
-		return certErr
+		return diag.FromErr(certErr)
 	}
 	if certificateSetFlag {
 		if !(protocol == CLB_LISTENER_PROTOCOL_HTTPS) {
-			return fmt.Errorf("[CHECK][CLB listener rule][Create] check: certificate para can only be set with rule of linstener with protocol 'HTTPS'")
+			return diag.Errorf("[CHECK][CLB listener rule][Create] check: certificate para can only be set with rule of linstener with protocol 'HTTPS'")
 		}
 		rule.Certificate = certificateInput
 	}
 
 	request.Rules = []*clb.RuleInput{&rule}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		requestId := ""
-		response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().CreateRule(request)
+		response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().CreateRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 			requestId = *response.Response.RequestId
-			retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+			retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 			if retryErr != nil {
 				return resource.NonRetryableError(errors.WithStack(retryErr))
 			}
@@ -320,11 +326,11 @@ func resourceTencentCloudClbListenerRuleCreate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create CLB listener rule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	locationId := ""
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		ruleInstance, ruleErr := clbService.DescribeRuleByPara(ctx, clbId, listenerId, domain, url)
 		if ruleErr != nil {
 			return retryError(errors.WithStack(ruleErr))
@@ -334,11 +340,11 @@ func resourceTencentCloudClbListenerRuleCreate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CLB listener rule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	//this ID style changes since terraform 1.47.0
-	d.SetId(clbId + FILED_SP + listenerId + FILED_SP + locationId)
+	d.SetId(helper.IdFormat(clbId, listenerId, locationId))
 
 	// set http2
 	if v, ok := d.GetOkExists("http2_switch"); ok {
@@ -348,15 +354,15 @@ func resourceTencentCloudClbListenerRuleCreate(d *schema.ResourceData, meta inte
 		domainRequest.LoadBalancerId = &clbId
 		domainRequest.ListenerId = &listenerId
 		domainRequest.Domain = &domain
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyDomainAttributes(domainRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyDomainAttributesWithContext(ctx, domainRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 				requestId := *response.Response.RequestId
-				retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+				retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 				if retryErr != nil {
 					return resource.NonRetryableError(errors.WithStack(retryErr))
 				}
@@ -365,31 +371,31 @@ func resourceTencentCloudClbListenerRuleCreate(d *schema.ResourceData, meta inte
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update CLB listener rule failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudClbListenerRuleRead(d, meta)
+	return resourceTencentCloudClbListenerRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbListenerRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbListenerRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_listener_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Id()
 	var locationId = resourceId
-	items := strings.Split(resourceId, FILED_SP)
+	items := helper.IdParse(resourceId)
 	itemLength := len(items)
 	clbId := d.Get("clb_id").(string)
 	listenerId := d.Get("listener_id").(string)
 	checkErr := ListenerIdCheck(listenerId)
 	if checkErr != nil {
-		return checkErr
+		return diag.FromErr(checkErr)
 	}
 	if itemLength == 1 && clbId == "" {
-		return fmt.Errorf("The old style listenerId %s does not support import, please use clbId#listenerId style", resourceId)
+		return diag.Errorf("The old style listenerId %s does not support import, please use clbId#listenerId style", resourceId)
 	} else if itemLength == 3 && clbId == "" {
 		locationId = items[2]
 		listenerId = items[1]
@@ -398,7 +404,7 @@ func resourceTencentCloudClbListenerRuleRead(d *schema.ResourceData, meta interf
 		locationId = items[2]
 		listenerId = items[1]
 	} else if itemLength != 1 && itemLength != 3 {
-		return fmt.Errorf("broken ID %s", resourceId)
+		return diag.Errorf("broken ID %s", resourceId)
 	}
 
 	clbService := ClbService{
@@ -407,7 +413,7 @@ func resourceTencentCloudClbListenerRuleRead(d *schema.ResourceData, meta interf
 	//this function is not supported by api, need to be travelled
 	filter := map[string]string{"rule_id": locationId, "listener_id": listenerId, "clb_id": clbId}
 	var instances []*clb.RuleOutput
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		results, e := clbService.DescribeRulesByFilter(ctx, filter)
 		if e != nil {
 			return retryError(e)
@@ -417,7 +423,7 @@ func resourceTencentCloudClbListenerRuleRead(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CLB listener rule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(instances) == 0 {
@@ -466,22 +472,22 @@ func resourceTencentCloudClbListenerRuleRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudClbListenerRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbListenerRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_listener_rule.update")()
 
 	clbActionMu.Lock()
 	defer clbActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	resourceId := d.Id()
-	items := strings.Split(resourceId, FILED_SP)
+	items := helper.IdParse(resourceId)
 	itemLength := len(items)
 	locationId := items[itemLength-1]
 	listenerId := d.Get("listener_id").(string)
 	checkErr := ListenerIdCheck(listenerId)
 	if checkErr != nil {
-		return checkErr
+		return diag.FromErr(checkErr)
 	}
 	clbId := d.Get("clb_id").(string)
 	protocol := ""
@@ -489,7 +495,7 @@ func resourceTencentCloudClbListenerRuleUpdate(d *schema.ResourceData, meta inte
 	clbService := ClbService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		instance, e := clbService.DescribeListenerById(ctx, listenerId, clbId)
 		if e != nil {
 			return retryError(e)
@@ -499,7 +505,7 @@ func resourceTencentCloudClbListenerRuleUpdate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s get CLB listener failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	changed := false
@@ -526,7 +532,7 @@ func resourceTencentCloudClbListenerRuleUpdate(d *schema.ResourceData, meta inte
 		changed = true
 		scheduler = d.Get("scheduler").(string)
 		if !(protocol == CLB_LISTENER_PROTOCOL_HTTP || protocol == CLB_LISTENER_PROTOCOL_HTTPS) {
-			return fmt.Errorf("[CHECK][CLB listener rule %s][Update] check: Scheduler can only be set with listener protocol TCP/UDP/TCP_SSL or rule of listener HTTP/HTTPS", locationId)
+			return diag.Errorf("[CHECK][CLB listener rule %s][Update] check: Scheduler can only be set with listener protocol TCP/UDP/TCP_SSL or rule of listener HTTP/HTTPS", locationId)
 		}
 		request.Scheduler = helper.String(scheduler)
 	}
@@ -535,10 +541,10 @@ func resourceTencentCloudClbListenerRuleUpdate(d *schema.ResourceData, meta inte
 		changed = true
 		sessionExpireTime = d.Get("session_expire_time").(int)
 		if !(protocol == CLB_LISTENER_PROTOCOL_HTTP || protocol == CLB_LISTENER_PROTOCOL_HTTPS) {
-			return fmt.Errorf("[CHECK][CLB listener rule %s][Update] check: session_expire_time can only be set with protocol TCP/UDP or rule of listener HTTP/HTTPS", locationId)
+			return diag.Errorf("[CHECK][CLB listener rule %s][Update] check: session_expire_time can only be set with protocol TCP/UDP or rule of listener HTTP/HTTPS", locationId)
 		}
 		if scheduler != CLB_LISTENER_SCHEDULER_WRR && scheduler != "" {
-			return fmt.Errorf("[CHECK][CLB listener rule %s][Update] check: session_expire_time can only be set when scheduler is WRR", locationId)
+			return diag.Errorf("[CHECK][CLB listener rule %s][Update] check: session_expire_time can only be set when scheduler is WRR", locationId)
 		}
 		sessionExpireTime64 := int64(sessionExpireTime)
 		request.SessionExpireTime = &sessionExpireTime64
@@ -546,7 +552,7 @@ func resourceTencentCloudClbListenerRuleUpdate(d *schema.ResourceData, meta inte
 
 	healthSetFlag, healthCheck, healthErr := checkHealthCheckPara(ctx, d, protocol, HEALTH_APPLY_TYPE_RULE)
 	if healthErr != nil {
-		return healthErr
+		return diag.FromErr(healthErr)
 	}
 
 	if healthSetFlag {
@@ -555,15 +561,15 @@ func resourceTencentCloudClbListenerRuleUpdate(d *schema.ResourceData, meta inte
 	}
 
 	if changed {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyRule(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyRuleWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 				requestId := *response.Response.RequestId
-				retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+				retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 				if retryErr != nil {
 					return resource.NonRetryableError(errors.WithStack(retryErr))
 				}
@@ -572,7 +578,7 @@ func resourceTencentCloudClbListenerRuleUpdate(d *schema.ResourceData, meta inte
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update CLB listener rule failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -592,11 +598,11 @@ func resourceTencentCloudClbListenerRuleUpdate(d *schema.ResourceData, meta inte
 		domainChanged = true
 		certificateSetFlag, certificateInput, certErr := checkCertificateInputPara(ctx, d, meta)
 		if certErr != nil {
-			return certErr
+			return diag.FromErr(certErr)
 		}
 		if certificateSetFlag {
 			if !(protocol == CLB_LISTENER_PROTOCOL_HTTPS) {
-				return fmt.Errorf("[CHECK][CLB listener rule][Create] check: certificate para can only be set with rule of linstener with protocol 'HTTPS'")
+				return diag.Errorf("[CHECK][CLB listener rule][Create] check: certificate para can only be set with rule of linstener with protocol 'HTTPS'")
 			}
 			domainRequest.Certificate = certificateInput
 		}
@@ -605,7 +611,7 @@ func resourceTencentCloudClbListenerRuleUpdate(d *schema.ResourceData, meta inte
 	if d.HasChange("http2_switch") {
 		if v, ok := d.GetOkExists("http2_switch"); ok {
 			if !(protocol == CLB_LISTENER_PROTOCOL_HTTPS) {
-				return fmt.Errorf("[CHECK][CLB listener rule][Create] check: certificate para can only be set with rule of linstener with protocol 'HTTPS'")
+				return diag.Errorf("[CHECK][CLB listener rule][Create] check: certificate para can only be set with rule of linstener with protocol 'HTTPS'")
 			}
 			domainChanged = true
 			domainRequest.Http2 = helper.Bool(v.(bool))
@@ -615,15 +621,15 @@ func resourceTencentCloudClbListenerRuleUpdate(d *schema.ResourceData, meta inte
 	if domainChanged {
 		domainRequest.ListenerId = &listenerId
 		domainRequest.LoadBalancerId = &clbId
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyDomainAttributes(domainRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyDomainAttributesWithContext(ctx, domainRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 				requestId := *response.Response.RequestId
-				retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+				retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 				if retryErr != nil {
 					return resource.NonRetryableError(errors.WithStack(retryErr))
 				}
@@ -632,36 +638,36 @@ func resourceTencentCloudClbListenerRuleUpdate(d *schema.ResourceData, meta inte
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update CLB listener rule failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceTencentCloudClbListenerRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbListenerRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_listener_rule.delete")()
 
 	clbActionMu.Lock()
 	defer clbActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	resourceId := d.Id()
-	items := strings.Split(resourceId, FILED_SP)
+	items := helper.IdParse(resourceId)
 	itemLength := len(items)
 	locationId := items[itemLength-1]
 	listenerId := d.Get("listener_id").(string)
 	checkErr := ListenerIdCheck(listenerId)
 	if checkErr != nil {
-		return checkErr
+		return diag.FromErr(checkErr)
 	}
 	clbId := d.Get("clb_id").(string)
 
 	clbService := ClbService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := clbService.DeleteRuleById(ctx, clbId, listenerId, locationId)
 		if e != nil {
 			return retryError(e)
@@ -670,7 +676,7 @@ func resourceTencentCloudClbListenerRuleDelete(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete CLB listener rule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
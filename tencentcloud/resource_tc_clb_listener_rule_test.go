@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func TestAccTencentCloudClbListenerRuleResource_basic(t *testing.T) {
@@ -126,7 +126,7 @@ func testAccCheckClbListenerRuleDestroy(s *terraform.State) error {
 			continue
 		}
 		resourceId := rs.Primary.ID
-		items := strings.Split(resourceId, FILED_SP)
+		items := helper.IdParse(resourceId)
 		itemLength := len(items)
 		locationId := items[itemLength-1]
 		listenerId := rs.Primary.Attributes["listener_id"]
@@ -157,7 +157,7 @@ func testAccCheckClbListenerRuleExists(n string) resource.TestCheckFunc {
 			client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn,
 		}
 		resourceId := rs.Primary.ID
-		items := strings.Split(resourceId, FILED_SP)
+		items := helper.IdParse(resourceId)
 		itemLength := len(items)
 		locationId := items[itemLength-1]
 		listenerId := rs.Primary.Attributes["listener_id"]
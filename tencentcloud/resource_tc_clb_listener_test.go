@@ -3,12 +3,12 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func TestAccTencentCloudClbListener_basic(t *testing.T) {
@@ -406,7 +406,7 @@ func testAccCheckClbListenerDestroy(s *terraform.State) error {
 		}
 		time.Sleep(5 * time.Second)
 		resourceId := rs.Primary.ID
-		items := strings.Split(resourceId, FILED_SP)
+		items := helper.IdParse(resourceId)
 		itemLength := len(items)
 		listenerId := items[itemLength-1]
 		clbId := rs.Primary.Attributes["clb_id"]
@@ -437,7 +437,7 @@ func testAccCheckClbListenerExists(n string) resource.TestCheckFunc {
 			client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn,
 		}
 		resourceId := rs.Primary.ID
-		items := strings.Split(resourceId, FILED_SP)
+		items := helper.IdParse(resourceId)
 		itemLength := len(items)
 		listenerId := items[itemLength-1]
 		clbId := rs.Primary.Attributes["clb_id"]
@@ -21,21 +21,21 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTencentCloudClbLogSet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbLogSetCreate,
-		Read:   resourceTencentCloudClbLogSetRead,
-		Delete: resourceTencentCloudClbLogSetDelete,
+		CreateContext: resourceTencentCloudClbLogSetCreate,
+		ReadContext:   resourceTencentCloudClbLogSetRead,
+		DeleteContext: resourceTencentCloudClbLogSetDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"period": {
@@ -63,12 +63,12 @@ func resourceTencentCloudClbLogSet() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClbLogSetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbLogSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_logset.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	id := d.Id()
@@ -76,12 +76,12 @@ func resourceTencentCloudClbLogSetRead(d *schema.ResourceData, meta interface{})
 	info, err := service.DescribeClsLogset(ctx, id)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if info == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `Logset` %s does not exist", id)
+		return diag.Errorf("resource `Logset` %s does not exist", id)
 	}
 
 	_ = d.Set("name", info.LogsetName)
@@ -92,18 +92,18 @@ func resourceTencentCloudClbLogSetRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudClbLogSetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbLogSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_logset.create")()
 	defer clbActionMu.Unlock()
 	clbActionMu.Lock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	_, _, err := service.DescribeClbLogSet(ctx)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var (
@@ -114,28 +114,28 @@ func resourceTencentCloudClbLogSetCreate(d *schema.ResourceData, meta interface{
 	id, err := service.CreateClbLogSet(ctx, "clb_logset", "", period)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	//加一个创建保护
 	time.Sleep(3 * time.Second)
 	d.SetId(id)
 
-	return resourceTencentCloudClbLogSetRead(d, meta)
+	return resourceTencentCloudClbLogSetRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbLogSetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbLogSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_logset.delete")()
 
 	clbActionMu.Lock()
 	defer clbActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	id := d.Id()
 
 	if err := service.DeleteClsLogsetById(ctx, id); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
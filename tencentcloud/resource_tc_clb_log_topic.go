@@ -21,10 +21,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"sync"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -32,11 +32,11 @@ var clsActionMu = &sync.Mutex{}
 
 func resourceTencentCloudClbLogTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbInstanceTopicCreate,
-		Read:   resourceTencentCloudClbInstanceTopicRead,
-		Delete: resourceTencentCloudClbInstanceTopicDelete,
+		CreateContext: resourceTencentCloudClbInstanceTopicCreate,
+		ReadContext:   resourceTencentCloudClbInstanceTopicRead,
+		DeleteContext: resourceTencentCloudClbInstanceTopicDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,9 +73,9 @@ func resourceTencentCloudClbLogTopic() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClbInstanceTopicCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbInstanceTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	clsService := ClsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -84,10 +84,10 @@ func resourceTencentCloudClbInstanceTopicCreate(d *schema.ResourceData, meta int
 	if v, ok := d.GetOk("log_set_id"); ok {
 		info, err := clsService.DescribeClsLogset(ctx, v.(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if info == nil {
-			return fmt.Errorf("resource `log_set` %s does not exist", v.(string))
+			return diag.Errorf("resource `log_set` %s does not exist", v.(string))
 		}
 	}
 
@@ -104,17 +104,17 @@ func resourceTencentCloudClbInstanceTopicCreate(d *schema.ResourceData, meta int
 	resp, err := clbService.CreateTopic(ctx, params)
 	if err != nil {
 		log.Printf("[CRITAL]%s create clb topic failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(*resp.Response.TopicId)
-	return resourceTencentCloudClbInstanceTopicRead(d, meta)
+	return resourceTencentCloudClbInstanceTopicRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbInstanceTopicRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbInstanceTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clsActionMu.Lock()
 	defer clsActionMu.Unlock()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	clsService := ClsService{
@@ -122,11 +122,11 @@ func resourceTencentCloudClbInstanceTopicRead(d *schema.ResourceData, meta inter
 	}
 	res, err := clsService.DescribeClsTopicById(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if res == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `logTopic` %s does not exist", id)
+		return diag.Errorf("resource `logTopic` %s does not exist", id)
 	}
 	_ = d.Set("log_set_id", res.LogsetId)
 	_ = d.Set("topic_name", res.TopicName)
@@ -136,11 +136,11 @@ func resourceTencentCloudClbInstanceTopicRead(d *schema.ResourceData, meta inter
 
 }
 
-func resourceTencentCloudClbInstanceTopicDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbInstanceTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clsActionMu.Lock()
 	defer clsActionMu.Unlock()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	clsService := ClsService{
@@ -148,7 +148,7 @@ func resourceTencentCloudClbInstanceTopicDelete(d *schema.ResourceData, meta int
 	}
 	err := clsService.DeleteClsTopic(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
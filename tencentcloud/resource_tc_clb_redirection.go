@@ -38,9 +38,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -50,12 +51,16 @@ import (
 
 func resourceTencentCloudClbRedirection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbRedirectionCreate,
-		Read:   resourceTencentCloudClbRedirectionRead,
-		Update: resourceTencentCloudClbRedirectionUpdate,
-		Delete: resourceTencentCloudClbRedirectionDelete,
+		CreateContext: resourceTencentCloudClbRedirectionCreate,
+		ReadContext:   resourceTencentCloudClbRedirectionRead,
+		UpdateContext: resourceTencentCloudClbRedirectionUpdate,
+		DeleteContext: resourceTencentCloudClbRedirectionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -109,25 +114,25 @@ func resourceTencentCloudClbRedirection() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClbRedirectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbRedirectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_redirection.create")()
 
 	clbActionMu.Lock()
 	defer clbActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	clbId := d.Get("clb_id").(string)
 	targetListenerId := d.Get("target_listener_id").(string)
 	checkErr := ListenerIdCheck(targetListenerId)
 	if checkErr != nil {
-		return checkErr
+		return diag.FromErr(checkErr)
 	}
 	targetLocId := d.Get("target_rule_id").(string)
 	checkErr = RuleIdCheck(targetLocId)
 	if checkErr != nil {
-		return checkErr
+		return diag.FromErr(checkErr)
 	}
 	sourceListenerId := ""
 	sourceLocId := ""
@@ -135,14 +140,14 @@ func resourceTencentCloudClbRedirectionCreate(d *schema.ResourceData, meta inter
 		sourceListenerId = v.(string)
 		checkErr := ListenerIdCheck(sourceListenerId)
 		if checkErr != nil {
-			return checkErr
+			return diag.FromErr(checkErr)
 		}
 	}
 	if v, ok := d.GetOk("source_rule_id"); ok {
 		sourceLocId = v.(string)
 		checkErr = RuleIdCheck(sourceLocId)
 		if checkErr != nil {
-			return checkErr
+			return diag.FromErr(checkErr)
 		}
 	}
 
@@ -164,7 +169,7 @@ func resourceTencentCloudClbRedirectionCreate(d *schema.ResourceData, meta inter
 		}
 		protocol := ""
 		port := -1
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			instance, e := clbService.DescribeListenerById(ctx, targetListenerId, clbId)
 			if e != nil {
 				return retryError(e)
@@ -175,17 +180,17 @@ func resourceTencentCloudClbRedirectionCreate(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s get CLB listener failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		if protocol == CLB_LISTENER_PROTOCOL_HTTPS && port != AUTO_TARGET_PORT {
-			return fmt.Errorf("[CHECK][CLB redirection][Create] check: The target listener must be https:443 when applying auto rewrite")
+			return diag.Errorf("[CHECK][CLB redirection][Create] check: The target listener must be https:443 when applying auto rewrite")
 		}
 
 		//get host array from location
 		filter := map[string]string{"rule_id": targetLocId, "listener_id": targetListenerId, "clb_id": clbId}
 		var instances []*clb.RuleOutput
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			results, e := clbService.DescribeRulesByFilter(ctx, filter)
 			if e != nil {
 				return retryError(e)
@@ -196,11 +201,11 @@ func resourceTencentCloudClbRedirectionCreate(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s read CLB listener rule failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		if len(instances) == 0 {
-			return fmt.Errorf("[CHECK][CLB redirection][Create] check: rule %s not found!", targetLocId)
+			return diag.Errorf("[CHECK][CLB redirection][Create] check: rule %s not found!", targetLocId)
 		}
 		instance := instances[0]
 		domain := instance.Domain
@@ -208,17 +213,17 @@ func resourceTencentCloudClbRedirectionCreate(d *schema.ResourceData, meta inter
 		request.Domains = []*string{domain}
 		//check source listener is null
 		if sourceListenerId != "" || sourceLocId != "" {
-			return fmt.Errorf("[CHECK][CLB redirection][Create] check: auto rewrite cannot specify source")
+			return diag.Errorf("[CHECK][CLB redirection][Create] check: auto rewrite cannot specify source")
 		}
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().AutoRewrite(request)
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().AutoRewriteWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 				requestId := *response.Response.RequestId
-				retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+				retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 				if retryErr != nil {
 					return resource.NonRetryableError(errors.WithStack(retryErr))
 				}
@@ -227,7 +232,7 @@ func resourceTencentCloudClbRedirectionCreate(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s create CLB redirection failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		params := make(map[string]interface{})
@@ -235,7 +240,7 @@ func resourceTencentCloudClbRedirectionCreate(d *schema.ResourceData, meta inter
 		params["port"] = AUTO_SOURCE_PORT
 		params["protocol"] = CLB_LISTENER_PROTOCOL_HTTP
 		var listeners []*clb.Listener
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			results, e := clbService.DescribeListenersByFilter(ctx, params)
 			if e != nil {
 				return retryError(e)
@@ -245,10 +250,10 @@ func resourceTencentCloudClbRedirectionCreate(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s read CLB listeners failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 		if len(listeners) == 0 {
-			return fmt.Errorf("[CHECK][CLB redirection][Create] check: listener not found!")
+			return diag.Errorf("[CHECK][CLB redirection][Create] check: listener not found!")
 		}
 		listener := listeners[0]
 		sourceListenerId = *listener.ListenerId
@@ -259,7 +264,7 @@ func resourceTencentCloudClbRedirectionCreate(d *schema.ResourceData, meta inter
 		rparams["listener_id"] = sourceListenerId
 		rparams["url"] = *url
 		var rules []*clb.RuleOutput
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			results, e := clbService.DescribeRulesByFilter(ctx, rparams)
 			if e != nil {
 				return retryError(e)
@@ -269,10 +274,10 @@ func resourceTencentCloudClbRedirectionCreate(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s read CLB listener rules failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 		if len(rules) == 0 {
-			return fmt.Errorf("[CHECK][CLB redirection][Create] check: rule not found!")
+			return diag.Errorf("[CHECK][CLB redirection][Create] check: rule not found!")
 		}
 
 		rule := rules[0]
@@ -289,15 +294,15 @@ func resourceTencentCloudClbRedirectionCreate(d *schema.ResourceData, meta inter
 		rewriteInfo.SourceLocationId = helper.String(sourceLocId)
 		rewriteInfo.TargetLocationId = helper.String(targetLocId)
 		request.RewriteInfos = []*clb.RewriteLocationMap{&rewriteInfo}
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ManualRewrite(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ManualRewriteWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
 				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 				requestId := *response.Response.RequestId
-				retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+				retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 				if retryErr != nil {
 					return resource.NonRetryableError(errors.WithStack(retryErr))
 				}
@@ -306,22 +311,22 @@ func resourceTencentCloudClbRedirectionCreate(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s create CLB redirection failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
-	d.SetId(sourceLocId + "#" + targetLocId + "#" + sourceListenerId + "#" + targetListenerId + "#" + clbId)
+	d.SetId(helper.IdFormat(sourceLocId, targetLocId, sourceListenerId, targetListenerId, clbId))
 
-	return resourceTencentCloudClbRedirectionRead(d, meta)
+	return resourceTencentCloudClbRedirectionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbRedirectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbRedirectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_redirection.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	rewriteId := d.Id()
 	isAutoRewrite := false
@@ -333,7 +338,7 @@ func resourceTencentCloudClbRedirectionRead(d *schema.ResourceData, meta interfa
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var instance *map[string]string
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		result, e := clbService.DescribeRedirectionById(ctx, rewriteId)
 		if e != nil {
 			return retryError(e)
@@ -343,7 +348,7 @@ func resourceTencentCloudClbRedirectionRead(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read CLB redirection failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil || len(*instance) == 0 {
@@ -360,21 +365,21 @@ func resourceTencentCloudClbRedirectionRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudClbRedirectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbRedirectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_redirection.update")()
 	defer inconsistentCheck(d, meta)()
 	// this nil update method works for the only filed `delete_all_auto_rewrite`
-	return resourceTencentCloudClbRedirectionRead(d, meta)
+	return resourceTencentCloudClbRedirectionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbRedirectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbRedirectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_redirection.delete")()
 
 	clbActionMu.Lock()
 	defer clbActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	clbService := ClbService{
@@ -386,7 +391,7 @@ func resourceTencentCloudClbRedirectionDelete(d *schema.ResourceData, meta inter
 	if deleteAll && isAutoRewrite {
 		//delete all the auto rewrite
 		var rewrites []*map[string]string
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			result, inErr := clbService.DescribeAllAutoRedirections(ctx, id)
 			if inErr != nil {
 				return retryError(inErr)
@@ -396,15 +401,16 @@ func resourceTencentCloudClbRedirectionDelete(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s delete CLB redirection failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		for _, rewrite := range rewrites {
 			if rewrite == nil {
 				continue
 			}
-			rewriteId := (*rewrite)["source_rule_id"] + FILED_SP + (*rewrite)["target_rule_id"] + FILED_SP + (*rewrite)["source_listener_id"] + FILED_SP + (*rewrite)["target_listener_id"] + FILED_SP + (*rewrite)["clb_id"]
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			rewriteId := helper.IdFormat((*rewrite)["source_rule_id"], (*rewrite)["target_rule_id"],
+				(*rewrite)["source_listener_id"], (*rewrite)["target_listener_id"], (*rewrite)["clb_id"])
+			err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 				e := clbService.DeleteRedirectionById(ctx, rewriteId)
 				if e != nil {
 					return retryError(e)
@@ -413,11 +419,11 @@ func resourceTencentCloudClbRedirectionDelete(d *schema.ResourceData, meta inter
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s delete CLB redirection failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	} else {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			e := clbService.DeleteRedirectionById(ctx, id)
 			if e != nil {
 				return retryError(e)
@@ -426,7 +432,7 @@ func resourceTencentCloudClbRedirectionDelete(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s delete CLB redirection failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -59,8 +59,11 @@ terraform import tencentcloud_clb_replace_cert_for_lbs.replace_cert_for_lbs repl
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -69,9 +72,12 @@ import (
 
 func resourceTencentCloudClbReplaceCertForLbs() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbReplaceCertForLbsCreate,
-		Read:   resourceTencentCloudClbReplaceCertForLbsRead,
-		Delete: resourceTencentCloudClbReplaceCertForLbsDelete,
+		CreateContext: resourceTencentCloudClbReplaceCertForLbsCreate,
+		ReadContext:   resourceTencentCloudClbReplaceCertForLbsRead,
+		DeleteContext: resourceTencentCloudClbReplaceCertForLbsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"old_certificate_id": {
 				Required:    true,
@@ -135,7 +141,7 @@ func resourceTencentCloudClbReplaceCertForLbs() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClbReplaceCertForLbsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbReplaceCertForLbsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_replace_cert_for_lbs.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -179,8 +185,8 @@ func resourceTencentCloudClbReplaceCertForLbsCreate(d *schema.ResourceData, meta
 		request.Certificate = &certificateInput
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ReplaceCertForLoadBalancers(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ReplaceCertForLoadBalancersWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -190,22 +196,22 @@ func resourceTencentCloudClbReplaceCertForLbsCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate clb replaceCertForLbs failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(oldCertificateId)
 
-	return resourceTencentCloudClbReplaceCertForLbsRead(d, meta)
+	return resourceTencentCloudClbReplaceCertForLbsRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbReplaceCertForLbsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbReplaceCertForLbsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_replace_cert_for_lbs.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudClbReplaceCertForLbsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbReplaceCertForLbsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_replace_cert_for_lbs.delete")()
 	defer inconsistentCheck(d, meta)()
 
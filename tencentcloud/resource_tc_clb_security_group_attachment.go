@@ -22,10 +22,9 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -33,11 +32,11 @@ import (
 
 func resourceTencentCloudClbSecurityGroupAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbSecurityGroupAttachmentCreate,
-		Read:   resourceTencentCloudClbSecurityGroupAttachmentRead,
-		Delete: resourceTencentCloudClbSecurityGroupAttachmentDelete,
+		CreateContext: resourceTencentCloudClbSecurityGroupAttachmentCreate,
+		ReadContext:   resourceTencentCloudClbSecurityGroupAttachmentRead,
+		DeleteContext: resourceTencentCloudClbSecurityGroupAttachmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"security_group": {
@@ -61,7 +60,7 @@ func resourceTencentCloudClbSecurityGroupAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClbSecurityGroupAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbSecurityGroupAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_security_group_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -85,40 +84,40 @@ func resourceTencentCloudClbSecurityGroupAttachmentCreate(d *schema.ResourceData
 		}
 	}
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	err := service.SetClbSecurityGroup(ctx, securityGroup, loadBalancerId, "ADD")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(securityGroup + FILED_SP + loadBalancerId)
+	d.SetId(helper.IdFormat(securityGroup, loadBalancerId))
 
-	return resourceTencentCloudClbSecurityGroupAttachmentRead(d, meta)
+	return resourceTencentCloudClbSecurityGroupAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbSecurityGroupAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbSecurityGroupAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_security_group_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	securityGroup := idSplit[0]
 	loadBalancerId := idSplit[1]
 
 	instance, err := service.DescribeLoadBalancerById(ctx, loadBalancerId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -147,23 +146,23 @@ func resourceTencentCloudClbSecurityGroupAttachmentRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudClbSecurityGroupAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbSecurityGroupAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_security_group_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	securityGroup := idSplit[0]
 	loadBalancerId := idSplit[1]
 
 	if err := service.SetClbSecurityGroup(ctx, securityGroup, loadBalancerId, "DEL"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -39,7 +39,9 @@ package tencentcloud
 
 import (
 	"context"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -47,12 +49,17 @@ import (
 
 func resourceTencentCloudClbSnatIp() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudClbSnatIpRead,
-		Create: resourceTencentCloudClbSnatIpCreate,
-		Update: resourceTencentCloudClbSnatIpUpdate,
-		Delete: resourceTencentCloudClbSnatIpDelete,
+		ReadContext:   resourceTencentCloudClbSnatIpRead,
+		CreateContext: resourceTencentCloudClbSnatIpCreate,
+		UpdateContext: resourceTencentCloudClbSnatIpUpdate,
+		DeleteContext: resourceTencentCloudClbSnatIpDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"clb_id": {
@@ -83,12 +90,12 @@ func resourceTencentCloudClbSnatIp() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClbSnatIpRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbSnatIpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_snat_ip.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := ClbService{client}
 
@@ -99,7 +106,7 @@ func resourceTencentCloudClbSnatIpRead(d *schema.ResourceData, meta interface{})
 		err      error
 	)
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		instance, err = service.DescribeLoadBalancerById(ctx, clbId)
 		if err != nil {
 			return retryError(err)
@@ -109,7 +116,7 @@ func resourceTencentCloudClbSnatIpRead(d *schema.ResourceData, meta interface{})
 
 	if err != nil {
 		d.SetId("")
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(clbId)
@@ -131,11 +138,11 @@ func resourceTencentCloudClbSnatIpRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudClbSnatIpCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbSnatIpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_snat_ip.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := ClbService{client}
 
@@ -154,7 +161,7 @@ func resourceTencentCloudClbSnatIpCreate(d *schema.ResourceData, meta interface{
 	}
 
 	var taskId string
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		reqId, err := service.CreateLoadBalancerSnatIps(ctx, clbId, snatIps)
 		if err != nil {
 			return retryError(err, clb.FAILEDOPERATION)
@@ -164,22 +171,22 @@ func resourceTencentCloudClbSnatIpCreate(d *schema.ResourceData, meta interface{
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if err := waitForTaskFinish(taskId, client.UseClbClient()); err != nil {
-		return err
+	if err := waitForTaskFinish(ctx, taskId, client.UseClbClient()); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(clbId)
 
-	return resourceTencentCloudClbSnatIpRead(d, meta)
+	return resourceTencentCloudClbSnatIpRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbSnatIpUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbSnatIpUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_snat_ip.update")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	clbId := d.Id()
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := ClbService{client}
@@ -222,7 +229,7 @@ func resourceTencentCloudClbSnatIpUpdate(d *schema.ResourceData, meta interface{
 				SubnetId: &subnet,
 				Ip:       &ip,
 			})
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				taskId, err = service.CreateLoadBalancerSnatIps(ctx, clbId, addIps)
 				if err != nil {
 					return retryError(err, clb.FAILEDOPERATION)
@@ -230,12 +237,12 @@ func resourceTencentCloudClbSnatIpUpdate(d *schema.ResourceData, meta interface{
 				return nil
 			})
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
-			err = waitForTaskFinish(taskId, client.UseClbClient())
+			err = waitForTaskFinish(ctx, taskId, client.UseClbClient())
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -252,7 +259,7 @@ func resourceTencentCloudClbSnatIpUpdate(d *schema.ResourceData, meta interface{
 				removeIps = append(removeIps, &ip)
 			}
 
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				taskId, err = service.DeleteLoadBalancerSnatIps(ctx, clbId, removeIps)
 				if err != nil {
 					return retryError(err, clb.FAILEDOPERATION)
@@ -260,25 +267,25 @@ func resourceTencentCloudClbSnatIpUpdate(d *schema.ResourceData, meta interface{
 				return nil
 			})
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
-			err = waitForTaskFinish(taskId, client.UseClbClient())
+			err = waitForTaskFinish(ctx, taskId, client.UseClbClient())
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 	}
 
-	return resourceTencentCloudClbSnatIpRead(d, meta)
+	return resourceTencentCloudClbSnatIpRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbSnatIpDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbSnatIpDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_snat_ip.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := ClbService{client}
 
@@ -287,7 +294,7 @@ func resourceTencentCloudClbSnatIpDelete(d *schema.ResourceData, meta interface{
 	instanceSnatIps := make([]*string, 0)
 	instance, err := service.DescribeLoadBalancerById(ctx, clbId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(instance.SnatIps) > 0 {
@@ -298,7 +305,7 @@ func resourceTencentCloudClbSnatIpDelete(d *schema.ResourceData, meta interface{
 	}
 
 	var taskId string
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		reqId, err := service.DeleteLoadBalancerSnatIps(ctx, clbId, instanceSnatIps)
 		if err != nil {
 			return retryError(err, clb.FAILEDOPERATION)
@@ -308,14 +315,14 @@ func resourceTencentCloudClbSnatIpDelete(d *schema.ResourceData, meta interface{
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if err := waitForTaskFinish(taskId, client.UseClbClient()); err != nil {
-		return err
+	if err := waitForTaskFinish(ctx, taskId, client.UseClbClient()); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
 
-	return err
+	return diag.FromErr(err)
 }
@@ -24,18 +24,19 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
 )
 
 func resourceTencentCloudClbTargetGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbTargetCreate,
-		Read:   resourceTencentCloudClbTargetRead,
-		Update: resourceTencentCloudClbTargetUpdate,
-		Delete: resourceTencentCloudClbTargetDelete,
+		CreateContext: resourceTencentCloudClbTargetCreate,
+		ReadContext:   resourceTencentCloudClbTargetRead,
+		UpdateContext: resourceTencentCloudClbTargetUpdate,
+		DeleteContext: resourceTencentCloudClbTargetDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"target_group_name": {
@@ -95,12 +96,11 @@ func resourceTencentCloudClbTargetGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClbTargetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbTargetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_target_group.create")()
 
 	var (
 		logId           = getLogId(contextNil)
-		ctx             = context.WithValue(context.TODO(), logIdKey, logId)
 		clbService      = ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		vpcId           = d.Get("vpc_id").(string)
 		targetGroupName = d.Get("target_group_name").(string)
@@ -109,6 +109,7 @@ func resourceTencentCloudClbTargetCreate(d *schema.ResourceData, meta interface{
 		targetGroupId   string
 		err             error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("target_group_instances"); ok {
 		targetGroupInstances := v.([]interface{})
@@ -130,28 +131,28 @@ func resourceTencentCloudClbTargetCreate(d *schema.ResourceData, meta interface{
 
 	targetGroupId, err = clbService.CreateTargetGroup(ctx, targetGroupName, vpcId, port, insAttachments)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(targetGroupId)
 
-	return resourceTencentCloudClbTargetRead(d, meta)
+	return resourceTencentCloudClbTargetRead(ctx, d, meta)
 
 }
 
-func resourceTencentCloudClbTargetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbTargetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_target_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		clbService = ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id         = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	filters := make(map[string]string)
 	targetGroupInfos, err := clbService.DescribeTargetGroups(ctx, id, filters)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(targetGroupInfos) < 1 {
 		d.SetId("")
@@ -164,17 +165,17 @@ func resourceTencentCloudClbTargetRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudClbTargetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbTargetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_target_group.update")()
 
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		clbService    = ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		targetGroupId = d.Id()
 		port          uint64
 		tgtGroupName  string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	isChanged := false
 	if d.HasChange("port") || d.HasChange("target_group_name") {
@@ -186,27 +187,27 @@ func resourceTencentCloudClbTargetUpdate(d *schema.ResourceData, meta interface{
 	if isChanged {
 		err := clbService.ModifyTargetGroup(ctx, targetGroupId, tgtGroupName, port)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudClbTargetRead(d, meta)
+	return resourceTencentCloudClbTargetRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbTargetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbTargetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_target_group.delete")()
 
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		clbService    = ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		targetGroupId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	err := clbService.DeleteTarget(ctx, targetGroupId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
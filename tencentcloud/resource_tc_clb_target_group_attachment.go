@@ -51,21 +51,27 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudClbTargetGroupAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbTargetGroupAttachmentCreate,
-		Read:   resourceTencentCloudClbTargetGroupAttachmentRead,
-		Update: resourceTencentCloudClbTargetGroupAttachmentUpdate,
-		Delete: resourceTencentCloudClbTargetGroupAttachmentDelete,
+		CreateContext: resourceTencentCloudClbTargetGroupAttachmentCreate,
+		ReadContext:   resourceTencentCloudClbTargetGroupAttachmentRead,
+		UpdateContext: resourceTencentCloudClbTargetGroupAttachmentUpdate,
+		DeleteContext: resourceTencentCloudClbTargetGroupAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"clb_id": {
@@ -101,7 +107,7 @@ func resourceTencentCloudClbTargetGroupAttachment() *schema.Resource {
 		},
 	}
 }
-func resourceTencentCloudClbTargetGroupAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbTargetGroupAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_target_group_attachment.create")()
 
 	var (
@@ -109,7 +115,6 @@ func resourceTencentCloudClbTargetGroupAttachmentCreate(d *schema.ResourceData,
 			client: meta.(*TencentCloudClient).apiV3Conn,
 		}
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		locationId    string
 		listenerId    = d.Get("listener_id").(string)
 		clbId         = d.Get("clb_id").(string)
@@ -120,6 +125,7 @@ func resourceTencentCloudClbTargetGroupAttachmentCreate(d *schema.ResourceData,
 		has         bool
 		err         error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if v, ok := d.GetOk("rule_id"); ok {
 		locationId = v.(string)
 	}
@@ -134,22 +140,22 @@ func resourceTencentCloudClbTargetGroupAttachmentCreate(d *schema.ResourceData,
 			targetGroupId = vTarget.(string)
 		}
 	} else {
-		return fmt.Errorf("'target_group_id' or 'targrt_group_id' at least set one, please use 'target_group_id'")
+		return diag.Errorf("'target_group_id' or 'targrt_group_id' at least set one, please use 'target_group_id'")
 	}
 
 	//check listenerId
 	checkErr := ListenerIdCheck(listenerId)
 	if checkErr != nil {
-		return checkErr
+		return diag.FromErr(checkErr)
 	}
 	//check ruleId
 	checkErr = RuleIdCheck(locationId)
 	if checkErr != nil {
-		return checkErr
+		return diag.FromErr(checkErr)
 	}
 
 	//check target group
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, err = clbService.DescribeLoadBalancerById(ctx, clbId)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -157,9 +163,9 @@ func resourceTencentCloudClbTargetGroupAttachmentCreate(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		targetInfos, err = clbService.DescribeTargetGroups(ctx, targetGroupId, nil)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -167,33 +173,33 @@ func resourceTencentCloudClbTargetGroupAttachmentCreate(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(targetInfos) > 0 && (*targetInfos[0].VpcId != *instance.TargetRegionInfo.VpcId) {
-		return fmt.Errorf("CLB instance needs to be in the same VPC as the backend target group")
+		return diag.Errorf("CLB instance needs to be in the same VPC as the backend target group")
 	}
 
 	err = clbService.AssociateTargetGroups(ctx, listenerId, clbId, targetGroupId, locationId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// wait status
 	has, err = clbService.DescribeAssociateTargetGroups(ctx, []string{targetGroupId, listenerId, clbId, locationId})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
-		return fmt.Errorf("AssociateTargetGroups faild, targetGroupId = %s, listenerId = %s, clbId = %s, ruleId = %s",
+		return diag.Errorf("AssociateTargetGroups faild, targetGroupId = %s, listenerId = %s, clbId = %s, ruleId = %s",
 			targetGroupId, listenerId, clbId, locationId)
 	}
 
-	d.SetId(strings.Join([]string{targetGroupId, listenerId, clbId, locationId}, FILED_SP))
+	d.SetId(helper.IdFormat(targetGroupId, listenerId, clbId, locationId))
 
-	return resourceTencentCloudClbTargetGroupAttachmentRead(d, meta)
+	return resourceTencentCloudClbTargetGroupAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbTargetGroupAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbTargetGroupAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_target_group_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -202,19 +208,19 @@ func resourceTencentCloudClbTargetGroupAttachmentRead(d *schema.ResourceData, me
 			client: meta.(*TencentCloudClient).apiV3Conn,
 		}
 		logId = getLogId(contextNil)
-		ctx   = context.WithValue(context.TODO(), logIdKey, logId)
 		id    = d.Id()
 		has   bool
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	ids := strings.Split(id, FILED_SP)
-	if len(ids) != 4 {
-		return fmt.Errorf("CLB target group attachment id is clb_id#listener_id#target_group_id#rule_id(only required for 7 layer CLB)")
+	ids, err := helper.IdParseN(id, 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	has, err := clbService.DescribeAssociateTargetGroups(ctx, ids)
+	has, err = clbService.DescribeAssociateTargetGroups(ctx, ids)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -231,12 +237,12 @@ func resourceTencentCloudClbTargetGroupAttachmentRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudClbTargetGroupAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbTargetGroupAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_target_group_attachment.update")()
-	return resourceTencentCloudClbTargetGroupAttachmentRead(d, meta)
+	return resourceTencentCloudClbTargetGroupAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbTargetGroupAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbTargetGroupAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_target_group_attachment.delete")()
 
 	var (
@@ -244,23 +250,23 @@ func resourceTencentCloudClbTargetGroupAttachmentDelete(d *schema.ResourceData,
 			client: meta.(*TencentCloudClient).apiV3Conn,
 		}
 		logId       = getLogId(contextNil)
-		ctx         = context.WithValue(context.TODO(), logIdKey, logId)
 		id          = d.Id()
 		targetInfos []*clb.TargetGroupInfo
 		err         error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	ids := strings.Split(id, FILED_SP)
-	if len(ids) != 4 {
-		return fmt.Errorf("CLB target group attachment id is clb_id#listener_id#target_group_id#rule_id(only required for 7 layer CLB)")
+	ids, err := helper.IdParseN(id, 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	if err := clbService.DisassociateTargetGroups(ctx, ids[0], ids[1], ids[2], ids[3]); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// check status
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		targetInfos, err = clbService.DescribeTargetGroups(ctx, ids[0], nil)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -291,7 +297,7 @@ func resourceTencentCloudClbTargetGroupAttachmentDelete(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
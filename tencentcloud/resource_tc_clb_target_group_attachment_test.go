@@ -3,12 +3,12 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 const clbTargetGroupAttachment = "tencentcloud_clb_target_group_attachment.group"
@@ -74,7 +74,7 @@ func testAccCheckClbTargetGroupAttachmentDestroy(s *terraform.State) error {
 			continue
 		}
 
-		ids := strings.Split(rs.Primary.ID, FILED_SP)
+		ids := helper.IdParse(rs.Primary.ID)
 		if len(ids) != 4 {
 			return fmt.Errorf("CLB target group attachment id is clb_id#listener_id#target_group_id#rule_id(only required for 7 layer CLB)")
 		}
@@ -120,7 +120,7 @@ func testAccCheckClbTargetGroupAttachmentExists(n string) resource.TestCheckFunc
 			client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn,
 		}
 
-		ids := strings.Split(rs.Primary.ID, FILED_SP)
+		ids := helper.IdParse(rs.Primary.ID)
 		if len(ids) != 4 {
 			return fmt.Errorf("CLB target group attachment id is clb_id#listener_id#target_group_id#rule_id(only required for 7 layer CLB)")
 		}
@@ -88,13 +88,12 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -102,12 +101,12 @@ import (
 
 func resourceTencentCloudClbTGAttachmentInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbTGAttachmentInstanceCreate,
-		Read:   resourceTencentCloudClbTGAttachmentInstanceRead,
-		Update: resourceTencentCloudClbTGAttachmentInstanceUpdate,
-		Delete: resourceTencentCloudClbTGAttachmentInstanceDelete,
+		CreateContext: resourceTencentCloudClbTGAttachmentInstanceCreate,
+		ReadContext:   resourceTencentCloudClbTGAttachmentInstanceRead,
+		UpdateContext: resourceTencentCloudClbTGAttachmentInstanceUpdate,
+		DeleteContext: resourceTencentCloudClbTGAttachmentInstanceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"target_group_id": {
@@ -139,12 +138,11 @@ func resourceTencentCloudClbTGAttachmentInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClbTGAttachmentInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbTGAttachmentInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_target_group_instance_attachment.create")()
 
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		clbService    = ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		targetGroupId = d.Get("target_group_id").(string)
 		bindIp        = d.Get("bind_ip").(string)
@@ -152,44 +150,45 @@ func resourceTencentCloudClbTGAttachmentInstanceCreate(d *schema.ResourceData, m
 		weight        = d.Get("weight").(int)
 		err           error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	err = clbService.RegisterTargetInstances(ctx, targetGroupId, bindIp, uint64(port), uint64(weight))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	time.Sleep(time.Duration(3) * time.Second)
 
-	d.SetId(strings.Join([]string{targetGroupId, bindIp, strconv.Itoa(port)}, FILED_SP))
+	d.SetId(helper.IdFormat(targetGroupId, bindIp, strconv.Itoa(port)))
 
-	return resourceTencentCloudClbTGAttachmentInstanceRead(d, meta)
+	return resourceTencentCloudClbTGAttachmentInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbTGAttachmentInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbTGAttachmentInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_target_group_instance_attachment.read")()
 
 	var (
 		logId                = getLogId(contextNil)
-		ctx                  = context.WithValue(context.TODO(), logIdKey, logId)
 		clbService           = ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id                   = d.Id()
 		targetGroupInstances []*clb.TargetGroupBackend
 	)
-	idSplit := strings.Split(id, FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("target group instance attachment id is not set")
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	idSplit, err := helper.IdParseN(id, 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	targetGroupId := idSplit[0]
 	bindIp := idSplit[1]
 	port, err := strconv.ParseUint(idSplit[2], 0, 64)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	filters := make(map[string]string)
 	filters["TargetGroupId"] = targetGroupId
 	filters["BindIP"] = bindIp
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		targetGroupInstances, err = clbService.DescribeTargetGroupInstances(ctx, filters)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -197,7 +196,7 @@ func resourceTencentCloudClbTGAttachmentInstanceRead(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, tgInstance := range targetGroupInstances {
 		if *tgInstance.Port == port {
@@ -211,63 +210,63 @@ func resourceTencentCloudClbTGAttachmentInstanceRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudClbTGAttachmentInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbTGAttachmentInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_target_group_instance_attachment.update")()
 
 	var (
 		logId                 = getLogId(contextNil)
-		ctx                   = context.WithValue(context.TODO(), logIdKey, logId)
 		clbService            = ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id                    = d.Id()
 		port                  int
 		bindIp, targetGroupId string
 		err                   error
 	)
-	idSplit := strings.Split(id, FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("target group instance attachment id is not set")
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	idSplit, err := helper.IdParseN(id, 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	targetGroupId = idSplit[0]
 	bindIp = idSplit[1]
 	port, err = strconv.Atoi(idSplit[2])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("weight") {
 		newWeight := d.Get("weight").(int)
 		err := clbService.ModifyTargetGroupInstancesWeight(ctx, targetGroupId, bindIp, uint64(port), uint64(newWeight))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudClbTGAttachmentInstanceRead(d, meta)
+	return resourceTencentCloudClbTGAttachmentInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClbTGAttachmentInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClbTGAttachmentInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb_target_group_instance_attachment.delete")()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		clbService = ClbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id         = d.Id()
 	)
-	idSplit := strings.Split(id, FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("target group instance attachment id is not set")
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	idSplit, err := helper.IdParseN(id, 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	targetGroupId := idSplit[0]
 	bindIp := idSplit[1]
 	port, err := strconv.ParseUint(idSplit[2], 0, 64)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = clbService.DeregisterTargetInstances(ctx, targetGroupId, bindIp, port)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -24,21 +24,21 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudClickhouseAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClickhouseAccountCreate,
-		Read:   resourceTencentCloudClickhouseAccountRead,
-		Update: resourceTencentCloudClickhouseAccountUpdate,
-		Delete: resourceTencentCloudClickhouseAccountDelete,
+		CreateContext: resourceTencentCloudClickhouseAccountCreate,
+		ReadContext:   resourceTencentCloudClickhouseAccountRead,
+		UpdateContext: resourceTencentCloudClickhouseAccountUpdate,
+		DeleteContext: resourceTencentCloudClickhouseAccountDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -66,12 +66,12 @@ func resourceTencentCloudClickhouseAccount() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClickhouseAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_account.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	instanceId := d.Get("instance_id").(string)
 	userName := d.Get("user_name").(string)
 	params := make(map[string]interface{})
@@ -84,27 +84,27 @@ func resourceTencentCloudClickhouseAccountCreate(d *schema.ResourceData, meta in
 	service := CdwchService{client: meta.(*TencentCloudClient).apiV3Conn}
 	err := service.ActionAlterCkUser(ctx, ACTION_ALTER_CK_USER_ADD_SYSTEM_USER, params)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	d.SetId(instanceId + FILED_SP + userName)
+	d.SetId(helper.IdFormat(instanceId, userName))
 
-	return resourceTencentCloudClickhouseAccountRead(d, meta)
+	return resourceTencentCloudClickhouseAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClickhouseAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_account.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := CdwchService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("tencentcloud_clickhouse_account id is broken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	accounts, err := service.DescribeClickhouseAccountByUserName(ctx, idSplit[0], idSplit[1])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(accounts) == 0 {
@@ -120,22 +120,22 @@ func resourceTencentCloudClickhouseAccountRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudClickhouseAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_account.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("tencentcloud_clickhouse_account id is broken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	immutableArgs := []string{"instance_id", "user_name", "describe"}
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -150,27 +150,27 @@ func resourceTencentCloudClickhouseAccountUpdate(d *schema.ResourceData, meta in
 		service := CdwchService{client: meta.(*TencentCloudClient).apiV3Conn}
 		err := service.ActionAlterCkUser(ctx, ACTION_ALTER_CK_USER_UPDATE_SYSTEM_USER, params)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudClickhouseAccountRead(d, meta)
+	return resourceTencentCloudClickhouseAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClickhouseAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_account.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := CdwchService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("tencentcloud_clickhouse_account id is broken, id is %s", d.Id())
+		return diag.Errorf("tencentcloud_clickhouse_account id is broken, id is %s", d.Id())
 	}
 
 	err := service.DescribeCkSqlApis(ctx, idSplit[0], "", idSplit[1], DESCRIBE_CK_SQL_APIS_DELETE_SYSTEM_USER)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -36,10 +36,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clickhouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdwch/v20200915"
@@ -48,12 +48,16 @@ import (
 
 func resourceTencentCloudClickhouseAccountPermission() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClickhouseAccountPermissionCreate,
-		Read:   resourceTencentCloudClickhouseAccountPermissionRead,
-		Update: resourceTencentCloudClickhouseAccountPermissionUpdate,
-		Delete: resourceTencentCloudClickhouseAccountPermissionDelete,
+		CreateContext: resourceTencentCloudClickhouseAccountPermissionCreate,
+		ReadContext:   resourceTencentCloudClickhouseAccountPermissionRead,
+		UpdateContext: resourceTencentCloudClickhouseAccountPermissionUpdate,
+		DeleteContext: resourceTencentCloudClickhouseAccountPermissionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -137,7 +141,7 @@ func resourceTencentCloudClickhouseAccountPermission() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClickhouseAccountPermissionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseAccountPermissionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_account_permission.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -198,8 +202,8 @@ func resourceTencentCloudClickhouseAccountPermissionCreate(d *schema.ResourceDat
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCdwchClient().ModifyUserNewPrivilege(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCdwchClient().ModifyUserNewPrivilegeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -209,30 +213,30 @@ func resourceTencentCloudClickhouseAccountPermissionCreate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cdwch accountPermission failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(instanceId + FILED_SP + cluster + FILED_SP + userName)
+	d.SetId(helper.IdFormat(instanceId, cluster, userName))
 
-	return resourceTencentCloudClickhouseAccountPermissionRead(d, meta)
+	return resourceTencentCloudClickhouseAccountPermissionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClickhouseAccountPermissionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseAccountPermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_account_permission.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := CdwchService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("tencentcloud_clickhouse_account id is broken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	accountPermission, err := service.DescribeCdwchAccountPermission(ctx, idSplit[0], idSplit[1], idSplit[2])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if accountPermission == nil {
@@ -303,7 +307,7 @@ func resourceTencentCloudClickhouseAccountPermissionRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudClickhouseAccountPermissionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseAccountPermissionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_account_permission.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -311,9 +315,9 @@ func resourceTencentCloudClickhouseAccountPermissionUpdate(d *schema.ResourceDat
 
 	request := clickhouse.NewModifyUserNewPrivilegeRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("tencentcloud_clickhouse_account id is broken, id is %s", d.Id())
+		return diag.Errorf("tencentcloud_clickhouse_account id is broken, id is %s", d.Id())
 	}
 	request.InstanceId = helper.String(idSplit[0])
 	request.Cluster = helper.String(idSplit[1])
@@ -323,7 +327,7 @@ func resourceTencentCloudClickhouseAccountPermissionUpdate(d *schema.ResourceDat
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -376,8 +380,8 @@ func resourceTencentCloudClickhouseAccountPermissionUpdate(d *schema.ResourceDat
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCdwchClient().ModifyUserNewPrivilege(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCdwchClient().ModifyUserNewPrivilegeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -387,28 +391,28 @@ func resourceTencentCloudClickhouseAccountPermissionUpdate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update cdwch accountPermission failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudClickhouseAccountPermissionRead(d, meta)
+	return resourceTencentCloudClickhouseAccountPermissionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClickhouseAccountPermissionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseAccountPermissionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_account_permission.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("tencentcloud_clickhouse_account id is broken, id is %s", d.Id())
+		return diag.Errorf("tencentcloud_clickhouse_account id is broken, id is %s", d.Id())
 	}
 
 	service := CdwchService{client: meta.(*TencentCloudClient).apiV3Conn}
 	err := service.DescribeCkSqlApis(ctx, idSplit[0], idSplit[1], idSplit[2], DESCRIBE_CK_SQL_APIS_REVOKE_CLUSTER_USER)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -22,9 +22,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clickhouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdwch/v20200915"
@@ -33,12 +34,16 @@ import (
 
 func resourceTencentCloudClickhouseBackup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClickhouseBackupCreate,
-		Read:   resourceTencentCloudClickhouseBackupRead,
-		Update: resourceTencentCloudClickhouseBackupUpdate,
-		Delete: resourceTencentCloudClickhouseBackupDelete,
+		CreateContext: resourceTencentCloudClickhouseBackupCreate,
+		ReadContext:   resourceTencentCloudClickhouseBackupRead,
+		UpdateContext: resourceTencentCloudClickhouseBackupUpdate,
+		DeleteContext: resourceTencentCloudClickhouseBackupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -56,7 +61,7 @@ func resourceTencentCloudClickhouseBackup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClickhouseBackupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_backup.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -77,8 +82,8 @@ func resourceTencentCloudClickhouseBackupCreate(d *schema.ResourceData, meta int
 		request.CosBucketName = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCdwchClient().OpenBackUp(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCdwchClient().OpenBackUpWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -88,21 +93,21 @@ func resourceTencentCloudClickhouseBackupCreate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create clickhouse backup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudClickhouseBackupRead(d, meta)
+	return resourceTencentCloudClickhouseBackupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClickhouseBackupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_backup.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CdwchService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -110,7 +115,7 @@ func resourceTencentCloudClickhouseBackupRead(d *schema.ResourceData, meta inter
 
 	backup, err := service.DescribeBackUpScheduleById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if backup == nil {
@@ -128,7 +133,7 @@ func resourceTencentCloudClickhouseBackupRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudClickhouseBackupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseBackupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_backup.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -136,13 +141,13 @@ func resourceTencentCloudClickhouseBackupUpdate(d *schema.ResourceData, meta int
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
-	return resourceTencentCloudClickhouseBackupRead(d, meta)
+	return resourceTencentCloudClickhouseBackupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClickhouseBackupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_backup.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -153,8 +158,8 @@ func resourceTencentCloudClickhouseBackupDelete(d *schema.ResourceData, meta int
 	request.OperationType = helper.String("close")
 	request.CosBucketName = helper.String(d.Get("cos_bucket_name").(string))
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCdwchClient().OpenBackUp(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCdwchClient().OpenBackUpWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -164,7 +169,7 @@ func resourceTencentCloudClickhouseBackupDelete(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create clickhouse backup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -43,20 +43,20 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTencentCloudClickhouseBackupStrategy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClickhouseBackupStrategyCreate,
-		Read:   resourceTencentCloudClickhouseBackupStrategyRead,
-		Update: resourceTencentCloudClickhouseBackupStrategyUpdate,
-		Delete: resourceTencentCloudClickhouseBackupStrategyDelete,
+		CreateContext: resourceTencentCloudClickhouseBackupStrategyCreate,
+		ReadContext:   resourceTencentCloudClickhouseBackupStrategyRead,
+		UpdateContext: resourceTencentCloudClickhouseBackupStrategyUpdate,
+		DeleteContext: resourceTencentCloudClickhouseBackupStrategyDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -174,21 +174,21 @@ func resourceTencentCloudClickhouseBackupStrategy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClickhouseBackupStrategyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseBackupStrategyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_backup_strategy.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	instanceId := d.Get("instance_id").(string)
 
 	service := CdwchService{client: meta.(*TencentCloudClient).apiV3Conn}
 	backUpSchedule, err := service.DescribeBackUpScheduleById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if backUpSchedule == nil || backUpSchedule.MetaStrategy == nil || backUpSchedule.MetaStrategy.ScheduleId == nil {
-		return fmt.Errorf("Can't fetch scheduleId!")
+		return diag.Errorf("Can't fetch scheduleId!")
 	}
 	paramMap := make(map[string]interface{})
 
@@ -212,20 +212,20 @@ func resourceTencentCloudClickhouseBackupStrategyCreate(d *schema.ResourceData,
 	err = service.CreateBackUpSchedule(ctx, instanceId, paramMap)
 	if err != nil {
 		log.Printf("[CRITAL]%s create clickhouse data backup strategy failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(instanceId)
 
-	return resourceTencentCloudClickhouseBackupStrategyUpdate(d, meta)
+	return resourceTencentCloudClickhouseBackupStrategyUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudClickhouseBackupStrategyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseBackupStrategyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_backup_strategy.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CdwchService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -234,7 +234,7 @@ func resourceTencentCloudClickhouseBackupStrategyRead(d *schema.ResourceData, me
 
 	backUpSchedule, err := service.DescribeBackUpScheduleById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if backUpSchedule == nil {
@@ -301,23 +301,23 @@ func resourceTencentCloudClickhouseBackupStrategyRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudClickhouseBackupStrategyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseBackupStrategyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_backup_strategy.update")()
 	defer inconsistentCheck(d, meta)()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := CdwchService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instanceId := d.Id()
 
 	backUpSchedule, err := service.DescribeBackUpScheduleById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if backUpSchedule == nil || backUpSchedule.MetaStrategy == nil || backUpSchedule.MetaStrategy.ScheduleId == nil {
-		return fmt.Errorf("Can't fetch meta scheduleId!")
+		return diag.Errorf("Can't fetch meta scheduleId!")
 	}
 	if backUpSchedule == nil || backUpSchedule.DataStrategy == nil || backUpSchedule.DataStrategy.ScheduleId == nil {
-		return fmt.Errorf("Can't fetch data scheduleId!")
+		return diag.Errorf("Can't fetch data scheduleId!")
 	}
 
 	if d.HasChange("data_backup_strategy") {
@@ -344,7 +344,7 @@ func resourceTencentCloudClickhouseBackupStrategyUpdate(d *schema.ResourceData,
 		err = service.CreateBackUpSchedule(ctx, instanceId, paramMap)
 		if err != nil {
 			log.Printf("[CRITAL]%s create clickhouse data backup strategy failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -370,16 +370,16 @@ func resourceTencentCloudClickhouseBackupStrategyUpdate(d *schema.ResourceData,
 			err = service.CreateBackUpSchedule(ctx, instanceId, paramMap)
 			if err != nil {
 				log.Printf("[CRITAL]%s create clickhouse meta backup strategy failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 	}
 
-	return resourceTencentCloudClickhouseBackupStrategyRead(d, meta)
+	return resourceTencentCloudClickhouseBackupStrategyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClickhouseBackupStrategyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseBackupStrategyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_backup_strategy.delete")()
 	defer inconsistentCheck(d, meta)()
 
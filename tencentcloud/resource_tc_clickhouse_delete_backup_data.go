@@ -13,8 +13,11 @@ resource "tencentcloud_clickhouse_delete_backup_data" "delete_back_up_data" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clickhouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdwch/v20200915"
@@ -23,9 +26,12 @@ import (
 
 func resourceTencentCloudClickhouseDeleteBackupData() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClickhouseDeleteBackupDataCreate,
-		Read:   resourceTencentCloudClickhouseDeleteBackupDataRead,
-		Delete: resourceTencentCloudClickhouseDeleteBackupDataDelete,
+		CreateContext: resourceTencentCloudClickhouseDeleteBackupDataCreate,
+		ReadContext:   resourceTencentCloudClickhouseDeleteBackupDataRead,
+		DeleteContext: resourceTencentCloudClickhouseDeleteBackupDataDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -44,7 +50,7 @@ func resourceTencentCloudClickhouseDeleteBackupData() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClickhouseDeleteBackupDataCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseDeleteBackupDataCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_delete_backup_data.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -65,8 +71,8 @@ func resourceTencentCloudClickhouseDeleteBackupDataCreate(d *schema.ResourceData
 		request.BackUpJobId = helper.IntInt64(backUpJobId)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCdwchClient().DeleteBackUpData(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCdwchClient().DeleteBackUpDataWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -76,22 +82,22 @@ func resourceTencentCloudClickhouseDeleteBackupDataCreate(d *schema.ResourceData
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate clickhouse deleteBackUpData failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId + helper.IntToStr(backUpJobId))
 
-	return resourceTencentCloudClickhouseDeleteBackupDataRead(d, meta)
+	return resourceTencentCloudClickhouseDeleteBackupDataRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClickhouseDeleteBackupDataRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseDeleteBackupDataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_delete_backup_data.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudClickhouseDeleteBackupDataDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseDeleteBackupDataDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_delete_backup_data.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -66,7 +66,9 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdwch "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdwch/v20200915"
@@ -75,12 +77,16 @@ import (
 
 func resourceTencentCloudClickhouseInstance() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudClickhouseInstanceRead,
-		Create: resourceTencentCloudClickhouseInstanceCreate,
-		Update: resourceTencentCloudClickhouseInstanceUpdate,
-		Delete: resourceTencentCloudClickhouseInstanceDelete,
+		ReadContext:   resourceTencentCloudClickhouseInstanceRead,
+		CreateContext: resourceTencentCloudClickhouseInstanceCreate,
+		UpdateContext: resourceTencentCloudClickhouseInstanceUpdate,
+		DeleteContext: resourceTencentCloudClickhouseInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -224,13 +230,13 @@ func resourceTencentCloudClickhouseInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClickhouseInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CdwchService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -238,7 +244,7 @@ func resourceTencentCloudClickhouseInstanceRead(d *schema.ResourceData, meta int
 
 	instanceInfos, err := service.DescribeInstancesNew(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(instanceInfos) == 0 {
@@ -250,7 +256,7 @@ func resourceTencentCloudClickhouseInstanceRead(d *schema.ResourceData, meta int
 	_ = d.Set("zone", instanceInfo.Zone)
 	haFlag, err := strconv.ParseBool(*instanceInfo.HA)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("ha_flag", haFlag)
 	_ = d.Set("vpc_id", instanceInfo.VpcId)
@@ -287,14 +293,14 @@ func resourceTencentCloudClickhouseInstanceRead(d *schema.ResourceData, meta int
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "cdwch", "cdwchInstance", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudClickhouseInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cdwch_tmp_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -385,8 +391,8 @@ func resourceTencentCloudClickhouseInstanceCreate(d *schema.ResourceData, meta i
 		request.CommonSpec = &nodeSpec
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCdwchClient().CreateInstanceNew(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCdwchClient().CreateInstanceNewWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -397,13 +403,13 @@ func resourceTencentCloudClickhouseInstanceCreate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cdwch tmpInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	instanceId = *response.Response.InstanceId
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := CdwchService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instanceInfo, innerErr := service.DescribeInstance(ctx, instanceId)
 		if innerErr != nil {
 			return retryError(innerErr)
@@ -414,7 +420,7 @@ func resourceTencentCloudClickhouseInstanceCreate(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
@@ -422,21 +428,21 @@ func resourceTencentCloudClickhouseInstanceCreate(d *schema.ResourceData, meta i
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::cdwch:%s:uin/:cdwchInstance/%s", region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudClickhouseInstanceRead(d, meta)
+	return resourceTencentCloudClickhouseInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClickhouseInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if d.HasChange("tags") {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
@@ -445,7 +451,7 @@ func resourceTencentCloudClickhouseInstanceUpdate(d *schema.ResourceData, meta i
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("cdwch", "cdwchInstance", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -453,29 +459,29 @@ func resourceTencentCloudClickhouseInstanceUpdate(d *schema.ResourceData, meta i
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
-	return resourceTencentCloudClickhouseInstanceRead(d, meta)
+	return resourceTencentCloudClickhouseInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClickhouseInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CdwchService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instanceId := d.Id()
 
 	if d.Get("charge_type").(string) == "PREPAID" {
 		if err := service.DestroyInstance(ctx, instanceId); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err := resource.Retry(5*writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			instanceInfo, innerErr := service.DescribeInstance(ctx, instanceId)
 			if innerErr != nil {
 				return retryError(innerErr)
@@ -486,14 +492,14 @@ func resourceTencentCloudClickhouseInstanceDelete(d *schema.ResourceData, meta i
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if err := service.DestroyInstance(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		instancesList, innerErr := service.DescribeInstancesNew(ctx, instanceId)
 		if innerErr != nil {
 			return retryError(innerErr)
@@ -504,7 +510,7 @@ func resourceTencentCloudClickhouseInstanceDelete(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -13,8 +13,11 @@ resource "tencentcloud_clickhouse_recover_backup_job" "recover_backup_job" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clickhouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdwch/v20200915"
@@ -23,9 +26,12 @@ import (
 
 func resourceTencentCloudClickhouseRecoverBackupJob() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClickhouseRecoverBackupJobCreate,
-		Read:   resourceTencentCloudClickhouseRecoverBackupJobRead,
-		Delete: resourceTencentCloudClickhouseRecoverBackupJobDelete,
+		CreateContext: resourceTencentCloudClickhouseRecoverBackupJobCreate,
+		ReadContext:   resourceTencentCloudClickhouseRecoverBackupJobRead,
+		DeleteContext: resourceTencentCloudClickhouseRecoverBackupJobDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -44,7 +50,7 @@ func resourceTencentCloudClickhouseRecoverBackupJob() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClickhouseRecoverBackupJobCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseRecoverBackupJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_recover_backup_job.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -65,8 +71,8 @@ func resourceTencentCloudClickhouseRecoverBackupJobCreate(d *schema.ResourceData
 		request.BackUpJobId = helper.IntInt64(backUpJobId)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCdwchClient().RecoverBackUpJob(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCdwchClient().RecoverBackUpJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -76,22 +82,22 @@ func resourceTencentCloudClickhouseRecoverBackupJobCreate(d *schema.ResourceData
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate clickhouse RecoverBackupJob failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId + helper.IntToStr(backUpJobId))
 
-	return resourceTencentCloudClickhouseRecoverBackupJobRead(d, meta)
+	return resourceTencentCloudClickhouseRecoverBackupJobRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClickhouseRecoverBackupJobRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseRecoverBackupJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_recover_backup_job.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudClickhouseRecoverBackupJobDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClickhouseRecoverBackupJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clickhouse_recover_backup_job.delete")()
 	defer inconsistentCheck(d, meta)()
 
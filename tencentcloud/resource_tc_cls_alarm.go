@@ -59,7 +59,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
@@ -68,12 +70,16 @@ import (
 
 func resourceTencentCloudClsAlarm() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsAlarmCreate,
-		Read:   resourceTencentCloudClsAlarmRead,
-		Update: resourceTencentCloudClsAlarmUpdate,
-		Delete: resourceTencentCloudClsAlarmDelete,
+		CreateContext: resourceTencentCloudClsAlarmCreate,
+		ReadContext:   resourceTencentCloudClsAlarmRead,
+		UpdateContext: resourceTencentCloudClsAlarmUpdate,
+		DeleteContext: resourceTencentCloudClsAlarmDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -259,7 +265,7 @@ func resourceTencentCloudClsAlarm() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClsAlarmCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsAlarmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_alarm.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -384,8 +390,8 @@ func resourceTencentCloudClsAlarmCreate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateAlarm(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateAlarmWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -396,32 +402,32 @@ func resourceTencentCloudClsAlarmCreate(d *schema.ResourceData, meta interface{}
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cls alarm failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	alarmId = *response.Response.AlarmId
 	d.SetId(alarmId)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::cls:%s:uin/:alarm/%s", region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudClsAlarmRead(d, meta)
+	return resourceTencentCloudClsAlarmRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsAlarmRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsAlarmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_alarm.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -429,7 +435,7 @@ func resourceTencentCloudClsAlarmRead(d *schema.ResourceData, meta interface{})
 
 	alarm, err := service.DescribeClsAlarmById(ctx, alarmId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if alarm == nil {
@@ -577,14 +583,14 @@ func resourceTencentCloudClsAlarmRead(d *schema.ResourceData, meta interface{})
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "cls", "alarm", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudClsAlarmUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsAlarmUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_alarm.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -726,8 +732,8 @@ func resourceTencentCloudClsAlarmUpdate(d *schema.ResourceData, meta interface{}
 			}
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyAlarm(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyAlarmWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -737,37 +743,37 @@ func resourceTencentCloudClsAlarmUpdate(d *schema.ResourceData, meta interface{}
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update cls alarm failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("tags") {
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		oldTags, newTags := d.GetChange("tags")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("cls", "alarm", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudClsAlarmRead(d, meta)
+	return resourceTencentCloudClsAlarmRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsAlarmDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsAlarmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_alarm.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	alarmId := d.Id()
 
 	if err := service.DeleteClsAlarmById(ctx, alarmId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -41,7 +41,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
@@ -50,12 +52,16 @@ import (
 
 func resourceTencentCloudClsAlarmNotice() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsAlarmNoticeCreate,
-		Read:   resourceTencentCloudClsAlarmNoticeRead,
-		Update: resourceTencentCloudClsAlarmNoticeUpdate,
-		Delete: resourceTencentCloudClsAlarmNoticeDelete,
+		CreateContext: resourceTencentCloudClsAlarmNoticeCreate,
+		ReadContext:   resourceTencentCloudClsAlarmNoticeRead,
+		UpdateContext: resourceTencentCloudClsAlarmNoticeUpdate,
+		DeleteContext: resourceTencentCloudClsAlarmNoticeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -168,7 +174,7 @@ func resourceTencentCloudClsAlarmNotice() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClsAlarmNoticeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsAlarmNoticeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_alarm_notice.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -251,8 +257,8 @@ func resourceTencentCloudClsAlarmNoticeCreate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateAlarmNotice(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateAlarmNoticeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -263,32 +269,32 @@ func resourceTencentCloudClsAlarmNoticeCreate(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cls alarmNotice failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	alarmNoticeId = *response.Response.AlarmNoticeId
 	d.SetId(alarmNoticeId)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::cls:%s:uin/:alarmNotice/%s", region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudClsAlarmNoticeRead(d, meta)
+	return resourceTencentCloudClsAlarmNoticeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsAlarmNoticeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsAlarmNoticeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_alarm_notice.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -296,7 +302,7 @@ func resourceTencentCloudClsAlarmNoticeRead(d *schema.ResourceData, meta interfa
 
 	alarmNotice, err := service.DescribeClsAlarmNoticeById(ctx, alarmNoticeId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if alarmNotice == nil {
@@ -389,14 +395,14 @@ func resourceTencentCloudClsAlarmNoticeRead(d *schema.ResourceData, meta interfa
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "cls", "alarmNotice", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudClsAlarmNoticeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsAlarmNoticeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_alarm_notice.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -492,8 +498,8 @@ func resourceTencentCloudClsAlarmNoticeUpdate(d *schema.ResourceData, meta inter
 			}
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyAlarmNotice(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyAlarmNoticeWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -503,37 +509,37 @@ func resourceTencentCloudClsAlarmNoticeUpdate(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update cls alarmNotice failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("tags") {
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		oldTags, newTags := d.GetChange("tags")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("cls", "alarmNotice", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudClsAlarmNoticeRead(d, meta)
+	return resourceTencentCloudClsAlarmNoticeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsAlarmNoticeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsAlarmNoticeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_alarm_notice.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	alarmNoticeId := d.Id()
 
 	if err := service.DeleteClsAlarmNoticeById(ctx, alarmNoticeId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -46,7 +46,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
@@ -55,12 +57,16 @@ import (
 
 func resourceTencentCloudClsCkafkaConsumer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsCkafkaConsumerCreate,
-		Read:   resourceTencentCloudClsCkafkaConsumerRead,
-		Update: resourceTencentCloudClsCkafkaConsumerUpdate,
-		Delete: resourceTencentCloudClsCkafkaConsumerDelete,
+		CreateContext: resourceTencentCloudClsCkafkaConsumerCreate,
+		ReadContext:   resourceTencentCloudClsCkafkaConsumerRead,
+		UpdateContext: resourceTencentCloudClsCkafkaConsumerUpdate,
+		DeleteContext: resourceTencentCloudClsCkafkaConsumerDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"topic_id": {
@@ -160,7 +166,7 @@ func resourceTencentCloudClsCkafkaConsumer() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClsCkafkaConsumerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsCkafkaConsumerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_ckafka_consumer.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -227,8 +233,8 @@ func resourceTencentCloudClsCkafkaConsumerCreate(d *schema.ResourceData, meta in
 		request.Compression = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateConsumer(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateConsumerWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -238,21 +244,21 @@ func resourceTencentCloudClsCkafkaConsumerCreate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cls ckafkaConsumer failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(topicId)
 
-	return resourceTencentCloudClsCkafkaConsumerRead(d, meta)
+	return resourceTencentCloudClsCkafkaConsumerRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsCkafkaConsumerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsCkafkaConsumerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_ckafka_consumer.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -260,7 +266,7 @@ func resourceTencentCloudClsCkafkaConsumerRead(d *schema.ResourceData, meta inte
 
 	ckafkaConsumer, err := service.DescribeClsCkafkaConsumerById(ctx, topicId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ckafkaConsumer == nil {
@@ -334,7 +340,7 @@ func resourceTencentCloudClsCkafkaConsumerRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudClsCkafkaConsumerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsCkafkaConsumerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_ckafka_consumer.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -410,8 +416,8 @@ func resourceTencentCloudClsCkafkaConsumerUpdate(d *schema.ResourceData, meta in
 			request.Compression = helper.IntInt64(v.(int))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyConsumer(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyConsumerWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -421,25 +427,25 @@ func resourceTencentCloudClsCkafkaConsumerUpdate(d *schema.ResourceData, meta in
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update cls ckafkaConsumer failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudClsCkafkaConsumerRead(d, meta)
+	return resourceTencentCloudClsCkafkaConsumerRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsCkafkaConsumerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsCkafkaConsumerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_ckafka_consumer.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	topicId := d.Id()
 
 	if err := service.DeleteClsCkafkaConsumerById(ctx, topicId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
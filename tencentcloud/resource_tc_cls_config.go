@@ -46,9 +46,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
@@ -57,12 +58,16 @@ import (
 
 func resourceTencentCloudClsConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsConfigCreate,
-		Read:   resourceTencentCloudClsConfigRead,
-		Delete: resourceTencentCloudClsConfigDelete,
-		Update: resourceTencentCloudClsConfigUpdate,
+		CreateContext: resourceTencentCloudClsConfigCreate,
+		ReadContext:   resourceTencentCloudClsConfigRead,
+		DeleteContext: resourceTencentCloudClsConfigDelete,
+		UpdateContext: resourceTencentCloudClsConfigUpdate,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -243,7 +248,7 @@ func resourceTencentCloudClsConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClsConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_config.create")()
 
 	logId := getLogId(contextNil)
@@ -268,7 +273,7 @@ func resourceTencentCloudClsConfigCreate(d *schema.ResourceData, meta interface{
 	if v, ok := d.GetOk("extract_rule"); ok {
 		extractRules := make([]*cls.ExtractRuleInfo, 0, 10)
 		if len(v.([]interface{})) != 1 {
-			return fmt.Errorf("need only one extract rule.")
+			return diag.Errorf("need only one extract rule.")
 		}
 		extractRule := cls.ExtractRuleInfo{}
 		dMap := v.([]interface{})[0].(map[string]interface{})
@@ -373,8 +378,8 @@ func resourceTencentCloudClsConfigCreate(d *schema.ResourceData, meta interface{
 		request.UserDefineRule = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -387,21 +392,21 @@ func resourceTencentCloudClsConfigCreate(d *schema.ResourceData, meta interface{
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cls config extra failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := *response.Response.ConfigId
 	d.SetId(id)
-	return resourceTencentCloudClsConfigRead(d, meta)
+	return resourceTencentCloudClsConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -409,7 +414,7 @@ func resourceTencentCloudClsConfigRead(d *schema.ResourceData, meta interface{})
 
 	config, err := service.DescribeClsConfigById(ctx, configId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if config == nil {
@@ -568,7 +573,7 @@ func resourceTencentCloudClsConfigRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudClsConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_config.update")()
 	logId := getLogId(contextNil)
 	request := cls.NewModifyConfigRequest()
@@ -597,7 +602,7 @@ func resourceTencentCloudClsConfigUpdate(d *schema.ResourceData, meta interface{
 		if v, ok := d.GetOk("extract_rule"); ok {
 			extractRules := make([]*cls.ExtractRuleInfo, 0, 10)
 			if len(v.([]interface{})) != 1 {
-				return fmt.Errorf("need only one extract rule.")
+				return diag.Errorf("need only one extract rule.")
 			}
 			extractRule := cls.ExtractRuleInfo{}
 			dMap := v.([]interface{})[0].(map[string]interface{})
@@ -708,8 +713,8 @@ func resourceTencentCloudClsConfigUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -720,22 +725,22 @@ func resourceTencentCloudClsConfigUpdate(d *schema.ResourceData, meta interface{
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudClsConfigRead(d, meta)
+	return resourceTencentCloudClsConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_config.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	id := d.Id()
 
 	if err := service.DeleteClsConfig(ctx, id); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -23,10 +23,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
@@ -35,11 +35,15 @@ import (
 
 func resourceTencentCloudClsConfigAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsConfigAttachmentCreate,
-		Read:   resourceTencentCloudClsConfigAttachmentRead,
-		Delete: resourceTencentCloudClsConfigAttachmentDelete,
+		CreateContext: resourceTencentCloudClsConfigAttachmentCreate,
+		ReadContext:   resourceTencentCloudClsConfigAttachmentRead,
+		DeleteContext: resourceTencentCloudClsConfigAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"config_id": {
@@ -58,7 +62,7 @@ func resourceTencentCloudClsConfigAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClsConfigAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsConfigAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_config_attachment.create")()
 
 	logId := getLogId(contextNil)
@@ -79,8 +83,8 @@ func resourceTencentCloudClsConfigAttachmentCreate(d *schema.ResourceData, meta
 		request.GroupId = helper.String(groupId)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ApplyConfigToMachineGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ApplyConfigToMachineGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -91,7 +95,7 @@ func resourceTencentCloudClsConfigAttachmentCreate(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId := helper.IdFormat(configId, groupId)
@@ -100,23 +104,23 @@ func resourceTencentCloudClsConfigAttachmentCreate(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudClsConfigAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsConfigAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_config_attachment.read")()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	configId := idSplit[0]
 	groupId := idSplit[1]
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	machineGroup, err := service.DescribeClsMachineGroupByConfigId(ctx, configId, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if machineGroup == nil {
@@ -131,23 +135,23 @@ func resourceTencentCloudClsConfigAttachmentRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTencentCloudClsConfigAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsConfigAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_config_attachment.delete")()
 
 	logId := getLogId(contextNil)
 	request := cls.NewDeleteConfigFromMachineGroupRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	configId := idSplit[0]
 	groupId := idSplit[1]
 	request.GroupId = helper.String(groupId)
 	request.ConfigId = helper.String(configId)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().DeleteConfigFromMachineGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().DeleteConfigFromMachineGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -157,7 +161,7 @@ func resourceTencentCloudClsConfigAttachmentDelete(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
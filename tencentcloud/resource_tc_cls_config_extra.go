@@ -77,9 +77,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
@@ -88,12 +89,16 @@ import (
 
 func resourceTencentCloudClsConfigExtra() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsConfigExtraCreate,
-		Read:   resourceTencentCloudClsConfigExtraRead,
-		Delete: resourceTencentCloudClsConfigExtraDelete,
-		Update: resourceTencentCloudClsConfigExtraUpdate,
+		CreateContext: resourceTencentCloudClsConfigExtraCreate,
+		ReadContext:   resourceTencentCloudClsConfigExtraRead,
+		DeleteContext: resourceTencentCloudClsConfigExtraDelete,
+		UpdateContext: resourceTencentCloudClsConfigExtraUpdate,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -431,7 +436,7 @@ func resourceTencentCloudClsConfigExtra() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClsConfigExtraCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsConfigExtraCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_config_extra.create")()
 
 	logId := getLogId(contextNil)
@@ -468,7 +473,7 @@ func resourceTencentCloudClsConfigExtraCreate(d *schema.ResourceData, meta inter
 	if v, ok := d.GetOk("host_file"); ok {
 		hostFiles := make([]*cls.HostFileInfo, 0, 10)
 		if len(v.([]interface{})) != 1 {
-			return fmt.Errorf("need only one host file.")
+			return diag.Errorf("need only one host file.")
 		}
 		hostFile := cls.HostFileInfo{}
 		dMap := v.([]interface{})[0].(map[string]interface{})
@@ -490,7 +495,7 @@ func resourceTencentCloudClsConfigExtraCreate(d *schema.ResourceData, meta inter
 	if v, ok := d.GetOk("container_file"); ok {
 		containerFiles := make([]*cls.ContainerFileInfo, 0, 10)
 		if len(v.([]interface{})) != 1 {
-			return fmt.Errorf("need only one container file.")
+			return diag.Errorf("need only one container file.")
 		}
 		containerFile := cls.ContainerFileInfo{}
 		dMap := v.([]interface{})[0].(map[string]interface{})
@@ -515,7 +520,7 @@ func resourceTencentCloudClsConfigExtraCreate(d *schema.ResourceData, meta inter
 		if v, ok := dMap["workload"]; ok {
 			workloads := make([]*cls.ContainerWorkLoadInfo, 0, 10)
 			if len(v.([]interface{})) != 1 {
-				return fmt.Errorf("need only one workload.")
+				return diag.Errorf("need only one workload.")
 			}
 			workload := cls.ContainerWorkLoadInfo{}
 			dMap := v.([]interface{})[0].(map[string]interface{})
@@ -550,7 +555,7 @@ func resourceTencentCloudClsConfigExtraCreate(d *schema.ResourceData, meta inter
 	if v, ok := d.GetOk("container_stdout"); ok {
 		containerStdouts := make([]*cls.ContainerStdoutInfo, 0, 10)
 		if len(v.([]interface{})) != 1 {
-			return fmt.Errorf("need only one container file.")
+			return diag.Errorf("need only one container file.")
 		}
 		containerStdout := cls.ContainerStdoutInfo{}
 		dMap := v.([]interface{})[0].(map[string]interface{})
@@ -605,7 +610,7 @@ func resourceTencentCloudClsConfigExtraCreate(d *schema.ResourceData, meta inter
 	if v, ok := d.GetOk("extract_rule"); ok {
 		extractRules := make([]*cls.ExtractRuleInfo, 0, 10)
 		if len(v.([]interface{})) != 1 {
-			return fmt.Errorf("need only one extract rule.")
+			return diag.Errorf("need only one extract rule.")
 		}
 		extractRule := cls.ExtractRuleInfo{}
 		dMap := v.([]interface{})[0].(map[string]interface{})
@@ -685,8 +690,8 @@ func resourceTencentCloudClsConfigExtraCreate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateConfigExtra(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateConfigExtraWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -699,21 +704,21 @@ func resourceTencentCloudClsConfigExtraCreate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cls config extra failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := *response.Response.ConfigExtraId
 	d.SetId(id)
-	return resourceTencentCloudClsConfigExtraRead(d, meta)
+	return resourceTencentCloudClsConfigExtraRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsConfigExtraRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsConfigExtraRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_config_extra.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -721,7 +726,7 @@ func resourceTencentCloudClsConfigExtraRead(d *schema.ResourceData, meta interfa
 
 	configExtra, err := service.DescribeClsConfigExtraById(ctx, configExtraId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if configExtra == nil {
@@ -985,7 +990,7 @@ func resourceTencentCloudClsConfigExtraRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudClsConfigExtraUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsConfigExtraUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_config_extra.update")()
 	logId := getLogId(contextNil)
 	request := cls.NewModifyConfigExtraRequest()
@@ -1031,7 +1036,7 @@ func resourceTencentCloudClsConfigExtraUpdate(d *schema.ResourceData, meta inter
 		if v, ok := d.GetOk("host_file"); ok {
 			hostFiles := make([]*cls.HostFileInfo, 0, 10)
 			if len(v.([]interface{})) != 1 {
-				return fmt.Errorf("need only one host file.")
+				return diag.Errorf("need only one host file.")
 			}
 			hostFile := cls.HostFileInfo{}
 			dMap := v.([]interface{})[0].(map[string]interface{})
@@ -1055,7 +1060,7 @@ func resourceTencentCloudClsConfigExtraUpdate(d *schema.ResourceData, meta inter
 		if v, ok := d.GetOk("container_file"); ok {
 			containerFiles := make([]*cls.ContainerFileInfo, 0, 10)
 			if len(v.([]interface{})) != 1 {
-				return fmt.Errorf("need only one container file.")
+				return diag.Errorf("need only one container file.")
 			}
 			containerFile := cls.ContainerFileInfo{}
 			dMap := v.([]interface{})[0].(map[string]interface{})
@@ -1080,7 +1085,7 @@ func resourceTencentCloudClsConfigExtraUpdate(d *schema.ResourceData, meta inter
 			if v, ok := dMap["workload"]; ok {
 				workloads := make([]*cls.ContainerWorkLoadInfo, 0, 10)
 				if len(v.([]interface{})) != 1 {
-					return fmt.Errorf("need only one workload.")
+					return diag.Errorf("need only one workload.")
 				}
 				workload := cls.ContainerWorkLoadInfo{}
 				dMap := v.([]interface{})[0].(map[string]interface{})
@@ -1116,7 +1121,7 @@ func resourceTencentCloudClsConfigExtraUpdate(d *schema.ResourceData, meta inter
 		if v, ok := d.GetOk("container_stdout"); ok {
 			containerStdouts := make([]*cls.ContainerStdoutInfo, 0, 10)
 			if len(v.([]interface{})) != 1 {
-				return fmt.Errorf("need only one container file.")
+				return diag.Errorf("need only one container file.")
 			}
 			containerStdout := cls.ContainerStdoutInfo{}
 			dMap := v.([]interface{})[0].(map[string]interface{})
@@ -1179,7 +1184,7 @@ func resourceTencentCloudClsConfigExtraUpdate(d *schema.ResourceData, meta inter
 		if v, ok := d.GetOk("extract_rule"); ok {
 			extractRules := make([]*cls.ExtractRuleInfo, 0, 10)
 			if len(v.([]interface{})) != 1 {
-				return fmt.Errorf("need only one extract rule.")
+				return diag.Errorf("need only one extract rule.")
 			}
 			extractRule := cls.ExtractRuleInfo{}
 			dMap := v.([]interface{})[0].(map[string]interface{})
@@ -1259,8 +1264,8 @@ func resourceTencentCloudClsConfigExtraUpdate(d *schema.ResourceData, meta inter
 			request.GroupId = helper.String(v.(string))
 		}
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyConfigExtra(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyConfigExtraWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1271,22 +1276,22 @@ func resourceTencentCloudClsConfigExtraUpdate(d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudClsConfigExtraRead(d, meta)
+	return resourceTencentCloudClsConfigExtraRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsConfigExtraDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsConfigExtraDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_config.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	id := d.Id()
 
 	if err := service.DeleteClsConfigExtra(ctx, id); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
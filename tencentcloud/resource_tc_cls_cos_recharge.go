@@ -43,10 +43,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
@@ -55,12 +55,16 @@ import (
 
 func resourceTencentCloudClsCosRecharge() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsCosRechargeCreate,
-		Read:   resourceTencentCloudClsCosRechargeRead,
-		Update: resourceTencentCloudClsCosRechargeUpdate,
-		Delete: resourceTencentCloudClsCosRechargeDelete,
+		CreateContext: resourceTencentCloudClsCosRechargeCreate,
+		ReadContext:   resourceTencentCloudClsCosRechargeRead,
+		UpdateContext: resourceTencentCloudClsCosRechargeUpdate,
+		DeleteContext: resourceTencentCloudClsCosRechargeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"topic_id": {
@@ -248,7 +252,7 @@ func resourceTencentCloudClsCosRecharge() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClsCosRechargeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsCosRechargeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_cos_recharge.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -376,8 +380,8 @@ func resourceTencentCloudClsCosRechargeCreate(d *schema.ResourceData, meta inter
 		request.ExtractRuleInfo = &extractRuleInfo
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateCosRecharge(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateCosRechargeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -388,36 +392,36 @@ func resourceTencentCloudClsCosRechargeCreate(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cls cosRecharge failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	reChargeId = *response.Response.Id
 
-	d.SetId(topicId + FILED_SP + reChargeId)
+	d.SetId(helper.IdFormat(topicId, reChargeId))
 
-	return resourceTencentCloudClsCosRechargeRead(d, meta)
+	return resourceTencentCloudClsCosRechargeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsCosRechargeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsCosRechargeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_cos_recharge.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	topicId := idSplit[0]
 	rechargeId := idSplit[1]
 
 	cosRecharge, err := service.DescribeClsCosRechargeById(ctx, topicId, rechargeId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if cosRecharge == nil {
@@ -569,7 +573,7 @@ func resourceTencentCloudClsCosRechargeRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudClsCosRechargeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsCosRechargeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_cos_recharge.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -577,9 +581,9 @@ func resourceTencentCloudClsCosRechargeUpdate(d *schema.ResourceData, meta inter
 
 	request := cls.NewModifyCosRechargeRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	topicId := idSplit[0]
 	id := idSplit[1]
@@ -591,7 +595,7 @@ func resourceTencentCloudClsCosRechargeUpdate(d *schema.ResourceData, meta inter
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -604,8 +608,8 @@ func resourceTencentCloudClsCosRechargeUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyCosRecharge(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyCosRechargeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -615,13 +619,13 @@ func resourceTencentCloudClsCosRechargeUpdate(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update cls cosRecharge failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudClsCosRechargeRead(d, meta)
+	return resourceTencentCloudClsCosRechargeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsCosRechargeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsCosRechargeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_cos_recharge.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -44,9 +44,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
@@ -55,12 +56,16 @@ import (
 
 func resourceTencentCloudClsCosShipper() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsCosShipperCreate,
-		Read:   resourceTencentCloudClsCosShipperRead,
-		Delete: resourceTencentCloudClsCosShipperDelete,
-		Update: resourceTencentCloudClsCosShipperUpdate,
+		CreateContext: resourceTencentCloudClsCosShipperCreate,
+		ReadContext:   resourceTencentCloudClsCosShipperRead,
+		DeleteContext: resourceTencentCloudClsCosShipperDelete,
+		UpdateContext: resourceTencentCloudClsCosShipperUpdate,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"topic_id": {
@@ -214,7 +219,7 @@ func resourceTencentCloudClsCosShipper() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClsCosShipperCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsCosShipperCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_cos_shipper.create")()
 
 	logId := getLogId(contextNil)
@@ -274,7 +279,7 @@ func resourceTencentCloudClsCosShipperCreate(d *schema.ResourceData, meta interf
 	if v, ok := d.GetOk("compress"); ok {
 		compresses := make([]*cls.CompressInfo, 0, 10)
 		if len(v.([]interface{})) != 1 {
-			return fmt.Errorf("need only one compress.")
+			return diag.Errorf("need only one compress.")
 		}
 		for _, item := range v.([]interface{}) {
 			dMap := item.(map[string]interface{})
@@ -290,7 +295,7 @@ func resourceTencentCloudClsCosShipperCreate(d *schema.ResourceData, meta interf
 	if v, ok := d.GetOk("content"); ok {
 		contents := make([]*cls.ContentInfo, 0, 10)
 		if len(v.([]interface{})) != 1 {
-			return fmt.Errorf("need only one content.")
+			return diag.Errorf("need only one content.")
 		}
 		for _, item := range v.([]interface{}) {
 			dMap := item.(map[string]interface{})
@@ -331,8 +336,8 @@ func resourceTencentCloudClsCosShipperCreate(d *schema.ResourceData, meta interf
 		request.Content = contents[0]
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateShipper(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateShipperWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -345,20 +350,20 @@ func resourceTencentCloudClsCosShipperCreate(d *schema.ResourceData, meta interf
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cls cos shipper failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := *response.Response.ShipperId
 	d.SetId(id)
-	return resourceTencentCloudClsCosShipperRead(d, meta)
+	return resourceTencentCloudClsCosShipperRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsCosShipperRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsCosShipperRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_cos_shipper.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	id := d.Id()
@@ -366,12 +371,12 @@ func resourceTencentCloudClsCosShipperRead(d *schema.ResourceData, meta interfac
 	shipper, err := service.DescribeClsCosShipperById(ctx, id)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if shipper == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `Shipper` %s does not exist", id)
+		return diag.Errorf("resource `Shipper` %s does not exist", id)
 	}
 
 	_ = d.Set("topic_id", shipper.TopicId)
@@ -435,7 +440,7 @@ func resourceTencentCloudClsCosShipperRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudClsCosShipperUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsCosShipperUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_cos_shipper.update")()
 	logId := getLogId(contextNil)
 	request := cls.NewModifyShipperRequest()
@@ -503,7 +508,7 @@ func resourceTencentCloudClsCosShipperUpdate(d *schema.ResourceData, meta interf
 		if v, ok := d.GetOk("compress"); ok {
 			compresses := make([]*cls.CompressInfo, 0, 10)
 			if len(v.([]interface{})) != 1 {
-				return fmt.Errorf("need only one compress.")
+				return diag.Errorf("need only one compress.")
 			}
 			for _, item := range v.([]interface{}) {
 				dMap := item.(map[string]interface{})
@@ -521,7 +526,7 @@ func resourceTencentCloudClsCosShipperUpdate(d *schema.ResourceData, meta interf
 		if v, ok := d.GetOk("content"); ok {
 			contents := make([]*cls.ContentInfo, 0, 10)
 			if len(v.([]interface{})) != 1 {
-				return fmt.Errorf("need only one content.")
+				return diag.Errorf("need only one content.")
 			}
 			for _, item := range v.([]interface{}) {
 				dMap := item.(map[string]interface{})
@@ -563,8 +568,8 @@ func resourceTencentCloudClsCosShipperUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyShipper(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyShipperWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -575,22 +580,22 @@ func resourceTencentCloudClsCosShipperUpdate(d *schema.ResourceData, meta interf
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudClsCosShipperRead(d, meta)
+	return resourceTencentCloudClsCosShipperRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsCosShipperDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsCosShipperDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_cos_shipper.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	id := d.Id()
 
 	if err := service.DeleteClsCosShipper(ctx, id); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
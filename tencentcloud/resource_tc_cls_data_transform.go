@@ -67,9 +67,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
@@ -78,12 +79,16 @@ import (
 
 func resourceTencentCloudClsDataTransform() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsDataTransformCreate,
-		Read:   resourceTencentCloudClsDataTransformRead,
-		Update: resourceTencentCloudClsDataTransformUpdate,
-		Delete: resourceTencentCloudClsDataTransformDelete,
+		CreateContext: resourceTencentCloudClsDataTransformCreate,
+		ReadContext:   resourceTencentCloudClsDataTransformRead,
+		UpdateContext: resourceTencentCloudClsDataTransformUpdate,
+		DeleteContext: resourceTencentCloudClsDataTransformDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"func_type": {
@@ -145,7 +150,7 @@ func resourceTencentCloudClsDataTransform() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClsDataTransformCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsDataTransformCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_data_transform.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -194,8 +199,8 @@ func resourceTencentCloudClsDataTransformCreate(d *schema.ResourceData, meta int
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateDataTransform(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateDataTransformWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -206,22 +211,22 @@ func resourceTencentCloudClsDataTransformCreate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cls dataTransform failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	taskId = *response.Response.TaskId
 	d.SetId(taskId)
 
-	return resourceTencentCloudClsDataTransformRead(d, meta)
+	return resourceTencentCloudClsDataTransformRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsDataTransformRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsDataTransformRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_data_transform.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -229,7 +234,7 @@ func resourceTencentCloudClsDataTransformRead(d *schema.ResourceData, meta inter
 
 	dataTransform, err := service.DescribeClsDataTransformById(ctx, dataTransformTaskId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if dataTransform == nil {
@@ -277,7 +282,7 @@ func resourceTencentCloudClsDataTransformRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudClsDataTransformUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsDataTransformUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_data_transform.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -293,7 +298,7 @@ func resourceTencentCloudClsDataTransformUpdate(d *schema.ResourceData, meta int
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -331,8 +336,8 @@ func resourceTencentCloudClsDataTransformUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyDataTransform(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyDataTransformWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -342,24 +347,24 @@ func resourceTencentCloudClsDataTransformUpdate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update cls dataTransform failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudClsDataTransformRead(d, meta)
+	return resourceTencentCloudClsDataTransformRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsDataTransformDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsDataTransformDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_data_transform.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	dataTransformTaskId := d.Id()
 
 	if err := service.DeleteClsDataTransformById(ctx, dataTransformTaskId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -28,10 +28,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
@@ -40,11 +40,14 @@ import (
 
 func resourceTencentCloudClsExport() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsExportCreate,
-		Read:   resourceTencentCloudClsExportRead,
-		Delete: resourceTencentCloudClsExportDelete,
+		CreateContext: resourceTencentCloudClsExportCreate,
+		ReadContext:   resourceTencentCloudClsExportRead,
+		DeleteContext: resourceTencentCloudClsExportDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"topic_id": {
@@ -99,7 +102,7 @@ func resourceTencentCloudClsExport() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClsExportCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsExportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_export.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -140,8 +143,8 @@ func resourceTencentCloudClsExportCreate(d *schema.ResourceData, meta interface{
 		request.Format = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateExport(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateExportWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -152,35 +155,35 @@ func resourceTencentCloudClsExportCreate(d *schema.ResourceData, meta interface{
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cls export failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	exportId = *response.Response.ExportId
-	d.SetId(topicId + FILED_SP + exportId)
+	d.SetId(helper.IdFormat(topicId, exportId))
 
-	return resourceTencentCloudClsExportRead(d, meta)
+	return resourceTencentCloudClsExportRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsExportRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_export.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	topicId := idSplit[0]
 	exportId := idSplit[1]
 
 	export, err := service.DescribeClsExportById(ctx, topicId, exportId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if export == nil {
@@ -220,20 +223,22 @@ func resourceTencentCloudClsExportRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudClsExportDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsExportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_export.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	exportId := idSplit[1]
 
 	if err := service.DeleteClsExportById(ctx, exportId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -69,7 +69,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
@@ -78,12 +80,16 @@ import (
 
 func resourceTencentCloudClsIndex() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsIndexCreate,
-		Read:   resourceTencentCloudClsIndexRead,
-		Delete: resourceTencentCloudClsIndexDelete,
-		Update: resourceTencentCloudClsIndexUpdate,
+		CreateContext: resourceTencentCloudClsIndexCreate,
+		ReadContext:   resourceTencentCloudClsIndexRead,
+		DeleteContext: resourceTencentCloudClsIndexDelete,
+		UpdateContext: resourceTencentCloudClsIndexUpdate,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"topic_id": {
@@ -267,7 +273,7 @@ func resourceTencentCloudClsIndex() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClsIndexCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsIndexCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_index.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -381,8 +387,8 @@ func resourceTencentCloudClsIndexCreate(d *schema.ResourceData, meta interface{}
 		request.MetadataFlag = helper.IntUint64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateIndex(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateIndexWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -394,15 +400,15 @@ func resourceTencentCloudClsIndexCreate(d *schema.ResourceData, meta interface{}
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cls index failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(indexId)
 
-	return resourceTencentCloudClsIndexRead(d, meta)
+	return resourceTencentCloudClsIndexRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsIndexRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsIndexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_index.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -416,8 +422,8 @@ func resourceTencentCloudClsIndexRead(d *schema.ResourceData, meta interface{})
 
 	request.TopicId = &id
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		response, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().DescribeIndex(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		response, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().DescribeIndexWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -426,7 +432,7 @@ func resourceTencentCloudClsIndexRead(d *schema.ResourceData, meta interface{})
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read cls index failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	res := result.Response
@@ -533,7 +539,7 @@ func resourceTencentCloudClsIndexRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudClsIndexUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsIndexUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_index.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -652,8 +658,8 @@ func resourceTencentCloudClsIndexUpdate(d *schema.ResourceData, meta interface{}
 			request.MetadataFlag = helper.IntUint64(v.(int))
 		}
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyIndex(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyIndexWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -664,23 +670,23 @@ func resourceTencentCloudClsIndexUpdate(d *schema.ResourceData, meta interface{}
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudClsIndexRead(d, meta)
+	return resourceTencentCloudClsIndexRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsIndexDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsIndexDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_cos_shipper.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	id := d.Id()
 
 	if err := service.DeleteClsIndex(ctx, id); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -52,10 +52,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
@@ -64,12 +64,16 @@ import (
 
 func resourceTencentCloudClsKafkaRecharge() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsKafkaRechargeCreate,
-		Read:   resourceTencentCloudClsKafkaRechargeRead,
-		Update: resourceTencentCloudClsKafkaRechargeUpdate,
-		Delete: resourceTencentCloudClsKafkaRechargeDelete,
+		CreateContext: resourceTencentCloudClsKafkaRechargeCreate,
+		ReadContext:   resourceTencentCloudClsKafkaRechargeRead,
+		UpdateContext: resourceTencentCloudClsKafkaRechargeUpdate,
+		DeleteContext: resourceTencentCloudClsKafkaRechargeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"topic_id": {
@@ -252,7 +256,7 @@ func resourceTencentCloudClsKafkaRecharge() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClsKafkaRechargeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsKafkaRechargeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_kafka_recharge.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -372,8 +376,8 @@ func resourceTencentCloudClsKafkaRechargeCreate(d *schema.ResourceData, meta int
 		request.LogRechargeRule = &logRechargeRuleInfo
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateKafkaRecharge(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateKafkaRechargeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -384,34 +388,34 @@ func resourceTencentCloudClsKafkaRechargeCreate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cls kafkaRecharge failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	id = *response.Response.Id
-	d.SetId(id + FILED_SP + topicId)
+	d.SetId(helper.IdFormat(id, topicId))
 
-	return resourceTencentCloudClsKafkaRechargeRead(d, meta)
+	return resourceTencentCloudClsKafkaRechargeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsKafkaRechargeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsKafkaRechargeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_kafka_recharge.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	kafkaRechargeId := idSplit[0]
 	kafkaTopic := idSplit[1]
 
 	kafkaRecharge, err := service.DescribeClsKafkaRechargeById(ctx, kafkaRechargeId, kafkaTopic)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if kafkaRecharge == nil {
@@ -543,7 +547,7 @@ func resourceTencentCloudClsKafkaRechargeRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudClsKafkaRechargeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsKafkaRechargeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_kafka_recharge.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -559,7 +563,7 @@ func resourceTencentCloudClsKafkaRechargeUpdate(d *schema.ResourceData, meta int
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -687,8 +691,8 @@ func resourceTencentCloudClsKafkaRechargeUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyKafkaRecharge(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyKafkaRechargeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -698,29 +702,29 @@ func resourceTencentCloudClsKafkaRechargeUpdate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update cls kafkaRecharge failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudClsKafkaRechargeRead(d, meta)
+	return resourceTencentCloudClsKafkaRechargeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsKafkaRechargeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsKafkaRechargeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_kafka_recharge.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	kafkaRechargeId := idSplit[0]
 	kafkaTopic := idSplit[1]
 
 	if err := service.DeleteClsKafkaRechargeById(ctx, kafkaRechargeId, kafkaTopic); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
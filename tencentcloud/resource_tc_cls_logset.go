@@ -25,7 +25,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
@@ -34,12 +36,16 @@ import (
 
 func resourceTencentCloudClsLogset() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudClsLogsetRead,
-		Create: resourceTencentCloudClsLogsetCreate,
-		Update: resourceTencentCloudClsLogsetUpdate,
-		Delete: resourceTencentCloudClsLogsetDelete,
+		ReadContext:   resourceTencentCloudClsLogsetRead,
+		CreateContext: resourceTencentCloudClsLogsetCreate,
+		UpdateContext: resourceTencentCloudClsLogsetUpdate,
+		DeleteContext: resourceTencentCloudClsLogsetDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"logset_name": {
@@ -75,7 +81,7 @@ func resourceTencentCloudClsLogset() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClsLogsetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsLogsetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_logset.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -90,8 +96,8 @@ func resourceTencentCloudClsLogsetCreate(d *schema.ResourceData, meta interface{
 		request.LogsetName = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateLogset(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateLogsetWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -104,30 +110,30 @@ func resourceTencentCloudClsLogsetCreate(d *schema.ResourceData, meta interface{
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cls logset failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	logsetId := *response.Response.LogsetId
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::cls:%s:uin/:logset/%s", region, logsetId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	d.SetId(logsetId)
-	return resourceTencentCloudClsLogsetRead(d, meta)
+	return resourceTencentCloudClsLogsetRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsLogsetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsLogsetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_logset.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -136,12 +142,12 @@ func resourceTencentCloudClsLogsetRead(d *schema.ResourceData, meta interface{})
 	logset, err := service.DescribeClsLogset(ctx, logsetId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if logset == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `logset` %s does not exist", logsetId)
+		return diag.Errorf("resource `logset` %s does not exist", logsetId)
 	}
 
 	if logset.LogsetName != nil {
@@ -164,19 +170,19 @@ func resourceTencentCloudClsLogsetRead(d *schema.ResourceData, meta interface{})
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "cls", "logset", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudClsLogsetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsLogsetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_logset.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := cls.NewModifyLogsetRequest()
 
@@ -188,8 +194,8 @@ func resourceTencentCloudClsLogsetUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyLogset(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyLogsetWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -200,7 +206,7 @@ func resourceTencentCloudClsLogsetUpdate(d *schema.ResourceData, meta interface{
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
@@ -210,25 +216,25 @@ func resourceTencentCloudClsLogsetUpdate(d *schema.ResourceData, meta interface{
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("cls", "logset", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudClsLogsetRead(d, meta)
+	return resourceTencentCloudClsLogsetRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsLogsetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsLogsetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_logset.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	logsetId := d.Id()
 
 	if err := service.DeleteClsLogsetById(ctx, logsetId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -35,9 +35,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
@@ -46,12 +47,16 @@ import (
 
 func resourceTencentCloudClsMachineGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsMachineGroupCreate,
-		Read:   resourceTencentCloudClsMachineGroupRead,
-		Delete: resourceTencentCloudClsMachineGroupDelete,
-		Update: resourceTencentCloudClsMachineGroupUpdate,
+		CreateContext: resourceTencentCloudClsMachineGroupCreate,
+		ReadContext:   resourceTencentCloudClsMachineGroupRead,
+		DeleteContext: resourceTencentCloudClsMachineGroupDelete,
+		UpdateContext: resourceTencentCloudClsMachineGroupUpdate,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"group_name": {
@@ -116,7 +121,7 @@ func resourceTencentCloudClsMachineGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClsMachineGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsMachineGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_machine_group.create")()
 
 	logId := getLogId(contextNil)
@@ -175,8 +180,8 @@ func resourceTencentCloudClsMachineGroupCreate(d *schema.ResourceData, meta inte
 		request.ServiceLogging = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateMachineGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateMachineGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -189,20 +194,20 @@ func resourceTencentCloudClsMachineGroupCreate(d *schema.ResourceData, meta inte
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cls machine group failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := *response.Response.GroupId
 	d.SetId(id)
-	return resourceTencentCloudClsMachineGroupRead(d, meta)
+	return resourceTencentCloudClsMachineGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsMachineGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsMachineGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_machine_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	id := d.Id()
@@ -210,12 +215,12 @@ func resourceTencentCloudClsMachineGroupRead(d *schema.ResourceData, meta interf
 	machineGroup, err := service.DescribeClsMachineGroupById(ctx, id)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if machineGroup == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `MachineGroup` %s does not exist", id)
+		return diag.Errorf("resource `MachineGroup` %s does not exist", id)
 	}
 
 	_ = d.Set("group_name", machineGroup.GroupName)
@@ -243,7 +248,7 @@ func resourceTencentCloudClsMachineGroupRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudClsMachineGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsMachineGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_machine_group.update")()
 	logId := getLogId(contextNil)
 	request := cls.NewModifyMachineGroupRequest()
@@ -294,8 +299,8 @@ func resourceTencentCloudClsMachineGroupUpdate(d *schema.ResourceData, meta inte
 		request.ServiceLogging = helper.Bool(d.Get("service_logging").(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyMachineGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyMachineGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -306,22 +311,22 @@ func resourceTencentCloudClsMachineGroupUpdate(d *schema.ResourceData, meta inte
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudClsMachineGroupRead(d, meta)
+	return resourceTencentCloudClsMachineGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsMachineGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsMachineGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_machine_group.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	id := d.Id()
 
 	if err := service.DeleteClsMachineGroup(ctx, id); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
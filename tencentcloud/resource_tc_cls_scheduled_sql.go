@@ -57,10 +57,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
@@ -69,12 +69,16 @@ import (
 
 func resourceTencentCloudClsScheduledSql() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsScheduledSqlCreate,
-		Read:   resourceTencentCloudClsScheduledSqlRead,
-		Update: resourceTencentCloudClsScheduledSqlUpdate,
-		Delete: resourceTencentCloudClsScheduledSqlDelete,
+		CreateContext: resourceTencentCloudClsScheduledSqlCreate,
+		ReadContext:   resourceTencentCloudClsScheduledSqlRead,
+		UpdateContext: resourceTencentCloudClsScheduledSqlUpdate,
+		DeleteContext: resourceTencentCloudClsScheduledSqlDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"src_topic_id": {
@@ -184,7 +188,7 @@ func resourceTencentCloudClsScheduledSql() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClsScheduledSqlCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsScheduledSqlCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_scheduled_sql.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -260,8 +264,8 @@ func resourceTencentCloudClsScheduledSqlCreate(d *schema.ResourceData, meta inte
 		request.SyntaxRule = helper.IntUint64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateScheduledSql(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateScheduledSqlWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -272,22 +276,22 @@ func resourceTencentCloudClsScheduledSqlCreate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cls scheduledSql failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	taskId = *response.Response.TaskId
 	d.SetId(taskId)
 
-	return resourceTencentCloudClsScheduledSqlRead(d, meta)
+	return resourceTencentCloudClsScheduledSqlRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsScheduledSqlRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsScheduledSqlRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_scheduled_sql.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -295,7 +299,7 @@ func resourceTencentCloudClsScheduledSqlRead(d *schema.ResourceData, meta interf
 
 	scheduledSql, err := service.DescribeClsScheduledSqlById(ctx, scheduledSqlId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if scheduledSql == nil {
@@ -344,11 +348,11 @@ func resourceTencentCloudClsScheduledSqlRead(d *schema.ResourceData, meta interf
 
 	location, err := time.LoadLocation("Asia/Shanghai")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	startTime, err := time.ParseInLocation("2006-01-02 15:04:05", *scheduledSql.ProcessStartTime, location)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if scheduledSql.ProcessStartTime != nil {
@@ -377,7 +381,7 @@ func resourceTencentCloudClsScheduledSqlRead(d *schema.ResourceData, meta interf
 
 	endTime, err := time.Parse("2006-01-02 15:04:05", *scheduledSql.ProcessStartTime)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if scheduledSql.ProcessEndTime != nil {
 		_ = d.Set("process_end_time", endTime.Unix())
@@ -390,7 +394,7 @@ func resourceTencentCloudClsScheduledSqlRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudClsScheduledSqlUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsScheduledSqlUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_scheduled_sql.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -406,7 +410,7 @@ func resourceTencentCloudClsScheduledSqlUpdate(d *schema.ResourceData, meta inte
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -483,8 +487,8 @@ func resourceTencentCloudClsScheduledSqlUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyScheduledSql(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyScheduledSqlWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -494,18 +498,18 @@ func resourceTencentCloudClsScheduledSqlUpdate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update cls scheduledSql failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudClsScheduledSqlRead(d, meta)
+	return resourceTencentCloudClsScheduledSqlRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsScheduledSqlDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsScheduledSqlDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_scheduled_sql.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	scheduledSqlId := d.Id()
@@ -514,7 +518,7 @@ func resourceTencentCloudClsScheduledSqlDelete(d *schema.ResourceData, meta inte
 		srcTopicId = v.(string)
 	}
 	if err := service.DeleteClsScheduledSqlById(ctx, scheduledSqlId, srcTopicId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -30,9 +30,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
@@ -41,12 +42,16 @@ import (
 
 func resourceTencentCloudClsTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsTopicCreate,
-		Read:   resourceTencentCloudClsTopicRead,
-		Delete: resourceTencentCloudClsTopicDelete,
-		Update: resourceTencentCloudClsTopicUpdate,
+		CreateContext: resourceTencentCloudClsTopicCreate,
+		ReadContext:   resourceTencentCloudClsTopicRead,
+		DeleteContext: resourceTencentCloudClsTopicDelete,
+		UpdateContext: resourceTencentCloudClsTopicUpdate,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"logset_id": {
@@ -100,7 +105,7 @@ func resourceTencentCloudClsTopic() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudClsTopicCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_topic.create")()
 
 	logId := getLogId(contextNil)
@@ -149,8 +154,8 @@ func resourceTencentCloudClsTopicCreate(d *schema.ResourceData, meta interface{}
 		request.Period = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateTopic(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().CreateTopicWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -163,20 +168,20 @@ func resourceTencentCloudClsTopicCreate(d *schema.ResourceData, meta interface{}
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cls topic failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := *response.Response.TopicId
 	d.SetId(id)
-	return resourceTencentCloudClsTopicRead(d, meta)
+	return resourceTencentCloudClsTopicRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsTopicRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_topic.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	id := d.Id()
@@ -184,12 +189,12 @@ func resourceTencentCloudClsTopicRead(d *schema.ResourceData, meta interface{})
 	topic, err := service.DescribeClsTopicById(ctx, id)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if topic == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `Topic` %s does not exist", id)
+		return diag.Errorf("resource `Topic` %s does not exist", id)
 	}
 
 	_ = d.Set("logset_id", topic.LogsetId)
@@ -209,7 +214,7 @@ func resourceTencentCloudClsTopicRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudClsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_topic.update")()
 	logId := getLogId(contextNil)
 	request := cls.NewModifyTopicRequest()
@@ -217,11 +222,11 @@ func resourceTencentCloudClsTopicUpdate(d *schema.ResourceData, meta interface{}
 	request.TopicId = helper.String(d.Id())
 
 	if d.HasChange("partition_count") {
-		return fmt.Errorf("`partition_count` do not support change now.")
+		return diag.Errorf("`partition_count` do not support change now.")
 	}
 
 	if d.HasChange("storage_type") {
-		return fmt.Errorf("`storage_type` do not support change now.")
+		return diag.Errorf("`storage_type` do not support change now.")
 	}
 
 	if d.HasChange("topic_name") {
@@ -254,8 +259,8 @@ func resourceTencentCloudClsTopicUpdate(d *schema.ResourceData, meta interface{}
 		request.Period = helper.IntInt64(d.Get("period").(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyTopic(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClsClient().ModifyTopicWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -266,22 +271,22 @@ func resourceTencentCloudClsTopicUpdate(d *schema.ResourceData, meta interface{}
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudClsTopicRead(d, meta)
+	return resourceTencentCloudClsTopicRead(ctx, d, meta)
 }
 
-func resourceTencentCloudClsTopicDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudClsTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cls_topic.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	id := d.Id()
 
 	if err := service.DeleteClsTopic(ctx, id); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -34,14 +34,23 @@ resource "tencentcloud_container_cluster" "foo" {
   cluster_version              = "1.7.8"
 }
 ```
+
+Import
+
+Container cluster can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_container_cluster.foo cls-mpuy5wvj
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
@@ -53,10 +62,17 @@ import (
 func resourceTencentCloudContainerCluster() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource has been deprecated in Terraform TencentCloud provider version 1.16.0. Please use 'tencentcloud_kubernetes_cluster' instead.",
-		Create:             resourceTencentCloudContainerClusterCreate,
-		Read:               resourceTencentCloudContainerClusterRead,
-		Update:             resourceTencentCloudContainerClusterUpdate,
-		Delete:             resourceTencentCloudContainerClusterDelete,
+		CreateContext:      resourceTencentCloudContainerClusterCreate,
+		ReadContext:        resourceTencentCloudContainerClusterRead,
+		UpdateContext:      resourceTencentCloudContainerClusterUpdate,
+		DeleteContext:      resourceTencentCloudContainerClusterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_name": {
@@ -240,17 +256,17 @@ func resourceTencentCloudContainerCluster() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudContainerClusterUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudContainerClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_container_cluster.update")()
 
-	return fmt.Errorf("the container cluster resource doesn't support update")
+	return diag.Errorf("the container cluster resource doesn't support update")
 }
 
-func resourceTencentCloudContainerClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudContainerClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_container_cluster.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 	cvmService := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -259,7 +275,7 @@ func resourceTencentCloudContainerClusterCreate(d *schema.ResourceData, meta int
 	var place cvm.Placement
 	if v, ok := d.GetOkExists("zone_id"); ok {
 		var zones []*cvm.ZoneInfo
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			var e error
 			zones, e = cvmService.DescribeZones(ctx)
 			if e != nil {
@@ -268,7 +284,7 @@ func resourceTencentCloudContainerClusterCreate(d *schema.ResourceData, meta int
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		zone := ""
 		for _, z := range zones {
@@ -451,11 +467,11 @@ func resourceTencentCloudContainerClusterCreate(d *schema.ResourceData, meta int
 
 	id, err := service.CreateCluster(ctx, basic, cAdvanced, cvms, iAdvanced, cidrSet, map[string]string{}, nil, nil, nil, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
-	err = resource.Retry(6*writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		_, _, err = service.DescribeClusterInstances(ctx, d.Id())
 		if e, ok := err.(*errors.TencentCloudSDKError); ok {
 			if e.GetCode() == "InternalError.ClusterNotFound" {
@@ -468,28 +484,29 @@ func resourceTencentCloudContainerClusterCreate(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if err = resourceTencentCloudContainerClusterRead(d, meta); err != nil {
-		log.Printf("[WARN]%s resource.tencentcloud_container_cluster.read after create fail , %s", logId, err.Error())
+	if diags := resourceTencentCloudContainerClusterRead(ctx, d, meta); diags.HasError() {
+		log.Printf("[WARN]%s resource.tencentcloud_container_cluster.read after create fail , %s", logId, diagnosticsError(diags).Error())
+		return warningDiagnostics(diags)
 	}
 
 	return nil
 }
 
-func resourceTencentCloudContainerClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudContainerClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_container_cluster.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var info ClusterInfo
 	var has bool
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		var e error
 		info, has, e = service.DescribeCluster(ctx, d.Id())
 		if e != nil {
@@ -517,7 +534,7 @@ func resourceTencentCloudContainerClusterRead(d *schema.ResourceData, meta inter
 	_ = d.Set("total_mem", 0)
 
 	var workers []InstanceInfo
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		var e error
 		_, workers, e = service.DescribeClusterInstances(ctx, info.ClusterId)
 		if e, ok := e.(*errors.TencentCloudSDKError); ok {
@@ -532,7 +549,7 @@ func resourceTencentCloudContainerClusterRead(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceIds := []*string{}
@@ -544,8 +561,8 @@ func resourceTencentCloudContainerClusterRead(d *schema.ResourceData, meta inter
 		describeInstancesreq := cvm.NewDescribeInstancesRequest()
 		describeInstancesreq.InstanceIds = instanceIds
 		var describeInstancesResponse *cvm.DescribeInstancesResponse
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().DescribeInstances(describeInstancesreq)
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().DescribeInstancesWithContext(ctx, describeInstancesreq)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, describeInstancesreq.GetAction(), describeInstancesreq.ToJsonString(), e.Error())
@@ -556,7 +573,7 @@ func resourceTencentCloudContainerClusterRead(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s DescribeInstances failed, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 		total_cpu := int64(0)
@@ -573,18 +590,18 @@ func resourceTencentCloudContainerClusterRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudContainerClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudContainerClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_container_cluster.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	return diag.FromErr(retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		err := service.DeleteCluster(ctx, d.Id())
 		if err != nil {
 			return retryError(err)
 		}
 		return nil
-	})
+	}))
 }
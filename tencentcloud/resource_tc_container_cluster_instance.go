@@ -26,6 +26,14 @@ resource "tencentcloud_container_cluster_instance" "bar_instance" {
   cluster_id        = "cls-abcdef"
 }
 ```
+
+Import
+
+Container cluster instance can be imported using the id `cluster_id#instance_id`, e.g.
+
+```
+$ terraform import tencentcloud_container_cluster_instance.bar_instance cls-mpuy5wvj#ins-6ekeudyx
+```
 */
 package tencentcloud
 
@@ -33,7 +41,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
@@ -46,10 +56,17 @@ import (
 func resourceTencentCloudContainerClusterInstance() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource has been deprecated in Terraform TencentCloud provider version 1.16.0. Please use 'tencentcloud_kubernetes_scale_worker' instead.",
-		Create:             resourceTencentCloudContainerClusterInstancesCreate,
-		Read:               resourceTencentCloudContainerClusterInstancesRead,
-		Update:             resourceTencentCloudContainerClusterInstancesUpdate,
-		Delete:             resourceTencentCloudContainerClusterInstancesDelete,
+		CreateContext:      resourceTencentCloudContainerClusterInstancesCreate,
+		ReadContext:        resourceTencentCloudContainerClusterInstancesRead,
+		UpdateContext:      resourceTencentCloudContainerClusterInstancesUpdate,
+		DeleteContext:      resourceTencentCloudContainerClusterInstancesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: helper.ImportWithArguments("cluster_id"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -203,24 +220,24 @@ func resourceTencentCloudContainerClusterInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudContainerClusterInstancesUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudContainerClusterInstancesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_container_cluster_instance.update")()
 
-	return fmt.Errorf("the container cluster instance resource doesn't support update")
+	return diag.Errorf("the container cluster instance resource doesn't support update")
 }
 
-func resourceTencentCloudContainerClusterInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudContainerClusterInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_container_cluster_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var workers []InstanceInfo
 	clusterId := d.Get("cluster_id").(string)
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		var e error
 		_, workers, e = service.DescribeClusterInstances(ctx, clusterId)
 		if e, ok := e.(*errors.TencentCloudSDKError); ok {
@@ -235,7 +252,7 @@ func resourceTencentCloudContainerClusterInstancesRead(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	found := false
@@ -254,8 +271,8 @@ func resourceTencentCloudContainerClusterInstancesRead(d *schema.ResourceData, m
 			describeInstancesreq := cvm.NewDescribeInstancesRequest()
 			describeInstancesreq.InstanceIds = []*string{common.StringPtr(v.InstanceId)}
 			var describeInstancesResponse *cvm.DescribeInstancesResponse
-			err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().DescribeInstances(describeInstancesreq)
+			err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().DescribeInstancesWithContext(ctx, describeInstancesreq)
 				if e != nil {
 					log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 						logId, describeInstancesreq.GetAction(), describeInstancesreq.ToJsonString(), e.Error())
@@ -266,7 +283,7 @@ func resourceTencentCloudContainerClusterInstancesRead(d *schema.ResourceData, m
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s DescribeInstances failed, reason:%s\n ", logId, err.Error())
-				return err
+				return diag.FromErr(err)
 			}
 
 			if len(describeInstancesResponse.Response.InstanceSet) > 0 {
@@ -287,11 +304,11 @@ func resourceTencentCloudContainerClusterInstancesRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceTencentCloudContainerClusterInstancesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudContainerClusterInstancesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_container_cluster_instance.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 	cvmService := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -301,7 +318,7 @@ func resourceTencentCloudContainerClusterInstancesCreate(d *schema.ResourceData,
 	var place cvm.Placement
 	if v, ok := d.GetOkExists("zone_id"); ok {
 		var zones []*cvm.ZoneInfo
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			var e error
 			zones, e = cvmService.DescribeZones(ctx)
 			if e != nil {
@@ -310,7 +327,7 @@ func resourceTencentCloudContainerClusterInstancesCreate(d *schema.ResourceData,
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		zone := ""
 		for _, z := range zones {
@@ -466,14 +483,14 @@ func resourceTencentCloudContainerClusterInstancesCreate(d *schema.ResourceData,
 
 	instanceIds, err := service.CreateClusterInstances(ctx, clusterId, cvms.Work[0], iAdvanced)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(instanceIds) != 1 {
-		return fmt.Errorf("no instance return")
+		return diag.Errorf("no instance return")
 	}
 
-	err = resource.Retry(6*writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		_, workers, e := service.DescribeClusterInstances(ctx, clusterId)
 		if ee, ok := e.(*errors.TencentCloudSDKError); ok {
 			if ee.GetCode() == "InternalError.ClusterNotFound" {
@@ -494,25 +511,25 @@ func resourceTencentCloudContainerClusterInstancesCreate(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceIds[0])
 
-	return resourceTencentCloudContainerClusterInstancesRead(d, meta)
+	return resourceTencentCloudContainerClusterInstancesRead(ctx, d, meta)
 }
 
-func resourceTencentCloudContainerClusterInstancesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudContainerClusterInstancesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_container_cluster_instance.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var workers []InstanceInfo
 	clusterId := d.Get("cluster_id").(string)
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		var e error
 		_, workers, e = service.DescribeClusterInstances(ctx, clusterId)
 		if ee, ok := e.(*errors.TencentCloudSDKError); ok {
@@ -526,7 +543,7 @@ func resourceTencentCloudContainerClusterInstancesDelete(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	found := false
@@ -541,7 +558,7 @@ func resourceTencentCloudContainerClusterInstancesDelete(d *schema.ResourceData,
 		return nil
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := service.DeleteClusterInstances(ctx, clusterId, []string{node.InstanceId})
 		if ee, ok := e.(*errors.TencentCloudSDKError); ok {
 			if ee.GetCode() == "InternalError.ClusterNotFound" {
@@ -554,5 +571,5 @@ func resourceTencentCloudContainerClusterInstancesDelete(d *schema.ResourceData,
 		return nil
 	})
 
-	return err
+	return diag.FromErr(err)
 }
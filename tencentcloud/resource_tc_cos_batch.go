@@ -53,12 +53,12 @@ package tencentcloud
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -67,12 +67,15 @@ import (
 
 func resourceTencentCloudCosBatch() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudCosBatchRead,
-		Create: resourceTencentCloudCosBatchCreate,
-		Update: resourceTencentCloudCosBatchUpdate,
-		Delete: resourceTencentCloudCosBatchDelete,
+		ReadContext:   resourceTencentCloudCosBatchRead,
+		CreateContext: resourceTencentCloudCosBatchCreate,
+		UpdateContext: resourceTencentCloudCosBatchUpdate,
+		DeleteContext: resourceTencentCloudCosBatchDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -428,15 +431,15 @@ func resourceTencentCloudCosBatch() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCosBatchRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBatchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_batch.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	uin := idSplit[0]
 	appid, _ := strconv.Atoi(idSplit[1])
@@ -449,14 +452,14 @@ func resourceTencentCloudCosBatchRead(d *schema.ResourceData, meta interface{})
 	responseBody, _ := json.Marshal(response.Body)
 	if err != nil {
 		log.Printf("[DEBUG]%s api[DescribeJob] success, request body [%s], response body [%s], err: [%s]\n", logId, jobId, responseBody, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if result == nil || result.Job == nil {
-		return fmt.Errorf("DescribeJob response is nil!")
+		return diag.Errorf("DescribeJob response is nil!")
 	}
 	confirmationRequired, err := strconv.ParseBool(result.Job.ConfirmationRequired)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("uin", uin)
 	_ = d.Set("appid", appid)
@@ -566,12 +569,12 @@ func resourceTencentCloudCosBatchRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudCosBatchCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBatchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_batch.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	uin := d.Get("uin").(string)
 	opt := &cos.BatchCreateJobOptions{
 		ClientRequestToken: uuid.New().String(),
@@ -765,7 +768,7 @@ func resourceTencentCloudCosBatchCreate(d *schema.ResourceData, meta interface{}
 		XCosAppid: appid,
 	}
 	var batchCreateJobResult *cos.BatchCreateJobResult
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		req, _ := json.Marshal(opt)
 		result, response, e := meta.(*TencentCloudClient).apiV3Conn.UseCosBatchClient(uin).Batch.CreateJob(ctx, opt, headers)
 		responseBody, _ := json.Marshal(response.Body)
@@ -779,7 +782,7 @@ func resourceTencentCloudCosBatchCreate(d *schema.ResourceData, meta interface{}
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create job failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	if v, ok := d.GetOk("status"); ok {
 		opt := &cos.BatchUpdateStatusOptions{
@@ -791,22 +794,22 @@ func resourceTencentCloudCosBatchCreate(d *schema.ResourceData, meta interface{}
 		responseBody, _ := json.Marshal(response.Body)
 		if err != nil {
 			log.Printf("[DEBUG]%s api[UpdateJobStatus] error, request body [%s], response body [%s], err: [%s]\n", logId, req, responseBody, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	d.SetId(uin + FILED_SP + strconv.Itoa(appid) + FILED_SP + batchCreateJobResult.JobId)
-	return resourceTencentCloudCosBatchRead(d, meta)
+	d.SetId(helper.IdFormat(uin, strconv.Itoa(appid), batchCreateJobResult.JobId))
+	return resourceTencentCloudCosBatchRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCosBatchUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBatchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_batch.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	uin := idSplit[0]
 	appid, _ := strconv.Atoi(idSplit[1])
@@ -824,7 +827,7 @@ func resourceTencentCloudCosBatchUpdate(d *schema.ResourceData, meta interface{}
 		responseBody, _ := json.Marshal(response.Body)
 		if err != nil {
 			log.Printf("[DEBUG]%s api[UpdateJobPriority] error, request body [%s], response body [%s], err: [%s]\n", logId, req, responseBody, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if d.HasChange("status") {
@@ -837,20 +840,20 @@ func resourceTencentCloudCosBatchUpdate(d *schema.ResourceData, meta interface{}
 		responseBody, _ := json.Marshal(response.Body)
 		if err != nil {
 			log.Printf("[DEBUG]%s api[UpdateJobStatus] error, request body [%s], response body [%s], err: [%s]\n", logId, req, responseBody, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudCosBatchRead(d, meta)
+	return resourceTencentCloudCosBatchRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCosBatchDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBatchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_batch.delete")()
 	defer inconsistentCheck(d, meta)()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	uin := idSplit[0]
 	appid, _ := strconv.Atoi(idSplit[1])
@@ -862,7 +865,7 @@ func resourceTencentCloudCosBatchDelete(d *schema.ResourceData, meta interface{}
 	responseBody, _ := json.Marshal(response.Body)
 	if err != nil {
 		log.Printf("[DEBUG]%s api[DeleteJob] success, response body [%s], err: [%s]\n", logId, responseBody, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -246,6 +246,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -368,16 +369,22 @@ func originPullRules() *schema.Resource {
 //}
 
 func resourceTencentCloudCosBucket() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceTencentCloudCosBucketCreate,
-		Read:   resourceTencentCloudCosBucketRead,
-		Update: resourceTencentCloudCosBucketUpdate,
-		Delete: resourceTencentCloudCosBucketDelete,
+	return helper.WithStateUpgraders(&schema.Resource{
+		CreateContext: resourceTencentCloudCosBucketCreate,
+		ReadContext:   resourceTencentCloudCosBucketRead,
+		UpdateContext: resourceTencentCloudCosBucketUpdate,
+		DeleteContext: resourceTencentCloudCosBucketDelete,
 		Importer: &schema.ResourceImporter{
 			State: helper.ImportWithDefaultValue(map[string]interface{}{
 				"force_clean": false,
 			}),
 		},
+		CustomizeDiff: helper.ComposeCustomizeDiff(
+			helper.AllowedOnlyWhen("versioning_enable", true, "replica_role", "replica_rules"),
+			helper.RequiredWhen("log_enable", true, "log_target_bucket", "log_prefix"),
+			helper.AllowedOnlyWhen("log_enable", true, "log_target_bucket", "log_prefix"),
+			helper.CannotDisable("enable_intelligent_tiering"),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -406,6 +413,7 @@ func resourceTencentCloudCosBucket() *schema.Resource {
 					return ACLBodyDiffFunc(olds, news, d)
 				},
 				DiffSuppressOnRefresh: true,
+				StateFunc:             normalizeACLBody,
 				ValidateFunc:          validateACLBody,
 				Description:           "ACL XML body for multiple grant info. NOTE: this argument will overwrite `acl`. Check https://intl.cloud.tencent.com/document/product/436/7737 for more detail.",
 			},
@@ -740,16 +748,16 @@ func resourceTencentCloudCosBucket() *schema.Resource {
 				Description: "The URL of this cos bucket.",
 			},
 		},
-	}
+	}, resourceTencentCloudCosBucketStateUpgradeV0)
 }
 
-func resourceTencentCloudCosBucketCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket.create")()
 
 	var err error
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	bucket := d.Get("bucket").(string)
 	acl := d.Get("acl").(string)
@@ -759,9 +767,9 @@ func resourceTencentCloudCosBucketCreate(d *schema.ResourceData, meta interface{
 
 	if !versioning {
 		if roleOk || role.(string) != "" {
-			return fmt.Errorf("cannot configure role unless versioning enable")
+			return diag.Errorf("cannot configure role unless versioning enable")
 		} else if ruleOk || len(rule.([]interface{})) > 0 {
-			return fmt.Errorf("cannot configure replica rule unless versioning enable")
+			return diag.Errorf("cannot configure replica rule unless versioning enable")
 		}
 	}
 
@@ -775,26 +783,26 @@ func resourceTencentCloudCosBucketCreate(d *schema.ResourceData, meta interface{
 		err = cosService.PutBucket(ctx, bucket, acl)
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(bucket)
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		if err := cosService.SetBucketTags(ctx, bucket, tags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCosBucketUpdate(d, meta)
+	return resourceTencentCloudCosBucketUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudCosBucketRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	bucket := d.Id()
 	cosService := CosService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -806,7 +814,7 @@ func resourceTencentCloudCosBucketRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		} else {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -822,14 +830,14 @@ func resourceTencentCloudCosBucketRead(d *schema.ResourceData, meta interface{})
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// acl
 	aclResult, err := cosService.GetBucketACL(ctx, bucket)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	aclBody, err := xml.Marshal(aclResult)
@@ -837,7 +845,7 @@ func resourceTencentCloudCosBucketRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		log.Printf("[WARN] Marshal XML Error: %s", err.Error())
 	} else if v, ok := d.Get("acl_body").(string); ok && v != "" {
-		_ = d.Set("acl_body", string(aclBody))
+		_ = d.Set("acl_body", normalizeACLBody(string(aclBody)))
 	}
 
 	acl := GetBucketPublicACL(aclResult)
@@ -847,42 +855,42 @@ func resourceTencentCloudCosBucketRead(d *schema.ResourceData, meta interface{})
 	// read the cors
 	corsRules, err := cosService.GetBucketCors(ctx, bucket)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("cors_rules", corsRules); err != nil {
-		return fmt.Errorf("setting cors_rules error: %v", err)
+		return diag.Errorf("setting cors_rules error: %v", err)
 	}
 
 	originPullRules, err := cosService.GetBucketPullOrigin(ctx, bucket)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = d.Set("origin_pull_rules", originPullRules); err != nil {
-		return fmt.Errorf("setting origin_pull_rules error: %v", err)
+		return diag.Errorf("setting origin_pull_rules error: %v", err)
 	}
 
 	originDomainRules, err := cosService.GetBucketOriginDomain(ctx, bucket)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("origin_domain_rules", originDomainRules); err != nil {
-		return fmt.Errorf("setting origin_domain_rules error: %v", err)
+		return diag.Errorf("setting origin_domain_rules error: %v", err)
 	}
 
 	// read the lifecycle
 	lifecycleRules, err := cosService.GetBucketLifecycle(ctx, bucket)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("lifecycle_rules", lifecycleRules); err != nil {
-		return fmt.Errorf("setting lifecycle_rules error: %v", err)
+		return diag.Errorf("setting lifecycle_rules error: %v", err)
 	}
 
 	// read the website
 	website, err := cosService.GetBucketWebsite(ctx, bucket)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(website) > 0 {
 		// {bucket}.cos-website.{region}.myqcloud.com
@@ -890,45 +898,45 @@ func resourceTencentCloudCosBucketRead(d *schema.ResourceData, meta interface{})
 		website[0]["endpoint"] = endPointUrl
 	}
 	if err = d.Set("website", website); err != nil {
-		return fmt.Errorf("setting website error: %v", err)
+		return diag.Errorf("setting website error: %v", err)
 	}
 
 	// read the encryption algorithm
 	encryption, err := cosService.GetBucketEncryption(ctx, bucket)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("encryption_algorithm", encryption); err != nil {
-		return fmt.Errorf("setting encryption error: %v", err)
+		return diag.Errorf("setting encryption error: %v", err)
 	}
 
 	// read the versioning
 	versioning, err := cosService.GetBucketVersioning(ctx, bucket)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("versioning_enable", versioning); err != nil {
-		return fmt.Errorf("setting versioning_enable error: %v", err)
+		return diag.Errorf("setting versioning_enable error: %v", err)
 	}
 
 	// read the acceleration
 	acceleration, err := cosService.GetBucketAccleration(ctx, bucket)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = d.Set("acceleration_enable", acceleration); err != nil {
-		return fmt.Errorf("setting acceleration_enable error: %v", err)
+		return diag.Errorf("setting acceleration_enable error: %v", err)
 	}
 
 	replicaResult, err := cosService.GetBucketReplication(ctx, bucket)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if replicaResult != nil {
 		err := setBucketReplication(d, *replicaResult)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -937,7 +945,7 @@ func resourceTencentCloudCosBucketRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		if e, ok := err.(*errors.TencentCloudSDKError); ok {
 			if e.GetCode() != "UnSupportedLoggingRegion" {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	} else {
@@ -949,7 +957,7 @@ func resourceTencentCloudCosBucketRead(d *schema.ResourceData, meta interface{})
 	// read the tags
 	tags, err := cosService.GetBucketTags(ctx, bucket)
 	if err != nil {
-		return fmt.Errorf("get tags failed: %v", err)
+		return diag.Errorf("get tags failed: %v", err)
 	}
 	if len(tags) > 0 {
 		_ = d.Set("tags", tags)
@@ -958,7 +966,7 @@ func resourceTencentCloudCosBucketRead(d *schema.ResourceData, meta interface{})
 	//read intelligent tiering
 	result, err := cosService.BucketGetIntelligentTiering(ctx, bucket)
 	if err != nil {
-		return fmt.Errorf("get intelligent tiering failed: %v", err)
+		return diag.Errorf("get intelligent tiering failed: %v", err)
 	}
 	if result != nil {
 		if result.Status == "Enabled" {
@@ -974,11 +982,11 @@ func resourceTencentCloudCosBucketRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	cosService := CosService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -987,7 +995,7 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange("enable_intelligent_tiering") || d.HasChange("intelligent_tiering_days") || d.HasChange("intelligent_tiering_request_frequent") {
 		old, new := d.GetChange("enable_intelligent_tiering")
 		if old.(bool) && !new.(bool) {
-			return fmt.Errorf("enable_intelligent_tiering, intelligent_tiering_days and intelligent_tiering_request_frequent not support change!")
+			return diag.Errorf("enable_intelligent_tiering, intelligent_tiering_days and intelligent_tiering_request_frequent not support change!")
 		}
 		var transition cos.BucketIntelligentTieringTransition
 		if v, ok := d.GetOk("intelligent_tiering_days"); ok {
@@ -1008,7 +1016,7 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 			}
 			err := cosService.BucketPutIntelligentTiering(ctx, d.Id(), opt)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -1017,12 +1025,12 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 		bucket := d.Get("bucket").(string)
 		err := waitAclEnable(ctx, meta, bucket)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		err = resourceTencentCloudCosBucketAclUpdate(ctx, meta, d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1031,11 +1039,11 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 		bucket := d.Get("bucket").(string)
 		err := waitAclEnable(ctx, meta, bucket)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if err := resourceTencentCloudCosBucketOriginACLBodyUpdate(ctx, cosService, d); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		_ = d.Set("acl_body", body)
 	}
@@ -1043,7 +1051,7 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange("cors_rules") {
 		err := resourceTencentCloudCosBucketCorsUpdate(ctx, meta, d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -1052,7 +1060,7 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 		rules := d.Get("origin_pull_rules")
 		err := resourceTencentCloudCosBucketOriginPullUpdate(ctx, cosService, d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		_ = d.Set("origin_pull_rules", rules)
 	}
@@ -1060,7 +1068,7 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange("origin_domain_rules") {
 		rules := d.Get("origin_domain_rules")
 		if err := resourceTencentCloudCosBucketOriginDomainUpdate(ctx, cosService, d); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		_ = d.Set("origin_domain_rules", rules)
 	}
@@ -1068,7 +1076,7 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange("lifecycle_rules") {
 		err := resourceTencentCloudCosBucketLifecycleUpdate(ctx, meta, d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -1076,7 +1084,7 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange("website") {
 		err := resourceTencentCloudCosBucketWebsiteUpdate(ctx, meta, d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -1084,7 +1092,7 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange("encryption_algorithm") {
 		err := resourceTencentCloudCosBucketEncryptionUpdate(ctx, meta, d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -1092,7 +1100,7 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange("versioning_enable") {
 		err := resourceTencentCloudCosBucketVersioningUpdate(ctx, meta, d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -1100,7 +1108,7 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange("acceleration_enable") {
 		err := resourceTencentCloudCosBucketAccelerationUpdate(ctx, meta, d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -1109,7 +1117,7 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 		err := resourceTencentCloudCosBucketReplicaUpdate(ctx, cosService, d)
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1118,7 +1126,7 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 
 		cosService := CosService{client: meta.(*TencentCloudClient).apiV3Conn}
 		if err := cosService.SetBucketTags(ctx, bucket, helper.GetTags(d, "tags")); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -1126,7 +1134,7 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange("log_enable") || d.HasChange("log_target_bucket") || d.HasChange("log_prefix") {
 		err := resourceTencentCloudCosBucketLogStatusUpdate(ctx, meta, d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -1137,13 +1145,13 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 	// if not, the data may be outdated.
 	time.Sleep(3 * time.Second)
 
-	return resourceTencentCloudCosBucketRead(d, meta)
+	return resourceTencentCloudCosBucketRead(ctx, d, meta)
 }
 
 func waitAclEnable(ctx context.Context, meta interface{}, bucket string) error {
 	logId := getLogId(ctx)
 	cosService := CosService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		aclResult, e := cosService.GetBucketACL(ctx, bucket)
 		if e != nil {
 			sdkError, ok := e.(*errors.TencentCloudSDKError)
@@ -1166,11 +1174,11 @@ func waitAclEnable(ctx context.Context, meta interface{}, bucket string) error {
 	return err
 }
 
-func resourceTencentCloudCosBucketDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	bucket := d.Id()
 	forced := d.Get("force_clean").(bool)
@@ -1180,7 +1188,7 @@ func resourceTencentCloudCosBucketDelete(d *schema.ResourceData, meta interface{
 	}
 	err := cosService.DeleteBucket(ctx, bucket, forced, versioned)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// wait for update cache
@@ -1199,7 +1207,7 @@ func resourceTencentCloudCosBucketEncryptionUpdate(ctx context.Context, meta int
 		request := s3.DeleteBucketEncryptionInput{
 			Bucket: aws.String(bucket),
 		}
-		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().DeleteBucketEncryption(&request)
+		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().DeleteBucketEncryptionWithContext(ctx, &request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, "delete bucket encryption", request.String(), err.Error())
@@ -1225,7 +1233,7 @@ func resourceTencentCloudCosBucketEncryptionUpdate(ctx context.Context, meta int
 	rules = append(rules, rule)
 	request.ServerSideEncryptionConfiguration.Rules = rules
 
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().PutBucketEncryption(&request)
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().PutBucketEncryptionWithContext(ctx, &request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, "put bucket encryption", request.String(), err.Error())
@@ -1252,7 +1260,7 @@ func resourceTencentCloudCosBucketVersioningUpdate(ctx context.Context, meta int
 			Status: aws.String(status),
 		},
 	}
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().PutBucketVersioning(&request)
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().PutBucketVersioningWithContext(ctx, &request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, "put bucket encryption", request.String(), err.Error())
@@ -1331,7 +1339,7 @@ func resourceTencentCloudCosBucketAclUpdate(ctx context.Context, meta interface{
 		Bucket: aws.String(bucket),
 		ACL:    aws.String(acl),
 	}
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().PutBucketAcl(&request)
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().PutBucketAclWithContext(ctx, &request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, "put bucket acl", request.String(), err.Error())
@@ -1353,7 +1361,7 @@ func resourceTencentCloudCosBucketCorsUpdate(ctx context.Context, meta interface
 		request := s3.DeleteBucketCorsInput{
 			Bucket: aws.String(bucket),
 		}
-		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().DeleteBucketCors(&request)
+		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().DeleteBucketCorsWithContext(ctx, &request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, "delete bucket cors", request.String(), err.Error())
@@ -1396,7 +1404,7 @@ func resourceTencentCloudCosBucketCorsUpdate(ctx context.Context, meta interface
 				CORSRules: rules,
 			},
 		}
-		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().PutBucketCors(&request)
+		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().PutBucketCorsWithContext(ctx, &request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, "put bucket cors", request.String(), err.Error())
@@ -1417,7 +1425,7 @@ func resourceTencentCloudCosBucketLifecycleUpdate(ctx context.Context, meta inte
 		request := s3.DeleteBucketLifecycleInput{
 			Bucket: aws.String(bucket),
 		}
-		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().DeleteBucketLifecycle(&request)
+		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().DeleteBucketLifecycleWithContext(ctx, &request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, "delete bucket lifecycle", request.String(), err.Error())
@@ -1526,7 +1534,7 @@ func resourceTencentCloudCosBucketLifecycleUpdate(ctx context.Context, meta inte
 				Rules: rules,
 			},
 		}
-		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().PutBucketLifecycleConfiguration(&request)
+		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().PutBucketLifecycleConfigurationWithContext(ctx, &request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, "put bucket lifecycle", request.String(), err.Error())
@@ -1549,7 +1557,7 @@ func resourceTencentCloudCosBucketWebsiteUpdate(ctx context.Context, meta interf
 		request := s3.DeleteBucketWebsiteInput{
 			Bucket: aws.String(bucket),
 		}
-		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().DeleteBucketWebsite(&request)
+		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().DeleteBucketWebsiteWithContext(ctx, &request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, "delete bucket website", request.String(), err.Error())
@@ -1582,7 +1590,7 @@ func resourceTencentCloudCosBucketWebsiteUpdate(ctx context.Context, meta interf
 				},
 			},
 		}
-		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().PutBucketWebsite(&request)
+		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().PutBucketWebsiteWithContext(ctx, &request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, "put bucket website", request.String(), err.Error())
@@ -1622,7 +1630,7 @@ func resourceTencentCloudCosBucketLogStatusUpdate(ctx context.Context, meta inte
 				},
 			}
 
-			resp, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().PutBucketLogging(request)
+			resp, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().PutBucketLoggingWithContext(ctx, request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, "cos enable log error", request.String(), err.Error())
@@ -1644,7 +1652,7 @@ func resourceTencentCloudCosBucketLogStatusUpdate(ctx context.Context, meta inte
 			BucketLoggingStatus: &s3.BucketLoggingStatus{},
 		}
 
-		resp, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().PutBucketLogging(request)
+		resp, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().PutBucketLoggingWithContext(ctx, request)
 		if err != nil {
 			return fmt.Errorf("cos disable log error: %s, bucket: %s", err.Error(), bucket)
 		}
@@ -1968,6 +1976,31 @@ func setBucketReplication(d *schema.ResourceData, result cos.GetBucketReplicatio
 	return
 }
 
+// normalizeACLBody returns the ACL XML body indented by 2 spaces, so that the plan shows the
+// changes of it line by line. The body is returned as it is if it is not an XML.
+func normalizeACLBody(v interface{}) string {
+	body, _ := v.(string)
+	doc := etree.NewDocument()
+	if err := doc.ReadFromString(body); err != nil {
+		return body
+	}
+	doc.Indent(2)
+	normalized, err := doc.WriteToString()
+	if err != nil {
+		return body
+	}
+	return normalized
+}
+
+// resourceTencentCloudCosBucketStateUpgradeV0 normalizes the `acl_body`, which was stored in
+// one line as the COS returns in version 0.
+func resourceTencentCloudCosBucketStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if body, ok := rawState["acl_body"].(string); ok && body != "" {
+		rawState["acl_body"] = normalizeACLBody(body)
+	}
+	return rawState, nil
+}
+
 func ACLBodyDiffFunc(olds, news string, d *schema.ResourceData) (result bool) {
 	defer logElapsed("resource.tencentcloud_cos_bucket.ACLBodyDiffFunc")()
 	log.Printf("[DEBUG] ACLBodyDiffFunc called, before:[\n%s\n], after:[\n%s\n]\n", olds, news)
@@ -26,10 +26,10 @@ package tencentcloud
 import (
 	"context"
 	"encoding/xml"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -39,12 +39,15 @@ import (
 
 func resourceTencentCloudCosBucketDomainCertificateAttachment() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudCosBucketDomainCertificateAttachmentRead,
-		Create: resourceTencentCloudCosBucketDomainCertificateAttachmentCreate,
+		ReadContext:   resourceTencentCloudCosBucketDomainCertificateAttachmentRead,
+		CreateContext: resourceTencentCloudCosBucketDomainCertificateAttachmentCreate,
 		// Update: resourceTencentCloudCosBucketDomainCertificateAttachmentUpdate,
-		Delete: resourceTencentCloudCosBucketDomainCertificateAttachmentDelete,
+		DeleteContext: resourceTencentCloudCosBucketDomainCertificateAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -109,18 +112,18 @@ func resourceTencentCloudCosBucketDomainCertificateAttachment() *schema.Resource
 	}
 }
 
-func resourceTencentCloudCosBucketDomainCertificateAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketDomainCertificateAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_domain_certificate_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var bucket string
 
 	if v, ok := d.GetOk("bucket"); ok {
 		bucket = v.(string)
 	} else {
-		return errors.New("get bucket failed!")
+		return diag.FromErr(errors.New("get bucket failed!"))
 	}
 
 	option := cos.BucketPutDomainCertificateOptions{}
@@ -148,7 +151,7 @@ func resourceTencentCloudCosBucketDomainCertificateAttachmentCreate(d *schema.Re
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTencentCosClient(bucket).Bucket.PutDomainCertificate(ctx, &option)
 		if e != nil {
 			return retryError(e)
@@ -162,21 +165,21 @@ func resourceTencentCloudCosBucketDomainCertificateAttachmentCreate(d *schema.Re
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cos bucketDomainCertificate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	ids := strings.Join([]string{bucket, option.DomainList[0]}, FILED_SP)
+	ids := helper.IdFormat(bucket, option.DomainList[0])
 	d.SetId(ids)
 
 	return nil
 }
 
-func resourceTencentCloudCosBucketDomainCertificateAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketDomainCertificateAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_domain_certificate_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CosService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -185,12 +188,12 @@ func resourceTencentCloudCosBucketDomainCertificateAttachmentRead(d *schema.Reso
 	certResult, bucket, err := service.DescribeCosBucketDomainCertificate(ctx, id)
 	log.Printf("[DEBUG] resource `bucketDomainCertificate certResult:%s`\n", certResult)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if certResult == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `bucketDomainCertificate` %s does not exist", id)
+		return diag.Errorf("resource `bucketDomainCertificate` %s does not exist", id)
 	}
 
 	_ = d.Set("bucket", bucket)
@@ -198,18 +201,18 @@ func resourceTencentCloudCosBucketDomainCertificateAttachmentRead(d *schema.Reso
 	return nil
 }
 
-func resourceTencentCloudCosBucketDomainCertificateAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketDomainCertificateAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 	defer logElapsed("resource.tencentcloud_cos_bucket_domain_certificate_attachment.delete id:", id)()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CosService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	if err := service.DeleteCosBucketDomainCertificate(ctx, id); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -19,6 +19,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentyun/cos-go-sdk-v5"
@@ -26,9 +27,12 @@ import (
 
 func resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationCreate,
-		Read:   resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationRead,
-		Delete: resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationDelete,
+		CreateContext: resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationCreate,
+		ReadContext:   resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationRead,
+		DeleteContext: resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"inventory_id": {
@@ -47,18 +51,18 @@ func resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperation() *schem
 	}
 }
 
-func resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_generate_inventory_immediately_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	inventoryId := d.Get("inventory_id").(string)
 	bucket := d.Get("bucket").(string)
 	result, _, err := meta.(*TencentCloudClient).apiV3Conn.UseTencentCosClient(bucket).Bucket.GetInventory(ctx, inventoryId)
 	if err != nil {
 		log.Printf("[CRITAL]%s get cos bucketInventory failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	id := fmt.Sprintf("%s_instant_%s", result.ID, time.Now().Format("20060102150405"))
 	inventoryOpt := &cos.BucketPostInventoryOptions{
@@ -70,13 +74,13 @@ func resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationCreate(d
 	}
 	_, err = meta.(*TencentCloudClient).apiV3Conn.UseTencentCosClient(bucket).Bucket.PostInventory(ctx, id, inventoryOpt)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	items := strings.Split(result.Destination.Bucket, "::")
 	targetBucket := items[len(items)-1]
 	targetBucketItems := strings.Split(targetBucket, "-")
 	objectId := fmt.Sprintf("%s/%s/%s/%s/%s/manifest.json", result.Destination.Prefix, targetBucketItems[len(targetBucketItems)-1], strings.Join(targetBucketItems[:len(targetBucketItems)-1], "-"), id, time.Now().Format("20060102"))
-	err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		resp, e := meta.(*TencentCloudClient).apiV3Conn.UseTencentCosClient(targetBucket).Object.Head(ctx, objectId, nil)
 		if resp.StatusCode == 404 {
 			return resource.RetryableError(fmt.Errorf("Inventory still creating!"))
@@ -89,21 +93,21 @@ func resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationCreate(d
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s PostInventory: object not exist, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(id)
 
-	return resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationRead(d, meta)
+	return resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_generate_inventory_immediately_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketGenerateInventoryImmediatelyOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_generate_inventory_immediately_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
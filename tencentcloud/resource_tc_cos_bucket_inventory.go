@@ -43,24 +43,30 @@ package tencentcloud
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	cos "github.com/tencentyun/cos-go-sdk-v5"
 )
 
 func resourceTencentCloudCosBucketInventory() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCosBucketInventoryCreate,
-		Read:   resourceTencentCloudCosBucketInventoryRead,
-		Update: resourceTencentCloudCosBucketInventoryUpdate,
-		Delete: resourceTencentCloudCosBucketInventoryDelete,
+		CreateContext: resourceTencentCloudCosBucketInventoryCreate,
+		ReadContext:   resourceTencentCloudCosBucketInventoryRead,
+		UpdateContext: resourceTencentCloudCosBucketInventoryUpdate,
+		DeleteContext: resourceTencentCloudCosBucketInventoryDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -121,9 +127,9 @@ func resourceTencentCloudCosBucketInventory() *schema.Resource {
 				},
 			},
 			"optional_fields": {
-				Type:     schema.TypeList,
-				MaxItems: 1,
-				Optional: true,
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
 				Description: "Analysis items to include in the inventory result	.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -202,12 +208,12 @@ func resourceTencentCloudCosBucketInventory() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCosBucketInventoryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketInventoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_inventory.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	bucket := d.Get("bucket").(string)
 	name := d.Get("name").(string)
 	isEnabled := d.Get("is_enabled").(string)
@@ -222,14 +228,14 @@ func resourceTencentCloudCosBucketInventoryCreate(d *schema.ResourceData, meta i
 			if v, ok := periodMap["start_time"]; ok && v.(string) != "" {
 				vStr, err := strconv.ParseInt(v.(string), 10, 64)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				period.StartTime = vStr
 			}
 			if v, ok := periodMap["end_time"]; ok && v.(string) != "" {
 				vStr, err := strconv.ParseInt(v.(string), 10, 64)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				period.EndTime = vStr
 			}
@@ -294,7 +300,7 @@ func resourceTencentCloudCosBucketInventoryCreate(d *schema.ResourceData, meta i
 		Destination:            &destination,
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		req, _ := json.Marshal(opt)
 		resp, e := meta.(*TencentCloudClient).apiV3Conn.UseTencentCosClient(bucket).Bucket.PutInventory(ctx, name, opt)
 		responseBody, _ := json.Marshal(resp.Body)
@@ -306,30 +312,30 @@ func resourceTencentCloudCosBucketInventoryCreate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cos bucketInventory failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
-	d.SetId(bucket + FILED_SP + name)
+	d.SetId(helper.IdFormat(bucket, name))
 
-	return resourceTencentCloudCosBucketInventoryRead(d, meta)
+	return resourceTencentCloudCosBucketInventoryRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCosBucketInventoryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketInventoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_inventory.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bucket := idSplit[0]
 	name := idSplit[1]
 	result, _, err := meta.(*TencentCloudClient).apiV3Conn.UseTencentCosClient(bucket).Bucket.GetInventory(ctx, name)
 	if err != nil {
 		log.Printf("[CRITAL]%s get cos bucketInventory failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("bucket", bucket)
 	_ = d.Set("name", name)
@@ -385,19 +391,19 @@ func resourceTencentCloudCosBucketInventoryRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudCosBucketInventoryUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketInventoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_inventory.update")()
 	defer inconsistentCheck(d, meta)()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	bucket := idSplit[0]
 	name := idSplit[1]
 	if !d.HasChange("is_enabled") && !d.HasChange("included_object_versions") && !d.HasChange("filter") && !d.HasChange("optional_fields") && !d.HasChange("schedule") && !d.HasChange("destination") {
-		return resourceTencentCloudCosBucketInventoryRead(d, meta)
+		return resourceTencentCloudCosBucketInventoryRead(ctx, d, meta)
 	}
 	isEnabled := d.Get("is_enabled").(string)
 	includedObjectVersions := d.Get("included_object_versions").(string)
@@ -411,14 +417,14 @@ func resourceTencentCloudCosBucketInventoryUpdate(d *schema.ResourceData, meta i
 			if v, ok := periodMap["start_time"]; ok && v.(string) != "" {
 				vStr, err := strconv.ParseInt(v.(string), 10, 64)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				period.StartTime = vStr
 			}
 			if v, ok := periodMap["end_time"]; ok && v.(string) != "" {
 				vStr, err := strconv.ParseInt(v.(string), 10, 64)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				period.EndTime = vStr
 			}
@@ -483,7 +489,7 @@ func resourceTencentCloudCosBucketInventoryUpdate(d *schema.ResourceData, meta i
 		Destination:            &destination,
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		req, _ := json.Marshal(opt)
 		resp, e := meta.(*TencentCloudClient).apiV3Conn.UseTencentCosClient(bucket).Bucket.PutInventory(ctx, name, opt)
 		responseBody, _ := json.Marshal(resp.Body)
@@ -495,27 +501,27 @@ func resourceTencentCloudCosBucketInventoryUpdate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cos bucketInventory failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCosBucketInventoryRead(d, meta)
+	return resourceTencentCloudCosBucketInventoryRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCosBucketInventoryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketInventoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_inventory.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	bucket := idSplit[0]
 	name := idSplit[1]
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		resp, e := meta.(*TencentCloudClient).apiV3Conn.UseTencentCosClient(bucket).Bucket.DeleteInventory(ctx, name)
 		if e != nil {
 			log.Printf("[CRITAL][retry]%s api[%s] fail, resp body [%s], reason[%s]\n",
@@ -526,7 +532,7 @@ func resourceTencentCloudCosBucketInventoryDelete(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete cos bucketInventory failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
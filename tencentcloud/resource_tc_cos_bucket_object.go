@@ -27,6 +27,14 @@ resource "tencentcloud_cos_bucket_object" "myobject" {
   content = "the content that you want to upload."
 }
 ```
+
+Import
+
+COS bucket object can be imported using the id `bucket#key`, e.g.
+
+```
+$ terraform import tencentcloud_cos_bucket_object.myobject mycos-1258798060#new_object_key
+```
 */
 package tencentcloud
 
@@ -42,16 +50,20 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mitchellh/go-homedir"
 )
 
 func resourceTencentCloudCosBucketObject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCosBucketObjectCreate,
-		Read:   resourceTencentCloudCosBucketObjectRead,
-		Update: resourceTencentCloudCosBucketObjectUpdate,
-		Delete: resourceTencentCloudCosBucketObjectDelete,
+		CreateContext: resourceTencentCloudCosBucketObjectCreate,
+		ReadContext:   resourceTencentCloudCosBucketObjectRead,
+		UpdateContext: resourceTencentCloudCosBucketObjectUpdate,
+		DeleteContext: resourceTencentCloudCosBucketObjectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTencentCloudCosBucketObjectImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -132,7 +144,7 @@ func resourceTencentCloudCosBucketObject() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCosBucketObjectCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketObjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_object.create")()
 
 	logId := getLogId(contextNil)
@@ -144,11 +156,11 @@ func resourceTencentCloudCosBucketObjectCreate(d *schema.ResourceData, meta inte
 		source := v.(string)
 		path, err := homedir.Expand(source)
 		if err != nil {
-			return fmt.Errorf("cos object source (%s) homedir expand error: %s", source, err.Error())
+			return diag.Errorf("cos object source (%s) homedir expand error: %s", source, err.Error())
 		}
 		file, err := os.Open(path)
 		if err != nil {
-			return fmt.Errorf("cos object source (%s) open error: %s", source, err.Error())
+			return diag.Errorf("cos object source (%s) open error: %s", source, err.Error())
 		}
 		body = file
 		defer func() {
@@ -161,7 +173,7 @@ func resourceTencentCloudCosBucketObjectCreate(d *schema.ResourceData, meta inte
 		content := v.(string)
 		body = bytes.NewReader([]byte(content))
 	} else {
-		return fmt.Errorf("must specify \"source\" or \"content\" field")
+		return diag.Errorf("must specify \"source\" or \"content\" field")
 	}
 
 	request := &s3.PutObjectInput{
@@ -189,15 +201,16 @@ func resourceTencentCloudCosBucketObjectCreate(d *schema.ResourceData, meta inte
 		request.StorageClass = aws.String(v.(string))
 	}
 
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().PutObject(request)
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseCosClient().PutObjectWithContext(ctx, request)
 	if err != nil {
-		return fmt.Errorf("putting object (%s) in cos bucket (%s) error: %s", key, bucket, err.Error())
+		return diag.Errorf("putting object (%s) in cos bucket (%s) error: %s", key, bucket, err.Error())
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, "put object", request.String(), response.String())
 
+	var diags diag.Diagnostics
 	if v, ok := d.GetOk("tags"); ok {
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		service := CosService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
 		}
@@ -209,18 +222,19 @@ func resourceTencentCloudCosBucketObjectCreate(d *schema.ResourceData, meta inte
 
 		if err := service.SetObjectTags(ctx, bucket, key, tags); err != nil {
 			log.Printf("[WARN] set object tags error, skip processing")
+			diags = warningDiagnostics(diag.Errorf("set the tags of object %s failed, reason: %v", key, err))
 		}
 	}
 
 	d.SetId(bucket + key)
-	return resourceTencentCloudCosBucketObjectRead(d, meta)
+	return append(diags, resourceTencentCloudCosBucketObjectRead(ctx, d, meta)...)
 }
 
-func resourceTencentCloudCosBucketObjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_object.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
@@ -235,7 +249,7 @@ func resourceTencentCloudCosBucketObjectRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("cache_control", response.CacheControl)
@@ -250,7 +264,7 @@ func resourceTencentCloudCosBucketObjectRead(d *schema.ResourceData, meta interf
 
 	_, aclResponse, aclErr := meta.(*TencentCloudClient).apiV3Conn.UseTencentCosClient(bucket).Object.GetACL(ctx, key)
 	if aclErr != nil {
-		return fmt.Errorf("cos [GetACL] error: %s, bucket: %s, object: %s", aclErr.Error(), bucket, key)
+		return diag.Errorf("cos [GetACL] error: %s, bucket: %s, object: %s", aclErr.Error(), bucket, key)
 	}
 	if aclResponse.StatusCode == 404 {
 		log.Printf("[WARN] [GetACL] returns %d, %s", 404, err)
@@ -267,18 +281,18 @@ func resourceTencentCloudCosBucketObjectRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudCosBucketObjectUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketObjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_object.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	fields := []string{
 		"cache_control",
@@ -292,7 +306,7 @@ func resourceTencentCloudCosBucketObjectUpdate(d *schema.ResourceData, meta inte
 	}
 	for _, key := range fields {
 		if d.HasChange(key) {
-			return resourceTencentCloudCosBucketObjectCreate(d, meta)
+			return resourceTencentCloudCosBucketObjectCreate(ctx, d, meta)
 		}
 	}
 
@@ -306,7 +320,7 @@ func resourceTencentCloudCosBucketObjectUpdate(d *schema.ResourceData, meta inte
 		acl := d.Get("acl").(string)
 		err := cosService.PutObjectAcl(ctx, bucket, key, acl)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -317,18 +331,18 @@ func resourceTencentCloudCosBucketObjectUpdate(d *schema.ResourceData, meta inte
 			tags[key] = val.(string)
 		}
 		if err := cosService.SetObjectTags(ctx, bucket, key, tags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceTencentCloudCosBucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketObjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_object.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
@@ -338,8 +352,21 @@ func resourceTencentCloudCosBucketObjectDelete(d *schema.ResourceData, meta inte
 	}
 	err := cosService.DeleteObject(ctx, bucket, key)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
+
+// resourceTencentCloudCosBucketObjectImport imports the object by `bucket#key`.
+func resourceTencentCloudCosBucketObjectImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	items := strings.SplitN(d.Id(), FILED_SP, 2)
+	if len(items) != 2 || items[0] == "" || items[1] == "" {
+		return nil, fmt.Errorf("id is broken, expected format bucket#key, got %s", d.Id())
+	}
+	bucket, key := items[0], items[1]
+	_ = d.Set("bucket", bucket)
+	_ = d.Set("key", key)
+	d.SetId(bucket + key)
+	return []*schema.ResourceData{d}, nil
+}
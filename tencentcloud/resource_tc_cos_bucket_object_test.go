@@ -46,6 +46,13 @@ func TestAccTencentCloudCosBucketObjectResource_source(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_cos_bucket_object.object_source", "content_type", "binary/octet-stream"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_cos_bucket_object.object_source",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccImportStateIdFunc("tencentcloud_cos_bucket_object.object_source", "bucket", "key"),
+				ImportStateVerifyIgnore: []string{"source", "content"},
+			},
 		},
 	})
 }
@@ -49,6 +49,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -56,12 +57,12 @@ import (
 
 func resourceTencentCloudCosBucketPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCosBucketPolicyCreate,
-		Read:   resourceTencentCloudCosBucketPolicyRead,
-		Update: resourceTencentCloudCosBucketPolicyUpdate,
-		Delete: resourceTencentCloudCosBucketPolicyDelete,
+		CreateContext: resourceTencentCloudCosBucketPolicyCreate,
+		ReadContext:   resourceTencentCloudCosBucketPolicyRead,
+		UpdateContext: resourceTencentCloudCosBucketPolicyUpdate,
+		DeleteContext: resourceTencentCloudCosBucketPolicyDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -96,11 +97,11 @@ func resourceTencentCloudCosBucketPolicy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCosBucketPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_policy.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	bucket := d.Get("bucket").(string)
 	policy := d.Get("policy").(string)
 
@@ -112,29 +113,29 @@ func resourceTencentCloudCosBucketPolicyCreate(d *schema.ResourceData, meta inte
 	}
 	policyErr := camService.PolicyDocumentForceCheck(policy)
 	if policyErr != nil {
-		return policyErr
+		return diag.FromErr(policyErr)
 	}
 
 	err := cosService.PutBucketPolicy(ctx, bucket, policy)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(bucket)
 
-	return resourceTencentCloudCosBucketPolicyRead(d, meta)
+	return resourceTencentCloudCosBucketPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCosBucketPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_policy.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	bucket := d.Id()
 	cosService := CosService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var result string
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		policy, e := cosService.DescribePolicyByBucket(ctx, bucket)
 		if e != nil {
 			return retryError(e)
@@ -144,12 +145,12 @@ func resourceTencentCloudCosBucketPolicyRead(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read cos bucket policy failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	result, err = removeSid(result)
 	if err != nil {
 		log.Printf("[CRITAL]%s read cos bucket policy failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if result == "" {
 		d.SetId("")
@@ -161,11 +162,11 @@ func resourceTencentCloudCosBucketPolicyRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudCosBucketPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_policy.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cosService := CosService{client: meta.(*TencentCloudClient).apiV3Conn}
 	bucket := d.Id()
 
@@ -176,11 +177,11 @@ func resourceTencentCloudCosBucketPolicyUpdate(d *schema.ResourceData, meta inte
 		}
 		policyErr := camService.PolicyDocumentForceCheck(policy)
 		if policyErr != nil {
-			return policyErr
+			return diag.FromErr(policyErr)
 		}
 		err := cosService.PutBucketPolicy(ctx, bucket, policy)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -188,14 +189,14 @@ func resourceTencentCloudCosBucketPolicyUpdate(d *schema.ResourceData, meta inte
 	// if not, the data may be outdated.
 	time.Sleep(3 * time.Second)
 
-	return resourceTencentCloudCosBucketPolicyRead(d, meta)
+	return resourceTencentCloudCosBucketPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCosBucketPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_policy.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	bucket := d.Id()
 	cosService := CosService{
@@ -203,7 +204,7 @@ func resourceTencentCloudCosBucketPolicyDelete(d *schema.ResourceData, meta inte
 	}
 	err := cosService.DeleteBucketPolicy(ctx, bucket)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// wait for update cache
@@ -26,7 +26,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cos "github.com/tencentyun/cos-go-sdk-v5"
@@ -34,12 +36,15 @@ import (
 
 func resourceTencentCloudCosBucketReferer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCosBucketRefererCreate,
-		Read:   resourceTencentCloudCosBucketRefererRead,
-		Update: resourceTencentCloudCosBucketRefererUpdate,
-		Delete: resourceTencentCloudCosBucketRefererDelete,
+		CreateContext: resourceTencentCloudCosBucketRefererCreate,
+		ReadContext:   resourceTencentCloudCosBucketRefererRead,
+		UpdateContext: resourceTencentCloudCosBucketRefererUpdate,
+		DeleteContext: resourceTencentCloudCosBucketRefererDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -74,7 +79,7 @@ func resourceTencentCloudCosBucketReferer() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCosBucketRefererCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketRefererCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_referer.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -85,15 +90,15 @@ func resourceTencentCloudCosBucketRefererCreate(d *schema.ResourceData, meta int
 
 	d.SetId(bucket)
 
-	return resourceTencentCloudCosBucketRefererUpdate(d, meta)
+	return resourceTencentCloudCosBucketRefererUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudCosBucketRefererRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketRefererRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_referer.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CosService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -101,7 +106,7 @@ func resourceTencentCloudCosBucketRefererRead(d *schema.ResourceData, meta inter
 
 	bucketReferer, err := service.DescribeCosBucketRefererById(ctx, bucket)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if bucketReferer == nil {
@@ -131,12 +136,12 @@ func resourceTencentCloudCosBucketRefererRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudCosBucketRefererUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketRefererUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_referer.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	bucket := d.Id()
 
@@ -158,7 +163,7 @@ func resourceTencentCloudCosBucketRefererUpdate(d *schema.ResourceData, meta int
 		request.EmptyReferConfiguration = v.(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTencentCosClient(bucket).Bucket.PutReferer(ctx, &request)
 		if e != nil {
 			return retryError(e)
@@ -169,13 +174,13 @@ func resourceTencentCloudCosBucketRefererUpdate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s cos bucketReferer failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCosBucketRefererRead(d, meta)
+	return resourceTencentCloudCosBucketRefererRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCosBucketRefererDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketRefererDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_referer.delete")()
 	defer inconsistentCheck(d, meta)()
 
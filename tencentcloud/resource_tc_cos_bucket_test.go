@@ -136,6 +136,25 @@ func TestAccTencentCloudCosBucketResource_ACL(t *testing.T) {
 	})
 }
 
+func TestResourceTencentCloudCosBucketStateUpgradeV0(t *testing.T) {
+	testStateUpgrade(t, resourceTencentCloudCosBucket(), 0, `{
+  "id": "test-1250000000",
+  "bucket": "test-1250000000",
+  "acl": "public-read",
+  "acl_body": "<AccessControlPolicy><Owner><ID>qcs::cam::uin/100000000001:uin/100000000001</ID><DisplayName>qcs::cam::uin/100000000001:uin/100000000001</DisplayName></Owner><AccessControlList><Grant><Grantee xmlns:xsi=\"http://www.w3.org/2001/XMLSchema-instance\" xsi:type=\"Group\"><URI>http://cam.qcloud.com/groups/global/AllUsers</URI></Grantee><Permission>READ</Permission></Grant></AccessControlList></AccessControlPolicy>"
+}`, `{
+  "id": "test-1250000000",
+  "bucket": "test-1250000000",
+  "acl": "public-read",
+  "acl_body": "<AccessControlPolicy>\n  <Owner>\n    <ID>qcs::cam::uin/100000000001:uin/100000000001</ID>\n    <DisplayName>qcs::cam::uin/100000000001:uin/100000000001</DisplayName>\n  </Owner>\n  <AccessControlList>\n    <Grant>\n      <Grantee xmlns:xsi=\"http://www.w3.org/2001/XMLSchema-instance\" xsi:type=\"Group\">\n        <URI>http://cam.qcloud.com/groups/global/AllUsers</URI>\n      </Grantee>\n      <Permission>READ</Permission>\n    </Grant>\n  </AccessControlList>\n</AccessControlPolicy>\n"
+}`)
+
+	// the buckets without acl_body are kept as they are
+	testStateUpgrade(t, resourceTencentCloudCosBucket(), 0,
+		`{"id": "test-1250000000", "bucket": "test-1250000000", "acl_body": ""}`,
+		`{"id": "test-1250000000", "bucket": "test-1250000000", "acl_body": ""}`)
+}
+
 func TestAccTencentCloudCosBucketResource_tags(t *testing.T) {
 	t.Parallel()
 
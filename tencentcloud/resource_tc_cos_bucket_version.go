@@ -23,7 +23,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cos "github.com/tencentyun/cos-go-sdk-v5"
@@ -31,12 +33,15 @@ import (
 
 func resourceTencentCloudCosBucketVersion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCosBucketVersionCreate,
-		Read:   resourceTencentCloudCosBucketVersionRead,
-		Update: resourceTencentCloudCosBucketVersionUpdate,
-		Delete: resourceTencentCloudCosBucketVersionDelete,
+		CreateContext: resourceTencentCloudCosBucketVersionCreate,
+		ReadContext:   resourceTencentCloudCosBucketVersionRead,
+		UpdateContext: resourceTencentCloudCosBucketVersionUpdate,
+		DeleteContext: resourceTencentCloudCosBucketVersionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -54,7 +59,7 @@ func resourceTencentCloudCosBucketVersion() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCosBucketVersionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_version.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -65,15 +70,15 @@ func resourceTencentCloudCosBucketVersionCreate(d *schema.ResourceData, meta int
 
 	d.SetId(bucket)
 
-	return resourceTencentCloudCosBucketVersionUpdate(d, meta)
+	return resourceTencentCloudCosBucketVersionUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudCosBucketVersionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_version.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CosService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -81,7 +86,7 @@ func resourceTencentCloudCosBucketVersionRead(d *schema.ResourceData, meta inter
 
 	bucketVersion, err := service.DescribeCosBucketVersionById(ctx, bucket)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if bucketVersion == nil {
@@ -99,12 +104,12 @@ func resourceTencentCloudCosBucketVersionRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudCosBucketVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_version.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	bucket := d.Id()
 
@@ -113,7 +118,7 @@ func resourceTencentCloudCosBucketVersionUpdate(d *schema.ResourceData, meta int
 		request.Status = v.(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTencentCosClient(bucket).Bucket.PutVersioning(ctx, &request)
 		if e != nil {
 			return retryError(e)
@@ -124,13 +129,13 @@ func resourceTencentCloudCosBucketVersionUpdate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s cos versioning failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCosBucketVersionRead(d, meta)
+	return resourceTencentCloudCosBucketVersionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCosBucketVersionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosBucketVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_bucket_version.delete")()
 	defer inconsistentCheck(d, meta)()
 
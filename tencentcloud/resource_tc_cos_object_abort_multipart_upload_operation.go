@@ -17,14 +17,15 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTencentCloudCosObjectAbortMultipartUploadOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCosObjectAbortMultipartUploadOperationCreate,
-		Read:   resourceTencentCloudCosObjectAbortMultipartUploadOperationRead,
-		Delete: resourceTencentCloudCosObjectAbortMultipartUploadOperationDelete,
+		CreateContext: resourceTencentCloudCosObjectAbortMultipartUploadOperationCreate,
+		ReadContext:   resourceTencentCloudCosObjectAbortMultipartUploadOperationRead,
+		DeleteContext: resourceTencentCloudCosObjectAbortMultipartUploadOperationDelete,
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -49,34 +50,34 @@ func resourceTencentCloudCosObjectAbortMultipartUploadOperation() *schema.Resour
 	}
 }
 
-func resourceTencentCloudCosObjectAbortMultipartUploadOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosObjectAbortMultipartUploadOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_object_abort_multipart_upload_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 	uploadId := d.Get("upload_id").(string)
 	_, err := meta.(*TencentCloudClient).apiV3Conn.UseTencentCosClient(bucket).Object.AbortMultipartUpload(ctx, key, uploadId)
 	if err != nil {
 		log.Printf("[CRITAL]%s AbortMultipartUpload failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(uploadId)
 
-	return resourceTencentCloudCosObjectAbortMultipartUploadOperationRead(d, meta)
+	return resourceTencentCloudCosObjectAbortMultipartUploadOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCosObjectAbortMultipartUploadOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosObjectAbortMultipartUploadOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_object_abort_multipart_upload_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCosObjectAbortMultipartUploadOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosObjectAbortMultipartUploadOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_object_abort_multipart_upload_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -17,14 +17,16 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCosObjectCopyOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCosObjectCopyOperationCreate,
-		Read:   resourceTencentCloudCosObjectCopyOperationRead,
-		Delete: resourceTencentCloudCosObjectCopyOperationDelete,
+		CreateContext: resourceTencentCloudCosObjectCopyOperationCreate,
+		ReadContext:   resourceTencentCloudCosObjectCopyOperationRead,
+		DeleteContext: resourceTencentCloudCosObjectCopyOperationDelete,
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -49,34 +51,34 @@ func resourceTencentCloudCosObjectCopyOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCosObjectCopyOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosObjectCopyOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_object_copy_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 	sourceURL := d.Get("source_url").(string)
 	_, _, err := meta.(*TencentCloudClient).apiV3Conn.UseTencentCosClient(bucket).Object.Copy(ctx, key, sourceURL, nil)
 	if err != nil {
 		log.Printf("[CRITAL]%s Restore failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(bucket + FILED_SP + key)
+	d.SetId(helper.IdFormat(bucket, key))
 
-	return resourceTencentCloudCosObjectCopyOperationRead(d, meta)
+	return resourceTencentCloudCosObjectCopyOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCosObjectCopyOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosObjectCopyOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_object_copy_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCosObjectCopyOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosObjectCopyOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_object_copy_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -19,14 +19,16 @@ import (
 	"log"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCosObjectDownloadOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCosObjectDownloadOperationCreate,
-		Read:   resourceTencentCloudCosObjectDownloadOperationRead,
-		Delete: resourceTencentCloudCosObjectDownloadOperationDelete,
+		CreateContext: resourceTencentCloudCosObjectDownloadOperationCreate,
+		ReadContext:   resourceTencentCloudCosObjectDownloadOperationRead,
+		DeleteContext: resourceTencentCloudCosObjectDownloadOperationDelete,
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -51,46 +53,46 @@ func resourceTencentCloudCosObjectDownloadOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCosObjectDownloadOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosObjectDownloadOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_object_download_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 	downloadPath := d.Get("download_path").(string)
 	resp, err := meta.(*TencentCloudClient).apiV3Conn.UseTencentCosClient(bucket).Object.Get(ctx, key, nil)
 	if err != nil {
 		log.Printf("[CRITAL]%s object download failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	defer resp.Body.Close()
 
 	fd, err := os.OpenFile(downloadPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0660)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, err = io.Copy(fd, resp.Body)
 	fd.Close()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(bucket + FILED_SP + key)
+	d.SetId(helper.IdFormat(bucket, key))
 
-	return resourceTencentCloudCosObjectDownloadOperationRead(d, meta)
+	return resourceTencentCloudCosObjectDownloadOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCosObjectDownloadOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosObjectDownloadOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_object_download_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCosObjectDownloadOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosObjectDownloadOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_object_download_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -18,15 +18,17 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	cos "github.com/tencentyun/cos-go-sdk-v5"
 )
 
 func resourceTencentCloudCosObjectRestoreOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCosObjectRestoreOperationCreate,
-		Read:   resourceTencentCloudCosObjectRestoreOperationRead,
-		Delete: resourceTencentCloudCosObjectRestoreOperationDelete,
+		CreateContext: resourceTencentCloudCosObjectRestoreOperationCreate,
+		ReadContext:   resourceTencentCloudCosObjectRestoreOperationRead,
+		DeleteContext: resourceTencentCloudCosObjectRestoreOperationDelete,
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -64,12 +66,12 @@ func resourceTencentCloudCosObjectRestoreOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCosObjectRestoreOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosObjectRestoreOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_object_restore_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 	tier := d.Get("tier").(string)
@@ -83,22 +85,22 @@ func resourceTencentCloudCosObjectRestoreOperationCreate(d *schema.ResourceData,
 	_, err := meta.(*TencentCloudClient).apiV3Conn.UseTencentCosClient(bucket).Object.PostRestore(ctx, key, opt)
 	if err != nil {
 		log.Printf("[CRITAL]%s Restore failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(bucket + FILED_SP + key)
+	d.SetId(helper.IdFormat(bucket, key))
 
-	return resourceTencentCloudCosObjectRestoreOperationRead(d, meta)
+	return resourceTencentCloudCosObjectRestoreOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCosObjectRestoreOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosObjectRestoreOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_object_restore_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCosObjectRestoreOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCosObjectRestoreOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cos_object_restore_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
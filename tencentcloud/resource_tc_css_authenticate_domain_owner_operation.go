@@ -22,9 +22,11 @@ resource "tencentcloud_css_authenticate_domain_owner_operation" "fileCheck" {
 package tencentcloud
 
 import (
-	"fmt"
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	css "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/live/v20180801"
@@ -33,9 +35,12 @@ import (
 
 func resourceTencentCloudCssAuthenticateDomainOwnerOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCssAuthenticateDomainOwnerOperationCreate,
-		Read:   resourceTencentCloudCssAuthenticateDomainOwnerOperationRead,
-		Delete: resourceTencentCloudCssAuthenticateDomainOwnerOperationDelete,
+		CreateContext: resourceTencentCloudCssAuthenticateDomainOwnerOperationCreate,
+		ReadContext:   resourceTencentCloudCssAuthenticateDomainOwnerOperationRead,
+		DeleteContext: resourceTencentCloudCssAuthenticateDomainOwnerOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
 				Required:    true,
@@ -55,7 +60,7 @@ func resourceTencentCloudCssAuthenticateDomainOwnerOperation() *schema.Resource
 	}
 }
 
-func resourceTencentCloudCssAuthenticateDomainOwnerOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssAuthenticateDomainOwnerOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_css_authenticate_domain_owner_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -75,8 +80,8 @@ func resourceTencentCloudCssAuthenticateDomainOwnerOperationCreate(d *schema.Res
 		request.VerifyType = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().AuthenticateDomainOwner(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().AuthenticateDomainOwnerWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -94,7 +99,7 @@ func resourceTencentCloudCssAuthenticateDomainOwnerOperationCreate(d *schema.Res
 		name = *response.Response.MainDomain
 		status = *response.Response.Status
 		if status < CSS_OWERSHIP_VIRIFIED {
-			return fmt.Errorf("Authenticate domain failed. Please check your domain and retry. MainDomain:[%s] Content:[%s], ", name, *response.Response.Content)
+			return diag.Errorf("Authenticate domain failed. Please check your domain and retry. MainDomain:[%s] Content:[%s], ", name, *response.Response.Content)
 		}
 	}
 
@@ -103,14 +108,14 @@ func resourceTencentCloudCssAuthenticateDomainOwnerOperationCreate(d *schema.Res
 	return nil
 }
 
-func resourceTencentCloudCssAuthenticateDomainOwnerOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssAuthenticateDomainOwnerOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_authenticate_domain_owner_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCssAuthenticateDomainOwnerOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssAuthenticateDomainOwnerOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_authenticate_domain_owner_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
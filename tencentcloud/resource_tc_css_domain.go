@@ -26,9 +26,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	css "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/live/v20180801"
@@ -37,12 +38,16 @@ import (
 
 func resourceTencentCloudCssDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCssDomainCreate,
-		Read:   resourceTencentCloudCssDomainRead,
-		Update: resourceTencentCloudCssDomainUpdate,
-		Delete: resourceTencentCloudCssDomainDelete,
+		CreateContext: resourceTencentCloudCssDomainCreate,
+		ReadContext:   resourceTencentCloudCssDomainRead,
+		UpdateContext: resourceTencentCloudCssDomainUpdate,
+		DeleteContext: resourceTencentCloudCssDomainDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
@@ -92,18 +97,18 @@ func resourceTencentCloudCssDomain() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCssDomainCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_domain.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId       = getLogId(contextNil)
-		ctx         = context.WithValue(context.TODO(), logIdKey, logId)
 		addRequest  = css.NewAddLiveDomainRequest()
 		authRequest = css.NewAuthenticateDomainOwnerRequest()
 		domainName  string
 		enable      *bool
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if v, ok := d.GetOk("domain_name"); ok {
 		domainName = v.(string)
 		addRequest.DomainName = helper.String(domainName)
@@ -132,15 +137,15 @@ func resourceTencentCloudCssDomainCreate(d *schema.ResourceData, meta interface{
 		authRequest.VerifyType = verfiryType
 	}
 
-	authRet, authErr := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().AuthenticateDomainOwner(authRequest)
+	authRet, authErr := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().AuthenticateDomainOwnerWithContext(ctx, authRequest)
 	if authErr != nil {
-		return fmt.Errorf("Verify domain ownership failed, please check your domain and retry the authentication process through `tencentcloud_css_authenticate_domain_owner_operation` resource. The error reasons from SDK:[%s]", authErr.Error())
+		return diag.Errorf("Verify domain ownership failed, please check your domain and retry the authentication process through `tencentcloud_css_authenticate_domain_owner_operation` resource. The error reasons from SDK:[%s]", authErr.Error())
 	} else {
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, addRequest.GetAction(), addRequest.ToJsonString(), authRet.ToJsonString())
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().AddLiveDomain(addRequest)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().AddLiveDomainWithContext(ctx, addRequest)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -150,7 +155,7 @@ func resourceTencentCloudCssDomainCreate(d *schema.ResourceData, meta interface{
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create css domain failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(domainName)
@@ -162,19 +167,19 @@ func resourceTencentCloudCssDomainCreate(d *schema.ResourceData, meta interface{
 
 	sErr := domainSwitch(ctx, enable, &domainName, meta)
 	if sErr != nil {
-		return sErr
+		return diag.FromErr(sErr)
 	}
 
-	return resourceTencentCloudCssDomainRead(d, meta)
+	return resourceTencentCloudCssDomainRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCssDomainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_domain.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CssService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -182,7 +187,7 @@ func resourceTencentCloudCssDomainRead(d *schema.ResourceData, meta interface{})
 
 	domain, err := service.DescribeCssDomainById(ctx, domainName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if domain == nil {
@@ -228,23 +233,23 @@ func resourceTencentCloudCssDomainRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudCssDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_domain.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		modifyRequest = css.NewModifyLivePlayDomainRequest()
 		enable        *bool
 		name          = helper.String(d.Id())
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	immutableArgs := []string{"domain_type", "is_delay_live", "is_mini_program_live", "verify_owner_type", "domain_infos"}
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -264,8 +269,8 @@ func resourceTencentCloudCssDomainUpdate(d *schema.ResourceData, meta interface{
 			modifyRequest.PlayType = helper.IntInt64(v.(int))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().ModifyLivePlayDomain(modifyRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().ModifyLivePlayDomainWithContext(ctx, modifyRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -275,7 +280,7 @@ func resourceTencentCloudCssDomainUpdate(d *schema.ResourceData, meta interface{
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update css domain failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -288,10 +293,10 @@ func resourceTencentCloudCssDomainUpdate(d *schema.ResourceData, meta interface{
 
 	sErr := domainSwitch(ctx, enable, name, meta)
 	if sErr != nil {
-		return sErr
+		return diag.FromErr(sErr)
 	}
 
-	return resourceTencentCloudCssDomainRead(d, meta)
+	return resourceTencentCloudCssDomainRead(ctx, d, meta)
 }
 
 func domainSwitch(ctx context.Context, enable *bool, name *string, meta interface{}) error {
@@ -304,8 +309,8 @@ func domainSwitch(ctx context.Context, enable *bool, name *string, meta interfac
 	if enable != nil {
 		if *enable {
 			enableRequest.DomainName = name
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().EnableLiveDomain(enableRequest)
+			err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().EnableLiveDomainWithContext(ctx, enableRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -319,8 +324,8 @@ func domainSwitch(ctx context.Context, enable *bool, name *string, meta interfac
 			}
 		} else {
 			forbidRequest.DomainName = name
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().ForbidLiveDomain(forbidRequest)
+			err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().ForbidLiveDomainWithContext(ctx, forbidRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -337,12 +342,12 @@ func domainSwitch(ctx context.Context, enable *bool, name *string, meta interfac
 	return nil
 }
 
-func resourceTencentCloudCssDomainDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_domain.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CssService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -353,7 +358,7 @@ func resourceTencentCloudCssDomainDelete(d *schema.ResourceData, meta interface{
 	}
 
 	if err := service.DeleteCssDomainById(ctx, helper.String(d.Id()), domainType); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -45,10 +45,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	css "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/live/v20180801"
@@ -57,11 +57,14 @@ import (
 
 func resourceTencentCloudCssLiveTranscodeRuleAttachment() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudCssLiveTranscodeRuleAttachmentRead,
-		Create: resourceTencentCloudCssLiveTranscodeRuleAttachmentCreate,
-		Delete: resourceTencentCloudCssLiveTranscodeRuleAttachmentDelete,
+		ReadContext:   resourceTencentCloudCssLiveTranscodeRuleAttachmentRead,
+		CreateContext: resourceTencentCloudCssLiveTranscodeRuleAttachmentCreate,
+		DeleteContext: resourceTencentCloudCssLiveTranscodeRuleAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
@@ -107,7 +110,7 @@ func resourceTencentCloudCssLiveTranscodeRuleAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCssLiveTranscodeRuleAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssLiveTranscodeRuleAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_live_transcode_rule_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -141,8 +144,8 @@ func resourceTencentCloudCssLiveTranscodeRuleAttachmentCreate(d *schema.Resource
 		request.TemplateId = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().CreateLiveTranscodeRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().CreateLiveTranscodeRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -154,25 +157,25 @@ func resourceTencentCloudCssLiveTranscodeRuleAttachmentCreate(d *schema.Resource
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create css liveTranscodeRuleAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(domainName + FILED_SP + appName + FILED_SP + streamName + FILED_SP + helper.Int64ToStr(templateId))
-	return resourceTencentCloudCssLiveTranscodeRuleAttachmentRead(d, meta)
+	d.SetId(helper.IdFormat(domainName, appName, streamName, helper.Int64ToStr(templateId)))
+	return resourceTencentCloudCssLiveTranscodeRuleAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCssLiveTranscodeRuleAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssLiveTranscodeRuleAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_live_transcode_rule_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CssService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	domainName := idSplit[0]
 	appName := idSplit[1]
@@ -181,12 +184,12 @@ func resourceTencentCloudCssLiveTranscodeRuleAttachmentRead(d *schema.ResourceDa
 
 	rules, err := service.DescribeCssLiveTranscodeRuleAttachment(ctx, helper.String(domainName), helper.String(templateId))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if rules == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `liveTranscodeRuleAttachment` %s does not exist", d.Id())
+		return diag.Errorf("resource `liveTranscodeRuleAttachment` %s does not exist", d.Id())
 	}
 
 	for _, v := range rules {
@@ -224,18 +227,18 @@ func resourceTencentCloudCssLiveTranscodeRuleAttachmentRead(d *schema.ResourceDa
 	return nil
 }
 
-func resourceTencentCloudCssLiveTranscodeRuleAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssLiveTranscodeRuleAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_live_transcode_rule_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CssService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	domainName := idSplit[0]
 	appName := idSplit[1]
@@ -243,7 +246,7 @@ func resourceTencentCloudCssLiveTranscodeRuleAttachmentDelete(d *schema.Resource
 	templateId := idSplit[3]
 
 	if err := service.DeleteCssLiveTranscodeRuleAttachmentById(ctx, helper.String(domainName), helper.String(appName), helper.String(streamName), helper.String(templateId)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -100,7 +100,7 @@ func testAccCheckCssLiveTranscodeRuleAttachmentDestroy(s *terraform.State) error
 			continue
 		}
 
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		domainName := idSplit[0]
 		templateId := idSplit[3]
 
@@ -130,7 +130,7 @@ func testAccCheckCssLiveTranscodeRuleAttachmentExists(re string) resource.TestCh
 		}
 
 		cssService := CssService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		domainName := idSplit[0]
 		templateId := idSplit[3]
 
@@ -41,9 +41,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	css "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/live/v20180801"
@@ -52,12 +53,16 @@ import (
 
 func resourceTencentCloudCssLiveTranscodeTemplate() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudCssLiveTranscodeTemplateRead,
-		Create: resourceTencentCloudCssLiveTranscodeTemplateCreate,
-		Update: resourceTencentCloudCssLiveTranscodeTemplateUpdate,
-		Delete: resourceTencentCloudCssLiveTranscodeTemplateDelete,
+		ReadContext:   resourceTencentCloudCssLiveTranscodeTemplateRead,
+		CreateContext: resourceTencentCloudCssLiveTranscodeTemplateCreate,
+		UpdateContext: resourceTencentCloudCssLiveTranscodeTemplateUpdate,
+		DeleteContext: resourceTencentCloudCssLiveTranscodeTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"template_name": {
@@ -195,7 +200,7 @@ func resourceTencentCloudCssLiveTranscodeTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCssLiveTranscodeTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssLiveTranscodeTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_live_transcode_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -295,8 +300,8 @@ func resourceTencentCloudCssLiveTranscodeTemplateCreate(d *schema.ResourceData,
 		request.DRMTracks = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().CreateLiveTranscodeTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().CreateLiveTranscodeTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -309,21 +314,21 @@ func resourceTencentCloudCssLiveTranscodeTemplateCreate(d *schema.ResourceData,
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create css liveTranscodeTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	templateId = helper.Int64ToStr(*response.Response.TemplateId)
 
 	d.SetId(templateId)
-	return resourceTencentCloudCssLiveTranscodeTemplateRead(d, meta)
+	return resourceTencentCloudCssLiveTranscodeTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCssLiveTranscodeTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssLiveTranscodeTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_live_transcode_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CssService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -332,12 +337,12 @@ func resourceTencentCloudCssLiveTranscodeTemplateRead(d *schema.ResourceData, me
 	liveTranscodeTemplate, err := service.DescribeCssLiveTranscodeTemplate(ctx, helper.StrToInt64Point(templateId))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if liveTranscodeTemplate == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `liveTranscodeTemplate` %s does not exist", templateId)
+		return diag.Errorf("resource `liveTranscodeTemplate` %s does not exist", templateId)
 	}
 
 	if liveTranscodeTemplate.TemplateName != nil {
@@ -431,7 +436,7 @@ func resourceTencentCloudCssLiveTranscodeTemplateRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudCssLiveTranscodeTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssLiveTranscodeTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_live_transcode_template.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -444,7 +449,7 @@ func resourceTencentCloudCssLiveTranscodeTemplateUpdate(d *schema.ResourceData,
 
 	if d.HasChange("template_name") {
 
-		return fmt.Errorf("`template_name` do not support change now.")
+		return diag.Errorf("`template_name` do not support change now.")
 
 	}
 
@@ -461,7 +466,7 @@ func resourceTencentCloudCssLiveTranscodeTemplateUpdate(d *schema.ResourceData,
 	}
 
 	if d.HasChange("audio_bitrate") {
-		return fmt.Errorf("`audio_bitrate` do not support change now.")
+		return diag.Errorf("`audio_bitrate` do not support change now.")
 	}
 
 	if d.HasChange("vcodec") {
@@ -544,7 +549,7 @@ func resourceTencentCloudCssLiveTranscodeTemplateUpdate(d *schema.ResourceData,
 	}
 
 	if d.HasChange("ai_trans_code") {
-		return fmt.Errorf("`ai_trans_code` do not support change now.")
+		return diag.Errorf("`ai_trans_code` do not support change now.")
 	}
 
 	if d.HasChange("adapt_bitrate_percent") {
@@ -574,8 +579,8 @@ func resourceTencentCloudCssLiveTranscodeTemplateUpdate(d *schema.ResourceData,
 
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().ModifyLiveTranscodeTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().ModifyLiveTranscodeTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -587,25 +592,25 @@ func resourceTencentCloudCssLiveTranscodeTemplateUpdate(d *schema.ResourceData,
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create css liveTranscodeTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCssLiveTranscodeTemplateRead(d, meta)
+	return resourceTencentCloudCssLiveTranscodeTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCssLiveTranscodeTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssLiveTranscodeTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_live_transcode_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CssService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	templateId := d.Id()
 
 	if err := service.DeleteCssLiveTranscodeTemplateById(ctx, helper.StrToInt64Point(templateId)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
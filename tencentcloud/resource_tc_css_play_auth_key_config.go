@@ -26,7 +26,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	css "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/live/v20180801"
@@ -35,12 +37,15 @@ import (
 
 func resourceTencentCloudCssPlayAuthKeyConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCssPlayAuthKeyConfigCreate,
-		Read:   resourceTencentCloudCssPlayAuthKeyConfigRead,
-		Update: resourceTencentCloudCssPlayAuthKeyConfigUpdate,
-		Delete: resourceTencentCloudCssPlayAuthKeyConfigDelete,
+		CreateContext: resourceTencentCloudCssPlayAuthKeyConfigCreate,
+		ReadContext:   resourceTencentCloudCssPlayAuthKeyConfigRead,
+		UpdateContext: resourceTencentCloudCssPlayAuthKeyConfigUpdate,
+		DeleteContext: resourceTencentCloudCssPlayAuthKeyConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
@@ -76,7 +81,7 @@ func resourceTencentCloudCssPlayAuthKeyConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCssPlayAuthKeyConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssPlayAuthKeyConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_play_auth_key_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -87,16 +92,16 @@ func resourceTencentCloudCssPlayAuthKeyConfigCreate(d *schema.ResourceData, meta
 
 	d.SetId(domainName)
 
-	return resourceTencentCloudCssPlayAuthKeyConfigUpdate(d, meta)
+	return resourceTencentCloudCssPlayAuthKeyConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudCssPlayAuthKeyConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssPlayAuthKeyConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_play_auth_key_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CssService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -104,7 +109,7 @@ func resourceTencentCloudCssPlayAuthKeyConfigRead(d *schema.ResourceData, meta i
 
 	playAuthKeyConfig, err := service.DescribeCssPlayAuthKeyConfigById(ctx, domainName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if playAuthKeyConfig == nil {
@@ -136,7 +141,7 @@ func resourceTencentCloudCssPlayAuthKeyConfigRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudCssPlayAuthKeyConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssPlayAuthKeyConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_play_auth_key_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -168,8 +173,8 @@ func resourceTencentCloudCssPlayAuthKeyConfigUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().ModifyLivePlayAuthKey(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().ModifyLivePlayAuthKeyWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -179,13 +184,13 @@ func resourceTencentCloudCssPlayAuthKeyConfigUpdate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update css playAuthKeyConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCssPlayAuthKeyConfigRead(d, meta)
+	return resourceTencentCloudCssPlayAuthKeyConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCssPlayAuthKeyConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssPlayAuthKeyConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_play_auth_key_config.delete")()
 	defer inconsistentCheck(d, meta)()
 	//donothing
@@ -29,10 +29,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	css "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/live/v20180801"
@@ -41,11 +41,14 @@ import (
 
 func resourceTencentCloudCssPlayDomainCertAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCssPlayDomainCertAttachmentCreate,
-		Read:   resourceTencentCloudCssPlayDomainCertAttachmentRead,
-		Delete: resourceTencentCloudCssPlayDomainCertAttachmentDelete,
+		CreateContext: resourceTencentCloudCssPlayDomainCertAttachmentCreate,
+		ReadContext:   resourceTencentCloudCssPlayDomainCertAttachmentRead,
+		DeleteContext: resourceTencentCloudCssPlayDomainCertAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"domain_info": {
@@ -104,7 +107,7 @@ func resourceTencentCloudCssPlayDomainCertAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCssPlayDomainCertAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssPlayDomainCertAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_play_domain_cert_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -134,8 +137,8 @@ func resourceTencentCloudCssPlayDomainCertAttachmentCreate(d *schema.ResourceDat
 		request.DomainInfos = append(request.DomainInfos, &info)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().ModifyLiveDomainCertBindings(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().ModifyLiveDomainCertBindingsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -146,38 +149,38 @@ func resourceTencentCloudCssPlayDomainCertAttachmentCreate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create css playDomainCertAttachment failed, error reason: %+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(response.Response.Errors) > 0 {
-		return fmt.Errorf("[CRITAL]%s create css playDomainCertAttachment failed, reason: response.Response.Errors[%+v]", logId, response.Response.Errors)
+		return diag.Errorf("[CRITAL]%s create css playDomainCertAttachment failed, reason: response.Response.Errors[%+v]", logId, response.Response.Errors)
 	}
 
-	d.SetId(strings.Join([]string{domainName, cloudCertId}, FILED_SP))
+	d.SetId(helper.IdFormat(domainName, cloudCertId))
 
-	return resourceTencentCloudCssPlayDomainCertAttachmentRead(d, meta)
+	return resourceTencentCloudCssPlayDomainCertAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCssPlayDomainCertAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssPlayDomainCertAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_play_domain_cert_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CssService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	domainName := idSplit[0]
 	cloudCertId := idSplit[1]
 
 	playDomainCertAttachment, err := service.DescribeCssPlayDomainCertAttachmentById(ctx, domainName, cloudCertId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if playDomainCertAttachment == nil {
@@ -223,22 +226,22 @@ func resourceTencentCloudCssPlayDomainCertAttachmentRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudCssPlayDomainCertAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssPlayDomainCertAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_play_domain_cert_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CssService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	domainName := idSplit[0]
 
 	if err := service.DeleteCssPlayDomainCertAttachmentById(ctx, domainName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
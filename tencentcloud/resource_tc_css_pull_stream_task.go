@@ -28,9 +28,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	css "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/live/v20180801"
@@ -39,12 +40,16 @@ import (
 
 func resourceTencentCloudCssPullStreamTask() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudCssPullStreamTaskRead,
-		Create: resourceTencentCloudCssPullStreamTaskCreate,
-		Update: resourceTencentCloudCssPullStreamTaskUpdate,
-		Delete: resourceTencentCloudCssPullStreamTaskDelete,
+		ReadContext:   resourceTencentCloudCssPullStreamTaskRead,
+		CreateContext: resourceTencentCloudCssPullStreamTaskCreate,
+		UpdateContext: resourceTencentCloudCssPullStreamTaskUpdate,
+		DeleteContext: resourceTencentCloudCssPullStreamTaskDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"source_type": {
@@ -256,7 +261,7 @@ func resourceTencentCloudCssPullStreamTask() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCssPullStreamTaskCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssPullStreamTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_pull_stream_task.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -375,8 +380,8 @@ func resourceTencentCloudCssPullStreamTaskCreate(d *schema.ResourceData, meta in
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().CreateLivePullStreamTask(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().CreateLivePullStreamTaskWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -389,21 +394,21 @@ func resourceTencentCloudCssPullStreamTaskCreate(d *schema.ResourceData, meta in
 
 	if err != nil {
 		log.Printf("[CRITICAL]%s create css pullStreamTask failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	taskId = *response.Response.TaskId
 
 	d.SetId(taskId)
-	return resourceTencentCloudCssPullStreamTaskRead(d, meta)
+	return resourceTencentCloudCssPullStreamTaskRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCssPullStreamTaskRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssPullStreamTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_pull_stream_task.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CssService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -412,12 +417,12 @@ func resourceTencentCloudCssPullStreamTaskRead(d *schema.ResourceData, meta inte
 	result, err := service.DescribeCssPullStreamTask(ctx, taskId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[CRITICAL]##########%v ", len(result))
 	if result == nil || len(result) < 1 {
 		d.SetId("")
-		return fmt.Errorf("resource `pullStreamTask` %s does not exist", taskId)
+		return diag.Errorf("resource `pullStreamTask` %s does not exist", taskId)
 	}
 
 	pullStreamTask := result[0]
@@ -556,7 +561,7 @@ func resourceTencentCloudCssPullStreamTaskRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudCssPullStreamTaskUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssPullStreamTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_pull_stream_task.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -570,7 +575,7 @@ func resourceTencentCloudCssPullStreamTaskUpdate(d *schema.ResourceData, meta in
 	request.TaskId = &taskId
 
 	if d.HasChange("source_type") {
-		return fmt.Errorf("`source_type` do not support change now.")
+		return diag.Errorf("`source_type` do not support change now.")
 	}
 
 	if d.HasChange("source_urls") {
@@ -584,15 +589,15 @@ func resourceTencentCloudCssPullStreamTaskUpdate(d *schema.ResourceData, meta in
 	}
 
 	if d.HasChange("domain_name") {
-		return fmt.Errorf("`domain_name` do not support change now.")
+		return diag.Errorf("`domain_name` do not support change now.")
 	}
 
 	if d.HasChange("app_name") {
-		return fmt.Errorf("`app_name` do not support change now.")
+		return diag.Errorf("`app_name` do not support change now.")
 	}
 
 	if d.HasChange("stream_name") {
-		return fmt.Errorf("`stream_name` do not support change now.")
+		return diag.Errorf("`stream_name` do not support change now.")
 	}
 
 	if d.HasChange("start_time") {
@@ -614,7 +619,7 @@ func resourceTencentCloudCssPullStreamTaskUpdate(d *schema.ResourceData, meta in
 	}
 
 	if d.HasChange("push_args") {
-		return fmt.Errorf("`push_args` do not support change now.")
+		return diag.Errorf("`push_args` do not support change now.")
 	}
 
 	if d.HasChange("callback_events") {
@@ -646,7 +651,7 @@ func resourceTencentCloudCssPullStreamTaskUpdate(d *schema.ResourceData, meta in
 	}
 
 	if d.HasChange("extra_cmd") {
-		return fmt.Errorf("`extra_cmd` do not support change now.")
+		return diag.Errorf("`extra_cmd` do not support change now.")
 	}
 
 	if d.HasChange("comment") {
@@ -656,7 +661,7 @@ func resourceTencentCloudCssPullStreamTaskUpdate(d *schema.ResourceData, meta in
 	}
 
 	if d.HasChange("to_url") {
-		return fmt.Errorf("`to_url` do not support change now.")
+		return diag.Errorf("`to_url` do not support change now.")
 	}
 
 	if d.HasChange("backup_source_type") {
@@ -717,8 +722,8 @@ func resourceTencentCloudCssPullStreamTaskUpdate(d *schema.ResourceData, meta in
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().ModifyLivePullStreamTask(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().ModifyLivePullStreamTaskWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -730,19 +735,19 @@ func resourceTencentCloudCssPullStreamTaskUpdate(d *schema.ResourceData, meta in
 
 	if err != nil {
 		log.Printf("[CRITICAL]%s create css pullStreamTask failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCssPullStreamTaskRead(d, meta)
+	return resourceTencentCloudCssPullStreamTaskRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCssPullStreamTaskDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssPullStreamTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_pull_stream_task.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var operator *string
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CssService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -752,7 +757,7 @@ func resourceTencentCloudCssPullStreamTaskDelete(d *schema.ResourceData, meta in
 	}
 
 	if err := service.DeleteCssPullStreamTaskById(ctx, helper.String(taskId), operator); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -26,7 +26,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	css "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/live/v20180801"
@@ -35,12 +37,15 @@ import (
 
 func resourceTencentCloudCssPushAuthKeyConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCssPushAuthKeyConfigCreate,
-		Read:   resourceTencentCloudCssPushAuthKeyConfigRead,
-		Update: resourceTencentCloudCssPushAuthKeyConfigUpdate,
-		Delete: resourceTencentCloudCssPushAuthKeyConfigDelete,
+		CreateContext: resourceTencentCloudCssPushAuthKeyConfigCreate,
+		ReadContext:   resourceTencentCloudCssPushAuthKeyConfigRead,
+		UpdateContext: resourceTencentCloudCssPushAuthKeyConfigUpdate,
+		DeleteContext: resourceTencentCloudCssPushAuthKeyConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
@@ -76,7 +81,7 @@ func resourceTencentCloudCssPushAuthKeyConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCssPushAuthKeyConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssPushAuthKeyConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_push_auth_key_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -87,16 +92,16 @@ func resourceTencentCloudCssPushAuthKeyConfigCreate(d *schema.ResourceData, meta
 
 	d.SetId(domainName)
 
-	return resourceTencentCloudCssPushAuthKeyConfigUpdate(d, meta)
+	return resourceTencentCloudCssPushAuthKeyConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudCssPushAuthKeyConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssPushAuthKeyConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_push_auth_key_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CssService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -104,7 +109,7 @@ func resourceTencentCloudCssPushAuthKeyConfigRead(d *schema.ResourceData, meta i
 
 	pushAuthKeyConfig, err := service.DescribeCssPushAuthKeyConfigById(ctx, domainName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if pushAuthKeyConfig == nil {
@@ -136,7 +141,7 @@ func resourceTencentCloudCssPushAuthKeyConfigRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudCssPushAuthKeyConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssPushAuthKeyConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_push_auth_key_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -168,8 +173,8 @@ func resourceTencentCloudCssPushAuthKeyConfigUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().ModifyLivePushAuthKey(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().ModifyLivePushAuthKeyWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -179,13 +184,13 @@ func resourceTencentCloudCssPushAuthKeyConfigUpdate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update css pushAuthKeyConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCssPushAuthKeyConfigRead(d, meta)
+	return resourceTencentCloudCssPushAuthKeyConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCssPushAuthKeyConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssPushAuthKeyConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_push_auth_key_config.delete")()
 	defer inconsistentCheck(d, meta)()
 	//donothing
@@ -25,9 +25,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	css "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/live/v20180801"
@@ -36,12 +37,16 @@ import (
 
 func resourceTencentCloudCssWatermark() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudCssWatermarkRead,
-		Create: resourceTencentCloudCssWatermarkCreate,
-		Update: resourceTencentCloudCssWatermarkUpdate,
-		Delete: resourceTencentCloudCssWatermarkDelete,
+		ReadContext:   resourceTencentCloudCssWatermarkRead,
+		CreateContext: resourceTencentCloudCssWatermarkCreate,
+		UpdateContext: resourceTencentCloudCssWatermarkUpdate,
+		DeleteContext: resourceTencentCloudCssWatermarkDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"picture_url": {
@@ -89,7 +94,7 @@ func resourceTencentCloudCssWatermark() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCssWatermarkCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssWatermarkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_watermark.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -127,8 +132,8 @@ func resourceTencentCloudCssWatermarkCreate(d *schema.ResourceData, meta interfa
 		request.Height = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().AddLiveWatermark(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().AddLiveWatermarkWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -141,21 +146,21 @@ func resourceTencentCloudCssWatermarkCreate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create css watermark failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	watermarkId = helper.UInt64ToStr(*response.Response.WatermarkId)
 
 	d.SetId(watermarkId)
-	return resourceTencentCloudCssWatermarkRead(d, meta)
+	return resourceTencentCloudCssWatermarkRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCssWatermarkRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssWatermarkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_watermark.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CssService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -164,12 +169,12 @@ func resourceTencentCloudCssWatermarkRead(d *schema.ResourceData, meta interface
 	watermark, err := service.DescribeCssWatermark(ctx, watermarkId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if watermark == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `watermark` %s does not exist", watermarkId)
+		return diag.Errorf("resource `watermark` %s does not exist", watermarkId)
 	}
 
 	if watermark.PictureUrl != nil {
@@ -203,7 +208,7 @@ func resourceTencentCloudCssWatermarkRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudCssWatermarkUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssWatermarkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_watermark.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -252,8 +257,8 @@ func resourceTencentCloudCssWatermarkUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().UpdateLiveWatermark(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().UpdateLiveWatermarkWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -265,25 +270,25 @@ func resourceTencentCloudCssWatermarkUpdate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create css watermark failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCssWatermarkRead(d, meta)
+	return resourceTencentCloudCssWatermarkRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCssWatermarkDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssWatermarkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_watermark.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CssService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	watermarkId := d.Id()
 
 	if err := service.DeleteCssWatermarkById(ctx, helper.StrToInt64Point(watermarkId)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -47,10 +47,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	css "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/live/v20180801"
@@ -59,11 +59,14 @@ import (
 
 func resourceTencentCloudCssWatermarkRuleAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCssWatermarkRuleAttachmentCreate,
-		Read:   resourceTencentCloudCssWatermarkRuleAttachmentRead,
-		Delete: resourceTencentCloudCssWatermarkRuleAttachmentDelete,
+		CreateContext: resourceTencentCloudCssWatermarkRuleAttachmentCreate,
+		ReadContext:   resourceTencentCloudCssWatermarkRuleAttachmentRead,
+		DeleteContext: resourceTencentCloudCssWatermarkRuleAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
@@ -109,7 +112,7 @@ func resourceTencentCloudCssWatermarkRuleAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCssWatermarkRuleAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssWatermarkRuleAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_watermark_rule_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -142,8 +145,8 @@ func resourceTencentCloudCssWatermarkRuleAttachmentCreate(d *schema.ResourceData
 		request.TemplateId = helper.IntInt64(templateId)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().CreateLiveWatermarkRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCssClient().CreateLiveWatermarkRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -153,27 +156,27 @@ func resourceTencentCloudCssWatermarkRuleAttachmentCreate(d *schema.ResourceData
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create css watermarkRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{domainName, appName, streamName, helper.IntToStr(templateId)}, FILED_SP))
+	d.SetId(helper.IdFormat(domainName, appName, streamName, helper.IntToStr(templateId)))
 
-	return resourceTencentCloudCssWatermarkRuleAttachmentRead(d, meta)
+	return resourceTencentCloudCssWatermarkRuleAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCssWatermarkRuleAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssWatermarkRuleAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_watermark_rule_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CssService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	domainName := idSplit[0]
 	appName := idSplit[1]
@@ -182,7 +185,7 @@ func resourceTencentCloudCssWatermarkRuleAttachmentRead(d *schema.ResourceData,
 
 	watermarkRule, err := service.DescribeCssWatermarkRuleAttachment(ctx, domainName, appName, streamName, templateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if watermarkRule == nil {
@@ -218,24 +221,24 @@ func resourceTencentCloudCssWatermarkRuleAttachmentRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudCssWatermarkRuleAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCssWatermarkRuleAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_css_watermark_rule_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CssService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	domainName := idSplit[0]
 	appName := idSplit[1]
 	streamName := idSplit[2]
 
 	if err := service.DetachCssWatermarkRuleAttachment(ctx, domainName, appName, streamName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
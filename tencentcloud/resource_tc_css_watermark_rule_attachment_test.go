@@ -3,12 +3,12 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func TestAccTencentCloudCssWatermarkRuleAttachmentResource_basic(t *testing.T) {
@@ -56,7 +56,7 @@ func testAccCheckWatermarkRuleAttachmentDestroy(s *terraform.State) error {
 			continue
 		}
 
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		domainName := idSplit[0]
 		appName := idSplit[1]
 		streamName := idSplit[2]
@@ -88,7 +88,7 @@ func testAccCheckCssWatermarkRuleAttachmentExists(re string) resource.TestCheckF
 		}
 
 		cssService := CssService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		domainName := idSplit[0]
 		appName := idSplit[1]
 		streamName := idSplit[2]
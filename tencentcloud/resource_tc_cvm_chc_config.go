@@ -39,6 +39,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -47,12 +48,17 @@ import (
 
 func resourceTencentCloudCvmChcConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCvmChcConfigCreate,
-		Update: resourceTencentCloudCvmChcConfigUpdate,
-		Read:   resourceTencentCloudCvmChcConfigRead,
-		Delete: resourceTencentCloudCvmChcConfigDelete,
+		CreateContext: resourceTencentCloudCvmChcConfigCreate,
+		UpdateContext: resourceTencentCloudCvmChcConfigUpdate,
+		ReadContext:   resourceTencentCloudCvmChcConfigRead,
+		DeleteContext: resourceTencentCloudCvmChcConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"chc_id": {
@@ -215,7 +221,7 @@ func resourceTencentCloudCvmChcConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCvmChcConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmChcConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_chc_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -238,8 +244,8 @@ func resourceTencentCloudCvmChcConfigCreate(d *schema.ResourceData, meta interfa
 		attributeRequest := cvm.NewModifyChcAttributeRequest()
 		attributeRequest.InstanceName = helper.String(v.(string))
 		attributeRequest.ChcIds = []*string{&chcId}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ModifyChcAttribute(attributeRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ModifyChcAttributeWithContext(ctx, attributeRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -249,7 +255,7 @@ func resourceTencentCloudCvmChcConfigCreate(d *schema.ResourceData, meta interfa
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s operate cvm chcAttribute failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -257,8 +263,8 @@ func resourceTencentCloudCvmChcConfigCreate(d *schema.ResourceData, meta interfa
 		attributeRequest := cvm.NewModifyChcAttributeRequest()
 		attributeRequest.DeviceType = helper.String(v.(string))
 		attributeRequest.ChcIds = []*string{&chcId}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ModifyChcAttribute(attributeRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ModifyChcAttributeWithContext(ctx, attributeRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -268,7 +274,7 @@ func resourceTencentCloudCvmChcConfigCreate(d *schema.ResourceData, meta interfa
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s operate cvm chcAttribute failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	bmcUser, bmcUserok := d.GetOk("bmc_user")
@@ -278,8 +284,8 @@ func resourceTencentCloudCvmChcConfigCreate(d *schema.ResourceData, meta interfa
 		attributeRequest.BmcUser = helper.String(bmcUser.(string))
 		attributeRequest.Password = helper.String(password.(string))
 		attributeRequest.ChcIds = []*string{&chcId}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ModifyChcAttribute(attributeRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ModifyChcAttributeWithContext(ctx, attributeRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -289,7 +295,7 @@ func resourceTencentCloudCvmChcConfigCreate(d *schema.ResourceData, meta interfa
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s operate cvm chcAttribute failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -329,8 +335,8 @@ func resourceTencentCloudCvmChcConfigCreate(d *schema.ResourceData, meta interfa
 
 	if assistChange {
 		assistRequest.ChcIds = []*string{&chcId}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ConfigureChcAssistVpc(assistRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ConfigureChcAssistVpcWithContext(ctx, assistRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -340,12 +346,12 @@ func resourceTencentCloudCvmChcConfigCreate(d *schema.ResourceData, meta interfa
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s create cvm chcAssistVpc failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
-		conf := BuildStateChangeConf([]string{}, []string{"READY"}, 20*readRetryTimeout, time.Second, service.CvmChcInstanceStateRefreshFunc(chcId, []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"READY"}, d.Timeout(schema.TimeoutCreate), time.Second, service.CvmChcInstanceStateRefreshFunc(ctx, chcId, []string{}))
 
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -386,8 +392,8 @@ func resourceTencentCloudCvmChcConfigCreate(d *schema.ResourceData, meta interfa
 
 	if deployChange {
 		deployRequest.ChcIds = []*string{&chcId}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ConfigureChcDeployVpc(deployRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ConfigureChcDeployVpcWithContext(ctx, deployRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -397,22 +403,22 @@ func resourceTencentCloudCvmChcConfigCreate(d *schema.ResourceData, meta interfa
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s create cvm chcDeployVpc failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
-		conf := BuildStateChangeConf([]string{}, []string{vpcId}, 10*readRetryTimeout, time.Second, service.CvmChcInstanceDeployVpcStateRefreshFunc(chcId, []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{vpcId}, d.Timeout(schema.TimeoutCreate), time.Second, service.CvmChcInstanceDeployVpcStateRefreshFunc(ctx, chcId, []string{}))
 
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
 	d.SetId(chcId)
 
-	return resourceTencentCloudCvmChcConfigRead(d, meta)
+	return resourceTencentCloudCvmChcConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCvmChcConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmChcConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_chc_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -426,8 +432,8 @@ func resourceTencentCloudCvmChcConfigUpdate(d *schema.ResourceData, meta interfa
 		if v, ok := d.GetOk("instance_name"); ok {
 			attributeRequest.InstanceName = helper.String(v.(string))
 		}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ModifyChcAttribute(attributeRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ModifyChcAttributeWithContext(ctx, attributeRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -437,7 +443,7 @@ func resourceTencentCloudCvmChcConfigUpdate(d *schema.ResourceData, meta interfa
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s operate cvm chcAttribute failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if d.HasChange("device_type") {
@@ -446,8 +452,8 @@ func resourceTencentCloudCvmChcConfigUpdate(d *schema.ResourceData, meta interfa
 		if v, ok := d.GetOk("device_type"); ok {
 			attributeRequest.DeviceType = helper.String(v.(string))
 		}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ModifyChcAttribute(attributeRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ModifyChcAttributeWithContext(ctx, attributeRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -457,7 +463,7 @@ func resourceTencentCloudCvmChcConfigUpdate(d *schema.ResourceData, meta interfa
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s operate cvm chcAttribute failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if d.HasChange("bmc_user") || d.HasChange("password") {
@@ -470,8 +476,8 @@ func resourceTencentCloudCvmChcConfigUpdate(d *schema.ResourceData, meta interfa
 		if v, ok := d.GetOk("password"); ok {
 			attributeRequest.Password = helper.String(v.(string))
 		}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ModifyChcAttribute(attributeRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ModifyChcAttributeWithContext(ctx, attributeRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -481,18 +487,18 @@ func resourceTencentCloudCvmChcConfigUpdate(d *schema.ResourceData, meta interfa
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s operate cvm chcAttribute failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCvmChcConfigRead(d, meta)
+	return resourceTencentCloudCvmChcConfigRead(ctx, d, meta)
 }
-func resourceTencentCloudCvmChcConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmChcConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_chc_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
 	chcId := d.Id()
@@ -502,7 +508,7 @@ func resourceTencentCloudCvmChcConfigRead(d *schema.ResourceData, meta interface
 	}
 	chcHosts, err := service.DescribeCvmChcHostsByFilter(ctx, params)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(chcHosts) < 1 {
@@ -596,20 +602,20 @@ func resourceTencentCloudCvmChcConfigRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudCvmChcConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmChcConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_chc_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
 	chcId := d.Id()
 
 	request := cvm.NewRemoveChcDeployVpcRequest()
 	request.ChcIds = []*string{&chcId}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RemoveChcDeployVpc(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RemoveChcDeployVpcWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -619,13 +625,13 @@ func resourceTencentCloudCvmChcConfigDelete(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s remove Chc deploy vpc failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	conf := BuildStateChangeConf([]string{}, []string{""}, 5*readRetryTimeout, time.Second, service.CvmChcInstanceDeployVpcStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{""}, d.Timeout(schema.TimeoutDelete), time.Second, service.CvmChcInstanceDeployVpcStateRefreshFunc(ctx, d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	params := map[string]interface{}{
@@ -633,20 +639,20 @@ func resourceTencentCloudCvmChcConfigDelete(d *schema.ResourceData, meta interfa
 	}
 	chcHosts, err := service.DescribeCvmChcHostsByFilter(ctx, params)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(chcHosts) > 0 && *chcHosts[0].InstanceState == "INIT" {
 		return nil
 	}
 
 	if err := service.DeleteCvmChcAssistVpcById(ctx, chcId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	conf = BuildStateChangeConf([]string{}, []string{"INIT"}, 10*readRetryTimeout, time.Second, service.CvmChcInstanceStateRefreshFunc(d.Id(), []string{}))
+	conf = BuildStateChangeConf([]string{}, []string{"INIT"}, d.Timeout(schema.TimeoutDelete), time.Second, service.CvmChcInstanceStateRefreshFunc(ctx, d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	return nil
@@ -14,9 +14,11 @@ resource "tencentcloud_cvm_export_images" "export_images" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -25,9 +27,12 @@ import (
 
 func resourceTencentCloudCvmExportImages() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCvmExportImagesCreate,
-		Read:   resourceTencentCloudCvmExportImagesRead,
-		Delete: resourceTencentCloudCvmExportImagesDelete,
+		CreateContext: resourceTencentCloudCvmExportImagesCreate,
+		ReadContext:   resourceTencentCloudCvmExportImagesRead,
+		DeleteContext: resourceTencentCloudCvmExportImagesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"bucket_name": {
 				Required:    true,
@@ -81,7 +86,7 @@ func resourceTencentCloudCvmExportImages() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCvmExportImagesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmExportImagesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_export_images.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -116,8 +121,8 @@ func resourceTencentCloudCvmExportImagesCreate(d *schema.ResourceData, meta inte
 		request.RoleName = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ExportImages(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ExportImagesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -127,30 +132,30 @@ func resourceTencentCloudCvmExportImagesCreate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate cvm exportImages failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(imageId)
 
 	service := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"NORMAL"}, 20*readRetryTimeout, time.Second, service.CvmSyncImagesStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"NORMAL"}, d.Timeout(schema.TimeoutCreate), time.Second, service.CvmSyncImagesStateRefreshFunc(ctx, d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudCvmExportImagesRead(d, meta)
+	return resourceTencentCloudCvmExportImagesRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCvmExportImagesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmExportImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_export_images.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCvmExportImagesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmExportImagesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_export_images.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -23,9 +23,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -34,12 +35,16 @@ import (
 
 func resourceTencentCloudCvmHpcCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCvmHpcClusterCreate,
-		Read:   resourceTencentCloudCvmHpcClusterRead,
-		Update: resourceTencentCloudCvmHpcClusterUpdate,
-		Delete: resourceTencentCloudCvmHpcClusterDelete,
+		CreateContext: resourceTencentCloudCvmHpcClusterCreate,
+		ReadContext:   resourceTencentCloudCvmHpcClusterRead,
+		UpdateContext: resourceTencentCloudCvmHpcClusterUpdate,
+		DeleteContext: resourceTencentCloudCvmHpcClusterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"zone": {
@@ -63,7 +68,7 @@ func resourceTencentCloudCvmHpcCluster() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCvmHpcClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmHpcClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_hpc_cluster.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -86,8 +91,8 @@ func resourceTencentCloudCvmHpcClusterCreate(d *schema.ResourceData, meta interf
 		request.Remark = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().CreateHpcCluster(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().CreateHpcClusterWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -98,26 +103,26 @@ func resourceTencentCloudCvmHpcClusterCreate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cvm hpcCluster failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(response.Response.HpcClusterSet) < 1 {
-		return fmt.Errorf("resource `tencentcloud_cvm_hpc_cluster` create failed.")
+		return diag.Errorf("resource `tencentcloud_cvm_hpc_cluster` create failed.")
 	}
 
 	hpcClusterId = *response.Response.HpcClusterSet[0].HpcClusterId
 	d.SetId(hpcClusterId)
 
-	return resourceTencentCloudCvmHpcClusterRead(d, meta)
+	return resourceTencentCloudCvmHpcClusterRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCvmHpcClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmHpcClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_hpc_cluster.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -125,12 +130,12 @@ func resourceTencentCloudCvmHpcClusterRead(d *schema.ResourceData, meta interfac
 
 	hpcCluster, err := service.DescribeCvmHpcClusterById(ctx, hpcClusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if hpcCluster == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `tencentcloud_cvm_hpc_cluster` %s does not exist", d.Id())
+		return diag.Errorf("resource `tencentcloud_cvm_hpc_cluster` %s does not exist", d.Id())
 	}
 
 	if hpcCluster.Zone != nil {
@@ -148,7 +153,7 @@ func resourceTencentCloudCvmHpcClusterRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudCvmHpcClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmHpcClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_hpc_cluster.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -172,8 +177,8 @@ func resourceTencentCloudCvmHpcClusterUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ModifyHpcClusterAttribute(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ModifyHpcClusterAttributeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -186,15 +191,15 @@ func resourceTencentCloudCvmHpcClusterUpdate(d *schema.ResourceData, meta interf
 		return nil
 	}
 
-	return resourceTencentCloudCvmHpcClusterRead(d, meta)
+	return resourceTencentCloudCvmHpcClusterRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCvmHpcClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmHpcClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_hpc_cluster.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
 	hpcClusterId := d.Id()
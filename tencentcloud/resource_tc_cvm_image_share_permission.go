@@ -23,7 +23,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -32,12 +34,16 @@ import (
 
 func resourceTencentCloudCvmImageSharePermission() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCvmImageSharePermissionCreate,
-		Update: resourceTencentCloudCvmImageSharePermissionUpdate,
-		Read:   resourceTencentCloudCvmImageSharePermissionRead,
-		Delete: resourceTencentCloudCvmImageSharePermissionDelete,
+		CreateContext: resourceTencentCloudCvmImageSharePermissionCreate,
+		UpdateContext: resourceTencentCloudCvmImageSharePermissionUpdate,
+		ReadContext:   resourceTencentCloudCvmImageSharePermissionRead,
+		DeleteContext: resourceTencentCloudCvmImageSharePermissionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"image_id": {
@@ -59,7 +65,7 @@ func resourceTencentCloudCvmImageSharePermission() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCvmImageSharePermissionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmImageSharePermissionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_image_share_permission.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -84,8 +90,8 @@ func resourceTencentCloudCvmImageSharePermissionCreate(d *schema.ResourceData, m
 
 	request.Permission = helper.String(IMAGE_SHARE_PERMISSION_SHARE)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ModifyImageSharePermission(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ModifyImageSharePermissionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -95,20 +101,20 @@ func resourceTencentCloudCvmImageSharePermissionCreate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate cvm modifyImageSharePermission failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(imageId)
 
-	return resourceTencentCloudCvmImageSharePermissionRead(d, meta)
+	return resourceTencentCloudCvmImageSharePermissionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCvmImageSharePermissionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmImageSharePermissionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_image_share_permission.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	if d.HasChange("account_ids") {
@@ -120,30 +126,30 @@ func resourceTencentCloudCvmImageSharePermissionUpdate(d *schema.ResourceData, m
 		if len(add) > 0 {
 			addError := service.ModifyImageSharePermission(ctx, d.Id(), IMAGE_SHARE_PERMISSION_SHARE, helper.InterfacesStrings(add))
 			if addError != nil {
-				return addError
+				return diag.FromErr(addError)
 			}
 		}
 		if len(remove) > 0 {
 			removeError := service.ModifyImageSharePermission(ctx, d.Id(), IMAGE_SHARE_PERMISSION_CANCEL, helper.InterfacesStrings(remove))
 			if removeError != nil {
-				return removeError
+				return diag.FromErr(removeError)
 			}
 		}
 	}
 
-	return resourceTencentCloudCvmImageSharePermissionRead(d, meta)
+	return resourceTencentCloudCvmImageSharePermissionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCvmImageSharePermissionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmImageSharePermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_image_share_permission.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var sharePermissionSet []*cvm.SharePermission
 
 	service := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeCvmImageSharePermissionByFilter(ctx, map[string]interface{}{"ImageId": helper.String(d.Id())})
 		if e != nil {
 			return retryError(e)
@@ -152,7 +158,7 @@ func resourceTencentCloudCvmImageSharePermissionRead(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	accountIds := make([]string, 0)
@@ -165,15 +171,15 @@ func resourceTencentCloudCvmImageSharePermissionRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudCvmImageSharePermissionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmImageSharePermissionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_image_share_permission.delete")()
 	defer inconsistentCheck(d, meta)()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var sharePermissionSet []*cvm.SharePermission
 
 	service := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		result, e := service.DescribeCvmImageSharePermissionByFilter(ctx, map[string]interface{}{"ImageId": helper.String(d.Id())})
 		if e != nil {
 			return retryError(e)
@@ -182,7 +188,7 @@ func resourceTencentCloudCvmImageSharePermissionDelete(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	accountIds := make([]string, 0)
@@ -192,7 +198,7 @@ func resourceTencentCloudCvmImageSharePermissionDelete(d *schema.ResourceData, m
 
 	err = service.ModifyImageSharePermission(ctx, d.Id(), IMAGE_SHARE_PERMISSION_CANCEL, accountIds)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -40,8 +40,11 @@ terraform import tencentcloud_cvm_import_image.import_image import_image_id
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -50,11 +53,14 @@ import (
 
 func resourceTencentCloudCvmImportImage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCvmImportImageCreate,
-		Read:   resourceTencentCloudCvmImportImageRead,
-		Delete: resourceTencentCloudCvmImportImageDelete,
+		CreateContext: resourceTencentCloudCvmImportImageCreate,
+		ReadContext:   resourceTencentCloudCvmImportImageRead,
+		DeleteContext: resourceTencentCloudCvmImportImageDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"architecture": {
@@ -165,7 +171,7 @@ func resourceTencentCloudCvmImportImage() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCvmImportImageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmImportImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_import_image.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -240,8 +246,8 @@ func resourceTencentCloudCvmImportImageCreate(d *schema.ResourceData, meta inter
 		request.BootMode = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ImportImage(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ImportImageWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -251,22 +257,22 @@ func resourceTencentCloudCvmImportImageCreate(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate cvm importImage failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(imageUrl)
 
-	return resourceTencentCloudCvmImportImageRead(d, meta)
+	return resourceTencentCloudCvmImportImageRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCvmImportImageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmImportImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_import_image.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCvmImportImageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmImportImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_import_image.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -18,13 +18,23 @@ resource "tencentcloud_cvm_launch_template" "demo" {
   image_id = data.tencentcloud_images.my_favorite_image.images.0.image_id
 }
 ```
+
+Import
+
+CVM launch template can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_cvm_launch_template.demo lt-b20scl2a
+```
 */
 package tencentcloud
 
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -33,9 +43,15 @@ import (
 
 func resourceTencentCloudCvmLaunchTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCvmLaunchTemplateCreate,
-		Read:   resourceTencentCloudCvmLaunchTemplateRead,
-		Delete: resourceTencentCloudCvmLaunchTemplateDelete,
+		CreateContext: resourceTencentCloudCvmLaunchTemplateCreate,
+		ReadContext:   resourceTencentCloudCvmLaunchTemplateRead,
+		DeleteContext: resourceTencentCloudCvmLaunchTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"launch_template_name": {
 				Required:    true,
@@ -625,7 +641,7 @@ func resourceTencentCloudCvmLaunchTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCvmLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmLaunchTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_launch_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -964,8 +980,8 @@ func resourceTencentCloudCvmLaunchTemplateCreate(d *schema.ResourceData, meta in
 		request.DisableApiTermination = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().CreateLaunchTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().CreateLaunchTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -976,22 +992,22 @@ func resourceTencentCloudCvmLaunchTemplateCreate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cvm launchTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	launchTemplateId = *response.Response.LaunchTemplateId
 	d.SetId(launchTemplateId)
 
-	return resourceTencentCloudCvmLaunchTemplateRead(d, meta)
+	return resourceTencentCloudCvmLaunchTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCvmLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmLaunchTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_launch_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -999,7 +1015,7 @@ func resourceTencentCloudCvmLaunchTemplateRead(d *schema.ResourceData, meta inte
 
 	launchTemplate, err := service.DescribeCvmLaunchTemplateById(ctx, launchTemplateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if launchTemplate == nil {
@@ -1014,7 +1030,7 @@ func resourceTencentCloudCvmLaunchTemplateRead(d *schema.ResourceData, meta inte
 
 	launchTemplateVersion, err := service.DescribeLaunchTemplateVersionsById(ctx, launchTemplateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if launchTemplateVersion == nil {
@@ -1399,18 +1415,18 @@ func resourceTencentCloudCvmLaunchTemplateRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudCvmLaunchTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmLaunchTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_launch_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
 	launchTemplateId := d.Id()
 
 	if err := service.DeleteCvmLaunchTemplateById(ctx, launchTemplateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -23,19 +23,25 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTencentCloudCvmLaunchTemplateDefaultVersion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCvmLaunchTemplateDefaultVersionCreate,
-		Read:   resourceTencentCloudCvmLaunchTemplateDefaultVersionRead,
-		Update: resourceTencentCloudCvmLaunchTemplateDefaultVersionUpdate,
-		Delete: resourceTencentCloudCvmLaunchTemplateDefaultVersionDelete,
+		CreateContext: resourceTencentCloudCvmLaunchTemplateDefaultVersionCreate,
+		ReadContext:   resourceTencentCloudCvmLaunchTemplateDefaultVersionRead,
+		UpdateContext: resourceTencentCloudCvmLaunchTemplateDefaultVersionUpdate,
+		DeleteContext: resourceTencentCloudCvmLaunchTemplateDefaultVersionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"launch_template_id": {
@@ -54,16 +60,16 @@ func resourceTencentCloudCvmLaunchTemplateDefaultVersion() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCvmLaunchTemplateDefaultVersionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmLaunchTemplateDefaultVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_launch_template_default_version.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
 	launchTemplateId := d.Get("launch_template_id").(string)
 	defaultVersion := d.Get("default_version").(int)
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		e := service.ModifyLaunchTemplateDefaultVersion(ctx, launchTemplateId, defaultVersion)
 		if e != nil {
 			return retryError(e)
@@ -71,27 +77,27 @@ func resourceTencentCloudCvmLaunchTemplateDefaultVersionCreate(d *schema.Resourc
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(launchTemplateId)
 
-	return resourceTencentCloudCvmLaunchTemplateDefaultVersionRead(d, meta)
+	return resourceTencentCloudCvmLaunchTemplateDefaultVersionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCvmLaunchTemplateDefaultVersionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmLaunchTemplateDefaultVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_launch_template_default_version.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	launchTemplateId := d.Id()
 	launchTemplateVersions, err := service.DescribeLaunchTemplateVersions(ctx, launchTemplateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(launchTemplateVersions) == 0 {
@@ -111,16 +117,16 @@ func resourceTencentCloudCvmLaunchTemplateDefaultVersionRead(d *schema.ResourceD
 	return nil
 }
 
-func resourceTencentCloudCvmLaunchTemplateDefaultVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmLaunchTemplateDefaultVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_launch_template_default_version.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
 	if d.HasChange("default_version") {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			e := service.ModifyLaunchTemplateDefaultVersion(ctx, d.Id(), d.Get("default_version").(int))
 			if e != nil {
 				return retryError(e)
@@ -128,14 +134,14 @@ func resourceTencentCloudCvmLaunchTemplateDefaultVersionUpdate(d *schema.Resourc
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCvmLaunchTemplateDefaultVersionRead(d, meta)
+	return resourceTencentCloudCvmLaunchTemplateDefaultVersionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCvmLaunchTemplateDefaultVersionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmLaunchTemplateDefaultVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_launch_template_default_version.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -30,11 +30,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -43,11 +43,14 @@ import (
 
 func resourceTencentCloudCvmLaunchTemplateVersion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCvmLaunchTemplateVersionCreate,
-		Read:   resourceTencentCloudCvmLaunchTemplateVersionRead,
-		Delete: resourceTencentCloudCvmLaunchTemplateVersionDelete,
+		CreateContext: resourceTencentCloudCvmLaunchTemplateVersionCreate,
+		ReadContext:   resourceTencentCloudCvmLaunchTemplateVersionRead,
+		DeleteContext: resourceTencentCloudCvmLaunchTemplateVersionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"placement": {
@@ -744,7 +747,7 @@ func resourceTencentCloudCvmLaunchTemplateVersion() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCvmLaunchTemplateVersionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmLaunchTemplateVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_launch_template_version.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -1085,8 +1088,8 @@ func resourceTencentCloudCvmLaunchTemplateVersionCreate(d *schema.ResourceData,
 		request.DisableApiTermination = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().CreateLaunchTemplateVersion(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().CreateLaunchTemplateVersionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1097,36 +1100,36 @@ func resourceTencentCloudCvmLaunchTemplateVersionCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cvm launchTemplateVersion failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	launchTemplateVersionNumber := *response.Response.LaunchTemplateVersionNumber
 	launchTemplateVersionNumberString := strconv.FormatInt(launchTemplateVersionNumber, 10)
-	d.SetId(launchTemplateId + FILED_SP + launchTemplateVersionNumberString)
+	d.SetId(helper.IdFormat(launchTemplateId, launchTemplateVersionNumberString))
 
-	return resourceTencentCloudCvmLaunchTemplateVersionRead(d, meta)
+	return resourceTencentCloudCvmLaunchTemplateVersionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCvmLaunchTemplateVersionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmLaunchTemplateVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_launch_template_version.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	launchTemplateId := idSplit[0]
 	launchTemplateVersionNumber := idSplit[1]
 
 	launchTemplateVersion, err := service.DescribeCvmLaunchTemplateVersionById(ctx, launchTemplateId, launchTemplateVersionNumber)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if launchTemplateVersion == nil {
@@ -1517,24 +1520,24 @@ func resourceTencentCloudCvmLaunchTemplateVersionRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudCvmLaunchTemplateVersionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmLaunchTemplateVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_launch_template_version.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	launchTemplateId := idSplit[0]
 	launchTemplateVersionNumber := idSplit[1]
 
 	if err := service.DeleteCvmLaunchTemplateVersionById(ctx, launchTemplateId, launchTemplateVersionNumber); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
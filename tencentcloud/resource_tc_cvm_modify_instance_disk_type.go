@@ -31,8 +31,11 @@ resource "tencentcloud_cvm_modify_instance_disk_type" "modify_instance_disk_type
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -41,11 +44,14 @@ import (
 
 func resourceTencentCloudCvmModifyInstanceDiskType() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCvmModifyInstanceDiskTypeCreate,
-		Read:   resourceTencentCloudCvmModifyInstanceDiskTypeRead,
-		Delete: resourceTencentCloudCvmModifyInstanceDiskTypeDelete,
+		CreateContext: resourceTencentCloudCvmModifyInstanceDiskTypeCreate,
+		ReadContext:   resourceTencentCloudCvmModifyInstanceDiskTypeRead,
+		DeleteContext: resourceTencentCloudCvmModifyInstanceDiskTypeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -170,7 +176,7 @@ func resourceTencentCloudCvmModifyInstanceDiskType() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCvmModifyInstanceDiskTypeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmModifyInstanceDiskTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_modify_instance_disk_type.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -237,8 +243,8 @@ func resourceTencentCloudCvmModifyInstanceDiskTypeCreate(d *schema.ResourceData,
 		request.SystemDisk = &systemDisk
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ModifyInstanceDiskType(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ModifyInstanceDiskTypeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -248,22 +254,22 @@ func resourceTencentCloudCvmModifyInstanceDiskTypeCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate cvm modifyInstanceDiskType failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudCvmModifyInstanceDiskTypeRead(d, meta)
+	return resourceTencentCloudCvmModifyInstanceDiskTypeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCvmModifyInstanceDiskTypeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmModifyInstanceDiskTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_modify_instance_disk_type.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCvmModifyInstanceDiskTypeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmModifyInstanceDiskTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_modify_instance_disk_type.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -22,8 +22,11 @@ terraform import tencentcloud_cvm_program_fpga_image.program_fpga_image program_
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -32,11 +35,14 @@ import (
 
 func resourceTencentCloudCvmProgramFpgaImage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCvmProgramFpgaImageCreate,
-		Read:   resourceTencentCloudCvmProgramFpgaImageRead,
-		Delete: resourceTencentCloudCvmProgramFpgaImageDelete,
+		CreateContext: resourceTencentCloudCvmProgramFpgaImageCreate,
+		ReadContext:   resourceTencentCloudCvmProgramFpgaImageRead,
+		DeleteContext: resourceTencentCloudCvmProgramFpgaImageDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -73,7 +79,7 @@ func resourceTencentCloudCvmProgramFpgaImage() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCvmProgramFpgaImageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmProgramFpgaImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_program_fpga_image.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -101,8 +107,8 @@ func resourceTencentCloudCvmProgramFpgaImageCreate(d *schema.ResourceData, meta
 		request.DryRun = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ProgramFpgaImage(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ProgramFpgaImageWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -112,22 +118,22 @@ func resourceTencentCloudCvmProgramFpgaImageCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate cvm programFpgaImage failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudCvmProgramFpgaImageRead(d, meta)
+	return resourceTencentCloudCvmProgramFpgaImageRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCvmProgramFpgaImageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmProgramFpgaImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_program_fpga_image.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCvmProgramFpgaImageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmProgramFpgaImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_program_fpga_image.delete")()
 	defer inconsistentCheck(d, meta)()
 
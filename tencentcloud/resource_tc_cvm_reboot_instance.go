@@ -13,8 +13,11 @@ resource "tencentcloud_cvm_reboot_instance" "reboot_instance" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -23,9 +26,12 @@ import (
 
 func resourceTencentCloudCvmRebootInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCvmRebootInstanceCreate,
-		Read:   resourceTencentCloudCvmRebootInstanceRead,
-		Delete: resourceTencentCloudCvmRebootInstanceDelete,
+		CreateContext: resourceTencentCloudCvmRebootInstanceCreate,
+		ReadContext:   resourceTencentCloudCvmRebootInstanceRead,
+		DeleteContext: resourceTencentCloudCvmRebootInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -55,7 +61,7 @@ func resourceTencentCloudCvmRebootInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCvmRebootInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmRebootInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_reboot_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -75,8 +81,8 @@ func resourceTencentCloudCvmRebootInstanceCreate(d *schema.ResourceData, meta in
 		request.StopType = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RebootInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RebootInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -86,22 +92,22 @@ func resourceTencentCloudCvmRebootInstanceCreate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate cvm rebootInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudCvmRebootInstanceRead(d, meta)
+	return resourceTencentCloudCvmRebootInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCvmRebootInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmRebootInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_reboot_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCvmRebootInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmRebootInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_reboot_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -16,8 +16,11 @@ resource "tencentcloud_cvm_renew_host" "renew_host" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -26,9 +29,12 @@ import (
 
 func resourceTencentCloudCvmRenewHost() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCvmRenewHostCreate,
-		Read:   resourceTencentCloudCvmRenewHostRead,
-		Delete: resourceTencentCloudCvmRenewHostDelete,
+		CreateContext: resourceTencentCloudCvmRenewHostCreate,
+		ReadContext:   resourceTencentCloudCvmRenewHostRead,
+		DeleteContext: resourceTencentCloudCvmRenewHostDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"host_id": {
 				Required:    true,
@@ -62,7 +68,7 @@ func resourceTencentCloudCvmRenewHost() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCvmRenewHostCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmRenewHostCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_renew_host.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -85,8 +91,8 @@ func resourceTencentCloudCvmRenewHostCreate(d *schema.ResourceData, meta interfa
 		request.HostChargePrepaid = &chargePrepaid
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RenewHosts(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RenewHostsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -96,22 +102,22 @@ func resourceTencentCloudCvmRenewHostCreate(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate cvm renewHost failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(hostId)
 
-	return resourceTencentCloudCvmRenewHostRead(d, meta)
+	return resourceTencentCloudCvmRenewHostRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCvmRenewHostRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmRenewHostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_renew_host.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCvmRenewHostDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmRenewHostDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_renew_host.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -17,8 +17,11 @@ resource "tencentcloud_cvm_renew_instance" "renew_instance" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -27,9 +30,12 @@ import (
 
 func resourceTencentCloudCvmRenewInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCvmRenewInstanceCreate,
-		Read:   resourceTencentCloudCvmRenewInstanceRead,
-		Delete: resourceTencentCloudCvmRenewInstanceDelete,
+		CreateContext: resourceTencentCloudCvmRenewInstanceCreate,
+		ReadContext:   resourceTencentCloudCvmRenewInstanceRead,
+		DeleteContext: resourceTencentCloudCvmRenewInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -78,7 +84,7 @@ func resourceTencentCloudCvmRenewInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCvmRenewInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmRenewInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_renew_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -103,8 +109,8 @@ func resourceTencentCloudCvmRenewInstanceCreate(d *schema.ResourceData, meta int
 		request.RenewPortableDataDisk = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RenewInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RenewInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -114,22 +120,22 @@ func resourceTencentCloudCvmRenewInstanceCreate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate cvm renewInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudCvmRenewInstanceRead(d, meta)
+	return resourceTencentCloudCvmRenewInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCvmRenewInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmRenewInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_renew_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCvmRenewInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmRenewInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_renew_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
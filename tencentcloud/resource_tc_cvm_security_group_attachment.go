@@ -22,22 +22,27 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCvmSecurityGroupAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCvmSecurityGroupAttachmentCreate,
-		Read:   resourceTencentCloudCvmSecurityGroupAttachmentRead,
-		Delete: resourceTencentCloudCvmSecurityGroupAttachmentDelete,
+		CreateContext: resourceTencentCloudCvmSecurityGroupAttachmentCreate,
+		ReadContext:   resourceTencentCloudCvmSecurityGroupAttachmentRead,
+		DeleteContext: resourceTencentCloudCvmSecurityGroupAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"security_group_id": {
@@ -57,7 +62,7 @@ func resourceTencentCloudCvmSecurityGroupAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCvmSecurityGroupAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmSecurityGroupAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_security_group_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -71,8 +76,8 @@ func resourceTencentCloudCvmSecurityGroupAttachmentCreate(d *schema.ResourceData
 	request.SecurityGroupIds = []*string{&securityGroupId}
 	request.InstanceIds = []*string{&instanceId}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().AssociateSecurityGroups(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().AssociateSecurityGroupsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -82,33 +87,33 @@ func resourceTencentCloudCvmSecurityGroupAttachmentCreate(d *schema.ResourceData
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cvm securityGroupAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
-	d.SetId(instanceId + FILED_SP + securityGroupId)
+	d.SetId(helper.IdFormat(instanceId, securityGroupId))
 
-	return resourceTencentCloudCvmSecurityGroupAttachmentRead(d, meta)
+	return resourceTencentCloudCvmSecurityGroupAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCvmSecurityGroupAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmSecurityGroupAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_security_group_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	securityGroupId := idSplit[1]
 
 	instanceInfo, err := service.DescribeInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instanceInfo == nil {
@@ -125,18 +130,18 @@ func resourceTencentCloudCvmSecurityGroupAttachmentRead(d *schema.ResourceData,
 
 		}
 	}
-	return fmt.Errorf("The security group get from api does not match with current instance %v", d.Id())
+	return diag.Errorf("The security group get from api does not match with current instance %v", d.Id())
 }
 
-func resourceTencentCloudCvmSecurityGroupAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmSecurityGroupAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_security_group_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	instanceId := idSplit[0]
 	securityGroupId := idSplit[1]
@@ -144,8 +149,8 @@ func resourceTencentCloudCvmSecurityGroupAttachmentDelete(d *schema.ResourceData
 	request := cvm.NewDisassociateSecurityGroupsRequest()
 	request.SecurityGroupIds = []*string{&securityGroupId}
 	request.InstanceIds = []*string{&instanceId}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().DisassociateSecurityGroups(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().DisassociateSecurityGroupsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -155,7 +160,7 @@ func resourceTencentCloudCvmSecurityGroupAttachmentDelete(d *schema.ResourceData
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete cvm securityGroupAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -13,9 +13,11 @@ resource "tencentcloud_cvm_sync_image" "sync_image" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -24,11 +26,14 @@ import (
 
 func resourceTencentCloudCvmSyncImage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCvmSyncImageCreate,
-		Read:   resourceTencentCloudCvmSyncImageRead,
-		Delete: resourceTencentCloudCvmSyncImageDelete,
+		CreateContext: resourceTencentCloudCvmSyncImageCreate,
+		ReadContext:   resourceTencentCloudCvmSyncImageRead,
+		DeleteContext: resourceTencentCloudCvmSyncImageDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"image_id": {
@@ -72,7 +77,7 @@ func resourceTencentCloudCvmSyncImage() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCvmSyncImageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmSyncImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_sync_image.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -101,8 +106,8 @@ func resourceTencentCloudCvmSyncImageCreate(d *schema.ResourceData, meta interfa
 		request.ImageSetRequired = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().SyncImages(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().SyncImagesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -112,30 +117,30 @@ func resourceTencentCloudCvmSyncImageCreate(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate cvm syncImages failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(imageId)
 
 	service := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"NORMAL"}, 20*readRetryTimeout, time.Second, service.CvmSyncImagesStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"NORMAL"}, d.Timeout(schema.TimeoutCreate), time.Second, service.CvmSyncImagesStateRefreshFunc(ctx, d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudCvmSyncImageRead(d, meta)
+	return resourceTencentCloudCvmSyncImageRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCvmSyncImageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmSyncImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_sync_image.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCvmSyncImageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCvmSyncImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cvm_sync_image.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -28,8 +28,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -38,12 +39,16 @@ import (
 
 func resourceTencentCloudCynosdbAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbAccountCreate,
-		Read:   resourceTencentCloudCynosdbAccountRead,
-		Update: resourceTencentCloudCynosdbAccountUpdate,
-		Delete: resourceTencentCloudCynosdbAccountDelete,
+		CreateContext: resourceTencentCloudCynosdbAccountCreate,
+		ReadContext:   resourceTencentCloudCynosdbAccountRead,
+		UpdateContext: resourceTencentCloudCynosdbAccountUpdate,
+		DeleteContext: resourceTencentCloudCynosdbAccountDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -82,7 +87,7 @@ func resourceTencentCloudCynosdbAccount() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_account.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -119,8 +124,8 @@ func resourceTencentCloudCynosdbAccountCreate(d *schema.ResourceData, meta inter
 	}
 	request.Accounts = append(request.Accounts, &newAccount)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().CreateAccounts(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().CreateAccountsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -130,27 +135,27 @@ func resourceTencentCloudCynosdbAccountCreate(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cynosdb account failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(clusterId + FILED_SP + accountName + FILED_SP + host)
+	d.SetId(helper.IdFormat(clusterId, accountName, host))
 
-	return resourceTencentCloudCynosdbAccountRead(d, meta)
+	return resourceTencentCloudCynosdbAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_account.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	accountName := idSplit[1]
@@ -158,7 +163,7 @@ func resourceTencentCloudCynosdbAccountRead(d *schema.ResourceData, meta interfa
 
 	account, err := service.DescribeCynosdbAccountById(ctx, clusterId, accountName, host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if account == nil {
@@ -192,15 +197,15 @@ func resourceTencentCloudCynosdbAccountRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudCynosdbAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_account.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	accountName := idSplit[1]
@@ -209,7 +214,7 @@ func resourceTencentCloudCynosdbAccountUpdate(d *schema.ResourceData, meta inter
 	immutableArgs := []string{"cluster_id", "accounts"}
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -222,8 +227,8 @@ func resourceTencentCloudCynosdbAccountUpdate(d *schema.ResourceData, meta inter
 			request.AccountPassword = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ResetAccountPassword(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ResetAccountPasswordWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -233,7 +238,7 @@ func resourceTencentCloudCynosdbAccountUpdate(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update cynosdb account failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -246,8 +251,8 @@ func resourceTencentCloudCynosdbAccountUpdate(d *schema.ResourceData, meta inter
 			request.Description = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyAccountDescription(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyAccountDescriptionWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -257,7 +262,7 @@ func resourceTencentCloudCynosdbAccountUpdate(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update cynosdb account failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -275,8 +280,8 @@ func resourceTencentCloudCynosdbAccountUpdate(d *schema.ResourceData, meta inter
 			request.NewHost = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyAccountHost(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyAccountHostWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -286,10 +291,10 @@ func resourceTencentCloudCynosdbAccountUpdate(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update cynosdb account failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
-		d.SetId(clusterId + FILED_SP + accountName + FILED_SP + newHost)
+		d.SetId(helper.IdFormat(clusterId, accountName, newHost))
 	}
 
 	if d.HasChange("max_user_connections") {
@@ -309,8 +314,8 @@ func resourceTencentCloudCynosdbAccountUpdate(d *schema.ResourceData, meta inter
 
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyAccountParams(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyAccountParamsWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -320,31 +325,31 @@ func resourceTencentCloudCynosdbAccountUpdate(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update cynosdb account failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCynosdbAccountRead(d, meta)
+	return resourceTencentCloudCynosdbAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_account.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	accountName := idSplit[1]
 	host := idSplit[2]
 
 	if err := service.DeleteCynosdbAccountById(ctx, clusterId, accountName, host); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -50,10 +50,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -62,12 +62,15 @@ import (
 
 func resourceTencentCloudCynosdbAccountPrivileges() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbAccountPrivilegesCreate,
-		Read:   resourceTencentCloudCynosdbAccountPrivilegesRead,
-		Update: resourceTencentCloudCynosdbAccountPrivilegesUpdate,
-		Delete: resourceTencentCloudCynosdbAccountPrivilegesDelete,
+		CreateContext: resourceTencentCloudCynosdbAccountPrivilegesCreate,
+		ReadContext:   resourceTencentCloudCynosdbAccountPrivilegesRead,
+		UpdateContext: resourceTencentCloudCynosdbAccountPrivilegesUpdate,
+		DeleteContext: resourceTencentCloudCynosdbAccountPrivilegesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -153,7 +156,7 @@ func resourceTencentCloudCynosdbAccountPrivileges() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbAccountPrivilegesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbAccountPrivilegesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_account_privileges.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -172,23 +175,23 @@ func resourceTencentCloudCynosdbAccountPrivilegesCreate(d *schema.ResourceData,
 		host = v.(string)
 	}
 
-	d.SetId(clusterId + FILED_SP + accountName + FILED_SP + host)
+	d.SetId(helper.IdFormat(clusterId, accountName, host))
 
-	return resourceTencentCloudCynosdbAccountPrivilegesUpdate(d, meta)
+	return resourceTencentCloudCynosdbAccountPrivilegesUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbAccountPrivilegesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbAccountPrivilegesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_account_privileges.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	accountName := idSplit[1]
@@ -196,7 +199,7 @@ func resourceTencentCloudCynosdbAccountPrivilegesRead(d *schema.ResourceData, me
 
 	accountPrivileges, err := service.DescribeCynosdbAccountPrivilegesById(ctx, clusterId, accountName, host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if accountPrivileges == nil {
@@ -260,7 +263,7 @@ func resourceTencentCloudCynosdbAccountPrivilegesRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudCynosdbAccountPrivilegesUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbAccountPrivilegesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_account_privileges.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -268,9 +271,9 @@ func resourceTencentCloudCynosdbAccountPrivilegesUpdate(d *schema.ResourceData,
 
 	request := cynosdb.NewModifyAccountPrivilegesRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	clusterId := idSplit[0]
 	accountName := idSplit[1]
@@ -335,8 +338,8 @@ func resourceTencentCloudCynosdbAccountPrivilegesUpdate(d *schema.ResourceData,
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyAccountPrivileges(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyAccountPrivilegesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -346,13 +349,13 @@ func resourceTencentCloudCynosdbAccountPrivilegesUpdate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update cynosdb accountPrivileges failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCynosdbAccountPrivilegesRead(d, meta)
+	return resourceTencentCloudCynosdbAccountPrivilegesRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbAccountPrivilegesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbAccountPrivilegesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_account_privileges.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -10,6 +10,14 @@ resource "tencentcloud_cynosdb_audit_log_file" "audit_log_file" {
   end_time    = "2022-08-12 10:29:20"
 }
 ```
+
+Import
+
+cynosdb audit_log_file can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_cynosdb_audit_log_file.audit_log_file cynosdbmysql-ins-afqx1hy0#cynosdbmysql-ins-afqx1hy0_audit.log
+```
 */
 package tencentcloud
 
@@ -17,8 +25,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -28,9 +37,15 @@ import (
 
 func resourceTencentCloudCynosdbAuditLogFile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbAuditLogFileCreate,
-		Read:   resourceTencentCloudCynosdbAuditLogFileRead,
-		Delete: resourceTencentCloudCynosdbAuditLogFileDelete,
+		CreateContext: resourceTencentCloudCynosdbAuditLogFileCreate,
+		ReadContext:   resourceTencentCloudCynosdbAuditLogFileRead,
+		DeleteContext: resourceTencentCloudCynosdbAuditLogFileDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -197,7 +212,7 @@ func resourceTencentCloudCynosdbAuditLogFile() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbAuditLogFileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbAuditLogFileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_audit_log_file.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -305,8 +320,8 @@ func resourceTencentCloudCynosdbAuditLogFileCreate(d *schema.ResourceData, meta
 		request.Filter = &auditLogFilter
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().CreateAuditLogFile(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().CreateAuditLogFileWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -317,15 +332,17 @@ func resourceTencentCloudCynosdbAuditLogFileCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cynosdb auditLogFile failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		request := cynosdb.NewDescribeAuditLogFilesRequest()
 		request.InstanceId = helper.String(instanceId)
 		request.FileName = response.Response.FileName
-		ratelimit.Check(request.GetAction())
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().DescribeAuditLogFiles(request)
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().DescribeAuditLogFilesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -336,40 +353,40 @@ func resourceTencentCloudCynosdbAuditLogFileCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cynosdb auditLogFile failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	auditLogFileId := strings.Join([]string{instanceId, *response.Response.FileName}, FILED_SP)
+	auditLogFileId := helper.IdFormat(instanceId, *response.Response.FileName)
 	d.SetId(auditLogFileId)
 
-	return resourceTencentCloudCynosdbAuditLogFileRead(d, meta)
+	return resourceTencentCloudCynosdbAuditLogFileRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbAuditLogFileRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbAuditLogFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_audit_log_file.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	fileName := idSplit[1]
 
 	auditLogFile, err := service.DescribeCynosdbAuditLogFileById(ctx, instanceId, fileName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if auditLogFile == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `CynosdbAuditLogFile` %s does not exist", d.Id())
+		return diag.Errorf("resource `CynosdbAuditLogFile` %s does not exist", d.Id())
 	}
 
 	_ = d.Set("file_name", *auditLogFile.FileName)
@@ -381,23 +398,23 @@ func resourceTencentCloudCynosdbAuditLogFileRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTencentCloudCynosdbAuditLogFileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbAuditLogFileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_audit_log_file.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	fileName := idSplit[1]
 
 	if err := service.DeleteCynosdbAuditLogFileById(ctx, instanceId, fileName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func TestAccTencentCloudCynosdbAuditLogFileResource(t *testing.T) {
@@ -23,6 +23,19 @@ func TestAccTencentCloudCynosdbAuditLogFileResource(t *testing.T) {
 					testAccCheckCynosdbCynosdbAuditLogFileExists("tencentcloud_cynosdb_audit_log_file.audit_log_file"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_cynosdb_audit_log_file.audit_log_file",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"instance_id",
+					"start_time",
+					"end_time",
+					"order",
+					"order_by",
+					"filter",
+				},
+			},
 		},
 	})
 }
@@ -39,7 +52,7 @@ func testAccCheckCynosdbAuditLogFileDestroy(s *terraform.State) error {
 			continue
 		}
 
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		if len(idSplit) != 2 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
@@ -73,7 +86,7 @@ func testAccCheckCynosdbCynosdbAuditLogFileExists(n string) resource.TestCheckFu
 		service := CynosdbService{
 			client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn,
 		}
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		if len(idSplit) != 2 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
@@ -23,7 +23,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -32,12 +34,15 @@ import (
 
 func resourceTencentCloudCynosdbBinlogSaveDays() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbBinlogSaveDaysCreate,
-		Read:   resourceTencentCloudCynosdbBinlogSaveDaysRead,
-		Update: resourceTencentCloudCynosdbBinlogSaveDaysUpdate,
-		Delete: resourceTencentCloudCynosdbBinlogSaveDaysDelete,
+		CreateContext: resourceTencentCloudCynosdbBinlogSaveDaysCreate,
+		ReadContext:   resourceTencentCloudCynosdbBinlogSaveDaysRead,
+		UpdateContext: resourceTencentCloudCynosdbBinlogSaveDaysUpdate,
+		DeleteContext: resourceTencentCloudCynosdbBinlogSaveDaysDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -56,7 +61,7 @@ func resourceTencentCloudCynosdbBinlogSaveDays() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbBinlogSaveDaysCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbBinlogSaveDaysCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_binlog_save_days.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -67,22 +72,22 @@ func resourceTencentCloudCynosdbBinlogSaveDaysCreate(d *schema.ResourceData, met
 
 	d.SetId(clusterId)
 
-	return resourceTencentCloudCynosdbBinlogSaveDaysUpdate(d, meta)
+	return resourceTencentCloudCynosdbBinlogSaveDaysUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbBinlogSaveDaysRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbBinlogSaveDaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_binlog_save_days.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	clusterId := d.Id()
 	binlogSaveDays, err := service.DescribeCynosdbBinlogSaveDaysById(ctx, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if binlogSaveDays == nil {
@@ -100,7 +105,7 @@ func resourceTencentCloudCynosdbBinlogSaveDaysRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudCynosdbBinlogSaveDaysUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbBinlogSaveDaysUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_binlog_save_days.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -115,8 +120,8 @@ func resourceTencentCloudCynosdbBinlogSaveDaysUpdate(d *schema.ResourceData, met
 		request.BinlogSaveDays = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyBinlogSaveDays(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyBinlogSaveDaysWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -126,13 +131,13 @@ func resourceTencentCloudCynosdbBinlogSaveDaysUpdate(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update cynosdb binlogSaveDays failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCynosdbBinlogSaveDaysRead(d, meta)
+	return resourceTencentCloudCynosdbBinlogSaveDaysRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbBinlogSaveDaysDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbBinlogSaveDaysDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_binlog_save_days.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -66,6 +66,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -76,24 +77,28 @@ import (
 
 func resourceTencentCloudCynosdbCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbClusterCreate,
-		Read:   resourceTencentCloudCynosdbClusterRead,
-		Update: resourceTencentCloudCynosdbClusterUpdate,
-		Delete: resourceTencentCloudCynosdbClusterDelete,
+		CreateContext: resourceTencentCloudCynosdbClusterCreate,
+		ReadContext:   resourceTencentCloudCynosdbClusterRead,
+		UpdateContext: resourceTencentCloudCynosdbClusterUpdate,
+		DeleteContext: resourceTencentCloudCynosdbClusterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: TencentCynosdbClusterBaseInfo(),
 	}
 }
 
-func resourceTencentCloudCynosdbClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_cluster.create")()
 
 	var (
 		logId = getLogId(contextNil)
-		ctx   = context.WithValue(context.TODO(), logIdKey, logId)
 
 		client         = meta.(*TencentCloudClient).apiV3Conn
 		cynosdbService = CynosdbService{client: client}
@@ -102,6 +107,7 @@ func resourceTencentCloudCynosdbClusterCreate(d *schema.ResourceData, meta inter
 
 		request = cynosdb.NewCreateClustersRequest()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request.ProjectId = helper.IntInt64(d.Get("project_id").(int))
 	request.Zone = helper.String(d.Get("available_zone").(string))
@@ -162,16 +168,16 @@ func resourceTencentCloudCynosdbClusterCreate(d *schema.ResourceData, meta inter
 	if v, ok := d.GetOk("instance_cpu_core"); ok {
 		request.Cpu = helper.IntInt64(v.(int))
 	} else if !isServerless {
-		return fmt.Errorf("`instance_cpu_core` is required while creating non-serverless cluster")
+		return diag.Errorf("`instance_cpu_core` is required while creating non-serverless cluster")
 	}
 	if v, ok := d.GetOk("instance_memory_size"); ok {
 		request.Memory = helper.IntInt64(v.(int))
 	} else if !isServerless {
-		return fmt.Errorf("`instance_memory_size` is required while creating non-serverless cluster")
+		return diag.Errorf("`instance_memory_size` is required while creating non-serverless cluster")
 	}
 
 	if _, ok := d.GetOk("serverless_status_flag"); ok && !isServerless {
-		return fmt.Errorf("cannot set `serverless_status_flag` while creating non-serverless cluster")
+		return diag.Errorf("cannot set `serverless_status_flag` while creating non-serverless cluster")
 	}
 
 	var chargeType int64 = 0
@@ -182,7 +188,7 @@ func resourceTencentCloudCynosdbClusterCreate(d *schema.ResourceData, meta inter
 				request.TimeSpan = helper.IntInt64(vv.(int))
 				request.TimeUnit = helper.String("m")
 			} else {
-				return fmt.Errorf("prepaid period can not be empty when charge type is %s", CYNOSDB_CHARGE_TYPE_PREPAID)
+				return diag.Errorf("prepaid period can not be empty when charge type is %s", CYNOSDB_CHARGE_TYPE_PREPAID)
 			}
 			request.AutoRenewFlag = helper.IntInt64(d.Get("auto_renew_flag").(int))
 		}
@@ -193,9 +199,11 @@ func resourceTencentCloudCynosdbClusterCreate(d *schema.ResourceData, meta inter
 
 	var response *cynosdb.CreateClustersResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().CreateClusters(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().CreateClustersWithContext(ctx, request)
 		if err != nil {
 			if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if e.GetCode() == "InvalidParameterValue.DealNameNotFound" {
@@ -208,19 +216,21 @@ func resourceTencentCloudCynosdbClusterCreate(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if response != nil && response.Response != nil && len(response.Response.DealNames) < 1 {
-		return fmt.Errorf("cynosdb cluster id count isn't 1")
+		return diag.Errorf("cynosdb cluster id count isn't 1")
 	}
 	//after 1.53.3 the response is async
 	dealName := response.Response.DealNames[0]
 	dealReq := cynosdb.NewDescribeResourcesByDealNameRequest()
 	dealRes := cynosdb.NewDescribeResourcesByDealNameResponse()
 	dealReq.DealName = dealName
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		dealRes, err = meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().DescribeResourcesByDealName(dealReq)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		dealRes, err = meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().DescribeResourcesByDealNameWithContext(ctx, dealReq)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
 			if sdkErr, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -233,11 +243,11 @@ func resourceTencentCloudCynosdbClusterCreate(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if dealRes != nil && dealRes.Response != nil && len(dealRes.Response.BillingResourceInfos) != 1 {
-		return fmt.Errorf("cynosdb cluster id count isn't 1")
+		return diag.Errorf("cynosdb cluster id count isn't 1")
 	}
 
 	id := *dealRes.Response.BillingResourceInfos[0].ClusterId
@@ -245,30 +255,30 @@ func resourceTencentCloudCynosdbClusterCreate(d *schema.ResourceData, meta inter
 
 	_, _, has, err := cynosdbService.DescribeClusterById(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
-		return fmt.Errorf("[CRITAL]%s creating cynosdb cluster failed, cluster doesn't exist", logId)
+		return diag.Errorf("[CRITAL]%s creating cynosdb cluster failed, cluster doesn't exist", logId)
 	}
 
 	// set tags
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		resourceName := BuildTagResourceName("cynosdb", "cluster", region, id)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	// set maintenance info
 	_, cluster, _, err := cynosdbService.DescribeClusterById(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var rwInstanceId string
 	for _, v := range cluster.InstanceSet {
 		_, instance, has, err := cynosdbService.DescribeInstanceById(ctx, *v.InstanceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if !has {
 			continue
@@ -293,13 +303,13 @@ func resourceTencentCloudCynosdbClusterCreate(d *schema.ResourceData, meta inter
 	duration := int64(d.Get("instance_maintain_duration").(int))
 	err = cynosdbService.ModifyMaintainPeriodConfig(ctx, rwInstanceId, startTime, duration, reqWeekdays)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// set sg
 	insGrps, err := cynosdbService.DescribeClusterInstanceGrps(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var rwGroupId string
 	var roGroupIds []string
@@ -317,7 +327,7 @@ func resourceTencentCloudCynosdbClusterCreate(d *schema.ResourceData, meta inter
 			vvv = append(vvv, helper.String(item.(string)))
 		}
 		if err = cynosdbService.ModifyInsGrpSecurityGroups(ctx, rwGroupId, d.Get("available_zone").(string), vvv); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if v, ok := d.GetOk("ro_group_sg"); ok && len(roGroupIds) > 0 {
@@ -328,7 +338,7 @@ func resourceTencentCloudCynosdbClusterCreate(d *schema.ResourceData, meta inter
 				vvv = append(vvv, helper.String(item.(string)))
 			}
 			if err = cynosdbService.ModifyInsGrpSecurityGroups(ctx, roGroupId, d.Get("available_zone").(string), vvv); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -338,19 +348,19 @@ func resourceTencentCloudCynosdbClusterCreate(d *schema.ResourceData, meta inter
 		resume := v.(string) == "resume"
 		err := cynosdbService.SwitchServerlessCluster(ctx, id, resume)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCynosdbClusterRead(d, meta)
+	return resourceTencentCloudCynosdbClusterRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_cluster.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -358,7 +368,7 @@ func resourceTencentCloudCynosdbClusterRead(d *schema.ResourceData, meta interfa
 	cynosdbService := CynosdbService{client: client}
 	item, cluster, has, err := cynosdbService.DescribeClusterById(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -395,14 +405,14 @@ func resourceTencentCloudCynosdbClusterRead(d *schema.ResourceData, meta interfa
 	tagService := &TagService{client: client}
 	tags, err := tagService.DescribeResourceTags(ctx, "cynosdb", "cluster", client.Region, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	for _, v := range cluster.InstanceSet {
 		_, instance, has, err := cynosdbService.DescribeInstanceById(ctx, *v.InstanceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if !has {
 			continue
@@ -410,7 +420,7 @@ func resourceTencentCloudCynosdbClusterRead(d *schema.ResourceData, meta interfa
 		if *instance.InstanceType == CYNOSDB_INSTANCE_RW_TYPE {
 			maintain, err := cynosdbService.DescribeMaintainPeriod(ctx, *v.InstanceId)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			if *item.DbMode != CYNOSDB_SERVERLESS {
 				_ = d.Set("instance_cpu_core", instance.Cpu)
@@ -430,7 +440,7 @@ func resourceTencentCloudCynosdbClusterRead(d *schema.ResourceData, meta interfa
 	// instance group infos
 	insGrps, err := cynosdbService.DescribeClusterInstanceGrps(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var rwGroupId string
 	rwGroupIns := make([]map[string]interface{}, 0)
@@ -476,7 +486,7 @@ func resourceTencentCloudCynosdbClusterRead(d *schema.ResourceData, meta interfa
 	if rwGroupId != "" {
 		sgs, err := cynosdbService.DescribeInsGrpSecurityGroups(ctx, rwGroupId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if sgs != nil {
 			sgIds := make([]*string, 0, len(sgs.Response.Groups))
@@ -489,7 +499,7 @@ func resourceTencentCloudCynosdbClusterRead(d *schema.ResourceData, meta interfa
 	if roGroupId != "" {
 		sgs, err := cynosdbService.DescribeInsGrpSecurityGroups(ctx, roGroupId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if sgs != nil {
 			sgIds := make([]*string, 0, len(sgs.Response.Groups))
@@ -505,7 +515,7 @@ func resourceTencentCloudCynosdbClusterRead(d *schema.ResourceData, meta interfa
 		currentParamMap := make(map[string]*cynosdb.ParamInfo)
 		params, err := cynosdbService.DescribeClusterParams(ctx, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		for _, param := range params {
 			currentParamMap[*param.ParamName] = param
@@ -534,12 +544,11 @@ func resourceTencentCloudCynosdbClusterRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_cluster.update")()
 
 	var (
 		logId          = getLogId(contextNil)
-		ctx            = context.WithValue(context.TODO(), logIdKey, logId)
 		clusterId      = d.Id()
 		instanceId     = d.Get("instance_id").(string)
 		client         = meta.(*TencentCloudClient).apiV3Conn
@@ -547,6 +556,7 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 		tagService     = TagService{client: client}
 		region         = client.Region
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	immutableArgs := []string{
 		"db_mode",
 		"min_cpu",
@@ -558,7 +568,7 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 
 	for _, a := range immutableArgs {
 		if d.HasChange(a) {
-			return fmt.Errorf("argument %s cannot be modified", a)
+			return diag.Errorf("argument %s cannot be modified", a)
 		}
 	}
 
@@ -569,10 +579,10 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 		memory := int64(d.Get("instance_memory_size").(int))
 		err := cynosdbService.UpgradeInstance(ctx, instanceId, cpu, memory)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		errUpdate := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		errUpdate := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			_, infos, has, e := cynosdbService.DescribeInstanceById(ctx, instanceId)
 			if e != nil {
 				return resource.NonRetryableError(e)
@@ -589,7 +599,7 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 			return nil
 		})
 		if errUpdate != nil {
-			return errUpdate
+			return diag.FromErr(errUpdate)
 		}
 
 	}
@@ -604,7 +614,7 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 		duration := int64(d.Get("instance_maintain_duration").(int))
 		err := cynosdbService.ModifyMaintainPeriodConfig(ctx, instanceId, startTime, duration, reqWeekdays)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -613,10 +623,10 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 	if d.HasChange("param_items") {
 		_, _, has, e := cynosdbService.DescribeClusterById(ctx, clusterId)
 		if e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 		if !has {
-			return fmt.Errorf("[CRITAL]%s updating cynosdb cluster instance failed, instance doesn't exist", logId)
+			return diag.Errorf("[CRITAL]%s updating cynosdb cluster instance failed, instance doesn't exist", logId)
 		}
 
 		o, n := d.GetChange("param_items")
@@ -624,7 +634,7 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 		newParams := n.([]interface{})
 
 		if len(oldParams) > len(newParams) {
-			return fmt.Errorf("`param_items` dosen't support remove for now")
+			return diag.Errorf("`param_items` dosen't support remove for now")
 		}
 
 		request := cynosdb.NewModifyClusterParamRequest()
@@ -649,7 +659,7 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 		var (
 			asyncRequestId string
 		)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			aReqId, modifyErr := cynosdbService.ModifyClusterParam(ctx, request)
 			if modifyErr != nil {
 				err := modifyErr.(*sdkErrors.TencentCloudSDKError)
@@ -662,30 +672,17 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		mysqlService := MysqlService{client: client}
 
-		_ = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("%s modify params task  status is %s", clusterId, taskStatus))
-			}
-			err = fmt.Errorf("%s create account task status is %s,we won't wait for it finish ,it show message:%s", clusterId, taskStatus, message)
-			return resource.NonRetryableError(err)
-		})
+		_ = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutUpdate))
 
 	}
 
 	if d.HasChange("prarm_template_id") {
-		return fmt.Errorf("prarm_template_id not support update!")
+		return diag.Errorf("prarm_template_id not support update!")
 	}
 
 	// update tags
@@ -695,7 +692,7 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 
 		resourceName := BuildTagResourceName("cynosdb", "cluster", region, clusterId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -708,7 +705,7 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 			vv = append(vv, helper.String(item.(string)))
 		}
 		if err := cynosdbService.ModifyInsGrpSecurityGroups(ctx, d.Get("rw_group_id").(string), d.Get("available_zone").(string), vv); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if d.HasChange("ro_group_sg") {
@@ -720,7 +717,7 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 		if roGroupId := d.Get("ro_group_id").(string); roGroupId != "" {
 			err := cynosdbService.ModifyInsGrpSecurityGroups(ctx, roGroupId, d.Get("available_zone").(string), vv)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -732,7 +729,7 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 		resume := d.Get("serverless_status_flag").(string) == "resume"
 		err := cynosdbService.SwitchServerlessCluster(ctx, clusterId, resume)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -741,7 +738,7 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 		autoRenewFlag := int64(d.Get("auto_renew_flag").(int))
 		err := cynosdbService.SetRenewFlag(ctx, clusterId, autoRenewFlag)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -750,7 +747,7 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 		clusterName := d.Get("cluster_name").(string)
 		err := cynosdbService.ModifyClusterName(ctx, clusterId, clusterName)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -759,7 +756,7 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 		oldStorageLimit, newStorageLimit := d.GetChange("storage_limit")
 		err := cynosdbService.ModifyClusterStorage(ctx, clusterId, int64(newStorageLimit.(int)), int64(oldStorageLimit.(int)))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -770,20 +767,20 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 		oldIpReserveHours := int64(d.Get("old_ip_reserve_hours").(int))
 		err := cynosdbService.SwitchClusterVpc(ctx, clusterId, vpcId, subnetId, oldIpReserveHours)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudCynosdbClusterRead(d, meta)
+	return resourceTencentCloudCynosdbClusterRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_cluster.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	clusterID := d.Id()
 	cynosdbService := CynosdbService{
@@ -797,21 +794,21 @@ func resourceTencentCloudCynosdbClusterDelete(d *schema.ResourceData, meta inter
 	_, err = cynosdbService.IsolateCluster(ctx, clusterID)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	conf := BuildStateChangeConf([]string{}, []string{"isolated"}, 2*readRetryTimeout, time.Second, cynosdbService.CynosdbInstanceIsolateStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"isolated"}, d.Timeout(schema.TimeoutDelete), time.Second, cynosdbService.CynosdbInstanceIsolateStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	if forceDelete {
 		if err = cynosdbService.OfflineCluster(ctx, clusterID); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		conf := BuildStateChangeConf([]string{}, []string{"offlined"}, 2*readRetryTimeout, time.Second, cynosdbService.CynosdbInstanceOfflineStateRefreshFunc(d.Id(), []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"offlined"}, d.Timeout(schema.TimeoutDelete), time.Second, cynosdbService.CynosdbInstanceOfflineStateRefreshFunc(d.Id(), []string{}))
 
 		if _, e := conf.WaitForState(); e != nil {
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
@@ -819,7 +816,7 @@ func resourceTencentCloudCynosdbClusterDelete(d *schema.ResourceData, meta inter
 					return nil
 				}
 			}
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
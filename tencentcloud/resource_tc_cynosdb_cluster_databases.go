@@ -30,10 +30,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -42,12 +42,16 @@ import (
 
 func resourceTencentCloudCynosdbClusterDatabases() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbClusterDatabasesCreate,
-		Read:   resourceTencentCloudCynosdbClusterDatabasesRead,
-		Update: resourceTencentCloudCynosdbClusterDatabasesUpdate,
-		Delete: resourceTencentCloudCynosdbClusterDatabasesDelete,
+		CreateContext: resourceTencentCloudCynosdbClusterDatabasesCreate,
+		ReadContext:   resourceTencentCloudCynosdbClusterDatabasesRead,
+		UpdateContext: resourceTencentCloudCynosdbClusterDatabasesUpdate,
+		DeleteContext: resourceTencentCloudCynosdbClusterDatabasesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -108,7 +112,7 @@ func resourceTencentCloudCynosdbClusterDatabases() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbClusterDatabasesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbClusterDatabasesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_cluster_databases.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -158,8 +162,8 @@ func resourceTencentCloudCynosdbClusterDatabasesCreate(d *schema.ResourceData, m
 		request.Description = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().CreateClusterDatabase(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().CreateClusterDatabaseWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -169,33 +173,33 @@ func resourceTencentCloudCynosdbClusterDatabasesCreate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cynosdb clusterDatabases failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(clusterId + FILED_SP + dbName)
+	d.SetId(helper.IdFormat(clusterId, dbName))
 
-	return resourceTencentCloudCynosdbClusterDatabasesRead(d, meta)
+	return resourceTencentCloudCynosdbClusterDatabasesRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbClusterDatabasesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbClusterDatabasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_cluster_databases.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	dbName := idSplit[1]
 
 	clusterDatabases, err := service.DescribeCynosdbClusterDatabasesById(ctx, clusterId, dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if clusterDatabases == nil {
@@ -251,7 +255,7 @@ func resourceTencentCloudCynosdbClusterDatabasesRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudCynosdbClusterDatabasesUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbClusterDatabasesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_cluster_databases.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -259,9 +263,9 @@ func resourceTencentCloudCynosdbClusterDatabasesUpdate(d *schema.ResourceData, m
 
 	request := cynosdb.NewModifyClusterDatabaseRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	clusterId := idSplit[0]
 	dbName := idSplit[1]
@@ -273,7 +277,7 @@ func resourceTencentCloudCynosdbClusterDatabasesUpdate(d *schema.ResourceData, m
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -314,8 +318,8 @@ func resourceTencentCloudCynosdbClusterDatabasesUpdate(d *schema.ResourceData, m
 		request.Description = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyClusterDatabase(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyClusterDatabaseWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -325,29 +329,29 @@ func resourceTencentCloudCynosdbClusterDatabasesUpdate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update cynosdb clusterDatabases failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCynosdbClusterDatabasesRead(d, meta)
+	return resourceTencentCloudCynosdbClusterDatabasesRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbClusterDatabasesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbClusterDatabasesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_cluster_databases.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	dbName := idSplit[1]
 
 	if err := service.DeleteCynosdbClusterDatabasesById(ctx, clusterId, dbName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -32,26 +32,31 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCynosdbClusterPasswordComplexity() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbClusterPasswordComplexityCreate,
-		Read:   resourceTencentCloudCynosdbClusterPasswordComplexityRead,
-		Update: resourceTencentCloudCynosdbClusterPasswordComplexityUpdate,
-		Delete: resourceTencentCloudCynosdbClusterPasswordComplexityDelete,
+		CreateContext: resourceTencentCloudCynosdbClusterPasswordComplexityCreate,
+		ReadContext:   resourceTencentCloudCynosdbClusterPasswordComplexityRead,
+		UpdateContext: resourceTencentCloudCynosdbClusterPasswordComplexityUpdate,
+		DeleteContext: resourceTencentCloudCynosdbClusterPasswordComplexityDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(18 * time.Minute),
+			Update: schema.DefaultTimeout(18 * time.Minute),
+			Delete: schema.DefaultTimeout(18 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -103,12 +108,12 @@ func resourceTencentCloudCynosdbClusterPasswordComplexity() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbClusterPasswordComplexityCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbClusterPasswordComplexityCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_cluster_password_complexity.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request   = cynosdb.NewOpenClusterPasswordComplexityRequest()
@@ -148,8 +153,8 @@ func resourceTencentCloudCynosdbClusterPasswordComplexityCreate(d *schema.Resour
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().OpenClusterPasswordComplexity(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().OpenClusterPasswordComplexityWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -160,49 +165,35 @@ func resourceTencentCloudCynosdbClusterPasswordComplexityCreate(d *schema.Resour
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cynosdb clusterPasswordComplexity failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(clusterId)
 
 	flowId := *response.Response.FlowId
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeFlow(ctx, flowId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("create cynosdb clusterPasswordComplexity is processing"))
-		}
-	})
+	err = service.WaitForFlow(ctx, flowId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cynosdb clusterPasswordComplexity fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = service.CopyClusterPasswordComplexity(ctx, clusterId)
 	if err != nil {
 		log.Printf("[CRITAL]%s create cynosdb copyClusterPasswordComplexity fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCynosdbClusterPasswordComplexityRead(d, meta)
+	return resourceTencentCloudCynosdbClusterPasswordComplexityRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbClusterPasswordComplexityRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbClusterPasswordComplexityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_cluster_password_complexity.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -210,7 +201,7 @@ func resourceTencentCloudCynosdbClusterPasswordComplexityRead(d *schema.Resource
 
 	clusterPasswordComplexity, err := service.DescribeCynosdbClusterPasswordComplexityById(ctx, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if clusterPasswordComplexity == nil {
@@ -224,7 +215,7 @@ func resourceTencentCloudCynosdbClusterPasswordComplexityRead(d *schema.Resource
 	if clusterPasswordComplexity.ValidatePasswordLength != nil {
 		currentValue, err := strconv.ParseInt(*clusterPasswordComplexity.ValidatePasswordLength.CurrentValue, 10, 64)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		_ = d.Set("validate_password_length", currentValue)
 	}
@@ -232,7 +223,7 @@ func resourceTencentCloudCynosdbClusterPasswordComplexityRead(d *schema.Resource
 	if clusterPasswordComplexity.ValidatePasswordMixedCaseCount != nil {
 		currentValue, err := strconv.ParseInt(*clusterPasswordComplexity.ValidatePasswordMixedCaseCount.CurrentValue, 10, 64)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		_ = d.Set("validate_password_mixed_case_count", currentValue)
 	}
@@ -240,7 +231,7 @@ func resourceTencentCloudCynosdbClusterPasswordComplexityRead(d *schema.Resource
 	if clusterPasswordComplexity.ValidatePasswordSpecialCharCount != nil {
 		currentValue, err := strconv.ParseInt(*clusterPasswordComplexity.ValidatePasswordSpecialCharCount.CurrentValue, 10, 64)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		_ = d.Set("validate_password_special_char_count", currentValue)
 	}
@@ -248,7 +239,7 @@ func resourceTencentCloudCynosdbClusterPasswordComplexityRead(d *schema.Resource
 	if clusterPasswordComplexity.ValidatePasswordNumberCount != nil {
 		currentValue, err := strconv.ParseInt(*clusterPasswordComplexity.ValidatePasswordNumberCount.CurrentValue, 10, 64)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		_ = d.Set("validate_password_number_count", currentValue)
 	}
@@ -267,12 +258,12 @@ func resourceTencentCloudCynosdbClusterPasswordComplexityRead(d *schema.Resource
 	return nil
 }
 
-func resourceTencentCloudCynosdbClusterPasswordComplexityUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbClusterPasswordComplexityUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_cluster_password_complexity.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request  = cynosdb.NewModifyClusterPasswordComplexityRequest()
@@ -310,8 +301,8 @@ func resourceTencentCloudCynosdbClusterPasswordComplexityUpdate(d *schema.Resour
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyClusterPasswordComplexity(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyClusterPasswordComplexityWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -322,75 +313,47 @@ func resourceTencentCloudCynosdbClusterPasswordComplexityUpdate(d *schema.Resour
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update cynosdb clusterPasswordComplexity failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	flowId := *response.Response.FlowId
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeFlow(ctx, flowId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("update cynosdb clusterPasswordComplexity is processing"))
-		}
-	})
+	err = service.WaitForFlow(ctx, flowId, d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update cynosdb clusterPasswordComplexity fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = service.CopyClusterPasswordComplexity(ctx, clusterId)
 	if err != nil {
 		log.Printf("[CRITAL]%s update cynosdb copyClusterPasswordComplexity fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCynosdbClusterPasswordComplexityRead(d, meta)
+	return resourceTencentCloudCynosdbClusterPasswordComplexityRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbClusterPasswordComplexityDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbClusterPasswordComplexityDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_cluster_password_complexity.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	clusterId := d.Id()
 
 	flowId, err := service.DeleteCynosdbClusterPasswordComplexityById(ctx, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeFlow(ctx, flowId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("delete cynosdb clusterPasswordComplexity is processing"))
-		}
-	})
+	err = service.WaitForFlow(ctx, flowId, d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s delete cynosdb clusterPasswordComplexity fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -24,7 +24,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -33,11 +35,14 @@ import (
 
 func resourceTencentCloudCynosdbClusterResourcePackagesAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbClusterResourcePackagesAttachmentCreate,
-		Read:   resourceTencentCloudCynosdbClusterResourcePackagesAttachmentRead,
-		Delete: resourceTencentCloudCynosdbClusterResourcePackagesAttachmentDelete,
+		CreateContext: resourceTencentCloudCynosdbClusterResourcePackagesAttachmentCreate,
+		ReadContext:   resourceTencentCloudCynosdbClusterResourcePackagesAttachmentRead,
+		DeleteContext: resourceTencentCloudCynosdbClusterResourcePackagesAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -57,7 +62,7 @@ func resourceTencentCloudCynosdbClusterResourcePackagesAttachment() *schema.Reso
 	}
 }
 
-func resourceTencentCloudCynosdbClusterResourcePackagesAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbClusterResourcePackagesAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_cluster_resource_packages_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -80,8 +85,8 @@ func resourceTencentCloudCynosdbClusterResourcePackagesAttachmentCreate(d *schem
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().BindClusterResourcePackages(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().BindClusterResourcePackagesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -98,28 +103,28 @@ func resourceTencentCloudCynosdbClusterResourcePackagesAttachmentCreate(d *schem
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cynosdb clusterResourcePackagesAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(clusterId)
 
-	return resourceTencentCloudCynosdbClusterResourcePackagesAttachmentRead(d, meta)
+	return resourceTencentCloudCynosdbClusterResourcePackagesAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbClusterResourcePackagesAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbClusterResourcePackagesAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_cluster_resource_packages_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		clusterId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	clusterResourcePackagesAttachment, err := service.DescribeCynosdbClusterResourcePackagesAttachmentById(ctx, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if clusterResourcePackagesAttachment == nil {
@@ -145,17 +150,17 @@ func resourceTencentCloudCynosdbClusterResourcePackagesAttachmentRead(d *schema.
 	return nil
 }
 
-func resourceTencentCloudCynosdbClusterResourcePackagesAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbClusterResourcePackagesAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_cluster_resource_packages_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		service       = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		clusterId     = d.Id()
 		packageIdsSet []*string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("package_ids"); ok {
 		idsSet := v.(*schema.Set).List()
@@ -165,7 +170,7 @@ func resourceTencentCloudCynosdbClusterResourcePackagesAttachmentDelete(d *schem
 		}
 
 		if err := service.DeleteCynosdbClusterResourcePackagesAttachmentById(ctx, clusterId, packageIdsSet); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
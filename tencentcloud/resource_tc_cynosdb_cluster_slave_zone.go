@@ -117,11 +117,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -131,17 +130,17 @@ import (
 
 func resourceTencentCloudCynosdbClusterSlaveZone() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbClusterSlaveZoneCreate,
-		Read:   resourceTencentCloudCynosdbClusterSlaveZoneRead,
-		Update: resourceTencentCloudCynosdbClusterSlaveZoneUpdate,
-		Delete: resourceTencentCloudCynosdbClusterSlaveZoneDelete,
+		CreateContext: resourceTencentCloudCynosdbClusterSlaveZoneCreate,
+		ReadContext:   resourceTencentCloudCynosdbClusterSlaveZoneRead,
+		UpdateContext: resourceTencentCloudCynosdbClusterSlaveZoneUpdate,
+		DeleteContext: resourceTencentCloudCynosdbClusterSlaveZoneDelete,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(300 * time.Second),
 			Update: schema.DefaultTimeout(300 * time.Second),
 			Delete: schema.DefaultTimeout(300 * time.Second),
 		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -159,7 +158,7 @@ func resourceTencentCloudCynosdbClusterSlaveZone() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbClusterSlaveZoneCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbClusterSlaveZoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_cluster_slave_zone.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -184,8 +183,8 @@ func resourceTencentCloudCynosdbClusterSlaveZoneCreate(d *schema.ResourceData, m
 		slaveZone = v.(string)
 	}
 
-	err := resource.Retry(timeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().AddClusterSlaveZone(request)
+	err := retryContext(ctx, timeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().AddClusterSlaveZoneWithContext(ctx, request)
 		if e != nil {
 			if sdkErr, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkErr.Code == "FailedOperation.OperationFailedError" {
@@ -201,47 +200,47 @@ func resourceTencentCloudCynosdbClusterSlaveZoneCreate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cynosdb clusterSlaveZone failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	flowId = response.Response.FlowId
 
 	if flowId == nil {
-		return fmt.Errorf("delete [%s] failed, reason: FlowId is null.\n", d.Id())
+		return diag.Errorf("delete [%s] failed, reason: FlowId is null.\n", d.Id())
 	}
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
-	conf := BuildStateChangeConf([]string{}, []string{CYNOSDB_FLOW_STATUS_SUCCESSFUL}, timeout, 3*time.Second, service.CynosdbClusterSlaveZoneStateRefreshFunc(*flowId, []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{CYNOSDB_FLOW_STATUS_SUCCESSFUL}, timeout, 3*time.Second, service.CynosdbClusterSlaveZoneStateRefreshFunc(ctx, *flowId, []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	d.SetId(strings.Join([]string{clusterId, slaveZone}, FILED_SP))
+	d.SetId(helper.IdFormat(clusterId, slaveZone))
 
-	return resourceTencentCloudCynosdbClusterSlaveZoneRead(d, meta)
+	return resourceTencentCloudCynosdbClusterSlaveZoneRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbClusterSlaveZoneRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbClusterSlaveZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_cluster_slave_zone.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	slaveZone := idSplit[1]
 
 	clusterSlaveZone, err := service.DescribeCynosdbClusterSlaveZoneById(ctx, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if clusterSlaveZone == nil {
@@ -266,7 +265,7 @@ func resourceTencentCloudCynosdbClusterSlaveZoneRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudCynosdbClusterSlaveZoneUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbClusterSlaveZoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_cluster_slave_zone.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -277,9 +276,9 @@ func resourceTencentCloudCynosdbClusterSlaveZoneUpdate(d *schema.ResourceData, m
 	request := cynosdb.NewModifyClusterSlaveZoneRequest()
 	var newSlaveZone string
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	clusterId := idSplit[0]
 	slaveZone := idSplit[1]
@@ -288,7 +287,7 @@ func resourceTencentCloudCynosdbClusterSlaveZoneUpdate(d *schema.ResourceData, m
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -303,8 +302,8 @@ func resourceTencentCloudCynosdbClusterSlaveZoneUpdate(d *schema.ResourceData, m
 	request.OldSlaveZone = helper.String(slaveZone)
 
 	var flowId *int64
-	err := resource.Retry(timeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyClusterSlaveZone(request)
+	err := retryContext(ctx, timeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyClusterSlaveZoneWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -315,41 +314,41 @@ func resourceTencentCloudCynosdbClusterSlaveZoneUpdate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update cynosdb clusterSlaveZone failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if flowId == nil {
-		return fmt.Errorf("delete [%s] failed, reason: FlowId is null.\n", d.Id())
+		return diag.Errorf("delete [%s] failed, reason: FlowId is null.\n", d.Id())
 	}
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{CYNOSDB_FLOW_STATUS_SUCCESSFUL}, timeout, time.Second, service.CynosdbClusterSlaveZoneStateRefreshFunc(*flowId, []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{CYNOSDB_FLOW_STATUS_SUCCESSFUL}, timeout, time.Second, service.CynosdbClusterSlaveZoneStateRefreshFunc(ctx, *flowId, []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	// update the id
-	d.SetId(strings.Join([]string{clusterId, newSlaveZone}, FILED_SP))
+	d.SetId(helper.IdFormat(clusterId, newSlaveZone))
 
-	return resourceTencentCloudCynosdbClusterSlaveZoneRead(d, meta)
+	return resourceTencentCloudCynosdbClusterSlaveZoneRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbClusterSlaveZoneDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbClusterSlaveZoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_cluster_slave_zone.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	timeout := d.Timeout(schema.TimeoutDelete)
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	clusterId := idSplit[0]
 	slaveZone := idSplit[1]
@@ -360,17 +359,17 @@ func resourceTencentCloudCynosdbClusterSlaveZoneDelete(d *schema.ResourceData, m
 	)
 
 	if flowId, err = service.DeleteCynosdbClusterSlaveZoneById(ctx, clusterId, slaveZone); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if flowId == nil {
-		return fmt.Errorf("delete [%s] failed, reason: FlowId is null.\n", d.Id())
+		return diag.Errorf("delete [%s] failed, reason: FlowId is null.\n", d.Id())
 	}
 
-	conf := BuildStateChangeConf([]string{}, []string{CYNOSDB_FLOW_STATUS_SUCCESSFUL}, timeout, time.Second, service.CynosdbClusterSlaveZoneStateRefreshFunc(*flowId, []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{CYNOSDB_FLOW_STATUS_SUCCESSFUL}, timeout, time.Second, service.CynosdbClusterSlaveZoneStateRefreshFunc(ctx, *flowId, []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	return nil
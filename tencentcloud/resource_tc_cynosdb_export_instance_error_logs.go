@@ -19,8 +19,11 @@ resource "tencentcloud_cynosdb_export_instance_error_logs" "export_instance_erro
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -29,9 +32,12 @@ import (
 
 func resourceTencentCloudCynosdbExportInstanceErrorLogs() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbExportInstanceErrorLogsCreate,
-		Read:   resourceTencentCloudCynosdbExportInstanceErrorLogsRead,
-		Delete: resourceTencentCloudCynosdbExportInstanceErrorLogsDelete,
+		CreateContext: resourceTencentCloudCynosdbExportInstanceErrorLogsCreate,
+		ReadContext:   resourceTencentCloudCynosdbExportInstanceErrorLogsRead,
+		DeleteContext: resourceTencentCloudCynosdbExportInstanceErrorLogsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -124,7 +130,7 @@ func resourceTencentCloudCynosdbExportInstanceErrorLogs() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbExportInstanceErrorLogsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbExportInstanceErrorLogsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_export_instance_error_logs.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -176,8 +182,8 @@ func resourceTencentCloudCynosdbExportInstanceErrorLogsCreate(d *schema.Resource
 		request.OrderByType = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ExportInstanceErrorLogs(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ExportInstanceErrorLogsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -188,7 +194,7 @@ func resourceTencentCloudCynosdbExportInstanceErrorLogsCreate(d *schema.Resource
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate cynosdb exportInstanceErrorLogs failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
@@ -213,17 +219,17 @@ func resourceTencentCloudCynosdbExportInstanceErrorLogsCreate(d *schema.Resource
 		_ = d.Set("error_log_item_export", logItemList)
 	}
 
-	return resourceTencentCloudCynosdbExportInstanceErrorLogsRead(d, meta)
+	return resourceTencentCloudCynosdbExportInstanceErrorLogsRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbExportInstanceErrorLogsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbExportInstanceErrorLogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_export_instance_error_logs.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCynosdbExportInstanceErrorLogsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbExportInstanceErrorLogsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_export_instance_error_logs.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -18,8 +18,11 @@ resource "tencentcloud_cynosdb_export_instance_slow_queries" "export_instance_sl
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -28,9 +31,12 @@ import (
 
 func resourceTencentCloudCynosdbExportInstanceSlowQueries() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbExportInstanceSlowQueriesCreate,
-		Read:   resourceTencentCloudCynosdbExportInstanceSlowQueriesRead,
-		Delete: resourceTencentCloudCynosdbExportInstanceSlowQueriesDelete,
+		CreateContext: resourceTencentCloudCynosdbExportInstanceSlowQueriesCreate,
+		ReadContext:   resourceTencentCloudCynosdbExportInstanceSlowQueriesRead,
+		DeleteContext: resourceTencentCloudCynosdbExportInstanceSlowQueriesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -91,7 +97,7 @@ func resourceTencentCloudCynosdbExportInstanceSlowQueries() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbExportInstanceSlowQueriesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbExportInstanceSlowQueriesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_export_instance_slow_queries.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -131,8 +137,8 @@ func resourceTencentCloudCynosdbExportInstanceSlowQueriesCreate(d *schema.Resour
 		request.FileType = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ExportInstanceSlowQueries(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ExportInstanceSlowQueriesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -143,7 +149,7 @@ func resourceTencentCloudCynosdbExportInstanceSlowQueriesCreate(d *schema.Resour
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate cynosdb exportInstanceSlowQueries failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
@@ -152,17 +158,17 @@ func resourceTencentCloudCynosdbExportInstanceSlowQueriesCreate(d *schema.Resour
 		_ = d.Set("file_content", response.Response.FileContent)
 	}
 
-	return resourceTencentCloudCynosdbExportInstanceSlowQueriesRead(d, meta)
+	return resourceTencentCloudCynosdbExportInstanceSlowQueriesRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbExportInstanceSlowQueriesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbExportInstanceSlowQueriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_export_instance_slow_queries.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCynosdbExportInstanceSlowQueriesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbExportInstanceSlowQueriesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_export_instance_slow_queries.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -15,28 +15,41 @@ resource "tencentcloud_cynosdb_instance_param" "instance_param" {
   }
 }
 ```
+
+Import
+
+cynosdb instance_param can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_cynosdb_instance_param.instance_param cynosdbmysql-bws8h88b#cynosdbmysql-ins-rikr6z4o
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCynosdbInstanceParam() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbInstanceParamCreate,
-		Read:   resourceTencentCloudCynosdbInstanceParamRead,
-		Update: resourceTencentCloudCynosdbInstanceParamUpdate,
-		Delete: resourceTencentCloudCynosdbInstanceParamDelete,
+		CreateContext: resourceTencentCloudCynosdbInstanceParamCreate,
+		ReadContext:   resourceTencentCloudCynosdbInstanceParamRead,
+		UpdateContext: resourceTencentCloudCynosdbInstanceParamUpdate,
+		DeleteContext: resourceTencentCloudCynosdbInstanceParamDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(18 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -82,7 +95,7 @@ func resourceTencentCloudCynosdbInstanceParam() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbInstanceParamCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbInstanceParamCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_instance_param.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -96,30 +109,30 @@ func resourceTencentCloudCynosdbInstanceParamCreate(d *schema.ResourceData, meta
 		instanceId = v.(string)
 	}
 
-	d.SetId(clusterId + FILED_SP + instanceId)
+	d.SetId(helper.IdFormat(clusterId, instanceId))
 
-	return resourceTencentCloudCynosdbInstanceParamUpdate(d, meta)
+	return resourceTencentCloudCynosdbInstanceParamUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbInstanceParamRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbInstanceParamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_instance_param.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	instanceId := idSplit[1]
 
 	instanceParam, err := service.DescribeCynosdbInstanceParamById(ctx, clusterId, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instanceParam == nil {
@@ -161,18 +174,18 @@ func resourceTencentCloudCynosdbInstanceParamRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudCynosdbInstanceParamUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbInstanceParamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_instance_param.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	request := cynosdb.NewModifyInstanceParamRequest()
 	response := cynosdb.NewModifyInstanceParamResponse()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	clusterId := idSplit[0]
 	instanceId := idSplit[1]
@@ -212,8 +225,8 @@ func resourceTencentCloudCynosdbInstanceParamUpdate(d *schema.ResourceData, meta
 		request.IsInMaintainPeriod = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyInstanceParam(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyInstanceParamWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -224,36 +237,22 @@ func resourceTencentCloudCynosdbInstanceParamUpdate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update cynosdb instanceParam failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	flowId := *response.Response.FlowId
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeFlow(ctx, flowId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("update cynosdb instanceParam is processing"))
-		}
-	})
+	err = service.WaitForFlow(ctx, flowId, d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update cynosdb instanceParam fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCynosdbInstanceParamRead(d, meta)
+	return resourceTencentCloudCynosdbInstanceParamRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbInstanceParamDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbInstanceParamDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_instance_param.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -18,22 +18,25 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCynosdbIsolateInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbIsolateInstanceCreate,
-		Read:   resourceTencentCloudCynosdbIsolateInstanceRead,
-		Update: resourceTencentCloudCynosdbIsolateInstanceUpdate,
-		Delete: resourceTencentCloudCynosdbIsolateInstanceDelete,
+		CreateContext: resourceTencentCloudCynosdbIsolateInstanceCreate,
+		ReadContext:   resourceTencentCloudCynosdbIsolateInstanceRead,
+		UpdateContext: resourceTencentCloudCynosdbIsolateInstanceUpdate,
+		DeleteContext: resourceTencentCloudCynosdbIsolateInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(18 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -59,7 +62,7 @@ func resourceTencentCloudCynosdbIsolateInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbIsolateInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbIsolateInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_isolate_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -75,28 +78,28 @@ func resourceTencentCloudCynosdbIsolateInstanceCreate(d *schema.ResourceData, me
 		instanceId = v.(string)
 	}
 
-	d.SetId(clusterId + FILED_SP + instanceId)
+	d.SetId(helper.IdFormat(clusterId, instanceId))
 
-	return resourceTencentCloudCynosdbIsolateInstanceUpdate(d, meta)
+	return resourceTencentCloudCynosdbIsolateInstanceUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbIsolateInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbIsolateInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_isolate_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCynosdbIsolateInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbIsolateInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_isolate_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	clusterId := idSplit[0]
 	instanceId := idSplit[1]
@@ -111,8 +114,8 @@ func resourceTencentCloudCynosdbIsolateInstanceUpdate(d *schema.ResourceData, me
 		request := cynosdb.NewIsolateInstanceRequest()
 		request.ClusterId = &clusterId
 		request.InstanceIdList = []*string{&instanceId}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().IsolateInstance(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().IsolateInstanceWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -123,15 +126,15 @@ func resourceTencentCloudCynosdbIsolateInstanceUpdate(d *schema.ResourceData, me
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s isolate cynosdb instance failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	} else if operate == "activate" {
 		request := cynosdb.NewActivateInstanceRequest()
 		request.ClusterId = &clusterId
 		request.InstanceIdList = []*string{&instanceId}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ActivateInstance(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ActivateInstanceWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -142,38 +145,24 @@ func resourceTencentCloudCynosdbIsolateInstanceUpdate(d *schema.ResourceData, me
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s activate cynosdb instance failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		return fmt.Errorf("[CRITAL]%s Operation type error", logId)
+		return diag.Errorf("[CRITAL]%s Operation type error", logId)
 	}
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err := resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeFlow(ctx, flowId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("isolate or activate cynosdb instance is processing"))
-		}
-	})
+	err := service.WaitForFlow(ctx, flowId, d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s isolate or activate cynosdb instance fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCynosdbIsolateInstanceRead(d, meta)
+	return resourceTencentCloudCynosdbIsolateInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbIsolateInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbIsolateInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_isolate_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -16,15 +16,24 @@ resource "tencentcloud_cynosdb_param_template" "param_template" {
     }
 }
 ```
+
+Import
+
+cynosdb param_template can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_cynosdb_param_template.param_template 15765
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -33,10 +42,17 @@ import (
 
 func resourceTencentCloudCynosdbParamTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbParamTemplateCreate,
-		Read:   resourceTencentCloudCynosdbParamTemplateRead,
-		Update: resourceTencentCloudCynosdbParamTemplateUpdate,
-		Delete: resourceTencentCloudCynosdbParamTemplateDelete,
+		CreateContext: resourceTencentCloudCynosdbParamTemplateCreate,
+		ReadContext:   resourceTencentCloudCynosdbParamTemplateRead,
+		UpdateContext: resourceTencentCloudCynosdbParamTemplateUpdate,
+		DeleteContext: resourceTencentCloudCynosdbParamTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"template_name": {
@@ -96,7 +112,7 @@ func resourceTencentCloudCynosdbParamTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbParamTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbParamTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_param_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -140,8 +156,8 @@ func resourceTencentCloudCynosdbParamTemplateCreate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().CreateParamTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().CreateParamTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -152,32 +168,32 @@ func resourceTencentCloudCynosdbParamTemplateCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cynosdb paramTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	templateId := *response.Response.TemplateId
 	d.SetId(strconv.FormatInt(templateId, 10))
 
-	return resourceTencentCloudCynosdbParamTemplateRead(d, meta)
+	return resourceTencentCloudCynosdbParamTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbParamTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbParamTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_param_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	templateId, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	paramTemplate, err := service.DescribeCynosdbParamTemplateById(ctx, templateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if paramTemplate == nil {
@@ -243,7 +259,7 @@ func resourceTencentCloudCynosdbParamTemplateRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudCynosdbParamTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbParamTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_param_template.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -252,7 +268,7 @@ func resourceTencentCloudCynosdbParamTemplateUpdate(d *schema.ResourceData, meta
 
 	templateId, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	request.TemplateId = &templateId
@@ -261,7 +277,7 @@ func resourceTencentCloudCynosdbParamTemplateUpdate(d *schema.ResourceData, meta
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -306,8 +322,8 @@ func resourceTencentCloudCynosdbParamTemplateUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyParamTemplate(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyParamTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -317,27 +333,27 @@ func resourceTencentCloudCynosdbParamTemplateUpdate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update cynosdb paramTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCynosdbParamTemplateRead(d, meta)
+	return resourceTencentCloudCynosdbParamTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbParamTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbParamTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_param_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	templateId, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := service.DeleteCynosdbParamTemplateById(ctx, templateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -21,6 +21,14 @@ resource "tencentcloud_cynosdb_proxy" "proxy" {
   }
 }
 ```
+
+Import
+
+cynosdb proxy can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_cynosdb_proxy.proxy cynosdbmysql-bws8h88b#cynosdbmysql-proxy-l6zf9t30
+```
 */
 package tencentcloud
 
@@ -28,22 +36,30 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCynosdbProxy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbProxyCreate,
-		Read:   resourceTencentCloudCynosdbProxyRead,
-		Update: resourceTencentCloudCynosdbProxyUpdate,
-		Delete: resourceTencentCloudCynosdbProxyDelete,
+		CreateContext: resourceTencentCloudCynosdbProxyCreate,
+		ReadContext:   resourceTencentCloudCynosdbProxyRead,
+		UpdateContext: resourceTencentCloudCynosdbProxyUpdate,
+		DeleteContext: resourceTencentCloudCynosdbProxyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(18 * time.Minute),
+			Update: schema.DefaultTimeout(18 * time.Minute),
+			Delete: schema.DefaultTimeout(18 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -155,13 +171,12 @@ func resourceTencentCloudCynosdbProxy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbProxyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbProxyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_proxy.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId        = getLogId(contextNil)
-		ctx          = context.WithValue(context.TODO(), logIdKey, logId)
 		service      = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request      = cynosdb.NewCreateProxyRequest()
 		response     = cynosdb.NewCreateProxyResponse()
@@ -169,6 +184,7 @@ func resourceTencentCloudCynosdbProxyCreate(d *schema.ResourceData, meta interfa
 		proxyGroupId string
 		flowId       int64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("cluster_id"); ok {
 		request.ClusterId = helper.String(v.(string))
@@ -233,8 +249,8 @@ func resourceTencentCloudCynosdbProxyCreate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().CreateProxy(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().CreateProxyWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -252,35 +268,20 @@ func resourceTencentCloudCynosdbProxyCreate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cynosdb proxy failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	flowId = *response.Response.FlowId
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeFlow(ctx, flowId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("cynosdb proxy is processing"))
-		}
-	})
+	err = service.WaitForFlow(ctx, flowId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cynosdb proxy fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	proxy, err := service.DescribeCynosdbProxyById(ctx, clusterId, "")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if proxy == nil {
@@ -296,24 +297,24 @@ func resourceTencentCloudCynosdbProxyCreate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	d.SetId(strings.Join([]string{clusterId, proxyGroupId}, FILED_SP))
+	d.SetId(helper.IdFormat(clusterId, proxyGroupId))
 
-	return resourceTencentCloudCynosdbProxyRead(d, meta)
+	return resourceTencentCloudCynosdbProxyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbProxyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbProxyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_proxy.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	clusterId := idSplit[0]
@@ -321,7 +322,7 @@ func resourceTencentCloudCynosdbProxyRead(d *schema.ResourceData, meta interface
 
 	proxy, err := service.DescribeCynosdbProxyById(ctx, clusterId, proxyGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if proxy == nil {
@@ -330,6 +331,8 @@ func resourceTencentCloudCynosdbProxyRead(d *schema.ResourceData, meta interface
 		return nil
 	}
 
+	_ = d.Set("cluster_id", clusterId)
+
 	if proxy != nil {
 		proxyGroupRwInfo := proxy.ProxyGroupInfos[0]
 		connectionPool := proxyGroupRwInfo.ConnectionPool
@@ -425,23 +428,23 @@ func resourceTencentCloudCynosdbProxyRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudCynosdbProxyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbProxyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_proxy.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId                  = getLogId(contextNil)
-		ctx                    = context.WithValue(context.TODO(), logIdKey, logId)
 		service                = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		switchProxyRequest     = cynosdb.NewSwitchProxyVpcRequest()
 		modifyProxyDescRequest = cynosdb.NewModifyProxyDescRequest()
 		upgradeProxyRequest    = cynosdb.NewUpgradeProxyRequest()
 		flowId                 int64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	clusterId := idSplit[0]
@@ -451,7 +454,7 @@ func resourceTencentCloudCynosdbProxyUpdate(d *schema.ResourceData, meta interfa
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -468,8 +471,8 @@ func resourceTencentCloudCynosdbProxyUpdate(d *schema.ResourceData, meta interfa
 			switchProxyRequest.UniqSubnetId = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().SwitchProxyVpc(switchProxyRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().SwitchProxyVpcWithContext(ctx, switchProxyRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -482,29 +485,14 @@ func resourceTencentCloudCynosdbProxyUpdate(d *schema.ResourceData, meta interfa
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update cynosdb proxy failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-			ok, err := service.DescribeFlow(ctx, flowId)
-			if err != nil {
-				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-
-			if ok {
-				return nil
-			} else {
-				return resource.RetryableError(fmt.Errorf("cynosdb proxy is processing"))
-			}
-		})
+		err = service.WaitForFlow(ctx, flowId, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update cynosdb proxy fail, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -516,8 +504,8 @@ func resourceTencentCloudCynosdbProxyUpdate(d *schema.ResourceData, meta interfa
 			modifyProxyDescRequest.Description = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyProxyDesc(modifyProxyDescRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyProxyDescWithContext(ctx, modifyProxyDescRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -529,7 +517,7 @@ func resourceTencentCloudCynosdbProxyUpdate(d *schema.ResourceData, meta interfa
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update cynosdb proxy desc failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -562,8 +550,8 @@ func resourceTencentCloudCynosdbProxyUpdate(d *schema.ResourceData, meta interfa
 			}
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().UpgradeProxy(upgradeProxyRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().UpgradeProxyWithContext(ctx, upgradeProxyRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -576,77 +564,47 @@ func resourceTencentCloudCynosdbProxyUpdate(d *schema.ResourceData, meta interfa
 
 		if err != nil {
 			log.Printf("[CRITAL]%s upgrade proxy failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-			ok, err := service.DescribeFlow(ctx, flowId)
-			if err != nil {
-				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-
-			if ok {
-				return nil
-			} else {
-				return resource.RetryableError(fmt.Errorf("upgrade proxy is processing"))
-			}
-		})
+		err = service.WaitForFlow(ctx, flowId, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			log.Printf("[CRITAL]%s upgrade proxy fail, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCynosdbProxyRead(d, meta)
+	return resourceTencentCloudCynosdbProxyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbProxyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbProxyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_proxy.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	clusterId := idSplit[0]
 
 	flowId, err := service.DeleteCynosdbProxyById(ctx, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeFlow(ctx, *flowId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("cynosdb proxy is processing"))
-		}
-	})
+	err = service.WaitForFlow(ctx, *flowId, d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s close cynosdb proxy fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -134,28 +134,43 @@ resource "tencentcloud_cynosdb_proxy_end_point" "proxy_end_point" {
   }
 }
 ```
+
+Import
+
+cynosdb proxy_end_point can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_cynosdb_proxy_end_point.proxy_end_point cynosdbmysql-bws8h88b#cynosdbmysql-proxy-l6zf9t30#cynosdbmysql-grp-bzxibu8t
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCynosdbProxyEndPoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbProxyEndPointCreate,
-		Read:   resourceTencentCloudCynosdbProxyEndPointRead,
-		Update: resourceTencentCloudCynosdbProxyEndPointUpdate,
-		Delete: resourceTencentCloudCynosdbProxyEndPointDelete,
+		CreateContext: resourceTencentCloudCynosdbProxyEndPointCreate,
+		ReadContext:   resourceTencentCloudCynosdbProxyEndPointRead,
+		UpdateContext: resourceTencentCloudCynosdbProxyEndPointUpdate,
+		DeleteContext: resourceTencentCloudCynosdbProxyEndPointDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(18 * time.Minute),
+			Update: schema.DefaultTimeout(18 * time.Minute),
+			Delete: schema.DefaultTimeout(18 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -297,13 +312,12 @@ func resourceTencentCloudCynosdbProxyEndPoint() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbProxyEndPointCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbProxyEndPointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_proxy_end_point.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId                 = getLogId(contextNil)
-		ctx                   = context.WithValue(context.TODO(), logIdKey, logId)
 		service               = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request               = cynosdb.NewCreateProxyEndPointRequest()
 		response              = cynosdb.NewCreateProxyEndPointResponse()
@@ -315,6 +329,7 @@ func resourceTencentCloudCynosdbProxyEndPointCreate(d *schema.ResourceData, meta
 		instanceGroupId       string
 		flowId                int64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("cluster_id"); ok {
 		request.ClusterId = helper.String(v.(string))
@@ -343,14 +358,14 @@ func resourceTencentCloudCynosdbProxyEndPointCreate(d *schema.ResourceData, meta
 
 		} else if v.(string) == STATUS_NO {
 			if _, ok := d.GetOk("connection_pool_type"); ok {
-				return fmt.Errorf("connection_pool_type invalid parameter")
+				return diag.Errorf("connection_pool_type invalid parameter")
 			}
 
 			if _, ok := d.GetOkExists("connection_pool_time_out"); ok {
-				return fmt.Errorf("connection_pool_time_out invalid parameter")
+				return diag.Errorf("connection_pool_time_out invalid parameter")
 			}
 		} else {
-			return fmt.Errorf("open_connection_pool invalid parameter")
+			return diag.Errorf("open_connection_pool invalid parameter")
 		}
 	}
 
@@ -400,18 +415,18 @@ func resourceTencentCloudCynosdbProxyEndPointCreate(d *schema.ResourceData, meta
 
 		} else if v.(string) == RO_TYPE {
 			if _, ok := d.GetOk("consistency_type"); ok {
-				return fmt.Errorf("consistency_type invalid parameter")
+				return diag.Errorf("consistency_type invalid parameter")
 			}
 
 			if _, ok := d.GetOkExists("consistency_time_out"); ok {
-				return fmt.Errorf("consistency_time_out invalid parameter")
+				return diag.Errorf("consistency_time_out invalid parameter")
 			}
 
 			if _, ok := d.GetOk("fail_over"); ok {
-				return fmt.Errorf("fail_over invalid parameter")
+				return diag.Errorf("fail_over invalid parameter")
 			}
 		} else {
-			return fmt.Errorf("rw_type invalid parameter")
+			return diag.Errorf("rw_type invalid parameter")
 		}
 	}
 
@@ -437,8 +452,8 @@ func resourceTencentCloudCynosdbProxyEndPointCreate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().CreateProxyEndPoint(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().CreateProxyEndPointWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -451,36 +466,21 @@ func resourceTencentCloudCynosdbProxyEndPointCreate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cynosdb proxyEndPoint failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	proxyGroupId = *response.Response.ProxyGroupId
 	flowId = *response.Response.FlowId
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeFlow(ctx, flowId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("create cynosdb proxyEndPoint is processing"))
-		}
-	})
+	err = service.WaitForFlow(ctx, flowId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cynosdb proxyEndPoint fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	proxyEndPoint, err := service.DescribeCynosdbProxyEndPointById(ctx, clusterId, proxyGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if proxyEndPoint == nil {
@@ -499,8 +499,8 @@ func resourceTencentCloudCynosdbProxyEndPointCreate(d *schema.ResourceData, meta
 		modifyVipVportRequest.Vport = vPort
 		modifyVipVportRequest.OldIpReserveHours = helper.IntInt64(0)
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyVipVport(modifyVipVportRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyVipVportWithContext(ctx, modifyVipVportRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -513,57 +513,42 @@ func resourceTencentCloudCynosdbProxyEndPointCreate(d *schema.ResourceData, meta
 
 		if err != nil {
 			log.Printf("[CRITAL]%s create cynosdb proxyEndPoint rw split vip vport failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-			ok, err := service.DescribeFlow(ctx, flowId)
-			if err != nil {
-				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-
-			if ok {
-				return nil
-			} else {
-				return resource.RetryableError(fmt.Errorf("create cynosdb proxyEndPoint rw split vip vport is processing"))
-			}
-		})
+		err = service.WaitForFlow(ctx, flowId, d.Timeout(schema.TimeoutCreate))
 
 		if err != nil {
 			log.Printf("[CRITAL]%s create cynosdb proxyEndPoint rw split vip vport fail, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	d.SetId(strings.Join([]string{clusterId, proxyGroupId, instanceGroupId}, FILED_SP))
+	d.SetId(helper.IdFormat(clusterId, proxyGroupId, instanceGroupId))
 
-	return resourceTencentCloudCynosdbProxyEndPointRead(d, meta)
+	return resourceTencentCloudCynosdbProxyEndPointRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbProxyEndPointRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbProxyEndPointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_proxy_end_point.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	proxyGroupId := idSplit[1]
 
 	proxyEndPoint, err := service.DescribeCynosdbProxyEndPointById(ctx, clusterId, proxyGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if proxyEndPoint == nil {
@@ -675,13 +660,12 @@ func resourceTencentCloudCynosdbProxyEndPointRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudCynosdbProxyEndPointUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbProxyEndPointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_proxy_end_point.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId                     = getLogId(contextNil)
-		ctx                       = context.WithValue(context.TODO(), logIdKey, logId)
 		service                   = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		switchProxyVpcRequest     = cynosdb.NewSwitchProxyVpcRequest()
 		modifyProxyRwSplitRequest = cynosdb.NewModifyProxyRwSplitRequest()
@@ -689,18 +673,19 @@ func resourceTencentCloudCynosdbProxyEndPointUpdate(d *schema.ResourceData, meta
 		modifyVipVportRequest     = cynosdb.NewModifyVipVportRequest()
 		flowId                    int64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	immutableArgs := []string{"cluster_id", "security_group_ids"}
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	proxyGroupId := idSplit[1]
@@ -736,7 +721,7 @@ func resourceTencentCloudCynosdbProxyEndPointUpdate(d *schema.ResourceData, meta
 					modifyProxyRwSplitRequest.OpenConnectionPool = helper.String(v.(string))
 
 				} else {
-					return fmt.Errorf("open_connection_pool invalid parameter")
+					return diag.Errorf("open_connection_pool invalid parameter")
 				}
 			}
 		}
@@ -762,7 +747,7 @@ func resourceTencentCloudCynosdbProxyEndPointUpdate(d *schema.ResourceData, meta
 					modifyProxyRwSplitRequest.RwType = helper.String(v.(string))
 
 				} else {
-					return fmt.Errorf("rw_type invalid parameter")
+					return diag.Errorf("rw_type invalid parameter")
 				}
 			}
 		}
@@ -797,8 +782,8 @@ func resourceTencentCloudCynosdbProxyEndPointUpdate(d *schema.ResourceData, meta
 			}
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyProxyRwSplit(modifyProxyRwSplitRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyProxyRwSplitWithContext(ctx, modifyProxyRwSplitRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -811,29 +796,14 @@ func resourceTencentCloudCynosdbProxyEndPointUpdate(d *schema.ResourceData, meta
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update cynosdb proxyEndPoint rw split, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-			ok, err := service.DescribeFlow(ctx, flowId)
-			if err != nil {
-				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-
-			if ok {
-				return nil
-			} else {
-				return resource.RetryableError(fmt.Errorf("cynosdb proxyEndPoint rw split is processing"))
-			}
-		})
+		err = service.WaitForFlow(ctx, flowId, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update cynosdb proxyEndPoint rw split fail, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -850,8 +820,8 @@ func resourceTencentCloudCynosdbProxyEndPointUpdate(d *schema.ResourceData, meta
 			switchProxyVpcRequest.UniqSubnetId = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().SwitchProxyVpc(switchProxyVpcRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().SwitchProxyVpcWithContext(ctx, switchProxyVpcRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -864,29 +834,14 @@ func resourceTencentCloudCynosdbProxyEndPointUpdate(d *schema.ResourceData, meta
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update cynosdb proxy vpc failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-			ok, err := service.DescribeFlow(ctx, flowId)
-			if err != nil {
-				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-
-			if ok {
-				return nil
-			} else {
-				return resource.RetryableError(fmt.Errorf("cynosdb proxy vpc is processing"))
-			}
-		})
+		err = service.WaitForFlow(ctx, flowId, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update cynosdb proxy vpc fail, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -898,8 +853,8 @@ func resourceTencentCloudCynosdbProxyEndPointUpdate(d *schema.ResourceData, meta
 			modifyProxyDescRequest.Description = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyProxyDesc(modifyProxyDescRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyProxyDescWithContext(ctx, modifyProxyDescRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -911,7 +866,7 @@ func resourceTencentCloudCynosdbProxyEndPointUpdate(d *schema.ResourceData, meta
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update cynosdb proxyEndPoint rw split desc failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -928,8 +883,8 @@ func resourceTencentCloudCynosdbProxyEndPointUpdate(d *schema.ResourceData, meta
 			modifyVipVportRequest.Vport = helper.IntInt64(v.(int))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyVipVport(modifyVipVportRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyVipVportWithContext(ctx, modifyVipVportRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -942,77 +897,47 @@ func resourceTencentCloudCynosdbProxyEndPointUpdate(d *schema.ResourceData, meta
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update cynosdb proxyEndPoint rw split vip vport failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-			ok, err := service.DescribeFlow(ctx, flowId)
-			if err != nil {
-				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-
-			if ok {
-				return nil
-			} else {
-				return resource.RetryableError(fmt.Errorf("update cynosdb proxyEndPoint rw split vip vport is processing"))
-			}
-		})
+		err = service.WaitForFlow(ctx, flowId, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update cynosdb proxyEndPoint rw split vip vport fail, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCynosdbProxyEndPointRead(d, meta)
+	return resourceTencentCloudCynosdbProxyEndPointRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbProxyEndPointDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbProxyEndPointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_proxy_end_point.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	proxyGroupId := idSplit[1]
 
 	flowId, err := service.DeleteCynosdbProxyEndPointById(ctx, clusterId, proxyGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeFlow(ctx, flowId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("delete cynosdb proxyEndPoint is processing"))
-		}
-	})
+	err = service.WaitForFlow(ctx, flowId, d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s delete cynosdb proxyEndPoint fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
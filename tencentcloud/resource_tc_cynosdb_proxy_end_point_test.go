@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 // go test -i; go test -test.run TestAccTencentCloudCynosdbProxyEndPointResource_basic -v
@@ -33,6 +33,12 @@ func TestAccTencentCloudCynosdbProxyEndPointResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_cynosdb_proxy_end_point.proxy_end_point", "id"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_cynosdb_proxy_end_point.proxy_end_point",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"security_group_ids"},
+			},
 		},
 	})
 }
@@ -47,7 +53,7 @@ func testAccCheckCynosdbProxyEndPointDestroy(s *terraform.State) error {
 			continue
 		}
 
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		if len(idSplit) != 3 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
@@ -82,7 +88,7 @@ func testAccCheckCynosdbProxyEndPointExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("cynosdb proxy end point id is not set")
 		}
 
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		if len(idSplit) != 3 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
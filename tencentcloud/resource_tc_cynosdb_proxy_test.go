@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 // go test -i; go test -test.run TestAccTencentCloudCynosdbProxyResource_basic -v
@@ -53,6 +53,12 @@ func TestAccTencentCloudCynosdbProxyResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_cynosdb_proxy.proxy", "description"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_cynosdb_proxy.proxy",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"security_group_ids"},
+			},
 		},
 	})
 }
@@ -70,7 +76,7 @@ func testAccCheckCynosdbProxyExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("cynosdb proxy id is not set")
 		}
 
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		if len(idSplit) != 2 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
@@ -98,7 +104,7 @@ func testAccCheckCynosdbProxyDestroy(s *terraform.State) error {
 			continue
 		}
 
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		if len(idSplit) != 2 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
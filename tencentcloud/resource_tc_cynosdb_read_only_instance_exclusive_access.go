@@ -38,10 +38,11 @@ resource "tencentcloud_cynosdb_read_only_instance_exclusive_access" "read_only_i
 package tencentcloud
 
 import (
+	"context"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -51,9 +52,12 @@ import (
 
 func resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccess() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessCreate,
-		Read:   resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessRead,
-		Delete: resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessDelete,
+		CreateContext: resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessCreate,
+		ReadContext:   resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessRead,
+		DeleteContext: resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -103,7 +107,7 @@ func resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccess() *schema.Resour
 	}
 }
 
-func resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_read_only_instance_exclusive_access.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -148,8 +152,8 @@ func resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessCreate(d *schema.
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().OpenReadOnlyInstanceExclusiveAccess(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().OpenReadOnlyInstanceExclusiveAccessWithContext(ctx, request)
 		if e != nil {
 			if sdkErr, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 				// repeat to execute this cmd can be ignored
@@ -166,7 +170,7 @@ func resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessCreate(d *schema.
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate cynosdb readOnlyInstanceExclusiveAccess failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if response.Response == nil || response.Response.FlowId == nil {
@@ -175,26 +179,26 @@ func resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessCreate(d *schema.
 		flowId = response.Response.FlowId
 
 		service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
-		conf := BuildStateChangeConf([]string{}, []string{CYNOSDB_FLOW_STATUS_SUCCESSFUL}, 10*readRetryTimeout, time.Second, service.CynosdbClusterSlaveZoneStateRefreshFunc(*flowId, []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{CYNOSDB_FLOW_STATUS_SUCCESSFUL}, d.Timeout(schema.TimeoutCreate), time.Second, service.CynosdbClusterSlaveZoneStateRefreshFunc(ctx, *flowId, []string{}))
 
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
-	d.SetId(strings.Join([]string{clusterId, instanceId}, FILED_SP))
+	d.SetId(helper.IdFormat(clusterId, instanceId))
 
-	return resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessRead(d, meta)
+	return resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_read_only_instance_exclusive_access.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_read_only_instance_exclusive_access.delete")()
 	defer inconsistentCheck(d, meta)()
 
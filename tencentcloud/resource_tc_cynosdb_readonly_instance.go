@@ -39,7 +39,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -88,30 +90,34 @@ func resourceTencentCloudCynosdbReadonlyInstance() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbReadonlyInstanceCreate,
-		Read:   resourceTencentCloudCynosdbReadonlyInstanceRead,
-		Update: resourceTencentCloudCynosdbReadonlyInstanceUpdate,
-		Delete: resourceTencentCloudCynosdbReadonlyInstanceDelete,
+		CreateContext: resourceTencentCloudCynosdbReadonlyInstanceCreate,
+		ReadContext:   resourceTencentCloudCynosdbReadonlyInstanceRead,
+		UpdateContext: resourceTencentCloudCynosdbReadonlyInstanceUpdate,
+		DeleteContext: resourceTencentCloudCynosdbReadonlyInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: instanceInfo,
 	}
 }
 
-func resourceTencentCloudCynosdbReadonlyInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbReadonlyInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_readonly_instance.create")()
 
 	var (
 		logId = getLogId(contextNil)
-		ctx   = context.WithValue(context.TODO(), logIdKey, logId)
 
 		client         = meta.(*TencentCloudClient).apiV3Conn
 		cynosdbService = CynosdbService{client: client}
 
 		request = cynosdb.NewAddInstancesRequest()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	// instance info
 	request.ClusterId = helper.String(d.Get("cluster_id").(string))
@@ -130,9 +136,11 @@ func resourceTencentCloudCynosdbReadonlyInstanceCreate(d *schema.ResourceData, m
 
 	var response *cynosdb.AddInstancesResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().AddInstances(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().AddInstancesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
 			return retryError(err)
@@ -140,10 +148,10 @@ func resourceTencentCloudCynosdbReadonlyInstanceCreate(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if response != nil && response.Response != nil && len(response.Response.ResourceIds) != 1 {
-		return fmt.Errorf("cynosdb readonly instance id count isn't 1")
+		return diag.Errorf("cynosdb readonly instance id count isn't 1")
 	}
 	d.SetId(*response.Response.ResourceIds[0])
 	id := d.Id()
@@ -163,18 +171,18 @@ func resourceTencentCloudCynosdbReadonlyInstanceCreate(d *schema.ResourceData, m
 	duration := int64(d.Get("instance_maintain_duration").(int))
 	err = cynosdbService.ModifyMaintainPeriodConfig(ctx, id, startTime, duration, reqWeekdays)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCynosdbReadonlyInstanceRead(d, meta)
+	return resourceTencentCloudCynosdbReadonlyInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbReadonlyInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbReadonlyInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_readonly_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -182,7 +190,7 @@ func resourceTencentCloudCynosdbReadonlyInstanceRead(d *schema.ResourceData, met
 	cynosdbService := CynosdbService{client: client}
 	clusterId, instance, has, err := cynosdbService.DescribeInstanceById(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -204,7 +212,7 @@ func resourceTencentCloudCynosdbReadonlyInstanceRead(d *schema.ResourceData, met
 
 	maintain, err := cynosdbService.DescribeMaintainPeriod(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("instance_maintain_weekdays", maintain.Response.MaintainWeekDays)
 	_ = d.Set("instance_maintain_start_time", maintain.Response.MaintainStartTime)
@@ -213,16 +221,16 @@ func resourceTencentCloudCynosdbReadonlyInstanceRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudCynosdbReadonlyInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbReadonlyInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_readonly_instance.update")()
 
 	var (
 		logId          = getLogId(contextNil)
-		ctx            = context.WithValue(context.TODO(), logIdKey, logId)
 		instanceId     = d.Id()
 		client         = meta.(*TencentCloudClient).apiV3Conn
 		cynosdbService = CynosdbService{client: client}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	d.Partial(true)
 
@@ -231,10 +239,10 @@ func resourceTencentCloudCynosdbReadonlyInstanceUpdate(d *schema.ResourceData, m
 		memory := int64(d.Get("instance_memory_size").(int))
 		err := cynosdbService.UpgradeInstance(ctx, instanceId, cpu, memory)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		errUpdate := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		errUpdate := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			_, infos, has, e := cynosdbService.DescribeInstanceById(ctx, instanceId)
 			if e != nil {
 				return resource.NonRetryableError(e)
@@ -251,7 +259,7 @@ func resourceTencentCloudCynosdbReadonlyInstanceUpdate(d *schema.ResourceData, m
 			return nil
 		})
 		if errUpdate != nil {
-			return errUpdate
+			return diag.FromErr(errUpdate)
 		}
 
 	}
@@ -266,25 +274,25 @@ func resourceTencentCloudCynosdbReadonlyInstanceUpdate(d *schema.ResourceData, m
 		duration := int64(d.Get("instance_maintain_duration").(int))
 		err := cynosdbService.ModifyMaintainPeriodConfig(ctx, instanceId, startTime, duration, reqWeekdays)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	if d.HasChange("vpc_id") || d.HasChange("subnet_id") {
-		return fmt.Errorf("`vpc_id`, `subnet_id` do not support change now.")
+		return diag.Errorf("`vpc_id`, `subnet_id` do not support change now.")
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudCynosdbReadonlyInstanceRead(d, meta)
+	return resourceTencentCloudCynosdbReadonlyInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbReadonlyInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbReadonlyInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_readonly_instance.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 	clusterId := d.Get("cluster_id").(string)
@@ -296,12 +304,12 @@ func resourceTencentCloudCynosdbReadonlyInstanceDelete(d *schema.ResourceData, m
 
 	var err error
 	if err = cynosdbService.IsolateInstance(ctx, clusterId, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if forceDelete {
 		if err = cynosdbService.OfflineInstance(ctx, clusterId, instanceId); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
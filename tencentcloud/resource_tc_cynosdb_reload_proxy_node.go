@@ -24,21 +24,25 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCynosdbReloadProxyNode() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbReloadProxyNodeCreate,
-		Read:   resourceTencentCloudCynosdbReloadProxyNodeRead,
-		Delete: resourceTencentCloudCynosdbReloadProxyNodeDelete,
+		CreateContext: resourceTencentCloudCynosdbReloadProxyNodeCreate,
+		ReadContext:   resourceTencentCloudCynosdbReloadProxyNodeRead,
+		DeleteContext: resourceTencentCloudCynosdbReloadProxyNodeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(18 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -57,19 +61,19 @@ func resourceTencentCloudCynosdbReloadProxyNode() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbReloadProxyNodeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbReloadProxyNodeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_reload_proxy_node.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request   = cynosdb.NewReloadBalanceProxyNodeRequest()
 		response  = cynosdb.NewReloadBalanceProxyNodeResponse()
 		clusterId string
 		flowId    int64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("cluster_id"); ok {
 		request.ClusterId = helper.String(v.(string))
@@ -80,8 +84,8 @@ func resourceTencentCloudCynosdbReloadProxyNodeCreate(d *schema.ResourceData, me
 		request.ProxyGroupId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ReloadBalanceProxyNode(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ReloadBalanceProxyNodeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -99,45 +103,30 @@ func resourceTencentCloudCynosdbReloadProxyNodeCreate(d *schema.ResourceData, me
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate cynosdb proxyNode failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	flowId = *response.Response.FlowId
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeFlow(ctx, flowId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("cynosdb proxy is processing"))
-		}
-	})
+	err = service.WaitForFlow(ctx, flowId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cynosdb proxy fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(clusterId)
 
-	return resourceTencentCloudCynosdbReloadProxyNodeRead(d, meta)
+	return resourceTencentCloudCynosdbReloadProxyNodeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbReloadProxyNodeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbReloadProxyNodeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_reload_proxy_node.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCynosdbReloadProxyNodeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbReloadProxyNodeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_reload_proxy_node.delete")()
 	defer inconsistentCheck(d, meta)()
 
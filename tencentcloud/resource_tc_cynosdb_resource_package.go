@@ -28,9 +28,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -39,12 +40,16 @@ import (
 
 func resourceTencentCloudCynosdbResourcePackage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbResourcePackageCreate,
-		Read:   resourceTencentCloudCynosdbResourcePackageRead,
-		Update: resourceTencentCloudCynosdbResourcePackageUpdate,
-		Delete: resourceTencentCloudCynosdbResourcePackageDelete,
+		CreateContext: resourceTencentCloudCynosdbResourcePackageCreate,
+		ReadContext:   resourceTencentCloudCynosdbResourcePackageRead,
+		UpdateContext: resourceTencentCloudCynosdbResourcePackageUpdate,
+		DeleteContext: resourceTencentCloudCynosdbResourcePackageDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_type": {
@@ -98,7 +103,7 @@ func resourceTencentCloudCynosdbResourcePackage() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbResourcePackageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbResourcePackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_resource_package.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -141,8 +146,8 @@ func resourceTencentCloudCynosdbResourcePackageCreate(d *schema.ResourceData, me
 		request.PackageName = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().CreateResourcePackage(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().CreateResourcePackageWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -153,29 +158,29 @@ func resourceTencentCloudCynosdbResourcePackageCreate(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create cynosdb resourcePackage failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// packageId = *response.Response.PackageId
 	// d.SetId(helper.String(packageId))
 
-	return resourceTencentCloudCynosdbResourcePackageRead(d, meta)
+	return resourceTencentCloudCynosdbResourcePackageRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbResourcePackageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbResourcePackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_resource_package.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	packageId := d.Id()
 	resourcePackage, err := service.DescribeCynosdbResourcePackageById(ctx, packageId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if resourcePackage == nil {
@@ -219,7 +224,7 @@ func resourceTencentCloudCynosdbResourcePackageRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudCynosdbResourcePackageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbResourcePackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_resource_package.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -235,7 +240,7 @@ func resourceTencentCloudCynosdbResourcePackageUpdate(d *schema.ResourceData, me
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -245,8 +250,8 @@ func resourceTencentCloudCynosdbResourcePackageUpdate(d *schema.ResourceData, me
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyResourcePackageName(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyResourcePackageNameWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -256,24 +261,24 @@ func resourceTencentCloudCynosdbResourcePackageUpdate(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update cynosdb resourcePackage failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCynosdbResourcePackageRead(d, meta)
+	return resourceTencentCloudCynosdbResourcePackageRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbResourcePackageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbResourcePackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_resource_package.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	packageId := d.Id()
 
 	if err := service.DeleteCynosdbResourcePackageById(ctx, packageId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
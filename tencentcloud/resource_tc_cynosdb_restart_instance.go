@@ -13,23 +13,26 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCynosdbRestartInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbRestartInstanceCreate,
-		Read:   resourceTencentCloudCynosdbRestartInstanceRead,
-		Delete: resourceTencentCloudCynosdbRestartInstanceDelete,
+		CreateContext: resourceTencentCloudCynosdbRestartInstanceCreate,
+		ReadContext:   resourceTencentCloudCynosdbRestartInstanceRead,
+		DeleteContext: resourceTencentCloudCynosdbRestartInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(18 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -48,12 +51,12 @@ func resourceTencentCloudCynosdbRestartInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbRestartInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbRestartInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_restart_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request    = cynosdb.NewRestartInstanceRequest()
@@ -65,8 +68,8 @@ func resourceTencentCloudCynosdbRestartInstanceCreate(d *schema.ResourceData, me
 		request.InstanceId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().RestartInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().RestartInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -77,50 +80,36 @@ func resourceTencentCloudCynosdbRestartInstanceCreate(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate cynosdb restartInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
 	flowId := *response.Response.FlowId
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeFlow(ctx, flowId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("create cynosdb clusterPasswordComplexity is processing"))
-		}
-	})
+	err = service.WaitForFlow(ctx, flowId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create cynosdb clusterPasswordComplexity fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCynosdbRestartInstanceRead(d, meta)
+	return resourceTencentCloudCynosdbRestartInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbRestartInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbRestartInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_restart_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	_, instance, has, err := service.DescribeInstanceById(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		log.Printf("[WARN]%s resource `DescribeInstanceById` [%s] not found, please check if it has been deleted.\n", logId, d.Id())
@@ -135,7 +124,7 @@ func resourceTencentCloudCynosdbRestartInstanceRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudCynosdbRestartInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbRestartInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_restart_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
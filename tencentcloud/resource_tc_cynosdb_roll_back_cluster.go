@@ -30,23 +30,26 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCynosdbRollBackCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbRollBackClusterCreate,
-		Read:   resourceTencentCloudCynosdbRollBackClusterRead,
-		Delete: resourceTencentCloudCynosdbRollBackClusterDelete,
+		CreateContext: resourceTencentCloudCynosdbRollBackClusterCreate,
+		ReadContext:   resourceTencentCloudCynosdbRollBackClusterRead,
+		DeleteContext: resourceTencentCloudCynosdbRollBackClusterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(18 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -150,12 +153,12 @@ func resourceTencentCloudCynosdbRollBackCluster() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbRollBackClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbRollBackClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_roll_back_cluster.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request   = cynosdb.NewRollBackClusterRequest()
@@ -225,8 +228,8 @@ func resourceTencentCloudCynosdbRollBackClusterCreate(d *schema.ResourceData, me
 		request.RollbackMode = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().RollBackCluster(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().RollBackClusterWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -237,45 +240,31 @@ func resourceTencentCloudCynosdbRollBackClusterCreate(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate cynosdb rollBackCluster failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(clusterId)
 
 	flowId := *response.Response.FlowId
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeFlow(ctx, flowId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("operate cynosdb rollBackCluster is processing"))
-		}
-	})
+	err = service.WaitForFlow(ctx, flowId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s Open cynosdb wan fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCynosdbRollBackClusterRead(d, meta)
+	return resourceTencentCloudCynosdbRollBackClusterRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbRollBackClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbRollBackClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_roll_back_cluster.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudCynosdbRollBackClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbRollBackClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_roll_back_cluster.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -23,10 +23,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
@@ -35,12 +36,17 @@ import (
 
 func resourceTencentCloudCynosdbSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbSecurityGroupCreate,
-		Update: resourceTencentCloudCynosdbSecurityGroupUpdate,
-		Read:   resourceTencentCloudCynosdbSecurityGroupRead,
-		Delete: resourceTencentCloudCynosdbSecurityGroupDelete,
+		CreateContext: resourceTencentCloudCynosdbSecurityGroupCreate,
+		UpdateContext: resourceTencentCloudCynosdbSecurityGroupUpdate,
+		ReadContext:   resourceTencentCloudCynosdbSecurityGroupRead,
+		DeleteContext: resourceTencentCloudCynosdbSecurityGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -67,12 +73,12 @@ func resourceTencentCloudCynosdbSecurityGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_security_group.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := cynosdb.NewModifyDBInstanceSecurityGroupsRequest()
 	var clusterId string
@@ -95,7 +101,7 @@ func resourceTencentCloudCynosdbSecurityGroupCreate(d *schema.ResourceData, meta
 
 	_, clusterIfo, has, err := service.DescribeClusterById(ctx, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if has {
 		request.Zone = clusterIfo.Zone
@@ -106,7 +112,7 @@ func resourceTencentCloudCynosdbSecurityGroupCreate(d *schema.ResourceData, meta
 	}
 	grpsResponse, err := service.DescribeClusterInstanceGrps(ctx, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceGrpInfoList := grpsResponse.Response.InstanceGrpInfoList
 	for _, instanceGrpInfo := range instanceGrpInfoList {
@@ -116,8 +122,8 @@ func resourceTencentCloudCynosdbSecurityGroupCreate(d *schema.ResourceData, meta
 			continue
 		}
 		request.InstanceId = instanceGrpInfo.InstanceGrpId
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyDBInstanceSecurityGroups(request)
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyDBInstanceSecurityGroupsWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -127,38 +133,38 @@ func resourceTencentCloudCynosdbSecurityGroupCreate(d *schema.ResourceData, meta
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s create cynosdb securityGroup failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	d.SetId(clusterId + FILED_SP + instanceGroupType)
-	return resourceTencentCloudCynosdbSecurityGroupRead(d, meta)
+	d.SetId(helper.IdFormat(clusterId, instanceGroupType))
+	return resourceTencentCloudCynosdbSecurityGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_security_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	instanceGroupType := idSplit[1]
 
 	grpsResponse, err := service.DescribeClusterInstanceGrps(ctx, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceGrpInfoList := grpsResponse.Response.InstanceGrpInfoList
 	if len(instanceGrpInfoList) == 0 {
-		return fmt.Errorf("Not fount instanceGrpInfoList")
+		return diag.Errorf("Not fount instanceGrpInfoList")
 	}
 
 	var securityGroups []*cynosdb.SecurityGroup
@@ -170,7 +176,7 @@ func resourceTencentCloudCynosdbSecurityGroupRead(d *schema.ResourceData, meta i
 		}
 		securityGroups, err = service.DescribeCynosdbSecurityGroups(ctx, *instanceGrpInfo.InstanceGrpId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -184,15 +190,15 @@ func resourceTencentCloudCynosdbSecurityGroupRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudCynosdbSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_security_group.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	instanceGroupType := idSplit[1]
@@ -201,14 +207,14 @@ func resourceTencentCloudCynosdbSecurityGroupUpdate(d *schema.ResourceData, meta
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	grpsResponse, err := service.DescribeClusterInstanceGrps(ctx, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Partial(true)
 	if d.HasChange("security_group_ids") {
 		securityGroupIdsSet := d.Get("security_group_ids").(*schema.Set).List()
 		if len(securityGroupIdsSet) == 0 {
-			return fmt.Errorf("`security_group_ids` can not empty")
+			return diag.Errorf("`security_group_ids` can not empty")
 		}
 		for i := range securityGroupIdsSet {
 			securityGroupId := securityGroupIdsSet[i].(string)
@@ -217,7 +223,7 @@ func resourceTencentCloudCynosdbSecurityGroupUpdate(d *schema.ResourceData, meta
 	}
 	_, clusterIfo, has, err := service.DescribeClusterById(ctx, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if has {
 		request.Zone = clusterIfo.Zone
@@ -230,8 +236,8 @@ func resourceTencentCloudCynosdbSecurityGroupUpdate(d *schema.ResourceData, meta
 			continue
 		}
 		request.InstanceId = instanceGrpInfo.InstanceGrpId
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyDBInstanceSecurityGroups(request)
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().ModifyDBInstanceSecurityGroupsWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -241,23 +247,23 @@ func resourceTencentCloudCynosdbSecurityGroupUpdate(d *schema.ResourceData, meta
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s create cynosdb securityGroup failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.Partial(false)
-	return resourceTencentCloudCynosdbSecurityGroupRead(d, meta)
+	return resourceTencentCloudCynosdbSecurityGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_security_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	instanceGroupType := idSplit[1]
@@ -266,14 +272,14 @@ func resourceTencentCloudCynosdbSecurityGroupDelete(d *schema.ResourceData, meta
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	_, clusterInfo, has, err := service.DescribeClusterById(ctx, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if has {
 		request.Zone = clusterInfo.Zone
 	}
 	grpsResponse, err := service.DescribeClusterInstanceGrps(ctx, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceGrpInfoList := grpsResponse.Response.InstanceGrpInfoList
@@ -285,7 +291,7 @@ func resourceTencentCloudCynosdbSecurityGroupDelete(d *schema.ResourceData, meta
 		securityGroupIds := make([]*string, 0)
 		securityGroups, err := service.DescribeCynosdbSecurityGroups(ctx, *instanceGrpInfo.InstanceGrpId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		for _, securityGroup := range securityGroups {
@@ -294,8 +300,8 @@ func resourceTencentCloudCynosdbSecurityGroupDelete(d *schema.ResourceData, meta
 
 		request.InstanceIds = []*string{instanceGrpInfo.InstanceGrpId}
 		request.SecurityGroupIds = securityGroupIds
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().DisassociateSecurityGroups(request)
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().DisassociateSecurityGroupsWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -305,7 +311,7 @@ func resourceTencentCloudCynosdbSecurityGroupDelete(d *schema.ResourceData, meta
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s create cynosdb securityGroup failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -14,23 +14,25 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCynosdbUpgradeProxyVersion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbUpgradeProxyVersionCreate,
-		Read:   resourceTencentCloudCynosdbUpgradeProxyVersionRead,
-		Update: resourceTencentCloudCynosdbUpgradeProxyVersionUpdate,
-		Delete: resourceTencentCloudCynosdbUpgradeProxyVersionDelete,
+		CreateContext: resourceTencentCloudCynosdbUpgradeProxyVersionCreate,
+		ReadContext:   resourceTencentCloudCynosdbUpgradeProxyVersionRead,
+		UpdateContext: resourceTencentCloudCynosdbUpgradeProxyVersionUpdate,
+		DeleteContext: resourceTencentCloudCynosdbUpgradeProxyVersionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(18 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -48,17 +50,17 @@ func resourceTencentCloudCynosdbUpgradeProxyVersion() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbUpgradeProxyVersionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbUpgradeProxyVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_upgrade_proxy_version.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId           = getLogId(contextNil)
-		ctx             = context.WithValue(context.TODO(), logIdKey, logId)
 		service         = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		srcProxyVersion string
 		clusterId       string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("cluster_id"); ok {
 		clusterId = v.(string)
@@ -66,7 +68,7 @@ func resourceTencentCloudCynosdbUpgradeProxyVersionCreate(d *schema.ResourceData
 
 	upgradeProxyGroup, err := service.DescribeCynosdbUpgradeProxyVersionById(ctx, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if upgradeProxyGroup == nil {
@@ -77,30 +79,30 @@ func resourceTencentCloudCynosdbUpgradeProxyVersionCreate(d *schema.ResourceData
 
 	srcProxyVersion = *upgradeProxyGroup.CurrentProxyVersion
 
-	d.SetId(strings.Join([]string{clusterId, srcProxyVersion}, FILED_SP))
+	d.SetId(helper.IdFormat(clusterId, srcProxyVersion))
 
-	return resourceTencentCloudCynosdbUpgradeProxyVersionUpdate(d, meta)
+	return resourceTencentCloudCynosdbUpgradeProxyVersionUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbUpgradeProxyVersionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbUpgradeProxyVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_upgrade_proxy_version.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 
 	upgradeProxyGroup, err := service.DescribeCynosdbUpgradeProxyVersionById(ctx, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if upgradeProxyGroup == nil {
@@ -120,22 +122,22 @@ func resourceTencentCloudCynosdbUpgradeProxyVersionRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudCynosdbUpgradeProxyVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbUpgradeProxyVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_upgrade_proxy_version.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request = cynosdb.NewUpgradeProxyVersionRequest()
 		flowId  int64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if d.HasChange("dst_proxy_version") {
-		idSplit := strings.Split(d.Id(), FILED_SP)
+		idSplit := helper.IdParse(d.Id())
 		if len(idSplit) != 2 {
-			return fmt.Errorf("id is broken,%s", idSplit)
+			return diag.Errorf("id is broken,%s", idSplit)
 		}
 		clusterId := idSplit[0]
 		srcProxyVersion := idSplit[1]
@@ -148,8 +150,8 @@ func resourceTencentCloudCynosdbUpgradeProxyVersionUpdate(d *schema.ResourceData
 			request.DstProxyVersion = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().UpgradeProxyVersion(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().UpgradeProxyVersionWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -162,36 +164,21 @@ func resourceTencentCloudCynosdbUpgradeProxyVersionUpdate(d *schema.ResourceData
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update cynosdb upgradeProxyVersion failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-			ok, err := service.DescribeFlow(ctx, flowId)
-			if err != nil {
-				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-
-			if ok {
-				return nil
-			} else {
-				return resource.RetryableError(fmt.Errorf("update cynosdb upgradeProxyVersion is processing"))
-			}
-		})
+		err = service.WaitForFlow(ctx, flowId, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update cynosdb upgradeProxyVersion fail, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudCynosdbUpgradeProxyVersionRead(d, meta)
+	return resourceTencentCloudCynosdbUpgradeProxyVersionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbUpgradeProxyVersionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbUpgradeProxyVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_upgrade_proxy_version.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -22,25 +22,28 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudCynosdbWan() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbWanCreate,
-		Read:   resourceTencentCloudCynosdbWanRead,
-		Update: resourceTencentCloudCynosdbWanUpdate,
-		Delete: resourceTencentCloudCynosdbWanDelete,
+		CreateContext: resourceTencentCloudCynosdbWanCreate,
+		ReadContext:   resourceTencentCloudCynosdbWanRead,
+		UpdateContext: resourceTencentCloudCynosdbWanUpdate,
+		DeleteContext: resourceTencentCloudCynosdbWanDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(18 * time.Minute),
+			Delete: schema.DefaultTimeout(18 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -82,12 +85,12 @@ func resourceTencentCloudCynosdbWan() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudCynosdbWanCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbWanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_wan.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request       = cynosdb.NewOpenWanRequest()
@@ -105,8 +108,8 @@ func resourceTencentCloudCynosdbWanCreate(d *schema.ResourceData, meta interface
 		request.InstanceGrpId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().OpenWan(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().OpenWanWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -117,56 +120,42 @@ func resourceTencentCloudCynosdbWanCreate(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s Open cynosdb wan failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(clusterId + FILED_SP + instanceGrpId)
+	d.SetId(helper.IdFormat(clusterId, instanceGrpId))
 
 	flowId := *response.Response.FlowId
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeFlow(ctx, flowId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("Open cynosdb wan is processing"))
-		}
-	})
+	err = service.WaitForFlow(ctx, flowId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s Open cynosdb wan fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudCynosdbWanRead(d, meta)
+	return resourceTencentCloudCynosdbWanRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbWanRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbWanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_wan.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	instanceGrpId := idSplit[1]
 
 	wan, err := service.DescribeClusterInstanceGrps(ctx, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if wan == nil {
@@ -200,7 +189,7 @@ func resourceTencentCloudCynosdbWanRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudCynosdbWanUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbWanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_wan.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -208,51 +197,37 @@ func resourceTencentCloudCynosdbWanUpdate(d *schema.ResourceData, meta interface
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
-	return resourceTencentCloudCynosdbWanRead(d, meta)
+	return resourceTencentCloudCynosdbWanRead(ctx, d, meta)
 }
 
-func resourceTencentCloudCynosdbWanDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudCynosdbWanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_cynosdb_wan.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := CynosdbService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	// clusterId := idSplit[0]
 	instanceGrpId := idSplit[1]
 
 	flowId, err := service.DeleteCynosdbWanById(ctx, instanceGrpId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeFlow(ctx, flowId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("Close cynosdb wan is processing"))
-		}
-	})
+	err = service.WaitForFlow(ctx, flowId, d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s Close cynosdb wan fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -63,6 +63,14 @@ resource "tencentcloud_dayu_cc_http_policy" "test_bgp" {
   }
 }
 ```
+
+Import
+
+Dayu CC http policy can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_dayu_cc_http_policy.test_bgpip bgpip#bgpip-00000294#policy-c5zk3q2q
+```
 */
 package tencentcloud
 
@@ -70,8 +78,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -82,10 +91,18 @@ import (
 
 func resourceTencentCloudDayuCCHttpPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuCCHttpPolicyCreate,
-		Read:   resourceTencentCloudDayuCCHttpPolicyRead,
-		Update: resourceTencentCloudDayuCCHttpPolicyUpdate,
-		Delete: resourceTencentCloudDayuCCHttpPolicyDelete,
+		CreateContext: resourceTencentCloudDayuCCHttpPolicyCreate,
+		ReadContext:   resourceTencentCloudDayuCCHttpPolicyRead,
+		UpdateContext: resourceTencentCloudDayuCCHttpPolicyUpdate,
+		DeleteContext: resourceTencentCloudDayuCCHttpPolicyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -187,11 +204,11 @@ func resourceTencentCloudDayuCCHttpPolicy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDayuCCHttpPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuCCHttpPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_cc_http_policy.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Get("resource_id").(string)
 	resourceType := d.Get("resource_type").(string)
@@ -207,7 +224,7 @@ func resourceTencentCloudDayuCCHttpPolicyCreate(d *schema.ResourceData, meta int
 
 	if smode == DAYU_CC_POLICY_SMODE_SPEED_LIMIT {
 		if frequency == 0 {
-			return fmt.Errorf("`frequencys` should be set when `smode` is `speedlimit`.")
+			return diag.Errorf("`frequencys` should be set when `smode` is `speedlimit`.")
 		}
 		ccPolicy.Frequency = helper.IntUint64(frequency)
 	} else {
@@ -245,7 +262,7 @@ func resourceTencentCloudDayuCCHttpPolicyCreate(d *schema.ResourceData, meta int
 	dayuService := DayuService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	policyId := ""
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := dayuService.CreateCCSelfdefinePolicy(ctx, resourceType, resourceId, ccPolicy)
 		if e != nil {
 			return retryError(e)
@@ -255,24 +272,24 @@ func resourceTencentCloudDayuCCHttpPolicyCreate(d *schema.ResourceData, meta int
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(resourceType + FILED_SP + resourceId + FILED_SP + policyId)
+	d.SetId(helper.IdFormat(resourceType, resourceId, policyId))
 
-	return resourceTencentCloudDayuCCHttpPolicyRead(d, meta)
+	return resourceTencentCloudDayuCCHttpPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuCCHttpPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuCCHttpPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_cc_http_policy.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 3 {
-		return fmt.Errorf("broken ID of dayu CC policy")
+		return diag.Errorf("broken ID of dayu CC policy")
 	}
 	resourceType := items[0]
 	resourceId := items[1]
@@ -282,7 +299,7 @@ func resourceTencentCloudDayuCCHttpPolicyRead(d *schema.ResourceData, meta inter
 
 	policy, has, err := dayuService.DescribeCCSelfdefinePolicy(ctx, resourceType, resourceId, policyId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			policy, has, err = dayuService.DescribeCCSelfdefinePolicy(ctx, resourceType, resourceId, policyId)
 			if err != nil {
 				return retryError(err)
@@ -291,7 +308,7 @@ func resourceTencentCloudDayuCCHttpPolicyRead(d *schema.ResourceData, meta inter
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -318,15 +335,15 @@ func resourceTencentCloudDayuCCHttpPolicyRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudDayuCCHttpPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuCCHttpPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_cc_http_policy.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 3 {
-		return fmt.Errorf("broken ID of CC policy")
+		return diag.Errorf("broken ID of CC policy")
 	}
 	resourceType := items[0]
 	resourceId := items[1]
@@ -343,7 +360,7 @@ func resourceTencentCloudDayuCCHttpPolicyUpdate(d *schema.ResourceData, meta int
 	}
 	if smode == DAYU_CC_POLICY_SMODE_SPEED_LIMIT {
 		if frequency == 0 {
-			return fmt.Errorf("`speedlimit` should be set when `smode` is `speedlimit`.")
+			return diag.Errorf("`speedlimit` should be set when `smode` is `speedlimit`.")
 		}
 		ccPolicy.Frequency = helper.IntUint64(frequency)
 	} else {
@@ -379,7 +396,7 @@ func resourceTencentCloudDayuCCHttpPolicyUpdate(d *schema.ResourceData, meta int
 	}
 	dayuService := DayuService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		e := dayuService.ModifyCCSelfdefinePolicy(ctx, resourceType, resourceId, policyId, ccPolicy)
 		if e != nil {
 			return retryError(e)
@@ -388,21 +405,21 @@ func resourceTencentCloudDayuCCHttpPolicyUpdate(d *schema.ResourceData, meta int
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudDayuCCHttpPolicyRead(d, meta)
+	return resourceTencentCloudDayuCCHttpPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuCCHttpPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuCCHttpPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_cc_http_policy.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 3 {
-		return fmt.Errorf("broken ID of CC policy")
+		return diag.Errorf("broken ID of CC policy")
 	}
 	resourceType := items[0]
 	resourceId := items[1]
@@ -410,7 +427,7 @@ func resourceTencentCloudDayuCCHttpPolicyDelete(d *schema.ResourceData, meta int
 
 	dayuService := DayuService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := dayuService.DeleteCCSelfdefinePolicy(ctx, resourceType, resourceId, policyId)
 		if e != nil {
 			return retryError(e)
@@ -419,12 +436,12 @@ func resourceTencentCloudDayuCCHttpPolicyDelete(d *schema.ResourceData, meta int
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, has, err := dayuService.DescribeCCSelfdefinePolicy(ctx, resourceType, resourceId, policyId)
 	if err != nil || has {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			_, has, err = dayuService.DescribeCCSelfdefinePolicy(ctx, resourceType, resourceId, policyId)
 			if err != nil {
 				return retryError(err)
@@ -439,11 +456,11 @@ func resourceTencentCloudDayuCCHttpPolicyDelete(d *schema.ResourceData, meta int
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		return nil
 	} else {
-		return errors.New("delete CC policy fail, CC policy still exist from sdk DescribeCCSelfDefinePolicy")
+		return diag.FromErr(errors.New("delete CC policy fail, CC policy still exist from sdk DescribeCCSelfDefinePolicy"))
 	}
 }
@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 var testDayuCCHttpPolicyResourceName = "tencentcloud_dayu_cc_http_policy"
@@ -44,6 +44,12 @@ func TestAccTencentCloudDayuCCHttpPolicyResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testDayuCCHttpPolicyResourceKey, "frequency", "100"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_dayu_cc_http_policy.test_policy",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resource_id", "resource_type"},
+			},
 		},
 	})
 }
@@ -127,7 +133,7 @@ func testAccCheckDayuCCHttpPolicyDestroy(s *terraform.State) error {
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 3 {
 			return fmt.Errorf("broken ID of DDos policy case")
 		}
@@ -162,7 +168,7 @@ func testAccCheckDayuCCHttpPolicyExists(n string) resource.TestCheckFunc {
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 3 {
 			return fmt.Errorf("broken ID of DDos policy case")
 		}
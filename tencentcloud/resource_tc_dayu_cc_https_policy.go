@@ -22,6 +22,14 @@ resource "tencentcloud_dayu_cc_https_policy" "test_policy" {
   }
 }
 
+```
+
+Import
+
+Dayu CC https policy can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_dayu_cc_https_policy.test_policy bgpip#bgpip-00000294#policy-c5zk3q2q
 ```
 */
 package tencentcloud
@@ -30,8 +38,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dayu "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dayu/v20180709"
@@ -40,10 +49,18 @@ import (
 
 func resourceTencentCloudDayuCCHttpsPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuCCHttpsPolicyCreate,
-		Read:   resourceTencentCloudDayuCCHttpsPolicyRead,
-		Update: resourceTencentCloudDayuCCHttpsPolicyUpdate,
-		Delete: resourceTencentCloudDayuCCHttpsPolicyDelete,
+		CreateContext: resourceTencentCloudDayuCCHttpsPolicyCreate,
+		ReadContext:   resourceTencentCloudDayuCCHttpsPolicyRead,
+		UpdateContext: resourceTencentCloudDayuCCHttpsPolicyUpdate,
+		DeleteContext: resourceTencentCloudDayuCCHttpsPolicyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -139,11 +156,11 @@ func resourceTencentCloudDayuCCHttpsPolicy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDayuCCHttpsPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuCCHttpsPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_cc_https_policy.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Get("resource_id").(string)
 	resourceType := d.Get("resource_type").(string)
@@ -184,7 +201,7 @@ func resourceTencentCloudDayuCCHttpsPolicyCreate(d *schema.ResourceData, meta in
 
 	policyId := ""
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := dayuService.CreateCCSelfdefinePolicy(ctx, resourceType, resourceId, ccPolicy)
 		if e != nil {
 			return retryError(e)
@@ -194,24 +211,24 @@ func resourceTencentCloudDayuCCHttpsPolicyCreate(d *schema.ResourceData, meta in
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(resourceType + FILED_SP + resourceId + FILED_SP + policyId)
+	d.SetId(helper.IdFormat(resourceType, resourceId, policyId))
 
-	return resourceTencentCloudDayuCCHttpPolicyRead(d, meta)
+	return resourceTencentCloudDayuCCHttpPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuCCHttpsPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuCCHttpsPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_cc_https_policy.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 3 {
-		return fmt.Errorf("broken ID of dayu CC policy")
+		return diag.Errorf("broken ID of dayu CC policy")
 	}
 	resourceType := items[0]
 	resourceId := items[1]
@@ -221,7 +238,7 @@ func resourceTencentCloudDayuCCHttpsPolicyRead(d *schema.ResourceData, meta inte
 
 	policy, has, err := dayuService.DescribeCCSelfdefinePolicy(ctx, resourceType, resourceId, policyId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			policy, has, err = dayuService.DescribeCCSelfdefinePolicy(ctx, resourceType, resourceId, policyId)
 			if err != nil {
 				return retryError(err)
@@ -230,7 +247,7 @@ func resourceTencentCloudDayuCCHttpsPolicyRead(d *schema.ResourceData, meta inte
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -249,15 +266,15 @@ func resourceTencentCloudDayuCCHttpsPolicyRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudDayuCCHttpsPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuCCHttpsPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_cc_https_policy.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 3 {
-		return fmt.Errorf("broken ID of CC policy")
+		return diag.Errorf("broken ID of CC policy")
 	}
 	resourceType := items[0]
 	resourceId := items[1]
@@ -300,7 +317,7 @@ func resourceTencentCloudDayuCCHttpsPolicyUpdate(d *schema.ResourceData, meta in
 	}
 	dayuService := DayuService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		e := dayuService.ModifyCCSelfdefinePolicy(ctx, resourceType, resourceId, policyId, ccPolicy)
 		if e != nil {
 			return retryError(e, InternalError)
@@ -309,21 +326,21 @@ func resourceTencentCloudDayuCCHttpsPolicyUpdate(d *schema.ResourceData, meta in
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudDayuCCHttpPolicyRead(d, meta)
+	return resourceTencentCloudDayuCCHttpPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuCCHttpsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuCCHttpsPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_cc_https_policy.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 3 {
-		return fmt.Errorf("broken ID of CC policy")
+		return diag.Errorf("broken ID of CC policy")
 	}
 	resourceType := items[0]
 	resourceId := items[1]
@@ -331,7 +348,7 @@ func resourceTencentCloudDayuCCHttpsPolicyDelete(d *schema.ResourceData, meta in
 
 	dayuService := DayuService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := dayuService.DeleteCCSelfdefinePolicy(ctx, resourceType, resourceId, policyId)
 		if e != nil {
 			return retryError(e)
@@ -340,12 +357,12 @@ func resourceTencentCloudDayuCCHttpsPolicyDelete(d *schema.ResourceData, meta in
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, has, err := dayuService.DescribeCCSelfdefinePolicy(ctx, resourceType, resourceId, policyId)
 	if err != nil || has {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			_, has, err = dayuService.DescribeCCSelfdefinePolicy(ctx, resourceType, resourceId, policyId)
 			if err != nil {
 				return retryError(err)
@@ -360,11 +377,11 @@ func resourceTencentCloudDayuCCHttpsPolicyDelete(d *schema.ResourceData, meta in
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		return nil
 	} else {
-		return errors.New("delete CC policy fail, CC policy still exist from sdk DescribeCCSelfDefinePolicy")
+		return diag.FromErr(errors.New("delete CC policy fail, CC policy still exist from sdk DescribeCCSelfDefinePolicy"))
 	}
 }
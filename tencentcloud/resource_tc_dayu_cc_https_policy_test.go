@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 var testDayuCCHttpsPolicyResourceName = "tencentcloud_dayu_cc_https_policy"
@@ -47,6 +47,12 @@ func TestAccTencentCloudDayuCCHttpsPolicyResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testDayuCCHttpsPolicyResourceKey, "rule_list.#", "1"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_dayu_cc_https_policy.test_policy",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resource_id", "resource_type"},
+			},
 		},
 	})
 }
@@ -59,7 +65,7 @@ func testAccCheckDayuCCHttpsPolicyDestroy(s *terraform.State) error {
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 3 {
 			return fmt.Errorf("broken ID of DDos policy case")
 		}
@@ -94,7 +100,7 @@ func testAccCheckDayuCCHttpsPolicyExists(n string) resource.TestCheckFunc {
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 3 {
 			return fmt.Errorf("broken ID of DDos policy case")
 		}
@@ -51,6 +51,14 @@ resource "tencentcloud_dayu_cc_policy_v2" "demo" {
   }
 }
 ```
+
+Import
+
+Dayu CC policy v2 can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_dayu_cc_policy_v2.demo bgpip-00000294#bgpip
+```
 */
 package tencentcloud
 
@@ -59,6 +67,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	antiddos "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/antiddos/v20200309"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -66,10 +75,13 @@ import (
 
 func resourceTencentCloudDayuCCPolicyV2() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuCCPolicyV2Create,
-		Read:   resourceTencentCloudDayuCCPolicyV2Read,
-		Update: resourceTencentCloudDayuCCPolicyV2Update,
-		Delete: resourceTencentCloudDayuCCPolicyV2Delete,
+		CreateContext: resourceTencentCloudDayuCCPolicyV2Create,
+		ReadContext:   resourceTencentCloudDayuCCPolicyV2Read,
+		UpdateContext: resourceTencentCloudDayuCCPolicyV2Update,
+		DeleteContext: resourceTencentCloudDayuCCPolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -343,21 +355,21 @@ func resourceTencentCloudDayuCCPolicyV2() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDayuCCPolicyV2Create(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuCCPolicyV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_cc_policy_v2.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	antiddosService := AntiddosService{client: meta.(*TencentCloudClient).apiV3Conn}
 	resourceId := d.Get("resource_id").(string)
 	business := d.Get("business").(string)
 	protectThresholdConfig, err := antiddosService.DescribeListProtectThresholdConfig(ctx, resourceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceDetailList := protectThresholdConfig.InstanceDetailList
 	if len(instanceDetailList) != 1 {
-		return fmt.Errorf("Can not fetch Eip.")
+		return diag.Errorf("Can not fetch Eip.")
 	}
 	ip := *instanceDetailList[0].EipList[0]
 	if v, ok := d.GetOk("thresholds"); ok {
@@ -368,7 +380,7 @@ func resourceTencentCloudDayuCCPolicyV2Create(d *schema.ResourceData, meta inter
 			threshold := thresholdMap["threshold"].(int)
 			err := antiddosService.ModifyCCThresholdPolicy(ctx, resourceId, "http", ip, domain, threshold)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -383,7 +395,7 @@ func resourceTencentCloudDayuCCPolicyV2Create(d *schema.ResourceData, meta inter
 			blackWhiteType := ccBlackWhiteIpMap["type"].(string)
 			err := antiddosService.CreateCcBlackWhiteIpList(ctx, resourceId, protocol, ip, domain, blackWhiteType, []string{blackWhiteIp})
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -402,7 +414,7 @@ func resourceTencentCloudDayuCCPolicyV2Create(d *schema.ResourceData, meta inter
 			}
 			err := antiddosService.CreateCcGeoIPBlockConfig(ctx, resourceId, protocol, ip, domain, ccGeoIPBlockConfig)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -432,7 +444,7 @@ func resourceTencentCloudDayuCCPolicyV2Create(d *schema.ResourceData, meta inter
 			}
 			err := antiddosService.CreateCCPrecisionPolicy(ctx, resourceId, protocol, ip, domain, policyAction, ccPrecisionPlyRecords)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -446,7 +458,7 @@ func resourceTencentCloudDayuCCPolicyV2Create(d *schema.ResourceData, meta inter
 			level := ccPrecisionReqLimitMap["level"].(string)
 			err := antiddosService.ModifyCCLevelPolicy(ctx, resourceId, ip, domain, protocol, level)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			policys := ccPrecisionReqLimitMap["policys"].([]interface{})
 			for _, policy := range policys {
@@ -475,23 +487,23 @@ func resourceTencentCloudDayuCCPolicyV2Create(d *schema.ResourceData, meta inter
 				}
 				err := antiddosService.CreateCCReqLimitPolicy(ctx, resourceId, protocol, ip, domain, ccPolicyRecord)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 
 		}
 	}
-	d.SetId(resourceId + FILED_SP + business)
-	return resourceTencentCloudDayuCCPolicyV2Read(d, meta)
+	d.SetId(helper.IdFormat(resourceId, business))
+	return resourceTencentCloudDayuCCPolicyV2Read(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuCCPolicyV2Read(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuCCPolicyV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_cc_policy_v2.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	items := strings.Split(d.Id(), FILED_SP)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	items := helper.IdParse(d.Id())
 	if len(items) < 2 {
-		return fmt.Errorf("broken ID of DDoS policy")
+		return diag.Errorf("broken ID of DDoS policy")
 	}
 	instanceId := items[0]
 	business := items[1]
@@ -499,7 +511,7 @@ func resourceTencentCloudDayuCCPolicyV2Read(d *schema.ResourceData, meta interfa
 
 	thresholdList, err := antiddosService.DescribeCCThresholdList(ctx, business, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	resultThresholds := make([]map[string]interface{}, 0)
 	for _, threshold := range thresholdList {
@@ -512,7 +524,7 @@ func resourceTencentCloudDayuCCPolicyV2Read(d *schema.ResourceData, meta interfa
 
 	ccGeoIpPolicys, err := antiddosService.DescribeCcGeoIPBlockConfigList(ctx, business, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	resultCCGeoIpPolicys := make([]map[string]interface{}, 0)
 	for _, ccGeoIpPolicy := range ccGeoIpPolicys {
@@ -530,7 +542,7 @@ func resourceTencentCloudDayuCCPolicyV2Read(d *schema.ResourceData, meta interfa
 
 	ccBlackWhiteIpList, err := antiddosService.DescribeCcBlackWhiteIpList(ctx, business, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	resultCCBlackWhiteIpList := make([]map[string]interface{}, 0)
 	for _, ccBlackWhiteIp := range ccBlackWhiteIpList {
@@ -547,7 +559,7 @@ func resourceTencentCloudDayuCCPolicyV2Read(d *schema.ResourceData, meta interfa
 
 	ccPrecisionPlyList, err := antiddosService.DescribeCCPrecisionPlyList(ctx, business, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	resultCCPrecisionPlyList := make([]map[string]interface{}, 0)
 	for _, ccPrecisionPly := range ccPrecisionPlyList {
@@ -573,7 +585,7 @@ func resourceTencentCloudDayuCCPolicyV2Read(d *schema.ResourceData, meta interfa
 
 	ccLevelList, err := antiddosService.DescribeCCLevelList(ctx, business, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	resultCCReqLimitList := make([]map[string]interface{}, 0)
@@ -591,7 +603,7 @@ func resourceTencentCloudDayuCCPolicyV2Read(d *schema.ResourceData, meta interfa
 		tmpResultCCReqLimitList["instance_id"] = ccLevelInstanceId
 		ccReqLimitPolicyList, err := antiddosService.DescribeCCReqLimitPolicyList(ctx, business, instanceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		resultCCReqLimitPolicyList := make([]map[string]interface{}, 0)
 		for _, ccReqLimitPolicy := range ccReqLimitPolicyList {
@@ -617,25 +629,25 @@ func resourceTencentCloudDayuCCPolicyV2Read(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudDayuCCPolicyV2Update(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuCCPolicyV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_cc_policy_v2.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	items := strings.Split(d.Id(), FILED_SP)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	items := helper.IdParse(d.Id())
 	if len(items) < 2 {
-		return fmt.Errorf("broken ID of DDoS policy")
+		return diag.Errorf("broken ID of DDoS policy")
 	}
 	instanceId := items[0]
 	business := items[1]
 	antiddosService := AntiddosService{client: meta.(*TencentCloudClient).apiV3Conn}
 	protectThresholdConfig, err := antiddosService.DescribeListProtectThresholdConfig(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceDetailList := protectThresholdConfig.InstanceDetailList
 	if len(instanceDetailList) != 1 {
-		return fmt.Errorf("Can not fetch Eip.")
+		return diag.Errorf("Can not fetch Eip.")
 	}
 	ip := *instanceDetailList[0].EipList[0]
 	if d.HasChange("thresholds") {
@@ -643,7 +655,7 @@ func resourceTencentCloudDayuCCPolicyV2Update(d *schema.ResourceData, meta inter
 		newthresholdMap := make(map[string]int)
 		thresholdList, err := antiddosService.DescribeCCThresholdList(ctx, business, instanceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		for _, threshold := range thresholdList {
 			key := *threshold.Domain + "_" + fmt.Sprint(*threshold.Threshold)
@@ -659,7 +671,7 @@ func resourceTencentCloudDayuCCPolicyV2Update(d *schema.ResourceData, meta inter
 			if oldthresholdMap[key] == 0 {
 				err := antiddosService.ModifyCCThresholdPolicy(ctx, instanceId, "http", ip, subDomain, subThreshold)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -668,11 +680,11 @@ func resourceTencentCloudDayuCCPolicyV2Update(d *schema.ResourceData, meta inter
 			if newthresholdMap[key] == 0 {
 				err := antiddosService.DeleteCCLevelPolicy(ctx, instanceId, ip, *threshold.Domain)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				err = antiddosService.DeleteCCThresholdPolicy(ctx, instanceId, ip, *threshold.Domain)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -682,7 +694,7 @@ func resourceTencentCloudDayuCCPolicyV2Update(d *schema.ResourceData, meta inter
 		newCCGeoIpPolicys := d.Get("cc_geo_ip_policys").([]interface{})
 		oldCCGeoIpPolicys, err := antiddosService.DescribeCcGeoIPBlockConfigList(ctx, business, instanceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		oldCCGeoIpPolicyMap := make(map[string]int)
 		newCCGeoIpPolicyMap := make(map[string]int)
@@ -711,7 +723,7 @@ func resourceTencentCloudDayuCCPolicyV2Update(d *schema.ResourceData, meta inter
 				}
 				err := antiddosService.CreateCcGeoIPBlockConfig(ctx, instanceId, protocol, ip, domain, ccGeoIPBlockConfig)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -743,7 +755,7 @@ func resourceTencentCloudDayuCCPolicyV2Update(d *schema.ResourceData, meta inter
 		newCCBlackWhiteIps := d.Get("cc_black_white_ips").([]interface{})
 		oldCCBlackWhiteIps, err := antiddosService.DescribeCcBlackWhiteIpList(ctx, business, instanceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		oldCCBlackWhiteIpMap := make(map[string]int)
 		newCCBlackWhiteIpMap := make(map[string]int)
@@ -770,7 +782,7 @@ func resourceTencentCloudDayuCCPolicyV2Update(d *schema.ResourceData, meta inter
 			if oldCCBlackWhiteIpMap[key] == 0 {
 				err := antiddosService.CreateCcBlackWhiteIpList(ctx, instanceId, protocol, ip, domain, blackWhiteIpType, []string{blackWhiteIp})
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -783,7 +795,7 @@ func resourceTencentCloudDayuCCPolicyV2Update(d *schema.ResourceData, meta inter
 			if newCCBlackWhiteIpMap[key] == 0 {
 				err := antiddosService.DeleteCcBlackWhiteIpList(ctx, instanceId, oldCCBlackWhiteIpPolicyIdMap[key])
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -793,7 +805,7 @@ func resourceTencentCloudDayuCCPolicyV2Update(d *schema.ResourceData, meta inter
 		newccPrecisionPolicys := d.Get("cc_precision_policys").([]interface{})
 		oldCCPrecisionPolicys, err := antiddosService.DescribeCCPrecisionPlyList(ctx, business, instanceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		oldCCPrecisionPolicyMap := make(map[string]int)
 		newCCPrecisionPolicyMap := make(map[string]int)
@@ -839,7 +851,7 @@ func resourceTencentCloudDayuCCPolicyV2Update(d *schema.ResourceData, meta inter
 					tmpCCPrecisionPlyRecord.ValueOperator = &valueOperator
 					err := antiddosService.CreateCCPrecisionPolicy(ctx, instanceId, protocol, ip, domain, policyAction, []*antiddos.CCPrecisionPlyRecord{&tmpCCPrecisionPlyRecord})
 					if err != nil {
-						return err
+						return diag.FromErr(err)
 					}
 				}
 			}
@@ -860,7 +872,7 @@ func resourceTencentCloudDayuCCPolicyV2Update(d *schema.ResourceData, meta inter
 				if newCCPrecisionPolicyMap[key] == 0 {
 					err := antiddosService.DeleteCCPrecisionPolicy(ctx, instanceId, policyId)
 					if err != nil {
-						return err
+						return diag.FromErr(err)
 					}
 				}
 			}
@@ -876,7 +888,7 @@ func resourceTencentCloudDayuCCPolicyV2Update(d *schema.ResourceData, meta inter
 
 		ccReqLimitPolicyList, err := antiddosService.DescribeCCReqLimitPolicyList(ctx, business, instanceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		for _, ccReqLimitPolicy := range ccReqLimitPolicyList {
 			action := *ccReqLimitPolicy.PolicyRecord.Action
@@ -929,13 +941,13 @@ func resourceTencentCloudDayuCCPolicyV2Update(d *schema.ResourceData, meta inter
 					}
 					err := antiddosService.CreateCCReqLimitPolicy(ctx, instanceId, protocol, ip, domain, ccPolicyRecord)
 					if err != nil {
-						return err
+						return diag.FromErr(err)
 					}
 				}
 			}
 			err = antiddosService.ModifyCCLevelPolicy(ctx, instanceId, ip, domain, protocol, newCCLevel)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 		}
@@ -955,51 +967,51 @@ func resourceTencentCloudDayuCCPolicyV2Update(d *schema.ResourceData, meta inter
 			if newCCPrecisionReqLimitPolicyMap[key] == 0 {
 				err := antiddosService.DeleteCCRequestLimitPolicy(ctx, instanceId, *ccReqLimitPolicy.PolicyId)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 			if newDomainSetMap[domain] == 0 {
 				err := antiddosService.DeleteCCLevelPolicy(ctx, instanceId, ip, domain)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
 	}
 
-	return resourceTencentCloudDayuCCPolicyV2Read(d, meta)
+	return resourceTencentCloudDayuCCPolicyV2Read(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuCCPolicyV2Delete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuCCPolicyV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_cc_policy_v2.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	items := strings.Split(d.Id(), FILED_SP)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	items := helper.IdParse(d.Id())
 	if len(items) < 2 {
-		return fmt.Errorf("broken ID of DDoS policy")
+		return diag.Errorf("broken ID of DDoS policy")
 	}
 	instanceId := items[0]
 	business := items[1]
 	antiddosService := AntiddosService{client: meta.(*TencentCloudClient).apiV3Conn}
 	thresholdList, err := antiddosService.DescribeCCThresholdList(ctx, business, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, threshold := range thresholdList {
 		err := antiddosService.DeleteCCLevelPolicy(ctx, instanceId, *threshold.Ip, *threshold.Domain)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		err = antiddosService.DeleteCCThresholdPolicy(ctx, instanceId, *threshold.Ip, *threshold.Domain)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	ccGeoIpPolicys, err := antiddosService.DescribeCcGeoIPBlockConfigList(ctx, business, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, ccGeoIpPolicy := range ccGeoIpPolicys {
 		areaInt64List := make([]*int64, 0)
@@ -1018,29 +1030,29 @@ func resourceTencentCloudDayuCCPolicyV2Delete(d *schema.ResourceData, meta inter
 
 	ccBlackWhiteIpList, err := antiddosService.DescribeCcBlackWhiteIpList(ctx, business, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, ccBlackWhiteIp := range ccBlackWhiteIpList {
 		err := antiddosService.DeleteCcBlackWhiteIpList(ctx, instanceId, *ccBlackWhiteIp.PolicyId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	ccPrecisionPlyList, err := antiddosService.DescribeCCPrecisionPlyList(ctx, business, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, ccPrecisionPly := range ccPrecisionPlyList {
 		err := antiddosService.DeleteCCPrecisionPolicy(ctx, instanceId, *ccPrecisionPly.PolicyId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	ccLevelPolicyDomainMap := make(map[string]interface{})
 	ccLevelList, err := antiddosService.DescribeCCLevelList(ctx, business, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, ccLevel := range ccLevelList {
 		key := *ccLevel.Ip + "_" + *ccLevel.Domain
@@ -1049,7 +1061,7 @@ func resourceTencentCloudDayuCCPolicyV2Delete(d *schema.ResourceData, meta inter
 	}
 	ccReqLimitPolicyList, err := antiddosService.DescribeCCReqLimitPolicyList(ctx, business, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, ccReqLimitPolicy := range ccReqLimitPolicyList {
 		key := *ccReqLimitPolicy.Ip + "_" + *ccReqLimitPolicy.Domain
@@ -1059,7 +1071,7 @@ func resourceTencentCloudDayuCCPolicyV2Delete(d *schema.ResourceData, meta inter
 		itemList := strings.Split(item, "_")
 		err := antiddosService.DeleteCCLevelPolicy(ctx, instanceId, itemList[0], itemList[1])
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func TestAccTencentCloudDayuCCPolicyV2Resource(t *testing.T) {
@@ -28,6 +28,12 @@ func TestAccTencentCloudDayuCCPolicyV2Resource(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_dayu_cc_policy_v2.demo", "thresholds.#", "1"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_dayu_cc_policy_v2.demo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resource_id", "business"},
+			},
 		},
 	})
 }
@@ -40,7 +46,7 @@ func testAccCheckDayuCCPolicyV2Destroy(s *terraform.State) error {
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 2 {
 			return fmt.Errorf("broken ID of DDoS policy")
 		}
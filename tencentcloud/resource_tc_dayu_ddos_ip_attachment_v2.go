@@ -14,16 +14,25 @@ resource "tencentcloud_dayu_ddos_ip_attachment_v2" "boundip" {
   }
 }
 ```
+
+Import
+
+Dayu DDoS ip attachment v2 can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_dayu_ddos_ip_attachment_v2.boundip bgp-0000000o#1.1.1.1
+```
 */
 package tencentcloud
 
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	antiddos "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/antiddos/v20200309"
@@ -32,9 +41,16 @@ import (
 
 func resourceTencentCloudDayuDDosIpAttachmentV2() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuDDosIpAttachmentCreateV2,
-		Read:   resourceTencentCloudDayuDDosIpAttachmentReadV2,
-		Delete: resourceTencentCloudDayuDDosIpAttachmentDeleteV2,
+		CreateContext: resourceTencentCloudDayuDDosIpAttachmentCreateV2,
+		ReadContext:   resourceTencentCloudDayuDDosIpAttachmentReadV2,
+		DeleteContext: resourceTencentCloudDayuDDosIpAttachmentDeleteV2,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"bgp_instance_id": {
 				Required:    true,
@@ -77,12 +93,12 @@ func resourceTencentCloudDayuDDosIpAttachmentV2() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDayuDDosIpAttachmentCreateV2(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuDDosIpAttachmentCreateV2(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_ddos_ip_attachment_v2.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := AntiddosService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -118,8 +134,8 @@ func resourceTencentCloudDayuDDosIpAttachmentCreateV2(d *schema.ResourceData, me
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAntiddosClient().CreateBoundIP(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAntiddosClient().CreateBoundIPWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -132,9 +148,9 @@ func resourceTencentCloudDayuDDosIpAttachmentCreateV2(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create antiddos boundip failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		boundip, e := service.DescribeAntiddosBoundipById(ctx, bgpInstanceId)
 		if e != nil {
 			return retryError(e)
@@ -146,27 +162,27 @@ func resourceTencentCloudDayuDDosIpAttachmentCreateV2(d *schema.ResourceData, me
 
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(bgpInstanceId + FILED_SP + strings.Join(boundIps, COMMA_SP))
+	d.SetId(helper.IdFormat(bgpInstanceId, strings.Join(boundIps, COMMA_SP)))
 
-	return resourceTencentCloudDayuDDosIpAttachmentReadV2(d, meta)
+	return resourceTencentCloudDayuDDosIpAttachmentReadV2(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuDDosIpAttachmentReadV2(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuDDosIpAttachmentReadV2(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_ddos_ip_attachment_v2.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := AntiddosService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bgpInstanceId := idSplit[0]
 	boundIps := idSplit[1]
@@ -177,7 +193,7 @@ func resourceTencentCloudDayuDDosIpAttachmentReadV2(d *schema.ResourceData, meta
 
 	boundip, err := service.DescribeAntiddosBoundipById(ctx, bgpInstanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if boundip == nil {
@@ -205,18 +221,18 @@ func resourceTencentCloudDayuDDosIpAttachmentReadV2(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudDayuDDosIpAttachmentDeleteV2(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuDDosIpAttachmentDeleteV2(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_ddos_ip_attachment_v2.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := AntiddosService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	bgpInstanceId := idSplit[0]
 	boundIps := idSplit[1]
@@ -236,8 +252,8 @@ func resourceTencentCloudDayuDDosIpAttachmentDeleteV2(d *schema.ResourceData, me
 	}
 	request.UnBoundDevList = ubBoundDevList
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAntiddosClient().CreateBoundIP(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAntiddosClient().CreateBoundIPWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -250,10 +266,10 @@ func resourceTencentCloudDayuDDosIpAttachmentDeleteV2(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create antiddos boundip failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		boundip, e := service.DescribeAntiddosBoundipById(ctx, bgpInstanceId)
 		if e != nil {
 			return retryError(e)
@@ -265,7 +281,7 @@ func resourceTencentCloudDayuDDosIpAttachmentDeleteV2(d *schema.ResourceData, me
 
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func TestAccTencentCloudDayuDdosIpAttachmentV2Resource_basic(t *testing.T) {
@@ -25,6 +26,11 @@ func TestAccTencentCloudDayuDdosIpAttachmentV2Resource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_dayu_ddos_ip_attachment_v2.boundip", "bound_ip_list.#", "2"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_dayu_ddos_ip_attachment_v2.boundip",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -40,7 +46,7 @@ func testAccCheckDayuDdosIpAttachmentDestroy(s *terraform.State) error {
 		if rs.Type != "tencentcloud_dayu_ddos_ip_attachment_v2" {
 			continue
 		}
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		if len(idSplit) != 2 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
@@ -85,7 +91,7 @@ func testAccCheckDayuDdosIpAttachmentExists(n string) resource.TestCheckFunc {
 			if rs.Type != "tencentcloud_dayu_ddos_ip_attachment_v2" {
 				continue
 			}
-			idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+			idSplit := helper.IdParse(rs.Primary.ID)
 			if len(idSplit) != 2 {
 				return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 			}
@@ -65,6 +65,14 @@ resource "tencentcloud_dayu_ddos_policy" "test_policy" {
   }
 }
 ```
+
+Import
+
+Dayu DDoS policy can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_dayu_ddos_policy.test_policy bgpip#policy-oddw0s1h
+```
 */
 package tencentcloud
 
@@ -72,19 +80,29 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dayu "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dayu/v20180709"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudDayuDdosPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuDdosPolicyCreate,
-		Read:   resourceTencentCloudDayuDdosPolicyRead,
-		Update: resourceTencentCloudDayuDdosPolicyUpdate,
-		Delete: resourceTencentCloudDayuDdosPolicyDelete,
+		CreateContext: resourceTencentCloudDayuDdosPolicyCreate,
+		ReadContext:   resourceTencentCloudDayuDdosPolicyRead,
+		UpdateContext: resourceTencentCloudDayuDdosPolicyUpdate,
+		DeleteContext: resourceTencentCloudDayuDdosPolicyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_type": {
@@ -459,11 +477,11 @@ func resourceTencentCloudDayuDdosPolicy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDayuDdosPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuDdosPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_ddos_policy.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceType := d.Get("resource_type").(string)
 	name := d.Get("name").(string)
@@ -476,7 +494,7 @@ func resourceTencentCloudDayuDdosPolicyCreate(d *schema.ResourceData, meta inter
 	ddosPolicyPortLimit, lErr := setDdosPolicyPortLimit(portMapping)
 
 	if lErr != nil {
-		return lErr
+		return diag.FromErr(lErr)
 	}
 
 	//set IpBlackWhite
@@ -485,13 +503,13 @@ func resourceTencentCloudDayuDdosPolicyCreate(d *schema.ResourceData, meta inter
 	ipBlackWhite, ipErr := setIpBlackWhite(blackIps, whiteIps)
 
 	if ipErr != nil {
-		return ipErr
+		return diag.FromErr(ipErr)
 	}
 	//set DDoSPolicyPacketFilter
 	packetFilterMapping := d.Get("packet_filters").([]interface{})
 	ddosPacketFilter, pErr := setDdosPolicyPacketFilter(packetFilterMapping)
 	if pErr != nil {
-		return pErr
+		return diag.FromErr(pErr)
 	}
 
 	//set WaterPrintPolicy
@@ -502,7 +520,7 @@ func resourceTencentCloudDayuDdosPolicyCreate(d *schema.ResourceData, meta inter
 
 	policyId := ""
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := dayuService.CreateDdosPolicy(ctx, resourceType, name, ddosPolicyDropOption, ddosPolicyPortLimit, ipBlackWhite, ddosPacketFilter, waterPrintPolicy)
 		if e != nil {
 			return retryError(e)
@@ -512,23 +530,23 @@ func resourceTencentCloudDayuDdosPolicyCreate(d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(resourceType + FILED_SP + policyId)
+	d.SetId(helper.IdFormat(resourceType, policyId))
 
-	return resourceTencentCloudDayuDdosPolicyRead(d, meta)
+	return resourceTencentCloudDayuDdosPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuDdosPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuDdosPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_ddos_policy.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 2 {
-		return fmt.Errorf("broken ID of DDoS policy")
+		return diag.Errorf("broken ID of DDoS policy")
 	}
 	resourceType := items[0]
 	policyId := items[1]
@@ -537,7 +555,7 @@ func resourceTencentCloudDayuDdosPolicyRead(d *schema.ResourceData, meta interfa
 
 	ddosPolicy, has, err := dayuService.DescribeDdosPolicy(ctx, resourceType, policyId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			ddosPolicy, has, err = dayuService.DescribeDdosPolicy(ctx, resourceType, policyId)
 			if err != nil {
 				return retryError(err)
@@ -546,7 +564,7 @@ func resourceTencentCloudDayuDdosPolicyRead(d *schema.ResourceData, meta interfa
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -568,15 +586,15 @@ func resourceTencentCloudDayuDdosPolicyRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudDayuDdosPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuDdosPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_ddos_policy.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 2 {
-		return fmt.Errorf("broken ID of DDoS policy")
+		return diag.Errorf("broken ID of DDoS policy")
 	}
 	resourceType := items[0]
 	policyId := items[1]
@@ -585,7 +603,7 @@ func resourceTencentCloudDayuDdosPolicyUpdate(d *schema.ResourceData, meta inter
 	d.Partial(true)
 
 	if d.HasChange("name") {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			e := dayuService.ModifyDdosPolicyName(ctx, resourceType, policyId, d.Get("name").(string))
 			if e != nil {
 				return retryError(e)
@@ -593,7 +611,7 @@ func resourceTencentCloudDayuDdosPolicyUpdate(d *schema.ResourceData, meta inter
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -609,7 +627,7 @@ func resourceTencentCloudDayuDdosPolicyUpdate(d *schema.ResourceData, meta inter
 		ddosPolicyPortLimit, lErr := setDdosPolicyPortLimit(portMapping)
 
 		if lErr != nil {
-			return lErr
+			return diag.FromErr(lErr)
 		}
 
 		//set IpBlackWhite
@@ -618,20 +636,20 @@ func resourceTencentCloudDayuDdosPolicyUpdate(d *schema.ResourceData, meta inter
 		ipBlackWhite, ipErr := setIpBlackWhite(blackIps, whiteIps)
 
 		if ipErr != nil {
-			return ipErr
+			return diag.FromErr(ipErr)
 		}
 		//set DDoSPolicyPacketFilter
 		packetFilterMapping := d.Get("packet_filters").([]interface{})
 		ddosPacketFilter, pErr := setDdosPolicyPacketFilter(packetFilterMapping)
 		if pErr != nil {
-			return pErr
+			return diag.FromErr(pErr)
 		}
 
 		//set WaterPrintPolicy
 		waterPrintMapping := d.Get("watermark_filters").([]interface{})
 		waterPrintPolicy, _ := setWaterPrintPolicy(waterPrintMapping)
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			e := dayuService.ModifyDdosPolicy(ctx, resourceType, policyId, ddosPolicyDropOption, ddosPolicyPortLimit, ipBlackWhite, ddosPacketFilter, waterPrintPolicy)
 			if e != nil {
 				return retryError(e)
@@ -639,32 +657,32 @@ func resourceTencentCloudDayuDdosPolicyUpdate(d *schema.ResourceData, meta inter
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudDayuDdosPolicyRead(d, meta)
+	return resourceTencentCloudDayuDdosPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuDdosPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuDdosPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_ddos_policy.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 2 {
-		return fmt.Errorf("broken ID of DDoS policy")
+		return diag.Errorf("broken ID of DDoS policy")
 	}
 	resourceType := items[0]
 	policyId := items[1]
 
 	dayuService := DayuService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := dayuService.DeleteDdosPolicy(ctx, resourceType, policyId)
 		if e != nil {
 			return retryError(e)
@@ -673,12 +691,12 @@ func resourceTencentCloudDayuDdosPolicyDelete(d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, has, err := dayuService.DescribeDdosPolicy(ctx, resourceType, policyId)
 	if err != nil || has {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			_, has, err = dayuService.DescribeDdosPolicy(ctx, resourceType, policyId)
 			if err != nil {
 				return retryError(err)
@@ -693,11 +711,11 @@ func resourceTencentCloudDayuDdosPolicyDelete(d *schema.ResourceData, meta inter
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		return nil
 	} else {
-		return errors.New("delete DDoS policy fail, DDoS policy still exist from sdk DescribeDDosPolicy")
+		return diag.FromErr(errors.New("delete DDoS policy fail, DDoS policy still exist from sdk DescribeDDosPolicy"))
 	}
 }
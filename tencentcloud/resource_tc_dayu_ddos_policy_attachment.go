@@ -10,25 +10,40 @@ resource "tencentcloud_dayu_ddos_policy_attachment" "dayu_ddos_policy_attachment
   policy_id     = tencentcloud_dayu_ddos_policy.test_policy.policy_id
 }
 ```
+
+Import
+
+Dayu DDoS policy attachment can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_dayu_ddos_policy_attachment.dayu_ddos_policy_attachment_basic bgpip-00000294#bgpip#policy-oddw0s1h
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudDayuDdosPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuDdosPolicyAttachmentCreate,
-		Read:   resourceTencentCloudDayuDdosPolicyAttachmentRead,
-		Delete: resourceTencentCloudDayuDdosPolicyAttachmentDelete,
+		CreateContext: resourceTencentCloudDayuDdosPolicyAttachmentCreate,
+		ReadContext:   resourceTencentCloudDayuDdosPolicyAttachmentRead,
+		DeleteContext: resourceTencentCloudDayuDdosPolicyAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -54,11 +69,11 @@ func resourceTencentCloudDayuDdosPolicyAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDayuDdosPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuDdosPolicyAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_ddos_policy_attachment.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Get("resource_id").(string)
 	policyId := d.Get("policy_id").(string)
@@ -70,7 +85,7 @@ func resourceTencentCloudDayuDdosPolicyAttachmentCreate(d *schema.ResourceData,
 	//only one bind relationship supported, check unbind status
 	_, has, statusErr := dayuService.DescribeDdosPolicyAttachments(ctx, resourceId, resourceType, "")
 	if statusErr != nil {
-		statusErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		statusErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			_, has, statusErr = dayuService.DescribeDdosPolicyAttachments(ctx, resourceId, resourceType, "")
 			if statusErr != nil {
 				return retryError(statusErr)
@@ -79,13 +94,13 @@ func resourceTencentCloudDayuDdosPolicyAttachmentCreate(d *schema.ResourceData,
 		})
 	}
 	if statusErr != nil {
-		return statusErr
+		return diag.FromErr(statusErr)
 	}
 	if has {
-		return fmt.Errorf("DDoS is already bined by policy")
+		return diag.Errorf("DDoS is already bined by policy")
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		e := dayuService.BindDdosPolicy(ctx, resourceId, resourceType, policyId)
 		if e != nil {
 			return retryError(e)
@@ -94,14 +109,14 @@ func resourceTencentCloudDayuDdosPolicyAttachmentCreate(d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	time.Sleep(10 * time.Second)
 	//check bind status
 	_, has, statusErr = dayuService.DescribeDdosPolicyAttachments(ctx, resourceId, resourceType, policyId)
 	if statusErr != nil {
-		statusErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		statusErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			_, has, statusErr = dayuService.DescribeDdosPolicyAttachments(ctx, resourceId, resourceType, policyId)
 			if statusErr != nil {
 				return retryError(statusErr)
@@ -110,27 +125,27 @@ func resourceTencentCloudDayuDdosPolicyAttachmentCreate(d *schema.ResourceData,
 		})
 	}
 	if statusErr != nil {
-		return statusErr
+		return diag.FromErr(statusErr)
 	}
 	if !has {
-		return fmt.Errorf("Create DDoS policy attachment faild")
+		return diag.Errorf("Create DDoS policy attachment faild")
 	}
 
-	d.SetId(resourceId + FILED_SP + resourceType + FILED_SP + policyId)
+	d.SetId(helper.IdFormat(resourceId, resourceType, policyId))
 
-	return resourceTencentCloudDayuDdosPolicyAttachmentRead(d, meta)
+	return resourceTencentCloudDayuDdosPolicyAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuDdosPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuDdosPolicyAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_ddos_policy_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 3 {
-		return fmt.Errorf("broken ID of DDoS policy attachment")
+		return diag.Errorf("broken ID of DDoS policy attachment")
 	}
 	resourceId := items[0]
 	resourceType := items[1]
@@ -141,7 +156,7 @@ func resourceTencentCloudDayuDdosPolicyAttachmentRead(d *schema.ResourceData, me
 	}
 	_, has, err := dayuService.DescribeDdosPolicyAttachments(ctx, resourceId, resourceType, policyId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			_, has, err = dayuService.DescribeDdosPolicyAttachments(ctx, resourceId, resourceType, policyId)
 			if err != nil {
 				return retryError(err)
@@ -151,7 +166,7 @@ func resourceTencentCloudDayuDdosPolicyAttachmentRead(d *schema.ResourceData, me
 	}
 	if err != nil {
 		log.Printf("[CRITAL]%s read DDoS Policy attachment failed, reason:%s\n", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if has {
@@ -165,15 +180,15 @@ func resourceTencentCloudDayuDdosPolicyAttachmentRead(d *schema.ResourceData, me
 	}
 }
 
-func resourceTencentCloudDayuDdosPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuDdosPolicyAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_ddos_policy_attachment.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 3 {
-		return fmt.Errorf("broken ID of DDoS policy attachment")
+		return diag.Errorf("broken ID of DDoS policy attachment")
 	}
 	resourceId := items[0]
 	resourceType := items[1]
@@ -183,7 +198,7 @@ func resourceTencentCloudDayuDdosPolicyAttachmentDelete(d *schema.ResourceData,
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := dayuService.UnbindDdosPolicy(ctx, resourceId, resourceType, policyId)
 		if e != nil {
 			return retryError(e)
@@ -192,14 +207,14 @@ func resourceTencentCloudDayuDdosPolicyAttachmentDelete(d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	time.Sleep(1 * time.Minute)
 	//check bind status
 	_, has, statusErr := dayuService.DescribeDdosPolicyAttachments(ctx, resourceId, resourceType, policyId)
 	if statusErr != nil {
-		statusErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		statusErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			_, has, statusErr = dayuService.DescribeDdosPolicyAttachments(ctx, resourceId, resourceType, policyId)
 			if statusErr != nil {
 				return retryError(statusErr)
@@ -208,10 +223,10 @@ func resourceTencentCloudDayuDdosPolicyAttachmentDelete(d *schema.ResourceData,
 		})
 	}
 	if statusErr != nil {
-		return statusErr
+		return diag.FromErr(statusErr)
 	}
 	if has {
-		return fmt.Errorf("Delete DDoS policy attachment faild")
+		return diag.Errorf("Delete DDoS policy attachment faild")
 	}
 
 	return nil
@@ -31,6 +31,14 @@ resource "tencentcloud_dayu_ddos_policy_case" "foo" {
   has_vpn             = "yes"
 }
 ```
+
+Import
+
+Dayu DDoS policy case can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_dayu_ddos_policy_case.foo bgpip#scene-lujifz2i
+```
 */
 package tencentcloud
 
@@ -40,7 +48,9 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkError "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -50,10 +60,18 @@ import (
 
 func resourceTencentCloudDayuDdosPolicyCase() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuDdosPolicyCaseCreate,
-		Read:   resourceTencentCloudDayuDdosPolicyCaseRead,
-		Update: resourceTencentCloudDayuDdosPolicyCaseUpdate,
-		Delete: resourceTencentCloudDayuDdosPolicyCaseDelete,
+		CreateContext: resourceTencentCloudDayuDdosPolicyCaseCreate,
+		ReadContext:   resourceTencentCloudDayuDdosPolicyCaseRead,
+		UpdateContext: resourceTencentCloudDayuDdosPolicyCaseUpdate,
+		DeleteContext: resourceTencentCloudDayuDdosPolicyCaseDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_type": {
@@ -216,11 +234,11 @@ func resourceTencentCloudDayuDdosPolicyCase() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDayuDdosPolicyCaseCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuDdosPolicyCaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_ddos_policy_case.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := dayu.NewCreateDDoSPolicyCaseRequest()
 	resourceType := d.Get("resource_type").(string)
@@ -253,26 +271,26 @@ func resourceTencentCloudDayuDdosPolicyCaseCreate(d *schema.ResourceData, meta i
 	startInt, sErr := strconv.Atoi(tcpPortStart)
 	endInt, eErr := strconv.Atoi(tcpPortEnd)
 	if sErr != nil {
-		return sErr
+		return diag.FromErr(sErr)
 	}
 	if eErr != nil {
-		return eErr
+		return diag.FromErr(eErr)
 	}
 	if endInt < startInt {
-		return fmt.Errorf("`tcp_start_port`:%s should not be greater than `tcp_end_port`:%s.", tcpPortStart, tcpPortEnd)
+		return diag.Errorf("`tcp_start_port`:%s should not be greater than `tcp_end_port`:%s.", tcpPortStart, tcpPortEnd)
 	}
 	udpPortStart := d.Get("udp_start_port").(string)
 	udpPortEnd := d.Get("udp_end_port").(string)
 	startInt, sErr = strconv.Atoi(udpPortStart)
 	endInt, eErr = strconv.Atoi(udpPortEnd)
 	if sErr != nil {
-		return sErr
+		return diag.FromErr(sErr)
 	}
 	if eErr != nil {
-		return eErr
+		return diag.FromErr(eErr)
 	}
 	if endInt < startInt {
-		return fmt.Errorf("`udp_start_port`:%s should not be greater than `udp_end_port`:%s.", udpPortStart, udpPortEnd)
+		return diag.Errorf("`udp_start_port`:%s should not be greater than `udp_end_port`:%s.", udpPortStart, udpPortEnd)
 	}
 	request.TcpSportStart = &tcpPortStart
 	request.TcpSportEnd = &tcpPortEnd
@@ -284,14 +302,14 @@ func resourceTencentCloudDayuDdosPolicyCaseCreate(d *schema.ResourceData, meta i
 	minTcpPackageLenInt, _ := strconv.Atoi(minTcpPackageLen)
 	maxTcpPackageLenInt, _ := strconv.Atoi(maxTcpPackageLen)
 	if maxTcpPackageLenInt < minTcpPackageLenInt {
-		return fmt.Errorf("`min_tcp_package_len`:%s should not be greater than `max_tcp_package_len`:%s.", minTcpPackageLen, maxTcpPackageLen)
+		return diag.Errorf("`min_tcp_package_len`:%s should not be greater than `max_tcp_package_len`:%s.", minTcpPackageLen, maxTcpPackageLen)
 	}
 	minUdpPackageLen := d.Get("min_udp_package_len").(string)
 	maxUdpPackageLen := d.Get("max_udp_package_len").(string)
 	minUdpPackageLenInt, _ := strconv.Atoi(minUdpPackageLen)
 	maxUdpPackageLenInt, _ := strconv.Atoi(maxUdpPackageLen)
 	if maxUdpPackageLenInt < minUdpPackageLenInt {
-		return fmt.Errorf("`min_udp_package_len`:%s should not be greater than `max_udp_package_len`:%s.", minUdpPackageLen, maxUdpPackageLen)
+		return diag.Errorf("`min_udp_package_len`:%s should not be greater than `max_udp_package_len`:%s.", minUdpPackageLen, maxUdpPackageLen)
 	}
 	request.MinTcpPackageLen = &minTcpPackageLen
 	request.MaxTcpPackageLen = &maxTcpPackageLen
@@ -301,7 +319,7 @@ func resourceTencentCloudDayuDdosPolicyCaseCreate(d *schema.ResourceData, meta i
 	dayuService := DayuService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	sceneId := ""
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := dayuService.CreateDdosPolicyCase(ctx, request)
 		if e != nil {
 			return retryError(e)
@@ -311,24 +329,24 @@ func resourceTencentCloudDayuDdosPolicyCaseCreate(d *schema.ResourceData, meta i
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(resourceType + FILED_SP + sceneId)
+	d.SetId(helper.IdFormat(resourceType, sceneId))
 
-	return resourceTencentCloudDayuDdosPolicyCaseRead(d, meta)
+	return resourceTencentCloudDayuDdosPolicyCaseRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuDdosPolicyCaseRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuDdosPolicyCaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_ddos_policy_case.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 2 {
-		return fmt.Errorf("broken ID of DDoS policy case")
+		return diag.Errorf("broken ID of DDoS policy case")
 	}
 	resourceType := items[0]
 	sceneId := items[1]
@@ -337,7 +355,7 @@ func resourceTencentCloudDayuDdosPolicyCaseRead(d *schema.ResourceData, meta int
 
 	ddosPolicyCase, has, err := dayuService.DescribeDdosPolicyCase(ctx, resourceType, sceneId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			ddosPolicyCase, has, err = dayuService.DescribeDdosPolicyCase(ctx, resourceType, sceneId)
 			if err != nil {
 				return retryError(err)
@@ -346,7 +364,7 @@ func resourceTencentCloudDayuDdosPolicyCaseRead(d *schema.ResourceData, meta int
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -428,15 +446,15 @@ func resourceTencentCloudDayuDdosPolicyCaseRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudDayuDdosPolicyCaseUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuDdosPolicyCaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_ddos_policy_case.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 2 {
-		return fmt.Errorf("broken ID of DDoS policy case")
+		return diag.Errorf("broken ID of DDoS policy case")
 	}
 	resourceType := items[0]
 	sceneId := items[1]
@@ -472,28 +490,28 @@ func resourceTencentCloudDayuDdosPolicyCaseUpdate(d *schema.ResourceData, meta i
 	startInt, sErr := strconv.Atoi(tcpPortStart)
 	endInt, eErr := strconv.Atoi(tcpPortEnd)
 	if sErr != nil {
-		return sErr
+		return diag.FromErr(sErr)
 	}
 	if eErr != nil {
-		return eErr
+		return diag.FromErr(eErr)
 	}
 
 	if endInt < startInt {
-		return fmt.Errorf("`tcp_start_port`:%s should not be greater than `tcp_end_port`:%s.", tcpPortStart, tcpPortEnd)
+		return diag.Errorf("`tcp_start_port`:%s should not be greater than `tcp_end_port`:%s.", tcpPortStart, tcpPortEnd)
 	}
 	udpPortStart := d.Get("udp_start_port").(string)
 	udpPortEnd := d.Get("udp_end_port").(string)
 	startInt, sErr = strconv.Atoi(udpPortStart)
 	endInt, eErr = strconv.Atoi(udpPortEnd)
 	if sErr != nil {
-		return sErr
+		return diag.FromErr(sErr)
 	}
 	if eErr != nil {
-		return eErr
+		return diag.FromErr(eErr)
 	}
 
 	if endInt < startInt {
-		return fmt.Errorf("`udp_start_port`:%s should not be greater than `udp_end_port`:%s.", udpPortStart, udpPortEnd)
+		return diag.Errorf("`udp_start_port`:%s should not be greater than `udp_end_port`:%s.", udpPortStart, udpPortEnd)
 	}
 	request.TcpSportStart = &tcpPortStart
 	request.TcpSportEnd = &tcpPortEnd
@@ -505,14 +523,14 @@ func resourceTencentCloudDayuDdosPolicyCaseUpdate(d *schema.ResourceData, meta i
 	minTcpPackageLenInt, _ := strconv.Atoi(minTcpPackageLen)
 	maxTcpPackageLenInt, _ := strconv.Atoi(maxTcpPackageLen)
 	if maxTcpPackageLenInt < minTcpPackageLenInt {
-		return fmt.Errorf("`min_tcp_package_len`:%s should not be greater than `max_tcp_package_len`:%s.", minTcpPackageLen, maxTcpPackageLen)
+		return diag.Errorf("`min_tcp_package_len`:%s should not be greater than `max_tcp_package_len`:%s.", minTcpPackageLen, maxTcpPackageLen)
 	}
 	minUdpPackageLen := d.Get("min_udp_package_len").(string)
 	maxUdpPackageLen := d.Get("max_udp_package_len").(string)
 	minUdpPackageLenInt, _ := strconv.Atoi(minUdpPackageLen)
 	maxUdpPackageLenInt, _ := strconv.Atoi(maxUdpPackageLen)
 	if maxUdpPackageLenInt < minUdpPackageLenInt {
-		return fmt.Errorf("`min_udp_package_len`:%s should not be greater than `max_udp_package_len`:%s.", minUdpPackageLen, maxUdpPackageLen)
+		return diag.Errorf("`min_udp_package_len`:%s should not be greater than `max_udp_package_len`:%s.", minUdpPackageLen, maxUdpPackageLen)
 	}
 	request.MinTcpPackageLen = &minTcpPackageLen
 	request.MaxTcpPackageLen = &maxTcpPackageLen
@@ -521,7 +539,7 @@ func resourceTencentCloudDayuDdosPolicyCaseUpdate(d *schema.ResourceData, meta i
 
 	dayuService := DayuService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		e := dayuService.ModifyDdosPolicyCase(ctx, request)
 		if e != nil {
 			return retryError(e)
@@ -529,21 +547,21 @@ func resourceTencentCloudDayuDdosPolicyCaseUpdate(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudDayuDdosPolicyCaseRead(d, meta)
+	return resourceTencentCloudDayuDdosPolicyCaseRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuDdosPolicyCaseDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuDdosPolicyCaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_ddos_policy_case.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 2 {
-		return fmt.Errorf("broken ID of DDoS policy")
+		return diag.Errorf("broken ID of DDoS policy")
 	}
 	resourceType := items[0]
 	sceneId := items[1]
@@ -560,7 +578,7 @@ func resourceTencentCloudDayuDdosPolicyCaseDelete(d *schema.ResourceData, meta i
 				policies, dErr := dayuService.DescribeDdosPolicies(ctx, resourceType, "")
 				if dErr != nil {
 					err = dErr
-					return err
+					return diag.FromErr(err)
 				}
 				bindPolicyId := ""
 				bindResourceIds := []string{}
@@ -573,19 +591,19 @@ func resourceTencentCloudDayuDdosPolicyCaseDelete(d *schema.ResourceData, meta i
 					}
 				}
 				if bindPolicyId == "" || len(bindResourceIds) == 0 {
-					return fmt.Errorf("the automatically generated policy of policy case %s can not be find", sceneId)
+					return diag.Errorf("the automatically generated policy of policy case %s can not be find", sceneId)
 				}
 				//unbind policy and resource
 				for _, resourceId := range bindResourceIds {
 					bErr := dayuService.UnbindDdosPolicy(ctx, resourceId, resourceType, bindPolicyId)
 					if bErr != nil {
 						err = bErr
-						return err
+						return diag.FromErr(err)
 					}
 				}
 			}
 		}
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			e := dayuService.DeleteDdosPolicyCase(ctx, resourceType, sceneId)
 			if e != nil {
 				return retryError(e)
@@ -595,12 +613,12 @@ func resourceTencentCloudDayuDdosPolicyCaseDelete(d *schema.ResourceData, meta i
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, has, err := dayuService.DescribeDdosPolicyCase(ctx, resourceType, sceneId)
 	if err != nil || has {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			_, has, err = dayuService.DescribeDdosPolicyCase(ctx, resourceType, sceneId)
 			if err != nil {
 				return retryError(err)
@@ -615,11 +633,11 @@ func resourceTencentCloudDayuDdosPolicyCaseDelete(d *schema.ResourceData, meta i
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		return nil
 	} else {
-		return errors.New("delete DDoS policy case fail, DDoS policy case still exist.")
+		return diag.FromErr(errors.New("delete DDoS policy case fail, DDoS policy case still exist."))
 	}
 }
@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 var testDayuDdosPolicyCaseResourceName = "tencentcloud_dayu_ddos_policy_case"
@@ -77,6 +77,12 @@ func TestAccTencentCloudDayuDdosPolicyCaseResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testDayuDdosPolicyCaseResourceKey, "max_udp_package_len", "1100"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_dayu_ddos_policy_case.test_policy_case",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resource_type"},
+			},
 		},
 	})
 }
@@ -89,7 +95,7 @@ func testAccCheckDayuDdosPolicyCaseDestroy(s *terraform.State) error {
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 2 {
 			return fmt.Errorf("broken ID of DDos policy case")
 		}
@@ -123,7 +129,7 @@ func testAccCheckDayuDdosPolicyCaseExists(n string) resource.TestCheckFunc {
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 2 {
 			return fmt.Errorf("broken ID of DDos policy case")
 		}
@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func TestAccTencentCloudDayuDdosPolicyResource(t *testing.T) {
@@ -127,6 +127,12 @@ func TestAccTencentCloudDayuDdosPolicyResource(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_dayu_ddos_policy.test_policy", "watermark_filters.0.open_switch", "false"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_dayu_ddos_policy.test_policy",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resource_type"},
+			},
 		},
 	})
 }
@@ -139,7 +145,7 @@ func testAccCheckDayuDdosPolicyDestroy(s *terraform.State) error {
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 2 {
 			return fmt.Errorf("broken ID of DDos policy")
 		}
@@ -173,7 +179,7 @@ func testAccCheckDayuDdosPolicyExists(n string) resource.TestCheckFunc {
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 2 {
 			return fmt.Errorf("broken ID of DDos policy")
 		}
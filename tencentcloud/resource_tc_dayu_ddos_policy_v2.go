@@ -77,6 +77,14 @@ resource "tencentcloud_dayu_ddos_policy_v2" "ddos_v2" {
 	}
 }
 
+```
+
+Import
+
+Dayu DDoS policy v2 can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_dayu_ddos_policy_v2.ddos_v2 bgpip-00000294#bgpip
 ```
 */
 package tencentcloud
@@ -84,9 +92,8 @@ package tencentcloud
 import (
 	"context"
 	"encoding/json"
-	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	antiddos "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/antiddos/v20200309"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -94,10 +101,13 @@ import (
 
 func resourceTencentCloudDayuDdosPolicyV2() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuDdosPolicyV2Create,
-		Read:   resourceTencentCloudDayuDdosPolicyV2Read,
-		Update: resourceTencentCloudDayuDdosPolicyV2Update,
-		Delete: resourceTencentCloudDayuDdosPolicyV2Delete,
+		CreateContext: resourceTencentCloudDayuDdosPolicyV2Create,
+		ReadContext:   resourceTencentCloudDayuDdosPolicyV2Read,
+		UpdateContext: resourceTencentCloudDayuDdosPolicyV2Update,
+		DeleteContext: resourceTencentCloudDayuDdosPolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -450,16 +460,16 @@ func resourceTencentCloudDayuDdosPolicyV2() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDayuDdosPolicyV2Create(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuDdosPolicyV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_ddos_policy_v2.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	antiddosService := AntiddosService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	resourceId := d.Get("resource_id").(string)
 	business := d.Get("business").(string)
 
-	d.SetId(resourceId + FILED_SP + business)
+	d.SetId(helper.IdFormat(resourceId, business))
 	if v, ok := d.GetOk("black_white_ips"); ok {
 		blackWhiteIps := v.([]interface{})
 		blacks := make([]string, 0)
@@ -477,14 +487,14 @@ func resourceTencentCloudDayuDdosPolicyV2Create(d *schema.ResourceData, meta int
 		if len(blacks) > 0 {
 			err := antiddosService.CreateDDoSBlackWhiteIpList(ctx, resourceId, blacks, DDOS_BLACK_WHITE_IP_TYPE_BLACK)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 		}
 		if len(whites) > 0 {
 			err := antiddosService.CreateDDoSBlackWhiteIpList(ctx, resourceId, whites, DDOS_BLACK_WHITE_IP_TYPE_WHITE)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -494,14 +504,14 @@ func resourceTencentCloudDayuDdosPolicyV2Create(d *schema.ResourceData, meta int
 		ddosLevel := v.(string)
 		err := antiddosService.ModifyDDoSLevel(ctx, business, resourceId, ddosLevel)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if v, ok := d.GetOk("ddos_threshold"); ok {
 		threshold := v.(int)
 		err := antiddosService.ModifyDDoSThreshold(ctx, business, resourceId, threshold)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -527,7 +537,7 @@ func resourceTencentCloudDayuDdosPolicyV2Create(d *schema.ResourceData, meta int
 			}
 			err := antiddosService.CreatePortAclConfig(ctx, resourceId, tmpAclConfig)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -548,7 +558,7 @@ func resourceTencentCloudDayuDdosPolicyV2Create(d *schema.ResourceData, meta int
 			}
 			err := antiddosService.CreateProtocolBlockConfig(ctx, resourceId, tmpProtocolBlockConfig)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -579,14 +589,14 @@ func resourceTencentCloudDayuDdosPolicyV2Create(d *schema.ResourceData, meta int
 			}
 			err := antiddosService.CreateDDoSConnectLimit(ctx, resourceId, tmpConnectLimitConfig)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 	if v, ok := d.GetOk("ddos_ai"); ok {
 		err := antiddosService.CreateDDoSAI(ctx, resourceId, v.(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if v, ok := d.GetOk("ddos_geo_ip_block_config"); ok {
@@ -608,7 +618,7 @@ func resourceTencentCloudDayuDdosPolicyV2Create(d *schema.ResourceData, meta int
 			}
 			err := antiddosService.CreateDDoSGeoIPBlockConfig(ctx, resourceId, tmpDDoSGeoIPBlockConfig)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -639,7 +649,7 @@ func resourceTencentCloudDayuDdosPolicyV2Create(d *schema.ResourceData, meta int
 			}
 			err := antiddosService.CreateDDoSSpeedLimitConfig(ctx, resourceId, tmpDDosConnectLimit)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -696,27 +706,27 @@ func resourceTencentCloudDayuDdosPolicyV2Create(d *schema.ResourceData, meta int
 			}
 			err := antiddosService.CreatePacketFilterConfig(ctx, resourceId, tmpPacketFilter)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return resourceTencentCloudDayuDdosPolicyV2Read(d, meta)
+	return resourceTencentCloudDayuDdosPolicyV2Read(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuDdosPolicyV2Read(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuDdosPolicyV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_ddos_policy_v2.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	antiddosService := AntiddosService{client: meta.(*TencentCloudClient).apiV3Conn}
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 2 {
-		return fmt.Errorf("broken ID of DDoS policy")
+		return diag.Errorf("broken ID of DDoS policy")
 	}
 	instanceId := items[0]
 	protectThresholdRelation, err := antiddosService.DescribeListProtectThresholdConfig(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	ddosLevel := protectThresholdRelation.DDoSLevel
 	ddosThreshold := protectThresholdRelation.DDoSThreshold
@@ -725,7 +735,7 @@ func resourceTencentCloudDayuDdosPolicyV2Read(d *schema.ResourceData, meta inter
 
 	blackWhiteIpRelationList, err := antiddosService.DescribeListBlackWhiteIpList(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	blackWhiteIpInfos := make([]map[string]string, 0)
 	for _, blackWhiteIpRelation := range blackWhiteIpRelationList {
@@ -737,7 +747,7 @@ func resourceTencentCloudDayuDdosPolicyV2Read(d *schema.ResourceData, meta inter
 
 	aclConfigRelationList, err := antiddosService.DescribeListPortAclList(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	acls := make([]map[string]interface{}, 0)
 	for _, aclConfigRelation := range aclConfigRelationList {
@@ -762,7 +772,7 @@ func resourceTencentCloudDayuDdosPolicyV2Read(d *schema.ResourceData, meta inter
 
 	protocolBlockRelation, err := antiddosService.DescribeListProtocolBlockConfig(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if protocolBlockRelation.ProtocolBlockConfig != nil {
 		dropIcmp := protocolBlockRelation.ProtocolBlockConfig.DropIcmp
@@ -779,7 +789,7 @@ func resourceTencentCloudDayuDdosPolicyV2Read(d *schema.ResourceData, meta inter
 
 	connectLimitRelation, err := antiddosService.DescribeDDoSConnectLimitList(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	ddosConnectLimit := make(map[string]interface{})
 	ddosConnectLimit["sd_new_limit"] = connectLimitRelation.SdNewLimit
@@ -795,13 +805,13 @@ func resourceTencentCloudDayuDdosPolicyV2Read(d *schema.ResourceData, meta inter
 
 	ddoSAIRelation, err := antiddosService.DescribeListDDoSAI(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("ddos_ai", ddoSAIRelation.DDoSAI)
 
 	ddosGeoIPBlockConfigRelations, err := antiddosService.DescribeListDDoSGeoIPBlockConfig(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	ddosGeoIPBlockConfigList := make([]map[string]interface{}, 0)
 
@@ -818,7 +828,7 @@ func resourceTencentCloudDayuDdosPolicyV2Read(d *schema.ResourceData, meta inter
 
 	ddosSpeedLimitConfigRelations, err := antiddosService.DescribeListDDoSSpeedLimitConfig(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	ddosSpeedLimitConfigs := make([]map[string]interface{}, 0)
 	for _, ddosSpeedLimitConfigRelation := range ddosSpeedLimitConfigRelations {
@@ -842,7 +852,7 @@ func resourceTencentCloudDayuDdosPolicyV2Read(d *schema.ResourceData, meta inter
 
 	packetFilterRelationList, err := antiddosService.DescribeListPacketFilterConfig(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	packetFilters := make([]map[string]interface{}, 0)
 	for _, packetFilterRelation := range packetFilterRelationList {
@@ -868,14 +878,14 @@ func resourceTencentCloudDayuDdosPolicyV2Read(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudDayuDdosPolicyV2Update(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuDdosPolicyV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_ddos_policy_v2.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	antiddosService := AntiddosService{client: meta.(*TencentCloudClient).apiV3Conn}
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 2 {
-		return fmt.Errorf("broken ID of DDoS policy")
+		return diag.Errorf("broken ID of DDoS policy")
 	}
 	resourceId := items[0]
 	business := items[1]
@@ -885,14 +895,14 @@ func resourceTencentCloudDayuDdosPolicyV2Update(d *schema.ResourceData, meta int
 		ddosLevel := d.Get("ddos_level").(string)
 		err := antiddosService.ModifyDDoSLevel(ctx, business, resourceId, ddosLevel)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if d.HasChange("ddos_threshold") {
 		threshold := d.Get("ddos_threshold").(int)
 		err := antiddosService.ModifyDDoSThreshold(ctx, business, resourceId, threshold)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -912,7 +922,7 @@ func resourceTencentCloudDayuDdosPolicyV2Update(d *schema.ResourceData, meta int
 			}
 			err := antiddosService.CreateProtocolBlockConfig(ctx, resourceId, tmpProtocolBlockConfig)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -944,7 +954,7 @@ func resourceTencentCloudDayuDdosPolicyV2Update(d *schema.ResourceData, meta int
 			}
 			err := antiddosService.CreateDDoSConnectLimit(ctx, resourceId, tmpConnectLimitConfig)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -952,7 +962,7 @@ func resourceTencentCloudDayuDdosPolicyV2Update(d *schema.ResourceData, meta int
 	if d.HasChange("ddos_ai") {
 		err := antiddosService.CreateDDoSAI(ctx, resourceId, d.Get("ddos_ai").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -962,7 +972,7 @@ func resourceTencentCloudDayuDdosPolicyV2Update(d *schema.ResourceData, meta int
 		oldUniqIpMap := make(map[string]int)
 		oldBlackWhiteIps, err := antiddosService.DescribeListBlackWhiteIpList(ctx, resourceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		for _, oldBlackWhiteIp := range oldBlackWhiteIps {
 			ip := oldBlackWhiteIp.Ip
@@ -978,7 +988,7 @@ func resourceTencentCloudDayuDdosPolicyV2Update(d *schema.ResourceData, meta int
 			if oldUniqIpMap["key"] == 0 {
 				err := antiddosService.CreateDDoSBlackWhiteIpList(ctx, resourceId, []string{blackWhiteIp["ip"].(string)}, blackWhiteIp["ip_type"].(string))
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -996,7 +1006,7 @@ func resourceTencentCloudDayuDdosPolicyV2Update(d *schema.ResourceData, meta int
 	if d.HasChange("acls") {
 		oldAclConfigRelationList, err := antiddosService.DescribeListPortAclList(ctx, resourceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		oldAclConfigs := make([]interface{}, 0)
 		for _, oldAclConfigRelation := range oldAclConfigRelationList {
@@ -1030,7 +1040,7 @@ func resourceTencentCloudDayuDdosPolicyV2Update(d *schema.ResourceData, meta int
 			_ = json.Unmarshal([]byte(decrementItem), &decrement)
 			err := antiddosService.DeletePortAclConfig(ctx, resourceId, decrement)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		for _, incrementItems := range increments {
@@ -1038,7 +1048,7 @@ func resourceTencentCloudDayuDdosPolicyV2Update(d *schema.ResourceData, meta int
 			_ = json.Unmarshal([]byte(incrementItems), &increment)
 			err := antiddosService.CreatePortAclConfig(ctx, resourceId, increment)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -1047,7 +1057,7 @@ func resourceTencentCloudDayuDdosPolicyV2Update(d *schema.ResourceData, meta int
 	if d.HasChange("ddos_geo_ip_block_config") {
 		oldDDoSGeoIPBlockConfigRelations, err := antiddosService.DescribeListDDoSGeoIPBlockConfig(ctx, resourceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		oldDDoSGeoIPBlockConfigs := make([]interface{}, 0)
@@ -1080,7 +1090,7 @@ func resourceTencentCloudDayuDdosPolicyV2Update(d *schema.ResourceData, meta int
 			_ = json.Unmarshal([]byte(decrementItem), &decrement)
 			err := antiddosService.DeleteDDoSGeoIPBlockConfig(ctx, resourceId, decrement)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		for _, incrementItems := range increments {
@@ -1088,7 +1098,7 @@ func resourceTencentCloudDayuDdosPolicyV2Update(d *schema.ResourceData, meta int
 			_ = json.Unmarshal([]byte(incrementItems), &increment)
 			err := antiddosService.CreateDDoSGeoIPBlockConfig(ctx, resourceId, increment)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -1097,7 +1107,7 @@ func resourceTencentCloudDayuDdosPolicyV2Update(d *schema.ResourceData, meta int
 	if d.HasChange("ddos_speed_limit_config") {
 		oldDDoSSpeedLimitConfigRelations, err := antiddosService.DescribeListDDoSSpeedLimitConfig(ctx, resourceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		oldDDoSSpeedLimitConfigs := make([]interface{}, 0)
@@ -1139,7 +1149,7 @@ func resourceTencentCloudDayuDdosPolicyV2Update(d *schema.ResourceData, meta int
 			_ = json.Unmarshal([]byte(decrementItem), &decrement)
 			err := antiddosService.DeleteDDoSSpeedLimitConfig(ctx, resourceId, decrement)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		for _, incrementItems := range increments {
@@ -1147,7 +1157,7 @@ func resourceTencentCloudDayuDdosPolicyV2Update(d *schema.ResourceData, meta int
 			_ = json.Unmarshal([]byte(incrementItems), &increment)
 			err := antiddosService.CreateDDoSSpeedLimitConfig(ctx, resourceId, increment)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -1156,7 +1166,7 @@ func resourceTencentCloudDayuDdosPolicyV2Update(d *schema.ResourceData, meta int
 	if d.HasChange("packet_filters") {
 		oldPacketFilterRelationList, err := antiddosService.DescribeListPacketFilterConfig(ctx, resourceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		oldPacketFilters := make([]interface{}, 0)
 		for _, packetFilterRelation := range oldPacketFilterRelationList {
@@ -1223,7 +1233,7 @@ func resourceTencentCloudDayuDdosPolicyV2Update(d *schema.ResourceData, meta int
 			_ = json.Unmarshal([]byte(decrementItem), &decrement)
 			err := antiddosService.DeletePacketFilterConfig(ctx, resourceId, decrement)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		for _, incrementItem := range increments {
@@ -1231,7 +1241,7 @@ func resourceTencentCloudDayuDdosPolicyV2Update(d *schema.ResourceData, meta int
 			_ = json.Unmarshal([]byte(incrementItem), &increment)
 			err := antiddosService.CreatePacketFilterConfig(ctx, resourceId, increment)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -1239,17 +1249,17 @@ func resourceTencentCloudDayuDdosPolicyV2Update(d *schema.ResourceData, meta int
 
 	d.Partial(false)
 
-	return resourceTencentCloudDayuDdosPolicyV2Read(d, meta)
+	return resourceTencentCloudDayuDdosPolicyV2Read(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuDdosPolicyV2Delete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuDdosPolicyV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_ddos_policy_v2.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	antiddosService := AntiddosService{client: meta.(*TencentCloudClient).apiV3Conn}
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 2 {
-		return fmt.Errorf("broken ID of DDoS policy")
+		return diag.Errorf("broken ID of DDoS policy")
 	}
 	resourceId := items[0]
 	business := items[1]
@@ -1259,7 +1269,7 @@ func resourceTencentCloudDayuDdosPolicyV2Delete(d *schema.ResourceData, meta int
 
 	blackWhiteIpRelationList, err := antiddosService.DescribeListBlackWhiteIpList(ctx, resourceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	blacks := make([]string, 0)
 	whites := make([]string, 0)
@@ -1282,7 +1292,7 @@ func resourceTencentCloudDayuDdosPolicyV2Delete(d *schema.ResourceData, meta int
 
 	aclConfigRelationList, err := antiddosService.DescribeListPortAclList(ctx, resourceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, aclConfigRelation := range aclConfigRelationList {
 		deleteAclConfigRelation := aclConfigRelation
@@ -1295,7 +1305,7 @@ func resourceTencentCloudDayuDdosPolicyV2Delete(d *schema.ResourceData, meta int
 
 	ddosGeoIPBlockConfigRelations, err := antiddosService.DescribeListDDoSGeoIPBlockConfig(ctx, resourceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, ddosGeoIPBlockConfigRelation := range ddosGeoIPBlockConfigRelations {
 		_ = antiddosService.DeleteDDoSGeoIPBlockConfig(ctx, resourceId, *ddosGeoIPBlockConfigRelation.GeoIPBlockConfig)
@@ -1303,7 +1313,7 @@ func resourceTencentCloudDayuDdosPolicyV2Delete(d *schema.ResourceData, meta int
 
 	ddosSpeedLimitConfigRelations, err := antiddosService.DescribeListDDoSSpeedLimitConfig(ctx, resourceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, ddosSpeedLimitConfigRelation := range ddosSpeedLimitConfigRelations {
 		_ = antiddosService.DeleteDDoSSpeedLimitConfig(ctx, resourceId, *ddosSpeedLimitConfigRelation.SpeedLimitConfig)
@@ -1312,7 +1322,7 @@ func resourceTencentCloudDayuDdosPolicyV2Delete(d *schema.ResourceData, meta int
 
 	packetFilterRelationList, err := antiddosService.DescribeListPacketFilterConfig(ctx, resourceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	for _, packetFilterRelation := range packetFilterRelationList {
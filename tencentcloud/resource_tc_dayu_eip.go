@@ -12,22 +12,33 @@ resource "tencentcloud_dayu_eip" "test" {
   bind_resource_type = "cvm"
 }
 ```
+
+Import
+
+Dayu eip can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_dayu_eip.test bgpip-0000011x#154.8.140.133
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudDayuEip() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuEipCreate,
-		Read:   resourceTencentCloudDayuEipRead,
-		Delete: resourceTencentCloudDayuEipDelete,
+		CreateContext: resourceTencentCloudDayuEipCreate,
+		ReadContext:   resourceTencentCloudDayuEipRead,
+		DeleteContext: resourceTencentCloudDayuEipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -110,10 +121,10 @@ func resourceTencentCloudDayuEip() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDayuEipCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuEipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_l4_rule.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	antiddosService := AntiddosService{client: meta.(*TencentCloudClient).apiV3Conn}
 	bindResourceType := d.Get("bind_resource_type").(string)
@@ -124,44 +135,44 @@ func resourceTencentCloudDayuEipCreate(d *schema.ResourceData, meta interface{})
 	if bindResourceType == DDOS_EIP_BIND_RESOURCE_TYPE_CLB {
 		err := antiddosService.AssociateDDoSEipLoadBalancer(ctx, resourceId, eip, bindResourceId, bindResourceRegion)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if bindResourceType == DDOS_EIP_BIND_RESOURCE_TYPE_CVM {
 		err := antiddosService.AssociateDDoSEipAddress(ctx, resourceId, eip, bindResourceId, bindResourceRegion)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	for {
 		bgpIPInstances, err := antiddosService.DescribeListBGPIPInstances(ctx, resourceId, DDOS_EIP_BIND_STATUS, 0, 10)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if len(bgpIPInstances) != 0 {
 			break
 		}
 	}
-	d.SetId(resourceId + FILED_SP + eip)
-	return resourceTencentCloudDayuEipRead(d, meta)
+	d.SetId(helper.IdFormat(resourceId, eip))
+	return resourceTencentCloudDayuEipRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuEipRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuEipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_l4_rule.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	items := strings.Split(d.Id(), FILED_SP)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	items := helper.IdParse(d.Id())
 	if len(items) < 2 {
-		return fmt.Errorf("broken ID of dayu eip.")
+		return diag.Errorf("broken ID of dayu eip.")
 	}
 	resourceId := items[0]
 	antiddosService := AntiddosService{client: meta.(*TencentCloudClient).apiV3Conn}
 	bgpIPInstances, err := antiddosService.DescribeListBGPIPInstances(ctx, resourceId, DDOS_EIP_BIND_STATUS, 0, 10)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(bgpIPInstances) != 0 {
 		posBGPIPInstance := bgpIPInstances[0]
@@ -179,21 +190,21 @@ func resourceTencentCloudDayuEipRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceTencentCloudDayuEipDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuEipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_l4_rule.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	items := strings.Split(d.Id(), FILED_SP)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	items := helper.IdParse(d.Id())
 	if len(items) < 2 {
-		return fmt.Errorf("broken ID of dayu eip.")
+		return diag.Errorf("broken ID of dayu eip.")
 	}
 	resourceId := items[0]
 	eip := items[1]
 	antiddosService := AntiddosService{client: meta.(*TencentCloudClient).apiV3Conn}
 	err := antiddosService.DisassociateDDoSEipAddress(ctx, resourceId, eip)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 var testDayuEipResourceName = "tencentcloud_dayu_eip"
@@ -32,6 +32,18 @@ func TestAccTencentCloudDayuEipResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testDayuEipResourceKey, "resource_region", "ap-hongkong"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_dayu_eip.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"resource_id",
+					"eip",
+					"bind_resource_id",
+					"bind_resource_region",
+					"bind_resource_type",
+				},
+			},
 		},
 	})
 }
@@ -44,7 +56,7 @@ func testAccCheckDayuEipDestroy(s *terraform.State) error {
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 2 {
 			return fmt.Errorf("broken ID of eip")
 		}
@@ -78,7 +90,7 @@ func testAccCheckDayuEipExists(n string) resource.TestCheckFunc {
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 2 {
 			return fmt.Errorf("broken ID of eip")
 		}
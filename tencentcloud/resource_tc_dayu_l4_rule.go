@@ -32,6 +32,14 @@ resource "tencentcloud_dayu_l4_rule" "test_rule" {
   }
 }
 ```
+
+Import
+
+Dayu layer 4 rule can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_dayu_l4_rule.test_rule bgpip#bgpip-00000294#rule-kgkkd6uw
+```
 */
 package tencentcloud
 
@@ -39,8 +47,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dayu "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dayu/v20180709"
@@ -49,10 +58,18 @@ import (
 
 func resourceTencentCloudDayuL4Rule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuL4RuleCreate,
-		Read:   resourceTencentCloudDayuL4RuleRead,
-		Update: resourceTencentCloudDayuL4RuleUpdate,
-		Delete: resourceTencentCloudDayuL4RuleDelete,
+		CreateContext: resourceTencentCloudDayuL4RuleCreate,
+		ReadContext:   resourceTencentCloudDayuL4RuleRead,
+		UpdateContext: resourceTencentCloudDayuL4RuleUpdate,
+		DeleteContext: resourceTencentCloudDayuL4RuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -183,11 +200,11 @@ func resourceTencentCloudDayuL4Rule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDayuL4RuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuL4RuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_l4_rule.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Get("resource_id").(string)
 	resourceType := d.Get("resource_type").(string)
@@ -200,13 +217,13 @@ func resourceTencentCloudDayuL4RuleCreate(d *schema.ResourceData, meta interface
 	sourceList := d.Get("source_list").(*schema.Set).List()
 
 	if source_type == DAYU_L7_RULE_SOURCE_TYPE_HOST && protocol != DAYU_L4_RULE_PROTOCOL_TCP {
-		return fmt.Errorf("`protocol` can only be set with `TCP` when `source_type` is 1(host source).")
+		return diag.Errorf("`protocol` can only be set with `TCP` when `source_type` is 1(host source).")
 	}
 
 	healthCheckSwitch := d.Get("health_check_switch").(bool)
 	if healthCheckSwitch {
 		if len(sourceList) <= 1 {
-			return fmt.Errorf("The `health_check_switch` cannot be set `true` when `source_list` has only one item.")
+			return diag.Errorf("The `health_check_switch` cannot be set `true` when `source_list` has only one item.")
 		}
 	}
 
@@ -222,7 +239,7 @@ func resourceTencentCloudDayuL4RuleCreate(d *schema.ResourceData, meta interface
 
 	if timeout > 0 && interval > 0 {
 		if timeout > interval {
-			return fmt.Errorf("The `health_check_interval` should be greater than `health_check_timeout`.")
+			return diag.Errorf("The `health_check_interval` should be greater than `health_check_timeout`.")
 		}
 	}
 
@@ -249,7 +266,7 @@ func resourceTencentCloudDayuL4RuleCreate(d *schema.ResourceData, meta interface
 	dayuService := DayuService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	ruleId := ""
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := dayuService.CreateL4Rule(ctx, resourceType, resourceId, rule)
 		if e != nil {
 			return retryError(e)
@@ -259,10 +276,10 @@ func resourceTencentCloudDayuL4RuleCreate(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(resourceType + FILED_SP + resourceId + FILED_SP + ruleId)
+	d.SetId(helper.IdFormat(resourceType, resourceId, ruleId))
 
 	//set health check
 	var healthCheck dayu.L4HealthConfig
@@ -274,7 +291,7 @@ func resourceTencentCloudDayuL4RuleCreate(d *schema.ResourceData, meta interface
 	healthCheck.TimeOut = helper.IntUint64(d.Get("health_check_timeout").(int))
 	healthCheck.VirtualPort = helper.IntUint64(destPort)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		e := dayuService.SetL4Health(ctx, resourceType, resourceId, healthCheck)
 		if e != nil {
 			return retryError(e)
@@ -283,7 +300,7 @@ func resourceTencentCloudDayuL4RuleCreate(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//set session
@@ -293,10 +310,10 @@ func resourceTencentCloudDayuL4RuleCreate(d *schema.ResourceData, meta interface
 		sessionTime = v.(int)
 	}
 	if sessionTime == 0 && sessionFlag {
-		return fmt.Errorf("`session_time` should be set when `session_switch` is true.")
+		return diag.Errorf("`session_time` should be set when `session_switch` is true.")
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		e := dayuService.SetSession(ctx, resourceType, resourceId, ruleId, sessionFlag, sessionTime)
 		if e != nil {
 			return retryError(e)
@@ -305,21 +322,21 @@ func resourceTencentCloudDayuL4RuleCreate(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudDayuL4RuleRead(d, meta)
+	return resourceTencentCloudDayuL4RuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuL4RuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuL4RuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_l4_rule.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 3 {
-		return fmt.Errorf("broken ID of dayu L4 rule")
+		return diag.Errorf("broken ID of dayu L4 rule")
 	}
 	resourceType := items[0]
 	resourceId := items[1]
@@ -331,7 +348,7 @@ func resourceTencentCloudDayuL4RuleUpdate(d *schema.ResourceData, meta interface
 	protocol := d.Get("protocol").(string)
 	//check
 	if sourceType == 1 && protocol != DAYU_L4_RULE_PROTOCOL_TCP {
-		return fmt.Errorf("`protocol` can only be set with `TCP` when `source_type` is 1(host source).")
+		return diag.Errorf("`protocol` can only be set with `TCP` when `source_type` is 1(host source).")
 	}
 	sourceList := d.Get("source_list").(*schema.Set).List()
 
@@ -347,7 +364,7 @@ func resourceTencentCloudDayuL4RuleUpdate(d *schema.ResourceData, meta interface
 
 	if timeout > 0 && interval > 0 {
 		if timeout > interval {
-			return fmt.Errorf("The `health_check_interval` should be greater than `health_check_timeout`.")
+			return diag.Errorf("The `health_check_interval` should be greater than `health_check_timeout`.")
 		}
 	}
 
@@ -384,7 +401,7 @@ func resourceTencentCloudDayuL4RuleUpdate(d *schema.ResourceData, meta interface
 			rule.SourceList = append(rule.SourceList, &l4RuleSource)
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			e := dayuService.ModifyL4Rule(ctx, resourceType, resourceId, rule)
 			if e != nil {
 				return retryError(e)
@@ -393,7 +410,7 @@ func resourceTencentCloudDayuL4RuleUpdate(d *schema.ResourceData, meta interface
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -409,7 +426,7 @@ func resourceTencentCloudDayuL4RuleUpdate(d *schema.ResourceData, meta interface
 	if healthFlag {
 		//set health check
 		if len(sourceList) <= 1 {
-			return fmt.Errorf("The `health_check_switch` cannot be set when `source_list` has only one item.")
+			return diag.Errorf("The `health_check_switch` cannot be set when `source_list` has only one item.")
 		}
 
 		var healthCheck dayu.L4HealthConfig
@@ -421,7 +438,7 @@ func resourceTencentCloudDayuL4RuleUpdate(d *schema.ResourceData, meta interface
 		healthCheck.TimeOut = helper.IntUint64(d.Get("health_check_timeout").(int))
 		healthCheck.VirtualPort = helper.IntUint64(d.Get("d_port").(int))
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			e := dayuService.SetL4Health(ctx, resourceType, resourceId, healthCheck)
 			if e != nil {
 				return retryError(e)
@@ -430,7 +447,7 @@ func resourceTencentCloudDayuL4RuleUpdate(d *schema.ResourceData, meta interface
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -442,10 +459,10 @@ func resourceTencentCloudDayuL4RuleUpdate(d *schema.ResourceData, meta interface
 			sessionTime = v.(int)
 		}
 		if sessionTime == 0 && sessionFlag {
-			return fmt.Errorf("`session_time` should be set when `session_switch` is true.")
+			return diag.Errorf("`session_time` should be set when `session_switch` is true.")
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			e := dayuService.SetSession(ctx, resourceType, resourceId, ruleId, sessionFlag, sessionTime)
 			if e != nil {
 				return retryError(e)
@@ -454,25 +471,25 @@ func resourceTencentCloudDayuL4RuleUpdate(d *schema.ResourceData, meta interface
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudDayuL4RuleRead(d, meta)
+	return resourceTencentCloudDayuL4RuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuL4RuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuL4RuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_l4_rule.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 3 {
-		return fmt.Errorf("broken ID of dayu L4 rule")
+		return diag.Errorf("broken ID of dayu L4 rule")
 	}
 	resourceType := items[0]
 	resourceId := items[1]
@@ -483,7 +500,7 @@ func resourceTencentCloudDayuL4RuleRead(d *schema.ResourceData, meta interface{}
 	//set rule
 	rule, health, has, err := dayuService.DescribeL4Rule(ctx, resourceType, resourceId, ruleId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			rule, health, has, err = dayuService.DescribeL4Rule(ctx, resourceType, resourceId, ruleId)
 			if err != nil {
 				return retryError(err)
@@ -492,7 +509,7 @@ func resourceTencentCloudDayuL4RuleRead(d *schema.ResourceData, meta interface{}
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -523,15 +540,15 @@ func resourceTencentCloudDayuL4RuleRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudDayuL4RuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuL4RuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_l4_rule.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 3 {
-		return fmt.Errorf("broken ID of L4 rule")
+		return diag.Errorf("broken ID of L4 rule")
 	}
 	resourceType := items[0]
 	resourceId := items[1]
@@ -539,7 +556,7 @@ func resourceTencentCloudDayuL4RuleDelete(d *schema.ResourceData, meta interface
 
 	dayuService := DayuService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := dayuService.DeleteL4Rule(ctx, resourceType, resourceId, ruleId)
 		if e != nil {
 			return retryError(e)
@@ -548,12 +565,12 @@ func resourceTencentCloudDayuL4RuleDelete(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, _, has, err := dayuService.DescribeL4Rule(ctx, resourceType, resourceId, ruleId)
 	if err != nil || has {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			_, _, has, err = dayuService.DescribeL4Rule(ctx, resourceType, resourceId, ruleId)
 			if err != nil {
 				return retryError(err)
@@ -568,11 +585,11 @@ func resourceTencentCloudDayuL4RuleDelete(d *schema.ResourceData, meta interface
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		return nil
 	} else {
-		return errors.New("delete CC policy fail, CC policy still exist from sdk")
+		return diag.FromErr(errors.New("delete CC policy fail, CC policy still exist from sdk"))
 	}
 }
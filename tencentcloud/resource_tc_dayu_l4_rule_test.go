@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 var testDayuL4RuleResourceName = "tencentcloud_dayu_l4_rule"
@@ -67,6 +67,12 @@ func TestAccTencentCloudDayuL4RuleResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testDayuL4RuleResourceKey, "session_time", "30"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_dayu_l4_rule.test_rule",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resource_id", "resource_type"},
+			},
 		},
 	})
 }
@@ -111,7 +117,7 @@ func testAccCheckDayuL4RuleDestroy(s *terraform.State) error {
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 3 {
 			return fmt.Errorf("broken ID of L4 rule")
 		}
@@ -146,7 +152,7 @@ func testAccCheckDayuL4RuleExists(n string) resource.TestCheckFunc {
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 3 {
 			return fmt.Errorf("broken ID of L4 rule")
 		}
@@ -32,26 +32,41 @@ resource "tencentcloud_dayu_l4_rule" "test_rule" {
   }
 }
 ```
+
+Import
+
+Dayu layer 4 rule v2 can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_dayu_l4_rule_v2.example bgpip#bgpip-000004xe#1.1.1.1#80
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dayu "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dayu/v20180709"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudDayuL4RuleV2() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuL4RuleCreateV2,
-		Read:   resourceTencentCloudDayuL4RuleReadV2,
-		Delete: resourceTencentCloudDayuL4RuleDeleteV2,
+		CreateContext: resourceTencentCloudDayuL4RuleCreateV2,
+		ReadContext:   resourceTencentCloudDayuL4RuleReadV2,
+		DeleteContext: resourceTencentCloudDayuL4RuleDeleteV2,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"business": {
@@ -163,11 +178,11 @@ func resourceTencentCloudDayuL4RuleV2() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDayuL4RuleCreateV2(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuL4RuleCreateV2(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_l4_rule_v2.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Get("resource_id").(string)
 	business := d.Get("business").(string)
@@ -179,24 +194,24 @@ func resourceTencentCloudDayuL4RuleCreateV2(d *schema.ResourceData, meta interfa
 	}
 	err := service.CreateNewL4Rules(ctx, business, resourceId, vpn, rules)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	virtualPortStr := strconv.Itoa(virtualPort)
-	d.SetId(business + FILED_SP + resourceId + FILED_SP + vpn + FILED_SP + virtualPortStr)
-	return resourceTencentCloudDayuL4RuleReadV2(d, meta)
+	d.SetId(helper.IdFormat(business, resourceId, vpn, virtualPortStr))
+	return resourceTencentCloudDayuL4RuleReadV2(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuL4RuleReadV2(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuL4RuleReadV2(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_l4_rule.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DayuService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 4 {
-		return fmt.Errorf("broken ID of dayu L4 rule")
+		return diag.Errorf("broken ID of dayu L4 rule")
 	}
 	business := items[0]
 	resourceId := items[1]
@@ -212,7 +227,7 @@ func resourceTencentCloudDayuL4RuleReadV2(d *schema.ResourceData, meta interface
 	extendParams["virtual_port"] = virtualPort
 
 	rules := make([]*dayu.NewL4RuleEntry, 0)
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, err := service.DescribeNewL4Rules(ctx, business, extendParams)
 
 		if err != nil {
@@ -223,7 +238,7 @@ func resourceTencentCloudDayuL4RuleReadV2(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	posRules := make([]dayu.NewL4RuleEntry, 0)
 	for _, rule := range rules {
@@ -237,19 +252,19 @@ func resourceTencentCloudDayuL4RuleReadV2(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudDayuL4RuleDeleteV2(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuL4RuleDeleteV2(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_l4_rule.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DayuService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 4 {
-		return fmt.Errorf("broken ID of dayu L4 rule")
+		return diag.Errorf("broken ID of dayu L4 rule")
 	}
 	business := items[0]
 	resourceId := items[1]
@@ -264,7 +279,7 @@ func resourceTencentCloudDayuL4RuleDeleteV2(d *schema.ResourceData, meta interfa
 	extendParams["virtual_port"] = virtualPort
 
 	rules := make([]*dayu.NewL4RuleEntry, 0)
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		result, err := service.DescribeNewL4Rules(ctx, business, extendParams)
 
 		if err != nil {
@@ -275,7 +290,7 @@ func resourceTencentCloudDayuL4RuleDeleteV2(d *schema.ResourceData, meta interfa
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var delRuleId string
 	if len(rules) > 0 {
@@ -285,7 +300,7 @@ func resourceTencentCloudDayuL4RuleDeleteV2(d *schema.ResourceData, meta interfa
 	}
 	err = service.DeleteNewL4Rules(ctx, business, resourceId, vpn, []string{delRuleId})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -5,11 +5,11 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 var testDayuL4RuleV2ResourceNameTCP = "tencentcloud_dayu_l4_rule_v2"
@@ -35,6 +35,12 @@ func TestAccTencentCloudDayuL4RuleV2Resource_TCP(t *testing.T) {
 					resource.TestCheckResourceAttr(testDayuL4RuleV2ResourceKeyTCP, "rules.0.source_port", "20"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_dayu_l4_rule_v2.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"business", "resource_id", "vpn", "virtual_port"},
+			},
 		},
 	})
 }
@@ -47,7 +53,7 @@ func testAccCheckDayuL4RuleV2Destroy(s *terraform.State) error {
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 4 {
 			return fmt.Errorf("broken ID of L4 rule")
 		}
@@ -84,7 +90,7 @@ func testAccCheckDayuL4RuleV2Exists(n string) resource.TestCheckFunc {
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 4 {
 			return fmt.Errorf("broken ID of L4 rule")
 		}
@@ -25,14 +25,23 @@ resource "tencentcloud_dayu_l7_rule" "test_rule" {
   health_check_unhealth_num = 10
 }
 ```
+
+Import
+
+Dayu layer 7 rule can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_dayu_l7_rule.test_rule bgpip#bgpip-00000294#rule-z4dmjzbk
+```
 */
 package tencentcloud
 
 import (
 	"context"
 	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dayu "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dayu/v20180709"
@@ -41,10 +50,18 @@ import (
 
 func resourceTencentCloudDayuL7Rule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuL7RuleCreate,
-		Read:   resourceTencentCloudDayuL7RuleRead,
-		Update: resourceTencentCloudDayuL7RuleUpdate,
-		Delete: resourceTencentCloudDayuL7RuleDelete,
+		CreateContext: resourceTencentCloudDayuL7RuleCreate,
+		ReadContext:   resourceTencentCloudDayuL7RuleRead,
+		UpdateContext: resourceTencentCloudDayuL7RuleUpdate,
+		DeleteContext: resourceTencentCloudDayuL7RuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -168,11 +185,11 @@ func resourceTencentCloudDayuL7Rule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDayuL7RuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuL7RuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_l7_rule.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Get("resource_id").(string)
 	resourceType := d.Get("resource_type").(string)
@@ -190,7 +207,7 @@ func resourceTencentCloudDayuL7RuleCreate(d *schema.ResourceData, meta interface
 			sslId = v.(string)
 		}
 		if sslId == "" {
-			return fmt.Errorf("`ssl_id` should be set when `protocol` is `https`.")
+			return diag.Errorf("`ssl_id` should be set when `protocol` is `https`.")
 		}
 		rule.SSLId = &sslId
 		rule.CertType = helper.IntUint64(2)
@@ -209,7 +226,7 @@ func resourceTencentCloudDayuL7RuleCreate(d *schema.ResourceData, meta interface
 	healthCheckSwitch := d.Get("health_check_switch").(bool)
 	if healthCheckSwitch {
 		if len(sourceList) <= 1 {
-			return fmt.Errorf("The `health_check_switch` cannot be set `true` when `source_list` has only one item.")
+			return diag.Errorf("The `health_check_switch` cannot be set `true` when `source_list` has only one item.")
 		}
 	}
 
@@ -223,7 +240,7 @@ func resourceTencentCloudDayuL7RuleCreate(d *schema.ResourceData, meta interface
 	dayuService := DayuService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	ruleId := ""
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := dayuService.CreateL7Rule(ctx, resourceType, resourceId, rule)
 		if e != nil {
 			return retryError(e)
@@ -233,17 +250,17 @@ func resourceTencentCloudDayuL7RuleCreate(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(resourceType + FILED_SP + resourceId + FILED_SP + ruleId)
+	d.SetId(helper.IdFormat(resourceType, resourceId, ruleId))
 
-	readyFlag, rErr := checkL7RuleStatus(meta, resourceType, resourceId, ruleId, "create")
+	readyFlag, rErr := checkL7RuleStatus(ctx, meta, resourceType, resourceId, ruleId, "create")
 	if rErr != nil {
-		return rErr
+		return diag.FromErr(rErr)
 	}
 	if !readyFlag {
-		return fmt.Errorf("Creating operation is timeout %s", ruleId)
+		return diag.Errorf("Creating operation is timeout %s", ruleId)
 	}
 
 	//set health check
@@ -258,7 +275,7 @@ func resourceTencentCloudDayuL7RuleCreate(d *schema.ResourceData, meta interface
 	healthCheck.AliveNum = helper.IntUint64(d.Get("health_check_health_num").(int))
 	healthCheck.StatusCode = helper.IntUint64(d.Get("health_check_code").(int))
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		e := dayuService.SetL7Health(ctx, resourceType, resourceId, healthCheck)
 		if e != nil {
 			return retryError(e)
@@ -267,21 +284,21 @@ func resourceTencentCloudDayuL7RuleCreate(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	readyFlag, rErr = checkL7RuleStatus(meta, resourceType, resourceId, ruleId, "check_health")
+	readyFlag, rErr = checkL7RuleStatus(ctx, meta, resourceType, resourceId, ruleId, "check_health")
 	if rErr != nil {
-		return rErr
+		return diag.FromErr(rErr)
 	}
 	if !readyFlag {
-		return fmt.Errorf("Set health is timeout %s", ruleId)
+		return diag.Errorf("Set health is timeout %s", ruleId)
 	}
 
 	//set switch
 	switchFlag := d.Get("switch").(bool)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		e := dayuService.SetRuleSwitch(ctx, resourceType, resourceId, ruleId, switchFlag, protocol)
 		if e != nil {
 			return retryError(e, InternalError)
@@ -290,30 +307,30 @@ func resourceTencentCloudDayuL7RuleCreate(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//check switch status
-	readyFlag, rErr = checkL7RuleStatus(meta, resourceType, resourceId, ruleId, fmt.Sprintf("check_switch_%t", switchFlag))
+	readyFlag, rErr = checkL7RuleStatus(ctx, meta, resourceType, resourceId, ruleId, fmt.Sprintf("check_switch_%t", switchFlag))
 	if rErr != nil {
-		return rErr
+		return diag.FromErr(rErr)
 	}
 	if !readyFlag {
-		return fmt.Errorf("Set switch is timeout %s", ruleId)
+		return diag.Errorf("Set switch is timeout %s", ruleId)
 	}
 
-	return resourceTencentCloudDayuL7RuleRead(d, meta)
+	return resourceTencentCloudDayuL7RuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuL7RuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuL7RuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_l7_rule.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 3 {
-		return fmt.Errorf("broken ID of dayu L7 rule")
+		return diag.Errorf("broken ID of dayu L7 rule")
 	}
 	resourceType := items[0]
 	resourceId := items[1]
@@ -346,7 +363,7 @@ func resourceTencentCloudDayuL7RuleUpdate(d *schema.ResourceData, meta interface
 				sslId = v.(string)
 			}
 			if sslId == "" {
-				return fmt.Errorf("`ssl_id` should be set when `protocol` is `https`.")
+				return diag.Errorf("`ssl_id` should be set when `protocol` is `https`.")
 			}
 			rule.SSLId = &sslId
 			rule.CertType = helper.IntUint64(2)
@@ -367,7 +384,7 @@ func resourceTencentCloudDayuL7RuleUpdate(d *schema.ResourceData, meta interface
 			rule.SourceList = append(rule.SourceList, &l4RuleSource)
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			e := dayuService.ModifyL7Rule(ctx, resourceType, resourceId, rule)
 			if e != nil {
 				return retryError(e)
@@ -376,15 +393,15 @@ func resourceTencentCloudDayuL7RuleUpdate(d *schema.ResourceData, meta interface
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		readyFlag, rErr := checkL7RuleStatus(meta, resourceType, resourceId, ruleId, "modify")
+		readyFlag, rErr := checkL7RuleStatus(ctx, meta, resourceType, resourceId, ruleId, "modify")
 		if rErr != nil {
-			return rErr
+			return diag.FromErr(rErr)
 		}
 		if !readyFlag {
-			return fmt.Errorf("Modify operation is timeout %s", ruleId)
+			return diag.Errorf("Modify operation is timeout %s", ruleId)
 		}
 
 	}
@@ -402,7 +419,7 @@ func resourceTencentCloudDayuL7RuleUpdate(d *schema.ResourceData, meta interface
 		//check
 		sourceList := d.Get("source_list").(*schema.Set).List()
 		if len(sourceList) <= 1 {
-			return fmt.Errorf("The `health_check_switch` cannot be set when `source_list` has only one item.")
+			return diag.Errorf("The `health_check_switch` cannot be set when `source_list` has only one item.")
 		}
 
 		//set health check
@@ -417,7 +434,7 @@ func resourceTencentCloudDayuL7RuleUpdate(d *schema.ResourceData, meta interface
 		healthCheck.AliveNum = helper.IntUint64(d.Get("health_check_health_num").(int))
 		healthCheck.StatusCode = helper.IntUint64(d.Get("health_check_code").(int))
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			e := dayuService.SetL7Health(ctx, resourceType, resourceId, healthCheck)
 			if e != nil {
 				return retryError(e)
@@ -426,15 +443,15 @@ func resourceTencentCloudDayuL7RuleUpdate(d *schema.ResourceData, meta interface
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		readyFlag, rErr := checkL7RuleStatus(meta, resourceType, resourceId, ruleId, "check_health")
+		readyFlag, rErr := checkL7RuleStatus(ctx, meta, resourceType, resourceId, ruleId, "check_health")
 		if rErr != nil {
-			return rErr
+			return diag.FromErr(rErr)
 		}
 		if !readyFlag {
-			return fmt.Errorf("Set health is timeout %s", ruleId)
+			return diag.Errorf("Set health is timeout %s", ruleId)
 		}
 	}
 
@@ -457,7 +474,7 @@ func resourceTencentCloudDayuL7RuleUpdate(d *schema.ResourceData, meta interface
 		}
 		if protocol != "" {
 
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				e := dayuService.SetRuleSwitch(ctx, resourceType, resourceId, ruleId, switchFlag, protocol)
 				if e != nil {
 					return retryError(e, InternalError)
@@ -466,16 +483,16 @@ func resourceTencentCloudDayuL7RuleUpdate(d *schema.ResourceData, meta interface
 			})
 
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			//check switch status
-			readyFlag, rErr := checkL7RuleStatus(meta, resourceType, resourceId, ruleId, fmt.Sprintf("check_switch_%t", switchFlag))
+			readyFlag, rErr := checkL7RuleStatus(ctx, meta, resourceType, resourceId, ruleId, fmt.Sprintf("check_switch_%t", switchFlag))
 			if rErr != nil {
-				return rErr
+				return diag.FromErr(rErr)
 			}
 			if !readyFlag {
-				return fmt.Errorf("Set switch is timeout %s", ruleId)
+				return diag.Errorf("Set switch is timeout %s", ruleId)
 			}
 		}
 
@@ -483,19 +500,19 @@ func resourceTencentCloudDayuL7RuleUpdate(d *schema.ResourceData, meta interface
 
 	d.Partial(false)
 
-	return resourceTencentCloudDayuL7RuleRead(d, meta)
+	return resourceTencentCloudDayuL7RuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuL7RuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuL7RuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_l7_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 3 {
-		return fmt.Errorf("broken ID of dayu L7 rule")
+		return diag.Errorf("broken ID of dayu L7 rule")
 	}
 	resourceType := items[0]
 	resourceId := items[1]
@@ -506,7 +523,7 @@ func resourceTencentCloudDayuL7RuleRead(d *schema.ResourceData, meta interface{}
 	//set rule
 	rule, health, has, err := dayuService.DescribeL7Rule(ctx, resourceType, resourceId, ruleId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			rule, health, has, err = dayuService.DescribeL7Rule(ctx, resourceType, resourceId, ruleId)
 			if err != nil {
 				return retryError(err)
@@ -515,12 +532,14 @@ func resourceTencentCloudDayuL7RuleRead(d *schema.ResourceData, meta interface{}
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
 		return nil
 	}
+	_ = d.Set("resource_type", resourceType)
+	_ = d.Set("resource_id", resourceId)
 	_ = d.Set("protocol", rule.Protocol)
 	_ = d.Set("domain", rule.Domain)
 	_ = d.Set("rule_id", rule.RuleId)
@@ -556,15 +575,15 @@ func resourceTencentCloudDayuL7RuleRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudDayuL7RuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuL7RuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_l7_rule.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 3 {
-		return fmt.Errorf("broken ID of L7 rule")
+		return diag.Errorf("broken ID of L7 rule")
 	}
 	resourceType := items[0]
 	resourceId := items[1]
@@ -572,7 +591,7 @@ func resourceTencentCloudDayuL7RuleDelete(d *schema.ResourceData, meta interface
 
 	dayuService := DayuService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := dayuService.DeleteL7Rule(ctx, resourceType, resourceId, ruleId)
 		if e != nil {
 			return retryError(e)
@@ -581,29 +600,29 @@ func resourceTencentCloudDayuL7RuleDelete(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	readyFlag, rErr := checkL7RuleStatus(meta, resourceType, resourceId, ruleId, "delete")
+	readyFlag, rErr := checkL7RuleStatus(ctx, meta, resourceType, resourceId, ruleId, "delete")
 	if rErr != nil {
-		return rErr
+		return diag.FromErr(rErr)
 	}
 	if !readyFlag {
-		return fmt.Errorf("Delete is timeout %s", ruleId)
+		return diag.Errorf("Delete is timeout %s", ruleId)
 	}
 
 	return nil
 }
 
-func checkL7RuleStatus(meta interface{}, resourceType string, resourceId string, ruleId string, checkType string) (status bool, errRrt error) {
+func checkL7RuleStatus(ctx context.Context, meta interface{}, resourceType string, resourceId string, ruleId string, checkType string) (status bool, errRrt error) {
 	defer logElapsed("resource.tencentcloud_dayu_l7_rule.check_status")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	dayuService := DayuService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		sRule, health, has, err := dayuService.DescribeL7Rule(ctx, resourceType, resourceId, ruleId)
 		if err != nil {
 			return retryError(err)
@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 var testDayuL7RuleResourceName = "tencentcloud_dayu_l7_rule"
@@ -57,6 +57,11 @@ func TestAccTencentCloudDayuL7RuleResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testDayuL7RuleResourceKey, "protocol", "http"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_dayu_l7_rule.test_rule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -69,7 +74,7 @@ func testAccCheckDayuL7RuleDestroy(s *terraform.State) error {
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 3 {
 			return fmt.Errorf("broken ID of L7 rule")
 		}
@@ -104,7 +109,7 @@ func testAccCheckDayuL7RuleExists(n string) resource.TestCheckFunc {
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 3 {
 			return fmt.Errorf("broken ID of L7 rule")
 		}
@@ -28,25 +28,41 @@ resource "tencentcloud_dayu_l7_rule_v2" "tencentcloud_dayu_l7_rule_v2" {
   }
 }
 ```
+
+Import
+
+Dayu layer 7 rule v2 can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_dayu_l7_rule_v2.tencentcloud_dayu_l7_rule_v2 bgpip#github.com#https
+```
 */
 package tencentcloud
 
 import (
 	"context"
 	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dayu "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dayu/v20180709"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudDayuL7RuleV2() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuL7RuleCreateV2,
-		Read:   resourceTencentCloudDayuL7RuleReadV2,
-		Update: resourceTencentCloudDayuL7RuleUpdateV2,
-		Delete: resourceTencentCloudDayuL7RuleDeleteV2,
+		CreateContext: resourceTencentCloudDayuL7RuleCreateV2,
+		ReadContext:   resourceTencentCloudDayuL7RuleReadV2,
+		UpdateContext: resourceTencentCloudDayuL7RuleUpdateV2,
+		DeleteContext: resourceTencentCloudDayuL7RuleDeleteV2,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -153,11 +169,11 @@ func resourceTencentCloudDayuL7RuleV2() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDayuL7RuleCreateV2(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuL7RuleCreateV2(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_l7_rule.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Get("resource_id").(string)
 	resourceIp := d.Get("resource_ip").(string)
@@ -169,22 +185,22 @@ func resourceTencentCloudDayuL7RuleCreateV2(d *schema.ResourceData, meta interfa
 	dayuService := DayuService{client: meta.(*TencentCloudClient).apiV3Conn}
 	err := dayuService.CreateL7RuleV2(ctx, resourceType, resourceId, resourceIp, rule)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	d.SetId(resourceType + FILED_SP + domain + FILED_SP + protocol)
-	return resourceTencentCloudDayuL7RuleReadV2(d, meta)
+	d.SetId(helper.IdFormat(resourceType, domain, protocol))
+	return resourceTencentCloudDayuL7RuleReadV2(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuL7RuleUpdateV2(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuL7RuleUpdateV2(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_l7_rule.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	dayuService := DayuService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 3 {
-		return fmt.Errorf("broken ID of dayu L7 rule")
+		return diag.Errorf("broken ID of dayu L7 rule")
 	}
 	business := items[0]
 	domain := items[1]
@@ -194,11 +210,11 @@ func resourceTencentCloudDayuL7RuleUpdateV2(d *schema.ResourceData, meta interfa
 	extendParams["protocol"] = protocol
 	rules, _, err := dayuService.DescribeL7RulesV2(ctx, business, extendParams)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(rules) == 0 {
 		err := fmt.Errorf("Create l7 rule failed.")
-		return err
+		return diag.FromErr(err)
 	}
 	ruleItem := rules[0]
 	resourceId := *ruleItem.Id
@@ -255,22 +271,22 @@ func resourceTencentCloudDayuL7RuleUpdateV2(d *schema.ResourceData, meta interfa
 
 	err = dayuService.ModifyL7RuleV2(ctx, business, resourceId, ruleItem)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	d.SetId(business + FILED_SP + domain + FILED_SP + protocol)
-	return resourceTencentCloudDayuL7RuleReadV2(d, meta)
+	d.SetId(helper.IdFormat(business, domain, protocol))
+	return resourceTencentCloudDayuL7RuleReadV2(ctx, d, meta)
 }
 
-func resourceTencentCloudDayuL7RuleReadV2(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuL7RuleReadV2(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_l7_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 3 {
-		return fmt.Errorf("broken ID of dayu L7 rule")
+		return diag.Errorf("broken ID of dayu L7 rule")
 	}
 	business := items[0]
 	domain := items[1]
@@ -282,11 +298,11 @@ func resourceTencentCloudDayuL7RuleReadV2(d *schema.ResourceData, meta interface
 	for {
 		rules, _, err := dayuService.DescribeL7RulesV2(ctx, business, extendParams)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if len(rules) == 0 {
 			err := fmt.Errorf("Create l7 rule failed.")
-			return err
+			return diag.FromErr(err)
 		}
 		if *rules[0].Status == uint64(0) {
 			_ = d.Set("resource_id", *rules[0].Id)
@@ -298,15 +314,15 @@ func resourceTencentCloudDayuL7RuleReadV2(d *schema.ResourceData, meta interface
 	}
 }
 
-func resourceTencentCloudDayuL7RuleDeleteV2(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDayuL7RuleDeleteV2(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dayu_l7_rule.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) < 3 {
-		return fmt.Errorf("broken ID of L7 rule")
+		return diag.Errorf("broken ID of L7 rule")
 	}
 	business := items[0]
 	domain := items[1]
@@ -318,17 +334,17 @@ func resourceTencentCloudDayuL7RuleDeleteV2(d *schema.ResourceData, meta interfa
 	extendParams["protocol"] = protocol
 	rules, _, err := dayuService.DescribeL7RulesV2(ctx, business, extendParams)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(rules) == 0 {
 		err := fmt.Errorf("Create l7 rule failed.")
-		return err
+		return diag.FromErr(err)
 	}
 	ruleItem := rules[0]
 	resourceId := *ruleItem.Id
 	resourceIp := *ruleItem.Ip
 	ruleId := *ruleItem.RuleId
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := dayuService.DeleteL7RulesV2(ctx, business, resourceId, resourceIp, ruleId)
 		if e != nil {
 			return retryError(e)
@@ -337,7 +353,7 @@ func resourceTencentCloudDayuL7RuleDeleteV2(d *schema.ResourceData, meta interfa
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 var testDayuL7RuleV2ResourceName = "tencentcloud_dayu_l7_rule_v2"
@@ -30,6 +30,12 @@ func TestAccTencentCloudDayuL7RuleV2Resource(t *testing.T) {
 					resource.TestCheckResourceAttr(testDayuL7RuleV2ResourceKey, "resource_ip", "119.28.217.162"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_dayu_l7_rule_v2.test_rule",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resource_ip", "resource_type", "rule"},
+			},
 		},
 	})
 }
@@ -41,7 +47,7 @@ func testAccCheckDayuL7RuleV2Destroy(s *terraform.State) error {
 		}
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 3 {
 			return fmt.Errorf("broken ID of dayu L7 rule")
 		}
@@ -89,7 +95,7 @@ func testAccCheckDayuL7RuleV2Exists(n string) resource.TestCheckFunc {
 		logId := getLogId(contextNil)
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 3 {
 			return fmt.Errorf("broken ID of dayu L7 rule")
 		}
@@ -18,11 +18,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -31,9 +30,12 @@ import (
 
 func resourceTencentCloudDbbrainDbDiagReportTask() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDbbrainDbDiagReportTaskCreate,
-		Read:   resourceTencentCloudDbbrainDbDiagReportTaskRead,
-		Delete: resourceTencentCloudDbbrainDbDiagReportTaskDelete,
+		CreateContext: resourceTencentCloudDbbrainDbDiagReportTaskCreate,
+		ReadContext:   resourceTencentCloudDbbrainDbDiagReportTaskRead,
+		DeleteContext: resourceTencentCloudDbbrainDbDiagReportTaskDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		// contact_group, contact_person, send_mail_flag and product fileds can not query by read api
 		// Importer: &schema.ResourceImporter{
 		// 	State: schema.ImportStatePassthrough,
@@ -97,7 +99,7 @@ func resourceTencentCloudDbbrainDbDiagReportTask() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDbbrainDbDiagReportTaskCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDbbrainDbDiagReportTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dbbrain_db_diag_report_task.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -147,8 +149,8 @@ func resourceTencentCloudDbbrainDbDiagReportTaskCreate(d *schema.ResourceData, m
 		request.Product = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDbbrainClient().CreateDBDiagReportTask(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDbbrainClient().CreateDBDiagReportTaskWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -159,39 +161,39 @@ func resourceTencentCloudDbbrainDbDiagReportTaskCreate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create dbbrain dbDiagReportTask failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	if response == nil || response.Response.AsyncRequestId == nil {
-		return fmt.Errorf("[CRITAL]%s The dbbrain dbDiagReportTask id not found after creation", logId)
+		return diag.Errorf("[CRITAL]%s The dbbrain dbDiagReportTask id not found after creation", logId)
 	}
 
 	asyncRequestId := response.Response.AsyncRequestId
-	d.SetId(helper.Int64ToStr(*asyncRequestId) + FILED_SP + instanceId + FILED_SP + product)
+	d.SetId(helper.IdFormat(helper.Int64ToStr(*asyncRequestId), instanceId, product))
 
 	service := DbbrainService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"100"}, 3*readRetryTimeout, time.Second, service.DbbrainDbDiagReportTaskStateRefreshFunc(asyncRequestId, instanceId, product, []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"100"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DbbrainDbDiagReportTaskStateRefreshFunc(asyncRequestId, instanceId, product, []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudDbbrainDbDiagReportTaskRead(d, meta)
+	return resourceTencentCloudDbbrainDbDiagReportTaskRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDbbrainDbDiagReportTaskRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDbbrainDbDiagReportTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dbbrain_db_diag_report_task.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DbbrainService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	asyncRequestId := idSplit[0]
 	instanceId := idSplit[1]
@@ -199,7 +201,7 @@ func resourceTencentCloudDbbrainDbDiagReportTaskRead(d *schema.ResourceData, met
 
 	dbDiagReportTask, err := service.DescribeDbbrainDbDiagReportTaskById(ctx, helper.StrToInt64Point(asyncRequestId), instanceId, product)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if dbDiagReportTask == nil {
@@ -235,24 +237,24 @@ func resourceTencentCloudDbbrainDbDiagReportTaskRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudDbbrainDbDiagReportTaskDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDbbrainDbDiagReportTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dbbrain_db_diag_report_task.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DbbrainService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	asyncRequestId := idSplit[0]
 	instanceId := idSplit[1]
 	product := idSplit[2]
 
 	if err := service.DeleteDbbrainDbDiagReportTaskById(ctx, *helper.StrToInt64Point(asyncRequestId), instanceId, product); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
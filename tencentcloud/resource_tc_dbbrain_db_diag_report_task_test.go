@@ -3,7 +3,6 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 	"time"
 
@@ -94,7 +93,7 @@ func testAccCheckDbbrainDbDiagReportTaskDestroy(s *terraform.State) error {
 			continue
 		}
 
-		ids := strings.Split(rs.Primary.ID, FILED_SP)
+		ids := helper.IdParse(rs.Primary.ID)
 		if len(ids) != 3 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
@@ -128,7 +127,7 @@ func testAccCheckDbbrainDbDiagReportTaskExists(re string) resource.TestCheckFunc
 			return fmt.Errorf("Dbbrain sql filter id is not set")
 		}
 
-		ids := strings.Split(rs.Primary.ID, FILED_SP)
+		ids := helper.IdParse(rs.Primary.ID)
 		if len(ids) != 3 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
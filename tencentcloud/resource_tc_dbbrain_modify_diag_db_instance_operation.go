@@ -29,8 +29,11 @@ resource "tencentcloud_dbbrain_modify_diag_db_instance_operation" "off" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -39,9 +42,12 @@ import (
 
 func resourceTencentCloudDbbrainModifyDiagDbInstanceOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDbbrainModifyDiagDbInstanceOperationCreate,
-		Read:   resourceTencentCloudDbbrainModifyDiagDbInstanceOperationRead,
-		Delete: resourceTencentCloudDbbrainModifyDiagDbInstanceOperationDelete,
+		CreateContext: resourceTencentCloudDbbrainModifyDiagDbInstanceOperationCreate,
+		ReadContext:   resourceTencentCloudDbbrainModifyDiagDbInstanceOperationRead,
+		DeleteContext: resourceTencentCloudDbbrainModifyDiagDbInstanceOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_confs": {
 				Required:    true,
@@ -93,7 +99,7 @@ func resourceTencentCloudDbbrainModifyDiagDbInstanceOperation() *schema.Resource
 	}
 }
 
-func resourceTencentCloudDbbrainModifyDiagDbInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDbbrainModifyDiagDbInstanceOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dbbrain_modify_diag_db_instance_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -131,8 +137,8 @@ func resourceTencentCloudDbbrainModifyDiagDbInstanceOperationCreate(d *schema.Re
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDbbrainClient().ModifyDiagDBInstanceConf(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDbbrainClient().ModifyDiagDBInstanceConfWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -142,23 +148,23 @@ func resourceTencentCloudDbbrainModifyDiagDbInstanceOperationCreate(d *schema.Re
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate dbbrain modifyDiagDbInstanceConf failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	operationId = helper.ResourceIdsHash([]string{*instanceConfs.DailyInspection, *instanceConfs.OverviewDisplay})
 	d.SetId(operationId)
 
-	return resourceTencentCloudDbbrainModifyDiagDbInstanceOperationRead(d, meta)
+	return resourceTencentCloudDbbrainModifyDiagDbInstanceOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDbbrainModifyDiagDbInstanceOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDbbrainModifyDiagDbInstanceOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dbbrain_modify_diag_db_instance_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudDbbrainModifyDiagDbInstanceOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDbbrainModifyDiagDbInstanceOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dbbrain_modify_diag_db_instance_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -19,10 +19,10 @@ package tencentcloud
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -31,9 +31,12 @@ import (
 
 func resourceTencentCloudDbbrainSecurityAuditLogExportTask() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudDbbrainSecurityAuditLogExportTaskRead,
-		Create: resourceTencentCloudDbbrainSecurityAuditLogExportTaskCreate,
-		Delete: resourceTencentCloudDbbrainSecurityAuditLogExportTaskDelete,
+		ReadContext:   resourceTencentCloudDbbrainSecurityAuditLogExportTaskRead,
+		CreateContext: resourceTencentCloudDbbrainSecurityAuditLogExportTaskCreate,
+		DeleteContext: resourceTencentCloudDbbrainSecurityAuditLogExportTaskDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		// Importer: &schema.ResourceImporter{
 		// 	State: schema.ImportStatePassthrough,
 		// },
@@ -85,7 +88,7 @@ func resourceTencentCloudDbbrainSecurityAuditLogExportTask() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDbbrainSecurityAuditLogExportTaskCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDbbrainSecurityAuditLogExportTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dbbrain_security_audit_log_export_task.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -95,10 +98,10 @@ func resourceTencentCloudDbbrainSecurityAuditLogExportTaskCreate(d *schema.Resou
 		request         = dbbrain.NewCreateSecurityAuditLogExportTaskRequest()
 		response        *dbbrain.CreateSecurityAuditLogExportTaskResponse
 		service         = DbbrainService{client: meta.(*TencentCloudClient).apiV3Conn}
-		ctx             = context.WithValue(context.TODO(), logIdKey, logId)
 		secAuditGroupId string
 		asyncRequestId  string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("sec_audit_group_id"); ok {
 		secAuditGroupId = v.(string)
@@ -125,8 +128,8 @@ func resourceTencentCloudDbbrainSecurityAuditLogExportTaskCreate(d *schema.Resou
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDbbrainClient().CreateSecurityAuditLogExportTask(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDbbrainClient().CreateSecurityAuditLogExportTaskWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -138,13 +141,13 @@ func resourceTencentCloudDbbrainSecurityAuditLogExportTaskCreate(d *schema.Resou
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create dbbrain securityAuditLogExportTask failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	asyncRequestId = helper.UInt64ToStr(*response.Response.AsyncRequestId)
-	d.SetId(secAuditGroupId + FILED_SP + asyncRequestId)
+	d.SetId(helper.IdFormat(secAuditGroupId, asyncRequestId))
 
-	err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		ret, err := service.DescribeDbbrainSecurityAuditLogExportTask(ctx, helper.String(secAuditGroupId), helper.String(asyncRequestId), nil)
 		if err != nil {
 			return retryError(err)
@@ -157,38 +160,38 @@ func resourceTencentCloudDbbrainSecurityAuditLogExportTaskCreate(d *schema.Resou
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s query dbbrain securityAuditLogExportTask failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudDbbrainSecurityAuditLogExportTaskRead(d, meta)
+	return resourceTencentCloudDbbrainSecurityAuditLogExportTaskRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDbbrainSecurityAuditLogExportTaskRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDbbrainSecurityAuditLogExportTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dbbrain_security_audit_log_export_task.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DbbrainService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	secAuditGroupId := helper.String(idSplit[0])
 	asyncRequestId := helper.String(idSplit[1])
 
 	securityAuditLogExportTask, err := service.DescribeDbbrainSecurityAuditLogExportTask(ctx, secAuditGroupId, asyncRequestId, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// _ = d.Set("sec_audit_group_id", secAuditGroupId)
 
 	if securityAuditLogExportTask == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `securityAuditLogExportTask` %s does not exist", d.Id())
+		return diag.Errorf("resource `securityAuditLogExportTask` %s does not exist", d.Id())
 	}
 
 	if securityAuditLogExportTask.LogStartTime != nil {
@@ -210,24 +213,24 @@ func resourceTencentCloudDbbrainSecurityAuditLogExportTaskRead(d *schema.Resourc
 	return nil
 }
 
-func resourceTencentCloudDbbrainSecurityAuditLogExportTaskDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDbbrainSecurityAuditLogExportTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dbbrain_security_audit_log_export_task.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DbbrainService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	secAuditGroupId := helper.String(idSplit[0])
 	asyncRequestId := helper.String(idSplit[1])
 
 	if err := service.DeleteDbbrainSecurityAuditLogExportTaskById(ctx, secAuditGroupId, asyncRequestId, nil); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
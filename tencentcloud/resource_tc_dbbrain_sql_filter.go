@@ -37,10 +37,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -49,10 +49,14 @@ import (
 
 func resourceTencentCloudDbbrainSqlFilter() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudDbbrainSqlFilterRead,
-		Create: resourceTencentCloudDbbrainSqlFilterCreate,
-		Update: resourceTencentCloudDbbrainSqlFilterUpdate,
-		Delete: resourceTencentCloudDbbrainSqlFilterDelete,
+		ReadContext:   resourceTencentCloudDbbrainSqlFilterRead,
+		CreateContext: resourceTencentCloudDbbrainSqlFilterCreate,
+		UpdateContext: resourceTencentCloudDbbrainSqlFilterUpdate,
+		DeleteContext: resourceTencentCloudDbbrainSqlFilterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		// Importer: &schema.ResourceImporter{
 		// 	State: schema.ImportStatePassthrough,
 		// },
@@ -129,19 +133,19 @@ func resourceTencentCloudDbbrainSqlFilter() *schema.Resource {
 		},
 	}
 }
-func resourceTencentCloudDbbrainSqlFilterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDbbrainSqlFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dbbrain_sql_filter.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
 	var (
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		request    = dbbrain.NewCreateSqlFilterRequest()
 		response   *dbbrain.CreateSqlFilterResponse
 		instanceId string
 		filterId   string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("instance_id"); ok {
 		instanceId = v.(string)
@@ -170,14 +174,14 @@ func resourceTencentCloudDbbrainSqlFilterCreate(d *schema.ResourceData, meta int
 
 	sessionToken, err := getSessionToken(d, meta, ctx)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if sessionToken != nil {
 		request.SessionToken = sessionToken
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDbbrainClient().CreateSqlFilter(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDbbrainClient().CreateSqlFilterWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -190,13 +194,13 @@ func resourceTencentCloudDbbrainSqlFilterCreate(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create dbbrain sqlFilter failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	filterId = helper.Int64ToStr(*response.Response.FilterId)
 
-	d.SetId(instanceId + FILED_SP + filterId)
-	return resourceTencentCloudDbbrainSqlFilterRead(d, meta)
+	d.SetId(helper.IdFormat(instanceId, filterId))
+	return resourceTencentCloudDbbrainSqlFilterRead(ctx, d, meta)
 }
 
 func getSessionToken(d *schema.ResourceData, meta interface{}, ctx context.Context) (sessionToken *string, errRet error) {
@@ -236,32 +240,32 @@ func getSessionToken(d *schema.ResourceData, meta interface{}, ctx context.Conte
 	return
 }
 
-func resourceTencentCloudDbbrainSqlFilterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDbbrainSqlFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dbbrain_sql_filter.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = DbbrainService{client: meta.(*TencentCloudClient).apiV3Conn}
 		idSplit []string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit = strings.Split(d.Id(), FILED_SP)
+	idSplit = helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	instanceId := idSplit[0]
 	filterId := idSplit[1]
 
 	sqlFilter, err := service.DescribeDbbrainSqlFilter(ctx, helper.String(instanceId), helper.String(filterId))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if sqlFilter == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `sqlFilter` %s does not exist", d.Id())
+		return diag.Errorf("resource `sqlFilter` %s does not exist", d.Id())
 	}
 
 	_ = d.Set("instance_id", instanceId)
@@ -289,19 +293,19 @@ func resourceTencentCloudDbbrainSqlFilterRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudDbbrainSqlFilterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDbbrainSqlFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dbbrain_sql_filter.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		request = dbbrain.NewModifySqlFiltersRequest()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := helper.String(idSplit[0])
 	filterId := idSplit[1]
@@ -309,23 +313,23 @@ func resourceTencentCloudDbbrainSqlFilterUpdate(d *schema.ResourceData, meta int
 	request.FilterIds = []*int64{helper.StrToInt64Point(filterId)}
 
 	if d.HasChange("instance_id") {
-		return fmt.Errorf("`instance_id` do not support change now.")
+		return diag.Errorf("`instance_id` do not support change now.")
 	}
 
 	if d.HasChange("sql_type") {
-		return fmt.Errorf("`sql_type` do not support change now.")
+		return diag.Errorf("`sql_type` do not support change now.")
 	}
 
 	if d.HasChange("filter_key") {
-		return fmt.Errorf("`filter_key` do not support change now.")
+		return diag.Errorf("`filter_key` do not support change now.")
 	}
 
 	if d.HasChange("max_concurrency") {
-		return fmt.Errorf("`max_concurrency` do not support change now.")
+		return diag.Errorf("`max_concurrency` do not support change now.")
 	}
 
 	if d.HasChange("duration") {
-		return fmt.Errorf("`duration` do not support change now.")
+		return diag.Errorf("`duration` do not support change now.")
 	}
 
 	if d.HasChange("status") {
@@ -342,14 +346,14 @@ func resourceTencentCloudDbbrainSqlFilterUpdate(d *schema.ResourceData, meta int
 
 	sessionToken, err := getSessionToken(d, meta, ctx)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if sessionToken != nil {
 		request.SessionToken = sessionToken
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDbbrainClient().ModifySqlFilters(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDbbrainClient().ModifySqlFiltersWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -362,36 +366,36 @@ func resourceTencentCloudDbbrainSqlFilterUpdate(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s modify dbbrain sqlFilter failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudDbbrainSqlFilterRead(d, meta)
+	return resourceTencentCloudDbbrainSqlFilterRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDbbrainSqlFilterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDbbrainSqlFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dbbrain_sql_filter.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = DbbrainService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := helper.String(idSplit[0])
 	filterId := helper.String(idSplit[1])
 	sessionToken, err := getSessionToken(d, meta, ctx)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := service.DeleteDbbrainSqlFilterById(ctx, instanceId, filterId, sessionToken); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -3,7 +3,6 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -69,7 +68,7 @@ func testAccCheckDbbrainSqlFilterDestroy(s *terraform.State) error {
 			continue
 		}
 
-		ids := strings.Split(rs.Primary.ID, FILED_SP)
+		ids := helper.IdParse(rs.Primary.ID)
 		if len(ids) != 2 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
@@ -102,7 +101,7 @@ func testAccCheckDbbrainSqlFilterExists(re string) resource.TestCheckFunc {
 			return fmt.Errorf("Dbbrain sql filter id is not set")
 		}
 
-		ids := strings.Split(rs.Primary.ID, FILED_SP)
+		ids := helper.IdParse(rs.Primary.ID)
 		if len(ids) != 2 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
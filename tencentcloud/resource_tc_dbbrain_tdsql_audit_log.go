@@ -22,10 +22,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dbbrain "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dbbrain/v20210527"
@@ -34,9 +34,12 @@ import (
 
 func resourceTencentCloudDbbrainTdsqlAuditLog() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDbbrainTdsqlAuditLogCreate,
-		Read:   resourceTencentCloudDbbrainTdsqlAuditLogRead,
-		Delete: resourceTencentCloudDbbrainTdsqlAuditLogDelete,
+		CreateContext: resourceTencentCloudDbbrainTdsqlAuditLogCreate,
+		ReadContext:   resourceTencentCloudDbbrainTdsqlAuditLogRead,
+		DeleteContext: resourceTencentCloudDbbrainTdsqlAuditLogDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"product": {
 				Required:    true,
@@ -133,7 +136,7 @@ func resourceTencentCloudDbbrainTdsqlAuditLog() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDbbrainTdsqlAuditLogCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDbbrainTdsqlAuditLogCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dbbrain_tdsql_audit_log.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -203,8 +206,8 @@ func resourceTencentCloudDbbrainTdsqlAuditLogCreate(d *schema.ResourceData, meta
 		request.Filter = &auditLogFilter
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDbbrainClient().CreateAuditLogFile(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDbbrainClient().CreateAuditLogFileWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -215,28 +218,28 @@ func resourceTencentCloudDbbrainTdsqlAuditLogCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create dbbrain tdsqlAuditLog failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	asyncRequestId = helper.Int64ToStr(*response.Response.AsyncRequestId)
-	d.SetId(strings.Join([]string{asyncRequestId, instanceId, product}, FILED_SP))
+	d.SetId(helper.IdFormat(asyncRequestId, instanceId, product))
 
-	return resourceTencentCloudDbbrainTdsqlAuditLogRead(d, meta)
+	return resourceTencentCloudDbbrainTdsqlAuditLogRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDbbrainTdsqlAuditLogRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDbbrainTdsqlAuditLogRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dbbrain_tdsql_audit_log.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DbbrainService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	asyncRequestId := idSplit[0]
 	instanceId := idSplit[1]
@@ -244,7 +247,7 @@ func resourceTencentCloudDbbrainTdsqlAuditLogRead(d *schema.ResourceData, meta i
 
 	tdsqlAuditLogs, err := service.DescribeDbbrainTdsqlAuditLogById(ctx, &asyncRequestId, instanceId, product)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(tdsqlAuditLogs) < 1 {
@@ -266,24 +269,24 @@ func resourceTencentCloudDbbrainTdsqlAuditLogRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudDbbrainTdsqlAuditLogDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDbbrainTdsqlAuditLogDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dbbrain_tdsql_audit_log.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DbbrainService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	asyncRequestId := idSplit[0]
 	instanceId := idSplit[1]
 	product := idSplit[2]
 
 	if err := service.DeleteDbbrainTdsqlAuditLogById(ctx, asyncRequestId, instanceId, product); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
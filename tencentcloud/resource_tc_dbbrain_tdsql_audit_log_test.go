@@ -3,7 +3,6 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 	"time"
 
@@ -100,7 +99,7 @@ func testAccCheckDbbrainTdsqlAuditLogDestroy(s *terraform.State) error {
 			continue
 		}
 
-		ids := strings.Split(rs.Primary.ID, FILED_SP)
+		ids := helper.IdParse(rs.Primary.ID)
 		if len(ids) != 3 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
@@ -134,7 +133,7 @@ func testAccCheckDbbrainTdsqlAuditLogExists(re string) resource.TestCheckFunc {
 			return fmt.Errorf("Dbbrain tdsql audit log id is not set")
 		}
 
-		ids := strings.Split(rs.Primary.ID, FILED_SP)
+		ids := helper.IdParse(rs.Primary.ID)
 		if len(ids) != 3 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
@@ -29,22 +29,25 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTencentCloudDcGatewayInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcGatewayCreate,
-		Read:   resourceTencentCloudDcGatewayRead,
-		Update: resourceTencentCloudDcGatewayUpdate,
-		Delete: resourceTencentCloudDcGatewayDelete,
+		CreateContext: resourceTencentCloudDcGatewayCreate,
+		ReadContext:   resourceTencentCloudDcGatewayRead,
+		UpdateContext: resourceTencentCloudDcGatewayUpdate,
+		DeleteContext: resourceTencentCloudDcGatewayDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -96,11 +99,11 @@ func resourceTencentCloudDcGatewayInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_gateway.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -114,27 +117,27 @@ func resourceTencentCloudDcGatewayCreate(d *schema.ResourceData, meta interface{
 	if networkType == DCG_NETWORK_TYPE_VPC &&
 		!strings.HasPrefix(networkInstanceId, "vpc") {
 
-		return fmt.Errorf("if `network_type` is '%s', the field `network_instance_id` must be a VPC resource",
+		return diag.Errorf("if `network_type` is '%s', the field `network_instance_id` must be a VPC resource",
 			DCG_NETWORK_TYPE_VPC)
 	}
 
 	if networkType == DCG_NETWORK_TYPE_CCN &&
 		!strings.HasPrefix(networkInstanceId, "ccn") {
 
-		return fmt.Errorf("if `network_type` is '%s', the field `network_instance_id` must be a CCN resource",
+		return diag.Errorf("if `network_type` is '%s', the field `network_instance_id` must be a CCN resource",
 			DCG_NETWORK_TYPE_CCN)
 	}
 
 	if networkType == DCG_NETWORK_TYPE_CCN && gatewayType != DCG_GATEWAY_TYPE_NORMAL {
 
-		return fmt.Errorf("if `network_type` is '%s', the field `gateway_type` must be '%s'",
+		return diag.Errorf("if `network_type` is '%s', the field `gateway_type` must be '%s'",
 			DCG_NETWORK_TYPE_CCN,
 			DCG_GATEWAY_TYPE_NORMAL)
 	}
 
 	dcgId, err := service.CreateDirectConnectGateway(ctx, name, networkType, networkInstanceId, gatewayType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(dcgId)
@@ -142,17 +145,17 @@ func resourceTencentCloudDcGatewayCreate(d *schema.ResourceData, meta interface{
 	// add sleep protect, either network_instance_id will be set "".
 	time.Sleep(1 * time.Second)
 
-	return resourceTencentCloudDcGatewayRead(d, meta)
+	return resourceTencentCloudDcGatewayRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_gateway.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		info, has, e := service.DescribeDirectConnectGateway(ctx, d.Id())
 		if e != nil {
 			return retryError(e)
@@ -173,34 +176,34 @@ func resourceTencentCloudDcGatewayRead(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudDcGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_gateway.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	if d.HasChange("name") {
 		var name = d.Get("name").(string)
-		return service.ModifyDirectConnectGatewayAttribute(ctx, d.Id(), name)
+		return diag.FromErr(service.ModifyDirectConnectGatewayAttribute(ctx, d.Id(), name))
 	}
 
-	return resourceTencentCloudDcGatewayRead(d, meta)
+	return resourceTencentCloudDcGatewayRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_gateway.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, e := service.DescribeDirectConnectGateway(ctx, d.Id())
 		if e != nil {
 			return retryError(e)
@@ -212,7 +215,7 @@ func resourceTencentCloudDcGatewayDelete(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return service.DeleteDirectConnectGateway(ctx, d.Id())
+	return diag.FromErr(service.DeleteDirectConnectGateway(ctx, d.Id()))
 }
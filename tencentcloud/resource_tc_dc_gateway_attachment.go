@@ -23,10 +23,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -35,11 +35,14 @@ import (
 
 func resourceTencentCloudDcGatewayAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcGatewayAttachmentCreate,
-		Read:   resourceTencentCloudDcGatewayAttachmentRead,
-		Delete: resourceTencentCloudDcGatewayAttachmentDelete,
+		CreateContext: resourceTencentCloudDcGatewayAttachmentCreate,
+		ReadContext:   resourceTencentCloudDcGatewayAttachmentRead,
+		DeleteContext: resourceTencentCloudDcGatewayAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -66,7 +69,7 @@ func resourceTencentCloudDcGatewayAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcGatewayAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcGatewayAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_gateway_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -93,8 +96,8 @@ func resourceTencentCloudDcGatewayAttachmentCreate(d *schema.ResourceData, meta
 		request.DirectConnectGatewayId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AssociateDirectConnectGatewayNatGateway(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AssociateDirectConnectGatewayNatGatewayWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -104,27 +107,27 @@ func resourceTencentCloudDcGatewayAttachmentCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc dcGatewayAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(vpcId + FILED_SP + directConnectGatewayId + FILED_SP + natGatewayId)
+	d.SetId(helper.IdFormat(vpcId, directConnectGatewayId, natGatewayId))
 
-	return resourceTencentCloudDcGatewayAttachmentRead(d, meta)
+	return resourceTencentCloudDcGatewayAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcGatewayAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcGatewayAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_dc_gateway_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	vpcId := idSplit[0]
 	directConnectGatewayId := idSplit[1]
@@ -132,7 +135,7 @@ func resourceTencentCloudDcGatewayAttachmentRead(d *schema.ResourceData, meta in
 
 	dcGatewayAttachment, err := service.DescribeDcGatewayAttachmentById(ctx, vpcId, directConnectGatewayId, natGatewayId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if dcGatewayAttachment == nil {
@@ -156,24 +159,24 @@ func resourceTencentCloudDcGatewayAttachmentRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTencentCloudDcGatewayAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcGatewayAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_dc_gateway_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	vpcId := idSplit[0]
 	directConnectGatewayId := idSplit[1]
 	natGatewayId := idSplit[2]
 
 	if err := service.DeleteDcGatewayAttachmentById(ctx, vpcId, directConnectGatewayId, natGatewayId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -27,24 +27,35 @@ resource "tencentcloud_dc_gateway_ccn_route" "route2" {
   cidr_block = "192.1.1.0/32"
 }
 ```
+
+Import
+
+DCG CCN route can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_dc_gateway_ccn_route.route1 dcg-dr1y0hu7#dcgr-3f36pqhp
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudDcGatewayCcnRouteInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcGatewayCcnRouteCreate,
-		Read:   resourceTencentCloudDcGatewayCcnRouteRead,
-		Delete: resourceTencentCloudDcGatewayCcnRouteDelete,
+		CreateContext: resourceTencentCloudDcGatewayCcnRouteCreate,
+		ReadContext:   resourceTencentCloudDcGatewayCcnRouteRead,
+		DeleteContext: resourceTencentCloudDcGatewayCcnRouteDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"dcg_id": {
 				Type:        schema.TypeString,
@@ -73,11 +84,11 @@ func resourceTencentCloudDcGatewayCcnRouteInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcGatewayCcnRouteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcGatewayCcnRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_gateway_ccn_route.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -90,33 +101,33 @@ func resourceTencentCloudDcGatewayCcnRouteCreate(d *schema.ResourceData, meta in
 	routeId, err := service.CreateDirectConnectGatewayCcnRoute(ctx, dcgId, cidrBlock, nil)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(dcgId + "#" + routeId)
+	d.SetId(helper.IdFormat(dcgId, routeId))
 
 	// add sleep protect, either network_instance_id will be set "".
 	time.Sleep(1 * time.Second)
 
-	return resourceTencentCloudDcGatewayCcnRouteRead(d, meta)
+	return resourceTencentCloudDcGatewayCcnRouteRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcGatewayCcnRouteRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcGatewayCcnRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_gateway_ccn_route.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	items := strings.Split(d.Id(), "#")
-	if len(items) != 2 {
-		return fmt.Errorf("id of resource.tencentcloud_dc_gateway_ccn_route is wrong")
+	items, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	dcgId, routeId := items[0], items[1]
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		info, has, e := service.DescribeDirectConnectGatewayCcnRoute(ctx, dcgId, routeId)
 		if e != nil {
 			return retryError(e)
@@ -133,33 +144,33 @@ func resourceTencentCloudDcGatewayCcnRouteRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudDcGatewayCcnRouteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcGatewayCcnRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_gateway_ccn_route.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	items := strings.Split(d.Id(), "#")
-	if len(items) != 2 {
-		return fmt.Errorf("id of resource.tencentcloud_dc_gateway_ccn_route is wrong")
+	items, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	dcgId, routeId := items[0], items[1]
 	_, has, err := service.DescribeDirectConnectGatewayCcnRoute(ctx, dcgId, routeId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if has == 0 {
 		return nil
 	}
 
-	return service.DeleteDirectConnectGatewayCcnRoute(ctx, dcgId, routeId)
+	return diag.FromErr(service.DeleteDirectConnectGatewayCcnRoute(ctx, dcgId, routeId))
 }
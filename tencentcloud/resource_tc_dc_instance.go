@@ -28,9 +28,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dc/v20180410"
@@ -39,12 +40,16 @@ import (
 
 func resourceTencentCloudDcInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcInstanceCreate,
-		Read:   resourceTencentCloudDcInstanceRead,
-		Update: resourceTencentCloudDcInstanceUpdate,
-		Delete: resourceTencentCloudDcInstanceDelete,
+		CreateContext: resourceTencentCloudDcInstanceCreate,
+		ReadContext:   resourceTencentCloudDcInstanceRead,
+		UpdateContext: resourceTencentCloudDcInstanceUpdate,
+		DeleteContext: resourceTencentCloudDcInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"direct_connect_name": {
@@ -152,7 +157,7 @@ func resourceTencentCloudDcInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -230,8 +235,8 @@ func resourceTencentCloudDcInstanceCreate(d *schema.ResourceData, meta interface
 		request.SignLaw = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcClient().CreateDirectConnect(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcClient().CreateDirectConnectWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -242,26 +247,26 @@ func resourceTencentCloudDcInstanceCreate(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create dc instance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	dcSet := response.Response.DirectConnectIdSet
 	if len(dcSet) < 1 {
-		return fmt.Errorf("Create Direct Connect failed")
+		return diag.Errorf("Create Direct Connect failed")
 	}
 
 	d.SetId(*dcSet[0])
 
-	return resourceTencentCloudDcInstanceRead(d, meta)
+	return resourceTencentCloudDcInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -269,7 +274,7 @@ func resourceTencentCloudDcInstanceRead(d *schema.ResourceData, meta interface{}
 
 	instances, err := service.DescribeDirectConnects(ctx, directConnectId, "")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(instances) < 1 {
@@ -351,7 +356,7 @@ func resourceTencentCloudDcInstanceRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudDcInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -371,7 +376,7 @@ func resourceTencentCloudDcInstanceUpdate(d *schema.ResourceData, meta interface
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -439,8 +444,8 @@ func resourceTencentCloudDcInstanceUpdate(d *schema.ResourceData, meta interface
 			request.SignLaw = helper.Bool(v.(bool))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcClient().ModifyDirectConnectAttribute(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcClient().ModifyDirectConnectAttributeWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -450,25 +455,25 @@ func resourceTencentCloudDcInstanceUpdate(d *schema.ResourceData, meta interface
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update dc instance failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudDcInstanceRead(d, meta)
+	return resourceTencentCloudDcInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	directConnectId := d.Id()
 
 	if err := service.DeleteDcInstanceById(ctx, directConnectId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -24,7 +24,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dc/v20180410"
@@ -33,11 +35,14 @@ import (
 
 func resourceTencentCloudDcInternetAddress() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcInternetAddressCreate,
-		Read:   resourceTencentCloudDcInternetAddressRead,
-		Delete: resourceTencentCloudDcInternetAddressDelete,
+		CreateContext: resourceTencentCloudDcInternetAddressCreate,
+		ReadContext:   resourceTencentCloudDcInternetAddressRead,
+		DeleteContext: resourceTencentCloudDcInternetAddressDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"mask_len": {
@@ -64,7 +69,7 @@ func resourceTencentCloudDcInternetAddress() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcInternetAddressCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcInternetAddressCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_internet_address.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -87,8 +92,8 @@ func resourceTencentCloudDcInternetAddressCreate(d *schema.ResourceData, meta in
 		request.AddrProto = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcClient().ApplyInternetAddress(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcClient().ApplyInternetAddressWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -99,22 +104,22 @@ func resourceTencentCloudDcInternetAddressCreate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create dc internetAddress failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId = *response.Response.InstanceId
 	d.SetId(instanceId)
 
-	return resourceTencentCloudDcInternetAddressRead(d, meta)
+	return resourceTencentCloudDcInternetAddressRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcInternetAddressRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcInternetAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_internet_address.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -122,7 +127,7 @@ func resourceTencentCloudDcInternetAddressRead(d *schema.ResourceData, meta inte
 
 	internetAddress, err := service.DescribeDcInternetAddressById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if internetAddress == nil {
@@ -146,18 +151,18 @@ func resourceTencentCloudDcInternetAddressRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudDcInternetAddressDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcInternetAddressDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_internet_address.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instanceId := d.Id()
 
 	if err := service.DeleteDcInternetAddressById(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -29,7 +29,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dc/v20180410"
@@ -37,12 +39,15 @@ import (
 
 func resourceTencentCloudDcInternetAddressConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcInternetAddressConfigCreate,
-		Read:   resourceTencentCloudDcInternetAddressConfigRead,
-		Update: resourceTencentCloudDcInternetAddressConfigUpdate,
-		Delete: resourceTencentCloudDcInternetAddressConfigDelete,
+		CreateContext: resourceTencentCloudDcInternetAddressConfigCreate,
+		ReadContext:   resourceTencentCloudDcInternetAddressConfigRead,
+		UpdateContext: resourceTencentCloudDcInternetAddressConfigUpdate,
+		DeleteContext: resourceTencentCloudDcInternetAddressConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -60,7 +65,7 @@ func resourceTencentCloudDcInternetAddressConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcInternetAddressConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcInternetAddressConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_internet_address_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -68,16 +73,16 @@ func resourceTencentCloudDcInternetAddressConfigCreate(d *schema.ResourceData, m
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudDcInternetAddressConfigUpdate(d, meta)
+	return resourceTencentCloudDcInternetAddressConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudDcInternetAddressConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcInternetAddressConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_internet_address_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -85,7 +90,7 @@ func resourceTencentCloudDcInternetAddressConfigRead(d *schema.ResourceData, met
 
 	internetAddressConfig, err := service.DescribeDcInternetAddressById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if internetAddressConfig == nil {
@@ -107,7 +112,7 @@ func resourceTencentCloudDcInternetAddressConfigRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudDcInternetAddressConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcInternetAddressConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_internet_address_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -128,8 +133,8 @@ func resourceTencentCloudDcInternetAddressConfigUpdate(d *schema.ResourceData, m
 	if enable {
 		enableRequest.InstanceId = &instanceId
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcClient().EnableInternetAddress(enableRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcClient().EnableInternetAddressWithContext(ctx, enableRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -139,13 +144,13 @@ func resourceTencentCloudDcInternetAddressConfigUpdate(d *schema.ResourceData, m
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update dc internetAddressConfig failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		disableRequest.InstanceId = &instanceId
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcClient().DisableInternetAddress(disableRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcClient().DisableInternetAddressWithContext(ctx, disableRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -155,14 +160,14 @@ func resourceTencentCloudDcInternetAddressConfigUpdate(d *schema.ResourceData, m
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update dc internetAddressConfig failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudDcInternetAddressConfigRead(d, meta)
+	return resourceTencentCloudDcInternetAddressConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcInternetAddressConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcInternetAddressConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_internet_address_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
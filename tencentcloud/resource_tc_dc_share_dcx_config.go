@@ -23,7 +23,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dc/v20180410"
@@ -31,12 +33,15 @@ import (
 
 func resourceTencentCloudDcShareDcxConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcShareDcxConfigCreate,
-		Read:   resourceTencentCloudDcShareDcxConfigRead,
-		Update: resourceTencentCloudDcShareDcxConfigUpdate,
-		Delete: resourceTencentCloudDcShareDcxConfigDelete,
+		CreateContext: resourceTencentCloudDcShareDcxConfigCreate,
+		ReadContext:   resourceTencentCloudDcShareDcxConfigRead,
+		UpdateContext: resourceTencentCloudDcShareDcxConfigUpdate,
+		DeleteContext: resourceTencentCloudDcShareDcxConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"direct_connect_tunnel_id": {
@@ -54,7 +59,7 @@ func resourceTencentCloudDcShareDcxConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcShareDcxConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcShareDcxConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_share_dcx_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -62,16 +67,16 @@ func resourceTencentCloudDcShareDcxConfigCreate(d *schema.ResourceData, meta int
 
 	d.SetId(dcxId)
 
-	return resourceTencentCloudDcShareDcxConfigUpdate(d, meta)
+	return resourceTencentCloudDcShareDcxConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudDcShareDcxConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcShareDcxConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_share_dcx_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -79,7 +84,7 @@ func resourceTencentCloudDcShareDcxConfigRead(d *schema.ResourceData, meta inter
 
 	ShareDcxConfig, err := service.DescribeDcShareDcxConfigById(ctx, dcxId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ShareDcxConfig == nil {
@@ -103,7 +108,7 @@ func resourceTencentCloudDcShareDcxConfigRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudDcShareDcxConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcShareDcxConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_share_dcx_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -124,8 +129,8 @@ func resourceTencentCloudDcShareDcxConfigUpdate(d *schema.ResourceData, meta int
 	if enable {
 		acceptRequest.DirectConnectTunnelId = &dcxId
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcClient().AcceptDirectConnectTunnel(acceptRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcClient().AcceptDirectConnectTunnelWithContext(ctx, acceptRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -135,13 +140,13 @@ func resourceTencentCloudDcShareDcxConfigUpdate(d *schema.ResourceData, meta int
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update dc ShareDcxConfig failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		rejectRequest.DirectConnectTunnelId = &dcxId
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcClient().RejectDirectConnectTunnel(rejectRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcClient().RejectDirectConnectTunnelWithContext(ctx, rejectRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -151,14 +156,14 @@ func resourceTencentCloudDcShareDcxConfigUpdate(d *schema.ResourceData, meta int
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update dc ShareDcxConfig failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudDcShareDcxConfigRead(d, meta)
+	return resourceTencentCloudDcShareDcxConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcShareDcxConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcShareDcxConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dc_share_dcx_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
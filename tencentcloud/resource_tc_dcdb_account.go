@@ -26,11 +26,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -39,12 +38,17 @@ import (
 
 func resourceTencentCloudDcdbAccount() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudDcdbAccountRead,
-		Create: resourceTencentCloudDcdbAccountCreate,
-		Update: resourceTencentCloudDcdbAccountUpdate,
-		Delete: resourceTencentCloudDcdbAccountDelete,
+		ReadContext:   resourceTencentCloudDcdbAccountRead,
+		CreateContext: resourceTencentCloudDcdbAccountCreate,
+		UpdateContext: resourceTencentCloudDcdbAccountUpdate,
+		DeleteContext: resourceTencentCloudDcdbAccountDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -93,7 +97,7 @@ func resourceTencentCloudDcdbAccount() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcdbAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_account.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -138,8 +142,8 @@ func resourceTencentCloudDcdbAccountCreate(d *schema.ResourceData, meta interfac
 		request.MaxUserConnections = helper.IntUint64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().CreateAccount(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().CreateAccountWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -152,27 +156,27 @@ func resourceTencentCloudDcdbAccountCreate(d *schema.ResourceData, meta interfac
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create dcdb account failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId = *response.Response.InstanceId
 
-	d.SetId(instanceId + FILED_SP + userName)
-	return resourceTencentCloudDcdbAccountRead(d, meta)
+	d.SetId(helper.IdFormat(instanceId, userName))
+	return resourceTencentCloudDcdbAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_account.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	userName := idSplit[1]
@@ -180,12 +184,12 @@ func resourceTencentCloudDcdbAccountRead(d *schema.ResourceData, meta interface{
 	account, err := service.DescribeDcdbAccount(ctx, instanceId, userName)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if account == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `account` %s does not exist", d.Id())
+		return diag.Errorf("resource `account` %s does not exist", d.Id())
 	}
 
 	if account.InstanceId != nil {
@@ -214,7 +218,7 @@ func resourceTencentCloudDcdbAccountRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTencentCloudDcdbAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_account.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -223,9 +227,9 @@ func resourceTencentCloudDcdbAccountUpdate(d *schema.ResourceData, meta interfac
 
 	request := dcdb.NewModifyAccountDescriptionRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	instanceId := idSplit[0]
 	userName := idSplit[1]
@@ -234,11 +238,11 @@ func resourceTencentCloudDcdbAccountUpdate(d *schema.ResourceData, meta interfac
 	request.UserName = &userName
 
 	if d.HasChange("instance_id") {
-		return fmt.Errorf("`instance_id` do not support change now.")
+		return diag.Errorf("`instance_id` do not support change now.")
 	}
 
 	if d.HasChange("user_name") {
-		return fmt.Errorf("`user_name` do not support change now.")
+		return diag.Errorf("`user_name` do not support change now.")
 	}
 
 	if d.HasChange("password") {
@@ -252,8 +256,8 @@ func resourceTencentCloudDcdbAccountUpdate(d *schema.ResourceData, meta interfac
 			}
 			request.Password = helper.String(v.(string))
 
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ResetAccountPassword(request)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ResetAccountPasswordWithContext(ctx, request)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -263,13 +267,13 @@ func resourceTencentCloudDcdbAccountUpdate(d *schema.ResourceData, meta interfac
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s operate dcdb resetAccountPasswordOperation failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
 	if d.HasChange("read_only") {
-		return fmt.Errorf("`read_only` do not support change now.")
+		return diag.Errorf("`read_only` do not support change now.")
 	}
 
 	if d.HasChange("description") {
@@ -282,11 +286,11 @@ func resourceTencentCloudDcdbAccountUpdate(d *schema.ResourceData, meta interfac
 	}
 
 	if d.HasChange("max_user_connections") {
-		return fmt.Errorf("`max_user_connections` do not support change now.")
+		return diag.Errorf("`max_user_connections` do not support change now.")
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ModifyAccountDescription(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ModifyAccountDescriptionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -298,42 +302,42 @@ func resourceTencentCloudDcdbAccountUpdate(d *schema.ResourceData, meta interfac
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create dcdb account failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudDcdbAccountRead(d, meta)
+	return resourceTencentCloudDcdbAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_account.delete")()
 	defer inconsistentCheck(d, meta)()
 	var host string
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken, %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	if v, ok := d.GetOk("host"); ok {
 		host = v.(string)
 	} else {
-		return fmt.Errorf("host is broken, %s", host)
+		return diag.Errorf("host is broken, %s", host)
 	}
 
 	instanceId := idSplit[0]
 	userName := idSplit[1]
 
 	if err := service.DeleteDcdbAccountById(ctx, instanceId, userName, host); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	conf := BuildStateChangeConf([]string{}, []string{"deleted"}, readRetryTimeout, time.Second, service.DcdbAccountRefreshFunc(instanceId, userName, []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"deleted"}, d.Timeout(schema.TimeoutDelete), time.Second, service.DcdbAccountRefreshFunc(instanceId, userName, []string{}))
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	return nil
@@ -36,11 +36,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -49,12 +48,15 @@ import (
 
 func resourceTencentCloudDcdbAccountPrivileges() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcdbAccountPrivilegesCreate,
-		Read:   resourceTencentCloudDcdbAccountPrivilegesRead,
-		Update: resourceTencentCloudDcdbAccountPrivilegesUpdate,
-		Delete: resourceTencentCloudDcdbAccountPrivilegesDelete,
+		CreateContext: resourceTencentCloudDcdbAccountPrivilegesCreate,
+		ReadContext:   resourceTencentCloudDcdbAccountPrivilegesRead,
+		UpdateContext: resourceTencentCloudDcdbAccountPrivilegesUpdate,
+		DeleteContext: resourceTencentCloudDcdbAccountPrivilegesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -212,24 +214,24 @@ func resourceTencentCloudDcdbAccountPrivileges() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcdbAccountPrivilegesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbAccountPrivilegesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_account_privileges.create")()
 	defer inconsistentCheck(d, meta)()
 
-	return resourceTencentCloudDcdbAccountPrivilegesUpdate(d, meta)
+	return resourceTencentCloudDcdbAccountPrivilegesUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbAccountPrivilegesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbAccountPrivilegesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_account_privileges.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 7 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 7)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := idSplit[0]
@@ -243,11 +245,11 @@ func resourceTencentCloudDcdbAccountPrivilegesRead(d *schema.ResourceData, meta
 	// query global_privileges
 	globalPrivileges, err := service.DescribeDcdbAccountPrivilegesById(ctx, d.Id(), helper.String("*"), nil, nil, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if globalPrivileges == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `globalPrivileges` %s does not exist", d.Id())
+		return diag.Errorf("resource `globalPrivileges` %s does not exist", d.Id())
 	}
 
 	if globalPrivileges.Privileges != nil {
@@ -274,10 +276,10 @@ func resourceTencentCloudDcdbAccountPrivilegesRead(d *schema.ResourceData, meta
 	// query db list
 	dbs, err := service.DescribeDcdbDatabases(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if dbs == nil {
-		return fmt.Errorf("%s the dbs get from DescribeDcdbDatabases is nil!", logId)
+		return diag.Errorf("%s the dbs get from DescribeDcdbDatabases is nil!", logId)
 	}
 
 	// query database_privileges
@@ -286,11 +288,11 @@ func resourceTencentCloudDcdbAccountPrivilegesRead(d *schema.ResourceData, meta
 		if *db.DbName == *dbName { // only support single name
 			dbPrivileges, err := service.DescribeDcdbAccountPrivilegesById(ctx, d.Id(), dbName, helper.String("*"), nil, nil)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			if dbPrivileges == nil {
 				d.SetId("")
-				return fmt.Errorf("resource `dbPrivileges` %s does not exist", d.Id())
+				return diag.Errorf("resource `dbPrivileges` %s does not exist", d.Id())
 			}
 
 			dbPrivilegesMap := map[string]interface{}{}
@@ -303,10 +305,10 @@ func resourceTencentCloudDcdbAccountPrivilegesRead(d *schema.ResourceData, meta
 			// query db objects
 			objs, err := service.DescribeDcdbDBObjects(ctx, instanceId, *dbName)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			if objs == nil {
-				return fmt.Errorf("%s the objs get from DescribeDcdbDBObjects is nil!", logId)
+				return diag.Errorf("%s the objs get from DescribeDcdbDBObjects is nil!", logId)
 			}
 
 			// query table_privileges
@@ -315,11 +317,11 @@ func resourceTencentCloudDcdbAccountPrivilegesRead(d *schema.ResourceData, meta
 				if *tab.Table == *tabName { // only support single name
 					tabPrivileges, err := service.DescribeDcdbAccountPrivilegesById(ctx, d.Id(), dbName, helper.String("table"), tabName, helper.String("*"))
 					if err != nil {
-						return err
+						return diag.FromErr(err)
 					}
 					if tabPrivileges == nil {
 						d.SetId("")
-						return fmt.Errorf("resource `tabPrivileges` %s does not exist", d.Id())
+						return diag.Errorf("resource `tabPrivileges` %s does not exist", d.Id())
 					}
 
 					tabPrivilegesMap := map[string]interface{}{}
@@ -333,10 +335,10 @@ func resourceTencentCloudDcdbAccountPrivilegesRead(d *schema.ResourceData, meta
 					// query db tables
 					tabs, err := service.DescribeDcdbDBTables(ctx, instanceId, *dbName, *tabName)
 					if err != nil {
-						return err
+						return diag.FromErr(err)
 					}
 					if tabs == nil {
-						return fmt.Errorf("%s the tabs get from DescribeDcdbDBTables is nil!", logId)
+						return diag.Errorf("%s the tabs get from DescribeDcdbDBTables is nil!", logId)
 					}
 
 					// query column_privileges
@@ -345,11 +347,11 @@ func resourceTencentCloudDcdbAccountPrivilegesRead(d *schema.ResourceData, meta
 						if *col.Col == *colName { // only support single name
 							colPrivileges, err := service.DescribeDcdbAccountPrivilegesById(ctx, d.Id(), dbName, helper.String("table"), tabName, colName)
 							if err != nil {
-								return err
+								return diag.FromErr(err)
 							}
 							if colPrivileges == nil {
 								d.SetId("")
-								return fmt.Errorf("resource `colPrivileges` %s does not exist", d.Id())
+								return diag.Errorf("resource `colPrivileges` %s does not exist", d.Id())
 							}
 
 							colPrivilegesMap := map[string]interface{}{}
@@ -373,11 +375,11 @@ func resourceTencentCloudDcdbAccountPrivilegesRead(d *schema.ResourceData, meta
 				if *view.View == *viewName { // only support single name
 					viewPrivileges, err := service.DescribeDcdbAccountPrivilegesById(ctx, d.Id(), dbName, helper.String("view"), viewName, nil)
 					if err != nil {
-						return err
+						return diag.FromErr(err)
 					}
 					if viewPrivileges == nil {
 						d.SetId("")
-						return fmt.Errorf("resource `viewPrivileges` %s does not exist", d.Id())
+						return diag.Errorf("resource `viewPrivileges` %s does not exist", d.Id())
 					}
 
 					viewPrivilegesMap := map[string]interface{}{}
@@ -397,7 +399,7 @@ func resourceTencentCloudDcdbAccountPrivilegesRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudDcdbAccountPrivilegesUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbAccountPrivilegesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_account_privileges.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -548,8 +550,8 @@ func resourceTencentCloudDcdbAccountPrivilegesUpdate(d *schema.ResourceData, met
 		},
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ModifyAccountPrivileges(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		response, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ModifyAccountPrivilegesWithContext(ctx, request)
 		flowId = response.Response.FlowId
 		if e != nil {
 			return retryError(e)
@@ -560,24 +562,24 @@ func resourceTencentCloudDcdbAccountPrivilegesUpdate(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update dcdb accountPrivileges failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if flowId != nil {
 		// need to wait modify operation success
 		// 0:success; 1:failed, 2:running
-		conf := BuildStateChangeConf([]string{}, []string{"0"}, 3*readRetryTimeout, time.Second, service.DcdbDbInstanceStateRefreshFunc(flowId, []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"0"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.DcdbDbInstanceStateRefreshFunc(flowId, []string{}))
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
-	d.SetId(strings.Join([]string{instanceId, userName, host, dbName, tabName, viewName, colName}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, userName, host, dbName, tabName, viewName, colName))
 
-	return resourceTencentCloudDcdbAccountPrivilegesRead(d, meta)
+	return resourceTencentCloudDcdbAccountPrivilegesRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbAccountPrivilegesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbAccountPrivilegesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_account_privileges.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -3,12 +3,12 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func init() {
@@ -98,7 +98,7 @@ func testAccCheckDcdbAccountDestroy(s *terraform.State) error {
 		if rs.Type != "tencentcloud_dcdb_account" {
 			continue
 		}
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		instanceId := idSplit[0]
 		userName := idSplit[1]
 
@@ -125,7 +125,7 @@ func testAccCheckDcdbAccountExists(re string) resource.TestCheckFunc {
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("dcdb account id is not set")
 		}
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		instanceId := idSplit[0]
 		userName := idSplit[1]
 
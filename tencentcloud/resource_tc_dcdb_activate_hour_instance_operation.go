@@ -12,8 +12,11 @@ resource "tencentcloud_dcdb_activate_hour_instance_operation" "activate_hour_ins
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -22,9 +25,12 @@ import (
 
 func resourceTencentCloudDcdbActivateHourInstanceOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcdbActivateHourInstanceOperationCreate,
-		Read:   resourceTencentCloudDcdbActivateHourInstanceOperationRead,
-		Delete: resourceTencentCloudDcdbActivateHourInstanceOperationDelete,
+		CreateContext: resourceTencentCloudDcdbActivateHourInstanceOperationCreate,
+		ReadContext:   resourceTencentCloudDcdbActivateHourInstanceOperationRead,
+		DeleteContext: resourceTencentCloudDcdbActivateHourInstanceOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -36,7 +42,7 @@ func resourceTencentCloudDcdbActivateHourInstanceOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcdbActivateHourInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbActivateHourInstanceOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_activate_hour_instance_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -51,8 +57,8 @@ func resourceTencentCloudDcdbActivateHourInstanceOperationCreate(d *schema.Resou
 		request.InstanceIds = []*string{helper.String(instanceId)}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ActiveHourDCDBInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ActiveHourDCDBInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -62,22 +68,22 @@ func resourceTencentCloudDcdbActivateHourInstanceOperationCreate(d *schema.Resou
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate dcdb activateHourInstanceOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudDcdbActivateHourInstanceOperationRead(d, meta)
+	return resourceTencentCloudDcdbActivateHourInstanceOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbActivateHourInstanceOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbActivateHourInstanceOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_activate_hour_instance_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudDcdbActivateHourInstanceOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbActivateHourInstanceOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_activate_hour_instance_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -53,9 +53,11 @@ resource "tencentcloud_dcdb_cancel_dcn_job_operation" "cancel_operation" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -64,9 +66,12 @@ import (
 
 func resourceTencentCloudDcdbCancelDcnJobOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcdbCancelDcnJobOperationCreate,
-		Read:   resourceTencentCloudDcdbCancelDcnJobOperationRead,
-		Delete: resourceTencentCloudDcdbCancelDcnJobOperationDelete,
+		CreateContext: resourceTencentCloudDcdbCancelDcnJobOperationCreate,
+		ReadContext:   resourceTencentCloudDcdbCancelDcnJobOperationRead,
+		DeleteContext: resourceTencentCloudDcdbCancelDcnJobOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -78,7 +83,7 @@ func resourceTencentCloudDcdbCancelDcnJobOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcdbCancelDcnJobOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbCancelDcnJobOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_cancel_dcn_job_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -95,8 +100,8 @@ func resourceTencentCloudDcdbCancelDcnJobOperationCreate(d *schema.ResourceData,
 		instanceId = v.(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().CancelDcnJob(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().CancelDcnJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -107,29 +112,29 @@ func resourceTencentCloudDcdbCancelDcnJobOperationCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate dcdb cancelDcnJobOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// need to wait flow success
 	// 0:success; 1:failed, 2:running
-	conf := BuildStateChangeConf([]string{}, []string{"0"}, 3*readRetryTimeout, time.Second, service.DcdbDbInstanceStateRefreshFunc(flowId, []string{"1"}))
+	conf := BuildStateChangeConf([]string{}, []string{"0"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DcdbDbInstanceStateRefreshFunc(flowId, []string{"1"}))
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudDcdbCancelDcnJobOperationRead(d, meta)
+	return resourceTencentCloudDcdbCancelDcnJobOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbCancelDcnJobOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbCancelDcnJobOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_cancel_dcn_job_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudDcdbCancelDcnJobOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbCancelDcnJobOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_cancel_dcn_job_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
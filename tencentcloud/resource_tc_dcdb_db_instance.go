@@ -51,10 +51,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -63,12 +63,16 @@ import (
 
 func resourceTencentCloudDcdbDbInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcdbDbInstanceCreate,
-		Read:   resourceTencentCloudDcdbDbInstanceRead,
-		Update: resourceTencentCloudDcdbDbInstanceUpdate,
-		Delete: resourceTencentCloudDcdbDbInstanceDelete,
+		CreateContext: resourceTencentCloudDcdbDbInstanceCreate,
+		ReadContext:   resourceTencentCloudDcdbDbInstanceRead,
+		UpdateContext: resourceTencentCloudDcdbDbInstanceUpdate,
+		DeleteContext: resourceTencentCloudDcdbDbInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"zones": {
@@ -263,12 +267,12 @@ func resourceTencentCloudDcdbDbInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcdbDbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbDbInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_db_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request       = dcdb.NewCreateDCDBInstanceRequest()
@@ -385,8 +389,8 @@ func resourceTencentCloudDcdbDbInstanceCreate(d *schema.ResourceData, meta inter
 		request.SecurityGroupIds = helper.InterfacesStringsPoint(securityGroupIdsSet)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().CreateDCDBInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().CreateDCDBInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -397,12 +401,12 @@ func resourceTencentCloudDcdbDbInstanceCreate(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create dcdb dbInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if response == nil || len(response.Response.InstanceIds) < 1 {
 		d.SetId("")
-		return fmt.Errorf("[CRITAL]%s create dcdb dbInstance failed.", d.Id())
+		return diag.Errorf("[CRITAL]%s create dcdb dbInstance failed.", d.Id())
 	}
 
 	instanceId = *response.Response.InstanceIds[0]
@@ -432,27 +436,27 @@ func resourceTencentCloudDcdbDbInstanceCreate(d *schema.ResourceData, meta inter
 
 	initRet, flowId, e := service.InitDcdbDbInstance(ctx, instanceId, request.InitParams)
 	if e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 	if !initRet {
-		return fmt.Errorf("db instance init failed")
+		return diag.Errorf("db instance init failed")
 	}
 
 	if flowId != nil {
 		// need to wait init operation success
 		// 0:success; 1:failed, 2:running
-		conf := BuildStateChangeConf([]string{}, []string{"0"}, 3*readRetryTimeout, time.Second, service.DcdbDbInstanceStateRefreshFunc(helper.UInt64Int64(*flowId), []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"0"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DcdbDbInstanceStateRefreshFunc(helper.UInt64Int64(*flowId), []string{}))
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
 	if dcnInstanceId != "" {
 		// need to wait dcn init processing complete
 		// 0:none; 1:creating, 2:running
-		conf := BuildStateChangeConf([]string{}, []string{"2"}, 3*readRetryTimeout, time.Second, service.DcdbDcnStateRefreshFunc(instanceId, []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"2"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DcdbDcnStateRefreshFunc(instanceId, []string{}))
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -460,7 +464,7 @@ func resourceTencentCloudDcdbDbInstanceCreate(d *schema.ResourceData, meta inter
 		flag := v.(bool)
 		err := service.SetDcdbExtranetAccess(ctx, instanceId, ipv6Flag, flag)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -478,25 +482,25 @@ func resourceTencentCloudDcdbDbInstanceCreate(d *schema.ResourceData, meta inter
 
 	if vip != "" || vipv6 != "" {
 		if vpcId == "" || subnetId == "" {
-			return fmt.Errorf("`vpc_id` and `subnet_id` cannot be empty when setting `vip` or `vipv6` fields!")
+			return diag.Errorf("`vpc_id` and `subnet_id` cannot be empty when setting `vip` or `vipv6` fields!")
 		}
 
 		err := service.SetNetworkVip(ctx, instanceId, vpcId, subnetId, vip, vipv6)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudDcdbDbInstanceRead(d, meta)
+	return resourceTencentCloudDcdbDbInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbDbInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_db_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -504,12 +508,12 @@ func resourceTencentCloudDcdbDbInstanceRead(d *schema.ResourceData, meta interfa
 
 	ret, err := service.DescribeDcdbDbInstance(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ret == nil || len(ret.Instances) < 1 {
 		d.SetId("")
-		return fmt.Errorf("resource `DcdbDbInstance` %s does not exist", d.Id())
+		return diag.Errorf("resource `DcdbDbInstance` %s does not exist", d.Id())
 	}
 
 	dbInstance := ret.Instances[0]
@@ -641,7 +645,7 @@ func resourceTencentCloudDcdbDbInstanceRead(d *schema.ResourceData, meta interfa
 
 		_ = d.Set("security_group_ids", sgIds)
 	} else {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// set dcn id and region
@@ -655,7 +659,7 @@ func resourceTencentCloudDcdbDbInstanceRead(d *schema.ResourceData, meta interfa
 			}
 		}
 	} else {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// set vip, vipv6 and vport
@@ -674,18 +678,18 @@ func resourceTencentCloudDcdbDbInstanceRead(d *schema.ResourceData, meta interfa
 			}
 		}
 	} else {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceTencentCloudDcdbDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbDbInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_db_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request = dcdb.NewModifyDBInstanceNameRequest()
@@ -696,7 +700,7 @@ func resourceTencentCloudDcdbDbInstanceUpdate(d *schema.ResourceData, meta inter
 
 	request.InstanceId = helper.String(instanceId)
 	if d.HasChange("zones") {
-		return fmt.Errorf("`zones` do not support change now.")
+		return diag.Errorf("`zones` do not support change now.")
 	}
 
 	if d.HasChange("period") || d.HasChange("auto_voucher") || d.HasChange("voucher_ids") {
@@ -718,8 +722,8 @@ func resourceTencentCloudDcdbDbInstanceUpdate(d *schema.ResourceData, meta inter
 				}
 			}
 
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().RenewDCDBInstance(request)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().RenewDCDBInstanceWithContext(ctx, request)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -729,23 +733,23 @@ func resourceTencentCloudDcdbDbInstanceUpdate(d *schema.ResourceData, meta inter
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s operate dcdb renewDCDBInstanceOperation failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 			_ = d.Set("period", period)
 		}
 		time.Sleep(2 * time.Second)
 	}
 	if d.HasChange("shard_memory") {
-		return fmt.Errorf("`shard_memory` do not support change now.")
+		return diag.Errorf("`shard_memory` do not support change now.")
 	}
 	if d.HasChange("shard_storage") {
-		return fmt.Errorf("`shard_storage` do not support change now.")
+		return diag.Errorf("`shard_storage` do not support change now.")
 	}
 	if d.HasChange("shard_node_count") {
-		return fmt.Errorf("`shard_node_count` do not support change now.")
+		return diag.Errorf("`shard_node_count` do not support change now.")
 	}
 	if d.HasChange("shard_count") {
-		return fmt.Errorf("`shard_count` do not support change now.")
+		return diag.Errorf("`shard_count` do not support change now.")
 	}
 
 	if v, ok := d.GetOkExists("extranet_access"); ok && v != nil {
@@ -756,7 +760,7 @@ func resourceTencentCloudDcdbDbInstanceUpdate(d *schema.ResourceData, meta inter
 		}
 		err := service.SetDcdbExtranetAccess(ctx, instanceId, ipv6Flag, flag)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		time.Sleep(2 * time.Second)
 	}
@@ -768,8 +772,8 @@ func resourceTencentCloudDcdbDbInstanceUpdate(d *schema.ResourceData, meta inter
 			request.InstanceIds = []*string{&instanceId}
 			request.ProjectId = helper.IntInt64(projectId.(int))
 
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ModifyDBInstancesProject(request)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ModifyDBInstancesProjectWithContext(ctx, request)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -779,7 +783,7 @@ func resourceTencentCloudDcdbDbInstanceUpdate(d *schema.ResourceData, meta inter
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s operate dcdb modifyInstanceProjectOperation failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		time.Sleep(2 * time.Second)
@@ -806,46 +810,46 @@ func resourceTencentCloudDcdbDbInstanceUpdate(d *schema.ResourceData, meta inter
 		}
 
 		if vpcId == "" || subnetId == "" {
-			return fmt.Errorf("`vpc_id` and `subnet_id` cannot be empty when updating network configs!")
+			return diag.Errorf("`vpc_id` and `subnet_id` cannot be empty when updating network configs!")
 		}
 
 		err := service.SetNetworkVip(ctx, instanceId, vpcId, subnetId, vip, vipv6)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("db_version_id") {
-		return fmt.Errorf("`db_version_id` do not support change now.")
+		return diag.Errorf("`db_version_id` do not support change now.")
 	}
 
 	if d.HasChange("ipv6_flag") {
-		return fmt.Errorf("`ipv6_flag` do not support change now.")
+		return diag.Errorf("`ipv6_flag` do not support change now.")
 	}
 	if d.HasChange("resource_tags") {
-		return fmt.Errorf("`resource_tags` do not support change now.")
+		return diag.Errorf("`resource_tags` do not support change now.")
 	}
 	if d.HasChange("init_params") {
-		return fmt.Errorf("`init_params` do not support change now.")
+		return diag.Errorf("`init_params` do not support change now.")
 	}
 	if d.HasChange("dcn_region") {
-		return fmt.Errorf("`dcn_region` do not support change now.")
+		return diag.Errorf("`dcn_region` do not support change now.")
 	}
 	if d.HasChange("dcn_instance_id") {
-		return fmt.Errorf("`dcn_instance_id` do not support change now.")
+		return diag.Errorf("`dcn_instance_id` do not support change now.")
 	}
 	if d.HasChange("auto_renew_flag") {
-		return fmt.Errorf("`auto_renew_flag` do not support change now.")
+		return diag.Errorf("`auto_renew_flag` do not support change now.")
 	}
 	if d.HasChange("security_group_ids") {
-		return fmt.Errorf("`security_group_ids` do not support change now.")
+		return diag.Errorf("`security_group_ids` do not support change now.")
 	}
 	if d.HasChange("instance_name") {
 		if v, ok := d.GetOk("instance_name"); ok {
 			request.InstanceName = helper.String(v.(string))
 		}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ModifyDBInstanceName(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ModifyDBInstanceNameWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -855,24 +859,24 @@ func resourceTencentCloudDcdbDbInstanceUpdate(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update dcdb dbInstance failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudDcdbDbInstanceRead(d, meta)
+	return resourceTencentCloudDcdbDbInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbDbInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbDbInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_db_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instanceId := d.Id()
 
 	if err := service.DeleteDcdbDbInstanceById(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
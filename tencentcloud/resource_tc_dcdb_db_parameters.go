@@ -27,8 +27,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -37,12 +38,15 @@ import (
 
 func resourceTencentCloudDcdbDbParameters() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcdbDbParametersCreate,
-		Read:   resourceTencentCloudDcdbDbParametersRead,
-		Update: resourceTencentCloudDcdbDbParametersUpdate,
-		Delete: resourceTencentCloudDcdbDbParametersDelete,
+		CreateContext: resourceTencentCloudDcdbDbParametersCreate,
+		ReadContext:   resourceTencentCloudDcdbDbParametersRead,
+		UpdateContext: resourceTencentCloudDcdbDbParametersUpdate,
+		DeleteContext: resourceTencentCloudDcdbDbParametersDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -75,24 +79,24 @@ func resourceTencentCloudDcdbDbParameters() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcdbDbParametersCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbDbParametersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_db_parameters.create")()
 	defer inconsistentCheck(d, meta)()
 
-	return resourceTencentCloudDcdbDbParametersUpdate(d, meta)
+	return resourceTencentCloudDcdbDbParametersUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbDbParametersRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbDbParametersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_db_parameters.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := idSplit[0]
@@ -100,11 +104,11 @@ func resourceTencentCloudDcdbDbParametersRead(d *schema.ResourceData, meta inter
 
 	dbParameters, err := service.DescribeDcdbDbParametersById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if dbParameters == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `dbParameters` %s does not exist", d.Id())
+		return diag.Errorf("resource `dbParameters` %s does not exist", d.Id())
 	}
 
 	if dbParameters.InstanceId != nil {
@@ -129,7 +133,7 @@ func resourceTencentCloudDcdbDbParametersRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudDcdbDbParametersUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbDbParametersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_db_parameters.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -139,9 +143,9 @@ func resourceTencentCloudDcdbDbParametersUpdate(d *schema.ResourceData, meta int
 		instanceId string
 		paramName  string
 		request    = dcdb.NewModifyDBParametersRequest()
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("instance_id"); ok {
 		request.InstanceId = helper.String(v.(string))
@@ -162,8 +166,8 @@ func resourceTencentCloudDcdbDbParametersUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ModifyDBParameters(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ModifyDBParametersWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -173,10 +177,10 @@ func resourceTencentCloudDcdbDbParametersUpdate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update dcdb dbParameters failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		dbParameters, err := service.DescribeDcdbDbParametersById(ctx, instanceId)
 		if err != nil {
 			return retryError(err)
@@ -198,15 +202,15 @@ func resourceTencentCloudDcdbDbParametersUpdate(d *schema.ResourceData, meta int
 		return resource.NonRetryableError(fmt.Errorf("DescribeDcdbDbParametersById not found the target param:[%s], exit...", paramName))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{instanceId, paramName}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, paramName))
 
-	return resourceTencentCloudDcdbDbParametersRead(d, meta)
+	return resourceTencentCloudDcdbDbParametersRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbDbParametersDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbDbParametersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_db_parameters.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -25,6 +25,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -33,12 +34,15 @@ import (
 
 func resourceTencentCloudDcdbDbSyncModeConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcdbDbSyncModeConfigCreate,
-		Read:   resourceTencentCloudDcdbDbSyncModeConfigRead,
-		Update: resourceTencentCloudDcdbDbSyncModeConfigUpdate,
-		Delete: resourceTencentCloudDcdbDbSyncModeConfigDelete,
+		CreateContext: resourceTencentCloudDcdbDbSyncModeConfigCreate,
+		ReadContext:   resourceTencentCloudDcdbDbSyncModeConfigRead,
+		UpdateContext: resourceTencentCloudDcdbDbSyncModeConfigUpdate,
+		DeleteContext: resourceTencentCloudDcdbDbSyncModeConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -56,7 +60,7 @@ func resourceTencentCloudDcdbDbSyncModeConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcdbDbSyncModeConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbDbSyncModeConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_db_sync_mode_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -66,16 +70,16 @@ func resourceTencentCloudDcdbDbSyncModeConfigCreate(d *schema.ResourceData, meta
 	}
 	d.SetId(instanceId)
 
-	return resourceTencentCloudDcdbDbSyncModeConfigUpdate(d, meta)
+	return resourceTencentCloudDcdbDbSyncModeConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbDbSyncModeConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbDbSyncModeConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_db_sync_mode_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -83,7 +87,7 @@ func resourceTencentCloudDcdbDbSyncModeConfigRead(d *schema.ResourceData, meta i
 
 	dbSyncModeConfig, err := service.DescribeDcdbDbSyncModeConfigById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if dbSyncModeConfig == nil {
@@ -101,7 +105,7 @@ func resourceTencentCloudDcdbDbSyncModeConfigRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudDcdbDbSyncModeConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbDbSyncModeConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_db_sync_mode_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -119,8 +123,8 @@ func resourceTencentCloudDcdbDbSyncModeConfigUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ModifyDBSyncMode(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ModifyDBSyncModeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -130,21 +134,21 @@ func resourceTencentCloudDcdbDbSyncModeConfigUpdate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update dcdb dbSyncModeConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"0"}, 2*readRetryTimeout, time.Second, service.DcdbDbSyncModeConfigStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"0"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.DcdbDbSyncModeConfigStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudDcdbDbSyncModeConfigRead(d, meta)
+	return resourceTencentCloudDcdbDbSyncModeConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbDbSyncModeConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbDbSyncModeConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_db_sync_mode_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
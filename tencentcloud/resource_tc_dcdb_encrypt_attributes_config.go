@@ -90,7 +90,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -99,12 +101,15 @@ import (
 
 func resourceTencentCloudDcdbEncryptAttributesConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcdbEncryptAttributesConfigCreate,
-		Read:   resourceTencentCloudDcdbEncryptAttributesConfigRead,
-		Update: resourceTencentCloudDcdbEncryptAttributesConfigUpdate,
-		Delete: resourceTencentCloudDcdbEncryptAttributesConfigDelete,
+		CreateContext: resourceTencentCloudDcdbEncryptAttributesConfigCreate,
+		ReadContext:   resourceTencentCloudDcdbEncryptAttributesConfigRead,
+		UpdateContext: resourceTencentCloudDcdbEncryptAttributesConfigUpdate,
+		DeleteContext: resourceTencentCloudDcdbEncryptAttributesConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -122,7 +127,7 @@ func resourceTencentCloudDcdbEncryptAttributesConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcdbEncryptAttributesConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbEncryptAttributesConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_encrypt_attributes_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -132,16 +137,16 @@ func resourceTencentCloudDcdbEncryptAttributesConfigCreate(d *schema.ResourceDat
 	}
 	d.SetId(instanceId)
 
-	return resourceTencentCloudDcdbEncryptAttributesConfigUpdate(d, meta)
+	return resourceTencentCloudDcdbEncryptAttributesConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbEncryptAttributesConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbEncryptAttributesConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_encrypt_attributes_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -149,7 +154,7 @@ func resourceTencentCloudDcdbEncryptAttributesConfigRead(d *schema.ResourceData,
 
 	encryptAttributesConfig, err := service.DescribeDcdbEncryptAttributesConfigById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if encryptAttributesConfig == nil {
@@ -167,7 +172,7 @@ func resourceTencentCloudDcdbEncryptAttributesConfigRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudDcdbEncryptAttributesConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbEncryptAttributesConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_encrypt_attributes_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -185,8 +190,8 @@ func resourceTencentCloudDcdbEncryptAttributesConfigUpdate(d *schema.ResourceDat
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ModifyDBEncryptAttributes(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ModifyDBEncryptAttributesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -196,13 +201,13 @@ func resourceTencentCloudDcdbEncryptAttributesConfigUpdate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update dcdb encryptAttributesConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudDcdbEncryptAttributesConfigRead(d, meta)
+	return resourceTencentCloudDcdbEncryptAttributesConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbEncryptAttributesConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbEncryptAttributesConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_encrypt_attributes_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
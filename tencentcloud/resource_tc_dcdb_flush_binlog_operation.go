@@ -12,8 +12,11 @@ resource "tencentcloud_dcdb_flush_binlog_operation" "flush_operation" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -22,9 +25,12 @@ import (
 
 func resourceTencentCloudDcdbFlushBinlogOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcdbFlushBinlogOperationCreate,
-		Read:   resourceTencentCloudDcdbFlushBinlogOperationRead,
-		Delete: resourceTencentCloudDcdbFlushBinlogOperationDelete,
+		CreateContext: resourceTencentCloudDcdbFlushBinlogOperationCreate,
+		ReadContext:   resourceTencentCloudDcdbFlushBinlogOperationRead,
+		DeleteContext: resourceTencentCloudDcdbFlushBinlogOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -36,7 +42,7 @@ func resourceTencentCloudDcdbFlushBinlogOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcdbFlushBinlogOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbFlushBinlogOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_flush_binlog_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -51,8 +57,8 @@ func resourceTencentCloudDcdbFlushBinlogOperationCreate(d *schema.ResourceData,
 		instanceId = v.(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().FlushBinlog(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().FlushBinlogWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -62,22 +68,22 @@ func resourceTencentCloudDcdbFlushBinlogOperationCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate dcdb flushBinlogOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudDcdbFlushBinlogOperationRead(d, meta)
+	return resourceTencentCloudDcdbFlushBinlogOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbFlushBinlogOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbFlushBinlogOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_flush_binlog_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudDcdbFlushBinlogOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbFlushBinlogOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_flush_binlog_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
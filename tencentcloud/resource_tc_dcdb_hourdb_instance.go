@@ -33,10 +33,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -45,12 +45,16 @@ import (
 
 func resourceTencentCloudDcdbHourdbInstance() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudDcdbHourdbInstanceRead,
-		Create: resourceTencentCloudDcdbHourdbInstanceCreate,
-		Update: resourceTencentCloudDcdbHourdbInstanceUpdate,
-		Delete: resourceTencentCloudDcdbHourdbInstanceDelete,
+		ReadContext:   resourceTencentCloudDcdbHourdbInstanceRead,
+		CreateContext: resourceTencentCloudDcdbHourdbInstanceCreate,
+		UpdateContext: resourceTencentCloudDcdbHourdbInstanceUpdate,
+		DeleteContext: resourceTencentCloudDcdbHourdbInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"zones": {
@@ -189,7 +193,7 @@ func resourceTencentCloudDcdbHourdbInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcdbHourdbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbHourdbInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_hourdb_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -202,9 +206,9 @@ func resourceTencentCloudDcdbHourdbInstanceCreate(d *schema.ResourceData, meta i
 		subnetId      string
 		ipv6Flag      int
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		service       = DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("zones"); ok {
 		zonesSet := v.(*schema.Set).List()
@@ -285,8 +289,8 @@ func resourceTencentCloudDcdbHourdbInstanceCreate(d *schema.ResourceData, meta i
 		dcnInstanceId = v.(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().CreateHourDCDBInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().CreateHourDCDBInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -299,7 +303,7 @@ func resourceTencentCloudDcdbHourdbInstanceCreate(d *schema.ResourceData, meta i
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create dcdb hourdbInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId = *response.Response.InstanceIds[0]
@@ -327,27 +331,27 @@ func resourceTencentCloudDcdbHourdbInstanceCreate(d *schema.ResourceData, meta i
 
 	initRet, flowId, err := service.InitDcdbDbInstance(ctx, instanceId, defaultInitParams)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !initRet {
-		return fmt.Errorf("db instance init failed")
+		return diag.Errorf("db instance init failed")
 	}
 
 	if flowId != nil {
 		// need to wait init operation success
 		// 0:success; 1:failed, 2:running
-		conf := BuildStateChangeConf([]string{}, []string{"0"}, 3*readRetryTimeout, time.Second, service.DcdbDbInstanceStateRefreshFunc(helper.UInt64Int64(*flowId), []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"0"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DcdbDbInstanceStateRefreshFunc(helper.UInt64Int64(*flowId), []string{}))
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
 	if dcnInstanceId != "" {
 		// need to wait dcn init processing complete
 		// 0:none; 1:creating, 2:running
-		conf := BuildStateChangeConf([]string{}, []string{"2"}, 3*readRetryTimeout, time.Second, service.DcdbDcnStateRefreshFunc(instanceId, []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"2"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DcdbDcnStateRefreshFunc(instanceId, []string{}))
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -355,7 +359,7 @@ func resourceTencentCloudDcdbHourdbInstanceCreate(d *schema.ResourceData, meta i
 		flag := v.(bool)
 		err := service.SetDcdbExtranetAccess(ctx, instanceId, ipv6Flag, flag)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -373,24 +377,24 @@ func resourceTencentCloudDcdbHourdbInstanceCreate(d *schema.ResourceData, meta i
 
 	if vip != "" || vipv6 != "" {
 		if vpcId == "" || subnetId == "" {
-			return fmt.Errorf("`vpc_id` and `subnet_id` cannot be empty when setting `vip` or `vipv6` fields!")
+			return diag.Errorf("`vpc_id` and `subnet_id` cannot be empty when setting `vip` or `vipv6` fields!")
 		}
 
 		err := service.SetNetworkVip(ctx, instanceId, vpcId, subnetId, vip, vipv6)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudDcdbHourdbInstanceRead(d, meta)
+	return resourceTencentCloudDcdbHourdbInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbHourdbInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbHourdbInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_hourdb_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -399,17 +403,17 @@ func resourceTencentCloudDcdbHourdbInstanceRead(d *schema.ResourceData, meta int
 	hourdbInstances, err := service.DescribeDcdbHourdbInstance(ctx, instanceId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if hourdbInstances == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `hourdbInstance` %s does not exist", instanceId)
+		return diag.Errorf("resource `hourdbInstance` %s does not exist", instanceId)
 	}
 
 	if *hourdbInstances.TotalCount > 1 || len(hourdbInstances.Instances) > 1 {
 		d.SetId("")
-		return fmt.Errorf("the count of resource `hourdbInstance` shoud not beyond one. count: %v\n", hourdbInstances.TotalCount)
+		return diag.Errorf("the count of resource `hourdbInstance` shoud not beyond one. count: %v\n", hourdbInstances.TotalCount)
 	}
 
 	hourdbInstance := hourdbInstances.Instances[0]
@@ -492,7 +496,7 @@ func resourceTencentCloudDcdbHourdbInstanceRead(d *schema.ResourceData, meta int
 		}
 		_ = d.Set("security_group_id", sgId)
 	} else {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// set dcn id and region
@@ -506,7 +510,7 @@ func resourceTencentCloudDcdbHourdbInstanceRead(d *schema.ResourceData, meta int
 			}
 		}
 	} else {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// set vip, vipv6 and vport
@@ -525,18 +529,18 @@ func resourceTencentCloudDcdbHourdbInstanceRead(d *schema.ResourceData, meta int
 			}
 		}
 	} else {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceTencentCloudDcdbHourdbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbHourdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_hourdb_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request = dcdb.NewModifyDBInstanceNameRequest()
@@ -548,27 +552,27 @@ func resourceTencentCloudDcdbHourdbInstanceUpdate(d *schema.ResourceData, meta i
 	request.InstanceId = &instanceId
 
 	if d.HasChange("zones") {
-		return fmt.Errorf("`zones` do not support change now.")
+		return diag.Errorf("`zones` do not support change now.")
 	}
 
 	if d.HasChange("shard_memory") {
-		return fmt.Errorf("`shard_memory` do not support change now.")
+		return diag.Errorf("`shard_memory` do not support change now.")
 	}
 
 	if d.HasChange("shard_storage") {
-		return fmt.Errorf("`shard_storage` do not support change now.")
+		return diag.Errorf("`shard_storage` do not support change now.")
 	}
 
 	if d.HasChange("shard_node_count") {
-		return fmt.Errorf("`shard_node_count` do not support change now.")
+		return diag.Errorf("`shard_node_count` do not support change now.")
 	}
 
 	if d.HasChange("shard_count") {
-		return fmt.Errorf("`shard_count` do not support change now.")
+		return diag.Errorf("`shard_count` do not support change now.")
 	}
 
 	if d.HasChange("db_version_id") {
-		return fmt.Errorf("`db_version_id` do not support change now.")
+		return diag.Errorf("`db_version_id` do not support change now.")
 	}
 
 	// wait for ModifyDBInstanceSecurityGroups
@@ -584,7 +588,7 @@ func resourceTencentCloudDcdbHourdbInstanceUpdate(d *schema.ResourceData, meta i
 		}
 		err := service.SetDcdbExtranetAccess(ctx, instanceId, ipv6Flag, flag)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		time.Sleep(2 * time.Second)
 	}
@@ -596,8 +600,8 @@ func resourceTencentCloudDcdbHourdbInstanceUpdate(d *schema.ResourceData, meta i
 			request.InstanceIds = []*string{&instanceId}
 			request.ProjectId = helper.IntInt64(projectId.(int))
 
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ModifyDBInstancesProject(request)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ModifyDBInstancesProjectWithContext(ctx, request)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -607,7 +611,7 @@ func resourceTencentCloudDcdbHourdbInstanceUpdate(d *schema.ResourceData, meta i
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s operate dcdb modifyInstanceProjectOperation failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		time.Sleep(2 * time.Second)
@@ -634,32 +638,32 @@ func resourceTencentCloudDcdbHourdbInstanceUpdate(d *schema.ResourceData, meta i
 		}
 
 		if vpcId == "" || subnetId == "" {
-			return fmt.Errorf("`vpc_id` and `subnet_id` cannot be empty when updating network configs!")
+			return diag.Errorf("`vpc_id` and `subnet_id` cannot be empty when updating network configs!")
 		}
 
 		err := service.SetNetworkVip(ctx, instanceId, vpcId, subnetId, vip, vipv6)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("dcn_region") {
-		return fmt.Errorf("`dcn_region` do not support change now.")
+		return diag.Errorf("`dcn_region` do not support change now.")
 	}
 	if d.HasChange("dcn_instance_id") {
-		return fmt.Errorf("`dcn_instance_id` do not support change now.")
+		return diag.Errorf("`dcn_instance_id` do not support change now.")
 	}
 
 	if d.HasChange("resource_tags") {
-		return fmt.Errorf("`resource_tags` do not support change now.")
+		return diag.Errorf("`resource_tags` do not support change now.")
 	}
 
 	if d.HasChange("instance_name") {
 		if v, ok := d.GetOk("instance_name"); ok {
 			request.InstanceName = helper.String(v.(string))
 		}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ModifyDBInstanceName(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().ModifyDBInstanceNameWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -671,26 +675,26 @@ func resourceTencentCloudDcdbHourdbInstanceUpdate(d *schema.ResourceData, meta i
 
 		if err != nil {
 			log.Printf("[CRITAL]%s create dcdb hourdbInstance failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudDcdbHourdbInstanceRead(d, meta)
+	return resourceTencentCloudDcdbHourdbInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbHourdbInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbHourdbInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_hourdb_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	instanceId := d.Id()
 
 	if err := service.DeleteDcdbHourdbInstanceById(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
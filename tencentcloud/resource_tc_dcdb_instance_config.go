@@ -24,17 +24,18 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTencentCloudDcdbInstanceConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcdbInstanceConfigCreate,
-		Read:   resourceTencentCloudDcdbInstanceConfigRead,
-		Update: resourceTencentCloudDcdbInstanceConfigUpdate,
-		Delete: resourceTencentCloudDcdbInstanceConfigDelete,
+		CreateContext: resourceTencentCloudDcdbInstanceConfigCreate,
+		ReadContext:   resourceTencentCloudDcdbInstanceConfigRead,
+		UpdateContext: resourceTencentCloudDcdbInstanceConfigUpdate,
+		DeleteContext: resourceTencentCloudDcdbInstanceConfigDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -52,7 +53,7 @@ func resourceTencentCloudDcdbInstanceConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcdbInstanceConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbInstanceConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_instance_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -62,16 +63,16 @@ func resourceTencentCloudDcdbInstanceConfigCreate(d *schema.ResourceData, meta i
 	}
 	d.SetId(instanceId)
 
-	return resourceTencentCloudDcdbInstanceConfigUpdate(d, meta)
+	return resourceTencentCloudDcdbInstanceConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbInstanceConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbInstanceConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_instance_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -79,7 +80,7 @@ func resourceTencentCloudDcdbInstanceConfigRead(d *schema.ResourceData, meta int
 
 	detail, err := service.DescribeDcdbDbInstanceDetailById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if detail == nil {
@@ -97,12 +98,12 @@ func resourceTencentCloudDcdbInstanceConfigRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudDcdbInstanceConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbInstanceConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_instance_config.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		service    = DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -120,13 +121,13 @@ func resourceTencentCloudDcdbInstanceConfigUpdate(d *schema.ResourceData, meta i
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update dcdb instanceConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudDcdbInstanceConfigRead(d, meta)
+	return resourceTencentCloudDcdbInstanceConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbInstanceConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbInstanceConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_instance_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
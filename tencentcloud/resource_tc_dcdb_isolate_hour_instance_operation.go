@@ -12,8 +12,11 @@ resource "tencentcloud_dcdb_isolate_hour_instance_operation" "isolate_hour_insta
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -22,9 +25,12 @@ import (
 
 func resourceTencentCloudDcdbIsolateHourInstanceOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcdbIsolateHourInstanceOperationCreate,
-		Read:   resourceTencentCloudDcdbIsolateHourInstanceOperationRead,
-		Delete: resourceTencentCloudDcdbIsolateHourInstanceOperationDelete,
+		CreateContext: resourceTencentCloudDcdbIsolateHourInstanceOperationCreate,
+		ReadContext:   resourceTencentCloudDcdbIsolateHourInstanceOperationRead,
+		DeleteContext: resourceTencentCloudDcdbIsolateHourInstanceOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -36,7 +42,7 @@ func resourceTencentCloudDcdbIsolateHourInstanceOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcdbIsolateHourInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbIsolateHourInstanceOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_isolate_hour_instance_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -51,8 +57,8 @@ func resourceTencentCloudDcdbIsolateHourInstanceOperationCreate(d *schema.Resour
 		request.InstanceIds = []*string{helper.String(instanceId)}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().IsolateHourDCDBInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().IsolateHourDCDBInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -62,22 +68,22 @@ func resourceTencentCloudDcdbIsolateHourInstanceOperationCreate(d *schema.Resour
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate dcdb isolateHourInstanceOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudDcdbIsolateHourInstanceOperationRead(d, meta)
+	return resourceTencentCloudDcdbIsolateHourInstanceOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbIsolateHourInstanceOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbIsolateHourInstanceOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_isolate_hour_instance_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudDcdbIsolateHourInstanceOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbIsolateHourInstanceOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_isolate_hour_instance_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -21,10 +21,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -33,11 +33,14 @@ import (
 
 func resourceTencentCloudDcdbSecurityGroupAttachment() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudDcdbSecurityGroupAttachmentRead,
-		Create: resourceTencentCloudDcdbSecurityGroupAttachmentCreate,
-		Delete: resourceTencentCloudDcdbSecurityGroupAttachmentDelete,
+		ReadContext:   resourceTencentCloudDcdbSecurityGroupAttachmentRead,
+		CreateContext: resourceTencentCloudDcdbSecurityGroupAttachmentCreate,
+		DeleteContext: resourceTencentCloudDcdbSecurityGroupAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"security_group_id": {
@@ -56,7 +59,7 @@ func resourceTencentCloudDcdbSecurityGroupAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcdbSecurityGroupAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbSecurityGroupAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_security_group_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -81,8 +84,8 @@ func resourceTencentCloudDcdbSecurityGroupAttachmentCreate(d *schema.ResourceDat
 		request.InstanceIds = []*string{helper.String(v.(string))}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().AssociateSecurityGroups(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().AssociateSecurityGroupsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -95,25 +98,25 @@ func resourceTencentCloudDcdbSecurityGroupAttachmentCreate(d *schema.ResourceDat
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create dcdb securityGroupAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(instanceId + FILED_SP + securityGroupId)
-	return resourceTencentCloudDcdbSecurityGroupAttachmentRead(d, meta)
+	d.SetId(helper.IdFormat(instanceId, securityGroupId))
+	return resourceTencentCloudDcdbSecurityGroupAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbSecurityGroupAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbSecurityGroupAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_security_group_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	securityGroupId := idSplit[1]
@@ -121,12 +124,12 @@ func resourceTencentCloudDcdbSecurityGroupAttachmentRead(d *schema.ResourceData,
 	securityGroupAttachment, err := service.DescribeDcdbSecurityGroup(ctx, instanceId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if securityGroupAttachment == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `securityGroupAttachment` %s does not exist", d.Id())
+		return diag.Errorf("resource `securityGroupAttachment` %s does not exist", d.Id())
 	}
 
 	for _, sg := range securityGroupAttachment.Groups {
@@ -137,27 +140,27 @@ func resourceTencentCloudDcdbSecurityGroupAttachmentRead(d *schema.ResourceData,
 		}
 	}
 
-	return fmt.Errorf("The security group get from api does not match with current instance %v", d.Id())
+	return diag.Errorf("The security group get from api does not match with current instance %v", d.Id())
 }
 
-func resourceTencentCloudDcdbSecurityGroupAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbSecurityGroupAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_security_group_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcdbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	securityGroupId := idSplit[1]
 
 	if err := service.DeleteDcdbSecurityGroupAttachmentById(ctx, instanceId, securityGroupId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
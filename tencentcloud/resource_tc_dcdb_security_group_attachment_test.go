@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func TestAccTencentCloudDCDBSecurityGroupAttachmentResource(t *testing.T) {
@@ -45,7 +45,7 @@ func testAccCheckDcdbSecurityGroupAttachmentDestroy(s *terraform.State) error {
 			continue
 		}
 
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		instanceId := idSplit[0]
 		securityGroupId := idSplit[1]
 
@@ -77,7 +77,7 @@ func testAccCheckDcdbSecurityGroupAttachmentExists(re string) resource.TestCheck
 			return fmt.Errorf("dcdb sg attachment instance id is not set")
 		}
 
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		instanceId := idSplit[0]
 		securityGroupId := idSplit[1]
 
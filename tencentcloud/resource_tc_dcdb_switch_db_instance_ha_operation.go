@@ -13,9 +13,11 @@ resource "tencentcloud_dcdb_switch_db_instance_ha_operation" "switch_operation"
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
@@ -24,9 +26,12 @@ import (
 
 func resourceTencentCloudDcdbSwitchDbInstanceHaOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcdbSwitchDbInstanceHaOperationCreate,
-		Read:   resourceTencentCloudDcdbSwitchDbInstanceHaOperationRead,
-		Delete: resourceTencentCloudDcdbSwitchDbInstanceHaOperationDelete,
+		CreateContext: resourceTencentCloudDcdbSwitchDbInstanceHaOperationCreate,
+		ReadContext:   resourceTencentCloudDcdbSwitchDbInstanceHaOperationRead,
+		DeleteContext: resourceTencentCloudDcdbSwitchDbInstanceHaOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -45,7 +50,7 @@ func resourceTencentCloudDcdbSwitchDbInstanceHaOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcdbSwitchDbInstanceHaOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbSwitchDbInstanceHaOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_switch_db_instance_ha_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -66,8 +71,8 @@ func resourceTencentCloudDcdbSwitchDbInstanceHaOperationCreate(d *schema.Resourc
 		request.Zone = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().SwitchDBInstanceHA(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcdbClient().SwitchDBInstanceHAWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -78,31 +83,31 @@ func resourceTencentCloudDcdbSwitchDbInstanceHaOperationCreate(d *schema.Resourc
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate dcdb switchDbInstanceHaOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if flowId != nil {
 		// need to wait init operation success
 		// 0:success; 1:failed, 2:running
-		conf := BuildStateChangeConf([]string{}, []string{"0"}, 3*readRetryTimeout, time.Second, service.DcdbDbInstanceStateRefreshFunc(helper.UInt64Int64(*flowId), []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"0"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DcdbDbInstanceStateRefreshFunc(helper.UInt64Int64(*flowId), []string{}))
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudDcdbSwitchDbInstanceHaOperationRead(d, meta)
+	return resourceTencentCloudDcdbSwitchDbInstanceHaOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcdbSwitchDbInstanceHaOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbSwitchDbInstanceHaOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_switch_db_instance_ha_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudDcdbSwitchDbInstanceHaOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcdbSwitchDbInstanceHaOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcdb_switch_db_instance_ha_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -49,9 +49,9 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -59,12 +59,12 @@ import (
 
 func resourceTencentCloudDcxInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcxInstanceCreate,
-		Read:   resourceTencentCloudDcxInstanceRead,
-		Update: resourceTencentCloudDcxInstanceUpdate,
-		Delete: resourceTencentCloudDcxInstanceDelete,
+		CreateContext: resourceTencentCloudDcxInstanceCreate,
+		ReadContext:   resourceTencentCloudDcxInstanceRead,
+		UpdateContext: resourceTencentCloudDcxInstanceUpdate,
+		DeleteContext: resourceTencentCloudDcxInstanceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -182,11 +182,11 @@ func resourceTencentCloudDcxInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcxInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcxInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcx.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -215,7 +215,7 @@ func resourceTencentCloudDcxInstanceCreate(d *schema.ResourceData, meta interfac
 	bgpAsnTemp, bgpAsnOk := d.GetOkExists("bgp_asn")
 	bgpKeyTemp, bgpKeyOk := d.GetOkExists("bgp_auth_key")
 	if bgpKeyOk && !bgpAsnOk {
-		return fmt.Errorf("bgp_auth_key need bgp_asn set")
+		return diag.Errorf("bgp_auth_key need bgp_asn set")
 	}
 	if bgpAsnOk {
 		bgpAsn = int64(bgpAsnTemp.(int))
@@ -249,11 +249,11 @@ func resourceTencentCloudDcxInstanceCreate(d *schema.ResourceData, meta interfac
 	}
 
 	if routeType == DC_ROUTE_TYPE_BGP && len(routeFilterPrefixes) > 0 {
-		return fmt.Errorf("can not set `route_filter_prefixes` if `route_type` is '%s'", DC_ROUTE_TYPE_BGP)
+		return diag.Errorf("can not set `route_filter_prefixes` if `route_type` is '%s'", DC_ROUTE_TYPE_BGP)
 	}
 
 	if routeType != DC_ROUTE_TYPE_BGP && bgpAsn != -1 {
-		return fmt.Errorf("can not set `bgp_asn`,`bgp_auth_key` if  `route_type` is not '%s'", DC_ROUTE_TYPE_BGP)
+		return diag.Errorf("can not set `bgp_asn`,`bgp_auth_key` if  `route_type` is not '%s'", DC_ROUTE_TYPE_BGP)
 	}
 
 	dcxId, err := service.CreateDirectConnectTunnel(ctx, dcId, name, networkType,
@@ -263,26 +263,26 @@ func resourceTencentCloudDcxInstanceCreate(d *schema.ResourceData, meta interfac
 		bgpAsn, vlan,
 		bandwidth, routeFilterPrefixes)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(dcxId)
 
-	return resourceTencentCloudDcxInstanceRead(d, meta)
+	return resourceTencentCloudDcxInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcxInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcxInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcx.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var (
 		dcxId = d.Id()
 	)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		item, has, e := service.DescribeDirectConnectTunnel(ctx, dcxId)
 		if e != nil {
 			return retryError(e)
@@ -332,16 +332,16 @@ func resourceTencentCloudDcxInstanceRead(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudDcxInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcxInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcx.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -356,17 +356,17 @@ func resourceTencentCloudDcxInstanceUpdate(d *schema.ResourceData, meta interfac
 
 	err := service.ModifyDirectConnectTunnelAttribute(ctx, dcxId, name, "", "", "", -1, -1, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudDcxInstanceRead(d, meta)
+	return resourceTencentCloudDcxInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcxInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcxInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcx.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -374,5 +374,5 @@ func resourceTencentCloudDcxInstanceDelete(d *schema.ResourceData, meta interfac
 		dcxId = d.Id()
 	)
 
-	return service.DeleteDirectConnectTunnel(ctx, dcxId)
+	return diag.FromErr(service.DeleteDirectConnectTunnel(ctx, dcxId))
 }
@@ -51,7 +51,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dc/v20180410"
@@ -60,12 +62,15 @@ import (
 
 func resourceTencentCloudDcxExtraConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcxExtraConfigCreate,
-		Read:   resourceTencentCloudDcxExtraConfigRead,
-		Update: resourceTencentCloudDcxExtraConfigUpdate,
-		Delete: resourceTencentCloudDcxExtraConfigDelete,
+		CreateContext: resourceTencentCloudDcxExtraConfigCreate,
+		ReadContext:   resourceTencentCloudDcxExtraConfigRead,
+		UpdateContext: resourceTencentCloudDcxExtraConfigUpdate,
+		DeleteContext: resourceTencentCloudDcxExtraConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"direct_connect_tunnel_id": {
@@ -221,7 +226,7 @@ func resourceTencentCloudDcxExtraConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDcxExtraConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcxExtraConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcx_extra_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -229,16 +234,16 @@ func resourceTencentCloudDcxExtraConfigCreate(d *schema.ResourceData, meta inter
 
 	d.SetId(directConnectTunnelId)
 
-	return resourceTencentCloudDcxExtraConfigUpdate(d, meta)
+	return resourceTencentCloudDcxExtraConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudDcxExtraConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcxExtraConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcx_extra_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -246,7 +251,7 @@ func resourceTencentCloudDcxExtraConfigRead(d *schema.ResourceData, meta interfa
 
 	dcxExtraConfig, err := service.DescribeDcxExtraConfigById(ctx, directConnectTunnelId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if dcxExtraConfig == nil {
@@ -359,7 +364,7 @@ func resourceTencentCloudDcxExtraConfigRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudDcxExtraConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcxExtraConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcx_extra_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -458,8 +463,8 @@ func resourceTencentCloudDcxExtraConfigUpdate(d *schema.ResourceData, meta inter
 		request.JumboEnable = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcClient().ModifyDirectConnectTunnelExtra(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDcClient().ModifyDirectConnectTunnelExtraWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -469,13 +474,13 @@ func resourceTencentCloudDcxExtraConfigUpdate(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create dc dcxExtraConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudDcxExtraConfigRead(d, meta)
+	return resourceTencentCloudDcxExtraConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDcxExtraConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDcxExtraConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dcx_extra_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
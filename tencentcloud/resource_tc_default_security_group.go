@@ -0,0 +1,294 @@
+/*
+Provides a resource to adopt the default security group of a project.
+
+The default security group is not created by this resource, the existing one is adopted when the resource is created, and it is created by the cloud only when the project has none. Its policies and tags are then managed as usual. Destroying the resource only removes it from the state, the default security group is left as it is.
+
+Unlike `tencentcloud_security_group`, the `ingress` and `egress` are authoritative: the policies of a direction which is not set are removed, so that a default security group without any of them denies all traffic.
+
+Example Usage
+
+Lock down the default security group
+
+```hcl
+resource "tencentcloud_default_security_group" "default" {
+  project_id = 0
+}
+```
+
+Allow the traffic inside the VPC only
+
+```hcl
+resource "tencentcloud_default_security_group" "default" {
+  ingress {
+    action     = "ACCEPT"
+    cidr_block = "10.0.0.0/8"
+    protocol   = "ALL"
+    port       = "ALL"
+  }
+
+  egress {
+    action     = "ACCEPT"
+    cidr_block = "10.0.0.0/8"
+    protocol   = "ALL"
+    port       = "ALL"
+  }
+
+  tags = {
+    "owner" = "security"
+  }
+}
+```
+
+~> **NOTE:** The policies of the default security group can not be managed by `tencentcloud_security_group_rule_set` or `tencentcloud_security_group_lite_rule` at the same time. The tags of the default security group which are not in `tags` are removed when it is adopted, except the ones matching the provider level `ignore_tags`.
+
+Import
+
+The default security group can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_default_security_group.default sg-ey3wmiz1
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudDefaultSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTencentCloudDefaultSecurityGroupCreate,
+		ReadContext:   resourceTencentCloudDefaultSecurityGroupRead,
+		UpdateContext: resourceTencentCloudDefaultSecurityGroupUpdate,
+		DeleteContext: resourceTencentCloudDefaultSecurityGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Project ID of the default security group. The default project is used when not set.",
+			},
+			"ingress": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Ingress policies of the default security group, all the ingress traffic is denied when not set. NOTE: this block is ordered, the first policy has the highest priority.",
+				Elem:        &schema.Resource{Schema: securityGroupPolicyElem()},
+			},
+			"egress": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Egress policies of the default security group, all the egress traffic is denied when not set. NOTE: this block is ordered, the first policy has the highest priority.",
+				Elem:        &schema.Resource{Schema: securityGroupPolicyElem()},
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Tags of the default security group.",
+			},
+
+			// Computed values
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the default security group.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the default security group.",
+			},
+			"policy_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the policies of the default security group, which increases on every change of them.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudDefaultSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_default_security_group.create")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	client := m.(*TencentCloudClient).apiV3Conn
+	vpcService := VpcService{client: client}
+	tagService := TagService{client: client}
+	region := client.Region
+
+	var projectId *int
+	// nolint: staticcheck
+	if v, ok := d.GetOkExists("project_id"); ok {
+		projectId = common.IntPtr(v.(int))
+	}
+
+	id, err := vpcService.CreateDefaultSecurityGroup(ctx, projectId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+	log.Printf("[DEBUG]%s default security group %s adopted", logId, id)
+
+	current, err := vpcService.DescribeSecurityGroupPolicies(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if diags := resourceTencentCloudDefaultSecurityGroupReplacePolicies(ctx, d, &vpcService, current); diags != nil {
+		return diags
+	}
+
+	liveTags, err := tagService.DescribeResourceTags(ctx, "cvm", "sg", region, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resourceName := BuildTagResourceName("cvm", "sg", region, id)
+	if err := adoptResourceTags(ctx, m, resourceName, liveTags, d.Get("tags").(map[string]interface{})); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceTencentCloudDefaultSecurityGroupRead(ctx, d, m)
+}
+
+func resourceTencentCloudDefaultSecurityGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_default_security_group.read")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	client := m.(*TencentCloudClient).apiV3Conn
+	vpcService := VpcService{client: client}
+	tagService := TagService{client: client}
+	region := client.Region
+
+	id := d.Id()
+
+	securityGroup, err := vpcService.DescribeSecurityGroup(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if securityGroup == nil {
+		log.Printf("[WARN]%s default security group %s has been deleted", logId, id)
+		d.SetId("")
+		return nil
+	}
+
+	if securityGroup.IsDefault != nil && !*securityGroup.IsDefault {
+		return diag.Errorf("security group %s is not a default security group", id)
+	}
+
+	_ = d.Set("name", securityGroup.SecurityGroupName)
+	_ = d.Set("description", securityGroup.SecurityGroupDesc)
+
+	projectId, err := strconv.Atoi(helper.PString(securityGroup.ProjectId))
+	if err != nil {
+		return diag.Errorf("security group %s project id invalid: %v", id, err)
+	}
+	_ = d.Set("project_id", projectId)
+
+	tags, err := tagService.DescribeResourceTags(ctx, "cvm", "sg", region, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("tags", tags)
+
+	policySet, err := vpcService.DescribeSecurityGroupPolicies(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if policySet != nil {
+		_ = d.Set("ingress", marshalSecurityPolicy(policySet.Ingress))
+		_ = d.Set("egress", marshalSecurityPolicy(policySet.Egress))
+		_ = d.Set("policy_version", policySet.Version)
+	}
+
+	return nil
+}
+
+func resourceTencentCloudDefaultSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_default_security_group.update")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	client := m.(*TencentCloudClient).apiV3Conn
+	vpcService := VpcService{client: client}
+	tagService := TagService{client: client}
+	region := client.Region
+
+	id := d.Id()
+
+	d.Partial(true)
+
+	if d.HasChanges("ingress", "egress") {
+		current, err := checkSecurityGroupPolicyVersion(ctx, &vpcService, id, d.Get("policy_version").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if diags := resourceTencentCloudDefaultSecurityGroupReplacePolicies(ctx, d, &vpcService, current); diags != nil {
+			return diags
+		}
+	}
+
+	if d.HasChange("tags") {
+		oldTags, newTags := d.GetChange("tags")
+		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+		resourceName := BuildTagResourceName("cvm", "sg", region, id)
+		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.Partial(false)
+
+	return resourceTencentCloudDefaultSecurityGroupRead(ctx, d, m)
+}
+
+func resourceTencentCloudDefaultSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_default_security_group.delete")()
+
+	logId := getLogId(contextNil)
+
+	log.Printf("[WARN]%s default security group %s is only removed from the state, it is not deleted", logId, d.Id())
+	return nil
+}
+
+// resourceTencentCloudDefaultSecurityGroupReplacePolicies makes the configured ingress and egress the
+// policies of the default security group, an empty direction is cleared.
+func resourceTencentCloudDefaultSecurityGroupReplacePolicies(ctx context.Context, d *schema.ResourceData, service *VpcService,
+	current *vpc.SecurityGroupPolicySet) diag.Diagnostics {
+	ingress, err := unmarshalSecurityPolicy(d.Get("ingress").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	egress, err := unmarshalSecurityPolicy(d.Get("egress").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// nothing to clear when the default security group has no policies
+	if current != nil && len(ingress) == 0 && len(egress) == 0 && len(current.Ingress) == 0 && len(current.Egress) == 0 {
+		return nil
+	}
+
+	if err := service.ReplaceSecurityGroupPolicies(ctx, d.Id(), ingress, egress, current); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
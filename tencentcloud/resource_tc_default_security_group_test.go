@@ -0,0 +1,90 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTencentCloudDefaultSecurityGroupResource_basic(t *testing.T) {
+	keyName := "tencentcloud_default_security_group.default"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDefaultSecurityGroupRetained,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultSecurityGroup,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(keyName, "project_id", "0"),
+					resource.TestCheckResourceAttrSet(keyName, "name"),
+					resource.TestCheckResourceAttr(keyName, "ingress.#", "0"),
+					resource.TestCheckResourceAttr(keyName, "egress.#", "0"),
+				),
+			},
+			{
+				ResourceName:      keyName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDefaultSecurityGroupUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(keyName, "ingress.#", "1"),
+					resource.TestCheckResourceAttr(keyName, "ingress.0.cidr_block", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(keyName, "ingress.0.port", "22"),
+					resource.TestCheckResourceAttr(keyName, "egress.#", "0"),
+					resource.TestCheckResourceAttr(keyName, "tags.tf-ci-test", "default-sg"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckDefaultSecurityGroupRetained checks the default security group is left as it is after the
+// resource is destroyed.
+func testAccCheckDefaultSecurityGroupRetained(s *terraform.State) error {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	service := VpcService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_default_security_group" {
+			continue
+		}
+		sg, err := service.DescribeSecurityGroup(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if sg == nil {
+			return fmt.Errorf("default security group %s should be retained after destroy", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+const testAccDefaultSecurityGroup = `
+resource "tencentcloud_default_security_group" "default" {
+  project_id = 0
+}
+`
+
+const testAccDefaultSecurityGroupUpdate = `
+resource "tencentcloud_default_security_group" "default" {
+  project_id = 0
+
+  ingress {
+    action     = "ACCEPT"
+    cidr_block = "10.0.0.0/8"
+    protocol   = "TCP"
+    port       = "22"
+  }
+
+  tags = {
+    "tf-ci-test" = "default-sg"
+  }
+}
+`
@@ -0,0 +1,322 @@
+/*
+Provides a resource to adopt the default VPC of the region.
+
+The default VPC is not created by this resource, the existing one is adopted when the resource is created, and it is created by the cloud only when the region has none. Its attributes and tags are then managed as usual. Destroying the resource only removes it from the state, the default VPC is left as it is.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_default_vpc" "default" {
+  name         = "default-vpc"
+  is_multicast = false
+
+  tags = {
+    "owner" = "network"
+  }
+}
+```
+
+Create the default VPC in a specific zone when the region has none
+
+```hcl
+resource "tencentcloud_default_vpc" "default" {
+  zone = "ap-guangzhou-3"
+}
+```
+
+~> **NOTE:** The tags of the default VPC which are not in `tags` are removed when it is adopted, except the ones matching the provider level `ignore_tags`.
+
+Import
+
+The default VPC can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_default_vpc.default vpc-id
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudDefaultVpc() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTencentCloudDefaultVpcCreate,
+		ReadContext:   resourceTencentCloudDefaultVpcRead,
+		UpdateContext: resourceTencentCloudDefaultVpcUpdate,
+		DeleteContext: resourceTencentCloudDefaultVpcDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The zone of the default subnet, which is only used when the region has no default VPC and it is created.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateStringLengthInRange(1, 60),
+				Description:  "The name of the default VPC. The name is left as it is when not set.",
+			},
+			"dns_servers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set: func(v interface{}) int {
+					return helper.HashString(v.(string))
+				},
+				Description: "The DNS server list of the default VPC. And you can specify 1 to 4 servers to this list.",
+			},
+			"is_multicast": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether multicast of the default VPC is enabled. It is left as it is when not set.",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Tags of the default VPC.",
+			},
+
+			// Computed values
+			"cidr_block": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The network address block of the default VPC.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the default VPC.",
+			},
+			"default_route_table_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Default route table id of the default VPC.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudDefaultVpcCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_default_vpc.create")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	client := meta.(*TencentCloudClient).apiV3Conn
+	vpcService := VpcService{client: client}
+
+	vpcId, _, err := vpcService.CreateDefaultVpc(ctx, d.Get("zone").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	info, has, err := vpcService.DescribeVpc(ctx, vpcId, "", "")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if has != 1 {
+		return diag.Errorf("one vpc_id read get %d vpc info", has)
+	}
+	if !info.isDefault {
+		return diag.Errorf("vpc %s returned as the default VPC is not the default one of region %s", vpcId, client.Region)
+	}
+
+	d.SetId(vpcId)
+	log.Printf("[DEBUG]%s default vpc %s adopted", logId, vpcId)
+
+	var (
+		name        = info.name
+		dnsServers  = info.dnsServers
+		isMulticast = info.isMulticast
+		modify      bool
+	)
+	if v, ok := d.GetOk("name"); ok && v.(string) != name {
+		name = v.(string)
+		modify = true
+	}
+	if v, ok := d.GetOk("dns_servers"); ok {
+		set := v.(*schema.Set)
+		if set.Len() > 4 {
+			return diag.Errorf("If dns_servers is set, then len(dns_servers) should be [1:4]")
+		}
+		live := schema.NewSet(set.F, nil)
+		for _, server := range dnsServers {
+			live.Add(server)
+		}
+		if !set.Equal(live) {
+			dnsServers = helper.InterfacesStrings(set.List())
+			modify = true
+		}
+	}
+	// nolint: staticcheck
+	if v, ok := d.GetOkExists("is_multicast"); ok && v.(bool) != isMulticast {
+		isMulticast = v.(bool)
+		modify = true
+	}
+
+	if modify {
+		if err := vpcService.ModifyVpcAttribute(ctx, vpcId, name, isMulticast, dnsServers); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	liveTags := make(map[string]string, len(info.tags))
+	for _, tag := range info.tags {
+		liveTags[helper.PString(tag.Key)] = helper.PString(tag.Value)
+	}
+	resourceName := fmt.Sprintf("qcs::vpc:%s:uin/:vpc/%s", client.Region, vpcId)
+	if err := adoptResourceTags(ctx, meta, resourceName, liveTags, d.Get("tags").(map[string]interface{})); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceTencentCloudDefaultVpcRead(ctx, d, meta)
+}
+
+func resourceTencentCloudDefaultVpcRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_default_vpc.read")()
+	defer inconsistentCheck(d, meta)()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	id := d.Id()
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		info, has, e := service.DescribeVpc(ctx, id, "", "")
+		if e != nil {
+			return retryError(e)
+		}
+
+		if has == 0 {
+			log.Printf("[WARN]%s default vpc %s has been deleted\n", logId, id)
+			d.SetId("")
+			return nil
+		}
+
+		if has != 1 {
+			errRet := fmt.Errorf("one vpc_id read get %d vpc info", has)
+			log.Printf("[CRITAL]%s %s\n", logId, errRet.Error())
+			return resource.NonRetryableError(errRet)
+		}
+
+		routeTables, err := service.DescribeRouteTables(ctx, "", "", id, nil, helper.Bool(true), "")
+		if err != nil {
+			log.Printf("[WARN] Describe default Route Table error: %s", err.Error())
+		}
+		for _, routeTable := range routeTables {
+			if routeTable.isDefault {
+				_ = d.Set("default_route_table_id", routeTable.routeTableId)
+				break
+			}
+		}
+
+		tags := make(map[string]string, len(info.tags))
+		for _, tag := range info.tags {
+			if tag.Key == nil || tag.Value == nil {
+				return resource.NonRetryableError(fmt.Errorf("vpc %s tag key or value is nil", id))
+			}
+			tags[*tag.Key] = *tag.Value
+		}
+
+		_ = d.Set("name", info.name)
+		_ = d.Set("cidr_block", info.cidr)
+		_ = d.Set("dns_servers", info.dnsServers)
+		_ = d.Set("is_multicast", info.isMulticast)
+		_ = d.Set("create_time", info.createTime)
+		_ = d.Set("tags", tags)
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceTencentCloudDefaultVpcUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_default_vpc.update")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	client := meta.(*TencentCloudClient).apiV3Conn
+	vpcService := VpcService{client: client}
+
+	id := d.Id()
+
+	d.Partial(true)
+
+	if d.HasChanges("name", "dns_servers", "is_multicast") {
+		dnsServers := helper.InterfacesStrings(d.Get("dns_servers").(*schema.Set).List())
+		if len(dnsServers) < 1 || len(dnsServers) > 4 {
+			return diag.Errorf("If dns_servers is set, then len(dns_servers) should be [1:4]")
+		}
+		if err := vpcService.ModifyVpcAttribute(ctx, id, d.Get("name").(string), d.Get("is_multicast").(bool), dnsServers); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		oldTags, newTags := d.GetChange("tags")
+		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+		tagService := TagService{client: client}
+		resourceName := fmt.Sprintf("qcs::vpc:%s:uin/:vpc/%s", client.Region, id)
+		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.Partial(false)
+
+	return resourceTencentCloudDefaultVpcRead(ctx, d, meta)
+}
+
+func resourceTencentCloudDefaultVpcDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_default_vpc.delete")()
+
+	logId := getLogId(contextNil)
+
+	log.Printf("[WARN]%s default vpc %s is only removed from the state, it is not deleted", logId, d.Id())
+	return nil
+}
+
+// adoptResourceTags makes tags the tags of an adopted resource, the live tags which are not in them
+// are deleted unless they match the provider level `ignore_tags`.
+func adoptResourceTags(ctx context.Context, meta interface{}, resourceName string, liveTags map[string]string, tags map[string]interface{}) error {
+	client := meta.(*TencentCloudClient)
+
+	oldTags := make(map[string]interface{}, len(liveTags))
+	for k, v := range liveTags {
+		if client.ignoreTags.ignored(k) {
+			continue
+		}
+		oldTags[k] = v
+	}
+
+	replaceTags, deleteTags := diffTags(oldTags, tags)
+	if len(replaceTags) == 0 && len(deleteTags) == 0 {
+		return nil
+	}
+
+	tagService := TagService{client: client.apiV3Conn}
+	return tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
+}
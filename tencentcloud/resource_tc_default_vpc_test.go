@@ -0,0 +1,77 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTencentCloudDefaultVpcResource_basic(t *testing.T) {
+	keyName := "tencentcloud_default_vpc.default"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDefaultVpcRetained,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultVpc,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(keyName, "cidr_block"),
+					resource.TestCheckResourceAttrSet(keyName, "default_route_table_id"),
+					resource.TestCheckResourceAttr(keyName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(keyName, "tags.tf-ci-test", "default-vpc"),
+				),
+			},
+			{
+				ResourceName:      keyName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDefaultVpcUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(keyName, "dns_servers.#", "2"),
+					resource.TestCheckResourceAttr(keyName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckDefaultVpcRetained checks the default VPC is left as it is after the resource is destroyed.
+func testAccCheckDefaultVpcRetained(s *terraform.State) error {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	service := VpcService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_default_vpc" {
+			continue
+		}
+		info, has, err := service.DescribeVpc(ctx, rs.Primary.ID, "", "")
+		if err != nil {
+			return err
+		}
+		if has == 0 || !info.isDefault {
+			return fmt.Errorf("default vpc %s should be retained after destroy", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+const testAccDefaultVpc = `
+resource "tencentcloud_default_vpc" "default" {
+  tags = {
+    "tf-ci-test" = "default-vpc"
+  }
+}
+`
+
+const testAccDefaultVpcUpdate = `
+resource "tencentcloud_default_vpc" "default" {
+  dns_servers = ["119.29.29.29", "183.60.83.19"]
+}
+`
@@ -24,9 +24,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dlc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dlc/v20210125"
@@ -35,12 +36,16 @@ import (
 
 func resourceTencentCloudDlcUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDlcUserCreate,
-		Read:   resourceTencentCloudDlcUserRead,
-		Update: resourceTencentCloudDlcUserUpdate,
-		Delete: resourceTencentCloudDlcUserDelete,
+		CreateContext: resourceTencentCloudDlcUserCreate,
+		ReadContext:   resourceTencentCloudDlcUserRead,
+		UpdateContext: resourceTencentCloudDlcUserUpdate,
+		DeleteContext: resourceTencentCloudDlcUserDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"user_id": {
@@ -80,7 +85,7 @@ func resourceTencentCloudDlcUser() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDlcUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDlcUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dlc_user.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -107,8 +112,8 @@ func resourceTencentCloudDlcUserCreate(d *schema.ResourceData, meta interface{})
 		request.UserAlias = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDlcClient().CreateUser(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDlcClient().CreateUserWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -118,21 +123,21 @@ func resourceTencentCloudDlcUserCreate(d *schema.ResourceData, meta interface{})
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create dlc user failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(userId)
 
-	return resourceTencentCloudDlcUserRead(d, meta)
+	return resourceTencentCloudDlcUserRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDlcUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDlcUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dlc_user.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DlcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -140,7 +145,7 @@ func resourceTencentCloudDlcUserRead(d *schema.ResourceData, meta interface{}) e
 
 	user, err := service.DescribeDlcUserById(ctx, userId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if user == nil {
@@ -177,7 +182,7 @@ func resourceTencentCloudDlcUserRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceTencentCloudDlcUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDlcUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dlc_user.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -193,7 +198,7 @@ func resourceTencentCloudDlcUserUpdate(d *schema.ResourceData, meta interface{})
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -203,8 +208,8 @@ func resourceTencentCloudDlcUserUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDlcClient().ModifyUser(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDlcClient().ModifyUserWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -214,24 +219,24 @@ func resourceTencentCloudDlcUserUpdate(d *schema.ResourceData, meta interface{})
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update dlc user failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudDlcUserRead(d, meta)
+	return resourceTencentCloudDlcUserRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDlcUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDlcUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dlc_user.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DlcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	userId := d.Id()
 
 	if err := service.DeleteDlcUserById(ctx, userId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
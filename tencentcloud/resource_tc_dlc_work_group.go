@@ -23,7 +23,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dlc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dlc/v20210125"
@@ -32,12 +34,16 @@ import (
 
 func resourceTencentCloudDlcWorkGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDlcWorkGroupCreate,
-		Read:   resourceTencentCloudDlcWorkGroupRead,
-		Update: resourceTencentCloudDlcWorkGroupUpdate,
-		Delete: resourceTencentCloudDlcWorkGroupDelete,
+		CreateContext: resourceTencentCloudDlcWorkGroupCreate,
+		ReadContext:   resourceTencentCloudDlcWorkGroupRead,
+		UpdateContext: resourceTencentCloudDlcWorkGroupUpdate,
+		DeleteContext: resourceTencentCloudDlcWorkGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"work_group_name": {
@@ -65,7 +71,7 @@ func resourceTencentCloudDlcWorkGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDlcWorkGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDlcWorkGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dlc_work_group.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -84,8 +90,8 @@ func resourceTencentCloudDlcWorkGroupCreate(d *schema.ResourceData, meta interfa
 		request.WorkGroupDescription = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDlcClient().CreateWorkGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDlcClient().CreateWorkGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -96,22 +102,22 @@ func resourceTencentCloudDlcWorkGroupCreate(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create dlc workGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	workGroupId = *response.Response.WorkGroupId
 	d.SetId(helper.Int64ToStr(workGroupId))
 
-	return resourceTencentCloudDlcWorkGroupRead(d, meta)
+	return resourceTencentCloudDlcWorkGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDlcWorkGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDlcWorkGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dlc_work_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DlcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -119,7 +125,7 @@ func resourceTencentCloudDlcWorkGroupRead(d *schema.ResourceData, meta interface
 
 	workGroup, err := service.DescribeDlcWorkGroupById(ctx, workGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if workGroup == nil {
@@ -150,7 +156,7 @@ func resourceTencentCloudDlcWorkGroupRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudDlcWorkGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDlcWorkGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dlc_work_group.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -168,8 +174,8 @@ func resourceTencentCloudDlcWorkGroupUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDlcClient().ModifyWorkGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDlcClient().ModifyWorkGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -179,24 +185,24 @@ func resourceTencentCloudDlcWorkGroupUpdate(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update dlc workGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudDlcWorkGroupRead(d, meta)
+	return resourceTencentCloudDlcWorkGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDlcWorkGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDlcWorkGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dlc_work_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DlcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	workGroupId := d.Id()
 
 	if err := service.DeleteDlcWorkGroupById(ctx, workGroupId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -23,9 +23,11 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dnspod "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dnspod/v20210323"
@@ -33,12 +35,15 @@ import (
 
 func resourceTencentCloudDnspodDomainInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDnspodDomainInstanceCreate,
-		Read:   resourceTencentCloudDnspodDomainInstanceRead,
-		Update: resourceTencentCloudDnspodDomainInstanceUpdate,
-		Delete: resourceTencentCloudDnspodDomainInstanceDelete,
+		CreateContext: resourceTencentCloudDnspodDomainInstanceCreate,
+		ReadContext:   resourceTencentCloudDnspodDomainInstanceRead,
+		UpdateContext: resourceTencentCloudDnspodDomainInstanceUpdate,
+		DeleteContext: resourceTencentCloudDnspodDomainInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -82,11 +87,11 @@ func resourceTencentCloudDnspodDomainInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDnspodDomainInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDnspodDomainInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dnspod_domain.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := dnspod.NewCreateDomainRequest()
 	var (
@@ -109,12 +114,12 @@ func resourceTencentCloudDnspodDomainInstanceCreate(d *schema.ResourceData, meta
 	request.GroupId = &groupId
 	request.IsMark = &isMark
 
-	result, err := meta.(*TencentCloudClient).apiV3Conn.UseDnsPodClient().CreateDomain(request)
+	result, err := meta.(*TencentCloudClient).apiV3Conn.UseDnsPodClient().CreateDomainWithContext(ctx, request)
 	response = result
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create DnsPod Domain failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(*response.Response.DomainInfo.Domain)
 
@@ -126,7 +131,7 @@ func resourceTencentCloudDnspodDomainInstanceCreate(d *schema.ResourceData, meta
 		err := service.ModifyDnsPodDomainStatus(ctx, *domainId, status)
 		if err != nil {
 			log.Printf("[CRITAL]%s set DnsPod Domain status failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -136,13 +141,13 @@ func resourceTencentCloudDnspodDomainInstanceCreate(d *schema.ResourceData, meta
 		err := service.ModifyDnsPodDomainRemark(ctx, *domainId, remark)
 		if err != nil {
 			log.Printf("[CRITAL]%s set DnsPod Domain remark failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudDnspodDomainInstanceRead(d, meta)
+	return resourceTencentCloudDnspodDomainInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDnspodDomainInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDnspodDomainInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dnspod_domain.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -155,8 +160,8 @@ func resourceTencentCloudDnspodDomainInstanceRead(d *schema.ResourceData, meta i
 
 	var response *dnspod.DescribeDomainResponse
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDnsPodClient().DescribeDomain(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDnsPodClient().DescribeDomainWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -174,16 +179,16 @@ func resourceTencentCloudDnspodDomainInstanceRead(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read DnsPod Domain failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudDnspodDomainInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDnspodDomainInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dnspod_domain.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	service := DnspodService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -193,7 +198,7 @@ func resourceTencentCloudDnspodDomainInstanceUpdate(d *schema.ResourceData, meta
 		err := service.ModifyDnsPodDomainStatus(ctx, id, status)
 		if err != nil {
 			log.Printf("[CRITAL]%s modify DnsPod Domain status failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -202,15 +207,15 @@ func resourceTencentCloudDnspodDomainInstanceUpdate(d *schema.ResourceData, meta
 		err := service.ModifyDnsPodDomainRemark(ctx, id, remark)
 		if err != nil {
 			log.Printf("[CRITAL]%s modify DnsPod Domain remark failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.Partial(false)
-	return resourceTencentCloudDnspodDomainInstanceRead(d, meta)
+	return resourceTencentCloudDnspodDomainInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDnspodDomainInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDnspodDomainInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dnspod_domain.delete")()
 
 	logId := getLogId(contextNil)
@@ -218,8 +223,8 @@ func resourceTencentCloudDnspodDomainInstanceDelete(d *schema.ResourceData, meta
 	request := dnspod.NewDeleteDomainRequest()
 	request.Domain = helper.String(d.Id())
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UseDnsPodClient().DeleteDomain(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseDnsPodClient().DeleteDomainWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -227,7 +232,7 @@ func resourceTencentCloudDnspodDomainInstanceDelete(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete DnsPod Domain failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -24,11 +24,13 @@ $ terraform import tencentcloud_dnspod_record.demo arunma.com#1194109872
 package tencentcloud
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dnspod "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dnspod/v20210323"
@@ -37,12 +39,17 @@ import (
 
 func resourceTencentCloudDnspodRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDnspodRecordCreate,
-		Read:   resourceTencentCloudDnspodRecordRead,
-		Update: resourceTencentCloudDnspodRecordUpdate,
-		Delete: resourceTencentCloudDnspodRecordDelete,
+		CreateContext: resourceTencentCloudDnspodRecordCreate,
+		ReadContext:   resourceTencentCloudDnspodRecordRead,
+		UpdateContext: resourceTencentCloudDnspodRecordUpdate,
+		DeleteContext: resourceTencentCloudDnspodRecordDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -105,7 +112,7 @@ func resourceTencentCloudDnspodRecord() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDnspodRecordCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDnspodRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dnspod_record.create")()
 	logId := getLogId(contextNil)
 	request := dnspod.NewCreateRecordRequest()
@@ -131,33 +138,33 @@ func resourceTencentCloudDnspodRecordCreate(d *schema.ResourceData, meta interfa
 	}
 	request.Status = &status
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		response, e := meta.(*TencentCloudClient).apiV3Conn.UseDnsPodClient().CreateRecord(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		response, e := meta.(*TencentCloudClient).apiV3Conn.UseDnsPodClient().CreateRecordWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
 		recordId := *response.Response.RecordId
 
-		d.SetId(domain + FILED_SP + fmt.Sprint(recordId))
+		d.SetId(helper.IdFormat(domain, fmt.Sprint(recordId)))
 
 		return nil
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create DnsPod record failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudDnspodRecordRead(d, meta)
+	return resourceTencentCloudDnspodRecordRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDnspodRecordRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDnspodRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dnspod_record.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
 	id := d.Id()
-	items := strings.Split(id, FILED_SP)
+	items := helper.IdParse(id)
 	if len(items) < 2 {
 		return nil
 	}
@@ -165,12 +172,12 @@ func resourceTencentCloudDnspodRecordRead(d *schema.ResourceData, meta interface
 	request.Domain = helper.String(items[0])
 	recordId, err := strconv.Atoi(items[1])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	request.RecordId = helper.IntUint64(recordId)
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		response, e := meta.(*TencentCloudClient).apiV3Conn.UseDnsPodClient().DescribeRecord(request)
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		response, e := meta.(*TencentCloudClient).apiV3Conn.UseDnsPodClient().DescribeRecordWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -196,23 +203,23 @@ func resourceTencentCloudDnspodRecordRead(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read DnsPod record failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudDnspodRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDnspodRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dnspod_record.update")()
 
 	id := d.Id()
-	items := strings.Split(id, FILED_SP)
+	items := helper.IdParse(id)
 	if len(items) < 2 {
 		return nil
 	}
 	domain := items[0]
 	recordId, err := strconv.Atoi(items[1])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	request := dnspod.NewModifyRecordRequest()
 	request.Domain = &domain
@@ -244,8 +251,8 @@ func resourceTencentCloudDnspodRecordUpdate(d *schema.ResourceData, meta interfa
 		request.TTL = helper.IntUint64(weight)
 	}
 	d.Partial(true)
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UseDnsPodClient().ModifyRecord(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseDnsPodClient().ModifyRecordWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -253,32 +260,32 @@ func resourceTencentCloudDnspodRecordUpdate(d *schema.ResourceData, meta interfa
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Partial(false)
-	return resourceTencentCloudDnspodRecordRead(d, meta)
+	return resourceTencentCloudDnspodRecordRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDnspodRecordDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDnspodRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dnspod_record.delete")()
 
 	logId := getLogId(contextNil)
 	id := d.Id()
-	items := strings.Split(id, FILED_SP)
+	items := helper.IdParse(id)
 	if len(items) < 2 {
 		return nil
 	}
 	domain := items[0]
 	recordId, err := strconv.Atoi(items[1])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	request := dnspod.NewDeleteRecordRequest()
 	request.Domain = helper.String(domain)
 	request.RecordId = helper.IntUint64(recordId)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UseDnsPodClient().DeleteRecord(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseDnsPodClient().DeleteRecordWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -286,7 +293,7 @@ func resourceTencentCloudDnspodRecordDelete(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete DnsPod record failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
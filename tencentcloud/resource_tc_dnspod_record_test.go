@@ -3,7 +3,6 @@ package tencentcloud
 import (
 	"fmt"
 	"strconv"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -49,7 +48,7 @@ func testAccCheckDnspodRecordExists(n string) resource.TestCheckFunc {
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("dnspod record id is not set")
 		}
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 2 {
 			return nil
 		}
@@ -85,7 +84,7 @@ func testAccCheckDnspodRecordDestroy(s *terraform.State) error {
 			continue
 		}
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) < 2 {
 			return nil
 		}
@@ -33,10 +33,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
@@ -45,10 +45,14 @@ import (
 
 func resourceTencentCloudDtsCompareTask() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudDtsCompareTaskRead,
-		Create: resourceTencentCloudDtsCompareTaskCreate,
-		Update: resourceTencentCloudDtsCompareTaskUpdate,
-		Delete: resourceTencentCloudDtsCompareTaskDelete,
+		ReadContext:   resourceTencentCloudDtsCompareTaskRead,
+		CreateContext: resourceTencentCloudDtsCompareTaskCreate,
+		UpdateContext: resourceTencentCloudDtsCompareTaskUpdate,
+		DeleteContext: resourceTencentCloudDtsCompareTaskDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		// Importer: &schema.ResourceImporter{
 		// 	State: schema.ImportStatePassthrough,
 		// },
@@ -168,7 +172,7 @@ func resourceTencentCloudDtsCompareTask() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDtsCompareTaskCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsCompareTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_compare_task.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -179,10 +183,10 @@ func resourceTencentCloudDtsCompareTaskCreate(d *schema.ResourceData, meta inter
 		response      *dts.CreateCompareTaskResponse
 		startRequest  = dts.NewStartCompareRequest()
 		service       = DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		jobId         string
 		compareTaskId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("job_id"); ok {
 		jobId = v.(string)
@@ -252,8 +256,8 @@ func resourceTencentCloudDtsCompareTaskCreate(d *schema.ResourceData, meta inter
 	}
 
 	// create compareTask
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().CreateCompareTask(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().CreateCompareTaskWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -266,19 +270,19 @@ func resourceTencentCloudDtsCompareTaskCreate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create dts compareTask failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// wait created
 	if err = service.PollingCompareTaskStatusUntil(ctx, jobId, compareTaskId, "created"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// start compareTask
 	compareTaskId = *response.Response.CompareTaskId
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		startRequest.CompareTaskId = helper.String(compareTaskId)
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().StartCompare(startRequest)
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().StartCompareWithContext(ctx, startRequest)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -290,30 +294,30 @@ func resourceTencentCloudDtsCompareTaskCreate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s start dts compareTask failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// wait running
 	if err = service.PollingCompareTaskStatusUntil(ctx, jobId, compareTaskId, "running"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(jobId + FILED_SP + compareTaskId)
-	return resourceTencentCloudDtsCompareTaskRead(d, meta)
+	d.SetId(helper.IdFormat(jobId, compareTaskId))
+	return resourceTencentCloudDtsCompareTaskRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsCompareTaskRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsCompareTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_compare_task.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	jobId := idSplit[0]
 	compareTaskId := idSplit[1]
@@ -321,12 +325,12 @@ func resourceTencentCloudDtsCompareTaskRead(d *schema.ResourceData, meta interfa
 	ret, err := service.DescribeDtsCompareTask(ctx, helper.String(jobId), helper.String(compareTaskId))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ret == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `compareTask` %s does not exist", compareTaskId)
+		return diag.Errorf("resource `compareTask` %s does not exist", compareTaskId)
 	}
 
 	if len(ret) > 0 {
@@ -413,7 +417,7 @@ func resourceTencentCloudDtsCompareTaskRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudDtsCompareTaskUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsCompareTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_compare_task.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -422,9 +426,9 @@ func resourceTencentCloudDtsCompareTaskUpdate(d *schema.ResourceData, meta inter
 
 	request := dts.NewModifyCompareTaskRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	jobId := idSplit[0]
 	compareTaskId := idSplit[1]
@@ -433,7 +437,7 @@ func resourceTencentCloudDtsCompareTaskUpdate(d *schema.ResourceData, meta inter
 	request.CompareTaskId = &compareTaskId
 
 	if d.HasChange("job_id") {
-		return fmt.Errorf("`job_id` do not support change now.")
+		return diag.Errorf("`job_id` do not support change now.")
 	}
 
 	if d.HasChange("task_name") {
@@ -501,8 +505,8 @@ func resourceTencentCloudDtsCompareTaskUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().ModifyCompareTask(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().ModifyCompareTaskWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -514,30 +518,30 @@ func resourceTencentCloudDtsCompareTaskUpdate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create dts compareTask failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudDtsCompareTaskRead(d, meta)
+	return resourceTencentCloudDtsCompareTaskRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsCompareTaskDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsCompareTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_compare_task.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	jobId := idSplit[0]
 	compareTaskId := idSplit[1]
 
 	if err := service.DeleteDtsCompareTaskById(ctx, jobId, compareTaskId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -14,8 +14,11 @@ resource "tencentcloud_dts_compare_task_stop_operation" "compare_task_stop_opera
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
@@ -24,9 +27,12 @@ import (
 
 func resourceTencentCloudDtsCompareTaskStopOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDtsCompareTaskStopOperationCreate,
-		Read:   resourceTencentCloudDtsCompareTaskStopOperationRead,
-		Delete: resourceTencentCloudDtsCompareTaskStopOperationDelete,
+		CreateContext: resourceTencentCloudDtsCompareTaskStopOperationCreate,
+		ReadContext:   resourceTencentCloudDtsCompareTaskStopOperationRead,
+		DeleteContext: resourceTencentCloudDtsCompareTaskStopOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Required:    true,
@@ -45,7 +51,7 @@ func resourceTencentCloudDtsCompareTaskStopOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDtsCompareTaskStopOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsCompareTaskStopOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_compare_task_stop_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -70,8 +76,8 @@ func resourceTencentCloudDtsCompareTaskStopOperationCreate(d *schema.ResourceDat
 		request.JobId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().StopCompare(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().StopCompareWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -81,22 +87,22 @@ func resourceTencentCloudDtsCompareTaskStopOperationCreate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate dts compareTaskStopOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(jobId + FILED_SP + compareTaskId)
+	d.SetId(helper.IdFormat(jobId, compareTaskId))
 
-	return resourceTencentCloudDtsCompareTaskStopOperationRead(d, meta)
+	return resourceTencentCloudDtsCompareTaskStopOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsCompareTaskStopOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsCompareTaskStopOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_compare_task_stop_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudDtsCompareTaskStopOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsCompareTaskStopOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_compare_task_stop_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -129,6 +129,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
@@ -138,12 +139,15 @@ import (
 
 func resourceTencentCloudDtsMigrateJob() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDtsMigrateJobCreate,
-		Read:   resourceTencentCloudDtsMigrateJobRead,
-		Update: resourceTencentCloudDtsMigrateJobUpdate,
-		Delete: resourceTencentCloudDtsMigrateJobDelete,
+		CreateContext: resourceTencentCloudDtsMigrateJobCreate,
+		ReadContext:   resourceTencentCloudDtsMigrateJobRead,
+		UpdateContext: resourceTencentCloudDtsMigrateJobUpdate,
+		DeleteContext: resourceTencentCloudDtsMigrateJobDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"service_id": {
@@ -785,7 +789,7 @@ func resourceTencentCloudDtsMigrateJob() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDtsMigrateJobCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsMigrateJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_migrate_job.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -803,48 +807,48 @@ func resourceTencentCloudDtsMigrateJobCreate(d *schema.ResourceData, meta interf
 	}
 
 	// case "modify":
-	err := handleModifyMigrate(d, tcClient, logId, serviceId)
+	err := handleModifyMigrate(ctx, d, tcClient, logId, serviceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	conf = BuildStateChangeConf([]string{}, []string{"created"}, 3*readRetryTimeout, time.Second, service.DtsMigrateJobStateRefreshFunc(serviceId, []string{}))
+	conf = BuildStateChangeConf([]string{}, []string{"created"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DtsMigrateJobStateRefreshFunc(serviceId, []string{}))
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	// case "check":
-	err = handleCheckMigrate(d, tcClient, logId, serviceId)
+	err = handleCheckMigrate(ctx, d, tcClient, logId, serviceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	conf = BuildStateChangeConf([]string{}, []string{"checkPass", "checkNotPass"}, 3*readRetryTimeout, time.Second, service.DtsMigrateCheckConfigStateRefreshFunc(serviceId, []string{}))
+	conf = BuildStateChangeConf([]string{}, []string{"checkPass", "checkNotPass"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DtsMigrateCheckConfigStateRefreshFunc(serviceId, []string{}))
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	d.SetId(serviceId)
-	return resourceTencentCloudDtsMigrateJobRead(d, meta)
+	return resourceTencentCloudDtsMigrateJobRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsMigrateJobUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsMigrateJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_migrate_job.update")()
 	defer inconsistentCheck(d, meta)()
 	logId := getLogId(contextNil)
 
 	log.Printf("[DEBUG]%s tencentcloud_dts_migrate_job.update in. id:[%s]\n", logId, d.Id())
 
-	return resourceTencentCloudDtsMigrateJobCreate(d, meta)
+	return resourceTencentCloudDtsMigrateJobCreate(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsMigrateJobRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsMigrateJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_migrate_job.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -852,12 +856,12 @@ func resourceTencentCloudDtsMigrateJobRead(d *schema.ResourceData, meta interfac
 	log.Printf("[DEBUG]%s tencentcloud_dts_migrate_job.read trying to call DescribeDtsMigrateJobById. jobId:[%s]\n", logId, jobId)
 	migrateJob, err := service.DescribeDtsMigrateJobById(ctx, jobId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if migrateJob == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", d.Id())
+		return diag.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
 	if migrateJob.JobId != nil {
@@ -1388,7 +1392,7 @@ func resourceTencentCloudDtsMigrateJobRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func handleModifyMigrate(d *schema.ResourceData, tcClient *connectivity.TencentCloudClient, logId, jobId string) error {
+func handleModifyMigrate(ctx context.Context, d *schema.ResourceData, tcClient *connectivity.TencentCloudClient, logId, jobId string) error {
 	configMigrationJobRequest := dts.NewModifyMigrationJobRequest()
 	configMigrationJobRequest.JobId = helper.String(jobId)
 
@@ -1783,8 +1787,8 @@ func handleModifyMigrate(d *schema.ResourceData, tcClient *connectivity.TencentC
 		configMigrationJobRequest.AutoRetryTimeRangeMinutes = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := tcClient.UseDtsClient().ModifyMigrationJob(configMigrationJobRequest)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := tcClient.UseDtsClient().ModifyMigrationJobWithContext(ctx, configMigrationJobRequest)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1799,12 +1803,12 @@ func handleModifyMigrate(d *schema.ResourceData, tcClient *connectivity.TencentC
 	return nil
 }
 
-func handleCheckMigrate(d *schema.ResourceData, tcClient *connectivity.TencentCloudClient, logId, jobId string) error {
+func handleCheckMigrate(ctx context.Context, d *schema.ResourceData, tcClient *connectivity.TencentCloudClient, logId, jobId string) error {
 	checkMigrateJobRequest := dts.NewCreateMigrateCheckJobRequest()
 	checkMigrateJobRequest.JobId = helper.String(jobId)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := tcClient.UseDtsClient().CreateMigrateCheckJob(checkMigrateJobRequest)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := tcClient.UseDtsClient().CreateMigrateCheckJobWithContext(ctx, checkMigrateJobRequest)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1914,7 +1918,7 @@ func handleCheckMigrate(d *schema.ResourceData, tcClient *connectivity.TencentCl
 // 	return nil
 // }
 
-func resourceTencentCloudDtsMigrateJobDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsMigrateJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_migrate_job.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -117,10 +117,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
@@ -129,10 +129,10 @@ import (
 
 func resourceTencentCloudDtsMigrateJobConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDtsMigrateJobConfigCreate,
-		Read:   resourceTencentCloudDtsMigrateJobConfigRead,
-		Update: resourceTencentCloudDtsMigrateJobConfigUpdate,
-		Delete: resourceTencentCloudDtsMigrateJobConfigDelete,
+		CreateContext: resourceTencentCloudDtsMigrateJobConfigCreate,
+		ReadContext:   resourceTencentCloudDtsMigrateJobConfigRead,
+		UpdateContext: resourceTencentCloudDtsMigrateJobConfigUpdate,
+		DeleteContext: resourceTencentCloudDtsMigrateJobConfigDelete,
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Required:    true,
@@ -156,7 +156,7 @@ func resourceTencentCloudDtsMigrateJobConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDtsMigrateJobConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsMigrateJobConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_migrate_job_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -167,16 +167,16 @@ func resourceTencentCloudDtsMigrateJobConfigCreate(d *schema.ResourceData, meta
 	}
 	d.SetId(jobId)
 
-	return resourceTencentCloudDtsMigrateJobConfigUpdate(d, meta)
+	return resourceTencentCloudDtsMigrateJobConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsMigrateJobConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsMigrateJobConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_migrate_job_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -184,7 +184,7 @@ func resourceTencentCloudDtsMigrateJobConfigRead(d *schema.ResourceData, meta in
 
 	migrateJobConfig, err := service.DescribeDtsMigrateJobById(ctx, jobId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if migrateJobConfig == nil {
@@ -204,7 +204,7 @@ func resourceTencentCloudDtsMigrateJobConfigRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTencentCloudDtsMigrateJobConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsMigrateJobConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_migrate_job_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -222,58 +222,58 @@ func resourceTencentCloudDtsMigrateJobConfigUpdate(d *schema.ResourceData, meta
 			var inErr error
 			switch action {
 			case DTS_MIGRATE_ACTION_PAUSE:
-				inErr = handlePauseMigrate(d, meta, logId, jobId)
+				inErr = handlePauseMigrate(ctx, d, meta, logId, jobId)
 				if inErr != nil {
-					return inErr
+					return diag.FromErr(inErr)
 				}
 			case DTS_MIGRATE_ACTION_CONTINUE:
-				inErr = handleContinueMigrate(d, meta, logId, jobId)
+				inErr = handleContinueMigrate(ctx, d, meta, logId, jobId)
 				if inErr != nil {
-					return inErr
+					return diag.FromErr(inErr)
 				}
 			case DTS_MIGRATE_ACTION_COMPLETE:
-				inErr = handleCompleteMigrate(d, meta, logId, jobId)
+				inErr = handleCompleteMigrate(ctx, d, meta, logId, jobId)
 				if inErr != nil {
-					return inErr
+					return diag.FromErr(inErr)
 				}
 			case DTS_MIGRATE_ACTION_RECOVER:
-				inErr = handleRecoverMigrate(d, meta, logId, jobId)
+				inErr = handleRecoverMigrate(ctx, d, meta, logId, jobId)
 				if inErr != nil {
-					return inErr
+					return diag.FromErr(inErr)
 				}
 			case DTS_MIGRATE_ACTION_STOP:
-				inErr = handleStopMigrate(d, meta, logId, jobId)
+				inErr = handleStopMigrate(ctx, d, meta, logId, jobId)
 				if inErr != nil {
-					return inErr
+					return diag.FromErr(inErr)
 				}
 			case DTS_MIGRATE_ACTION_ISOLATE:
-				inErr = handleIsolateMigrate(d, meta, logId, jobId)
+				inErr = handleIsolateMigrate(ctx, d, meta, logId, jobId)
 				if inErr != nil {
-					return inErr
+					return diag.FromErr(inErr)
 				}
 			default:
-				return fmt.Errorf("invalid action: %s", action)
+				return diag.Errorf("invalid action: %s", action)
 			} // switch end
 		}
 	}
 
-	return resourceTencentCloudDtsMigrateJobConfigRead(d, meta)
+	return resourceTencentCloudDtsMigrateJobConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsMigrateJobConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsMigrateJobConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_migrate_job_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func handlePauseMigrate(d *schema.ResourceData, meta interface{}, logId, jobId string) error {
+func handlePauseMigrate(ctx context.Context, d *schema.ResourceData, meta interface{}, logId, jobId string) error {
 	request := dts.NewPauseMigrateJobRequest()
 	request.JobId = helper.String(jobId)
 	// response = dts.NewPauseMigrateJobResponse()
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().PauseMigrateJob(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().PauseMigrateJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -296,13 +296,13 @@ func handlePauseMigrate(d *schema.ResourceData, meta interface{}, logId, jobId s
 	return nil
 }
 
-func handleContinueMigrate(d *schema.ResourceData, meta interface{}, logId, jobId string) error {
+func handleContinueMigrate(ctx context.Context, d *schema.ResourceData, meta interface{}, logId, jobId string) error {
 	request := dts.NewContinueMigrateJobRequest()
 	request.JobId = helper.String(jobId)
 	// response = dts.NewPauseMigrateJobResponse()
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().ContinueMigrateJob(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().ContinueMigrateJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -326,7 +326,7 @@ func handleContinueMigrate(d *schema.ResourceData, meta interface{}, logId, jobI
 	return nil
 }
 
-func handleCompleteMigrate(d *schema.ResourceData, meta interface{}, logId, jobId string) error {
+func handleCompleteMigrate(ctx context.Context, d *schema.ResourceData, meta interface{}, logId, jobId string) error {
 	request := dts.NewCompleteMigrateJobRequest()
 	request.JobId = helper.String(jobId)
 	// response = dts.NewPauseMigrateJobResponse()
@@ -337,8 +337,8 @@ func handleCompleteMigrate(d *schema.ResourceData, meta interface{}, logId, jobI
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().CompleteMigrateJob(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().CompleteMigrateJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -362,13 +362,13 @@ func handleCompleteMigrate(d *schema.ResourceData, meta interface{}, logId, jobI
 	return nil
 }
 
-func handleRecoverMigrate(d *schema.ResourceData, meta interface{}, logId, jobId string) error {
+func handleRecoverMigrate(ctx context.Context, d *schema.ResourceData, meta interface{}, logId, jobId string) error {
 	request := dts.NewRecoverMigrateJobRequest()
 	request.JobId = helper.String(jobId)
 	// response = dts.NewPauseMigrateJobResponse()
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().RecoverMigrateJob(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().RecoverMigrateJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -392,13 +392,13 @@ func handleRecoverMigrate(d *schema.ResourceData, meta interface{}, logId, jobId
 	return nil
 }
 
-func handleStopMigrate(d *schema.ResourceData, meta interface{}, logId, jobId string) error {
+func handleStopMigrate(ctx context.Context, d *schema.ResourceData, meta interface{}, logId, jobId string) error {
 	request := dts.NewStopMigrateJobRequest()
 	request.JobId = helper.String(jobId)
 	// response = dts.NewPauseMigrateJobResponse()
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().StopMigrateJob(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().StopMigrateJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -422,9 +422,9 @@ func handleStopMigrate(d *schema.ResourceData, meta interface{}, logId, jobId st
 	return nil
 }
 
-func handleIsolateMigrate(d *schema.ResourceData, meta interface{}, logId, jobId string) error {
+func handleIsolateMigrate(ctx context.Context, d *schema.ResourceData, meta interface{}, logId, jobId string) error {
 	service := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	err := service.IsolateDtsMigrateJobById(ctx, jobId)
 
 	if err != nil {
@@ -14,9 +14,11 @@ resource "tencentcloud_dts_migrate_job_resume_operation" "resume" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
@@ -25,9 +27,12 @@ import (
 
 func resourceTencentCloudDtsMigrateJobResumeOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDtsMigrateJobResumeOperationCreate,
-		Read:   resourceTencentCloudDtsMigrateJobResumeOperationRead,
-		Delete: resourceTencentCloudDtsMigrateJobResumeOperationDelete,
+		CreateContext: resourceTencentCloudDtsMigrateJobResumeOperationCreate,
+		ReadContext:   resourceTencentCloudDtsMigrateJobResumeOperationRead,
+		DeleteContext: resourceTencentCloudDtsMigrateJobResumeOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Required:    true,
@@ -46,7 +51,7 @@ func resourceTencentCloudDtsMigrateJobResumeOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDtsMigrateJobResumeOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsMigrateJobResumeOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_migrate_job_resume_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -65,8 +70,8 @@ func resourceTencentCloudDtsMigrateJobResumeOperationCreate(d *schema.ResourceDa
 		request.ResumeOption = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().ResumeMigrateJob(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().ResumeMigrateJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -76,29 +81,29 @@ func resourceTencentCloudDtsMigrateJobResumeOperationCreate(d *schema.ResourceDa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate dts migrateJobResumeOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(jobId)
 
 	service := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"running", "readyComplete"}, 3*readRetryTimeout, time.Second, service.DtsMigrateJobResumeOperationStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"running", "readyComplete"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DtsMigrateJobResumeOperationStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudDtsMigrateJobResumeOperationRead(d, meta)
+	return resourceTencentCloudDtsMigrateJobResumeOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsMigrateJobResumeOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsMigrateJobResumeOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_migrate_job_resume_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudDtsMigrateJobResumeOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsMigrateJobResumeOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_migrate_job_resume_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -18,6 +18,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
@@ -26,9 +27,12 @@ import (
 
 func resourceTencentCloudDtsMigrateJobStartOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDtsMigrateJobStartOperationCreate,
-		Read:   resourceTencentCloudDtsMigrateJobStartOperationRead,
-		Delete: resourceTencentCloudDtsMigrateJobStartOperationDelete,
+		CreateContext: resourceTencentCloudDtsMigrateJobStartOperationCreate,
+		ReadContext:   resourceTencentCloudDtsMigrateJobStartOperationRead,
+		DeleteContext: resourceTencentCloudDtsMigrateJobStartOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				ForceNew:    true,
@@ -40,17 +44,17 @@ func resourceTencentCloudDtsMigrateJobStartOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDtsMigrateJobStartOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsMigrateJobStartOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_migrate_job_start_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId    = getLogId(contextNil)
-		ctx      = context.WithValue(context.TODO(), logIdKey, logId)
 		tcClient = meta.(*TencentCloudClient).apiV3Conn
 		service  = DtsService{client: tcClient}
 		jobId    string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("job_id"); ok {
 		jobId = v.(string)
@@ -60,7 +64,7 @@ func resourceTencentCloudDtsMigrateJobStartOperationCreate(d *schema.ResourceDat
 	object, err := service.DescribeDtsMigrateCheckById(ctx, jobId)
 	if err != nil {
 		log.Printf("[CRITAL]%s DescribeDtsMigrateCheckById failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	result := *object.CheckFlag
@@ -73,13 +77,13 @@ func resourceTencentCloudDtsMigrateJobStartOperationCreate(d *schema.ResourceDat
 				}
 			}
 		}
-		return fmt.Errorf("The DTS migration check not passed. Please re-check the job. check_result:[%s], reason:[%s]", result, errorLog)
+		return diag.Errorf("The DTS migration check not passed. Please re-check the job. check_result:[%s], reason:[%s]", result, errorLog)
 	}
 
 	startMigrateJobRequest := dts.NewStartMigrateJobRequest()
 	startMigrateJobRequest.JobId = helper.String(jobId)
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := tcClient.UseDtsClient().StartMigrateJob(startMigrateJobRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := tcClient.UseDtsClient().StartMigrateJobWithContext(ctx, startMigrateJobRequest)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -89,26 +93,26 @@ func resourceTencentCloudDtsMigrateJobStartOperationCreate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s start dts migrateJob failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	conf := BuildStateChangeConf([]string{}, []string{"running", "error"}, 3*readRetryTimeout, time.Second, service.DtsMigrateJobStateRefreshFunc(jobId, []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"running", "error"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DtsMigrateJobStateRefreshFunc(jobId, []string{}))
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	d.SetId(jobId)
-	return resourceTencentCloudDtsMigrateJobStartOperationRead(d, meta)
+	return resourceTencentCloudDtsMigrateJobStartOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsMigrateJobStartOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsMigrateJobStartOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_migrate_job_start_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudDtsMigrateJobStartOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsMigrateJobStartOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_migrate_job_start_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
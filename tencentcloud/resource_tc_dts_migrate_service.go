@@ -29,9 +29,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
@@ -40,12 +41,16 @@ import (
 
 func resourceTencentCloudDtsMigrateService() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudDtsMigrateServiceRead,
-		Create: resourceTencentCloudDtsMigrateServiceCreate,
-		Update: resourceTencentCloudDtsMigrateServiceUpdate,
-		Delete: resourceTencentCloudDtsMigrateServiceDelete,
+		ReadContext:   resourceTencentCloudDtsMigrateServiceRead,
+		CreateContext: resourceTencentCloudDtsMigrateServiceCreate,
+		UpdateContext: resourceTencentCloudDtsMigrateServiceUpdate,
+		DeleteContext: resourceTencentCloudDtsMigrateServiceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"src_database_type": {
@@ -107,7 +112,7 @@ func resourceTencentCloudDtsMigrateService() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDtsMigrateServiceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsMigrateServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_migrate_service.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -115,10 +120,10 @@ func resourceTencentCloudDtsMigrateServiceCreate(d *schema.ResourceData, meta in
 		logId    = getLogId(contextNil)
 		request  = dts.NewCreateMigrationServiceRequest()
 		response *dts.CreateMigrationServiceResponse
-		ctx      = context.WithValue(context.TODO(), logIdKey, logId)
 		service  = DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 		jobId    string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("src_database_type"); ok {
 		request.SrcDatabaseType = helper.String(v.(string))
@@ -159,8 +164,8 @@ func resourceTencentCloudDtsMigrateServiceCreate(d *schema.ResourceData, meta in
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().CreateMigrationService(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().CreateMigrationServiceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -173,42 +178,42 @@ func resourceTencentCloudDtsMigrateServiceCreate(d *schema.ResourceData, meta in
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create dts migrateJob failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	if response.Response == nil || response.Response.JobIds == nil {
-		return fmt.Errorf("%s create dts migrateJob failed, response is nil!", logId)
+		return diag.Errorf("%s create dts migrateJob failed, response is nil!", logId)
 	}
 
 	jobId = *response.Response.JobIds[0]
 	// wait created
 	if err = service.PollingMigrateJobStatusUntil(ctx, jobId, DTSJobStatus, []string{"created"}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(jobId)
-	return resourceTencentCloudDtsMigrateServiceRead(d, meta)
+	return resourceTencentCloudDtsMigrateServiceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsMigrateServiceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsMigrateServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_migrate_service.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 		jobId   = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	migrateJob, err := service.DescribeDtsMigrateJobById(ctx, jobId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if migrateJob == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `migrateJob` %s does not exist", jobId)
+		return diag.Errorf("resource `migrateJob` %s does not exist", jobId)
 	}
 
 	if migrateJob.SrcInfo != nil {
@@ -260,7 +265,7 @@ func resourceTencentCloudDtsMigrateServiceRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudDtsMigrateServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsMigrateServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_migrate_service.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -273,8 +278,8 @@ func resourceTencentCloudDtsMigrateServiceUpdate(d *schema.ResourceData, meta in
 		request.NewInstanceClass = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().ModifyMigrateJobSpec(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().ModifyMigrateJobSpecWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -284,25 +289,25 @@ func resourceTencentCloudDtsMigrateServiceUpdate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create dts migrateService failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudDtsMigrateServiceRead(d, meta)
+	return resourceTencentCloudDtsMigrateServiceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsMigrateServiceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsMigrateServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_migrate_service.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	migrateJobId := d.Id()
 
 	if err := service.DeleteDtsMigrateServiceById(ctx, migrateJobId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
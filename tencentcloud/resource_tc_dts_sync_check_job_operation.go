@@ -12,9 +12,11 @@ resource "tencentcloud_dts_sync_check_job_operation" "sync_check_job_operation"
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
@@ -23,9 +25,12 @@ import (
 
 func resourceTencentCloudDtsSyncCheckJobOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDtsSyncCheckJobOperationCreate,
-		Read:   resourceTencentCloudDtsSyncCheckJobOperationRead,
-		Delete: resourceTencentCloudDtsSyncCheckJobOperationDelete,
+		CreateContext: resourceTencentCloudDtsSyncCheckJobOperationCreate,
+		ReadContext:   resourceTencentCloudDtsSyncCheckJobOperationRead,
+		DeleteContext: resourceTencentCloudDtsSyncCheckJobOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Required:    true,
@@ -37,7 +42,7 @@ func resourceTencentCloudDtsSyncCheckJobOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDtsSyncCheckJobOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncCheckJobOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_check_job_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -52,8 +57,8 @@ func resourceTencentCloudDtsSyncCheckJobOperationCreate(d *schema.ResourceData,
 		request.JobId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().CreateCheckSyncJob(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().CreateCheckSyncJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -63,30 +68,30 @@ func resourceTencentCloudDtsSyncCheckJobOperationCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate dts syncCheckJobOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(jobId)
 
 	service := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"failed", "success"}, readRetryTimeout, time.Second, service.DtsSyncCheckJobOperationStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"failed", "success"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DtsSyncCheckJobOperationStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudDtsSyncCheckJobOperationRead(d, meta)
+	return resourceTencentCloudDtsSyncCheckJobOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsSyncCheckJobOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncCheckJobOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_check_job_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudDtsSyncCheckJobOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncCheckJobOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_check_job_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -124,6 +124,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
@@ -132,12 +133,15 @@ import (
 
 func resourceTencentCloudDtsSyncConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDtsSyncConfigCreate,
-		Read:   resourceTencentCloudDtsSyncConfigRead,
-		Update: resourceTencentCloudDtsSyncConfigUpdate,
-		Delete: resourceTencentCloudDtsSyncConfigDelete,
+		CreateContext: resourceTencentCloudDtsSyncConfigCreate,
+		ReadContext:   resourceTencentCloudDtsSyncConfigRead,
+		UpdateContext: resourceTencentCloudDtsSyncConfigUpdate,
+		DeleteContext: resourceTencentCloudDtsSyncConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"job_id": {
@@ -746,7 +750,7 @@ func resourceTencentCloudDtsSyncConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDtsSyncConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -756,16 +760,16 @@ func resourceTencentCloudDtsSyncConfigCreate(d *schema.ResourceData, meta interf
 	}
 	d.SetId(jobId)
 
-	return resourceTencentCloudDtsSyncConfigUpdate(d, meta)
+	return resourceTencentCloudDtsSyncConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsSyncConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -773,7 +777,7 @@ func resourceTencentCloudDtsSyncConfigRead(d *schema.ResourceData, meta interfac
 
 	syncConfig, err := service.DescribeDtsSyncConfigById(ctx, jobId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if syncConfig == nil {
@@ -1241,7 +1245,7 @@ func resourceTencentCloudDtsSyncConfigRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudDtsSyncConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -1650,8 +1654,8 @@ func resourceTencentCloudDtsSyncConfigUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().ConfigureSyncJob(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().ConfigureSyncJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1661,20 +1665,20 @@ func resourceTencentCloudDtsSyncConfigUpdate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update dts syncConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	service := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
-	conf := BuildStateChangeConf([]string{}, []string{"Initialized"}, readRetryTimeout, time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "", []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"Initialized"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "", []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudDtsSyncConfigRead(d, meta)
+	return resourceTencentCloudDtsSyncConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsSyncConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -24,10 +24,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
@@ -36,9 +36,13 @@ import (
 
 func resourceTencentCloudDtsSyncJob() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudDtsSyncJobRead,
-		Create: resourceTencentCloudDtsSyncJobCreate,
-		Delete: resourceTencentCloudDtsSyncJobDelete,
+		ReadContext:   resourceTencentCloudDtsSyncJobRead,
+		CreateContext: resourceTencentCloudDtsSyncJobCreate,
+		DeleteContext: resourceTencentCloudDtsSyncJobDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		// Importer: &schema.ResourceImporter{
 		// 	State: schema.ImportStatePassthrough,
 		// },
@@ -149,7 +153,7 @@ func resourceTencentCloudDtsSyncJob() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDtsSyncJobCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -215,8 +219,8 @@ func resourceTencentCloudDtsSyncJobCreate(d *schema.ResourceData, meta interface
 		request.ExistedJobId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().CreateSyncJob(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().CreateSyncJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -229,21 +233,21 @@ func resourceTencentCloudDtsSyncJobCreate(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create dts syncJob failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	jobId = *response.Response.JobIds[0]
 
 	d.SetId(jobId)
-	return resourceTencentCloudDtsSyncJobRead(d, meta)
+	return resourceTencentCloudDtsSyncJobRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsSyncJobRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -252,12 +256,12 @@ func resourceTencentCloudDtsSyncJobRead(d *schema.ResourceData, meta interface{}
 	syncJob, err := service.DescribeDtsSyncJob(ctx, helper.String(syncJobId))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if syncJob == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `syncJob` %s does not exist", syncJobId)
+		return diag.Errorf("resource `syncJob` %s does not exist", syncJobId)
 	}
 
 	if syncJob.PayMode != nil {
@@ -311,35 +315,35 @@ func resourceTencentCloudDtsSyncJobRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudDtsSyncJobDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	syncJobId := d.Id()
 
 	if err := service.IsolateDtsSyncJobById(ctx, syncJobId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	conf := BuildStateChangeConf([]string{}, []string{"Isolated", "Stopped"}, 2*readRetryTimeout, time.Second, service.DtsSyncJobConfigIsolateStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"Isolated", "Stopped"}, d.Timeout(schema.TimeoutDelete), time.Second, service.DtsSyncJobConfigIsolateStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	if err := service.DestroyDtsSyncJobById(ctx, syncJobId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	conf = BuildStateChangeConf([]string{}, []string{"Offlined"}, 2*readRetryTimeout, time.Second, service.DtsSyncJobConfigDeleteStateRefreshFunc(d.Id(), []string{}))
+	conf = BuildStateChangeConf([]string{}, []string{"Offlined"}, d.Timeout(schema.TimeoutDelete), time.Second, service.DtsSyncJobConfigDeleteStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	return nil
@@ -12,9 +12,11 @@ resource "tencentcloud_dts_sync_job_continue_operation" "sync_job_continue_opera
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
@@ -23,11 +25,14 @@ import (
 
 func resourceTencentCloudDtsSyncJobContinueOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDtsSyncJobContinueOperationCreate,
-		Read:   resourceTencentCloudDtsSyncJobContinueOperationRead,
-		Delete: resourceTencentCloudDtsSyncJobContinueOperationDelete,
+		CreateContext: resourceTencentCloudDtsSyncJobContinueOperationCreate,
+		ReadContext:   resourceTencentCloudDtsSyncJobContinueOperationRead,
+		DeleteContext: resourceTencentCloudDtsSyncJobContinueOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"job_id": {
@@ -40,7 +45,7 @@ func resourceTencentCloudDtsSyncJobContinueOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDtsSyncJobContinueOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobContinueOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job_continue_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -55,8 +60,8 @@ func resourceTencentCloudDtsSyncJobContinueOperationCreate(d *schema.ResourceDat
 		jobId = v.(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().ContinueSyncJob(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().ContinueSyncJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -66,30 +71,30 @@ func resourceTencentCloudDtsSyncJobContinueOperationCreate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate dts syncJobContinueOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(jobId)
 
 	service := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"Running"}, 2*readRetryTimeout, time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Running", []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Running", []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudDtsSyncJobContinueOperationRead(d, meta)
+	return resourceTencentCloudDtsSyncJobContinueOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsSyncJobContinueOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobContinueOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job_continue_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudDtsSyncJobContinueOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobContinueOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job_continue_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -12,9 +12,11 @@ resource "tencentcloud_dts_sync_job_isolate_operation" "sync_job_isolate_operati
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
@@ -23,9 +25,12 @@ import (
 
 func resourceTencentCloudDtsSyncJobIsolateOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDtsSyncJobIsolateOperationCreate,
-		Read:   resourceTencentCloudDtsSyncJobIsolateOperationRead,
-		Delete: resourceTencentCloudDtsSyncJobIsolateOperationDelete,
+		CreateContext: resourceTencentCloudDtsSyncJobIsolateOperationCreate,
+		ReadContext:   resourceTencentCloudDtsSyncJobIsolateOperationRead,
+		DeleteContext: resourceTencentCloudDtsSyncJobIsolateOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Required:    true,
@@ -37,7 +42,7 @@ func resourceTencentCloudDtsSyncJobIsolateOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDtsSyncJobIsolateOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobIsolateOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job_isolate_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -52,8 +57,8 @@ func resourceTencentCloudDtsSyncJobIsolateOperationCreate(d *schema.ResourceData
 		jobId = v.(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().IsolateSyncJob(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().IsolateSyncJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -63,30 +68,30 @@ func resourceTencentCloudDtsSyncJobIsolateOperationCreate(d *schema.ResourceData
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate dts syncJobIsolateOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(jobId)
 
 	service := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"Isolated"}, 2*readRetryTimeout, time.Second, service.DtsSyncJobTradeStateRefreshFunc(d.Id(), "Isolated", []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"Isolated"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DtsSyncJobTradeStateRefreshFunc(d.Id(), "Isolated", []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudDtsSyncJobIsolateOperationRead(d, meta)
+	return resourceTencentCloudDtsSyncJobIsolateOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsSyncJobIsolateOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobIsolateOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job_isolate_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudDtsSyncJobIsolateOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobIsolateOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job_isolate_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -12,9 +12,11 @@ resource "tencentcloud_dts_sync_job_pause_operation" "sync_job_pause_operation"
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
@@ -23,9 +25,12 @@ import (
 
 func resourceTencentCloudDtsSyncJobPauseOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDtsSyncJobPauseOperationCreate,
-		Read:   resourceTencentCloudDtsSyncJobPauseOperationRead,
-		Delete: resourceTencentCloudDtsSyncJobPauseOperationDelete,
+		CreateContext: resourceTencentCloudDtsSyncJobPauseOperationCreate,
+		ReadContext:   resourceTencentCloudDtsSyncJobPauseOperationRead,
+		DeleteContext: resourceTencentCloudDtsSyncJobPauseOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Required:    true,
@@ -37,7 +42,7 @@ func resourceTencentCloudDtsSyncJobPauseOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDtsSyncJobPauseOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobPauseOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job_pause_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -52,8 +57,8 @@ func resourceTencentCloudDtsSyncJobPauseOperationCreate(d *schema.ResourceData,
 		jobId = v.(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().PauseSyncJob(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().PauseSyncJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -63,30 +68,30 @@ func resourceTencentCloudDtsSyncJobPauseOperationCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate dts syncJobPauseOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(jobId)
 
 	service := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"Paused"}, 2*readRetryTimeout, time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Paused", []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"Paused"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Paused", []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudDtsSyncJobPauseOperationRead(d, meta)
+	return resourceTencentCloudDtsSyncJobPauseOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsSyncJobPauseOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobPauseOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job_pause_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudDtsSyncJobPauseOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobPauseOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job_pause_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -12,9 +12,11 @@ resource "tencentcloud_dts_sync_job_recover_operation" "sync_job_recover_operati
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
@@ -23,9 +25,12 @@ import (
 
 func resourceTencentCloudDtsSyncJobRecoverOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDtsSyncJobRecoverOperationCreate,
-		Read:   resourceTencentCloudDtsSyncJobRecoverOperationRead,
-		Delete: resourceTencentCloudDtsSyncJobRecoverOperationDelete,
+		CreateContext: resourceTencentCloudDtsSyncJobRecoverOperationCreate,
+		ReadContext:   resourceTencentCloudDtsSyncJobRecoverOperationRead,
+		DeleteContext: resourceTencentCloudDtsSyncJobRecoverOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Required:    true,
@@ -37,7 +42,7 @@ func resourceTencentCloudDtsSyncJobRecoverOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDtsSyncJobRecoverOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobRecoverOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job_recover_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -52,8 +57,8 @@ func resourceTencentCloudDtsSyncJobRecoverOperationCreate(d *schema.ResourceData
 		jobId = v.(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().RecoverSyncJob(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().RecoverSyncJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -63,36 +68,36 @@ func resourceTencentCloudDtsSyncJobRecoverOperationCreate(d *schema.ResourceData
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate dts syncJobRecoverOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(jobId)
 
 	service := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"Running", "Stopped"}, 2*readRetryTimeout, time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Stopped", []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"Running", "Stopped"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Stopped", []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	conf = BuildStateChangeConf([]string{}, []string{"Normal"}, 2*readRetryTimeout, time.Second, service.DtsSyncJobTradeStateRefreshFunc(d.Id(), "", []string{}))
+	conf = BuildStateChangeConf([]string{}, []string{"Normal"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DtsSyncJobTradeStateRefreshFunc(d.Id(), "", []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudDtsSyncJobRecoverOperationRead(d, meta)
+	return resourceTencentCloudDtsSyncJobRecoverOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsSyncJobRecoverOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobRecoverOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job_recover_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudDtsSyncJobRecoverOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobRecoverOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job_recover_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -13,9 +13,11 @@ resource "tencentcloud_dts_sync_job_resize_operation" "sync_job_resize_operation
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
@@ -24,9 +26,12 @@ import (
 
 func resourceTencentCloudDtsSyncJobResizeOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDtsSyncJobResizeOperationCreate,
-		Read:   resourceTencentCloudDtsSyncJobResizeOperationRead,
-		Delete: resourceTencentCloudDtsSyncJobResizeOperationDelete,
+		CreateContext: resourceTencentCloudDtsSyncJobResizeOperationCreate,
+		ReadContext:   resourceTencentCloudDtsSyncJobResizeOperationRead,
+		DeleteContext: resourceTencentCloudDtsSyncJobResizeOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Required:    true,
@@ -45,7 +50,7 @@ func resourceTencentCloudDtsSyncJobResizeOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDtsSyncJobResizeOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobResizeOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job_resize_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -64,8 +69,8 @@ func resourceTencentCloudDtsSyncJobResizeOperationCreate(d *schema.ResourceData,
 		request.NewInstanceClass = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().ResizeSyncJob(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().ResizeSyncJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -75,36 +80,36 @@ func resourceTencentCloudDtsSyncJobResizeOperationCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate dts syncJobResizeOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(jobId)
 
 	service := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"Running", "Stopped"}, 2*readRetryTimeout, time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Stopped", []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"Running", "Stopped"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Stopped", []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	conf = BuildStateChangeConf([]string{}, []string{"Normal", "Isolated"}, 2*readRetryTimeout, time.Second, service.DtsSyncJobTradeStateRefreshFunc(d.Id(), "Isolated", []string{}))
+	conf = BuildStateChangeConf([]string{}, []string{"Normal", "Isolated"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DtsSyncJobTradeStateRefreshFunc(d.Id(), "Isolated", []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudDtsSyncJobResizeOperationRead(d, meta)
+	return resourceTencentCloudDtsSyncJobResizeOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsSyncJobResizeOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobResizeOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job_resize_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudDtsSyncJobResizeOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobResizeOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job_resize_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
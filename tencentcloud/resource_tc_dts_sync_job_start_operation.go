@@ -12,9 +12,11 @@ resource "tencentcloud_dts_sync_job_start_operation" "sync_job_start_operation"
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
@@ -23,9 +25,12 @@ import (
 
 func resourceTencentCloudDtsSyncJobStartOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDtsSyncJobStartOperationCreate,
-		Read:   resourceTencentCloudDtsSyncJobStartOperationRead,
-		Delete: resourceTencentCloudDtsSyncJobStartOperationDelete,
+		CreateContext: resourceTencentCloudDtsSyncJobStartOperationCreate,
+		ReadContext:   resourceTencentCloudDtsSyncJobStartOperationRead,
+		DeleteContext: resourceTencentCloudDtsSyncJobStartOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Required:    true,
@@ -37,7 +42,7 @@ func resourceTencentCloudDtsSyncJobStartOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDtsSyncJobStartOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobStartOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job_start_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -52,8 +57,8 @@ func resourceTencentCloudDtsSyncJobStartOperationCreate(d *schema.ResourceData,
 		jobId = v.(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().StartSyncJob(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseDtsClient().StartSyncJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -63,30 +68,30 @@ func resourceTencentCloudDtsSyncJobStartOperationCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate dts syncJobStartOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(jobId)
 
 	service := DtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"Running"}, 2*readRetryTimeout, time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Running", []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Running", []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudDtsSyncJobStartOperationRead(d, meta)
+	return resourceTencentCloudDtsSyncJobStartOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDtsSyncJobStartOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobStartOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job_start_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudDtsSyncJobStartOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDtsSyncJobStartOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_dts_sync_job_start_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -29,7 +29,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	eb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/eb/v20210416"
@@ -38,12 +40,16 @@ import (
 
 func resourceTencentCloudEbEventBus() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEbEventBusCreate,
-		Read:   resourceTencentCloudEbEventBusRead,
-		Update: resourceTencentCloudEbEventBusUpdate,
-		Delete: resourceTencentCloudEbEventBusDelete,
+		CreateContext: resourceTencentCloudEbEventBusCreate,
+		ReadContext:   resourceTencentCloudEbEventBusRead,
+		UpdateContext: resourceTencentCloudEbEventBusUpdate,
+		DeleteContext: resourceTencentCloudEbEventBusDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"event_bus_name": {
@@ -79,7 +85,7 @@ func resourceTencentCloudEbEventBus() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudEbEventBusCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbEventBusCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_event_bus.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -106,8 +112,8 @@ func resourceTencentCloudEbEventBusCreate(d *schema.ResourceData, meta interface
 		request.EnableStore = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().CreateEventBus(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().CreateEventBusWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -118,32 +124,32 @@ func resourceTencentCloudEbEventBusCreate(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create eb eventBus failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	eventBusId = *response.Response.EventBusId
 	d.SetId(eventBusId)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::eb:%s:uin/:eventbusid/%s", region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudEbEventBusRead(d, meta)
+	return resourceTencentCloudEbEventBusRead(ctx, d, meta)
 }
 
-func resourceTencentCloudEbEventBusRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbEventBusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_event_bus.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := EbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -151,7 +157,7 @@ func resourceTencentCloudEbEventBusRead(d *schema.ResourceData, meta interface{}
 
 	eventBus, err := service.DescribeEbEventBusById(ctx, eventBusId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if eventBus == nil {
@@ -180,19 +186,19 @@ func resourceTencentCloudEbEventBusRead(d *schema.ResourceData, meta interface{}
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "eb", "eventbusid", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudEbEventBusUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbEventBusUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_event_bus.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := eb.NewUpdateEventBusRequest()
 
@@ -201,11 +207,11 @@ func resourceTencentCloudEbEventBusUpdate(d *schema.ResourceData, meta interface
 	service := EbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	eventBus, err := service.DescribeEbEventBusById(ctx, eventBusId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if eventBus == nil {
-		return fmt.Errorf("[ERROR] resource `EbEventBus` [%s] not found, please check if it has been deleted.\n", d.Id())
+		return diag.Errorf("[ERROR] resource `EbEventBus` [%s] not found, please check if it has been deleted.\n", d.Id())
 	}
 
 	request.EventBusId = &eventBusId
@@ -214,13 +220,13 @@ func resourceTencentCloudEbEventBusUpdate(d *schema.ResourceData, meta interface
 	if v, ok := d.GetOkExists("enable_store"); ok {
 		request.EnableStore = helper.Bool(v.(bool))
 	} else {
-		return fmt.Errorf("[ERROR] When EbEventBus is modified, `enable_store` must be entered.\n")
+		return diag.Errorf("[ERROR] When EbEventBus is modified, `enable_store` must be entered.\n")
 	}
 
 	if v, ok := d.GetOkExists("save_days"); ok {
 		request.SaveDays = helper.IntInt64(v.(int))
 	} else {
-		return fmt.Errorf("[ERROR] When EbEventBus is modified, `save_days` must be entered.\n")
+		return diag.Errorf("[ERROR] When EbEventBus is modified, `save_days` must be entered.\n")
 	}
 
 	if d.HasChange("event_bus_name") {
@@ -235,8 +241,8 @@ func resourceTencentCloudEbEventBusUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().UpdateEventBus(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().UpdateEventBusWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -246,7 +252,7 @@ func resourceTencentCloudEbEventBusUpdate(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update eb eventBus failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
@@ -256,25 +262,25 @@ func resourceTencentCloudEbEventBusUpdate(d *schema.ResourceData, meta interface
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("eb", "eventbusid", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudEbEventBusRead(d, meta)
+	return resourceTencentCloudEbEventBusRead(ctx, d, meta)
 }
 
-func resourceTencentCloudEbEventBusDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbEventBusDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_event_bus.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := EbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	eventBusId := d.Id()
 
 	if err := service.DeleteEbEventBusById(ctx, eventBusId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -123,10 +123,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	eb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/eb/v20210416"
@@ -135,12 +136,16 @@ import (
 
 func resourceTencentCloudEbEventConnector() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEbEventConnectorCreate,
-		Read:   resourceTencentCloudEbEventConnectorRead,
-		Update: resourceTencentCloudEbEventConnectorUpdate,
-		Delete: resourceTencentCloudEbEventConnectorDelete,
+		CreateContext: resourceTencentCloudEbEventConnectorCreate,
+		ReadContext:   resourceTencentCloudEbEventConnectorRead,
+		UpdateContext: resourceTencentCloudEbEventConnectorUpdate,
+		DeleteContext: resourceTencentCloudEbEventConnectorDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"connection_description": {
@@ -233,7 +238,7 @@ func resourceTencentCloudEbEventConnector() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudEbEventConnectorCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbEventConnectorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_event_connector.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -294,8 +299,8 @@ func resourceTencentCloudEbEventConnectorCreate(d *schema.ResourceData, meta int
 		request.Type = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().CreateConnection(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().CreateConnectionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -306,35 +311,35 @@ func resourceTencentCloudEbEventConnectorCreate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create eb eventConnector failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	connectionId = *response.Response.ConnectionId
-	d.SetId(eventBusId + FILED_SP + connectionId)
+	d.SetId(helper.IdFormat(eventBusId, connectionId))
 
-	return resourceTencentCloudEbEventConnectorRead(d, meta)
+	return resourceTencentCloudEbEventConnectorRead(ctx, d, meta)
 }
 
-func resourceTencentCloudEbEventConnectorRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbEventConnectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_event_connector.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := EbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	eventBusId := idSplit[0]
 	connectionId := idSplit[1]
 
 	eventConnector, err := service.DescribeEbEventConnectorById(ctx, connectionId, eventBusId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if eventConnector == nil {
@@ -411,7 +416,7 @@ func resourceTencentCloudEbEventConnectorRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudEbEventConnectorUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbEventConnectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_event_connector.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -419,9 +424,9 @@ func resourceTencentCloudEbEventConnectorUpdate(d *schema.ResourceData, meta int
 
 	request := eb.NewUpdateConnectionRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	eventBusId := idSplit[0]
 	connectionId := idSplit[1]
@@ -433,7 +438,7 @@ func resourceTencentCloudEbEventConnectorUpdate(d *schema.ResourceData, meta int
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -455,8 +460,8 @@ func resourceTencentCloudEbEventConnectorUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().UpdateConnection(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().UpdateConnectionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -466,29 +471,29 @@ func resourceTencentCloudEbEventConnectorUpdate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update eb eventConnector failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudEbEventConnectorRead(d, meta)
+	return resourceTencentCloudEbEventConnectorRead(ctx, d, meta)
 }
 
-func resourceTencentCloudEbEventConnectorDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbEventConnectorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_event_connector.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := EbService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	eventBusId := idSplit[0]
 	connectionId := idSplit[1]
 
 	if err := service.DeleteEbEventConnectorById(ctx, connectionId, eventBusId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -47,8 +47,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	eb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/eb/v20210416"
@@ -57,12 +58,16 @@ import (
 
 func resourceTencentCloudEbEventRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEbEventRuleCreate,
-		Read:   resourceTencentCloudEbEventRuleRead,
-		Update: resourceTencentCloudEbEventRuleUpdate,
-		Delete: resourceTencentCloudEbEventRuleDelete,
+		CreateContext: resourceTencentCloudEbEventRuleCreate,
+		ReadContext:   resourceTencentCloudEbEventRuleRead,
+		UpdateContext: resourceTencentCloudEbEventRuleUpdate,
+		DeleteContext: resourceTencentCloudEbEventRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"event_pattern": {
@@ -110,7 +115,7 @@ func resourceTencentCloudEbEventRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudEbEventRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbEventRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_event_rule.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -143,8 +148,8 @@ func resourceTencentCloudEbEventRuleCreate(d *schema.ResourceData, meta interfac
 		request.Description = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().CreateRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().CreateRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -155,45 +160,45 @@ func resourceTencentCloudEbEventRuleCreate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create eb eventRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleId = *response.Response.RuleId
-	d.SetId(eventBusId + FILED_SP + ruleId)
+	d.SetId(helper.IdFormat(eventBusId, ruleId))
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::eb:%s:uin/:ruleid/%s/%s", region, eventBusId, ruleId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudEbEventRuleRead(d, meta)
+	return resourceTencentCloudEbEventRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudEbEventRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbEventRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_event_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := EbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	eventBusId := idSplit[0]
 	ruleId := idSplit[1]
 
 	eventRule, err := service.DescribeEbEventRuleById(ctx, eventBusId, ruleId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if eventRule == nil {
@@ -228,14 +233,14 @@ func resourceTencentCloudEbEventRuleRead(d *schema.ResourceData, meta interface{
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "eb", "ruleid", tcClient.Region, eventBusId+"/"+ruleId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudEbEventRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbEventRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_event_rule.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -243,9 +248,9 @@ func resourceTencentCloudEbEventRuleUpdate(d *schema.ResourceData, meta interfac
 
 	request := eb.NewUpdateRuleRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	eventBusId := idSplit[0]
 	ruleId := idSplit[1]
@@ -257,7 +262,7 @@ func resourceTencentCloudEbEventRuleUpdate(d *schema.ResourceData, meta interfac
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -279,8 +284,8 @@ func resourceTencentCloudEbEventRuleUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().UpdateRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().UpdateRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -290,41 +295,41 @@ func resourceTencentCloudEbEventRuleUpdate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update eb eventRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		oldTags, newTags := d.GetChange("tags")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("eb", "ruleid", tcClient.Region, eventBusId+"/"+ruleId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudEbEventRuleRead(d, meta)
+	return resourceTencentCloudEbEventRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudEbEventRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbEventRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_event_rule.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := EbService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	eventBusId := idSplit[0]
 	ruleId := idSplit[1]
 
 	if err := service.DeleteEbEventRuleById(ctx, eventBusId, ruleId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
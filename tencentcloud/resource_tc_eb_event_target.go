@@ -109,10 +109,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	eb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/eb/v20210416"
@@ -121,12 +121,16 @@ import (
 
 func resourceTencentCloudEbEventTarget() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEbEventTargetCreate,
-		Read:   resourceTencentCloudEbEventTargetRead,
-		Update: resourceTencentCloudEbEventTargetUpdate,
-		Delete: resourceTencentCloudEbEventTargetDelete,
+		CreateContext: resourceTencentCloudEbEventTargetCreate,
+		ReadContext:   resourceTencentCloudEbEventTargetRead,
+		UpdateContext: resourceTencentCloudEbEventTargetUpdate,
+		DeleteContext: resourceTencentCloudEbEventTargetDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"event_bus_id": {
@@ -266,7 +270,7 @@ func resourceTencentCloudEbEventTarget() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudEbEventTargetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbEventTargetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_event_target.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -353,8 +357,8 @@ func resourceTencentCloudEbEventTargetCreate(d *schema.ResourceData, meta interf
 		request.RuleId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().CreateTarget(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().CreateTargetWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -365,28 +369,28 @@ func resourceTencentCloudEbEventTargetCreate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create eb eventTarget failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	targetId = *response.Response.TargetId
-	d.SetId(eventBusId + FILED_SP + ruleId + FILED_SP + targetId)
+	d.SetId(helper.IdFormat(eventBusId, ruleId, targetId))
 
-	return resourceTencentCloudEbEventTargetRead(d, meta)
+	return resourceTencentCloudEbEventTargetRead(ctx, d, meta)
 }
 
-func resourceTencentCloudEbEventTargetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbEventTargetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_event_target.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := EbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	eventBusId := idSplit[0]
 	ruleId := idSplit[1]
@@ -394,7 +398,7 @@ func resourceTencentCloudEbEventTargetRead(d *schema.ResourceData, meta interfac
 
 	eventTarget, err := service.DescribeEbEventTargetById(ctx, eventBusId, ruleId, targetId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if eventTarget == nil {
@@ -500,7 +504,7 @@ func resourceTencentCloudEbEventTargetRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudEbEventTargetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbEventTargetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_event_target.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -508,9 +512,9 @@ func resourceTencentCloudEbEventTargetUpdate(d *schema.ResourceData, meta interf
 
 	request := eb.NewUpdateTargetRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	eventBusId := idSplit[0]
 	ruleId := idSplit[1]
@@ -524,12 +528,12 @@ func resourceTencentCloudEbEventTargetUpdate(d *schema.ResourceData, meta interf
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().UpdateTarget(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().UpdateTargetWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -539,30 +543,30 @@ func resourceTencentCloudEbEventTargetUpdate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update eb eventTarget failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudEbEventTargetRead(d, meta)
+	return resourceTencentCloudEbEventTargetRead(ctx, d, meta)
 }
 
-func resourceTencentCloudEbEventTargetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbEventTargetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_event_target.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := EbService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	eventBusId := idSplit[0]
 	ruleId := idSplit[1]
 	targetId := idSplit[2]
 
 	if err := service.DeleteEbEventTargetById(ctx, eventBusId, ruleId, targetId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -99,10 +99,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	eb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/eb/v20210416"
@@ -111,12 +111,16 @@ import (
 
 func resourceTencentCloudEbEventTransform() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEbEventTransformCreate,
-		Read:   resourceTencentCloudEbEventTransformRead,
-		Update: resourceTencentCloudEbEventTransformUpdate,
-		Delete: resourceTencentCloudEbEventTransformDelete,
+		CreateContext: resourceTencentCloudEbEventTransformCreate,
+		ReadContext:   resourceTencentCloudEbEventTransformRead,
+		UpdateContext: resourceTencentCloudEbEventTransformUpdate,
+		DeleteContext: resourceTencentCloudEbEventTransformDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"event_bus_id": {
@@ -233,7 +237,7 @@ func resourceTencentCloudEbEventTransform() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudEbEventTransformCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbEventTransformCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_event_transform.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -311,8 +315,8 @@ func resourceTencentCloudEbEventTransformCreate(d *schema.ResourceData, meta int
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().CreateTransformation(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().CreateTransformationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -323,28 +327,28 @@ func resourceTencentCloudEbEventTransformCreate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create eb ebTransform failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	transformationId = *response.Response.TransformationId
-	d.SetId(eventBusId + FILED_SP + ruleId + FILED_SP + transformationId)
+	d.SetId(helper.IdFormat(eventBusId, ruleId, transformationId))
 
-	return resourceTencentCloudEbEventTransformRead(d, meta)
+	return resourceTencentCloudEbEventTransformRead(ctx, d, meta)
 }
 
-func resourceTencentCloudEbEventTransformRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbEventTransformRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_event_transform.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := EbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	eventBusId := idSplit[0]
 	ruleId := idSplit[1]
@@ -352,7 +356,7 @@ func resourceTencentCloudEbEventTransformRead(d *schema.ResourceData, meta inter
 
 	ebTransform, err := service.DescribeEbEventTransformById(ctx, eventBusId, ruleId, transformationId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ebTransform == nil {
@@ -441,7 +445,7 @@ func resourceTencentCloudEbEventTransformRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudEbEventTransformUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbEventTransformUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_event_transform.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -449,9 +453,9 @@ func resourceTencentCloudEbEventTransformUpdate(d *schema.ResourceData, meta int
 
 	request := eb.NewUpdateTransformationRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	eventBusId := idSplit[0]
 	ruleId := idSplit[1]
@@ -464,7 +468,7 @@ func resourceTencentCloudEbEventTransformUpdate(d *schema.ResourceData, meta int
 	immutableArgs := []string{"event_bus_id", "rule_id"}
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -525,8 +529,8 @@ func resourceTencentCloudEbEventTransformUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().UpdateTransformation(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().UpdateTransformationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -536,30 +540,30 @@ func resourceTencentCloudEbEventTransformUpdate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update eb ebTransform failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudEbEventTransformRead(d, meta)
+	return resourceTencentCloudEbEventTransformRead(ctx, d, meta)
 }
 
-func resourceTencentCloudEbEventTransformDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbEventTransformDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_event_transform.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := EbService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	eventBusId := idSplit[0]
 	ruleId := idSplit[1]
 	transformationId := idSplit[2]
 
 	if err := service.DeleteEbEventTransformById(ctx, eventBusId, ruleId, transformationId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -38,8 +38,11 @@ resource "tencentcloud_eb_put_events" "put_events" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	eb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/eb/v20210416"
@@ -48,9 +51,12 @@ import (
 
 func resourceTencentCloudEbPutEvents() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEbPutEventsCreate,
-		Read:   resourceTencentCloudEbPutEventsRead,
-		Delete: resourceTencentCloudEbPutEventsDelete,
+		CreateContext: resourceTencentCloudEbPutEventsCreate,
+		ReadContext:   resourceTencentCloudEbPutEventsRead,
+		DeleteContext: resourceTencentCloudEbPutEventsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"event_list": {
@@ -99,7 +105,7 @@ func resourceTencentCloudEbPutEvents() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudEbPutEventsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbPutEventsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_put_events.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -137,8 +143,8 @@ func resourceTencentCloudEbPutEventsCreate(d *schema.ResourceData, meta interfac
 		request.EventBusId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().PutEvents(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEbClient().PutEventsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -148,22 +154,22 @@ func resourceTencentCloudEbPutEventsCreate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate eb putEvents failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(eventBusId)
 
-	return resourceTencentCloudEbPutEventsRead(d, meta)
+	return resourceTencentCloudEbPutEventsRead(ctx, d, meta)
 }
 
-func resourceTencentCloudEbPutEventsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbPutEventsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_put_events.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudEbPutEventsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEbPutEventsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eb_put_events.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -54,7 +54,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -64,12 +66,16 @@ import (
 
 func resourceTencentCloudEip() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEipCreate,
-		Read:   resourceTencentCloudEipRead,
-		Update: resourceTencentCloudEipUpdate,
-		Delete: resourceTencentCloudEipDelete,
+		CreateContext: resourceTencentCloudEipCreate,
+		ReadContext:   resourceTencentCloudEipRead,
+		UpdateContext: resourceTencentCloudEipUpdate,
+		DeleteContext: resourceTencentCloudEipDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -168,11 +174,11 @@ func resourceTencentCloudEip() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudEipCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eip.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := meta.(*TencentCloudClient).apiV3Conn
 	vpcService := VpcService{client: client}
@@ -231,9 +237,11 @@ func resourceTencentCloudEipCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	eipId := ""
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := client.UseVpcClient().AllocateAddresses(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := client.UseVpcClient().AllocateAddressesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -249,7 +257,7 @@ func resourceTencentCloudEipCreate(d *schema.ResourceData, meta interface{}) err
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(eipId)
 
@@ -257,12 +265,12 @@ func resourceTencentCloudEipCreate(d *schema.ResourceData, meta interface{}) err
 		resourceName := BuildTagResourceName(VPC_SERVICE_TYPE, EIP_RESOURCE_TYPE, region, eipId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
 			log.Printf("[CRITAL]%s set eip tags failed: %+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	// wait for status
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		eip, errRet := vpcService.DescribeEipById(ctx, eipId)
 		if errRet != nil {
 			return retryError(errRet)
@@ -273,18 +281,18 @@ func resourceTencentCloudEipCreate(d *schema.ResourceData, meta interface{}) err
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudEipRead(d, meta)
+	return resourceTencentCloudEipRead(ctx, d, meta)
 }
 
-func resourceTencentCloudEipRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eip.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := meta.(*TencentCloudClient).apiV3Conn
 	vpcService := VpcService{client: client}
@@ -293,7 +301,7 @@ func resourceTencentCloudEipRead(d *schema.ResourceData, meta interface{}) error
 
 	eipId := d.Id()
 	var eip *vpc.Address
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		instance, errRet := vpcService.DescribeEipById(ctx, eipId)
 		if errRet != nil {
 			return retryError(errRet)
@@ -302,7 +310,7 @@ func resourceTencentCloudEipRead(d *schema.ResourceData, meta interface{}) error
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if eip == nil {
 		d.SetId("")
@@ -312,13 +320,13 @@ func resourceTencentCloudEipRead(d *schema.ResourceData, meta interface{}) error
 	tags, err := tagService.DescribeResourceTags(ctx, VPC_SERVICE_TYPE, EIP_RESOURCE_TYPE, region, eipId)
 	if err != nil {
 		log.Printf("[CRITAL]%s describe eip tags failed: %+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	bgp, err := vpcService.DescribeVpcBandwidthPackageByEip(ctx, eipId)
 	if err != nil {
 		log.Printf("[CRITAL]%s describe eip tags failed: %+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("name", eip.AddressName)
 	_ = d.Set("type", eip.AddressType)
@@ -345,11 +353,11 @@ func resourceTencentCloudEipRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceTencentCloudEipUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eip.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := meta.(*TencentCloudClient).apiV3Conn
 	vpcService := VpcService{client: client}
@@ -367,7 +375,7 @@ func resourceTencentCloudEipUpdate(d *schema.ResourceData, meta interface{}) err
 	}
 	for _, field := range unsupportedUpdateFields {
 		if d.HasChange(field) {
-			return fmt.Errorf("tencentcloud_eip update on %s is not support yet", field)
+			return diag.Errorf("tencentcloud_eip update on %s is not support yet", field)
 		}
 	}
 
@@ -375,7 +383,7 @@ func resourceTencentCloudEipUpdate(d *schema.ResourceData, meta interface{}) err
 		name := d.Get("name").(string)
 		err := vpcService.ModifyEipName(ctx, eipId, name)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -398,7 +406,7 @@ func resourceTencentCloudEipUpdate(d *schema.ResourceData, meta interface{}) err
 		if chargeType != "" && bandWidthOut != 0 {
 			err := vpcService.ModifyEipInternetChargeType(ctx, eipId, chargeType, bandWidthOut, period, renewFlag)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -408,7 +416,7 @@ func resourceTencentCloudEipUpdate(d *schema.ResourceData, meta interface{}) err
 			bandwidthOut := v.(int)
 			err := vpcService.ModifyEipBandwidthOut(ctx, eipId, bandwidthOut)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 		}
@@ -419,7 +427,7 @@ func resourceTencentCloudEipUpdate(d *schema.ResourceData, meta interface{}) err
 		renewFlag := d.Get("auto_renew_flag").(int)
 		err := vpcService.RenewAddress(ctx, eipId, period, renewFlag)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -430,26 +438,26 @@ func resourceTencentCloudEipUpdate(d *schema.ResourceData, meta interface{}) err
 
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
 			log.Printf("[CRITAL]%s update eip tags failed: %+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudEipRead(d, meta)
+	return resourceTencentCloudEipRead(ctx, d, meta)
 }
 
-func resourceTencentCloudEipDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eip.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	vpcService := VpcService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	eipId := d.Id()
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		errRet := vpcService.UnattachEip(ctx, eipId)
 		if errRet != nil {
 			return retryError(errRet, "DesOperation.MutexTaskRunning")
@@ -457,10 +465,10 @@ func resourceTencentCloudEipDelete(d *schema.ResourceData, meta interface{}) err
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		errRet := vpcService.DeleteEip(ctx, eipId)
 		if errRet != nil {
 			return retryError(errRet, "DesOperation.MutexTaskRunning", "OperationDenied.MutexTaskRunning")
@@ -468,7 +476,7 @@ func resourceTencentCloudEipDelete(d *schema.ResourceData, meta interface{}) err
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var internetChargeType string
@@ -478,7 +486,7 @@ func resourceTencentCloudEipDelete(d *schema.ResourceData, meta interface{}) err
 
 	if internetChargeType == "BANDWIDTH_PREPAID_BY_MONTH" {
 		// isolated
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			eip, errRet := vpcService.DescribeEipById(ctx, eipId)
 			if errRet != nil {
 				return retryError(errRet)
@@ -489,11 +497,11 @@ func resourceTencentCloudEipDelete(d *schema.ResourceData, meta interface{}) err
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		// release
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			errRet := vpcService.DeleteEip(ctx, eipId)
 			if errRet != nil {
 				return retryError(errRet, "DesOperation.MutexTaskRunning", "OperationDenied.MutexTaskRunning")
@@ -501,11 +509,11 @@ func resourceTencentCloudEipDelete(d *schema.ResourceData, meta interface{}) err
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		eip, errRet := vpcService.DescribeEipById(ctx, eipId)
 		if errRet != nil {
 			return retryError(errRet)
@@ -516,7 +524,7 @@ func resourceTencentCloudEipDelete(d *schema.ResourceData, meta interface{}) err
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -20,9 +20,11 @@ terraform import tencentcloud_eip_address_transform.address_transform address_tr
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	eip "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -31,11 +33,14 @@ import (
 
 func resourceTencentCloudEipAddressTransform() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEipAddressTransformCreate,
-		Read:   resourceTencentCloudEipAddressTransformRead,
-		Delete: resourceTencentCloudEipAddressTransformDelete,
+		CreateContext: resourceTencentCloudEipAddressTransformCreate,
+		ReadContext:   resourceTencentCloudEipAddressTransformRead,
+		DeleteContext: resourceTencentCloudEipAddressTransformDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -48,7 +53,7 @@ func resourceTencentCloudEipAddressTransform() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudEipAddressTransformCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEipAddressTransformCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eip_address_transform.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -64,8 +69,8 @@ func resourceTencentCloudEipAddressTransformCreate(d *schema.ResourceData, meta
 		request.InstanceId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().TransformAddress(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().TransformAddressWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -76,7 +81,7 @@ func resourceTencentCloudEipAddressTransformCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate eip addressTransform failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	taskId := *response.Response.TaskId
@@ -84,23 +89,23 @@ func resourceTencentCloudEipAddressTransformCreate(d *schema.ResourceData, meta
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 1*readRetryTimeout, time.Second, service.VpcIpv6AddressStateRefreshFunc(helper.UInt64ToStr(taskId), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, d.Timeout(schema.TimeoutCreate), time.Second, service.VpcIpv6AddressStateRefreshFunc(helper.UInt64ToStr(taskId), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudEipAddressTransformRead(d, meta)
+	return resourceTencentCloudEipAddressTransformRead(ctx, d, meta)
 }
 
-func resourceTencentCloudEipAddressTransformRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEipAddressTransformRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eip_address_transform.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudEipAddressTransformDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEipAddressTransformDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eip_address_transform.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -121,7 +121,9 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -130,11 +132,15 @@ import (
 
 func resourceTencentCloudEipAssociation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEipAssociationCreate,
-		Read:   resourceTencentCloudEipAssociationRead,
-		Delete: resourceTencentCloudEipAssociationDelete,
+		CreateContext: resourceTencentCloudEipAssociationCreate,
+		ReadContext:   resourceTencentCloudEipAssociationRead,
+		DeleteContext: resourceTencentCloudEipAssociationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"eip_id": {
@@ -182,19 +188,19 @@ func resourceTencentCloudEipAssociation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudEipAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEipAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eip_association.create")()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		vpcService = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		eip        *vpc.Address
 		errRet     error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	eipId := d.Get("eip_id").(string)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		eip, errRet = vpcService.DescribeEipById(ctx, eipId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -208,16 +214,16 @@ func resourceTencentCloudEipAssociationCreate(d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *eip.AddressStatus != EIP_STATUS_UNBIND {
-		return fmt.Errorf("eip status is illegal %s", *eip.AddressStatus)
+		return diag.Errorf("eip status is illegal %s", *eip.AddressStatus)
 	}
 
 	if v, ok := d.GetOk("instance_id"); ok {
 		instanceId := v.(string)
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			e := vpcService.AttachEip(ctx, eipId, instanceId)
 			if e != nil {
 				return retryError(e)
@@ -227,11 +233,11 @@ func resourceTencentCloudEipAssociationCreate(d *schema.ResourceData, meta inter
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		associationId := fmt.Sprintf("%v::%v", eipId, instanceId)
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			eip, errRet = vpcService.DescribeEipById(ctx, eipId)
 			if errRet != nil {
 				return retryError(errRet)
@@ -249,11 +255,11 @@ func resourceTencentCloudEipAssociationCreate(d *schema.ResourceData, meta inter
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		d.SetId(associationId)
-		return resourceTencentCloudEipAssociationRead(d, meta)
+		return resourceTencentCloudEipAssociationRead(ctx, d, meta)
 	}
 
 	needRequest := false
@@ -274,9 +280,11 @@ func resourceTencentCloudEipAssociationCreate(d *schema.ResourceData, meta inter
 	}
 
 	if needRequest {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			response, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AssociateAddress(request)
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			response, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AssociateAddressWithContext(ctx, request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -289,12 +297,12 @@ func resourceTencentCloudEipAssociationCreate(d *schema.ResourceData, meta inter
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		id := fmt.Sprintf("%v::%v::%v", eipId, networkId, privateIp)
 
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			eip, errRet = vpcService.DescribeEipById(ctx, eipId)
 			if errRet != nil {
 				return retryError(errRet)
@@ -312,33 +320,33 @@ func resourceTencentCloudEipAssociationCreate(d *schema.ResourceData, meta inter
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		d.SetId(id)
-		return resourceTencentCloudEipAssociationRead(d, meta)
+		return resourceTencentCloudEipAssociationRead(ctx, d, meta)
 	}
 
 	return nil
 }
 
-func resourceTencentCloudEipAssociationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEipAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eip_association.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		vpcService = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id         = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	association, err := parseEipAssociationId(id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		eip, errRet := vpcService.DescribeEipById(ctx, association.EipId)
 		if errRet != nil {
 			return retryError(errRet)
@@ -352,7 +360,7 @@ func resourceTencentCloudEipAssociationRead(d *schema.ResourceData, meta interfa
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("eip_id", association.EipId)
@@ -367,22 +375,22 @@ func resourceTencentCloudEipAssociationRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudEipAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEipAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eip_association.delete")()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		vpcService = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id         = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	association, err := parseEipAssociationId(id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := vpcService.UnattachEip(ctx, association.EipId)
 		if e != nil {
 			return retryError(e, "DesOperation.MutexTaskRunning")
@@ -392,7 +400,7 @@ func resourceTencentCloudEipAssociationDelete(d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -12,8 +12,11 @@ resource "tencentcloud_eip_normal_address_return" "normal_address_return" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -21,9 +24,12 @@ import (
 
 func resourceTencentCloudEipNormalAddressReturn() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEipNormalAddressReturnCreate,
-		Read:   resourceTencentCloudEipNormalAddressReturnRead,
-		Delete: resourceTencentCloudEipNormalAddressReturnDelete,
+		CreateContext: resourceTencentCloudEipNormalAddressReturnCreate,
+		ReadContext:   resourceTencentCloudEipNormalAddressReturnRead,
+		DeleteContext: resourceTencentCloudEipNormalAddressReturnDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"address_ips": {
 				Optional: true,
@@ -38,7 +44,7 @@ func resourceTencentCloudEipNormalAddressReturn() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudEipNormalAddressReturnCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEipNormalAddressReturnCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eip_normal_address_return.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -57,8 +63,8 @@ func resourceTencentCloudEipNormalAddressReturnCreate(d *schema.ResourceData, me
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ReturnNormalAddresses(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ReturnNormalAddressesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -68,22 +74,22 @@ func resourceTencentCloudEipNormalAddressReturnCreate(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate vpc normalAddressReturn failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(addressIps)
 
-	return resourceTencentCloudEipNormalAddressReturnRead(d, meta)
+	return resourceTencentCloudEipNormalAddressReturnRead(ctx, d, meta)
 }
 
-func resourceTencentCloudEipNormalAddressReturnRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEipNormalAddressReturnRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eip_normal_address_return.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudEipNormalAddressReturnDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEipNormalAddressReturnDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eip_normal_address_return.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -13,9 +13,11 @@ resource "tencentcloud_eip_public_address_adjust" "public_address_adjust" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -24,9 +26,12 @@ import (
 
 func resourceTencentCloudEipPublicAddressAdjust() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEipPublicAddressAdjustCreate,
-		Read:   resourceTencentCloudEipPublicAddressAdjustRead,
-		Delete: resourceTencentCloudEipPublicAddressAdjustDelete,
+		CreateContext: resourceTencentCloudEipPublicAddressAdjustCreate,
+		ReadContext:   resourceTencentCloudEipPublicAddressAdjustRead,
+		DeleteContext: resourceTencentCloudEipPublicAddressAdjustDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Optional:    true,
@@ -44,7 +49,7 @@ func resourceTencentCloudEipPublicAddressAdjust() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudEipPublicAddressAdjustCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEipPublicAddressAdjustCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_public_address_adjust.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -67,8 +72,8 @@ func resourceTencentCloudEipPublicAddressAdjustCreate(d *schema.ResourceData, me
 		request.AddressId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AdjustPublicAddress(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AdjustPublicAddressWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -81,27 +86,27 @@ func resourceTencentCloudEipPublicAddressAdjustCreate(d *schema.ResourceData, me
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate vpc publicAddressAdjust failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 1*readRetryTimeout, time.Second, service.VpcIpv6AddressStateRefreshFunc(helper.UInt64ToStr(taskId), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, d.Timeout(schema.TimeoutCreate), time.Second, service.VpcIpv6AddressStateRefreshFunc(helper.UInt64ToStr(taskId), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	d.SetId(instanceId + FILED_SP + addressId)
-	return resourceTencentCloudEipPublicAddressAdjustRead(d, meta)
+	d.SetId(helper.IdFormat(instanceId, addressId))
+	return resourceTencentCloudEipPublicAddressAdjustRead(ctx, d, meta)
 }
 
-func resourceTencentCloudEipPublicAddressAdjustRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEipPublicAddressAdjustRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eip_public_address_adjust.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudEipPublicAddressAdjustDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEipPublicAddressAdjustDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eip_public_address_adjust.delete")()
 	defer inconsistentCheck(d, meta)()
 
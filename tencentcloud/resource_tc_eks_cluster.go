@@ -59,7 +59,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -70,12 +72,17 @@ import (
 func resourceTencentCloudEksCluster() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource was offline and no longer supported.",
-		Read:               resourceTencentcloudEKSClusterRead,
-		Create:             resourceTencentcloudEKSClusterCreate,
-		Update:             resourceTencentcloudEKSClusterUpdate,
-		Delete:             resourceTencentcloudEKSClusterDelete,
+		ReadContext:        resourceTencentcloudEKSClusterRead,
+		CreateContext:      resourceTencentcloudEKSClusterCreate,
+		UpdateContext:      resourceTencentcloudEKSClusterUpdate,
+		DeleteContext:      resourceTencentcloudEKSClusterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -223,20 +230,20 @@ func resourceTencentCloudEksCluster() *schema.Resource {
 	}
 }
 
-func resourceTencentcloudEKSClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentcloudEKSClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eks_cluster.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := EksService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	cluster, has, err := service.DescribeEksCluster(ctx, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
-		return fmt.Errorf("cluster %s not found", d.Id())
+		return diag.Errorf("cluster %s not found", d.Id())
 	}
 
 	_ = d.Set("cluster_name", cluster.ClusterName)
@@ -254,7 +261,7 @@ func resourceTencentcloudEKSClusterRead(d *schema.ResourceData, meta interface{}
 	info, err := service.DescribeEKSClusterCredentialById(ctx, d.Id())
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("kube_config", info.KubeConfig)
@@ -268,7 +275,7 @@ func resourceTencentcloudEKSClusterRead(d *schema.ResourceData, meta interface{}
 		internalLB = append(internalLB, lb)
 		err = d.Set("internal_lb", internalLB)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -303,11 +310,11 @@ func resourceTencentcloudEKSClusterRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentcloudEKSClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentcloudEKSClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eks_cluster.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		client           = meta.(*TencentCloudClient).apiV3Conn
@@ -371,7 +378,7 @@ func resourceTencentcloudEKSClusterCreate(d *schema.ResourceData, meta interface
 	if extraParamRaw, ok := d.GetOk("extra_param"); ok {
 		param, err := json.Marshal(extraParamRaw.(map[string]string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		request.ExtraParam = helper.String(string(param))
 	}
@@ -379,12 +386,12 @@ func resourceTencentcloudEKSClusterCreate(d *schema.ResourceData, meta interface
 	id, err := service.CreateEksCluster(ctx, request)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
 
-	err = resource.Retry(readRetryTimeout*3, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		cluster, _, err := service.DescribeEksCluster(ctx, id)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -397,7 +404,7 @@ func resourceTencentcloudEKSClusterCreate(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	upgradeRequest := tke.NewUpdateEKSClusterRequest()
@@ -441,7 +448,7 @@ func resourceTencentcloudEKSClusterCreate(d *schema.ResourceData, meta interface
 	}
 
 	if upgradeRequest.ClusterId != nil {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			inErr := service.UpdateEksCluster(ctx, upgradeRequest)
 			if inErr != nil {
 				return retryError(err, InternalError)
@@ -450,10 +457,10 @@ func resourceTencentcloudEKSClusterCreate(d *schema.ResourceData, meta interface
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			info, inErr := service.DescribeEKSClusterCredentialById(ctx, id)
 			if inErr != nil {
 				return retryError(inErr)
@@ -469,7 +476,7 @@ func resourceTencentcloudEKSClusterCreate(d *schema.ResourceData, meta interface
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -481,14 +488,14 @@ func resourceTencentcloudEKSClusterCreate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	return resourceTencentcloudEKSClusterRead(d, meta)
+	return resourceTencentcloudEKSClusterRead(ctx, d, meta)
 }
 
-func resourceTencentcloudEKSClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentcloudEKSClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eks_cluster.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	id := d.Id()
 	request := tke.NewUpdateEKSClusterRequest()
 	request.ClusterId = helper.String(id)
@@ -597,10 +604,10 @@ func resourceTencentcloudEKSClusterUpdate(d *schema.ResourceData, meta interface
 	if len(updateAttrs) > 0 {
 		err := service.UpdateEksCluster(ctx, request)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			info, inErr := service.DescribeEKSClusterCredentialById(ctx, id)
 			if inErr != nil {
 				return retryError(inErr)
@@ -616,7 +623,7 @@ func resourceTencentcloudEKSClusterUpdate(d *schema.ResourceData, meta interface
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -627,18 +634,18 @@ func resourceTencentcloudEKSClusterUpdate(d *schema.ResourceData, meta interface
 
 		resourceName := BuildTagResourceName("ccs", "cluster", region, id)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentcloudEKSClusterRead(d, meta)
+	return resourceTencentcloudEKSClusterRead(ctx, d, meta)
 }
 
-func resourceTencentcloudEKSClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentcloudEKSClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eks_cluster.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -648,10 +655,10 @@ func resourceTencentcloudEKSClusterDelete(d *schema.ResourceData, meta interface
 	request.ClusterId = helper.String(id)
 
 	if err := service.DeleteEksCluster(ctx, request); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err := resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		info, has, err := service.DescribeEksCluster(ctx, d.Id())
 		if has && info.Status == "Terminating" {
 			return resource.RetryableError(fmt.Errorf("cluster %s terminating, retrying", d.Id()))
@@ -669,7 +676,7 @@ func resourceTencentcloudEKSClusterDelete(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
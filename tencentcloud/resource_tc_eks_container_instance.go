@@ -90,6 +90,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
@@ -710,12 +711,12 @@ func resourceEksCiContainerSchema(isInitContainer bool) map[string]*schema.Schem
 func resourceTencentCloudEksContainerInstance() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource was offline and no longer supported.",
-		Read:               resourceTencentcloudEKSContainerInstanceRead,
-		Create:             resourceTencentcloudEKSContainerInstanceCreate,
-		Update:             resourceTencentcloudEKSContainerInstanceUpdate,
-		Delete:             resourceTencentcloudEKSContainerInstanceDelete,
+		ReadContext:        resourceTencentcloudEKSContainerInstanceRead,
+		CreateContext:      resourceTencentcloudEKSContainerInstanceCreate,
+		UpdateContext:      resourceTencentcloudEKSContainerInstanceUpdate,
+		DeleteContext:      resourceTencentcloudEKSContainerInstanceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -956,18 +957,18 @@ func resourceTencentCloudEksContainerInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentcloudEKSContainerInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentcloudEKSContainerInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eks_cluster.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := EksService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var (
 		instance *tke.EksCi
 		has      bool
 	)
 
-	outErr := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		var err error
 		instance, has, err = service.DescribeEksContainerInstanceById(ctx, d.Id())
 		if err != nil {
@@ -983,7 +984,7 @@ func resourceTencentcloudEKSContainerInstanceRead(d *schema.ResourceData, meta i
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if !has {
@@ -992,17 +993,17 @@ func resourceTencentcloudEKSContainerInstanceRead(d *schema.ResourceData, meta i
 	}
 
 	if err := setDataFromEksDescribeResponse(instance, d); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceTencentcloudEKSContainerInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentcloudEKSContainerInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eks_cluster.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := EksService{client: client}
 
@@ -1011,19 +1012,19 @@ func resourceTencentcloudEKSContainerInstanceCreate(d *schema.ResourceData, meta
 	id, err := service.CreateEksContainerInstances(ctx, request)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
 
-	return resourceTencentcloudEKSContainerInstanceRead(d, meta)
+	return resourceTencentcloudEKSContainerInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentcloudEKSContainerInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentcloudEKSContainerInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eks_cluster.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	id := d.Id()
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := EksService{client: client}
@@ -1079,18 +1080,18 @@ func resourceTencentcloudEKSContainerInstanceUpdate(d *schema.ResourceData, meta
 	if len(updateAttrs) > 0 {
 		err := service.UpdateEksContainerInstances(ctx, request)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentcloudEKSContainerInstanceRead(d, meta)
+	return resourceTencentcloudEKSContainerInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentcloudEKSContainerInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentcloudEKSContainerInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eks_cluster.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	id := d.Id()
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := EksService{client: client}
@@ -1099,7 +1100,7 @@ func resourceTencentcloudEKSContainerInstanceDelete(d *schema.ResourceData, meta
 	request.EksCiIds = []*string{&id}
 
 	if err := service.DeleteEksContainerInstance(ctx, request); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
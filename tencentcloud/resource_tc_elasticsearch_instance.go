@@ -156,7 +156,9 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -167,10 +169,15 @@ import (
 
 func resourceTencentCloudElasticsearchInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudElasticsearchInstanceCreate,
-		Read:   resourceTencentCloudElasticsearchInstanceRead,
-		Update: resourceTencentCloudElasticsearchInstanceUpdate,
-		Delete: resourceTencentCloudElasticsearchInstanceDelete,
+		CreateContext: resourceTencentCloudElasticsearchInstanceCreate,
+		ReadContext:   resourceTencentCloudElasticsearchInstanceRead,
+		UpdateContext: resourceTencentCloudElasticsearchInstanceUpdate,
+		DeleteContext: resourceTencentCloudElasticsearchInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
 			State: helper.ImportWithDefaultValue(map[string]interface{}{
 				"basic_security_type": ES_BASIC_SECURITY_TYPE_OFF,
@@ -408,10 +415,10 @@ func resourceTencentCloudElasticsearchInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudElasticsearchInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudElasticsearchInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_elasticsearch_instance.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	elasticsearchService := ElasticsearchService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -432,7 +439,7 @@ func resourceTencentCloudElasticsearchInstanceCreate(d *schema.ResourceData, met
 			if v, ok := d.GetOk("charge_period"); ok {
 				request.ChargePeriod = helper.IntUint64(v.(int))
 			} else {
-				return fmt.Errorf("elasticsearch charge period can not be empty when charge type is %s", chargeType)
+				return diag.Errorf("elasticsearch charge period can not be empty when charge type is %s", chargeType)
 			}
 			if v, ok := d.GetOk("renew_flag"); ok {
 				request.RenewFlag = helper.String(v.(string))
@@ -455,7 +462,7 @@ func resourceTencentCloudElasticsearchInstanceCreate(d *schema.ResourceData, met
 					request.MultiZoneInfo = append(request.MultiZoneInfo, &info)
 				}
 			} else {
-				return fmt.Errorf("elasticsearch multi_zone_infos can not be empty when deploy mode is %d", deployMode)
+				return diag.Errorf("elasticsearch multi_zone_infos can not be empty when deploy mode is %d", deployMode)
 			}
 		}
 	}
@@ -508,9 +515,11 @@ func resourceTencentCloudElasticsearchInstanceCreate(d *schema.ResourceData, met
 	}
 
 	instanceId := ""
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := meta.(*TencentCloudClient).apiV3Conn.UseEsClient().CreateInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := meta.(*TencentCloudClient).apiV3Conn.UseEsClient().CreateInstanceWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -520,12 +529,12 @@ func resourceTencentCloudElasticsearchInstanceCreate(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(instanceId)
 
 	instanceEmptyRetries := 5
-	err = resource.Retry(15*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, errRet := elasticsearchService.DescribeInstanceById(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -543,7 +552,7 @@ func resourceTencentCloudElasticsearchInstanceCreate(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// es acl
@@ -563,7 +572,7 @@ func resourceTencentCloudElasticsearchInstanceCreate(d *schema.ResourceData, met
 		}
 	}
 
-	err = resource.Retry(writeRetryTimeout*2, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		errRet := elasticsearchService.UpdateInstance(ctx, instanceId, "", "", 0, nil, nil, &esAcl)
 		if errRet != nil {
 			return retryError(errRet)
@@ -571,7 +580,7 @@ func resourceTencentCloudElasticsearchInstanceCreate(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// tags
@@ -582,18 +591,18 @@ func resourceTencentCloudElasticsearchInstanceCreate(d *schema.ResourceData, met
 		resourceName := fmt.Sprintf("qcs::es:%s:uin/:instance/%s", region, instanceId)
 		err := tagService.ModifyTags(ctx, resourceName, tags, nil)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudElasticsearchInstanceRead(d, meta)
+	return resourceTencentCloudElasticsearchInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudElasticsearchInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudElasticsearchInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_elasticsearch_instance.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	instanceId := d.Id()
 	elasticsearchService := ElasticsearchService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -601,7 +610,7 @@ func resourceTencentCloudElasticsearchInstanceRead(d *schema.ResourceData, meta
 
 	var instance *es.InstanceInfo
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		instance, errRet = elasticsearchService.DescribeInstanceById(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -609,7 +618,7 @@ func resourceTencentCloudElasticsearchInstanceRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if instance == nil {
 		d.SetId("")
@@ -690,11 +699,11 @@ func resourceTencentCloudElasticsearchInstanceRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudElasticsearchInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_elasticsearch.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	instanceId := d.Id()
 	elasticsearchService := ElasticsearchService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -705,7 +714,7 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 	if d.HasChange("instance_name") {
 		instanceName := d.Get("instance_name").(string)
 		// Update operation support at most one item at the same time
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			errRet := elasticsearchService.UpdateInstance(ctx, instanceId, instanceName, "", 0, nil, nil, nil)
 			if errRet != nil {
 				return retryError(errRet)
@@ -713,12 +722,12 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if d.HasChange("password") {
 		password := d.Get("password").(string)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			errRet := elasticsearchService.UpdateInstance(ctx, instanceId, "", password, 0, nil, nil, nil)
 			if errRet != nil {
 				return retryError(errRet)
@@ -726,13 +735,13 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("version") {
 		version := d.Get("version").(string)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			errRet := elasticsearchService.UpdateInstanceVersion(ctx, instanceId, version)
 			if errRet != nil {
 				return retryError(errRet)
@@ -740,18 +749,18 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		err = tencentCloudElasticsearchInstanceUpgradeWaiting(ctx, &elasticsearchService, instanceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("license_type") {
 		licenseType := d.Get("license_type").(string)
-		err := resource.Retry(writeRetryTimeout*2, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			errRet := elasticsearchService.UpdateInstanceLicense(ctx, instanceId, licenseType)
 			if errRet != nil {
 				return retryError(errRet)
@@ -759,12 +768,12 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		err = tencentCloudElasticsearchInstanceUpgradeWaiting(ctx, &elasticsearchService, instanceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -772,7 +781,7 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 		basicSecurityType := d.Get("basic_security_type").(int)
 		licenseType := d.Get("license_type").(string)
 		licenseTypeUpgrading := licenseType != "oss"
-		err := resource.Retry(writeRetryTimeout*2, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			errRet := elasticsearchService.UpdateInstance(ctx, instanceId, "", "", int64(basicSecurityType), nil, nil, nil)
 			if errRet != nil {
 				err := errRet.(*sdkErrors.TencentCloudSDKError)
@@ -785,12 +794,12 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		err = tencentCloudElasticsearchInstanceUpgradeWaiting(ctx, &elasticsearchService, instanceId)
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -803,7 +812,7 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 				NodeNum:  helper.IntUint64(value["node_num"].(int)),
 				NodeType: helper.String(value["node_type"].(string)),
 			}
-			err = resource.Retry(writeRetryTimeout*2, func() *resource.RetryError {
+			err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				errRet := elasticsearchService.UpdateInstance(ctx, instanceId, "", "", 0, nil, info, nil)
 				if errRet != nil {
 					return retryError(errRet)
@@ -813,11 +822,11 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 			break
 		}
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		err = tencentCloudElasticsearchInstanceUpgradeWaiting(ctx, &elasticsearchService, instanceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -842,7 +851,7 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 			}
 			nodeInfoList = append(nodeInfoList, &dataDisk)
 		}
-		err := resource.Retry(writeRetryTimeout*2, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			errRet := elasticsearchService.UpdateInstance(ctx, instanceId, "", "", 0, nodeInfoList, nil, nil)
 			if errRet != nil {
 				return retryError(errRet)
@@ -850,11 +859,11 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		err = tencentCloudElasticsearchInstanceUpgradeWaiting(ctx, &elasticsearchService, instanceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if d.HasChange("tags") {
@@ -867,7 +876,7 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 		resourceName := fmt.Sprintf("qcs::es:%s:uin/:instance/%s", region, instanceId)
 		err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if d.HasChange("es_acl") {
@@ -887,7 +896,7 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 			}
 		}
 
-		err := resource.Retry(writeRetryTimeout*2, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			errRet := elasticsearchService.UpdateInstance(ctx, instanceId, "", "", 0, nil, nil, &esAcl)
 			if errRet != nil {
 				return retryError(errRet)
@@ -895,25 +904,25 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudElasticsearchInstanceRead(d, meta)
+	return resourceTencentCloudElasticsearchInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudElasticsearchInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudElasticsearchInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_elasticsearch.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	instanceId := d.Id()
 	elasticsearchService := ElasticsearchService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		errRet := elasticsearchService.DeleteInstance(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet)
@@ -921,10 +930,10 @@ func resourceTencentCloudElasticsearchInstanceDelete(d *schema.ResourceData, met
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		instance, errRet := elasticsearchService.DescribeInstanceById(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -935,7 +944,7 @@ func resourceTencentCloudElasticsearchInstanceDelete(d *schema.ResourceData, met
 		return resource.RetryableError(fmt.Errorf("elasticsearch instance status is %d, retry...", *instance.Status))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -943,7 +952,7 @@ func resourceTencentCloudElasticsearchInstanceDelete(d *schema.ResourceData, met
 
 func tencentCloudElasticsearchInstanceUpgradeWaiting(ctx context.Context, service *ElasticsearchService, instanceId string) error {
 	statusChangeRetries := 5
-	return resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+	return retryContext(ctx, 10*readRetryTimeout, func() *resource.RetryError {
 		instance, errRet := service.DescribeInstanceById(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
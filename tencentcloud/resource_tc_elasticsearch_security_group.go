@@ -26,7 +26,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	elasticsearch "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/es/v20180416"
@@ -34,12 +36,15 @@ import (
 
 func resourceTencentCloudElasticsearchSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudElasticsearchSecurityGroupCreate,
-		Read:   resourceTencentCloudElasticsearchSecurityGroupRead,
-		Update: resourceTencentCloudElasticsearchSecurityGroupUpdate,
-		Delete: resourceTencentCloudElasticsearchSecurityGroupDelete,
+		CreateContext: resourceTencentCloudElasticsearchSecurityGroupCreate,
+		ReadContext:   resourceTencentCloudElasticsearchSecurityGroupRead,
+		UpdateContext: resourceTencentCloudElasticsearchSecurityGroupUpdate,
+		DeleteContext: resourceTencentCloudElasticsearchSecurityGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -61,7 +66,7 @@ func resourceTencentCloudElasticsearchSecurityGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudElasticsearchSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudElasticsearchSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_elasticsearch_security_group.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -72,16 +77,16 @@ func resourceTencentCloudElasticsearchSecurityGroupCreate(d *schema.ResourceData
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudElasticsearchSecurityGroupUpdate(d, meta)
+	return resourceTencentCloudElasticsearchSecurityGroupUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudElasticsearchSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudElasticsearchSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_elasticsearch_security_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ElasticsearchService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -89,7 +94,7 @@ func resourceTencentCloudElasticsearchSecurityGroupRead(d *schema.ResourceData,
 
 	securityGroup, err := service.DescribeInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if securityGroup == nil {
@@ -109,7 +114,7 @@ func resourceTencentCloudElasticsearchSecurityGroupRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudElasticsearchSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudElasticsearchSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_elasticsearch_security_group.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -128,8 +133,8 @@ func resourceTencentCloudElasticsearchSecurityGroupUpdate(d *schema.ResourceData
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEsClient().ModifyEsVipSecurityGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseEsClient().ModifyEsVipSecurityGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -139,13 +144,13 @@ func resourceTencentCloudElasticsearchSecurityGroupUpdate(d *schema.ResourceData
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update elasticsearch securityGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudElasticsearchSecurityGroupRead(d, meta)
+	return resourceTencentCloudElasticsearchSecurityGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudElasticsearchSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudElasticsearchSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_elasticsearch_security_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
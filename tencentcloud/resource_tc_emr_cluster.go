@@ -88,6 +88,14 @@ resource "tencentcloud_emr_cluster" "emr_cluster" {
 	sg_id=tencentcloud_security_group.emr_sg.id
 }
 ```
+
+Import
+
+EMR cluster can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_emr_cluster.emr_cluster emr-mm8rzvuh
+```
 */
 package tencentcloud
 
@@ -95,7 +103,9 @@ import (
 	"context"
 	innerErr "errors"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
@@ -105,10 +115,18 @@ import (
 
 func resourceTencentCloudEmrCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEmrClusterCreate,
-		Read:   resourceTencentCloudEmrClusterRead,
-		Delete: resourceTencentCloudEmrClusterDelete,
-		Update: resourceTencentCloudEmrClusterUpdate,
+		CreateContext: resourceTencentCloudEmrClusterCreate,
+		ReadContext:   resourceTencentCloudEmrClusterRead,
+		DeleteContext: resourceTencentCloudEmrClusterDelete,
+		UpdateContext: resourceTencentCloudEmrClusterUpdate,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"display_strategy": {
 				Type:        schema.TypeString,
@@ -261,10 +279,10 @@ func resourceTencentCloudEmrCluster() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudEmrClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEmrClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_emr_cluster.update")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	emrService := EMRService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -273,13 +291,13 @@ func resourceTencentCloudEmrClusterUpdate(d *schema.ResourceData, meta interface
 	timeSpan, hasTimeSpan := d.GetOkExists("time_span")
 	payMode, hasPayMode := d.GetOkExists("pay_mode")
 	if !hasTimeUnit || !hasTimeSpan || !hasPayMode {
-		return innerErr.New("Time_unit, time_span or pay_mode must be set.")
+		return diag.FromErr(innerErr.New("Time_unit, time_span or pay_mode must be set."))
 	}
 	if d.HasChange("tags") {
 		oldTags, newTags := d.GetChange("tags")
 		err := emrService.ModifyResourcesTags(ctx, meta.(*TencentCloudClient).apiV3Conn.Region, instanceId, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -306,16 +324,16 @@ func resourceTencentCloudEmrClusterUpdate(d *schema.ResourceData, meta interface
 		hasChange = true
 	}
 	if d.HasChange("extend_fs_field") {
-		return innerErr.New("extend_fs_field not support update.")
+		return diag.FromErr(innerErr.New("extend_fs_field not support update."))
 	}
 	if !hasChange {
 		return nil
 	}
 	_, err := emrService.UpdateInstance(ctx, request)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		clusters, err := emrService.DescribeInstancesById(ctx, instanceId, DisplayStrategyIsclusterList)
 
 		if e, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -338,21 +356,21 @@ func resourceTencentCloudEmrClusterUpdate(d *schema.ResourceData, meta interface
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudEmrClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEmrClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_emr_cluster.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	emrService := EMRService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	instanceId, err := emrService.CreateInstance(ctx, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(instanceId)
 	_ = d.Set("instance_id", instanceId)
@@ -360,7 +378,7 @@ func resourceTencentCloudEmrClusterCreate(d *schema.ResourceData, meta interface
 	if v, ok := d.GetOk("display_strategy"); ok {
 		displayStrategy = v.(string)
 	}
-	err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		clusters, err := emrService.DescribeInstancesById(ctx, instanceId, displayStrategy)
 
 		if e, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -383,32 +401,32 @@ func resourceTencentCloudEmrClusterCreate(d *schema.ResourceData, meta interface
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceTencentCloudEmrClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEmrClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_emr_cluster.delete")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	emrService := EMRService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	instanceId := d.Id()
 	clusters, err := emrService.DescribeInstancesById(ctx, instanceId, DisplayStrategyIsclusterList)
 	if len(clusters) == 0 {
-		return innerErr.New("Not find clusters.")
+		return diag.FromErr(innerErr.New("Not find clusters."))
 	}
 	metaDB := clusters[0].MetaDb
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err = emrService.DeleteInstance(ctx, d); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		clusters, err := emrService.DescribeInstancesById(ctx, instanceId, DisplayStrategyIsclusterList)
 
 		if e, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -434,14 +452,14 @@ func resourceTencentCloudEmrClusterDelete(d *schema.ResourceData, meta interface
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if metaDB != nil && *metaDB != "" {
 		// remove metadb
 		mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			err := mysqlService.OfflineIsolatedInstances(ctx, *metaDB)
 			if err != nil {
 				return retryError(err, InternalError)
@@ -450,20 +468,20 @@ func resourceTencentCloudEmrClusterDelete(d *schema.ResourceData, meta interface
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
 }
 
-func resourceTencentCloudEmrClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEmrClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	emrService := EMRService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	instanceId := d.Id()
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		_, err := emrService.DescribeInstancesById(ctx, instanceId, DisplayStrategyIsclusterList)
 
 		if e, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -478,14 +496,14 @@ func resourceTencentCloudEmrClusterRead(d *schema.ResourceData, meta interface{}
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 	region := meta.(*TencentCloudClient).apiV3Conn.Region
 	tags, err := tagService.DescribeResourceTags(ctx, "emr", "emr-instance", region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 	return nil
@@ -67,9 +67,9 @@ package tencentcloud
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -99,12 +99,12 @@ func eniIpOutputResource() *schema.Resource {
 
 func resourceTencentCloudEni() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEniCreate,
-		Read:   resourceTencentCloudEniRead,
-		Update: resourceTencentCloudEniUpdate,
-		Delete: resourceTencentCloudEniDelete,
+		CreateContext: resourceTencentCloudEniCreate,
+		ReadContext:   resourceTencentCloudEniRead,
+		UpdateContext: resourceTencentCloudEniUpdate,
+		DeleteContext: resourceTencentCloudEniDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -213,10 +213,10 @@ func resourceTencentCloudEni() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudEniCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudEniCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eni.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	name := d.Get("name").(string)
 	vpcId := d.Get("vpc_id").(string)
@@ -245,7 +245,7 @@ func resourceTencentCloudEniCreate(d *schema.ResourceData, m interface{}) error
 			ipStr := m["ip"].(string)
 			ip := net.ParseIP(ipStr)
 			if ip == nil {
-				return fmt.Errorf("ip %s is invalid", ipStr)
+				return diag.Errorf("ip %s is invalid", ipStr)
 			}
 
 			primary := m["primary"].(bool)
@@ -255,7 +255,7 @@ func resourceTencentCloudEniCreate(d *schema.ResourceData, m interface{}) error
 				hasPrimary = true
 
 			case hasPrimary && primary:
-				return errors.New("only can have a primary ipv4")
+				return diag.FromErr(errors.New("only can have a primary ipv4"))
 			}
 
 			ipv4 := VpcEniIP{
@@ -269,7 +269,7 @@ func resourceTencentCloudEniCreate(d *schema.ResourceData, m interface{}) error
 		}
 
 		if !hasPrimary {
-			return errors.New("need a primary ipv4")
+			return diag.FromErr(errors.New("need a primary ipv4"))
 		}
 	}
 
@@ -278,7 +278,7 @@ func resourceTencentCloudEniCreate(d *schema.ResourceData, m interface{}) error
 	}
 
 	if len(ipv4s) == 0 && ipv4Count == nil {
-		return errors.New("ipv4s or ipv4_count must be set")
+		return diag.FromErr(errors.New("ipv4s or ipv4_count must be set"))
 	}
 
 	if raw := helper.GetTags(d, "tags"); len(raw) > 0 {
@@ -299,7 +299,7 @@ func resourceTencentCloudEniCreate(d *schema.ResourceData, m interface{}) error
 	case len(ipv4s) > 0 && len(ipv4s) <= 10:
 		id, err = vpcService.CreateEni(ctx, name, vpcId, subnetId, desc, securityGroups, nil, ipv4s, tags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		d.SetId(id)
@@ -323,21 +323,21 @@ func resourceTencentCloudEniCreate(d *schema.ResourceData, m interface{}) error
 
 		id, err = vpcService.CreateEni(ctx, name, vpcId, subnetId, desc, securityGroups, nil, withPrimaryIpv4s, tags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		d.SetId(id)
 
 		for _, ipv4s := range ipv4ss[1:] {
 			if err = vpcService.AssignIpv4ToEni(ctx, id, ipv4s, nil); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 	case ipv4Count != nil && *ipv4Count <= 10:
 		id, err = vpcService.CreateEni(ctx, name, vpcId, subnetId, desc, securityGroups, ipv4Count, nil, tags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		d.SetId(id)
@@ -347,7 +347,7 @@ func resourceTencentCloudEniCreate(d *schema.ResourceData, m interface{}) error
 
 		id, err = vpcService.CreateEni(ctx, name, vpcId, subnetId, desc, securityGroups, common.IntPtr(10), nil, tags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		d.SetId(id)
@@ -355,14 +355,14 @@ func resourceTencentCloudEniCreate(d *schema.ResourceData, m interface{}) error
 		count -= 10
 		for count > 10 {
 			if err = vpcService.AssignIpv4ToEni(ctx, id, nil, common.IntPtr(10)); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			count -= 10
 		}
 		// assign last ip
 		if count > 0 {
 			if err = vpcService.AssignIpv4ToEni(ctx, id, nil, &count); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -370,19 +370,19 @@ func resourceTencentCloudEniCreate(d *schema.ResourceData, m interface{}) error
 	if len(tags) > 0 {
 		resourceName := BuildTagResourceName("vpc", "eni", region, id)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudEniRead(d, m)
+	return resourceTencentCloudEniRead(ctx, d, m)
 }
 
-func resourceTencentCloudEniRead(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudEniRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eni.read")()
 	defer inconsistentCheck(d, m)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -390,7 +390,7 @@ func resourceTencentCloudEniRead(d *schema.ResourceData, m interface{}) error {
 
 	enis, err := service.DescribeEniById(ctx, []string{id})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(enis) < 1 {
@@ -441,10 +441,10 @@ func resourceTencentCloudEniRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceTencentCloudEniUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudEniUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eni.update")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -479,7 +479,7 @@ func resourceTencentCloudEniUpdate(d *schema.ResourceData, m interface{}) error
 
 	if len(updateAttrs) > 0 {
 		if err := vpcService.ModifyEniAttribute(ctx, id, name, desc, sgs); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -491,7 +491,7 @@ func resourceTencentCloudEniUpdate(d *schema.ResourceData, m interface{}) error
 		newSet := newRaw.(*schema.Set)
 
 		if newSet.Len() == 0 {
-			return errors.New("can't remove all ipv4s")
+			return diag.FromErr(errors.New("can't remove all ipv4s"))
 		}
 
 		removeSet := oldSet.Difference(newSet).List()
@@ -521,24 +521,24 @@ func resourceTencentCloudEniUpdate(d *schema.ResourceData, m interface{}) error
 			ipStr := m["ip"].(string)
 			ip := net.ParseIP(ipStr)
 			if ip == nil {
-				return fmt.Errorf("ip %s is invalid", ipStr)
+				return diag.Errorf("ip %s is invalid", ipStr)
 			}
 
 			if m["primary"].(bool) {
 				if modifyPrimaryIpv4 == nil {
-					return errors.New("can't set more than one primary ipv4")
+					return diag.FromErr(errors.New("can't set more than one primary ipv4"))
 				}
 
 				// if newPrimaryCount > 1, means new ipv4s have more than one primary ipv4,
 				// if only one, maybe just update primary ipv4 description
 				newPrimaryCount++
 				if newPrimaryCount > 1 {
-					return errors.New("can't set more than one primary ipv4")
+					return diag.FromErr(errors.New("can't set more than one primary ipv4"))
 				}
 
 				// only can update primary ipv4 description
 				if modifyPrimaryIpv4.ip.String() != ipStr {
-					return errors.New("can't change primary ipv4")
+					return diag.FromErr(errors.New("can't change primary ipv4"))
 				}
 
 				modifyPrimaryIpv4.desc = helper.String(m["description"].(string))
@@ -558,23 +558,23 @@ func resourceTencentCloudEniUpdate(d *schema.ResourceData, m interface{}) error
 			// if desc is nil, means remove primary ipv4 but not add same primary ipv4,
 			// that means not just update primary ipv4 description, user remove primary ipv4
 			if modifyPrimaryIpv4.desc == nil {
-				return errors.New("can't remove primary ipv4")
+				return diag.FromErr(errors.New("can't remove primary ipv4"))
 			}
 
 			if err := vpcService.ModifyEniPrimaryIpv4Desc(ctx, id, modifyPrimaryIpv4.ip.String(), modifyPrimaryIpv4.desc); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 		if len(removeIpv4) > 0 {
 			if len(removeIpv4) <= 10 {
 				if err := vpcService.UnAssignIpv4FromEni(ctx, id, removeIpv4); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			} else {
 				for _, remove := range chunkRemoveIpv4(removeIpv4) {
 					if err := vpcService.UnAssignIpv4FromEni(ctx, id, remove); err != nil {
-						return err
+						return diag.FromErr(err)
 					}
 				}
 			}
@@ -583,12 +583,12 @@ func resourceTencentCloudEniUpdate(d *schema.ResourceData, m interface{}) error
 		if len(addIpv4) > 0 {
 			if len(addIpv4) <= 10 {
 				if err := vpcService.AssignIpv4ToEni(ctx, id, addIpv4, nil); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			} else {
 				for _, add := range chunkEniIP(addIpv4) {
 					if err := vpcService.AssignIpv4ToEni(ctx, id, add, nil); err != nil {
-						return err
+						return diag.FromErr(err)
 					}
 				}
 			}
@@ -607,19 +607,19 @@ func resourceTencentCloudEniUpdate(d *schema.ResourceData, m interface{}) error
 
 				if count <= 10 {
 					if err := vpcService.AssignIpv4ToEni(ctx, id, nil, &count); err != nil {
-						return err
+						return diag.FromErr(err)
 					}
 				} else {
 					for count > 10 {
 						if err := vpcService.AssignIpv4ToEni(ctx, id, nil, common.IntPtr(10)); err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 						count -= 10
 					}
 					// assign last ip
 					if count > 0 {
 						if err := vpcService.AssignIpv4ToEni(ctx, id, nil, &count); err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 					}
 				}
@@ -641,12 +641,12 @@ func resourceTencentCloudEniUpdate(d *schema.ResourceData, m interface{}) error
 
 				if len(removeIpv4) <= 10 {
 					if err := vpcService.UnAssignIpv4FromEni(ctx, id, removeIpv4); err != nil {
-						return err
+						return diag.FromErr(err)
 					}
 				} else {
 					for _, remove := range chunkRemoveIpv4(removeIpv4) {
 						if err := vpcService.UnAssignIpv4FromEni(ctx, id, remove); err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 					}
 				}
@@ -662,26 +662,26 @@ func resourceTencentCloudEniUpdate(d *schema.ResourceData, m interface{}) error
 		resourceName := BuildTagResourceName("vpc", "eni", region, id)
 
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudEniRead(d, m)
+	return resourceTencentCloudEniRead(ctx, d, m)
 }
 
-func resourceTencentCloudEniDelete(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudEniDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eni.delete")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
 	service := VpcService{client: m.(*TencentCloudClient).apiV3Conn}
 
-	return service.DeleteEni(ctx, id)
+	return diag.FromErr(service.DeleteEni(ctx, id))
 }
 
 func chunkEniIP(ipv4s []VpcEniIP) [][]VpcEniIP {
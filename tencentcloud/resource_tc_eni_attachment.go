@@ -77,16 +77,17 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTencentCloudEniAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEniAttachmentCreate,
-		Read:   resourceTencentCloudEniAttachmentRead,
-		Delete: resourceTencentCloudEniAttachmentDelete,
+		CreateContext: resourceTencentCloudEniAttachmentCreate,
+		ReadContext:   resourceTencentCloudEniAttachmentRead,
+		DeleteContext: resourceTencentCloudEniAttachmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -106,10 +107,10 @@ func resourceTencentCloudEniAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudEniAttachmentCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudEniAttachmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eni_attachment.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	eniId := d.Get("eni_id").(string)
 	cvmId := d.Get("instance_id").(string)
@@ -117,20 +118,20 @@ func resourceTencentCloudEniAttachmentCreate(d *schema.ResourceData, m interface
 	service := VpcService{client: m.(*TencentCloudClient).apiV3Conn}
 
 	if err := service.AttachEniToCvm(ctx, eniId, cvmId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s+%s", eniId, cvmId))
 
-	return resourceTencentCloudEniAttachmentRead(d, m)
+	return resourceTencentCloudEniAttachmentRead(ctx, d, m)
 }
 
-func resourceTencentCloudEniAttachmentRead(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudEniAttachmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eni_attachment.read")()
 	defer inconsistentCheck(d, m)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	split := strings.Split(id, "+")
@@ -146,7 +147,7 @@ func resourceTencentCloudEniAttachmentRead(d *schema.ResourceData, m interface{}
 
 	enis, err := service.DescribeEniById(ctx, []string{eniId})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(enis) < 1 {
@@ -167,10 +168,10 @@ func resourceTencentCloudEniAttachmentRead(d *schema.ResourceData, m interface{}
 	return nil
 }
 
-func resourceTencentCloudEniAttachmentDelete(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudEniAttachmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eni_attachment.delete")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	split := strings.Split(id, "+")
@@ -184,5 +185,5 @@ func resourceTencentCloudEniAttachmentDelete(d *schema.ResourceData, m interface
 
 	service := VpcService{client: m.(*TencentCloudClient).apiV3Conn}
 
-	return service.DetachEniFromCvm(ctx, eniId, cvmId)
+	return diag.FromErr(service.DetachEniFromCvm(ctx, eniId, cvmId))
 }
@@ -73,7 +73,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -81,11 +83,14 @@ import (
 
 func resourceTencentCloudEniSgAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEniSgAttachmentCreate,
-		Read:   resourceTencentCloudEniSgAttachmentRead,
-		Delete: resourceTencentCloudEniSgAttachmentDelete,
+		CreateContext: resourceTencentCloudEniSgAttachmentCreate,
+		ReadContext:   resourceTencentCloudEniSgAttachmentRead,
+		DeleteContext: resourceTencentCloudEniSgAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"network_interface_ids": {
@@ -112,7 +117,7 @@ func resourceTencentCloudEniSgAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudEniSgAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEniSgAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eni_sg_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -138,8 +143,8 @@ func resourceTencentCloudEniSgAttachmentCreate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AssociateNetworkInterfaceSecurityGroups(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AssociateNetworkInterfaceSecurityGroupsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -149,21 +154,21 @@ func resourceTencentCloudEniSgAttachmentCreate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc eniSgAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(networkInterfaceId)
 
-	return resourceTencentCloudEniSgAttachmentRead(d, meta)
+	return resourceTencentCloudEniSgAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudEniSgAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEniSgAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eni_sg_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -171,7 +176,7 @@ func resourceTencentCloudEniSgAttachmentRead(d *schema.ResourceData, meta interf
 
 	enis, err := service.DescribeEniById(ctx, []string{networkInterfaceId})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(enis) < 1 {
@@ -191,12 +196,12 @@ func resourceTencentCloudEniSgAttachmentRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudEniSgAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudEniSgAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eni_sg_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	networkInterfaceId := d.Id()
@@ -211,7 +216,7 @@ func resourceTencentCloudEniSgAttachmentDelete(d *schema.ResourceData, meta inte
 	}
 
 	if err := service.DeleteVpcEniSgAttachmentById(ctx, networkInterfaceId, securityGroupIds); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
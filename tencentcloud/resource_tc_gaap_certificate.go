@@ -24,18 +24,21 @@ package tencentcloud
 import (
 	"context"
 	"errors"
-	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudGaapCertificate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudGaapCertificateCreate,
-		Read:   resourceTencentCloudGaapCertificateRead,
-		Update: resourceTencentCloudGaapCertificateUpdate,
-		Delete: resourceTencentCloudGaapCertificateDelete,
+		CreateContext: resourceTencentCloudGaapCertificateCreate,
+		ReadContext:   resourceTencentCloudGaapCertificateRead,
+		UpdateContext: resourceTencentCloudGaapCertificateUpdate,
+		DeleteContext: resourceTencentCloudGaapCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"type": {
 				Type:         schema.TypeString,
@@ -94,10 +97,10 @@ func resourceTencentCloudGaapCertificate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudGaapCertificateCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_certificate.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	certificateType := gaapCertificateStringMap[d.Get("type").(string)]
 
@@ -114,20 +117,20 @@ func resourceTencentCloudGaapCertificateCreate(d *schema.ResourceData, m interfa
 
 	id, err := service.createCertificate(ctx, certificateType, content, name, key)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
 
-	return resourceTencentCloudGaapCertificateRead(d, m)
+	return resourceTencentCloudGaapCertificateRead(ctx, d, m)
 }
 
-func resourceTencentCloudGaapCertificateRead(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_certificate.read")()
 	defer inconsistentCheck(d, m)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -135,7 +138,7 @@ func resourceTencentCloudGaapCertificateRead(d *schema.ResourceData, m interface
 
 	certificate, err := service.DescribeCertificateById(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if certificate == nil {
@@ -144,18 +147,18 @@ func resourceTencentCloudGaapCertificateRead(d *schema.ResourceData, m interface
 	}
 
 	if certificate.CertificateType == nil {
-		return errors.New("certificate type is nil")
+		return diag.FromErr(errors.New("certificate type is nil"))
 	}
 	if certType, ok := gaapCertificateIntMap[int(*certificate.CertificateType)]; ok {
 		_ = d.Set("type", certType)
 	} else {
-		return fmt.Errorf("unknown certificate type %d", *certificate.CertificateType)
+		return diag.Errorf("unknown certificate type %d", *certificate.CertificateType)
 	}
 
 	_ = d.Set("name", certificate.CertificateAlias)
 
 	if certificate.CreateTime == nil {
-		return errors.New("certificate create time is nil")
+		return diag.FromErr(errors.New("certificate create time is nil"))
 	}
 	_ = d.Set("create_time", helper.FormatUnixTime(*certificate.CreateTime))
 
@@ -172,10 +175,10 @@ func resourceTencentCloudGaapCertificateRead(d *schema.ResourceData, m interface
 	return nil
 }
 
-func resourceTencentCloudGaapCertificateUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapCertificateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_certificate.update")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	name := d.Get("name").(string)
@@ -183,20 +186,20 @@ func resourceTencentCloudGaapCertificateUpdate(d *schema.ResourceData, m interfa
 	service := GaapService{client: m.(*TencentCloudClient).apiV3Conn}
 
 	if err := service.ModifyCertificateName(ctx, id, name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudGaapCertificateRead(d, m)
+	return resourceTencentCloudGaapCertificateRead(ctx, d, m)
 }
 
-func resourceTencentCloudGaapCertificateDelete(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapCertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_certificate.delete")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
 	service := GaapService{client: m.(*TencentCloudClient).apiV3Conn}
 
-	return service.DeleteCertificate(ctx, id)
+	return diag.FromErr(service.DeleteCertificate(ctx, id))
 }
@@ -77,6 +77,12 @@ func TestAccTencentCloudGaapCertificate_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_gaap_certificate.foo", "subject_cn", ""),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_gaap_certificate.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "key"},
+			},
 		},
 	})
 }
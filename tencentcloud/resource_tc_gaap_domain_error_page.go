@@ -31,21 +31,33 @@ resource tencentcloud_gaap_domain_error_page "foo" {
   body        = "bad request"
 }
 ```
+
+Import
+
+GAAP domain error page can be imported using the id `listener_id#domain#error_page_id`, e.g.
+
+```
+$ terraform import tencentcloud_gaap_domain_error_page.example listener-11ypivbh#www.qq.com#errorPage-ck8l53ha
+```
 */
 package tencentcloud
 
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudGaapDomainErrorPageInfo() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudGaapDomainErrorPageInfoCreate,
-		Read:   resourceTencentCloudGaapDomainErrorPageInfoRead,
-		Delete: resourceTencentCloudGaapDomainErrorPageInfoDelete,
+		CreateContext: resourceTencentCloudGaapDomainErrorPageInfoCreate,
+		ReadContext:   resourceTencentCloudGaapDomainErrorPageInfoRead,
+		DeleteContext: resourceTencentCloudGaapDomainErrorPageInfoDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: helper.ImportWithArguments("listener_id", "domain"),
+		},
 		Schema: map[string]*schema.Schema{
 			"listener_id": {
 				Type:        schema.TypeString,
@@ -97,13 +109,13 @@ func resourceTencentCloudGaapDomainErrorPageInfo() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudGaapDomainErrorPageInfoCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapDomainErrorPageInfoCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_domain_error_page.create")()
 	gaapActionMu.Lock()
 	defer gaapActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := GaapService{client: m.(*TencentCloudClient).apiV3Conn}
 
@@ -135,20 +147,20 @@ func resourceTencentCloudGaapDomainErrorPageInfoCreate(d *schema.ResourceData, m
 
 	id, err := service.CreateDomainErrorPageInfo(ctx, listenerId, domain, body, newErrorCode, errorCodes, clearHeaders, setHeaders)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
 
-	return resourceTencentCloudGaapDomainErrorPageInfoRead(d, m)
+	return resourceTencentCloudGaapDomainErrorPageInfoRead(ctx, d, m)
 }
 
-func resourceTencentCloudGaapDomainErrorPageInfoRead(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapDomainErrorPageInfoRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_domain_error_page.read")()
 	defer inconsistentCheck(d, m)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := GaapService{client: m.(*TencentCloudClient).apiV3Conn}
 
@@ -159,7 +171,7 @@ func resourceTencentCloudGaapDomainErrorPageInfoRead(d *schema.ResourceData, m i
 
 	info, err := service.DescribeDomainErrorPageInfo(ctx, listenerId, domain, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if info == nil {
@@ -184,17 +196,17 @@ func resourceTencentCloudGaapDomainErrorPageInfoRead(d *schema.ResourceData, m i
 	return nil
 }
 
-func resourceTencentCloudGaapDomainErrorPageInfoDelete(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapDomainErrorPageInfoDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_domain_error_page.delete")()
 	gaapActionMu.Lock()
 	defer gaapActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := GaapService{client: m.(*TencentCloudClient).apiV3Conn}
 
 	id := d.Id()
 
-	return service.DeleteDomainErrorPageInfo(ctx, id)
+	return diag.FromErr(service.DeleteDomainErrorPageInfo(ctx, id))
 }
@@ -44,18 +44,19 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudGaapHttpDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudGaapHttpDomainCreate,
-		Read:   resourceTencentCloudGaapHttpDomainRead,
-		Update: resourceTencentCloudGaapHttpDomainUpdate,
-		Delete: resourceTencentCloudGaapHttpDomainDelete,
+		CreateContext: resourceTencentCloudGaapHttpDomainCreate,
+		ReadContext:   resourceTencentCloudGaapHttpDomainRead,
+		UpdateContext: resourceTencentCloudGaapHttpDomainUpdate,
+		DeleteContext: resourceTencentCloudGaapHttpDomainDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -151,13 +152,13 @@ func resourceTencentCloudGaapHttpDomain() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudGaapHttpDomainCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapHttpDomainCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_http_domain.create")()
 	gaapActionMu.Lock()
 	defer gaapActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	listenerId := d.Get("listener_id").(string)
 	domain := d.Get("domain").(string)
@@ -170,7 +171,7 @@ func resourceTencentCloudGaapHttpDomainCreate(d *schema.ResourceData, m interfac
 	)
 	httpListeners, err := service.DescribeHTTPListeners(ctx, nil, &listenerId, nil, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(httpListeners) > 0 {
 		protocol = "HTTP"
@@ -178,12 +179,12 @@ func resourceTencentCloudGaapHttpDomainCreate(d *schema.ResourceData, m interfac
 
 	httpsListeners, err := service.DescribeHTTPSListeners(ctx, nil, &listenerId, nil, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(httpsListeners) > 0 {
 		protocol = "HTTPS"
 		if httpsListeners[0].ForwardProtocol == nil {
-			return errors.New("https listener forward protocol is nil")
+			return diag.FromErr(errors.New("https listener forward protocol is nil"))
 		}
 		forwardProtocol = *httpsListeners[0].ForwardProtocol
 	}
@@ -191,7 +192,7 @@ func resourceTencentCloudGaapHttpDomainCreate(d *schema.ResourceData, m interfac
 	switch protocol {
 	case "HTTP":
 		if err := service.CreateHTTPDomain(ctx, listenerId, domain); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		id := fmt.Sprintf("%s+%s+%s", listenerId, protocol, domain)
@@ -214,7 +215,7 @@ func resourceTencentCloudGaapHttpDomainCreate(d *schema.ResourceData, m interfac
 			for _, polyIdRaw := range set.List() {
 				polyId := polyIdRaw.(string)
 				if polyId == "default" {
-					return errors.New("client_certificate_ids can't have `default`")
+					return diag.FromErr(errors.New("client_certificate_ids can't have `default`"))
 				}
 
 				polyClientCertificateIds = append(polyClientCertificateIds, polyId)
@@ -236,13 +237,13 @@ func resourceTencentCloudGaapHttpDomainCreate(d *schema.ResourceData, m interfac
 		}
 
 		if basicAuth && basicAuthId == nil {
-			return errors.New("when use basic auth, basic auth id should be set")
+			return diag.FromErr(errors.New("when use basic auth, basic auth id should be set"))
 		}
 
 		// realserver certification
 		realserverAuth := d.Get("realserver_auth").(bool)
 		if forwardProtocol == "HTTP" && realserverAuth {
-			return errors.New("when listener forward protocol is http, realserver_auth can't be true")
+			return diag.FromErr(errors.New("when listener forward protocol is http, realserver_auth can't be true"))
 		}
 
 		if raw, ok := d.GetOk("realserver_certificate_ids"); ok {
@@ -261,13 +262,13 @@ func resourceTencentCloudGaapHttpDomainCreate(d *schema.ResourceData, m interfac
 		}
 
 		if realserverAuth && (len(realserverCertificateIds) == 0 || realserverCertificateDomain == nil) {
-			return errors.New("when use realserver auth, realserver_certificate_ids and domain should be set")
+			return diag.FromErr(errors.New("when use realserver auth, realserver_certificate_ids and domain should be set"))
 		}
 
 		// gaap certification
 		gaapAuth := d.Get("gaap_auth").(bool)
 		if forwardProtocol == "HTTP" && gaapAuth {
-			return errors.New("when listener forward protocol is http, gaap_auth can't be set")
+			return diag.FromErr(errors.New("when listener forward protocol is http, gaap_auth can't be set"))
 		}
 
 		if raw, ok := d.GetOk("gaap_auth_id"); ok {
@@ -275,11 +276,11 @@ func resourceTencentCloudGaapHttpDomainCreate(d *schema.ResourceData, m interfac
 		}
 
 		if gaapAuth && gaapCertificateId == nil {
-			return errors.New("when use gaap auth, gaap auth id should be set")
+			return diag.FromErr(errors.New("when use gaap auth, gaap auth id should be set"))
 		}
 
 		if err := service.CreateHTTPSDomain(ctx, listenerId, domain, certificateId, polyClientCertificateIds); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		id := fmt.Sprintf("%s+%s+%s", listenerId, protocol, domain)
@@ -292,20 +293,20 @@ func resourceTencentCloudGaapHttpDomainCreate(d *schema.ResourceData, m interfac
 				realserverCertificateIds,
 				realserverCertificateDomain, basicAuthId, gaapCertificateId,
 			); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return resourceTencentCloudGaapHttpDomainRead(d, m)
+	return resourceTencentCloudGaapHttpDomainRead(ctx, d, m)
 }
 
-func resourceTencentCloudGaapHttpDomainRead(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapHttpDomainRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_http_domain.read")()
 	defer inconsistentCheck(d, m)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	var (
@@ -326,7 +327,7 @@ func resourceTencentCloudGaapHttpDomainRead(d *schema.ResourceData, m interface{
 
 	httpDomain, err := service.DescribeDomain(ctx, listenerId, domain)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if httpDomain == nil {
@@ -388,13 +389,13 @@ func resourceTencentCloudGaapHttpDomainRead(d *schema.ResourceData, m interface{
 	return nil
 }
 
-func resourceTencentCloudGaapHttpDomainUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapHttpDomainUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_http_domain.update")()
 	gaapActionMu.Lock()
 	defer gaapActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	var (
@@ -406,7 +407,7 @@ func resourceTencentCloudGaapHttpDomainUpdate(d *schema.ResourceData, m interfac
 
 	if len(split) != 3 {
 		log.Printf("[CRITAL]%s id is broken", logId)
-		return resourceTencentCloudGaapHttpDomainRead(d, m)
+		return resourceTencentCloudGaapHttpDomainRead(ctx, d, m)
 	}
 
 	listenerId, protocol, domain = split[0], split[1], split[2]
@@ -414,7 +415,7 @@ func resourceTencentCloudGaapHttpDomainUpdate(d *schema.ResourceData, m interfac
 	switch protocol {
 	case "HTTP":
 		// when protocol is http, nothing can be updated
-		return errors.New("http listener can't set auth")
+		return diag.FromErr(errors.New("http listener can't set auth"))
 
 	case "HTTPS":
 	}
@@ -423,7 +424,7 @@ func resourceTencentCloudGaapHttpDomainUpdate(d *schema.ResourceData, m interfac
 
 	listeners, err := service.DescribeHTTPSListeners(ctx, nil, &listenerId, nil, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// if listener doesn't exist, domain won't exist
@@ -434,7 +435,7 @@ func resourceTencentCloudGaapHttpDomainUpdate(d *schema.ResourceData, m interfac
 	}
 
 	if listeners[0].ForwardProtocol == nil {
-		return errors.New("listener forward protocol is nil")
+		return diag.FromErr(errors.New("listener forward protocol is nil"))
 	}
 	forwardProtocol := *listeners[0].ForwardProtocol
 
@@ -469,7 +470,7 @@ func resourceTencentCloudGaapHttpDomainUpdate(d *schema.ResourceData, m interfac
 			ctx, listenerId, domain, certificateId,
 			polyClientCertificateIds,
 		); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -480,7 +481,7 @@ func resourceTencentCloudGaapHttpDomainUpdate(d *schema.ResourceData, m interfac
 			d.HasChange("realserver_certificate_domain") ||
 			d.HasChange("gaap_auth") ||
 			d.HasChange("gaap_auth_id") {
-			return errors.New("when listener forward protocol is HTTP, only can set basic auth")
+			return diag.FromErr(errors.New("when listener forward protocol is HTTP, only can set basic auth"))
 		}
 	}
 
@@ -519,7 +520,7 @@ func resourceTencentCloudGaapHttpDomainUpdate(d *schema.ResourceData, m interfac
 
 	// only happen on modify basic auth
 	if basicAuth && basicAuthId == nil {
-		return errors.New("when enable basic auth, basic_auth_id must be set")
+		return diag.FromErr(errors.New("when enable basic auth, basic_auth_id must be set"))
 	}
 
 	if raw, ok := d.GetOk("realserver_certificate_id"); ok {
@@ -553,11 +554,11 @@ func resourceTencentCloudGaapHttpDomainUpdate(d *schema.ResourceData, m interfac
 	// only happen on modify realserver auth
 	if realserverAuth {
 		if len(realserverCertificateIds) == 0 {
-			return errors.New("when enable realserver auth, realserver_certificate_ids must be set")
+			return diag.FromErr(errors.New("when enable realserver auth, realserver_certificate_ids must be set"))
 		}
 
 		if realserverCertificateDomain == nil {
-			return errors.New("when enable realserver auth, realserver_certificate_domain must be set")
+			return diag.FromErr(errors.New("when enable realserver auth, realserver_certificate_domain must be set"))
 		}
 	}
 
@@ -571,7 +572,7 @@ func resourceTencentCloudGaapHttpDomainUpdate(d *schema.ResourceData, m interfac
 
 	// only happen on modify gaap auth
 	if gaapAuth && gaapCertificateId == nil {
-		return errors.New("when enable gaap auth, gaap_auth_id must be set")
+		return diag.FromErr(errors.New("when enable gaap auth, gaap_auth_id must be set"))
 	}
 
 	if len(updateAdvancedAttr) > 0 {
@@ -580,22 +581,22 @@ func resourceTencentCloudGaapHttpDomainUpdate(d *schema.ResourceData, m interfac
 			listenerId, domain, realserverAuth, basicAuth, gaapAuth,
 			realserverCertificateIds, realserverCertificateDomain, basicAuthId, gaapCertificateId,
 		); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudGaapHttpDomainRead(d, m)
+	return resourceTencentCloudGaapHttpDomainRead(ctx, d, m)
 }
 
-func resourceTencentCloudGaapHttpDomainDelete(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapHttpDomainDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_http_domain.delete")()
 	gaapActionMu.Lock()
 	defer gaapActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	var (
@@ -613,5 +614,5 @@ func resourceTencentCloudGaapHttpDomainDelete(d *schema.ResourceData, m interfac
 
 	service := GaapService{client: m.(*TencentCloudClient).apiV3Conn}
 
-	return service.DeleteDomain(ctx, listenerId, domain)
+	return diag.FromErr(service.DeleteDomain(ctx, listenerId, domain))
 }
@@ -74,6 +74,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	gaap "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/gaap/v20180529"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -81,12 +82,12 @@ import (
 
 func resourceTencentCloudGaapHttpRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudGaapHttpRuleCreate,
-		Read:   resourceTencentCloudGaapHttpRuleRead,
-		Update: resourceTencentCloudGaapHttpRuleUpdate,
-		Delete: resourceTencentCloudGaapHttpRuleDelete,
+		CreateContext: resourceTencentCloudGaapHttpRuleCreate,
+		ReadContext:   resourceTencentCloudGaapHttpRuleRead,
+		UpdateContext: resourceTencentCloudGaapHttpRuleUpdate,
+		DeleteContext: resourceTencentCloudGaapHttpRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"listener_id": {
@@ -236,13 +237,13 @@ func resourceTencentCloudGaapHttpRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudGaapHttpRuleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapHttpRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_http_rule.create")()
 	gaapActionMu.Lock()
 	defer gaapActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	listenerId := d.Get("listener_id").(string)
 
@@ -269,7 +270,7 @@ func resourceTencentCloudGaapHttpRuleCreate(d *schema.ResourceData, m interface{
 		defaultSet := schema.NewSet(schema.HashInt, codes)
 		diff := statusCodeSet.Difference(defaultSet)
 		if diff.Len() > 0 {
-			return fmt.Errorf("invalid health check status %v", diff.List())
+			return diag.Errorf("invalid health check status %v", diff.List())
 		}
 
 		rule.healthCheckStatusCodes = make([]int, 0, statusCodeSet.Len())
@@ -282,17 +283,17 @@ func resourceTencentCloudGaapHttpRuleCreate(d *schema.ResourceData, m interface{
 	}
 
 	if len(rule.healthCheckStatusCodes) == 0 {
-		return errors.New("health_check_status_codes can't be empty")
+		return diag.FromErr(errors.New("health_check_status_codes can't be empty"))
 	}
 
 	if rule.serverNameIndicationSwitch == GAAP_SERVER_NAME_INDICATION_SWITCH_ON && rule.serverNameIndication == "" {
-		return fmt.Errorf("ServerNameIndication (SNI) is required when the SNI switch is turned on.")
+		return diag.Errorf("ServerNameIndication (SNI) is required when the SNI switch is turned on.")
 	}
 	service := GaapService{client: m.(*TencentCloudClient).apiV3Conn}
 
 	id, err := service.CreateHttpRule(ctx, rule)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
@@ -312,17 +313,17 @@ func resourceTencentCloudGaapHttpRuleCreate(d *schema.ResourceData, m interface{
 		}
 
 		if err := service.BindHttpRuleRealservers(ctx, rule.listenerId, id, realservers); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudGaapHttpRuleRead(d, m)
+	return resourceTencentCloudGaapHttpRuleRead(ctx, d, m)
 }
 
-func resourceTencentCloudGaapHttpRuleRead(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapHttpRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_http_rule.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -330,7 +331,7 @@ func resourceTencentCloudGaapHttpRuleRead(d *schema.ResourceData, m interface{})
 
 	rule, err := service.DescribeHttpRule(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if rule == nil {
@@ -376,13 +377,13 @@ func resourceTencentCloudGaapHttpRuleRead(d *schema.ResourceData, m interface{})
 	return nil
 }
 
-func resourceTencentCloudGaapHttpRuleUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapHttpRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_http_rule.update")()
 	gaapActionMu.Lock()
 	defer gaapActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	listenerId := d.Get("listener_id").(string)
@@ -394,7 +395,7 @@ func resourceTencentCloudGaapHttpRuleUpdate(d *schema.ResourceData, m interface{
 	sniSwitch := d.Get("sni_switch").(string)
 	sni := d.Get("sni").(string)
 	if sniSwitch == GAAP_SERVER_NAME_INDICATION_SWITCH_ON && sni == "" {
-		return fmt.Errorf("ServerNameIndication (SNI) is required when the SNI switch is turned on.")
+		return diag.Errorf("ServerNameIndication (SNI) is required when the SNI switch is turned on.")
 	}
 	if d.HasChange("path") {
 		path = helper.String(d.Get("path").(string))
@@ -422,7 +423,7 @@ func resourceTencentCloudGaapHttpRuleUpdate(d *schema.ResourceData, m interface{
 		defaultSet := schema.NewSet(schema.HashInt, codes)
 		diff := statusCodeSet.Difference(defaultSet)
 		if diff.Len() > 0 {
-			return fmt.Errorf("invalid health check status %v", diff.List())
+			return diag.Errorf("invalid health check status %v", diff.List())
 		}
 
 		healthCheckStatusCodes = make([]int, 0, statusCodeSet.Len())
@@ -459,41 +460,41 @@ func resourceTencentCloudGaapHttpRuleUpdate(d *schema.ResourceData, m interface{
 		listenerId, id, healthCheckPath, healthCheckMethod, sniSwitch, sni,
 		path, scheduler, healthCheck, interval, connectTimeout, healthCheckStatusCodes,
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("forward_host") {
 		forwardHost := d.Get("forward_host").(string)
 		if err := service.ModifyHTTPRuleForwardHost(ctx, listenerId, id, forwardHost); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	if realserverUpdate {
 		if err := service.BindHttpRuleRealservers(ctx, listenerId, id, realservers); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudGaapHttpRuleRead(d, m)
+	return resourceTencentCloudGaapHttpRuleRead(ctx, d, m)
 }
 
-func resourceTencentCloudGaapHttpRuleDelete(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapHttpRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_http_rule.delete")()
 	gaapActionMu.Lock()
 	defer gaapActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	listenerId := d.Get("listener_id").(string)
 
 	service := GaapService{client: m.(*TencentCloudClient).apiV3Conn}
 
-	return service.DeleteHttpRule(ctx, listenerId, id)
+	return diag.FromErr(service.DeleteHttpRule(ctx, listenerId, id))
 }
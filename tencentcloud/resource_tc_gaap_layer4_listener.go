@@ -59,18 +59,19 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudGaapLayer4Listener() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudGaapLayer4ListenerCreate,
-		Read:   resourceTencentCloudGaapLayer4ListenerRead,
-		Update: resourceTencentCloudGaapLayer4ListenerUpdate,
-		Delete: resourceTencentCloudGaapLayer4ListenerDelete,
+		CreateContext: resourceTencentCloudGaapLayer4ListenerCreate,
+		ReadContext:   resourceTencentCloudGaapLayer4ListenerRead,
+		UpdateContext: resourceTencentCloudGaapLayer4ListenerUpdate,
+		DeleteContext: resourceTencentCloudGaapLayer4ListenerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -194,13 +195,13 @@ func resourceTencentCloudGaapLayer4Listener() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudGaapLayer4ListenerCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapLayer4ListenerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_layer4_listener.create")()
 	gaapActionMu.Lock()
 	defer gaapActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	protocol := d.Get("protocol").(string)
 	name := d.Get("name").(string)
@@ -209,14 +210,14 @@ func resourceTencentCloudGaapLayer4ListenerCreate(d *schema.ResourceData, m inte
 	realserverType := d.Get("realserver_type").(string)
 
 	if protocol == "TCP" && realserverType == "DOMAIN" && scheduler == "wrr" {
-		return errors.New("TCP listener DOMAIN realserver type doesn't support wrr scheduler")
+		return diag.FromErr(errors.New("TCP listener DOMAIN realserver type doesn't support wrr scheduler"))
 	}
 
 	proxyId := d.Get("proxy_id").(string)
 	healthCheck := d.Get("health_check").(bool)
 
 	if protocol == "UDP" && healthCheck {
-		return errors.New("UDP listener can't use health check")
+		return diag.FromErr(errors.New("UDP listener can't use health check"))
 	}
 
 	interval := d.Get("interval").(int)
@@ -224,7 +225,7 @@ func resourceTencentCloudGaapLayer4ListenerCreate(d *schema.ResourceData, m inte
 
 	// only check for TCP listener
 	if protocol == "TCP" && connectTimeout >= interval {
-		return errors.New("connect_timeout must be less than interval")
+		return diag.FromErr(errors.New("connect_timeout must be less than interval"))
 	}
 	clientIPMethod := d.Get("client_ip_method").(int)
 
@@ -236,7 +237,7 @@ func resourceTencentCloudGaapLayer4ListenerCreate(d *schema.ResourceData, m inte
 			m := v.(map[string]interface{})
 
 			if scheduler == "rr" && m["weight"].(int) != 1 {
-				return errors.New("when scheduler is rr, realserver weight should be 1 or null")
+				return diag.FromErr(errors.New("when scheduler is rr, realserver weight should be 1 or null"))
 			}
 
 			realservers = append(realservers, gaapRealserverBind{
@@ -259,13 +260,13 @@ func resourceTencentCloudGaapLayer4ListenerCreate(d *schema.ResourceData, m inte
 	case "TCP":
 		id, err = service.CreateTCPListener(ctx, name, scheduler, realserverType, proxyId, port, interval, connectTimeout, clientIPMethod, healthCheck)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	case "UDP":
 		id, err = service.CreateUDPListener(ctx, name, scheduler, realserverType, proxyId, port)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -273,19 +274,19 @@ func resourceTencentCloudGaapLayer4ListenerCreate(d *schema.ResourceData, m inte
 
 	if len(realservers) > 0 {
 		if err := service.BindLayer4ListenerRealservers(ctx, id, protocol, proxyId, realservers); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudGaapLayer4ListenerRead(d, m)
+	return resourceTencentCloudGaapLayer4ListenerRead(ctx, d, m)
 }
 
-func resourceTencentCloudGaapLayer4ListenerRead(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapLayer4ListenerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_layer4_listener.read")()
 	defer inconsistentCheck(d, m)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -309,12 +310,12 @@ func resourceTencentCloudGaapLayer4ListenerRead(d *schema.ResourceData, m interf
 
 	tcpListeners, err := service.DescribeTCPListeners(ctx, nil, &id, nil, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	udpListeners, err := service.DescribeUDPListeners(ctx, nil, &id, nil, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	switch {
@@ -330,7 +331,7 @@ func resourceTencentCloudGaapLayer4ListenerRead(d *schema.ResourceData, m interf
 		proxyId = listener.ProxyId
 
 		if listener.HealthCheck == nil {
-			return errors.New("listener health check is nil")
+			return diag.FromErr(errors.New("listener health check is nil"))
 		}
 		healthCheck = helper.Bool(*listener.HealthCheck == 1)
 
@@ -352,7 +353,7 @@ func resourceTencentCloudGaapLayer4ListenerRead(d *schema.ResourceData, m interf
 		status = listener.ListenerStatus
 
 		if listener.CreateTime == nil {
-			return errors.New("listener create time is nil")
+			return diag.FromErr(errors.New("listener create time is nil"))
 		}
 		createTime = helper.FormatUnixTime(*listener.CreateTime)
 
@@ -387,7 +388,7 @@ func resourceTencentCloudGaapLayer4ListenerRead(d *schema.ResourceData, m interf
 		status = listener.ListenerStatus
 
 		if listener.CreateTime == nil {
-			return errors.New("listener create time is nil")
+			return diag.FromErr(errors.New("listener create time is nil"))
 		}
 		createTime = helper.FormatUnixTime(*listener.CreateTime)
 
@@ -413,13 +414,13 @@ func resourceTencentCloudGaapLayer4ListenerRead(d *schema.ResourceData, m interf
 	return nil
 }
 
-func resourceTencentCloudGaapLayer4ListenerUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapLayer4ListenerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_layer4_listener.update")()
 	gaapActionMu.Lock()
 	defer gaapActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	protocol := d.Get("protocol").(string)
@@ -452,7 +453,7 @@ func resourceTencentCloudGaapLayer4ListenerUpdate(d *schema.ResourceData, m inte
 		healthCheck = helper.Bool(d.Get("health_check").(bool))
 	}
 	if protocol == "UDP" && healthCheck != nil && *healthCheck {
-		return errors.New("UDP listener can't enable health check")
+		return diag.FromErr(errors.New("UDP listener can't enable health check"))
 	}
 
 	if d.HasChange("interval") {
@@ -467,19 +468,19 @@ func resourceTencentCloudGaapLayer4ListenerUpdate(d *schema.ResourceData, m inte
 
 	// only check for TCP listener
 	if protocol == "TCP" && connectTimeout >= interval {
-		return errors.New("connect_timeout must be less than interval")
+		return diag.FromErr(errors.New("connect_timeout must be less than interval"))
 	}
 
 	if len(attrChange) > 0 {
 		switch protocol {
 		case "TCP":
 			if err := service.ModifyTCPListenerAttribute(ctx, proxyId, id, name, scheduler, healthCheck, interval, connectTimeout); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 		case "UDP":
 			if err := service.ModifyUDPListenerAttribute(ctx, proxyId, id, name, scheduler); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -498,23 +499,23 @@ func resourceTencentCloudGaapLayer4ListenerUpdate(d *schema.ResourceData, m inte
 		}
 
 		if err := service.BindLayer4ListenerRealservers(ctx, id, protocol, proxyId, realservers); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudGaapLayer4ListenerRead(d, m)
+	return resourceTencentCloudGaapLayer4ListenerRead(ctx, d, m)
 }
 
-func resourceTencentCloudGaapLayer4ListenerDelete(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapLayer4ListenerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_layer4_listener.delete")()
 	gaapActionMu.Lock()
 	defer gaapActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	protocol := d.Get("protocol").(string)
@@ -522,5 +523,5 @@ func resourceTencentCloudGaapLayer4ListenerDelete(d *schema.ResourceData, m inte
 
 	service := GaapService{client: m.(*TencentCloudClient).apiV3Conn}
 
-	return service.DeleteLayer4Listener(ctx, id, proxyId, protocol)
+	return diag.FromErr(service.DeleteLayer4Listener(ctx, id, proxyId, protocol))
 }
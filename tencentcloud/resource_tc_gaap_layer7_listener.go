@@ -34,6 +34,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	gaap "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/gaap/v20180529"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -41,12 +42,12 @@ import (
 
 func resourceTencentCloudGaapLayer7Listener() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudGaapLayer7ListenerCreate,
-		Read:   resourceTencentCloudGaapLayer7ListenerRead,
-		Update: resourceTencentCloudGaapLayer7ListenerUpdate,
-		Delete: resourceTencentCloudGaapLayer7ListenerDelete,
+		CreateContext: resourceTencentCloudGaapLayer7ListenerCreate,
+		ReadContext:   resourceTencentCloudGaapLayer7ListenerRead,
+		UpdateContext: resourceTencentCloudGaapLayer7ListenerUpdate,
+		DeleteContext: resourceTencentCloudGaapLayer7ListenerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -129,13 +130,13 @@ func resourceTencentCloudGaapLayer7Listener() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudGaapLayer7ListenerCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapLayer7ListenerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_layer7_listener.create")()
 	gaapActionMu.Lock()
 	defer gaapActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	protocol := d.Get("protocol").(string)
 	name := d.Get("name").(string)
@@ -161,19 +162,19 @@ func resourceTencentCloudGaapLayer7ListenerCreate(d *schema.ResourceData, m inte
 
 		certificateId := d.Get("certificate_id").(string)
 		if certificateId == "" {
-			return errors.New("when protocol is HTTPS, certificate_id can't be empty")
+			return diag.FromErr(errors.New("when protocol is HTTPS, certificate_id can't be empty"))
 		}
 
 		if raw, ok := d.GetOk("forward_protocol"); ok {
 			forwardProtocol = raw.(string)
 		} else {
-			return errors.New("when protocol is HTTPS, forward_protocol is required")
+			return diag.FromErr(errors.New("when protocol is HTTPS, forward_protocol is required"))
 		}
 
 		if raw, ok := d.GetOkExists("auth_type"); ok {
 			authType = raw.(int)
 		} else {
-			return errors.New("when protocol is HTTPS, auth_type is required")
+			return diag.FromErr(errors.New("when protocol is HTTPS, auth_type is required"))
 		}
 
 		var polyClientCertificateIds []string
@@ -190,7 +191,7 @@ func resourceTencentCloudGaapLayer7ListenerCreate(d *schema.ResourceData, m inte
 		}
 
 		if authType == 1 && len(polyClientCertificateIds) == 0 {
-			return errors.New("when protocol is HTTPS and auth type is 1, client_certificate_ids can't be empty")
+			return diag.FromErr(errors.New("when protocol is HTTPS and auth type is 1, client_certificate_ids can't be empty"))
 		}
 
 		id, err = service.CreateHTTPSListener(
@@ -200,20 +201,20 @@ func resourceTencentCloudGaapLayer7ListenerCreate(d *schema.ResourceData, m inte
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
 
-	return resourceTencentCloudGaapLayer7ListenerRead(d, m)
+	return resourceTencentCloudGaapLayer7ListenerRead(ctx, d, m)
 }
 
-func resourceTencentCloudGaapLayer7ListenerRead(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapLayer7ListenerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_layer7_listener.read")()
 	defer inconsistentCheck(d, m)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	protocol := d.Get("protocol").(string)
@@ -239,7 +240,7 @@ LOOP:
 			// import mode, need check protocol
 			httpListeners, err := service.DescribeHTTPListeners(ctx, nil, &id, nil, nil)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			if len(httpListeners) > 0 {
 				protocol = "HTTP"
@@ -248,7 +249,7 @@ LOOP:
 
 			httpsListeners, err := service.DescribeHTTPSListeners(ctx, nil, &id, nil, nil)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			if len(httpsListeners) > 0 {
 				protocol = "HTTPS"
@@ -262,13 +263,13 @@ LOOP:
 		case "HTTP":
 			listeners, err := service.DescribeHTTPListeners(ctx, nil, &id, nil, nil)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			var listener *gaap.HTTPListener
 			for _, l := range listeners {
 				if l.ListenerId == nil {
-					return errors.New("listener id is nil")
+					return diag.FromErr(errors.New("listener id is nil"))
 				}
 				if *l.ListenerId == id {
 					listener = l
@@ -287,7 +288,7 @@ LOOP:
 			proxyId = listener.ProxyId
 
 			if listener.CreateTime == nil {
-				return errors.New("listener create time is nil")
+				return diag.FromErr(errors.New("listener create time is nil"))
 			}
 			createTime = helper.FormatUnixTime(*listener.CreateTime)
 
@@ -296,13 +297,13 @@ LOOP:
 		case "HTTPS":
 			listeners, err := service.DescribeHTTPSListeners(ctx, nil, &id, nil, nil)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			var listener *gaap.HTTPSListener
 			for _, l := range listeners {
 				if l.ListenerId == nil {
-					return errors.New("listener id is nil")
+					return diag.FromErr(errors.New("listener id is nil"))
 				}
 				if *l.ListenerId == id {
 					listener = l
@@ -334,7 +335,7 @@ LOOP:
 			status = listener.ListenerStatus
 
 			if listener.CreateTime == nil {
-				return errors.New("listener create time is nil")
+				return diag.FromErr(errors.New("listener create time is nil"))
 			}
 			createTime = helper.FormatUnixTime(*listener.CreateTime)
 
@@ -357,13 +358,13 @@ LOOP:
 	return nil
 }
 
-func resourceTencentCloudGaapLayer7ListenerUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapLayer7ListenerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_layer7_listener.update")()
 	gaapActionMu.Lock()
 	defer gaapActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	protocol := d.Get("protocol").(string)
@@ -376,7 +377,7 @@ func resourceTencentCloudGaapLayer7ListenerUpdate(d *schema.ResourceData, m inte
 		if d.HasChange("name") {
 			name := d.Get("name").(string)
 			if err := service.ModifyHTTPListener(ctx, id, proxyId, name); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -410,17 +411,17 @@ func resourceTencentCloudGaapLayer7ListenerUpdate(d *schema.ResourceData, m inte
 		}
 
 		if err := service.ModifyHTTPSListener(ctx, proxyId, id, name, forwardProtocol, certificateId, polyClientCertificateIds); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudGaapLayer7ListenerRead(d, m)
+	return resourceTencentCloudGaapLayer7ListenerRead(ctx, d, m)
 }
 
-func resourceTencentCloudGaapLayer7ListenerDelete(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapLayer7ListenerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_layer7_listener.delete")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	proxyId := d.Get("proxy_id").(string)
@@ -428,5 +429,5 @@ func resourceTencentCloudGaapLayer7ListenerDelete(d *schema.ResourceData, m inte
 
 	service := GaapService{client: m.(*TencentCloudClient).apiV3Conn}
 
-	return service.DeleteLayer7Listener(ctx, id, proxyId, protocol)
+	return diag.FromErr(service.DeleteLayer7Listener(ctx, id, proxyId, protocol))
 }
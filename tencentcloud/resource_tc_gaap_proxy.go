@@ -35,6 +35,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	gaap "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/gaap/v20180529"
@@ -45,12 +46,12 @@ var gaapActionMu = &sync.Mutex{}
 
 func resourceTencentCloudGaapProxy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudGaapProxyCreate,
-		Read:   resourceTencentCloudGaapProxyRead,
-		Update: resourceTencentCloudGaapProxyUpdate,
-		Delete: resourceTencentCloudGaapProxyDelete,
+		CreateContext: resourceTencentCloudGaapProxyCreate,
+		ReadContext:   resourceTencentCloudGaapProxyRead,
+		UpdateContext: resourceTencentCloudGaapProxyUpdate,
+		DeleteContext: resourceTencentCloudGaapProxyDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -150,11 +151,11 @@ func resourceTencentCloudGaapProxy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudGaapProxyCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapProxyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_proxy.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params := make(map[string]interface{})
 	name := d.Get("name").(string)
@@ -174,26 +175,26 @@ func resourceTencentCloudGaapProxyCreate(d *schema.ResourceData, m interface{})
 
 	id, err := service.CreateProxy(ctx, name, accessRegion, realserverRegion, bandwidth, concurrent, projectId, tags, params)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
 
 	if !enable {
 		if err := service.DisableProxy(ctx, id); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudGaapProxyRead(d, m)
+	return resourceTencentCloudGaapProxyRead(ctx, d, m)
 }
 
-func resourceTencentCloudGaapProxyRead(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapProxyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_proxy.read")()
 	defer inconsistentCheck(d, m)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -201,13 +202,13 @@ func resourceTencentCloudGaapProxyRead(d *schema.ResourceData, m interface{}) er
 
 	proxies, err := service.DescribeProxies(ctx, []string{id}, nil, nil, nil, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var proxy *gaap.ProxyInfo
 	for _, p := range proxies {
 		if p.ProxyId == nil {
-			return errors.New("proxy id is nil")
+			return diag.FromErr(errors.New("proxy id is nil"))
 		}
 		if *p.ProxyId == id {
 			proxy = p
@@ -221,37 +222,37 @@ func resourceTencentCloudGaapProxyRead(d *schema.ResourceData, m interface{}) er
 	}
 
 	if proxy.ProxyName == nil {
-		return errors.New("proxy name is nil")
+		return diag.FromErr(errors.New("proxy name is nil"))
 	}
 	_ = d.Set("name", proxy.ProxyName)
 
 	if proxy.ProjectId == nil {
-		return errors.New("proxy project id is nil")
+		return diag.FromErr(errors.New("proxy project id is nil"))
 	}
 	_ = d.Set("project_id", proxy.ProjectId)
 
 	if proxy.Bandwidth == nil {
-		return errors.New("proxy bandwidth is nil")
+		return diag.FromErr(errors.New("proxy bandwidth is nil"))
 	}
 	_ = d.Set("bandwidth", proxy.Bandwidth)
 
 	if proxy.Concurrent == nil {
-		return errors.New("proxy concurrent is nil")
+		return diag.FromErr(errors.New("proxy concurrent is nil"))
 	}
 	_ = d.Set("concurrent", proxy.Concurrent)
 
 	if proxy.AccessRegion == nil {
-		return errors.New("proxy access region is nil")
+		return diag.FromErr(errors.New("proxy access region is nil"))
 	}
 	_ = d.Set("access_region", proxy.AccessRegion)
 
 	if proxy.RealServerRegion == nil {
-		return errors.New("proxy realserver region is nil")
+		return diag.FromErr(errors.New("proxy realserver region is nil"))
 	}
 	_ = d.Set("realserver_region", proxy.RealServerRegion)
 
 	if proxy.Status == nil {
-		return errors.New("proxy status is nil")
+		return diag.FromErr(errors.New("proxy status is nil"))
 	}
 	_ = d.Set("enable", *proxy.Status == GAAP_PROXY_RUNNING)
 	_ = d.Set("status", proxy.Status)
@@ -265,27 +266,27 @@ func resourceTencentCloudGaapProxyRead(d *schema.ResourceData, m interface{}) er
 	}
 
 	if proxy.CreateTime == nil {
-		return errors.New("proxy create time is nil")
+		return diag.FromErr(errors.New("proxy create time is nil"))
 	}
 	_ = d.Set("create_time", helper.FormatUnixTime(*proxy.CreateTime))
 
 	if proxy.Domain == nil {
-		return errors.New("proxy access domain is nil")
+		return diag.FromErr(errors.New("proxy access domain is nil"))
 	}
 	_ = d.Set("domain", proxy.Domain)
 
 	if proxy.IP == nil {
-		return errors.New("proxy access IP is nil")
+		return diag.FromErr(errors.New("proxy access IP is nil"))
 	}
 	_ = d.Set("ip", proxy.IP)
 
 	if proxy.Scalarable == nil {
-		return errors.New("proxy scalable is nil")
+		return diag.FromErr(errors.New("proxy scalable is nil"))
 	}
 	_ = d.Set("scalable", *proxy.Scalarable == 1)
 
 	if len(proxy.SupportProtocols) == 0 {
-		return errors.New("proxy support protocols is empty")
+		return diag.FromErr(errors.New("proxy support protocols is empty"))
 	}
 	supportProtocols := make([]string, 0, len(proxy.SupportProtocols))
 	for _, sp := range proxy.SupportProtocols {
@@ -294,7 +295,7 @@ func resourceTencentCloudGaapProxyRead(d *schema.ResourceData, m interface{}) er
 	_ = d.Set("support_protocols", supportProtocols)
 
 	if proxy.ForwardIP == nil {
-		return errors.New("proxy forward ip is nil")
+		return diag.FromErr(errors.New("proxy forward ip is nil"))
 	}
 	_ = d.Set("forward_ip", proxy.ForwardIP)
 
@@ -304,11 +305,11 @@ func resourceTencentCloudGaapProxyRead(d *schema.ResourceData, m interface{}) er
 	return nil
 }
 
-func resourceTencentCloudGaapProxyUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapProxyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_proxy.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -319,7 +320,7 @@ func resourceTencentCloudGaapProxyUpdate(d *schema.ResourceData, m interface{})
 	if d.HasChange("name") {
 		name := d.Get("name").(string)
 		if err := gaapService.ModifyProxyName(ctx, id, name); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -327,7 +328,7 @@ func resourceTencentCloudGaapProxyUpdate(d *schema.ResourceData, m interface{})
 	if d.HasChange("project_id") {
 		projectId := d.Get("project_id").(int)
 		if err := gaapService.ModifyProxyProjectId(ctx, id, projectId); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -344,7 +345,7 @@ func resourceTencentCloudGaapProxyUpdate(d *schema.ResourceData, m interface{})
 			concurrent = common.IntPtr(d.Get("concurrent").(int))
 		}
 		if err := gaapService.ModifyProxyConfiguration(ctx, id, bandwidth, concurrent); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		//deal with sync delay
 		time.Sleep(time.Duration(10) * time.Second)
@@ -354,11 +355,11 @@ func resourceTencentCloudGaapProxyUpdate(d *schema.ResourceData, m interface{})
 		enable := d.Get("enable").(bool)
 		if enable {
 			if err := gaapService.EnableProxy(ctx, id); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		} else {
 			if err := gaapService.DisableProxy(ctx, id); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -374,23 +375,23 @@ func resourceTencentCloudGaapProxyUpdate(d *schema.ResourceData, m interface{})
 		resourceName := fmt.Sprintf("qcs::gaap:%s:uin/:proxy/%s", region, id)
 
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudGaapProxyRead(d, m)
+	return resourceTencentCloudGaapProxyRead(ctx, d, m)
 }
 
-func resourceTencentCloudGaapProxyDelete(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapProxyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_proxy.update")()
 	//gaapActionMu.Lock()
 	//defer gaapActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	createTimeStr := d.Get("create_time").(string)
@@ -406,5 +407,5 @@ func resourceTencentCloudGaapProxyDelete(d *schema.ResourceData, m interface{})
 
 	service := GaapService{client: m.(*TencentCloudClient).apiV3Conn}
 
-	return service.DeleteProxy(ctx, id)
+	return diag.FromErr(service.DeleteProxy(ctx, id))
 }
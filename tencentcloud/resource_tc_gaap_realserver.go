@@ -27,9 +27,9 @@ package tencentcloud
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	gaap "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/gaap/v20180529"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -37,12 +37,12 @@ import (
 
 func resourceTencentCloudGaapRealserver() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudGaapRealserverCreate,
-		Read:   resourceTencentCloudGaapRealserverRead,
-		Update: resourceTencentCloudGaapRealserverUpdate,
-		Delete: resourceTencentCloudGaapRealserverDelete,
+		CreateContext: resourceTencentCloudGaapRealserverCreate,
+		ReadContext:   resourceTencentCloudGaapRealserverRead,
+		UpdateContext: resourceTencentCloudGaapRealserverUpdate,
+		DeleteContext: resourceTencentCloudGaapRealserverDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"ip": {
@@ -82,10 +82,10 @@ func resourceTencentCloudGaapRealserver() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudGaapRealserverCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapRealserverCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_realserver.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		addressIsSet bool
@@ -103,7 +103,7 @@ func resourceTencentCloudGaapRealserverCreate(d *schema.ResourceData, m interfac
 	}
 
 	if !addressIsSet {
-		return errors.New("ip or domain must be set")
+		return diag.FromErr(errors.New("ip or domain must be set"))
 	}
 
 	name := d.Get("name").(string)
@@ -112,15 +112,15 @@ func resourceTencentCloudGaapRealserverCreate(d *schema.ResourceData, m interfac
 
 	realservers, err := service.DescribeRealservers(ctx, &address, nil, nil, -1)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(realservers) > 0 {
-		return fmt.Errorf("the realserver with ip/domain %s already exists", address)
+		return diag.Errorf("the realserver with ip/domain %s already exists", address)
 	}
 
 	id, err := service.CreateRealserver(ctx, address, name, projectId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
@@ -128,21 +128,21 @@ func resourceTencentCloudGaapRealserverCreate(d *schema.ResourceData, m interfac
 		tagService := &TagService{client: tagClient}
 		resourceName := BuildTagResourceName("gaap", "realServer", tagClient.Region, id)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(id)
 
-	return resourceTencentCloudGaapRealserverRead(d, m)
+	return resourceTencentCloudGaapRealserverRead(ctx, d, m)
 }
 
-func resourceTencentCloudGaapRealserverRead(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapRealserverRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_realserver.read")()
 	defer inconsistentCheck(d, m)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -150,13 +150,13 @@ func resourceTencentCloudGaapRealserverRead(d *schema.ResourceData, m interface{
 
 	realservers, err := service.DescribeRealservers(ctx, nil, nil, nil, -1)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var realserver *gaap.BindRealServerInfo
 	for _, rs := range realservers {
 		if rs.RealServerId == nil {
-			return errors.New("realserver id is nil")
+			return diag.FromErr(errors.New("realserver id is nil"))
 		}
 
 		if *rs.RealServerId == id {
@@ -171,7 +171,7 @@ func resourceTencentCloudGaapRealserverRead(d *schema.ResourceData, m interface{
 	}
 
 	if realserver.RealServerIP == nil {
-		return errors.New("realserver ip or domain is nil")
+		return diag.FromErr(errors.New("realserver ip or domain is nil"))
 	}
 	if net.ParseIP(*realserver.RealServerIP) != nil {
 		_ = d.Set("ip", realserver.RealServerIP)
@@ -180,12 +180,12 @@ func resourceTencentCloudGaapRealserverRead(d *schema.ResourceData, m interface{
 	}
 
 	if realserver.RealServerName == nil {
-		return errors.New("realserver name is nil")
+		return diag.FromErr(errors.New("realserver name is nil"))
 	}
 	_ = d.Set("name", realserver.RealServerName)
 
 	if realserver.ProjectId == nil {
-		return errors.New("realserver project id is nil")
+		return diag.FromErr(errors.New("realserver project id is nil"))
 	}
 	_ = d.Set("project_id", realserver.ProjectId)
 
@@ -193,17 +193,17 @@ func resourceTencentCloudGaapRealserverRead(d *schema.ResourceData, m interface{
 	tagService := TagService{client: tagClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "gaap", "realServer", tagClient.Region, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudGaapRealserverUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapRealserverUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_realserver.update")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -215,7 +215,7 @@ func resourceTencentCloudGaapRealserverUpdate(d *schema.ResourceData, m interfac
 		gaapService := GaapService{client: m.(*TencentCloudClient).apiV3Conn}
 
 		if err := gaapService.ModifyRealserverName(ctx, id, newName); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -230,24 +230,24 @@ func resourceTencentCloudGaapRealserverUpdate(d *schema.ResourceData, m interfac
 		resourceName := BuildTagResourceName("gaap", "realServer", region, id)
 
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudGaapRealserverRead(d, m)
+	return resourceTencentCloudGaapRealserverRead(ctx, d, m)
 }
 
-func resourceTencentCloudGaapRealserverDelete(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapRealserverDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_realserver.delete")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
 	service := GaapService{client: m.(*TencentCloudClient).apiV3Conn}
 
-	return service.DeleteRealserver(ctx, id)
+	return diag.FromErr(service.DeleteRealserver(ctx, id))
 }
@@ -31,17 +31,18 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTencentCloudGaapSecurityPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudGaapSecurityPolicyCreate,
-		Read:   resourceTencentCloudGaapSecurityPolicyRead,
-		Update: resourceTencentCloudGaapSecurityPolicyUpdate,
-		Delete: resourceTencentCloudGaapSecurityPolicyDelete,
+		CreateContext: resourceTencentCloudGaapSecurityPolicyCreate,
+		ReadContext:   resourceTencentCloudGaapSecurityPolicyRead,
+		UpdateContext: resourceTencentCloudGaapSecurityPolicyUpdate,
+		DeleteContext: resourceTencentCloudGaapSecurityPolicyDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,13 +69,13 @@ func resourceTencentCloudGaapSecurityPolicy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudGaapSecurityPolicyCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapSecurityPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_security_policy.create")()
 	gaapActionMu.Lock()
 	defer gaapActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	proxyId := d.Get("proxy_id").(string)
 	action := d.Get("action").(string)
@@ -84,26 +85,26 @@ func resourceTencentCloudGaapSecurityPolicyCreate(d *schema.ResourceData, m inte
 
 	id, err := service.CreateSecurityPolicy(ctx, proxyId, action)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
 
 	if enable {
 		if err := service.EnableSecurityPolicy(ctx, proxyId, id); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudGaapSecurityPolicyRead(d, m)
+	return resourceTencentCloudGaapSecurityPolicyRead(ctx, d, m)
 }
 
-func resourceTencentCloudGaapSecurityPolicyRead(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapSecurityPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_security_policy.read")()
 	defer inconsistentCheck(d, m)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -111,7 +112,7 @@ func resourceTencentCloudGaapSecurityPolicyRead(d *schema.ResourceData, m interf
 
 	proxyId, status, action, exist, err := service.DescribeSecurityPolicy(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !exist {
@@ -126,13 +127,13 @@ func resourceTencentCloudGaapSecurityPolicyRead(d *schema.ResourceData, m interf
 	return nil
 }
 
-func resourceTencentCloudGaapSecurityPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapSecurityPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_security_policy.update")()
 	gaapActionMu.Lock()
 	defer gaapActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	proxyId := d.Get("proxy_id").(string)
@@ -142,24 +143,24 @@ func resourceTencentCloudGaapSecurityPolicyUpdate(d *schema.ResourceData, m inte
 
 	if enable {
 		if err := service.EnableSecurityPolicy(ctx, proxyId, id); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if err := service.DisableSecurityPolicy(ctx, proxyId, id); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudGaapSecurityPolicyRead(d, m)
+	return resourceTencentCloudGaapSecurityPolicyRead(ctx, d, m)
 }
 
-func resourceTencentCloudGaapSecurityPolicyDelete(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapSecurityPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_security_policy.delete")()
 	gaapActionMu.Lock()
 	defer gaapActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	enable := d.Get("enable").(bool)
@@ -169,9 +170,9 @@ func resourceTencentCloudGaapSecurityPolicyDelete(d *schema.ResourceData, m inte
 
 	if enable {
 		if err := service.DisableSecurityPolicy(ctx, proxyId, id); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return service.DeleteSecurityPolicy(ctx, id)
+	return diag.FromErr(service.DeleteSecurityPolicy(ctx, id))
 }
@@ -42,17 +42,18 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTencentCloudGaapSecurityRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudGaapSecurityRuleCreate,
-		Read:   resourceTencentCloudGaapSecurityRuleRead,
-		Update: resourceTencentCloudGaapSecurityRuleUpdate,
-		Delete: resourceTencentCloudGaapSecurityRuleDelete,
+		CreateContext: resourceTencentCloudGaapSecurityRuleCreate,
+		ReadContext:   resourceTencentCloudGaapSecurityRuleRead,
+		UpdateContext: resourceTencentCloudGaapSecurityRuleUpdate,
+		DeleteContext: resourceTencentCloudGaapSecurityRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -108,13 +109,13 @@ func resourceTencentCloudGaapSecurityRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudGaapSecurityRuleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapSecurityRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_security_rule.create")()
 	gaapActionMu.Lock()
 	defer gaapActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	policyId := d.Get("policy_id").(string)
 	cidrIp := d.Get("cidr_ip").(string)
@@ -124,25 +125,25 @@ func resourceTencentCloudGaapSecurityRuleCreate(d *schema.ResourceData, m interf
 	name := d.Get("name").(string)
 
 	if protocol == "ALL" && port != "ALL" {
-		return errors.New("when protocol is ALL, port should be ALL, too")
+		return diag.FromErr(errors.New("when protocol is ALL, port should be ALL, too"))
 	}
 
 	service := GaapService{client: m.(*TencentCloudClient).apiV3Conn}
 
 	id, err := service.CreateSecurityRule(ctx, policyId, name, cidrIp, port, action, protocol)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
 
-	return resourceTencentCloudGaapSecurityRuleRead(d, m)
+	return resourceTencentCloudGaapSecurityRuleRead(ctx, d, m)
 }
 
-func resourceTencentCloudGaapSecurityRuleRead(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapSecurityRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_security_rule.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -150,7 +151,7 @@ func resourceTencentCloudGaapSecurityRuleRead(d *schema.ResourceData, m interfac
 
 	rule, err := service.DescribeSecurityRule(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if rule == nil {
@@ -181,13 +182,13 @@ func resourceTencentCloudGaapSecurityRuleRead(d *schema.ResourceData, m interfac
 	return nil
 }
 
-func resourceTencentCloudGaapSecurityRuleUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapSecurityRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_security_rule.update")()
 	gaapActionMu.Lock()
 	defer gaapActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	policyId := d.Get("policy_id").(string)
@@ -200,24 +201,24 @@ func resourceTencentCloudGaapSecurityRuleUpdate(d *schema.ResourceData, m interf
 	service := GaapService{client: m.(*TencentCloudClient).apiV3Conn}
 
 	if err := service.ModifySecurityRule(ctx, policyId, id, cidrIp, action, port, protocol, name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudGaapSecurityRuleRead(d, m)
+	return resourceTencentCloudGaapSecurityRuleRead(ctx, d, m)
 }
 
-func resourceTencentCloudGaapSecurityRuleDelete(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudGaapSecurityRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_gaap_security_rule.delete")()
 	gaapActionMu.Lock()
 	defer gaapActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	policyId := d.Get("policy_id").(string)
 
 	service := GaapService{client: m.(*TencentCloudClient).apiV3Conn}
 
-	return service.DeleteSecurityRule(ctx, policyId, id)
+	return diag.FromErr(service.DeleteSecurityRule(ctx, policyId, id))
 }
@@ -23,9 +23,12 @@ $ terraform import tencentcloud_ha_vip.foo havip-kjqwe4ba
 package tencentcloud
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -36,12 +39,17 @@ import (
 
 func resourceTencentCloudHaVip() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudHaVipCreate,
-		Read:   resourceTencentCloudHaVipRead,
-		Update: resourceTencentCloudHaVipUpdate,
-		Delete: resourceTencentCloudHaVipDelete,
+		CreateContext: resourceTencentCloudHaVipCreate,
+		ReadContext:   resourceTencentCloudHaVipRead,
+		UpdateContext: resourceTencentCloudHaVipUpdate,
+		DeleteContext: resourceTencentCloudHaVipDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -100,7 +108,7 @@ func resourceTencentCloudHaVip() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudHaVipCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudHaVipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ha_vip.create")()
 
 	logId := getLogId(contextNil)
@@ -114,8 +122,8 @@ func resourceTencentCloudHaVipCreate(d *schema.ResourceData, meta interface{}) e
 		request.Vip = helper.String(v.(string))
 	}
 	var response *vpc.CreateHaVipResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateHaVip(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateHaVipWithContext(ctx, request)
 		if e != nil {
 			return retryError(errors.WithStack(e))
 		}
@@ -124,19 +132,19 @@ func resourceTencentCloudHaVipCreate(d *schema.ResourceData, meta interface{}) e
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create HA VIP failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if response.Response.HaVip == nil {
-		return fmt.Errorf("HA VIP id is nil")
+		return diag.Errorf("HA VIP id is nil")
 	}
 	haVipId := *response.Response.HaVip.HaVipId
 	d.SetId(haVipId)
 
-	return resourceTencentCloudHaVipRead(d, meta)
+	return resourceTencentCloudHaVipRead(ctx, d, meta)
 }
 
-func resourceTencentCloudHaVipRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudHaVipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ha_vip.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -147,8 +155,8 @@ func resourceTencentCloudHaVipRead(d *schema.ResourceData, meta interface{}) err
 	request.HaVipIds = []*string{&haVipId}
 
 	var response *vpc.DescribeHaVipsResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeHaVips(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeHaVipsWithContext(ctx, request)
 		if e != nil {
 			return retryError(errors.WithStack(e))
 		}
@@ -157,7 +165,7 @@ func resourceTencentCloudHaVipRead(d *schema.ResourceData, meta interface{}) err
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read HA VIP failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	if len(response.Response.HaVipSet) < 1 {
 		d.SetId("")
@@ -179,7 +187,7 @@ func resourceTencentCloudHaVipRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceTencentCloudHaVipUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudHaVipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ha_vip.update")()
 
 	logId := getLogId(contextNil)
@@ -189,8 +197,8 @@ func resourceTencentCloudHaVipUpdate(d *schema.ResourceData, meta interface{}) e
 	request.HaVipId = &haVipId
 	if d.HasChange("name") {
 		request.HaVipName = helper.String(d.Get("name").(string))
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyHaVipAttribute(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyHaVipAttributeWithContext(ctx, request)
 			if e != nil {
 				return retryError(errors.WithStack(e))
 			}
@@ -198,14 +206,14 @@ func resourceTencentCloudHaVipUpdate(d *schema.ResourceData, meta interface{}) e
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s modify HA VIP failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudHaVipRead(d, meta)
+	return resourceTencentCloudHaVipRead(ctx, d, meta)
 }
 
-func resourceTencentCloudHaVipDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudHaVipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ha_vip.delete")()
 
 	logId := getLogId(contextNil)
@@ -214,8 +222,8 @@ func resourceTencentCloudHaVipDelete(d *schema.ResourceData, meta interface{}) e
 
 	request := vpc.NewDeleteHaVipRequest()
 	request.HaVipId = &haVipId
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DeleteHaVip(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DeleteHaVipWithContext(ctx, request)
 		if e != nil {
 			return retryError(errors.WithStack(e), VPCUnsupportedOperation)
 		}
@@ -223,13 +231,13 @@ func resourceTencentCloudHaVipDelete(d *schema.ResourceData, meta interface{}) e
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete HA VIP failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	//to get the status of haVip
 	statRequest := vpc.NewDescribeHaVipsRequest()
 	statRequest.HaVipIds = []*string{&haVipId}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeHaVips(statRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeHaVipsWithContext(ctx, statRequest)
 		if e != nil {
 			ee, ok := e.(*sdkErrors.TencentCloudSDKError)
 			if !ok {
@@ -254,7 +262,7 @@ func resourceTencentCloudHaVipDelete(d *schema.ResourceData, meta interface{}) e
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete HA VIP failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
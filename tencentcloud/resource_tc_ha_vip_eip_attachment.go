@@ -24,8 +24,8 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -35,11 +35,11 @@ import (
 
 func resourceTencentCloudHaVipEipAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudHaVipEipAttachmentCreate,
-		Read:   resourceTencentCloudHaVipEipAttachmentRead,
-		Delete: resourceTencentCloudHaVipEipAttachmentDelete,
+		CreateContext: resourceTencentCloudHaVipEipAttachmentCreate,
+		ReadContext:   resourceTencentCloudHaVipEipAttachmentRead,
+		DeleteContext: resourceTencentCloudHaVipEipAttachmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -60,28 +60,28 @@ func resourceTencentCloudHaVipEipAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudHaVipEipAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudHaVipEipAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ha_vip_eip_attachment.create")()
 
 	haVipId := d.Get("havip_id").(string)
 	addressIp := d.Get("address_ip").(string)
 
-	bindErr := haVipAssociateEip(meta, haVipId, addressIp)
+	bindErr := haVipAssociateEip(ctx, meta, haVipId, addressIp)
 	if bindErr != nil {
-		return bindErr
+		return diag.FromErr(bindErr)
 	}
 
-	d.SetId(haVipId + "#" + addressIp)
+	d.SetId(helper.IdFormat(haVipId, addressIp))
 
-	return resourceTencentCloudHaVipEipAttachmentRead(d, meta)
+	return resourceTencentCloudHaVipEipAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudHaVipEipAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudHaVipEipAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ha_vip_eip_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	haVipEipAttachmentId := d.Id()
 
@@ -91,7 +91,7 @@ func resourceTencentCloudHaVipEipAttachmentRead(d *schema.ResourceData, meta int
 	vpcService := VpcService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		eipId, haVipId, flag, e := vpcService.DescribeHaVipEipById(ctx, haVipEipAttachmentId)
 		if e != nil {
 			return retryError(e)
@@ -103,7 +103,7 @@ func resourceTencentCloudHaVipEipAttachmentRead(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read HA VIP EIP attachment failed, reason:%s\n", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !has {
@@ -117,33 +117,33 @@ func resourceTencentCloudHaVipEipAttachmentRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudHaVipEipAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudHaVipEipAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ha_vip_eip_attachment.delete")()
 
 	haVipEipAttachmentId := d.Id()
-	items := strings.Split(haVipEipAttachmentId, "#")
-	if len(items) != 2 {
-		return fmt.Errorf("decode HA VIP EIP attachment id error")
+	items, err := helper.IdParseN(haVipEipAttachmentId, 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	haVipId := items[0]
 	addressIp := items[1]
 
-	unBindErr := haVipDisassociateEip(meta, haVipId, addressIp)
+	unBindErr := haVipDisassociateEip(ctx, meta, haVipId, addressIp)
 	if unBindErr != nil {
-		return unBindErr
+		return diag.FromErr(unBindErr)
 	}
 
 	return nil
 }
 
-func haVipAssociateEip(meta interface{}, havipId string, eip string) error {
+func haVipAssociateEip(ctx context.Context, meta interface{}, havipId string, eip string) error {
 	//associate eip
 	logId := getLogId(contextNil)
 	bindRequest := vpc.NewHaVipAssociateAddressIpRequest()
 	bindRequest.HaVipId = helper.String(havipId)
 	bindRequest.AddressIp = helper.String(eip)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().HaVipAssociateAddressIp(bindRequest)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().HaVipAssociateAddressIpWithContext(ctx, bindRequest)
 		if e != nil {
 			return retryError(errors.WithStack(e))
 		}
@@ -156,8 +156,8 @@ func haVipAssociateEip(meta interface{}, havipId string, eip string) error {
 
 	statRequest := vpc.NewDescribeHaVipsRequest()
 	statRequest.HaVipIds = []*string{&havipId}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeHaVips(statRequest)
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeHaVipsWithContext(ctx, statRequest)
 		if e != nil {
 			return retryError(errors.WithStack(e), VPCUnsupportedOperation)
 		} else {
@@ -179,13 +179,13 @@ func haVipAssociateEip(meta interface{}, havipId string, eip string) error {
 	return nil
 }
 
-func haVipDisassociateEip(meta interface{}, havipId string, eip string) error {
+func haVipDisassociateEip(ctx context.Context, meta interface{}, havipId string, eip string) error {
 	//associate eip
 	logId := getLogId(contextNil)
 	bindRequest := vpc.NewHaVipDisassociateAddressIpRequest()
 	bindRequest.HaVipId = helper.String(havipId)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().HaVipDisassociateAddressIp(bindRequest)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().HaVipDisassociateAddressIpWithContext(ctx, bindRequest)
 		if e != nil {
 			return retryError(errors.WithStack(e))
 		}
@@ -198,8 +198,8 @@ func haVipDisassociateEip(meta interface{}, havipId string, eip string) error {
 
 	statRequest := vpc.NewDescribeHaVipsRequest()
 	statRequest.HaVipIds = []*string{&havipId}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeHaVips(statRequest)
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeHaVipsWithContext(ctx, statRequest)
 		if e != nil {
 			//when associated eip is in deleting process, delete ha vip may return unsupported operation error
 			return retryError(errors.WithStack(e), VPCUnsupportedOperation)
@@ -26,7 +26,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -36,12 +38,16 @@ import (
 
 func resourceTencentCloudImage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudImageCreate,
-		Read:   resourceTencentCloudImageRead,
-		Update: resourceTencentCloudImageUpdate,
-		Delete: resourceTencentCloudImageDelete,
+		CreateContext: resourceTencentCloudImageCreate,
+		ReadContext:   resourceTencentCloudImageRead,
+		UpdateContext: resourceTencentCloudImageUpdate,
+		DeleteContext: resourceTencentCloudImageDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(9 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -102,10 +108,10 @@ func resourceTencentCloudImage() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudImageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_image.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	cvmService := CvmService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -151,7 +157,7 @@ func resourceTencentCloudImageCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if len(request.SnapshotIds) > 0 && len(request.DataDiskIds) > 0 {
-		return fmt.Errorf("`%s` and `%s` Can't appear in the profile China at the same time,The parameter `%s` depends on the pre_parameter `%s`",
+		return diag.Errorf("`%s` and `%s` Can't appear in the profile China at the same time,The parameter `%s` depends on the pre_parameter `%s`",
 			"snapshot_ids", "data_disk_ids", "data_disk_ids", "instance_id")
 	}
 
@@ -172,9 +178,11 @@ func resourceTencentCloudImageCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	imageId := ""
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := cvmService.client.UseCvmClient().CreateImage(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := cvmService.client.UseCvmClient().CreateImageWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -184,7 +192,7 @@ func resourceTencentCloudImageCreate(d *schema.ResourceData, meta interface{}) e
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(imageId)
 
@@ -195,28 +203,28 @@ func resourceTencentCloudImageCreate(d *schema.ResourceData, meta interface{}) e
 		resourceName := BuildTagResourceName("cvm", "image", tcClient.Region, imageId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
 			// If tags attachment failed, the user will be notified, then plan/apply/update with terraform.
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	// wait for status
 	_, has, errRet := cvmService.DescribeImageById(ctx, imageId, false)
 	if errRet != nil {
-		return errRet
+		return diag.FromErr(errRet)
 	}
 	if !has {
-		return fmt.Errorf("[CRITAL]%s creating cvm image failed, image doesn't exist", logId)
+		return diag.Errorf("[CRITAL]%s creating cvm image failed, image doesn't exist", logId)
 	}
 
-	return resourceTencentCloudImageRead(d, meta)
+	return resourceTencentCloudImageRead(ctx, d, meta)
 }
 
-func resourceTencentCloudImageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_image.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	imageId := d.Id()
 	cvmService := CvmService{
@@ -225,7 +233,7 @@ func resourceTencentCloudImageRead(d *schema.ResourceData, meta interface{}) err
 
 	image, has, errRet := cvmService.DescribeImageById(ctx, imageId, false)
 	if errRet != nil {
-		return errRet
+		return diag.FromErr(errRet)
 	}
 	if !has {
 		d.SetId("")
@@ -260,17 +268,17 @@ func resourceTencentCloudImageRead(d *schema.ResourceData, meta interface{}) err
 
 	tags, err := tagService.DescribeResourceTags(ctx, "cvm", "image", client.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 	return nil
 }
 
-func resourceTencentCloudImageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudImageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_image.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 	cvmService := CvmService{
@@ -282,7 +290,7 @@ func resourceTencentCloudImageUpdate(d *schema.ResourceData, meta interface{}) e
 		imageDesc := d.Get("image_description").(string)
 
 		if err := cvmService.ModifyImage(ctx, instanceId, imageName, imageDesc); nil != err {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -296,18 +304,18 @@ func resourceTencentCloudImageUpdate(d *schema.ResourceData, meta interface{}) e
 		resourceName := BuildTagResourceName("cvm", "image", region, instanceId)
 		err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudImageRead(d, meta)
+	return resourceTencentCloudImageRead(ctx, d, meta)
 }
 
-func resourceTencentCloudImageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_image.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	cvmService := CvmService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -315,18 +323,18 @@ func resourceTencentCloudImageDelete(d *schema.ResourceData, meta interface{}) e
 
 	imageId := d.Id()
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	if err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := cvmService.DeleteImage(ctx, imageId)
 		if e != nil {
 			return retryError(e)
 		}
 		return nil
 	}); nil != err {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//check image
-	if err := resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	if err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, err := cvmService.DescribeImageById(ctx, imageId, true)
 		if err != nil {
 			return retryError(err)
@@ -336,7 +344,7 @@ func resourceTencentCloudImageDelete(d *schema.ResourceData, meta interface{}) e
 		}
 		return nil
 	}); nil != err {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -110,6 +110,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -120,13 +121,28 @@ import (
 
 func resourceTencentCloudInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudInstanceCreate,
-		Read:   resourceTencentCloudInstanceRead,
-		Update: resourceTencentCloudInstanceUpdate,
-		Delete: resourceTencentCloudInstanceDelete,
+		CreateContext: resourceTencentCloudInstanceCreate,
+		ReadContext:   resourceTencentCloudInstanceRead,
+		UpdateContext: resourceTencentCloudInstanceUpdate,
+		DeleteContext: resourceTencentCloudInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: helper.ComposeCustomizeDiff(
+			helper.AllowedOnlyWhen("instance_charge_type", CVM_CHARGE_TYPE_PREPAID, "instance_charge_type_prepaid_period", "instance_charge_type_prepaid_renew_flag"),
+			helper.AllowedOnlyWhen("instance_charge_type", CVM_CHARGE_TYPE_SPOTPAID, "spot_instance_type", "spot_max_price"),
+			helper.CustomizeDiffOnCreate(
+				helper.RequiredWhen("instance_charge_type", CVM_CHARGE_TYPE_CDHPAID, "cdh_instance_type", "cdh_host_id"),
+			),
+			helper.AllowedOnlyWhen("instance_charge_type", CVM_CHARGE_TYPE_CDHPAID, "cdh_instance_type", "cdh_host_id"),
+			helper.IntInRangeOf("system_disk_type", "system_disk_size", CVM_SYSTEM_DISK_SIZE_RANGE),
+			helper.ListIntInRangeOf("data_disks", "data_disk_type", "data_disk_size", CVM_DATA_DISK_SIZE_RANGE),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"image_id": {
@@ -500,10 +516,10 @@ func resourceTencentCloudInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_instance.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	cvmService := CvmService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -565,12 +581,12 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 			if v, ok := d.GetOk("cdh_instance_type"); ok {
 				request.InstanceType = helper.String(v.(string))
 			} else {
-				return fmt.Errorf("cdh_instance_type can not be empty when instance_charge_type is %s", instanceChargeType)
+				return diag.Errorf("cdh_instance_type can not be empty when instance_charge_type is %s", instanceChargeType)
 			}
 			if v, ok := d.GetOk("cdh_host_id"); ok {
 				request.Placement.HostIds = append(request.Placement.HostIds, helper.String(v.(string)))
 			} else {
-				return fmt.Errorf("cdh_host_id can not be empty when instance_charge_type is %s", instanceChargeType)
+				return diag.Errorf("cdh_host_id can not be empty when instance_charge_type is %s", instanceChargeType)
 			}
 		}
 	}
@@ -735,9 +751,11 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 
 	instanceId := ""
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check("create")
-		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RunInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, "create"); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RunInstancesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -758,14 +776,14 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(instanceId)
 
 	//get system disk ID and data disk ID
 	var systemDiskId string
 	var dataDiskIds []string
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, errRet := cvmService.DescribeInstanceById(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -792,7 +810,7 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Wait for the tags attached to the vm since tags attachment it's async while vm creation.
@@ -802,7 +820,7 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 		resourceName := BuildTagResourceName("cvm", "instance", tcClient.Region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
 			// If tags attachment failed, the user will be notified, then plan/apply/update with terraform.
-			return err
+			return diag.FromErr(err)
 		}
 
 		//except instance ,system disk and data disk will be tagged
@@ -812,7 +830,7 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 			resourceName = BuildTagResourceName("cvm", "volume", tcClient.Region, systemDiskId)
 			if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
 				// If tags attachment failed, the user will be notified, then plan/apply/update with terraform.
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		//tag disk ids
@@ -821,7 +839,7 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 				resourceName = BuildTagResourceName("cvm", "volume", tcClient.Region, diskId)
 				if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
 					// If tags attachment failed, the user will be notified, then plan/apply/update with terraform.
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -831,10 +849,10 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 		stoppedMode := d.Get("stopped_mode").(string)
 		err = cvmService.StopInstance(ctx, instanceId, stoppedMode)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			instance, errRet := cvmService.DescribeInstanceById(ctx, instanceId)
 			if errRet != nil {
 				return retryError(errRet, InternalError)
@@ -845,19 +863,19 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 			return resource.RetryableError(fmt.Errorf("cvm instance status is %s, retry...", *instance.InstanceState))
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudInstanceRead(d, meta)
+	return resourceTencentCloudInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 	forceDelete := false
@@ -873,7 +891,7 @@ func resourceTencentCloudInstanceRead(d *schema.ResourceData, meta interface{})
 	cbsService := CbsService{client: client}
 	var instance *cvm.Instance
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		instance, errRet = cvmService.DescribeInstanceById(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -884,7 +902,7 @@ func resourceTencentCloudInstanceRead(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if instance == nil || *instance.InstanceState == CVM_STATUS_LAUNCH_FAILED {
 		d.SetId("")
@@ -894,9 +912,9 @@ func resourceTencentCloudInstanceRead(d *schema.ResourceData, meta interface{})
 
 	var cvmImages []string
 	var response *cvm.DescribeImagesResponse
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		request := cvm.NewDescribeImagesRequest()
-		response, errRet = client.UseCvmClient().DescribeImages(request)
+		response, errRet = client.UseCvmClient().DescribeImagesWithContext(ctx, request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
 		}
@@ -910,7 +928,7 @@ func resourceTencentCloudInstanceRead(d *schema.ResourceData, meta interface{})
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.Get("image_id").(string) == "" || instance.ImageId == nil || !IsContains(cvmImages, *instance.ImageId) {
@@ -950,7 +968,7 @@ func resourceTencentCloudInstanceRead(d *schema.ResourceData, meta interface{})
 
 	tags, err := tagService.DescribeResourceTags(ctx, "cvm", "instance", client.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// as attachment add tencentcloud:autoscaling:auto-scaling-group-id tag automatically
 	// we should remove this tag, otherwise it will cause terraform state change
@@ -980,7 +998,7 @@ func resourceTencentCloudInstanceRead(d *schema.ResourceData, meta interface{})
 				diskSizeMap[*id] = helper.Int64Uint64(*size)
 			}
 		}
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			disks, err := cbsService.DescribeDiskList(ctx, diskIds)
 			if err != nil {
 				return resource.NonRetryableError(err)
@@ -1005,7 +1023,7 @@ func resourceTencentCloudInstanceRead(d *schema.ResourceData, meta interface{})
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	for _, disk := range instance.DataDisks {
@@ -1058,11 +1076,11 @@ func resourceTencentCloudInstanceRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+func resourceTencentCloudInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_instance.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	instanceId := d.Id()
 	cvmService := CvmService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -1073,7 +1091,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 	// Get the latest instance info from actual resource.
 	instanceInfo, err := cvmService.DescribeInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var (
@@ -1103,12 +1121,12 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 		// change charge type
 		err := cvmService.ModifyInstanceChargeType(ctx, instanceId, expectChargeType, period, renewFlag)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		// query cvm status
-		err = waitForOperationFinished(d, meta, 5*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+		err = waitForOperationFinished(ctx, d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		periodSet = true
 		renewFlagSet = true
@@ -1129,12 +1147,12 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 		}
 		err := cvmService.ModifyInstanceChargeType(ctx, instanceId, chargeType, period, renewFlag)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		// query cvm status
-		err = waitForOperationFinished(d, meta, 5*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+		err = waitForOperationFinished(ctx, d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		renewFlagSet = true
 	}
@@ -1143,13 +1161,13 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 		//renew api
 		err := cvmService.ModifyRenewParam(ctx, instanceId, d.Get("instance_charge_type_prepaid_renew_flag").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		//check success
-		err = waitForOperationFinished(d, meta, 2*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+		err = waitForOperationFinished(ctx, d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		time.Sleep(readRetryTimeout)
@@ -1159,7 +1177,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 	if d.HasChange("instance_name") {
 		err := cvmService.ModifyInstanceName(ctx, instanceId, d.Get("instance_name").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -1167,7 +1185,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 	if d.HasChange("disable_api_termination") {
 		err := cvmService.ModifyDisableApiTermination(ctx, instanceId, d.Get("disable_api_termination").(bool))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1179,7 +1197,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 		}
 		err := cvmService.ModifySecurityGroups(ctx, instanceId, securityGroupIds)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -1192,7 +1210,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 		}
 		err := cvmService.ModifySecurityGroups(ctx, instanceId, orderlySecurityGroupIds)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -1201,7 +1219,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 		projectId := d.Get("project_id").(int)
 		err := cvmService.ModifyProjectId(ctx, instanceId, int64(projectId))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -1262,7 +1280,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 		}
 
 		if err := cvmService.ResetInstance(ctx, request); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		// Modify Login Info Directly
@@ -1270,11 +1288,11 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 		if d.HasChange("password") {
 			err := cvmService.ModifyPassword(ctx, instanceId, d.Get("password").(string))
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			err = waitForOperationFinished(d, meta, 2*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+			err = waitForOperationFinished(ctx, d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -1286,22 +1304,22 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 			if oldKeyId != "" {
 				err := cvmService.UnbindKeyPair(ctx, []*string{&oldKeyId}, []*string{&instanceId})
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
-				err = waitForOperationFinished(d, meta, 2*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+				err = waitForOperationFinished(ctx, d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 
 			if keyId != "" {
 				err = cvmService.BindKeyPair(ctx, []*string{&keyId}, instanceId)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
-				err = waitForOperationFinished(d, meta, 2*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+				err = waitForOperationFinished(ctx, d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -1321,21 +1339,21 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 			if removes.Len() > 0 {
 				err := cvmService.UnbindKeyPair(ctx, helper.InterfacesStringsPoint(removes.List()), []*string{&instanceId})
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
-				err = waitForOperationFinished(d, meta, 2*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+				err = waitForOperationFinished(ctx, d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 			if adds.Len() > 0 {
 				err = cvmService.BindKeyPair(ctx, helper.InterfacesStringsPoint(adds.List()), instanceId)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
-				err = waitForOperationFinished(d, meta, 2*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+				err = waitForOperationFinished(ctx, d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -1347,7 +1365,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 		nv := n.([]interface{})
 
 		if len(ov) != len(nv) {
-			return fmt.Errorf("error: data disk count has changed (%d -> %d) but doesn't support add or remove for now", len(ov), len(nv))
+			return diag.Errorf("error: data disk count has changed (%d -> %d) but doesn't support add or remove for now", len(ov), len(nv))
 		}
 
 		cbsService := CbsService{
@@ -1366,7 +1384,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 			err := cbsService.ResizeDisk(ctx, diskId, size)
 
 			if err != nil {
-				return fmt.Errorf("an error occurred when modifying %s, reason: %s", sizeKey, err.Error())
+				return diag.Errorf("an error occurred when modifying %s, reason: %s", sizeKey, err.Error())
 			}
 
 		}
@@ -1376,7 +1394,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 	if d.HasChange("running_flag") {
 		flag = d.Get("running_flag").(bool)
 		if err := switchInstance(&cvmService, ctx, d, flag); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -1396,10 +1414,10 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 
 		err := cvmService.ResizeInstanceDisks(ctx, req)
 		if err != nil {
-			return fmt.Errorf("an error occurred when modifying system_disk, reason: %s", err.Error())
+			return diag.Errorf("an error occurred when modifying system_disk, reason: %s", err.Error())
 		}
 
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			instance, err := cvmService.DescribeInstanceById(ctx, instanceId)
 			if err != nil {
 				return resource.NonRetryableError(err)
@@ -1422,7 +1440,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -1430,24 +1448,24 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 	if d.HasChange("instance_type") {
 		err := cvmService.ModifyInstanceType(ctx, instanceId, d.Get("instance_type").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = waitForOperationFinished(d, meta, 2*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+		err = waitForOperationFinished(ctx, d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("cdh_instance_type") {
 		err := cvmService.ModifyInstanceType(ctx, instanceId, d.Get("cdh_instance_type").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = waitForOperationFinished(d, meta, 2*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+		err = waitForOperationFinished(ctx, d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1457,7 +1475,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 		privateIp := d.Get("private_ip").(string)
 		err := cvmService.ModifyVpc(ctx, instanceId, vpcId, subnetId, privateIp)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1471,7 +1489,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 		resourceName := BuildTagResourceName("cvm", "instance", region, instanceId)
 		err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		//except instance ,system disk and data disk will be tagged
 		//keep logical consistence with the console
@@ -1480,7 +1498,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 			if systemDiskId.(string) != "" {
 				resourceName = BuildTagResourceName("cvm", "volume", region, systemDiskId.(string))
 				if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -1492,7 +1510,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 				dataDiskId := disk["data_disk_id"].(string)
 				resourceName = BuildTagResourceName("cvm", "volume", region, dataDiskId)
 				if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -1503,31 +1521,31 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 		chargeType := d.Get("internet_charge_type").(string)
 		bandWidthOut := int64(d.Get("internet_max_bandwidth_out").(int))
 		if chargeType != "TRAFFIC_POSTPAID_BY_HOUR" && chargeType != "BANDWIDTH_POSTPAID_BY_HOUR" && chargeType != "BANDWIDTH_PACKAGE" {
-			return fmt.Errorf("charge type should be one of `TRAFFIC_POSTPAID_BY_HOUR BANDWIDTH_POSTPAID_BY_HOUR BANDWIDTH_PACKAGE` when adjusting internet_max_bandwidth_out")
+			return diag.Errorf("charge type should be one of `TRAFFIC_POSTPAID_BY_HOUR BANDWIDTH_POSTPAID_BY_HOUR BANDWIDTH_PACKAGE` when adjusting internet_max_bandwidth_out")
 		}
 
 		err := cvmService.ModifyInternetMaxBandwidthOut(ctx, instanceId, chargeType, bandWidthOut)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = waitForOperationFinished(d, meta, 2*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+		err = waitForOperationFinished(ctx, d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudInstanceRead(d, meta)
+	return resourceTencentCloudInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_instance.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 	//check is force delete or not
@@ -1537,7 +1555,7 @@ func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		errRet := cvmService.DeleteInstance(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet)
@@ -1545,14 +1563,14 @@ func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//check recycling
 	notExist := false
 
 	//check exist
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		instance, errRet := cvmService.DescribeInstanceById(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -1568,7 +1586,7 @@ func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}
 		return resource.RetryableError(fmt.Errorf("cvm instance status is %s, retry...", *instance.InstanceState))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if notExist || !forceDelete {
@@ -1576,7 +1594,7 @@ func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}
 	}
 
 	// exist in recycle, delete again
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		errRet := cvmService.DeleteInstance(ctx, instanceId)
 		//when state is terminating, do not delete but check exist
 		if errRet != nil {
@@ -1593,11 +1611,11 @@ func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//describe and check not exist
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		instance, errRet := cvmService.DescribeInstanceById(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -1608,17 +1626,17 @@ func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}
 		return resource.RetryableError(fmt.Errorf("cvm instance status is %s, retry...", *instance.InstanceState))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if v, ok := d.GetOk("data_disks"); ok {
 		dataDisks := v.([]interface{})
-		for _, d := range dataDisks {
-			value := d.(map[string]interface{})
+		for _, disk := range dataDisks {
+			value := disk.(map[string]interface{})
 			diskId := value["data_disk_id"].(string)
 			deleteWithInstance := value["delete_with_instance"].(bool)
 			if deleteWithInstance {
 				cbsService := CbsService{client: meta.(*TencentCloudClient).apiV3Conn}
-				err := resource.Retry(readRetryTimeout*2, func() *resource.RetryError {
+				err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 					diskInfo, e := cbsService.DescribeDiskById(ctx, diskId)
 					if e != nil {
 						return retryError(e, InternalError)
@@ -1630,9 +1648,9 @@ func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}
 				})
 				if err != nil {
 					log.Printf("[CRITAL]%s delete cbs failed, reason:%s\n ", logId, err.Error())
-					return err
+					return diag.FromErr(err)
 				}
-				err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+				err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 					e := cbsService.DeleteDiskById(ctx, diskId)
 					if e != nil {
 						return retryError(e, InternalError)
@@ -1641,9 +1659,9 @@ func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}
 				})
 				if err != nil {
 					log.Printf("[CRITAL]%s delete cbs failed, reason:%s\n ", logId, err.Error())
-					return err
+					return diag.FromErr(err)
 				}
-				err = resource.Retry(readRetryTimeout*2, func() *resource.RetryError {
+				err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 					diskInfo, e := cbsService.DescribeDiskById(ctx, diskId)
 					if e != nil {
 						return retryError(e, InternalError)
@@ -1655,9 +1673,9 @@ func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}
 				})
 				if err != nil {
 					log.Printf("[CRITAL]%s read cbs status failed, reason:%s\n ", logId, err.Error())
-					return err
+					return diag.FromErr(err)
 				}
-				err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+				err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 					e := cbsService.DeleteDiskById(ctx, diskId)
 					if e != nil {
 						return retryError(e, InternalError)
@@ -1666,9 +1684,9 @@ func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}
 				})
 				if err != nil {
 					log.Printf("[CRITAL]%s delete cbs failed, reason:%s\n ", logId, err.Error())
-					return err
+					return diag.FromErr(err)
 				}
-				err = resource.Retry(readRetryTimeout*2, func() *resource.RetryError {
+				err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 					diskInfo, e := cbsService.DescribeDiskById(ctx, diskId)
 					if e != nil {
 						return retryError(e, InternalError)
@@ -1680,7 +1698,7 @@ func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}
 				})
 				if err != nil {
 					log.Printf("[CRITAL]%s read cbs status failed, reason:%s\n ", logId, err.Error())
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -1695,7 +1713,7 @@ func switchInstance(cvmService *CvmService, ctx context.Context, d *schema.Resou
 		if err != nil {
 			return err
 		}
-		err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, 2*readRetryTimeout, func() *resource.RetryError {
 			instance, errRet := cvmService.DescribeInstanceById(ctx, instanceId)
 			if errRet != nil {
 				return retryError(errRet, InternalError)
@@ -1711,7 +1729,7 @@ func switchInstance(cvmService *CvmService, ctx context.Context, d *schema.Resou
 	} else {
 		stoppedMode := d.Get("stopped_mode").(string)
 		skipStopApi := false
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 			// when retry polling instance status, stop instance should skipped
 			if !skipStopApi {
 				err := cvmService.StopInstance(ctx, instanceId, stoppedMode)
@@ -1743,7 +1761,7 @@ func switchInstance(cvmService *CvmService, ctx context.Context, d *schema.Resou
 		if err != nil {
 			return err
 		}
-		err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, 2*readRetryTimeout, func() *resource.RetryError {
 			instance, errRet := cvmService.DescribeInstanceById(ctx, instanceId)
 			if errRet != nil {
 				return retryError(errRet, InternalError)
@@ -1760,9 +1778,9 @@ func switchInstance(cvmService *CvmService, ctx context.Context, d *schema.Resou
 	return nil
 }
 
-func waitForOperationFinished(d *schema.ResourceData, meta interface{}, timeout time.Duration, state string, immediately bool) error {
+func waitForOperationFinished(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration, state string, immediately bool) error {
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	cvmService := CvmService{client}
 	instanceId := d.Id()
@@ -1771,7 +1789,7 @@ func waitForOperationFinished(d *schema.ResourceData, meta interface{}, timeout
 		time.Sleep(time.Second * 10)
 	}
 
-	err := resource.Retry(timeout, func() *resource.RetryError {
+	err := retryContext(ctx, timeout, func() *resource.RetryError {
 		instance, errRet := cvmService.DescribeInstanceById(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
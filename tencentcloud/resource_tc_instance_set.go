@@ -74,6 +74,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -84,10 +85,10 @@ import (
 
 func resourceTencentCloudInstanceSet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudInstanceSetCreate,
-		Read:   resourceTencentCloudInstanceSetRead,
-		Update: resourceTencentCloudInstanceSetUpdate,
-		Delete: resourceTencentCloudInstanceSetDelete,
+		CreateContext: resourceTencentCloudInstanceSetCreate,
+		ReadContext:   resourceTencentCloudInstanceSetRead,
+		UpdateContext: resourceTencentCloudInstanceSetUpdate,
+		DeleteContext: resourceTencentCloudInstanceSetDelete,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(600 * time.Second),
 			Read:   schema.DefaultTimeout(600 * time.Second),
@@ -329,87 +330,87 @@ func resourceTencentCloudInstanceSet() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudInstanceSetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudInstanceSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	doneChan := make(chan struct{}, 1)
 	rspChan := make(chan error, 1)
 
 	timeout := d.Timeout(schema.TimeoutCreate)
 
 	go func(d *schema.ResourceData, meta interface{}) {
-		e := doResourceTencentCloudInstanceSetCreate(d, meta)
+		e := doResourceTencentCloudInstanceSetCreate(ctx, d, meta)
 		doneChan <- struct{}{}
 		rspChan <- e
 	}(d, meta)
 
 	select {
 	case <-doneChan:
-		return <-rspChan
+		return diag.FromErr(<-rspChan)
 	case <-time.After(timeout):
-		return fmt.Errorf("Do cvm instance set create action timeout, current timeout :[%.3f]s", timeout.Seconds())
+		return diag.Errorf("Do cvm instance set create action timeout, current timeout :[%.3f]s", timeout.Seconds())
 	}
 }
 
-func resourceTencentCloudInstanceSetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudInstanceSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	doneChan := make(chan struct{}, 1)
 	rspChan := make(chan error, 1)
 
 	timeout := d.Timeout(schema.TimeoutRead)
 
 	go func(d *schema.ResourceData, meta interface{}) {
-		e := doResourceTencentCloudInstanceSetRead(d, meta)
+		e := doResourceTencentCloudInstanceSetRead(ctx, d, meta)
 		doneChan <- struct{}{}
 		rspChan <- e
 	}(d, meta)
 
 	select {
 	case <-doneChan:
-		return <-rspChan
+		return diag.FromErr(<-rspChan)
 	case <-time.After(timeout):
-		return fmt.Errorf("Do cvm instance set read action timeout, current timeout :[%.3f]s", timeout.Seconds())
+		return diag.Errorf("Do cvm instance set read action timeout, current timeout :[%.3f]s", timeout.Seconds())
 	}
 }
 
-func resourceTencentCloudInstanceSetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudInstanceSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	doneChan := make(chan struct{}, 1)
 	rspChan := make(chan error, 1)
 
 	timeout := d.Timeout(schema.TimeoutUpdate)
 
 	go func(d *schema.ResourceData, meta interface{}) {
-		e := doResourceTencentCloudInstanceSetUpdate(d, meta)
+		e := doResourceTencentCloudInstanceSetUpdate(ctx, d, meta)
 		doneChan <- struct{}{}
 		rspChan <- e
 	}(d, meta)
 
 	select {
 	case <-doneChan:
-		return <-rspChan
+		return diag.FromErr(<-rspChan)
 	case <-time.After(timeout):
-		return fmt.Errorf("Do cvm instance set update action timeout, current timeout :[%.3f]s", timeout.Seconds())
+		return diag.Errorf("Do cvm instance set update action timeout, current timeout :[%.3f]s", timeout.Seconds())
 	}
 }
 
-func resourceTencentCloudInstanceSetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudInstanceSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	doneChan := make(chan struct{}, 1)
 	rspChan := make(chan error, 1)
 
 	timeout := d.Timeout(schema.TimeoutDelete)
 
 	go func(d *schema.ResourceData, meta interface{}) {
-		e := doResourceTencentCloudInstanceSetDelete(d, meta)
+		e := doResourceTencentCloudInstanceSetDelete(ctx, d, meta)
 		doneChan <- struct{}{}
 		rspChan <- e
 	}(d, meta)
 
 	select {
 	case <-doneChan:
-		return <-rspChan
+		return diag.FromErr(<-rspChan)
 	case <-time.After(timeout):
-		return fmt.Errorf("Do cvm instance set delete action timeout, current timeout :[%.3f]s", timeout.Seconds())
+		return diag.Errorf("Do cvm instance set delete action timeout, current timeout :[%.3f]s", timeout.Seconds())
 	}
 }
 
-func doResourceTencentCloudInstanceSetCreate(d *schema.ResourceData, meta interface{}) error {
+func doResourceTencentCloudInstanceSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_instance_set.create")()
 	logId := getLogId(contextNil)
 
@@ -541,9 +542,11 @@ func doResourceTencentCloudInstanceSetCreate(d *schema.ResourceData, meta interf
 
 	instanceIds := make([]*string, 0)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check("create")
-		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RunInstances(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, "create"); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RunInstancesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -574,12 +577,12 @@ func doResourceTencentCloudInstanceSetCreate(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func doResourceTencentCloudInstanceSetRead(d *schema.ResourceData, meta interface{}) error {
+func doResourceTencentCloudInstanceSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_instance_set.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var instanceSetIds []*string
 	if v, ok := d.GetOk("instance_ids"); ok {
@@ -645,11 +648,11 @@ func doResourceTencentCloudInstanceSetRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func doResourceTencentCloudInstanceSetUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+func doResourceTencentCloudInstanceSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (err error) {
 	defer logElapsed("resource.tencentcloud_instance_set.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	cvmService := CvmService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -668,7 +671,7 @@ func doResourceTencentCloudInstanceSetUpdate(d *schema.ResourceData, meta interf
 		// need delete instance
 		if len(needExclude) > 0 {
 			instanceSetIds := helper.StrListToStr(helper.InterfacesStringsPoint(needExclude))
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 				errRet := cvmService.DeleteInstanceSetByIds(ctx, instanceSetIds)
 				if errRet != nil {
 					log.Printf("[CRITAL][first delete]%s api[%s] fail, reason[%s]\n",
@@ -806,9 +809,11 @@ func doResourceTencentCloudInstanceSetUpdate(d *schema.ResourceData, meta interf
 				request.UserData = &userData
 			}
 
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				ratelimit.Check("create")
-				response, err := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RunInstances(request)
+			err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+				if err := ratelimit.CheckContext(ctx, "create"); err != nil {
+					return resource.NonRetryableError(err)
+				}
+				response, err := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RunInstancesWithContext(ctx, request)
 				if err != nil {
 					log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 						logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -867,11 +872,11 @@ func doResourceTencentCloudInstanceSetUpdate(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func doResourceTencentCloudInstanceSetDelete(d *schema.ResourceData, meta interface{}) error {
+func doResourceTencentCloudInstanceSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_instance_set.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	//instanceSetIds := d.Id()
 
@@ -885,7 +890,7 @@ func doResourceTencentCloudInstanceSetDelete(d *schema.ResourceData, meta interf
 	}
 
 	// delete
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		errRet := cvmService.DeleteInstanceSetByIds(ctx, helper.StrListToStr(instanceSetIds))
 		if errRet != nil {
 			log.Printf("[CRITAL][first delete]%s api[%s] fail, reason[%s]\n",
@@ -17,10 +17,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -29,10 +29,14 @@ import (
 
 func resourceTencentCloudIpv6AddressBandwidth() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudIpv6AddressBandwidthCreate,
-		Read:   resourceTencentCloudIpv6AddressBandwidthRead,
-		Update: resourceTencentCloudIpv6AddressBandwidthUpdate,
-		Delete: resourceTencentCloudIpv6AddressBandwidthDelete,
+		CreateContext: resourceTencentCloudIpv6AddressBandwidthCreate,
+		ReadContext:   resourceTencentCloudIpv6AddressBandwidthRead,
+		UpdateContext: resourceTencentCloudIpv6AddressBandwidthUpdate,
+		DeleteContext: resourceTencentCloudIpv6AddressBandwidthDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		// it can support import because
 		//Importer: &schema.ResourceImporter{
 		//	State: schema.ImportStatePassthrough,
@@ -67,7 +71,7 @@ func resourceTencentCloudIpv6AddressBandwidth() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudIpv6AddressBandwidthCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudIpv6AddressBandwidthCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ipv6_address_bandwidth.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -95,8 +99,8 @@ func resourceTencentCloudIpv6AddressBandwidthCreate(d *schema.ResourceData, meta
 		request.BandwidthPackageId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AllocateIp6AddressesBandwidth(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AllocateIp6AddressesBandwidthWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -107,7 +111,7 @@ func resourceTencentCloudIpv6AddressBandwidthCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc ipv6Address failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	taskId := *response.Response.TaskId
@@ -118,22 +122,22 @@ func resourceTencentCloudIpv6AddressBandwidthCreate(d *schema.ResourceData, meta
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 1*readRetryTimeout, time.Second, service.VpcIpv6AddressStateRefreshFunc(taskId, []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, d.Timeout(schema.TimeoutCreate), time.Second, service.VpcIpv6AddressStateRefreshFunc(taskId, []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudIpv6AddressBandwidthRead(d, meta)
+	return resourceTencentCloudIpv6AddressBandwidthRead(ctx, d, meta)
 }
 
-func resourceTencentCloudIpv6AddressBandwidthRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudIpv6AddressBandwidthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ipv6_address_bandwidth.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -141,7 +145,7 @@ func resourceTencentCloudIpv6AddressBandwidthRead(d *schema.ResourceData, meta i
 
 	ipv6Address, err := service.DescribeVpcIpv6AddressById(ctx, ipv6AddressId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ipv6Address == nil {
@@ -167,7 +171,7 @@ func resourceTencentCloudIpv6AddressBandwidthRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudIpv6AddressBandwidthUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudIpv6AddressBandwidthUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ipv6_address_bandwidth.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -183,7 +187,7 @@ func resourceTencentCloudIpv6AddressBandwidthUpdate(d *schema.ResourceData, meta
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -199,8 +203,8 @@ func resourceTencentCloudIpv6AddressBandwidthUpdate(d *schema.ResourceData, meta
 	if needChange {
 		var taskId string
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyIp6AddressesBandwidth(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyIp6AddressesBandwidthWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -211,34 +215,34 @@ func resourceTencentCloudIpv6AddressBandwidthUpdate(d *schema.ResourceData, meta
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update vpc ipv6Address failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-		conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 1*readRetryTimeout, time.Second, service.VpcIpv6AddressStateRefreshFunc(taskId, []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.VpcIpv6AddressStateRefreshFunc(taskId, []string{}))
 
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 
 	}
 
-	return resourceTencentCloudIpv6AddressBandwidthRead(d, meta)
+	return resourceTencentCloudIpv6AddressBandwidthRead(ctx, d, meta)
 }
 
-func resourceTencentCloudIpv6AddressBandwidthDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudIpv6AddressBandwidthDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ipv6_address_bandwidth.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	ipv6AddressId := d.Id()
 
 	if err := service.DeleteVpcIpv6AddressById(ctx, ipv6AddressId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
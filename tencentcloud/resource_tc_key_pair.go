@@ -29,9 +29,11 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -40,12 +42,15 @@ import (
 
 func resourceTencentCloudKeyPair() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudKeyPairCreate,
-		Read:   resourceTencentCloudKeyPairRead,
-		Update: resourceTencentCloudKeyPairUpdate,
-		Delete: resourceTencentCloudKeyPairDelete,
+		CreateContext: resourceTencentCloudKeyPairCreate,
+		ReadContext:   resourceTencentCloudKeyPairRead,
+		UpdateContext: resourceTencentCloudKeyPairUpdate,
+		DeleteContext: resourceTencentCloudKeyPairDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -98,8 +103,8 @@ func cvmCreateKeyPair(ctx context.Context, d *schema.ResourceData, meta interfac
 	request.KeyName = helper.String(d.Get("key_name").(string))
 	request.ProjectId = helper.IntInt64(d.Get("project_id").(int))
 
-	innerErr := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().CreateKeyPair(request)
+	innerErr := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().CreateKeyPairWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -130,8 +135,8 @@ func cvmCreateKeyPairByImportPublicKey(ctx context.Context, d *schema.ResourceDa
 	request.ProjectId = helper.IntInt64(d.Get("project_id").(int))
 	request.PublicKey = helper.String(d.Get("public_key").(string))
 
-	innerErr := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ImportKeyPair(request)
+	innerErr := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().ImportKeyPairWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -154,11 +159,11 @@ func cvmCreateKeyPairByImportPublicKey(ctx context.Context, d *schema.ResourceDa
 	return
 }
 
-func resourceTencentCloudKeyPairCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudKeyPairCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_key_pair.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var (
 		keyId string
 		err   error
@@ -170,7 +175,7 @@ func resourceTencentCloudKeyPairCreate(d *schema.ResourceData, meta interface{})
 		keyId, err = cvmCreateKeyPair(ctx, d, meta)
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(keyId)
 
@@ -179,19 +184,19 @@ func resourceTencentCloudKeyPairCreate(d *schema.ResourceData, meta interface{})
 		tagService := &TagService{client: tcClient}
 		resourceName := BuildTagResourceName("cvm", "keypair", tcClient.Region, keyId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudKeyPairRead(d, meta)
+	return resourceTencentCloudKeyPairRead(ctx, d, meta)
 }
 
-func resourceTencentCloudKeyPairRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudKeyPairRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_key_pair.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	keyId := d.Id()
 	cvmService := CvmService{
@@ -199,7 +204,7 @@ func resourceTencentCloudKeyPairRead(d *schema.ResourceData, meta interface{}) e
 	}
 	var keyPair *cvm.KeyPair
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		keyPair, errRet = cvmService.DescribeKeyPairById(ctx, keyId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -207,7 +212,7 @@ func resourceTencentCloudKeyPairRead(d *schema.ResourceData, meta interface{}) e
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if keyPair == nil {
 		d.SetId("")
@@ -230,18 +235,18 @@ func resourceTencentCloudKeyPairRead(d *schema.ResourceData, meta interface{}) e
 
 	tags, err := tagService.DescribeResourceTags(ctx, "cvm", "keypair", client.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudKeyPairUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudKeyPairUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_key_pair.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	keyId := d.Id()
 	cvmService := CvmService{
@@ -252,7 +257,7 @@ func resourceTencentCloudKeyPairUpdate(d *schema.ResourceData, meta interface{})
 		keyName := d.Get("key_name").(string)
 		err := cvmService.ModifyKeyPairName(ctx, keyId, keyName)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -266,18 +271,18 @@ func resourceTencentCloudKeyPairUpdate(d *schema.ResourceData, meta interface{})
 		resourceName := BuildTagResourceName("cvm", "keypair", region, keyId)
 		err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudKeyPairRead(d, meta)
+	return resourceTencentCloudKeyPairRead(ctx, d, meta)
 }
 
-func resourceTencentCloudKeyPairDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudKeyPairDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_key_pair.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	keyId := d.Id()
 	cvmService := CvmService{
@@ -286,7 +291,7 @@ func resourceTencentCloudKeyPairDelete(d *schema.ResourceData, meta interface{})
 
 	var keyPair *cvm.KeyPair
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		keyPair, errRet = cvmService.DescribeKeyPairById(ctx, keyId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -294,7 +299,7 @@ func resourceTencentCloudKeyPairDelete(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if keyPair == nil {
 		d.SetId("")
@@ -302,7 +307,7 @@ func resourceTencentCloudKeyPairDelete(d *schema.ResourceData, meta interface{})
 	}
 
 	if len(keyPair.AssociatedInstanceIds) > 0 {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			errRet := cvmService.UnbindKeyPair(ctx, []*string{&keyId}, keyPair.AssociatedInstanceIds)
 			if errRet != nil {
 				if sdkErr, ok := errRet.(*errors.TencentCloudSDKError); ok {
@@ -315,11 +320,11 @@ func resourceTencentCloudKeyPairDelete(d *schema.ResourceData, meta interface{})
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		errRet := cvmService.DeleteKeyPair(ctx, keyId)
 		if errRet != nil {
 			return retryError(errRet, KYE_PAIR_INVALID_ERROR, KEY_PAIR_NOT_SUPPORT_ERROR)
@@ -327,7 +332,7 @@ func resourceTencentCloudKeyPairDelete(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -62,7 +62,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	kms "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/kms/v20190118"
@@ -96,23 +98,28 @@ func resourceTencentCloudKmsExternalKey() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Create: resourceTencentCloudKmsExternalKeyCreate,
-		Read:   resourceTencentCloudKmsExternalKeyRead,
-		Update: resourceTencentCloudKmsExternalKeyUpdate,
-		Delete: resourceTencentCloudKmsExternalKeyDelete,
+		CreateContext: resourceTencentCloudKmsExternalKeyCreate,
+		ReadContext:   resourceTencentCloudKmsExternalKeyRead,
+		UpdateContext: resourceTencentCloudKmsExternalKeyUpdate,
+		DeleteContext: resourceTencentCloudKmsExternalKeyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: specialInfo,
 	}
 }
 
-func resourceTencentCloudKmsExternalKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudKmsExternalKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kms_external_key.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	kmsService := KmsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -127,7 +134,7 @@ func resourceTencentCloudKmsExternalKeyCreate(d *schema.ResourceData, meta inter
 
 	var keyId string
 	var outErr, inErr error
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		keyId, inErr = kmsService.CreateKey(ctx, keyType, alias, description, keyUsage)
 		if inErr != nil {
 			return retryError(inErr)
@@ -136,7 +143,7 @@ func resourceTencentCloudKmsExternalKeyCreate(d *schema.ResourceData, meta inter
 	})
 	if outErr != nil {
 		log.Printf("[CRITAL]%s create KMS key failed, reason:%+v", logId, outErr)
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	d.SetId(keyId)
 
@@ -148,7 +155,7 @@ func resourceTencentCloudKmsExternalKeyCreate(d *schema.ResourceData, meta inter
 		param["key_material_base64"] = v.(string)
 		param["valid_to"] = d.Get("valid_to").(int)
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			e := kmsService.ImportKeyMaterial(ctx, param)
 			if e != nil {
 				return retryError(e)
@@ -157,11 +164,11 @@ func resourceTencentCloudKmsExternalKeyCreate(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s Create KMS external key failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		if isEnabled := d.Get("is_enabled").(bool); !isEnabled {
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 				e := kmsService.DisableKey(ctx, d.Id())
 				if e != nil {
 					return retryError(e)
@@ -170,12 +177,12 @@ func resourceTencentCloudKmsExternalKeyCreate(d *schema.ResourceData, meta inter
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s modify key state failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 		if isArchived := d.Get("is_archived").(bool); isArchived {
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 				e := kmsService.ArchiveKey(ctx, d.Id())
 				if e != nil {
 					return retryError(e)
@@ -184,7 +191,7 @@ func resourceTencentCloudKmsExternalKeyCreate(d *schema.ResourceData, meta inter
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s modify key state failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -194,30 +201,30 @@ func resourceTencentCloudKmsExternalKeyCreate(d *schema.ResourceData, meta inter
 		tagService := &TagService{client: tcClient}
 		keyMetaData, err := kmsService.DescribeKeyById(ctx, keyId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		resourceName := BuildTagResourceName("kms", "key", tcClient.Region, *keyMetaData.ResourceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudKmsExternalKeyRead(d, meta)
+	return resourceTencentCloudKmsExternalKeyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudKmsExternalKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudKmsExternalKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kms_external_key.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	keyId := d.Id()
 	kmsService := &KmsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var key *kms.KeyMetadata
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := kmsService.DescribeKeyById(ctx, keyId)
 		if e != nil {
 			return retryError(e)
@@ -227,7 +234,7 @@ func resourceTencentCloudKmsExternalKeyRead(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read KMS external key failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if key == nil {
@@ -245,17 +252,17 @@ func resourceTencentCloudKmsExternalKeyRead(d *schema.ResourceData, meta interfa
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "kms", "key", tcClient.Region, *key.ResourceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 	return nil
 }
 
-func resourceTencentCloudKmsExternalKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudKmsExternalKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kms_external_key.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	keyId := d.Id()
 	kmsService := KmsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -264,7 +271,7 @@ func resourceTencentCloudKmsExternalKeyUpdate(d *schema.ResourceData, meta inter
 
 	if d.HasChange("description") {
 		description := d.Get("description").(string)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			e := kmsService.UpdateKeyDescription(ctx, keyId, description)
 			if e != nil {
 				return retryError(e)
@@ -273,14 +280,14 @@ func resourceTencentCloudKmsExternalKeyUpdate(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s modify KMS external key description failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	if d.HasChange("alias") {
 		alias := d.Get("alias").(string)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			e := kmsService.UpdateKeyAlias(ctx, keyId, alias)
 			if e != nil {
 				return retryError(e)
@@ -289,7 +296,7 @@ func resourceTencentCloudKmsExternalKeyUpdate(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s modify KMS external key alias failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -298,12 +305,12 @@ func resourceTencentCloudKmsExternalKeyUpdate(d *schema.ResourceData, meta inter
 		err := updateKeyMaterial(ctx, kmsService, d)
 		if err != nil {
 			log.Printf("[CRITAL]%s import KMS external key material key failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	var key *kms.KeyMetadata
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, e := kmsService.DescribeKeyById(ctx, keyId)
 		if e != nil {
 			return retryError(e)
@@ -313,7 +320,7 @@ func resourceTencentCloudKmsExternalKeyUpdate(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read KMS external key failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *key.KeyState == KMS_KEY_STATE_ENABLED || *key.KeyState == KMS_KEY_STATE_DISABLED || *key.KeyState == KMS_KEY_STATE_ARCHIVED {
@@ -321,7 +328,7 @@ func resourceTencentCloudKmsExternalKeyUpdate(d *schema.ResourceData, meta inter
 			err := updateIsArchived(ctx, kmsService, keyId, isArchived.(bool))
 			if err != nil {
 				log.Printf("[CRITAL]%s modify key state failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 
 		} else {
@@ -329,7 +336,7 @@ func resourceTencentCloudKmsExternalKeyUpdate(d *schema.ResourceData, meta inter
 			err := updateIsEnabled(ctx, kmsService, keyId, isEnabled)
 			if err != nil {
 				log.Printf("[CRITAL]%s modify key state failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 
 		}
@@ -343,25 +350,25 @@ func resourceTencentCloudKmsExternalKeyUpdate(d *schema.ResourceData, meta inter
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 		keyMetaData, err := kmsService.DescribeKeyById(ctx, keyId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		resourceName := BuildTagResourceName("kms", "key", tcClient.Region, *keyMetaData.ResourceId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudKmsExternalKeyRead(d, meta)
+	return resourceTencentCloudKmsExternalKeyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudKmsExternalKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudKmsExternalKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kms_external_key.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	kmsService := KmsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -370,7 +377,7 @@ func resourceTencentCloudKmsExternalKeyDelete(d *schema.ResourceData, meta inter
 	pendingDeleteWindowInDays := d.Get("pending_delete_window_in_days").(int)
 	isEnabled := d.Get("is_enabled").(bool)
 	if isEnabled {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			e := kmsService.DisableKey(ctx, keyId)
 			if e != nil {
 				return retryError(e)
@@ -379,10 +386,10 @@ func resourceTencentCloudKmsExternalKeyDelete(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s modify KMS key state failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := kmsService.DeleteKey(ctx, keyId, uint64(pendingDeleteWindowInDays))
 		if e != nil {
 			return retryError(e)
@@ -391,10 +398,10 @@ func resourceTencentCloudKmsExternalKeyDelete(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete KMS key failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	return diag.FromErr(retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		key, e := kmsService.DescribeKeyById(ctx, keyId)
 		if e != nil {
 			return retryError(e)
@@ -403,7 +410,7 @@ func resourceTencentCloudKmsExternalKeyDelete(d *schema.ResourceData, meta inter
 			return nil
 		}
 		return resource.RetryableError(fmt.Errorf("delete fail"))
-	})
+	}))
 }
 
 func updateKeyMaterial(ctx context.Context, kmsService KmsService, d *schema.ResourceData) error {
@@ -416,7 +423,7 @@ func updateKeyMaterial(ctx context.Context, kmsService KmsService, d *schema.Res
 
 	var err error
 	if d.HasChange("key_material_base64") && param["key_material_base64"] == "" {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 			e := kmsService.DeleteImportKeyMaterial(ctx, d.Id())
 			if e != nil {
 				return retryError(e)
@@ -424,7 +431,7 @@ func updateKeyMaterial(ctx context.Context, kmsService KmsService, d *schema.Res
 			return nil
 		})
 	} else {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 			e := kmsService.ImportKeyMaterial(ctx, param)
 			if e != nil {
 				return retryError(e)
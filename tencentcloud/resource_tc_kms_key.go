@@ -58,9 +58,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	kms "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/kms/v20190118"
@@ -133,26 +135,31 @@ func resourceTencentCloudKmsKey() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Create: resourceTencentCloudKmsKeyCreate,
-		Read:   resourceTencentCloudKmsKeyRead,
-		Update: resourceTencentCloudKmsKeyUpdate,
-		Delete: resourceTencentCloudKmsKeyDelete,
+		CreateContext: resourceTencentCloudKmsKeyCreate,
+		ReadContext:   resourceTencentCloudKmsKeyRead,
+		UpdateContext: resourceTencentCloudKmsKeyUpdate,
+		DeleteContext: resourceTencentCloudKmsKeyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: specialInfo,
 	}
 }
 
-func resourceTencentCloudKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudKmsKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kms_key.create")()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		kmsService = KmsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	keyType := KMS_ORIGIN_TYPE[KMS_ORIGIN_TENCENT_KMS]
 	alias := d.Get("alias").(string)
@@ -169,7 +176,7 @@ func resourceTencentCloudKmsKeyCreate(d *schema.ResourceData, meta interface{})
 
 	var keyId string
 	var outErr, inErr error
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		keyId, inErr = kmsService.CreateKey(ctx, keyType, alias, description, keyUsage)
 		if inErr != nil {
 			return retryError(inErr)
@@ -180,11 +187,11 @@ func resourceTencentCloudKmsKeyCreate(d *schema.ResourceData, meta interface{})
 
 	if outErr != nil {
 		log.Printf("[CRITAL]%s create KMS key failed, reason:%+v", logId, outErr)
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if isEnabled := d.Get("is_enabled").(bool); !isEnabled {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			e := kmsService.DisableKey(ctx, d.Id())
 			if e != nil {
 				return retryError(e)
@@ -195,13 +202,13 @@ func resourceTencentCloudKmsKeyCreate(d *schema.ResourceData, meta interface{})
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify key state failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if keyUsage == KMS_KEY_USAGE_ENCRYPT_DECRYPT {
 		if keyRotationEnabled := d.Get("key_rotation_enabled").(bool); keyRotationEnabled {
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 				e := kmsService.EnableKeyRotation(ctx, d.Id())
 				if e != nil {
 					return retryError(e)
@@ -212,13 +219,13 @@ func resourceTencentCloudKmsKeyCreate(d *schema.ResourceData, meta interface{})
 
 			if err != nil {
 				log.Printf("[CRITAL]%s modify KMS key rotation status failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
 	if isArchived := d.Get("is_archived").(bool); isArchived {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			e := kmsService.ArchiveKey(ctx, d.Id())
 			if e != nil {
 				return retryError(e)
@@ -229,7 +236,7 @@ func resourceTencentCloudKmsKeyCreate(d *schema.ResourceData, meta interface{})
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify key state failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -238,33 +245,33 @@ func resourceTencentCloudKmsKeyCreate(d *schema.ResourceData, meta interface{})
 		tagService := &TagService{client: tcClient}
 		keyMetaData, err := kmsService.DescribeKeyById(ctx, keyId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		resourceName := BuildTagResourceName("kms", "key", tcClient.Region, *keyMetaData.ResourceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(keyId)
-	return resourceTencentCloudKmsKeyRead(d, meta)
+	return resourceTencentCloudKmsKeyRead(ctx, d, meta)
 
 }
 
-func resourceTencentCloudKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudKmsKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kms_key.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		kmsService = &KmsService{client: meta.(*TencentCloudClient).apiV3Conn}
 		keyId      = d.Id()
 		key        *kms.KeyMetadata
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := kmsService.DescribeKeyById(ctx, keyId)
 		if e != nil {
 			return retryError(e)
@@ -276,7 +283,7 @@ func resourceTencentCloudKmsKeyRead(d *schema.ResourceData, meta interface{}) er
 
 	if err != nil {
 		log.Printf("[CRITAL]%s read KMS key failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if key == nil {
@@ -295,27 +302,27 @@ func resourceTencentCloudKmsKeyRead(d *schema.ResourceData, meta interface{}) er
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "kms", "key", tcClient.Region, *key.ResourceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("tags", tags)
 	return nil
 }
 
-func resourceTencentCloudKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudKmsKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kms_key.update")()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		kmsService = KmsService{client: meta.(*TencentCloudClient).apiV3Conn}
 		keyId      = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	d.Partial(true)
 	if d.HasChange("description") {
 		description := d.Get("description").(string)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			e := kmsService.UpdateKeyDescription(ctx, keyId, description)
 			if e != nil {
 				return retryError(e)
@@ -326,14 +333,14 @@ func resourceTencentCloudKmsKeyUpdate(d *schema.ResourceData, meta interface{})
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify KMS key description failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	if d.HasChange("alias") {
 		alias := d.Get("alias").(string)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			e := kmsService.UpdateKeyAlias(ctx, keyId, alias)
 			if e != nil {
 				return retryError(e)
@@ -344,7 +351,7 @@ func resourceTencentCloudKmsKeyUpdate(d *schema.ResourceData, meta interface{})
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify KMS key alias failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -354,7 +361,7 @@ func resourceTencentCloudKmsKeyUpdate(d *schema.ResourceData, meta interface{})
 			err := updateIsArchived(ctx, kmsService, keyId, isArchived.(bool))
 			if err != nil {
 				log.Printf("[CRITAL]%s modify key state failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 
 		} else {
@@ -362,7 +369,7 @@ func resourceTencentCloudKmsKeyUpdate(d *schema.ResourceData, meta interface{})
 			err := updateIsEnabled(ctx, kmsService, keyId, isEnabled)
 			if err != nil {
 				log.Printf("[CRITAL]%s modify key state failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 
 		}
@@ -374,7 +381,7 @@ func resourceTencentCloudKmsKeyUpdate(d *schema.ResourceData, meta interface{})
 			err := updateKeyRotationStatus(ctx, kmsService, keyId, keyRotationEnabled)
 			if err != nil {
 				log.Printf("[CRITAL]%s modify KMS key rotation status failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 
 		}
@@ -388,33 +395,33 @@ func resourceTencentCloudKmsKeyUpdate(d *schema.ResourceData, meta interface{})
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 		keyMetaData, err := kmsService.DescribeKeyById(ctx, keyId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		resourceName := BuildTagResourceName("kms", "key", tcClient.Region, *keyMetaData.ResourceId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudKmsKeyRead(d, meta)
+	return resourceTencentCloudKmsKeyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudKmsKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kms_key.delete")()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		kmsService = KmsService{client: meta.(*TencentCloudClient).apiV3Conn}
 		keyId      = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	pendingDeleteWindowInDays := d.Get("pending_delete_window_in_days").(int)
 	isEnabled := d.Get("is_enabled").(bool)
 	if isEnabled {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			e := kmsService.DisableKey(ctx, keyId)
 			if e != nil {
 				ee, ok := e.(*sdkErrors.TencentCloudSDKError)
@@ -430,11 +437,11 @@ func resourceTencentCloudKmsKeyDelete(d *schema.ResourceData, meta interface{})
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify KMS key state failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := kmsService.DeleteKey(ctx, keyId, uint64(pendingDeleteWindowInDays))
 		if e != nil {
 			ee, ok := e.(*sdkErrors.TencentCloudSDKError)
@@ -450,10 +457,10 @@ func resourceTencentCloudKmsKeyDelete(d *schema.ResourceData, meta interface{})
 
 	if err != nil {
 		log.Printf("[CRITAL]%s delete KMS key failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	return diag.FromErr(retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		key, e := kmsService.DescribeKeyById(ctx, keyId)
 		if e != nil {
 			return retryError(e)
@@ -464,13 +471,13 @@ func resourceTencentCloudKmsKeyDelete(d *schema.ResourceData, meta interface{})
 		}
 
 		return resource.RetryableError(fmt.Errorf("delete fail"))
-	})
+	}))
 }
 
 func updateKeyRotationStatus(ctx context.Context, kmsService KmsService, keyId string, keyRotationEnabled bool) error {
 	var err error
 	if keyRotationEnabled {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 			e := kmsService.EnableKeyRotation(ctx, keyId)
 			if e != nil {
 				return retryError(e)
@@ -478,7 +485,7 @@ func updateKeyRotationStatus(ctx context.Context, kmsService KmsService, keyId s
 			return nil
 		})
 	} else {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 			e := kmsService.DisableKeyRotation(ctx, keyId)
 			if e != nil {
 				return retryError(e)
@@ -492,7 +499,7 @@ func updateKeyRotationStatus(ctx context.Context, kmsService KmsService, keyId s
 func updateIsEnabled(ctx context.Context, kmsService KmsService, keyId string, isEnabled bool) error {
 	var err error
 	if isEnabled {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 			e := kmsService.EnableKey(ctx, keyId)
 			if e != nil {
 				return retryError(e)
@@ -501,7 +508,7 @@ func updateIsEnabled(ctx context.Context, kmsService KmsService, keyId string, i
 		})
 
 	} else {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 			e := kmsService.DisableKey(ctx, keyId)
 			if e != nil {
 				return retryError(e)
@@ -515,7 +522,7 @@ func updateIsEnabled(ctx context.Context, kmsService KmsService, keyId string, i
 func updateIsArchived(ctx context.Context, kmsService KmsService, keyId string, isArchived bool) error {
 	var err error
 	if isArchived {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 			e := kmsService.ArchiveKey(ctx, keyId)
 			if e != nil {
 				return retryError(e)
@@ -523,7 +530,7 @@ func updateIsArchived(ctx context.Context, kmsService KmsService, keyId string,
 			return nil
 		})
 	} else {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 			e := kmsService.CancelKeyArchive(ctx, keyId)
 			if e != nil {
 				return retryError(e)
@@ -135,6 +135,7 @@ import (
 
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
@@ -186,22 +187,22 @@ func resourceTencentCloudTkeAddonAttachment() *schema.Resource {
 				Description: "Addon current status.",
 			},
 		},
-		Create: resourceTencentCloudTkeAddonAttachmentCreate,
-		Update: resourceTencentCloudTkeAddonAttachmentUpdate,
-		Read:   resourceTencentCloudTkeAddonAttachmentRead,
-		Delete: resourceTencentCloudTkeAddonAttachmentDelete,
+		CreateContext: resourceTencentCloudTkeAddonAttachmentCreate,
+		UpdateContext: resourceTencentCloudTkeAddonAttachmentUpdate,
+		ReadContext:   resourceTencentCloudTkeAddonAttachmentRead,
+		DeleteContext: resourceTencentCloudTkeAddonAttachmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 
-func resourceTencentCloudTkeAddonAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeAddonAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.resource_tc_kubernetes_addon_attachment.create")()
 	logId := getLogId(contextNil)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := TkeService{client: client}
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		clusterId = d.Get("cluster_id").(string)
@@ -215,7 +216,7 @@ func resourceTencentCloudTkeAddonAttachmentCreate(d *schema.ResourceData, meta i
 		request := tke.NewGetTkeAppChartListRequest()
 		chartList, err := service.GetTkeAppChartList(ctx, request)
 		if err != nil {
-			return fmt.Errorf("error while fetching latest chart versions, %s", err.Error())
+			return diag.Errorf("error while fetching latest chart versions, %s", err.Error())
 		}
 		for i := range chartList {
 			chart := chartList[i]
@@ -231,17 +232,17 @@ func resourceTencentCloudTkeAddonAttachmentCreate(d *schema.ResourceData, meta i
 		v := helper.InterfacesStringsPoint(values)
 		reqBody, reqErr = service.GetAddonReqBody(addonName, version, v)
 		if reqErr != nil {
-			return reqErr
+			return diag.FromErr(reqErr)
 		}
 	}
 
 	err := service.CreateExtensionAddon(ctx, clusterId, reqBody)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(clusterId + FILED_SP + addonName)
+	d.SetId(helper.IdFormat(clusterId, addonName))
 
 	resData := &AddonResponseData{}
 	reason := "unknown error"
@@ -252,32 +253,32 @@ func resourceTencentCloudTkeAddonAttachmentCreate(d *schema.ResourceData, meta i
 	}
 
 	if !has {
-		return fmt.Errorf("addon %s not exists", addonName)
+		return diag.Errorf("addon %s not exists", addonName)
 	}
 
 	if phase == "ChartFetchFailed" || phase == "Failed" || phase == "RollbackFailed" || phase == "SyncFailed" {
 		msg := fmt.Sprintf("Unexpected chart phase `%s`, reason: %s", phase, reason)
-		if err := resourceTencentCloudTkeAddonAttachmentDelete(d, meta); err != nil {
-			return err
+		if diags := resourceTencentCloudTkeAddonAttachmentDelete(ctx, d, meta); diags.HasError() {
+			return diags
 		}
 		d.SetId("")
-		return fmt.Errorf(msg)
+		return diag.FromErr(fmt.Errorf(msg))
 	}
 
-	return resourceTencentCloudTkeAddonAttachmentRead(d, meta)
+	return resourceTencentCloudTkeAddonAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTkeAddonAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeAddonAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.resource_tc_kubernetes_addon_attachment.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	id := d.Id()
 	has := false
-	split := strings.Split(id, FILED_SP)
+	split := helper.IdParse(id)
 	if len(split) < 2 {
-		return fmt.Errorf("id expected format: cluster_id#addon_name but no addon_name provided")
+		return diag.Errorf("id expected format: cluster_id#addon_name but no addon_name provided")
 	}
 	clusterId := split[0]
 	addonName := split[1]
@@ -292,14 +293,14 @@ func resourceTencentCloudTkeAddonAttachmentRead(d *schema.ResourceData, meta int
 
 	if err != nil || !has {
 		d.SetId("")
-		return err
+		return diag.FromErr(err)
 	}
 
 	response, _, err = service.DescribeExtensionAddon(ctx, clusterId, addonName)
 
 	if err != nil {
 		d.SetId("")
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("response_body", response)
@@ -323,7 +324,7 @@ func resourceTencentCloudTkeAddonAttachmentRead(d *schema.ResourceData, meta int
 		strMap := helper.CovertInterfaceMapToStrPtr(statuses)
 		err := d.Set("status", strMap)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -332,15 +333,15 @@ func resourceTencentCloudTkeAddonAttachmentRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudTkeAddonAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeAddonAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.resource_tc_kubernetes_addon_attachment.update")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var (
 		id        = d.Id()
-		split     = strings.Split(id, FILED_SP)
+		split     = helper.IdParse(id)
 		clusterId = split[0]
 		addonName = split[1]
 		version   = d.Get("version").(string)
@@ -354,41 +355,41 @@ func resourceTencentCloudTkeAddonAttachmentUpdate(d *schema.ResourceData, meta i
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = service.UpdateExtensionAddon(ctx, clusterId, addonName, reqBody)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTkeAddonAttachmentRead(d, meta)
+	return resourceTencentCloudTkeAddonAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTkeAddonAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeAddonAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.resource_tc_kubernetes_addon_attachment.delete")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var (
 		id        = d.Id()
-		split     = strings.Split(id, FILED_SP)
+		split     = helper.IdParse(id)
 		clusterId = split[0]
 		addonName = split[1]
 		has       bool
 	)
 
 	if err := service.DeleteExtensionAddon(ctx, clusterId, addonName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// check if addon terminating or still exists
 	_, has, _ = service.PollingAddonsPhase(ctx, clusterId, addonName, nil)
 
 	if has {
-		return fmt.Errorf("addon %s still exists", addonName)
+		return diag.Errorf("addon %s still exists", addonName)
 	}
 
 	return nil
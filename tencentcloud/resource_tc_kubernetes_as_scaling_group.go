@@ -47,6 +47,14 @@ resource "tencentcloud_kubernetes_node_pool" "mynodepool" {
   }
 
 }
+
+Import
+
+Kubernetes as scaling group can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_kubernetes_as_scaling_group.example cls-mpuy5wvj:asg-n32ymck2
+```
 */
 package tencentcloud
 
@@ -54,7 +62,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -66,10 +76,18 @@ import (
 func resourceTencentCloudKubernetesAsScalingGroup() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource was offline and no longer supported.",
-		Create:             resourceKubernetesAsScalingGroupCreate,
-		Read:               resourceKubernetesAsScalingGroupRead,
-		Delete:             resourceKubernetesAsScalingGroupDelete,
-		Update:             resourceKubernetesAsScalingGroupUpdate,
+		CreateContext:      resourceKubernetesAsScalingGroupCreate,
+		ReadContext:        resourceKubernetesAsScalingGroupRead,
+		DeleteContext:      resourceKubernetesAsScalingGroupDelete,
+		UpdateContext:      resourceKubernetesAsScalingGroupUpdate,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -656,24 +674,24 @@ func kubernetesAsScalingConfigParaSerial(dMap map[string]interface{}, meta inter
 	return result, errRet
 }
 
-func resourceKubernetesAsScalingGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceKubernetesAsScalingGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_as_scaling_group.read")()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 		items   = strings.Split(d.Id(), ":")
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if len(items) != 2 {
-		return fmt.Errorf("resource_tc_kubernetes_as_scaling_group id  is broken")
+		return diag.Errorf("resource_tc_kubernetes_as_scaling_group id  is broken")
 	}
 	clusterId := items[0]
 	asGroupId := items[1]
 
 	_, has, err := service.DescribeCluster(ctx, clusterId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			_, has, err = service.DescribeCluster(ctx, clusterId)
 			if err != nil {
 				return retryError(err)
@@ -698,7 +716,7 @@ func resourceKubernetesAsScalingGroupRead(d *schema.ResourceData, meta interface
 		number int
 	)
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		_, number, err = asService.DescribeAutoScalingGroupById(ctx, asGroupId)
 		if err != nil {
 			return retryError(err)
@@ -709,14 +727,14 @@ func resourceKubernetesAsScalingGroupRead(d *schema.ResourceData, meta interface
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if number == 0 {
 		return nil
 	}
 
 	var clusterAsGroupSet *tke.ClusterAsGroup
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		clusterAsGroupSet, err = service.DescribeClusterAsGroupsByGroupId(ctx, clusterId, asGroupId)
 		if err != nil {
 			return retryError(err)
@@ -737,7 +755,7 @@ func resourceKubernetesAsScalingGroupRead(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if clusterAsGroupSet == nil {
@@ -746,30 +764,30 @@ func resourceKubernetesAsScalingGroupRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceKubernetesAsScalingGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceKubernetesAsScalingGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	defer logElapsed("resource.tencentcloud_kubernetes_as_scaling_group.create")()
 	var (
 		logId       = getLogId(contextNil)
-		ctx         = context.WithValue(context.TODO(), logIdKey, logId)
 		clusterId   = d.Get("cluster_id").(string)
 		groupParas  = d.Get("auto_scaling_group").([]interface{})
 		configParas = d.Get("auto_scaling_config").([]interface{})
 		asService   = AsService{client: meta.(*TencentCloudClient).apiV3Conn}
 		iAdvanced   InstanceAdvancedSettings
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if len(groupParas) != 1 || len(configParas) != 1 {
-		return fmt.Errorf("need only one auto_scaling_group and one auto_scaling_config")
+		return diag.Errorf("need only one auto_scaling_group and one auto_scaling_config")
 	}
 
 	groupParaStr, err := kubernetesAsScalingGroupParaSerial(groupParas[0].(map[string]interface{}), meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	configParaStr, err := kubernetesAsScalingConfigParaSerial(configParas[0].(map[string]interface{}), meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	labels := GetTkeLabels(d, "labels")
@@ -787,13 +805,13 @@ func resourceKubernetesAsScalingGroupCreate(d *schema.ResourceData, meta interfa
 
 	asGroupId, err := service.CreateClusterAsGroup(ctx, clusterId, groupParaStr, configParaStr, labels, iAdvanced)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(clusterId + ":" + asGroupId)
 
 	// wait for status ok
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		scalingGroup, _, errRet := asService.DescribeAutoScalingGroupById(ctx, asGroupId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -804,33 +822,33 @@ func resourceKubernetesAsScalingGroupCreate(d *schema.ResourceData, meta interfa
 		return resource.RetryableError(fmt.Errorf("scaling group status is %s, retry...", *scalingGroup.InActivityStatus))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceKubernetesAsScalingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceKubernetesAsScalingGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_as_scaling_group.update")()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 		items   = strings.Split(d.Id(), ":")
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if len(items) != 2 {
-		return fmt.Errorf("resource_tc_kubernetes_as_scaling_group id  is broken")
+		return diag.Errorf("resource_tc_kubernetes_as_scaling_group id  is broken")
 	}
 	clusterId := items[0]
 	asGroupId := items[1]
 	if d.HasChange("auto_scaling_group") {
 		asGroups := d.Get("auto_scaling_group").([]interface{})
-		for _, d := range asGroups {
-			value := d.(map[string]interface{})
+		for _, group := range asGroups {
+			value := group.(map[string]interface{})
 			maxSize := int64(value["max_size"].(int))
 			minSize := int64(value["min_size"].(int))
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				errRet := service.ModifyClusterAsGroupAttribute(ctx, clusterId, asGroupId, maxSize, minSize)
 				if errRet != nil {
 					return retryError(errRet)
@@ -838,26 +856,26 @@ func resourceKubernetesAsScalingGroupUpdate(d *schema.ResourceData, meta interfa
 				return nil
 			})
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return resourceKubernetesAsScalingGroupRead(d, meta)
+	return resourceKubernetesAsScalingGroupRead(ctx, d, meta)
 }
 
-func resourceKubernetesAsScalingGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceKubernetesAsScalingGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_as_scaling_group.delete")()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 		asService = AsService{client: meta.(*TencentCloudClient).apiV3Conn}
 		items     = strings.Split(d.Id(), ":")
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if len(items) != 2 {
-		return fmt.Errorf("resource_tc_kubernetes_as_scaling_group id  is broken")
+		return diag.Errorf("resource_tc_kubernetes_as_scaling_group id  is broken")
 	}
 	clusterId := items[0]
 	asGroupId := items[1]
@@ -865,21 +883,21 @@ func resourceKubernetesAsScalingGroupDelete(d *schema.ResourceData, meta interfa
 	//set len(cvm)==0
 	scalingGroup, has, err := asService.DescribeAutoScalingGroupById(ctx, asGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if has == 0 {
 		return nil
 	}
 	if *scalingGroup.InstanceCount > 0 || *scalingGroup.DesiredCapacity > 0 {
-		if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		if err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			return retryError(asService.ClearScalingGroupInstance(ctx, asGroupId))
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	// wait  set finish
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		scalingGroup, _, errRet := asService.DescribeAutoScalingGroupById(ctx, asGroupId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -890,12 +908,12 @@ func resourceKubernetesAsScalingGroupDelete(d *schema.ResourceData, meta interfa
 		return resource.RetryableError(fmt.Errorf("scaling group status is %s, retry...", *scalingGroup.InActivityStatus))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//delete as group
 	hasDelete := false
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		err := service.DeleteClusterAsGroups(ctx, clusterId, asGroupId)
 
 		if sdkErr, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -911,7 +929,7 @@ func resourceKubernetesAsScalingGroupDelete(d *schema.ResourceData, meta interfa
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if hasDelete {
@@ -919,7 +937,7 @@ func resourceKubernetesAsScalingGroupDelete(d *schema.ResourceData, meta interfa
 	}
 
 	// wait for delete ok
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, errRet := asService.DescribeAutoScalingGroupById(ctx, asGroupId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -930,5 +948,5 @@ func resourceKubernetesAsScalingGroupDelete(d *schema.ResourceData, meta interfa
 		return nil
 	})
 
-	return err
+	return diag.FromErr(err)
 }
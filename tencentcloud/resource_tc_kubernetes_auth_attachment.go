@@ -136,14 +136,24 @@ resource "tencentcloud_kubernetes_auth_attachment" "test_use_tke_default_auth_at
   use_tke_default                      = true
 }
 ```
+
+Import
+
+Kubernetes auth attachment can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_kubernetes_auth_attachment.test_auth_attach cls-mpuy5wvj
+```
 */
 package tencentcloud
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -189,17 +199,24 @@ func resourceTencentCloudTKEAuthAttachment() *schema.Resource {
 				Description: "The default jwks_uri of tke. If use_tke_default is set to `true`, this parameter will be set to the default value.",
 			},
 		},
-		Create: resourceTencentCloudTKEAuthAttachmentCreate,
-		Update: resourceTencentCloudTKEAuthAttachmentUpdate,
-		Read:   resourceTencentCloudTKEAuthAttachmentRead,
-		Delete: resourceTencentCloudTKEAuthAttachmentDelete,
+		CreateContext: resourceTencentCloudTKEAuthAttachmentCreate,
+		UpdateContext: resourceTencentCloudTKEAuthAttachmentUpdate,
+		ReadContext:   resourceTencentCloudTKEAuthAttachmentRead,
+		DeleteContext: resourceTencentCloudTKEAuthAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 	}
 }
 
-func resourceTencentCloudTKEAuthAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTKEAuthAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.resource_tc_kubernetes_auth_attachment.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	id := d.Get("cluster_id").(string)
 
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -222,7 +239,7 @@ func resourceTencentCloudTKEAuthAttachmentCreate(d *schema.ResourceData, meta in
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		err := service.ModifyClusterAuthenticationOptions(ctx, request)
 		if err != nil {
 			return retryError(err, tke.RESOURCEUNAVAILABLE_CLUSTERSTATE)
@@ -231,16 +248,16 @@ func resourceTencentCloudTKEAuthAttachmentCreate(d *schema.ResourceData, meta in
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
-	return resourceTencentCloudTKEAuthAttachmentRead(d, meta)
+	return resourceTencentCloudTKEAuthAttachmentRead(ctx, d, meta)
 }
-func resourceTencentCloudTKEAuthAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTKEAuthAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.resource_tc_kubernetes_auth_attachment.read")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -249,10 +266,11 @@ func resourceTencentCloudTKEAuthAttachmentRead(d *schema.ResourceData, meta inte
 
 	if err != nil {
 		d.SetId("")
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
+	_ = d.Set("cluster_id", id)
 
 	if v, ok := d.GetOk("use_tke_default"); ok && v.(bool) {
 		_ = d.Set("tke_default_issuer", info.Issuer)
@@ -265,10 +283,10 @@ func resourceTencentCloudTKEAuthAttachmentRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudTKEAuthAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTKEAuthAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.resource_tc_kubernetes_auth_attachment.update")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -299,7 +317,7 @@ func resourceTencentCloudTKEAuthAttachmentUpdate(d *schema.ResourceData, meta in
 		}
 	}
 
-	err := resource.Retry(3*writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		err := service.ModifyClusterAuthenticationOptions(ctx, request)
 		if err != nil {
 			return retryError(err, tke.RESOURCEUNAVAILABLE_CLUSTERSTATE)
@@ -308,16 +326,16 @@ func resourceTencentCloudTKEAuthAttachmentUpdate(d *schema.ResourceData, meta in
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTKEAuthAttachmentRead(d, meta)
+	return resourceTencentCloudTKEAuthAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTKEAuthAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTKEAuthAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.resource_tc_kubernetes_auth_attachment.delete")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -330,13 +348,13 @@ func resourceTencentCloudTKEAuthAttachmentDelete(d *schema.ResourceData, meta in
 	}
 
 	if err := service.ModifyClusterAuthenticationOptions(ctx, request); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, err := service.WaitForAuthenticationOptionsUpdateSuccess(ctx, id)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
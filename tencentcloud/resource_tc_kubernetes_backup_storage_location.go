@@ -27,7 +27,9 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
@@ -37,11 +39,15 @@ import (
 func resourceTencentCloudTkeBackupStorageLocation() *schema.Resource {
 	return &schema.Resource{
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CreateContext: resourceTencentCloudTkeBackupStorageLocationCreate,
+		ReadContext:   resourceTencentCloudTkeBackupStorageLocationRead,
+		DeleteContext: resourceTencentCloudTkeBackupStorageLocationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
-		Create: resourceTencentCloudTkeBackupStorageLocationCreate,
-		Read:   resourceTencentCloudTkeBackupStorageLocationRead,
-		Delete: resourceTencentCloudTkeBackupStorageLocationDelete,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -81,22 +87,22 @@ func resourceTencentCloudTkeBackupStorageLocation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTkeBackupStorageLocationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeBackupStorageLocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_backup_storage_location.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := TkeService{client: client}
 
 	request := genCreateBackupStorageLocationRequest(d)
 	err := service.createBackupStorageLocation(ctx, request)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// wait for status ok
-	err = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		locations, errRet := service.describeBackupStorageLocations(ctx, []string{d.Get("name").(string)})
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -113,24 +119,24 @@ func resourceTencentCloudTkeBackupStorageLocationCreate(d *schema.ResourceData,
 		return resource.RetryableError(fmt.Errorf("location %s is still in state %s", d.Get("name").(string), *locations[0].State))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(d.Get("name").(string))
-	return resourceTencentCloudTkeBackupStorageLocationRead(d, meta)
+	return resourceTencentCloudTkeBackupStorageLocationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTkeBackupStorageLocationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeBackupStorageLocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_backup_storage_location.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := TkeService{client: client}
 
 	locations, err := service.describeBackupStorageLocations(ctx, []string{d.Id()})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, location := range locations {
 		if *location.Name == d.Id() {
@@ -148,21 +154,21 @@ func resourceTencentCloudTkeBackupStorageLocationRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudTkeBackupStorageLocationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeBackupStorageLocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_backup_storage_location.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := TkeService{client: client}
 
 	err := service.deleteBackupStorageLocation(ctx, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// wait until location is deleted
-	err = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		locations, errRet := service.describeBackupStorageLocations(ctx, []string{d.Id()})
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -173,7 +179,7 @@ func resourceTencentCloudTkeBackupStorageLocationDelete(d *schema.ResourceData,
 		return resource.RetryableError(fmt.Errorf("location %s is still not deleted", d.Id()))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
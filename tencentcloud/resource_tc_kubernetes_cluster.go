@@ -783,6 +783,14 @@ resource "tencentcloud_kubernetes_cluster" "managed_cluster" {
   }
 }
 ```
+
+Import
+
+Kubernetes cluster can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_kubernetes_cluster.example cls-mpuy5wvj
+```
 */
 package tencentcloud
 
@@ -796,6 +804,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -1854,10 +1863,32 @@ func resourceTencentCloudTkeCluster() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Create: resourceTencentCloudTkeClusterCreate,
-		Read:   resourceTencentCloudTkeClusterRead,
-		Update: resourceTencentCloudTkeClusterUpdate,
-		Delete: resourceTencentCloudTkeClusterDelete,
+		CreateContext: resourceTencentCloudTkeClusterCreate,
+		ReadContext:   resourceTencentCloudTkeClusterRead,
+		UpdateContext: resourceTencentCloudTkeClusterUpdate,
+		DeleteContext: resourceTencentCloudTkeClusterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: helper.ComposeCustomizeDiff(
+			helper.RequiredWhen("cluster_intranet", true, "cluster_intranet_subnet_id"),
+			helper.AllowedOnlyWhen("cluster_intranet", true, "cluster_intranet_subnet_id"),
+			helper.AllowedOnlyWhen("network_type", TKE_CLUSTER_NETWORK_TYPE_VPC_CNI, "eni_subnet_ids"),
+			helper.CustomizeDiffOnCreate(helper.ComposeCustomizeDiff(
+				helper.RequiredWhen("network_type", TKE_CLUSTER_NETWORK_TYPE_VPC_CNI, "service_cidr", "eni_subnet_ids"),
+				helper.RequiredWhen("network_type", TKE_CLUSTER_NETWORK_TYPE_GR, "cluster_cidr"),
+				helper.RequiredWhen("network_type", TKE_CLUSTER_NETWORK_TYPE_CILIUM_OVERLAY, "cluster_cidr", "cluster_subnet_id"),
+				helper.ConflictsWhen("cluster_deploy_type", TKE_DEPLOY_TYPE_MANAGED, "master_config"),
+				helper.RequiredWhen("cluster_deploy_type", TKE_DEPLOY_TYPE_INDEPENDENT, "master_config"),
+			)),
+			helper.Immutable("cluster_subnet_id"),
+			helper.CannotDisable("acquire_cluster_admin_role"),
+		),
 		Schema: schemaBody,
 	}
 }
@@ -2212,8 +2243,9 @@ func tkeGetAuthOptions(d *schema.ResourceData) *tke.ModifyClusterAuthenticationO
 	return request
 }
 
-// upgradeClusterInstances upgrade instances, upgrade type try seq:major, hot.
-func upgradeClusterInstances(tkeService TkeService, ctx context.Context, id string) error {
+// upgradeClusterInstances upgrade instances, upgrade type try seq:major, hot. It waits for the upgrade
+// finished within timeout.
+func upgradeClusterInstances(tkeService TkeService, ctx context.Context, id string, timeout time.Duration) error {
 	// get all available instances for upgrade
 	upgradeType := "major"
 	instanceIds, err := tkeService.CheckInstancesUpgradeAble(ctx, id, upgradeType)
@@ -2234,7 +2266,7 @@ func upgradeClusterInstances(tkeService TkeService, ctx context.Context, id stri
 	}
 
 	// upgrade instances
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		inErr := tkeService.UpgradeClusterInstances(ctx, id, upgradeType, instanceIds)
 		if inErr != nil {
 			return retryError(inErr)
@@ -2245,9 +2277,8 @@ func upgradeClusterInstances(tkeService TkeService, ctx context.Context, id stri
 		return err
 	}
 
-	// check update status: upgrade instance one by one, so timeout depend on instance number.
-	timeout := readRetryTimeout * time.Duration(instNum)
-	err = resource.Retry(timeout, func() *resource.RetryError {
+	// check update status: upgrade instance one by one
+	err = retryContext(ctx, timeout, func() *resource.RetryError {
 		done, inErr := tkeService.GetUpgradeInstanceResult(ctx, id)
 		if inErr != nil {
 			return retryError(inErr)
@@ -2265,11 +2296,11 @@ func upgradeClusterInstances(tkeService TkeService, ctx context.Context, id stri
 	return nil
 }
 
-func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_cluster.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		basic                        ClusterBasicSetting
@@ -2290,10 +2321,10 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 	clusterDeployType := d.Get("cluster_deploy_type").(string)
 
 	if clusterIntranet && intranetSubnetId == "" {
-		return fmt.Errorf("`cluster_intranet_subnet_id` must set when `cluster_intranet` is true")
+		return diag.Errorf("`cluster_intranet_subnet_id` must set when `cluster_intranet` is true")
 	}
 	if !clusterIntranet && intranetSubnetId != "" {
-		return fmt.Errorf("`cluster_intranet_subnet_id` can only set when `cluster_intranet` is true")
+		return diag.Errorf("`cluster_intranet_subnet_id` can only set when `cluster_intranet` is true")
 	}
 
 	vpcId := d.Get("vpc_id").(string)
@@ -2387,30 +2418,30 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 			cidrSet.EniSubnetIds = append(cidrSet.EniSubnetIds, subnetId)
 		}
 		if cidrSet.ServiceCIDR == "" || len(cidrSet.EniSubnetIds) == 0 {
-			return fmt.Errorf("`service_cidr` must be set and `eni_subnet_ids` must be set when cluster `network_type` is VPC-CNI.")
+			return diag.Errorf("`service_cidr` must be set and `eni_subnet_ids` must be set when cluster `network_type` is VPC-CNI.")
 		}
 	} else {
 		// GR cluster
 		if cidrSet.ClusterCidr == "" {
-			return fmt.Errorf("`cluster_cidr` must be set when cluster `network_type` is GR")
+			return diag.Errorf("`cluster_cidr` must be set when cluster `network_type` is GR")
 		}
 		items := strings.Split(cidrSet.ClusterCidr, "/")
 		if len(items) != 2 {
-			return fmt.Errorf("`cluster_cidr` must be network segment ")
+			return diag.Errorf("`cluster_cidr` must be network segment ")
 		}
 
 		bitNumber, err := strconv.ParseInt(items[1], 10, 64)
 
 		if err != nil {
-			return fmt.Errorf("`cluster_cidr` must be network segment ")
+			return diag.Errorf("`cluster_cidr` must be network segment ")
 		}
 
 		if math.Pow(2, float64(32-bitNumber)) <= float64(cidrSet.MaxNodePodNum) {
-			return fmt.Errorf("`cluster_cidr` Network segment range is too small, can not cover cluster_max_service_num")
+			return diag.Errorf("`cluster_cidr` Network segment range is too small, can not cover cluster_max_service_num")
 		}
 
 		if advanced.NetworkType == TKE_CLUSTER_NETWORK_TYPE_CILIUM_OVERLAY && d.Get("cluster_subnet_id").(string) == "" {
-			return fmt.Errorf("`cluster_subnet_id` must be set ")
+			return diag.Errorf("`cluster_subnet_id` must be set ")
 		}
 	}
 
@@ -2424,7 +2455,7 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 	}
 	if masters, ok := d.GetOk("master_config"); ok {
 		if clusterDeployType == TKE_DEPLOY_TYPE_MANAGED {
-			return fmt.Errorf("if `cluster_deploy_type` is `MANAGED_CLUSTER` , You don't need define the master yourself")
+			return diag.Errorf("if `cluster_deploy_type` is `MANAGED_CLUSTER` , You don't need define the master yourself")
 		}
 		var masterCount int64 = 0
 		masterList := masters.([]interface{})
@@ -2432,7 +2463,7 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 			master := masterList[index].(map[string]interface{})
 			paraJson, count, err := tkeGetCvmRunInstancesPara(master, meta, vpcId, basic.ProjectId)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			cvms.Master = append(cvms.Master, paraJson)
@@ -2446,10 +2477,10 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 			}
 		}
 		if masterCount < 3 {
-			return fmt.Errorf("if `cluster_deploy_type` is `TKE_DEPLOY_TYPE_INDEPENDENT` len(master_config) should >=3")
+			return diag.Errorf("if `cluster_deploy_type` is `TKE_DEPLOY_TYPE_INDEPENDENT` len(master_config) should >=3")
 		}
 	} else if clusterDeployType == TKE_DEPLOY_TYPE_INDEPENDENT {
-		return fmt.Errorf("if `cluster_deploy_type` is `TKE_DEPLOY_TYPE_INDEPENDENT` , You need define the master yourself")
+		return diag.Errorf("if `cluster_deploy_type` is `TKE_DEPLOY_TYPE_INDEPENDENT` , You need define the master yourself")
 	}
 
 	if workers, ok := d.GetOk("worker_config"); ok {
@@ -2458,7 +2489,7 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 			worker := workerList[index].(map[string]interface{})
 			paraJson, _, err := tkeGetCvmRunInstancesPara(worker, meta, vpcId, basic.ProjectId)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			cvms.Work = append(cvms.Work, paraJson)
 
@@ -2555,7 +2586,7 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 
 	// RunInstancesForNode（master_config+worker_config) 和 ExistedInstancesForNode 不能同时存在
 	if len(cvms.Master)+len(cvms.Work) > 0 && len(existInstances) > 0 {
-		return fmt.Errorf("master_config+worker_config and exist_instance can not exist at the same time")
+		return diag.Errorf("master_config+worker_config and exist_instance can not exist at the same time")
 	}
 
 	if v, ok := d.GetOk("extension_addon"); ok {
@@ -2574,7 +2605,7 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 	id, err := service.CreateCluster(ctx, basic, advanced, cvms, iAdvanced, cidrSet, tags, existInstances, &overrideSettings, iDiskMountSettings, extensionAddons)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
@@ -2583,7 +2614,7 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		// create often cost more than 20 Minutes.
-		err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			_, _, err = service.DescribeClusterInstances(ctx, d.Id())
 
 			if e, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -2600,18 +2631,18 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = service.CheckOneOfClusterNodeReady(ctx, d.Id(), clusterInternet || clusterIntranet)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//intranet
 	if clusterIntranet {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			inErr := service.CreateClusterEndpoint(ctx, id, intranetSubnetId, clusterInternetSecurityGroup, false, clusterIntranetDomain, "")
 			if inErr != nil {
 				return retryError(inErr)
@@ -2619,9 +2650,9 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			status, message, inErr := service.DescribeClusterEndpointStatus(ctx, id, false)
 			if inErr != nil {
 				return retryError(inErr)
@@ -2637,12 +2668,12 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 				fmt.Errorf("%s create intranet cluster endpoint error ,status is %s,message is %s", id, status, message))
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if clusterInternet {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			inErr := service.CreateClusterEndpoint(ctx, id, "", clusterInternetSecurityGroup, true, clusterInternetDomain, "")
 			if inErr != nil {
 				return retryError(inErr)
@@ -2650,9 +2681,9 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			status, message, inErr := service.DescribeClusterEndpointStatus(ctx, id, true)
 			if inErr != nil {
 				return retryError(inErr)
@@ -2668,14 +2699,14 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 				fmt.Errorf("%s create cluster internet endpoint error ,status is %s,message is %s", id, status, message))
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	//Modify node pool global config
 	if _, ok := d.GetOk("node_pool_global_config"); ok {
 		request := tkeGetNodePoolGlobalConfig(d)
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			inErr := service.ModifyClusterNodePoolGlobalConfig(ctx, request)
 			if inErr != nil {
 				return retryError(inErr)
@@ -2683,21 +2714,21 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if v, ok := d.GetOk("acquire_cluster_admin_role"); ok && v.(bool) {
 		err := service.AcquireClusterAdminRole(ctx, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if _, ok := d.GetOk("auth_options"); ok {
 		request := tkeGetAuthOptions(d)
 		if err := service.ModifyClusterAuthenticationOptions(ctx, request); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -2708,7 +2739,7 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 		if enabled {
 			err := service.SwitchLogAgent(ctx, id, rootDir, enabled)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -2720,7 +2751,7 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 		if enabled {
 			err := service.SwitchEventPersistence(ctx, id, logSetId, topicId, enabled, false)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -2732,29 +2763,29 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 		if enabled {
 			err := service.SwitchClusterAudit(ctx, id, logSetId, topicId, enabled, false)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	if err = resourceTencentCloudTkeClusterRead(d, meta); err != nil {
-		log.Printf("[WARN]%s resource.kubernetes_cluster.read after create fail , %s", logId, err.Error())
-		return err
+	if diags := resourceTencentCloudTkeClusterRead(ctx, d, meta); diags.HasError() {
+		log.Printf("[WARN]%s resource.kubernetes_cluster.read after create fail , %s", logId, diagnosticsError(diags).Error())
+		return diags
 	}
 	return nil
 }
 
-func resourceTencentCloudTkeClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_cluster.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	info, has, err := service.DescribeCluster(ctx, d.Id())
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			info, has, err = service.DescribeCluster(ctx, d.Id())
 			if err != nil {
 				return retryError(err)
@@ -2806,7 +2837,7 @@ func resourceTencentCloudTkeClusterRead(d *schema.ResourceData, meta interface{}
 
 	config, err := service.DescribeClusterConfig(ctx, d.Id(), true)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			config, err = service.DescribeClusterConfig(ctx, d.Id(), true)
 			if err != nil {
 				return retryError(err)
@@ -2823,7 +2854,7 @@ func resourceTencentCloudTkeClusterRead(d *schema.ResourceData, meta interface{}
 
 	intranetConfig, err := service.DescribeClusterConfig(ctx, d.Id(), false)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			intranetConfig, err = service.DescribeClusterConfig(ctx, d.Id(), false)
 			if err != nil {
 				return retryError(err)
@@ -2840,7 +2871,7 @@ func resourceTencentCloudTkeClusterRead(d *schema.ResourceData, meta interface{}
 
 	_, workers, err := service.DescribeClusterInstances(ctx, d.Id())
 	if err != nil {
-		err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, 10*readRetryTimeout, func() *resource.RetryError {
 			_, workers, err = service.DescribeClusterInstances(ctx, d.Id())
 
 			if e, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -2855,7 +2886,7 @@ func resourceTencentCloudTkeClusterRead(d *schema.ResourceData, meta interface{}
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	workerInstancesList := make([]map[string]interface{}, 0, len(workers))
@@ -2874,7 +2905,7 @@ func resourceTencentCloudTkeClusterRead(d *schema.ResourceData, meta interface{}
 	securityRet, err := service.DescribeClusterSecurity(ctx, d.Id())
 
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			securityRet, err = service.DescribeClusterSecurity(ctx, d.Id())
 			if e, ok := err.(*errors.TencentCloudSDKError); ok {
 				if e.GetCode() == "InternalError.ClusterNotFound" {
@@ -2888,7 +2919,7 @@ func resourceTencentCloudTkeClusterRead(d *schema.ResourceData, meta interface{}
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var emptyStrFunc = func(ptr *string) string {
 		if ptr == nil {
@@ -2926,7 +2957,7 @@ func resourceTencentCloudTkeClusterRead(d *schema.ResourceData, meta interface{}
 	//}
 
 	var globalConfig *tke.ClusterAsGroupOption
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		globalConfig, err = service.DescribeClusterNodePoolGlobalConfig(ctx, d.Id())
 		if e, ok := err.(*errors.TencentCloudSDKError); ok {
 			if e.GetCode() == "InternalError.ClusterNotFound" {
@@ -2939,7 +2970,7 @@ func resourceTencentCloudTkeClusterRead(d *schema.ResourceData, meta interface{}
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if globalConfig != nil {
@@ -2959,10 +2990,10 @@ func resourceTencentCloudTkeClusterRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_cluster.update")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -2973,7 +3004,7 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 	d.Partial(true)
 
 	if d.HasChange("cluster_subnet_id") {
-		return fmt.Errorf("argument cluster_subnet_id cannot be changed")
+		return diag.Errorf("argument cluster_subnet_id cannot be changed")
 	}
 
 	if d.HasChange("tags") {
@@ -2982,7 +3013,7 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 
 		resourceName := BuildTagResourceName("ccs", "cluster", region, id)
 		if err := service.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -2997,32 +3028,32 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 	)
 
 	if clusterIntranet && intranetSubnetId == "" {
-		return fmt.Errorf("`cluster_intranet_subnet_id` must set when `cluster_intranet` is true")
+		return diag.Errorf("`cluster_intranet_subnet_id` must set when `cluster_intranet` is true")
 	}
 
 	if d.HasChange("cluster_intranet_subnet_id") && !d.HasChange("cluster_intranet") {
-		return fmt.Errorf("`cluster_intranet_subnet_id` must modified with `cluster_intranet`")
+		return diag.Errorf("`cluster_intranet_subnet_id` must modified with `cluster_intranet`")
 	}
 
 	if d.HasChange("cluster_internet_security_group") && !d.HasChange("cluster_internet") {
 		if clusterInternet {
 			err := tkeService.ModifyClusterEndpointSG(ctx, id, clusterInternetSecurityGroup)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
 	if d.HasChange("cluster_intranet") {
 		if err := ModifyClusterInternetOrIntranetAccess(ctx, d, &tkeService, TKE_CLUSTER_INTRANET, clusterIntranet, clusterInternetSecurityGroup, intranetSubnetId, clusterIntranetDomain); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	if d.HasChange("cluster_internet") {
 		if err := ModifyClusterInternetOrIntranetAccess(ctx, d, &tkeService, TKE_CLUSTER_INTERNET, clusterInternet, clusterInternetSecurityGroup, "", clusterInternetDomain); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -3031,22 +3062,22 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 		// recreate the cluster intranet endpoint using new domain
 		// first close
 		if err := ModifyClusterInternetOrIntranetAccess(ctx, d, &tkeService, TKE_CLUSTER_INTRANET, TKE_CLUSTER_CLOSE_ACCESS, clusterInternetSecurityGroup, intranetSubnetId, clusterIntranetDomain); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		// then reopen
 		if err := ModifyClusterInternetOrIntranetAccess(ctx, d, &tkeService, TKE_CLUSTER_INTRANET, TKE_CLUSTER_OPEN_ACCESS, clusterInternetSecurityGroup, intranetSubnetId, clusterIntranetDomain); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if !d.HasChange("cluster_internet") && clusterInternet && d.HasChange("cluster_internet_domain") {
 		// recreate the cluster internet endpoint using new domain
 		// first close
 		if err := ModifyClusterInternetOrIntranetAccess(ctx, d, &tkeService, TKE_CLUSTER_INTERNET, TKE_CLUSTER_CLOSE_ACCESS, clusterInternetSecurityGroup, "", clusterInternetDomain); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		// then reopen
 		if err := ModifyClusterInternetOrIntranetAccess(ctx, d, &tkeService, TKE_CLUSTER_INTERNET, TKE_CLUSTER_OPEN_ACCESS, clusterInternetSecurityGroup, "", clusterInternetDomain); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -3059,7 +3090,7 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 
 		ins, _, err := tkeService.DescribeCluster(ctx, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		//ignore same cluster level if same
@@ -3067,7 +3098,7 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 			clusterLevel = ""
 		}
 
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			err := tkeService.ModifyClusterAttribute(ctx, id, projectId, clusterName, clusterDesc, clusterLevel, autoUpgradeClusterLevel)
 			if err != nil {
 				// create and update immediately may cause cluster level syntax error, this error can wait until cluster level state normal
@@ -3077,7 +3108,7 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -3086,16 +3117,16 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 		newVersion := d.Get("cluster_version").(string)
 		isOk, err := tkeService.CheckClusterVersion(ctx, id, newVersion)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if !isOk {
-			return fmt.Errorf("version %s is unsupported", newVersion)
+			return diag.Errorf("version %s is unsupported", newVersion)
 		}
 		extraArgs, ok := d.GetOk("cluster_extra_args")
 		if !ok {
 			extraArgs = nil
 		}
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr := tkeService.ModifyClusterVersion(ctx, id, newVersion, extraArgs)
 			if inErr != nil {
 				return retryError(inErr)
@@ -3103,10 +3134,10 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		//check status
-		err = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			ins, has, inErr := tkeService.DescribeCluster(ctx, id)
 			if inErr != nil {
 				return retryError(inErr)
@@ -3121,7 +3152,7 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 			}
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		// upgrade instances version
@@ -3130,9 +3161,9 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 			upgrade = v.(bool)
 		}
 		if upgrade {
-			err := upgradeClusterInstances(tkeService, ctx, id)
+			err := upgradeClusterInstances(tkeService, ctx, id, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -3140,7 +3171,7 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 	// update node pool global config
 	if d.HasChange("node_pool_global_config") {
 		request := tkeGetNodePoolGlobalConfig(d)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr := tkeService.ModifyClusterNodePoolGlobalConfig(ctx, request)
 			if inErr != nil {
 				return retryError(inErr)
@@ -3148,14 +3179,14 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	if d.HasChange("auth_options") {
 		request := tkeGetAuthOptions(d)
-		err := resource.Retry(3*writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr := tkeService.ModifyClusterAuthenticationOptions(ctx, request)
 			if inErr != nil {
 				return retryError(inErr)
@@ -3163,7 +3194,7 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -3171,7 +3202,7 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 	if d.HasChange("deletion_protection") {
 		enable := d.Get("deletion_protection").(bool)
 		if err := tkeService.ModifyDeletionProtection(ctx, id, enable); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -3179,11 +3210,11 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 	if d.HasChange("acquire_cluster_admin_role") {
 		o, n := d.GetChange("acquire_cluster_admin_role")
 		if o.(bool) && !n.(bool) {
-			return fmt.Errorf("argument `acquire_cluster_admin_role` cannot set to false")
+			return diag.Errorf("argument `acquire_cluster_admin_role` cannot set to false")
 		}
 		err := tkeService.AcquireClusterAdminRole(ctx, id)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -3197,7 +3228,7 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 		}
 		err := tkeService.SwitchLogAgent(ctx, id, rootDir, enabled)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -3216,7 +3247,7 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 
 		err := tkeService.SwitchEventPersistence(ctx, id, logSetId, topicId, enabled, deleteEventLog)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -3235,7 +3266,7 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 
 		err := tkeService.SwitchClusterAudit(ctx, id, logSetId, topicId, enabled, deleteAuditLog)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -3249,7 +3280,7 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 			param := addon["param"].(string)
 			name, err := tkeService.GetAddonNameFromJson(param)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			_, has, _ := tkeService.PollingAddonsPhase(ctx, id, name, nil)
 			if has {
@@ -3258,11 +3289,11 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 				err = tkeService.CreateExtensionAddon(ctx, id, param)
 			}
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			_, _, err = tkeService.PollingAddonsPhase(ctx, id, name, nil)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -3271,7 +3302,7 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 			param := addon["param"].(string)
 			name, err := tkeService.GetAddonNameFromJson(param)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			_, has, _ := tkeService.PollingAddonsPhase(ctx, id, name, nil)
 			if !has {
@@ -3279,29 +3310,30 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 			}
 			err = tkeService.DeleteExtensionAddon(ctx, id, name)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			_, has, _ = tkeService.PollingAddonsPhase(ctx, id, name, nil)
 			if has {
-				return fmt.Errorf("addon %s still exists", name)
+				return diag.Errorf("addon %s still exists", name)
 			}
 		}
 
 	}
 
 	d.Partial(false)
-	if err := resourceTencentCloudTkeClusterRead(d, meta); err != nil {
-		log.Printf("[WARN]%s resource.kubernetes_cluster.read after update fail , %s", logId, err.Error())
+	if diags := resourceTencentCloudTkeClusterRead(ctx, d, meta); diags.HasError() {
+		log.Printf("[WARN]%s resource.kubernetes_cluster.read after update fail , %s", logId, diagnosticsError(diags).Error())
+		return warningDiagnostics(diags)
 	}
 
 	return nil
 }
 
-func resourceTencentCloudTkeClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_cluster.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 	deleteEventLogSetAndTopic := false
 	enableEventLog := false
@@ -3316,7 +3348,7 @@ func resourceTencentCloudTkeClusterDelete(d *schema.ResourceData, meta interface
 		deleteAuditLogSetAndTopic = v["delete_audit_log_and_topic"].(bool)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if deleteEventLogSetAndTopic && enableEventLog {
 			err := service.SwitchEventPersistence(ctx, d.Id(), "", "", false, true)
 			if e, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -3352,12 +3384,12 @@ func resourceTencentCloudTkeClusterDelete(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_, _, err = service.DescribeClusterInstances(ctx, d.Id())
 
 	if err != nil {
-		err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			_, _, err = service.DescribeClusterInstances(ctx, d.Id())
 			if e, ok := err.(*errors.TencentCloudSDKError); ok {
 				if e.GetCode() == "InvalidParameter.ClusterNotFound" {
@@ -3370,7 +3402,7 @@ func resourceTencentCloudTkeClusterDelete(d *schema.ResourceData, meta interface
 			return nil
 		})
 	}
-	return err
+	return diag.FromErr(err)
 
 }
 
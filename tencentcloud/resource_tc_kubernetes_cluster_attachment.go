@@ -95,6 +95,14 @@ Example Usage
 	}
 
 ```
+
+Import
+
+Kubernetes cluster attachment can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_kubernetes_cluster_attachment.test_attach ins-6ekeudyx_cls-mpuy5wvj
+```
 */
 package tencentcloud
 
@@ -102,7 +110,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -341,9 +351,16 @@ func resourceTencentCloudTkeClusterAttachment() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Create: resourceTencentCloudTkeClusterAttachmentCreate,
-		Read:   resourceTencentCloudTkeClusterAttachmentRead,
-		Delete: resourceTencentCloudTkeClusterAttachmentDelete,
+		CreateContext: resourceTencentCloudTkeClusterAttachmentCreate,
+		ReadContext:   resourceTencentCloudTkeClusterAttachmentRead,
+		DeleteContext: resourceTencentCloudTkeClusterAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: schemaBody,
 	}
 }
@@ -455,19 +472,19 @@ func tkeGetInstanceAdvancedPara(dMap map[string]interface{}, meta interface{}) (
 
 	return setting
 }
-func resourceTencentCloudTkeClusterAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeClusterAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_cluster_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tkeService := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 	cvmService := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instanceId, clusterId := "", ""
 
 	if items := strings.Split(d.Id(), "_"); len(items) != 2 {
-		return fmt.Errorf("the resource id is corrupted")
+		return diag.Errorf("the resource id is corrupted")
 	} else {
 		instanceId, clusterId = items[0], items[1]
 	}
@@ -475,7 +492,7 @@ func resourceTencentCloudTkeClusterAttachmentRead(d *schema.ResourceData, meta i
 	/*tke has been deleted*/
 	_, has, err := tkeService.DescribeCluster(ctx, clusterId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			_, has, err = tkeService.DescribeCluster(ctx, clusterId)
 			if err != nil {
 				return retryError(err, InternalError)
@@ -493,7 +510,7 @@ func resourceTencentCloudTkeClusterAttachmentRead(d *schema.ResourceData, meta i
 
 	/*cvm has been deleted*/
 	var instance *cvm.Instance
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		instance, err = cvmService.DescribeInstanceById(ctx, instanceId)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -501,7 +518,7 @@ func resourceTencentCloudTkeClusterAttachmentRead(d *schema.ResourceData, meta i
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if instance == nil {
 		d.SetId("")
@@ -512,7 +529,7 @@ func resourceTencentCloudTkeClusterAttachmentRead(d *schema.ResourceData, meta i
 	has = false
 	/*attachment has been  deleted*/
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		_, workers, err := tkeService.DescribeClusterInstances(ctx, clusterId)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -538,7 +555,7 @@ func resourceTencentCloudTkeClusterAttachmentRead(d *schema.ResourceData, meta i
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !has {
@@ -555,11 +572,11 @@ func resourceTencentCloudTkeClusterAttachmentRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudTkeClusterAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeClusterAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_cluster_attachment.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tkeService := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 	cvmService := CvmService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -584,7 +601,7 @@ func resourceTencentCloudTkeClusterAttachmentCreate(d *schema.ResourceData, meta
 	}
 
 	if loginSettingsNumbers != 1 {
-		return fmt.Errorf("parameters `key_ids` and `password` must set and only set one")
+		return diag.Errorf("parameters `key_ids` and `password` must set and only set one")
 	}
 
 	request.InstanceAdvancedSettings = &tke.InstanceAdvancedSettings{}
@@ -621,7 +638,7 @@ func resourceTencentCloudTkeClusterAttachmentCreate(d *schema.ResourceData, meta
 	var err error
 	_, workers, err := tkeService.DescribeClusterInstances(ctx, *request.ClusterId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			_, workers, err = tkeService.DescribeClusterInstances(ctx, *request.ClusterId)
 			if err != nil {
 				return retryError(err, InternalError)
@@ -630,7 +647,7 @@ func resourceTencentCloudTkeClusterAttachmentCreate(d *schema.ResourceData, meta
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	has := false
@@ -640,20 +657,22 @@ func resourceTencentCloudTkeClusterAttachmentCreate(d *schema.ResourceData, meta
 		}
 	}
 	if has {
-		return fmt.Errorf("instance %s has been attached to cluster %s,can not attach again", *instanceId, *request.ClusterId)
+		return diag.Errorf("instance %s has been attached to cluster %s,can not attach again", *instanceId, *request.ClusterId)
 	}
 
 	var response *tke.AddExistedInstancesResponse
 
-	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = tkeService.client.UseTkeClient().AddExistedInstances(request)
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = tkeService.client.UseTkeClient().AddExistedInstancesWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return fmt.Errorf("add existed instance %s to cluster %s error,reason %v", *instanceId, *request.ClusterId, err)
+		return diag.Errorf("add existed instance %s to cluster %s error,reason %v", *instanceId, *request.ClusterId, err)
 	}
 	var success = false
 	for _, v := range response.Response.SuccInstanceIds {
@@ -664,11 +683,11 @@ func resourceTencentCloudTkeClusterAttachmentCreate(d *schema.ResourceData, meta
 	}
 
 	if !success {
-		return fmt.Errorf("add existed instance %s to cluster %s error, instance not in success instanceIds", *instanceId, *request.ClusterId)
+		return diag.Errorf("add existed instance %s to cluster %s error, instance not in success instanceIds", *instanceId, *request.ClusterId)
 	}
 
 	/*wait for cvm status*/
-	if err = resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, errRet := cvmService.DescribeInstanceById(ctx, *instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -678,11 +697,11 @@ func resourceTencentCloudTkeClusterAttachmentCreate(d *schema.ResourceData, meta
 		}
 		return resource.RetryableError(fmt.Errorf("cvm instance %s status is %s, retry...", *instanceId, *instance.InstanceState))
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	/*wait for tke init ok */
-	err = resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		_, workers, err = tkeService.DescribeClusterInstances(ctx, *request.ClusterId)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -710,20 +729,20 @@ func resourceTencentCloudTkeClusterAttachmentCreate(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTkeClusterAttachmentRead(d, meta)
+	return resourceTencentCloudTkeClusterAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTkeClusterAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeClusterAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_cluster_attachment.delete")()
 
 	tkeService := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instanceId, clusterId := "", ""
 
 	if items := strings.Split(d.Id(), "_"); len(items) != 2 {
-		return fmt.Errorf("the resource id is corrupted")
+		return diag.Errorf("the resource id is corrupted")
 	} else {
 		instanceId, clusterId = items[0], items[1]
 	}
@@ -738,8 +757,8 @@ func resourceTencentCloudTkeClusterAttachmentDelete(d *schema.ResourceData, meta
 
 	var err error
 
-	if err = resource.Retry(4*writeRetryTimeout, func() *resource.RetryError {
-		_, err := tkeService.client.UseTkeClient().DeleteClusterInstances(request)
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := tkeService.client.UseTkeClient().DeleteClusterInstancesWithContext(ctx, request)
 		if e, ok := err.(*errors.TencentCloudSDKError); ok {
 			if e.GetCode() == "InternalError.ClusterNotFound" {
 				return nil
@@ -755,7 +774,7 @@ func resourceTencentCloudTkeClusterAttachmentDelete(d *schema.ResourceData, meta
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
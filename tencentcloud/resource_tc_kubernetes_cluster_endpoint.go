@@ -41,18 +41,19 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTencentCloudTkeClusterEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTkeClusterEndpointRead,
-		Create: resourceTencentCloudTkeClusterEndpointCreate,
-		Update: resourceTencentCloudTkeClusterEndpointUpdate,
-		Delete: resourceTencentCloudTkeClusterEndpointDelete,
+		ReadContext:   resourceTencentCloudTkeClusterEndpointRead,
+		CreateContext: resourceTencentCloudTkeClusterEndpointCreate,
+		UpdateContext: resourceTencentCloudTkeClusterEndpointUpdate,
+		DeleteContext: resourceTencentCloudTkeClusterEndpointDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -151,12 +152,12 @@ func resourceTencentCloudTkeClusterEndpoint() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTkeClusterEndpointRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeClusterEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_cluster_endpoint.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := TkeService{client}
 
@@ -164,11 +165,11 @@ func resourceTencentCloudTkeClusterEndpointRead(d *schema.ResourceData, meta int
 	info, has, err := service.DescribeCluster(ctx, id)
 	if err != nil {
 		d.SetId("")
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
-		return fmt.Errorf("cluster %s not found", id)
+		return diag.Errorf("cluster %s not found", id)
 	}
 
 	d.SetId(id)
@@ -179,7 +180,7 @@ func resourceTencentCloudTkeClusterEndpointRead(d *schema.ResourceData, meta int
 	response, err := service.DescribeClusterSecurity(ctx, id)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var (
@@ -204,11 +205,11 @@ func resourceTencentCloudTkeClusterEndpointRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudTkeClusterEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeClusterEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_cluster_endpoint.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := TkeService{client}
 
@@ -225,29 +226,29 @@ func resourceTencentCloudTkeClusterEndpointCreate(d *schema.ResourceData, meta i
 	)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if clusterIntranet && intranetSubnetId == "" {
-		return fmt.Errorf("`cluster_intranet_subnet_id` must set when `cluster_intranet` is true")
+		return diag.Errorf("`cluster_intranet_subnet_id` must set when `cluster_intranet` is true")
 	}
 	if !clusterIntranet && intranetSubnetId != "" {
-		return fmt.Errorf("`cluster_intranet_subnet_id` can only set when `cluster_intranet` is true")
+		return diag.Errorf("`cluster_intranet_subnet_id` can only set when `cluster_intranet` is true")
 	}
 
 	if err := service.CheckOneOfClusterNodeReady(ctx, id, true); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create Intranet(Private) Network
 	if clusterIntranet {
 		err := tencentCloudClusterIntranetSwitch(ctx, &service, id, intranetSubnetId, true, clusterIntranetDomain)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		err = waitForClusterEndpointFinish(ctx, &service, id, true, false)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -255,24 +256,24 @@ func resourceTencentCloudTkeClusterEndpointCreate(d *schema.ResourceData, meta i
 	if clusterInternet {
 		err := tencentCloudClusterInternetSwitch(ctx, &service, id, true, clusterInternetSecurityGroup, clusterInternetDomain, extensiveParameters)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		err = waitForClusterEndpointFinish(ctx, &service, id, true, true)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(id)
 
-	return resourceTencentCloudTkeClusterEndpointRead(d, meta)
+	return resourceTencentCloudTkeClusterEndpointRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTkeClusterEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeClusterEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_cluster_endpoint.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := TkeService{client}
 	id := d.Id()
@@ -295,7 +296,7 @@ func resourceTencentCloudTkeClusterEndpointUpdate(d *schema.ResourceData, meta i
 		if clusterInternet {
 			err := service.ModifyClusterEndpointSG(ctx, id, clusterInternetSecurityGroup)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -303,73 +304,73 @@ func resourceTencentCloudTkeClusterEndpointUpdate(d *schema.ResourceData, meta i
 	if d.HasChange("cluster_internet") {
 		err = tencentCloudClusterInternetSwitch(ctx, &service, id, clusterInternet, clusterInternetSecurityGroup, clusterInternetDomain, extensiveParameters)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		err = waitForClusterEndpointFinish(ctx, &service, id, clusterInternet, true)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if clusterInternet && d.HasChange("cluster_internet_domain") {
 		// only domain changed, need to close and reopen
 		// close
 		err = tencentCloudClusterInternetSwitch(ctx, &service, id, false, clusterInternetSecurityGroup, clusterInternetDomain, "")
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		err = waitForClusterEndpointFinish(ctx, &service, id, false, true)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		// reopen
 		err = tencentCloudClusterInternetSwitch(ctx, &service, id, true, clusterInternetSecurityGroup, clusterInternetDomain, extensiveParameters)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		err = waitForClusterEndpointFinish(ctx, &service, id, true, true)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("cluster_intranet") {
 		err = tencentCloudClusterIntranetSwitch(ctx, &service, id, subnetId, clusterIntranet, clusterIntranetDomain)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		err = waitForClusterEndpointFinish(ctx, &service, id, clusterIntranet, false)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if clusterIntranet && d.HasChange("cluster_intranet_domain") {
 		// only domain changed, need to close and reopen
 		// close
 		err = tencentCloudClusterIntranetSwitch(ctx, &service, id, subnetId, false, clusterIntranetDomain)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		err = waitForClusterEndpointFinish(ctx, &service, id, false, false)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		// reopen
 		err = tencentCloudClusterIntranetSwitch(ctx, &service, id, subnetId, true, clusterIntranetDomain)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		err = waitForClusterEndpointFinish(ctx, &service, id, true, false)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTkeClusterEndpointRead(d, meta)
+	return resourceTencentCloudTkeClusterEndpointRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTkeClusterEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeClusterEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_cluster_endpoint.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := TkeService{client}
 	var (
@@ -380,7 +381,7 @@ func resourceTencentCloudTkeClusterEndpointDelete(d *schema.ResourceData, meta i
 	response, err := service.DescribeClusterSecurity(ctx, id)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var (
@@ -409,11 +410,11 @@ func resourceTencentCloudTkeClusterEndpointDelete(d *schema.ResourceData, meta i
 		}
 	}
 
-	return errs.ErrorOrNil()
+	return diag.FromErr(errs.ErrorOrNil())
 }
 
 func waitForClusterEndpointFinish(ctx context.Context, service *TkeService, id string, enabled bool, isInternet bool) (err error) {
-	return resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+	return retryContext(ctx, 2*readRetryTimeout, func() *resource.RetryError {
 		var (
 			status         string
 			message        string
@@ -445,7 +446,7 @@ func waitForClusterEndpointFinish(ctx context.Context, service *TkeService, id s
 }
 
 func tencentCloudClusterInternetSwitch(ctx context.Context, service *TkeService, id string, enable bool, sg string, domain string, extensiveParameters string) (err error) {
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		if enable {
 			err = service.CreateClusterEndpoint(ctx, id, "", sg, true, domain, extensiveParameters)
 			if err != nil {
@@ -466,7 +467,7 @@ func tencentCloudClusterInternetSwitch(ctx context.Context, service *TkeService,
 }
 
 func tencentCloudClusterIntranetSwitch(ctx context.Context, service *TkeService, id, subnetId string, enable bool, domain string) (err error) {
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		if enable {
 			err = service.CreateClusterEndpoint(ctx, id, subnetId, "", false, domain, "")
 			if err != nil {
@@ -102,6 +102,52 @@ func TestAccTencentCloudKubernetesClusterResourceBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(testTkeClusterResourceKey, "auto_upgrade_cluster_level", "false"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_kubernetes_cluster.managed_cluster",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"cluster_subnet_id",
+					"cluster_os_type",
+					"container_runtime",
+					"upgrade_instances_follow_cluster",
+					"cluster_as_enabled",
+					"acquire_cluster_admin_role",
+					"cluster_extra_args",
+					"node_name_type",
+					"network_type",
+					"enable_customized_pod_cidr",
+					"base_pod_num",
+					"is_non_static_ip_mode",
+					"deletion_protection",
+					"kube_proxy_mode",
+					"cluster_internet",
+					"cluster_internet_domain",
+					"cluster_intranet",
+					"cluster_intranet_domain",
+					"cluster_internet_security_group",
+					"managed_cluster_internet_security_policies",
+					"cluster_intranet_subnet_id",
+					"service_cidr",
+					"eni_subnet_ids",
+					"claim_expired_seconds",
+					"master_config",
+					"worker_config",
+					"exist_instance",
+					"auth_options",
+					"extension_addon",
+					"log_agent",
+					"event_persistence",
+					"cluster_audit",
+					"labels",
+					"unschedulable",
+					"mount_target",
+					"globe_desired_pod_num",
+					"docker_graph_path",
+					"extra_args",
+					"runtime_version",
+				},
+			},
 		},
 	})
 }
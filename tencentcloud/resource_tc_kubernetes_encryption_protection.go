@@ -48,6 +48,14 @@ resource "tencentcloud_kubernetes_encryption_protection" "example" {
   }
 }
 ```
+
+Import
+
+tke encryption_protection can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_kubernetes_encryption_protection.example cls-mpuy5wvj
+```
 */
 package tencentcloud
 
@@ -56,6 +64,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
@@ -64,9 +73,16 @@ import (
 
 func resourceTencentCloudKubernetesEncryptionProtection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudKubernetesEncryptionProtectionCreate,
-		Read:   resourceTencentCloudKubernetesEncryptionProtectionRead,
-		Delete: resourceTencentCloudKubernetesEncryptionProtectionDelete,
+		CreateContext: resourceTencentCloudKubernetesEncryptionProtectionCreate,
+		ReadContext:   resourceTencentCloudKubernetesEncryptionProtectionRead,
+		DeleteContext: resourceTencentCloudKubernetesEncryptionProtectionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(9 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -105,7 +121,7 @@ func resourceTencentCloudKubernetesEncryptionProtection() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudKubernetesEncryptionProtectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudKubernetesEncryptionProtectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tke_encryption_protection.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -131,8 +147,8 @@ func resourceTencentCloudKubernetesEncryptionProtectionCreate(d *schema.Resource
 		request.KMSConfiguration = &kMSConfiguration
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTkeClient().EnableEncryptionProtection(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTkeClient().EnableEncryptionProtectionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -142,29 +158,29 @@ func resourceTencentCloudKubernetesEncryptionProtectionCreate(d *schema.Resource
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tke encryptionProtection failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(clusterId)
 
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"Opened"}, 3*readRetryTimeout, time.Second, service.TkeEncryptionProtectionStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"Opened"}, d.Timeout(schema.TimeoutCreate), time.Second, service.TkeEncryptionProtectionStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudKubernetesEncryptionProtectionRead(d, meta)
+	return resourceTencentCloudKubernetesEncryptionProtectionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudKubernetesEncryptionProtectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudKubernetesEncryptionProtectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tke_encryption_protection.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -172,7 +188,7 @@ func resourceTencentCloudKubernetesEncryptionProtectionRead(d *schema.ResourceDa
 
 	encryptionProtection, err := service.DescribeTkeEncryptionProtectionById(ctx, encryptionProtectionId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if encryptionProtection == nil {
@@ -181,6 +197,8 @@ func resourceTencentCloudKubernetesEncryptionProtectionRead(d *schema.ResourceDa
 		return nil
 	}
 
+	_ = d.Set("cluster_id", encryptionProtectionId)
+
 	if encryptionProtection.Status != nil {
 		_ = d.Set("status", encryptionProtection.Status)
 	}
@@ -188,24 +206,24 @@ func resourceTencentCloudKubernetesEncryptionProtectionRead(d *schema.ResourceDa
 	return nil
 }
 
-func resourceTencentCloudKubernetesEncryptionProtectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudKubernetesEncryptionProtectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tke_encryption_protection.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 	encryptionProtectionId := d.Id()
 
 	if err := service.DeleteTkeEncryptionProtectionById(ctx, encryptionProtectionId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	conf := BuildStateChangeConf([]string{}, []string{"Closed"}, 3*readRetryTimeout, time.Second, service.TkeEncryptionProtectionStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"Closed"}, d.Timeout(schema.TimeoutDelete), time.Second, service.TkeEncryptionProtectionStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	return nil
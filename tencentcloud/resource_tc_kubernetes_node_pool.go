@@ -151,7 +151,9 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
@@ -394,10 +396,15 @@ func composedKubernetesAsScalingConfigPara() map[string]*schema.Schema {
 
 func resourceTencentCloudKubernetesNodePool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKubernetesNodePoolCreate,
-		Read:   resourceKubernetesNodePoolRead,
-		Delete: resourceKubernetesNodePoolDelete,
-		Update: resourceKubernetesNodePoolUpdate,
+		CreateContext: resourceKubernetesNodePoolCreate,
+		ReadContext:   resourceKubernetesNodePoolRead,
+		DeleteContext: resourceKubernetesNodePoolDelete,
+		UpdateContext: resourceKubernetesNodePoolUpdate,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -633,8 +640,7 @@ func resourceTencentCloudKubernetesNodePool() *schema.Resource {
 			//State: schema.ImportStatePassthrough,
 			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 				importFlag = true
-				err := resourceKubernetesNodePoolRead(d, m)
-				if err != nil {
+				if diags := resourceKubernetesNodePoolRead(ctx, d, m); diags.HasError() {
 					return nil, fmt.Errorf("failed to import resource")
 				}
 
@@ -645,7 +651,7 @@ func resourceTencentCloudKubernetesNodePool() *schema.Resource {
 	}
 }
 
-//this function composes every single parameter to an as scale parameter with json string format
+// this function composes every single parameter to an as scale parameter with json string format
 func composeParameterToAsScalingGroupParaSerial(d *schema.ResourceData) (string, error) {
 	var (
 		result string
@@ -695,7 +701,7 @@ func composeParameterToAsScalingGroupParaSerial(d *schema.ResourceData) (string,
 	return result, errRet
 }
 
-//This function is used to specify tke as group launch config, similar to kubernetesAsScalingConfigParaSerial, but less parameter
+// This function is used to specify tke as group launch config, similar to kubernetesAsScalingConfigParaSerial, but less parameter
 func composedKubernetesAsScalingConfigParaSerial(dMap map[string]interface{}, meta interface{}) (string, error) {
 	var (
 		result string
@@ -1049,25 +1055,25 @@ func desiredCapacityOutRange(d *schema.ResourceData) bool {
 	return capacity > maxSize || capacity < minSize
 }
 
-func resourceKubernetesNodePoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceKubernetesNodePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_node_pool.read")()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 		asService = AsService{client: meta.(*TencentCloudClient).apiV3Conn}
-		items     = strings.Split(d.Id(), FILED_SP)
+		items     = helper.IdParse(d.Id())
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if len(items) != 2 {
-		return fmt.Errorf("resource_tc_kubernetes_node_pool id  is broken")
+		return diag.Errorf("resource_tc_kubernetes_node_pool id  is broken")
 	}
 	clusterId := items[0]
 	nodePoolId := items[1]
 
 	_, has, err := service.DescribeCluster(ctx, clusterId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			_, has, err = service.DescribeCluster(ctx, clusterId)
 			if err != nil {
 				return retryError(err)
@@ -1092,7 +1098,7 @@ func resourceKubernetesNodePoolRead(d *schema.ResourceData, meta interface{}) er
 		nodePool *tke.NodePool
 	)
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		nodePool, has, err = service.DescribeNodePool(ctx, clusterId, nodePoolId)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -1108,7 +1114,7 @@ func resourceKubernetesNodePoolRead(d *schema.ResourceData, meta interface{}) er
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !has {
@@ -1278,7 +1284,7 @@ func resourceKubernetesNodePoolRead(d *schema.ResourceData, meta interface{}) er
 		asgConfig := make([]interface{}, 0, 1)
 		asgConfig = append(asgConfig, launchConfig)
 		if err := d.Set("auto_scaling_config", asgConfig); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1384,7 +1390,7 @@ func resourceKubernetesNodePoolRead(d *schema.ResourceData, meta interface{}) er
 	// Relative scaling group status
 	asg, hasAsg, err := asService.DescribeAutoScalingGroupById(ctx, *nodePool.AutoscalingGroupId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			asg, hasAsg, err = asService.DescribeAutoScalingGroupById(ctx, *nodePool.AutoscalingGroupId)
 			if err != nil {
 				return retryError(err)
@@ -1438,29 +1444,29 @@ func resourceKubernetesNodePoolRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceKubernetesNodePoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceKubernetesNodePoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_node_pool.create")()
 	var (
 		logId           = getLogId(contextNil)
-		ctx             = context.WithValue(context.TODO(), logIdKey, logId)
 		clusterId       = d.Get("cluster_id").(string)
 		enableAutoScale = d.Get("enable_auto_scale").(bool)
 		configParas     = d.Get("auto_scaling_config").([]interface{})
 		name            = d.Get("name").(string)
 		iAdvanced       tke.InstanceAdvancedSettings
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if len(configParas) != 1 {
-		return fmt.Errorf("need only one auto_scaling_config")
+		return diag.Errorf("need only one auto_scaling_config")
 	}
 
 	groupParaStr, err := composeParameterToAsScalingGroupParaSerial(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	configParaStr, err := composedKubernetesAsScalingConfigParaSerial(configParas[0].(map[string]interface{}), meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	labels := GetTkeLabels(d, "labels")
@@ -1494,13 +1500,13 @@ func resourceKubernetesNodePoolCreate(d *schema.ResourceData, meta interface{})
 
 	nodePoolId, err := service.CreateClusterNodePool(ctx, clusterId, name, groupParaStr, configParaStr, enableAutoScale, nodeOs, nodeOsType, labels, taints, iAdvanced, deletionProtection)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(clusterId + FILED_SP + nodePoolId)
+	d.SetId(helper.IdFormat(clusterId, nodePoolId))
 
 	// wait for status ok
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		nodePool, _, errRet := service.DescribeNodePool(ctx, clusterId, nodePoolId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -1511,7 +1517,7 @@ func resourceKubernetesNodePoolCreate(d *schema.ResourceData, meta interface{})
 		return resource.RetryableError(fmt.Errorf("node pool status is %s, retry...", *nodePool.LifeState))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceTypes := getNodePoolInstanceTypes(d)
@@ -1519,33 +1525,28 @@ func resourceKubernetesNodePoolCreate(d *schema.ResourceData, meta interface{})
 	if len(instanceTypes) != 0 {
 		err := service.ModifyClusterNodePoolInstanceTypes(ctx, clusterId, nodePoolId, instanceTypes)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	//modify os, instanceTypes and image
-	err = resourceKubernetesNodePoolUpdate(d, meta)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return resourceKubernetesNodePoolUpdate(ctx, d, meta)
 }
 
-func resourceKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceKubernetesNodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_node_pool.update")()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		client     = meta.(*TencentCloudClient).apiV3Conn
 		service    = TkeService{client: client}
 		asService  = AsService{client: client}
 		cvmService = CvmService{client: client}
-		items      = strings.Split(d.Id(), FILED_SP)
+		items      = helper.IdParse(d.Id())
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if len(items) != 2 {
-		return fmt.Errorf("resource_tc_kubernetes_node_pool id  is broken")
+		return diag.Errorf("resource_tc_kubernetes_node_pool id  is broken")
 	}
 	clusterId := items[0]
 	nodePoolId := items[1]
@@ -1556,24 +1557,24 @@ func resourceKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{})
 	if d.HasChange("auto_scaling_config") {
 		nodePool, _, err := service.DescribeNodePool(ctx, clusterId, nodePoolId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		launchConfigId := *nodePool.LaunchConfigurationId
 		//  change as config here
 		request, composeError := composeAsLaunchConfigModifyRequest(d, launchConfigId)
 		if composeError != nil {
-			return composeError
+			return diag.FromErr(composeError)
 		}
-		_, err = client.UseAsClient().ModifyLaunchConfigurationAttributes(request)
+		_, err = client.UseAsClient().ModifyLaunchConfigurationAttributesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 		// change existed cvm security service if necessary
 		if err := ModifySecurityServiceOfCvmInNodePool(ctx, d, &service, &cvmService, client, clusterId, *nodePool.NodePoolId); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -1586,7 +1587,7 @@ func resourceKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{})
 	// modify min/max first will cause error, this case must upgrade desired first
 	if d.HasChange("desired_capacity") || !desiredCapacityOutRange(d) {
 		desiredCapacity := int64(d.Get("desired_capacity").(int))
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			errRet := service.ModifyClusterNodePoolDesiredCapacity(ctx, clusterId, nodePoolId, desiredCapacity)
 			if errRet != nil {
 				return retryError(errRet)
@@ -1594,7 +1595,7 @@ func resourceKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{})
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		capacityHasChanged = true
 	}
@@ -1625,7 +1626,7 @@ func resourceKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{})
 		if strings.Contains(nodeOs, "img-") {
 			nodeOsType = ""
 		}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			errRet := service.ModifyClusterNodePool(ctx, clusterId, nodePoolId, name, enableAutoScale, minSize, maxSize, nodeOs, nodeOsType, labels, taints, tags, deletionProtection)
 			if errRet != nil {
 				return retryError(errRet)
@@ -1633,7 +1634,7 @@ func resourceKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{})
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1647,7 +1648,7 @@ func resourceKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{})
 
 		nodePool, _, err := service.DescribeNodePool(ctx, clusterId, nodePoolId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		var (
@@ -1684,7 +1685,7 @@ func resourceKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{})
 			request.TerminationPolicies = helper.InterfacesStringsPoint(v.([]interface{}))
 		}
 
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			errRet := asService.ModifyAutoScalingGroup(ctx, request)
 			if errRet != nil {
 				return retryError(errRet)
@@ -1693,14 +1694,14 @@ func resourceKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{})
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	if d.HasChange("desired_capacity") && !capacityHasChanged {
 		desiredCapacity := int64(d.Get("desired_capacity").(int))
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			errRet := service.ModifyClusterNodePoolDesiredCapacity(ctx, clusterId, nodePoolId, desiredCapacity)
 			if errRet != nil {
 				return retryError(errRet)
@@ -1708,13 +1709,13 @@ func resourceKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{})
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("auto_scaling_config.0.backup_instance_types") {
 		instanceTypes := getNodePoolInstanceTypes(d)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			errRet := service.ModifyClusterNodePoolInstanceTypes(ctx, clusterId, nodePoolId, instanceTypes)
 			if errRet != nil {
 				return retryError(errRet)
@@ -1722,39 +1723,39 @@ func resourceKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{})
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		_ = d.Set("auto_scaling_config.0.backup_instance_types", instanceTypes)
 	}
 	d.Partial(false)
 
-	return resourceKubernetesNodePoolRead(d, meta)
+	return resourceKubernetesNodePoolRead(ctx, d, meta)
 }
 
-func resourceKubernetesNodePoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceKubernetesNodePoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_node_pool.delete")()
 
 	var (
 		logId              = getLogId(contextNil)
-		ctx                = context.WithValue(context.TODO(), logIdKey, logId)
 		service            = TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
-		items              = strings.Split(d.Id(), FILED_SP)
+		items              = helper.IdParse(d.Id())
 		deleteKeepInstance = d.Get("delete_keep_instance").(bool)
 		deletionProtection = d.Get("deletion_protection").(bool)
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if len(items) != 2 {
-		return fmt.Errorf("resource_tc_kubernetes_node_pool id  is broken")
+		return diag.Errorf("resource_tc_kubernetes_node_pool id  is broken")
 	}
 	clusterId := items[0]
 	nodePoolId := items[1]
 
 	if deletionProtection {
-		return fmt.Errorf("deletion protection was enabled, please set `deletion_protection` to `false` and apply first")
+		return diag.Errorf("deletion protection was enabled, please set `deletion_protection` to `false` and apply first")
 	}
 
 	//delete as group
 	hasDelete := false
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		err := service.DeleteClusterNodePool(ctx, clusterId, nodePoolId, deleteKeepInstance)
 
 		if sdkErr, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1770,7 +1771,7 @@ func resourceKubernetesNodePoolDelete(d *schema.ResourceData, meta interface{})
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if hasDelete {
@@ -1778,7 +1779,7 @@ func resourceKubernetesNodePoolDelete(d *schema.ResourceData, meta interface{})
 	}
 
 	// wait for delete ok
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		nodePool, has, errRet := service.DescribeNodePool(ctx, clusterId, nodePoolId)
 		if errRet != nil {
 			errCode := errRet.(*sdkErrors.TencentCloudSDKError).Code
@@ -1793,7 +1794,7 @@ func resourceKubernetesNodePoolDelete(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 
-	return err
+	return diag.FromErr(err)
 }
 
 func isCUDNNEmpty(cudnn *tke.CUDNN) bool {
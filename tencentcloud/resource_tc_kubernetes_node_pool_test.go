@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"testing"
 
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -203,7 +202,7 @@ func testAccCheckTkeNodePoolDestroy(s *terraform.State) error {
 	if rs.Primary.ID == "" {
 		return fmt.Errorf("tke  node pool id is not set")
 	}
-	items := strings.Split(rs.Primary.ID, FILED_SP)
+	items := helper.IdParse(rs.Primary.ID)
 	if len(items) != 2 {
 		return fmt.Errorf("resource_tc_kubernetes_node_pool id %s is broken", rs.Primary.ID)
 	}
@@ -242,7 +241,7 @@ func testAccCheckTkeNodePoolExists(s *terraform.State) error {
 		return fmt.Errorf("tke node pool id is not set")
 	}
 
-	items := strings.Split(rs.Primary.ID, FILED_SP)
+	items := helper.IdParse(rs.Primary.ID)
 	if len(items) != 2 {
 		return fmt.Errorf("resource_tc_kubernetes_node_pool id  %s is broken", rs.Primary.ID)
 	}
@@ -112,6 +112,7 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -120,9 +121,13 @@ import (
 
 func resourceTencentCloudTkeScaleWorker() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTkeScaleWorkerCreate,
-		Read:   resourceTencentCloudTkeScaleWorkerRead,
-		Delete: resourceTencentCloudTkeScaleWorkerDelete,
+		CreateContext: resourceTencentCloudTkeScaleWorkerCreate,
+		ReadContext:   resourceTencentCloudTkeScaleWorkerRead,
+		DeleteContext: resourceTencentCloudTkeScaleWorkerDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -250,10 +255,10 @@ func resourceTencentCloudTkeScaleWorker() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTkeScaleWorkerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeScaleWorkerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_scale_worker.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var cvms RunInstancesForNode
 	var iAdvanced tke.InstanceAdvancedSettings
@@ -263,12 +268,12 @@ func resourceTencentCloudTkeScaleWorkerCreate(d *schema.ResourceData, meta inter
 
 	clusterId := d.Get("cluster_id").(string)
 	if clusterId == "" {
-		return fmt.Errorf("`cluster_id` is empty.")
+		return diag.Errorf("`cluster_id` is empty.")
 	}
 
 	info, has, err := service.DescribeCluster(ctx, clusterId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			info, has, err = service.DescribeCluster(ctx, clusterId)
 			if err != nil {
 				return retryError(err)
@@ -282,7 +287,7 @@ func resourceTencentCloudTkeScaleWorkerCreate(d *schema.ResourceData, meta inter
 	}
 
 	if !has {
-		return fmt.Errorf("cluster [%s] is not exist.", clusterId)
+		return diag.Errorf("cluster [%s] is not exist.", clusterId)
 	}
 
 	dMap := make(map[string]interface{}, 5)
@@ -306,25 +311,25 @@ func resourceTencentCloudTkeScaleWorkerCreate(d *schema.ResourceData, meta inter
 			worker := workerList[index].(map[string]interface{})
 			paraJson, _, err := tkeGetCvmRunInstancesPara(worker, meta, info.VpcId, info.ProjectId)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			cvms.Work = append(cvms.Work, paraJson)
 		}
 	}
 	if len(cvms.Work) != 1 {
-		return fmt.Errorf("only one additional configuration of virtual machines is now supported now, " +
-			"so len(cvms.Work) should be 1")
+		return diag.FromErr(fmt.Errorf("only one additional configuration of virtual machines is now supported now, " +
+			"so len(cvms.Work) should be 1"))
 	}
 
 	instanceIds, err := service.CreateClusterInstances(ctx, clusterId, cvms.Work[0], iAdvanced)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	workerInstancesList := make([]map[string]interface{}, 0, len(instanceIds))
 	for _, v := range instanceIds {
 		if v == "" {
-			return fmt.Errorf("CreateClusterInstances return one instanceId is empty")
+			return diag.Errorf("CreateClusterInstances return one instanceId is empty")
 		}
 		infoMap := make(map[string]interface{})
 		infoMap["instance_id"] = v
@@ -333,19 +338,19 @@ func resourceTencentCloudTkeScaleWorkerCreate(d *schema.ResourceData, meta inter
 	}
 
 	if err = d.Set("worker_instances_list", workerInstancesList); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	md := md5.New()
 
 	if _, err = md.Write([]byte(clusterId)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceIdJoin := strings.Join(instanceIds, "#")
 
 	if _, err = md.Write([]byte(instanceIdJoin)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := fmt.Sprintf("TkeScaleWorker.%x", md.Sum(nil))
@@ -354,27 +359,27 @@ func resourceTencentCloudTkeScaleWorkerCreate(d *schema.ResourceData, meta inter
 
 	//wait for LANIP
 	time.Sleep(readRetryTimeout)
-	return resourceTencentCloudTkeScaleWorkerRead(d, meta)
+	return resourceTencentCloudTkeScaleWorkerRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTkeScaleWorkerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeScaleWorkerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	defer logElapsed("resource.tencentcloud_kubernetes_scale_worker.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	clusterId := d.Get("cluster_id").(string)
 	if clusterId == "" {
-		return fmt.Errorf("tke.`cluster_id` is empty.")
+		return diag.Errorf("tke.`cluster_id` is empty.")
 	}
 
 	_, has, err := service.DescribeCluster(ctx, clusterId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			_, has, err = service.DescribeCluster(ctx, clusterId)
 			if err != nil {
 				return retryError(err)
@@ -401,11 +406,11 @@ func resourceTencentCloudTkeScaleWorkerRead(d *schema.ResourceData, meta interfa
 		infoMap, ok := v.(map[string]interface{})
 
 		if !ok || infoMap["instance_id"] == nil {
-			return fmt.Errorf("worker_instances_list is broken.")
+			return diag.Errorf("worker_instances_list is broken.")
 		}
 		instanceId, ok := infoMap["instance_id"].(string)
 		if !ok || instanceId == "" {
-			return fmt.Errorf("worker_instances_list.instance_id is broken.")
+			return diag.Errorf("worker_instances_list.instance_id is broken.")
 		}
 
 		if instanceMap[instanceId] {
@@ -418,7 +423,7 @@ func resourceTencentCloudTkeScaleWorkerRead(d *schema.ResourceData, meta interfa
 
 	_, workers, err := service.DescribeClusterInstances(ctx, clusterId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			_, workers, err = service.DescribeClusterInstances(ctx, clusterId)
 
 			if e, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -434,7 +439,7 @@ func resourceTencentCloudTkeScaleWorkerRead(d *schema.ResourceData, meta interfa
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	newWorkerInstancesList := make([]map[string]interface{}, 0, len(workers))
@@ -466,25 +471,25 @@ func resourceTencentCloudTkeScaleWorkerRead(d *schema.ResourceData, meta interfa
 
 	_ = d.Set("labels", labelsMap)
 
-	return d.Set("worker_instances_list", newWorkerInstancesList)
+	return diag.FromErr(d.Set("worker_instances_list", newWorkerInstancesList))
 }
-func resourceTencentCloudTkeScaleWorkerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeScaleWorkerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	defer logElapsed("resource.tencentcloud_kubernetes_scale_worker.delete")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	clusterId := d.Get("cluster_id").(string)
 
 	if clusterId == "" {
-		return fmt.Errorf("`cluster_id` is empty.")
+		return diag.Errorf("`cluster_id` is empty.")
 	}
 
 	_, has, err := service.DescribeCluster(ctx, clusterId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			_, has, err = service.DescribeCluster(ctx, clusterId)
 			if err != nil {
 				return retryError(err)
@@ -509,11 +514,11 @@ func resourceTencentCloudTkeScaleWorkerDelete(d *schema.ResourceData, meta inter
 		infoMap, ok := v.(map[string]interface{})
 
 		if !ok || infoMap["instance_id"] == nil {
-			return fmt.Errorf("worker_instances_list is broken.")
+			return diag.Errorf("worker_instances_list is broken.")
 		}
 		instanceId, ok := infoMap["instance_id"].(string)
 		if !ok || instanceId == "" {
-			return fmt.Errorf("worker_instances_list.instance_id is broken.")
+			return diag.Errorf("worker_instances_list.instance_id is broken.")
 		}
 
 		if instanceMap[instanceId] {
@@ -526,7 +531,7 @@ func resourceTencentCloudTkeScaleWorkerDelete(d *schema.ResourceData, meta inter
 
 	_, workers, err := service.DescribeClusterInstances(ctx, clusterId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			_, workers, err = service.DescribeClusterInstances(ctx, clusterId)
 
 			if e, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -543,7 +548,7 @@ func resourceTencentCloudTkeScaleWorkerDelete(d *schema.ResourceData, meta inter
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	needDeletes := []string{}
@@ -559,7 +564,7 @@ func resourceTencentCloudTkeScaleWorkerDelete(d *schema.ResourceData, meta inter
 
 	err = service.DeleteClusterInstances(ctx, clusterId, needDeletes)
 	if err != nil {
-		err = resource.Retry(3*writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			err = service.DeleteClusterInstances(ctx, clusterId, needDeletes)
 
 			if e, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -579,5 +584,5 @@ func resourceTencentCloudTkeScaleWorkerDelete(d *schema.ResourceData, meta inter
 			return nil
 		})
 	}
-	return err
+	return diag.FromErr(err)
 }
@@ -114,8 +114,8 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
@@ -126,12 +126,12 @@ import (
 func resourceTkeServerLessNodePool() *schema.Resource {
 	return &schema.Resource{
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Read:   resourceTkeServerlessNodePoolRead,
-		Create: resourceTkeServerlessNodePoolCreate,
-		Update: resourceTkeServerlessNodePoolUpdate,
-		Delete: resourceTkeServerlessNodePoolDelete,
+		ReadContext:   resourceTkeServerlessNodePoolRead,
+		CreateContext: resourceTkeServerlessNodePoolCreate,
+		UpdateContext: resourceTkeServerlessNodePoolUpdate,
+		DeleteContext: resourceTkeServerlessNodePoolDelete,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -209,27 +209,27 @@ func resourceTkeServerLessNodePool() *schema.Resource {
 	}
 }
 
-func resourceTkeServerlessNodePoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTkeServerlessNodePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_eks_cluster.read")()
 
 	var (
-		items = strings.Split(d.Id(), FILED_SP)
+		items = helper.IdParse(d.Id())
 	)
 	if len(items) != 2 {
-		return fmt.Errorf("resource_tc_kubernetes_node_pool id  is broken")
+		return diag.Errorf("resource_tc_kubernetes_node_pool id  is broken")
 	}
 	clusterId := items[0]
 	nodePoolId := items[1]
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var (
 		nodePool *tke.VirtualNodePool
 		has      bool
 	)
 
-	outErr := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		var err error
 		nodePool, has, err = service.DescribeServerlessNodePoolByClusterIdAndNodePoolId(ctx, clusterId, nodePoolId)
 		if err != nil {
@@ -245,7 +245,7 @@ func resourceTkeServerlessNodePoolRead(d *schema.ResourceData, meta interface{})
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if !has {
@@ -254,17 +254,17 @@ func resourceTkeServerlessNodePoolRead(d *schema.ResourceData, meta interface{})
 	}
 
 	if err := setDataFromDescribeVirtualNodePoolResponse(clusterId, nodePool, d); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceTkeServerlessNodePoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTkeServerlessNodePoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_serverless_node_pool.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := TkeService{client: client}
 
@@ -273,26 +273,26 @@ func resourceTkeServerlessNodePoolCreate(d *schema.ResourceData, meta interface{
 	nodePoolId, err := service.CreateClusterVirtualNodePool(ctx, request)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterId := *request.ClusterId
-	d.SetId(clusterId + FILED_SP + nodePoolId)
+	d.SetId(helper.IdFormat(clusterId, nodePoolId))
 
-	return resourceTkeServerlessNodePoolRead(d, meta)
+	return resourceTkeServerlessNodePoolRead(ctx, d, meta)
 }
-func resourceTkeServerlessNodePoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTkeServerlessNodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// currently only name, labels and taints can be modified
 	defer logElapsed("resource.tencentcloud_kubernetes_serverless_node_pool.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
-		items = strings.Split(d.Id(), FILED_SP)
+		items = helper.IdParse(d.Id())
 	)
 	if len(items) != 2 {
-		return fmt.Errorf("resource_tc_kubernetes_node_pool id  is broken")
+		return diag.Errorf("resource_tc_kubernetes_node_pool id  is broken")
 	}
 	clusterId := items[0]
 	nodePoolId := items[1]
@@ -317,22 +317,22 @@ func resourceTkeServerlessNodePoolUpdate(d *schema.ResourceData, meta interface{
 	}
 
 	if err := service.ModifyClusterVirtualNodePool(ctx, request); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTkeServerlessNodePoolRead(d, meta)
+	return resourceTkeServerlessNodePoolRead(ctx, d, meta)
 }
-func resourceTkeServerlessNodePoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTkeServerlessNodePoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_kubernetes_serverless_node_pool.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
-		items = strings.Split(d.Id(), FILED_SP)
+		items = helper.IdParse(d.Id())
 	)
 	if len(items) != 2 {
-		return fmt.Errorf("resource_tc_kubernetes_node_pool id  is broken")
+		return diag.Errorf("resource_tc_kubernetes_node_pool id  is broken")
 	}
 	clusterId := items[0]
 	nodePoolId := items[1]
@@ -346,7 +346,7 @@ func resourceTkeServerlessNodePoolDelete(d *schema.ResourceData, meta interface{
 	request.Force = common.BoolPtr(true)
 
 	if err := service.DeleteClusterVirtualNodePool(ctx, request); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -385,7 +385,7 @@ func genCreateClusterVirtualNodePoolReq(d *schema.ResourceData) *tke.CreateClust
 }
 
 func setDataFromDescribeVirtualNodePoolResponse(clusterId string, res *tke.VirtualNodePool, d *schema.ResourceData) error {
-	d.SetId(clusterId + FILED_SP + *res.NodePoolId)
+	d.SetId(helper.IdFormat(clusterId, *res.NodePoolId))
 	_ = d.Set("name", res.Name)
 	_ = d.Set("life_state", res.LifeState)
 	labels := make(map[string]interface{})
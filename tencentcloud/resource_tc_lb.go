@@ -13,14 +13,23 @@ resource "tencentcloud_lb" "classic" {
   project_id = 0
 }
 ```
+
+Import
+
+CLB instance can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_lb.classic lb-7a0t6zqb
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
@@ -37,10 +46,18 @@ const (
 func resourceTencentCloudLB() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource has been deprecated in Terraform TencentCloud provider version 1.15.0. Please use 'tencentcloud_clb_instance' instead.",
-		Create:             resourceTencentCloudLBCreate,
-		Read:               resourceTencentCloudLBRead,
-		Update:             resourceTencentCloudLBUpdate,
-		Delete:             resourceTencentCloudLBDelete,
+		CreateContext:      resourceTencentCloudLBCreate,
+		ReadContext:        resourceTencentCloudLBRead,
+		UpdateContext:      resourceTencentCloudLBUpdate,
+		DeleteContext:      resourceTencentCloudLBDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"type": {
@@ -87,7 +104,7 @@ func resourceTencentCloudLB() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudLBCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLBCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb.create")()
 
 	clbActionMu.Lock()
@@ -121,8 +138,8 @@ func resourceTencentCloudLBCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	var response *clb.CreateLoadBalancerResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().CreateLoadBalancer(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().CreateLoadBalancerWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -132,7 +149,7 @@ func resourceTencentCloudLBCreate(d *schema.ResourceData, meta interface{}) erro
 				logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
 			requestId := *result.Response.RequestId
 
-			retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+			retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 			if retryErr != nil {
 				return retryError(retryErr)
 			}
@@ -142,29 +159,29 @@ func resourceTencentCloudLBCreate(d *schema.ResourceData, meta interface{}) erro
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create clb instance failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if len(response.Response.LoadBalancerIds) < 1 {
-		return fmt.Errorf("load balancer id is nil")
+		return diag.Errorf("load balancer id is nil")
 	}
 	d.SetId(*response.Response.LoadBalancerIds[0])
 
-	return resourceTencentCloudLBRead(d, meta)
+	return resourceTencentCloudLBRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLBRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLBRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	clbId := d.Id()
 	clbService := ClbService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 	var instance *clb.LoadBalancer
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, e := clbService.DescribeLoadBalancerById(ctx, clbId)
 		if e != nil {
 			return retryError(e)
@@ -174,7 +191,7 @@ func resourceTencentCloudLBRead(d *schema.ResourceData, meta interface{}) error
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read clb instance failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("type", instance.LoadBalancerType)
@@ -197,7 +214,7 @@ func resourceTencentCloudLBRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceTencentCloudLBUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLBUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb.update")()
 
 	clbActionMu.Lock()
@@ -216,8 +233,8 @@ func resourceTencentCloudLBUpdate(d *schema.ResourceData, meta interface{}) erro
 	request.LoadBalancerId = helper.String(clbId)
 	request.LoadBalancerName = helper.String(d.Get("name").(string))
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyLoadBalancerAttributes(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		response, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().ModifyLoadBalancerAttributesWithContext(ctx, request)
 
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -228,7 +245,7 @@ func resourceTencentCloudLBUpdate(d *schema.ResourceData, meta interface{}) erro
 				logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 			requestId := *response.Response.RequestId
 
-			retryErr := waitForTaskFinish(requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
+			retryErr := waitForTaskFinish(ctx, requestId, meta.(*TencentCloudClient).apiV3Conn.UseClbClient())
 			if retryErr != nil {
 				return retryError(retryErr)
 			}
@@ -237,28 +254,28 @@ func resourceTencentCloudLBUpdate(d *schema.ResourceData, meta interface{}) erro
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update clb instance failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudLBRead(d, meta)
+	return resourceTencentCloudLBRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLBDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLBDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_clb.delete")()
 
 	clbActionMu.Lock()
 	defer clbActionMu.Unlock()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	clbId := d.Id()
 	clbService := ClbService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := clbService.DeleteLoadBalancerById(ctx, clbId)
 		if e != nil {
 			log.Printf("[CRITAL]%s reason[%s]\n", logId, e.Error())
@@ -268,7 +285,7 @@ func resourceTencentCloudLBDelete(d *schema.ResourceData, meta interface{}) erro
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete clb instance failed, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
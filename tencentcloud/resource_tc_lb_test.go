@@ -38,6 +38,11 @@ func TestAccTencentCloudLB_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_lb.classic", "project_id"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_lb.classic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
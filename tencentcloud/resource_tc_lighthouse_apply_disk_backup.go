@@ -13,9 +13,11 @@ resource "tencentcloud_lighthouse_apply_disk_backup" "apply_disk_backup" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -24,9 +26,12 @@ import (
 
 func resourceTencentCloudLighthouseApplyDiskBackup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudLighthouseApplyDiskBackupCreate,
-		Read:   resourceTencentCloudLighthouseApplyDiskBackupRead,
-		Delete: resourceTencentCloudLighthouseApplyDiskBackupDelete,
+		CreateContext: resourceTencentCloudLighthouseApplyDiskBackupCreate,
+		ReadContext:   resourceTencentCloudLighthouseApplyDiskBackupRead,
+		DeleteContext: resourceTencentCloudLighthouseApplyDiskBackupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"disk_id": {
 				Required:    true,
@@ -45,7 +50,7 @@ func resourceTencentCloudLighthouseApplyDiskBackup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudLighthouseApplyDiskBackupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseApplyDiskBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_apply_disk_backup.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -66,8 +71,8 @@ func resourceTencentCloudLighthouseApplyDiskBackupCreate(d *schema.ResourceData,
 		request.DiskBackupId = helper.String(diskBackupId)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ApplyDiskBackup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ApplyDiskBackupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -77,30 +82,30 @@ func resourceTencentCloudLighthouseApplyDiskBackupCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate lighthouse applyDiskBackup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(diskId + FILED_SP + diskBackupId)
+	d.SetId(helper.IdFormat(diskId, diskBackupId))
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*readRetryTimeout, time.Second, service.LighthouseApplyDiskBackupStateRefreshFunc(diskBackupId, []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, d.Timeout(schema.TimeoutCreate), time.Second, service.LighthouseApplyDiskBackupStateRefreshFunc(diskBackupId, []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudLighthouseApplyDiskBackupRead(d, meta)
+	return resourceTencentCloudLighthouseApplyDiskBackupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseApplyDiskBackupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseApplyDiskBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_apply_disk_backup.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudLighthouseApplyDiskBackupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseApplyDiskBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_apply_disk_backup.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -13,9 +13,11 @@ resource "tencentcloud_lighthouse_apply_instance_snapshot" "apply_instance_snaps
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -24,9 +26,12 @@ import (
 
 func resourceTencentCloudLighthouseApplyInstanceSnapshot() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudLighthouseApplyInstanceSnapshotCreate,
-		Read:   resourceTencentCloudLighthouseApplyInstanceSnapshotRead,
-		Delete: resourceTencentCloudLighthouseApplyInstanceSnapshotDelete,
+		CreateContext: resourceTencentCloudLighthouseApplyInstanceSnapshotCreate,
+		ReadContext:   resourceTencentCloudLighthouseApplyInstanceSnapshotRead,
+		DeleteContext: resourceTencentCloudLighthouseApplyInstanceSnapshotDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -46,7 +51,7 @@ func resourceTencentCloudLighthouseApplyInstanceSnapshot() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudLighthouseApplyInstanceSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseApplyInstanceSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_apply_instance_snapshot.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -67,8 +72,8 @@ func resourceTencentCloudLighthouseApplyInstanceSnapshotCreate(d *schema.Resourc
 		request.SnapshotId = helper.String(snapshotId)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ApplyInstanceSnapshot(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ApplyInstanceSnapshotWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -78,30 +83,30 @@ func resourceTencentCloudLighthouseApplyInstanceSnapshotCreate(d *schema.Resourc
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate lighthouse applyInstanceSnapshot failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(instanceId + FILED_SP + snapshotId)
+	d.SetId(helper.IdFormat(instanceId, snapshotId))
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*readRetryTimeout, time.Second, service.LighthouseApplySnapshotStateRefreshFunc(snapshotId, []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, d.Timeout(schema.TimeoutCreate), time.Second, service.LighthouseApplySnapshotStateRefreshFunc(snapshotId, []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudLighthouseApplyInstanceSnapshotRead(d, meta)
+	return resourceTencentCloudLighthouseApplyInstanceSnapshotRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseApplyInstanceSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseApplyInstanceSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_apply_instance_snapshot.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudLighthouseApplyInstanceSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseApplyInstanceSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_apply_instance_snapshot.delete")()
 	defer inconsistentCheck(d, meta)()
 
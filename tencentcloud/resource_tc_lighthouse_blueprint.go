@@ -26,6 +26,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -34,12 +35,16 @@ import (
 
 func resourceTencentCloudLighthouseBlueprint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudLighthouseBlueprintCreate,
-		Read:   resourceTencentCloudLighthouseBlueprintRead,
-		Update: resourceTencentCloudLighthouseBlueprintUpdate,
-		Delete: resourceTencentCloudLighthouseBlueprintDelete,
+		CreateContext: resourceTencentCloudLighthouseBlueprintCreate,
+		ReadContext:   resourceTencentCloudLighthouseBlueprintRead,
+		UpdateContext: resourceTencentCloudLighthouseBlueprintUpdate,
+		DeleteContext: resourceTencentCloudLighthouseBlueprintDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"blueprint_name": {
@@ -63,7 +68,7 @@ func resourceTencentCloudLighthouseBlueprint() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudLighthouseBlueprintCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseBlueprintCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_blueprint.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -86,8 +91,8 @@ func resourceTencentCloudLighthouseBlueprintCreate(d *schema.ResourceData, meta
 		request.InstanceId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().CreateBlueprint(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().CreateBlueprintWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -98,7 +103,7 @@ func resourceTencentCloudLighthouseBlueprintCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create lighthouse blueprint failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	blueprintId = *response.Response.BlueprintId
@@ -106,22 +111,22 @@ func resourceTencentCloudLighthouseBlueprintCreate(d *schema.ResourceData, meta
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"NORMAL"}, 10*readRetryTimeout, time.Second, service.LighthouseBlueprintStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"NORMAL"}, d.Timeout(schema.TimeoutCreate), time.Second, service.LighthouseBlueprintStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudLighthouseBlueprintRead(d, meta)
+	return resourceTencentCloudLighthouseBlueprintRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseBlueprintRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseBlueprintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_blueprint.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -129,7 +134,7 @@ func resourceTencentCloudLighthouseBlueprintRead(d *schema.ResourceData, meta in
 
 	blueprint, err := service.DescribeLighthouseBlueprintById(ctx, blueprintId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if blueprint == nil {
@@ -149,7 +154,7 @@ func resourceTencentCloudLighthouseBlueprintRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTencentCloudLighthouseBlueprintUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseBlueprintUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_blueprint.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -173,8 +178,8 @@ func resourceTencentCloudLighthouseBlueprintUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ModifyBlueprintAttribute(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ModifyBlueprintAttributeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -184,24 +189,24 @@ func resourceTencentCloudLighthouseBlueprintUpdate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update lighthouse blueprint failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudLighthouseBlueprintRead(d, meta)
+	return resourceTencentCloudLighthouseBlueprintRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseBlueprintDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseBlueprintDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_blueprint.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 	blueprintId := d.Id()
 
 	if err := service.DeleteLighthouseBlueprintById(ctx, blueprintId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
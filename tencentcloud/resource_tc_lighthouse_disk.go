@@ -17,15 +17,23 @@ resource "tencentcloud_lighthouse_disk" "disk" {
   disk_name = "test"
 }
 ```
+
+Import
+
+lighthouse disk can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_lighthouse_disk.disk lhdisk-3ih7ebje
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -34,10 +42,18 @@ import (
 
 func resourceTencentCloudLighthouseDisk() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudLighthouseDiskCreate,
-		Read:   resourceTencentCloudLighthouseDiskRead,
-		Update: resourceTencentCloudLighthouseDiskUpdate,
-		Delete: resourceTencentCloudLighthouseDiskDelete,
+		CreateContext: resourceTencentCloudLighthouseDiskCreate,
+		ReadContext:   resourceTencentCloudLighthouseDiskRead,
+		UpdateContext: resourceTencentCloudLighthouseDiskUpdate,
+		DeleteContext: resourceTencentCloudLighthouseDiskDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"zone": {
 				Required:    true,
@@ -140,7 +156,7 @@ func resourceTencentCloudLighthouseDisk() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudLighthouseDiskCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseDiskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_disk.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -206,8 +222,8 @@ func resourceTencentCloudLighthouseDiskCreate(d *schema.ResourceData, meta inter
 		request.AutoMountConfiguration = &autoMountConfiguration
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().CreateDisks(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().CreateDisksWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -218,11 +234,11 @@ func resourceTencentCloudLighthouseDiskCreate(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create lighthouse disk failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if response == nil || response.Response == nil || len(response.Response.DiskIdSet) == 0 {
-		return fmt.Errorf("Response is null")
+		return diag.Errorf("Response is null")
 	}
 	diskIds := make([]string, 0, len(response.Response.DiskIdSet))
 	for _, diskId := range response.Response.DiskIdSet {
@@ -233,22 +249,22 @@ func resourceTencentCloudLighthouseDiskCreate(d *schema.ResourceData, meta inter
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"UNATTACHED", "ATTACHED"}, 20*readRetryTimeout, time.Second, service.LighthouseDiskStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"UNATTACHED", "ATTACHED"}, d.Timeout(schema.TimeoutCreate), time.Second, service.LighthouseDiskStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudLighthouseDiskRead(d, meta)
+	return resourceTencentCloudLighthouseDiskRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseDiskRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseDiskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_disk.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -256,7 +272,7 @@ func resourceTencentCloudLighthouseDiskRead(d *schema.ResourceData, meta interfa
 	diskId := diskIds[0]
 	disk, err := service.DescribeLighthouseDiskById(ctx, diskId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if disk == nil {
@@ -287,7 +303,7 @@ func resourceTencentCloudLighthouseDiskRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudLighthouseDiskUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseDiskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_disk.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -305,8 +321,8 @@ func resourceTencentCloudLighthouseDiskUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 	if changeAttribute {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ModifyDisksAttribute(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ModifyDisksAttributeWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -316,7 +332,7 @@ func resourceTencentCloudLighthouseDiskUpdate(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update lighthouse disk failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -330,8 +346,8 @@ func resourceTencentCloudLighthouseDiskUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 	if changeRenewFlag {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ModifyDisksRenewFlag(renewFlagRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ModifyDisksRenewFlagWithContext(ctx, renewFlagRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -341,35 +357,35 @@ func resourceTencentCloudLighthouseDiskUpdate(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update lighthouse disk failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudLighthouseDiskRead(d, meta)
+	return resourceTencentCloudLighthouseDiskRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseDiskDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseDiskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_disk.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 	diskId := d.Id()
 
 	if err := service.IsolateLighthouseDiskById(ctx, diskId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*readRetryTimeout, time.Second, service.LighthouseDiskLatestOperationRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, d.Timeout(schema.TimeoutDelete), time.Second, service.LighthouseDiskLatestOperationRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	if err := service.TerminateLighthouseDiskById(ctx, diskId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -25,6 +25,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -33,11 +34,15 @@ import (
 
 func resourceTencentCloudLighthouseDiskAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudLighthouseDiskAttachmentCreate,
-		Read:   resourceTencentCloudLighthouseDiskAttachmentRead,
-		Delete: resourceTencentCloudLighthouseDiskAttachmentDelete,
+		CreateContext: resourceTencentCloudLighthouseDiskAttachmentCreate,
+		ReadContext:   resourceTencentCloudLighthouseDiskAttachmentRead,
+		DeleteContext: resourceTencentCloudLighthouseDiskAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"disk_id": {
@@ -57,7 +62,7 @@ func resourceTencentCloudLighthouseDiskAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudLighthouseDiskAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseDiskAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_disk_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -78,8 +83,8 @@ func resourceTencentCloudLighthouseDiskAttachmentCreate(d *schema.ResourceData,
 		request.InstanceId = helper.String(instanceId)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().AttachDisks(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().AttachDisksWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -89,35 +94,35 @@ func resourceTencentCloudLighthouseDiskAttachmentCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create lighthouse diskAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(diskId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"ATTACHED"}, 20*readRetryTimeout, time.Second, service.LighthouseDiskStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"ATTACHED"}, d.Timeout(schema.TimeoutCreate), time.Second, service.LighthouseDiskStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudLighthouseDiskAttachmentRead(d, meta)
+	return resourceTencentCloudLighthouseDiskAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseDiskAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseDiskAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_disk_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	diskAttachment, err := service.DescribeLighthouseDiskById(ctx, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if diskAttachment == nil {
@@ -137,23 +142,23 @@ func resourceTencentCloudLighthouseDiskAttachmentRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudLighthouseDiskAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseDiskAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_disk_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	if err := service.DeleteLighthouseDiskAttachmentById(ctx, d.Id()); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	conf := BuildStateChangeConf([]string{}, []string{"UNATTACHED"}, 20*readRetryTimeout, time.Second, service.LighthouseDiskStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"UNATTACHED"}, d.Timeout(schema.TimeoutDelete), time.Second, service.LighthouseDiskStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	return nil
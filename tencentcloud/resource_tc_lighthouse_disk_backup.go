@@ -25,6 +25,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -33,12 +34,16 @@ import (
 
 func resourceTencentCloudLighthouseDiskBackup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudLighthouseDiskBackupCreate,
-		Read:   resourceTencentCloudLighthouseDiskBackupRead,
-		Update: resourceTencentCloudLighthouseDiskBackupUpdate,
-		Delete: resourceTencentCloudLighthouseDiskBackupDelete,
+		CreateContext: resourceTencentCloudLighthouseDiskBackupCreate,
+		ReadContext:   resourceTencentCloudLighthouseDiskBackupRead,
+		UpdateContext: resourceTencentCloudLighthouseDiskBackupUpdate,
+		DeleteContext: resourceTencentCloudLighthouseDiskBackupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"disk_id": {
@@ -57,7 +62,7 @@ func resourceTencentCloudLighthouseDiskBackup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudLighthouseDiskBackupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseDiskBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_disk_backup.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -76,8 +81,8 @@ func resourceTencentCloudLighthouseDiskBackupCreate(d *schema.ResourceData, meta
 		request.DiskBackupName = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().CreateDiskBackup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().CreateDiskBackupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -88,7 +93,7 @@ func resourceTencentCloudLighthouseDiskBackupCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create lighthouse diskBackup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	diskBackupId = *response.Response.DiskBackupId
@@ -96,22 +101,22 @@ func resourceTencentCloudLighthouseDiskBackupCreate(d *schema.ResourceData, meta
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"NORMAL"}, 20*readRetryTimeout, time.Second, service.LighthouseDiskBackupStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"NORMAL"}, d.Timeout(schema.TimeoutCreate), time.Second, service.LighthouseDiskBackupStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudLighthouseDiskBackupRead(d, meta)
+	return resourceTencentCloudLighthouseDiskBackupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseDiskBackupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseDiskBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_disk_backup.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -119,7 +124,7 @@ func resourceTencentCloudLighthouseDiskBackupRead(d *schema.ResourceData, meta i
 
 	diskBackup, err := service.DescribeLighthouseDiskBackupById(ctx, diskBackupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if diskBackup == nil {
@@ -139,7 +144,7 @@ func resourceTencentCloudLighthouseDiskBackupRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudLighthouseDiskBackupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseDiskBackupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_disk_backup.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -157,8 +162,8 @@ func resourceTencentCloudLighthouseDiskBackupUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ModifyDiskBackupsAttribute(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ModifyDiskBackupsAttributeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -168,24 +173,24 @@ func resourceTencentCloudLighthouseDiskBackupUpdate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update lighthouse diskBackup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudLighthouseDiskBackupRead(d, meta)
+	return resourceTencentCloudLighthouseDiskBackupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseDiskBackupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseDiskBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_disk_backup.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 	diskBackupId := d.Id()
 
 	if err := service.DeleteLighthouseDiskBackupById(ctx, diskBackupId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -38,7 +38,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -47,12 +49,17 @@ import (
 
 func resourceTencentCloudLighthouseFirewallRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudLighthouseFirewallRuleCreate,
-		Read:   resourceTencentCloudLighthouseFirewallRuleRead,
-		Update: resourceTencentCloudLighthouseFirewallRuleUpdate,
-		Delete: resourceTencentCloudLighthouseFirewallRuleDelete,
+		CreateContext: resourceTencentCloudLighthouseFirewallRuleCreate,
+		ReadContext:   resourceTencentCloudLighthouseFirewallRuleRead,
+		UpdateContext: resourceTencentCloudLighthouseFirewallRuleUpdate,
+		DeleteContext: resourceTencentCloudLighthouseFirewallRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -100,7 +107,7 @@ func resourceTencentCloudLighthouseFirewallRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudLighthouseFirewallRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_firewall_rule.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -138,8 +145,8 @@ func resourceTencentCloudLighthouseFirewallRuleCreate(d *schema.ResourceData, me
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().CreateFirewallRules(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().CreateFirewallRulesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -149,25 +156,25 @@ func resourceTencentCloudLighthouseFirewallRuleCreate(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create lighthouse firewallRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudLighthouseFirewallRuleRead(d, meta)
+	return resourceTencentCloudLighthouseFirewallRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseFirewallRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_firewall_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	firewallRules, err := service.DescribeLighthouseFirewallRuleById(ctx, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(firewallRules) == 0 {
@@ -210,7 +217,7 @@ func resourceTencentCloudLighthouseFirewallRuleRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudLighthouseFirewallRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseFirewallRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_firewall_rule.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -246,8 +253,8 @@ func resourceTencentCloudLighthouseFirewallRuleUpdate(d *schema.ResourceData, me
 	}
 	if hasChanged {
 		request.InstanceId = helper.String(d.Id())
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ModifyFirewallRules(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ModifyFirewallRulesWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -257,25 +264,25 @@ func resourceTencentCloudLighthouseFirewallRuleUpdate(d *schema.ResourceData, me
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update lighthouse firewallRule failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudLighthouseFirewallRuleRead(d, meta)
+	return resourceTencentCloudLighthouseFirewallRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseFirewallRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_firewall_rule.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	firewallRuleInfos, err := service.DescribeLighthouseFirewallRuleById(ctx, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(firewallRuleInfos) == 0 {
@@ -318,8 +325,8 @@ func resourceTencentCloudLighthouseFirewallRuleDelete(d *schema.ResourceData, me
 	request.InstanceId = helper.String(d.Id())
 	request.FirewallRules = firewallRuleList
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().DeleteFirewallRules(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().DeleteFirewallRulesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -329,7 +336,7 @@ func resourceTencentCloudLighthouseFirewallRuleDelete(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s deleted lighthouse firewallRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -35,9 +35,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -46,12 +47,16 @@ import (
 
 func resourceTencentCloudLighthouseFirewallTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudLighthouseFirewallTemplateCreate,
-		Read:   resourceTencentCloudLighthouseFirewallTemplateRead,
-		Update: resourceTencentCloudLighthouseFirewallTemplateUpdate,
-		Delete: resourceTencentCloudLighthouseFirewallTemplateDelete,
+		CreateContext: resourceTencentCloudLighthouseFirewallTemplateCreate,
+		ReadContext:   resourceTencentCloudLighthouseFirewallTemplateRead,
+		UpdateContext: resourceTencentCloudLighthouseFirewallTemplateUpdate,
+		DeleteContext: resourceTencentCloudLighthouseFirewallTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"template_name": {
@@ -98,7 +103,7 @@ func resourceTencentCloudLighthouseFirewallTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudLighthouseFirewallTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseFirewallTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_firewall_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -136,8 +141,8 @@ func resourceTencentCloudLighthouseFirewallTemplateCreate(d *schema.ResourceData
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().CreateFirewallTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().CreateFirewallTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -148,22 +153,22 @@ func resourceTencentCloudLighthouseFirewallTemplateCreate(d *schema.ResourceData
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create lighthouse firewallTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	templateId = *response.Response.TemplateId
 	d.SetId(templateId)
 
-	return resourceTencentCloudLighthouseFirewallTemplateRead(d, meta)
+	return resourceTencentCloudLighthouseFirewallTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseFirewallTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseFirewallTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_firewall_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -171,7 +176,7 @@ func resourceTencentCloudLighthouseFirewallTemplateRead(d *schema.ResourceData,
 
 	firewallTemplate, err := service.DescribeFirewallTemplateById(ctx, firewallTemplateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if firewallTemplate == nil {
@@ -185,7 +190,7 @@ func resourceTencentCloudLighthouseFirewallTemplateRead(d *schema.ResourceData,
 	}
 	templateRules, err := service.DescribeFirewallTemplateRulesById(ctx, firewallTemplateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	templateRulesList := []interface{}{}
 	for _, templateRule := range templateRules {
@@ -219,7 +224,7 @@ func resourceTencentCloudLighthouseFirewallTemplateRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudLighthouseFirewallTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseFirewallTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_firewall_template.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -235,7 +240,7 @@ func resourceTencentCloudLighthouseFirewallTemplateUpdate(d *schema.ResourceData
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -245,8 +250,8 @@ func resourceTencentCloudLighthouseFirewallTemplateUpdate(d *schema.ResourceData
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ModifyFirewallTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ModifyFirewallTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -256,24 +261,24 @@ func resourceTencentCloudLighthouseFirewallTemplateUpdate(d *schema.ResourceData
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update lighthouse firewallTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudLighthouseFirewallTemplateRead(d, meta)
+	return resourceTencentCloudLighthouseFirewallTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseFirewallTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseFirewallTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_firewall_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 	firewallTemplateId := d.Id()
 
 	if err := service.DeleteFirewallTemplateById(ctx, firewallTemplateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -76,6 +76,13 @@ resource "tencentcloud_lighthouse_instance" "lighthouse" {
 }
 ```
 
+Import
+
+Lighthouse instance can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_lighthouse_instance.lighthouse lhins-hwe21u91
+```
 */
 package tencentcloud
 
@@ -87,6 +94,7 @@ import (
 
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -94,10 +102,18 @@ import (
 
 func resourceTencentCloudLighthouseInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudLighthouseInstanceCreate,
-		Read:   resourceTencentCloudLighthouseInstanceRead,
-		Delete: resourceTencentCloudLighthouseInstanceDelete,
-		Update: resourceTencentCloudLighthouseInstanceUpdate,
+		CreateContext: resourceTencentCloudLighthouseInstanceCreate,
+		ReadContext:   resourceTencentCloudLighthouseInstanceRead,
+		DeleteContext: resourceTencentCloudLighthouseInstanceDelete,
+		UpdateContext: resourceTencentCloudLighthouseInstanceUpdate,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"bundle_id": {
 				Type:        schema.TypeString,
@@ -283,7 +299,7 @@ func resourceTencentCloudLighthouseInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudLighthouseInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -404,19 +420,19 @@ func resourceTencentCloudLighthouseInstanceCreate(d *schema.ResourceData, meta i
 		request.FirewallTemplateId = helper.String(v.(string))
 	}
 
-	result, err := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().CreateInstances(request)
+	result, err := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().CreateInstancesWithContext(ctx, request)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create lighthouse instance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId = *result.Response.InstanceIdSet[0]
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	lighthouseService := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, errRet := lighthouseService.DescribeLighthouseInstanceById(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -430,20 +446,20 @@ func resourceTencentCloudLighthouseInstanceCreate(d *schema.ResourceData, meta i
 		return resource.RetryableError(fmt.Errorf("lighthouse instance status is %s, retry...", *instance.InstanceState))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudLighthouseInstanceRead(d, meta)
+	return resourceTencentCloudLighthouseInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	lighthouseService := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	id := d.Id()
@@ -451,12 +467,12 @@ func resourceTencentCloudLighthouseInstanceRead(d *schema.ResourceData, meta int
 	instance, err := lighthouseService.DescribeLighthouseInstanceById(ctx, id)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `lighthouse instance` %s does not exist", id)
+		return diag.Errorf("resource `lighthouse instance` %s does not exist", id)
 	}
 
 	if instance.BundleId != nil {
@@ -482,7 +498,7 @@ func resourceTencentCloudLighthouseInstanceRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudLighthouseInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -496,7 +512,7 @@ func resourceTencentCloudLighthouseInstanceUpdate(d *schema.ResourceData, meta i
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -508,8 +524,8 @@ func resourceTencentCloudLighthouseInstanceUpdate(d *schema.ResourceData, meta i
 		if v, ok := d.GetOk("instance_name"); ok {
 			request.InstanceName = helper.String(v.(string))
 		}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ModifyInstancesAttribute(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ModifyInstancesAttributeWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -520,12 +536,12 @@ func resourceTencentCloudLighthouseInstanceUpdate(d *schema.ResourceData, meta i
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
-		err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			instance, errRet := service.DescribeLighthouseInstanceById(ctx, id)
 			if errRet != nil {
 				return retryError(errRet, InternalError)
@@ -543,7 +559,7 @@ func resourceTencentCloudLighthouseInstanceUpdate(d *schema.ResourceData, meta i
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -554,8 +570,8 @@ func resourceTencentCloudLighthouseInstanceUpdate(d *schema.ResourceData, meta i
 		request.BundleId = helper.String(new.(string))
 		autoVoucher := d.Get("is_update_bundle_id_auto_voucher").(bool)
 		request.AutoVoucher = &autoVoucher
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ModifyInstancesBundle(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ModifyInstancesBundleWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -565,15 +581,15 @@ func resourceTencentCloudLighthouseInstanceUpdate(d *schema.ResourceData, meta i
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update lighthouse instanceModifyBundle failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-		conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*readRetryTimeout, time.Second, service.LighthouseInstanceStateRefreshFunc(id, []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.LighthouseInstanceStateRefreshFunc(id, []string{}))
 
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -583,8 +599,8 @@ func resourceTencentCloudLighthouseInstanceUpdate(d *schema.ResourceData, meta i
 		request.InstanceId = helper.String(id)
 		request.BlueprintId = helper.String(new.(string))
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ResetInstance(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ResetInstanceWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -594,22 +610,22 @@ func resourceTencentCloudLighthouseInstanceUpdate(d *schema.ResourceData, meta i
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s operate lighthouse resetInstance failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-		conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*readRetryTimeout, time.Second, service.LighthouseInstanceStateRefreshFunc(id, []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.LighthouseInstanceStateRefreshFunc(id, []string{}))
 
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
 	if d.HasChange("period") {
 		old, _ := d.GetChange("period")
 		_ = d.Set("period", old)
-		return fmt.Errorf("`period` do not support change now.")
+		return diag.Errorf("`period` do not support change now.")
 	}
 
 	if d.HasChange("renew_flag") {
@@ -617,8 +633,8 @@ func resourceTencentCloudLighthouseInstanceUpdate(d *schema.ResourceData, meta i
 		request := lighthouse.NewModifyInstancesRenewFlagRequest()
 		request.InstanceIds = helper.StringsStringsPoint([]string{id})
 		request.RenewFlag = helper.String(new.(string))
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ModifyInstancesRenewFlag(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ModifyInstancesRenewFlagWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -628,39 +644,39 @@ func resourceTencentCloudLighthouseInstanceUpdate(d *schema.ResourceData, meta i
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s operate lighthouse modifyInstanceRenewFlag failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 		service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-		conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*readRetryTimeout, time.Second, service.LighthouseInstanceStateRefreshFunc(id, []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.LighthouseInstanceStateRefreshFunc(id, []string{}))
 
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
 	if d.HasChange("zone") {
 		old, _ := d.GetChange("zone")
 		_ = d.Set("zone", old)
-		return fmt.Errorf("`zone` do not support change now.")
+		return diag.Errorf("`zone` do not support change now.")
 	}
 
 	if d.HasChange("dry_run") {
 		old, _ := d.GetChange("dry_run")
 		_ = d.Set("dry_run", old)
-		return fmt.Errorf("`dry_run` do not support change now.")
+		return diag.Errorf("`dry_run` do not support change now.")
 	}
 
 	if d.HasChange("client_token") {
 		old, _ := d.GetChange("client_token")
 		_ = d.Set("client_token", old)
-		return fmt.Errorf("`client_token` do not support change now.")
+		return diag.Errorf("`client_token` do not support change now.")
 	}
 
 	if d.HasChange("login_configuration.0.auto_generate_password") {
 		old, _ := d.GetChange("login_configuration")
 		_ = d.Set("login_configuration", old)
-		return fmt.Errorf("`auto_generate_password` do not support change now.")
+		return diag.Errorf("`auto_generate_password` do not support change now.")
 	}
 	if d.HasChange("login_configuration.0.password") {
 		_, new := d.GetChange("login_configuration")
@@ -672,8 +688,8 @@ func resourceTencentCloudLighthouseInstanceUpdate(d *schema.ResourceData, meta i
 		request := lighthouse.NewResetInstancesPasswordRequest()
 		request.InstanceIds = helper.StringsStringsPoint([]string{id})
 		request.Password = helper.String(newPassword)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ResetInstancesPassword(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ResetInstancesPasswordWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -683,14 +699,14 @@ func resourceTencentCloudLighthouseInstanceUpdate(d *schema.ResourceData, meta i
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s operate lighthouse resetInstancesPassword failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 		service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-		conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*readRetryTimeout, time.Second, service.LighthouseInstanceStateRefreshFunc(id, []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.LighthouseInstanceStateRefreshFunc(id, []string{}))
 
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 
 		_ = d.Set("login_configuration", new)
@@ -699,33 +715,33 @@ func resourceTencentCloudLighthouseInstanceUpdate(d *schema.ResourceData, meta i
 	if d.HasChange("containers") {
 		old, _ := d.GetChange("containers")
 		_ = d.Set("containers", old)
-		return fmt.Errorf("`containers` do not support change now.")
+		return diag.Errorf("`containers` do not support change now.")
 	}
 
-	return resourceTencentCloudLighthouseInstanceRead(d, meta)
+	return resourceTencentCloudLighthouseInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 	id := d.Id()
 	isolateDataDisk := d.Get("isolate_data_disk").(bool)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if err := service.IsolateLighthouseInstanceById(ctx, id, isolateDataDisk); err != nil {
 			return retryError(err)
 		}
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		instance, errRet := service.DescribeLighthouseInstanceById(ctx, id)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -743,11 +759,11 @@ func resourceTencentCloudLighthouseInstanceDelete(d *schema.ResourceData, meta i
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := service.DeleteLighthouseInstanceById(ctx, id); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -32,11 +33,11 @@ import (
 
 func resourceTencentCloudLighthouseKeyPair() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudLighthouseKeyPairCreate,
-		Read:   resourceTencentCloudLighthouseKeyPairRead,
-		Delete: resourceTencentCloudLighthouseKeyPairDelete,
+		CreateContext: resourceTencentCloudLighthouseKeyPairCreate,
+		ReadContext:   resourceTencentCloudLighthouseKeyPairRead,
+		DeleteContext: resourceTencentCloudLighthouseKeyPairDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"key_name": {
@@ -71,8 +72,8 @@ func createKeyPair(ctx context.Context, d *schema.ResourceData, meta interface{}
 	request := lighthouse.NewCreateKeyPairRequest()
 	response := lighthouse.NewCreateKeyPairResponse()
 	request.KeyName = helper.String(d.Get("key_name").(string))
-	innerErr := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().CreateKeyPair(request)
+	innerErr := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().CreateKeyPairWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -100,8 +101,8 @@ func createKeyPairByImportPublicKey(ctx context.Context, d *schema.ResourceData,
 	response := lighthouse.NewImportKeyPairResponse()
 	request.KeyName = helper.String(d.Get("key_name").(string))
 	request.PublicKey = helper.String(d.Get("public_key").(string))
-	innerErr := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ImportKeyPair(request)
+	innerErr := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ImportKeyPairWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -124,7 +125,7 @@ func createKeyPairByImportPublicKey(ctx context.Context, d *schema.ResourceData,
 	return
 }
 
-func resourceTencentCloudLighthouseKeyPairCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseKeyPairCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_key_pair.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -133,7 +134,7 @@ func resourceTencentCloudLighthouseKeyPairCreate(d *schema.ResourceData, meta in
 		err   error
 	)
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if _, ok := d.GetOk("public_key"); ok {
 		keyId, err = createKeyPairByImportPublicKey(ctx, d, meta)
@@ -141,20 +142,20 @@ func resourceTencentCloudLighthouseKeyPairCreate(d *schema.ResourceData, meta in
 		keyId, err = createKeyPair(ctx, d, meta)
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(keyId)
-	return resourceTencentCloudLighthouseKeyPairRead(d, meta)
+	return resourceTencentCloudLighthouseKeyPairRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseKeyPairRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseKeyPairRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_key_pair.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -162,7 +163,7 @@ func resourceTencentCloudLighthouseKeyPairRead(d *schema.ResourceData, meta inte
 
 	keyPair, err := service.DescribeLighthouseKeyPairById(ctx, keyId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if keyPair == nil {
@@ -179,18 +180,18 @@ func resourceTencentCloudLighthouseKeyPairRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudLighthouseKeyPairDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseKeyPairDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_key_pair.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 	keyId := d.Id()
 
 	if err := service.DeleteLighthouseKeyPairById(ctx, keyId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
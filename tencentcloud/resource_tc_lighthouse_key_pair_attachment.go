@@ -22,23 +22,27 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudLighthouseKeyPairAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudLighthouseKeyPairAttachmentCreate,
-		Read:   resourceTencentCloudLighthouseKeyPairAttachmentRead,
-		Delete: resourceTencentCloudLighthouseKeyPairAttachmentDelete,
+		CreateContext: resourceTencentCloudLighthouseKeyPairAttachmentCreate,
+		ReadContext:   resourceTencentCloudLighthouseKeyPairAttachmentRead,
+		DeleteContext: resourceTencentCloudLighthouseKeyPairAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"key_id": {
@@ -58,7 +62,7 @@ func resourceTencentCloudLighthouseKeyPairAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudLighthouseKeyPairAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseKeyPairAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_key_pair_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -74,8 +78,8 @@ func resourceTencentCloudLighthouseKeyPairAttachmentCreate(d *schema.ResourceDat
 	request.KeyIds = []*string{&keyId}
 	request.InstanceIds = []*string{&instanceId}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().AssociateInstancesKeyPairs(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().AssociateInstancesKeyPairsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -85,42 +89,42 @@ func resourceTencentCloudLighthouseKeyPairAttachmentCreate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create lighthouse keyPairAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(keyId + FILED_SP + instanceId)
+	d.SetId(helper.IdFormat(keyId, instanceId))
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*readRetryTimeout, time.Second, service.LighthouseInstanceStateRefreshFunc(instanceId, []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, d.Timeout(schema.TimeoutCreate), time.Second, service.LighthouseInstanceStateRefreshFunc(instanceId, []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudLighthouseKeyPairAttachmentRead(d, meta)
+	return resourceTencentCloudLighthouseKeyPairAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseKeyPairAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseKeyPairAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_key_pair_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	keyId := idSplit[0]
 	instanceId := idSplit[1]
 
 	keyPairAttachment, err := service.DescribeLighthouseKeyPairAttachmentById(ctx, keyId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if keyPairAttachment == nil {
@@ -145,29 +149,29 @@ func resourceTencentCloudLighthouseKeyPairAttachmentRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudLighthouseKeyPairAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseKeyPairAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_key_pair_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	keyId := idSplit[0]
 	instanceId := idSplit[1]
 
 	if err := service.DeleteLighthouseKeyPairAttachmentById(ctx, keyId, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*readRetryTimeout, time.Second, service.LighthouseInstanceStateRefreshFunc(instanceId, []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, d.Timeout(schema.TimeoutDelete), time.Second, service.LighthouseInstanceStateRefreshFunc(instanceId, []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	return nil
@@ -12,9 +12,11 @@ resource "tencentcloud_lighthouse_reboot_instance" "reboot_instance" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -22,9 +24,12 @@ import (
 
 func resourceTencentCloudLighthouseRebootInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudLighthouseRebootInstanceCreate,
-		Read:   resourceTencentCloudLighthouseRebootInstanceRead,
-		Delete: resourceTencentCloudLighthouseRebootInstanceDelete,
+		CreateContext: resourceTencentCloudLighthouseRebootInstanceCreate,
+		ReadContext:   resourceTencentCloudLighthouseRebootInstanceRead,
+		DeleteContext: resourceTencentCloudLighthouseRebootInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -36,7 +41,7 @@ func resourceTencentCloudLighthouseRebootInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudLighthouseRebootInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseRebootInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_reboot_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -48,8 +53,8 @@ func resourceTencentCloudLighthouseRebootInstanceCreate(d *schema.ResourceData,
 	instanceId := d.Get("instance_id").(string)
 	request.InstanceIds = []*string{&instanceId}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().RebootInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().RebootInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -59,30 +64,30 @@ func resourceTencentCloudLighthouseRebootInstanceCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate lighthouse rebootInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*readRetryTimeout, time.Second, service.LighthouseInstanceStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, d.Timeout(schema.TimeoutCreate), time.Second, service.LighthouseInstanceStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudLighthouseRebootInstanceRead(d, meta)
+	return resourceTencentCloudLighthouseRebootInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseRebootInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseRebootInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_reboot_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudLighthouseRebootInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseRebootInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_reboot_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
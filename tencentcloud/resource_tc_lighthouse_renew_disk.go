@@ -18,9 +18,11 @@ resource "tencentcloud_lighthouse_renew_disk" "renew_disk" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -29,9 +31,12 @@ import (
 
 func resourceTencentCloudLighthouseRenewDisk() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudLighthouseRenewDiskCreate,
-		Read:   resourceTencentCloudLighthouseRenewDiskRead,
-		Delete: resourceTencentCloudLighthouseRenewDiskDelete,
+		CreateContext: resourceTencentCloudLighthouseRenewDiskCreate,
+		ReadContext:   resourceTencentCloudLighthouseRenewDiskRead,
+		DeleteContext: resourceTencentCloudLighthouseRenewDiskDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"disk_id": {
 				Required:    true,
@@ -82,7 +87,7 @@ func resourceTencentCloudLighthouseRenewDisk() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudLighthouseRenewDiskCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseRenewDiskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_renew_disk.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -118,8 +123,8 @@ func resourceTencentCloudLighthouseRenewDiskCreate(d *schema.ResourceData, meta
 		request.AutoVoucher = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().RenewDisks(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().RenewDisksWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -129,30 +134,30 @@ func resourceTencentCloudLighthouseRenewDiskCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate lighthouse renewDisks failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(diskId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*readRetryTimeout, time.Second, service.LighthouseDiskLatestOperationRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, d.Timeout(schema.TimeoutCreate), time.Second, service.LighthouseDiskLatestOperationRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudLighthouseRenewDiskRead(d, meta)
+	return resourceTencentCloudLighthouseRenewDiskRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseRenewDiskRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseRenewDiskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_renew_disk.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudLighthouseRenewDiskDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseRenewDiskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_renew_disk.delete")()
 	defer inconsistentCheck(d, meta)()
 
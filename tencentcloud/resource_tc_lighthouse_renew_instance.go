@@ -19,9 +19,11 @@ resource "tencentcloud_lighthouse_renew_instance" "renew_instance" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -30,11 +32,14 @@ import (
 
 func resourceTencentCloudLighthouseRenewInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudLighthouseRenewInstanceCreate,
-		Read:   resourceTencentCloudLighthouseRenewInstanceRead,
-		Delete: resourceTencentCloudLighthouseRenewInstanceDelete,
+		CreateContext: resourceTencentCloudLighthouseRenewInstanceCreate,
+		ReadContext:   resourceTencentCloudLighthouseRenewInstanceRead,
+		DeleteContext: resourceTencentCloudLighthouseRenewInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -89,7 +94,7 @@ func resourceTencentCloudLighthouseRenewInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudLighthouseRenewInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseRenewInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_renew_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -123,8 +128,8 @@ func resourceTencentCloudLighthouseRenewInstanceCreate(d *schema.ResourceData, m
 		request.AutoVoucher = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().RenewInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().RenewInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -134,30 +139,30 @@ func resourceTencentCloudLighthouseRenewInstanceCreate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate lighthouse renewInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*readRetryTimeout, time.Second, service.LighthouseInstanceStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, d.Timeout(schema.TimeoutCreate), time.Second, service.LighthouseInstanceStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudLighthouseRenewInstanceRead(d, meta)
+	return resourceTencentCloudLighthouseRenewInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseRenewInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseRenewInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_renew_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudLighthouseRenewInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseRenewInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_renew_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -9,6 +9,14 @@ resource "tencentcloud_lighthouse_snapshot" "snapshot" {
   snapshot_name = "snap_20200903"
 }
 ```
+
+Import
+
+lighthouse snapshot can be imported using the id `instance_id#snapshot_id`, e.g.
+
+```
+$ terraform import tencentcloud_lighthouse_snapshot.snapshot lhins-acd1234#lhsnap-mdc9d4ox
+```
 */
 package tencentcloud
 
@@ -17,6 +25,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -25,10 +34,17 @@ import (
 
 func resourceTencentCloudLighthouseSnapshot() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudLighthouseSnapshotCreate,
-		Read:   resourceTencentCloudLighthouseSnapshotRead,
-		Update: resourceTencentCloudLighthouseSnapshotUpdate,
-		Delete: resourceTencentCloudLighthouseSnapshotDelete,
+		CreateContext: resourceTencentCloudLighthouseSnapshotCreate,
+		ReadContext:   resourceTencentCloudLighthouseSnapshotRead,
+		UpdateContext: resourceTencentCloudLighthouseSnapshotUpdate,
+		DeleteContext: resourceTencentCloudLighthouseSnapshotDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: helper.ImportWithArguments("instance_id"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -47,7 +63,7 @@ func resourceTencentCloudLighthouseSnapshot() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudLighthouseSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_snapshot.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -64,8 +80,8 @@ func resourceTencentCloudLighthouseSnapshotCreate(d *schema.ResourceData, meta i
 		request.SnapshotName = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().CreateInstanceSnapshot(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().CreateInstanceSnapshotWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -76,7 +92,7 @@ func resourceTencentCloudLighthouseSnapshotCreate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create lighthouse snapshot failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	snapshotId = *response.Response.SnapshotId
@@ -84,22 +100,22 @@ func resourceTencentCloudLighthouseSnapshotCreate(d *schema.ResourceData, meta i
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"NORMAL"}, 20*readRetryTimeout, time.Second, service.LighthouseSnapshotStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"NORMAL"}, d.Timeout(schema.TimeoutCreate), time.Second, service.LighthouseSnapshotStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudLighthouseSnapshotRead(d, meta)
+	return resourceTencentCloudLighthouseSnapshotRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_snapshot.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -107,7 +123,7 @@ func resourceTencentCloudLighthouseSnapshotRead(d *schema.ResourceData, meta int
 
 	snapshot, err := service.DescribeLighthouseSnapshotById(ctx, snapshotId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if snapshot == nil {
@@ -123,7 +139,7 @@ func resourceTencentCloudLighthouseSnapshotRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudLighthouseSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_snapshot.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -141,8 +157,8 @@ func resourceTencentCloudLighthouseSnapshotUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ModifySnapshotAttribute(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().ModifySnapshotAttributeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -152,24 +168,24 @@ func resourceTencentCloudLighthouseSnapshotUpdate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update lighthouse snapshot failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudLighthouseSnapshotRead(d, meta)
+	return resourceTencentCloudLighthouseSnapshotRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_snapshot.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 	snapshotId := d.Id()
 
 	if err := service.DeleteLighthouseSnapshotById(ctx, snapshotId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
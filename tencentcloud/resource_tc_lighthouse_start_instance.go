@@ -12,9 +12,11 @@ resource "tencentcloud_lighthouse_start_instance" "start_instance" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -22,9 +24,12 @@ import (
 
 func resourceTencentCloudLighthouseStartInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudLighthouseStartInstanceCreate,
-		Read:   resourceTencentCloudLighthouseStartInstanceRead,
-		Delete: resourceTencentCloudLighthouseStartInstanceDelete,
+		CreateContext: resourceTencentCloudLighthouseStartInstanceCreate,
+		ReadContext:   resourceTencentCloudLighthouseStartInstanceRead,
+		DeleteContext: resourceTencentCloudLighthouseStartInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -36,7 +41,7 @@ func resourceTencentCloudLighthouseStartInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudLighthouseStartInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseStartInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_start_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -45,8 +50,8 @@ func resourceTencentCloudLighthouseStartInstanceCreate(d *schema.ResourceData, m
 	request := lighthouse.NewStartInstancesRequest()
 	instanceId := d.Get("instance_id").(string)
 	request.InstanceIds = []*string{&instanceId}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().StartInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().StartInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -56,30 +61,30 @@ func resourceTencentCloudLighthouseStartInstanceCreate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate lighthouse startInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*readRetryTimeout, time.Second, service.LighthouseInstanceStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, d.Timeout(schema.TimeoutCreate), time.Second, service.LighthouseInstanceStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudLighthouseStartInstanceRead(d, meta)
+	return resourceTencentCloudLighthouseStartInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseStartInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseStartInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_start_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudLighthouseStartInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseStartInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_start_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -12,9 +12,11 @@ resource "tencentcloud_lighthouse_stop_instance" "stop_instance" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
@@ -22,9 +24,12 @@ import (
 
 func resourceTencentCloudLighthouseStopInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudLighthouseStopInstanceCreate,
-		Read:   resourceTencentCloudLighthouseStopInstanceRead,
-		Delete: resourceTencentCloudLighthouseStopInstanceDelete,
+		CreateContext: resourceTencentCloudLighthouseStopInstanceCreate,
+		ReadContext:   resourceTencentCloudLighthouseStopInstanceRead,
+		DeleteContext: resourceTencentCloudLighthouseStopInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -36,7 +41,7 @@ func resourceTencentCloudLighthouseStopInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudLighthouseStopInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseStopInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_stop_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -45,8 +50,8 @@ func resourceTencentCloudLighthouseStopInstanceCreate(d *schema.ResourceData, me
 	request := lighthouse.NewStopInstancesRequest()
 	instanceId := d.Get("instance_id").(string)
 	request.InstanceIds = []*string{&instanceId}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().StopInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseLighthouseClient().StopInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -56,30 +61,30 @@ func resourceTencentCloudLighthouseStopInstanceCreate(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate lighthouse stopInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
 	service := LightHouseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*readRetryTimeout, time.Second, service.LighthouseInstanceStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, d.Timeout(schema.TimeoutCreate), time.Second, service.LighthouseInstanceStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudLighthouseStopInstanceRead(d, meta)
+	return resourceTencentCloudLighthouseStopInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudLighthouseStopInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseStopInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_stop_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudLighthouseStopInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudLighthouseStopInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_lighthouse_stop_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -25,10 +25,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -37,12 +37,16 @@ import (
 
 func resourceTencentCloudMariadbAccount() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMariadbAccountRead,
-		Create: resourceTencentCloudMariadbAccountCreate,
-		Update: resourceTencentCloudMariadbAccountUpdate,
-		Delete: resourceTencentCloudMariadbAccountDelete,
+		ReadContext:   resourceTencentCloudMariadbAccountRead,
+		CreateContext: resourceTencentCloudMariadbAccountCreate,
+		UpdateContext: resourceTencentCloudMariadbAccountUpdate,
+		DeleteContext: resourceTencentCloudMariadbAccountDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -85,7 +89,7 @@ func resourceTencentCloudMariadbAccount() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMariadbAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_account.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -125,8 +129,8 @@ func resourceTencentCloudMariadbAccountCreate(d *schema.ResourceData, meta inter
 		request.Description = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().CreateAccount(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().CreateAccountWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -138,25 +142,25 @@ func resourceTencentCloudMariadbAccountCreate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mariadb account failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(instanceId + FILED_SP + userName + FILED_SP + host)
-	return resourceTencentCloudMariadbAccountRead(d, meta)
+	d.SetId(helper.IdFormat(instanceId, userName, host))
+	return resourceTencentCloudMariadbAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_account.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	userName := idSplit[1]
@@ -165,12 +169,12 @@ func resourceTencentCloudMariadbAccountRead(d *schema.ResourceData, meta interfa
 	account, err := service.DescribeMariadbAccount(ctx, instanceId, userName, host)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if account == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `account` %s does not exist", userName)
+		return diag.Errorf("resource `account` %s does not exist", userName)
 	}
 
 	_ = d.Set("instance_id", instanceId)
@@ -192,7 +196,7 @@ func resourceTencentCloudMariadbAccountRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudMariadbAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_account.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -200,9 +204,9 @@ func resourceTencentCloudMariadbAccountUpdate(d *schema.ResourceData, meta inter
 
 	request := mariadb.NewModifyAccountDescriptionRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	userName := idSplit[1]
@@ -213,19 +217,19 @@ func resourceTencentCloudMariadbAccountUpdate(d *schema.ResourceData, meta inter
 	request.Host = &host
 
 	if d.HasChange("instance_id") {
-		return fmt.Errorf("`instance_id` do not support change now.")
+		return diag.Errorf("`instance_id` do not support change now.")
 	}
 
 	if d.HasChange("user_name") {
-		return fmt.Errorf("`user_name` do not support change now.")
+		return diag.Errorf("`user_name` do not support change now.")
 	}
 
 	if d.HasChange("host") {
-		return fmt.Errorf("`host` do not support change now.")
+		return diag.Errorf("`host` do not support change now.")
 	}
 
 	if d.HasChange("read_only") {
-		return fmt.Errorf("`read_only` do not support change now.")
+		return diag.Errorf("`read_only` do not support change now.")
 	}
 
 	if d.HasChange("description") {
@@ -233,8 +237,8 @@ func resourceTencentCloudMariadbAccountUpdate(d *schema.ResourceData, meta inter
 			request.Description = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyAccountDescription(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyAccountDescriptionWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -246,7 +250,7 @@ func resourceTencentCloudMariadbAccountUpdate(d *schema.ResourceData, meta inter
 
 		if err != nil {
 			log.Printf("[CRITAL]%s create mariadb account failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -267,8 +271,8 @@ func resourceTencentCloudMariadbAccountUpdate(d *schema.ResourceData, meta inter
 
 		PwdRequest.InstanceId = &instanceId
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ResetAccountPassword(PwdRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ResetAccountPasswordWithContext(ctx, PwdRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -280,33 +284,33 @@ func resourceTencentCloudMariadbAccountUpdate(d *schema.ResourceData, meta inter
 
 		if err != nil {
 			log.Printf("[CRITAL]%s operate mariadb resetPassword failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
-	return resourceTencentCloudMariadbAccountRead(d, meta)
+	return resourceTencentCloudMariadbAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_account.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	userName := idSplit[1]
 	host := idSplit[2]
 
 	if err := service.DeleteMariadbAccountById(ctx, instanceId, userName, host); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -28,8 +28,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
@@ -39,12 +40,15 @@ import (
 
 func resourceTencentCloudMariadbAccountPrivileges() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMariadbAccountPrivilegesCreate,
-		Read:   resourceTencentCloudMariadbAccountPrivilegesRead,
-		Update: resourceTencentCloudMariadbAccountPrivilegesUpdate,
-		Delete: resourceTencentCloudMariadbAccountPrivilegesDelete,
+		CreateContext: resourceTencentCloudMariadbAccountPrivilegesCreate,
+		ReadContext:   resourceTencentCloudMariadbAccountPrivilegesRead,
+		UpdateContext: resourceTencentCloudMariadbAccountPrivilegesUpdate,
+		DeleteContext: resourceTencentCloudMariadbAccountPrivilegesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(50 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -234,7 +238,7 @@ func resourceTencentCloudMariadbAccountPrivileges() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMariadbAccountPrivilegesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbAccountPrivilegesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_account_privileges.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -260,24 +264,24 @@ func resourceTencentCloudMariadbAccountPrivilegesCreate(d *schema.ResourceData,
 		}
 	}
 
-	d.SetId(strings.Join([]string{instanceId, User, Host}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, User, Host))
 
-	return resourceTencentCloudMariadbAccountPrivilegesUpdate(d, meta)
+	return resourceTencentCloudMariadbAccountPrivilegesUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbAccountPrivilegesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbAccountPrivilegesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_account_privileges.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := idSplit[0]
@@ -286,7 +290,7 @@ func resourceTencentCloudMariadbAccountPrivilegesRead(d *schema.ResourceData, me
 
 	accountPrivileges, err := service.DescribeMariadbAccountPrivilegesById(ctx, instanceId, User, Host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if accountPrivileges == nil {
@@ -318,21 +322,21 @@ func resourceTencentCloudMariadbAccountPrivilegesRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudMariadbAccountPrivilegesUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbAccountPrivilegesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_account_privileges.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request = mariadb.NewModifyAccountPrivilegesRequest()
 		flowId  int64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 
 	instanceId := idSplit[0]
@@ -471,8 +475,8 @@ func resourceTencentCloudMariadbAccountPrivilegesUpdate(d *schema.ResourceData,
 
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyAccountPrivileges(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyAccountPrivilegesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -485,10 +489,10 @@ func resourceTencentCloudMariadbAccountPrivilegesUpdate(d *schema.ResourceData,
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update mariadb accountPrivileges failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, e := service.DescribeFlowById(ctx, flowId)
 		if e != nil {
 			return retryError(e)
@@ -508,13 +512,13 @@ func resourceTencentCloudMariadbAccountPrivilegesUpdate(d *schema.ResourceData,
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update mariadb accountPrivileges task failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMariadbAccountPrivilegesRead(d, meta)
+	return resourceTencentCloudMariadbAccountPrivilegesRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbAccountPrivilegesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbAccountPrivilegesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_account_privileges.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -25,7 +25,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -34,12 +36,15 @@ import (
 
 func resourceTencentCloudMariadbBackupTime() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMariadbBackupTimeCreate,
-		Read:   resourceTencentCloudMariadbBackupTimeRead,
-		Update: resourceTencentCloudMariadbBackupTimeUpdate,
-		Delete: resourceTencentCloudMariadbBackupTimeDelete,
+		CreateContext: resourceTencentCloudMariadbBackupTimeCreate,
+		ReadContext:   resourceTencentCloudMariadbBackupTimeRead,
+		UpdateContext: resourceTencentCloudMariadbBackupTimeUpdate,
+		DeleteContext: resourceTencentCloudMariadbBackupTimeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -61,7 +66,7 @@ func resourceTencentCloudMariadbBackupTime() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMariadbBackupTimeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbBackupTimeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_backup_time.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -73,23 +78,23 @@ func resourceTencentCloudMariadbBackupTimeCreate(d *schema.ResourceData, meta in
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudMariadbBackupTimeUpdate(d, meta)
+	return resourceTencentCloudMariadbBackupTimeUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbBackupTimeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbBackupTimeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_backup_time.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	backupTime, err := service.DescribeMariadbBackupTimeById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if backupTime == nil {
@@ -113,7 +118,7 @@ func resourceTencentCloudMariadbBackupTimeRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudMariadbBackupTimeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbBackupTimeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_backup_time.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -132,8 +137,8 @@ func resourceTencentCloudMariadbBackupTimeUpdate(d *schema.ResourceData, meta in
 		request.EndBackupTime = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyBackupTime(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyBackupTimeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -149,13 +154,13 @@ func resourceTencentCloudMariadbBackupTimeUpdate(d *schema.ResourceData, meta in
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update mariadb backupTime failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMariadbBackupTimeRead(d, meta)
+	return resourceTencentCloudMariadbBackupTimeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbBackupTimeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbBackupTimeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_backup_time.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -15,7 +15,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -24,9 +26,12 @@ import (
 
 func resourceTencentCloudMariadbCancelDcnJob() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMariadbCancelDcnJobCreate,
-		Read:   resourceTencentCloudMariadbCancelDcnJobRead,
-		Delete: resourceTencentCloudMariadbCancelDcnJobDelete,
+		CreateContext: resourceTencentCloudMariadbCancelDcnJobCreate,
+		ReadContext:   resourceTencentCloudMariadbCancelDcnJobRead,
+		DeleteContext: resourceTencentCloudMariadbCancelDcnJobDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(50 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -39,25 +44,25 @@ func resourceTencentCloudMariadbCancelDcnJob() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMariadbCancelDcnJobCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbCancelDcnJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_cancel_dcn_job.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = mariadb.NewCancelDcnJobRequest()
 		instanceId string
 		flowId     int64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("instance_id"); ok {
 		request.InstanceId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().CancelDcnJob(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().CancelDcnJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -70,10 +75,10 @@ func resourceTencentCloudMariadbCancelDcnJobCreate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mariadb cancelDcnJob failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := service.DescribeFlowById(ctx, flowId)
 		if e != nil {
 			return retryError(e)
@@ -93,22 +98,22 @@ func resourceTencentCloudMariadbCancelDcnJobCreate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mariadb cancelDcnJob task failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudMariadbCancelDcnJobRead(d, meta)
+	return resourceTencentCloudMariadbCancelDcnJobRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbCancelDcnJobRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbCancelDcnJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_cancel_dcn_job.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudMariadbCancelDcnJobDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbCancelDcnJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_cancel_dcn_job.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -29,7 +29,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
@@ -39,12 +41,17 @@ import (
 
 func resourceTencentCloudMariadbDedicatedclusterDbInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMariadbDedicatedclusterDbInstanceCreate,
-		Read:   resourceTencentCloudMariadbDedicatedclusterDbInstanceRead,
-		Update: resourceTencentCloudMariadbDedicatedclusterDbInstanceUpdate,
-		Delete: resourceTencentCloudMariadbDedicatedclusterDbInstanceDelete,
+		CreateContext: resourceTencentCloudMariadbDedicatedclusterDbInstanceCreate,
+		ReadContext:   resourceTencentCloudMariadbDedicatedclusterDbInstanceRead,
+		UpdateContext: resourceTencentCloudMariadbDedicatedclusterDbInstanceUpdate,
+		DeleteContext: resourceTencentCloudMariadbDedicatedclusterDbInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(50 * time.Minute),
+			Delete: schema.DefaultTimeout(9 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"goods_num": {
@@ -119,17 +126,17 @@ func resourceTencentCloudMariadbDedicatedclusterDbInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMariadbDedicatedclusterDbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbDedicatedclusterDbInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_dedicatedcluster_db_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		request    = mariadb.NewCreateDedicatedClusterDBInstanceRequest()
 		response   *mariadb.CreateDedicatedClusterDBInstanceResponse
 		instanceId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("goods_num"); ok {
 		request.GoodsNum = helper.IntInt64(v.(int))
@@ -167,8 +174,8 @@ func resourceTencentCloudMariadbDedicatedclusterDbInstanceCreate(d *schema.Resou
 		request.InstanceName = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().CreateDedicatedClusterDBInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().CreateDedicatedClusterDBInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -181,7 +188,7 @@ func resourceTencentCloudMariadbDedicatedclusterDbInstanceCreate(d *schema.Resou
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mariadb dedicatedclusterDbInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId = *response.Response.InstanceIds[0]
@@ -209,10 +216,10 @@ func resourceTencentCloudMariadbDedicatedclusterDbInstanceCreate(d *schema.Resou
 
 	initRet, err := service.InitDbInstance(ctx, instanceId, initParams)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !initRet {
-		return fmt.Errorf("db instance init failed")
+		return diag.Errorf("db instance init failed")
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
@@ -220,23 +227,23 @@ func resourceTencentCloudMariadbDedicatedclusterDbInstanceCreate(d *schema.Resou
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::mariadb:%s:uin/:mariadb-dedicatedcluster-instance/%s", region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMariadbDedicatedclusterDbInstanceRead(d, meta)
+	return resourceTencentCloudMariadbDedicatedclusterDbInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbDedicatedclusterDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbDedicatedclusterDbInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_dedicatedcluster_db_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	paramMap := make(map[string]interface{})
 	paramMap["instance_ids"] = []*string{&instanceId}
@@ -244,12 +251,12 @@ func resourceTencentCloudMariadbDedicatedclusterDbInstanceRead(d *schema.Resourc
 	dbInstances, err := service.DescribeMariadbDbInstancesByFilter(ctx, paramMap)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(dbInstances) < 1 {
 		d.SetId("")
-		return fmt.Errorf("resource `dedicatedclusterDbInstance` %s does not exist", instanceId)
+		return diag.Errorf("resource `dedicatedclusterDbInstance` %s does not exist", instanceId)
 	}
 
 	_ = d.Set("goods_num", len(dbInstances))
@@ -295,66 +302,66 @@ func resourceTencentCloudMariadbDedicatedclusterDbInstanceRead(d *schema.Resourc
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "mariadb", "mariadb-dedicatedcluster-instance", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudMariadbDedicatedclusterDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbDedicatedclusterDbInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_dedicatedcluster_db_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = mariadb.NewModifyDBInstanceNameRequest()
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request.InstanceId = &instanceId
 
 	if d.HasChange("goods_num") {
 
-		return fmt.Errorf("`goods_num` do not support change now.")
+		return diag.Errorf("`goods_num` do not support change now.")
 
 	}
 
 	if d.HasChange("memory") {
 
-		return fmt.Errorf("`memory` do not support change now.")
+		return diag.Errorf("`memory` do not support change now.")
 
 	}
 
 	if d.HasChange("storage") {
 
-		return fmt.Errorf("`storage` do not support change now.")
+		return diag.Errorf("`storage` do not support change now.")
 
 	}
 
 	if d.HasChange("cluster_id") {
 
-		return fmt.Errorf("`cluster_id` do not support change now.")
+		return diag.Errorf("`cluster_id` do not support change now.")
 
 	}
 
 	if d.HasChange("vpc_id") {
 
-		return fmt.Errorf("`vpc_id` do not support change now.")
+		return diag.Errorf("`vpc_id` do not support change now.")
 
 	}
 
 	if d.HasChange("subnet_id") {
 
-		return fmt.Errorf("`subnet_id` do not support change now.")
+		return diag.Errorf("`subnet_id` do not support change now.")
 
 	}
 
 	if d.HasChange("db_version_id") {
 
-		return fmt.Errorf("`db_version_id` do not support change now.")
+		return diag.Errorf("`db_version_id` do not support change now.")
 
 	}
 
@@ -363,8 +370,8 @@ func resourceTencentCloudMariadbDedicatedclusterDbInstanceUpdate(d *schema.Resou
 			request.InstanceName = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyDBInstanceName(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyDBInstanceNameWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -376,7 +383,7 @@ func resourceTencentCloudMariadbDedicatedclusterDbInstanceUpdate(d *schema.Resou
 
 		if err != nil {
 			log.Printf("[CRITAL]%s create mariadb dedicatedclusterDbInstance failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -387,7 +394,7 @@ func resourceTencentCloudMariadbDedicatedclusterDbInstanceUpdate(d *schema.Resou
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("mariadb", "mariadb-dedicatedcluster-instance", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -398,8 +405,8 @@ func resourceTencentCloudMariadbDedicatedclusterDbInstanceUpdate(d *schema.Resou
 			MPRequest.InstanceIds = common.StringPtrs([]string{instanceId})
 			MPRequest.ProjectId = &projectId
 
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyDBInstancesProject(MPRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyDBInstancesProjectWithContext(ctx, MPRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -411,7 +418,7 @@ func resourceTencentCloudMariadbDedicatedclusterDbInstanceUpdate(d *schema.Resou
 
 			if err != nil {
 				log.Printf("[CRITAL]%s operate mariadb modifyInstanceProject failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -431,8 +438,8 @@ func resourceTencentCloudMariadbDedicatedclusterDbInstanceUpdate(d *schema.Resou
 				VipRequest.SubnetId = helper.String(v.(string))
 			}
 
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyInstanceNetwork(VipRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyInstanceNetworkWithContext(ctx, VipRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -445,12 +452,12 @@ func resourceTencentCloudMariadbDedicatedclusterDbInstanceUpdate(d *schema.Resou
 
 			if err != nil {
 				log.Printf("[CRITAL]%s operate mariadb network failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 
 			// wait
 			if VipFlowId != NONE_FLOW_TASK {
-				err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+				err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 					result, e := service.DescribeFlowById(ctx, VipFlowId)
 					if e != nil {
 						return retryError(e)
@@ -470,21 +477,21 @@ func resourceTencentCloudMariadbDedicatedclusterDbInstanceUpdate(d *schema.Resou
 
 				if err != nil {
 					log.Printf("[CRITAL]%s operate mariadb network task failed, reason:%+v", logId, err)
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
 	}
 
-	return resourceTencentCloudMariadbDedicatedclusterDbInstanceRead(d, meta)
+	return resourceTencentCloudMariadbDedicatedclusterDbInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbDedicatedclusterDbInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbDedicatedclusterDbInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_dedicatedcluster_db_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -492,7 +499,7 @@ func resourceTencentCloudMariadbDedicatedclusterDbInstanceDelete(d *schema.Resou
 
 	paramMap := make(map[string]interface{})
 	paramMap["instance_ids"] = []*string{&instanceId}
-	err := resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		dbInstances, errRet := service.DescribeMariadbDbInstancesByFilter(ctx, paramMap)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -504,8 +511,8 @@ func resourceTencentCloudMariadbDedicatedclusterDbInstanceDelete(d *schema.Resou
 		if *dbInstances[0].Status == 2 {
 			isolateRequest := mariadb.NewIsolateDedicatedDBInstanceRequest()
 			isolateRequest.InstanceId = &instanceId
-			errIsolate := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				if _, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().IsolateDedicatedDBInstance(isolateRequest); e != nil {
+			errIsolate := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+				if _, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().IsolateDedicatedDBInstanceWithContext(ctx, isolateRequest); e != nil {
 					return resource.NonRetryableError(fmt.Errorf("delete db instance failed, err: %v", e))
 				}
 				return nil
@@ -517,7 +524,7 @@ func resourceTencentCloudMariadbDedicatedclusterDbInstanceDelete(d *schema.Resou
 		}
 
 		if *dbInstances[0].Status < 0 {
-			ee := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			ee := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 				if e := service.DeleteMariadbDbInstance(ctx, instanceId); e != nil {
 					return resource.NonRetryableError(fmt.Errorf("delete db instance failed, err: %v", e))
 				}
@@ -531,7 +538,7 @@ func resourceTencentCloudMariadbDedicatedclusterDbInstanceDelete(d *schema.Resou
 		return resource.RetryableError(fmt.Errorf("db instance status is %v, retry...", *dbInstances[0].Status))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -14,9 +14,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -25,12 +26,15 @@ import (
 
 func resourceTencentCloudMariadbEncryptAttributes() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMariadbEncryptAttributesCreate,
-		Read:   resourceTencentCloudMariadbEncryptAttributesRead,
-		Update: resourceTencentCloudMariadbEncryptAttributesUpdate,
-		Delete: resourceTencentCloudMariadbEncryptAttributesDelete,
+		CreateContext: resourceTencentCloudMariadbEncryptAttributesCreate,
+		ReadContext:   resourceTencentCloudMariadbEncryptAttributesRead,
+		UpdateContext: resourceTencentCloudMariadbEncryptAttributesUpdate,
+		DeleteContext: resourceTencentCloudMariadbEncryptAttributesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -48,7 +52,7 @@ func resourceTencentCloudMariadbEncryptAttributes() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMariadbEncryptAttributesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbEncryptAttributesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_encrypt_attributes.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -59,15 +63,15 @@ func resourceTencentCloudMariadbEncryptAttributesCreate(d *schema.ResourceData,
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudMariadbEncryptAttributesUpdate(d, meta)
+	return resourceTencentCloudMariadbEncryptAttributesUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbEncryptAttributesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbEncryptAttributesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_encrypt_attributes.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -76,12 +80,12 @@ func resourceTencentCloudMariadbEncryptAttributesRead(d *schema.ResourceData, me
 	encryptAttributes, err := service.DescribeDBEncryptAttributes(ctx, instanceId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if encryptAttributes == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `encryptAttributes` %s does not exist", instanceId)
+		return diag.Errorf("resource `encryptAttributes` %s does not exist", instanceId)
 	}
 
 	_ = d.Set("instance_id", instanceId)
@@ -93,7 +97,7 @@ func resourceTencentCloudMariadbEncryptAttributesRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudMariadbEncryptAttributesUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbEncryptAttributesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_encrypt_attributes.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -109,8 +113,8 @@ func resourceTencentCloudMariadbEncryptAttributesUpdate(d *schema.ResourceData,
 		request.EncryptEnabled = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyDBEncryptAttributes(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyDBEncryptAttributesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -120,13 +124,13 @@ func resourceTencentCloudMariadbEncryptAttributesUpdate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update mariadb encryptAttributes failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMariadbEncryptAttributesRead(d, meta)
+	return resourceTencentCloudMariadbEncryptAttributesRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbEncryptAttributesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbEncryptAttributesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_encrypt_attributes.delete")()
 	defer inconsistentCheck(d, meta)()
 
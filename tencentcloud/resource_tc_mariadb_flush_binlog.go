@@ -12,8 +12,11 @@ resource "tencentcloud_mariadb_flush_binlog" "flush_binlog" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -22,9 +25,12 @@ import (
 
 func resourceTencentCloudMariadbFlushBinlog() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMariadbFlushBinlogCreate,
-		Read:   resourceTencentCloudMariadbFlushBinlogRead,
-		Delete: resourceTencentCloudMariadbFlushBinlogDelete,
+		CreateContext: resourceTencentCloudMariadbFlushBinlogCreate,
+		ReadContext:   resourceTencentCloudMariadbFlushBinlogRead,
+		DeleteContext: resourceTencentCloudMariadbFlushBinlogDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -37,7 +43,7 @@ func resourceTencentCloudMariadbFlushBinlog() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMariadbFlushBinlogCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbFlushBinlogCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_flush_binlog.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -52,8 +58,8 @@ func resourceTencentCloudMariadbFlushBinlogCreate(d *schema.ResourceData, meta i
 		instanceId = v.(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().FlushBinlog(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().FlushBinlogWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -65,22 +71,22 @@ func resourceTencentCloudMariadbFlushBinlogCreate(d *schema.ResourceData, meta i
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mariadb flushBinlog failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudMariadbFlushBinlogRead(d, meta)
+	return resourceTencentCloudMariadbFlushBinlogRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbFlushBinlogRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbFlushBinlogRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_flush_binlog.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudMariadbFlushBinlogDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbFlushBinlogDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_flush_binlog.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -36,7 +36,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
@@ -46,12 +48,16 @@ import (
 
 func resourceTencentCloudMariadbHourDbInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMariadbHourDbInstanceCreate,
-		Read:   resourceTencentCloudMariadbHourDbInstanceRead,
-		Update: resourceTencentCloudMariadbHourDbInstanceUpdate,
-		Delete: resourceTencentCloudMariadbHourDbInstanceDelete,
+		CreateContext: resourceTencentCloudMariadbHourDbInstanceCreate,
+		ReadContext:   resourceTencentCloudMariadbHourDbInstanceRead,
+		UpdateContext: resourceTencentCloudMariadbHourDbInstanceUpdate,
+		DeleteContext: resourceTencentCloudMariadbHourDbInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"zones": {
@@ -127,18 +133,18 @@ func resourceTencentCloudMariadbHourDbInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMariadbHourDbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbHourDbInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_hour_db_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = mariadb.NewCreateHourDBInstanceRequest()
 		response   *mariadb.CreateHourDBInstanceResponse
 		instanceId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("zones"); ok {
 		zonesSet := v.(*schema.Set).List()
@@ -180,8 +186,8 @@ func resourceTencentCloudMariadbHourDbInstanceCreate(d *schema.ResourceData, met
 		request.InstanceName = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().CreateHourDBInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().CreateHourDBInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -195,7 +201,7 @@ func resourceTencentCloudMariadbHourDbInstanceCreate(d *schema.ResourceData, met
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mariadb hourDbInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId = *response.Response.InstanceIds[0]
@@ -222,11 +228,11 @@ func resourceTencentCloudMariadbHourDbInstanceCreate(d *schema.ResourceData, met
 
 	initRet, err := service.InitDbInstance(ctx, instanceId, initParams)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !initRet {
-		return fmt.Errorf("db instance init failed")
+		return diag.Errorf("db instance init failed")
 	}
 
 	// set Tags
@@ -235,32 +241,32 @@ func resourceTencentCloudMariadbHourDbInstanceCreate(d *schema.ResourceData, met
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::mariadb:%s:uin/:mariadb-hour-instance/%s", region, instanceId)
 		if err = tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMariadbHourDbInstanceRead(d, meta)
+	return resourceTencentCloudMariadbHourDbInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbHourDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbHourDbInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_hour_db_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	hourDbInstance, err := service.DescribeMariadbDbInstanceDetail(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if hourDbInstance == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `hourDbInstance` %s does not exist", instanceId)
+		return diag.Errorf("resource `hourDbInstance` %s does not exist", instanceId)
 	}
 
 	if hourDbInstance.Zone != nil {
@@ -312,53 +318,53 @@ func resourceTencentCloudMariadbHourDbInstanceRead(d *schema.ResourceData, meta
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "mariadb", "mariadb-hour-instance", tcClient.Region, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudMariadbHourDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbHourDbInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_hour_db_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = mariadb.NewModifyDBInstanceNameRequest()
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request.InstanceId = &instanceId
 
 	if d.HasChange("zones") {
-		return fmt.Errorf("`zones` do not support change now.")
+		return diag.Errorf("`zones` do not support change now.")
 	}
 
 	if d.HasChange("node_count") {
-		return fmt.Errorf("`node_count` do not support change now.")
+		return diag.Errorf("`node_count` do not support change now.")
 	}
 
 	if d.HasChange("memory") {
-		return fmt.Errorf("`memory` do not support change now.")
+		return diag.Errorf("`memory` do not support change now.")
 	}
 
 	if d.HasChange("storage") {
-		return fmt.Errorf("`storage` do not support change now.")
+		return diag.Errorf("`storage` do not support change now.")
 	}
 
 	if d.HasChange("vpc_id") {
-		return fmt.Errorf("`vpc_id` do not support change now.")
+		return diag.Errorf("`vpc_id` do not support change now.")
 	}
 
 	if d.HasChange("subnet_id") {
-		return fmt.Errorf("`subnet_id` do not support change now.")
+		return diag.Errorf("`subnet_id` do not support change now.")
 	}
 
 	if d.HasChange("db_version_id") {
-		return fmt.Errorf("`db_version_id` do not support change now.")
+		return diag.Errorf("`db_version_id` do not support change now.")
 	}
 
 	if d.HasChange("instance_name") {
@@ -366,8 +372,8 @@ func resourceTencentCloudMariadbHourDbInstanceUpdate(d *schema.ResourceData, met
 			request.InstanceName = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyDBInstanceName(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyDBInstanceNameWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -379,7 +385,7 @@ func resourceTencentCloudMariadbHourDbInstanceUpdate(d *schema.ResourceData, met
 
 		if err != nil {
 			log.Printf("[CRITAL]%s create mariadb hourDbInstance failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -390,7 +396,7 @@ func resourceTencentCloudMariadbHourDbInstanceUpdate(d *schema.ResourceData, met
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("mariadb", "mariadb-hour-instance", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -401,8 +407,8 @@ func resourceTencentCloudMariadbHourDbInstanceUpdate(d *schema.ResourceData, met
 			MPRequest.InstanceIds = common.StringPtrs([]string{instanceId})
 			MPRequest.ProjectId = &projectId
 
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyDBInstancesProject(MPRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyDBInstancesProjectWithContext(ctx, MPRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -414,7 +420,7 @@ func resourceTencentCloudMariadbHourDbInstanceUpdate(d *schema.ResourceData, met
 
 			if err != nil {
 				log.Printf("[CRITAL]%s operate mariadb modifyInstanceProject failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -434,8 +440,8 @@ func resourceTencentCloudMariadbHourDbInstanceUpdate(d *schema.ResourceData, met
 				VipRequest.SubnetId = helper.String(v.(string))
 			}
 
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyInstanceNetwork(VipRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyInstanceNetworkWithContext(ctx, VipRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -448,12 +454,12 @@ func resourceTencentCloudMariadbHourDbInstanceUpdate(d *schema.ResourceData, met
 
 			if err != nil {
 				log.Printf("[CRITAL]%s operate mariadb network failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 
 			// wait
 			if VipFlowId != NONE_FLOW_TASK {
-				err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+				err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 					result, e := service.DescribeFlowById(ctx, VipFlowId)
 					if e != nil {
 						return retryError(e)
@@ -473,28 +479,28 @@ func resourceTencentCloudMariadbHourDbInstanceUpdate(d *schema.ResourceData, met
 
 				if err != nil {
 					log.Printf("[CRITAL]%s operate mariadb network task failed, reason:%+v", logId, err)
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
 	}
 
-	return resourceTencentCloudMariadbHourDbInstanceRead(d, meta)
+	return resourceTencentCloudMariadbHourDbInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbHourDbInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbHourDbInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_hour_db_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	instanceId := d.Id()
 
 	if err := service.DeleteMariadbHourDbInstanceById(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -56,7 +56,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
@@ -66,12 +68,17 @@ import (
 
 func resourceTencentCloudMariadbInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMariadbInstanceCreate,
-		Read:   resourceTencentCloudMariadbInstanceRead,
-		Update: resourceTencentCloudMariadbInstanceUpdate,
-		Delete: resourceTencentCloudMariadbInstanceDelete,
+		CreateContext: resourceTencentCloudMariadbInstanceCreate,
+		ReadContext:   resourceTencentCloudMariadbInstanceRead,
+		UpdateContext: resourceTencentCloudMariadbInstanceUpdate,
+		DeleteContext: resourceTencentCloudMariadbInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -410,12 +417,12 @@ func resourceTencentCloudMariadbInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMariadbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request    = mariadb.NewCreateDBInstanceRequest()
@@ -527,8 +534,8 @@ func resourceTencentCloudMariadbInstanceCreate(d *schema.ResourceData, meta inte
 		request.DcnInstanceId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().CreateDBInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().CreateDBInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -541,14 +548,14 @@ func resourceTencentCloudMariadbInstanceCreate(d *schema.ResourceData, meta inte
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mariadb instance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId = *response.Response.InstanceIds[0]
 	d.SetId(instanceId)
 
 	service := MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, e := service.DescribeMariadbInstanceById(ctx, instanceId)
 		if e != nil {
 			return resource.NonRetryableError(e)
@@ -569,7 +576,7 @@ func resourceTencentCloudMariadbInstanceCreate(d *schema.ResourceData, meta inte
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mariadb fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
@@ -577,27 +584,27 @@ func resourceTencentCloudMariadbInstanceCreate(d *schema.ResourceData, meta inte
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::mariadb:%s:uin/:instance/%s", region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMariadbInstanceRead(d, meta)
+	return resourceTencentCloudMariadbInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instance, err := service.DescribeMariadbInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -793,13 +800,13 @@ func resourceTencentCloudMariadbInstanceRead(d *schema.ResourceData, meta interf
 	region := client.Region
 	tags, err := tagService.DescribeResourceTags(ctx, "mariadb", "instance", region, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	DbInstance, err := service.DescribeMariadbDbInstanceDetail(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if DbInstance.Zone != nil {
@@ -814,17 +821,17 @@ func resourceTencentCloudMariadbInstanceRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudMariadbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = mariadb.NewModifyDBInstanceNameRequest()
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request.InstanceId = &instanceId
 
@@ -832,7 +839,7 @@ func resourceTencentCloudMariadbInstanceUpdate(d *schema.ResourceData, meta inte
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -841,8 +848,8 @@ func resourceTencentCloudMariadbInstanceUpdate(d *schema.ResourceData, meta inte
 			request.InstanceName = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyDBInstanceName(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyDBInstanceNameWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -853,7 +860,7 @@ func resourceTencentCloudMariadbInstanceUpdate(d *schema.ResourceData, meta inte
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update mariadb instance failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -864,7 +871,7 @@ func resourceTencentCloudMariadbInstanceUpdate(d *schema.ResourceData, meta inte
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("mariadb", "instance", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -875,8 +882,8 @@ func resourceTencentCloudMariadbInstanceUpdate(d *schema.ResourceData, meta inte
 			MPRequest.InstanceIds = common.StringPtrs([]string{instanceId})
 			MPRequest.ProjectId = &projectId
 
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyDBInstancesProject(MPRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyDBInstancesProjectWithContext(ctx, MPRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -888,7 +895,7 @@ func resourceTencentCloudMariadbInstanceUpdate(d *schema.ResourceData, meta inte
 
 			if err != nil {
 				log.Printf("[CRITAL]%s operate mariadb modifyInstanceProject failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -908,8 +915,8 @@ func resourceTencentCloudMariadbInstanceUpdate(d *schema.ResourceData, meta inte
 				VipRequest.SubnetId = helper.String(v.(string))
 			}
 
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyInstanceNetwork(VipRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyInstanceNetworkWithContext(ctx, VipRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -922,12 +929,12 @@ func resourceTencentCloudMariadbInstanceUpdate(d *schema.ResourceData, meta inte
 
 			if err != nil {
 				log.Printf("[CRITAL]%s operate mariadb network failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 
 			// wait
 			if VipFlowId != NONE_FLOW_TASK {
-				err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+				err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 					result, e := service.DescribeFlowById(ctx, VipFlowId)
 					if e != nil {
 						return retryError(e)
@@ -947,30 +954,30 @@ func resourceTencentCloudMariadbInstanceUpdate(d *schema.ResourceData, meta inte
 
 				if err != nil {
 					log.Printf("[CRITAL]%s operate mariadb network task failed, reason:%+v", logId, err)
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
 	}
 
-	return resourceTencentCloudMariadbInstanceRead(d, meta)
+	return resourceTencentCloudMariadbInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instanceId := d.Id()
 
 	if err := service.IsolateDBInstanceById(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err := resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		instance, e := service.DescribeMariadbInstanceById(ctx, instanceId)
 		if e != nil {
 			return resource.NonRetryableError(e)
@@ -990,14 +997,14 @@ func resourceTencentCloudMariadbInstanceDelete(d *schema.ResourceData, meta inte
 
 	if err != nil {
 		log.Printf("[CRITAL]%s isolate mariadb fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := service.DeleteMariadbInstanceById(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		instance, e := service.DescribeMariadbInstanceById(ctx, instanceId)
 		if e != nil {
 			return resource.NonRetryableError(e)
@@ -1016,7 +1023,7 @@ func resourceTencentCloudMariadbInstanceDelete(d *schema.ResourceData, meta inte
 
 	if err != nil {
 		log.Printf("[CRITAL]%s delete mariadb fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
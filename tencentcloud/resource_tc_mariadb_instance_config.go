@@ -28,7 +28,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -36,12 +38,15 @@ import (
 
 func resourceTencentCloudMariadbInstanceConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMariadbInstanceConfigCreate,
-		Read:   resourceTencentCloudMariadbInstanceConfigRead,
-		Update: resourceTencentCloudMariadbInstanceConfigUpdate,
-		Delete: resourceTencentCloudMariadbInstanceConfigDelete,
+		CreateContext: resourceTencentCloudMariadbInstanceConfigCreate,
+		ReadContext:   resourceTencentCloudMariadbInstanceConfigRead,
+		UpdateContext: resourceTencentCloudMariadbInstanceConfigUpdate,
+		DeleteContext: resourceTencentCloudMariadbInstanceConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(50 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -68,7 +73,7 @@ func resourceTencentCloudMariadbInstanceConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMariadbInstanceConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbInstanceConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_instance_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -80,23 +85,23 @@ func resourceTencentCloudMariadbInstanceConfigCreate(d *schema.ResourceData, met
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudMariadbInstanceConfigUpdate(d, meta)
+	return resourceTencentCloudMariadbInstanceConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbInstanceConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbInstanceConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_instance_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	dbDetail, err := service.DescribeDBInstanceDetailById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if dbDetail == nil {
@@ -120,17 +125,17 @@ func resourceTencentCloudMariadbInstanceConfigRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudMariadbInstanceConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbInstanceConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_instance_config.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = mariadb.NewModifyAccountPrivilegesRequest()
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	needChange := false
 
@@ -152,8 +157,8 @@ func resourceTencentCloudMariadbInstanceConfigUpdate(d *schema.ResourceData, met
 				rsAccessStrategyRequest.InstanceId = &instanceId
 				rsAccessStrategyRequest.RsAccessStrategy = &rsAccessStrategy
 
-				err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-					result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyRealServerAccessStrategy(rsAccessStrategyRequest)
+				err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+					result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyRealServerAccessStrategyWithContext(ctx, rsAccessStrategyRequest)
 					if e != nil {
 						return retryError(e)
 					} else {
@@ -165,7 +170,7 @@ func resourceTencentCloudMariadbInstanceConfigUpdate(d *schema.ResourceData, met
 
 				if err != nil {
 					log.Printf("[CRITAL]%s operate mariadb accessStrategy failed, reason:%+v", logId, err)
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -177,8 +182,8 @@ func resourceTencentCloudMariadbInstanceConfigUpdate(d *schema.ResourceData, met
 			if extranetAccess == ExtranetAccess_ENABLE {
 				extranetAccessRequest := mariadb.NewOpenDBExtranetAccessRequest()
 				extranetAccessRequest.InstanceId = &instanceId
-				err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-					result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().OpenDBExtranetAccess(extranetAccessRequest)
+				err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+					result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().OpenDBExtranetAccessWithContext(ctx, extranetAccessRequest)
 					if e != nil {
 						return retryError(e)
 					} else {
@@ -191,14 +196,14 @@ func resourceTencentCloudMariadbInstanceConfigUpdate(d *schema.ResourceData, met
 
 				if err != nil {
 					log.Printf("[CRITAL]%s operate mariadb openDBExtranetAccess failed, reason:%+v", logId, err)
-					return err
+					return diag.FromErr(err)
 				}
 
 			} else {
 				extranetAccessRequest := mariadb.NewCloseDBExtranetAccessRequest()
 				extranetAccessRequest.InstanceId = &instanceId
-				err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-					result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().CloseDBExtranetAccess(extranetAccessRequest)
+				err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+					result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().CloseDBExtranetAccessWithContext(ctx, extranetAccessRequest)
 					if e != nil {
 						return retryError(e)
 					} else {
@@ -210,13 +215,13 @@ func resourceTencentCloudMariadbInstanceConfigUpdate(d *schema.ResourceData, met
 				})
 				if err != nil {
 					log.Printf("[CRITAL]%s operate mariadb closeDBExtranetAccess failed, reason:%+v", logId, err)
-					return err
+					return diag.FromErr(err)
 				}
 			}
 
 			// wait
 			if extranetAccessFlowId != NONE_FLOW_TASK {
-				err := resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+				err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 					result, e := service.DescribeFlowById(ctx, extranetAccessFlowId)
 					if e != nil {
 						return retryError(e)
@@ -236,16 +241,16 @@ func resourceTencentCloudMariadbInstanceConfigUpdate(d *schema.ResourceData, met
 
 				if err != nil {
 					log.Printf("[CRITAL]%s operate mariadb DBExtranetAccess task failed, reason:%+v", logId, err)
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
 	}
 
-	return resourceTencentCloudMariadbInstanceConfigRead(d, meta)
+	return resourceTencentCloudMariadbInstanceConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbInstanceConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbInstanceConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_instance_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -21,9 +21,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -32,12 +33,15 @@ import (
 
 func resourceTencentCloudMariadbLogFileRetentionPeriod() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMariadbLogFileRetentionPeriodRead,
-		Create: resourceTencentCloudMariadbLogFileRetentionPeriodCreate,
-		Update: resourceTencentCloudMariadbLogFileRetentionPeriodUpdate,
-		Delete: resourceTencentCloudMariadbLogFileRetentionPeriodDelete,
+		ReadContext:   resourceTencentCloudMariadbLogFileRetentionPeriodRead,
+		CreateContext: resourceTencentCloudMariadbLogFileRetentionPeriodCreate,
+		UpdateContext: resourceTencentCloudMariadbLogFileRetentionPeriodUpdate,
+		DeleteContext: resourceTencentCloudMariadbLogFileRetentionPeriodDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -55,7 +59,7 @@ func resourceTencentCloudMariadbLogFileRetentionPeriod() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMariadbLogFileRetentionPeriodCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbLogFileRetentionPeriodCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_log_file_retention_period.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -65,15 +69,15 @@ func resourceTencentCloudMariadbLogFileRetentionPeriodCreate(d *schema.ResourceD
 	}
 
 	d.SetId(instanceId)
-	return resourceTencentCloudMariadbLogFileRetentionPeriodUpdate(d, meta)
+	return resourceTencentCloudMariadbLogFileRetentionPeriodUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbLogFileRetentionPeriodRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbLogFileRetentionPeriodRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_log_file_retention_period.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -82,12 +86,12 @@ func resourceTencentCloudMariadbLogFileRetentionPeriodRead(d *schema.ResourceDat
 	logFileRetentionPeriod, err := service.DescribeMariadbLogFileRetentionPeriod(ctx, instanceId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if logFileRetentionPeriod == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `logFileRetentionPeriod` %s does not exist", instanceId)
+		return diag.Errorf("resource `logFileRetentionPeriod` %s does not exist", instanceId)
 	}
 
 	if logFileRetentionPeriod.InstanceId != nil {
@@ -101,7 +105,7 @@ func resourceTencentCloudMariadbLogFileRetentionPeriodRead(d *schema.ResourceDat
 	return nil
 }
 
-func resourceTencentCloudMariadbLogFileRetentionPeriodUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbLogFileRetentionPeriodUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_log_file_retention_period.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -117,8 +121,8 @@ func resourceTencentCloudMariadbLogFileRetentionPeriodUpdate(d *schema.ResourceD
 		request.Days = helper.Uint64(uint64(v.(int)))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyLogFileRetentionPeriod(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyLogFileRetentionPeriodWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -130,13 +134,13 @@ func resourceTencentCloudMariadbLogFileRetentionPeriodUpdate(d *schema.ResourceD
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mariadb logFileRetentionPeriod failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMariadbLogFileRetentionPeriodRead(d, meta)
+	return resourceTencentCloudMariadbLogFileRetentionPeriodRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbLogFileRetentionPeriodDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbLogFileRetentionPeriodDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_log_file_retention_period.delete")()
 	defer inconsistentCheck(d, meta)()
 
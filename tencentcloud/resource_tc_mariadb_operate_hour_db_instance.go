@@ -16,7 +16,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
@@ -25,10 +27,13 @@ import (
 
 func resourceTencentCloudMariadbOperateHourDbInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMariadbActivateHourDbInstanceCreate,
-		Read:   resourceTencentCloudMariadbActivateHourDbInstanceRead,
-		Update: resourceTencentCloudMariadbActivateHourDbInstanceUpdate,
-		Delete: resourceTencentCloudMariadbActivateHourDbInstanceDelete,
+		CreateContext: resourceTencentCloudMariadbActivateHourDbInstanceCreate,
+		ReadContext:   resourceTencentCloudMariadbActivateHourDbInstanceRead,
+		UpdateContext: resourceTencentCloudMariadbActivateHourDbInstanceUpdate,
+		DeleteContext: resourceTencentCloudMariadbActivateHourDbInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -45,7 +50,7 @@ func resourceTencentCloudMariadbOperateHourDbInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMariadbActivateHourDbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbActivateHourDbInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_activate_hour_db_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -57,26 +62,26 @@ func resourceTencentCloudMariadbActivateHourDbInstanceCreate(d *schema.ResourceD
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudMariadbActivateHourDbInstanceUpdate(d, meta)
+	return resourceTencentCloudMariadbActivateHourDbInstanceUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbActivateHourDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbActivateHourDbInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_activate_hour_db_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 		operate    string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("operate"); ok {
 		operate = v.(string)
 	}
 
-	err := resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, 10*writeRetryTimeout, func() *resource.RetryError {
 		result, e := service.DescribeDBInstanceDetailById(ctx, instanceId)
 		if e != nil {
 			return retryError(e)
@@ -108,7 +113,7 @@ func resourceTencentCloudMariadbActivateHourDbInstanceRead(d *schema.ResourceDat
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update mariadb accountPrivileges task failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("operate", operate)
@@ -116,7 +121,7 @@ func resourceTencentCloudMariadbActivateHourDbInstanceRead(d *schema.ResourceDat
 	return nil
 }
 
-func resourceTencentCloudMariadbActivateHourDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbActivateHourDbInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_activate_hour_db_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -131,8 +136,8 @@ func resourceTencentCloudMariadbActivateHourDbInstanceUpdate(d *schema.ResourceD
 			request := mariadb.NewActivateHourDBInstanceRequest()
 			response := mariadb.NewActivateHourDBInstanceResponse()
 			request.InstanceIds = common.StringPtrs([]string{instanceId})
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ActivateHourDBInstance(request)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ActivateHourDBInstanceWithContext(ctx, request)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -145,19 +150,19 @@ func resourceTencentCloudMariadbActivateHourDbInstanceUpdate(d *schema.ResourceD
 
 			if err != nil {
 				log.Printf("[CRITAL]%s operate mariadb activateHourDbInstance failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 
 			if response == nil {
-				return fmt.Errorf("operate mariadb activateHourDbInstance not found")
+				return diag.Errorf("operate mariadb activateHourDbInstance not found")
 			}
 
 		} else if operate == "isolate" {
 			request := mariadb.NewIsolateHourDBInstanceRequest()
 			response := mariadb.NewIsolateHourDBInstanceResponse()
 			request.InstanceIds = common.StringPtrs([]string{instanceId})
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().IsolateHourDBInstance(request)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().IsolateHourDBInstanceWithContext(ctx, request)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -170,22 +175,22 @@ func resourceTencentCloudMariadbActivateHourDbInstanceUpdate(d *schema.ResourceD
 
 			if err != nil {
 				log.Printf("[CRITAL]%s operate mariadb isolateHourInstance failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 
 			if response == nil {
-				return fmt.Errorf("operate mariadb isolateHourInstance not found")
+				return diag.Errorf("operate mariadb isolateHourInstance not found")
 			}
 
 		} else {
-			return fmt.Errorf("[CRITAL]%s operate type error, %s", logId, operate)
+			return diag.Errorf("[CRITAL]%s operate type error, %s", logId, operate)
 		}
 	}
 
-	return resourceTencentCloudMariadbActivateHourDbInstanceRead(d, meta)
+	return resourceTencentCloudMariadbActivateHourDbInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbActivateHourDbInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbActivateHourDbInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_activate_hour_db_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
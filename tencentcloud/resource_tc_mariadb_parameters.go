@@ -281,9 +281,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -292,12 +293,15 @@ import (
 
 func resourceTencentCloudMariadbParameters() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMariadbParametersRead,
-		Create: resourceTencentCloudMariadbParametersCreate,
-		Update: resourceTencentCloudMariadbParametersUpdate,
-		Delete: resourceTencentCloudMariadbParametersDelete,
+		ReadContext:   resourceTencentCloudMariadbParametersRead,
+		CreateContext: resourceTencentCloudMariadbParametersCreate,
+		UpdateContext: resourceTencentCloudMariadbParametersUpdate,
+		DeleteContext: resourceTencentCloudMariadbParametersDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -329,7 +333,7 @@ func resourceTencentCloudMariadbParameters() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMariadbParametersCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbParametersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_parameters.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -339,15 +343,15 @@ func resourceTencentCloudMariadbParametersCreate(d *schema.ResourceData, meta in
 	}
 
 	d.SetId(instanceId)
-	return resourceTencentCloudMariadbParametersUpdate(d, meta)
+	return resourceTencentCloudMariadbParametersUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbParametersRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbParametersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_parameters.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -356,12 +360,12 @@ func resourceTencentCloudMariadbParametersRead(d *schema.ResourceData, meta inte
 	parameters, err := service.DescribeMariadbParameters(ctx, instanceId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if parameters == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `parameters` %s does not exist", instanceId)
+		return diag.Errorf("resource `parameters` %s does not exist", instanceId)
 	}
 
 	if parameters.InstanceId != nil {
@@ -387,7 +391,7 @@ func resourceTencentCloudMariadbParametersRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudMariadbParametersUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbParametersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_parameters.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -414,8 +418,8 @@ func resourceTencentCloudMariadbParametersUpdate(d *schema.ResourceData, meta in
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyDBParameters(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyDBParametersWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -427,13 +431,13 @@ func resourceTencentCloudMariadbParametersUpdate(d *schema.ResourceData, meta in
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mariadb parameters failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMariadbParametersRead(d, meta)
+	return resourceTencentCloudMariadbParametersRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbParametersDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbParametersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_parameters.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -13,9 +13,12 @@ resource "tencentcloud_mariadb_renew_instance" "renew_instance" {
 package tencentcloud
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
@@ -25,9 +28,12 @@ import (
 
 func resourceTencentCloudMariadbRenewInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMariadbRenewInstanceCreate,
-		Read:   resourceTencentCloudMariadbRenewInstanceRead,
-		Delete: resourceTencentCloudMariadbRenewInstanceDelete,
+		CreateContext: resourceTencentCloudMariadbRenewInstanceCreate,
+		ReadContext:   resourceTencentCloudMariadbRenewInstanceRead,
+		DeleteContext: resourceTencentCloudMariadbRenewInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(50 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -47,7 +53,7 @@ func resourceTencentCloudMariadbRenewInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMariadbRenewInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbRenewInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_renew_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -67,8 +73,8 @@ func resourceTencentCloudMariadbRenewInstanceCreate(d *schema.ResourceData, meta
 		request.Period = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().RenewDBInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().RenewDBInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -81,14 +87,14 @@ func resourceTencentCloudMariadbRenewInstanceCreate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mariadb renewInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// check order
 	OrderRequest := mariadb.NewDescribeOrdersRequest()
 	OrderRequest.DealNames = common.StringPtrs([]string{dealName})
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
-		resp, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().DescribeOrders(OrderRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		resp, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().DescribeOrdersWithContext(ctx, OrderRequest)
 		if e != nil {
 			return resource.RetryableError(err)
 		}
@@ -111,22 +117,22 @@ func resourceTencentCloudMariadbRenewInstanceCreate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mariadb renewInstance task failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudMariadbRenewInstanceRead(d, meta)
+	return resourceTencentCloudMariadbRenewInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbRenewInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbRenewInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_renew_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudMariadbRenewInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbRenewInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_renew_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -15,7 +15,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
@@ -25,9 +27,12 @@ import (
 
 func resourceTencentCloudMariadbRestartInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMariadbRestartInstanceCreate,
-		Read:   resourceTencentCloudMariadbRestartInstanceRead,
-		Delete: resourceTencentCloudMariadbRestartInstanceDelete,
+		CreateContext: resourceTencentCloudMariadbRestartInstanceCreate,
+		ReadContext:   resourceTencentCloudMariadbRestartInstanceRead,
+		DeleteContext: resourceTencentCloudMariadbRestartInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(50 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -46,18 +51,18 @@ func resourceTencentCloudMariadbRestartInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMariadbRestartInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbRestartInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_restart_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = mariadb.NewRestartDBInstancesRequest()
 		instanceId string
 		flowId     int64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("instance_id"); ok {
 		request.InstanceIds = common.StringPtrs([]string{v.(string)})
@@ -68,8 +73,8 @@ func resourceTencentCloudMariadbRestartInstanceCreate(d *schema.ResourceData, me
 		request.RestartTime = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().RestartDBInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().RestartDBInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -82,11 +87,11 @@ func resourceTencentCloudMariadbRestartInstanceCreate(d *schema.ResourceData, me
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mariadb restartInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// wait
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := service.DescribeFlowById(ctx, flowId)
 		if e != nil {
 			return retryError(e)
@@ -106,22 +111,22 @@ func resourceTencentCloudMariadbRestartInstanceCreate(d *schema.ResourceData, me
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mariadb restartInstance task failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudMariadbRestartInstanceRead(d, meta)
+	return resourceTencentCloudMariadbRestartInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbRestartInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbRestartInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_restart_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudMariadbRestartInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbRestartInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_restart_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
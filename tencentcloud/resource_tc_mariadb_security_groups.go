@@ -24,10 +24,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -36,12 +36,16 @@ import (
 
 func resourceTencentCloudMariadbSecurityGroups() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMariadbSecurityGroupsRead,
-		Create: resourceTencentCloudMariadbSecurityGroupsCreate,
-		Update: resourceTencentCloudMariadbSecurityGroupsUpdate,
-		Delete: resourceTencentCloudMariadbSecurityGroupsDelete,
+		ReadContext:   resourceTencentCloudMariadbSecurityGroupsRead,
+		CreateContext: resourceTencentCloudMariadbSecurityGroupsCreate,
+		UpdateContext: resourceTencentCloudMariadbSecurityGroupsUpdate,
+		DeleteContext: resourceTencentCloudMariadbSecurityGroupsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -65,7 +69,7 @@ func resourceTencentCloudMariadbSecurityGroups() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMariadbSecurityGroupsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbSecurityGroupsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_security_groups.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -93,8 +97,8 @@ func resourceTencentCloudMariadbSecurityGroupsCreate(d *schema.ResourceData, met
 		request.Product = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().AssociateSecurityGroups(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().AssociateSecurityGroupsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -106,25 +110,25 @@ func resourceTencentCloudMariadbSecurityGroupsCreate(d *schema.ResourceData, met
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mariadb securityGroups failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(instanceId + FILED_SP + securityGroupId + FILED_SP + product)
-	return resourceTencentCloudMariadbSecurityGroupsRead(d, meta)
+	d.SetId(helper.IdFormat(instanceId, securityGroupId, product))
+	return resourceTencentCloudMariadbSecurityGroupsRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbSecurityGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_security_groups.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	securityGroupId := idSplit[1]
@@ -133,12 +137,12 @@ func resourceTencentCloudMariadbSecurityGroupsRead(d *schema.ResourceData, meta
 	securityGroup, err := service.DescribeMariadbSecurityGroup(ctx, instanceId, securityGroupId, product)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if securityGroup == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `securityGroups` %s does not exist", securityGroupId)
+		return diag.Errorf("resource `securityGroups` %s does not exist", securityGroupId)
 	}
 
 	_ = d.Set("instance_id", instanceId)
@@ -148,7 +152,7 @@ func resourceTencentCloudMariadbSecurityGroupsRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudMariadbSecurityGroupsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbSecurityGroupsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_security_groups.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -156,9 +160,9 @@ func resourceTencentCloudMariadbSecurityGroupsUpdate(d *schema.ResourceData, met
 
 	request := mariadb.NewModifyDBInstanceSecurityGroupsRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	instanceId := idSplit[0]
 	securityGroupId := idSplit[1]
@@ -169,19 +173,19 @@ func resourceTencentCloudMariadbSecurityGroupsUpdate(d *schema.ResourceData, met
 	request.Product = &product
 
 	if d.HasChange("instance_id") {
-		return fmt.Errorf("`instance_id` do not support change now.")
+		return diag.Errorf("`instance_id` do not support change now.")
 	}
 
 	if d.HasChange("security_group_id") {
-		return fmt.Errorf("`security_group_id` do not support change now.")
+		return diag.Errorf("`security_group_id` do not support change now.")
 	}
 
 	if d.HasChange("product") {
-		return fmt.Errorf("`product` do not support change now.")
+		return diag.Errorf("`product` do not support change now.")
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyDBInstanceSecurityGroups(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().ModifyDBInstanceSecurityGroupsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -193,31 +197,31 @@ func resourceTencentCloudMariadbSecurityGroupsUpdate(d *schema.ResourceData, met
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mariadb securityGroups failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMariadbSecurityGroupsRead(d, meta)
+	return resourceTencentCloudMariadbSecurityGroupsRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbSecurityGroupsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbSecurityGroupsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_security_groups.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	securityGroupId := idSplit[1]
 	product := idSplit[2]
 
 	if err := service.DeleteMariadbSecurityGroupsById(ctx, instanceId, securityGroupId, product); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
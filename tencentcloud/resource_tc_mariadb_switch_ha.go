@@ -16,7 +16,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
@@ -25,9 +27,12 @@ import (
 
 func resourceTencentCloudMariadbSwitchHA() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMariadbSwitchHACreate,
-		Read:   resourceTencentCloudMariadbSwitchHARead,
-		Delete: resourceTencentCloudMariadbSwitchHADelete,
+		CreateContext: resourceTencentCloudMariadbSwitchHACreate,
+		ReadContext:   resourceTencentCloudMariadbSwitchHARead,
+		DeleteContext: resourceTencentCloudMariadbSwitchHADelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(50 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -46,18 +51,18 @@ func resourceTencentCloudMariadbSwitchHA() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMariadbSwitchHACreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbSwitchHACreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_switch_ha.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = MariadbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = mariadb.NewSwitchDBInstanceHARequest()
 		instanceId string
 		flowId     int64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("instance_id"); ok {
 		request.InstanceId = helper.String(v.(string))
@@ -68,8 +73,8 @@ func resourceTencentCloudMariadbSwitchHACreate(d *schema.ResourceData, meta inte
 		request.Zone = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().SwitchDBInstanceHA(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMariadbClient().SwitchDBInstanceHAWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -82,11 +87,11 @@ func resourceTencentCloudMariadbSwitchHACreate(d *schema.ResourceData, meta inte
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mariadb switchHA failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// wait
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := service.DescribeFlowById(ctx, flowId)
 		if e != nil {
 			return retryError(e)
@@ -106,22 +111,22 @@ func resourceTencentCloudMariadbSwitchHACreate(d *schema.ResourceData, meta inte
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mariadb switchHA task failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudMariadbSwitchHARead(d, meta)
+	return resourceTencentCloudMariadbSwitchHARead(ctx, d, meta)
 }
 
-func resourceTencentCloudMariadbSwitchHARead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbSwitchHARead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_switch_ha.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudMariadbSwitchHADelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMariadbSwitchHADelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mariadb_switch_ha.delete")()
 	defer inconsistentCheck(d, meta)()
 
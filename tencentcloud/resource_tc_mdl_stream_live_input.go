@@ -26,7 +26,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mdl "github.com/tencentcloud/tencentcloud-sdk-go-intl-en/tencentcloud/mdl/v20200326"
@@ -35,12 +37,16 @@ import (
 
 func resourceTencentCloudMdlStreamLiveInput() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMdlStreamLiveInputCreate,
-		Read:   resourceTencentCloudMdlStreamLiveInputRead,
-		Update: resourceTencentCloudMdlStreamLiveInputUpdate,
-		Delete: resourceTencentCloudMdlStreamLiveInputDelete,
+		CreateContext: resourceTencentCloudMdlStreamLiveInputCreate,
+		ReadContext:   resourceTencentCloudMdlStreamLiveInputRead,
+		UpdateContext: resourceTencentCloudMdlStreamLiveInputUpdate,
+		DeleteContext: resourceTencentCloudMdlStreamLiveInputDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -124,7 +130,7 @@ func resourceTencentCloudMdlStreamLiveInput() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMdlStreamLiveInputCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMdlStreamLiveInputCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mdl_stream_live_input.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -186,8 +192,8 @@ func resourceTencentCloudMdlStreamLiveInputCreate(d *schema.ResourceData, meta i
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMdlClient().CreateStreamLiveInput(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMdlClient().CreateStreamLiveInputWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -198,22 +204,22 @@ func resourceTencentCloudMdlStreamLiveInputCreate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mdl streamliveInput failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	id = *response.Response.Id
 	d.SetId(id)
 
-	return resourceTencentCloudMdlStreamLiveInputRead(d, meta)
+	return resourceTencentCloudMdlStreamLiveInputRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMdlStreamLiveInputRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMdlStreamLiveInputRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mdl_stream_live_input.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MdlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -221,7 +227,7 @@ func resourceTencentCloudMdlStreamLiveInputRead(d *schema.ResourceData, meta int
 
 	streamliveInput, err := service.DescribeMdlStreamLiveInputById(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if streamliveInput == nil {
@@ -293,7 +299,7 @@ func resourceTencentCloudMdlStreamLiveInputRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudMdlStreamLiveInputUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMdlStreamLiveInputUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mdl_streamlive_input.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -364,8 +370,8 @@ func resourceTencentCloudMdlStreamLiveInputUpdate(d *schema.ResourceData, meta i
 			}
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMdlClient().ModifyStreamLiveInput(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMdlClient().ModifyStreamLiveInputWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -375,25 +381,25 @@ func resourceTencentCloudMdlStreamLiveInputUpdate(d *schema.ResourceData, meta i
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update mdl streamliveInput failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMdlStreamLiveInputRead(d, meta)
+	return resourceTencentCloudMdlStreamLiveInputRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMdlStreamLiveInputDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMdlStreamLiveInputDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mdl_stream_live_input.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MdlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	id := d.Id()
 
 	if err := service.DeleteMdlStreamLiveInputById(ctx, id); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -34,7 +34,9 @@ import (
 	"log"
 	"reflect"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mongodb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mongodb/v20190725"
@@ -101,12 +103,15 @@ func resourceTencentCloudMongodbInstance() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Create: resourceTencentCloudMongodbInstanceCreate,
-		Read:   resourceTencentCloudMongodbInstanceRead,
-		Update: resourceTencentCloudMongodbInstanceUpdate,
-		Delete: resourceTencentCloudMongodbInstanceDelete,
+		CreateContext: resourceTencentCloudMongodbInstanceCreate,
+		ReadContext:   resourceTencentCloudMongodbInstanceRead,
+		UpdateContext: resourceTencentCloudMongodbInstanceUpdate,
+		DeleteContext: resourceTencentCloudMongodbInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: mongodbInstanceInfo,
@@ -210,9 +215,11 @@ func mongodbCreateInstanceByUse(ctx context.Context, d *schema.ResourceData, met
 
 	var response *mongodb.CreateDBInstanceHourResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateDBInstanceHour(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateDBInstanceHourWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
 			return retryError(err)
@@ -241,9 +248,11 @@ func mongodbCreateInstanceByMonth(ctx context.Context, d *schema.ResourceData, m
 
 	var response *mongodb.CreateDBInstanceResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateDBInstance(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateDBInstanceWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
 			return retryError(err)
@@ -262,11 +271,11 @@ func mongodbCreateInstanceByMonth(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
-func resourceTencentCloudMongodbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMongodbInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_instance.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := meta.(*TencentCloudClient).apiV3Conn
 	mongodbService := MongodbService{client: client}
@@ -276,7 +285,7 @@ func resourceTencentCloudMongodbInstanceCreate(d *schema.ResourceData, meta inte
 	// check security group info
 	if d.Get("engine_version").(string) == MONGODB_ENGINE_VERSION_4_WT {
 		if _, ok := d.GetOk("security_groups"); ok {
-			return fmt.Errorf("[CRITAL] for instance which `engine_version` is `MONGO_40_WT`, `security_groups` is not supported")
+			return diag.Errorf("[CRITAL] for instance which `engine_version` is `MONGO_40_WT`, `security_groups` is not supported")
 		}
 	}
 
@@ -285,14 +294,14 @@ func resourceTencentCloudMongodbInstanceCreate(d *schema.ResourceData, meta inte
 		_, ok := d.GetOk("prepaid_period")
 		_, ok1 := d.GetOk("auto_renew_flag")
 		if ok || ok1 {
-			return fmt.Errorf("prepaid_period and auto_renew_flag don't make sense for POSTPAID_BY_HOUR instance, please remove them from your template")
+			return diag.Errorf("prepaid_period and auto_renew_flag don't make sense for POSTPAID_BY_HOUR instance, please remove them from your template")
 		}
 		if err := mongodbCreateInstanceByUse(ctx, d, meta); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if err := mongodbCreateInstanceByMonth(ctx, d, meta); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -300,43 +309,43 @@ func resourceTencentCloudMongodbInstanceCreate(d *schema.ResourceData, meta inte
 
 	_, has, err := mongodbService.DescribeInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
-		return fmt.Errorf("[CRITAL]%s creating mongodb instance failed, instance doesn't exist", logId)
+		return diag.Errorf("[CRITAL]%s creating mongodb instance failed, instance doesn't exist", logId)
 	}
 
 	// setting instance name
 	instanceName := d.Get("instance_name").(string)
 	err = mongodbService.ModifyInstanceName(ctx, instanceId, instanceName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, has, err = mongodbService.DescribeInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
-		return fmt.Errorf("[CRITAL]%s creating mongodb instance failed, instance doesn't exist", logId)
+		return diag.Errorf("[CRITAL]%s creating mongodb instance failed, instance doesn't exist", logId)
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		resourceName := BuildTagResourceName("mongodb", "instance", region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMongodbInstanceRead(d, meta)
+	return resourceTencentCloudMongodbInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMongodbInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMongodbInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 
 	instanceId := d.Id()
@@ -345,7 +354,7 @@ func resourceTencentCloudMongodbInstanceRead(d *schema.ResourceData, meta interf
 	tagService := TagService{client}
 	instance, has, err := mongodbService.DescribeInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -367,7 +376,7 @@ func resourceTencentCloudMongodbInstanceRead(d *schema.ResourceData, meta interf
 		"Volume":       "volume",
 		"MachineType":  "machine type",
 	}); len(nilFields) > 0 {
-		return fmt.Errorf("mongodb %v are nil", nilFields)
+		return diag.Errorf("mongodb %v are nil", nilFields)
 	}
 
 	_ = d.Set("instance_name", instance.InstanceName)
@@ -402,7 +411,7 @@ func resourceTencentCloudMongodbInstanceRead(d *schema.ResourceData, meta interf
 
 	replicateSets, err := mongodbService.DescribeDBInstanceNodeProperty(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(replicateSets) > 0 {
 		var hiddenZone string
@@ -442,11 +451,11 @@ func resourceTencentCloudMongodbInstanceRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudMongodbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMongodbInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_instance.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 
@@ -461,7 +470,7 @@ func resourceTencentCloudMongodbInstanceUpdate(d *schema.ResourceData, meta inte
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -470,11 +479,11 @@ func resourceTencentCloudMongodbInstanceUpdate(d *schema.ResourceData, meta inte
 		volume := d.Get("volume").(int)
 		err := mongodbService.UpgradeInstance(ctx, instanceId, memory, volume)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		// it will take time to wait for memory and volume change even describe request succeeded even the status returned in describe response is running
-		errUpdate := resource.Retry(20*readRetryTimeout, func() *resource.RetryError {
+		errUpdate := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			infos, has, e := mongodbService.DescribeInstanceById(ctx, instanceId)
 			if e != nil {
 				return resource.NonRetryableError(e)
@@ -491,7 +500,7 @@ func resourceTencentCloudMongodbInstanceUpdate(d *schema.ResourceData, meta inte
 			return nil
 		})
 		if errUpdate != nil {
-			return errUpdate
+			return diag.FromErr(errUpdate)
 		}
 
 	}
@@ -500,7 +509,7 @@ func resourceTencentCloudMongodbInstanceUpdate(d *schema.ResourceData, meta inte
 		instanceName := d.Get("instance_name").(string)
 		err := mongodbService.ModifyInstanceName(ctx, instanceId, instanceName)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -509,7 +518,7 @@ func resourceTencentCloudMongodbInstanceUpdate(d *schema.ResourceData, meta inte
 		projectId := d.Get("project_id").(int)
 		err := mongodbService.ModifyProjectId(ctx, instanceId, projectId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -518,7 +527,7 @@ func resourceTencentCloudMongodbInstanceUpdate(d *schema.ResourceData, meta inte
 		password := d.Get("password").(string)
 		err := mongodbService.ResetInstancePassword(ctx, instanceId, "mongouser", password)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -529,13 +538,13 @@ func resourceTencentCloudMongodbInstanceUpdate(d *schema.ResourceData, meta inte
 
 		resourceName := BuildTagResourceName("mongodb", "instance", region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	if d.HasChange("prepaid_period") {
-		return fmt.Errorf("setting of the field[prepaid_period] does not make sense after the initialization")
+		return diag.Errorf("setting of the field[prepaid_period] does not make sense after the initialization")
 	}
 
 	if d.HasChange("auto_renew_flag") {
@@ -543,7 +552,7 @@ func resourceTencentCloudMongodbInstanceUpdate(d *schema.ResourceData, meta inte
 		period := d.Get("prepaid_period").(int)
 		err := mongodbService.ModifyAutoRenewFlag(ctx, instanceId, period, autoRenewFlag)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -554,7 +563,7 @@ func resourceTencentCloudMongodbInstanceUpdate(d *schema.ResourceData, meta inte
 
 		err := mongodbService.ModifyNetworkAddress(ctx, instanceId, vpcId, subnetId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -566,20 +575,20 @@ func resourceTencentCloudMongodbInstanceUpdate(d *schema.ResourceData, meta inte
 		}
 		err := mongodbService.ModifySecurityGroups(ctx, instanceId, securityGroupIds)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudMongodbInstanceRead(d, meta)
+	return resourceTencentCloudMongodbInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMongodbInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMongodbInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_instance.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 	mongodbService := MongodbService{
@@ -588,7 +597,7 @@ func resourceTencentCloudMongodbInstanceDelete(d *schema.ResourceData, meta inte
 
 	instanceDetail, has, err := mongodbService.DescribeInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -597,26 +606,26 @@ func resourceTencentCloudMongodbInstanceDelete(d *schema.ResourceData, meta inte
 	if MONGODB_CHARGE_TYPE[*instanceDetail.PayMode] == MONGODB_CHARGE_TYPE_PREPAID {
 		err := mongodbService.TerminateDBInstances(ctx, instanceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := mongodbService.IsolateInstance(ctx, instanceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	err = mongodbService.OfflineIsolatedDBInstance(ctx, instanceId, true)
 	if err != nil {
 		log.Printf("[CRITAL]%s mongodb %s fail, reason:%s", logId, "OfflineIsolatedDBInstance", err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	//describe and check not exist
 	_, has, errRet := mongodbService.DescribeInstanceById(ctx, instanceId)
 	if errRet != nil {
-		return errRet
+		return diag.FromErr(errRet)
 	}
 	if !has {
 		return nil
 	}
-	return fmt.Errorf("[CRITAL]%s mongodb %s fail", logId, "OfflineIsolatedDBInstance")
+	return diag.Errorf("[CRITAL]%s mongodb %s fail", logId, "OfflineIsolatedDBInstance")
 }
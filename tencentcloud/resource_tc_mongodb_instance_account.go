@@ -16,15 +16,23 @@ resource "tencentcloud_mongodb_instance_account" "instance_account" {
   }
 }
 ```
+
+Import
+
+mongodb instance_account can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_mongodb_instance_account.instance_account cmgo-lxaz2c9b#test_account
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mongodb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mongodb/v20190725"
@@ -33,10 +41,17 @@ import (
 
 func resourceTencentCloudMongodbInstanceAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMongodbInstanceAccountCreate,
-		Read:   resourceTencentCloudMongodbInstanceAccountRead,
-		Update: resourceTencentCloudMongodbInstanceAccountUpdate,
-		Delete: resourceTencentCloudMongodbInstanceAccountDelete,
+		CreateContext: resourceTencentCloudMongodbInstanceAccountCreate,
+		ReadContext:   resourceTencentCloudMongodbInstanceAccountRead,
+		UpdateContext: resourceTencentCloudMongodbInstanceAccountUpdate,
+		DeleteContext: resourceTencentCloudMongodbInstanceAccountDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -94,7 +109,7 @@ func resourceTencentCloudMongodbInstanceAccount() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMongodbInstanceAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMongodbInstanceAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_instance_account.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -142,8 +157,8 @@ func resourceTencentCloudMongodbInstanceAccountCreate(d *schema.ResourceData, me
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateAccountUser(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateAccountUserWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -154,43 +169,43 @@ func resourceTencentCloudMongodbInstanceAccountCreate(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mongodb instanceAccount failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(instanceId + FILED_SP + userName)
+	d.SetId(helper.IdFormat(instanceId, userName))
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	if response != nil && response.Response != nil {
-		if err = service.DescribeAsyncRequestInfo(ctx, helper.UInt64ToStr(*response.Response.FlowId), 3*readRetryTimeout); err != nil {
-			return err
+		if err = service.DescribeAsyncRequestInfo(ctx, helper.UInt64ToStr(*response.Response.FlowId), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMongodbInstanceAccountRead(d, meta)
+	return resourceTencentCloudMongodbInstanceAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMongodbInstanceAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMongodbInstanceAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_instance_account.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	userName := idSplit[1]
 
 	instanceAccount, err := service.DescribeMongodbInstanceAccountById(ctx, instanceId, userName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instanceAccount == nil {
@@ -232,7 +247,7 @@ func resourceTencentCloudMongodbInstanceAccountRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudMongodbInstanceAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMongodbInstanceAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_instance_account.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -240,9 +255,9 @@ func resourceTencentCloudMongodbInstanceAccountUpdate(d *schema.ResourceData, me
 
 	request := mongodb.NewSetAccountUserPrivilegeRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	userName := idSplit[1]
@@ -254,7 +269,7 @@ func resourceTencentCloudMongodbInstanceAccountUpdate(d *schema.ResourceData, me
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -274,8 +289,8 @@ func resourceTencentCloudMongodbInstanceAccountUpdate(d *schema.ResourceData, me
 		}
 
 		var response *mongodb.SetAccountUserPrivilegeResponse
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().SetAccountUserPrivilege(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().SetAccountUserPrivilegeWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -286,44 +301,44 @@ func resourceTencentCloudMongodbInstanceAccountUpdate(d *schema.ResourceData, me
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update mongodb instanceAccount failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		service := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 		if response != nil && response.Response != nil {
-			if err = service.DescribeAsyncRequestInfo(ctx, helper.UInt64ToStr(*response.Response.FlowId), 3*readRetryTimeout); err != nil {
-				return err
+			if err = service.DescribeAsyncRequestInfo(ctx, helper.UInt64ToStr(*response.Response.FlowId), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 	}
 
 	if d.HasChange("password") {
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		service := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
 		password := d.Get("password").(string)
 		err := service.ResetInstancePassword(ctx, instanceId, userName, password)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
-	return resourceTencentCloudMongodbInstanceAccountRead(d, meta)
+	return resourceTencentCloudMongodbInstanceAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMongodbInstanceAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMongodbInstanceAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_instance_account.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	userName := idSplit[1]
@@ -334,7 +349,7 @@ func resourceTencentCloudMongodbInstanceAccountDelete(d *schema.ResourceData, me
 	}
 
 	if err := service.DeleteMongodbInstanceAccountById(ctx, instanceId, userName, mongoUserPassword); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -19,6 +19,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mongodb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mongodb/v20190725"
@@ -27,9 +28,9 @@ import (
 
 func resourceTencentCloudMongodbInstanceBackup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMongodbInstanceBackupCreate,
-		Read:   resourceTencentCloudMongodbInstanceBackupRead,
-		Delete: resourceTencentCloudMongodbInstanceBackupDelete,
+		CreateContext: resourceTencentCloudMongodbInstanceBackupCreate,
+		ReadContext:   resourceTencentCloudMongodbInstanceBackupRead,
+		DeleteContext: resourceTencentCloudMongodbInstanceBackupDelete,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(3 * time.Minute),
 		},
@@ -58,7 +59,7 @@ func resourceTencentCloudMongodbInstanceBackup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMongodbInstanceBackupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMongodbInstanceBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_mongodb_instance_backup.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -81,8 +82,8 @@ func resourceTencentCloudMongodbInstanceBackupCreate(d *schema.ResourceData, met
 		request.BackupRemark = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateBackupDBInstance(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateBackupDBInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -99,28 +100,28 @@ func resourceTencentCloudMongodbInstanceBackupCreate(d *schema.ResourceData, met
 	taskId = *response.Response.AsyncRequestId
 	d.SetId(taskId)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	timeout := d.Timeout(schema.TimeoutCreate)
 	if response != nil && response.Response != nil {
 		if err = service.DescribeAsyncRequestInfo(ctx, taskId, timeout); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMongodbInstanceBackupRead(d, meta)
+	return resourceTencentCloudMongodbInstanceBackupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMongodbInstanceBackupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMongodbInstanceBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_instance_backup.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudMongodbInstanceBackupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMongodbInstanceBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_instance_backup.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -25,10 +25,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mongodb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mongodb/v20190725"
@@ -37,11 +37,14 @@ import (
 
 func resourceTencentCloudMongodbInstanceBackupDownloadTask() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMongodbInstanceBackupDownloadTaskCreate,
-		Read:   resourceTencentCloudMongodbInstanceBackupDownloadTaskRead,
-		Delete: resourceTencentCloudMongodbInstanceBackupDownloadTaskDelete,
+		CreateContext: resourceTencentCloudMongodbInstanceBackupDownloadTaskCreate,
+		ReadContext:   resourceTencentCloudMongodbInstanceBackupDownloadTaskRead,
+		DeleteContext: resourceTencentCloudMongodbInstanceBackupDownloadTaskDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -77,7 +80,7 @@ func resourceTencentCloudMongodbInstanceBackupDownloadTask() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMongodbInstanceBackupDownloadTaskCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMongodbInstanceBackupDownloadTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_instance_backup_download_task.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -109,8 +112,8 @@ func resourceTencentCloudMongodbInstanceBackupDownloadTaskCreate(d *schema.Resou
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateBackupDownloadTask(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateBackupDownloadTaskWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -120,34 +123,34 @@ func resourceTencentCloudMongodbInstanceBackupDownloadTaskCreate(d *schema.Resou
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mongodb instanceBackupDownloadTask failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(instanceId + FILED_SP + backupName)
+	d.SetId(helper.IdFormat(instanceId, backupName))
 
-	return resourceTencentCloudMongodbInstanceBackupDownloadTaskRead(d, meta)
+	return resourceTencentCloudMongodbInstanceBackupDownloadTaskRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMongodbInstanceBackupDownloadTaskRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMongodbInstanceBackupDownloadTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_instance_backup_download_task.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	backupName := idSplit[1]
 
 	instanceBackupDownloadTask, err := service.DescribeMongodbInstanceBackupDownloadTaskById(ctx, instanceId, backupName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instanceBackupDownloadTask == nil {
@@ -174,7 +177,7 @@ func resourceTencentCloudMongodbInstanceBackupDownloadTaskRead(d *schema.Resourc
 	return nil
 }
 
-func resourceTencentCloudMongodbInstanceBackupDownloadTaskDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMongodbInstanceBackupDownloadTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_instance_backup_download_task.delete")()
 	defer inconsistentCheck(d, meta)()
 
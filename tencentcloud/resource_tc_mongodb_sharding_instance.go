@@ -40,7 +40,9 @@ import (
 	"log"
 	"reflect"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mongodb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mongodb/v20190725"
@@ -92,12 +94,15 @@ func resourceTencentCloudMongodbShardingInstance() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Create: resourceMongodbShardingInstanceCreate,
-		Read:   resourceMongodbShardingInstanceRead,
-		Update: resourceMongodbShardingInstanceUpdate,
-		Delete: resourceMongodbShardingInstanceDelete,
+		CreateContext: resourceMongodbShardingInstanceCreate,
+		ReadContext:   resourceMongodbShardingInstanceRead,
+		UpdateContext: resourceMongodbShardingInstanceUpdate,
+		DeleteContext: resourceMongodbShardingInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: mongodbShardingInstanceInfo,
@@ -207,9 +212,11 @@ func mongodbCreateShardingInstanceByUse(ctx context.Context, d *schema.ResourceD
 
 	var response *mongodb.CreateDBInstanceHourResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateDBInstanceHour(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateDBInstanceHourWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
 			return retryError(err)
@@ -238,9 +245,11 @@ func mongodbCreateShardingInstanceByMonth(ctx context.Context, d *schema.Resourc
 
 	var response *mongodb.CreateDBInstanceResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateDBInstance(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateDBInstanceWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
 			return retryError(err)
@@ -259,11 +268,11 @@ func mongodbCreateShardingInstanceByMonth(ctx context.Context, d *schema.Resourc
 	return nil
 }
 
-func resourceMongodbShardingInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceMongodbShardingInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_sharding_instance.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := meta.(*TencentCloudClient).apiV3Conn
 	mongodbService := MongodbService{client: client}
@@ -273,7 +282,7 @@ func resourceMongodbShardingInstanceCreate(d *schema.ResourceData, meta interfac
 	// check security group info
 	if d.Get("engine_version").(string) == MONGODB_ENGINE_VERSION_4_WT {
 		if _, ok := d.GetOk("security_groups"); ok {
-			return fmt.Errorf("[CRITAL] for instance which `engine_version` is `MONGO_40_WT`, `security_groups` is not supported")
+			return diag.Errorf("[CRITAL] for instance which `engine_version` is `MONGO_40_WT`, `security_groups` is not supported")
 		}
 	}
 
@@ -282,14 +291,14 @@ func resourceMongodbShardingInstanceCreate(d *schema.ResourceData, meta interfac
 		_, ok := d.GetOk("prepaid_period")
 		_, ok1 := d.GetOk("auto_renew_flag")
 		if ok || ok1 {
-			return fmt.Errorf("prepaid_period and auto_renew_flag don't make sense for POSTPAID_BY_HOUR instance, please remove them from your template")
+			return diag.Errorf("prepaid_period and auto_renew_flag don't make sense for POSTPAID_BY_HOUR instance, please remove them from your template")
 		}
 		if err := mongodbCreateShardingInstanceByUse(ctx, d, meta); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if err := mongodbCreateShardingInstanceByMonth(ctx, d, meta); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -297,50 +306,50 @@ func resourceMongodbShardingInstanceCreate(d *schema.ResourceData, meta interfac
 
 	_, has, err := mongodbService.DescribeInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
-		return fmt.Errorf("[CRITAL]%s creating mongodb sharding instance failed, instance doesn't exist", logId)
+		return diag.Errorf("[CRITAL]%s creating mongodb sharding instance failed, instance doesn't exist", logId)
 	}
 
 	// setting instance name
 	instanceName := d.Get("instance_name").(string)
 	err = mongodbService.ModifyInstanceName(ctx, instanceId, instanceName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, has, err = mongodbService.DescribeInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
-		return fmt.Errorf("[CRITAL]%s creating mongodb instance failed, instance doesn't exist", logId)
+		return diag.Errorf("[CRITAL]%s creating mongodb instance failed, instance doesn't exist", logId)
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		resourceName := BuildTagResourceName("mongodb", "instance", region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceMongodbShardingInstanceRead(d, meta)
+	return resourceMongodbShardingInstanceRead(ctx, d, meta)
 }
 
-func resourceMongodbShardingInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceMongodbShardingInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_sharding_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 
 	mongodbService := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instance, has, err := mongodbService.DescribeInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -365,7 +374,7 @@ func resourceMongodbShardingInstanceRead(d *schema.ResourceData, meta interface{
 		"ReplicationSetNum": "shard quantity",
 		"SecondaryNum":      "secondary number",
 	}); len(nilFields) > 0 {
-		return fmt.Errorf("mongodb %v are nil", nilFields)
+		return diag.Errorf("mongodb %v are nil", nilFields)
 	}
 
 	_ = d.Set("shard_quantity", instance.ReplicationSetNum)
@@ -405,7 +414,7 @@ func resourceMongodbShardingInstanceRead(d *schema.ResourceData, meta interface{
 
 	groups, err := mongodbService.DescribeSecurityGroup(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	groupIds := make([]string, 0)
 	for _, group := range groups {
@@ -415,7 +424,7 @@ func resourceMongodbShardingInstanceRead(d *schema.ResourceData, meta interface{
 
 	replicateSets, err := mongodbService.DescribeDBInstanceNodeProperty(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(replicateSets) > 1 {
 		var hiddenZone string
@@ -433,10 +442,10 @@ func resourceMongodbShardingInstanceRead(d *schema.ResourceData, meta interface{
 	tags := make(map[string]string, len(instance.Tags))
 	for _, tag := range instance.Tags {
 		if tag.TagKey == nil {
-			return errors.New("mongodb tag key is nil")
+			return diag.FromErr(errors.New("mongodb tag key is nil"))
 		}
 		if tag.TagValue == nil {
-			return errors.New("mongodb tag value is nil")
+			return diag.FromErr(errors.New("mongodb tag value is nil"))
 		}
 		if *tag.TagKey == "project" {
 			continue
@@ -449,11 +458,11 @@ func resourceMongodbShardingInstanceRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceMongodbShardingInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceMongodbShardingInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_sharding_instance.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 
@@ -464,21 +473,21 @@ func resourceMongodbShardingInstanceUpdate(d *schema.ResourceData, meta interfac
 
 	d.Partial(true)
 	if d.HasChange("availability_zone_list") || d.HasChange("hidden_zone") {
-		return fmt.Errorf("setting of the field[availability_zone_list, hidden_zone] does not support update")
+		return diag.Errorf("setting of the field[availability_zone_list, hidden_zone] does not support update")
 	}
 	if d.HasChange("mongos_cpu") || d.HasChange("mongos_memory") || d.HasChange("mongos_node_num") {
-		return fmt.Errorf("setting of the field[mongos_cpu, mongos_memory, mongos_node_num] does not support update")
+		return diag.Errorf("setting of the field[mongos_cpu, mongos_memory, mongos_node_num] does not support update")
 	}
 	if d.HasChange("memory") || d.HasChange("volume") {
 		memory := d.Get("memory").(int)
 		volume := d.Get("volume").(int)
 		err := mongodbService.UpgradeInstance(ctx, instanceId, memory, volume)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		// it will take time to wait for memory and volume change even describe request succeeded even the status returned in describe response is running
-		errUpdate := resource.Retry(20*readRetryTimeout, func() *resource.RetryError {
+		errUpdate := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			infos, has, e := mongodbService.DescribeInstanceById(ctx, instanceId)
 			if e != nil {
 				return resource.NonRetryableError(e)
@@ -495,7 +504,7 @@ func resourceMongodbShardingInstanceUpdate(d *schema.ResourceData, meta interfac
 			return nil
 		})
 		if errUpdate != nil {
-			return errUpdate
+			return diag.FromErr(errUpdate)
 		}
 
 	}
@@ -504,7 +513,7 @@ func resourceMongodbShardingInstanceUpdate(d *schema.ResourceData, meta interfac
 		instanceName := d.Get("instance_name").(string)
 		err := mongodbService.ModifyInstanceName(ctx, instanceId, instanceName)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -513,7 +522,7 @@ func resourceMongodbShardingInstanceUpdate(d *schema.ResourceData, meta interfac
 		projectId := d.Get("project_id").(int)
 		err := mongodbService.ModifyProjectId(ctx, instanceId, projectId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -522,7 +531,7 @@ func resourceMongodbShardingInstanceUpdate(d *schema.ResourceData, meta interfac
 		password := d.Get("password").(string)
 		err := mongodbService.ResetInstancePassword(ctx, instanceId, "mongouser", password)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -533,13 +542,13 @@ func resourceMongodbShardingInstanceUpdate(d *schema.ResourceData, meta interfac
 
 		resourceName := BuildTagResourceName("mongodb", "instance", region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	if d.HasChange("prepaid_period") {
-		return fmt.Errorf("setting of the field[prepaid_period] does not make sense after the initialization")
+		return diag.Errorf("setting of the field[prepaid_period] does not make sense after the initialization")
 	}
 
 	if d.HasChange("auto_renew_flag") {
@@ -547,21 +556,21 @@ func resourceMongodbShardingInstanceUpdate(d *schema.ResourceData, meta interfac
 		period := d.Get("prepaid_period").(int)
 		err := mongodbService.ModifyAutoRenewFlag(ctx, instanceId, period, autoRenewFlag)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceMongodbShardingInstanceRead(d, meta)
+	return resourceMongodbShardingInstanceRead(ctx, d, meta)
 }
 
-func resourceMongodbShardingInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceMongodbShardingInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_sharding_instance.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 	mongodbService := MongodbService{
@@ -570,7 +579,7 @@ func resourceMongodbShardingInstanceDelete(d *schema.ResourceData, meta interfac
 
 	instanceDetail, has, err := mongodbService.DescribeInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -579,19 +588,19 @@ func resourceMongodbShardingInstanceDelete(d *schema.ResourceData, meta interfac
 	if MONGODB_CHARGE_TYPE[*instanceDetail.PayMode] == MONGODB_CHARGE_TYPE_PREPAID {
 		err := mongodbService.TerminateDBInstances(ctx, instanceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := mongodbService.IsolateInstance(ctx, instanceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	err = mongodbService.OfflineIsolatedDBInstance(ctx, instanceId, true)
 	if err != nil {
 		log.Printf("[CRITAL]%s mongodb %s fail, reason:%s", logId, "OfflineIsolatedDBInstance", err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
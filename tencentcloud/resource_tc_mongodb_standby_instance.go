@@ -61,7 +61,9 @@ import (
 	"log"
 	"reflect"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mongodb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mongodb/v20190725"
@@ -111,12 +113,15 @@ func resourceTencentCloudMongodbStandbyInstance() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Create: resourceTencentCloudMongodbStandbyInstanceCreate,
-		Read:   resourceTencentCloudMongodbStandbyInstanceRead,
-		Update: resourceTencentCloudMongodbStandbyInstanceUpdate,
-		Delete: resourceTencentCloudMongodbStandbyInstanceDelete,
+		CreateContext: resourceTencentCloudMongodbStandbyInstanceCreate,
+		ReadContext:   resourceTencentCloudMongodbStandbyInstanceRead,
+		UpdateContext: resourceTencentCloudMongodbStandbyInstanceUpdate,
+		DeleteContext: resourceTencentCloudMongodbStandbyInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: mongodbStandbyInstanceInfo,
@@ -198,9 +203,11 @@ func mongodbCreateStandbyInstanceByUse(ctx context.Context, d *schema.ResourceDa
 
 	var response *mongodb.CreateDBInstanceHourResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateDBInstanceHour(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateDBInstanceHourWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
 			return retryError(err)
@@ -229,9 +236,11 @@ func mongodbCreateStandbyInstanceByMonth(ctx context.Context, d *schema.Resource
 
 	var response *mongodb.CreateDBInstanceResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateDBInstance(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateDBInstanceWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
 			return retryError(err)
@@ -250,11 +259,11 @@ func mongodbCreateStandbyInstanceByMonth(ctx context.Context, d *schema.Resource
 	return nil
 }
 
-func resourceTencentCloudMongodbStandbyInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMongodbStandbyInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_standby_instance.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := meta.(*TencentCloudClient).apiV3Conn
 	client1 := *client
@@ -266,20 +275,20 @@ func resourceTencentCloudMongodbStandbyInstanceCreate(d *schema.ResourceData, me
 	// collect info from master instance
 	var masterInfoMap = make(map[string]string, 3)
 	if _, ok := d.GetOk("father_instance_id"); !ok {
-		return fmt.Errorf("[CRITAL] father instance id must be specified for standby instance")
+		return diag.Errorf("[CRITAL] father instance id must be specified for standby instance")
 	}
 	if _, ok := d.GetOk("father_instance_region"); !ok {
-		return fmt.Errorf("[CRITAL] father instance region must be specified for standby instance")
+		return diag.Errorf("[CRITAL] father instance region must be specified for standby instance")
 	}
 	fatherRegion := d.Get("father_instance_region").(string)
 	mongodbService1.client.Region = fatherRegion
 	masterInfoMap["father_instance_id"] = d.Get("father_instance_id").(string)
 	masterInfo, has, err := mongodbService1.DescribeInstanceById(ctx, masterInfoMap["father_instance_id"])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
-		return fmt.Errorf("[CRITAL] father instance can't be found for creating mongodb standby instance")
+		return diag.Errorf("[CRITAL] father instance can't be found for creating mongodb standby instance")
 	}
 
 	masterInfoMap["machine_type"] = *masterInfo.MachineType
@@ -287,13 +296,13 @@ func resourceTencentCloudMongodbStandbyInstanceCreate(d *schema.ResourceData, me
 
 	// check available_zone info
 	if d.Get("available_zone").(string) == *masterInfo.Zone {
-		return fmt.Errorf("[CRITAL] standBy instance zoneId must not same with father instance's")
+		return diag.Errorf("[CRITAL] standBy instance zoneId must not same with father instance's")
 	}
 
 	// check security group info
 	if *masterInfo.MongoVersion == MONGODB_ENGINE_VERSION_4_WT {
 		if _, ok := d.GetOk("security_groups"); ok {
-			return fmt.Errorf("[CRITAL] for instance which `engine_version` is `MONGO_40_WT`, `security_groups` is not supported")
+			return diag.Errorf("[CRITAL] for instance which `engine_version` is `MONGO_40_WT`, `security_groups` is not supported")
 		}
 	}
 
@@ -303,14 +312,14 @@ func resourceTencentCloudMongodbStandbyInstanceCreate(d *schema.ResourceData, me
 		_, ok := d.GetOk("prepaid_period")
 		_, ok1 := d.GetOk("auto_renew_flag")
 		if ok || ok1 {
-			return fmt.Errorf("prepaid_period and auto_renew_flag don't make sense for POSTPAID_BY_HOUR mongodb standby instance, please remove them from your template")
+			return diag.Errorf("prepaid_period and auto_renew_flag don't make sense for POSTPAID_BY_HOUR mongodb standby instance, please remove them from your template")
 		}
 		if err := mongodbCreateStandbyInstanceByUse(ctx, d, meta, masterInfoMap); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if err := mongodbCreateStandbyInstanceByMonth(ctx, d, meta, masterInfoMap); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -318,50 +327,50 @@ func resourceTencentCloudMongodbStandbyInstanceCreate(d *schema.ResourceData, me
 
 	_, has, err = mongodbService.DescribeInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
-		return fmt.Errorf("[CRITAL]%s creating mongodb standby instance failed, instance doesn't exist", logId)
+		return diag.Errorf("[CRITAL]%s creating mongodb standby instance failed, instance doesn't exist", logId)
 	}
 
 	// setting instance name
 	instanceName := d.Get("instance_name").(string)
 	err = mongodbService.ModifyInstanceName(ctx, instanceId, instanceName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, has, err = mongodbService.DescribeInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
-		return fmt.Errorf("[CRITAL]%s creating mongodb instance failed, instance doesn't exist", logId)
+		return diag.Errorf("[CRITAL]%s creating mongodb instance failed, instance doesn't exist", logId)
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		resourceName := BuildTagResourceName("mongodb", "instance", region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMongodbInstanceRead(d, meta)
+	return resourceTencentCloudMongodbInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMongodbStandbyInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMongodbStandbyInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_standby_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 
 	mongodbService := MongodbService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instance, has, err := mongodbService.DescribeInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -383,7 +392,7 @@ func resourceTencentCloudMongodbStandbyInstanceRead(d *schema.ResourceData, meta
 		"Volume":       "volume",
 		"MachineType":  "machine type",
 	}); len(nilFields) > 0 {
-		return fmt.Errorf("mongodb %v are nil", nilFields)
+		return diag.Errorf("mongodb %v are nil", nilFields)
 	}
 
 	_ = d.Set("instance_name", instance.InstanceName)
@@ -414,10 +423,10 @@ func resourceTencentCloudMongodbStandbyInstanceRead(d *schema.ResourceData, meta
 	tags := make(map[string]string, len(instance.Tags))
 	for _, tag := range instance.Tags {
 		if tag.TagKey == nil {
-			return errors.New("mongodb tag key is nil")
+			return diag.FromErr(errors.New("mongodb tag key is nil"))
 		}
 		if tag.TagValue == nil {
-			return errors.New("mongodb tag value is nil")
+			return diag.FromErr(errors.New("mongodb tag value is nil"))
 		}
 		if *tag.TagKey == "project" {
 			continue
@@ -430,11 +439,11 @@ func resourceTencentCloudMongodbStandbyInstanceRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudMongodbStandbyInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMongodbStandbyInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_standby_instance.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 
@@ -450,11 +459,11 @@ func resourceTencentCloudMongodbStandbyInstanceUpdate(d *schema.ResourceData, me
 		volume := d.Get("volume").(int)
 		err := mongodbService.UpgradeInstance(ctx, instanceId, memory, volume)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		// it will take time to wait for memory and volume change even describe request succeeded even the status returned in describe response is running
-		errUpdate := resource.Retry(20*readRetryTimeout, func() *resource.RetryError {
+		errUpdate := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			infos, has, e := mongodbService.DescribeInstanceById(ctx, instanceId)
 			if e != nil {
 				return resource.NonRetryableError(e)
@@ -471,7 +480,7 @@ func resourceTencentCloudMongodbStandbyInstanceUpdate(d *schema.ResourceData, me
 			return nil
 		})
 		if errUpdate != nil {
-			return errUpdate
+			return diag.FromErr(errUpdate)
 		}
 
 	}
@@ -480,7 +489,7 @@ func resourceTencentCloudMongodbStandbyInstanceUpdate(d *schema.ResourceData, me
 		instanceName := d.Get("instance_name").(string)
 		err := mongodbService.ModifyInstanceName(ctx, instanceId, instanceName)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -489,7 +498,7 @@ func resourceTencentCloudMongodbStandbyInstanceUpdate(d *schema.ResourceData, me
 		projectId := d.Get("project_id").(int)
 		err := mongodbService.ModifyProjectId(ctx, instanceId, projectId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -500,13 +509,13 @@ func resourceTencentCloudMongodbStandbyInstanceUpdate(d *schema.ResourceData, me
 
 		resourceName := BuildTagResourceName("mongodb", "instance", region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	if d.HasChange("prepaid_period") {
-		return fmt.Errorf("setting of the field[prepaid_period] does not make sense after the initialization")
+		return diag.Errorf("setting of the field[prepaid_period] does not make sense after the initialization")
 	}
 
 	if d.HasChange("auto_renew_flag") {
@@ -514,21 +523,21 @@ func resourceTencentCloudMongodbStandbyInstanceUpdate(d *schema.ResourceData, me
 		period := d.Get("prepaid_period").(int)
 		err := mongodbService.ModifyAutoRenewFlag(ctx, instanceId, period, autoRenewFlag)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudMongodbStandbyInstanceRead(d, meta)
+	return resourceTencentCloudMongodbStandbyInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMongodbStandbyInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMongodbStandbyInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mongodb_standby_instance.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 	mongodbService := MongodbService{
@@ -537,32 +546,32 @@ func resourceTencentCloudMongodbStandbyInstanceDelete(d *schema.ResourceData, me
 
 	instanceDetail, has, err := mongodbService.DescribeInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
 		return nil
 	}
 	if MONGODB_CHARGE_TYPE[*instanceDetail.PayMode] == MONGODB_CHARGE_TYPE_PREPAID {
-		return fmt.Errorf("PREPAID instances are not allowed to be deleted now, please isolate them on console")
+		return diag.Errorf("PREPAID instances are not allowed to be deleted now, please isolate them on console")
 	}
 
 	err = mongodbService.IsolateInstance(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = mongodbService.OfflineIsolatedDBInstance(ctx, instanceId, true)
 	if err != nil {
 		log.Printf("[CRITAL]%s mongodb %s fail, reason:%s", logId, "OfflineIsolatedDBInstance", err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	//describe and check not exist
 	_, has, errRet := mongodbService.DescribeInstanceById(ctx, instanceId)
 	if errRet != nil {
-		return errRet
+		return diag.FromErr(errRet)
 	}
 	if !has {
 		return nil
 	}
-	return fmt.Errorf("[CRITAL]%s mongodb %s fail", logId, "OfflineIsolatedDBInstance")
+	return diag.Errorf("[CRITAL]%s mongodb %s fail", logId, "OfflineIsolatedDBInstance")
 }
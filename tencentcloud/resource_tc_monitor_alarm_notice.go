@@ -70,7 +70,9 @@ package tencentcloud
 
 import (
 	"context"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -80,12 +82,16 @@ import (
 
 func resourceTencentCloudMonitorAlarmNotice() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentMonitorAlarmNoticeCreate,
-		Read:   resourceTencentMonitorAlarmNoticeRead,
-		Update: resourceTencentMonitorAlarmNoticeUpdate,
-		Delete: resourceTencentMonitorAlarmNoticeDelete,
+		CreateContext: resourceTencentMonitorAlarmNoticeCreate,
+		ReadContext:   resourceTencentMonitorAlarmNoticeRead,
+		UpdateContext: resourceTencentMonitorAlarmNoticeUpdate,
+		DeleteContext: resourceTencentMonitorAlarmNoticeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -283,7 +289,7 @@ func resourceTencentCloudMonitorAlarmNotice() *schema.Resource {
 	}
 }
 
-func resourceTencentMonitorAlarmNoticeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorAlarmNoticeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_alarm_notice.create")()
 
 	var (
@@ -427,28 +433,30 @@ func resourceTencentMonitorAlarmNoticeCreate(d *schema.ResourceData, meta interf
 	}
 
 	var noticeId *string
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := monitorService.client.UseMonitorClient().CreateAlarmNotice(request)
+	if err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := monitorService.client.UseMonitorClient().CreateAlarmNoticeWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		noticeId = response.Response.NoticeId
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*noticeId)
 
-	return resourceTencentMonitorAlarmNoticeRead(d, meta)
+	return resourceTencentMonitorAlarmNoticeRead(ctx, d, meta)
 }
 
-func resourceTencentMonitorAlarmNoticeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorAlarmNoticeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_alarm_notice.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		monitorService = MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -463,32 +471,32 @@ func resourceTencentMonitorAlarmNoticeRead(d *schema.ResourceData, meta interfac
 
 	alarmNotice, err = monitorService.DescribeAlarmNoticeById(ctx, alarmNoticeMap)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	for _, noticesItem := range alarmNotice {
 		if err = d.Set("name", noticesItem.Name); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err = d.Set("notice_type", noticesItem.NoticeType); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err = d.Set("notice_language", noticesItem.NoticeLanguage); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err = d.Set("updated_by", noticesItem.UpdatedBy); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err = d.Set("updated_at", noticesItem.UpdatedAt); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err = d.Set("is_preset", noticesItem.IsPreset); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err = d.Set("policy_ids", noticesItem.PolicyIds); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err = d.Set("amp_consumer_id", noticesItem.AMPConsumerId); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		userNoticesItems := make([]interface{}, 0, 100)
@@ -533,13 +541,13 @@ func resourceTencentMonitorAlarmNoticeRead(d *schema.ResourceData, meta interfac
 		}
 
 		if err = d.Set("user_notices", userNoticesItems); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err = d.Set("url_notices", urlNoticesItems); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if err = d.Set("cls_notices", clsNoticesItems); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -547,7 +555,7 @@ func resourceTencentMonitorAlarmNoticeRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentMonitorAlarmNoticeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorAlarmNoticeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_alarm_notice.update")()
 
 	var (
@@ -692,27 +700,29 @@ func resourceTencentMonitorAlarmNoticeUpdate(d *schema.ResourceData, meta interf
 		request.CLSNotices = clsNotices
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := monitorService.client.UseMonitorClient().ModifyAlarmNotice(request)
+	if err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := monitorService.client.UseMonitorClient().ModifyAlarmNoticeWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(d.Id())
 
-	return resourceTencentMonitorAlarmNoticeRead(d, meta)
+	return resourceTencentMonitorAlarmNoticeRead(ctx, d, meta)
 }
 
-func resourceTencentMonitorAlarmNoticeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorAlarmNoticeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_alarm_notice.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		monitorService = MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -720,7 +730,7 @@ func resourceTencentMonitorAlarmNoticeDelete(d *schema.ResourceData, meta interf
 
 	err := monitorService.DeleteMonitorAlarmNoticeById(ctx, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -254,13 +254,14 @@ $ terraform import tencentcloud_monitor_alarm_policy.policy policy-id
 package tencentcloud
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"strconv"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -351,12 +352,17 @@ func AlarmPolicyRule() map[string]*schema.Schema {
 
 func resourceTencentCloudMonitorAlarmPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentMonitorAlarmPolicyCreate,
-		Read:   resourceTencentMonitorAlarmPolicyRead,
-		Update: resourceTencentMonitorAlarmPolicyUpdate,
-		Delete: resourceTencentMonitorAlarmPolicyDelete,
+		CreateContext: resourceTencentMonitorAlarmPolicyCreate,
+		ReadContext:   resourceTencentMonitorAlarmPolicyRead,
+		UpdateContext: resourceTencentMonitorAlarmPolicyUpdate,
+		DeleteContext: resourceTencentMonitorAlarmPolicyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -500,7 +506,7 @@ func resourceTencentCloudMonitorAlarmPolicy() *schema.Resource {
 	}
 }
 
-func resourceTencentMonitorAlarmPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorAlarmPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_alarm_policy.create")()
 	logId := getLogId(contextNil)
 	var (
@@ -537,7 +543,7 @@ func resourceTencentMonitorAlarmPolicyCreate(d *schema.ResourceData, meta interf
 		conditions := v.([]interface{})
 
 		if len(conditions) != 1 {
-			return fmt.Errorf("need only one conditions.")
+			return diag.Errorf("need only one conditions.")
 		}
 
 		condition := conditions[0].(map[string]interface{})
@@ -672,9 +678,11 @@ func resourceTencentMonitorAlarmPolicyCreate(d *schema.ResourceData, meta interf
 
 	var groupId *string
 	var policyId *string
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := monitorService.client.UseMonitorClient().CreateAlarmPolicy(request)
+	if err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := monitorService.client.UseMonitorClient().CreateAlarmPolicyWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -687,7 +695,7 @@ func resourceTencentMonitorAlarmPolicyCreate(d *schema.ResourceData, meta interf
 		groupId = response.Response.OriginId
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(*policyId)
 
@@ -710,9 +718,11 @@ func resourceTencentMonitorAlarmPolicyCreate(d *schema.ResourceData, meta interf
 		}
 		request.Tag = tagSet[0]
 
-		if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			response, err := monitorService.client.UseMonitorClient().BindingPolicyTag(request)
+		if err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			response, err := monitorService.client.UseMonitorClient().BindingPolicyTagWithContext(ctx, request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -722,14 +732,14 @@ func resourceTencentMonitorAlarmPolicyCreate(d *schema.ResourceData, meta interf
 				logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentMonitorAlarmPolicyRead(d, meta)
+	return resourceTencentMonitorAlarmPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentMonitorAlarmPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorAlarmPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_alarm_policy.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -746,16 +756,18 @@ func resourceTencentMonitorAlarmPolicyRead(d *schema.ResourceData, meta interfac
 	request.PolicyId = &policyId
 	request.Module = helper.String("monitor")
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := monitorService.client.UseMonitorClient().DescribeAlarmPolicy(request)
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := monitorService.client.UseMonitorClient().DescribeAlarmPolicyWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		policy = response.Response.Policy
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if policy == nil {
@@ -891,10 +903,10 @@ func resourceTencentMonitorAlarmPolicyRead(d *schema.ResourceData, meta interfac
 			errResults = multierror.Append(errResults, err)
 		}
 	}
-	return errResults.ErrorOrNil()
+	return diag.FromErr(errResults.ErrorOrNil())
 }
 
-func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorAlarmPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_alarm_policy.update")()
 	//logId := getLogId(contextNil)
 	//ctx := context.WithValue(context.TODO(), logIdKey, logId)
@@ -911,14 +923,16 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 		value := d.Get("policy_name").(string)
 		request.Value = helper.String(value)
 
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyInfo(request); err != nil {
+		if err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyInfoWithContext(ctx, request); err != nil {
 				return retryError(err, InternalError)
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -930,14 +944,16 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 		value := d.Get("remark").(string)
 		request.Value = helper.String(value)
 
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyInfo(request); err != nil {
+		if err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyInfoWithContext(ctx, request); err != nil {
 				return retryError(err, InternalError)
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -949,14 +965,16 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 		enable := d.Get("enable").(int)
 		request.Enable = helper.IntInt64(enable)
 
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyStatus(request); err != nil {
+		if err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyStatusWithContext(ctx, request); err != nil {
 				return retryError(err, InternalError)
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -969,7 +987,7 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 			conditions := v.([]interface{})
 
 			if len(conditions) != 1 {
-				return fmt.Errorf("need only one conditions.")
+				return diag.Errorf("need only one conditions.")
 			}
 
 			condition := conditions[0].(map[string]interface{})
@@ -1082,14 +1100,16 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 			request.EventCondition = &eventCondition
 		}
 
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyCondition(request); err != nil {
+		if err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyConditionWithContext(ctx, request); err != nil {
 				return retryError(err, InternalError)
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1106,14 +1126,16 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 			request.NoticeIds = notice
 		}
 
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyNotice(request); err != nil {
+		if err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyNoticeWithContext(ctx, request); err != nil {
 				return retryError(err, InternalError)
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1132,20 +1154,22 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 			}
 			request.TriggerTasks = tasks
 		}
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyTasks(request); err != nil {
+		if err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyTasksWithContext(ctx, request); err != nil {
 				return retryError(err, InternalError)
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentMonitorAlarmPolicyRead(d, meta)
+	return resourceTencentMonitorAlarmPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentMonitorAlarmPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorAlarmPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_alarm_policy.delete")()
 
 	var (
@@ -1156,14 +1180,16 @@ func resourceTencentMonitorAlarmPolicyDelete(d *schema.ResourceData, meta interf
 	policyIds := []*string{helper.String(d.Id())}
 	request.PolicyIds = policyIds
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		if _, err := monitorService.client.UseMonitorClient().DeleteAlarmPolicy(request); err != nil {
+	if err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if _, err := monitorService.client.UseMonitorClient().DeleteAlarmPolicyWithContext(ctx, request); err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
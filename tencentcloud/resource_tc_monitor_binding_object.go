@@ -42,6 +42,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -52,9 +53,13 @@ import (
 func resourceTencentCloudMonitorBindingObject() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource has been deprecated in Terraform TencentCloud provider version 1.60.5. Please use 'tencentcloud_monitor_policy_binding_object' instead.",
-		Create:             resourceTencentMonitorBindingObjectCreate,
-		Read:               resourceTencentMonitorBindingObjectRead,
-		Delete:             resourceTencentMonitorBindingObjectDelete,
+		CreateContext:      resourceTencentMonitorBindingObjectCreate,
+		ReadContext:        resourceTencentMonitorBindingObjectRead,
+		DeleteContext:      resourceTencentMonitorBindingObjectDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"group_id": {
 				Type:        schema.TypeInt,
@@ -96,24 +101,24 @@ func resourceTencentCloudMonitorBindingObject() *schema.Resource {
 	}
 }
 
-func resourceTencentMonitorBindingObjectCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorBindingObjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_binding_object.create")()
 
 	var (
 		logId          = getLogId(contextNil)
-		ctx            = context.WithValue(context.TODO(), logIdKey, logId)
 		monitorService = MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request        = monitor.NewBindingPolicyObjectRequest()
 		idSeeds        []string
 		groupId        = int64(d.Get("group_id").(int))
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	info, err := monitorService.DescribePolicyGroup(ctx, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if info == nil {
-		return fmt.Errorf("policy group %d not exist", groupId)
+		return diag.Errorf("policy group %d not exist", groupId)
 	}
 	request.GroupId = &groupId
 	dimensions := d.Get("dimensions").(*schema.Set).List()
@@ -129,7 +134,7 @@ func resourceTencentMonitorBindingObjectCreate(d *schema.ResourceData, meta inte
 		var region = MonitorRegionMap[monitorService.client.Region]
 
 		if region == "" {
-			return fmt.Errorf("monitor not support region `%s` bind", monitorService.client.Region)
+			return diag.Errorf("monitor not support region `%s` bind", monitorService.client.Region)
 		}
 		idSeeds = append(idSeeds, dimensionsJson, region)
 		dimension.Dimensions = &dimensionsJson
@@ -139,14 +144,16 @@ func resourceTencentMonitorBindingObjectCreate(d *schema.ResourceData, meta inte
 	}
 
 	request.Module = helper.String("monitor")
-	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		if _, err = monitorService.client.UseMonitorClient().BindingPolicyObject(request); err != nil {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if _, err = monitorService.client.UseMonitorClient().BindingPolicyObjectWithContext(ctx, request); err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(helper.DataResourceIdsHash(idSeeds))
 	time.Sleep(3 * time.Second)
@@ -154,7 +161,7 @@ func resourceTencentMonitorBindingObjectCreate(d *schema.ResourceData, meta inte
 	objects, err := monitorService.DescribeBindingPolicyObjectList(ctx, groupId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	successDimensionsJsonMap := make(map[string]bool)
@@ -169,35 +176,35 @@ func resourceTencentMonitorBindingObjectCreate(d *schema.ResourceData, meta inte
 	}
 
 	if len(bindingFails) > 0 {
-		return fmt.Errorf("bind objects to policy has partial failure,Please check if it is an instance of this region `%s`,[%s]",
+		return diag.Errorf("bind objects to policy has partial failure,Please check if it is an instance of this region `%s`,[%s]",
 			monitorService.client.Region, helper.SliceFieldSerialize(bindingFails))
 	}
 
-	return resourceTencentMonitorBindingObjectRead(d, meta)
+	return resourceTencentMonitorBindingObjectRead(ctx, d, meta)
 }
 
-func resourceTencentMonitorBindingObjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorBindingObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_binding_object.read")()
 	defer inconsistentCheck(d, meta)()
 	var (
 		logId          = getLogId(contextNil)
-		ctx            = context.WithValue(context.TODO(), logIdKey, logId)
 		monitorService = MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 		groupId        = int64(d.Get("group_id").(int))
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	info, err := monitorService.DescribePolicyGroup(ctx, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if info == nil {
-		return fmt.Errorf("policy group %d not exist", groupId)
+		return diag.Errorf("policy group %d not exist", groupId)
 	}
 
 	objects, err := monitorService.DescribeBindingPolicyObjectList(ctx, groupId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	getUniqueId := func(dimensionsJson string) (has bool, uniqueId string) {
@@ -231,30 +238,30 @@ func resourceTencentMonitorBindingObjectRead(d *schema.ResourceData, meta interf
 		return nil
 	}
 
-	return d.Set("dimensions", newDimensions)
+	return diag.FromErr(d.Set("dimensions", newDimensions))
 }
-func resourceTencentMonitorBindingObjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorBindingObjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_binding_object.delete")()
 
 	var (
 		logId          = getLogId(contextNil)
-		ctx            = context.WithValue(context.TODO(), logIdKey, logId)
 		monitorService = MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 		groupId        = int64(d.Get("group_id").(int))
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	info, err := monitorService.DescribePolicyGroup(ctx, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if info == nil {
-		return fmt.Errorf("policy group %d not exist", groupId)
+		return diag.Errorf("policy group %d not exist", groupId)
 	}
 
 	objects, err := monitorService.DescribeBindingPolicyObjectList(ctx, groupId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	getUniqueId := func(dimensionsJson string) (has bool, uniqueId string) {
 		for _, item := range objects {
@@ -291,14 +298,16 @@ func resourceTencentMonitorBindingObjectDelete(d *schema.ResourceData, meta inte
 	request.GroupId = &groupId
 	request.UniqueId = uniqueIds
 
-	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		if _, err = monitorService.client.UseMonitorClient().UnBindingPolicyObject(request); err != nil {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if _, err = monitorService.client.UseMonitorClient().UnBindingPolicyObjectWithContext(ctx, request); err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -35,14 +35,24 @@ resource "tencentcloud_monitor_binding_receiver" "receiver" {
   }
 }
 ```
+
+Import
+
+Monitor binding receiver can be imported using the policy group ID, e.g.
+
+```
+$ terraform import tencentcloud_monitor_binding_receiver.receiver 2082
+```
 */
 package tencentcloud
 
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -52,10 +62,18 @@ import (
 
 func resourceTencentCloudMonitorBindingAlarmReceiver() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentMonitorBindingAlarmReceiverCreate,
-		Read:   resourceTencentMonitorBindingAlarmReceiverRead,
-		Update: resourceTencentMonitorBindingAlarmReceiverUpdate,
-		Delete: resourceTencentMonitorBindingAlarmReceiverDelete,
+		CreateContext: resourceTencentMonitorBindingAlarmReceiverCreate,
+		ReadContext:   resourceTencentMonitorBindingAlarmReceiverRead,
+		UpdateContext: resourceTencentMonitorBindingAlarmReceiverUpdate,
+		DeleteContext: resourceTencentMonitorBindingAlarmReceiverDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTencentMonitorBindingAlarmReceiverImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"group_id": {
 				Type:        schema.TypeInt,
@@ -123,24 +141,24 @@ func resourceTencentCloudMonitorBindingAlarmReceiver() *schema.Resource {
 	}
 }
 
-func resourceTencentMonitorBindingAlarmReceiverCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorBindingAlarmReceiverCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_binding_receiver.create")()
 
 	var (
 		logId          = getLogId(contextNil)
-		ctx            = context.WithValue(context.TODO(), logIdKey, logId)
 		monitorService = MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request        = monitor.NewModifyAlarmReceiversRequest()
 		groupId        = int64(d.Get("group_id").(int))
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	info, err := monitorService.DescribePolicyGroup(ctx, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if info == nil {
-		return fmt.Errorf("policy group %d not exist", groupId)
+		return diag.Errorf("policy group %d not exist", groupId)
 	}
 
 	request.GroupId = &groupId
@@ -165,12 +183,12 @@ func resourceTencentMonitorBindingAlarmReceiverCreate(d *schema.ResourceData, me
 
 		if *receiverInfo.ReceiverType == monitorReceiverTypeGroup {
 			if len(receiverInfo.ReceiverGroupList) < 1 {
-				return fmt.Errorf("miss field receiver_group_list, this array at least  has one element when you choose `group` receiver_type")
+				return diag.Errorf("miss field receiver_group_list, this array at least  has one element when you choose `group` receiver_type")
 			}
 		}
 		if *receiverInfo.ReceiverType == monitorReceiverTypeUser {
 			if len(receiverInfo.ReceiverUserList) < 1 {
-				return fmt.Errorf("miss field receiver_user_list, this array at least  has one element when you choose `user` receiver_type")
+				return diag.Errorf("miss field receiver_user_list, this array at least  has one element when you choose `user` receiver_type")
 			}
 		}
 
@@ -178,35 +196,37 @@ func resourceTencentMonitorBindingAlarmReceiverCreate(d *schema.ResourceData, me
 		request.ReceiverInfos = append(request.ReceiverInfos, &receiverInfo)
 	}
 
-	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		if _, err = monitorService.client.UseMonitorClient().ModifyAlarmReceivers(request); err != nil {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if _, err = monitorService.client.UseMonitorClient().ModifyAlarmReceiversWithContext(ctx, request); err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%d", groupId))
 	time.Sleep(3 * time.Second)
 
-	return resourceTencentMonitorBindingAlarmReceiverRead(d, meta)
+	return resourceTencentMonitorBindingAlarmReceiverRead(ctx, d, meta)
 }
 
-func resourceTencentMonitorBindingAlarmReceiverRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorBindingAlarmReceiverRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_binding_receiver.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId          = getLogId(contextNil)
-		ctx            = context.WithValue(context.TODO(), logIdKey, logId)
 		monitorService = MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 		groupId        = int64(d.Get("group_id").(int))
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	info, err := monitorService.DescribePolicyGroup(ctx, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if info == nil {
@@ -228,22 +248,22 @@ func resourceTencentMonitorBindingAlarmReceiverRead(d *schema.ResourceData, meta
 		list = append(list, receiverMap)
 
 	}
-	return d.Set("receivers", list)
+	return diag.FromErr(d.Set("receivers", list))
 
 }
-func resourceTencentMonitorBindingAlarmReceiverUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorBindingAlarmReceiverUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_binding_receiver.update")()
 	var (
 		logId          = getLogId(contextNil)
-		ctx            = context.WithValue(context.TODO(), logIdKey, logId)
 		monitorService = MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request        = monitor.NewModifyAlarmReceiversRequest()
 		groupId        = int64(d.Get("group_id").(int))
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	info, err := monitorService.DescribePolicyGroup(ctx, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if info == nil {
@@ -272,45 +292,47 @@ func resourceTencentMonitorBindingAlarmReceiverUpdate(d *schema.ResourceData, me
 		}
 		if *receiverInfo.ReceiverType == monitorReceiverTypeGroup {
 			if len(receiverInfo.ReceiverGroupList) < 1 {
-				return fmt.Errorf("miss field receiver_group_list, this array at least  has one element when you choose `group` receiver_type")
+				return diag.Errorf("miss field receiver_group_list, this array at least  has one element when you choose `group` receiver_type")
 			}
 		}
 		if *receiverInfo.ReceiverType == monitorReceiverTypeUser {
 			if len(receiverInfo.ReceiverUserList) < 1 {
-				return fmt.Errorf("miss field receiver_user_list, this array at least  has one element when you choose `user` receiver_type")
+				return diag.Errorf("miss field receiver_user_list, this array at least  has one element when you choose `user` receiver_type")
 			}
 		}
 		receiverInfo.ReceiveLanguage = helper.String(ifaceMap["receive_language"].(string))
 		request.ReceiverInfos = append(request.ReceiverInfos, &receiverInfo)
 	}
 
-	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		if _, err = monitorService.client.UseMonitorClient().ModifyAlarmReceivers(request); err != nil {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if _, err = monitorService.client.UseMonitorClient().ModifyAlarmReceiversWithContext(ctx, request); err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	time.Sleep(3 * time.Second)
-	return resourceTencentMonitorBindingAlarmReceiverRead(d, meta)
+	return resourceTencentMonitorBindingAlarmReceiverRead(ctx, d, meta)
 }
 
-func resourceTencentMonitorBindingAlarmReceiverDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorBindingAlarmReceiverDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_binding_receiver.delete")()
 
 	var (
 		logId          = getLogId(contextNil)
-		ctx            = context.WithValue(context.TODO(), logIdKey, logId)
 		monitorService = MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request        = monitor.NewModifyAlarmReceiversRequest()
 		groupId        = int64(d.Get("group_id").(int))
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	info, err := monitorService.DescribePolicyGroup(ctx, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if info == nil {
@@ -320,14 +342,26 @@ func resourceTencentMonitorBindingAlarmReceiverDelete(d *schema.ResourceData, me
 
 	request.GroupId = &groupId
 	request.Module = helper.String("monitor")
-	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		if _, err = monitorService.client.UseMonitorClient().ModifyAlarmReceivers(request); err != nil {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if _, err = monitorService.client.UseMonitorClient().ModifyAlarmReceiversWithContext(ctx, request); err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
+
+// resourceTencentMonitorBindingAlarmReceiverImport imports the receivers by the policy group ID.
+func resourceTencentMonitorBindingAlarmReceiverImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	groupId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("id is broken, expected the policy group ID, got %s", d.Id())
+	}
+	_ = d.Set("group_id", groupId)
+	return []*schema.ResourceData{d}, nil
+}
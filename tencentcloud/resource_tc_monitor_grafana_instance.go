@@ -47,7 +47,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -56,12 +58,17 @@ import (
 
 func resourceTencentCloudMonitorGrafanaInstance() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorGrafanaInstanceRead,
-		Create: resourceTencentCloudMonitorGrafanaInstanceCreate,
-		Update: resourceTencentCloudMonitorGrafanaInstanceUpdate,
-		Delete: resourceTencentCloudMonitorGrafanaInstanceDelete,
+		ReadContext:   resourceTencentCloudMonitorGrafanaInstanceRead,
+		CreateContext: resourceTencentCloudMonitorGrafanaInstanceCreate,
+		UpdateContext: resourceTencentCloudMonitorGrafanaInstanceUpdate,
+		DeleteContext: resourceTencentCloudMonitorGrafanaInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
@@ -153,7 +160,7 @@ func resourceTencentCloudMonitorGrafanaInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMonitorGrafanaInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorGrafanaInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_grafana_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -189,8 +196,8 @@ func resourceTencentCloudMonitorGrafanaInstanceCreate(d *schema.ResourceData, me
 		request.EnableInternet = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreateGrafanaInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreateGrafanaInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -203,15 +210,15 @@ func resourceTencentCloudMonitorGrafanaInstanceCreate(d *schema.ResourceData, me
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create monitor grafanaInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	grafanaInstanceId := *response.Response.InstanceId
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, errRet := service.DescribeMonitorGrafanaInstance(ctx, grafanaInstanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -225,7 +232,7 @@ func resourceTencentCloudMonitorGrafanaInstanceCreate(d *schema.ResourceData, me
 		return resource.RetryableError(fmt.Errorf("grafanaInstance status is %v, retry...", *instance.InstanceStatus))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(grafanaInstanceId)
@@ -234,18 +241,18 @@ func resourceTencentCloudMonitorGrafanaInstanceCreate(d *schema.ResourceData, me
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::monitor:%s:uin/:grafana-instance/%s", region, grafanaInstanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudMonitorGrafanaInstanceRead(d, meta)
+	return resourceTencentCloudMonitorGrafanaInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorGrafanaInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorGrafanaInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_grafana_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -254,12 +261,12 @@ func resourceTencentCloudMonitorGrafanaInstanceRead(d *schema.ResourceData, meta
 	grafanaInstance, err := service.DescribeMonitorGrafanaInstance(ctx, instanceId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if grafanaInstance == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `grafanaInstance` %s does not exist", instanceId)
+		return diag.Errorf("resource `grafanaInstance` %s does not exist", instanceId)
 	}
 
 	if grafanaInstance.InstanceName != nil {
@@ -308,19 +315,19 @@ func resourceTencentCloudMonitorGrafanaInstanceRead(d *schema.ResourceData, meta
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "monitor", "grafana-instance", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudMonitorGrafanaInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorGrafanaInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_grafana_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := monitor.NewModifyGrafanaInstanceRequest()
 
@@ -335,23 +342,23 @@ func resourceTencentCloudMonitorGrafanaInstanceUpdate(d *schema.ResourceData, me
 	}
 
 	if d.HasChange("vpc_id") {
-		return fmt.Errorf("`vpc_id` do not support change now.")
+		return diag.Errorf("`vpc_id` do not support change now.")
 	}
 
 	if d.HasChange("subnet_ids") {
-		return fmt.Errorf("`subnet_ids` do not support change now.")
+		return diag.Errorf("`subnet_ids` do not support change now.")
 	}
 
 	if d.HasChange("grafana_init_password") {
-		return fmt.Errorf("`grafana_init_password` do not support change now.")
+		return diag.Errorf("`grafana_init_password` do not support change now.")
 	}
 
 	if d.HasChange("enable_internet") {
-		return fmt.Errorf("`enable_internet` do not support change now.")
+		return diag.Errorf("`enable_internet` do not support change now.")
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().ModifyGrafanaInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().ModifyGrafanaInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -362,7 +369,7 @@ func resourceTencentCloudMonitorGrafanaInstanceUpdate(d *schema.ResourceData, me
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
@@ -372,29 +379,29 @@ func resourceTencentCloudMonitorGrafanaInstanceUpdate(d *schema.ResourceData, me
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("monitor", "grafana-instance", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMonitorGrafanaInstanceRead(d, meta)
+	return resourceTencentCloudMonitorGrafanaInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorGrafanaInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorGrafanaInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_grafana_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	instanceId := d.Id()
 
 	if err := service.DeleteMonitorGrafanaInstanceById(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err := resource.Retry(1*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		instance, errRet := service.DescribeMonitorGrafanaInstance(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -408,7 +415,7 @@ func resourceTencentCloudMonitorGrafanaInstanceDelete(d *schema.ResourceData, me
 		return resource.RetryableError(fmt.Errorf("grafanaInstance status is %v, retry...", *instance.InstanceStatus))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	claenFlag := false
@@ -420,10 +427,10 @@ func resourceTencentCloudMonitorGrafanaInstanceDelete(d *schema.ResourceData, me
 	}
 	if claenFlag {
 		if err := service.CleanGrafanaInstanceById(ctx, instanceId); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			instance, errRet := service.DescribeMonitorGrafanaInstance(ctx, instanceId)
 			if errRet != nil {
 				return retryError(errRet, InternalError)
@@ -434,7 +441,7 @@ func resourceTencentCloudMonitorGrafanaInstanceDelete(d *schema.ResourceData, me
 			return resource.RetryableError(fmt.Errorf("grafanaInstance status is %v, retry...", *instance.InstanceStatus))
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -40,15 +40,23 @@ resource "tencentcloud_monitor_grafana_integration" "grafanaIntegration" {
   content     = "{\"kind\":\"tencentcloud-monitor-app\",\"spec\":{\"dataSourceSpec\":{\"authProvider\":{\"__anyOf\":\"使用密钥\",\"useRole\":true,\"secretId\":\"arunma@tencent.com\",\"secretKey\":\"12345678\"},\"name\":\"uint-test\"},\"grafanaSpec\":{\"organizationIds\":[]}}}"
 }
 ```
+
+Import
+
+monitor grafanaIntegration can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_monitor_grafana_integration.grafanaIntegration integration-3fuomvmd#grafana-50nj6v00
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -57,10 +65,17 @@ import (
 
 func resourceTencentCloudMonitorGrafanaIntegration() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorGrafanaIntegrationRead,
-		Create: resourceTencentCloudMonitorGrafanaIntegrationCreate,
-		Update: resourceTencentCloudMonitorGrafanaIntegrationUpdate,
-		Delete: resourceTencentCloudMonitorGrafanaIntegrationDelete,
+		ReadContext:   resourceTencentCloudMonitorGrafanaIntegrationRead,
+		CreateContext: resourceTencentCloudMonitorGrafanaIntegrationCreate,
+		UpdateContext: resourceTencentCloudMonitorGrafanaIntegrationUpdate,
+		DeleteContext: resourceTencentCloudMonitorGrafanaIntegrationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -99,7 +114,7 @@ func resourceTencentCloudMonitorGrafanaIntegration() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMonitorGrafanaIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorGrafanaIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_grafana_integration.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -125,8 +140,8 @@ func resourceTencentCloudMonitorGrafanaIntegrationCreate(d *schema.ResourceData,
 		request.Content = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreateGrafanaIntegration(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreateGrafanaIntegrationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -139,27 +154,27 @@ func resourceTencentCloudMonitorGrafanaIntegrationCreate(d *schema.ResourceData,
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create monitor grafanaIntegration failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	integrationId = *response.Response.IntegrationId
 
-	d.SetId(strings.Join([]string{integrationId, instanceId}, FILED_SP))
-	return resourceTencentCloudMonitorGrafanaIntegrationRead(d, meta)
+	d.SetId(helper.IdFormat(integrationId, instanceId))
+	return resourceTencentCloudMonitorGrafanaIntegrationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorGrafanaIntegrationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorGrafanaIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_grafana_integration.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	integrationId := idSplit[0]
 	instanceId := idSplit[1]
@@ -167,12 +182,12 @@ func resourceTencentCloudMonitorGrafanaIntegrationRead(d *schema.ResourceData, m
 	grafanaIntegration, err := service.DescribeMonitorGrafanaIntegration(ctx, integrationId, instanceId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if grafanaIntegration == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `grafanaIntegration` %s does not exist", integrationId)
+		return diag.Errorf("resource `grafanaIntegration` %s does not exist", integrationId)
 	}
 
 	_ = d.Set("instance_id", instanceId)
@@ -192,7 +207,7 @@ func resourceTencentCloudMonitorGrafanaIntegrationRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceTencentCloudMonitorGrafanaIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorGrafanaIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_grafana_integration.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -200,9 +215,9 @@ func resourceTencentCloudMonitorGrafanaIntegrationUpdate(d *schema.ResourceData,
 
 	request := monitor.NewUpdateGrafanaIntegrationRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	integrationId := idSplit[0]
 	instanceId := idSplit[1]
@@ -211,11 +226,11 @@ func resourceTencentCloudMonitorGrafanaIntegrationUpdate(d *schema.ResourceData,
 	request.InstanceId = &instanceId
 
 	if d.HasChange("instance_id") {
-		return fmt.Errorf("`instance_id` do not support change now.")
+		return diag.Errorf("`instance_id` do not support change now.")
 	}
 
 	if d.HasChange("kind") {
-		return fmt.Errorf("`kind` do not support change now.")
+		return diag.Errorf("`kind` do not support change now.")
 	} else {
 		if v, ok := d.GetOk("kind"); ok {
 			request.Kind = helper.String(v.(string))
@@ -228,8 +243,8 @@ func resourceTencentCloudMonitorGrafanaIntegrationUpdate(d *schema.ResourceData,
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().UpdateGrafanaIntegration(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().UpdateGrafanaIntegrationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -240,30 +255,30 @@ func resourceTencentCloudMonitorGrafanaIntegrationUpdate(d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMonitorGrafanaIntegrationRead(d, meta)
+	return resourceTencentCloudMonitorGrafanaIntegrationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorGrafanaIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorGrafanaIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_grafana_integration.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	integrationId := idSplit[0]
 	instanceId := idSplit[1]
 
 	if err := service.DeleteMonitorGrafanaIntegrationById(ctx, integrationId, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -69,16 +69,24 @@ resource "tencentcloud_monitor_grafana_notification_channel" "grafanaNotificatio
   extra_org_ids = ["1"]
 }
 
+```
+
+Import
+
+monitor grafanaNotificationChannel can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_monitor_grafana_notification_channel.grafanaNotificationChannel nchannel-1ovrnx3n#grafana-50nj6v00
 ```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -87,10 +95,17 @@ import (
 
 func resourceTencentCloudMonitorGrafanaNotificationChannel() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorGrafanaNotificationChannelRead,
-		Create: resourceTencentCloudMonitorGrafanaNotificationChannelCreate,
-		Update: resourceTencentCloudMonitorGrafanaNotificationChannelUpdate,
-		Delete: resourceTencentCloudMonitorGrafanaNotificationChannelDelete,
+		ReadContext:   resourceTencentCloudMonitorGrafanaNotificationChannelRead,
+		CreateContext: resourceTencentCloudMonitorGrafanaNotificationChannelCreate,
+		UpdateContext: resourceTencentCloudMonitorGrafanaNotificationChannelUpdate,
+		DeleteContext: resourceTencentCloudMonitorGrafanaNotificationChannelDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -141,7 +156,7 @@ func resourceTencentCloudMonitorGrafanaNotificationChannel() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMonitorGrafanaNotificationChannelCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorGrafanaNotificationChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_grafana_notification_channel.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -183,8 +198,8 @@ func resourceTencentCloudMonitorGrafanaNotificationChannelCreate(d *schema.Resou
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreateGrafanaNotificationChannel(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreateGrafanaNotificationChannelWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -197,27 +212,27 @@ func resourceTencentCloudMonitorGrafanaNotificationChannelCreate(d *schema.Resou
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create monitor grafanaNotificationChannel failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	channelId = *response.Response.ChannelId
 
-	d.SetId(channelId + FILED_SP + instanceId)
-	return resourceTencentCloudMonitorGrafanaNotificationChannelRead(d, meta)
+	d.SetId(helper.IdFormat(channelId, instanceId))
+	return resourceTencentCloudMonitorGrafanaNotificationChannelRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorGrafanaNotificationChannelRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorGrafanaNotificationChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_grafana_notification_channel.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	channelId := idSplit[0]
 	instanceId := idSplit[1]
@@ -225,12 +240,12 @@ func resourceTencentCloudMonitorGrafanaNotificationChannelRead(d *schema.Resourc
 	grafanaNotificationChannel, err := service.DescribeMonitorGrafanaNotificationChannel(ctx, channelId, instanceId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if grafanaNotificationChannel == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `grafanaNotificationChannel` %s does not exist", channelId)
+		return diag.Errorf("resource `grafanaNotificationChannel` %s does not exist", channelId)
 	}
 
 	_ = d.Set("instance_id", instanceId)
@@ -248,7 +263,7 @@ func resourceTencentCloudMonitorGrafanaNotificationChannelRead(d *schema.Resourc
 	return nil
 }
 
-func resourceTencentCloudMonitorGrafanaNotificationChannelUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorGrafanaNotificationChannelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_grafana_notification_channel.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -256,9 +271,9 @@ func resourceTencentCloudMonitorGrafanaNotificationChannelUpdate(d *schema.Resou
 
 	request := monitor.NewUpdateGrafanaNotificationChannelRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	channelId := idSplit[0]
 	instanceId := idSplit[1]
@@ -271,27 +286,27 @@ func resourceTencentCloudMonitorGrafanaNotificationChannelUpdate(d *schema.Resou
 	}
 
 	if d.HasChange("instance_id") {
-		return fmt.Errorf("`instance_id` do not support change now.")
+		return diag.Errorf("`instance_id` do not support change now.")
 	}
 
 	if d.HasChange("channel_name") {
-		return fmt.Errorf("`channel_name` do not support change now.")
+		return diag.Errorf("`channel_name` do not support change now.")
 	}
 
 	if d.HasChange("org_id") {
-		return fmt.Errorf("`org_id` do not support change now.")
+		return diag.Errorf("`org_id` do not support change now.")
 	}
 
 	if d.HasChange("receivers") {
-		return fmt.Errorf("`receivers` do not support change now.")
+		return diag.Errorf("`receivers` do not support change now.")
 	}
 
 	if d.HasChange("extra_org_ids") {
-		return fmt.Errorf("`extra_org_ids` do not support change now.")
+		return diag.Errorf("`extra_org_ids` do not support change now.")
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().UpdateGrafanaNotificationChannel(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().UpdateGrafanaNotificationChannelWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -302,30 +317,30 @@ func resourceTencentCloudMonitorGrafanaNotificationChannelUpdate(d *schema.Resou
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMonitorGrafanaNotificationChannelRead(d, meta)
+	return resourceTencentCloudMonitorGrafanaNotificationChannelRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorGrafanaNotificationChannelDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorGrafanaNotificationChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_grafana_notification_channel.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	channelId := idSplit[0]
 	instanceId := idSplit[1]
 
 	if err := service.DeleteMonitorGrafanaNotificationChannelById(ctx, channelId, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
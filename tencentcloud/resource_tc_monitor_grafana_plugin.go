@@ -52,8 +52,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -62,12 +63,15 @@ import (
 
 func resourceTencentCloudMonitorGrafanaPlugin() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorGrafanaPluginRead,
-		Create: resourceTencentCloudMonitorGrafanaPluginCreate,
-		Update: resourceTencentCloudMonitorGrafanaPluginUpdate,
-		Delete: resourceTencentCloudMonitorGrafanaPluginDelete,
+		ReadContext:   resourceTencentCloudMonitorGrafanaPluginRead,
+		CreateContext: resourceTencentCloudMonitorGrafanaPluginCreate,
+		UpdateContext: resourceTencentCloudMonitorGrafanaPluginUpdate,
+		DeleteContext: resourceTencentCloudMonitorGrafanaPluginDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -92,7 +96,7 @@ func resourceTencentCloudMonitorGrafanaPlugin() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMonitorGrafanaPluginCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorGrafanaPluginCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_grafana_plugin.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -122,8 +126,8 @@ func resourceTencentCloudMonitorGrafanaPluginCreate(d *schema.ResourceData, meta
 	}
 	request.Plugins = append(request.Plugins, &plugin)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().InstallPlugins(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().InstallPluginsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -136,13 +140,13 @@ func resourceTencentCloudMonitorGrafanaPluginCreate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create monitor grafanaPlugin failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	descResquest.PluginId = &pluginId
 	descResquest.InstanceId = &instanceId
-	outErr := resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
-		response, err := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().DescribeInstalledPlugins(descResquest)
+	outErr := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		response, err := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().DescribeInstalledPluginsWithContext(ctx, descResquest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -155,25 +159,25 @@ func resourceTencentCloudMonitorGrafanaPluginCreate(d *schema.ResourceData, meta
 	})
 	if outErr != nil {
 		log.Printf("[CRITAL]%s Inquire monitor grafanaPlugin failed, reason:%+v", logId, outErr)
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	d.SetId(strings.Join([]string{instanceId, pluginId}, FILED_SP))
-	return resourceTencentCloudMonitorGrafanaPluginRead(d, meta)
+	d.SetId(helper.IdFormat(instanceId, pluginId))
+	return resourceTencentCloudMonitorGrafanaPluginRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorGrafanaPluginRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorGrafanaPluginRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_grafana_plugin.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	pluginId := idSplit[1]
@@ -181,12 +185,12 @@ func resourceTencentCloudMonitorGrafanaPluginRead(d *schema.ResourceData, meta i
 	grafanaPlugin, err := service.DescribeMonitorGrafanaPlugin(ctx, instanceId, pluginId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if grafanaPlugin == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `grafanaPlugin` %s does not exist", pluginId)
+		return diag.Errorf("resource `grafanaPlugin` %s does not exist", pluginId)
 	}
 
 	_ = d.Set("instance_id", instanceId)
@@ -202,15 +206,15 @@ func resourceTencentCloudMonitorGrafanaPluginRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudMonitorGrafanaPluginUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorGrafanaPluginUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_grafana_plugin.update")()
 	defer inconsistentCheck(d, meta)()
 
 	request := monitor.NewUninstallGrafanaPluginsRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	pluginId := idSplit[1]
@@ -219,39 +223,38 @@ func resourceTencentCloudMonitorGrafanaPluginUpdate(d *schema.ResourceData, meta
 	request.PluginIds = []*string{&pluginId}
 
 	if d.HasChange("instance_id") {
-		return fmt.Errorf("`instance_id` do not support change now.")
+		return diag.Errorf("`instance_id` do not support change now.")
 	}
 
 	if d.HasChange("plugin_id") {
-		return fmt.Errorf("`plugin_id` do not support change now.")
+		return diag.Errorf("`plugin_id` do not support change now.")
 	}
 
-	err := resourceTencentCloudMonitorGrafanaPluginDelete(d, meta)
-	if err != nil {
-		return err
+	if diags := resourceTencentCloudMonitorGrafanaPluginDelete(ctx, d, meta); diags.HasError() {
+		return diags
 	}
 
-	return resourceTencentCloudMonitorGrafanaPluginCreate(d, meta)
+	return resourceTencentCloudMonitorGrafanaPluginCreate(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorGrafanaPluginDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorGrafanaPluginDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_grafana_plugin.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	pluginId := idSplit[1]
 
 	if err := service.DeleteMonitorGrafanaPluginById(ctx, instanceId, pluginId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -54,10 +54,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -66,12 +66,16 @@ import (
 
 func resourceTencentCloudMonitorGrafanaSsoAccount() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorGrafanaSsoAccountRead,
-		Create: resourceTencentCloudMonitorGrafanaSsoAccountCreate,
-		Update: resourceTencentCloudMonitorGrafanaSsoAccountUpdate,
-		Delete: resourceTencentCloudMonitorGrafanaSsoAccountDelete,
+		ReadContext:   resourceTencentCloudMonitorGrafanaSsoAccountRead,
+		CreateContext: resourceTencentCloudMonitorGrafanaSsoAccountCreate,
+		UpdateContext: resourceTencentCloudMonitorGrafanaSsoAccountUpdate,
+		DeleteContext: resourceTencentCloudMonitorGrafanaSsoAccountDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -117,7 +121,7 @@ func resourceTencentCloudMonitorGrafanaSsoAccount() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMonitorGrafanaSsoAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorGrafanaSsoAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_grafana_sso_account.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -158,8 +162,8 @@ func resourceTencentCloudMonitorGrafanaSsoAccountCreate(d *schema.ResourceData,
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreateSSOAccount(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreateSSOAccountWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -172,27 +176,27 @@ func resourceTencentCloudMonitorGrafanaSsoAccountCreate(d *schema.ResourceData,
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create monitor ssoAccount failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	userId = *response.Response.UserId
 
-	d.SetId(strings.Join([]string{instanceId, userId}, FILED_SP))
-	return resourceTencentCloudMonitorGrafanaSsoAccountRead(d, meta)
+	d.SetId(helper.IdFormat(instanceId, userId))
+	return resourceTencentCloudMonitorGrafanaSsoAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorGrafanaSsoAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorGrafanaSsoAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_grafana_sso_account.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	userId := idSplit[1]
@@ -200,12 +204,12 @@ func resourceTencentCloudMonitorGrafanaSsoAccountRead(d *schema.ResourceData, me
 	ssoAccount, err := service.DescribeMonitorSsoAccount(ctx, instanceId, userId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ssoAccount == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `ssoAccount` %s does not exist", userId)
+		return diag.Errorf("resource `ssoAccount` %s does not exist", userId)
 	}
 
 	_ = d.Set("instance_id", instanceId)
@@ -232,7 +236,7 @@ func resourceTencentCloudMonitorGrafanaSsoAccountRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudMonitorGrafanaSsoAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorGrafanaSsoAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_grafana_sso_account.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -240,9 +244,9 @@ func resourceTencentCloudMonitorGrafanaSsoAccountUpdate(d *schema.ResourceData,
 
 	request := monitor.NewUpdateSSOAccountRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	instanceId := idSplit[0]
 	userId := idSplit[1]
@@ -251,11 +255,11 @@ func resourceTencentCloudMonitorGrafanaSsoAccountUpdate(d *schema.ResourceData,
 	request.UserId = &userId
 
 	if d.HasChange("instance_id") {
-		return fmt.Errorf("`instance_id` do not support change now.")
+		return diag.Errorf("`instance_id` do not support change now.")
 	}
 
 	if d.HasChange("user_id") {
-		return fmt.Errorf("`user_id` do not support change now.")
+		return diag.Errorf("`user_id` do not support change now.")
 	}
 
 	if d.HasChange("notes") {
@@ -281,8 +285,8 @@ func resourceTencentCloudMonitorGrafanaSsoAccountUpdate(d *schema.ResourceData,
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().UpdateSSOAccount(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().UpdateSSOAccountWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -293,30 +297,30 @@ func resourceTencentCloudMonitorGrafanaSsoAccountUpdate(d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMonitorGrafanaSsoAccountRead(d, meta)
+	return resourceTencentCloudMonitorGrafanaSsoAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorGrafanaSsoAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorGrafanaSsoAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_grafana_sso_account.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	userId := idSplit[1]
 
 	if err := service.DeleteMonitorSsoAccountById(ctx, instanceId, userId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -67,9 +67,9 @@ package tencentcloud
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -79,11 +79,15 @@ import (
 
 func resourceTencentCloudMonitorPolicyBindingObject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentMonitorPolicyBindingObjectCreate,
-		Read:   resourceTencentMonitorPolicyBindingObjectRead,
-		Delete: resourceTencentMonitorPolicyBindingObjectDelete,
+		CreateContext: resourceTencentMonitorPolicyBindingObjectCreate,
+		ReadContext:   resourceTencentMonitorPolicyBindingObjectRead,
+		DeleteContext: resourceTencentMonitorPolicyBindingObjectDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"policy_id": {
@@ -126,23 +130,23 @@ func resourceTencentCloudMonitorPolicyBindingObject() *schema.Resource {
 	}
 }
 
-func resourceTencentMonitorPolicyBindingObjectCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorPolicyBindingObjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_binding_object.create")()
 
 	var (
 		logId          = getLogId(contextNil)
-		ctx            = context.WithValue(context.TODO(), logIdKey, logId)
 		monitorService = MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request        = monitor.NewBindingPolicyObjectRequest()
 		policyId       = d.Get("policy_id").(string)
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	info, err := monitorService.DescribeAlarmPolicyById(ctx, policyId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if info == nil {
-		return fmt.Errorf("alarm policy %s not exist", policyId)
+		return diag.Errorf("alarm policy %s not exist", policyId)
 	}
 	request.GroupId = helper.Int64(0)
 	request.PolicyId = &policyId
@@ -157,7 +161,7 @@ func resourceTencentMonitorPolicyBindingObjectCreate(d *schema.ResourceData, met
 		var region = MonitorRegionMap[monitorService.client.Region]
 
 		if region == "" {
-			return fmt.Errorf("monitor not support region `%s` bind", monitorService.client.Region)
+			return diag.Errorf("monitor not support region `%s` bind", monitorService.client.Region)
 		}
 		dimension.Dimensions = &dimensionsJson
 		dimension.Region = &region
@@ -165,46 +169,48 @@ func resourceTencentMonitorPolicyBindingObjectCreate(d *schema.ResourceData, met
 	}
 
 	request.Module = helper.String("monitor")
-	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		if _, err = monitorService.client.UseMonitorClient().BindingPolicyObject(request); err != nil {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if _, err = monitorService.client.UseMonitorClient().BindingPolicyObjectWithContext(ctx, request); err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(policyId)
 	time.Sleep(3 * time.Second)
 
-	return resourceTencentMonitorPolicyBindingObjectRead(d, meta)
+	return resourceTencentMonitorPolicyBindingObjectRead(ctx, d, meta)
 }
 
-func resourceTencentMonitorPolicyBindingObjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorPolicyBindingObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_policy_binding_object.read")()
 	defer inconsistentCheck(d, meta)()
 	var (
 		logId          = getLogId(contextNil)
-		ctx            = context.WithValue(context.TODO(), logIdKey, logId)
 		monitorService = MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 		policyId       = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	_ = d.Set("policy_id", policyId)
 
 	info, err := monitorService.DescribeAlarmPolicyById(ctx, policyId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if info == nil {
-		return fmt.Errorf("alarm policy %s not exist", policyId)
+		return diag.Errorf("alarm policy %s not exist", policyId)
 	}
 
 	objects, err := monitorService.DescribeBindingAlarmPolicyObjectList(ctx, policyId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	newDimensions := make([]interface{}, 0, 10)
@@ -218,31 +224,31 @@ func resourceTencentMonitorPolicyBindingObjectRead(d *schema.ResourceData, meta
 		})
 	}
 
-	return d.Set("dimensions", newDimensions)
+	return diag.FromErr(d.Set("dimensions", newDimensions))
 }
 
-func resourceTencentMonitorPolicyBindingObjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorPolicyBindingObjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_binding_object.delete")()
 
 	var (
 		logId          = getLogId(contextNil)
-		ctx            = context.WithValue(context.TODO(), logIdKey, logId)
 		monitorService = MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 		policyId       = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	info, err := monitorService.DescribeAlarmPolicyById(ctx, policyId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if info == nil {
-		return fmt.Errorf("alarm policy %s not exist", policyId)
+		return diag.Errorf("alarm policy %s not exist", policyId)
 	}
 
 	objects, err := monitorService.DescribeBindingAlarmPolicyObjectList(ctx, policyId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	getUniqueId := func(dimensionsJson string) (has bool, uniqueId string) {
 		for _, item := range objects {
@@ -275,14 +281,16 @@ func resourceTencentMonitorPolicyBindingObjectDelete(d *schema.ResourceData, met
 	request.PolicyId = &policyId
 	request.UniqueId = uniqueIds
 
-	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		if _, err = monitorService.client.UseMonitorClient().UnBindingPolicyObject(request); err != nil {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if _, err = monitorService.client.UseMonitorClient().UnBindingPolicyObjectWithContext(ctx, request); err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
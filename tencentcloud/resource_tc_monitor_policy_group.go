@@ -56,7 +56,9 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -67,12 +69,17 @@ import (
 func resourceTencentCloudMonitorPolicyGroup() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource has been deprecated in Terraform TencentCloud provider version 1.59.18. Please use 'tencentcloud_monitor_alarm_policy' instead.",
-		Create:             resourceTencentMonitorPolicyGroupCreate,
-		Read:               resourceTencentMonitorPolicyGroupRead,
-		Update:             resourceTencentMonitorPolicyGroupUpdate,
-		Delete:             resourceTencentMonitorPolicyGroupDelete,
+		CreateContext:      resourceTencentMonitorPolicyGroupCreate,
+		ReadContext:        resourceTencentMonitorPolicyGroupRead,
+		UpdateContext:      resourceTencentMonitorPolicyGroupUpdate,
+		DeleteContext:      resourceTencentMonitorPolicyGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -324,7 +331,7 @@ func resourceTencentCloudMonitorPolicyGroup() *schema.Resource {
 		},
 	}
 }
-func resourceTencentMonitorPolicyGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorPolicyGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_policy_group.create")()
 
 	var (
@@ -378,9 +385,11 @@ func resourceTencentMonitorPolicyGroupCreate(d *schema.ResourceData, meta interf
 	}
 
 	var groupId *int64
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := monitorService.client.UseMonitorClient().CreatePolicyGroup(request)
+	if err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := monitorService.client.UseMonitorClient().CreatePolicyGroupWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -388,18 +397,18 @@ func resourceTencentMonitorPolicyGroupCreate(d *schema.ResourceData, meta interf
 
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%d", *groupId))
-	return resourceTencentMonitorPolicyGroupRead(d, meta)
+	return resourceTencentMonitorPolicyGroupRead(ctx, d, meta)
 }
 
-func resourceTencentMonitorPolicyGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorPolicyGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_policy_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		monitorService = MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -409,12 +418,12 @@ func resourceTencentMonitorPolicyGroupRead(d *schema.ResourceData, meta interfac
 
 	groupId, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
-		return fmt.Errorf("id [%s] is broken", d.Id())
+		return diag.Errorf("id [%s] is broken", d.Id())
 	}
 
 	info, err := monitorService.DescribePolicyGroup(ctx, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if info == nil {
 		d.SetId("")
@@ -424,14 +433,16 @@ func resourceTencentMonitorPolicyGroupRead(d *schema.ResourceData, meta interfac
 	request.GroupId = &groupId
 	request.Module = helper.String("monitor")
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		if response, err = monitorService.client.UseMonitorClient().DescribePolicyGroupInfo(request); err != nil {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if response, err = monitorService.client.UseMonitorClient().DescribePolicyGroupInfoWithContext(ctx, request); err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if response == nil {
@@ -548,7 +559,7 @@ func resourceTencentMonitorPolicyGroupRead(d *schema.ResourceData, meta interfac
 
 	objects, err := monitorService.DescribeBindingPolicyObjectList(ctx, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	bindingObjects := make([]interface{}, 0, len(objects))
 
@@ -564,16 +575,16 @@ func resourceTencentMonitorPolicyGroupRead(d *schema.ResourceData, meta interfac
 	errs = append(errs, d.Set("binding_objects", bindingObjects))
 
 	if len(errs) > 0 {
-		return errs[0]
+		return diag.FromErr(errs[0])
 	} else {
 		return nil
 	}
 }
 
-func resourceTencentMonitorPolicyGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorPolicyGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_policy_group.update")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		monitorService = MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -581,12 +592,12 @@ func resourceTencentMonitorPolicyGroupUpdate(d *schema.ResourceData, meta interf
 	)
 	groupId, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
-		return fmt.Errorf("id [%s] is broken", d.Id())
+		return diag.Errorf("id [%s] is broken", d.Id())
 	}
 
 	info, err := monitorService.DescribePolicyGroup(ctx, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if info == nil {
 		d.SetId("")
@@ -634,21 +645,23 @@ func resourceTencentMonitorPolicyGroupUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := monitorService.client.UseMonitorClient().ModifyPolicyGroup(request)
+	if err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := monitorService.client.UseMonitorClient().ModifyPolicyGroupWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentMonitorPolicyGroupRead(d, meta)
+	return resourceTencentMonitorPolicyGroupRead(ctx, d, meta)
 }
 
-func resourceTencentMonitorPolicyGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentMonitorPolicyGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_policy_group.delete")()
 
 	var (
@@ -658,19 +671,21 @@ func resourceTencentMonitorPolicyGroupDelete(d *schema.ResourceData, meta interf
 
 	groupId, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
-		return fmt.Errorf("id [%s] is broken", d.Id())
+		return diag.Errorf("id [%s] is broken", d.Id())
 	}
 	request.GroupId = []*int64{&groupId}
 	request.Module = helper.String("monitor")
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		if _, err = monitorService.client.UseMonitorClient().DeletePolicyGroup(request); err != nil {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if _, err = monitorService.client.UseMonitorClient().DeletePolicyGroupWithContext(ctx, request); err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -73,10 +73,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -86,12 +86,16 @@ import (
 
 func resourceTencentCloudMonitorTmpAlertRule() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorTmpAlertRuleRead,
-		Create: resourceTencentCloudMonitorTmpAlertRuleCreate,
-		Update: resourceTencentCloudMonitorTmpAlertRuleUpdate,
-		Delete: resourceTencentCloudMonitorTmpAlertRuleDelete,
+		ReadContext:   resourceTencentCloudMonitorTmpAlertRuleRead,
+		CreateContext: resourceTencentCloudMonitorTmpAlertRuleCreate,
+		UpdateContext: resourceTencentCloudMonitorTmpAlertRuleUpdate,
+		DeleteContext: resourceTencentCloudMonitorTmpAlertRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -172,7 +176,7 @@ func resourceTencentCloudMonitorTmpAlertRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMonitorTmpAlertRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpAlertRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_alert_rule.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -214,7 +218,7 @@ func resourceTencentCloudMonitorTmpAlertRuleCreate(d *schema.ResourceData, meta
 		prometheusRuleKV := make([]*monitor.PrometheusRuleKV, 0, len(labelsList))
 		for _, labels := range labelsList {
 			if labels == nil {
-				return fmt.Errorf("Invalid `labels` parameter, must not be empty")
+				return diag.Errorf("Invalid `labels` parameter, must not be empty")
 			}
 			label := labels.(map[string]interface{})
 			var kv monitor.PrometheusRuleKV
@@ -229,7 +233,7 @@ func resourceTencentCloudMonitorTmpAlertRuleCreate(d *schema.ResourceData, meta
 		prometheusRuleKV := make([]*monitor.PrometheusRuleKV, 0, len(annotationsList))
 		for _, annotations := range annotationsList {
 			if annotations == nil {
-				return fmt.Errorf("Invalid `annotation` parameter, must not be empty")
+				return diag.Errorf("Invalid `annotation` parameter, must not be empty")
 			}
 			annotation := annotations.(map[string]interface{})
 			var kv monitor.PrometheusRuleKV
@@ -243,8 +247,8 @@ func resourceTencentCloudMonitorTmpAlertRuleCreate(d *schema.ResourceData, meta
 		request.Type = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreateAlertRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreateAlertRuleWithContext(ctx, request)
 		if e != nil {
 			ee, ok := e.(*sdkErrors.TencentCloudSDKError)
 			if ok && IsContains("FailedOperation", ee.Code) {
@@ -261,39 +265,39 @@ func resourceTencentCloudMonitorTmpAlertRuleCreate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create monitor tmpAlertRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleId := *response.Response.RuleId
 
-	d.SetId(strings.Join([]string{instanceId, ruleId}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, ruleId))
 
-	return resourceTencentCloudMonitorTmpAlertRuleRead(d, meta)
+	return resourceTencentCloudMonitorTmpAlertRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpAlertRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpAlertRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_alert_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	ids := strings.Split(d.Id(), FILED_SP)
-	if len(ids) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	ids, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	tmpAlertRule, err := service.DescribeMonitorTmpAlertRuleById(ctx, ids[0], ids[1])
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if tmpAlertRule == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `tmpAlertRule` %s does not exist", ids[1])
+		return diag.Errorf("resource `tmpAlertRule` %s does not exist", ids[1])
 	}
 
 	_ = d.Set("instance_id", ids[0])
@@ -348,7 +352,7 @@ func resourceTencentCloudMonitorTmpAlertRuleRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTencentCloudMonitorTmpAlertRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpAlertRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_alert_rule.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -356,9 +360,9 @@ func resourceTencentCloudMonitorTmpAlertRuleUpdate(d *schema.ResourceData, meta
 
 	request := monitor.NewUpdateAlertRuleRequest()
 
-	ids := strings.Split(d.Id(), FILED_SP)
+	ids := helper.IdParse(d.Id())
 	if len(ids) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+		return diag.Errorf("id is broken, id is %s", d.Id())
 	}
 
 	request.InstanceId = helper.String(ids[0])
@@ -419,8 +423,8 @@ func resourceTencentCloudMonitorTmpAlertRuleUpdate(d *schema.ResourceData, meta
 		request.Type = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().UpdateAlertRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().UpdateAlertRuleWithContext(ctx, request)
 		if e != nil {
 			ee, ok := e.(*sdkErrors.TencentCloudSDKError)
 			if ok && IsContains("FailedOperation", ee.Code) {
@@ -435,27 +439,27 @@ func resourceTencentCloudMonitorTmpAlertRuleUpdate(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMonitorTmpAlertRuleRead(d, meta)
+	return resourceTencentCloudMonitorTmpAlertRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpAlertRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpAlertRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_alert_rule.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
-	ids := strings.Split(d.Id(), FILED_SP)
-	if len(ids) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	ids, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	if err := service.DeleteMonitorTmpAlertRule(ctx, ids[0], ids[1]); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
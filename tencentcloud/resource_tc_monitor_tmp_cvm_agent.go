@@ -49,10 +49,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -61,12 +61,15 @@ import (
 
 func resourceTencentCloudMonitorTmpCvmAgent() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorTmpCvmAgentRead,
-		Create: resourceTencentCloudMonitorTmpCvmAgentCreate,
+		ReadContext:   resourceTencentCloudMonitorTmpCvmAgentRead,
+		CreateContext: resourceTencentCloudMonitorTmpCvmAgentCreate,
 		//Update: resourceTencentCloudMonitorTmpCvmAgentUpdate,
-		Delete: resourceTencentCloudMonitorTmpCvmAgentDelete,
+		DeleteContext: resourceTencentCloudMonitorTmpCvmAgentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -92,7 +95,7 @@ func resourceTencentCloudMonitorTmpCvmAgent() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMonitorTmpCvmAgentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpCvmAgentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_cvm_agent.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -114,8 +117,8 @@ func resourceTencentCloudMonitorTmpCvmAgentCreate(d *schema.ResourceData, meta i
 		request.Name = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreatePrometheusAgent(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreatePrometheusAgentWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -128,38 +131,38 @@ func resourceTencentCloudMonitorTmpCvmAgentCreate(d *schema.ResourceData, meta i
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create monitor tmpCvmAgent failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpCvmAgentId := *response.Response.AgentId
 
-	d.SetId(strings.Join([]string{instanceId, tmpCvmAgentId}, FILED_SP))
-	return resourceTencentCloudMonitorTmpCvmAgentRead(d, meta)
+	d.SetId(helper.IdFormat(instanceId, tmpCvmAgentId))
+	return resourceTencentCloudMonitorTmpCvmAgentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpCvmAgentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpCvmAgentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmpCvmAgent.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	ids := strings.Split(d.Id(), FILED_SP)
-	if len(ids) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	ids, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	tmpCvmAgent, err := service.DescribeMonitorTmpCvmAgent(ctx, ids[0], ids[1])
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if tmpCvmAgent == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `tmpCvmAgent` %s does not exist", ids[1])
+		return diag.Errorf("resource `tmpCvmAgent` %s does not exist", ids[1])
 	}
 
 	if tmpCvmAgent.InstanceId != nil {
@@ -177,7 +180,7 @@ func resourceTencentCloudMonitorTmpCvmAgentRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudMonitorTmpCvmAgentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpCvmAgentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_cvm_agent.delete")()
 	defer inconsistentCheck(d, meta)()
 
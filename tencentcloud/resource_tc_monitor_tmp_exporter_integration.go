@@ -50,6 +50,14 @@ resource "tencentcloud_monitor_tmp_exporter_integration" "tmpExporterIntegration
   kube_type  = 3
 }
 ```
+
+Import
+
+monitor tmpExporterIntegration can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_monitor_tmp_exporter_integration.tmpExporterIntegration ex-ba7dhgey#prom-dko9d0nu#1#cls-mpuy5wvj#blackbox-exporter
+```
 */
 package tencentcloud
 
@@ -58,10 +66,11 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -70,10 +79,18 @@ import (
 
 func resourceTencentCloudMonitorTmpExporterIntegration() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorTmpExporterIntegrationRead,
-		Create: resourceTencentCloudMonitorTmpExporterIntegrationCreate,
-		Update: resourceTencentCloudMonitorTmpExporterIntegrationUpdate,
-		Delete: resourceTencentCloudMonitorTmpExporterIntegrationDelete,
+		ReadContext:   resourceTencentCloudMonitorTmpExporterIntegrationRead,
+		CreateContext: resourceTencentCloudMonitorTmpExporterIntegrationCreate,
+		UpdateContext: resourceTencentCloudMonitorTmpExporterIntegrationUpdate,
+		DeleteContext: resourceTencentCloudMonitorTmpExporterIntegrationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(24 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(6 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -108,7 +125,7 @@ func resourceTencentCloudMonitorTmpExporterIntegration() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMonitorTmpExporterIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpExporterIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_exporter_integration.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -152,8 +169,8 @@ func resourceTencentCloudMonitorTmpExporterIntegrationCreate(d *schema.ResourceD
 
 	initStatus := tke.NewDescribePrometheusInstanceInitStatusRequest()
 	initStatus.InstanceId = request.InstanceId
-	err := resource.Retry(8*readRetryTimeout, func() *resource.RetryError {
-		results, errRet := meta.(*TencentCloudClient).apiV3Conn.UseTkeClient().DescribePrometheusInstanceInitStatus(initStatus)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		results, errRet := meta.(*TencentCloudClient).apiV3Conn.UseTkeClient().DescribePrometheusInstanceInitStatusWithContext(ctx, initStatus)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
 		}
@@ -167,8 +184,8 @@ func resourceTencentCloudMonitorTmpExporterIntegrationCreate(d *schema.ResourceD
 		if *status == "uninitialized" {
 			iniRequest := tke.NewRunPrometheusInstanceRequest()
 			iniRequest.InstanceId = request.InstanceId
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseTkeClient().RunPrometheusInstance(iniRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseTkeClient().RunPrometheusInstanceWithContext(ctx, iniRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -185,11 +202,11 @@ func resourceTencentCloudMonitorTmpExporterIntegrationCreate(d *schema.ResourceD
 		return resource.RetryableError(fmt.Errorf("prometheusInstanceInit status is %v, retry...", *status))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreateExporterIntegration(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreateExporterIntegrationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -202,22 +219,22 @@ func resourceTencentCloudMonitorTmpExporterIntegrationCreate(d *schema.ResourceD
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create monitor tmpExporterIntegration failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpExporterIntegrationId := *response.Response.Names[0]
 
-	d.SetId(strings.Join([]string{tmpExporterIntegrationId, instanceId, strconv.Itoa(kubeType), clusterId, kind}, FILED_SP))
+	d.SetId(helper.IdFormat(tmpExporterIntegrationId, instanceId, strconv.Itoa(kubeType), clusterId, kind))
 
-	return resourceTencentCloudMonitorTmpExporterIntegrationRead(d, meta)
+	return resourceTencentCloudMonitorTmpExporterIntegrationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpExporterIntegrationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpExporterIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmpExporterIntegration.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -226,12 +243,12 @@ func resourceTencentCloudMonitorTmpExporterIntegrationRead(d *schema.ResourceDat
 	tmpExporterIntegration, err := service.DescribeMonitorTmpExporterIntegration(ctx, tmpExporterIntegrationId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if tmpExporterIntegration == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `tmpExporterIntegration` %s does not exist", tmpExporterIntegrationId)
+		return diag.Errorf("resource `tmpExporterIntegration` %s does not exist", tmpExporterIntegrationId)
 	}
 
 	if tmpExporterIntegration.Kind != nil {
@@ -245,7 +262,7 @@ func resourceTencentCloudMonitorTmpExporterIntegrationRead(d *schema.ResourceDat
 	return nil
 }
 
-func resourceTencentCloudMonitorTmpExporterIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpExporterIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_exporter_integration.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -273,8 +290,8 @@ func resourceTencentCloudMonitorTmpExporterIntegrationUpdate(d *schema.ResourceD
 		request.ClusterId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().UpdateExporterIntegration(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().UpdateExporterIntegrationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -285,27 +302,27 @@ func resourceTencentCloudMonitorTmpExporterIntegrationUpdate(d *schema.ResourceD
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMonitorTmpExporterIntegrationRead(d, meta)
+	return resourceTencentCloudMonitorTmpExporterIntegrationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpExporterIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpExporterIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_exporter_integration.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 	tmpExporterIntegrationId := d.Id()
 
 	if err := service.DeleteMonitorTmpExporterIntegrationById(ctx, tmpExporterIntegrationId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err := resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		tmpExporterIntegration, errRet := service.DescribeMonitorTmpExporterIntegration(ctx, tmpExporterIntegrationId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -316,7 +333,7 @@ func resourceTencentCloudMonitorTmpExporterIntegrationDelete(d *schema.ResourceD
 		return resource.RetryableError(fmt.Errorf("exporter integration status is %v, retry...", *tmpExporterIntegration.Status))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
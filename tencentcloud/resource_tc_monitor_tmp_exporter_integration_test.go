@@ -75,6 +75,12 @@ func TestAccTencentCloudMonitorExporterIntegration_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_exporter_integration.basic", "cluster_id", "cls-9ae9qo9k"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_monitor_tmp_exporter_integration.basic",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"instance_id", "kube_type", "cluster_id"},
+			},
 		},
 	})
 }
@@ -60,7 +60,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -69,12 +71,15 @@ import (
 
 func resourceTencentCloudMonitorTmpGrafanaConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMonitorTmpGrafanaConfigCreate,
-		Read:   resourceTencentCloudMonitorTmpGrafanaConfigRead,
-		Update: resourceTencentCloudMonitorTmpGrafanaConfigUpdate,
-		Delete: resourceTencentCloudMonitorTmpGrafanaConfigDelete,
+		CreateContext: resourceTencentCloudMonitorTmpGrafanaConfigCreate,
+		ReadContext:   resourceTencentCloudMonitorTmpGrafanaConfigRead,
+		UpdateContext: resourceTencentCloudMonitorTmpGrafanaConfigUpdate,
+		DeleteContext: resourceTencentCloudMonitorTmpGrafanaConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -92,7 +97,7 @@ func resourceTencentCloudMonitorTmpGrafanaConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMonitorTmpGrafanaConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpGrafanaConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_grafana_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -103,15 +108,15 @@ func resourceTencentCloudMonitorTmpGrafanaConfigCreate(d *schema.ResourceData, m
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudMonitorTmpGrafanaConfigUpdate(d, meta)
+	return resourceTencentCloudMonitorTmpGrafanaConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpGrafanaConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpGrafanaConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_grafana_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -119,7 +124,7 @@ func resourceTencentCloudMonitorTmpGrafanaConfigRead(d *schema.ResourceData, met
 
 	tmpGrafanaConfig, err := service.DescribeMonitorTmpGrafanaConfigById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if tmpGrafanaConfig == nil {
@@ -137,7 +142,7 @@ func resourceTencentCloudMonitorTmpGrafanaConfigRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudMonitorTmpGrafanaConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpGrafanaConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_grafana_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -152,8 +157,8 @@ func resourceTencentCloudMonitorTmpGrafanaConfigUpdate(d *schema.ResourceData, m
 		request.Config = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().UpdateGrafanaConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().UpdateGrafanaConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -163,13 +168,13 @@ func resourceTencentCloudMonitorTmpGrafanaConfigUpdate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update monitor tmpGrafanaConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMonitorTmpGrafanaConfigRead(d, meta)
+	return resourceTencentCloudMonitorTmpGrafanaConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpGrafanaConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpGrafanaConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_grafana_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
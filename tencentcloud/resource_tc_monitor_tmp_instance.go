@@ -46,7 +46,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -55,12 +57,17 @@ import (
 
 func resourceTencentCloudMonitorTmpInstance() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorTmpInstanceRead,
-		Create: resourceTencentCloudMonitorTmpInstanceCreate,
-		Update: resourceTencentCloudMonitorTmpInstanceUpdate,
-		Delete: resourceTencentCloudMonitorTmpInstanceDelete,
+		ReadContext:   resourceTencentCloudMonitorTmpInstanceRead,
+		CreateContext: resourceTencentCloudMonitorTmpInstanceCreate,
+		UpdateContext: resourceTencentCloudMonitorTmpInstanceUpdate,
+		DeleteContext: resourceTencentCloudMonitorTmpInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
@@ -126,7 +133,7 @@ func resourceTencentCloudMonitorTmpInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMonitorTmpInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -157,8 +164,8 @@ func resourceTencentCloudMonitorTmpInstanceCreate(d *schema.ResourceData, meta i
 		request.Zone = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreatePrometheusMultiTenantInstancePostPayMode(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreatePrometheusMultiTenantInstancePostPayModeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -171,15 +178,15 @@ func resourceTencentCloudMonitorTmpInstanceCreate(d *schema.ResourceData, meta i
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create monitor tmpInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpInstanceId := *response.Response.InstanceId
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	err = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, errRet := service.DescribeMonitorTmpInstance(ctx, tmpInstanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -193,7 +200,7 @@ func resourceTencentCloudMonitorTmpInstanceCreate(d *schema.ResourceData, meta i
 		return resource.RetryableError(fmt.Errorf("tmpInstance status is %v, retry...", *instance.InstanceStatus))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
@@ -201,19 +208,19 @@ func resourceTencentCloudMonitorTmpInstanceCreate(d *schema.ResourceData, meta i
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::monitor:%s:uin/:prom-instance/%s", region, tmpInstanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	d.SetId(tmpInstanceId)
-	return resourceTencentCloudMonitorTmpInstanceRead(d, meta)
+	return resourceTencentCloudMonitorTmpInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmpInstance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -222,7 +229,7 @@ func resourceTencentCloudMonitorTmpInstanceRead(d *schema.ResourceData, meta int
 	tmpInstance, err := service.DescribeMonitorTmpInstance(ctx, tmpInstanceId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if tmpInstance == nil {
@@ -271,19 +278,19 @@ func resourceTencentCloudMonitorTmpInstanceRead(d *schema.ResourceData, meta int
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "monitor", "prom-instance", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudMonitorTmpInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := monitor.NewModifyPrometheusInstanceAttributesRequest()
 
@@ -294,11 +301,11 @@ func resourceTencentCloudMonitorTmpInstanceUpdate(d *schema.ResourceData, meta i
 	}
 
 	if d.HasChange("vpc_id") {
-		return fmt.Errorf("`vpc_id` do not support change now.")
+		return diag.Errorf("`vpc_id` do not support change now.")
 	}
 
 	if d.HasChange("subnet_id") {
-		return fmt.Errorf("`subnet_id` do not support change now.")
+		return diag.Errorf("`subnet_id` do not support change now.")
 	}
 
 	if d.HasChange("data_retention_time") {
@@ -308,11 +315,11 @@ func resourceTencentCloudMonitorTmpInstanceUpdate(d *schema.ResourceData, meta i
 	}
 
 	if d.HasChange("zone") {
-		return fmt.Errorf("`zone` do not support change now.")
+		return diag.Errorf("`zone` do not support change now.")
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().ModifyPrometheusInstanceAttributes(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().ModifyPrometheusInstanceAttributesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -323,7 +330,7 @@ func resourceTencentCloudMonitorTmpInstanceUpdate(d *schema.ResourceData, meta i
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
@@ -333,28 +340,28 @@ func resourceTencentCloudMonitorTmpInstanceUpdate(d *schema.ResourceData, meta i
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("monitor", "prom-instance", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMonitorTmpInstanceRead(d, meta)
+	return resourceTencentCloudMonitorTmpInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 	tmpInstanceId := d.Id()
 
 	if err := service.IsolateMonitorTmpInstanceById(ctx, tmpInstanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err := resource.Retry(1*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		instance, errRet := service.DescribeMonitorTmpInstance(ctx, tmpInstanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -368,11 +375,11 @@ func resourceTencentCloudMonitorTmpInstanceDelete(d *schema.ResourceData, meta i
 		return resource.RetryableError(fmt.Errorf("tmpInstance status is %v, retry...", *instance.InstanceStatus))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := service.DeleteMonitorTmpInstanceById(ctx, tmpInstanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
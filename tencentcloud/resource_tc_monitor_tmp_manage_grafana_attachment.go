@@ -64,7 +64,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -73,11 +75,14 @@ import (
 
 func resourceTencentCloudMonitorTmpManageGrafanaAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMonitorTmpManageGrafanaAttachmentCreate,
-		Read:   resourceTencentCloudMonitorTmpManageGrafanaAttachmentRead,
-		Delete: resourceTencentCloudMonitorTmpManageGrafanaAttachmentDelete,
+		CreateContext: resourceTencentCloudMonitorTmpManageGrafanaAttachmentCreate,
+		ReadContext:   resourceTencentCloudMonitorTmpManageGrafanaAttachmentRead,
+		DeleteContext: resourceTencentCloudMonitorTmpManageGrafanaAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -97,7 +102,7 @@ func resourceTencentCloudMonitorTmpManageGrafanaAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMonitorTmpManageGrafanaAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpManageGrafanaAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_manage_grafana_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -116,8 +121,8 @@ func resourceTencentCloudMonitorTmpManageGrafanaAttachmentCreate(d *schema.Resou
 		request.GrafanaId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().BindPrometheusManagedGrafana(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().BindPrometheusManagedGrafanaWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -127,21 +132,21 @@ func resourceTencentCloudMonitorTmpManageGrafanaAttachmentCreate(d *schema.Resou
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create monitor manageGrafanaAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudMonitorTmpManageGrafanaAttachmentRead(d, meta)
+	return resourceTencentCloudMonitorTmpManageGrafanaAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpManageGrafanaAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpManageGrafanaAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_manage_grafana_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -149,7 +154,7 @@ func resourceTencentCloudMonitorTmpManageGrafanaAttachmentRead(d *schema.Resourc
 
 	manageGrafanaAttachment, err := service.DescribeMonitorManageGrafanaAttachmentById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if manageGrafanaAttachment == nil {
@@ -169,18 +174,18 @@ func resourceTencentCloudMonitorTmpManageGrafanaAttachmentRead(d *schema.Resourc
 	return nil
 }
 
-func resourceTencentCloudMonitorTmpManageGrafanaAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpManageGrafanaAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_manage_grafana_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instanceId := d.Id()
 
 	if err := service.DeleteMonitorManageGrafanaAttachmentById(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -56,10 +56,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -68,12 +68,16 @@ import (
 
 func resourceTencentCloudMonitorTmpRecordingRule() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorTmpRecordingRuleRead,
-		Create: resourceTencentCloudMonitorTmpRecordingRuleCreate,
-		Update: resourceTencentCloudMonitorTmpRecordingRuleUpdate,
-		Delete: resourceTencentCloudMonitorTmpRecordingRuleDelete,
+		ReadContext:   resourceTencentCloudMonitorTmpRecordingRuleRead,
+		CreateContext: resourceTencentCloudMonitorTmpRecordingRuleCreate,
+		UpdateContext: resourceTencentCloudMonitorTmpRecordingRuleUpdate,
+		DeleteContext: resourceTencentCloudMonitorTmpRecordingRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -100,7 +104,7 @@ func resourceTencentCloudMonitorTmpRecordingRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMonitorTmpRecordingRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpRecordingRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_recording_rule.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -127,8 +131,8 @@ func resourceTencentCloudMonitorTmpRecordingRuleCreate(d *schema.ResourceData, m
 		request.RuleState = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreateRecordingRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreateRecordingRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -141,38 +145,38 @@ func resourceTencentCloudMonitorTmpRecordingRuleCreate(d *schema.ResourceData, m
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create monitor recordingRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	recordingRuleId := *response.Response.RuleId
-	d.SetId(strings.Join([]string{instanceId, recordingRuleId}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, recordingRuleId))
 
-	return resourceTencentCloudMonitorTmpRecordingRuleRead(d, meta)
+	return resourceTencentCloudMonitorTmpRecordingRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpRecordingRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpRecordingRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tmp_monitor_recording_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	ids := strings.Split(d.Id(), FILED_SP)
-	if len(ids) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	ids, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	recordingRule, err := service.DescribeMonitorRecordingRuleById(ctx, ids[0], ids[1])
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if recordingRule == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `recordingRule` %s does not exist", ids[1])
+		return diag.Errorf("resource `recordingRule` %s does not exist", ids[1])
 	}
 
 	_ = d.Set("instance_id", ids[0])
@@ -182,7 +186,7 @@ func resourceTencentCloudMonitorTmpRecordingRuleRead(d *schema.ResourceData, met
 	if recordingRule.Group != nil {
 		group, err := Base64ToString(*recordingRule.Group)
 		if err != nil {
-			return fmt.Errorf("`recordingRule.Group` %s does not be decoded to yaml", *recordingRule.Group)
+			return diag.Errorf("`recordingRule.Group` %s does not be decoded to yaml", *recordingRule.Group)
 		}
 		_ = d.Set("group", &group)
 	}
@@ -194,7 +198,7 @@ func resourceTencentCloudMonitorTmpRecordingRuleRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudMonitorTmpRecordingRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpRecordingRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tmp_monitor_recording_rule.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -202,9 +206,9 @@ func resourceTencentCloudMonitorTmpRecordingRuleUpdate(d *schema.ResourceData, m
 
 	request := monitor.NewUpdateRecordingRuleRequest()
 
-	ids := strings.Split(d.Id(), FILED_SP)
+	ids := helper.IdParse(d.Id())
 	if len(ids) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+		return diag.Errorf("id is broken, id is %s", d.Id())
 	}
 
 	request.InstanceId = helper.String(ids[0])
@@ -223,8 +227,8 @@ func resourceTencentCloudMonitorTmpRecordingRuleUpdate(d *schema.ResourceData, m
 			request.RuleState = helper.IntInt64(v.(int))
 		}
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().UpdateRecordingRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().UpdateRecordingRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -235,28 +239,28 @@ func resourceTencentCloudMonitorTmpRecordingRuleUpdate(d *schema.ResourceData, m
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMonitorTmpRecordingRuleRead(d, meta)
+	return resourceTencentCloudMonitorTmpRecordingRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpRecordingRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpRecordingRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_recording_rule.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	ids := strings.Split(d.Id(), FILED_SP)
-	if len(ids) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	ids, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	if err := service.DeleteMonitorRecordingRule(ctx, ids[0], ids[1]); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
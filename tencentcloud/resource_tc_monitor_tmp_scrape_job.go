@@ -60,10 +60,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -72,12 +72,16 @@ import (
 
 func resourceTencentCloudMonitorTmpScrapeJob() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorTmpScrapeJobRead,
-		Create: resourceTencentCloudMonitorTmpScrapeJobCreate,
-		Update: resourceTencentCloudMonitorTmpScrapeJobUpdate,
-		Delete: resourceTencentCloudMonitorTmpScrapeJobDelete,
+		ReadContext:   resourceTencentCloudMonitorTmpScrapeJobRead,
+		CreateContext: resourceTencentCloudMonitorTmpScrapeJobCreate,
+		UpdateContext: resourceTencentCloudMonitorTmpScrapeJobUpdate,
+		DeleteContext: resourceTencentCloudMonitorTmpScrapeJobDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -101,7 +105,7 @@ func resourceTencentCloudMonitorTmpScrapeJob() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMonitorTmpScrapeJobCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpScrapeJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_scrape_job.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -129,8 +133,8 @@ func resourceTencentCloudMonitorTmpScrapeJobCreate(d *schema.ResourceData, meta
 		request.Config = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreatePrometheusScrapeJob(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreatePrometheusScrapeJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -143,22 +147,22 @@ func resourceTencentCloudMonitorTmpScrapeJobCreate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create monitor tmpScrapeJob failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpScrapeJobId := *response.Response.JobId
 
-	d.SetId(strings.Join([]string{tmpScrapeJobId, instanceId, agentId}, FILED_SP))
+	d.SetId(helper.IdFormat(tmpScrapeJobId, instanceId, agentId))
 
-	return resourceTencentCloudMonitorTmpScrapeJobRead(d, meta)
+	return resourceTencentCloudMonitorTmpScrapeJobRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpScrapeJobRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpScrapeJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmpScrapeJob.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -167,15 +171,19 @@ func resourceTencentCloudMonitorTmpScrapeJobRead(d *schema.ResourceData, meta in
 	tmpScrapeJob, err := service.DescribeMonitorTmpScrapeJob(ctx, tmpScrapeJobId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if tmpScrapeJob == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `tmpScrapeJob` %s does not exist", tmpScrapeJobId)
+		return diag.Errorf("resource `tmpScrapeJob` %s does not exist", tmpScrapeJobId)
 	}
 
-	_ = d.Set("instance_id", strings.Split(tmpScrapeJobId, FILED_SP)[1])
+	ids, err := helper.IdParseN(tmpScrapeJobId, 3)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("instance_id", ids[1])
 	if tmpScrapeJob.AgentId != nil {
 		_ = d.Set("agent_id", tmpScrapeJob.AgentId)
 	}
@@ -187,7 +195,7 @@ func resourceTencentCloudMonitorTmpScrapeJobRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTencentCloudMonitorTmpScrapeJobUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpScrapeJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_scrape_job.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -195,18 +203,21 @@ func resourceTencentCloudMonitorTmpScrapeJobUpdate(d *schema.ResourceData, meta
 
 	request := monitor.NewUpdatePrometheusScrapeJobRequest()
 
-	ids := strings.Split(d.Id(), FILED_SP)
+	ids, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	request.JobId = &ids[0]
 	request.InstanceId = &ids[1]
 	request.AgentId = &ids[2]
 
 	if d.HasChange("instance_id") {
-		return fmt.Errorf("`instance_id` do not support change now.")
+		return diag.Errorf("`instance_id` do not support change now.")
 	}
 
 	if d.HasChange("agent_id") {
-		return fmt.Errorf("`agent_id` do not support change now.")
+		return diag.Errorf("`agent_id` do not support change now.")
 	}
 
 	if d.HasChange("config") {
@@ -215,8 +226,8 @@ func resourceTencentCloudMonitorTmpScrapeJobUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().UpdatePrometheusScrapeJob(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().UpdatePrometheusScrapeJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -227,24 +238,24 @@ func resourceTencentCloudMonitorTmpScrapeJobUpdate(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMonitorTmpScrapeJobRead(d, meta)
+	return resourceTencentCloudMonitorTmpScrapeJobRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpScrapeJobDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpScrapeJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_scrape_job.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 	tmpScrapeJobId := d.Id()
 
 	if err := service.DeleteMonitorTmpScrapeJobById(ctx, tmpScrapeJobId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -193,10 +193,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -205,12 +205,16 @@ import (
 
 func resourceTencentCloudMonitorTmpTkeAlertPolicy() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTkeTmpAlertPolicyRead,
-		Create: resourceTencentCloudTkeTmpAlertPolicyCreate,
-		Update: resourceTencentCloudTkeTmpAlertPolicyUpdate,
-		Delete: resourceTencentCloudTkeTmpAlertPolicyDelete,
+		ReadContext:   resourceTencentCloudTkeTmpAlertPolicyRead,
+		CreateContext: resourceTencentCloudTkeTmpAlertPolicyCreate,
+		UpdateContext: resourceTencentCloudTkeTmpAlertPolicyUpdate,
+		DeleteContext: resourceTencentCloudTkeTmpAlertPolicyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -444,7 +448,7 @@ func resourceTencentCloudMonitorTmpTkeAlertPolicy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTkeTmpAlertPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeTmpAlertPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tke_tmp_alert_policy.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -598,8 +602,8 @@ func resourceTencentCloudTkeTmpAlertPolicyCreate(d *schema.ResourceData, meta in
 		request.AlertRule = &prometheusAlertPolicyItem
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreatePrometheusAlertPolicy(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreatePrometheusAlertPolicyWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -612,26 +616,26 @@ func resourceTencentCloudTkeTmpAlertPolicyCreate(d *schema.ResourceData, meta in
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tke tmpAlertPolicy failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	tmpAlertPolicyId := *response.Response.Id
 	instanceId := *request.InstanceId
 
-	d.SetId(strings.Join([]string{instanceId, tmpAlertPolicyId}, FILED_SP))
-	return resourceTencentCloudTkeTmpAlertPolicyRead(d, meta)
+	d.SetId(helper.IdFormat(instanceId, tmpAlertPolicyId))
+	return resourceTencentCloudTkeTmpAlertPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTkeTmpAlertPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeTmpAlertPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tke_tmp_alert_policy.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	ids := strings.Split(d.Id(), FILED_SP)
-	if len(ids) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	ids, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := ids[0]
@@ -640,12 +644,12 @@ func resourceTencentCloudTkeTmpAlertPolicyRead(d *schema.ResourceData, meta inte
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 	tmpAlertPolicy, err := service.DescribeTkeTmpAlertPolicy(ctx, instanceId, tmpAlertPolicyId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG] tmpAlertPolicy[%v]\n", tmpAlertPolicy)
 	if tmpAlertPolicy == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `AlertPolicy` %s does not exist", tmpAlertPolicyId)
+		return diag.Errorf("resource `AlertPolicy` %s does not exist", tmpAlertPolicyId)
 	}
 
 	rules := make([]map[string]interface{}, 0, len(tmpAlertPolicy.Rules))
@@ -741,7 +745,7 @@ func resourceTencentCloudTkeTmpAlertPolicyRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudTkeTmpAlertPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeTmpAlertPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tke_tmp_alert_policy.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -749,9 +753,9 @@ func resourceTencentCloudTkeTmpAlertPolicyUpdate(d *schema.ResourceData, meta in
 
 	request := monitor.NewModifyPrometheusAlertPolicyRequest()
 
-	ids := strings.Split(d.Id(), FILED_SP)
+	ids := helper.IdParse(d.Id())
 	if len(ids) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+		return diag.Errorf("id is broken, id is %s", d.Id())
 	}
 
 	instanceId := ids[0]
@@ -760,7 +764,7 @@ func resourceTencentCloudTkeTmpAlertPolicyUpdate(d *schema.ResourceData, meta in
 	request.InstanceId = &instanceId
 
 	if d.HasChange("instance_id") {
-		return fmt.Errorf("`instance_id` do not support change now.")
+		return diag.Errorf("`instance_id` do not support change now.")
 	}
 
 	if dMap, ok := helper.InterfacesHeadMap(d, "alert_rule"); ok {
@@ -901,8 +905,8 @@ func resourceTencentCloudTkeTmpAlertPolicyUpdate(d *schema.ResourceData, meta in
 		request.AlertRule = &prometheusAlertPolicyItem
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().ModifyPrometheusAlertPolicy(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().ModifyPrometheusAlertPolicyWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -913,30 +917,30 @@ func resourceTencentCloudTkeTmpAlertPolicyUpdate(d *schema.ResourceData, meta in
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTkeTmpAlertPolicyRead(d, meta)
+	return resourceTencentCloudTkeTmpAlertPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTkeTmpAlertPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeTmpAlertPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tke_tmp_alert_policy.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
-	ids := strings.Split(d.Id(), FILED_SP)
-	if len(ids) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	ids, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := ids[0]
 	tmpAlertPolicyId := ids[1]
 
 	if err := service.DeleteTkeTmpAlertPolicyById(ctx, instanceId, tmpAlertPolicyId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 //func init() {
@@ -131,7 +131,7 @@ func testAccCheckTmpTkeAlertPolicyDestroy(s *terraform.State) error {
 			continue
 		}
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) != 2 {
 			return fmt.Errorf("invalid ID %s", rs.Primary.ID)
 		}
@@ -162,7 +162,7 @@ func testAccCheckTmpTkeAlertPolicyExists(r string) resource.TestCheckFunc {
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("instance id is not set")
 		}
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) != 2 {
 			return fmt.Errorf("invalid ID %s", rs.Primary.ID)
 		}
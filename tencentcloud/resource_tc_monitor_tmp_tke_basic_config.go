@@ -171,19 +171,28 @@ resource "tencentcloud_monitor_tmp_tke_basic_config" "tmp_tke_basic_config" {
   depends_on = [tencentcloud_monitor_tmp_tke_cluster_agent.foo]
 }
 
+```
+
+Import
+
+monitor tmp_tke_basic_config can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_monitor_tmp_tke_basic_config.tmp_tke_basic_config prom-dko9d0nu#tke#cls-mpuy5wvj#kubelet
 ```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 const (
@@ -194,10 +203,16 @@ const (
 
 func resourceTencentCloudMonitorTmpTkeBasicConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMonitorTmpTkeBasicConfigCreate,
-		Read:   resourceTencentCloudMonitorTmpTkeBasicConfigRead,
-		Update: resourceTencentCloudMonitorTmpTkeBasicConfigUpdate,
-		Delete: resourceTencentCloudMonitorTmpTkeBasicConfigDelete,
+		CreateContext: resourceTencentCloudMonitorTmpTkeBasicConfigCreate,
+		ReadContext:   resourceTencentCloudMonitorTmpTkeBasicConfigRead,
+		UpdateContext: resourceTencentCloudMonitorTmpTkeBasicConfigUpdate,
+		DeleteContext: resourceTencentCloudMonitorTmpTkeBasicConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -249,7 +264,7 @@ func resourceTencentCloudMonitorTmpTkeBasicConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMonitorTmpTkeBasicConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpTkeBasicConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_basic_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -272,21 +287,21 @@ func resourceTencentCloudMonitorTmpTkeBasicConfigCreate(d *schema.ResourceData,
 		name = v.(string)
 	}
 
-	d.SetId(strings.Join([]string{instanceId, clusterType, clusterId, name}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, clusterType, clusterId, name))
 
-	return resourceTencentCloudMonitorTmpTkeBasicConfigUpdate(d, meta)
+	return resourceTencentCloudMonitorTmpTkeBasicConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpTkeBasicConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpTkeBasicConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_basic_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	clusterType := idSplit[1]
@@ -296,7 +311,7 @@ func resourceTencentCloudMonitorTmpTkeBasicConfigRead(d *schema.ResourceData, me
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 	tmpTkeBasicConfig, err := service.DescribeTkeTmpBasicConfigById(ctx, clusterId, clusterType, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if tmpTkeBasicConfig == nil {
@@ -312,7 +327,7 @@ func resourceTencentCloudMonitorTmpTkeBasicConfigRead(d *schema.ResourceData, me
 
 	configType, config, err := service.GetConfigType(name, tmpTkeBasicConfig)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("config_type", configType)
 	_ = d.Set("config", config.Config)
@@ -320,18 +335,18 @@ func resourceTencentCloudMonitorTmpTkeBasicConfigRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudMonitorTmpTkeBasicConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpTkeBasicConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_basic_config.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := monitor.NewModifyPrometheusConfigRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	instanceId := idSplit[0]
 	clusterType := idSplit[1]
@@ -353,16 +368,16 @@ func resourceTencentCloudMonitorTmpTkeBasicConfigUpdate(d *schema.ResourceData,
 		service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 		tmpTkeBasicConfig, err := service.DescribeTkeTmpBasicConfigById(ctx, clusterId, clusterType, instanceId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		configType, config, err := service.GetConfigType(name, tmpTkeBasicConfig)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		serviceMonitors, podMonitors, rawMobs, err := configInit(configType, config, regexs)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if serviceMonitors != "" {
@@ -391,8 +406,8 @@ func resourceTencentCloudMonitorTmpTkeBasicConfigUpdate(d *schema.ResourceData,
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().ModifyPrometheusConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().ModifyPrometheusConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -402,13 +417,13 @@ func resourceTencentCloudMonitorTmpTkeBasicConfigUpdate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update monitor tmpTkeBasicConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMonitorTmpTkeBasicConfigRead(d, meta)
+	return resourceTencentCloudMonitorTmpTkeBasicConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpTkeBasicConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpTkeBasicConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_basic_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
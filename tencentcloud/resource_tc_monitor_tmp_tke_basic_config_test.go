@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 // go test -i; go test -test.run TestAccTencentCloudMonitorTmpTkeBasicConfigResource_basic -v
@@ -27,6 +27,12 @@ func TestAccTencentCloudMonitorTmpTkeBasicConfigResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_tke_basic_config.tmp_tke_basic_config", "metrics_name.#", "2"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_monitor_tmp_tke_basic_config.tmp_tke_basic_config",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metrics_name"},
+			},
 		},
 	})
 }
@@ -43,7 +49,7 @@ func testAccCheckTmpTkeBasicConfigExists(r string) resource.TestCheckFunc {
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("instance id is not set")
 		}
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) != 4 {
 			return fmt.Errorf("invalid ID %s", rs.Primary.ID)
 		}
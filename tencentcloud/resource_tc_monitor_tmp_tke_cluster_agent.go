@@ -161,6 +161,14 @@ resource "tencentcloud_monitor_tmp_tke_cluster_agent" "foo" {
   }
 }
 ```
+
+Import
+
+monitor tmp_tke_cluster_agent can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_monitor_tmp_tke_cluster_agent.foo prom-dko9d0nu#cls-mpuy5wvj#tke
+```
 */
 package tencentcloud
 
@@ -168,8 +176,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -178,10 +187,18 @@ import (
 
 func resourceTencentCloudMonitorTmpTkeClusterAgent() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorTmpTkeClusterAgentRead,
-		Create: resourceTencentCloudMonitorTmpTkeClusterAgentCreate,
-		Update: resourceTencentCloudMonitorTmpTkeClusterAgentUpdate,
-		Delete: resourceTencentCloudMonitorTmpTkeClusterAgentDelete,
+		ReadContext:   resourceTencentCloudMonitorTmpTkeClusterAgentRead,
+		CreateContext: resourceTencentCloudMonitorTmpTkeClusterAgentCreate,
+		UpdateContext: resourceTencentCloudMonitorTmpTkeClusterAgentUpdate,
+		DeleteContext: resourceTencentCloudMonitorTmpTkeClusterAgentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(6 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -321,7 +338,7 @@ func resourceTencentCloudMonitorTmpTkeClusterAgent() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMonitorTmpTkeClusterAgentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpTkeClusterAgentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_cluster_agent.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -412,8 +429,8 @@ func resourceTencentCloudMonitorTmpTkeClusterAgentCreate(d *schema.ResourceData,
 		request.Agents = prometheusClusterAgents
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreatePrometheusClusterAgent(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreatePrometheusClusterAgentWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -425,13 +442,13 @@ func resourceTencentCloudMonitorTmpTkeClusterAgentCreate(d *schema.ResourceData,
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tke cluster agent failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		clusterAgent, errRet := service.DescribeTmpTkeClusterAgentsById(ctx, instanceId, clusterId, clusterType)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -445,26 +462,26 @@ func resourceTencentCloudMonitorTmpTkeClusterAgentCreate(d *schema.ResourceData,
 		return resource.RetryableError(fmt.Errorf("cluster agent status is %v, retry...", *clusterAgent.Status))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{instanceId, clusterId, clusterType}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, clusterId, clusterType))
 
-	return resourceTencentCloudMonitorTmpTkeClusterAgentRead(d, meta)
+	return resourceTencentCloudMonitorTmpTkeClusterAgentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpTkeClusterAgentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpTkeClusterAgentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_cluster_agent.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	ids := strings.Split(d.Id(), FILED_SP)
-	if len(ids) != 3 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	ids, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := ids[0]
@@ -474,14 +491,16 @@ func resourceTencentCloudMonitorTmpTkeClusterAgentRead(d *schema.ResourceData, m
 	clusterAgent, err := service.DescribeTmpTkeClusterAgentsById(ctx, instanceId, clusterId, clusterType)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if clusterAgent == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `global_notification` %s does not exist", instanceId)
+		return diag.Errorf("resource `global_notification` %s does not exist", instanceId)
 	}
 
+	_ = d.Set("instance_id", instanceId)
+
 	var agents []map[string]interface{}
 	agent := make(map[string]interface{})
 	agent["cluster_id"] = clusterAgent.ClusterId
@@ -507,7 +526,7 @@ func resourceTencentCloudMonitorTmpTkeClusterAgentRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceTencentCloudMonitorTmpTkeClusterAgentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpTkeClusterAgentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_cluster_agent.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -518,9 +537,9 @@ func resourceTencentCloudMonitorTmpTkeClusterAgentUpdate(d *schema.ResourceData,
 		response *monitor.ModifyPrometheusAgentExternalLabelsResponse
 	)
 
-	ids := strings.Split(d.Id(), FILED_SP)
+	ids := helper.IdParse(d.Id())
 	if len(ids) != 3 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+		return diag.Errorf("id is broken, id is %s", d.Id())
 	}
 
 	instanceId := ids[0]
@@ -529,11 +548,11 @@ func resourceTencentCloudMonitorTmpTkeClusterAgentUpdate(d *schema.ResourceData,
 	request.ClusterId = &clusterId
 
 	if d.HasChange("instance_id") {
-		return fmt.Errorf("`instance_id` do not support change now.")
+		return diag.Errorf("`instance_id` do not support change now.")
 	}
 
 	if d.HasChange("cluster_id") {
-		return fmt.Errorf("`cluster_id` do not support change now.")
+		return diag.Errorf("`cluster_id` do not support change now.")
 	}
 
 	if d.HasChange("agents") {
@@ -553,8 +572,8 @@ func resourceTencentCloudMonitorTmpTkeClusterAgentUpdate(d *schema.ResourceData,
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().ModifyPrometheusAgentExternalLabels(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().ModifyPrometheusAgentExternalLabelsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -568,23 +587,23 @@ func resourceTencentCloudMonitorTmpTkeClusterAgentUpdate(d *schema.ResourceData,
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTkeTmpAlertPolicyRead(d, meta)
+	return resourceTencentCloudTkeTmpAlertPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpTkeClusterAgentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpTkeClusterAgentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_cluster_agent.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	ids := strings.Split(d.Id(), FILED_SP)
+	ids := helper.IdParse(d.Id())
 	if len(ids) != 3 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+		return diag.Errorf("id is broken, id is %s", d.Id())
 	}
 
 	instanceId := ids[0]
@@ -592,10 +611,10 @@ func resourceTencentCloudMonitorTmpTkeClusterAgentDelete(d *schema.ResourceData,
 	clusterType := ids[2]
 
 	if err := service.DeletePrometheusClusterAgent(ctx, instanceId, clusterId, clusterType); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err := resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		clusterAgent, errRet := service.DescribeTmpTkeClusterAgentsById(ctx, instanceId, clusterId, clusterType)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -606,7 +625,7 @@ func resourceTencentCloudMonitorTmpTkeClusterAgentDelete(d *schema.ResourceData,
 		return resource.RetryableError(fmt.Errorf("cluster agent status is %v, retry...", *clusterAgent.Status))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func init() {
@@ -61,6 +61,11 @@ func TestAccTencentCloudMonitorClusterAgent_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_tke_cluster_agent.basic", "agents.0.cluster_type", "eks"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_monitor_tmp_tke_cluster_agent.basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -73,7 +78,7 @@ func testAccCheckClusterAgentDestroy(s *terraform.State) error {
 		if rs.Type != "tencentcloud_monitor_tmp_tke_cluster_agent" {
 			continue
 		}
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) != 3 {
 			return fmt.Errorf("invalid ID %s", rs.Primary.ID)
 		}
@@ -104,7 +109,7 @@ func testAccCheckClusterAgentExists(r string) resource.TestCheckFunc {
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("instance id is not set")
 		}
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) != 3 {
 			return fmt.Errorf("invalid ID %s", rs.Primary.ID)
 		}
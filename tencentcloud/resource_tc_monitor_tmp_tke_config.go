@@ -182,16 +182,24 @@ resource "tencentcloud_monitor_tmp_tke_config" "foo" {
   }
 }
 
+```
+
+Import
+
+monitor tmp_tke_config can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_monitor_tmp_tke_config.foo prom-dko9d0nu#tke#cls-mpuy5wvj
 ```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -200,10 +208,17 @@ import (
 
 func resourceTencentCloudMonitorTmpTkeConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTkeTmpConfigCreate,
-		Read:   resourceTencentCloudTkeTmpConfigRead,
-		Update: resourceTencentCloudTkeTmpConfigUpdate,
-		Delete: resourceTencentCloudTkeTmpConfigDelete,
+		CreateContext: resourceTencentCloudTkeTmpConfigCreate,
+		ReadContext:   resourceTencentCloudTkeTmpConfigRead,
+		UpdateContext: resourceTencentCloudTkeTmpConfigUpdate,
+		DeleteContext: resourceTencentCloudTkeTmpConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -304,37 +319,37 @@ func resourceTencentCloudMonitorTmpTkeConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTkeTmpConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeTmpConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tke_tmp_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId    = getLogId(contextNil)
-		ctx      = context.WithValue(context.TODO(), logIdKey, logId)
 		service  = MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 		configId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	params, err := service.DescribeTkeTmpConfigById(ctx, configId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if params == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `prometheus_config` %s does not exist", configId)
+		return diag.Errorf("resource `prometheus_config` %s does not exist", configId)
 	}
 
 	if e := d.Set("config", params.Config); e != nil {
 		log.Printf("[CRITAL]%s provider set config fail, reason:%s\n", logId, e.Error())
-		return e
+		return diag.FromErr(e)
 	}
 
 	return nil
 }
 
-func resourceTencentCloudTkeTmpConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeTmpConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tke_tmp_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -362,10 +377,10 @@ func resourceTencentCloudTkeTmpConfigCreate(d *schema.ResourceData, meta interfa
 		request.RawJobs = serializePromConfigItems(v)
 	}
 
-	ids := strings.Join([]string{*request.InstanceId, *request.ClusterType, *request.ClusterId}, FILED_SP)
+	ids := helper.IdFormat(*request.InstanceId, *request.ClusterType, *request.ClusterId)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreatePrometheusConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		response, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreatePrometheusConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -376,15 +391,15 @@ func resourceTencentCloudTkeTmpConfigCreate(d *schema.ResourceData, meta interfa
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(ids)
 
-	return resourceTencentCloudTkeTmpConfigRead(d, meta)
+	return resourceTencentCloudTkeTmpConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTkeTmpConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeTmpConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tke_tmp_config.update, Id: %s", d.Id())()
 	defer inconsistentCheck(d, meta)()
 
@@ -397,7 +412,7 @@ func resourceTencentCloudTkeTmpConfigUpdate(d *schema.ResourceData, meta interfa
 
 	ids, err := service.parseConfigId(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	request.ClusterId = &ids.ClusterId
 	request.ClusterType = &ids.ClusterType
@@ -421,8 +436,8 @@ func resourceTencentCloudTkeTmpConfigUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, e := client.UseMonitorClient().ModifyPrometheusConfig(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		response, e := client.UseMonitorClient().ModifyPrometheusConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -433,24 +448,24 @@ func resourceTencentCloudTkeTmpConfigUpdate(d *schema.ResourceData, meta interfa
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTkeTmpConfigRead(d, meta)
+	return resourceTencentCloudTkeTmpConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTkeTmpConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeTmpConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tke_tmp_config.delete, Id: %s", d.Id())()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId           = getLogId(contextNil)
-		ctx             = context.WithValue(context.TODO(), logIdKey, logId)
 		service         = MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 		ServiceMonitors = []*string{}
 		PodMonitors     = []*string{}
 		RawJobs         = []*string{}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("service_monitors"); ok {
 		ServiceMonitors = serializePromConfigItemNames(v)
@@ -465,7 +480,7 @@ func resourceTencentCloudTkeTmpConfigDelete(d *schema.ResourceData, meta interfa
 	}
 
 	if err := service.DeleteTkeTmpConfigByName(ctx, d.Id(), ServiceMonitors, PodMonitors, RawJobs); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -194,8 +194,8 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -203,12 +203,12 @@ import (
 
 func resourceTencentCloudMonitorTmpTkeGlobalNotification() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorTmpTkeGlobalNotificationRead,
-		Create: resourceTencentCloudMonitorTmpTkeGlobalNotificationCreate,
-		Update: resourceTencentCloudMonitorTmpTkeGlobalNotificationUpdate,
-		Delete: resourceTencentCloudMonitorTmpTkeGlobalNotificationDelete,
+		ReadContext:   resourceTencentCloudMonitorTmpTkeGlobalNotificationRead,
+		CreateContext: resourceTencentCloudMonitorTmpTkeGlobalNotificationCreate,
+		UpdateContext: resourceTencentCloudMonitorTmpTkeGlobalNotificationUpdate,
+		DeleteContext: resourceTencentCloudMonitorTmpTkeGlobalNotificationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -331,7 +331,7 @@ func resourceTencentCloudMonitorTmpTkeGlobalNotification() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMonitorTmpTkeGlobalNotificationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpTkeGlobalNotificationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_global_notification.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -342,15 +342,15 @@ func resourceTencentCloudMonitorTmpTkeGlobalNotificationCreate(d *schema.Resourc
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudMonitorTmpTkeGlobalNotificationUpdate(d, meta)
+	return resourceTencentCloudMonitorTmpTkeGlobalNotificationUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpTkeGlobalNotificationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpTkeGlobalNotificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_global_notification.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -359,12 +359,12 @@ func resourceTencentCloudMonitorTmpTkeGlobalNotificationRead(d *schema.ResourceD
 	globalNotification, err := service.DescribeTkeTmpGlobalNotification(ctx, instanceId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if globalNotification == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `global_notification` %s does not exist", instanceId)
+		return diag.Errorf("resource `global_notification` %s does not exist", instanceId)
 	}
 
 	if *globalNotification.Enabled {
@@ -401,12 +401,12 @@ func resourceTencentCloudMonitorTmpTkeGlobalNotificationRead(d *schema.ResourceD
 	return nil
 }
 
-func resourceTencentCloudMonitorTmpTkeGlobalNotificationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpTkeGlobalNotificationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_global_notification.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	if d.HasChange("notification") {
@@ -472,19 +472,19 @@ func resourceTencentCloudMonitorTmpTkeGlobalNotificationUpdate(d *schema.Resourc
 		}
 
 		if _, err := service.ModifyTkeTmpGlobalNotification(ctx, d.Id(), notification); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMonitorTmpTkeGlobalNotificationRead(d, meta)
+	return resourceTencentCloudMonitorTmpTkeGlobalNotificationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpTkeGlobalNotificationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpTkeGlobalNotificationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_global_notification.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	id := d.Id()
@@ -495,7 +495,7 @@ func resourceTencentCloudMonitorTmpTkeGlobalNotificationDelete(d *schema.Resourc
 	}
 
 	if _, err := service.ModifyTkeTmpGlobalNotification(ctx, id, notification); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
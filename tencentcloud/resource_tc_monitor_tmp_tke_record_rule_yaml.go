@@ -188,10 +188,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -200,10 +200,14 @@ import (
 
 func resourceTencentCloudMonitorTmpTkeRecordRuleYaml() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTkeTmpRecordRuleYamlRead,
-		Create: resourceTencentCloudTkeTmpRecordRuleYamlCreate,
-		Update: resourceTencentCloudTkeTmpRecordRuleYamlUpdate,
-		Delete: resourceTencentCloudTkeTmpRecordRuleYamlDelete,
+		ReadContext:   resourceTencentCloudTkeTmpRecordRuleYamlRead,
+		CreateContext: resourceTencentCloudTkeTmpRecordRuleYamlCreate,
+		UpdateContext: resourceTencentCloudTkeTmpRecordRuleYamlUpdate,
+		DeleteContext: resourceTencentCloudTkeTmpRecordRuleYamlDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		//Importer: &schema.ResourceImporter{
 		//	State: schema.ImportStatePassthrough,
 		//},
@@ -248,7 +252,7 @@ func resourceTencentCloudMonitorTmpTkeRecordRuleYaml() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTkeTmpRecordRuleYamlCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeTmpRecordRuleYamlCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_record_rule_yaml.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -272,8 +276,8 @@ func resourceTencentCloudTkeTmpRecordRuleYamlCreate(d *schema.ResourceData, meta
 		request.Content = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreatePrometheusRecordRuleYaml(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreatePrometheusRecordRuleYamlWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -285,24 +289,24 @@ func resourceTencentCloudTkeTmpRecordRuleYamlCreate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tke tmpRecordRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId := *request.InstanceId
-	d.SetId(strings.Join([]string{instanceId, tmpRecordRuleName}, FILED_SP))
-	return resourceTencentCloudTkeTmpRecordRuleYamlRead(d, meta)
+	d.SetId(helper.IdFormat(instanceId, tmpRecordRuleName))
+	return resourceTencentCloudTkeTmpRecordRuleYamlRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTkeTmpRecordRuleYamlRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeTmpRecordRuleYamlRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_record_rule_yaml.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	ids := strings.Split(d.Id(), FILED_SP)
-	if len(ids) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	ids, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := ids[0]
@@ -311,7 +315,7 @@ func resourceTencentCloudTkeTmpRecordRuleYamlRead(d *schema.ResourceData, meta i
 	recordRuleService := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 	request, err := recordRuleService.DescribePrometheusRecordRuleByName(ctx, instanceId, name)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			request, err = recordRuleService.DescribePrometheusRecordRuleByName(ctx, instanceId, name)
 			if err != nil {
 				return retryError(err)
@@ -320,7 +324,7 @@ func resourceTencentCloudTkeTmpRecordRuleYamlRead(d *schema.ResourceData, meta i
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	recordRules := request.Response.Records
@@ -343,7 +347,7 @@ func resourceTencentCloudTkeTmpRecordRuleYamlRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudTkeTmpRecordRuleYamlUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeTmpRecordRuleYamlUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_record_rule_yaml.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -351,9 +355,9 @@ func resourceTencentCloudTkeTmpRecordRuleYamlUpdate(d *schema.ResourceData, meta
 
 	request := monitor.NewModifyPrometheusRecordRuleYamlRequest()
 
-	ids := strings.Split(d.Id(), FILED_SP)
-	if len(ids) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	ids, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	request.InstanceId = &ids[0]
@@ -363,8 +367,8 @@ func resourceTencentCloudTkeTmpRecordRuleYamlUpdate(d *schema.ResourceData, meta
 		if v, ok := d.GetOk("content"); ok {
 			request.Content = helper.String(v.(string))
 
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().ModifyPrometheusRecordRuleYaml(request)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().ModifyPrometheusRecordRuleYamlWithContext(ctx, request)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -375,31 +379,31 @@ func resourceTencentCloudTkeTmpRecordRuleYamlUpdate(d *schema.ResourceData, meta
 			})
 
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
-			return resourceTencentCloudTkeTmpRecordRuleYamlRead(d, meta)
+			return resourceTencentCloudTkeTmpRecordRuleYamlRead(ctx, d, meta)
 		}
 	}
 
 	return nil
 }
 
-func resourceTencentCloudTkeTmpRecordRuleYamlDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTkeTmpRecordRuleYamlDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_record_rule_yaml.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	ids := strings.Split(d.Id(), FILED_SP)
-	if len(ids) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	ids, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 	if err := service.DeletePrometheusRecordRuleYaml(ctx, ids[0], ids[1]); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
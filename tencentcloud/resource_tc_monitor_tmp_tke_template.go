@@ -88,9 +88,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -99,12 +100,16 @@ import (
 
 func resourceTencentCloudMonitorTmpTkeTemplate() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorTmpTkeTemplateRead,
-		Create: resourceTencentCloudMonitorTmpTkeTemplateCreate,
-		Update: resourceTencentCloudMonitorTmpTkeTemplateUpdate,
-		Delete: resourceTencentCloudMonitorTmpTkeTemplateDelete,
+		ReadContext:   resourceTencentCloudMonitorTmpTkeTemplateRead,
+		CreateContext: resourceTencentCloudMonitorTmpTkeTemplateCreate,
+		UpdateContext: resourceTencentCloudMonitorTmpTkeTemplateUpdate,
+		DeleteContext: resourceTencentCloudMonitorTmpTkeTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"template": {
@@ -257,7 +262,7 @@ func resourceTencentCloudMonitorTmpTkeTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMonitorTmpTkeTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpTkeTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -372,8 +377,8 @@ func resourceTencentCloudMonitorTmpTkeTemplateCreate(d *schema.ResourceData, met
 
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreatePrometheusTemp(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().CreatePrometheusTempWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -386,21 +391,21 @@ func resourceTencentCloudMonitorTmpTkeTemplateCreate(d *schema.ResourceData, met
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tke template failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	templateId := *response.Response.TemplateId
 	d.SetId(templateId)
 
-	return resourceTencentCloudMonitorTmpTkeTemplateRead(d, meta)
+	return resourceTencentCloudMonitorTmpTkeTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpTkeTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpTkeTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -409,12 +414,12 @@ func resourceTencentCloudMonitorTmpTkeTemplateRead(d *schema.ResourceData, meta
 	template, err := service.DescribeTmpTkeTemplateById(ctx, templateId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if template == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `template` %s does not exist", templateId)
+		return diag.Errorf("resource `template` %s does not exist", templateId)
 	}
 
 	templates := make([]map[string]interface{}, 0)
@@ -428,7 +433,7 @@ func resourceTencentCloudMonitorTmpTkeTemplateRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudMonitorTmpTkeTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpTkeTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_template.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -527,8 +532,8 @@ func resourceTencentCloudMonitorTmpTkeTemplateUpdate(d *schema.ResourceData, met
 			request.Template = &prometheusTemp
 		}
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().ModifyPrometheusTemp(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().ModifyPrometheusTempWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -539,24 +544,24 @@ func resourceTencentCloudMonitorTmpTkeTemplateUpdate(d *schema.ResourceData, met
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMonitorTmpTkeTemplateRead(d, meta)
+	return resourceTencentCloudMonitorTmpTkeTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpTkeTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpTkeTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 	id := d.Id()
 
 	if err := service.DeleteTmpTkeTemplate(ctx, id); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -256,15 +256,23 @@ resource "tencentcloud_monitor_tmp_tke_template_attachment" "temp_attachment" {
   depends_on = [tencentcloud_monitor_tmp_tke_cluster_agent.foo]
 }
 ```
+
+Import
+
+monitor tmp_tke_template_attachment can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_monitor_tmp_tke_template_attachment.temp_attachment temp-wvsijmbf#prom-dko9d0nu#ap-guangzhou
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
@@ -273,9 +281,16 @@ import (
 
 func resourceTencentCloudMonitorTmpTkeTemplateAttachment() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorTmpTkeTemplateAttachmentRead,
-		Create: resourceTencentCloudMonitorTmpTkeTemplateAttachmentCreate,
-		Delete: resourceTencentCloudMonitorTmpTkeTemplateAttachmentDelete,
+		ReadContext:   resourceTencentCloudMonitorTmpTkeTemplateAttachmentRead,
+		CreateContext: resourceTencentCloudMonitorTmpTkeTemplateAttachmentCreate,
+		DeleteContext: resourceTencentCloudMonitorTmpTkeTemplateAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"template_id": {
 				Type:        schema.TypeString,
@@ -339,7 +354,7 @@ func resourceTencentCloudMonitorTmpTkeTemplateAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMonitorTmpTkeTemplateAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpTkeTemplateAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_template_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -391,8 +406,8 @@ func resourceTencentCloudMonitorTmpTkeTemplateAttachmentCreate(d *schema.Resourc
 
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().SyncPrometheusTemp(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().SyncPrometheusTempWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -404,44 +419,46 @@ func resourceTencentCloudMonitorTmpTkeTemplateAttachmentCreate(d *schema.Resourc
 
 	if err != nil {
 		log.Printf("[CRITAL]%s sync tke template failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	templateId := *request.TemplateId
 	instanceId := *request.Targets[0].InstanceId
 	region := *request.Targets[0].Region
-	d.SetId(strings.Join([]string{templateId, instanceId, region}, FILED_SP))
+	d.SetId(helper.IdFormat(templateId, instanceId, region))
 
-	return resourceTencentCloudMonitorTmpTkeTemplateAttachmentRead(d, meta)
+	return resourceTencentCloudMonitorTmpTkeTemplateAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMonitorTmpTkeTemplateAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpTkeTemplateAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_template_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	ids := strings.Split(d.Id(), FILED_SP)
-	if len(ids) != 3 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	ids, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	templateId := ids[0]
 	instanceId := ids[1]
 	region := ids[2]
 
+	_ = d.Set("template_id", templateId)
+
 	targets, err := service.DescribePrometheusTempSync(ctx, templateId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if targets == nil || len(targets) < 1 {
 		d.SetId("")
-		return fmt.Errorf("resource `targets` %s does not exist", templateId)
+		return diag.Errorf("resource `targets` %s does not exist", templateId)
 	}
 
 	tempTargets := make([]map[string]interface{}, 0)
@@ -464,16 +481,16 @@ func resourceTencentCloudMonitorTmpTkeTemplateAttachmentRead(d *schema.ResourceD
 	return nil
 }
 
-func resourceTencentCloudMonitorTmpTkeTemplateAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMonitorTmpTkeTemplateAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_monitor_tmp_tke_template_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 	request := monitor.NewDeletePrometheusTempSyncRequest()
 
-	ids := strings.Split(d.Id(), FILED_SP)
+	ids := helper.IdParse(d.Id())
 	if len(ids) != 3 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+		return diag.Errorf("id is broken, id is %s", d.Id())
 	}
 
 	templateId := ids[0]
@@ -489,8 +506,8 @@ func resourceTencentCloudMonitorTmpTkeTemplateAttachmentDelete(d *schema.Resourc
 	targets = append(targets, &target)
 	request.Targets = targets
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().DeletePrometheusTempSync(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().DeletePrometheusTempSyncWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -500,7 +517,7 @@ func resourceTencentCloudMonitorTmpTkeTemplateAttachmentDelete(d *schema.Resourc
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
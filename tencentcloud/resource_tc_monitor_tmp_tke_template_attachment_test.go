@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func TestAccTencentCloudMonitorTempAttachment_basic(t *testing.T) {
@@ -26,6 +26,11 @@ func TestAccTencentCloudMonitorTempAttachment_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_monitor_tmp_tke_template_attachment.basic", "targets.0.region", "ap-guangzhou"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_monitor_tmp_tke_template_attachment.basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -38,7 +43,7 @@ func testAccCheckTempAttachmentDestroy(s *terraform.State) error {
 		if rs.Type != "tencentcloud_monitor_tmp_tke_template_attachment" {
 			continue
 		}
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) != 3 {
 			return fmt.Errorf("invalid ID %s", rs.Primary.ID)
 		}
@@ -75,7 +80,7 @@ func testAccCheckTempAttachmentExists(r string) resource.TestCheckFunc {
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("resource id is not set")
 		}
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) != 3 {
 			return fmt.Errorf("invalid ID %s", rs.Primary.ID)
 		}
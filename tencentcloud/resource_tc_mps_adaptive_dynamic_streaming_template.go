@@ -74,7 +74,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mps "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mps/v20190612"
@@ -83,12 +85,16 @@ import (
 
 func resourceTencentCloudMpsAdaptiveDynamicStreamingTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateCreate,
-		Read:   resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateRead,
-		Update: resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateUpdate,
-		Delete: resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateDelete,
+		CreateContext: resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateCreate,
+		ReadContext:   resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateRead,
+		UpdateContext: resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateUpdate,
+		DeleteContext: resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"format": {
@@ -229,7 +235,7 @@ func resourceTencentCloudMpsAdaptiveDynamicStreamingTemplate() *schema.Resource
 	}
 }
 
-func resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_adaptive_dynamic_streaming_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -321,8 +327,8 @@ func resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateCreate(d *schema.Res
 		request.Comment = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreateAdaptiveDynamicStreamingTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreateAdaptiveDynamicStreamingTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -333,22 +339,22 @@ func resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateCreate(d *schema.Res
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mps adaptiveDynamicStreamingTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	definition = *response.Response.Definition
 	d.SetId(helper.UInt64ToStr(definition))
 
-	return resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateRead(d, meta)
+	return resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_adaptive_dynamic_streaming_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -356,7 +362,7 @@ func resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateRead(d *schema.Resou
 
 	adaptiveDynamicStreamingTemplate, err := service.DescribeMpsAdaptiveDynamicStreamingTemplateById(ctx, definition)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if adaptiveDynamicStreamingTemplate == nil {
@@ -472,7 +478,7 @@ func resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateRead(d *schema.Resou
 	return nil
 }
 
-func resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_adaptive_dynamic_streaming_template.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -578,8 +584,8 @@ func resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateUpdate(d *schema.Res
 			request.Comment = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ModifyAdaptiveDynamicStreamingTemplate(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ModifyAdaptiveDynamicStreamingTemplateWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -589,25 +595,25 @@ func resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateUpdate(d *schema.Res
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update mps adaptiveDynamicStreamingTemplate failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateRead(d, meta)
+	return resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsAdaptiveDynamicStreamingTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_adaptive_dynamic_streaming_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	definition := d.Id()
 
 	if err := service.DeleteMpsAdaptiveDynamicStreamingTemplateById(ctx, definition); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
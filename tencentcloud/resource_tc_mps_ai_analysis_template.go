@@ -39,7 +39,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mps "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mps/v20190612"
@@ -48,12 +50,16 @@ import (
 
 func resourceTencentCloudMpsAiAnalysisTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMpsAiAnalysisTemplateCreate,
-		Read:   resourceTencentCloudMpsAiAnalysisTemplateRead,
-		Update: resourceTencentCloudMpsAiAnalysisTemplateUpdate,
-		Delete: resourceTencentCloudMpsAiAnalysisTemplateDelete,
+		CreateContext: resourceTencentCloudMpsAiAnalysisTemplateCreate,
+		ReadContext:   resourceTencentCloudMpsAiAnalysisTemplateRead,
+		UpdateContext: resourceTencentCloudMpsAiAnalysisTemplateUpdate,
+		DeleteContext: resourceTencentCloudMpsAiAnalysisTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -135,7 +141,7 @@ func resourceTencentCloudMpsAiAnalysisTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMpsAiAnalysisTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsAiAnalysisTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_ai_analysis_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -186,8 +192,8 @@ func resourceTencentCloudMpsAiAnalysisTemplateCreate(d *schema.ResourceData, met
 		request.FrameTagConfigure = &frameTagConfigureInfo
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreateAIAnalysisTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreateAIAnalysisTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -198,22 +204,22 @@ func resourceTencentCloudMpsAiAnalysisTemplateCreate(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mps aiAnalysisTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	definition = *response.Response.Definition
 	d.SetId(helper.Int64ToStr(definition))
 
-	return resourceTencentCloudMpsAiAnalysisTemplateRead(d, meta)
+	return resourceTencentCloudMpsAiAnalysisTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsAiAnalysisTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsAiAnalysisTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_ai_analysis_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -221,7 +227,7 @@ func resourceTencentCloudMpsAiAnalysisTemplateRead(d *schema.ResourceData, meta
 
 	aiAnalysisTemplate, err := service.DescribeMpsAiAnalysisTemplateById(ctx, definition)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if aiAnalysisTemplate == nil {
@@ -281,7 +287,7 @@ func resourceTencentCloudMpsAiAnalysisTemplateRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudMpsAiAnalysisTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsAiAnalysisTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_ai_analysis_template.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -344,8 +350,8 @@ func resourceTencentCloudMpsAiAnalysisTemplateUpdate(d *schema.ResourceData, met
 			request.FrameTagConfigure = &frameTagConfigureInfo
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ModifyAIAnalysisTemplate(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ModifyAIAnalysisTemplateWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -355,25 +361,25 @@ func resourceTencentCloudMpsAiAnalysisTemplateUpdate(d *schema.ResourceData, met
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update mps aiAnalysisTemplate failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMpsAiAnalysisTemplateRead(d, meta)
+	return resourceTencentCloudMpsAiAnalysisTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsAiAnalysisTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsAiAnalysisTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_ai_analysis_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	definition := d.Id()
 
 	if err := service.DeleteMpsAiAnalysisTemplateById(ctx, definition); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -51,7 +51,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mps "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mps/v20190612"
@@ -60,12 +62,16 @@ import (
 
 func resourceTencentCloudMpsAiRecognitionTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMpsAiRecognitionTemplateCreate,
-		Read:   resourceTencentCloudMpsAiRecognitionTemplateRead,
-		Update: resourceTencentCloudMpsAiRecognitionTemplateUpdate,
-		Delete: resourceTencentCloudMpsAiRecognitionTemplateDelete,
+		CreateContext: resourceTencentCloudMpsAiRecognitionTemplateCreate,
+		ReadContext:   resourceTencentCloudMpsAiRecognitionTemplateRead,
+		UpdateContext: resourceTencentCloudMpsAiRecognitionTemplateUpdate,
+		DeleteContext: resourceTencentCloudMpsAiRecognitionTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -210,7 +216,7 @@ func resourceTencentCloudMpsAiRecognitionTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMpsAiRecognitionTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsAiRecognitionTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_ai_recognition_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -306,8 +312,8 @@ func resourceTencentCloudMpsAiRecognitionTemplateCreate(d *schema.ResourceData,
 		request.AsrWordsConfigure = &asrWordsConfigureInfo
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreateAIRecognitionTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreateAIRecognitionTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -318,22 +324,22 @@ func resourceTencentCloudMpsAiRecognitionTemplateCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mps aiRecognitionTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	definition = *response.Response.Definition
 	d.SetId(helper.Int64ToStr(definition))
 
-	return resourceTencentCloudMpsAiRecognitionTemplateRead(d, meta)
+	return resourceTencentCloudMpsAiRecognitionTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsAiRecognitionTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsAiRecognitionTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_ai_recognition_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -341,7 +347,7 @@ func resourceTencentCloudMpsAiRecognitionTemplateRead(d *schema.ResourceData, me
 
 	aiRecognitionTemplate, err := service.DescribeMpsAiRecognitionTemplateById(ctx, definition)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if aiRecognitionTemplate == nil {
@@ -439,7 +445,7 @@ func resourceTencentCloudMpsAiRecognitionTemplateRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudMpsAiRecognitionTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsAiRecognitionTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_ai_recognition_template.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -548,8 +554,8 @@ func resourceTencentCloudMpsAiRecognitionTemplateUpdate(d *schema.ResourceData,
 			request.AsrWordsConfigure = &asrWordsConfigureInfo
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ModifyAIRecognitionTemplate(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ModifyAIRecognitionTemplateWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -559,25 +565,25 @@ func resourceTencentCloudMpsAiRecognitionTemplateUpdate(d *schema.ResourceData,
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update mps aiRecognitionTemplate failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMpsAiRecognitionTemplateRead(d, meta)
+	return resourceTencentCloudMpsAiRecognitionTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsAiRecognitionTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsAiRecognitionTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_ai_recognition_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	definition := d.Id()
 
 	if err := service.DeleteMpsAiRecognitionTemplateById(ctx, definition); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
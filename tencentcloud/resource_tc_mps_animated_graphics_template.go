@@ -28,7 +28,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mps "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mps/v20190612"
@@ -37,12 +39,16 @@ import (
 
 func resourceTencentCloudMpsAnimatedGraphicsTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMpsAnimatedGraphicsTemplateCreate,
-		Read:   resourceTencentCloudMpsAnimatedGraphicsTemplateRead,
-		Update: resourceTencentCloudMpsAnimatedGraphicsTemplateUpdate,
-		Delete: resourceTencentCloudMpsAnimatedGraphicsTemplateDelete,
+		CreateContext: resourceTencentCloudMpsAnimatedGraphicsTemplateCreate,
+		ReadContext:   resourceTencentCloudMpsAnimatedGraphicsTemplateRead,
+		UpdateContext: resourceTencentCloudMpsAnimatedGraphicsTemplateUpdate,
+		DeleteContext: resourceTencentCloudMpsAnimatedGraphicsTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"fps": {
@@ -96,7 +102,7 @@ func resourceTencentCloudMpsAnimatedGraphicsTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMpsAnimatedGraphicsTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsAnimatedGraphicsTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_animated_graphics_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -139,8 +145,8 @@ func resourceTencentCloudMpsAnimatedGraphicsTemplateCreate(d *schema.ResourceDat
 		request.Comment = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreateAnimatedGraphicsTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreateAnimatedGraphicsTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -151,22 +157,22 @@ func resourceTencentCloudMpsAnimatedGraphicsTemplateCreate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mps animatedGraphicsTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	definition = *response.Response.Definition
 	d.SetId(helper.UInt64ToStr(definition))
 
-	return resourceTencentCloudMpsAnimatedGraphicsTemplateRead(d, meta)
+	return resourceTencentCloudMpsAnimatedGraphicsTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsAnimatedGraphicsTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsAnimatedGraphicsTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_animated_graphics_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -174,7 +180,7 @@ func resourceTencentCloudMpsAnimatedGraphicsTemplateRead(d *schema.ResourceData,
 
 	animatedGraphicsTemplate, err := service.DescribeMpsAnimatedGraphicsTemplateById(ctx, definition)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if animatedGraphicsTemplate == nil {
@@ -218,7 +224,7 @@ func resourceTencentCloudMpsAnimatedGraphicsTemplateRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudMpsAnimatedGraphicsTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsAnimatedGraphicsTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_animated_graphics_template.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -275,8 +281,8 @@ func resourceTencentCloudMpsAnimatedGraphicsTemplateUpdate(d *schema.ResourceDat
 			request.Comment = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ModifyAnimatedGraphicsTemplate(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ModifyAnimatedGraphicsTemplateWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -286,25 +292,25 @@ func resourceTencentCloudMpsAnimatedGraphicsTemplateUpdate(d *schema.ResourceDat
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update mps animatedGraphicsTemplate failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMpsAnimatedGraphicsTemplateRead(d, meta)
+	return resourceTencentCloudMpsAnimatedGraphicsTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsAnimatedGraphicsTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsAnimatedGraphicsTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_animated_graphics_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	definition := d.Id()
 
 	if err := service.DeleteMpsAnimatedGraphicsTemplateById(ctx, definition); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
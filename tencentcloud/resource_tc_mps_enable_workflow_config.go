@@ -125,7 +125,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mps "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mps/v20190612"
@@ -134,12 +136,15 @@ import (
 
 func resourceTencentCloudMpsEnableWorkflowConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMpsEnableWorkflowConfigCreate,
-		Read:   resourceTencentCloudMpsEnableWorkflowConfigRead,
-		Update: resourceTencentCloudMpsEnableWorkflowConfigUpdate,
-		Delete: resourceTencentCloudMpsEnableWorkflowConfigDelete,
+		CreateContext: resourceTencentCloudMpsEnableWorkflowConfigCreate,
+		ReadContext:   resourceTencentCloudMpsEnableWorkflowConfigRead,
+		UpdateContext: resourceTencentCloudMpsEnableWorkflowConfigUpdate,
+		DeleteContext: resourceTencentCloudMpsEnableWorkflowConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"workflow_id": {
@@ -157,7 +162,7 @@ func resourceTencentCloudMpsEnableWorkflowConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMpsEnableWorkflowConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsEnableWorkflowConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_enable_workflow_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -167,16 +172,16 @@ func resourceTencentCloudMpsEnableWorkflowConfigCreate(d *schema.ResourceData, m
 	}
 	d.SetId(helper.IntToStr(workflowId))
 
-	return resourceTencentCloudMpsEnableWorkflowConfigUpdate(d, meta)
+	return resourceTencentCloudMpsEnableWorkflowConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsEnableWorkflowConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsEnableWorkflowConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_enable_workflow_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -184,7 +189,7 @@ func resourceTencentCloudMpsEnableWorkflowConfigRead(d *schema.ResourceData, met
 
 	enableWorkflowConfig, err := service.DescribeMpsWorkflowById(ctx, workflowId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if enableWorkflowConfig == nil {
@@ -209,7 +214,7 @@ func resourceTencentCloudMpsEnableWorkflowConfigRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudMpsEnableWorkflowConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsEnableWorkflowConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_enable_workflow_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -229,8 +234,8 @@ func resourceTencentCloudMpsEnableWorkflowConfigUpdate(d *schema.ResourceData, m
 
 		if enabled {
 			enableRequest.WorkflowId = workflowId
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().EnableWorkflow(enableRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().EnableWorkflowWithContext(ctx, enableRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -240,12 +245,12 @@ func resourceTencentCloudMpsEnableWorkflowConfigUpdate(d *schema.ResourceData, m
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s operate mps enableWorkflowConfig failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		} else {
 			disableRequest.WorkflowId = workflowId
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().DisableWorkflow(disableRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().DisableWorkflowWithContext(ctx, disableRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -255,15 +260,15 @@ func resourceTencentCloudMpsEnableWorkflowConfigUpdate(d *schema.ResourceData, m
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s operate mps disableWorkflowConfig failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return resourceTencentCloudMpsEnableWorkflowConfigRead(d, meta)
+	return resourceTencentCloudMpsEnableWorkflowConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsEnableWorkflowConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsEnableWorkflowConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_enable_workflow_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
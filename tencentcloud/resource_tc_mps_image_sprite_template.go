@@ -31,7 +31,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mps "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mps/v20190612"
@@ -40,12 +42,16 @@ import (
 
 func resourceTencentCloudMpsImageSpriteTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMpsImageSpriteTemplateCreate,
-		Read:   resourceTencentCloudMpsImageSpriteTemplateRead,
-		Update: resourceTencentCloudMpsImageSpriteTemplateUpdate,
-		Delete: resourceTencentCloudMpsImageSpriteTemplateDelete,
+		CreateContext: resourceTencentCloudMpsImageSpriteTemplateCreate,
+		ReadContext:   resourceTencentCloudMpsImageSpriteTemplateRead,
+		UpdateContext: resourceTencentCloudMpsImageSpriteTemplateUpdate,
+		DeleteContext: resourceTencentCloudMpsImageSpriteTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"sample_type": {
@@ -117,7 +123,7 @@ func resourceTencentCloudMpsImageSpriteTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMpsImageSpriteTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsImageSpriteTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_image_sprite_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -172,8 +178,8 @@ func resourceTencentCloudMpsImageSpriteTemplateCreate(d *schema.ResourceData, me
 		request.Format = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreateImageSpriteTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreateImageSpriteTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -184,22 +190,22 @@ func resourceTencentCloudMpsImageSpriteTemplateCreate(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mps imageSpriteTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	definition = *response.Response.Definition
 	d.SetId(helper.UInt64ToStr(definition))
 
-	return resourceTencentCloudMpsImageSpriteTemplateRead(d, meta)
+	return resourceTencentCloudMpsImageSpriteTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsImageSpriteTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsImageSpriteTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_image_sprite_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -207,7 +213,7 @@ func resourceTencentCloudMpsImageSpriteTemplateRead(d *schema.ResourceData, meta
 
 	imageSpriteTemplate, err := service.DescribeMpsImageSpriteTemplateById(ctx, definition)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if imageSpriteTemplate == nil {
@@ -263,7 +269,7 @@ func resourceTencentCloudMpsImageSpriteTemplateRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudMpsImageSpriteTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsImageSpriteTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_image_sprite_template.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -331,8 +337,8 @@ func resourceTencentCloudMpsImageSpriteTemplateUpdate(d *schema.ResourceData, me
 			request.Format = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ModifyImageSpriteTemplate(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ModifyImageSpriteTemplateWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -342,26 +348,26 @@ func resourceTencentCloudMpsImageSpriteTemplateUpdate(d *schema.ResourceData, me
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update mps imageSpriteTemplate failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
-	return resourceTencentCloudMpsImageSpriteTemplateRead(d, meta)
+	return resourceTencentCloudMpsImageSpriteTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsImageSpriteTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsImageSpriteTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_image_sprite_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	definition := d.Id()
 
 	if err := service.DeleteMpsImageSpriteTemplateById(ctx, definition); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -32,7 +32,9 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mps "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mps/v20190612"
@@ -41,12 +43,16 @@ import (
 
 func resourceTencentCloudMpsPersonSample() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMpsPersonSampleCreate,
-		Read:   resourceTencentCloudMpsPersonSampleRead,
-		Update: resourceTencentCloudMpsPersonSampleUpdate,
-		Delete: resourceTencentCloudMpsPersonSampleDelete,
+		CreateContext: resourceTencentCloudMpsPersonSampleCreate,
+		ReadContext:   resourceTencentCloudMpsPersonSampleRead,
+		UpdateContext: resourceTencentCloudMpsPersonSampleUpdate,
+		DeleteContext: resourceTencentCloudMpsPersonSampleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -82,7 +88,7 @@ func resourceTencentCloudMpsPersonSample() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMpsPersonSampleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsPersonSampleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_person_sample.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -117,8 +123,8 @@ func resourceTencentCloudMpsPersonSampleCreate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreatePersonSample(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreatePersonSampleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -129,22 +135,22 @@ func resourceTencentCloudMpsPersonSampleCreate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mps personSample failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	personId = *response.Response.Person.PersonId
 	d.SetId(personId)
 
-	return resourceTencentCloudMpsPersonSampleRead(d, meta)
+	return resourceTencentCloudMpsPersonSampleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsPersonSampleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsPersonSampleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_person_sample.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -152,7 +158,7 @@ func resourceTencentCloudMpsPersonSampleRead(d *schema.ResourceData, meta interf
 
 	personSample, err := service.DescribeMpsPersonSampleById(ctx, personId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if personSample == nil {
@@ -179,11 +185,11 @@ func resourceTencentCloudMpsPersonSampleRead(d *schema.ResourceData, meta interf
 			url := faceInfo.Url
 			res, err := http.Get(*url)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			content, err := ioutil.ReadAll(res.Body)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			base64Encode := base64.StdEncoding.EncodeToString(content)
 			faceContents = append(faceContents, &base64Encode)
@@ -194,7 +200,7 @@ func resourceTencentCloudMpsPersonSampleRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudMpsPersonSampleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsPersonSampleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_person_sample.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -245,8 +251,8 @@ func resourceTencentCloudMpsPersonSampleUpdate(d *schema.ResourceData, meta inte
 			request.FaceOperationInfo = &operationInfo
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ModifyPersonSample(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ModifyPersonSampleWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -256,25 +262,25 @@ func resourceTencentCloudMpsPersonSampleUpdate(d *schema.ResourceData, meta inte
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update mps personSample failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMpsPersonSampleRead(d, meta)
+	return resourceTencentCloudMpsPersonSampleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsPersonSampleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsPersonSampleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_person_sample.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	personId := d.Id()
 
 	if err := service.DeleteMpsPersonSampleById(ctx, personId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -29,7 +29,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mps "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mps/v20190612"
@@ -38,12 +40,16 @@ import (
 
 func resourceTencentCloudMpsSampleSnapshotTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMpsSampleSnapshotTemplateCreate,
-		Read:   resourceTencentCloudMpsSampleSnapshotTemplateRead,
-		Update: resourceTencentCloudMpsSampleSnapshotTemplateUpdate,
-		Delete: resourceTencentCloudMpsSampleSnapshotTemplateDelete,
+		CreateContext: resourceTencentCloudMpsSampleSnapshotTemplateCreate,
+		ReadContext:   resourceTencentCloudMpsSampleSnapshotTemplateRead,
+		UpdateContext: resourceTencentCloudMpsSampleSnapshotTemplateUpdate,
+		DeleteContext: resourceTencentCloudMpsSampleSnapshotTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"sample_type": {
@@ -103,7 +109,7 @@ func resourceTencentCloudMpsSampleSnapshotTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMpsSampleSnapshotTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsSampleSnapshotTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_sample_snapshot_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -150,8 +156,8 @@ func resourceTencentCloudMpsSampleSnapshotTemplateCreate(d *schema.ResourceData,
 		request.FillType = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreateSampleSnapshotTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreateSampleSnapshotTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -162,22 +168,22 @@ func resourceTencentCloudMpsSampleSnapshotTemplateCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mps sampleSnapshotTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	definition = *response.Response.Definition
 	d.SetId(helper.UInt64ToStr(definition))
 
-	return resourceTencentCloudMpsSampleSnapshotTemplateRead(d, meta)
+	return resourceTencentCloudMpsSampleSnapshotTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsSampleSnapshotTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsSampleSnapshotTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_sample_snapshot_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -185,7 +191,7 @@ func resourceTencentCloudMpsSampleSnapshotTemplateRead(d *schema.ResourceData, m
 
 	sampleSnapshotTemplate, err := service.DescribeMpsSampleSnapshotTemplateById(ctx, definition)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if sampleSnapshotTemplate == nil {
@@ -233,7 +239,7 @@ func resourceTencentCloudMpsSampleSnapshotTemplateRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceTencentCloudMpsSampleSnapshotTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsSampleSnapshotTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_sample_snapshot_template.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -294,8 +300,8 @@ func resourceTencentCloudMpsSampleSnapshotTemplateUpdate(d *schema.ResourceData,
 			request.FillType = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ModifySampleSnapshotTemplate(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ModifySampleSnapshotTemplateWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -305,25 +311,25 @@ func resourceTencentCloudMpsSampleSnapshotTemplateUpdate(d *schema.ResourceData,
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update mps sampleSnapshotTemplate failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMpsSampleSnapshotTemplateRead(d, meta)
+	return resourceTencentCloudMpsSampleSnapshotTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsSampleSnapshotTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsSampleSnapshotTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_sample_snapshot_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	definition := d.Id()
 
 	if err := service.DeleteMpsSampleSnapshotTemplateById(ctx, definition); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
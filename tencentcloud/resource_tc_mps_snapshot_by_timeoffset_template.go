@@ -27,7 +27,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mps "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mps/v20190612"
@@ -36,12 +38,16 @@ import (
 
 func resourceTencentCloudMpsSnapshotByTimeoffsetTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMpsSnapshotByTimeoffsetTemplateCreate,
-		Read:   resourceTencentCloudMpsSnapshotByTimeoffsetTemplateRead,
-		Update: resourceTencentCloudMpsSnapshotByTimeoffsetTemplateUpdate,
-		Delete: resourceTencentCloudMpsSnapshotByTimeoffsetTemplateDelete,
+		CreateContext: resourceTencentCloudMpsSnapshotByTimeoffsetTemplateCreate,
+		ReadContext:   resourceTencentCloudMpsSnapshotByTimeoffsetTemplateRead,
+		UpdateContext: resourceTencentCloudMpsSnapshotByTimeoffsetTemplateUpdate,
+		DeleteContext: resourceTencentCloudMpsSnapshotByTimeoffsetTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -89,7 +95,7 @@ func resourceTencentCloudMpsSnapshotByTimeoffsetTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMpsSnapshotByTimeoffsetTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsSnapshotByTimeoffsetTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_snapshot_by_timeoffset_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -128,8 +134,8 @@ func resourceTencentCloudMpsSnapshotByTimeoffsetTemplateCreate(d *schema.Resourc
 		request.FillType = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreateSnapshotByTimeOffsetTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreateSnapshotByTimeOffsetTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -140,22 +146,22 @@ func resourceTencentCloudMpsSnapshotByTimeoffsetTemplateCreate(d *schema.Resourc
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mps snapshotByTimeoffsetTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	definition = *response.Response.Definition
 	d.SetId(helper.UInt64ToStr(definition))
 
-	return resourceTencentCloudMpsSnapshotByTimeoffsetTemplateRead(d, meta)
+	return resourceTencentCloudMpsSnapshotByTimeoffsetTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsSnapshotByTimeoffsetTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsSnapshotByTimeoffsetTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_snapshot_by_timeoffset_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -163,7 +169,7 @@ func resourceTencentCloudMpsSnapshotByTimeoffsetTemplateRead(d *schema.ResourceD
 
 	snapshotByTimeoffsetTemplate, err := service.DescribeMpsSnapshotByTimeoffsetTemplateById(ctx, definition)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if snapshotByTimeoffsetTemplate == nil {
@@ -203,7 +209,7 @@ func resourceTencentCloudMpsSnapshotByTimeoffsetTemplateRead(d *schema.ResourceD
 	return nil
 }
 
-func resourceTencentCloudMpsSnapshotByTimeoffsetTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsSnapshotByTimeoffsetTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_snapshot_by_timeoffset_template.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -256,8 +262,8 @@ func resourceTencentCloudMpsSnapshotByTimeoffsetTemplateUpdate(d *schema.Resourc
 			request.FillType = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ModifySnapshotByTimeOffsetTemplate(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ModifySnapshotByTimeOffsetTemplateWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -267,25 +273,25 @@ func resourceTencentCloudMpsSnapshotByTimeoffsetTemplateUpdate(d *schema.Resourc
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update mps snapshotByTimeoffsetTemplate failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMpsSnapshotByTimeoffsetTemplateRead(d, meta)
+	return resourceTencentCloudMpsSnapshotByTimeoffsetTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsSnapshotByTimeoffsetTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsSnapshotByTimeoffsetTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_snapshot_by_timeoffset_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	definition := d.Id()
 
 	if err := service.DeleteMpsSnapshotByTimeoffsetTemplateById(ctx, definition); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
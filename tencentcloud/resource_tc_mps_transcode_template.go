@@ -44,7 +44,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mps "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mps/v20190612"
@@ -53,12 +55,16 @@ import (
 
 func resourceTencentCloudMpsTranscodeTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMpsTranscodeTemplateCreate,
-		Read:   resourceTencentCloudMpsTranscodeTemplateRead,
-		Update: resourceTencentCloudMpsTranscodeTemplateUpdate,
-		Delete: resourceTencentCloudMpsTranscodeTemplateDelete,
+		CreateContext: resourceTencentCloudMpsTranscodeTemplateCreate,
+		ReadContext:   resourceTencentCloudMpsTranscodeTemplateRead,
+		UpdateContext: resourceTencentCloudMpsTranscodeTemplateUpdate,
+		DeleteContext: resourceTencentCloudMpsTranscodeTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"container": {
@@ -448,7 +454,7 @@ func resourceTencentCloudMpsTranscodeTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMpsTranscodeTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsTranscodeTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_transcode_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -661,8 +667,8 @@ func resourceTencentCloudMpsTranscodeTemplateCreate(d *schema.ResourceData, meta
 		request.EnhanceConfig = &enhanceConfig
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreateTranscodeTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreateTranscodeTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -673,22 +679,22 @@ func resourceTencentCloudMpsTranscodeTemplateCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mps transcodeTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	definition = *response.Response.Definition
 	d.SetId(helper.Int64ToStr(definition))
 
-	return resourceTencentCloudMpsTranscodeTemplateRead(d, meta)
+	return resourceTencentCloudMpsTranscodeTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsTranscodeTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsTranscodeTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_transcode_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -696,7 +702,7 @@ func resourceTencentCloudMpsTranscodeTemplateRead(d *schema.ResourceData, meta i
 
 	transcodeTemplate, err := service.DescribeMpsTranscodeTemplateById(ctx, definition)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if transcodeTemplate == nil {
@@ -976,7 +982,7 @@ func resourceTencentCloudMpsTranscodeTemplateRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudMpsTranscodeTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsTranscodeTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_transcode_template.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -1208,8 +1214,8 @@ func resourceTencentCloudMpsTranscodeTemplateUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ModifyTranscodeTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ModifyTranscodeTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1219,24 +1225,24 @@ func resourceTencentCloudMpsTranscodeTemplateUpdate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update mps transcodeTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMpsTranscodeTemplateRead(d, meta)
+	return resourceTencentCloudMpsTranscodeTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsTranscodeTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsTranscodeTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_transcode_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	definition := d.Id()
 
 	if err := service.DeleteMpsTranscodeTemplateById(ctx, definition); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -36,7 +36,9 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mps "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mps/v20190612"
@@ -45,12 +47,16 @@ import (
 
 func resourceTencentCloudMpsWatermarkTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMpsWatermarkTemplateCreate,
-		Read:   resourceTencentCloudMpsWatermarkTemplateRead,
-		Update: resourceTencentCloudMpsWatermarkTemplateUpdate,
-		Delete: resourceTencentCloudMpsWatermarkTemplateDelete,
+		CreateContext: resourceTencentCloudMpsWatermarkTemplateCreate,
+		ReadContext:   resourceTencentCloudMpsWatermarkTemplateRead,
+		UpdateContext: resourceTencentCloudMpsWatermarkTemplateUpdate,
+		DeleteContext: resourceTencentCloudMpsWatermarkTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"type": {
@@ -176,7 +182,7 @@ func resourceTencentCloudMpsWatermarkTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMpsWatermarkTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsWatermarkTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_watermark_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -256,8 +262,8 @@ func resourceTencentCloudMpsWatermarkTemplateCreate(d *schema.ResourceData, meta
 		request.SvgTemplate = &svgWatermarkInput
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreateWatermarkTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreateWatermarkTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -268,22 +274,22 @@ func resourceTencentCloudMpsWatermarkTemplateCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mps watermarkTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	definition = *response.Response.Definition
 	d.SetId(helper.Int64ToStr(definition))
 
-	return resourceTencentCloudMpsWatermarkTemplateRead(d, meta)
+	return resourceTencentCloudMpsWatermarkTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsWatermarkTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsWatermarkTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_watermark_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -291,7 +297,7 @@ func resourceTencentCloudMpsWatermarkTemplateRead(d *schema.ResourceData, meta i
 
 	watermarkTemplate, err := service.DescribeMpsWatermarkTemplateById(ctx, definition)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if watermarkTemplate == nil {
@@ -331,11 +337,11 @@ func resourceTencentCloudMpsWatermarkTemplateRead(d *schema.ResourceData, meta i
 			url := watermarkTemplate.ImageTemplate.ImageUrl
 			res, err := http.Get(*url)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			content, err := ioutil.ReadAll(res.Body)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			base64Encode := base64.StdEncoding.EncodeToString(content)
 			imageTemplateMap["image_content"] = base64Encode
@@ -395,7 +401,7 @@ func resourceTencentCloudMpsWatermarkTemplateRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudMpsWatermarkTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsWatermarkTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_watermark_template.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -488,8 +494,8 @@ func resourceTencentCloudMpsWatermarkTemplateUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ModifyWatermarkTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ModifyWatermarkTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -499,24 +505,24 @@ func resourceTencentCloudMpsWatermarkTemplateUpdate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update mps watermarkTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMpsWatermarkTemplateRead(d, meta)
+	return resourceTencentCloudMpsWatermarkTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsWatermarkTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsWatermarkTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_watermark_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	definition := d.Id()
 
 	if err := service.DeleteMpsWatermarkTemplateById(ctx, definition); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -36,8 +36,11 @@ resource "tencentcloud_mps_withdraws_watermark_operation" "operation" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mps "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mps/v20190612"
@@ -46,9 +49,12 @@ import (
 
 func resourceTencentCloudMpsWithdrawsWatermarkOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMpsWithdrawsWatermarkOperationCreate,
-		Read:   resourceTencentCloudMpsWithdrawsWatermarkOperationRead,
-		Delete: resourceTencentCloudMpsWithdrawsWatermarkOperationDelete,
+		CreateContext: resourceTencentCloudMpsWithdrawsWatermarkOperationCreate,
+		ReadContext:   resourceTencentCloudMpsWithdrawsWatermarkOperationRead,
+		DeleteContext: resourceTencentCloudMpsWithdrawsWatermarkOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"input_info": {
 				Required:    true,
@@ -229,7 +235,7 @@ func resourceTencentCloudMpsWithdrawsWatermarkOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMpsWithdrawsWatermarkOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsWithdrawsWatermarkOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_withdraws_watermark_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -333,8 +339,8 @@ func resourceTencentCloudMpsWithdrawsWatermarkOperationCreate(d *schema.Resource
 		request.SessionContext = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().WithdrawsWatermark(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().WithdrawsWatermarkWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -345,23 +351,23 @@ func resourceTencentCloudMpsWithdrawsWatermarkOperationCreate(d *schema.Resource
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mps withdrawsWatermarkOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	taskId = *response.Response.TaskId
 	d.SetId(taskId)
 
-	return resourceTencentCloudMpsWithdrawsWatermarkOperationRead(d, meta)
+	return resourceTencentCloudMpsWithdrawsWatermarkOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsWithdrawsWatermarkOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsWithdrawsWatermarkOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_withdraws_watermark_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudMpsWithdrawsWatermarkOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsWithdrawsWatermarkOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_withdraws_watermark_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
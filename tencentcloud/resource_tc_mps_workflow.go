@@ -108,7 +108,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mps "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mps/v20190612"
@@ -117,12 +119,16 @@ import (
 
 func resourceTencentCloudMpsWorkflow() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMpsWorkflowCreate,
-		Read:   resourceTencentCloudMpsWorkflowRead,
-		Update: resourceTencentCloudMpsWorkflowUpdate,
-		Delete: resourceTencentCloudMpsWorkflowDelete,
+		CreateContext: resourceTencentCloudMpsWorkflowCreate,
+		ReadContext:   resourceTencentCloudMpsWorkflowRead,
+		UpdateContext: resourceTencentCloudMpsWorkflowUpdate,
+		DeleteContext: resourceTencentCloudMpsWorkflowDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"workflow_name": {
@@ -1899,7 +1905,7 @@ func resourceTencentCloudMpsWorkflow() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMpsWorkflowCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_workflow.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -2885,8 +2891,8 @@ func resourceTencentCloudMpsWorkflowCreate(d *schema.ResourceData, meta interfac
 		request.TaskPriority = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreateWorkflow(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().CreateWorkflowWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -2897,22 +2903,22 @@ func resourceTencentCloudMpsWorkflowCreate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mps workflow failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	workflowId = *response.Response.WorkflowId
 	d.SetId(helper.Int64ToStr(workflowId))
 
-	return resourceTencentCloudMpsWorkflowRead(d, meta)
+	return resourceTencentCloudMpsWorkflowRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsWorkflowRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_workflow.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -2920,7 +2926,7 @@ func resourceTencentCloudMpsWorkflowRead(d *schema.ResourceData, meta interface{
 
 	workflow, err := service.DescribeMpsWorkflowById(ctx, workflowId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if workflow == nil {
@@ -4257,7 +4263,7 @@ func resourceTencentCloudMpsWorkflowRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTencentCloudMpsWorkflowUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsWorkflowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_workflow.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -5260,8 +5266,8 @@ func resourceTencentCloudMpsWorkflowUpdate(d *schema.ResourceData, meta interfac
 			request.TaskPriority = helper.IntInt64(v.(int))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ResetWorkflow(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMpsClient().ResetWorkflowWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -5272,25 +5278,25 @@ func resourceTencentCloudMpsWorkflowUpdate(d *schema.ResourceData, meta interfac
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update mps workflow failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMpsWorkflowRead(d, meta)
+	return resourceTencentCloudMpsWorkflowRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMpsWorkflowDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMpsWorkflowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mps_workflow.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MpsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	workflowId := d.Id()
 
 	if err := service.DeleteMpsWorkflowById(ctx, workflowId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
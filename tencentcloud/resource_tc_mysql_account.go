@@ -73,23 +73,29 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudMysqlAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlAccountCreate,
-		Read:   resourceTencentCloudMysqlAccountRead,
-		Update: resourceTencentCloudMysqlAccountUpdate,
-		Delete: resourceTencentCloudMysqlAccountDelete,
+		CreateContext: resourceTencentCloudMysqlAccountCreate,
+		ReadContext:   resourceTencentCloudMysqlAccountRead,
+		UpdateContext: resourceTencentCloudMysqlAccountUpdate,
+		DeleteContext: resourceTencentCloudMysqlAccountDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"mysql_id": {
@@ -134,11 +140,11 @@ func resourceTencentCloudMysqlAccount() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_account.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -153,49 +159,39 @@ func resourceTencentCloudMysqlAccountCreate(d *schema.ResourceData, meta interfa
 
 	asyncRequestId, err := mysqlService.CreateAccount(ctx, mysqlId, accountName, accountHost, accountPassword, accountDescription, maxUserConnections)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s create account %s.%s task  status is %s", mysqlId, accountName, accountHost, taskStatus))
-		}
-		err = fmt.Errorf("%s create account task status is %s,we won't wait for it finish ,it show message:%s", mysqlId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mysql account fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	resourceId := fmt.Sprintf("%s%s%s", mysqlId, FILED_SP, accountName)
+	resourceId := helper.IdFormat(mysqlId, accountName)
 
 	if accountHost != MYSQL_DEFAULT_ACCOUNT_HOST {
-		resourceId += FILED_SP + accountHost
+		resourceId = helper.IdFormat(mysqlId, accountName, accountHost)
 	}
 
 	d.SetId(resourceId)
 
-	return resourceTencentCloudMysqlAccountRead(d, meta)
+	return resourceTencentCloudMysqlAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_account.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items, err := parseMysqlAccountId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	var (
 		mysqlId                      = items[0]
@@ -209,7 +205,7 @@ func resourceTencentCloudMysqlAccountRead(d *schema.ResourceData, meta interface
 	}
 
 	var onlineHas = true
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		allAccounts, e := mysqlService.DescribeAccounts(ctx, mysqlId)
 		if e != nil {
 			if mysqlService.NotFoundMysqlInstance(e) {
@@ -233,7 +229,7 @@ func resourceTencentCloudMysqlAccountRead(d *schema.ResourceData, meta interface
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("Describe mysql acounts fails, reason %s", err.Error())
+		return diag.Errorf("Describe mysql acounts fails, reason %s", err.Error())
 	}
 	if !onlineHas {
 		return nil
@@ -250,15 +246,18 @@ func resourceTencentCloudMysqlAccountRead(d *schema.ResourceData, meta interface
 
 	return nil
 }
-func resourceTencentCloudMysqlAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_account.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items, err := parseMysqlAccountId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	var (
 		mysqlId     = items[0]
@@ -276,27 +275,14 @@ func resourceTencentCloudMysqlAccountUpdate(d *schema.ResourceData, meta interfa
 
 		asyncRequestId, err := mysqlService.ModifyAccountDescription(ctx, mysqlId, accountName, accountHost, d.Get("description").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("%s modify account  description %s.%s task  status is %s", mysqlId, accountName, accountHost, taskStatus))
-			}
-			err = fmt.Errorf("modify mysql account description task status is %s,we won't wait for it finish ,it show message:%s", taskStatus, message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify mysql account description fail, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -305,28 +291,14 @@ func resourceTencentCloudMysqlAccountUpdate(d *schema.ResourceData, meta interfa
 
 		asyncRequestId, err := mysqlService.ModifyAccountPassword(ctx, mysqlId, accountName, accountHost, d.Get("password").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("%s modify mysql account password %s.%s task  status is %s", mysqlId, accountName, accountHost, taskStatus))
-			}
-			err = fmt.Errorf("modify mysql account password task status is %s,we won't wait for it finish ,it show message:%s", taskStatus,
-				message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify mysql account password fail, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -338,27 +310,14 @@ func resourceTencentCloudMysqlAccountUpdate(d *schema.ResourceData, meta interfa
 		}
 		asyncRequestId, err := mysqlService.ModifyAccountMaxUserConnections(ctx, mysqlId, accountName, accountHost, maxUserConnections)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("%s modify mysql account maxUserConnections %s task  status is %s", mysqlId, accountName, taskStatus))
-			}
-			err = fmt.Errorf("modify mysql account maxUserConnections task status is %s,we won't wait for it finish ,it show message:%s", taskStatus, message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify mysql account maxUserConnections fail, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -367,33 +326,20 @@ func resourceTencentCloudMysqlAccountUpdate(d *schema.ResourceData, meta interfa
 		oldHost, newHost := d.GetChange("host")
 		asyncRequestId, err := mysqlService.ModifyAccountHost(ctx, mysqlId, accountName, oldHost.(string), newHost.(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("%s modify account  host %s.%s task  status is %s", mysqlId, accountName, accountHost, taskStatus))
-			}
-			err = fmt.Errorf("modify mysql account host task status is %s,we won't wait for it finish ,it show message:%s", taskStatus, message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify mysql account host fail, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
-		resourceId := fmt.Sprintf("%s%s%s", mysqlId, FILED_SP, accountName)
+		resourceId := helper.IdFormat(mysqlId, accountName)
 
 		if newHost.(string) != MYSQL_DEFAULT_ACCOUNT_HOST {
-			resourceId += FILED_SP + newHost.(string)
+			resourceId = helper.IdFormat(mysqlId, accountName, newHost.(string))
 		}
 
 		d.SetId(resourceId)
@@ -404,15 +350,18 @@ func resourceTencentCloudMysqlAccountUpdate(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudMysqlAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_account.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items, err := parseMysqlAccountId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	var (
 		mysqlId     = items[0]
@@ -425,30 +374,25 @@ func resourceTencentCloudMysqlAccountDelete(d *schema.ResourceData, meta interfa
 
 	asyncRequestId, err := mysqlService.DeleteAccount(ctx, mysqlId, accountName, accountHost)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s delete mysql account %s.%s task  status is %s", mysqlId, accountName, accountHost, taskStatus))
-		}
-		err = fmt.Errorf("delete mysql account  task status is %s,we won't wait for it finish ,it show message:%s", taskStatus,
-			message)
-		return resource.NonRetryableError(err)
-	})
+	err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
 
 	return nil
 }
+
+// parseMysqlAccountId returns the parts of the id `mysql_id#account_name[#account_host]`, the host
+// is omitted by the legacy IDs of the accounts on the default host.
+func parseMysqlAccountId(id string) ([]string, error) {
+	if items := helper.IdParse(id); len(items) == 3 {
+		return items, nil
+	}
+	return helper.IdParseN(id, 2)
+}
@@ -69,17 +69,26 @@ resource "tencentcloud_mysql_account_privilege" "default" {
   database_names = ["dbname1", "dbname2"]
 }
 ```
+
+Import
+
+MySQL account privilege can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_mysql_account_privilege.default '{"MysqlId":"cdb-switpmff","AccountName":"test","AccountHost":"%"}'
+```
 */
 package tencentcloud
 
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -97,10 +106,17 @@ func resourceTencentCloudMysqlAccountPrivilege() *schema.Resource {
 
 	return &schema.Resource{
 		DeprecationMessage: "This resource has been deprecated in Terraform TencentCloud provider version 1.26.0. Please use 'tencentcloud_mysql_privilege' instead.",
-		Create:             resourceTencentCloudMysqlAccountPrivilegeCreate,
-		Read:               resourceTencentCloudMysqlAccountPrivilegeRead,
-		Update:             resourceTencentCloudMysqlAccountPrivilegeUpdate,
-		Delete:             resourceTencentCloudMysqlAccountPrivilegeDelete,
+		CreateContext:      resourceTencentCloudMysqlAccountPrivilegeCreate,
+		ReadContext:        resourceTencentCloudMysqlAccountPrivilegeRead,
+		UpdateContext:      resourceTencentCloudMysqlAccountPrivilegeUpdate,
+		DeleteContext:      resourceTencentCloudMysqlAccountPrivilegeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"mysql_id": {
@@ -145,7 +161,7 @@ func resourceTencentCloudMysqlAccountPrivilege() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlAccountPrivilegeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlAccountPrivilegeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_account_privilege.create")()
 
 	var (
@@ -164,22 +180,22 @@ func resourceTencentCloudMysqlAccountPrivilegeCreate(d *schema.ResourceData, met
 
 	d.SetId(string(privilegeIdStr))
 
-	return resourceTencentCloudMysqlAccountPrivilegeUpdate(d, meta)
+	return resourceTencentCloudMysqlAccountPrivilegeUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlAccountPrivilegeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlAccountPrivilegeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_account_privilege.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var privilegeId resourceTencentCloudMysqlAccountPrivilegeId
 
 	if err := json.Unmarshal([]byte(d.Id()), &privilegeId); err != nil {
 		err = fmt.Errorf("Local data[terraform.tfstate] corruption,can not got old account privilege id")
 		log.Printf("[CRITAL]%s %s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if privilegeId.AccountHost == "" {
@@ -191,7 +207,7 @@ func resourceTencentCloudMysqlAccountPrivilegeRead(d *schema.ResourceData, meta
 	//check if the account is delete
 	var accountInfo *cdb.AccountInfo = nil
 	var onlineHas = true
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		accountInfos, e := mysqlService.DescribeAccounts(ctx, privilegeId.MysqlId)
 		if e != nil {
 			if mysqlService.NotFoundMysqlInstance(e) {
@@ -215,7 +231,7 @@ func resourceTencentCloudMysqlAccountPrivilegeRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("Describe mysql acounts fails, reaseon %s", err.Error())
+		return diag.Errorf("Describe mysql acounts fails, reaseon %s", err.Error())
 	}
 	if !onlineHas {
 		return nil
@@ -226,7 +242,7 @@ func resourceTencentCloudMysqlAccountPrivilegeRead(d *schema.ResourceData, meta
 		dbNames = append(dbNames, v.(string))
 	}
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		privileges, e := mysqlService.DescribeAccountPrivileges(ctx,
 			privilegeId.MysqlId,
 			privilegeId.AccountName,
@@ -275,7 +291,7 @@ func resourceTencentCloudMysqlAccountPrivilegeRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("Describe mysql acounts privilege fails, reaseon %s", err.Error())
+		return diag.Errorf("Describe mysql acounts privilege fails, reaseon %s", err.Error())
 	}
 	_ = d.Set("account_name", privilegeId.AccountName)
 	_ = d.Set("account_host", privilegeId.AccountHost)
@@ -283,11 +299,11 @@ func resourceTencentCloudMysqlAccountPrivilegeRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudMysqlAccountPrivilegeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlAccountPrivilegeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_account_privilege.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -296,7 +312,7 @@ func resourceTencentCloudMysqlAccountPrivilegeUpdate(d *schema.ResourceData, met
 	if err := json.Unmarshal([]byte(d.Id()), &privilegeId); err != nil {
 		err = fmt.Errorf("Local data[terraform.tfstate] corruption,can not got old account privilege id")
 		log.Printf("[CRITAL]%s %s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if privilegeId.AccountHost == "" {
@@ -328,44 +344,28 @@ func resourceTencentCloudMysqlAccountPrivilegeUpdate(d *schema.ResourceData, met
 			upperPrivileges)
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("modify account privilege   task  status is %s", taskStatus))
-			}
-			if taskStatus == MYSQL_TASK_STATUS_FAILED || taskStatus == MYSQL_TASK_STATUS_REMOVED {
-				return resource.NonRetryableError(errors.New("sdk ModifyAccountPrivileges task running fail," + message))
-			}
-			err = fmt.Errorf("modify account privilege task status is %s,we won't wait for it finish ,it show message:%s\n", taskStatus, message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify account privilege fail, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 		d.Partial(false)
 
 	}
 
-	return resourceTencentCloudMysqlAccountPrivilegeRead(d, meta)
+	return resourceTencentCloudMysqlAccountPrivilegeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlAccountPrivilegeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlAccountPrivilegeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_account_privilege.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -374,7 +374,7 @@ func resourceTencentCloudMysqlAccountPrivilegeDelete(d *schema.ResourceData, met
 	if err := json.Unmarshal([]byte(d.Id()), &privilegeId); err != nil {
 		err = fmt.Errorf("Local data[terraform.tfstate] corruption,can not got old account privilege id")
 		log.Printf("[CRITAL]%s %s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if privilegeId.AccountHost == "" {
@@ -396,26 +396,13 @@ func resourceTencentCloudMysqlAccountPrivilegeDelete(d *schema.ResourceData, met
 		upperPrivileges)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("delete account privilege   task  status is %s", taskStatus))
-		}
-		err = fmt.Errorf("delete account privilege  task status is %s,we won't wait for it finish ,it show message:%s", taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s delete account privilege fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
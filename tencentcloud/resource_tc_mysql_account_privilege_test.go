@@ -47,6 +47,12 @@ func TestAccTencentCloudMysqlAccountPrivilegeResource(t *testing.T) {
 					resource.TestCheckTypeSetElemAttr("tencentcloud_mysql_account_privilege.mysql_account_privilege", "privileges.*", "TRIGGER"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_mysql_account_privilege.mysql_account_privilege",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"database_names"},
+			},
 		},
 	})
 }
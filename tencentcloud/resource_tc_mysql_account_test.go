@@ -2,6 +2,7 @@ package tencentcloud
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -77,6 +78,29 @@ func init() {
 	})
 }
 
+func TestParseMysqlAccountId(t *testing.T) {
+	for id, expected := range map[string][]string{
+		"cdb-1#test":            {"cdb-1", "test", ""},
+		"cdb-1#test#10.0.0.%25": {"cdb-1", "test", "10.0.0.%"},
+	} {
+		items, err := parseMysqlAccountId(id)
+		if err != nil {
+			t.Fatalf("parse %s: %v", id, err)
+		}
+		if items[0] != expected[0] || items[1] != expected[1] || (len(items) == 3 && items[2] != expected[2]) {
+			t.Errorf("parse %s: unexpected %q", id, items)
+		}
+	}
+
+	for _, id := range []string{"cdb-1", "cdb-1#test#%#x"} {
+		_, err := parseMysqlAccountId(id)
+		var countErr *helper.IdPartsCountError
+		if !errors.As(err, &countErr) {
+			t.Errorf("expect IdPartsCountError for the broken id %s, got %v", id, err)
+		}
+	}
+}
+
 // go test -i; go test -test.run TestAccTencentCloudMysqlAccountResource_basic -v
 func TestAccTencentCloudMysqlAccountResource_basic(t *testing.T) {
 	t.Parallel()
@@ -128,7 +152,7 @@ func testAccCheckMysqlAccountExists(r string) resource.TestCheckFunc {
 		}
 
 		mysqlService := MysqlService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
-		split := strings.Split(rs.Primary.ID, FILED_SP)
+		split := helper.IdParse(rs.Primary.ID)
 		if len(split) < 2 {
 			return fmt.Errorf("mysql account is not set")
 		}
@@ -169,7 +193,7 @@ func testAccCheckMysqlAccountDestroy(s *terraform.State) error {
 			continue
 		}
 
-		split := strings.Split(r.Primary.ID, FILED_SP)
+		split := helper.IdParse(r.Primary.ID)
 		if len(split) < 2 {
 			continue
 		}
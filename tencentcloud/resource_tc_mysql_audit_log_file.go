@@ -78,16 +78,22 @@ resource "tencentcloud_mysql_audit_log_file" "example" {
 }
 ```
 
+Import
+
+mysql audit_log_file can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_mysql_audit_log_file.example cdb-fitq5t9h#cdb-fitq5t9h_audit.log
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -96,9 +102,15 @@ import (
 
 func resourceTencentCloudMysqlAuditLogFile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlAuditLogFileCreate,
-		Read:   resourceTencentCloudMysqlAuditLogFileRead,
-		Delete: resourceTencentCloudMysqlAuditLogFileDelete,
+		CreateContext: resourceTencentCloudMysqlAuditLogFileCreate,
+		ReadContext:   resourceTencentCloudMysqlAuditLogFileRead,
+		DeleteContext: resourceTencentCloudMysqlAuditLogFileDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -238,7 +250,7 @@ func resourceTencentCloudMysqlAuditLogFile() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlAuditLogFileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlAuditLogFileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_audit_log_file.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -337,8 +349,8 @@ func resourceTencentCloudMysqlAuditLogFileCreate(d *schema.ResourceData, meta in
 		request.Filter = &auditLogFilter
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateAuditLogFile(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateAuditLogFileWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -349,49 +361,49 @@ func resourceTencentCloudMysqlAuditLogFileCreate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mysql auditLogFile failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	fileName = *response.Response.FileName
-	d.SetId(instanceId + FILED_SP + fileName)
+	d.SetId(helper.IdFormat(instanceId, fileName))
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	conf := BuildStateChangeConf(
 		[]string{"creating"},
 		[]string{"success", "failed"},
-		1*readRetryTimeout,
+		d.Timeout(schema.TimeoutCreate),
 		time.Second,
 		service.MysqlAuditLogFileStateRefreshFunc(instanceId, fileName, []string{}),
 	)
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudMysqlAuditLogFileRead(d, meta)
+	return resourceTencentCloudMysqlAuditLogFileRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlAuditLogFileRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlAuditLogFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_audit_log_file.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	fileName := idSplit[1]
 
 	auditLogFile, err := service.DescribeMysqlAuditLogFileById(ctx, instanceId, fileName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if auditLogFile == nil {
@@ -411,23 +423,23 @@ func resourceTencentCloudMysqlAuditLogFileRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudMysqlAuditLogFileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlAuditLogFileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_audit_log_file.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	fileName := idSplit[1]
 
 	if err := service.DeleteMysqlAuditLogFileById(ctx, instanceId, fileName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
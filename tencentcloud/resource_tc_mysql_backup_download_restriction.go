@@ -30,7 +30,9 @@ import (
 	"context"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -40,12 +42,15 @@ import (
 
 func resourceTencentCloudMysqlBackupDownloadRestriction() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlBackupDownloadRestrictionCreate,
-		Read:   resourceTencentCloudMysqlBackupDownloadRestrictionRead,
-		Update: resourceTencentCloudMysqlBackupDownloadRestrictionUpdate,
-		Delete: resourceTencentCloudMysqlBackupDownloadRestrictionDelete,
+		CreateContext: resourceTencentCloudMysqlBackupDownloadRestrictionCreate,
+		ReadContext:   resourceTencentCloudMysqlBackupDownloadRestrictionRead,
+		UpdateContext: resourceTencentCloudMysqlBackupDownloadRestrictionUpdate,
+		DeleteContext: resourceTencentCloudMysqlBackupDownloadRestrictionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"limit_type": {
@@ -101,28 +106,28 @@ func resourceTencentCloudMysqlBackupDownloadRestriction() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlBackupDownloadRestrictionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlBackupDownloadRestrictionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_backup_download_restriction.create")()
 	defer inconsistentCheck(d, meta)()
 
 	d.SetId("BackupDownloadRestriction")
 
-	return resourceTencentCloudMysqlBackupDownloadRestrictionUpdate(d, meta)
+	return resourceTencentCloudMysqlBackupDownloadRestrictionUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlBackupDownloadRestrictionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlBackupDownloadRestrictionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_backup_download_restriction.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	backupDownloadRestriction, err := service.DescribeMysqlBackupDownloadRestrictionById(ctx)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if backupDownloadRestriction == nil {
@@ -170,7 +175,7 @@ func resourceTencentCloudMysqlBackupDownloadRestrictionRead(d *schema.ResourceDa
 	return nil
 }
 
-func resourceTencentCloudMysqlBackupDownloadRestrictionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlBackupDownloadRestrictionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_backup_download_restriction.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -218,8 +223,8 @@ func resourceTencentCloudMysqlBackupDownloadRestrictionUpdate(d *schema.Resource
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyBackupDownloadRestriction(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyBackupDownloadRestrictionWithContext(ctx, request)
 		if e != nil {
 			if sdkerr, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 				if strings.Contains(sdkerr.Code, "FailedOperation") {
@@ -234,13 +239,13 @@ func resourceTencentCloudMysqlBackupDownloadRestrictionUpdate(d *schema.Resource
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update mysql backupDownloadRestriction failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMysqlBackupDownloadRestrictionRead(d, meta)
+	return resourceTencentCloudMysqlBackupDownloadRestrictionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlBackupDownloadRestrictionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlBackupDownloadRestrictionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_backup_download_restriction.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -82,7 +82,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -91,12 +93,15 @@ import (
 
 func resourceTencentCloudMysqlBackupEncryptionStatus() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlBackupEncryptionStatusCreate,
-		Read:   resourceTencentCloudMysqlBackupEncryptionStatusRead,
-		Update: resourceTencentCloudMysqlBackupEncryptionStatusUpdate,
-		Delete: resourceTencentCloudMysqlBackupEncryptionStatusDelete,
+		CreateContext: resourceTencentCloudMysqlBackupEncryptionStatusCreate,
+		ReadContext:   resourceTencentCloudMysqlBackupEncryptionStatusRead,
+		UpdateContext: resourceTencentCloudMysqlBackupEncryptionStatusUpdate,
+		DeleteContext: resourceTencentCloudMysqlBackupEncryptionStatusDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -114,7 +119,7 @@ func resourceTencentCloudMysqlBackupEncryptionStatus() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlBackupEncryptionStatusCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlBackupEncryptionStatusCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_backup_encryption_status.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -125,15 +130,15 @@ func resourceTencentCloudMysqlBackupEncryptionStatusCreate(d *schema.ResourceDat
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudMysqlBackupEncryptionStatusUpdate(d, meta)
+	return resourceTencentCloudMysqlBackupEncryptionStatusUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlBackupEncryptionStatusRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlBackupEncryptionStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_backup_encryption_status.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -141,7 +146,7 @@ func resourceTencentCloudMysqlBackupEncryptionStatusRead(d *schema.ResourceData,
 
 	backupEncryptionStatus, err := service.DescribeMysqlBackupEncryptionStatusById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if backupEncryptionStatus == nil {
@@ -159,7 +164,7 @@ func resourceTencentCloudMysqlBackupEncryptionStatusRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudMysqlBackupEncryptionStatusUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlBackupEncryptionStatusUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_backup_encryption_status.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -174,8 +179,8 @@ func resourceTencentCloudMysqlBackupEncryptionStatusUpdate(d *schema.ResourceDat
 		request.EncryptionStatus = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyBackupEncryptionStatus(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyBackupEncryptionStatusWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -185,13 +190,13 @@ func resourceTencentCloudMysqlBackupEncryptionStatusUpdate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update mysql backupEncryptionStatus failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMysqlBackupEncryptionStatusRead(d, meta)
+	return resourceTencentCloudMysqlBackupEncryptionStatusRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlBackupEncryptionStatusDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlBackupEncryptionStatusDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_backup_encryption_status.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -61,6 +61,14 @@ resource "tencentcloud_mysql_backup_policy" "example" {
   backup_time      = "01:00-05:00"
 }
 ```
+
+Import
+
+MySQL backup policy can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_mysql_backup_policy.example cdb-switpmff
+```
 */
 package tencentcloud
 
@@ -69,16 +77,20 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTencentCloudMysqlBackupPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlBackupPolicyCreate,
-		Read:   resourceTencentCloudMysqlBackupPolicyRead,
-		Update: resourceTencentCloudMysqlBackupPolicyUpdate,
-		Delete: resourceTencentCloudMysqlBackupPolicyDelete,
+		CreateContext: resourceTencentCloudMysqlBackupPolicyCreate,
+		ReadContext:   resourceTencentCloudMysqlBackupPolicyRead,
+		UpdateContext: resourceTencentCloudMysqlBackupPolicyUpdate,
+		DeleteContext: resourceTencentCloudMysqlBackupPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"mysql_id": {
@@ -119,22 +131,22 @@ func resourceTencentCloudMysqlBackupPolicy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlBackupPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlBackupPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_backup_policy.create")()
 
 	d.SetId(d.Get("mysql_id").(string))
 
-	return resourceTencentCloudMysqlBackupPolicyUpdate(d, meta)
+	return resourceTencentCloudMysqlBackupPolicyUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlBackupPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlBackupPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_backup_policy.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		desResponse, e := mysqlService.DescribeBackupConfigByMysqlId(ctx, d.Id())
 		if e != nil {
 			if mysqlService.NotFoundMysqlInstance(e) {
@@ -162,16 +174,16 @@ func resourceTencentCloudMysqlBackupPolicyRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("[API]Describe mysql backup policy fail,reason:%s", err.Error())
+		return diag.Errorf("[API]Describe mysql backup policy fail,reason:%s", err.Error())
 	}
 	return nil
 }
 
-func resourceTencentCloudMysqlBackupPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlBackupPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_backup_policy.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -186,7 +198,7 @@ func resourceTencentCloudMysqlBackupPolicyUpdate(d *schema.ResourceData, meta in
 
 	if d.HasChange("retention_period") || d.HasChange("backup_model") || d.HasChange("backup_time") {
 		if backupModel != "physical" {
-			return fmt.Errorf("`backup_model` only support 'physical'")
+			return diag.Errorf("`backup_model` only support 'physical'")
 		}
 		isUpdate = true
 	}
@@ -194,18 +206,18 @@ func resourceTencentCloudMysqlBackupPolicyUpdate(d *schema.ResourceData, meta in
 	if isUpdate {
 		err := mysqlService.ModifyBackupConfigByMysqlId(ctx, mysqlId, retentionPeriod, backupModel, backupTime)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudMysqlBackupPolicyRead(d, meta)
+	return resourceTencentCloudMysqlBackupPolicyRead(ctx, d, meta)
 }
 
 //set all config to default
-func resourceTencentCloudMysqlBackupPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlBackupPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_backup_policy.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -216,7 +228,7 @@ func resourceTencentCloudMysqlBackupPolicyDelete(d *schema.ResourceData, meta in
 	)
 	err := mysqlService.ModifyBackupConfigByMysqlId(ctx, d.Id(), retentionPeriod, backupModel, backupTime)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
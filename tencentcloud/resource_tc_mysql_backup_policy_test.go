@@ -38,6 +38,11 @@ func TestAccTencentCloudMysqlBackupPolicyResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_mysql_backup_policy.mysql_backup_policy", "binlog_period"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_mysql_backup_policy.mysql_backup_policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -75,10 +75,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -87,9 +87,12 @@ import (
 
 func resourceTencentCloudMysqlDbImportJobOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlDbImportJobOperationCreate,
-		Read:   resourceTencentCloudMysqlDbImportJobOperationRead,
-		Delete: resourceTencentCloudMysqlDbImportJobOperationDelete,
+		CreateContext: resourceTencentCloudMysqlDbImportJobOperationCreate,
+		ReadContext:   resourceTencentCloudMysqlDbImportJobOperationRead,
+		DeleteContext: resourceTencentCloudMysqlDbImportJobOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -143,12 +146,12 @@ func resourceTencentCloudMysqlDbImportJobOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlDbImportJobOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlDbImportJobOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_db_import_job_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request    = mysql.NewCreateDBImportJobRequest()
@@ -180,8 +183,8 @@ func resourceTencentCloudMysqlDbImportJobOperationCreate(d *schema.ResourceData,
 		request.CosUrl = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateDBImportJob(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateDBImportJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -192,55 +195,42 @@ func resourceTencentCloudMysqlDbImportJobOperationCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mysql dbImportJob failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s create dbImportJob  status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s create dbImportJob status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = service.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create dbImportJob fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(instanceId + FILED_SP + asyncRequestId)
+	d.SetId(helper.IdFormat(instanceId, asyncRequestId))
 
-	return resourceTencentCloudMysqlDbImportJobOperationRead(d, meta)
+	return resourceTencentCloudMysqlDbImportJobOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlDbImportJobOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlDbImportJobOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_db_import_job_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	asyncRequestId := idSplit[1]
 
 	dbImportJob, err := service.DescribeMysqlDbImportJobById(ctx, instanceId, asyncRequestId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if dbImportJob == nil {
@@ -268,7 +258,7 @@ func resourceTencentCloudMysqlDbImportJobOperationRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceTencentCloudMysqlDbImportJobOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlDbImportJobOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_db_import_job_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
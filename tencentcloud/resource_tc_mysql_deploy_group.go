@@ -24,9 +24,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -35,12 +36,16 @@ import (
 
 func resourceTencentCloudMysqlDeployGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlDeployGroupCreate,
-		Read:   resourceTencentCloudMysqlDeployGroupRead,
-		Update: resourceTencentCloudMysqlDeployGroupUpdate,
-		Delete: resourceTencentCloudMysqlDeployGroupDelete,
+		CreateContext: resourceTencentCloudMysqlDeployGroupCreate,
+		ReadContext:   resourceTencentCloudMysqlDeployGroupRead,
+		UpdateContext: resourceTencentCloudMysqlDeployGroupUpdate,
+		DeleteContext: resourceTencentCloudMysqlDeployGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"deploy_group_name": {
@@ -73,7 +78,7 @@ func resourceTencentCloudMysqlDeployGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlDeployGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlDeployGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_deploy_group.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -104,8 +109,8 @@ func resourceTencentCloudMysqlDeployGroupCreate(d *schema.ResourceData, meta int
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateDeployGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateDeployGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -116,22 +121,22 @@ func resourceTencentCloudMysqlDeployGroupCreate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mysql deployGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	deployGroupId = *response.Response.DeployGroupId
 	d.SetId(deployGroupId)
 
-	return resourceTencentCloudMysqlDeployGroupRead(d, meta)
+	return resourceTencentCloudMysqlDeployGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlDeployGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlDeployGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_deploy_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -139,7 +144,7 @@ func resourceTencentCloudMysqlDeployGroupRead(d *schema.ResourceData, meta inter
 
 	deployGroup, err := service.DescribeMysqlDeployGroupById(ctx, deployGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if deployGroup == nil {
@@ -167,7 +172,7 @@ func resourceTencentCloudMysqlDeployGroupRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudMysqlDeployGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlDeployGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_deploy_group.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -183,7 +188,7 @@ func resourceTencentCloudMysqlDeployGroupUpdate(d *schema.ResourceData, meta int
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -199,8 +204,8 @@ func resourceTencentCloudMysqlDeployGroupUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyNameOrDescByDpId(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyNameOrDescByDpIdWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -210,24 +215,24 @@ func resourceTencentCloudMysqlDeployGroupUpdate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update mysql deployGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMysqlDeployGroupRead(d, meta)
+	return resourceTencentCloudMysqlDeployGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlDeployGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlDeployGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_deploy_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	deployGroupId := d.Id()
 
 	if err := service.DeleteMysqlDeployGroupById(ctx, deployGroupId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
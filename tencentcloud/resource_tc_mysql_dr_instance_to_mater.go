@@ -69,9 +69,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -79,12 +80,15 @@ import (
 
 func resourceTencentCloudMysqlDrInstanceToMater() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlDrInstanceToMaterCreate,
-		Read:   resourceTencentCloudMysqlDrInstanceToMaterRead,
-		Update: resourceTencentCloudMysqlDrInstanceToMaterUpdate,
-		Delete: resourceTencentCloudMysqlDrInstanceToMaterDelete,
+		CreateContext: resourceTencentCloudMysqlDrInstanceToMaterCreate,
+		ReadContext:   resourceTencentCloudMysqlDrInstanceToMaterRead,
+		UpdateContext: resourceTencentCloudMysqlDrInstanceToMaterUpdate,
+		DeleteContext: resourceTencentCloudMysqlDrInstanceToMaterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -96,7 +100,7 @@ func resourceTencentCloudMysqlDrInstanceToMater() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlDrInstanceToMaterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlDrInstanceToMaterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_dr_instance_to_mater.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -107,16 +111,16 @@ func resourceTencentCloudMysqlDrInstanceToMaterCreate(d *schema.ResourceData, me
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudMysqlDrInstanceToMaterUpdate(d, meta)
+	return resourceTencentCloudMysqlDrInstanceToMaterUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlDrInstanceToMaterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlDrInstanceToMaterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_dr_instance_to_mater.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -124,7 +128,7 @@ func resourceTencentCloudMysqlDrInstanceToMaterRead(d *schema.ResourceData, meta
 
 	drInstanceToMater, err := service.DescribeDBInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if drInstanceToMater == nil {
@@ -140,12 +144,12 @@ func resourceTencentCloudMysqlDrInstanceToMaterRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudMysqlDrInstanceToMaterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlDrInstanceToMaterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_dr_instance_to_mater.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := mysql.NewSwitchDrInstanceToMasterRequest()
 	response := mysql.NewSwitchDrInstanceToMasterResponse()
@@ -153,8 +157,8 @@ func resourceTencentCloudMysqlDrInstanceToMaterUpdate(d *schema.ResourceData, me
 
 	request.InstanceId = &instanceId
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().SwitchDrInstanceToMaster(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().SwitchDrInstanceToMasterWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -165,35 +169,22 @@ func resourceTencentCloudMysqlDrInstanceToMaterUpdate(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update mysql drInstanceToMater failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s DrInstanceToMaster status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s update mysql drInstanceToMater status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = service.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update mysql drInstanceToMater fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMysqlDrInstanceToMaterRead(d, meta)
+	return resourceTencentCloudMysqlDrInstanceToMaterRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlDrInstanceToMaterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlDrInstanceToMaterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_dr_instance_to_mater.delete")()
 	defer inconsistentCheck(d, meta)()
 
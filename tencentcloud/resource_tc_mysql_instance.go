@@ -137,8 +137,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -417,10 +419,18 @@ func resourceTencentCloudMysqlInstance() *schema.Resource {
 		specialInfo[k] = v
 	}
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlInstanceCreate,
-		Read:   resourceTencentCloudMysqlInstanceRead,
-		Update: resourceTencentCloudMysqlInstanceUpdate,
-		Delete: resourceTencentCloudMysqlInstanceDelete,
+		CreateContext: resourceTencentCloudMysqlInstanceCreate,
+		ReadContext:   resourceTencentCloudMysqlInstanceRead,
+		UpdateContext: resourceTencentCloudMysqlInstanceUpdate,
+		DeleteContext: resourceTencentCloudMysqlInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		CustomizeDiff: helper.ComposeCustomizeDiff(
+			helper.Immutable("param_template_id", "prepaid_period"),
+			resourceTencentCloudMysqlInstanceSellConfigCustomizeDiff,
+		),
 		Schema: specialInfo,
 		Importer: &schema.ResourceImporter{
 			State: helper.ImportWithDefaultValue(map[string]interface{}{
@@ -440,7 +450,7 @@ func resourceTencentCloudMysqlInstance() *schema.Resource {
 }
 
 /*
-   [master] and [dr] and [ro] all need set
+[master] and [dr] and [ro] all need set
 */
 func mysqlAllInstanceRoleSet(ctx context.Context, requestInter interface{}, d *schema.ResourceData, meta interface{}) error {
 	requestByMonth, okByMonth := requestInter.(*cdb.CreateDBInstanceRequest)
@@ -560,7 +570,7 @@ func mysqlAllInstanceRoleSet(ctx context.Context, requestInter interface{}, d *s
 }
 
 /*
- [master] need set
+[master] need set
 */
 func mysqlMasterInstanceRoleSet(ctx context.Context, requestInter interface{}, d *schema.ResourceData, meta interface{}) error {
 	requestByMonth, okByMonth := requestInter.(*cdb.CreateDBInstanceRequest)
@@ -675,9 +685,9 @@ func mysqlCreateInstancePayByMonth(ctx context.Context, d *schema.ResourceData,
 	}
 
 	var response *cdb.CreateDBInstanceResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		// shadowed response will not pass to outside
-		r, inErr := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateDBInstance(request)
+		r, inErr := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateDBInstanceWithContext(ctx, request)
 		if inErr != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), inErr.Error())
@@ -721,9 +731,9 @@ func mysqlCreateInstancePayByUse(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	var response *cdb.CreateDBInstanceHourResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		// shadowed response will not pass to outside
-		r, inErr := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateDBInstanceHour(request)
+		r, inErr := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateDBInstanceHourWithContext(ctx, request)
 		if inErr != nil {
 			return retryError(inErr)
 		}
@@ -753,11 +763,11 @@ func mysqlCreateInstancePayByUse(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudMysqlInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_instance.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -766,15 +776,15 @@ func resourceTencentCloudMysqlInstanceCreate(d *schema.ResourceData, meta interf
 	if payType == MysqlPayByMonth {
 		err := mysqlCreateInstancePayByMonth(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if payType == MysqlPayByUse {
 		err := mysqlCreateInstancePayByUse(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		return fmt.Errorf("mysql not support this pay type yet.")
+		return diag.Errorf("mysql not support this pay type yet.")
 	}
 
 	mysqlID := d.Id()
@@ -784,11 +794,11 @@ func resourceTencentCloudMysqlInstanceCreate(d *schema.ResourceData, meta interf
 		tagService := &TagService{client: tcClient}
 		resourceName := BuildTagResourceName("cdb", "instanceId", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	err := resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		mysqlInfo, err := mysqlService.DescribeDBInstanceById(ctx, mysqlID)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -809,7 +819,7 @@ func resourceTencentCloudMysqlInstanceCreate(d *schema.ResourceData, meta interf
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mysql  task fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	//internet service
@@ -817,35 +827,17 @@ func resourceTencentCloudMysqlInstanceCreate(d *schema.ResourceData, meta interf
 	if internetService == 1 {
 		asyncRequestId, err := mysqlService.OpenWanService(ctx, d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("create account task  status is %s", taskStatus))
-			}
-			err = fmt.Errorf("open internet service task status is %s,we won't wait for it finish ,it show message:%s", ",",
-				message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutCreate))
 
 		if err != nil {
 			log.Printf("[CRITAL]%s open internet service   fail, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMysqlInstanceRead(d, meta)
+	return resourceTencentCloudMysqlInstanceRead(ctx, d, meta)
 }
 
 func tencentMsyqlBasicInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}, master bool) (mysqlInfo *cdb.InstanceInfo,
@@ -945,17 +937,17 @@ func tencentMsyqlBasicInfoRead(ctx context.Context, d *schema.ResourceData, meta
 	return
 }
 
-func resourceTencentCloudMysqlInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var mysqlInfo *cdb.InstanceInfo
 	var e error
 	var onlineHas = true
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		mysqlInfo, e = tencentMsyqlBasicInfoRead(ctx, d, meta, true)
 		if e != nil {
 			if mysqlService.NotFoundMysqlInstance(e) {
@@ -984,7 +976,7 @@ func resourceTencentCloudMysqlInstanceRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("Fail to get basic info from mysql, reaseon %s", err.Error())
+		return diag.Errorf("Fail to get basic info from mysql, reaseon %s", err.Error())
 	}
 	if !onlineHas {
 		return nil
@@ -998,7 +990,7 @@ func resourceTencentCloudMysqlInstanceRead(d *schema.ResourceData, meta interfac
 			cares = append(cares, k)
 		}
 
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			caresParameters, e := mysqlService.DescribeCaresParameters(ctx, d.Id(), cares)
 			if e != nil {
 				if mysqlService.NotFoundMysqlInstance(e) {
@@ -1016,13 +1008,13 @@ func resourceTencentCloudMysqlInstanceRead(d *schema.ResourceData, meta interfac
 			return nil
 		})
 		if err != nil {
-			return fmt.Errorf("Describe CaresParameters Fail, reason:%s", err.Error())
+			return diag.Errorf("Describe CaresParameters Fail, reason:%s", err.Error())
 		}
 		if !onlineHas {
 			return nil
 		}
 	}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		backConfig, e := mysqlService.DescribeDBInstanceConfig(ctx, d.Id())
 		if e != nil {
 			if mysqlService.NotFoundMysqlInstance(e) {
@@ -1043,13 +1035,13 @@ func resourceTencentCloudMysqlInstanceRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("Describe DBInstanceConfig Fail, reason:%s", err.Error())
+		return diag.Errorf("Describe DBInstanceConfig Fail, reason:%s", err.Error())
 	}
 	return nil
 }
 
 /*
-   [master] and [dr] and [ro] all need update
+[master] and [dr] and [ro] all need update
 */
 func mysqlAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
@@ -1121,27 +1113,7 @@ func mysqlAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, met
 			return err
 		}
 
-		err = resource.Retry(6*time.Hour, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-
-			if err != nil {
-				if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("update mysql  mem_size/volume_size status is %s", taskStatus))
-			}
-			err = fmt.Errorf("update mysql  mem_size/volume_size task status is %s,we won't wait for it finish ,it show message:%s",
-				",", message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, 6*time.Hour)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update mysql  mem_size/volume_size  fail, reason:%s\n ", logId, err.Error())
@@ -1199,27 +1171,7 @@ func mysqlAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, met
 			return err
 		}
 
-		err = resource.Retry(6*time.Hour, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-
-			if err != nil {
-				if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("update mysql engineVersion status is %s", taskStatus))
-			}
-			err = fmt.Errorf("update mysql engineVersion task status is %s,we won't wait for it finish ,it show message:%s",
-				",", message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, 6*time.Hour)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update mysql engineVersion fail, reason:%s\n ", logId, err.Error())
@@ -1250,7 +1202,7 @@ func mysqlAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, met
 }
 
 /*
- [master] need set
+[master] need set
 */
 func mysqlMasterInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	logId := getLogId(ctx)
@@ -1324,25 +1276,7 @@ func mysqlMasterInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 				log.Printf("[CRITAL]%s update mysql %s fail, reason:%s\n ", logId, tag, err.Error())
 				return err
 			}
-			err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
-				taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-				if err != nil {
-					if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-						return resource.RetryableError(err)
-					} else {
-						return resource.NonRetryableError(err)
-					}
-				}
-				if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-					return nil
-				}
-				if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-					return resource.RetryableError(fmt.Errorf("update mysql  %s status is %s", tag, taskStatus))
-				}
-				err = fmt.Errorf("update mysql   task status is %s,we won't wait for it finish ,it show message:%s",
-					tag, message)
-				return resource.NonRetryableError(err)
-			})
+			err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, 10*readRetryTimeout)
 			if err != nil {
 				log.Printf("[CRITAL]%s update mysql  %s  fail, reason:%s\n ", logId, tag, err.Error())
 				return err
@@ -1369,25 +1303,7 @@ func mysqlMasterInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 			log.Printf("[CRITAL]%s update mysql %s fail, reason:%s\n ", logId, tag, err.Error())
 			return err
 		}
-		err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("update mysql  %s status is %s", tag, taskStatus))
-			}
-			err = fmt.Errorf("update mysql task status is %s,we won't wait for it finish ,it show message:%s",
-				tag, message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, 10*readRetryTimeout)
 		if err != nil {
 			log.Printf("[CRITAL]%s update mysql  %s  fail, reason:%s\n ", logId, tag, err.Error())
 			return err
@@ -1408,25 +1324,7 @@ func mysqlMasterInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 			return err
 		}
 
-		err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("change root password status is %s", taskStatus))
-			}
-			err = fmt.Errorf("change root password task status is %s,we won't wait for it finish ,it show message:%s",
-				taskStatus, message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, 10*readRetryTimeout)
 		if err != nil {
 			log.Printf("[CRITAL]%s change root password   fail, reason:%s\n ", logId, err.Error())
 			return err
@@ -1469,11 +1367,11 @@ func mysqlUpdateInstancePayByUse(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudMysqlInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_instance.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	payType := getPayType(d).(int)
 
@@ -1481,31 +1379,31 @@ func resourceTencentCloudMysqlInstanceUpdate(d *schema.ResourceData, meta interf
 	if payType == MysqlPayByMonth {
 		err := mysqlUpdateInstancePayByMonth(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if payType == MysqlPayByUse {
 		err := mysqlUpdateInstancePayByUse(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		return fmt.Errorf("mysql not support this pay type yet.")
+		return diag.Errorf("mysql not support this pay type yet.")
 	}
 	d.Partial(false)
 	time.Sleep(7 * time.Second)
 
-	return resourceTencentCloudMysqlInstanceRead(d, meta)
+	return resourceTencentCloudMysqlInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_instance.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, err := mysqlService.IsolateDBInstance(ctx, d.Id())
 		if err != nil {
 			//for the pay order wait
@@ -1515,14 +1413,14 @@ func resourceTencentCloudMysqlInstanceDelete(d *schema.ResourceData, meta interf
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var hasDeleted = false
 
 	payType := getPayType(d).(int)
 	forceDelete := d.Get("force_delete").(bool)
-	err = resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		mysqlInfo, err := mysqlService.DescribeDBInstanceById(ctx, d.Id())
 
 		if err != nil {
@@ -1549,7 +1447,7 @@ func resourceTencentCloudMysqlInstanceDelete(d *schema.ResourceData, meta interf
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if payType == MysqlPayByMonth && !forceDelete {
@@ -1558,10 +1456,11 @@ func resourceTencentCloudMysqlInstanceDelete(d *schema.ResourceData, meta interf
 
 	err = mysqlService.OfflineIsolatedInstances(ctx, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	var asyncReleased bool
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		mysqlInfo, err := mysqlService.DescribeIsolatedDBInstanceById(ctx, d.Id())
 		if err != nil {
 			if _, ok := err.(*errors.TencentCloudSDKError); !ok {
@@ -1575,12 +1474,19 @@ func resourceTencentCloudMysqlInstanceDelete(d *schema.ResourceData, meta interf
 		} else {
 			if mysqlInfo.RoGroups != nil && len(mysqlInfo.RoGroups) > 0 {
 				log.Printf("[WARN]this mysql has RoGroups , RoGroups is released asynchronously, and the bound resource is not now fully released now\n")
+				asyncReleased = true
 				return nil
 			}
 			return resource.RetryableError(fmt.Errorf("after OfflineIsolatedInstances mysql Status is %d", *mysqlInfo.Status))
 		}
 	})
-	return err
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if asyncReleased {
+		return warningDiagnostics(diag.Errorf("the read-only groups of mysql %s are released asynchronously, the resources bound to them are not fully released yet", d.Id()))
+	}
+	return nil
 }
 
 func getPayType(d *schema.ResourceData) (payType interface{}) {
@@ -1604,3 +1510,107 @@ func isBasicDevice(d *schema.ResourceData) bool {
 	}
 	return v.(string) == "BASIC"
 }
+
+// resourceTencentCloudMysqlInstanceSellConfigCustomizeDiff checks that the mem_size and volume_size
+// combination is on sale in the availability_zone, the check is skipped when the sell configs can
+// not be described.
+func resourceTencentCloudMysqlInstanceSellConfigCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*TencentCloudClient)
+	if !ok || client == nil || client.apiV3Conn == nil {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChange("mem_size") && !d.HasChange("volume_size") {
+		return nil
+	}
+	for _, key := range []string{"availability_zone", "mem_size", "volume_size", "engine_version", "device_type"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+	zone := d.Get("availability_zone").(string)
+	engineVersion := d.Get("engine_version").(string)
+	deviceType := d.Get("device_type").(string)
+	memSize := int64(d.Get("mem_size").(int))
+	volumeSize := int64(d.Get("volume_size").(int))
+	if zone == "" || engineVersion == "" {
+		return nil
+	}
+
+	logId := getLogId(ctx)
+	mysqlService := MysqlService{client: client.apiV3Conn}
+	sellConfigures, err := mysqlService.DescribeDBZoneConfig(ctx)
+	if err != nil || sellConfigures == nil {
+		log.Printf("[WARN]%s skip checking the sell configs of mysql, reason:%v", logId, err)
+		return nil
+	}
+
+	var zoneConfig *cdb.CdbZoneSellConf
+	for _, regionItem := range sellConfigures.Regions {
+		if regionItem.Region == nil || *regionItem.Region != client.apiV3Conn.Region {
+			continue
+		}
+		for _, zoneItem := range regionItem.RegionConfig {
+			if zoneItem.Zone != nil && *zoneItem.Zone == zone {
+				zoneConfig = zoneItem
+				break
+			}
+		}
+	}
+	if zoneConfig == nil {
+		return nil
+	}
+
+	configIds := make(map[int64]bool)
+	for _, sellType := range zoneConfig.SellType {
+		for _, version := range sellType.EngineVersion {
+			if version != nil && *version == engineVersion {
+				for _, id := range sellType.ConfigIds {
+					configIds[*id] = true
+				}
+				break
+			}
+		}
+	}
+	if len(configIds) == 0 {
+		return nil
+	}
+
+	var (
+		memSizes     []string
+		volumeRanges []string
+		seen         = make(map[int64]bool)
+	)
+	for _, config := range sellConfigures.Configs {
+		if config.Id == nil || !configIds[*config.Id] || config.Status == nil || *config.Status != ZONE_SELL_STATUS_ONLINE ||
+			config.Memory == nil || config.VolumeMin == nil || config.VolumeMax == nil {
+			continue
+		}
+		if deviceType != "" && config.DeviceType != nil && *config.DeviceType != deviceType {
+			continue
+		}
+		if !seen[*config.Memory] {
+			seen[*config.Memory] = true
+			memSizes = append(memSizes, fmt.Sprintf("%d", *config.Memory))
+		}
+		if *config.Memory != memSize {
+			continue
+		}
+		step := int64(1)
+		if config.VolumeStep != nil && *config.VolumeStep > 0 {
+			step = *config.VolumeStep
+		}
+		if volumeSize >= *config.VolumeMin && volumeSize <= *config.VolumeMax && (volumeSize-*config.VolumeMin)%step == 0 {
+			return nil
+		}
+		volumeRanges = append(volumeRanges, fmt.Sprintf("[%d, %d] step %d", *config.VolumeMin, *config.VolumeMax, step))
+	}
+	if len(memSizes) == 0 {
+		return nil
+	}
+	if len(volumeRanges) == 0 {
+		return fmt.Errorf("`mem_size` %d is not sold in zone %s with engine_version %s, available values: %s",
+			memSize, zone, engineVersion, strings.Join(memSizes, ", "))
+	}
+	return fmt.Errorf("`volume_size` %d is not sold in zone %s with mem_size %d, available ranges: %s",
+		volumeSize, zone, memSize, strings.Join(volumeRanges, ", "))
+}
@@ -66,7 +66,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -75,9 +77,12 @@ import (
 
 func resourceTencentCloudMysqlInstanceEncryptionOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlInstanceEncryptionOperationCreate,
-		Read:   resourceTencentCloudMysqlInstanceEncryptionOperationRead,
-		Delete: resourceTencentCloudMysqlInstanceEncryptionOperationDelete,
+		CreateContext: resourceTencentCloudMysqlInstanceEncryptionOperationCreate,
+		ReadContext:   resourceTencentCloudMysqlInstanceEncryptionOperationRead,
+		DeleteContext: resourceTencentCloudMysqlInstanceEncryptionOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -104,12 +109,12 @@ func resourceTencentCloudMysqlInstanceEncryptionOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlInstanceEncryptionOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlInstanceEncryptionOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_instance_encryption_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request    = mysql.NewOpenDBInstanceEncryptionRequest()
@@ -128,8 +133,8 @@ func resourceTencentCloudMysqlInstanceEncryptionOperationCreate(d *schema.Resour
 		request.KeyRegion = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().OpenDBInstanceEncryption(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().OpenDBInstanceEncryptionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -139,13 +144,13 @@ func resourceTencentCloudMysqlInstanceEncryptionOperationCreate(d *schema.Resour
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mysql instanceEncryptionOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instanceInfo, err := service.DescribeMysqlInstanceInfoById(ctx, instanceId)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -162,19 +167,19 @@ func resourceTencentCloudMysqlInstanceEncryptionOperationCreate(d *schema.Resour
 
 	if err != nil {
 		log.Printf("[CRITAL]%s instanceEncryption fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMysqlInstanceEncryptionOperationRead(d, meta)
+	return resourceTencentCloudMysqlInstanceEncryptionOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlInstanceEncryptionOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlInstanceEncryptionOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_instance_encryption_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -182,7 +187,7 @@ func resourceTencentCloudMysqlInstanceEncryptionOperationRead(d *schema.Resource
 
 	result, err := service.DescribeMysqlInstanceInfoById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if result == nil {
@@ -204,7 +209,7 @@ func resourceTencentCloudMysqlInstanceEncryptionOperationRead(d *schema.Resource
 	return nil
 }
 
-func resourceTencentCloudMysqlInstanceEncryptionOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlInstanceEncryptionOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_instance_encryption_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -64,7 +64,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -74,10 +76,13 @@ import (
 
 func resourceTencentCloudMysqlIsolateInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlIsolateInstanceCreate,
-		Read:   resourceTencentCloudMysqlIsolateInstanceRead,
-		Update: resourceTencentCloudMysqlIsolateInstanceUpdate,
-		Delete: resourceTencentCloudMysqlIsolateInstanceDelete,
+		CreateContext: resourceTencentCloudMysqlIsolateInstanceCreate,
+		ReadContext:   resourceTencentCloudMysqlIsolateInstanceRead,
+		UpdateContext: resourceTencentCloudMysqlIsolateInstanceUpdate,
+		DeleteContext: resourceTencentCloudMysqlIsolateInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(21 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -103,7 +108,7 @@ func resourceTencentCloudMysqlIsolateInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlIsolateInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlIsolateInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_isolate_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -114,15 +119,15 @@ func resourceTencentCloudMysqlIsolateInstanceCreate(d *schema.ResourceData, meta
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudMysqlIsolateInstanceUpdate(d, meta)
+	return resourceTencentCloudMysqlIsolateInstanceUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlIsolateInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlIsolateInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_isolate_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -130,7 +135,7 @@ func resourceTencentCloudMysqlIsolateInstanceRead(d *schema.ResourceData, meta i
 
 	isolateInstance, err := service.DescribeDBInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if isolateInstance == nil {
@@ -150,12 +155,12 @@ func resourceTencentCloudMysqlIsolateInstanceRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudMysqlIsolateInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlIsolateInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_isolate_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 
@@ -167,8 +172,8 @@ func resourceTencentCloudMysqlIsolateInstanceUpdate(d *schema.ResourceData, meta
 	if operate == "isolate" {
 		request := mysql.NewIsolateDBInstanceRequest()
 		request.InstanceId = helper.String(instanceId)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().IsolateDBInstance(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().IsolateDBInstanceWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -178,11 +183,11 @@ func resourceTencentCloudMysqlIsolateInstanceUpdate(d *schema.ResourceData, meta
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s create mysql isolateInstance failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-		err = resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			mysqlInfo, err := service.DescribeDBInstanceById(ctx, instanceId)
 
 			if err != nil {
@@ -206,14 +211,14 @@ func resourceTencentCloudMysqlIsolateInstanceUpdate(d *schema.ResourceData, meta
 
 		if err != nil {
 			log.Printf("[CRITAL]%s Isolate mysql isolateInstance fail, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	} else if operate == "recover" {
 		service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 		if err := service.DeleteMysqlIsolateInstanceById(ctx, instanceId); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		err := resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			mysqlInfo, err := service.DescribeDBInstanceById(ctx, instanceId)
 
 			if err != nil {
@@ -237,14 +242,14 @@ func resourceTencentCloudMysqlIsolateInstanceUpdate(d *schema.ResourceData, meta
 
 		if err != nil {
 			log.Printf("[CRITAL]%s ReleaseIsolatedDBInstances mysql fail, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMysqlIsolateInstanceRead(d, meta)
+	return resourceTencentCloudMysqlIsolateInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlIsolateInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlIsolateInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_isolate_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -72,7 +72,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -81,12 +83,15 @@ import (
 
 func resourceTencentCloudMysqlLocalBinlogConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlLocalBinlogConfigCreate,
-		Read:   resourceTencentCloudMysqlLocalBinlogConfigRead,
-		Update: resourceTencentCloudMysqlLocalBinlogConfigUpdate,
-		Delete: resourceTencentCloudMysqlLocalBinlogConfigDelete,
+		CreateContext: resourceTencentCloudMysqlLocalBinlogConfigCreate,
+		ReadContext:   resourceTencentCloudMysqlLocalBinlogConfigRead,
+		UpdateContext: resourceTencentCloudMysqlLocalBinlogConfigUpdate,
+		DeleteContext: resourceTencentCloudMysqlLocalBinlogConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -111,22 +116,22 @@ func resourceTencentCloudMysqlLocalBinlogConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlLocalBinlogConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlLocalBinlogConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_local_binlog_config.create")()
 	defer inconsistentCheck(d, meta)()
 
 	d.SetId(d.Get("instance_id").(string))
 
-	return resourceTencentCloudMysqlLocalBinlogConfigUpdate(d, meta)
+	return resourceTencentCloudMysqlLocalBinlogConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlLocalBinlogConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlLocalBinlogConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_local_binlog_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -134,7 +139,7 @@ func resourceTencentCloudMysqlLocalBinlogConfigRead(d *schema.ResourceData, meta
 
 	localBinlogConfig, err := service.DescribeMysqlLocalBinlogConfigById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if localBinlogConfig == nil {
@@ -157,7 +162,7 @@ func resourceTencentCloudMysqlLocalBinlogConfigRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudMysqlLocalBinlogConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlLocalBinlogConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_local_binlog_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -177,8 +182,8 @@ func resourceTencentCloudMysqlLocalBinlogConfigUpdate(d *schema.ResourceData, me
 		request.MaxUsage = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyLocalBinlogConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyLocalBinlogConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -188,13 +193,13 @@ func resourceTencentCloudMysqlLocalBinlogConfigUpdate(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update mysql localBinlogConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMysqlLocalBinlogConfigRead(d, meta)
+	return resourceTencentCloudMysqlLocalBinlogConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlLocalBinlogConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlLocalBinlogConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_local_binlog_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
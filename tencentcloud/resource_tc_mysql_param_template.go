@@ -85,9 +85,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -96,12 +97,16 @@ import (
 
 func resourceTencentCloudMysqlParamTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlParamTemplateCreate,
-		Read:   resourceTencentCloudMysqlParamTemplateRead,
-		Update: resourceTencentCloudMysqlParamTemplateUpdate,
-		Delete: resourceTencentCloudMysqlParamTemplateDelete,
+		CreateContext: resourceTencentCloudMysqlParamTemplateCreate,
+		ReadContext:   resourceTencentCloudMysqlParamTemplateRead,
+		UpdateContext: resourceTencentCloudMysqlParamTemplateUpdate,
+		DeleteContext: resourceTencentCloudMysqlParamTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -167,7 +172,7 @@ func resourceTencentCloudMysqlParamTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlParamTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlParamTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_param_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -216,8 +221,8 @@ func resourceTencentCloudMysqlParamTemplateCreate(d *schema.ResourceData, meta i
 		request.EngineType = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateParamTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateParamTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -228,22 +233,22 @@ func resourceTencentCloudMysqlParamTemplateCreate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mysql paramTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	templateId = *response.Response.TemplateId
 	d.SetId(helper.Int64ToStr(templateId))
 
-	return resourceTencentCloudMysqlParamTemplateRead(d, meta)
+	return resourceTencentCloudMysqlParamTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlParamTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlParamTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_param_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -251,22 +256,22 @@ func resourceTencentCloudMysqlParamTemplateRead(d *schema.ResourceData, meta int
 
 	paramTemplate, err := service.DescribeMysqlParamTemplateById(ctx, templateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if paramTemplate == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `MysqlParamTemplate` %s does not exist", d.Id())
+		return diag.Errorf("resource `MysqlParamTemplate` %s does not exist", d.Id())
 	}
 
 	paramTemplateInfo, err := service.DescribeMysqlParamTemplateInfoById(ctx, templateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if paramTemplateInfo == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `MysqlParamTemplateInfo` %s does not exist", d.Id())
+		return diag.Errorf("resource `MysqlParamTemplateInfo` %s does not exist", d.Id())
 	}
 
 	if paramTemplate.Name != nil {
@@ -339,7 +344,7 @@ func resourceTencentCloudMysqlParamTemplateRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudMysqlParamTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlParamTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_param_template.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -355,7 +360,7 @@ func resourceTencentCloudMysqlParamTemplateUpdate(d *schema.ResourceData, meta i
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -387,8 +392,8 @@ func resourceTencentCloudMysqlParamTemplateUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyParamTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyParamTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -398,24 +403,24 @@ func resourceTencentCloudMysqlParamTemplateUpdate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update mysql paramTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMysqlParamTemplateRead(d, meta)
+	return resourceTencentCloudMysqlParamTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlParamTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlParamTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_param_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	templateId := d.Id()
 
 	if err := service.DeleteMysqlParamTemplateById(ctx, templateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
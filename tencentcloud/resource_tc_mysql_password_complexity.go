@@ -73,14 +73,22 @@ resource "tencentcloud_mysql_password_complexity" "example" {
 }
 ```
 
+Import
+
+mysql password_complexity can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_mysql_password_complexity.example cdb-fitq5t9h
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -89,10 +97,16 @@ import (
 
 func resourceTencentCloudMysqlPasswordComplexity() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlPasswordComplexityCreate,
-		Read:   resourceTencentCloudMysqlPasswordComplexityRead,
-		Update: resourceTencentCloudMysqlPasswordComplexityUpdate,
-		Delete: resourceTencentCloudMysqlPasswordComplexityDelete,
+		CreateContext: resourceTencentCloudMysqlPasswordComplexityCreate,
+		ReadContext:   resourceTencentCloudMysqlPasswordComplexityRead,
+		UpdateContext: resourceTencentCloudMysqlPasswordComplexityUpdate,
+		DeleteContext: resourceTencentCloudMysqlPasswordComplexityDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -124,7 +138,7 @@ func resourceTencentCloudMysqlPasswordComplexity() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlPasswordComplexityCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlPasswordComplexityCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_password_complexity.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -135,15 +149,15 @@ func resourceTencentCloudMysqlPasswordComplexityCreate(d *schema.ResourceData, m
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudMysqlPasswordComplexityUpdate(d, meta)
+	return resourceTencentCloudMysqlPasswordComplexityUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlPasswordComplexityRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlPasswordComplexityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_password_complexity.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -151,7 +165,7 @@ func resourceTencentCloudMysqlPasswordComplexityRead(d *schema.ResourceData, met
 
 	passwordComplexitys, err := service.DescribeMysqlPasswordComplexityById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if passwordComplexitys == nil {
@@ -165,12 +179,12 @@ func resourceTencentCloudMysqlPasswordComplexityRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudMysqlPasswordComplexityUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlPasswordComplexityUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_password_complexity.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := mysql.NewModifyInstancePasswordComplexityRequest()
 	response := mysql.NewModifyInstancePasswordComplexityResponse()
@@ -193,8 +207,8 @@ func resourceTencentCloudMysqlPasswordComplexityUpdate(d *schema.ResourceData, m
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyInstancePasswordComplexity(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyInstancePasswordComplexityWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -205,35 +219,22 @@ func resourceTencentCloudMysqlPasswordComplexityUpdate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update mysql passwordComplexity failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s update mysql passwordComplexity status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s update mysql passwordComplexity status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = service.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update mysql passwordComplexity fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMysqlPasswordComplexityRead(d, meta)
+	return resourceTencentCloudMysqlPasswordComplexityRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlPasswordComplexityDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlPasswordComplexityDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_password_complexity.delete")()
 	defer inconsistentCheck(d, meta)()
 
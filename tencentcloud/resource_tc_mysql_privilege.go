@@ -93,6 +93,14 @@ resource "tencentcloud_mysql_privilege" "example" {
   }
 }
 ```
+
+Import
+
+MySQL privilege can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_mysql_privilege.example '{"MysqlId":"cdb-switpmff","AccountName":"test","AccountHost":"%"}'
+```
 */
 package tencentcloud
 
@@ -104,6 +112,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -140,10 +149,13 @@ func resourceTencentCloudMysqlPrivilegeHash(v interface{}) int {
 
 func resourceTencentCloudMysqlPrivilege() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlPrivilegeCreate,
-		Read:   resourceTencentCloudMysqlPrivilegeRead,
-		Update: resourceTencentCloudMysqlPrivilegeUpdate,
-		Delete: resourceTencentCloudMysqlPrivilegeDelete,
+		CreateContext: resourceTencentCloudMysqlPrivilegeCreate,
+		ReadContext:   resourceTencentCloudMysqlPrivilegeRead,
+		UpdateContext: resourceTencentCloudMysqlPrivilegeUpdate,
+		DeleteContext: resourceTencentCloudMysqlPrivilegeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"mysql_id": {
 				Type:        schema.TypeString,
@@ -393,8 +405,10 @@ func (me *resourceTencentCloudMysqlPrivilegeId) update(ctx context.Context, d *s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyAccountPrivileges(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyAccountPrivilegesWithContext(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -404,34 +418,16 @@ func (me *resourceTencentCloudMysqlPrivilegeId) update(ctx context.Context, d *s
 	asyncRequestId := *response.Response.AsyncRequestId
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return retryError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("modify account privilege  task  status is %s", taskStatus))
-		}
-
-		if taskStatus == MYSQL_TASK_STATUS_FAILED || taskStatus == MYSQL_TASK_STATUS_REMOVED {
-			return resource.NonRetryableError(errors.New("sdk ModifyAccountPrivileges task running fail," + message))
-		}
-		err = fmt.Errorf("modify account privilege task status is %s,we won't wait for it finish ,it show message:%s\n", taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, readRetryTimeout)
 	return err
 }
 
-func resourceTencentCloudMysqlPrivilegeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlPrivilegeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	defer logElapsed("resource.tencentcloud_mysql_privilege.update")()
 
 	var (
 		logId       = getLogId(contextNil)
-		ctx         = context.WithValue(context.TODO(), logIdKey, logId)
 		mysqlId     = d.Get("mysql_id").(string)
 		accountName = d.Get("account_name").(string)
 		accountHost = d.Get("account_host").(string)
@@ -440,31 +436,32 @@ func resourceTencentCloudMysqlPrivilegeCreate(d *schema.ResourceData, meta inter
 			AccountName: accountName,
 			AccountHost: accountHost}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	privilegeIdStr, err := json.Marshal(privilegeId)
 	if err != nil {
-		return errors.New("json encode to id fail," + err.Error())
+		return diag.FromErr(errors.New("json encode to id fail," + err.Error()))
 	}
 	err = privilegeId.update(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(string(privilegeIdStr))
 	return nil
 }
 
-func resourceTencentCloudMysqlPrivilegeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlPrivilegeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_privilege.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var privilegeId resourceTencentCloudMysqlPrivilegeId
 
 	if err := json.Unmarshal([]byte(d.Id()), &privilegeId); err != nil {
 		err = fmt.Errorf("Local data[terraform.tfstate] corruption,can not got old account privilege id")
 		log.Printf("[CRITAL]%s %s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if privilegeId.AccountHost == "" {
@@ -475,7 +472,7 @@ func resourceTencentCloudMysqlPrivilegeRead(d *schema.ResourceData, meta interfa
 
 	var accountInfo *cdb.AccountInfo = nil
 	var onlineHas = true
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		accountInfos, err := mysqlService.DescribeAccounts(ctx, privilegeId.MysqlId)
 		if err != nil {
 			if sdkerr, ok := err.(*sdkError.TencentCloudSDKError); ok && sdkerr.GetCode() == "InvalidParameter" &&
@@ -502,7 +499,7 @@ func resourceTencentCloudMysqlPrivilegeRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("Describe mysql acounts fails, reaseon %s", err.Error())
+		return diag.Errorf("Describe mysql acounts fails, reaseon %s", err.Error())
 	}
 	if !onlineHas {
 		return nil
@@ -514,9 +511,11 @@ func resourceTencentCloudMysqlPrivilegeRead(d *schema.ResourceData, meta interfa
 	request.Host = helper.String(privilegeId.AccountHost)
 
 	var response *cdb.DescribeAccountPrivilegesResponse
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().DescribeAccountPrivileges(request)
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().DescribeAccountPrivilegesWithContext(ctx, request)
 		if err != nil {
 			if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
 				if sdkErr.Code == MysqlInstanceIdNotFound {
@@ -537,14 +536,14 @@ func resourceTencentCloudMysqlPrivilegeRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !onlineHas {
 		return nil
 	}
 
 	if response == nil || response.Response == nil {
-		return errors.New("sdk DescribeAccountPrivileges return error,miss Response")
+		return diag.FromErr(errors.New("sdk DescribeAccountPrivileges return error,miss Response"))
 	}
 	globals := make([]string, 0, len(response.Response.GlobalPrivileges))
 	for _, v := range response.Response.GlobalPrivileges {
@@ -601,18 +600,18 @@ func resourceTencentCloudMysqlPrivilegeRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudMysqlPrivilegeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlPrivilegeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_privilege.update")()
 
 	var (
 		logId       = getLogId(contextNil)
-		ctx         = context.WithValue(context.TODO(), logIdKey, logId)
 		privilegeId = resourceTencentCloudMysqlPrivilegeId{}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if err := json.Unmarshal([]byte(d.Id()), &privilegeId); err != nil {
 		err = fmt.Errorf("Local data[terraform.tfstate] corruption,can not got old account privilege id")
 		log.Printf("[CRITAL]%s %s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if privilegeId.AccountHost == "" {
 		privilegeId.AccountHost = MYSQL_DEFAULT_ACCOUNT_HOST
@@ -621,31 +620,31 @@ func resourceTencentCloudMysqlPrivilegeUpdate(d *schema.ResourceData, meta inter
 	if d.HasChange("global") || d.HasChange("database") || d.HasChange("table") || d.HasChange("column") {
 		err := privilegeId.update(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
 }
 
-func resourceTencentCloudMysqlPrivilegeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlPrivilegeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_privilege.delete")()
 
 	var (
 		logId       = getLogId(contextNil)
-		ctx         = context.WithValue(context.TODO(), logIdKey, logId)
 		privilegeId = resourceTencentCloudMysqlPrivilegeId{}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if err := json.Unmarshal([]byte(d.Id()), &privilegeId); err != nil {
 		err = fmt.Errorf("Local data[terraform.tfstate] corruption,can not got old account privilege id")
 		log.Printf("[CRITAL]%s %s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if privilegeId.AccountHost == "" {
 		privilegeId.AccountHost = MYSQL_DEFAULT_ACCOUNT_HOST
 	}
 	err := privilegeId.update(ctx, nil, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
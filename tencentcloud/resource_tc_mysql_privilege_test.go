@@ -50,6 +50,11 @@ func TestAccTencentCloudMysqlPrivilegeResource(t *testing.T) {
 					resource.TestCheckTypeSetElemAttr(testAccTencentCloudMysqlPrivilegeName, "global.*", "SELECT"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_mysql_privilege.privilege",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -78,8 +78,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -88,12 +89,17 @@ import (
 
 func resourceTencentCloudMysqlProxy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlProxyCreate,
-		Read:   resourceTencentCloudMysqlProxyRead,
-		Update: resourceTencentCloudMysqlProxyUpdate,
-		Delete: resourceTencentCloudMysqlProxyDelete,
+		CreateContext: resourceTencentCloudMysqlProxyCreate,
+		ReadContext:   resourceTencentCloudMysqlProxyRead,
+		UpdateContext: resourceTencentCloudMysqlProxyUpdate,
+		DeleteContext: resourceTencentCloudMysqlProxyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -212,12 +218,12 @@ func resourceTencentCloudMysqlProxy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlProxyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlProxyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_proxy.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request    = mysql.NewCreateCdbProxyRequest()
@@ -280,8 +286,8 @@ func resourceTencentCloudMysqlProxyCreate(d *schema.ResourceData, meta interface
 		request.ConnectionPoolLimit = helper.IntUint64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateCdbProxy(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateCdbProxyWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -292,64 +298,51 @@ func resourceTencentCloudMysqlProxyCreate(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mysql proxy failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s create mysql proxy status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s create mysql proxy status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = service.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mysql proxy fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	proxy, err := service.DescribeMysqlProxyById(ctx, instanceId, "")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	proxyAddressId := *proxy.ProxyAddress[0].ProxyAddressId
 	proxyGroupId := *proxy.ProxyGroupId
-	d.SetId(instanceId + FILED_SP + proxyGroupId + FILED_SP + proxyAddressId)
+	d.SetId(helper.IdFormat(instanceId, proxyGroupId, proxyAddressId))
 
 	ip := d.Get("vip").(string)
 	port := d.Get("vport").(int)
 	if ip != "" || port > 0 {
 		err := service.ModifyCdbProxyAddressVipAndVPort(ctx, proxyGroupId, proxyAddressId, vpcId, subnetId, ip, uint64(port))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMysqlProxyRead(d, meta)
+	return resourceTencentCloudMysqlProxyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlProxyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlProxyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_proxy.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	items := strings.Split(d.Id(), FILED_SP)
-	if len(items) != 3 {
-		return fmt.Errorf("invalid ID %s", d.Id())
+	items, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := items[0]
@@ -358,7 +351,7 @@ func resourceTencentCloudMysqlProxyRead(d *schema.ResourceData, meta interface{}
 
 	proxy, err := service.DescribeMysqlProxyById(ctx, instanceId, proxyGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if proxy == nil {
@@ -410,7 +403,7 @@ func resourceTencentCloudMysqlProxyRead(d *schema.ResourceData, meta interface{}
 
 	securityGroups, err := service.DescribeDBSecurityGroups(ctx, proxyAddressId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(securityGroups) > 0 {
 		_ = d.Set("security_group", securityGroups)
@@ -443,16 +436,16 @@ func resourceTencentCloudMysqlProxyRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudMysqlProxyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlProxyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_proxy.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	items := strings.Split(d.Id(), FILED_SP)
-	if len(items) != 3 {
-		return fmt.Errorf("invalid ID %s", d.Id())
+	items, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := items[0]
@@ -462,17 +455,17 @@ func resourceTencentCloudMysqlProxyUpdate(d *schema.ResourceData, meta interface
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	proxy, err := service.DescribeMysqlProxyById(ctx, instanceId, proxyGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if proxy == nil || proxy.ProxyGroupId == nil {
-		return fmt.Errorf("Instance `%s` proxy does not exist", instanceId)
+		return diag.Errorf("Instance `%s` proxy does not exist", instanceId)
 	}
 
 	immutableArgs := []string{"instance_id", "security_group"}
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -508,8 +501,8 @@ func resourceTencentCloudMysqlProxyUpdate(d *schema.ResourceData, meta interface
 			request.UpgradeTime = helper.String("nowTime")
 		}
 
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().AdjustCdbProxy(request)
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().AdjustCdbProxyWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -520,28 +513,15 @@ func resourceTencentCloudMysqlProxyUpdate(d *schema.ResourceData, meta interface
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update mysql proxy failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		asyncRequestId := *response.Response.AsyncRequestId
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("%s update mysql proxy status is %s", instanceId, taskStatus))
-			}
-			err = fmt.Errorf("%s update mysql proxy status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-			return resource.NonRetryableError(err)
-		})
+		err = service.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update mysql proxy fail, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -552,8 +532,8 @@ func resourceTencentCloudMysqlProxyUpdate(d *schema.ResourceData, meta interface
 		request.ProxyGroupId = proxy.ProxyGroupId
 		request.ConnectionPoolLimit = helper.IntUint64(connectionPoolLimit.(int))
 
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyCdbProxyParam(request)
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyCdbProxyParamWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -563,7 +543,7 @@ func resourceTencentCloudMysqlProxyUpdate(d *schema.ResourceData, meta interface
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update mysql proxy failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -575,7 +555,7 @@ func resourceTencentCloudMysqlProxyUpdate(d *schema.ResourceData, meta interface
 
 		err := service.ModifyCdbProxyAddressVipAndVPort(ctx, proxyGroupId, proxyAddressId, vpcId, subnetId, ip, port)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -583,7 +563,7 @@ func resourceTencentCloudMysqlProxyUpdate(d *schema.ResourceData, meta interface
 		desc := d.Get("desc").(string)
 		err := service.ModifyCdbProxyAddressDesc(ctx, proxyGroupId, proxyAddressId, desc)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -592,30 +572,30 @@ func resourceTencentCloudMysqlProxyUpdate(d *schema.ResourceData, meta interface
 		if v, ok := d.GetOk("upgrade_time"); ok {
 			upgradeTime = v.(string)
 		} else {
-			return fmt.Errorf("The parameter `upgrade_time` must be filled in when modifying the proxy version")
+			return diag.Errorf("The parameter `upgrade_time` must be filled in when modifying the proxy version")
 		}
 
 		oldProxyVersion, proxyVersion := d.GetChange("proxy_version")
 		err := service.UpgradeCDBProxyVersion(ctx, instanceId, proxyGroupId, oldProxyVersion.(string), proxyVersion.(string), upgradeTime)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMysqlProxyRead(d, meta)
+	return resourceTencentCloudMysqlProxyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlProxyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlProxyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_proxy.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	items := strings.Split(d.Id(), FILED_SP)
+	items := helper.IdParse(d.Id())
 	if len(items) != 3 {
-		return fmt.Errorf("invalid ID %s", d.Id())
+		return diag.Errorf("invalid ID %s", d.Id())
 	}
 
 	instanceId := items[0]
@@ -623,10 +603,10 @@ func resourceTencentCloudMysqlProxyDelete(d *schema.ResourceData, meta interface
 	// proxyAddressId := items[2]
 
 	if err := service.DeleteMysqlProxyById(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		proxy, err := service.DescribeMysqlProxyById(ctx, instanceId, proxyGroupId)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -643,7 +623,7 @@ func resourceTencentCloudMysqlProxyDelete(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		log.Printf("[CRITAL]%s delete mysql proxy fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
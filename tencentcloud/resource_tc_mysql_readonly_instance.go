@@ -83,7 +83,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -119,10 +121,14 @@ func resourceTencentCloudMysqlReadonlyInstance() *schema.Resource {
 	delete(readonlyInstanceInfo, "gtid")
 
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlReadonlyInstanceCreate,
-		Read:   resourceTencentCloudMysqlReadonlyInstanceRead,
-		Update: resourceTencentCloudMysqlReadonlyInstanceUpdate,
-		Delete: resourceTencentCloudMysqlReadonlyInstanceDelete,
+		CreateContext: resourceTencentCloudMysqlReadonlyInstanceCreate,
+		ReadContext:   resourceTencentCloudMysqlReadonlyInstanceRead,
+		UpdateContext: resourceTencentCloudMysqlReadonlyInstanceUpdate,
+		DeleteContext: resourceTencentCloudMysqlReadonlyInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 
 		Importer: &schema.ResourceImporter{
 			State: helper.ImportWithDefaultValue(map[string]interface{}{
@@ -189,7 +195,7 @@ func mysqlCreateReadonlyInstancePayByMonth(ctx context.Context, d *schema.Resour
 		return err
 	}
 
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateDBInstance(request)
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateDBInstanceWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -244,7 +250,7 @@ func mysqlCreateReadonlyInstancePayByUse(ctx context.Context, d *schema.Resource
 		request.MasterRegion = &masterRegion
 	}
 
-	response, err := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateDBInstanceHour(request)
+	response, err := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateDBInstanceHourWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -260,18 +266,18 @@ func mysqlCreateReadonlyInstancePayByUse(ctx context.Context, d *schema.Resource
 	return nil
 }
 
-func resourceTencentCloudMysqlReadonlyInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlReadonlyInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_readonly_instance.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	// the mysql master instance must have a backup before creating a read-only instance
 	masterInstanceId := d.Get("master_instance_id").(string)
 
-	err := resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		backups, err := mysqlService.DescribeBackupsByMysqlId(ctx, masterInstanceId, 10)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -283,27 +289,27 @@ func resourceTencentCloudMysqlReadonlyInstanceCreate(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mysql task fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	payType := getPayType(d).(int)
 	if payType == MysqlPayByMonth {
 		err := mysqlCreateReadonlyInstancePayByMonth(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if payType == MysqlPayByUse {
 		err := mysqlCreateReadonlyInstancePayByUse(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		return fmt.Errorf("mysql not support this pay type yet.")
+		return diag.Errorf("mysql not support this pay type yet.")
 	}
 
 	mysqlID := d.Id()
 
-	err = resource.Retry(4*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		mysqlInfo, err := mysqlService.DescribeDBInstanceById(ctx, mysqlID)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -324,7 +330,7 @@ func resourceTencentCloudMysqlReadonlyInstanceCreate(d *schema.ResourceData, met
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mysql  task fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
@@ -333,21 +339,21 @@ func resourceTencentCloudMysqlReadonlyInstanceCreate(d *schema.ResourceData, met
 		resourceName := BuildTagResourceName("cdb", "instanceId", tcClient.Region, d.Id())
 		log.Printf("[DEBUG]Mysql instance create, resourceName:%s\n", resourceName)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudMysqlReadonlyInstanceRead(d, meta)
+	return resourceTencentCloudMysqlReadonlyInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlReadonlyInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlReadonlyInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_readonly_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		mysqlInfo, e := tencentMsyqlBasicInfoRead(ctx, d, meta, false)
 		if e != nil {
 			if mysqlService.NotFoundMysqlInstance(e) {
@@ -367,12 +373,12 @@ func resourceTencentCloudMysqlReadonlyInstanceRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("Fail to get basic info from mysql, reaseon %s", err.Error())
+		return diag.Errorf("Fail to get basic info from mysql, reaseon %s", err.Error())
 	}
 
 	mysqlInfo, errRet := mysqlService.DescribeDBInstanceById(ctx, d.Id())
 	if errRet != nil {
-		return fmt.Errorf("Describe mysql instance fails, reaseon %v", errRet.Error())
+		return diag.Errorf("Describe mysql instance fails, reaseon %v", errRet.Error())
 	}
 	if mysqlInfo == nil {
 		d.SetId("")
@@ -417,7 +423,7 @@ func resourceTencentCloudMysqlReadonlyInstanceRead(d *schema.ResourceData, meta
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("security_groups", securityGroups)
 
@@ -425,7 +431,7 @@ func resourceTencentCloudMysqlReadonlyInstanceRead(d *schema.ResourceData, meta
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "cdb", "instanceId", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("tags", tags); err != nil {
@@ -447,11 +453,11 @@ func resourceTencentCloudMysqlReadonlyInstanceRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudMysqlReadonlyInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlReadonlyInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_readonly_instance.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	payType := getPayType(d).(int)
 
@@ -462,14 +468,14 @@ func resourceTencentCloudMysqlReadonlyInstanceUpdate(d *schema.ResourceData, met
 			renewFlag := int64(d.Get("auto_renew_flag").(int))
 			mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 			if err := mysqlService.ModifyAutoRenewFlag(ctx, d.Id(), renewFlag); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 		}
 	}
 	err := mysqlAllInstanceRoleUpdate(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	immutableFields := []string{
@@ -480,23 +486,23 @@ func resourceTencentCloudMysqlReadonlyInstanceUpdate(d *schema.ResourceData, met
 
 	for _, f := range immutableFields {
 		if d.HasChange(f) {
-			return fmt.Errorf("argument `%s` cannot be modified for now", f)
+			return diag.Errorf("argument `%s` cannot be modified for now", f)
 		}
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudMysqlReadonlyInstanceRead(d, meta)
+	return resourceTencentCloudMysqlReadonlyInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlReadonlyInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlReadonlyInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_readonly_instance.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, err := mysqlService.IsolateDBInstance(ctx, d.Id())
 		if err != nil {
 			//for the pay order wait
@@ -506,14 +512,14 @@ func resourceTencentCloudMysqlReadonlyInstanceDelete(d *schema.ResourceData, met
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var hasDeleted = false
 	payType := getPayType(d).(int)
 	forceDelete := d.Get("force_delete").(bool)
 
-	err = resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		mysqlInfo, err := mysqlService.DescribeDBInstanceById(ctx, d.Id())
 
 		if err != nil {
@@ -541,15 +547,16 @@ func resourceTencentCloudMysqlReadonlyInstanceDelete(d *schema.ResourceData, met
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if payType == MysqlPayByMonth && !forceDelete {
 		return nil
 	}
 
 	err = mysqlService.OfflineIsolatedInstances(ctx, d.Id())
-	if err == nil {
-		log.Printf("[WARN]this mysql is readonly instance, it is released asynchronously, and the bound resource is not now fully released now\n")
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	return err
+	log.Printf("[WARN]this mysql is readonly instance, it is released asynchronously, and the bound resource is not now fully released now\n")
+	return warningDiagnostics(diag.Errorf("the mysql readonly instance %s is released asynchronously, the resources bound to it are not fully released yet", d.Id()))
 }
@@ -80,8 +80,11 @@ resource "tencentcloud_mysql_reload_balance_proxy_node" "example" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -90,9 +93,12 @@ import (
 
 func resourceTencentCloudMysqlReloadBalanceProxyNode() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlReloadBalanceProxyNodeCreate,
-		Read:   resourceTencentCloudMysqlReloadBalanceProxyNodeRead,
-		Delete: resourceTencentCloudMysqlReloadBalanceProxyNodeDelete,
+		CreateContext: resourceTencentCloudMysqlReloadBalanceProxyNodeCreate,
+		ReadContext:   resourceTencentCloudMysqlReloadBalanceProxyNodeRead,
+		DeleteContext: resourceTencentCloudMysqlReloadBalanceProxyNodeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"proxy_group_id": {
@@ -112,7 +118,7 @@ func resourceTencentCloudMysqlReloadBalanceProxyNode() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlReloadBalanceProxyNodeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlReloadBalanceProxyNodeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_reload_balance_proxy_node.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -131,8 +137,8 @@ func resourceTencentCloudMysqlReloadBalanceProxyNodeCreate(d *schema.ResourceDat
 		request.ProxyAddressId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ReloadBalanceProxyNode(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ReloadBalanceProxyNodeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -142,22 +148,22 @@ func resourceTencentCloudMysqlReloadBalanceProxyNodeCreate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mysql reloadBalanceProxyNode failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudMysqlReloadBalanceProxyNodeRead(d, meta)
+	return resourceTencentCloudMysqlReloadBalanceProxyNodeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlReloadBalanceProxyNodeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlReloadBalanceProxyNodeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_reload_balance_proxy_node.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudMysqlReloadBalanceProxyNodeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlReloadBalanceProxyNodeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_reload_balance_proxy_node.delete")()
 	defer inconsistentCheck(d, meta)()
 
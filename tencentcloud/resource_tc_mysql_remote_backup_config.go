@@ -74,7 +74,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -83,12 +85,15 @@ import (
 
 func resourceTencentCloudMysqlRemoteBackupConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlRemoteBackupConfigCreate,
-		Read:   resourceTencentCloudMysqlRemoteBackupConfigRead,
-		Update: resourceTencentCloudMysqlRemoteBackupConfigUpdate,
-		Delete: resourceTencentCloudMysqlRemoteBackupConfigDelete,
+		CreateContext: resourceTencentCloudMysqlRemoteBackupConfigCreate,
+		ReadContext:   resourceTencentCloudMysqlRemoteBackupConfigRead,
+		UpdateContext: resourceTencentCloudMysqlRemoteBackupConfigUpdate,
+		DeleteContext: resourceTencentCloudMysqlRemoteBackupConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -127,7 +132,7 @@ func resourceTencentCloudMysqlRemoteBackupConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlRemoteBackupConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRemoteBackupConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_remote_backup_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -138,16 +143,16 @@ func resourceTencentCloudMysqlRemoteBackupConfigCreate(d *schema.ResourceData, m
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudMysqlRemoteBackupConfigUpdate(d, meta)
+	return resourceTencentCloudMysqlRemoteBackupConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlRemoteBackupConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRemoteBackupConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_remote_backup_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -155,7 +160,7 @@ func resourceTencentCloudMysqlRemoteBackupConfigRead(d *schema.ResourceData, met
 
 	remoteBackupConfig, err := service.DescribeMysqlRemoteBackupConfigById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if remoteBackupConfig == nil {
@@ -185,7 +190,7 @@ func resourceTencentCloudMysqlRemoteBackupConfigRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudMysqlRemoteBackupConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRemoteBackupConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_remote_backup_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -217,8 +222,8 @@ func resourceTencentCloudMysqlRemoteBackupConfigUpdate(d *schema.ResourceData, m
 		request.ExpireDays = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyRemoteBackupConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyRemoteBackupConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -228,13 +233,13 @@ func resourceTencentCloudMysqlRemoteBackupConfigUpdate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update mysql remoteBackupConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMysqlRemoteBackupConfigRead(d, meta)
+	return resourceTencentCloudMysqlRemoteBackupConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlRemoteBackupConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRemoteBackupConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_remote_backup_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -69,9 +69,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -80,9 +81,12 @@ import (
 
 func resourceTencentCloudMysqlRenewDbInstanceOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlRenewDbInstanceOperationCreate,
-		Read:   resourceTencentCloudMysqlRenewDbInstanceOperationRead,
-		Delete: resourceTencentCloudMysqlRenewDbInstanceOperationDelete,
+		CreateContext: resourceTencentCloudMysqlRenewDbInstanceOperationCreate,
+		ReadContext:   resourceTencentCloudMysqlRenewDbInstanceOperationRead,
+		DeleteContext: resourceTencentCloudMysqlRenewDbInstanceOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -121,7 +125,7 @@ func resourceTencentCloudMysqlRenewDbInstanceOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlRenewDbInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRenewDbInstanceOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_renew_db_instance_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -145,8 +149,8 @@ func resourceTencentCloudMysqlRenewDbInstanceOperationCreate(d *schema.ResourceD
 		request.ModifyPayType = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().RenewDBInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().RenewDBInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -157,27 +161,27 @@ func resourceTencentCloudMysqlRenewDbInstanceOperationCreate(d *schema.ResourceD
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mysql renewDbInstanceOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	dealId := *response.Response.DealId
 	d.SetId(instanceId)
 	_ = d.Set("deal_id", dealId)
 
-	return resourceTencentCloudMysqlRenewDbInstanceOperationRead(d, meta)
+	return resourceTencentCloudMysqlRenewDbInstanceOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlRenewDbInstanceOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRenewDbInstanceOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_renew_db_instance_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	mysqlInfo, errRet := service.DescribeDBInstanceById(ctx, d.Id())
 	if errRet != nil {
-		return fmt.Errorf("Describe mysql instance fails, reaseon %s", errRet.Error())
+		return diag.Errorf("Describe mysql instance fails, reaseon %s", errRet.Error())
 	}
 
 	if mysqlInfo == nil {
@@ -192,7 +196,7 @@ func resourceTencentCloudMysqlRenewDbInstanceOperationRead(d *schema.ResourceDat
 	return nil
 }
 
-func resourceTencentCloudMysqlRenewDbInstanceOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRenewDbInstanceOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_renew_db_instance_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
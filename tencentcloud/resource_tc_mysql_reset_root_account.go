@@ -61,8 +61,11 @@ resource "tencentcloud_mysql_reset_root_account" "example" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -71,9 +74,12 @@ import (
 
 func resourceTencentCloudMysqlResetRootAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlResetRootAccountCreate,
-		Read:   resourceTencentCloudMysqlResetRootAccountRead,
-		Delete: resourceTencentCloudMysqlResetRootAccountDelete,
+		CreateContext: resourceTencentCloudMysqlResetRootAccountCreate,
+		ReadContext:   resourceTencentCloudMysqlResetRootAccountRead,
+		DeleteContext: resourceTencentCloudMysqlResetRootAccountDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -86,7 +92,7 @@ func resourceTencentCloudMysqlResetRootAccount() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlResetRootAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlResetRootAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_reset_root_account.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -102,8 +108,8 @@ func resourceTencentCloudMysqlResetRootAccountCreate(d *schema.ResourceData, met
 		request.InstanceId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ResetRootAccount(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ResetRootAccountWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -113,22 +119,22 @@ func resourceTencentCloudMysqlResetRootAccountCreate(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mysql resetRootAccount failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudMysqlResetRootAccountRead(d, meta)
+	return resourceTencentCloudMysqlResetRootAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlResetRootAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlResetRootAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_reset_root_account.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudMysqlResetRootAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlResetRootAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_reset_root_account.delete")()
 	defer inconsistentCheck(d, meta)()
 
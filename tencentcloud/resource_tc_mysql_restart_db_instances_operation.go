@@ -69,9 +69,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -79,9 +80,12 @@ import (
 
 func resourceTencentCloudMysqlRestartDbInstancesOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlRestartDbInstancesOperationCreate,
-		Read:   resourceTencentCloudMysqlRestartDbInstancesOperationRead,
-		Delete: resourceTencentCloudMysqlRestartDbInstancesOperationDelete,
+		CreateContext: resourceTencentCloudMysqlRestartDbInstancesOperationCreate,
+		ReadContext:   resourceTencentCloudMysqlRestartDbInstancesOperationRead,
+		DeleteContext: resourceTencentCloudMysqlRestartDbInstancesOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -100,12 +104,12 @@ func resourceTencentCloudMysqlRestartDbInstancesOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlRestartDbInstancesOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRestartDbInstancesOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_restart_db_instances_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request    = mysql.NewRestartDBInstancesRequest()
@@ -117,8 +121,8 @@ func resourceTencentCloudMysqlRestartDbInstancesOperationCreate(d *schema.Resour
 		request.InstanceIds = []*string{&instanceId}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().RestartDBInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().RestartDBInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -129,43 +133,30 @@ func resourceTencentCloudMysqlRestartDbInstancesOperationCreate(d *schema.Resour
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mysql restartDbInstancesOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s operate mysql restartDbInstancesOperation status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s operate mysql restartDbInstancesOperation is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = service.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mysql restartDbInstancesOperation fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMysqlRestartDbInstancesOperationRead(d, meta)
+	return resourceTencentCloudMysqlRestartDbInstancesOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlRestartDbInstancesOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRestartDbInstancesOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_restart_db_instances_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -173,7 +164,7 @@ func resourceTencentCloudMysqlRestartDbInstancesOperationRead(d *schema.Resource
 
 	isolateInstance, err := service.DescribeDBInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if isolateInstance == nil {
@@ -193,7 +184,7 @@ func resourceTencentCloudMysqlRestartDbInstancesOperationRead(d *schema.Resource
 	return nil
 }
 
-func resourceTencentCloudMysqlRestartDbInstancesOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRestartDbInstancesOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_restart_db_instances_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -22,15 +22,23 @@ resource "tencentcloud_mysql_ro_group" "example" {
   is_balance_ro_load = 1
 }
 ```
+
+Import
+
+mysql ro_group can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_mysql_ro_group.example cdb-e8i766hx#cdbrg-f49t0gnj
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -39,10 +47,16 @@ import (
 
 func resourceTencentCloudMysqlRoGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlRoGroupCreate,
-		Read:   resourceTencentCloudMysqlRoGroupRead,
-		Update: resourceTencentCloudMysqlRoGroupUpdate,
-		Delete: resourceTencentCloudMysqlRoGroupDelete,
+		CreateContext: resourceTencentCloudMysqlRoGroupCreate,
+		ReadContext:   resourceTencentCloudMysqlRoGroupRead,
+		UpdateContext: resourceTencentCloudMysqlRoGroupUpdate,
+		DeleteContext: resourceTencentCloudMysqlRoGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -127,7 +141,7 @@ func resourceTencentCloudMysqlRoGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlRoGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRoGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_ro_group.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -141,30 +155,30 @@ func resourceTencentCloudMysqlRoGroupCreate(d *schema.ResourceData, meta interfa
 		roGroupId = v.(string)
 	}
 
-	d.SetId(instanceId + FILED_SP + roGroupId)
+	d.SetId(helper.IdFormat(instanceId, roGroupId))
 
-	return resourceTencentCloudMysqlRoGroupUpdate(d, meta)
+	return resourceTencentCloudMysqlRoGroupUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlRoGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRoGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_ro_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	roGroupId := idSplit[1]
 
 	roGroup, err := service.DescribeMysqlRoGroupById(ctx, instanceId, roGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if roGroup == nil {
@@ -228,21 +242,20 @@ func resourceTencentCloudMysqlRoGroupRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudMysqlRoGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRoGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_ro_group.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := mysql.NewModifyRoGroupInfoRequest()
 	response := mysql.NewModifyRoGroupInfoResponse()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
-	instanceId := idSplit[0]
 	roGroupId := idSplit[1]
 
 	request.RoGroupId = &roGroupId
@@ -294,8 +307,8 @@ func resourceTencentCloudMysqlRoGroupUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyRoGroupInfo(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyRoGroupInfoWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -306,35 +319,22 @@ func resourceTencentCloudMysqlRoGroupUpdate(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update mysql roGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s create mysql rollback status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s create mysql rollback status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = service.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mysql rollback fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMysqlRoGroupRead(d, meta)
+	return resourceTencentCloudMysqlRoGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlRoGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRoGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_ro_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -82,8 +82,11 @@ resource "tencentcloud_mysql_ro_group_load_operation" "ro_group_load_operation"
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -92,9 +95,12 @@ import (
 
 func resourceTencentCloudMysqlRoGroupLoadOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlRoGroupLoadOperationCreate,
-		Read:   resourceTencentCloudMysqlRoGroupLoadOperationRead,
-		Delete: resourceTencentCloudMysqlRoGroupLoadOperationDelete,
+		CreateContext: resourceTencentCloudMysqlRoGroupLoadOperationCreate,
+		ReadContext:   resourceTencentCloudMysqlRoGroupLoadOperationRead,
+		DeleteContext: resourceTencentCloudMysqlRoGroupLoadOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"ro_group_id": {
@@ -107,7 +113,7 @@ func resourceTencentCloudMysqlRoGroupLoadOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlRoGroupLoadOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRoGroupLoadOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_ro_group_load_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -122,8 +128,8 @@ func resourceTencentCloudMysqlRoGroupLoadOperationCreate(d *schema.ResourceData,
 		request.RoGroupId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().BalanceRoGroupLoad(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().BalanceRoGroupLoadWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -133,22 +139,22 @@ func resourceTencentCloudMysqlRoGroupLoadOperationCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mysql roGroupLoadOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(roGroupId)
 
-	return resourceTencentCloudMysqlRoGroupLoadOperationRead(d, meta)
+	return resourceTencentCloudMysqlRoGroupLoadOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlRoGroupLoadOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRoGroupLoadOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_ro_group_load_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudMysqlRoGroupLoadOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRoGroupLoadOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_ro_group_load_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
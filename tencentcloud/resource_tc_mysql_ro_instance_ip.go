@@ -27,13 +27,23 @@ resource "tencentcloud_mysql_ro_instance_ip" "example" {
   uniq_vpc_id    = tencentcloud_vpc.vpc.id
 }
 ```
+
+Import
+
+mysql ro_instance_ip can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_mysql_ro_instance_ip.example cdbro-bdlvcfpj
+```
 */
 package tencentcloud
 
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -42,9 +52,15 @@ import (
 
 func resourceTencentCloudMysqlRoInstanceIp() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlRoInstanceIpCreate,
-		Read:   resourceTencentCloudMysqlRoInstanceIpRead,
-		Delete: resourceTencentCloudMysqlRoInstanceIpDelete,
+		CreateContext: resourceTencentCloudMysqlRoInstanceIpCreate,
+		ReadContext:   resourceTencentCloudMysqlRoInstanceIpRead,
+		DeleteContext: resourceTencentCloudMysqlRoInstanceIpDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -83,7 +99,7 @@ func resourceTencentCloudMysqlRoInstanceIp() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlRoInstanceIpCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRoInstanceIpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_ro_instance_ip.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -106,8 +122,8 @@ func resourceTencentCloudMysqlRoInstanceIpCreate(d *schema.ResourceData, meta in
 		request.UniqVpcId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateRoInstanceIp(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateRoInstanceIpWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -117,20 +133,20 @@ func resourceTencentCloudMysqlRoInstanceIpCreate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mysql roInstanceIp failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudMysqlRoInstanceIpRead(d, meta)
+	return resourceTencentCloudMysqlRoInstanceIpRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlRoInstanceIpRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRoInstanceIpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_ro_instance_ip.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -138,7 +154,7 @@ func resourceTencentCloudMysqlRoInstanceIpRead(d *schema.ResourceData, meta inte
 
 	switchForUpgrade, err := service.DescribeDBInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if switchForUpgrade == nil {
@@ -172,7 +188,7 @@ func resourceTencentCloudMysqlRoInstanceIpRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudMysqlRoInstanceIpDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRoInstanceIpDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_ro_instance_ip.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -80,9 +80,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -91,9 +92,12 @@ import (
 
 func resourceTencentCloudMysqlRoStartReplication() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlRoStartReplicationCreate,
-		Read:   resourceTencentCloudMysqlRoStartReplicationRead,
-		Delete: resourceTencentCloudMysqlRoStartReplicationDelete,
+		CreateContext: resourceTencentCloudMysqlRoStartReplicationCreate,
+		ReadContext:   resourceTencentCloudMysqlRoStartReplicationRead,
+		DeleteContext: resourceTencentCloudMysqlRoStartReplicationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -106,12 +110,12 @@ func resourceTencentCloudMysqlRoStartReplication() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlRoStartReplicationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRoStartReplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_ro_start_replication.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request    = mysql.NewStartReplicationRequest()
@@ -123,8 +127,8 @@ func resourceTencentCloudMysqlRoStartReplicationCreate(d *schema.ResourceData, m
 		request.InstanceId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().StartReplication(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().StartReplicationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -135,44 +139,31 @@ func resourceTencentCloudMysqlRoStartReplicationCreate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mysql roStartReplication failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s start mysql roStopReplication status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s start mysql roStopReplication status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = service.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s start mysql roStopReplication fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMysqlRoStartReplicationRead(d, meta)
+	return resourceTencentCloudMysqlRoStartReplicationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlRoStartReplicationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRoStartReplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_ro_start_replication.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudMysqlRoStartReplicationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRoStartReplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_ro_start_replication.delete")()
 	defer inconsistentCheck(d, meta)()
 
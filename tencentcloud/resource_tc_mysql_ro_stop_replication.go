@@ -80,9 +80,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -91,9 +92,12 @@ import (
 
 func resourceTencentCloudMysqlRoStopReplication() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlRoStopReplicationCreate,
-		Read:   resourceTencentCloudMysqlRoStopReplicationRead,
-		Delete: resourceTencentCloudMysqlRoStopReplicationDelete,
+		CreateContext: resourceTencentCloudMysqlRoStopReplicationCreate,
+		ReadContext:   resourceTencentCloudMysqlRoStopReplicationRead,
+		DeleteContext: resourceTencentCloudMysqlRoStopReplicationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -106,12 +110,12 @@ func resourceTencentCloudMysqlRoStopReplication() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlRoStopReplicationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRoStopReplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_ro_stop_replication.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request    = mysql.NewStopReplicationRequest()
@@ -123,8 +127,8 @@ func resourceTencentCloudMysqlRoStopReplicationCreate(d *schema.ResourceData, me
 		request.InstanceId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().StopReplication(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().StopReplicationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -135,44 +139,31 @@ func resourceTencentCloudMysqlRoStopReplicationCreate(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mysql roStopReplication failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s stop mysql roStopReplication status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s stop mysql roStopReplication status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = service.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s stop mysql roStopReplication fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMysqlRoStopReplicationRead(d, meta)
+	return resourceTencentCloudMysqlRoStopReplicationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlRoStopReplicationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRoStopReplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_ro_stop_replication.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudMysqlRoStopReplicationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRoStopReplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_ro_stop_replication.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -79,10 +79,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -91,9 +91,12 @@ import (
 
 func resourceTencentCloudMysqlRollback() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlRollbackCreate,
-		Read:   resourceTencentCloudMysqlRollbackRead,
-		Delete: resourceTencentCloudMysqlRollbackDelete,
+		CreateContext: resourceTencentCloudMysqlRollbackCreate,
+		ReadContext:   resourceTencentCloudMysqlRollbackRead,
+		DeleteContext: resourceTencentCloudMysqlRollbackDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -176,12 +179,12 @@ func resourceTencentCloudMysqlRollback() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlRollbackCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRollbackCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_rollback.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request               = mysql.NewStartBatchRollbackRequest()
@@ -239,8 +242,8 @@ func resourceTencentCloudMysqlRollbackCreate(d *schema.ResourceData, meta interf
 	}
 	request.Instances = append(request.Instances, &rollbackInstancesInfo)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().StartBatchRollback(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().StartBatchRollbackWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -251,55 +254,42 @@ func resourceTencentCloudMysqlRollbackCreate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mysql rollback failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	asyncRequestId := *response.Response.AsyncRequestId
-	d.SetId(instanceId + FILED_SP + asyncRequestId)
+	d.SetId(helper.IdFormat(instanceId, asyncRequestId))
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s create mysql rollback status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s create mysql rollback status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = service.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mysql rollback fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMysqlRollbackRead(d, meta)
+	return resourceTencentCloudMysqlRollbackRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlRollbackRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRollbackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_rollback.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	asyncRequestId := idSplit[1]
 
 	rollbacks, err := service.DescribeMysqlRollbackById(ctx, instanceId, asyncRequestId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if rollbacks == nil {
@@ -379,7 +369,7 @@ func resourceTencentCloudMysqlRollbackRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudMysqlRollbackDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlRollbackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_rollback.delete")()
 	defer inconsistentCheck(d, meta)()
 
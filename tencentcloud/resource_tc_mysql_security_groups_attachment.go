@@ -70,10 +70,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -82,11 +82,14 @@ import (
 
 func resourceTencentCloudMysqlSecurityGroupsAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlSecurityGroupsAttachmentCreate,
-		Read:   resourceTencentCloudMysqlSecurityGroupsAttachmentRead,
-		Delete: resourceTencentCloudMysqlSecurityGroupsAttachmentDelete,
+		CreateContext: resourceTencentCloudMysqlSecurityGroupsAttachmentCreate,
+		ReadContext:   resourceTencentCloudMysqlSecurityGroupsAttachmentRead,
+		DeleteContext: resourceTencentCloudMysqlSecurityGroupsAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"security_group_id": {
@@ -106,7 +109,7 @@ func resourceTencentCloudMysqlSecurityGroupsAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlSecurityGroupsAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlSecurityGroupsAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_security_groups_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -127,8 +130,8 @@ func resourceTencentCloudMysqlSecurityGroupsAttachmentCreate(d *schema.ResourceD
 		request.InstanceIds = []*string{helper.String(v.(string))}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().AssociateSecurityGroups(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().AssociateSecurityGroupsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -138,34 +141,34 @@ func resourceTencentCloudMysqlSecurityGroupsAttachmentCreate(d *schema.ResourceD
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create mysql securityGroupsAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(securityGroupId + FILED_SP + instanceId)
+	d.SetId(helper.IdFormat(securityGroupId, instanceId))
 
-	return resourceTencentCloudMysqlSecurityGroupsAttachmentRead(d, meta)
+	return resourceTencentCloudMysqlSecurityGroupsAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlSecurityGroupsAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlSecurityGroupsAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_security_groups_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	securityGroupId := idSplit[0]
 	instanceId := idSplit[1]
 
 	securityGroupsAttachment, err := service.DescribeMysqlSecurityGroupsAttachmentById(ctx, securityGroupId, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if securityGroupsAttachment == nil {
@@ -182,23 +185,23 @@ func resourceTencentCloudMysqlSecurityGroupsAttachmentRead(d *schema.ResourceDat
 	return nil
 }
 
-func resourceTencentCloudMysqlSecurityGroupsAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlSecurityGroupsAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_security_groups_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	securityGroupId := idSplit[0]
 	instanceId := idSplit[1]
 
 	if err := service.DeleteMysqlSecurityGroupsAttachmentById(ctx, securityGroupId, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -63,7 +63,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -72,9 +74,12 @@ import (
 
 func resourceTencentCloudMysqlSwitchForUpgrade() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlSwitchForUpgradeCreate,
-		Read:   resourceTencentCloudMysqlSwitchForUpgradeRead,
-		Delete: resourceTencentCloudMysqlSwitchForUpgradeDelete,
+		CreateContext: resourceTencentCloudMysqlSwitchForUpgradeCreate,
+		ReadContext:   resourceTencentCloudMysqlSwitchForUpgradeRead,
+		DeleteContext: resourceTencentCloudMysqlSwitchForUpgradeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(21 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -87,12 +92,12 @@ func resourceTencentCloudMysqlSwitchForUpgrade() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlSwitchForUpgradeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlSwitchForUpgradeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_switch_for_upgrade.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request    = mysql.NewSwitchForUpgradeRequest()
@@ -103,8 +108,8 @@ func resourceTencentCloudMysqlSwitchForUpgradeCreate(d *schema.ResourceData, met
 		request.InstanceId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().SwitchForUpgrade(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().SwitchForUpgradeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -114,13 +119,13 @@ func resourceTencentCloudMysqlSwitchForUpgradeCreate(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s mysql switchForUpgrade failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		mysqlInfo, err := service.DescribeDBInstanceById(ctx, instanceId)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -141,19 +146,19 @@ func resourceTencentCloudMysqlSwitchForUpgradeCreate(d *schema.ResourceData, met
 
 	if err != nil {
 		log.Printf("[CRITAL]%s mysql switchForUpgrade fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMysqlSwitchForUpgradeRead(d, meta)
+	return resourceTencentCloudMysqlSwitchForUpgradeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlSwitchForUpgradeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlSwitchForUpgradeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_switch_for_upgrade.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -161,7 +166,7 @@ func resourceTencentCloudMysqlSwitchForUpgradeRead(d *schema.ResourceData, meta
 
 	switchForUpgrade, err := service.DescribeDBInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if switchForUpgrade == nil {
@@ -177,7 +182,7 @@ func resourceTencentCloudMysqlSwitchForUpgradeRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudMysqlSwitchForUpgradeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlSwitchForUpgradeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_switch_for_upgrade.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -73,9 +73,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -84,9 +85,12 @@ import (
 
 func resourceTencentCloudMysqlSwitchMasterSlaveOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlSwitchMasterSlaveOperationCreate,
-		Read:   resourceTencentCloudMysqlSwitchMasterSlaveOperationRead,
-		Delete: resourceTencentCloudMysqlSwitchMasterSlaveOperationDelete,
+		CreateContext: resourceTencentCloudMysqlSwitchMasterSlaveOperationCreate,
+		ReadContext:   resourceTencentCloudMysqlSwitchMasterSlaveOperationRead,
+		DeleteContext: resourceTencentCloudMysqlSwitchMasterSlaveOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -120,12 +124,12 @@ func resourceTencentCloudMysqlSwitchMasterSlaveOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlSwitchMasterSlaveOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlSwitchMasterSlaveOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_switch_master_slave_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request    = mysql.NewSwitchDBInstanceMasterSlaveRequest()
@@ -149,8 +153,8 @@ func resourceTencentCloudMysqlSwitchMasterSlaveOperationCreate(d *schema.Resourc
 		request.WaitSwitch = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().SwitchDBInstanceMasterSlave(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().SwitchDBInstanceMasterSlaveWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -161,44 +165,31 @@ func resourceTencentCloudMysqlSwitchMasterSlaveOperationCreate(d *schema.Resourc
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mysql switchMasterSlaveOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s operate mysql switchMasterSlaveOperation status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s operate mysql switchMasterSlaveOperation status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = service.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mysql switchMasterSlaveOperation fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMysqlSwitchMasterSlaveOperationRead(d, meta)
+	return resourceTencentCloudMysqlSwitchMasterSlaveOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlSwitchMasterSlaveOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlSwitchMasterSlaveOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_switch_master_slave_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudMysqlSwitchMasterSlaveOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlSwitchMasterSlaveOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_switch_master_slave_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -83,7 +83,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -92,9 +94,12 @@ import (
 
 func resourceTencentCloudMysqlSwitchProxy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlSwitchProxyCreate,
-		Read:   resourceTencentCloudMysqlSwitchProxyRead,
-		Delete: resourceTencentCloudMysqlSwitchProxyDelete,
+		CreateContext: resourceTencentCloudMysqlSwitchProxyCreate,
+		ReadContext:   resourceTencentCloudMysqlSwitchProxyRead,
+		DeleteContext: resourceTencentCloudMysqlSwitchProxyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -114,12 +119,12 @@ func resourceTencentCloudMysqlSwitchProxy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlSwitchProxyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlSwitchProxyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_switch_proxy.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request      = mysql.NewSwitchCDBProxyRequest()
@@ -136,8 +141,8 @@ func resourceTencentCloudMysqlSwitchProxyCreate(d *schema.ResourceData, meta int
 		request.ProxyGroupId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().SwitchCDBProxy(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().SwitchCDBProxyWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -147,13 +152,13 @@ func resourceTencentCloudMysqlSwitchProxyCreate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mysql switchProxy failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(instanceId + FILED_SP + proxyGroupId)
+	d.SetId(helper.IdFormat(instanceId, proxyGroupId))
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		proxy, err := service.DescribeMysqlProxyById(ctx, instanceId, proxyGroupId)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -167,20 +172,20 @@ func resourceTencentCloudMysqlSwitchProxyCreate(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s Switch mysql proxy fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMysqlSwitchProxyRead(d, meta)
+	return resourceTencentCloudMysqlSwitchProxyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlSwitchProxyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlSwitchProxyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_switch_proxy.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudMysqlSwitchProxyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlSwitchProxyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_switch_proxy.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -82,7 +82,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -91,12 +93,15 @@ import (
 
 func resourceTencentCloudMysqlTimeWindow() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlTimeWindowCreate,
-		Read:   resourceTencentCloudMysqlTimeWindowRead,
-		Update: resourceTencentCloudMysqlTimeWindowUpdate,
-		Delete: resourceTencentCloudMysqlTimeWindowDelete,
+		CreateContext: resourceTencentCloudMysqlTimeWindowCreate,
+		ReadContext:   resourceTencentCloudMysqlTimeWindowRead,
+		UpdateContext: resourceTencentCloudMysqlTimeWindowUpdate,
+		DeleteContext: resourceTencentCloudMysqlTimeWindowDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -132,22 +137,22 @@ func resourceTencentCloudMysqlTimeWindow() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlTimeWindowCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlTimeWindowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_time_window.create")()
 	defer inconsistentCheck(d, meta)()
 
 	d.SetId(d.Get("instance_id").(string))
 
-	return resourceTencentCloudMysqlTimeWindowUpdate(d, meta)
+	return resourceTencentCloudMysqlTimeWindowUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlTimeWindowRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlTimeWindowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_time_window.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -155,7 +160,7 @@ func resourceTencentCloudMysqlTimeWindowRead(d *schema.ResourceData, meta interf
 
 	timeWindow, err := service.DescribeMysqlTimeWindowById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if timeWindow == nil {
@@ -215,7 +220,7 @@ func resourceTencentCloudMysqlTimeWindowRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudMysqlTimeWindowUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlTimeWindowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_time_window.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -249,8 +254,8 @@ func resourceTencentCloudMysqlTimeWindowUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyTimeWindow(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyTimeWindowWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -261,24 +266,24 @@ func resourceTencentCloudMysqlTimeWindowUpdate(d *schema.ResourceData, meta inte
 	if err != nil {
 		d.SetId("")
 		log.Printf("[CRITAL]%s update mysql timeWindow failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMysqlTimeWindowRead(d, meta)
+	return resourceTencentCloudMysqlTimeWindowRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlTimeWindowDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlTimeWindowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_time_window.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instanceId := d.Id()
 
 	if err := service.DeleteMysqlTimeWindowById(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -63,9 +63,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mysql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -74,9 +75,12 @@ import (
 
 func resourceTencentCloudMysqlVerifyRootAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlVerifyRootAccountCreate,
-		Read:   resourceTencentCloudMysqlVerifyRootAccountRead,
-		Delete: resourceTencentCloudMysqlVerifyRootAccountDelete,
+		CreateContext: resourceTencentCloudMysqlVerifyRootAccountCreate,
+		ReadContext:   resourceTencentCloudMysqlVerifyRootAccountRead,
+		DeleteContext: resourceTencentCloudMysqlVerifyRootAccountDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -96,12 +100,12 @@ func resourceTencentCloudMysqlVerifyRootAccount() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudMysqlVerifyRootAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlVerifyRootAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_verify_root_account.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request    = mysql.NewVerifyRootAccountRequest()
@@ -117,8 +121,8 @@ func resourceTencentCloudMysqlVerifyRootAccountCreate(d *schema.ResourceData, me
 		request.Password = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().VerifyRootAccount(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().VerifyRootAccountWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -129,44 +133,31 @@ func resourceTencentCloudMysqlVerifyRootAccountCreate(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate mysql verifyRootAccount failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s verify rootAccount status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s verify rootAccount status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = service.WaitForAsyncRequest(ctx, asyncRequestId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s verify rootAccount fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudMysqlVerifyRootAccountRead(d, meta)
+	return resourceTencentCloudMysqlVerifyRootAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudMysqlVerifyRootAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlVerifyRootAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_verify_root_account.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudMysqlVerifyRootAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudMysqlVerifyRootAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_mysql_verify_root_account.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -50,6 +50,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -58,12 +59,17 @@ import (
 
 func resourceTencentCloudNatGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudNatGatewayCreate,
-		Read:   resourceTencentCloudNatGatewayRead,
-		Update: resourceTencentCloudNatGatewayUpdate,
-		Delete: resourceTencentCloudNatGatewayDelete,
+		CreateContext: resourceTencentCloudNatGatewayCreate,
+		ReadContext:   resourceTencentCloudNatGatewayRead,
+		UpdateContext: resourceTencentCloudNatGatewayUpdate,
+		DeleteContext: resourceTencentCloudNatGatewayDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -124,7 +130,7 @@ func resourceTencentCloudNatGateway() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudNatGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudNatGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_nat_gateway.create")()
 
 	logId := getLogId(contextNil)
@@ -162,8 +168,8 @@ func resourceTencentCloudNatGatewayCreate(d *schema.ResourceData, meta interface
 	}
 
 	var response *vpc.CreateNatGatewayResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateNatGateway(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateNatGatewayWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -174,30 +180,30 @@ func resourceTencentCloudNatGatewayCreate(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create NAT gateway failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(response.Response.NatGatewaySet) < 1 {
-		return fmt.Errorf("NAT gateway ID is nil")
+		return diag.Errorf("NAT gateway ID is nil")
 	}
 	d.SetId(*response.Response.NatGatewaySet[0].NatGatewayId)
 
 	//cs::vpc:ap-guangzhou:uin/12345:nat/nat-nxxx
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		resourceName := BuildTagResourceName("vpc", "nat", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	// must wait for finishing creating NAT
 	statRequest := vpc.NewDescribeNatGatewaysRequest()
 	statRequest.NatGatewayIds = []*string{response.Response.NatGatewaySet[0].NatGatewayId}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeNatGateways(statRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeNatGatewaysWithContext(ctx, statRequest)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -219,24 +225,24 @@ func resourceTencentCloudNatGatewayCreate(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create NAT gateway failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceTencentCloudNatGatewayRead(d, meta)
+	return resourceTencentCloudNatGatewayRead(ctx, d, meta)
 }
 
-func resourceTencentCloudNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudNatGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_nat_gateway.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	natGatewayId := d.Id()
 	request := vpc.NewDescribeNatGatewaysRequest()
 	request.NatGatewayIds = []*string{&natGatewayId}
 	var response *vpc.DescribeNatGatewaysResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeNatGateways(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeNatGatewaysWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -247,7 +253,7 @@ func resourceTencentCloudNatGatewayRead(d *schema.ResourceData, meta interface{}
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read NAT gateway failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if len(response.Response.NatGatewaySet) < 1 {
 		d.SetId("")
@@ -268,25 +274,25 @@ func resourceTencentCloudNatGatewayRead(d *schema.ResourceData, meta interface{}
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "vpc", "nat", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudNatGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_nat_gateway.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	vpcService := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	immutableArgs := []string{"zone"}
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -306,8 +312,8 @@ func resourceTencentCloudNatGatewayUpdate(d *schema.ResourceData, meta interface
 		changed = true
 	}
 	if changed {
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyNatGatewayAttribute(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyNatGatewayAttributeWithContext(ctx, request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -317,7 +323,7 @@ func resourceTencentCloudNatGatewayUpdate(d *schema.ResourceData, meta interface
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s modify NAT gateway failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	//max concurrent
@@ -327,8 +333,8 @@ func resourceTencentCloudNatGatewayUpdate(d *schema.ResourceData, meta interface
 		concurrent := d.Get("max_concurrent").(int)
 		concurrent64 := uint64(concurrent)
 		concurrentReq.MaxConcurrentConnection = &concurrent64
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ResetNatGatewayConnection(concurrentReq)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ResetNatGatewayConnectionWithContext(ctx, concurrentReq)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, concurrentReq.GetAction(), concurrentReq.ToJsonString(), e.Error())
@@ -338,7 +344,7 @@ func resourceTencentCloudNatGatewayUpdate(d *schema.ResourceData, meta interface
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s modify NAT gateway concurrent failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -385,7 +391,7 @@ func resourceTencentCloudNatGatewayUpdate(d *schema.ResourceData, meta interface
 
 				if len(unassignedRequest.PublicIpAddresses) > 0 {
 					var response *vpc.DisassociateNatGatewayAddressResponseParams
-					err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+					err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 						result, e := vpcService.DisassociateNatGatewayAddress(ctx, unassignedRequest)
 						if e != nil {
 							return retryError(e)
@@ -397,13 +403,13 @@ func resourceTencentCloudNatGatewayUpdate(d *schema.ResourceData, meta interface
 					})
 					if err != nil {
 						log.Printf("[CRITAL]%s modify NAT gateway EIP failed, reason:%s\n", logId, err.Error())
-						return err
+						return diag.FromErr(err)
 					}
 
 					if response != nil && response.RequestId != nil {
 						err = vpcService.DescribeVpcTaskResult(ctx, response.RequestId)
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 					}
 				}
@@ -433,8 +439,8 @@ func resourceTencentCloudNatGatewayUpdate(d *schema.ResourceData, meta interface
 				}
 				if len(assignedRequest.PublicIpAddresses) > 0 {
 					var response *vpc.AssociateNatGatewayAddressResponseParams
-					err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-						result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AssociateNatGatewayAddress(assignedRequest)
+					err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+						result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AssociateNatGatewayAddressWithContext(ctx, assignedRequest)
 						if e != nil {
 							log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 								logId, assignedRequest.GetAction(), assignedRequest.ToJsonString(), e.Error())
@@ -447,13 +453,13 @@ func resourceTencentCloudNatGatewayUpdate(d *schema.ResourceData, meta interface
 					})
 					if err != nil {
 						log.Printf("[CRITAL]%s modify NAT gateway EIP failed, reason:%s\n", logId, err.Error())
-						return err
+						return diag.FromErr(err)
 					}
 
 					if response != nil && response.RequestId != nil {
 						err = vpcService.DescribeVpcTaskResult(ctx, response.RequestId)
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 					}
 				}
@@ -465,7 +471,7 @@ func resourceTencentCloudNatGatewayUpdate(d *schema.ResourceData, meta interface
 				unassignedRequest := vpc.NewDisassociateNatGatewayAddressRequest()
 				unassignedRequest.NatGatewayId = &natGatewayId
 				unassignedRequest.PublicIpAddresses = []*string{&backUpOldIp}
-				err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+				err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 					result, e := vpcService.DisassociateNatGatewayAddress(ctx, unassignedRequest)
 					if e != nil {
 						return retryError(e)
@@ -477,12 +483,12 @@ func resourceTencentCloudNatGatewayUpdate(d *schema.ResourceData, meta interface
 				})
 				if err != nil {
 					log.Printf("[CRITAL]%s modify NAT gateway EIP failed, reason:%s\n", logId, err.Error())
-					return err
+					return diag.FromErr(err)
 				}
 				if response != nil && response.RequestId != nil {
 					err = vpcService.DescribeVpcTaskResult(ctx, response.RequestId)
 					if err != nil {
-						return err
+						return diag.FromErr(err)
 					}
 				}
 			}
@@ -492,8 +498,8 @@ func resourceTencentCloudNatGatewayUpdate(d *schema.ResourceData, meta interface
 				assignedRequest := vpc.NewAssociateNatGatewayAddressRequest()
 				assignedRequest.NatGatewayId = &natGatewayId
 				assignedRequest.PublicIpAddresses = []*string{&backUpNewIp}
-				err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-					result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AssociateNatGatewayAddress(assignedRequest)
+				err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+					result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AssociateNatGatewayAddressWithContext(ctx, assignedRequest)
 					if e != nil {
 						log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 							logId, assignedRequest.GetAction(), assignedRequest.ToJsonString(), e.Error())
@@ -506,12 +512,12 @@ func resourceTencentCloudNatGatewayUpdate(d *schema.ResourceData, meta interface
 				})
 				if err != nil {
 					log.Printf("[CRITAL]%s modify NAT gateway EIP failed, reason:%s\n", logId, err.Error())
-					return err
+					return diag.FromErr(err)
 				}
 				if response != nil && response.RequestId != nil {
 					err = vpcService.DescribeVpcTaskResult(ctx, response.RequestId)
 					if err != nil {
-						return err
+						return diag.FromErr(err)
 					}
 				}
 			}
@@ -529,16 +535,16 @@ func resourceTencentCloudNatGatewayUpdate(d *schema.ResourceData, meta interface
 		resourceName := BuildTagResourceName("vpc", "nat", tcClient.Region, d.Id())
 		err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudNatGatewayRead(d, meta)
+	return resourceTencentCloudNatGatewayRead(ctx, d, meta)
 }
 
-func resourceTencentCloudNatGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudNatGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_nat_gateway.delete")()
 
 	logId := getLogId(contextNil)
@@ -546,8 +552,8 @@ func resourceTencentCloudNatGatewayDelete(d *schema.ResourceData, meta interface
 	natGatewayId := d.Id()
 	request := vpc.NewDeleteNatGatewayRequest()
 	request.NatGatewayId = &natGatewayId
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DeleteNatGateway(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DeleteNatGatewayWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -557,7 +563,7 @@ func resourceTencentCloudNatGatewayDelete(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete NAT gateway failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	// must wait for finishing deleting NAT
 	time.Sleep(10 * time.Second)
@@ -565,8 +571,8 @@ func resourceTencentCloudNatGatewayDelete(d *schema.ResourceData, meta interface
 
 	statRequest := vpc.NewDescribeNatGatewaysRequest()
 	statRequest.NatGatewayIds = []*string{&natGatewayId}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeNatGateways(statRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeNatGatewaysWithContext(ctx, statRequest)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -590,7 +596,7 @@ func resourceTencentCloudNatGatewayDelete(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete NAT gateway failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
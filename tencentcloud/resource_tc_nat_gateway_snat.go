@@ -135,6 +135,7 @@ import (
 	"errors"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -142,12 +143,12 @@ import (
 
 func resourceTencentCloudNatGatewaySnat() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudNatGatewaySnatCreate,
-		Read:   resourceTencentCloudNatGatewaySnatRead,
-		Update: resourceTencentCloudNatGatewaySnatUpdate,
-		Delete: resourceTencentCloudNatGatewaySnatDelete,
+		CreateContext: resourceTencentCloudNatGatewaySnatCreate,
+		ReadContext:   resourceTencentCloudNatGatewaySnatRead,
+		UpdateContext: resourceTencentCloudNatGatewaySnatUpdate,
+		DeleteContext: resourceTencentCloudNatGatewaySnatDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: NatGatewaySnatPara(),
@@ -216,20 +217,20 @@ func NatGatewaySnatPara() map[string]*schema.Schema {
 	}
 }
 
-func resourceTencentCloudNatGatewaySnatCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudNatGatewaySnatCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_nat_gateway_snat.create")()
 
 	var (
 		logId        = getLogId(contextNil)
-		ctx          = context.WithValue(context.TODO(), logIdKey, logId)
 		vpcService   = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		natGatewayId = d.Get("nat_gateway_id").(string)
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	// param valid
 	err := paramValid(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	snat := getSnat(d)
@@ -237,7 +238,7 @@ func resourceTencentCloudNatGatewaySnatCreate(d *schema.ResourceData, meta inter
 	err = vpcService.CreateNatGatewaySnat(ctx, natGatewayId, snat)
 	if err != nil {
 		log.Printf("[CRITAL]%s create nat gateway snat failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	err, result := vpcService.DescribeNatGatewaySnats(ctx, natGatewayId, []*vpc.Filter{{
@@ -251,36 +252,36 @@ func resourceTencentCloudNatGatewaySnatCreate(d *schema.ResourceData, meta inter
 		Values: []*string{snat.Description},
 	}})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(result) == 0 {
-		return errors.New("[CRITAL] create nat gateway snat failed: read result is empty")
+		return diag.FromErr(errors.New("[CRITAL] create nat gateway snat failed: read result is empty"))
 	}
 	rule := result[len(result)-1]
 	d.SetId(helper.IdFormat(*rule.NatGatewayId, *rule.ResourceId))
 
-	return resourceTencentCloudNatGatewaySnatRead(d, meta)
+	return resourceTencentCloudNatGatewaySnatRead(ctx, d, meta)
 }
 
-func resourceTencentCloudNatGatewaySnatRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudNatGatewaySnatRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_nat_gateway_snat.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id      = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	compositeId := helper.IdParse(id)
 	if len(compositeId) != 2 {
-		return errors.New("the id format must be '{nat_gateway_id}#{resource_id}'")
+		return diag.FromErr(errors.New("the id format must be '{nat_gateway_id}#{resource_id}'"))
 	}
 
 	err, snatList := service.DescribeNatGatewaySnats(ctx, compositeId[0], nil)
 	if err != nil {
 		log.Printf("[CRITAL]%s read nat gateway snat failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	var snat *vpc.SourceIpTranslationNatRule
 	for _, s := range snatList {
@@ -309,24 +310,24 @@ func resourceTencentCloudNatGatewaySnatRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudNatGatewaySnatUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudNatGatewaySnatUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_gateway_route.update")()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id      = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	compositeId := helper.IdParse(id)
 	if len(compositeId) != 2 {
-		return errors.New("the id format must be '{nat_gateway_id}#{snat_id}'")
+		return diag.FromErr(errors.New("the id format must be '{nat_gateway_id}#{snat_id}'"))
 	}
 
 	// param valid
 	err := paramValid(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// update
@@ -334,27 +335,27 @@ func resourceTencentCloudNatGatewaySnatUpdate(d *schema.ResourceData, meta inter
 	err = service.ModifyNatGatewaySnat(ctx, compositeId[0], snat)
 	if err != nil {
 		log.Printf("[CRITAL]%s modify nat gateway snat failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudNatGatewaySnatRead(d, meta)
+	return resourceTencentCloudNatGatewaySnatRead(ctx, d, meta)
 }
 
-func resourceTencentCloudNatGatewaySnatDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudNatGatewaySnatDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_nat_gateway_snat.delete")()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	natGatewayId := d.Get("nat_gateway_id").(string)
 	snatId := d.Get("snat_id").(string)
 	err := service.DeleteNatGatewaySnat(ctx, natGatewayId, snatId)
 	if err != nil {
 		log.Printf("[CRITAL]%s delete nat gateway snat failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
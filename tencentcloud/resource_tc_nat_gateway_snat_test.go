@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func TestAccTencentCloudNatGatewaySnat_basic(t *testing.T) {
@@ -52,7 +52,7 @@ func testAccCheckNatGatewaySnatDestroy(s *terraform.State) error {
 			return fmt.Errorf("nat gateway snat id is not set")
 		}
 
-		ids := strings.Split(rs.Primary.ID, FILED_SP)
+		ids := helper.IdParse(rs.Primary.ID)
 
 		err, result := service.DescribeNatGatewaySnats(contextNil, ids[0], nil)
 		if err != nil {
@@ -80,7 +80,7 @@ func testAccCheckNatGatewaySnatExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("nat gateway snat id is not set")
 		}
 
-		ids := strings.Split(rs.Primary.ID, FILED_SP)
+		ids := helper.IdParse(rs.Primary.ID)
 
 		service := VpcService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
 		err, result := service.DescribeNatGatewaySnats(contextNil, ids[0], nil)
@@ -54,8 +54,11 @@ terraform import tencentcloud_nat_refresh_nat_dc_route.refresh_nat_dc_route vpc_
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -64,11 +67,14 @@ import (
 
 func resourceTencentCloudNatRefreshNatDcRoute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudNatRefreshNatDcRouteCreate,
-		Read:   resourceTencentCloudNatRefreshNatDcRouteRead,
-		Delete: resourceTencentCloudNatRefreshNatDcRouteDelete,
+		CreateContext: resourceTencentCloudNatRefreshNatDcRouteCreate,
+		ReadContext:   resourceTencentCloudNatRefreshNatDcRouteRead,
+		DeleteContext: resourceTencentCloudNatRefreshNatDcRouteDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -95,7 +101,7 @@ func resourceTencentCloudNatRefreshNatDcRoute() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudNatRefreshNatDcRouteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudNatRefreshNatDcRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_nat_refresh_nat_dc_route.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -120,8 +126,8 @@ func resourceTencentCloudNatRefreshNatDcRouteCreate(d *schema.ResourceData, meta
 		request.DryRun = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().RefreshDirectConnectGatewayRouteToNatGateway(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().RefreshDirectConnectGatewayRouteToNatGatewayWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -131,22 +137,22 @@ func resourceTencentCloudNatRefreshNatDcRouteCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate vpc refreshNatDcRoute failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(vpcId + FILED_SP + natGatewayId)
+	d.SetId(helper.IdFormat(vpcId, natGatewayId))
 
-	return resourceTencentCloudNatRefreshNatDcRouteRead(d, meta)
+	return resourceTencentCloudNatRefreshNatDcRouteRead(ctx, d, meta)
 }
 
-func resourceTencentCloudNatRefreshNatDcRouteRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudNatRefreshNatDcRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_nat_refresh_nat_dc_route.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudNatRefreshNatDcRouteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudNatRefreshNatDcRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_nat_refresh_nat_dc_route.delete")()
 	defer inconsistentCheck(d, meta)()
 
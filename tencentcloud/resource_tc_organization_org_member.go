@@ -29,9 +29,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	organization "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/organization/v20210331"
@@ -40,12 +41,16 @@ import (
 
 func resourceTencentCloudOrganizationOrgMember() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudOrganizationOrgMemberRead,
-		Create: resourceTencentCloudOrganizationOrgMemberCreate,
-		Update: resourceTencentCloudOrganizationOrgMemberUpdate,
-		Delete: resourceTencentCloudOrganizationOrgMemberDelete,
+		ReadContext:   resourceTencentCloudOrganizationOrgMemberRead,
+		CreateContext: resourceTencentCloudOrganizationOrgMemberCreate,
+		UpdateContext: resourceTencentCloudOrganizationOrgMemberUpdate,
+		DeleteContext: resourceTencentCloudOrganizationOrgMemberDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -158,7 +163,7 @@ func resourceTencentCloudOrganizationOrgMember() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudOrganizationOrgMemberCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudOrganizationOrgMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_organization_org_member.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -203,8 +208,8 @@ func resourceTencentCloudOrganizationOrgMemberCreate(d *schema.ResourceData, met
 		request.PayUin = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseOrganizationClient().CreateOrganizationMember(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseOrganizationClient().CreateOrganizationMemberWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -217,21 +222,21 @@ func resourceTencentCloudOrganizationOrgMemberCreate(d *schema.ResourceData, met
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create organization orgMember failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	uin = *response.Response.Uin
 
 	d.SetId(helper.Int64ToStr(uin))
-	return resourceTencentCloudOrganizationOrgMemberRead(d, meta)
+	return resourceTencentCloudOrganizationOrgMemberRead(ctx, d, meta)
 }
 
-func resourceTencentCloudOrganizationOrgMemberRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudOrganizationOrgMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_organization_org_member.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := OrganizationService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -240,12 +245,12 @@ func resourceTencentCloudOrganizationOrgMemberRead(d *schema.ResourceData, meta
 	orgMember, err := service.DescribeOrganizationOrgMember(ctx, orgMemberId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if orgMember == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `orgMember` %s does not exist", orgMemberId)
+		return diag.Errorf("resource `orgMember` %s does not exist", orgMemberId)
 	}
 
 	if orgMember.Name != nil {
@@ -325,7 +330,7 @@ func resourceTencentCloudOrganizationOrgMemberRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudOrganizationOrgMemberUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudOrganizationOrgMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_organization_org_member.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -339,19 +344,19 @@ func resourceTencentCloudOrganizationOrgMemberUpdate(d *schema.ResourceData, met
 
 	if d.HasChange("name") {
 
-		return fmt.Errorf("`name` do not support change now.")
+		return diag.Errorf("`name` do not support change now.")
 
 	}
 
 	if d.HasChange("policy_type") {
 
-		return fmt.Errorf("`policy_type` do not support change now.")
+		return diag.Errorf("`policy_type` do not support change now.")
 
 	}
 
 	if d.HasChange("permission_ids") {
 
-		return fmt.Errorf("`permission_ids` do not support change now.")
+		return diag.Errorf("`permission_ids` do not support change now.")
 
 	}
 
@@ -363,24 +368,24 @@ func resourceTencentCloudOrganizationOrgMemberUpdate(d *schema.ResourceData, met
 
 	if d.HasChange("remark") {
 
-		return fmt.Errorf("`remark` do not support change now.")
+		return diag.Errorf("`remark` do not support change now.")
 
 	}
 
 	if d.HasChange("record_id") {
 
-		return fmt.Errorf("`record_id` do not support change now.")
+		return diag.Errorf("`record_id` do not support change now.")
 
 	}
 
 	if d.HasChange("pay_uin") {
 
-		return fmt.Errorf("`pay_uin` do not support change now.")
+		return diag.Errorf("`pay_uin` do not support change now.")
 
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseOrganizationClient().MoveOrganizationNodeMembers(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseOrganizationClient().MoveOrganizationNodeMembersWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -392,25 +397,25 @@ func resourceTencentCloudOrganizationOrgMemberUpdate(d *schema.ResourceData, met
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create organization orgMember failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudOrganizationOrgMemberRead(d, meta)
+	return resourceTencentCloudOrganizationOrgMemberRead(ctx, d, meta)
 }
 
-func resourceTencentCloudOrganizationOrgMemberDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudOrganizationOrgMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_organization_org_member.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := OrganizationService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	orgMemberId := d.Id()
 
 	if err := service.DeleteOrganizationOrgMemberById(ctx, orgMemberId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -23,9 +23,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	organization "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/organization/v20210331"
@@ -34,12 +35,16 @@ import (
 
 func resourceTencentCloudOrganizationOrgNode() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudOrganizationOrgNodeRead,
-		Create: resourceTencentCloudOrganizationOrgNodeCreate,
-		Update: resourceTencentCloudOrganizationOrgNodeUpdate,
-		Delete: resourceTencentCloudOrganizationOrgNodeDelete,
+		ReadContext:   resourceTencentCloudOrganizationOrgNodeRead,
+		CreateContext: resourceTencentCloudOrganizationOrgNodeCreate,
+		UpdateContext: resourceTencentCloudOrganizationOrgNodeUpdate,
+		DeleteContext: resourceTencentCloudOrganizationOrgNodeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"parent_node_id": {
@@ -75,7 +80,7 @@ func resourceTencentCloudOrganizationOrgNode() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudOrganizationOrgNodeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudOrganizationOrgNodeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_organization_org_node.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -101,8 +106,8 @@ func resourceTencentCloudOrganizationOrgNodeCreate(d *schema.ResourceData, meta
 		request.Remark = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseOrganizationClient().AddOrganizationNode(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseOrganizationClient().AddOrganizationNodeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -115,21 +120,21 @@ func resourceTencentCloudOrganizationOrgNodeCreate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create organization orgNode failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	nodeId = *response.Response.NodeId
 
 	d.SetId(helper.Int64ToStr(nodeId))
-	return resourceTencentCloudOrganizationOrgNodeRead(d, meta)
+	return resourceTencentCloudOrganizationOrgNodeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudOrganizationOrgNodeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudOrganizationOrgNodeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_organization_org_node.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := OrganizationService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -138,12 +143,12 @@ func resourceTencentCloudOrganizationOrgNodeRead(d *schema.ResourceData, meta in
 	orgNode, err := service.DescribeOrganizationOrgNode(ctx, orgNodeId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if orgNode == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `orgNode` %s does not exist", orgNodeId)
+		return diag.Errorf("resource `orgNode` %s does not exist", orgNodeId)
 	}
 
 	if orgNode.ParentNodeId != nil {
@@ -169,7 +174,7 @@ func resourceTencentCloudOrganizationOrgNodeRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTencentCloudOrganizationOrgNodeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudOrganizationOrgNodeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_organization_org_node.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -181,7 +186,7 @@ func resourceTencentCloudOrganizationOrgNodeUpdate(d *schema.ResourceData, meta
 	request.NodeId = helper.StrToUint64Point(orgNodeId)
 
 	if d.HasChange("parent_node_id") {
-		return fmt.Errorf("`parent_node_id` do not support change now.")
+		return diag.Errorf("`parent_node_id` do not support change now.")
 	}
 
 	if d.HasChange("name") {
@@ -196,8 +201,8 @@ func resourceTencentCloudOrganizationOrgNodeUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseOrganizationClient().UpdateOrganizationNode(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseOrganizationClient().UpdateOrganizationNodeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -209,25 +214,25 @@ func resourceTencentCloudOrganizationOrgNodeUpdate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create organization orgNode failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudOrganizationOrgNodeRead(d, meta)
+	return resourceTencentCloudOrganizationOrgNodeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudOrganizationOrgNodeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudOrganizationOrgNodeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_organization_org_node.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := OrganizationService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	orgNodeId := d.Id()
 
 	if err := service.DeleteOrganizationOrgNodeById(ctx, orgNodeId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -21,11 +21,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	organization "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/organization/v20210331"
@@ -34,11 +34,14 @@ import (
 
 func resourceTencentCloudOrganizationPolicySubAccountAttachment() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudOrganizationPolicySubAccountAttachmentRead,
-		Create: resourceTencentCloudOrganizationPolicySubAccountAttachmentCreate,
-		Delete: resourceTencentCloudOrganizationPolicySubAccountAttachmentDelete,
+		ReadContext:   resourceTencentCloudOrganizationPolicySubAccountAttachmentRead,
+		CreateContext: resourceTencentCloudOrganizationPolicySubAccountAttachmentCreate,
+		DeleteContext: resourceTencentCloudOrganizationPolicySubAccountAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"policy_id": {
@@ -107,7 +110,7 @@ func resourceTencentCloudOrganizationPolicySubAccountAttachment() *schema.Resour
 	}
 }
 
-func resourceTencentCloudOrganizationPolicySubAccountAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudOrganizationPolicySubAccountAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_organization_policy_sub_account_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -135,8 +138,8 @@ func resourceTencentCloudOrganizationPolicySubAccountAttachmentCreate(d *schema.
 		request.MemberUin = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseOrganizationClient().BindOrganizationMemberAuthAccount(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseOrganizationClient().BindOrganizationMemberAuthAccountWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -148,25 +151,25 @@ func resourceTencentCloudOrganizationPolicySubAccountAttachmentCreate(d *schema.
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create organization policySubAccountAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strconv.Itoa(policyId) + FILED_SP + strconv.Itoa(memberUin) + FILED_SP + strconv.Itoa(orgSubAccountUin))
-	return resourceTencentCloudOrganizationPolicySubAccountAttachmentRead(d, meta)
+	d.SetId(helper.IdFormat(strconv.Itoa(policyId), strconv.Itoa(memberUin), strconv.Itoa(orgSubAccountUin)))
+	return resourceTencentCloudOrganizationPolicySubAccountAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudOrganizationPolicySubAccountAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudOrganizationPolicySubAccountAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_organization_policy_sub_account_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := OrganizationService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	policyId := idSplit[0]
 	memberUin := idSplit[1]
@@ -174,12 +177,12 @@ func resourceTencentCloudOrganizationPolicySubAccountAttachmentRead(d *schema.Re
 	policySubAccountAttachment, err := service.DescribeOrganizationPolicySubAccountAttachment(ctx, policyId, memberUin)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if policySubAccountAttachment == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `policySubAccountAttachment` %s does not exist", d.Id())
+		return diag.Errorf("resource `policySubAccountAttachment` %s does not exist", d.Id())
 	}
 
 	if policySubAccountAttachment.PolicyId != nil {
@@ -223,25 +226,25 @@ func resourceTencentCloudOrganizationPolicySubAccountAttachmentRead(d *schema.Re
 	return nil
 }
 
-func resourceTencentCloudOrganizationPolicySubAccountAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudOrganizationPolicySubAccountAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_organization_policy_sub_account_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := OrganizationService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	policyId := idSplit[0]
 	memberUin := idSplit[1]
 	orgSubAccountUin := idSplit[2]
 
 	if err := service.DeleteOrganizationPolicySubAccountAttachmentById(ctx, policyId, memberUin, orgSubAccountUin); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -22,7 +22,9 @@ package tencentcloud
 
 import (
 	"context"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -30,12 +32,17 @@ import (
 
 func resourceTencentCloudPlacementGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPlacementGroupCreate,
-		Read:   resourceTencentCloudPlacementGroupRead,
-		Update: resourceTencentCloudPlacementGroupUpdate,
-		Delete: resourceTencentCloudPlacementGroupDelete,
+		CreateContext: resourceTencentCloudPlacementGroupCreate,
+		ReadContext:   resourceTencentCloudPlacementGroupRead,
+		UpdateContext: resourceTencentCloudPlacementGroupUpdate,
+		DeleteContext: resourceTencentCloudPlacementGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,10 +80,10 @@ func resourceTencentCloudPlacementGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudPlacementGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPlacementGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_placement_group.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	cvmService := CvmService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -85,7 +92,7 @@ func resourceTencentCloudPlacementGroupCreate(d *schema.ResourceData, meta inter
 	placementType := d.Get("type").(string)
 	var id string
 	var errRet error
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		id, errRet = cvmService.CreatePlacementGroup(ctx, placementName, placementType)
 		if errRet != nil {
 			return retryError(errRet)
@@ -93,19 +100,19 @@ func resourceTencentCloudPlacementGroupCreate(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(id)
 
-	return resourceTencentCloudPlacementGroupRead(d, meta)
+	return resourceTencentCloudPlacementGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPlacementGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPlacementGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_placement_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	placementId := d.Id()
 	cvmService := CvmService{
@@ -113,7 +120,7 @@ func resourceTencentCloudPlacementGroupRead(d *schema.ResourceData, meta interfa
 	}
 	var placement *cvm.DisasterRecoverGroup
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		placement, errRet = cvmService.DescribePlacementGroupById(ctx, placementId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -121,7 +128,7 @@ func resourceTencentCloudPlacementGroupRead(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if placement == nil {
 		d.SetId("")
@@ -137,10 +144,10 @@ func resourceTencentCloudPlacementGroupRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudPlacementGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPlacementGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_placement_group.update")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	placementId := d.Id()
 	cvmService := CvmService{
@@ -148,7 +155,7 @@ func resourceTencentCloudPlacementGroupUpdate(d *schema.ResourceData, meta inter
 	}
 	if d.HasChange("name") {
 		placementName := d.Get("name").(string)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			err := cvmService.ModifyPlacementGroup(ctx, placementId, placementName)
 			if err != nil {
 				return retryError(err)
@@ -156,23 +163,23 @@ func resourceTencentCloudPlacementGroupUpdate(d *schema.ResourceData, meta inter
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudPlacementGroupRead(d, meta)
+	return resourceTencentCloudPlacementGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPlacementGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPlacementGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_placement_group.delete")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	placementId := d.Id()
 	cvmService := CvmService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		err := cvmService.DeletePlacementGroup(ctx, placementId)
 		if err != nil {
 			return retryError(err)
@@ -180,7 +187,7 @@ func resourceTencentCloudPlacementGroupDelete(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
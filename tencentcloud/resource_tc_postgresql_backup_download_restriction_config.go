@@ -46,7 +46,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -55,12 +57,15 @@ import (
 
 func resourceTencentCloudPostgresqlBackupDownloadRestrictionConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigCreate,
-		Read:   resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigRead,
-		Update: resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigUpdate,
-		Delete: resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigDelete,
+		CreateContext: resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigCreate,
+		ReadContext:   resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigRead,
+		UpdateContext: resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigUpdate,
+		DeleteContext: resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"restriction_type": {
@@ -102,7 +107,7 @@ func resourceTencentCloudPostgresqlBackupDownloadRestrictionConfig() *schema.Res
 	}
 }
 
-func resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_backup_download_restriction_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -113,16 +118,16 @@ func resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigCreate(d *sche
 
 	d.SetId(resType)
 
-	return resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigUpdate(d, meta)
+	return resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_backup_download_restriction_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -130,7 +135,7 @@ func resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigRead(d *schema
 
 	BackupDownloadRestrictionConfig, err := service.DescribePostgresqlBackupDownloadRestrictionConfigById(ctx, resType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if BackupDownloadRestrictionConfig == nil {
@@ -162,7 +167,7 @@ func resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigRead(d *schema
 	return nil
 }
 
-func resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_backup_download_restriction_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -215,8 +220,8 @@ func resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigUpdate(d *sche
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyBackupDownloadRestriction(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyBackupDownloadRestrictionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -226,13 +231,13 @@ func resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigUpdate(d *sche
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update postgresql BackupDownloadRestrictionConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigRead(d, meta)
+	return resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlBackupDownloadRestrictionConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_backup_download_restriction_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
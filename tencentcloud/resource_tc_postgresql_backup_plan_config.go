@@ -26,9 +26,10 @@ package tencentcloud
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgres "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -37,12 +38,15 @@ import (
 
 func resourceTencentCloudPostgresqlBackupPlanConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlBackupPlanConfigCreate,
-		Read:   resourceTencentCloudPostgresqlBackupPlanConfigRead,
-		Update: resourceTencentCloudPostgresqlBackupPlanConfigUpdate,
-		Delete: resourceTencentCloudPostgresqlBackupPlanConfigDelete,
+		CreateContext: resourceTencentCloudPostgresqlBackupPlanConfigCreate,
+		ReadContext:   resourceTencentCloudPostgresqlBackupPlanConfigRead,
+		UpdateContext: resourceTencentCloudPostgresqlBackupPlanConfigUpdate,
+		DeleteContext: resourceTencentCloudPostgresqlBackupPlanConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"db_instance_id": {
@@ -81,7 +85,7 @@ func resourceTencentCloudPostgresqlBackupPlanConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudPostgresqlBackupPlanConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlBackupPlanConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_backup_plan_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -92,16 +96,16 @@ func resourceTencentCloudPostgresqlBackupPlanConfigCreate(d *schema.ResourceData
 
 	d.SetId(dBInstanceId)
 
-	return resourceTencentCloudPostgresqlBackupPlanConfigUpdate(d, meta)
+	return resourceTencentCloudPostgresqlBackupPlanConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlBackupPlanConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlBackupPlanConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_backup_plan_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -109,7 +113,7 @@ func resourceTencentCloudPostgresqlBackupPlanConfigRead(d *schema.ResourceData,
 
 	BackupPlanConfig, err := service.DescribePostgresqlBackupPlanConfigById(ctx, dBInstanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if BackupPlanConfig == nil {
@@ -136,7 +140,7 @@ func resourceTencentCloudPostgresqlBackupPlanConfigRead(d *schema.ResourceData,
 		var newJson interface{}
 		err := json.Unmarshal([]byte(*BackupPlanConfig.BackupPeriod), &newJson)
 		if err != nil {
-			return fmt.Errorf("convert BackupPeriod from string to interface{} failed, reason:+%v", err.Error())
+			return diag.Errorf("convert BackupPeriod from string to interface{} failed, reason:+%v", err.Error())
 		}
 		_ = d.Set("backup_period", newJson)
 
@@ -145,7 +149,7 @@ func resourceTencentCloudPostgresqlBackupPlanConfigRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudPostgresqlBackupPlanConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlBackupPlanConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_backup_plan_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -191,8 +195,8 @@ func resourceTencentCloudPostgresqlBackupPlanConfigUpdate(d *schema.ResourceData
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyBackupPlan(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyBackupPlanWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -202,13 +206,13 @@ func resourceTencentCloudPostgresqlBackupPlanConfigUpdate(d *schema.ResourceData
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update postgres BackupPlanConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudPostgresqlBackupPlanConfigRead(d, meta)
+	return resourceTencentCloudPostgresqlBackupPlanConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlBackupPlanConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlBackupPlanConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_backup_plan_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
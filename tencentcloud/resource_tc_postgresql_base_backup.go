@@ -12,6 +12,13 @@ resource "tencentcloud_postgresql_base_backup" "base_backup" {
 }
 ```
 
+Import
+
+postgresql base_backup can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_postgresql_base_backup.base_backup postgres-gzg9jb2n#20230607121123_full_2.log
+```
 */
 package tencentcloud
 
@@ -19,8 +26,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -29,10 +37,17 @@ import (
 
 func resourceTencentCloudPostgresqlBaseBackup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlBaseBackupCreate,
-		Read:   resourceTencentCloudPostgresqlBaseBackupRead,
-		Update: resourceTencentCloudPostgresqlBaseBackupUpdate,
-		Delete: resourceTencentCloudPostgresqlBaseBackupDelete,
+		CreateContext: resourceTencentCloudPostgresqlBaseBackupCreate,
+		ReadContext:   resourceTencentCloudPostgresqlBaseBackupRead,
+		UpdateContext: resourceTencentCloudPostgresqlBaseBackupUpdate,
+		DeleteContext: resourceTencentCloudPostgresqlBaseBackupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"db_instance_id": {
 				Required:    true,
@@ -62,7 +77,7 @@ func resourceTencentCloudPostgresqlBaseBackup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudPostgresqlBaseBackupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlBaseBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_base_backup.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -79,8 +94,8 @@ func resourceTencentCloudPostgresqlBaseBackupCreate(d *schema.ResourceData, meta
 		dBInstanceId = v.(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().CreateBaseBackup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().CreateBaseBackupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -91,46 +106,46 @@ func resourceTencentCloudPostgresqlBaseBackupCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create postgresql BaseBackup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	baseBackupId = *response.Response.BaseBackupId
 
-	d.SetId(strings.Join([]string{dBInstanceId, baseBackupId}, FILED_SP))
+	d.SetId(helper.IdFormat(dBInstanceId, baseBackupId))
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::postgres:%s:uin/:dbInstanceId/%s", region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudPostgresqlBaseBackupRead(d, meta)
+	return resourceTencentCloudPostgresqlBaseBackupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlBaseBackupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlBaseBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_base_backup.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	// dBInstanceId := idSplit[0]
+	dBInstanceId := idSplit[0]
 	baseBackupId := idSplit[1]
 
 	BaseBackup, err := service.DescribePostgresqlBaseBackupById(ctx, baseBackupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if BaseBackup == nil {
@@ -139,6 +154,8 @@ func resourceTencentCloudPostgresqlBaseBackupRead(d *schema.ResourceData, meta i
 		return nil
 	}
 
+	_ = d.Set("db_instance_id", dBInstanceId)
+
 	if BaseBackup.Id != nil {
 		_ = d.Set("base_backup_id", BaseBackup.Id)
 	}
@@ -151,14 +168,14 @@ func resourceTencentCloudPostgresqlBaseBackupRead(d *schema.ResourceData, meta i
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "postgres", "dbInstanceId", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudPostgresqlBaseBackupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlBaseBackupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_base_backup.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -166,9 +183,9 @@ func resourceTencentCloudPostgresqlBaseBackupUpdate(d *schema.ResourceData, meta
 
 	request := postgresql.NewModifyBaseBackupExpireTimeRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	dBInstanceId := idSplit[0]
 	baseBackupId := idSplit[1]
@@ -180,7 +197,7 @@ func resourceTencentCloudPostgresqlBaseBackupUpdate(d *schema.ResourceData, meta
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -190,8 +207,8 @@ func resourceTencentCloudPostgresqlBaseBackupUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyBaseBackupExpireTime(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyBaseBackupExpireTimeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -201,41 +218,41 @@ func resourceTencentCloudPostgresqlBaseBackupUpdate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update postgresql BaseBackup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		oldTags, newTags := d.GetChange("tags")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("postgres", "dbInstanceId", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudPostgresqlBaseBackupRead(d, meta)
+	return resourceTencentCloudPostgresqlBaseBackupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlBaseBackupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlBaseBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_base_backup.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	dBInstanceId := idSplit[0]
 	baseBackupId := idSplit[1]
 
 	if err := service.DeletePostgresqlBaseBackupById(ctx, dBInstanceId, baseBackupId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
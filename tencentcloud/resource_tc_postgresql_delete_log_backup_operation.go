@@ -13,9 +13,11 @@ resource "tencentcloud_postgresql_delete_log_backup_operation" "delete_log_backu
 package tencentcloud
 
 import (
+	"context"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -24,9 +26,12 @@ import (
 
 func resourceTencentCloudPostgresqlDeleteLogBackupOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlDeleteLogBackupOperationCreate,
-		Read:   resourceTencentCloudPostgresqlDeleteLogBackupOperationRead,
-		Delete: resourceTencentCloudPostgresqlDeleteLogBackupOperationDelete,
+		CreateContext: resourceTencentCloudPostgresqlDeleteLogBackupOperationCreate,
+		ReadContext:   resourceTencentCloudPostgresqlDeleteLogBackupOperationRead,
+		DeleteContext: resourceTencentCloudPostgresqlDeleteLogBackupOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"db_instance_id": {
 				Required:    true,
@@ -45,7 +50,7 @@ func resourceTencentCloudPostgresqlDeleteLogBackupOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudPostgresqlDeleteLogBackupOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlDeleteLogBackupOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_delete_log_backup_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -67,8 +72,8 @@ func resourceTencentCloudPostgresqlDeleteLogBackupOperationCreate(d *schema.Reso
 		logBackupId = v.(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().DeleteLogBackup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().DeleteLogBackupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -78,22 +83,22 @@ func resourceTencentCloudPostgresqlDeleteLogBackupOperationCreate(d *schema.Reso
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate postgresql DeleteLogBackupOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{dBInstanceId, logBackupId}, FILED_SP))
+	d.SetId(helper.IdFormat(dBInstanceId, logBackupId))
 
-	return resourceTencentCloudPostgresqlDeleteLogBackupOperationRead(d, meta)
+	return resourceTencentCloudPostgresqlDeleteLogBackupOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlDeleteLogBackupOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlDeleteLogBackupOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_delete_log_backup_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudPostgresqlDeleteLogBackupOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlDeleteLogBackupOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_delete_log_backup_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
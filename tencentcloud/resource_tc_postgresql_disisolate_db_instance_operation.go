@@ -14,10 +14,11 @@ resource "tencentcloud_postgresql_disisolate_db_instance_operation" "disisolate_
 package tencentcloud
 
 import (
+	"context"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -26,9 +27,12 @@ import (
 
 func resourceTencentCloudPostgresqlDisisolateDbInstanceOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlDisisolateDbInstanceOperationCreate,
-		Read:   resourceTencentCloudPostgresqlDisisolateDbInstanceOperationRead,
-		Delete: resourceTencentCloudPostgresqlDisisolateDbInstanceOperationDelete,
+		CreateContext: resourceTencentCloudPostgresqlDisisolateDbInstanceOperationCreate,
+		ReadContext:   resourceTencentCloudPostgresqlDisisolateDbInstanceOperationRead,
+		DeleteContext: resourceTencentCloudPostgresqlDisisolateDbInstanceOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"db_instance_id_set": {
 				Required: true,
@@ -67,7 +71,7 @@ func resourceTencentCloudPostgresqlDisisolateDbInstanceOperation() *schema.Resou
 	}
 }
 
-func resourceTencentCloudPostgresqlDisisolateDbInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlDisisolateDbInstanceOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_disisolate_db_instance_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -108,8 +112,8 @@ func resourceTencentCloudPostgresqlDisisolateDbInstanceOperationCreate(d *schema
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().DisIsolateDBInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().DisIsolateDBInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -119,30 +123,30 @@ func resourceTencentCloudPostgresqlDisisolateDbInstanceOperationCreate(d *schema
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate postgresql DisisolateDbInstanceOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join(ids, FILED_SP))
+	d.SetId(helper.IdFormat(ids...))
 
 	service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"running"}, 10*readRetryTimeout, 10*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(firstInstanceId, []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"running"}, d.Timeout(schema.TimeoutCreate), 10*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(firstInstanceId, []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudPostgresqlDisisolateDbInstanceOperationRead(d, meta)
+	return resourceTencentCloudPostgresqlDisisolateDbInstanceOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlDisisolateDbInstanceOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlDisisolateDbInstanceOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_disisolate_db_instance_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudPostgresqlDisisolateDbInstanceOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlDisisolateDbInstanceOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_disisolate_db_instance_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
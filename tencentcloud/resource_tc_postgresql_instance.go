@@ -186,6 +186,7 @@ import (
 
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -194,12 +195,17 @@ import (
 
 func resourceTencentCloudPostgresqlInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlInstanceCreate,
-		Read:   resourceTencentCloudPostgresqlInstanceRead,
-		Update: resourceTencentCloudPostgresqlInstanceUpdate,
-		Delete: resourceTencentCLoudPostgresqlInstanceDelete,
+		CreateContext: resourceTencentCloudPostgresqlInstanceCreate,
+		ReadContext:   resourceTencentCloudPostgresqlInstanceRead,
+		UpdateContext: resourceTencentCloudPostgresqlInstanceUpdate,
+		DeleteContext: resourceTencentCLoudPostgresqlInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -473,11 +479,11 @@ func resourceTencentCloudPostgresqlInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_instance.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	postgresqlService := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -556,7 +562,7 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 	}
 
 	// get specCode with engine_version and memory
-	outErr = resource.Retry(readRetryTimeout*5, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		speccodes, inErr := postgresqlService.DescribeSpecinfos(ctx, zone)
 		if inErr != nil {
 			return retryError(inErr)
@@ -580,15 +586,15 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if specVersion == "" {
-		return fmt.Errorf(`The "engine_version" value: "%s" is invalid, Valid values are one of: "%s"`, dbVersion, strings.Join(allowVersion, `", "`))
+		return diag.Errorf(`The "engine_version" value: "%s" is invalid, Valid values are one of: "%s"`, dbVersion, strings.Join(allowVersion, `", "`))
 	}
 
 	if specCode == "" {
-		return fmt.Errorf(`The "memory" value: %d is invalid, Valid values are one of: %s`, memory, strings.Join(allowMemory, `, `))
+		return diag.Errorf(`The "memory" value: %d is invalid, Valid values are one of: %s`, memory, strings.Join(allowMemory, `, `))
 	}
 
 	var dbNodeSet []*postgresql.DBNode
@@ -609,11 +615,11 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 
 		// check if availability_zone and node_set consists
 		if include, z, nzs := checkZoneSetInclude(d); !include {
-			return fmt.Errorf("`availability_zone`: %s is not included in `db_node_set`: %s", z, nzs)
+			return diag.Errorf("`availability_zone`: %s is not included in `db_node_set`: %s", z, nzs)
 		}
 	}
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instanceId, inErr = postgresqlService.CreatePostgresqlInstance(ctx,
 			name,
 			dbVersion,
@@ -645,13 +651,13 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	d.SetId(instanceId)
 
 	// check creation done
-	err := resource.Retry(20*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, has, err := postgresqlService.DescribePostgresqlInstanceById(ctx, instanceId)
 		if err != nil {
 			return retryError(err)
@@ -669,13 +675,13 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// check init status
 	checkErr := postgresqlService.CheckDBInstanceStatus(ctx, instanceId)
 	if checkErr != nil {
-		return checkErr
+		return diag.FromErr(checkErr)
 	}
 	// set open public access
 	public_access_switch := false
@@ -684,7 +690,7 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 	}
 
 	if public_access_switch {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			inErr = postgresqlService.ModifyPublicService(ctx, true, instanceId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -692,17 +698,17 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 	}
 
 	// check creation done
 	checkErr = postgresqlService.CheckDBInstanceStatus(ctx, instanceId)
 	if checkErr != nil {
-		return checkErr
+		return diag.FromErr(checkErr)
 	}
 	// set name
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		inErr := postgresqlService.ModifyPostgresqlInstanceName(ctx, instanceId, name)
 		if inErr != nil {
 			return retryError(inErr)
@@ -710,13 +716,13 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	// check creation done
 	checkErr = postgresqlService.CheckDBInstanceStatus(ctx, instanceId)
 	if checkErr != nil {
-		return checkErr
+		return diag.FromErr(checkErr)
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
@@ -724,7 +730,7 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 		tagService := &TagService{client: tcClient}
 		resourceName := BuildTagResourceName("postgres", "DBInstanceId", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -738,7 +744,7 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 	}
 
 	if len(paramEntrys) != 0 {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			inErr := postgresqlService.ModifyPgParams(ctx, instanceId, paramEntrys)
 			if inErr != nil {
 				return retryError(inErr)
@@ -746,7 +752,7 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 		// 10s is required to synchronize the data(`DescribeParamsEvent`).
 		time.Sleep(10 * time.Second)
@@ -769,7 +775,7 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 		if v, ok := plan["backup_period"].([]interface{}); ok && len(v) > 0 {
 			request.BackupPeriod = helper.InterfacesStringsPoint(v)
 		}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			err := postgresqlService.ModifyBackupPlan(ctx, request)
 			if err != nil {
 				return retryError(err, postgresql.OPERATIONDENIED_INSTANCESTATUSLIMITOPERROR)
@@ -777,18 +783,18 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudPostgresqlInstanceRead(d, meta)
+	return resourceTencentCloudPostgresqlInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_instance.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	postgresqlService := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instanceId := d.Id()
@@ -801,11 +807,11 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		// "auto_voucher",
 		"voucher_ids",
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("period") && !d.HasChange("charge_type") {
-		return fmt.Errorf("The `period` field can be changed only when updating the charge type from `POSTPAID_BY_HOUR` to `PREPAID`.")
+		return diag.Errorf("The `period` field can be changed only when updating the charge type from `POSTPAID_BY_HOUR` to `PREPAID`.")
 	}
 
 	if d.HasChange("charge_type") {
@@ -827,7 +833,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		}
 
 		if chargeTypeOld != "POSTPAID_BY_HOUR" || chargeTypeNew != "PREPAID" {
-			return fmt.Errorf("It only support to update the charge type from `POSTPAID_BY_HOUR` to `PREPAID`.")
+			return diag.Errorf("It only support to update the charge type from `POSTPAID_BY_HOUR` to `PREPAID`.")
 		}
 
 		if v, ok := d.GetOk("period"); ok {
@@ -857,8 +863,8 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		request.AutoRenewFlag = helper.IntInt64(autoRenew)
 		request.AutoVoucher = helper.IntInt64(autoVoucher)
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyDBInstanceChargeType(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyDBInstanceChargeTypeWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -868,14 +874,14 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s operate postgresql ModifyDbInstanceChargeType failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		// wait unit charge type changing operation of instance done
 		service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-		conf := BuildStateChangeConf([]string{}, []string{"running"}, 2*readRetryTimeout, time.Second, service.PostgresqlDBInstanceStateRefreshFunc(instanceId, []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"running"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.PostgresqlDBInstanceStateRefreshFunc(instanceId, []string{}))
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 
 	}
@@ -916,8 +922,8 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		// ip assigned by system
 		request.IsAssignVip = helper.Bool(false)
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().CreateDBInstanceNetworkAccess(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().CreateDBInstanceNetworkAccessWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -927,14 +933,14 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s create postgresql Instance NetworkAccess failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 		// wait for new network enabled
-		conf := BuildStateChangeConf([]string{}, []string{"opened"}, 3*readRetryTimeout, time.Second, service.PostgresqlDBInstanceNetworkAccessStateRefreshFunc(instanceId, vpcNew, subnetNew, vipOld, "", []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"opened"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.PostgresqlDBInstanceNetworkAccessStateRefreshFunc(instanceId, vpcNew, subnetNew, vipOld, "", []string{}))
 		if object, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		} else {
 			// find the vip assiged by system
 			ret := object.(*postgresql.DBInstanceNetInfo)
@@ -942,9 +948,9 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		}
 
 		// wait unit network changing operation of instance done
-		conf = BuildStateChangeConf([]string{}, []string{"running"}, 3*readRetryTimeout, time.Second, service.PostgresqlDBInstanceStateRefreshFunc(instanceId, []string{}))
+		conf = BuildStateChangeConf([]string{}, []string{"running"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.PostgresqlDBInstanceStateRefreshFunc(instanceId, []string{}))
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 
 		// delete the old one
@@ -952,19 +958,19 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 			vipOld = v.(string)
 		}
 		if err := service.DeletePostgresqlDBInstanceNetworkAccessById(ctx, instanceId, vpcOld, subnetOld, vipOld); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		// wait for old network removed
-		conf = BuildStateChangeConf([]string{}, []string{"closed"}, 3*readRetryTimeout, time.Second, service.PostgresqlDBInstanceNetworkAccessStateRefreshFunc(instanceId, vpcOld, subnetOld, vipNew, vipOld, []string{}))
+		conf = BuildStateChangeConf([]string{}, []string{"closed"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.PostgresqlDBInstanceNetworkAccessStateRefreshFunc(instanceId, vpcOld, subnetOld, vipNew, vipOld, []string{}))
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 
 		// wait unit network changing operation of instance done
-		conf = BuildStateChangeConf([]string{}, []string{"running"}, 3*readRetryTimeout, time.Second, service.PostgresqlDBInstanceStateRefreshFunc(instanceId, []string{}))
+		conf = BuildStateChangeConf([]string{}, []string{"running"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.PostgresqlDBInstanceStateRefreshFunc(instanceId, []string{}))
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 
 		// refresh the private ip with new one
@@ -974,7 +980,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 	// update name
 	if d.HasChange("name") {
 		name := d.Get("name").(string)
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = postgresqlService.ModifyPostgresqlInstanceName(ctx, instanceId, name)
 			if inErr != nil {
 				return retryError(inErr)
@@ -982,12 +988,12 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 		// check update name done
 		checkErr = postgresqlService.CheckDBInstanceStatus(ctx, instanceId)
 		if checkErr != nil {
-			return checkErr
+			return diag.FromErr(checkErr)
 		}
 
 	}
@@ -996,7 +1002,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 	if d.HasChange("memory") || d.HasChange("storage") {
 		memory := d.Get("memory").(int)
 		storage := d.Get("storage").(int)
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = postgresqlService.UpgradePostgresqlInstance(ctx, instanceId, memory, storage)
 			if inErr != nil {
 				return retryError(inErr)
@@ -1004,10 +1010,10 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 		// Wait for status to processing
-		_ = resource.Retry(time.Second*10, func() *resource.RetryError {
+		_ = retryContext(ctx, time.Second*10, func() *resource.RetryError {
 			instance, _, err := postgresqlService.DescribePostgresqlInstanceById(ctx, instanceId)
 			if err != nil {
 				return retryError(err)
@@ -1021,7 +1027,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		// check update storage and memory done
 		checkErr = postgresqlService.CheckDBInstanceStatus(ctx, instanceId, 60)
 		if checkErr != nil {
-			return checkErr
+			return diag.FromErr(checkErr)
 		}
 
 	}
@@ -1029,7 +1035,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 	// update project id
 	if d.HasChange("project_id") {
 		projectId := d.Get("project_id").(int)
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = postgresqlService.ModifyPostgresqlInstanceProjectId(ctx, instanceId, projectId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -1037,13 +1043,13 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 		// check update project id done
 		checkErr = postgresqlService.CheckDBInstanceStatus(ctx, instanceId)
 		if checkErr != nil {
-			return checkErr
+			return diag.FromErr(checkErr)
 		}
 
 	}
@@ -1054,7 +1060,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		if v, ok := d.GetOkExists("public_access_switch"); ok {
 			public_access_switch = v.(bool)
 		}
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = postgresqlService.ModifyPublicService(ctx, public_access_switch, instanceId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -1062,12 +1068,12 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 		// check update public service done
 		checkErr = postgresqlService.CheckDBInstanceStatus(ctx, instanceId)
 		if checkErr != nil {
-			return checkErr
+			return diag.FromErr(checkErr)
 		}
 
 	}
@@ -1075,7 +1081,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 	// update root password
 	if d.HasChange("root_password") {
 		// to avoid other updating process conflicts with updating password, set the password updating with the last step, there is no way to figure out whether changing password is done
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = postgresqlService.SetPostgresqlInstanceRootPassword(ctx, instanceId, d.Get("root_password").(string))
 			if inErr != nil {
 				return retryError(inErr)
@@ -1083,12 +1089,12 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 		// check update password done
 		checkErr = postgresqlService.CheckDBInstanceStatus(ctx, instanceId)
 		if checkErr != nil {
-			return checkErr
+			return diag.FromErr(checkErr)
 		}
 
 	}
@@ -1104,7 +1110,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		}
 		err := service.ModifyDBInstanceSecurityGroups(ctx, "postgres", d.Id(), sgIds)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -1127,7 +1133,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 			}
 			err := postgresqlService.ModifyBackupPlan(ctx, request)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -1135,7 +1141,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 	if d.HasChange("db_node_set") {
 
 		if include, z, nzs := checkZoneSetInclude(d); !include {
-			return fmt.Errorf("`availability_zone`: %s is not included in `db_node_set`: %s", z, nzs)
+			return diag.Errorf("`availability_zone`: %s is not included in `db_node_set`: %s", z, nzs)
 		}
 
 		nodeSet := d.Get("db_node_set").(*schema.Set).List()
@@ -1154,7 +1160,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 			})
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			if err := postgresqlService.ModifyDBInstanceDeployment(ctx, request); err != nil {
 				return retryError(err, postgresql.OPERATIONDENIED_INSTANCESTATUSLIMITOPERROR)
 			}
@@ -1162,10 +1168,10 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(readRetryTimeout*10, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			instance, _, err := postgresqlService.DescribePostgresqlInstanceById(ctx, d.Id())
 			if err != nil {
 				return retryError(err)
@@ -1177,14 +1183,14 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	if d.HasChange("availability_zone") {
 		log.Printf("[WARN] argument `availability_zone` modified, skip process")
-		return fmt.Errorf("The `availability_zone` cannot be modified, please use `db_node_set` instead of it.")
+		return diag.Errorf("The `availability_zone` cannot be modified, please use `db_node_set` instead of it.")
 	}
 
 	if d.HasChange("db_kernel_version") {
@@ -1199,8 +1205,8 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		switchTag := POSTGRESQL_KERNEL_UPGRADE_IMMEDIATELY
 		upgradeRequest.SwitchTag = helper.IntUint64(switchTag)
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().UpgradeDBInstanceKernelVersion(upgradeRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().UpgradeDBInstanceKernelVersionWithContext(ctx, upgradeRequest)
 			if e != nil {
 				tcErr := e.(*sdkErrors.TencentCloudSDKError)
 
@@ -1217,15 +1223,15 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s create dcdb dbInstance failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		// only wait for immediately upgrade mode
 
-		conf := BuildStateChangeConf([]string{}, []string{"running", "isolated", "offline"}, 10*readRetryTimeout, time.Second, postgresqlService.PostgresqlUpgradeKernelVersionRefreshFunc(d.Id(), []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"running", "isolated", "offline"}, d.Timeout(schema.TimeoutUpdate), time.Second, postgresqlService.PostgresqlUpgradeKernelVersionRefreshFunc(d.Id(), []string{}))
 
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 
 	}
@@ -1240,7 +1246,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		resourceName := BuildTagResourceName("postgres", "DBInstanceId", tcClient.Region, d.Id())
 		err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -1256,14 +1262,14 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		}
 	}
 	if d.HasChange("db_major_vesion") || d.HasChange("db_major_version") {
-		return fmt.Errorf("Not support change db major version.")
+		return diag.Errorf("Not support change db major version.")
 	}
 
 	if d.HasChange("need_support_tde") || d.HasChange("kms_key_id") || d.HasChange("kms_region") {
-		return fmt.Errorf("Not support change params contact with data transparent encryption.")
+		return diag.Errorf("Not support change params contact with data transparent encryption.")
 	}
 	if len(paramEntrys) != 0 {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr := postgresqlService.ModifyPgParams(ctx, instanceId, paramEntrys)
 			if inErr != nil {
 				return retryError(inErr)
@@ -1271,7 +1277,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 		// 10s is required to synchronize the data(`DescribeParamsEvent`).
 		time.Sleep(10 * time.Second)
@@ -1279,14 +1285,14 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 
 	d.Partial(false)
 
-	return resourceTencentCloudPostgresqlInstanceRead(d, meta)
+	return resourceTencentCloudPostgresqlInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_instance.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		instance *postgresql.DBInstance
@@ -1296,7 +1302,7 @@ func resourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta int
 	)
 	// Check if import
 	postgresqlService := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		instance, has, inErr = postgresqlService.DescribePostgresqlInstanceById(ctx, d.Id())
 		if inErr != nil {
 			ee, ok := inErr.(*sdkErrors.TencentCloudSDKError)
@@ -1311,7 +1317,7 @@ func resourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	if !has {
 		d.SetId("")
@@ -1321,7 +1327,7 @@ func resourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta int
 	// rootUser
 	accounts, outErr := postgresqlService.DescribeRootUser(ctx, d.Id())
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	var rootUser string
 	if len(accounts) > 0 {
@@ -1377,7 +1383,7 @@ func resourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta int
 	redisService := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 	sg, err := redisService.DescribeDBSecurityGroups(ctx, "postgres", d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(sg) > 0 {
 		_ = d.Set("security_groups", sg)
@@ -1388,7 +1394,7 @@ func resourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta int
 
 	ins, err := postgresqlService.DescribeDBInstanceAttribute(ctx, attrRequest)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	nodeSet := ins.DBNodeSet
 	zoneSet := schema.NewSet(schema.HashString, nil)
@@ -1423,7 +1429,7 @@ func resourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta int
 	_ = d.Set("need_support_tde", instance.IsSupportTDE)
 	has, kms, err := postgresqlService.DescribeDBEncryptionKeys(ctx, kmsRequest)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if has {
 		_ = d.Set("kms_key_id", kms.KeyId)
@@ -1441,13 +1447,13 @@ func resourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta int
 	instanceList, err := postgresqlService.DescribePostgresqlInstances(ctx, filters)
 	if err != nil {
 		log.Printf("[CRITAL]%s query postgreSql  error, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if len(instanceList) == 0 {
-		return fmt.Errorf("no postgresql instance find by id: %s\n.", d.Id())
+		return diag.Errorf("no postgresql instance find by id: %s\n.", d.Id())
 	}
 	if len(instanceList) > 1 {
-		return fmt.Errorf("find more than one postgresql instance find by id: %s\n.", d.Id())
+		return diag.Errorf("find more than one postgresql instance find by id: %s\n.", d.Id())
 	}
 
 	_ = d.Set("uid", instanceList[0].Uid)
@@ -1458,7 +1464,7 @@ func resourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta int
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "postgres", "DBInstanceId", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
@@ -1469,7 +1475,7 @@ func resourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta int
 		response, err := postgresqlService.DescribeBackupPlans(ctx, request)
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		var backupPlan *postgresql.BackupPlan
@@ -1497,7 +1503,7 @@ func resourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta int
 				// set period list from BackupPeriods string, eg:"BackupPeriod": "[\"tuesday\",\"wednesday\"]",
 				err := json.Unmarshal([]byte(*backupPlan.BackupPeriod), &strSlice)
 				if err != nil {
-					return fmt.Errorf("BackupPeriod:[%s] has invalid format,Unmarshal failed! error: %v", *backupPlan.BackupPeriod, err.Error())
+					return diag.Errorf("BackupPeriod:[%s] has invalid format,Unmarshal failed! error: %v", *backupPlan.BackupPeriod, err.Error())
 				}
 				planMap["backup_period"] = strSlice
 			}
@@ -1508,7 +1514,7 @@ func resourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta int
 
 	// pg params
 	var parmas map[string]string
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		parmas, inErr = postgresqlService.DescribePgParams(ctx, d.Id())
 		if inErr != nil {
 			ee, ok := inErr.(*sdkErrors.TencentCloudSDKError)
@@ -1520,15 +1526,15 @@ func resourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	maxStandbyStreamingDelayValue, err := strconv.Atoi(parmas["max_standby_streaming_delay"])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	maxStandbyArchiveDelayValue, err := strconv.Atoi(parmas["max_standby_archive_delay"])
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("max_standby_streaming_delay", maxStandbyStreamingDelayValue)
 	_ = d.Set("max_standby_archive_delay", maxStandbyArchiveDelayValue)
@@ -1536,11 +1542,11 @@ func resourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCLoudPostgresqlInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCLoudPostgresqlInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_instance.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 	postgresqlService := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -1548,7 +1554,7 @@ func resourceTencentCLoudPostgresqlInstanceDelete(d *schema.ResourceData, meta i
 	var outErr, inErr error
 	var has bool
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, inErr = postgresqlService.DescribePostgresqlInstanceById(ctx, d.Id())
 		if inErr != nil {
 			// ResourceNotFound.InstanceNotFoundError
@@ -1562,7 +1568,7 @@ func resourceTencentCLoudPostgresqlInstanceDelete(d *schema.ResourceData, meta i
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if !has {
@@ -1571,12 +1577,12 @@ func resourceTencentCLoudPostgresqlInstanceDelete(d *schema.ResourceData, meta i
 
 	outErr = postgresqlService.IsolatePostgresqlInstance(ctx, instanceId)
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	outErr = postgresqlService.DeletePostgresqlInstance(ctx, instanceId)
 	if outErr != nil {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			inErr = postgresqlService.DeletePostgresqlInstance(ctx, instanceId)
 			if inErr != nil {
 				// ResourceNotFound.InstanceNotFoundError
@@ -1591,10 +1597,10 @@ func resourceTencentCLoudPostgresqlInstanceDelete(d *schema.ResourceData, meta i
 	}
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, inErr = postgresqlService.DescribePostgresqlInstanceById(ctx, d.Id())
 		if inErr != nil {
 			// ResourceNotFound.InstanceNotFoundError
@@ -1612,7 +1618,7 @@ func resourceTencentCLoudPostgresqlInstanceDelete(d *schema.ResourceData, meta i
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	return nil
@@ -12,20 +12,25 @@ resource "tencentcloud_postgresql_isolate_db_instance_operation" "isolate_db_ins
 package tencentcloud
 
 import (
+	"context"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudPostgresqlIsolateDbInstanceOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlIsolateDbInstanceOperationCreate,
-		Read:   resourceTencentCloudPostgresqlIsolateDbInstanceOperationRead,
-		Delete: resourceTencentCloudPostgresqlIsolateDbInstanceOperationDelete,
+		CreateContext: resourceTencentCloudPostgresqlIsolateDbInstanceOperationCreate,
+		ReadContext:   resourceTencentCloudPostgresqlIsolateDbInstanceOperationRead,
+		DeleteContext: resourceTencentCloudPostgresqlIsolateDbInstanceOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"db_instance_id_set": {
 				Required: true,
@@ -40,7 +45,7 @@ func resourceTencentCloudPostgresqlIsolateDbInstanceOperation() *schema.Resource
 	}
 }
 
-func resourceTencentCloudPostgresqlIsolateDbInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlIsolateDbInstanceOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_isolate_db_instance_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -63,8 +68,8 @@ func resourceTencentCloudPostgresqlIsolateDbInstanceOperationCreate(d *schema.Re
 		firstInstanceId = dBInstanceIdSetSet[0].(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().IsolateDBInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().IsolateDBInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -74,30 +79,30 @@ func resourceTencentCloudPostgresqlIsolateDbInstanceOperationCreate(d *schema.Re
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate postgresql IsolateDbInstanceOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join(ids, FILED_SP))
+	d.SetId(helper.IdFormat(ids...))
 
 	service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"isolated"}, 10*readRetryTimeout, 10*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(firstInstanceId, []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"isolated"}, d.Timeout(schema.TimeoutCreate), 10*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(firstInstanceId, []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudPostgresqlIsolateDbInstanceOperationRead(d, meta)
+	return resourceTencentCloudPostgresqlIsolateDbInstanceOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlIsolateDbInstanceOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlIsolateDbInstanceOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_isolate_db_instance_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudPostgresqlIsolateDbInstanceOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlIsolateDbInstanceOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_isolate_db_instance_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -14,8 +14,11 @@ resource "tencentcloud_postgresql_modify_account_remark_operation" "modify_accou
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -24,9 +27,12 @@ import (
 
 func resourceTencentCloudPostgresqlModifyAccountRemarkOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlModifyAccountRemarkOperationCreate,
-		Read:   resourceTencentCloudPostgresqlModifyAccountRemarkOperationRead,
-		Delete: resourceTencentCloudPostgresqlModifyAccountRemarkOperationDelete,
+		CreateContext: resourceTencentCloudPostgresqlModifyAccountRemarkOperationCreate,
+		ReadContext:   resourceTencentCloudPostgresqlModifyAccountRemarkOperationRead,
+		DeleteContext: resourceTencentCloudPostgresqlModifyAccountRemarkOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"db_instance_id": {
 				Required:    true,
@@ -52,7 +58,7 @@ func resourceTencentCloudPostgresqlModifyAccountRemarkOperation() *schema.Resour
 	}
 }
 
-func resourceTencentCloudPostgresqlModifyAccountRemarkOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlModifyAccountRemarkOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_modify_account_remark_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -75,8 +81,8 @@ func resourceTencentCloudPostgresqlModifyAccountRemarkOperationCreate(d *schema.
 		request.Remark = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyAccountRemark(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyAccountRemarkWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -86,22 +92,22 @@ func resourceTencentCloudPostgresqlModifyAccountRemarkOperationCreate(d *schema.
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate postgresql ModifyAccountRemarkOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(dBInstanceId)
 
-	return resourceTencentCloudPostgresqlModifyAccountRemarkOperationRead(d, meta)
+	return resourceTencentCloudPostgresqlModifyAccountRemarkOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlModifyAccountRemarkOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlModifyAccountRemarkOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_modify_account_remark_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudPostgresqlModifyAccountRemarkOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlModifyAccountRemarkOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_modify_account_remark_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -13,8 +13,11 @@ resource "tencentcloud_postgresql_modify_switch_time_period_operation" "modify_s
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -23,11 +26,14 @@ import (
 
 func resourceTencentCloudPostgresqlModifySwitchTimePeriodOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationCreate,
-		Read:   resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationRead,
-		Delete: resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationDelete,
+		CreateContext: resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationCreate,
+		ReadContext:   resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationRead,
+		DeleteContext: resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"db_instance_id": {
@@ -47,7 +53,7 @@ func resourceTencentCloudPostgresqlModifySwitchTimePeriodOperation() *schema.Res
 	}
 }
 
-func resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_modify_switch_time_period_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -66,8 +72,8 @@ func resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationCreate(d *sche
 		request.SwitchTag = helper.IntUint64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifySwitchTimePeriod(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifySwitchTimePeriodWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -77,22 +83,22 @@ func resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationCreate(d *sche
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate postgresql ModifySwitchTimePeriodOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(dBInstanceId)
 
-	return resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationRead(d, meta)
+	return resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_modify_switch_time_period_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlModifySwitchTimePeriodOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_modify_switch_time_period_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -37,9 +37,9 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -47,12 +47,12 @@ import (
 
 func resourceTencentCloudPostgresqlParameterTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlParameterTemplateCreate,
-		Read:   resourceTencentCloudPostgresqlParameterTemplateRead,
-		Update: resourceTencentCloudPostgresqlParameterTemplateUpdate,
-		Delete: resourceTencentCloudPostgresqlParameterTemplateDelete,
+		CreateContext: resourceTencentCloudPostgresqlParameterTemplateCreate,
+		ReadContext:   resourceTencentCloudPostgresqlParameterTemplateRead,
+		UpdateContext: resourceTencentCloudPostgresqlParameterTemplateUpdate,
+		DeleteContext: resourceTencentCloudPostgresqlParameterTemplateDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"template_name": {
@@ -111,7 +111,7 @@ func resourceTencentCloudPostgresqlParameterTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudPostgresqlParameterTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlParameterTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_parameter_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -139,11 +139,11 @@ func resourceTencentCloudPostgresqlParameterTemplateCreate(d *schema.ResourceDat
 		request.TemplateDescription = helper.String(v.(string))
 	}
 
-	result, err := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().CreateParameterTemplate(request)
+	result, err := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().CreateParameterTemplateWithContext(ctx, request)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create postgresql ParameterTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	response = result
 	templateId := response.Response.TemplateId
@@ -174,25 +174,25 @@ func resourceTencentCloudPostgresqlParameterTemplateCreate(d *schema.ResourceDat
 	}
 
 	if len(modifyRequest.ModifyParamEntrySet) > 0 || len(modifyRequest.DeleteParamSet) > 0 {
-		_, err = meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyParameterTemplate(modifyRequest)
+		_, err = meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyParameterTemplateWithContext(ctx, modifyRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s update postgresql ParameterTemplate in create method failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(*templateId)
 
-	return resourceTencentCloudPostgresqlParameterTemplateRead(d, meta)
+	return resourceTencentCloudPostgresqlParameterTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlParameterTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlParameterTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_parameter_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -200,7 +200,7 @@ func resourceTencentCloudPostgresqlParameterTemplateRead(d *schema.ResourceData,
 
 	ParameterTemplate, err := service.DescribePostgresqlParameterTemplateById(ctx, templateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ParameterTemplate == nil {
@@ -240,7 +240,7 @@ func resourceTencentCloudPostgresqlParameterTemplateRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudPostgresqlParameterTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlParameterTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_parameter_template.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -255,7 +255,7 @@ func resourceTencentCloudPostgresqlParameterTemplateUpdate(d *schema.ResourceDat
 	// do not care the param_info_set attribute
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -298,28 +298,28 @@ func resourceTencentCloudPostgresqlParameterTemplateUpdate(d *schema.ResourceDat
 		}
 	}
 
-	_, err := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyParameterTemplate(request)
+	_, err := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyParameterTemplateWithContext(ctx, request)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update postgresql ParameterTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudPostgresqlParameterTemplateRead(d, meta)
+	return resourceTencentCloudPostgresqlParameterTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlParameterTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlParameterTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_parameter_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	templateId := d.Id()
 
 	if err := service.DeletePostgresqlParameterTemplateById(ctx, templateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -14,10 +14,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -26,10 +26,14 @@ import (
 
 func resourceTencentCloudPostgresqlReadonlyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlReadOnlyAttachmentCreate,
-		Read:   resourceTencentCloudPostgresqlReadOnlyAttachmentRead,
+		CreateContext: resourceTencentCloudPostgresqlReadOnlyAttachmentCreate,
+		ReadContext:   resourceTencentCloudPostgresqlReadOnlyAttachmentRead,
 		//Update: resourceTencentCloudPostgresqlReadOnlyAttachmentUpdate,
-		Delete: resourceTencentCLoudPostgresqlReadOnlyAttachmentDelete,
+		DeleteContext: resourceTencentCLoudPostgresqlReadOnlyAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		//Importer: &schema.ResourceImporter{
 		//	State: schema.ImportStatePassthrough,
 		//},
@@ -51,7 +55,7 @@ func resourceTencentCloudPostgresqlReadonlyAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudPostgresqlReadOnlyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlReadOnlyAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_readonly_attachment.create")()
 
 	logId := getLogId(contextNil)
@@ -71,8 +75,8 @@ func resourceTencentCloudPostgresqlReadOnlyAttachmentCreate(d *schema.ResourceDa
 		request.ReadOnlyGroupId = helper.String(groupId)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().AddDBInstanceToReadOnlyGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().AddDBInstanceToReadOnlyGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -82,7 +86,7 @@ func resourceTencentCloudPostgresqlReadOnlyAttachmentCreate(d *schema.ResourceDa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceId := helper.IdFormat(dbInstanceId, groupId)
 	d.SetId(instanceId)
@@ -90,38 +94,38 @@ func resourceTencentCloudPostgresqlReadOnlyAttachmentCreate(d *schema.ResourceDa
 	return nil
 }
 
-func resourceTencentCloudPostgresqlReadOnlyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlReadOnlyAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_readonly_attachment.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	postgresqlService := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	_, err := postgresqlService.DescribePostgresqlReadOnlyGroupById(ctx, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceTencentCLoudPostgresqlReadOnlyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCLoudPostgresqlReadOnlyAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_readonly_attachment.delete")()
 
 	logId := getLogId(contextNil)
 	request := postgresql.NewRemoveDBInstanceFromReadOnlyGroupRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	dbInstanceId := idSplit[0]
 	groupId := idSplit[1]
 	request.ReadOnlyGroupId = helper.String(groupId)
 	request.DBInstanceId = helper.String(dbInstanceId)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().RemoveDBInstanceFromReadOnlyGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().RemoveDBInstanceFromReadOnlyGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -131,7 +135,7 @@ func resourceTencentCLoudPostgresqlReadOnlyAttachmentDelete(d *schema.ResourceDa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
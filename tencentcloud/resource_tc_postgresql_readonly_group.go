@@ -27,6 +27,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -35,10 +36,15 @@ import (
 
 func resourceTencentCloudPostgresqlReadonlyGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlReadOnlyGroupCreate,
-		Read:   resourceTencentCloudPostgresqlReadOnlyGroupRead,
-		Update: resourceTencentCloudPostgresqlReadOnlyGroupUpdate,
-		Delete: resourceTencentCLoudPostgresqlReadOnlyGroupDelete,
+		CreateContext: resourceTencentCloudPostgresqlReadOnlyGroupCreate,
+		ReadContext:   resourceTencentCloudPostgresqlReadOnlyGroupRead,
+		UpdateContext: resourceTencentCloudPostgresqlReadOnlyGroupUpdate,
+		DeleteContext: resourceTencentCLoudPostgresqlReadOnlyGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		//Importer: &schema.ResourceImporter{
 		//	State: schema.ImportStatePassthrough,
 		//},
@@ -113,7 +119,7 @@ func resourceTencentCloudPostgresqlReadonlyGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudPostgresqlReadOnlyGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlReadOnlyGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_readonly_group.create")()
 
 	logId := getLogId(contextNil)
@@ -168,8 +174,8 @@ func resourceTencentCloudPostgresqlReadOnlyGroupCreate(d *schema.ResourceData, m
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().CreateReadOnlyGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().CreateReadOnlyGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -180,15 +186,15 @@ func resourceTencentCloudPostgresqlReadOnlyGroupCreate(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceId := *response.Response.ReadOnlyGroupId
 	d.SetId(instanceId)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	postgresqlService := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		groups, e := postgresqlService.DescribePostgresqlReadOnlyGroupById(ctx, msaterDbInstanceId)
 		if e != nil {
 			return retryError(e)
@@ -206,18 +212,18 @@ func resourceTencentCloudPostgresqlReadOnlyGroupCreate(d *schema.ResourceData, m
 		return resource.RetryableError(fmt.Errorf("waiting status[%s] to running, retry... ", status))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//return resourceTencentCloudPostgresqlReadOnlyGroupRead(d, meta)
 	return nil
 }
 
-func resourceTencentCloudPostgresqlReadOnlyGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlReadOnlyGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_readonly_group.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	// for now, the id should be the master db instance id, cause the describe api only support this kind of filter.
 	var id string
@@ -228,17 +234,17 @@ func resourceTencentCloudPostgresqlReadOnlyGroupRead(d *schema.ResourceData, met
 	postgresqlService := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	_, err := postgresqlService.DescribePostgresqlReadOnlyGroupById(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceTencentCloudPostgresqlReadOnlyGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlReadOnlyGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_readonly_group.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	request := postgresql.NewModifyReadOnlyGroupConfigRequest()
 
 	request.ReadOnlyGroupId = helper.String(d.Id())
@@ -279,7 +285,7 @@ func resourceTencentCloudPostgresqlReadOnlyGroupUpdate(d *schema.ResourceData, m
 		// get the old ip before creating
 		netInfos, err := service.DescribePostgresqlReadonlyGroupNetInfosById(ctx, msaterDbInstanceId, d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		var oldNetInfo *postgresql.DBInstanceNetInfo
@@ -304,8 +310,8 @@ func resourceTencentCloudPostgresqlReadOnlyGroupUpdate(d *schema.ResourceData, m
 		// ip assigned by system
 		request.IsAssignVip = helper.Bool(false)
 
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().CreateReadOnlyGroupNetworkAccess(request)
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().CreateReadOnlyGroupNetworkAccessWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -315,14 +321,14 @@ func resourceTencentCloudPostgresqlReadOnlyGroupUpdate(d *schema.ResourceData, m
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s create postgresql ReadOnlyGroup NetworkAccess failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		// wait for new network enabled
-		conf := BuildStateChangeConf([]string{}, []string{"opened"}, 3*readRetryTimeout, time.Second, service.PostgresqlReadonlyGroupNetworkAccessStateRefreshFunc(msaterDbInstanceId, d.Id(), vpcNew, subnetNew, vipOld, "", []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"opened"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.PostgresqlReadonlyGroupNetworkAccessStateRefreshFunc(msaterDbInstanceId, d.Id(), vpcNew, subnetNew, vipOld, "", []string{}))
 
 		if object, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		} else {
 			// find the vip assiged by system
 			ret := object.(*postgresql.DBInstanceNetInfo)
@@ -333,26 +339,26 @@ func resourceTencentCloudPostgresqlReadOnlyGroupUpdate(d *schema.ResourceData, m
 			logId, msaterDbInstanceId, d.Id(), vpcOld, vpcNew, subnetOld, subnetNew, vipOld, vipNew)
 
 		// wait unit network changing operation of ro group done
-		conf = BuildStateChangeConf([]string{}, []string{"ok"}, 3*readRetryTimeout, time.Second, service.PostgresqlReadonlyGroupStateRefreshFunc(msaterDbInstanceId, d.Id(), []string{}))
+		conf = BuildStateChangeConf([]string{}, []string{"ok"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.PostgresqlReadonlyGroupStateRefreshFunc(msaterDbInstanceId, d.Id(), []string{}))
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 
 		// delete the old one
 		if err := service.DeletePostgresqlReadonlyGroupNetworkAccessById(ctx, d.Id(), vpcOld, subnetOld, vipOld); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		// wait for old network removed
-		conf = BuildStateChangeConf([]string{}, []string{"closed"}, 3*readRetryTimeout, time.Second, service.PostgresqlReadonlyGroupNetworkAccessStateRefreshFunc(msaterDbInstanceId, d.Id(), vpcOld, subnetOld, vipNew, vipOld, []string{}))
+		conf = BuildStateChangeConf([]string{}, []string{"closed"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.PostgresqlReadonlyGroupNetworkAccessStateRefreshFunc(msaterDbInstanceId, d.Id(), vpcOld, subnetOld, vipNew, vipOld, []string{}))
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 
 		// wait unit network changing operation of ro group done
-		conf = BuildStateChangeConf([]string{}, []string{"ok"}, 3*readRetryTimeout, time.Second, service.PostgresqlReadonlyGroupStateRefreshFunc(msaterDbInstanceId, d.Id(), []string{}))
+		conf = BuildStateChangeConf([]string{}, []string{"ok"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.PostgresqlReadonlyGroupStateRefreshFunc(msaterDbInstanceId, d.Id(), []string{}))
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 
 		// refresh the private ip with new one
@@ -366,8 +372,8 @@ func resourceTencentCloudPostgresqlReadOnlyGroupUpdate(d *schema.ResourceData, m
 	request.MaxReplayLatency = helper.IntUint64(d.Get("max_replay_latency").(int))
 	request.MinDelayEliminateReserve = helper.IntUint64(d.Get("min_delay_eliminate_reserve").(int))
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyReadOnlyGroupConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyReadOnlyGroupConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -378,21 +384,21 @@ func resourceTencentCloudPostgresqlReadOnlyGroupUpdate(d *schema.ResourceData, m
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCLoudPostgresqlReadOnlyGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCLoudPostgresqlReadOnlyGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_readonly_group.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	groupId := d.Id()
 	postgresqlService := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := postgresqlService.DeletePostgresqlReadOnlyGroupById(ctx, groupId)
 		if e != nil {
 			return retryError(e)
@@ -400,7 +406,7 @@ func resourceTencentCLoudPostgresqlReadOnlyGroupDelete(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -55,6 +55,7 @@ import (
 
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -62,12 +63,17 @@ import (
 
 func resourceTencentCloudPostgresqlReadonlyInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlReadOnlyInstanceCreate,
-		Read:   resourceTencentCloudPostgresqlReadOnlyInstanceRead,
-		Update: resourceTencentCloudPostgresqlReadOnlyInstanceUpdate,
-		Delete: resourceTencentCLoudPostgresqlReadOnlyInstanceDelete,
+		CreateContext: resourceTencentCloudPostgresqlReadOnlyInstanceCreate,
+		ReadContext:   resourceTencentCloudPostgresqlReadOnlyInstanceRead,
+		UpdateContext: resourceTencentCloudPostgresqlReadOnlyInstanceUpdate,
+		DeleteContext: resourceTencentCLoudPostgresqlReadOnlyInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -198,11 +204,11 @@ func resourceTencentCloudPostgresqlReadonlyInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudPostgresqlReadOnlyInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlReadOnlyInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_readonly_instance.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request           = postgresql.NewCreateReadOnlyDBInstanceRequest()
@@ -281,7 +287,7 @@ func resourceTencentCloudPostgresqlReadOnlyInstanceCreate(d *schema.ResourceData
 	// get specCode with db_version and memory
 	var allowVersion, allowMemory []string
 	var specVersion, specCode string
-	err := resource.Retry(readRetryTimeout*5, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		speccodes, inErr := postgresqlService.DescribeSpecinfos(ctx, zone)
 		if inErr != nil {
 			return retryError(inErr)
@@ -305,21 +311,21 @@ func resourceTencentCloudPostgresqlReadOnlyInstanceCreate(d *schema.ResourceData
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if specVersion == "" {
-		return fmt.Errorf(`The "db_version" value: "%s" is invalid, Valid values are one of: "%s"`, dbVersion, strings.Join(allowVersion, `", "`))
+		return diag.Errorf(`The "db_version" value: "%s" is invalid, Valid values are one of: "%s"`, dbVersion, strings.Join(allowVersion, `", "`))
 	}
 	if specCode == "" {
-		return fmt.Errorf(`The "storage" value: %d is invalid, Valid values are one of: %s`, memory, strings.Join(allowMemory, `, `))
+		return diag.Errorf(`The "storage" value: %d is invalid, Valid values are one of: %s`, memory, strings.Join(allowMemory, `, `))
 	}
 	request.SpecCode = helper.String(specCode)
 
 	request.InstanceCount = helper.IntUint64(1)
 	request.Period = helper.IntUint64(1)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().CreateReadOnlyDBInstance(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().CreateReadOnlyDBInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -331,13 +337,13 @@ func resourceTencentCloudPostgresqlReadOnlyInstanceCreate(d *schema.ResourceData
 
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	instanceId := *response.Response.DBInstanceIdSet[0]
 	d.SetId(instanceId)
 
 	// check creation done
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, has, err := postgresqlService.DescribePostgresqlInstanceById(ctx, instanceId)
 		if err != nil {
 			return retryError(err)
@@ -351,23 +357,23 @@ func resourceTencentCloudPostgresqlReadOnlyInstanceCreate(d *schema.ResourceData
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudPostgresqlReadOnlyInstanceRead(d, meta)
+	return resourceTencentCloudPostgresqlReadOnlyInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlReadOnlyInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlReadOnlyInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_readonly_instance.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 	postgresqlService := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instance, has, err := postgresqlService.DescribePostgresqlInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -401,7 +407,7 @@ func resourceTencentCloudPostgresqlReadOnlyInstanceRead(d *schema.ResourceData,
 	redisService := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 	sg, err := redisService.DescribeDBSecurityGroups(ctx, "postgres", d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(sg) > 0 {
 		_ = d.Set("security_groups_ids", sg)
@@ -429,11 +435,11 @@ func resourceTencentCloudPostgresqlReadOnlyInstanceRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudPostgresqlReadOnlyInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlReadOnlyInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_readonly_instance.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	postgresqlService := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instanceId := d.Id()
@@ -446,7 +452,7 @@ func resourceTencentCloudPostgresqlReadOnlyInstanceUpdate(d *schema.ResourceData
 		"auto_voucher",
 		"voucher_ids",
 	); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("read_only_group_id") {
@@ -471,8 +477,8 @@ func resourceTencentCloudPostgresqlReadOnlyInstanceUpdate(d *schema.ResourceData
 		request.ReadOnlyGroupId = &roGroupIdOld
 		request.NewReadOnlyGroupId = &roGroupIdNew
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyDBInstanceReadOnlyGroup(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyDBInstanceReadOnlyGroupWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -482,12 +488,12 @@ func resourceTencentCloudPostgresqlReadOnlyInstanceUpdate(d *schema.ResourceData
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s operate postgresql ChangeDbInstanceReadOnlyGroupOperation failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
-		conf := BuildStateChangeConf([]string{}, []string{"ok"}, 2*readRetryTimeout, time.Second, service.PostgresqlReadonlyGroupStateRefreshFunc(masterInstanceId, roGroupIdNew, []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"ok"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.PostgresqlReadonlyGroupStateRefreshFunc(masterInstanceId, roGroupIdNew, []string{}))
 		if _, e := conf.WaitForState(); e != nil {
-			return e
+			return diag.FromErr(e)
 		}
 	}
 
@@ -495,7 +501,7 @@ func resourceTencentCloudPostgresqlReadOnlyInstanceUpdate(d *schema.ResourceData
 	// update name
 	if d.HasChange("name") {
 		name := d.Get("name").(string)
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = postgresqlService.ModifyPostgresqlInstanceName(ctx, instanceId, name)
 			if inErr != nil {
 				return retryError(inErr)
@@ -503,12 +509,12 @@ func resourceTencentCloudPostgresqlReadOnlyInstanceUpdate(d *schema.ResourceData
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 		// check update name done
 		checkErr = postgresqlService.CheckDBInstanceStatus(ctx, instanceId)
 		if checkErr != nil {
-			return checkErr
+			return diag.FromErr(checkErr)
 		}
 
 	}
@@ -517,7 +523,7 @@ func resourceTencentCloudPostgresqlReadOnlyInstanceUpdate(d *schema.ResourceData
 	if d.HasChange("memory") || d.HasChange("storage") {
 		memory := d.Get("memory").(int)
 		storage := d.Get("storage").(int)
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = postgresqlService.UpgradePostgresqlInstance(ctx, instanceId, memory, storage)
 			if inErr != nil {
 				return retryError(inErr)
@@ -525,12 +531,12 @@ func resourceTencentCloudPostgresqlReadOnlyInstanceUpdate(d *schema.ResourceData
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 		// check update storage and memory done
 		checkErr = postgresqlService.CheckDBInstanceStatus(ctx, instanceId)
 		if checkErr != nil {
-			return checkErr
+			return diag.FromErr(checkErr)
 		}
 
 	}
@@ -538,7 +544,7 @@ func resourceTencentCloudPostgresqlReadOnlyInstanceUpdate(d *schema.ResourceData
 	// update project id
 	if d.HasChange("project_id") {
 		projectId := d.Get("project_id").(int)
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = postgresqlService.ModifyPostgresqlInstanceProjectId(ctx, instanceId, projectId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -546,13 +552,13 @@ func resourceTencentCloudPostgresqlReadOnlyInstanceUpdate(d *schema.ResourceData
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 		// check update project id done
 		checkErr = postgresqlService.CheckDBInstanceStatus(ctx, instanceId)
 		if checkErr != nil {
-			return checkErr
+			return diag.FromErr(checkErr)
 		}
 
 	}
@@ -568,7 +574,7 @@ func resourceTencentCloudPostgresqlReadOnlyInstanceUpdate(d *schema.ResourceData
 		}
 		err := service.ModifyDBInstanceSecurityGroups(ctx, "postgres", d.Id(), sgIds)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -590,20 +596,20 @@ func resourceTencentCloudPostgresqlReadOnlyInstanceUpdate(d *schema.ResourceData
 
 	d.Partial(false)
 
-	return resourceTencentCloudPostgresqlReadOnlyInstanceRead(d, meta)
+	return resourceTencentCloudPostgresqlReadOnlyInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCLoudPostgresqlReadOnlyInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCLoudPostgresqlReadOnlyInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_readonly_instance.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 	postgresqlService := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	// isolate
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := postgresqlService.IsolatePostgresqlInstance(ctx, instanceId)
 		if e != nil {
 			return retryError(e)
@@ -611,10 +617,10 @@ func resourceTencentCLoudPostgresqlReadOnlyInstanceDelete(d *schema.ResourceData
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// delete
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := postgresqlService.DeletePostgresqlInstance(ctx, instanceId)
 		if e != nil {
 			return retryError(e)
@@ -622,7 +628,7 @@ func resourceTencentCLoudPostgresqlReadOnlyInstanceDelete(d *schema.ResourceData
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
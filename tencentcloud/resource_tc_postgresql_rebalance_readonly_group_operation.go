@@ -25,8 +25,11 @@ resource "tencentcloud_postgresql_rebalance_readonly_group_operation" "rebalance
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -35,9 +38,12 @@ import (
 
 func resourceTencentCloudPostgresqlRebalanceReadonlyGroupOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlRebalanceReadonlyGroupOperationCreate,
-		Read:   resourceTencentCloudPostgresqlRebalanceReadonlyGroupOperationRead,
-		Delete: resourceTencentCloudPostgresqlRebalanceReadonlyGroupOperationDelete,
+		CreateContext: resourceTencentCloudPostgresqlRebalanceReadonlyGroupOperationCreate,
+		ReadContext:   resourceTencentCloudPostgresqlRebalanceReadonlyGroupOperationRead,
+		DeleteContext: resourceTencentCloudPostgresqlRebalanceReadonlyGroupOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"read_only_group_id": {
 				Required:    true,
@@ -49,7 +55,7 @@ func resourceTencentCloudPostgresqlRebalanceReadonlyGroupOperation() *schema.Res
 	}
 }
 
-func resourceTencentCloudPostgresqlRebalanceReadonlyGroupOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlRebalanceReadonlyGroupOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_rebalance_readonly_group_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -64,8 +70,8 @@ func resourceTencentCloudPostgresqlRebalanceReadonlyGroupOperationCreate(d *sche
 		readOnlyGroupId = v.(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().RebalanceReadOnlyGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().RebalanceReadOnlyGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -75,22 +81,22 @@ func resourceTencentCloudPostgresqlRebalanceReadonlyGroupOperationCreate(d *sche
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate postgresql RebalanceReadonlyGroupOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(readOnlyGroupId)
 
-	return resourceTencentCloudPostgresqlRebalanceReadonlyGroupOperationRead(d, meta)
+	return resourceTencentCloudPostgresqlRebalanceReadonlyGroupOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlRebalanceReadonlyGroupOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlRebalanceReadonlyGroupOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_rebalance_readonly_group_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudPostgresqlRebalanceReadonlyGroupOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlRebalanceReadonlyGroupOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_rebalance_readonly_group_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -14,9 +14,11 @@ resource "tencentcloud_postgresql_renew_db_instance_operation" "renew_db_instanc
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -25,9 +27,12 @@ import (
 
 func resourceTencentCloudPostgresqlRenewDbInstanceOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlRenewDbInstanceOperationCreate,
-		Read:   resourceTencentCloudPostgresqlRenewDbInstanceOperationRead,
-		Delete: resourceTencentCloudPostgresqlRenewDbInstanceOperationDelete,
+		CreateContext: resourceTencentCloudPostgresqlRenewDbInstanceOperationCreate,
+		ReadContext:   resourceTencentCloudPostgresqlRenewDbInstanceOperationRead,
+		DeleteContext: resourceTencentCloudPostgresqlRenewDbInstanceOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"db_instance_id": {
 				Required:    true,
@@ -63,7 +68,7 @@ func resourceTencentCloudPostgresqlRenewDbInstanceOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudPostgresqlRenewDbInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlRenewDbInstanceOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_renew_db_instance_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -96,8 +101,8 @@ func resourceTencentCloudPostgresqlRenewDbInstanceOperationCreate(d *schema.Reso
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().RenewInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().RenewInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -107,30 +112,30 @@ func resourceTencentCloudPostgresqlRenewDbInstanceOperationCreate(d *schema.Reso
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate postgresql RenewDbInstanceOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(dBInstanceId)
 
 	service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"running"}, 10*readRetryTimeout, 5*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"running"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudPostgresqlRenewDbInstanceOperationRead(d, meta)
+	return resourceTencentCloudPostgresqlRenewDbInstanceOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlRenewDbInstanceOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlRenewDbInstanceOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_renew_db_instance_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudPostgresqlRenewDbInstanceOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlRenewDbInstanceOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_renew_db_instance_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
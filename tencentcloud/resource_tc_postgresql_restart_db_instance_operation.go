@@ -12,9 +12,11 @@ resource "tencentcloud_postgresql_restart_db_instance_operation" "restart_db_ins
 package tencentcloud
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -23,9 +25,12 @@ import (
 
 func resourceTencentCloudPostgresqlRestartDbInstanceOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlRestartDbInstanceOperationCreate,
-		Read:   resourceTencentCloudPostgresqlRestartDbInstanceOperationRead,
-		Delete: resourceTencentCloudPostgresqlRestartDbInstanceOperationDelete,
+		CreateContext: resourceTencentCloudPostgresqlRestartDbInstanceOperationCreate,
+		ReadContext:   resourceTencentCloudPostgresqlRestartDbInstanceOperationRead,
+		DeleteContext: resourceTencentCloudPostgresqlRestartDbInstanceOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"db_instance_id": {
 				Required:    true,
@@ -37,7 +42,7 @@ func resourceTencentCloudPostgresqlRestartDbInstanceOperation() *schema.Resource
 	}
 }
 
-func resourceTencentCloudPostgresqlRestartDbInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlRestartDbInstanceOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_restart_db_instance_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -52,8 +57,8 @@ func resourceTencentCloudPostgresqlRestartDbInstanceOperationCreate(d *schema.Re
 		dBInstanceId = v.(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().RestartDBInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().RestartDBInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -63,30 +68,30 @@ func resourceTencentCloudPostgresqlRestartDbInstanceOperationCreate(d *schema.Re
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate postgresql RestartDbInstanceOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(dBInstanceId)
 
 	service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"running"}, 2*readRetryTimeout, 10*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"running"}, d.Timeout(schema.TimeoutCreate), 10*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudPostgresqlRestartDbInstanceOperationRead(d, meta)
+	return resourceTencentCloudPostgresqlRestartDbInstanceOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlRestartDbInstanceOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlRestartDbInstanceOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_restart_db_instance_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudPostgresqlRestartDbInstanceOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlRestartDbInstanceOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_restart_db_instance_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -31,15 +31,23 @@ resource "tencentcloud_postgresql_security_group_config" "security_group_config"
   read_only_group_id = tencentcloud_postgresql_readonly_group.group.id
 }
 ```
+
+Import
+
+postgresql security_group_config can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_postgresql_security_group_config.security_group_config postgres-gzg9jb2n#pgrogrp-m4o1ylxa
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	postgresql "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/postgres/v20170312"
@@ -48,10 +56,16 @@ import (
 
 func resourceTencentCloudPostgresqlSecurityGroupConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlSecurityGroupConfigCreate,
-		Read:   resourceTencentCloudPostgresqlSecurityGroupConfigRead,
-		Update: resourceTencentCloudPostgresqlSecurityGroupConfigUpdate,
-		Delete: resourceTencentCloudPostgresqlSecurityGroupConfigDelete,
+		CreateContext: resourceTencentCloudPostgresqlSecurityGroupConfigCreate,
+		ReadContext:   resourceTencentCloudPostgresqlSecurityGroupConfigRead,
+		UpdateContext: resourceTencentCloudPostgresqlSecurityGroupConfigUpdate,
+		DeleteContext: resourceTencentCloudPostgresqlSecurityGroupConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"security_group_id_set": {
 				Required: true,
@@ -78,7 +92,7 @@ func resourceTencentCloudPostgresqlSecurityGroupConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudPostgresqlSecurityGroupConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlSecurityGroupConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_security_group_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -93,31 +107,31 @@ func resourceTencentCloudPostgresqlSecurityGroupConfigCreate(d *schema.ResourceD
 		roGroupId = v.(string)
 	}
 
-	d.SetId(strings.Join([]string{pgInstanceId, roGroupId}, FILED_SP))
+	d.SetId(helper.IdFormat(pgInstanceId, roGroupId))
 
-	return resourceTencentCloudPostgresqlSecurityGroupConfigUpdate(d, meta)
+	return resourceTencentCloudPostgresqlSecurityGroupConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlSecurityGroupConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlSecurityGroupConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_security_group_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	dBInstanceId := idSplit[0]
 	readOnlyGroupId := idSplit[1]
 
 	SecurityGroupConfigs, err := service.DescribePostgresqlSecurityGroupConfigById(ctx, dBInstanceId, readOnlyGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(SecurityGroupConfigs) < 1 {
@@ -137,7 +151,7 @@ func resourceTencentCloudPostgresqlSecurityGroupConfigRead(d *schema.ResourceDat
 	return nil
 }
 
-func resourceTencentCloudPostgresqlSecurityGroupConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlSecurityGroupConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_security_group_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -145,9 +159,9 @@ func resourceTencentCloudPostgresqlSecurityGroupConfigUpdate(d *schema.ResourceD
 
 	request := postgresql.NewModifyDBInstanceSecurityGroupsRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	dBInstanceId := idSplit[0]
 	readOnlyGroupId := idSplit[1]
@@ -165,8 +179,8 @@ func resourceTencentCloudPostgresqlSecurityGroupConfigUpdate(d *schema.ResourceD
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyDBInstanceSecurityGroups(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyDBInstanceSecurityGroupsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -176,13 +190,13 @@ func resourceTencentCloudPostgresqlSecurityGroupConfigUpdate(d *schema.ResourceD
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update postgresql SecurityGroupConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudPostgresqlSecurityGroupConfigRead(d, meta)
+	return resourceTencentCloudPostgresqlSecurityGroupConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPostgresqlSecurityGroupConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPostgresqlSecurityGroupConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_postgresql_security_group_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -27,12 +27,12 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	privatedns "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/privatedns/v20201028"
@@ -40,12 +40,16 @@ import (
 
 func resourceTencentCloudPrivateDnsRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDPrivateDnsRecordCreate,
-		Read:   resourceTencentCloudDPrivateDnsRecordRead,
-		Update: resourceTencentCloudDPrivateDnsRecordUpdate,
-		Delete: resourceTencentCloudDPrivateDnsRecordDelete,
+		CreateContext: resourceTencentCloudDPrivateDnsRecordCreate,
+		ReadContext:   resourceTencentCloudDPrivateDnsRecordRead,
+		UpdateContext: resourceTencentCloudDPrivateDnsRecordUpdate,
+		DeleteContext: resourceTencentCloudDPrivateDnsRecordDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -91,7 +95,7 @@ func resourceTencentCloudPrivateDnsRecord() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDPrivateDnsRecordCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDPrivateDnsRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_private_dns_record.create")()
 
 	logId := getLogId(contextNil)
@@ -121,42 +125,42 @@ func resourceTencentCloudDPrivateDnsRecordCreate(d *schema.ResourceData, meta in
 		request.TTL = helper.Int64(int64(v.(int)))
 	}
 
-	result, err := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().CreatePrivateZoneRecord(request)
+	result, err := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().CreatePrivateZoneRecordWithContext(ctx, request)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create PrivateDns record failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	response := result
 
 	recordId := *response.Response.RecordId
-	d.SetId(strings.Join([]string{zoneId, recordId}, FILED_SP))
+	d.SetId(helper.IdFormat(zoneId, recordId))
 
-	return resourceTencentCloudDPrivateDnsRecordRead(d, meta)
+	return resourceTencentCloudDPrivateDnsRecordRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDPrivateDnsRecordRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDPrivateDnsRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_private_dns_zone.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PrivateDnsService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("record id strategy is can't read, id is borken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	zoneId := idSplit[0]
 	recordId := idSplit[1]
 
 	records, err := service.DescribePrivateDnsRecordByFilter(ctx, zoneId, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(records) < 1 {
@@ -189,12 +193,12 @@ func resourceTencentCloudDPrivateDnsRecordRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudDPrivateDnsRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDPrivateDnsRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_private_dns_record.update")()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("record id strategy is can't read, id is borken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	logId := getLogId(contextNil)
 	zoneId := idSplit[0]
@@ -248,8 +252,8 @@ func resourceTencentCloudDPrivateDnsRecordUpdate(d *schema.ResourceData, meta in
 		if v, ok := d.GetOk("record_value"); ok {
 			request.RecordValue = helper.String(v.(string))
 		}
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			_, e := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().ModifyPrivateZoneRecord(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			_, e := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().ModifyPrivateZoneRecordWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			}
@@ -257,21 +261,21 @@ func resourceTencentCloudDPrivateDnsRecordUpdate(d *schema.ResourceData, meta in
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s modify privateDns record info failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudDPrivateDnsRecordRead(d, meta)
+	return resourceTencentCloudDPrivateDnsRecordRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDPrivateDnsRecordDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDPrivateDnsRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_private_dns_record.delete")()
 
 	logId := getLogId(contextNil)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("record id strategy is can't read, id is borken, id is %s", d.Id())
+		return diag.Errorf("record id strategy is can't read, id is borken, id is %s", d.Id())
 	}
 	zoneId := idSplit[0]
 	recordId := idSplit[1]
@@ -282,8 +286,8 @@ func resourceTencentCloudDPrivateDnsRecordDelete(d *schema.ResourceData, meta in
 
 	var response *privatedns.DescribePrivateZoneResponse
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().DescribePrivateZone(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().DescribePrivateZoneWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -293,7 +297,7 @@ func resourceTencentCloudDPrivateDnsRecordDelete(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read private dns failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	info := response.Response.PrivateZone
@@ -305,8 +309,8 @@ func resourceTencentCloudDPrivateDnsRecordDelete(d *schema.ResourceData, meta in
 	unBindRequest.VpcSet = []*privatedns.VpcInfo{}
 	unBindRequest.AccountVpcSet = []*privatedns.AccountVpcInfo{}
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().ModifyPrivateZoneVpc(unBindRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().ModifyPrivateZoneVpcWithContext(ctx, unBindRequest)
 		if e != nil {
 			return retryError(e)
 		}
@@ -314,7 +318,7 @@ func resourceTencentCloudDPrivateDnsRecordDelete(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s unbind privateDns zone vpc failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	// delete
@@ -322,8 +326,8 @@ func resourceTencentCloudDPrivateDnsRecordDelete(d *schema.ResourceData, meta in
 	recordRequest.ZoneId = helper.String(zoneId)
 	recordRequest.RecordId = helper.String(recordId)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().DeletePrivateZoneRecord(recordRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().DeletePrivateZoneRecordWithContext(ctx, recordRequest)
 		if e != nil {
 			return retryError(e)
 		}
@@ -331,7 +335,7 @@ func resourceTencentCloudDPrivateDnsRecordDelete(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete privateDns record failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	// rebind
@@ -351,8 +355,8 @@ func resourceTencentCloudDPrivateDnsRecordDelete(d *schema.ResourceData, meta in
 
 	unBindRequest.AccountVpcSet = accountVpcSet
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().ModifyPrivateZoneVpc(unBindRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().ModifyPrivateZoneVpcWithContext(ctx, unBindRequest)
 		if e != nil {
 			return retryError(e)
 		}
@@ -360,7 +364,7 @@ func resourceTencentCloudDPrivateDnsRecordDelete(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s rebind privateDns zone vpc failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
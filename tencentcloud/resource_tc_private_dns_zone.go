@@ -69,11 +69,12 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	privatedns "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/privatedns/v20201028"
@@ -81,12 +82,16 @@ import (
 
 func resourceTencentCloudPrivateDnsZone() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDPrivateDnsZoneCreate,
-		Read:   resourceTencentCloudDPrivateDnsZoneRead,
-		Update: resourceTencentCloudDPrivateDnsZoneUpdate,
-		Delete: resourceTencentCloudDPrivateDnsZoneDelete,
+		CreateContext: resourceTencentCloudDPrivateDnsZoneCreate,
+		ReadContext:   resourceTencentCloudDPrivateDnsZoneRead,
+		UpdateContext: resourceTencentCloudDPrivateDnsZoneUpdate,
+		DeleteContext: resourceTencentCloudDPrivateDnsZoneDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -194,14 +199,14 @@ func resourceTencentCloudPrivateDnsZone() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudDPrivateDnsZoneCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDPrivateDnsZoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_private_dns_zone.create")()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		request = privatedns.NewCreatePrivateZoneRequest()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	domain := d.Get("domain").(string)
 	request.Domain = &domain
@@ -262,11 +267,11 @@ func resourceTencentCloudDPrivateDnsZoneCreate(d *schema.ResourceData, meta inte
 		request.CnameSpeedupStatus = helper.String(cnameSpeedupStatus)
 	}
 
-	result, err := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().CreatePrivateZone(request)
+	result, err := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().CreatePrivateZoneWithContext(ctx, request)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create PrivateDns failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	response := result
@@ -281,29 +286,29 @@ func resourceTencentCloudDPrivateDnsZoneCreate(d *schema.ResourceData, meta inte
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		resourceName := BuildTagResourceName("privatedns", "zone", region, id)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudDPrivateDnsZoneRead(d, meta)
+	return resourceTencentCloudDPrivateDnsZoneRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDPrivateDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDPrivateDnsZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_private_dns_zone.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId    = getLogId(contextNil)
-		ctx      = context.WithValue(context.TODO(), logIdKey, logId)
 		request  = privatedns.NewDescribePrivateZoneRequest()
 		response *privatedns.DescribePrivateZoneResponse
 		id       = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request.ZoneId = &id
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().DescribePrivateZone(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().DescribePrivateZoneWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -314,7 +319,7 @@ func resourceTencentCloudDPrivateDnsZoneRead(d *schema.ResourceData, meta interf
 
 	if err != nil {
 		log.Printf("[CRITAL]%s read DnsPod Domain failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	info := response.Response.PrivateZone
@@ -337,7 +342,7 @@ func resourceTencentCloudDPrivateDnsZoneRead(d *schema.ResourceData, meta interf
 
 	tags, err := tagService.DescribeResourceTags(ctx, "privatedns", "zone", region, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
@@ -365,17 +370,17 @@ func resourceTencentCloudDPrivateDnsZoneRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudDPrivateDnsZoneUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDPrivateDnsZoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_private_dns_zone.update")()
 
 	var (
 		logId = getLogId(contextNil)
-		ctx   = context.WithValue(context.TODO(), logIdKey, logId)
 		id    = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if d.HasChange("tag_set") {
-		return fmt.Errorf("tag_set do not support change, please use tags instead.")
+		return diag.Errorf("tag_set do not support change, please use tags instead.")
 	}
 
 	if d.HasChange("remark") || d.HasChange("dns_forward_status") || d.HasChange("cname_speedup_status") {
@@ -393,8 +398,8 @@ func resourceTencentCloudDPrivateDnsZoneUpdate(d *schema.ResourceData, meta inte
 			request.CnameSpeedupStatus = helper.String(v.(string))
 		}
 
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			_, e := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().ModifyPrivateZone(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			_, e := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().ModifyPrivateZoneWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			}
@@ -403,7 +408,7 @@ func resourceTencentCloudDPrivateDnsZoneUpdate(d *schema.ResourceData, meta inte
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify privateDns zone info failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -440,8 +445,8 @@ func resourceTencentCloudDPrivateDnsZoneUpdate(d *schema.ResourceData, meta inte
 			request.AccountVpcSet = accVpcSets
 		}
 
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			_, e := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().ModifyPrivateZoneVpc(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			_, e := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().ModifyPrivateZoneVpcWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			}
@@ -451,7 +456,7 @@ func resourceTencentCloudDPrivateDnsZoneUpdate(d *schema.ResourceData, meta inte
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify privateDns zone vpc failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -465,15 +470,15 @@ func resourceTencentCloudDPrivateDnsZoneUpdate(d *schema.ResourceData, meta inte
 
 		resourceName := BuildTagResourceName("privatedns", "zone", region, id)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
-	return resourceTencentCloudDPrivateDnsZoneRead(d, meta)
+	return resourceTencentCloudDPrivateDnsZoneRead(ctx, d, meta)
 }
 
-func resourceTencentCloudDPrivateDnsZoneDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudDPrivateDnsZoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_private_dns_zone.delete")()
 
 	var (
@@ -484,8 +489,8 @@ func resourceTencentCloudDPrivateDnsZoneDelete(d *schema.ResourceData, meta inte
 
 	request.ZoneId = &id
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().DeletePrivateZone(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().DeletePrivateZoneWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -495,7 +500,7 @@ func resourceTencentCloudDPrivateDnsZoneDelete(d *schema.ResourceData, meta inte
 
 	if err != nil {
 		log.Printf("[CRITAL]%s delete privateDns zone failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
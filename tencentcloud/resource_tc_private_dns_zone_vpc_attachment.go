@@ -61,10 +61,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	privatedns "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/privatedns/v20201028"
@@ -73,11 +73,14 @@ import (
 
 func resourceTencentCloudPrivateDnsZoneVpcAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPrivateDnsZoneVpcAttachmentCreate,
-		Read:   resourceTencentCloudPrivateDnsZoneVpcAttachmentRead,
-		Delete: resourceTencentCloudPrivateDnsZoneVpcAttachmentDelete,
+		CreateContext: resourceTencentCloudPrivateDnsZoneVpcAttachmentCreate,
+		ReadContext:   resourceTencentCloudPrivateDnsZoneVpcAttachmentRead,
+		DeleteContext: resourceTencentCloudPrivateDnsZoneVpcAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
@@ -139,7 +142,7 @@ func resourceTencentCloudPrivateDnsZoneVpcAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudPrivateDnsZoneVpcAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPrivateDnsZoneVpcAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_private_dns_zone_vpc_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -193,8 +196,8 @@ func resourceTencentCloudPrivateDnsZoneVpcAttachmentCreate(d *schema.ResourceDat
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().AddSpecifyPrivateZoneVpc(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePrivateDnsClient().AddSpecifyPrivateZoneVpcWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -206,27 +209,27 @@ func resourceTencentCloudPrivateDnsZoneVpcAttachmentCreate(d *schema.ResourceDat
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create PrivateDns ZoneVpcAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{zoneId, uniqVpcId}, FILED_SP))
+	d.SetId(helper.IdFormat(zoneId, uniqVpcId))
 
-	return resourceTencentCloudPrivateDnsZoneVpcAttachmentRead(d, meta)
+	return resourceTencentCloudPrivateDnsZoneVpcAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPrivateDnsZoneVpcAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPrivateDnsZoneVpcAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_private_dns_zone_vpc_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = PrivateDnsService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	zoneId := idSplit[0]
@@ -234,7 +237,7 @@ func resourceTencentCloudPrivateDnsZoneVpcAttachmentRead(d *schema.ResourceData,
 
 	ZoneVpcAttachment, err := service.DescribePrivateDnsZoneVpcAttachmentById(ctx, zoneId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ZoneVpcAttachment == nil {
@@ -283,21 +286,21 @@ func resourceTencentCloudPrivateDnsZoneVpcAttachmentRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudPrivateDnsZoneVpcAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPrivateDnsZoneVpcAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_private_dns_zone_vpc_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = PrivateDnsService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region  string
 		uin     string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	zoneId := idSplit[0]
@@ -306,7 +309,7 @@ func resourceTencentCloudPrivateDnsZoneVpcAttachmentDelete(d *schema.ResourceDat
 	// get vpc detail
 	ZoneVpcAttachment, err := service.DescribePrivateDnsZoneVpcAttachmentById(ctx, zoneId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ZoneVpcAttachment == nil {
@@ -335,7 +338,7 @@ func resourceTencentCloudPrivateDnsZoneVpcAttachmentDelete(d *schema.ResourceDat
 	}
 
 	if err = service.DeletePrivateDnsZoneVpcAttachmentById(ctx, zoneId, uniqVpcId, region, uin); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
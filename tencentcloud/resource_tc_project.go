@@ -25,7 +25,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tag "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tag/v20180813"
@@ -34,12 +36,16 @@ import (
 
 func resourceTencentCloudProject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudProjectCreate,
-		Read:   resourceTencentCloudProjectRead,
-		Update: resourceTencentCloudProjectUpdate,
-		Delete: resourceTencentCloudProjectDelete,
+		CreateContext: resourceTencentCloudProjectCreate,
+		ReadContext:   resourceTencentCloudProjectRead,
+		UpdateContext: resourceTencentCloudProjectUpdate,
+		DeleteContext: resourceTencentCloudProjectDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"project_name": {
@@ -76,7 +82,7 @@ func resourceTencentCloudProject() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudProjectCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_project.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -95,8 +101,8 @@ func resourceTencentCloudProjectCreate(d *schema.ResourceData, meta interface{})
 		request.Info = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTagClient().AddProject(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTagClient().AddProjectWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -107,7 +113,7 @@ func resourceTencentCloudProjectCreate(d *schema.ResourceData, meta interface{})
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tag project failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	projectId = *response.Response.ProjectId
@@ -115,27 +121,27 @@ func resourceTencentCloudProjectCreate(d *schema.ResourceData, meta interface{})
 
 	if v, ok := d.GetOkExists("disable"); ok {
 		if v.(int) == 1 {
-			ctx := context.WithValue(context.TODO(), logIdKey, logId)
+			ctx = context.WithValue(ctx, logIdKey, logId)
 
 			service := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 			projectId := helper.StrToUInt64(d.Id())
 
 			if err := service.DisableProjectById(ctx, projectId); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return resourceTencentCloudProjectRead(d, meta)
+	return resourceTencentCloudProjectRead(ctx, d, meta)
 }
 
-func resourceTencentCloudProjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_project.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -143,7 +149,7 @@ func resourceTencentCloudProjectRead(d *schema.ResourceData, meta interface{}) e
 
 	project, disable, err := service.DescribeProjectById(ctx, projectId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if project == nil {
@@ -175,7 +181,7 @@ func resourceTencentCloudProjectRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceTencentCloudProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_project.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -210,8 +216,8 @@ func resourceTencentCloudProjectUpdate(d *schema.ResourceData, meta interface{})
 			request.Disable = helper.IntInt64(v.(int))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTagClient().UpdateProject(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTagClient().UpdateProjectWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -221,25 +227,25 @@ func resourceTencentCloudProjectUpdate(d *schema.ResourceData, meta interface{})
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update tag project failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudProjectRead(d, meta)
+	return resourceTencentCloudProjectRead(ctx, d, meta)
 }
 
-func resourceTencentCloudProjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_project.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 	projectId := helper.StrToUInt64(d.Id())
 
 	if err := service.DisableProjectById(ctx, projectId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -23,19 +23,26 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTencentCloudProtocolTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudProtocolTemplateCreate,
-		Read:   resourceTencentCloudProtocolTemplateRead,
-		Update: resourceTencentCloudProtocolTemplateUpdate,
-		Delete: resourceTencentCloudProtocolTemplateDelete,
+		CreateContext: resourceTencentCloudProtocolTemplateCreate,
+		ReadContext:   resourceTencentCloudProtocolTemplateRead,
+		UpdateContext: resourceTencentCloudProtocolTemplateUpdate,
+		DeleteContext: resourceTencentCloudProtocolTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -58,10 +65,10 @@ func resourceTencentCloudProtocolTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudProtocolTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudProtocolTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_protocol_template.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	name := d.Get("name").(string)
 	protocols := d.Get("protocols").(*schema.Set).List()
@@ -72,7 +79,7 @@ func resourceTencentCloudProtocolTemplateCreate(d *schema.ResourceData, meta int
 	var outErr, inErr error
 	var templateId string
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		templateId, inErr = vpcProtocol.CreateServiceTemplate(ctx, name, protocols)
 		if inErr != nil {
 			return retryError(inErr)
@@ -80,27 +87,27 @@ func resourceTencentCloudProtocolTemplateCreate(d *schema.ResourceData, meta int
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	d.SetId(templateId)
 
-	return resourceTencentCloudProtocolTemplateRead(d, meta)
+	return resourceTencentCloudProtocolTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudProtocolTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudProtocolTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_protocol_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	templateId := d.Id()
 	var outErr, inErr error
 	vpcProtocol := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	template, has, outErr := vpcProtocol.DescribeServiceTemplateById(ctx, templateId)
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			template, has, inErr = vpcProtocol.DescribeServiceTemplateById(ctx, templateId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -109,7 +116,7 @@ func resourceTencentCloudProtocolTemplateRead(d *schema.ResourceData, meta inter
 		})
 	}
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	if !has {
 		d.SetId("")
@@ -122,11 +129,11 @@ func resourceTencentCloudProtocolTemplateRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudProtocolTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudProtocolTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_protocol_template.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	templateId := d.Id()
 
 	if d.HasChange("name") || d.HasChange("protocols") {
@@ -134,7 +141,7 @@ func resourceTencentCloudProtocolTemplateUpdate(d *schema.ResourceData, meta int
 		name := d.Get("name").(string)
 		protocols := d.Get("protocols").(*schema.Set).List()
 		vpcProtocol := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = vpcProtocol.ModifyServiceTemplate(ctx, templateId, name, protocols)
 			if inErr != nil {
 				return retryError(inErr, "UnsupportedOperation.MutexOperationTaskRunning")
@@ -142,25 +149,25 @@ func resourceTencentCloudProtocolTemplateUpdate(d *schema.ResourceData, meta int
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 	}
 
-	return resourceTencentCloudProtocolTemplateRead(d, meta)
+	return resourceTencentCloudProtocolTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudProtocolTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudProtocolTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_protocol_template.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	templateId := d.Id()
 	vpcProtocol := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var outErr, inErr error
 
 	outErr = vpcProtocol.DeleteServiceTemplate(ctx, templateId)
 	if outErr != nil {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			inErr = vpcProtocol.DeleteServiceTemplate(ctx, templateId)
 			if inErr != nil {
 				return retryError(inErr, "UnsupportedOperation.MutexOperationTaskRunning")
@@ -170,10 +177,10 @@ func resourceTencentCloudProtocolTemplateDelete(d *schema.ResourceData, meta int
 	}
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	//check not exist
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, inErr := vpcProtocol.DescribeServiceTemplateById(ctx, templateId)
 		if inErr != nil {
 			return retryError(inErr)
@@ -185,5 +192,5 @@ func resourceTencentCloudProtocolTemplateDelete(d *schema.ResourceData, meta int
 		}
 	})
 
-	return outErr
+	return diag.FromErr(outErr)
 }
@@ -23,19 +23,26 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTencentCloudProtocolTemplateGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudProtocolTemplateGroupCreate,
-		Read:   resourceTencentCloudProtocolTemplateGroupRead,
-		Update: resourceTencentCloudProtocolTemplateGroupUpdate,
-		Delete: resourceTencentCloudProtocolTemplateGroupDelete,
+		CreateContext: resourceTencentCloudProtocolTemplateGroupCreate,
+		ReadContext:   resourceTencentCloudProtocolTemplateGroupRead,
+		UpdateContext: resourceTencentCloudProtocolTemplateGroupUpdate,
+		DeleteContext: resourceTencentCloudProtocolTemplateGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -57,10 +64,10 @@ func resourceTencentCloudProtocolTemplateGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudProtocolTemplateGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudProtocolTemplateGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_protocol_template_group.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	name := d.Get("name").(string)
 	protocols := d.Get("template_ids").(*schema.Set).List()
@@ -71,7 +78,7 @@ func resourceTencentCloudProtocolTemplateGroupCreate(d *schema.ResourceData, met
 	var outErr, inErr error
 	var templateGroupId string
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		templateGroupId, inErr = vpcService.CreateServiceTemplateGroup(ctx, name, protocols)
 		if inErr != nil {
 			return retryError(inErr)
@@ -79,27 +86,27 @@ func resourceTencentCloudProtocolTemplateGroupCreate(d *schema.ResourceData, met
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	d.SetId(templateGroupId)
 
-	return resourceTencentCloudProtocolTemplateGroupRead(d, meta)
+	return resourceTencentCloudProtocolTemplateGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudProtocolTemplateGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudProtocolTemplateGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_protocol_template_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	templateId := d.Id()
 	var outErr, inErr error
 	vpcService := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	templateGroup, has, outErr := vpcService.DescribeServiceTemplateGroupById(ctx, templateId)
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			templateGroup, has, inErr = vpcService.DescribeServiceTemplateGroupById(ctx, templateId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -108,7 +115,7 @@ func resourceTencentCloudProtocolTemplateGroupRead(d *schema.ResourceData, meta
 		})
 	}
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	if !has {
 		d.SetId("")
@@ -121,11 +128,11 @@ func resourceTencentCloudProtocolTemplateGroupRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudProtocolTemplateGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudProtocolTemplateGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_protocol_template_group.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	templateGroupId := d.Id()
 
 	if d.HasChange("name") || d.HasChange("template_ids") {
@@ -133,7 +140,7 @@ func resourceTencentCloudProtocolTemplateGroupUpdate(d *schema.ResourceData, met
 		name := d.Get("name").(string)
 		templadteIds := d.Get("template_ids").(*schema.Set).List()
 		vpcService := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = vpcService.ModifyServiceTemplateGroup(ctx, templateGroupId, name, templadteIds)
 			if inErr != nil {
 				return retryError(inErr, "UnsupportedOperation.MutexOperationTaskRunning")
@@ -141,26 +148,26 @@ func resourceTencentCloudProtocolTemplateGroupUpdate(d *schema.ResourceData, met
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 	}
 
-	return resourceTencentCloudProtocolTemplateGroupRead(d, meta)
+	return resourceTencentCloudProtocolTemplateGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudProtocolTemplateGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudProtocolTemplateGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_protocol_template_group.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	templateGroupId := d.Id()
 	vpcService := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var outErr, inErr error
 
 	outErr = vpcService.DeleteServiceTemplateGroup(ctx, templateGroupId)
 	if outErr != nil {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			inErr = vpcService.DeleteServiceTemplateGroup(ctx, templateGroupId)
 			if inErr != nil {
 				return retryError(inErr, "UnsupportedOperation.MutexOperationTaskRunning")
@@ -170,11 +177,11 @@ func resourceTencentCloudProtocolTemplateGroupDelete(d *schema.ResourceData, met
 	}
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	//check not exist
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, inErr := vpcService.DescribeServiceTemplateGroupById(ctx, templateGroupId)
 		if inErr != nil {
 			return retryError(inErr)
@@ -186,5 +193,5 @@ func resourceTencentCloudProtocolTemplateGroupDelete(d *schema.ResourceData, met
 		}
 	})
 
-	return outErr
+	return diag.FromErr(outErr)
 }
@@ -63,10 +63,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -76,12 +76,15 @@ import (
 
 func resourceTencentCloudPtsAlertChannel() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudPtsAlertChannelRead,
-		Create: resourceTencentCloudPtsAlertChannelCreate,
-		Update: resourceTencentCloudPtsAlertChannelUpdate,
-		Delete: resourceTencentCloudPtsAlertChannelDelete,
+		ReadContext:   resourceTencentCloudPtsAlertChannelRead,
+		CreateContext: resourceTencentCloudPtsAlertChannelCreate,
+		UpdateContext: resourceTencentCloudPtsAlertChannelUpdate,
+		DeleteContext: resourceTencentCloudPtsAlertChannelDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"notice_id": {
@@ -141,7 +144,7 @@ func resourceTencentCloudPtsAlertChannel() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudPtsAlertChannelCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsAlertChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_alert_channel.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -167,8 +170,8 @@ func resourceTencentCloudPtsAlertChannelCreate(d *schema.ResourceData, meta inte
 		request.AMPConsumerId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().CreateAlertChannel(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().CreateAlertChannelWithContext(ctx, request)
 		if e != nil {
 			if sdkError, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "FailedOperation.DbRecordCreateFailed" {
@@ -185,25 +188,25 @@ func resourceTencentCloudPtsAlertChannelCreate(d *schema.ResourceData, meta inte
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create pts alertChannel failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(projectId + FILED_SP + noticeId)
-	return resourceTencentCloudPtsAlertChannelRead(d, meta)
+	d.SetId(helper.IdFormat(projectId, noticeId))
+	return resourceTencentCloudPtsAlertChannelRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPtsAlertChannelRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsAlertChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_alert_channel.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	projectId := idSplit[0]
 	noticeId := idSplit[1]
@@ -211,12 +214,12 @@ func resourceTencentCloudPtsAlertChannelRead(d *schema.ResourceData, meta interf
 	alertChannel, err := service.DescribePtsAlertChannel(ctx, noticeId, projectId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if alertChannel == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `alertChannel` %s does not exist", noticeId)
+		return diag.Errorf("resource `alertChannel` %s does not exist", noticeId)
 	}
 
 	if alertChannel.NoticeId != nil {
@@ -258,31 +261,31 @@ func resourceTencentCloudPtsAlertChannelRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudPtsAlertChannelUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsAlertChannelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_alert_channel.update")()
 	defer inconsistentCheck(d, meta)()
 
-	return resourceTencentCloudPtsAlertChannelRead(d, meta)
+	return resourceTencentCloudPtsAlertChannelRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPtsAlertChannelDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsAlertChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_alert_channel.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	projectId := idSplit[0]
 	noticeId := idSplit[1]
 
 	if err := service.DeletePtsAlertChannelById(ctx, noticeId, projectId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
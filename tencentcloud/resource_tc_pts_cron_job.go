@@ -29,10 +29,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	pts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/pts/v20210728"
@@ -41,12 +41,16 @@ import (
 
 func resourceTencentCloudPtsCronJob() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudPtsCronJobRead,
-		Create: resourceTencentCloudPtsCronJobCreate,
-		Update: resourceTencentCloudPtsCronJobUpdate,
-		Delete: resourceTencentCloudPtsCronJobDelete,
+		ReadContext:   resourceTencentCloudPtsCronJobRead,
+		CreateContext: resourceTencentCloudPtsCronJobCreate,
+		UpdateContext: resourceTencentCloudPtsCronJobUpdate,
+		DeleteContext: resourceTencentCloudPtsCronJobDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -154,7 +158,7 @@ func resourceTencentCloudPtsCronJob() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudPtsCronJobCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsCronJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_cron_job.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -208,8 +212,8 @@ func resourceTencentCloudPtsCronJobCreate(d *schema.ResourceData, meta interface
 		request.Note = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().CreateCronJob(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().CreateCronJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -222,27 +226,27 @@ func resourceTencentCloudPtsCronJobCreate(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create pts cronJob failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	cronJobId = *response.Response.CronJobId
 
-	d.SetId(projectId + FILED_SP + cronJobId)
-	return resourceTencentCloudPtsCronJobRead(d, meta)
+	d.SetId(helper.IdFormat(projectId, cronJobId))
+	return resourceTencentCloudPtsCronJobRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPtsCronJobRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsCronJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_cron_job.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	projectId := idSplit[0]
 	cronJobId := idSplit[1]
@@ -250,12 +254,12 @@ func resourceTencentCloudPtsCronJobRead(d *schema.ResourceData, meta interface{}
 	cronJob, err := service.DescribePtsCronJob(ctx, cronJobId, projectId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if cronJob == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `cronJob` %s does not exist", cronJobId)
+		return diag.Errorf("resource `cronJob` %s does not exist", cronJobId)
 	}
 
 	if cronJob.Name != nil {
@@ -329,7 +333,7 @@ func resourceTencentCloudPtsCronJobRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudPtsCronJobUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsCronJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_cron_job.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -337,9 +341,9 @@ func resourceTencentCloudPtsCronJobUpdate(d *schema.ResourceData, meta interface
 
 	request := pts.NewUpdateCronJobRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	projectId := idSplit[0]
 	cronJobId := idSplit[1]
@@ -383,8 +387,8 @@ func resourceTencentCloudPtsCronJobUpdate(d *schema.ResourceData, meta interface
 		request.Note = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().UpdateCronJob(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().UpdateCronJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -396,30 +400,30 @@ func resourceTencentCloudPtsCronJobUpdate(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create pts cronJob failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudPtsCronJobRead(d, meta)
+	return resourceTencentCloudPtsCronJobRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPtsCronJobDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsCronJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_cron_job.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	projectId := idSplit[0]
 	cronJobId := idSplit[1]
 
 	if err := service.DeletePtsCronJobById(ctx, cronJobId, projectId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
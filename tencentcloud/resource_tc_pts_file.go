@@ -36,10 +36,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -49,12 +49,15 @@ import (
 
 func resourceTencentCloudPtsFile() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudPtsFileRead,
-		Create: resourceTencentCloudPtsFileCreate,
-		Update: resourceTencentCloudPtsFileUpdate,
-		Delete: resourceTencentCloudPtsFileDelete,
+		ReadContext:   resourceTencentCloudPtsFileRead,
+		CreateContext: resourceTencentCloudPtsFileCreate,
+		UpdateContext: resourceTencentCloudPtsFileUpdate,
+		DeleteContext: resourceTencentCloudPtsFileDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"file_id": {
@@ -170,7 +173,7 @@ func resourceTencentCloudPtsFile() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudPtsFileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsFileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_file.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -264,8 +267,8 @@ func resourceTencentCloudPtsFileCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().CreateFile(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().CreateFileWithContext(ctx, request)
 		if e != nil {
 			if sdkError, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "FailedOperation.DbRecordCreateFailed" {
@@ -282,25 +285,25 @@ func resourceTencentCloudPtsFileCreate(d *schema.ResourceData, meta interface{})
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create pts file failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(projectId + FILED_SP + fileId)
-	return resourceTencentCloudPtsFileRead(d, meta)
+	d.SetId(helper.IdFormat(projectId, fileId))
+	return resourceTencentCloudPtsFileRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPtsFileRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_file.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	projectId := idSplit[0]
 	fileId := idSplit[1]
@@ -308,12 +311,12 @@ func resourceTencentCloudPtsFileRead(d *schema.ResourceData, meta interface{}) e
 	file, err := service.DescribePtsFile(ctx, projectId, fileId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if file == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `file` %s does not exist", fileId)
+		return diag.Errorf("resource `file` %s does not exist", fileId)
 	}
 
 	if file.FileId != nil {
@@ -388,31 +391,31 @@ func resourceTencentCloudPtsFileRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceTencentCloudPtsFileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsFileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_file.update")()
 	defer inconsistentCheck(d, meta)()
 
-	return resourceTencentCloudPtsFileRead(d, meta)
+	return resourceTencentCloudPtsFileRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPtsFileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsFileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_file.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	projectId := idSplit[0]
 	fileId := idSplit[1]
 
 	if err := service.DeletePtsFileById(ctx, projectId, fileId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -24,10 +24,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	pts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/pts/v20210728"
@@ -36,12 +36,16 @@ import (
 
 func resourceTencentCloudPtsJob() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudPtsJobRead,
-		Create: resourceTencentCloudPtsJobCreate,
-		Update: resourceTencentCloudPtsJobUpdate,
-		Delete: resourceTencentCloudPtsJobDelete,
+		ReadContext:   resourceTencentCloudPtsJobRead,
+		CreateContext: resourceTencentCloudPtsJobCreate,
+		UpdateContext: resourceTencentCloudPtsJobUpdate,
+		DeleteContext: resourceTencentCloudPtsJobDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"scenario_id": {
@@ -699,7 +703,7 @@ func resourceTencentCloudPtsJob() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudPtsJobCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_job.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -735,8 +739,8 @@ func resourceTencentCloudPtsJobCreate(d *schema.ResourceData, meta interface{})
 		request.Note = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().StartJob(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().StartJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -749,27 +753,27 @@ func resourceTencentCloudPtsJobCreate(d *schema.ResourceData, meta interface{})
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create pts job failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	jobId = *response.Response.JobId
 
-	d.SetId(projectId + FILED_SP + scenarioId + FILED_SP + jobId)
-	return resourceTencentCloudPtsJobRead(d, meta)
+	d.SetId(helper.IdFormat(projectId, scenarioId, jobId))
+	return resourceTencentCloudPtsJobRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPtsJobRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_job.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	projectId := idSplit[0]
 	scenarioId := idSplit[1]
@@ -778,12 +782,12 @@ func resourceTencentCloudPtsJobRead(d *schema.ResourceData, meta interface{}) er
 	job, err := service.DescribePtsJob(ctx, projectId, scenarioId, jobId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if job == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `job` %s does not exist", jobId)
+		return diag.Errorf("resource `job` %s does not exist", jobId)
 	}
 
 	if job.ScenarioId != nil {
@@ -1059,7 +1063,7 @@ func resourceTencentCloudPtsJobRead(d *schema.ResourceData, meta interface{}) er
 			if testScripts.EncodedContent != nil {
 				content, err := Base64ToString(*testScripts.EncodedContent)
 				if err != nil {
-					return fmt.Errorf("`testScripts.EncodedContent` %s does not be decoded to string", *testScripts.EncodedContent)
+					return diag.Errorf("`testScripts.EncodedContent` %s does not be decoded to string", *testScripts.EncodedContent)
 				}
 				testScriptsMap["encoded_content"] = content
 			}
@@ -1214,7 +1218,7 @@ func resourceTencentCloudPtsJobRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceTencentCloudPtsJobUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_job.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -1222,9 +1226,9 @@ func resourceTencentCloudPtsJobUpdate(d *schema.ResourceData, meta interface{})
 
 	request := pts.NewUpdateJobRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	projectId := idSplit[0]
 	scenarioId := idSplit[1]
@@ -1235,11 +1239,11 @@ func resourceTencentCloudPtsJobUpdate(d *schema.ResourceData, meta interface{})
 	request.JobId = &jobId
 
 	if d.HasChange("job_owner") {
-		return fmt.Errorf("`job_owner` do not support change now.")
+		return diag.Errorf("`job_owner` do not support change now.")
 	}
 
 	if d.HasChange("debug") {
-		return fmt.Errorf("`debug` do not support change now.")
+		return diag.Errorf("`debug` do not support change now.")
 	}
 
 	if d.HasChange("note") {
@@ -1248,8 +1252,8 @@ func resourceTencentCloudPtsJobUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().UpdateJob(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().UpdateJobWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1261,31 +1265,31 @@ func resourceTencentCloudPtsJobUpdate(d *schema.ResourceData, meta interface{})
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create pts job failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudPtsJobRead(d, meta)
+	return resourceTencentCloudPtsJobRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPtsJobDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_job.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	projectId := idSplit[0]
 	scenarioId := idSplit[1]
 	jobId := idSplit[2]
 
 	if err := service.DeletePtsJobById(ctx, projectId, scenarioId, jobId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
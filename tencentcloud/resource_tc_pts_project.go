@@ -25,10 +25,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	pts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/pts/v20210728"
@@ -37,12 +38,16 @@ import (
 
 func resourceTencentCloudPtsProject() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudPtsProjectRead,
-		Create: resourceTencentCloudPtsProjectCreate,
-		Update: resourceTencentCloudPtsProjectUpdate,
-		Delete: resourceTencentCloudPtsProjectDelete,
+		ReadContext:   resourceTencentCloudPtsProjectRead,
+		CreateContext: resourceTencentCloudPtsProjectCreate,
+		UpdateContext: resourceTencentCloudPtsProjectUpdate,
+		DeleteContext: resourceTencentCloudPtsProjectDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -116,7 +121,7 @@ func resourceTencentCloudPtsProject() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudPtsProjectCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_project.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -153,8 +158,8 @@ func resourceTencentCloudPtsProjectCreate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().CreateProject(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().CreateProjectWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -167,21 +172,21 @@ func resourceTencentCloudPtsProjectCreate(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create pts project failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	projectId = *response.Response.ProjectId
 
 	d.SetId(projectId)
-	return resourceTencentCloudPtsProjectRead(d, meta)
+	return resourceTencentCloudPtsProjectRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPtsProjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_project.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -190,12 +195,12 @@ func resourceTencentCloudPtsProjectRead(d *schema.ResourceData, meta interface{}
 	project, err := service.DescribePtsProject(ctx, projectId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if project == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `project` %s does not exist", projectId)
+		return diag.Errorf("resource `project` %s does not exist", projectId)
 	}
 
 	if project.Name != nil {
@@ -249,7 +254,7 @@ func resourceTencentCloudPtsProjectRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudPtsProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_project.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -286,8 +291,8 @@ func resourceTencentCloudPtsProjectUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().UpdateProject(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().UpdateProjectWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -299,25 +304,25 @@ func resourceTencentCloudPtsProjectUpdate(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create pts project failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudPtsProjectRead(d, meta)
+	return resourceTencentCloudPtsProjectRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPtsProjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_project.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	projectId := d.Id()
 
 	if err := service.DeletePtsProjectById(ctx, projectId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
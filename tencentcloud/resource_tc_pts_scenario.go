@@ -101,10 +101,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	pts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/pts/v20210728"
@@ -113,12 +113,16 @@ import (
 
 func resourceTencentCloudPtsScenario() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudPtsScenarioRead,
-		Create: resourceTencentCloudPtsScenarioCreate,
-		Update: resourceTencentCloudPtsScenarioUpdate,
-		Delete: resourceTencentCloudPtsScenarioDelete,
+		ReadContext:   resourceTencentCloudPtsScenarioRead,
+		CreateContext: resourceTencentCloudPtsScenarioCreate,
+		UpdateContext: resourceTencentCloudPtsScenarioUpdate,
+		DeleteContext: resourceTencentCloudPtsScenarioDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -773,7 +777,7 @@ func resourceTencentCloudPtsScenario() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudPtsScenarioCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsScenarioCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_scenario.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -1181,8 +1185,8 @@ func resourceTencentCloudPtsScenarioCreate(d *schema.ResourceData, meta interfac
 		requestUp.DomainNameConfig = &domainNameConfig
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().CreateScenario(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().CreateScenarioWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1195,14 +1199,14 @@ func resourceTencentCloudPtsScenarioCreate(d *schema.ResourceData, meta interfac
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create pts scenario failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	scenarioId = *response.Response.ScenarioId
 
 	requestUp.ScenarioId = &scenarioId
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().UpdateScenario(requestUp)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().UpdateScenarioWithContext(ctx, requestUp)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1214,25 +1218,25 @@ func resourceTencentCloudPtsScenarioCreate(d *schema.ResourceData, meta interfac
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create pts scenario failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(projectId + FILED_SP + scenarioId)
-	return resourceTencentCloudPtsScenarioRead(d, meta)
+	d.SetId(helper.IdFormat(projectId, scenarioId))
+	return resourceTencentCloudPtsScenarioRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPtsScenarioRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsScenarioRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_scenario.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	projectId := idSplit[0]
 	scenarioId := idSplit[1]
@@ -1240,12 +1244,12 @@ func resourceTencentCloudPtsScenarioRead(d *schema.ResourceData, meta interface{
 	scenario, err := service.DescribePtsScenario(ctx, projectId, scenarioId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if scenario == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `scenario` %s does not exist", scenarioId)
+		return diag.Errorf("resource `scenario` %s does not exist", scenarioId)
 	}
 
 	if scenario.Name != nil {
@@ -1446,14 +1450,14 @@ func resourceTencentCloudPtsScenarioRead(d *schema.ResourceData, meta interface{
 			if testScripts.EncodedContent != nil {
 				content, err := Base64ToString(*testScripts.EncodedContent)
 				if err != nil {
-					return fmt.Errorf("`testScripts.EncodedContent` %s does not be decoded to string", *testScripts.EncodedContent)
+					return diag.Errorf("`testScripts.EncodedContent` %s does not be decoded to string", *testScripts.EncodedContent)
 				}
 				testScriptsMap["encoded_content"] = content
 			}
 			if testScripts.EncodedHttpArchive != nil {
 				archive, err := Base64ToString(*testScripts.EncodedHttpArchive)
 				if err != nil {
-					return fmt.Errorf("`testScripts.EncodedHttpArchive` %s does not be decoded to string", *testScripts.EncodedHttpArchive)
+					return diag.Errorf("`testScripts.EncodedHttpArchive` %s does not be decoded to string", *testScripts.EncodedHttpArchive)
 				}
 				testScriptsMap["encoded_http_archive"] = archive
 			}
@@ -1675,7 +1679,7 @@ func resourceTencentCloudPtsScenarioRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTencentCloudPtsScenarioUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsScenarioUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_scenario.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -1683,9 +1687,9 @@ func resourceTencentCloudPtsScenarioUpdate(d *schema.ResourceData, meta interfac
 
 	request := pts.NewUpdateScenarioRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	projectId := idSplit[0]
 	scenarioId := idSplit[1]
@@ -1694,7 +1698,7 @@ func resourceTencentCloudPtsScenarioUpdate(d *schema.ResourceData, meta interfac
 	request.ScenarioId = &scenarioId
 
 	if d.HasChange("project_id") {
-		return fmt.Errorf("`project_id` do not support change now.")
+		return diag.Errorf("`project_id` do not support change now.")
 	}
 
 	if v, ok := d.GetOk("name"); ok {
@@ -2074,8 +2078,8 @@ func resourceTencentCloudPtsScenarioUpdate(d *schema.ResourceData, meta interfac
 		request.DomainNameConfig = &domainNameConfig
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().UpdateScenario(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().UpdateScenarioWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -2087,30 +2091,30 @@ func resourceTencentCloudPtsScenarioUpdate(d *schema.ResourceData, meta interfac
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create pts scenario failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudPtsScenarioRead(d, meta)
+	return resourceTencentCloudPtsScenarioRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPtsScenarioDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsScenarioDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_scenario.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := PtsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	projectId := idSplit[0]
 	scenarioId := idSplit[1]
 
 	if err := service.DeletePtsScenarioById(ctx, projectId, scenarioId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
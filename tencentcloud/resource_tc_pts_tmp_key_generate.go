@@ -13,8 +13,11 @@ resource "tencentcloud_pts_tmp_key_generate" "tmp_key" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	pts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/pts/v20210728"
@@ -23,9 +26,12 @@ import (
 
 func resourceTencentCloudPtsTmpKeyGenerate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPtsTmpKeyGenerateCreate,
-		Read:   resourceTencentCloudPtsTmpKeyGenerateRead,
-		Delete: resourceTencentCloudPtsTmpKeyGenerateDelete,
+		CreateContext: resourceTencentCloudPtsTmpKeyGenerateCreate,
+		ReadContext:   resourceTencentCloudPtsTmpKeyGenerateRead,
+		DeleteContext: resourceTencentCloudPtsTmpKeyGenerateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
@@ -80,7 +86,7 @@ func resourceTencentCloudPtsTmpKeyGenerate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudPtsTmpKeyGenerateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsTmpKeyGenerateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_tmp_key_generate.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -100,8 +106,8 @@ func resourceTencentCloudPtsTmpKeyGenerateCreate(d *schema.ResourceData, meta in
 		request.ScenarioId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().GenerateTmpKey(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UsePtsClient().GenerateTmpKeyWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -112,7 +118,7 @@ func resourceTencentCloudPtsTmpKeyGenerateCreate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate pts tmpKey failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(projectId)
@@ -145,17 +151,17 @@ func resourceTencentCloudPtsTmpKeyGenerateCreate(d *schema.ResourceData, meta in
 		}
 	}
 
-	return resourceTencentCloudPtsTmpKeyGenerateRead(d, meta)
+	return resourceTencentCloudPtsTmpKeyGenerateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudPtsTmpKeyGenerateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsTmpKeyGenerateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_tmp_key_generate.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudPtsTmpKeyGenerateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudPtsTmpKeyGenerateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_pts_tmp_key_generate.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -99,11 +99,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -113,12 +112,17 @@ import (
 
 func resourceTencentCloudRedisAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisAccountCreate,
-		Read:   resourceTencentCloudRedisAccountRead,
-		Update: resourceTencentCloudRedisAccountUpdate,
-		Delete: resourceTencentCloudRedisAccountDelete,
+		CreateContext: resourceTencentCloudRedisAccountCreate,
+		ReadContext:   resourceTencentCloudRedisAccountRead,
+		UpdateContext: resourceTencentCloudRedisAccountUpdate,
+		DeleteContext: resourceTencentCloudRedisAccountDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(18 * time.Minute),
+			Update: schema.DefaultTimeout(18 * time.Minute),
+			Delete: schema.DefaultTimeout(18 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -164,12 +168,12 @@ func resourceTencentCloudRedisAccount() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRedisAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_account.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request     = redis.NewCreateInstanceAccountRequest()
@@ -207,8 +211,8 @@ func resourceTencentCloudRedisAccountCreate(d *schema.ResourceData, meta interfa
 		request.Privilege = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().CreateInstanceAccount(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().CreateInstanceAccountWithContext(ctx, request)
 		if e != nil {
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 				if ee.Code == "FailedOperation.SystemError" {
@@ -224,68 +228,54 @@ func resourceTencentCloudRedisAccountCreate(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create redis account failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(instanceId + FILED_SP + accountName)
+	d.SetId(helper.IdFormat(instanceId, accountName))
 
 	taskId := *response.Response.TaskId
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("create account is processing"))
-		}
-	})
+	err = service.WaitForTask(ctx, taskId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s redis create account fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	conf := BuildStateChangeConf(
 		[]string{},
 		[]string{"2"},
-		6*readRetryTimeout,
+		d.Timeout(schema.TimeoutCreate),
 		time.Second,
 		service.RedisAccountStateRefreshFunc(instanceId, accountName, []string{}),
 	)
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudRedisAccountRead(d, meta)
+	return resourceTencentCloudRedisAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_account.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	accountName := idSplit[1]
 
 	account, err := service.DescribeRedisAccountById(ctx, instanceId, accountName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if account == nil {
@@ -321,19 +311,19 @@ func resourceTencentCloudRedisAccountRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudRedisAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_account.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := redis.NewModifyInstanceAccountRequest()
 	response := redis.NewModifyInstanceAccountResponse()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	instanceId := idSplit[0]
 	accountName := idSplit[1]
@@ -345,7 +335,7 @@ func resourceTencentCloudRedisAccountUpdate(d *schema.ResourceData, meta interfa
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -373,8 +363,8 @@ func resourceTencentCloudRedisAccountUpdate(d *schema.ResourceData, meta interfa
 		request.Privilege = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ModifyInstanceAccount(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ModifyInstanceAccountWithContext(ctx, request)
 		if e != nil {
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 				if ee.Code == "FailedOperation.SystemError" {
@@ -390,86 +380,58 @@ func resourceTencentCloudRedisAccountUpdate(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update redis account failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	taskId := *response.Response.TaskId
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("change account is processing"))
-		}
-	})
+	err = service.WaitForTask(ctx, taskId, d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s redis change account fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	conf := BuildStateChangeConf(
 		[]string{},
 		[]string{"2"},
-		6*readRetryTimeout,
+		d.Timeout(schema.TimeoutUpdate),
 		time.Second,
 		service.RedisAccountStateRefreshFunc(instanceId, accountName, []string{}),
 	)
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudRedisAccountRead(d, meta)
+	return resourceTencentCloudRedisAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_account.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	accountName := idSplit[1]
 
 	taskId, err := service.DeleteRedisAccountById(ctx, instanceId, accountName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("delete account is processing"))
-		}
-	})
+	err = service.WaitForTask(ctx, taskId, d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s redis delete account fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
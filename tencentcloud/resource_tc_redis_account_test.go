@@ -3,12 +3,12 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 // go test -i; go test -test.run TestAccTencentCloudRedisAccountResource_basic -v
@@ -52,7 +52,7 @@ func testAccTencentCloudRedisAccountExists(r string) resource.TestCheckFunc {
 			return fmt.Errorf("resource %s is not found", r)
 		}
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) != 2 {
 			return fmt.Errorf("invalid ID %s", rs.Primary.ID)
 		}
@@ -83,7 +83,7 @@ func testAccTencentCloudRedisAccountDestroy(s *terraform.State) error {
 		}
 		time.Sleep(5 * time.Second)
 
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) != 2 {
 			return fmt.Errorf("invalid ID %s", rs.Primary.ID)
 		}
@@ -54,8 +54,8 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -63,13 +63,13 @@ import (
 
 func resourceTencentCloudRedisBackupConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisBackupConfigCreate,
-		Read:   resourceTencentCloudRedisBackupConfigRead,
-		Update: resourceTencentCloudRedisBackupConfigUpdate,
-		Delete: resourceTencentCloudRedisBackupConfigDelete,
+		CreateContext: resourceTencentCloudRedisBackupConfigCreate,
+		ReadContext:   resourceTencentCloudRedisBackupConfigRead,
+		UpdateContext: resourceTencentCloudRedisBackupConfigUpdate,
+		DeleteContext: resourceTencentCloudRedisBackupConfigDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -106,23 +106,23 @@ func resourceTencentCloudRedisBackupConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRedisBackupConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisBackupConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_backup_config.create")()
 
 	d.SetId(d.Get("redis_id").(string))
 
-	return resourceTencentCloudRedisBackupConfigUpdate(d, meta)
+	return resourceTencentCloudRedisBackupConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisBackupConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisBackupConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_backup_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		backupPeriods, backupTime, e := service.DescribeAutoBackupConfig(ctx, d.Id())
 		if e != nil {
 			if sdkErr, ok := e.(*errors.TencentCloudSDKError); ok {
@@ -139,12 +139,12 @@ func resourceTencentCloudRedisBackupConfigRead(d *schema.ResourceData, meta inte
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudRedisBackupConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisBackupConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_backup_config.update")()
 
 	weeksAllows := map[string]bool{
@@ -153,7 +153,7 @@ func resourceTencentCloudRedisBackupConfigUpdate(d *schema.ResourceData, meta in
 	}
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -167,14 +167,14 @@ func resourceTencentCloudRedisBackupConfigUpdate(d *schema.ResourceData, meta in
 
 	for _, v := range interfaceBackupPeriods {
 		if !weeksAllows[v.(string)] {
-			return fmt.Errorf("redis backup config[backup_period] not supports %s", v.(string))
+			return diag.Errorf("redis backup config[backup_period] not supports %s", v.(string))
 		}
 	}
 
 	backupPeriods := []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
 
 	if len(backupPeriods) == 0 {
-		return fmt.Errorf("redis backup config[backup_period] can not empty")
+		return diag.Errorf("redis backup config[backup_period] can not empty")
 	}
 
 	if d.HasChange("backup_time") || d.HasChange("backup_period") {
@@ -184,17 +184,17 @@ func resourceTencentCloudRedisBackupConfigUpdate(d *schema.ResourceData, meta in
 	if isUpdate {
 		err := service.ModifyAutoBackupConfig(ctx, redisId, backupPeriods, backupTime)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudRedisBackupConfigRead(d, meta)
+	return resourceTencentCloudRedisBackupConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisBackupConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisBackupConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_backup_config.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -205,7 +205,7 @@ func resourceTencentCloudRedisBackupConfigDelete(d *schema.ResourceData, meta in
 
 	err := service.ModifyAutoBackupConfig(ctx, d.Id(), backupPeriods, backupTime)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 
@@ -31,7 +31,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
@@ -40,12 +42,15 @@ import (
 
 func resourceTencentCloudRedisBackupDownloadRestriction() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisBackupDownloadRestrictionCreate,
-		Read:   resourceTencentCloudRedisBackupDownloadRestrictionRead,
-		Update: resourceTencentCloudRedisBackupDownloadRestrictionUpdate,
-		Delete: resourceTencentCloudRedisBackupDownloadRestrictionDelete,
+		CreateContext: resourceTencentCloudRedisBackupDownloadRestrictionCreate,
+		ReadContext:   resourceTencentCloudRedisBackupDownloadRestrictionRead,
+		UpdateContext: resourceTencentCloudRedisBackupDownloadRestrictionUpdate,
+		DeleteContext: resourceTencentCloudRedisBackupDownloadRestrictionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"limit_type": {
@@ -101,7 +106,7 @@ func resourceTencentCloudRedisBackupDownloadRestriction() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRedisBackupDownloadRestrictionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisBackupDownloadRestrictionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_backup_download_restriction.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -109,21 +114,21 @@ func resourceTencentCloudRedisBackupDownloadRestrictionCreate(d *schema.Resource
 
 	d.SetId(region)
 
-	return resourceTencentCloudRedisBackupDownloadRestrictionUpdate(d, meta)
+	return resourceTencentCloudRedisBackupDownloadRestrictionUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisBackupDownloadRestrictionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisBackupDownloadRestrictionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_backup_download_restriction.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 	backupDownloadRestriction, err := service.DescribeRedisBackupDownloadRestrictionById(ctx)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if backupDownloadRestriction == nil {
@@ -171,7 +176,7 @@ func resourceTencentCloudRedisBackupDownloadRestrictionRead(d *schema.ResourceDa
 	return nil
 }
 
-func resourceTencentCloudRedisBackupDownloadRestrictionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisBackupDownloadRestrictionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_backup_download_restriction.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -219,8 +224,8 @@ func resourceTencentCloudRedisBackupDownloadRestrictionUpdate(d *schema.Resource
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ModifyBackupDownloadRestriction(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ModifyBackupDownloadRestrictionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -230,13 +235,13 @@ func resourceTencentCloudRedisBackupDownloadRestrictionUpdate(d *schema.Resource
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update redis backupDownloadRestriction failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudRedisBackupDownloadRestrictionRead(d, meta)
+	return resourceTencentCloudRedisBackupDownloadRestrictionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisBackupDownloadRestrictionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisBackupDownloadRestrictionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_backup_download_restriction.delete")()
 	defer inconsistentCheck(d, meta)()
 
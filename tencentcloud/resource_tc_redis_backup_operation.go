@@ -19,21 +19,24 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudRedisBackupOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisBackupOperationCreate,
-		Read:   resourceTencentCloudRedisBackupOperationRead,
-		Delete: resourceTencentCloudRedisBackupOperationDelete,
+		CreateContext: resourceTencentCloudRedisBackupOperationCreate,
+		ReadContext:   resourceTencentCloudRedisBackupOperationRead,
+		DeleteContext: resourceTencentCloudRedisBackupOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(18 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -60,12 +63,12 @@ func resourceTencentCloudRedisBackupOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRedisBackupOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisBackupOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_backup_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request    = redis.NewManualBackupInstanceRequest()
@@ -85,8 +88,8 @@ func resourceTencentCloudRedisBackupOperationCreate(d *schema.ResourceData, meta
 		request.StorageDays = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ManualBackupInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ManualBackupInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -97,7 +100,7 @@ func resourceTencentCloudRedisBackupOperationCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate redis backupOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	taskId := *response.Response.TaskId
@@ -105,39 +108,25 @@ func resourceTencentCloudRedisBackupOperationCreate(d *schema.ResourceData, meta
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 	if taskId > 0 {
-		err := resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-			ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-			if err != nil {
-				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-			if ok {
-				return nil
-			} else {
-				return resource.RetryableError(fmt.Errorf("redis backupOperation is processing"))
-			}
-		})
+		err := service.WaitForTask(ctx, taskId, d.Timeout(schema.TimeoutCreate))
 
 		if err != nil {
 			log.Printf("[CRITAL]%s redis backupOperation fail, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudRedisBackupOperationRead(d, meta)
+	return resourceTencentCloudRedisBackupOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisBackupOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisBackupOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_backup_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudRedisBackupOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisBackupOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_backup_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
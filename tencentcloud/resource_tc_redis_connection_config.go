@@ -55,9 +55,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -67,12 +68,15 @@ import (
 
 func resourceTencentCloudRedisConnectionConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisConnectionConfigCreate,
-		Read:   resourceTencentCloudRedisConnectionConfigRead,
-		Update: resourceTencentCloudRedisConnectionConfigUpdate,
-		Delete: resourceTencentCloudRedisConnectionConfigDelete,
+		CreateContext: resourceTencentCloudRedisConnectionConfigCreate,
+		ReadContext:   resourceTencentCloudRedisConnectionConfigRead,
+		UpdateContext: resourceTencentCloudRedisConnectionConfigUpdate,
+		DeleteContext: resourceTencentCloudRedisConnectionConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(18 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -121,7 +125,7 @@ func resourceTencentCloudRedisConnectionConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRedisConnectionConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisConnectionConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_connection_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -134,16 +138,16 @@ func resourceTencentCloudRedisConnectionConfigCreate(d *schema.ResourceData, met
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudRedisConnectionConfigUpdate(d, meta)
+	return resourceTencentCloudRedisConnectionConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisConnectionConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisConnectionConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_connection_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -151,7 +155,7 @@ func resourceTencentCloudRedisConnectionConfigRead(d *schema.ResourceData, meta
 
 	connectionConfig, err := service.DescribeRedisInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if connectionConfig == nil {
@@ -176,7 +180,7 @@ func resourceTencentCloudRedisConnectionConfigRead(d *schema.ResourceData, meta
 
 	bandwidthRange, err := service.DescribeBandwidthRangeById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if connectionConfig == nil {
@@ -200,12 +204,12 @@ func resourceTencentCloudRedisConnectionConfigRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudRedisConnectionConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisConnectionConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_connection_config.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := redis.NewModifyConnectionConfigRequest()
 	response := redis.NewModifyConnectionConfigResponse()
@@ -221,8 +225,8 @@ func resourceTencentCloudRedisConnectionConfigUpdate(d *schema.ResourceData, met
 		request.Bandwidth = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ModifyConnectionConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ModifyConnectionConfigWithContext(ctx, request)
 		if e != nil {
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 				if ee.Code == "FailedOperation.SystemError" {
@@ -238,37 +242,23 @@ func resourceTencentCloudRedisConnectionConfigUpdate(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update redis param failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	taskId := *response.Response.TaskId
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("change account is processing"))
-		}
-	})
+	err = service.WaitForTask(ctx, taskId, d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s redis change connection fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudRedisConnectionConfigRead(d, meta)
+	return resourceTencentCloudRedisConnectionConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisConnectionConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisConnectionConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_connection_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
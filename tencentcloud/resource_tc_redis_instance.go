@@ -241,7 +241,9 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -258,13 +260,27 @@ func resourceTencentCloudRedisInstance() *schema.Resource {
 	typeStr := strings.Trim(strings.Join(types, ","), ",")
 
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisInstanceCreate,
-		Read:   resourceTencentCloudRedisInstanceRead,
-		Update: resourceTencentCloudRedisInstanceUpdate,
-		Delete: resourceTencentCloudRedisInstanceDelete,
+		CreateContext: resourceTencentCloudRedisInstanceCreate,
+		ReadContext:   resourceTencentCloudRedisInstanceRead,
+		UpdateContext: resourceTencentCloudRedisInstanceUpdate,
+		DeleteContext: resourceTencentCloudRedisInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: helper.ComposeCustomizeDiff(
+			helper.CustomizeDiffOnCreate(helper.ComposeCustomizeDiff(
+				helper.RequiredWhen("charge_type", REDIS_CHARGE_TYPE_PREPAID, "prepaid_period"),
+				helper.RequiredWhen("no_auth", false, "password"),
+				helper.RequiredWhen("no_auth", true, "vpc_id", "subnet_id"),
+				resourceTencentCloudRedisInstanceCreateCustomizeDiff,
+			)),
+			resourceTencentCloudRedisInstanceNetworkCustomizeDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
@@ -480,11 +496,11 @@ func resourceTencentCloudRedisInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRedisInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_instance.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := meta.(*TencentCloudClient).apiV3Conn
 	redisService := RedisService{client: client}
@@ -522,20 +538,20 @@ func resourceTencentCloudRedisInstanceCreate(d *schema.ResourceData, meta interf
 		if period, ok := d.GetOk("prepaid_period"); ok {
 			chargePeriod = uint64(period.(int))
 		} else {
-			return fmt.Errorf("instance charge type prepaid period can not be empty when charge type is %s", chargeType)
+			return diag.Errorf("instance charge type prepaid period can not be empty when charge type is %s", chargeType)
 		}
 	}
 
 	if (typeId == 0 && redisType == "") || (typeId != 0 && redisType != "") {
-		return fmt.Errorf("`type_id` and `type` set one item and only one item")
+		return diag.Errorf("`type_id` and `type` set one item and only one item")
 	}
 
 	if password == "" && !noAuth {
-		return fmt.Errorf("`password` must not be empty unless `no_auth` is `true`")
+		return diag.Errorf("`password` must not be empty unless `no_auth` is `true`")
 	}
 
 	if noAuth && (vpcId == "" || subnetId == "") {
-		return fmt.Errorf("cannot set `no_auth=true` if `vpc_id` and `subnet_id` is empty")
+		return diag.Errorf("cannot set `no_auth=true` if `vpc_id` and `subnet_id` is empty")
 	}
 
 	for id, name := range REDIS_NAMES {
@@ -547,64 +563,14 @@ func resourceTencentCloudRedisInstanceCreate(d *schema.ResourceData, meta interf
 
 	sellConfigures, err := redisService.DescribeRedisZoneConfig(ctx)
 	if err != nil {
-		return fmt.Errorf("api[DescribeRedisZoneConfig]fail, return %s", err.Error())
-	}
-	var regionItem *redis.RegionConf
-	var zoneItem *redis.ZoneCapacityConf
-	var redisItem *redis.ProductConf
-	for _, regionItem = range sellConfigures {
-		if *regionItem.RegionId == region {
-			break
-		}
-	}
-	if regionItem == nil {
-		return fmt.Errorf("all redis in this region `%s` be sold out", region)
-	}
-	for _, zones := range regionItem.ZoneSet {
-		if *zones.IsSaleout {
-			continue
-		}
-		if *zones.ZoneName == availabilityZone {
-			zoneItem = zones
-			break
-		}
-	}
-	if zoneItem == nil {
-		return fmt.Errorf("all redis in this zone `%s` be sold out", availabilityZone)
-	}
-
-	for _, reds := range zoneItem.ProductSet {
-		if *reds.Type == typeId {
-			redisItem = reds
-			break
-		}
-	}
-	if redisItem == nil {
-		return fmt.Errorf("redis type_id `%d` be sold out or this type_id is not supports", typeId)
-	}
-	var redisShardNums []string
-	var redisReplicasNums []string
-	var numErrors []string
-	for _, v := range redisItem.ShardNum {
-		redisShardNums = append(redisShardNums, *v)
-	}
-	for _, v := range redisItem.ReplicaNum {
-		redisReplicasNums = append(redisReplicasNums, *v)
-	}
-	if !IsContains(redisShardNums, fmt.Sprintf("%d", redisShardNum)) {
-		numErrors = append(numErrors, fmt.Sprintf("redis_shard_num : %s", strings.Join(redisShardNums, ",")))
-	}
-
-	if !IsContains(redisReplicasNums, fmt.Sprintf("%d", redisReplicasNum)) {
-		numErrors = append(numErrors, fmt.Sprintf(" redis_replicas_num : %s", strings.Join(redisReplicasNums, ",")))
+		return diag.Errorf("api[DescribeRedisZoneConfig]fail, return %s", err.Error())
 	}
-
-	if len(numErrors) > 0 {
-		return fmt.Errorf("redis type_id `%d` only supports %s", typeId, strings.Join(numErrors, ","))
+	if err := checkRedisSellConfig(sellConfigures, region, availabilityZone, typeId, redisShardNum, redisReplicasNum); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if operation != "" {
-		return fmt.Errorf("This parameter `operation_network` is not required when redis is created")
+		return diag.Errorf("This parameter `operation_network` is not required when redis is created")
 	}
 
 	requestSecurityGroup := make([]string, 0, len(securityGroups))
@@ -619,9 +585,9 @@ func resourceTencentCloudRedisInstanceCreate(d *schema.ResourceData, meta interf
 	if raw, ok := d.GetOk("replica_zone_ids"); ok {
 		zoneIds := raw.([]interface{})
 
-		masterZoneId, err := service.getZoneId(availabilityZone)
+		masterZoneId, err := service.getZoneId(ctx, availabilityZone)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		// insert master node
@@ -662,37 +628,37 @@ func resourceTencentCloudRedisInstanceCreate(d *schema.ResourceData, meta interf
 	)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(instanceIds) == 0 {
-		return fmt.Errorf("redis api CreateInstances return empty redis id")
+		return diag.Errorf("redis api CreateInstances return empty redis id")
 	}
 	var redisId = *instanceIds[0]
-	_, _, _, err = redisService.CheckRedisOnlineOk(ctx, redisId, 20*readRetryTimeout)
+	_, _, _, err = redisService.CheckRedisOnlineOk(ctx, redisId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create redis task fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(redisId)
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		resourceName := BuildTagResourceName("redis", "instance", region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudRedisInstanceRead(d, meta)
+	return resourceTencentCloudRedisInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 	var onlineHas = true
@@ -701,7 +667,7 @@ func resourceTencentCloudRedisInstanceRead(d *schema.ResourceData, meta interfac
 		info *redis.InstanceSet
 		e    error
 	)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		has, _, info, e = service.CheckRedisOnlineOk(ctx, d.Id(), readRetryTimeout*20)
 		if info != nil {
 			if *info.Status == REDIS_STATUS_ISOLATE || *info.Status == REDIS_STATUS_TODELETE {
@@ -721,7 +687,7 @@ func resourceTencentCloudRedisInstanceRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("Fail to get info from redis, reaseon %s", err.Error())
+		return diag.Errorf("Fail to get info from redis, reaseon %s", err.Error())
 	}
 	if !onlineHas {
 		return nil
@@ -731,15 +697,15 @@ func resourceTencentCloudRedisInstanceRead(d *schema.ResourceData, meta interfac
 	if statusName == "" {
 		err = fmt.Errorf("redis read unkwnow status %d", *info.Status)
 		log.Printf("[CRITAL]%s redis read status name error, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("status", statusName)
 
 	_ = d.Set("name", *info.InstanceName)
 
-	zoneName, err := service.getZoneName(*info.ZoneId)
+	zoneName, err := service.getZoneName(ctx, *info.ZoneId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// not set field type_id
 	// process import case
@@ -748,7 +714,7 @@ func resourceTencentCloudRedisInstanceRead(d *schema.ResourceData, meta interfac
 		if typeName == "" {
 			err = fmt.Errorf("redis read unkwnow type %d", *info.Type)
 			log.Printf("[CRITAL]%s redis read type name error, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 		_ = d.Set("type", typeName)
 	} else {
@@ -781,7 +747,7 @@ func resourceTencentCloudRedisInstanceRead(d *schema.ResourceData, meta interfac
 	if d.Get("vpc_id").(string) != "" {
 		securityGroups, err := service.DescribeInstanceSecurityGroup(ctx, d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if len(securityGroups) > 0 {
 			_ = d.Set("security_groups", securityGroups)
@@ -823,7 +789,7 @@ func resourceTencentCloudRedisInstanceRead(d *schema.ResourceData, meta interfac
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "redis", "instance", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("tags", tags)
@@ -832,11 +798,11 @@ func resourceTencentCloudRedisInstanceRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_instance.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -852,7 +818,7 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 	}
 	for _, field := range unsupportedUpdateFields {
 		if d.HasChange(field) {
-			return fmt.Errorf("tencentcloud_redis_instance update on %s is not support yet", field)
+			return diag.Errorf("tencentcloud_redis_instance update on %s is not support yet", field)
 		}
 	}
 
@@ -865,7 +831,7 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		}
 		err := redisService.ModifyInstanceName(ctx, id, name)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -880,10 +846,10 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		redisReplicasNum := oReplica.(int)
 
 		if newMemSize < 1 {
-			return fmt.Errorf("redis mem_size value cannot be set to less than 1")
+			return diag.Errorf("redis mem_size value cannot be set to less than 1")
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			_, err := redisService.UpgradeInstance(ctx, id, newMemSize, redisShardNum, redisReplicasNum, nil)
 			if err != nil {
 				// Upgrade memory will cause instance lock and cannot acknowledge by polling status, wait until lock release
@@ -894,14 +860,14 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 
 		if err != nil {
 			log.Printf("[CRITAL]%s redis upgrade instance error, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 		err = redisService.CheckRedisUpdateOk(ctx, id)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s redis update mem size fail , reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -911,7 +877,7 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		oReplica, _ := d.GetChange("redis_replicas_num")
 		redisReplicasNum := oReplica.(int)
 		memSize := d.Get("mem_size").(int)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			_, err := redisService.UpgradeInstance(ctx, id, memSize, redisShardNum, redisReplicasNum, nil)
 			if err != nil {
 				// Upgrade memory will cause instance lock and cannot acknowledge by polling status, wait until lock release
@@ -922,21 +888,21 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 
 		if err != nil {
 			log.Printf("[CRITAL]%s redis upgrade instance error, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 		err = redisService.CheckRedisUpdateOk(ctx, id)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s redis update shard num fail , reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("redis_replicas_num") || d.HasChange("replica_zone_ids") {
 		err := resourceRedisNodeSetModify(ctx, &redisService, d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -949,7 +915,7 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		)
 
 		// After redis spec modified, reset password may not successfully response immediately.
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			taskId, err = redisService.ResetPassword(ctx, id, password, noAuth)
 			if err != nil {
 				log.Printf("[CRITAL]%s redis change password error, reason:%s\n", logId, err.Error())
@@ -959,28 +925,14 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
-			ok, err := redisService.DescribeTaskInfo(ctx, id, taskId)
-			if err != nil {
-				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-			if ok {
-				return nil
-			} else {
-				return resource.RetryableError(fmt.Errorf("change password is processing"))
-			}
-		})
+		err = redisService.WaitForTask(ctx, taskId, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			log.Printf("[CRITAL]%s redis change password fail, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -988,7 +940,7 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		request := redis.NewApplyParamsTemplateRequest()
 		request.InstanceIds = []*string{&id}
 		request.TemplateId = helper.String(d.Get("params_template_id").(string))
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			_, err := redisService.ApplyParamsTemplate(ctx, request)
 			if err != nil {
 				return retryError(err, redis.FAILEDOPERATION_SYSTEMERROR, redis.RESOURCEUNAVAILABLE_INSTANCELOCKEDERROR)
@@ -997,7 +949,7 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1005,7 +957,7 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		projectId := d.Get("project_id").(int)
 		err := redisService.ModifyInstanceProjectId(ctx, id, int64(projectId))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1017,7 +969,7 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		}
 		err := redisService.ModifyDBInstanceSecurityGroups(ctx, "redis", d.Id(), sgIds)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1027,8 +979,8 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		request.InstanceId = &id
 		request.TargetInstanceType = helper.String(strconv.Itoa(typeId))
 		request.SwitchOption = helper.IntInt64(2)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().UpgradeInstanceVersion(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().UpgradeInstanceVersionWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -1038,20 +990,20 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s operate redis upgradeVersionOperation failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
-		_, _, _, err = service.CheckRedisOnlineOk(ctx, id, 20*readRetryTimeout)
+		_, _, _, err = service.CheckRedisOnlineOk(ctx, id, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			log.Printf("[CRITAL]%s redis upgradeVersionOperation fail, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("vpc_id") || d.HasChange("subnet_id") || d.HasChange("port") || d.HasChange("recycle") || d.HasChange("ip") {
 		if _, ok := d.GetOk("operation_network"); !ok {
-			return fmt.Errorf("When modifying `vpc_id`, `subnet_id`, `port`, `recycle`, `ip`, the `operation_network` parameter is required")
+			return diag.Errorf("When modifying `vpc_id`, `subnet_id`, `port`, `recycle`, `ip`, the `operation_network` parameter is required")
 		}
 
 		request := redis.NewModifyNetworkConfigRequest()
@@ -1065,36 +1017,36 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 			if v, ok := d.GetOk("ip"); ok {
 				request.Vip = helper.String(v.(string))
 			} else {
-				return fmt.Errorf("When `operation_network` is %v, this parameter must be filled in", operation)
+				return diag.Errorf("When `operation_network` is %v, this parameter must be filled in", operation)
 			}
 
 			if v, ok := d.GetOk("port"); ok {
 				request.VPort = helper.IntInt64(v.(int))
 			} else {
-				return fmt.Errorf("When `operation_network` is %v, this parameter must be filled in", operation)
+				return diag.Errorf("When `operation_network` is %v, this parameter must be filled in", operation)
 			}
 		case REDIS_MODIFY_NETWORK_CONFIG[1], REDIS_MODIFY_NETWORK_CONFIG[2]:
 			if v, ok := d.GetOk("vpc_id"); ok {
 				request.VpcId = helper.String(v.(string))
 			} else {
-				return fmt.Errorf("When `operation_network` is %v, this parameter must be filled in", operation)
+				return diag.Errorf("When `operation_network` is %v, this parameter must be filled in", operation)
 			}
 
 			if v, ok := d.GetOk("subnet_id"); ok {
 				request.SubnetId = helper.String(v.(string))
 			} else {
-				return fmt.Errorf("When `operation_network` is %v, this parameter must be filled in", operation)
+				return diag.Errorf("When `operation_network` is %v, this parameter must be filled in", operation)
 			}
 		case REDIS_MODIFY_NETWORK_CONFIG[3]:
 			if v, ok := d.GetOk("port"); ok {
 				request.VPort = helper.IntInt64(v.(int))
 			} else {
-				return fmt.Errorf("When `operation_network` is %v, this parameter must be filled in", operation)
+				return diag.Errorf("When `operation_network` is %v, this parameter must be filled in", operation)
 			}
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ModifyNetworkConfig(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ModifyNetworkConfigWithContext(ctx, request)
 			if e != nil {
 				if _, ok := e.(*sdkErrors.TencentCloudSDKError); !ok {
 					return resource.RetryableError(e)
@@ -1108,14 +1060,14 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s operate redis networkConfig failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
-		_, _, _, err = service.CheckRedisOnlineOk(ctx, id, 20*readRetryTimeout)
+		_, _, _, err = service.CheckRedisOnlineOk(ctx, id, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			log.Printf("[CRITAL]%s redis networkConfig fail, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 		_ = d.Set("operation_network", operation)
@@ -1127,31 +1079,31 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 
 		resourceName := BuildTagResourceName("redis", "instance", region, id)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudRedisInstanceRead(d, meta)
+	return resourceTencentCloudRedisInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	defer logElapsed("resource.tencentcloud_redis_instance.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	// Collect infos before deleting action
 	var chargeType string
-	has, _, info, err := service.CheckRedisOnlineOk(ctx, d.Id(), 20*readRetryTimeout)
+	has, _, info, err := service.CheckRedisOnlineOk(ctx, d.Id(), d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s redis querying before deleting task fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !has {
@@ -1162,7 +1114,7 @@ func resourceTencentCloudRedisInstanceDelete(d *schema.ResourceData, meta interf
 
 	var wait = func(action string, taskInfo interface{}) (errRet error) {
 
-		errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		errRet = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			var ok bool
 			var err error
 			switch v := taskInfo.(type) {
@@ -1196,20 +1148,20 @@ func resourceTencentCloudRedisInstanceDelete(d *schema.ResourceData, meta interf
 		taskId, err := service.DestroyPostpaidInstance(ctx, d.Id())
 		if err != nil {
 			log.Printf("[CRITAL]%s redis %s fail, reason:%s\n", logId, "DestroyPostpaidInstance", err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 		if err = wait("DestroyPostpaidInstance", taskId); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	} else {
 		if _, err := service.DestroyPrepaidInstance(ctx, d.Id()); err != nil {
 			log.Printf("[CRITAL]%s redis %s fail, reason:%s\n", logId, "DestroyPrepaidInstance", err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 		// Deal info only support create and renew and resize, need to check destroy status by describing api.
-		if errDestroyChecking := resource.Retry(20*readRetryTimeout, func() *resource.RetryError {
+		if errDestroyChecking := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			has, isolated, err := service.CheckRedisDestroyOk(ctx, d.Id())
 			if err != nil {
 				log.Printf("[CRITAL]%s CheckRedisDestroyOk fail, reason:%s\n", logId, err.Error())
@@ -1221,7 +1173,7 @@ func resourceTencentCloudRedisInstanceDelete(d *schema.ResourceData, meta interf
 			return resource.RetryableError(fmt.Errorf("instance is not ready to be destroyed"))
 		}); errDestroyChecking != nil {
 			log.Printf("[CRITAL]%s redis querying before deleting task fail, reason:%s\n", logId, errDestroyChecking.Error())
-			return errDestroyChecking
+			return diag.FromErr(errDestroyChecking)
 		}
 	}
 
@@ -1229,10 +1181,10 @@ func resourceTencentCloudRedisInstanceDelete(d *schema.ResourceData, meta interf
 		taskId, err := service.CleanUpInstance(ctx, d.Id())
 		if err != nil {
 			log.Printf("[CRITAL]%s redis %s fail, reason:%s\n", logId, "CleanUpInstance", err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
-		return wait("CleanUpInstance", taskId)
+		return diag.FromErr(wait("CleanUpInstance", taskId))
 	}
 	return nil
 }
@@ -1281,7 +1233,7 @@ func resourceRedisNodeSetModify(ctx context.Context, service *RedisService, d *s
 				ZoneId:   helper.IntUint64(zoneId),
 			})
 		}
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 			_, err := service.UpgradeInstance(ctx, d.Id(), memSize, shardNum, redisReplicaCount+len(adds), addNodes)
 			if err != nil {
 				return retryError(err, redis.FAILEDOPERATION_UNKNOWN)
@@ -1309,7 +1261,7 @@ func resourceRedisNodeSetModify(ctx context.Context, service *RedisService, d *s
 		if replicasParam <= 0 {
 			return fmt.Errorf("cannot delete replica %d which is your only replica on instance %s", removeNodes[0].NodeId, id)
 		}
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 			_, err := service.UpgradeInstance(ctx, id, memSize, shardNum, replicasParam, removeNodes)
 			if err != nil {
 				return retryError(err, redis.FAILEDOPERATION_UNKNOWN)
@@ -1329,7 +1281,7 @@ func resourceRedisNodeSetModify(ctx context.Context, service *RedisService, d *s
 	if d.HasChange("redis_replicas_num") && len(oz) == 0 && len(nz) == 0 {
 		_, replica := d.GetChange("redis_replicas_num")
 		redisReplicasNum := replica.(int)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 			_, err := service.UpgradeInstance(ctx, id, memSize, shardNum, redisReplicasNum, nil)
 			if err != nil {
 				// Upgrade memory will cause instance lock and cannot acknowledge by polling status, wait until lock release
@@ -1365,3 +1317,116 @@ func tencentCloudRedisGetRemoveNodesByIds(ids []int, nodes []*redis.RedisNodeInf
 	}
 	return
 }
+
+// checkRedisSellConfig checks that the type_id, redis_shard_num and redis_replicas_num are on sale in the zone.
+func checkRedisSellConfig(sellConfigures []*redis.RegionConf, region, zone string, typeId int64, shardNum, replicasNum int) error {
+	var regionItem *redis.RegionConf
+	var zoneItem *redis.ZoneCapacityConf
+	var redisItem *redis.ProductConf
+	for _, item := range sellConfigures {
+		if *item.RegionId == region {
+			regionItem = item
+			break
+		}
+	}
+	if regionItem == nil {
+		return fmt.Errorf("all redis in this region `%s` be sold out", region)
+	}
+	for _, zones := range regionItem.ZoneSet {
+		if *zones.IsSaleout {
+			continue
+		}
+		if *zones.ZoneName == zone {
+			zoneItem = zones
+			break
+		}
+	}
+	if zoneItem == nil {
+		return fmt.Errorf("all redis in this zone `%s` be sold out", zone)
+	}
+
+	for _, reds := range zoneItem.ProductSet {
+		if *reds.Type == typeId {
+			redisItem = reds
+			break
+		}
+	}
+	if redisItem == nil {
+		return fmt.Errorf("redis type_id `%d` be sold out or this type_id is not supports", typeId)
+	}
+	var redisShardNums []string
+	var redisReplicasNums []string
+	var numErrors []string
+	for _, v := range redisItem.ShardNum {
+		redisShardNums = append(redisShardNums, *v)
+	}
+	for _, v := range redisItem.ReplicaNum {
+		redisReplicasNums = append(redisReplicasNums, *v)
+	}
+	if !IsContains(redisShardNums, fmt.Sprintf("%d", shardNum)) {
+		numErrors = append(numErrors, fmt.Sprintf("redis_shard_num : %s", strings.Join(redisShardNums, ",")))
+	}
+
+	if !IsContains(redisReplicasNums, fmt.Sprintf("%d", replicasNum)) {
+		numErrors = append(numErrors, fmt.Sprintf(" redis_replicas_num : %s", strings.Join(redisReplicasNums, ",")))
+	}
+
+	if len(numErrors) > 0 {
+		return fmt.Errorf("redis type_id `%d` only supports %s", typeId, strings.Join(numErrors, ","))
+	}
+	return nil
+}
+
+func resourceTencentCloudRedisInstanceCreateCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"type_id", "type", "redis_replicas_num", "availability_zone"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+	redisType := d.Get("type").(string)
+	typeId := int64(d.Get("type_id").(int))
+	if (typeId == 0 && redisType == "") || (typeId != 0 && redisType != "") {
+		return fmt.Errorf("`type_id` and `type` set one item and only one item")
+	}
+	if helper.IsSetInConfig(d, "operation_network") {
+		return fmt.Errorf("`operation_network` is not required when redis is created")
+	}
+
+	client, ok := meta.(*TencentCloudClient)
+	if !ok || client == nil || client.apiV3Conn == nil {
+		return nil
+	}
+	for id, name := range REDIS_NAMES {
+		if redisType == name {
+			typeId = id
+			break
+		}
+	}
+	// redis_shard_num is computed when not set, it defaults to 1 the same as in create
+	redisShardNum := 1
+	if v, ok := d.GetOk("redis_shard_num"); ok && d.NewValueKnown("redis_shard_num") {
+		redisShardNum = v.(int)
+	}
+
+	logId := getLogId(ctx)
+	redisService := RedisService{client: client.apiV3Conn}
+	sellConfigures, err := redisService.DescribeRedisZoneConfig(ctx)
+	if err != nil {
+		log.Printf("[WARN]%s skip checking the sell configs of redis, reason:%s", logId, err.Error())
+		return nil
+	}
+	return checkRedisSellConfig(sellConfigures, client.apiV3Conn.Region, d.Get("availability_zone").(string),
+		typeId, redisShardNum, d.Get("redis_replicas_num").(int))
+}
+
+func resourceTencentCloudRedisInstanceNetworkCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if d.HasChange("vpc_id") || d.HasChange("subnet_id") || d.HasChange("port") || d.HasChange("recycle") || d.HasChange("ip") {
+		if !helper.IsSetInConfig(d, "operation_network") {
+			return fmt.Errorf("When modifying `vpc_id`, `subnet_id`, `port`, `recycle`, `ip`, the `operation_network` parameter is required")
+		}
+	}
+	return nil
+}
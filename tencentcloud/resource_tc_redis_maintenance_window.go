@@ -53,7 +53,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
@@ -62,12 +64,15 @@ import (
 
 func resourceTencentCloudRedisMaintenanceWindow() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisMaintenanceWindowCreate,
-		Read:   resourceTencentCloudRedisMaintenanceWindowRead,
-		Update: resourceTencentCloudRedisMaintenanceWindowUpdate,
-		Delete: resourceTencentCloudRedisMaintenanceWindowDelete,
+		CreateContext: resourceTencentCloudRedisMaintenanceWindowCreate,
+		ReadContext:   resourceTencentCloudRedisMaintenanceWindowRead,
+		UpdateContext: resourceTencentCloudRedisMaintenanceWindowUpdate,
+		DeleteContext: resourceTencentCloudRedisMaintenanceWindowDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -91,7 +96,7 @@ func resourceTencentCloudRedisMaintenanceWindow() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRedisMaintenanceWindowCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisMaintenanceWindowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_maintenance_window.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -104,16 +109,16 @@ func resourceTencentCloudRedisMaintenanceWindowCreate(d *schema.ResourceData, me
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudRedisMaintenanceWindowUpdate(d, meta)
+	return resourceTencentCloudRedisMaintenanceWindowUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisMaintenanceWindowRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisMaintenanceWindowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_maintenance_window.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -121,7 +126,7 @@ func resourceTencentCloudRedisMaintenanceWindowRead(d *schema.ResourceData, meta
 
 	maintenanceWindow, err := service.DescribeRedisMaintenanceWindowById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if maintenanceWindow == nil {
@@ -143,7 +148,7 @@ func resourceTencentCloudRedisMaintenanceWindowRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudRedisMaintenanceWindowUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisMaintenanceWindowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_maintenance_window.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -163,8 +168,8 @@ func resourceTencentCloudRedisMaintenanceWindowUpdate(d *schema.ResourceData, me
 		request.EndTime = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ModifyMaintenanceWindow(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ModifyMaintenanceWindowWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -174,13 +179,13 @@ func resourceTencentCloudRedisMaintenanceWindowUpdate(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update redis maintenanceWindow failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudRedisMaintenanceWindowRead(d, meta)
+	return resourceTencentCloudRedisMaintenanceWindowRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisMaintenanceWindowDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisMaintenanceWindowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_maintenance_window.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -41,24 +41,27 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudRedisParam() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisParamCreate,
-		Read:   resourceTencentCloudRedisParamRead,
-		Update: resourceTencentCloudRedisParamUpdate,
-		Delete: resourceTencentCloudRedisParamDelete,
+		CreateContext: resourceTencentCloudRedisParamCreate,
+		ReadContext:   resourceTencentCloudRedisParamRead,
+		UpdateContext: resourceTencentCloudRedisParamUpdate,
+		DeleteContext: resourceTencentCloudRedisParamDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(18 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -76,7 +79,7 @@ func resourceTencentCloudRedisParam() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRedisParamCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisParamCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_param.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -89,15 +92,15 @@ func resourceTencentCloudRedisParamCreate(d *schema.ResourceData, meta interface
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudRedisParamUpdate(d, meta)
+	return resourceTencentCloudRedisParamUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisParamRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisParamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_param.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -105,7 +108,7 @@ func resourceTencentCloudRedisParamRead(d *schema.ResourceData, meta interface{}
 
 	param, err := service.DescribeRedisParamById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(param) == 0 {
@@ -129,12 +132,12 @@ func resourceTencentCloudRedisParamRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudRedisParamUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisParamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_param.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := redis.NewModifyInstanceParamsRequest()
 	response := redis.NewModifyInstanceParamsResponse()
@@ -146,7 +149,7 @@ func resourceTencentCloudRedisParamUpdate(d *schema.ResourceData, meta interface
 		service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 		param, err := service.DescribeRedisParamById(ctx, instanceId)
 		if err != nil && len(param) == 0 {
-			return fmt.Errorf("[ERROR] resource `RedisParam` [%s] not found, please check if it has been deleted.\n", d.Id())
+			return diag.Errorf("[ERROR] resource `RedisParam` [%s] not found, please check if it has been deleted.\n", d.Id())
 		}
 		for k, v := range v.(map[string]interface{}) {
 			if value, ok := param[k]; ok {
@@ -157,17 +160,17 @@ func resourceTencentCloudRedisParamUpdate(d *schema.ResourceData, meta interface
 					request.InstanceParams = append(request.InstanceParams, &instanceParam)
 				}
 			} else {
-				return fmt.Errorf("[ERROR] The parameter name [%v] does not exist, please check the parameter name.\n", k)
+				return diag.Errorf("[ERROR] The parameter name [%v] does not exist, please check the parameter name.\n", k)
 			}
 		}
 	}
 
 	if len(request.InstanceParams) == 0 {
-		return resourceTencentCloudRedisParamRead(d, meta)
+		return resourceTencentCloudRedisParamRead(ctx, d, meta)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ModifyInstanceParams(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ModifyInstanceParamsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -178,37 +181,23 @@ func resourceTencentCloudRedisParamUpdate(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update redis param failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	taskId := *response.Response.TaskId
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("change param is processing"))
-		}
-	})
+	err = service.WaitForTask(ctx, taskId, d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s redis change param fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudRedisParamRead(d, meta)
+	return resourceTencentCloudRedisParamRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisParamDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisParamDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_param.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -47,8 +47,9 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
@@ -57,12 +58,15 @@ import (
 
 func resourceTencentCloudRedisParamTemplate() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudRedisParamTemplateRead,
-		Create: resourceTencentCloudRedisParamTemplateCreate,
-		Update: resourceTencentCloudRedisParamTemplateUpdate,
-		Delete: resourceTencentCloudRedisParamTemplateDelete,
+		ReadContext:   resourceTencentCloudRedisParamTemplateRead,
+		CreateContext: resourceTencentCloudRedisParamTemplateCreate,
+		UpdateContext: resourceTencentCloudRedisParamTemplateUpdate,
+		DeleteContext: resourceTencentCloudRedisParamTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -165,12 +169,12 @@ func resourceTencentCloudRedisParamTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRedisParamTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisParamTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_param_template.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := RedisService{client: client}
 
@@ -210,7 +214,7 @@ func resourceTencentCloudRedisParamTemplateCreate(d *schema.ResourceData, meta i
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		resTemplateId, e := service.CreateParamTemplate(ctx, request)
 		if e != nil {
 			return retryError(e)
@@ -220,23 +224,23 @@ func resourceTencentCloudRedisParamTemplateCreate(d *schema.ResourceData, meta i
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if id == "" {
-		return fmt.Errorf("cannot get redis template id")
+		return diag.Errorf("cannot get redis template id")
 	}
 
 	d.SetId(id)
-	return resourceTencentCloudRedisParamTemplateRead(d, meta)
+	return resourceTencentCloudRedisParamTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisParamTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisParamTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_param_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -245,12 +249,12 @@ func resourceTencentCloudRedisParamTemplateRead(d *schema.ResourceData, meta int
 	paramTemplate, err := service.DescribeParamTemplateInfo(ctx, id)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if paramTemplate == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `param_template` %s does not exist", id)
+		return diag.Errorf("resource `param_template` %s does not exist", id)
 	}
 
 	d.SetId(id)
@@ -291,13 +295,13 @@ func resourceTencentCloudRedisParamTemplateRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudRedisParamTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisParamTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_param_template.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	request := redis.NewModifyParamTemplateRequest()
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := RedisService{client: client}
@@ -315,11 +319,11 @@ func resourceTencentCloudRedisParamTemplateUpdate(d *schema.ResourceData, meta i
 	}
 
 	if d.HasChange("product_type") {
-		return fmt.Errorf("`product_type` do not support change now.")
+		return diag.Errorf("`product_type` do not support change now.")
 	}
 
 	if d.HasChange("template_id") {
-		return fmt.Errorf("`template_id` do not support change now.")
+		return diag.Errorf("`template_id` do not support change now.")
 	}
 
 	if d.HasChange("params_override") {
@@ -337,18 +341,18 @@ func resourceTencentCloudRedisParamTemplateUpdate(d *schema.ResourceData, meta i
 	err := service.ModifyParamTemplate(ctx, request)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudRedisParamTemplateRead(d, meta)
+	return resourceTencentCloudRedisParamTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisParamTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisParamTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_param_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -357,7 +361,7 @@ func resourceTencentCloudRedisParamTemplateDelete(d *schema.ResourceData, meta i
 	request := redis.NewDeleteParamTemplateRequest()
 	request.TemplateId = &id
 	if err := service.DeleteParamTemplate(ctx, request); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
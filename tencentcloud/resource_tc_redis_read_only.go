@@ -74,25 +74,28 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudRedisReadOnly() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisReadOnlyCreate,
-		Read:   resourceTencentCloudRedisReadOnlyRead,
-		Update: resourceTencentCloudRedisReadOnlyUpdate,
-		Delete: resourceTencentCloudRedisReadOnlyDelete,
+		CreateContext: resourceTencentCloudRedisReadOnlyCreate,
+		ReadContext:   resourceTencentCloudRedisReadOnlyRead,
+		UpdateContext: resourceTencentCloudRedisReadOnlyUpdate,
+		DeleteContext: resourceTencentCloudRedisReadOnlyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(18 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -110,7 +113,7 @@ func resourceTencentCloudRedisReadOnly() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRedisReadOnlyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisReadOnlyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_read_only.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -123,15 +126,15 @@ func resourceTencentCloudRedisReadOnlyCreate(d *schema.ResourceData, meta interf
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudRedisReadOnlyUpdate(d, meta)
+	return resourceTencentCloudRedisReadOnlyUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisReadOnlyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisReadOnlyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_read_only.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -139,7 +142,7 @@ func resourceTencentCloudRedisReadOnlyRead(d *schema.ResourceData, meta interfac
 
 	setInputMode, err := service.DescribeRedisInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if setInputMode == nil {
@@ -159,12 +162,12 @@ func resourceTencentCloudRedisReadOnlyRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudRedisReadOnlyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisReadOnlyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_read_only.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := redis.NewModifyInstanceReadOnlyRequest()
 	response := redis.NewModifyInstanceReadOnlyResponse()
@@ -177,8 +180,8 @@ func resourceTencentCloudRedisReadOnlyUpdate(d *schema.ResourceData, meta interf
 		request.InputMode = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ModifyInstanceReadOnly(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ModifyInstanceReadOnlyWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -189,36 +192,22 @@ func resourceTencentCloudRedisReadOnlyUpdate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update redis setInputMode failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	taskId := *response.Response.TaskId
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("change inputMode is processing"))
-		}
-	})
+	err = service.WaitForTask(ctx, taskId, d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s redis change inputMode fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudRedisReadOnlyRead(d, meta)
+	return resourceTencentCloudRedisReadOnlyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisReadOnlyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisReadOnlyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_read_only.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -68,7 +68,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -78,11 +80,14 @@ import (
 
 func resourceTencentCloudRedisRenewInstanceOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisRenewInstanceOperationCreate,
-		Read:   resourceTencentCloudRedisRenewInstanceOperationRead,
-		Delete: resourceTencentCloudRedisRenewInstanceOperationDelete,
+		CreateContext: resourceTencentCloudRedisRenewInstanceOperationCreate,
+		ReadContext:   resourceTencentCloudRedisRenewInstanceOperationRead,
+		DeleteContext: resourceTencentCloudRedisRenewInstanceOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -109,12 +114,12 @@ func resourceTencentCloudRedisRenewInstanceOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRedisRenewInstanceOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisRenewInstanceOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_renew_instance_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request    = redis.NewRenewInstanceRequest()
@@ -133,8 +138,8 @@ func resourceTencentCloudRedisRenewInstanceOperationCreate(d *schema.ResourceDat
 		request.ModifyPayMode = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().RenewInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().RenewInstanceWithContext(ctx, request)
 		if e != nil {
 			if sdkErr, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkErr.Code == "FailedOperation.PayFailed" {
@@ -149,29 +154,29 @@ func resourceTencentCloudRedisRenewInstanceOperationCreate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate redis renewInstanceOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
-	_, _, _, err = service.CheckRedisOnlineOk(ctx, instanceId, 20*readRetryTimeout)
+	_, _, _, err = service.CheckRedisOnlineOk(ctx, instanceId, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		log.Printf("[CRITAL]%s redis upgradeVersionOperation fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudRedisRenewInstanceOperationRead(d, meta)
+	return resourceTencentCloudRedisRenewInstanceOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisRenewInstanceOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisRenewInstanceOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_renew_instance_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudRedisRenewInstanceOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisRenewInstanceOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_renew_instance_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
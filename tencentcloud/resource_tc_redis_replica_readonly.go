@@ -60,26 +60,40 @@ resource "tencentcloud_redis_replica_readonly" "replica_readonly" {
   operate = "enable"
 }
 ```
+
+Import
+
+redis replica_readonly can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_redis_replica_readonly.replica_readonly crs-c1nl9rpv
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 )
 
 func resourceTencentCloudRedisReplicaReadonly() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisReplicaReadonlyCreate,
-		Read:   resourceTencentCloudRedisReplicaReadonlyRead,
-		Update: resourceTencentCloudRedisReplicaReadonlyUpdate,
-		Delete: resourceTencentCloudRedisReplicaReadonlyDelete,
+		CreateContext: resourceTencentCloudRedisReplicaReadonlyCreate,
+		ReadContext:   resourceTencentCloudRedisReplicaReadonlyRead,
+		UpdateContext: resourceTencentCloudRedisReplicaReadonlyUpdate,
+		DeleteContext: resourceTencentCloudRedisReplicaReadonlyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(18 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -107,7 +121,7 @@ func resourceTencentCloudRedisReplicaReadonly() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRedisReplicaReadonlyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisReplicaReadonlyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_replica_readonly.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -120,16 +134,16 @@ func resourceTencentCloudRedisReplicaReadonlyCreate(d *schema.ResourceData, meta
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudRedisReplicaReadonlyUpdate(d, meta)
+	return resourceTencentCloudRedisReplicaReadonlyUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisReplicaReadonlyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisReplicaReadonlyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_replica_readonly.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -137,7 +151,7 @@ func resourceTencentCloudRedisReplicaReadonlyRead(d *schema.ResourceData, meta i
 
 	instance, err := service.DescribeRedisInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -161,12 +175,12 @@ func resourceTencentCloudRedisReplicaReadonlyRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudRedisReplicaReadonlyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisReplicaReadonlyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_replica_readonly.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		disableRequest  = redis.NewDisableReplicaReadonlyRequest()
@@ -181,8 +195,8 @@ func resourceTencentCloudRedisReplicaReadonlyUpdate(d *schema.ResourceData, meta
 		operate := v.(string)
 		if operate == "enable" {
 			enableRequest.InstanceId = &instanceId
-			err := resource.Retry(3*writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().EnableReplicaReadonly(enableRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().EnableReplicaReadonlyWithContext(ctx, enableRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -193,15 +207,15 @@ func resourceTencentCloudRedisReplicaReadonlyUpdate(d *schema.ResourceData, meta
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s update redis replicaReadonly failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 
 			taskId = *enableResponse.Response.TaskId
 		}
 		if operate == "disable" {
 			disableRequest.InstanceId = &instanceId
-			err := resource.Retry(3*writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().DisableReplicaReadonly(disableRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().DisableReplicaReadonlyWithContext(ctx, disableRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -212,7 +226,7 @@ func resourceTencentCloudRedisReplicaReadonlyUpdate(d *schema.ResourceData, meta
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s update redis replicaReadonly failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 
 			taskId = *disableResponse.Response.TaskId
@@ -220,31 +234,17 @@ func resourceTencentCloudRedisReplicaReadonlyUpdate(d *schema.ResourceData, meta
 	}
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err := resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("change inputMode is processing"))
-		}
-	})
+	err := service.WaitForTask(ctx, taskId, d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s redis change inputMode fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudRedisReplicaReadonlyRead(d, meta)
+	return resourceTencentCloudRedisReplicaReadonlyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisReplicaReadonlyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisReplicaReadonlyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_replica_readonly.delete")()
 	defer inconsistentCheck(d, meta)()
 
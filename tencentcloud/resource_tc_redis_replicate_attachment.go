@@ -89,24 +89,27 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudRedisReplicateAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisReplicateAttachmentCreate,
-		Read:   resourceTencentCloudRedisReplicateAttachmentRead,
-		Update: resourceTencentCloudRedisReplicateAttachmentUpdate,
-		Delete: resourceTencentCloudRedisReplicateAttachmentDelete,
+		CreateContext: resourceTencentCloudRedisReplicateAttachmentCreate,
+		ReadContext:   resourceTencentCloudRedisReplicateAttachmentRead,
+		UpdateContext: resourceTencentCloudRedisReplicateAttachmentUpdate,
+		DeleteContext: resourceTencentCloudRedisReplicateAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(18 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -133,12 +136,12 @@ func resourceTencentCloudRedisReplicateAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRedisReplicateAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisReplicateAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_replicate_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request          = redis.NewAddReplicationInstanceRequest()
@@ -181,20 +184,20 @@ func resourceTencentCloudRedisReplicateAttachmentCreate(d *schema.ResourceData,
 		service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 		err := service.AddReplicationInstance(ctx, groupId, instanceId, instanceRole)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudRedisReplicateAttachmentRead(d, meta)
+	return resourceTencentCloudRedisReplicateAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisReplicateAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisReplicateAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_replicate_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -202,7 +205,7 @@ func resourceTencentCloudRedisReplicateAttachmentRead(d *schema.ResourceData, me
 
 	replicateGroup, err := service.DescribeRedisReplicateInstanceById(ctx, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if replicateGroup == nil {
@@ -227,12 +230,12 @@ func resourceTencentCloudRedisReplicateAttachmentRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudRedisReplicateAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisReplicateAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_replicate_attachment.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request  = redis.NewChangeMasterInstanceRequest()
@@ -243,14 +246,12 @@ func resourceTencentCloudRedisReplicateAttachmentUpdate(d *schema.ResourceData,
 	request.GroupId = &groupId
 
 	if d.HasChange("master_instance_id") {
-		instanceId := ""
 		if v, ok := d.GetOk("master_instance_id"); ok {
-			instanceId = v.(string)
 			request.InstanceId = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ChangeMasterInstance(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ChangeMasterInstanceWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -261,32 +262,18 @@ func resourceTencentCloudRedisReplicateAttachmentUpdate(d *schema.ResourceData,
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s operate redis RedisReplicateAttachment failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		taskId := *response.Response.TaskId
 
 		service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 		if taskId > 0 {
-			err := resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-				ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-				if err != nil {
-					if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-						return resource.RetryableError(err)
-					} else {
-						return resource.NonRetryableError(err)
-					}
-				}
-				if ok {
-					return nil
-				} else {
-					return resource.RetryableError(fmt.Errorf("update redis changeMaster is processing"))
-				}
-			})
+			err := service.WaitForTask(ctx, taskId, d.Timeout(schema.TimeoutUpdate))
 
 			if err != nil {
 				log.Printf("[CRITAL]%s update redis changeMaster fail, reason:%s\n", logId, err.Error())
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -303,7 +290,7 @@ func resourceTencentCloudRedisReplicateAttachmentUpdate(d *schema.ResourceData,
 			for _, instanceId := range add {
 				err := service.AddReplicationInstance(ctx, groupId, instanceId, "r")
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -312,27 +299,27 @@ func resourceTencentCloudRedisReplicateAttachmentUpdate(d *schema.ResourceData,
 			for _, instanceId := range remove {
 				err := service.DeleteRedisReplicateAttachmentById(ctx, instanceId, groupId)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
 	}
 
-	return resourceTencentCloudRedisReplicateAttachmentRead(d, meta)
+	return resourceTencentCloudRedisReplicateAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisReplicateAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisReplicateAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_replicate_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	groupId := d.Id()
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 	replicateGroup, err := service.DescribeRedisReplicateInstanceById(ctx, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if replicateGroup.Instances != nil {
@@ -343,12 +330,12 @@ func resourceTencentCloudRedisReplicateAttachmentDelete(d *schema.ResourceData,
 				continue
 			}
 			if err := service.DeleteRedisReplicateAttachmentById(ctx, *v.InstanceId, groupId); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		if masterInstanceId != "" {
 			if err := service.DeleteRedisReplicateAttachmentById(ctx, masterInstanceId, groupId); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
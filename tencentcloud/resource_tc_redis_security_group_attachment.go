@@ -89,10 +89,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
@@ -103,11 +103,14 @@ const PRODUCT string = "redis"
 
 func resourceTencentCloudRedisSecurityGroupAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisSecurityGroupAttachmentCreate,
-		Read:   resourceTencentCloudRedisSecurityGroupAttachmentRead,
-		Delete: resourceTencentCloudRedisSecurityGroupAttachmentDelete,
+		CreateContext: resourceTencentCloudRedisSecurityGroupAttachmentCreate,
+		ReadContext:   resourceTencentCloudRedisSecurityGroupAttachmentRead,
+		DeleteContext: resourceTencentCloudRedisSecurityGroupAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -127,7 +130,7 @@ func resourceTencentCloudRedisSecurityGroupAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRedisSecurityGroupAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisSecurityGroupAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_security_group_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -150,8 +153,8 @@ func resourceTencentCloudRedisSecurityGroupAttachmentCreate(d *schema.ResourceDa
 		request.SecurityGroupId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().AssociateSecurityGroups(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().AssociateSecurityGroupsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -161,33 +164,33 @@ func resourceTencentCloudRedisSecurityGroupAttachmentCreate(d *schema.ResourceDa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create redis securityGroupAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(instanceId + FILED_SP + securityGroupId)
+	d.SetId(helper.IdFormat(instanceId, securityGroupId))
 
-	return resourceTencentCloudRedisSecurityGroupAttachmentRead(d, meta)
+	return resourceTencentCloudRedisSecurityGroupAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisSecurityGroupAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisSecurityGroupAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_security_group_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	securityGroupId := idSplit[1]
 
 	securityGroupAttachment, err := service.DescribeRedisSecurityGroupAttachmentById(ctx, PRODUCT, instanceId, securityGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if securityGroupAttachment == nil {
@@ -205,23 +208,23 @@ func resourceTencentCloudRedisSecurityGroupAttachmentRead(d *schema.ResourceData
 	return nil
 }
 
-func resourceTencentCloudRedisSecurityGroupAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisSecurityGroupAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_security_group_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	securityGroupId := idSplit[1]
 
 	if err := service.DeleteRedisSecurityGroupAttachmentById(ctx, PRODUCT, instanceId, securityGroupId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -73,9 +73,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -84,12 +85,15 @@ import (
 
 func resourceTencentCloudRedisSsl() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisSslCreate,
-		Read:   resourceTencentCloudRedisSslRead,
-		Update: resourceTencentCloudRedisSslUpdate,
-		Delete: resourceTencentCloudRedisSslDelete,
+		CreateContext: resourceTencentCloudRedisSslCreate,
+		ReadContext:   resourceTencentCloudRedisSslRead,
+		UpdateContext: resourceTencentCloudRedisSslUpdate,
+		DeleteContext: resourceTencentCloudRedisSslDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(18 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -108,7 +112,7 @@ func resourceTencentCloudRedisSsl() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRedisSslCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisSslCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_ssl.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -121,15 +125,15 @@ func resourceTencentCloudRedisSslCreate(d *schema.ResourceData, meta interface{}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudRedisSslUpdate(d, meta)
+	return resourceTencentCloudRedisSslUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisSslRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisSslRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_ssl.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -137,7 +141,7 @@ func resourceTencentCloudRedisSslRead(d *schema.ResourceData, meta interface{})
 
 	ssl, err := service.DescribeRedisSslById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ssl == nil {
@@ -157,12 +161,12 @@ func resourceTencentCloudRedisSslRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudRedisSslUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisSslUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_ssl.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		openSSLRequest  = redis.NewOpenSSLRequest()
@@ -175,8 +179,8 @@ func resourceTencentCloudRedisSslUpdate(d *schema.ResourceData, meta interface{}
 		config := v.(string)
 		if config == "enabled" {
 			openSSLRequest.InstanceId = &instanceId
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().OpenSSL(openSSLRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().OpenSSLWithContext(ctx, openSSLRequest)
 				if e != nil {
 					if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 						if ee.Code == "FailedOperation.SystemError" {
@@ -192,12 +196,12 @@ func resourceTencentCloudRedisSslUpdate(d *schema.ResourceData, meta interface{}
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s update redis ssl failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		} else {
 			closeSSLRequest.InstanceId = &instanceId
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().CloseSSL(closeSSLRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().CloseSSLWithContext(ctx, closeSSLRequest)
 				if e != nil {
 					if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 						if ee.Code == "FailedOperation.SystemError" {
@@ -213,39 +217,25 @@ func resourceTencentCloudRedisSslUpdate(d *schema.ResourceData, meta interface{}
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s update redis ssl failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 	if taskId > 0 {
-		err := resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-			ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-			if err != nil {
-				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-			if ok {
-				return nil
-			} else {
-				return resource.RetryableError(fmt.Errorf("ssl config is processing"))
-			}
-		})
+		err := service.WaitForTask(ctx, taskId, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			log.Printf("[CRITAL]%s redis ssl config fail, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudRedisSslRead(d, meta)
+	return resourceTencentCloudRedisSslRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisSslDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisSslDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_ssl.delete")()
 	defer inconsistentCheck(d, meta)()
 
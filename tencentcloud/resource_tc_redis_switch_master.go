@@ -75,9 +75,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -87,10 +88,13 @@ import (
 
 func resourceTencentCloudRedisSwitchMaster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisSwitchMasterCreate,
-		Read:   resourceTencentCloudRedisSwitchMasterRead,
-		Update: resourceTencentCloudRedisSwitchMasterUpdate,
-		Delete: resourceTencentCloudRedisSwitchMasterDelete,
+		CreateContext: resourceTencentCloudRedisSwitchMasterCreate,
+		ReadContext:   resourceTencentCloudRedisSwitchMasterRead,
+		UpdateContext: resourceTencentCloudRedisSwitchMasterUpdate,
+		DeleteContext: resourceTencentCloudRedisSwitchMasterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(18 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -108,7 +112,7 @@ func resourceTencentCloudRedisSwitchMaster() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRedisSwitchMasterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisSwitchMasterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_switch_master.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -121,15 +125,15 @@ func resourceTencentCloudRedisSwitchMasterCreate(d *schema.ResourceData, meta in
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudRedisSwitchMasterUpdate(d, meta)
+	return resourceTencentCloudRedisSwitchMasterUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisSwitchMasterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisSwitchMasterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_switch_master.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -139,7 +143,7 @@ func resourceTencentCloudRedisSwitchMasterRead(d *schema.ResourceData, meta inte
 
 	switchMaster, err := service.DescribeRedisInstanceZoneInfoByFilter(ctx, paramMap)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if switchMaster == nil {
@@ -162,12 +166,12 @@ func resourceTencentCloudRedisSwitchMasterRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudRedisSwitchMasterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisSwitchMasterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_switch_master.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := redis.NewChangeReplicaToMasterRequest()
 	response := redis.NewChangeReplicaToMasterResponse()
@@ -179,8 +183,8 @@ func resourceTencentCloudRedisSwitchMasterUpdate(d *schema.ResourceData, meta in
 		request.GroupId = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ChangeReplicaToMaster(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ChangeReplicaToMasterWithContext(ctx, request)
 		if e != nil {
 			if _, ok := e.(*sdkErrors.TencentCloudSDKError); !ok {
 				return resource.RetryableError(e)
@@ -195,36 +199,22 @@ func resourceTencentCloudRedisSwitchMasterUpdate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update redis switchMaster failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 	taskId := *response.Response.TaskId
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("update redis switchMaster is processing"))
-		}
-	})
+	err = service.WaitForTask(ctx, taskId, d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update redis switchMaster fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudRedisSwitchMasterRead(d, meta)
+	return resourceTencentCloudRedisSwitchMasterRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisSwitchMasterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisSwitchMasterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_switch_master.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -15,23 +15,26 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudRedisUpgradeMultiZoneOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisUpgradeMultiZoneOperationCreate,
-		Read:   resourceTencentCloudRedisUpgradeMultiZoneOperationRead,
-		Delete: resourceTencentCloudRedisUpgradeMultiZoneOperationDelete,
+		CreateContext: resourceTencentCloudRedisUpgradeMultiZoneOperationCreate,
+		ReadContext:   resourceTencentCloudRedisUpgradeMultiZoneOperationRead,
+		DeleteContext: resourceTencentCloudRedisUpgradeMultiZoneOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(18 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -51,12 +54,12 @@ func resourceTencentCloudRedisUpgradeMultiZoneOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRedisUpgradeMultiZoneOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisUpgradeMultiZoneOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_upgrade_multi_zone_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request    = redis.NewUpgradeVersionToMultiAvailabilityZonesRequest()
@@ -72,8 +75,8 @@ func resourceTencentCloudRedisUpgradeMultiZoneOperationCreate(d *schema.Resource
 		request.UpgradeProxyAndRedisServer = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().UpgradeVersionToMultiAvailabilityZones(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().UpgradeVersionToMultiAvailabilityZonesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -84,45 +87,31 @@ func resourceTencentCloudRedisUpgradeMultiZoneOperationCreate(d *schema.Resource
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate redis upgradeMultiZoneOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 	taskId := *response.Response.FlowId
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("upgrade multi zone is processing"))
-		}
-	})
+	err = service.WaitForTask(ctx, taskId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s redis upgrade multi zone fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudRedisUpgradeMultiZoneOperationRead(d, meta)
+	return resourceTencentCloudRedisUpgradeMultiZoneOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisUpgradeMultiZoneOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisUpgradeMultiZoneOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_upgrade_multi_zone_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudRedisUpgradeMultiZoneOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisUpgradeMultiZoneOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_upgrade_multi_zone_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -17,23 +17,26 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudRedisUpgradeProxyVersionOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisUpgradeProxyVersionOperationCreate,
-		Read:   resourceTencentCloudRedisUpgradeProxyVersionOperationRead,
-		Delete: resourceTencentCloudRedisUpgradeProxyVersionOperationDelete,
+		CreateContext: resourceTencentCloudRedisUpgradeProxyVersionOperationCreate,
+		ReadContext:   resourceTencentCloudRedisUpgradeProxyVersionOperationRead,
+		DeleteContext: resourceTencentCloudRedisUpgradeProxyVersionOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(18 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -67,12 +70,12 @@ func resourceTencentCloudRedisUpgradeProxyVersionOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRedisUpgradeProxyVersionOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisUpgradeProxyVersionOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_upgrade_proxy_version_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request    = redis.NewUpgradeProxyVersionRequest()
@@ -96,8 +99,8 @@ func resourceTencentCloudRedisUpgradeProxyVersionOperationCreate(d *schema.Resou
 		request.InstanceTypeUpgradeNow = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().UpgradeProxyVersion(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().UpgradeProxyVersionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -108,45 +111,31 @@ func resourceTencentCloudRedisUpgradeProxyVersionOperationCreate(d *schema.Resou
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate redis upgradeProxyVersionOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
 	service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
 	taskId := *response.Response.FlowId
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("upgrade proxy version is processing"))
-		}
-	})
+	err = service.WaitForTask(ctx, taskId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s redis upgrade proxy version fail, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudRedisUpgradeProxyVersionOperationRead(d, meta)
+	return resourceTencentCloudRedisUpgradeProxyVersionOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRedisUpgradeProxyVersionOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisUpgradeProxyVersionOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_upgrade_proxy_version_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudRedisUpgradeProxyVersionOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRedisUpgradeProxyVersionOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_redis_upgrade_proxy_version_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
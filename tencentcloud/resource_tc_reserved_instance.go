@@ -24,8 +24,9 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
@@ -33,12 +34,15 @@ import (
 
 func resourceTencentCloudReservedInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudReservedInstanceCreate,
-		Read:   resourceTencentCloudReservedInstanceRead,
-		Update: resourceTencentCloudReservedInstanceUpdate,
-		Delete: resourceTencentCloudReservedInstanceDelete,
+		CreateContext: resourceTencentCloudReservedInstanceCreate,
+		ReadContext:   resourceTencentCloudReservedInstanceRead,
+		UpdateContext: resourceTencentCloudReservedInstanceUpdate,
+		DeleteContext: resourceTencentCloudReservedInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -81,10 +85,10 @@ func resourceTencentCloudReservedInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudReservedInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudReservedInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_reserved_instance.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	configId := d.Get("config_id").(string)
 	count := d.Get("instance_count").(int)
@@ -97,7 +101,7 @@ func resourceTencentCloudReservedInstanceCreate(d *schema.ResourceData, meta int
 	}
 	var instanceId string
 	var errRet error
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instanceId, errRet = cvmService.CreateReservedInstance(ctx, configId, int64(count), extendParams)
 		if errRet != nil {
 			return retryError(errRet)
@@ -105,19 +109,19 @@ func resourceTencentCloudReservedInstanceCreate(d *schema.ResourceData, meta int
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(instanceId)
 
-	return resourceTencentCloudReservedInstanceRead(d, meta)
+	return resourceTencentCloudReservedInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudReservedInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudReservedInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_reserved_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	cvmService := CvmService{
@@ -128,7 +132,7 @@ func resourceTencentCloudReservedInstanceRead(d *schema.ResourceData, meta inter
 	}
 	var instances []*cvm.ReservedInstances
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		instances, errRet = cvmService.DescribeReservedInstanceByFilter(ctx, filter)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -136,7 +140,7 @@ func resourceTencentCloudReservedInstanceRead(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(instances) < 1 {
 		d.SetId("")
@@ -153,10 +157,10 @@ func resourceTencentCloudReservedInstanceRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudReservedInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	return fmt.Errorf("reserved instance not allowed to modify")
+func resourceTencentCloudReservedInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diag.Errorf("reserved instance not allowed to modify")
 }
 
-func resourceTencentCloudReservedInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudReservedInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
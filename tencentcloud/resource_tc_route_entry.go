@@ -32,6 +32,14 @@ resource "tencentcloud_route_entry" "rtb_entry_instance" {
   next_hub       = "vpngw-db52irtl"
 }
 ```
+
+Import
+
+Route entry can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_route_entry.rtb_entry_instance vpc-ahukkpet::rtb-bh9zjutv::10.4.4.0/24::CVM::10.16.1.7
+```
 */
 package tencentcloud
 
@@ -39,7 +47,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -74,9 +84,15 @@ var routeTypeNewMap = map[string]string{
 func resourceTencentCloudRouteEntry() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource has been deprecated in Terraform TencentCloud provider version 1.10.0. Please use 'tencentcloud_route_table_entry' instead.",
-		Create:             resourceTencentCloudRouteEntryCreate,
-		Read:               resourceTencentCloudRouteEntryRead,
-		Delete:             resourceTencentCloudRouteEntryDelete,
+		CreateContext:      resourceTencentCloudRouteEntryCreate,
+		ReadContext:        resourceTencentCloudRouteEntryRead,
+		DeleteContext:      resourceTencentCloudRouteEntryDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -126,11 +142,11 @@ func resourceTencentCloudRouteEntry() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRouteEntryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRouteEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_route_entry.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	vpcId := d.Get("vpc_id").(string)
@@ -140,16 +156,16 @@ func resourceTencentCloudRouteEntryCreate(d *schema.ResourceData, meta interface
 	nextHub := d.Get("next_hub").(string)
 
 	if nextType == GATE_WAY_TYPE_EIP && nextHub != "0" {
-		return fmt.Errorf("if next_type is %s, next_hub can only be \"0\" ", GATE_WAY_TYPE_EIP)
+		return diag.Errorf("if next_type is %s, next_hub can only be \"0\" ", GATE_WAY_TYPE_EIP)
 	}
 
 	if _, ok := routeTypeNewMap[nextType]; !ok {
-		return fmt.Errorf("The value of next_type is invalid")
+		return diag.Errorf("The value of next_type is invalid")
 	}
 
 	_, err := service.CreateRoutes(ctx, routeTableId, destinationCidrBlock, routeTypeNewMap[nextType], nextHub, "", true)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	route := map[string]string{
@@ -161,28 +177,28 @@ func resourceTencentCloudRouteEntryCreate(d *schema.ResourceData, meta interface
 	}
 	uniqRouteEntryId, ok := routeIdEncode(route)
 	if !ok {
-		return fmt.Errorf("Failed to encode route entry")
+		return diag.Errorf("Failed to encode route entry")
 	}
 
 	d.SetId(uniqRouteEntryId)
 
-	return resourceTencentCloudRouteEntryRead(d, meta)
+	return resourceTencentCloudRouteEntryRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRouteEntryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRouteEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_route_entry.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	route, ok := routeIdDecode(d.Id())
 	if !ok {
-		return fmt.Errorf("tencentcloud_route_entry read error, id decode faild, id:%v", d.Id())
+		return diag.Errorf("tencentcloud_route_entry read error, id decode faild, id:%v", d.Id())
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		info, has, e := service.DescribeRouteTable(ctx, route["routeTableId"])
 		if e != nil {
 			return retryError(e)
@@ -223,26 +239,26 @@ func resourceTencentCloudRouteEntryRead(d *schema.ResourceData, meta interface{}
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceTencentCloudRouteEntryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRouteEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_route_entry.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	route, ok := routeIdDecode(d.Id())
 	if !ok {
-		return fmt.Errorf("tencentcloud_route_entry read error, id decode faild, id:%v", d.Id())
+		return diag.Errorf("tencentcloud_route_entry read error, id decode faild, id:%v", d.Id())
 	}
 
 	var routeEntryId int64
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		info, has, e := service.DescribeRouteTable(ctx, route["routeTableId"])
 		if e != nil {
 			return retryError(e)
@@ -282,10 +298,10 @@ func resourceTencentCloudRouteEntryDelete(d *schema.ResourceData, meta interface
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if err := service.DeleteRoutes(ctx, route["routeTableId"], uint64(routeEntryId)); err != nil {
 			if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok {
 				if sdkErr.Code == VPCNotFound {
@@ -297,7 +313,7 @@ func resourceTencentCloudRouteEntryDelete(d *schema.ResourceData, meta interface
 		return nil
 	})
 
-	return err
+	return diag.FromErr(err)
 }
 
 func routeIdEncode(route map[string]string) (routeId string, ok bool) {
@@ -29,7 +29,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -38,12 +40,15 @@ import (
 
 func resourceTencentCloudVpcRouteTable() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcRouteTableCreate,
-		Read:   resourceTencentCloudVpcRouteTableRead,
-		Update: resourceTencentCloudVpcRouteTableUpdate,
-		Delete: resourceTencentCloudVpcRouteTableDelete,
+		CreateContext: resourceTencentCloudVpcRouteTableCreate,
+		ReadContext:   resourceTencentCloudVpcRouteTableRead,
+		UpdateContext: resourceTencentCloudVpcRouteTableUpdate,
+		DeleteContext: resourceTencentCloudVpcRouteTableDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -95,11 +100,11 @@ func resourceTencentCloudVpcRouteTable() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcRouteTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_route_table.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	vpcService := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -111,7 +116,7 @@ func resourceTencentCloudVpcRouteTableCreate(d *schema.ResourceData, meta interf
 	if temp, ok := d.GetOk("vpc_id"); ok {
 		vpcId = temp.(string)
 		if len(vpcId) < 1 {
-			return fmt.Errorf("vpc_id should be not empty string")
+			return diag.Errorf("vpc_id should be not empty string")
 		}
 	}
 	if temp, ok := d.GetOk("name"); ok {
@@ -124,7 +129,7 @@ func resourceTencentCloudVpcRouteTableCreate(d *schema.ResourceData, meta interf
 
 	routeTableId, err := vpcService.CreateRouteTable(ctx, name, vpcId, tags)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(routeTableId)
 
@@ -135,19 +140,19 @@ func resourceTencentCloudVpcRouteTableCreate(d *schema.ResourceData, meta interf
 		resourceName := fmt.Sprintf("qcs::vpc:%s:uin/:rtb/%s", region, routeTableId)
 
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudVpcRouteTableRead(d, meta)
+	return resourceTencentCloudVpcRouteTableRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcRouteTableRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcRouteTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_route_table.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -157,7 +162,7 @@ func resourceTencentCloudVpcRouteTableRead(d *schema.ResourceData, meta interfac
 		e    error
 	)
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		info, has, e = service.DescribeRouteTable(ctx, id)
 		if e != nil {
 			return retryError(e)
@@ -175,7 +180,7 @@ func resourceTencentCloudVpcRouteTableRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if has == 0 {
 		return nil
@@ -191,7 +196,7 @@ func resourceTencentCloudVpcRouteTableRead(d *schema.ResourceData, meta interfac
 	region := meta.(*TencentCloudClient).apiV3Conn.Region
 	tags, err := tagService.DescribeResourceTags(ctx, "vpc", "rtb", region, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("vpc_id", info.vpcId)
@@ -205,11 +210,11 @@ func resourceTencentCloudVpcRouteTableRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudVpcRouteTableUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_route_table.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -221,7 +226,7 @@ func resourceTencentCloudVpcRouteTableUpdate(d *schema.ResourceData, meta interf
 		name := d.Get("name").(string)
 		err := service.ModifyRouteTableAttribute(ctx, id, name)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -236,25 +241,25 @@ func resourceTencentCloudVpcRouteTableUpdate(d *schema.ResourceData, meta interf
 		resourceName := fmt.Sprintf("qcs::vpc:%s:uin/:rtb/%s", region, id)
 
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudVpcRouteTableRead(d, meta)
+	return resourceTencentCloudVpcRouteTableRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcRouteTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_route_table.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if err := service.DeleteRouteTable(ctx, d.Id()); err != nil {
 			if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok {
 				if sdkErr.Code == VPCNotFound {
@@ -266,5 +271,5 @@ func resourceTencentCloudVpcRouteTableDelete(d *schema.ResourceData, meta interf
 		return nil
 	})
 
-	return err
+	return diag.FromErr(err)
 }
@@ -50,10 +50,12 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -61,12 +63,15 @@ import (
 
 func resourceTencentCloudVpcRouteEntry() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcRouteEntryCreate,
-		Read:   resourceTencentCloudVpcRouteEntryRead,
-		Update: resourceTencentCloudVpcRouteEntryUpdate,
-		Delete: resourceTencentCloudVpcRouteEntryDelete,
+		CreateContext: resourceTencentCloudVpcRouteEntryCreate,
+		ReadContext:   resourceTencentCloudVpcRouteEntryRead,
+		UpdateContext: resourceTencentCloudVpcRouteEntryUpdate,
+		DeleteContext: resourceTencentCloudVpcRouteEntryDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -112,11 +117,11 @@ func resourceTencentCloudVpcRouteEntry() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcRouteEntryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcRouteEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_route_table_entry.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -150,18 +155,18 @@ func resourceTencentCloudVpcRouteEntryCreate(d *schema.ResourceData, meta interf
 	}
 
 	if routeTableId == "" || destinationCidrBlock == "" || nextType == "" || nextHub == "" {
-		return fmt.Errorf("some needed fields is empty string")
+		return diag.Errorf("some needed fields is empty string")
 	}
 
 	if nextType == GATE_WAY_TYPE_EIP && nextHub != "0" {
-		return fmt.Errorf("if next_type is %s, next_hub can only be \"0\" ", GATE_WAY_TYPE_EIP)
+		return diag.Errorf("if next_type is %s, next_hub can only be \"0\" ", GATE_WAY_TYPE_EIP)
 	}
 
 	// route cannot disable on create
 	entryId, err := service.CreateRoutes(ctx, routeTableId, destinationCidrBlock, nextType, nextHub, description, true)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%d.%s", entryId, routeTableId))
@@ -172,27 +177,27 @@ func resourceTencentCloudVpcRouteEntryCreate(d *schema.ResourceData, meta interf
 		request.RouteIds = []*uint64{helper.Int64Uint64(entryId)}
 		err := service.DisableRoutes(ctx, request)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceTencentCloudVpcRouteEntryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcRouteEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_route_table_entry.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	items := strings.Split(d.Id(), ".")
 	if len(items) != 2 {
-		return fmt.Errorf("entry id be destroyed, we can not get route table id")
+		return diag.Errorf("entry id be destroyed, we can not get route table id")
 	}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		info, has, e := service.DescribeRouteTable(ctx, items[1])
 		if e != nil {
 			return retryError(e)
@@ -224,58 +229,58 @@ func resourceTencentCloudVpcRouteEntryRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudVpcRouteEntryUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcRouteEntryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := VpcService{client}
 
 	items := strings.Split(d.Id(), ".")
 	if len(items) != 2 {
-		return fmt.Errorf("entry id be destroyed, we can not get route table id")
+		return diag.Errorf("entry id be destroyed, we can not get route table id")
 	}
 
 	id := items[0]
 	routeTableId := items[1]
 	routeEntryId, err := strconv.ParseUint(id, 10, 64)
 	if err != nil {
-		return fmt.Errorf("parse route entry id %s fail: %s", id, routeTableId)
+		return diag.Errorf("parse route entry id %s fail: %s", id, routeTableId)
 	}
 
 	if d.HasChange("disabled") {
 		disabled := d.Get("disabled").(bool)
 		if err := service.SwitchRouteEnabled(ctx, routeTableId, routeEntryId, !disabled); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudVpcRouteEntryRead(d, meta)
+	return resourceTencentCloudVpcRouteEntryRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcRouteEntryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcRouteEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_route_table_entry.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	items := strings.Split(d.Id(), ".")
 	if len(items) != 2 {
-		return fmt.Errorf("entry id be destroyed, we can not get route table id")
+		return diag.Errorf("entry id be destroyed, we can not get route table id")
 	}
 
 	routeTableId := items[1]
 	entryId, err := strconv.ParseUint(items[0], 10, 64)
 	if err != nil {
-		return fmt.Errorf("entry id be destroyed, we can not get route entry id")
+		return diag.Errorf("entry id be destroyed, we can not get route entry id")
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if err := service.DeleteRoutes(ctx, routeTableId, entryId); err != nil {
 			if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok {
 				if sdkErr.Code == VPCNotFound {
@@ -287,5 +292,5 @@ func resourceTencentCloudVpcRouteEntryDelete(d *schema.ResourceData, meta interf
 		return nil
 	})
 
-	return err
+	return diag.FromErr(err)
 }
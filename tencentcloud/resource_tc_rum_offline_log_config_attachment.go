@@ -21,10 +21,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	rum "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/rum/v20210622"
@@ -33,11 +33,14 @@ import (
 
 func resourceTencentCloudRumOfflineLogConfigAttachment() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudRumOfflineLogConfigAttachmentRead,
-		Create: resourceTencentCloudRumOfflineLogConfigAttachmentCreate,
-		Delete: resourceTencentCloudRumOfflineLogConfigAttachmentDelete,
+		ReadContext:   resourceTencentCloudRumOfflineLogConfigAttachmentRead,
+		CreateContext: resourceTencentCloudRumOfflineLogConfigAttachmentCreate,
+		DeleteContext: resourceTencentCloudRumOfflineLogConfigAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"project_key": {
@@ -63,7 +66,7 @@ func resourceTencentCloudRumOfflineLogConfigAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRumOfflineLogConfigAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRumOfflineLogConfigAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_rum_offline_log_config_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -85,8 +88,8 @@ func resourceTencentCloudRumOfflineLogConfigAttachmentCreate(d *schema.ResourceD
 		request.UniqueID = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRumClient().CreateOfflineLogConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRumClient().CreateOfflineLogConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -98,25 +101,25 @@ func resourceTencentCloudRumOfflineLogConfigAttachmentCreate(d *schema.ResourceD
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create rum offlineLogConfigAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(projectKey + FILED_SP + uniqueId)
-	return resourceTencentCloudRumOfflineLogConfigAttachmentRead(d, meta)
+	d.SetId(helper.IdFormat(projectKey, uniqueId))
+	return resourceTencentCloudRumOfflineLogConfigAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRumOfflineLogConfigAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRumOfflineLogConfigAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_rum_offline_log_config_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RumService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	projectKey := idSplit[0]
 	uniqueId := idSplit[1]
@@ -124,12 +127,12 @@ func resourceTencentCloudRumOfflineLogConfigAttachmentRead(d *schema.ResourceDat
 	offlineLogConfig, err := service.DescribeRumOfflineLogConfigAttachment(ctx, projectKey, uniqueId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if offlineLogConfig == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `offlineLogConfigAttachment` %s does not exist", d.Id())
+		return diag.Errorf("resource `offlineLogConfigAttachment` %s does not exist", d.Id())
 	}
 
 	_ = d.Set("project_key", projectKey)
@@ -142,24 +145,24 @@ func resourceTencentCloudRumOfflineLogConfigAttachmentRead(d *schema.ResourceDat
 	return nil
 }
 
-func resourceTencentCloudRumOfflineLogConfigAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRumOfflineLogConfigAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_rum_offline_log_config_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RumService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	projectKey := idSplit[0]
 	uniqueId := idSplit[1]
 
 	if err := service.DeleteRumOfflineLogConfigAttachmentById(ctx, projectKey, uniqueId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
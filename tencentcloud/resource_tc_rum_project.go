@@ -27,10 +27,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	rum "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/rum/v20210622"
@@ -39,12 +40,16 @@ import (
 
 func resourceTencentCloudRumProject() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudRumProjectRead,
-		Create: resourceTencentCloudRumProjectCreate,
-		Update: resourceTencentCloudRumProjectUpdate,
-		Delete: resourceTencentCloudRumProjectDelete,
+		ReadContext:   resourceTencentCloudRumProjectRead,
+		CreateContext: resourceTencentCloudRumProjectCreate,
+		UpdateContext: resourceTencentCloudRumProjectUpdate,
+		DeleteContext: resourceTencentCloudRumProjectDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -90,8 +95,8 @@ func resourceTencentCloudRumProject() *schema.Resource {
 			},
 
 			"desc": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:        schema.TypeString,
+				Optional:    true,
 				Description: "	Description of the created project (optional and up to 1,000 characters).",
 			},
 
@@ -140,7 +145,7 @@ func resourceTencentCloudRumProject() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRumProjectCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRumProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_rum_project.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -184,8 +189,8 @@ func resourceTencentCloudRumProjectCreate(d *schema.ResourceData, meta interface
 		request.Desc = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRumClient().CreateProject(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRumClient().CreateProjectWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -198,21 +203,21 @@ func resourceTencentCloudRumProjectCreate(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create rum project failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	id = *response.Response.ID
 
 	d.SetId(strconv.Itoa(int(id)))
-	return resourceTencentCloudRumProjectRead(d, meta)
+	return resourceTencentCloudRumProjectRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRumProjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRumProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_rum_project.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RumService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -221,12 +226,12 @@ func resourceTencentCloudRumProjectRead(d *schema.ResourceData, meta interface{}
 	project, err := service.DescribeRumProject(ctx, projectId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if project == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `project` %s does not exist", projectId)
+		return diag.Errorf("resource `project` %s does not exist", projectId)
 	}
 
 	if project.Name != nil {
@@ -292,7 +297,7 @@ func resourceTencentCloudRumProjectRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudRumProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRumProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_rum_project.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -304,7 +309,7 @@ func resourceTencentCloudRumProjectUpdate(d *schema.ResourceData, meta interface
 
 	id, e := strconv.Atoi(projectId)
 	if e != nil {
-		return fmt.Errorf("[ERROR]%s api[%s] sting to uint64 error, err [%s]", logId, request.GetAction(), e)
+		return diag.Errorf("[ERROR]%s api[%s] sting to uint64 error, err [%s]", logId, request.GetAction(), e)
 	}
 
 	request.ID = helper.Uint64(uint64(id))
@@ -358,8 +363,8 @@ func resourceTencentCloudRumProjectUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRumClient().ModifyProject(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRumClient().ModifyProjectWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -371,25 +376,25 @@ func resourceTencentCloudRumProjectUpdate(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create rum project failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudRumProjectRead(d, meta)
+	return resourceTencentCloudRumProjectRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRumProjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRumProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_rum_project.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RumService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	projectId := d.Id()
 
 	if err := service.DeleteRumProjectById(ctx, projectId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -29,7 +29,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	rum "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/rum/v20210622"
@@ -38,12 +40,16 @@ import (
 
 func resourceTencentCloudRumTawInstance() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudRumTawInstanceRead,
-		Create: resourceTencentCloudRumTawInstanceCreate,
-		Update: resourceTencentCloudRumTawInstanceUpdate,
-		Delete: resourceTencentCloudRumTawInstanceDelete,
+		ReadContext:   resourceTencentCloudRumTawInstanceRead,
+		CreateContext: resourceTencentCloudRumTawInstanceCreate,
+		UpdateContext: resourceTencentCloudRumTawInstanceUpdate,
+		DeleteContext: resourceTencentCloudRumTawInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"area_id": {
@@ -133,7 +139,7 @@ func resourceTencentCloudRumTawInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRumTawInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRumTawInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_rum_taw_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -191,8 +197,8 @@ func resourceTencentCloudRumTawInstanceCreate(d *schema.ResourceData, meta inter
 	// 	request.BuyingChannel = helper.String(v.(string))
 	// }
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRumClient().CreateTawInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRumClient().CreateTawInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -205,32 +211,32 @@ func resourceTencentCloudRumTawInstanceCreate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create rum tawInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId = *response.Response.InstanceId
 
 	d.SetId(instanceId)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::rum:%s:uin/:Instance/%s", region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudRumTawInstanceRead(d, meta)
+	return resourceTencentCloudRumTawInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRumTawInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRumTawInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_rum_taw_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RumService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -239,12 +245,12 @@ func resourceTencentCloudRumTawInstanceRead(d *schema.ResourceData, meta interfa
 	tawInstance, err := service.DescribeRumTawInstance(ctx, instanceId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if tawInstance == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `tawInstance` %s does not exist", instanceId)
+		return diag.Errorf("resource `tawInstance` %s does not exist", instanceId)
 	}
 
 	if tawInstance.AreaId != nil {
@@ -316,19 +322,19 @@ func resourceTencentCloudRumTawInstanceRead(d *schema.ResourceData, meta interfa
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "rum", "Instance", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudRumTawInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRumTawInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_rum_taw_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := rum.NewModifyInstanceRequest()
 
@@ -337,15 +343,15 @@ func resourceTencentCloudRumTawInstanceUpdate(d *schema.ResourceData, meta inter
 	request.InstanceId = &instanceId
 
 	if d.HasChange("area_id") {
-		return fmt.Errorf("`area_id` do not support change now.")
+		return diag.Errorf("`area_id` do not support change now.")
 	}
 
 	if d.HasChange("charge_type") {
-		return fmt.Errorf("`charge_type` do not support change now.")
+		return diag.Errorf("`charge_type` do not support change now.")
 	}
 
 	if d.HasChange("data_retention_days") {
-		return fmt.Errorf("`data_retention_days` do not support change now.")
+		return diag.Errorf("`data_retention_days` do not support change now.")
 	}
 
 	if v, ok := d.GetOk("instance_name"); ok {
@@ -356,8 +362,8 @@ func resourceTencentCloudRumTawInstanceUpdate(d *schema.ResourceData, meta inter
 		request.InstanceDesc = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRumClient().ModifyInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRumClient().ModifyInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -369,7 +375,7 @@ func resourceTencentCloudRumTawInstanceUpdate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update rum tawInstance failed, reason:%+v, type: %T", logId, err, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
@@ -379,26 +385,26 @@ func resourceTencentCloudRumTawInstanceUpdate(d *schema.ResourceData, meta inter
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("rum", "Instance", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudRumTawInstanceRead(d, meta)
+	return resourceTencentCloudRumTawInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRumTawInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRumTawInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_rum_taw_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RumService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	instanceId := d.Id()
 
 	if err := service.DeleteRumTawInstanceById(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -23,11 +23,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	rum "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/rum/v20210622"
@@ -36,12 +36,15 @@ import (
 
 func resourceTencentCloudRumWhitelist() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudRumWhitelistRead,
-		Create: resourceTencentCloudRumWhitelistCreate,
-		Update: resourceTencentCloudRumWhitelistUpdate,
-		Delete: resourceTencentCloudRumWhitelistDelete,
+		ReadContext:   resourceTencentCloudRumWhitelistRead,
+		CreateContext: resourceTencentCloudRumWhitelistCreate,
+		UpdateContext: resourceTencentCloudRumWhitelistUpdate,
+		DeleteContext: resourceTencentCloudRumWhitelistDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -95,7 +98,7 @@ func resourceTencentCloudRumWhitelist() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudRumWhitelistCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRumWhitelistCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_rum_whitelist.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -125,8 +128,8 @@ func resourceTencentCloudRumWhitelistCreate(d *schema.ResourceData, meta interfa
 		request.Aid = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRumClient().CreateWhitelist(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseRumClient().CreateWhitelistWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -139,27 +142,27 @@ func resourceTencentCloudRumWhitelistCreate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create rum whitelist failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	wid = strconv.Itoa(int(*response.Response.ID))
 
-	d.SetId(instanceID + FILED_SP + wid)
-	return resourceTencentCloudRumWhitelistRead(d, meta)
+	d.SetId(helper.IdFormat(instanceID, wid))
+	return resourceTencentCloudRumWhitelistRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRumWhitelistRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRumWhitelistRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_rum_whitelist.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RumService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceID := idSplit[0]
 	wid := idSplit[1]
@@ -167,12 +170,12 @@ func resourceTencentCloudRumWhitelistRead(d *schema.ResourceData, meta interface
 	whitelist, err := service.DescribeRumWhitelist(ctx, instanceID, wid)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if whitelist == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `whitelist` %s does not exist", wid)
+		return diag.Errorf("resource `whitelist` %s does not exist", wid)
 	}
 
 	if whitelist.InstanceID != nil {
@@ -210,47 +213,47 @@ func resourceTencentCloudRumWhitelistRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudRumWhitelistUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRumWhitelistUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_rum_whitelist.update")()
 	defer inconsistentCheck(d, meta)()
 
 	if d.HasChange("instance_id") {
-		return fmt.Errorf("`instance_id` do not support change now.")
+		return diag.Errorf("`instance_id` do not support change now.")
 	}
 
 	if d.HasChange("remark") {
-		return fmt.Errorf("`remark` do not support change now.")
+		return diag.Errorf("`remark` do not support change now.")
 	}
 
 	if d.HasChange("whitelist_uin") {
-		return fmt.Errorf("`whitelist_uin` do not support change now.")
+		return diag.Errorf("`whitelist_uin` do not support change now.")
 	}
 
 	if d.HasChange("aid") {
-		return fmt.Errorf("`aid` do not support change now.")
+		return diag.Errorf("`aid` do not support change now.")
 	}
 
-	return resourceTencentCloudRumWhitelistRead(d, meta)
+	return resourceTencentCloudRumWhitelistRead(ctx, d, meta)
 }
 
-func resourceTencentCloudRumWhitelistDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudRumWhitelistDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_rum_whitelist.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := RumService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceID := idSplit[0]
 	wid := idSplit[1]
 
 	if err := service.DeleteRumWhitelistById(ctx, instanceID, wid); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -59,6 +59,7 @@ import (
 
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mitchellh/go-homedir"
 	"github.com/pkg/errors"
@@ -98,12 +99,12 @@ func scfFunctionValidate(allowDot bool) schema.SchemaValidateFunc {
 
 func resourceTencentCloudScfFunction() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudScfFunctionCreate,
-		Read:   resourceTencentCloudScfFunctionRead,
-		Update: resourceTencentCloudScfFunctionUpdate,
-		Delete: resourceTencentCloudScfFunctionDelete,
+		CreateContext: resourceTencentCloudScfFunctionCreate,
+		ReadContext:   resourceTencentCloudScfFunctionRead,
+		UpdateContext: resourceTencentCloudScfFunctionUpdate,
+		DeleteContext: resourceTencentCloudScfFunctionDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -517,10 +518,10 @@ func resourceTencentCloudScfFunction() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudScfFunctionCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudScfFunctionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_function.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := m.(*TencentCloudClient).apiV3Conn
 	scfService := ScfService{client: client}
@@ -547,7 +548,7 @@ func resourceTencentCloudScfFunctionCreate(d *schema.ResourceData, m interface{}
 		functionInfo.subnetId = helper.String(raw.(string))
 	}
 	if err := helper.CheckIfSetTogether(d, "vpc_id", "subnet_id"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if raw, ok := d.GetOk("role"); ok {
@@ -564,7 +565,7 @@ func resourceTencentCloudScfFunctionCreate(d *schema.ResourceData, m interface{}
 		functionInfo.funcType = helper.String(raw.(string))
 	}
 	if err := helper.CheckIfSetTogether(d, "cls_logset_id", "cls_topic_id"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	type scfFunctionCodeType int
@@ -628,7 +629,7 @@ func resourceTencentCloudScfFunctionCreate(d *schema.ResourceData, m interface{}
 		eipStatus := "DISABLE"
 		if enableEip {
 			if !enablePublicNet.(bool) {
-				return fmt.Errorf("cannot set enable_eip_config to true if enable_public_net was disable")
+				return diag.Errorf("cannot set enable_eip_config to true if enable_public_net was disable")
 			}
 			eipStatus = "ENABLE"
 		}
@@ -640,16 +641,16 @@ func resourceTencentCloudScfFunctionCreate(d *schema.ResourceData, m interface{}
 	if raw, ok := d.GetOk("zip_file"); ok {
 		path, err := homedir.Expand(raw.(string))
 		if err != nil {
-			return fmt.Errorf("zip file (%s) homedir expand error: %s", raw.(string), err.Error())
+			return diag.Errorf("zip file (%s) homedir expand error: %s", raw.(string), err.Error())
 		}
 		file, err := os.Open(path)
 		if err != nil {
-			return fmt.Errorf("zip file (%s) open error: %s", path, err.Error())
+			return diag.Errorf("zip file (%s) open error: %s", path, err.Error())
 		}
 		defer file.Close()
 		body, err := ioutil.ReadAll(file)
 		if err != nil {
-			return fmt.Errorf("zip file (%s) read error: %s", path, err.Error())
+			return diag.Errorf("zip file (%s) read error: %s", path, err.Error())
 		}
 
 		codeType = scfFunctionZipFileCode
@@ -687,13 +688,13 @@ func resourceTencentCloudScfFunctionCreate(d *schema.ResourceData, m interface{}
 	switch codeType {
 	case scfFunctionCosCode:
 		if err := helper.CheckIfSetTogether(d, "cos_bucket_name", "cos_object_name", "cos_bucket_region"); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	case scfFunctionZipFileCode:
 	case scfFunctionImageCode:
 	default:
-		return errors.New("no function code set")
+		return diag.FromErr(errors.New("no function code set"))
 	}
 
 	if v, ok := d.GetOk("cfs_config"); ok {
@@ -734,7 +735,7 @@ func resourceTencentCloudScfFunctionCreate(d *schema.ResourceData, m interface{}
 
 	if err := scfService.CreateFunction(ctx, functionInfo); err != nil {
 		log.Printf("[CRITAL]%s create function failed: %+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// id format is [namespace]+[function name], so that we can support import with enough info
@@ -742,7 +743,7 @@ func resourceTencentCloudScfFunctionCreate(d *schema.ResourceData, m interface{}
 
 	err := waitScfFunctionReady(ctx, functionInfo.name, *functionInfo.namespace, client.UseScfClient())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.Get("l5_enable").(bool) {
@@ -752,7 +753,7 @@ func resourceTencentCloudScfFunctionCreate(d *schema.ResourceData, m interface{}
 			l5Enable:  helper.Bool(true),
 		}); err != nil {
 			log.Printf("[CRITAL]%s enable function L5 failed: %+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -765,7 +766,7 @@ func resourceTencentCloudScfFunctionCreate(d *schema.ResourceData, m interface{}
 			switch tg["type"].(string) {
 			case SCF_TRIGGER_TYPE_COS:
 				if tg["cos_region"].(string) == "" {
-					return fmt.Errorf("type if cos, cos_region is required")
+					return diag.Errorf("type if cos, cos_region is required")
 				}
 				// scf cos trigger name format is xxx-1234567890.cos.ap-guangzhou.myqcloud.com
 				tg["name"] = fmt.Sprintf("%s.cos.%s.myqcloud.com", tg["name"].(string), tg["cos_region"].(string))
@@ -780,25 +781,25 @@ func resourceTencentCloudScfFunctionCreate(d *schema.ResourceData, m interface{}
 
 		if err := scfService.CreateTriggers(ctx, functionInfo.name, *functionInfo.namespace, triggers); err != nil {
 			log.Printf("[CRITAL]%s create triggers failed: %+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	_, err = scfService.DescribeFunction(ctx, functionInfo.name, *functionInfo.namespace)
 	if err != nil {
 		log.Printf("[CRITAL]%s get function id failed: %+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudScfFunctionRead(d, m)
+	return resourceTencentCloudScfFunctionRead(ctx, d, m)
 }
 
-func resourceTencentCloudScfFunctionRead(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudScfFunctionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_function.read")()
 	defer inconsistentCheck(d, m)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ScfService{client: m.(*TencentCloudClient).apiV3Conn}
 
@@ -893,7 +894,7 @@ func resourceTencentCloudScfFunctionRead(d *schema.ResourceData, m interface{})
 			cfsList = append(cfsList, cfs)
 		}
 		if err := d.Set("cfs_config", cfsList); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -926,10 +927,10 @@ func resourceTencentCloudScfFunctionRead(d *schema.ResourceData, m interface{})
 	return nil
 }
 
-func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudScfFunctionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_function.update")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := m.(*TencentCloudClient).apiV3Conn
 	scfService := ScfService{client: client}
@@ -946,7 +947,7 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 	namespace, name := split[0], split[1]
 
 	if d.HasChange("func_type") {
-		return fmt.Errorf("`func_type` do not support change now.")
+		return diag.Errorf("`func_type` do not support change now.")
 	}
 
 	d.Partial(true)
@@ -986,7 +987,7 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 		functionInfo.cosBucketRegion = helper.String(raw.(string))
 	}
 	if err := helper.CheckIfSetTogether(d, "cos_bucket_name", "cos_object_name", "cos_bucket_region"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("zip_file") {
@@ -995,16 +996,16 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 	if raw, ok := d.GetOk("zip_file"); ok {
 		path, err := homedir.Expand(raw.(string))
 		if err != nil {
-			return fmt.Errorf("zip file (%s) homedir expand error: %s", raw.(string), err.Error())
+			return diag.Errorf("zip file (%s) homedir expand error: %s", raw.(string), err.Error())
 		}
 		file, err := os.Open(path)
 		if err != nil {
-			return fmt.Errorf("zip file (%s) open error: %s", path, err.Error())
+			return diag.Errorf("zip file (%s) open error: %s", path, err.Error())
 		}
 		defer file.Close()
 		body, err := ioutil.ReadAll(file)
 		if err != nil {
-			return fmt.Errorf("zip file (%s) read error: %s", path, err.Error())
+			return diag.Errorf("zip file (%s) read error: %s", path, err.Error())
 		}
 
 		content := base64.StdEncoding.EncodeToString(body)
@@ -1080,14 +1081,14 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 	// update function code
 	if len(updateAttrs) > 0 {
 		if len(updateAttrs) == 0 && updateAttrs[0] == "handler" {
-			return errors.New("can't only change handler")
+			return diag.FromErr(errors.New("can't only change handler"))
 		}
 
 		functionInfo.handler = helper.String(d.Get("handler").(string))
 
 		if err := scfService.ModifyFunctionCode(ctx, functionInfo); err != nil {
 			log.Printf("[CRITAL]%s update function code failed: %+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -1134,7 +1135,7 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 		functionInfo.subnetId = helper.String(raw.(string))
 	}
 	if err := helper.CheckIfSetTogether(d, "vpc_id", "subnet_id"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("role") {
@@ -1155,7 +1156,7 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 		functionInfo.clsTopicId = helper.String(raw.(string))
 	}
 	if err := helper.CheckIfSetTogether(d, "cls_logset_id", "cls_topic_id"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("l5_enable") {
@@ -1190,7 +1191,7 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 		enablePublicNet := d.Get("enable_public_net").(bool)
 		if raw.(bool) {
 			if !enablePublicNet {
-				return fmt.Errorf("cannot set enable_eip_config to true if enable_public_net was disable")
+				return diag.Errorf("cannot set enable_eip_config to true if enable_public_net was disable")
 			}
 			status = "ENABLE"
 		}
@@ -1203,7 +1204,7 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 	if len(updateAttrs) > 0 {
 		if err := scfService.ModifyFunctionConfig(ctx, functionInfo); err != nil {
 			log.Printf("[CRITAL]%s update function configuration failed: %+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1229,7 +1230,7 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 		}
 		if err := scfService.DeleteTriggers(ctx, name, namespace, oldTriggers); err != nil {
 			log.Printf("[CRITAL]%s delete old triggers failed: %+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		newTriggers := make([]scfTrigger, 0, newSet.Len())
@@ -1239,7 +1240,7 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 			switch tg["type"].(string) {
 			case SCF_TRIGGER_TYPE_COS:
 				if tg["cos_region"].(string) == "" {
-					return fmt.Errorf("type if cos, cos_region is required")
+					return diag.Errorf("type if cos, cos_region is required")
 				}
 				tg["name"] = fmt.Sprintf("%s.cos.%s.myqcloud.com", tg["name"].(string), tg["cos_region"].(string))
 			}
@@ -1252,7 +1253,7 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 		}
 		if err := scfService.CreateTriggers(ctx, name, namespace, newTriggers); err != nil {
 			log.Printf("[CRITAL]%s create new triggers failed: %+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -1261,7 +1262,7 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 		resp, err := scfService.DescribeFunction(ctx, functionInfo.name, *functionInfo.namespace)
 		if err != nil {
 			log.Printf("[CRITAL]%s get function id failed: %+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 		functionId := *resp.Response.FunctionId
 
@@ -1271,7 +1272,7 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
 			log.Printf("[CRITAL]%s update function tags failed: %+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		// wait for tags add successfully
@@ -1280,13 +1281,13 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 
 	d.Partial(false)
 
-	return resourceTencentCloudScfFunctionRead(d, m)
+	return resourceTencentCloudScfFunctionRead(ctx, d, m)
 }
 
-func resourceTencentCloudScfFunctionDelete(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudScfFunctionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_function.delete")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ScfService{client: m.(*TencentCloudClient).apiV3Conn}
 
@@ -1298,5 +1299,5 @@ func resourceTencentCloudScfFunctionDelete(d *schema.ResourceData, m interface{}
 	}
 	namespace, name := split[0], split[1]
 
-	return service.DeleteFunction(ctx, name, namespace)
+	return diag.FromErr(service.DeleteFunction(ctx, name, namespace))
 }
@@ -51,10 +51,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
@@ -63,12 +63,16 @@ import (
 
 func resourceTencentCloudScfFunctionAlias() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudScfFunctionAliasCreate,
-		Read:   resourceTencentCloudScfFunctionAliasRead,
-		Update: resourceTencentCloudScfFunctionAliasUpdate,
-		Delete: resourceTencentCloudScfFunctionAliasDelete,
+		CreateContext: resourceTencentCloudScfFunctionAliasCreate,
+		ReadContext:   resourceTencentCloudScfFunctionAliasRead,
+		UpdateContext: resourceTencentCloudScfFunctionAliasUpdate,
+		DeleteContext: resourceTencentCloudScfFunctionAliasDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -163,7 +167,7 @@ func resourceTencentCloudScfFunctionAlias() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudScfFunctionAliasCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfFunctionAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_function_alias.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -235,8 +239,8 @@ func resourceTencentCloudScfFunctionAliasCreate(d *schema.ResourceData, meta int
 		request.Description = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().CreateAlias(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().CreateAliasWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -246,27 +250,27 @@ func resourceTencentCloudScfFunctionAliasCreate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create scf FunctionAlias failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(namespace + FILED_SP + functionName + FILED_SP + name)
+	d.SetId(helper.IdFormat(namespace, functionName, name))
 
-	return resourceTencentCloudScfFunctionAliasRead(d, meta)
+	return resourceTencentCloudScfFunctionAliasRead(ctx, d, meta)
 }
 
-func resourceTencentCloudScfFunctionAliasRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfFunctionAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_function_alias.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ScfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	namespace := idSplit[0]
 	functionName := idSplit[1]
@@ -274,7 +278,7 @@ func resourceTencentCloudScfFunctionAliasRead(d *schema.ResourceData, meta inter
 
 	functionAlias, err := service.DescribeScfFunctionAliasById(ctx, namespace, functionName, name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if functionAlias == nil {
@@ -352,7 +356,7 @@ func resourceTencentCloudScfFunctionAliasRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudScfFunctionAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfFunctionAliasUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_function_alias.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -360,9 +364,9 @@ func resourceTencentCloudScfFunctionAliasUpdate(d *schema.ResourceData, meta int
 
 	request := scf.NewUpdateAliasRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	namespace := idSplit[0]
 	functionName := idSplit[1]
@@ -426,8 +430,8 @@ func resourceTencentCloudScfFunctionAliasUpdate(d *schema.ResourceData, meta int
 			request.Description = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().UpdateAlias(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().UpdateAliasWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -437,31 +441,31 @@ func resourceTencentCloudScfFunctionAliasUpdate(d *schema.ResourceData, meta int
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update scf FunctionAlias failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudScfFunctionAliasRead(d, meta)
+	return resourceTencentCloudScfFunctionAliasRead(ctx, d, meta)
 }
 
-func resourceTencentCloudScfFunctionAliasDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfFunctionAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_function_alias.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ScfService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	namespace := idSplit[0]
 	functionName := idSplit[1]
 	name := idSplit[2]
 
 	if err := service.DeleteScfFunctionAliasById(ctx, namespace, functionName, name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -28,10 +28,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
@@ -40,12 +40,15 @@ import (
 
 func resourceTencentCloudScfFunctionEventInvokeConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudScfFunctionEventInvokeConfigCreate,
-		Read:   resourceTencentCloudScfFunctionEventInvokeConfigRead,
-		Update: resourceTencentCloudScfFunctionEventInvokeConfigUpdate,
-		Delete: resourceTencentCloudScfFunctionEventInvokeConfigDelete,
+		CreateContext: resourceTencentCloudScfFunctionEventInvokeConfigCreate,
+		ReadContext:   resourceTencentCloudScfFunctionEventInvokeConfigRead,
+		UpdateContext: resourceTencentCloudScfFunctionEventInvokeConfigUpdate,
+		DeleteContext: resourceTencentCloudScfFunctionEventInvokeConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"function_name": {
@@ -93,38 +96,38 @@ func resourceTencentCloudScfFunctionEventInvokeConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudScfFunctionEventInvokeConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfFunctionEventInvokeConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_function_event_invoke_config.create")()
 	defer inconsistentCheck(d, meta)()
 
 	functionName := d.Get("function_name").(string)
 	namespace := d.Get("namespace").(string)
 
-	d.SetId(functionName + FILED_SP + namespace)
+	d.SetId(helper.IdFormat(functionName, namespace))
 
-	return resourceTencentCloudScfFunctionEventInvokeConfigUpdate(d, meta)
+	return resourceTencentCloudScfFunctionEventInvokeConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudScfFunctionEventInvokeConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfFunctionEventInvokeConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_function_event_invoke_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ScfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	functionName := idSplit[0]
 	namespace := idSplit[1]
 
 	FunctionEventInvokeConfig, err := service.DescribeScfFunctionEventInvokeConfigById(ctx, namespace, functionName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if FunctionEventInvokeConfig == nil {
@@ -165,7 +168,7 @@ func resourceTencentCloudScfFunctionEventInvokeConfigRead(d *schema.ResourceData
 	return nil
 }
 
-func resourceTencentCloudScfFunctionEventInvokeConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfFunctionEventInvokeConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_function_event_invoke_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -173,9 +176,9 @@ func resourceTencentCloudScfFunctionEventInvokeConfigUpdate(d *schema.ResourceDa
 
 	request := scf.NewUpdateFunctionEventInvokeConfigRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	functionName := idSplit[0]
 	namespace := idSplit[1]
@@ -201,8 +204,8 @@ func resourceTencentCloudScfFunctionEventInvokeConfigUpdate(d *schema.ResourceDa
 		request.AsyncTriggerConfig = &asyncTriggerConfig
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().UpdateFunctionEventInvokeConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().UpdateFunctionEventInvokeConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -212,13 +215,13 @@ func resourceTencentCloudScfFunctionEventInvokeConfigUpdate(d *schema.ResourceDa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update scf FunctionEventInvokeConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudScfFunctionEventInvokeConfigRead(d, meta)
+	return resourceTencentCloudScfFunctionEventInvokeConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudScfFunctionEventInvokeConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfFunctionEventInvokeConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_function_event_invoke_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
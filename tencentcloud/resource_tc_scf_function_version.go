@@ -24,10 +24,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
@@ -36,11 +36,14 @@ import (
 
 func resourceTencentCloudScfFunctionVersion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudScfFunctionVersionCreate,
-		Read:   resourceTencentCloudScfFunctionVersionRead,
-		Delete: resourceTencentCloudScfFunctionVersionDelete,
+		CreateContext: resourceTencentCloudScfFunctionVersionCreate,
+		ReadContext:   resourceTencentCloudScfFunctionVersionRead,
+		DeleteContext: resourceTencentCloudScfFunctionVersionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"function_name": {
@@ -73,7 +76,7 @@ func resourceTencentCloudScfFunctionVersion() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudScfFunctionVersionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfFunctionVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_function_version.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -100,8 +103,8 @@ func resourceTencentCloudScfFunctionVersionCreate(d *schema.ResourceData, meta i
 		request.Namespace = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().PublishVersion(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().PublishVersionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -112,36 +115,36 @@ func resourceTencentCloudScfFunctionVersionCreate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create scf FunctionVersion failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	functionVersion = *response.Response.FunctionVersion
-	d.SetId(functionName + FILED_SP + namespace + FILED_SP + functionVersion)
+	d.SetId(helper.IdFormat(functionName, namespace, functionVersion))
 
 	// wait ready
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	err = waitScfFunctionReady(ctx, functionName, namespace, client.UseScfClient())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudScfFunctionVersionRead(d, meta)
+	return resourceTencentCloudScfFunctionVersionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudScfFunctionVersionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfFunctionVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_function_version.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ScfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	functionName := idSplit[0]
 	namespace := idSplit[1]
@@ -149,7 +152,7 @@ func resourceTencentCloudScfFunctionVersionRead(d *schema.ResourceData, meta int
 
 	version, err := service.DescribeScfFunctionVersionById(ctx, functionName, namespace, functionVersion)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if version == nil {
@@ -177,24 +180,24 @@ func resourceTencentCloudScfFunctionVersionRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudScfFunctionVersionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfFunctionVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_function_version.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ScfService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	functionName := idSplit[0]
 	namespace := idSplit[1]
 	functionVersion := idSplit[2]
 
 	if err := service.DeleteScfFunctionVersionById(ctx, functionName, namespace, functionVersion); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
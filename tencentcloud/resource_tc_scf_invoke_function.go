@@ -15,8 +15,11 @@ resource "tencentcloud_scf_invoke_function" "invoke_function" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
@@ -25,9 +28,12 @@ import (
 
 func resourceTencentCloudScfInvokeFunction() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudScfInvokeFunctionCreate,
-		Read:   resourceTencentCloudScfInvokeFunctionRead,
-		Delete: resourceTencentCloudScfInvokeFunctionDelete,
+		CreateContext: resourceTencentCloudScfInvokeFunctionCreate,
+		ReadContext:   resourceTencentCloudScfInvokeFunctionRead,
+		DeleteContext: resourceTencentCloudScfInvokeFunctionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"function_name": {
 				Required:    true,
@@ -81,7 +87,7 @@ func resourceTencentCloudScfInvokeFunction() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudScfInvokeFunctionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfInvokeFunctionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_invoke_function.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -119,8 +125,8 @@ func resourceTencentCloudScfInvokeFunctionCreate(d *schema.ResourceData, meta in
 		request.RoutingKey = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().Invoke(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().InvokeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -131,24 +137,24 @@ func resourceTencentCloudScfInvokeFunctionCreate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate scf InvokeFunction failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	functionRequestId := *response.Response.Result.FunctionRequestId
 
 	d.SetId(functionRequestId)
 
-	return resourceTencentCloudScfInvokeFunctionRead(d, meta)
+	return resourceTencentCloudScfInvokeFunctionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudScfInvokeFunctionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfInvokeFunctionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_invoke_function.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudScfInvokeFunctionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfInvokeFunctionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_invoke_function.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -28,15 +28,16 @@ $ terraform import tencentcloud_scf_layer.layer layerId#layerVersion
 package tencentcloud
 
 import (
+	"context"
 	"encoding/base64"
-	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
-	"strings"
+	"time"
 
 	"github.com/mitchellh/go-homedir"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
@@ -74,12 +75,16 @@ func LayerContent() map[string]*schema.Schema {
 
 func resourceTencentCloudScfLayer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudScfLayerCreate,
-		Read:   resourceTencentCloudScfLayerRead,
-		Update: resourceTencentCloudScfLayerUpdate,
-		Delete: resourceTencentCloudScfLayerDelete,
+		CreateContext: resourceTencentCloudScfLayerCreate,
+		ReadContext:   resourceTencentCloudScfLayerRead,
+		UpdateContext: resourceTencentCloudScfLayerUpdate,
+		DeleteContext: resourceTencentCloudScfLayerDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -147,7 +152,7 @@ func resourceTencentCloudScfLayer() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudScfLayerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfLayerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_layer.create")()
 	logId := getLogId(contextNil)
 	var (
@@ -173,7 +178,7 @@ func resourceTencentCloudScfLayerCreate(d *schema.ResourceData, meta interface{}
 	if v, ok := d.GetOk("content"); ok {
 		items := v.([]interface{})
 		if len(items) != 1 {
-			return fmt.Errorf("need only one content.")
+			return diag.Errorf("need only one content.")
 		}
 		item := items[0].(map[string]interface{})
 		var content = scf.Code{}
@@ -189,16 +194,16 @@ func resourceTencentCloudScfLayerCreate(d *schema.ResourceData, meta interface{}
 		if item["zip_file"] != "" {
 			path, err := homedir.Expand(item["zip_file"].(string))
 			if err != nil {
-				return fmt.Errorf("zip file (%s) homedir expand error: %s", item["zip_file"].(string), err.Error())
+				return diag.Errorf("zip file (%s) homedir expand error: %s", item["zip_file"].(string), err.Error())
 			}
 			file, err := os.Open(path)
 			if err != nil {
-				return fmt.Errorf("zip file (%s) open error: %s", path, err.Error())
+				return diag.Errorf("zip file (%s) open error: %s", path, err.Error())
 			}
 			defer file.Close()
 			body, err := ioutil.ReadAll(file)
 			if err != nil {
-				return fmt.Errorf("zip file (%s) read error: %s", path, err.Error())
+				return diag.Errorf("zip file (%s) read error: %s", path, err.Error())
 			}
 
 			zipContent := base64.StdEncoding.EncodeToString(body)
@@ -215,9 +220,11 @@ func resourceTencentCloudScfLayerCreate(d *schema.ResourceData, meta interface{}
 		request.LicenseInfo = helper.String(v.(string))
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := scfService.client.UseScfClient().PublishLayerVersion(request)
+	if err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := scfService.client.UseScfClient().PublishLayerVersionWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -229,18 +236,18 @@ func resourceTencentCloudScfLayerCreate(d *schema.ResourceData, meta interface{}
 		layerVersion = response.Response.LayerVersion
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(*layerName + FILED_SP + helper.Int64ToStr(*layerVersion))
-	return resourceTencentCloudScfLayerRead(d, meta)
+	d.SetId(helper.IdFormat(*layerName, helper.Int64ToStr(*layerVersion)))
+	return resourceTencentCloudScfLayerRead(ctx, d, meta)
 }
 
-func resourceTencentCloudScfLayerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfLayerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceTencentCloudScfLayerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfLayerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_layer.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -253,9 +260,9 @@ func resourceTencentCloudScfLayerRead(d *schema.ResourceData, meta interface{})
 		layerResponse = scf.GetLayerVersionResponse{}
 	)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("scf layer id is borken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	layerName := idSplit[0]
 	layerVersion := idSplit[1]
@@ -263,9 +270,11 @@ func resourceTencentCloudScfLayerRead(d *schema.ResourceData, meta interface{})
 	layerRequest.LayerName = &layerName
 	layerRequest.LayerVersion = helper.Int64(helper.StrToInt64(layerVersion))
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(layerRequest.GetAction())
-		response, err := scfService.client.UseScfClient().GetLayerVersion(layerRequest)
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, layerRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := scfService.client.UseScfClient().GetLayerVersionWithContext(ctx, layerRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, layerRequest.GetAction(), layerRequest.ToJsonString(), err.Error())
@@ -277,7 +286,7 @@ func resourceTencentCloudScfLayerRead(d *schema.ResourceData, meta interface{})
 		layerResponse = *response
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var errs []error
@@ -300,13 +309,13 @@ func resourceTencentCloudScfLayerRead(d *schema.ResourceData, meta interface{})
 	errs = append(errs, d.Set("compatible_runtimes", runtimes))
 
 	if len(errs) > 0 {
-		return errs[0]
+		return diag.FromErr(errs[0])
 	} else {
 		return nil
 	}
 }
 
-func resourceTencentCloudScfLayerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfLayerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_layer.delete")()
 
 	logId := getLogId(contextNil)
@@ -316,9 +325,9 @@ func resourceTencentCloudScfLayerDelete(d *schema.ResourceData, meta interface{}
 		request    = scf.NewDeleteLayerVersionRequest()
 	)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("scf layer id is borken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	layerName := idSplit[0]
 	layerVersion := idSplit[1]
@@ -326,16 +335,18 @@ func resourceTencentCloudScfLayerDelete(d *schema.ResourceData, meta interface{}
 	request.LayerName = &layerName
 	request.LayerVersion = helper.Int64(helper.StrToInt64(layerVersion))
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		if _, err := scfService.client.UseScfClient().DeleteLayerVersion(request); err != nil {
+	if err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if _, err := scfService.client.UseScfClient().DeleteLayerVersionWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -23,17 +23,18 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTencentCloudScfNamespace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudScfNamespaceCreate,
-		Read:   resourceTencentCloudScfNamespaceRead,
-		Update: resourceTencentCloudScfNamespaceUpdate,
-		Delete: resourceTencentCloudScfNamespaceDelete,
+		CreateContext: resourceTencentCloudScfNamespaceCreate,
+		ReadContext:   resourceTencentCloudScfNamespaceRead,
+		UpdateContext: resourceTencentCloudScfNamespaceUpdate,
+		DeleteContext: resourceTencentCloudScfNamespaceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -70,10 +71,10 @@ func resourceTencentCloudScfNamespace() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudScfNamespaceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudScfNamespaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_namespace.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ScfService{client: m.(*TencentCloudClient).apiV3Conn}
 
@@ -82,20 +83,20 @@ func resourceTencentCloudScfNamespaceCreate(d *schema.ResourceData, m interface{
 
 	if err := service.CreateNamespace(ctx, namespace, desc); err != nil {
 		log.Printf("[CRITAL]%s create namespace failed: %+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(namespace)
 
-	return resourceTencentCloudScfNamespaceRead(d, m)
+	return resourceTencentCloudScfNamespaceRead(ctx, d, m)
 }
 
-func resourceTencentCloudScfNamespaceRead(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudScfNamespaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_namespace.read")()
 	defer inconsistentCheck(d, m)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ScfService{client: m.(*TencentCloudClient).apiV3Conn}
 
@@ -104,7 +105,7 @@ func resourceTencentCloudScfNamespaceRead(d *schema.ResourceData, m interface{})
 	namespace, err := service.DescribeNamespace(ctx, id)
 	if err != nil {
 		log.Printf("[CRITAL]%s read namespace failed: %+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if namespace == nil {
@@ -121,27 +122,27 @@ func resourceTencentCloudScfNamespaceRead(d *schema.ResourceData, m interface{})
 	return nil
 }
 
-func resourceTencentCloudScfNamespaceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudScfNamespaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_namespace.update")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ScfService{client: m.(*TencentCloudClient).apiV3Conn}
 
 	if err := service.ModifyNamespace(ctx, d.Id(), d.Get("description").(string)); err != nil {
 		log.Printf("[CRITAL]%s update namespace description failed: %+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudScfNamespaceRead(d, m)
+	return resourceTencentCloudScfNamespaceRead(ctx, d, m)
 }
 
-func resourceTencentCloudScfNamespaceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudScfNamespaceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_namespace.delete")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ScfService{client: m.(*TencentCloudClient).apiV3Conn}
 
-	return service.DeleteNamespace(ctx, d.Id())
+	return diag.FromErr(service.DeleteNamespace(ctx, d.Id()))
 }
@@ -21,16 +21,23 @@ resource "tencentcloud_scf_provisioned_concurrency_config" "provisioned_concurre
   max_capacity                        = 2
 }
 ```
+
+Import
+
+scf provisioned_concurrency_config can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_scf_provisioned_concurrency_config.provisioned_concurrency_config keep-1676351130#2#default
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
@@ -39,9 +46,15 @@ import (
 
 func resourceTencentCloudScfProvisionedConcurrencyConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudScfProvisionedConcurrencyConfigCreate,
-		Read:   resourceTencentCloudScfProvisionedConcurrencyConfigRead,
-		Delete: resourceTencentCloudScfProvisionedConcurrencyConfigDelete,
+		CreateContext: resourceTencentCloudScfProvisionedConcurrencyConfigCreate,
+		ReadContext:   resourceTencentCloudScfProvisionedConcurrencyConfigRead,
+		DeleteContext: resourceTencentCloudScfProvisionedConcurrencyConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"function_name": {
 				Required:    true,
@@ -133,7 +146,7 @@ func resourceTencentCloudScfProvisionedConcurrencyConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudScfProvisionedConcurrencyConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfProvisionedConcurrencyConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_provisioned_concurrency_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -200,8 +213,8 @@ func resourceTencentCloudScfProvisionedConcurrencyConfigCreate(d *schema.Resourc
 		request.MaxCapacity = helper.IntUint64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().PutProvisionedConcurrencyConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().PutProvisionedConcurrencyConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -211,30 +224,30 @@ func resourceTencentCloudScfProvisionedConcurrencyConfigCreate(d *schema.Resourc
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create scf ProvisionedConcurrencyConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(functionName + FILED_SP + qualifier + FILED_SP + namespace)
+	d.SetId(helper.IdFormat(functionName, qualifier, namespace))
 
 	// dirty code
 	time.Sleep(5 * time.Second)
 
-	return resourceTencentCloudScfProvisionedConcurrencyConfigRead(d, meta)
+	return resourceTencentCloudScfProvisionedConcurrencyConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudScfProvisionedConcurrencyConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfProvisionedConcurrencyConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_provisioned_concurrency_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ScfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	functionName := idSplit[0]
 	qualifier := idSplit[1]
@@ -242,7 +255,7 @@ func resourceTencentCloudScfProvisionedConcurrencyConfigRead(d *schema.ResourceD
 
 	provisionedConcurrencyConfig, err := service.DescribeScfProvisionedConcurrencyConfigById(ctx, functionName, qualifier, namespace)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if provisionedConcurrencyConfig == nil {
@@ -290,24 +303,24 @@ func resourceTencentCloudScfProvisionedConcurrencyConfigRead(d *schema.ResourceD
 	return nil
 }
 
-func resourceTencentCloudScfProvisionedConcurrencyConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfProvisionedConcurrencyConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_provisioned_concurrency_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ScfService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	functionName := idSplit[0]
 	qualifier := idSplit[1]
 	namespace := idSplit[2]
 
 	if err := service.DeleteScfProvisionedConcurrencyConfigById(ctx, functionName, qualifier, namespace); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
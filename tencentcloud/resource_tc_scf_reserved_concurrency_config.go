@@ -23,10 +23,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
@@ -35,11 +35,14 @@ import (
 
 func resourceTencentCloudScfReservedConcurrencyConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudScfReservedConcurrencyConfigCreate,
-		Read:   resourceTencentCloudScfReservedConcurrencyConfigRead,
-		Delete: resourceTencentCloudScfReservedConcurrencyConfigDelete,
+		CreateContext: resourceTencentCloudScfReservedConcurrencyConfigCreate,
+		ReadContext:   resourceTencentCloudScfReservedConcurrencyConfigRead,
+		DeleteContext: resourceTencentCloudScfReservedConcurrencyConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"function_name": {
@@ -67,7 +70,7 @@ func resourceTencentCloudScfReservedConcurrencyConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudScfReservedConcurrencyConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfReservedConcurrencyConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_reserved_concurrency_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -92,8 +95,8 @@ func resourceTencentCloudScfReservedConcurrencyConfigCreate(d *schema.ResourceDa
 		request.Namespace = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().PutReservedConcurrencyConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().PutReservedConcurrencyConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -103,34 +106,34 @@ func resourceTencentCloudScfReservedConcurrencyConfigCreate(d *schema.ResourceDa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create scf ReservedConcurrencyConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(namespace + FILED_SP + functionName)
+	d.SetId(helper.IdFormat(namespace, functionName))
 
-	return resourceTencentCloudScfReservedConcurrencyConfigRead(d, meta)
+	return resourceTencentCloudScfReservedConcurrencyConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudScfReservedConcurrencyConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfReservedConcurrencyConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_reserved_concurrency_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ScfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	namespace := idSplit[0]
 	functionName := idSplit[1]
 
 	reservedConcurrencyConfig, err := service.DescribeScfReservedConcurrencyConfigById(ctx, namespace, functionName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if reservedConcurrencyConfig == nil {
@@ -149,23 +152,23 @@ func resourceTencentCloudScfReservedConcurrencyConfigRead(d *schema.ResourceData
 	return nil
 }
 
-func resourceTencentCloudScfReservedConcurrencyConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfReservedConcurrencyConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_reserved_concurrency_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ScfService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	namespace := idSplit[0]
 	functionName := idSplit[1]
 
 	if err := service.DeleteScfReservedConcurrencyConfigById(ctx, namespace, functionName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
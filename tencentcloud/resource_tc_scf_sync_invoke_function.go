@@ -14,8 +14,11 @@ resource "tencentcloud_scf_sync_invoke_function" "invoke_function" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
@@ -24,9 +27,12 @@ import (
 
 func resourceTencentCloudScfSyncInvokeFunction() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudScfSyncInvokeFunctionCreate,
-		Read:   resourceTencentCloudScfSyncInvokeFunctionRead,
-		Delete: resourceTencentCloudScfSyncInvokeFunctionDelete,
+		CreateContext: resourceTencentCloudScfSyncInvokeFunctionCreate,
+		ReadContext:   resourceTencentCloudScfSyncInvokeFunctionRead,
+		DeleteContext: resourceTencentCloudScfSyncInvokeFunctionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"function_name": {
 				Required:    true,
@@ -73,7 +79,7 @@ func resourceTencentCloudScfSyncInvokeFunction() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudScfSyncInvokeFunctionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfSyncInvokeFunctionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_sync_invoke_function.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -108,8 +114,8 @@ func resourceTencentCloudScfSyncInvokeFunctionCreate(d *schema.ResourceData, met
 		request.RoutingKey = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().InvokeFunction(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().InvokeFunctionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -120,23 +126,23 @@ func resourceTencentCloudScfSyncInvokeFunctionCreate(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate scf syncInvokeFunction failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	functionRequestId = *response.Response.Result.FunctionRequestId
 	d.SetId(functionRequestId)
 
-	return resourceTencentCloudScfSyncInvokeFunctionRead(d, meta)
+	return resourceTencentCloudScfSyncInvokeFunctionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudScfSyncInvokeFunctionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfSyncInvokeFunctionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_sync_invoke_function.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudScfSyncInvokeFunctionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfSyncInvokeFunctionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_sync_invoke_function.delete")()
 	defer inconsistentCheck(d, meta)()
 
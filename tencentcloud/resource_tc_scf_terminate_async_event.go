@@ -15,8 +15,11 @@ resource "tencentcloud_scf_terminate_async_event" "terminate_async_event" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
@@ -25,9 +28,12 @@ import (
 
 func resourceTencentCloudScfTerminateAsyncEvent() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudScfTerminateAsyncEventCreate,
-		Read:   resourceTencentCloudScfTerminateAsyncEventRead,
-		Delete: resourceTencentCloudScfTerminateAsyncEventDelete,
+		CreateContext: resourceTencentCloudScfTerminateAsyncEventCreate,
+		ReadContext:   resourceTencentCloudScfTerminateAsyncEventRead,
+		DeleteContext: resourceTencentCloudScfTerminateAsyncEventDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"function_name": {
 				Required:    true,
@@ -60,7 +66,7 @@ func resourceTencentCloudScfTerminateAsyncEvent() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudScfTerminateAsyncEventCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfTerminateAsyncEventCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_terminate_async_event.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -87,8 +93,8 @@ func resourceTencentCloudScfTerminateAsyncEventCreate(d *schema.ResourceData, me
 		request.GraceShutdown = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().TerminateAsyncEvent(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().TerminateAsyncEventWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -98,22 +104,22 @@ func resourceTencentCloudScfTerminateAsyncEventCreate(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate scf terminateAsyncEvent failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(invokeRequestId)
 
-	return resourceTencentCloudScfTerminateAsyncEventRead(d, meta)
+	return resourceTencentCloudScfTerminateAsyncEventRead(ctx, d, meta)
 }
 
-func resourceTencentCloudScfTerminateAsyncEventRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfTerminateAsyncEventRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_terminate_async_event.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudScfTerminateAsyncEventDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfTerminateAsyncEventDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_terminate_async_event.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -32,10 +32,10 @@ package tencentcloud
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
@@ -44,12 +44,16 @@ import (
 
 func resourceTencentCloudScfTriggerConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudScfTriggerConfigCreate,
-		Read:   resourceTencentCloudScfTriggerConfigRead,
-		Update: resourceTencentCloudScfTriggerConfigUpdate,
-		Delete: resourceTencentCloudScfTriggerConfigDelete,
+		CreateContext: resourceTencentCloudScfTriggerConfigCreate,
+		ReadContext:   resourceTencentCloudScfTriggerConfigRead,
+		UpdateContext: resourceTencentCloudScfTriggerConfigUpdate,
+		DeleteContext: resourceTencentCloudScfTriggerConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"function_name": {
@@ -118,7 +122,7 @@ type TriggerDesc struct {
 	Cron string `json:"cron"`
 }
 
-func resourceTencentCloudScfTriggerConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfTriggerConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_trigger_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -170,8 +174,8 @@ func resourceTencentCloudScfTriggerConfigCreate(d *schema.ResourceData, meta int
 		request.CustomArgument = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().UpdateTrigger(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().UpdateTriggerWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -181,27 +185,27 @@ func resourceTencentCloudScfTriggerConfigCreate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate scf triggerConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(functionName + FILED_SP + namespace + FILED_SP + triggerName)
+	d.SetId(helper.IdFormat(functionName, namespace, triggerName))
 
-	return resourceTencentCloudScfTriggerConfigUpdate(d, meta)
+	return resourceTencentCloudScfTriggerConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudScfTriggerConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfTriggerConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_trigger_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := ScfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	functionName := idSplit[0]
 	namespace := idSplit[1]
@@ -209,7 +213,7 @@ func resourceTencentCloudScfTriggerConfigRead(d *schema.ResourceData, meta inter
 
 	triggerConfig, err := service.DescribeScfTriggerConfigById(ctx, functionName, namespace, triggerName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if triggerConfig == nil {
@@ -246,7 +250,7 @@ func resourceTencentCloudScfTriggerConfigRead(d *schema.ResourceData, meta inter
 		var triggerDesc TriggerDesc
 		err := json.Unmarshal([]byte(*triggerConfig.TriggerDesc), &triggerDesc)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		_ = d.Set("trigger_desc", triggerDesc.Cron)
 	}
@@ -262,7 +266,7 @@ func resourceTencentCloudScfTriggerConfigRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudScfTriggerConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfTriggerConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_trigger_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -270,9 +274,9 @@ func resourceTencentCloudScfTriggerConfigUpdate(d *schema.ResourceData, meta int
 
 	request := scf.NewUpdateTriggerRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	functionName := idSplit[0]
 	namespace := idSplit[1]
@@ -306,8 +310,8 @@ func resourceTencentCloudScfTriggerConfigUpdate(d *schema.ResourceData, meta int
 		request.CustomArgument = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().UpdateTrigger(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseScfClient().UpdateTriggerWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -317,13 +321,13 @@ func resourceTencentCloudScfTriggerConfigUpdate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update scf triggerConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudScfTriggerConfigRead(d, meta)
+	return resourceTencentCloudScfTriggerConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudScfTriggerConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudScfTriggerConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_scf_trigger_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -26,6 +26,41 @@ resource "tencentcloud_security_group" "example" {
 }
 ```
 
+Create a security group with inline policies
+
+The security group and its policies are created atomically, so that a failed apply never leaves an empty or open group.
+
+```hcl
+resource "tencentcloud_security_group" "example" {
+  name        = "tf-example-sg"
+  description = "sg test"
+
+  ingress {
+    action      = "ACCEPT"
+    cidr_block  = "10.0.0.0/16"
+    protocol    = "TCP"
+    port        = "80,443"
+    description = "web"
+  }
+
+  ingress {
+    action     = "DROP"
+    cidr_block = "0.0.0.0/0"
+    protocol   = "ALL"
+    port       = "ALL"
+  }
+
+  egress {
+    action     = "ACCEPT"
+    cidr_block = "0.0.0.0/0"
+    protocol   = "ALL"
+    port       = "ALL"
+  }
+}
+```
+
+~> **NOTE:** The inline policies are only managed when `ingress` or `egress` is set, then the policies of a direction which is not set are cleared. Removing both of them from the config leaves the policies of the group as they are. They can not be used together with `tencentcloud_security_group_rule_set` or `tencentcloud_security_group_lite_rule` of the same group, the policies changed by another resource during an apply are reported as a conflict.
+
 Import
 
 Security group can be imported using the id, e.g.
@@ -41,22 +76,29 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSecurityGroupCreate,
-		Read:   resourceTencentCloudSecurityGroupRead,
-		Update: resourceTencentCloudSecurityGroupUpdate,
-		Delete: resourceTencentCloudSecurityGroupDelete,
+		CreateContext: resourceTencentCloudSecurityGroupCreate,
+		ReadContext:   resourceTencentCloudSecurityGroupRead,
+		UpdateContext: resourceTencentCloudSecurityGroupUpdate,
+		DeleteContext: resourceTencentCloudSecurityGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceTencentCloudSecurityGroupPoliciesCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -84,15 +126,34 @@ func resourceTencentCloudSecurityGroup() *schema.Resource {
 				Optional:    true,
 				Description: "Tags of the security group.",
 			},
+			"ingress": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Inline ingress policies of the security group, which are created together with the group. NOTE: this block is ordered, the first policy has the highest priority.",
+				Elem:        &schema.Resource{Schema: securityGroupPolicyElem()},
+			},
+			"egress": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Inline egress policies of the security group, which are created together with the group. NOTE: this block is ordered, the first policy has the highest priority.",
+				Elem:        &schema.Resource{Schema: securityGroupPolicyElem()},
+			},
+			"policy_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the policies of the security group, which increases on every change of them.",
+			},
 		},
 	}
 }
 
-func resourceTencentCloudSecurityGroupCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_security_group.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := m.(*TencentCloudClient).apiV3Conn
 	vpcService := VpcService{client: client}
@@ -114,9 +175,31 @@ func resourceTencentCloudSecurityGroupCreate(d *schema.ResourceData, m interface
 		tags = temp
 	}
 
-	id, err := vpcService.CreateSecurityGroup(ctx, name, desc, projectId, tags)
+	var (
+		id  string
+		err error
+	)
+	ingress, ingressOk := d.GetOk("ingress")
+	egress, egressOk := d.GetOk("egress")
+	if ingressOk || egressOk {
+		policySet := &vpc.SecurityGroupPolicySet{}
+		if ingressOk {
+			if policySet.Ingress, err = unmarshalSecurityPolicy(ingress.([]interface{})); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if egressOk {
+			if policySet.Egress, err = unmarshalSecurityPolicy(egress.([]interface{})); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		// the tags are not taken by CreateSecurityGroupWithPolicies, they are set below
+		id, err = vpcService.CreateSecurityGroupWithPolicies(ctx, name, desc, projectId, policySet)
+	} else {
+		id, err = vpcService.CreateSecurityGroup(ctx, name, desc, projectId, tags)
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
@@ -124,18 +207,18 @@ func resourceTencentCloudSecurityGroupCreate(d *schema.ResourceData, m interface
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		resourceName := BuildTagResourceName("cvm", "sg", region, id)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudSecurityGroupRead(d, m)
+	return resourceTencentCloudSecurityGroupRead(ctx, d, m)
 }
 
-func resourceTencentCloudSecurityGroupRead(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudSecurityGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_security_group.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := m.(*TencentCloudClient).apiV3Conn
 	vpcService := VpcService{client: client}
@@ -146,7 +229,7 @@ func resourceTencentCloudSecurityGroupRead(d *schema.ResourceData, m interface{}
 
 	securityGroup, err := vpcService.DescribeSecurityGroup(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if securityGroup == nil {
@@ -159,24 +242,34 @@ func resourceTencentCloudSecurityGroupRead(d *schema.ResourceData, m interface{}
 
 	projectId, err := strconv.Atoi(*securityGroup.ProjectId)
 	if err != nil {
-		return fmt.Errorf("securtiy group %s project id invalid: %v", *securityGroup.SecurityGroupId, err)
+		return diag.Errorf("securtiy group %s project id invalid: %v", *securityGroup.SecurityGroupId, err)
 	}
 	_ = d.Set("project_id", projectId)
 
 	tags, err := tagService.DescribeResourceTags(ctx, "cvm", "sg", region, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
+	policySet, err := vpcService.DescribeSecurityGroupPolicies(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if policySet != nil {
+		_ = d.Set("ingress", marshalSecurityPolicy(policySet.Ingress))
+		_ = d.Set("egress", marshalSecurityPolicy(policySet.Egress))
+		_ = d.Set("policy_version", policySet.Version)
+	}
+
 	return nil
 }
 
-func resourceTencentCloudSecurityGroupUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_security_group.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := m.(*TencentCloudClient).apiV3Conn
 	vpcService := VpcService{client: client}
@@ -205,39 +298,57 @@ func resourceTencentCloudSecurityGroupUpdate(d *schema.ResourceData, m interface
 
 	if len(attrUpdate) > 0 {
 		if err := vpcService.ModifySecurityGroup(ctx, id, newName, newDesc); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
+	if d.HasChanges("ingress", "egress") {
+		current, err := checkSecurityGroupPolicyVersion(ctx, &vpcService, id, d.Get("policy_version").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		ingress, err := unmarshalSecurityPolicy(d.Get("ingress").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		egress, err := unmarshalSecurityPolicy(d.Get("egress").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := vpcService.ReplaceSecurityGroupPolicies(ctx, id, ingress, egress, current); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("tags") {
 		oldTags, newTags := d.GetChange("tags")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("cvm", "sg", region, id)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudSecurityGroupRead(d, m)
+	return resourceTencentCloudSecurityGroupRead(ctx, d, m)
 }
 
-func resourceTencentCloudSecurityGroupDelete(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_security_group.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
 	vpcService := VpcService{client: m.(*TencentCloudClient).apiV3Conn}
 
 	// wait until all instances unbind this security group
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		associateSet, err := vpcService.DescribeSecurityGroupsAssociate(ctx, []string{id})
 		if err != nil {
 			return resource.RetryableError(err)
@@ -270,10 +381,10 @@ func resourceTencentCloudSecurityGroupDelete(d *schema.ResourceData, m interface
 
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := vpcService.DeleteSecurityGroup(ctx, id)
 		if e != nil {
 			return resource.RetryableError(fmt.Errorf("security group delete failed: %v", e))
@@ -282,8 +393,48 @@ func resourceTencentCloudSecurityGroupDelete(d *schema.ResourceData, m interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s security group delete failed: %v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
+
+// resourceTencentCloudSecurityGroupPoliciesCustomizeDiff plans the inline policies of a direction which is
+// not in the config to be cleared when the other one is, as the group is created without them.
+func resourceTencentCloudSecurityGroupPoliciesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	inConfig := func(key string) bool {
+		v := config.GetAttr(key)
+		return !v.IsKnown() || (!v.IsNull() && v.LengthInt() > 0)
+	}
+	ingressInConfig, egressInConfig := inConfig("ingress"), inConfig("egress")
+	if ingressInConfig && !egressInConfig && len(d.Get("egress").([]interface{})) > 0 {
+		return d.SetNew("egress", []interface{}{})
+	}
+	if egressInConfig && !ingressInConfig && len(d.Get("ingress").([]interface{})) > 0 {
+		return d.SetNew("ingress", []interface{}{})
+	}
+	return nil
+}
+
+// checkSecurityGroupPolicyVersion returns the policies of the security group, or a conflict error when
+// their version is not the one read by the refresh, which means that they have been changed by another
+// resource during the apply. The check is skipped when version is empty.
+func checkSecurityGroupPolicyVersion(ctx context.Context, service *VpcService, sgId, version string) (*vpc.SecurityGroupPolicySet, error) {
+	current, err := service.DescribeSecurityGroupPolicies(ctx, sgId)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil || version == "" {
+		return current, nil
+	}
+	if currentVersion := helper.PString(current.Version); currentVersion != version {
+		return nil, fmt.Errorf("the policies of security group %s have been changed from version %s to %s by another resource during the apply, "+
+			"the policies of a security group should be managed by only one of the inline `ingress` and `egress` of `tencentcloud_security_group`, "+
+			"`tencentcloud_default_security_group`, `tencentcloud_security_group_rule_set` and `tencentcloud_security_group_lite_rule`", sgId, version, currentVersion)
+	}
+	return current, nil
+}
@@ -45,18 +45,19 @@ package tencentcloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudSecurityGroupLiteRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSecurityGroupLiteRuleCreate,
-		Read:   resourceTencentCloudSecurityGroupLiteRuleRead,
-		Update: resourceTencentCloudSecurityGroupLiteRuleUpdate,
-		Delete: resourceTencentCloudSecurityGroupLiteRuleDelete,
+		CreateContext: resourceTencentCloudSecurityGroupLiteRuleCreate,
+		ReadContext:   resourceTencentCloudSecurityGroupLiteRuleRead,
+		UpdateContext: resourceTencentCloudSecurityGroupLiteRuleUpdate,
+		DeleteContext: resourceTencentCloudSecurityGroupLiteRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -82,10 +83,10 @@ func resourceTencentCloudSecurityGroupLiteRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSecurityGroupLiteRuleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudSecurityGroupLiteRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_security_group_lite_rule.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: m.(*TencentCloudClient).apiV3Conn}
 
@@ -101,7 +102,7 @@ func resourceTencentCloudSecurityGroupLiteRuleCreate(d *schema.ResourceData, m i
 		for _, ingressStr := range ingressStrs {
 			liteRule, err := parseRule(ingressStr)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			ingress = append(ingress, liteRule)
 		}
@@ -112,27 +113,27 @@ func resourceTencentCloudSecurityGroupLiteRuleCreate(d *schema.ResourceData, m i
 		for _, egressStr := range egressStrs {
 			liteRule, err := parseRule(egressStr)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			egress = append(egress, liteRule)
 		}
 	}
 
 	if err := service.AttachLiteRulesToSecurityGroup(ctx, sgId, ingress, egress); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(sgId)
 
-	return resourceTencentCloudSecurityGroupLiteRuleRead(d, m)
+	return resourceTencentCloudSecurityGroupLiteRuleRead(ctx, d, m)
 }
 
-func resourceTencentCloudSecurityGroupLiteRuleRead(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudSecurityGroupLiteRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_security_group_lite_rule.read")()
 	defer inconsistentCheck(d, m)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -140,7 +141,7 @@ func resourceTencentCloudSecurityGroupLiteRuleRead(d *schema.ResourceData, m int
 
 	respIngress, respEgress, exist, err := service.DescribeSecurityGroupPolices(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !exist {
@@ -165,10 +166,10 @@ func resourceTencentCloudSecurityGroupLiteRuleRead(d *schema.ResourceData, m int
 	return nil
 }
 
-func resourceTencentCloudSecurityGroupLiteRuleUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudSecurityGroupLiteRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_security_group_lite_rule.update")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -187,7 +188,7 @@ func resourceTencentCloudSecurityGroupLiteRuleUpdate(d *schema.ResourceData, m i
 			for _, ingressStr := range ingressStrs {
 				liteRule, err := parseRule(ingressStr)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				ingress = append(ingress, liteRule)
 			}
@@ -197,7 +198,7 @@ func resourceTencentCloudSecurityGroupLiteRuleUpdate(d *schema.ResourceData, m i
 			for _, ingressStr := range ingressStrs {
 				liteRule, err := parseRule(ingressStr)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				ingress = append(ingress, liteRule)
 			}
@@ -212,7 +213,7 @@ func resourceTencentCloudSecurityGroupLiteRuleUpdate(d *schema.ResourceData, m i
 			for _, egressStr := range egressStrs {
 				liteRule, err := parseRule(egressStr)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				egress = append(egress, liteRule)
 			}
@@ -222,7 +223,7 @@ func resourceTencentCloudSecurityGroupLiteRuleUpdate(d *schema.ResourceData, m i
 			for _, egressStr := range egressStrs {
 				liteRule, err := parseRule(egressStr)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				egress = append(egress, liteRule)
 			}
@@ -235,17 +236,17 @@ func resourceTencentCloudSecurityGroupLiteRuleUpdate(d *schema.ResourceData, m i
 
 	if deleteIngress && deleteEgress {
 		if err := service.DetachAllLiteRulesFromSecurityGroup(ctx, id); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		d.Partial(false)
 
-		return resourceTencentCloudSecurityGroupLiteRuleRead(d, m)
+		return resourceTencentCloudSecurityGroupLiteRuleRead(ctx, d, m)
 	}
 
 	if deleteIngress {
 		if err := service.DeleteLiteRules(ctx, id, ingress, true); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		ingress = nil
@@ -253,7 +254,7 @@ func resourceTencentCloudSecurityGroupLiteRuleUpdate(d *schema.ResourceData, m i
 
 	if deleteEgress {
 		if err := service.DeleteLiteRules(ctx, id, egress, false); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		egress = nil
@@ -262,23 +263,23 @@ func resourceTencentCloudSecurityGroupLiteRuleUpdate(d *schema.ResourceData, m i
 	// if both len == 0, means both rules are deleted
 	if len(ingress) > 0 || len(egress) > 0 {
 		if err := service.modifyLiteRulesInSecurityGroup(ctx, id, ingress, egress); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudSecurityGroupLiteRuleRead(d, m)
+	return resourceTencentCloudSecurityGroupLiteRuleRead(ctx, d, m)
 }
 
-func resourceTencentCloudSecurityGroupLiteRuleDelete(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudSecurityGroupLiteRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_security_group_lite_rule.delete")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
 	service := VpcService{client: m.(*TencentCloudClient).apiV3Conn}
 
-	return service.DetachAllLiteRulesFromSecurityGroup(ctx, id)
+	return diag.FromErr(service.DetachAllLiteRulesFromSecurityGroup(ctx, id))
 }
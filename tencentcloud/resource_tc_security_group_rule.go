@@ -60,7 +60,9 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
@@ -70,9 +72,12 @@ import (
 func resourceTencentCloudSecurityGroupRule() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource will be offline and no longer supported, beacause single security rule is hardly ordered. Please use 'tencentcloud_security_group_lite_rule' instead.",
-		Create:             resourceTencentCloudSecurityGroupRuleCreate,
-		Read:               resourceTencentCloudSecurityGroupRuleRead,
-		Delete:             resourceTencentCloudSecurityGroupRuleDelete,
+		CreateContext:      resourceTencentCloudSecurityGroupRuleCreate,
+		ReadContext:        resourceTencentCloudSecurityGroupRuleRead,
+		DeleteContext:      resourceTencentCloudSecurityGroupRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"security_group_id": {
@@ -225,11 +230,11 @@ func resourceTencentCloudSecurityGroupRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSecurityGroupRuleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_security_group_rule.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: m.(*TencentCloudClient).apiV3Conn}
 
@@ -276,7 +281,7 @@ func resourceTencentCloudSecurityGroupRuleCreate(d *schema.ResourceData, m inter
 		}
 		//complex conflict check
 		if addressTemplateGroupId != nil && *addressTemplateGroupId != "" && addressTemplateId != nil && *addressTemplateId != "" {
-			return fmt.Errorf("`address_template.group_id` conflicts with `address_template.template_id`")
+			return diag.Errorf("`address_template.group_id` conflicts with `address_template.template_id`")
 		}
 	}
 
@@ -291,14 +296,14 @@ func resourceTencentCloudSecurityGroupRuleCreate(d *schema.ResourceData, m inter
 		}
 		//complex conflict check
 		if protocolTemplateGroupId != nil && *protocolTemplateGroupId != "" && protocolTemplateId != nil && *protocolTemplateId != "" {
-			return fmt.Errorf("`protocol_template.group_id` conflicts with `protocol_template.template_id`")
+			return diag.Errorf("`protocol_template.group_id` conflicts with `protocol_template.template_id`")
 		}
 	}
 
 	desc = common.StringPtr(d.Get("description").(string))
 
 	if cidrIp == nil && sourceSgId == nil && addressTemplateId == nil && addressTemplateGroupId == nil {
-		return errors.New("need cidr_ip or source_sgid or address_template")
+		return diag.FromErr(errors.New("need cidr_ip or source_sgid or address_template"))
 	}
 
 	/*
@@ -311,7 +316,7 @@ func resourceTencentCloudSecurityGroupRuleCreate(d *schema.ResourceData, m inter
 
 	if protocol != nil {
 		if strings.ToUpper(*protocol) == "ICMP" && portRange != nil {
-			return errors.New("when ip_protocol is ICMP, can't set port_range")
+			return diag.FromErr(errors.New("when ip_protocol is ICMP, can't set port_range"))
 		}
 	}
 	var policyIndex int64
@@ -340,25 +345,25 @@ func resourceTencentCloudSecurityGroupRuleCreate(d *schema.ResourceData, m inter
 
 	ruleId, err := service.CreateSecurityGroupPolicy(ctx, infoWithPolicyIndex)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(ruleId)
 
-	return resourceTencentCloudSecurityGroupRuleRead(d, m)
+	return resourceTencentCloudSecurityGroupRuleRead(ctx, d, m)
 }
 
-func resourceTencentCloudSecurityGroupRuleRead(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_security_group_rule.read")()
 	defer inconsistentCheck(d, m)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: m.(*TencentCloudClient).apiV3Conn}
 
 	ruleId := d.Id()
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		sgId, policyType, policy, e := service.DescribeSecurityGroupPolicy(ctx, ruleId)
 		if e != nil {
 			return retryError(e)
@@ -432,16 +437,16 @@ func resourceTencentCloudSecurityGroupRuleRead(d *schema.ResourceData, m interfa
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudSecurityGroupRuleDelete(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_security_group_rule.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: m.(*TencentCloudClient).apiV3Conn}
 
@@ -449,15 +454,15 @@ func resourceTencentCloudSecurityGroupRuleDelete(d *schema.ResourceData, m inter
 	sgId, policyType, policy, err := service.DescribeSecurityGroupPolicy(ctx, ruleId)
 	if err != nil {
 		log.Printf("[CRITAL]%s security group rule query failed: %s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if policy == nil {
-		return fmt.Errorf("The security group policy(ruleId: %s) is nil.", ruleId)
+		return diag.Errorf("The security group policy(ruleId: %s) is nil.", ruleId)
 	}
 
 	index := *policy.PolicyIndex
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := service.DeleteSecurityGroupPolicyByPolicyIndex(ctx, index, sgId, policyType)
 		if e != nil {
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
@@ -487,7 +492,7 @@ func resourceTencentCloudSecurityGroupRuleDelete(d *schema.ResourceData, m inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s security group rule delete failed: %s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -1,7 +1,7 @@
 /*
 Provides a resource to create security group rule. This resource is similar with tencentcloud_security_group_lite_rule, rules can be ordered and configure descriptions.
 
-~> **NOTE:** This resource must exclusive in one security group, do not declare additional rule resources of this security group elsewhere.
+~> **NOTE:** This resource must exclusive in one security group, do not declare additional rule resources or the inline `ingress` and `egress` of `tencentcloud_security_group` of this security group elsewhere.
 
 Example Usage
 
@@ -110,82 +110,19 @@ import (
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTencentCloudSecurityGroupRuleSet() *schema.Resource {
-	ruleElem := map[string]*schema.Schema{
-		"action": {
-			Type:         schema.TypeString,
-			Required:     true,
-			ValidateFunc: validateAllowedStringValueIgnoreCase([]string{"ACCEPT", "DROP"}),
-			Description:  "Rule policy of security group. Valid values: `ACCEPT` and `DROP`.",
-		},
-		"description": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Description of the security group rule.",
-		},
-		"cidr_block": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "An IP address network or CIDR segment. NOTE: `cidr_block`, `ipv6_cidr_block`, `source_security_id` and `address_template_*` are exclusive and cannot be set in the same time.",
-		},
-		"ipv6_cidr_block": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "An IPV6 address network or CIDR segment, and conflict with `source_security_id` and `address_template_*`.",
-		},
-		"source_security_id": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "ID of the nested security group, and conflicts with `cidr_block` and `address_template_*`.",
-		},
-		"address_template_id": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Specify Address template ID like `ipm-xxxxxxxx`, conflict with `source_security_id` and `cidr_block`.",
-		},
-		"address_template_group": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Specify Group ID of Address template like `ipmg-xxxxxxxx`, conflict with `source_security_id` and `cidr_block`.",
-		},
-		"service_template_id": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Specify Protocol template ID like `ppm-xxxxxxxx`, conflict with `cidr_block` and `port`.",
-		},
-		"service_template_group": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Specify Group ID of Protocol template ID like `ppmg-xxxxxxxx`, conflict with `cidr_block` and `port`.",
-		},
-		"protocol": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Computed:    true,
-			Description: "Type of IP protocol. Valid values: `TCP`, `UDP` and `ICMP`. Default to all types protocol, and conflicts with `service_template_*`.",
-		},
-		"port": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Computed:    true,
-			Description: "Range of the port. The available value can be one, multiple or one segment. E.g. `80`, `80,90` and `80-90`. Default to all ports, and conflicts with `service_template_*`.",
-		},
-		"policy_index": {
-			Type:        schema.TypeInt,
-			Computed:    true,
-			Description: "The security group rule index number, whose value dynamically changes with changes in security group rules.",
-		},
-	}
+	ruleElem := securityGroupPolicyElem()
 	return &schema.Resource{
-		Create: resourceTencentCloudSecurityGroupRuleSetCreate,
-		Read:   resourceTencentCloudSecurityGroupRuleSetRead,
-		Update: resourceTencentCloudSecurityGroupRuleSetUpdate,
-		Delete: resourceTencentCloudSecurityGroupRuleSetDelete,
+		CreateContext: resourceTencentCloudSecurityGroupRuleSetCreate,
+		ReadContext:   resourceTencentCloudSecurityGroupRuleSetRead,
+		UpdateContext: resourceTencentCloudSecurityGroupRuleSetUpdate,
+		DeleteContext: resourceTencentCloudSecurityGroupRuleSetDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"security_group_id": {
@@ -215,17 +152,17 @@ func resourceTencentCloudSecurityGroupRuleSet() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSecurityGroupRuleSetCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudSecurityGroupRuleSetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_security_group_rule_set.create")()
 
 	var (
 		logId           = getLogId(contextNil)
-		ctx             = context.WithValue(context.TODO(), logIdKey, logId)
 		service         = VpcService{client: m.(*TencentCloudClient).apiV3Conn}
 		request         = vpc.NewModifySecurityGroupPoliciesRequest()
 		securityGroupId string
 		err             error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("security_group_id"); ok {
 		request.SecurityGroupId = helper.String(v.(string))
@@ -238,7 +175,7 @@ func resourceTencentCloudSecurityGroupRuleSetCreate(d *schema.ResourceData, m in
 		ingressRules := v.([]interface{})
 		request.SecurityGroupPolicySet.Ingress, err = unmarshalSecurityPolicy(ingressRules)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -246,41 +183,41 @@ func resourceTencentCloudSecurityGroupRuleSetCreate(d *schema.ResourceData, m in
 		egressRules := v.([]interface{})
 		request.SecurityGroupPolicySet.Egress, err = unmarshalSecurityPolicy(egressRules)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	err = service.ModifySecurityGroupPolicies(ctx, request)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(securityGroupId)
-	return resourceTencentCloudSecurityGroupRuleSetRead(d, m)
+	return resourceTencentCloudSecurityGroupRuleSetRead(ctx, d, m)
 }
 
-func resourceTencentCloudSecurityGroupRuleSetRead(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudSecurityGroupRuleSetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_security_group_rule_set.read")()
 	defer inconsistentCheck(d, m)()
 
 	var (
 		logId           = getLogId(contextNil)
-		ctx             = context.WithValue(context.TODO(), logIdKey, logId)
 		service         = VpcService{client: m.(*TencentCloudClient).apiV3Conn}
 		securityGroupId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := vpc.NewDescribeSecurityGroupPoliciesRequest()
 	request.SecurityGroupId = &securityGroupId
 
 	result, err := service.DescribeSecurityGroupPolicies(ctx, securityGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if result == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `tencentcloud_security_group_rule_set` %s does not exist", d.Id())
+		return diag.Errorf("resource `tencentcloud_security_group_rule_set` %s does not exist", d.Id())
 	}
 
 	_ = d.Set("security_group_id", securityGroupId)
@@ -300,12 +237,11 @@ func resourceTencentCloudSecurityGroupRuleSetRead(d *schema.ResourceData, m inte
 	return nil
 }
 
-func resourceTencentCloudSecurityGroupRuleSetUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudSecurityGroupRuleSetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("tencentcloud_security_group_rule_set.update")()
 
 	var (
 		logId           = getLogId(contextNil)
-		ctx             = context.WithValue(context.TODO(), logIdKey, logId)
 		service         = VpcService{client: m.(*TencentCloudClient).apiV3Conn}
 		request         = vpc.NewModifySecurityGroupPoliciesRequest()
 		securityGroupId = d.Id()
@@ -313,6 +249,7 @@ func resourceTencentCloudSecurityGroupRuleSetUpdate(d *schema.ResourceData, m in
 		needChange      bool
 		err             error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	mutableArgs := []string{"ingress", "egress"}
 
@@ -324,6 +261,10 @@ func resourceTencentCloudSecurityGroupRuleSetUpdate(d *schema.ResourceData, m in
 	}
 
 	if needChange {
+		if _, err := checkSecurityGroupPolicyVersion(ctx, &service, securityGroupId, d.Get("version").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+
 		version := d.Get("version").(string)
 		ver, _ := strconv.ParseInt(version, 10, 64)
 		ver += 1
@@ -345,7 +286,7 @@ func resourceTencentCloudSecurityGroupRuleSetUpdate(d *schema.ResourceData, m in
 		} else if len(newIngressList) != 0 && len(newEgressList) == 0 {
 			request.SecurityGroupPolicySet.Ingress, err = unmarshalSecurityPolicy(newIngressList)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			if len(oldEgressList) > 0 {
@@ -358,7 +299,7 @@ func resourceTencentCloudSecurityGroupRuleSetUpdate(d *schema.ResourceData, m in
 
 				e := service.DeleteSecurityGroupPolicyByPolicyIndexList(ctx, securityGroupId, tmpList, "egress")
 				if e != nil {
-					return e
+					return diag.FromErr(e)
 				}
 
 				ver += 1
@@ -367,7 +308,7 @@ func resourceTencentCloudSecurityGroupRuleSetUpdate(d *schema.ResourceData, m in
 		} else if len(newIngressList) == 0 && len(newEgressList) != 0 {
 			request.SecurityGroupPolicySet.Egress, err = unmarshalSecurityPolicy(newEgressList)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			if len(oldIngressList) > 0 {
@@ -380,7 +321,7 @@ func resourceTencentCloudSecurityGroupRuleSetUpdate(d *schema.ResourceData, m in
 
 				e := service.DeleteSecurityGroupPolicyByPolicyIndexList(ctx, securityGroupId, tmpList, "ingress")
 				if e != nil {
-					return e
+					return diag.FromErr(e)
 				}
 
 				ver += 1
@@ -389,12 +330,12 @@ func resourceTencentCloudSecurityGroupRuleSetUpdate(d *schema.ResourceData, m in
 		} else {
 			request.SecurityGroupPolicySet.Ingress, err = unmarshalSecurityPolicy(newIngressList)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			request.SecurityGroupPolicySet.Egress, err = unmarshalSecurityPolicy(newEgressList)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
@@ -402,23 +343,23 @@ func resourceTencentCloudSecurityGroupRuleSetUpdate(d *schema.ResourceData, m in
 		request.SecurityGroupPolicySet.Version = helper.String(nextVer)
 		err = service.ModifySecurityGroupPolicies(ctx, request)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudSecurityGroupRuleSetRead(d, m)
+	return resourceTencentCloudSecurityGroupRuleSetRead(ctx, d, m)
 }
 
-func resourceTencentCloudSecurityGroupRuleSetDelete(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudSecurityGroupRuleSetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_security_group_rule_set.delete")()
 
 	var (
 		logId           = getLogId(contextNil)
-		ctx             = context.WithValue(context.TODO(), logIdKey, logId)
 		service         = VpcService{client: m.(*TencentCloudClient).apiV3Conn}
 		request         = vpc.NewModifySecurityGroupPoliciesRequest()
 		securityGroupId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request.SecurityGroupId = &securityGroupId
 	request.SecurityGroupPolicySet = &vpc.SecurityGroupPolicySet{
@@ -430,12 +371,81 @@ func resourceTencentCloudSecurityGroupRuleSetDelete(d *schema.ResourceData, m in
 	err := service.ModifySecurityGroupPolicies(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s security group rule delete failed: %s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
+// securityGroupPolicyElem is the schema of a policy of the rule set, also used by the inline policies of the security group.
+func securityGroupPolicyElem() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"action": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateAllowedStringValueIgnoreCase([]string{"ACCEPT", "DROP"}),
+			Description:  "Rule policy of security group. Valid values: `ACCEPT` and `DROP`.",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Description of the security group rule.",
+		},
+		"cidr_block": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "An IP address network or CIDR segment. NOTE: `cidr_block`, `ipv6_cidr_block`, `source_security_id` and `address_template_*` are exclusive and cannot be set in the same time.",
+		},
+		"ipv6_cidr_block": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "An IPV6 address network or CIDR segment, and conflict with `source_security_id` and `address_template_*`.",
+		},
+		"source_security_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "ID of the nested security group, and conflicts with `cidr_block` and `address_template_*`.",
+		},
+		"address_template_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specify Address template ID like `ipm-xxxxxxxx`, conflict with `source_security_id` and `cidr_block`.",
+		},
+		"address_template_group": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specify Group ID of Address template like `ipmg-xxxxxxxx`, conflict with `source_security_id` and `cidr_block`.",
+		},
+		"service_template_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specify Protocol template ID like `ppm-xxxxxxxx`, conflict with `cidr_block` and `port`.",
+		},
+		"service_template_group": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specify Group ID of Protocol template ID like `ppmg-xxxxxxxx`, conflict with `cidr_block` and `port`.",
+		},
+		"protocol": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Type of IP protocol. Valid values: `TCP`, `UDP` and `ICMP`. Default to all types protocol, and conflicts with `service_template_*`.",
+		},
+		"port": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Range of the port. The available value can be one, multiple or one segment. E.g. `80`, `80,90` and `80-90`. Default to all ports, and conflicts with `service_template_*`.",
+		},
+		"policy_index": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The security group rule index number, whose value dynamically changes with changes in security group rules.",
+		},
+	}
+}
+
 func unmarshalSecurityPolicy(policies []interface{}) (output []*vpc.SecurityGroupPolicy, err error) {
 	for i := range policies {
 		policy := policies[i].(map[string]interface{})
@@ -544,7 +554,7 @@ func marshalSecurityPolicy(policies []*vpc.SecurityGroupPolicy) []interface{} {
 		if policy.Ipv6CidrBlock != nil {
 			dMap["ipv6_cidr_block"] = policy.Ipv6CidrBlock
 		}
-		if policy.Ipv6CidrBlock != nil {
+		if policy.SecurityGroupId != nil {
 			dMap["source_security_id"] = policy.SecurityGroupId
 		}
 		if policy.AddressTemplate != nil && policy.AddressTemplate.AddressId != nil {
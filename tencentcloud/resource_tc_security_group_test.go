@@ -148,6 +148,44 @@ func TestAccTencentCloudSecurityGroup_tags(t *testing.T) {
 	})
 }
 
+func TestAccTencentCloudSecurityGroup_inlinePolicies(t *testing.T) {
+	t.Parallel()
+	var sgId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSecurityGroupDestroy(&sgId),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityGroupConfigInlinePolicies,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupExists("tencentcloud_security_group.foo", &sgId),
+					resource.TestCheckResourceAttr("tencentcloud_security_group.foo", "ingress.#", "2"),
+					resource.TestCheckResourceAttr("tencentcloud_security_group.foo", "ingress.0.port", "80,443"),
+					resource.TestCheckResourceAttr("tencentcloud_security_group.foo", "ingress.1.action", "DROP"),
+					resource.TestCheckResourceAttr("tencentcloud_security_group.foo", "egress.#", "1"),
+					resource.TestCheckResourceAttrSet("tencentcloud_security_group.foo", "policy_version"),
+				),
+			},
+			{
+				ResourceName:      "tencentcloud_security_group.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSecurityGroupConfigInlinePoliciesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupExists("tencentcloud_security_group.foo", &sgId),
+					resource.TestCheckResourceAttr("tencentcloud_security_group.foo", "ingress.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_security_group.foo", "ingress.0.port", "22"),
+					resource.TestCheckResourceAttr("tencentcloud_security_group.foo", "egress.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckSecurityGroupDestroy(id *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testAccProvider.Meta().(*TencentCloudClient).apiV3Conn
@@ -232,3 +270,44 @@ resource "tencentcloud_security_group" "foo" {
   }
 }
 `
+
+const testAccSecurityGroupConfigInlinePolicies = `
+resource "tencentcloud_security_group" "foo" {
+  name = "ci-temp-test-sg-inline"
+
+  ingress {
+    action      = "ACCEPT"
+    cidr_block  = "10.0.0.0/16"
+    protocol    = "TCP"
+    port        = "80,443"
+    description = "web"
+  }
+
+  ingress {
+    action     = "DROP"
+    cidr_block = "0.0.0.0/0"
+    protocol   = "ALL"
+    port       = "ALL"
+  }
+
+  egress {
+    action     = "ACCEPT"
+    cidr_block = "0.0.0.0/0"
+    protocol   = "ALL"
+    port       = "ALL"
+  }
+}
+`
+
+const testAccSecurityGroupConfigInlinePoliciesUpdate = `
+resource "tencentcloud_security_group" "foo" {
+  name = "ci-temp-test-sg-inline"
+
+  ingress {
+    action     = "ACCEPT"
+    cidr_block = "10.0.0.0/16"
+    protocol   = "TCP"
+    port       = "22"
+  }
+}
+`
@@ -31,8 +31,11 @@ resource "tencentcloud_ses_batch_send_email" "batch_send_email" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ses "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ses/v20201002"
@@ -41,11 +44,14 @@ import (
 
 func resourceTencentCloudSesBatchSendEmail() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSesBatchSendEmailCreate,
-		Read:   resourceTencentCloudSesBatchSendEmailRead,
-		Delete: resourceTencentCloudSesBatchSendEmailDelete,
+		CreateContext: resourceTencentCloudSesBatchSendEmailCreate,
+		ReadContext:   resourceTencentCloudSesBatchSendEmailRead,
+		DeleteContext: resourceTencentCloudSesBatchSendEmailDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"from_email_address": {
@@ -187,7 +193,7 @@ func resourceTencentCloudSesBatchSendEmail() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSesBatchSendEmailCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesBatchSendEmailCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_batch_send_email.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -273,8 +279,8 @@ func resourceTencentCloudSesBatchSendEmailCreate(d *schema.ResourceData, meta in
 		request.ADLocation = helper.IntUint64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().BatchSendEmail(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().BatchSendEmailWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -285,23 +291,23 @@ func resourceTencentCloudSesBatchSendEmailCreate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate ses batchSendEmail failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	taskId = *response.Response.TaskId
 	d.SetId(helper.UInt64ToStr(taskId))
 
-	return resourceTencentCloudSesBatchSendEmailRead(d, meta)
+	return resourceTencentCloudSesBatchSendEmailRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSesBatchSendEmailRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesBatchSendEmailRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_batch_send_email.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudSesBatchSendEmailDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesBatchSendEmailDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_batch_send_email.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -14,8 +14,11 @@ resource "tencentcloud_ses_black_list_delete" "black_list" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ses "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ses/v20201002"
@@ -24,11 +27,14 @@ import (
 
 func resourceTencentCloudSesBlackListDelete() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSesBlackListDeleteCreate,
-		Read:   resourceTencentCloudSesBlackListDeleteRead,
-		Delete: resourceTencentCloudSesBlackListDeleteDelete,
+		CreateContext: resourceTencentCloudSesBlackListDeleteCreate,
+		ReadContext:   resourceTencentCloudSesBlackListDeleteRead,
+		DeleteContext: resourceTencentCloudSesBlackListDeleteDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"email_address": {
@@ -41,7 +47,7 @@ func resourceTencentCloudSesBlackListDelete() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSesBlackListDeleteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesBlackListDeleteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_black_list_delete.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -56,8 +62,8 @@ func resourceTencentCloudSesBlackListDeleteCreate(d *schema.ResourceData, meta i
 		request.EmailAddressList = append(request.EmailAddressList, helper.String(v.(string)))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().DeleteBlackList(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().DeleteBlackListWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -67,22 +73,22 @@ func resourceTencentCloudSesBlackListDeleteCreate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate ses BlackList failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(emailAddress)
 
-	return resourceTencentCloudSesBlackListDeleteRead(d, meta)
+	return resourceTencentCloudSesBlackListDeleteRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSesBlackListDeleteRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesBlackListDeleteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_black_list_delete.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudSesBlackListDeleteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesBlackListDeleteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_black_list_delete.delete")()
 	defer inconsistentCheck(d, meta)()
 
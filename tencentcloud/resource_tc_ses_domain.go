@@ -20,9 +20,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ses "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ses/v20201002"
@@ -31,11 +32,14 @@ import (
 
 func resourceTencentCloudSesDomain() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudSesDomainRead,
-		Create: resourceTencentCloudSesDomainCreate,
-		Delete: resourceTencentCloudSesDomainDelete,
+		ReadContext:   resourceTencentCloudSesDomainRead,
+		CreateContext: resourceTencentCloudSesDomainCreate,
+		DeleteContext: resourceTencentCloudSesDomainDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"email_identity": {
@@ -73,7 +77,7 @@ func resourceTencentCloudSesDomain() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSesDomainCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_domain.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -89,8 +93,8 @@ func resourceTencentCloudSesDomainCreate(d *schema.ResourceData, meta interface{
 		request.EmailIdentity = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().CreateEmailIdentity(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().CreateEmailIdentityWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -102,19 +106,19 @@ func resourceTencentCloudSesDomainCreate(d *schema.ResourceData, meta interface{
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create ses domain failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(emailIdentity)
-	return resourceTencentCloudSesDomainRead(d, meta)
+	return resourceTencentCloudSesDomainRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSesDomainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_domain.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := SesService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -123,12 +127,12 @@ func resourceTencentCloudSesDomainRead(d *schema.ResourceData, meta interface{})
 	attributes, err := service.DescribeSesDomain(ctx, emailIdentity)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if attributes == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `domain` %s does not exist", emailIdentity)
+		return diag.Errorf("resource `domain` %s does not exist", emailIdentity)
 	}
 
 	_ = d.Set("email_identity", emailIdentity)
@@ -159,19 +163,19 @@ func resourceTencentCloudSesDomainRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudSesDomainDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_domain.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := SesService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	emailIdentity := d.Id()
 
 	if err := service.DeleteSesDomainById(ctx, emailIdentity); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
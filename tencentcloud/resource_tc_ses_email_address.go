@@ -21,9 +21,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ses "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ses/v20201002"
@@ -32,11 +33,14 @@ import (
 
 func resourceTencentCloudSesEmailAddress() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudSesEmailAddressRead,
-		Create: resourceTencentCloudSesEmailAddressCreate,
-		Delete: resourceTencentCloudSesEmailAddressDelete,
+		ReadContext:   resourceTencentCloudSesEmailAddressRead,
+		CreateContext: resourceTencentCloudSesEmailAddressCreate,
+		DeleteContext: resourceTencentCloudSesEmailAddressDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"email_address": {
@@ -56,7 +60,7 @@ func resourceTencentCloudSesEmailAddress() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSesEmailAddressCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesEmailAddressCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_email_address.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -76,8 +80,8 @@ func resourceTencentCloudSesEmailAddressCreate(d *schema.ResourceData, meta inte
 		request.EmailSenderName = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().CreateEmailAddress(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().CreateEmailAddressWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -89,19 +93,19 @@ func resourceTencentCloudSesEmailAddressCreate(d *schema.ResourceData, meta inte
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create ses email_address failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(emailAddress)
-	return resourceTencentCloudSesEmailAddressRead(d, meta)
+	return resourceTencentCloudSesEmailAddressRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSesEmailAddressRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesEmailAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_email_address.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := SesService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -110,12 +114,12 @@ func resourceTencentCloudSesEmailAddressRead(d *schema.ResourceData, meta interf
 	emailSender, err := service.DescribeSesEmailAddress(ctx, emailAddress)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if emailSender == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `email_address` %s does not exist", emailAddress)
+		return diag.Errorf("resource `email_address` %s does not exist", emailAddress)
 	}
 
 	if emailSender.EmailAddress != nil {
@@ -129,19 +133,19 @@ func resourceTencentCloudSesEmailAddressRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudSesEmailAddressDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesEmailAddressDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_email_address.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := SesService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	emailAddressId := d.Id()
 
 	if err := service.DeleteSesEmail_addressById(ctx, emailAddressId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
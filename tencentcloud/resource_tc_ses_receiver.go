@@ -48,7 +48,9 @@ import (
 	"context"
 	"log"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ses "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ses/v20201002"
@@ -57,11 +59,14 @@ import (
 
 func resourceTencentCloudSesReceiver() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSesReceiverCreate,
-		Read:   resourceTencentCloudSesReceiverRead,
-		Delete: resourceTencentCloudSesReceiverDelete,
+		CreateContext: resourceTencentCloudSesReceiverCreate,
+		ReadContext:   resourceTencentCloudSesReceiverRead,
+		DeleteContext: resourceTencentCloudSesReceiverDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"receivers_name": {
@@ -104,7 +109,7 @@ func resourceTencentCloudSesReceiver() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSesReceiverCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesReceiverCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_receiver.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -123,8 +128,8 @@ func resourceTencentCloudSesReceiverCreate(d *schema.ResourceData, meta interfac
 		request.Desc = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().CreateReceiver(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().CreateReceiverWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -135,7 +140,7 @@ func resourceTencentCloudSesReceiverCreate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create ses Receiver failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	receiverId = *response.Response.ReceiverId
@@ -169,8 +174,8 @@ func resourceTencentCloudSesReceiverCreate(d *schema.ResourceData, meta interfac
 			request := ses.NewCreateReceiverDetailWithDataRequest()
 			request.ReceiverId = &receiverId
 			request.Datas = dataList
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().CreateReceiverDetailWithData(request)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().CreateReceiverDetailWithDataWithContext(ctx, request)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -180,15 +185,15 @@ func resourceTencentCloudSesReceiverCreate(d *schema.ResourceData, meta interfac
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s create ses receiverDetail failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		if len(emilList) > 0 {
 			request := ses.NewCreateReceiverDetailRequest()
 			request.ReceiverId = &receiverId
 			request.Emails = emilList
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().CreateReceiverDetail(request)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().CreateReceiverDetailWithContext(ctx, request)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -198,30 +203,30 @@ func resourceTencentCloudSesReceiverCreate(d *schema.ResourceData, meta interfac
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s create ses receiverDetail failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
 	d.SetId(strconv.Itoa(int(receiverId)))
 
-	return resourceTencentCloudSesReceiverRead(d, meta)
+	return resourceTencentCloudSesReceiverRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSesReceiverRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesReceiverRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_receiver.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := SesService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	receiverId := d.Id()
 	receiver, err := service.DescribeSesReceiverById(ctx, receiverId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if receiver == nil {
@@ -240,7 +245,7 @@ func resourceTencentCloudSesReceiverRead(d *schema.ResourceData, meta interface{
 
 	receiverData, err := service.DescribeSesReceiverDetailById(ctx, receiverId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if receiverData != nil {
 		dataList := []interface{}{}
@@ -264,18 +269,18 @@ func resourceTencentCloudSesReceiverRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTencentCloudSesReceiverDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesReceiverDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_receiver.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := SesService{client: meta.(*TencentCloudClient).apiV3Conn}
 	receiverId := d.Id()
 
 	if err := service.DeleteSesReceiverById(ctx, receiverId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -23,8 +23,11 @@ resource "tencentcloud_ses_send_email" "send_email" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ses "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ses/v20201002"
@@ -33,11 +36,14 @@ import (
 
 func resourceTencentCloudSesSendEmail() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSesSendEmailCreate,
-		Read:   resourceTencentCloudSesSendEmailRead,
-		Delete: resourceTencentCloudSesSendEmailDelete,
+		CreateContext: resourceTencentCloudSesSendEmailCreate,
+		ReadContext:   resourceTencentCloudSesSendEmailRead,
+		DeleteContext: resourceTencentCloudSesSendEmailDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"from_email_address": {
@@ -151,7 +157,7 @@ func resourceTencentCloudSesSendEmail() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSesSendEmailCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesSendEmailCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_send_email.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -231,8 +237,8 @@ func resourceTencentCloudSesSendEmailCreate(d *schema.ResourceData, meta interfa
 		request.TriggerType = helper.IntUint64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().SendEmail(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().SendEmailWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -243,23 +249,23 @@ func resourceTencentCloudSesSendEmailCreate(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate ses sendEmail failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	messageId = *response.Response.MessageId
 	d.SetId(messageId)
 
-	return resourceTencentCloudSesSendEmailRead(d, meta)
+	return resourceTencentCloudSesSendEmailRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSesSendEmailRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesSendEmailRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_send_email.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudSesSendEmailDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesSendEmailDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_send_email.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -58,10 +58,11 @@ package tencentcloud
 import (
 	"context"
 	"encoding/base64"
-	"fmt"
 	"log"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ses "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ses/v20201002"
@@ -70,12 +71,16 @@ import (
 
 func resourceTencentCloudSesTemplate() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudSesTemplateRead,
-		Create: resourceTencentCloudSesTemplateCreate,
-		Update: resourceTencentCloudSesTemplateUpdate,
-		Delete: resourceTencentCloudSesTemplateDelete,
+		ReadContext:   resourceTencentCloudSesTemplateRead,
+		CreateContext: resourceTencentCloudSesTemplateCreate,
+		UpdateContext: resourceTencentCloudSesTemplateUpdate,
+		DeleteContext: resourceTencentCloudSesTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"template_name": {
@@ -108,7 +113,7 @@ func resourceTencentCloudSesTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSesTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -137,8 +142,8 @@ func resourceTencentCloudSesTemplateCreate(d *schema.ResourceData, meta interfac
 		request.TemplateContent = &prometheusTemp
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().CreateEmailTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().CreateEmailTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -151,38 +156,38 @@ func resourceTencentCloudSesTemplateCreate(d *schema.ResourceData, meta interfac
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create ses template failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	templateId = *response.Response.TemplateID
 
 	d.SetId(strconv.FormatUint(templateId, 10))
-	return resourceTencentCloudSesTemplateRead(d, meta)
+	return resourceTencentCloudSesTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSesTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := SesService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	templateID := d.Id()
 	templateId, ee := strconv.Atoi(templateID)
 	if ee != nil {
-		return ee
+		return diag.FromErr(ee)
 	}
 
 	templateResponse, err := service.DescribeSesTemplate(ctx, uint64(templateId))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if templateResponse == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `template` %v does not exist", templateId)
+		return diag.Errorf("resource `template` %v does not exist", templateId)
 	}
 
 	template := templateResponse.TemplateContent
@@ -192,7 +197,7 @@ func resourceTencentCloudSesTemplateRead(d *schema.ResourceData, meta interface{
 		if template.Html != nil {
 			html, err := base64.StdEncoding.DecodeString(*template.Html)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			contentHtml := string(html)
 			templateContent["html"] = &contentHtml
@@ -200,7 +205,7 @@ func resourceTencentCloudSesTemplateRead(d *schema.ResourceData, meta interface{
 		if template.Text != nil {
 			text, err := base64.StdEncoding.DecodeString(*template.Text)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			contentText := string(text)
 			templateContent["text"] = &contentText
@@ -208,7 +213,7 @@ func resourceTencentCloudSesTemplateRead(d *schema.ResourceData, meta interface{
 		templateContents = append(templateContents, templateContent)
 		err = d.Set("template_content", templateContents)
 		if ee != nil {
-			return fmt.Errorf("set template_content err: %v", err)
+			return diag.Errorf("set template_content err: %v", err)
 		}
 	}
 
@@ -217,7 +222,7 @@ func resourceTencentCloudSesTemplateRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTencentCloudSesTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_template.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -227,7 +232,7 @@ func resourceTencentCloudSesTemplateUpdate(d *schema.ResourceData, meta interfac
 
 	templateID, ee := strconv.Atoi(d.Id())
 	if ee != nil {
-		return ee
+		return diag.FromErr(ee)
 	}
 	templateId := uint64(templateID)
 	request.TemplateID = &templateId
@@ -249,8 +254,8 @@ func resourceTencentCloudSesTemplateUpdate(d *schema.ResourceData, meta interfac
 		request.TemplateContent = &prometheusTemp
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().UpdateEmailTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().UpdateEmailTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -262,29 +267,29 @@ func resourceTencentCloudSesTemplateUpdate(d *schema.ResourceData, meta interfac
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create ses template failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSesTemplateRead(d, meta)
+	return resourceTencentCloudSesTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSesTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := SesService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	templateID, ee := strconv.Atoi(d.Id())
 	if ee != nil {
-		return ee
+		return diag.FromErr(ee)
 	}
 	templateId := uint64(templateID)
 
 	if err := service.DeleteSesTemplateById(ctx, templateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -16,7 +16,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ses "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ses/v20201002"
@@ -25,11 +27,14 @@ import (
 
 func resourceTencentCloudSesVerifyDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSesVerifyDomainCreate,
-		Read:   resourceTencentCloudSesVerifyDomainRead,
-		Delete: resourceTencentCloudSesVerifyDomainDelete,
+		CreateContext: resourceTencentCloudSesVerifyDomainCreate,
+		ReadContext:   resourceTencentCloudSesVerifyDomainRead,
+		DeleteContext: resourceTencentCloudSesVerifyDomainDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"email_identity": {
@@ -42,12 +47,12 @@ func resourceTencentCloudSesVerifyDomain() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSesVerifyDomainCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesVerifyDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_verify_domain.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request       = ses.NewUpdateEmailIdentityRequest()
@@ -58,8 +63,8 @@ func resourceTencentCloudSesVerifyDomainCreate(d *schema.ResourceData, meta inte
 		request.EmailIdentity = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().UpdateEmailIdentity(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSesClient().UpdateEmailIdentityWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -69,27 +74,27 @@ func resourceTencentCloudSesVerifyDomainCreate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate ses verifyDomain failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(emailIdentity)
 
 	service := SesService{client: meta.(*TencentCloudClient).apiV3Conn}
 	if err := service.CheckEmailIdentityById(ctx, emailIdentity); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSesVerifyDomainRead(d, meta)
+	return resourceTencentCloudSesVerifyDomainRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSesVerifyDomainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesVerifyDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_verify_domain.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudSesVerifyDomainDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSesVerifyDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ses_verify_domain.delete")()
 	defer inconsistentCheck(d, meta)()
 
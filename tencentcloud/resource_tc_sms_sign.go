@@ -16,16 +16,23 @@ resource "tencentcloud_sms_sign" "example" {
 }
 ```
 
+Import
+
+sms sign can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_sms_sign.example 462130#0
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sms "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sms/v20210111"
@@ -34,10 +41,17 @@ import (
 
 func resourceTencentCloudSmsSign() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudSmsSignRead,
-		Create: resourceTencentCloudSmsSignCreate,
-		Update: resourceTencentCloudSmsSignUpdate,
-		Delete: resourceTencentCloudSmsSignDelete,
+		ReadContext:   resourceTencentCloudSmsSignRead,
+		CreateContext: resourceTencentCloudSmsSignCreate,
+		UpdateContext: resourceTencentCloudSmsSignUpdate,
+		DeleteContext: resourceTencentCloudSmsSignDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"sign_name": {
 				Type:        schema.TypeString,
@@ -90,7 +104,7 @@ func resourceTencentCloudSmsSign() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSmsSignCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSmsSignCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sms_sign.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -136,8 +150,8 @@ func resourceTencentCloudSmsSignCreate(d *schema.ResourceData, meta interface{})
 		request.Remark = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSmsClient().AddSmsSign(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSmsClient().AddSmsSignWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -150,26 +164,26 @@ func resourceTencentCloudSmsSignCreate(d *schema.ResourceData, meta interface{})
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sms sign failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	signId = *response.Response.AddSignStatus.SignId
-	d.SetId(helper.UInt64ToStr(signId) + FILED_SP + strconv.Itoa(international))
-	return resourceTencentCloudSmsSignRead(d, meta)
+	d.SetId(helper.IdFormat(helper.UInt64ToStr(signId), strconv.Itoa(international)))
+	return resourceTencentCloudSmsSignRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSmsSignRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSmsSignRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sms_sign.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := SmsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	signId := idSplit[0]
 	international := idSplit[1]
@@ -177,12 +191,12 @@ func resourceTencentCloudSmsSignRead(d *schema.ResourceData, meta interface{}) e
 	sign, err := service.DescribeSmsSign(ctx, signId, international)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if sign == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `sign` %s does not exist", signId)
+		return diag.Errorf("resource `sign` %s does not exist", signId)
 	}
 
 	if sign.SignName != nil {
@@ -196,7 +210,7 @@ func resourceTencentCloudSmsSignRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceTencentCloudSmsSignUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSmsSignUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sms_sign.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -204,9 +218,9 @@ func resourceTencentCloudSmsSignUpdate(d *schema.ResourceData, meta interface{})
 
 	request := sms.NewModifySmsSignRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	signId := idSplit[0]
 
@@ -244,8 +258,8 @@ func resourceTencentCloudSmsSignUpdate(d *schema.ResourceData, meta interface{})
 		request.Remark = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSmsClient().ModifySmsSign(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSmsClient().ModifySmsSignWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -257,29 +271,29 @@ func resourceTencentCloudSmsSignUpdate(d *schema.ResourceData, meta interface{})
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sms sign failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSmsSignRead(d, meta)
+	return resourceTencentCloudSmsSignRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSmsSignDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSmsSignDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sms_sign.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := SmsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	signId := idSplit[0]
 
 	if err := service.DeleteSmsSignById(ctx, signId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -20,6 +20,19 @@ func TestAccTencentCloudSmsSign_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_sms_sign.sign", "sign_name", "terraform"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_sms_sign.sign",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"sign_type",
+					"document_type",
+					"sign_purpose",
+					"proof_image",
+					"commission_image",
+					"remark",
+				},
+			},
 		},
 	})
 }
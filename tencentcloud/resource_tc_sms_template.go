@@ -20,11 +20,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sms "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sms/v20210111"
@@ -33,12 +33,16 @@ import (
 
 func resourceTencentCloudSmsTemplate() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudSmsTemplateRead,
-		Create: resourceTencentCloudSmsTemplateCreate,
-		Update: resourceTencentCloudSmsTemplateUpdate,
-		Delete: resourceTencentCloudSmsTemplateDelete,
+		ReadContext:   resourceTencentCloudSmsTemplateRead,
+		CreateContext: resourceTencentCloudSmsTemplateCreate,
+		UpdateContext: resourceTencentCloudSmsTemplateUpdate,
+		DeleteContext: resourceTencentCloudSmsTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"template_name": {
@@ -74,7 +78,7 @@ func resourceTencentCloudSmsTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSmsTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSmsTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sms_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -108,8 +112,8 @@ func resourceTencentCloudSmsTemplateCreate(d *schema.ResourceData, meta interfac
 		request.Remark = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSmsClient().AddSmsTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSmsClient().AddSmsTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -122,27 +126,27 @@ func resourceTencentCloudSmsTemplateCreate(d *schema.ResourceData, meta interfac
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sms template failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	templateId = *response.Response.AddTemplateStatus.TemplateId
 
-	d.SetId(templateId + FILED_SP + strconv.Itoa(international))
-	return resourceTencentCloudSmsTemplateRead(d, meta)
+	d.SetId(helper.IdFormat(templateId, strconv.Itoa(international)))
+	return resourceTencentCloudSmsTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSmsTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSmsTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sms_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := SmsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	templateId := idSplit[0]
 	international := idSplit[1]
@@ -150,12 +154,12 @@ func resourceTencentCloudSmsTemplateRead(d *schema.ResourceData, meta interface{
 	template, err := service.DescribeSmsTemplate(ctx, templateId, international)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if template == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `template` %s does not exist", templateId)
+		return diag.Errorf("resource `template` %s does not exist", templateId)
 	}
 
 	if template.TemplateName != nil {
@@ -173,16 +177,16 @@ func resourceTencentCloudSmsTemplateRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTencentCloudSmsTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSmsTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sms_template.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 	request := sms.NewModifySmsTemplateRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	templateId := idSplit[0]
 
@@ -208,8 +212,8 @@ func resourceTencentCloudSmsTemplateUpdate(d *schema.ResourceData, meta interfac
 		request.Remark = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSmsClient().ModifySmsTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSmsClient().ModifySmsTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -221,29 +225,29 @@ func resourceTencentCloudSmsTemplateUpdate(d *schema.ResourceData, meta interfac
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sms template failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSmsTemplateRead(d, meta)
+	return resourceTencentCloudSmsTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSmsTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSmsTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sms_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := SmsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	templateId := idSplit[0]
 
 	if err := service.DeleteSmsTemplateById(ctx, templateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
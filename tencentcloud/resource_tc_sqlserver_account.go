@@ -68,20 +68,27 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudSqlserverAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverAccountCreate,
-		Read:   resourceTencentCloudSqlserverAccountRead,
-		Update: resourceTencentCloudSqlserverAccountUpdate,
-		Delete: resourceTencentCLoudSqlserverAccountDelete,
+		CreateContext: resourceTencentCloudSqlserverAccountCreate,
+		ReadContext:   resourceTencentCloudSqlserverAccountRead,
+		UpdateContext: resourceTencentCloudSqlserverAccountUpdate,
+		DeleteContext: resourceTencentCLoudSqlserverAccountDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -134,11 +141,11 @@ func resourceTencentCloudSqlserverAccount() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_account.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -152,7 +159,7 @@ func resourceTencentCloudSqlserverAccountCreate(d *schema.ResourceData, meta int
 
 	var outErr, inErr error
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		inErr = sqlserverService.CreateSqlserverAccount(ctx, instanceId, name, password, remark, isAdmin)
 		if inErr != nil {
 			return retryError(inErr)
@@ -160,25 +167,25 @@ func resourceTencentCloudSqlserverAccountCreate(d *schema.ResourceData, meta int
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	d.SetId(instanceId + FILED_SP + name)
+	d.SetId(helper.IdFormat(instanceId, name))
 
-	return resourceTencentCloudSqlserverAccountRead(d, meta)
+	return resourceTencentCloudSqlserverAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_account.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	id := d.Id()
-	idStrs := strings.Split(id, FILED_SP)
-	if len(idStrs) != 2 {
-		return fmt.Errorf("invalid SQL Server account id %s", id)
+	idStrs, err := helper.IdParseN(id, 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idStrs[0]
 	name := idStrs[1]
@@ -188,13 +195,13 @@ func resourceTencentCloudSqlserverAccountUpdate(d *schema.ResourceData, meta int
 
 	//update is_admin
 	if d.HasChange("is_admin") {
-		return fmt.Errorf("is_admin is not allowed to change")
+		return diag.Errorf("is_admin is not allowed to change")
 	}
 
 	//update password
 	if d.HasChange("password") {
 		password := d.Get("password").(string)
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = sqlserverService.ResetSqlserverAccountPassword(ctx, instanceId, name, password)
 			if inErr != nil {
 				return retryError(inErr)
@@ -202,7 +209,7 @@ func resourceTencentCloudSqlserverAccountUpdate(d *schema.ResourceData, meta int
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 	}
@@ -210,7 +217,7 @@ func resourceTencentCloudSqlserverAccountUpdate(d *schema.ResourceData, meta int
 	//update remark
 	if d.HasChange("remark") {
 		remark := d.Get("remark").(string)
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = sqlserverService.ModifySqlserverAccountRemark(ctx, instanceId, name, remark)
 			if inErr != nil {
 				return retryError(inErr)
@@ -218,27 +225,27 @@ func resourceTencentCloudSqlserverAccountUpdate(d *schema.ResourceData, meta int
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudSqlserverAccountRead(d, meta)
+	return resourceTencentCloudSqlserverAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	defer logElapsed("resource.tencentcloud_sqlserver_account.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
-	idStrs := strings.Split(id, FILED_SP)
-	if len(idStrs) != 2 {
-		return fmt.Errorf("invalid SQL Server account id %s", id)
+	idStrs, err := helper.IdParseN(id, 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idStrs[0]
 	name := idStrs[1]
@@ -247,7 +254,7 @@ func resourceTencentCloudSqlserverAccountRead(d *schema.ResourceData, meta inter
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	account, has, outErr := sqlserverService.DescribeSqlserverAccountById(ctx, instanceId, name)
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			account, has, inErr = sqlserverService.DescribeSqlserverAccountById(ctx, instanceId, name)
 			if inErr != nil {
 				return retryError(inErr)
@@ -256,7 +263,7 @@ func resourceTencentCloudSqlserverAccountRead(d *schema.ResourceData, meta inter
 		})
 	}
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	if !has {
 		d.SetId("")
@@ -277,16 +284,16 @@ func resourceTencentCloudSqlserverAccountRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCLoudSqlserverAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCLoudSqlserverAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_account.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
-	idStrs := strings.Split(id, FILED_SP)
-	if len(idStrs) != 2 {
-		return fmt.Errorf("invalid SQL Server account id %s", id)
+	idStrs, err := helper.IdParseN(id, 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idStrs[0]
 	name := idStrs[1]
@@ -296,7 +303,7 @@ func resourceTencentCLoudSqlserverAccountDelete(d *schema.ResourceData, meta int
 	var outErr, inErr error
 	var has bool
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, inErr = sqlserverService.DescribeSqlserverAccountById(ctx, instanceId, name)
 		if inErr != nil {
 			return retryError(inErr)
@@ -305,14 +312,14 @@ func resourceTencentCLoudSqlserverAccountDelete(d *schema.ResourceData, meta int
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if !has {
 		return nil
 	}
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		inErr = sqlserverService.DeleteSqlserverAccount(ctx, instanceId, name)
 		if inErr != nil {
 			return retryError(inErr)
@@ -321,10 +328,10 @@ func resourceTencentCLoudSqlserverAccountDelete(d *schema.ResourceData, meta int
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, inErr = sqlserverService.DescribeSqlserverAccountById(ctx, instanceId, name)
 		if inErr != nil {
 			return retryError(inErr)
@@ -337,7 +344,7 @@ func resourceTencentCLoudSqlserverAccountDelete(d *schema.ResourceData, meta int
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	return nil
 }
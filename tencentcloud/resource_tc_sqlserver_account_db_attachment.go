@@ -82,20 +82,27 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudSqlserverAccountDBAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverAccountDBAttachmentCreate,
-		Read:   resourceTencentCloudSqlserverAccountDBAttachmentRead,
-		Update: resourceTencentCloudSqlserverAccountDBAttachmentUpdate,
-		Delete: resourceTencentCLoudSqlserverAccountDBAttachmentDelete,
+		CreateContext: resourceTencentCloudSqlserverAccountDBAttachmentCreate,
+		ReadContext:   resourceTencentCloudSqlserverAccountDBAttachmentRead,
+		UpdateContext: resourceTencentCloudSqlserverAccountDBAttachmentUpdate,
+		DeleteContext: resourceTencentCLoudSqlserverAccountDBAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -126,11 +133,11 @@ func resourceTencentCloudSqlserverAccountDBAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverAccountDBAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverAccountDBAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_account_db_attachment.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -144,7 +151,7 @@ func resourceTencentCloudSqlserverAccountDBAttachmentCreate(d *schema.ResourceDa
 	var outErr, inErr error
 
 	//check account exists
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		_, has, inErr := sqlserverService.DescribeSqlserverAccountById(ctx, instanceId, accountName)
 		if inErr != nil {
 			return retryError(inErr)
@@ -155,12 +162,12 @@ func resourceTencentCloudSqlserverAccountDBAttachmentCreate(d *schema.ResourceDa
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	//check db exists
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, has, inErr := sqlserverService.DescribeDBDetailsById(ctx, instanceId+FILED_SP+dbName)
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, has, inErr := sqlserverService.DescribeDBDetailsById(ctx, helper.IdFormat(instanceId, dbName))
 		if inErr != nil {
 			return retryError(inErr)
 		}
@@ -170,10 +177,10 @@ func resourceTencentCloudSqlserverAccountDBAttachmentCreate(d *schema.ResourceDa
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		inErr = sqlserverService.ModifyAccountDBAttachment(ctx, instanceId, accountName, dbName, privilege)
 		if inErr != nil {
 			return retryError(inErr)
@@ -181,25 +188,25 @@ func resourceTencentCloudSqlserverAccountDBAttachmentCreate(d *schema.ResourceDa
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	d.SetId(instanceId + FILED_SP + accountName + FILED_SP + dbName)
+	d.SetId(helper.IdFormat(instanceId, accountName, dbName))
 
-	return resourceTencentCloudSqlserverAccountDBAttachmentRead(d, meta)
+	return resourceTencentCloudSqlserverAccountDBAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverAccountDBAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverAccountDBAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_account_db_attachment.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	id := d.Id()
-	idStrs := strings.Split(id, FILED_SP)
-	if len(idStrs) != 3 {
-		return fmt.Errorf("invalid SQL Server account DB attachment %s", id)
+	idStrs, err := helper.IdParseN(id, 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idStrs[0]
 	accountName := idStrs[1]
@@ -210,7 +217,7 @@ func resourceTencentCloudSqlserverAccountDBAttachmentUpdate(d *schema.ResourceDa
 	//update privilege
 	if d.HasChange("privilege") {
 		privilege := d.Get("privilege").(string)
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = sqlserverService.ModifyAccountDBAttachment(ctx, instanceId, accountName, dbName, privilege)
 			if inErr != nil {
 				return retryError(inErr)
@@ -218,24 +225,24 @@ func resourceTencentCloudSqlserverAccountDBAttachmentUpdate(d *schema.ResourceDa
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 	}
 
-	return resourceTencentCloudSqlserverAccountDBAttachmentRead(d, meta)
+	return resourceTencentCloudSqlserverAccountDBAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverAccountDBAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverAccountDBAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	defer logElapsed("resource.tencentcloud_sqlserver_account_db_attachment.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
-	idStrs := strings.Split(id, FILED_SP)
-	if len(idStrs) != 3 {
-		return fmt.Errorf("invalid SQL Server account DB attachment ID %s", id)
+	idStrs, err := helper.IdParseN(id, 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idStrs[0]
 	accountName := idStrs[1]
@@ -246,7 +253,7 @@ func resourceTencentCloudSqlserverAccountDBAttachmentRead(d *schema.ResourceData
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	attachment, has, outErr := sqlserverService.DescribeAccountDBAttachmentById(ctx, instanceId, accountName, dbName)
 	if outErr != nil {
-		inErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		inErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			attachment, has, inErr = sqlserverService.DescribeAccountDBAttachmentById(ctx, instanceId, accountName, dbName)
 			if inErr != nil {
 				return retryError(inErr)
@@ -268,16 +275,16 @@ func resourceTencentCloudSqlserverAccountDBAttachmentRead(d *schema.ResourceData
 	return nil
 }
 
-func resourceTencentCLoudSqlserverAccountDBAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCLoudSqlserverAccountDBAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_account_db_attachment.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
-	idStrs := strings.Split(id, FILED_SP)
-	if len(idStrs) != 3 {
-		return fmt.Errorf("invalid SQL Server account DB attachment id %s", id)
+	idStrs, err := helper.IdParseN(id, 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idStrs[0]
 	accountName := idStrs[1]
@@ -289,7 +296,7 @@ func resourceTencentCLoudSqlserverAccountDBAttachmentDelete(d *schema.ResourceDa
 	var has bool
 	privilege := "Delete"
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, inErr = sqlserverService.DescribeAccountDBAttachmentById(ctx, instanceId, accountName, dbName)
 		if inErr != nil {
 			return retryError(inErr)
@@ -298,14 +305,14 @@ func resourceTencentCLoudSqlserverAccountDBAttachmentDelete(d *schema.ResourceDa
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if !has {
 		return nil
 	}
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		inErr = sqlserverService.ModifyAccountDBAttachment(ctx, instanceId, accountName, dbName, privilege)
 		if inErr != nil {
 			return retryError(inErr)
@@ -314,10 +321,10 @@ func resourceTencentCLoudSqlserverAccountDBAttachmentDelete(d *schema.ResourceDa
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, inErr = sqlserverService.DescribeAccountDBAttachmentById(ctx, instanceId, accountName, dbName)
 		if inErr != nil {
 			return retryError(inErr)
@@ -330,7 +337,7 @@ func resourceTencentCLoudSqlserverAccountDBAttachmentDelete(d *schema.ResourceDa
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	return nil
 }
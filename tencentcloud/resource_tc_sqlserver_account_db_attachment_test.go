@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 var testSqlserverAccountDBAttachmentResourceName = "tencentcloud_sqlserver_account_db_attachment"
@@ -99,7 +99,7 @@ func testAccCheckSqlserverAccountDBAttachmentDestroy(s *terraform.State) error {
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
 		id := rs.Primary.ID
-		idStrs := strings.Split(id, FILED_SP)
+		idStrs := helper.IdParse(id)
 		if len(idStrs) != 3 {
 			return fmt.Errorf("invalid SQL server account id %s", id)
 		}
@@ -132,7 +132,7 @@ func testAccCheckSqlserverAccountDBAttachmentExists(n string) resource.TestCheck
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
 		id := rs.Primary.ID
-		idStrs := strings.Split(id, FILED_SP)
+		idStrs := helper.IdParse(id)
 		if len(idStrs) != 3 {
 			return fmt.Errorf("invalid SQL server account id %s", id)
 		}
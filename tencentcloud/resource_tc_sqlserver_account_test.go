@@ -3,12 +3,12 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 var testSqlserverAccountResourceName = "tencentcloud_sqlserver_account"
@@ -115,7 +115,7 @@ func testAccCheckSqlserverAccountDestroy(s *terraform.State) error {
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
 		id := rs.Primary.ID
-		idStrs := strings.Split(id, FILED_SP)
+		idStrs := helper.IdParse(id)
 		if len(idStrs) != 2 {
 			return fmt.Errorf("invalid SQL server account id %s", id)
 		}
@@ -149,7 +149,7 @@ func testAccCheckSqlserverAccountExists(n string) resource.TestCheckFunc {
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
 		id := rs.Primary.ID
-		idStrs := strings.Split(id, FILED_SP)
+		idStrs := helper.IdParse(id)
 		if len(idStrs) != 2 {
 			return fmt.Errorf("invalid SQL server account id %s", id)
 		}
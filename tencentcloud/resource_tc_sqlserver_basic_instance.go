@@ -60,7 +60,9 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -70,12 +72,17 @@ import (
 func resourceTencentCloudSqlserverBasicInstance() *schema.Resource {
 
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverBasicInstanceCreate,
-		Read:   resourceTencentCloudSqlserverBasicInstanceRead,
-		Update: resourceTencentCloudSqlserverBasicInstanceUpdate,
-		Delete: resourceTencentCLoudSqlserverBasicInstanceDelete,
+		CreateContext: resourceTencentCloudSqlserverBasicInstanceCreate,
+		ReadContext:   resourceTencentCloudSqlserverBasicInstanceRead,
+		UpdateContext: resourceTencentCloudSqlserverBasicInstanceUpdate,
+		DeleteContext: resourceTencentCLoudSqlserverBasicInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -227,12 +234,11 @@ func resourceTencentCloudSqlserverBasicInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverBasicInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverBasicInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_basic_instance.create")()
 
 	var (
 		logId            = getLogId(contextNil)
-		ctx              = context.WithValue(context.TODO(), logIdKey, logId)
 		client           = meta.(*TencentCloudClient).apiV3Conn
 		sqlserverService = SqlserverService{client: client}
 		tagService       = TagService{client: client}
@@ -244,6 +250,7 @@ func resourceTencentCloudSqlserverBasicInstanceCreate(d *schema.ResourceData, me
 		voucherIds       = make([]string, 0)
 		weekSet          = make([]int, 0)
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if payType == COMMON_PAYTYPE_POSTPAID {
 		payType = "POSTPAID"
 		paramMap["autoRenew"] = 0
@@ -302,7 +309,7 @@ func resourceTencentCloudSqlserverBasicInstanceCreate(d *schema.ResourceData, me
 
 	var instanceId string
 	var outErr, inErr error
-	outErr = resource.Retry(12*writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instanceId, inErr = sqlserverService.CreateSqlserverBasicInstance(ctx, paramMap, weekSet, voucherIds, securityGroups)
 		if inErr != nil {
 			return retryError(inErr)
@@ -310,13 +317,13 @@ func resourceTencentCloudSqlserverBasicInstanceCreate(d *schema.ResourceData, me
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	d.SetId(instanceId)
 
 	//set name
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		inErr := sqlserverService.ModifySqlserverInstanceName(ctx, instanceId, name)
 		if inErr != nil {
 			return retryError(inErr)
@@ -324,23 +331,23 @@ func resourceTencentCloudSqlserverBasicInstanceCreate(d *schema.ResourceData, me
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		resourceName := BuildTagResourceName("sqlserver", "instance", region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudSqlserverBasicInstanceRead(d, meta)
+	return resourceTencentCloudSqlserverBasicInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverBasicInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverBasicInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_basic_instance.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var outErr, inErr error
 	instanceId := d.Id()
@@ -348,7 +355,7 @@ func resourceTencentCloudSqlserverBasicInstanceRead(d *schema.ResourceData, meta
 
 	instance, has, outErr := sqlserverService.DescribeSqlserverInstanceById(ctx, d.Id())
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	if !has {
 		d.SetId("")
@@ -383,7 +390,7 @@ func resourceTencentCloudSqlserverBasicInstanceRead(d *schema.ResourceData, meta
 	var weekSet []int
 	var startTime string
 	var timeSpan int
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		weekSet, startTime, timeSpan, inErr = sqlserverService.DescribeMaintenanceSpan(ctx, instanceId)
 		if inErr != nil {
 			return retryError(inErr)
@@ -391,14 +398,14 @@ func resourceTencentCloudSqlserverBasicInstanceRead(d *schema.ResourceData, meta
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	_ = d.Set("maintenance_week_set", weekSet)
 	_ = d.Set("maintenance_start_time", startTime)
 	_ = d.Set("maintenance_time_span", timeSpan)
 
 	var securityGroup []string
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		securityGroup, inErr = sqlserverService.DescribeInstanceSecurityGroups(ctx, instanceId)
 		if inErr != nil {
 			return retryError(inErr)
@@ -407,7 +414,7 @@ func resourceTencentCloudSqlserverBasicInstanceRead(d *schema.ResourceData, meta
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	_ = d.Set("security_groups", securityGroup)
 
@@ -415,17 +422,17 @@ func resourceTencentCloudSqlserverBasicInstanceRead(d *schema.ResourceData, meta
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "sqlserver", "instance", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 	return nil
 }
 
-func resourceTencentCloudSqlserverBasicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverBasicInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_basic_instance.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	sqlserverService := SqlserverService{client: client}
 	tagService := TagService{client: client}
@@ -438,7 +445,7 @@ func resourceTencentCloudSqlserverBasicInstanceUpdate(d *schema.ResourceData, me
 	//update name
 	if d.HasChange("name") {
 		name := d.Get("name").(string)
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = sqlserverService.ModifySqlserverInstanceName(ctx, instanceId, name)
 			if inErr != nil {
 				return retryError(inErr)
@@ -446,7 +453,7 @@ func resourceTencentCloudSqlserverBasicInstanceUpdate(d *schema.ResourceData, me
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 	}
@@ -464,7 +471,7 @@ func resourceTencentCloudSqlserverBasicInstanceUpdate(d *schema.ResourceData, me
 				voucherIds = append(voucherIds, id.(string))
 			}
 		}
-		outErr = resource.Retry(12*writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = sqlserverService.UpgradeSqlserverBasicInstance(ctx, instanceId, memory, storage, cpu, autoVoucher, voucherIds)
 			if inErr != nil {
 				return retryError(inErr)
@@ -472,7 +479,7 @@ func resourceTencentCloudSqlserverBasicInstanceUpdate(d *schema.ResourceData, me
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 	}
@@ -486,7 +493,7 @@ func resourceTencentCloudSqlserverBasicInstanceUpdate(d *schema.ResourceData, me
 
 		for _, v := range oldSet {
 			sgId := v.(string)
-			outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				inErr := sqlserverService.RemoveSecurityGroup(ctx, instanceId, sgId)
 				if inErr != nil {
 					return retryError(inErr)
@@ -494,12 +501,12 @@ func resourceTencentCloudSqlserverBasicInstanceUpdate(d *schema.ResourceData, me
 				return nil
 			})
 			if outErr != nil {
-				return outErr
+				return diag.FromErr(outErr)
 			}
 		}
 		for _, v := range newSet {
 			sgId := v.(string)
-			outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				inErr := sqlserverService.AddSecurityGroup(ctx, instanceId, sgId)
 				if inErr != nil {
 					return retryError(inErr)
@@ -507,7 +514,7 @@ func resourceTencentCloudSqlserverBasicInstanceUpdate(d *schema.ResourceData, me
 				return nil
 			})
 			if outErr != nil {
-				return outErr
+				return diag.FromErr(outErr)
 			}
 		}
 
@@ -515,7 +522,7 @@ func resourceTencentCloudSqlserverBasicInstanceUpdate(d *schema.ResourceData, me
 	//update project id
 	if d.HasChange("project_id") {
 		projectId := d.Get("project_id").(int)
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = sqlserverService.ModifySqlserverInstanceProjectId(ctx, instanceId, projectId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -523,7 +530,7 @@ func resourceTencentCloudSqlserverBasicInstanceUpdate(d *schema.ResourceData, me
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 	}
@@ -538,7 +545,7 @@ func resourceTencentCloudSqlserverBasicInstanceUpdate(d *schema.ResourceData, me
 		}
 		startTime := d.Get("maintenance_start_time").(string)
 		timeSpan := d.Get("maintenance_time_span").(int)
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = sqlserverService.ModifySqlserverInstanceMaintenanceSpan(ctx, instanceId, weekSet, startTime, timeSpan)
 			if inErr != nil {
 				return retryError(inErr)
@@ -546,7 +553,7 @@ func resourceTencentCloudSqlserverBasicInstanceUpdate(d *schema.ResourceData, me
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 	}
@@ -556,7 +563,7 @@ func resourceTencentCloudSqlserverBasicInstanceUpdate(d *schema.ResourceData, me
 			var renewFlag int
 			_, newValue := d.GetChange("auto_renew")
 			renewFlag = newValue.(int)
-			outErr = resource.Retry(2*writeRetryTimeout, func() *resource.RetryError {
+			outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				inErr = sqlserverService.NewModifyDBInstanceRenewFlag(ctx, instanceId, renewFlag)
 				if inErr != nil {
 					return retryError(inErr)
@@ -564,7 +571,7 @@ func resourceTencentCloudSqlserverBasicInstanceUpdate(d *schema.ResourceData, me
 				return nil
 			})
 			if outErr != nil {
-				return outErr
+				return diag.FromErr(outErr)
 			}
 
 		}
@@ -575,19 +582,19 @@ func resourceTencentCloudSqlserverBasicInstanceUpdate(d *schema.ResourceData, me
 
 		resourceName := BuildTagResourceName("sqlserver", "instance", region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 	d.Partial(false)
-	return resourceTencentCloudSqlserverBasicInstanceRead(d, meta)
+	return resourceTencentCloudSqlserverBasicInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCLoudSqlserverBasicInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCLoudSqlserverBasicInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_basic_instance.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -596,7 +603,7 @@ func resourceTencentCLoudSqlserverBasicInstanceDelete(d *schema.ResourceData, me
 	var has bool
 	var instance *sqlserver.DBInstance
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		instance, has, inErr = sqlserverService.DescribeSqlserverInstanceById(ctx, d.Id())
 		if inErr != nil {
 			return retryError(inErr)
@@ -605,7 +612,7 @@ func resourceTencentCLoudSqlserverBasicInstanceDelete(d *schema.ResourceData, me
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if !has {
@@ -613,10 +620,10 @@ func resourceTencentCLoudSqlserverBasicInstanceDelete(d *schema.ResourceData, me
 	}
 	// PREPAID
 	if *instance.PayMode == 1 {
-		return fmt.Errorf("PREPAID instances are not allowed to be deleted now, please terminate them on console")
+		return diag.Errorf("PREPAID instances are not allowed to be deleted now, please terminate them on console")
 	}
 	//terminate sql instance
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		inErr = sqlserverService.TerminateSqlserverInstance(ctx, instanceId)
 		if inErr != nil {
 			return retryError(inErr)
@@ -625,10 +632,10 @@ func resourceTencentCLoudSqlserverBasicInstanceDelete(d *schema.ResourceData, me
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		inErr = sqlserverService.DeleteSqlserverInstance(ctx, instanceId)
 		if inErr != nil {
 			return retryError(inErr)
@@ -637,10 +644,10 @@ func resourceTencentCLoudSqlserverBasicInstanceDelete(d *schema.ResourceData, me
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, inErr := sqlserverService.DescribeSqlserverInstanceById(ctx, d.Id())
 		if inErr != nil {
 			return retryError(inErr)
@@ -653,7 +660,7 @@ func resourceTencentCLoudSqlserverBasicInstanceDelete(d *schema.ResourceData, me
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	return nil
 }
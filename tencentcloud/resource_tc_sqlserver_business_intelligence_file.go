@@ -65,8 +65,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -75,11 +76,14 @@ import (
 
 func resourceTencentCloudSqlserverBusinessIntelligenceFile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverBusinessIntelligenceFileCreate,
-		Read:   resourceTencentCloudSqlserverBusinessIntelligenceFileRead,
-		Delete: resourceTencentCloudSqlserverBusinessIntelligenceFileDelete,
+		CreateContext: resourceTencentCloudSqlserverBusinessIntelligenceFileCreate,
+		ReadContext:   resourceTencentCloudSqlserverBusinessIntelligenceFileRead,
+		DeleteContext: resourceTencentCloudSqlserverBusinessIntelligenceFileDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -110,7 +114,7 @@ func resourceTencentCloudSqlserverBusinessIntelligenceFile() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverBusinessIntelligenceFileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverBusinessIntelligenceFileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_business_intelligence_file.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -138,8 +142,8 @@ func resourceTencentCloudSqlserverBusinessIntelligenceFileCreate(d *schema.Resou
 		CreateBIFRequest.Remark = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().CreateBusinessIntelligenceFile(CreateBIFRequest)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().CreateBusinessIntelligenceFileWithContext(ctx, CreateBIFRequest)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -157,27 +161,27 @@ func resourceTencentCloudSqlserverBusinessIntelligenceFileCreate(d *schema.Resou
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver businessIntelligenceFile failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{instanceId, *CreateBIFResponse.Response.FileTaskId}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, *CreateBIFResponse.Response.FileTaskId))
 
-	return resourceTencentCloudSqlserverBusinessIntelligenceFileRead(d, meta)
+	return resourceTencentCloudSqlserverBusinessIntelligenceFileRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverBusinessIntelligenceFileRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverBusinessIntelligenceFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_business_intelligence_file.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := idSplit[0]
@@ -185,7 +189,7 @@ func resourceTencentCloudSqlserverBusinessIntelligenceFileRead(d *schema.Resourc
 
 	businessIntelligenceFile, err := service.DescribeSqlserverBusinessIntelligenceFileById(ctx, instanceId, fileName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if businessIntelligenceFile == nil {
@@ -213,26 +217,26 @@ func resourceTencentCloudSqlserverBusinessIntelligenceFileRead(d *schema.Resourc
 	return nil
 }
 
-func resourceTencentCloudSqlserverBusinessIntelligenceFileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverBusinessIntelligenceFileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_business_intelligence_file.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := idSplit[0]
 	fileName := idSplit[1]
 
 	if err := service.DeleteSqlserverBusinessIntelligenceFileById(ctx, instanceId, fileName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -58,7 +58,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -67,12 +69,16 @@ import (
 
 func resourceTencentCloudSqlserverBusinessIntelligenceInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverBusinessIntelligenceInstanceCreate,
-		Read:   resourceTencentCloudSqlserverBusinessIntelligenceInstanceRead,
-		Update: resourceTencentCloudSqlserverBusinessIntelligenceInstanceUpdate,
-		Delete: resourceTencentCloudSqlserverBusinessIntelligenceInstanceDelete,
+		CreateContext: resourceTencentCloudSqlserverBusinessIntelligenceInstanceCreate,
+		ReadContext:   resourceTencentCloudSqlserverBusinessIntelligenceInstanceRead,
+		UpdateContext: resourceTencentCloudSqlserverBusinessIntelligenceInstanceUpdate,
+		DeleteContext: resourceTencentCloudSqlserverBusinessIntelligenceInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(25 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"zone": {
@@ -179,7 +185,7 @@ func resourceTencentCloudSqlserverBusinessIntelligenceInstance() *schema.Resourc
 	}
 }
 
-func resourceTencentCloudSqlserverBusinessIntelligenceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverBusinessIntelligenceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_business_intelligence_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -266,8 +272,8 @@ func resourceTencentCloudSqlserverBusinessIntelligenceInstanceCreate(d *schema.R
 		instanceName = v.(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().CreateBusinessDBInstances(CreateDBIRequest)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().CreateBusinessDBInstancesWithContext(ctx, CreateDBIRequest)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -284,11 +290,11 @@ func resourceTencentCloudSqlserverBusinessIntelligenceInstanceCreate(d *schema.R
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver businessIntelligenceInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(5*writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().DescribeDBInstances(DescRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().DescribeDBInstancesWithContext(ctx, DescRequest)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -319,15 +325,15 @@ func resourceTencentCloudSqlserverBusinessIntelligenceInstanceCreate(d *schema.R
 
 	if err != nil {
 		log.Printf("[CRITAL]%s describe sqlserver businessIntelligenceInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId = *DescResponse.InstanceId
 
 	ModifyRequest.InstanceId = &instanceId
 	ModifyRequest.InstanceName = &instanceName
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyDBInstanceName(ModifyRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyDBInstanceNameWithContext(ctx, ModifyRequest)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -339,28 +345,28 @@ func resourceTencentCloudSqlserverBusinessIntelligenceInstanceCreate(d *schema.R
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver businessIntelligenceInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudSqlserverBusinessIntelligenceInstanceRead(d, meta)
+	return resourceTencentCloudSqlserverBusinessIntelligenceInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverBusinessIntelligenceInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverBusinessIntelligenceInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_business_intelligence_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	businessIntelligenceInstance, err := service.DescribeSqlserverBusinessIntelligenceInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if businessIntelligenceInstance == nil {
@@ -439,7 +445,7 @@ func resourceTencentCloudSqlserverBusinessIntelligenceInstanceRead(d *schema.Res
 
 	maintenanceSpan, err := service.DescribeMaintenanceSpanById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if maintenanceSpan == nil {
@@ -463,7 +469,7 @@ func resourceTencentCloudSqlserverBusinessIntelligenceInstanceRead(d *schema.Res
 	return nil
 }
 
-func resourceTencentCloudSqlserverBusinessIntelligenceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverBusinessIntelligenceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_business_intelligence_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -471,7 +477,7 @@ func resourceTencentCloudSqlserverBusinessIntelligenceInstanceUpdate(d *schema.R
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -491,8 +497,8 @@ func resourceTencentCloudSqlserverBusinessIntelligenceInstanceUpdate(d *schema.R
 		return nil
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyDBInstanceName(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyDBInstanceNameWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -504,29 +510,29 @@ func resourceTencentCloudSqlserverBusinessIntelligenceInstanceUpdate(d *schema.R
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver businessIntelligenceInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverBusinessIntelligenceInstanceRead(d, meta)
+	return resourceTencentCloudSqlserverBusinessIntelligenceInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverBusinessIntelligenceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverBusinessIntelligenceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_business_intelligence_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if err := service.TerminateSqlserverInstanceById(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := service.DeleteSqlserverInstanceById(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -81,9 +81,12 @@ resource "tencentcloud_sqlserver_complete_expansion" "example" {
 package tencentcloud
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -92,9 +95,12 @@ import (
 
 func resourceTencentCloudSqlserverCompleteExpansion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverCompleteExpansionCreate,
-		Read:   resourceTencentCloudSqlserverCompleteExpansionRead,
-		Delete: resourceTencentCloudSqlserverCompleteExpansionDelete,
+		CreateContext: resourceTencentCloudSqlserverCompleteExpansionCreate,
+		ReadContext:   resourceTencentCloudSqlserverCompleteExpansionRead,
+		DeleteContext: resourceTencentCloudSqlserverCompleteExpansionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(50 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -107,7 +113,7 @@ func resourceTencentCloudSqlserverCompleteExpansion() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverCompleteExpansionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverCompleteExpansionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_complete_expansion.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -125,8 +131,8 @@ func resourceTencentCloudSqlserverCompleteExpansionCreate(d *schema.ResourceData
 		instanceId = v.(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().CompleteExpansion(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().CompleteExpansionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -145,12 +151,12 @@ func resourceTencentCloudSqlserverCompleteExpansionCreate(d *schema.ResourceData
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate sqlserver completeExpansion failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	flowRequest.FlowId = &flowId
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().DescribeFlowStatus(flowRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().DescribeFlowStatusWithContext(ctx, flowRequest)
 		if e != nil {
 			return retryError(e)
 		}
@@ -169,22 +175,22 @@ func resourceTencentCloudSqlserverCompleteExpansionCreate(d *schema.ResourceData
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver completeExpansion failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudSqlserverCompleteExpansionRead(d, meta)
+	return resourceTencentCloudSqlserverCompleteExpansionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverCompleteExpansionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverCompleteExpansionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_complete_expansion.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudSqlserverCompleteExpansionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverCompleteExpansionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_complete_expansion.delete")()
 	defer inconsistentCheck(d, meta)()
 
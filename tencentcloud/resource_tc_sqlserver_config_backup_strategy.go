@@ -111,7 +111,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -120,12 +122,15 @@ import (
 
 func resourceTencentCloudSqlserverConfigBackupStrategy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverConfigBackupStrategyCreate,
-		Read:   resourceTencentCloudSqlserverConfigBackupStrategyRead,
-		Update: resourceTencentCloudSqlserverConfigBackupStrategyUpdate,
-		Delete: resourceTencentCloudSqlserverConfigBackupStrategyDelete,
+		CreateContext: resourceTencentCloudSqlserverConfigBackupStrategyCreate,
+		ReadContext:   resourceTencentCloudSqlserverConfigBackupStrategyRead,
+		UpdateContext: resourceTencentCloudSqlserverConfigBackupStrategyUpdate,
+		DeleteContext: resourceTencentCloudSqlserverConfigBackupStrategyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -206,7 +211,7 @@ func resourceTencentCloudSqlserverConfigBackupStrategy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverConfigBackupStrategyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigBackupStrategyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_backup_strategy.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -217,16 +222,16 @@ func resourceTencentCloudSqlserverConfigBackupStrategyCreate(d *schema.ResourceD
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudSqlserverConfigBackupStrategyUpdate(d, meta)
+	return resourceTencentCloudSqlserverConfigBackupStrategyUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverConfigBackupStrategyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigBackupStrategyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_backup_strategy.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -234,7 +239,7 @@ func resourceTencentCloudSqlserverConfigBackupStrategyRead(d *schema.ResourceDat
 
 	configBackupStrategy, err := service.DescribeSqlserverConfigBackupStrategyById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if configBackupStrategy == nil {
@@ -294,7 +299,7 @@ func resourceTencentCloudSqlserverConfigBackupStrategyRead(d *schema.ResourceDat
 	return nil
 }
 
-func resourceTencentCloudSqlserverConfigBackupStrategyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigBackupStrategyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_backup_strategy.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -365,8 +370,8 @@ func resourceTencentCloudSqlserverConfigBackupStrategyUpdate(d *schema.ResourceD
 			request.RegularBackupStartTime = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyBackupStrategy(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyBackupStrategyWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -376,14 +381,14 @@ func resourceTencentCloudSqlserverConfigBackupStrategyUpdate(d *schema.ResourceD
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update sqlserver configBackupStrategy failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudSqlserverConfigBackupStrategyRead(d, meta)
+	return resourceTencentCloudSqlserverConfigBackupStrategyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverConfigBackupStrategyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigBackupStrategyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_backup_strategy.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -87,8 +87,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -97,12 +98,15 @@ import (
 
 func resourceTencentCloudSqlserverConfigDatabaseCDC() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverConfigDatabaseCDCCreate,
-		Read:   resourceTencentCloudSqlserverConfigDatabaseCDCRead,
-		Update: resourceTencentCloudSqlserverConfigDatabaseCDCUpdate,
-		Delete: resourceTencentCloudSqlserverConfigDatabaseCDCDelete,
+		CreateContext: resourceTencentCloudSqlserverConfigDatabaseCDCCreate,
+		ReadContext:   resourceTencentCloudSqlserverConfigDatabaseCDCRead,
+		UpdateContext: resourceTencentCloudSqlserverConfigDatabaseCDCUpdate,
+		DeleteContext: resourceTencentCloudSqlserverConfigDatabaseCDCDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(50 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"db_name": {
@@ -124,7 +128,7 @@ func resourceTencentCloudSqlserverConfigDatabaseCDC() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverConfigDatabaseCDCCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigDatabaseCDCCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_database_cdc.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -141,33 +145,33 @@ func resourceTencentCloudSqlserverConfigDatabaseCDCCreate(d *schema.ResourceData
 		dbName = v.(string)
 	}
 
-	d.SetId(strings.Join([]string{instanceId, dbName}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, dbName))
 
-	return resourceTencentCloudSqlserverConfigDatabaseCDCUpdate(d, meta)
+	return resourceTencentCloudSqlserverConfigDatabaseCDCUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverConfigDatabaseCDCRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigDatabaseCDCRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_database_cdc.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		Name       string
 		modifyType string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	dbName := idSplit[1]
 
 	configDatabaseCDC, err := service.DescribeSqlserverConfigDatabaseCDCById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if configDatabaseCDC == nil {
@@ -195,7 +199,7 @@ func resourceTencentCloudSqlserverConfigDatabaseCDCRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudSqlserverConfigDatabaseCDCUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigDatabaseCDCUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_database_cdc.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -206,9 +210,9 @@ func resourceTencentCloudSqlserverConfigDatabaseCDCUpdate(d *schema.ResourceData
 		flowId      int64
 	)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+		return diag.Errorf("id is broken,%s", idSplit)
 	}
 	instanceId := idSplit[0]
 	dbName := idSplit[1]
@@ -227,8 +231,8 @@ func resourceTencentCloudSqlserverConfigDatabaseCDCUpdate(d *schema.ResourceData
 	}
 
 	request.InstanceId = &instanceId
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyDatabaseCDC(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyDatabaseCDCWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -246,12 +250,12 @@ func resourceTencentCloudSqlserverConfigDatabaseCDCUpdate(d *schema.ResourceData
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver configDatabaseCDC failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	flowRequest.FlowId = &flowId
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().DescribeFlowStatus(flowRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().DescribeFlowStatusWithContext(ctx, flowRequest)
 		if e != nil {
 			return retryError(e)
 		}
@@ -269,13 +273,13 @@ func resourceTencentCloudSqlserverConfigDatabaseCDCUpdate(d *schema.ResourceData
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver configDatabaseCDC failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverConfigDatabaseCDCRead(d, meta)
+	return resourceTencentCloudSqlserverConfigDatabaseCDCRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverConfigDatabaseCDCDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigDatabaseCDCDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_database_cdc.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -77,8 +77,9 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -87,12 +88,15 @@ import (
 
 func resourceTencentCloudSqlserverConfigDatabaseCT() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverConfigDatabaseCTCreate,
-		Read:   resourceTencentCloudSqlserverConfigDatabaseCTRead,
-		Update: resourceTencentCloudSqlserverConfigDatabaseCTUpdate,
-		Delete: resourceTencentCloudSqlserverConfigDatabaseCTDelete,
+		CreateContext: resourceTencentCloudSqlserverConfigDatabaseCTCreate,
+		ReadContext:   resourceTencentCloudSqlserverConfigDatabaseCTRead,
+		UpdateContext: resourceTencentCloudSqlserverConfigDatabaseCTUpdate,
+		DeleteContext: resourceTencentCloudSqlserverConfigDatabaseCTDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(50 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"db_name": {
@@ -120,7 +124,7 @@ func resourceTencentCloudSqlserverConfigDatabaseCT() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverConfigDatabaseCTCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigDatabaseCTCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_database_c_t.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -137,35 +141,35 @@ func resourceTencentCloudSqlserverConfigDatabaseCTCreate(d *schema.ResourceData,
 		dbName = v.(string)
 	}
 
-	d.SetId(strings.Join([]string{instanceId, dbName}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, dbName))
 
-	return resourceTencentCloudSqlserverConfigDatabaseCTUpdate(d, meta)
+	return resourceTencentCloudSqlserverConfigDatabaseCTUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverConfigDatabaseCTRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigDatabaseCTRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_database_c_t.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId              = getLogId(contextNil)
-		ctx                = context.WithValue(context.TODO(), logIdKey, logId)
 		service            = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		Name               string
 		modifyType         string
 		retentionPeriod    string
 		changeRetentionDay int
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	dbName := idSplit[1]
 
 	configDatabaseCT, err := service.DescribeSqlserverConfigDatabaseCTById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if configDatabaseCT == nil {
@@ -196,7 +200,7 @@ func resourceTencentCloudSqlserverConfigDatabaseCTRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceTencentCloudSqlserverConfigDatabaseCTUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigDatabaseCTUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_database_c_t.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -207,9 +211,9 @@ func resourceTencentCloudSqlserverConfigDatabaseCTUpdate(d *schema.ResourceData,
 		flowId      int64
 	)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+		return diag.Errorf("id is broken,%s", idSplit)
 	}
 	instanceId := idSplit[0]
 	dbName := idSplit[1]
@@ -227,8 +231,8 @@ func resourceTencentCloudSqlserverConfigDatabaseCTUpdate(d *schema.ResourceData,
 	dbNames = append(dbNames, &dbName)
 	request.DBNames = dbNames
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyDatabaseCT(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyDatabaseCTWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -246,12 +250,12 @@ func resourceTencentCloudSqlserverConfigDatabaseCTUpdate(d *schema.ResourceData,
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver configDatabaseCT failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	flowRequest.FlowId = &flowId
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().DescribeFlowStatus(flowRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().DescribeFlowStatusWithContext(ctx, flowRequest)
 		if e != nil {
 			return retryError(e)
 		}
@@ -269,13 +273,13 @@ func resourceTencentCloudSqlserverConfigDatabaseCTUpdate(d *schema.ResourceData,
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver configDatabaseCT failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverConfigDatabaseCTRead(d, meta)
+	return resourceTencentCloudSqlserverConfigDatabaseCTRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverConfigDatabaseCTDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigDatabaseCTDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_database_c_t.delete")()
 	defer inconsistentCheck(d, meta)()
 
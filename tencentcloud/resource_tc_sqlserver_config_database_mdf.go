@@ -74,21 +74,26 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudSqlserverConfigDatabaseMdf() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverConfigDatabaseMdfCreate,
-		Read:   resourceTencentCloudSqlserverConfigDatabaseMdfRead,
-		Update: resourceTencentCloudSqlserverConfigDatabaseMdfUpdate,
-		Delete: resourceTencentCloudSqlserverConfigDatabaseMdfDelete,
+		CreateContext: resourceTencentCloudSqlserverConfigDatabaseMdfCreate,
+		ReadContext:   resourceTencentCloudSqlserverConfigDatabaseMdfRead,
+		UpdateContext: resourceTencentCloudSqlserverConfigDatabaseMdfUpdate,
+		DeleteContext: resourceTencentCloudSqlserverConfigDatabaseMdfDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(50 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"db_name": {
@@ -105,7 +110,7 @@ func resourceTencentCloudSqlserverConfigDatabaseMdf() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverConfigDatabaseMdfCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigDatabaseMdfCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_database_mdf.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -122,32 +127,32 @@ func resourceTencentCloudSqlserverConfigDatabaseMdfCreate(d *schema.ResourceData
 		dbName = v.(string)
 	}
 
-	d.SetId(strings.Join([]string{instanceId, dbName}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, dbName))
 
-	return resourceTencentCloudSqlserverConfigDatabaseMdfUpdate(d, meta)
+	return resourceTencentCloudSqlserverConfigDatabaseMdfUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverConfigDatabaseMdfRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigDatabaseMdfRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_database_mdf.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		Name    string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	dbName := idSplit[1]
 
 	configDatabaseMdf, err := service.DescribeSqlserverConfigDatabaseMdfById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if configDatabaseMdf == nil {
@@ -169,7 +174,7 @@ func resourceTencentCloudSqlserverConfigDatabaseMdfRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudSqlserverConfigDatabaseMdfUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigDatabaseMdfUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_database_mdf.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -180,9 +185,9 @@ func resourceTencentCloudSqlserverConfigDatabaseMdfUpdate(d *schema.ResourceData
 		flowId      int64
 	)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+		return diag.Errorf("id is broken,%s", idSplit)
 	}
 	instanceId := idSplit[0]
 	dbName := idSplit[1]
@@ -192,8 +197,8 @@ func resourceTencentCloudSqlserverConfigDatabaseMdfUpdate(d *schema.ResourceData
 	dbNames = append(dbNames, &dbName)
 	request.DBNames = dbNames
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyDatabaseMdf(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyDatabaseMdfWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -211,12 +216,12 @@ func resourceTencentCloudSqlserverConfigDatabaseMdfUpdate(d *schema.ResourceData
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver configDatabaseMdf failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	flowRequest.FlowId = &flowId
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().DescribeFlowStatus(flowRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().DescribeFlowStatusWithContext(ctx, flowRequest)
 		if e != nil {
 			return retryError(e)
 		}
@@ -235,13 +240,13 @@ func resourceTencentCloudSqlserverConfigDatabaseMdfUpdate(d *schema.ResourceData
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver configDatabaseMdf failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverConfigDatabaseMdfRead(d, meta)
+	return resourceTencentCloudSqlserverConfigDatabaseMdfRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverConfigDatabaseMdfDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigDatabaseMdfDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_database_mdf.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -70,8 +70,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -80,12 +81,15 @@ import (
 
 func resourceTencentCloudSqlserverConfigInstanceParam() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverConfigInstanceParamCreate,
-		Read:   resourceTencentCloudSqlserverConfigInstanceParamRead,
-		Update: resourceTencentCloudSqlserverConfigInstanceParamUpdate,
-		Delete: resourceTencentCloudSqlserverConfigInstanceParamDelete,
+		CreateContext: resourceTencentCloudSqlserverConfigInstanceParamCreate,
+		ReadContext:   resourceTencentCloudSqlserverConfigInstanceParamRead,
+		UpdateContext: resourceTencentCloudSqlserverConfigInstanceParamUpdate,
+		DeleteContext: resourceTencentCloudSqlserverConfigInstanceParamDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -116,7 +120,7 @@ func resourceTencentCloudSqlserverConfigInstanceParam() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverConfigInstanceParamCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigInstanceParamCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_instance_param.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -139,31 +143,31 @@ func resourceTencentCloudSqlserverConfigInstanceParamCreate(d *schema.ResourceDa
 		}
 	}
 
-	d.SetId(strings.Join(params, FILED_SP))
+	d.SetId(helper.IdFormat(params...))
 
-	return resourceTencentCloudSqlserverConfigInstanceParamUpdate(d, meta)
+	return resourceTencentCloudSqlserverConfigInstanceParamUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverConfigInstanceParamRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigInstanceParamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_instance_param.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) == 1 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+		return diag.Errorf("id is broken, id is %s", d.Id())
 	}
 	instanceId := idSplit[0]
 	params := idSplit[1:]
 
 	configInstanceParam, err := service.DescribeSqlserverConfigInstanceParamById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if configInstanceParam == nil {
@@ -196,7 +200,7 @@ func resourceTencentCloudSqlserverConfigInstanceParamRead(d *schema.ResourceData
 	return nil
 }
 
-func resourceTencentCloudSqlserverConfigInstanceParamUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigInstanceParamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_instance_param.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -205,9 +209,9 @@ func resourceTencentCloudSqlserverConfigInstanceParamUpdate(d *schema.ResourceDa
 		request = sqlserver.NewModifyInstanceParamRequest()
 	)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) == 1 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+		return diag.Errorf("id is broken, id is %s", d.Id())
 	}
 	instanceId := idSplit[0]
 
@@ -227,8 +231,8 @@ func resourceTencentCloudSqlserverConfigInstanceParamUpdate(d *schema.ResourceDa
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyInstanceParam(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyInstanceParamWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -245,13 +249,13 @@ func resourceTencentCloudSqlserverConfigInstanceParamUpdate(d *schema.ResourceDa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver configInstanceParam failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverConfigInstanceParamRead(d, meta)
+	return resourceTencentCloudSqlserverConfigInstanceParamRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverConfigInstanceParamDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigInstanceParamDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_instance_param.delete")()
 	defer inconsistentCheck(d, meta)()
 
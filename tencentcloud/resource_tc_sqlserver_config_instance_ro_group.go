@@ -89,8 +89,9 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -99,12 +100,15 @@ import (
 
 func resourceTencentCloudSqlserverConfigInstanceRoGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverConfigInstanceRoGroupCreate,
-		Read:   resourceTencentCloudSqlserverConfigInstanceRoGroupRead,
-		Update: resourceTencentCloudSqlserverConfigInstanceRoGroupUpdate,
-		Delete: resourceTencentCloudSqlserverConfigInstanceRoGroupDelete,
+		CreateContext: resourceTencentCloudSqlserverConfigInstanceRoGroupCreate,
+		ReadContext:   resourceTencentCloudSqlserverConfigInstanceRoGroupRead,
+		UpdateContext: resourceTencentCloudSqlserverConfigInstanceRoGroupUpdate,
+		DeleteContext: resourceTencentCloudSqlserverConfigInstanceRoGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -170,7 +174,7 @@ func resourceTencentCloudSqlserverConfigInstanceRoGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverConfigInstanceRoGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigInstanceRoGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_instance_ro_group.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -201,24 +205,24 @@ func resourceTencentCloudSqlserverConfigInstanceRoGroupCreate(d *schema.Resource
 		balanceWeight = "0"
 	}
 
-	d.SetId(strings.Join([]string{instanceId, readOnlyGroupId, autoWeight, balanceWeight}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, readOnlyGroupId, autoWeight, balanceWeight))
 
-	return resourceTencentCloudSqlserverConfigInstanceRoGroupUpdate(d, meta)
+	return resourceTencentCloudSqlserverConfigInstanceRoGroupUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverConfigInstanceRoGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigInstanceRoGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_instance_ro_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	readOnlyGroupId := idSplit[1]
@@ -227,7 +231,7 @@ func resourceTencentCloudSqlserverConfigInstanceRoGroupRead(d *schema.ResourceDa
 
 	configInstanceRoGroup, err := service.DescribeSqlserverConfigInstanceRoGroupById(ctx, instanceId, readOnlyGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if configInstanceRoGroup == nil {
@@ -290,7 +294,7 @@ func resourceTencentCloudSqlserverConfigInstanceRoGroupRead(d *schema.ResourceDa
 	return nil
 }
 
-func resourceTencentCloudSqlserverConfigInstanceRoGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigInstanceRoGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_instance_ro_group.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -299,9 +303,9 @@ func resourceTencentCloudSqlserverConfigInstanceRoGroupUpdate(d *schema.Resource
 		request = sqlserver.NewModifyReadOnlyGroupDetailsRequest()
 	)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+		return diag.Errorf("id is broken, id is %s", d.Id())
 	}
 	instanceId := idSplit[0]
 	readOnlyGroupId := idSplit[1]
@@ -347,8 +351,8 @@ func resourceTencentCloudSqlserverConfigInstanceRoGroupUpdate(d *schema.Resource
 		request.BalanceWeight = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyReadOnlyGroupDetails(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyReadOnlyGroupDetailsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -365,13 +369,13 @@ func resourceTencentCloudSqlserverConfigInstanceRoGroupUpdate(d *schema.Resource
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver configInstanceRoGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverConfigInstanceRoGroupRead(d, meta)
+	return resourceTencentCloudSqlserverConfigInstanceRoGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverConfigInstanceRoGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigInstanceRoGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_instance_ro_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
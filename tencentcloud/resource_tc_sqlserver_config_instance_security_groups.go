@@ -65,7 +65,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -74,12 +76,15 @@ import (
 
 func resourceTencentCloudSqlserverConfigInstanceSecurityGroups() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverConfigInstanceSecurityGroupsCreate,
-		Read:   resourceTencentCloudSqlserverConfigInstanceSecurityGroupsRead,
-		Update: resourceTencentCloudSqlserverConfigInstanceSecurityGroupsUpdate,
-		Delete: resourceTencentCloudSqlserverConfigInstanceSecurityGroupsDelete,
+		CreateContext: resourceTencentCloudSqlserverConfigInstanceSecurityGroupsCreate,
+		ReadContext:   resourceTencentCloudSqlserverConfigInstanceSecurityGroupsRead,
+		UpdateContext: resourceTencentCloudSqlserverConfigInstanceSecurityGroupsUpdate,
+		DeleteContext: resourceTencentCloudSqlserverConfigInstanceSecurityGroupsDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -97,7 +102,7 @@ func resourceTencentCloudSqlserverConfigInstanceSecurityGroups() *schema.Resourc
 	}
 }
 
-func resourceTencentCloudSqlserverConfigInstanceSecurityGroupsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigInstanceSecurityGroupsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_instance_security_groups.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -109,23 +114,23 @@ func resourceTencentCloudSqlserverConfigInstanceSecurityGroupsCreate(d *schema.R
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudSqlserverConfigInstanceSecurityGroupsUpdate(d, meta)
+	return resourceTencentCloudSqlserverConfigInstanceSecurityGroupsUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverConfigInstanceSecurityGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigInstanceSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_instance_security_groups.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	configInstanceSecurityGroups, err := service.DescribeSqlserverConfigInstanceSecurityGroupsById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if configInstanceSecurityGroups == nil {
@@ -145,7 +150,7 @@ func resourceTencentCloudSqlserverConfigInstanceSecurityGroupsRead(d *schema.Res
 	return nil
 }
 
-func resourceTencentCloudSqlserverConfigInstanceSecurityGroupsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigInstanceSecurityGroupsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_instance_security_groups.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -163,8 +168,8 @@ func resourceTencentCloudSqlserverConfigInstanceSecurityGroupsUpdate(d *schema.R
 
 	request.InstanceId = &instanceId
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyDBInstanceSecurityGroups(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyDBInstanceSecurityGroupsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -176,13 +181,13 @@ func resourceTencentCloudSqlserverConfigInstanceSecurityGroupsUpdate(d *schema.R
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver configInstanceSecurityGroups failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverConfigInstanceSecurityGroupsRead(d, meta)
+	return resourceTencentCloudSqlserverConfigInstanceSecurityGroupsRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverConfigInstanceSecurityGroupsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigInstanceSecurityGroupsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_instance_security_groups.delete")()
 	defer inconsistentCheck(d, meta)()
 
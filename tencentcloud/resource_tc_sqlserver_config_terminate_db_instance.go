@@ -65,7 +65,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -73,12 +75,15 @@ import (
 
 func resourceTencentCloudSqlserverConfigTerminateDBInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverConfigTerminateDBInstanceCreate,
-		Read:   resourceTencentCloudSqlserverConfigTerminateDBInstanceRead,
-		Update: resourceTencentCloudSqlserverConfigTerminateDBInstanceUpdate,
-		Delete: resourceTencentCloudSqlserverConfigTerminateDBInstanceDelete,
+		CreateContext: resourceTencentCloudSqlserverConfigTerminateDBInstanceCreate,
+		ReadContext:   resourceTencentCloudSqlserverConfigTerminateDBInstanceRead,
+		UpdateContext: resourceTencentCloudSqlserverConfigTerminateDBInstanceUpdate,
+		DeleteContext: resourceTencentCloudSqlserverConfigTerminateDBInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -90,7 +95,7 @@ func resourceTencentCloudSqlserverConfigTerminateDBInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverConfigTerminateDBInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigTerminateDBInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_terminate_db_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -102,23 +107,23 @@ func resourceTencentCloudSqlserverConfigTerminateDBInstanceCreate(d *schema.Reso
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudSqlserverConfigTerminateDBInstanceUpdate(d, meta)
+	return resourceTencentCloudSqlserverConfigTerminateDBInstanceUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverConfigTerminateDBInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigTerminateDBInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_terminate_db_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	configTerminateDBInstance, err := service.DescribeSqlserverConfigTerminateDBInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if configTerminateDBInstance == nil {
@@ -134,7 +139,7 @@ func resourceTencentCloudSqlserverConfigTerminateDBInstanceRead(d *schema.Resour
 	return nil
 }
 
-func resourceTencentCloudSqlserverConfigTerminateDBInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigTerminateDBInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_terminate_db_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -146,8 +151,8 @@ func resourceTencentCloudSqlserverConfigTerminateDBInstanceUpdate(d *schema.Reso
 
 	request.InstanceIdSet = []*string{&instanceId}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().TerminateDBInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().TerminateDBInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -159,13 +164,13 @@ func resourceTencentCloudSqlserverConfigTerminateDBInstanceUpdate(d *schema.Reso
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver configTerminateDBInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverConfigTerminateDBInstanceRead(d, meta)
+	return resourceTencentCloudSqlserverConfigTerminateDBInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverConfigTerminateDBInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverConfigTerminateDBInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_config_terminate_db_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -88,7 +88,9 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -97,12 +99,15 @@ import (
 
 func resourceTencentCloudSqlserverDatabaseTDE() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverDatabaseTDECreate,
-		Read:   resourceTencentCloudSqlserverDatabaseTDERead,
-		Update: resourceTencentCloudSqlserverDatabaseTDEUpdate,
-		Delete: resourceTencentCloudSqlserverDatabaseTDEDelete,
+		CreateContext: resourceTencentCloudSqlserverDatabaseTDECreate,
+		ReadContext:   resourceTencentCloudSqlserverDatabaseTDERead,
+		UpdateContext: resourceTencentCloudSqlserverDatabaseTDEUpdate,
+		DeleteContext: resourceTencentCloudSqlserverDatabaseTDEDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(50 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -125,7 +130,7 @@ func resourceTencentCloudSqlserverDatabaseTDE() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverDatabaseTDECreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverDatabaseTDECreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_database_tde.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -147,25 +152,25 @@ func resourceTencentCloudSqlserverDatabaseTDECreate(d *schema.ResourceData, meta
 	}
 
 	dbNameListStr := strings.Join(dbNameList, COMMA_SP)
-	d.SetId(strings.Join([]string{instanceId, dbNameListStr}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, dbNameListStr))
 
-	return resourceTencentCloudSqlserverDatabaseTDEUpdate(d, meta)
+	return resourceTencentCloudSqlserverDatabaseTDEUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverDatabaseTDERead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverDatabaseTDERead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_database_tde.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		encryption string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	dbNameListStr := idSplit[1]
@@ -173,7 +178,7 @@ func resourceTencentCloudSqlserverDatabaseTDERead(d *schema.ResourceData, meta i
 
 	databaseTDE, err := service.DescribeSqlserverDatabaseTDEById(ctx, instanceId, dbNameList)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if databaseTDE == nil {
@@ -201,7 +206,7 @@ func resourceTencentCloudSqlserverDatabaseTDERead(d *schema.ResourceData, meta i
 		}
 
 		if len(checkEncryption) != 1 {
-			return fmt.Errorf("sqlserver database tde encryption result is not normal, id is %s", d.Id())
+			return diag.Errorf("sqlserver database tde encryption result is not normal, id is %s", d.Id())
 		}
 
 		_ = d.Set("db_names", tmpList)
@@ -211,22 +216,22 @@ func resourceTencentCloudSqlserverDatabaseTDERead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudSqlserverDatabaseTDEUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverDatabaseTDEUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_database_tde.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = sqlserver.NewModifyDBEncryptAttributesRequest()
 		encryption string
 		flowId     int64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+		return diag.Errorf("id is broken, id is %s", d.Id())
 	}
 	instanceId := idSplit[0]
 	dbNameListStr := idSplit[1]
@@ -245,8 +250,8 @@ func resourceTencentCloudSqlserverDatabaseTDEUpdate(d *schema.ResourceData, meta
 		request.DBTDEEncrypt = append(request.DBTDEEncrypt, &parameter)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyDBEncryptAttributes(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyDBEncryptAttributesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -264,10 +269,10 @@ func resourceTencentCloudSqlserverDatabaseTDEUpdate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver databaseTDE failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, e := service.DescribeCloneStatusByFlowId(ctx, flowId)
 		if e != nil {
 			return retryError(e)
@@ -296,13 +301,13 @@ func resourceTencentCloudSqlserverDatabaseTDEUpdate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s sqlserver databaseTDE task fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverDatabaseTDERead(d, meta)
+	return resourceTencentCloudSqlserverDatabaseTDERead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverDatabaseTDEDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverDatabaseTDEDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_database_tde.delete")()
 	defer inconsistentCheck(d, meta)()
 
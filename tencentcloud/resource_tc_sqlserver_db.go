@@ -67,20 +67,20 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudSqlserverDB() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverDBCreate,
-		Read:   resourceTencentCloudSqlserverDBRead,
-		Update: resourceTencentCloudSqlserverDBUpdate,
-		Delete: resourceTencentCloudSqlserverDBDelete,
+		CreateContext: resourceTencentCloudSqlserverDBCreate,
+		ReadContext:   resourceTencentCloudSqlserverDBRead,
+		UpdateContext: resourceTencentCloudSqlserverDBUpdate,
+		DeleteContext: resourceTencentCloudSqlserverDBDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -123,21 +123,21 @@ func resourceTencentCloudSqlserverDB() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverDBCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverDBCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_db.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	instanceId := d.Get("instance_id").(string)
 	_, has, err := sqlserverService.DescribeSqlserverInstanceById(ctx, instanceId)
 	if err != nil {
-		return fmt.Errorf("[CRITAL]%s DescribeSqlserverInstanceById fail, reason:%s\n", logId, err)
+		return diag.Errorf("[CRITAL]%s DescribeSqlserverInstanceById fail, reason:%s\n", logId, err)
 	}
 	if !has {
-		return fmt.Errorf("[CRITAL]%s SQL Server instance %s dose not exist for DB creation", logId, instanceId)
+		return diag.Errorf("[CRITAL]%s SQL Server instance %s dose not exist for DB creation", logId, instanceId)
 	}
 
 	dbName := d.Get("name").(string)
@@ -145,31 +145,31 @@ func resourceTencentCloudSqlserverDBCreate(d *schema.ResourceData, meta interfac
 	remark := d.Get("remark").(string)
 
 	if err := sqlserverService.CreateSqlserverDB(ctx, instanceId, dbName, charset, remark); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(instanceId + FILED_SP + dbName)
-	return resourceTencentCloudSqlserverDBRead(d, meta)
+	d.SetId(helper.IdFormat(instanceId, dbName))
+	return resourceTencentCloudSqlserverDBRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverDBRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverDBRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_db.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	id := d.Id()
 	dbInfo, has, err := sqlserverService.DescribeDBDetailsById(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
 		return nil
 	}
-	idItem := strings.Split(id, FILED_SP)
+	idItem := helper.IdParse(id)
 	if len(idItem) < 2 {
-		return fmt.Errorf("broken ID %s of SQL Server DB", id)
+		return diag.Errorf("broken ID %s of SQL Server DB", id)
 	}
 	instanceId := idItem[0]
 	dbName := idItem[1]
@@ -183,29 +183,29 @@ func resourceTencentCloudSqlserverDBRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTencentCloudSqlserverDBUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverDBUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_db.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instanceId := d.Get("instance_id").(string)
 
 	if d.HasChange("remark") {
 		if err := sqlserverService.ModifySqlserverDBRemark(ctx, instanceId, d.Get("name").(string), d.Get("remark").(string)); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceTencentCloudSqlserverDBDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverDBDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_db.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instanceId := d.Get("instance_id").(string)
@@ -214,7 +214,7 @@ func resourceTencentCloudSqlserverDBDelete(d *schema.ResourceData, meta interfac
 	// precheck before delete
 	_, has, err := sqlserverService.DescribeSqlserverInstanceById(ctx, instanceId)
 	if err != nil {
-		return fmt.Errorf("[CRITAL]%s DescribeSqlserverInstanceById when deleting SQL Server DB fail, reason:%s\n", logId, err)
+		return diag.Errorf("[CRITAL]%s DescribeSqlserverInstanceById when deleting SQL Server DB fail, reason:%s\n", logId, err)
 	}
 	if !has {
 		return nil
@@ -222,11 +222,11 @@ func resourceTencentCloudSqlserverDBDelete(d *schema.ResourceData, meta interfac
 	id := d.Id()
 	_, has, err = sqlserverService.DescribeDBDetailsById(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		return nil
 	}
 
-	return sqlserverService.DeleteSqlserverDB(ctx, instanceId, []*string{&name})
+	return diag.FromErr(sqlserverService.DeleteSqlserverDB(ctx, instanceId, []*string{&name}))
 }
@@ -90,8 +90,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -100,12 +101,16 @@ import (
 
 func resourceTencentCloudSqlserverFullBackupMigration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverFullBackupMigrationCreate,
-		Read:   resourceTencentCloudSqlserverFullBackupMigrationRead,
-		Update: resourceTencentCloudSqlserverFullBackupMigrationUpdate,
-		Delete: resourceTencentCloudSqlserverFullBackupMigrationDelete,
+		CreateContext: resourceTencentCloudSqlserverFullBackupMigrationCreate,
+		ReadContext:   resourceTencentCloudSqlserverFullBackupMigrationRead,
+		UpdateContext: resourceTencentCloudSqlserverFullBackupMigrationUpdate,
+		DeleteContext: resourceTencentCloudSqlserverFullBackupMigrationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -143,7 +148,7 @@ func resourceTencentCloudSqlserverFullBackupMigration() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverFullBackupMigrationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverFullBackupMigrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_full_backup_migration.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -176,8 +181,8 @@ func resourceTencentCloudSqlserverFullBackupMigrationCreate(d *schema.ResourceDa
 		request.BackupFiles = helper.InterfacesStringsPoint(v.([]interface{}))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().CreateBackupMigration(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().CreateBackupMigrationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -195,28 +200,28 @@ func resourceTencentCloudSqlserverFullBackupMigrationCreate(d *schema.ResourceDa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver fullBackupMigration failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	backupMigrationId = *response.Response.BackupMigrationId
-	d.SetId(strings.Join([]string{instanceId, backupMigrationId}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, backupMigrationId))
 
-	return resourceTencentCloudSqlserverFullBackupMigrationRead(d, meta)
+	return resourceTencentCloudSqlserverFullBackupMigrationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverFullBackupMigrationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverFullBackupMigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_full_backup_migration.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := idSplit[0]
@@ -224,7 +229,7 @@ func resourceTencentCloudSqlserverFullBackupMigrationRead(d *schema.ResourceData
 
 	fullBackupMigration, err := service.DescribeSqlserverFullBackupMigrationById(ctx, instanceId, backupMigrationId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if fullBackupMigration == nil {
@@ -258,7 +263,7 @@ func resourceTencentCloudSqlserverFullBackupMigrationRead(d *schema.ResourceData
 	return nil
 }
 
-func resourceTencentCloudSqlserverFullBackupMigrationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverFullBackupMigrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_full_backup_migration.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -266,7 +271,7 @@ func resourceTencentCloudSqlserverFullBackupMigrationUpdate(d *schema.ResourceDa
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -275,9 +280,9 @@ func resourceTencentCloudSqlserverFullBackupMigrationUpdate(d *schema.ResourceDa
 		request = sqlserver.NewModifyBackupMigrationRequest()
 	)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+		return diag.Errorf("id is broken,%s", idSplit)
 	}
 
 	instanceId := idSplit[0]
@@ -304,8 +309,8 @@ func resourceTencentCloudSqlserverFullBackupMigrationUpdate(d *schema.ResourceDa
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyBackupMigration(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyBackupMigrationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -322,32 +327,32 @@ func resourceTencentCloudSqlserverFullBackupMigrationUpdate(d *schema.ResourceDa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver fullBackupMigration failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverFullBackupMigrationRead(d, meta)
+	return resourceTencentCloudSqlserverFullBackupMigrationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverFullBackupMigrationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverFullBackupMigrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_full_backup_migration.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := idSplit[0]
 	backupMigrationId := idSplit[1]
 
 	if err := service.DeleteSqlserverFullBackupMigrationById(ctx, instanceId, backupMigrationId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
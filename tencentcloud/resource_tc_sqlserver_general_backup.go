@@ -76,8 +76,9 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -86,12 +87,16 @@ import (
 
 func resourceTencentCloudSqlserverGeneralBackup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverGeneralBackupCreate,
-		Read:   resourceTencentCloudSqlserverGeneralBackupRead,
-		Update: resourceTencentCloudSqlserverGeneralBackupUpdate,
-		Delete: resourceTencentCloudSqlserverGeneralBackupDelete,
+		CreateContext: resourceTencentCloudSqlserverGeneralBackupCreate,
+		ReadContext:   resourceTencentCloudSqlserverGeneralBackupRead,
+		UpdateContext: resourceTencentCloudSqlserverGeneralBackupUpdate,
+		DeleteContext: resourceTencentCloudSqlserverGeneralBackupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(50 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"strategy": {
@@ -127,13 +132,12 @@ func resourceTencentCloudSqlserverGeneralBackup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverGeneralBackupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverGeneralBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_general_backup.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = sqlserver.NewCreateBackupRequest()
 		instanceId string
@@ -144,6 +148,7 @@ func resourceTencentCloudSqlserverGeneralBackupCreate(d *schema.ResourceData, me
 		fileName   string
 		err        error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("strategy"); ok {
 		request.Strategy = helper.IntInt64(v.(int))
@@ -166,8 +171,8 @@ func resourceTencentCloudSqlserverGeneralBackupCreate(d *schema.ResourceData, me
 		request.BackupName = helper.String(v.(string))
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().CreateBackup(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().CreateBackupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -184,11 +189,11 @@ func resourceTencentCloudSqlserverGeneralBackupCreate(d *schema.ResourceData, me
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver Backup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// waiting for backup done.
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := service.DescribeBackupByFlowId(ctx, instanceId, flowId)
 		if e != nil {
 			return retryError(e)
@@ -221,20 +226,19 @@ func resourceTencentCloudSqlserverGeneralBackupCreate(d *schema.ResourceData, me
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver Backup task fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{instanceId, strconv.Itoa(int(backupId)), flowId, startStr, endStr, fileName}, FILED_SP))
-	return resourceTencentCloudSqlserverGeneralBackupRead(d, meta)
+	d.SetId(helper.IdFormat(instanceId, strconv.Itoa(int(backupId)), flowId, startStr, endStr, fileName))
+	return resourceTencentCloudSqlserverGeneralBackupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverGeneralBackupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverGeneralBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_general_backup.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId string
 		flowId     string
@@ -243,10 +247,11 @@ func resourceTencentCloudSqlserverGeneralBackupRead(d *schema.ResourceData, meta
 		backupId   uint64
 		strategy   int64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 6 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 6)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId = idSplit[0]
@@ -258,7 +263,7 @@ func resourceTencentCloudSqlserverGeneralBackupRead(d *schema.ResourceData, meta
 
 	backupList, err := service.DescribeSqlserverBackupByBackupId(ctx, instanceId, startStr, endStr, backupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if backupList == nil {
@@ -290,7 +295,7 @@ func resourceTencentCloudSqlserverGeneralBackupRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudSqlserverGeneralBackupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverGeneralBackupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_general_backup.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -298,7 +303,7 @@ func resourceTencentCloudSqlserverGeneralBackupUpdate(d *schema.ResourceData, me
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -309,9 +314,9 @@ func resourceTencentCloudSqlserverGeneralBackupUpdate(d *schema.ResourceData, me
 		backupId   uint64
 	)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 6 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 
 	instanceId = idSplit[0]
@@ -327,8 +332,8 @@ func resourceTencentCloudSqlserverGeneralBackupUpdate(d *schema.ResourceData, me
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyBackupName(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyBackupNameWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -339,34 +344,34 @@ func resourceTencentCloudSqlserverGeneralBackupUpdate(d *schema.ResourceData, me
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver generalBackups failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverGeneralBackupRead(d, meta)
+	return resourceTencentCloudSqlserverGeneralBackupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverGeneralBackupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverGeneralBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_general_backup.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId string
 		fileName   string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 6 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 6)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId = idSplit[0]
 	fileName = idSplit[5]
 
 	if err := service.DeleteSqlserverGeneralBackupsById(ctx, instanceId, fileName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
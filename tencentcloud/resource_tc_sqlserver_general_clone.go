@@ -75,8 +75,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -86,12 +87,17 @@ import (
 
 func resourceTencentCloudSqlserverGeneralClone() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverGeneralCloneCreate,
-		Read:   resourceTencentCloudSqlserverGeneralCloneRead,
-		Update: resourceTencentCloudSqlserverGeneralCloneUpdate,
-		Delete: resourceTencentCloudSqlserverGeneralCloneDelete,
+		CreateContext: resourceTencentCloudSqlserverGeneralCloneCreate,
+		ReadContext:   resourceTencentCloudSqlserverGeneralCloneRead,
+		UpdateContext: resourceTencentCloudSqlserverGeneralCloneUpdate,
+		DeleteContext: resourceTencentCloudSqlserverGeneralCloneDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(50 * time.Minute),
+			Update: schema.DefaultTimeout(50 * time.Minute),
+			Delete: schema.DefaultTimeout(50 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -114,13 +120,12 @@ func resourceTencentCloudSqlserverGeneralClone() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverGeneralCloneCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverGeneralCloneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_general_clone.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = sqlserver.NewCloneDBRequest()
 		response   = sqlserver.NewCloneDBResponse()
@@ -129,6 +134,7 @@ func resourceTencentCloudSqlserverGeneralCloneCreate(d *schema.ResourceData, met
 		newName    string
 		flowId     int64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("instance_id"); ok {
 		request.InstanceId = helper.String(v.(string))
@@ -148,8 +154,8 @@ func resourceTencentCloudSqlserverGeneralCloneCreate(d *schema.ResourceData, met
 
 	request.RenameRestore = append(request.RenameRestore, &renameRestore)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().CloneDB(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().CloneDBWithContext(ctx, request)
 		if e != nil {
 			if sdkerr, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkerr.Code == "FailedOperation.DBError" {
@@ -172,11 +178,11 @@ func resourceTencentCloudSqlserverGeneralCloneCreate(d *schema.ResourceData, met
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver clone failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// wait for sqlserver clone done.
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := service.DescribeCloneStatusByFlowId(ctx, flowId)
 		if e != nil {
 			return retryError(e)
@@ -205,30 +211,30 @@ func resourceTencentCloudSqlserverGeneralCloneCreate(d *schema.ResourceData, met
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver clone task fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{instanceId, oldName, newName}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, oldName, newName))
 
-	return resourceTencentCloudSqlserverGeneralCloneRead(d, meta)
+	return resourceTencentCloudSqlserverGeneralCloneRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverGeneralCloneRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverGeneralCloneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_general_clone.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId string
 		oldName    string
 		newName    string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId = idSplit[0]
 	oldName = idSplit[1]
@@ -236,7 +242,7 @@ func resourceTencentCloudSqlserverGeneralCloneRead(d *schema.ResourceData, meta
 
 	generalClone, err := service.DescribeSqlserverGeneralCloneById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if generalClone == nil {
@@ -256,13 +262,12 @@ func resourceTencentCloudSqlserverGeneralCloneRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudSqlserverGeneralCloneUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverGeneralCloneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_general_clone.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = sqlserver.NewModifyDBNameRequest()
 		instanceId string
@@ -270,10 +275,11 @@ func resourceTencentCloudSqlserverGeneralCloneUpdate(d *schema.ResourceData, met
 		oldName    string
 		newName    string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	instanceId = idSplit[0]
 
@@ -292,8 +298,8 @@ func resourceTencentCloudSqlserverGeneralCloneUpdate(d *schema.ResourceData, met
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyDBName(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyDBNameWithContext(ctx, request)
 		if e != nil {
 
 			return retryError(e)
@@ -312,11 +318,11 @@ func resourceTencentCloudSqlserverGeneralCloneUpdate(d *schema.ResourceData, met
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver general clone failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// wait for sqlserver clone update done.
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, e := service.DescribeCloneStatusByFlowId(ctx, flowId)
 		if e != nil {
 			return retryError(e)
@@ -345,45 +351,45 @@ func resourceTencentCloudSqlserverGeneralCloneUpdate(d *schema.ResourceData, met
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver clone task fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{instanceId, oldName, newName}, FILED_SP))
-	return resourceTencentCloudSqlserverGeneralCloneRead(d, meta)
+	d.SetId(helper.IdFormat(instanceId, oldName, newName))
+	return resourceTencentCloudSqlserverGeneralCloneRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverGeneralCloneDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverGeneralCloneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_general_clone.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId string
 		dbName     string
 		flowId     int64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId = idSplit[0]
 	dbName = idSplit[2]
 
 	result, err := service.DeleteSqlserverGeneralCloneDB(ctx, instanceId, dbName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if result == nil {
-		return fmt.Errorf("delete sqlserver clone task failed")
+		return diag.Errorf("delete sqlserver clone task failed")
 	}
 
 	flowId = *result.Response.FlowId
 	// wait for sqlserver clone delete done.
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		cloneStatus, e := service.DescribeCloneStatusByFlowId(ctx, flowId)
 		if e != nil {
 			return retryError(e)
@@ -412,7 +418,7 @@ func resourceTencentCloudSqlserverGeneralCloneDelete(d *schema.ResourceData, met
 
 	if err != nil {
 		log.Printf("[CRITAL]%s delete sqlserver clone task fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -63,9 +63,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -74,12 +75,16 @@ import (
 
 func resourceTencentCloudSqlserverGeneralCloudInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverGeneralCloudInstanceCreate,
-		Read:   resourceTencentCloudSqlserverGeneralCloudInstanceRead,
-		Update: resourceTencentCloudSqlserverGeneralCloudInstanceUpdate,
-		Delete: resourceTencentCloudSqlserverGeneralCloudInstanceDelete,
+		CreateContext: resourceTencentCloudSqlserverGeneralCloudInstanceCreate,
+		ReadContext:   resourceTencentCloudSqlserverGeneralCloudInstanceRead,
+		UpdateContext: resourceTencentCloudSqlserverGeneralCloudInstanceUpdate,
+		DeleteContext: resourceTencentCloudSqlserverGeneralCloudInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -212,19 +217,19 @@ func resourceTencentCloudSqlserverGeneralCloudInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverGeneralCloudInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverGeneralCloudInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_general_cloud_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId        = getLogId(contextNil)
-		ctx          = context.WithValue(context.TODO(), logIdKey, logId)
 		service      = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request      = sqlserver.NewCreateCloudDBInstancesRequest()
 		instanceId   string
 		instanceName string
 		dealId       string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("name"); ok {
 		instanceName = v.(string)
@@ -327,8 +332,8 @@ func resourceTencentCloudSqlserverGeneralCloudInstanceCreate(d *schema.ResourceD
 		request.TimeZone = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().CreateCloudDBInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().CreateCloudDBInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -341,16 +346,16 @@ func resourceTencentCloudSqlserverGeneralCloudInstanceCreate(d *schema.ResourceD
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver generalCloudInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId, err = service.GetInfoFromDeal(ctx, dealId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// set name
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		inErr := service.ModifySqlserverInstanceName(ctx, instanceId, instanceName)
 		if inErr != nil {
 			return retryError(inErr)
@@ -360,28 +365,28 @@ func resourceTencentCloudSqlserverGeneralCloudInstanceCreate(d *schema.ResourceD
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudSqlserverGeneralCloudInstanceRead(d, meta)
+	return resourceTencentCloudSqlserverGeneralCloudInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverGeneralCloudInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverGeneralCloudInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_general_cloud_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	generalCloudInstance, err := service.DescribeSqlserverGeneralCloudInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if generalCloudInstance == nil {
@@ -499,7 +504,7 @@ func resourceTencentCloudSqlserverGeneralCloudInstanceRead(d *schema.ResourceDat
 
 	maintenanceSpan, err := service.DescribeMaintenanceSpanById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if maintenanceSpan == nil {
@@ -522,7 +527,7 @@ func resourceTencentCloudSqlserverGeneralCloudInstanceRead(d *schema.ResourceDat
 
 	securityGroupList, err := service.DescribeInstanceSecurityGroups(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if securityGroupList == nil {
@@ -538,13 +543,12 @@ func resourceTencentCloudSqlserverGeneralCloudInstanceRead(d *schema.ResourceDat
 	return nil
 }
 
-func resourceTencentCloudSqlserverGeneralCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverGeneralCloudInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_general_cloud_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId            = getLogId(contextNil)
-		ctx              = context.WithValue(context.TODO(), logIdKey, logId)
 		client           = meta.(*TencentCloudClient).apiV3Conn
 		sqlserverService = SqlserverService{client: client}
 		request          = sqlserver.NewUpgradeDBInstanceRequest()
@@ -553,13 +557,14 @@ func resourceTencentCloudSqlserverGeneralCloudInstanceUpdate(d *schema.ResourceD
 		dealId           string
 		instanceName     string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request.InstanceId = &instanceId
 	immutableArgs := []string{"zone", "machine_type", "instance_charge_type", "project_id", "subnet_id", "vpc_id", "period", "security_group_list", "weekly", "start_time", "span", "resource_tags", "collation", "time_zone"}
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -568,7 +573,7 @@ func resourceTencentCloudSqlserverGeneralCloudInstanceUpdate(d *schema.ResourceD
 			instanceName = v.(string)
 
 			// set name
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				inErr := sqlserverService.ModifySqlserverInstanceName(ctx, instanceId, instanceName)
 				if inErr != nil {
 					return retryError(inErr)
@@ -578,7 +583,7 @@ func resourceTencentCloudSqlserverGeneralCloudInstanceUpdate(d *schema.ResourceD
 			})
 
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -610,8 +615,8 @@ func resourceTencentCloudSqlserverGeneralCloudInstanceUpdate(d *schema.ResourceD
 	waitSwitch = 0
 	request.WaitSwitch = &waitSwitch
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().UpgradeDBInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().UpgradeDBInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -624,34 +629,34 @@ func resourceTencentCloudSqlserverGeneralCloudInstanceUpdate(d *schema.ResourceD
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver generalCloudInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, err = sqlserverService.GetInfoFromDeal(ctx, dealId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverGeneralCloudInstanceRead(d, meta)
+	return resourceTencentCloudSqlserverGeneralCloudInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverGeneralCloudInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverGeneralCloudInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_general_cloud_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if err := service.TerminateSqlserverInstanceById(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := service.DeleteSqlserverInstanceById(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -125,6 +125,14 @@ resource "tencentcloud_sqlserver_general_cloud_ro_instance" "example" {
   }
 }
 ```
+
+Import
+
+sqlserver general_cloud_ro_instance can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_sqlserver_general_cloud_ro_instance.example mssql-gyg9xycl#mssqlro-o3cd7ypl
+```
 */
 package tencentcloud
 
@@ -132,9 +140,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -143,16 +151,19 @@ import (
 
 func resourceTencentCloudSqlserverGeneralCloudRoInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverGeneralCloudRoInstanceCreate,
-		Read:   resourceTencentCloudSqlserverGeneralCloudRoInstanceRead,
-		Update: resourceTencentCloudSqlserverGeneralCloudRoInstanceUpdate,
-		Delete: resourceTencentCloudSqlserverGeneralCloudRoInstanceDelete,
+		CreateContext: resourceTencentCloudSqlserverGeneralCloudRoInstanceCreate,
+		ReadContext:   resourceTencentCloudSqlserverGeneralCloudRoInstanceRead,
+		UpdateContext: resourceTencentCloudSqlserverGeneralCloudRoInstanceUpdate,
+		DeleteContext: resourceTencentCloudSqlserverGeneralCloudRoInstanceDelete,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(CreateDefaultTimeout * time.Second),
 			Read:   schema.DefaultTimeout(ReadDefaultTimeout * time.Second),
 			Update: schema.DefaultTimeout(UpdateDefaultTimeout * time.Second),
 			Delete: schema.DefaultTimeout(DeleteDefaultTimeout * time.Second),
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -272,13 +283,12 @@ func resourceTencentCloudSqlserverGeneralCloudRoInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverGeneralCloudRoInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverGeneralCloudRoInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_general_cloud_ro_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId        = getLogId(contextNil)
-		ctx          = context.WithValue(context.TODO(), logIdKey, logId)
 		service      = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request      = sqlserver.NewCreateCloudReadOnlyDBInstancesRequest()
 		response     = sqlserver.NewCreateCloudReadOnlyDBInstancesResponse()
@@ -287,6 +297,7 @@ func resourceTencentCloudSqlserverGeneralCloudRoInstanceCreate(d *schema.Resourc
 		roInstanceId string
 		dealNames    string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("instance_id"); ok {
 		request.InstanceId = helper.String(v.(string))
@@ -380,8 +391,8 @@ func resourceTencentCloudSqlserverGeneralCloudRoInstanceCreate(d *schema.Resourc
 
 	request.GoodsNum = helper.IntInt64(1)
 
-	err := resource.Retry(timeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().CreateCloudReadOnlyDBInstances(request)
+	err := retryContext(ctx, timeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().CreateCloudReadOnlyDBInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -394,13 +405,13 @@ func resourceTencentCloudSqlserverGeneralCloudRoInstanceCreate(d *schema.Resourc
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver generalCloudRoInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	dealNames = *response.Response.DealNames[0]
 	roInstanceId, err = service.GetInfoFromDeal(ctx, dealNames, timeout)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if tags := helper.GetTags(d, "resource_tags"); len(tags) > 0 {
@@ -408,35 +419,35 @@ func resourceTencentCloudSqlserverGeneralCloudRoInstanceCreate(d *schema.Resourc
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::sqlserver:%s:uin/:instance/%s", region, roInstanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	d.SetId(strings.Join([]string{instanceId, roInstanceId}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, roInstanceId))
 
-	return resourceTencentCloudSqlserverGeneralCloudRoInstanceRead(d, meta)
+	return resourceTencentCloudSqlserverGeneralCloudRoInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverGeneralCloudRoInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverGeneralCloudRoInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_general_cloud_ro_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	roInstanceId := idSplit[1]
 
 	generalCloudRoInstance, err := service.DescribeSqlserverGeneralCloudRoInstanceById(ctx, roInstanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if generalCloudRoInstance == nil {
@@ -499,14 +510,14 @@ func resourceTencentCloudSqlserverGeneralCloudRoInstanceRead(d *schema.ResourceD
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "sqlserver", "instance", tcClient.Region, roInstanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("resource_tags", tags)
 
 	securityGroupList, err := service.DescribeInstanceSecurityGroups(ctx, roInstanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if securityGroupList == nil {
@@ -521,7 +532,7 @@ func resourceTencentCloudSqlserverGeneralCloudRoInstanceRead(d *schema.ResourceD
 
 	roGroupList, err := service.DescribeReadonlyGroupList(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if roGroupList == nil {
@@ -560,23 +571,23 @@ func resourceTencentCloudSqlserverGeneralCloudRoInstanceRead(d *schema.ResourceD
 	return nil
 }
 
-func resourceTencentCloudSqlserverGeneralCloudRoInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverGeneralCloudRoInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_general_cloud_ro_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId            = getLogId(contextNil)
-		ctx              = context.WithValue(context.TODO(), logIdKey, logId)
 		client           = meta.(*TencentCloudClient).apiV3Conn
 		sqlserverService = SqlserverService{client: client}
 		request          = sqlserver.NewUpgradeDBInstanceRequest()
 		timeout          = d.Timeout(schema.TimeoutUpdate)
 		dealId           string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	roInstanceId := idSplit[1]
 
@@ -584,7 +595,7 @@ func resourceTencentCloudSqlserverGeneralCloudRoInstanceUpdate(d *schema.Resourc
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -604,8 +615,8 @@ func resourceTencentCloudSqlserverGeneralCloudRoInstanceUpdate(d *schema.Resourc
 			request.Cpu = helper.IntInt64(v.(int))
 		}
 
-		err := resource.Retry(timeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().UpgradeDBInstance(request)
+		err := retryContext(ctx, timeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().UpgradeDBInstanceWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -618,40 +629,40 @@ func resourceTencentCloudSqlserverGeneralCloudRoInstanceUpdate(d *schema.Resourc
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update sqlserver generalCloudRoInstance failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		_, err = sqlserverService.GetInfoFromDeal(ctx, dealId, timeout)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudSqlserverGeneralCloudRoInstanceRead(d, meta)
+	return resourceTencentCloudSqlserverGeneralCloudRoInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverGeneralCloudRoInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverGeneralCloudRoInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_general_cloud_ro_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	roInstanceId := idSplit[1]
 
 	if err := service.TerminateSqlserverInstance(ctx, roInstanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := service.DeleteSqlserverGeneralCloudRoInstanceById(ctx, roInstanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
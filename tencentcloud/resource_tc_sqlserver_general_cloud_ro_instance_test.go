@@ -3,13 +3,13 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 // go test -i; go test -timeout=0 -test.run TestAccTencentCloudNeedFixSqlserverGeneralCloudRoInstanceResource_basic -v
@@ -36,6 +36,12 @@ func TestAccTencentCloudNeedFixSqlserverGeneralCloudRoInstanceResource_basic(t *
 					resource.TestCheckResourceAttrSet("tencentcloud_sqlserver_general_cloud_ro_instance.example", "id"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_sqlserver_general_cloud_ro_instance.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"read_only_group_type", "period"},
+			},
 		},
 	})
 }
@@ -49,7 +55,7 @@ func testAccCheckSqlserverGeneralCloudRoInstanceDestroy(s *terraform.State) erro
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 		service := SqlserverService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
 
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		if len(idSplit) != 2 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
@@ -84,7 +90,7 @@ func testAccCheckSqlserverRoInstanceExists(n string) resource.TestCheckFunc {
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 		service := SqlserverService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
 
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		if len(idSplit) != 2 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
@@ -66,7 +66,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -75,11 +77,15 @@ import (
 
 func resourceTencentCloudSqlserverGeneralCommunication() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverGeneralCommunicationCreate,
-		Read:   resourceTencentCloudSqlserverGeneralCommunicationRead,
-		Delete: resourceTencentCloudSqlserverGeneralCommunicationDelete,
+		CreateContext: resourceTencentCloudSqlserverGeneralCommunicationCreate,
+		ReadContext:   resourceTencentCloudSqlserverGeneralCommunicationRead,
+		DeleteContext: resourceTencentCloudSqlserverGeneralCommunicationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(50 * time.Minute),
+			Delete: schema.DefaultTimeout(50 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -92,7 +98,7 @@ func resourceTencentCloudSqlserverGeneralCommunication() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverGeneralCommunicationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverGeneralCommunicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_general_communication.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -107,8 +113,8 @@ func resourceTencentCloudSqlserverGeneralCommunicationCreate(d *schema.ResourceD
 		request.InstanceIdSet = append(request.InstanceIdSet, helper.String(v.(string)))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().OpenInterCommunication(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().OpenInterCommunicationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -126,14 +132,14 @@ func resourceTencentCloudSqlserverGeneralCommunicationCreate(d *schema.ResourceD
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver generalCommunication failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId := *response.Response.InterInstanceFlowSet[0].InstanceId
 	flowId := *response.Response.InterInstanceFlowSet[0].FlowId
 	flowRequest.FlowId = &flowId
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().DescribeFlowStatus(flowRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().DescribeFlowStatusWithContext(ctx, flowRequest)
 		if e != nil {
 			return retryError(e)
 		}
@@ -151,28 +157,28 @@ func resourceTencentCloudSqlserverGeneralCommunicationCreate(d *schema.ResourceD
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver generalCommunication failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudSqlserverGeneralCommunicationRead(d, meta)
+	return resourceTencentCloudSqlserverGeneralCommunicationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverGeneralCommunicationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverGeneralCommunicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_general_communication.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	generalCommunication, err := service.DescribeSqlserverGeneralCommunicationById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if generalCommunication == nil {
@@ -188,27 +194,27 @@ func resourceTencentCloudSqlserverGeneralCommunicationRead(d *schema.ResourceDat
 	return nil
 }
 
-func resourceTencentCloudSqlserverGeneralCommunicationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverGeneralCommunicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_general_communication.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId       = getLogId(contextNil)
-		ctx         = context.WithValue(context.TODO(), logIdKey, logId)
 		service     = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		flowRequest = sqlserver.NewDescribeFlowStatusRequest()
 		instanceId  = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	flowId, err := service.DeleteSqlserverGeneralCommunicationById(ctx, instanceId)
 	if err != nil {
 		log.Printf("[CRITAL]%s delete sqlserver generalCommunication failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	flowRequest.FlowId = &flowId
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().DescribeFlowStatus(flowRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().DescribeFlowStatusWithContext(ctx, flowRequest)
 		if e != nil {
 			return retryError(e)
 		}
@@ -226,7 +232,7 @@ func resourceTencentCloudSqlserverGeneralCommunicationDelete(d *schema.ResourceD
 
 	if err != nil {
 		log.Printf("[CRITAL]%s delete sqlserver generalCommunication status failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -26,8 +26,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -36,12 +37,16 @@ import (
 
 func resourceTencentCloudSqlserverIncreBackupMigration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverIncreBackupMigrationCreate,
-		Read:   resourceTencentCloudSqlserverIncreBackupMigrationRead,
-		Update: resourceTencentCloudSqlserverIncreBackupMigrationUpdate,
-		Delete: resourceTencentCloudSqlserverIncreBackupMigrationDelete,
+		CreateContext: resourceTencentCloudSqlserverIncreBackupMigrationCreate,
+		ReadContext:   resourceTencentCloudSqlserverIncreBackupMigrationRead,
+		UpdateContext: resourceTencentCloudSqlserverIncreBackupMigrationUpdate,
+		DeleteContext: resourceTencentCloudSqlserverIncreBackupMigrationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -75,7 +80,7 @@ func resourceTencentCloudSqlserverIncreBackupMigration() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverIncreBackupMigrationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverIncreBackupMigrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_incre_backup_migration.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -105,8 +110,8 @@ func resourceTencentCloudSqlserverIncreBackupMigrationCreate(d *schema.ResourceD
 		request.IsRecovery = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().CreateIncrementalMigration(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().CreateIncrementalMigrationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -124,27 +129,27 @@ func resourceTencentCloudSqlserverIncreBackupMigrationCreate(d *schema.ResourceD
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver increBackupMigration failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{instanceId, backupMigrationId, *response.Response.IncrementalMigrationId}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, backupMigrationId, *response.Response.IncrementalMigrationId))
 
-	return resourceTencentCloudSqlserverIncreBackupMigrationRead(d, meta)
+	return resourceTencentCloudSqlserverIncreBackupMigrationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverIncreBackupMigrationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverIncreBackupMigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_incre_backup_migration.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := idSplit[0]
@@ -153,7 +158,7 @@ func resourceTencentCloudSqlserverIncreBackupMigrationRead(d *schema.ResourceDat
 
 	increBackupMigration, err := service.DescribeSqlserverIncreBackupMigrationById(ctx, instanceId, backupMigrationId, incrementalMigrationId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if increBackupMigration == nil {
@@ -181,7 +186,7 @@ func resourceTencentCloudSqlserverIncreBackupMigrationRead(d *schema.ResourceDat
 	return nil
 }
 
-func resourceTencentCloudSqlserverIncreBackupMigrationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverIncreBackupMigrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_incre_backup_migration.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -189,7 +194,7 @@ func resourceTencentCloudSqlserverIncreBackupMigrationUpdate(d *schema.ResourceD
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -198,9 +203,9 @@ func resourceTencentCloudSqlserverIncreBackupMigrationUpdate(d *schema.ResourceD
 		request = sqlserver.NewModifyIncrementalMigrationRequest()
 	)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+		return diag.Errorf("id is broken,%s", idSplit)
 	}
 
 	instanceId := idSplit[0]
@@ -223,8 +228,8 @@ func resourceTencentCloudSqlserverIncreBackupMigrationUpdate(d *schema.ResourceD
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyIncrementalMigration(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyIncrementalMigrationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -236,25 +241,25 @@ func resourceTencentCloudSqlserverIncreBackupMigrationUpdate(d *schema.ResourceD
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver increBackupMigration failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverIncreBackupMigrationRead(d, meta)
+	return resourceTencentCloudSqlserverIncreBackupMigrationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverIncreBackupMigrationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverIncreBackupMigrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_incre_backup_migration.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := idSplit[0]
@@ -262,7 +267,7 @@ func resourceTencentCloudSqlserverIncreBackupMigrationDelete(d *schema.ResourceD
 	incrementalMigrationId := idSplit[2]
 
 	if err := service.DeleteSqlserverIncreBackupMigrationById(ctx, instanceId, backupMigrationId, incrementalMigrationId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
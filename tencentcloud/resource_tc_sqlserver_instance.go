@@ -47,7 +47,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -230,10 +232,15 @@ func resourceTencentCloudSqlserverInstance() *schema.Resource {
 		specialInfo[k] = v
 	}
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverInstanceCreate,
-		Read:   resourceTencentCloudSqlserverInstanceRead,
-		Update: resourceTencentCloudSqlserverInstanceUpdate,
-		Delete: resourceTencentCLoudSqlserverInstanceDelete,
+		CreateContext: resourceTencentCloudSqlserverInstanceCreate,
+		ReadContext:   resourceTencentCloudSqlserverInstanceRead,
+		UpdateContext: resourceTencentCloudSqlserverInstanceUpdate,
+		DeleteContext: resourceTencentCLoudSqlserverInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
 			State: helper.ImportWithDefaultValue(map[string]interface{}{
 				"auto_voucher": 0,
@@ -243,11 +250,11 @@ func resourceTencentCloudSqlserverInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_instance.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := meta.(*TencentCloudClient).apiV3Conn
 	sqlserverService := SqlserverService{client: client}
@@ -345,7 +352,7 @@ func resourceTencentCloudSqlserverInstanceCreate(d *schema.ResourceData, meta in
 	request.GoodsNum = helper.IntInt64(1)
 	request.Zone = &zone
 
-	outErr = resource.Retry(6*writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instanceId, inErr = sqlserverService.CreateSqlserverInstance(ctx, request)
 		if inErr != nil {
 			return retryError(inErr)
@@ -353,13 +360,13 @@ func resourceTencentCloudSqlserverInstanceCreate(d *schema.ResourceData, meta in
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	d.SetId(instanceId)
 
 	//set name
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		inErr := sqlserverService.ModifySqlserverInstanceName(ctx, instanceId, name)
 		if inErr != nil {
 			return retryError(inErr)
@@ -367,16 +374,16 @@ func resourceTencentCloudSqlserverInstanceCreate(d *schema.ResourceData, meta in
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		resourceName := BuildTagResourceName("sqlserver", "instance", region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudSqlserverInstanceRead(d, meta)
+	return resourceTencentCloudSqlserverInstanceRead(ctx, d, meta)
 }
 
 func sqlServerAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
@@ -405,7 +412,7 @@ func sqlServerAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 	//update name
 	if d.HasChange("name") {
 		name := d.Get("name").(string)
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 			inErr = sqlserverService.ModifySqlserverInstanceName(ctx, instanceId, name)
 			if inErr != nil {
 				return retryError(inErr)
@@ -426,7 +433,7 @@ func sqlServerAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 
 		for _, v := range oldSet {
 			sgId := v.(string)
-			outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			outErr = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 				inErr := sqlserverService.RemoveSecurityGroup(ctx, instanceId, sgId)
 				if inErr != nil {
 					return retryError(inErr)
@@ -439,7 +446,7 @@ func sqlServerAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 		for _, v := range newSet {
 			sgId := v.(string)
-			outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			outErr = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 				inErr := sqlserverService.AddSecurityGroup(ctx, instanceId, sgId)
 				if inErr != nil {
 					return retryError(inErr)
@@ -465,7 +472,7 @@ func sqlServerAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
-func sqlServerAllInstanceNetUpdate(d *schema.ResourceData, meta interface{}) error {
+func sqlServerAllInstanceNetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	var (
 		logId       = getLogId(contextNil)
 		request     = sqlserver.NewModifyDBInstanceNetworkRequest()
@@ -480,8 +487,8 @@ func sqlServerAllInstanceNetUpdate(d *schema.ResourceData, meta interface{}) err
 		request.InstanceId = &instanceId
 		request.NewVpcId = &vpcId
 		request.NewSubnetId = &subnetId
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyDBInstanceNetwork(request)
+		err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyDBInstanceNetworkWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -503,8 +510,8 @@ func sqlServerAllInstanceNetUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 
 		flowRequest.FlowId = &flowId
-		err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().DescribeFlowStatus(flowRequest)
+		err = retryContext(ctx, 10*writeRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().DescribeFlowStatusWithContext(ctx, flowRequest)
 			if e != nil {
 				return retryError(e)
 			}
@@ -529,21 +536,21 @@ func sqlServerAllInstanceNetUpdate(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceTencentCloudSqlserverInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_instance.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	d.Partial(true)
 
 	//basic info update
 	if err := sqlServerAllInstanceRoleUpdate(ctx, d, meta); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	//update network
-	if err := sqlServerAllInstanceNetUpdate(d, meta); err != nil {
-		return err
+	if err := sqlServerAllInstanceNetUpdate(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
 	}
 
 	var outErr, inErr error
@@ -556,7 +563,7 @@ func resourceTencentCloudSqlserverInstanceUpdate(d *schema.ResourceData, meta in
 	//update project id
 	if d.HasChange("project_id") {
 		projectId := d.Get("project_id").(int)
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = sqlserverService.ModifySqlserverInstanceProjectId(ctx, instanceId, projectId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -564,7 +571,7 @@ func resourceTencentCloudSqlserverInstanceUpdate(d *schema.ResourceData, meta in
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 	}
@@ -580,7 +587,7 @@ func resourceTencentCloudSqlserverInstanceUpdate(d *schema.ResourceData, meta in
 		startTime := d.Get("maintenance_start_time").(string)
 		timeSpan := d.Get("maintenance_time_span").(int)
 
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = sqlserverService.ModifySqlserverInstanceMaintenanceSpan(ctx, instanceId, weekSet, startTime, timeSpan)
 			if inErr != nil {
 				return retryError(inErr)
@@ -588,7 +595,7 @@ func resourceTencentCloudSqlserverInstanceUpdate(d *schema.ResourceData, meta in
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 	}
@@ -598,14 +605,14 @@ func resourceTencentCloudSqlserverInstanceUpdate(d *schema.ResourceData, meta in
 
 		resourceName := BuildTagResourceName("sqlserver", "instance", region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudSqlserverInstanceRead(d, meta)
+	return resourceTencentCloudSqlserverInstanceRead(ctx, d, meta)
 }
 func tencentSqlServerBasicInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) (instance *sqlserver.DBInstance,
 	has bool, errRet error) {
@@ -619,7 +626,7 @@ func tencentSqlServerBasicInfoRead(ctx context.Context, d *schema.ResourceData,
 	var outErr, inErr error
 	instance, has, outErr = sqlserverService.DescribeSqlserverInstanceById(ctx, d.Id())
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			instance, has, inErr = sqlserverService.DescribeSqlserverInstanceById(ctx, instanceId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -652,7 +659,7 @@ func tencentSqlServerBasicInfoRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	var securityGroup []string
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		securityGroup, inErr = sqlserverService.DescribeInstanceSecurityGroups(ctx, instanceId)
 		if inErr != nil {
 			return retryError(inErr)
@@ -675,11 +682,11 @@ func tencentSqlServerBasicInfoRead(ctx context.Context, d *schema.ResourceData,
 	return
 }
 
-func resourceTencentCloudSqlserverInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_instance.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var outErr, inErr error
 	instanceId := d.Id()
@@ -687,7 +694,7 @@ func resourceTencentCloudSqlserverInstanceRead(d *schema.ResourceData, meta inte
 
 	instance, has, err := tencentSqlServerBasicInfoRead(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -700,7 +707,7 @@ func resourceTencentCloudSqlserverInstanceRead(d *schema.ResourceData, meta inte
 	//maintanence
 	weekSet, startTime, timeSpan, outErr := sqlserverService.DescribeMaintenanceSpan(ctx, instanceId)
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			weekSet, startTime, timeSpan, inErr = sqlserverService.DescribeMaintenanceSpan(ctx, instanceId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -709,7 +716,7 @@ func resourceTencentCloudSqlserverInstanceRead(d *schema.ResourceData, meta inte
 		})
 	}
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	_ = d.Set("maintenance_week_set", weekSet)
 	_ = d.Set("maintenance_start_time", startTime)
@@ -719,18 +726,18 @@ func resourceTencentCloudSqlserverInstanceRead(d *schema.ResourceData, meta inte
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "sqlserver", "instance", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCLoudSqlserverInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCLoudSqlserverInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_instance.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -738,7 +745,7 @@ func resourceTencentCLoudSqlserverInstanceDelete(d *schema.ResourceData, meta in
 	var outErr, inErr error
 	var has bool
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, inErr = sqlserverService.DescribeSqlserverInstanceById(ctx, d.Id())
 		if inErr != nil {
 			return retryError(inErr)
@@ -747,7 +754,7 @@ func resourceTencentCLoudSqlserverInstanceDelete(d *schema.ResourceData, meta in
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if !has {
@@ -758,7 +765,7 @@ func resourceTencentCLoudSqlserverInstanceDelete(d *schema.ResourceData, meta in
 	outErr = sqlserverService.TerminateSqlserverInstance(ctx, instanceId)
 
 	if outErr != nil {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			inErr = sqlserverService.TerminateSqlserverInstance(ctx, instanceId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -768,13 +775,13 @@ func resourceTencentCLoudSqlserverInstanceDelete(d *schema.ResourceData, meta in
 	}
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	outErr = sqlserverService.DeleteSqlserverInstance(ctx, instanceId)
 
 	if outErr != nil {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			inErr = sqlserverService.DeleteSqlserverInstance(ctx, instanceId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -784,7 +791,7 @@ func resourceTencentCLoudSqlserverInstanceDelete(d *schema.ResourceData, meta in
 	}
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	return nil
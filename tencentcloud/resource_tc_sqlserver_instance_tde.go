@@ -67,7 +67,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -76,12 +78,15 @@ import (
 
 func resourceTencentCloudSqlserverInstanceTDE() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverInstanceTDECreate,
-		Read:   resourceTencentCloudSqlserverInstanceTDERead,
-		Update: resourceTencentCloudSqlserverInstanceTDEUpdate,
-		Delete: resourceTencentCloudSqlserverInstanceTDEDelete,
+		CreateContext: resourceTencentCloudSqlserverInstanceTDECreate,
+		ReadContext:   resourceTencentCloudSqlserverInstanceTDERead,
+		UpdateContext: resourceTencentCloudSqlserverInstanceTDEUpdate,
+		DeleteContext: resourceTencentCloudSqlserverInstanceTDEDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(50 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -103,7 +108,7 @@ func resourceTencentCloudSqlserverInstanceTDE() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverInstanceTDECreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverInstanceTDECreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_instance_tde.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -117,23 +122,23 @@ func resourceTencentCloudSqlserverInstanceTDECreate(d *schema.ResourceData, meta
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudSqlserverInstanceTDEUpdate(d, meta)
+	return resourceTencentCloudSqlserverInstanceTDEUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverInstanceTDERead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverInstanceTDERead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_instance_tde.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceTDE, err := service.DescribeSqlserverInstanceTDEById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instanceTDE == nil {
@@ -157,18 +162,18 @@ func resourceTencentCloudSqlserverInstanceTDERead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudSqlserverInstanceTDEUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverInstanceTDEUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_instance_tde.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = sqlserver.NewModifyInstanceEncryptAttributesRequest()
 		instanceId = d.Id()
 		flowId     int64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("certificate_attribution"); ok {
 		request.CertificateAttribution = helper.String(v.(string))
@@ -180,8 +185,8 @@ func resourceTencentCloudSqlserverInstanceTDEUpdate(d *schema.ResourceData, meta
 
 	request.InstanceId = &instanceId
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyInstanceEncryptAttributes(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyInstanceEncryptAttributesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -199,10 +204,10 @@ func resourceTencentCloudSqlserverInstanceTDEUpdate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver instanceTDE failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, e := service.DescribeCloneStatusByFlowId(ctx, flowId)
 		if e != nil {
 			return retryError(e)
@@ -231,13 +236,13 @@ func resourceTencentCloudSqlserverInstanceTDEUpdate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver instanceTDE task fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverInstanceTDERead(d, meta)
+	return resourceTencentCloudSqlserverInstanceTDERead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverInstanceTDEDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverInstanceTDEDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_instance_tde.delete")()
 	defer inconsistentCheck(d, meta)()
 
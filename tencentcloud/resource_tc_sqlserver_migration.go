@@ -143,9 +143,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -154,12 +155,16 @@ import (
 
 func resourceTencentCloudSqlserverMigration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverMigrationCreate,
-		Read:   resourceTencentCloudSqlserverMigrationRead,
-		Update: resourceTencentCloudSqlserverMigrationUpdate,
-		Delete: resourceTencentCloudSqlserverMigrationDelete,
+		CreateContext: resourceTencentCloudSqlserverMigrationCreate,
+		ReadContext:   resourceTencentCloudSqlserverMigrationRead,
+		UpdateContext: resourceTencentCloudSqlserverMigrationUpdate,
+		DeleteContext: resourceTencentCloudSqlserverMigrationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"migrate_name": {
@@ -309,7 +314,7 @@ func resourceTencentCloudSqlserverMigration() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverMigrationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverMigrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_migration.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -410,8 +415,8 @@ func resourceTencentCloudSqlserverMigrationCreate(d *schema.ResourceData, meta i
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().CreateMigration(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().CreateMigrationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -422,22 +427,22 @@ func resourceTencentCloudSqlserverMigrationCreate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create sqlserver migration failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	migrateId = helper.Int64ToStr(*response.Response.MigrateId)
 	d.SetId(migrateId)
 
-	return resourceTencentCloudSqlserverMigrationRead(d, meta)
+	return resourceTencentCloudSqlserverMigrationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverMigrationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverMigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_migration.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -445,12 +450,12 @@ func resourceTencentCloudSqlserverMigrationRead(d *schema.ResourceData, meta int
 
 	migration, err := service.DescribeSqlserverMigrationById(ctx, migrationId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if migration == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `SqlserverMigration` %s does not exist", d.Id())
+		return diag.Errorf("resource `SqlserverMigration` %s does not exist", d.Id())
 	}
 
 	if migration.MigrateName != nil {
@@ -551,7 +556,7 @@ func resourceTencentCloudSqlserverMigrationRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudSqlserverMigrationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverMigrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_migration.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -564,7 +569,7 @@ func resourceTencentCloudSqlserverMigrationUpdate(d *schema.ResourceData, meta i
 	if d.HasChange("rename_restore") {
 		o, _ := d.GetChange("rename_restore")
 		_ = d.Set("rename_restore", o)
-		return fmt.Errorf("argument `%s` cannot be changed", d.Id())
+		return diag.Errorf("argument `%s` cannot be changed", d.Id())
 	}
 	if d.HasChange("migrate_name") {
 		if v, ok := d.GetOk("migrate_name"); ok {
@@ -651,8 +656,8 @@ func resourceTencentCloudSqlserverMigrationUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyMigration(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().ModifyMigrationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -662,24 +667,24 @@ func resourceTencentCloudSqlserverMigrationUpdate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver migration failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverMigrationRead(d, meta)
+	return resourceTencentCloudSqlserverMigrationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverMigrationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverMigrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_migration.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	migrateId := d.Id()
 
 	if err := service.DeleteSqlserverMigrationById(ctx, migrateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -112,21 +112,21 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudSqlserverPublishSubscribe() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverPublishSubscribeCreate,
-		Read:   resourceTencentCloudSqlserverPublishSubscribeRead,
-		Update: resourceTencentCloudSqlserverPublishSubscribeUpdate,
-		Delete: resourceTencentCloudSqlserverPublishSubscribeDelete,
+		CreateContext: resourceTencentCloudSqlserverPublishSubscribeCreate,
+		ReadContext:   resourceTencentCloudSqlserverPublishSubscribeRead,
+		UpdateContext: resourceTencentCloudSqlserverPublishSubscribeUpdate,
+		DeleteContext: resourceTencentCloudSqlserverPublishSubscribeDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -179,11 +179,11 @@ func resourceTencentCloudSqlserverPublishSubscribe() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverPublishSubscribeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverPublishSubscribeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_publish_subscribe.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		sqlserverService     = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -196,54 +196,54 @@ func resourceTencentCloudSqlserverPublishSubscribeCreate(d *schema.ResourceData,
 	//check publishInstanceId exist and status
 	_, has, err := sqlserverService.DescribeSqlserverInstanceById(ctx, publishInstanceId)
 	if err != nil {
-		return fmt.Errorf("[CRITAL]%s DescribeSqlserverInstanceById fail, reason:%s\n", logId, err)
+		return diag.Errorf("[CRITAL]%s DescribeSqlserverInstanceById fail, reason:%s\n", logId, err)
 	}
 	if !has {
-		return fmt.Errorf("[CRITAL]%s SQL Server Publish instance %s dose not exist for Publish Subscribe Create", logId, publishInstanceId)
+		return diag.Errorf("[CRITAL]%s SQL Server Publish instance %s dose not exist for Publish Subscribe Create", logId, publishInstanceId)
 	}
 
 	//check subscribeInstanceId exist and status
 	_, has, err = sqlserverService.DescribeSqlserverInstanceById(ctx, subscribeInstanceId)
 	if err != nil {
-		return fmt.Errorf("[CRITAL]%s DescribeSqlserverInstanceById fail, reason:%s\n", logId, err)
+		return diag.Errorf("[CRITAL]%s DescribeSqlserverInstanceById fail, reason:%s\n", logId, err)
 	}
 	if !has {
-		return fmt.Errorf("[CRITAL]%s SQL Server Subscribe %s dose not exist for Publish Subscribe Create", logId, subscribeInstanceId)
+		return diag.Errorf("[CRITAL]%s SQL Server Subscribe %s dose not exist for Publish Subscribe Create", logId, subscribeInstanceId)
 	}
 
 	if err := sqlserverService.CreateSqlserverPublishSubscribe(ctx, publishInstanceId, subscribeInstanceId, publishSubscribeName, databaseTuples); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_, hasExist, err := sqlserverService.DescribeSqlserverPublishSubscribeById(ctx, publishInstanceId, subscribeInstanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !hasExist {
-		return fmt.Errorf("this Sqlserver Publish Subscribe %s Create Failed", subscribeInstanceId)
+		return diag.Errorf("this Sqlserver Publish Subscribe %s Create Failed", subscribeInstanceId)
 	}
-	d.SetId(publishInstanceId + FILED_SP + subscribeInstanceId)
-	return resourceTencentCloudSqlserverPublishSubscribeRead(d, meta)
+	d.SetId(helper.IdFormat(publishInstanceId, subscribeInstanceId))
+	return resourceTencentCloudSqlserverPublishSubscribeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverPublishSubscribeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverPublishSubscribeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_publish_subscribe.read")()
 
 	var (
 		logId            = getLogId(contextNil)
-		ctx              = context.WithValue(context.TODO(), logIdKey, logId)
 		sqlserverService = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id               = d.Id()
-		idItem           = strings.Split(id, FILED_SP)
+		idItem           = helper.IdParse(id)
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if len(idItem) < 2 {
-		return fmt.Errorf("broken ID %s of SQL Server Publish Subscribe", id)
+		return diag.Errorf("broken ID %s of SQL Server Publish Subscribe", id)
 	}
 	publishInstanceId := idItem[0]
 	subscribeInstanceId := idItem[1]
 	publishSubscribe, has, err := sqlserverService.DescribeSqlserverPublishSubscribeById(ctx, publishInstanceId, subscribeInstanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -263,26 +263,26 @@ func resourceTencentCloudSqlserverPublishSubscribeRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceTencentCloudSqlserverPublishSubscribeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverPublishSubscribeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_publish_subscribe.update")()
 
 	var (
 		logId               = getLogId(contextNil)
-		ctx                 = context.WithValue(context.TODO(), logIdKey, logId)
 		sqlserverService    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		publishInstanceId   = d.Get("publish_instance_id").(string)
 		subscribeInstanceId = d.Get("subscribe_instance_id").(string)
 		deleteSubscribeDb   = d.Get("delete_subscribe_db").(bool)
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	publishSubscribe, _, err := sqlserverService.DescribeSqlserverPublishSubscribeById(ctx, publishInstanceId, subscribeInstanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange("publish_subscribe_name") {
 		publishSubscribeName := d.Get("publish_subscribe_name").(string)
 		if err := sqlserverService.ModifyPublishSubscribeName(ctx, *publishSubscribe.Id, publishSubscribeName); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -322,36 +322,36 @@ func resourceTencentCloudSqlserverPublishSubscribeUpdate(d *schema.ResourceData,
 			}
 		}
 		if deleteDatabaseTupleSet == nil {
-			return fmt.Errorf("[CRITAL]%s resourceTencentCloudSqlserverPublishSubscribeUpdate fail, reason: DatabaseTupleSet does not allow modify", logId)
+			return diag.Errorf("[CRITAL]%s resourceTencentCloudSqlserverPublishSubscribeUpdate fail, reason: DatabaseTupleSet does not allow modify", logId)
 		}
 		if err := sqlserverService.DeletePublishSubscribe(ctx, publishSubscribe, deleteDatabaseTupleSet); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if deleteSubscribeDb {
 			//delete subscribe databases
 			if err = sqlserverService.DeleteSqlserverDB(ctx, subscribeInstanceId, subscribeDatabases); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return resourceTencentCloudSqlserverPublishSubscribeRead(d, meta)
+	return resourceTencentCloudSqlserverPublishSubscribeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverPublishSubscribeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverPublishSubscribeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_publish_subscribe.delete")()
 
 	var (
 		logId               = getLogId(contextNil)
-		ctx                 = context.WithValue(context.TODO(), logIdKey, logId)
 		sqlserverService    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		publishInstanceId   = d.Get("publish_instance_id").(string)
 		subscribeInstanceId = d.Get("subscribe_instance_id").(string)
 		deleteSubscribeDb   = d.Get("delete_subscribe_db").(bool)
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	publishSubscribe, has, err := sqlserverService.DescribeSqlserverPublishSubscribeById(ctx, publishInstanceId, subscribeInstanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		return nil
@@ -368,12 +368,12 @@ func resourceTencentCloudSqlserverPublishSubscribeDelete(d *schema.ResourceData,
 	}
 
 	if err := sqlserverService.DeletePublishSubscribe(ctx, publishSubscribe, oldDatabaseTupleSet); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if deleteSubscribeDb {
 		//delete subscribe databases
 		if err = sqlserverService.DeleteSqlserverDB(ctx, subscribeInstanceId, subscribeDatabases); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	return nil
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 	"time"
 
@@ -169,7 +168,7 @@ func testAccCheckSqlserverPublishSubscribeDestroy(s *terraform.State) error {
 		if rs.Type != "tencentcloud_sqlserver_publish_subscribe" {
 			continue
 		}
-		split := strings.Split(rs.Primary.ID, FILED_SP)
+		split := helper.IdParse(rs.Primary.ID)
 		if len(split) < 2 {
 			continue
 		}
@@ -198,7 +197,7 @@ func testAccCheckSqlserverPublishSubscribeExists(n string) resource.TestCheckFun
 		}
 
 		sqlserverService := SqlserverService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
-		split := strings.Split(rs.Primary.ID, FILED_SP)
+		split := helper.IdParse(rs.Primary.ID)
 		if len(split) < 2 {
 			return fmt.Errorf("SQL Server Publish Subscribe is not set: %s", rs.Primary.ID)
 		}
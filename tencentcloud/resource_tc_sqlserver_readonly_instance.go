@@ -78,9 +78,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -146,20 +148,27 @@ func resourceTencentCloudSqlserverReadonlyInstance() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverReadonlyInstanceCreate,
-		Read:   resourceTencentCloudSqlserverReadonlyInstanceRead,
-		Update: resourceTencentCloudSqlserverReadonlyInstanceUpdate,
-		Delete: resourceTencentCloudSqlserverReadonlyInstanceDelete,
+		CreateContext: resourceTencentCloudSqlserverReadonlyInstanceCreate,
+		ReadContext:   resourceTencentCloudSqlserverReadonlyInstanceRead,
+		UpdateContext: resourceTencentCloudSqlserverReadonlyInstanceUpdate,
+		DeleteContext: resourceTencentCloudSqlserverReadonlyInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: readonlyInstanceInfo,
 	}
 }
 
-func resourceTencentCloudSqlserverReadonlyInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverReadonlyInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_readonly_instance.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := meta.(*TencentCloudClient).apiV3Conn
 	sqlserverService := SqlserverService{client: client}
@@ -255,7 +264,7 @@ func resourceTencentCloudSqlserverReadonlyInstanceCreate(d *schema.ResourceData,
 	var instanceId string
 	var outErr, inErr error
 
-	outErr = resource.Retry(12*writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instanceId, inErr = sqlserverService.CreateSqlserverReadonlyInstance(ctx, request)
 		if inErr != nil {
 			return retryError(inErr)
@@ -263,13 +272,13 @@ func resourceTencentCloudSqlserverReadonlyInstanceCreate(d *schema.ResourceData,
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	d.SetId(instanceId)
 
 	//set name
-	outErr = resource.Retry(3*writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		inErr := sqlserverService.ModifySqlserverInstanceName(ctx, instanceId, name)
 		if inErr != nil {
 			return retryError(inErr)
@@ -277,31 +286,31 @@ func resourceTencentCloudSqlserverReadonlyInstanceCreate(d *schema.ResourceData,
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		resourceName := BuildTagResourceName("sqlserver", "instance", region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudSqlserverReadonlyInstanceRead(d, meta)
+	return resourceTencentCloudSqlserverReadonlyInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverReadonlyInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverReadonlyInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_readonly_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	instance, has, err := tencentSqlServerBasicInfoRead(ctx, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !has {
@@ -316,7 +325,7 @@ func resourceTencentCloudSqlserverReadonlyInstanceRead(d *schema.ResourceData, m
 	readOnlyInstance, err := sqlserverService.DescribeReadonlyGroupListByReadonlyInstanceId(ctx, instanceId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if readOnlyInstance == nil {
@@ -336,32 +345,32 @@ func resourceTencentCloudSqlserverReadonlyInstanceRead(d *schema.ResourceData, m
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "sqlserver", "instance", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudSqlserverReadonlyInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverReadonlyInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_readonly_instance.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	//basic info update
 	if err := sqlServerAllInstanceRoleUpdate(ctx, d, meta); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverReadonlyInstanceRead(d, meta)
+	return resourceTencentCloudSqlserverReadonlyInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverReadonlyInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverReadonlyInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_readonly_instance.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -369,7 +378,7 @@ func resourceTencentCloudSqlserverReadonlyInstanceDelete(d *schema.ResourceData,
 	var outErr, inErr error
 	var has bool
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, inErr = sqlserverService.DescribeSqlserverInstanceById(ctx, d.Id())
 		if inErr != nil {
 			return retryError(inErr)
@@ -378,7 +387,7 @@ func resourceTencentCloudSqlserverReadonlyInstanceDelete(d *schema.ResourceData,
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if !has {
@@ -389,7 +398,7 @@ func resourceTencentCloudSqlserverReadonlyInstanceDelete(d *schema.ResourceData,
 	outErr = sqlserverService.TerminateSqlserverInstance(ctx, instanceId)
 
 	if outErr != nil {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			inErr = sqlserverService.TerminateSqlserverInstance(ctx, instanceId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -399,13 +408,13 @@ func resourceTencentCloudSqlserverReadonlyInstanceDelete(d *schema.ResourceData,
 	}
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	outErr = sqlserverService.DeleteSqlserverInstance(ctx, instanceId)
 
 	if outErr != nil {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			inErr = sqlserverService.DeleteSqlserverInstance(ctx, instanceId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -415,10 +424,10 @@ func resourceTencentCloudSqlserverReadonlyInstanceDelete(d *schema.ResourceData,
 	}
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, inErr := sqlserverService.DescribeSqlserverInstanceById(ctx, d.Id())
 		if inErr != nil {
 			return retryError(inErr)
@@ -431,7 +440,7 @@ func resourceTencentCloudSqlserverReadonlyInstanceDelete(d *schema.ResourceData,
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	return nil
 }
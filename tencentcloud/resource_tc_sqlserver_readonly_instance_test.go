@@ -65,6 +65,12 @@ func TestAccTencentCloudReadonlySqlserverInstanceResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testSqlserverInstanceResourceKey, "tags.update", "update"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_sqlserver_readonly_instance.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"readonly_group_type", "force_upgrade"},
+			},
 		},
 	})
 }
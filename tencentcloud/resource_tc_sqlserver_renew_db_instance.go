@@ -65,11 +65,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -78,12 +78,15 @@ import (
 
 func resourceTencentCloudSqlserverRenewDBInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverRenewDBInstanceCreate,
-		Read:   resourceTencentCloudSqlserverRenewDBInstanceRead,
-		Update: resourceTencentCloudSqlserverRenewDBInstanceUpdate,
-		Delete: resourceTencentCloudSqlserverRenewDBInstanceDelete,
+		CreateContext: resourceTencentCloudSqlserverRenewDBInstanceCreate,
+		ReadContext:   resourceTencentCloudSqlserverRenewDBInstanceRead,
+		UpdateContext: resourceTencentCloudSqlserverRenewDBInstanceUpdate,
+		DeleteContext: resourceTencentCloudSqlserverRenewDBInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -101,7 +104,7 @@ func resourceTencentCloudSqlserverRenewDBInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverRenewDBInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverRenewDBInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_renew_db_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -120,31 +123,31 @@ func resourceTencentCloudSqlserverRenewDBInstanceCreate(d *schema.ResourceData,
 		period = "1"
 	}
 
-	d.SetId(strings.Join([]string{instanceId, period}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, period))
 
-	return resourceTencentCloudSqlserverRenewDBInstanceUpdate(d, meta)
+	return resourceTencentCloudSqlserverRenewDBInstanceUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverRenewDBInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverRenewDBInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_renew_db_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	period := idSplit[1]
 
 	renewDBInstance, err := service.DescribeSqlserverRenewDBInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if renewDBInstance == nil {
@@ -163,7 +166,7 @@ func resourceTencentCloudSqlserverRenewDBInstanceRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudSqlserverRenewDBInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverRenewDBInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_renew_db_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -172,9 +175,9 @@ func resourceTencentCloudSqlserverRenewDBInstanceUpdate(d *schema.ResourceData,
 		request = sqlserver.NewRenewDBInstanceRequest()
 	)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+		return diag.Errorf("id is broken, id is %s", d.Id())
 	}
 	instanceId := idSplit[0]
 
@@ -184,8 +187,8 @@ func resourceTencentCloudSqlserverRenewDBInstanceUpdate(d *schema.ResourceData,
 
 	request.InstanceId = &instanceId
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().RenewDBInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().RenewDBInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -197,13 +200,13 @@ func resourceTencentCloudSqlserverRenewDBInstanceUpdate(d *schema.ResourceData,
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver renewDBInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverRenewDBInstanceRead(d, meta)
+	return resourceTencentCloudSqlserverRenewDBInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverRenewDBInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverRenewDBInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_renew_db_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
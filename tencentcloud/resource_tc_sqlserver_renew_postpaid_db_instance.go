@@ -69,7 +69,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -77,12 +79,15 @@ import (
 
 func resourceTencentCloudSqlserverRenewPostpaidDBInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverRenewPostpaidDBInstanceCreate,
-		Read:   resourceTencentCloudSqlserverRenewPostpaidDBInstanceRead,
-		Update: resourceTencentCloudSqlserverRenewPostpaidDBInstanceUpdate,
-		Delete: resourceTencentCloudSqlserverRenewPostpaidDBInstanceDelete,
+		CreateContext: resourceTencentCloudSqlserverRenewPostpaidDBInstanceCreate,
+		ReadContext:   resourceTencentCloudSqlserverRenewPostpaidDBInstanceRead,
+		UpdateContext: resourceTencentCloudSqlserverRenewPostpaidDBInstanceUpdate,
+		DeleteContext: resourceTencentCloudSqlserverRenewPostpaidDBInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -94,7 +99,7 @@ func resourceTencentCloudSqlserverRenewPostpaidDBInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverRenewPostpaidDBInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverRenewPostpaidDBInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_renew_postpaid_db_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -106,23 +111,23 @@ func resourceTencentCloudSqlserverRenewPostpaidDBInstanceCreate(d *schema.Resour
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudSqlserverRenewPostpaidDBInstanceUpdate(d, meta)
+	return resourceTencentCloudSqlserverRenewPostpaidDBInstanceUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverRenewPostpaidDBInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverRenewPostpaidDBInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_renew_postpaid_db_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	renewPostpaidDBInstance, err := service.DescribeSqlserverRenewPostpaidDBInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if renewPostpaidDBInstance == nil {
@@ -138,7 +143,7 @@ func resourceTencentCloudSqlserverRenewPostpaidDBInstanceRead(d *schema.Resource
 	return nil
 }
 
-func resourceTencentCloudSqlserverRenewPostpaidDBInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverRenewPostpaidDBInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_renew_postpaid_db_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -150,8 +155,8 @@ func resourceTencentCloudSqlserverRenewPostpaidDBInstanceUpdate(d *schema.Resour
 
 	request.InstanceId = &instanceId
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().RenewPostpaidDBInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().RenewPostpaidDBInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -163,13 +168,13 @@ func resourceTencentCloudSqlserverRenewPostpaidDBInstanceUpdate(d *schema.Resour
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver renewPostpaidDBInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverRenewPostpaidDBInstanceRead(d, meta)
+	return resourceTencentCloudSqlserverRenewPostpaidDBInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverRenewPostpaidDBInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverRenewPostpaidDBInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_renew_postpaid_db_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -66,7 +66,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -74,12 +76,15 @@ import (
 
 func resourceTencentCloudSqlserverRestartDBInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverRestartDBInstanceCreate,
-		Read:   resourceTencentCloudSqlserverRestartDBInstanceRead,
-		Update: resourceTencentCloudSqlserverRestartDBInstanceUpdate,
-		Delete: resourceTencentCloudSqlserverRestartDBInstanceDelete,
+		CreateContext: resourceTencentCloudSqlserverRestartDBInstanceCreate,
+		ReadContext:   resourceTencentCloudSqlserverRestartDBInstanceRead,
+		UpdateContext: resourceTencentCloudSqlserverRestartDBInstanceUpdate,
+		DeleteContext: resourceTencentCloudSqlserverRestartDBInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(50 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -91,7 +96,7 @@ func resourceTencentCloudSqlserverRestartDBInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverRestartDBInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverRestartDBInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_restart_d_b_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -103,23 +108,23 @@ func resourceTencentCloudSqlserverRestartDBInstanceCreate(d *schema.ResourceData
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudSqlserverRestartDBInstanceUpdate(d, meta)
+	return resourceTencentCloudSqlserverRestartDBInstanceUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverRestartDBInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverRestartDBInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_restart_d_b_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	restartDBInstance, err := service.DescribeSqlserverRestartDBInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if restartDBInstance == nil {
@@ -135,22 +140,22 @@ func resourceTencentCloudSqlserverRestartDBInstanceRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudSqlserverRestartDBInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverRestartDBInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_restart_d_b_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		request    = sqlserver.NewRestartDBInstanceRequest()
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 		flowId     uint64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request.InstanceId = &instanceId
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().RestartDBInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().RestartDBInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -168,10 +173,10 @@ func resourceTencentCloudSqlserverRestartDBInstanceUpdate(d *schema.ResourceData
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver restartDBInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, e := service.DescribeCloneStatusByFlowId(ctx, int64(flowId))
 		if e != nil {
 			return retryError(e)
@@ -200,13 +205,13 @@ func resourceTencentCloudSqlserverRestartDBInstanceUpdate(d *schema.ResourceData
 
 	if err != nil {
 		log.Printf("[CRITAL]%s restart sqlserver restartDBInstance task fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverRestartDBInstanceRead(d, meta)
+	return resourceTencentCloudSqlserverRestartDBInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverRestartDBInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverRestartDBInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_restart_d_b_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -30,7 +30,9 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -39,12 +41,15 @@ import (
 
 func resourceTencentCloudSqlserverRestoreInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverRestoreInstanceCreate,
-		Read:   resourceTencentCloudSqlserverRestoreInstanceRead,
-		Update: resourceTencentCloudSqlserverRestoreInstanceUpdate,
-		Delete: resourceTencentCloudSqlserverRestoreInstanceDelete,
+		CreateContext: resourceTencentCloudSqlserverRestoreInstanceCreate,
+		ReadContext:   resourceTencentCloudSqlserverRestoreInstanceRead,
+		UpdateContext: resourceTencentCloudSqlserverRestoreInstanceUpdate,
+		DeleteContext: resourceTencentCloudSqlserverRestoreInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(50 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -99,7 +104,7 @@ func resourceTencentCloudSqlserverRestoreInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverRestoreInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverRestoreInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_restore_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -133,24 +138,24 @@ func resourceTencentCloudSqlserverRestoreInstanceCreate(d *schema.ResourceData,
 	oldNameListStr := strings.Join(oldNameList, COMMA_SP)
 	newNameListStr := strings.Join(newNameList, COMMA_SP)
 
-	d.SetId(strings.Join([]string{instanceId, backupId, oldNameListStr, newNameListStr}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, backupId, oldNameListStr, newNameListStr))
 
-	return resourceTencentCloudSqlserverRestoreInstanceUpdate(d, meta)
+	return resourceTencentCloudSqlserverRestoreInstanceUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverRestoreInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverRestoreInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_restore_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	backupId := idSplit[1]
@@ -161,7 +166,7 @@ func resourceTencentCloudSqlserverRestoreInstanceRead(d *schema.ResourceData, me
 	allNameList := append(oldNameList, newNameList...)
 	restoreInstance, err := service.DescribeSqlserverRestoreInstanceById(ctx, instanceId, allNameList)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if restoreInstance == nil {
@@ -204,21 +209,21 @@ func resourceTencentCloudSqlserverRestoreInstanceRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudSqlserverRestoreInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverRestoreInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_restore_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request = sqlserver.NewRestoreInstanceRequest()
 		flowId  int64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+		return diag.Errorf("id is broken, id is %s", d.Id())
 	}
 	instanceId := idSplit[0]
 	backupId := idSplit[1]
@@ -241,8 +246,8 @@ func resourceTencentCloudSqlserverRestoreInstanceUpdate(d *schema.ResourceData,
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().RestoreInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().RestoreInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -255,10 +260,10 @@ func resourceTencentCloudSqlserverRestoreInstanceUpdate(d *schema.ResourceData,
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver restoreInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, e := service.DescribeCloneStatusByFlowId(ctx, flowId)
 		if e != nil {
 			return retryError(e)
@@ -287,25 +292,25 @@ func resourceTencentCloudSqlserverRestoreInstanceUpdate(d *schema.ResourceData,
 
 	if err != nil {
 		log.Printf("[CRITAL]%s restore sqlserver restoreInstance task fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverRestoreInstanceRead(d, meta)
+	return resourceTencentCloudSqlserverRestoreInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverRestoreInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverRestoreInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_restore_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId            = getLogId(contextNil)
-		ctx              = context.WithValue(context.TODO(), logIdKey, logId)
 		sqlserverService = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+		return diag.Errorf("id is broken, id is %s", d.Id())
 	}
 	instanceId := idSplit[0]
 	newNameListStr := idSplit[3]
@@ -321,5 +326,5 @@ func resourceTencentCloudSqlserverRestoreInstanceDelete(d *schema.ResourceData,
 	}
 
 	err := sqlserverService.DeleteSqlserverDB(ctx, instanceId, tmpNames)
-	return err
+	return diag.FromErr(err)
 }
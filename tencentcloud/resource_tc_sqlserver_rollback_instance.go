@@ -29,7 +29,9 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -38,12 +40,15 @@ import (
 
 func resourceTencentCloudSqlserverRollbackInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverRollbackInstanceCreate,
-		Read:   resourceTencentCloudSqlserverRollbackInstanceRead,
-		Update: resourceTencentCloudSqlserverRollbackInstanceUpdate,
-		Delete: resourceTencentCloudSqlserverRollbackInstanceDelete,
+		CreateContext: resourceTencentCloudSqlserverRollbackInstanceCreate,
+		ReadContext:   resourceTencentCloudSqlserverRollbackInstanceRead,
+		UpdateContext: resourceTencentCloudSqlserverRollbackInstanceUpdate,
+		DeleteContext: resourceTencentCloudSqlserverRollbackInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(50 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -98,7 +103,7 @@ func resourceTencentCloudSqlserverRollbackInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverRollbackInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverRollbackInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_rollback_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -132,24 +137,24 @@ func resourceTencentCloudSqlserverRollbackInstanceCreate(d *schema.ResourceData,
 	oldNameListStr := strings.Join(oldNameList, COMMA_SP)
 	newNameListStr := strings.Join(newNameList, COMMA_SP)
 
-	d.SetId(strings.Join([]string{instanceId, tmpTime, oldNameListStr, newNameListStr}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, tmpTime, oldNameListStr, newNameListStr))
 
-	return resourceTencentCloudSqlserverRollbackInstanceUpdate(d, meta)
+	return resourceTencentCloudSqlserverRollbackInstanceUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverRollbackInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverRollbackInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_rollback_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	tmpTime := idSplit[1]
@@ -160,7 +165,7 @@ func resourceTencentCloudSqlserverRollbackInstanceRead(d *schema.ResourceData, m
 	allNameList := append(oldNameList, newNameList...)
 	rollbackInstance, err := service.DescribeSqlserverRollbackInstanceById(ctx, instanceId, allNameList)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if rollbackInstance == nil {
@@ -202,22 +207,22 @@ func resourceTencentCloudSqlserverRollbackInstanceRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceTencentCloudSqlserverRollbackInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverRollbackInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_rollback_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request = sqlserver.NewRollbackInstanceRequest()
 		flowId  uint64
 		tmpType uint64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+		return diag.Errorf("id is broken, id is %s", d.Id())
 	}
 	instanceId := idSplit[0]
 
@@ -243,8 +248,8 @@ func resourceTencentCloudSqlserverRollbackInstanceUpdate(d *schema.ResourceData,
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().RollbackInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().RollbackInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -257,10 +262,10 @@ func resourceTencentCloudSqlserverRollbackInstanceUpdate(d *schema.ResourceData,
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update sqlserver rollbackInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, e := service.DescribeCloneStatusByFlowId(ctx, int64(flowId))
 		if e != nil {
 			return retryError(e)
@@ -289,25 +294,25 @@ func resourceTencentCloudSqlserverRollbackInstanceUpdate(d *schema.ResourceData,
 
 	if err != nil {
 		log.Printf("[CRITAL]%s sqlserver rollbackInstance task fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudSqlserverRollbackInstanceRead(d, meta)
+	return resourceTencentCloudSqlserverRollbackInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverRollbackInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverRollbackInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_rollback_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId            = getLogId(contextNil)
-		ctx              = context.WithValue(context.TODO(), logIdKey, logId)
 		sqlserverService = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+		return diag.Errorf("id is broken, id is %s", d.Id())
 	}
 	instanceId := idSplit[0]
 	newNameListStr := idSplit[3]
@@ -323,5 +328,5 @@ func resourceTencentCloudSqlserverRollbackInstanceDelete(d *schema.ResourceData,
 	}
 
 	err := sqlserverService.DeleteSqlserverDB(ctx, instanceId, tmpNames)
-	return err
+	return diag.FromErr(err)
 }
@@ -16,7 +16,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -25,9 +27,12 @@ import (
 
 func resourceTencentCloudSqlserverStartBackupFullMigration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverStartBackupFullMigrationCreate,
-		Read:   resourceTencentCloudSqlserverStartBackupFullMigrationRead,
-		Delete: resourceTencentCloudSqlserverStartBackupFullMigrationDelete,
+		CreateContext: resourceTencentCloudSqlserverStartBackupFullMigrationCreate,
+		ReadContext:   resourceTencentCloudSqlserverStartBackupFullMigrationRead,
+		DeleteContext: resourceTencentCloudSqlserverStartBackupFullMigrationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(50 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -46,18 +51,18 @@ func resourceTencentCloudSqlserverStartBackupFullMigration() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverStartBackupFullMigrationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverStartBackupFullMigrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_start_backup_full_migration.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = sqlserver.NewStartBackupMigrationRequest()
 		instanceId string
 		flowId     uint64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if v, ok := d.GetOk("instance_id"); ok {
 		request.InstanceId = helper.String(v.(string))
 		instanceId = v.(string)
@@ -67,8 +72,8 @@ func resourceTencentCloudSqlserverStartBackupFullMigrationCreate(d *schema.Resou
 		request.BackupMigrationId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().StartBackupMigration(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().StartBackupMigrationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -81,11 +86,11 @@ func resourceTencentCloudSqlserverStartBackupFullMigrationCreate(d *schema.Resou
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate sqlserver startBackupFullMigration failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// wait
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := service.DescribeCloneStatusByFlowId(ctx, int64(flowId))
 		if e != nil {
 			return retryError(e)
@@ -114,22 +119,22 @@ func resourceTencentCloudSqlserverStartBackupFullMigrationCreate(d *schema.Resou
 
 	if err != nil {
 		log.Printf("[CRITAL]%s sqlserver startBackupFullMigration task fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudSqlserverStartBackupFullMigrationRead(d, meta)
+	return resourceTencentCloudSqlserverStartBackupFullMigrationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverStartBackupFullMigrationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverStartBackupFullMigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_start_backup_full_migration.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudSqlserverStartBackupFullMigrationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverStartBackupFullMigrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_start_backup_full_migration.delete")()
 	defer inconsistentCheck(d, meta)()
 
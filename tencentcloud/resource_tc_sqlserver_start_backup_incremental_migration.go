@@ -17,7 +17,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -26,9 +28,12 @@ import (
 
 func resourceTencentCloudSqlserverStartBackupIncrementalMigration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverStartBackupIncrementalMigrationCreate,
-		Read:   resourceTencentCloudSqlserverStartBackupIncrementalMigrationRead,
-		Delete: resourceTencentCloudSqlserverStartBackupIncrementalMigrationDelete,
+		CreateContext: resourceTencentCloudSqlserverStartBackupIncrementalMigrationCreate,
+		ReadContext:   resourceTencentCloudSqlserverStartBackupIncrementalMigrationRead,
+		DeleteContext: resourceTencentCloudSqlserverStartBackupIncrementalMigrationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(50 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -53,18 +58,18 @@ func resourceTencentCloudSqlserverStartBackupIncrementalMigration() *schema.Reso
 	}
 }
 
-func resourceTencentCloudSqlserverStartBackupIncrementalMigrationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverStartBackupIncrementalMigrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_start_backup_incremental_migration.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = sqlserver.NewStartIncrementalMigrationRequest()
 		instanceId string
 		flowId     uint64
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("instance_id"); ok {
 		request.InstanceId = helper.String(v.(string))
@@ -78,8 +83,8 @@ func resourceTencentCloudSqlserverStartBackupIncrementalMigrationCreate(d *schem
 		request.IncrementalMigrationId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().StartIncrementalMigration(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().StartIncrementalMigrationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -92,11 +97,11 @@ func resourceTencentCloudSqlserverStartBackupIncrementalMigrationCreate(d *schem
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate sqlserver startBackupIncrementalMigration failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// wait
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := service.DescribeCloneStatusByFlowId(ctx, int64(flowId))
 		if e != nil {
 			return retryError(e)
@@ -125,22 +130,22 @@ func resourceTencentCloudSqlserverStartBackupIncrementalMigrationCreate(d *schem
 
 	if err != nil {
 		log.Printf("[CRITAL]%s sqlserver startBackupIncrementalMigration task fail, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudSqlserverStartBackupIncrementalMigrationRead(d, meta)
+	return resourceTencentCloudSqlserverStartBackupIncrementalMigrationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverStartBackupIncrementalMigrationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverStartBackupIncrementalMigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_start_backup_incremental_migration.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudSqlserverStartBackupIncrementalMigrationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverStartBackupIncrementalMigrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_start_backup_incremental_migration.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -59,8 +59,11 @@ resource "tencentcloud_sqlserver_start_xevent" "example" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
@@ -69,9 +72,12 @@ import (
 
 func resourceTencentCloudSqlserverStartXevent() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverStartXeventCreate,
-		Read:   resourceTencentCloudSqlserverStartXeventRead,
-		Delete: resourceTencentCloudSqlserverStartXeventDelete,
+		CreateContext: resourceTencentCloudSqlserverStartXeventCreate,
+		ReadContext:   resourceTencentCloudSqlserverStartXeventRead,
+		DeleteContext: resourceTencentCloudSqlserverStartXeventDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -104,7 +110,7 @@ func resourceTencentCloudSqlserverStartXevent() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSqlserverStartXeventCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverStartXeventCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_start_xevent.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -132,8 +138,8 @@ func resourceTencentCloudSqlserverStartXeventCreate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().StartInstanceXEvent(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSqlserverClient().StartInstanceXEventWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -145,22 +151,22 @@ func resourceTencentCloudSqlserverStartXeventCreate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate sqlserver startXevent failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudSqlserverStartXeventRead(d, meta)
+	return resourceTencentCloudSqlserverStartXeventRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSqlserverStartXeventRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverStartXeventRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_start_xevent.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudSqlserverStartXeventDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSqlserverStartXeventDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_sqlserver_start_xevent.delete")()
 	defer inconsistentCheck(d, meta)()
 
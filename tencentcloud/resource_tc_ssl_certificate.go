@@ -63,7 +63,9 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkError "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -73,12 +75,17 @@ import (
 
 func resourceTencentCloudSslCertificate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSslCertificateCreate,
-		Read:   resourceTencentCloudSslCertificateRead,
-		Update: resourceTencentCloudSslCertificateUpdate,
-		Delete: resourceTencentCloudSslCertificateDelete,
+		CreateContext: resourceTencentCloudSslCertificateCreate,
+		ReadContext:   resourceTencentCloudSslCertificateRead,
+		UpdateContext: resourceTencentCloudSslCertificateUpdate,
+		DeleteContext: resourceTencentCloudSslCertificateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -185,17 +192,17 @@ func resourceTencentCloudSslCertificate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSslCertificateCreate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudSslCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssl_certificate.create")()
 
 	var (
 		logId            = getLogId(contextNil)
-		ctx              = context.WithValue(context.TODO(), logIdKey, logId)
 		sslService       = SSLService{client: m.(*TencentCloudClient).apiV3Conn}
 		outErr, inErr    error
 		id               string
 		describeResponse *ssl.DescribeCertificateDetailResponse
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := ssl.NewUploadCertificateRequest()
 	request.CertificatePublicKey = helper.String(d.Get("cert").(string))
@@ -206,10 +213,10 @@ func resourceTencentCloudSslCertificateCreate(d *schema.ResourceData, m interfac
 		request.CertificatePrivateKey = helper.String(raw.(string))
 	}
 	if *request.CertificateType == "SVR" && (request.CertificatePrivateKey == nil || *request.CertificatePrivateKey == "") {
-		return errors.New("when type is SVR, key can't be empty")
+		return diag.FromErr(errors.New("when type is SVR, key can't be empty"))
 	}
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		id, inErr = sslService.UploadCertificate(ctx, request)
 		if inErr != nil {
 			return retryError(inErr)
@@ -218,12 +225,12 @@ func resourceTencentCloudSslCertificateCreate(d *schema.ResourceData, m interfac
 	})
 	if outErr != nil {
 		log.Printf("[CRITAL]%s create certificate failed, reason: %v", logId, outErr)
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	describeRequest := ssl.NewDescribeCertificateDetailRequest()
 	describeRequest.CertificateId = &id
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		describeResponse, inErr = sslService.DescribeCertificateDetail(ctx, describeRequest)
 		if inErr != nil {
 			return retryError(inErr)
@@ -245,7 +252,7 @@ func resourceTencentCloudSslCertificateCreate(d *schema.ResourceData, m interfac
 	})
 	if outErr != nil {
 		log.Printf("[CRITAL]%s create certificate failed, reason: %v", logId, outErr)
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
@@ -253,30 +260,30 @@ func resourceTencentCloudSslCertificateCreate(d *schema.ResourceData, m interfac
 		tagService := &TagService{client: tagClient}
 		resourceName := BuildTagResourceName("ssl", "certificate", tagClient.Region, id)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	d.SetId(id)
 
-	return resourceTencentCloudSslCertificateRead(d, m)
+	return resourceTencentCloudSslCertificateRead(ctx, d, m)
 }
 
-func resourceTencentCloudSslCertificateRead(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudSslCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssl_certificate.read")()
 	defer inconsistentCheck(d, m)()
 
 	var (
 		logId            = getLogId(contextNil)
-		ctx              = context.WithValue(context.TODO(), logIdKey, logId)
 		sslService       = SSLService{client: m.(*TencentCloudClient).apiV3Conn}
 		outErr, inErr    error
 		id               = d.Id()
 		describeResponse *ssl.DescribeCertificateDetailResponse
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	describeRequest := ssl.NewDescribeCertificateDetailRequest()
 	describeRequest.CertificateId = &id
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		describeResponse, inErr = sslService.DescribeCertificateDetail(ctx, describeRequest)
 		if inErr != nil {
 			if sdkErr, ok := inErr.(*sdkError.TencentCloudSDKError); ok {
@@ -290,7 +297,7 @@ func resourceTencentCloudSslCertificateRead(d *schema.ResourceData, m interface{
 	})
 	if outErr != nil {
 		log.Printf("[CRITAL]%s read certificate failed, reason: %v", logId, outErr)
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if describeResponse == nil || describeResponse.Response == nil || describeResponse.Response.CertificateId == nil {
@@ -311,14 +318,14 @@ func resourceTencentCloudSslCertificateRead(d *schema.ResourceData, m interface{
 		"CertEndTime":          "end time",
 		"InsertTime":           "create time",
 	}); len(nilNames) > 0 {
-		return fmt.Errorf("certificate %v are nil", nilNames)
+		return diag.Errorf("certificate %v are nil", nilNames)
 	}
 
 	_ = d.Set("name", certificate.Alias)
 	_ = d.Set("type", certificate.CertificateType)
 	projectId, err := strconv.Atoi(*certificate.ProjectId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("project_id", projectId)
 	_ = d.Set("cert", strings.TrimRight(*certificate.CertificatePublicKey, "\n"))
@@ -340,21 +347,21 @@ func resourceTencentCloudSslCertificateRead(d *schema.ResourceData, m interface{
 
 	tags, err := tagService.DescribeResourceTags(ctx, "ssl", "certificate", tagClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 	return nil
 }
 
-func resourceTencentCloudSslCertificateUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudSslCertificateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssl_certificate.update")()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		id         = d.Id()
 		sslService = SSLService{client: m.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	d.Partial(true)
 	if d.HasChange("name") {
@@ -363,7 +370,7 @@ func resourceTencentCloudSslCertificateUpdate(d *schema.ResourceData, m interfac
 		_, alias := d.GetChange("name")
 		aliasRequest.Alias = helper.String(alias.(string))
 
-		if outErr := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		if outErr := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			if inErr := sslService.ModifyCertificateAlias(ctx, aliasRequest); inErr != nil {
 				if sdkErr, ok := inErr.(*sdkError.TencentCloudSDKError); ok {
 					code := sdkErr.GetCode()
@@ -375,7 +382,7 @@ func resourceTencentCloudSslCertificateUpdate(d *schema.ResourceData, m interfac
 			}
 			return nil
 		}); outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 	}
@@ -387,7 +394,7 @@ func resourceTencentCloudSslCertificateUpdate(d *schema.ResourceData, m interfac
 		_, projectId := d.GetChange("project_id")
 		projectRequest.ProjectId = helper.Uint64(uint64(projectId.(int)))
 
-		if outErr := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		if outErr := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			if inErr := sslService.ModifyCertificateProject(ctx, projectRequest); inErr != nil {
 				if sdkErr, ok := inErr.(*sdkError.TencentCloudSDKError); ok {
 					code := sdkErr.GetCode()
@@ -399,7 +406,7 @@ func resourceTencentCloudSslCertificateUpdate(d *schema.ResourceData, m interfac
 			}
 			return nil
 		}); outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 	}
@@ -412,27 +419,27 @@ func resourceTencentCloudSslCertificateUpdate(d *schema.ResourceData, m interfac
 		resourceName := BuildTagResourceName("ssl", "certificate", tagClient.Region, id)
 		err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	d.Partial(false)
-	return resourceTencentCloudSslCertificateRead(d, m)
+	return resourceTencentCloudSslCertificateRead(ctx, d, m)
 }
 
-func resourceTencentCloudSslCertificateDelete(d *schema.ResourceData, m interface{}) error {
+func resourceTencentCloudSslCertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssl_certificate.delete")()
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		sslService    = SSLService{client: m.(*TencentCloudClient).apiV3Conn}
 		outErr, inErr error
 		id            = d.Id()
 		deleteResult  bool
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	request := ssl.NewDeleteCertificateRequest()
 	request.CertificateId = helper.String(id)
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		deleteResult, inErr = sslService.DeleteCertificate(ctx, request)
 		if inErr != nil {
 			return retryError(inErr)
@@ -445,7 +452,7 @@ func resourceTencentCloudSslCertificateDelete(d *schema.ResourceData, m interfac
 
 	if outErr != nil {
 		log.Printf("[CRITAL]%s delete SSL certificate failed, reason:%+v", logId, outErr)
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	return nil
 }
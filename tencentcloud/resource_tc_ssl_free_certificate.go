@@ -34,23 +34,23 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
 	ssl2 "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ssl/v20191205"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudSSLFreeCertificate() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudFreeCertificateRead,
-		Create: resourceTencentCloudFreeCertificateCreate,
-		Update: resourceTencentCloudFreeCertificateUpdate,
-		Delete: resourceTencentCloudFreeCertificateDelete,
+		ReadContext:   resourceTencentCloudFreeCertificateRead,
+		CreateContext: resourceTencentCloudFreeCertificateCreate,
+		UpdateContext: resourceTencentCloudFreeCertificateUpdate,
+		DeleteContext: resourceTencentCloudFreeCertificateDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"dv_auth_method": {
@@ -204,12 +204,12 @@ func resourceTencentCloudSSLFreeCertificate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudFreeCertificateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudFreeCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssl_free_certificate.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := SSLService{client}
 	id := d.Id()
@@ -221,7 +221,7 @@ func resourceTencentCloudFreeCertificateRead(d *schema.ResourceData, meta interf
 
 	if err != nil {
 		d.SetId("")
-		return err
+		return diag.FromErr(err)
 	}
 
 	detail := response.Response
@@ -285,7 +285,7 @@ func resourceTencentCloudFreeCertificateRead(d *schema.ResourceData, meta interf
 	if detail.ProjectId != nil {
 		pid, err := strconv.Atoi(*detail.ProjectId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		_ = d.Set("project_id", pid)
 	}
@@ -305,11 +305,11 @@ func resourceTencentCloudFreeCertificateRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudFreeCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudFreeCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssl_free_certificate.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := SSLService{client}
 
@@ -356,19 +356,19 @@ func resourceTencentCloudFreeCertificateCreate(d *schema.ResourceData, meta inte
 
 	id, err := service.ApplyCertificate(ctx, request)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
 
-	return resourceTencentCloudFreeCertificateRead(d, meta)
+	return resourceTencentCloudFreeCertificateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudFreeCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudFreeCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssl_free_certificate.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := SSLService{client}
 
@@ -384,7 +384,7 @@ func resourceTencentCloudFreeCertificateUpdate(d *schema.ResourceData, meta inte
 		}
 		request.CertificateId = &id
 		if err := service.ModifyCertificateAlias(ctx, request); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -398,7 +398,7 @@ func resourceTencentCloudFreeCertificateUpdate(d *schema.ResourceData, meta inte
 		}
 		request.CertificateIdList = []*string{&id}
 		if err := service.ModifyCertificateProject(ctx, request); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -414,18 +414,18 @@ func resourceTencentCloudFreeCertificateUpdate(d *schema.ResourceData, meta inte
 	}
 	for _, f := range immutableFields {
 		if d.HasChange(f) {
-			return fmt.Errorf("cannot update argument `%s`, please reset to previous value or replace by creating a new resource", f)
+			return diag.Errorf("cannot update argument `%s`, please reset to previous value or replace by creating a new resource", f)
 		}
 	}
 
-	return resourceTencentCloudFreeCertificateRead(d, meta)
+	return resourceTencentCloudFreeCertificateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudFreeCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudFreeCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssl_free_certificate.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := SSLService{client}
 	id := d.Id()
@@ -435,7 +435,7 @@ func resourceTencentCloudFreeCertificateDelete(d *schema.ResourceData, meta inte
 
 	_, err := service.DeleteCertificate(ctx, request)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -62,8 +62,9 @@ import (
 	"log"
 	"math"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -73,12 +74,17 @@ import (
 
 func resourceTencentCloudSSLInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSSLInstanceCreate,
-		Read:   resourceTencentCloudSSLInstanceRead,
-		Update: resourceTencentCloudSSLInstanceUpdate,
-		Delete: resourceTencentCloudSSLInstanceDelete,
+		CreateContext: resourceTencentCloudSSLInstanceCreate,
+		ReadContext:   resourceTencentCloudSSLInstanceRead,
+		UpdateContext: resourceTencentCloudSSLInstanceUpdate,
+		DeleteContext: resourceTencentCloudSSLInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -333,24 +339,24 @@ func resourceTencentCloudSSLInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSSLInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSSLInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssl_pay_certificate.create")()
 
 	var (
 		productId     = int64(d.Get("product_id").(int))
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		sslService    = SSLService{client: meta.(*TencentCloudClient).apiV3Conn}
 		certificateId string
 		err           error
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := ssl.NewCreateCertificateRequest()
 	request.ProductId = helper.Int64(productId)
 	request.DomainNum = helper.Int64(int64(d.Get("domain_num").(int)))
 	request.TimeSpan = helper.Int64(int64(d.Get("time_span").(int)))
 
-	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		certificateId, _, err = sslService.CreateCertificate(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -363,36 +369,36 @@ func resourceTencentCloudSSLInstanceCreate(d *schema.ResourceData, meta interfac
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	d.SetId(strings.Join([]string{certificateId,
+	d.SetId(helper.IdFormat(certificateId,
 		strconv.FormatInt(*request.ProductId, 10),
 		strconv.FormatInt(*request.DomainNum, 10),
-		strconv.FormatInt(*request.TimeSpan, 10)}, FILED_SP))
+		strconv.FormatInt(*request.TimeSpan, 10)))
 
-	return resourceTencentCloudSSLInstanceUpdate(d, meta)
+	return resourceTencentCloudSSLInstanceUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudSSLInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSSLInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssl_pay_certificate.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		sslService = SSLService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id         = d.Id()
 		err        error
 		response   *ssl.DescribeCertificateDetailResponse
 	)
-	ids := strings.Split(d.Id(), FILED_SP)
-	if len(ids) != 4 {
-		return fmt.Errorf("ids param is error. id:  %s", id)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	ids, err := helper.IdParseN(id, 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	request := ssl.NewDescribeCertificateDetailRequest()
 	request.CertificateId = helper.String(ids[0])
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, err = sslService.DescribeCertificateDetail(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -403,7 +409,7 @@ func resourceTencentCloudSSLInstanceRead(d *schema.ResourceData, meta interface{
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if response.Response.CertificateId == nil || response.Response.ProjectId == nil {
 		d.SetId("")
@@ -412,16 +418,16 @@ func resourceTencentCloudSSLInstanceRead(d *schema.ResourceData, meta interface{
 
 	var productId, domainNum, timeSpan, projectId int64
 	if productId, err = strconv.ParseInt(ids[1], 10, 64); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if domainNum, err = strconv.ParseInt(ids[2], 10, 64); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if timeSpan, err = strconv.ParseInt(ids[3], 10, 64); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if projectId, err = strconv.ParseInt(*response.Response.ProjectId, 10, 64); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("product_id", productId)
@@ -453,24 +459,24 @@ func resourceTencentCloudSSLInstanceRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTencentCloudSSLInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSSLInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssl_pay_certificate.update")()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		id         = d.Id()
 		sslService = SSLService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	ids := strings.Split(d.Id(), FILED_SP)
-	if len(ids) != 4 {
-		return fmt.Errorf("ids param is error. id:  %s", id)
+	ids, err := helper.IdParseN(id, 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	certificateId := ids[0]
 	productId, err := strconv.ParseInt(ids[1], 10, 64)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Partial(true)
 	if d.HasChange("alias") {
@@ -479,7 +485,7 @@ func resourceTencentCloudSSLInstanceUpdate(d *schema.ResourceData, meta interfac
 		_, alias := d.GetChange("alias")
 		aliasRequest.Alias = helper.String(alias.(string))
 
-		if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		if err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			if err := sslService.ModifyCertificateAlias(ctx, aliasRequest); err != nil {
 				if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
 					code := sdkError.GetCode()
@@ -491,7 +497,7 @@ func resourceTencentCloudSSLInstanceUpdate(d *schema.ResourceData, meta interfac
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -503,7 +509,7 @@ func resourceTencentCloudSSLInstanceUpdate(d *schema.ResourceData, meta interfac
 		_, projectId := d.GetChange("project_id")
 		projectRequest.ProjectId = helper.Uint64(uint64(projectId.(int)))
 
-		if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		if err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			if err := sslService.ModifyCertificateProject(ctx, projectRequest); err != nil {
 				if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
 					code := sdkError.GetCode()
@@ -515,7 +521,7 @@ func resourceTencentCloudSSLInstanceUpdate(d *schema.ResourceData, meta interfac
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -523,35 +529,35 @@ func resourceTencentCloudSSLInstanceUpdate(d *schema.ResourceData, meta interfac
 
 		status, err := sslService.getCertificateStatus(ctx, certificateId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if status == SSL_STATUS_CANCELING {
 			err := fmt.Errorf("%s \n\tcertificateId[%s], status[%v]", SSL_ERR_CANCELING, certificateId, status)
-			return err
+			return diag.FromErr(err)
 		}
 
 		if status != SSL_STATUS_PENDING_SUB {
 			if _, ok := SslCanCancelStatus[status]; ok {
 				code, err := cancelAudit(ctx, sslService, certificateId)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				status = code
 			}
 			if status == SSL_STATUS_CANCELED {
 				err := resubmit(ctx, sslService, certificateId)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			} else {
 				err := fmt.Errorf("status[%v] information cannot be modified in this status certificateId[%s]", status, certificateId)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 		infoRequest := getSubmitInfoRequest(d)
 		infoRequest.CertificateId = helper.String(certificateId)
-		if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		if err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			if err := sslService.SubmitCertificateInformation(ctx, infoRequest); err != nil {
 				if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
 					code := sdkError.GetCode()
@@ -563,12 +569,12 @@ func resourceTencentCloudSSLInstanceUpdate(d *schema.ResourceData, meta interfac
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		commitInfoRequest := ssl.NewCommitCertificateInformationRequest()
 		commitInfoRequest.CertificateId = helper.String(certificateId)
-		if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		if err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			if err = sslService.CommitCertificateInformation(ctx, commitInfoRequest); err != nil {
 				if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
 					code := sdkError.GetCode()
@@ -580,7 +586,7 @@ func resourceTencentCloudSSLInstanceUpdate(d *schema.ResourceData, meta interfac
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if IsContainProductId(productId, GEOTRUST_OV_EV_TYPE) {
@@ -588,7 +594,7 @@ func resourceTencentCloudSSLInstanceUpdate(d *schema.ResourceData, meta interfac
 			uploadConfirmLetterRequest := ssl.NewUploadConfirmLetterRequest()
 			uploadConfirmLetterRequest.CertificateId = helper.String(certificateId)
 			uploadConfirmLetterRequest.ConfirmLetter = helper.String(confirmLetter)
-			if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			if err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				if err = sslService.UploadConfirmLetter(ctx, uploadConfirmLetterRequest); err != nil {
 					if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
 						code := sdkError.GetCode()
@@ -600,34 +606,34 @@ func resourceTencentCloudSSLInstanceUpdate(d *schema.ResourceData, meta interfac
 				}
 				return nil
 			}); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 	d.Partial(false)
 
-	return resourceTencentCloudSSLInstanceRead(d, meta)
+	return resourceTencentCloudSSLInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSSLInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSSLInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssl_pay_certificate.delete")()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		id         = d.Id()
 		sslService = SSLService{client: meta.(*TencentCloudClient).apiV3Conn}
 		err        error
 	)
-	ids := strings.Split(d.Id(), FILED_SP)
-	if len(ids) != 4 {
-		return fmt.Errorf("ids param is error. id:  %s", id)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	ids, err := helper.IdParseN(id, 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	request := ssl.NewCancelCertificateOrderRequest()
 	request.CertificateId = helper.String(ids[0])
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if err = sslService.CancelCertificateOrder(ctx, request); err != nil {
 			if sdkError, ok := err.(*errors.TencentCloudSDKError); ok {
 				code := sdkError.GetCode()
@@ -639,7 +645,7 @@ func resourceTencentCloudSSLInstanceDelete(d *schema.ResourceData, meta interfac
 		}
 		return nil
 	})
-	return err
+	return diag.FromErr(err)
 }
 
 func getSubmitInfoRequest(d *schema.ResourceData) *ssl.SubmitCertificateInformationRequest {
@@ -3,7 +3,6 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -118,7 +117,7 @@ func testAccCheckSSLInstanceDestroy(s *terraform.State) error {
 			continue
 		}
 		resourceId := rs.Primary.ID
-		ids := strings.Split(resourceId, FILED_SP)
+		ids := helper.IdParse(resourceId)
 		if len(ids) != 4 {
 			return fmt.Errorf("ids param is error. id:  %s", resourceId)
 		}
@@ -163,7 +162,7 @@ func testAccCheckSSLInstanceExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("[TECENT_TERRAFORM_CHECK][SSL instance][Exists] check: SSL instance certificateId is not set")
 		}
 		resourceId := rs.Primary.ID
-		ids := strings.Split(resourceId, FILED_SP)
+		ids := helper.IdParse(resourceId)
 		if len(ids) != 4 {
 			return fmt.Errorf("ids param is error. id:  %s", resourceId)
 		}
@@ -85,15 +85,23 @@ resource "tencentcloud_ssm_product_secret" "example" {
   }
 }
 ```
+
+Import
+
+ssm product_secret can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_ssm_product_secret.example tf-product-ssm-test
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ssm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ssm/v20190923"
@@ -102,10 +110,17 @@ import (
 
 func resourceTencentCloudSsmProductSecret() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSsmProductSecretCreate,
-		Read:   resourceTencentCloudSsmProductSecretRead,
-		Update: resourceTencentCloudSsmProductSecretUpdate,
-		Delete: resourceTencentCloudSsmProductSecretDelete,
+		CreateContext: resourceTencentCloudSsmProductSecretCreate,
+		ReadContext:   resourceTencentCloudSsmProductSecretRead,
+		UpdateContext: resourceTencentCloudSsmProductSecretUpdate,
+		DeleteContext: resourceTencentCloudSsmProductSecretDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"secret_name": {
 				Required:    true,
@@ -223,19 +238,19 @@ func resourceTencentCloudSsmProductSecret() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSsmProductSecretCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSsmProductSecretCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssm_product_secret.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = ssm.NewCreateProductSecretRequest()
 		response   = ssm.NewCreateProductSecretResponse()
 		secretInfo *SecretInfo
 		secretName string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("secret_name"); ok {
 		request.SecretName = helper.String(v.(string))
@@ -308,8 +323,8 @@ func resourceTencentCloudSsmProductSecretCreate(d *schema.ResourceData, meta int
 		request.RotationFrequency = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSsmClient().CreateProductSecret(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSsmClient().CreateProductSecretWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -322,31 +337,31 @@ func resourceTencentCloudSsmProductSecretCreate(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create ssm productSecret failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	secretName = *response.Response.SecretName
 	flowId := *response.Response.FlowID
-	conf := BuildStateChangeConf([]string{}, []string{"1"}, readRetryTimeout, time.Second, service.SsmProductSecretStateRefreshFunc(flowId, []string{"0"}))
+	conf := BuildStateChangeConf([]string{}, []string{"1"}, d.Timeout(schema.TimeoutCreate), time.Second, service.SsmProductSecretStateRefreshFunc(flowId, []string{"0"}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	// update status if disabled
 	if v, ok := d.GetOk("status"); ok {
 		status := v.(string)
 		if status == "Disabled" {
-			ctx := context.WithValue(context.TODO(), logIdKey, logId)
+			ctx = context.WithValue(ctx, logIdKey, logId)
 			err = service.DisableSecret(ctx, secretName)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			secretInfo, err = service.DescribeSecretByName(ctx, secretName)
 			if err != nil {
 				return retryError(err)
@@ -356,36 +371,36 @@ func resourceTencentCloudSsmProductSecretCreate(d *schema.ResourceData, meta int
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		resourceName := BuildTagResourceName("ssm", "secret", tcClient.Region, secretInfo.resourceId)
 		if err = tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(secretName)
-	return resourceTencentCloudSsmProductSecretRead(d, meta)
+	return resourceTencentCloudSsmProductSecretRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSsmProductSecretRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSsmProductSecretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssm_product_secret.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
 		secretInfo *SecretInfo
 		secretName = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	productSecret, err := service.DescribeSecretById(ctx, secretName, 1)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if productSecret == nil {
@@ -437,7 +452,7 @@ func resourceTencentCloudSsmProductSecretRead(d *schema.ResourceData, meta inter
 		_ = d.Set("secret_type", productSecret.SecretType)
 	}
 
-	outErr := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		secretInfo, err = service.DescribeSecretByName(ctx, secretName)
 		if err != nil {
 			return retryError(err)
@@ -447,14 +462,14 @@ func resourceTencentCloudSsmProductSecretRead(d *schema.ResourceData, meta inter
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	tcClient := meta.(*TencentCloudClient).apiV3Conn
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "ssm", "secret", tcClient.Region, secretInfo.resourceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("tags", tags)
@@ -462,16 +477,16 @@ func resourceTencentCloudSsmProductSecretRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudSsmProductSecretUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSsmProductSecretUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssm_product_secret.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		ssmService = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
 		secretName = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	immutableArgs := []string{
 		"user_name_prefix", "product_name", "instance_id",
@@ -480,7 +495,7 @@ func resourceTencentCloudSsmProductSecretUpdate(d *schema.ResourceData, meta int
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -492,8 +507,8 @@ func resourceTencentCloudSsmProductSecretUpdate(d *schema.ResourceData, meta int
 			request.Description = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseSsmClient().UpdateDescription(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseSsmClient().UpdateDescriptionWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -503,7 +518,7 @@ func resourceTencentCloudSsmProductSecretUpdate(d *schema.ResourceData, meta int
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update ssm productSecret failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -515,12 +530,12 @@ func resourceTencentCloudSsmProductSecretUpdate(d *schema.ResourceData, meta int
 			if status == "Disabled" {
 				err := service.DisableSecret(ctx, secretName)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			} else {
 				err := service.EnableSecret(ctx, secretName)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -542,8 +557,8 @@ func resourceTencentCloudSsmProductSecretUpdate(d *schema.ResourceData, meta int
 			request.Frequency = helper.IntInt64(v.(int))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseSsmClient().UpdateRotationStatus(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseSsmClient().UpdateRotationStatusWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -553,7 +568,7 @@ func resourceTencentCloudSsmProductSecretUpdate(d *schema.ResourceData, meta int
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update ssm productSecret failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -565,38 +580,38 @@ func resourceTencentCloudSsmProductSecretUpdate(d *schema.ResourceData, meta int
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 		secretInfo, err := ssmService.DescribeSecretByName(ctx, secretName)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		resourceName := BuildTagResourceName("ssm", "secret", tcClient.Region, secretInfo.resourceId)
 		if err = tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
-	return resourceTencentCloudSsmProductSecretRead(d, meta)
+	return resourceTencentCloudSsmProductSecretRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSsmProductSecretDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSsmProductSecretDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssm_product_secret.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
 		secretName = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	// disable before destroy
 	err := service.DisableSecret(ctx, secretName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = service.DeleteSsmProductSecretById(ctx, secretName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
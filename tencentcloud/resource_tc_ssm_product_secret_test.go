@@ -29,6 +29,12 @@ func TestAccTencentCloudSsmProductSecretResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_ssm_product_secret.product_secret", "status", "Enabled"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_ssm_product_secret.product_secret",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"user_name_prefix", "domains", "privileges_list", "status"},
+			},
 		},
 	})
 }
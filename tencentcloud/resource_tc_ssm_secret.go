@@ -84,9 +84,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	ssm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ssm/v20190923"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -95,12 +97,17 @@ import (
 
 func resourceTencentCloudSsmSecret() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSsmSecretCreate,
-		Read:   resourceTencentCloudSsmSecretRead,
-		Update: resourceTencentCloudSsmSecretUpdate,
-		Delete: resourceTencentCloudSsmSecretDelete,
+		CreateContext: resourceTencentCloudSsmSecretCreate,
+		ReadContext:   resourceTencentCloudSsmSecretRead,
+		UpdateContext: resourceTencentCloudSsmSecretUpdate,
+		DeleteContext: resourceTencentCloudSsmSecretDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -159,12 +166,11 @@ func resourceTencentCloudSsmSecret() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSsmSecretCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSsmSecretCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssm_secret.create")()
 
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		ssmService    = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request       = ssm.NewCreateSecretRequest()
 		response      = ssm.NewCreateSecretResponse()
@@ -172,6 +178,7 @@ func resourceTencentCloudSsmSecretCreate(d *schema.ResourceData, meta interface{
 		outErr, inErr error
 		secretName    string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("secret_name"); ok {
 		request.SecretName = helper.String(v.(string))
@@ -194,8 +201,8 @@ func resourceTencentCloudSsmSecretCreate(d *schema.ResourceData, meta interface{
 	}
 
 	request.SecretString = helper.String("default")
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSsmClient().CreateSecret(request)
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSsmClient().CreateSecretWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -207,13 +214,13 @@ func resourceTencentCloudSsmSecretCreate(d *schema.ResourceData, meta interface{
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	secretName = *response.Response.SecretName
 
 	if isEnabled := d.Get("is_enabled").(bool); !isEnabled {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			inErr = ssmService.DisableSecret(ctx, secretName)
 			if inErr != nil {
 				return retryError(inErr)
@@ -223,12 +230,12 @@ func resourceTencentCloudSsmSecretCreate(d *schema.ResourceData, meta interface{
 		})
 
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			secretInfo, inErr = ssmService.DescribeSecretByName(ctx, secretName)
 			if inErr != nil {
 				return retryError(inErr)
@@ -238,34 +245,34 @@ func resourceTencentCloudSsmSecretCreate(d *schema.ResourceData, meta interface{
 		})
 
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		resourceName := BuildTagResourceName("ssm", "secret", tcClient.Region, secretInfo.resourceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(secretName)
-	return resourceTencentCloudSsmSecretRead(d, meta)
+	return resourceTencentCloudSsmSecretRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSsmSecretRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSsmSecretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssm_secret.read")()
 
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		ssmService    = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
 		secretName    = d.Id()
 		outErr, inErr error
 		secretInfo    *SecretInfo
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		secretInfo, inErr = ssmService.DescribeSecretByName(ctx, secretName)
 		if inErr != nil {
 			return retryError(inErr)
@@ -275,7 +282,7 @@ func resourceTencentCloudSsmSecretRead(d *schema.ResourceData, meta interface{})
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if secretInfo == nil {
@@ -300,22 +307,22 @@ func resourceTencentCloudSsmSecretRead(d *schema.ResourceData, meta interface{})
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "ssm", "secret", tcClient.Region, secretInfo.resourceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("tags", tags)
 	return nil
 }
 
-func resourceTencentCloudSsmSecretUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSsmSecretUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssm_secret.update")()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		ssmService = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
 		secretName = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	d.Partial(true)
 
@@ -326,13 +333,13 @@ func resourceTencentCloudSsmSecretUpdate(d *schema.ResourceData, meta interface{
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
 	if d.HasChange("description") {
 		description := d.Get("description").(string)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			e := ssmService.UpdateSecretDescription(ctx, secretName, description)
 			if e != nil {
 				return retryError(e)
@@ -343,7 +350,7 @@ func resourceTencentCloudSsmSecretUpdate(d *schema.ResourceData, meta interface{
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify SSM secret description failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -353,7 +360,7 @@ func resourceTencentCloudSsmSecretUpdate(d *schema.ResourceData, meta interface{
 		err := updateSecretIsEnabled(ctx, ssmService, secretName, isEnabled)
 		if err != nil {
 			log.Printf("[CRITAL]%s modify SSM secret status failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -365,34 +372,34 @@ func resourceTencentCloudSsmSecretUpdate(d *schema.ResourceData, meta interface{
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 		secretInfo, err := ssmService.DescribeSecretByName(ctx, secretName)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		resourceName := BuildTagResourceName("ssm", "secret", tcClient.Region, secretInfo.resourceId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	d.Partial(false)
-	return resourceTencentCloudSsmSecretRead(d, meta)
+	return resourceTencentCloudSsmSecretRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSsmSecretDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSsmSecretDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssm_secret.delete")()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		ssmService = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
 		secretName = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	recoveryWindowInDays := d.Get("recovery_window_in_days").(int)
 	isEnabled := d.Get("is_enabled").(bool)
 	if isEnabled {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			e := ssmService.DisableSecret(ctx, secretName)
 			if e != nil {
 				return retryError(e)
@@ -403,11 +410,11 @@ func resourceTencentCloudSsmSecretDelete(d *schema.ResourceData, meta interface{
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify SSM secret status failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := ssmService.DeleteSecret(ctx, secretName, uint64(recoveryWindowInDays))
 		if e != nil {
 			return retryError(e)
@@ -418,10 +425,10 @@ func resourceTencentCloudSsmSecretDelete(d *schema.ResourceData, meta interface{
 
 	if err != nil {
 		log.Printf("[CRITAL]%s delete SSM secret failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	return diag.FromErr(retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		secretInfo, e := ssmService.DescribeSecretByName(ctx, secretName)
 		if e != nil {
 			if sdkError, ok := e.(*sdkErrors.TencentCloudSDKError); ok && sdkError.Code == "ResourceNotFound" {
@@ -436,13 +443,13 @@ func resourceTencentCloudSsmSecretDelete(d *schema.ResourceData, meta interface{
 		}
 
 		return resource.RetryableError(fmt.Errorf("delete fail"))
-	})
+	}))
 }
 
 func updateSecretIsEnabled(ctx context.Context, ssmService SsmService, secretName string, isEnabled bool) error {
 	var err error
 	if isEnabled {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 			e := ssmService.EnableSecret(ctx, secretName)
 			if e != nil {
 				return retryError(e)
@@ -451,7 +458,7 @@ func updateSecretIsEnabled(ctx context.Context, ssmService SsmService, secretNam
 		})
 
 	} else {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 			e := ssmService.DisableSecret(ctx, secretName)
 			if e != nil {
 				return retryError(e)
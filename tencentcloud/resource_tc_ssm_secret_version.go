@@ -49,21 +49,28 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudSsmSecretVersion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSsmSecretVersionCreate,
-		Read:   resourceTencentCloudSsmSecretVersionRead,
-		Update: resourceTencentCloudSsmSecretVersionUpdate,
-		Delete: resourceTencentCloudSsmSecretVersionDelete,
+		CreateContext: resourceTencentCloudSsmSecretVersionCreate,
+		ReadContext:   resourceTencentCloudSsmSecretVersionRead,
+		UpdateContext: resourceTencentCloudSsmSecretVersionUpdate,
+		DeleteContext: resourceTencentCloudSsmSecretVersionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -95,16 +102,16 @@ func resourceTencentCloudSsmSecretVersion() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSsmSecretVersionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSsmSecretVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssm_secret_version.create")()
 
 	var (
 		logId                 = getLogId(contextNil)
-		ctx                   = context.WithValue(context.TODO(), logIdKey, logId)
 		ssmService            = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
 		outErr, inErr         error
 		secretName, versionId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	param := make(map[string]interface{})
 	param["secret_name"] = d.Get("secret_name").(string)
@@ -117,7 +124,7 @@ func resourceTencentCloudSsmSecretVersionCreate(d *schema.ResourceData, meta int
 		param["secret_string"] = v.(string)
 	}
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		secretName, versionId, inErr = ssmService.PutSecretValue(ctx, param)
 		if inErr != nil {
 			return retryError(inErr)
@@ -127,32 +134,32 @@ func resourceTencentCloudSsmSecretVersionCreate(d *schema.ResourceData, meta int
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	d.SetId(strings.Join([]string{secretName, versionId}, FILED_SP))
-	return resourceTencentCloudSsmSecretVersionRead(d, meta)
+	d.SetId(helper.IdFormat(secretName, versionId))
+	return resourceTencentCloudSsmSecretVersionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSsmSecretVersionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSsmSecretVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssm_secret_version.read")()
 
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		ssmService    = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
 		outErr, inErr error
 		secretInfo    *SecretInfo
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	ids := strings.Split(d.Id(), FILED_SP)
-	if len(ids) != 2 {
-		return fmt.Errorf("SSM secret version id can't read, id is borken, id is %s", d.Id())
+	ids, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	secretName := ids[0]
 	versionId := ids[1]
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		secretInfo, inErr = ssmService.DescribeSecretByName(ctx, secretName)
 		if inErr != nil {
 			return retryError(inErr)
@@ -162,11 +169,11 @@ func resourceTencentCloudSsmSecretVersionRead(d *schema.ResourceData, meta inter
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	var versionIds []string
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		versionIds, inErr = ssmService.DescribeSecretVersionIdsByName(ctx, secretName)
 		if inErr != nil {
 			return retryError(inErr)
@@ -176,7 +183,7 @@ func resourceTencentCloudSsmSecretVersionRead(d *schema.ResourceData, meta inter
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	var hasVersionId bool
@@ -194,7 +201,7 @@ func resourceTencentCloudSsmSecretVersionRead(d *schema.ResourceData, meta inter
 
 	if secretInfo.status == SSM_STATUS_ENABLED {
 		var secretVersionInfo *SecretVersionInfo
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			secretVersionInfo, inErr = ssmService.DescribeSecretVersion(ctx, secretName, versionId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -204,7 +211,7 @@ func resourceTencentCloudSsmSecretVersionRead(d *schema.ResourceData, meta inter
 		})
 
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 		_ = d.Set("secret_name", secretVersionInfo.secretName)
@@ -216,25 +223,25 @@ func resourceTencentCloudSsmSecretVersionRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudSsmSecretVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSsmSecretVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssm_secret_version.update")()
 
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		ssmService    = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
 		outErr, inErr error
 		secretInfo    *SecretInfo
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	ids := strings.Split(d.Id(), FILED_SP)
-	if len(ids) != 2 {
-		return fmt.Errorf("SSM secret version id can't read, id is borken, id is %s", d.Id())
+	ids, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	secretName := ids[0]
 	versionId := ids[1]
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		secretInfo, inErr = ssmService.DescribeSecretByName(ctx, secretName)
 		if inErr != nil {
 			return retryError(inErr)
@@ -244,7 +251,7 @@ func resourceTencentCloudSsmSecretVersionUpdate(d *schema.ResourceData, meta int
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if secretInfo.status == SSM_STATUS_ENABLED {
@@ -262,7 +269,7 @@ func resourceTencentCloudSsmSecretVersionUpdate(d *schema.ResourceData, meta int
 		}
 
 		if d.HasChange("secret_binary") || d.HasChange("secret_string") {
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				e := ssmService.UpdateSecret(ctx, param)
 				if e != nil {
 					return retryError(e)
@@ -273,33 +280,33 @@ func resourceTencentCloudSsmSecretVersionUpdate(d *schema.ResourceData, meta int
 
 			if err != nil {
 				log.Printf("[CRITAL]%s modify SSM secret content failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 		d.Partial(false)
 	}
 
-	return resourceTencentCloudSsmSecretVersionRead(d, meta)
+	return resourceTencentCloudSsmSecretVersionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSsmSecretVersionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSsmSecretVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssm_secret_version.delete")()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		ssmService = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	ids := strings.Split(d.Id(), FILED_SP)
+	ids := helper.IdParse(d.Id())
 	if len(ids) != 2 {
-		return fmt.Errorf("SSM secret version id can't read, id is borken, id is %s", d.Id())
+		return diag.Errorf("SSM secret version id can't read, id is borken, id is %s", d.Id())
 	}
 	secretName := ids[0]
 	versionId := ids[1]
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		e := ssmService.DeleteSecretVersion(ctx, secretName, versionId)
 		if e != nil {
 			return retryError(e)
@@ -310,10 +317,10 @@ func resourceTencentCloudSsmSecretVersionDelete(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s delete SSM secret version failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	return diag.FromErr(retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, e := ssmService.DescribeSecretVersion(ctx, secretName, versionId)
 		if e != nil {
 			if sdkError, ok := e.(*sdkErrors.TencentCloudSDKError); ok && sdkError.Code == "ResourceNotFound" {
@@ -324,5 +331,5 @@ func resourceTencentCloudSsmSecretVersionDelete(d *schema.ResourceData, meta int
 		}
 
 		return resource.RetryableError(fmt.Errorf("delete fail"))
-	})
+	}))
 }
@@ -42,9 +42,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ssm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ssm/v20190923"
@@ -53,12 +54,16 @@ import (
 
 func resourceTencentCloudSsmSshKeyPairSecret() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSsmSshKeyPairSecretCreate,
-		Read:   resourceTencentCloudSsmSshKeyPairSecretRead,
-		Update: resourceTencentCloudSsmSshKeyPairSecretUpdate,
-		Delete: resourceTencentCloudSsmSshKeyPairSecretDelete,
+		CreateContext: resourceTencentCloudSsmSshKeyPairSecretCreate,
+		ReadContext:   resourceTencentCloudSsmSshKeyPairSecretRead,
+		UpdateContext: resourceTencentCloudSsmSshKeyPairSecretUpdate,
+		DeleteContext: resourceTencentCloudSsmSshKeyPairSecretDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"secret_name": {
@@ -120,19 +125,19 @@ func resourceTencentCloudSsmSshKeyPairSecret() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudSsmSshKeyPairSecretCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSsmSshKeyPairSecretCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssm_ssh_key_pair_secret.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		ssmService = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = ssm.NewCreateSSHKeyPairSecretRequest()
 		response   = ssm.NewCreateSSHKeyPairSecretResponse()
 		secretInfo *SecretInfo
 		secretName string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("secret_name"); ok {
 		request.SecretName = helper.String(v.(string))
@@ -154,8 +159,8 @@ func resourceTencentCloudSsmSshKeyPairSecretCreate(d *schema.ResourceData, meta
 		request.SSHKeyName = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSsmClient().CreateSSHKeyPairSecret(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseSsmClient().CreateSSHKeyPairSecretWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -168,7 +173,7 @@ func resourceTencentCloudSsmSshKeyPairSecretCreate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create ssm sshKeyPairSecret failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	secretName = *response.Response.SecretName
@@ -179,13 +184,13 @@ func resourceTencentCloudSsmSshKeyPairSecretCreate(d *schema.ResourceData, meta
 		if status == "Disabled" {
 			err = ssmService.DisableSecret(ctx, secretName)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
-		outErr := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			secretInfo, err = ssmService.DescribeSecretByName(ctx, secretName)
 			if err != nil {
 				return retryError(err)
@@ -195,36 +200,36 @@ func resourceTencentCloudSsmSshKeyPairSecretCreate(d *schema.ResourceData, meta
 		})
 
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		resourceName := BuildTagResourceName("ssm", "secret", tcClient.Region, secretInfo.resourceId)
 		if err = tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(secretName)
-	return resourceTencentCloudSsmSshKeyPairSecretRead(d, meta)
+	return resourceTencentCloudSsmSshKeyPairSecretRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSsmSshKeyPairSecretRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSsmSshKeyPairSecretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssm_ssh_key_pair_secret.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
 		secretInfo *SecretInfo
 		secretName = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	sshKeyPairSecret, err := service.DescribeSecretById(ctx, secretName, 2)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if sshKeyPairSecret == nil {
@@ -265,7 +270,7 @@ func resourceTencentCloudSsmSshKeyPairSecretRead(d *schema.ResourceData, meta in
 		_ = d.Set("secret_type", sshKeyPairSecret.SecretType)
 	}
 
-	outErr := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		secretInfo, err = service.DescribeSecretByName(ctx, secretName)
 		if err != nil {
 			return retryError(err)
@@ -275,30 +280,30 @@ func resourceTencentCloudSsmSshKeyPairSecretRead(d *schema.ResourceData, meta in
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	tcClient := meta.(*TencentCloudClient).apiV3Conn
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "ssm", "secret", tcClient.Region, secretInfo.resourceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("tags", tags)
 	return nil
 }
 
-func resourceTencentCloudSsmSshKeyPairSecretUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSsmSshKeyPairSecretUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssm_ssh_key_pair_secret.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		ssmService = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
 		secretName = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	immutableArgs := []string{
 		"project_id",
@@ -308,7 +313,7 @@ func resourceTencentCloudSsmSshKeyPairSecretUpdate(d *schema.ResourceData, meta
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -320,8 +325,8 @@ func resourceTencentCloudSsmSshKeyPairSecretUpdate(d *schema.ResourceData, meta
 			request.Description = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseSsmClient().UpdateDescription(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseSsmClient().UpdateDescriptionWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -333,7 +338,7 @@ func resourceTencentCloudSsmSshKeyPairSecretUpdate(d *schema.ResourceData, meta
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update ssm sshKeyPairSecret failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -345,12 +350,12 @@ func resourceTencentCloudSsmSshKeyPairSecretUpdate(d *schema.ResourceData, meta
 			if status == "Disabled" {
 				err := service.DisableSecret(ctx, secretName)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			} else {
 				err := service.EnableSecret(ctx, secretName)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -364,34 +369,34 @@ func resourceTencentCloudSsmSshKeyPairSecretUpdate(d *schema.ResourceData, meta
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 		secretInfo, err := ssmService.DescribeSecretByName(ctx, secretName)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		resourceName := BuildTagResourceName("ssm", "secret", tcClient.Region, secretInfo.resourceId)
 		if err = tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
-	return resourceTencentCloudSsmSshKeyPairSecretRead(d, meta)
+	return resourceTencentCloudSsmSshKeyPairSecretRead(ctx, d, meta)
 }
 
-func resourceTencentCloudSsmSshKeyPairSecretDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudSsmSshKeyPairSecretDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_ssm_ssh_key_pair_secret.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = SsmService{client: meta.(*TencentCloudClient).apiV3Conn}
 		secretName = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	// disable before destroy
 	err := service.DisableSecret(ctx, secretName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var cleanSSHKey *bool
@@ -401,7 +406,7 @@ func resourceTencentCloudSsmSshKeyPairSecretDelete(d *schema.ResourceData, meta
 	}
 
 	if err = service.DeleteSsmSshKeyPairSecretById(ctx, secretName, cleanSSHKey); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
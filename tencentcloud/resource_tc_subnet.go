@@ -34,7 +34,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -43,12 +45,15 @@ import (
 
 func resourceTencentCloudVpcSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcSubnetCreate,
-		Read:   resourceTencentCloudVpcSubnetRead,
-		Update: resourceTencentCloudVpcSubnetUpdate,
-		Delete: resourceTencentCloudVpcSubnetDelete,
+		CreateContext: resourceTencentCloudVpcSubnetCreate,
+		ReadContext:   resourceTencentCloudVpcSubnetRead,
+		UpdateContext: resourceTencentCloudVpcSubnetUpdate,
+		DeleteContext: resourceTencentCloudVpcSubnetDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -115,11 +120,11 @@ func resourceTencentCloudVpcSubnet() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcSubnetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcSubnetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_subnet.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	vpcService := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -135,13 +140,13 @@ func resourceTencentCloudVpcSubnetCreate(d *schema.ResourceData, meta interface{
 	if temp, ok := d.GetOk("vpc_id"); ok {
 		vpcId = temp.(string)
 		if len(vpcId) < 1 {
-			return fmt.Errorf("vpc_id should be not empty string")
+			return diag.Errorf("vpc_id should be not empty string")
 		}
 	}
 	if temp, ok := d.GetOk("availability_zone"); ok {
 		availabilityZone = temp.(string)
 		if len(availabilityZone) < 1 {
-			return fmt.Errorf("availability_zone should be not empty string")
+			return diag.Errorf("availability_zone should be not empty string")
 		}
 	}
 	if temp, ok := d.GetOk("name"); ok {
@@ -156,19 +161,19 @@ func resourceTencentCloudVpcSubnetCreate(d *schema.ResourceData, meta interface{
 	if temp, ok := d.GetOk("route_table_id"); ok {
 		routeTableId = temp.(string)
 		if len(routeTableId) < 1 {
-			return fmt.Errorf("route_table_id should be not empty string")
+			return diag.Errorf("route_table_id should be not empty string")
 		}
 	}
 
 	if routeTableId != "" {
 		_, has, err := vpcService.IsRouteTableInVpc(ctx, routeTableId, vpcId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if has != 1 {
 			err = fmt.Errorf("error,route_table [%s]  not found in vpc [%s]", routeTableId, vpcId)
 			log.Printf("[CRITAL]%s %s", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -178,19 +183,19 @@ func resourceTencentCloudVpcSubnetCreate(d *schema.ResourceData, meta interface{
 
 	subnetId, err := vpcService.CreateSubnet(ctx, vpcId, name, cidrBlock, availabilityZone, tags)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(subnetId)
 
 	err = vpcService.ModifySubnetAttribute(ctx, subnetId, name, isMulticast)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if routeTableId != "" {
 		err = vpcService.ReplaceRouteTableAssociation(ctx, subnetId, routeTableId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -201,19 +206,19 @@ func resourceTencentCloudVpcSubnetCreate(d *schema.ResourceData, meta interface{
 		resourceName := fmt.Sprintf("qcs::vpc:%s:uin/:subnet/%s", region, subnetId)
 
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudVpcSubnetRead(d, meta)
+	return resourceTencentCloudVpcSubnetRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcSubnetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcSubnetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_subnet.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -225,7 +230,7 @@ func resourceTencentCloudVpcSubnetRead(d *schema.ResourceData, meta interface{})
 		has  int
 		e    error
 	)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		info, has, e = vpcService.DescribeSubnet(ctx, id, nil, "", "")
 		if e != nil {
 			return retryError(e)
@@ -245,14 +250,14 @@ func resourceTencentCloudVpcSubnetRead(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if has == 0 {
 		return nil
 	}
 	tags, err := tagService.DescribeResourceTags(ctx, "vpc", "subnet", region, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("vpc_id", info.vpcId)
@@ -269,11 +274,11 @@ func resourceTencentCloudVpcSubnetRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudVpcSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcSubnetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_subnet.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -300,27 +305,27 @@ func resourceTencentCloudVpcSubnetUpdate(d *schema.ResourceData, meta interface{
 	d.Partial(true)
 
 	if err := service.ModifySubnetAttribute(ctx, id, name, isMulticast); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("route_table_id") {
 		routeTableId := d.Get("route_table_id").(string)
 		if len(routeTableId) < 1 {
-			return fmt.Errorf("route_table_id should be not empty string")
+			return diag.Errorf("route_table_id should be not empty string")
 		}
 
 		_, has, err := service.IsRouteTableInVpc(ctx, routeTableId, d.Get("vpc_id").(string))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if has != 1 {
 			err = fmt.Errorf("error,route_table [%s]  not found in vpc [%s]", routeTableId, d.Get("vpc_id").(string))
 			log.Printf("[CRITAL]%s %s", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 		if err := service.ReplaceRouteTableAssociation(ctx, id, routeTableId); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -334,25 +339,25 @@ func resourceTencentCloudVpcSubnetUpdate(d *schema.ResourceData, meta interface{
 		resourceName := fmt.Sprintf("qcs::vpc:%s:uin/:subnet/%s", region, id)
 
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudVpcSubnetRead(d, meta)
+	return resourceTencentCloudVpcSubnetRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcSubnetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcSubnetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_subnet.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if err := service.DeleteSubnet(ctx, d.Id()); err != nil {
 			if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok {
 				if sdkErr.Code == VPCNotFound {
@@ -364,5 +369,5 @@ func resourceTencentCloudVpcSubnetDelete(d *schema.ResourceData, meta interface{
 		return nil
 	})
 
-	return err
+	return diag.FromErr(err)
 }
@@ -24,10 +24,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tag "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tag/v20180813"
@@ -36,11 +36,14 @@ import (
 
 func resourceTencentCloudTag() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTagResourceCreate,
-		Read:   resourceTencentCloudTagResourceRead,
-		Delete: resourceTencentCloudTagResourceDelete,
+		CreateContext: resourceTencentCloudTagResourceCreate,
+		ReadContext:   resourceTencentCloudTagResourceRead,
+		DeleteContext: resourceTencentCloudTagResourceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"tag_key": {
@@ -60,7 +63,7 @@ func resourceTencentCloudTag() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTagResourceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTagResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tag.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -81,8 +84,8 @@ func resourceTencentCloudTagResourceCreate(d *schema.ResourceData, meta interfac
 		request.TagValue = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTagClient().CreateTag(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTagClient().CreateTagWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -92,34 +95,34 @@ func resourceTencentCloudTagResourceCreate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tag tag failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(tagKey + FILED_SP + tagValue)
+	d.SetId(helper.IdFormat(tagKey, tagValue))
 
-	return resourceTencentCloudTagResourceRead(d, meta)
+	return resourceTencentCloudTagResourceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTagResourceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTagResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tag.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	tagKey := idSplit[0]
 	tagValue := idSplit[1]
 
 	tagRes, err := service.DescribeTagResourceById(ctx, tagKey, tagValue)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if tagRes == nil {
@@ -139,23 +142,23 @@ func resourceTencentCloudTagResourceRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTencentCloudTagResourceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTagResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tag.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	tagKey := idSplit[0]
 	tagValue := idSplit[1]
 
 	if err := service.DeleteTagResourceById(ctx, tagKey, tagValue); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
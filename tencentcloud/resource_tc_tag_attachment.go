@@ -25,10 +25,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tag "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tag/v20180813"
@@ -37,11 +37,14 @@ import (
 
 func resourceTencentCloudTagAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTagAttachmentCreate,
-		Read:   resourceTencentCloudTagAttachmentRead,
-		Delete: resourceTencentCloudTagAttachmentDelete,
+		CreateContext: resourceTencentCloudTagAttachmentCreate,
+		ReadContext:   resourceTencentCloudTagAttachmentRead,
+		DeleteContext: resourceTencentCloudTagAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"tag_key": {
@@ -68,7 +71,7 @@ func resourceTencentCloudTagAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTagAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTagAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tag_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -95,8 +98,8 @@ func resourceTencentCloudTagAttachmentCreate(d *schema.ResourceData, meta interf
 		request.Resource = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTagClient().AddResourceTag(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTagClient().AddResourceTagWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -106,27 +109,27 @@ func resourceTencentCloudTagAttachmentCreate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tag tagAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(tagKey + FILED_SP + tagValue + FILED_SP + resourceId)
+	d.SetId(helper.IdFormat(tagKey, tagValue, resourceId))
 
-	return resourceTencentCloudTagAttachmentRead(d, meta)
+	return resourceTencentCloudTagAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTagAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTagAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tag_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	tagKey := idSplit[0]
 	tagValue := idSplit[1]
@@ -134,7 +137,7 @@ func resourceTencentCloudTagAttachmentRead(d *schema.ResourceData, meta interfac
 
 	tagAttachment, err := service.DescribeTagTagAttachmentById(ctx, tagKey, tagValue, resource)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if tagAttachment == nil {
@@ -161,23 +164,23 @@ func resourceTencentCloudTagAttachmentRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudTagAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTagAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tag_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	tagKey := idSplit[0]
 	resource := idSplit[2]
 
 	if err := service.DeleteTagTagAttachmentById(ctx, tagKey, resource); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -30,9 +30,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tat/v20201028"
@@ -41,12 +42,16 @@ import (
 
 func resourceTencentCloudTatCommand() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTatCommandRead,
-		Create: resourceTencentCloudTatCommandCreate,
-		Update: resourceTencentCloudTatCommandUpdate,
-		Delete: resourceTencentCloudTatCommandDelete,
+		ReadContext:   resourceTencentCloudTatCommandRead,
+		CreateContext: resourceTencentCloudTatCommandCreate,
+		UpdateContext: resourceTencentCloudTatCommandUpdate,
+		DeleteContext: resourceTencentCloudTatCommandDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"command_name": {
@@ -162,7 +167,7 @@ func resourceTencentCloudTatCommand() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTatCommandCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTatCommandCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tat_command.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -233,8 +238,8 @@ func resourceTencentCloudTatCommandCreate(d *schema.ResourceData, meta interface
 		request.OutputCOSKeyPrefix = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTatClient().CreateCommand(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTatClient().CreateCommandWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -247,21 +252,21 @@ func resourceTencentCloudTatCommandCreate(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tat command failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	commandId = *response.Response.CommandId
 
 	d.SetId(commandId)
-	return resourceTencentCloudTatCommandRead(d, meta)
+	return resourceTencentCloudTatCommandRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTatCommandRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTatCommandRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tat_command.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TatService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -270,12 +275,12 @@ func resourceTencentCloudTatCommandRead(d *schema.ResourceData, meta interface{}
 	command, err := service.DescribeTatCommand(ctx, commandId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if command == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `command` %s does not exist", commandId)
+		return diag.Errorf("resource `command` %s does not exist", commandId)
 	}
 
 	if command.CommandName != nil {
@@ -285,7 +290,7 @@ func resourceTencentCloudTatCommandRead(d *schema.ResourceData, meta interface{}
 	if command.Content != nil {
 		content, err := Base64ToString(*command.Content)
 		if err != nil {
-			return fmt.Errorf("`Content` [%v] base64 to string failed, err: %v.", *command.Content, err)
+			return diag.Errorf("`Content` [%v] base64 to string failed, err: %v.", *command.Content, err)
 		}
 		_ = d.Set("content", content)
 	}
@@ -361,7 +366,7 @@ func resourceTencentCloudTatCommandRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudTatCommandUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTatCommandUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tat_command.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -409,7 +414,7 @@ func resourceTencentCloudTatCommandUpdate(d *schema.ResourceData, meta interface
 	}
 
 	if d.HasChange("enable_parameter") {
-		return fmt.Errorf("`enable_parameter` do not support change now.")
+		return diag.Errorf("`enable_parameter` do not support change now.")
 	}
 
 	if d.HasChange("default_parameters") {
@@ -419,7 +424,7 @@ func resourceTencentCloudTatCommandUpdate(d *schema.ResourceData, meta interface
 	}
 
 	if d.HasChange("tags") {
-		return fmt.Errorf("`tags` do not support change now.")
+		return diag.Errorf("`tags` do not support change now.")
 	}
 
 	if d.HasChange("username") {
@@ -440,8 +445,8 @@ func resourceTencentCloudTatCommandUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTatClient().ModifyCommand(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTatClient().ModifyCommandWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -453,25 +458,25 @@ func resourceTencentCloudTatCommandUpdate(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tat command failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTatCommandRead(d, meta)
+	return resourceTencentCloudTatCommandRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTatCommandDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTatCommandDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tat_command.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TatService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	commandId := d.Id()
 
 	if err := service.DeleteTatCommandById(ctx, commandId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
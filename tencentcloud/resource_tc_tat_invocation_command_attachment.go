@@ -27,10 +27,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tat/v20201028"
@@ -39,9 +39,12 @@ import (
 
 func resourceTencentCloudTatInvocationCommandAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTatInvocationCommandAttachmentCreate,
-		Read:   resourceTencentCloudTatInvocationCommandAttachmentRead,
-		Delete: resourceTencentCloudTatInvocationCommandAttachmentDelete,
+		CreateContext: resourceTencentCloudTatInvocationCommandAttachmentCreate,
+		ReadContext:   resourceTencentCloudTatInvocationCommandAttachmentRead,
+		DeleteContext: resourceTencentCloudTatInvocationCommandAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		// Importer: &schema.ResourceImporter{
 		// 	State: schema.ImportStatePassthrough,
 		// },
@@ -154,7 +157,7 @@ func resourceTencentCloudTatInvocationCommandAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTatInvocationCommandAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTatInvocationCommandAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tat_invocation_command_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -223,8 +226,8 @@ func resourceTencentCloudTatInvocationCommandAttachmentCreate(d *schema.Resource
 		request.OutputCOSKeyPrefix = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTatClient().RunCommand(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTatClient().RunCommandWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -235,35 +238,35 @@ func resourceTencentCloudTatInvocationCommandAttachmentCreate(d *schema.Resource
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tat invocationCommandAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	invocationId = *response.Response.InvocationId
-	d.SetId(invocationId + FILED_SP + instanceId)
+	d.SetId(helper.IdFormat(invocationId, instanceId))
 
-	return resourceTencentCloudTatInvocationCommandAttachmentRead(d, meta)
+	return resourceTencentCloudTatInvocationCommandAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTatInvocationCommandAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTatInvocationCommandAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tat_invocation_command_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TatService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	invocationId := idSplit[0]
 	instanceId := idSplit[1]
 
 	invocation, err := service.DescribeTatInvocationById(ctx, invocationId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if invocation == nil {
@@ -333,24 +336,24 @@ func resourceTencentCloudTatInvocationCommandAttachmentRead(d *schema.ResourceDa
 	return nil
 }
 
-func resourceTencentCloudTatInvocationCommandAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTatInvocationCommandAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tat_invocation_command_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TatService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	invocationId := idSplit[0]
 	instanceId := idSplit[1]
 
 	if err := service.DeleteTatInvocationById(ctx, invocationId, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -28,10 +28,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tat/v20201028"
@@ -40,11 +40,14 @@ import (
 
 func resourceTencentCloudTatInvocationInvokeAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTatInvocationInvokeAttachmentCreate,
-		Read:   resourceTencentCloudTatInvocationInvokeAttachmentRead,
-		Delete: resourceTencentCloudTatInvocationInvokeAttachmentDelete,
+		CreateContext: resourceTencentCloudTatInvocationInvokeAttachmentCreate,
+		ReadContext:   resourceTencentCloudTatInvocationInvokeAttachmentRead,
+		DeleteContext: resourceTencentCloudTatInvocationInvokeAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -107,7 +110,7 @@ func resourceTencentCloudTatInvocationInvokeAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTatInvocationInvokeAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTatInvocationInvokeAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tat_invocation_invoke_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -152,8 +155,8 @@ func resourceTencentCloudTatInvocationInvokeAttachmentCreate(d *schema.ResourceD
 		request.CommandId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTatClient().InvokeCommand(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTatClient().InvokeCommandWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -164,35 +167,35 @@ func resourceTencentCloudTatInvocationInvokeAttachmentCreate(d *schema.ResourceD
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tat invocation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	invocationId = *response.Response.InvocationId
-	d.SetId(invocationId + FILED_SP + instanceId)
+	d.SetId(helper.IdFormat(invocationId, instanceId))
 
-	return resourceTencentCloudTatInvocationInvokeAttachmentRead(d, meta)
+	return resourceTencentCloudTatInvocationInvokeAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTatInvocationInvokeAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTatInvocationInvokeAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tat_invocation_invoke_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TatService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	invocationId := idSplit[0]
 	instanceId := idSplit[1]
 
 	invocation, err := service.DescribeTatInvocationById(ctx, invocationId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if invocation == nil {
@@ -234,23 +237,23 @@ func resourceTencentCloudTatInvocationInvokeAttachmentRead(d *schema.ResourceDat
 	return nil
 }
 
-func resourceTencentCloudTatInvocationInvokeAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTatInvocationInvokeAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tat_invocation_invoke_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TatService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	invocationId := idSplit[0]
 	instanceId := idSplit[1]
 
 	if err := service.DeleteTatInvocationById(ctx, invocationId, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -30,9 +30,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tat/v20201028"
@@ -41,12 +42,16 @@ import (
 
 func resourceTencentCloudTatInvoker() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTatInvokerRead,
-		Create: resourceTencentCloudTatInvokerCreate,
-		Update: resourceTencentCloudTatInvokerUpdate,
-		Delete: resourceTencentCloudTatInvokerDelete,
+		ReadContext:   resourceTencentCloudTatInvokerRead,
+		CreateContext: resourceTencentCloudTatInvokerCreate,
+		UpdateContext: resourceTencentCloudTatInvokerUpdate,
+		DeleteContext: resourceTencentCloudTatInvokerDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -141,7 +146,7 @@ func resourceTencentCloudTatInvoker() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTatInvokerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTatInvokerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tat_invoker.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -196,8 +201,8 @@ func resourceTencentCloudTatInvokerCreate(d *schema.ResourceData, meta interface
 		request.ScheduleSettings = &scheduleSettings
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTatClient().CreateInvoker(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTatClient().CreateInvokerWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -210,21 +215,21 @@ func resourceTencentCloudTatInvokerCreate(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tat invoker failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	invokerId = *response.Response.InvokerId
 
 	d.SetId(invokerId)
-	return resourceTencentCloudTatInvokerRead(d, meta)
+	return resourceTencentCloudTatInvokerRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTatInvokerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTatInvokerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tat_invoker.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TatService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -233,12 +238,12 @@ func resourceTencentCloudTatInvokerRead(d *schema.ResourceData, meta interface{}
 	invoker, err := service.DescribeTatInvoker(ctx, invokerId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if invoker == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `invoker` %s does not exist", invokerId)
+		return diag.Errorf("resource `invoker` %s does not exist", invokerId)
 	}
 
 	if invoker.Name != nil {
@@ -299,7 +304,7 @@ func resourceTencentCloudTatInvokerRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudTatInvokerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTatInvokerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tat_invoker.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -368,8 +373,8 @@ func resourceTencentCloudTatInvokerUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTatClient().ModifyInvoker(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTatClient().ModifyInvokerWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -381,25 +386,25 @@ func resourceTencentCloudTatInvokerUpdate(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tat invoker failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTatInvokerRead(d, meta)
+	return resourceTencentCloudTatInvokerRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTatInvokerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTatInvokerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tat_invoker.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TatService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	invokerId := d.Id()
 
 	if err := service.DeleteTatInvokerById(ctx, invokerId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
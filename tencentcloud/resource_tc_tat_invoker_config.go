@@ -23,7 +23,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tat/v20201028"
@@ -31,12 +33,15 @@ import (
 
 func resourceTencentCloudTatInvokerConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTatInvokerConfigCreate,
-		Read:   resourceTencentCloudTatInvokerConfigRead,
-		Update: resourceTencentCloudTatInvokerConfigUpdate,
-		Delete: resourceTencentCloudTatInvokerConfigDelete,
+		CreateContext: resourceTencentCloudTatInvokerConfigCreate,
+		ReadContext:   resourceTencentCloudTatInvokerConfigRead,
+		UpdateContext: resourceTencentCloudTatInvokerConfigUpdate,
+		DeleteContext: resourceTencentCloudTatInvokerConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"invoker_id": {
@@ -55,7 +60,7 @@ func resourceTencentCloudTatInvokerConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTatInvokerConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTatInvokerConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tat_invoker_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -68,16 +73,16 @@ func resourceTencentCloudTatInvokerConfigCreate(d *schema.ResourceData, meta int
 
 	d.SetId(invokerId)
 
-	return resourceTencentCloudTatInvokerConfigUpdate(d, meta)
+	return resourceTencentCloudTatInvokerConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudTatInvokerConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTatInvokerConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tat_invoker_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TatService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -85,7 +90,7 @@ func resourceTencentCloudTatInvokerConfigRead(d *schema.ResourceData, meta inter
 
 	invokerConfig, err := service.DescribeTatInvokerConfigById(ctx, invokerId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if invokerConfig == nil {
@@ -107,7 +112,7 @@ func resourceTencentCloudTatInvokerConfigRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudTatInvokerConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTatInvokerConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tat_invoker_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -124,8 +129,8 @@ func resourceTencentCloudTatInvokerConfigUpdate(d *schema.ResourceData, meta int
 		status := v.(string)
 		if status == "on" {
 			enableInvokerRequest.InvokerId = &invokerId
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseTatClient().EnableInvoker(enableInvokerRequest)
+			err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseTatClient().EnableInvokerWithContext(ctx, enableInvokerRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -135,8 +140,8 @@ func resourceTencentCloudTatInvokerConfigUpdate(d *schema.ResourceData, meta int
 			})
 		} else {
 			disableInvokerRequest.InvokerId = &invokerId
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseTatClient().DisableInvoker(disableInvokerRequest)
+			err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseTatClient().DisableInvokerWithContext(ctx, disableInvokerRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -149,13 +154,13 @@ func resourceTencentCloudTatInvokerConfigUpdate(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update tat invokerConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTatInvokerConfigRead(d, meta)
+	return resourceTencentCloudTatInvokerConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTatInvokerConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTatInvokerConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tat_invoker_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
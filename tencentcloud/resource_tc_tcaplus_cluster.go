@@ -49,6 +49,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -56,12 +57,17 @@ import (
 
 func resourceTencentCloudTcaplusCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcaplusClusterCreate,
-		Read:   resourceTencentCloudTcaplusClusterRead,
-		Update: resourceTencentCloudTcaplusClusterUpdate,
-		Delete: resourceTencentCloudTcaplusClusterDelete,
+		CreateContext: resourceTencentCloudTcaplusClusterCreate,
+		ReadContext:   resourceTencentCloudTcaplusClusterRead,
+		UpdateContext: resourceTencentCloudTcaplusClusterUpdate,
+		DeleteContext: resourceTencentCloudTcaplusClusterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -173,12 +179,12 @@ func resourceTencentCloudTcaplusCluster() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcaplusClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcaplusClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	defer logElapsed("resource.tencentcloud_tcaplus_cluster.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcaplusService := TcaplusService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -193,7 +199,7 @@ func resourceTencentCloudTcaplusClusterCreate(d *schema.ResourceData, meta inter
 	var clusterId string
 	var inErr, outErr error
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		clusterId, inErr = tcaplusService.CreateCluster(ctx, idlType, clusterName, vpcId, subnetId, password)
 		if inErr != nil {
 			return retryError(inErr)
@@ -201,25 +207,25 @@ func resourceTencentCloudTcaplusClusterCreate(d *schema.ResourceData, meta inter
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	d.SetId(clusterId)
 	time.Sleep(3 * time.Second)
-	return resourceTencentCloudTcaplusClusterRead(d, meta)
+	return resourceTencentCloudTcaplusClusterRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcaplusClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcaplusClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcaplus_cluster.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcaplusService := TcaplusService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	clusterInfo, has, err := tcaplusService.DescribeCluster(ctx, d.Id())
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			clusterInfo, has, err = tcaplusService.DescribeCluster(ctx, d.Id())
 			if err != nil {
 				return retryError(err)
@@ -228,7 +234,7 @@ func resourceTencentCloudTcaplusClusterRead(d *schema.ResourceData, meta interfa
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -254,18 +260,18 @@ func resourceTencentCloudTcaplusClusterRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudTcaplusClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcaplusClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcaplus_clusterupdate")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcaplusService := TcaplusService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	d.Partial(true)
 
 	if d.HasChange("cluster_name") {
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			err := tcaplusService.ModifyClusterName(ctx, d.Id(), d.Get("cluster_name").(string))
 			if err != nil {
 				return retryError(err)
@@ -273,13 +279,13 @@ func resourceTencentCloudTcaplusClusterUpdate(d *schema.ResourceData, meta inter
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("password") {
 		oldPwd, newPwd := d.GetChange("password")
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			err := tcaplusService.ModifyClusterPassword(ctx, d.Id(),
 				oldPwd.(string),
 				newPwd.(string),
@@ -297,27 +303,27 @@ func resourceTencentCloudTcaplusClusterUpdate(d *schema.ResourceData, meta inter
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudTcaplusClusterRead(d, meta)
+	return resourceTencentCloudTcaplusClusterRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcaplusClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcaplusClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcaplus_cluster.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcaplusService := TcaplusService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	_, err := tcaplusService.DeleteCluster(ctx, d.Id())
 
 	if err != nil {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			_, err = tcaplusService.DeleteCluster(ctx, d.Id())
 			if err != nil {
 				return retryError(err)
@@ -327,12 +333,12 @@ func resourceTencentCloudTcaplusClusterDelete(d *schema.ResourceData, meta inter
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, has, err := tcaplusService.DescribeCluster(ctx, d.Id())
 	if err != nil || has {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			_, has, err = tcaplusService.DescribeCluster(ctx, d.Id())
 			if err != nil {
 				return retryError(err)
@@ -347,11 +353,11 @@ func resourceTencentCloudTcaplusClusterDelete(d *schema.ResourceData, meta inter
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		return nil
 	} else {
-		return errors.New("delete cluster fail, cluster still exist from sdk DescribeClusters")
+		return diag.FromErr(errors.New("delete cluster fail, cluster still exist from sdk DescribeClusters"))
 	}
 }
@@ -77,9 +77,10 @@ package tencentcloud
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -95,9 +96,13 @@ type TcaplusIdlId struct {
 
 func resourceTencentCloudTcaplusIdl() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcaplusIdlCreate,
-		Read:   resourceTencentCloudTcaplusIdlRead,
-		Delete: resourceTencentCloudTcaplusIdlDelete,
+		CreateContext: resourceTencentCloudTcaplusIdlCreate,
+		ReadContext:   resourceTencentCloudTcaplusIdlRead,
+		DeleteContext: resourceTencentCloudTcaplusIdlDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -187,11 +192,11 @@ func resourceTencentCloudTcaplusIdl() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcaplusIdlCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcaplusIdlCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcaplus_idl.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcaplusService := TcaplusService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -206,13 +211,13 @@ func resourceTencentCloudTcaplusIdlCreate(d *schema.ResourceData, meta interface
 
 	items := strings.Split(groupId, ":")
 	if len(items) != 2 {
-		return fmt.Errorf("group id is broken,%s", groupId)
+		return diag.Errorf("group id is broken,%s", groupId)
 	}
 	groupId = items[1]
 
 	matchExtTypes := FileExtTypeMatch[tcaplusIdlId.FileType]
 	if matchExtTypes == nil || !matchExtTypes[tcaplusIdlId.FileExtType] {
-		return fmt.Errorf("file_ext_type %s not match file_type %s",
+		return diag.Errorf("file_ext_type %s not match file_type %s",
 			tcaplusIdlId.FileExtType, tcaplusIdlId.FileType)
 	}
 
@@ -220,7 +225,7 @@ func resourceTencentCloudTcaplusIdlCreate(d *schema.ResourceData, meta interface
 
 	idlId, parseTableInfos, err := tcaplusService.VerifyIdlFiles(ctx, tcaplusIdlId, groupId, fileContent)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			idlId, parseTableInfos, err = tcaplusService.VerifyIdlFiles(ctx, tcaplusIdlId, groupId, fileContent)
 			if err != nil {
 				return retryError(err)
@@ -229,11 +234,11 @@ func resourceTencentCloudTcaplusIdlCreate(d *schema.ResourceData, meta interface
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(parseTableInfos) == 0 {
-		return fmt.Errorf("Illegal idl. no tables defined in this file were found")
+		return diag.Errorf("Illegal idl. no tables defined in this file were found")
 	}
 
 	tableInfos := make([]map[string]interface{}, 0, len(parseTableInfos))
@@ -260,30 +265,30 @@ func resourceTencentCloudTcaplusIdlCreate(d *schema.ResourceData, meta interface
 	_ = d.Set("table_infos", tableInfos)
 	id, err := json.Marshal(tcaplusIdlId)
 	if err != nil {
-		return fmt.Errorf("format idl id fail,%s", err.Error())
+		return diag.Errorf("format idl id fail,%s", err.Error())
 	}
 	d.SetId(string(id))
-	return resourceTencentCloudTcaplusIdlRead(d, meta)
+	return resourceTencentCloudTcaplusIdlRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcaplusIdlRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcaplusIdlRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcaplus_idl.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcaplusService := TcaplusService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var tcaplusIdlId TcaplusIdlId
 
 	if err := json.Unmarshal([]byte(d.Id()), &tcaplusIdlId); err != nil {
-		return fmt.Errorf("idl id is broken,%s", err.Error())
+		return diag.Errorf("idl id is broken,%s", err.Error())
 	}
 
 	parseTableInfos, err := tcaplusService.DesOldIdlFiles(ctx, tcaplusIdlId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			parseTableInfos, err = tcaplusService.DesOldIdlFiles(ctx, tcaplusIdlId)
 			if err != nil {
 				return retryError(err)
@@ -292,7 +297,7 @@ func resourceTencentCloudTcaplusIdlRead(d *schema.ResourceData, meta interface{}
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(parseTableInfos) == 0 {
@@ -319,26 +324,26 @@ func resourceTencentCloudTcaplusIdlRead(d *schema.ResourceData, meta interface{}
 		tableInfos = append(tableInfos, infoMap)
 	}
 
-	return d.Set("table_infos", tableInfos)
+	return diag.FromErr(d.Set("table_infos", tableInfos))
 }
 
-func resourceTencentCloudTcaplusIdlDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcaplusIdlDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcaplusService := TcaplusService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var tcaplusIdlId TcaplusIdlId
 
 	if err := json.Unmarshal([]byte(d.Id()), &tcaplusIdlId); err != nil {
-		return fmt.Errorf("idl id is broken,%s", err.Error())
+		return diag.Errorf("idl id is broken,%s", err.Error())
 	}
 
 	err := tcaplusService.DeleteIdlFiles(ctx, tcaplusIdlId)
 
 	if err != nil {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			err = tcaplusService.DeleteIdlFiles(ctx, tcaplusIdlId)
 			if err != nil {
 				return retryError(err)
@@ -346,5 +351,5 @@ func resourceTencentCloudTcaplusIdlDelete(d *schema.ResourceData, meta interface
 			return nil
 		})
 	}
-	return err
+	return diag.FromErr(err)
 }
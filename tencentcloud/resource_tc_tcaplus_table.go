@@ -84,6 +84,14 @@ resource "tencentcloud_tcaplus_table" "example" {
   reserved_volume   = 1
 }
 ```
+
+Import
+
+tcaplus table can be imported using the id `cluster_id#table_id`, e.g.
+
+```
+$ terraform import tencentcloud_tcaplus_table.example 19162256624#tcaplus-5e9ea8a2
+```
 */
 package tencentcloud
 
@@ -93,16 +101,26 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudTcaplusTable() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcaplusTableCreate,
-		Read:   resourceTencentCloudTcaplusTableRead,
-		Update: resourceTencentCloudTcaplusTableUpdate,
-		Delete: resourceTencentCloudTcaplusTableDelete,
+		CreateContext: resourceTencentCloudTcaplusTableCreate,
+		ReadContext:   resourceTencentCloudTcaplusTableRead,
+		UpdateContext: resourceTencentCloudTcaplusTableUpdate,
+		DeleteContext: resourceTencentCloudTcaplusTableDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: helper.ImportWithArguments("cluster_id"),
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -188,19 +206,19 @@ func resourceTencentCloudTcaplusTable() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcaplusTableCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcaplusTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	defer logElapsed("resource.tencentcloud_tcaplus_table.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcaplusService := TcaplusService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	var tcaplusIdlId TcaplusIdlId
 
 	if err := json.Unmarshal([]byte(d.Get("idl_id").(string)), &tcaplusIdlId); err != nil {
-		return fmt.Errorf("field `idl_id` is illegal,%s", err.Error())
+		return diag.Errorf("field `idl_id` is illegal,%s", err.Error())
 	}
 	clusterId := d.Get("cluster_id").(string)
 	groupId := d.Get("tablegroup_id").(string)
@@ -225,12 +243,12 @@ func resourceTencentCloudTcaplusTableCreate(d *schema.ResourceData, meta interfa
 		reservedVolume)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(tableInstanceId)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		info, has, err := tcaplusService.DescribeTask(ctx, clusterId, taskId)
 		if err != nil {
 			return retryError(err)
@@ -254,19 +272,19 @@ func resourceTencentCloudTcaplusTableCreate(d *schema.ResourceData, meta interfa
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	time.Sleep(time.Second)
 
-	return resourceTencentCloudTcaplusTableRead(d, meta)
+	return resourceTencentCloudTcaplusTableRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcaplusTableUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcaplusTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcaplus_table.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcaplusService := TcaplusService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -283,7 +301,7 @@ func resourceTencentCloudTcaplusTableUpdate(d *schema.ResourceData, meta interfa
 		err := tcaplusService.ModifyTableMemo(ctx, clusterId, groupId, tableId, tableName, d.Get("description").(string))
 		if err != nil {
 
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				err = tcaplusService.ModifyTableMemo(ctx, clusterId, groupId, tableId, tableName, d.Get("description").(string))
 				if err != nil {
 					return retryError(err)
@@ -292,7 +310,7 @@ func resourceTencentCloudTcaplusTableUpdate(d *schema.ResourceData, meta interfa
 			})
 		}
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -300,11 +318,11 @@ func resourceTencentCloudTcaplusTableUpdate(d *schema.ResourceData, meta interfa
 	if d.HasChange("idl_id") || d.HasChange("table_name") || d.HasChange("table_idl_type") {
 		var tcaplusIdlId TcaplusIdlId
 		if err := json.Unmarshal([]byte(d.Get("idl_id").(string)), &tcaplusIdlId); err != nil {
-			return fmt.Errorf("field `idl_id` is illegal,%s", err.Error())
+			return diag.Errorf("field `idl_id` is illegal,%s", err.Error())
 		}
 		taskId, err := tcaplusService.ModifyTables(ctx, tcaplusIdlId, clusterId, groupId, tableId, tableName, d.Get("table_idl_type").(string))
 		if err != nil {
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+			err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				taskId, err = tcaplusService.ModifyTables(ctx, tcaplusIdlId, clusterId, groupId, tableId, tableName, d.Get("table_idl_type").(string))
 				if err != nil {
 					return retryError(err)
@@ -313,9 +331,9 @@ func resourceTencentCloudTcaplusTableUpdate(d *schema.ResourceData, meta interfa
 			})
 		}
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			info, has, err := tcaplusService.DescribeTask(ctx, clusterId, taskId)
 			if err != nil {
 				return retryError(err)
@@ -336,7 +354,7 @@ func resourceTencentCloudTcaplusTableUpdate(d *schema.ResourceData, meta interfa
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -344,14 +362,14 @@ func resourceTencentCloudTcaplusTableUpdate(d *schema.ResourceData, meta interfa
 
 	time.Sleep(time.Second)
 
-	return resourceTencentCloudTcaplusTableRead(d, meta)
+	return resourceTencentCloudTcaplusTableRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcaplusTableRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcaplusTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcaplus_table.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcaplusService := TcaplusService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -360,7 +378,7 @@ func resourceTencentCloudTcaplusTableRead(d *schema.ResourceData, meta interface
 	tableInfo, has, err := tcaplusService.DescribeTable(ctx, clusterId, d.Id())
 
 	if err != nil {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 			tableInfo, has, err = tcaplusService.DescribeTable(ctx, clusterId, d.Id())
 			if err != nil {
 				return retryError(err)
@@ -372,7 +390,7 @@ func resourceTencentCloudTcaplusTableRead(d *schema.ResourceData, meta interface
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -396,11 +414,11 @@ func resourceTencentCloudTcaplusTableRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudTcaplusTableDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcaplusTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcaplus_table.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcaplusService := TcaplusService{client: meta.(*TencentCloudClient).apiV3Conn}
 	clusterId := d.Get("cluster_id").(string)
@@ -411,7 +429,7 @@ func resourceTencentCloudTcaplusTableDelete(d *schema.ResourceData, meta interfa
 	_, err := tcaplusService.DeleteTable(ctx, clusterId, groupId, instanceTableId, tableName)
 
 	if err != nil {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			_, err = tcaplusService.DeleteTable(ctx, clusterId, groupId, instanceTableId, tableName)
 			if err != nil {
 				return retryError(err)
@@ -420,13 +438,13 @@ func resourceTencentCloudTcaplusTableDelete(d *schema.ResourceData, meta interfa
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, has, err := tcaplusService.DescribeTable(ctx, clusterId, instanceTableId)
 
 	if err != nil || has {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			_, has, err = tcaplusService.DescribeTable(ctx, clusterId, instanceTableId)
 			if err != nil {
 				return retryError(err)
@@ -439,16 +457,16 @@ func resourceTencentCloudTcaplusTableDelete(d *schema.ResourceData, meta interfa
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if has {
-		return fmt.Errorf("delete table fail, table still exist from sdk DescribeTable")
+		return diag.Errorf("delete table fail, table still exist from sdk DescribeTable")
 	}
 
 	taskId, err := tcaplusService.DeleteTable(ctx, clusterId, groupId, instanceTableId, tableName)
 	if err != nil {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			taskId, err = tcaplusService.DeleteTable(ctx, clusterId, groupId, instanceTableId, tableName)
 			if err != nil {
 				return retryError(err)
@@ -457,10 +475,10 @@ func resourceTencentCloudTcaplusTableDelete(d *schema.ResourceData, meta interfa
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		info, has, err := tcaplusService.DescribeTask(ctx, clusterId, taskId)
 		if err != nil {
 			return retryError(err)
@@ -483,5 +501,5 @@ func resourceTencentCloudTcaplusTableDelete(d *schema.ResourceData, meta interfa
 		return nil
 	})
 
-	return err
+	return diag.FromErr(err)
 }
@@ -128,6 +128,13 @@ func TestAccTencentCloudTcaplusTableResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testTcaplusTableResourceNameResourceKey, "error", ""),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_tcaplus_table.test_table",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccImportStateIdFunc("tencentcloud_tcaplus_table.test_table", "cluster_id", "id"),
+				ImportStateVerifyIgnore: []string{"idl_id", "reserved_read_cu", "reserved_write_cu"},
+			},
 		},
 	})
 }
@@ -34,6 +34,14 @@ resource "tencentcloud_tcaplus_tablegroup" "example" {
   tablegroup_name = "tf_example_group_name"
 }
 ```
+
+Import
+
+tcaplus table group can be imported using the id `cluster_id:tablegroup_id`, e.g.
+
+```
+$ terraform import tencentcloud_tcaplus_tablegroup.example 19162256624:3
+```
 */
 package tencentcloud
 
@@ -41,17 +49,27 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTencentCloudTcaplusTableGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcaplusTableGroupCreate,
-		Read:   resourceTencentCloudTcaplusTableGroupRead,
-		Update: resourceTencentCloudTcaplusTableGroupUpdate,
-		Delete: resourceTencentCloudTcaplusTableGroupDelete,
+		CreateContext: resourceTencentCloudTcaplusTableGroupCreate,
+		ReadContext:   resourceTencentCloudTcaplusTableGroupRead,
+		UpdateContext: resourceTencentCloudTcaplusTableGroupUpdate,
+		DeleteContext: resourceTencentCloudTcaplusTableGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTencentCloudTcaplusTableGroupImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -85,11 +103,11 @@ func resourceTencentCloudTcaplusTableGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcaplusTableGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcaplusTableGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcaplus_tablegroup.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcaplusService := TcaplusService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -99,18 +117,18 @@ func resourceTencentCloudTcaplusTableGroupCreate(d *schema.ResourceData, meta in
 	)
 	groupId, err := tcaplusService.CreateGroup(ctx, clusterId, groupName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s:%s", clusterId, groupId))
-	return resourceTencentCloudTcaplusTableGroupRead(d, meta)
+	return resourceTencentCloudTcaplusTableGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcaplusTableGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcaplusTableGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcaplus_tablegroup.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcaplusService := TcaplusService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -119,7 +137,7 @@ func resourceTencentCloudTcaplusTableGroupRead(d *schema.ResourceData, meta inte
 
 	info, has, err := tcaplusService.DescribeGroup(ctx, clusterId, groupId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			info, has, err = tcaplusService.DescribeGroup(ctx, clusterId, groupId)
 			if err != nil {
 				return retryError(err)
@@ -128,7 +146,7 @@ func resourceTencentCloudTcaplusTableGroupRead(d *schema.ResourceData, meta inte
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -143,11 +161,11 @@ func resourceTencentCloudTcaplusTableGroupRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudTcaplusTableGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcaplusTableGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcaplus_tablegroup.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcaplusService := TcaplusService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -155,7 +173,7 @@ func resourceTencentCloudTcaplusTableGroupUpdate(d *schema.ResourceData, meta in
 	groupId := d.Id()
 
 	if d.HasChange("tablegroup_name") {
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			err := tcaplusService.ModifyGroupName(ctx, clusterId, groupId, d.Get("tablegroup_name").(string))
 			if err != nil {
 				return retryError(err)
@@ -163,17 +181,17 @@ func resourceTencentCloudTcaplusTableGroupUpdate(d *schema.ResourceData, meta in
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudTcaplusTableGroupRead(d, meta)
+	return resourceTencentCloudTcaplusTableGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcaplusTableGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcaplusTableGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcaplus_tablegroup.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcaplusService := TcaplusService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -182,7 +200,7 @@ func resourceTencentCloudTcaplusTableGroupDelete(d *schema.ResourceData, meta in
 
 	err := tcaplusService.DeleteGroup(ctx, clusterId, groupId)
 	if err != nil {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			err = tcaplusService.DeleteGroup(ctx, clusterId, groupId)
 			if err != nil {
 				return retryError(err)
@@ -192,12 +210,12 @@ func resourceTencentCloudTcaplusTableGroupDelete(d *schema.ResourceData, meta in
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, has, err := tcaplusService.DescribeGroup(ctx, clusterId, groupId)
 	if err != nil || has {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			_, has, err = tcaplusService.DescribeGroup(ctx, clusterId, groupId)
 			if err != nil {
 				return retryError(err)
@@ -210,11 +228,21 @@ func resourceTencentCloudTcaplusTableGroupDelete(d *schema.ResourceData, meta in
 		})
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		return nil
 	} else {
-		return errors.New("delete group fail, group still exist from sdk DescribeGroup")
+		return diag.FromErr(errors.New("delete group fail, group still exist from sdk DescribeGroup"))
+	}
+}
+
+// resourceTencentCloudTcaplusTableGroupImport imports the table group by `cluster_id:tablegroup_id`.
+func resourceTencentCloudTcaplusTableGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	items := strings.Split(d.Id(), ":")
+	if len(items) != 2 {
+		return nil, fmt.Errorf("id is broken, expected format cluster_id:tablegroup_id, got %s", d.Id())
 	}
+	_ = d.Set("cluster_id", items[0])
+	return []*schema.ResourceData{d}, nil
 }
@@ -39,6 +39,11 @@ func TestAccTencentCloudTcaplusGroupResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testTcaplusGroupResourceNameResourceKey, "table_count", "0"),
 				),
 			},
+			{
+				ResourceName:      "tencentcloud_tcaplus_tablegroup.test_group",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -47,9 +47,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcm/v20210413"
@@ -58,12 +59,15 @@ import (
 
 func resourceTencentCloudTcmAccessLogConfig() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTcmAccessLogConfigRead,
-		Create: resourceTencentCloudTcmAccessLogConfigCreate,
-		Update: resourceTencentCloudTcmAccessLogConfigUpdate,
-		Delete: resourceTencentCloudTcmAccessLogConfigDelete,
+		ReadContext:   resourceTencentCloudTcmAccessLogConfigRead,
+		CreateContext: resourceTencentCloudTcmAccessLogConfigCreate,
+		UpdateContext: resourceTencentCloudTcmAccessLogConfigUpdate,
+		DeleteContext: resourceTencentCloudTcmAccessLogConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"mesh_name": {
@@ -181,7 +185,7 @@ func resourceTencentCloudTcmAccessLogConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcmAccessLogConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmAccessLogConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcm_access_log_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -190,15 +194,15 @@ func resourceTencentCloudTcmAccessLogConfigCreate(d *schema.ResourceData, meta i
 		meshName = v.(string)
 	}
 	d.SetId(meshName)
-	return resourceTencentCloudTcmAccessLogConfigUpdate(d, meta)
+	return resourceTencentCloudTcmAccessLogConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudTcmAccessLogConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmAccessLogConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcm_access_log_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TcmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -207,12 +211,12 @@ func resourceTencentCloudTcmAccessLogConfigRead(d *schema.ResourceData, meta int
 	accessLogConfig, err := service.DescribeTcmAccessLogConfig(ctx, meshName)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if accessLogConfig == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `accessLogConfig` %s does not exist", meshName)
+		return diag.Errorf("resource `accessLogConfig` %s does not exist", meshName)
 	}
 
 	_ = d.Set("mesh_name", meshName)
@@ -287,7 +291,7 @@ func resourceTencentCloudTcmAccessLogConfigRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudTcmAccessLogConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmAccessLogConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcm_access_log_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -368,8 +372,8 @@ func resourceTencentCloudTcmAccessLogConfigUpdate(d *schema.ResourceData, meta i
 		request.Address = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTcmClient().ModifyAccessLogConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTcmClient().ModifyAccessLogConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -381,13 +385,13 @@ func resourceTencentCloudTcmAccessLogConfigUpdate(d *schema.ResourceData, meta i
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tcm accessLogConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTcmAccessLogConfigRead(d, meta)
+	return resourceTencentCloudTcmAccessLogConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcmAccessLogConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmAccessLogConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcm_access_log_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -30,8 +30,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcm/v20210413"
@@ -40,11 +41,15 @@ import (
 
 func resourceTencentCloudTcmClusterAttachment() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTcmClusterAttachmentRead,
-		Create: resourceTencentCloudTcmClusterAttachmentCreate,
-		Delete: resourceTencentCloudTcmClusterAttachmentDelete,
+		ReadContext:   resourceTencentCloudTcmClusterAttachmentRead,
+		CreateContext: resourceTencentCloudTcmClusterAttachmentCreate,
+		DeleteContext: resourceTencentCloudTcmClusterAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(9 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"mesh_id": {
@@ -100,17 +105,17 @@ func resourceTencentCloudTcmClusterAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcmClusterAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmClusterAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcm_cluster_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		request   = tcm.NewLinkClusterListRequest()
 		meshId    string
 		clusterId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("mesh_id"); ok {
 		meshId = v.(string)
@@ -145,8 +150,8 @@ func resourceTencentCloudTcmClusterAttachmentCreate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTcmClient().LinkClusterList(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTcmClient().LinkClusterListWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -158,11 +163,11 @@ func resourceTencentCloudTcmClusterAttachmentCreate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tcm clusterAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	service := TcmService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		mesh, errRet := service.DescribeTcmMesh(ctx, meshId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -187,25 +192,25 @@ func resourceTencentCloudTcmClusterAttachmentCreate(d *schema.ResourceData, meta
 		return resource.RetryableError(fmt.Errorf("link status is %v, retry...", linkState))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{meshId, clusterId}, FILED_SP))
-	return resourceTencentCloudTcmClusterAttachmentRead(d, meta)
+	d.SetId(helper.IdFormat(meshId, clusterId))
+	return resourceTencentCloudTcmClusterAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcmClusterAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmClusterAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcm_cluster_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TcmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	ids := strings.Split(d.Id(), FILED_SP)
-	if len(ids) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	ids, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	meshId := ids[0]
@@ -214,12 +219,12 @@ func resourceTencentCloudTcmClusterAttachmentRead(d *schema.ResourceData, meta i
 	mesh, err := service.DescribeTcmMesh(ctx, meshId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if mesh == nil || mesh.Mesh == nil || len(mesh.Mesh.ClusterList) < 1 {
 		d.SetId("")
-		return fmt.Errorf("resource `clusterAttachment` %s does not exist", meshId)
+		return diag.Errorf("resource `clusterAttachment` %s does not exist", meshId)
 	}
 
 	_ = d.Set("mesh_id", meshId)
@@ -259,28 +264,28 @@ func resourceTencentCloudTcmClusterAttachmentRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudTcmClusterAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmClusterAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcm_cluster_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TcmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	ids := strings.Split(d.Id(), FILED_SP)
+	ids := helper.IdParse(d.Id())
 	if len(ids) != 2 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+		return diag.Errorf("id is broken, id is %s", d.Id())
 	}
 
 	meshId := ids[0]
 	clusterId := ids[1]
 
 	if err := service.DeleteTcmClusterAttachmentById(ctx, meshId, clusterId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err := resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		mesh, errRet := service.DescribeTcmMesh(ctx, meshId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -302,7 +307,7 @@ func resourceTencentCloudTcmClusterAttachmentDelete(d *schema.ResourceData, meta
 		return resource.RetryableError(fmt.Errorf("link status is %v, retry...", linkState))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -83,7 +83,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcm/v20210413"
@@ -92,12 +94,17 @@ import (
 
 func resourceTencentCloudTcmMesh() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTcmMeshRead,
-		Create: resourceTencentCloudTcmMeshCreate,
-		Update: resourceTencentCloudTcmMeshUpdate,
-		Delete: resourceTencentCloudTcmMeshDelete,
+		ReadContext:   resourceTencentCloudTcmMeshRead,
+		CreateContext: resourceTencentCloudTcmMeshCreate,
+		UpdateContext: resourceTencentCloudTcmMeshUpdate,
+		DeleteContext: resourceTencentCloudTcmMeshDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(18 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(18 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"mesh_id": {
@@ -499,7 +506,7 @@ func resourceTencentCloudTcmMesh() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcmMeshCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmMeshCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcm_mesh.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -717,8 +724,8 @@ func resourceTencentCloudTcmMeshCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTcmClient().CreateMesh(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTcmClient().CreateMeshWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -730,14 +737,14 @@ func resourceTencentCloudTcmMeshCreate(d *schema.ResourceData, meta interface{})
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tcm mesh failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	meshId = *response.Response.MeshId
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TcmService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		mesh, errRet := service.DescribeTcmMesh(ctx, meshId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -748,19 +755,19 @@ func resourceTencentCloudTcmMeshCreate(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(meshId)
-	return resourceTencentCloudTcmMeshRead(d, meta)
+	return resourceTencentCloudTcmMeshRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcmMeshRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmMeshRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcm_mesh.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TcmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -768,7 +775,7 @@ func resourceTencentCloudTcmMeshRead(d *schema.ResourceData, meta interface{}) e
 
 	meshResponse, err := service.DescribeTcmMesh(ctx, meshId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	mesh := meshResponse.Mesh
@@ -1005,7 +1012,7 @@ func resourceTencentCloudTcmMeshRead(d *schema.ResourceData, meta interface{}) e
 
 		err = d.Set("config", []interface{}{configMap})
 		if err != nil {
-			return fmt.Errorf("set error, err: %v", err)
+			return diag.Errorf("set error, err: %v", err)
 		}
 	}
 
@@ -1031,7 +1038,7 @@ func resourceTencentCloudTcmMeshRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceTencentCloudTcmMeshUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmMeshUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcm_mesh.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -1045,7 +1052,7 @@ func resourceTencentCloudTcmMeshUpdate(d *schema.ResourceData, meta interface{})
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -1233,8 +1240,8 @@ func resourceTencentCloudTcmMeshUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTcmClient().ModifyMesh(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTcmClient().ModifyMeshWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1245,28 +1252,28 @@ func resourceTencentCloudTcmMeshUpdate(d *schema.ResourceData, meta interface{})
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update tcm mesh failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTcmMeshRead(d, meta)
+	return resourceTencentCloudTcmMeshRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcmMeshDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmMeshDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcm_mesh.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TcmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	meshId := d.Id()
 
 	if err := service.DeleteTcmMeshById(ctx, meshId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err := resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		mesh, errRet := service.DescribeTcmMesh(ctx, meshId)
 		if errRet != nil {
 			if isExpectError(errRet, []string{"ResourceNotFound"}) {
@@ -1285,7 +1292,7 @@ func resourceTencentCloudTcmMeshDelete(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -39,7 +39,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcm/v20210413"
@@ -48,11 +50,14 @@ import (
 
 func resourceTencentCloudTcmPrometheusAttachment() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTcmPrometheusAttachmentRead,
-		Create: resourceTencentCloudTcmPrometheusAttachmentCreate,
-		Delete: resourceTencentCloudTcmPrometheusAttachmentDelete,
+		ReadContext:   resourceTencentCloudTcmPrometheusAttachmentRead,
+		CreateContext: resourceTencentCloudTcmPrometheusAttachmentCreate,
+		DeleteContext: resourceTencentCloudTcmPrometheusAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(18 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"mesh_id": {
@@ -147,7 +152,7 @@ func resourceTencentCloudTcmPrometheusAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcmPrometheusAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmPrometheusAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcm_prometheus_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -203,8 +208,8 @@ func resourceTencentCloudTcmPrometheusAttachmentCreate(d *schema.ResourceData, m
 		request.Prometheus = &prometheusConfig
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTcmClient().LinkPrometheus(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTcmClient().LinkPrometheusWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -216,14 +221,14 @@ func resourceTencentCloudTcmPrometheusAttachmentCreate(d *schema.ResourceData, m
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tcm prometheusAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(meshID)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TcmService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		mesh, errRet := service.DescribeTcmMesh(ctx, meshID)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -237,18 +242,18 @@ func resourceTencentCloudTcmPrometheusAttachmentCreate(d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTcmPrometheusAttachmentRead(d, meta)
+	return resourceTencentCloudTcmPrometheusAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcmPrometheusAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmPrometheusAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcm_prometheus_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TcmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -257,13 +262,13 @@ func resourceTencentCloudTcmPrometheusAttachmentRead(d *schema.ResourceData, met
 	response, err := service.DescribeTcmMesh(ctx, meshId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	mesh := response.Mesh
 	if mesh == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `prometheusAttachment` %s does not exist", meshId)
+		return diag.Errorf("resource `prometheusAttachment` %s does not exist", meshId)
 	}
 
 	if mesh.MeshId != nil {
@@ -333,19 +338,19 @@ func resourceTencentCloudTcmPrometheusAttachmentRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudTcmPrometheusAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmPrometheusAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcm_prometheus_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TcmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	meshID := d.Id()
 
 	if err := service.DeleteTcmPrometheusAttachmentById(ctx, meshID); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -46,11 +46,12 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcm/v20210413"
@@ -58,12 +59,15 @@ import (
 
 func resourceTencentCloudTcmTracingConfig() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTcmTracingConfigRead,
-		Create: resourceTencentCloudTcmTracingConfigCreate,
-		Update: resourceTencentCloudTcmTracingConfigUpdate,
-		Delete: resourceTencentCloudTcmTracingConfigDelete,
+		ReadContext:   resourceTencentCloudTcmTracingConfigRead,
+		CreateContext: resourceTencentCloudTcmTracingConfigCreate,
+		UpdateContext: resourceTencentCloudTcmTracingConfigUpdate,
+		DeleteContext: resourceTencentCloudTcmTracingConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"mesh_id": {
@@ -129,7 +133,7 @@ func resourceTencentCloudTcmTracingConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcmTracingConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmTracingConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcm_tracing_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -139,15 +143,15 @@ func resourceTencentCloudTcmTracingConfigCreate(d *schema.ResourceData, meta int
 	}
 
 	d.SetId(meshId)
-	return resourceTencentCloudTcmTracingConfigUpdate(d, meta)
+	return resourceTencentCloudTcmTracingConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudTcmTracingConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmTracingConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcm_tracing_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TcmService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -156,12 +160,12 @@ func resourceTencentCloudTcmTracingConfigRead(d *schema.ResourceData, meta inter
 	response, err := service.DescribeTcmMesh(ctx, meshId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if response == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `tracingConfig` %s does not exist", meshId)
+		return diag.Errorf("resource `tracingConfig` %s does not exist", meshId)
 	}
 
 	mesh := response.Mesh
@@ -204,7 +208,7 @@ func resourceTencentCloudTcmTracingConfigRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudTcmTracingConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmTracingConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcm_tracing_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -248,8 +252,8 @@ func resourceTencentCloudTcmTracingConfigUpdate(d *schema.ResourceData, meta int
 		request.Zipkin = &tracingZipkin
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTcmClient().ModifyTracingConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTcmClient().ModifyTracingConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -261,13 +265,13 @@ func resourceTencentCloudTcmTracingConfigUpdate(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tcm tracingConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTcmTracingConfigRead(d, meta)
+	return resourceTencentCloudTcmTracingConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcmTracingConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmTracingConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcm_tracing_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
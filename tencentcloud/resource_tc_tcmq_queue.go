@@ -21,9 +21,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -33,12 +34,16 @@ import (
 
 func resourceTencentCloudTcmqQueue() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcmqQueueCreate,
-		Read:   resourceTencentCloudTcmqQueueRead,
-		Update: resourceTencentCloudTcmqQueueUpdate,
-		Delete: resourceTencentCloudTcmqQueueDelete,
+		CreateContext: resourceTencentCloudTcmqQueueCreate,
+		ReadContext:   resourceTencentCloudTcmqQueueRead,
+		UpdateContext: resourceTencentCloudTcmqQueueUpdate,
+		DeleteContext: resourceTencentCloudTcmqQueueDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"queue_name": {
@@ -146,7 +151,7 @@ func resourceTencentCloudTcmqQueue() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcmqQueueCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmqQueueCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcmq_queue.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -221,8 +226,8 @@ func resourceTencentCloudTcmqQueueCreate(d *schema.ResourceData, meta interface{
 		request.RetentionSizeInMB = helper.IntUint64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateCmqQueue(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateCmqQueueWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -232,21 +237,21 @@ func resourceTencentCloudTcmqQueueCreate(d *schema.ResourceData, meta interface{
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tcmq queue failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(queueName)
 
-	return resourceTencentCloudTcmqQueueRead(d, meta)
+	return resourceTencentCloudTcmqQueueRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcmqQueueRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmqQueueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcmq_queue.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TcmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -259,12 +264,12 @@ func resourceTencentCloudTcmqQueueRead(d *schema.ResourceData, meta interface{})
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	if queue == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", d.Id())
+		return diag.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
 	if queue.QueueName != nil {
@@ -338,7 +343,7 @@ func resourceTencentCloudTcmqQueueRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudTcmqQueueUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmqQueueUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcmq_queue.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -445,8 +450,8 @@ func resourceTencentCloudTcmqQueueUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyCmqQueueAttribute(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyCmqQueueAttributeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -456,24 +461,24 @@ func resourceTencentCloudTcmqQueueUpdate(d *schema.ResourceData, meta interface{
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tcmq queue failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTcmqQueueRead(d, meta)
+	return resourceTencentCloudTcmqQueueRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcmqQueueDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmqQueueDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcmq_queue.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TcmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 	queueName := d.Id()
 
 	if err := service.DeleteTcmqQueueById(ctx, queueName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
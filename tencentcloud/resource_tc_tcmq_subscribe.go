@@ -24,10 +24,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -36,12 +36,16 @@ import (
 
 func resourceTencentCloudTcmqSubscribe() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcmqSubscribeCreate,
-		Read:   resourceTencentCloudTcmqSubscribeRead,
-		Update: resourceTencentCloudTcmqSubscribeUpdate,
-		Delete: resourceTencentCloudTcmqSubscribeDelete,
+		CreateContext: resourceTencentCloudTcmqSubscribeCreate,
+		ReadContext:   resourceTencentCloudTcmqSubscribeRead,
+		UpdateContext: resourceTencentCloudTcmqSubscribeUpdate,
+		DeleteContext: resourceTencentCloudTcmqSubscribeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"topic_name": {
@@ -109,7 +113,7 @@ func resourceTencentCloudTcmqSubscribe() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcmqSubscribeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmqSubscribeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcmq_subscribe.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -162,8 +166,8 @@ func resourceTencentCloudTcmqSubscribeCreate(d *schema.ResourceData, meta interf
 		request.NotifyContentFormat = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateCmqSubscribe(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateCmqSubscribeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -173,39 +177,39 @@ func resourceTencentCloudTcmqSubscribeCreate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tcmq subscribe failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(topicName + FILED_SP + subscriptionName)
+	d.SetId(helper.IdFormat(topicName, subscriptionName))
 
-	return resourceTencentCloudTcmqSubscribeRead(d, meta)
+	return resourceTencentCloudTcmqSubscribeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcmqSubscribeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmqSubscribeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcmq_subscribe.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TcmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	topicName := idSplit[0]
 	subscriptionName := idSplit[1]
 
 	subscribe, err := service.DescribeTcmqSubscribeById(ctx, topicName, subscriptionName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if subscribe == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", d.Id())
+		return diag.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
 	_ = d.Set("topic_name", topicName)
@@ -241,7 +245,7 @@ func resourceTencentCloudTcmqSubscribeRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudTcmqSubscribeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmqSubscribeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcmq_subscribe.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -249,9 +253,9 @@ func resourceTencentCloudTcmqSubscribeUpdate(d *schema.ResourceData, meta interf
 
 	request := tcmq.NewModifyCmqSubscriptionAttributeRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	topicName := idSplit[0]
 	subscriptionName := idSplit[1]
@@ -292,8 +296,8 @@ func resourceTencentCloudTcmqSubscribeUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyCmqSubscriptionAttribute(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyCmqSubscriptionAttributeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -303,29 +307,29 @@ func resourceTencentCloudTcmqSubscribeUpdate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tcmq subscribe failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTcmqSubscribeRead(d, meta)
+	return resourceTencentCloudTcmqSubscribeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcmqSubscribeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmqSubscribeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcmq_subscribe.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TcmqService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	topicName := idSplit[0]
 	subscriptionName := idSplit[1]
 
 	if err := service.DeleteTcmqSubscribeById(ctx, topicName, subscriptionName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func TestAccTencentCloudTcmqSubscribeResource_basic(t *testing.T) {
@@ -46,7 +46,7 @@ func testAccCheckTcmqSubscribeDestroy(s *terraform.State) error {
 		if rs.Type != "tencentcloud_tcmq_subscribe" {
 			continue
 		}
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		if len(idSplit) != 2 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
@@ -77,7 +77,7 @@ func testAccCheckTcmqSubscribeExists(n string) resource.TestCheckFunc {
 		}
 
 		service := TcmqService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		if len(idSplit) != 2 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
@@ -21,9 +21,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -33,12 +34,16 @@ import (
 
 func resourceTencentCloudTcmqTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcmqTopicCreate,
-		Read:   resourceTencentCloudTcmqTopicRead,
-		Update: resourceTencentCloudTcmqTopicUpdate,
-		Delete: resourceTencentCloudTcmqTopicDelete,
+		CreateContext: resourceTencentCloudTcmqTopicCreate,
+		ReadContext:   resourceTencentCloudTcmqTopicRead,
+		UpdateContext: resourceTencentCloudTcmqTopicUpdate,
+		DeleteContext: resourceTencentCloudTcmqTopicDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"topic_name": {
@@ -78,7 +83,7 @@ func resourceTencentCloudTcmqTopic() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcmqTopicCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmqTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcmq_topic.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -109,8 +114,8 @@ func resourceTencentCloudTcmqTopicCreate(d *schema.ResourceData, meta interface{
 		request.Trace = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateCmqTopic(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateCmqTopicWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -120,21 +125,21 @@ func resourceTencentCloudTcmqTopicCreate(d *schema.ResourceData, meta interface{
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tcmq topic failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(topicName)
 
-	return resourceTencentCloudTcmqTopicRead(d, meta)
+	return resourceTencentCloudTcmqTopicRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcmqTopicRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmqTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcmq_topic.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TcmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -147,12 +152,12 @@ func resourceTencentCloudTcmqTopicRead(d *schema.ResourceData, meta interface{})
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	if topic == nil {
 		d.SetId("")
-		return fmt.Errorf("resource %s does not exist", topicName)
+		return diag.Errorf("resource %s does not exist", topicName)
 	}
 	if topic.TopicName != nil {
 		_ = d.Set("topic_name", topic.TopicName)
@@ -177,7 +182,7 @@ func resourceTencentCloudTcmqTopicRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudTcmqTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmqTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcmq_topic.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -212,8 +217,8 @@ func resourceTencentCloudTcmqTopicUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyCmqTopicAttribute(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyCmqTopicAttributeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -223,24 +228,24 @@ func resourceTencentCloudTcmqTopicUpdate(d *schema.ResourceData, meta interface{
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tcmq topic failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTcmqTopicRead(d, meta)
+	return resourceTencentCloudTcmqTopicRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcmqTopicDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcmqTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcmq_topic.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TcmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 	topicName := d.Id()
 
 	if err := service.DeleteTcmqTopicById(ctx, topicName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
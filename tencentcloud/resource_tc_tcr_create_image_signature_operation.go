@@ -51,9 +51,11 @@ terraform import tencentcloud_tcr_create_image_signature_operation.image_signatu
 package tencentcloud
 
 import (
+	"context"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcr/v20190924"
@@ -62,11 +64,14 @@ import (
 
 func resourceTencentCloudTcrCreateImageSignatureOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrCreateImageSignatureOperationCreate,
-		Read:   resourceTencentCloudTcrCreateImageSignatureOperationRead,
-		Delete: resourceTencentCloudTcrCreateImageSignatureOperationDelete,
+		CreateContext: resourceTencentCloudTcrCreateImageSignatureOperationCreate,
+		ReadContext:   resourceTencentCloudTcrCreateImageSignatureOperationRead,
+		DeleteContext: resourceTencentCloudTcrCreateImageSignatureOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
@@ -100,7 +105,7 @@ func resourceTencentCloudTcrCreateImageSignatureOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcrCreateImageSignatureOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrCreateImageSignatureOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_create_image_signature_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -133,8 +138,8 @@ func resourceTencentCloudTcrCreateImageSignatureOperationCreate(d *schema.Resour
 		imageVersion = v.(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().CreateSignature(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().CreateSignatureWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -144,22 +149,22 @@ func resourceTencentCloudTcrCreateImageSignatureOperationCreate(d *schema.Resour
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate tcr ImageSignatureOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{registryId, namespaceName, repositoryName, imageVersion}, FILED_SP))
+	d.SetId(helper.IdFormat(registryId, namespaceName, repositoryName, imageVersion))
 
-	return resourceTencentCloudTcrCreateImageSignatureOperationRead(d, meta)
+	return resourceTencentCloudTcrCreateImageSignatureOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrCreateImageSignatureOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrCreateImageSignatureOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_create_image_signature_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudTcrCreateImageSignatureOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrCreateImageSignatureOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_create_image_signature_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
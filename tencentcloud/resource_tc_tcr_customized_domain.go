@@ -38,9 +38,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcr/v20190924"
@@ -49,12 +49,15 @@ import (
 
 func resourceTencentCloudTcrCustomizedDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrCustomizedDomainCreate,
-		Read:   resourceTencentCloudTcrCustomizedDomainRead,
-		Update: resourceTencentCloudTcrCustomizedDomainUpdate,
-		Delete: resourceTencentCloudTcrCustomizedDomainDelete,
+		CreateContext: resourceTencentCloudTcrCustomizedDomainCreate,
+		ReadContext:   resourceTencentCloudTcrCustomizedDomainRead,
+		UpdateContext: resourceTencentCloudTcrCustomizedDomainUpdate,
+		DeleteContext: resourceTencentCloudTcrCustomizedDomainDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
@@ -87,7 +90,7 @@ func resourceTencentCloudTcrCustomizedDomain() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcrCustomizedDomainCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrCustomizedDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_customized_domain.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -112,8 +115,8 @@ func resourceTencentCloudTcrCustomizedDomainCreate(d *schema.ResourceData, meta
 		request.CertificateId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().CreateInstanceCustomizedDomain(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().CreateInstanceCustomizedDomainWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -123,52 +126,52 @@ func resourceTencentCloudTcrCustomizedDomainCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tcr CustomizedDomain failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{registryId, domainName}, FILED_SP))
+	d.SetId(helper.IdFormat(registryId, domainName))
 
 	service := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 2*readRetryTimeout, time.Second, service.TcrCustomizedDomainStateRefreshFunc(registryId, domainName, []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"SUCCESS"}, d.Timeout(schema.TimeoutCreate), time.Second, service.TcrCustomizedDomainStateRefreshFunc(registryId, domainName, []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::tcr:%s:uin/:instance/%s", region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTcrCustomizedDomainRead(d, meta)
+	return resourceTencentCloudTcrCustomizedDomainRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrCustomizedDomainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrCustomizedDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_customized_domain.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	registryId := idSplit[0]
 	domainName := idSplit[1]
 
 	domains, err := service.DescribeTcrCustomizedDomainById(ctx, registryId, &domainName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(domains) == 0 {
@@ -195,14 +198,14 @@ func resourceTencentCloudTcrCustomizedDomainRead(d *schema.ResourceData, meta in
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "tcr", "instance", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudTcrCustomizedDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrCustomizedDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_customized_domain.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -212,41 +215,41 @@ func resourceTencentCloudTcrCustomizedDomainUpdate(d *schema.ResourceData, meta
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 	if d.HasChange("tags") {
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		oldTags, newTags := d.GetChange("tags")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("tcr", "instance", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTcrCustomizedDomainRead(d, meta)
+	return resourceTencentCloudTcrCustomizedDomainRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrCustomizedDomainDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrCustomizedDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_customized_domain.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	registryId := idSplit[0]
 	domainName := idSplit[1]
 
 	if err := service.DeleteTcrCustomizedDomainById(ctx, registryId, domainName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
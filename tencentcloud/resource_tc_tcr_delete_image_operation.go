@@ -37,9 +37,11 @@ resource "tencentcloud_tcr_delete_image_operation" "example" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcr/v20190924"
@@ -48,9 +50,12 @@ import (
 
 func resourceTencentCloudTcrDeleteImageOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrDeleteImageOperationCreate,
-		Read:   resourceTencentCloudTcrDeleteImageOperationRead,
-		Delete: resourceTencentCloudTcrDeleteImageOperationDelete,
+		CreateContext: resourceTencentCloudTcrDeleteImageOperationCreate,
+		ReadContext:   resourceTencentCloudTcrDeleteImageOperationRead,
+		DeleteContext: resourceTencentCloudTcrDeleteImageOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Required:    true,
@@ -83,7 +88,7 @@ func resourceTencentCloudTcrDeleteImageOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcrDeleteImageOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrDeleteImageOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_delete_image_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -116,8 +121,8 @@ func resourceTencentCloudTcrDeleteImageOperationCreate(d *schema.ResourceData, m
 		namespaceName = v.(string)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().DeleteImage(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().DeleteImageWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -127,22 +132,22 @@ func resourceTencentCloudTcrDeleteImageOperationCreate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate tcr DeleteImageOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{registryId, namespaceName, repositoryName, imageVersion}, FILED_SP))
+	d.SetId(helper.IdFormat(registryId, namespaceName, repositoryName, imageVersion))
 
-	return resourceTencentCloudTcrDeleteImageOperationRead(d, meta)
+	return resourceTencentCloudTcrDeleteImageOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrDeleteImageOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrDeleteImageOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_delete_image_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudTcrDeleteImageOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrDeleteImageOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_delete_image_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -107,8 +107,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcr/v20190924"
@@ -117,12 +118,16 @@ import (
 
 func resourceTencentCloudTcrImmutableTagRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrImmutableTagRuleCreate,
-		Read:   resourceTencentCloudTcrImmutableTagRuleRead,
-		Update: resourceTencentCloudTcrImmutableTagRuleUpdate,
-		Delete: resourceTencentCloudTcrImmutableTagRuleDelete,
+		CreateContext: resourceTencentCloudTcrImmutableTagRuleCreate,
+		ReadContext:   resourceTencentCloudTcrImmutableTagRuleRead,
+		UpdateContext: resourceTencentCloudTcrImmutableTagRuleUpdate,
+		DeleteContext: resourceTencentCloudTcrImmutableTagRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
@@ -192,7 +197,7 @@ func resourceTencentCloudTcrImmutableTagRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcrImmutableTagRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrImmutableTagRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_immutable_tag_rule.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -202,9 +207,9 @@ func resourceTencentCloudTcrImmutableTagRuleCreate(d *schema.ResourceData, meta
 		request       = tcr.NewCreateImmutableTagRulesRequest()
 		registryId    string
 		namespaceName string
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		service       = TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if v, ok := d.GetOk("registry_id"); ok {
 		request.RegistryId = helper.String(v.(string))
 		registryId = v.(string)
@@ -235,8 +240,8 @@ func resourceTencentCloudTcrImmutableTagRuleCreate(d *schema.ResourceData, meta
 		request.Rule = &immutableTagRule
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().CreateImmutableTagRules(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().CreateImmutableTagRulesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -246,44 +251,44 @@ func resourceTencentCloudTcrImmutableTagRuleCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tcr ImmutableTagRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ImmutableTagRules, err := service.DescribeTcrImmutableTagRuleById(ctx, registryId, &namespaceName, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleId := helper.Int64ToStr(*ImmutableTagRules[0].RuleId)
 
-	d.SetId(strings.Join([]string{registryId, namespaceName, ruleId}, FILED_SP))
+	d.SetId(helper.IdFormat(registryId, namespaceName, ruleId))
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::tcr:%s:uin/:instance/%s", region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTcrImmutableTagRuleRead(d, meta)
+	return resourceTencentCloudTcrImmutableTagRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrImmutableTagRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrImmutableTagRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_immutable_tag_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	tcClient := meta.(*TencentCloudClient).apiV3Conn
 	tagService := &TagService{client: tcClient}
 	service := TCRService{client: tcClient}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	registryId := idSplit[0]
 	namespaceName := idSplit[1]
@@ -291,7 +296,7 @@ func resourceTencentCloudTcrImmutableTagRuleRead(d *schema.ResourceData, meta in
 
 	ImmutableTagRules, err := service.DescribeTcrImmutableTagRuleById(ctx, registryId, &namespaceName, &ruleId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ImmutableTagRules == nil {
@@ -338,14 +343,14 @@ func resourceTencentCloudTcrImmutableTagRuleRead(d *schema.ResourceData, meta in
 
 	tags, err := tagService.DescribeResourceTags(ctx, "tcr", "instance", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudTcrImmutableTagRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrImmutableTagRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_immutable_tag_rule.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -353,9 +358,9 @@ func resourceTencentCloudTcrImmutableTagRuleUpdate(d *schema.ResourceData, meta
 
 	request := tcr.NewModifyImmutableTagRulesRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	registryId := idSplit[0]
 	namespaceName := idSplit[1]
@@ -369,7 +374,7 @@ func resourceTencentCloudTcrImmutableTagRuleUpdate(d *schema.ResourceData, meta
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -407,8 +412,8 @@ func resourceTencentCloudTcrImmutableTagRuleUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().ModifyImmutableTagRules(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().ModifyImmutableTagRulesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -418,42 +423,42 @@ func resourceTencentCloudTcrImmutableTagRuleUpdate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tcr ImmutableTagRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		oldTags, newTags := d.GetChange("tags")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("tcr", "instance", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTcrImmutableTagRuleRead(d, meta)
+	return resourceTencentCloudTcrImmutableTagRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrImmutableTagRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrImmutableTagRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_immutable_tag_rule.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	registryId := idSplit[0]
 	namespaceName := idSplit[1]
 	ruleId := idSplit[2]
 
 	if err := service.DeleteTcrImmutableTagRuleById(ctx, registryId, namespaceName, ruleId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -90,6 +90,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcr/v20190924"
@@ -98,12 +99,17 @@ import (
 
 func resourceTencentCloudTcrInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrInstanceCreate,
-		Read:   resourceTencentCloudTcrInstanceRead,
-		Update: resourceTencentCloudTcrInstanceUpdate,
-		Delete: resourceTencentCloudTcrInstanceDelete,
+		CreateContext: resourceTencentCloudTcrInstanceCreate,
+		ReadContext:   resourceTencentCloudTcrInstanceRead,
+		UpdateContext: resourceTencentCloudTcrInstanceUpdate,
+		DeleteContext: resourceTencentCloudTcrInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -237,11 +243,11 @@ func resourceTencentCloudTcrInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcrInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_instance.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	client := meta.(*TencentCloudClient).apiV3Conn
 	tcrService := TCRService{client: client}
@@ -257,7 +263,7 @@ func resourceTencentCloudTcrInstanceCreate(d *schema.ResourceData, meta interfac
 
 	// Check if security_policy but open_public_operation is false
 	if _, ok := d.GetOk("security_policy"); ok && !operation {
-		return fmt.Errorf("`open_public_operation` must be `true` if `security_policy` set")
+		return diag.Errorf("`open_public_operation` must be `true` if `security_policy` set")
 	}
 
 	// Check if replicas region same with current
@@ -267,7 +273,7 @@ func resourceTencentCloudTcrInstanceCreate(d *schema.ResourceData, meta interfac
 			rep := v[i].(map[string]interface{})
 			repRegion := fmt.Sprintf("%d", rep["region_id"].(int))
 			if repRegion == providerRegionId {
-				return fmt.Errorf("replication %s region is same with instance region %s (%s)", repRegion, providerRegionId, client.Region)
+				return diag.Errorf("replication %s region is same with instance region %s (%s)", repRegion, providerRegionId, client.Region)
 			}
 		}
 	}
@@ -282,7 +288,7 @@ func resourceTencentCloudTcrInstanceCreate(d *schema.ResourceData, meta interfac
 		params["instance_charge_type_prepaid_renew_flag"] = v.(int)
 	}
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instanceId, inErr = tcrService.CreateTCRInstance(ctx, name, insType, params)
 		if inErr != nil {
 			return retryError(inErr)
@@ -290,13 +296,13 @@ func resourceTencentCloudTcrInstanceCreate(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	d.SetId(instanceId)
 
 	//check creation done
-	err := resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, has, err := tcrService.DescribeTCRInstanceById(ctx, instanceId)
 		if err != nil {
 			return retryError(err)
@@ -311,13 +317,13 @@ func resourceTencentCloudTcrInstanceCreate(d *schema.ResourceData, meta interfac
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if instanceStatus == "Running" {
 		openPublicOperation, ok := d.GetOk("open_public_operation")
 		operation = openPublicOperation.(bool)
 
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			if ok {
 				if operation {
 					inErr = tcrService.ManageTCRExternalEndpoint(ctx, instanceId, "Create")
@@ -331,12 +337,12 @@ func resourceTencentCloudTcrInstanceCreate(d *schema.ResourceData, meta interfac
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 
 		if raw, ok := d.GetOk("security_policy"); ok && operation {
 			// Waiting for External EndPoint opened
-			err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+			err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 				var (
 					status string
 				)
@@ -357,10 +363,10 @@ func resourceTencentCloudTcrInstanceCreate(d *schema.ResourceData, meta interfac
 			})
 
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
-			if err := resourceTencentCloudTcrSecurityPolicyAdd(d, meta, raw.(*schema.Set).List()); err != nil {
-				return err
+			if err := resourceTencentCloudTcrSecurityPolicyAdd(ctx, d, meta, raw.(*schema.Set).List()); err != nil {
+				return diag.FromErr(err)
 			}
 		} else if !operation {
 			log.Printf("[WARN] `open_public_operation` was not opened, skip `security_policy` set.")
@@ -369,7 +375,7 @@ func resourceTencentCloudTcrInstanceCreate(d *schema.ResourceData, meta interfac
 		if _, ok := d.GetOk("replications"); ok {
 			err := resourceTencentCloudTcrReplicationSet(ctx, d, meta)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -379,25 +385,25 @@ func resourceTencentCloudTcrInstanceCreate(d *schema.ResourceData, meta interfac
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := BuildTagResourceName("tcr", "instance", region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTcrInstanceRead(d, meta)
+	return resourceTencentCloudTcrInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_instance.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var outErr, inErr error
 	client := meta.(*TencentCloudClient).apiV3Conn
 	tcrService := TCRService{client: client}
 	instance, has, outErr := tcrService.DescribeTCRInstanceById(ctx, d.Id())
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			instance, has, inErr = tcrService.DescribeTCRInstanceById(ctx, d.Id())
 			if inErr != nil {
 				return retryError(inErr)
@@ -406,7 +412,7 @@ func resourceTencentCloudTcrInstanceRead(d *schema.ResourceData, meta interface{
 		})
 	}
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	if !has {
 		d.SetId("")
@@ -415,7 +421,7 @@ func resourceTencentCloudTcrInstanceRead(d *schema.ResourceData, meta interface{
 
 	publicStatus, has, outErr := tcrService.DescribeExternalEndpointStatus(ctx, d.Id())
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			publicStatus, has, inErr = tcrService.DescribeExternalEndpointStatus(ctx, d.Id())
 			if inErr != nil {
 				return retryError(inErr)
@@ -424,7 +430,7 @@ func resourceTencentCloudTcrInstanceRead(d *schema.ResourceData, meta interface{
 		})
 	}
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	if !has {
 		d.SetId("")
@@ -455,7 +461,7 @@ func resourceTencentCloudTcrInstanceRead(d *schema.ResourceData, meta interface{
 	request.RegistryId = helper.String(d.Id())
 	var securityPolicySet []*tcr.SecurityPolicy
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		policySet, inErr := tcrService.DescribeSecurityPolicies(ctx, request)
 		if inErr != nil && publicStatus != "Closed" {
 			expectedErr := ""
@@ -487,12 +493,12 @@ func resourceTencentCloudTcrInstanceRead(d *schema.ResourceData, meta interface{
 
 	err = d.Set("security_policy", policies)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	replicas := d.Get("replications").([]interface{})
 
-	err = resource.Retry(readRetryTimeout*3, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout*3, func() *resource.RetryError {
 		request := tcr.NewDescribeReplicationInstancesRequest()
 		request.RegistryId = helper.String(d.Id())
 		request.Limit = helper.IntInt64(100)
@@ -516,7 +522,7 @@ func resourceTencentCloudTcrInstanceRead(d *schema.ResourceData, meta interface{
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(replicas) > 0 {
@@ -532,7 +538,7 @@ func resourceTencentCloudTcrInstanceRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTencentCloudTcrInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_instance.update")()
 	//delete_bucket
 	var (
@@ -542,12 +548,12 @@ func resourceTencentCloudTcrInstanceUpdate(d *schema.ResourceData, meta interfac
 	)
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	tcrService := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instanceId = d.Id()
 	if d.HasChange("open_public_operation") {
 		operation = d.Get("open_public_operation").(bool)
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			if operation {
 				inErr = tcrService.ManageTCRExternalEndpoint(ctx, instanceId, "Create")
 			} else {
@@ -559,14 +565,14 @@ func resourceTencentCloudTcrInstanceUpdate(d *schema.ResourceData, meta interfac
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 	}
 
 	if d.HasChange("security_policy") {
 		var err error
 		// Waiting for External EndPoint opened
-		err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			var (
 				status string
 			)
@@ -587,7 +593,7 @@ func resourceTencentCloudTcrInstanceUpdate(d *schema.ResourceData, meta interfac
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		o, n := d.GetChange("security_policy")
@@ -596,15 +602,15 @@ func resourceTencentCloudTcrInstanceUpdate(d *schema.ResourceData, meta interfac
 		add := ns.Difference(os).List()
 		remove := os.Difference(ns).List()
 		if len(remove) > 0 {
-			err := resourceTencentCloudTcrSecurityPolicyRemove(d, meta, remove)
+			err := resourceTencentCloudTcrSecurityPolicyRemove(ctx, d, meta, remove)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		if len(add) > 0 {
-			err := resourceTencentCloudTcrSecurityPolicyAdd(d, meta, add)
+			err := resourceTencentCloudTcrSecurityPolicyAdd(ctx, d, meta, add)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -612,7 +618,7 @@ func resourceTencentCloudTcrInstanceUpdate(d *schema.ResourceData, meta interfac
 	if d.HasChange("replications") {
 		err := resourceTencentCloudTcrReplicationSet(ctx, d, meta)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -623,7 +629,7 @@ func resourceTencentCloudTcrInstanceUpdate(d *schema.ResourceData, meta interfac
 		resourceName := BuildTagResourceName("tcr", "instance", region, d.Id())
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -631,9 +637,9 @@ func resourceTencentCloudTcrInstanceUpdate(d *schema.ResourceData, meta interfac
 	if d.HasChange("instance_type") {
 		instanceType := d.Get("instance_type").(string)
 		if err := tcrService.ModifyInstance(ctx, d.Id(), instanceType); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		err := resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			instance, has, err := tcrService.DescribeTCRInstanceById(ctx, instanceId)
 			if err != nil {
 				return resource.NonRetryableError(err)
@@ -645,7 +651,7 @@ func resourceTencentCloudTcrInstanceUpdate(d *schema.ResourceData, meta interfac
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -675,8 +681,8 @@ func resourceTencentCloudTcrInstanceUpdate(d *schema.ResourceData, meta interfac
 				}
 				request.Flag = helper.IntInt64(0)
 
-				err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-					result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().RenewInstance(request)
+				err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+					result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().RenewInstanceWithContext(ctx, request)
 					if e != nil {
 						return retryError(e)
 					} else {
@@ -686,32 +692,32 @@ func resourceTencentCloudTcrInstanceUpdate(d *schema.ResourceData, meta interfac
 				})
 				if err != nil {
 					log.Printf("[CRITAL]%s operate tcr renewInstanceOperation failed, reason:%+v", logId, err)
-					return err
+					return diag.FromErr(err)
 				}
 
-				conf := BuildStateChangeConf([]string{}, []string{"Running"}, 3*readRetryTimeout, time.Second, tcrService.TcrStateRefreshFunc(instanceId, []string{}))
+				conf := BuildStateChangeConf([]string{}, []string{"Running"}, d.Timeout(schema.TimeoutUpdate), time.Second, tcrService.TcrStateRefreshFunc(instanceId, []string{}))
 
 				if _, e := conf.WaitForState(); e != nil {
-					return e
+					return diag.FromErr(e)
 				}
 
 			} else {
-				return fmt.Errorf("Only the postpaid user allows changing the `instance_charge_type_prepaid_period`! The current charge type is: [%v].", chargeType)
+				return diag.Errorf("Only the postpaid user allows changing the `instance_charge_type_prepaid_period`! The current charge type is: [%v].", chargeType)
 			}
 
 		} else {
-			return fmt.Errorf("`registry_charge_type` must be set when trying to change the `instance_charge_type_prepaid_period`!")
+			return diag.Errorf("`registry_charge_type` must be set when trying to change the `instance_charge_type_prepaid_period`!")
 		}
 	}
 
-	return resourceTencentCloudTcrInstanceRead(d, meta)
+	return resourceTencentCloudTcrInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_instance.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceId := d.Id()
 	deleteBucket := d.Get("delete_bucket").(bool)
@@ -726,12 +732,12 @@ func resourceTencentCloudTcrInstanceDelete(d *schema.ResourceData, meta interfac
 	replicas, outErr := tcrService.DescribeReplicationInstances(ctx, repRequest)
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	for i := range replicas {
 		item := replicas[i]
-		_ = resource.Retry(writeRetryTimeout*5, func() *resource.RetryError {
+		_ = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			request := tcr.NewDeleteReplicationInstanceRequest()
 			request.RegistryId = &instanceId
 			request.ReplicationRegistryId = item.ReplicationRegistryId
@@ -746,7 +752,7 @@ func resourceTencentCloudTcrInstanceDelete(d *schema.ResourceData, meta interfac
 
 	outErr = tcrService.DeleteTCRInstance(ctx, instanceId, deleteBucket)
 	if outErr != nil {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			inErr = tcrService.DeleteTCRInstance(ctx, instanceId, deleteBucket)
 			if inErr != nil {
 				return retryError(inErr)
@@ -756,10 +762,10 @@ func resourceTencentCloudTcrInstanceDelete(d *schema.ResourceData, meta interfac
 	}
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, inErr = tcrService.DescribeTCRInstanceById(ctx, d.Id())
 		if inErr != nil {
 			return retryError(inErr)
@@ -772,13 +778,13 @@ func resourceTencentCloudTcrInstanceDelete(d *schema.ResourceData, meta interfac
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	return nil
 }
 
-func resourceTencentCloudTcrSecurityPolicyAdd(d *schema.ResourceData, meta interface{}, add []interface{}) error {
+func resourceTencentCloudTcrSecurityPolicyAdd(ctx context.Context, d *schema.ResourceData, meta interface{}, add []interface{}) error {
 	client := meta.(*TencentCloudClient).apiV3Conn
 	request := tcr.NewCreateMultipleSecurityPolicyRequest()
 	request.RegistryId = helper.String(d.Id())
@@ -801,14 +807,14 @@ func resourceTencentCloudTcrSecurityPolicyAdd(d *schema.ResourceData, meta inter
 		request.SecurityGroupPolicySet = append(request.SecurityGroupPolicySet, policy)
 	}
 
-	_, err := client.UseTCRClient().CreateMultipleSecurityPolicy(request)
+	_, err := client.UseTCRClient().CreateMultipleSecurityPolicyWithContext(ctx, request)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func resourceTencentCloudTcrSecurityPolicyRemove(d *schema.ResourceData, meta interface{}, remove []interface{}) error {
+func resourceTencentCloudTcrSecurityPolicyRemove(ctx context.Context, d *schema.ResourceData, meta interface{}, remove []interface{}) error {
 	client := meta.(*TencentCloudClient).apiV3Conn
 	request := tcr.NewDeleteMultipleSecurityPolicyRequest()
 	request.RegistryId = helper.String(d.Id())
@@ -831,7 +837,7 @@ func resourceTencentCloudTcrSecurityPolicyRemove(d *schema.ResourceData, meta in
 		request.SecurityGroupPolicySet = append(request.SecurityGroupPolicySet, policy)
 	}
 
-	_, err := client.UseTCRClient().DeleteMultipleSecurityPolicy(request)
+	_, err := client.UseTCRClient().DeleteMultipleSecurityPolicyWithContext(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -872,7 +878,7 @@ func resourceTencentCloudTcrReplicationSet(ctx context.Context, d *schema.Resour
 			if synTag, ok := replica["syn_tag"].(bool); ok {
 				request.SyncTag = &synTag
 			}
-			err := resource.Retry(writeRetryTimeout*5, func() *resource.RetryError {
+			err := retryContext(ctx, writeRetryTimeout*5, func() *resource.RetryError {
 				_, err := service.CreateReplicationInstance(ctx, request)
 				if err != nil {
 					sdkErr, ok := err.(*sdkErrors.TencentCloudSDKError)
@@ -916,7 +922,7 @@ func resourceTencentCloudTcrReplicationSet(ctx context.Context, d *schema.Resour
 			request.RegistryId = helper.String(d.Id())
 			request.ReplicationRegistryId = helper.String(id)
 			request.ReplicationRegionId = helper.IntUint64(regionId)
-			err := resource.Retry(writeRetryTimeout*5, func() *resource.RetryError {
+			err := retryContext(ctx, writeRetryTimeout*5, func() *resource.RetryError {
 				err := service.DeleteReplicationInstance(ctx, request)
 				if err != nil {
 					return retryError(err, tcr.INTERNALERROR_ERRCONFLICT)
@@ -117,9 +117,11 @@ resource "tencentcloud_tcr_manage_replication_operation" "example_sync" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcr/v20190924"
@@ -128,11 +130,14 @@ import (
 
 func resourceTencentCloudTcrManageReplicationOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrManageReplicationOperationCreate,
-		Read:   resourceTencentCloudTcrManageReplicationOperationRead,
-		Delete: resourceTencentCloudTcrManageReplicationOperationDelete,
+		CreateContext: resourceTencentCloudTcrManageReplicationOperationCreate,
+		ReadContext:   resourceTencentCloudTcrManageReplicationOperationRead,
+		DeleteContext: resourceTencentCloudTcrManageReplicationOperationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"source_registry_id": {
@@ -239,7 +244,7 @@ func resourceTencentCloudTcrManageReplicationOperation() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcrManageReplicationOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrManageReplicationOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_manage_replication_operation.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -311,8 +316,8 @@ func resourceTencentCloudTcrManageReplicationOperationCreate(d *schema.ResourceD
 		request.PeerReplicationOption = &peerReplicationOption
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().ManageReplication(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().ManageReplicationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -322,22 +327,22 @@ func resourceTencentCloudTcrManageReplicationOperationCreate(d *schema.ResourceD
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate tcr ManageReplicationOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{sourceRegistryId, destinationRegistryId, ruleName}, FILED_SP))
+	d.SetId(helper.IdFormat(sourceRegistryId, destinationRegistryId, ruleName))
 
-	return resourceTencentCloudTcrManageReplicationOperationRead(d, meta)
+	return resourceTencentCloudTcrManageReplicationOperationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrManageReplicationOperationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrManageReplicationOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_manage_replication_operation.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudTcrManageReplicationOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrManageReplicationOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_manage_replication_operation.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -40,20 +40,27 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudTcrNamespace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrNamespaceCreate,
-		Read:   resourceTencentCloudTcrNamespaceRead,
-		Update: resourceTencentCloudTcrNamespaceUpdate,
-		Delete: resourceTencentCLoudTcrNamespaceDelete,
+		CreateContext: resourceTencentCloudTcrNamespaceCreate,
+		ReadContext:   resourceTencentCloudTcrNamespaceRead,
+		UpdateContext: resourceTencentCloudTcrNamespaceUpdate,
+		DeleteContext: resourceTencentCLoudTcrNamespaceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -113,11 +120,11 @@ func resourceTencentCloudTcrNamespace() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcrNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_namespace.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcrService := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -132,7 +139,7 @@ func resourceTencentCloudTcrNamespaceCreate(d *schema.ResourceData, meta interfa
 		outErr, inErr  error
 	)
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		inErr = tcrService.CreateTCRNameSpace(ctx, instanceId, name, isPublic, isAutoScan, isPreventVUL, severity, whitelistItems)
 		if inErr != nil {
 			return retryError(inErr)
@@ -140,24 +147,24 @@ func resourceTencentCloudTcrNamespaceCreate(d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	d.SetId(instanceId + FILED_SP + name)
+	d.SetId(helper.IdFormat(instanceId, name))
 
-	return resourceTencentCloudTcrNamespaceRead(d, meta)
+	return resourceTencentCloudTcrNamespaceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrNamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_namespace.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Id()
-	items := strings.Split(resourceId, FILED_SP)
-	if len(items) != 2 {
-		return fmt.Errorf("invalid ID %s", resourceId)
+	items, err := helper.IdParseN(resourceId, 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := items[0]
@@ -175,7 +182,7 @@ func resourceTencentCloudTcrNamespaceUpdate(d *schema.ResourceData, meta interfa
 	tcrService := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 	outErr = tcrService.ModifyTCRNameSpace(ctx, instanceId, namespaceName, isPublic, isAutoScan, isPreventVUL, severity, whitelistItems)
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			inErr = tcrService.ModifyTCRNameSpace(ctx, instanceId, namespaceName, isPublic, isAutoScan, isPreventVUL, severity, whitelistItems)
 			if inErr != nil {
 				return retryError(inErr)
@@ -184,22 +191,22 @@ func resourceTencentCloudTcrNamespaceUpdate(d *schema.ResourceData, meta interfa
 		})
 	}
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	return resourceTencentCloudTcrNamespaceRead(d, meta)
+	return resourceTencentCloudTcrNamespaceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_namespace.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Id()
-	items := strings.Split(resourceId, FILED_SP)
-	if len(items) != 2 {
-		return fmt.Errorf("invalid ID %s", resourceId)
+	items, err := helper.IdParseN(resourceId, 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := items[0]
@@ -209,7 +216,7 @@ func resourceTencentCloudTcrNamespaceRead(d *schema.ResourceData, meta interface
 	tcrService := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 	namespace, has, outErr := tcrService.DescribeTCRNameSpaceById(ctx, instanceId, namespaceName)
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			namespace, has, inErr = tcrService.DescribeTCRNameSpaceById(ctx, instanceId, namespaceName)
 			if inErr != nil {
 				return retryError(inErr)
@@ -218,7 +225,7 @@ func resourceTencentCloudTcrNamespaceRead(d *schema.ResourceData, meta interface
 		})
 	}
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	if !has {
 		d.SetId("")
@@ -248,16 +255,16 @@ func resourceTencentCloudTcrNamespaceRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCLoudTcrNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCLoudTcrNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_namespace.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Id()
-	items := strings.Split(resourceId, FILED_SP)
-	if len(items) != 2 {
-		return fmt.Errorf("invalid ID %s", resourceId)
+	items, err := helper.IdParseN(resourceId, 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := items[0]
@@ -270,7 +277,7 @@ func resourceTencentCLoudTcrNamespaceDelete(d *schema.ResourceData, meta interfa
 
 	outErr = tcrService.DeleteTCRNameSpace(ctx, instanceId, namespaceName)
 	if outErr != nil {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			inErr = tcrService.DeleteTCRNameSpace(ctx, instanceId, namespaceName)
 			if inErr != nil {
 				return retryError(inErr)
@@ -280,10 +287,10 @@ func resourceTencentCLoudTcrNamespaceDelete(d *schema.ResourceData, meta interfa
 	}
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, inErr = tcrService.DescribeTCRNameSpaceById(ctx, instanceId, namespaceName)
 		if inErr != nil {
 			return retryError(inErr)
@@ -296,7 +303,7 @@ func resourceTencentCLoudTcrNamespaceDelete(d *schema.ResourceData, meta interfa
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	return nil
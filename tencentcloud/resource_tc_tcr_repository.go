@@ -46,20 +46,27 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudTcrRepository() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrRepositoryCreate,
-		Read:   resourceTencentCloudTcrRepositoryRead,
-		Update: resourceTencentCloudTcrRepositoryUpdate,
-		Delete: resourceTencentCLoudTcrRepositoryDelete,
+		CreateContext: resourceTencentCloudTcrRepositoryCreate,
+		ReadContext:   resourceTencentCloudTcrRepositoryRead,
+		UpdateContext: resourceTencentCloudTcrRepositoryUpdate,
+		DeleteContext: resourceTencentCLoudTcrRepositoryDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -118,11 +125,11 @@ func resourceTencentCloudTcrRepository() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcrRepositoryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrRepositoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_repository.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcrService := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -135,7 +142,7 @@ func resourceTencentCloudTcrRepositoryCreate(d *schema.ResourceData, meta interf
 		outErr, inErr error
 	)
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		inErr = tcrService.CreateTCRRepository(ctx, instanceId, namespaceName, name, briefDesc, description)
 		if inErr != nil {
 			return retryError(inErr)
@@ -143,24 +150,24 @@ func resourceTencentCloudTcrRepositoryCreate(d *schema.ResourceData, meta interf
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	d.SetId(instanceId + FILED_SP + namespaceName + FILED_SP + name)
+	d.SetId(helper.IdFormat(instanceId, namespaceName, name))
 
-	return resourceTencentCloudTcrRepositoryRead(d, meta)
+	return resourceTencentCloudTcrRepositoryRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrRepositoryUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrRepositoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_repository.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Id()
-	items := strings.Split(resourceId, FILED_SP)
-	if len(items) != 3 {
-		return fmt.Errorf("invalid ID %s", resourceId)
+	items, err := helper.IdParseN(resourceId, 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := items[0]
@@ -174,7 +181,7 @@ func resourceTencentCloudTcrRepositoryUpdate(d *schema.ResourceData, meta interf
 		tcrService := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 		outErr = tcrService.ModifyTCRRepository(ctx, instanceId, namespaceName, repositoryName, briefDesc, description)
 		if outErr != nil {
-			outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				inErr = tcrService.ModifyTCRRepository(ctx, instanceId, namespaceName, repositoryName, briefDesc, description)
 				if inErr != nil {
 					return retryError(inErr)
@@ -183,23 +190,23 @@ func resourceTencentCloudTcrRepositoryUpdate(d *schema.ResourceData, meta interf
 			})
 		}
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 	}
 
-	return resourceTencentCloudTcrRepositoryRead(d, meta)
+	return resourceTencentCloudTcrRepositoryRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrRepositoryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrRepositoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_repository.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Id()
-	items := strings.Split(resourceId, FILED_SP)
-	if len(items) != 3 {
-		return fmt.Errorf("invalid ID %s", resourceId)
+	items, err := helper.IdParseN(resourceId, 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := items[0]
@@ -210,7 +217,7 @@ func resourceTencentCloudTcrRepositoryRead(d *schema.ResourceData, meta interfac
 	tcrService := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 	repository, has, outErr := tcrService.DescribeTCRRepositoryById(ctx, instanceId, namespaceName, repositoryName)
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			repository, has, inErr = tcrService.DescribeTCRRepositoryById(ctx, instanceId, namespaceName, repositoryName)
 			if inErr != nil {
 				return retryError(inErr)
@@ -219,7 +226,7 @@ func resourceTencentCloudTcrRepositoryRead(d *schema.ResourceData, meta interfac
 		})
 	}
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	if !has {
 		d.SetId("")
@@ -238,7 +245,7 @@ func resourceTencentCloudTcrRepositoryRead(d *schema.ResourceData, meta interfac
 	//get public domain
 	instance, has, outErr := tcrService.DescribeTCRInstanceById(ctx, instanceId)
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			instance, has, inErr = tcrService.DescribeTCRInstanceById(ctx, instanceId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -247,28 +254,28 @@ func resourceTencentCloudTcrRepositoryRead(d *schema.ResourceData, meta interfac
 		})
 	}
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if has {
 		_ = d.Set("url", fmt.Sprintf("%s/%s/%s", *instance.PublicDomain, namespaceName, repositoryName))
 	} else {
-		return fmt.Errorf("cannot find instance %s", instanceId)
+		return diag.Errorf("cannot find instance %s", instanceId)
 	}
 
 	return nil
 }
 
-func resourceTencentCLoudTcrRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCLoudTcrRepositoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_repository.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Id()
-	items := strings.Split(resourceId, FILED_SP)
-	if len(items) != 3 {
-		return fmt.Errorf("invalid ID %s", resourceId)
+	items, err := helper.IdParseN(resourceId, 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := items[0]
@@ -282,7 +289,7 @@ func resourceTencentCLoudTcrRepositoryDelete(d *schema.ResourceData, meta interf
 
 	outErr = tcrService.DeleteTCRRepository(ctx, instanceId, namespaceName, repositoryName)
 	if outErr != nil {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			inErr = tcrService.DeleteTCRRepository(ctx, instanceId, namespaceName, repositoryName)
 			if inErr != nil {
 				return retryError(inErr)
@@ -292,10 +299,10 @@ func resourceTencentCLoudTcrRepositoryDelete(d *schema.ResourceData, meta interf
 	}
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, inErr = tcrService.DescribeTCRRepositoryById(ctx, instanceId, namespaceName, repositoryName)
 		if inErr != nil {
 			return retryError(inErr)
@@ -308,7 +315,7 @@ func resourceTencentCLoudTcrRepositoryDelete(d *schema.ResourceData, meta interf
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	return nil
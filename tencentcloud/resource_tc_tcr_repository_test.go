@@ -119,7 +119,7 @@ func testAccCheckTCRRepositoryDestroy(s *terraform.State) error {
 		if rs.Type != "tencentcloud_tcr_repository" {
 			continue
 		}
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) != 3 {
 			return fmt.Errorf("invalid ID %s", rs.Primary.ID)
 		}
@@ -150,7 +150,7 @@ func testAccCheckTCRRepositoryExists(n string) resource.TestCheckFunc {
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("TCR repository id is not set")
 		}
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) != 3 {
 			return fmt.Errorf("invalid ID %s", rs.Primary.ID)
 		}
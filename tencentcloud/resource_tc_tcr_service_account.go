@@ -77,7 +77,9 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcr/v20190924"
@@ -86,12 +88,16 @@ import (
 
 func resourceTencentCloudTcrServiceAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrServiceAccountCreate,
-		Read:   resourceTencentCloudTcrServiceAccountRead,
-		Update: resourceTencentCloudTcrServiceAccountUpdate,
-		Delete: resourceTencentCloudTcrServiceAccountDelete,
+		CreateContext: resourceTencentCloudTcrServiceAccountCreate,
+		ReadContext:   resourceTencentCloudTcrServiceAccountRead,
+		UpdateContext: resourceTencentCloudTcrServiceAccountUpdate,
+		DeleteContext: resourceTencentCloudTcrServiceAccountDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
@@ -169,7 +175,7 @@ func resourceTencentCloudTcrServiceAccount() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcrServiceAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrServiceAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_service_account.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -227,8 +233,8 @@ func resourceTencentCloudTcrServiceAccountCreate(d *schema.ResourceData, meta in
 		request.Disable = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().CreateServiceAccount(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().CreateServiceAccountWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -239,53 +245,53 @@ func resourceTencentCloudTcrServiceAccountCreate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tcr ServiceAccount failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !strings.Contains(*response.Response.Name, name) {
-		return fmt.Errorf("The name[%s] return from response is not equal to the name[%s] of tf code.", *response.Response.Name, name)
+		return diag.Errorf("The name[%s] return from response is not equal to the name[%s] of tf code.", *response.Response.Name, name)
 	}
 
-	d.SetId(strings.Join([]string{registryId, name}, FILED_SP))
+	d.SetId(helper.IdFormat(registryId, name))
 
 	pw := response.Response.Password
 	if pw != nil {
 		_ = d.Set("password", *pw)
 	}
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::tcr:%s:uin/:instance/%s", region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTcrServiceAccountRead(d, meta)
+	return resourceTencentCloudTcrServiceAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrServiceAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrServiceAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_service_account.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	registryId := idSplit[0]
 	name := idSplit[1]
 
 	ServiceAccount, err := service.DescribeTcrServiceAccountById(ctx, registryId, name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ServiceAccount == nil {
@@ -333,14 +339,14 @@ func resourceTencentCloudTcrServiceAccountRead(d *schema.ResourceData, meta inte
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "tcr", "instance", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudTcrServiceAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrServiceAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_service_account.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -348,9 +354,9 @@ func resourceTencentCloudTcrServiceAccountUpdate(d *schema.ResourceData, meta in
 
 	request := tcr.NewModifyServiceAccountRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	registryId := idSplit[0]
 	name := idSplit[1]
@@ -362,7 +368,7 @@ func resourceTencentCloudTcrServiceAccountUpdate(d *schema.ResourceData, meta in
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -412,8 +418,8 @@ func resourceTencentCloudTcrServiceAccountUpdate(d *schema.ResourceData, meta in
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().ModifyServiceAccount(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().ModifyServiceAccountWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -423,41 +429,41 @@ func resourceTencentCloudTcrServiceAccountUpdate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tcr ServiceAccount failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		oldTags, newTags := d.GetChange("tags")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("tcr", "instance", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTcrServiceAccountRead(d, meta)
+	return resourceTencentCloudTcrServiceAccountRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrServiceAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrServiceAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_service_account.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	registryId := idSplit[0]
 	name := TCR_NAME_PREFIX + idSplit[1]
 
 	if err := service.DeleteTcrServiceAccountById(ctx, registryId, name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -47,11 +47,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcr/v20190924"
@@ -60,10 +59,13 @@ import (
 
 func resourceTencentCloudTcrTagRetentionExecutionConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrTagRetentionExecutionConfigCreate,
-		Read:   resourceTencentCloudTcrTagRetentionExecutionConfigRead,
-		Update: resourceTencentCloudTcrTagRetentionExecutionConfigUpdate,
-		Delete: resourceTencentCloudTcrTagRetentionExecutionConfigDelete,
+		CreateContext: resourceTencentCloudTcrTagRetentionExecutionConfigCreate,
+		ReadContext:   resourceTencentCloudTcrTagRetentionExecutionConfigRead,
+		UpdateContext: resourceTencentCloudTcrTagRetentionExecutionConfigUpdate,
+		DeleteContext: resourceTencentCloudTcrTagRetentionExecutionConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Required:    true,
@@ -92,7 +94,7 @@ func resourceTencentCloudTcrTagRetentionExecutionConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcrTagRetentionExecutionConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrTagRetentionExecutionConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_tag_retention_execution_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -109,31 +111,31 @@ func resourceTencentCloudTcrTagRetentionExecutionConfigCreate(d *schema.Resource
 		retentionId = helper.IntToStr(v.(int))
 	}
 
-	d.SetId(strings.Join([]string{registryId, retentionId}, FILED_SP))
+	d.SetId(helper.IdFormat(registryId, retentionId))
 
-	return resourceTencentCloudTcrTagRetentionExecutionConfigUpdate(d, meta)
+	return resourceTencentCloudTcrTagRetentionExecutionConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrTagRetentionExecutionConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrTagRetentionExecutionConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_tag_retention_execution_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	registryId := idSplit[0]
 	retentionId := idSplit[1]
 
 	TagRetentionExecutionConfig, err := service.DescribeTcrTagRetentionExecutionConfigById(ctx, registryId, retentionId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if TagRetentionExecutionConfig == nil {
@@ -155,7 +157,7 @@ func resourceTencentCloudTcrTagRetentionExecutionConfigRead(d *schema.ResourceDa
 	return nil
 }
 
-func resourceTencentCloudTcrTagRetentionExecutionConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrTagRetentionExecutionConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_tag_retention_execution_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -163,9 +165,9 @@ func resourceTencentCloudTcrTagRetentionExecutionConfigUpdate(d *schema.Resource
 
 	request := tcr.NewCreateTagRetentionExecutionRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	registryId := idSplit[0]
 	retentionId := idSplit[1]
@@ -179,8 +181,8 @@ func resourceTencentCloudTcrTagRetentionExecutionConfigUpdate(d *schema.Resource
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().CreateTagRetentionExecution(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().CreateTagRetentionExecutionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -190,21 +192,21 @@ func resourceTencentCloudTcrTagRetentionExecutionConfigUpdate(d *schema.Resource
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tcr TagRetentionExecutionConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	service := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	conf := BuildStateChangeConf([]string{}, []string{"Succeed"}, 3*readRetryTimeout, time.Second, service.TcrTagRetentionExecutionConfigStateRefreshFunc(registryId, retentionId, []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"Succeed"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.TcrTagRetentionExecutionConfigStateRefreshFunc(registryId, retentionId, []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	return resourceTencentCloudTcrTagRetentionExecutionConfigRead(d, meta)
+	return resourceTencentCloudTcrTagRetentionExecutionConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrTagRetentionExecutionConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrTagRetentionExecutionConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_tag_retention_execution_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
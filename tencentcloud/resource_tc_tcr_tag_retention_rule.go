@@ -44,10 +44,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcr/v20190924"
@@ -56,12 +56,16 @@ import (
 
 func resourceTencentCloudTcrTagRetentionRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrTagRetentionRuleCreate,
-		Read:   resourceTencentCloudTcrTagRetentionRuleRead,
-		Update: resourceTencentCloudTcrTagRetentionRuleUpdate,
-		Delete: resourceTencentCloudTcrTagRetentionRuleDelete,
+		CreateContext: resourceTencentCloudTcrTagRetentionRuleCreate,
+		ReadContext:   resourceTencentCloudTcrTagRetentionRuleRead,
+		UpdateContext: resourceTencentCloudTcrTagRetentionRuleUpdate,
+		DeleteContext: resourceTencentCloudTcrTagRetentionRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
@@ -118,18 +122,18 @@ func resourceTencentCloudTcrTagRetentionRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcrTagRetentionRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrTagRetentionRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_tag_retention_rule.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		request       = tcr.NewCreateTagRetentionRuleRequest()
 		registryId    string
 		namespaceName string
 		tcrService    = TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if v, ok := d.GetOk("registry_id"); ok {
 		registryId = v.(string)
 		request.RegistryId = helper.String(v.(string))
@@ -143,10 +147,10 @@ func resourceTencentCloudTcrTagRetentionRuleCreate(d *schema.ResourceData, meta
 		namespaceName = v.(string)
 		namespace, has, err := tcrService.DescribeTCRNameSpaceById(ctx, registryId, namespaceName)
 		if !has || namespace == nil {
-			return fmt.Errorf("TCR namespace not found.")
+			return diag.Errorf("TCR namespace not found.")
 		}
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		request.NamespaceId = namespace.NamespaceId
 	}
@@ -170,8 +174,8 @@ func resourceTencentCloudTcrTagRetentionRuleCreate(d *schema.ResourceData, meta
 		request.Disabled = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().CreateTagRetentionRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().CreateTagRetentionRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -181,38 +185,38 @@ func resourceTencentCloudTcrTagRetentionRuleCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tcr TagRetentionRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	TagRetentionRule, err := tcrService.DescribeTcrTagRetentionRuleById(ctx, registryId, namespaceName, nil)
 	if err != nil {
-		return fmt.Errorf("Query retention rule by id failed, reason:[%s]", err.Error())
+		return diag.Errorf("Query retention rule by id failed, reason:[%s]", err.Error())
 	}
 
 	if TagRetentionRule != nil {
 		retentionId := helper.Int64ToStr(*TagRetentionRule.RetentionId)
-		d.SetId(strings.Join([]string{registryId, namespaceName, retentionId}, FILED_SP))
+		d.SetId(helper.IdFormat(registryId, namespaceName, retentionId))
 	} else {
 		log.Printf("[CRITAL]%s TagRetentionRule is nil! Set unique id as empty.", logId)
 		d.SetId("")
 	}
 
-	return resourceTencentCloudTcrTagRetentionRuleRead(d, meta)
+	return resourceTencentCloudTcrTagRetentionRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrTagRetentionRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrTagRetentionRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_tag_retention_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	registryId := idSplit[0]
 	namespaceName := idSplit[1]
@@ -220,7 +224,7 @@ func resourceTencentCloudTcrTagRetentionRuleRead(d *schema.ResourceData, meta in
 
 	TagRetentionRule, err := service.DescribeTcrTagRetentionRuleById(ctx, registryId, namespaceName, &retentionId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if TagRetentionRule == nil {
@@ -265,7 +269,7 @@ func resourceTencentCloudTcrTagRetentionRuleRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTencentCloudTcrTagRetentionRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrTagRetentionRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_tag_retention_rule.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -273,11 +277,11 @@ func resourceTencentCloudTcrTagRetentionRuleUpdate(d *schema.ResourceData, meta
 
 	request := tcr.NewModifyTagRetentionRuleRequest()
 	tcrService := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	registryId := idSplit[0]
 	namespaceName := idSplit[1]
@@ -285,10 +289,10 @@ func resourceTencentCloudTcrTagRetentionRuleUpdate(d *schema.ResourceData, meta
 
 	namespace, has, err := tcrService.DescribeTCRNameSpaceById(ctx, registryId, namespaceName)
 	if !has || namespace == nil {
-		return fmt.Errorf("TCR namespace not found.")
+		return diag.Errorf("TCR namespace not found.")
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	request.RegistryId = &registryId
@@ -302,7 +306,7 @@ func resourceTencentCloudTcrTagRetentionRuleUpdate(d *schema.ResourceData, meta
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -325,8 +329,8 @@ func resourceTencentCloudTcrTagRetentionRuleUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().ModifyTagRetentionRule(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().ModifyTagRetentionRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -337,29 +341,29 @@ func resourceTencentCloudTcrTagRetentionRuleUpdate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update tcr TagRetentionRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTcrTagRetentionRuleRead(d, meta)
+	return resourceTencentCloudTcrTagRetentionRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrTagRetentionRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrTagRetentionRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_tag_retention_rule.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	registryId := idSplit[0]
 	retentionId := idSplit[2]
 
 	if err := service.DeleteTcrTagRetentionRuleById(ctx, registryId, retentionId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -34,20 +34,27 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudTcrToken() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrTokenCreate,
-		Read:   resourceTencentCloudTcrTokenRead,
-		Update: resourceTencentCloudTcrTokenUpdate,
-		Delete: resourceTencentCLoudTcrTokenDelete,
+		CreateContext: resourceTencentCloudTcrTokenCreate,
+		ReadContext:   resourceTencentCloudTcrTokenRead,
+		UpdateContext: resourceTencentCloudTcrTokenUpdate,
+		DeleteContext: resourceTencentCLoudTcrTokenDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -94,11 +101,11 @@ func resourceTencentCloudTcrToken() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcrTokenCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_token.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcrService := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -110,7 +117,7 @@ func resourceTencentCloudTcrTokenCreate(d *schema.ResourceData, meta interface{}
 		outErr, inErr            error
 	)
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		tokenId, token, userName, inErr = tcrService.CreateTCRLongTermToken(ctx, instanceId, description)
 		if inErr != nil {
 			return retryError(inErr)
@@ -118,15 +125,15 @@ func resourceTencentCloudTcrTokenCreate(d *schema.ResourceData, meta interface{}
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	d.SetId(instanceId + FILED_SP + tokenId)
+	d.SetId(helper.IdFormat(instanceId, tokenId))
 	_ = d.Set("token", token)
 	_ = d.Set("user_name", userName)
 
 	if !enable {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			inErr = tcrService.ModifyTCRLongTermToken(ctx, instanceId, tokenId, enable)
 			if inErr != nil {
 				return retryError(inErr)
@@ -134,23 +141,23 @@ func resourceTencentCloudTcrTokenCreate(d *schema.ResourceData, meta interface{}
 			return nil
 		})
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 	}
 
-	return resourceTencentCloudTcrTokenRead(d, meta)
+	return resourceTencentCloudTcrTokenRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrTokenUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrTokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_token.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Id()
-	items := strings.Split(resourceId, FILED_SP)
-	if len(items) != 2 {
-		return fmt.Errorf("invalid ID %s", resourceId)
+	items, err := helper.IdParseN(resourceId, 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := items[0]
@@ -162,7 +169,7 @@ func resourceTencentCloudTcrTokenUpdate(d *schema.ResourceData, meta interface{}
 		tcrService := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 		outErr = tcrService.ModifyTCRLongTermToken(ctx, instanceId, tokenId, enable)
 		if outErr != nil {
-			outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			outErr = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				inErr = tcrService.ModifyTCRLongTermToken(ctx, instanceId, tokenId, enable)
 				if inErr != nil {
 					return retryError(inErr)
@@ -171,23 +178,23 @@ func resourceTencentCloudTcrTokenUpdate(d *schema.ResourceData, meta interface{}
 			})
 		}
 		if outErr != nil {
-			return outErr
+			return diag.FromErr(outErr)
 		}
 	}
 
-	return resourceTencentCloudTcrTokenRead(d, meta)
+	return resourceTencentCloudTcrTokenRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrTokenRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_token.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Id()
-	items := strings.Split(resourceId, FILED_SP)
-	if len(items) != 2 {
-		return fmt.Errorf("invalid ID %s", resourceId)
+	items, err := helper.IdParseN(resourceId, 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := items[0]
@@ -197,7 +204,7 @@ func resourceTencentCloudTcrTokenRead(d *schema.ResourceData, meta interface{})
 	tcrService := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 	token, has, outErr := tcrService.DescribeTCRLongTermTokenById(ctx, instanceId, tokenId)
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			token, has, inErr = tcrService.DescribeTCRLongTermTokenById(ctx, instanceId, tokenId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -206,7 +213,7 @@ func resourceTencentCloudTcrTokenRead(d *schema.ResourceData, meta interface{})
 		})
 	}
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	if !has {
 		d.SetId("")
@@ -222,16 +229,16 @@ func resourceTencentCloudTcrTokenRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCLoudTcrTokenDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCLoudTcrTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_token.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Id()
-	items := strings.Split(resourceId, FILED_SP)
-	if len(items) != 2 {
-		return fmt.Errorf("invalid ID %s", resourceId)
+	items, err := helper.IdParseN(resourceId, 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := items[0]
@@ -244,7 +251,7 @@ func resourceTencentCLoudTcrTokenDelete(d *schema.ResourceData, meta interface{}
 
 	outErr = tcrService.DeleteTCRLongTermToken(ctx, instanceId, tokenId)
 	if outErr != nil {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			inErr = tcrService.DeleteTCRLongTermToken(ctx, instanceId, tokenId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -254,10 +261,10 @@ func resourceTencentCLoudTcrTokenDelete(d *schema.ResourceData, meta interface{}
 	}
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, inErr = tcrService.DescribeTCRLongTermTokenById(ctx, instanceId, tokenId)
 		if inErr != nil {
 			return retryError(inErr)
@@ -270,7 +277,7 @@ func resourceTencentCLoudTcrTokenDelete(d *schema.ResourceData, meta interface{}
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	return nil
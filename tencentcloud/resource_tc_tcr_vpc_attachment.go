@@ -50,10 +50,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
+	"time"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcr/v20190924"
@@ -61,10 +62,14 @@ import (
 
 func resourceTencentCloudTcrVpcAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrVpcAttachmentCreate,
-		Read:   resourceTencentCloudTcrVpcAttachmentRead,
-		Update: resourceTencentCloudTcrVpcAttachmentUpdate,
-		Delete: resourceTencentCLoudTcrVpcAttachmentDelete,
+		CreateContext: resourceTencentCloudTcrVpcAttachmentCreate,
+		ReadContext:   resourceTencentCloudTcrVpcAttachmentRead,
+		UpdateContext: resourceTencentCloudTcrVpcAttachmentUpdate,
+		DeleteContext: resourceTencentCLoudTcrVpcAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
 			State: helper.ImportWithDefaultValue(map[string]interface{}{
 				"enable_public_domain_dns": false,
@@ -131,11 +136,11 @@ func resourceTencentCloudTcrVpcAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcrVpcAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrVpcAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_vpc_attachment.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcrService := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -149,7 +154,7 @@ func resourceTencentCloudTcrVpcAttachmentCreate(d *schema.ResourceData, meta int
 		has           bool
 	)
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		inErr = tcrService.CreateTCRVPCAttachment(ctx, instanceId, vpcId, subnetId, regionId, regionName)
 		if inErr != nil {
 			return retryError(inErr)
@@ -157,14 +162,14 @@ func resourceTencentCloudTcrVpcAttachmentCreate(d *schema.ResourceData, meta int
 		return nil
 	})
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	d.SetId(instanceId + FILED_SP + vpcId + FILED_SP + subnetId)
+	d.SetId(helper.IdFormat(instanceId, vpcId, subnetId))
 
 	//check exist
 	//the attachment takes effect with a minute
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		_, has, inErr = tcrService.DescribeTCRVPCAttachmentById(ctx, instanceId, vpcId, subnetId)
 		if inErr != nil {
 			return retryError(inErr)
@@ -177,35 +182,35 @@ func resourceTencentCloudTcrVpcAttachmentCreate(d *schema.ResourceData, meta int
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	if enablePublicDomainDns := d.Get("enable_public_domain_dns").(bool); enablePublicDomainDns {
 		err := EnableTcrVpcDns(ctx, tcrService, instanceId, vpcId, subnetId, true, regionName)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if enableVpcDomainDns := d.Get("enable_vpc_domain_dns").(bool); enableVpcDomainDns {
 		err := EnableTcrVpcDns(ctx, tcrService, instanceId, vpcId, subnetId, false, regionName)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudTcrVpcAttachmentRead(d, meta)
+	return resourceTencentCloudTcrVpcAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrVpcAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrVpcAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_vpc_attachment.read")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Id()
-	items := strings.Split(resourceId, FILED_SP)
-	if len(items) != 3 {
-		return fmt.Errorf("invalid ID %s", resourceId)
+	items, err := helper.IdParseN(resourceId, 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := items[0]
@@ -216,7 +221,7 @@ func resourceTencentCloudTcrVpcAttachmentRead(d *schema.ResourceData, meta inter
 	tcrService := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 	vpcAccess, has, outErr := tcrService.DescribeTCRVPCAttachmentById(ctx, instanceId, vpcId, subnetId)
 	if outErr != nil {
-		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			vpcAccess, has, inErr = tcrService.DescribeTCRVPCAttachmentById(ctx, instanceId, vpcId, subnetId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -225,7 +230,7 @@ func resourceTencentCloudTcrVpcAttachmentRead(d *schema.ResourceData, meta inter
 		})
 	}
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 	if !has {
 		d.SetId("")
@@ -241,13 +246,13 @@ func resourceTencentCloudTcrVpcAttachmentRead(d *schema.ResourceData, meta inter
 	if *vpcAccess.AccessIp != "" {
 		publicDomainDnsStatus, err := GetDnsStatus(ctx, tcrService, instanceId, vpcId, *vpcAccess.AccessIp, true)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		_ = d.Set("enable_public_domain_dns", *publicDomainDnsStatus.Status == TCR_VPC_DNS_STATUS_ENABLED)
 
 		vpcDomainDnsStatus, err := GetDnsStatus(ctx, tcrService, instanceId, vpcId, *vpcAccess.AccessIp, false)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		_ = d.Set("enable_vpc_domain_dns", *vpcDomainDnsStatus.Status == TCR_VPC_DNS_STATUS_ENABLED)
 	}
@@ -255,11 +260,11 @@ func resourceTencentCloudTcrVpcAttachmentRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudTcrVpcAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrVpcAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_vpc_attachment.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tcrService := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -275,12 +280,12 @@ func resourceTencentCloudTcrVpcAttachmentUpdate(d *schema.ResourceData, meta int
 		if isEnabled := d.Get("enable_public_domain_dns").(bool); isEnabled {
 			err := EnableTcrVpcDns(ctx, tcrService, instanceId, vpcId, subnetId, true, regionName)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		} else {
 			err := DisableTcrVpcDns(ctx, tcrService, instanceId, vpcId, subnetId, true, regionName)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -289,32 +294,32 @@ func resourceTencentCloudTcrVpcAttachmentUpdate(d *schema.ResourceData, meta int
 		if isEnabled := d.Get("enable_vpc_domain_dns").(bool); isEnabled {
 			err := EnableTcrVpcDns(ctx, tcrService, instanceId, vpcId, subnetId, false, regionName)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		} else {
 			err := DisableTcrVpcDns(ctx, tcrService, instanceId, vpcId, subnetId, false, regionName)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 	d.Partial(false)
 
-	return resourceTencentCloudTcrVpcAttachmentRead(d, meta)
+	return resourceTencentCloudTcrVpcAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCLoudTcrVpcAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCLoudTcrVpcAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_vpc_attachment.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	resourceId := d.Id()
 	regionId := d.Get("region_id").(int)
 	regionName := d.Get("region_name").(string)
-	items := strings.Split(resourceId, FILED_SP)
-	if len(items) != 3 {
-		return fmt.Errorf("invalid ID %s", resourceId)
+	items, err := helper.IdParseN(resourceId, 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := items[0]
@@ -328,7 +333,7 @@ func resourceTencentCLoudTcrVpcAttachmentDelete(d *schema.ResourceData, meta int
 
 	outErr = tcrService.DeleteTCRVPCAttachment(ctx, instanceId, vpcId, subnetId, regionId, regionName)
 	if outErr != nil {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			inErr = tcrService.DeleteTCRVPCAttachment(ctx, instanceId, vpcId, subnetId, regionId, regionName)
 			if inErr != nil {
 				return retryError(inErr)
@@ -338,10 +343,10 @@ func resourceTencentCLoudTcrVpcAttachmentDelete(d *schema.ResourceData, meta int
 	}
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, has, inErr = tcrService.DescribeTCRVPCAttachmentById(ctx, instanceId, vpcId, subnetId)
 		if inErr != nil {
 			return retryError(inErr)
@@ -354,14 +359,14 @@ func resourceTencentCLoudTcrVpcAttachmentDelete(d *schema.ResourceData, meta int
 	})
 
 	if outErr != nil {
-		return outErr
+		return diag.FromErr(outErr)
 	}
 
 	return nil
 }
 
 func WaitForAccessIpExists(ctx context.Context, tcrService TCRService, instanceId string, vpcId string, subnetId string) (accessIp string, errRet error) {
-	errRet = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	errRet = retryContext(ctx, 3*readRetryTimeout, func() *resource.RetryError {
 		result, has, inErr := tcrService.DescribeTCRVPCAttachmentById(ctx, instanceId, vpcId, subnetId)
 		if inErr != nil {
 			return retryError(inErr)
@@ -387,7 +392,7 @@ func EnableTcrVpcDns(ctx context.Context, tcrService TCRService, instanceId stri
 		return err
 	}
 
-	outErr := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		inErr := tcrService.CreateTcrVpcDns(ctx, instanceId, vpcId, accessIp, usePublicDomain, regionName)
 		if inErr != nil {
 			return retryError(inErr)
@@ -404,7 +409,7 @@ func DisableTcrVpcDns(ctx context.Context, tcrService TCRService, instanceId str
 		return err
 	}
 
-	outErr := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		inErr := tcrService.DeleteTcrVpcDns(ctx, instanceId, vpcId, accessIp, usePublicDomain, regionName)
 		if inErr != nil {
 			return retryError(inErr)
@@ -416,7 +421,7 @@ func DisableTcrVpcDns(ctx context.Context, tcrService TCRService, instanceId str
 }
 
 func GetDnsStatus(ctx context.Context, tcrService TCRService, instanceId string, vpcId string, accessIp string, usePublicDomain bool) (status *tcr.VpcPrivateDomainStatus, err error) {
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		result, has, inErr := tcrService.DescribeTcrVpcDnsById(ctx, instanceId, vpcId, accessIp, usePublicDomain)
 		if inErr != nil {
 			return retryError(inErr)
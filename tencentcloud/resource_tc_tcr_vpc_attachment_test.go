@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func TestAccTencentCloudTcrVPCAttachment_basic(t *testing.T) {
@@ -48,7 +48,7 @@ func testAccCheckTCRVPCAttachmentDestroy(s *terraform.State) error {
 		if rs.Type != "tencentcloud_tcr_vpc_attachment" {
 			continue
 		}
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) != 3 {
 			return fmt.Errorf("invalid ID %s", rs.Primary.ID)
 		}
@@ -80,7 +80,7 @@ func testAccCheckTCRVPCAttachmentExists(n string) resource.TestCheckFunc {
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("vpc attachment id is not set")
 		}
-		items := strings.Split(rs.Primary.ID, FILED_SP)
+		items := helper.IdParse(rs.Primary.ID)
 		if len(items) != 3 {
 			return fmt.Errorf("invalid ID %s", rs.Primary.ID)
 		}
@@ -75,8 +75,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tcr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcr/v20190924"
@@ -85,12 +86,16 @@ import (
 
 func resourceTencentCloudTcrWebhookTrigger() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrWebhookTriggerCreate,
-		Read:   resourceTencentCloudTcrWebhookTriggerRead,
-		Update: resourceTencentCloudTcrWebhookTriggerUpdate,
-		Delete: resourceTencentCloudTcrWebhookTriggerDelete,
+		CreateContext: resourceTencentCloudTcrWebhookTriggerCreate,
+		ReadContext:   resourceTencentCloudTcrWebhookTriggerRead,
+		UpdateContext: resourceTencentCloudTcrWebhookTriggerUpdate,
+		DeleteContext: resourceTencentCloudTcrWebhookTriggerDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
@@ -200,7 +205,7 @@ func resourceTencentCloudTcrWebhookTrigger() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTcrWebhookTriggerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrWebhookTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_webhook_trigger.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -277,8 +282,8 @@ func resourceTencentCloudTcrWebhookTriggerCreate(d *schema.ResourceData, meta in
 		request.Namespace = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().CreateWebhookTrigger(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().CreateWebhookTriggerWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -289,38 +294,38 @@ func resourceTencentCloudTcrWebhookTriggerCreate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tcr WebhookTrigger failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	triggerId = helper.Int64ToStr(*response.Response.Trigger.Id)
-	d.SetId(strings.Join([]string{registryId, namespaceName, triggerId}, FILED_SP))
+	d.SetId(helper.IdFormat(registryId, namespaceName, triggerId))
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::tcr:%s:uin/:repository/%s", region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTcrWebhookTriggerRead(d, meta)
+	return resourceTencentCloudTcrWebhookTriggerRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrWebhookTriggerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrWebhookTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_webhook_trigger.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	registryId := idSplit[0]
 	namespaceName := idSplit[1]
@@ -328,7 +333,7 @@ func resourceTencentCloudTcrWebhookTriggerRead(d *schema.ResourceData, meta inte
 
 	WebhookTrigger, err := service.DescribeTcrWebhookTriggerById(ctx, registryId, triggerId, namespaceName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if WebhookTrigger == nil {
@@ -409,7 +414,7 @@ func resourceTencentCloudTcrWebhookTriggerRead(d *schema.ResourceData, meta inte
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "tcr", "repository", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
@@ -419,7 +424,7 @@ func resourceTencentCloudTcrWebhookTriggerRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudTcrWebhookTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrWebhookTriggerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_webhook_trigger.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -427,9 +432,9 @@ func resourceTencentCloudTcrWebhookTriggerUpdate(d *schema.ResourceData, meta in
 
 	request := tcr.NewModifyWebhookTriggerRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	registryId := idSplit[0]
 	namespaceName := idSplit[1]
@@ -442,7 +447,7 @@ func resourceTencentCloudTcrWebhookTriggerUpdate(d *schema.ResourceData, meta in
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -511,8 +516,8 @@ func resourceTencentCloudTcrWebhookTriggerUpdate(d *schema.ResourceData, meta in
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().ModifyWebhookTrigger(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTCRClient().ModifyWebhookTriggerWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -522,42 +527,42 @@ func resourceTencentCloudTcrWebhookTriggerUpdate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tcr WebhookTrigger failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		oldTags, newTags := d.GetChange("tags")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("tcr", "repository", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTcrWebhookTriggerRead(d, meta)
+	return resourceTencentCloudTcrWebhookTriggerRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTcrWebhookTriggerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTcrWebhookTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tcr_webhook_trigger.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TCRService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	registryId := idSplit[0]
 	namespaceName := idSplit[1]
 	triggerId := idSplit[2]
 
 	if err := service.DeleteTcrWebhookTriggerById(ctx, registryId, namespaceName, helper.StrToInt64(triggerId)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -35,7 +35,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdcpg "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdcpg/v20211118"
@@ -44,12 +46,16 @@ import (
 
 func resourceTencentCloudTdcpgCluster() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTdcpgClusterRead,
-		Create: resourceTencentCloudTdcpgClusterCreate,
-		Update: resourceTencentCloudTdcpgClusterUpdate,
-		Delete: resourceTencentCloudTdcpgClusterDelete,
+		ReadContext:   resourceTencentCloudTdcpgClusterRead,
+		CreateContext: resourceTencentCloudTdcpgClusterCreate,
+		UpdateContext: resourceTencentCloudTdcpgClusterUpdate,
+		DeleteContext: resourceTencentCloudTdcpgClusterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"zone": {
@@ -136,7 +142,7 @@ func resourceTencentCloudTdcpgCluster() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTdcpgClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdcpgClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdcpg_cluster.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -146,10 +152,10 @@ func resourceTencentCloudTdcpgClusterCreate(d *schema.ResourceData, meta interfa
 		request   = tdcpg.NewCreateClusterRequest()
 		response  *tdcpg.CreateClusterResponse
 		service   = TdcpgService{client: meta.(*TencentCloudClient).apiV3Conn}
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		clusterId string
 		dealNames []*string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("zone"); ok {
 		request.Zone = helper.String(v.(string))
@@ -203,8 +209,8 @@ func resourceTencentCloudTdcpgClusterCreate(d *schema.ResourceData, meta interfa
 		request.ProjectId = helper.IntUint64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdcpgClient().CreateCluster(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdcpgClient().CreateClusterWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -217,10 +223,10 @@ func resourceTencentCloudTdcpgClusterCreate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITICAL]%s create tdcpg cluster failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		dealNames = response.Response.DealNameSet
 		resources, e := service.DescribeTdcpgResourceByDealName(ctx, dealNames)
 
@@ -236,28 +242,28 @@ func resourceTencentCloudTdcpgClusterCreate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITICAL]%s query tdcpg cluster resource by deal name:[%v] failed, reason:%+v", logId, dealNames, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(clusterId)
-	return resourceTencentCloudTdcpgClusterRead(d, meta)
+	return resourceTencentCloudTdcpgClusterRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdcpgClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdcpgClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdcpg_cluster.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId           = getLogId(contextNil)
-		ctx             = context.WithValue(context.TODO(), logIdKey, logId)
 		cluster         *tdcpg.Cluster
 		clusterInstance *tdcpg.Instance
 		service         = TdcpgService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	// query the cluster
 	clusterId := d.Id()
-	err := resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, 5*readRetryTimeout, func() *resource.RetryError {
 
 		result, err := service.DescribeTdcpgCluster(ctx, &clusterId)
 		if err != nil {
@@ -281,11 +287,11 @@ func resourceTencentCloudTdcpgClusterRead(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		d.SetId("")
-		return fmt.Errorf("resource `cluster` %s does not exist. error reason:[%s]", clusterId, err)
+		return diag.Errorf("resource `cluster` %s does not exist. error reason:[%s]", clusterId, err)
 	}
 
 	// query the instance of cluster
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		instances, e := service.DescribeTdcpgInstancesByFilter(ctx, &clusterId, nil)
 		if e != nil {
 			return retryError(e)
@@ -298,7 +304,7 @@ func resourceTencentCloudTdcpgClusterRead(d *schema.ResourceData, meta interface
 		return resource.RetryableError(fmt.Errorf("instances is nil, retry..."))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// set attr from the instance of cluster
@@ -359,7 +365,7 @@ func resourceTencentCloudTdcpgClusterRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudTdcpgClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdcpgClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdcpg_cluster.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -372,31 +378,31 @@ func resourceTencentCloudTdcpgClusterUpdate(d *schema.ResourceData, meta interfa
 	request.ClusterId = &clusterId
 
 	if d.HasChange("zone") {
-		return fmt.Errorf("`zone` do not support change now.")
+		return diag.Errorf("`zone` do not support change now.")
 	}
 
 	if d.HasChange("master_user_password") {
-		return fmt.Errorf("`master_user_password` do not support change now.")
+		return diag.Errorf("`master_user_password` do not support change now.")
 	}
 
 	if d.HasChange("cpu") {
-		return fmt.Errorf("`cpu` do not support change now.")
+		return diag.Errorf("`cpu` do not support change now.")
 	}
 
 	if d.HasChange("memory") {
-		return fmt.Errorf("`memory` do not support change now.")
+		return diag.Errorf("`memory` do not support change now.")
 	}
 
 	if d.HasChange("vpc_id") {
-		return fmt.Errorf("`vpc_id` do not support change now.")
+		return diag.Errorf("`vpc_id` do not support change now.")
 	}
 
 	if d.HasChange("subnet_id") {
-		return fmt.Errorf("`subnet_id` do not support change now.")
+		return diag.Errorf("`subnet_id` do not support change now.")
 	}
 
 	if d.HasChange("pay_mode") {
-		return fmt.Errorf("`pay_mode` do not support change now.")
+		return diag.Errorf("`pay_mode` do not support change now.")
 	}
 
 	if v, ok := d.GetOk("cluster_name"); ok {
@@ -404,27 +410,27 @@ func resourceTencentCloudTdcpgClusterUpdate(d *schema.ResourceData, meta interfa
 	}
 
 	if d.HasChange("db_version") {
-		return fmt.Errorf("`db_version` do not support change now.")
+		return diag.Errorf("`db_version` do not support change now.")
 	}
 
 	if d.HasChange("instance_count") {
-		return fmt.Errorf("`instance_count` do not support change now.")
+		return diag.Errorf("`instance_count` do not support change now.")
 	}
 
 	if d.HasChange("period") {
-		return fmt.Errorf("`period` do not support change now.")
+		return diag.Errorf("`period` do not support change now.")
 	}
 
 	if d.HasChange("storage") {
-		return fmt.Errorf("`storage` do not support change now.")
+		return diag.Errorf("`storage` do not support change now.")
 	}
 
 	if d.HasChange("project_id") {
-		return fmt.Errorf("`project_id` do not support change now.")
+		return diag.Errorf("`project_id` do not support change now.")
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdcpgClient().ModifyClusterName(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdcpgClient().ModifyClusterNameWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -436,25 +442,25 @@ func resourceTencentCloudTdcpgClusterUpdate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITICAL]%s create tdcpg cluster failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTdcpgClusterRead(d, meta)
+	return resourceTencentCloudTdcpgClusterRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdcpgClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdcpgClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdcpg_cluster.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TdcpgService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	clusterId := d.Id()
 
 	if err := service.DeleteTdcpgClusterById(ctx, &clusterId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -35,8 +35,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdcpg "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdcpg/v20211118"
@@ -45,12 +46,16 @@ import (
 
 func resourceTencentCloudTdcpgInstance() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTdcpgInstanceRead,
-		Create: resourceTencentCloudTdcpgInstanceCreate,
-		Update: resourceTencentCloudTdcpgInstanceUpdate,
-		Delete: resourceTencentCloudTdcpgInstanceDelete,
+		ReadContext:   resourceTencentCloudTdcpgInstanceRead,
+		CreateContext: resourceTencentCloudTdcpgInstanceCreate,
+		UpdateContext: resourceTencentCloudTdcpgInstanceUpdate,
+		DeleteContext: resourceTencentCloudTdcpgInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -86,7 +91,7 @@ func resourceTencentCloudTdcpgInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTdcpgInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdcpgInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdcpg_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -96,11 +101,11 @@ func resourceTencentCloudTdcpgInstanceCreate(d *schema.ResourceData, meta interf
 		request    = tdcpg.NewCreateClusterInstancesRequest()
 		response   *tdcpg.CreateClusterInstancesResponse
 		service    = TdcpgService{client: meta.(*TencentCloudClient).apiV3Conn}
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		clusterId  string
 		instanceId string
 		dealNames  []*string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("cluster_id"); ok {
 		request.ClusterId = helper.String(v.(string))
@@ -118,8 +123,8 @@ func resourceTencentCloudTdcpgInstanceCreate(d *schema.ResourceData, meta interf
 		request.InstanceName = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdcpgClient().CreateClusterInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdcpgClient().CreateClusterInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -132,10 +137,10 @@ func resourceTencentCloudTdcpgInstanceCreate(d *schema.ResourceData, meta interf
 
 	if err != nil {
 		log.Printf("[CRITICAL]%s create tdcpg instance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		dealNames = response.Response.DealNameSet
 		resources, e := service.DescribeTdcpgResourceByDealName(ctx, dealNames)
 
@@ -152,29 +157,32 @@ func resourceTencentCloudTdcpgInstanceCreate(d *schema.ResourceData, meta interf
 
 	if err != nil {
 		log.Printf("[CRITICAL]%s query tdcpg cluster resource by deal name:[%v] failed, reason:%+v", logId, dealNames, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(clusterId + FILED_SP + instanceId)
-	return resourceTencentCloudTdcpgInstanceRead(d, meta)
+	d.SetId(helper.IdFormat(clusterId, instanceId))
+	return resourceTencentCloudTdcpgInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdcpgInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdcpgInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdcpg_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = TdcpgService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instance   *tdcpg.Instance
-		ids        = strings.Split(d.Id(), FILED_SP)
-		clusterId  = ids[0]
-		instanceId = ids[1]
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	ids, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	clusterId, instanceId := ids[0], ids[1]
 
 	// query the instance of cluster
-	err := resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, 3*readRetryTimeout, func() *resource.RetryError {
 		instances, e := service.DescribeTdcpgInstance(ctx, &clusterId, &instanceId)
 		if e != nil {
 			return retryError(e)
@@ -196,12 +204,12 @@ func resourceTencentCloudTdcpgInstanceRead(d *schema.ResourceData, meta interfac
 		return resource.RetryableError(fmt.Errorf("can not get tdcpg instance[%s] status, retry...", d.Id()))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `instance` %s does not exist", instanceId)
+		return diag.Errorf("resource `instance` %s does not exist", instanceId)
 	}
 
 	if instance.ClusterId != nil {
@@ -223,19 +231,22 @@ func resourceTencentCloudTdcpgInstanceRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudTdcpgInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdcpgInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdcpg_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = TdcpgService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = tdcpg.NewModifyClusterInstancesSpecRequest()
-		ids        = strings.Split(d.Id(), FILED_SP)
-		clusterId  = ids[0]
-		instanceId = ids[1]
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	ids, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	clusterId, instanceId := ids[0], ids[1]
 
 	request.ClusterId = &clusterId
 	request.InstanceIdSet = []*string{helper.String(instanceId)}
@@ -252,8 +263,8 @@ func resourceTencentCloudTdcpgInstanceUpdate(d *schema.ResourceData, meta interf
 		request.OperationTiming = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdcpgClient().ModifyClusterInstancesSpec(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdcpgClient().ModifyClusterInstancesSpecWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -265,11 +276,11 @@ func resourceTencentCloudTdcpgInstanceUpdate(d *schema.ResourceData, meta interf
 
 	if err != nil {
 		log.Printf("[CRITICAL]%s modify tdcpg instance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// check the instance value to make sure modify successfully.
-	err = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		instances, e := service.DescribeTdcpgInstance(ctx, &clusterId, &instanceId)
 		if e != nil {
 			return retryError(e)
@@ -290,27 +301,30 @@ func resourceTencentCloudTdcpgInstanceUpdate(d *schema.ResourceData, meta interf
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTdcpgInstanceRead(d, meta)
+	return resourceTencentCloudTdcpgInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdcpgInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdcpgInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdcpg_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = TdcpgService{client: meta.(*TencentCloudClient).apiV3Conn}
-		ids        = strings.Split(d.Id(), FILED_SP)
-		clusterId  = ids[0]
-		instanceId = ids[1]
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	ids, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	clusterId, instanceId := ids[0], ids[1]
 
 	if err := service.DeleteTdcpgInstanceById(ctx, &clusterId, &instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -114,7 +114,7 @@ func testAccCheckTdcpgInstanceDestroy(s *terraform.State) error {
 		if rs.Type != "tencentcloud_tdcpg_instance" {
 			continue
 		}
-		ids := strings.Split(rs.Primary.ID, FILED_SP)
+		ids := helper.IdParse(rs.Primary.ID)
 
 		ret, err := tdcpgService.DescribeTdcpgInstance(ctx, &ids[0], &ids[1])
 		if err != nil {
@@ -146,7 +146,7 @@ func testAccCheckTdcpgInstanceExists(re string) resource.TestCheckFunc {
 		}
 
 		tdcpgService := TdcpgService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
-		ids := strings.Split(rs.Primary.ID, FILED_SP)
+		ids := helper.IdParse(rs.Primary.ID)
 		ret, err := tdcpgService.DescribeTdcpgInstance(ctx, &ids[0], &ids[1])
 		if err != nil {
 			return err
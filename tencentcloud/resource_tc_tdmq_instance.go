@@ -27,7 +27,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -36,12 +38,15 @@ import (
 
 func resourceTencentCloudTdmqInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTdmqCreate,
-		Read:   resourceTencentCloudTdmqRead,
-		Update: resourceTencentCloudTdmqUpdate,
-		Delete: resourceTencentCloudTdmqDelete,
+		CreateContext: resourceTencentCloudTdmqCreate,
+		ReadContext:   resourceTencentCloudTdmqRead,
+		UpdateContext: resourceTencentCloudTdmqUpdate,
+		DeleteContext: resourceTencentCloudTdmqDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -69,11 +74,11 @@ func resourceTencentCloudTdmqInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTdmqCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_instance.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request  = tdmq.NewCreateClusterRequest()
@@ -91,8 +96,8 @@ func resourceTencentCloudTdmqCreate(d *schema.ResourceData, meta interface{}) er
 		request.Remark = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateCluster(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateClusterWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -105,7 +110,7 @@ func resourceTencentCloudTdmqCreate(d *schema.ResourceData, meta interface{}) er
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tdmq instance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterId := *response.Response.ClusterId
@@ -115,27 +120,27 @@ func resourceTencentCloudTdmqCreate(d *schema.ResourceData, meta interface{}) er
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::tdmq:%s:uin/:cluster/%s", region, clusterId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(clusterId)
 
-	return resourceTencentCloudTdmqRead(d, meta)
+	return resourceTencentCloudTdmqRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
 	tdmqService := TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		info, has, e := tdmqService.DescribeTdmqInstanceById(ctx, id)
 		if e != nil {
 			return retryError(e)
@@ -150,25 +155,25 @@ func resourceTencentCloudTdmqRead(d *schema.ResourceData, meta interface{}) erro
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	tcClient := meta.(*TencentCloudClient).apiV3Conn
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "tdmq", "cluster", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudTdmqUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_instance.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -193,7 +198,7 @@ func resourceTencentCloudTdmqUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if err := service.ModifyTdmqInstanceAttribute(ctx, id, clusterName, remark); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
@@ -203,23 +208,23 @@ func resourceTencentCloudTdmqUpdate(d *schema.ResourceData, meta interface{}) er
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("tdmq", "cluster", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudTdmqRead(d, meta)
+	return resourceTencentCloudTdmqRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_instance.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 	clusterId := d.Id()
 
 	if err := service.DeleteTdmqInstance(ctx, clusterId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -36,23 +36,27 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	tdmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudTdmqNamespace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTdmqNamespaceCreate,
-		Read:   resourceTencentCloudTdmqNamespaceRead,
-		Update: resourceTencentCloudTdmqNamespaceUpdate,
-		Delete: resourceTencentCloudTdmqNamespaceDelete,
+		CreateContext: resourceTencentCloudTdmqNamespaceCreate,
+		ReadContext:   resourceTencentCloudTdmqNamespaceRead,
+		UpdateContext: resourceTencentCloudTdmqNamespaceUpdate,
+		DeleteContext: resourceTencentCloudTdmqNamespaceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -103,11 +107,11 @@ func resourceTencentCloudTdmqNamespace() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTdmqNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_namespace.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tdmqService := TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -121,7 +125,7 @@ func resourceTencentCloudTdmqNamespaceCreate(d *schema.ResourceData, meta interf
 	if temp, ok := d.GetOk("environ_name"); ok {
 		environ_name = temp.(string)
 		if len(environ_name) < 1 {
-			return fmt.Errorf("environ_name should be not empty string")
+			return diag.Errorf("environ_name should be not empty string")
 		}
 	}
 
@@ -147,30 +151,30 @@ func resourceTencentCloudTdmqNamespaceCreate(d *schema.ResourceData, meta interf
 	}
 	environId, err := tdmqService.CreateTdmqNamespace(ctx, environ_name, msg_ttl, clusterId, remark, retentionPolicy)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{environId, clusterId}, FILED_SP))
-	return resourceTencentCloudTdmqNamespaceRead(d, meta)
+	d.SetId(helper.IdFormat(environId, clusterId))
+	return resourceTencentCloudTdmqNamespaceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_namespace.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+		return diag.Errorf("id is broken,%s", idSplit)
 	}
 	environId := idSplit[0]
 	clusterId := idSplit[1]
 
 	tdmqService := TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		info, has, e := tdmqService.DescribeTdmqNamespaceById(ctx, environId, clusterId)
 		if e != nil {
 			return retryError(e)
@@ -194,20 +198,20 @@ func resourceTencentCloudTdmqNamespaceRead(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudTdmqNamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_instance.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	environId := idSplit[0]
 	clusterId := idSplit[1]
@@ -249,29 +253,29 @@ func resourceTencentCloudTdmqNamespaceUpdate(d *schema.ResourceData, meta interf
 
 	d.Partial(true)
 	if err := service.ModifyTdmqNamespaceAttribute(ctx, environId, msgTtl, remark, clusterId, retentionPolicy); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Partial(false)
-	return resourceTencentCloudTdmqNamespaceRead(d, meta)
+	return resourceTencentCloudTdmqNamespaceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_instance.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+		return diag.Errorf("id is broken,%s", idSplit)
 	}
 	environId := idSplit[0]
 	clusterId := idSplit[1]
 
 	service := TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if err := service.DeleteTdmqNamespace(ctx, environId, clusterId); err != nil {
 			if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok {
 				if sdkErr.Code == VPCNotFound {
@@ -283,5 +287,5 @@ func resourceTencentCloudTdmqNamespaceDelete(d *schema.ResourceData, meta interf
 		return nil
 	})
 
-	return err
+	return diag.FromErr(err)
 }
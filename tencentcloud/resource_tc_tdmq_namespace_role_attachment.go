@@ -41,9 +41,9 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -52,12 +52,15 @@ import (
 
 func resourceTencentCloudTdmqNamespaceRoleAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTdmqNamespaceRoleAttachmentCreate,
-		Read:   resourceTencentCloudTdmqNamespaceRoleAttachmentRead,
-		Update: resourceTencentCloudTdmqNamespaceRoleAttachmentUpdate,
-		Delete: resourceTencentCloudTdmqNamespaceRoleAttachmentDelete,
+		CreateContext: resourceTencentCloudTdmqNamespaceRoleAttachmentCreate,
+		ReadContext:   resourceTencentCloudTdmqNamespaceRoleAttachmentRead,
+		UpdateContext: resourceTencentCloudTdmqNamespaceRoleAttachmentUpdate,
+		DeleteContext: resourceTencentCloudTdmqNamespaceRoleAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -92,11 +95,11 @@ func resourceTencentCloudTdmqNamespaceRoleAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTdmqNamespaceRoleAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqNamespaceRoleAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_namespace_role_attachment.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tdmqService := TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -109,14 +112,14 @@ func resourceTencentCloudTdmqNamespaceRoleAttachmentCreate(d *schema.ResourceDat
 	if temp, ok := d.GetOk("environ_id"); ok {
 		environId = temp.(string)
 		if len(environId) < 1 {
-			return fmt.Errorf("environ_id should be not empty string")
+			return diag.Errorf("environ_id should be not empty string")
 		}
 	}
 
 	if temp, ok := d.GetOk("role_name"); ok {
 		roleName = temp.(string)
 		if len(roleName) < 1 {
-			return fmt.Errorf("role_name should be not empty string")
+			return diag.Errorf("role_name should be not empty string")
 		}
 	}
 
@@ -129,30 +132,30 @@ func resourceTencentCloudTdmqNamespaceRoleAttachmentCreate(d *schema.ResourceDat
 	if temp, ok := d.GetOk("cluster_id"); ok {
 		clusterId = temp.(string)
 		if len(clusterId) < 1 {
-			return fmt.Errorf("cluster_id should be not empty string")
+			return diag.Errorf("cluster_id should be not empty string")
 		}
 	}
 
 	err := tdmqService.CreateTdmqNamespaceRoleAttachment(ctx, environId, roleName, permissions, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(environId + FILED_SP + roleName)
+	d.SetId(helper.IdFormat(environId, roleName))
 
-	return resourceTencentCloudTdmqNamespaceRoleAttachmentRead(d, meta)
+	return resourceTencentCloudTdmqNamespaceRoleAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqNamespaceRoleAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqNamespaceRoleAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_namespace_role_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("environment role id is borken, id is %s", d.Id())
+		return diag.Errorf("environment role id is borken, id is %s", d.Id())
 	}
 	environId := idSplit[0]
 	roleName := idSplit[1]
@@ -160,7 +163,7 @@ func resourceTencentCloudTdmqNamespaceRoleAttachmentRead(d *schema.ResourceData,
 
 	tdmqService := TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		info, has, e := tdmqService.DescribeTdmqNamespaceRoleAttachment(ctx, environId, roleName, clusterId)
 		if e != nil {
 			return retryError(e)
@@ -176,20 +179,20 @@ func resourceTencentCloudTdmqNamespaceRoleAttachmentRead(d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudTdmqNamespaceRoleAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqNamespaceRoleAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_namespace_role_attachment.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("environment role id is borken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	environId := idSplit[0]
 	roleName := idSplit[1]
@@ -214,22 +217,22 @@ func resourceTencentCloudTdmqNamespaceRoleAttachmentUpdate(d *schema.ResourceDat
 	d.Partial(true)
 
 	if err := service.ModifyTdmqNamespaceRoleAttachment(ctx, environId, roleName, permissions, clusterId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Partial(false)
-	return resourceTencentCloudTdmqNamespaceRoleAttachmentRead(d, meta)
+	return resourceTencentCloudTdmqNamespaceRoleAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqNamespaceRoleAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqNamespaceRoleAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_namespace_role_attachment.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("environment role id is borken, id is %s", d.Id())
+		return diag.Errorf("environment role id is borken, id is %s", d.Id())
 	}
 	environId := idSplit[0]
 	roleName := idSplit[1]
@@ -237,7 +240,7 @@ func resourceTencentCloudTdmqNamespaceRoleAttachmentDelete(d *schema.ResourceDat
 
 	service := TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if err := service.DeleteTdmqNamespaceRoleAttachment(ctx, environId, roleName, clusterId); err != nil {
 			if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok {
 				if sdkErr.Code == VPCNotFound {
@@ -249,5 +252,5 @@ func resourceTencentCloudTdmqNamespaceRoleAttachmentDelete(d *schema.ResourceDat
 		return nil
 	})
 
-	return err
+	return diag.FromErr(err)
 }
@@ -14,15 +14,23 @@ resource "tencentcloud_tdmq_rabbitmq_user" "rabbitmq_user" {
   max_channels    = 3
 }
 ```
+
+Import
+
+tdmq rabbitmq_user can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_tdmq_rabbitmq_user.rabbitmq_user amqp-8xzx822q#keep-user
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -31,10 +39,17 @@ import (
 
 func resourceTencentCloudTdmqRabbitmqUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTdmqRabbitmqUserCreate,
-		Read:   resourceTencentCloudTdmqRabbitmqUserRead,
-		Update: resourceTencentCloudTdmqRabbitmqUserUpdate,
-		Delete: resourceTencentCloudTdmqRabbitmqUserDelete,
+		CreateContext: resourceTencentCloudTdmqRabbitmqUserCreate,
+		ReadContext:   resourceTencentCloudTdmqRabbitmqUserRead,
+		UpdateContext: resourceTencentCloudTdmqRabbitmqUserUpdate,
+		DeleteContext: resourceTencentCloudTdmqRabbitmqUserDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -78,7 +93,7 @@ func resourceTencentCloudTdmqRabbitmqUser() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTdmqRabbitmqUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRabbitmqUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_rabbitmq_user.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -119,8 +134,8 @@ func resourceTencentCloudTdmqRabbitmqUserCreate(d *schema.ResourceData, meta int
 		request.MaxChannels = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateRabbitMQUser(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateRabbitMQUserWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -133,29 +148,29 @@ func resourceTencentCloudTdmqRabbitmqUserCreate(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tdmq rabbitmqUser failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	user = *response.Response.User
 
-	d.SetId(strings.Join([]string{instanceId, user}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, user))
 
-	return resourceTencentCloudTdmqRabbitmqUserRead(d, meta)
+	return resourceTencentCloudTdmqRabbitmqUserRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRabbitmqUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRabbitmqUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_rabbitmq_user.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := idSplit[0]
@@ -163,7 +178,7 @@ func resourceTencentCloudTdmqRabbitmqUserRead(d *schema.ResourceData, meta inter
 
 	rabbitmqUser, err := service.DescribeTdmqRabbitmqUserById(ctx, instanceId, user)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if rabbitmqUser == nil {
@@ -195,7 +210,7 @@ func resourceTencentCloudTdmqRabbitmqUserRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudTdmqRabbitmqUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRabbitmqUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_rabbitmq_user.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -204,9 +219,9 @@ func resourceTencentCloudTdmqRabbitmqUserUpdate(d *schema.ResourceData, meta int
 		request = tdmq.NewModifyRabbitMQUserRequest()
 	)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := idSplit[0]
@@ -216,7 +231,7 @@ func resourceTencentCloudTdmqRabbitmqUserUpdate(d *schema.ResourceData, meta int
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -240,8 +255,8 @@ func resourceTencentCloudTdmqRabbitmqUserUpdate(d *schema.ResourceData, meta int
 			request.MaxChannels = helper.IntInt64(v.(int))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyRabbitMQUser(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyRabbitMQUserWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -253,33 +268,33 @@ func resourceTencentCloudTdmqRabbitmqUserUpdate(d *schema.ResourceData, meta int
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update tdmq rabbitmqUser failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTdmqRabbitmqUserRead(d, meta)
+	return resourceTencentCloudTdmqRabbitmqUserRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRabbitmqUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRabbitmqUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_rabbitmq_user.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := idSplit[0]
 	user := idSplit[1]
 
 	if err := service.DeleteTdmqRabbitmqUserById(ctx, instanceId, user); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
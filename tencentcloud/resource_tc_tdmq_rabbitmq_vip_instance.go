@@ -32,6 +32,14 @@ resource "tencentcloud_tdmq_rabbitmq_vip_instance" "example" {
   time_span                             = 1
 }
 ```
+
+Import
+
+tdmq rabbitmq_vip_instance can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_tdmq_rabbitmq_vip_instance.example amqp-mok52gmn
+```
 */
 package tencentcloud
 
@@ -39,7 +47,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -48,10 +58,17 @@ import (
 
 func resourceTencentCloudTdmqRabbitmqVipInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTdmqRabbitmqVipInstanceCreate,
-		Read:   resourceTencentCloudTdmqRabbitmqVipInstanceRead,
-		Update: resourceTencentCloudTdmqRabbitmqVipInstanceUpdate,
-		Delete: resourceTencentCloudTdmqRabbitmqVipInstanceDelete,
+		CreateContext: resourceTencentCloudTdmqRabbitmqVipInstanceCreate,
+		ReadContext:   resourceTencentCloudTdmqRabbitmqVipInstanceRead,
+		UpdateContext: resourceTencentCloudTdmqRabbitmqVipInstanceUpdate,
+		DeleteContext: resourceTencentCloudTdmqRabbitmqVipInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"zone_ids": {
@@ -109,18 +126,18 @@ func resourceTencentCloudTdmqRabbitmqVipInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTdmqRabbitmqVipInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRabbitmqVipInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_rabbitmq_vip_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request    = tdmq.NewCreateRabbitMQVipInstanceRequest()
 		response   = tdmq.NewCreateRabbitMQVipInstanceResponse()
 		instanceId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("zone_ids"); ok {
 		zoneIdsSet := v.(*schema.Set).List()
@@ -166,8 +183,8 @@ func resourceTencentCloudTdmqRabbitmqVipInstanceCreate(d *schema.ResourceData, m
 		request.TimeSpan = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateRabbitMQVipInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateRabbitMQVipInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -180,7 +197,7 @@ func resourceTencentCloudTdmqRabbitmqVipInstanceCreate(d *schema.ResourceData, m
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tdmq rabbitmqVipInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId = *response.Response.InstanceId
@@ -193,7 +210,7 @@ func resourceTencentCloudTdmqRabbitmqVipInstanceCreate(d *schema.ResourceData, m
 	filter.Values = helper.Strings([]string{instanceId})
 	tmpSet = append(tmpSet, &filter)
 	paramMap["filters"] = tmpSet
-	err = resource.Retry(readRetryTimeout*10, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := service.DescribeTdmqRabbitmqVipInstanceByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -218,28 +235,28 @@ func resourceTencentCloudTdmqRabbitmqVipInstanceCreate(d *schema.ResourceData, m
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tdmq rabbitmqVipInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudTdmqRabbitmqVipInstanceRead(d, meta)
+	return resourceTencentCloudTdmqRabbitmqVipInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRabbitmqVipInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRabbitmqVipInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_rabbitmq_vip_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	rabbitmqVipInstance, err := service.DescribeTdmqRabbitmqVipInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if rabbitmqVipInstance == nil {
@@ -272,7 +289,7 @@ func resourceTencentCloudTdmqRabbitmqVipInstanceRead(d *schema.ResourceData, met
 	filter.Values = helper.Strings([]string{instanceId})
 	tmpSet = append(tmpSet, &filter)
 	paramMap["filters"] = tmpSet
-	err = resource.Retry(readRetryTimeout*10, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout*10, func() *resource.RetryError {
 		result, e := service.DescribeTdmqRabbitmqVipInstanceByFilter(ctx, paramMap)
 		if e != nil {
 			return retryError(e)
@@ -307,7 +324,7 @@ func resourceTencentCloudTdmqRabbitmqVipInstanceRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudTdmqRabbitmqVipInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRabbitmqVipInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_rabbitmq_vip_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -321,7 +338,7 @@ func resourceTencentCloudTdmqRabbitmqVipInstanceUpdate(d *schema.ResourceData, m
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -333,8 +350,8 @@ func resourceTencentCloudTdmqRabbitmqVipInstanceUpdate(d *schema.ResourceData, m
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyRabbitMQVipInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyRabbitMQVipInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -346,25 +363,25 @@ func resourceTencentCloudTdmqRabbitmqVipInstanceUpdate(d *schema.ResourceData, m
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update tdmq rabbitmqVipInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTdmqRabbitmqVipInstanceRead(d, meta)
+	return resourceTencentCloudTdmqRabbitmqVipInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRabbitmqVipInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRabbitmqVipInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_rabbitmq_vip_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if err := service.DeleteTdmqRabbitmqVipInstanceById(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
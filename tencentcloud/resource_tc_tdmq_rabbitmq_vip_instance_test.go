@@ -34,6 +34,12 @@ func TestAccTencentCloudTdmqRabbitmqVipInstanceResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_tdmq_rabbitmq_vip_instance.example", "id"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_tdmq_rabbitmq_vip_instance.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"enable_create_default_ha_mirror_queue", "time_span"},
+			},
 		},
 	})
 }
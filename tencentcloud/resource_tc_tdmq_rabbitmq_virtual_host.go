@@ -11,15 +11,23 @@ resource "tencentcloud_tdmq_rabbitmq_virtual_host" "rabbitmq_virtual_host" {
   trace_flag   = false
 }
 ```
+
+Import
+
+tdmq rabbitmq_virtual_host can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_tdmq_rabbitmq_virtual_host.rabbitmq_virtual_host amqp-pd59kxwq#keep-vhost
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -28,10 +36,17 @@ import (
 
 func resourceTencentCloudTdmqRabbitmqVirtualHost() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTdmqRabbitmqVirtualHostCreate,
-		Read:   resourceTencentCloudTdmqRabbitmqVirtualHostRead,
-		Update: resourceTencentCloudTdmqRabbitmqVirtualHostUpdate,
-		Delete: resourceTencentCloudTdmqRabbitmqVirtualHostDelete,
+		CreateContext: resourceTencentCloudTdmqRabbitmqVirtualHostCreate,
+		ReadContext:   resourceTencentCloudTdmqRabbitmqVirtualHostRead,
+		UpdateContext: resourceTencentCloudTdmqRabbitmqVirtualHostUpdate,
+		DeleteContext: resourceTencentCloudTdmqRabbitmqVirtualHostDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -58,7 +73,7 @@ func resourceTencentCloudTdmqRabbitmqVirtualHost() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTdmqRabbitmqVirtualHostCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRabbitmqVirtualHostCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_rabbitmq_virtual_host.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -87,8 +102,8 @@ func resourceTencentCloudTdmqRabbitmqVirtualHostCreate(d *schema.ResourceData, m
 		request.TraceFlag = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateRabbitMQVirtualHost(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateRabbitMQVirtualHostWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -101,35 +116,35 @@ func resourceTencentCloudTdmqRabbitmqVirtualHostCreate(d *schema.ResourceData, m
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tdmq rabbitmqVirtualHost failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	virtualHost = *response.Response.VirtualHost
-	d.SetId(strings.Join([]string{instanceId, virtualHost}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceId, virtualHost))
 
-	return resourceTencentCloudTdmqRabbitmqVirtualHostRead(d, meta)
+	return resourceTencentCloudTdmqRabbitmqVirtualHostRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRabbitmqVirtualHostRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRabbitmqVirtualHostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_rabbitmq_virtual_host.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	virtualHost := idSplit[1]
 
 	rabbitmqVirtualHost, err := service.DescribeTdmqRabbitmqVirtualHostById(ctx, instanceId, virtualHost)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if rabbitmqVirtualHost == nil {
@@ -153,7 +168,7 @@ func resourceTencentCloudTdmqRabbitmqVirtualHostRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudTdmqRabbitmqVirtualHostUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRabbitmqVirtualHostUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_rabbitmq_virtual_host.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -162,9 +177,9 @@ func resourceTencentCloudTdmqRabbitmqVirtualHostUpdate(d *schema.ResourceData, m
 		request = tdmq.NewModifyRabbitMQVirtualHostRequest()
 	)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := idSplit[0]
@@ -173,7 +188,7 @@ func resourceTencentCloudTdmqRabbitmqVirtualHostUpdate(d *schema.ResourceData, m
 	immutableArgs := []string{"instance_id", "virtual_host"}
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -189,8 +204,8 @@ func resourceTencentCloudTdmqRabbitmqVirtualHostUpdate(d *schema.ResourceData, m
 			request.TraceFlag = helper.Bool(v.(bool))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyRabbitMQVirtualHost(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyRabbitMQVirtualHostWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -202,33 +217,33 @@ func resourceTencentCloudTdmqRabbitmqVirtualHostUpdate(d *schema.ResourceData, m
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update tdmq rabbitmqVirtualHost failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTdmqRabbitmqVirtualHostRead(d, meta)
+	return resourceTencentCloudTdmqRabbitmqVirtualHostRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRabbitmqVirtualHostDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRabbitmqVirtualHostDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_rabbitmq_virtual_host.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceId := idSplit[0]
 	virtualHost := idSplit[1]
 
 	if err := service.DeleteTdmqRabbitmqVirtualHostById(ctx, instanceId, virtualHost); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -3,11 +3,11 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 // go test -i; go test -test.run TestAccTencentCloudNeedFixTdmqRabbitmqVirtualHostResource_basic -v
@@ -42,6 +42,12 @@ func TestAccTencentCloudNeedFixTdmqRabbitmqVirtualHostResource_basic(t *testing.
 					resource.TestCheckResourceAttrSet("tencentcloud_tdmq_rabbitmq_virtual_host.rabbitmq_virtual_host", "trace_flag"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_tdmq_rabbitmq_virtual_host.rabbitmq_virtual_host",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"trace_flag"},
+			},
 		},
 	})
 }
@@ -56,7 +62,7 @@ func testAccCheckTdmqRabbitmqVirtualHostDestroy(s *terraform.State) error {
 			continue
 		}
 
-		ids := strings.Split(rs.Primary.ID, FILED_SP)
+		ids := helper.IdParse(rs.Primary.ID)
 		if len(ids) != 2 {
 			return fmt.Errorf("invalid ID %s", rs.Primary.ID)
 		}
@@ -90,7 +96,7 @@ func testAccCheckTdmqRabbitmqVirtualHostExists(re string) resource.TestCheckFunc
 		}
 
 		service := TdmqService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
-		ids := strings.Split(rs.Primary.ID, FILED_SP)
+		ids := helper.IdParse(rs.Primary.ID)
 		if len(ids) != 2 {
 			return fmt.Errorf("invalid ID %s", rs.Primary.ID)
 		}
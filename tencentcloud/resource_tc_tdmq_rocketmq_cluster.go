@@ -20,9 +20,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmqRocketmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -31,12 +32,16 @@ import (
 
 func resourceTencentCloudTdmqRocketmqCluster() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTdmqRocketmqClusterRead,
-		Create: resourceTencentCloudTdmqRocketmqClusterCreate,
-		Update: resourceTencentCloudTdmqRocketmqClusterUpdate,
-		Delete: resourceTencentCloudTdmqRocketmqClusterDelete,
+		ReadContext:   resourceTencentCloudTdmqRocketmqClusterRead,
+		CreateContext: resourceTencentCloudTdmqRocketmqClusterCreate,
+		UpdateContext: resourceTencentCloudTdmqRocketmqClusterUpdate,
+		DeleteContext: resourceTencentCloudTdmqRocketmqClusterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_name": {
@@ -122,12 +127,12 @@ func resourceTencentCloudTdmqRocketmqCluster() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTdmqRocketmqClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_cluster.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request   = tdmqRocketmq.NewCreateRocketMQClusterRequest()
@@ -145,8 +150,8 @@ func resourceTencentCloudTdmqRocketmqClusterCreate(d *schema.ResourceData, meta
 		request.Remark = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateRocketMQCluster(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateRocketMQClusterWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -159,11 +164,11 @@ func resourceTencentCloudTdmqRocketmqClusterCreate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tdmqRocketmq cluster failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterId = *response.Response.ClusterId
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		service := TdmqRocketmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 		_, innerErr := service.DescribeTdmqRocketmqCluster(ctx, clusterId)
 		if innerErr != nil {
@@ -173,19 +178,19 @@ func resourceTencentCloudTdmqRocketmqClusterCreate(d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(clusterId)
-	return resourceTencentCloudTdmqRocketmqClusterRead(d, meta)
+	return resourceTencentCloudTdmqRocketmqClusterRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRocketmqClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_cluster.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TdmqRocketmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -194,12 +199,12 @@ func resourceTencentCloudTdmqRocketmqClusterRead(d *schema.ResourceData, meta in
 	cluster, err := service.DescribeTdmqRocketmqCluster(ctx, clusterId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if cluster == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `cluster` %s does not exist", clusterId)
+		return diag.Errorf("resource `cluster` %s does not exist", clusterId)
 	}
 
 	if cluster.ClusterName != nil {
@@ -261,7 +266,7 @@ func resourceTencentCloudTdmqRocketmqClusterRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTencentCloudTdmqRocketmqClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_cluster.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -287,8 +292,8 @@ func resourceTencentCloudTdmqRocketmqClusterUpdate(d *schema.ResourceData, meta
 
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyRocketMQCluster(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyRocketMQClusterWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -300,25 +305,25 @@ func resourceTencentCloudTdmqRocketmqClusterUpdate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tdmqRocketmq cluster failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTdmqRocketmqClusterRead(d, meta)
+	return resourceTencentCloudTdmqRocketmqClusterRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRocketmqClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_cluster.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TdmqRocketmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	clusterId := d.Id()
 
 	if err := service.DeleteTdmqRocketmqClusterById(ctx, clusterId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -39,10 +39,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmqRocketmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -51,12 +51,16 @@ import (
 
 func resourceTencentCloudTdmqRocketmqEnvironmentRole() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTdmqRocketmqEnvironmentRoleRead,
-		Create: resourceTencentCloudTdmqRocketmqEnvironmentRoleCreate,
-		Update: resourceTencentCloudTdmqRocketmqEnvironmentRoleUpdate,
-		Delete: resourceTencentCloudTdmqRocketmqEnvironmentRoleDelete,
+		ReadContext:   resourceTencentCloudTdmqRocketmqEnvironmentRoleRead,
+		CreateContext: resourceTencentCloudTdmqRocketmqEnvironmentRoleCreate,
+		UpdateContext: resourceTencentCloudTdmqRocketmqEnvironmentRoleUpdate,
+		DeleteContext: resourceTencentCloudTdmqRocketmqEnvironmentRoleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"environment_name": {
@@ -92,7 +96,7 @@ func resourceTencentCloudTdmqRocketmqEnvironmentRole() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTdmqRocketmqEnvironmentRoleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqEnvironmentRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_environment_role.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -128,8 +132,8 @@ func resourceTencentCloudTdmqRocketmqEnvironmentRoleCreate(d *schema.ResourceDat
 		request.ClusterId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateEnvironmentRole(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateEnvironmentRoleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -141,25 +145,25 @@ func resourceTencentCloudTdmqRocketmqEnvironmentRoleCreate(d *schema.ResourceDat
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tdmqRocketmq environmentRole failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(clusterId + FILED_SP + roleName + FILED_SP + environmentName)
-	return resourceTencentCloudTdmqRocketmqEnvironmentRoleRead(d, meta)
+	d.SetId(helper.IdFormat(clusterId, roleName, environmentName))
+	return resourceTencentCloudTdmqRocketmqEnvironmentRoleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRocketmqEnvironmentRoleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqEnvironmentRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_environment_role.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TdmqRocketmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	roleName := idSplit[1]
@@ -168,12 +172,12 @@ func resourceTencentCloudTdmqRocketmqEnvironmentRoleRead(d *schema.ResourceData,
 	environmentRoles, err := service.DescribeTdmqRocketmqEnvironmentRole(ctx, clusterId, roleName, environmentName)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(environmentRoles) == 0 {
 		d.SetId("")
-		return fmt.Errorf("resource `environmentRole` %s does not exist", roleName)
+		return diag.Errorf("resource `environmentRole` %s does not exist", roleName)
 	}
 	environmentRole := environmentRoles[0]
 	_ = d.Set("environment_name", environmentRole.EnvironmentId)
@@ -188,7 +192,7 @@ func resourceTencentCloudTdmqRocketmqEnvironmentRoleRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudTdmqRocketmqEnvironmentRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqEnvironmentRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_environment_role.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -196,9 +200,9 @@ func resourceTencentCloudTdmqRocketmqEnvironmentRoleUpdate(d *schema.ResourceDat
 
 	request := tdmqRocketmq.NewModifyEnvironmentRoleRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	clusterId := idSplit[0]
 	roleName := idSplit[1]
@@ -218,8 +222,8 @@ func resourceTencentCloudTdmqRocketmqEnvironmentRoleUpdate(d *schema.ResourceDat
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyEnvironmentRole(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyEnvironmentRoleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -231,31 +235,31 @@ func resourceTencentCloudTdmqRocketmqEnvironmentRoleUpdate(d *schema.ResourceDat
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tdmqRocketmq environmentRole failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTdmqRocketmqEnvironmentRoleRead(d, meta)
+	return resourceTencentCloudTdmqRocketmqEnvironmentRoleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRocketmqEnvironmentRoleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqEnvironmentRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_environment_role.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TdmqRocketmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	roleName := idSplit[1]
 	environmentId := idSplit[2]
 
 	if err := service.DeleteTdmqRocketmqEnvironmentRoleById(ctx, clusterId, roleName, environmentId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -35,10 +35,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmqRocketmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -47,12 +47,16 @@ import (
 
 func resourceTencentCloudTdmqRocketmqGroup() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTdmqRocketmqGroupRead,
-		Create: resourceTencentCloudTdmqRocketmqGroupCreate,
-		Update: resourceTencentCloudTdmqRocketmqGroupUpdate,
-		Delete: resourceTencentCloudTdmqRocketmqGroupDelete,
+		ReadContext:   resourceTencentCloudTdmqRocketmqGroupRead,
+		CreateContext: resourceTencentCloudTdmqRocketmqGroupCreate,
+		UpdateContext: resourceTencentCloudTdmqRocketmqGroupUpdate,
+		DeleteContext: resourceTencentCloudTdmqRocketmqGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"group_name": {
@@ -151,7 +155,7 @@ func resourceTencentCloudTdmqRocketmqGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTdmqRocketmqGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_group.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -191,8 +195,8 @@ func resourceTencentCloudTdmqRocketmqGroupCreate(d *schema.ResourceData, meta in
 		request.Remark = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateRocketMQGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateRocketMQGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -204,25 +208,25 @@ func resourceTencentCloudTdmqRocketmqGroupCreate(d *schema.ResourceData, meta in
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tdmqRocketmq group failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(clusterId + FILED_SP + namespaceName + FILED_SP + groupName)
-	return resourceTencentCloudTdmqRocketmqGroupRead(d, meta)
+	d.SetId(helper.IdFormat(clusterId, namespaceName, groupName))
+	return resourceTencentCloudTdmqRocketmqGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRocketmqGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TdmqRocketmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	namespaceName := idSplit[1]
@@ -231,12 +235,12 @@ func resourceTencentCloudTdmqRocketmqGroupRead(d *schema.ResourceData, meta inte
 	groupList, err := service.DescribeTdmqRocketmqGroup(ctx, clusterId, namespaceName, groupName)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(groupList) == 0 {
 		d.SetId("")
-		return fmt.Errorf("resource `group` %s does not exist", groupName)
+		return diag.Errorf("resource `group` %s does not exist", groupName)
 	}
 	group := groupList[0]
 	_ = d.Set("group_name", group.Name)
@@ -258,7 +262,7 @@ func resourceTencentCloudTdmqRocketmqGroupRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudTdmqRocketmqGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_group.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -266,9 +270,9 @@ func resourceTencentCloudTdmqRocketmqGroupUpdate(d *schema.ResourceData, meta in
 
 	request := tdmqRocketmq.NewModifyRocketMQGroupRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	clusterId := idSplit[0]
 	namespaceId := idSplit[1]
@@ -296,8 +300,8 @@ func resourceTencentCloudTdmqRocketmqGroupUpdate(d *schema.ResourceData, meta in
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyRocketMQGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyRocketMQGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -309,31 +313,31 @@ func resourceTencentCloudTdmqRocketmqGroupUpdate(d *schema.ResourceData, meta in
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tdmqRocketmq group failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTdmqRocketmqGroupRead(d, meta)
+	return resourceTencentCloudTdmqRocketmqGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRocketmqGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TdmqRocketmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	namespaceId := idSplit[1]
 	groupId := idSplit[2]
 
 	if err := service.DeleteTdmqRocketmqGroupById(ctx, clusterId, namespaceId, groupId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -26,10 +26,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmqRocketmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -38,12 +38,16 @@ import (
 
 func resourceTencentCloudTdmqRocketmqNamespace() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTdmqRocketmqNamespaceRead,
-		Create: resourceTencentCloudTdmqRocketmqNamespaceCreate,
-		Update: resourceTencentCloudTdmqRocketmqNamespaceUpdate,
-		Delete: resourceTencentCloudTdmqRocketmqNamespaceDelete,
+		ReadContext:   resourceTencentCloudTdmqRocketmqNamespaceRead,
+		CreateContext: resourceTencentCloudTdmqRocketmqNamespaceCreate,
+		UpdateContext: resourceTencentCloudTdmqRocketmqNamespaceUpdate,
+		DeleteContext: resourceTencentCloudTdmqRocketmqNamespaceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -93,7 +97,7 @@ func resourceTencentCloudTdmqRocketmqNamespace() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTdmqRocketmqNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_namespace.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -119,8 +123,8 @@ func resourceTencentCloudTdmqRocketmqNamespaceCreate(d *schema.ResourceData, met
 		request.Remark = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateRocketMQNamespace(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateRocketMQNamespaceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -132,24 +136,24 @@ func resourceTencentCloudTdmqRocketmqNamespaceCreate(d *schema.ResourceData, met
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tdmqRocketmq namespace failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
-	d.SetId(clusterId + FILED_SP + namespaceName)
-	return resourceTencentCloudTdmqRocketmqNamespaceRead(d, meta)
+	d.SetId(helper.IdFormat(clusterId, namespaceName))
+	return resourceTencentCloudTdmqRocketmqNamespaceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRocketmqNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_namespace.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TdmqRocketmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	namespaceName := idSplit[1]
@@ -157,12 +161,12 @@ func resourceTencentCloudTdmqRocketmqNamespaceRead(d *schema.ResourceData, meta
 	namespaceList, err := service.DescribeTdmqRocketmqNamespace(ctx, namespaceName, clusterId)
 
 	if err != nil || len(namespaceList) == 0 {
-		return err
+		return diag.FromErr(err)
 	}
 	namespace := namespaceList[0]
 	if namespace == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `namespace` %s does not exist", namespaceName)
+		return diag.Errorf("resource `namespace` %s does not exist", namespaceName)
 	}
 
 	_ = d.Set("cluster_id", clusterId)
@@ -174,7 +178,7 @@ func resourceTencentCloudTdmqRocketmqNamespaceRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudTdmqRocketmqNamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_namespace.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -182,9 +186,9 @@ func resourceTencentCloudTdmqRocketmqNamespaceUpdate(d *schema.ResourceData, met
 
 	request := tdmqRocketmq.NewModifyRocketMQNamespaceRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	clusterId := idSplit[0]
 	namespaceName := idSplit[1]
@@ -196,7 +200,7 @@ func resourceTencentCloudTdmqRocketmqNamespaceUpdate(d *schema.ResourceData, met
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -206,8 +210,8 @@ func resourceTencentCloudTdmqRocketmqNamespaceUpdate(d *schema.ResourceData, met
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyRocketMQNamespace(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyRocketMQNamespaceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -219,30 +223,30 @@ func resourceTencentCloudTdmqRocketmqNamespaceUpdate(d *schema.ResourceData, met
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tdmqRocketmq namespace failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTdmqRocketmqNamespaceRead(d, meta)
+	return resourceTencentCloudTdmqRocketmqNamespaceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRocketmqNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_namespace.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TdmqRocketmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	namespaceName := idSplit[1]
 
 	if err := service.DeleteTdmqRocketmqNamespaceById(ctx, namespaceName, clusterId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -26,10 +26,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmqRocketmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -38,12 +38,16 @@ import (
 
 func resourceTencentCloudTdmqRocketmqRole() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTdmqRocketmqRoleRead,
-		Create: resourceTencentCloudTdmqRocketmqRoleCreate,
-		Update: resourceTencentCloudTdmqRocketmqRoleUpdate,
-		Delete: resourceTencentCloudTdmqRocketmqRoleDelete,
+		ReadContext:   resourceTencentCloudTdmqRocketmqRoleRead,
+		CreateContext: resourceTencentCloudTdmqRocketmqRoleCreate,
+		UpdateContext: resourceTencentCloudTdmqRocketmqRoleUpdate,
+		DeleteContext: resourceTencentCloudTdmqRocketmqRoleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"role_name": {
@@ -85,7 +89,7 @@ func resourceTencentCloudTdmqRocketmqRole() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTdmqRocketmqRoleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_role.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -112,8 +116,8 @@ func resourceTencentCloudTdmqRocketmqRoleCreate(d *schema.ResourceData, meta int
 		request.ClusterId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateRole(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateRoleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -125,25 +129,25 @@ func resourceTencentCloudTdmqRocketmqRoleCreate(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tdmqRocketmq role failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(clusterId + FILED_SP + roleName)
-	return resourceTencentCloudTdmqRocketmqRoleRead(d, meta)
+	d.SetId(helper.IdFormat(clusterId, roleName))
+	return resourceTencentCloudTdmqRocketmqRoleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRocketmqRoleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_role.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TdmqRocketmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	roleName := idSplit[1]
@@ -151,12 +155,12 @@ func resourceTencentCloudTdmqRocketmqRoleRead(d *schema.ResourceData, meta inter
 	role, err := service.DescribeTdmqRocketmqRole(ctx, clusterId, roleName)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if role == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `role` %s does not exist", roleName)
+		return diag.Errorf("resource `role` %s does not exist", roleName)
 	}
 
 	_ = d.Set("role_name", role.RoleName)
@@ -169,7 +173,7 @@ func resourceTencentCloudTdmqRocketmqRoleRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudTdmqRocketmqRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_role.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -177,9 +181,9 @@ func resourceTencentCloudTdmqRocketmqRoleUpdate(d *schema.ResourceData, meta int
 
 	request := tdmqRocketmq.NewModifyRoleRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	clusterId := idSplit[0]
 	roleName := idSplit[1]
@@ -189,7 +193,7 @@ func resourceTencentCloudTdmqRocketmqRoleUpdate(d *schema.ResourceData, meta int
 
 	if d.HasChange("role_name") {
 
-		return fmt.Errorf("`role_name` do not support change now.")
+		return diag.Errorf("`role_name` do not support change now.")
 
 	}
 
@@ -202,12 +206,12 @@ func resourceTencentCloudTdmqRocketmqRoleUpdate(d *schema.ResourceData, meta int
 
 	if d.HasChange("cluster_id") {
 
-		return fmt.Errorf("`cluster_id` do not support change now.")
+		return diag.Errorf("`cluster_id` do not support change now.")
 
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyRole(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyRoleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -219,30 +223,30 @@ func resourceTencentCloudTdmqRocketmqRoleUpdate(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tdmqRocketmq role failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTdmqRocketmqRoleRead(d, meta)
+	return resourceTencentCloudTdmqRocketmqRoleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRocketmqRoleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_role.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TdmqRocketmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	roleName := idSplit[1]
 
 	if err := service.DeleteTdmqRocketmqRoleById(ctx, clusterId, roleName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -34,10 +34,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmqRocketmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -46,12 +46,16 @@ import (
 
 func resourceTencentCloudTdmqRocketmqTopic() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTdmqRocketmqTopicRead,
-		Create: resourceTencentCloudTdmqRocketmqTopicCreate,
-		Update: resourceTencentCloudTdmqRocketmqTopicUpdate,
-		Delete: resourceTencentCloudTdmqRocketmqTopicDelete,
+		ReadContext:   resourceTencentCloudTdmqRocketmqTopicRead,
+		CreateContext: resourceTencentCloudTdmqRocketmqTopicCreate,
+		UpdateContext: resourceTencentCloudTdmqRocketmqTopicUpdate,
+		DeleteContext: resourceTencentCloudTdmqRocketmqTopicDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"topic_name": {
@@ -106,7 +110,7 @@ func resourceTencentCloudTdmqRocketmqTopic() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTdmqRocketmqTopicCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_topic.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -149,8 +153,8 @@ func resourceTencentCloudTdmqRocketmqTopicCreate(d *schema.ResourceData, meta in
 		request.PartitionNum = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateRocketMQTopic(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateRocketMQTopicWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -162,25 +166,25 @@ func resourceTencentCloudTdmqRocketmqTopicCreate(d *schema.ResourceData, meta in
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tdmqRocketmq topic failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(clusterId + FILED_SP + namespaceName + FILED_SP + topicType + FILED_SP + topicName)
-	return resourceTencentCloudTdmqRocketmqTopicRead(d, meta)
+	d.SetId(helper.IdFormat(clusterId, namespaceName, topicType, topicName))
+	return resourceTencentCloudTdmqRocketmqTopicRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRocketmqTopicRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_topic.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TdmqRocketmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	namespaceName := idSplit[1]
@@ -189,12 +193,12 @@ func resourceTencentCloudTdmqRocketmqTopicRead(d *schema.ResourceData, meta inte
 
 	topicList, err := service.DescribeTdmqRocketmqTopic(ctx, clusterId, namespaceName, topicName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(topicList) == 0 {
 		d.SetId("")
-		return fmt.Errorf("resource `topic` %s does not exist", topicName)
+		return diag.Errorf("resource `topic` %s does not exist", topicName)
 	}
 
 	topic := topicList[0]
@@ -211,7 +215,7 @@ func resourceTencentCloudTdmqRocketmqTopicRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudTdmqRocketmqTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_topic.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -219,9 +223,9 @@ func resourceTencentCloudTdmqRocketmqTopicUpdate(d *schema.ResourceData, meta in
 
 	request := tdmqRocketmq.NewModifyRocketMQTopicRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	clusterId := idSplit[0]
 	namespaceName := idSplit[1]
@@ -233,25 +237,25 @@ func resourceTencentCloudTdmqRocketmqTopicUpdate(d *schema.ResourceData, meta in
 
 	if d.HasChange("topic") {
 
-		return fmt.Errorf("`topic` do not support change now.")
+		return diag.Errorf("`topic` do not support change now.")
 
 	}
 
 	if d.HasChange("namespace_name") {
 
-		return fmt.Errorf("`namespace_name` do not support change now.")
+		return diag.Errorf("`namespace_name` do not support change now.")
 
 	}
 
 	if d.HasChange("type") {
 
-		return fmt.Errorf("`type` do not support change now.")
+		return diag.Errorf("`type` do not support change now.")
 
 	}
 
 	if d.HasChange("cluster_id") {
 
-		return fmt.Errorf("`cluster_id` do not support change now.")
+		return diag.Errorf("`cluster_id` do not support change now.")
 
 	}
 
@@ -269,8 +273,8 @@ func resourceTencentCloudTdmqRocketmqTopicUpdate(d *schema.ResourceData, meta in
 
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyRocketMQTopic(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyRocketMQTopicWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -282,31 +286,31 @@ func resourceTencentCloudTdmqRocketmqTopicUpdate(d *schema.ResourceData, meta in
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tdmqRocketmq topic failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTdmqRocketmqTopicRead(d, meta)
+	return resourceTencentCloudTdmqRocketmqTopicRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRocketmqTopicDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmqRocketmq_topic.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TdmqRocketmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	namespaceName := idSplit[1]
 	topicName := idSplit[3]
 
 	if err := service.DeleteTdmqRocketmqTopicById(ctx, clusterId, namespaceName, topicName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -3,12 +3,12 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 // go test -i; go test -test.run TestAccTencentCloudTdmqRocketmqTopicResource_basic -v
@@ -46,7 +46,7 @@ func testAccCheckTdmqRocketmqTopicDestroy(s *terraform.State) error {
 		if rs.Type != "tencentcloud_tdmq_rocketmq_topic" {
 			continue
 		}
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		if len(idSplit) != 4 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
@@ -86,7 +86,7 @@ func testAccCheckTdmqRocketmqTopicExists(re string) resource.TestCheckFunc {
 			return fmt.Errorf("Rocketmq topic id is not set")
 		}
 
-		idSplit := strings.Split(rs.Primary.ID, FILED_SP)
+		idSplit := helper.IdParse(rs.Primary.ID)
 		if len(idSplit) != 4 {
 			return fmt.Errorf("id is broken,%s", rs.Primary.ID)
 		}
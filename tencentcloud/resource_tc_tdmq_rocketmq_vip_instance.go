@@ -40,6 +40,14 @@ resource "tencentcloud_tdmq_rocketmq_vip_instance" "example" {
   time_span = 1
 }
 ```
+
+Import
+
+tdmq rocketmq_vip_instance can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_tdmq_rocketmq_vip_instance.example rmq-q4mq5obj
+```
 */
 package tencentcloud
 
@@ -49,6 +57,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -57,10 +66,17 @@ import (
 
 func resourceTencentCloudTdmqRocketmqVipInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTdmqRocketmqVipInstanceCreate,
-		Read:   resourceTencentCloudTdmqRocketmqVipInstanceRead,
-		Update: resourceTencentCloudTdmqRocketmqVipInstanceUpdate,
-		Delete: resourceTencentCloudTdmqRocketmqVipInstanceDelete,
+		CreateContext: resourceTencentCloudTdmqRocketmqVipInstanceCreate,
+		ReadContext:   resourceTencentCloudTdmqRocketmqVipInstanceRead,
+		UpdateContext: resourceTencentCloudTdmqRocketmqVipInstanceUpdate,
+		DeleteContext: resourceTencentCloudTdmqRocketmqVipInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -120,18 +136,18 @@ func resourceTencentCloudTdmqRocketmqVipInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTdmqRocketmqVipInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqVipInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_rocketmq_vip_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request   = tdmq.NewCreateRocketMQVipInstanceRequest()
 		response  = tdmq.NewCreateRocketMQVipInstanceResponse()
 		clusterId string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("name"); ok {
 		request.Name = helper.String(v.(string))
@@ -172,8 +188,8 @@ func resourceTencentCloudTdmqRocketmqVipInstanceCreate(d *schema.ResourceData, m
 		request.TimeSpan = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateRocketMQVipInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateRocketMQVipInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -186,13 +202,13 @@ func resourceTencentCloudTdmqRocketmqVipInstanceCreate(d *schema.ResourceData, m
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tdmq rocketmqVipInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterId = *response.Response.ClusterId
 
 	// wait
-	err = resource.Retry(readRetryTimeout*10, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := service.DescribeTdmqRocketmqVipInstancesByFilter(ctx, clusterId)
 		if e != nil {
 			return retryError(e)
@@ -209,27 +225,27 @@ func resourceTencentCloudTdmqRocketmqVipInstanceCreate(d *schema.ResourceData, m
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tdmq rocketmqVipInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(clusterId)
-	return resourceTencentCloudTdmqRocketmqVipInstanceRead(d, meta)
+	return resourceTencentCloudTdmqRocketmqVipInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRocketmqVipInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqVipInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_rocketmq_vip_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 		clusterId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	rocketmqVipInstanceDetail, err := service.DescribeTdmqRocketmqVipInstanceById(ctx, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if rocketmqVipInstanceDetail == nil {
@@ -240,7 +256,7 @@ func resourceTencentCloudTdmqRocketmqVipInstanceRead(d *schema.ResourceData, met
 
 	rocketmqVipInstances, err := service.DescribeTdmqRocketmqVipInstancesByFilter(ctx, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if rocketmqVipInstances == nil {
@@ -290,17 +306,17 @@ func resourceTencentCloudTdmqRocketmqVipInstanceRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudTdmqRocketmqVipInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqVipInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_rocketmq_vip_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request   = tdmq.NewModifyRocketMQInstanceSpecRequest()
 		clusterId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request.InstanceId = &clusterId
 
@@ -308,7 +324,7 @@ func resourceTencentCloudTdmqRocketmqVipInstanceUpdate(d *schema.ResourceData, m
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -330,8 +346,8 @@ func resourceTencentCloudTdmqRocketmqVipInstanceUpdate(d *schema.ResourceData, m
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyRocketMQInstanceSpec(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyRocketMQInstanceSpecWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -342,14 +358,14 @@ func resourceTencentCloudTdmqRocketmqVipInstanceUpdate(d *schema.ResourceData, m
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update tdmq rocketmqVipInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// sleep - fix in the future
 	time.Sleep(20 * time.Second)
 
 	// wait
-	err = resource.Retry(readRetryTimeout*10, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		result, e := service.DescribeTdmqRocketmqVipInstancesByFilter(ctx, clusterId)
 		if e != nil {
 			return retryError(e)
@@ -366,7 +382,7 @@ func resourceTencentCloudTdmqRocketmqVipInstanceUpdate(d *schema.ResourceData, m
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update tdmq rocketmqVipInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// update name
@@ -378,8 +394,8 @@ func resourceTencentCloudTdmqRocketmqVipInstanceUpdate(d *schema.ResourceData, m
 		}
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyRocketMQCluster(clusterRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().ModifyRocketMQClusterWithContext(ctx, clusterRequest)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -392,25 +408,25 @@ func resourceTencentCloudTdmqRocketmqVipInstanceUpdate(d *schema.ResourceData, m
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update cluster name failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTdmqRocketmqVipInstanceRead(d, meta)
+	return resourceTencentCloudTdmqRocketmqVipInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRocketmqVipInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRocketmqVipInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_rocketmq_vip_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 		clusterId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if err := service.DeleteTdmqRocketmqVipInstanceById(ctx, clusterId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -34,6 +34,12 @@ func TestAccTencentCloudTdmqRocketmqVipInstanceResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_tdmq_rocketmq_vip_instance.example", "id"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_tdmq_rocketmq_vip_instance.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"time_span"},
+			},
 		},
 	})
 }
@@ -31,8 +31,9 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -40,12 +41,15 @@ import (
 
 func resourceTencentCloudTdmqRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTdmqRoleCreate,
-		Read:   resourceTencentCloudTdmqRoleRead,
-		Update: resourceTencentCloudTdmqRoleUpdate,
-		Delete: resourceTencentCloudTdmqRoleDelete,
+		CreateContext: resourceTencentCloudTdmqRoleCreate,
+		ReadContext:   resourceTencentCloudTdmqRoleRead,
+		UpdateContext: resourceTencentCloudTdmqRoleUpdate,
+		DeleteContext: resourceTencentCloudTdmqRoleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,11 +72,11 @@ func resourceTencentCloudTdmqRole() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTdmqRoleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_role.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tdmqService := TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -84,14 +88,14 @@ func resourceTencentCloudTdmqRoleCreate(d *schema.ResourceData, meta interface{}
 	if temp, ok := d.GetOk("role_name"); ok {
 		roleName = temp.(string)
 		if len(roleName) < 1 {
-			return fmt.Errorf("role_name should be not empty string")
+			return diag.Errorf("role_name should be not empty string")
 		}
 	}
 
 	if temp, ok := d.GetOk("cluster_id"); ok {
 		clusterId = temp.(string)
 		if len(clusterId) < 1 {
-			return fmt.Errorf("cluster_id should be not empty string")
+			return diag.Errorf("cluster_id should be not empty string")
 		}
 	}
 
@@ -101,26 +105,26 @@ func resourceTencentCloudTdmqRoleCreate(d *schema.ResourceData, meta interface{}
 
 	clusterId, err := tdmqService.CreateTdmqRole(ctx, roleName, clusterId, remark)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(clusterId)
 
-	return resourceTencentCloudTdmqRoleRead(d, meta)
+	return resourceTencentCloudTdmqRoleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRoleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_role.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	roleName := d.Id()
 	clusterId := d.Get("cluster_id").(string)
 
 	tdmqService := TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		info, has, e := tdmqService.DescribeTdmqRoleById(ctx, roleName, clusterId)
 		if e != nil {
 			return retryError(e)
@@ -135,16 +139,16 @@ func resourceTencentCloudTdmqRoleRead(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudTdmqRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_role.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	roleName := d.Id()
 	clusterId := d.Get("cluster_id").(string)
@@ -164,25 +168,25 @@ func resourceTencentCloudTdmqRoleUpdate(d *schema.ResourceData, meta interface{}
 	d.Partial(true)
 
 	if err := service.ModifyTdmqRoleAttribute(ctx, roleName, clusterId, remark); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Partial(false)
-	return resourceTencentCloudTdmqRoleRead(d, meta)
+	return resourceTencentCloudTdmqRoleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqRoleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_role.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	roleName := d.Id()
 	clusterId := d.Get("cluster_id").(string)
 
 	service := TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if err := service.DeleteTdmqRole(ctx, roleName, clusterId); err != nil {
 			if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok {
 				if sdkErr.Code == VPCNotFound {
@@ -194,5 +198,5 @@ func resourceTencentCloudTdmqRoleDelete(d *schema.ResourceData, meta interface{}
 		return nil
 	})
 
-	return err
+	return diag.FromErr(err)
 }
@@ -17,9 +17,12 @@ resource "tencentcloud_tdmq_send_rocketmq_message" "send_rocketmq_message" {
 package tencentcloud
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -28,9 +31,12 @@ import (
 
 func resourceTencentCloudTdmqSendRocketmqMessage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTdmqSendRocketmqMessageCreate,
-		Read:   resourceTencentCloudTdmqSendRocketmqMessageRead,
-		Delete: resourceTencentCloudTdmqSendRocketmqMessageDelete,
+		CreateContext: resourceTencentCloudTdmqSendRocketmqMessageCreate,
+		ReadContext:   resourceTencentCloudTdmqSendRocketmqMessageRead,
+		DeleteContext: resourceTencentCloudTdmqSendRocketmqMessageDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -73,7 +79,7 @@ func resourceTencentCloudTdmqSendRocketmqMessage() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTdmqSendRocketmqMessageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqSendRocketmqMessageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_send_rocketmq_message.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -108,8 +114,8 @@ func resourceTencentCloudTdmqSendRocketmqMessageCreate(d *schema.ResourceData, m
 		request.MsgTag = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().SendRocketMQMessage(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().SendRocketMQMessageWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -131,22 +137,22 @@ func resourceTencentCloudTdmqSendRocketmqMessageCreate(d *schema.ResourceData, m
 
 	if err != nil {
 		log.Printf("[CRITAL]%s operate tdmq sendRocketmqMessage failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(clusterId)
 
-	return resourceTencentCloudTdmqSendRocketmqMessageRead(d, meta)
+	return resourceTencentCloudTdmqSendRocketmqMessageRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqSendRocketmqMessageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqSendRocketmqMessageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_send_rocketmq_message.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudTdmqSendRocketmqMessageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqSendRocketmqMessageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_send_rocketmq_message.delete")()
 	defer inconsistentCheck(d, meta)()
 
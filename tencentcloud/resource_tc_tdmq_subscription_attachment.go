@@ -54,10 +54,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tdmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
@@ -66,12 +66,15 @@ import (
 
 func resourceTencentCloudTdmqSubscriptionAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTdmqSubscriptionAttachmentCreate,
-		Read:   resourceTencentCloudTdmqSubscriptionAttachmentRead,
-		Update: resourceTencentCloudTdmqSubscriptionAttachmentUpdate,
-		Delete: resourceTencentCloudTdmqSubscriptionAttachmentDelete,
+		CreateContext: resourceTencentCloudTdmqSubscriptionAttachmentCreate,
+		ReadContext:   resourceTencentCloudTdmqSubscriptionAttachmentRead,
+		UpdateContext: resourceTencentCloudTdmqSubscriptionAttachmentUpdate,
+		DeleteContext: resourceTencentCloudTdmqSubscriptionAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"environment_id": {
@@ -113,7 +116,7 @@ func resourceTencentCloudTdmqSubscriptionAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTdmqSubscriptionAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqSubscriptionAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_subscription_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -164,8 +167,8 @@ func resourceTencentCloudTdmqSubscriptionAttachmentCreate(d *schema.ResourceData
 	request.IsIdempotent = &isIdempotent
 	request.AutoCreatePolicyTopic = &autoCreatePolicyTopic
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateSubscription(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdmqClient().CreateSubscriptionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -177,27 +180,27 @@ func resourceTencentCloudTdmqSubscriptionAttachmentCreate(d *schema.ResourceData
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tdmq subscriptionAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{environmentId, Topic, subscriptionName, clusterId}, FILED_SP))
+	d.SetId(helper.IdFormat(environmentId, Topic, subscriptionName, clusterId))
 
-	return resourceTencentCloudTdmqSubscriptionAttachmentRead(d, meta)
+	return resourceTencentCloudTdmqSubscriptionAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqSubscriptionAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqSubscriptionAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_subscription_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	environmentId := idSplit[0]
 	Topic := idSplit[1]
@@ -206,7 +209,7 @@ func resourceTencentCloudTdmqSubscriptionAttachmentRead(d *schema.ResourceData,
 
 	subscriptionAttachment, err := service.DescribeTdmqSubscriptionAttachmentById(ctx, environmentId, Topic, subscriptionName, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if subscriptionAttachment == nil {
@@ -236,7 +239,7 @@ func resourceTencentCloudTdmqSubscriptionAttachmentRead(d *schema.ResourceData,
 	// Get Topics Status For auto_create_policy_topic
 	has, err := service.GetTdmqTopicsAttachmentById(ctx, environmentId, Topic, subscriptionName, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("auto_create_policy_topic", has)
@@ -244,27 +247,27 @@ func resourceTencentCloudTdmqSubscriptionAttachmentRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudTdmqSubscriptionAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqSubscriptionAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_subscription_attachment.update")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudTdmqSubscriptionAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqSubscriptionAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_subscription_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId                 = getLogId(contextNil)
-		ctx                   = context.WithValue(context.TODO(), logIdKey, logId)
 		service               = TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 		autoCreatePolicyTopic bool
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 4 {
-		return fmt.Errorf("id is broken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 4)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	environmentId := idSplit[0]
@@ -274,7 +277,7 @@ func resourceTencentCloudTdmqSubscriptionAttachmentDelete(d *schema.ResourceData
 
 	// Delete Subscription
 	if err := service.DeleteTdmqSubscriptionAttachmentById(ctx, environmentId, Topic, subscriptionName, clusterId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok := d.GetOk("auto_create_policy_topic"); ok {
@@ -282,7 +285,7 @@ func resourceTencentCloudTdmqSubscriptionAttachmentDelete(d *schema.ResourceData
 		if autoCreatePolicyTopic {
 			// Delete Topics
 			if err := service.DeleteTdmqTopicsAttachmentById(ctx, environmentId, Topic, subscriptionName, clusterId); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
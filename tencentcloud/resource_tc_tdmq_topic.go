@@ -45,8 +45,9 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -54,12 +55,15 @@ import (
 
 func resourceTencentCloudTdmqTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTdmqTopicCreate,
-		Read:   resourceTencentCloudTdmqTopicRead,
-		Update: resourceTencentCloudTdmqTopicUpdate,
-		Delete: resourceTencentCloudTdmqTopicDelete,
+		CreateContext: resourceTencentCloudTdmqTopicCreate,
+		ReadContext:   resourceTencentCloudTdmqTopicRead,
+		UpdateContext: resourceTencentCloudTdmqTopicUpdate,
+		DeleteContext: resourceTencentCloudTdmqTopicDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -115,11 +119,11 @@ func resourceTencentCloudTdmqTopic() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTdmqTopicCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_topic.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	tdmqService := TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -135,13 +139,13 @@ func resourceTencentCloudTdmqTopicCreate(d *schema.ResourceData, meta interface{
 	if temp, ok := d.GetOk("environ_id"); ok {
 		environId = temp.(string)
 		if len(environId) < 1 {
-			return fmt.Errorf("environ_id should be not empty string")
+			return diag.Errorf("environ_id should be not empty string")
 		}
 	}
 	if temp, ok := d.GetOk("topic_name"); ok {
 		topicName = temp.(string)
 		if len(topicName) < 1 {
-			return fmt.Errorf("topic_name should be not empty string")
+			return diag.Errorf("topic_name should be not empty string")
 		}
 	}
 	partitions = uint64(d.Get("partitions").(int))
@@ -165,19 +169,19 @@ func resourceTencentCloudTdmqTopicCreate(d *schema.ResourceData, meta interface{
 
 	err := tdmqService.CreateTdmqTopic(ctx, environId, topicName, partitions, topicType, remark, clusterId, pulsarTopicType)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(topicName)
 
-	return resourceTencentCloudTdmqTopicRead(d, meta)
+	return resourceTencentCloudTdmqTopicRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqTopicRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	topicName := d.Id()
 	environId := d.Get("environ_id").(string)
@@ -185,7 +189,7 @@ func resourceTencentCloudTdmqTopicRead(d *schema.ResourceData, meta interface{})
 
 	tdmqService := TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		info, has, e := tdmqService.DescribeTdmqTopicById(ctx, environId, topicName, clusterId)
 		if e != nil {
 			return retryError(e)
@@ -203,19 +207,19 @@ func resourceTencentCloudTdmqTopicRead(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudTdmqTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_topic.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if d.HasChange("topic_type") {
-		return fmt.Errorf("`topic_type` do not support change now.")
+		return diag.Errorf("`topic_type` do not support change now.")
 	}
 
 	topicName := d.Id()
@@ -246,17 +250,17 @@ func resourceTencentCloudTdmqTopicUpdate(d *schema.ResourceData, meta interface{
 
 	if err := service.ModifyTdmqTopicAttribute(ctx, environId, topicName,
 		partitions, remark, clusterId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Partial(false)
-	return resourceTencentCloudTdmqTopicRead(d, meta)
+	return resourceTencentCloudTdmqTopicRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTdmqTopicDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTdmqTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tdmq_instance.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TdmqService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -264,7 +268,7 @@ func resourceTencentCloudTdmqTopicDelete(d *schema.ResourceData, meta interface{
 	environId := d.Get("environ_id").(string)
 	clusterId := d.Get("cluster_id").(string)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if err := service.DeleteTdmqTopic(ctx, environId, topicName, clusterId); err != nil {
 			if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok {
 				if sdkErr.Code == VPCNotFound {
@@ -276,5 +280,5 @@ func resourceTencentCloudTdmqTopicDelete(d *schema.ResourceData, meta interface{
 		return nil
 	})
 
-	return err
+	return diag.FromErr(err)
 }
@@ -28,10 +28,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tem "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tem/v20210701"
@@ -40,12 +40,16 @@ import (
 
 func resourceTencentCloudTemAppConfig() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTemAppConfigRead,
-		Create: resourceTencentCloudTemAppConfigCreate,
-		Update: resourceTencentCloudTemAppConfigUpdate,
-		Delete: resourceTencentCloudTemAppConfigDelete,
+		ReadContext:   resourceTencentCloudTemAppConfigRead,
+		CreateContext: resourceTencentCloudTemAppConfigCreate,
+		UpdateContext: resourceTencentCloudTemAppConfigUpdate,
+		DeleteContext: resourceTencentCloudTemAppConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"environment_id": {
@@ -85,7 +89,7 @@ func resourceTencentCloudTemAppConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTemAppConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemAppConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_app_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -122,8 +126,8 @@ func resourceTencentCloudTemAppConfigCreate(d *schema.ResourceData, meta interfa
 
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().CreateConfigData(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().CreateConfigDataWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -135,25 +139,25 @@ func resourceTencentCloudTemAppConfigCreate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tem appConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(environmentId + FILED_SP + name)
-	return resourceTencentCloudTemAppConfigRead(d, meta)
+	d.SetId(helper.IdFormat(environmentId, name))
+	return resourceTencentCloudTemAppConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTemAppConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemAppConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_appConfig.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TemService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	environmentId := idSplit[0]
 	name := idSplit[1]
@@ -161,12 +165,12 @@ func resourceTencentCloudTemAppConfigRead(d *schema.ResourceData, meta interface
 	appConfig, err := service.DescribeTemAppConfig(ctx, environmentId, name)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if appConfig == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `appConfig` %s does not exist", name)
+		return diag.Errorf("resource `appConfig` %s does not exist", name)
 	}
 
 	_ = d.Set("environment_id", environmentId)
@@ -194,7 +198,7 @@ func resourceTencentCloudTemAppConfigRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudTemAppConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemAppConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_app_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -202,9 +206,9 @@ func resourceTencentCloudTemAppConfigUpdate(d *schema.ResourceData, meta interfa
 
 	request := tem.NewModifyConfigDataRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	environmentId := idSplit[0]
 	name := idSplit[1]
@@ -213,11 +217,11 @@ func resourceTencentCloudTemAppConfigUpdate(d *schema.ResourceData, meta interfa
 	request.Name = &name
 
 	if d.HasChange("environment_id") {
-		return fmt.Errorf("`environment_id` do not support change now.")
+		return diag.Errorf("`environment_id` do not support change now.")
 	}
 
 	if d.HasChange("name") {
-		return fmt.Errorf("`name` do not support change now.")
+		return diag.Errorf("`name` do not support change now.")
 	}
 
 	if d.HasChange("config_data") {
@@ -236,8 +240,8 @@ func resourceTencentCloudTemAppConfigUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().ModifyConfigData(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().ModifyConfigDataWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -248,29 +252,29 @@ func resourceTencentCloudTemAppConfigUpdate(d *schema.ResourceData, meta interfa
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTemAppConfigRead(d, meta)
+	return resourceTencentCloudTemAppConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTemAppConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemAppConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_app_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TemService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	environmentId := idSplit[0]
 	name := idSplit[1]
 
 	if err := service.DeleteTemAppConfigById(ctx, environmentId, name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -24,7 +24,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tem "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tem/v20210701"
@@ -33,10 +35,14 @@ import (
 
 func resourceTencentCloudTemApplication() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTemApplicationRead,
-		Create: resourceTencentCloudTemApplicationCreate,
-		Update: resourceTencentCloudTemApplicationUpdate,
-		Delete: resourceTencentCloudTemApplicationDelete,
+		ReadContext:   resourceTencentCloudTemApplicationRead,
+		CreateContext: resourceTencentCloudTemApplicationCreate,
+		UpdateContext: resourceTencentCloudTemApplicationUpdate,
+		DeleteContext: resourceTencentCloudTemApplicationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		// Importer: &schema.ResourceImporter{
 		// 	State: schema.ImportStatePassthrough,
 		// },
@@ -99,12 +105,12 @@ func resourceTencentCloudTemApplication() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTemApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_application.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request  = tem.NewCreateApplicationRequest()
@@ -153,8 +159,8 @@ func resourceTencentCloudTemApplicationCreate(d *schema.ResourceData, meta inter
 
 	request.DeployMode = helper.String("IMAGE")
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().CreateApplication(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().CreateApplicationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -167,7 +173,7 @@ func resourceTencentCloudTemApplicationCreate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tem application failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	applicationId := *response.Response.Result
@@ -179,19 +185,19 @@ func resourceTencentCloudTemApplicationCreate(d *schema.ResourceData, meta inter
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::tem:%s:uin/:application/%s", region, applicationId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTemApplicationRead(d, meta)
+	return resourceTencentCloudTemApplicationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTemApplicationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_application.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TemService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -206,12 +212,12 @@ func resourceTencentCloudTemApplicationRead(d *schema.ResourceData, meta interfa
 	application := applications.Result.Records[0]
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if application == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `application` %s does not exist", applicationId)
+		return diag.Errorf("resource `application` %s does not exist", applicationId)
 	}
 
 	if application.ApplicationName != nil {
@@ -243,26 +249,26 @@ func resourceTencentCloudTemApplicationRead(d *schema.ResourceData, meta interfa
 	region := client.Region
 	tags, err := tagService.DescribeResourceTags(ctx, "tem", "application", region, applicationId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudTemApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_application.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := tem.NewModifyApplicationInfoRequest()
 
 	request.ApplicationId = helper.String(d.Id())
 
 	if d.HasChange("application_name") {
-		return fmt.Errorf("`application_name` do not support change now.")
+		return diag.Errorf("`application_name` do not support change now.")
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -270,31 +276,31 @@ func resourceTencentCloudTemApplicationUpdate(d *schema.ResourceData, meta inter
 	}
 
 	if d.HasChange("coding_language") {
-		return fmt.Errorf("`coding_language` do not support change now.")
+		return diag.Errorf("`coding_language` do not support change now.")
 	}
 
 	if d.HasChange("use_default_image_service") {
-		return fmt.Errorf("`use_default_image_service` do not support change now.")
+		return diag.Errorf("`use_default_image_service` do not support change now.")
 	}
 
 	if d.HasChange("repo_type") {
-		return fmt.Errorf("`repo_type` do not support change now.")
+		return diag.Errorf("`repo_type` do not support change now.")
 	}
 
 	if d.HasChange("repo_server") {
-		return fmt.Errorf("`repo_server` do not support change now.")
+		return diag.Errorf("`repo_server` do not support change now.")
 	}
 
 	if d.HasChange("repo_name") {
-		return fmt.Errorf("`repo_name` do not support change now.")
+		return diag.Errorf("`repo_name` do not support change now.")
 	}
 
 	if d.HasChange("instance_id") {
-		return fmt.Errorf("`instance_id` do not support change now.")
+		return diag.Errorf("`instance_id` do not support change now.")
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().ModifyApplicationInfo(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().ModifyApplicationInfoWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -305,7 +311,7 @@ func resourceTencentCloudTemApplicationUpdate(d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
@@ -315,25 +321,25 @@ func resourceTencentCloudTemApplicationUpdate(d *schema.ResourceData, meta inter
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("tem", "application", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTemApplicationRead(d, meta)
+	return resourceTencentCloudTemApplicationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTemApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_application.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TemService{client: meta.(*TencentCloudClient).apiV3Conn}
 	applicationId := d.Id()
 
 	if err := service.DeleteTemApplicationById(ctx, applicationId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -33,8 +33,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tem "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tem/v20210701"
@@ -43,12 +44,16 @@ import (
 
 func resourceTencentCloudTemApplicationService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTemApplicationServiceCreate,
-		Read:   resourceTencentCloudTemApplicationServiceRead,
-		Update: resourceTencentCloudTemApplicationServiceUpdate,
-		Delete: resourceTencentCloudTemApplicationServiceDelete,
+		CreateContext: resourceTencentCloudTemApplicationServiceCreate,
+		ReadContext:   resourceTencentCloudTemApplicationServiceRead,
+		UpdateContext: resourceTencentCloudTemApplicationServiceUpdate,
+		DeleteContext: resourceTencentCloudTemApplicationServiceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"environment_id": {
@@ -127,7 +132,7 @@ func resourceTencentCloudTemApplicationService() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTemApplicationServiceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemApplicationServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_application_service.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -157,12 +162,12 @@ func resourceTencentCloudTemApplicationServiceCreate(d *schema.ResourceData, met
 				if vv, ok := dMap["vpc_id"]; ok && vv != "" {
 					servicePortMapping.VpcId = helper.String(vv.(string))
 				} else {
-					return fmt.Errorf("vpc_id is required when type is `VPC`")
+					return diag.Errorf("vpc_id is required when type is `VPC`")
 				}
 				if vv, ok := dMap["subnet_id"]; ok && vv != "" {
 					servicePortMapping.SubnetId = helper.String(vv.(string))
 				} else {
-					return fmt.Errorf("subnet_id is required when type is `VPC`")
+					return diag.Errorf("subnet_id is required when type is `VPC`")
 				}
 			}
 		}
@@ -189,8 +194,8 @@ func resourceTencentCloudTemApplicationServiceCreate(d *schema.ResourceData, met
 		request.Service = &servicePortMapping
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().CreateApplicationService(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().CreateApplicationServiceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -200,12 +205,12 @@ func resourceTencentCloudTemApplicationServiceCreate(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tem applicationService failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TemService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		service, errRet := service.DescribeTemApplicationServiceById(ctx, environmentId, applicationId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -216,27 +221,27 @@ func resourceTencentCloudTemApplicationServiceCreate(d *schema.ResourceData, met
 		return resource.RetryableError(fmt.Errorf("service is not ready %v, retry...", *service.Result.AllIpDone))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(environmentId + FILED_SP + applicationId + FILED_SP + serviceName)
+	d.SetId(helper.IdFormat(environmentId, applicationId, serviceName))
 
-	return resourceTencentCloudTemApplicationServiceRead(d, meta)
+	return resourceTencentCloudTemApplicationServiceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTemApplicationServiceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemApplicationServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_application_service.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TemService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	environmentId := idSplit[0]
 	applicationId := idSplit[1]
@@ -244,12 +249,12 @@ func resourceTencentCloudTemApplicationServiceRead(d *schema.ResourceData, meta
 
 	res, err := service.DescribeTemApplicationServiceById(ctx, environmentId, applicationId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if res == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", d.Id())
+		return diag.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
 	_ = d.Set("environment_id", environmentId)
@@ -315,14 +320,14 @@ func resourceTencentCloudTemApplicationServiceRead(d *schema.ResourceData, meta
 
 		err = d.Set("service", []interface{}{serviceMap})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceTencentCloudTemApplicationServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemApplicationServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_application_service.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -330,9 +335,9 @@ func resourceTencentCloudTemApplicationServiceUpdate(d *schema.ResourceData, met
 
 	request := tem.NewModifyApplicationServiceRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	environmentId := idSplit[0]
 	applicationId := idSplit[1]
@@ -349,12 +354,12 @@ func resourceTencentCloudTemApplicationServiceUpdate(d *schema.ResourceData, met
 					if vv, ok := dMap["vpc_id"]; ok && vv != "" {
 						servicePortMapping.VpcId = helper.String(vv.(string))
 					} else {
-						return fmt.Errorf("vpc_id is required when type is `VPC`")
+						return diag.Errorf("vpc_id is required when type is `VPC`")
 					}
 					if vv, ok := dMap["subnet_id"]; ok && vv != "" {
 						servicePortMapping.SubnetId = helper.String(vv.(string))
 					} else {
-						return fmt.Errorf("subnet_id is required when type is `VPC`")
+						return diag.Errorf("subnet_id is required when type is `VPC`")
 					}
 				}
 			}
@@ -380,8 +385,8 @@ func resourceTencentCloudTemApplicationServiceUpdate(d *schema.ResourceData, met
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().ModifyApplicationService(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().ModifyApplicationServiceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -391,12 +396,12 @@ func resourceTencentCloudTemApplicationServiceUpdate(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tem applicationService failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TemService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		service, errRet := service.DescribeTemApplicationServiceById(ctx, environmentId, applicationId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -407,30 +412,30 @@ func resourceTencentCloudTemApplicationServiceUpdate(d *schema.ResourceData, met
 		return resource.RetryableError(fmt.Errorf("service is not ready %v, retry...", *service.Result.AllIpDone))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTemApplicationServiceRead(d, meta)
+	return resourceTencentCloudTemApplicationServiceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTemApplicationServiceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemApplicationServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_application_service.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TemService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	environmentId := idSplit[0]
 	applicationId := idSplit[1]
 	serviceName := idSplit[2]
 
 	if err := service.DeleteTemApplicationServiceById(ctx, environmentId, applicationId, serviceName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -28,7 +28,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tem "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tem/v20210701"
@@ -37,12 +39,16 @@ import (
 
 func resourceTencentCloudTemEnvironment() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTemEnvironmentRead,
-		Create: resourceTencentCloudTemEnvironmentCreate,
-		Update: resourceTencentCloudTemEnvironmentUpdate,
-		Delete: resourceTencentCloudTemEnvironmentDelete,
+		ReadContext:   resourceTencentCloudTemEnvironmentRead,
+		CreateContext: resourceTencentCloudTemEnvironmentCreate,
+		UpdateContext: resourceTencentCloudTemEnvironmentUpdate,
+		DeleteContext: resourceTencentCloudTemEnvironmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"environment_name": {
@@ -80,7 +86,7 @@ func resourceTencentCloudTemEnvironment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTemEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_environment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -121,8 +127,8 @@ func resourceTencentCloudTemEnvironmentCreate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().CreateEnvironment(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().CreateEnvironmentWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -135,15 +141,15 @@ func resourceTencentCloudTemEnvironmentCreate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tem environment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	environmentId := *response.Response.Result
 
 	service := TemService{client: meta.(*TencentCloudClient).apiV3Conn}
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, errRet := service.DescribeTemEnvironmentStatus(ctx, environmentId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -157,7 +163,7 @@ func resourceTencentCloudTemEnvironmentCreate(d *schema.ResourceData, meta inter
 		return resource.RetryableError(fmt.Errorf("environment status is %v, retry...", *instance.ClusterStatus))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(environmentId)
@@ -167,19 +173,19 @@ func resourceTencentCloudTemEnvironmentCreate(d *schema.ResourceData, meta inter
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::tem:%s:uin/:environment/%s", region, environmentId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTemEnvironmentRead(d, meta)
+	return resourceTencentCloudTemEnvironmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTemEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_environment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TemService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -188,12 +194,12 @@ func resourceTencentCloudTemEnvironmentRead(d *schema.ResourceData, meta interfa
 	environments, err := service.DescribeTemEnvironment(ctx, environmentId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	environment := environments.Result
 	if environment == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `environment` %s does not exist", environmentId)
+		return diag.Errorf("resource `environment` %s does not exist", environmentId)
 	}
 
 	if environment.EnvironmentName != nil {
@@ -217,19 +223,19 @@ func resourceTencentCloudTemEnvironmentRead(d *schema.ResourceData, meta interfa
 	region := client.Region
 	tags, err := tagService.DescribeResourceTags(ctx, "tem", "environment", region, environmentId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudTemEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_environment.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := tem.NewModifyEnvironmentRequest()
 
@@ -248,7 +254,7 @@ func resourceTencentCloudTemEnvironmentUpdate(d *schema.ResourceData, meta inter
 	}
 
 	if d.HasChange("vpc") {
-		return fmt.Errorf("`vpc` do not support change now.")
+		return diag.Errorf("`vpc` do not support change now.")
 	}
 
 	if d.HasChange("subnet_ids") {
@@ -261,8 +267,8 @@ func resourceTencentCloudTemEnvironmentUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().ModifyEnvironment(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().ModifyEnvironmentWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -273,7 +279,7 @@ func resourceTencentCloudTemEnvironmentUpdate(d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
@@ -283,25 +289,25 @@ func resourceTencentCloudTemEnvironmentUpdate(d *schema.ResourceData, meta inter
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("tem", "environment", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTemEnvironmentRead(d, meta)
+	return resourceTencentCloudTemEnvironmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTemEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_environment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TemService{client: meta.(*TencentCloudClient).apiV3Conn}
 	environmentId := d.Id()
 
 	if err := service.DeleteTemEnvironmentById(ctx, environmentId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
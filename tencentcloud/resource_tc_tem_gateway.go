@@ -52,10 +52,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tem "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tem/v20210701"
@@ -64,12 +64,16 @@ import (
 
 func resourceTencentCloudTemGateway() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTemGatewayRead,
-		Create: resourceTencentCloudTemGatewayCreate,
-		Update: resourceTencentCloudTemGatewayUpdate,
-		Delete: resourceTencentCloudTemGatewayDelete,
+		ReadContext:   resourceTencentCloudTemGatewayRead,
+		CreateContext: resourceTencentCloudTemGatewayCreate,
+		UpdateContext: resourceTencentCloudTemGatewayUpdate,
+		DeleteContext: resourceTencentCloudTemGatewayDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"ingress": {
@@ -220,7 +224,7 @@ func resourceTencentCloudTemGateway() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTemGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_gateway.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -317,8 +321,8 @@ func resourceTencentCloudTemGatewayCreate(d *schema.ResourceData, meta interface
 		request.Ingress = &ingressInfo
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().ModifyIngress(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().ModifyIngressWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -330,26 +334,26 @@ func resourceTencentCloudTemGatewayCreate(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tem gateway failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(environmentId + FILED_SP + ingressName)
+	d.SetId(helper.IdFormat(environmentId, ingressName))
 
-	return resourceTencentCloudTemGatewayRead(d, meta)
+	return resourceTencentCloudTemGatewayRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTemGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_gateway.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TemService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	environmentId := idSplit[0]
 	ingressName := idSplit[1]
@@ -357,12 +361,12 @@ func resourceTencentCloudTemGatewayRead(d *schema.ResourceData, meta interface{}
 	gateway, err := service.DescribeTemGateway(ctx, environmentId, ingressName)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if gateway == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `gateway` %s does not exist", ingressName)
+		return diag.Errorf("resource `gateway` %s does not exist", ingressName)
 	}
 
 	ingressMap := map[string]interface{}{}
@@ -457,7 +461,7 @@ func resourceTencentCloudTemGatewayRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudTemGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_gateway.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -469,9 +473,9 @@ func resourceTencentCloudTemGatewayUpdate(d *schema.ResourceData, meta interface
 		if dMap, ok := helper.InterfacesHeadMap(d, "ingress"); ok {
 			ingressInfo := tem.IngressInfo{}
 
-			idSplit := strings.Split(d.Id(), FILED_SP)
-			if len(idSplit) != 2 {
-				return fmt.Errorf("id is broken,%s", d.Id())
+			idSplit, err := helper.IdParseN(d.Id(), 2)
+			if err != nil {
+				return diag.FromErr(err)
 			}
 			environmentId := idSplit[0]
 			ingressName := idSplit[1]
@@ -549,8 +553,8 @@ func resourceTencentCloudTemGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().ModifyIngress(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().ModifyIngressWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -561,29 +565,29 @@ func resourceTencentCloudTemGatewayUpdate(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTemGatewayRead(d, meta)
+	return resourceTencentCloudTemGatewayRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTemGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_gateway.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TemService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	environmentId := idSplit[0]
 	ingressName := idSplit[1]
 
 	if err := service.DeleteTemGatewayById(ctx, environmentId, ingressName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -27,10 +27,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tem "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tem/v20210701"
@@ -39,12 +39,16 @@ import (
 
 func resourceTencentCloudTemLogConfig() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTemLogConfigRead,
-		Create: resourceTencentCloudTemLogConfigCreate,
-		Update: resourceTencentCloudTemLogConfigUpdate,
-		Delete: resourceTencentCloudTemLogConfigDelete,
+		ReadContext:   resourceTencentCloudTemLogConfigRead,
+		CreateContext: resourceTencentCloudTemLogConfigCreate,
+		UpdateContext: resourceTencentCloudTemLogConfigUpdate,
+		DeleteContext: resourceTencentCloudTemLogConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"environment_id": {
@@ -120,7 +124,7 @@ func resourceTencentCloudTemLogConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTemLogConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemLogConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_log_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -145,8 +149,8 @@ func resourceTencentCloudTemLogConfigCreate(d *schema.ResourceData, meta interfa
 
 	if v, ok := d.GetOk("workload_id"); ok {
 		workloadId := v.(string)
-		if workloadId != environmentId+FILED_SP+applicationId {
-			return fmt.Errorf("workloadId is error, it should be %s", environmentId+FILED_SP+applicationId)
+		if workloadId != helper.IdFormat(environmentId, applicationId) {
+			return diag.Errorf("workloadId is error, it should be %s", helper.IdFormat(environmentId, applicationId))
 		}
 	}
 
@@ -183,8 +187,8 @@ func resourceTencentCloudTemLogConfigCreate(d *schema.ResourceData, meta interfa
 		request.FilePattern = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().CreateLogConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().CreateLogConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -196,25 +200,25 @@ func resourceTencentCloudTemLogConfigCreate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tem logConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(environmentId + FILED_SP + applicationId + FILED_SP + name)
-	return resourceTencentCloudTemLogConfigRead(d, meta)
+	d.SetId(helper.IdFormat(environmentId, applicationId, name))
+	return resourceTencentCloudTemLogConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTemLogConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemLogConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_logConfig.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TemService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	environmentId := idSplit[0]
 	applicationId := idSplit[1]
@@ -223,17 +227,17 @@ func resourceTencentCloudTemLogConfigRead(d *schema.ResourceData, meta interface
 	logConfig, err := service.DescribeTemLogConfig(ctx, environmentId, applicationId, name)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if logConfig == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `logConfig` %s does not exist", name)
+		return diag.Errorf("resource `logConfig` %s does not exist", name)
 	}
 
 	_ = d.Set("environment_id", environmentId)
 	_ = d.Set("application_id", logConfig.ApplicationId)
-	_ = d.Set("workload_id", environmentId+FILED_SP+applicationId)
+	_ = d.Set("workload_id", helper.IdFormat(environmentId, applicationId))
 
 	if logConfig.Name != nil {
 		_ = d.Set("name", logConfig.Name)
@@ -270,7 +274,7 @@ func resourceTencentCloudTemLogConfigRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudTemLogConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemLogConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_log_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -278,9 +282,9 @@ func resourceTencentCloudTemLogConfigUpdate(d *schema.ResourceData, meta interfa
 
 	request := tem.NewModifyLogConfigRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	environmentId := idSplit[0]
 	applicationId := idSplit[1]
@@ -323,8 +327,8 @@ func resourceTencentCloudTemLogConfigUpdate(d *schema.ResourceData, meta interfa
 		request.Data = &data
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().ModifyLogConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().ModifyLogConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -335,30 +339,30 @@ func resourceTencentCloudTemLogConfigUpdate(d *schema.ResourceData, meta interfa
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTemLogConfigRead(d, meta)
+	return resourceTencentCloudTemLogConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTemLogConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemLogConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_log_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TemService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	environmentId := idSplit[0]
 	applicationId := idSplit[1]
 	name := idSplit[2]
 
 	if err := service.DeleteTemLogConfigById(ctx, environmentId, applicationId, name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
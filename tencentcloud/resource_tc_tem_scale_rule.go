@@ -57,10 +57,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tem "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tem/v20210701"
@@ -69,12 +69,16 @@ import (
 
 func resourceTencentCloudTemScaleRule() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTemScaleRuleRead,
-		Create: resourceTencentCloudTemScaleRuleCreate,
-		Update: resourceTencentCloudTemScaleRuleUpdate,
-		Delete: resourceTencentCloudTemScaleRuleDelete,
+		ReadContext:   resourceTencentCloudTemScaleRuleRead,
+		CreateContext: resourceTencentCloudTemScaleRuleCreate,
+		UpdateContext: resourceTencentCloudTemScaleRuleUpdate,
+		DeleteContext: resourceTencentCloudTemScaleRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"environment_id": {
@@ -219,7 +223,7 @@ func resourceTencentCloudTemScaleRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTemScaleRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemScaleRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_scale_rule.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -244,8 +248,8 @@ func resourceTencentCloudTemScaleRuleCreate(d *schema.ResourceData, meta interfa
 
 	if v, ok := d.GetOk("workload_id"); ok {
 		workloadId := v.(string)
-		if workloadId != environmentId+FILED_SP+applicationId {
-			return fmt.Errorf("workloadId is error, it should be %s", environmentId+FILED_SP+applicationId)
+		if workloadId != helper.IdFormat(environmentId, applicationId) {
+			return diag.Errorf("workloadId is error, it should be %s", helper.IdFormat(environmentId, applicationId))
 		}
 	}
 
@@ -323,8 +327,8 @@ func resourceTencentCloudTemScaleRuleCreate(d *schema.ResourceData, meta interfa
 		request.Autoscaler = &autoscaler
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().CreateApplicationAutoscaler(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().CreateApplicationAutoscalerWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -337,27 +341,27 @@ func resourceTencentCloudTemScaleRuleCreate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tem scaleRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	scaleRuleId := *response.Response.Result
 
-	d.SetId(environmentId + FILED_SP + applicationId + FILED_SP + scaleRuleId)
-	return resourceTencentCloudTemScaleRuleRead(d, meta)
+	d.SetId(helper.IdFormat(environmentId, applicationId, scaleRuleId))
+	return resourceTencentCloudTemScaleRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTemScaleRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemScaleRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_scaleRule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TemService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	environmentId := idSplit[0]
 	applicationId := idSplit[1]
@@ -366,17 +370,17 @@ func resourceTencentCloudTemScaleRuleRead(d *schema.ResourceData, meta interface
 	scaleRule, err := service.DescribeTemScaleRule(ctx, environmentId, applicationId, scaleRuleId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if scaleRule == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `scaleRule` %s does not exist", scaleRuleId)
+		return diag.Errorf("resource `scaleRule` %s does not exist", scaleRuleId)
 	}
 
 	_ = d.Set("environment_id", environmentId)
 	_ = d.Set("application_id", applicationId)
-	_ = d.Set("workload_id", environmentId+FILED_SP+applicationId)
+	_ = d.Set("workload_id", helper.IdFormat(environmentId, applicationId))
 
 	autoscalerMap := map[string]interface{}{}
 	if scaleRule.AutoscalerName != nil {
@@ -460,7 +464,7 @@ func resourceTencentCloudTemScaleRuleRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudTemScaleRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemScaleRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_scale_rule.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -468,9 +472,9 @@ func resourceTencentCloudTemScaleRuleUpdate(d *schema.ResourceData, meta interfa
 
 	request := tem.NewModifyApplicationAutoscalerRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	environmentId := idSplit[0]
 	applicationId := idSplit[1]
@@ -481,11 +485,11 @@ func resourceTencentCloudTemScaleRuleUpdate(d *schema.ResourceData, meta interfa
 	request.AutoscalerId = &scaleRuleId
 
 	if d.HasChange("environment_id") {
-		return fmt.Errorf("`environment_id` do not support change now.")
+		return diag.Errorf("`environment_id` do not support change now.")
 	}
 
 	if d.HasChange("application_id") {
-		return fmt.Errorf("`application_id` do not support change now.")
+		return diag.Errorf("`application_id` do not support change now.")
 	}
 
 	if d.HasChange("autoscaler") {
@@ -564,8 +568,8 @@ func resourceTencentCloudTemScaleRuleUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().ModifyApplicationAutoscaler(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().ModifyApplicationAutoscalerWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -576,35 +580,35 @@ func resourceTencentCloudTemScaleRuleUpdate(d *schema.ResourceData, meta interfa
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTemScaleRuleRead(d, meta)
+	return resourceTencentCloudTemScaleRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTemScaleRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemScaleRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_scale_rule.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TemService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	environmentId := idSplit[0]
 	applicationId := idSplit[1]
 	scaleRuleId := idSplit[2]
 
 	if err := service.DisableTemScaleRuleById(ctx, environmentId, applicationId, scaleRuleId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := service.DeleteTemScaleRuleById(ctx, environmentId, applicationId, scaleRuleId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
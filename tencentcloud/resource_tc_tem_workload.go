@@ -57,10 +57,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tem "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tem/v20210701"
@@ -69,12 +69,16 @@ import (
 
 func resourceTencentCloudTemWorkload() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTemWorkloadRead,
-		Create: resourceTencentCloudTemWorkloadCreate,
-		Update: resourceTencentCloudTemWorkloadUpdate,
-		Delete: resourceTencentCloudTemWorkloadDelete,
+		ReadContext:   resourceTencentCloudTemWorkloadRead,
+		CreateContext: resourceTencentCloudTemWorkloadCreate,
+		UpdateContext: resourceTencentCloudTemWorkloadUpdate,
+		DeleteContext: resourceTencentCloudTemWorkloadDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"application_id": {
@@ -444,7 +448,7 @@ func resourceTencentCloudTemWorkload() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTemWorkloadCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemWorkloadCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_workload.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -685,8 +689,8 @@ func resourceTencentCloudTemWorkloadCreate(d *schema.ResourceData, meta interfac
 
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().DeployApplication(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().DeployApplicationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -698,25 +702,25 @@ func resourceTencentCloudTemWorkloadCreate(d *schema.ResourceData, meta interfac
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create tem workload failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(environmentId + FILED_SP + applicationId)
-	return resourceTencentCloudTemWorkloadRead(d, meta)
+	d.SetId(helper.IdFormat(environmentId, applicationId))
+	return resourceTencentCloudTemWorkloadRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTemWorkloadRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemWorkloadRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_workload.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TemService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	environmentId := idSplit[0]
 	applicationId := idSplit[1]
@@ -726,12 +730,12 @@ func resourceTencentCloudTemWorkloadRead(d *schema.ResourceData, meta interface{
 	workload := workloads.Result
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if workload == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `workload` does not exist")
+		return diag.Errorf("resource `workload` does not exist")
 	}
 
 	if workload.ApplicationId != nil {
@@ -945,7 +949,7 @@ func resourceTencentCloudTemWorkloadRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTencentCloudTemWorkloadUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemWorkloadUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_workload.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -955,9 +959,9 @@ func resourceTencentCloudTemWorkloadUpdate(d *schema.ResourceData, meta interfac
 		request = tem.NewDeployApplicationRequest()
 	)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	environmentId := idSplit[0]
 	applicationId := idSplit[1]
@@ -1187,8 +1191,8 @@ func resourceTencentCloudTemWorkloadUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().DeployApplication(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTemClient().DeployApplicationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1199,29 +1203,29 @@ func resourceTencentCloudTemWorkloadUpdate(d *schema.ResourceData, meta interfac
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTemWorkloadRead(d, meta)
+	return resourceTencentCloudTemWorkloadRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTemWorkloadDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTemWorkloadDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tem_workload.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TemService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	environmentId := idSplit[0]
 	applicationId := idSplit[1]
 
 	if err := service.DeleteTemWorkloadById(ctx, environmentId, applicationId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -29,8 +29,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	teo "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/teo/v20220901"
@@ -39,12 +40,17 @@ import (
 
 func resourceTencentCloudTeoAccelerationDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTeoAccelerationDomainCreate,
-		Read:   resourceTencentCloudTeoAccelerationDomainRead,
-		Update: resourceTencentCloudTeoAccelerationDomainUpdate,
-		Delete: resourceTencentCloudTeoAccelerationDomainDelete,
+		CreateContext: resourceTencentCloudTeoAccelerationDomainCreate,
+		ReadContext:   resourceTencentCloudTeoAccelerationDomainRead,
+		UpdateContext: resourceTencentCloudTeoAccelerationDomainUpdate,
+		DeleteContext: resourceTencentCloudTeoAccelerationDomainDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(18 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
@@ -126,12 +132,12 @@ func resourceTencentCloudTeoAccelerationDomain() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTeoAccelerationDomainCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoAccelerationDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_acceleration_domain.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request    = teo.NewCreateAccelerationDomainRequest()
@@ -178,8 +184,8 @@ func resourceTencentCloudTeoAccelerationDomainCreate(d *schema.ResourceData, met
 		request.OriginInfo = &originInfo
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().CreateAccelerationDomain(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().CreateAccelerationDomainWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -189,40 +195,40 @@ func resourceTencentCloudTeoAccelerationDomainCreate(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create teo accelerationDomain failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(zoneId + FILED_SP + domainName)
+	d.SetId(helper.IdFormat(zoneId, domainName))
 
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
 	err = service.CheckAccelerationDomainStatus(ctx, zoneId, domainName, "")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTeoAccelerationDomainRead(d, meta)
+	return resourceTencentCloudTeoAccelerationDomainRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTeoAccelerationDomainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoAccelerationDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_acceleration_domain.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	zoneId := idSplit[0]
 	domainName := idSplit[1]
 
 	accelerationDomain, err := service.DescribeTeoAccelerationDomainById(ctx, zoneId, domainName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if accelerationDomain == nil {
@@ -288,16 +294,16 @@ func resourceTencentCloudTeoAccelerationDomainRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudTeoAccelerationDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoAccelerationDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_acceleration_domain.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	zoneId := idSplit[0]
 	domainName := idSplit[1]
@@ -337,8 +343,8 @@ func resourceTencentCloudTeoAccelerationDomainUpdate(d *schema.ResourceData, met
 			request.OriginInfo = &originInfo
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyAccelerationDomain(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyAccelerationDomainWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -348,13 +354,13 @@ func resourceTencentCloudTeoAccelerationDomainUpdate(d *schema.ResourceData, met
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update teo accelerationDomain failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
 		err = service.CheckAccelerationDomainStatus(ctx, zoneId, domainName, "")
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -367,8 +373,8 @@ func resourceTencentCloudTeoAccelerationDomainUpdate(d *schema.ResourceData, met
 			request.Status = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyAccelerationDomainStatuses(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyAccelerationDomainStatusesWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -378,11 +384,11 @@ func resourceTencentCloudTeoAccelerationDomainUpdate(d *schema.ResourceData, met
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update teo accelerationDomain failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
-		err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			instance, errRet := service.DescribeTeoAccelerationDomainById(ctx, zoneId, domainName)
 			if errRet != nil {
 				return retryError(errRet, InternalError)
@@ -393,24 +399,24 @@ func resourceTencentCloudTeoAccelerationDomainUpdate(d *schema.ResourceData, met
 			return resource.RetryableError(fmt.Errorf("AccelerationDomain status is %v, retry...", *instance.DomainStatus))
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTeoAccelerationDomainRead(d, meta)
+	return resourceTencentCloudTeoAccelerationDomainRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTeoAccelerationDomainDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoAccelerationDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_acceleration_domain.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	zoneId := idSplit[0]
 	domainName := idSplit[1]
@@ -420,8 +426,8 @@ func resourceTencentCloudTeoAccelerationDomainDelete(d *schema.ResourceData, met
 	request.DomainNames = []*string{&domainName}
 	request.Status = helper.String("offline")
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyAccelerationDomainStatuses(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyAccelerationDomainStatusesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -431,16 +437,16 @@ func resourceTencentCloudTeoAccelerationDomainDelete(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update teo accelerationDomain failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = service.CheckAccelerationDomainStatus(ctx, zoneId, domainName, "delete")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = service.DeleteTeoAccelerationDomainById(ctx, zoneId, domainName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -33,8 +33,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	teo "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/teo/v20220901"
@@ -43,12 +44,17 @@ import (
 
 func resourceTencentCloudTeoApplicationProxy() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTeoApplicationProxyRead,
-		Create: resourceTencentCloudTeoApplicationProxyCreate,
-		Update: resourceTencentCloudTeoApplicationProxyUpdate,
-		Delete: resourceTencentCloudTeoApplicationProxyDelete,
+		ReadContext:   resourceTencentCloudTeoApplicationProxyRead,
+		CreateContext: resourceTencentCloudTeoApplicationProxyCreate,
+		UpdateContext: resourceTencentCloudTeoApplicationProxyUpdate,
+		DeleteContext: resourceTencentCloudTeoApplicationProxyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(18 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
@@ -161,7 +167,7 @@ func resourceTencentCloudTeoApplicationProxy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTeoApplicationProxyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoApplicationProxyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_application_proxy.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -212,16 +218,16 @@ func resourceTencentCloudTeoApplicationProxyCreate(d *schema.ResourceData, meta
 	}
 
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	err := service.CheckZoneComplete(ctx, zoneId)
 	if err != nil {
 		log.Printf("[CRITAL]%s create teo dnsRecord failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().CreateApplicationProxy(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().CreateApplicationProxyWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -234,12 +240,12 @@ func resourceTencentCloudTeoApplicationProxyCreate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create teo applicationProxy failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	proxyId = *response.Response.ProxyId
 
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, errRet := service.DescribeTeoApplicationProxy(ctx, zoneId, proxyId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -254,25 +260,25 @@ func resourceTencentCloudTeoApplicationProxyCreate(d *schema.ResourceData, meta
 		return resource.RetryableError(fmt.Errorf("applicationProxy status is %v, retry...", *instance.Status))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(zoneId + FILED_SP + proxyId)
-	return resourceTencentCloudTeoApplicationProxyRead(d, meta)
+	d.SetId(helper.IdFormat(zoneId, proxyId))
+	return resourceTencentCloudTeoApplicationProxyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTeoApplicationProxyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoApplicationProxyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_application_proxy.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	zoneId := idSplit[0]
 	proxyId := idSplit[1]
@@ -280,12 +286,12 @@ func resourceTencentCloudTeoApplicationProxyRead(d *schema.ResourceData, meta in
 	applicationProxy, err := service.DescribeTeoApplicationProxy(ctx, zoneId, proxyId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if applicationProxy == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `applicationProxy` %s does not exist", proxyId)
+		return diag.Errorf("resource `applicationProxy` %s does not exist", proxyId)
 	}
 
 	if applicationProxy.ZoneId != nil {
@@ -356,16 +362,16 @@ func resourceTencentCloudTeoApplicationProxyRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTencentCloudTeoApplicationProxyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoApplicationProxyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_application_proxy.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 	request := teo.NewModifyApplicationProxyRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	zoneId := idSplit[0]
 	proxyId := idSplit[1]
@@ -374,7 +380,7 @@ func resourceTencentCloudTeoApplicationProxyUpdate(d *schema.ResourceData, meta
 	request.ProxyId = &proxyId
 
 	if d.HasChange("zone_id") {
-		return fmt.Errorf("`zone_id` do not support change now.")
+		return diag.Errorf("`zone_id` do not support change now.")
 	}
 
 	if v, ok := d.GetOk("proxy_name"); ok {
@@ -388,15 +394,15 @@ func resourceTencentCloudTeoApplicationProxyUpdate(d *schema.ResourceData, meta
 	}
 
 	if d.HasChange("plat_type") {
-		return fmt.Errorf("`plat_type` do not support change now.")
+		return diag.Errorf("`plat_type` do not support change now.")
 	}
 
 	if d.HasChange("security_type") {
-		return fmt.Errorf("`security_type` do not support change now.")
+		return diag.Errorf("`security_type` do not support change now.")
 	}
 
 	if d.HasChange("accelerate_type") {
-		return fmt.Errorf("`accelerate_type` do not support change now.")
+		return diag.Errorf("`accelerate_type` do not support change now.")
 	}
 
 	if d.HasChange("session_persist_time") {
@@ -416,8 +422,8 @@ func resourceTencentCloudTeoApplicationProxyUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyApplicationProxy(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyApplicationProxyWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -429,7 +435,7 @@ func resourceTencentCloudTeoApplicationProxyUpdate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create teo applicationProxy failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("status") {
@@ -440,8 +446,8 @@ func resourceTencentCloudTeoApplicationProxyUpdate(d *schema.ResourceData, meta
 			statusRequest.ProxyId = &proxyId
 			statusRequest.Status = helper.String(v.(string))
 
-			statusErr := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				statusResult, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyApplicationProxyStatus(statusRequest)
+			statusErr := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				statusResult, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyApplicationProxyStatusWithContext(ctx, statusRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -453,32 +459,32 @@ func resourceTencentCloudTeoApplicationProxyUpdate(d *schema.ResourceData, meta
 
 			if statusErr != nil {
 				log.Printf("[CRITAL]%s create teo applicationProxy failed, reason:%+v", logId, statusErr)
-				return statusErr
+				return diag.FromErr(statusErr)
 			}
 			_ = d.Set("status", v.(string))
 		}
 	}
 
-	return resourceTencentCloudTeoApplicationProxyRead(d, meta)
+	return resourceTencentCloudTeoApplicationProxyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTeoApplicationProxyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoApplicationProxyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_application_proxy.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	zoneId := idSplit[0]
 	proxyId := idSplit[1]
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		e := resourceTencentCloudTeoApplicationProxyRead(d, meta)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		e := diagnosticsError(resourceTencentCloudTeoApplicationProxyRead(ctx, d, meta))
 		if e != nil {
 			log.Printf("[CRITAL]%s get teo applicationProxy failed, reason:%+v", logId, e)
 			return resource.RetryableError(e)
@@ -496,18 +502,18 @@ func resourceTencentCloudTeoApplicationProxyDelete(d *schema.ResourceData, meta
 		statusRequest.ZoneId = &zoneId
 		statusRequest.ProxyId = &proxyId
 		statusRequest.Status = helper.String("offline")
-		_, e = meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyApplicationProxyStatus(statusRequest)
+		_, e = meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyApplicationProxyStatusWithContext(ctx, statusRequest)
 		if e != nil {
 			return resource.NonRetryableError(fmt.Errorf("setting applicationProxy `status` to offline failed, reason: %v", e))
 		}
 		return resource.RetryableError(fmt.Errorf("setting applicationProxy `status` to offline"))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = service.DeleteTeoApplicationProxyById(ctx, zoneId, proxyId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -35,8 +35,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	teo "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/teo/v20220901"
@@ -45,12 +46,17 @@ import (
 
 func resourceTencentCloudTeoApplicationProxyRule() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTeoApplicationProxyRuleRead,
-		Create: resourceTencentCloudTeoApplicationProxyRuleCreate,
-		Update: resourceTencentCloudTeoApplicationProxyRuleUpdate,
-		Delete: resourceTencentCloudTeoApplicationProxyRuleDelete,
+		ReadContext:   resourceTencentCloudTeoApplicationProxyRuleRead,
+		CreateContext: resourceTencentCloudTeoApplicationProxyRuleCreate,
+		UpdateContext: resourceTencentCloudTeoApplicationProxyRuleUpdate,
+		DeleteContext: resourceTencentCloudTeoApplicationProxyRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+			Update: schema.DefaultTimeout(180 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
@@ -131,7 +137,7 @@ func resourceTencentCloudTeoApplicationProxyRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTeoApplicationProxyRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoApplicationProxyRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_application_proxy_rule.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -190,8 +196,8 @@ func resourceTencentCloudTeoApplicationProxyRuleCreate(d *schema.ResourceData, m
 		request.SessionPersist = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().CreateApplicationProxyRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().CreateApplicationProxyRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e, InternalError)
 		} else {
@@ -204,7 +210,7 @@ func resourceTencentCloudTeoApplicationProxyRuleCreate(d *schema.ResourceData, m
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create teo applicationProxyRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if response.Response.RuleId != nil {
@@ -212,9 +218,9 @@ func resourceTencentCloudTeoApplicationProxyRuleCreate(d *schema.ResourceData, m
 	}
 
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	err = resource.Retry(60*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, errRet := service.DescribeTeoApplicationProxyRule(ctx, zoneId, proxyId, ruleId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -228,25 +234,25 @@ func resourceTencentCloudTeoApplicationProxyRuleCreate(d *schema.ResourceData, m
 		return resource.RetryableError(fmt.Errorf("applicationProxyRule status is %v, retry...", *instance.Status))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(zoneId + FILED_SP + proxyId + FILED_SP + ruleId)
-	return resourceTencentCloudTeoApplicationProxyRuleRead(d, meta)
+	d.SetId(helper.IdFormat(zoneId, proxyId, ruleId))
+	return resourceTencentCloudTeoApplicationProxyRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTeoApplicationProxyRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoApplicationProxyRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_application_proxy_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	zoneId := idSplit[0]
 	proxyId := idSplit[1]
@@ -255,12 +261,12 @@ func resourceTencentCloudTeoApplicationProxyRuleRead(d *schema.ResourceData, met
 	proxyRule, err := service.DescribeTeoApplicationProxyRule(ctx, zoneId, proxyId, ruleId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if proxyRule == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `applicationProxyRule` %s does not exist", ruleId)
+		return diag.Errorf("resource `applicationProxyRule` %s does not exist", ruleId)
 	}
 
 	_ = d.Set("zone_id", zoneId)
@@ -302,7 +308,7 @@ func resourceTencentCloudTeoApplicationProxyRuleRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudTeoApplicationProxyRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoApplicationProxyRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_application_proxy_rule.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -310,9 +316,9 @@ func resourceTencentCloudTeoApplicationProxyRuleUpdate(d *schema.ResourceData, m
 
 	request := teo.NewModifyApplicationProxyRuleRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	zoneId := idSplit[0]
 	proxyId := idSplit[1]
@@ -324,13 +330,13 @@ func resourceTencentCloudTeoApplicationProxyRuleUpdate(d *schema.ResourceData, m
 
 	if d.HasChange("zone_id") {
 
-		return fmt.Errorf("`zone_id` do not support change now.")
+		return diag.Errorf("`zone_id` do not support change now.")
 
 	}
 
 	if d.HasChange("proxy_id") {
 
-		return fmt.Errorf("`proxy_id` do not support change now.")
+		return diag.Errorf("`proxy_id` do not support change now.")
 
 	}
 
@@ -370,8 +376,8 @@ func resourceTencentCloudTeoApplicationProxyRuleUpdate(d *schema.ResourceData, m
 		request.SessionPersist = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyApplicationProxyRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyApplicationProxyRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -383,7 +389,7 @@ func resourceTencentCloudTeoApplicationProxyRuleUpdate(d *schema.ResourceData, m
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create teo applicationProxyRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("status") {
@@ -395,8 +401,8 @@ func resourceTencentCloudTeoApplicationProxyRuleUpdate(d *schema.ResourceData, m
 			statusRequest.RuleId = &ruleId
 			statusRequest.Status = helper.String(v.(string))
 
-			statusErr := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				statusResult, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyApplicationProxyRuleStatus(statusRequest)
+			statusErr := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				statusResult, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyApplicationProxyRuleStatusWithContext(ctx, statusRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -408,15 +414,15 @@ func resourceTencentCloudTeoApplicationProxyRuleUpdate(d *schema.ResourceData, m
 
 			if statusErr != nil {
 				log.Printf("[CRITAL]%s create teo applicationProxy failed, reason:%+v", logId, statusErr)
-				return statusErr
+				return diag.FromErr(statusErr)
 			}
 			_ = d.Set("status", v.(string))
 		}
 	}
 
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	err = resource.Retry(60*readRetryTimeout, func() *resource.RetryError {
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		instance, errRet := service.DescribeTeoApplicationProxyRule(ctx, zoneId, proxyId, ruleId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -430,30 +436,30 @@ func resourceTencentCloudTeoApplicationProxyRuleUpdate(d *schema.ResourceData, m
 		return resource.RetryableError(fmt.Errorf("applicationProxyRule status is %v, retry...", *instance.Status))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTeoApplicationProxyRuleRead(d, meta)
+	return resourceTencentCloudTeoApplicationProxyRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTeoApplicationProxyRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoApplicationProxyRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_application_proxy_rule.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	zoneId := idSplit[0]
 	proxyId := idSplit[1]
 	ruleId := idSplit[2]
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		instance, errRet := service.DescribeTeoApplicationProxyRule(ctx, zoneId, proxyId, ruleId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -470,18 +476,18 @@ func resourceTencentCloudTeoApplicationProxyRuleDelete(d *schema.ResourceData, m
 		statusRequest.ProxyId = &proxyId
 		statusRequest.RuleId = &ruleId
 		statusRequest.Status = helper.String("offline")
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyApplicationProxyRuleStatus(statusRequest)
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyApplicationProxyRuleStatusWithContext(ctx, statusRequest)
 		if e != nil {
 			return resource.NonRetryableError(fmt.Errorf("setting applicationProxyRule `status` to offline failed, reason: %v", e))
 		}
 		return resource.RetryableError(fmt.Errorf("setting applicationProxyRule `status` to offline"))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err = service.DeleteTeoApplicationProxyRuleById(ctx, zoneId, proxyId, ruleId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -37,10 +37,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	teo "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/teo/v20220901"
@@ -49,12 +49,15 @@ import (
 
 func resourceTencentCloudTeoCertificateConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTeoCertificateConfigCreate,
-		Read:   resourceTencentCloudTeoCertificateConfigRead,
-		Update: resourceTencentCloudTeoCertificateConfigUpdate,
-		Delete: resourceTencentCloudTeoCertificateConfigDelete,
+		CreateContext: resourceTencentCloudTeoCertificateConfigCreate,
+		ReadContext:   resourceTencentCloudTeoCertificateConfigRead,
+		UpdateContext: resourceTencentCloudTeoCertificateConfigUpdate,
+		DeleteContext: resourceTencentCloudTeoCertificateConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
@@ -133,7 +136,7 @@ func resourceTencentCloudTeoCertificateConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTeoCertificateConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoCertificateConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_certificate_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -149,31 +152,31 @@ func resourceTencentCloudTeoCertificateConfigCreate(d *schema.ResourceData, meta
 		host = v.(string)
 	}
 
-	d.SetId(zoneId + FILED_SP + host)
+	d.SetId(helper.IdFormat(zoneId, host))
 
-	return resourceTencentCloudTeoCertificateConfigUpdate(d, meta)
+	return resourceTencentCloudTeoCertificateConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudTeoCertificateConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoCertificateConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_certificate_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	zoneId := idSplit[0]
 	host := idSplit[1]
 
 	accelerationDomain, err := service.DescribeTeoAccelerationDomainById(ctx, zoneId, host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if accelerationDomain == nil {
@@ -194,7 +197,7 @@ func resourceTencentCloudTeoCertificateConfigRead(d *schema.ResourceData, meta i
 		certificate := accelerationDomain.Certificate
 		zone, err := service.DescribeTeoZone(ctx, zoneId)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		serverCertInfoList := []interface{}{}
@@ -242,18 +245,18 @@ func resourceTencentCloudTeoCertificateConfigRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudTeoCertificateConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoCertificateConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_certificate_config.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := teo.NewModifyHostsCertificateRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	zoneId := idSplit[0]
 	host := idSplit[1]
@@ -294,8 +297,8 @@ func resourceTencentCloudTeoCertificateConfigUpdate(d *schema.ResourceData, meta
 		request.Mode = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyHostsCertificate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyHostsCertificateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -305,19 +308,19 @@ func resourceTencentCloudTeoCertificateConfigUpdate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update teo certificate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
 	err = service.CheckAccelerationDomainStatus(ctx, zoneId, host, "")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTeoCertificateConfigRead(d, meta)
+	return resourceTencentCloudTeoCertificateConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTeoCertificateConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoCertificateConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_certificate_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
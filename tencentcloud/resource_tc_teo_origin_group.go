@@ -51,10 +51,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	teo "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/teo/v20220901"
@@ -63,12 +63,16 @@ import (
 
 func resourceTencentCloudTeoOriginGroup() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTeoOriginGroupRead,
-		Create: resourceTencentCloudTeoOriginGroupCreate,
-		Update: resourceTencentCloudTeoOriginGroupUpdate,
-		Delete: resourceTencentCloudTeoOriginGroupDelete,
+		ReadContext:   resourceTencentCloudTeoOriginGroupRead,
+		CreateContext: resourceTencentCloudTeoOriginGroupCreate,
+		UpdateContext: resourceTencentCloudTeoOriginGroupUpdate,
+		DeleteContext: resourceTencentCloudTeoOriginGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
@@ -173,7 +177,7 @@ func resourceTencentCloudTeoOriginGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTeoOriginGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoOriginGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_origin_group.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -243,8 +247,8 @@ func resourceTencentCloudTeoOriginGroupCreate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().CreateOriginGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().CreateOriginGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e, "OperationDenied")
 		} else {
@@ -257,27 +261,27 @@ func resourceTencentCloudTeoOriginGroupCreate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create teo originGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	originGroupId = *response.Response.OriginGroupId
 
-	d.SetId(zoneId + FILED_SP + originGroupId)
-	return resourceTencentCloudTeoOriginGroupRead(d, meta)
+	d.SetId(helper.IdFormat(zoneId, originGroupId))
+	return resourceTencentCloudTeoOriginGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTeoOriginGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoOriginGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_origin_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	zoneId := idSplit[0]
 	originGroupId := idSplit[1]
@@ -285,12 +289,12 @@ func resourceTencentCloudTeoOriginGroupRead(d *schema.ResourceData, meta interfa
 	originGroup, err := service.DescribeTeoOriginGroup(ctx, zoneId, originGroupId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if originGroup == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `originGroup` %s does not exist", originGroupId)
+		return diag.Errorf("resource `originGroup` %s does not exist", originGroupId)
 	}
 
 	_ = d.Set("zone_id", zoneId)
@@ -358,16 +362,16 @@ func resourceTencentCloudTeoOriginGroupRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudTeoOriginGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoOriginGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_origin_group.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 	request := teo.NewModifyOriginGroupRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	zoneId := idSplit[0]
 	originGroupId := idSplit[1]
@@ -427,8 +431,8 @@ func resourceTencentCloudTeoOriginGroupUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyOriginGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyOriginGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -440,30 +444,30 @@ func resourceTencentCloudTeoOriginGroupUpdate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create teo originGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTeoOriginGroupRead(d, meta)
+	return resourceTencentCloudTeoOriginGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTeoOriginGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoOriginGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_origin_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	zoneId := idSplit[0]
 	originGroupId := idSplit[1]
 
 	if err := service.DeleteTeoOriginGroupById(ctx, zoneId, originGroupId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
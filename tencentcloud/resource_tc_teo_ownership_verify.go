@@ -12,8 +12,11 @@ resource "tencentcloud_teo_ownership_verify" "ownership_verify" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	teo "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/teo/v20220901"
@@ -22,9 +25,12 @@ import (
 
 func resourceTencentCloudTeoOwnershipVerify() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTeoOwnershipVerifyCreate,
-		Read:   resourceTencentCloudTeoOwnershipVerifyRead,
-		Delete: resourceTencentCloudTeoOwnershipVerifyDelete,
+		CreateContext: resourceTencentCloudTeoOwnershipVerifyCreate,
+		ReadContext:   resourceTencentCloudTeoOwnershipVerifyRead,
+		DeleteContext: resourceTencentCloudTeoOwnershipVerifyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"domain": {
@@ -49,7 +55,7 @@ func resourceTencentCloudTeoOwnershipVerify() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTeoOwnershipVerifyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoOwnershipVerifyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_ownership_verify.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -65,8 +71,8 @@ func resourceTencentCloudTeoOwnershipVerifyCreate(d *schema.ResourceData, meta i
 		request.Domain = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().VerifyOwnership(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().VerifyOwnershipWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -77,7 +83,7 @@ func resourceTencentCloudTeoOwnershipVerifyCreate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate teo ownershipVerify failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(domain)
@@ -87,17 +93,17 @@ func resourceTencentCloudTeoOwnershipVerifyCreate(d *schema.ResourceData, meta i
 		_ = d.Set("result", *response.Response.Result)
 	}
 
-	return resourceTencentCloudTeoOwnershipVerifyRead(d, meta)
+	return resourceTencentCloudTeoOwnershipVerifyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTeoOwnershipVerifyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoOwnershipVerifyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_ownership_verify.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudTeoOwnershipVerifyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoOwnershipVerifyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_ownership_verify.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -134,11 +134,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	teo "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/teo/v20220901"
@@ -147,12 +146,16 @@ import (
 
 func resourceTencentCloudTeoRuleEngine() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTeoRuleEngineRead,
-		Create: resourceTencentCloudTeoRuleEngineCreate,
-		Update: resourceTencentCloudTeoRuleEngineUpdate,
-		Delete: resourceTencentCloudTeoRuleEngineDelete,
+		ReadContext:   resourceTencentCloudTeoRuleEngineRead,
+		CreateContext: resourceTencentCloudTeoRuleEngineCreate,
+		UpdateContext: resourceTencentCloudTeoRuleEngineUpdate,
+		DeleteContext: resourceTencentCloudTeoRuleEngineDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
@@ -580,7 +583,7 @@ func resourceTencentCloudTeoRuleEngine() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTeoRuleEngineCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoRuleEngineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_rule_engine.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -880,8 +883,8 @@ func resourceTencentCloudTeoRuleEngineCreate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().CreateRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().CreateRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -894,27 +897,27 @@ func resourceTencentCloudTeoRuleEngineCreate(d *schema.ResourceData, meta interf
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create teo ruleEngine failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleId = *response.Response.RuleId
 
-	d.SetId(zoneId + FILED_SP + ruleId)
-	return resourceTencentCloudTeoRuleEngineRead(d, meta)
+	d.SetId(helper.IdFormat(zoneId, ruleId))
+	return resourceTencentCloudTeoRuleEngineRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTeoRuleEngineRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoRuleEngineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_rule_engine.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	zoneId := idSplit[0]
 	ruleId := idSplit[1]
@@ -922,12 +925,12 @@ func resourceTencentCloudTeoRuleEngineRead(d *schema.ResourceData, meta interfac
 	ruleEngine, err := service.DescribeTeoRuleEngine(ctx, zoneId, ruleId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ruleEngine == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `ruleEngine` %s does not exist", ruleId)
+		return diag.Errorf("resource `ruleEngine` %s does not exist", ruleId)
 	}
 
 	_ = d.Set("zone_id", zoneId)
@@ -1206,16 +1209,16 @@ func resourceTencentCloudTeoRuleEngineRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudTeoRuleEngineUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoRuleEngineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_rule_engine.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 	request := teo.NewModifyRuleRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	zoneId := idSplit[0]
 	ruleId := idSplit[1]
@@ -1504,8 +1507,8 @@ func resourceTencentCloudTeoRuleEngineUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1517,29 +1520,29 @@ func resourceTencentCloudTeoRuleEngineUpdate(d *schema.ResourceData, meta interf
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create teo ruleEngine failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTeoRuleEngineRead(d, meta)
+	return resourceTencentCloudTeoRuleEngineRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTeoRuleEngineDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoRuleEngineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_rule_engine.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	zoneId := idSplit[0]
 	ruleId := idSplit[1]
 
-	err := resource.Retry(5*time.Second, func() *resource.RetryError {
+	err := retryContext(ctx, 5*time.Second, func() *resource.RetryError {
 		if e := service.DeleteTeoRuleEngineById(ctx, zoneId, ruleId); e != nil {
 			return retryError(e, "InternalError")
 		}
@@ -1548,7 +1551,7 @@ func resourceTencentCloudTeoRuleEngineDelete(d *schema.ResourceData, meta interf
 
 	if err != nil {
 		log.Printf("[CRITAL]%s delete teo ruleEngine failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -30,7 +30,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	teo "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/teo/v20220901"
@@ -39,12 +41,16 @@ import (
 
 func resourceTencentCloudTeoZone() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTeoZoneRead,
-		Create: resourceTencentCloudTeoZoneCreate,
-		Update: resourceTencentCloudTeoZoneUpdate,
-		Delete: resourceTencentCloudTeoZoneDelete,
+		ReadContext:   resourceTencentCloudTeoZoneRead,
+		CreateContext: resourceTencentCloudTeoZoneCreate,
+		UpdateContext: resourceTencentCloudTeoZoneUpdate,
+		DeleteContext: resourceTencentCloudTeoZoneDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(18 * time.Minute),
+			Update: schema.DefaultTimeout(18 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"zone_name": {
@@ -144,12 +150,12 @@ func resourceTencentCloudTeoZone() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTeoZoneCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoZoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_zone.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request  = teo.NewCreateZoneRequest()
@@ -177,8 +183,8 @@ func resourceTencentCloudTeoZoneCreate(d *schema.ResourceData, meta interface{})
 		request.PlanId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().CreateZone(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().CreateZoneWithContext(ctx, request)
 		if e != nil {
 			if isExpectError(e, []string{"ResourceInUse", "ResourceInUse.Others"}) {
 				return resource.NonRetryableError(e)
@@ -194,14 +200,14 @@ func resourceTencentCloudTeoZoneCreate(d *schema.ResourceData, meta interface{})
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create teo zone failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	zoneId = *response.Response.ZoneId
 	d.SetId(zoneId)
 
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, errRet := service.DescribeTeoZone(ctx, zoneId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -212,14 +218,14 @@ func resourceTencentCloudTeoZoneCreate(d *schema.ResourceData, meta interface{})
 		return resource.RetryableError(fmt.Errorf("zone status is %v, retry...", *instance.Status))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, _ := d.GetOkExists("paused"); v != nil {
 		if v.(bool) {
 			err := service.ModifyZoneStatus(ctx, zoneId, v.(bool), "create")
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -228,18 +234,18 @@ func resourceTencentCloudTeoZoneCreate(d *schema.ResourceData, meta interface{})
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		resourceName := fmt.Sprintf("qcs::teo::uin/:zone/%s", zoneId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudTeoZoneRead(d, meta)
+	return resourceTencentCloudTeoZoneRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTeoZoneRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_zone.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -248,7 +254,7 @@ func resourceTencentCloudTeoZoneRead(d *schema.ResourceData, meta interface{}) e
 	zone, err := service.DescribeTeoZone(ctx, zoneId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if zone == nil {
@@ -318,19 +324,19 @@ func resourceTencentCloudTeoZoneRead(d *schema.ResourceData, meta interface{}) e
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "teo", "zone", "", zoneId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudTeoZoneUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoZoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_zone.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	request := teo.NewModifyZoneRequest()
@@ -342,7 +348,7 @@ func resourceTencentCloudTeoZoneUpdate(d *schema.ResourceData, meta interface{})
 		if v, ok := d.GetOkExists("paused"); ok {
 			err := service.ModifyZoneStatus(ctx, zoneId, v.(bool), "update")
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -360,8 +366,8 @@ func resourceTencentCloudTeoZoneUpdate(d *schema.ResourceData, meta interface{})
 			request.Area = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyZone(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyZoneWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -373,11 +379,11 @@ func resourceTencentCloudTeoZoneUpdate(d *schema.ResourceData, meta interface{})
 
 		if err != nil {
 			log.Printf("[CRITAL]%s create teo zone failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
-		err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			instance, errRet := service.DescribeTeoZone(ctx, zoneId)
 			if errRet != nil {
 				return retryError(errRet, InternalError)
@@ -388,7 +394,7 @@ func resourceTencentCloudTeoZoneUpdate(d *schema.ResourceData, meta interface{})
 			return resource.RetryableError(fmt.Errorf("zone status is %v, retry...", *instance.Status))
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -399,37 +405,37 @@ func resourceTencentCloudTeoZoneUpdate(d *schema.ResourceData, meta interface{})
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("teo", "zone", "", zoneId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTeoZoneRead(d, meta)
+	return resourceTencentCloudTeoZoneRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTeoZoneDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoZoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_zone.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
 	zoneId := d.Id()
 
 	instance, err := service.DescribeTeoZone(ctx, zoneId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !*instance.Paused {
 		err := service.ModifyZoneStatus(ctx, zoneId, true, "delete")
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if err = service.DeleteTeoZoneById(ctx, zoneId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -123,10 +123,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	teo "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/teo/v20220901"
@@ -135,12 +135,12 @@ import (
 
 func resourceTencentCloudTeoZoneSetting() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTeoZoneSettingRead,
-		Create: resourceTencentCloudTeoZoneSettingCreate,
-		Update: resourceTencentCloudTeoZoneSettingUpdate,
-		Delete: resourceTencentCloudTeoZoneSettingDelete,
+		ReadContext:   resourceTencentCloudTeoZoneSettingRead,
+		CreateContext: resourceTencentCloudTeoZoneSettingCreate,
+		UpdateContext: resourceTencentCloudTeoZoneSettingUpdate,
+		DeleteContext: resourceTencentCloudTeoZoneSettingDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
@@ -629,7 +629,7 @@ func resourceTencentCloudTeoZoneSetting() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTeoZoneSettingCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoZoneSettingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_zone_setting.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -640,15 +640,15 @@ func resourceTencentCloudTeoZoneSettingCreate(d *schema.ResourceData, meta inter
 
 	d.SetId(zoneId)
 
-	return resourceTencentCloudTeoZoneSettingUpdate(d, meta)
+	return resourceTencentCloudTeoZoneSettingUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudTeoZoneSettingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoZoneSettingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_zone_setting.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TeoService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -657,12 +657,12 @@ func resourceTencentCloudTeoZoneSettingRead(d *schema.ResourceData, meta interfa
 	zoneSetting, err := service.DescribeTeoZoneSetting(ctx, zoneId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if zoneSetting == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `zoneSetting` %s does not exist", zoneId)
+		return diag.Errorf("resource `zoneSetting` %s does not exist", zoneId)
 	}
 
 	_ = d.Set("zone_id", zoneId)
@@ -914,7 +914,7 @@ func resourceTencentCloudTeoZoneSettingRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudTeoZoneSettingUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoZoneSettingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_zone_setting.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -1208,8 +1208,8 @@ func resourceTencentCloudTeoZoneSettingUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	err := resource.Retry(15*time.Second, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyZoneSetting(request)
+	err := retryContext(ctx, 15*time.Second, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTeoClient().ModifyZoneSettingWithContext(ctx, request)
 		if e != nil {
 			return retryError(e, "InvalidParameter.ZoneNotFound")
 		} else {
@@ -1221,12 +1221,12 @@ func resourceTencentCloudTeoZoneSettingUpdate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s read teo zoneSetting failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceTencentCloudTeoZoneSettingRead(d, meta)
+	return resourceTencentCloudTeoZoneSettingRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTeoZoneSettingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTeoZoneSettingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_teo_zone_setting.delete")()
 	defer inconsistentCheck(d, meta)()
 
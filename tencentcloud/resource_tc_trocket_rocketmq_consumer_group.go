@@ -39,10 +39,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	trocket "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/trocket/v20230308"
@@ -51,12 +51,16 @@ import (
 
 func resourceTencentCloudTrocketRocketmqConsumerGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTrocketRocketmqConsumerGroupCreate,
-		Read:   resourceTencentCloudTrocketRocketmqConsumerGroupRead,
-		Update: resourceTencentCloudTrocketRocketmqConsumerGroupUpdate,
-		Delete: resourceTencentCloudTrocketRocketmqConsumerGroupDelete,
+		CreateContext: resourceTencentCloudTrocketRocketmqConsumerGroupCreate,
+		ReadContext:   resourceTencentCloudTrocketRocketmqConsumerGroupRead,
+		UpdateContext: resourceTencentCloudTrocketRocketmqConsumerGroupUpdate,
+		DeleteContext: resourceTencentCloudTrocketRocketmqConsumerGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -100,7 +104,7 @@ func resourceTencentCloudTrocketRocketmqConsumerGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTrocketRocketmqConsumerGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTrocketRocketmqConsumerGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_trocket_rocketmq_consumer_group.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -136,8 +140,8 @@ func resourceTencentCloudTrocketRocketmqConsumerGroupCreate(d *schema.ResourceDa
 		request.Remark = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTrocketClient().CreateConsumerGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTrocketClient().CreateConsumerGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -148,36 +152,36 @@ func resourceTencentCloudTrocketRocketmqConsumerGroupCreate(d *schema.ResourceDa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create trocket rocketmqConsumerGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId = *response.Response.InstanceId
 	consumerGroup = *response.Response.ConsumerGroup
-	d.SetId(instanceId + FILED_SP + consumerGroup)
+	d.SetId(helper.IdFormat(instanceId, consumerGroup))
 
-	return resourceTencentCloudTrocketRocketmqConsumerGroupRead(d, meta)
+	return resourceTencentCloudTrocketRocketmqConsumerGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTrocketRocketmqConsumerGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTrocketRocketmqConsumerGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_trocket_rocketmq_consumer_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TrocketService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	consumerGroup := idSplit[1]
 
 	rocketmqConsumerGroup, err := service.DescribeTrocketRocketmqConsumerGroupById(ctx, instanceId, consumerGroup)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if rocketmqConsumerGroup == nil {
@@ -208,7 +212,7 @@ func resourceTencentCloudTrocketRocketmqConsumerGroupRead(d *schema.ResourceData
 	return nil
 }
 
-func resourceTencentCloudTrocketRocketmqConsumerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTrocketRocketmqConsumerGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_trocket_rocketmq_consumer_group.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -216,9 +220,9 @@ func resourceTencentCloudTrocketRocketmqConsumerGroupUpdate(d *schema.ResourceDa
 
 	request := trocket.NewModifyConsumerGroupRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	consumerGroup := idSplit[1]
@@ -253,8 +257,8 @@ func resourceTencentCloudTrocketRocketmqConsumerGroupUpdate(d *schema.ResourceDa
 			request.Remark = helper.String(v.(string))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTrocketClient().ModifyConsumerGroup(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTrocketClient().ModifyConsumerGroupWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -264,30 +268,30 @@ func resourceTencentCloudTrocketRocketmqConsumerGroupUpdate(d *schema.ResourceDa
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update trocket rocketmqConsumerGroup failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTrocketRocketmqConsumerGroupRead(d, meta)
+	return resourceTencentCloudTrocketRocketmqConsumerGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTrocketRocketmqConsumerGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTrocketRocketmqConsumerGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_trocket_rocketmq_consumer_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TrocketService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	consumerGroup := idSplit[1]
 
 	if err := service.DeleteTrocketRocketmqConsumerGroupById(ctx, instanceId, consumerGroup); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
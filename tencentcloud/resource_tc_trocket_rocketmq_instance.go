@@ -55,6 +55,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -64,12 +65,17 @@ import (
 
 func resourceTencentCloudTrocketRocketmqInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTrocketRocketmqInstanceCreate,
-		Read:   resourceTencentCloudTrocketRocketmqInstanceRead,
-		Update: resourceTencentCloudTrocketRocketmqInstanceUpdate,
-		Delete: resourceTencentCloudTrocketRocketmqInstanceDelete,
+		CreateContext: resourceTencentCloudTrocketRocketmqInstanceCreate,
+		ReadContext:   resourceTencentCloudTrocketRocketmqInstanceRead,
+		UpdateContext: resourceTencentCloudTrocketRocketmqInstanceUpdate,
+		DeleteContext: resourceTencentCloudTrocketRocketmqInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_type": {
@@ -176,12 +182,12 @@ func resourceTencentCloudTrocketRocketmqInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTrocketRocketmqInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTrocketRocketmqInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_trocket_rocketmq_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	var (
 		request      = trocket.NewCreateInstanceRequest()
 		response     = trocket.NewCreateInstanceResponse()
@@ -223,7 +229,7 @@ func resourceTencentCloudTrocketRocketmqInstanceCreate(d *schema.ResourceData, m
 	}
 
 	if enablePublic && bandwidth <= 0 {
-		return fmt.Errorf("`bandwidth` must be greater than zero when `enable_public` equal true.")
+		return diag.Errorf("`bandwidth` must be greater than zero when `enable_public` equal true.")
 	}
 	if v, ok := d.GetOk("ip_rules"); ok {
 		for _, item := range v.([]interface{}) {
@@ -246,8 +252,8 @@ func resourceTencentCloudTrocketRocketmqInstanceCreate(d *schema.ResourceData, m
 		request.MessageRetention = helper.IntInt64(v.(int))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTrocketClient().CreateInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTrocketClient().CreateInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -258,14 +264,14 @@ func resourceTencentCloudTrocketRocketmqInstanceCreate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create trocket rocketmqInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 	instanceId = *response.Response.InstanceId
 
 	service := TrocketService{client: meta.(*TencentCloudClient).apiV3Conn}
-	conf := BuildStateChangeConf([]string{}, []string{"RUNNING"}, 10*readRetryTimeout, time.Second, service.TrocketRocketmqInstanceStateRefreshFunc(instanceId, []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"RUNNING"}, d.Timeout(schema.TimeoutCreate), time.Second, service.TrocketRocketmqInstanceStateRefreshFunc(instanceId, []string{}))
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
@@ -273,22 +279,22 @@ func resourceTencentCloudTrocketRocketmqInstanceCreate(d *schema.ResourceData, m
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::trocket:%s:uin/:instance/%s", region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(instanceId)
 
-	return resourceTencentCloudTrocketRocketmqInstanceRead(d, meta)
+	return resourceTencentCloudTrocketRocketmqInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTrocketRocketmqInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTrocketRocketmqInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_trocket_rocketmq_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TrocketService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -296,7 +302,7 @@ func resourceTencentCloudTrocketRocketmqInstanceRead(d *schema.ResourceData, met
 
 	rocketmqInstance, err := service.DescribeTrocketRocketmqInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if rocketmqInstance == nil {
@@ -367,7 +373,7 @@ func resourceTencentCloudTrocketRocketmqInstanceRead(d *schema.ResourceData, met
 	tagService := &TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 	tags, err := tagService.DescribeResourceTags(ctx, "trocket", "instance", tcClient.Region, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_ = d.Set("tags", tags)
@@ -375,12 +381,12 @@ func resourceTencentCloudTrocketRocketmqInstanceRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudTrocketRocketmqInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTrocketRocketmqInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_trocket_rocketmq_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	request := trocket.NewModifyInstanceRequest()
 
 	instanceId := d.Id()
@@ -391,7 +397,7 @@ func resourceTencentCloudTrocketRocketmqInstanceUpdate(d *schema.ResourceData, m
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -419,8 +425,8 @@ func resourceTencentCloudTrocketRocketmqInstanceUpdate(d *schema.ResourceData, m
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTrocketClient().ModifyInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTrocketClient().ModifyInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -430,13 +436,13 @@ func resourceTencentCloudTrocketRocketmqInstanceUpdate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update trocket rocketmqInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	service := TrocketService{client: meta.(*TencentCloudClient).apiV3Conn}
-	conf := BuildStateChangeConf([]string{}, []string{"RUNNING"}, 10*readRetryTimeout, time.Second, service.TrocketRocketmqInstanceStateRefreshFunc(instanceId, []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"RUNNING"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.TrocketRocketmqInstanceStateRefreshFunc(instanceId, []string{}))
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	if d.HasChange("tags") {
@@ -446,26 +452,26 @@ func resourceTencentCloudTrocketRocketmqInstanceUpdate(d *schema.ResourceData, m
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("trocket", "instance", tcClient.Region, instanceId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudTrocketRocketmqInstanceRead(d, meta)
+	return resourceTencentCloudTrocketRocketmqInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTrocketRocketmqInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTrocketRocketmqInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_trocket_rocketmq_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TrocketService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instanceId := d.Id()
 
 	if err := service.DeleteTrocketRocketmqInstanceById(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	conf := BuildStateChangeConf([]string{}, []string{""}, 10*readRetryTimeout, time.Second, service.TrocketRocketmqInstanceStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{""}, d.Timeout(schema.TimeoutDelete), time.Second, service.TrocketRocketmqInstanceStateRefreshFunc(d.Id(), []string{}))
 
 	if _, err := conf.WaitForState(); err != nil {
 		if sdkerr, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -473,7 +479,7 @@ func resourceTencentCloudTrocketRocketmqInstanceDelete(d *schema.ResourceData, m
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
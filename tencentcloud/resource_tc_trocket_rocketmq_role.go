@@ -46,10 +46,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	trocket "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/trocket/v20230308"
@@ -58,12 +58,16 @@ import (
 
 func resourceTencentCloudTrocketRocketmqRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTrocketRocketmqRoleCreate,
-		Read:   resourceTencentCloudTrocketRocketmqRoleRead,
-		Update: resourceTencentCloudTrocketRocketmqRoleUpdate,
-		Delete: resourceTencentCloudTrocketRocketmqRoleDelete,
+		CreateContext: resourceTencentCloudTrocketRocketmqRoleCreate,
+		ReadContext:   resourceTencentCloudTrocketRocketmqRoleRead,
+		UpdateContext: resourceTencentCloudTrocketRocketmqRoleUpdate,
+		DeleteContext: resourceTencentCloudTrocketRocketmqRoleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -125,7 +129,7 @@ func resourceTencentCloudTrocketRocketmqRole() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTrocketRocketmqRoleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTrocketRocketmqRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_trocket_rocketmq_role.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -158,8 +162,8 @@ func resourceTencentCloudTrocketRocketmqRoleCreate(d *schema.ResourceData, meta
 		request.PermRead = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTrocketClient().CreateRole(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTrocketClient().CreateRoleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -170,35 +174,35 @@ func resourceTencentCloudTrocketRocketmqRoleCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create trocket rocketmqRole failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	role = *response.Response.Role
-	d.SetId(instanceId + FILED_SP + role)
+	d.SetId(helper.IdFormat(instanceId, role))
 
-	return resourceTencentCloudTrocketRocketmqRoleRead(d, meta)
+	return resourceTencentCloudTrocketRocketmqRoleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTrocketRocketmqRoleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTrocketRocketmqRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_trocket_rocketmq_role.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TrocketService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	role := idSplit[1]
 
 	rocketmqRole, err := service.DescribeTrocketRocketmqRoleById(ctx, instanceId, role)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if rocketmqRole == nil {
@@ -241,7 +245,7 @@ func resourceTencentCloudTrocketRocketmqRoleRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTencentCloudTrocketRocketmqRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTrocketRocketmqRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_trocket_rocketmq_role.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -249,9 +253,9 @@ func resourceTencentCloudTrocketRocketmqRoleUpdate(d *schema.ResourceData, meta
 
 	request := trocket.NewModifyRoleRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	role := idSplit[1]
@@ -281,8 +285,8 @@ func resourceTencentCloudTrocketRocketmqRoleUpdate(d *schema.ResourceData, meta
 			request.PermRead = helper.Bool(v.(bool))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTrocketClient().ModifyRole(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTrocketClient().ModifyRoleWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -292,29 +296,29 @@ func resourceTencentCloudTrocketRocketmqRoleUpdate(d *schema.ResourceData, meta
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update trocket rocketmqRole failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudTrocketRocketmqRoleRead(d, meta)
+	return resourceTencentCloudTrocketRocketmqRoleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTrocketRocketmqRoleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTrocketRocketmqRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_trocket_rocketmq_role.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TrocketService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	role := idSplit[1]
 
 	if err := service.DeleteTrocketRocketmqRoleById(ctx, instanceId, role); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -38,10 +38,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	trocket "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/trocket/v20230308"
@@ -50,12 +50,16 @@ import (
 
 func resourceTencentCloudTrocketRocketmqTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTrocketRocketmqTopicCreate,
-		Read:   resourceTencentCloudTrocketRocketmqTopicRead,
-		Update: resourceTencentCloudTrocketRocketmqTopicUpdate,
-		Delete: resourceTencentCloudTrocketRocketmqTopicDelete,
+		CreateContext: resourceTencentCloudTrocketRocketmqTopicCreate,
+		ReadContext:   resourceTencentCloudTrocketRocketmqTopicRead,
+		UpdateContext: resourceTencentCloudTrocketRocketmqTopicUpdate,
+		DeleteContext: resourceTencentCloudTrocketRocketmqTopicDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -94,7 +98,7 @@ func resourceTencentCloudTrocketRocketmqTopic() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTrocketRocketmqTopicCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTrocketRocketmqTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_trocket_rocketmq_topic.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -126,8 +130,8 @@ func resourceTencentCloudTrocketRocketmqTopicCreate(d *schema.ResourceData, meta
 		request.Remark = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTrocketClient().CreateTopic(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTrocketClient().CreateTopicWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -138,36 +142,36 @@ func resourceTencentCloudTrocketRocketmqTopicCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create trocket rocketmqTopic failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId = *response.Response.InstanceId
 	topic = *response.Response.Topic
-	d.SetId(instanceId + FILED_SP + topic)
+	d.SetId(helper.IdFormat(instanceId, topic))
 
-	return resourceTencentCloudTrocketRocketmqTopicRead(d, meta)
+	return resourceTencentCloudTrocketRocketmqTopicRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTrocketRocketmqTopicRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTrocketRocketmqTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_trocket_rocketmq_topic.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TrocketService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	topic := idSplit[1]
 
 	rocketmqTopic, err := service.DescribeTrocketRocketmqTopicById(ctx, instanceId, topic)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if rocketmqTopic == nil {
@@ -199,7 +203,7 @@ func resourceTencentCloudTrocketRocketmqTopicRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudTrocketRocketmqTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTrocketRocketmqTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_trocket_rocketmq_topic.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -207,9 +211,9 @@ func resourceTencentCloudTrocketRocketmqTopicUpdate(d *schema.ResourceData, meta
 
 	request := trocket.NewModifyTopicRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	instanceId := idSplit[0]
 	topic := idSplit[1]
@@ -229,8 +233,8 @@ func resourceTencentCloudTrocketRocketmqTopicUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTrocketClient().ModifyTopic(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTrocketClient().ModifyTopicWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -240,29 +244,29 @@ func resourceTencentCloudTrocketRocketmqTopicUpdate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update trocket rocketmqTopic failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTrocketRocketmqTopicRead(d, meta)
+	return resourceTencentCloudTrocketRocketmqTopicRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTrocketRocketmqTopicDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTrocketRocketmqTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_trocket_rocketmq_topic.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TrocketService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	instanceId := idSplit[0]
 	topic := idSplit[1]
 
 	if err := service.DeleteTrocketRocketmqTopicById(ctx, instanceId, topic); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -76,8 +76,9 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -86,12 +87,16 @@ import (
 
 func resourceTencentCloudTseCngwCanaryRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseCngwCanaryRuleCreate,
-		Read:   resourceTencentCloudTseCngwCanaryRuleRead,
-		Update: resourceTencentCloudTseCngwCanaryRuleUpdate,
-		Delete: resourceTencentCloudTseCngwCanaryRuleDelete,
+		CreateContext: resourceTencentCloudTseCngwCanaryRuleCreate,
+		ReadContext:   resourceTencentCloudTseCngwCanaryRuleRead,
+		UpdateContext: resourceTencentCloudTseCngwCanaryRuleUpdate,
+		DeleteContext: resourceTencentCloudTseCngwCanaryRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
@@ -220,7 +225,7 @@ func resourceTencentCloudTseCngwCanaryRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTseCngwCanaryRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwCanaryRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_canary_rule.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -304,8 +309,8 @@ func resourceTencentCloudTseCngwCanaryRuleCreate(d *schema.ResourceData, meta in
 		request.CanaryRule = &cloudNativeAPIGatewayCanaryRule
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().CreateCloudNativeAPIGatewayCanaryRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().CreateCloudNativeAPIGatewayCanaryRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -315,36 +320,36 @@ func resourceTencentCloudTseCngwCanaryRuleCreate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tse cngwCanaryRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(gatewayId + FILED_SP + serviceId + FILED_SP + strconv.Itoa(priority))
+	d.SetId(helper.IdFormat(gatewayId, serviceId, strconv.Itoa(priority)))
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::tse:%s:uin/:cngw_canary_rule/%s", region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTseCngwCanaryRuleRead(d, meta)
+	return resourceTencentCloudTseCngwCanaryRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTseCngwCanaryRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwCanaryRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_canary_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	gatewayId := idSplit[0]
 	serviceId := idSplit[1]
@@ -352,7 +357,7 @@ func resourceTencentCloudTseCngwCanaryRuleRead(d *schema.ResourceData, meta inte
 
 	cngwCanaryRule, err := service.DescribeTseCngwCanaryRuleById(ctx, gatewayId, serviceId, priority)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if cngwCanaryRule == nil {
@@ -456,14 +461,14 @@ func resourceTencentCloudTseCngwCanaryRuleRead(d *schema.ResourceData, meta inte
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "tse", "cngw_canary_rule", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudTseCngwCanaryRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwCanaryRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_canary_rule.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -471,9 +476,9 @@ func resourceTencentCloudTseCngwCanaryRuleUpdate(d *schema.ResourceData, meta in
 
 	request := tse.NewModifyCloudNativeAPIGatewayCanaryRuleRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	gatewayId := idSplit[0]
 	serviceId := idSplit[1]
@@ -481,7 +486,7 @@ func resourceTencentCloudTseCngwCanaryRuleUpdate(d *schema.ResourceData, meta in
 
 	priorityInt64, err := strconv.ParseInt(priority, 10, 64)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	request.GatewayId = &gatewayId
@@ -491,7 +496,7 @@ func resourceTencentCloudTseCngwCanaryRuleUpdate(d *schema.ResourceData, meta in
 	immutableArgs := []string{"gateway_id", "service_id"}
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -556,8 +561,8 @@ func resourceTencentCloudTseCngwCanaryRuleUpdate(d *schema.ResourceData, meta in
 		request.CanaryRule = &cloudNativeAPIGatewayCanaryRule
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().ModifyCloudNativeAPIGatewayCanaryRule(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().ModifyCloudNativeAPIGatewayCanaryRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -567,42 +572,42 @@ func resourceTencentCloudTseCngwCanaryRuleUpdate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tse cngwCanaryRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		oldTags, newTags := d.GetChange("tags")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("tse", "cngw_canary_rule", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTseCngwCanaryRuleRead(d, meta)
+	return resourceTencentCloudTseCngwCanaryRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTseCngwCanaryRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwCanaryRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_canary_rule.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	gatewayId := idSplit[0]
 	serviceId := idSplit[1]
 	priority := idSplit[2]
 
 	if err := service.DeleteTseCngwCanaryRuleById(ctx, gatewayId, serviceId, priority); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -26,10 +26,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -38,12 +38,16 @@ import (
 
 func resourceTencentCloudTseCngwCertificate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseCngwCertificateCreate,
-		Read:   resourceTencentCloudTseCngwCertificateRead,
-		Update: resourceTencentCloudTseCngwCertificateUpdate,
-		Delete: resourceTencentCloudTseCngwCertificateDelete,
+		CreateContext: resourceTencentCloudTseCngwCertificateCreate,
+		ReadContext:   resourceTencentCloudTseCngwCertificateRead,
+		UpdateContext: resourceTencentCloudTseCngwCertificateUpdate,
+		DeleteContext: resourceTencentCloudTseCngwCertificateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
@@ -90,7 +94,7 @@ func resourceTencentCloudTseCngwCertificate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTseCngwCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_certificate.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -123,8 +127,8 @@ func resourceTencentCloudTseCngwCertificateCreate(d *schema.ResourceData, meta i
 		request.Name = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().CreateCloudNativeAPIGatewayCertificate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().CreateCloudNativeAPIGatewayCertificateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -135,35 +139,35 @@ func resourceTencentCloudTseCngwCertificateCreate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tse cngwCertificate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	certificateId = *response.Response.Result.Id
-	d.SetId(gatewayId + FILED_SP + certificateId)
+	d.SetId(helper.IdFormat(gatewayId, certificateId))
 
-	return resourceTencentCloudTseCngwCertificateRead(d, meta)
+	return resourceTencentCloudTseCngwCertificateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTseCngwCertificateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_certificate.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	gatewayId := idSplit[0]
 	certificateId := idSplit[1]
 
 	cngwCertificate, err := service.DescribeTseCngwCertificateById(ctx, gatewayId, certificateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if cngwCertificate == nil {
@@ -197,7 +201,7 @@ func resourceTencentCloudTseCngwCertificateRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudTseCngwCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_certificate.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -205,9 +209,9 @@ func resourceTencentCloudTseCngwCertificateUpdate(d *schema.ResourceData, meta i
 
 	request := tse.NewUpdateCloudNativeAPIGatewayCertificateInfoRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	gatewayId := idSplit[0]
 	certificateId := idSplit[1]
@@ -229,8 +233,8 @@ func resourceTencentCloudTseCngwCertificateUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().UpdateCloudNativeAPIGatewayCertificateInfo(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().UpdateCloudNativeAPIGatewayCertificateInfoWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -240,29 +244,29 @@ func resourceTencentCloudTseCngwCertificateUpdate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tse cngwCertificate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTseCngwCertificateRead(d, meta)
+	return resourceTencentCloudTseCngwCertificateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTseCngwCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_certificate.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	gatewayId := idSplit[0]
 	certificateId := idSplit[1]
 
 	if err := service.DeleteTseCngwCertificateById(ctx, gatewayId, certificateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
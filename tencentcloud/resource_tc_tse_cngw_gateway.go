@@ -54,7 +54,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -63,12 +65,16 @@ import (
 
 func resourceTencentCloudTseCngwGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseCngwGatewayCreate,
-		Read:   resourceTencentCloudTseCngwGatewayRead,
-		Update: resourceTencentCloudTseCngwGatewayUpdate,
-		Delete: resourceTencentCloudTseCngwGatewayDelete,
+		CreateContext: resourceTencentCloudTseCngwGatewayCreate,
+		ReadContext:   resourceTencentCloudTseCngwGatewayRead,
+		UpdateContext: resourceTencentCloudTseCngwGatewayUpdate,
+		DeleteContext: resourceTencentCloudTseCngwGatewayDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -236,12 +242,12 @@ func resourceTencentCloudTseCngwGateway() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTseCngwGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_gateway.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request   = tse.NewCreateCloudNativeAPIGatewayRequest()
@@ -339,8 +345,8 @@ func resourceTencentCloudTseCngwGatewayCreate(d *schema.ResourceData, meta inter
 		request.InternetConfig = &internetConfig
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().CreateCloudNativeAPIGateway(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().CreateCloudNativeAPIGatewayWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -351,7 +357,7 @@ func resourceTencentCloudTseCngwGatewayCreate(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tse cngwGateway failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	gatewayId = *response.Response.Result.GatewayId
@@ -359,7 +365,7 @@ func resourceTencentCloudTseCngwGatewayCreate(d *schema.ResourceData, meta inter
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 	if err := service.CheckTseNativeAPIGatewayStatusById(ctx, gatewayId, "create"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
@@ -367,20 +373,20 @@ func resourceTencentCloudTseCngwGatewayCreate(d *schema.ResourceData, meta inter
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::tse:%s:uin/:gateway/%s", region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTseCngwGatewayRead(d, meta)
+	return resourceTencentCloudTseCngwGatewayRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTseCngwGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_gateway.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -388,7 +394,7 @@ func resourceTencentCloudTseCngwGatewayRead(d *schema.ResourceData, meta interfa
 
 	cngwGateway, err := service.DescribeTseCngwGatewayById(ctx, gatewayId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if cngwGateway == nil {
@@ -469,19 +475,19 @@ func resourceTencentCloudTseCngwGatewayRead(d *schema.ResourceData, meta interfa
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "tse", "gateway", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudTseCngwGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_gateway.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := tse.NewModifyCloudNativeAPIGatewayRequest()
 
@@ -492,7 +498,7 @@ func resourceTencentCloudTseCngwGatewayUpdate(d *schema.ResourceData, meta inter
 	immutableArgs := []string{"type", "gateway_version", "vpc_config", "feature_version", "internet_max_bandwidth_out", "engine_region", "ingress_class_name", "trade_type", "internet_config"}
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -519,8 +525,8 @@ func resourceTencentCloudTseCngwGatewayUpdate(d *schema.ResourceData, meta inter
 	}
 
 	if changeFlag {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().ModifyCloudNativeAPIGateway(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().ModifyCloudNativeAPIGatewayWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -530,12 +536,12 @@ func resourceTencentCloudTseCngwGatewayUpdate(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update tse cngwGateway failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 		if err := service.CheckTseNativeAPIGatewayStatusById(ctx, gatewayId, "update"); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -546,10 +552,10 @@ func resourceTencentCloudTseCngwGatewayUpdate(d *schema.ResourceData, meta inter
 		service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 		cngwGroup, err := service.DescribeTseGroupsByFilter(ctx, paramMap)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if len(cngwGroup.GatewayGroupList) < 1 {
-			return fmt.Errorf("[WARN]%s resource `TseCngwGroup` [%s] not found, please check if it has been deleted.\n", logId, gatewayId)
+			return diag.Errorf("[WARN]%s resource `TseCngwGroup` [%s] not found, please check if it has been deleted.\n", logId, gatewayId)
 		}
 		groupId := ""
 		for _, v := range cngwGroup.GatewayGroupList {
@@ -574,8 +580,8 @@ func resourceTencentCloudTseCngwGatewayUpdate(d *schema.ResourceData, meta inter
 			nodeConfigRequest.NodeConfig = &cloudNativeAPIGatewayNodeConfig
 		}
 
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().UpdateCloudNativeAPIGatewaySpec(nodeConfigRequest)
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().UpdateCloudNativeAPIGatewaySpecWithContext(ctx, nodeConfigRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -585,44 +591,44 @@ func resourceTencentCloudTseCngwGatewayUpdate(d *schema.ResourceData, meta inter
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update tse cngwGateway failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		if err := service.CheckTseNativeAPIGatewayGroupStatusById(ctx, gatewayId, groupId, "update"); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("tags") {
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		oldTags, newTags := d.GetChange("tags")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("tse", "gateway", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTseCngwGatewayRead(d, meta)
+	return resourceTencentCloudTseCngwGatewayRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTseCngwGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_gateway.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 	gatewayId := d.Id()
 
 	if err := service.DeleteTseCngwGatewayById(ctx, gatewayId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := service.CheckTseNativeAPIGatewayStatusById(ctx, gatewayId, "delete"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
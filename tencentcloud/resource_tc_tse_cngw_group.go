@@ -64,10 +64,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -76,12 +76,16 @@ import (
 
 func resourceTencentCloudTseCngwGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseCngwGroupCreate,
-		Read:   resourceTencentCloudTseCngwGroupRead,
-		Update: resourceTencentCloudTseCngwGroupUpdate,
-		Delete: resourceTencentCloudTseCngwGroupDelete,
+		CreateContext: resourceTencentCloudTseCngwGroupCreate,
+		ReadContext:   resourceTencentCloudTseCngwGroupRead,
+		UpdateContext: resourceTencentCloudTseCngwGroupUpdate,
+		DeleteContext: resourceTencentCloudTseCngwGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
@@ -189,12 +193,12 @@ func resourceTencentCloudTseCngwGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTseCngwGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_group.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request   = tse.NewCreateNativeGatewayServerGroupRequest()
@@ -263,8 +267,8 @@ func resourceTencentCloudTseCngwGroupCreate(d *schema.ResourceData, meta interfa
 		request.InternetConfig = &internetConfig
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().CreateNativeGatewayServerGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().CreateNativeGatewayServerGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -275,40 +279,40 @@ func resourceTencentCloudTseCngwGroupCreate(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tse cngwGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	groupId = *response.Response.Result.GroupId
-	d.SetId(gatewayId + FILED_SP + groupId)
+	d.SetId(helper.IdFormat(gatewayId, groupId))
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 	if err := service.CheckTseNativeAPIGatewayGroupStatusById(ctx, gatewayId, groupId, "create"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTseCngwGroupRead(d, meta)
+	return resourceTencentCloudTseCngwGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTseCngwGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	gatewayId := idSplit[0]
 	groupId := idSplit[1]
 
 	cngwGroup, err := service.DescribeTseCngwGroupById(ctx, gatewayId, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if cngwGroup == nil {
@@ -392,18 +396,18 @@ func resourceTencentCloudTseCngwGroupRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudTseCngwGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_group.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := tse.NewModifyNativeGatewayServerGroupRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	gatewayId := idSplit[0]
 	groupId := idSplit[1]
@@ -415,7 +419,7 @@ func resourceTencentCloudTseCngwGroupUpdate(d *schema.ResourceData, meta interfa
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -431,8 +435,8 @@ func resourceTencentCloudTseCngwGroupUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().ModifyNativeGatewayServerGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().ModifyNativeGatewayServerGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -442,37 +446,37 @@ func resourceTencentCloudTseCngwGroupUpdate(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tse cngwGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 	if err := service.CheckTseNativeAPIGatewayGroupStatusById(ctx, gatewayId, groupId, "update"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTseCngwGroupRead(d, meta)
+	return resourceTencentCloudTseCngwGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTseCngwGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	gatewayId := idSplit[0]
 	groupId := idSplit[1]
 
 	if err := service.DeleteTseCngwGroupById(ctx, gatewayId, groupId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := service.CheckTseNativeAPIGatewayGroupStatusById(ctx, gatewayId, groupId, "delete"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
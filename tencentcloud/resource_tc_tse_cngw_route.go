@@ -95,10 +95,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -107,12 +107,16 @@ import (
 
 func resourceTencentCloudTseCngwRoute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseCngwRouteCreate,
-		Read:   resourceTencentCloudTseCngwRouteRead,
-		Update: resourceTencentCloudTseCngwRouteUpdate,
-		Delete: resourceTencentCloudTseCngwRouteDelete,
+		CreateContext: resourceTencentCloudTseCngwRouteCreate,
+		ReadContext:   resourceTencentCloudTseCngwRouteRead,
+		UpdateContext: resourceTencentCloudTseCngwRouteUpdate,
+		DeleteContext: resourceTencentCloudTseCngwRouteDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
@@ -232,7 +236,7 @@ func resourceTencentCloudTseCngwRoute() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTseCngwRouteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_route.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -329,8 +333,8 @@ func resourceTencentCloudTseCngwRouteCreate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().CreateCloudNativeAPIGatewayRoute(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().CreateCloudNativeAPIGatewayRouteWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -340,27 +344,27 @@ func resourceTencentCloudTseCngwRouteCreate(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tse cngwRoute failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(gatewayId + FILED_SP + serviceID + FILED_SP + routeName)
+	d.SetId(helper.IdFormat(gatewayId, serviceID, routeName))
 
-	return resourceTencentCloudTseCngwRouteRead(d, meta)
+	return resourceTencentCloudTseCngwRouteRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTseCngwRouteRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_route.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	gatewayId := idSplit[0]
 	serviceID := idSplit[1]
@@ -368,7 +372,7 @@ func resourceTencentCloudTseCngwRouteRead(d *schema.ResourceData, meta interface
 
 	cngwRoute, err := service.DescribeTseCngwRouteById(ctx, gatewayId, serviceID, routeName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if cngwRoute == nil {
@@ -444,18 +448,18 @@ func resourceTencentCloudTseCngwRouteRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudTseCngwRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_route.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := tse.NewModifyCloudNativeAPIGatewayRouteRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	gatewayId := idSplit[0]
 	serviceID := idSplit[1]
@@ -464,11 +468,11 @@ func resourceTencentCloudTseCngwRouteUpdate(d *schema.ResourceData, meta interfa
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 	cngwRoute, err := service.DescribeTseCngwRouteById(ctx, gatewayId, serviceID, routeName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if cngwRoute == nil {
-		return fmt.Errorf("The result of querying %s is empty", routeName)
+		return diag.Errorf("The result of querying %s is empty", routeName)
 	}
 
 	request.GatewayId = &gatewayId
@@ -480,7 +484,7 @@ func resourceTencentCloudTseCngwRouteUpdate(d *schema.ResourceData, meta interfa
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -568,8 +572,8 @@ func resourceTencentCloudTseCngwRouteUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().ModifyCloudNativeAPIGatewayRoute(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().ModifyCloudNativeAPIGatewayRouteWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -579,30 +583,30 @@ func resourceTencentCloudTseCngwRouteUpdate(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tse cngwRoute failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTseCngwRouteRead(d, meta)
+	return resourceTencentCloudTseCngwRouteRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTseCngwRouteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_route.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	gatewayId := idSplit[0]
 	// serviceID := idSplit[1]
 	routeName := idSplit[2]
 
 	if err := service.DeleteTseCngwRouteById(ctx, gatewayId, routeName); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
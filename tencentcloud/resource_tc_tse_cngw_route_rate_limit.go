@@ -124,10 +124,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -136,12 +136,16 @@ import (
 
 func resourceTencentCloudTseCngwRouteRateLimit() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseCngwRouteRateLimitCreate,
-		Read:   resourceTencentCloudTseCngwRouteRateLimitRead,
-		Update: resourceTencentCloudTseCngwRouteRateLimitUpdate,
-		Delete: resourceTencentCloudTseCngwRouteRateLimitDelete,
+		CreateContext: resourceTencentCloudTseCngwRouteRateLimitCreate,
+		ReadContext:   resourceTencentCloudTseCngwRouteRateLimitRead,
+		UpdateContext: resourceTencentCloudTseCngwRouteRateLimitUpdate,
+		DeleteContext: resourceTencentCloudTseCngwRouteRateLimitDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
@@ -308,7 +312,7 @@ func resourceTencentCloudTseCngwRouteRateLimit() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTseCngwRouteRateLimitCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwRouteRateLimitCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_route_rate_limit.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -416,8 +420,8 @@ func resourceTencentCloudTseCngwRouteRateLimitCreate(d *schema.ResourceData, met
 		request.LimitDetail = &cloudNativeAPIGatewayRateLimitDetail
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().CreateCloudNativeAPIGatewayRouteRateLimit(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().CreateCloudNativeAPIGatewayRouteRateLimitWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -427,33 +431,33 @@ func resourceTencentCloudTseCngwRouteRateLimitCreate(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tse cngwRouteRateLimit failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(gatewayId + FILED_SP + routeId)
+	d.SetId(helper.IdFormat(gatewayId, routeId))
 
-	return resourceTencentCloudTseCngwRouteRateLimitRead(d, meta)
+	return resourceTencentCloudTseCngwRouteRateLimitRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTseCngwRouteRateLimitRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwRouteRateLimitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_route_rate_limit.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	gatewayId := idSplit[0]
 	routeId := idSplit[1]
 
 	cngwRouteRateLimit, err := service.DescribeTseCngwRouteRateLimitById(ctx, gatewayId, routeId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if cngwRouteRateLimit == nil {
@@ -588,7 +592,7 @@ func resourceTencentCloudTseCngwRouteRateLimitRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudTseCngwRouteRateLimitUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwRouteRateLimitUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_route_rate_limit.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -596,9 +600,9 @@ func resourceTencentCloudTseCngwRouteRateLimitUpdate(d *schema.ResourceData, met
 
 	request := tse.NewModifyCloudNativeAPIGatewayRouteRateLimitRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	gatewayId := idSplit[0]
 	routeId := idSplit[1]
@@ -610,7 +614,7 @@ func resourceTencentCloudTseCngwRouteRateLimitUpdate(d *schema.ResourceData, met
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -703,8 +707,8 @@ func resourceTencentCloudTseCngwRouteRateLimitUpdate(d *schema.ResourceData, met
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().ModifyCloudNativeAPIGatewayRouteRateLimit(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().ModifyCloudNativeAPIGatewayRouteRateLimitWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -714,29 +718,29 @@ func resourceTencentCloudTseCngwRouteRateLimitUpdate(d *schema.ResourceData, met
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tse cngwRouteRateLimit failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTseCngwRouteRateLimitRead(d, meta)
+	return resourceTencentCloudTseCngwRouteRateLimitRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTseCngwRouteRateLimitDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwRouteRateLimitDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_route_rate_limit.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	gatewayId := idSplit[0]
 	routeId := idSplit[1]
 
 	if err := service.DeleteTseCngwRouteRateLimitById(ctx, gatewayId, routeId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
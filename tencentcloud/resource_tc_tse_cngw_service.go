@@ -80,8 +80,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -90,12 +91,16 @@ import (
 
 func resourceTencentCloudTseCngwService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseCngwServiceCreate,
-		Read:   resourceTencentCloudTseCngwServiceRead,
-		Update: resourceTencentCloudTseCngwServiceUpdate,
-		Delete: resourceTencentCloudTseCngwServiceDelete,
+		CreateContext: resourceTencentCloudTseCngwServiceCreate,
+		ReadContext:   resourceTencentCloudTseCngwServiceRead,
+		UpdateContext: resourceTencentCloudTseCngwServiceUpdate,
+		DeleteContext: resourceTencentCloudTseCngwServiceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
@@ -298,7 +303,7 @@ func resourceTencentCloudTseCngwService() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTseCngwServiceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_service.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -417,8 +422,8 @@ func resourceTencentCloudTseCngwServiceCreate(d *schema.ResourceData, meta inter
 		request.UpstreamInfo = &kongUpstreamInfo
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().CreateCloudNativeAPIGatewayService(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().CreateCloudNativeAPIGatewayServiceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -428,42 +433,42 @@ func resourceTencentCloudTseCngwServiceCreate(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tse cngwService failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(gatewayId + FILED_SP + name)
+	d.SetId(helper.IdFormat(gatewayId, name))
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::tse:%s:uin/:cngw_service/%s", region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTseCngwServiceRead(d, meta)
+	return resourceTencentCloudTseCngwServiceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTseCngwServiceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_service.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	gatewayId := idSplit[0]
 	name := idSplit[1]
 	cngwService, err := service.DescribeTseCngwServiceById(ctx, gatewayId, name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if cngwService == nil {
@@ -619,25 +624,25 @@ func resourceTencentCloudTseCngwServiceRead(d *schema.ResourceData, meta interfa
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "tse", "cngw_service", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudTseCngwServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_service.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := tse.NewModifyCloudNativeAPIGatewayServiceRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	gatewayId := idSplit[0]
 	name := idSplit[1]
@@ -647,7 +652,7 @@ func resourceTencentCloudTseCngwServiceUpdate(d *schema.ResourceData, meta inter
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 	cngwService, err := service.DescribeTseCngwServiceById(ctx, gatewayId, name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	serviceId := ""
@@ -657,14 +662,14 @@ func resourceTencentCloudTseCngwServiceUpdate(d *schema.ResourceData, meta inter
 	request.ID = &serviceId
 
 	if cngwService == nil {
-		return fmt.Errorf("[WARN]%s resource `TseCngwService` [%s] not found, please check if it has been deleted.\n", logId, d.Id())
+		return diag.Errorf("[WARN]%s resource `TseCngwService` [%s] not found, please check if it has been deleted.\n", logId, d.Id())
 	}
 
 	immutableArgs := []string{"gateway_id", "name"}
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -766,8 +771,8 @@ func resourceTencentCloudTseCngwServiceUpdate(d *schema.ResourceData, meta inter
 		request.UpstreamInfo = &kongUpstreamInfo
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().ModifyCloudNativeAPIGatewayService(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().ModifyCloudNativeAPIGatewayServiceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -777,7 +782,7 @@ func resourceTencentCloudTseCngwServiceUpdate(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tse cngwService failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
@@ -787,29 +792,29 @@ func resourceTencentCloudTseCngwServiceUpdate(d *schema.ResourceData, meta inter
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("tse", "cngw_service", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTseCngwServiceRead(d, meta)
+	return resourceTencentCloudTseCngwServiceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTseCngwServiceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_service.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	gatewayId := idSplit[0]
 	name := idSplit[1]
 	if err := service.DeleteTseCngwServiceById(ctx, gatewayId, name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -100,10 +100,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -112,12 +112,16 @@ import (
 
 func resourceTencentCloudTseCngwServiceRateLimit() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseCngwServiceRateLimitCreate,
-		Read:   resourceTencentCloudTseCngwServiceRateLimitRead,
-		Update: resourceTencentCloudTseCngwServiceRateLimitUpdate,
-		Delete: resourceTencentCloudTseCngwServiceRateLimitDelete,
+		CreateContext: resourceTencentCloudTseCngwServiceRateLimitCreate,
+		ReadContext:   resourceTencentCloudTseCngwServiceRateLimitRead,
+		UpdateContext: resourceTencentCloudTseCngwServiceRateLimitUpdate,
+		DeleteContext: resourceTencentCloudTseCngwServiceRateLimitDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
@@ -284,7 +288,7 @@ func resourceTencentCloudTseCngwServiceRateLimit() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTseCngwServiceRateLimitCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwServiceRateLimitCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_service_rate_limit.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -392,8 +396,8 @@ func resourceTencentCloudTseCngwServiceRateLimitCreate(d *schema.ResourceData, m
 		request.LimitDetail = &cloudNativeAPIGatewayRateLimitDetail
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().CreateCloudNativeAPIGatewayServiceRateLimit(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().CreateCloudNativeAPIGatewayServiceRateLimitWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -403,33 +407,33 @@ func resourceTencentCloudTseCngwServiceRateLimitCreate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tse cngwServiceRateLimit failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(gatewayId + FILED_SP + name)
+	d.SetId(helper.IdFormat(gatewayId, name))
 
-	return resourceTencentCloudTseCngwServiceRateLimitRead(d, meta)
+	return resourceTencentCloudTseCngwServiceRateLimitRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTseCngwServiceRateLimitRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwServiceRateLimitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_service_rate_limit.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	gatewayId := idSplit[0]
 	name := idSplit[1]
 
 	cngwServiceRateLimit, err := service.DescribeTseCngwServiceRateLimitById(ctx, gatewayId, name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if cngwServiceRateLimit == nil {
@@ -560,14 +564,14 @@ func resourceTencentCloudTseCngwServiceRateLimitRead(d *schema.ResourceData, met
 
 		err = d.Set("limit_detail", []interface{}{limitDetailMap})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceTencentCloudTseCngwServiceRateLimitUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwServiceRateLimitUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_service_rate_limit.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -575,9 +579,9 @@ func resourceTencentCloudTseCngwServiceRateLimitUpdate(d *schema.ResourceData, m
 
 	request := tse.NewModifyCloudNativeAPIGatewayServiceRateLimitRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	gatewayId := idSplit[0]
 	name := idSplit[1]
@@ -589,7 +593,7 @@ func resourceTencentCloudTseCngwServiceRateLimitUpdate(d *schema.ResourceData, m
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -682,8 +686,8 @@ func resourceTencentCloudTseCngwServiceRateLimitUpdate(d *schema.ResourceData, m
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().ModifyCloudNativeAPIGatewayServiceRateLimit(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().ModifyCloudNativeAPIGatewayServiceRateLimitWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -693,29 +697,29 @@ func resourceTencentCloudTseCngwServiceRateLimitUpdate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tse cngwServiceRateLimit failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTseCngwServiceRateLimitRead(d, meta)
+	return resourceTencentCloudTseCngwServiceRateLimitRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTseCngwServiceRateLimitDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseCngwServiceRateLimitDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_cngw_service_rate_limit.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	gatewayId := idSplit[0]
 	name := idSplit[1]
 
 	if err := service.DeleteTseCngwServiceRateLimitById(ctx, gatewayId, name); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
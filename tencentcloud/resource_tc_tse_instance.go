@@ -111,7 +111,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
@@ -120,12 +122,16 @@ import (
 
 func resourceTencentCloudTseInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseInstanceCreate,
-		Read:   resourceTencentCloudTseInstanceRead,
-		Update: resourceTencentCloudTseInstanceUpdate,
-		Delete: resourceTencentCloudTseInstanceDelete,
+		CreateContext: resourceTencentCloudTseInstanceCreate,
+		ReadContext:   resourceTencentCloudTseInstanceRead,
+		UpdateContext: resourceTencentCloudTseInstanceUpdate,
+		DeleteContext: resourceTencentCloudTseInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"engine_type": {
@@ -259,12 +265,12 @@ func resourceTencentCloudTseInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTseInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request    = tse.NewCreateEngineRequest()
@@ -363,8 +369,8 @@ func resourceTencentCloudTseInstanceCreate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().CreateEngine(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().CreateEngineWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -375,7 +381,7 @@ func resourceTencentCloudTseInstanceCreate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tse instance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId = *response.Response.InstanceId
@@ -383,7 +389,7 @@ func resourceTencentCloudTseInstanceCreate(d *schema.ResourceData, meta interfac
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 	if err := service.CheckTseInstanceStatusById(ctx, instanceId, "create"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
@@ -391,19 +397,19 @@ func resourceTencentCloudTseInstanceCreate(d *schema.ResourceData, meta interfac
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::tse:%s:uin/:instance/%s", region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTseInstanceRead(d, meta)
+	return resourceTencentCloudTseInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTseInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -411,7 +417,7 @@ func resourceTencentCloudTseInstanceRead(d *schema.ResourceData, meta interface{
 
 	instance, err := service.DescribeTseInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -531,19 +537,19 @@ func resourceTencentCloudTseInstanceRead(d *schema.ResourceData, meta interface{
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "tse", "instance", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudTseInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := tse.NewUpdateEngineInternetAccessRequest()
 	instanceId := d.Id()
@@ -553,7 +559,7 @@ func resourceTencentCloudTseInstanceUpdate(d *schema.ResourceData, meta interfac
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -567,8 +573,8 @@ func resourceTencentCloudTseInstanceUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().UpdateEngineInternetAccess(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTseClient().UpdateEngineInternetAccessWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -578,44 +584,44 @@ func resourceTencentCloudTseInstanceUpdate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tse instance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 	if err := service.CheckTseInstanceStatusById(ctx, instanceId, "update"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		oldTags, newTags := d.GetChange("tags")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("tse", "instance", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTseInstanceRead(d, meta)
+	return resourceTencentCloudTseInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTseInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTseInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tse_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TseService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instanceId := d.Id()
 
 	if err := service.DeleteTseInstanceById(ctx, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if err := service.CheckTseInstanceStatusById(ctx, instanceId, "delete"); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
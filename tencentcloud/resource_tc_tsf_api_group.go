@@ -30,9 +30,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -41,12 +42,16 @@ import (
 
 func resourceTencentCloudTsfApiGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfApiGroupCreate,
-		Read:   resourceTencentCloudTsfApiGroupRead,
-		Update: resourceTencentCloudTsfApiGroupUpdate,
-		Delete: resourceTencentCloudTsfApiGroupDelete,
+		CreateContext: resourceTencentCloudTsfApiGroupCreate,
+		ReadContext:   resourceTencentCloudTsfApiGroupRead,
+		UpdateContext: resourceTencentCloudTsfApiGroupUpdate,
+		DeleteContext: resourceTencentCloudTsfApiGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -201,7 +206,7 @@ func resourceTencentCloudTsfApiGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfApiGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApiGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_api_group.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -252,8 +257,8 @@ func resourceTencentCloudTsfApiGroupCreate(d *schema.ResourceData, meta interfac
 		request.ServiceNameKeyPosition = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateApiGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateApiGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -264,22 +269,22 @@ func resourceTencentCloudTsfApiGroupCreate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf apiGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	groupId = *response.Response.Result
 	d.SetId(groupId)
 
-	return resourceTencentCloudTsfApiGroupRead(d, meta)
+	return resourceTencentCloudTsfApiGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfApiGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApiGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_api_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -287,7 +292,7 @@ func resourceTencentCloudTsfApiGroupRead(d *schema.ResourceData, meta interface{
 
 	apiGroup, err := service.DescribeTsfApiGroupById(ctx, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if apiGroup == nil {
@@ -404,7 +409,7 @@ func resourceTencentCloudTsfApiGroupRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTencentCloudTsfApiGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApiGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_api_group.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -420,7 +425,7 @@ func resourceTencentCloudTsfApiGroupUpdate(d *schema.ResourceData, meta interfac
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -466,8 +471,8 @@ func resourceTencentCloudTsfApiGroupUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().UpdateApiGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().UpdateApiGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -477,24 +482,24 @@ func resourceTencentCloudTsfApiGroupUpdate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tsf apiGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTsfApiGroupRead(d, meta)
+	return resourceTencentCloudTsfApiGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfApiGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApiGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_api_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 	groupId := d.Id()
 
 	if err := service.DeleteTsfApiGroupById(ctx, groupId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -23,10 +23,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -35,12 +35,16 @@ import (
 
 func resourceTencentCloudTsfApiRateLimitRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfApiRateLimitRuleCreate,
-		Read:   resourceTencentCloudTsfApiRateLimitRuleRead,
-		Update: resourceTencentCloudTsfApiRateLimitRuleUpdate,
-		Delete: resourceTencentCloudTsfApiRateLimitRuleDelete,
+		CreateContext: resourceTencentCloudTsfApiRateLimitRuleCreate,
+		ReadContext:   resourceTencentCloudTsfApiRateLimitRuleRead,
+		UpdateContext: resourceTencentCloudTsfApiRateLimitRuleUpdate,
+		DeleteContext: resourceTencentCloudTsfApiRateLimitRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"api_id": {
@@ -108,7 +112,7 @@ func resourceTencentCloudTsfApiRateLimitRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfApiRateLimitRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApiRateLimitRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_api_rate_limit_rule.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -133,8 +137,8 @@ func resourceTencentCloudTsfApiRateLimitRuleCreate(d *schema.ResourceData, meta
 		request.UsableStatus = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateApiRateLimitRuleWithDetailResp(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateApiRateLimitRuleWithDetailRespWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -145,35 +149,35 @@ func resourceTencentCloudTsfApiRateLimitRuleCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf apiRateLimitRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleId = *response.Response.Result.RuleId
-	d.SetId(apiId + FILED_SP + ruleId)
+	d.SetId(helper.IdFormat(apiId, ruleId))
 
-	return resourceTencentCloudTsfApiRateLimitRuleRead(d, meta)
+	return resourceTencentCloudTsfApiRateLimitRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfApiRateLimitRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApiRateLimitRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_api_rate_limit_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	apiId := idSplit[0]
 	ruleId := idSplit[1]
 
 	apiRateLimitRule, err := service.DescribeTsfApiRateLimitRuleById(ctx, apiId, ruleId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if apiRateLimitRule == nil {
@@ -225,7 +229,7 @@ func resourceTencentCloudTsfApiRateLimitRuleRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTencentCloudTsfApiRateLimitRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApiRateLimitRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_api_rate_limit_rule.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -233,9 +237,9 @@ func resourceTencentCloudTsfApiRateLimitRuleUpdate(d *schema.ResourceData, meta
 
 	request := tsf.NewUpdateApiRateLimitRuleRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	// apiId := idSplit[0]
 	ruleId := idSplit[1]
@@ -246,7 +250,7 @@ func resourceTencentCloudTsfApiRateLimitRuleUpdate(d *schema.ResourceData, meta
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -262,8 +266,8 @@ func resourceTencentCloudTsfApiRateLimitRuleUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().UpdateApiRateLimitRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().UpdateApiRateLimitRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -273,29 +277,29 @@ func resourceTencentCloudTsfApiRateLimitRuleUpdate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tsf apiRateLimitRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTsfApiRateLimitRuleRead(d, meta)
+	return resourceTencentCloudTsfApiRateLimitRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfApiRateLimitRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApiRateLimitRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_api_rate_limit_rule.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	apiId := idSplit[0]
 	ruleId := idSplit[1]
 
 	if err := service.DeleteTsfApiRateLimitRuleById(ctx, apiId, ruleId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -28,9 +28,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -39,10 +40,14 @@ import (
 
 func resourceTencentCloudTsfApplication() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfApplicationCreate,
-		Read:   resourceTencentCloudTsfApplicationRead,
-		Update: resourceTencentCloudTsfApplicationUpdate,
-		Delete: resourceTencentCloudTsfApplicationDelete,
+		CreateContext: resourceTencentCloudTsfApplicationCreate,
+		ReadContext:   resourceTencentCloudTsfApplicationRead,
+		UpdateContext: resourceTencentCloudTsfApplicationUpdate,
+		DeleteContext: resourceTencentCloudTsfApplicationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		// Importer: &schema.ResourceImporter{
 		// 	State: schema.ImportStatePassthrough,
 		// },
@@ -163,7 +168,7 @@ func resourceTencentCloudTsfApplication() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -249,8 +254,8 @@ func resourceTencentCloudTsfApplicationCreate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateApplication(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateApplicationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -261,22 +266,22 @@ func resourceTencentCloudTsfApplicationCreate(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf application failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	applicationId = *response.Response.Result
 	d.SetId(applicationId)
 
-	return resourceTencentCloudTsfApplicationRead(d, meta)
+	return resourceTencentCloudTsfApplicationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfApplicationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -284,7 +289,7 @@ func resourceTencentCloudTsfApplicationRead(d *schema.ResourceData, meta interfa
 
 	application, err := service.DescribeTsfApplicationById(ctx, applicationId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if application == nil {
@@ -381,7 +386,7 @@ func resourceTencentCloudTsfApplicationRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudTsfApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -397,7 +402,7 @@ func resourceTencentCloudTsfApplicationUpdate(d *schema.ResourceData, meta inter
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -446,8 +451,8 @@ func resourceTencentCloudTsfApplicationUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ModifyApplication(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ModifyApplicationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -457,24 +462,24 @@ func resourceTencentCloudTsfApplicationUpdate(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tsf application failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTsfApplicationRead(d, meta)
+	return resourceTencentCloudTsfApplicationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 	applicationId := d.Id()
 
 	if err := service.DeleteTsfApplicationById(ctx, applicationId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -21,9 +21,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -32,10 +33,13 @@ import (
 
 func resourceTencentCloudTsfApplicationConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfApplicationConfigCreate,
-		Read:   resourceTencentCloudTsfApplicationConfigRead,
-		Update: resourceTencentCloudTsfApplicationConfigUpdate,
-		Delete: resourceTencentCloudTsfApplicationConfigDelete,
+		CreateContext: resourceTencentCloudTsfApplicationConfigCreate,
+		ReadContext:   resourceTencentCloudTsfApplicationConfigRead,
+		UpdateContext: resourceTencentCloudTsfApplicationConfigUpdate,
+		DeleteContext: resourceTencentCloudTsfApplicationConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		// Importer: &schema.ResourceImporter{
 		// 	State: schema.ImportStatePassthrough,
 		// },
@@ -100,7 +104,7 @@ func resourceTencentCloudTsfApplicationConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfApplicationConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -147,8 +151,8 @@ func resourceTencentCloudTsfApplicationConfigCreate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateConfigWithDetailResp(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateConfigWithDetailRespWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -159,22 +163,22 @@ func resourceTencentCloudTsfApplicationConfigCreate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf applicationConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	configId = *response.Response.Result.ConfigId
 	d.SetId(configId)
 
-	return resourceTencentCloudTsfApplicationConfigRead(d, meta)
+	return resourceTencentCloudTsfApplicationConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfApplicationConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -182,7 +186,7 @@ func resourceTencentCloudTsfApplicationConfigRead(d *schema.ResourceData, meta i
 
 	applicationConfig, err := service.DescribeTsfApplicationConfigById(ctx, configId, "")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if applicationConfig == nil {
@@ -226,7 +230,7 @@ func resourceTencentCloudTsfApplicationConfigRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudTsfApplicationConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_microservice.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -243,25 +247,25 @@ func resourceTencentCloudTsfApplicationConfigUpdate(d *schema.ResourceData, meta
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
-	return resourceTencentCloudTsfApplicationConfigRead(d, meta)
+	return resourceTencentCloudTsfApplicationConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfApplicationConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 	configId := d.Id()
 
 	if err := service.DeleteTsfApplicationConfigById(ctx, configId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -17,13 +17,23 @@ resource "tencentcloud_tsf_application_file_config" "application_file_config" {
   encode_with_base64 = true
 }
 ```
+
+Import
+
+tsf application_file_config can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_tsf_application_file_config.application_file_config dcfg-f-123456
+```
 */
 package tencentcloud
 
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -32,9 +42,15 @@ import (
 
 func resourceTencentCloudTsfApplicationFileConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfApplicationFileConfigCreate,
-		Read:   resourceTencentCloudTsfApplicationFileConfigRead,
-		Delete: resourceTencentCloudTsfApplicationFileConfigDelete,
+		CreateContext: resourceTencentCloudTsfApplicationFileConfigCreate,
+		ReadContext:   resourceTencentCloudTsfApplicationFileConfigRead,
+		DeleteContext: resourceTencentCloudTsfApplicationFileConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"config_name": {
@@ -120,7 +136,7 @@ func resourceTencentCloudTsfApplicationFileConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfApplicationFileConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationFileConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application_file_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -179,8 +195,8 @@ func resourceTencentCloudTsfApplicationFileConfigCreate(d *schema.ResourceData,
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateFileConfigWithDetailResp(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateFileConfigWithDetailRespWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -191,22 +207,22 @@ func resourceTencentCloudTsfApplicationFileConfigCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf applicationFileConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	configId = *response.Response.Result.ConfigId
 	d.SetId(configId)
 
-	return resourceTencentCloudTsfApplicationFileConfigRead(d, meta)
+	return resourceTencentCloudTsfApplicationFileConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfApplicationFileConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationFileConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application_file_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -214,7 +230,7 @@ func resourceTencentCloudTsfApplicationFileConfigRead(d *schema.ResourceData, me
 
 	applicationFileConfig, err := service.DescribeTsfApplicationFileConfigById(ctx, configId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if applicationFileConfig == nil {
@@ -262,18 +278,18 @@ func resourceTencentCloudTsfApplicationFileConfigRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudTsfApplicationFileConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationFileConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application_file_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 	configId := d.Id()
 
 	if err := service.DeleteTsfApplicationFileConfigById(ctx, configId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
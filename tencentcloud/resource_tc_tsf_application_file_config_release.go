@@ -23,10 +23,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -35,11 +35,14 @@ import (
 
 func resourceTencentCloudTsfApplicationFileConfigRelease() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfApplicationFileConfigReleaseCreate,
-		Read:   resourceTencentCloudTsfApplicationFileConfigReleaseRead,
-		Delete: resourceTencentCloudTsfApplicationFileConfigReleaseDelete,
+		CreateContext: resourceTencentCloudTsfApplicationFileConfigReleaseCreate,
+		ReadContext:   resourceTencentCloudTsfApplicationFileConfigReleaseRead,
+		DeleteContext: resourceTencentCloudTsfApplicationFileConfigReleaseDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"config_id": {
@@ -66,7 +69,7 @@ func resourceTencentCloudTsfApplicationFileConfigRelease() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfApplicationFileConfigReleaseCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationFileConfigReleaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application_file_config_release.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -91,8 +94,8 @@ func resourceTencentCloudTsfApplicationFileConfigReleaseCreate(d *schema.Resourc
 		request.ReleaseDesc = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ReleaseFileConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ReleaseFileConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -102,34 +105,34 @@ func resourceTencentCloudTsfApplicationFileConfigReleaseCreate(d *schema.Resourc
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf applicationFileConfigRelease failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(configId + FILED_SP + groupId)
+	d.SetId(helper.IdFormat(configId, groupId))
 
-	return resourceTencentCloudTsfApplicationFileConfigReleaseRead(d, meta)
+	return resourceTencentCloudTsfApplicationFileConfigReleaseRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfApplicationFileConfigReleaseRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationFileConfigReleaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application_file_config_release.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	configId := idSplit[0]
 	groupId := idSplit[1]
 
 	applicationFileConfigRelease, err := service.DescribeTsfApplicationFileConfigReleaseById(ctx, configId, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if applicationFileConfigRelease == nil {
@@ -153,23 +156,23 @@ func resourceTencentCloudTsfApplicationFileConfigReleaseRead(d *schema.ResourceD
 	return nil
 }
 
-func resourceTencentCloudTsfApplicationFileConfigReleaseDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationFileConfigReleaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application_file_config_release.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	configId := idSplit[0]
 	groupId := idSplit[1]
 
 	if err := service.DeleteTsfApplicationFileConfigReleaseById(ctx, configId, groupId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -20,7 +20,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -29,9 +31,12 @@ import (
 
 func resourceTencentCloudTsfApplicationPublicConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfApplicationPublicConfigCreate,
-		Read:   resourceTencentCloudTsfApplicationPublicConfigRead,
-		Delete: resourceTencentCloudTsfApplicationPublicConfigDelete,
+		CreateContext: resourceTencentCloudTsfApplicationPublicConfigCreate,
+		ReadContext:   resourceTencentCloudTsfApplicationPublicConfigRead,
+		DeleteContext: resourceTencentCloudTsfApplicationPublicConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		// Importer: &schema.ResourceImporter{
 		// 	State: schema.ImportStatePassthrough,
 		// },
@@ -91,7 +96,7 @@ func resourceTencentCloudTsfApplicationPublicConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfApplicationPublicConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationPublicConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application_public_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -134,8 +139,8 @@ func resourceTencentCloudTsfApplicationPublicConfigCreate(d *schema.ResourceData
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreatePublicConfigWithDetailResp(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreatePublicConfigWithDetailRespWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -146,22 +151,22 @@ func resourceTencentCloudTsfApplicationPublicConfigCreate(d *schema.ResourceData
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf applicationPublicConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	configId = *response.Response.Result.ConfigId
 	d.SetId(configId)
 
-	return resourceTencentCloudTsfApplicationPublicConfigRead(d, meta)
+	return resourceTencentCloudTsfApplicationPublicConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfApplicationPublicConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationPublicConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application_public_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -169,7 +174,7 @@ func resourceTencentCloudTsfApplicationPublicConfigRead(d *schema.ResourceData,
 
 	applicationPublicConfig, err := service.DescribeTsfApplicationPublicConfigById(ctx, configId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if applicationPublicConfig == nil {
@@ -209,18 +214,18 @@ func resourceTencentCloudTsfApplicationPublicConfigRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudTsfApplicationPublicConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationPublicConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application_public_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 	configId := d.Id()
 
 	if err := service.DeleteTsfApplicationPublicConfigById(ctx, configId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -23,10 +23,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -35,11 +35,14 @@ import (
 
 func resourceTencentCloudTsfApplicationPublicConfigRelease() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfApplicationPublicConfigReleaseCreate,
-		Read:   resourceTencentCloudTsfApplicationPublicConfigReleaseRead,
-		Delete: resourceTencentCloudTsfApplicationPublicConfigReleaseDelete,
+		CreateContext: resourceTencentCloudTsfApplicationPublicConfigReleaseCreate,
+		ReadContext:   resourceTencentCloudTsfApplicationPublicConfigReleaseRead,
+		DeleteContext: resourceTencentCloudTsfApplicationPublicConfigReleaseDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"config_id": {
@@ -66,7 +69,7 @@ func resourceTencentCloudTsfApplicationPublicConfigRelease() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfApplicationPublicConfigReleaseCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationPublicConfigReleaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application_public_config_release.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -91,8 +94,8 @@ func resourceTencentCloudTsfApplicationPublicConfigReleaseCreate(d *schema.Resou
 		request.ReleaseDesc = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ReleasePublicConfig(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ReleasePublicConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -102,33 +105,33 @@ func resourceTencentCloudTsfApplicationPublicConfigReleaseCreate(d *schema.Resou
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf applicationPublicConfigRelease failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(configId + FILED_SP + namespaceId)
+	d.SetId(helper.IdFormat(configId, namespaceId))
 
-	return resourceTencentCloudTsfApplicationPublicConfigReleaseRead(d, meta)
+	return resourceTencentCloudTsfApplicationPublicConfigReleaseRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfApplicationPublicConfigReleaseRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationPublicConfigReleaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application_public_config_release.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	configId := idSplit[0]
 	namespaceId := idSplit[1]
 
 	applicationPublicConfigRelease, err := service.DescribeTsfApplicationPublicConfigReleaseById(ctx, configId, namespaceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if applicationPublicConfigRelease == nil {
@@ -152,23 +155,23 @@ func resourceTencentCloudTsfApplicationPublicConfigReleaseRead(d *schema.Resourc
 	return nil
 }
 
-func resourceTencentCloudTsfApplicationPublicConfigReleaseDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationPublicConfigReleaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application_public_config_release.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	configId := idSplit[0]
 	namespaceId := idSplit[1]
 
 	if err := service.DeleteTsfApplicationPublicConfigReleaseById(ctx, configId, namespaceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -23,10 +23,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -35,11 +35,14 @@ import (
 
 func resourceTencentCloudTsfApplicationReleaseConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfApplicationReleaseConfigCreate,
-		Read:   resourceTencentCloudTsfApplicationReleaseConfigRead,
-		Delete: resourceTencentCloudTsfApplicationReleaseConfigDelete,
+		CreateContext: resourceTencentCloudTsfApplicationReleaseConfigCreate,
+		ReadContext:   resourceTencentCloudTsfApplicationReleaseConfigRead,
+		DeleteContext: resourceTencentCloudTsfApplicationReleaseConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"config_id": {
@@ -127,7 +130,7 @@ func resourceTencentCloudTsfApplicationReleaseConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfApplicationReleaseConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationReleaseConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application_release_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -153,8 +156,8 @@ func resourceTencentCloudTsfApplicationReleaseConfigCreate(d *schema.ResourceDat
 		request.ReleaseDesc = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ReleaseConfigWithDetailResp(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ReleaseConfigWithDetailRespWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -165,35 +168,35 @@ func resourceTencentCloudTsfApplicationReleaseConfigCreate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf applicationReleaseConfig failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	configReleaseId := *response.Response.Result.ConfigReleaseId
-	d.SetId(configId + FILED_SP + groupId + FILED_SP + configReleaseId)
+	d.SetId(helper.IdFormat(configId, groupId, configReleaseId))
 
-	return resourceTencentCloudTsfApplicationReleaseConfigRead(d, meta)
+	return resourceTencentCloudTsfApplicationReleaseConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfApplicationReleaseConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationReleaseConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application_release_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	configId := idSplit[0]
 	groupId := idSplit[1]
 
 	applicationReleaseConfig, err := service.DescribeTsfApplicationReleaseConfigById(ctx, configId, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if applicationReleaseConfig == nil {
@@ -257,22 +260,22 @@ func resourceTencentCloudTsfApplicationReleaseConfigRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudTsfApplicationReleaseConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfApplicationReleaseConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_application_release_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	configId := idSplit[2]
 
 	if err := service.DeleteTsfApplicationReleaseConfigById(ctx, configId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -22,22 +22,26 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudTsfBindApiGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfBindApiGroupCreate,
-		Read:   resourceTencentCloudTsfBindApiGroupRead,
-		Delete: resourceTencentCloudTsfBindApiGroupDelete,
+		CreateContext: resourceTencentCloudTsfBindApiGroupCreate,
+		ReadContext:   resourceTencentCloudTsfBindApiGroupRead,
+		DeleteContext: resourceTencentCloudTsfBindApiGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"gateway_deploy_group_id": {
@@ -57,7 +61,7 @@ func resourceTencentCloudTsfBindApiGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfBindApiGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfBindApiGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_bind_api_group.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -82,8 +86,8 @@ func resourceTencentCloudTsfBindApiGroupCreate(d *schema.ResourceData, meta inte
 		},
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().BindApiGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().BindApiGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -93,32 +97,32 @@ func resourceTencentCloudTsfBindApiGroupCreate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf bindApiGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strings.Join([]string{groupId, gatewayDeployGroupId}, FILED_SP))
+	d.SetId(helper.IdFormat(groupId, gatewayDeployGroupId))
 
-	return resourceTencentCloudTsfBindApiGroupRead(d, meta)
+	return resourceTencentCloudTsfBindApiGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfBindApiGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfBindApiGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_bind_api_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	groupId := idSplit[0]
 	gatewayDeployGroupId := idSplit[1]
 
 	bindApiGroup, err := service.DescribeTsfBindApiGroupById(ctx, groupId, gatewayDeployGroupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if bindApiGroup == nil {
@@ -133,23 +137,23 @@ func resourceTencentCloudTsfBindApiGroupRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudTsfBindApiGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfBindApiGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_bind_api_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	groupId := idSplit[0]
 	gatewayDeployGroupId := idSplit[1]
 
 	if err := service.DeleteTsfBindApiGroupById(ctx, groupId, gatewayDeployGroupId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -29,6 +29,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -37,10 +38,14 @@ import (
 
 func resourceTencentCloudTsfCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfClusterCreate,
-		Read:   resourceTencentCloudTsfClusterRead,
-		Update: resourceTencentCloudTsfClusterUpdate,
-		Delete: resourceTencentCloudTsfClusterDelete,
+		CreateContext: resourceTencentCloudTsfClusterCreate,
+		ReadContext:   resourceTencentCloudTsfClusterRead,
+		UpdateContext: resourceTencentCloudTsfClusterUpdate,
+		DeleteContext: resourceTencentCloudTsfClusterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
 		// Importer: &schema.ResourceImporter{
 		// 	State: schema.ImportStatePassthrough,
 		// },
@@ -374,7 +379,7 @@ func resourceTencentCloudTsfCluster() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_cluster.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -453,8 +458,8 @@ func resourceTencentCloudTsfClusterCreate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateCluster(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateClusterWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -465,7 +470,7 @@ func resourceTencentCloudTsfClusterCreate(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf cluster failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	clusterId = *response.Response.Result
@@ -475,34 +480,34 @@ func resourceTencentCloudTsfClusterCreate(d *schema.ResourceData, meta interface
 	conf := BuildStateChangeConf(
 		[]string{"Creating"},
 		[]string{"Running"},
-		8*readRetryTimeout,
+		d.Timeout(schema.TimeoutCreate),
 		time.Second,
 		service.TsfClusterStateRefreshFunc(d.Id(), []string{}),
 	)
 	if _, e := conf.WaitForState(); e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::tsf:%s:uin/:cluster/%s", region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTsfClusterRead(d, meta)
+	return resourceTencentCloudTsfClusterRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_cluster.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -510,7 +515,7 @@ func resourceTencentCloudTsfClusterRead(d *schema.ResourceData, meta interface{}
 
 	cluster, err := service.DescribeTsfClusterById(ctx, clusterId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if cluster == nil {
@@ -739,14 +744,14 @@ func resourceTencentCloudTsfClusterRead(d *schema.ResourceData, meta interface{}
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "tsf", "cluster", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudTsfClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_cluster.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -762,7 +767,7 @@ func resourceTencentCloudTsfClusterUpdate(d *schema.ResourceData, meta interface
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -784,8 +789,8 @@ func resourceTencentCloudTsfClusterUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ModifyCluster(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ModifyClusterWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -795,36 +800,36 @@ func resourceTencentCloudTsfClusterUpdate(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tsf cluster failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		oldTags, newTags := d.GetChange("tags")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("tsf", "cluster", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTsfClusterRead(d, meta)
+	return resourceTencentCloudTsfClusterRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_cluster.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 	clusterId := d.Id()
 
 	if err := service.DeleteTsfClusterById(ctx, clusterId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
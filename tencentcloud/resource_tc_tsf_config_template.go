@@ -23,9 +23,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -34,10 +35,14 @@ import (
 
 func resourceTencentCloudTsfConfigTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfConfigTemplateCreate,
-		Read:   resourceTencentCloudTsfConfigTemplateRead,
-		Update: resourceTencentCloudTsfConfigTemplateUpdate,
-		Delete: resourceTencentCloudTsfConfigTemplateDelete,
+		CreateContext: resourceTencentCloudTsfConfigTemplateCreate,
+		ReadContext:   resourceTencentCloudTsfConfigTemplateRead,
+		UpdateContext: resourceTencentCloudTsfConfigTemplateUpdate,
+		DeleteContext: resourceTencentCloudTsfConfigTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		// Importer: &schema.ResourceImporter{
 		// 	State: schema.ImportStatePassthrough,
 		// },
@@ -96,7 +101,7 @@ func resourceTencentCloudTsfConfigTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfConfigTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfConfigTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_config_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -131,8 +136,8 @@ func resourceTencentCloudTsfConfigTemplateCreate(d *schema.ResourceData, meta in
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateConfigTemplateWithDetailResp(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateConfigTemplateWithDetailRespWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -143,22 +148,22 @@ func resourceTencentCloudTsfConfigTemplateCreate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf configTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	templateId = *response.Response.Result.ConfigTemplateId
 	d.SetId(templateId)
 
-	return resourceTencentCloudTsfConfigTemplateRead(d, meta)
+	return resourceTencentCloudTsfConfigTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfConfigTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfConfigTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_config_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -166,7 +171,7 @@ func resourceTencentCloudTsfConfigTemplateRead(d *schema.ResourceData, meta inte
 
 	configTemplate, err := service.DescribeTsfConfigTemplateById(ctx, templateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if configTemplate == nil {
@@ -210,7 +215,7 @@ func resourceTencentCloudTsfConfigTemplateRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudTsfConfigTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfConfigTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_config_template.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -226,7 +231,7 @@ func resourceTencentCloudTsfConfigTemplateUpdate(d *schema.ResourceData, meta in
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -250,8 +255,8 @@ func resourceTencentCloudTsfConfigTemplateUpdate(d *schema.ResourceData, meta in
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().UpdateConfigTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().UpdateConfigTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -261,24 +266,24 @@ func resourceTencentCloudTsfConfigTemplateUpdate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tsf configTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTsfConfigTemplateRead(d, meta)
+	return resourceTencentCloudTsfConfigTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfConfigTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfConfigTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_config_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 	templateId := d.Id()
 
 	if err := service.DeleteTsfConfigTemplateById(ctx, templateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -72,7 +72,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -81,10 +83,14 @@ import (
 
 func resourceTencentCloudTsfDeployContainerGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfDeployContainerGroupCreate,
-		Read:   resourceTencentCloudTsfDeployContainerGroupRead,
-		Update: resourceTencentCloudTsfDeployContainerGroupUpdate,
-		Delete: resourceTencentCloudTsfDeployContainerGroupDelete,
+		CreateContext: resourceTencentCloudTsfDeployContainerGroupCreate,
+		ReadContext:   resourceTencentCloudTsfDeployContainerGroupRead,
+		UpdateContext: resourceTencentCloudTsfDeployContainerGroupUpdate,
+		DeleteContext: resourceTencentCloudTsfDeployContainerGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(50 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -750,12 +756,12 @@ func resourceTencentCloudTsfDeployContainerGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfDeployContainerGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfDeployContainerGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_deploy_container_group.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request  = tsf.NewDeployContainerGroupRequest()
@@ -1115,8 +1121,8 @@ func resourceTencentCloudTsfDeployContainerGroupCreate(d *schema.ResourceData, m
 		request.WarmupSetting = &warmupSetting
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().DeployContainerGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().DeployContainerGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1127,16 +1133,16 @@ func resourceTencentCloudTsfDeployContainerGroupCreate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s deploy tsf deployContainerGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !*response.Response.Result {
-		return fmt.Errorf("[CRITAL]%s deploy tsf deployContainerGroup failed", logId)
+		return diag.Errorf("[CRITAL]%s deploy tsf deployContainerGroup failed", logId)
 	}
 	d.SetId(groupId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		groupInfo, err := service.DescribeTsfStartContainerGroupById(ctx, groupId)
 		if err != nil {
 			return retryError(err)
@@ -1157,18 +1163,18 @@ func resourceTencentCloudTsfDeployContainerGroupCreate(d *schema.ResourceData, m
 
 	if err != nil {
 		log.Printf("[CRITAL]%s deploy container group, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTsfDeployContainerGroupRead(d, meta)
+	return resourceTencentCloudTsfDeployContainerGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfDeployContainerGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfDeployContainerGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_deploy_container_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -1176,7 +1182,7 @@ func resourceTencentCloudTsfDeployContainerGroupRead(d *schema.ResourceData, met
 
 	deployContainerGroup, err := service.DescribeTsfDeployContainerGroupById(ctx, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if deployContainerGroup == nil {
@@ -1534,7 +1540,7 @@ func resourceTencentCloudTsfDeployContainerGroupRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudTsfDeployContainerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfDeployContainerGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_deploy_container_group.update")()
 	defer inconsistentCheck(d, meta)()
 	logId := getLogId(contextNil)
@@ -1549,7 +1555,7 @@ func resourceTencentCloudTsfDeployContainerGroupUpdate(d *schema.ResourceData, m
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -1559,8 +1565,8 @@ func resourceTencentCloudTsfDeployContainerGroupUpdate(d *schema.ResourceData, m
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ModifyContainerReplicas(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ModifyContainerReplicasWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1570,13 +1576,13 @@ func resourceTencentCloudTsfDeployContainerGroupUpdate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tsf unitRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTsfDeployContainerGroupRead(d, meta)
+	return resourceTencentCloudTsfDeployContainerGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfDeployContainerGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfDeployContainerGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_deploy_container_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -37,7 +37,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -46,9 +48,12 @@ import (
 
 func resourceTencentCloudTsfDeployVmGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfDeployVmGroupCreate,
-		Read:   resourceTencentCloudTsfDeployVmGroupRead,
-		Delete: resourceTencentCloudTsfDeployVmGroupDelete,
+		CreateContext: resourceTencentCloudTsfDeployVmGroupCreate,
+		ReadContext:   resourceTencentCloudTsfDeployVmGroupRead,
+		DeleteContext: resourceTencentCloudTsfDeployVmGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -415,12 +420,12 @@ func resourceTencentCloudTsfDeployVmGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfDeployVmGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfDeployVmGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_deploy_vm_group.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request = tsf.NewDeployGroupRequest()
@@ -613,8 +618,8 @@ func resourceTencentCloudTsfDeployVmGroupCreate(d *schema.ResourceData, meta int
 		request.WarmupSetting = &warmupSetting
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().DeployGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().DeployGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -624,13 +629,13 @@ func resourceTencentCloudTsfDeployVmGroupCreate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf deployVmGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(groupId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		groupInfo, err := service.DescribeTsfStartGroupById(ctx, groupId)
 		if err != nil {
 			return retryError(err)
@@ -651,19 +656,19 @@ func resourceTencentCloudTsfDeployVmGroupCreate(d *schema.ResourceData, meta int
 
 	if err != nil {
 		log.Printf("[CRITAL]%s deploy vm group, reason:%s\n ", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTsfDeployVmGroupRead(d, meta)
+	return resourceTencentCloudTsfDeployVmGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfDeployVmGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfDeployVmGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_deploy_vm_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -671,7 +676,7 @@ func resourceTencentCloudTsfDeployVmGroupRead(d *schema.ResourceData, meta inter
 
 	deployVmGroup, err := service.DescribeTsfDeployVmGroupById(ctx, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if deployVmGroup == nil {
@@ -879,7 +884,7 @@ func resourceTencentCloudTsfDeployVmGroupRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudTsfDeployVmGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfDeployVmGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_deploy_vm_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
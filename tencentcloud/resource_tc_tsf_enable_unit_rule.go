@@ -23,7 +23,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -31,12 +33,15 @@ import (
 
 func resourceTencentCloudTsfEnableUnitRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfEnableUnitRuleCreate,
-		Read:   resourceTencentCloudTsfEnableUnitRuleRead,
-		Update: resourceTencentCloudTsfEnableUnitRuleUpdate,
-		Delete: resourceTencentCloudTsfEnableUnitRuleDelete,
+		CreateContext: resourceTencentCloudTsfEnableUnitRuleCreate,
+		ReadContext:   resourceTencentCloudTsfEnableUnitRuleRead,
+		UpdateContext: resourceTencentCloudTsfEnableUnitRuleUpdate,
+		DeleteContext: resourceTencentCloudTsfEnableUnitRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"rule_id": {
@@ -54,7 +59,7 @@ func resourceTencentCloudTsfEnableUnitRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfEnableUnitRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfEnableUnitRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_enable_unit_rule.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -65,15 +70,15 @@ func resourceTencentCloudTsfEnableUnitRuleCreate(d *schema.ResourceData, meta in
 
 	d.SetId(id)
 
-	return resourceTencentCloudTsfEnableUnitRuleUpdate(d, meta)
+	return resourceTencentCloudTsfEnableUnitRuleUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfEnableUnitRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfEnableUnitRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_enable_unit_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -81,7 +86,7 @@ func resourceTencentCloudTsfEnableUnitRuleRead(d *schema.ResourceData, meta inte
 
 	enableUnitRule, err := service.DescribeTsfEnableUnitRuleById(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if enableUnitRule == nil {
@@ -101,7 +106,7 @@ func resourceTencentCloudTsfEnableUnitRuleRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudTsfEnableUnitRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfEnableUnitRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_enable_unit_rule.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -112,8 +117,8 @@ func resourceTencentCloudTsfEnableUnitRuleUpdate(d *schema.ResourceData, meta in
 		if v.(string) == "enabled" {
 			request := tsf.NewEnableUnitRuleRequest()
 			request.Id = &id
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().EnableUnitRule(request)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().EnableUnitRuleWithContext(ctx, request)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -123,15 +128,15 @@ func resourceTencentCloudTsfEnableUnitRuleUpdate(d *schema.ResourceData, meta in
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s update tsf enableUnitRule failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 		if v.(string) == "disabled" {
 			request := tsf.NewDisableUnitRuleRequest()
 			request.Id = &id
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().DisableUnitRule(request)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().DisableUnitRuleWithContext(ctx, request)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -141,15 +146,15 @@ func resourceTencentCloudTsfEnableUnitRuleUpdate(d *schema.ResourceData, meta in
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s update tsf disableUnitRule failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return resourceTencentCloudTsfEnableUnitRuleRead(d, meta)
+	return resourceTencentCloudTsfEnableUnitRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfEnableUnitRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfEnableUnitRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_enable_unit_rule.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -31,7 +31,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -40,12 +42,16 @@ import (
 
 func resourceTencentCloudTsfGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfGroupCreate,
-		Read:   resourceTencentCloudTsfGroupRead,
-		Update: resourceTencentCloudTsfGroupUpdate,
-		Delete: resourceTencentCloudTsfGroupDelete,
+		CreateContext: resourceTencentCloudTsfGroupCreate,
+		ReadContext:   resourceTencentCloudTsfGroupRead,
+		UpdateContext: resourceTencentCloudTsfGroupUpdate,
+		DeleteContext: resourceTencentCloudTsfGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"application_id": {
@@ -99,7 +105,7 @@ func resourceTencentCloudTsfGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_group.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -134,8 +140,8 @@ func resourceTencentCloudTsfGroupCreate(d *schema.ResourceData, meta interface{}
 		request.Alias = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -146,32 +152,32 @@ func resourceTencentCloudTsfGroupCreate(d *schema.ResourceData, meta interface{}
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf group failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	groupId = *response.Response.Result
 	d.SetId(groupId)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::tsf:%s:uin/:group/%s", region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTsfGroupRead(d, meta)
+	return resourceTencentCloudTsfGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -179,7 +185,7 @@ func resourceTencentCloudTsfGroupRead(d *schema.ResourceData, meta interface{})
 
 	group, err := service.DescribeTsfGroupById(ctx, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if group == nil {
@@ -220,14 +226,14 @@ func resourceTencentCloudTsfGroupRead(d *schema.ResourceData, meta interface{})
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "tsf", "group", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudTsfGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_group.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -243,7 +249,7 @@ func resourceTencentCloudTsfGroupUpdate(d *schema.ResourceData, meta interface{}
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -265,8 +271,8 @@ func resourceTencentCloudTsfGroupUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ModifyGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ModifyGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -276,36 +282,36 @@ func resourceTencentCloudTsfGroupUpdate(d *schema.ResourceData, meta interface{}
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tsf group failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 		oldTags, newTags := d.GetChange("tags")
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("tsf", "group", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTsfGroupRead(d, meta)
+	return resourceTencentCloudTsfGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 	groupId := d.Id()
 
 	if err := service.DeleteTsfGroupById(ctx, groupId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
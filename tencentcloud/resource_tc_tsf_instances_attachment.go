@@ -27,10 +27,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -39,9 +39,12 @@ import (
 
 func resourceTencentCloudTsfInstancesAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfInstancesAttachmentCreate,
-		Read:   resourceTencentCloudTsfInstancesAttachmentRead,
-		Delete: resourceTencentCloudTsfInstancesAttachmentDelete,
+		CreateContext: resourceTencentCloudTsfInstancesAttachmentCreate,
+		ReadContext:   resourceTencentCloudTsfInstancesAttachmentRead,
+		DeleteContext: resourceTencentCloudTsfInstancesAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		// Importer: &schema.ResourceImporter{
 		// 	State: schema.ImportStatePassthrough,
 		// },
@@ -155,7 +158,7 @@ func resourceTencentCloudTsfInstancesAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfInstancesAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfInstancesAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_instances_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -231,8 +234,8 @@ func resourceTencentCloudTsfInstancesAttachmentCreate(d *schema.ResourceData, me
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().AddClusterInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().AddClusterInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -242,34 +245,34 @@ func resourceTencentCloudTsfInstancesAttachmentCreate(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate tsf addClusterInstancesOperation failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(clusterId + FILED_SP + instanceId)
+	d.SetId(helper.IdFormat(clusterId, instanceId))
 
-	return resourceTencentCloudTsfInstancesAttachmentRead(d, meta)
+	return resourceTencentCloudTsfInstancesAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfInstancesAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfInstancesAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_instances_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	instanceId := idSplit[1]
 
 	instancesAttachment, err := service.DescribeTsfInstancesAttachmentById(ctx, clusterId, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instancesAttachment == nil {
@@ -339,23 +342,23 @@ func resourceTencentCloudTsfInstancesAttachmentRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudTsfInstancesAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfInstancesAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_instances_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	clusterId := idSplit[0]
 	instanceId := idSplit[1]
 
 	if err := service.DeleteTsfInstancesAttachmentById(ctx, clusterId, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -19,9 +19,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -30,10 +31,14 @@ import (
 
 func resourceTencentCloudTsfLane() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfLaneCreate,
-		Read:   resourceTencentCloudTsfLaneRead,
-		Update: resourceTencentCloudTsfLaneUpdate,
-		Delete: resourceTencentCloudTsfLaneDelete,
+		CreateContext: resourceTencentCloudTsfLaneCreate,
+		ReadContext:   resourceTencentCloudTsfLaneRead,
+		UpdateContext: resourceTencentCloudTsfLaneUpdate,
+		DeleteContext: resourceTencentCloudTsfLaneDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		// Importer: &schema.ResourceImporter{
 		// 	State: schema.ImportStatePassthrough,
 		// },
@@ -163,7 +168,7 @@ func resourceTencentCloudTsfLane() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfLaneCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfLaneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_lane.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -234,8 +239,8 @@ func resourceTencentCloudTsfLaneCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateLane(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateLaneWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -246,22 +251,22 @@ func resourceTencentCloudTsfLaneCreate(d *schema.ResourceData, meta interface{})
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf lane failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	laneId = *response.Response.Result
 	d.SetId(laneId)
 
-	return resourceTencentCloudTsfLaneRead(d, meta)
+	return resourceTencentCloudTsfLaneRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfLaneRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfLaneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_lane.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -269,7 +274,7 @@ func resourceTencentCloudTsfLaneRead(d *schema.ResourceData, meta interface{}) e
 
 	lane, err := service.DescribeTsfLaneById(ctx, laneId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if lane == nil {
@@ -370,7 +375,7 @@ func resourceTencentCloudTsfLaneRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceTencentCloudTsfLaneUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfLaneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_lane.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -386,7 +391,7 @@ func resourceTencentCloudTsfLaneUpdate(d *schema.ResourceData, meta interface{})
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -400,8 +405,8 @@ func resourceTencentCloudTsfLaneUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ModifyLane(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ModifyLaneWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -411,24 +416,24 @@ func resourceTencentCloudTsfLaneUpdate(d *schema.ResourceData, meta interface{})
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tsf lane failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTsfLaneRead(d, meta)
+	return resourceTencentCloudTsfLaneRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfLaneDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfLaneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_lane.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 	laneId := d.Id()
 
 	if err := service.DeleteTsfLaneById(ctx, laneId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -23,9 +23,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -34,10 +35,14 @@ import (
 
 func resourceTencentCloudTsfLaneRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfLaneRuleCreate,
-		Read:   resourceTencentCloudTsfLaneRuleRead,
-		Update: resourceTencentCloudTsfLaneRuleUpdate,
-		Delete: resourceTencentCloudTsfLaneRuleDelete,
+		CreateContext: resourceTencentCloudTsfLaneRuleCreate,
+		ReadContext:   resourceTencentCloudTsfLaneRuleRead,
+		UpdateContext: resourceTencentCloudTsfLaneRuleUpdate,
+		DeleteContext: resourceTencentCloudTsfLaneRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		// Importer: &schema.ResourceImporter{
 		// 	State: schema.ImportStatePassthrough,
 		// },
@@ -157,7 +162,7 @@ func resourceTencentCloudTsfLaneRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfLaneRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfLaneRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_lane_rule.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -221,8 +226,8 @@ func resourceTencentCloudTsfLaneRuleCreate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateLaneRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateLaneRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -233,22 +238,22 @@ func resourceTencentCloudTsfLaneRuleCreate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf laneRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleId = *response.Response.Result
 	d.SetId(ruleId)
 
-	return resourceTencentCloudTsfLaneRuleUpdate(d, meta)
+	return resourceTencentCloudTsfLaneRuleUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfLaneRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfLaneRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_lane_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -256,7 +261,7 @@ func resourceTencentCloudTsfLaneRuleRead(d *schema.ResourceData, meta interface{
 
 	laneRule, err := service.DescribeTsfLaneRuleById(ctx, ruleId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if laneRule == nil {
@@ -348,7 +353,7 @@ func resourceTencentCloudTsfLaneRuleRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTencentCloudTsfLaneRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfLaneRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_lane_rule.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -364,7 +369,7 @@ func resourceTencentCloudTsfLaneRuleUpdate(d *schema.ResourceData, meta interfac
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -416,8 +421,8 @@ func resourceTencentCloudTsfLaneRuleUpdate(d *schema.ResourceData, meta interfac
 		request.LaneId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ModifyLaneRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ModifyLaneRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -427,24 +432,24 @@ func resourceTencentCloudTsfLaneRuleUpdate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tsf laneRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTsfLaneRuleRead(d, meta)
+	return resourceTencentCloudTsfLaneRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfLaneRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfLaneRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_lane_rule.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 	ruleId := d.Id()
 
 	if err := service.DeleteTsfLaneRuleById(ctx, ruleId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
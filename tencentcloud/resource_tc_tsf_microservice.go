@@ -28,8 +28,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -38,12 +39,16 @@ import (
 
 func resourceTencentCloudTsfMicroservice() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfMicroserviceCreate,
-		Read:   resourceTencentCloudTsfMicroserviceRead,
-		Update: resourceTencentCloudTsfMicroserviceUpdate,
-		Delete: resourceTencentCloudTsfMicroserviceDelete,
+		CreateContext: resourceTencentCloudTsfMicroserviceCreate,
+		ReadContext:   resourceTencentCloudTsfMicroserviceRead,
+		UpdateContext: resourceTencentCloudTsfMicroserviceUpdate,
+		DeleteContext: resourceTencentCloudTsfMicroserviceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"namespace_id": {
@@ -73,12 +78,12 @@ func resourceTencentCloudTsfMicroservice() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfMicroserviceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfMicroserviceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_microservice.create")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		request          = tsf.NewCreateMicroserviceRequest()
@@ -101,8 +106,8 @@ func resourceTencentCloudTsfMicroserviceCreate(d *schema.ResourceData, meta inte
 		request.MicroserviceDesc = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateMicroservice(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateMicroserviceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -113,20 +118,20 @@ func resourceTencentCloudTsfMicroserviceCreate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf microservice failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *response.Response.Result {
 		service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 		microservice, err := service.DescribeTsfMicroserviceById(ctx, namespaceId, "", microserviceName)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		microserviceId = *microservice.MicroserviceId
-		d.SetId(namespaceId + FILED_SP + microserviceId)
+		d.SetId(helper.IdFormat(namespaceId, microserviceId))
 	} else {
-		return fmt.Errorf("[DEBUG]%s api[%s] Creation failed, and the return result of interface creation is false", logId, request.GetAction())
+		return diag.Errorf("[DEBUG]%s api[%s] Creation failed, and the return result of interface creation is false", logId, request.GetAction())
 	}
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
@@ -134,33 +139,33 @@ func resourceTencentCloudTsfMicroserviceCreate(d *schema.ResourceData, meta inte
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::tsf:%s:uin/:microservice/%s", region, microserviceId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTsfMicroserviceRead(d, meta)
+	return resourceTencentCloudTsfMicroserviceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfMicroserviceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfMicroserviceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_microservice.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	namespaceId := idSplit[0]
 	microserviceId := idSplit[1]
 
 	microservice, err := service.DescribeTsfMicroserviceById(ctx, namespaceId, microserviceId, "")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if microservice == nil {
@@ -184,25 +189,25 @@ func resourceTencentCloudTsfMicroserviceRead(d *schema.ResourceData, meta interf
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "tsf", "microservice", tcClient.Region, microserviceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudTsfMicroserviceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfMicroserviceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_microservice.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := tsf.NewModifyMicroserviceRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	// namespaceId := idSplit[0]
 	microserviceId := idSplit[1]
@@ -213,7 +218,7 @@ func resourceTencentCloudTsfMicroserviceUpdate(d *schema.ResourceData, meta inte
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -223,8 +228,8 @@ func resourceTencentCloudTsfMicroserviceUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ModifyMicroservice(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ModifyMicroserviceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -234,7 +239,7 @@ func resourceTencentCloudTsfMicroserviceUpdate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tsf microservice failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
@@ -244,30 +249,30 @@ func resourceTencentCloudTsfMicroserviceUpdate(d *schema.ResourceData, meta inte
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("tsf", "microservice", tcClient.Region, microserviceId)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTsfMicroserviceRead(d, meta)
+	return resourceTencentCloudTsfMicroserviceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfMicroserviceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfMicroserviceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_microservice.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	// namespaceId := idSplit[0]
 	microserviceId := idSplit[1]
 
 	if err := service.DeleteTsfMicroserviceById(ctx, microserviceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -21,9 +21,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -32,10 +33,14 @@ import (
 
 func resourceTencentCloudTsfNamespace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfNamespaceCreate,
-		Read:   resourceTencentCloudTsfNamespaceRead,
-		Update: resourceTencentCloudTsfNamespaceUpdate,
-		Delete: resourceTencentCloudTsfNamespaceDelete,
+		CreateContext: resourceTencentCloudTsfNamespaceCreate,
+		ReadContext:   resourceTencentCloudTsfNamespaceRead,
+		UpdateContext: resourceTencentCloudTsfNamespaceUpdate,
+		DeleteContext: resourceTencentCloudTsfNamespaceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		// Importer: &schema.ResourceImporter{
 		// 	State: schema.ImportStatePassthrough,
 		// },
@@ -148,7 +153,7 @@ func resourceTencentCloudTsfNamespace() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_namespace.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -199,8 +204,8 @@ func resourceTencentCloudTsfNamespaceCreate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateNamespace(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateNamespaceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -211,22 +216,22 @@ func resourceTencentCloudTsfNamespaceCreate(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf namespace failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	namespaceId = *response.Response.Result
 	d.SetId(namespaceId)
 
-	return resourceTencentCloudTsfNamespaceRead(d, meta)
+	return resourceTencentCloudTsfNamespaceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_namespace.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -234,7 +239,7 @@ func resourceTencentCloudTsfNamespaceRead(d *schema.ResourceData, meta interface
 
 	namespace, err := service.DescribeTsfNamespaceById(ctx, namespaceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if namespace == nil {
@@ -310,7 +315,7 @@ func resourceTencentCloudTsfNamespaceRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudTsfNamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_namespace.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -326,7 +331,7 @@ func resourceTencentCloudTsfNamespaceUpdate(d *schema.ResourceData, meta interfa
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -348,8 +353,8 @@ func resourceTencentCloudTsfNamespaceUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ModifyNamespace(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ModifyNamespaceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -359,24 +364,24 @@ func resourceTencentCloudTsfNamespaceUpdate(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tsf namespace failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTsfNamespaceRead(d, meta)
+	return resourceTencentCloudTsfNamespaceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_namespace.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 	namespaceId := d.Id()
 
 	if err := service.DeleteTsfNamespaceById(ctx, namespaceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
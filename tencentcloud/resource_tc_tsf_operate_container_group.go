@@ -16,7 +16,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -24,10 +26,13 @@ import (
 
 func resourceTencentCloudTsfOperateContainerGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfOperateContainerGroupCreate,
-		Read:   resourceTencentCloudTsfOperateContainerGroupRead,
-		Update: resourceTencentCloudTsfOperateContainerGroupUpdate,
-		Delete: resourceTencentCloudTsfOperateContainerGroupDelete,
+		CreateContext: resourceTencentCloudTsfOperateContainerGroupCreate,
+		ReadContext:   resourceTencentCloudTsfOperateContainerGroupRead,
+		UpdateContext: resourceTencentCloudTsfOperateContainerGroupUpdate,
+		DeleteContext: resourceTencentCloudTsfOperateContainerGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -45,7 +50,7 @@ func resourceTencentCloudTsfOperateContainerGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfOperateContainerGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfOperateContainerGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_operate_container_group.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -56,22 +61,22 @@ func resourceTencentCloudTsfOperateContainerGroupCreate(d *schema.ResourceData,
 
 	d.SetId(groupId)
 
-	return resourceTencentCloudTsfOperateContainerGroupUpdate(d, meta)
+	return resourceTencentCloudTsfOperateContainerGroupUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfOperateContainerGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfOperateContainerGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_operate_container_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	groupId := d.Id()
 	startContainerGroup, err := service.DescribeTsfStartContainerGroupById(ctx, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if startContainerGroup == nil {
@@ -85,12 +90,12 @@ func resourceTencentCloudTsfOperateContainerGroupRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudTsfOperateContainerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfOperateContainerGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_operate_container_group.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	groupId := d.Id()
 
@@ -100,8 +105,8 @@ func resourceTencentCloudTsfOperateContainerGroupUpdate(d *schema.ResourceData,
 		if operate == "start" {
 			request := tsf.NewStartContainerGroupRequest()
 			request.GroupId = &groupId
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().StartContainerGroup(request)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().StartContainerGroupWithContext(ctx, request)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -112,17 +117,17 @@ func resourceTencentCloudTsfOperateContainerGroupUpdate(d *schema.ResourceData,
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s update tsf startContainerGroup failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 
 			if !status {
-				return fmt.Errorf("[CRITAL]%s start tsf containerGroup failed", logId)
+				return diag.Errorf("[CRITAL]%s start tsf containerGroup failed", logId)
 			}
 		} else if operate == "stop" {
 			request := tsf.NewStopContainerGroupRequest()
 			request.GroupId = &groupId
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().StopContainerGroup(request)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().StopContainerGroupWithContext(ctx, request)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -133,18 +138,18 @@ func resourceTencentCloudTsfOperateContainerGroupUpdate(d *schema.ResourceData,
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s update tsf stopContainerGroup failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 
 			if !status {
-				return fmt.Errorf("[CRITAL]%s stop tsf containerGroup failed", logId)
+				return diag.Errorf("[CRITAL]%s stop tsf containerGroup failed", logId)
 			}
 		} else {
-			return fmt.Errorf("[CRITAL]%s operate type error, %s", logId, operate)
+			return diag.Errorf("[CRITAL]%s operate type error, %s", logId, operate)
 		}
 
 		service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			groupInfo, err := service.DescribeTsfStartContainerGroupById(ctx, groupId)
 			if err != nil {
 				return retryError(err)
@@ -174,14 +179,14 @@ func resourceTencentCloudTsfOperateContainerGroupUpdate(d *schema.ResourceData,
 
 		if err != nil {
 			log.Printf("[CRITAL]%s start or stop operation, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudTsfOperateContainerGroupRead(d, meta)
+	return resourceTencentCloudTsfOperateContainerGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfOperateContainerGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfOperateContainerGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_Operate_container_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -16,7 +16,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -24,10 +26,13 @@ import (
 
 func resourceTencentCloudTsfOperateGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfOperateGroupCreate,
-		Read:   resourceTencentCloudTsfOperateGroupRead,
-		Update: resourceTencentCloudTsfOperateGroupUpdate,
-		Delete: resourceTencentCloudTsfOperateGroupDelete,
+		CreateContext: resourceTencentCloudTsfOperateGroupCreate,
+		ReadContext:   resourceTencentCloudTsfOperateGroupRead,
+		UpdateContext: resourceTencentCloudTsfOperateGroupUpdate,
+		DeleteContext: resourceTencentCloudTsfOperateGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -45,7 +50,7 @@ func resourceTencentCloudTsfOperateGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfOperateGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfOperateGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_operate_group.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -56,22 +61,22 @@ func resourceTencentCloudTsfOperateGroupCreate(d *schema.ResourceData, meta inte
 
 	d.SetId(groupId)
 
-	return resourceTencentCloudTsfOperateGroupUpdate(d, meta)
+	return resourceTencentCloudTsfOperateGroupUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfOperateGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfOperateGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_operate_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	groupId := d.Id()
 	startGroup, err := service.DescribeTsfStartGroupById(ctx, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if startGroup == nil {
@@ -87,12 +92,12 @@ func resourceTencentCloudTsfOperateGroupRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudTsfOperateGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfOperateGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_operate_group.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	groupId := d.Id()
 	if v, ok := d.GetOk("operate"); ok {
@@ -100,8 +105,8 @@ func resourceTencentCloudTsfOperateGroupUpdate(d *schema.ResourceData, meta inte
 		if operate == "start" {
 			request := tsf.NewStartGroupRequest()
 			request.GroupId = &groupId
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().StartGroup(request)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().StartGroupWithContext(ctx, request)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -111,14 +116,14 @@ func resourceTencentCloudTsfOperateGroupUpdate(d *schema.ResourceData, meta inte
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s update tsf startGroup failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 		if operate == "stop" {
 			request := tsf.NewStopGroupRequest()
 			request.GroupId = &groupId
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().StopGroup(request)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().StopGroupWithContext(ctx, request)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -128,12 +133,12 @@ func resourceTencentCloudTsfOperateGroupUpdate(d *schema.ResourceData, meta inte
 			})
 			if err != nil {
 				log.Printf("[CRITAL]%s update tsf stopGroup failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 		service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			groupInfo, err := service.DescribeTsfStartGroupById(ctx, groupId)
 			if err != nil {
 				return retryError(err)
@@ -163,15 +168,15 @@ func resourceTencentCloudTsfOperateGroupUpdate(d *schema.ResourceData, meta inte
 
 		if err != nil {
 			log.Printf("[CRITAL]%s start or stop operation, reason:%s\n ", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
-	return resourceTencentCloudTsfOperateGroupRead(d, meta)
+	return resourceTencentCloudTsfOperateGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfOperateGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfOperateGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_operate_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
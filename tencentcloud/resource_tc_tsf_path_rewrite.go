@@ -25,9 +25,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -36,12 +37,16 @@ import (
 
 func resourceTencentCloudTsfPathRewrite() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfPathRewriteCreate,
-		Read:   resourceTencentCloudTsfPathRewriteRead,
-		Update: resourceTencentCloudTsfPathRewriteUpdate,
-		Delete: resourceTencentCloudTsfPathRewriteDelete,
+		CreateContext: resourceTencentCloudTsfPathRewriteCreate,
+		ReadContext:   resourceTencentCloudTsfPathRewriteRead,
+		UpdateContext: resourceTencentCloudTsfPathRewriteUpdate,
+		DeleteContext: resourceTencentCloudTsfPathRewriteDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"path_rewrite_id": {
@@ -83,7 +88,7 @@ func resourceTencentCloudTsfPathRewrite() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfPathRewriteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfPathRewriteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_path_rewrite.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -118,8 +123,8 @@ func resourceTencentCloudTsfPathRewriteCreate(d *schema.ResourceData, meta inter
 
 	pathRewrites = append(pathRewrites, &pathRewrite)
 	request.PathRewrites = pathRewrites
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreatePathRewritesWithDetailResp(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreatePathRewritesWithDetailRespWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -130,22 +135,22 @@ func resourceTencentCloudTsfPathRewriteCreate(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf pathRewrite failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	pathRewriteId = *response.Response.Result[0]
 	d.SetId(pathRewriteId)
 
-	return resourceTencentCloudTsfPathRewriteRead(d, meta)
+	return resourceTencentCloudTsfPathRewriteRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfPathRewriteRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfPathRewriteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_path_rewrite.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -153,7 +158,7 @@ func resourceTencentCloudTsfPathRewriteRead(d *schema.ResourceData, meta interfa
 
 	pathRewrite, err := service.DescribeTsfPathRewriteById(ctx, pathRewriteId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if pathRewrite == nil {
@@ -189,7 +194,7 @@ func resourceTencentCloudTsfPathRewriteRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudTsfPathRewriteUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfPathRewriteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_path_rewrite.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -205,7 +210,7 @@ func resourceTencentCloudTsfPathRewriteUpdate(d *schema.ResourceData, meta inter
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -233,8 +238,8 @@ func resourceTencentCloudTsfPathRewriteUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ModifyPathRewrite(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ModifyPathRewriteWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -244,24 +249,24 @@ func resourceTencentCloudTsfPathRewriteUpdate(d *schema.ResourceData, meta inter
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tsf pathRewrite failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTsfPathRewriteRead(d, meta)
+	return resourceTencentCloudTsfPathRewriteRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfPathRewriteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfPathRewriteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_path_rewrite.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 	pathRewriteId := d.Id()
 
 	if err := service.DeleteTsfPathRewriteById(ctx, pathRewriteId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -13,9 +13,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -24,9 +25,12 @@ import (
 
 func resourceTencentCloudTsfReleaseApiGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfReleaseApiGroupCreate,
-		Read:   resourceTencentCloudTsfReleaseApiGroupRead,
-		Delete: resourceTencentCloudTsfReleaseApiGroupDelete,
+		CreateContext: resourceTencentCloudTsfReleaseApiGroupCreate,
+		ReadContext:   resourceTencentCloudTsfReleaseApiGroupRead,
+		DeleteContext: resourceTencentCloudTsfReleaseApiGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -39,7 +43,7 @@ func resourceTencentCloudTsfReleaseApiGroup() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfReleaseApiGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfReleaseApiGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_release_api_group.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -55,8 +59,8 @@ func resourceTencentCloudTsfReleaseApiGroupCreate(d *schema.ResourceData, meta i
 		request.GroupId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ReleaseApiGroup(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ReleaseApiGroupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -67,30 +71,30 @@ func resourceTencentCloudTsfReleaseApiGroupCreate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf releaseApiGroup failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !*response.Response.Result {
-		return fmt.Errorf("[CRITAL]%s create tsf releaseApiGroup failed", logId)
+		return diag.Errorf("[CRITAL]%s create tsf releaseApiGroup failed", logId)
 	}
 	d.SetId(groupId)
 
-	return resourceTencentCloudTsfReleaseApiGroupRead(d, meta)
+	return resourceTencentCloudTsfReleaseApiGroupRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfReleaseApiGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfReleaseApiGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_release_api_group.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	groupId := d.Id()
 	releaseApiGroup, err := service.DescribeTsfReleaseApiGroupById(ctx, groupId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if releaseApiGroup == nil {
@@ -106,7 +110,7 @@ func resourceTencentCloudTsfReleaseApiGroupRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudTsfReleaseApiGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfReleaseApiGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_release_api_group.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -26,9 +26,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -37,12 +38,16 @@ import (
 
 func resourceTencentCloudTsfRepository() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfRepositoryCreate,
-		Read:   resourceTencentCloudTsfRepositoryRead,
-		Update: resourceTencentCloudTsfRepositoryUpdate,
-		Delete: resourceTencentCloudTsfRepositoryDelete,
+		CreateContext: resourceTencentCloudTsfRepositoryCreate,
+		ReadContext:   resourceTencentCloudTsfRepositoryRead,
+		UpdateContext: resourceTencentCloudTsfRepositoryUpdate,
+		DeleteContext: resourceTencentCloudTsfRepositoryDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"repository_id": {
@@ -104,7 +109,7 @@ func resourceTencentCloudTsfRepository() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfRepositoryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfRepositoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_repository.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -139,8 +144,8 @@ func resourceTencentCloudTsfRepositoryCreate(d *schema.ResourceData, meta interf
 		request.RepositoryDesc = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateRepository(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateRepositoryWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -151,22 +156,22 @@ func resourceTencentCloudTsfRepositoryCreate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf repository failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// repositoryId = *response.Response.RepositoryId
 	d.SetId(repositoryId)
 
-	return resourceTencentCloudTsfRepositoryRead(d, meta)
+	return resourceTencentCloudTsfRepositoryRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfRepositoryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfRepositoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_repository.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -174,7 +179,7 @@ func resourceTencentCloudTsfRepositoryRead(d *schema.ResourceData, meta interfac
 
 	repository, err := service.DescribeTsfRepositoryById(ctx, repositoryId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if repository == nil {
@@ -222,7 +227,7 @@ func resourceTencentCloudTsfRepositoryRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudTsfRepositoryUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfRepositoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_repository.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -238,7 +243,7 @@ func resourceTencentCloudTsfRepositoryUpdate(d *schema.ResourceData, meta interf
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -248,8 +253,8 @@ func resourceTencentCloudTsfRepositoryUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().UpdateRepository(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().UpdateRepositoryWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -259,24 +264,24 @@ func resourceTencentCloudTsfRepositoryUpdate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tsf repository failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTsfRepositoryRead(d, meta)
+	return resourceTencentCloudTsfRepositoryRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfRepositoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_repository.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 	repositoryId := d.Id()
 
 	if err := service.DeleteTsfRepositoryById(ctx, repositoryId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -38,10 +38,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -50,10 +51,14 @@ import (
 
 func resourceTencentCloudTsfTask() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfTaskCreate,
-		Read:   resourceTencentCloudTsfTaskRead,
-		Update: resourceTencentCloudTsfTaskUpdate,
-		Delete: resourceTencentCloudTsfTaskDelete,
+		CreateContext: resourceTencentCloudTsfTaskCreate,
+		ReadContext:   resourceTencentCloudTsfTaskRead,
+		UpdateContext: resourceTencentCloudTsfTaskUpdate,
+		DeleteContext: resourceTencentCloudTsfTaskDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		// Importer: &schema.ResourceImporter{
 		// 	State: schema.ImportStatePassthrough,
 		// },
@@ -246,7 +251,7 @@ func resourceTencentCloudTsfTask() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfTaskCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_task.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -349,8 +354,8 @@ func resourceTencentCloudTsfTaskCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateTask(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateTaskWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -361,22 +366,22 @@ func resourceTencentCloudTsfTaskCreate(d *schema.ResourceData, meta interface{})
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf task failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	taskId = *response.Response.Result
 	d.SetId(taskId)
 
-	return resourceTencentCloudTsfTaskRead(d, meta)
+	return resourceTencentCloudTsfTaskRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfTaskRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_task.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -384,7 +389,7 @@ func resourceTencentCloudTsfTaskRead(d *schema.ResourceData, meta interface{}) e
 
 	task, err := service.DescribeTsfTaskById(ctx, taskId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if task == nil {
@@ -520,7 +525,7 @@ func resourceTencentCloudTsfTaskRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceTencentCloudTsfTaskUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_task.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -536,7 +541,7 @@ func resourceTencentCloudTsfTaskUpdate(d *schema.ResourceData, meta interface{})
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -664,8 +669,8 @@ func resourceTencentCloudTsfTaskUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ModifyTask(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().ModifyTaskWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -675,24 +680,24 @@ func resourceTencentCloudTsfTaskUpdate(d *schema.ResourceData, meta interface{})
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tsf task failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTsfTaskRead(d, meta)
+	return resourceTencentCloudTsfTaskRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfTaskDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_task.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 	taskId := d.Id()
 
 	if err := service.DeleteTsfTaskById(ctx, taskId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -23,10 +23,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -35,11 +35,14 @@ import (
 
 func resourceTencentCloudTsfUnitNamespace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfUnitNamespaceCreate,
-		Read:   resourceTencentCloudTsfUnitNamespaceRead,
-		Delete: resourceTencentCloudTsfUnitNamespaceDelete,
+		CreateContext: resourceTencentCloudTsfUnitNamespaceCreate,
+		ReadContext:   resourceTencentCloudTsfUnitNamespaceRead,
+		DeleteContext: resourceTencentCloudTsfUnitNamespaceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"gateway_instance_id": {
@@ -74,7 +77,7 @@ func resourceTencentCloudTsfUnitNamespace() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfUnitNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfUnitNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_unit_namespace.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -110,8 +113,8 @@ func resourceTencentCloudTsfUnitNamespaceCreate(d *schema.ResourceData, meta int
 	}
 	request.UnitNamespaceList = append(request.UnitNamespaceList, &unitNamespace)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateUnitNamespaces(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateUnitNamespacesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -122,38 +125,38 @@ func resourceTencentCloudTsfUnitNamespaceCreate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf unitNamespace failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if !*response.Response.Result {
-		return fmt.Errorf("[CRITAL]%s create tsf unitNamespace failed", logId)
+		return diag.Errorf("[CRITAL]%s create tsf unitNamespace failed", logId)
 	}
 
-	d.SetId(gatewayInstanceId + FILED_SP + namespaceId)
+	d.SetId(helper.IdFormat(gatewayInstanceId, namespaceId))
 
-	return resourceTencentCloudTsfUnitNamespaceRead(d, meta)
+	return resourceTencentCloudTsfUnitNamespaceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfUnitNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfUnitNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_unit_namespace.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	gatewayInstanceId := idSplit[0]
 	namespaceId := idSplit[1]
 
 	unitNamespace, err := service.DescribeTsfUnitNamespaceById(ctx, gatewayInstanceId, namespaceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if unitNamespace == nil {
@@ -180,28 +183,28 @@ func resourceTencentCloudTsfUnitNamespaceRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudTsfUnitNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfUnitNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_unit_namespace.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	gatewayInstanceId := idSplit[0]
 	namespaceId := idSplit[1]
 
 	unitNamespace, err := service.DescribeTsfUnitNamespaceById(ctx, gatewayInstanceId, namespaceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := service.DeleteTsfUnitNamespaceById(ctx, gatewayInstanceId, *unitNamespace.Id); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
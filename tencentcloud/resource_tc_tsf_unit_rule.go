@@ -37,9 +37,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
@@ -48,12 +49,16 @@ import (
 
 func resourceTencentCloudTsfUnitRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfUnitRuleCreate,
-		Read:   resourceTencentCloudTsfUnitRuleRead,
-		Update: resourceTencentCloudTsfUnitRuleUpdate,
-		Delete: resourceTencentCloudTsfUnitRuleDelete,
+		CreateContext: resourceTencentCloudTsfUnitRuleCreate,
+		ReadContext:   resourceTencentCloudTsfUnitRuleRead,
+		UpdateContext: resourceTencentCloudTsfUnitRuleUpdate,
+		DeleteContext: resourceTencentCloudTsfUnitRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"gateway_instance_id": {
@@ -183,7 +188,7 @@ func resourceTencentCloudTsfUnitRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudTsfUnitRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfUnitRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_unit_rule.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -263,8 +268,8 @@ func resourceTencentCloudTsfUnitRuleCreate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateUnitRuleWithDetailResp(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().CreateUnitRuleWithDetailRespWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -275,22 +280,22 @@ func resourceTencentCloudTsfUnitRuleCreate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create tsf unitRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleId = *response.Response.Result.Id
 	d.SetId(ruleId)
 
-	return resourceTencentCloudTsfUnitRuleRead(d, meta)
+	return resourceTencentCloudTsfUnitRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfUnitRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfUnitRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_unit_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -298,7 +303,7 @@ func resourceTencentCloudTsfUnitRuleRead(d *schema.ResourceData, meta interface{
 
 	unitRule, err := service.DescribeTsfUnitRuleById(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if unitRule == nil {
@@ -404,14 +409,14 @@ func resourceTencentCloudTsfUnitRuleRead(d *schema.ResourceData, meta interface{
 
 		err = d.Set("unit_rule_item_list", unitRuleItemListList)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceTencentCloudTsfUnitRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfUnitRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_unit_rule.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -427,7 +432,7 @@ func resourceTencentCloudTsfUnitRuleUpdate(d *schema.ResourceData, meta interfac
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -500,8 +505,8 @@ func resourceTencentCloudTsfUnitRuleUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().UpdateUnitRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTsfClient().UpdateUnitRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -511,24 +516,24 @@ func resourceTencentCloudTsfUnitRuleUpdate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update tsf unitRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudTsfUnitRuleRead(d, meta)
+	return resourceTencentCloudTsfUnitRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudTsfUnitRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudTsfUnitRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_tsf_unit_rule.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := TsfService{client: meta.(*TencentCloudClient).apiV3Conn}
 	id := d.Id()
 
 	if err := service.DeleteTsfUnitRuleById(ctx, id); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -63,6 +63,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vod "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vod/v20180717"
@@ -72,12 +73,16 @@ import (
 
 func resourceTencentCloudVodAdaptiveDynamicStreamingTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVodAdaptiveDynamicStreamingTemplateCreate,
-		Read:   resourceTencentCloudVodAdaptiveDynamicStreamingTemplateRead,
-		Update: resourceTencentCloudVodAdaptiveDynamicStreamingTemplateUpdate,
-		Delete: resourceTencentCloudVodAdaptiveDynamicStreamingTemplateDelete,
+		CreateContext: resourceTencentCloudVodAdaptiveDynamicStreamingTemplateCreate,
+		ReadContext:   resourceTencentCloudVodAdaptiveDynamicStreamingTemplateRead,
+		UpdateContext: resourceTencentCloudVodAdaptiveDynamicStreamingTemplateUpdate,
+		DeleteContext: resourceTencentCloudVodAdaptiveDynamicStreamingTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -235,7 +240,7 @@ func resourceTencentCloudVodAdaptiveDynamicStreamingTemplate() *schema.Resource
 	}
 }
 
-func resourceTencentCloudVodAdaptiveDynamicStreamingTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodAdaptiveDynamicStreamingTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_adaptive_dynamic_streaming_template.create")()
 
 	var (
@@ -285,8 +290,10 @@ func resourceTencentCloudVodAdaptiveDynamicStreamingTemplateCreate(d *schema.Res
 
 	var response *vod.CreateAdaptiveDynamicStreamingTemplateResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		response, err = meta.(*TencentCloudClient).apiV3Conn.UseVodClient().CreateAdaptiveDynamicStreamingTemplate(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -295,33 +302,33 @@ func resourceTencentCloudVodAdaptiveDynamicStreamingTemplateCreate(d *schema.Res
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if response == nil || response.Response == nil {
-		return fmt.Errorf("for vod adaptive dynamic streaming template creation, response is nil")
+		return diag.Errorf("for vod adaptive dynamic streaming template creation, response is nil")
 	}
 	d.SetId(strconv.FormatUint(*response.Response.Definition, 10))
 
-	return resourceTencentCloudVodAdaptiveDynamicStreamingTemplateRead(d, meta)
+	return resourceTencentCloudVodAdaptiveDynamicStreamingTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVodAdaptiveDynamicStreamingTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodAdaptiveDynamicStreamingTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_adaptive_dynamic_streaming_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		id         = d.Id()
 		subAppId   = d.Get("sub_app_id").(int)
 		client     = meta.(*TencentCloudClient).apiV3Conn
 		vodService = VodService{client: client}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	// waiting for refreshing cache
 	time.Sleep(30 * time.Second)
 	template, has, err := vodService.DescribeAdaptiveDynamicStreamingTemplatesById(ctx, id, subAppId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -367,7 +374,7 @@ func resourceTencentCloudVodAdaptiveDynamicStreamingTemplateRead(d *schema.Resou
 	return nil
 }
 
-func resourceTencentCloudVodAdaptiveDynamicStreamingTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodAdaptiveDynamicStreamingTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_adaptive_dynamic_streaming_template.update")()
 
 	var (
@@ -445,8 +452,10 @@ func resourceTencentCloudVodAdaptiveDynamicStreamingTemplateUpdate(d *schema.Res
 
 	if changeFlag {
 		var err error
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 			_, err = meta.(*TencentCloudClient).apiV3Conn.UseVodClient().ModifyAdaptiveDynamicStreamingTemplate(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -455,20 +464,20 @@ func resourceTencentCloudVodAdaptiveDynamicStreamingTemplateUpdate(d *schema.Res
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		return resourceTencentCloudVodAdaptiveDynamicStreamingTemplateRead(d, meta)
+		return resourceTencentCloudVodAdaptiveDynamicStreamingTemplateRead(ctx, d, meta)
 	}
 
 	return nil
 }
 
-func resourceTencentCloudVodAdaptiveDynamicStreamingTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodAdaptiveDynamicStreamingTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_adaptive_dynamic_streaming_template.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	vodService := VodService{
@@ -476,7 +485,7 @@ func resourceTencentCloudVodAdaptiveDynamicStreamingTemplateDelete(d *schema.Res
 	}
 
 	if err := vodService.DeleteAdaptiveDynamicStreamingTemplate(ctx, id, uint64(d.Get("sub_app_id").(int))); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -30,11 +30,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vod "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vod/v20180717"
@@ -44,12 +44,16 @@ import (
 
 func resourceTencentCloudVodImageSpriteTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVodImageSpriteTemplateCreate,
-		Read:   resourceTencentCloudVodImageSpriteTemplateRead,
-		Update: resourceTencentCloudVodImageSpriteTemplateUpdate,
-		Delete: resourceTencentCloudVodImageSpriteTemplateDelete,
+		CreateContext: resourceTencentCloudVodImageSpriteTemplateCreate,
+		ReadContext:   resourceTencentCloudVodImageSpriteTemplateRead,
+		UpdateContext: resourceTencentCloudVodImageSpriteTemplateUpdate,
+		DeleteContext: resourceTencentCloudVodImageSpriteTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -130,7 +134,7 @@ func resourceTencentCloudVodImageSpriteTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVodImageSpriteTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodImageSpriteTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_image_sprite_template.create")()
 
 	var (
@@ -156,8 +160,10 @@ func resourceTencentCloudVodImageSpriteTemplateCreate(d *schema.ResourceData, me
 
 	var response *vod.CreateImageSpriteTemplateResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		response, err = meta.(*TencentCloudClient).apiV3Conn.UseVodClient().CreateImageSpriteTemplate(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -166,33 +172,33 @@ func resourceTencentCloudVodImageSpriteTemplateCreate(d *schema.ResourceData, me
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if response == nil || response.Response == nil {
-		return fmt.Errorf("for image sprite template creation, response is nil")
+		return diag.Errorf("for image sprite template creation, response is nil")
 	}
 	d.SetId(strconv.FormatUint(*response.Response.Definition, 10))
 
-	return resourceTencentCloudVodImageSpriteTemplateRead(d, meta)
+	return resourceTencentCloudVodImageSpriteTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVodImageSpriteTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodImageSpriteTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_image_sprite_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		id         = d.Id()
 		subAppId   = d.Get("sub_app_id").(int)
 		client     = meta.(*TencentCloudClient).apiV3Conn
 		vodService = VodService{client: client}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	// waiting for refreshing cache
 	time.Sleep(30 * time.Second)
 	template, has, err := vodService.DescribeImageSpriteTemplatesById(ctx, id, subAppId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -215,7 +221,7 @@ func resourceTencentCloudVodImageSpriteTemplateRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudVodImageSpriteTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodImageSpriteTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_image_sprite_template.update")()
 
 	var (
@@ -268,8 +274,10 @@ func resourceTencentCloudVodImageSpriteTemplateUpdate(d *schema.ResourceData, me
 
 	if changeFlag {
 		var err error
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 			_, err = meta.(*TencentCloudClient).apiV3Conn.UseVodClient().ModifyImageSpriteTemplate(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -278,20 +286,20 @@ func resourceTencentCloudVodImageSpriteTemplateUpdate(d *schema.ResourceData, me
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		return resourceTencentCloudVodImageSpriteTemplateRead(d, meta)
+		return resourceTencentCloudVodImageSpriteTemplateRead(ctx, d, meta)
 	}
 
 	return nil
 }
 
-func resourceTencentCloudVodImageSpriteTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodImageSpriteTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_image_sprite_template.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	vodService := VodService{
@@ -299,7 +307,7 @@ func resourceTencentCloudVodImageSpriteTemplateDelete(d *schema.ResourceData, me
 	}
 
 	if err := vodService.DeleteImageSpriteTemplate(ctx, id, uint64(d.Get("sub_app_id").(int))); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -102,7 +102,9 @@ import (
 	"context"
 	"log"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vod "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vod/v20180717"
@@ -112,12 +114,16 @@ import (
 
 func resourceTencentCloudVodProcedureTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVodProcedureTemplateCreate,
-		Read:   resourceTencentCloudVodProcedureTemplateRead,
-		Update: resourceTencentCloudVodProcedureTemplateUpdate,
-		Delete: resourceTencentCloudVodProcedureTemplateDelete,
+		CreateContext: resourceTencentCloudVodProcedureTemplateCreate,
+		ReadContext:   resourceTencentCloudVodProcedureTemplateRead,
+		UpdateContext: resourceTencentCloudVodProcedureTemplateUpdate,
+		DeleteContext: resourceTencentCloudVodProcedureTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -618,7 +624,7 @@ func generateMediaProcessTask(d *schema.ResourceData) (mediaReq *vod.MediaProces
 	return mediaReq
 }
 
-func resourceTencentCloudVodProcedureTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodProcedureTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_procedure_template.create")()
 
 	var (
@@ -639,8 +645,10 @@ func resourceTencentCloudVodProcedureTemplateCreate(d *schema.ResourceData, meta
 	}
 
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		_, err = meta.(*TencentCloudClient).apiV3Conn.UseVodClient().CreateProcedureTemplate(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -649,28 +657,28 @@ func resourceTencentCloudVodProcedureTemplateCreate(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(d.Get("name").(string))
 
-	return resourceTencentCloudVodProcedureTemplateRead(d, meta)
+	return resourceTencentCloudVodProcedureTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVodProcedureTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodProcedureTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_procedure_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		id         = d.Id()
 		subAppId   = d.Get("sub_app_id").(int)
 		client     = meta.(*TencentCloudClient).apiV3Conn
 		vodService = VodService{client: client}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	template, has, err := vodService.DescribeProcedureTemplatesById(ctx, id, subAppId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -873,7 +881,7 @@ func resourceTencentCloudVodProcedureTemplateRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudVodProcedureTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodProcedureTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_procedure_template.update")()
 
 	var (
@@ -900,8 +908,10 @@ func resourceTencentCloudVodProcedureTemplateUpdate(d *schema.ResourceData, meta
 
 	if changeFlag {
 		var err error
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 			_, err = meta.(*TencentCloudClient).apiV3Conn.UseVodClient().ResetProcedureTemplate(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -910,20 +920,20 @@ func resourceTencentCloudVodProcedureTemplateUpdate(d *schema.ResourceData, meta
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		return resourceTencentCloudVodProcedureTemplateRead(d, meta)
+		return resourceTencentCloudVodProcedureTemplateRead(ctx, d, meta)
 	}
 
 	return nil
 }
 
-func resourceTencentCloudVodProcedureTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodProcedureTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_procedure_template.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	vodService := VodService{
@@ -931,7 +941,7 @@ func resourceTencentCloudVodProcedureTemplateDelete(d *schema.ResourceData, meta
 	}
 
 	if err := vodService.DeleteProcedureTemplate(ctx, id, uint64(d.Get("sub_app_id").(int))); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
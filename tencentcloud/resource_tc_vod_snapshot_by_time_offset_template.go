@@ -27,11 +27,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vod "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vod/v20180717"
@@ -41,12 +41,16 @@ import (
 
 func resourceTencentCloudVodSnapshotByTimeOffsetTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVodSnapshotByTimeOffsetTemplateCreate,
-		Read:   resourceTencentCloudVodSnapshotByTimeOffsetTemplateRead,
-		Update: resourceTencentCloudVodSnapshotByTimeOffsetTemplateUpdate,
-		Delete: resourceTencentCloudVodSnapshotByTimeOffsetTemplateDelete,
+		CreateContext: resourceTencentCloudVodSnapshotByTimeOffsetTemplateCreate,
+		ReadContext:   resourceTencentCloudVodSnapshotByTimeOffsetTemplateRead,
+		UpdateContext: resourceTencentCloudVodSnapshotByTimeOffsetTemplateUpdate,
+		DeleteContext: resourceTencentCloudVodSnapshotByTimeOffsetTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -111,7 +115,7 @@ func resourceTencentCloudVodSnapshotByTimeOffsetTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVodSnapshotByTimeOffsetTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodSnapshotByTimeOffsetTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_snapshot_by_time_offset_template.create")()
 
 	var (
@@ -136,8 +140,10 @@ func resourceTencentCloudVodSnapshotByTimeOffsetTemplateCreate(d *schema.Resourc
 
 	var response *vod.CreateSnapshotByTimeOffsetTemplateResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		response, err = meta.(*TencentCloudClient).apiV3Conn.UseVodClient().CreateSnapshotByTimeOffsetTemplate(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -146,33 +152,33 @@ func resourceTencentCloudVodSnapshotByTimeOffsetTemplateCreate(d *schema.Resourc
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if response == nil || response.Response == nil {
-		return fmt.Errorf("for vod snapshot by time offset template creation, response is nil")
+		return diag.Errorf("for vod snapshot by time offset template creation, response is nil")
 	}
 	d.SetId(strconv.FormatUint(*response.Response.Definition, 10))
 
-	return resourceTencentCloudVodSnapshotByTimeOffsetTemplateRead(d, meta)
+	return resourceTencentCloudVodSnapshotByTimeOffsetTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVodSnapshotByTimeOffsetTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodSnapshotByTimeOffsetTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_snapshot_by_time_offset_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		id         = d.Id()
 		subAppId   = d.Get("sub_app_id").(int)
 		client     = meta.(*TencentCloudClient).apiV3Conn
 		vodService = VodService{client: client}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	// waiting for refreshing cache
 	time.Sleep(30 * time.Second)
 	template, has, err := vodService.DescribeSnapshotByTimeOffsetTemplatesById(ctx, id, subAppId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -192,7 +198,7 @@ func resourceTencentCloudVodSnapshotByTimeOffsetTemplateRead(d *schema.ResourceD
 	return nil
 }
 
-func resourceTencentCloudVodSnapshotByTimeOffsetTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodSnapshotByTimeOffsetTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_snapshot_by_time_offset_template.update")()
 
 	var (
@@ -233,8 +239,10 @@ func resourceTencentCloudVodSnapshotByTimeOffsetTemplateUpdate(d *schema.Resourc
 
 	if changeFlag {
 		var err error
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 			_, err = meta.(*TencentCloudClient).apiV3Conn.UseVodClient().ModifySnapshotByTimeOffsetTemplate(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -243,20 +251,20 @@ func resourceTencentCloudVodSnapshotByTimeOffsetTemplateUpdate(d *schema.Resourc
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		return resourceTencentCloudVodSnapshotByTimeOffsetTemplateRead(d, meta)
+		return resourceTencentCloudVodSnapshotByTimeOffsetTemplateRead(ctx, d, meta)
 	}
 
 	return nil
 }
 
-func resourceTencentCloudVodSnapshotByTimeOffsetTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodSnapshotByTimeOffsetTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_snapshot_by_time_offset_template.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	vodService := VodService{
@@ -264,7 +272,7 @@ func resourceTencentCloudVodSnapshotByTimeOffsetTemplateDelete(d *schema.Resourc
 	}
 
 	if err := vodService.DeleteSnapshotByTimeOffsetTemplate(ctx, id, uint64(d.Get("sub_app_id").(int))); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -22,10 +22,11 @@ $ terraform import tencentcloud_vod_sub_application.foo name+"#"+id
 package tencentcloud
 
 import (
-	"fmt"
+	"context"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vod "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vod/v20180717"
@@ -35,12 +36,17 @@ import (
 
 func resourceTencentCloudVodSubApplication() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVodSubApplicationCreate,
-		Read:   resourceTencentCloudVodSubApplicationRead,
-		Update: resourceTencentCloudVodSubApplicationUpdate,
-		Delete: resourceTencentCloudVodSubApplicationDelete,
+		CreateContext: resourceTencentCloudVodSubApplicationCreate,
+		ReadContext:   resourceTencentCloudVodSubApplicationRead,
+		UpdateContext: resourceTencentCloudVodSubApplicationUpdate,
+		DeleteContext: resourceTencentCloudVodSubApplicationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -71,7 +77,7 @@ func resourceTencentCloudVodSubApplication() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVodSubApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodSubApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_sub_application.create")()
 
 	var (
@@ -90,8 +96,10 @@ func resourceTencentCloudVodSubApplicationCreate(d *schema.ResourceData, meta in
 		request.Description = helper.String(v.(string))
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		response, err := meta.(*TencentCloudClient).apiV3Conn.UseVodClient().CreateSubAppId(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -100,18 +108,20 @@ func resourceTencentCloudVodSubApplicationCreate(d *schema.ResourceData, meta in
 		subAppId = response.Response.SubAppId
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(*subAppName + FILED_SP + helper.UInt64ToStr(*subAppId))
+	d.SetId(helper.IdFormat(*subAppName, helper.UInt64ToStr(*subAppId)))
 
 	if v, ok := d.GetOk("status"); ok {
 		statusResquest := vod.NewModifySubAppIdStatusRequest()
 		statusResquest.SubAppId = subAppId
 		statusResquest.Status = helper.String(v.(string))
 
-		if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(statusResquest.GetAction())
+		if err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, statusResquest.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 			_, err := meta.(*TencentCloudClient).apiV3Conn.UseVodClient().ModifySubAppIdStatus(statusResquest)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -119,14 +129,14 @@ func resourceTencentCloudVodSubApplicationCreate(d *schema.ResourceData, meta in
 			}
 			return nil
 		}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudVodSubApplicationRead(d, meta)
+	return resourceTencentCloudVodSubApplicationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVodSubApplicationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodSubApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_sub_application.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -138,9 +148,9 @@ func resourceTencentCloudVodSubApplicationRead(d *schema.ResourceData, meta inte
 		appInfo = vod.SubAppIdInfo{}
 	)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("sub application id is borken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	subAppName := idSplit[0]
 	subAppId := idSplit[1]
@@ -149,7 +159,7 @@ func resourceTencentCloudVodSubApplicationRead(d *schema.ResourceData, meta inte
 
 	response, err := client.UseVodClient().DescribeSubAppIds(request)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	infoSet := response.Response.SubAppIdInfoSet
 	if len(infoSet) == 0 {
@@ -172,7 +182,7 @@ func resourceTencentCloudVodSubApplicationRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudVodSubApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodSubApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_sub_application.update")()
 
 	var (
@@ -181,9 +191,9 @@ func resourceTencentCloudVodSubApplicationUpdate(d *schema.ResourceData, meta in
 		changeFlag = false
 	)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("sub application id is borken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	subAppId := idSplit[1]
 
@@ -203,8 +213,10 @@ func resourceTencentCloudVodSubApplicationUpdate(d *schema.ResourceData, meta in
 
 	if changeFlag {
 		var err error
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 			_, err = meta.(*TencentCloudClient).apiV3Conn.UseVodClient().ModifySubAppIdInfo(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -213,7 +225,7 @@ func resourceTencentCloudVodSubApplicationUpdate(d *schema.ResourceData, meta in
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if d.HasChange("status") {
@@ -223,8 +235,10 @@ func resourceTencentCloudVodSubApplicationUpdate(d *schema.ResourceData, meta in
 		}
 		statusRequest.SubAppId = helper.Uint64(helper.StrToUInt64(subAppId))
 		var err error
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(statusRequest.GetAction())
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, statusRequest.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 			_, err = meta.(*TencentCloudClient).apiV3Conn.UseVodClient().ModifySubAppIdStatus(statusRequest)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, statusRequest.GetAction(), err.Error())
@@ -233,43 +247,47 @@ func resourceTencentCloudVodSubApplicationUpdate(d *schema.ResourceData, meta in
 			return nil
 		})
 	}
-	return resourceTencentCloudVodSubApplicationRead(d, meta)
+	return resourceTencentCloudVodSubApplicationRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVodSubApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodSubApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_sub_application.delete")()
 	logId := getLogId(contextNil)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("sub application id is borken, id is %s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	subAppId := idSplit[1]
 	var statusRequest = vod.NewModifySubAppIdStatusRequest()
 	// first turn off
 	statusRequest.Status = helper.String("Off")
 	statusRequest.SubAppId = helper.Uint64(helper.StrToUInt64(subAppId))
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(statusRequest.GetAction())
+	if err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, statusRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		if _, err := meta.(*TencentCloudClient).apiV3Conn.UseVodClient().ModifySubAppIdStatus(statusRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, statusRequest.GetAction(), err.Error())
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// then destroy
 	statusRequest.Status = helper.String("Destroyed")
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(statusRequest.GetAction())
+	if err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, statusRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		if _, err := meta.(*TencentCloudClient).apiV3Conn.UseVodClient().ModifySubAppIdStatus(statusRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, statusRequest.GetAction(), err.Error())
 			return retryError(err, InternalError)
 		}
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -93,7 +93,9 @@ import (
 	"context"
 	"log"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vod "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vod/v20180717"
@@ -103,12 +105,16 @@ import (
 
 func resourceTencentCloudVodSuperPlayerConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVodSuperPlayerConfigCreate,
-		Read:   resourceTencentCloudVodSuperPlayerConfigRead,
-		Update: resourceTencentCloudVodSuperPlayerConfigUpdate,
-		Delete: resourceTencentCloudVodSuperPlayerConfigDelete,
+		CreateContext: resourceTencentCloudVodSuperPlayerConfigCreate,
+		ReadContext:   resourceTencentCloudVodSuperPlayerConfigRead,
+		UpdateContext: resourceTencentCloudVodSuperPlayerConfigUpdate,
+		DeleteContext: resourceTencentCloudVodSuperPlayerConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -208,7 +214,7 @@ func resourceTencentCloudVodSuperPlayerConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVodSuperPlayerConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodSuperPlayerConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_super_player_config.create")()
 
 	var (
@@ -287,8 +293,10 @@ func resourceTencentCloudVodSuperPlayerConfigCreate(d *schema.ResourceData, meta
 	}
 
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		_, err = meta.(*TencentCloudClient).apiV3Conn.UseVodClient().CreateSuperPlayerConfig(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -297,28 +305,28 @@ func resourceTencentCloudVodSuperPlayerConfigCreate(d *schema.ResourceData, meta
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(d.Get("name").(string))
 
-	return resourceTencentCloudVodSuperPlayerConfigRead(d, meta)
+	return resourceTencentCloudVodSuperPlayerConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVodSuperPlayerConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodSuperPlayerConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_super_player_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		id         = d.Id()
 		client     = meta.(*TencentCloudClient).apiV3Conn
 		vodService = VodService{client: client}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	config, has, err := vodService.DescribeSuperPlayerConfigsById(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -360,7 +368,7 @@ func resourceTencentCloudVodSuperPlayerConfigRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceTencentCloudVodSuperPlayerConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodSuperPlayerConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_super_player_config.update")()
 
 	var (
@@ -434,8 +442,10 @@ func resourceTencentCloudVodSuperPlayerConfigUpdate(d *schema.ResourceData, meta
 
 	if changeFlag {
 		var err error
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 			_, err = meta.(*TencentCloudClient).apiV3Conn.UseVodClient().ModifySuperPlayerConfig(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -444,20 +454,20 @@ func resourceTencentCloudVodSuperPlayerConfigUpdate(d *schema.ResourceData, meta
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		return resourceTencentCloudVodSuperPlayerConfigRead(d, meta)
+		return resourceTencentCloudVodSuperPlayerConfigRead(ctx, d, meta)
 	}
 
 	return nil
 }
 
-func resourceTencentCloudVodSuperPlayerConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVodSuperPlayerConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vod_super_player_config.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 	vodService := VodService{
@@ -465,7 +475,7 @@ func resourceTencentCloudVodSuperPlayerConfigDelete(d *schema.ResourceData, meta
 	}
 
 	if err := vodService.DeleteSuperPlayerConfig(ctx, id, uint64(d.Get("sub_app_id").(int))); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -51,6 +51,7 @@ import (
 
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -59,12 +60,15 @@ import (
 
 func resourceTencentCloudVpcInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcInstanceCreate,
-		Read:   resourceTencentCloudVpcInstanceRead,
-		Update: resourceTencentCloudVpcInstanceUpdate,
-		Delete: resourceTencentCloudVpcInstanceDelete,
+		CreateContext: resourceTencentCloudVpcInstanceCreate,
+		ReadContext:   resourceTencentCloudVpcInstanceRead,
+		UpdateContext: resourceTencentCloudVpcInstanceUpdate,
+		DeleteContext: resourceTencentCloudVpcInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -140,11 +144,11 @@ func resourceTencentCloudVpcInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	vpcService := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -169,10 +173,10 @@ func resourceTencentCloudVpcInstanceCreate(d *schema.ResourceData, meta interfac
 			dnsServers = append(dnsServers, v.(string))
 		}
 		if len(dnsServers) < 1 {
-			return fmt.Errorf("If dns_servers is set, then len(dns_servers) should be [1:4]")
+			return diag.Errorf("If dns_servers is set, then len(dns_servers) should be [1:4]")
 		}
 		if len(dnsServers) > 4 {
-			return fmt.Errorf("If dns_servers is set, then len(dns_servers) should be [1:4]")
+			return diag.Errorf("If dns_servers is set, then len(dns_servers) should be [1:4]")
 		}
 
 	}
@@ -183,7 +187,7 @@ func resourceTencentCloudVpcInstanceCreate(d *schema.ResourceData, meta interfac
 	}
 	vpcId, _, err := vpcService.CreateVpc(ctx, name, cidrBlock, isMulticast, dnsServers, tags)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(vpcId)
@@ -195,26 +199,26 @@ func resourceTencentCloudVpcInstanceCreate(d *schema.ResourceData, meta interfac
 		request.CidrBlocks = helper.InterfacesStringsPoint(assistantCidrs)
 		_, err := vpcService.CreateAssistantCidr(ctx, request)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	// protected while tag is not ready, default is 1s
 	time.Sleep(waitReadTimeout)
 
-	return resourceTencentCloudVpcInstanceRead(d, meta)
+	return resourceTencentCloudVpcInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	id := d.Id()
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		info, has, e := service.DescribeVpc(ctx, id, "", "")
 		if e != nil {
 			return retryError(e)
@@ -272,16 +276,16 @@ func resourceTencentCloudVpcInstanceRead(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudVpcInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	id := d.Id()
 
@@ -307,10 +311,10 @@ func resourceTencentCloudVpcInstanceUpdate(d *schema.ResourceData, meta interfac
 	if d.HasChange("dns_servers") {
 		slice = now.(*schema.Set).List()
 		if len(slice) < 1 {
-			return fmt.Errorf("If dns_servers is set, then len(dns_servers) should be [1:4]")
+			return diag.Errorf("If dns_servers is set, then len(dns_servers) should be [1:4]")
 		}
 		if len(slice) > 4 {
-			return fmt.Errorf("If dns_servers is set, then len(dns_servers) should be [1:4]")
+			return diag.Errorf("If dns_servers is set, then len(dns_servers) should be [1:4]")
 		}
 	} else {
 		slice = old.(*schema.Set).List()
@@ -330,7 +334,7 @@ func resourceTencentCloudVpcInstanceUpdate(d *schema.ResourceData, meta interfac
 	}
 
 	if err := vpcService.ModifyVpcAttribute(ctx, id, name, isMulticast, dnsServers); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("assistant_cidrs") {
@@ -340,7 +344,7 @@ func resourceTencentCloudVpcInstanceUpdate(d *schema.ResourceData, meta interfac
 		request.NewCidrBlocks = helper.InterfacesStringsPoint(now.([]interface{}))
 		request.OldCidrBlocks = helper.InterfacesStringsPoint(old.([]interface{}))
 		if err := vpcService.ModifyAssistantCidr(ctx, request); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -354,25 +358,25 @@ func resourceTencentCloudVpcInstanceUpdate(d *schema.ResourceData, meta interfac
 		resourceName := fmt.Sprintf("qcs::vpc:%s:uin/:vpc/%s", region, id)
 
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudVpcInstanceRead(d, meta)
+	return resourceTencentCloudVpcInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		if err := service.DeleteVpc(ctx, d.Id()); err != nil {
 			if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok {
 				if sdkErr.Code == VPCNotFound {
@@ -384,5 +388,5 @@ func resourceTencentCloudVpcInstanceDelete(d *schema.ResourceData, meta interfac
 		return nil
 	})
 
-	return err
+	return diag.FromErr(err)
 }
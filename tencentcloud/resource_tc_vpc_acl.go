@@ -41,18 +41,19 @@ import (
 
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudVpcACL() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcACLCreate,
-		Read:   resourceTencentCloudVpcACLRead,
-		Update: resourceTencentCloudVpcACLUpdate,
-		Delete: resourceTencentCloudVpcACLDelete,
+		CreateContext: resourceTencentCloudVpcACLCreate,
+		ReadContext:   resourceTencentCloudVpcACLRead,
+		UpdateContext: resourceTencentCloudVpcACLUpdate,
+		DeleteContext: resourceTencentCloudVpcACLDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -95,12 +96,11 @@ func resourceTencentCloudVpcACL() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcACLCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_acl.create")()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		vpcService = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 		ingress []VpcACLRule
@@ -109,13 +109,14 @@ func resourceTencentCloudVpcACLCreate(d *schema.ResourceData, meta interface{})
 		name    = d.Get("name").(string)
 		tags    map[string]string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if temp, ok := d.GetOk("ingress"); ok {
 		ingressStrs := helper.InterfacesStrings(temp.([]interface{}))
 		for _, ingressStr := range ingressStrs {
 			liteRule, err := parseACLRule(ingressStr)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			ingress = append(ingress, liteRule)
 		}
@@ -125,7 +126,7 @@ func resourceTencentCloudVpcACLCreate(d *schema.ResourceData, meta interface{})
 		for _, egressStr := range egressStrs {
 			liteRule, err := parseACLRule(egressStr)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			egress = append(egress, liteRule)
 		}
@@ -137,7 +138,7 @@ func resourceTencentCloudVpcACLCreate(d *schema.ResourceData, meta interface{})
 
 	aclID, err := vpcService.CreateVpcNetworkAcl(ctx, vpcID, name, tags)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(aclID)
@@ -148,33 +149,33 @@ func resourceTencentCloudVpcACLCreate(d *schema.ResourceData, meta interface{})
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		resourceName := BuildTagResourceName("vpc", "acl", region, aclID)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if len(ingress) > 0 || len(egress) > 0 {
 		err = vpcService.AttachRulesToACL(ctx, aclID, ingress, egress)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudVpcACLRead(d, meta)
+	return resourceTencentCloudVpcACLRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcACLRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcACLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_acl.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id      = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	info, has, err := service.DescribeNetWorkByACLID(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if has == 0 {
 		log.Printf("[WARN]%s %s\n", logId, "ACL has been delete")
@@ -184,7 +185,7 @@ func resourceTencentCloudVpcACLRead(d *schema.ResourceData, meta interface{}) er
 	if has != 1 {
 		errRet := fmt.Errorf("one acl_id read get %d ACL info", has)
 		log.Printf("[CRITAL]%s %s\n", logId, errRet.Error())
-		return errRet
+		return diag.FromErr(errRet)
 	}
 
 	_ = d.Set("vpc_id", info.VpcId)
@@ -274,19 +275,18 @@ func resourceTencentCloudVpcACLRead(d *schema.ResourceData, meta interface{}) er
 	region := client.Region
 	tags, err := tagService.DescribeResourceTags(ctx, "vpc", "acl", region, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudVpcACLUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcACLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_acl.update")()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id      = d.Id()
 
@@ -294,6 +294,7 @@ func resourceTencentCloudVpcACLUpdate(d *schema.ResourceData, meta interface{})
 		ingress []VpcACLRule
 		egress  []VpcACLRule
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	d.Partial(true)
 
@@ -321,7 +322,7 @@ func resourceTencentCloudVpcACLUpdate(d *schema.ResourceData, meta interface{})
 			for _, ingressStr := range newIngress {
 				liteRule, err := parseACLRule(ingressStr)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				ingress = append(ingress, liteRule)
 			}
@@ -344,7 +345,7 @@ func resourceTencentCloudVpcACLUpdate(d *schema.ResourceData, meta interface{})
 			for _, egressStr := range newIngress {
 				liteRule, err := parseACLRule(egressStr)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				egress = append(egress, liteRule)
 			}
@@ -353,7 +354,7 @@ func resourceTencentCloudVpcACLUpdate(d *schema.ResourceData, meta interface{})
 
 	if d.HasChange("egress") || d.HasChange("ingress") {
 		if err := service.ModifyNetWorkAclRules(ctx, id, ingress, egress); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -367,37 +368,37 @@ func resourceTencentCloudVpcACLUpdate(d *schema.ResourceData, meta interface{})
 
 		resourceName := BuildTagResourceName("vpc", "acl", region, id)
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudVpcACLRead(d, meta)
+	return resourceTencentCloudVpcACLRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcACLDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcACLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_acl.delete")()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id      = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	err := service.DeleteAcl(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, has, err := service.DescribeNetWorkByACLID(ctx, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if has > 0 {
-		return fmt.Errorf("[CRITAL]%s delete network ACL : %s  failed\n", logId, id)
+		return diag.Errorf("[CRITAL]%s delete network ACL : %s  failed\n", logId, id)
 	}
 	return nil
 }
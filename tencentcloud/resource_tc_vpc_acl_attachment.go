@@ -50,18 +50,19 @@ package tencentcloud
 import (
 	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func resourceTencentCloudVpcAclAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcAclAttachmentCreate,
-		Read:   resourceTencentCloudVpcAclAttachmentRead,
-		Delete: resourceTencentCloudVpcAclAttachmentDelete,
+		CreateContext: resourceTencentCloudVpcAclAttachmentCreate,
+		ReadContext:   resourceTencentCloudVpcAclAttachmentRead,
+		DeleteContext: resourceTencentCloudVpcAclAttachmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -82,14 +83,14 @@ func resourceTencentCloudVpcAclAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcAclAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcAclAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_acl_attachment.create")()
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		subnetIds []string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	aclId := d.Get("acl_id").(string)
 	subnetId := d.Get("subnet_id").(string)
@@ -98,26 +99,26 @@ func resourceTencentCloudVpcAclAttachmentCreate(d *schema.ResourceData, meta int
 
 	err := service.AssociateAclSubnets(ctx, aclId, subnetIds)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(aclId + FILED_SP + subnetId)
+	d.SetId(helper.IdFormat(aclId, subnetId))
 
-	return resourceTencentCloudVpcAclAttachmentRead(d, meta)
+	return resourceTencentCloudVpcAclAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcAclAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcAclAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_acl_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId        = getLogId(contextNil)
-		ctx          = context.WithValue(context.TODO(), logIdKey, logId)
 		service      = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		attachmentId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(attachmentId, FILED_SP)
+	idSplit := helper.IdParse(attachmentId)
 	if len(idSplit) < 2 {
 		d.SetId("")
 		return nil
@@ -126,7 +127,7 @@ func resourceTencentCloudVpcAclAttachmentRead(d *schema.ResourceData, meta inter
 	subnetId := idSplit[1]
 	results, err := service.DescribeNetWorkAcls(ctx, aclId, "", "")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if len(results) < 1 || len(results[0].SubnetSet) < 1 {
 		d.SetId("")
@@ -140,19 +141,19 @@ func resourceTencentCloudVpcAclAttachmentRead(d *schema.ResourceData, meta inter
 
 }
 
-func resourceTencentCloudVpcAclAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcAclAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_acl_attachment.delete")()
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		service       = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		attachmentAcl = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	err := service.DeleteAclAttachment(ctx, attachmentAcl)
 	if err != nil {
 		log.Printf("[CRITAL]%s delete ACL attachment failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
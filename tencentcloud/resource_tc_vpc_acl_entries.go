@@ -0,0 +1,537 @@
+/*
+Provides a resource to manage the entries of a VPC network ACL as ordered lists. The changed entries are patched, the added and removed ones are created and deleted, and the entries are reordered in place instead of replacing the whole rule set.
+
+~> **NOTE:** The resource manages all the entries of the network ACL except the default ones, so the `ingress` and `egress` of the `tencentcloud_vpc_acl` must be left unset and their changes ignored as below.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_vpc" "vpc" {
+  name       = "vpc-example"
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "tencentcloud_vpc_acl" "example" {
+  vpc_id = tencentcloud_vpc.vpc.id
+  name   = "tf-example"
+
+  lifecycle {
+    ignore_changes = [ingress, egress]
+  }
+}
+
+resource "tencentcloud_vpc_acl_entries" "example" {
+  network_acl_id = tencentcloud_vpc_acl.example.id
+
+  ingress {
+    protocol    = "TCP"
+    port        = "80,443"
+    cidr_block  = "0.0.0.0/0"
+    action      = "ACCEPT"
+    description = "web"
+  }
+
+  ingress {
+    protocol    = "TCP"
+    port        = "22"
+    cidr_block  = "10.0.0.0/8"
+    action      = "ACCEPT"
+    description = "ssh from the office"
+  }
+
+  ingress {
+    protocol        = "ALL"
+    ipv6_cidr_block = "::/0"
+    action          = "DROP"
+  }
+
+  egress {
+    protocol   = "ALL"
+    cidr_block = "0.0.0.0/0"
+    action     = "ACCEPT"
+  }
+}
+```
+
+Import
+
+VPC ACL entries can be imported using the id of the network ACL, e.g.
+
+```
+$ terraform import tencentcloud_vpc_acl_entries.example acl-12345678
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudVpcAclEntries() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTencentCloudVpcAclEntriesCreate,
+		ReadContext:   resourceTencentCloudVpcAclEntriesRead,
+		UpdateContext: resourceTencentCloudVpcAclEntriesUpdate,
+		DeleteContext: resourceTencentCloudVpcAclEntriesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceTencentCloudVpcAclEntriesCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"network_acl_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the network ACL.",
+			},
+			"ingress": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        vpcAclEntryResource(),
+				Description: "Ingress entries of the network ACL, the entries are evaluated in the order of the list.",
+			},
+			"egress": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        vpcAclEntryResource(),
+				Description: "Egress entries of the network ACL, the entries are evaluated in the order of the list.",
+			},
+		},
+	}
+}
+
+func vpcAclEntryResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue(VPC_ACL_ENTRY_PROTOCOLS),
+				Description:  "Protocol of the entry. Valid values: `TCP`, `UDP`, `ICMP`, `ALL`.",
+			},
+			"port": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     VPC_ACL_ENTRY_PORT_ALL,
+				Description: "Port of the entry, such as `80`, `80,443`, `80-90` or `ALL`. It must be `ALL` when `protocol` is `ICMP` or `ALL`. Default is `ALL`.",
+			},
+			"cidr_block": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+				Description:  "IPv4 CIDR block of the entry. Exactly one of `cidr_block` and `ipv6_cidr_block` must be set.",
+			},
+			"ipv6_cidr_block": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "IPv6 CIDR block of the entry. Exactly one of `cidr_block` and `ipv6_cidr_block` must be set.",
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue(VPC_ACL_ENTRY_ACTIONS),
+				Description:  "Action of the entry. Valid values: `ACCEPT`, `DROP`.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStringLengthInRange(0, 100),
+				Description:  "Description of the entry.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudVpcAclEntriesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	var errs *multierror.Error
+	for _, key := range []string{"ingress", "egress"} {
+		if !d.NewValueKnown(key) {
+			continue
+		}
+		for i := range d.Get(key).([]interface{}) {
+			prefix := fmt.Sprintf("%s.%d", key, i)
+			if d.NewValueKnown(prefix+".cidr_block") && d.NewValueKnown(prefix+".ipv6_cidr_block") {
+				cidrBlock := d.Get(prefix + ".cidr_block").(string)
+				ipv6CidrBlock := d.Get(prefix + ".ipv6_cidr_block").(string)
+				if (cidrBlock == "") == (ipv6CidrBlock == "") {
+					errs = multierror.Append(errs, fmt.Errorf("exactly one of `%s.cidr_block` and `%s.ipv6_cidr_block` must be set", prefix, prefix))
+				}
+			}
+			if d.NewValueKnown(prefix+".protocol") && d.NewValueKnown(prefix+".port") {
+				protocol := d.Get(prefix + ".protocol").(string)
+				port := d.Get(prefix + ".port").(string)
+				if !vpcAclEntryHasPort(protocol) && port != VPC_ACL_ENTRY_PORT_ALL {
+					errs = multierror.Append(errs, fmt.Errorf("`%s.port` must be `ALL` when `%s.protocol` is %s", prefix, prefix, protocol))
+				}
+			}
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+func resourceTencentCloudVpcAclEntriesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_vpc_acl_entries.create")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+	aclId := d.Get("network_acl_id").(string)
+
+	if err := reconcileVpcAclEntries(ctx, &service, aclId, d); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(aclId)
+
+	return resourceTencentCloudVpcAclEntriesRead(ctx, d, meta)
+}
+
+func resourceTencentCloudVpcAclEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_vpc_acl_entries.read")()
+	defer inconsistentCheck(d, meta)()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	info, has, err := service.DescribeNetWorkByACLID(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if has == 0 {
+		log.Printf("[WARN]%s network acl [%s] not found, please check if it has been deleted.\n", logId, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("network_acl_id", d.Id())
+	_ = d.Set("ingress", flattenVpcAclEntries(liveVpcAclEntries(info.IngressEntries)))
+	_ = d.Set("egress", flattenVpcAclEntries(liveVpcAclEntries(info.EgressEntries)))
+
+	return nil
+}
+
+func resourceTencentCloudVpcAclEntriesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_vpc_acl_entries.update")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if d.HasChanges("ingress", "egress") {
+		if err := reconcileVpcAclEntries(ctx, &service, d.Id(), d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceTencentCloudVpcAclEntriesRead(ctx, d, meta)
+}
+
+func resourceTencentCloudVpcAclEntriesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_vpc_acl_entries.delete")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+	aclId := d.Id()
+
+	info, has, err := service.DescribeNetWorkByACLID(ctx, aclId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if has == 0 {
+		return nil
+	}
+
+	entrySet := &vpc.NetworkAclEntrySet{}
+	for _, entry := range liveVpcAclEntries(info.IngressEntries) {
+		entrySet.Ingress = append(entrySet.Ingress, vpcAclEntryRef(entry))
+	}
+	for _, entry := range liveVpcAclEntries(info.EgressEntries) {
+		entrySet.Egress = append(entrySet.Egress, vpcAclEntryRef(entry))
+	}
+	if len(entrySet.Ingress) == 0 && len(entrySet.Egress) == 0 {
+		return nil
+	}
+	if err := service.DeleteNetworkAclEntries(ctx, aclId, entrySet); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// reconcileVpcAclEntries makes the entries of the network ACL the ingress and egress of d. The entries
+// are deleted, patched and created as planned by planVpcAclEntries, and then reordered.
+func reconcileVpcAclEntries(ctx context.Context, service *VpcService, aclId string, d *schema.ResourceData) error {
+	desiredIngress := expandVpcAclEntries(d.Get("ingress").([]interface{}))
+	desiredEgress := expandVpcAclEntries(d.Get("egress").([]interface{}))
+
+	info, has, err := service.DescribeNetWorkByACLID(ctx, aclId)
+	if err != nil {
+		return err
+	}
+	if has == 0 {
+		return fmt.Errorf("network acl %s not found", aclId)
+	}
+	ingressPlan := planVpcAclEntries(liveVpcAclEntries(info.IngressEntries), desiredIngress)
+	egressPlan := planVpcAclEntries(liveVpcAclEntries(info.EgressEntries), desiredEgress)
+
+	if len(ingressPlan.deletes) > 0 || len(egressPlan.deletes) > 0 {
+		entrySet := &vpc.NetworkAclEntrySet{Ingress: ingressPlan.deletes, Egress: egressPlan.deletes}
+		if err := service.DeleteNetworkAclEntries(ctx, aclId, entrySet); err != nil {
+			return err
+		}
+	}
+	if len(ingressPlan.updates) > 0 || len(egressPlan.updates) > 0 {
+		entrySet := &vpc.NetworkAclEntrySet{Ingress: ingressPlan.updates, Egress: egressPlan.updates}
+		if err := service.UpdateNetworkAclEntries(ctx, aclId, entrySet); err != nil {
+			return err
+		}
+	}
+	if len(ingressPlan.creates) > 0 || len(egressPlan.creates) > 0 {
+		entrySet := &vpc.NetworkAclEntrySet{Ingress: ingressPlan.creates, Egress: egressPlan.creates}
+		if err := service.CreateNetworkAclEntries(ctx, aclId, entrySet); err != nil {
+			return err
+		}
+	}
+
+	// the created entries are appended, so the order is fixed once all the entries have IDs
+	info, has, err = service.DescribeNetWorkByACLID(ctx, aclId)
+	if err != nil {
+		return err
+	}
+	if has == 0 {
+		return fmt.Errorf("network acl %s not found", aclId)
+	}
+	ingress, ingressChanged, err := orderVpcAclEntries(liveVpcAclEntries(info.IngressEntries), desiredIngress)
+	if err != nil {
+		return err
+	}
+	egress, egressChanged, err := orderVpcAclEntries(liveVpcAclEntries(info.EgressEntries), desiredEgress)
+	if err != nil {
+		return err
+	}
+	if !ingressChanged && !egressChanged {
+		return nil
+	}
+	entrySet := &vpc.NetworkAclEntrySet{}
+	if ingressChanged {
+		entrySet.Ingress = ingress
+	}
+	if egressChanged {
+		entrySet.Egress = egress
+	}
+	return service.UpdateNetworkAclEntries(ctx, aclId, entrySet)
+}
+
+// vpcAclEntriesPlan is the changes turning the live entries of a direction into the desired ones.
+type vpcAclEntriesPlan struct {
+	deletes []*vpc.NetworkAclEntry
+	updates []*vpc.NetworkAclEntry
+	creates []*vpc.NetworkAclEntry
+}
+
+// planVpcAclEntries pairs every desired entry with a live entry of the same content. The rest of
+// them are paired in order with the rest of the live entries of the same IP version, which are
+// patched so that they keep their IDs. The desired entries left are created and the live ones left
+// are deleted.
+func planVpcAclEntries(live, desired []*vpc.NetworkAclEntry) (plan vpcAclEntriesPlan) {
+	paired := make([]bool, len(live))
+	unpaired := make([]*vpc.NetworkAclEntry, 0, len(desired))
+	for _, entry := range desired {
+		index := -1
+		for i, liveEntry := range live {
+			if !paired[i] && vpcAclEntryKey(liveEntry) == vpcAclEntryKey(entry) {
+				index = i
+				break
+			}
+		}
+		if index == -1 {
+			unpaired = append(unpaired, entry)
+			continue
+		}
+		paired[index] = true
+	}
+
+	for _, entry := range unpaired {
+		index := -1
+		for i, liveEntry := range live {
+			if !paired[i] && isIpv6VpcAclEntry(liveEntry) == isIpv6VpcAclEntry(entry) {
+				index = i
+				break
+			}
+		}
+		if index == -1 {
+			plan.creates = append(plan.creates, vpcAclEntryRequest(entry, nil, 0))
+			continue
+		}
+		paired[index] = true
+		plan.updates = append(plan.updates, vpcAclEntryRequest(entry, live[index], 0))
+	}
+
+	for i, liveEntry := range live {
+		if !paired[i] {
+			plan.deletes = append(plan.deletes, vpcAclEntryRef(liveEntry))
+		}
+	}
+	return
+}
+
+// orderVpcAclEntries returns the desired entries with the IDs of the live entries of the same content
+// and the priorities of their positions, and whether the live entries are in another order.
+func orderVpcAclEntries(live, desired []*vpc.NetworkAclEntry) (ordered []*vpc.NetworkAclEntry, changed bool, errRet error) {
+	if len(live) != len(desired) {
+		errRet = fmt.Errorf("network acl has %d entries, expect %d", len(live), len(desired))
+		return
+	}
+	paired := make([]bool, len(live))
+	for position, entry := range desired {
+		index := -1
+		for i, liveEntry := range live {
+			if !paired[i] && vpcAclEntryKey(liveEntry) == vpcAclEntryKey(entry) {
+				index = i
+				break
+			}
+		}
+		if index == -1 {
+			errRet = fmt.Errorf("network acl entry %s is not found", vpcAclEntryKey(entry))
+			return
+		}
+		paired[index] = true
+		if index != position {
+			changed = true
+		}
+		ordered = append(ordered, vpcAclEntryRequest(entry, live[index], int64(position+1)))
+	}
+	return
+}
+
+// liveVpcAclEntries returns the normalized entries in the order of their priorities, without the default ones.
+func liveVpcAclEntries(entries []*vpc.NetworkAclEntry) []*vpc.NetworkAclEntry {
+	result := make([]*vpc.NetworkAclEntry, 0, len(entries))
+	for _, entry := range entries {
+		if CheckIfDefaultRule(entry) {
+			continue
+		}
+		normalized := *entry
+		normalized.Protocol = helper.String(strings.ToUpper(helper.PString(entry.Protocol)))
+		normalized.Action = helper.String(strings.ToUpper(helper.PString(entry.Action)))
+		if port := helper.PString(entry.Port); port == "" || !vpcAclEntryHasPort(*normalized.Protocol) {
+			normalized.Port = helper.String(VPC_ACL_ENTRY_PORT_ALL)
+		} else {
+			normalized.Port = helper.String(strings.ToUpper(port))
+		}
+		result = append(result, &normalized)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return helper.PInt64(result[i].Priority) < helper.PInt64(result[j].Priority)
+	})
+	return result
+}
+
+func expandVpcAclEntries(items []interface{}) []*vpc.NetworkAclEntry {
+	entries := make([]*vpc.NetworkAclEntry, 0, len(items))
+	for _, item := range items {
+		entryMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		entry := &vpc.NetworkAclEntry{
+			Protocol:    helper.String(entryMap["protocol"].(string)),
+			Port:        helper.String(entryMap["port"].(string)),
+			Action:      helper.String(entryMap["action"].(string)),
+			Description: helper.String(entryMap["description"].(string)),
+		}
+		if v := entryMap["cidr_block"].(string); v != "" {
+			entry.CidrBlock = helper.String(v)
+		}
+		if v := entryMap["ipv6_cidr_block"].(string); v != "" {
+			entry.Ipv6CidrBlock = helper.String(v)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func flattenVpcAclEntries(entries []*vpc.NetworkAclEntry) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, map[string]interface{}{
+			"protocol":        helper.PString(entry.Protocol),
+			"port":            helper.PString(entry.Port),
+			"cidr_block":      helper.PString(entry.CidrBlock),
+			"ipv6_cidr_block": helper.PString(entry.Ipv6CidrBlock),
+			"action":          helper.PString(entry.Action),
+			"description":     helper.PString(entry.Description),
+		})
+	}
+	return result
+}
+
+// vpcAclEntryKey is the content of the entry, the entries of the same key are interchangeable.
+func vpcAclEntryKey(entry *vpc.NetworkAclEntry) string {
+	return strings.Join([]string{
+		helper.PString(entry.Action),
+		helper.PString(entry.CidrBlock),
+		helper.PString(entry.Ipv6CidrBlock),
+		helper.PString(entry.Port),
+		helper.PString(entry.Protocol),
+		helper.PString(entry.Description),
+	}, FILED_SP)
+}
+
+// vpcAclEntryRequest returns the entry as the parameter of the entry APIs, with the ID of live
+// when it is not nil, and the priority when it is positive.
+func vpcAclEntryRequest(entry, live *vpc.NetworkAclEntry, priority int64) *vpc.NetworkAclEntry {
+	request := &vpc.NetworkAclEntry{
+		Protocol:      entry.Protocol,
+		CidrBlock:     entry.CidrBlock,
+		Ipv6CidrBlock: entry.Ipv6CidrBlock,
+		Action:        entry.Action,
+		Description:   entry.Description,
+	}
+	if vpcAclEntryHasPort(helper.PString(entry.Protocol)) {
+		request.Port = entry.Port
+	}
+	if live != nil {
+		if isIpv6VpcAclEntry(entry) {
+			request.NetworkAclIpv6EntryId = live.NetworkAclIpv6EntryId
+		} else {
+			request.NetworkAclIpv4EntryId = live.NetworkAclIpv4EntryId
+		}
+	}
+	if priority > 0 {
+		request.Priority = helper.Int64(priority)
+	}
+	return request
+}
+
+// vpcAclEntryRef returns the entry with only its ID, which is what DeleteNetworkAclEntries takes.
+func vpcAclEntryRef(entry *vpc.NetworkAclEntry) *vpc.NetworkAclEntry {
+	if isIpv6VpcAclEntry(entry) {
+		return &vpc.NetworkAclEntry{NetworkAclIpv6EntryId: entry.NetworkAclIpv6EntryId}
+	}
+	return &vpc.NetworkAclEntry{NetworkAclIpv4EntryId: entry.NetworkAclIpv4EntryId}
+}
+
+func isIpv6VpcAclEntry(entry *vpc.NetworkAclEntry) bool {
+	return helper.PString(entry.Ipv6CidrBlock) != ""
+}
+
+// vpcAclEntryHasPort reports whether the entries of the protocol take a port.
+func vpcAclEntryHasPort(protocol string) bool {
+	return protocol != VPC_ACL_ENTRY_PROTOCOL_ALL && protocol != VPC_ACL_ENTRY_PROTOCOL_ICMP
+}
@@ -0,0 +1,261 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func testVpcAclEntry(id, port, cidrBlock string) *vpc.NetworkAclEntry {
+	entry := &vpc.NetworkAclEntry{
+		Protocol: helper.String("TCP"),
+		Port:     helper.String(port),
+		Action:   helper.String("ACCEPT"),
+	}
+	if id != "" {
+		entry.NetworkAclIpv4EntryId = helper.String(id)
+	}
+	if cidrBlock != "" {
+		entry.CidrBlock = helper.String(cidrBlock)
+	} else {
+		entry.Ipv6CidrBlock = helper.String("::/0")
+		entry.NetworkAclIpv4EntryId = nil
+		entry.NetworkAclIpv6EntryId = helper.String(id)
+	}
+	return entry
+}
+
+func testVpcAclEntryIds(entries []*vpc.NetworkAclEntry) (ids []string) {
+	for _, entry := range entries {
+		if entry.NetworkAclIpv6EntryId != nil {
+			ids = append(ids, *entry.NetworkAclIpv6EntryId)
+		} else {
+			ids = append(ids, helper.PString(entry.NetworkAclIpv4EntryId))
+		}
+	}
+	return
+}
+
+func TestPlanVpcAclEntries(t *testing.T) {
+	live := []*vpc.NetworkAclEntry{
+		testVpcAclEntry("a", "80", "10.0.0.0/16"),
+		testVpcAclEntry("b", "443", "10.0.0.0/16"),
+		testVpcAclEntry("c", "22", "10.0.0.0/16"),
+		testVpcAclEntry("d", "53", ""),
+	}
+	desired := []*vpc.NetworkAclEntry{
+		testVpcAclEntry("", "22", "10.0.0.0/16"),
+		testVpcAclEntry("", "8443", "10.0.0.0/16"),
+		testVpcAclEntry("", "80", "10.0.0.0/16"),
+		testVpcAclEntry("", "53", "10.1.0.0/16"),
+	}
+
+	plan := planVpcAclEntries(live, desired)
+	if ids := testVpcAclEntryIds(plan.updates); fmt.Sprint(ids) != "[b]" || *plan.updates[0].Port != "8443" {
+		t.Errorf("expect entry b to be patched to port 8443, got %v", ids)
+	}
+	if len(plan.creates) != 1 || *plan.creates[0].CidrBlock != "10.1.0.0/16" || plan.creates[0].NetworkAclIpv4EntryId != nil {
+		t.Errorf("expect the ipv4 entry of port 53 to be created, got %d entries", len(plan.creates))
+	}
+	if ids := testVpcAclEntryIds(plan.deletes); fmt.Sprint(ids) != "[d]" {
+		t.Errorf("expect the ipv6 entry d to be deleted, got %v", ids)
+	}
+
+	plan = planVpcAclEntries(live, live)
+	if len(plan.updates) != 0 || len(plan.creates) != 0 || len(plan.deletes) != 0 {
+		t.Errorf("expect no changes for the same entries, got %+v", plan)
+	}
+}
+
+func TestOrderVpcAclEntries(t *testing.T) {
+	live := []*vpc.NetworkAclEntry{
+		testVpcAclEntry("a", "80", "10.0.0.0/16"),
+		testVpcAclEntry("b", "443", "10.0.0.0/16"),
+		testVpcAclEntry("c", "80", "10.0.0.0/16"),
+	}
+	desired := []*vpc.NetworkAclEntry{
+		testVpcAclEntry("", "443", "10.0.0.0/16"),
+		testVpcAclEntry("", "80", "10.0.0.0/16"),
+		testVpcAclEntry("", "80", "10.0.0.0/16"),
+	}
+
+	ordered, changed, err := orderVpcAclEntries(live, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed {
+		t.Errorf("expect the entries to be reordered")
+	}
+	if ids := testVpcAclEntryIds(ordered); fmt.Sprint(ids) != "[b a c]" {
+		t.Errorf("expect the order [b a c], got %v", ids)
+	}
+	for i, entry := range ordered {
+		if helper.PInt64(entry.Priority) != int64(i+1) {
+			t.Errorf("expect the priority of entry %d to be %d, got %d", i, i+1, helper.PInt64(entry.Priority))
+		}
+	}
+
+	if _, changed, _ = orderVpcAclEntries(live, live); changed {
+		t.Errorf("expect no reorder for the same entries")
+	}
+	if _, _, err = orderVpcAclEntries(live, desired[:2]); err == nil {
+		t.Errorf("expect an error for the entries not created")
+	}
+}
+
+func TestLiveVpcAclEntries(t *testing.T) {
+	entries := []*vpc.NetworkAclEntry{
+		{Protocol: helper.String("tcp"), Port: helper.String("80"), CidrBlock: helper.String("10.0.0.0/16"), Action: helper.String("Accept"), Priority: helper.Int64(2)},
+		{Protocol: helper.String("icmp"), CidrBlock: helper.String("10.0.0.0/16"), Action: helper.String("Drop"), Priority: helper.Int64(1)},
+		{Protocol: helper.String("all"), CidrBlock: helper.String("0.0.0.0/0"), Action: helper.String("Drop"), Priority: helper.Int64(3)},
+	}
+
+	live := liveVpcAclEntries(entries)
+	if len(live) != 2 {
+		t.Fatalf("expect the default entry to be removed, got %d entries", len(live))
+	}
+	if vpcAclEntryKey(live[0]) != "DROP#10.0.0.0/16##ALL#ICMP#" {
+		t.Errorf("unexpected first entry %s", vpcAclEntryKey(live[0]))
+	}
+	if vpcAclEntryKey(live[1]) != "ACCEPT#10.0.0.0/16##80#TCP#" {
+		t.Errorf("unexpected second entry %s", vpcAclEntryKey(live[1]))
+	}
+}
+
+func TestAccTencentCloudVpcAclEntriesResource_basic(t *testing.T) {
+	t.Parallel()
+	keyName := "tencentcloud_vpc_acl_entries.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcAclEntriesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcAclEntries,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(keyName, "network_acl_id"),
+					resource.TestCheckResourceAttr(keyName, "ingress.#", "2"),
+					resource.TestCheckResourceAttr(keyName, "ingress.0.port", "80,443"),
+					resource.TestCheckResourceAttr(keyName, "ingress.1.port", "22"),
+					resource.TestCheckResourceAttr(keyName, "egress.#", "1"),
+					resource.TestCheckResourceAttr(keyName, "egress.0.protocol", "ALL"),
+					resource.TestCheckResourceAttr(keyName, "egress.0.port", "ALL"),
+				),
+			},
+			{
+				ResourceName:      keyName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVpcAclEntriesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(keyName, "ingress.#", "3"),
+					resource.TestCheckResourceAttr(keyName, "ingress.0.port", "22"),
+					resource.TestCheckResourceAttr(keyName, "ingress.0.cidr_block", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(keyName, "ingress.1.port", "80,443"),
+					resource.TestCheckResourceAttr(keyName, "ingress.2.protocol", "ICMP"),
+					resource.TestCheckResourceAttr(keyName, "egress.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpcAclEntriesDestroy(s *terraform.State) error {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	service := VpcService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_vpc_acl_entries" {
+			continue
+		}
+		info, has, err := service.DescribeNetWorkByACLID(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if has == 0 {
+			continue
+		}
+		if n := len(liveVpcAclEntries(info.IngressEntries)) + len(liveVpcAclEntries(info.EgressEntries)); n > 0 {
+			return fmt.Errorf("network acl %s still has %d entries", rs.Primary.ID, n)
+		}
+	}
+	return nil
+}
+
+const testAccVpcAclEntriesBasic = `
+resource "tencentcloud_vpc" "vpc" {
+  name       = "tf-ci-test-acl-entries"
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "tencentcloud_vpc_acl" "example" {
+  vpc_id = tencentcloud_vpc.vpc.id
+  name   = "tf-ci-test-acl-entries"
+
+  lifecycle {
+    ignore_changes = [ingress, egress]
+  }
+}
+`
+
+const testAccVpcAclEntries = testAccVpcAclEntriesBasic + `
+resource "tencentcloud_vpc_acl_entries" "example" {
+  network_acl_id = tencentcloud_vpc_acl.example.id
+
+  ingress {
+    protocol    = "TCP"
+    port        = "80,443"
+    cidr_block  = "0.0.0.0/0"
+    action      = "ACCEPT"
+    description = "web"
+  }
+
+  ingress {
+    protocol   = "TCP"
+    port       = "22"
+    cidr_block = "10.0.0.0/16"
+    action     = "ACCEPT"
+  }
+
+  egress {
+    protocol   = "ALL"
+    cidr_block = "0.0.0.0/0"
+    action     = "ACCEPT"
+  }
+}
+`
+
+const testAccVpcAclEntriesUpdate = testAccVpcAclEntriesBasic + `
+resource "tencentcloud_vpc_acl_entries" "example" {
+  network_acl_id = tencentcloud_vpc_acl.example.id
+
+  ingress {
+    protocol   = "TCP"
+    port       = "22"
+    cidr_block = "10.0.0.0/8"
+    action     = "ACCEPT"
+  }
+
+  ingress {
+    protocol    = "TCP"
+    port        = "80,443"
+    cidr_block  = "0.0.0.0/0"
+    action      = "ACCEPT"
+    description = "web"
+  }
+
+  ingress {
+    protocol   = "ICMP"
+    cidr_block = "0.0.0.0/0"
+    action     = "DROP"
+  }
+}
+`
@@ -57,7 +57,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -66,12 +68,16 @@ import (
 
 func resourceTencentCloudVpcBandwidthPackage() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudVpcBandwidthPackageRead,
-		Create: resourceTencentCloudVpcBandwidthPackageCreate,
-		Update: resourceTencentCloudVpcBandwidthPackageUpdate,
-		Delete: resourceTencentCloudVpcBandwidthPackageDelete,
+		ReadContext:   resourceTencentCloudVpcBandwidthPackageRead,
+		CreateContext: resourceTencentCloudVpcBandwidthPackageCreate,
+		UpdateContext: resourceTencentCloudVpcBandwidthPackageUpdate,
+		DeleteContext: resourceTencentCloudVpcBandwidthPackageDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"network_type": {
@@ -126,7 +132,7 @@ func resourceTencentCloudVpcBandwidthPackage() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcBandwidthPackageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcBandwidthPackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_bwp_bandwidth_package.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -171,8 +177,8 @@ func resourceTencentCloudVpcBandwidthPackageCreate(d *schema.ResourceData, meta
 		request.Egress = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateBandwidthPackage(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateBandwidthPackageWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -185,26 +191,26 @@ func resourceTencentCloudVpcBandwidthPackageCreate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create bwp bandwidthPackage failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	bandwidthPackageId := *response.Response.BandwidthPackageId
 
 	d.SetId(bandwidthPackageId)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
 		region := meta.(*TencentCloudClient).apiV3Conn.Region
 		resourceName := fmt.Sprintf("qcs::vpc:%s:uin/:bandwidthPackage/%s", region, bandwidthPackageId)
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, errRet := service.DescribeVpcBandwidthPackage(ctx, bandwidthPackageId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -218,18 +224,18 @@ func resourceTencentCloudVpcBandwidthPackageCreate(d *schema.ResourceData, meta
 		return resource.RetryableError(fmt.Errorf("vpc bandwidthPackage instance status is %v, retry...", *instance.Status))
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudVpcBandwidthPackageRead(d, meta)
+	return resourceTencentCloudVpcBandwidthPackageRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcBandwidthPackageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcBandwidthPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_bwp_bandwidth_package.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -238,7 +244,7 @@ func resourceTencentCloudVpcBandwidthPackageRead(d *schema.ResourceData, meta in
 	bandwidthPackage, err := service.DescribeVpcBandwidthPackage(ctx, bandwidthPackageId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if bandwidthPackage == nil {
@@ -273,19 +279,19 @@ func resourceTencentCloudVpcBandwidthPackageRead(d *schema.ResourceData, meta in
 	tagService := &TagService{client: tcClient}
 	tags, err := tagService.DescribeResourceTags(ctx, "vpc", "bandwidthPackage", tcClient.Region, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudVpcBandwidthPackageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcBandwidthPackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_bandwidth_package.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	bandwidthPackageId := d.Id()
 
@@ -297,7 +303,7 @@ func resourceTencentCloudVpcBandwidthPackageUpdate(d *schema.ResourceData, meta
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -314,8 +320,8 @@ func resourceTencentCloudVpcBandwidthPackageUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyBandwidthPackageAttribute(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyBandwidthPackageAttributeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -327,7 +333,7 @@ func resourceTencentCloudVpcBandwidthPackageUpdate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc bandwidthPackage failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
@@ -337,26 +343,26 @@ func resourceTencentCloudVpcBandwidthPackageUpdate(d *schema.ResourceData, meta
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("vpc", "bandwidthPackage", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudVpcBandwidthPackageRead(d, meta)
+	return resourceTencentCloudVpcBandwidthPackageRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcBandwidthPackageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcBandwidthPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_bwp_bandwidth_package.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	bandwidthPackageId := d.Id()
 
 	if err := service.DeleteVpcBandwidthPackageById(ctx, bandwidthPackageId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
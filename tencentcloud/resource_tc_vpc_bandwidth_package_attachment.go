@@ -51,10 +51,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -63,11 +63,14 @@ import (
 
 func resourceTencentCloudVpcBandwidthPackageAttachment() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudVpcBandwidthPackageAttachmentRead,
-		Create: resourceTencentCloudVpcBandwidthPackageAttachmentCreate,
-		Delete: resourceTencentCloudVpcBandwidthPackageAttachmentDelete,
+		ReadContext:   resourceTencentCloudVpcBandwidthPackageAttachmentRead,
+		CreateContext: resourceTencentCloudVpcBandwidthPackageAttachmentCreate,
+		DeleteContext: resourceTencentCloudVpcBandwidthPackageAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -108,7 +111,7 @@ func resourceTencentCloudVpcBandwidthPackageAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcBandwidthPackageAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcBandwidthPackageAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_bandwidth_package_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -142,8 +145,8 @@ func resourceTencentCloudVpcBandwidthPackageAttachmentCreate(d *schema.ResourceD
 		request.Protocol = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AddBandwidthPackageResources(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AddBandwidthPackageResourcesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -155,25 +158,25 @@ func resourceTencentCloudVpcBandwidthPackageAttachmentCreate(d *schema.ResourceD
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc bandwidthPackageAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(bandwidthPackageId + FILED_SP + resourceId)
-	return resourceTencentCloudVpcBandwidthPackageAttachmentRead(d, meta)
+	d.SetId(helper.IdFormat(bandwidthPackageId, resourceId))
+	return resourceTencentCloudVpcBandwidthPackageAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcBandwidthPackageAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcBandwidthPackageAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_bandwidth_package_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bandwidthPackageId := idSplit[0]
 	resourceId := idSplit[1]
@@ -181,7 +184,7 @@ func resourceTencentCloudVpcBandwidthPackageAttachmentRead(d *schema.ResourceDat
 	bandwidthPackageAttachment, err := service.DescribeVpcBandwidthPackageAttachment(ctx, bandwidthPackageId, resourceId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if bandwidthPackageAttachment == nil {
@@ -213,24 +216,24 @@ func resourceTencentCloudVpcBandwidthPackageAttachmentRead(d *schema.ResourceDat
 	return nil
 }
 
-func resourceTencentCloudVpcBandwidthPackageAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcBandwidthPackageAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_bandwidth_package_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	bandwidthPackageId := idSplit[0]
 	resourceId := idSplit[1]
 
 	if err := service.DeleteVpcBandwidthPackageAttachmentById(ctx, bandwidthPackageId, resourceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
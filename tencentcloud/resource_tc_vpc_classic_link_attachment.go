@@ -69,10 +69,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -81,11 +81,14 @@ import (
 
 func resourceTencentCloudVpcClassicLinkAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcClassicLinkAttachmentCreate,
-		Read:   resourceTencentCloudVpcClassicLinkAttachmentRead,
-		Delete: resourceTencentCloudVpcClassicLinkAttachmentDelete,
+		CreateContext: resourceTencentCloudVpcClassicLinkAttachmentCreate,
+		ReadContext:   resourceTencentCloudVpcClassicLinkAttachmentRead,
+		DeleteContext: resourceTencentCloudVpcClassicLinkAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -109,7 +112,7 @@ func resourceTencentCloudVpcClassicLinkAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcClassicLinkAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcClassicLinkAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_classic_link_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -133,8 +136,8 @@ func resourceTencentCloudVpcClassicLinkAttachmentCreate(d *schema.ResourceData,
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AttachClassicLinkVpc(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AttachClassicLinkVpcWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -144,34 +147,34 @@ func resourceTencentCloudVpcClassicLinkAttachmentCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc ClassicLinkAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(vpcId + FILED_SP + instanceId)
+	d.SetId(helper.IdFormat(vpcId, instanceId))
 
-	return resourceTencentCloudVpcClassicLinkAttachmentRead(d, meta)
+	return resourceTencentCloudVpcClassicLinkAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcClassicLinkAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcClassicLinkAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_classic_link_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	vpcId := idSplit[0]
 	instanceId := idSplit[1]
 
 	classicLinkAttachment, err := service.DescribeVpcClassicLinkAttachmentById(ctx, vpcId, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if classicLinkAttachment == nil {
@@ -191,23 +194,23 @@ func resourceTencentCloudVpcClassicLinkAttachmentRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceTencentCloudVpcClassicLinkAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcClassicLinkAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_classic_link_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	vpcId := idSplit[0]
 	instanceId := idSplit[1]
 
 	if err := service.DeleteVpcClassicLinkAttachmentById(ctx, vpcId, instanceId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -47,10 +47,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -59,11 +59,14 @@ import (
 
 func resourceTencentCloudVpcDhcpAssociateAddress() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcDhcpAssociateAddressCreate,
-		Read:   resourceTencentCloudVpcDhcpAssociateAddressRead,
-		Delete: resourceTencentCloudVpcDhcpAssociateAddressDelete,
+		CreateContext: resourceTencentCloudVpcDhcpAssociateAddressCreate,
+		ReadContext:   resourceTencentCloudVpcDhcpAssociateAddressRead,
+		DeleteContext: resourceTencentCloudVpcDhcpAssociateAddressDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"dhcp_ip_id": {
@@ -83,7 +86,7 @@ func resourceTencentCloudVpcDhcpAssociateAddress() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcDhcpAssociateAddressCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcDhcpAssociateAddressCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_dhcp_associate_address.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -104,8 +107,8 @@ func resourceTencentCloudVpcDhcpAssociateAddressCreate(d *schema.ResourceData, m
 		request.AddressIp = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AssociateDhcpIpWithAddressIp(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AssociateDhcpIpWithAddressIpWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -115,34 +118,34 @@ func resourceTencentCloudVpcDhcpAssociateAddressCreate(d *schema.ResourceData, m
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc DhcpAssociateAddress failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(dhcpIpId + FILED_SP + addressIp)
+	d.SetId(helper.IdFormat(dhcpIpId, addressIp))
 
-	return resourceTencentCloudVpcDhcpAssociateAddressRead(d, meta)
+	return resourceTencentCloudVpcDhcpAssociateAddressRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcDhcpAssociateAddressRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcDhcpAssociateAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_dhcp_associate_address.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	dhcpIpId := idSplit[0]
 	addressIp := idSplit[1]
 
 	DhcpAssociateAddress, err := service.DescribeVpcDhcpAssociateAddressById(ctx, dhcpIpId, addressIp)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if DhcpAssociateAddress == nil {
@@ -162,22 +165,22 @@ func resourceTencentCloudVpcDhcpAssociateAddressRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudVpcDhcpAssociateAddressDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcDhcpAssociateAddressDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_dhcp_associate_address.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	dhcpIpId := idSplit[0]
 
 	if err := service.DeleteVpcDhcpAssociateAddressById(ctx, dhcpIpId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -38,9 +38,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -49,12 +50,16 @@ import (
 
 func resourceTencentCloudVpcDhcpIp() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcDhcpIpCreate,
-		Read:   resourceTencentCloudVpcDhcpIpRead,
-		Update: resourceTencentCloudVpcDhcpIpUpdate,
-		Delete: resourceTencentCloudVpcDhcpIpDelete,
+		CreateContext: resourceTencentCloudVpcDhcpIpCreate,
+		ReadContext:   resourceTencentCloudVpcDhcpIpRead,
+		UpdateContext: resourceTencentCloudVpcDhcpIpUpdate,
+		DeleteContext: resourceTencentCloudVpcDhcpIpDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -78,7 +83,7 @@ func resourceTencentCloudVpcDhcpIp() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcDhcpIpCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcDhcpIpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_dhcp_ip.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -104,8 +109,8 @@ func resourceTencentCloudVpcDhcpIpCreate(d *schema.ResourceData, meta interface{
 	// 默认1
 	request.SecondaryPrivateIpAddressCount = helper.IntUint64(1)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateDhcpIp(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateDhcpIpWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -116,27 +121,27 @@ func resourceTencentCloudVpcDhcpIpCreate(d *schema.ResourceData, meta interface{
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc dhcpIp failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	dhcpIpSet := response.Response.DhcpIpSet
 	if len(dhcpIpSet) < 1 {
-		return fmt.Errorf("create vpc dhcpIp failed.")
+		return diag.Errorf("create vpc dhcpIp failed.")
 	}
 
 	dhcpIpId = *dhcpIpSet[0].DhcpIpId
 	d.SetId(dhcpIpId)
 
-	return resourceTencentCloudVpcDhcpIpRead(d, meta)
+	return resourceTencentCloudVpcDhcpIpRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcDhcpIpRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcDhcpIpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_dhcp_ip.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -144,7 +149,7 @@ func resourceTencentCloudVpcDhcpIpRead(d *schema.ResourceData, meta interface{})
 
 	dhcpIp, err := service.DescribeVpcDhcpIpById(ctx, dhcpIpId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if dhcpIp == nil {
@@ -168,7 +173,7 @@ func resourceTencentCloudVpcDhcpIpRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTencentCloudVpcDhcpIpUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcDhcpIpUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_dhcp_ip.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -184,7 +189,7 @@ func resourceTencentCloudVpcDhcpIpUpdate(d *schema.ResourceData, meta interface{
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -194,8 +199,8 @@ func resourceTencentCloudVpcDhcpIpUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyDhcpIpAttribute(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyDhcpIpAttributeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -205,24 +210,24 @@ func resourceTencentCloudVpcDhcpIpUpdate(d *schema.ResourceData, meta interface{
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update vpc dhcpIp failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudVpcDhcpIpRead(d, meta)
+	return resourceTencentCloudVpcDhcpIpRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcDhcpIpDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcDhcpIpDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_dhcp_ip.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	dhcpIpId := d.Id()
 
 	if err := service.DeleteVpcDhcpIpById(ctx, dhcpIpId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
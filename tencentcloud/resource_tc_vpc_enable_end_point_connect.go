@@ -14,8 +14,11 @@ resource "tencentcloud_vpc_enable_end_point_connect" "enable_end_point_connect"
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -24,9 +27,12 @@ import (
 
 func resourceTencentCloudVpcEnableEndPointConnect() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcEnableEndPointConnectCreate,
-		Read:   resourceTencentCloudVpcEnableEndPointConnectRead,
-		Delete: resourceTencentCloudVpcEnableEndPointConnectDelete,
+		CreateContext: resourceTencentCloudVpcEnableEndPointConnectCreate,
+		ReadContext:   resourceTencentCloudVpcEnableEndPointConnectRead,
+		DeleteContext: resourceTencentCloudVpcEnableEndPointConnectDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"end_point_service_id": {
 				Required:    true,
@@ -55,7 +61,7 @@ func resourceTencentCloudVpcEnableEndPointConnect() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcEnableEndPointConnectCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcEnableEndPointConnectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_enable_end_point_connect.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -83,8 +89,8 @@ func resourceTencentCloudVpcEnableEndPointConnectCreate(d *schema.ResourceData,
 		request.AcceptFlag = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().EnableVpcEndPointConnect(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().EnableVpcEndPointConnectWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -94,22 +100,22 @@ func resourceTencentCloudVpcEnableEndPointConnectCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate vpc enableEndPointConnect failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(endPointServiceId + FILED_SP + endPointId)
+	d.SetId(helper.IdFormat(endPointServiceId, endPointId))
 
-	return resourceTencentCloudVpcEnableEndPointConnectRead(d, meta)
+	return resourceTencentCloudVpcEnableEndPointConnectRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcEnableEndPointConnectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcEnableEndPointConnectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_enable_end_point_connect.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudVpcEnableEndPointConnectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcEnableEndPointConnectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_enable_end_point_connect.delete")()
 	defer inconsistentCheck(d, meta)()
 
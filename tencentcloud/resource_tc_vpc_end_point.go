@@ -25,9 +25,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -36,12 +37,16 @@ import (
 
 func resourceTencentCloudVpcEndPoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcEndPointCreate,
-		Read:   resourceTencentCloudVpcEndPointRead,
-		Update: resourceTencentCloudVpcEndPointUpdate,
-		Delete: resourceTencentCloudVpcEndPointDelete,
+		CreateContext: resourceTencentCloudVpcEndPointCreate,
+		ReadContext:   resourceTencentCloudVpcEndPointRead,
+		UpdateContext: resourceTencentCloudVpcEndPointUpdate,
+		DeleteContext: resourceTencentCloudVpcEndPointDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -95,7 +100,7 @@ func resourceTencentCloudVpcEndPoint() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcEndPointCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcEndPointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_end_point.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -126,8 +131,8 @@ func resourceTencentCloudVpcEndPointCreate(d *schema.ResourceData, meta interfac
 		request.EndPointVip = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateVpcEndPoint(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateVpcEndPointWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -138,22 +143,22 @@ func resourceTencentCloudVpcEndPointCreate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc endPoint failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	endPointId = *response.Response.EndPoint.EndPointId
 	d.SetId(endPointId)
 
-	return resourceTencentCloudVpcEndPointRead(d, meta)
+	return resourceTencentCloudVpcEndPointRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcEndPointRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcEndPointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_end_point.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -161,12 +166,12 @@ func resourceTencentCloudVpcEndPointRead(d *schema.ResourceData, meta interface{
 
 	endPoint, err := service.DescribeVpcEndPointById(ctx, endPointId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if endPoint == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", d.Id())
+		return diag.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
 	if endPoint.VpcId != nil {
@@ -204,7 +209,7 @@ func resourceTencentCloudVpcEndPointRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTencentCloudVpcEndPointUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcEndPointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_end_point.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -224,7 +229,7 @@ func resourceTencentCloudVpcEndPointUpdate(d *schema.ResourceData, meta interfac
 	}
 	for _, field := range unsupportedUpdateFields {
 		if d.HasChange(field) {
-			return fmt.Errorf("tencentcloud_vpc_end_point update on %s is not support yet", field)
+			return diag.Errorf("tencentcloud_vpc_end_point update on %s is not support yet", field)
 		}
 	}
 
@@ -234,8 +239,8 @@ func resourceTencentCloudVpcEndPointUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyVpcEndPointAttribute(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyVpcEndPointAttributeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -245,18 +250,18 @@ func resourceTencentCloudVpcEndPointUpdate(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc endPoint failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudVpcEndPointRead(d, meta)
+	return resourceTencentCloudVpcEndPointRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcEndPointDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcEndPointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_end_point.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	endPointId := d.Id()
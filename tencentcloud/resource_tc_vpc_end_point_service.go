@@ -25,9 +25,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -36,12 +37,16 @@ import (
 
 func resourceTencentCloudVpcEndPointService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcEndPointServiceCreate,
-		Read:   resourceTencentCloudVpcEndPointServiceRead,
-		Update: resourceTencentCloudVpcEndPointServiceUpdate,
-		Delete: resourceTencentCloudVpcEndPointServiceDelete,
+		CreateContext: resourceTencentCloudVpcEndPointServiceCreate,
+		ReadContext:   resourceTencentCloudVpcEndPointServiceRead,
+		UpdateContext: resourceTencentCloudVpcEndPointServiceUpdate,
+		DeleteContext: resourceTencentCloudVpcEndPointServiceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -102,7 +107,7 @@ func resourceTencentCloudVpcEndPointService() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcEndPointServiceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcEndPointServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_end_point_service.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -133,8 +138,8 @@ func resourceTencentCloudVpcEndPointServiceCreate(d *schema.ResourceData, meta i
 		request.ServiceType = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateVpcEndPointService(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateVpcEndPointServiceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -145,22 +150,22 @@ func resourceTencentCloudVpcEndPointServiceCreate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc endPointService failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	endPointServiceId = *response.Response.EndPointService.EndPointServiceId
 	d.SetId(endPointServiceId)
 
-	return resourceTencentCloudVpcEndPointServiceRead(d, meta)
+	return resourceTencentCloudVpcEndPointServiceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcEndPointServiceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcEndPointServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_end_point_service.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -169,12 +174,12 @@ func resourceTencentCloudVpcEndPointServiceRead(d *schema.ResourceData, meta int
 	endPointService, err := service.DescribeVpcEndPointServiceById(ctx, endPointServiceId)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if endPointService == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", d.Id())
+		return diag.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
 	if endPointService.VpcId != nil {
@@ -216,7 +221,7 @@ func resourceTencentCloudVpcEndPointServiceRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudVpcEndPointServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcEndPointServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_end_point_service.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -238,7 +243,7 @@ func resourceTencentCloudVpcEndPointServiceUpdate(d *schema.ResourceData, meta i
 	}
 	for _, field := range unsupportedUpdateFields {
 		if d.HasChange(field) {
-			return fmt.Errorf("tencentcloud_vpc_end_point_service update on %s is not support yet", field)
+			return diag.Errorf("tencentcloud_vpc_end_point_service update on %s is not support yet", field)
 		}
 	}
 
@@ -260,8 +265,8 @@ func resourceTencentCloudVpcEndPointServiceUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyVpcEndPointServiceAttribute(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyVpcEndPointServiceAttributeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -271,18 +276,18 @@ func resourceTencentCloudVpcEndPointServiceUpdate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc endPointService failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudVpcEndPointServiceRead(d, meta)
+	return resourceTencentCloudVpcEndPointServiceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcEndPointServiceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcEndPointServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_end_point_service.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	endPointServiceId := d.Id()
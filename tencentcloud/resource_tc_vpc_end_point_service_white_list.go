@@ -23,10 +23,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -35,12 +35,16 @@ import (
 
 func resourceTencentCloudVpcEndPointServiceWhiteList() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcEndPointServiceWhiteListCreate,
-		Read:   resourceTencentCloudVpcEndPointServiceWhiteListRead,
-		Update: resourceTencentCloudVpcEndPointServiceWhiteListUpdate,
-		Delete: resourceTencentCloudVpcEndPointServiceWhiteListDelete,
+		CreateContext: resourceTencentCloudVpcEndPointServiceWhiteListCreate,
+		ReadContext:   resourceTencentCloudVpcEndPointServiceWhiteListRead,
+		UpdateContext: resourceTencentCloudVpcEndPointServiceWhiteListUpdate,
+		DeleteContext: resourceTencentCloudVpcEndPointServiceWhiteListDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"user_uin": {
@@ -76,7 +80,7 @@ func resourceTencentCloudVpcEndPointServiceWhiteList() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcEndPointServiceWhiteListCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcEndPointServiceWhiteListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_end_point_service_white_list.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -101,8 +105,8 @@ func resourceTencentCloudVpcEndPointServiceWhiteListCreate(d *schema.ResourceDat
 		request.Description = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateVpcEndPointServiceWhiteList(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateVpcEndPointServiceWhiteListWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -112,39 +116,39 @@ func resourceTencentCloudVpcEndPointServiceWhiteListCreate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc endPointServiceWhiteList failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(userUin + FILED_SP + endPointServiceId)
+	d.SetId(helper.IdFormat(userUin, endPointServiceId))
 
-	return resourceTencentCloudVpcEndPointServiceWhiteListRead(d, meta)
+	return resourceTencentCloudVpcEndPointServiceWhiteListRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcEndPointServiceWhiteListRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcEndPointServiceWhiteListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_end_point_service_white_list.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	userUin := idSplit[0]
 	endPointServiceId := idSplit[1]
 
 	endPointServiceWhiteList, err := service.DescribeVpcEndPointServiceWhiteListById(ctx, userUin, endPointServiceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if endPointServiceWhiteList == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `track` %s does not exist", d.Id())
+		return diag.Errorf("resource `track` %s does not exist", d.Id())
 	}
 
 	if endPointServiceWhiteList.UserUin != nil {
@@ -170,7 +174,7 @@ func resourceTencentCloudVpcEndPointServiceWhiteListRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudVpcEndPointServiceWhiteListUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcEndPointServiceWhiteListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_end_point_service_white_list.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -178,9 +182,9 @@ func resourceTencentCloudVpcEndPointServiceWhiteListUpdate(d *schema.ResourceDat
 
 	request := vpc.NewModifyVpcEndPointServiceWhiteListRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	userUin := idSplit[0]
 	endPointServiceId := idSplit[1]
@@ -194,7 +198,7 @@ func resourceTencentCloudVpcEndPointServiceWhiteListUpdate(d *schema.ResourceDat
 	}
 	for _, field := range unsupportedUpdateFields {
 		if d.HasChange(field) {
-			return fmt.Errorf("tencentcloud_vpc_end_point_service_white_list update on %s is not support yet", field)
+			return diag.Errorf("tencentcloud_vpc_end_point_service_white_list update on %s is not support yet", field)
 		}
 	}
 
@@ -204,8 +208,8 @@ func resourceTencentCloudVpcEndPointServiceWhiteListUpdate(d *schema.ResourceDat
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyVpcEndPointServiceWhiteList(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyVpcEndPointServiceWhiteListWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -215,23 +219,23 @@ func resourceTencentCloudVpcEndPointServiceWhiteListUpdate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc endPointServiceWhiteList failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudVpcEndPointServiceWhiteListRead(d, meta)
+	return resourceTencentCloudVpcEndPointServiceWhiteListRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcEndPointServiceWhiteListDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcEndPointServiceWhiteListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_end_point_service_white_list.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	userUin := idSplit[0]
 	endPointServiceId := idSplit[1]
@@ -118,8 +118,9 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -128,12 +129,16 @@ import (
 
 func resourceTencentCloudVpcFlowLog() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcFlowLogCreate,
-		Read:   resourceTencentCloudVpcFlowLogRead,
-		Update: resourceTencentCloudVpcFlowLogUpdate,
-		Delete: resourceTencentCloudVpcFlowLogDelete,
+		CreateContext: resourceTencentCloudVpcFlowLogCreate,
+		ReadContext:   resourceTencentCloudVpcFlowLogRead,
+		UpdateContext: resourceTencentCloudVpcFlowLogUpdate,
+		DeleteContext: resourceTencentCloudVpcFlowLogDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"flow_log_name": {
@@ -214,7 +219,7 @@ func resourceTencentCloudVpcFlowLog() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcFlowLogCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcFlowLogCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_flow_log.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -272,8 +277,8 @@ func resourceTencentCloudVpcFlowLogCreate(d *schema.ResourceData, meta interface
 		request.CloudLogRegion = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateFlowLog(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateFlowLogWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -284,11 +289,11 @@ func resourceTencentCloudVpcFlowLogCreate(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc flowLog failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(response.Response.FlowLog) == 0 {
-		return fmt.Errorf("api %s returns nil response", request.GetAction())
+		return diag.Errorf("api %s returns nil response", request.GetAction())
 	}
 
 	flowLogId = *response.Response.FlowLog[0].FlowLogId
@@ -298,41 +303,41 @@ func resourceTencentCloudVpcFlowLogCreate(d *schema.ResourceData, meta interface
 	if tags := helper.GetTags(d, "tags"); len(tags) > 0 {
 		client := meta.(*TencentCloudClient).apiV3Conn
 		tagService := TagService{client}
-		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ctx = context.WithValue(ctx, logIdKey, logId)
 		resourceName := BuildTagResourceName("vpc", "fl", client.Region, flowLogId)
 		err := tagService.ModifyTags(ctx, resourceName, tags, nil)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudVpcFlowLogRead(d, meta)
+	return resourceTencentCloudVpcFlowLogRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcFlowLogRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcFlowLogRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_flow_log.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	flowLogId, vpcId, err := resourceTencentCloudGetFlowLogId(d)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	flowLog, err := service.DescribeVpcFlowLogById(ctx, flowLogId, vpcId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if flowLog == nil {
 		d.SetId("")
-		return fmt.Errorf("resource `vpc_flow_log` %s does not exist", flowLogId)
+		return diag.Errorf("resource `vpc_flow_log` %s does not exist", flowLogId)
 	}
 
 	if flowLog.FlowLogName != nil {
@@ -389,7 +394,7 @@ func resourceTencentCloudVpcFlowLogRead(d *schema.ResourceData, meta interface{}
 	tagService := &TagService{client: client}
 	tags, err := tagService.DescribeResourceTags(ctx, "vpc", "fl", client.Region, flowLogId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(tags) > 0 {
@@ -399,19 +404,19 @@ func resourceTencentCloudVpcFlowLogRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceTencentCloudVpcFlowLogUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcFlowLogUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_flow_log.update")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := vpc.NewModifyFlowLogAttributeRequest()
 
 	flowLogId, vpcId, err := resourceTencentCloudGetFlowLogId(d)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	immutableArgs := []string{
@@ -429,7 +434,7 @@ func resourceTencentCloudVpcFlowLogUpdate(d *schema.ResourceData, meta interface
 		if d.HasChange(v) {
 			o, _ := d.GetChange(v)
 			_ = d.Set(v, o)
-			return fmt.Errorf("argument %s cannot be changed", v)
+			return diag.Errorf("argument %s cannot be changed", v)
 		}
 	}
 
@@ -448,8 +453,8 @@ func resourceTencentCloudVpcFlowLogUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyFlowLogAttribute(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyFlowLogAttributeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -459,7 +464,7 @@ func resourceTencentCloudVpcFlowLogUpdate(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update vpc flowLog failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("tags") {
@@ -471,25 +476,25 @@ func resourceTencentCloudVpcFlowLogUpdate(d *schema.ResourceData, meta interface
 		resourceName := BuildTagResourceName("vpc", "fl", client.Region, flowLogId)
 		err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudVpcFlowLogRead(d, meta)
+	return resourceTencentCloudVpcFlowLogRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcFlowLogDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcFlowLogDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_flow_log.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	flowLogId, vpcId, err := resourceTencentCloudGetFlowLogId(d)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := service.DeleteVpcFlowLogById(ctx, flowLogId, vpcId); err != nil {
@@ -500,7 +505,7 @@ func resourceTencentCloudVpcFlowLogDelete(d *schema.ResourceData, meta interface
 }
 
 func resourceTencentCloudSetFlowLogId(d *schema.ResourceData, id, vpcId string) {
-	d.SetId(id + FILED_SP + vpcId)
+	d.SetId(helper.IdFormat(id, vpcId))
 }
 
 func resourceTencentCloudGetFlowLogId(d *schema.ResourceData) (id, vpcId string, err error) {
@@ -510,7 +515,7 @@ func resourceTencentCloudGetFlowLogId(d *schema.ResourceData) (id, vpcId string,
 		err = fmt.Errorf("invalid id format %s, expect `fl-xxxxxxxx#vpc-xxxxxxxx`", rawId)
 		return
 	}
-	ids := strings.Split(rawId, FILED_SP)
+	ids := helper.IdParse(rawId)
 	id = ids[0]
 	vpcId = ids[1]
 	return
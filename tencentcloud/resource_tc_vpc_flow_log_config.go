@@ -128,7 +128,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -136,12 +138,15 @@ import (
 
 func resourceTencentCloudVpcFlowLogConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcFlowLogConfigCreate,
-		Read:   resourceTencentCloudVpcFlowLogConfigRead,
-		Update: resourceTencentCloudVpcFlowLogConfigUpdate,
-		Delete: resourceTencentCloudVpcFlowLogConfigDelete,
+		CreateContext: resourceTencentCloudVpcFlowLogConfigCreate,
+		ReadContext:   resourceTencentCloudVpcFlowLogConfigRead,
+		UpdateContext: resourceTencentCloudVpcFlowLogConfigUpdate,
+		DeleteContext: resourceTencentCloudVpcFlowLogConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"flow_log_id": {
@@ -159,7 +164,7 @@ func resourceTencentCloudVpcFlowLogConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcFlowLogConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcFlowLogConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_flow_log_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -167,16 +172,16 @@ func resourceTencentCloudVpcFlowLogConfigCreate(d *schema.ResourceData, meta int
 
 	d.SetId(flowLogId)
 
-	return resourceTencentCloudVpcFlowLogConfigUpdate(d, meta)
+	return resourceTencentCloudVpcFlowLogConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcFlowLogConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcFlowLogConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_flow_log_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -187,7 +192,7 @@ func resourceTencentCloudVpcFlowLogConfigRead(d *schema.ResourceData, meta inter
 
 	flowLogs, err := service.DescribeFlowLogs(ctx, request)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(flowLogs) < 1 {
@@ -207,7 +212,7 @@ func resourceTencentCloudVpcFlowLogConfigRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudVpcFlowLogConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcFlowLogConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_flow_log_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -227,8 +232,8 @@ func resourceTencentCloudVpcFlowLogConfigUpdate(d *schema.ResourceData, meta int
 
 	if enable {
 		enableRequest.FlowLogIds = []*string{&flowLogId}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().EnableFlowLogs(enableRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().EnableFlowLogsWithContext(ctx, enableRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -238,12 +243,12 @@ func resourceTencentCloudVpcFlowLogConfigUpdate(d *schema.ResourceData, meta int
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update vpc flowLogConfig failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		disableRequest.FlowLogIds = []*string{&flowLogId}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DisableFlowLogs(disableRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DisableFlowLogsWithContext(ctx, disableRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -253,14 +258,14 @@ func resourceTencentCloudVpcFlowLogConfigUpdate(d *schema.ResourceData, meta int
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update vpc flowLogConfig failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudVpcFlowLogConfigRead(d, meta)
+	return resourceTencentCloudVpcFlowLogConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcFlowLogConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcFlowLogConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_flow_log_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
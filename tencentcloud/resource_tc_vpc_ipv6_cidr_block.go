@@ -27,7 +27,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -36,11 +38,14 @@ import (
 
 func resourceTencentCloudVpcIpv6CidrBlock() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcIpv6CidrBlockCreate,
-		Read:   resourceTencentCloudVpcIpv6CidrBlockRead,
-		Delete: resourceTencentCloudVpcIpv6CidrBlockDelete,
+		CreateContext: resourceTencentCloudVpcIpv6CidrBlockCreate,
+		ReadContext:   resourceTencentCloudVpcIpv6CidrBlockRead,
+		DeleteContext: resourceTencentCloudVpcIpv6CidrBlockDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -58,7 +63,7 @@ func resourceTencentCloudVpcIpv6CidrBlock() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcIpv6CidrBlockCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcIpv6CidrBlockCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_ipv6_cidr_block.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -73,8 +78,8 @@ func resourceTencentCloudVpcIpv6CidrBlockCreate(d *schema.ResourceData, meta int
 		request.VpcId = helper.String(vpcId)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AssignIpv6CidrBlock(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AssignIpv6CidrBlockWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -84,21 +89,21 @@ func resourceTencentCloudVpcIpv6CidrBlockCreate(d *schema.ResourceData, meta int
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc ipv6CidrBlock failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(vpcId)
 
-	return resourceTencentCloudVpcIpv6CidrBlockRead(d, meta)
+	return resourceTencentCloudVpcIpv6CidrBlockRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcIpv6CidrBlockRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcIpv6CidrBlockRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_ipv6_cidr_block.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -106,7 +111,7 @@ func resourceTencentCloudVpcIpv6CidrBlockRead(d *schema.ResourceData, meta inter
 
 	instance, err := service.DescribeVpcById(ctx, vpcId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instance == nil {
@@ -126,18 +131,18 @@ func resourceTencentCloudVpcIpv6CidrBlockRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTencentCloudVpcIpv6CidrBlockDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcIpv6CidrBlockDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_ipv6_cidr_block.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	vpcId := d.Id()
 
 	if err := service.DeleteVpcIpv6CidrBlockById(ctx, vpcId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
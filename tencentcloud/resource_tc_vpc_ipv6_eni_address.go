@@ -40,16 +40,23 @@ resource "tencentcloud_vpc_ipv6_eni_address" "ipv6_eni_address" {
   }
 }
 ```
+
+Import
+
+vpc ipv6_eni_address can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_vpc_ipv6_eni_address.ipv6_eni_address vpc-9ebvfbbr#eni-3f5axjbf#2402:4e00:1015:a200:2dc6:5f6a:a47f:4d3a
+```
 */
 package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -58,10 +65,17 @@ import (
 
 func resourceTencentCloudVpcIpv6EniAddress() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcIpv6EniAddressCreate,
-		Read:   resourceTencentCloudVpcIpv6EniAddressRead,
-		Update: resourceTencentCloudVpcIpv6EniAddressUpdate,
-		Delete: resourceTencentCloudVpcIpv6EniAddressDelete,
+		CreateContext: resourceTencentCloudVpcIpv6EniAddressCreate,
+		ReadContext:   resourceTencentCloudVpcIpv6EniAddressRead,
+		UpdateContext: resourceTencentCloudVpcIpv6EniAddressUpdate,
+		DeleteContext: resourceTencentCloudVpcIpv6EniAddressDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Required:    true,
@@ -118,7 +132,7 @@ func resourceTencentCloudVpcIpv6EniAddress() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcIpv6EniAddressCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcIpv6EniAddressCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_ipv6_eni_address.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -165,8 +179,8 @@ func resourceTencentCloudVpcIpv6EniAddressCreate(d *schema.ResourceData, meta in
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AssignIpv6Addresses(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AssignIpv6AddressesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -177,34 +191,34 @@ func resourceTencentCloudVpcIpv6EniAddressCreate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc ipv6EniAddress failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	addressSet := response.Response.Ipv6AddressSet
 	if len(addressSet) < 1 {
-		return fmt.Errorf("assign ipv6 addresses failed.")
+		return diag.Errorf("assign ipv6 addresses failed.")
 	}
 
 	time.Sleep(5 * time.Second)
 
-	d.SetId(vpcId + FILED_SP + networkInterfaceId + FILED_SP + *addressSet[0].Address)
+	d.SetId(helper.IdFormat(vpcId, networkInterfaceId, *addressSet[0].Address))
 
-	return resourceTencentCloudVpcIpv6EniAddressRead(d, meta)
+	return resourceTencentCloudVpcIpv6EniAddressRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcIpv6EniAddressRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcIpv6EniAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_ipv6_eni_address.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	vpcId := idSplit[0]
 	networkInterfaceId := idSplit[1]
@@ -212,7 +226,7 @@ func resourceTencentCloudVpcIpv6EniAddressRead(d *schema.ResourceData, meta inte
 
 	ipv6EniAddress, err := service.DescribeVpcIpv6EniAddressById(ctx, vpcId, address)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ipv6EniAddress == nil {
@@ -227,7 +241,7 @@ func resourceTencentCloudVpcIpv6EniAddressRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudVpcIpv6EniAddressUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcIpv6EniAddressUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_ipv6_eni_address.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -235,9 +249,9 @@ func resourceTencentCloudVpcIpv6EniAddressUpdate(d *schema.ResourceData, meta in
 
 	request := vpc.NewModifyIpv6AddressesAttributeRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	networkInterfaceId := idSplit[1]
 
@@ -271,8 +285,8 @@ func resourceTencentCloudVpcIpv6EniAddressUpdate(d *schema.ResourceData, meta in
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyIpv6AddressesAttribute(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyIpv6AddressesAttributeWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -282,29 +296,29 @@ func resourceTencentCloudVpcIpv6EniAddressUpdate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update vpc ipv6EniAddress failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudVpcIpv6EniAddressRead(d, meta)
+	return resourceTencentCloudVpcIpv6EniAddressRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcIpv6EniAddressDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcIpv6EniAddressDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_ipv6_eni_address.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	networkInterfaceId := idSplit[1]
 	address := idSplit[2]
 
 	if err := service.DeleteVpcIpv6EniAddressById(ctx, networkInterfaceId, address); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
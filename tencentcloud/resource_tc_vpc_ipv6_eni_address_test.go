@@ -35,6 +35,12 @@ func TestAccTencentCloudVpcIpv6EniAddressResource_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:            "tencentcloud_vpc_ipv6_eni_address.ipv6_eni_address",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ipv6_addresses"},
+			},
 		},
 	})
 }
@@ -44,10 +44,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -56,11 +56,14 @@ import (
 
 func resourceTencentCloudVpcIpv6SubnetCidrBlock() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcIpv6SubnetCidrBlockCreate,
-		Read:   resourceTencentCloudVpcIpv6SubnetCidrBlockRead,
-		Delete: resourceTencentCloudVpcIpv6SubnetCidrBlockDelete,
+		CreateContext: resourceTencentCloudVpcIpv6SubnetCidrBlockCreate,
+		ReadContext:   resourceTencentCloudVpcIpv6SubnetCidrBlockRead,
+		DeleteContext: resourceTencentCloudVpcIpv6SubnetCidrBlockDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -95,7 +98,7 @@ func resourceTencentCloudVpcIpv6SubnetCidrBlock() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcIpv6SubnetCidrBlockCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcIpv6SubnetCidrBlockCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_ipv6_subnet_cidr_block.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -126,8 +129,8 @@ func resourceTencentCloudVpcIpv6SubnetCidrBlockCreate(d *schema.ResourceData, me
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AssignIpv6SubnetCidrBlock(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AssignIpv6SubnetCidrBlockWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -137,33 +140,33 @@ func resourceTencentCloudVpcIpv6SubnetCidrBlockCreate(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc ipv6SubnetCidrBlock failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(vpcId + FILED_SP + subnetId)
+	d.SetId(helper.IdFormat(vpcId, subnetId))
 
-	return resourceTencentCloudVpcIpv6SubnetCidrBlockRead(d, meta)
+	return resourceTencentCloudVpcIpv6SubnetCidrBlockRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcIpv6SubnetCidrBlockRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcIpv6SubnetCidrBlockRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_ipv6_subnet_cidr_block.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	subnetId := idSplit[1]
 
 	ipv6SubnetCidrBlock, err := service.DescribeSubnetById(ctx, subnetId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ipv6SubnetCidrBlock == nil {
@@ -188,23 +191,23 @@ func resourceTencentCloudVpcIpv6SubnetCidrBlockRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudVpcIpv6SubnetCidrBlockDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcIpv6SubnetCidrBlockDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_ipv6_subnet_cidr_block.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	vpcId := idSplit[0]
 	subnetId := idSplit[1]
 
 	if err := service.DeleteVpcIpv6SubnetCidrBlockById(ctx, vpcId, subnetId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
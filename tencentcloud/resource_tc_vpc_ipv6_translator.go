@@ -0,0 +1,201 @@
+/*
+Provides a resource to create an IPv6 translator, which translates the IPv6 traffic from the clients to the IPv4 backends (NAT64) with the rules of `tencentcloud_vpc_ipv6_translator_rule`.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_vpc_ipv6_translator" "example" {
+  ip6_translator_name       = "tf-example"
+  internet_service_provider = "BGP"
+}
+```
+
+Import
+
+IPv6 translator can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_vpc_ipv6_translator.example ip6-xxxxxxxx
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudVpcIpv6Translator() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTencentCloudVpcIpv6TranslatorCreate,
+		ReadContext:   resourceTencentCloudVpcIpv6TranslatorRead,
+		UpdateContext: resourceTencentCloudVpcIpv6TranslatorUpdate,
+		DeleteContext: resourceTencentCloudVpcIpv6TranslatorDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"ip6_translator_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringLengthInRange(1, 60),
+				Description:  "Name of the IPv6 translator.",
+			},
+			"internet_service_provider": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(VPC_IP6_TRANSLATOR_ISPS),
+				Description:  "Internet service provider of the IPv6 address of the translator. Valid values: `CMCC`, `CTCC`, `CUCC`, `BGP`.",
+			},
+			// Computed values
+			"vip6": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IPv6 address of the translator, which the clients connect to.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the IPv6 translator, such as `CREATING`, `RUNNING`, `MODIFYING` and `DELETING`.",
+			},
+			"rule_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of the rules of the IPv6 translator.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the IPv6 translator.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudVpcIpv6TranslatorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_vpc_ipv6_translator.create")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	var ip6TranslatorId string
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		id, e := service.CreateIp6Translator(ctx, d.Get("ip6_translator_name").(string), d.Get("internet_service_provider").(string))
+		if e != nil {
+			return retryError(e)
+		}
+		ip6TranslatorId = id
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(ip6TranslatorId)
+
+	// the ipv6 translator may not be visible right after it is created
+	if _, err := service.WaitForIp6TranslatorRunning(ctx, ip6TranslatorId, helper.NotFoundPending, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceTencentCloudVpcIpv6TranslatorRead(ctx, d, meta)
+}
+
+func resourceTencentCloudVpcIpv6TranslatorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_vpc_ipv6_translator.read")()
+	defer inconsistentCheck(d, meta)()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	ip6Translator, err := service.DescribeIp6TranslatorById(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if ip6Translator == nil {
+		log.Printf("[WARN]%s vpc ipv6 translator [%s] not found, please check if it has been deleted.\n", logId, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("ip6_translator_name", ip6Translator.Ip6TranslatorName)
+	_ = d.Set("internet_service_provider", ip6Translator.IspName)
+	_ = d.Set("vip6", ip6Translator.Vip6)
+	_ = d.Set("status", ip6Translator.TranslatorStatus)
+	_ = d.Set("rule_count", ip6Translator.Ip6RuleCount)
+	_ = d.Set("create_time", ip6Translator.CreatedTime)
+
+	return nil
+}
+
+func resourceTencentCloudVpcIpv6TranslatorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_vpc_ipv6_translator.update")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+	ip6TranslatorId := d.Id()
+
+	if d.HasChange("ip6_translator_name") {
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if e := service.ModifyIp6Translator(ctx, ip6TranslatorId, d.Get("ip6_translator_name").(string)); e != nil {
+				return retryError(e)
+			}
+			return nil
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if _, err := service.WaitForIp6TranslatorRunning(ctx, ip6TranslatorId, helper.NotFoundFail, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceTencentCloudVpcIpv6TranslatorRead(ctx, d, meta)
+}
+
+func resourceTencentCloudVpcIpv6TranslatorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_vpc_ipv6_translator.delete")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+	ip6TranslatorId := d.Id()
+
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		if e := service.DeleteIp6TranslatorById(ctx, ip6TranslatorId); e != nil {
+			if isExpectError(e, []string{VPCNotFound, VPC_IP6_TRANSLATOR_NOT_FOUND}) {
+				return nil
+			}
+			return retryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := service.WaitForIp6TranslatorDeleted(ctx, ip6TranslatorId, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,296 @@
+/*
+Provides a resource to create a rule of an IPv6 translator, which translates the traffic to a port of the IPv6 address of the translator to an IPv4 backend.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_vpc_ipv6_translator" "example" {
+  ip6_translator_name       = "tf-example"
+  internet_service_provider = "BGP"
+}
+
+resource "tencentcloud_vpc_ipv6_translator_rule" "https" {
+  ip6_translator_id = tencentcloud_vpc_ipv6_translator.example.id
+  ip6_rule_name     = "https"
+  protocol          = "TCP"
+  ipv6_port         = 443
+  ipv4_address      = "1.1.1.1"
+  ipv4_port         = 8443
+}
+```
+
+Import
+
+IPv6 translator rule can be imported using the id of the translator and the id of the rule, e.g.
+
+```
+$ terraform import tencentcloud_vpc_ipv6_translator_rule.https ip6-xxxxxxxx#rule6-xxxxxxxx
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudVpcIpv6TranslatorRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTencentCloudVpcIpv6TranslatorRuleCreate,
+		ReadContext:   resourceTencentCloudVpcIpv6TranslatorRuleRead,
+		UpdateContext: resourceTencentCloudVpcIpv6TranslatorRuleUpdate,
+		DeleteContext: resourceTencentCloudVpcIpv6TranslatorRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"ip6_translator_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the IPv6 translator.",
+			},
+			"ip6_rule_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateStringLengthInRange(1, 60),
+				Description:  "Name of the rule.",
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(VPC_IP6_RULE_PROTOCOLS),
+				Description:  "Protocol of the rule. Valid values: `TCP`, `UDP`.",
+			},
+			"ipv6_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIntegerInRange(0, 65535),
+				Description:  "Port of the IPv6 address of the translator, which the clients connect to.",
+			},
+			"ipv4_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIp,
+				Description:  "IPv4 address of the backend.",
+			},
+			"ipv4_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntegerInRange(0, 65535),
+				Description:  "Port of the IPv4 backend.",
+			},
+			// Computed values
+			"ip6_rule_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the rule.",
+			},
+			"vip6": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IPv6 address of the translator.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the rule, such as `CREATING`, `RUNNING`, `MODIFYING` and `DELETING`.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the rule.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudVpcIpv6TranslatorRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_vpc_ipv6_translator_rule.create")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	ip6TranslatorId := d.Get("ip6_translator_id").(string)
+	ruleInfo := &vpc.Ip6RuleInfo{
+		Protocol: helper.String(d.Get("protocol").(string)),
+		Vport6:   helper.IntInt64(d.Get("ipv6_port").(int)),
+		Vip:      helper.String(d.Get("ipv4_address").(string)),
+		Vport:    helper.IntInt64(d.Get("ipv4_port").(int)),
+	}
+
+	// the rules of a translator can not be changed while it is modifying for another rule
+	if _, err := service.WaitForIp6TranslatorRunning(ctx, ip6TranslatorId, helper.NotFoundFail, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var ip6RuleId string
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		id, e := service.AddIp6Rule(ctx, ip6TranslatorId, d.Get("ip6_rule_name").(string), ruleInfo)
+		if e != nil {
+			return retryError(e, InternalError, VPC_IP6_MUTEX_TASK_RUNNING)
+		}
+		ip6RuleId = id
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helper.IdFormat(ip6TranslatorId, ip6RuleId))
+
+	// the rule may not be visible right after it is added
+	if _, err := service.WaitForIp6RuleRunning(ctx, ip6TranslatorId, ip6RuleId, helper.NotFoundPending, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceTencentCloudVpcIpv6TranslatorRuleRead(ctx, d, meta)
+}
+
+func resourceTencentCloudVpcIpv6TranslatorRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_vpc_ipv6_translator_rule.read")()
+	defer inconsistentCheck(d, meta)()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	ip6TranslatorId, ip6RuleId, err := parseVpcIpv6TranslatorRuleId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ip6Rule, err := service.DescribeIp6RuleById(ctx, ip6TranslatorId, ip6RuleId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if ip6Rule == nil {
+		log.Printf("[WARN]%s vpc ipv6 translator rule [%s] not found, please check if it has been deleted.\n", logId, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("ip6_translator_id", ip6TranslatorId)
+	_ = d.Set("ip6_rule_id", ip6Rule.Ip6RuleId)
+	_ = d.Set("ip6_rule_name", ip6Rule.Ip6RuleName)
+	_ = d.Set("protocol", ip6Rule.Protocol)
+	_ = d.Set("ipv6_port", ip6Rule.Vport6)
+	_ = d.Set("ipv4_address", ip6Rule.Vip)
+	_ = d.Set("ipv4_port", ip6Rule.Vport)
+	_ = d.Set("vip6", ip6Rule.Vip6)
+	_ = d.Set("status", ip6Rule.RuleStatus)
+	_ = d.Set("create_time", ip6Rule.CreatedTime)
+
+	return nil
+}
+
+func resourceTencentCloudVpcIpv6TranslatorRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_vpc_ipv6_translator_rule.update")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	ip6TranslatorId, ip6RuleId, err := parseVpcIpv6TranslatorRuleId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("ip6_rule_name", "ipv4_address", "ipv4_port") {
+		request := vpc.NewModifyIp6RuleRequest()
+		request.Ip6TranslatorId = &ip6TranslatorId
+		request.Ip6RuleId = &ip6RuleId
+		if d.HasChange("ip6_rule_name") {
+			request.Ip6RuleName = helper.String(d.Get("ip6_rule_name").(string))
+		}
+		if d.HasChanges("ipv4_address", "ipv4_port") {
+			request.Vip = helper.String(d.Get("ipv4_address").(string))
+			request.Vport = helper.IntInt64(d.Get("ipv4_port").(int))
+		}
+
+		if _, err := service.WaitForIp6TranslatorRunning(ctx, ip6TranslatorId, helper.NotFoundFail, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if e := service.ModifyIp6Rule(ctx, request); e != nil {
+				return retryError(e, InternalError, VPC_IP6_MUTEX_TASK_RUNNING)
+			}
+			return nil
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if _, err := service.WaitForIp6RuleRunning(ctx, ip6TranslatorId, ip6RuleId, helper.NotFoundFail, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceTencentCloudVpcIpv6TranslatorRuleRead(ctx, d, meta)
+}
+
+func resourceTencentCloudVpcIpv6TranslatorRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_vpc_ipv6_translator_rule.delete")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	ip6TranslatorId, ip6RuleId, err := parseVpcIpv6TranslatorRuleId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		if e := service.RemoveIp6RuleById(ctx, ip6TranslatorId, ip6RuleId); e != nil {
+			if isExpectError(e, []string{VPCNotFound, VPC_IP6_TRANSLATOR_NOT_FOUND, VPC_IP6_RULE_NOT_FOUND}) {
+				return nil
+			}
+			return retryError(e, InternalError, VPC_IP6_MUTEX_TASK_RUNNING)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := service.WaitForIp6RuleDeleted(ctx, ip6TranslatorId, ip6RuleId, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// parseVpcIpv6TranslatorRuleId returns the id of the translator and the id of the rule in the id of
+// tencentcloud_vpc_ipv6_translator_rule.
+func parseVpcIpv6TranslatorRuleId(id string) (ip6TranslatorId, ip6RuleId string, err error) {
+	idSplit, err := helper.IdParseN(id, 2)
+	if err != nil {
+		return
+	}
+	for i, v := range idSplit {
+		if v == "" {
+			err = &helper.IdEmptyPartError{Id: id, Index: i}
+			return
+		}
+	}
+	return idSplit[0], idSplit[1], nil
+}
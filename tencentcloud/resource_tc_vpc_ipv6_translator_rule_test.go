@@ -0,0 +1,128 @@
+package tencentcloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func TestParseVpcIpv6TranslatorRuleId(t *testing.T) {
+	id := helper.IdFormat("ip6-abcd1234", "rule6-abcd1234")
+	ip6TranslatorId, ip6RuleId, err := parseVpcIpv6TranslatorRuleId(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ip6TranslatorId != "ip6-abcd1234" || ip6RuleId != "rule6-abcd1234" {
+		t.Errorf("unexpected ids %s and %s", ip6TranslatorId, ip6RuleId)
+	}
+
+	for _, id := range []string{"ip6-abcd1234", "ip6-abcd1234#rule6-abcd1234#x"} {
+		_, _, err := parseVpcIpv6TranslatorRuleId(id)
+		var countErr *helper.IdPartsCountError
+		if !errors.As(err, &countErr) {
+			t.Errorf("expect IdPartsCountError for the broken id %s, got %v", id, err)
+		}
+	}
+	for _, id := range []string{"ip6-abcd1234#", "#rule6-abcd1234"} {
+		_, _, err := parseVpcIpv6TranslatorRuleId(id)
+		var emptyErr *helper.IdEmptyPartError
+		if !errors.As(err, &emptyErr) {
+			t.Errorf("expect IdEmptyPartError for the broken id %s, got %v", id, err)
+		}
+	}
+}
+
+func TestAccTencentCloudVpcIpv6TranslatorRuleResource_basic(t *testing.T) {
+	t.Parallel()
+	keyName := "tencentcloud_vpc_ipv6_translator_rule.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcIpv6TranslatorRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcIpv6TranslatorRule,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(keyName, "ip6_rule_id"),
+					resource.TestCheckResourceAttr(keyName, "ip6_rule_name", "tf-ci-test-https"),
+					resource.TestCheckResourceAttr(keyName, "protocol", "TCP"),
+					resource.TestCheckResourceAttr(keyName, "ipv6_port", "443"),
+					resource.TestCheckResourceAttr(keyName, "ipv4_address", "1.1.1.1"),
+					resource.TestCheckResourceAttr(keyName, "ipv4_port", "8443"),
+					resource.TestCheckResourceAttr(keyName, "status", "RUNNING"),
+				),
+			},
+			{
+				ResourceName:      keyName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVpcIpv6TranslatorRuleUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(keyName, "ipv4_address", "1.1.1.2"),
+					resource.TestCheckResourceAttr(keyName, "ipv4_port", "443"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpcIpv6TranslatorRuleDestroy(s *terraform.State) error {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	service := VpcService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_vpc_ipv6_translator_rule" {
+			continue
+		}
+		ip6TranslatorId, ip6RuleId, err := parseVpcIpv6TranslatorRuleId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		ip6Rule, err := service.DescribeIp6RuleById(ctx, ip6TranslatorId, ip6RuleId)
+		if err != nil {
+			return err
+		}
+		if ip6Rule != nil {
+			return fmt.Errorf("vpc ipv6 translator rule %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+const testAccVpcIpv6TranslatorRuleBasic = `
+resource "tencentcloud_vpc_ipv6_translator" "example" {
+  ip6_translator_name       = "tf-ci-test-ip6-translator-rule"
+  internet_service_provider = "BGP"
+}
+`
+
+const testAccVpcIpv6TranslatorRule = testAccVpcIpv6TranslatorRuleBasic + `
+resource "tencentcloud_vpc_ipv6_translator_rule" "example" {
+  ip6_translator_id = tencentcloud_vpc_ipv6_translator.example.id
+  ip6_rule_name     = "tf-ci-test-https"
+  protocol          = "TCP"
+  ipv6_port         = 443
+  ipv4_address      = "1.1.1.1"
+  ipv4_port         = 8443
+}
+`
+
+const testAccVpcIpv6TranslatorRuleUpdate = testAccVpcIpv6TranslatorRuleBasic + `
+resource "tencentcloud_vpc_ipv6_translator_rule" "example" {
+  ip6_translator_id = tencentcloud_vpc_ipv6_translator.example.id
+  ip6_rule_name     = "tf-ci-test-https"
+  protocol          = "TCP"
+  ipv6_port         = 443
+  ipv4_address      = "1.1.1.2"
+  ipv4_port         = 443
+}
+`
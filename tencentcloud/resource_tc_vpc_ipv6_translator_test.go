@@ -0,0 +1,76 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTencentCloudVpcIpv6TranslatorResource_basic(t *testing.T) {
+	t.Parallel()
+	keyName := "tencentcloud_vpc_ipv6_translator.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcIpv6TranslatorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcIpv6Translator,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(keyName, "ip6_translator_name", "tf-ci-test-ip6-translator"),
+					resource.TestCheckResourceAttr(keyName, "internet_service_provider", "BGP"),
+					resource.TestCheckResourceAttr(keyName, "status", "RUNNING"),
+					resource.TestCheckResourceAttrSet(keyName, "vip6"),
+				),
+			},
+			{
+				ResourceName:      keyName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVpcIpv6TranslatorUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(keyName, "ip6_translator_name", "tf-ci-test-ip6-translator-update"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpcIpv6TranslatorDestroy(s *terraform.State) error {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	service := VpcService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_vpc_ipv6_translator" {
+			continue
+		}
+		ip6Translator, err := service.DescribeIp6TranslatorById(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if ip6Translator != nil {
+			return fmt.Errorf("vpc ipv6 translator %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+const testAccVpcIpv6Translator = `
+resource "tencentcloud_vpc_ipv6_translator" "example" {
+  ip6_translator_name       = "tf-ci-test-ip6-translator"
+  internet_service_provider = "BGP"
+}
+`
+
+const testAccVpcIpv6TranslatorUpdate = `
+resource "tencentcloud_vpc_ipv6_translator" "example" {
+  ip6_translator_name       = "tf-ci-test-ip6-translator-update"
+  internet_service_provider = "BGP"
+}
+`
@@ -28,10 +28,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -40,12 +40,16 @@ import (
 
 func resourceTencentCloudVpcLocalGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcLocalGatewayCreate,
-		Read:   resourceTencentCloudVpcLocalGatewayRead,
-		Update: resourceTencentCloudVpcLocalGatewayUpdate,
-		Delete: resourceTencentCloudVpcLocalGatewayDelete,
+		CreateContext: resourceTencentCloudVpcLocalGatewayCreate,
+		ReadContext:   resourceTencentCloudVpcLocalGatewayRead,
+		UpdateContext: resourceTencentCloudVpcLocalGatewayUpdate,
+		DeleteContext: resourceTencentCloudVpcLocalGatewayDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"local_gateway_name": {
@@ -69,7 +73,7 @@ func resourceTencentCloudVpcLocalGateway() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcLocalGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcLocalGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_local_gateway.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -94,8 +98,8 @@ func resourceTencentCloudVpcLocalGatewayCreate(d *schema.ResourceData, meta inte
 		request.CdcId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateLocalGateway(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateLocalGatewayWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -106,34 +110,34 @@ func resourceTencentCloudVpcLocalGatewayCreate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc localGateway failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	localGatewayId = *response.Response.LocalGateway.UniqLocalGwId
-	d.SetId(cdcId + FILED_SP + localGatewayId)
+	d.SetId(helper.IdFormat(cdcId, localGatewayId))
 
-	return resourceTencentCloudVpcLocalGatewayRead(d, meta)
+	return resourceTencentCloudVpcLocalGatewayRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcLocalGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcLocalGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_local_gateway.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	localGatewayId := idSplit[1]
 
 	localGateway, err := service.DescribeVpcLocalGatewayById(ctx, localGatewayId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if localGateway == nil {
@@ -157,7 +161,7 @@ func resourceTencentCloudVpcLocalGatewayRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudVpcLocalGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcLocalGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_local_gateway.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -165,9 +169,9 @@ func resourceTencentCloudVpcLocalGatewayUpdate(d *schema.ResourceData, meta inte
 
 	request := vpc.NewModifyLocalGatewayRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	cdcId := idSplit[0]
 	localGatewayId := idSplit[1]
@@ -185,8 +189,8 @@ func resourceTencentCloudVpcLocalGatewayUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyLocalGateway(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyLocalGatewayWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -196,29 +200,29 @@ func resourceTencentCloudVpcLocalGatewayUpdate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update vpc localGateway failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudVpcLocalGatewayRead(d, meta)
+	return resourceTencentCloudVpcLocalGatewayRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcLocalGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcLocalGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_local_gateway.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	cdcId := idSplit[0]
 	localGatewayId := idSplit[1]
 
 	if err := service.DeleteVpcLocalGatewayById(ctx, cdcId, localGatewayId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -221,7 +221,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -230,12 +232,16 @@ import (
 
 func resourceTencentCloudVpcNetDetect() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcNetDetectCreate,
-		Read:   resourceTencentCloudVpcNetDetectRead,
-		Update: resourceTencentCloudVpcNetDetectUpdate,
-		Delete: resourceTencentCloudVpcNetDetectDelete,
+		CreateContext: resourceTencentCloudVpcNetDetectCreate,
+		ReadContext:   resourceTencentCloudVpcNetDetectRead,
+		UpdateContext: resourceTencentCloudVpcNetDetectUpdate,
+		DeleteContext: resourceTencentCloudVpcNetDetectDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -288,7 +294,7 @@ func resourceTencentCloudVpcNetDetect() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcNetDetectCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcNetDetectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_net_detect.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -331,8 +337,8 @@ func resourceTencentCloudVpcNetDetectCreate(d *schema.ResourceData, meta interfa
 		request.NetDetectDescription = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateNetDetect(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateNetDetectWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -343,22 +349,22 @@ func resourceTencentCloudVpcNetDetectCreate(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc netDetect failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	netDetectId = *response.Response.NetDetect.NetDetectId
 	d.SetId(netDetectId)
 
-	return resourceTencentCloudVpcNetDetectRead(d, meta)
+	return resourceTencentCloudVpcNetDetectRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcNetDetectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcNetDetectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_net_detect.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -366,7 +372,7 @@ func resourceTencentCloudVpcNetDetectRead(d *schema.ResourceData, meta interface
 
 	netDetect, err := service.DescribeVpcNetDetectById(ctx, netDetectId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if netDetect == nil {
@@ -406,7 +412,7 @@ func resourceTencentCloudVpcNetDetectRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudVpcNetDetectUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcNetDetectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_net_detect.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -457,8 +463,8 @@ func resourceTencentCloudVpcNetDetectUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyNetDetect(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyNetDetectWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -468,24 +474,24 @@ func resourceTencentCloudVpcNetDetectUpdate(d *schema.ResourceData, meta interfa
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update vpc netDetect failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudVpcNetDetectRead(d, meta)
+	return resourceTencentCloudVpcNetDetectRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcNetDetectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcNetDetectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_net_detect.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	netDetectId := d.Id()
 
 	if err := service.DeleteVpcNetDetectById(ctx, netDetectId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -75,7 +75,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -84,12 +86,16 @@ import (
 
 func resourceTencentCloudVpcNetworkAclQuintuple() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcNetworkAclQuintupleCreate,
-		Read:   resourceTencentCloudVpcNetworkAclQuintupleRead,
-		Update: resourceTencentCloudVpcNetworkAclQuintupleUpdate,
-		Delete: resourceTencentCloudVpcNetworkAclQuintupleDelete,
+		CreateContext: resourceTencentCloudVpcNetworkAclQuintupleCreate,
+		ReadContext:   resourceTencentCloudVpcNetworkAclQuintupleRead,
+		UpdateContext: resourceTencentCloudVpcNetworkAclQuintupleUpdate,
+		DeleteContext: resourceTencentCloudVpcNetworkAclQuintupleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"network_acl_id": {
@@ -240,7 +246,7 @@ func resourceTencentCloudVpcNetworkAclQuintuple() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcNetworkAclQuintupleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcNetworkAclQuintupleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_network_acl_quintuple.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -340,8 +346,8 @@ func resourceTencentCloudVpcNetworkAclQuintupleCreate(d *schema.ResourceData, me
 		request.NetworkAclQuintupleSet = &networkAclQuintupleEntries
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateNetworkAclQuintupleEntries(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateNetworkAclQuintupleEntriesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -351,21 +357,21 @@ func resourceTencentCloudVpcNetworkAclQuintupleCreate(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc networkAclQuintuple failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(networkAclId)
 
-	return resourceTencentCloudVpcNetworkAclQuintupleRead(d, meta)
+	return resourceTencentCloudVpcNetworkAclQuintupleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcNetworkAclQuintupleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcNetworkAclQuintupleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_network_acl_quintuple.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -373,7 +379,7 @@ func resourceTencentCloudVpcNetworkAclQuintupleRead(d *schema.ResourceData, meta
 
 	networkAclQuintuples, err := service.DescribeVpcNetworkAclQuintupleById(ctx, networkAclId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if networkAclQuintuples == nil {
@@ -497,7 +503,7 @@ func resourceTencentCloudVpcNetworkAclQuintupleRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceTencentCloudVpcNetworkAclQuintupleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcNetworkAclQuintupleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_network_acl_quintuple.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -596,8 +602,8 @@ func resourceTencentCloudVpcNetworkAclQuintupleUpdate(d *schema.ResourceData, me
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyNetworkAclQuintupleEntries(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyNetworkAclQuintupleEntriesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -607,24 +613,24 @@ func resourceTencentCloudVpcNetworkAclQuintupleUpdate(d *schema.ResourceData, me
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update vpc networkAclQuintuple failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudVpcNetworkAclQuintupleRead(d, meta)
+	return resourceTencentCloudVpcNetworkAclQuintupleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcNetworkAclQuintupleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcNetworkAclQuintupleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_network_acl_quintuple.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	networkAclId := d.Id()
 
 	if err := service.DeleteVpcNetworkAclQuintupleById(ctx, networkAclId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -32,10 +32,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -44,11 +44,14 @@ import (
 
 func resourceTencentCloudVpcNotifyRoutes() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcNotifyRoutesCreate,
-		Read:   resourceTencentCloudVpcNotifyRoutesRead,
-		Delete: resourceTencentCloudVpcNotifyRoutesDelete,
+		CreateContext: resourceTencentCloudVpcNotifyRoutesCreate,
+		ReadContext:   resourceTencentCloudVpcNotifyRoutesRead,
+		DeleteContext: resourceTencentCloudVpcNotifyRoutesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"route_table_id": {
@@ -77,7 +80,7 @@ func resourceTencentCloudVpcNotifyRoutes() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcNotifyRoutesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcNotifyRoutesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_notify_routes.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -101,8 +104,8 @@ func resourceTencentCloudVpcNotifyRoutesCreate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().NotifyRoutes(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().NotifyRoutesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -112,34 +115,34 @@ func resourceTencentCloudVpcNotifyRoutesCreate(d *schema.ResourceData, meta inte
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate vpc notifyRoutes failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(routeTableId + FILED_SP + routeItemId)
+	d.SetId(helper.IdFormat(routeTableId, routeItemId))
 
-	return resourceTencentCloudVpcNotifyRoutesRead(d, meta)
+	return resourceTencentCloudVpcNotifyRoutesRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcNotifyRoutesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcNotifyRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_notify_routes.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	routeTableId := idSplit[0]
 	routeItemId := idSplit[1]
 
 	notifyRoutes, err := service.DescribeVpcNotifyRoutesById(ctx, routeTableId, routeItemId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if notifyRoutes == nil {
@@ -163,23 +166,23 @@ func resourceTencentCloudVpcNotifyRoutesRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudVpcNotifyRoutesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcNotifyRoutesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_notify_routes.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	routeTableId := idSplit[0]
 	routeItemId := idSplit[1]
 
 	if err := service.DeleteVpcNotifyRoutesById(ctx, routeTableId, routeItemId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
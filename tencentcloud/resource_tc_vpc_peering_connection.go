@@ -0,0 +1,396 @@
+/*
+Provides a resource to create a VPC peering connection, which connects two VPCs of the same or different accounts and regions without a CCN.
+
+~> **NOTE:** The peering connection to the VPC of another account stays `PENDING` until the peer account accepts it, which can be done with `tencentcloud_vpc_peering_connection_accepter` and a provider configured with the credentials of the peer account.
+
+Example Usage
+
+```hcl
+resource "tencentcloud_vpc" "source" {
+  name       = "tf-example-peering-source"
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "tencentcloud_vpc" "peer" {
+  name       = "tf-example-peering-peer"
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "tencentcloud_vpc_peering_connection" "example" {
+  peering_connection_name = "tf-example"
+  source_vpc_id           = tencentcloud_vpc.source.id
+  peer_vpc_id             = tencentcloud_vpc.peer.id
+  bandwidth               = 10
+  auto_accept             = true
+}
+```
+
+Cross-account peering connection
+
+```hcl
+provider "tencentcloud" {
+  alias  = "peer"
+  region = "ap-shanghai"
+}
+
+resource "tencentcloud_vpc_peering_connection" "requester" {
+  peering_connection_name = "tf-example-cross-account"
+  source_vpc_id           = "vpc-xxxxxxxx"
+  peer_vpc_id             = "vpc-yyyyyyyy"
+  peer_uin                = "100000000002"
+  peer_region             = "ap-shanghai"
+  bandwidth               = 10
+}
+
+resource "tencentcloud_vpc_peering_connection_accepter" "accepter" {
+  provider              = tencentcloud.peer
+  peering_connection_id = tencentcloud_vpc_peering_connection.requester.id
+}
+```
+
+Import
+
+VPC peering connection can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_vpc_peering_connection.example pcx-xxxxxxxx
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudVpcPeeringConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTencentCloudVpcPeeringConnectionCreate,
+		ReadContext:   resourceTencentCloudVpcPeeringConnectionRead,
+		UpdateContext: resourceTencentCloudVpcPeeringConnectionUpdate,
+		DeleteContext: resourceTencentCloudVpcPeeringConnectionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"peering_connection_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringLengthInRange(1, 60),
+				Description:  "Name of the peering connection.",
+			},
+			"source_vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the VPC of this account to connect from.",
+			},
+			"peer_vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the VPC to connect to.",
+			},
+			"peer_uin": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "UIN of the account of the peer VPC. Default is the account of the provider.",
+			},
+			"peer_region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Region of the peer VPC. Default is the region of the provider.",
+			},
+			"bandwidth": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerMin(1),
+				Description:  "Bandwidth of the peering connection in Mbps, it can be modified.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      VPC_PEERING_CONNECTION_TYPE_VPC_PEER,
+				ValidateFunc: validateAllowedStringValue(VPC_PEERING_CONNECTION_TYPES),
+				Description:  "Type of the peering connection. Valid values: `VPC_PEER` (between VPCs), `VPC_BM_PEER` (between a VPC and a bare metal VPC). Default is `VPC_PEER`.",
+			},
+			"charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue(VPC_PEERING_CONNECTION_CHARGE_TYPES),
+				Description:  "Billing mode of the cross-region peering connection. Valid values: `POSTPAID_BY_DAY_MAX`, `POSTPAID_BY_MONTH_95`.",
+			},
+			"qos_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue(VPC_PEERING_CONNECTION_QOS_LEVELS),
+				Description:  "Service quality of the cross-region peering connection. Valid values: `PT` (platinum), `AU` (gold), `AG` (silver).",
+			},
+			"auto_accept": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to accept the peering connection with the credentials of the provider, it only works when the peer VPC belongs to the same account, otherwise an error is returned and the peering connection has to be accepted by the `tencentcloud_vpc_peering_connection_accepter` of the peer account. Default is `false`.",
+			},
+			// Computed values
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the peering connection, such as `PENDING`, `ACTIVE`, `EXPIRED` and `REJECTED`.",
+			},
+			"source_region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Region of the source VPC.",
+			},
+			"source_uin": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "UIN of the account of the source VPC.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the peering connection.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudVpcPeeringConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_vpc_peering_connection.create")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	params := &CreateVpcPeeringConnectionParams{
+		PeeringConnectionName: helper.String(d.Get("peering_connection_name").(string)),
+		SourceVpcId:           helper.String(d.Get("source_vpc_id").(string)),
+		DestinationVpcId:      helper.String(d.Get("peer_vpc_id").(string)),
+		Type:                  helper.String(d.Get("type").(string)),
+	}
+	if v, ok := d.GetOk("peer_uin"); ok {
+		if d.Get("auto_accept").(bool) {
+			if err := checkVpcPeeringConnectionAutoAccept(ctx, meta, v.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		params.DestinationUin = helper.String(v.(string))
+	} else {
+		// the peer VPC belongs to the account of the provider
+		stsService := StsService{client: meta.(*TencentCloudClient).apiV3Conn}
+		callerIdentity, err := stsService.DescribeStsCallerIdentityByFilter(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		params.DestinationUin = callerIdentity.AccountId
+	}
+	if v, ok := d.GetOk("peer_region"); ok {
+		params.DestinationRegion = helper.String(v.(string))
+	} else {
+		params.DestinationRegion = helper.String(meta.(*TencentCloudClient).apiV3Conn.Region)
+	}
+	if v, ok := d.GetOk("bandwidth"); ok {
+		params.Bandwidth = helper.IntInt64(v.(int))
+	}
+	if v, ok := d.GetOk("charge_type"); ok {
+		params.ChargeType = helper.String(v.(string))
+	}
+	if v, ok := d.GetOk("qos_level"); ok {
+		params.QosLevel = helper.String(v.(string))
+	}
+
+	peeringConnectionId, err := service.CreateVpcPeeringConnection(ctx, params)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(peeringConnectionId)
+
+	// the peering connection may not be visible right after it is created
+	peeringConnection, err := service.WaitForVpcPeeringConnectionState(ctx, peeringConnectionId,
+		[]string{VPC_PEERING_CONNECTION_STATE_PENDING, VPC_PEERING_CONNECTION_STATE_ACTIVE},
+		VPC_PEERING_CONNECTION_FAILED_STATES, helper.NotFoundPending, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("auto_accept").(bool) && helper.PString(peeringConnection.State) == VPC_PEERING_CONNECTION_STATE_PENDING {
+		if err := acceptVpcPeeringConnection(ctx, &service, peeringConnectionId, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceTencentCloudVpcPeeringConnectionRead(ctx, d, meta)
+}
+
+func resourceTencentCloudVpcPeeringConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_vpc_peering_connection.read")()
+	defer inconsistentCheck(d, meta)()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	peeringConnection, err := service.DescribeVpcPeeringConnectionById(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if peeringConnection == nil {
+		log.Printf("[WARN]%s vpc peering connection [%s] not found, please check if it has been deleted.\n", logId, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("peering_connection_name", peeringConnection.PeeringConnectionName)
+	_ = d.Set("source_vpc_id", peeringConnection.SourceVpcId)
+	_ = d.Set("peer_vpc_id", peeringConnection.PeerVpcId)
+	_ = d.Set("peer_region", peeringConnection.DestinationRegion)
+	_ = d.Set("source_region", peeringConnection.SourceRegion)
+	_ = d.Set("bandwidth", peeringConnection.Bandwidth)
+	_ = d.Set("charge_type", peeringConnection.ChargeType)
+	_ = d.Set("qos_level", peeringConnection.QosLevel)
+	_ = d.Set("state", peeringConnection.State)
+	_ = d.Set("create_time", peeringConnection.CreateTime)
+	if peeringConnection.Type != nil {
+		_ = d.Set("type", peeringConnection.Type)
+	}
+	if peeringConnection.DestinationUin != nil {
+		_ = d.Set("peer_uin", strconv.FormatInt(*peeringConnection.DestinationUin, 10))
+	}
+	if peeringConnection.SourceUin != nil {
+		_ = d.Set("source_uin", strconv.FormatInt(*peeringConnection.SourceUin, 10))
+	}
+
+	return nil
+}
+
+func resourceTencentCloudVpcPeeringConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_vpc_peering_connection.update")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+	peeringConnectionId := d.Id()
+
+	if d.HasChange("auto_accept") && d.Get("auto_accept").(bool) {
+		if err := checkVpcPeeringConnectionAutoAccept(ctx, meta, d.Get("peer_uin").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChanges("peering_connection_name", "bandwidth", "charge_type") {
+		params := &ModifyVpcPeeringConnectionParams{
+			PeeringConnectionId:   &peeringConnectionId,
+			PeeringConnectionName: helper.String(d.Get("peering_connection_name").(string)),
+		}
+		if d.HasChange("bandwidth") {
+			params.Bandwidth = helper.IntInt64(d.Get("bandwidth").(int))
+		}
+		if d.HasChange("charge_type") {
+			params.ChargeType = helper.String(d.Get("charge_type").(string))
+		}
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			if e := service.ModifyVpcPeeringConnection(ctx, params); e != nil {
+				return retryError(e)
+			}
+			return nil
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("auto_accept") && d.Get("auto_accept").(bool) && d.Get("state").(string) == VPC_PEERING_CONNECTION_STATE_PENDING {
+		if err := acceptVpcPeeringConnection(ctx, &service, peeringConnectionId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceTencentCloudVpcPeeringConnectionRead(ctx, d, meta)
+}
+
+func resourceTencentCloudVpcPeeringConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_vpc_peering_connection.delete")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+	peeringConnectionId := d.Id()
+
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		if e := service.DeleteVpcPeeringConnectionById(ctx, peeringConnectionId); e != nil {
+			if isExpectError(e, []string{VPCNotFound}) {
+				return nil
+			}
+			return retryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := service.WaitForVpcPeeringConnectionState(ctx, peeringConnectionId, []string{VPC_PEERING_CONNECTION_STATE_DELETED},
+		nil, helper.NotFoundTarget, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// acceptVpcPeeringConnection accepts the pending peering connection and waits for it to be active.
+func acceptVpcPeeringConnection(ctx context.Context, service *VpcService, peeringConnectionId string, timeout time.Duration) error {
+	err := retryContext(ctx, timeout, func() *resource.RetryError {
+		if e := service.AcceptVpcPeeringConnection(ctx, peeringConnectionId); e != nil {
+			return retryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	_, err = service.WaitForVpcPeeringConnectionState(ctx, peeringConnectionId, []string{VPC_PEERING_CONNECTION_STATE_ACTIVE},
+		VPC_PEERING_CONNECTION_FAILED_STATES, helper.NotFoundFail, timeout)
+	return err
+}
+
+// checkVpcPeeringConnectionAutoAccept returns an error unless the peer VPC belongs to the account of the provider,
+// the peering connection to another account is accepted by the `tencentcloud_vpc_peering_connection_accepter` of the peer.
+func checkVpcPeeringConnectionAutoAccept(ctx context.Context, meta interface{}, peerUin string) error {
+	stsService := StsService{client: meta.(*TencentCloudClient).apiV3Conn}
+	callerIdentity, err := stsService.DescribeStsCallerIdentityByFilter(ctx)
+	if err != nil {
+		return err
+	}
+	if accountId := helper.PString(callerIdentity.AccountId); peerUin != accountId {
+		return fmt.Errorf("`auto_accept` only works for the peer VPC of the same account %s, the peering connection to account %s has to be accepted by the `tencentcloud_vpc_peering_connection_accepter` of the peer account", accountId, peerUin)
+	}
+	return nil
+}
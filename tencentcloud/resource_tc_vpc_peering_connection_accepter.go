@@ -0,0 +1,214 @@
+/*
+Provides a resource to accept a VPC peering connection requested by another account, it is managed with a provider configured with the credentials and region of the peer account.
+
+~> **NOTE:** Destroying the resource only removes it from the state, the peering connection is deleted by destroying the `tencentcloud_vpc_peering_connection` of the requester.
+
+Example Usage
+
+```hcl
+provider "tencentcloud" {
+  alias  = "peer"
+  region = "ap-shanghai"
+}
+
+resource "tencentcloud_vpc_peering_connection" "requester" {
+  peering_connection_name = "tf-example-cross-account"
+  source_vpc_id           = "vpc-xxxxxxxx"
+  peer_vpc_id             = "vpc-yyyyyyyy"
+  peer_uin                = "100000000002"
+  peer_region             = "ap-shanghai"
+  bandwidth               = 10
+}
+
+resource "tencentcloud_vpc_peering_connection_accepter" "accepter" {
+  provider              = tencentcloud.peer
+  peering_connection_id = tencentcloud_vpc_peering_connection.requester.id
+}
+```
+
+Import
+
+VPC peering connection accepter can be imported using the id of the peering connection, e.g.
+
+```
+$ terraform import tencentcloud_vpc_peering_connection_accepter.accepter pcx-xxxxxxxx
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudVpcPeeringConnectionAccepter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTencentCloudVpcPeeringConnectionAccepterCreate,
+		ReadContext:   resourceTencentCloudVpcPeeringConnectionAccepterRead,
+		DeleteContext: resourceTencentCloudVpcPeeringConnectionAccepterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"peering_connection_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the peering connection to accept.",
+			},
+			// Computed values
+			"peering_connection_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the peering connection.",
+			},
+			"source_vpc_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the VPC of the requester.",
+			},
+			"source_region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Region of the VPC of the requester.",
+			},
+			"source_uin": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "UIN of the account of the requester.",
+			},
+			"peer_vpc_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the VPC of this account.",
+			},
+			"peer_region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Region of the VPC of this account.",
+			},
+			"peer_uin": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "UIN of this account.",
+			},
+			"bandwidth": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Bandwidth of the peering connection in Mbps.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the peering connection.",
+			},
+			"charge_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Billing mode of the peering connection.",
+			},
+			"qos_level": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Service quality of the peering connection.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the peering connection.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the peering connection.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudVpcPeeringConnectionAccepterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_vpc_peering_connection_accepter.create")()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+	peeringConnectionId := d.Get("peering_connection_id").(string)
+
+	peeringConnection, err := service.WaitForVpcPeeringConnectionState(ctx, peeringConnectionId,
+		[]string{VPC_PEERING_CONNECTION_STATE_PENDING, VPC_PEERING_CONNECTION_STATE_ACTIVE},
+		VPC_PEERING_CONNECTION_FAILED_STATES, helper.NotFoundPending, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("vpc peering connection %s can not be accepted: %w", peeringConnectionId, err))
+	}
+
+	// the peering connection accepted before is adopted
+	if helper.PString(peeringConnection.State) == VPC_PEERING_CONNECTION_STATE_PENDING {
+		if err := acceptVpcPeeringConnection(ctx, &service, peeringConnectionId, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(peeringConnectionId)
+
+	return resourceTencentCloudVpcPeeringConnectionAccepterRead(ctx, d, meta)
+}
+
+func resourceTencentCloudVpcPeeringConnectionAccepterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_vpc_peering_connection_accepter.read")()
+	defer inconsistentCheck(d, meta)()
+
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+
+	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	peeringConnection, err := service.DescribeVpcPeeringConnectionById(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if peeringConnection == nil {
+		log.Printf("[WARN]%s vpc peering connection [%s] not found, please check if it has been deleted.\n", logId, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("peering_connection_id", d.Id())
+	_ = d.Set("peering_connection_name", peeringConnection.PeeringConnectionName)
+	_ = d.Set("source_vpc_id", peeringConnection.SourceVpcId)
+	_ = d.Set("source_region", peeringConnection.SourceRegion)
+	_ = d.Set("peer_vpc_id", peeringConnection.PeerVpcId)
+	_ = d.Set("peer_region", peeringConnection.DestinationRegion)
+	_ = d.Set("bandwidth", peeringConnection.Bandwidth)
+	_ = d.Set("type", peeringConnection.Type)
+	_ = d.Set("charge_type", peeringConnection.ChargeType)
+	_ = d.Set("qos_level", peeringConnection.QosLevel)
+	_ = d.Set("state", peeringConnection.State)
+	_ = d.Set("create_time", peeringConnection.CreateTime)
+	if peeringConnection.SourceUin != nil {
+		_ = d.Set("source_uin", strconv.FormatInt(*peeringConnection.SourceUin, 10))
+	}
+	if peeringConnection.DestinationUin != nil {
+		_ = d.Set("peer_uin", strconv.FormatInt(*peeringConnection.DestinationUin, 10))
+	}
+
+	return nil
+}
+
+func resourceTencentCloudVpcPeeringConnectionAccepterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer logElapsed("resource.tencentcloud_vpc_peering_connection_accepter.delete")()
+
+	logId := getLogId(contextNil)
+	log.Printf("[WARN]%s vpc peering connection [%s] is only removed from the state, it is deleted by the requester.\n", logId, d.Id())
+
+	return warningDiagnostics(diag.Errorf("vpc peering connection %s is only removed from the state, it is deleted by the requester", d.Id()))
+}
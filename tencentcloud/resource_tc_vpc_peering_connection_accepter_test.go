@@ -0,0 +1,115 @@
+package tencentcloud
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// the aliases of a provider share its instance in the acceptance tests, so the provider of the peer
+// account is served with another name, as the alias `tencentcloud.peer` of the resource examples.
+const testAccVpcPeeringConnectionPeerProvider = "tencentcloudpeer"
+
+func TestAccTencentCloudVpcPeeringConnectionAccepterResource_basic(t *testing.T) {
+	keyName := "tencentcloud_vpc_peering_connection_accepter.accepter"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheckVpcPeeringConnectionAccepter(t) },
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"tencentcloud":                          func() (*schema.Provider, error) { return testAccProvider, nil },
+			testAccVpcPeeringConnectionPeerProvider: func() (*schema.Provider, error) { return Provider(), nil },
+		},
+		CheckDestroy: testAccCheckVpcPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVpcPeeringConnectionAccepterAutoAccept(),
+				ExpectError: regexp.MustCompile("`auto_accept` only works for the peer VPC of the same account"),
+			},
+			{
+				Config: testAccVpcPeeringConnectionAccepter(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcPeeringConnectionExists("tencentcloud_vpc_peering_connection.requester"),
+					resource.TestCheckResourceAttrPair(keyName, "peering_connection_id", "tencentcloud_vpc_peering_connection.requester", "id"),
+					resource.TestCheckResourceAttrPair(keyName, "source_vpc_id", "tencentcloud_vpc.source", "id"),
+					resource.TestCheckResourceAttrPair(keyName, "peer_vpc_id", "tencentcloud_vpc.peer", "id"),
+					resource.TestCheckResourceAttrPair(keyName, "peer_uin", "data.tencentcloud_user_info.peer", "owner_uin"),
+					resource.TestCheckResourceAttr(keyName, "peering_connection_name", "tf-ci-test-peering-accepter"),
+					resource.TestCheckResourceAttr(keyName, "bandwidth", "10"),
+					resource.TestCheckResourceAttr(keyName, "state", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(keyName, "source_uin"),
+					resource.TestCheckResourceAttrSet(keyName, "create_time"),
+					resource.TestCheckResourceAttr("tencentcloud_vpc_peering_connection.requester", "state", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      keyName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPreCheckVpcPeeringConnectionAccepter(t *testing.T) {
+	testAccPreCheck(t)
+	if os.Getenv(COMMON_PROVIDER_SECRET_ID) == "" || os.Getenv(COMMON_PROVIDER_SECRET_KEY) == "" {
+		t.Fatalf("%v and %v of the peer account must be set for acceptance tests\n", COMMON_PROVIDER_SECRET_ID, COMMON_PROVIDER_SECRET_KEY)
+	}
+}
+
+func testAccVpcPeeringConnectionAccepterBasic() string {
+	return fmt.Sprintf(`
+provider "%[1]s" {
+  secret_id  = "%[2]s"
+  secret_key = "%[3]s"
+}
+
+data "tencentcloud_user_info" "peer" {
+  provider = %[1]s
+}
+
+resource "tencentcloud_vpc" "source" {
+  name       = "tf-ci-test-peering-accepter-source"
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "tencentcloud_vpc" "peer" {
+  provider   = %[1]s
+  name       = "tf-ci-test-peering-accepter-peer"
+  cidr_block = "172.16.0.0/16"
+}
+`, testAccVpcPeeringConnectionPeerProvider, os.Getenv(COMMON_PROVIDER_SECRET_ID), os.Getenv(COMMON_PROVIDER_SECRET_KEY))
+}
+
+func testAccVpcPeeringConnectionAccepterAutoAccept() string {
+	return testAccVpcPeeringConnectionAccepterBasic() + `
+resource "tencentcloud_vpc_peering_connection" "requester" {
+  peering_connection_name = "tf-ci-test-peering-accepter"
+  source_vpc_id           = tencentcloud_vpc.source.id
+  peer_vpc_id             = tencentcloud_vpc.peer.id
+  peer_uin                = data.tencentcloud_user_info.peer.owner_uin
+  bandwidth               = 10
+  auto_accept             = true
+}
+`
+}
+
+func testAccVpcPeeringConnectionAccepter() string {
+	return testAccVpcPeeringConnectionAccepterBasic() + fmt.Sprintf(`
+resource "tencentcloud_vpc_peering_connection" "requester" {
+  peering_connection_name = "tf-ci-test-peering-accepter"
+  source_vpc_id           = tencentcloud_vpc.source.id
+  peer_vpc_id             = tencentcloud_vpc.peer.id
+  peer_uin                = data.tencentcloud_user_info.peer.owner_uin
+  bandwidth               = 10
+}
+
+resource "tencentcloud_vpc_peering_connection_accepter" "accepter" {
+  provider              = %s
+  peering_connection_id = tencentcloud_vpc_peering_connection.requester.id
+}
+`, testAccVpcPeeringConnectionPeerProvider)
+}
@@ -0,0 +1,126 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTencentCloudVpcPeeringConnectionResource_basic(t *testing.T) {
+	t.Parallel()
+	keyName := "tencentcloud_vpc_peering_connection.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcPeeringConnection,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcPeeringConnectionExists(keyName),
+					resource.TestCheckResourceAttr(keyName, "peering_connection_name", "tf-ci-test-peering"),
+					resource.TestCheckResourceAttr(keyName, "bandwidth", "10"),
+					resource.TestCheckResourceAttr(keyName, "type", "VPC_PEER"),
+					resource.TestCheckResourceAttr(keyName, "state", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(keyName, "peer_uin"),
+					resource.TestCheckResourceAttrSet(keyName, "peer_region"),
+					resource.TestCheckResourceAttrSet(keyName, "create_time"),
+				),
+			},
+			{
+				ResourceName:            keyName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_accept"},
+			},
+			{
+				Config: testAccVpcPeeringConnectionUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcPeeringConnectionExists(keyName),
+					resource.TestCheckResourceAttr(keyName, "peering_connection_name", "tf-ci-test-peering-update"),
+					resource.TestCheckResourceAttr(keyName, "bandwidth", "20"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpcPeeringConnectionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		logId := getLogId(contextNil)
+		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("vpc peering connection %s is not found", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("vpc peering connection id is not set")
+		}
+
+		service := VpcService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+		peeringConnection, err := service.DescribeVpcPeeringConnectionById(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if peeringConnection == nil {
+			return fmt.Errorf("vpc peering connection %s is not found", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckVpcPeeringConnectionDestroy(s *terraform.State) error {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	service := VpcService{client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_vpc_peering_connection" {
+			continue
+		}
+		peeringConnection, err := service.DescribeVpcPeeringConnectionById(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if peeringConnection != nil {
+			return fmt.Errorf("vpc peering connection %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+const testAccVpcPeeringConnectionBasic = `
+resource "tencentcloud_vpc" "source" {
+  name       = "tf-ci-test-peering-source"
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "tencentcloud_vpc" "peer" {
+  name       = "tf-ci-test-peering-peer"
+  cidr_block = "172.16.0.0/16"
+}
+`
+
+const testAccVpcPeeringConnection = testAccVpcPeeringConnectionBasic + `
+resource "tencentcloud_vpc_peering_connection" "example" {
+  peering_connection_name = "tf-ci-test-peering"
+  source_vpc_id           = tencentcloud_vpc.source.id
+  peer_vpc_id             = tencentcloud_vpc.peer.id
+  bandwidth               = 10
+  auto_accept             = true
+}
+`
+
+const testAccVpcPeeringConnectionUpdate = testAccVpcPeeringConnectionBasic + `
+resource "tencentcloud_vpc_peering_connection" "example" {
+  peering_connection_name = "tf-ci-test-peering-update"
+  source_vpc_id           = tencentcloud_vpc.source.id
+  peer_vpc_id             = tencentcloud_vpc.peer.id
+  bandwidth               = 20
+  auto_accept             = true
+}
+`
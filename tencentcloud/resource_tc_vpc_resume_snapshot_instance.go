@@ -61,8 +61,11 @@ resource "tencentcloud_vpc_resume_snapshot_instance" "example" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -71,9 +74,12 @@ import (
 
 func resourceTencentCloudVpcResumeSnapshotInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcResumeSnapshotInstanceCreate,
-		Read:   resourceTencentCloudVpcResumeSnapshotInstanceRead,
-		Delete: resourceTencentCloudVpcResumeSnapshotInstanceDelete,
+		CreateContext: resourceTencentCloudVpcResumeSnapshotInstanceCreate,
+		ReadContext:   resourceTencentCloudVpcResumeSnapshotInstanceRead,
+		DeleteContext: resourceTencentCloudVpcResumeSnapshotInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"snapshot_policy_id": {
 				Required:    true,
@@ -99,7 +105,7 @@ func resourceTencentCloudVpcResumeSnapshotInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcResumeSnapshotInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcResumeSnapshotInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_resume_snapshot_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -126,8 +132,8 @@ func resourceTencentCloudVpcResumeSnapshotInstanceCreate(d *schema.ResourceData,
 		request.InstanceId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ResumeSnapshotInstance(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ResumeSnapshotInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -137,22 +143,22 @@ func resourceTencentCloudVpcResumeSnapshotInstanceCreate(d *schema.ResourceData,
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s operate vpc resumeSnapshotInstance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(snapshotPolicyId + FILED_SP + snapshotFileId + FILED_SP + instanceId)
+	d.SetId(helper.IdFormat(snapshotPolicyId, snapshotFileId, instanceId))
 
-	return resourceTencentCloudVpcResumeSnapshotInstanceRead(d, meta)
+	return resourceTencentCloudVpcResumeSnapshotInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcResumeSnapshotInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcResumeSnapshotInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_resume_snapshot_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudVpcResumeSnapshotInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcResumeSnapshotInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_resume_snapshot_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
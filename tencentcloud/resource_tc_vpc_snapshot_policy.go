@@ -44,9 +44,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -55,12 +56,16 @@ import (
 
 func resourceTencentCloudVpcSnapshotPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcSnapshotPolicyCreate,
-		Read:   resourceTencentCloudVpcSnapshotPolicyRead,
-		Update: resourceTencentCloudVpcSnapshotPolicyUpdate,
-		Delete: resourceTencentCloudVpcSnapshotPolicyDelete,
+		CreateContext: resourceTencentCloudVpcSnapshotPolicyCreate,
+		ReadContext:   resourceTencentCloudVpcSnapshotPolicyRead,
+		UpdateContext: resourceTencentCloudVpcSnapshotPolicyUpdate,
+		DeleteContext: resourceTencentCloudVpcSnapshotPolicyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"snapshot_policy_name": {
@@ -131,7 +136,7 @@ func resourceTencentCloudVpcSnapshotPolicy() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcSnapshotPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcSnapshotPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_snapshot_policy.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -186,8 +191,8 @@ func resourceTencentCloudVpcSnapshotPolicyCreate(d *schema.ResourceData, meta in
 	}
 	request.SnapshotPolicies = append(request.SnapshotPolicies, &snapshotPolicy)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateSnapshotPolicies(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateSnapshotPoliciesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -198,28 +203,28 @@ func resourceTencentCloudVpcSnapshotPolicyCreate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc snapshotPolicies failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	snapshotPolicies := response.Response.SnapshotPolicies
 	if len(snapshotPolicies) < 1 {
-		return fmt.Errorf("create vpc snapshotPolicies failed.")
+		return diag.Errorf("create vpc snapshotPolicies failed.")
 	}
 
 	snapshotPolicyId = *snapshotPolicies[0].SnapshotPolicyId
 
 	d.SetId(snapshotPolicyId)
 
-	return resourceTencentCloudVpcSnapshotPolicyRead(d, meta)
+	return resourceTencentCloudVpcSnapshotPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcSnapshotPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcSnapshotPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_snapshot_policy.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -227,7 +232,7 @@ func resourceTencentCloudVpcSnapshotPolicyRead(d *schema.ResourceData, meta inte
 
 	snapshotPolicies, err := service.DescribeVpcSnapshotPoliciesById(ctx, snapshotPolicyId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if snapshotPolicies == nil {
@@ -296,7 +301,7 @@ func resourceTencentCloudVpcSnapshotPolicyRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudVpcSnapshotPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcSnapshotPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_snapshot_policies.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -314,7 +319,7 @@ func resourceTencentCloudVpcSnapshotPolicyUpdate(d *schema.ResourceData, meta in
 	}
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -356,8 +361,8 @@ func resourceTencentCloudVpcSnapshotPolicyUpdate(d *schema.ResourceData, meta in
 		}
 		request.SnapshotPoliciesInfo = append(request.SnapshotPoliciesInfo, &snapshotPolicy)
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifySnapshotPolicies(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifySnapshotPoliciesWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -367,25 +372,25 @@ func resourceTencentCloudVpcSnapshotPolicyUpdate(d *schema.ResourceData, meta in
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update vpc snapshotPolicies failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudVpcSnapshotPolicyRead(d, meta)
+	return resourceTencentCloudVpcSnapshotPolicyRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcSnapshotPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcSnapshotPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_snapshot_policy.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	snapshotPolicyId := d.Id()
 
 	if err := service.DeleteVpcSnapshotPoliciesById(ctx, snapshotPolicyId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
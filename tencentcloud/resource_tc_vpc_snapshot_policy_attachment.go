@@ -61,7 +61,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -70,11 +72,14 @@ import (
 
 func resourceTencentCloudVpcSnapshotPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcSnapshotPolicyAttachmentCreate,
-		Read:   resourceTencentCloudVpcSnapshotPolicyAttachmentRead,
-		Delete: resourceTencentCloudVpcSnapshotPolicyAttachmentDelete,
+		CreateContext: resourceTencentCloudVpcSnapshotPolicyAttachmentCreate,
+		ReadContext:   resourceTencentCloudVpcSnapshotPolicyAttachmentRead,
+		DeleteContext: resourceTencentCloudVpcSnapshotPolicyAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"snapshot_policy_id": {
@@ -128,7 +133,7 @@ func resourceTencentCloudVpcSnapshotPolicyAttachment() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcSnapshotPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcSnapshotPolicyAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_snapshot_policy_attachment.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -166,8 +171,8 @@ func resourceTencentCloudVpcSnapshotPolicyAttachmentCreate(d *schema.ResourceDat
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AttachSnapshotInstances(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AttachSnapshotInstancesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -177,21 +182,21 @@ func resourceTencentCloudVpcSnapshotPolicyAttachmentCreate(d *schema.ResourceDat
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc snapshotPolicyAttachment failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(snapshotPolicyId)
 
-	return resourceTencentCloudVpcSnapshotPolicyAttachmentRead(d, meta)
+	return resourceTencentCloudVpcSnapshotPolicyAttachmentRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcSnapshotPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcSnapshotPolicyAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_snapshot_policy_attachment.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -199,7 +204,7 @@ func resourceTencentCloudVpcSnapshotPolicyAttachmentRead(d *schema.ResourceData,
 
 	snapshotPolicyAttachment, err := service.DescribeVpcSnapshotPolicyAttachmentById(ctx, snapshotPolicyId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if snapshotPolicyAttachment == nil {
@@ -245,18 +250,18 @@ func resourceTencentCloudVpcSnapshotPolicyAttachmentRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceTencentCloudVpcSnapshotPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcSnapshotPolicyAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_snapshot_policy_attachment.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	snapshotPolicyId := d.Id()
 
 	if err := service.DeleteVpcSnapshotPolicyAttachmentById(ctx, snapshotPolicyId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -50,7 +50,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -58,12 +60,15 @@ import (
 
 func resourceTencentCloudVpcSnapshotPolicyConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcSnapshotPolicyConfigCreate,
-		Read:   resourceTencentCloudVpcSnapshotPolicyConfigRead,
-		Update: resourceTencentCloudVpcSnapshotPolicyConfigUpdate,
-		Delete: resourceTencentCloudVpcSnapshotPolicyConfigDelete,
+		CreateContext: resourceTencentCloudVpcSnapshotPolicyConfigCreate,
+		ReadContext:   resourceTencentCloudVpcSnapshotPolicyConfigRead,
+		UpdateContext: resourceTencentCloudVpcSnapshotPolicyConfigUpdate,
+		DeleteContext: resourceTencentCloudVpcSnapshotPolicyConfigDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"snapshot_policy_id": {
@@ -81,7 +86,7 @@ func resourceTencentCloudVpcSnapshotPolicyConfig() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcSnapshotPolicyConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcSnapshotPolicyConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_snapshot_policy_config.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -89,16 +94,16 @@ func resourceTencentCloudVpcSnapshotPolicyConfigCreate(d *schema.ResourceData, m
 
 	d.SetId(snapshotPolicyId)
 
-	return resourceTencentCloudVpcSnapshotPolicyConfigUpdate(d, meta)
+	return resourceTencentCloudVpcSnapshotPolicyConfigUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcSnapshotPolicyConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcSnapshotPolicyConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_snapshot_policy_config.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -106,7 +111,7 @@ func resourceTencentCloudVpcSnapshotPolicyConfigRead(d *schema.ResourceData, met
 
 	snapshotPolicies, err := service.DescribeVpcSnapshotPoliciesById(ctx, snapshotPolicyId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(snapshotPolicies) < 1 {
@@ -126,7 +131,7 @@ func resourceTencentCloudVpcSnapshotPolicyConfigRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudVpcSnapshotPolicyConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcSnapshotPolicyConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_snapshot_policy_config.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -146,8 +151,8 @@ func resourceTencentCloudVpcSnapshotPolicyConfigUpdate(d *schema.ResourceData, m
 
 	if enable {
 		enableRequest.SnapshotPolicyIds = []*string{&snapshotPolicyId}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().EnableSnapshotPolicies(enableRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().EnableSnapshotPoliciesWithContext(ctx, enableRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -157,12 +162,12 @@ func resourceTencentCloudVpcSnapshotPolicyConfigUpdate(d *schema.ResourceData, m
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update vpc snapshotPolicyConfig failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		disableRequest.SnapshotPolicyIds = []*string{&snapshotPolicyId}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DisableSnapshotPolicies(disableRequest)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DisableSnapshotPoliciesWithContext(ctx, disableRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -172,14 +177,14 @@ func resourceTencentCloudVpcSnapshotPolicyConfigUpdate(d *schema.ResourceData, m
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update vpc snapshotPolicyConfig failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudVpcSnapshotPolicyConfigRead(d, meta)
+	return resourceTencentCloudVpcSnapshotPolicyConfigRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcSnapshotPolicyConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcSnapshotPolicyConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_snapshot_policy_config.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -21,9 +21,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -32,11 +33,14 @@ import (
 
 func resourceTencentCloudVpcTrafficPackage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcTrafficPackageCreate,
-		Read:   resourceTencentCloudVpcTrafficPackageRead,
-		Delete: resourceTencentCloudVpcTrafficPackageDelete,
+		CreateContext: resourceTencentCloudVpcTrafficPackageCreate,
+		ReadContext:   resourceTencentCloudVpcTrafficPackageRead,
+		DeleteContext: resourceTencentCloudVpcTrafficPackageDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"traffic_amount": {
@@ -67,7 +71,7 @@ func resourceTencentCloudVpcTrafficPackage() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpcTrafficPackageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcTrafficPackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_traffic_package.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -83,8 +87,8 @@ func resourceTencentCloudVpcTrafficPackageCreate(d *schema.ResourceData, meta in
 	}
 	request.TrafficPackageCount = helper.IntUint64(1)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateTrafficPackages(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateTrafficPackagesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -95,28 +99,28 @@ func resourceTencentCloudVpcTrafficPackageCreate(d *schema.ResourceData, meta in
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create vpc TrafficPackage failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	trafficPackageSet := response.Response.TrafficPackageSet
 
 	if len(trafficPackageSet) < 1 {
-		return fmt.Errorf("create traffic package failed.")
+		return diag.Errorf("create traffic package failed.")
 	}
 	trafficPackageId = *trafficPackageSet[0]
 
 	d.SetId(trafficPackageId)
 
-	return resourceTencentCloudVpcTrafficPackageRead(d, meta)
+	return resourceTencentCloudVpcTrafficPackageRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpcTrafficPackageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcTrafficPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_traffic_package.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -124,7 +128,7 @@ func resourceTencentCloudVpcTrafficPackageRead(d *schema.ResourceData, meta inte
 
 	TrafficPackage, err := service.DescribeVpcTrafficPackageById(ctx, trafficPackageId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if TrafficPackage == nil {
@@ -152,18 +156,18 @@ func resourceTencentCloudVpcTrafficPackageRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceTencentCloudVpcTrafficPackageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpcTrafficPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpc_traffic_package.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	trafficPackageId := d.Id()
 
 	if err := service.DeleteVpcTrafficPackageById(ctx, trafficPackageId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -52,6 +52,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -61,12 +62,17 @@ import (
 
 func resourceTencentCloudVpnConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpnConnectionCreate,
-		Read:   resourceTencentCloudVpnConnectionRead,
-		Update: resourceTencentCloudVpnConnectionUpdate,
-		Delete: resourceTencentCloudVpnConnectionDelete,
+		CreateContext: resourceTencentCloudVpnConnectionCreate,
+		ReadContext:   resourceTencentCloudVpnConnectionRead,
+		UpdateContext: resourceTencentCloudVpnConnectionUpdate,
+		DeleteContext: resourceTencentCloudVpnConnectionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -313,22 +319,22 @@ func resourceTencentCloudVpnConnection() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpnConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_connection.create")()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	// pre check vpn gateway id
 	has, gateway, err := service.DescribeVpngwById(ctx, d.Get("vpn_gateway_id").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
-		return fmt.Errorf("[CRITAL] vpn_gateway_id %s doesn't exist", d.Get("vpn_gateway_id").(string))
+		return diag.Errorf("[CRITAL] vpn_gateway_id %s doesn't exist", d.Get("vpn_gateway_id").(string))
 	}
 
 	// create vpn connection
@@ -336,12 +342,12 @@ func resourceTencentCloudVpnConnectionCreate(d *schema.ResourceData, meta interf
 	request.VpnConnectionName = helper.String(d.Get("name").(string))
 	if *gateway.Type != "CCN" {
 		if _, ok := d.GetOk("vpc_id"); !ok {
-			return fmt.Errorf("[CRITAL] vpc_id is required for this vpn connection which vpn gateway is in %s type", *gateway.Type)
+			return diag.Errorf("[CRITAL] vpc_id is required for this vpn connection which vpn gateway is in %s type", *gateway.Type)
 		}
 		request.VpcId = helper.String(d.Get("vpc_id").(string))
 	} else {
 		if _, ok := d.GetOk("vpc_id"); ok {
-			return fmt.Errorf("[CRITAL] vpc_id doesn't make sense when vpn gateway is in CCN type")
+			return diag.Errorf("[CRITAL] vpc_id doesn't make sense when vpn gateway is in CCN type")
 		}
 		request.VpcId = helper.String("")
 	}
@@ -362,7 +368,7 @@ func resourceTencentCloudVpnConnectionCreate(d *schema.ResourceData, meta interf
 
 	sgps := d.Get("security_group_policy").(*schema.Set).List()
 	if len(sgps) < 1 {
-		return fmt.Errorf("Para `security_group_policy` should be set at least one.")
+		return diag.Errorf("Para `security_group_policy` should be set at least one.")
 	}
 
 	request.SecurityPolicyDatabases = make([]*vpc.SecurityPolicyDatabase, 0, len(sgps))
@@ -391,26 +397,26 @@ func resourceTencentCloudVpnConnectionCreate(d *schema.ResourceData, meta interf
 		if v, ok := d.GetOk("ike_local_address"); ok {
 			ikeOptionsSpecification.LocalAddress = helper.String(v.(string))
 		} else {
-			return fmt.Errorf("ike_local_address need to be set when ike_local_identity is `ADDRESS`.")
+			return diag.Errorf("ike_local_address need to be set when ike_local_identity is `ADDRESS`.")
 		}
 	} else {
 		if v, ok := d.GetOk("ike_local_fqdn_name"); ok {
 			ikeOptionsSpecification.LocalFqdnName = helper.String(v.(string))
 		} else {
-			return fmt.Errorf("ike_local_fqdn_name need to be set when ike_local_identity is `FQDN`")
+			return diag.Errorf("ike_local_fqdn_name need to be set when ike_local_identity is `FQDN`")
 		}
 	}
 	if *ikeOptionsSpecification.LocalIdentity == VPN_IKE_IDENTITY_ADDRESS {
 		if v, ok := d.GetOk("ike_remote_address"); ok {
 			ikeOptionsSpecification.RemoteAddress = helper.String(v.(string))
 		} else {
-			return fmt.Errorf("ike_remote_address need to be set when ike_remote_identity is `ADDRESS`.")
+			return diag.Errorf("ike_remote_address need to be set when ike_remote_identity is `ADDRESS`.")
 		}
 	} else {
 		if v, ok := d.GetOk("ike_remote_fqdn_name"); ok {
 			ikeOptionsSpecification.RemoteFqdnName = helper.String(v.(string))
 		} else {
-			return fmt.Errorf("ike_remote_fqdn_name need to be set when ike_remote_identity is `FQDN`")
+			return diag.Errorf("ike_remote_fqdn_name need to be set when ike_remote_identity is `FQDN`")
 		}
 	}
 
@@ -443,8 +449,8 @@ func resourceTencentCloudVpnConnectionCreate(d *schema.ResourceData, meta interf
 		request.HealthCheckRemoteIp = helper.String(v.(string))
 	}
 	var response *vpc.CreateVpnConnectionResponse
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateVpnConnection(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateVpnConnectionWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -455,11 +461,11 @@ func resourceTencentCloudVpnConnectionCreate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create VPN connection failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if response.Response.VpnConnection == nil {
-		return fmt.Errorf("VPN connection id is nil")
+		return diag.Errorf("VPN connection id is nil")
 	}
 
 	vpnConnectionId := ""
@@ -487,8 +493,8 @@ func resourceTencentCloudVpnConnectionCreate(d *schema.ResourceData, meta interf
 		offset := uint64(0)
 		idRequest.Offset = &offset
 
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnConnections(idRequest)
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnConnectionsWithContext(ctx, idRequest)
 
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -506,20 +512,20 @@ func resourceTencentCloudVpnConnectionCreate(d *schema.ResourceData, meta interf
 
 		if err != nil {
 			log.Printf("[CRITAL]%s create VPN connection failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if vpnConnectionId == "" {
-		return fmt.Errorf("VPN connection id is nil")
+		return diag.Errorf("VPN connection id is nil")
 	}
 
 	d.SetId(vpnConnectionId)
 	// must wait for finishing creating connection
 	statRequest := vpc.NewDescribeVpnConnectionsRequest()
 	statRequest.VpnConnectionIds = []*string{&vpnConnectionId}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnConnections(statRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnConnectionsWithContext(ctx, statRequest)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, statRequest.GetAction(), statRequest.ToJsonString(), e.Error())
@@ -539,7 +545,7 @@ func resourceTencentCloudVpnConnectionCreate(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create VPN connection failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	//modify tags
@@ -550,29 +556,29 @@ func resourceTencentCloudVpnConnectionCreate(d *schema.ResourceData, meta interf
 		resourceName := BuildTagResourceName("vpc", "vpnx", region, vpnConnectionId)
 
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudVpnConnectionRead(d, meta)
+	return resourceTencentCloudVpnConnectionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpnConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_connection.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	connectionId := d.Id()
 	request := vpc.NewDescribeVpnConnectionsRequest()
 	request.VpnConnectionIds = []*string{&connectionId}
 	var response *vpc.DescribeVpnConnectionsResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnConnections(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnConnectionsWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -588,7 +594,7 @@ func resourceTencentCloudVpnConnectionRead(d *schema.ResourceData, meta interfac
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s read VPN connection failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if response == nil || response.Response == nil || len(response.Response.VpnConnectionSet) < 1 {
 		d.SetId("")
@@ -604,10 +610,10 @@ func resourceTencentCloudVpnConnectionRead(d *schema.ResourceData, meta interfac
 	has, gateway, err := service.DescribeVpngwById(ctx, d.Get("vpn_gateway_id").(string))
 	if err != nil {
 		log.Printf("[CRITAL]%s read vpn_geteway failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
-		return fmt.Errorf("[CRITAL] vpn_gateway_id %s doesn't exist", d.Get("vpn_gateway_id").(string))
+		return diag.Errorf("[CRITAL] vpn_gateway_id %s doesn't exist", d.Get("vpn_gateway_id").(string))
 	}
 
 	if *gateway.Type != "CCN" {
@@ -664,7 +670,7 @@ func resourceTencentCloudVpnConnectionRead(d *schema.ResourceData, meta interfac
 	if *connection.DpdTimeout != "" {
 		dpdTimeoutInt, err := strconv.Atoi(*connection.DpdTimeout)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		_ = d.Set("dpd_timeout", dpdTimeoutInt)
 	}
@@ -676,18 +682,18 @@ func resourceTencentCloudVpnConnectionRead(d *schema.ResourceData, meta interfac
 	region := meta.(*TencentCloudClient).apiV3Conn.Region
 	tags, err := tagService.DescribeResourceTags(ctx, "vpc", "vpnx", region, connectionId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudVpnConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_connection.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	d.Partial(true)
 	connectionId := d.Id()
@@ -720,7 +726,7 @@ func resourceTencentCloudVpnConnectionUpdate(d *schema.ResourceData, meta interf
 	if d.HasChange("security_group_policy") {
 		sgps := d.Get("security_group_policy").(*schema.Set).List()
 		if len(sgps) < 1 {
-			return fmt.Errorf("Para `security_group_policy` should be set at least one.")
+			return diag.Errorf("Para `security_group_policy` should be set at least one.")
 		}
 		request.SecurityPolicyDatabases = make([]*vpc.SecurityPolicyDatabase, 0, len(sgps))
 		for _, v := range sgps {
@@ -789,26 +795,26 @@ func resourceTencentCloudVpnConnectionUpdate(d *schema.ResourceData, meta interf
 			if v, ok := d.GetOk("ike_local_address"); ok {
 				ikeOptionsSpecification.LocalAddress = helper.String(v.(string))
 			} else {
-				return fmt.Errorf("ike_local_address need to be set when ike_local_identity is `ADDRESS`.")
+				return diag.Errorf("ike_local_address need to be set when ike_local_identity is `ADDRESS`.")
 			}
 		} else {
 			if v, ok := d.GetOk("ike_local_fqdn_name"); ok {
 				ikeOptionsSpecification.LocalFqdnName = helper.String(v.(string))
 			} else {
-				return fmt.Errorf("ike_local_fqdn_name need to be set when ike_local_identity is `FQDN`")
+				return diag.Errorf("ike_local_fqdn_name need to be set when ike_local_identity is `FQDN`")
 			}
 		}
 		if *ikeOptionsSpecification.LocalIdentity == VPN_IKE_IDENTITY_ADDRESS {
 			if v, ok := d.GetOk("ike_remote_address"); ok {
 				ikeOptionsSpecification.RemoteAddress = helper.String(v.(string))
 			} else {
-				return fmt.Errorf("ike_remote_address need to be set when ike_remote_identity is `ADDRESS`.")
+				return diag.Errorf("ike_remote_address need to be set when ike_remote_identity is `ADDRESS`.")
 			}
 		} else {
 			if v, ok := d.GetOk("ike_remote_fqdn_name"); ok {
 				ikeOptionsSpecification.RemoteFqdnName = helper.String(v.(string))
 			} else {
-				return fmt.Errorf("ike_remote_fqdn_name need to be set when ike_remote_identity is `FQDN`")
+				return diag.Errorf("ike_remote_fqdn_name need to be set when ike_remote_identity is `FQDN`")
 			}
 		}
 
@@ -849,8 +855,8 @@ func resourceTencentCloudVpnConnectionUpdate(d *schema.ResourceData, meta interf
 		changeFlag = true
 	}
 	if changeFlag {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyVpnConnectionAttribute(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyVpnConnectionAttributeWithContext(ctx, request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -860,7 +866,7 @@ func resourceTencentCloudVpnConnectionUpdate(d *schema.ResourceData, meta interf
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s modify VPN connection failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	time.Sleep(3 * time.Minute)
@@ -876,15 +882,15 @@ func resourceTencentCloudVpnConnectionUpdate(d *schema.ResourceData, meta interf
 		resourceName := BuildTagResourceName("vpc", "vpnx", region, connectionId)
 		err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	d.Partial(false)
 
-	return resourceTencentCloudVpnConnectionRead(d, meta)
+	return resourceTencentCloudVpnConnectionRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpnConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_connection.delete")()
 
 	logId := getLogId(contextNil)
@@ -895,8 +901,8 @@ func resourceTencentCloudVpnConnectionDelete(d *schema.ResourceData, meta interf
 	request := vpc.NewDeleteVpnConnectionRequest()
 	request.VpnConnectionId = &connectionId
 	request.VpnGatewayId = &vpnGatewayId
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DeleteVpnConnection(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DeleteVpnConnectionWithContext(ctx, request)
 		if e != nil {
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 				if ee.GetCode() == "UnsupportedOperation.InvalidState" {
@@ -911,13 +917,13 @@ func resourceTencentCloudVpnConnectionDelete(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete VPN connection failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	//to get the status of vpn connection
 	statRequest := vpc.NewDescribeVpnConnectionsRequest()
 	statRequest.VpnConnectionIds = []*string{&connectionId}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnConnections(statRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnConnectionsWithContext(ctx, statRequest)
 		if e != nil {
 			ee, ok := e.(*sdkErrors.TencentCloudSDKError)
 			if !ok {
@@ -943,7 +949,7 @@ func resourceTencentCloudVpnConnectionDelete(d *schema.ResourceData, meta interf
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete VPN connection failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -13,8 +13,11 @@ resource "tencentcloud_vpn_connection_reset" "vpn_connection_reset" {
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -23,9 +26,12 @@ import (
 
 func resourceTencentCloudVpnConnectionReset() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpnConnectionResetCreate,
-		Read:   resourceTencentCloudVpnConnectionResetRead,
-		Delete: resourceTencentCloudVpnConnectionResetDelete,
+		CreateContext: resourceTencentCloudVpnConnectionResetCreate,
+		ReadContext:   resourceTencentCloudVpnConnectionResetRead,
+		DeleteContext: resourceTencentCloudVpnConnectionResetDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"vpn_gateway_id": {
 				Required:    true,
@@ -44,7 +50,7 @@ func resourceTencentCloudVpnConnectionReset() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpnConnectionResetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnConnectionResetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpn_connection_reset.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -65,8 +71,8 @@ func resourceTencentCloudVpnConnectionResetCreate(d *schema.ResourceData, meta i
 		request.VpnConnectionId = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ResetVpnConnection(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ResetVpnConnectionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -79,19 +85,19 @@ func resourceTencentCloudVpnConnectionResetCreate(d *schema.ResourceData, meta i
 		return nil
 	}
 
-	d.SetId(vpnGatewayId + FILED_SP + vpnConnectionId)
+	d.SetId(helper.IdFormat(vpnGatewayId, vpnConnectionId))
 
-	return resourceTencentCloudVpnConnectionResetRead(d, meta)
+	return resourceTencentCloudVpnConnectionResetRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpnConnectionResetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnConnectionResetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_connection_reset.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudVpnConnectionResetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnConnectionResetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_connection_reset.delete")()
 	defer inconsistentCheck(d, meta)()
 
@@ -19,8 +19,11 @@ resource "tencentcloud_vpn_customer_gateway_configuration_download" "vpn_custome
 package tencentcloud
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -29,11 +32,14 @@ import (
 
 func resourceTencentCloudVpnCustomerGatewayConfigurationDownload() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpnCustomerGatewayConfigurationDownloadCreate,
-		Read:   resourceTencentCloudVpnCustomerGatewayConfigurationDownloadRead,
-		Delete: resourceTencentCloudVpnCustomerGatewayConfigurationDownloadDelete,
+		CreateContext: resourceTencentCloudVpnCustomerGatewayConfigurationDownloadCreate,
+		ReadContext:   resourceTencentCloudVpnCustomerGatewayConfigurationDownloadRead,
+		DeleteContext: resourceTencentCloudVpnCustomerGatewayConfigurationDownloadDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"vpn_gateway_id": {
@@ -93,7 +99,7 @@ func resourceTencentCloudVpnCustomerGatewayConfigurationDownload() *schema.Resou
 	}
 }
 
-func resourceTencentCloudVpnCustomerGatewayConfigurationDownloadCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnCustomerGatewayConfigurationDownloadCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("data_source.tencentcloud_vpn_customer_gateway_configuration_download.read")()
 	defer inconsistentCheck(d, meta)()
 
@@ -133,8 +139,8 @@ func resourceTencentCloudVpnCustomerGatewayConfigurationDownloadCreate(d *schema
 		request.InterfaceName = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DownloadCustomerGatewayConfiguration(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DownloadCustomerGatewayConfigurationWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -148,21 +154,21 @@ func resourceTencentCloudVpnCustomerGatewayConfigurationDownloadCreate(d *schema
 		return nil
 	}
 
-	d.SetId(vpnGatewayId + FILED_SP + vpnConnectionId)
+	d.SetId(helper.IdFormat(vpnGatewayId, vpnConnectionId))
 
 	_ = d.Set("customer_gateway_configuration", response.Response.CustomerGatewayConfiguration)
 
-	return resourceTencentCloudVpnCustomerGatewayConfigurationDownloadRead(d, meta)
+	return resourceTencentCloudVpnCustomerGatewayConfigurationDownloadRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpnCustomerGatewayConfigurationDownloadRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnCustomerGatewayConfigurationDownloadRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_customer_gateway_configuration_download.read")()
 	defer inconsistentCheck(d, meta)()
 
 	return nil
 }
 
-func resourceTencentCloudVpnCustomerGatewayConfigurationDownloadDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnCustomerGatewayConfigurationDownloadDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_customer_gateway_configuration_download.delete")()
 	defer inconsistentCheck(d, meta)()
 
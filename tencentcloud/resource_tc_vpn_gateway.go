@@ -94,6 +94,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -103,12 +104,17 @@ import (
 
 func resourceTencentCloudVpnGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpnGatewayCreate,
-		Read:   resourceTencentCloudVpnGatewayRead,
-		Update: resourceTencentCloudVpnGatewayUpdate,
-		Delete: resourceTencentCloudVpnGatewayDelete,
+		CreateContext: resourceTencentCloudVpnGatewayCreate,
+		ReadContext:   resourceTencentCloudVpnGatewayRead,
+		UpdateContext: resourceTencentCloudVpnGatewayUpdate,
+		DeleteContext: resourceTencentCloudVpnGatewayDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -224,11 +230,11 @@ func resourceTencentCloudVpnGateway() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpnGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_gateway.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	request := vpc.NewCreateVpnGatewayRequest()
 	request.VpnGatewayName = helper.String(d.Get("name").(string))
@@ -253,18 +259,18 @@ func resourceTencentCloudVpnGatewayCreate(d *schema.ResourceData, meta interface
 		request.Type = helper.String(v.(string))
 		if v.(string) != "CCN" && v.(string) != "SSL_CCN" {
 			if _, ok := d.GetOk("vpc_id"); !ok {
-				return fmt.Errorf("[CRITAL] vpc_id is required for vpn gateway in %s type", v.(string))
+				return diag.Errorf("[CRITAL] vpc_id is required for vpn gateway in %s type", v.(string))
 			}
 			request.VpcId = helper.String(d.Get("vpc_id").(string))
 		} else {
 			if _, ok := d.GetOk("vpc_id"); ok {
-				return fmt.Errorf("[CRITAL] vpc_id doesn't make sense when vpn gateway is in CCN type")
+				return diag.Errorf("[CRITAL] vpc_id doesn't make sense when vpn gateway is in CCN type")
 			}
 			request.VpcId = helper.String("")
 		}
 	} else {
 		if _, ok := d.GetOk("vpc_id"); !ok {
-			return fmt.Errorf("[CRITAL] vpc_id is required for vpn gateway in %s type", "IPSEC")
+			return diag.Errorf("[CRITAL] vpc_id is required for vpn gateway in %s type", "IPSEC")
 		}
 		request.VpcId = helper.String(d.Get("vpc_id").(string))
 	}
@@ -278,8 +284,8 @@ func resourceTencentCloudVpnGatewayCreate(d *schema.ResourceData, meta interface
 	}
 
 	var response *vpc.CreateVpnGatewayResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateVpnGateway(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().CreateVpnGatewayWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -290,11 +296,11 @@ func resourceTencentCloudVpnGatewayCreate(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create VPN gateway failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if response.Response.VpnGateway == nil {
-		return fmt.Errorf("VPN gateway id is nil")
+		return diag.Errorf("VPN gateway id is nil")
 	}
 	gatewayId := *response.Response.VpnGateway.VpnGatewayId
 	d.SetId(gatewayId)
@@ -302,8 +308,8 @@ func resourceTencentCloudVpnGatewayCreate(d *schema.ResourceData, meta interface
 	// must wait for creating gateway finished
 	statRequest := vpc.NewDescribeVpnGatewaysRequest()
 	statRequest.VpnGatewayIds = []*string{helper.String(gatewayId)}
-	err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnGateways(statRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnGatewaysWithContext(ctx, statRequest)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, statRequest.GetAction(), statRequest.ToJsonString(), e.Error())
@@ -323,7 +329,7 @@ func resourceTencentCloudVpnGatewayCreate(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create VPN gateway failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	//modify tags
@@ -334,28 +340,28 @@ func resourceTencentCloudVpnGatewayCreate(d *schema.ResourceData, meta interface
 		resourceName := BuildTagResourceName("vpc", "vpngw", region, gatewayId)
 
 		if err := tagService.ModifyTags(ctx, resourceName, tags, nil); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudVpnGatewayRead(d, meta)
+	return resourceTencentCloudVpnGatewayRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_gateway.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId     = getLogId(contextNil)
-		ctx       = context.WithValue(context.TODO(), logIdKey, logId)
 		service   = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		gatewayId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	has, gateway, err := service.DescribeVpngwById(ctx, gatewayId)
 	if err != nil {
 		log.Printf("[CRITAL]%s read VPN gateway failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	if !has {
 		d.SetId("")
@@ -382,18 +388,18 @@ func resourceTencentCloudVpnGatewayRead(d *schema.ResourceData, meta interface{}
 	region := meta.(*TencentCloudClient).apiV3Conn.Region
 	tags, err := tagService.DescribeResourceTags(ctx, "vpc", "vpngw", region, gatewayId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	_ = d.Set("tags", tags)
 
 	return nil
 }
 
-func resourceTencentCloudVpnGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_gateway.update")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	d.Partial(true)
 	gatewayId := d.Id()
@@ -403,7 +409,7 @@ func resourceTencentCloudVpnGatewayUpdate(d *schema.ResourceData, meta interface
 	}
 	for _, field := range unsupportedUpdateFields {
 		if d.HasChange(field) {
-			return fmt.Errorf("Template resource_tc_vpn_gateway update on %s is not supportted yet. Please renew it on controller web page.", field)
+			return diag.Errorf("Template resource_tc_vpn_gateway update on %s is not supportted yet. Please renew it on controller web page.", field)
 		}
 	}
 
@@ -411,7 +417,7 @@ func resourceTencentCloudVpnGatewayUpdate(d *schema.ResourceData, meta interface
 		chargeType := d.Get("charge_type").(string)
 		period := d.Get("prepaid_period").(int)
 		if chargeType != VPN_CHARGE_TYPE_PREPAID {
-			return fmt.Errorf("Invalid renew flag change. Only support pre-paid vpn.")
+			return diag.Errorf("Invalid renew flag change. Only support pre-paid vpn.")
 		}
 		request := vpc.NewRenewVpnGatewayRequest()
 		request.VpnGatewayId = &gatewayId
@@ -419,8 +425,8 @@ func resourceTencentCloudVpnGatewayUpdate(d *schema.ResourceData, meta interface
 		preChargePara.Period = helper.IntUint64(period)
 		request.InstanceChargePrepaid = &preChargePara
 
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().RenewVpnGateway(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().RenewVpnGatewayWithContext(ctx, request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -430,7 +436,7 @@ func resourceTencentCloudVpnGatewayUpdate(d *schema.ResourceData, meta interface
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s modify VPN gateway prepaid period failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -438,7 +444,7 @@ func resourceTencentCloudVpnGatewayUpdate(d *schema.ResourceData, meta interface
 		chargeType := d.Get("charge_type").(string)
 		renewFlag := d.Get("prepaid_renew_flag").(string)
 		if chargeType != VPN_CHARGE_TYPE_PREPAID {
-			return fmt.Errorf("Invalid renew flag change. Only support pre-paid vpn.")
+			return diag.Errorf("Invalid renew flag change. Only support pre-paid vpn.")
 		}
 		request := vpc.NewSetVpnGatewaysRenewFlagRequest()
 		request.VpnGatewayIds = []*string{&gatewayId}
@@ -448,8 +454,8 @@ func resourceTencentCloudVpnGatewayUpdate(d *schema.ResourceData, meta interface
 			request.AutoRenewFlag = helper.IntInt64(0)
 		}
 
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().SetVpnGatewaysRenewFlag(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().SetVpnGatewaysRenewFlagWithContext(ctx, request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -459,7 +465,7 @@ func resourceTencentCloudVpnGatewayUpdate(d *schema.ResourceData, meta interface
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s modify VPN gateway renewflag failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -475,10 +481,10 @@ func resourceTencentCloudVpnGatewayUpdate(d *schema.ResourceData, meta interface
 		if oldChargeType == VPN_CHARGE_TYPE_PREPAID && newChargeType == VPN_CHARGE_TYPE_POSTPAID_BY_HOUR {
 			request.InstanceChargeType = &newChargeType
 		} else if oldChargeType == VPN_CHARGE_TYPE_POSTPAID_BY_HOUR && newChargeType == VPN_CHARGE_TYPE_PREPAID {
-			return fmt.Errorf("Invalid charge type change. Only support pre-paid to post-paid way.")
+			return diag.Errorf("Invalid charge type change. Only support pre-paid to post-paid way.")
 		}
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyVpnGatewayAttribute(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyVpnGatewayAttributeWithContext(ctx, request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -488,7 +494,7 @@ func resourceTencentCloudVpnGatewayUpdate(d *schema.ResourceData, meta interface
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s modify VPN gateway name failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -499,8 +505,8 @@ func resourceTencentCloudVpnGatewayUpdate(d *schema.ResourceData, meta interface
 		bandwidth := d.Get("bandwidth").(int)
 		bandwidth64 := uint64(bandwidth)
 		request.InternetMaxBandwidthOut = &bandwidth64
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ResetVpnGatewayInternetMaxBandwidth(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ResetVpnGatewayInternetMaxBandwidthWithContext(ctx, request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -510,7 +516,7 @@ func resourceTencentCloudVpnGatewayUpdate(d *schema.ResourceData, meta interface
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s modify VPN gateway bandwidth failed, reason:%s\n", logId, err.Error())
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
@@ -526,21 +532,21 @@ func resourceTencentCloudVpnGatewayUpdate(d *schema.ResourceData, meta interface
 		resourceName := BuildTagResourceName("vpc", "vpngw", region, gatewayId)
 		err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	if d.HasChange("cdc_id") || d.HasChange("max_connection") {
-		return fmt.Errorf("cdc_id and max_connection do not support change now.")
+		return diag.Errorf("cdc_id and max_connection do not support change now.")
 	}
 
 	d.Partial(false)
 
-	return resourceTencentCloudVpnGatewayRead(d, meta)
+	return resourceTencentCloudVpnGatewayRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpnGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_gateway.delete")()
 
 	logId := getLogId(contextNil)
@@ -553,8 +559,8 @@ func resourceTencentCloudVpnGatewayDelete(d *schema.ResourceData, meta interface
 	//to get the type and networkinstanceid of gateway
 	vpngwRequest := vpc.NewDescribeVpnGatewaysRequest()
 	vpngwRequest.VpnGatewayIds = []*string{&gatewayId}
-	vpngwErr := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnGateways(vpngwRequest)
+	vpngwErr := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnGatewaysWithContext(ctx, vpngwRequest)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -582,7 +588,7 @@ func resourceTencentCloudVpnGatewayDelete(d *schema.ResourceData, meta interface
 	})
 	if vpngwErr != nil {
 		log.Printf("[CRITAL]%s describe VPN gateway failed, reason:%s\n", logId, vpngwErr.Error())
-		return vpngwErr
+		return diag.FromErr(vpngwErr)
 	}
 
 	//check the vpn gateway is not related with any tunnel
@@ -605,8 +611,8 @@ func resourceTencentCloudVpnGatewayDelete(d *schema.ResourceData, meta interface
 	offset := uint64(0)
 	tRequest.Offset = &offset
 
-	tErr := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnConnections(tRequest)
+	tErr := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnConnectionsWithContext(ctx, tRequest)
 
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -622,14 +628,14 @@ func resourceTencentCloudVpnGatewayDelete(d *schema.ResourceData, meta interface
 	})
 	if tErr != nil {
 		log.Printf("[CRITAL]%s describe VPN connection failed, reason:%s\n", logId, tErr.Error())
-		return tErr
+		return diag.FromErr(tErr)
 	}
 
 	request := vpc.NewDeleteVpnGatewayRequest()
 	request.VpnGatewayId = &gatewayId
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DeleteVpnGateway(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DeleteVpnGatewayWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -639,13 +645,13 @@ func resourceTencentCloudVpnGatewayDelete(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete VPN gateway failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	//to get the status of gateway
 	statRequest := vpc.NewDescribeVpnGatewaysRequest()
 	statRequest.VpnGatewayIds = []*string{&gatewayId}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnGateways(statRequest)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DescribeVpnGatewaysWithContext(ctx, statRequest)
 		if e != nil {
 			ee, ok := e.(*errors.TencentCloudSDKError)
 			if !ok {
@@ -671,7 +677,7 @@ func resourceTencentCloudVpnGatewayDelete(d *schema.ResourceData, meta interface
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete VPN gateway failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -25,10 +25,10 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -37,12 +37,15 @@ import (
 
 func resourceTencentCloudVpnGatewayCcnRoutes() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpnGatewayCcnRoutesCreate,
-		Read:   resourceTencentCloudVpnGatewayCcnRoutesRead,
-		Update: resourceTencentCloudVpnGatewayCcnRoutesUpdate,
-		Delete: resourceTencentCloudVpnGatewayCcnRoutesDelete,
+		CreateContext: resourceTencentCloudVpnGatewayCcnRoutesCreate,
+		ReadContext:   resourceTencentCloudVpnGatewayCcnRoutesRead,
+		UpdateContext: resourceTencentCloudVpnGatewayCcnRoutesUpdate,
+		DeleteContext: resourceTencentCloudVpnGatewayCcnRoutesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"vpn_gateway_id": {
@@ -72,7 +75,7 @@ func resourceTencentCloudVpnGatewayCcnRoutes() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpnGatewayCcnRoutesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnGatewayCcnRoutesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_gateway_ccn_routes.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -89,31 +92,31 @@ func resourceTencentCloudVpnGatewayCcnRoutesCreate(d *schema.ResourceData, meta
 		routeId = v.(string)
 	}
 
-	d.SetId(vpnGwId + FILED_SP + routeId)
+	d.SetId(helper.IdFormat(vpnGwId, routeId))
 
-	return resourceTencentCloudVpnGatewayCcnRoutesUpdate(d, meta)
+	return resourceTencentCloudVpnGatewayCcnRoutesUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudVpnGatewayCcnRoutesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnGatewayCcnRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_gateway_ccn_routes.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	vpnGatewayId := idSplit[0]
 	routeId := idSplit[1]
 
 	vpnGatewayCcnRoutes, err := service.DescribeVpcVpnGatewayCcnRoutesById(ctx, vpnGatewayId, routeId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if vpnGatewayCcnRoutes == nil {
@@ -130,7 +133,7 @@ func resourceTencentCloudVpnGatewayCcnRoutesRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceTencentCloudVpnGatewayCcnRoutesUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnGatewayCcnRoutesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_gateway_ccn_routes.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -138,9 +141,9 @@ func resourceTencentCloudVpnGatewayCcnRoutesUpdate(d *schema.ResourceData, meta
 
 	request := vpc.NewModifyVpnGatewayCcnRoutesRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+		return diag.Errorf("id is broken,%s", d.Id())
 	}
 	vpnGatewayId := idSplit[0]
 	routeId := idSplit[1]
@@ -152,8 +155,8 @@ func resourceTencentCloudVpnGatewayCcnRoutesUpdate(d *schema.ResourceData, meta
 	route.DestinationCidrBlock = helper.String(d.Get("destination_cidr_block").(string))
 	request.Routes = append(request.Routes, &route)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyVpnGatewayCcnRoutes(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyVpnGatewayCcnRoutesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -163,13 +166,13 @@ func resourceTencentCloudVpnGatewayCcnRoutesUpdate(d *schema.ResourceData, meta
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s update vpnGatewayCcnRoutes failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudVpnGatewayCcnRoutesRead(d, meta)
+	return resourceTencentCloudVpnGatewayCcnRoutesRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpnGatewayCcnRoutesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnGatewayCcnRoutesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_gateway_ccn_routes.delete")()
 	defer inconsistentCheck(d, meta)()
 
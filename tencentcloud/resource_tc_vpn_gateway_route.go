@@ -27,9 +27,9 @@ package tencentcloud
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -37,12 +37,12 @@ import (
 
 func resourceTencentCloudVpnGatewayRoute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpnGatewayRouteCreate,
-		Read:   resourceTencentCloudVpnGatewayRouteRead,
-		Update: resourceTencentCloudVpnGatewayRouteUpdate,
-		Delete: resourceTencentCloudVpnGatewayRouteDelete,
+		CreateContext: resourceTencentCloudVpnGatewayRouteCreate,
+		ReadContext:   resourceTencentCloudVpnGatewayRouteRead,
+		UpdateContext: resourceTencentCloudVpnGatewayRouteUpdate,
+		DeleteContext: resourceTencentCloudVpnGatewayRouteDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: VpnGatewayRoutePara(),
@@ -109,11 +109,11 @@ func VpnGatewayRoutePara() map[string]*schema.Schema {
 	}
 }
 
-func resourceTencentCloudVpnGatewayRouteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnGatewayRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_gateway_route.create")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	vpnGatewayId := d.Get("vpn_gateway_id").(string)
 	priority := int64(d.Get("priority").(int))
@@ -132,37 +132,37 @@ func resourceTencentCloudVpnGatewayRouteCreate(d *schema.ResourceData, meta inte
 	err, routeList := vpcService.CreateVpnGatewayRoute(ctx, vpnGatewayId, []*vpc.VpnGatewayRoute{route})
 	if err != nil {
 		log.Printf("[CRITAL]%s create VPN gateway route failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(routeList) == 0 {
-		return fmt.Errorf("VPN gateway route id is nil")
+		return diag.Errorf("VPN gateway route id is nil")
 	}
 	d.SetId(helper.IdFormat(vpnGatewayId, *(routeList[0].RouteId)))
 
 	//setRouteInfo(d, vpnGatewayId, route)
-	return resourceTencentCloudVpnGatewayRouteRead(d, meta)
+	return resourceTencentCloudVpnGatewayRouteRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpnGatewayRouteRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnGatewayRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_gateway_route.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id      = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	compositeId := helper.IdParse(id)
 	if len(compositeId) != 2 {
-		return errors.New("the id format must be '{vpn_gateway_id}#{route_id}'")
+		return diag.FromErr(errors.New("the id format must be '{vpn_gateway_id}#{route_id}'"))
 	}
 
 	err, routeList := service.DescribeVpnGatewayRoutes(ctx, compositeId[0], nil)
 	if err != nil {
 		log.Printf("[CRITAL]%s read VPN gateway routes failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	var route *vpc.VpnGatewayRoute
 	for _, r := range routeList {
@@ -179,18 +179,18 @@ func resourceTencentCloudVpnGatewayRouteRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudVpnGatewayRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnGatewayRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_gateway_route.update")()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id      = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	compositeId := helper.IdParse(id)
 	if len(compositeId) != 2 {
-		return errors.New("the id format must be '{vpn_gateway_id}#{route_id}'")
+		return diag.FromErr(errors.New("the id format must be '{vpn_gateway_id}#{route_id}'"))
 	}
 
 	if !d.HasChange("status") {
@@ -202,31 +202,31 @@ func resourceTencentCloudVpnGatewayRouteUpdate(d *schema.ResourceData, meta inte
 	err, route := service.ModifyVpnGatewayRoute(ctx, compositeId[0], compositeId[1], status)
 	if err != nil {
 		log.Printf("[CRITAL]%s modify VPN gateway route failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 
 	setRouteInfo(d, compositeId[0], route)
 	return nil
 }
 
-func resourceTencentCloudVpnGatewayRouteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnGatewayRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_gateway_route.delete")()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id      = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	compositeId := helper.IdParse(id)
 	if len(compositeId) != 2 {
-		return errors.New("the id format must be '{vpn_gateway_id}#{route_id}'")
+		return diag.FromErr(errors.New("the id format must be '{vpn_gateway_id}#{route_id}'"))
 	}
 
 	err := service.DeleteVpnGatewayRoutes(ctx, compositeId[0], []*string{&compositeId[1]})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete VPN gateway routes failed, reason:%s\n", logId, err.Error())
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -4,12 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func TestAccTencentCloudVpnGatewayRoute_basic(t *testing.T) {
@@ -56,7 +56,7 @@ func testAccCheckVpnGatewayRouteDestroy(s *terraform.State) error {
 		if rs.Type != "tencentcloud_vpn_gateway_route" {
 			continue
 		}
-		ids := strings.Split(rs.Primary.ID, FILED_SP)
+		ids := helper.IdParse(rs.Primary.ID)
 		err, result := vpcService.DescribeVpnGatewayRoutes(ctx, ids[0], nil)
 		if err != nil {
 			log.Printf("[CRITAL]%s read VPN gateway route failed, reason:%s\n", logId, err.Error())
@@ -91,7 +91,7 @@ func testAccCheckVpnGatewayRouteExists(n string) resource.TestCheckFunc {
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("VPN gateway route id is not set")
 		}
-		ids := strings.Split(rs.Primary.ID, FILED_SP)
+		ids := helper.IdParse(rs.Primary.ID)
 		err, result := vpcService.DescribeVpnGatewayRoutes(ctx, ids[0], nil)
 		if err != nil {
 			log.Printf("[CRITAL]%s read VPN gateway failed, reason:%s\n", logId, err.Error())
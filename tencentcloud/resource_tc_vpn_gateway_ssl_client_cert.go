@@ -23,7 +23,9 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
@@ -32,12 +34,15 @@ import (
 
 func resourceTencentCloudVpnGatewaySslClientCert() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpnGatewaySslClientCertCreate,
-		Read:   resourceTencentCloudVpnGatewaySslClientCertRead,
-		Update: resourceTencentCloudVpnGatewaySslClientCertUpdate,
-		Delete: resourceTencentCloudVpnGatewaySslClientCertDelete,
+		CreateContext: resourceTencentCloudVpnGatewaySslClientCertCreate,
+		ReadContext:   resourceTencentCloudVpnGatewaySslClientCertRead,
+		UpdateContext: resourceTencentCloudVpnGatewaySslClientCertUpdate,
+		DeleteContext: resourceTencentCloudVpnGatewaySslClientCertDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"ssl_vpn_client_id": {
@@ -57,23 +62,23 @@ func resourceTencentCloudVpnGatewaySslClientCert() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpnGatewaySslClientCertCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnGatewaySslClientCertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_gateway_ssl_client_cert.create")()
 	defer inconsistentCheck(d, meta)()
 
 	sslVpnClientId := d.Get("ssl_vpn_client_id").(string)
 	d.SetId(sslVpnClientId)
 
-	return resourceTencentCloudVpnGatewaySslClientCertUpdate(d, meta)
+	return resourceTencentCloudVpnGatewaySslClientCertUpdate(ctx, d, meta)
 }
 
-func resourceTencentCloudVpnGatewaySslClientCertRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnGatewaySslClientCertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_gateway_ssl_client_cert.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -81,7 +86,7 @@ func resourceTencentCloudVpnGatewaySslClientCertRead(d *schema.ResourceData, met
 
 	_, vpnGatewaySslClientCert, err := service.DescribeVpnSslClientById(ctx, sslVpnClientId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if vpnGatewaySslClientCert == nil {
@@ -106,7 +111,7 @@ func resourceTencentCloudVpnGatewaySslClientCertRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceTencentCloudVpnGatewaySslClientCertUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnGatewaySslClientCertUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_gateway_ssl_client_cert.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -126,8 +131,8 @@ func resourceTencentCloudVpnGatewaySslClientCertUpdate(d *schema.ResourceData, m
 
 		request.SslVpnClientId = &sslVpnClientId
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().EnableVpnGatewaySslClientCert(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().EnableVpnGatewaySslClientCertWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -138,7 +143,7 @@ func resourceTencentCloudVpnGatewaySslClientCertUpdate(d *schema.ResourceData, m
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s enable vpc vpnGatewaySslClientCert failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 
 	} else {
@@ -149,8 +154,8 @@ func resourceTencentCloudVpnGatewaySslClientCertUpdate(d *schema.ResourceData, m
 
 		request.SslVpnClientId = &sslVpnClientId
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DisableVpnGatewaySslClientCert(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().DisableVpnGatewaySslClientCertWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -161,22 +166,22 @@ func resourceTencentCloudVpnGatewaySslClientCertUpdate(d *schema.ResourceData, m
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s disable vpc vpnGatewaySslClientCert failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	err := service.DescribeVpcTaskResult(ctx, helper.String(helper.UInt64ToStr(*taskId)))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTencentCloudVpnGatewaySslClientCertRead(d, meta)
+	return resourceTencentCloudVpnGatewaySslClientCertRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpnGatewaySslClientCertDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnGatewaySslClientCertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_gateway_ssl_client_cert.delete")()
 	defer inconsistentCheck(d, meta)()
 
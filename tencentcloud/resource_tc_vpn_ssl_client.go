@@ -24,22 +24,27 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTencentCloudVpnSslClient() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpnSslClientCreate,
-		Read:   resourceTencentCloudVpnSslClientRead,
-		Delete: resourceTencentCloudVpnSslClientDelete,
+		CreateContext: resourceTencentCloudVpnSslClientCreate,
+		ReadContext:   resourceTencentCloudVpnSslClientRead,
+		DeleteContext: resourceTencentCloudVpnSslClientDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -59,10 +64,10 @@ func resourceTencentCloudVpnSslClient() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpnSslClientCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnSslClientCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_ssl_client.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		vpcService       = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -84,9 +89,11 @@ func resourceTencentCloudVpnSslClientCreate(d *schema.ResourceData, meta interfa
 		taskId      *uint64
 		sslClientId *string
 	)
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := vpcService.client.UseVpcClient().CreateVpnGatewaySslClient(request)
+	if err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := vpcService.client.UseVpcClient().CreateVpnGatewaySslClientWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -98,30 +105,30 @@ func resourceTencentCloudVpnSslClientCreate(d *schema.ResourceData, meta interfa
 		sslClientId = response.Response.SslVpnClientId
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err := vpcService.DescribeVpcTaskResult(ctx, helper.String(helper.UInt64ToStr(*taskId)))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*sslClientId)
 
-	return resourceTencentCloudVpnSslClientRead(d, meta)
+	return resourceTencentCloudVpnSslClientRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpnSslClientRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnSslClientRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_ssl_client.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	sslClientId := d.Id()
 	vpcService := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		has, info, e := vpcService.DescribeVpnSslClientById(ctx, sslClientId)
 		if e != nil {
 			return retryError(e)
@@ -136,16 +143,16 @@ func resourceTencentCloudVpnSslClientRead(d *schema.ResourceData, meta interface
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudVpnSslClientDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnSslClientDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_ssl_client.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -153,13 +160,13 @@ func resourceTencentCloudVpnSslClientDelete(d *schema.ResourceData, meta interfa
 
 	taskId, err := service.DeleteVpnGatewaySslClient(ctx, sslClientId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = service.DescribeVpcTaskResult(ctx, helper.String(helper.UInt64ToStr(*taskId)))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return err
+	return diag.FromErr(err)
 }
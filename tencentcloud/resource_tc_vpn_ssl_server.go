@@ -32,22 +32,27 @@ package tencentcloud
 import (
 	"context"
 	"log"
+	"time"
 
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTencentCloudVpnSslServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpnSslServerCreate,
-		Read:   resourceTencentCloudVpnSslServerRead,
-		Delete: resourceTencentCloudVpnSslServerDelete,
+		CreateContext: resourceTencentCloudVpnSslServerCreate,
+		ReadContext:   resourceTencentCloudVpnSslServerRead,
+		DeleteContext: resourceTencentCloudVpnSslServerDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -114,10 +119,10 @@ func resourceTencentCloudVpnSslServer() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudVpnSslServerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnSslServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_ssl_server.create")()
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	var (
 		vpcService   = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
@@ -162,9 +167,11 @@ func resourceTencentCloudVpnSslServerCreate(d *schema.ResourceData, meta interfa
 		taskId      *int64
 		sslServerId *string
 	)
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := vpcService.client.UseVpcClient().CreateVpnGatewaySslServer(request)
+	if err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := vpcService.client.UseVpcClient().CreateVpnGatewaySslServerWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -176,30 +183,30 @@ func resourceTencentCloudVpnSslServerCreate(d *schema.ResourceData, meta interfa
 		sslServerId = response.Response.SslVpnServerId
 		return nil
 	}); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err := vpcService.DescribeVpcTaskResult(ctx, helper.String(helper.Int64ToStr(*taskId)))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(*sslServerId)
 
-	return resourceTencentCloudVpnSslServerRead(d, meta)
+	return resourceTencentCloudVpnSslServerRead(ctx, d, meta)
 }
 
-func resourceTencentCloudVpnSslServerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnSslServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_ssl_server.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	sslServerId := d.Id()
 	vpcService := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		has, info, e := vpcService.DescribeVpnSslServerById(ctx, sslServerId)
 		if e != nil {
 			return retryError(e)
@@ -235,16 +242,16 @@ func resourceTencentCloudVpnSslServerRead(d *schema.ResourceData, meta interface
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceTencentCloudVpnSslServerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudVpnSslServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_vpn_ssl_server.delete")()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
@@ -252,12 +259,12 @@ func resourceTencentCloudVpnSslServerDelete(d *schema.ResourceData, meta interfa
 
 	taskId, err := service.DeleteVpnGatewaySslServer(ctx, serverId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = service.DescribeVpcTaskResult(ctx, helper.String(helper.UInt64ToStr(taskId)))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
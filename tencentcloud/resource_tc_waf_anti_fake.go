@@ -26,11 +26,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
@@ -40,12 +40,16 @@ import (
 
 func resourceTencentCloudWafAntiFake() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafAntiFakeCreate,
-		Read:   resourceTencentCloudWafAntiFakeRead,
-		Update: resourceTencentCloudWafAntiFakeUpdate,
-		Delete: resourceTencentCloudWafAntiFakeDelete,
+		CreateContext: resourceTencentCloudWafAntiFakeCreate,
+		ReadContext:   resourceTencentCloudWafAntiFakeRead,
+		UpdateContext: resourceTencentCloudWafAntiFakeUpdate,
+		DeleteContext: resourceTencentCloudWafAntiFakeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"domain": {
@@ -84,7 +88,7 @@ func resourceTencentCloudWafAntiFake() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudWafAntiFakeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafAntiFakeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_anti_fake.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -109,8 +113,8 @@ func resourceTencentCloudWafAntiFakeCreate(d *schema.ResourceData, meta interfac
 		request.Uri = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().AddAntiFakeUrl(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().AddAntiFakeUrlWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -123,11 +127,11 @@ func resourceTencentCloudWafAntiFakeCreate(d *schema.ResourceData, meta interfac
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create waf antiFake failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	id = *response.Response.Id
-	d.SetId(strings.Join([]string{id, domain}, FILED_SP))
+	d.SetId(helper.IdFormat(id, domain))
 
 	// set status
 	if v, ok := d.GetOkExists("status"); ok {
@@ -138,8 +142,8 @@ func resourceTencentCloudWafAntiFakeCreate(d *schema.ResourceData, meta interfac
 			modifyAntiFakeUrlStatusRequest.Ids = common.Uint64Ptrs([]uint64{idUInt})
 			modifyAntiFakeUrlStatusRequest.Domain = &domain
 			modifyAntiFakeUrlStatusRequest.Status = helper.IntUint64(v.(int))
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyAntiFakeUrlStatus(modifyAntiFakeUrlStatusRequest)
+			err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyAntiFakeUrlStatusWithContext(ctx, modifyAntiFakeUrlStatusRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -150,34 +154,34 @@ func resourceTencentCloudWafAntiFakeCreate(d *schema.ResourceData, meta interfac
 
 			if err != nil {
 				log.Printf("[CRITAL]%s update waf antiFake status failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return resourceTencentCloudWafAntiFakeRead(d, meta)
+	return resourceTencentCloudWafAntiFakeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudWafAntiFakeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafAntiFakeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_anti_fake.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	id := idSplit[0]
 	domain := idSplit[1]
 
 	antiFake, err := service.DescribeWafAntiFakeById(ctx, id, domain)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if antiFake == nil {
@@ -213,7 +217,7 @@ func resourceTencentCloudWafAntiFakeRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTencentCloudWafAntiFakeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafAntiFakeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_anti_fake.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -223,9 +227,9 @@ func resourceTencentCloudWafAntiFakeUpdate(d *schema.ResourceData, meta interfac
 		modifyAntiFakeUrlStatusRequest = waf.NewModifyAntiFakeUrlStatusRequest()
 	)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+		return diag.Errorf("id is broken,%s", idSplit)
 	}
 	id := idSplit[0]
 	domain := idSplit[1]
@@ -234,7 +238,7 @@ func resourceTencentCloudWafAntiFakeUpdate(d *schema.ResourceData, meta interfac
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -250,8 +254,8 @@ func resourceTencentCloudWafAntiFakeUpdate(d *schema.ResourceData, meta interfac
 		modifyAntiFakeUrlRequest.Uri = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyAntiFakeUrl(modifyAntiFakeUrlRequest)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyAntiFakeUrlWithContext(ctx, modifyAntiFakeUrlRequest)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -262,7 +266,7 @@ func resourceTencentCloudWafAntiFakeUpdate(d *schema.ResourceData, meta interfac
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update waf antiFake failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("status") {
@@ -273,8 +277,8 @@ func resourceTencentCloudWafAntiFakeUpdate(d *schema.ResourceData, meta interfac
 		idUInt, _ := strconv.ParseUint(id, 10, 64)
 		modifyAntiFakeUrlStatusRequest.Ids = common.Uint64Ptrs([]uint64{idUInt})
 		modifyAntiFakeUrlStatusRequest.Domain = &domain
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyAntiFakeUrlStatus(modifyAntiFakeUrlStatusRequest)
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyAntiFakeUrlStatusWithContext(ctx, modifyAntiFakeUrlStatusRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -285,32 +289,32 @@ func resourceTencentCloudWafAntiFakeUpdate(d *schema.ResourceData, meta interfac
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update waf antiFake status failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudWafAntiFakeRead(d, meta)
+	return resourceTencentCloudWafAntiFakeRead(ctx, d, meta)
 }
 
-func resourceTencentCloudWafAntiFakeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafAntiFakeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_anti_fake.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	id := idSplit[0]
 	domain := idSplit[1]
 
 	if err := service.DeleteWafAntiFakeById(ctx, id, domain); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -29,11 +29,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	waf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
@@ -42,12 +42,16 @@ import (
 
 func resourceTencentCloudWafAntiInfoLeak() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafAntiInfoLeakCreate,
-		Read:   resourceTencentCloudWafAntiInfoLeakRead,
-		Update: resourceTencentCloudWafAntiInfoLeakUpdate,
-		Delete: resourceTencentCloudWafAntiInfoLeakDelete,
+		CreateContext: resourceTencentCloudWafAntiInfoLeakCreate,
+		ReadContext:   resourceTencentCloudWafAntiInfoLeakRead,
+		UpdateContext: resourceTencentCloudWafAntiInfoLeakUpdate,
+		DeleteContext: resourceTencentCloudWafAntiInfoLeakDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"domain": {
@@ -102,7 +106,7 @@ func resourceTencentCloudWafAntiInfoLeak() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudWafAntiInfoLeakCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafAntiInfoLeakCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_anti_info_leak.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -148,8 +152,8 @@ func resourceTencentCloudWafAntiInfoLeakCreate(d *schema.ResourceData, meta inte
 		request.Uri = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().AddAntiInfoLeakRules(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().AddAntiInfoLeakRulesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -162,12 +166,12 @@ func resourceTencentCloudWafAntiInfoLeakCreate(d *schema.ResourceData, meta inte
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create waf antiInfoLeak failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleIdInt := *response.Response.RuleId
 	ruleId = strconv.FormatInt(ruleIdInt, 10)
-	d.SetId(strings.Join([]string{ruleId, domain}, FILED_SP))
+	d.SetId(helper.IdFormat(ruleId, domain))
 
 	// set status
 	if v, ok := d.GetOkExists("status"); ok {
@@ -178,8 +182,8 @@ func resourceTencentCloudWafAntiInfoLeakCreate(d *schema.ResourceData, meta inte
 			modifyAntiInfoLeakRuleStatusRequest.Domain = &domain
 			modifyAntiInfoLeakRuleStatusRequest.RuleId = &idUInt
 			modifyAntiInfoLeakRuleStatusRequest.Status = helper.IntUint64(v.(int))
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyAntiInfoLeakRuleStatus(modifyAntiInfoLeakRuleStatusRequest)
+			err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyAntiInfoLeakRuleStatusWithContext(ctx, modifyAntiInfoLeakRuleStatusRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -190,34 +194,34 @@ func resourceTencentCloudWafAntiInfoLeakCreate(d *schema.ResourceData, meta inte
 
 			if err != nil {
 				log.Printf("[CRITAL]%s update waf antiInfoLeak status failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return resourceTencentCloudWafAntiInfoLeakRead(d, meta)
+	return resourceTencentCloudWafAntiInfoLeakRead(ctx, d, meta)
 }
 
-func resourceTencentCloudWafAntiInfoLeakRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafAntiInfoLeakRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_anti_info_leak.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	ruleId := idSplit[0]
 	domain := idSplit[1]
 
 	antiInfoLeak, err := service.DescribeWafAntiInfoLeakById(ctx, ruleId, domain)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if antiInfoLeak == nil {
@@ -267,7 +271,7 @@ func resourceTencentCloudWafAntiInfoLeakRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudWafAntiInfoLeakUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafAntiInfoLeakUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_anti_info_leak.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -281,13 +285,13 @@ func resourceTencentCloudWafAntiInfoLeakUpdate(d *schema.ResourceData, meta inte
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+		return diag.Errorf("id is broken,%s", idSplit)
 	}
 	ruleId := idSplit[0]
 	domain := idSplit[1]
@@ -321,8 +325,8 @@ func resourceTencentCloudWafAntiInfoLeakUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyAntiInfoLeakRules(modifyAntiInfoLeakRulesRequest)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyAntiInfoLeakRulesWithContext(ctx, modifyAntiInfoLeakRulesRequest)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -334,7 +338,7 @@ func resourceTencentCloudWafAntiInfoLeakUpdate(d *schema.ResourceData, meta inte
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update waf antiInfoLeak failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("status") {
@@ -344,8 +348,8 @@ func resourceTencentCloudWafAntiInfoLeakUpdate(d *schema.ResourceData, meta inte
 
 		modifyAntiInfoLeakRuleStatusRequest.Domain = &domain
 		modifyAntiInfoLeakRuleStatusRequest.RuleId = &ruleIdUInt
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyAntiInfoLeakRuleStatus(modifyAntiInfoLeakRuleStatusRequest)
+		err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyAntiInfoLeakRuleStatusWithContext(ctx, modifyAntiInfoLeakRuleStatusRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -356,32 +360,32 @@ func resourceTencentCloudWafAntiInfoLeakUpdate(d *schema.ResourceData, meta inte
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update waf antiFake status failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceTencentCloudWafAntiInfoLeakRead(d, meta)
+	return resourceTencentCloudWafAntiInfoLeakRead(ctx, d, meta)
 }
 
-func resourceTencentCloudWafAntiInfoLeakDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafAntiInfoLeakDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_anti_info_leak.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	ruleId := idSplit[0]
 	domain := idSplit[1]
 
 	if err := service.DeleteWafAntiInfoLeakById(ctx, ruleId, domain); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -115,8 +115,9 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
@@ -126,12 +127,16 @@ import (
 
 func resourceTencentCloudWafClbDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafClbDomainCreate,
-		Read:   resourceTencentCloudWafClbDomainRead,
-		Update: resourceTencentCloudWafClbDomainUpdate,
-		Delete: resourceTencentCloudWafClbDomainDelete,
+		CreateContext: resourceTencentCloudWafClbDomainCreate,
+		ReadContext:   resourceTencentCloudWafClbDomainRead,
+		UpdateContext: resourceTencentCloudWafClbDomainUpdate,
+		DeleteContext: resourceTencentCloudWafClbDomainDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -284,13 +289,12 @@ func resourceTencentCloudWafClbDomain() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudWafClbDomainCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafClbDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_clb_domain.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		service       = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request       = waf.NewCreateHostRequest()
 		instanceID    string
@@ -303,6 +307,7 @@ func resourceTencentCloudWafClbDomainCreate(d *schema.ResourceData, meta interfa
 		isCdn         int
 		albType       string
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("instance_id"); ok {
 		request.InstanceID = helper.String(v.(string))
@@ -376,11 +381,11 @@ func resourceTencentCloudWafClbDomainCreate(d *schema.ResourceData, meta interfa
 					hostRecord.LoadBalancerSet = append(hostRecord.LoadBalancerSet, &loadBalancer)
 				}
 			} else {
-				return fmt.Errorf("If `alb_type` is clb, `load_balancer_set` is required.")
+				return diag.Errorf("If `alb_type` is clb, `load_balancer_set` is required.")
 			}
 		} else {
 			if _, ok := d.GetOk("load_balancer_set"); ok {
-				return fmt.Errorf("If `alb_type` is apisix or tsegw, `load_balancer_set` is not supported.")
+				return diag.Errorf("If `alb_type` is apisix or tsegw, `load_balancer_set` is not supported.")
 			}
 		}
 	}
@@ -401,7 +406,7 @@ func resourceTencentCloudWafClbDomainCreate(d *schema.ResourceData, meta interfa
 				hostRecord.IpHeaders = append(hostRecord.IpHeaders, &ipHeaders)
 			}
 		} else {
-			return fmt.Errorf("If `is_cdn` is %d, not supported setting `ip_headers`.", isCdn)
+			return diag.Errorf("If `is_cdn` is %d, not supported setting `ip_headers`.", isCdn)
 		}
 	}
 
@@ -410,8 +415,8 @@ func resourceTencentCloudWafClbDomainCreate(d *schema.ResourceData, meta interfa
 	describeHostLimitRequest.Domain = &domain
 	describeHostLimitRequest.InstanceID = &instanceID
 	describeHostLimitRequest.AlbType = &albType
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().DescribeHostLimit(describeHostLimitRequest)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().DescribeHostLimitWithContext(ctx, describeHostLimitRequest)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -428,12 +433,12 @@ func resourceTencentCloudWafClbDomainCreate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create waf clbDomain failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	request.Host = &hostRecord
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().CreateHost(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().CreateHostWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -445,13 +450,13 @@ func resourceTencentCloudWafClbDomainCreate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create waf clbDomain failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// get domain id
 	domainInfo, err := service.DescribeDomainsById(ctx, instanceID, domain)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if domainInfo == nil {
@@ -463,10 +468,10 @@ func resourceTencentCloudWafClbDomainCreate(d *schema.ResourceData, meta interfa
 		domainId = *domainInfo.DomainId
 	}
 
-	d.SetId(strings.Join([]string{instanceID, domain, domainId}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceID, domain, domainId))
 
 	// wait domain state
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := service.DescribeDomainsById(ctx, instanceID, domain)
 		if e != nil {
 			return retryError(e)
@@ -480,7 +485,7 @@ func resourceTencentCloudWafClbDomainCreate(d *schema.ResourceData, meta interfa
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// set engine
@@ -495,8 +500,8 @@ func resourceTencentCloudWafClbDomainCreate(d *schema.ResourceData, meta interfa
 			modifyHostModeRequest.InstanceID = &instanceID
 			modifyHostModeRequest.Mode = &engine
 
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyHostMode(modifyHostModeRequest)
+			err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyHostModeWithContext(ctx, modifyHostModeRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -508,7 +513,7 @@ func resourceTencentCloudWafClbDomainCreate(d *schema.ResourceData, meta interfa
 
 			if err != nil {
 				log.Printf("[CRITAL]%s modify waf clbDomain engine failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -526,8 +531,8 @@ func resourceTencentCloudWafClbDomainCreate(d *schema.ResourceData, meta interfa
 			modifyBotStatusRequest.Status = &tmpStatus
 			modifyBotStatusRequest.Category = common.StringPtr("bot")
 
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyBotStatus(modifyBotStatusRequest)
+			err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyBotStatusWithContext(ctx, modifyBotStatusRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -539,7 +544,7 @@ func resourceTencentCloudWafClbDomainCreate(d *schema.ResourceData, meta interfa
 
 			if err != nil {
 				log.Printf("[CRITAL]%s modify waf clbDomain bot_status failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -555,8 +560,8 @@ func resourceTencentCloudWafClbDomainCreate(d *schema.ResourceData, meta interfa
 			modifyApiAnalyzeStatusRequest.InstanceId = &instanceID
 			modifyApiAnalyzeStatusRequest.Status = &apiSafeStatus
 
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyApiAnalyzeStatus(modifyApiAnalyzeStatusRequest)
+			err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyApiAnalyzeStatusWithContext(ctx, modifyApiAnalyzeStatusRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -568,7 +573,7 @@ func resourceTencentCloudWafClbDomainCreate(d *schema.ResourceData, meta interfa
 
 			if err != nil {
 				log.Printf("[CRITAL]%s modify waf clbDomain api_safe_status failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -589,8 +594,8 @@ func resourceTencentCloudWafClbDomainCreate(d *schema.ResourceData, meta interfa
 				},
 			}
 
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyHostStatus(modifyHostStatusRequest)
+			err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyHostStatusWithContext(ctx, modifyHostStatusRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -602,27 +607,27 @@ func resourceTencentCloudWafClbDomainCreate(d *schema.ResourceData, meta interfa
 
 			if err != nil {
 				log.Printf("[CRITAL]%s modify waf clbDomain status failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return resourceTencentCloudWafClbDomainRead(d, meta)
+	return resourceTencentCloudWafClbDomainRead(ctx, d, meta)
 }
 
-func resourceTencentCloudWafClbDomainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafClbDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_clb_domain.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceID := idSplit[0]
@@ -631,7 +636,7 @@ func resourceTencentCloudWafClbDomainRead(d *schema.ResourceData, meta interface
 
 	domainInfo, err := service.DescribeDomainsById(ctx, instanceID, domain)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if domainInfo == nil {
@@ -743,7 +748,7 @@ func resourceTencentCloudWafClbDomainRead(d *schema.ResourceData, meta interface
 
 	clbInfo, err := service.DescribeWafClbDomainById(ctx, instanceID, domain, domainId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if clbInfo == nil {
@@ -763,7 +768,7 @@ func resourceTencentCloudWafClbDomainRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTencentCloudWafClbDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafClbDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_clb_domain.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -778,9 +783,9 @@ func resourceTencentCloudWafClbDomainUpdate(d *schema.ResourceData, meta interfa
 		apiSafeStatus     uint64
 	)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+		return diag.Errorf("id is broken,%s", idSplit)
 	}
 
 	instanceID := idSplit[0]
@@ -791,7 +796,7 @@ func resourceTencentCloudWafClbDomainUpdate(d *schema.ResourceData, meta interfa
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -812,8 +817,8 @@ func resourceTencentCloudWafClbDomainUpdate(d *schema.ResourceData, meta interfa
 					},
 				}
 
-				err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-					result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyHostStatus(modifyHostStatusRequest)
+				err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+					result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyHostStatusWithContext(ctx, modifyHostStatusRequest)
 					if e != nil {
 						return retryError(e)
 					} else {
@@ -825,7 +830,7 @@ func resourceTencentCloudWafClbDomainUpdate(d *schema.ResourceData, meta interfa
 
 				if err != nil {
 					log.Printf("[CRITAL]%s modify waf clbDomain status failed, reason:%+v", logId, err)
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -898,11 +903,11 @@ func resourceTencentCloudWafClbDomainUpdate(d *schema.ResourceData, meta interfa
 					hostRecord.LoadBalancerSet = append(hostRecord.LoadBalancerSet, &loadBalancer)
 				}
 			} else {
-				return fmt.Errorf("If `alb_type` is clb, `load_balancer_set` is required.")
+				return diag.Errorf("If `alb_type` is clb, `load_balancer_set` is required.")
 			}
 		} else {
 			if _, ok := d.GetOk("load_balancer_set"); ok {
-				return fmt.Errorf("If `alb_type` is apisix or tsegw, `load_balancer_set` is not supported.")
+				return diag.Errorf("If `alb_type` is apisix or tsegw, `load_balancer_set` is not supported.")
 			}
 		}
 	}
@@ -919,13 +924,13 @@ func resourceTencentCloudWafClbDomainUpdate(d *schema.ResourceData, meta interfa
 				hostRecord.IpHeaders = append(hostRecord.IpHeaders, &ipHeaders)
 			}
 		} else {
-			return fmt.Errorf("If `is_cdn` is %d, not supported setting `ip_headers`.", isCdn)
+			return diag.Errorf("If `is_cdn` is %d, not supported setting `ip_headers`.", isCdn)
 		}
 	}
 
 	modifyHostRequest.Host = &hostRecord
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyHost(modifyHostRequest)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyHostWithContext(ctx, modifyHostRequest)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -937,7 +942,7 @@ func resourceTencentCloudWafClbDomainUpdate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		log.Printf("[CRITAL]%s modify waf clbDomain failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// set flow mode
@@ -950,8 +955,8 @@ func resourceTencentCloudWafClbDomainUpdate(d *schema.ResourceData, meta interfa
 			modifyHostFlowModeRequest.InstanceID = &instanceID
 			modifyHostFlowModeRequest.FlowMode = &flowMode
 
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyHostFlowMode(modifyHostFlowModeRequest)
+			err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyHostFlowModeWithContext(ctx, modifyHostFlowModeRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -963,7 +968,7 @@ func resourceTencentCloudWafClbDomainUpdate(d *schema.ResourceData, meta interfa
 
 			if err != nil {
 				log.Printf("[CRITAL]%s modify waf clbDomain flow_mode failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -978,8 +983,8 @@ func resourceTencentCloudWafClbDomainUpdate(d *schema.ResourceData, meta interfa
 			modifyHostModeRequest.InstanceID = &instanceID
 			modifyHostModeRequest.Mode = &engine
 
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyHostMode(modifyHostModeRequest)
+			err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyHostModeWithContext(ctx, modifyHostModeRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -991,7 +996,7 @@ func resourceTencentCloudWafClbDomainUpdate(d *schema.ResourceData, meta interfa
 
 			if err != nil {
 				log.Printf("[CRITAL]%s modify waf clbDomain engine failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -1007,8 +1012,8 @@ func resourceTencentCloudWafClbDomainUpdate(d *schema.ResourceData, meta interfa
 			modifyBotStatusRequest.Status = &tmpStatus
 			modifyBotStatusRequest.Category = common.StringPtr("bot")
 
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyBotStatus(modifyBotStatusRequest)
+			err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyBotStatusWithContext(ctx, modifyBotStatusRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -1020,7 +1025,7 @@ func resourceTencentCloudWafClbDomainUpdate(d *schema.ResourceData, meta interfa
 
 			if err != nil {
 				log.Printf("[CRITAL]%s modify waf clbDomain bot_status failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -1034,8 +1039,8 @@ func resourceTencentCloudWafClbDomainUpdate(d *schema.ResourceData, meta interfa
 			modifyApiAnalyzeStatusRequest.InstanceId = &instanceID
 			modifyApiAnalyzeStatusRequest.Status = &apiSafeStatus
 
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyApiAnalyzeStatus(modifyApiAnalyzeStatusRequest)
+			err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyApiAnalyzeStatusWithContext(ctx, modifyApiAnalyzeStatusRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -1047,7 +1052,7 @@ func resourceTencentCloudWafClbDomainUpdate(d *schema.ResourceData, meta interfa
 
 			if err != nil {
 				log.Printf("[CRITAL]%s modify waf clbDomain api_safe_status failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -1069,8 +1074,8 @@ func resourceTencentCloudWafClbDomainUpdate(d *schema.ResourceData, meta interfa
 					},
 				}
 
-				err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-					result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyHostStatus(modifyHostStatusRequest)
+				err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+					result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyHostStatusWithContext(ctx, modifyHostStatusRequest)
 					if e != nil {
 						return retryError(e)
 					} else {
@@ -1082,28 +1087,28 @@ func resourceTencentCloudWafClbDomainUpdate(d *schema.ResourceData, meta interfa
 
 				if err != nil {
 					log.Printf("[CRITAL]%s modify waf clbDomain status failed, reason:%+v", logId, err)
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
 	}
 
-	return resourceTencentCloudWafClbDomainRead(d, meta)
+	return resourceTencentCloudWafClbDomainRead(ctx, d, meta)
 }
 
-func resourceTencentCloudWafClbDomainDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafClbDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_clb_domain.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceID := idSplit[0]
@@ -1111,7 +1116,7 @@ func resourceTencentCloudWafClbDomainDelete(d *schema.ResourceData, meta interfa
 	domainId := idSplit[2]
 
 	if err := service.DeleteWafClbDomainById(ctx, instanceID, domain, domainId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -26,6 +26,14 @@ resource "tencentcloud_waf_clb_instance" "example" {
   elastic_mode     = 1
 }
 ```
+
+Import
+
+waf clb_instance can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_waf_clb_instance.example waf_2kxtlbky00b2v1fn
+```
 */
 package tencentcloud
 
@@ -33,7 +41,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	waf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
@@ -42,10 +52,17 @@ import (
 
 func resourceTencentCloudWafClbInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafClbInstanceCreate,
-		Read:   resourceTencentCloudWafClbInstanceRead,
-		Update: resourceTencentCloudWafClbInstanceUpdate,
-		Delete: resourceTencentCloudWafClbInstanceDelete,
+		CreateContext: resourceTencentCloudWafClbInstanceCreate,
+		ReadContext:   resourceTencentCloudWafClbInstanceRead,
+		UpdateContext: resourceTencentCloudWafClbInstanceUpdate,
+		DeleteContext: resourceTencentCloudWafClbInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"goods_category": {
@@ -135,7 +152,7 @@ func resourceTencentCloudWafClbInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudWafClbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafClbInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_clb_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -156,7 +173,7 @@ func resourceTencentCloudWafClbInstanceCreate(d *schema.ResourceData, meta inter
 		mainlandMode = REGION_ID_NON_MAINLAND
 
 	} else {
-		return fmt.Errorf("Region only supports `ap-guangzhou` and `ap-seoul`.")
+		return diag.Errorf("Region only supports `ap-guangzhou` and `ap-seoul`.")
 	}
 
 	goods := []*waf.GoodNews{}
@@ -257,8 +274,8 @@ func resourceTencentCloudWafClbInstanceCreate(d *schema.ResourceData, meta inter
 	//}
 
 	request.Goods = goods
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().GenerateDealsAndPayNew(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().GenerateDealsAndPayNewWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -275,7 +292,7 @@ func resourceTencentCloudWafClbInstanceCreate(d *schema.ResourceData, meta inter
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create waf clb instance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId = *response.Response.InstanceId
@@ -289,8 +306,8 @@ func resourceTencentCloudWafClbInstanceCreate(d *schema.ResourceData, meta inter
 			newSwitchElasticModeRequest.InstanceID = &instanceId
 			newSwitchElasticModeRequest.Mode = helper.IntInt64(elasticMode)
 			newSwitchElasticModeRequest.Edition = helper.String(EDITION_CLB)
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().SwitchElasticMode(newSwitchElasticModeRequest)
+			err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().SwitchElasticModeWithContext(ctx, newSwitchElasticModeRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -302,28 +319,28 @@ func resourceTencentCloudWafClbInstanceCreate(d *schema.ResourceData, meta inter
 
 			if err != nil {
 				log.Printf("[CRITAL]%s update waf clb instance elastic mode failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return resourceTencentCloudWafClbInstanceRead(d, meta)
+	return resourceTencentCloudWafClbInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudWafClbInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafClbInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_clb_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceInfo, err := service.DescribeWafInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instanceInfo == nil {
@@ -380,7 +397,7 @@ func resourceTencentCloudWafClbInstanceRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceTencentCloudWafClbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafClbInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_clb_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -396,7 +413,7 @@ func resourceTencentCloudWafClbInstanceUpdate(d *schema.ResourceData, meta inter
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -405,8 +422,8 @@ func resourceTencentCloudWafClbInstanceUpdate(d *schema.ResourceData, meta inter
 			modifyInstanceNameRequest.InstanceID = &instanceId
 			modifyInstanceNameRequest.InstanceName = helper.String(v.(string))
 			modifyInstanceNameRequest.Edition = helper.String(EDITION_CLB)
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyInstanceName(modifyInstanceNameRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyInstanceNameWithContext(ctx, modifyInstanceNameRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -418,7 +435,7 @@ func resourceTencentCloudWafClbInstanceUpdate(d *schema.ResourceData, meta inter
 
 			if err != nil {
 				log.Printf("[CRITAL]%s update waf clb instance name failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -427,8 +444,8 @@ func resourceTencentCloudWafClbInstanceUpdate(d *schema.ResourceData, meta inter
 		if v, ok := d.GetOkExists("auto_renew_flag"); ok {
 			modifyInstanceRenewFlagRequest.InstanceId = &instanceId
 			modifyInstanceRenewFlagRequest.RenewFlag = helper.IntInt64(v.(int))
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyInstanceRenewFlag(modifyInstanceRenewFlagRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyInstanceRenewFlagWithContext(ctx, modifyInstanceRenewFlagRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -440,7 +457,7 @@ func resourceTencentCloudWafClbInstanceUpdate(d *schema.ResourceData, meta inter
 
 			if err != nil {
 				log.Printf("[CRITAL]%s update waf clb instance auto renew flag failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -450,8 +467,8 @@ func resourceTencentCloudWafClbInstanceUpdate(d *schema.ResourceData, meta inter
 			newSwitchElasticModeRequest.InstanceID = &instanceId
 			newSwitchElasticModeRequest.Mode = helper.IntInt64(v.(int))
 			newSwitchElasticModeRequest.Edition = helper.String(EDITION_CLB)
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().SwitchElasticMode(newSwitchElasticModeRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().SwitchElasticModeWithContext(ctx, newSwitchElasticModeRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -463,17 +480,17 @@ func resourceTencentCloudWafClbInstanceUpdate(d *schema.ResourceData, meta inter
 
 			if err != nil {
 				log.Printf("[CRITAL]%s update waf clb instance elastic mode failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return resourceTencentCloudWafClbInstanceRead(d, meta)
+	return resourceTencentCloudWafClbInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudWafClbInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafClbInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_clb_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
-	return fmt.Errorf("tencentcloud waf clb instance not supported delete, please contact the work order for processing")
+	return diag.Errorf("tencentcloud waf clb instance not supported delete, please contact the work order for processing")
 }
@@ -32,6 +32,12 @@ func TestAccTencentCloudNeedFixWafClbInstanceResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("tencentcloud_waf_clb_instance.example", "id"),
 				),
 			},
+			{
+				ResourceName:            "tencentcloud_waf_clb_instance.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"goods_category", "time_span", "time_unit"},
+			},
 		},
 	})
 }
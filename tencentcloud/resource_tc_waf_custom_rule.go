@@ -35,11 +35,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	waf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
@@ -48,12 +48,16 @@ import (
 
 func resourceTencentCloudWafCustomRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafCustomRuleCreate,
-		Read:   resourceTencentCloudWafCustomRuleRead,
-		Update: resourceTencentCloudWafCustomRuleUpdate,
-		Delete: resourceTencentCloudWafCustomRuleDelete,
+		CreateContext: resourceTencentCloudWafCustomRuleCreate,
+		ReadContext:   resourceTencentCloudWafCustomRuleRead,
+		UpdateContext: resourceTencentCloudWafCustomRuleUpdate,
+		DeleteContext: resourceTencentCloudWafCustomRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -132,7 +136,7 @@ func resourceTencentCloudWafCustomRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudWafCustomRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafCustomRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_custom_rule.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -195,8 +199,8 @@ func resourceTencentCloudWafCustomRuleCreate(d *schema.ResourceData, meta interf
 		request.ActionType = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().AddCustomRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().AddCustomRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -209,7 +213,7 @@ func resourceTencentCloudWafCustomRuleCreate(d *schema.ResourceData, meta interf
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create waf CustomRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleId := *response.Response.RuleId
@@ -224,8 +228,8 @@ func resourceTencentCloudWafCustomRuleCreate(d *schema.ResourceData, meta interf
 		tmpRuleId := uint64(ruleId)
 		statusRequest.RuleId = &tmpRuleId
 		statusRequest.Status = helper.IntUint64(CUSTOM_RULE_STATUS_0_INT)
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyCustomRuleStatus(statusRequest)
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyCustomRuleStatusWithContext(ctx, statusRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -237,27 +241,27 @@ func resourceTencentCloudWafCustomRuleCreate(d *schema.ResourceData, meta interf
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify waf CustomRule status failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	d.SetId(strings.Join([]string{domain, ruleIdStr}, FILED_SP))
-	return resourceTencentCloudWafCustomRuleRead(d, meta)
+	d.SetId(helper.IdFormat(domain, ruleIdStr))
+	return resourceTencentCloudWafCustomRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudWafCustomRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafCustomRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_custom_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	domain := idSplit[0]
@@ -265,7 +269,7 @@ func resourceTencentCloudWafCustomRuleRead(d *schema.ResourceData, meta interfac
 
 	customRule, err := service.DescribeWafCustomRuleById(ctx, domain, ruleId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if customRule == nil {
@@ -335,7 +339,7 @@ func resourceTencentCloudWafCustomRuleRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudWafCustomRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafCustomRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_custom_rule.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -345,9 +349,9 @@ func resourceTencentCloudWafCustomRuleUpdate(d *schema.ResourceData, meta interf
 		statusRequest = waf.NewModifyCustomRuleStatusRequest()
 	)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+		return diag.Errorf("id is broken,%s", idSplit)
 	}
 
 	domain := idSplit[0]
@@ -357,7 +361,7 @@ func resourceTencentCloudWafCustomRuleUpdate(d *schema.ResourceData, meta interf
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -410,8 +414,8 @@ func resourceTencentCloudWafCustomRuleUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyCustomRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyCustomRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -423,7 +427,7 @@ func resourceTencentCloudWafCustomRuleUpdate(d *schema.ResourceData, meta interf
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update waf CustomRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("status") {
@@ -437,8 +441,8 @@ func resourceTencentCloudWafCustomRuleUpdate(d *schema.ResourceData, meta interf
 				statusRequest.Status = helper.IntUint64(CUSTOM_RULE_STATUS_1_INT)
 			}
 
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyCustomRuleStatus(statusRequest)
+			err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyCustomRuleStatusWithContext(ctx, statusRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -450,34 +454,34 @@ func resourceTencentCloudWafCustomRuleUpdate(d *schema.ResourceData, meta interf
 
 			if err != nil {
 				log.Printf("[CRITAL]%s modify waf CustomRule status failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return resourceTencentCloudWafCustomRuleRead(d, meta)
+	return resourceTencentCloudWafCustomRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudWafCustomRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafCustomRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_custom_rule.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	domain := idSplit[0]
 	ruleId := idSplit[1]
 
 	if err := service.DeleteWafCustomRuleById(ctx, domain, ruleId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
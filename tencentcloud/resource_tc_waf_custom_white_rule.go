@@ -34,11 +34,11 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	waf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
@@ -47,12 +47,16 @@ import (
 
 func resourceTencentCloudWafCustomWhiteRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafCustomWhiteRuleCreate,
-		Read:   resourceTencentCloudWafCustomWhiteRuleRead,
-		Update: resourceTencentCloudWafCustomWhiteRuleUpdate,
-		Delete: resourceTencentCloudWafCustomWhiteRuleDelete,
+		CreateContext: resourceTencentCloudWafCustomWhiteRuleCreate,
+		ReadContext:   resourceTencentCloudWafCustomWhiteRuleRead,
+		UpdateContext: resourceTencentCloudWafCustomWhiteRuleUpdate,
+		DeleteContext: resourceTencentCloudWafCustomWhiteRuleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -125,7 +129,7 @@ func resourceTencentCloudWafCustomWhiteRule() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudWafCustomWhiteRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafCustomWhiteRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_custom_white_rule.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -180,8 +184,8 @@ func resourceTencentCloudWafCustomWhiteRuleCreate(d *schema.ResourceData, meta i
 		request.Bypass = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().AddCustomWhiteRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().AddCustomWhiteRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -194,7 +198,7 @@ func resourceTencentCloudWafCustomWhiteRuleCreate(d *schema.ResourceData, meta i
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create waf CustomWhiteRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleId := *response.Response.RuleId
@@ -208,8 +212,8 @@ func resourceTencentCloudWafCustomWhiteRuleCreate(d *schema.ResourceData, meta i
 		statusRequest.Domain = &domain
 		statusRequest.RuleId = &ruleId
 		statusRequest.Status = helper.IntUint64(CUSTOM_WHITE_RULE_STATUS_0_INT)
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyCustomWhiteRuleStatus(statusRequest)
+		err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyCustomWhiteRuleStatusWithContext(ctx, statusRequest)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -221,27 +225,27 @@ func resourceTencentCloudWafCustomWhiteRuleCreate(d *schema.ResourceData, meta i
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify waf CustomWhiteRule status failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	d.SetId(strings.Join([]string{domain, ruleIdStr}, FILED_SP))
-	return resourceTencentCloudWafCustomWhiteRuleRead(d, meta)
+	d.SetId(helper.IdFormat(domain, ruleIdStr))
+	return resourceTencentCloudWafCustomWhiteRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudWafCustomWhiteRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafCustomWhiteRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_custom_white_rule.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	domain := idSplit[0]
@@ -249,7 +253,7 @@ func resourceTencentCloudWafCustomWhiteRuleRead(d *schema.ResourceData, meta int
 
 	customWhiteRule, err := service.DescribeWafCustomWhiteRuleById(ctx, domain, ruleId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if customWhiteRule == nil {
@@ -315,7 +319,7 @@ func resourceTencentCloudWafCustomWhiteRuleRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudWafCustomWhiteRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafCustomWhiteRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_custom_white_rule.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -325,9 +329,9 @@ func resourceTencentCloudWafCustomWhiteRuleUpdate(d *schema.ResourceData, meta i
 		statusRequest = waf.NewModifyCustomWhiteRuleStatusRequest()
 	)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
+	idSplit := helper.IdParse(d.Id())
 	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+		return diag.Errorf("id is broken,%s", idSplit)
 	}
 
 	domain := idSplit[0]
@@ -337,7 +341,7 @@ func resourceTencentCloudWafCustomWhiteRuleUpdate(d *schema.ResourceData, meta i
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -388,8 +392,8 @@ func resourceTencentCloudWafCustomWhiteRuleUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyCustomWhiteRule(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyCustomWhiteRuleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -401,7 +405,7 @@ func resourceTencentCloudWafCustomWhiteRuleUpdate(d *schema.ResourceData, meta i
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update waf CustomWhiteRule failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("status") {
@@ -415,8 +419,8 @@ func resourceTencentCloudWafCustomWhiteRuleUpdate(d *schema.ResourceData, meta i
 				statusRequest.Status = helper.IntUint64(CUSTOM_WHITE_RULE_STATUS_1_INT)
 			}
 
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyCustomWhiteRuleStatus(statusRequest)
+			err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyCustomWhiteRuleStatusWithContext(ctx, statusRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -428,34 +432,34 @@ func resourceTencentCloudWafCustomWhiteRuleUpdate(d *schema.ResourceData, meta i
 
 			if err != nil {
 				log.Printf("[CRITAL]%s modify waf CustomRule status failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return resourceTencentCloudWafCustomWhiteRuleRead(d, meta)
+	return resourceTencentCloudWafCustomWhiteRuleRead(ctx, d, meta)
 }
 
-func resourceTencentCloudWafCustomWhiteRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafCustomWhiteRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_custom_white_rule.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	domain := idSplit[0]
 	ruleId := idSplit[1]
 
 	if err := service.DeleteWafCustomWhiteRuleById(ctx, domain, ruleId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -181,8 +181,9 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
@@ -192,12 +193,16 @@ import (
 
 func resourceTencentCloudWafSaasDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafSaasDomainCreate,
-		Read:   resourceTencentCloudWafSaasDomainRead,
-		Update: resourceTencentCloudWafSaasDomainUpdate,
-		Delete: resourceTencentCloudWafSaasDomainDelete,
+		CreateContext: resourceTencentCloudWafSaasDomainCreate,
+		ReadContext:   resourceTencentCloudWafSaasDomainRead,
+		UpdateContext: resourceTencentCloudWafSaasDomainUpdate,
+		DeleteContext: resourceTencentCloudWafSaasDomainDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -444,13 +449,12 @@ func resourceTencentCloudWafSaasDomain() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafSaasDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_saas_domain.create")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		service       = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 		verifyRequest = waf.NewDescribeDomainVerifyResultRequest()
 		request       = waf.NewAddSpartaProtectionRequest()
@@ -463,6 +467,7 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 		//ipv6Status    int64
 		isCdn int
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	if v, ok := d.GetOk("instance_id"); ok {
 		request.InstanceID = helper.String(v.(string))
@@ -477,8 +482,8 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 	// check domain legal
 	verifyRequest.InstanceID = &instanceID
 	verifyRequest.Domain = &domain
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().DescribeDomainVerifyResult(verifyRequest)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().DescribeDomainVerifyResultWithContext(ctx, verifyRequest)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -495,7 +500,7 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 
 	if err != nil {
 		log.Printf("[CRITAL]%s verify waf saasDomain failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok := d.GetOkExists("cert_type"); ok {
@@ -508,16 +513,16 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 		certType := v.(int)
 		if certType == CERT_TYPE_0 {
 			if cert != "" || privateKey != "" || sslId != "" {
-				return fmt.Errorf("If `cert_type` is 0, not support setting `cert`, `private_key`, `ssl_id`.")
+				return diag.Errorf("If `cert_type` is 0, not support setting `cert`, `private_key`, `ssl_id`.")
 			}
 
 		} else if certType == CERT_TYPE_1 {
 			if sslId != "" {
-				return fmt.Errorf("If `cert_type` is 1, not support setting `ssl_id`.")
+				return diag.Errorf("If `cert_type` is 1, not support setting `ssl_id`.")
 			}
 
 			if cert == "" || privateKey == "" {
-				return fmt.Errorf("If `cert_type` is 1, `cert`, `private_key` is required.")
+				return diag.Errorf("If `cert_type` is 1, `cert`, `private_key` is required.")
 			}
 
 			request.Cert = &cert
@@ -525,11 +530,11 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 
 		} else {
 			if cert != "" || privateKey != "" {
-				return fmt.Errorf("If `cert_type` is 2, not support setting `cert`, `private_key`.")
+				return diag.Errorf("If `cert_type` is 2, not support setting `cert`, `private_key`.")
 			}
 
 			if sslId == "" {
-				return fmt.Errorf("If `cert_type` is 2, `ssl_id` is required.")
+				return diag.Errorf("If `cert_type` is 2, `ssl_id` is required.")
 			}
 
 			request.SSLId = &sslId
@@ -554,7 +559,7 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 		upstreamScheme := v.(string)
 		if upstreamScheme == UPSTREAM_SCHEME_HTTP {
 			if httpsUpstreamPort == "" {
-				return fmt.Errorf("If `upstream_scheme` is http, `https_upstream_port` is required.")
+				return diag.Errorf("If `upstream_scheme` is http, `https_upstream_port` is required.")
 			}
 
 			request.HttpsUpstreamPort = &httpsUpstreamPort
@@ -567,7 +572,7 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 		upstreamType := v.(int)
 		if upstreamType == UP_STREAM_TYPE_0 {
 			if _, ok := d.GetOk("upstream_domain"); ok {
-				return fmt.Errorf("If `upstream_type` is 0, not support setting `upstream_domain`.")
+				return diag.Errorf("If `upstream_type` is 0, not support setting `upstream_domain`.")
 			}
 
 			if v, ok := d.GetOk("src_list"); ok {
@@ -579,17 +584,17 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 
 				if len(srcListSet) == 1 {
 					if _, ok := d.GetOk("weights"); ok {
-						return fmt.Errorf("If `src_list` length is 1, not support setting `weights`.")
+						return diag.Errorf("If `src_list` length is 1, not support setting `weights`.")
 					}
 				} else {
 					if loadBalance != LOAD_BALANCE_2 {
-						return fmt.Errorf("If `load_balance` is 0 or 1, not support setting `weights`.")
+						return diag.Errorf("If `load_balance` is 0 or 1, not support setting `weights`.")
 					}
 
 					if v, ok := d.GetOk("weights"); ok {
 						weightsSet := v.([]interface{})
 						if len(weightsSet) != len(srcListSet) {
-							return fmt.Errorf("The lengths of `weights` and `src_list` are not equal.")
+							return diag.Errorf("The lengths of `weights` and `src_list` are not equal.")
 						}
 
 						for i := range weightsSet {
@@ -600,7 +605,7 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 				}
 
 			} else {
-				return fmt.Errorf("If `upstream_type` is 0, `src_list` is required.")
+				return diag.Errorf("If `upstream_type` is 0, `src_list` is required.")
 			}
 
 			if v, ok := d.GetOk("is_keep_alive"); ok {
@@ -609,11 +614,11 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 
 		} else {
 			if _, ok := d.GetOk("src_list"); ok {
-				return fmt.Errorf("If `upstream_type` is 1, not support setting `src_list`.")
+				return diag.Errorf("If `upstream_type` is 1, not support setting `src_list`.")
 			}
 
 			if _, ok := d.GetOk("weights"); ok {
-				return fmt.Errorf("If `upstream_type` is 1, not support setting `weights`.")
+				return diag.Errorf("If `upstream_type` is 1, not support setting `weights`.")
 			}
 
 			if v, ok := d.GetOk("is_keep_alive"); ok {
@@ -623,7 +628,7 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 			if v, ok := d.GetOk("upstream_domain"); ok {
 				request.UpstreamDomain = helper.String(v.(string))
 			} else {
-				return fmt.Errorf("If `upstream_type` is 1, `upstream_domain` is required.")
+				return diag.Errorf("If `upstream_type` is 1, `upstream_domain` is required.")
 			}
 		}
 	}
@@ -681,7 +686,7 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 
 		if cipherTemplate != CIPHER_TEMPLATE_3 {
 			if _, ok := d.GetOk("ciphers"); ok {
-				return fmt.Errorf("If `cipher_template` is 1 or 2, not support setting `ciphers`.")
+				return diag.Errorf("If `cipher_template` is 1 or 2, not support setting `ciphers`.")
 			}
 		} else {
 			if v, ok := d.GetOk("ciphers"); ok {
@@ -718,7 +723,7 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 				request.IpHeaders = append(request.IpHeaders, &ipHeaders)
 			}
 		} else {
-			return fmt.Errorf("If `is_cdn` is %d, not supported setting `ip_headers`.", isCdn)
+			return diag.Errorf("If `is_cdn` is %d, not supported setting `ip_headers`.", isCdn)
 		}
 	}
 
@@ -726,8 +731,8 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 		request.XFFReset = helper.IntInt64(v.(int))
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().AddSpartaProtection(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().AddSpartaProtectionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -739,13 +744,13 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create waf saasDomain failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// get domain id
 	domainInfo, err := service.DescribeDomainsById(ctx, instanceID, domain)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if domainInfo == nil {
@@ -757,10 +762,10 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 		domainId = *domainInfo.DomainId
 	}
 
-	d.SetId(strings.Join([]string{instanceID, domain, domainId}, FILED_SP))
+	d.SetId(helper.IdFormat(instanceID, domain, domainId))
 
 	// wait domain state
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		result, e := service.DescribeDomainsById(ctx, instanceID, domain)
 		if e != nil {
 			return retryError(e)
@@ -774,7 +779,7 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// set bot
@@ -790,8 +795,8 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 			modifyBotStatusRequest.Status = &tmpStatus
 			modifyBotStatusRequest.Category = common.StringPtr("bot")
 
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyBotStatus(modifyBotStatusRequest)
+			err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyBotStatusWithContext(ctx, modifyBotStatusRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -803,7 +808,7 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 
 			if err != nil {
 				log.Printf("[CRITAL]%s modify waf saasDomain bot_status failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -819,8 +824,8 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 			modifyApiAnalyzeStatusRequest.InstanceId = &instanceID
 			modifyApiAnalyzeStatusRequest.Status = &apiSafeStatus
 
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyApiAnalyzeStatus(modifyApiAnalyzeStatusRequest)
+			err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyApiAnalyzeStatusWithContext(ctx, modifyApiAnalyzeStatusRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -832,7 +837,7 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 
 			if err != nil {
 				log.Printf("[CRITAL]%s modify waf saasDomain api_safe_status failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -901,22 +906,22 @@ func resourceTencentCloudWafSaasDomainCreate(d *schema.ResourceData, meta interf
 	//	}
 	//}
 
-	return resourceTencentCloudWafSaasDomainRead(d, meta)
+	return resourceTencentCloudWafSaasDomainRead(ctx, d, meta)
 }
 
-func resourceTencentCloudWafSaasDomainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafSaasDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_saas_domain.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceID := idSplit[0]
@@ -925,7 +930,7 @@ func resourceTencentCloudWafSaasDomainRead(d *schema.ResourceData, meta interfac
 
 	saasDomain, err := service.DescribeWafSaasDomainById(ctx, instanceID, domain, domainId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if saasDomain == nil {
@@ -936,7 +941,7 @@ func resourceTencentCloudWafSaasDomainRead(d *schema.ResourceData, meta interfac
 
 	domainInfo, err := service.DescribeDomainsById(ctx, instanceID, domain)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if domainInfo == nil {
@@ -1112,13 +1117,12 @@ func resourceTencentCloudWafSaasDomainRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafSaasDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_saas_domain.update")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId         = getLogId(contextNil)
-		ctx           = context.WithValue(context.TODO(), logIdKey, logId)
 		service       = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 		request       = waf.NewModifySpartaProtectionRequest()
 		botStatus     uint64
@@ -1127,18 +1131,19 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 		loadBalance string
 		isCdn       int
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	immutableArgs := []string{"instance_id", "domain"}
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceID := idSplit[0]
@@ -1184,7 +1189,7 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 	// get ports by api
 	saasDomain, err := service.DescribeWafSaasDomainById(ctx, instanceID, domain, domainId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if saasDomain == nil {
@@ -1238,16 +1243,16 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 		certType := v.(int)
 		if certType == CERT_TYPE_0 {
 			if cert != "" || privateKey != "" || sslId != "" {
-				return fmt.Errorf("If `cert_type` is 0, not support setting `cert`, `private_key`, `ssl_id`.")
+				return diag.Errorf("If `cert_type` is 0, not support setting `cert`, `private_key`, `ssl_id`.")
 			}
 
 		} else if certType == CERT_TYPE_1 {
 			if sslId != "" {
-				return fmt.Errorf("If `cert_type` is 1, not support setting `ssl_id`.")
+				return diag.Errorf("If `cert_type` is 1, not support setting `ssl_id`.")
 			}
 
 			if cert == "" || privateKey == "" {
-				return fmt.Errorf("If `cert_type` is 1, `cert`, `private_key` is required.")
+				return diag.Errorf("If `cert_type` is 1, `cert`, `private_key` is required.")
 			}
 
 			request.Cert = &cert
@@ -1255,11 +1260,11 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 
 		} else {
 			if cert != "" || privateKey != "" {
-				return fmt.Errorf("If `cert_type` is 2, not support setting `cert`, `private_key`.")
+				return diag.Errorf("If `cert_type` is 2, not support setting `cert`, `private_key`.")
 			}
 
 			if sslId == "" {
-				return fmt.Errorf("If `cert_type` is 2, `ssl_id` is required.")
+				return diag.Errorf("If `cert_type` is 2, `ssl_id` is required.")
 			}
 
 			request.SSLId = &sslId
@@ -1286,7 +1291,7 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 		upstreamScheme := v.(string)
 		if upstreamScheme == UPSTREAM_SCHEME_HTTP {
 			if httpsUpstreamPort == "" {
-				return fmt.Errorf("If `upstream_scheme` is http, `https_upstream_port` is required.")
+				return diag.Errorf("If `upstream_scheme` is http, `https_upstream_port` is required.")
 			}
 
 			request.HttpsUpstreamPort = &httpsUpstreamPort
@@ -1299,7 +1304,7 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 		upstreamType := v.(int)
 		if upstreamType == UP_STREAM_TYPE_0 {
 			if _, ok := d.GetOk("upstream_domain"); ok {
-				return fmt.Errorf("If `upstream_type` is 0, not support setting `upstream_domain`.")
+				return diag.Errorf("If `upstream_type` is 0, not support setting `upstream_domain`.")
 			}
 
 			if v, ok := d.GetOk("src_list"); ok {
@@ -1311,17 +1316,17 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 
 				if len(srcListSet) == 1 {
 					if _, ok := d.GetOk("weights"); ok {
-						return fmt.Errorf("If `src_list` length is 1, not support setting `weights`.")
+						return diag.Errorf("If `src_list` length is 1, not support setting `weights`.")
 					}
 				} else {
 					if loadBalance != LOAD_BALANCE_2 {
-						return fmt.Errorf("If `load_balance` is 0 or 1, not support setting `weights`.")
+						return diag.Errorf("If `load_balance` is 0 or 1, not support setting `weights`.")
 					}
 
 					if v, ok := d.GetOk("weights"); ok {
 						weightsSet := v.([]interface{})
 						if len(weightsSet) != len(srcListSet) {
-							return fmt.Errorf("The lengths of `weights` and `src_list` are not equal.")
+							return diag.Errorf("The lengths of `weights` and `src_list` are not equal.")
 						}
 
 						for i := range weightsSet {
@@ -1332,7 +1337,7 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 				}
 
 			} else {
-				return fmt.Errorf("If `upstream_type` is 0, `src_list` is required.")
+				return diag.Errorf("If `upstream_type` is 0, `src_list` is required.")
 			}
 
 			if v, ok := d.GetOk("is_keep_alive"); ok {
@@ -1341,11 +1346,11 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 
 		} else {
 			if _, ok := d.GetOk("src_list"); ok {
-				return fmt.Errorf("If `upstream_type` is 1, not support setting `src_list`.")
+				return diag.Errorf("If `upstream_type` is 1, not support setting `src_list`.")
 			}
 
 			if _, ok := d.GetOk("weights"); ok {
-				return fmt.Errorf("If `upstream_type` is 1, not support setting `weights`.")
+				return diag.Errorf("If `upstream_type` is 1, not support setting `weights`.")
 			}
 
 			if v, ok := d.GetOk("is_keep_alive"); ok {
@@ -1355,7 +1360,7 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 			if v, ok := d.GetOk("upstream_domain"); ok {
 				request.UpstreamDomain = helper.String(v.(string))
 			} else {
-				return fmt.Errorf("If `upstream_type` is 1, `upstream_domain` is required.")
+				return diag.Errorf("If `upstream_type` is 1, `upstream_domain` is required.")
 			}
 		}
 	}
@@ -1473,7 +1478,7 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 				request.IpHeaders = append(request.IpHeaders, &ipHeaders)
 			}
 		} else {
-			return fmt.Errorf("If `is_cdn` is %d, not supported setting `ip_headers`.", isCdn)
+			return diag.Errorf("If `is_cdn` is %d, not supported setting `ip_headers`.", isCdn)
 		}
 	}
 
@@ -1481,8 +1486,8 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 		request.XFFReset = helper.IntInt64(v.(int))
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifySpartaProtection(request)
+	err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifySpartaProtectionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1494,7 +1499,7 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update waf saasDomain failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// set bot
@@ -1508,8 +1513,8 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 			modifyBotStatusRequest.Status = &tmpStatus
 			modifyBotStatusRequest.Category = common.StringPtr("bot")
 
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyBotStatus(modifyBotStatusRequest)
+			err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyBotStatusWithContext(ctx, modifyBotStatusRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -1521,7 +1526,7 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 
 			if err != nil {
 				log.Printf("[CRITAL]%s modify waf saasDomain bot_status failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -1535,8 +1540,8 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 			modifyApiAnalyzeStatusRequest.InstanceId = &instanceID
 			modifyApiAnalyzeStatusRequest.Status = &apiSafeStatus
 
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyApiAnalyzeStatus(modifyApiAnalyzeStatusRequest)
+			err = retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyApiAnalyzeStatusWithContext(ctx, modifyApiAnalyzeStatusRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -1548,7 +1553,7 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 
 			if err != nil {
 				log.Printf("[CRITAL]%s modify waf saasDomain api_safe_status failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -1622,29 +1627,29 @@ func resourceTencentCloudWafSaasDomainUpdate(d *schema.ResourceData, meta interf
 	//	}
 	//}
 
-	return resourceTencentCloudWafSaasDomainRead(d, meta)
+	return resourceTencentCloudWafSaasDomainRead(ctx, d, meta)
 }
 
-func resourceTencentCloudWafSaasDomainDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafSaasDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_saas_domain.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId   = getLogId(contextNil)
-		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
 		service = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 3 {
-		return fmt.Errorf("id is broken,%s", idSplit)
+	idSplit, err := helper.IdParseN(d.Id(), 3)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	instanceID := idSplit[0]
 	domain := idSplit[1]
 
 	if err := service.DeleteWafSaasDomainById(ctx, instanceID, domain); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -27,6 +27,14 @@ resource "tencentcloud_waf_saas_instance" "example" {
   real_region      = "gz"
 }
 ```
+
+Import
+
+waf saas_instance can be imported using the id, e.g.
+
+```
+$ terraform import tencentcloud_waf_saas_instance.example waf_2kxtlbky00b2v1fn
+```
 */
 package tencentcloud
 
@@ -34,7 +42,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	waf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
@@ -43,10 +53,17 @@ import (
 
 func resourceTencentCloudWafSaasInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafSaasInstanceCreate,
-		Read:   resourceTencentCloudWafSaasInstanceRead,
-		Update: resourceTencentCloudWafSaasInstanceUpdate,
-		Delete: resourceTencentCloudWafSaasInstanceDelete,
+		CreateContext: resourceTencentCloudWafSaasInstanceCreate,
+		ReadContext:   resourceTencentCloudWafSaasInstanceRead,
+		UpdateContext: resourceTencentCloudWafSaasInstanceUpdate,
+		DeleteContext: resourceTencentCloudWafSaasInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"goods_category": {
@@ -143,7 +160,7 @@ func resourceTencentCloudWafSaasInstance() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudWafSaasInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafSaasInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_saas_instance.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -166,7 +183,7 @@ func resourceTencentCloudWafSaasInstanceCreate(d *schema.ResourceData, meta inte
 		mainlandMode = REGION_ID_NON_MAINLAND
 
 	} else {
-		return fmt.Errorf("Region only supports `ap-guangzhou` and `ap-seoul`.")
+		return diag.Errorf("Region only supports `ap-guangzhou` and `ap-seoul`.")
 	}
 
 	goods := []*waf.GoodNews{}
@@ -212,7 +229,7 @@ func resourceTencentCloudWafSaasInstanceCreate(d *schema.ResourceData, meta inte
 
 	if mainlandMode == REGION_ID_MAINLAND {
 		if !IsContains(SAAS_REAL_REGION_MAINLAND, realRegion) {
-			return fmt.Errorf("If Region is `ap-guangzhou`, parameter `real_region` is not legal")
+			return diag.Errorf("If Region is `ap-guangzhou`, parameter `real_region` is not legal")
 		}
 
 		instanceGood.RegionId = helper.IntInt64(mainlandMode)
@@ -221,7 +238,7 @@ func resourceTencentCloudWafSaasInstanceCreate(d *schema.ResourceData, meta inte
 
 	} else {
 		if !IsContains(SAAS_REAL_REGION_NON_MAINLAND, realRegion) {
-			return fmt.Errorf("If Region is `ap-seoul`, parameter `real_region` is not legal")
+			return diag.Errorf("If Region is `ap-seoul`, parameter `real_region` is not legal")
 		}
 
 		instanceGood.RegionId = helper.IntInt64(mainlandMode)
@@ -291,8 +308,8 @@ func resourceTencentCloudWafSaasInstanceCreate(d *schema.ResourceData, meta inte
 	//}
 
 	request.Goods = goods
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().GenerateDealsAndPayNew(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().GenerateDealsAndPayNewWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -309,7 +326,7 @@ func resourceTencentCloudWafSaasInstanceCreate(d *schema.ResourceData, meta inte
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create waf saas instance failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceId = *response.Response.InstanceId
@@ -323,8 +340,8 @@ func resourceTencentCloudWafSaasInstanceCreate(d *schema.ResourceData, meta inte
 			newSwitchElasticModeRequest.InstanceID = &instanceId
 			newSwitchElasticModeRequest.Mode = helper.IntInt64(elasticMode)
 			newSwitchElasticModeRequest.Edition = helper.String(EDITION_SAAS)
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().SwitchElasticMode(newSwitchElasticModeRequest)
+			err = retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().SwitchElasticModeWithContext(ctx, newSwitchElasticModeRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -336,28 +353,28 @@ func resourceTencentCloudWafSaasInstanceCreate(d *schema.ResourceData, meta inte
 
 			if err != nil {
 				log.Printf("[CRITAL]%s update waf saas instance elastic mode failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return resourceTencentCloudWafSaasInstanceRead(d, meta)
+	return resourceTencentCloudWafSaasInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudWafSaasInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafSaasInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_saas_instance.read")()
 	defer inconsistentCheck(d, meta)()
 
 	var (
 		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
 		service    = WafService{client: meta.(*TencentCloudClient).apiV3Conn}
 		instanceId = d.Id()
 	)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	instanceInfo, err := service.DescribeWafInstanceById(ctx, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if instanceInfo == nil {
@@ -418,7 +435,7 @@ func resourceTencentCloudWafSaasInstanceRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTencentCloudWafSaasInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafSaasInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_saas_instance.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -434,7 +451,7 @@ func resourceTencentCloudWafSaasInstanceUpdate(d *schema.ResourceData, meta inte
 
 	for _, v := range immutableArgs {
 		if d.HasChange(v) {
-			return fmt.Errorf("argument `%s` cannot be changed", v)
+			return diag.Errorf("argument `%s` cannot be changed", v)
 		}
 	}
 
@@ -443,8 +460,8 @@ func resourceTencentCloudWafSaasInstanceUpdate(d *schema.ResourceData, meta inte
 			modifyInstanceNameRequest.InstanceID = &instanceId
 			modifyInstanceNameRequest.InstanceName = helper.String(v.(string))
 			modifyInstanceNameRequest.Edition = helper.String(EDITION_SAAS)
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyInstanceName(modifyInstanceNameRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyInstanceNameWithContext(ctx, modifyInstanceNameRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -456,7 +473,7 @@ func resourceTencentCloudWafSaasInstanceUpdate(d *schema.ResourceData, meta inte
 
 			if err != nil {
 				log.Printf("[CRITAL]%s update waf saas instance name failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -465,8 +482,8 @@ func resourceTencentCloudWafSaasInstanceUpdate(d *schema.ResourceData, meta inte
 		if v, ok := d.GetOkExists("auto_renew_flag"); ok {
 			modifyInstanceRenewFlagRequest.InstanceId = &instanceId
 			modifyInstanceRenewFlagRequest.RenewFlag = helper.IntInt64(v.(int))
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyInstanceRenewFlag(modifyInstanceRenewFlagRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().ModifyInstanceRenewFlagWithContext(ctx, modifyInstanceRenewFlagRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -478,7 +495,7 @@ func resourceTencentCloudWafSaasInstanceUpdate(d *schema.ResourceData, meta inte
 
 			if err != nil {
 				log.Printf("[CRITAL]%s update waf saas instance auto renew flag failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -488,8 +505,8 @@ func resourceTencentCloudWafSaasInstanceUpdate(d *schema.ResourceData, meta inte
 			newSwitchElasticModeRequest.InstanceID = &instanceId
 			newSwitchElasticModeRequest.Mode = helper.IntInt64(v.(int))
 			newSwitchElasticModeRequest.Edition = helper.String(EDITION_SAAS)
-			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().SwitchElasticMode(newSwitchElasticModeRequest)
+			err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				result, e := meta.(*TencentCloudClient).apiV3Conn.UseWafClient().SwitchElasticModeWithContext(ctx, newSwitchElasticModeRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -501,17 +518,17 @@ func resourceTencentCloudWafSaasInstanceUpdate(d *schema.ResourceData, meta inte
 
 			if err != nil {
 				log.Printf("[CRITAL]%s update waf saas instance elastic mode failed, reason:%+v", logId, err)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return resourceTencentCloudWafSaasInstanceRead(d, meta)
+	return resourceTencentCloudWafSaasInstanceRead(ctx, d, meta)
 }
 
-func resourceTencentCloudWafSaasInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWafSaasInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_waf_saas_instance.delete")()
 	defer inconsistentCheck(d, meta)()
 
-	return fmt.Errorf("tencentcloud waf saas instance not supported delete, please contact the work order for processing")
+	return diag.Errorf("tencentcloud waf saas instance not supported delete, please contact the work order for processing")
 }
@@ -31,10 +31,10 @@ package tencentcloud
 import (
 	"context"
 	"encoding/base64"
-	"fmt"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	wedata "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/wedata/v20210820"
@@ -43,12 +43,16 @@ import (
 
 func resourceTencentCloudWedataRuleTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWedataRuleTemplateCreate,
-		Read:   resourceTencentCloudWedataRuleTemplateRead,
-		Update: resourceTencentCloudWedataRuleTemplateUpdate,
-		Delete: resourceTencentCloudWedataRuleTemplateDelete,
+		CreateContext: resourceTencentCloudWedataRuleTemplateCreate,
+		ReadContext:   resourceTencentCloudWedataRuleTemplateRead,
+		UpdateContext: resourceTencentCloudWedataRuleTemplateUpdate,
+		DeleteContext: resourceTencentCloudWedataRuleTemplateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"project_id": {
@@ -118,7 +122,7 @@ func resourceTencentCloudWedataRuleTemplate() *schema.Resource {
 	}
 }
 
-func resourceTencentCloudWedataRuleTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWedataRuleTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_wedata_rule_template.create")()
 	defer inconsistentCheck(d, meta)()
 
@@ -176,8 +180,8 @@ func resourceTencentCloudWedataRuleTemplateCreate(d *schema.ResourceData, meta i
 		request.WhereFlag = helper.Bool(v.(bool))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWedataClient().CreateRuleTemplate(request)
+	err := retryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		result, e := meta.(*TencentCloudClient).apiV3Conn.UseWedataClient().CreateRuleTemplateWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -188,35 +192,35 @@ func resourceTencentCloudWedataRuleTemplateCreate(d *schema.ResourceData, meta i
 	})
 	if err != nil {
 		log.Printf("[CRITAL]%s create wedata ruleTemplate failed, reason:%+v", logId, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	ruleTemplateId = *response.Response.Data
-	d.SetId(projectId + FILED_SP + helper.UInt64ToStr(ruleTemplateId))
+	d.SetId(helper.IdFormat(projectId, helper.UInt64ToStr(ruleTemplateId)))
 
-	return resourceTencentCloudWedataRuleTemplateRead(d, meta)
+	return resourceTencentCloudWedataRuleTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudWedataRuleTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWedataRuleTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_wedata_rule_template.read")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
 
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := WedataService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	projectId := idSplit[0]
 	ruleTemplateId := idSplit[1]
 
 	ruleTemplate, err := service.DescribeWedataRuleTemplateById(ctx, projectId, ruleTemplateId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if ruleTemplate == nil {
@@ -264,7 +268,7 @@ func resourceTencentCloudWedataRuleTemplateRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTencentCloudWedataRuleTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWedataRuleTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_wedata_rule_template.update")()
 	defer inconsistentCheck(d, meta)()
 
@@ -272,9 +276,9 @@ func resourceTencentCloudWedataRuleTemplateUpdate(d *schema.ResourceData, meta i
 
 	request := wedata.NewModifyRuleTemplateRequest()
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	projectId := idSplit[0]
 	ruleTemplateId := idSplit[1]
@@ -341,8 +345,8 @@ func resourceTencentCloudWedataRuleTemplateUpdate(d *schema.ResourceData, meta i
 			request.WhereFlag = helper.Bool(v.(bool))
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := meta.(*TencentCloudClient).apiV3Conn.UseWedataClient().ModifyRuleTemplate(request)
+		err := retryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			result, e := meta.(*TencentCloudClient).apiV3Conn.UseWedataClient().ModifyRuleTemplateWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -352,29 +356,29 @@ func resourceTencentCloudWedataRuleTemplateUpdate(d *schema.ResourceData, meta i
 		})
 		if err != nil {
 			log.Printf("[CRITAL]%s update wedata ruleTemplate failed, reason:%+v", logId, err)
-			return err
+			return diag.FromErr(err)
 		}
 	}
-	return resourceTencentCloudWedataRuleTemplateRead(d, meta)
+	return resourceTencentCloudWedataRuleTemplateRead(ctx, d, meta)
 }
 
-func resourceTencentCloudWedataRuleTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTencentCloudWedataRuleTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	defer logElapsed("resource.tencentcloud_wedata_rule_template.delete")()
 	defer inconsistentCheck(d, meta)()
 
 	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ctx = context.WithValue(ctx, logIdKey, logId)
 
 	service := WedataService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := helper.IdParseN(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	projectId := idSplit[0]
 	ruleTemplateId := idSplit[1]
 
 	if err := service.DeleteWedataRuleTemplateById(ctx, projectId, ruleTemplateId); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -25,8 +25,10 @@ func (me *SSLService) ApplyCertificate(ctx context.Context, request *ssl.ApplyCe
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSSLCertificateClient().ApplyCertificate(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSSLCertificateClient().ApplyCertificateWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -48,9 +50,11 @@ func (me *SSLService) ApplyCertificate(ctx context.Context, request *ssl.ApplyCe
 func (me *SSLService) CreateCertificate(ctx context.Context, request *ssl.CreateCertificateRequest) (certificateId, dealId string, errRet error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := client.CreateCertificate(request)
+	response, err := client.CreateCertificateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -74,9 +78,11 @@ func (me *SSLService) CreateCertificate(ctx context.Context, request *ssl.Create
 func (me *SSLService) CommitCertificateInformation(ctx context.Context, request *ssl.CommitCertificateInformationRequest) (errRet error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := client.CommitCertificateInformation(request)
+	response, err := client.CommitCertificateInformationWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -93,9 +99,11 @@ func (me *SSLService) CommitCertificateInformation(ctx context.Context, request
 func (me *SSLService) DescribeCertificateDetail(ctx context.Context, request *ssl.DescribeCertificateDetailRequest) (response *ssl.DescribeCertificateDetailResponse, err error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
+	if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return
+	}
 
-	response, err = client.DescribeCertificateDetail(request)
+	response, err = client.DescribeCertificateDetailWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -107,11 +115,13 @@ func (me *SSLService) DescribeCertificateDetail(ctx context.Context, request *ss
 func (me *SSLService) ModifyCertificateAlias(ctx context.Context, request *ssl.ModifyCertificateAliasRequest) (err error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
+	if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return
+	}
 
 	var response *ssl.ModifyCertificateAliasResponse
 
-	response, err = client.ModifyCertificateAlias(request)
+	response, err = client.ModifyCertificateAliasWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -127,11 +137,13 @@ func (me *SSLService) ModifyCertificateAlias(ctx context.Context, request *ssl.M
 func (me *SSLService) ModifyCertificateProject(ctx context.Context, request *ssl.ModifyCertificateProjectRequest) (err error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
+	if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return
+	}
 
 	var response *ssl.ModifyCertificateProjectResponse
 
-	response, err = client.ModifyCertificateProject(request)
+	response, err = client.ModifyCertificateProjectWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -154,11 +166,13 @@ func (me *SSLService) ModifyCertificateProject(ctx context.Context, request *ssl
 func (me *SSLService) DeleteCertificate(ctx context.Context, request *ssl.DeleteCertificateRequest) (deleteResult bool, err error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
+	if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return
+	}
 
 	var response *ssl.DeleteCertificateResponse
 
-	response, err = client.DeleteCertificate(request)
+	response, err = client.DeleteCertificateWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -175,11 +189,13 @@ func (me *SSLService) DeleteCertificate(ctx context.Context, request *ssl.Delete
 func (me *SSLService) CancelCertificateOrder(ctx context.Context, request *ssl.CancelCertificateOrderRequest) (err error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
+	if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return
+	}
 
 	var response *ssl.CancelCertificateOrderResponse
 
-	response, err = client.CancelCertificateOrder(request)
+	response, err = client.CancelCertificateOrderWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -195,11 +211,13 @@ func (me *SSLService) CancelCertificateOrder(ctx context.Context, request *ssl.C
 func (me *SSLService) SubmitCertificateInformation(ctx context.Context, request *ssl.SubmitCertificateInformationRequest) (err error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
+	if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return
+	}
 
 	var response *ssl.SubmitCertificateInformationResponse
 
-	response, err = client.SubmitCertificateInformation(request)
+	response, err = client.SubmitCertificateInformationWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -215,11 +233,13 @@ func (me *SSLService) SubmitCertificateInformation(ctx context.Context, request
 func (me *SSLService) UploadConfirmLetter(ctx context.Context, request *ssl.UploadConfirmLetterRequest) (err error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
+	if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return
+	}
 
 	var response *ssl.UploadConfirmLetterResponse
 
-	response, err = client.UploadConfirmLetter(request)
+	response, err = client.UploadConfirmLetterWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -235,10 +255,12 @@ func (me *SSLService) UploadConfirmLetter(ctx context.Context, request *ssl.Uplo
 func (me *SSLService) UploadCertificate(ctx context.Context, request *ssl.UploadCertificateRequest) (id string, err error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
+	if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return
+	}
 
 	var response *ssl.UploadCertificateResponse
-	response, err = client.UploadCertificate(request)
+	response, err = client.UploadCertificateWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 			logId, request.GetAction(), request.ToJsonString(), err)
@@ -271,8 +293,10 @@ func (me *SSLService) DescribeCertificates(ctx context.Context, request *ssl.Des
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
-		response, err = client.DescribeCertificates(request)
+		if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return
+		}
+		response, err = client.DescribeCertificatesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -318,9 +342,11 @@ func (me *SSLService) checkCertificateType(ctx context.Context, certId string, c
 func (me *SSLService) ModifyCertificateResubmit(ctx context.Context, request *ssl.ModifyCertificateResubmitRequest) (err error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
+	if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return
+	}
 
-	response, err := client.ModifyCertificateResubmit(request)
+	response, err := client.ModifyCertificateResubmitWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -341,7 +367,7 @@ func (me *SSLService) CancelAuditCertificate(ctx context.Context, request *ssl.C
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
 
-	response, err := client.CancelAuditCertificate(request)
+	response, err := client.CancelAuditCertificateWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
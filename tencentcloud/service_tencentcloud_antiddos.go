@@ -34,10 +34,12 @@ func (me *AntiddosService) DescribeListBGPIPInstances(ctx context.Context, insta
 	request.Offset = &offsetInt64
 	limitInt64 := uint64(limit)
 	request.Limit = &limitInt64
-	ratelimit.Check(request.GetAction())
+	if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return
+	}
 	var response *antiddos.DescribeListBGPIPInstancesResponse
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		response, err = me.client.UseAntiddosClient().DescribeListBGPIPInstances(request)
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		response, err = me.client.UseAntiddosClient().DescribeListBGPIPInstancesWithContext(ctx, request)
 
 		if e, ok := err.(*errors.TencentCloudSDKError); ok {
 			if e.GetCode() == "InternalError.ClusterNotFound" {
@@ -67,8 +69,8 @@ func (me *AntiddosService) AssociateDDoSEipAddress(ctx context.Context, instance
 	request.CvmInstanceID = common.StringPtr(cvmInstanceID)
 	request.CvmRegion = common.StringPtr(cvmRegion)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().AssociateDDoSEipAddress(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().AssociateDDoSEipAddressWithContext(ctx, request)
 
 		if e, ok := err.(*errors.TencentCloudSDKError); ok {
 			if e.GetCode() == "InternalError.ClusterNotFound" {
@@ -98,8 +100,8 @@ func (me *AntiddosService) AssociateDDoSEipLoadBalancer(ctx context.Context, ins
 	request.LoadBalancerID = common.StringPtr(loadBalancerID)
 	request.LoadBalancerRegion = common.StringPtr(loadBalancerRegion)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().AssociateDDoSEipLoadBalancer(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().AssociateDDoSEipLoadBalancerWithContext(ctx, request)
 
 		if e, ok := err.(*errors.TencentCloudSDKError); ok {
 			if e.GetCode() == "InternalError.ClusterNotFound" {
@@ -127,8 +129,8 @@ func (me *AntiddosService) DisassociateDDoSEipAddress(ctx context.Context, insta
 	request.InstanceId = common.StringPtr(instanceId)
 	request.Eip = common.StringPtr(eip)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().DisassociateDDoSEipAddress(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().DisassociateDDoSEipAddressWithContext(ctx, request)
 
 		if e, ok := err.(*errors.TencentCloudSDKError); ok {
 			if e.GetCode() == "InternalError.ClusterNotFound" {
@@ -156,8 +158,8 @@ func (me *AntiddosService) DescribeListProtectThresholdConfig(ctx context.Contex
 	request.Limit = helper.IntUint64(1)
 	request.Offset = helper.Int64Uint64(0)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, err := me.client.UseAntiddosClient().DescribeListProtectThresholdConfig(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		response, err := me.client.UseAntiddosClient().DescribeListProtectThresholdConfigWithContext(ctx, request)
 		configList := response.Response.ConfigList
 		if len(configList) > 0 {
 			result = *configList[0]
@@ -185,8 +187,10 @@ func (me *AntiddosService) DescribeListBlackWhiteIpList(ctx context.Context, ins
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
-		response, e := me.client.UseAntiddosClient().DescribeListBlackWhiteIpList(request)
+		if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return
+		}
+		response, e := me.client.UseAntiddosClient().DescribeListBlackWhiteIpListWithContext(ctx, request)
 		if e != nil {
 			err = e
 			return
@@ -212,8 +216,10 @@ func (me *AntiddosService) DescribeListPortAclList(ctx context.Context, instance
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
-		response, e := me.client.UseAntiddosClient().DescribeListPortAclList(request)
+		if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return
+		}
+		response, e := me.client.UseAntiddosClient().DescribeListPortAclListWithContext(ctx, request)
 		if e != nil {
 			err = e
 			return
@@ -236,8 +242,8 @@ func (me *AntiddosService) DescribeListProtocolBlockConfig(ctx context.Context,
 	request.Limit = helper.IntInt64(1)
 	request.Offset = helper.IntInt64(0)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, err := me.client.UseAntiddosClient().DescribeListProtocolBlockConfig(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		response, err := me.client.UseAntiddosClient().DescribeListProtocolBlockConfigWithContext(ctx, request)
 		configList := response.Response.ConfigList
 		if len(configList) > 0 {
 			result = *configList[0]
@@ -262,8 +268,8 @@ func (me *AntiddosService) DescribeDDoSConnectLimitList(ctx context.Context, ins
 	request.Limit = helper.IntUint64(1)
 	request.Offset = helper.IntUint64(0)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, err := me.client.UseAntiddosClient().DescribeDDoSConnectLimitList(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		response, err := me.client.UseAntiddosClient().DescribeDDoSConnectLimitListWithContext(ctx, request)
 		configList := response.Response.ConfigList
 		if len(configList) > 0 {
 			result = *configList[0].ConnectLimitConfig
@@ -288,8 +294,8 @@ func (me *AntiddosService) DescribeListDDoSAI(ctx context.Context, instanceId st
 	request.Limit = helper.IntInt64(1)
 	request.Offset = helper.IntInt64(0)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, err := me.client.UseAntiddosClient().DescribeListDDoSAI(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		response, err := me.client.UseAntiddosClient().DescribeListDDoSAIWithContext(ctx, request)
 		configList := response.Response.ConfigList
 		if len(configList) > 0 {
 			result = *configList[0]
@@ -316,8 +322,10 @@ func (me *AntiddosService) DescribeListDDoSGeoIPBlockConfig(ctx context.Context,
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
-		response, e := me.client.UseAntiddosClient().DescribeListDDoSGeoIPBlockConfig(request)
+		if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return
+		}
+		response, e := me.client.UseAntiddosClient().DescribeListDDoSGeoIPBlockConfigWithContext(ctx, request)
 		if e != nil {
 			err = e
 			return
@@ -342,8 +350,10 @@ func (me *AntiddosService) DescribeListDDoSSpeedLimitConfig(ctx context.Context,
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
-		response, e := me.client.UseAntiddosClient().DescribeListDDoSSpeedLimitConfig(request)
+		if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return
+		}
+		response, e := me.client.UseAntiddosClient().DescribeListDDoSSpeedLimitConfigWithContext(ctx, request)
 		if e != nil {
 			err = e
 			return
@@ -367,8 +377,8 @@ func (me *AntiddosService) DescribeListPacketFilterConfig(ctx context.Context, i
 	request.Limit = helper.IntInt64(1)
 	request.Offset = helper.IntInt64(0)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, err := me.client.UseAntiddosClient().DescribeListPacketFilterConfig(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		response, err := me.client.UseAntiddosClient().DescribeListPacketFilterConfigWithContext(ctx, request)
 		configList := response.Response.ConfigList
 		if len(configList) > 0 {
 			result = configList
@@ -393,8 +403,8 @@ func (me *AntiddosService) DescribeListWaterPrintConfig(ctx context.Context, ins
 	request.Limit = helper.IntInt64(1)
 	request.Offset = helper.IntInt64(0)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, err := me.client.UseAntiddosClient().DescribeListWaterPrintConfig(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		response, err := me.client.UseAntiddosClient().DescribeListWaterPrintConfigWithContext(ctx, request)
 		configList := response.Response.ConfigList
 		if len(configList) > 0 {
 			result = *configList[0]
@@ -424,8 +434,8 @@ func (me *AntiddosService) CreateDDoSBlackWhiteIpList(ctx context.Context, insta
 	request.IpList = requestIpList
 	request.Type = common.StringPtr(ipType)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().CreateDDoSBlackWhiteIpList(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().CreateDDoSBlackWhiteIpListWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -445,8 +455,8 @@ func (me *AntiddosService) ModifyDDoSThreshold(ctx context.Context, business, in
 	request.Business = common.StringPtr(business)
 	request.Id = common.StringPtr(instanceId)
 	request.Threshold = helper.IntUint64(threshold)
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().ModifyDDoSThreshold(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().ModifyDDoSThresholdWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceUnavailable" {
@@ -473,8 +483,8 @@ func (me *AntiddosService) ModifyDDoSLevel(ctx context.Context, business, instan
 	request.Method = common.StringPtr("set")
 	request.DDoSLevel = common.StringPtr(ddosLevel)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().ModifyDDoSLevel(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().ModifyDDoSLevelWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -494,8 +504,8 @@ func (me *AntiddosService) CreatePortAclConfig(ctx context.Context, instanceId s
 	request.AclConfig = &aclConfig
 	request.InstanceId = &instanceId
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().CreatePortAclConfig(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().CreatePortAclConfigWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceInUse" {
@@ -520,8 +530,8 @@ func (me *AntiddosService) CreateProtocolBlockConfig(ctx context.Context, instan
 	request.InstanceId = &instanceId
 	request.ProtocolBlockConfig = &protocolBlockConfig
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().CreateProtocolBlockConfig(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().CreateProtocolBlockConfigWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -541,8 +551,8 @@ func (me *AntiddosService) CreateDDoSConnectLimit(ctx context.Context, instanceI
 	request.InstanceId = &instanceId
 	request.ConnectLimitConfig = &connectLimitConfig
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().CreateDDoSConnectLimit(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().CreateDDoSConnectLimitWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -562,8 +572,8 @@ func (me *AntiddosService) CreateDDoSAI(ctx context.Context, instanceId, ddosAI
 	request.DDoSAI = &ddosAI
 	request.InstanceIdList = []*string{&instanceId}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().CreateDDoSAI(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().CreateDDoSAIWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -583,8 +593,8 @@ func (me *AntiddosService) CreateDDoSGeoIPBlockConfig(ctx context.Context, insta
 	request.InstanceId = &instanceId
 	request.DDoSGeoIPBlockConfig = &ddosGeoIPBlockConfig
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().CreateDDoSGeoIPBlockConfig(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().CreateDDoSGeoIPBlockConfigWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -604,8 +614,8 @@ func (me *AntiddosService) CreateDDoSSpeedLimitConfig(ctx context.Context, insta
 	request.InstanceId = &instanceId
 	request.DDoSSpeedLimitConfig = &ddosSpeedLimitConfig
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().CreateDDoSSpeedLimitConfig(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().CreateDDoSSpeedLimitConfigWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -625,8 +635,8 @@ func (me *AntiddosService) CreatePacketFilterConfig(ctx context.Context, instanc
 	request.InstanceId = &instanceId
 	request.PacketFilterConfig = &packetFilterConfig
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().CreatePacketFilterConfig(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().CreatePacketFilterConfigWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -653,8 +663,8 @@ func (me *AntiddosService) DeleteDDoSBlackWhiteIpList(ctx context.Context, insta
 		})
 	}
 	request.IpList = ipList
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().DeleteDDoSBlackWhiteIpList(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().DeleteDDoSBlackWhiteIpListWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -673,8 +683,8 @@ func (me *AntiddosService) DeletePortAclConfig(ctx context.Context, instanceId s
 	request := antiddos.NewDeletePortAclConfigRequest()
 	request.InstanceId = &instanceId
 	request.AclConfig = &aclConfig
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().DeletePortAclConfig(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().DeletePortAclConfigWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -701,8 +711,8 @@ func (me *AntiddosService) DeleteProtocolBlockConfig(ctx context.Context, instan
 	}
 	request.ProtocolBlockConfig = &protocolBlockConfig
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().CreateProtocolBlockConfig(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().CreateProtocolBlockConfigWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -733,8 +743,8 @@ func (me *AntiddosService) DeleteDDoSConnectLimit(ctx context.Context, instanceI
 	}
 	request.ConnectLimitConfig = &connectLimitConfig
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().CreateDDoSConnectLimit(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().CreateDDoSConnectLimitWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -754,8 +764,8 @@ func (me *AntiddosService) DeleteDDoSAI(ctx context.Context, instanceId string)
 	request.DDoSAI = common.StringPtr("off")
 	request.InstanceIdList = []*string{&instanceId}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().CreateDDoSAI(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().CreateDDoSAIWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -775,8 +785,8 @@ func (me *AntiddosService) DeleteDDoSGeoIPBlockConfig(ctx context.Context, insta
 	request.InstanceId = &instanceId
 	request.DDoSGeoIPBlockConfig = &ddosGeoIPBlockConfig
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().DeleteDDoSGeoIPBlockConfig(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().DeleteDDoSGeoIPBlockConfigWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -796,8 +806,8 @@ func (me *AntiddosService) DeleteDDoSSpeedLimitConfig(ctx context.Context, insta
 	request.InstanceId = &instanceId
 	request.DDoSSpeedLimitConfig = &ddosSpeedLimitConfig
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().DeleteDDoSSpeedLimitConfig(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().DeleteDDoSSpeedLimitConfigWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -817,8 +827,8 @@ func (me *AntiddosService) DeletePacketFilterConfig(ctx context.Context, instanc
 	request.InstanceId = &instanceId
 	request.PacketFilterConfig = &packetFilterConfig
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().DeletePacketFilterConfig(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().DeletePacketFilterConfigWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -838,8 +848,8 @@ func (me *AntiddosService) DeleteDDoSThreshold(ctx context.Context, business, in
 	request.Business = common.StringPtr(business)
 	request.Id = common.StringPtr(instanceId)
 	request.Threshold = helper.IntUint64(0)
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().ModifyDDoSThreshold(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().ModifyDDoSThresholdWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceUnavailable" {
@@ -866,8 +876,8 @@ func (me *AntiddosService) DeleteyDDoSLevel(ctx context.Context, business, insta
 	request.Method = common.StringPtr("set")
 	request.DDoSLevel = common.StringPtr("middle")
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().ModifyDDoSLevel(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().ModifyDDoSLevelWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -891,8 +901,10 @@ func (me *AntiddosService) DescribeCCThresholdList(ctx context.Context, business
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
-		response, e := me.client.UseAntiddosClient().DescribeCCThresholdList(request)
+		if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return
+		}
+		response, e := me.client.UseAntiddosClient().DescribeCCThresholdListWithContext(ctx, request)
 		if e != nil {
 			err = e
 			return
@@ -916,8 +928,8 @@ func (me *AntiddosService) ModifyCCThresholdPolicy(ctx context.Context, instance
 	request.Ip = &ip
 	request.Protocol = &protocol
 	request.Threshold = helper.IntInt64(threshold)
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().ModifyCCThresholdPolicy(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().ModifyCCThresholdPolicyWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceUnavailable" {
@@ -946,8 +958,10 @@ func (me *AntiddosService) DescribeCcGeoIPBlockConfigList(ctx context.Context, b
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
-		response, e := me.client.UseAntiddosClient().DescribeCcGeoIPBlockConfigList(request)
+		if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return
+		}
+		response, e := me.client.UseAntiddosClient().DescribeCcGeoIPBlockConfigListWithContext(ctx, request)
 		if e != nil {
 			err = e
 			return
@@ -971,8 +985,8 @@ func (me *AntiddosService) CreateCcGeoIPBlockConfig(ctx context.Context, instanc
 	request.IP = &ip
 	request.Protocol = &protocol
 	request.CcGeoIPBlockConfig = &ccGeoIPBlockConfig
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().CreateCcGeoIPBlockConfig(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().CreateCcGeoIPBlockConfigWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -991,8 +1005,8 @@ func (me *AntiddosService) DeleteCcGeoIPBlockConfig(ctx context.Context, instanc
 	request := antiddos.NewDeleteCcGeoIPBlockConfigRequest()
 	request.InstanceId = &instanceId
 	request.CcGeoIPBlockConfig = &ccGeoIPBlockConfig
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().DeleteCcGeoIPBlockConfig(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().DeleteCcGeoIPBlockConfigWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -1016,8 +1030,10 @@ func (me *AntiddosService) DescribeCcBlackWhiteIpList(ctx context.Context, busin
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
-		response, e := me.client.UseAntiddosClient().DescribeCcBlackWhiteIpList(request)
+		if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return
+		}
+		response, e := me.client.UseAntiddosClient().DescribeCcBlackWhiteIpListWithContext(ctx, request)
 		if e != nil {
 			err = e
 			return
@@ -1049,8 +1065,8 @@ func (me *AntiddosService) CreateCcBlackWhiteIpList(ctx context.Context, instanc
 		})
 	}
 	request.IpList = ipLists
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().CreateCcBlackWhiteIpList(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().CreateCcBlackWhiteIpListWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -1069,8 +1085,8 @@ func (me *AntiddosService) DeleteCcBlackWhiteIpList(ctx context.Context, instanc
 	request := antiddos.NewDeleteCcBlackWhiteIpListRequest()
 	request.InstanceId = &instanceId
 	request.PolicyId = &policyId
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().DeleteCcBlackWhiteIpList(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().DeleteCcBlackWhiteIpListWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -1094,8 +1110,10 @@ func (me *AntiddosService) DescribeCCPrecisionPlyList(ctx context.Context, busin
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
-		response, e := me.client.UseAntiddosClient().DescribeCCPrecisionPlyList(request)
+		if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return
+		}
+		response, e := me.client.UseAntiddosClient().DescribeCCPrecisionPlyListWithContext(ctx, request)
 		if e != nil {
 			err = e
 			return
@@ -1120,8 +1138,8 @@ func (me *AntiddosService) CreateCCPrecisionPolicy(ctx context.Context, instance
 	request.Protocol = &protocol
 	request.PolicyAction = &policyAction
 	request.PolicyList = policyList
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().CreateCCPrecisionPolicy(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().CreateCCPrecisionPolicyWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -1140,8 +1158,8 @@ func (me *AntiddosService) DeleteCCPrecisionPolicy(ctx context.Context, instance
 	request := antiddos.NewDeleteCCPrecisionPolicyRequest()
 	request.InstanceId = &instanceId
 	request.PolicyId = &policyId
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().DeleteCCPrecisionPolicy(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().DeleteCCPrecisionPolicyWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -1163,8 +1181,8 @@ func (me *AntiddosService) ModifyCCLevelPolicy(ctx context.Context, instanceId,
 	request.Domain = &domain
 	request.Protocol = &protocol
 	request.Level = &level
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().ModifyCCLevelPolicy(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().ModifyCCLevelPolicyWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -1188,8 +1206,10 @@ func (me *AntiddosService) DescribeCCReqLimitPolicyList(ctx context.Context, bus
 	request.Offset = &offset
 
 	for {
-		ratelimit.Check(request.GetAction())
-		response, e := me.client.UseAntiddosClient().DescribeCCReqLimitPolicyList(request)
+		if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return
+		}
+		response, e := me.client.UseAntiddosClient().DescribeCCReqLimitPolicyListWithContext(ctx, request)
 		if e != nil {
 			err = e
 			return
@@ -1213,8 +1233,8 @@ func (me *AntiddosService) CreateCCReqLimitPolicy(ctx context.Context, instanceI
 	request.Ip = &ip
 	request.Protocol = &protocol
 	request.Policy = &ccReqLimitPolicyRecord
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().CreateCCReqLimitPolicy(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().CreateCCReqLimitPolicyWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -1233,8 +1253,8 @@ func (me *AntiddosService) DeleteCCRequestLimitPolicy(ctx context.Context, insta
 	request := antiddos.NewDeleteCCRequestLimitPolicyRequest()
 	request.InstanceId = &instanceId
 	request.PolicyId = &policyId
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().DeleteCCRequestLimitPolicy(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().DeleteCCRequestLimitPolicyWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -1255,8 +1275,10 @@ func (me *AntiddosService) DescribeCCLevelPolicy(ctx context.Context, domain, in
 	request.Ip = &ip
 	request.Protocol = &protocol
 
-	ratelimit.Check(request.GetAction())
-	response, e := me.client.UseAntiddosClient().DescribeCCLevelPolicy(request)
+	if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return
+	}
+	response, e := me.client.UseAntiddosClient().DescribeCCLevelPolicyWithContext(ctx, request)
 	if e != nil {
 		err = e
 		return
@@ -1275,8 +1297,10 @@ func (me *AntiddosService) DescribeListBGPIPInstanceById(ctx context.Context, bu
 	request.FilterInstanceId = &instanceId
 
 	for {
-		ratelimit.Check(request.GetAction())
-		response, e := me.client.UseAntiddosClient().DescribeListBGPIPInstances(request)
+		if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return
+		}
+		response, e := me.client.UseAntiddosClient().DescribeListBGPIPInstancesWithContext(ctx, request)
 		if e != nil {
 			err = e
 			return
@@ -1302,8 +1326,10 @@ func (me *AntiddosService) DescribeListBGPInstanceById(ctx context.Context, busi
 	request.FilterInstanceId = &instanceId
 
 	for {
-		ratelimit.Check(request.GetAction())
-		response, e := me.client.UseAntiddosClient().DescribeListBGPInstances(request)
+		if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return
+		}
+		response, e := me.client.UseAntiddosClient().DescribeListBGPInstancesWithContext(ctx, request)
 		if e != nil {
 			err = e
 			return
@@ -1330,8 +1356,10 @@ func (me *AntiddosService) DescribeCCLevelList(ctx context.Context, business, in
 	request.Business = &business
 
 	for {
-		ratelimit.Check(request.GetAction())
-		response, e := me.client.UseAntiddosClient().DescribeCCLevelList(request)
+		if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return
+		}
+		response, e := me.client.UseAntiddosClient().DescribeCCLevelListWithContext(ctx, request)
 		if e != nil {
 			err = e
 			return
@@ -1354,8 +1382,8 @@ func (me *AntiddosService) DeleteCCLevelPolicy(ctx context.Context, instanceId,
 	request.Ip = &ip
 	request.Domain = &domain
 	request.Protocol = common.StringPtr("http")
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().DeleteCCLevelPolicy(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().DeleteCCLevelPolicyWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -1376,8 +1404,8 @@ func (me *AntiddosService) DeleteCCThresholdPolicy(ctx context.Context, instance
 	request.Ip = &ip
 	request.Domain = &domain
 	request.Protocol = common.StringPtr("http")
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseAntiddosClient().DeleteCCThresholdPolicy(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseAntiddosClient().DeleteCCThresholdPolicyWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -1405,9 +1433,11 @@ func (me *AntiddosService) DescribeAntiddosBoundipById(ctx context.Context, id s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseAntiddosClient().DescribeListBGPInstances(request)
+	response, err := me.client.UseAntiddosClient().DescribeListBGPInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -19,7 +19,9 @@ func (me *APIService) DescribeZonesWithProduct(ctx context.Context, product stri
 	request := api.NewDescribeZonesRequest()
 	request.Product = common.StringPtr(product)
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 	// API: https://cloud.tencent.com/document/product/1278/55254
 	response, err := me.client.UseApiClient().DescribeZones(request)
 	if err != nil {
@@ -21,8 +21,10 @@ type APIGatewayService struct {
 func (me *APIGatewayService) CreateApiKey(ctx context.Context, secretName string) (accessKeyId string, errRet error) {
 	request := apigateway.NewCreateApiKeyRequest()
 	request.SecretName = &secretName
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().CreateApiKey(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().CreateApiKeyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -38,8 +40,10 @@ func (me *APIGatewayService) CreateApiKey(ctx context.Context, secretName string
 func (me *APIGatewayService) EnableApiKey(ctx context.Context, accessKeyId string) (errRet error) {
 	request := apigateway.NewEnableApiKeyRequest()
 	request.AccessKeyId = &accessKeyId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().EnableApiKey(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().EnableApiKeyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -58,8 +62,10 @@ func (me *APIGatewayService) EnableApiKey(ctx context.Context, accessKeyId strin
 func (me *APIGatewayService) DisableApiKey(ctx context.Context, accessKeyId string) (errRet error) {
 	request := apigateway.NewDisableApiKeyRequest()
 	request.AccessKeyId = &accessKeyId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().DisableApiKey(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().DisableApiKeyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -114,8 +120,10 @@ func (me *APIGatewayService) DescribeApiKeysStatus(ctx context.Context, secretNa
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseAPIGatewayClient().DescribeApiKeysStatus(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseAPIGatewayClient().DescribeApiKeysStatusWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -137,8 +145,10 @@ func (me *APIGatewayService) DescribeApiKeysStatus(ctx context.Context, secretNa
 func (me *APIGatewayService) DeleteApiKey(ctx context.Context, accessKeyId string) (errRet error) {
 	request := apigateway.NewDeleteApiKeyRequest()
 	request.AccessKeyId = &accessKeyId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().DeleteApiKey(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().DeleteApiKeyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -167,10 +177,12 @@ func (me *APIGatewayService) CreateUsagePlan(ctx context.Context, usagePlanName
 		request.UsagePlanDesc = usagePlanDesc
 	}
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := me.client.UseAPIGatewayClient().CreateUsagePlan(request)
+		response, err := me.client.UseAPIGatewayClient().CreateUsagePlanWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s API[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
 			return retryError(err)
@@ -189,9 +201,11 @@ func (me *APIGatewayService) DescribeUsagePlan(ctx context.Context, usagePlanId
 	request := apigateway.NewDescribeUsagePlanRequest()
 	request.UsagePlanId = &usagePlanId
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseAPIGatewayClient().DescribeUsagePlan(request)
+	response, err := me.client.UseAPIGatewayClient().DescribeUsagePlanWithContext(ctx, request)
 	if err != nil {
 		if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok && sdkErr.GetCode() == "ResourceNotFound.InvalidUsagePlan" {
 			return
@@ -212,9 +226,11 @@ func (me *APIGatewayService) DeleteUsagePlan(ctx context.Context, usagePlanId st
 	request := apigateway.NewDeleteUsagePlanRequest()
 	request.UsagePlanId = &usagePlanId
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseAPIGatewayClient().DeleteUsagePlan(request)
+	response, err := me.client.UseAPIGatewayClient().DeleteUsagePlanWithContext(ctx, request)
 
 	if err != nil {
 		return err
@@ -240,7 +256,9 @@ func (me *APIGatewayService) ModifyUsagePlan(ctx context.Context,
 	request := apigateway.NewModifyUsagePlanRequest()
 	request.UsagePlanId = &usagePlanId
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 	request.UsagePlanName = &usagePlanName
 	if usagePlanDesc != nil {
 		request.UsagePlanDesc = usagePlanDesc
@@ -248,9 +266,11 @@ func (me *APIGatewayService) ModifyUsagePlan(ctx context.Context,
 	request.MaxRequestNum = &maxRequestNum
 	request.MaxRequestNumPreSec = &maxRequestNumPreSec
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseAPIGatewayClient().ModifyUsagePlan(request)
+	response, err := me.client.UseAPIGatewayClient().ModifyUsagePlanWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -276,8 +296,10 @@ func (me *APIGatewayService) DescribeUsagePlanEnvironments(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseAPIGatewayClient().DescribeUsagePlanEnvironments(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseAPIGatewayClient().DescribeUsagePlanEnvironmentsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -321,8 +343,10 @@ func (me *APIGatewayService) DescribeUsagePlansStatus(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseAPIGatewayClient().DescribeUsagePlansStatus(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseAPIGatewayClient().DescribeUsagePlansStatusWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -357,8 +381,10 @@ func (me *APIGatewayService) DescribeIPStrategysStatus(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().DescribeIPStrategysStatus(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().DescribeIPStrategysStatusWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -389,8 +415,10 @@ func (me *APIGatewayService) DescribeIPStrategies(ctx context.Context, serviceId
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseAPIGatewayClient().DescribeIPStrategy(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseAPIGatewayClient().DescribeIPStrategyWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -423,8 +451,10 @@ func (me *APIGatewayService) DescribeServiceSubDomains(ctx context.Context, serv
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseAPIGatewayClient().DescribeServiceSubDomains(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseAPIGatewayClient().DescribeServiceSubDomainsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -450,8 +480,10 @@ func (me *APIGatewayService) BindSecretId(ctx context.Context,
 	request.UsagePlanId = &usagePlanId
 	request.AccessKeyIds = []*string{&apiKeyId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().BindSecretIds(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().BindSecretIdsWithContext(ctx, request)
 
 	if err != nil {
 		return err
@@ -475,8 +507,10 @@ func (me *APIGatewayService) BindSecretIds(ctx context.Context, usagePlanId stri
 		request.AccessKeyIds = append(request.AccessKeyIds, &v)
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().BindSecretIds(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().BindSecretIdsWithContext(ctx, request)
 
 	if err != nil {
 		return err
@@ -510,8 +544,10 @@ func (me *APIGatewayService) UnBindSecretId(ctx context.Context,
 	request.UsagePlanId = &usagePlanId
 	request.AccessKeyIds = []*string{&apiKeyId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().UnBindSecretIds(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().UnBindSecretIdsWithContext(ctx, request)
 
 	if err != nil {
 		return err
@@ -562,8 +598,10 @@ func (me *APIGatewayService) CreateService(ctx context.Context,
 	}
 	request.NetTypes = helper.Strings(netTypes)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().CreateService(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().CreateServiceWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -577,8 +615,10 @@ func (me *APIGatewayService) DescribeService(ctx context.Context, serviceId stri
 	request := apigateway.NewDescribeServiceRequest()
 	request.ServiceId = &serviceId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().DescribeService(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().DescribeServiceWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok && sdkError.Code == SERVICE_ERR_CODE {
 			return
@@ -605,8 +645,10 @@ func (me *APIGatewayService) ModifyService(ctx context.Context,
 	request.ServiceDesc = &serviceDesc
 	request.NetTypes = helper.Strings(netTypes)
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseAPIGatewayClient().ModifyService(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseAPIGatewayClient().ModifyServiceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -620,8 +662,10 @@ func (me *APIGatewayService) DeleteService(ctx context.Context,
 	request := apigateway.NewDeleteServiceRequest()
 	request.ServiceId = &serviceId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().DeleteService(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().DeleteServiceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -642,8 +686,10 @@ func (me *APIGatewayService) UnReleaseService(ctx context.Context, serviceId, en
 	request.ServiceId = &serviceId
 	request.EnvironmentName = &environment
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().UnReleaseService(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().UnReleaseServiceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -670,8 +716,10 @@ func (me *APIGatewayService) DescribeServiceUsagePlan(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseAPIGatewayClient().DescribeServiceUsagePlan(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseAPIGatewayClient().DescribeServiceUsagePlanWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -702,8 +750,10 @@ func (me *APIGatewayService) DescribeApiUsagePlan(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseAPIGatewayClient().DescribeApiUsagePlan(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseAPIGatewayClient().DescribeApiUsagePlanWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -734,8 +784,10 @@ func (me *APIGatewayService) DescribeApiUsagePlanSecretIds(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseAPIGatewayClient().DescribeUsagePlanSecretIds(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseAPIGatewayClient().DescribeUsagePlanSecretIdsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -768,10 +820,12 @@ func (me *APIGatewayService) BindEnvironment(ctx context.Context,
 		request.ApiIds = []*string{&apiId}
 	}
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := me.client.UseAPIGatewayClient().BindEnvironment(request)
+		response, err := me.client.UseAPIGatewayClient().BindEnvironmentWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s API[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
 			return retryError(err)
@@ -806,9 +860,11 @@ func (me *APIGatewayService) UnBindSecretIds(ctx context.Context, usagePlanId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseAPIGatewayClient().UnBindSecretIds(request)
+	response, err := me.client.UseAPIGatewayClient().UnBindSecretIdsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -831,10 +887,12 @@ func (me *APIGatewayService) UnBindEnvironment(ctx context.Context,
 		request.ApiIds = []*string{&apiId}
 	}
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, errRet := me.client.UseAPIGatewayClient().UnBindEnvironment(request)
+		response, errRet := me.client.UseAPIGatewayClient().UnBindEnvironmentWithContext(ctx, request)
 		if errRet != nil {
 			return retryError(errRet)
 		}
@@ -861,9 +919,11 @@ func (me *APIGatewayService) DescribeApi(ctx context.Context,
 	request.ServiceId = &serviceId
 	request.ApiId = &apiId
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseAPIGatewayClient().DescribeApi(request)
+	response, err := me.client.UseAPIGatewayClient().DescribeApiWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*errors.TencentCloudSDKError); ok && sdkError.Code == SERVICE_ERR_CODE || sdkError.Code == API_ERR_CODE {
 
@@ -888,8 +948,10 @@ func (me *APIGatewayService) DeleteApi(ctx context.Context, serviceId,
 	request := apigateway.NewDeleteApiRequest()
 	request.ServiceId = &serviceId
 	request.ApiId = &apiId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().DeleteApi(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().DeleteApiWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -933,8 +995,10 @@ func (me *APIGatewayService) DescribeServicesStatus(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseAPIGatewayClient().DescribeServicesStatus(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseAPIGatewayClient().DescribeServicesStatusWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -981,8 +1045,10 @@ func (me *APIGatewayService) DescribeApisStatus(ctx context.Context,
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseAPIGatewayClient().DescribeApisStatus(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseAPIGatewayClient().DescribeApisStatusWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1022,9 +1088,11 @@ func (me *APIGatewayService) DescribeServiceEnvironmentStrategyList(ctx context.
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			response, err = me.client.UseAPIGatewayClient().DescribeServiceEnvironmentStrategy(request)
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			response, err = me.client.UseAPIGatewayClient().DescribeServiceEnvironmentStrategyWithContext(ctx, request)
 			if err != nil {
 				return retryError(err, InternalError)
 			}
@@ -1083,9 +1151,11 @@ func (me *APIGatewayService) DescribeApiEnvironmentStrategyList(ctx context.Cont
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			response, err = me.client.UseAPIGatewayClient().DescribeApiEnvironmentStrategy(request)
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			response, err = me.client.UseAPIGatewayClient().DescribeApiEnvironmentStrategyWithContext(ctx, request)
 			if err != nil {
 				return retryError(err, InternalError)
 			}
@@ -1129,9 +1199,11 @@ func (me *APIGatewayService) ModifyApiEnvironmentStrategy(ctx context.Context,
 	request.EnvironmentName = &environmentName
 	request.ApiIds = append(request.ApiIds, helper.Strings(apiIDs)...)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = me.client.UseAPIGatewayClient().ModifyApiEnvironmentStrategy(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = me.client.UseAPIGatewayClient().ModifyApiEnvironmentStrategyWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -1167,9 +1239,11 @@ func (me *APIGatewayService) ModifyServiceEnvironmentStrategy(ctx context.Contex
 	request.Strategy = &strategy
 	request.EnvironmentNames = append(request.EnvironmentNames, helper.Strings(environmentName)...)
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = me.client.UseAPIGatewayClient().ModifyServiceEnvironmentStrategy(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = me.client.UseAPIGatewayClient().ModifyServiceEnvironmentStrategyWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -1218,9 +1292,11 @@ func (me *APIGatewayService) BindSubDomainService(ctx context.Context,
 		request.PathMappingSet = append(request.PathMappingSet, pathTmp)
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err = me.client.UseAPIGatewayClient().BindSubDomain(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err = me.client.UseAPIGatewayClient().BindSubDomainWithContext(ctx, request)
 		if err != nil {
 			if ee, ok := err.(*errors.TencentCloudSDKError); ok {
 				if ee.Code == CERTIFI_CATE_ID_EXPIRED || ee.Code == CERTIFICATE_ID_UNDER_VERIFY ||
@@ -1254,9 +1330,11 @@ func (me *APIGatewayService) DescribeServiceSubDomainsService(ctx context.Contex
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			response, err = me.client.UseAPIGatewayClient().DescribeServiceSubDomains(request)
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			response, err = me.client.UseAPIGatewayClient().DescribeServiceSubDomainsWithContext(ctx, request)
 			if err != nil {
 				return retryError(err, InternalError)
 			}
@@ -1290,9 +1368,11 @@ func (me *APIGatewayService) DescribeServiceSubDomainMappings(ctx context.Contex
 	request.ServiceId = &serviceId
 	request.SubDomain = &subDomain
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = me.client.UseAPIGatewayClient().DescribeServiceSubDomainMappings(request)
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = me.client.UseAPIGatewayClient().DescribeServiceSubDomainMappingsWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -1341,9 +1421,11 @@ func (me *APIGatewayService) ModifySubDomainService(ctx context.Context,
 		request.PathMappingSet = append(request.PathMappingSet, pathTmp)
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = me.client.UseAPIGatewayClient().ModifySubDomain(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = me.client.UseAPIGatewayClient().ModifySubDomainWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -1376,9 +1458,11 @@ func (me *APIGatewayService) UnBindSubDomainService(ctx context.Context,
 	request.ServiceId = &serviceId
 	request.SubDomain = &subDomain
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = me.client.UseAPIGatewayClient().UnBindSubDomain(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = me.client.UseAPIGatewayClient().UnBindSubDomainWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -1408,8 +1492,10 @@ func (me *APIGatewayService) CreateIPStrategy(ctx context.Context,
 	request.StrategyType = &strategyType
 	request.StrategyData = &strategyData
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().CreateIPStrategy(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().CreateIPStrategyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1428,8 +1514,10 @@ func (me *APIGatewayService) DescribeIPStrategyHas(ctx context.Context,
 	request := apigateway.NewDescribeIPStrategysStatusRequest()
 	request.ServiceId = &serviceId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().DescribeIPStrategysStatus(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().DescribeIPStrategysStatusWithContext(ctx, request)
 	if err != nil {
 		if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok && sdkErr.Code == SERVICE_ERR_CODE {
 			return false, nil
@@ -1470,8 +1558,10 @@ func (me *APIGatewayService) DescribeIPStrategyStatus(ctx context.Context, servi
 		for {
 			request.Limit = &limit
 			request.Offset = &offset
-			ratelimit.Check(request.GetAction())
-			response, err := me.client.UseAPIGatewayClient().DescribeIPStrategy(request)
+			if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+				return
+			}
+			response, err := me.client.UseAPIGatewayClient().DescribeIPStrategyWithContext(ctx, request)
 			if err != nil {
 				errRet = err
 				return
@@ -1500,8 +1590,10 @@ func (me *APIGatewayService) UpdateIPStrategy(ctx context.Context, serviceId, st
 	request.StrategyId = &strategyId
 	request.ServiceId = &serviceId
 	request.StrategyData = &strategyData
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().ModifyIPStrategy(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().ModifyIPStrategyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1522,8 +1614,10 @@ func (me *APIGatewayService) DeleteIPStrategy(ctx context.Context, serviceId, st
 	request.StrategyId = &strategyId
 	request.ServiceId = &serviceId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().DeleteIPStrategy(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().DeleteIPStrategyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1548,8 +1642,10 @@ func (me *APIGatewayService) CreateStrategyAttachment(ctx context.Context,
 	request.EnvironmentName = &envName
 	request.BindApiIds = bindarr
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().BindIPStrategy(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().BindIPStrategyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1571,8 +1667,10 @@ func (me *APIGatewayService) DeleteStrategyAttachment(ctx context.Context,
 	request.EnvironmentName = &envName
 	request.UnBindApiIds = unBindarr
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().UnBindIPStrategy(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().UnBindIPStrategyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1615,9 +1713,11 @@ func (me *APIGatewayService) ReleaseService(ctx context.Context,
 	request.EnvironmentName = &environmentName
 	request.ReleaseDesc = &releaseDesc
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = me.client.UseAPIGatewayClient().ReleaseService(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = me.client.UseAPIGatewayClient().ReleaseServiceWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -1643,8 +1743,10 @@ func (me *APIGatewayService) DescribeServiceEnvironmentReleaseHistory(ctx contex
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseAPIGatewayClient().DescribeServiceEnvironmentReleaseHistory(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseAPIGatewayClient().DescribeServiceEnvironmentReleaseHistoryWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*errors.TencentCloudSDKError); ok && sdkError.Code == SERVICE_ERR_CODE {
 				return
@@ -1680,9 +1782,11 @@ func (me *APIGatewayService) DescribeApiGatewayPluginById(ctx context.Context, p
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseAPIGatewayClient().DescribePlugins(request)
+	response, err := me.client.UseAPIGatewayClient().DescribePluginsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1709,9 +1813,11 @@ func (me *APIGatewayService) DeleteApiGatewayPluginById(ctx context.Context, plu
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseAPIGatewayClient().DeletePlugin(request)
+	response, err := me.client.UseAPIGatewayClient().DeletePluginWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1733,9 +1839,11 @@ func (me *APIGatewayService) DescribeApiGatewayPluginAttachmentById(ctx context.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseAPIGatewayClient().DescribePluginApis(request)
+	response, err := me.client.UseAPIGatewayClient().DescribePluginApisWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1770,9 +1878,11 @@ func (me *APIGatewayService) DeleteApiGatewayPluginAttachmentById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseAPIGatewayClient().DetachPlugin(request)
+	response, err := me.client.UseAPIGatewayClient().DetachPluginWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1796,8 +1906,10 @@ func (me *APIGatewayService) DescribeApiDoc(ctx context.Context, apiDocId string
 	}()
 
 	request.ApiDocId = &apiDocId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().DescribeAPIDocDetail(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().DescribeAPIDocDetailWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1834,8 +1946,10 @@ func (me *APIGatewayService) DescribeApiDocList(ctx context.Context) (apiDoc []*
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseAPIGatewayClient().DescribeAPIDocs(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseAPIGatewayClient().DescribeAPIDocsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1872,8 +1986,10 @@ func (me *APIGatewayService) DeleteAPIGatewayAPIDocById(ctx context.Context, api
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().DeleteAPIDoc(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().DeleteAPIDocWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1904,8 +2020,10 @@ func (me *APIGatewayService) DescribeApiApp(ctx context.Context, apiAppId string
 		},
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().DescribeApiAppsStatus(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().DescribeApiAppsStatusWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1959,8 +2077,10 @@ func (me *APIGatewayService) DescribeApiAppList(ctx context.Context, apiAppId, a
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseAPIGatewayClient().DescribeApiAppsStatus(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseAPIGatewayClient().DescribeApiAppsStatusWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1994,8 +2114,10 @@ func (me *APIGatewayService) DeleteAPIGatewayAPIAppById(ctx context.Context, api
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAPIGatewayClient().DeleteApiApp(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAPIGatewayClient().DeleteApiAppWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2032,9 +2154,11 @@ func (me *APIGatewayService) DescribeAPIGatewayApiAppAttachmentById(ctx context.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseAPIGatewayClient().DescribeApiAppBindApisStatus(request)
+	response, err := me.client.UseAPIGatewayClient().DescribeApiAppBindApisStatusWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2064,9 +2188,11 @@ func (me *APIGatewayService) DeleteAPIGatewayApiAppAttachmentById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseAPIGatewayClient().UnbindApiApp(request)
+	response, err := me.client.UseAPIGatewayClient().UnbindApiAppWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2096,9 +2222,11 @@ func (me *APIGatewayService) DescribeApigatewayUpstreamById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseAPIGatewayClient().DescribeUpstreams(request)
+	response, err := me.client.UseAPIGatewayClient().DescribeUpstreamsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2126,9 +2254,11 @@ func (me *APIGatewayService) DeleteApigatewayUpstreamById(ctx context.Context, u
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseAPIGatewayClient().DeleteUpstream(request)
+	response, err := me.client.UseAPIGatewayClient().DeleteUpstreamWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2163,7 +2293,9 @@ func (me *APIGatewayService) DescribeAPIGatewayPluginByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -2172,7 +2304,7 @@ func (me *APIGatewayService) DescribeAPIGatewayPluginByFilter(ctx context.Contex
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseAPIGatewayClient().DescribeAllPluginApis(request)
+		response, err := me.client.UseAPIGatewayClient().DescribeAllPluginApisWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2215,7 +2347,9 @@ func (me *APIGatewayService) DescribeAPIGatewayUpstreamByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -2224,7 +2358,7 @@ func (me *APIGatewayService) DescribeAPIGatewayUpstreamByFilter(ctx context.Cont
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseAPIGatewayClient().DescribeUpstreamBindApis(request)
+		response, err := me.client.UseAPIGatewayClient().DescribeUpstreamBindApisWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2264,7 +2398,9 @@ func (me *APIGatewayService) DescribeAPIGatewayApiUsagePlanByFilter(ctx context.
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -2274,7 +2410,7 @@ func (me *APIGatewayService) DescribeAPIGatewayApiUsagePlanByFilter(ctx context.
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseAPIGatewayClient().DescribeApiUsagePlan(request)
+		response, err := me.client.UseAPIGatewayClient().DescribeApiUsagePlanWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2318,9 +2454,11 @@ func (me *APIGatewayService) DescribeAPIGatewayApiAppServiceByFilter(ctx context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseAPIGatewayClient().DescribeServiceForApiApp(request)
+	response, err := me.client.UseAPIGatewayClient().DescribeServiceForApiAppWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -25,9 +25,11 @@ func (me *ApmService) DescribeApmInstanceById(ctx context.Context, instanceId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseApmClient().DescribeApmInstances(request)
+	response, err := me.client.UseApmClient().DescribeApmInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -54,9 +56,11 @@ func (me *ApmService) DeleteApmInstanceById(ctx context.Context, instanceId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseApmClient().TerminateApmInstance(request)
+	response, err := me.client.UseApmClient().TerminateApmInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -21,8 +21,10 @@ func (me *AsService) DescribeLaunchConfigurationById(ctx context.Context, config
 	logId := getLogId(ctx)
 	request := as.NewDescribeLaunchConfigurationsRequest()
 	request.LaunchConfigurationIds = []*string{&configurationId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAsClient().DescribeLaunchConfigurations(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAsClient().DescribeLaunchConfigurationsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -62,8 +64,10 @@ func (me *AsService) DescribeLaunchConfigurationByFilter(ctx context.Context, co
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseAsClient().DescribeLaunchConfigurations(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseAsClient().DescribeLaunchConfigurationsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -86,8 +90,10 @@ func (me *AsService) DeleteLaunchConfiguration(ctx context.Context, configuratio
 	logId := getLogId(ctx)
 	request := as.NewDeleteLaunchConfigurationRequest()
 	request.LaunchConfigurationId = &configurationId
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseAsClient().DeleteLaunchConfiguration(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseAsClient().DeleteLaunchConfigurationWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -100,8 +106,10 @@ func (me *AsService) DescribeAutoScalingGroupById(ctx context.Context, scalingGr
 	logId := getLogId(ctx)
 	request := as.NewDescribeAutoScalingGroupsRequest()
 	request.AutoScalingGroupIds = []*string{&scalingGroupId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAsClient().DescribeAutoScalingGroups(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAsClient().DescribeAutoScalingGroupsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -158,8 +166,10 @@ func (me *AsService) DescribeAutoScalingGroupByFilter(
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseAsClient().DescribeAutoScalingGroups(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseAsClient().DescribeAutoScalingGroupsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -186,8 +196,10 @@ func (me *AsService) ClearScalingGroupInstance(ctx context.Context, scalingGroup
 	request.MinSize = helper.IntUint64(0)
 	request.MaxSize = helper.IntUint64(0)
 	request.DesiredCapacity = helper.IntUint64(0)
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseAsClient().ModifyAutoScalingGroup(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseAsClient().ModifyAutoScalingGroupWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -200,8 +212,10 @@ func (me *AsService) DeleteScalingGroup(ctx context.Context, scalingGroupId stri
 	logId := getLogId(ctx)
 	request := as.NewDeleteAutoScalingGroupRequest()
 	request.AutoScalingGroupId = &scalingGroupId
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseAsClient().DeleteAutoScalingGroup(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseAsClient().DeleteAutoScalingGroupWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -218,8 +232,10 @@ func (me *AsService) AttachInstances(ctx context.Context, scalingGroupId string,
 	for i := range instanceIds {
 		request.InstanceIds = append(request.InstanceIds, &instanceIds[i])
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAsClient().AttachInstances(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseAsClient().AttachInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -227,7 +243,7 @@ func (me *AsService) AttachInstances(ctx context.Context, scalingGroupId string,
 	}
 	activityId := *response.Response.ActivityId
 
-	err = resource.Retry(4*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, 4*readRetryTimeout, func() *resource.RetryError {
 		status, err := me.DescribeActivityById(ctx, activityId)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -250,8 +266,10 @@ func (me *AsService) DescribeActivityById(ctx context.Context, activityId string
 	logId := getLogId(ctx)
 	request := as.NewDescribeAutoScalingActivitiesRequest()
 	request.ActivityIds = []*string{&activityId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAsClient().DescribeAutoScalingActivities(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAsClient().DescribeAutoScalingActivitiesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -273,8 +291,10 @@ func (me *AsService) DetachInstances(ctx context.Context, scalingGroupId string,
 	for i := range instanceIds {
 		request.InstanceIds = append(request.InstanceIds, &instanceIds[i])
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAsClient().DetachInstances(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseAsClient().DetachInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -282,7 +302,7 @@ func (me *AsService) DetachInstances(ctx context.Context, scalingGroupId string,
 	}
 	activityId := *response.Response.ActivityId
 
-	err = resource.Retry(4*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, 4*readRetryTimeout, func() *resource.RetryError {
 		status, err := me.DescribeActivityById(ctx, activityId)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -310,8 +330,10 @@ func (me *AsService) DescribeAutoScalingAttachment(ctx context.Context, scalingG
 			Values: []*string{&scalingGroupId},
 		},
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAsClient().DescribeAutoScalingInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAsClient().DescribeAutoScalingInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -332,8 +354,10 @@ func (me *AsService) DescribeScalingPolicyById(ctx context.Context, scalingPolic
 	logId := getLogId(ctx)
 	request := as.NewDescribeScalingPoliciesRequest()
 	request.AutoScalingPolicyIds = []*string{&scalingPolicyId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAsClient().DescribeScalingPolicies(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAsClient().DescribeScalingPoliciesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -380,8 +404,10 @@ func (me *AsService) DescribeScalingPolicyByFilter(ctx context.Context, policyId
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseAsClient().DescribeScalingPolicies(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseAsClient().DescribeScalingPoliciesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -404,8 +430,10 @@ func (me *AsService) DeleteScalingPolicy(ctx context.Context, scalingPolicyId st
 	logId := getLogId(ctx)
 	request := as.NewDeleteScalingPolicyRequest()
 	request.AutoScalingPolicyId = &scalingPolicyId
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseAsClient().DeleteScalingPolicy(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseAsClient().DeleteScalingPolicyWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -418,8 +446,10 @@ func (me *AsService) DescribeScheduledActionById(ctx context.Context, scheduledA
 	logId := getLogId(ctx)
 	request := as.NewDescribeScheduledActionsRequest()
 	request.ScheduledActionIds = []*string{&scheduledActionId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAsClient().DescribeScheduledActions(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAsClient().DescribeScheduledActionsWithContext(ctx, request)
 	if err != nil {
 		sdkErr, ok := err.(*sdkErrors.TencentCloudSDKError)
 		if ok && sdkErr.Code == AsScheduleNotFound {
@@ -449,8 +479,10 @@ func (me *AsService) ModifyAutoScalingGroup(ctx context.Context, request *as.Mod
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAsClient().ModifyAutoScalingGroup(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAsClient().ModifyAutoScalingGroupWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -467,8 +499,10 @@ func (me *AsService) DeleteScheduledAction(ctx context.Context, scheduledActonId
 	logId := getLogId(ctx)
 	request := as.NewDeleteScheduledActionRequest()
 	request.ScheduledActionId = &scheduledActonId
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseAsClient().DeleteScheduledAction(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseAsClient().DeleteScheduledActionWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -481,8 +515,10 @@ func (me *AsService) DescribeLifecycleHookById(ctx context.Context, lifecycleHoo
 	logId := getLogId(ctx)
 	request := as.NewDescribeLifecycleHooksRequest()
 	request.LifecycleHookIds = []*string{&lifecycleHookId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAsClient().DescribeLifecycleHooks(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAsClient().DescribeLifecycleHooksWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -501,8 +537,10 @@ func (me *AsService) DeleteLifecycleHook(ctx context.Context, lifecycleHookId st
 	logId := getLogId(ctx)
 	request := as.NewDeleteLifecycleHookRequest()
 	request.LifecycleHookId = &lifecycleHookId
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseAsClient().DeleteLifecycleHook(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseAsClient().DeleteLifecycleHookWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -515,8 +553,10 @@ func (me *AsService) DescribeNotificationById(ctx context.Context, notificationI
 	logId := getLogId(ctx)
 	request := as.NewDescribeNotificationConfigurationsRequest()
 	request.AutoScalingNotificationIds = []*string{&notificationId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAsClient().DescribeNotificationConfigurations(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAsClient().DescribeNotificationConfigurationsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -535,8 +575,10 @@ func (me *AsService) DeleteNotification(ctx context.Context, notificationId stri
 	logId := getLogId(ctx)
 	request := as.NewDeleteNotificationConfigurationRequest()
 	request.AutoScalingNotificationId = &notificationId
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseAsClient().DeleteNotificationConfiguration(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseAsClient().DeleteNotificationConfigurationWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -566,7 +608,9 @@ func (me *AsService) DescribeAsInstancesByFilter(ctx context.Context, param map[
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -575,7 +619,7 @@ func (me *AsService) DescribeAsInstancesByFilter(ctx context.Context, param map[
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseAsClient().DescribeAutoScalingInstances(request)
+		response, err := me.client.UseAsClient().DescribeAutoScalingInstancesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -642,9 +686,11 @@ func (me *AsService) DescribeAsAdvices(ctx context.Context, param map[string]int
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseAsClient().DescribeAutoScalingAdvices(request)
+	response, err := me.client.UseAsClient().DescribeAutoScalingAdvicesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -668,9 +714,11 @@ func (me *AsService) DescribeAsLimits(ctx context.Context) (limits *as.DescribeA
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseAsClient().DescribeAccountLimits(request)
+	response, err := me.client.UseAsClient().DescribeAccountLimitsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -700,9 +748,11 @@ func (me *AsService) DescribeAsLastActivity(ctx context.Context, param map[strin
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseAsClient().DescribeAutoScalingGroupLastActivities(request)
+	response, err := me.client.UseAsClient().DescribeAutoScalingGroupLastActivitiesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -726,9 +776,11 @@ func (me *AsService) DescribeAsLoadBalancerById(ctx context.Context, autoScaling
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseAsClient().DescribeAutoScalingGroups(request)
+	response, err := me.client.UseAsClient().DescribeAutoScalingGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -755,9 +807,11 @@ func (me *AsService) DeleteAsLoadBalancerById(ctx context.Context, autoScalingGr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseAsClient().DetachLoadBalancers(request)
+	response, err := me.client.UseAsClient().DetachLoadBalancersWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
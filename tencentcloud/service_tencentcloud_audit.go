@@ -31,9 +31,11 @@ func (me *AuditService) DescribeAuditById(ctx context.Context, name string) (aud
 	}()
 
 	var response *audit.DescribeAuditResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseAuditClient().DescribeAudit(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := me.client.UseAuditClient().DescribeAuditWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s %s fail, reason:%s\n", logId, request.GetAction(), e.Error())
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
@@ -63,8 +65,10 @@ func (me *AuditService) DescribeAuditCosRegions(ctx context.Context) (regions []
 	logId := getLogId(ctx)
 	request := audit.NewListCosEnableRegionRequest()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAuditClient().ListCosEnableRegion(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAuditClient().ListCosEnableRegionWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -82,8 +86,10 @@ func (me *AuditService) DescribeAuditCmqRegions(ctx context.Context) (regions []
 	logId := getLogId(ctx)
 	request := audit.NewListCmqEnableRegionRequest()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAuditClient().ListCmqEnableRegion(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAuditClient().ListCmqEnableRegionWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -101,8 +107,10 @@ func (me *AuditService) DescribeKeyAlias(ctx context.Context, region string) (ke
 	logId := getLogId(ctx)
 	request := audit.NewListKeyAliasByRegionRequest()
 	request.KmsRegion = &region
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAuditClient().ListKeyAliasByRegion(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAuditClient().ListKeyAliasByRegionWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -130,7 +138,7 @@ func (me *AuditService) DescribeAuditTrackById(ctx context.Context, trackId stri
 	}()
 	request.TrackId = helper.StrToUint64Point(trackId)
 
-	response, err := me.client.UseAuditClient().DescribeAuditTrack(request)
+	response, err := me.client.UseAuditClient().DescribeAuditTrackWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -157,8 +165,10 @@ func (me *AuditService) DeleteAuditTrackById(ctx context.Context, trackId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseAuditClient().DeleteAuditTrack(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseAuditClient().DeleteAuditTrackWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -29,8 +29,10 @@ func (me *CamService) DescribeRoleById(ctx context.Context, roleId string) (camI
 	for {
 		request.Page = &pageStart
 		request.Rp = &rp
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCamClient().DescribeRoleList(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCamClient().DescribeRoleListWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -71,8 +73,10 @@ func (me *CamService) DescribeRolesByFilter(ctx context.Context, params map[stri
 	for {
 		request.Page = &pageStart
 		request.Rp = &rp
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCamClient().DescribeRoleList(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCamClient().DescribeRoleListWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -117,8 +121,10 @@ func (me *CamService) DeleteRoleById(ctx context.Context, roleId string) error {
 	logId := getLogId(ctx)
 	request := cam.NewDeleteRoleRequest()
 	request.RoleId = &roleId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCamClient().DeleteRole(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCamClient().DeleteRoleWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -135,8 +141,10 @@ func (me *CamService) DeleteRoleByName(ctx context.Context, roleName string) err
 	logId := getLogId(ctx)
 	request := cam.NewDeleteRoleRequest()
 	request.RoleName = &roleName
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCamClient().DeleteRole(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCamClient().DeleteRoleWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -149,9 +157,9 @@ func (me *CamService) DeleteRoleByName(ctx context.Context, roleName string) err
 }
 
 func (me *CamService) decodeCamPolicyAttachmentId(id string) (instanceId string, policyId64 uint64, errRet error) {
-	items := strings.Split(id, "#")
-	if len(items) != 2 {
-		return instanceId, policyId64, fmt.Errorf(" id is not exist %s", id)
+	items, err := helper.IdParseN(id, 2)
+	if err != nil {
+		return instanceId, policyId64, err
 	}
 	instanceId = items[0]
 	policyId, e := strconv.Atoi(items[1])
@@ -173,8 +181,10 @@ func (me *CamService) DescribeRolePolicyAttachmentByName(ctx context.Context, ro
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.RoleName = &roleName
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCamClient().ListAttachedRolePolicies(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCamClient().ListAttachedRolePoliciesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -227,8 +237,10 @@ func (me *CamService) DescribeRolePolicyAttachmentById(ctx context.Context, role
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.RoleId = &roleId
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCamClient().ListAttachedRolePolicies(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCamClient().ListAttachedRolePoliciesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -277,8 +289,10 @@ func (me *CamService) DescribeRolePolicyAttachmentsByFilter(ctx context.Context,
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.RoleId = &roleId
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCamClient().ListAttachedRolePolicies(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCamClient().ListAttachedRolePoliciesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -329,8 +343,10 @@ func (me *CamService) DeleteRolePolicyAttachmentByName(ctx context.Context, role
 	request := cam.NewDetachRolePolicyRequest()
 	request.DetachRoleName = &roleName
 	request.PolicyName = &policyName
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCamClient().DetachRolePolicy(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCamClient().DetachRolePolicyWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -350,8 +366,10 @@ func (me *CamService) DeleteRolePolicyAttachmentById(ctx context.Context, rolePo
 	request := cam.NewDetachRolePolicyRequest()
 	request.DetachRoleId = &roleId
 	request.PolicyId = &policyId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCamClient().DetachRolePolicy(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCamClient().DetachRolePolicyWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -386,8 +404,10 @@ func (me *CamService) DescribeUserPolicyAttachmentById(ctx context.Context, user
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.TargetUin = uin
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCamClient().ListAttachedUserPolicies(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCamClient().ListAttachedUserPoliciesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -444,8 +464,10 @@ func (me *CamService) DescribeUserPolicyAttachmentsByFilter(ctx context.Context,
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.TargetUin = uin
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCamClient().ListAttachedUserPolicies(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCamClient().ListAttachedUserPoliciesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -510,8 +532,10 @@ func (me *CamService) AddUserPolicyAttachment(ctx context.Context, userId string
 	request := cam.NewAttachUserPolicyRequest()
 	request.AttachUin = uin
 	request.PolicyId = &policyIdInt64
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCamClient().AttachUserPolicy(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCamClient().AttachUserPolicyWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -540,8 +564,10 @@ func (me *CamService) DeleteUserPolicyAttachmentById(ctx context.Context, userPo
 	request := cam.NewDetachUserPolicyRequest()
 	request.DetachUin = uin
 	request.PolicyId = &policyId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCamClient().DetachUserPolicy(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCamClient().DetachUserPolicyWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -573,8 +599,10 @@ func (me *CamService) DescribeGroupPolicyAttachmentById(ctx context.Context, gro
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.TargetGroupId = &groupIdInt64
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCamClient().ListAttachedGroupPolicies(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCamClient().ListAttachedGroupPoliciesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -628,8 +656,10 @@ func (me *CamService) DescribeGroupPolicyAttachmentsByFilter(ctx context.Context
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.TargetGroupId = &groupIdInt64
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCamClient().ListAttachedGroupPolicies(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCamClient().ListAttachedGroupPoliciesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -693,8 +723,10 @@ func (me *CamService) AddGroupPolicyAttachment(ctx context.Context, groupId stri
 	request := cam.NewAttachGroupPolicyRequest()
 	request.AttachGroupId = &groupIdInt64
 	request.PolicyId = &policyIdInt64
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCamClient().AttachGroupPolicy(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCamClient().AttachGroupPolicyWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -720,8 +752,10 @@ func (me *CamService) DeleteGroupPolicyAttachmentById(ctx context.Context, group
 	request := cam.NewDetachGroupPolicyRequest()
 	request.DetachGroupId = &groupIdInt64
 	request.PolicyId = &policyId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCamClient().DetachGroupPolicy(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCamClient().DetachGroupPolicyWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -742,7 +776,7 @@ func (me *CamService) DescribePolicyById(ctx context.Context, policyId string) (
 	}
 	policyIdInt64 := uint64(policyIdInt)
 	request.PolicyId = &policyIdInt64
-	result, err := me.client.UseCamClient().GetPolicy(request)
+	result, err := me.client.UseCamClient().GetPolicyWithContext(ctx, request)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM policy failed, reason:%s\n", logId, err.Error())
@@ -797,8 +831,10 @@ func (me *CamService) DescribePoliciesByFilter(ctx context.Context, params map[s
 	for {
 		request.Page = &pageStart
 		request.Rp = &rp
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCamClient().ListPolicies(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCamClient().ListPoliciesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s read CAM policy failed, reason:%s\n", logId, err.Error())
 			if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -851,8 +887,10 @@ func (me *CamService) DescribeUserById(ctx context.Context, userId string) (resp
 	logId := getLogId(ctx)
 	request := cam.NewGetUserRequest()
 	request.Name = &userId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCamClient().GetUser(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCamClient().GetUserWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -877,8 +915,10 @@ func (me *CamService) DescribeUsersByFilter(ctx context.Context, params map[stri
 
 	result = make([]*cam.SubAccountInfo, 0)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCamClient().ListUsers(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCamClient().ListUsersWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -980,8 +1020,10 @@ func (me *CamService) DescribeGroupById(ctx context.Context, groupId string) (ca
 	}
 	groupIdInt64 := uint64(groupIdInt)
 	request.GroupId = &groupIdInt64
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCamClient().GetGroup(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCamClient().GetGroupWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1010,8 +1052,10 @@ func (me *CamService) DescribeGroupsByFilter(ctx context.Context, params map[str
 	for {
 		request.Page = &pageStart
 		request.Rp = &rp
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCamClient().ListGroups(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCamClient().ListGroupsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1074,7 +1118,7 @@ func (me *CamService) DescribeGroupMembershipById(ctx context.Context, groupId s
 	for {
 		request.Page = &pageStart
 		request.Rp = &rp
-		response, err := me.client.UseCamClient().ListUsersForGroup(request)
+		response, err := me.client.UseCamClient().ListUsersForGroupWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s read CAM group membership failed, reason:%s\n", logId, err.Error())
 			if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1107,7 +1151,7 @@ func (me *CamService) DescribeSAMLProviderById(ctx context.Context, providerName
 	logId := getLogId(ctx)
 	request := cam.NewGetSAMLProviderRequest()
 	request.Name = &providerName
-	result, err := me.client.UseCamClient().GetSAMLProvider(request)
+	result, err := me.client.UseCamClient().GetSAMLProviderWithContext(ctx, request)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s read cam SAML provider failed, reason:%s\n", logId, err.Error())
@@ -1144,8 +1188,10 @@ func (me *CamService) DescribeSAMLProvidersByFilter(ctx context.Context, params
 		}
 	}
 	providers = make([]*cam.SAMLProviderInfo, 0)
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCamClient().ListSAMLProviders(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCamClient().ListSAMLProvidersWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM SAML provider failed, reason:%s\n", logId, err.Error())
 		if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1234,7 +1280,7 @@ func (me *CamService) DescribeCamServiceLinkedRole(ctx context.Context, roleId s
 	}()
 	request.RoleId = &roleId
 
-	response, err := me.client.UseCamClient().GetRole(request)
+	response, err := me.client.UseCamClient().GetRoleWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1265,8 +1311,10 @@ func (me *CamService) DeleteCamServiceLinkedRoleById(ctx context.Context, roleId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCamClient().DeleteServiceLinkedRole(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCamClient().DeleteServiceLinkedRoleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return "", err
@@ -1294,7 +1342,7 @@ func (me *CamService) DescribeCamServiceLinkedRoleDeleteStatus(ctx context.Conte
 	}()
 	request.DeletionTaskId = &deletionTaskId
 
-	response, err := me.client.UseCamClient().GetServiceLinkedRoleDeletionStatus(request)
+	response, err := me.client.UseCamClient().GetServiceLinkedRoleDeletionStatusWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1318,9 +1366,11 @@ func (me *CamService) DescribeCamUserSamlConfigById(ctx context.Context) (userSa
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCamClient().DescribeUserSAMLConfig(request)
+	response, err := me.client.UseCamClient().DescribeUserSAMLConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1344,9 +1394,11 @@ func (me *CamService) DeleteCamUserSamlConfigById(ctx context.Context) (errRet e
 
 	request.Operate = helper.String("disable")
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCamClient().UpdateUserSAMLConfig(request)
+	response, err := me.client.UseCamClient().UpdateUserSAMLConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1367,9 +1419,11 @@ func (me *CamService) DescribeCamMfaFlagById(ctx context.Context, id uint64) (lo
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCamClient().DescribeSafeAuthFlagColl(request)
+	response, err := me.client.UseCamClient().DescribeSafeAuthFlagCollWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -28,7 +28,9 @@ func (me *CatService) DescribeCatTaskSet(ctx context.Context, taskId string) (ta
 	}()
 
 	request.TaskIDs = []*string{helper.String(taskId)}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset int64 = 0
 	var pageSize int64 = 100
@@ -37,8 +39,10 @@ func (me *CatService) DescribeCatTaskSet(ctx context.Context, taskId string) (ta
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCatClient().DescribeProbeTasks(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCatClient().DescribeProbeTasksWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -79,8 +83,10 @@ func (me *CatService) DeleteCatTaskSetById(ctx context.Context, taskId string) (
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCatClient().DeleteProbeTask(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCatClient().DeleteProbeTaskWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -127,8 +133,10 @@ func (me *CatService) DescribeCatNodeByFilter(ctx context.Context, param map[str
 
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCatClient().DescribeProbeNodes(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCatClient().DescribeProbeNodesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -222,8 +230,10 @@ func (me *CatService) DescribeCatProbeDataByFilter(ctx context.Context, param ma
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCatClient().DescribeDetailedSingleProbeData(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCatClient().DescribeDetailedSingleProbeDataWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
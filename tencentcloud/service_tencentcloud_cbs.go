@@ -53,8 +53,10 @@ func (me *CbsService) DescribeDiskList(ctx context.Context, diskIds []*string) (
 	request := cbs.NewDescribeDisksRequest()
 	request.DiskIds = diskIds
 	request.Limit = helper.IntUint64(100)
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCbsClient().DescribeDisks(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCbsClient().DescribeDisksWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -93,8 +95,10 @@ func (me *CbsService) DescribeDisksByFilter(ctx context.Context, params map[stri
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCbsClient().DescribeDisks(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCbsClient().DescribeDisksWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -135,7 +139,7 @@ func (me *CbsService) DescribeDisksInParallelByFilter(ctx context.Context, param
 		}
 		request.Filters = append(request.Filters, filter)
 	}
-	response, err := me.client.UseCbsClient().DescribeDisks(request)
+	response, err := me.client.UseCbsClient().DescribeDisksWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -179,8 +183,12 @@ func (me *CbsService) DescribeDisksInParallelByFilter(ctx context.Context, param
 			request.Offset = helper.IntUint64(offset)
 			request.Limit = helper.IntUint64(limit)
 
-			ratelimit.Check(request.GetAction())
-			response, err := me.client.UseCbsClient().DescribeDisks(request)
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				errRet = err
+				wg.Done()
+				return
+			}
+			response, err := me.client.UseCbsClient().DescribeDisksWithContext(ctx, request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -215,8 +223,10 @@ func (me *CbsService) ModifyDiskAttributes(ctx context.Context, diskId, diskName
 	if projectId >= 0 {
 		request.ProjectId = helper.IntUint64(projectId)
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCbsClient().ModifyDiskAttributes(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCbsClient().ModifyDiskAttributesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -238,8 +248,10 @@ func (me *CbsService) DeleteDiskSetByIds(ctx context.Context, diskSetIds string)
 	}
 
 	request.DiskIds = helper.StringsStringsPoint(diskSet)
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCbsClient().TerminateDisks(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCbsClient().TerminateDisksWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -254,8 +266,10 @@ func (me *CbsService) DeleteDiskById(ctx context.Context, diskId string) error {
 	logId := getLogId(ctx)
 	request := cbs.NewTerminateDisksRequest()
 	request.DiskIds = []*string{&diskId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCbsClient().TerminateDisks(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCbsClient().TerminateDisksWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -271,8 +285,10 @@ func (me *CbsService) ResizeDisk(ctx context.Context, diskId string, diskSize in
 	request := cbs.NewResizeDiskRequest()
 	request.DiskId = &diskId
 	request.DiskSize = helper.IntUint64(diskSize)
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCbsClient().ResizeDisk(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCbsClient().ResizeDiskWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -288,8 +304,10 @@ func (me *CbsService) ModifyThroughputPerformance(ctx context.Context, diskId st
 	request := cbs.NewModifyDiskExtraPerformanceRequest()
 	request.DiskId = &diskId
 	request.ThroughputPerformance = helper.IntUint64(throughputPerformance)
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCbsClient().ModifyDiskExtraPerformance(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCbsClient().ModifyDiskExtraPerformanceWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -305,8 +323,10 @@ func (me *CbsService) ApplySnapshot(ctx context.Context, diskId, snapshotId stri
 	request := cbs.NewApplySnapshotRequest()
 	request.DiskId = &diskId
 	request.SnapshotId = &snapshotId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCbsClient().ApplySnapshot(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCbsClient().ApplySnapshotWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -322,8 +342,10 @@ func (me *CbsService) AttachDisk(ctx context.Context, diskId, instanceId string)
 	request := cbs.NewAttachDisksRequest()
 	request.DiskIds = []*string{&diskId}
 	request.InstanceId = &instanceId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCbsClient().AttachDisks(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCbsClient().AttachDisksWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -339,8 +361,10 @@ func (me *CbsService) DetachDisk(ctx context.Context, diskId, instanceId string)
 	request := cbs.NewDetachDisksRequest()
 	request.DiskIds = []*string{&diskId}
 	request.InstanceId = &instanceId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCbsClient().DetachDisks(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCbsClient().DetachDisksWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -365,8 +389,10 @@ func (me *CbsService) CreateSnapshot(ctx context.Context, diskId, snapshotName s
 			request.Tags = append(request.Tags, &tag)
 		}
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCbsClient().CreateSnapshot(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCbsClient().CreateSnapshotWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -384,8 +410,10 @@ func (me *CbsService) DescribeSnapshotById(ctx context.Context, snapshotId strin
 	logId := getLogId(ctx)
 	request := cbs.NewDescribeSnapshotsRequest()
 	request.SnapshotIds = []*string{&snapshotId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCbsClient().DescribeSnapshots(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCbsClient().DescribeSnapshotsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -419,9 +447,11 @@ func (me *CbsService) DescribeSnapshotByIds(ctx context.Context, snapshotIdsPara
 		request.Offset = &offset
 		request.Limit = &pageSize
 
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			response, err = me.client.UseCbsClient().DescribeSnapshots(request)
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			response, err = me.client.UseCbsClient().DescribeSnapshotsWithContext(ctx, request)
 			if err != nil {
 				return retryError(err, InternalError)
 			}
@@ -461,8 +491,10 @@ func (me *CbsService) DescribeSnapshotsByFilter(ctx context.Context, params map[
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCbsClient().DescribeSnapshots(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCbsClient().DescribeSnapshotsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -491,8 +523,10 @@ func (me *CbsService) ModifySnapshotName(ctx context.Context, snapshotId, snapsh
 	request := cbs.NewModifySnapshotAttributeRequest()
 	request.SnapshotId = &snapshotId
 	request.SnapshotName = &snapshotName
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCbsClient().ModifySnapshotAttribute(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCbsClient().ModifySnapshotAttributeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -507,8 +541,10 @@ func (me *CbsService) DeleteSnapshot(ctx context.Context, snapshotId string) err
 	logId := getLogId(ctx)
 	request := cbs.NewDeleteSnapshotsRequest()
 	request.SnapshotIds = []*string{&snapshotId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCbsClient().DeleteSnapshots(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCbsClient().DeleteSnapshotsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -523,8 +559,10 @@ func (me *CbsService) DescribeSnapshotPolicyById(ctx context.Context, policyId s
 	logId := getLogId(contextNil)
 	request := cbs.NewDescribeAutoSnapshotPoliciesRequest()
 	request.AutoSnapshotPolicyIds = []*string{&policyId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCbsClient().DescribeAutoSnapshotPolicies(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCbsClient().DescribeAutoSnapshotPoliciesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -558,8 +596,10 @@ func (me *CbsService) DescribeSnapshotPolicy(ctx context.Context, policyId, poli
 		}
 		request.Filters = append(request.Filters, &filter)
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCbsClient().DescribeAutoSnapshotPolicies(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCbsClient().DescribeAutoSnapshotPoliciesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -574,8 +614,10 @@ func (me *CbsService) DeleteSnapshotPolicy(ctx context.Context, policyId string)
 	logId := getLogId(ctx)
 	request := cbs.NewDeleteAutoSnapshotPoliciesRequest()
 	request.AutoSnapshotPolicyIds = []*string{&policyId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCbsClient().DeleteAutoSnapshotPolicies(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCbsClient().DeleteAutoSnapshotPoliciesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -591,8 +633,10 @@ func (me *CbsService) AttachSnapshotPolicy(ctx context.Context, diskId, policyId
 	request := cbs.NewBindAutoSnapshotPolicyRequest()
 	request.AutoSnapshotPolicyId = &policyId
 	request.DiskIds = []*string{&diskId}
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseCbsClient().BindAutoSnapshotPolicy(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseCbsClient().BindAutoSnapshotPolicyWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -605,8 +649,10 @@ func (me *CbsService) DescribeAttachedSnapshotPolicy(ctx context.Context, diskId
 	logId := getLogId(ctx)
 	request := cbs.NewDescribeDiskAssociatedAutoSnapshotPolicyRequest()
 	request.DiskId = &diskId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCbsClient().DescribeDiskAssociatedAutoSnapshotPolicy(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCbsClient().DescribeDiskAssociatedAutoSnapshotPolicyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -627,8 +673,10 @@ func (me *CbsService) UnattachSnapshotPolicy(ctx context.Context, diskId, policy
 	request := cbs.NewUnbindAutoSnapshotPolicyRequest()
 	request.AutoSnapshotPolicyId = &policyId
 	request.DiskIds = []*string{&diskId}
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseCbsClient().UnbindAutoSnapshotPolicy(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseCbsClient().UnbindAutoSnapshotPolicyWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -642,8 +690,10 @@ func (me *CbsService) ModifyDiskChargeType(ctx context.Context, storageId string
 	request := cbs.NewModifyDisksChargeTypeRequest()
 	request.DiskIds = []*string{&storageId}
 	request.DiskChargePrepaid = &cbs.DiskChargePrepaid{Period: helper.IntUint64(period), RenewFlag: &renewFlag}
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseCbsClient().ModifyDisksChargeType(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseCbsClient().ModifyDisksChargeTypeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -658,8 +708,10 @@ func (me *CbsService) ModifyDisksRenewFlag(ctx context.Context, storageId string
 	request.DiskIds = []*string{&storageId}
 	request.RenewFlag = &renewFlag
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseCbsClient().ModifyDisksRenewFlag(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseCbsClient().ModifyDisksRenewFlagWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -680,9 +732,11 @@ func (me *CbsService) DescribeCbsDiskBackupById(ctx context.Context, diskBackupI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCbsClient().DescribeDiskBackups(request)
+	response, err := me.client.UseCbsClient().DescribeDiskBackupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -709,9 +763,11 @@ func (me *CbsService) DeleteCbsDiskBackupById(ctx context.Context, diskBackupId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCbsClient().DeleteDiskBackups(request)
+	response, err := me.client.UseCbsClient().DeleteDiskBackupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -733,9 +789,11 @@ func (me *CbsService) ModifyDiskBackupQuota(ctx context.Context, diskId string,
 	request.DiskId = helper.String(diskId)
 	request.DiskBackupQuota = helper.IntUint64(diskBackupQuota)
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCbsClient().ModifyDiskBackupQuota(request)
+	response, err := me.client.UseCbsClient().ModifyDiskBackupQuotaWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -756,9 +814,11 @@ func (me *CbsService) CreateDiskBackup(ctx context.Context, diskId, diskBackupNa
 	request.DiskId = helper.String(diskId)
 	request.DiskBackupName = helper.String(diskBackupName)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseCbsClient().CreateDiskBackup(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := me.client.UseCbsClient().CreateDiskBackupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -787,9 +847,11 @@ func (me *CbsService) DescribeCbsSnapshotSharePermissionById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCbsClient().DescribeSnapshotSharePermission(request)
+	response, err := me.client.UseCbsClient().DescribeSnapshotSharePermissionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -812,10 +874,12 @@ func (me *CbsService) ModifySnapshotsSharePermission(ctx context.Context, snapsh
 	request.SnapshotIds = []*string{&snapshotId}
 	request.Permission = helper.String(permission)
 	request.AccountIds = helper.StringsStringsPoint(accountIds)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := me.client.UseCbsClient().ModifySnapshotsSharePermission(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := me.client.UseCbsClient().ModifySnapshotsSharePermissionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -841,10 +905,12 @@ func (me *CbsService) ApplyDiskBackup(ctx context.Context, diskBackupId, diskId
 	}()
 	request.DiskBackupId = helper.String(diskBackupId)
 	request.DiskId = helper.String(diskId)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := me.client.UseCbsClient().ApplyDiskBackup(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := me.client.UseCbsClient().ApplyDiskBackupWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
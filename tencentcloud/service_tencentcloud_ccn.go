@@ -89,8 +89,10 @@ getMoreData:
 	}
 	request.Limit = &limit
 	request.Offset = &offset
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DescribeCcns(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DescribeCcnsWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -155,8 +157,10 @@ func (me *VpcService) DescribeCcnRegionBandwidthLimits(ctx context.Context, ccnI
 	infos = make([]CcnBandwidthLimit, 0, 100)
 
 	request.CcnId = &ccnId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DescribeCcnRegionBandwidthLimits(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DescribeCcnRegionBandwidthLimitsWithContext(ctx, request)
 
 	defer func() {
 		if errRet != nil {
@@ -204,8 +208,10 @@ func (me *VpcService) CreateCcn(ctx context.Context, name, description,
 	request.QosLevel = &qos
 	request.InstanceChargeType = &chargeType
 	request.BandwidthLimitType = &bandWithLimitType
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().CreateCcn(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().CreateCcnWithContext(ctx, request)
 
 	defer func() {
 		if errRet != nil {
@@ -254,8 +260,10 @@ func (me *VpcService) DeleteCcn(ctx context.Context, ccnId string) (errRet error
 	logId := getLogId(ctx)
 	request := vpc.NewDeleteCcnRequest()
 	request.CcnId = &ccnId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DeleteCcn(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DeleteCcnWithContext(ctx, request)
 
 	defer func() {
 		if errRet != nil {
@@ -296,8 +304,10 @@ func (me *VpcService) ModifyCcnAttribute(ctx context.Context, ccnId, name, descr
 	if description != "" {
 		request.CcnDescription = &description
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().ModifyCcnAttribute(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().ModifyCcnAttributeWithContext(ctx, request)
 
 	defer func() {
 		if errRet != nil {
@@ -353,8 +363,10 @@ func (me *VpcService) DescribeCcnAttachedInstances(ctx context.Context, ccnId st
 	logId := getLogId(ctx)
 	request := vpc.NewDescribeCcnAttachedInstancesRequest()
 	request.CcnId = &ccnId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DescribeCcnAttachedInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DescribeCcnAttachedInstancesWithContext(ctx, request)
 
 	defer func() {
 		if errRet != nil {
@@ -414,8 +426,10 @@ func (me *VpcService) DescribeCcnAttachmentsByInstance(ctx context.Context, inst
 	request.Filters = append(request.Filters, &vpc.Filter{Name: helper.String("instance-id"), Values: []*string{&instanceId}})
 	request.Filters = append(request.Filters, &vpc.Filter{Name: helper.String("instance-region"), Values: []*string{&instanceRegion}})
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DescribeCcnAttachedInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DescribeCcnAttachedInstancesWithContext(ctx, request)
 
 	defer func() {
 		if errRet != nil {
@@ -469,8 +483,10 @@ func (me *VpcService) AttachCcnInstances(ctx context.Context, ccnId, instanceReg
 	}
 
 	request.Instances = []*vpc.CcnInstance{&ccnInstance}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().AttachCcnInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().AttachCcnInstancesWithContext(ctx, request)
 
 	defer func() {
 		if errRet != nil {
@@ -511,8 +527,10 @@ func (me *VpcService) DetachCcnInstances(ctx context.Context, ccnId, instanceReg
 	ccnInstance.InstanceType = &instanceType
 
 	request.Instances = []*vpc.CcnInstance{&ccnInstance}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DetachCcnInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DetachCcnInstancesWithContext(ctx, request)
 
 	defer func() {
 		if errRet != nil {
@@ -597,9 +615,11 @@ func (me *VpcService) GetCcnRegionBandwidthLimits(ctx context.Context,
 	request.Limit = &limit
 	request.Offset = &offset
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 	for {
-		response, err = me.client.UseVpcClient().GetCcnRegionBandwidthLimits(request)
+		response, err = me.client.UseVpcClient().GetCcnRegionBandwidthLimitsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -638,8 +658,10 @@ func (me *VpcService) SetCcnRegionBandwidthLimits(ctx context.Context, ccnId, re
 	}
 
 	request.CcnRegionBandwidthLimits = []*vpc.CcnRegionBandwidthLimit{&ccnRegionBandwidthLimit}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().SetCcnRegionBandwidthLimits(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().SetCcnRegionBandwidthLimitsWithContext(ctx, request)
 
 	defer func() {
 		if errRet != nil {
@@ -672,7 +694,7 @@ func (me *VpcService) ModifyCcnRegionBandwidthLimitsType(ctx context.Context, cc
 	request := vpc.NewModifyCcnRegionBandwidthLimitsTypeRequest()
 	request.CcnId = &ccnID
 	request.BandwidthLimitType = &limitType
-	_, err := me.client.UseVpcClient().ModifyCcnRegionBandwidthLimitsType(request)
+	_, err := me.client.UseVpcClient().ModifyCcnRegionBandwidthLimitsTypeWithContext(ctx, request)
 	if err != nil {
 		return err
 	}
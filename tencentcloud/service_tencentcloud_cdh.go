@@ -24,8 +24,10 @@ func (me *CdhService) DescribeCdhInstanceById(ctx context.Context, hostId string
 	}
 	request.Filters = []*cvm.Filter{&filter}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeHosts(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCvmClient().DescribeHostsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -59,8 +61,10 @@ func (me *CdhService) DescribeCdhInstanceByFilter(ctx context.Context, filters m
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCvmClient().DescribeHosts(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCvmClient().DescribeHostsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -90,8 +94,10 @@ func (me *CdhService) CreateCdhInstance(ctx context.Context, placement *cvm.Plac
 	request.HostChargeType = helper.String(hostChargeType)
 	request.HostType = helper.String(hostType)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().AllocateHosts(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCvmClient().AllocateHostsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -115,8 +121,10 @@ func (me *CdhService) ModifyHostName(ctx context.Context, hostId, hostName strin
 	request.HostIds = []*string{helper.String(hostId)}
 	request.HostName = helper.String(hostName)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyHostsAttribute(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCvmClient().ModifyHostsAttributeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -135,8 +143,10 @@ func (me *CdhService) ModifyProject(ctx context.Context, hostId string, projectI
 	request.HostIds = []*string{helper.String(hostId)}
 	request.ProjectId = helper.IntUint64(projectId)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyHostsAttribute(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCvmClient().ModifyHostsAttributeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -155,8 +165,10 @@ func (me *CdhService) ModifyPrepaidRenewFlag(ctx context.Context, hostId, renewF
 	request.HostIds = []*string{helper.String(hostId)}
 	request.RenewFlag = helper.String(renewFlag)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyHostsAttribute(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCvmClient().ModifyHostsAttributeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
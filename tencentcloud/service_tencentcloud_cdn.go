@@ -33,8 +33,10 @@ func (me *CdnService) DescribeDomainsConfigByDomain(ctx context.Context, domain
 	}
 	request.Filters = append(request.Filters, filter)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCdnClient().DescribeDomainsConfig(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCdnClient().DescribeDomainsConfigWithContext(ctx, request)
 	if err != nil {
 		if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok {
 			if sdkErr.Code == CDN_HOST_NOT_FOUND {
@@ -61,8 +63,10 @@ func (me *CdnService) UpdateDomainConfig(ctx context.Context, request *cdn.Updat
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCdnClient().UpdateDomainConfig(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCdnClient().UpdateDomainConfigWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -80,8 +84,10 @@ func (me *CdnService) DeleteDomain(ctx context.Context, domain string) error {
 	request := cdn.NewDeleteCdnDomainRequest()
 	request.Domain = &domain
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseCdnClient().DeleteCdnDomain(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseCdnClient().DeleteCdnDomainWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -95,8 +101,10 @@ func (me *CdnService) StopDomain(ctx context.Context, domain string) error {
 	request := cdn.NewStopCdnDomainRequest()
 	request.Domain = &domain
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseCdnClient().StopCdnDomain(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseCdnClient().StopCdnDomainWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -110,8 +118,10 @@ func (me *CdnService) StartDomain(ctx context.Context, domain string) error {
 	request := cdn.NewStartCdnDomainRequest()
 	request.Domain = &domain
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseCdnClient().StartCdnDomain(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseCdnClient().StartCdnDomainWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -146,9 +156,11 @@ func (me *CdnService) DescribeDomainsConfigByFilters(ctx context.Context,
 	request.Offset = &offset
 
 	for {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			response, err = me.client.UseCdnClient().DescribeDomainsConfig(request)
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			response, err = me.client.UseCdnClient().DescribeDomainsConfigWithContext(ctx, request)
 
 			if err != nil {
 				if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -189,8 +201,10 @@ func (me *CdnService) VerifyDomainRecord(ctx context.Context, domain string) (re
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCdnClient().VerifyDomainRecord(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCdnClient().VerifyDomainRecordWithContext(ctx, request)
 
 	if err != nil {
 		return
@@ -218,8 +232,10 @@ func (me *CdnService) CreateVerifyRecord(ctx context.Context, domain string) (re
 
 	request.Domain = &domain
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCdnClient().CreateVerifyRecord(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCdnClient().CreateVerifyRecordWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -243,8 +259,10 @@ func (me *CdnService) DescribePurgeTasks(ctx context.Context, request *cdn.Descr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCdnClient().DescribePurgeTasks(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCdnClient().DescribePurgeTasksWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -270,8 +288,10 @@ func (me *CdnService) DescribePushTasks(ctx context.Context, request *cdn.Descri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCdnClient().DescribePushTasks(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCdnClient().DescribePushTasksWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -297,8 +317,10 @@ func (me *CdnService) PurgeUrlsCache(ctx context.Context, request *cdn.PurgeUrls
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCdnClient().PurgeUrlsCache(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCdnClient().PurgeUrlsCacheWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -324,8 +346,10 @@ func (me *CdnService) PushUrlsCache(ctx context.Context, request *cdn.PushUrlsCa
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCdnClient().PushUrlsCache(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCdnClient().PushUrlsCacheWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
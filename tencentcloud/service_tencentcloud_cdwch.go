@@ -32,9 +32,11 @@ func (me *CdwchService) DescribeInstance(ctx context.Context, instanceId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCdwchClient().DescribeInstance(request)
+	response, err := me.client.UseCdwchClient().DescribeInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -58,9 +60,11 @@ func (me *CdwchService) DestroyInstance(ctx context.Context, instanceId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCdwchClient().DestroyInstance(request)
+	response, err := me.client.UseCdwchClient().DestroyInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -85,9 +89,11 @@ func (me *CdwchService) ResizeDisk(ctx context.Context, instanceId string, nodeT
 	request.InstanceId = &instanceId
 	request.Type = &nodeType
 	request.DiskSize = helper.IntInt64(resizeDisk)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCdwchClient().ResizeDisk(request)
+	response, err := me.client.UseCdwchClient().ResizeDiskWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -114,9 +120,11 @@ func (me *CdwchService) ScaleUpInstance(ctx context.Context, instanceId, nodeTyp
 	request.ScaleUpEnableRolling = helper.Bool(true)
 	request.Type = &nodeType
 	request.SpecName = &specName
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCdwchClient().ScaleUpInstance(request)
+	response, err := me.client.UseCdwchClient().ScaleUpInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -147,9 +155,11 @@ func (me *CdwchService) ScaleOutInstance(ctx context.Context, instanceId string,
 	if shardIps != nil {
 		request.ReduceShardInfo = shardIps
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCdwchClient().ScaleOutInstance(request)
+	response, err := me.client.UseCdwchClient().ScaleOutInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -173,9 +183,11 @@ func (me *CdwchService) DescribeInstanceClusters(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCdwchClient().DescribeInstanceClusters(request)
+	response, err := me.client.UseCdwchClient().DescribeInstanceClustersWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -199,9 +211,11 @@ func (me *CdwchService) DescribeInstancesNew(ctx context.Context, instanceId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCdwchClient().DescribeInstancesNew(request)
+	response, err := me.client.UseCdwchClient().DescribeInstancesNewWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -225,9 +239,11 @@ func (me *CdwchService) DescribeBackUpScheduleById(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCdwchClient().DescribeBackUpSchedule(request)
+	response, err := me.client.UseCdwchClient().DescribeBackUpScheduleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -298,9 +314,11 @@ func (me *CdwchService) CreateBackUpSchedule(ctx context.Context, instanceId str
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseCdwchClient().CreateBackUpSchedule(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := me.client.UseCdwchClient().CreateBackUpScheduleWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -340,7 +358,9 @@ func (me *CdwchService) DescribeClickhouseBackupJobsByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -349,7 +369,7 @@ func (me *CdwchService) DescribeClickhouseBackupJobsByFilter(ctx context.Context
 	for {
 		request.PageNum = &offset
 		request.PageSize = &limit
-		response, err := me.client.UseCdwchClient().DescribeBackUpJob(request)
+		response, err := me.client.UseCdwchClient().DescribeBackUpJobWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -384,9 +404,11 @@ func (me *CdwchService) DescribeClickhouseAccountByUserName(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCdwchClient().DescribeCkSqlApis(request)
+	response, err := me.client.UseCdwchClient().DescribeCkSqlApisWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -417,9 +439,11 @@ func (me *CdwchService) DescribeCkSqlApis(ctx context.Context, instanceId, clust
 		request.Cluster = helper.String(cluster)
 	}
 
-	ratelimit.Check(request.GetAction())
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
 
-	response, err := me.client.UseCdwchClient().DescribeCkSqlApis(request)
+	response, err := me.client.UseCdwchClient().DescribeCkSqlApisWithContext(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -448,8 +472,8 @@ func (me *CdwchService) ActionAlterCkUser(ctx context.Context, apiType string, u
 	request.UserInfo = &ckUserAlterInfo
 	request.ApiType = helper.String(apiType)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := me.client.UseCdwchClient().ActionAlterCkUser(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := me.client.UseCdwchClient().ActionAlterCkUserWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -479,9 +503,11 @@ func (me *CdwchService) DescribeCdwchAccountPermission(ctx context.Context, inst
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCdwchClient().DescribeCkSqlApis(request)
+	response, err := me.client.UseCdwchClient().DescribeCkSqlApisWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -516,9 +542,11 @@ func (me *CdwchService) DescribeClickhouseBackupTablesByFilter(ctx context.Conte
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCdwchClient().DescribeBackUpTables(request)
+	response, err := me.client.UseCdwchClient().DescribeBackUpTablesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
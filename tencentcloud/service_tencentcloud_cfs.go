@@ -34,8 +34,10 @@ func (me *CfsService) DescribeFileSystem(ctx context.Context, fsId, vpcId, subne
 		request.SubnetId = &subnetId
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCfsClient().DescribeCfsFileSystems(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCfsClient().DescribeCfsFileSystemsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -54,8 +56,10 @@ func (me *CfsService) DescribeMountTargets(ctx context.Context, fsId string) (ta
 	request := cfs.NewDescribeMountTargetsRequest()
 	request.FileSystemId = &fsId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCfsClient().DescribeMountTargets(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCfsClient().DescribeMountTargetsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -75,8 +79,10 @@ func (me *CfsService) ModifyFileSystemName(ctx context.Context, fsId, fsName str
 	request.FileSystemId = &fsId
 	request.FsName = &fsName
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCfsClient().UpdateCfsFileSystemName(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCfsClient().UpdateCfsFileSystemNameWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -94,8 +100,10 @@ func (me *CfsService) ModifyFileSystemAccessGroup(ctx context.Context, fsId, acc
 	request.FileSystemId = &fsId
 	request.PGroupId = &accessGroupId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCfsClient().UpdateCfsFileSystemPGroup(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCfsClient().UpdateCfsFileSystemPGroupWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -112,8 +120,10 @@ func (me *CfsService) DeleteFileSystem(ctx context.Context, fsId string) error {
 	request := cfs.NewDeleteCfsFileSystemRequest()
 	request.FileSystemId = &fsId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCfsClient().DeleteCfsFileSystem(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCfsClient().DeleteCfsFileSystemWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -133,8 +143,10 @@ func (me *CfsService) CreateAccessGroup(ctx context.Context, name, description s
 		request.DescInfo = &description
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCfsClient().CreateCfsPGroup(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCfsClient().CreateCfsPGroupWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -155,8 +167,10 @@ func (me *CfsService) CreateAccessGroup(ctx context.Context, name, description s
 func (me *CfsService) DescribeAccessGroup(ctx context.Context, id, name string) (accessGroups []*cfs.PGroupInfo, errRet error) {
 	logId := getLogId(ctx)
 	request := cfs.NewDescribeCfsPGroupsRequest()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCfsClient().DescribeCfsPGroups(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCfsClient().DescribeCfsPGroupsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -183,8 +197,10 @@ func (me *CfsService) DeleteAccessGroup(ctx context.Context, id string) error {
 	logId := getLogId(ctx)
 	request := cfs.NewDeleteCfsPGroupRequest()
 	request.PGroupId = &id
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCfsClient().DeleteCfsPGroup(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCfsClient().DeleteCfsPGroupWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -200,8 +216,10 @@ func (me *CfsService) DescribeAccessRule(ctx context.Context, accessGroupId, acc
 	logId := getLogId(ctx)
 	request := cfs.NewDescribeCfsRulesRequest()
 	request.PGroupId = &accessGroupId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCfsClient().DescribeCfsRules(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCfsClient().DescribeCfsRulesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -231,8 +249,10 @@ func (me *CfsService) DeleteAccessRule(ctx context.Context, accessGroupId, acces
 	request := cfs.NewDeleteCfsRuleRequest()
 	request.PGroupId = &accessGroupId
 	request.RuleId = &accessRuleId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCfsClient().DeleteCfsRule(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCfsClient().DeleteCfsRuleWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -256,7 +276,9 @@ func (me *CfsService) DescribeCfsAutoSnapshotPolicyById(ctx context.Context, aut
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -266,7 +288,7 @@ func (me *CfsService) DescribeCfsAutoSnapshotPolicyById(ctx context.Context, aut
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCfsClient().DescribeAutoSnapshotPolicies(request)
+		response, err := me.client.UseCfsClient().DescribeAutoSnapshotPoliciesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -303,9 +325,11 @@ func (me *CfsService) DeleteCfsAutoSnapshotPolicyById(ctx context.Context, autoS
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfsClient().DeleteAutoSnapshotPolicy(request)
+	response, err := me.client.UseCfsClient().DeleteAutoSnapshotPolicyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -327,7 +351,9 @@ func (me *CfsService) DescribeCfsAutoSnapshotPolicyAttachmentById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -337,7 +363,7 @@ func (me *CfsService) DescribeCfsAutoSnapshotPolicyAttachmentById(ctx context.Co
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCfsClient().DescribeAutoSnapshotPolicies(request)
+		response, err := me.client.UseCfsClient().DescribeAutoSnapshotPoliciesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -389,9 +415,11 @@ func (me *CfsService) DeleteCfsAutoSnapshotPolicyAttachmentById(ctx context.Cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfsClient().UnbindAutoSnapshotPolicy(request)
+	response, err := me.client.UseCfsClient().UnbindAutoSnapshotPolicyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -413,7 +441,9 @@ func (me *CfsService) DescribeCfsSnapshotById(ctx context.Context, snapshotId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -423,7 +453,7 @@ func (me *CfsService) DescribeCfsSnapshotById(ctx context.Context, snapshotId st
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCfsClient().DescribeCfsSnapshots(request)
+		response, err := me.client.UseCfsClient().DescribeCfsSnapshotsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -460,9 +490,11 @@ func (me *CfsService) DeleteCfsSnapshotById(ctx context.Context, snapshotId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfsClient().DeleteCfsSnapshot(request)
+	response, err := me.client.UseCfsClient().DeleteCfsSnapshotWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -500,9 +532,11 @@ func (me *CfsService) DescribeCfsMountTargetsById(ctx context.Context, fileSyste
 
 	request.FileSystemId = helper.String(fileSystemId)
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfsClient().DescribeMountTargets(request)
+	response, err := me.client.UseCfsClient().DescribeMountTargetsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -528,9 +562,11 @@ func (me *CfsService) DescribeCfsFileSystemClientsById(ctx context.Context, file
 
 	request.FileSystemId = helper.String(fileSystemId)
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfsClient().DescribeCfsFileSystemClients(request)
+	response, err := me.client.UseCfsClient().DescribeCfsFileSystemClientsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -566,7 +602,9 @@ func (me *CfsService) DescribeCfsUserQuotaById(ctx context.Context, fileSystemId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -576,7 +614,7 @@ func (me *CfsService) DescribeCfsUserQuotaById(ctx context.Context, fileSystemId
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCfsClient().DescribeUserQuota(request)
+		response, err := me.client.UseCfsClient().DescribeUserQuotaWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -615,9 +653,11 @@ func (me *CfsService) DeleteCfsUserQuotaById(ctx context.Context, fileSystemId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfsClient().DeleteUserQuota(request)
+	response, err := me.client.UseCfsClient().DeleteUserQuotaWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -639,7 +679,7 @@ func (me *CfsService) DescribeCfsAvailableZoneByFilter(ctx context.Context) (ava
 		}
 	}()
 
-	response, err := me.client.UseCfsClient().DescribeAvailableZoneInfo(request)
+	response, err := me.client.UseCfsClient().DescribeAvailableZoneInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -28,9 +28,11 @@ func (me *CfwService) DescribeCfwAddressTemplateById(ctx context.Context, uuid s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfwClient().DescribeAddressTemplateList(request)
+	response, err := me.client.UseCfwClient().DescribeAddressTemplateListWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -58,9 +60,11 @@ func (me *CfwService) DeleteCfwAddressTemplateById(ctx context.Context, uuid str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfwClient().DeleteAddressTemplate(request)
+	response, err := me.client.UseCfwClient().DeleteAddressTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -97,9 +101,11 @@ func (me *CfwService) DescribeCfwBlockIgnoreListById(ctx context.Context, iP, do
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfwClient().DescribeBlockIgnoreList(request)
+	response, err := me.client.UseCfwClient().DescribeBlockIgnoreListWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -145,9 +151,11 @@ func (me *CfwService) DeleteCfwBlockIgnoreListById(ctx context.Context, iP, doma
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfwClient().DeleteBlockIgnoreRuleList(request)
+	response, err := me.client.UseCfwClient().DeleteBlockIgnoreRuleListWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -178,9 +186,11 @@ func (me *CfwService) DescribeCfwEdgePolicyById(ctx context.Context, uuid string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfwClient().DescribeAclRule(request)
+	response, err := me.client.UseCfwClient().DescribeAclRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -209,9 +219,11 @@ func (me *CfwService) DeleteCfwEdgePolicyById(ctx context.Context, uuid string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfwClient().RemoveAclRule(request)
+	response, err := me.client.UseCfwClient().RemoveAclRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -241,9 +253,11 @@ func (me *CfwService) DescribeCfwNatInstanceById(ctx context.Context, natinsId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfwClient().DescribeNatFwInstancesInfo(request)
+	response, err := me.client.UseCfwClient().DescribeNatFwInstancesInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -273,9 +287,11 @@ func (me *CfwService) DescribeCfwEipsById(ctx context.Context, instanceId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfwClient().DescribeCfwEips(request)
+	response, err := me.client.UseCfwClient().DescribeCfwEipsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -306,9 +322,11 @@ func (me *CfwService) DeleteCfwNatInstanceById(ctx context.Context, instanceId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfwClient().DeleteNatFwInstance(request)
+	response, err := me.client.UseCfwClient().DeleteNatFwInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -333,9 +351,11 @@ func (me *CfwService) DescribeNatFwVpcDnsLstById(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfwClient().DescribeNatFwVpcDnsLst(request)
+	response, err := me.client.UseCfwClient().DescribeNatFwVpcDnsLstWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -374,9 +394,11 @@ func (me *CfwService) DescribeCfwNatPolicyById(ctx context.Context, uuid string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfwClient().DescribeNatAcRule(request)
+	response, err := me.client.UseCfwClient().DescribeNatAcRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -405,9 +427,11 @@ func (me *CfwService) DeleteCfwNatPolicyById(ctx context.Context, uuid string) (
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfwClient().RemoveNatAcRule(request)
+	response, err := me.client.UseCfwClient().RemoveNatAcRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -438,9 +462,11 @@ func (me *CfwService) DescribeCfwVpcInstanceById(ctx context.Context, fwGroupId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfwClient().DescribeFwGroupInstanceInfo(request)
+	response, err := me.client.UseCfwClient().DescribeFwGroupInstanceInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -469,9 +495,11 @@ func (me *CfwService) DeleteCfwVpcInstanceById(ctx context.Context, fwGroupId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfwClient().DeleteVpcFwGroup(request)
+	response, err := me.client.UseCfwClient().DeleteVpcFwGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -502,9 +530,11 @@ func (me *CfwService) DescribeFwGroupInstanceInfoById(ctx context.Context, fwGro
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfwClient().DescribeFwGroupInstanceInfo(request)
+	response, err := me.client.UseCfwClient().DescribeFwGroupInstanceInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -540,9 +570,11 @@ func (me *CfwService) DescribeCfwVpcPolicyById(ctx context.Context, uuid string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfwClient().DescribeVpcAcRule(request)
+	response, err := me.client.UseCfwClient().DescribeVpcAcRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -571,9 +603,11 @@ func (me *CfwService) DeleteCfwVpcPolicyById(ctx context.Context, uuid string) (
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfwClient().RemoveVpcAcRule(request)
+	response, err := me.client.UseCfwClient().RemoveVpcAcRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -600,9 +634,11 @@ func (me *CfwService) DescribeCfwNatFirewallSwitchById(ctx context.Context, natI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfwClient().DescribeNatSwitchList(request)
+	response, err := me.client.UseCfwClient().DescribeNatSwitchListWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -640,7 +676,9 @@ func (me *CfwService) DescribeCfwNatFwSwitchesByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -649,7 +687,7 @@ func (me *CfwService) DescribeCfwNatFwSwitchesByFilter(ctx context.Context, para
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCfwClient().DescribeNatSwitchList(request)
+		response, err := me.client.UseCfwClient().DescribeNatSwitchListWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -697,9 +735,11 @@ func (me *CfwService) DescribeCfwVpcFirewallSwitchById(ctx context.Context, vpcI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfwClient().DescribeVpcFwGroupSwitch(request)
+	response, err := me.client.UseCfwClient().DescribeVpcFwGroupSwitchWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -733,7 +773,9 @@ func (me *CfwService) DescribeCfwVpcFwSwitchesByFilter(ctx context.Context, vpcI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -742,7 +784,7 @@ func (me *CfwService) DescribeCfwVpcFwSwitchesByFilter(ctx context.Context, vpcI
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCfwClient().DescribeVpcFwGroupSwitch(request)
+		response, err := me.client.UseCfwClient().DescribeVpcFwGroupSwitchWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -777,7 +819,9 @@ func (me *CfwService) DescribeCfwEdgeFwSwitchesByFilter(ctx context.Context) (ed
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -786,7 +830,7 @@ func (me *CfwService) DescribeCfwEdgeFwSwitchesByFilter(ctx context.Context) (ed
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCfwClient().DescribeFwEdgeIps(request)
+		response, err := me.client.UseCfwClient().DescribeFwEdgeIpsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -827,9 +871,11 @@ func (me *CfwService) DescribeCfwEdgeFirewallSwitchById(ctx context.Context, pub
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCfwClient().DescribeFwEdgeIps(request)
+	response, err := me.client.UseCfwClient().DescribeFwEdgeIpsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -28,9 +28,11 @@ func (me *ChdfsService) DescribeChdfsAccessGroupById(ctx context.Context, access
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseChdfsClient().DescribeAccessGroup(request)
+	response, err := me.client.UseChdfsClient().DescribeAccessGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -57,9 +59,11 @@ func (me *ChdfsService) DeleteChdfsAccessGroupById(ctx context.Context, accessGr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseChdfsClient().DeleteAccessGroup(request)
+	response, err := me.client.UseChdfsClient().DeleteAccessGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -81,9 +85,11 @@ func (me *ChdfsService) DescribeChdfsFileSystemById(ctx context.Context, fileSys
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseChdfsClient().DescribeFileSystem(request)
+	response, err := me.client.UseChdfsClient().DescribeFileSystemWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -110,9 +116,11 @@ func (me *ChdfsService) DeleteChdfsFileSystemById(ctx context.Context, fileSyste
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseChdfsClient().DeleteFileSystem(request)
+	response, err := me.client.UseChdfsClient().DeleteFileSystemWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -148,9 +156,11 @@ func (me *ChdfsService) DescribeChdfsAccessRulesById(ctx context.Context, access
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseChdfsClient().DescribeAccessRules(request)
+	response, err := me.client.UseChdfsClient().DescribeAccessRulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -182,9 +192,11 @@ func (me *ChdfsService) DeleteChdfsAccessRulesById(ctx context.Context, accessRu
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseChdfsClient().DeleteAccessRules(request)
+	response, err := me.client.UseChdfsClient().DeleteAccessRulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -206,9 +218,11 @@ func (me *ChdfsService) DescribeChdfsLifeCycleRuleById(ctx context.Context, file
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseChdfsClient().DescribeLifeCycleRules(request)
+	response, err := me.client.UseChdfsClient().DescribeLifeCycleRulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -240,9 +254,11 @@ func (me *ChdfsService) DescribeChdfsLifeCycleRuleByPath(ctx context.Context, fi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseChdfsClient().DescribeLifeCycleRules(request)
+	response, err := me.client.UseChdfsClient().DescribeLifeCycleRulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -274,9 +290,11 @@ func (me *ChdfsService) DeleteChdfsLifeCycleRuleById(ctx context.Context, lifeCy
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseChdfsClient().DeleteLifeCycleRules(request)
+	response, err := me.client.UseChdfsClient().DeleteLifeCycleRulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -298,9 +316,11 @@ func (me *ChdfsService) DescribeChdfsMountPointById(ctx context.Context, mountPo
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseChdfsClient().DescribeMountPoint(request)
+	response, err := me.client.UseChdfsClient().DescribeMountPointWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -327,9 +347,11 @@ func (me *ChdfsService) DeleteChdfsMountPointById(ctx context.Context, mountPoin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseChdfsClient().DeleteMountPoint(request)
+	response, err := me.client.UseChdfsClient().DeleteMountPointWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -352,9 +374,11 @@ func (me *ChdfsService) DeleteChdfsMountPointAttachmentById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseChdfsClient().DisassociateAccessGroups(request)
+	response, err := me.client.UseChdfsClient().DisassociateAccessGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -385,9 +409,11 @@ func (me *ChdfsService) DescribeChdfsAccessGroupsByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseChdfsClient().DescribeAccessGroups(request)
+	response, err := me.client.UseChdfsClient().DescribeAccessGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -423,9 +449,11 @@ func (me *ChdfsService) DescribeChdfsMountPointsByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseChdfsClient().DescribeMountPoints(request)
+	response, err := me.client.UseChdfsClient().DescribeMountPointsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -449,9 +477,11 @@ func (me *ChdfsService) DescribeChdfsFileSystems(ctx context.Context) (fileSyste
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseChdfsClient().DescribeFileSystems(request)
+	response, err := me.client.UseChdfsClient().DescribeFileSystemsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -34,7 +34,9 @@ func (me *CiamService) DescribeCiamUserGroupById(ctx context.Context, userStoreI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 1
@@ -48,7 +50,7 @@ func (me *CiamService) DescribeCiamUserGroupById(ctx context.Context, userStoreI
 		}
 		request.Pageable = &page
 
-		response, err := me.client.UseCiamClient().ListUserGroups(request)
+		response, err := me.client.UseCiamClient().ListUserGroupsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -86,9 +88,11 @@ func (me *CiamService) DeleteCiamUserGroupById(ctx context.Context, userStoreId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCiamClient().DeleteUserGroups(request)
+	response, err := me.client.UseCiamClient().DeleteUserGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -109,9 +113,11 @@ func (me *CiamService) DescribeCiamUserStoreById(ctx context.Context, userPoolId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCiamClient().ListUserStore(request)
+	response, err := me.client.UseCiamClient().ListUserStoreWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -143,9 +149,11 @@ func (me *CiamService) DeleteCiamUserStoreById(ctx context.Context, userPoolId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCiamClient().DeleteUserStore(request)
+	response, err := me.client.UseCiamClient().DeleteUserStoreWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
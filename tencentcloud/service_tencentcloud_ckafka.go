@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	ckafka "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
@@ -34,8 +33,8 @@ func (me *CkafkaService) CheckCkafkaInstanceReady(ctx context.Context,
 		}
 	}()
 	request.InstanceId = &instanceId
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, err := me.client.UseCkafkaClient().DescribeInstancesDetail(request)
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, err := me.client.UseCkafkaClient().DescribeInstancesDetailWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -59,8 +58,10 @@ func (me *CkafkaService) CheckCkafkaInstanceReady(ctx context.Context,
 func (me *CkafkaService) ModifyCkafkaInstanceAttributes(ctx context.Context,
 	request *ckafka.ModifyInstanceAttributesRequest) (errRet error) {
 	logId := getLogId(ctx)
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseCkafkaClient().ModifyInstanceAttributes(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseCkafkaClient().ModifyInstanceAttributesWithContext(ctx, request)
 	if err != nil {
 		return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId,
 			request.GetAction(), request.ToJsonString(), err.Error())
@@ -82,8 +83,8 @@ func (me *CkafkaService) DescribeCkafkaInstanceById(ctx context.Context,
 		}
 	}()
 	request.InstanceId = &instanceId
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, err := me.client.UseCkafkaClient().DescribeInstancesDetail(request)
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, err := me.client.UseCkafkaClient().DescribeInstancesDetailWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -110,8 +111,8 @@ func (me *CkafkaService) CreateUser(ctx context.Context, instanceId, user, passw
 
 	var response *ckafka.CreateUserResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, err = me.client.UseCkafkaClient().CreateUser(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		response, err = me.client.UseCkafkaClient().CreateUserWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -144,7 +145,7 @@ func (me *CkafkaService) OperateStatusCheck(ctx context.Context, result *ckafka.
 func (me *CkafkaService) DescribeUserByUserId(ctx context.Context, userId string) (userInfo *ckafka.User, has bool, errRet error) {
 	logId := getLogId(ctx)
 
-	items := strings.Split(userId, FILED_SP)
+	items := helper.IdParse(userId)
 	if len(items) != 2 {
 		errRet = fmt.Errorf("id of resource.tencentcloud_ckafka_user is wrong")
 		return
@@ -161,8 +162,8 @@ func (me *CkafkaService) DescribeUserByUserId(ctx context.Context, userId string
 
 	var response *ckafka.DescribeUserResponse
 	var err error
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		response, err = me.client.UseCkafkaClient().DescribeUser(request)
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		response, err = me.client.UseCkafkaClient().DescribeUserWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -201,8 +202,8 @@ func (me *CkafkaService) ModifyPassword(ctx context.Context, instanceId, user, o
 
 	var response *ckafka.ModifyPasswordResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, err = me.client.UseCkafkaClient().ModifyPassword(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		response, err = me.client.UseCkafkaClient().ModifyPasswordWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -221,7 +222,7 @@ func (me *CkafkaService) ModifyPassword(ctx context.Context, instanceId, user, o
 func (me *CkafkaService) DeleteUser(ctx context.Context, userId string) (errRet error) {
 	logId := getLogId(ctx)
 
-	items := strings.Split(userId, FILED_SP)
+	items := helper.IdParse(userId)
 	if len(items) != 2 {
 		errRet = fmt.Errorf("id of resource.tencentcloud_ckafka_user is wrong")
 		return
@@ -234,8 +235,8 @@ func (me *CkafkaService) DeleteUser(ctx context.Context, userId string) (errRet
 
 	var response *ckafka.DeleteUserResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, err = me.client.UseCkafkaClient().DeleteUser(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		response, err = me.client.UseCkafkaClient().DeleteUserWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -273,9 +274,11 @@ func (me *CkafkaService) DescribeUserByFilter(ctx context.Context, params map[st
 	for {
 		var response *ckafka.DescribeUserResponse
 		var err error
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			response, err = me.client.UseCkafkaClient().DescribeUser(request)
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			response, err = me.client.UseCkafkaClient().DescribeUserWithContext(ctx, request)
 			if err != nil {
 				return retryError(err)
 			}
@@ -309,8 +312,8 @@ func (me *CkafkaService) CreateAcl(ctx context.Context, instanceId, resourceType
 
 	var response *ckafka.CreateAclResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, err = me.client.UseCkafkaClient().CreateAcl(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		response, err = me.client.UseCkafkaClient().CreateAclWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -336,7 +339,7 @@ func (me *CkafkaService) DescribeAclByFilter(ctx context.Context, params map[str
 	resourceType := params["resource_type"].(string)
 	resourceName := params["resource_name"].(string)
 	if resourceType == "TOPIC" {
-		if _, has, _ := me.DescribeTopicById(ctx, instanceId+FILED_SP+resourceName); !has {
+		if _, has, _ := me.DescribeTopicById(ctx, helper.IdFormat(instanceId, resourceName)); !has {
 			return
 		}
 	}
@@ -357,9 +360,11 @@ func (me *CkafkaService) DescribeAclByFilter(ctx context.Context, params map[str
 	for {
 		var response *ckafka.DescribeACLResponse
 		var err error
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			response, err = me.client.UseCkafkaClient().DescribeACL(request)
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			response, err = me.client.UseCkafkaClient().DescribeACLWithContext(ctx, request)
 			if err != nil {
 				return retryError(err)
 			}
@@ -382,7 +387,7 @@ func (me *CkafkaService) DescribeAclByFilter(ctx context.Context, params map[str
 
 func (me *CkafkaService) DescribeAclByAclId(ctx context.Context, aclId string) (aclInfo *ckafka.Acl, has bool, errRet error) {
 	// acl id is organized by "instanceId + FILED_SP + permissionType + FILED_SP + principal + FILED_SP + host + FILED_SP + operation + FILED_SP + resourceType + FILED_SP + resourceName"
-	items := strings.Split(aclId, FILED_SP)
+	items := helper.IdParse(aclId)
 	if len(items) != 7 {
 		errRet = fmt.Errorf("id of resource.tencentcloud_ckafka_acl is wrong")
 		return
@@ -415,7 +420,7 @@ func (me *CkafkaService) DeleteAcl(ctx context.Context, aclId string) (errRet er
 	logId := getLogId(ctx)
 
 	// acl id is organized by "instanceId + FILED_SP + permissionType + FILED_SP + principal + FILED_SP + host + FILED_SP + operation + FILED_SP + resourceType + FILED_SP + resourceName"
-	items := strings.Split(aclId, FILED_SP)
+	items := helper.IdParse(aclId)
 	if len(items) != 7 {
 		errRet = fmt.Errorf("id of resource.tencentcloud_ckafka_acl is wrong")
 		return
@@ -433,8 +438,8 @@ func (me *CkafkaService) DeleteAcl(ctx context.Context, aclId string) (errRet er
 
 	var response *ckafka.DeleteAclResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, err = me.client.UseCkafkaClient().DeleteAcl(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		response, err = me.client.UseCkafkaClient().DeleteAclWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -457,9 +462,11 @@ func (me *CkafkaService) DescribeInstanceById(ctx context.Context, instanceId st
 	request.InstanceId = &instanceId
 	var response *ckafka.DescribeInstanceAttributesResponse
 	var err error
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = me.client.UseCkafkaClient().DescribeInstanceAttributes(request)
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = me.client.UseCkafkaClient().DescribeInstanceAttributesWithContext(ctx, request)
 		if err != nil {
 			if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok {
 				if sdkErr.Code == CkafkaInstanceNotFound || sdkErr.Code == CkafkaFailedOperation {
@@ -490,7 +497,7 @@ func (me *CkafkaService) DescribeTopicById(ctx context.Context, topicId string)
 	logId := getLogId(ctx)
 
 	request := ckafka.NewDescribeTopicAttributesRequest()
-	items := strings.Split(topicId, FILED_SP)
+	items := helper.IdParse(topicId)
 	if len(items) != 2 {
 		errRet = fmt.Errorf("id of resource.tencentcloud_ckafka_topic is wrong")
 		return
@@ -500,9 +507,11 @@ func (me *CkafkaService) DescribeTopicById(ctx context.Context, topicId string)
 	request.TopicName = &topicName
 	var response *ckafka.DescribeTopicAttributesResponse
 	var err error
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = me.client.UseCkafkaClient().DescribeTopicAttributes(request)
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = me.client.UseCkafkaClient().DescribeTopicAttributesWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -548,8 +557,10 @@ func (me *CkafkaService) DescribeCkafkaTopics(ctx context.Context, instanceId st
 		return
 	}
 	for {
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCkafkaClient().DescribeTopicDetail(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCkafkaClient().DescribeTopicDetailWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -575,9 +586,11 @@ func (me *CkafkaService) CreateCkafkaTopic(ctx context.Context, request *ckafka.
 		}
 	}()
 	var response *ckafka.CreateTopicResponse
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		resp, e := me.client.UseCkafkaClient().CreateTopic(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		resp, e := me.client.UseCkafkaClient().CreateTopicWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -598,7 +611,7 @@ func (me *CkafkaService) CreateCkafkaTopic(ctx context.Context, request *ckafka.
 
 func (me *CkafkaService) DescribeCkafkaTopicByName(ctx context.Context, instanceId string, topicName string) (topic *ckafka.TopicDetail, has bool, errRet error) {
 	var topicList []*ckafka.TopicDetail
-	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	errRet = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		list, err := me.DescribeCkafkaTopics(ctx, instanceId, topicName)
 		if err != nil {
 			return retryError(err)
@@ -630,8 +643,10 @@ func (me *CkafkaService) DescribeCkafkaTopicAttributes(ctx context.Context, inst
 	request.InstanceId = &instanceId
 	request.TopicName = &topicName
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCkafkaClient().DescribeTopicAttributes(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCkafkaClient().DescribeTopicAttributesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -657,9 +672,11 @@ func (me *CkafkaService) AddCkafkaTopicIpWhiteList(ctx context.Context, instance
 	request.InstanceId = &instanceId
 	request.IpWhiteList = whiteIpList
 	var response *ckafka.CreateTopicIpWhiteListResponse
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		resp, e := me.client.UseCkafkaClient().CreateTopicIpWhiteList(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		resp, e := me.client.UseCkafkaClient().CreateTopicIpWhiteListWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -688,9 +705,11 @@ func (me *CkafkaService) AddCkafkaTopicPartition(ctx context.Context, instanceId
 	request.InstanceId = &instanceId
 	request.PartitionNum = &partitionNum
 	var response *ckafka.CreatePartitionResponse
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		resp, e := me.client.UseCkafkaClient().CreatePartition(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		resp, e := me.client.UseCkafkaClient().CreatePartitionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -718,10 +737,12 @@ func (me *CkafkaService) RemoveCkafkaTopicIpWhiteList(ctx context.Context, insta
 	request.TopicName = &topicName
 	request.InstanceId = &instaneId
 	request.IpWhiteList = whiteIpList
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 	var response *ckafka.DeleteTopicIpWhiteListResponse
-	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		resp, e := me.client.UseCkafkaClient().DeleteTopicIpWhiteList(request)
+	errRet = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		resp, e := me.client.UseCkafkaClient().DeleteTopicIpWhiteListWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		}
@@ -747,8 +768,10 @@ func (me *CkafkaService) DescribeCkafkaById(ctx context.Context, instanceId stri
 	}()
 
 	request.InstanceId = &instanceId
-	ratelimit.Check(request.GetAction())
-	resp, err := me.client.UseCkafkaClient().DescribeInstancesDetail(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	resp, err := me.client.UseCkafkaClient().DescribeInstancesDetailWithContext(ctx, request)
 	if err != nil {
 		has = false
 		return
@@ -771,8 +794,10 @@ func (me *CkafkaService) ModifyCkafkaTopicAttribute(ctx context.Context, request
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, errRet := me.client.UseCkafkaClient().ModifyTopicAttributes(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, errRet := me.client.UseCkafkaClient().ModifyTopicAttributesWithContext(ctx, request)
 	if errRet != nil {
 		return errRet
 	}
@@ -793,9 +818,11 @@ func (me *CkafkaService) DeleteCkafkaTopic(ctx context.Context, instanceId strin
 	request.InstanceId = &instanceId
 	request.TopicName = &name
 
-	ratelimit.Check(request.GetAction())
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseCkafkaClient().DeleteTopic(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseCkafkaClient().DeleteTopicWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -805,7 +832,7 @@ func (me *CkafkaService) DeleteCkafkaTopic(ctx context.Context, instanceId strin
 		return
 	}
 	//重试超时时间
-	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	errRet = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		topicList, err := me.DescribeCkafkaTopics(ctx, instanceId, name)
 		if err != nil {
 			return retryError(err)
@@ -834,9 +861,11 @@ func (me *CkafkaService) DescribeCkafkaDatahubTopicById(ctx context.Context, top
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCkafkaClient().DescribeDatahubTopic(request)
+	response, err := me.client.UseCkafkaClient().DescribeDatahubTopicWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -863,9 +892,11 @@ func (me *CkafkaService) DeleteCkafkaDatahubTopicById(ctx context.Context, topic
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCkafkaClient().DeleteDatahubTopic(request)
+	response, err := me.client.UseCkafkaClient().DeleteDatahubTopicWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -887,9 +918,11 @@ func (me *CkafkaService) DescribeCkafkaConnectResourceById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCkafkaClient().DescribeConnectResource(request)
+	response, err := me.client.UseCkafkaClient().DescribeConnectResourceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -916,9 +949,11 @@ func (me *CkafkaService) DeleteCkafkaConnectResourceById(ctx context.Context, re
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCkafkaClient().DeleteConnectResource(request)
+	response, err := me.client.UseCkafkaClient().DeleteConnectResourceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -975,9 +1010,11 @@ func (me *CkafkaService) DescribeCkafkaConnectResourceByFilter(ctx context.Conte
 	request.Offset = helper.IntInt64(offset)
 	request.Limit = helper.IntInt64(limit)
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCkafkaClient().DescribeConnectResources(request)
+	response, err := me.client.UseCkafkaClient().DescribeConnectResourcesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1019,8 +1056,10 @@ func (me *CkafkaService) DescribeCkafkaDatahubTopicByFilter(ctx context.Context,
 	}
 	request.Limit = helper.IntUint64(limit)
 	request.Offset = helper.IntUint64(offset)
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCkafkaClient().DescribeDatahubTopics(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCkafkaClient().DescribeDatahubTopicsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1060,7 +1099,9 @@ func (me *CkafkaService) DescribeCkafkaDatahubGroupOffsetsByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1069,7 +1110,7 @@ func (me *CkafkaService) DescribeCkafkaDatahubGroupOffsetsByFilter(ctx context.C
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCkafkaClient().DescribeDatahubGroupOffsets(request)
+		response, err := me.client.UseCkafkaClient().DescribeDatahubGroupOffsetsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1120,7 +1161,9 @@ func (me *CkafkaService) DescribeCkafkaDatahubTaskByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1129,7 +1172,7 @@ func (me *CkafkaService) DescribeCkafkaDatahubTaskByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCkafkaClient().DescribeDatahubTasks(request)
+		response, err := me.client.UseCkafkaClient().DescribeDatahubTasksWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1171,7 +1214,9 @@ func (me *CkafkaService) DescribeCkafkaGroupByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1180,7 +1225,7 @@ func (me *CkafkaService) DescribeCkafkaGroupByFilter(ctx context.Context, param
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCkafkaClient().DescribeGroup(request)
+		response, err := me.client.UseCkafkaClient().DescribeGroupWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1228,7 +1273,9 @@ func (me *CkafkaService) DescribeCkafkaGroupOffsetsByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1237,7 +1284,7 @@ func (me *CkafkaService) DescribeCkafkaGroupOffsetsByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCkafkaClient().DescribeGroupOffsets(request)
+		response, err := me.client.UseCkafkaClient().DescribeGroupOffsetsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1279,9 +1326,11 @@ func (me *CkafkaService) DescribeCkafkaGroupInfoByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCkafkaClient().DescribeGroupInfo(request)
+	response, err := me.client.UseCkafkaClient().DescribeGroupInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1311,9 +1360,11 @@ func (me *CkafkaService) DescribeCkafkaTaskStatusByFilter(ctx context.Context, f
 
 	request.FlowId = helper.IntInt64(flowId)
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCkafkaClient().DescribeTaskStatus(request)
+	response, err := me.client.UseCkafkaClient().DescribeTaskStatusWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1356,9 +1407,11 @@ func (me *CkafkaService) DescribeCkafkaTopicFlowRankingByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCkafkaClient().DescribeTopicFlowRanking(request)
+	response, err := me.client.UseCkafkaClient().DescribeTopicFlowRankingWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1395,9 +1448,11 @@ func (me *CkafkaService) DescribeCkafkaTopicProduceConnectionByFilter(ctx contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCkafkaClient().DescribeTopicProduceConnection(request)
+	response, err := me.client.UseCkafkaClient().DescribeTopicProduceConnectionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1434,7 +1489,9 @@ func (me *CkafkaService) DescribeCkafkaTopicSubscribeGroupByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -1443,7 +1500,7 @@ func (me *CkafkaService) DescribeCkafkaTopicSubscribeGroupByFilter(ctx context.C
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCkafkaClient().DescribeTopicSubscribeGroup(request)
+		response, err := me.client.UseCkafkaClient().DescribeTopicSubscribeGroupWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1488,7 +1545,9 @@ func (me *CkafkaService) DescribeCkafkaTopicSyncReplicaByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -1497,7 +1556,7 @@ func (me *CkafkaService) DescribeCkafkaTopicSyncReplicaByFilter(ctx context.Cont
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCkafkaClient().DescribeTopicSyncReplica(request)
+		response, err := me.client.UseCkafkaClient().DescribeTopicSyncReplicaWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1531,9 +1590,11 @@ func (me *CkafkaService) DescribeCkafkaAclRuleById(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCkafkaClient().DescribeAclRule(request)
+	response, err := me.client.UseCkafkaClient().DescribeAclRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1561,9 +1622,11 @@ func (me *CkafkaService) DeleteCkafkaAclRuleById(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCkafkaClient().DeleteAclRule(request)
+	response, err := me.client.UseCkafkaClient().DeleteAclRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1586,9 +1649,11 @@ func (me *CkafkaService) DescribeCkafkaConsumerGroupById(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCkafkaClient().DescribeConsumerGroup(request)
+	response, err := me.client.UseCkafkaClient().DescribeConsumerGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1616,9 +1681,11 @@ func (me *CkafkaService) DeleteCkafkaConsumerGroupById(ctx context.Context, inst
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCkafkaClient().DeleteGroup(request)
+	response, err := me.client.UseCkafkaClient().DeleteGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1640,9 +1707,11 @@ func (me *CkafkaService) DeleteCkafkaDatahubTaskById(ctx context.Context, taskId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCkafkaClient().DeleteDatahubTask(request)
+	response, err := me.client.UseCkafkaClient().DeleteDatahubTaskWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1664,9 +1733,11 @@ func (me *CkafkaService) DescribeDatahubTask(ctx context.Context, taskId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCkafkaClient().DescribeDatahubTask(request)
+	response, err := me.client.UseCkafkaClient().DescribeDatahubTaskWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1712,9 +1783,11 @@ func (me *CkafkaService) DescribeCkafkaCkafkaZoneByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCkafkaClient().DescribeCkafkaZone(request)
+	response, err := me.client.UseCkafkaClient().DescribeCkafkaZoneWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1743,9 +1816,11 @@ func (me *CkafkaService) DescribeCkafkaRouteById(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCkafkaClient().DescribeRoute(request)
+	response, err := me.client.UseCkafkaClient().DescribeRouteWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1773,9 +1848,11 @@ func (me *CkafkaService) DeleteCkafkaRouteById(ctx context.Context, instanceId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCkafkaClient().DeleteRoute(request)
+	response, err := me.client.UseCkafkaClient().DeleteRouteWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1785,11 +1862,11 @@ func (me *CkafkaService) DeleteCkafkaRouteById(ctx context.Context, instanceId s
 	return
 }
 
-func (me *CkafkaService) CkafkaRouteStateRefreshFunc(flowId int64, failStates []string) resource.StateRefreshFunc {
+func (me *CkafkaService) CkafkaRouteStateRefreshFunc(ctx context.Context, flowId int64, failStates []string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		request := ckafka.NewDescribeTaskStatusRequest()
 		request.FlowId = helper.Int64(flowId)
-		object, err := me.client.UseCkafkaClient().DescribeTaskStatus(request)
+		object, err := me.client.UseCkafkaClient().DescribeTaskStatusWithContext(ctx, request)
 
 		if err != nil {
 			return nil, "", err
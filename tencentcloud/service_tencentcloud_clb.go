@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
 
@@ -26,7 +25,7 @@ type ClbService struct {
 func RuleIdCheck(ruleId string) error {
 	//check clb listener rule id first
 	//old example file cause wrong usage of listener.id
-	items := strings.Split(ruleId, FILED_SP)
+	items := helper.IdParse(ruleId)
 	if len(items) > 1 {
 		return fmt.Errorf("Unsupported references of rule_id since version 1.47.0, please check your tf content and use `tencentcloud_clb_listener_rule.xxx.rule_id` instead of `tencentcloud_clb_listener_rule.xxx.id`")
 	}
@@ -36,7 +35,7 @@ func RuleIdCheck(ruleId string) error {
 func ListenerIdCheck(listenerId string) error {
 	//check clb listener listener id first
 	//old example file cause wrong usage of listener.id
-	items := strings.Split(listenerId, FILED_SP)
+	items := helper.IdParse(listenerId)
 	if len(items) > 1 {
 		return fmt.Errorf("Unsupported references of listener_id since version 1.47.0, please check your tf content and use `tencentcloud_clb_listener.xxx.listener_id` instead of `tencentcloud_clb_listener.xxx.id`")
 	}
@@ -47,8 +46,10 @@ func (me *ClbService) DescribeLoadBalancerById(ctx context.Context, clbId string
 	logId := getLogId(ctx)
 	request := clb.NewDescribeLoadBalancersRequest()
 	request.LoadBalancerIds = []*string{&clbId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().DescribeLoadBalancers(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClbClient().DescribeLoadBalancersWithContext(ctx, request)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
@@ -92,8 +93,10 @@ func (me *ClbService) DescribeLoadBalancerByFilter(ctx context.Context, params m
 	for {
 		request.Offset = &(offset)
 		request.Limit = &(pageSize)
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseClbClient().DescribeLoadBalancers(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseClbClient().DescribeLoadBalancersWithContext(ctx, request)
 		if err != nil {
 			errRet = errors.WithStack(err)
 			return
@@ -119,8 +122,10 @@ func (me *ClbService) DeleteLoadBalancerById(ctx context.Context, clbId string)
 	logId := getLogId(ctx)
 	request := clb.NewDeleteLoadBalancerRequest()
 	request.LoadBalancerIds = []*string{&clbId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().DeleteLoadBalancer(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseClbClient().DeleteLoadBalancerWithContext(ctx, request)
 	if err != nil {
 		if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 			if e.GetCode() == "InvalidParameter.LBIdNotFound" {
@@ -132,7 +137,7 @@ func (me *ClbService) DeleteLoadBalancerById(ctx context.Context, clbId string)
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 	requestId := *response.Response.RequestId
-	retryErr := waitForTaskFinish(requestId, me.client.UseClbClient())
+	retryErr := waitForTaskFinish(ctx, requestId, me.client.UseClbClient())
 	if retryErr != nil {
 		return retryErr
 	}
@@ -144,8 +149,10 @@ func (me *ClbService) DescribeListenerById(ctx context.Context, listenerId strin
 	request := clb.NewDescribeListenersRequest()
 	request.ListenerIds = []*string{&listenerId}
 	request.LoadBalancerId = &clbId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().DescribeListeners(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClbClient().DescribeListenersWithContext(ctx, request)
 	if err != nil {
 		if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 			if e.GetCode() == "InvalidParameter.LBIdNotFound" {
@@ -191,8 +198,10 @@ func (me *ClbService) DescribeListenersByFilter(ctx context.Context, params map[
 	}
 
 	listeners = make([]*clb.Listener, 0)
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().DescribeListeners(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClbClient().DescribeListenersWithContext(ctx, request)
 	if err != nil {
 		if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 			if e.GetCode() == "InvalidParameter.LBIdNotFound" {
@@ -215,15 +224,17 @@ func (me *ClbService) DeleteListenerById(ctx context.Context, clbId string, list
 	request := clb.NewDeleteListenerRequest()
 	request.ListenerId = &listenerId
 	request.LoadBalancerId = &clbId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().DeleteListener(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseClbClient().DeleteListenerWithContext(ctx, request)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 	requestId := *response.Response.RequestId
-	retryErr := waitForTaskFinish(requestId, me.client.UseClbClient())
+	retryErr := waitForTaskFinish(ctx, requestId, me.client.UseClbClient())
 	if retryErr != nil {
 		return retryErr
 	}
@@ -234,8 +245,10 @@ func (me *ClbService) DescribeTaskStatusById(ctx context.Context, taskId string)
 	logId := getLogId(ctx)
 	request := clb.NewDescribeTaskStatusRequest()
 	request.TaskId = &taskId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().DescribeTaskStatus(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClbClient().DescribeTaskStatusWithContext(ctx, request)
 	if err != nil {
 		return 0, errors.WithStack(err)
 	}
@@ -283,8 +296,10 @@ func (me *ClbService) DescribeRulesByFilter(ctx context.Context, params map[stri
 	}
 	request.LoadBalancerId = &clbId
 	request.ListenerIds = []*string{&listenerId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().DescribeListeners(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClbClient().DescribeListenersWithContext(ctx, request)
 	if err != nil {
 		//in case that the lb is not exist, return empty
 		if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -336,8 +351,10 @@ func (me *ClbService) DescribeRuleByPara(ctx context.Context, clbId string, list
 	request := clb.NewDescribeListenersRequest()
 	request.ListenerIds = []*string{&listenerId}
 	request.LoadBalancerId = &clbId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().DescribeListeners(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClbClient().DescribeListenersWithContext(ctx, request)
 	if err != nil {
 		//in case that the lb is not exist, return empty
 		if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -381,15 +398,17 @@ func (me *ClbService) DeleteRuleById(ctx context.Context, clbId string, listener
 	request.ListenerId = &listenerId
 	request.LoadBalancerId = &clbId
 	request.LocationIds = []*string{&locationId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().DeleteRule(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseClbClient().DeleteRuleWithContext(ctx, request)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 	requestId := *response.Response.RequestId
-	retryErr := waitForTaskFinish(requestId, me.client.UseClbClient())
+	retryErr := waitForTaskFinish(ctx, requestId, me.client.UseClbClient())
 	if retryErr != nil {
 		return retryErr
 	}
@@ -401,8 +420,10 @@ func (me *ClbService) DescribeAttachmentByPara(ctx context.Context, clbId string
 	request := clb.NewDescribeListenersRequest()
 	request.ListenerIds = []*string{&listenerId}
 	request.LoadBalancerId = &clbId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().DescribeListeners(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClbClient().DescribeListenersWithContext(ctx, request)
 	if err != nil {
 		//in case that the lb is not exist, return empty
 		if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -429,8 +450,10 @@ func (me *ClbService) DescribeAttachmentByPara(ctx context.Context, clbId string
 	aRequest.LoadBalancerId = &clbId
 	aRequest.Protocol = protocol
 	aRequest.Port = port
-	ratelimit.Check(request.GetAction())
-	aResponse, aErr := me.client.UseClbClient().DescribeTargets(aRequest)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	aResponse, aErr := me.client.UseClbClient().DescribeTargetsWithContext(ctx, aRequest)
 
 	if aErr != nil {
 		//in case that the lb is not exist, return empty
@@ -478,8 +501,10 @@ func (me *ClbService) DescribeAttachmentsByFilter(ctx context.Context, params ma
 	}
 	request.LoadBalancerId = &clbId
 	request.ListenerIds = []*string{&listenerId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().DescribeListeners(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClbClient().DescribeListenersWithContext(ctx, request)
 	if err != nil {
 		//in case that the lb is not exist, return empty
 		if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -505,8 +530,10 @@ func (me *ClbService) DescribeAttachmentsByFilter(ctx context.Context, params ma
 	aRequest.LoadBalancerId = &clbId
 	aRequest.Protocol = protocol
 	aRequest.Port = port
-	ratelimit.Check(request.GetAction())
-	aResponse, aErr := me.client.UseClbClient().DescribeTargets(aRequest)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	aResponse, aErr := me.client.UseClbClient().DescribeTargetsWithContext(ctx, aRequest)
 
 	if aErr != nil {
 		//in case that the lb is not exist, return empty
@@ -542,8 +569,10 @@ func (me *ClbService) DeleteAttachmentById(ctx context.Context, clbId string, li
 	if locationId != "" {
 		request.LocationId = &locationId
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().DeregisterTargets(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseClbClient().DeregisterTargetsWithContext(ctx, request)
 	if err != nil {
 		ee, ok := err.(*sdkErrors.TencentCloudSDKError)
 		if ok && ee.GetCode() == "InvalidParameter" {
@@ -554,7 +583,7 @@ func (me *ClbService) DeleteAttachmentById(ctx context.Context, clbId string, li
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 	requestId := *response.Response.RequestId
-	retryErr := waitForTaskFinish(requestId, me.client.UseClbClient())
+	retryErr := waitForTaskFinish(ctx, requestId, me.client.UseClbClient())
 	if retryErr != nil {
 		return retryErr
 	}
@@ -563,7 +592,7 @@ func (me *ClbService) DeleteAttachmentById(ctx context.Context, clbId string, li
 
 func (me *ClbService) DescribeRedirectionById(ctx context.Context, rewriteId string) (rewriteInfo *map[string]string, errRet error) {
 	logId := getLogId(ctx)
-	items := strings.Split(rewriteId, "#")
+	items := helper.IdParse(rewriteId)
 	if len(items) != 5 {
 		errRet = fmt.Errorf("[CHECK][CLB redirection][Describe] check: redirection id %s is not with format loc-xxx#loc-xxx#lbl-xxx#lbl-xxx#lb-xxx", rewriteId)
 		errRet = errors.WithStack(errRet)
@@ -579,8 +608,10 @@ func (me *ClbService) DescribeRedirectionById(ctx context.Context, rewriteId str
 	request.LoadBalancerId = &clbId
 	request.SourceListenerIds = []*string{&sourceListenerId}
 	request.SourceLocationIds = []*string{&sourceLocId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().DescribeRewrite(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClbClient().DescribeRewriteWithContext(ctx, request)
 	if err != nil {
 		//in case that the lb is not exist, return empty
 		if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -618,7 +649,7 @@ func (me *ClbService) DescribeRedirectionById(ctx context.Context, rewriteId str
 
 func (me *ClbService) DescribeAllAutoRedirections(ctx context.Context, rewriteId string) (rewriteInfos []*map[string]string, errRet error) {
 	logId := getLogId(ctx)
-	items := strings.Split(rewriteId, "#")
+	items := helper.IdParse(rewriteId)
 	if len(items) != 5 {
 		errRet = fmt.Errorf("[CHECK][CLB redirection][Describe] check: redirection id %s is not with format loc-xxx#loc-xxx#lbl-xxx#lbl-xxx#lb-xxx", rewriteId)
 		errRet = errors.WithStack(errRet)
@@ -630,8 +661,10 @@ func (me *ClbService) DescribeAllAutoRedirections(ctx context.Context, rewriteId
 	request := clb.NewDescribeRewriteRequest()
 	request.LoadBalancerId = &clbId
 	request.SourceListenerIds = []*string{&sourceListenerId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().DescribeRewrite(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClbClient().DescribeRewriteWithContext(ctx, request)
 	if err != nil {
 		//in case that the lb is not exist, return empty
 		if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -701,8 +734,10 @@ func (me *ClbService) DescribeRedirectionsByFilter(ctx context.Context, params m
 	request.LoadBalancerId = &clbId
 	request.SourceListenerIds = []*string{&sourceListenerId}
 	request.SourceLocationIds = []*string{&sourceLocId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().DescribeRewrite(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClbClient().DescribeRewriteWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		errRet = errors.WithStack(errRet)
@@ -735,7 +770,7 @@ func (me *ClbService) DescribeRedirectionsByFilter(ctx context.Context, params m
 
 func (me *ClbService) DeleteRedirectionById(ctx context.Context, rewriteId string) error {
 	logId := getLogId(ctx)
-	items := strings.Split(rewriteId, "#")
+	items := helper.IdParse(rewriteId)
 	if len(items) != 5 {
 		errRet := fmt.Errorf("[CHECK][CLB redirection][Describe] check: redirection id %s is not with format loc-xxx#loc-xxx#lbl-xxx#lbl-xxx#lb-xxx", rewriteId)
 		errRet = errors.WithStack(errRet)
@@ -755,15 +790,17 @@ func (me *ClbService) DeleteRedirectionById(ctx context.Context, rewriteId strin
 	rewriteInfo.SourceLocationId = &sourceLocId
 	rewriteInfo.TargetLocationId = &targetLocId
 	request.RewriteInfos = []*clb.RewriteLocationMap{&rewriteInfo}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().DeleteRewrite(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseClbClient().DeleteRewriteWithContext(ctx, request)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 	requestId := *response.Response.RequestId
-	retryErr := waitForTaskFinish(requestId, me.client.UseClbClient())
+	retryErr := waitForTaskFinish(ctx, requestId, me.client.UseClbClient())
 	if retryErr != nil {
 		return retryErr
 	}
@@ -1002,21 +1039,25 @@ func checkCertificateInputPara(ctx context.Context, d *schema.ResourceData, meta
 	return
 }
 
-func waitForTaskFinish(requestId string, meta *clb.Client) (err error) {
+func waitForTaskFinish(ctx context.Context, requestId string, meta *clb.Client) (err error) {
 	taskQueryRequest := clb.NewDescribeTaskStatusRequest()
 	taskQueryRequest.TaskId = &requestId
-	err = resource.Retry(4*readRetryTimeout, func() *resource.RetryError {
-		taskResponse, e := meta.DescribeTaskStatus(taskQueryRequest)
-		if e != nil {
-			return resource.NonRetryableError(errors.WithStack(e))
-		}
-		if *taskResponse.Response.Status == int64(CLB_TASK_EXPANDING) {
-			return resource.RetryableError(errors.WithStack(fmt.Errorf("CLB task status is %d(expanding), requestId is %s", *taskResponse.Response.Status, *taskResponse.Response.RequestId)))
-		} else if *taskResponse.Response.Status == int64(CLB_TASK_FAIL) {
-			return resource.NonRetryableError(errors.WithStack(fmt.Errorf("CLB task status is %d(failed), requestId is %s", *taskResponse.Response.Status, *taskResponse.Response.RequestId)))
-		}
-		return nil
-	})
+	waiter := &helper.StateWaiter{
+		Name:    fmt.Sprintf("CLB task %s", requestId),
+		Pending: []string{helper.Int64ToStr(CLB_TASK_EXPANDING)},
+		Target:  []string{helper.Int64ToStr(CLB_TASK_SUCCESS)},
+		Failed:  []string{helper.Int64ToStr(CLB_TASK_FAIL)},
+		Refresh: func() (interface{}, string, error) {
+			taskResponse, e := meta.DescribeTaskStatusWithContext(ctx, taskQueryRequest)
+			if e != nil {
+				return nil, "", errors.WithStack(e)
+			}
+			return taskResponse.Response, helper.Int64ToStr(*taskResponse.Response.Status), nil
+		},
+		Retryable: retryableErrorFunc(ctx),
+		Timeout:   4 * readRetryTimeout,
+	}
+	_, err = waiter.WaitForStateContext(ctx)
 	return
 }
 
@@ -1068,8 +1109,8 @@ func (me *ClbService) CreateTargetGroup(ctx context.Context, targetGroupName str
 		request.VpcId = &vpcId
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, err = me.client.UseClbClient().CreateTargetGroup(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		response, err = me.client.UseClbClient().CreateTargetGroupWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -1098,9 +1139,11 @@ func (me *ClbService) CreateTopic(ctx context.Context, params map[string]interfa
 		request.PartitionCount = common.Uint64Ptr((uint64)(partitionCount.(int)))
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		resp, ok := me.client.UseClbClient().CreateTopic(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		resp, ok := me.client.UseClbClient().CreateTopicWithContext(ctx, request)
 		if ok != nil {
 			err = ok
 			return retryError(ok)
@@ -1117,8 +1160,8 @@ func (me *ClbService) ModifyTargetGroup(ctx context.Context, targetGroupId, targ
 	request.TargetGroupName = &targetGroupName
 	request.Port = &port
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseClbClient().ModifyTargetGroupAttribute(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseClbClient().ModifyTargetGroupAttributeWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -1141,8 +1184,8 @@ func (me *ClbService) RegisterTargetInstances(ctx context.Context, targetGroupId
 			Weight: &weight,
 		},
 	}
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseClbClient().RegisterTargetGroupInstances(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseClbClient().RegisterTargetGroupInstancesWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -1165,8 +1208,8 @@ func (me *ClbService) DeregisterTargetInstances(ctx context.Context, targetGroup
 		},
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseClbClient().DeregisterTargetGroupInstances(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseClbClient().DeregisterTargetGroupInstancesWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -1183,8 +1226,8 @@ func (me *ClbService) DeleteTarget(ctx context.Context, targetGroupId string) er
 	request := clb.NewDeleteTargetGroupsRequest()
 	request.TargetGroupIds = []*string{&targetGroupId}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, err := me.client.UseClbClient().DeleteTargetGroups(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, err := me.client.UseClbClient().DeleteTargetGroupsWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -1216,8 +1259,10 @@ func (me *ClbService) DescribeTargetGroups(ctx context.Context, targetGroupId st
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseClbClient().DescribeTargetGroups(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseClbClient().DescribeTargetGroupsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1256,8 +1301,10 @@ func (me *ClbService) DescribeTargetGroupInstances(ctx context.Context, filters
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseClbClient().DescribeTargetGroupInstances(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseClbClient().DescribeTargetGroupInstancesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1295,16 +1342,18 @@ func (me *ClbService) AssociateTargetGroups(ctx context.Context, listenerId, clb
 	}
 	request.Associations = append(request.Associations, &association)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseClbClient().AssociateTargetGroups(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := me.client.UseClbClient().AssociateTargetGroupsWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		} else {
 			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 			requestId := *response.Response.RequestId
-			retryErr := waitForTaskFinish(requestId, me.client.UseClbClient())
+			retryErr := waitForTaskFinish(ctx, requestId, me.client.UseClbClient())
 			if retryErr != nil {
 				return resource.NonRetryableError(errors.WithStack(retryErr))
 			}
@@ -1322,7 +1371,7 @@ func (me *ClbService) DescribeAssociateTargetGroups(ctx context.Context, ids []s
 		targetInfos []*clb.TargetGroupInfo
 	)
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		targetInfos, err = me.DescribeTargetGroups(ctx, ids[0], nil)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -1374,9 +1423,11 @@ func (me *ClbService) DisassociateTargetGroups(ctx context.Context, targetGroupI
 		},
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseClbClient().DisassociateTargetGroups(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseClbClient().DisassociateTargetGroupsWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -1397,9 +1448,11 @@ func (me *ClbService) ModifyTargetGroupInstancesWeight(ctx context.Context, targ
 	request.TargetGroupId = &targetGroupId
 	request.TargetGroupInstances = []*clb.TargetGroupInstance{&instance}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseClbClient().ModifyTargetGroupInstancesWeight(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseClbClient().ModifyTargetGroupInstancesWeightWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -1422,9 +1475,11 @@ func (me *ClbService) ModifyTargetGroupInstancesPort(ctx context.Context, target
 	request.TargetGroupId = &targetGroupId
 	request.TargetGroupInstances = []*clb.TargetGroupInstance{&instance}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseClbClient().ModifyTargetGroupInstancesPort(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseClbClient().ModifyTargetGroupInstancesPortWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -1445,9 +1500,11 @@ func (me *ClbService) ModifyTargetPort(ctx context.Context, loadBalancerId, list
 	request.Targets = []*clb.Target{&target}
 	request.NewPort = &newPort
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseClbClient().ModifyTargetPort(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseClbClient().ModifyTargetPortWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -1468,9 +1525,11 @@ func (me *ClbService) ModifyTargetWeight(ctx context.Context, loadBalancerId, li
 	request.Targets = []*clb.Target{&target}
 	request.Weight = &weight
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseClbClient().ModifyTargetWeight(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseClbClient().ModifyTargetWeightWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -1492,8 +1551,10 @@ func (me *ClbService) DescribeClbLogSet(ctx context.Context) (logSetId string, h
 				logId, "delete object", request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().DescribeClsLogSet(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClbClient().DescribeClsLogSetWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1515,8 +1576,10 @@ func (me *ClbService) CreateClbLogSet(ctx context.Context, name string, logsetTy
 	if logsetType != "" {
 		request.LogsetType = &logsetType
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().CreateClsLogSet(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClbClient().CreateClsLogSetWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1540,8 +1603,10 @@ func (me *ClbService) UpdateClsLogSet(ctx context.Context, request *cls.ModifyLo
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClsClient().ModifyLogset(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClsClient().ModifyLogsetWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -1557,8 +1622,10 @@ func (me *ClbService) DescribeLbCustomizedConfigById(ctx context.Context, config
 	request := clb.NewDescribeCustomizedConfigListRequest()
 	request.UconfigIds = []*string{&configId}
 	request.ConfigType = helper.String("CLB")
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().DescribeCustomizedConfigList(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClbClient().DescribeCustomizedConfigListWithContext(ctx, request)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
@@ -1578,8 +1645,10 @@ func (me *ClbService) DeleteLbCustomizedConfigById(ctx context.Context, configId
 	request := clb.NewSetCustomizedConfigForLoadBalancerRequest()
 	request.OperationType = helper.String("DELETE")
 	request.UconfigId = helper.String(configId)
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().SetCustomizedConfigForLoadBalancer(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClbClient().SetCustomizedConfigForLoadBalancerWithContext(ctx, request)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
@@ -1600,9 +1669,11 @@ func (me *ClbService) BindOrUnBindCustomizedConfigWithLbId(ctx context.Context,
 		lbId := lbIdsList[i].(string)
 		request.LoadBalancerIds = append(request.LoadBalancerIds, &lbId)
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClbClient().SetCustomizedConfigForLoadBalancer(request)
+	response, err := me.client.UseClbClient().SetCustomizedConfigForLoadBalancerWithContext(ctx, request)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
@@ -1625,8 +1696,10 @@ func (me *ClbService) CreateLoadBalancerSnatIps(ctx context.Context, id string,
 	request.LoadBalancerId = &id
 	request.SnatIps = ips
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().CreateLoadBalancerSnatIps(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClbClient().CreateLoadBalancerSnatIpsWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1654,8 +1727,10 @@ func (me *ClbService) DeleteLoadBalancerSnatIps(ctx context.Context, id string,
 	request.LoadBalancerId = &id
 	request.Ips = ips
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClbClient().DeleteLoadBalancerSnatIps(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClbClient().DeleteLoadBalancerSnatIpsWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1693,9 +1768,11 @@ func (me *ClbService) DescribeClbFunctionTargetsAttachmentById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClbClient().DescribeTargets(request)
+	response, err := me.client.UseClbClient().DescribeTargetsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1734,9 +1811,11 @@ func (me *ClbService) DeleteClbFunctionTargetsAttachmentById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClbClient().DeregisterFunctionTargets(request)
+	response, err := me.client.UseClbClient().DeregisterFunctionTargetsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1764,7 +1843,9 @@ func (me *ClbService) DescribeClbClusterResourcesByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -1773,7 +1854,7 @@ func (me *ClbService) DescribeClbClusterResourcesByFilter(ctx context.Context, p
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseClbClient().DescribeClusterResources(request)
+		response, err := me.client.UseClbClient().DescribeClusterResourcesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1812,7 +1893,9 @@ func (me *ClbService) DescribeClbCrossTargetsByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -1821,7 +1904,7 @@ func (me *ClbService) DescribeClbCrossTargetsByFilter(ctx context.Context, param
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseClbClient().DescribeCrossTargets(request)
+		response, err := me.client.UseClbClient().DescribeCrossTargetsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1860,7 +1943,9 @@ func (me *ClbService) DescribeClbExclusiveClustersByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -1869,7 +1954,7 @@ func (me *ClbService) DescribeClbExclusiveClustersByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseClbClient().DescribeExclusiveClusters(request)
+		response, err := me.client.UseClbClient().DescribeExclusiveClustersWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1908,7 +1993,9 @@ func (me *ClbService) DescribeClbIdleInstancesByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -1917,7 +2004,7 @@ func (me *ClbService) DescribeClbIdleInstancesByFilter(ctx context.Context, para
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseClbClient().DescribeIdleLoadBalancers(request)
+		response, err := me.client.UseClbClient().DescribeIdleLoadBalancersWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1956,9 +2043,11 @@ func (me *ClbService) DescribeClbListenersByTargets(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClbClient().DescribeLBListeners(request)
+	response, err := me.client.UseClbClient().DescribeLBListenersWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1988,9 +2077,11 @@ func (me *ClbService) DescribeClbInstanceByCertId(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClbClient().DescribeLoadBalancerListByCertId(request)
+	response, err := me.client.UseClbClient().DescribeLoadBalancerListByCertIdWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2020,9 +2111,11 @@ func (me *ClbService) DescribeClbInstanceTraffic(ctx context.Context, param map[
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClbClient().DescribeLoadBalancerTraffic(request)
+	response, err := me.client.UseClbClient().DescribeLoadBalancerTrafficWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2058,7 +2151,9 @@ func (me *ClbService) DescribeClbInstanceDetailByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -2067,7 +2162,7 @@ func (me *ClbService) DescribeClbInstanceDetailByFilter(ctx context.Context, par
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseClbClient().DescribeLoadBalancersDetail(request)
+		response, err := me.client.UseClbClient().DescribeLoadBalancersDetailWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2106,7 +2201,9 @@ func (me *ClbService) DescribeClbResourcesByFilter(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -2115,7 +2212,7 @@ func (me *ClbService) DescribeClbResourcesByFilter(ctx context.Context, param ma
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseClbClient().DescribeResources(request)
+		response, err := me.client.UseClbClient().DescribeResourcesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2157,7 +2254,9 @@ func (me *ClbService) DescribeClbTargetGroupListByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -2166,7 +2265,7 @@ func (me *ClbService) DescribeClbTargetGroupListByFilter(ctx context.Context, pa
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseClbClient().DescribeTargetGroupList(request)
+		response, err := me.client.UseClbClient().DescribeTargetGroupListWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2205,9 +2304,11 @@ func (me *ClbService) DescribeClbTargetHealthByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClbClient().DescribeTargetHealth(request)
+	response, err := me.client.UseClbClient().DescribeTargetHealthWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2233,9 +2334,11 @@ func (me *ClbService) SetClbSecurityGroup(ctx context.Context, securityGroup str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClbClient().SetSecurityGroupForLoadbalancers(request)
+	response, err := me.client.UseClbClient().SetSecurityGroupForLoadbalancersWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -36,7 +36,9 @@ func (me *ClsService) DescribeClsLogset(ctx context.Context, logsetId string) (l
 			Values: []*string{&logsetId},
 		},
 	)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset int64 = 0
 	var pageSize int64 = 100
@@ -45,8 +47,10 @@ func (me *ClsService) DescribeClsLogset(ctx context.Context, logsetId string) (l
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseClsClient().DescribeLogsets(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseClsClient().DescribeLogsetsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -88,8 +92,10 @@ func (me *ClsService) DeleteClsLogsetById(ctx context.Context, logsetId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClsClient().DeleteLogset(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClsClient().DeleteLogsetWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -128,8 +134,10 @@ func (me *ClsService) DescribeClsLogsetByFilter(ctx context.Context, filters map
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseClsClient().DescribeLogsets(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseClsClient().DescribeLogsetsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -180,8 +188,10 @@ func (me *ClsService) DescribeClsTopicByFilter(ctx context.Context, filters map[
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseClsClient().DescribeTopics(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseClsClient().DescribeTopicsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -222,7 +232,9 @@ func (me *ClsService) DescribeClsTopicById(ctx context.Context, topicId string)
 			Values: []*string{&topicId},
 		},
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 	var (
 		offset    int64 = 0
 		pageSize  int64 = 100
@@ -232,8 +244,10 @@ func (me *ClsService) DescribeClsTopicById(ctx context.Context, topicId string)
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseClsClient().DescribeTopics(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseClsClient().DescribeTopicsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -273,8 +287,10 @@ func (me *ClsService) DeleteClsTopic(ctx context.Context, id string) (errRet err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClsClient().DeleteTopic(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClsClient().DeleteTopicWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -314,8 +330,10 @@ func (me *ClsService) DescribeClsMachineGroupByFilter(ctx context.Context, filte
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseClsClient().DescribeMachineGroups(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseClsClient().DescribeMachineGroupsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -363,8 +381,10 @@ func (me *ClsService) DescribeClsMachineGroupById(ctx context.Context, id string
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseClsClient().DescribeMachineGroups(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseClsClient().DescribeMachineGroupsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -404,8 +424,10 @@ func (me *ClsService) DeleteClsMachineGroup(ctx context.Context, id string) (err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClsClient().DeleteMachineGroup(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClsClient().DeleteMachineGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -430,7 +452,7 @@ func (me *ClsService) DescribeClsMachineGroupByConfigId(ctx context.Context, con
 
 	request.ConfigId = &configId
 
-	response, err := me.client.UseClsClient().DescribeConfigMachineGroups(request)
+	response, err := me.client.UseClsClient().DescribeConfigMachineGroupsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -481,8 +503,10 @@ func (me *ClsService) DescribeClsCosShippersByFilter(ctx context.Context, filter
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseClsClient().DescribeShippers(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseClsClient().DescribeShippersWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -530,8 +554,10 @@ func (me *ClsService) DescribeClsCosShipperById(ctx context.Context, shipperId s
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseClsClient().DescribeShippers(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseClsClient().DescribeShippersWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -571,8 +597,10 @@ func (me *ClsService) DeleteClsCosShipper(ctx context.Context, id string) (errRe
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClsClient().DeleteShipper(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClsClient().DeleteShipperWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -601,7 +629,9 @@ func (me *ClsService) DescribeClsConfigById(ctx context.Context, configId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -611,7 +641,7 @@ func (me *ClsService) DescribeClsConfigById(ctx context.Context, configId string
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseClsClient().DescribeConfigs(request)
+		response, err := me.client.UseClsClient().DescribeConfigsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -649,8 +679,10 @@ func (me *ClsService) DeleteClsConfig(ctx context.Context, id string) (errRet er
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClsClient().DeleteConfig(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClsClient().DeleteConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -679,7 +711,9 @@ func (me *ClsService) DescribeClsConfigExtraById(ctx context.Context, configExtr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -689,7 +723,7 @@ func (me *ClsService) DescribeClsConfigExtraById(ctx context.Context, configExtr
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseClsClient().DescribeConfigExtras(request)
+		response, err := me.client.UseClsClient().DescribeConfigExtrasWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -727,8 +761,10 @@ func (me *ClsService) DeleteClsConfigExtra(ctx context.Context, id string) (errR
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClsClient().DeleteConfigExtra(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClsClient().DeleteConfigExtraWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -753,8 +789,10 @@ func (me *ClsService) DeleteClsIndex(ctx context.Context, id string) (errRet err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseClsClient().DeleteIndex(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseClsClient().DeleteIndexWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -781,7 +819,9 @@ func (me *ClsService) DescribeClsAlarmById(ctx context.Context, alarmId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -791,7 +831,7 @@ func (me *ClsService) DescribeClsAlarmById(ctx context.Context, alarmId string)
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseClsClient().DescribeAlarms(request)
+		response, err := me.client.UseClsClient().DescribeAlarmsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -828,9 +868,11 @@ func (me *ClsService) DeleteClsAlarmById(ctx context.Context, alarmId string) (e
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClsClient().DeleteAlarm(request)
+	response, err := me.client.UseClsClient().DeleteAlarmWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -856,7 +898,9 @@ func (me *ClsService) DescribeClsAlarmNoticeById(ctx context.Context, alarmNotic
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -866,7 +910,7 @@ func (me *ClsService) DescribeClsAlarmNoticeById(ctx context.Context, alarmNotic
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseClsClient().DescribeAlarmNotices(request)
+		response, err := me.client.UseClsClient().DescribeAlarmNoticesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -903,9 +947,11 @@ func (me *ClsService) DeleteClsAlarmNoticeById(ctx context.Context, alarmNoticeI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClsClient().DeleteAlarmNotice(request)
+	response, err := me.client.UseClsClient().DeleteAlarmNoticeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -927,9 +973,11 @@ func (me *ClsService) DescribeClsCkafkaConsumerById(ctx context.Context, topicId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClsClient().DescribeConsumer(request)
+	response, err := me.client.UseClsClient().DescribeConsumerWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -952,9 +1000,11 @@ func (me *ClsService) DeleteClsCkafkaConsumerById(ctx context.Context, topicId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClsClient().DeleteConsumer(request)
+	response, err := me.client.UseClsClient().DeleteConsumerWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -976,9 +1026,11 @@ func (me *ClsService) DescribeClsCosRechargeById(ctx context.Context, topicId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClsClient().DescribeCosRecharges(request)
+	response, err := me.client.UseClsClient().DescribeCosRechargesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1006,9 +1058,11 @@ func (me *ClsService) DescribeClsExportById(ctx context.Context, topicId string,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClsClient().DescribeExports(request)
+	response, err := me.client.UseClsClient().DescribeExportsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1037,9 +1091,11 @@ func (me *ClsService) DeleteClsExportById(ctx context.Context, exportId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClsClient().DeleteExport(request)
+	response, err := me.client.UseClsClient().DeleteExportWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1073,9 +1129,11 @@ func (me *ClsService) DescribeClsShipperTasksByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClsClient().DescribeShipperTasks(request)
+	response, err := me.client.UseClsClient().DescribeShipperTasksWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1105,9 +1163,11 @@ func (me *ClsService) DescribeClsMachinesByFilter(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClsClient().DescribeMachines(request)
+	response, err := me.client.UseClsClient().DescribeMachinesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1136,9 +1196,11 @@ func (me *ClsService) DescribeClsMachineGroupConfigsByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClsClient().DescribeMachineGroupConfigs(request)
+	response, err := me.client.UseClsClient().DescribeMachineGroupConfigsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1161,9 +1223,11 @@ func (me *ClsService) DescribeClsDataTransformById(ctx context.Context, taskId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClsClient().DescribeDataTransformInfo(request)
+	response, err := me.client.UseClsClient().DescribeDataTransformInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1190,9 +1254,11 @@ func (me *ClsService) DeleteClsDataTransformById(ctx context.Context, taskId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClsClient().DeleteDataTransform(request)
+	response, err := me.client.UseClsClient().DeleteDataTransformWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1213,9 +1279,11 @@ func (me *ClsService) DescribeClsKafkaRechargeById(ctx context.Context, id strin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClsClient().DescribeKafkaRecharges(request)
+	response, err := me.client.UseClsClient().DescribeKafkaRechargesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1242,9 +1310,11 @@ func (me *ClsService) DeleteClsKafkaRechargeById(ctx context.Context, id string,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClsClient().DeleteKafkaRecharge(request)
+	response, err := me.client.UseClsClient().DeleteKafkaRechargeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1266,9 +1336,11 @@ func (me *ClsService) DescribeClsScheduledSqlById(ctx context.Context, taskId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClsClient().DescribeScheduledSqlInfo(request)
+	response, err := me.client.UseClsClient().DescribeScheduledSqlInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1295,9 +1367,11 @@ func (me *ClsService) DeleteClsScheduledSqlById(ctx context.Context, taskId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseClsClient().DeleteScheduledSql(request)
+	response, err := me.client.UseClsClient().DeleteScheduledSqlWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
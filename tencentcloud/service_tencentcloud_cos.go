@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"strings"
 
 	"github.com/beevik/etree"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -47,8 +46,10 @@ func (me *CosService) HeadObject(ctx context.Context, bucket, key string) (info
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 	}
-	ratelimit.Check("HeadObject")
-	response, err := me.client.UseCosClient().HeadObject(&request)
+	if errRet = ratelimit.CheckContext(ctx, "HeadObject"); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCosClient().HeadObjectWithContext(ctx, &request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, "head object", request.String(), err.Error())
@@ -75,8 +76,10 @@ func (me *CosService) DeleteObject(ctx context.Context, bucket, key string) (err
 				logId, "delete object", request.String(), errRet.Error())
 		}
 	}()
-	ratelimit.Check("DeleteObject")
-	response, err := me.client.UseCosClient().DeleteObject(&request)
+	if errRet = ratelimit.CheckContext(ctx, "DeleteObject"); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCosClient().DeleteObjectWithContext(ctx, &request)
 	if err != nil {
 		errRet = fmt.Errorf("cos delete object error: %s, bucket: %s, object: %s", err.Error(), bucket, key)
 		return
@@ -102,8 +105,10 @@ func (me *CosService) PutObjectAcl(ctx context.Context, bucket, key, acl string)
 				logId, "put object acl", request.String(), errRet.Error())
 		}
 	}()
-	ratelimit.Check("PutObjectAcl")
-	response, err := me.client.UseCosClient().PutObjectAcl(&request)
+	if errRet = ratelimit.CheckContext(ctx, "PutObjectAcl"); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCosClient().PutObjectAclWithContext(ctx, &request)
 	if err != nil {
 		errRet = fmt.Errorf("cos put object acl error: %s, bucket: %s, object: %s", err.Error(), bucket, key)
 		return
@@ -129,8 +134,10 @@ func (me *CosService) PutBucket(ctx context.Context, bucket, acl string) (errRet
 				logId, "put bucket", request.String(), errRet.Error())
 		}
 	}()
-	ratelimit.Check("CreateBucket")
-	response, err := me.client.UseCosClient().CreateBucket(&request)
+	if errRet = ratelimit.CheckContext(ctx, "CreateBucket"); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCosClient().CreateBucketWithContext(ctx, &request)
 	if err != nil {
 		errRet = fmt.Errorf("cos put bucket error: %s, bucket: %s", err.Error(), bucket)
 		return
@@ -155,7 +162,9 @@ func (me *CosService) TencentCosPutBucket(ctx context.Context, bucket string, op
 		}
 	}()
 
-	ratelimit.Check("TencentcloudCosPutBucket")
+	if errRet = ratelimit.CheckContext(ctx, "TencentcloudCosPutBucket"); errRet != nil {
+		return
+	}
 	response, err := me.client.UseTencentCosClient(bucket).Bucket.Put(ctx, opt)
 
 	if err != nil {
@@ -183,7 +192,9 @@ func (me *CosService) TencentCosBucketGetLocation(ctx context.Context, bucket st
 		}
 	}()
 
-	ratelimit.Check("TencentcloudCosPutBucket")
+	if errRet = ratelimit.CheckContext(ctx, "TencentcloudCosPutBucket"); errRet != nil {
+		return
+	}
 	result, response, err := me.client.UseTencentCosClient(bucket).Bucket.GetLocation(ctx)
 	if err != nil {
 		errRet = fmt.Errorf("cos get location error: %s, bucket: %s", err.Error(), bucket)
@@ -232,7 +243,9 @@ func (me *CosService) TencentCosPutBucketACLBody(
 		}
 	}()
 
-	ratelimit.Check("TencentcloudCosPutBucketACLBody")
+	if errRet = ratelimit.CheckContext(ctx, "TencentcloudCosPutBucketACLBody"); errRet != nil {
+		return
+	}
 	response, err := me.client.UseTencentCosClient(bucket).Bucket.PutACL(ctx, opt)
 
 	if err != nil {
@@ -254,8 +267,10 @@ func (me *CosService) HeadBucket(ctx context.Context, bucket string) (errRet err
 	request := s3.HeadBucketInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("HeadBucket")
-	response, err := me.client.UseCosClient().HeadBucket(&request)
+	if errRet = ratelimit.CheckContext(ctx, "HeadBucket"); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCosClient().HeadBucketWithContext(ctx, &request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, "head bucket", request.String(), err.Error())
@@ -307,8 +322,10 @@ func (me *CosService) DeleteBucket(ctx context.Context, bucket string, forced bo
 	request := s3.DeleteBucketInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("DeleteBucket")
-	response, err := me.client.UseCosClient().DeleteBucket(&request)
+	if errRet = ratelimit.CheckContext(ctx, "DeleteBucket"); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCosClient().DeleteBucketWithContext(ctx, &request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, "delete bucket", request.String(), err.Error())
@@ -390,7 +407,7 @@ func (me *CosService) ForceCleanObject(ctx context.Context, bucket string, versi
 		log.Printf("[CRITAL]%s api[%s] it still [%v] objects have not been removed, need try DeleteMulti again.\n",
 			logId, "DeleteMulti", len(result.Errors))
 
-		if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			unDelObjs := make([]cos.Object, 0, len(result.Errors))
 			for _, v := range result.Errors {
 				unDelObjs = append(unDelObjs, cos.Object{
@@ -431,8 +448,10 @@ func (me *CosService) GetBucketCors(ctx context.Context, bucket string) (corsRul
 		Bucket: aws.String(bucket),
 	}
 
-	ratelimit.Check("GetBucketCors")
-	response, err := me.client.UseCosClient().GetBucketCors(&request)
+	if errRet = ratelimit.CheckContext(ctx, "GetBucketCors"); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCosClient().GetBucketCorsWithContext(ctx, &request)
 	if err != nil {
 		awsError, ok := err.(awserr.Error)
 		if !ok || awsError.Code() != "NoSuchCORSConfiguration" {
@@ -474,8 +493,10 @@ func (me *CosService) GetBucketLifecycle(ctx context.Context, bucket string) (li
 	request := s3.GetBucketLifecycleConfigurationInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("GetBucketLifecycleConfiguration")
-	response, err := me.client.UseCosClient().GetBucketLifecycleConfiguration(&request)
+	if errRet = ratelimit.CheckContext(ctx, "GetBucketLifecycleConfiguration"); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCosClient().GetBucketLifecycleConfigurationWithContext(ctx, &request)
 	if err != nil {
 		awsError, ok := err.(awserr.Error)
 		if !ok || awsError.Code() != "NoSuchLifecycleConfiguration" {
@@ -578,8 +599,10 @@ func (me *CosService) GetDataSourceBucketLifecycle(ctx context.Context, bucket s
 		Bucket: aws.String(bucket),
 	}
 
-	ratelimit.Check("GetBucketLifecycleConfiguration")
-	response, err := me.client.UseCosClient().GetBucketLifecycleConfiguration(&request)
+	if errRet = ratelimit.CheckContext(ctx, "GetBucketLifecycleConfiguration"); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCosClient().GetBucketLifecycleConfigurationWithContext(ctx, &request)
 	if err != nil {
 		awsError, ok := err.(awserr.Error)
 		if !ok || awsError.Code() != "NoSuchLifecycleConfiguration" {
@@ -673,8 +696,10 @@ func (me *CosService) GetBucketWebsite(ctx context.Context, bucket string) (webs
 	request := s3.GetBucketWebsiteInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("GetBucketWebsite")
-	response, err := me.client.UseCosClient().GetBucketWebsite(&request)
+	if errRet = ratelimit.CheckContext(ctx, "GetBucketWebsite"); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCosClient().GetBucketWebsiteWithContext(ctx, &request)
 	if err != nil {
 		awsError, ok := err.(awserr.Error)
 		if ok && awsError.Code() == "NoSuchWebsiteConfiguration" {
@@ -710,8 +735,10 @@ func (me *CosService) GetBucketEncryption(ctx context.Context, bucket string) (e
 	request := s3.GetBucketEncryptionInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("GetBucketEncryption")
-	response, err := me.client.UseCosClient().GetBucketEncryption(&request)
+	if errRet = ratelimit.CheckContext(ctx, "GetBucketEncryption"); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCosClient().GetBucketEncryptionWithContext(ctx, &request)
 	if err != nil {
 		awsError, ok := err.(awserr.Error)
 		if ok && awsError.Code() == "NoSuchEncryptionConfiguration" {
@@ -738,8 +765,10 @@ func (me *CosService) GetBucketVersioning(ctx context.Context, bucket string) (v
 	request := s3.GetBucketVersioningInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("GetBucketVersioning")
-	response, err := me.client.UseCosClient().GetBucketVersioning(&request)
+	if errRet = ratelimit.CheckContext(ctx, "GetBucketVersioning"); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCosClient().GetBucketVersioningWithContext(ctx, &request)
 	if err != nil {
 		awsError, ok := err.(awserr.Error)
 		if ok && awsError.Code() == "NoSuchVersioningConfiguration" {
@@ -769,8 +798,10 @@ func (me *CosService) GetBucketAccleration(ctx context.Context, bucket string) (
 	request := s3.GetBucketAccelerateConfigurationInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("GetBucketAccelerateConfiguration")
-	response, err := me.client.UseCosClient().GetBucketAccelerateConfiguration(&request)
+	if errRet = ratelimit.CheckContext(ctx, "GetBucketAccelerateConfiguration"); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCosClient().GetBucketAccelerateConfigurationWithContext(ctx, &request)
 	if err != nil {
 		awsError, ok := err.(awserr.Error)
 		if ok && awsError.Code() == "NoSuchAccelerateConfiguration" {
@@ -800,8 +831,10 @@ func (me *CosService) GetBucketLogStatus(ctx context.Context, bucket string) (lo
 	request := s3.GetBucketLoggingInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("GetBucketVersioning")
-	response, err := me.client.UseCosClient().GetBucketLogging(&request)
+	if errRet = ratelimit.CheckContext(ctx, "GetBucketVersioning"); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCosClient().GetBucketLoggingWithContext(ctx, &request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, "get bucket log status", request.String(), err.Error())
@@ -827,8 +860,10 @@ func (me *CosService) ListBuckets(ctx context.Context) (buckets []*s3.Bucket, er
 	logId := getLogId(ctx)
 
 	request := s3.ListBucketsInput{}
-	ratelimit.Check("ListBuckets")
-	response, err := me.client.UseCosClient().ListBuckets(&request)
+	if errRet = ratelimit.CheckContext(ctx, "ListBuckets"); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCosClient().ListBucketsWithContext(ctx, &request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, "get bucket list", request.String(), err.Error())
@@ -848,8 +883,10 @@ func (me *CosService) ListObjects(ctx context.Context, bucket string) (objects [
 	request := s3.ListObjectsInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("ListObjects")
-	response, err := me.client.UseCosClient().ListObjects(&request)
+	if errRet = ratelimit.CheckContext(ctx, "ListObjects"); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCosClient().ListObjectsWithContext(ctx, &request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, "get object list", request.String(), err.Error())
@@ -869,9 +906,11 @@ func (me *CosService) SetBucketTags(ctx context.Context, bucket string, tags map
 
 	deleteReq := &s3.DeleteBucketTaggingInput{Bucket: aws.String(bucket)}
 
-	ratelimit.Check("DeleteBucketTagging")
+	if err := ratelimit.CheckContext(ctx, "DeleteBucketTagging"); err != nil {
+		return err
+	}
 
-	deleteResp, err := me.client.UseCosClient().DeleteBucketTagging(deleteReq)
+	deleteResp, err := me.client.UseCosClient().DeleteBucketTaggingWithContext(ctx, deleteReq)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 			logId, "delete olg tags", deleteReq.String(), err)
@@ -897,9 +936,11 @@ func (me *CosService) SetBucketTags(ctx context.Context, bucket string, tags map
 		})
 	}
 
-	ratelimit.Check("PutBucketTagging")
+	if err := ratelimit.CheckContext(ctx, "PutBucketTagging"); err != nil {
+		return err
+	}
 
-	resp, err := me.client.UseCosClient().PutBucketTagging(putReq)
+	resp, err := me.client.UseCosClient().PutBucketTaggingWithContext(ctx, putReq)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 			logId, "put new tags", deleteReq.String(), err)
@@ -917,9 +958,11 @@ func (me *CosService) GetBucketTags(ctx context.Context, bucket string) (map[str
 
 	req := &s3.GetBucketTaggingInput{Bucket: aws.String(bucket)}
 
-	ratelimit.Check("GetBucketTagging")
+	if err := ratelimit.CheckContext(ctx, "GetBucketTagging"); err != nil {
+		return nil, err
+	}
 
-	resp, err := me.client.UseCosClient().GetBucketTagging(req)
+	resp, err := me.client.UseCosClient().GetBucketTaggingWithContext(ctx, req)
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != "404" {
 			return nil, nil
@@ -949,8 +992,10 @@ func (me *CosService) GetObjectTags(ctx context.Context, bucket string, key stri
 		Key:    &key,
 	}
 
-	ratelimit.Check("GetObjectTagging")
-	resp, err := me.client.UseCosClient().GetObjectTagging(req)
+	if err := ratelimit.CheckContext(ctx, "GetObjectTagging"); err != nil {
+		return nil, err
+	}
+	resp, err := me.client.UseCosClient().GetObjectTaggingWithContext(ctx, req)
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != "404" {
 			return nil, nil
@@ -982,9 +1027,11 @@ func (me *CosService) SetObjectTags(ctx context.Context, bucket string, key stri
 		Key:    aws.String(key),
 	}
 
-	ratelimit.Check("DeleteObjectTagging")
+	if err := ratelimit.CheckContext(ctx, "DeleteObjectTagging"); err != nil {
+		return err
+	}
 
-	deleteResp, err := me.client.UseCosClient().DeleteObjectTagging(deleteReq)
+	deleteResp, err := me.client.UseCosClient().DeleteObjectTaggingWithContext(ctx, deleteReq)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 			logId, "delete olg object tags", deleteReq.String(), err)
@@ -1011,9 +1058,11 @@ func (me *CosService) SetObjectTags(ctx context.Context, bucket string, key stri
 		})
 	}
 
-	ratelimit.Check("PutObjectTagging")
+	if err := ratelimit.CheckContext(ctx, "PutObjectTagging"); err != nil {
+		return err
+	}
 
-	resp, err := me.client.UseCosClient().PutObjectTagging(putReq)
+	resp, err := me.client.UseCosClient().PutObjectTaggingWithContext(ctx, putReq)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 			logId, "put new object tags", deleteReq.String(), err)
@@ -1039,8 +1088,10 @@ func (me *CosService) PutBucketPolicy(ctx context.Context, bucket, policy string
 				logId, "put bucket", request.String(), errRet.Error())
 		}
 	}()
-	ratelimit.Check("PutBucketPolicy")
-	response, err := me.client.UseCosClient().PutBucketPolicy(&request)
+	if errRet = ratelimit.CheckContext(ctx, "PutBucketPolicy"); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCosClient().PutBucketPolicyWithContext(ctx, &request)
 	if err != nil {
 		errRet = fmt.Errorf("cos put bucket policy error: %s, bucket: %s", err.Error(), bucket)
 		return
@@ -1056,8 +1107,10 @@ func (me *CosService) DescribePolicyByBucket(ctx context.Context, bucket string)
 	logId := getLogId(ctx)
 	request := s3.GetBucketPolicyInput{Bucket: aws.String(bucket)}
 
-	ratelimit.Check("GetBucketPolicy")
-	response, err := me.client.UseCosClient().GetBucketPolicy(&request)
+	if errRet = ratelimit.CheckContext(ctx, "GetBucketPolicy"); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCosClient().GetBucketPolicyWithContext(ctx, &request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, "get bucket policy", request.String(), err.Error())
@@ -1076,8 +1129,10 @@ func (me *CosService) DeleteBucketPolicy(ctx context.Context, bucket string) (er
 	request := s3.DeleteBucketPolicyInput{
 		Bucket: aws.String(bucket),
 	}
-	ratelimit.Check("DeleteBucketPolicy")
-	response, err := me.client.UseCosClient().DeleteBucketPolicy(&request)
+	if errRet = ratelimit.CheckContext(ctx, "DeleteBucketPolicy"); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCosClient().DeleteBucketPolicyWithContext(ctx, &request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, "delete bucket policy", request.String(), err.Error())
@@ -1099,7 +1154,9 @@ func (me *CosService) GetBucketACL(ctx context.Context, bucket string) (result *
 		}
 	}()
 
-	ratelimit.Check("TencentcloudCosPutBucketACL")
+	if errRet = ratelimit.CheckContext(ctx, "TencentcloudCosPutBucketACL"); errRet != nil {
+		return
+	}
 	acl, _, err := me.client.UseTencentCosClient(bucket).Bucket.GetACL(ctx)
 
 	if err != nil {
@@ -1158,7 +1215,9 @@ func (me *CosService) GetBucketPullOrigin(ctx context.Context, bucket string) (r
 		}
 	}()
 
-	ratelimit.Check("TencentcloudCosGetBucketPullOrigin")
+	if errRet = ratelimit.CheckContext(ctx, "TencentcloudCosGetBucketPullOrigin"); errRet != nil {
+		return
+	}
 	originConfig, response, err := me.client.UseTencentCosClient(bucket).Bucket.GetOrigin(ctx)
 
 	if response.StatusCode == 404 {
@@ -1236,7 +1295,9 @@ func (me *CosService) PutBucketPullOrigin(ctx context.Context, bucket string, ru
 	opt := &cos.BucketPutOriginOptions{
 		Rule: rules,
 	}
-	ratelimit.Check("PutBucketPullOrigin")
+	if errRet = ratelimit.CheckContext(ctx, "PutBucketPullOrigin"); errRet != nil {
+		return
+	}
 	response, err := me.client.UseTencentCosClient(bucket).Bucket.PutOrigin(ctx, opt)
 	req, _ := json.Marshal(opt)
 	resp, _ := json.Marshal(response.Response.Body)
@@ -1269,7 +1330,9 @@ func (me *CosService) DeleteBucketPullOrigin(ctx context.Context, bucket string)
 		}
 	}()
 
-	ratelimit.Check("DeleteBucketPullOrigin")
+	if errRet = ratelimit.CheckContext(ctx, "DeleteBucketPullOrigin"); errRet != nil {
+		return
+	}
 	response, err := me.client.UseTencentCosClient(bucket).Bucket.DeleteOrigin(ctx)
 	resp, _ := json.Marshal(response.Response.Body)
 
@@ -1294,7 +1357,9 @@ func (me *CosService) GetBucketOriginDomain(ctx context.Context, bucket string)
 		}
 	}()
 
-	ratelimit.Check("TencentcloudCosGetBucketOriginDomain")
+	if errRet = ratelimit.CheckContext(ctx, "TencentcloudCosGetBucketOriginDomain"); errRet != nil {
+		return
+	}
 	domain, response, err := me.client.UseTencentCosClient(bucket).Bucket.GetDomain(ctx)
 
 	if response != nil && response.StatusCode == 404 {
@@ -1331,7 +1396,9 @@ func (me *CosService) PutBucketOriginDomain(ctx context.Context, bucket string,
 	opt := &cos.BucketPutDomainOptions{
 		Rules: rules,
 	}
-	ratelimit.Check("PutBucketOriginDomain")
+	if errRet = ratelimit.CheckContext(ctx, "PutBucketOriginDomain"); errRet != nil {
+		return
+	}
 	response, err := me.client.UseTencentCosClient(bucket).Bucket.PutDomain(ctx, opt)
 	req, _ := json.Marshal(opt)
 	resp, _ := json.Marshal(response.Response.Body)
@@ -1364,7 +1431,9 @@ func (me *CosService) DeleteBucketOriginDomain(ctx context.Context, bucket strin
 		}
 	}()
 
-	ratelimit.Check("DeleteBucketOriginDomain")
+	if errRet = ratelimit.CheckContext(ctx, "DeleteBucketOriginDomain"); errRet != nil {
+		return
+	}
 	response, err := me.client.UseTencentCosClient(bucket).Bucket.DeleteDomain(ctx)
 	resp, _ := json.Marshal(response.Response.Body)
 
@@ -1388,7 +1457,9 @@ func (me *CosService) GetBucketReplication(ctx context.Context, bucket string) (
 		}
 	}()
 
-	ratelimit.Check("GetBucketReplication")
+	if errRet = ratelimit.CheckContext(ctx, "GetBucketReplication"); errRet != nil {
+		return
+	}
 	result, response, err := me.client.UseTencentCosClient(bucket).Bucket.GetBucketReplication(ctx)
 
 	if response.StatusCode == 404 {
@@ -1426,7 +1497,9 @@ func (me *CosService) PutBucketReplication(ctx context.Context, bucket string, r
 		}
 	}()
 
-	ratelimit.Check("PutBucketReplication")
+	if errRet = ratelimit.CheckContext(ctx, "PutBucketReplication"); errRet != nil {
+		return
+	}
 	response, err := me.client.UseTencentCosClient(bucket).Bucket.PutBucketReplication(ctx, option)
 
 	resp, _ := json.Marshal(response.Response.Body)
@@ -1451,7 +1524,9 @@ func (me *CosService) DeleteBucketReplication(ctx context.Context, bucket string
 		}
 	}()
 
-	ratelimit.Check("DeleteBucketReplication")
+	if errRet = ratelimit.CheckContext(ctx, "DeleteBucketReplication"); errRet != nil {
+		return
+	}
 	response, err := me.client.UseTencentCosClient(bucket).Bucket.DeleteBucketReplication(ctx)
 
 	resp, _ := json.Marshal(response.Response.Body)
@@ -1530,7 +1605,9 @@ func (me *CosService) DeleteCosBucketDomainCertificate(ctx context.Context, cert
 		}
 	}()
 
-	ratelimit.Check("DeleteDomainCertificate")
+	if errRet = ratelimit.CheckContext(ctx, "DeleteDomainCertificate"); errRet != nil {
+		return
+	}
 	response, err := me.client.UseTencentCosClient(bucket).Bucket.DeleteDomainCertificate(ctx, option)
 
 	if err != nil {
@@ -1547,9 +1624,9 @@ func (me *CosService) DeleteCosBucketDomainCertificate(ctx context.Context, cert
 }
 
 func (me *CosService) parseCertId(configId string) (ret *CosBucketDomainCertItem, err error) {
-	idSplit := strings.Split(configId, FILED_SP)
-	if len(idSplit) != 2 {
-		return nil, fmt.Errorf("id is broken,%s", configId)
+	idSplit, err := helper.IdParseN(configId, 2)
+	if err != nil {
+		return nil, err
 	}
 
 	bucket := idSplit[0]
@@ -1622,7 +1699,9 @@ func (me *CosService) BucketPutIntelligentTiering(ctx context.Context, bucket st
 		}
 	}()
 
-	ratelimit.Check("BucketPutIntelligentTiering")
+	if errRet = ratelimit.CheckContext(ctx, "BucketPutIntelligentTiering"); errRet != nil {
+		return
+	}
 	response, err := me.client.UseTencentCosClient(bucket).Bucket.PutIntelligentTiering(ctx, opt)
 	if err != nil {
 		errRet = fmt.Errorf("cos bucket put intelligent tiering error: %s, bucket: %s", err.Error(), bucket)
@@ -1645,7 +1724,9 @@ func (me *CosService) BucketPutIntelligentTiering(ctx context.Context, bucket st
 func (me *CosService) BucketGetIntelligentTiering(ctx context.Context, bucket string) (result *cos.BucketGetIntelligentTieringResult, errRet error) {
 	logId := getLogId(ctx)
 
-	ratelimit.Check("BucketGetIntelligentTiering")
+	if errRet = ratelimit.CheckContext(ctx, "BucketGetIntelligentTiering"); errRet != nil {
+		return
+	}
 	intelligentTieringResult, response, err := me.client.UseTencentCosClient(bucket).Bucket.GetIntelligentTiering(ctx)
 	resp, _ := json.Marshal(response.Response.Body)
 	if response.StatusCode == 404 {
@@ -30,7 +30,7 @@ func (me *CssService) DescribeCssWatermark(ctx context.Context, watermarkId stri
 
 	request.WatermarkId = helper.StrToUint64Point(watermarkId)
 
-	response, err := me.client.UseCssClient().DescribeLiveWatermark(request)
+	response, err := me.client.UseCssClient().DescribeLiveWatermarkWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITICAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -59,7 +59,7 @@ func (me *CssService) DescribeCssWatermarks(ctx context.Context) (marks []*css.W
 		}
 	}()
 
-	response, err := me.client.UseCssClient().DescribeLiveWatermarks(request)
+	response, err := me.client.UseCssClient().DescribeLiveWatermarksWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITICAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -89,8 +89,10 @@ func (me *CssService) DeleteCssWatermarkById(ctx context.Context, watermarkId *i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCssClient().DeleteLiveWatermark(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCssClient().DeleteLiveWatermarkWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -118,7 +120,7 @@ func (me *CssService) DescribeCssPullStreamTask(ctx context.Context, taskId stri
 		request.TaskId = &taskId
 	}
 
-	response, err := me.client.UseCssClient().DescribeLivePullStreamTasks(request)
+	response, err := me.client.UseCssClient().DescribeLivePullStreamTasksWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITICAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -149,8 +151,10 @@ func (me *CssService) DeleteCssPullStreamTaskById(ctx context.Context, taskId, o
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCssClient().DeleteLivePullStreamTask(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCssClient().DeleteLivePullStreamTaskWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -175,7 +179,7 @@ func (me *CssService) DescribeCssLiveTranscodeTemplate(ctx context.Context, temp
 	}()
 	request.TemplateId = templateId
 
-	response, err := me.client.UseCssClient().DescribeLiveTranscodeTemplate(request)
+	response, err := me.client.UseCssClient().DescribeLiveTranscodeTemplateWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITICAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -204,7 +208,7 @@ func (me *CssService) DescribeCssLiveTranscodeTemplates(ctx context.Context) (te
 		}
 	}()
 
-	response, err := me.client.UseCssClient().DescribeLiveTranscodeTemplates(request)
+	response, err := me.client.UseCssClient().DescribeLiveTranscodeTemplatesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITICAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -234,8 +238,10 @@ func (me *CssService) DeleteCssLiveTranscodeTemplateById(ctx context.Context, te
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCssClient().DeleteLiveTranscodeTemplate(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCssClient().DeleteLiveTranscodeTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -267,7 +273,7 @@ func (me *CssService) DescribeCssLiveTranscodeRuleAttachment(ctx context.Context
 		request.TemplateIds = []*int64{helper.StrToInt64Point(*templateId)}
 	}
 
-	response, err := me.client.UseCssClient().DescribeLiveTranscodeRules(request)
+	response, err := me.client.UseCssClient().DescribeLiveTranscodeRulesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITICAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -300,8 +306,10 @@ func (me *CssService) DeleteCssLiveTranscodeRuleAttachmentById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCssClient().DeleteLiveTranscodeRule(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCssClient().DeleteLiveTranscodeRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -324,9 +332,11 @@ func (me *CssService) DescribeCssDomainById(ctx context.Context, name string) (d
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCssClient().DescribeLiveDomain(request)
+	response, err := me.client.UseCssClient().DescribeLiveDomainWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -358,9 +368,11 @@ func (me *CssService) DeleteCssDomainById(ctx context.Context, name *string, dty
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCssClient().DeleteLiveDomain(request)
+	response, err := me.client.UseCssClient().DeleteLiveDomainWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -405,7 +417,9 @@ func (me *CssService) DescribeCssDomainsByFilter(ctx context.Context, param map[
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var currNumber uint64 = 1
 	var pageSize uint64 = 20
@@ -414,7 +428,7 @@ func (me *CssService) DescribeCssDomainsByFilter(ctx context.Context, param map[
 		request.PageNum = &currNumber
 		request.PageSize = &pageSize
 
-		response, err := me.client.UseCssClient().DescribeLiveDomains(request)
+		response, err := me.client.UseCssClient().DescribeLiveDomainsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -449,7 +463,9 @@ func (me *CssService) DescribeCssPlayDomainCertAttachmentById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -459,7 +475,7 @@ func (me *CssService) DescribeCssPlayDomainCertAttachmentById(ctx context.Contex
 	for {
 		request.Offset = &offset
 		request.Length = &limit
-		response, err := me.client.UseCssClient().DescribeLiveDomainCertBindings(request)
+		response, err := me.client.UseCssClient().DescribeLiveDomainCertBindingsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -501,9 +517,11 @@ func (me *CssService) DeleteCssPlayDomainCertAttachmentById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCssClient().UnBindLiveDomainCert(request)
+	response, err := me.client.UseCssClient().UnBindLiveDomainCertWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -525,9 +543,11 @@ func (me *CssService) DescribeCssPlayAuthKeyConfigById(ctx context.Context, doma
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCssClient().DescribeLivePlayAuthKey(request)
+	response, err := me.client.UseCssClient().DescribeLivePlayAuthKeyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -554,9 +574,11 @@ func (me *CssService) DescribeCssPushAuthKeyConfigById(ctx context.Context, doma
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCssClient().DescribeLivePushAuthKey(request)
+	response, err := me.client.UseCssClient().DescribeLivePushAuthKeyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -588,7 +610,7 @@ func (me *CssService) DescribeCssWatermarkRuleAttachment(ctx context.Context, do
 	// request.StreamName = &streamName
 	// request.WatermarkId = &watermarkId
 
-	response, err := me.client.UseCssClient().DescribeLiveWatermarkRules(request)
+	response, err := me.client.UseCssClient().DescribeLiveWatermarkRulesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITICAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -627,8 +649,10 @@ func (me *CssService) DetachCssWatermarkRuleAttachment(ctx context.Context, doma
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCssClient().DeleteLiveWatermarkRule(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCssClient().DeleteLiveWatermarkRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -39,8 +39,10 @@ func (me *CvmService) DescribeInstanceSetByIds(ctx context.Context, instanceSetI
 
 	request.Limit = helper.IntInt64(100)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCvmClient().DescribeInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -62,8 +64,10 @@ func (me *CvmService) DescribeInstanceById(ctx context.Context, instanceId strin
 	request := cvm.NewDescribeInstancesRequest()
 	request.InstanceIds = []*string{&instanceId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCvmClient().DescribeInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -102,8 +106,10 @@ func (me *CvmService) DescribeInstanceByFilter(ctx context.Context, instancesId
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCvmClient().DescribeInstances(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCvmClient().DescribeInstancesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -138,7 +144,7 @@ func (me *CvmService) DescribeInstanceInParallelByFilter(ctx context.Context, fi
 		request.Filters = append(request.Filters, &filter)
 	}
 
-	response, err := me.client.UseCvmClient().DescribeInstances(request)
+	response, err := me.client.UseCvmClient().DescribeInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -180,8 +186,12 @@ func (me *CvmService) DescribeInstanceInParallelByFilter(ctx context.Context, fi
 			request.Offset = helper.IntInt64(offset)
 			request.Limit = helper.IntInt64(limit)
 
-			ratelimit.Check(request.GetAction())
-			response, err := me.client.UseCvmClient().DescribeInstances(request)
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				errRet = err
+				wg.Done()
+				return
+			}
+			response, err := me.client.UseCvmClient().DescribeInstancesWithContext(ctx, request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 					logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -214,8 +224,10 @@ func (me *CvmService) ModifyInstanceName(ctx context.Context, instanceId, instan
 	request.InstanceIds = []*string{&instanceId}
 	request.InstanceName = &instanceName
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyInstancesAttribute(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCvmClient().ModifyInstancesAttributeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -233,8 +245,10 @@ func (me *CvmService) ModifySecurityGroups(ctx context.Context, instanceId strin
 	request.InstanceIds = []*string{&instanceId}
 	request.SecurityGroups = securityGroups
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyInstancesAttribute(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCvmClient().ModifyInstancesAttributeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -252,8 +266,10 @@ func (me *CvmService) ModifyDisableApiTermination(ctx context.Context, instanceI
 	request.InstanceIds = []*string{&instanceId}
 	request.DisableApiTermination = &disableApiTermination
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyInstancesAttribute(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCvmClient().ModifyInstancesAttributeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -271,8 +287,10 @@ func (me *CvmService) ModifyProjectId(ctx context.Context, instanceId string, pr
 	request.InstanceIds = []*string{&instanceId}
 	request.ProjectId = &projectId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyInstancesProject(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCvmClient().ModifyInstancesProjectWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -291,8 +309,10 @@ func (me *CvmService) ModifyInstanceType(ctx context.Context, instanceId, instan
 	request.InstanceType = &instanceType
 	request.ForceStop = helper.Bool(true)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ResetInstancesType(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCvmClient().ResetInstancesTypeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -312,8 +332,10 @@ func (me *CvmService) ModifyPassword(ctx context.Context, instanceId, password s
 	forceStop := true
 	request.ForceStop = &forceStop
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ResetInstancesPassword(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCvmClient().ResetInstancesPasswordWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -334,8 +356,10 @@ func (me *CvmService) ModifyInternetMaxBandwidthOut(ctx context.Context, instanc
 		InternetMaxBandwidthOut: &internetMaxBandWidthOut,
 	}
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseCvmClient().ResetInstancesInternetMaxBandwidth(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseCvmClient().ResetInstancesInternetMaxBandwidthWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -356,8 +380,10 @@ func (me *CvmService) ModifyVpc(ctx context.Context, instanceId, vpcId, subnetId
 		request.VirtualPrivateCloud.PrivateIpAddresses = []*string{&privateIp}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyInstancesVpcAttribute(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCvmClient().ModifyInstancesVpcAttributeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -377,8 +403,10 @@ func (me *CvmService) StopInstance(ctx context.Context, instanceId string, stopp
 		request.StoppedMode = &stoppedMode
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().StopInstances(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCvmClient().StopInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -395,8 +423,10 @@ func (me *CvmService) StartInstance(ctx context.Context, instanceId string) erro
 	request := cvm.NewStartInstancesRequest()
 	request.InstanceIds = []*string{&instanceId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().StartInstances(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCvmClient().StartInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -413,8 +443,10 @@ func (me *CvmService) DeleteInstance(ctx context.Context, instanceId string) err
 	request := cvm.NewTerminateInstancesRequest()
 	request.InstanceIds = []*string{&instanceId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().TerminateInstances(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCvmClient().TerminateInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -441,8 +473,10 @@ func (me *CvmService) DeleteInstanceSetByIds(ctx context.Context, instanceSetIds
 		request.InstanceIds = append(request.InstanceIds, instanceId)
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().TerminateInstances(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCvmClient().TerminateInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -456,9 +490,11 @@ func (me *CvmService) DeleteInstanceSetByIds(ctx context.Context, instanceSetIds
 
 func (me *CvmService) ResetInstance(ctx context.Context, request *cvm.ResetInstanceRequest) (errRet error) {
 	logId := getLogId(ctx)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCvmClient().ResetInstance(request)
+	response, err := me.client.UseCvmClient().ResetInstanceWithContext(ctx, request)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -483,8 +519,10 @@ func (me *CvmService) DescribeInstanceTypes(ctx context.Context, zone string) (i
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeInstanceTypeConfigs(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCvmClient().DescribeInstanceTypeConfigsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -514,8 +552,10 @@ func (me *CvmService) DescribeInstanceTypesByFilter(ctx context.Context, filters
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeInstanceTypeConfigs(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCvmClient().DescribeInstanceTypeConfigsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -545,8 +585,10 @@ func (me *CvmService) DescribeInstancesSellTypeByFilter(ctx context.Context, fil
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeZoneInstanceConfigInfos(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCvmClient().DescribeZoneInstanceConfigInfosWithContext(ctx, request)
 	if err != nil {
 		//deal with not supported error
 		e, ok := err.(*sdkErrors.TencentCloudSDKError)
@@ -568,8 +610,10 @@ func (me *CvmService) DescribeKeyPairById(ctx context.Context, keyId string) (ke
 	request := cvm.NewDescribeKeyPairsRequest()
 	request.KeyIds = []*string{&keyId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeKeyPairs(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCvmClient().DescribeKeyPairsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -613,8 +657,10 @@ func (me *CvmService) DescribeKeyPairByFilter(ctx context.Context, id, name stri
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCvmClient().DescribeKeyPairs(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCvmClient().DescribeKeyPairsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -642,8 +688,10 @@ func (me *CvmService) ModifyKeyPairName(ctx context.Context, keyId, keyName stri
 	request.KeyId = &keyId
 	request.KeyName = &keyName
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyKeyPairAttribute(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCvmClient().ModifyKeyPairAttributeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -660,8 +708,10 @@ func (me *CvmService) DeleteKeyPair(ctx context.Context, keyId string) error {
 	request := cvm.NewDeleteKeyPairsRequest()
 	request.KeyIds = []*string{&keyId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DeleteKeyPairs(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCvmClient().DeleteKeyPairsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -680,8 +730,10 @@ func (me *CvmService) UnbindKeyPair(ctx context.Context, keyIds []*string, insta
 	request.InstanceIds = instanceIds
 	request.ForceStop = helper.Bool(true)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DisassociateInstancesKeyPairs(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCvmClient().DisassociateInstancesKeyPairsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -700,8 +752,10 @@ func (me *CvmService) BindKeyPair(ctx context.Context, keyIds []*string, instanc
 	request.InstanceIds = []*string{&instanceId}
 	request.ForceStop = helper.Bool(true)
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseCvmClient().AssociateInstancesKeyPairs(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseCvmClient().AssociateInstancesKeyPairsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -717,8 +771,10 @@ func (me *CvmService) CreatePlacementGroup(ctx context.Context, placementName, p
 	request.Name = &placementName
 	request.Type = &placementType
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().CreateDisasterRecoverGroup(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCvmClient().CreateDisasterRecoverGroupWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -741,8 +797,10 @@ func (me *CvmService) DescribePlacementGroupById(ctx context.Context, placementI
 	request := cvm.NewDescribeDisasterRecoverGroupsRequest()
 	request.DisasterRecoverGroupIds = []*string{&placementId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeDisasterRecoverGroups(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCvmClient().DescribeDisasterRecoverGroupsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -775,8 +833,10 @@ func (me *CvmService) DescribePlacementGroupByFilter(ctx context.Context, id, na
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCvmClient().DescribeDisasterRecoverGroups(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCvmClient().DescribeDisasterRecoverGroupsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -804,8 +864,10 @@ func (me *CvmService) ModifyPlacementGroup(ctx context.Context, placementId, nam
 	request.DisasterRecoverGroupId = &placementId
 	request.Name = &name
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyDisasterRecoverGroupAttribute(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCvmClient().ModifyDisasterRecoverGroupAttributeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -822,8 +884,10 @@ func (me *CvmService) DeletePlacementGroup(ctx context.Context, placementId stri
 	request := cvm.NewDeleteDisasterRecoverGroupsRequest()
 	request.DisasterRecoverGroupIds = []*string{&placementId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DeleteDisasterRecoverGroups(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCvmClient().DeleteDisasterRecoverGroupsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -839,8 +903,10 @@ func (me *CvmService) DescribeRegions(ctx context.Context) (zones []*cvm.RegionI
 	logId := getLogId(ctx)
 	request := cvm.NewDescribeRegionsRequest()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeRegions(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCvmClient().DescribeRegionsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -858,8 +924,10 @@ func (me *CvmService) DescribeZones(ctx context.Context) (zones []*cvm.ZoneInfo,
 	logId := getLogId(ctx)
 	request := cvm.NewDescribeZonesRequest()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeZones(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCvmClient().DescribeZonesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -883,8 +951,10 @@ func (me *CvmService) CreateReservedInstance(ctx context.Context, configId strin
 		request.ReservedInstanceName = &reservedInstanceName
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().PurchaseReservedInstancesOffering(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCvmClient().PurchaseReservedInstancesOfferingWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -920,8 +990,10 @@ func (me *CvmService) DescribeReservedInstanceByFilter(ctx context.Context, filt
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCvmClient().DescribeReservedInstances(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCvmClient().DescribeReservedInstancesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -961,8 +1033,10 @@ func (me *CvmService) DescribeReservedInstanceConfigs(ctx context.Context, filte
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCvmClient().DescribeReservedInstancesOfferings(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCvmClient().DescribeReservedInstancesOfferingsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1027,9 +1101,11 @@ func (me *CvmService) ModifyImage(ctx context.Context, instanceId, imageName, im
 	request.ImageName = helper.String(imageName)
 	request.ImageDescription = helper.String(imageDesc)
 
-	err := resource.Retry(6*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, e := me.client.UseCvmClient().ModifyImageAttribute(request)
+	err := retryContext(ctx, 6*writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, e := me.client.UseCvmClient().ModifyImageAttributeWithContext(ctx, request)
 		if e != nil {
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
 				if ee.Code == "InvalidImageId.Malformed" || ee.Code == "InvalidImageId.NotFound" ||
@@ -1053,8 +1129,10 @@ func (me *CvmService) DeleteImage(ctx context.Context, imageId string) error {
 	request := cvm.NewDeleteImagesRequest()
 	request.ImageIds = []*string{&imageId}
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseCvmClient().DeleteImages(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseCvmClient().DeleteImagesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1070,9 +1148,11 @@ func (me *CvmService) DescribeImageById(ctx context.Context, keyId string, isDel
 	request.ImageIds = []*string{&keyId}
 
 	var imgRsp *cvm.DescribeImagesResponse
-	err := resource.Retry(20*readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCvmClient().DescribeImages(request)
+	err := retryContext(ctx, 20*readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := me.client.UseCvmClient().DescribeImagesWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -1137,8 +1217,10 @@ func (me *CvmService) DescribeImagesByFilter(ctx context.Context, filters map[st
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCvmClient().DescribeImages(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCvmClient().DescribeImagesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1167,8 +1249,10 @@ func (me *CvmService) ModifyRenewParam(ctx context.Context, instanceId string, r
 	request.InstanceIds = []*string{&instanceId}
 	request.RenewFlag = &renewFlag
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyInstancesRenewFlag(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCvmClient().ModifyInstancesRenewFlagWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1196,8 +1280,10 @@ func (me *CvmService) ModifyInstanceChargeType(ctx context.Context, instanceId s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyInstancesChargeType(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCvmClient().ModifyInstancesChargeTypeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1219,8 +1305,10 @@ func (me *CvmService) ResizeInstanceDisks(ctx context.Context, request *cvm.Resi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ResizeInstanceDisks(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCvmClient().ResizeInstanceDisksWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1245,7 +1333,9 @@ func (me *CvmService) DescribeCvmHpcClusterById(ctx context.Context, hpcClusterI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -1255,7 +1345,7 @@ func (me *CvmService) DescribeCvmHpcClusterById(ctx context.Context, hpcClusterI
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCvmClient().DescribeHpcClusters(request)
+		response, err := me.client.UseCvmClient().DescribeHpcClustersWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1292,9 +1382,11 @@ func (me *CvmService) DeleteCvmHpcClusterById(ctx context.Context, hpcClusterId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCvmClient().DeleteHpcClusters(request)
+	response, err := me.client.UseCvmClient().DeleteHpcClustersWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1316,9 +1408,11 @@ func (me *CvmService) DescribeCvmLaunchTemplateById(ctx context.Context, launchT
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCvmClient().DescribeLaunchTemplates(request)
+	response, err := me.client.UseCvmClient().DescribeLaunchTemplatesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1345,9 +1439,11 @@ func (me *CvmService) DescribeLaunchTemplateVersionsById(ctx context.Context, la
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCvmClient().DescribeLaunchTemplateVersions(request)
+	response, err := me.client.UseCvmClient().DescribeLaunchTemplateVersionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1377,10 +1473,12 @@ func (me *CvmService) DescribeLaunchTemplateVersions(ctx context.Context, launch
 	offect := 0
 	limit := 50
 	for {
-		ratelimit.Check(request.GetAction())
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
 		request.Offset = helper.IntUint64(offect)
 		request.Limit = helper.IntUint64(limit)
-		response, err := me.client.UseCvmClient().DescribeLaunchTemplateVersions(request)
+		response, err := me.client.UseCvmClient().DescribeLaunchTemplateVersionsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1413,9 +1511,11 @@ func (me *CvmService) DeleteCvmLaunchTemplateById(ctx context.Context, launchTem
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCvmClient().DeleteLaunchTemplate(request)
+	response, err := me.client.UseCvmClient().DeleteLaunchTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1438,9 +1538,11 @@ func (me *CvmService) DescribeCvmLaunchTemplateVersionById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCvmClient().DescribeLaunchTemplateVersions(request)
+	response, err := me.client.UseCvmClient().DescribeLaunchTemplateVersionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1468,9 +1570,11 @@ func (me *CvmService) DeleteCvmLaunchTemplateVersionById(ctx context.Context, la
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCvmClient().DeleteLaunchTemplateVersions(request)
+	response, err := me.client.UseCvmClient().DeleteLaunchTemplateVersionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1486,8 +1590,10 @@ func (me *CvmService) ModifyLaunchTemplateDefaultVersion(ctx context.Context, la
 	request.LaunchTemplateId = helper.String(launchTemplateId)
 	request.DefaultVersion = helper.IntInt64(defaultVersion)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().ModifyLaunchTemplateDefaultVersion(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseCvmClient().ModifyLaunchTemplateDefaultVersionWithContext(ctx, request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
 			if sdkErr.Code == "InvalidParameterValue.LaunchTemplateIdVerSetAlready" && strings.Contains(sdkErr.Message, "The specified launch template version had been set to default") {
@@ -1525,7 +1631,9 @@ func (me *CvmService) DescribeCvmChcHostsByFilter(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1534,7 +1642,7 @@ func (me *CvmService) DescribeCvmChcHostsByFilter(ctx context.Context, param map
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCvmClient().DescribeChcHosts(request)
+		response, err := me.client.UseCvmClient().DescribeChcHostsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1573,9 +1681,11 @@ func (me *CvmService) DescribeCvmChcDeniedActionsByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCvmClient().DescribeChcDeniedActions(request)
+	response, err := me.client.UseCvmClient().DescribeChcDeniedActionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1602,9 +1712,11 @@ func (me *CvmService) DeleteCvmChcAssistVpcById(ctx context.Context, chcId strin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCvmClient().RemoveChcAssistVpc(request)
+	response, err := me.client.UseCvmClient().RemoveChcAssistVpcWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1614,11 +1726,11 @@ func (me *CvmService) DeleteCvmChcAssistVpcById(ctx context.Context, chcId strin
 	return
 }
 
-func (me *CvmService) CvmChcInstanceStateRefreshFunc(chcId string, failStates []string) resource.StateRefreshFunc {
+func (me *CvmService) CvmChcInstanceStateRefreshFunc(ctx context.Context, chcId string, failStates []string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		request := cvm.NewDescribeChcHostsRequest()
 		request.ChcIds = []*string{&chcId}
-		response, err := me.client.UseCvmClient().DescribeChcHosts(request)
+		response, err := me.client.UseCvmClient().DescribeChcHostsWithContext(ctx, request)
 
 		if err != nil {
 			return nil, "", err
@@ -1631,11 +1743,11 @@ func (me *CvmService) CvmChcInstanceStateRefreshFunc(chcId string, failStates []
 	}
 }
 
-func (me *CvmService) CvmChcInstanceDeployVpcStateRefreshFunc(chcId string, failStates []string) resource.StateRefreshFunc {
+func (me *CvmService) CvmChcInstanceDeployVpcStateRefreshFunc(ctx context.Context, chcId string, failStates []string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		request := cvm.NewDescribeChcHostsRequest()
 		request.ChcIds = []*string{&chcId}
-		response, err := me.client.UseCvmClient().DescribeChcHosts(request)
+		response, err := me.client.UseCvmClient().DescribeChcHostsWithContext(ctx, request)
 
 		if err != nil {
 			return nil, "", err
@@ -1652,11 +1764,11 @@ func (me *CvmService) CvmChcInstanceDeployVpcStateRefreshFunc(chcId string, fail
 	}
 }
 
-func (me *CvmService) CvmSyncImagesStateRefreshFunc(imageId string, failStates []string) resource.StateRefreshFunc {
+func (me *CvmService) CvmSyncImagesStateRefreshFunc(ctx context.Context, imageId string, failStates []string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		request := cvm.NewDescribeImagesRequest()
 		request.ImageIds = []*string{&imageId}
-		response, err := me.client.UseCvmClient().DescribeImages(request)
+		response, err := me.client.UseCvmClient().DescribeImagesWithContext(ctx, request)
 
 		if err != nil {
 			return nil, "", err
@@ -1685,9 +1797,11 @@ func (me *CvmService) DescribeCvmImageQuotaByFilter(ctx context.Context, param m
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCvmClient().DescribeImageQuota(request)
+	response, err := me.client.UseCvmClient().DescribeImageQuotaWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1721,9 +1835,11 @@ func (me *CvmService) DescribeCvmImageSharePermissionByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCvmClient().DescribeImageSharePermission(request)
+	response, err := me.client.UseCvmClient().DescribeImageSharePermissionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1750,10 +1866,12 @@ func (me *CvmService) ModifyImageSharePermission(ctx context.Context, imageId, p
 	request.ImageId = helper.String(imageId)
 	request.Permission = helper.String(permission)
 	request.AccountIds = helper.StringsStringsPoint(accountIds)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := me.client.UseCvmClient().ModifyImageSharePermission(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := me.client.UseCvmClient().ModifyImageSharePermissionWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
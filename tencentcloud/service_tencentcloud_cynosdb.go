@@ -6,6 +6,7 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -26,9 +27,11 @@ func (me *CynosdbService) DescribeRedisZoneConfig(ctx context.Context) (instance
 	request.DbType = helper.String("MYSQL")
 	request.IncludeZoneStocks = helper.Bool(true)
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, e := me.client.UseCynosdbClient().DescribeInstanceSpecs(request)
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, e := me.client.UseCynosdbClient().DescribeInstanceSpecsWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), e.Error())
@@ -62,8 +65,10 @@ func (me *CynosdbService) DescribeClusters(ctx context.Context, filters map[stri
 	for {
 		request.Offset = helper.IntInt64(offset)
 		request.Limit = helper.IntInt64(pageSize)
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCynosdbClient().DescribeClusters(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCynosdbClient().DescribeClustersWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -93,45 +98,46 @@ func (me *CynosdbService) DescribeClusterById(ctx context.Context, clusterId str
 	request.ClusterId = &clusterId
 
 	// get cluster status
-	var notExist bool
-	var clusters []*cynosdb.CynosdbCluster
-	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		clusters, errRet = me.DescribeClusters(ctx, map[string]string{"ClusterId": clusterId})
-		if errRet != nil {
-			return retryError(errRet)
-		}
-		if len(clusters) == 0 {
-			notExist = true
-			return nil
-		}
-		if len(clusters) != 1 {
-			return resource.NonRetryableError(fmt.Errorf("[CRITAL] mutiple cluster found by cluster id %s", clusterId))
-		}
-		clusterItem = clusters[0]
-		clusterStatus := clusterItem.Status
-		if clusterStatus == nil {
-			return resource.NonRetryableError(fmt.Errorf("cluster %s status is nil", clusterId))
-		}
-		if *clusterStatus == CYNOSDB_STATUS_RUNNING {
-			return nil
-		}
-		if *clusterStatus == CYNOSDB_STATUS_ISOLATED || *clusterStatus == CYNOSDB_STATUS_OFFLINE || *clusterStatus == CYNOSDB_STATUS_DELETED {
-			notExist = true
-			return nil
-		}
-
-		return resource.RetryableError(fmt.Errorf("cynosdb cluster %s is still in processing", clusterId))
-	})
-	if errRet != nil || notExist {
+	waiter := &helper.StateWaiter{
+		Name:   fmt.Sprintf("cynosdb cluster %s", clusterId),
+		Target: []string{CYNOSDB_STATUS_RUNNING, CYNOSDB_STATUS_ISOLATED, CYNOSDB_STATUS_OFFLINE, CYNOSDB_STATUS_DELETED},
+		Refresh: func() (interface{}, string, error) {
+			clusters, err := me.DescribeClusters(ctx, map[string]string{"ClusterId": clusterId})
+			if err != nil {
+				return nil, "", err
+			}
+			if len(clusters) == 0 {
+				return nil, "", nil
+			}
+			if len(clusters) != 1 {
+				return nil, "", fmt.Errorf("[CRITAL] mutiple cluster found by cluster id %s", clusterId)
+			}
+			if clusters[0].Status == nil {
+				return nil, "", fmt.Errorf("cluster %s status is nil", clusterId)
+			}
+			return clusters[0], *clusters[0].Status, nil
+		},
+		NotFound:  helper.NotFoundTarget,
+		Retryable: retryableErrorFunc(ctx),
+		Timeout:   readRetryTimeout,
+	}
+	var result interface{}
+	result, errRet = waiter.WaitForStateContext(ctx)
+	if errRet != nil || result == nil {
+		return
+	}
+	clusterItem = result.(*cynosdb.CynosdbCluster)
+	if *clusterItem.Status != CYNOSDB_STATUS_RUNNING {
 		return
 	}
 	has = true
 
 	var response *cynosdb.DescribeClusterDetailResponse
-	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, errRet = me.client.UseCynosdbClient().DescribeClusterDetail(request)
+	errRet = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, errRet = me.client.UseCynosdbClient().DescribeClusterDetailWithContext(ctx, request)
 		if errRet != nil {
 			return retryError(errRet)
 		}
@@ -156,9 +162,11 @@ func (me *CynosdbService) UpgradeInstance(ctx context.Context, instanceId string
 	request.Memory = &mem
 	request.UpgradeType = helper.String(CYNOSDB_UPGRADE_IMMEDIATE)
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, errRet = me.client.UseCynosdbClient().UpgradeInstance(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, errRet = me.client.UseCynosdbClient().UpgradeInstanceWithContext(ctx, request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
 			return retryError(errRet)
@@ -178,9 +186,11 @@ func (me *CynosdbService) DescribeMaintainPeriod(ctx context.Context, instanceId
 
 	request.InstanceId = &instanceId
 
-	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, errRet = me.client.UseCynosdbClient().DescribeMaintainPeriod(request)
+	errRet = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, errRet = me.client.UseCynosdbClient().DescribeMaintainPeriodWithContext(ctx, request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
 			return retryError(errRet)
@@ -203,9 +213,11 @@ func (me *CynosdbService) ModifyMaintainPeriodConfig(ctx context.Context, instan
 	request.MaintainDuration = &duration
 	request.MaintainWeekDays = weekdays
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, errRet = me.client.UseCynosdbClient().ModifyMaintainPeriodConfig(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, errRet = me.client.UseCynosdbClient().ModifyMaintainPeriodConfigWithContext(ctx, request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
 			return retryError(errRet)
@@ -225,9 +237,11 @@ func (me *CynosdbService) IsolateCluster(ctx context.Context, clusterId string)
 
 	request.ClusterId = &clusterId
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, errRet := me.client.UseCynosdbClient().IsolateCluster(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, errRet := me.client.UseCynosdbClient().IsolateClusterWithContext(ctx, request)
 		if errRet != nil {
 			// isolate after creation immediately will encounter this error
 			if ee, ok := errRet.(*sdkErrors.TencentCloudSDKError); ok {
@@ -254,9 +268,11 @@ func (me *CynosdbService) OfflineCluster(ctx context.Context, clusterId string)
 
 	request.ClusterId = &clusterId
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, errRet = me.client.UseCynosdbClient().OfflineCluster(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, errRet = me.client.UseCynosdbClient().OfflineClusterWithContext(ctx, request)
 		if errRet != nil {
 			if ee, ok := errRet.(*sdkErrors.TencentCloudSDKError); ok {
 				if strings.Contains(ee.Message, "IsolateInstanceFlow failed") {
@@ -294,8 +310,10 @@ func (me *CynosdbService) DescribeInstances(ctx context.Context, filters map[str
 	for {
 		request.Offset = helper.IntInt64(offset)
 		request.Limit = helper.IntInt64(pageSize)
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCynosdbClient().DescribeInstances(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseCynosdbClient().DescribeInstancesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -319,40 +337,44 @@ func (me *CynosdbService) DescribeInstanceById(ctx context.Context, instanceId s
 	request := cynosdb.NewDescribeInstanceDetailRequest()
 	request.InstanceId = &instanceId
 
-	var notExist bool
-	var instances []*cynosdb.CynosdbInstance
-	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		instances, errRet = me.DescribeInstances(ctx, map[string]string{"InstanceId": instanceId})
-		if errRet != nil {
-			return retryError(errRet)
-		}
-		if len(instances) == 0 {
-			notExist = true
-			return nil
-		}
-		if len(instances) != 1 {
-			return resource.NonRetryableError(fmt.Errorf("[CRITAL] mutiple instance found by cluster id %s", instanceId))
-		}
-		if *instances[0].Status == CYNOSDB_STATUS_ISOLATED || *instances[0].Status == CYNOSDB_STATUS_OFFLINE || *instances[0].Status == CYNOSDB_STATUS_DELETED {
-			notExist = true
-			return nil
-		} else if *instances[0].Status == CYNOSDB_STATUS_RUNNING {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("cynosdb instance %s is still in processing", instanceId))
-		}
-	})
-	if errRet != nil || notExist {
+	waiter := &helper.StateWaiter{
+		Name:   fmt.Sprintf("cynosdb instance %s", instanceId),
+		Target: []string{CYNOSDB_STATUS_RUNNING, CYNOSDB_STATUS_ISOLATED, CYNOSDB_STATUS_OFFLINE, CYNOSDB_STATUS_DELETED},
+		Refresh: func() (interface{}, string, error) {
+			instances, err := me.DescribeInstances(ctx, map[string]string{"InstanceId": instanceId})
+			if err != nil {
+				return nil, "", err
+			}
+			if len(instances) == 0 {
+				return nil, "", nil
+			}
+			if len(instances) != 1 {
+				return nil, "", fmt.Errorf("[CRITAL] mutiple instance found by cluster id %s", instanceId)
+			}
+			return instances[0], *instances[0].Status, nil
+		},
+		NotFound:  helper.NotFoundTarget,
+		Retryable: retryableErrorFunc(ctx),
+		Timeout:   readRetryTimeout,
+	}
+	var result interface{}
+	result, errRet = waiter.WaitForStateContext(ctx)
+	if errRet != nil || result == nil {
+		return
+	}
+	instance := result.(*cynosdb.CynosdbInstance)
+	if *instance.Status != CYNOSDB_STATUS_RUNNING {
 		return
 	}
 	has = true
-	clusterId = *instances[0].ClusterId
+	clusterId = *instance.ClusterId
 
 	var response *cynosdb.DescribeInstanceDetailResponse
-	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, errRet = me.client.UseCynosdbClient().DescribeInstanceDetail(request)
+	errRet = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, errRet = me.client.UseCynosdbClient().DescribeInstanceDetailWithContext(ctx, request)
 		if errRet != nil {
 			return retryError(errRet)
 		}
@@ -367,10 +389,10 @@ func (me *CynosdbService) DescribeInstanceById(ctx context.Context, instanceId s
 	instanceInfo = response.Response.Detail
 
 	if instanceInfo.VpcId != nil {
-		instanceInfo.VpcId = instances[0].VpcId
+		instanceInfo.VpcId = instance.VpcId
 	}
 	if instanceInfo.SubnetId != nil {
-		instanceInfo.SubnetId = instances[0].SubnetId
+		instanceInfo.SubnetId = instance.SubnetId
 	}
 	return
 }
@@ -380,9 +402,11 @@ func (me *CynosdbService) DescribeClusterInstanceGrps(ctx context.Context, clust
 	request := cynosdb.NewDescribeClusterInstanceGrpsRequest()
 	request.ClusterId = &clusterId
 
-	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, errRet = me.client.UseCynosdbClient().DescribeClusterInstanceGrps(request)
+	errRet = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, errRet = me.client.UseCynosdbClient().DescribeClusterInstanceGrpsWithContext(ctx, request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
 			return retryError(errRet)
@@ -401,9 +425,11 @@ func (me *CynosdbService) DescribeInsGrpSecurityGroups(ctx context.Context, inst
 	request := cynosdb.NewDescribeDBSecurityGroupsRequest()
 	request.InstanceId = &instanceGrpId
 
-	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, errRet = me.client.UseCynosdbClient().DescribeDBSecurityGroups(request)
+	errRet = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, errRet = me.client.UseCynosdbClient().DescribeDBSecurityGroupsWithContext(ctx, request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
 			return retryError(errRet)
@@ -425,9 +451,11 @@ func (me *CynosdbService) ModifyInsGrpSecurityGroups(ctx context.Context, insGrp
 	request.Zone = &az
 	request.SecurityGroupIds = sg
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, errRet = me.client.UseCynosdbClient().ModifyDBInstanceSecurityGroups(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, errRet = me.client.UseCynosdbClient().ModifyDBInstanceSecurityGroupsWithContext(ctx, request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
 			return retryError(errRet)
@@ -450,8 +478,10 @@ func (me *CynosdbService) ModifyClusterParam(ctx context.Context, request *cynos
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCynosdbClient().ModifyClusterParam(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCynosdbClient().ModifyClusterParamWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -473,9 +503,11 @@ func (me *CynosdbService) IsolateInstance(ctx context.Context, clusterId, instan
 	request.ClusterId = &clusterId
 	request.InstanceIdList = []*string{helper.String(instanceId)}
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, errRet = me.client.UseCynosdbClient().IsolateInstance(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, errRet = me.client.UseCynosdbClient().IsolateInstanceWithContext(ctx, request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
 			return retryError(errRet)
@@ -493,9 +525,11 @@ func (me *CynosdbService) OfflineInstance(ctx context.Context, clusterId, instan
 	request.ClusterId = &clusterId
 	request.InstanceIdList = []*string{helper.String(instanceId)}
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, errRet = me.client.UseCynosdbClient().OfflineInstance(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, errRet = me.client.UseCynosdbClient().OfflineInstanceWithContext(ctx, request)
 		if errRet != nil {
 			if ee, ok := errRet.(*sdkErrors.TencentCloudSDKError); ok {
 				if strings.Contains(ee.Message, "OfflineInstanceFlow failed") {
@@ -518,9 +552,11 @@ func (me *CynosdbService) DescribeClusterParams(ctx context.Context, clusterId s
 	request.ClusterId = &clusterId
 
 	var response *cynosdb.DescribeClusterParamsResponse
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, errRet = me.client.UseCynosdbClient().DescribeClusterParams(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, errRet = me.client.UseCynosdbClient().DescribeClusterParamsWithContext(ctx, request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
 			return retryError(errRet)
@@ -544,8 +580,10 @@ func (me *CynosdbService) ResumeServerless(ctx context.Context, request *cynosdb
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCynosdbClient().ResumeServerless(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCynosdbClient().ResumeServerlessWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -567,8 +605,10 @@ func (me *CynosdbService) PauseServerless(ctx context.Context, request *cynosdb.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCynosdbClient().PauseServerless(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCynosdbClient().PauseServerlessWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -591,7 +631,7 @@ func (me *CynosdbService) SwitchServerlessCluster(ctx context.Context, clusterId
 	if st == nil {
 		return nil
 	}
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		if resume && *st == "resuming" || pause && *st == "pausing" {
 			return resource.RetryableError(fmt.Errorf("waiting for status %s finish", *st))
 		}
@@ -621,29 +661,39 @@ func (me *CynosdbService) SwitchServerlessCluster(ctx context.Context, clusterId
 	if err != nil {
 		return err
 	}
+	from, pending, target := "resume", "pausing", "pause"
+	if resume {
+		from, pending, target = "pause", "resuming", "resume"
+	}
 	statusChangeRetry := 5
-	return resource.Retry(readRetryTimeout*5, func() *resource.RetryError {
-		_, detail, _, err = me.DescribeClusterById(ctx, clusterId)
-		if err != nil {
-			return retryError(err)
-		}
-		st := detail.ServerlessStatus
-		if st == nil {
-			return resource.NonRetryableError(fmt.Errorf("cannot read serverless cluster status"))
-		}
-		if resume && *st == "pause" || pause && *st == "resume" {
-			if statusChangeRetry > 0 {
+	waiter := &helper.StateWaiter{
+		Name:    fmt.Sprintf("serverless cynosdb cluster %s", clusterId),
+		Pending: []string{from, pending},
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			_, detail, _, err := me.DescribeClusterById(ctx, clusterId)
+			if err != nil {
+				return nil, "", err
+			}
+			st := detail.ServerlessStatus
+			if st == nil {
+				return nil, "", fmt.Errorf("cannot read serverless cluster status")
+			}
+			if *st == from {
+				if statusChangeRetry == 0 {
+					return nil, "", fmt.Errorf("api action invoked but status still %s", *st)
+				}
 				statusChangeRetry -= 1
-				return resource.RetryableError(fmt.Errorf("waiting for status change, retry %d", statusChangeRetry))
+			} else if *st == pending {
+				statusChangeRetry = 0
 			}
-			return resource.NonRetryableError(fmt.Errorf("api action invoked but status still %s", *st))
-		}
-		if resume && *st == "resuming" || pause && *st == "pausing" {
-			statusChangeRetry = 0
-			return resource.RetryableError(fmt.Errorf("waiting for status %s finished", *st))
-		}
-		return nil
-	})
+			return detail, *st, nil
+		},
+		Retryable: retryableErrorFunc(ctx),
+		Timeout:   readRetryTimeout * 5,
+	}
+	_, err = waiter.WaitForStateContext(ctx)
+	return err
 }
 
 func (me *CynosdbService) DescribeCynosdbAuditLogFileById(ctx context.Context, instanceId string, fileName string) (auditLogFile *cynosdb.AuditLogFile, errRet error) {
@@ -659,9 +709,11 @@ func (me *CynosdbService) DescribeCynosdbAuditLogFileById(ctx context.Context, i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeAuditLogFiles(request)
+	response, err := me.client.UseCynosdbClient().DescribeAuditLogFilesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -689,9 +741,11 @@ func (me *CynosdbService) DeleteCynosdbAuditLogFileById(ctx context.Context, ins
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DeleteAuditLogFile(request)
+	response, err := me.client.UseCynosdbClient().DeleteAuditLogFileWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -714,9 +768,11 @@ func (me *CynosdbService) DeleteCynosdbSecurityGroupById(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DisassociateSecurityGroups(request)
+	response, err := me.client.UseCynosdbClient().DisassociateSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -738,9 +794,11 @@ func (me *CynosdbService) DescribeCynosdbSecurityGroups(ctx context.Context, ins
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeDBSecurityGroups(request)
+	response, err := me.client.UseCynosdbClient().DescribeDBSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -769,9 +827,11 @@ func (me *CynosdbService) DescribeCynosdbBackup(ctx context.Context, clusterId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeBackupList(request)
+	response, err := me.client.UseCynosdbClient().DescribeBackupListWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -800,9 +860,11 @@ func (me *CynosdbService) DescribeCynosdbBackupById(ctx context.Context, cluster
 	}
 	request.BackupIds = []*int64{&backupIdInt64}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeBackupList(request)
+	response, err := me.client.UseCynosdbClient().DescribeBackupListWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -835,9 +897,11 @@ func (me *CynosdbService) DeleteCynosdbBackupById(ctx context.Context, clusterId
 	}
 	request.BackupIds = []*int64{&backupIdInt64}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DeleteBackup(request)
+	response, err := me.client.UseCynosdbClient().DeleteBackupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -877,8 +941,8 @@ func (me *CynosdbService) ModifyBackupConfig(ctx context.Context, clusterId stri
 	if v, ok := params["backup_type"]; ok {
 		request.BackupType = helper.String(v.(string))
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := me.client.UseCynosdbClient().ModifyBackupConfig(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := me.client.UseCynosdbClient().ModifyBackupConfigWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -910,8 +974,8 @@ func (me *CynosdbService) DescribeCynosdbAccountsByFilter(ctx context.Context, c
 	if v, ok := paramMap["hosts"]; ok {
 		request.Hosts = helper.InterfacesStringsPoint(v.([]interface{}))
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, e := me.client.UseCynosdbClient().DescribeAccounts(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		response, e := me.client.UseCynosdbClient().DescribeAccountsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -941,8 +1005,8 @@ func (me *CynosdbService) DescribeClusterParamsByFilter(ctx context.Context, clu
 	if v, ok := paramMap["param_name"]; ok {
 		request.ParamName = helper.String(v.(string))
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, e := me.client.UseCynosdbClient().DescribeClusterParams(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		response, e := me.client.UseCynosdbClient().DescribeClusterParamsWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1004,8 +1068,8 @@ func (me *CynosdbService) DescribeCynosdbParamTemplatesByFilter(ctx context.Cont
 	if v, ok := paramMap["order_direction"]; ok {
 		request.OrderDirection = v.(*string)
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		response, e := me.client.UseCynosdbClient().DescribeParamTemplates(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		response, e := me.client.UseCynosdbClient().DescribeParamTemplatesWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1054,7 +1118,9 @@ func (me *CynosdbService) DescribeCynosdbAuditLogsByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1064,7 +1130,7 @@ func (me *CynosdbService) DescribeCynosdbAuditLogsByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCynosdbClient().DescribeAuditLogs(request)
+		response, err := me.client.UseCynosdbClient().DescribeAuditLogsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1108,9 +1174,11 @@ func (me *CynosdbService) DescribeCynosdbBackupDownloadUrlById(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeBackupDownloadUrl(request)
+	response, err := me.client.UseCynosdbClient().DescribeBackupDownloadUrlWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1147,9 +1215,11 @@ func (me *CynosdbService) DescribeCynosdbBinlogDownloadUrlByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeBinlogDownloadUrl(request)
+	response, err := me.client.UseCynosdbClient().DescribeBinlogDownloadUrlWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1186,7 +1256,9 @@ func (me *CynosdbService) DescribeCynosdbClusterDetailDatabasesByFilter(ctx cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1196,7 +1268,7 @@ func (me *CynosdbService) DescribeCynosdbClusterDetailDatabasesByFilter(ctx cont
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCynosdbClient().DescribeClusterDetailDatabases(request)
+		response, err := me.client.UseCynosdbClient().DescribeClusterDetailDatabasesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1246,7 +1318,9 @@ func (me *CynosdbService) DescribeCynosdbClusterParamLogsByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1256,7 +1330,7 @@ func (me *CynosdbService) DescribeCynosdbClusterParamLogsByFilter(ctx context.Co
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCynosdbClient().DescribeClusterParamLogs(request)
+		response, err := me.client.UseCynosdbClient().DescribeClusterParamLogsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1306,9 +1380,11 @@ func (me *CynosdbService) DescribeCynosdbClusterByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().SearchClusterTables(request)
+	response, err := me.client.UseCynosdbClient().SearchClusterTablesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1349,7 +1425,9 @@ func (me *CynosdbService) DescribeCynosdbDescribeInstanceSlowQueriesByFilter(ctx
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1359,7 +1437,7 @@ func (me *CynosdbService) DescribeCynosdbDescribeInstanceSlowQueriesByFilter(ctx
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCynosdbClient().DescribeBinlogs(request)
+		response, err := me.client.UseCynosdbClient().DescribeBinlogsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1418,7 +1496,9 @@ func (me *CynosdbService) DescribeCynosdbDescribeInstanceErrorLogsByFilter(ctx c
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1428,7 +1508,7 @@ func (me *CynosdbService) DescribeCynosdbDescribeInstanceErrorLogsByFilter(ctx c
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCynosdbClient().DescribeInstanceErrorLogs(request)
+		response, err := me.client.UseCynosdbClient().DescribeInstanceErrorLogsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1473,9 +1553,11 @@ func (me *CynosdbService) DescribeCynosdbAccountAllGrantPrivilegesByFilter(ctx c
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeAccountAllGrantPrivileges(request)
+	response, err := me.client.UseCynosdbClient().DescribeAccountAllGrantPrivilegesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1513,7 +1595,9 @@ func (me *CynosdbService) DescribeCynosdbProjectSecurityGroupsByFilter(ctx conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1523,7 +1607,7 @@ func (me *CynosdbService) DescribeCynosdbProjectSecurityGroupsByFilter(ctx conte
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCynosdbClient().DescribeProjectSecurityGroups(request)
+		response, err := me.client.UseCynosdbClient().DescribeProjectSecurityGroupsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1570,7 +1654,9 @@ func (me *CynosdbService) DescribeCynosdbResourcePackageSaleSpecsByFilter(ctx co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1580,7 +1666,7 @@ func (me *CynosdbService) DescribeCynosdbResourcePackageSaleSpecsByFilter(ctx co
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCynosdbClient().DescribeResourcePackageSaleSpec(request)
+		response, err := me.client.UseCynosdbClient().DescribeResourcePackageSaleSpecWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1621,9 +1707,11 @@ func (me *CynosdbService) DescribeCynosdbRollbackTimeRangeByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeRollbackTimeRange(request)
+	response, err := me.client.UseCynosdbClient().DescribeRollbackTimeRangeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1664,9 +1752,11 @@ func (me *CynosdbService) DescribeCynosdbRollbackTimeValidityByFilter(ctx contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeRollbackTimeValidity(request)
+	response, err := me.client.UseCynosdbClient().DescribeRollbackTimeValidityWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1719,7 +1809,9 @@ func (me *CynosdbService) DescribeCynosdbResourcePackageListByFilter(ctx context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1729,7 +1821,7 @@ func (me *CynosdbService) DescribeCynosdbResourcePackageListByFilter(ctx context
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCynosdbClient().DescribeResourcePackageList(request)
+		response, err := me.client.UseCynosdbClient().DescribeResourcePackageListWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1763,9 +1855,11 @@ func (me *CynosdbService) DescribeCynosdbClusterResourcePackagesAttachmentById(c
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeClusterDetail(request)
+	response, err := me.client.UseCynosdbClient().DescribeClusterDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1794,9 +1888,11 @@ func (me *CynosdbService) DeleteCynosdbClusterResourcePackagesAttachmentById(ctx
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().UnbindClusterResourcePackages(request)
+	response, err := me.client.UseCynosdbClient().UnbindClusterResourcePackagesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1819,9 +1915,11 @@ func (me *CynosdbService) DeleteCynosdbProxyById(ctx context.Context, clusterId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().CloseProxy(request)
+	response, err := me.client.UseCynosdbClient().CloseProxyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1844,9 +1942,11 @@ func (me *CynosdbService) DescribeCynosdbProxyById(ctx context.Context, clusterI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeProxies(request)
+	response, err := me.client.UseCynosdbClient().DescribeProxiesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1896,7 +1996,9 @@ func (me *CynosdbService) DescribeCynosdbProxyNodeByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1906,7 +2008,7 @@ func (me *CynosdbService) DescribeCynosdbProxyNodeByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCynosdbClient().DescribeProxyNodes(request)
+		response, err := me.client.UseCynosdbClient().DescribeProxyNodesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1950,9 +2052,11 @@ func (me *CynosdbService) DescribeCynosdbProxyVersionByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeSupportProxyVersion(request)
+	response, err := me.client.UseCynosdbClient().DescribeSupportProxyVersionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2033,8 +2137,10 @@ func (me *CynosdbService) DescribeCynosdbZoneByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCynosdbClient().DescribeZones(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseCynosdbClient().DescribeZonesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2064,9 +2170,11 @@ func (me *CynosdbService) DescribeCynosdbAccountById(ctx context.Context, cluste
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeAccounts(request)
+	response, err := me.client.UseCynosdbClient().DescribeAccountsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2099,9 +2207,11 @@ func (me *CynosdbService) DeleteCynosdbAccountById(ctx context.Context, clusterI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DeleteAccounts(request)
+	response, err := me.client.UseCynosdbClient().DeleteAccountsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2127,9 +2237,11 @@ func (me *CynosdbService) DescribeCynosdbAccountPrivilegesById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeAccountAllGrantPrivileges(request)
+	response, err := me.client.UseCynosdbClient().DescribeAccountAllGrantPrivilegesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2156,9 +2268,11 @@ func (me *CynosdbService) DeleteCynosdbAccountPrivilegesById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().RevokeAccountPrivileges(request)
+	response, err := me.client.UseCynosdbClient().RevokeAccountPrivilegesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2180,9 +2294,11 @@ func (me *CynosdbService) DescribeCynosdbBinlogSaveDaysById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeBinlogSaveDays(request)
+	response, err := me.client.UseCynosdbClient().DescribeBinlogSaveDaysWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2210,9 +2326,11 @@ func (me *CynosdbService) DescribeCynosdbClusterDatabasesById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeClusterDetailDatabases(request)
+	response, err := me.client.UseCynosdbClient().DescribeClusterDetailDatabasesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2241,9 +2359,11 @@ func (me *CynosdbService) DeleteCynosdbClusterDatabasesById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DeleteClusterDatabase(request)
+	response, err := me.client.UseCynosdbClient().DeleteClusterDatabaseWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2265,9 +2385,11 @@ func (me *CynosdbService) DescribeCynosdbClusterPasswordComplexityById(ctx conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeClusterPasswordComplexity(request)
+	response, err := me.client.UseCynosdbClient().DescribeClusterPasswordComplexityWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2294,9 +2416,11 @@ func (me *CynosdbService) DeleteCynosdbClusterPasswordComplexityById(ctx context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().CloseClusterPasswordComplexity(request)
+	response, err := me.client.UseCynosdbClient().CloseClusterPasswordComplexityWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2307,32 +2431,36 @@ func (me *CynosdbService) DeleteCynosdbClusterPasswordComplexityById(ctx context
 	return
 }
 
-func (me *CynosdbService) DescribeFlow(ctx context.Context, flowId int64) (ok bool, errRet error) {
-	logId := getLogId(ctx)
-	request := cynosdb.NewDescribeFlowRequest()
-	request.FlowId = &flowId
+// CynosdbFlowStateRefreshFunc returns the status of the flow, 0 is succeeded, 2 is running and
+// the others are failed.
+func (me *CynosdbService) CynosdbFlowStateRefreshFunc(ctx context.Context, flowId int64) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		request := cynosdb.NewDescribeFlowRequest()
+		request.FlowId = &flowId
 
-	defer func() {
-		if errRet != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return nil, "", err
 		}
-	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCynosdbClient().DescribeFlow(request)
-	if err != nil {
-		errRet = err
-		return
-	}
-	if *response.Response.Status == 2 {
-		return
+		response, err := me.client.UseCynosdbClient().DescribeFlowWithContext(ctx, request)
+		if err != nil {
+			return nil, "", err
+		}
+		return response.Response, helper.Int64ToStr(*response.Response.Status), nil
 	}
-	if *response.Response.Status == 0 {
-		ok = true
-		return
+}
+
+// WaitForFlow waits for the flow to succeed, the retryable errors are retried.
+func (me *CynosdbService) WaitForFlow(ctx context.Context, flowId int64, timeout time.Duration) error {
+	waiter := &helper.StateWaiter{
+		Name:      fmt.Sprintf("cynosdb flow %d", flowId),
+		Pending:   []string{"2"},
+		Target:    []string{"0"},
+		Refresh:   me.CynosdbFlowStateRefreshFunc(ctx, flowId),
+		Retryable: retryableErrorFunc(ctx),
+		Timeout:   timeout,
 	}
-	errRet = fmt.Errorf("redis task exe fail, task status is %v", *response.Response.Status)
-	return
+	_, err := waiter.WaitForStateContext(ctx)
+	return err
 }
 
 func (me *CynosdbService) CopyClusterPasswordComplexity(ctx context.Context, clusterId string) (errRet error) {
@@ -2348,9 +2476,11 @@ func (me *CynosdbService) CopyClusterPasswordComplexity(ctx context.Context, clu
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().CopyClusterPasswordComplexity(request)
+	response, err := me.client.UseCynosdbClient().CopyClusterPasswordComplexityWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2358,21 +2488,7 @@ func (me *CynosdbService) CopyClusterPasswordComplexity(ctx context.Context, clu
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
 	flowId := *response.Response.FlowId
-	err = resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := me.DescribeFlow(ctx, flowId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("create cynosdb clusterPasswordComplexity is processing"))
-		}
-	})
+	err = me.WaitForFlow(ctx, flowId, 6*readRetryTimeout)
 	if err != nil {
 		log.Printf("[CRITAL]%s create cynosdb clusterPasswordComplexity fail, reason:%s\n", logId, err.Error())
 		errRet = err
@@ -2395,9 +2511,11 @@ func (me *CynosdbService) DescribeCynosdbInstanceParamById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeInstanceParams(request)
+	response, err := me.client.UseCynosdbClient().DescribeInstanceParamsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2424,9 +2542,11 @@ func (me *CynosdbService) DeleteCynosdbWanById(ctx context.Context, instanceGrpI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().CloseWan(request)
+	response, err := me.client.UseCynosdbClient().CloseWanWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2449,9 +2569,11 @@ func (me *CynosdbService) DescribeCynosdbParamTemplateById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeParamTemplateDetail(request)
+	response, err := me.client.UseCynosdbClient().DescribeParamTemplateDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2474,9 +2596,11 @@ func (me *CynosdbService) DeleteCynosdbParamTemplateById(ctx context.Context, te
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DeleteParamTemplate(request)
+	response, err := me.client.UseCynosdbClient().DeleteParamTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2492,9 +2616,11 @@ func (me *CynosdbService) SetRenewFlag(ctx context.Context, instanceId string, a
 	request.ResourceIds = []*string{&instanceId}
 	request.AutoRenewFlag = &autoRenewFlag
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, errRet = me.client.UseCynosdbClient().SetRenewFlag(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, errRet = me.client.UseCynosdbClient().SetRenewFlagWithContext(ctx, request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
 			return retryError(errRet)
@@ -2514,9 +2640,11 @@ func (me *CynosdbService) ModifyClusterName(ctx context.Context, clusterId strin
 	request.ClusterId = &clusterId
 	request.ClusterName = &clusterName
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, errRet = me.client.UseCynosdbClient().ModifyClusterName(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, errRet = me.client.UseCynosdbClient().ModifyClusterNameWithContext(ctx, request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
 			return retryError(errRet)
@@ -2538,9 +2666,11 @@ func (me *CynosdbService) ModifyClusterStorage(ctx context.Context, clusterId st
 	request.OldStorageLimit = &oldStorageLimit
 	request.DealMode = helper.IntInt64(0)
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, errRet = me.client.UseCynosdbClient().ModifyClusterStorage(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, errRet = me.client.UseCynosdbClient().ModifyClusterStorageWithContext(ctx, request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
 			return retryError(errRet)
@@ -2563,9 +2693,11 @@ func (me *CynosdbService) SwitchClusterVpc(ctx context.Context, clusterId string
 	request.OldIpReserveHours = &oldIpReserveHours
 
 	var flowId int64
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, errRet := me.client.UseCynosdbClient().SwitchClusterVpc(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, errRet := me.client.UseCynosdbClient().SwitchClusterVpcWithContext(ctx, request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
 			return retryError(errRet)
@@ -2577,21 +2709,7 @@ func (me *CynosdbService) SwitchClusterVpc(ctx context.Context, clusterId string
 		return
 	}
 
-	err := resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-		ok, err := me.DescribeFlow(ctx, flowId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
-			} else {
-				return resource.NonRetryableError(err)
-			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("update cynosdb SwitchClusterVpc is processing"))
-		}
-	})
+	err := me.WaitForFlow(ctx, flowId, 6*readRetryTimeout)
 	if err != nil {
 		log.Printf("[CRITAL]%s update cynosdb SwitchClusterVpc fail, reason:%s\n", logId, err.Error())
 		errRet = err
@@ -2613,9 +2731,11 @@ func (me *CynosdbService) DescribeCynosdbResourcePackageById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeResourcePackageDetail(request)
+	response, err := me.client.UseCynosdbClient().DescribeResourcePackageDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2642,9 +2762,11 @@ func (me *CynosdbService) DeleteCynosdbResourcePackageById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().RefundResourcePackage(request)
+	response, err := me.client.UseCynosdbClient().RefundResourcePackageWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2666,9 +2788,11 @@ func (me *CynosdbService) DescribeCynosdbClusterSlaveZoneById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeClusterDetail(request)
+	response, err := me.client.UseCynosdbClient().DescribeClusterDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2692,9 +2816,11 @@ func (me *CynosdbService) DeleteCynosdbClusterSlaveZoneById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().RemoveClusterSlaveZone(request)
+	response, err := me.client.UseCynosdbClient().RemoveClusterSlaveZoneWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2706,13 +2832,13 @@ func (me *CynosdbService) DeleteCynosdbClusterSlaveZoneById(ctx context.Context,
 	return
 }
 
-func (me *CynosdbService) CynosdbClusterSlaveZoneStateRefreshFunc(flowId int64, failStates []string) resource.StateRefreshFunc {
+func (me *CynosdbService) CynosdbClusterSlaveZoneStateRefreshFunc(ctx context.Context, flowId int64, failStates []string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 
 		request := cynosdb.NewDescribeFlowRequest()
 		request.FlowId = &flowId
 
-		response, err := me.client.UseCynosdbClient().DescribeFlow(request)
+		response, err := me.client.UseCynosdbClient().DescribeFlowWithContext(ctx, request)
 
 		if err != nil {
 			return nil, "", err
@@ -2766,7 +2892,9 @@ func (me *CynosdbService) DescribeCynosdbInstanceSlowQueriesByFilter(ctx context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -2775,7 +2903,7 @@ func (me *CynosdbService) DescribeCynosdbInstanceSlowQueriesByFilter(ctx context
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseCynosdbClient().DescribeInstanceSlowQueries(request)
+		response, err := me.client.UseCynosdbClient().DescribeInstanceSlowQueriesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2807,9 +2935,11 @@ func (me *CynosdbService) DescribeCynosdbProxyEndPointById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeProxies(request)
+	response, err := me.client.UseCynosdbClient().DescribeProxiesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2843,9 +2973,11 @@ func (me *CynosdbService) DeleteCynosdbProxyEndPointById(ctx context.Context, cl
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().CloseProxy(request)
+	response, err := me.client.UseCynosdbClient().CloseProxyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2870,9 +3002,11 @@ func (me *CynosdbService) DescribeCynosdbUpgradeProxyVersionById(ctx context.Con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseCynosdbClient().DescribeProxies(request)
+	response, err := me.client.UseCynosdbClient().DescribeProxiesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
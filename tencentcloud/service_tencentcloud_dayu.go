@@ -41,8 +41,10 @@ func (me *DayuService) DescribeCCSelfdefinePolicies(ctx context.Context, resourc
 	request.Offset = &offset
 	request.Limit = &limit
 	for {
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseDayuClient().DescribeCCSelfDefinePolicy(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseDayuClient().DescribeCCSelfDefinePolicyWithContext(ctx, request)
 
 		if err != nil {
 			if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -122,8 +124,10 @@ func (me *DayuService) CreateCCSelfdefinePolicy(ctx context.Context, resourceTyp
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.Policy = &ccPolicy
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().CreateCCSelfDefinePolicy(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().CreateCCSelfDefinePolicyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -169,8 +173,10 @@ func (me *DayuService) ModifyCCSelfdefinePolicy(ctx context.Context, resourceTyp
 	request.Business = &resourceType
 	request.SetId = &policyId
 	request.Policy = &ccPolicy
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().ModifyCCSelfDefinePolicy(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().ModifyCCSelfDefinePolicyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -202,8 +208,10 @@ func (me *DayuService) DeleteCCSelfdefinePolicy(ctx context.Context, resourceTyp
 	request.Business = &resourceType
 	request.Id = &resourceId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().DeleteCCSelfDefinePolicy(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().DeleteCCSelfDefinePolicyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -236,8 +244,10 @@ func (me *DayuService) CreateDdosPolicy(ctx context.Context, resourceType string
 	request.IpAllowDenys = ipBlackWhite
 	request.PacketFilters = ddosPacketFilter
 	request.WaterPrint = waterPrintPolicy
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().CreateDDoSPolicy(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().CreateDDoSPolicyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -269,8 +279,10 @@ func (me *DayuService) DescribeDdosPolicies(ctx context.Context, resourceType st
 
 	request.Business = &resourceType
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().DescribeDDoSPolicy(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().DescribeDDoSPolicyWithContext(ctx, request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
 			if sdkErr.Code == "InvalidParameterValue" {
@@ -310,8 +322,10 @@ func (me *DayuService) DescribeDdosPolicy(ctx context.Context, resourceType stri
 	}()
 
 	request.Business = &resourceType
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().DescribeDDoSPolicy(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().DescribeDDoSPolicyWithContext(ctx, request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
 			if sdkErr.Code == "InvalidParameterValue" {
@@ -623,8 +637,10 @@ func (me *DayuService) DeleteDdosPolicy(ctx context.Context, resourceType string
 	request.PolicyId = &policyId
 	request.Business = &resourceType
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().DeleteDDoSPolicy(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().DeleteDDoSPolicyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -657,8 +673,10 @@ func (me *DayuService) ModifyDdosPolicy(ctx context.Context, resourceType string
 	request.IpAllowDenys = ipBlackWhite
 	request.PacketFilters = ddosPacketFilter
 	request.WaterPrint = waterPrintPolicy
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().ModifyDDoSPolicy(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().ModifyDDoSPolicyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -690,8 +708,10 @@ func (me *DayuService) ModifyDdosPolicyName(ctx context.Context, resourceType st
 	request.PolicyId = &policyId
 	request.Name = helper.String(url.QueryEscape(name))
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().ModifyDDoSPolicyName(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().ModifyDDoSPolicyNameWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -718,8 +738,10 @@ func (me *DayuService) CreateDdosPolicyCase(ctx context.Context, request *dayu.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().CreateDDoSPolicyCase(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().CreateDDoSPolicyCaseWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -747,8 +769,10 @@ func (me *DayuService) DescribeDdosPolicyCase(ctx context.Context, resourceType
 	}()
 	request.SceneId = &sceneId
 	request.Business = &resourceType
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().DescribePolicyCase(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().DescribePolicyCaseWithContext(ctx, request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
 			if sdkErr.Code == "InvalidParameterValue" {
@@ -790,8 +814,10 @@ func (me *DayuService) DeleteDdosPolicyCase(ctx context.Context, resourceType st
 	request.SceneId = &sceneId
 	request.Business = &resourceType
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().DeleteDDoSPolicyCase(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().DeleteDDoSPolicyCaseWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -825,8 +851,10 @@ func (me *DayuService) ModifyDdosPolicyCase(ctx context.Context, request *dayu.M
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().ModifyDDoSPolicyCase(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().ModifyDDoSPolicyCaseWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -858,8 +886,10 @@ func (me *DayuService) DescribeDdosPolicyAttachments(ctx context.Context, resour
 		request.Id = &resourceId
 	}
 	request.Business = &resourceType
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().DescribeDDoSPolicy(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().DescribeDDoSPolicyWithContext(ctx, request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
 			if sdkErr.Code == "InvalidParameterValue" {
@@ -907,8 +937,10 @@ func (me *DayuService) BindDdosPolicy(ctx context.Context, resourceId string, re
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().ModifyResBindDDoSPolicy(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().ModifyResBindDDoSPolicyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -941,8 +973,10 @@ func (me *DayuService) UnbindDdosPolicy(ctx context.Context, resourceId string,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().ModifyResBindDDoSPolicy(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().ModifyResBindDDoSPolicyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -991,8 +1025,10 @@ func (me *DayuService) DescribeL7Rules(ctx context.Context, resourceType string,
 	request.Offset = &offset
 	request.Limit = &limit
 	for {
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseDayuClient().DescribleL7Rules(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseDayuClient().DescribleL7RulesWithContext(ctx, request)
 
 		if err != nil {
 			if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -1073,8 +1109,10 @@ func (me *DayuService) SetL7Health(ctx context.Context, resourceType string, res
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.HealthConfig = []*dayu.L7HealthConfig{&healthCheck}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().CreateL7HealthConfig(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().CreateL7HealthConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1106,8 +1144,10 @@ func (me *DayuService) DescribeL7Health(ctx context.Context, resourceType string
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.RuleIdList = []*string{&ruleId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().DescribeL7HealthConfig(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().DescribeL7HealthConfigWithContext(ctx, request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
 			if sdkErr.Code == "InvalidParameterValue" {
@@ -1149,8 +1189,10 @@ func (me *DayuService) CreateL7Rule(ctx context.Context, resourceType string, re
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.Rules = []*dayu.L7RuleEntry{&rule}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().CreateL7Rules(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().CreateL7RulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1194,8 +1236,10 @@ func (me *DayuService) ModifyL7Rule(ctx context.Context, resourceType string, re
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.Rule = &rule
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().ModifyL7Rules(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().ModifyL7RulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1238,8 +1282,10 @@ func (me *DayuService) DeleteL7Rule(ctx context.Context, resourceType string, re
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.RuleIdList = []*string{&ruleId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().DeleteL7Rules(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().DeleteL7RulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1275,8 +1321,10 @@ func (me *DayuService) SetRuleSwitch(ctx context.Context, resourceType string, r
 		} else {
 			request.Method = helper.String("close")
 		}
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseDayuClient().ModifyCCHostProtection(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseDayuClient().ModifyCCHostProtectionWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1309,8 +1357,10 @@ func (me *DayuService) SetRuleSwitch(ctx context.Context, resourceType string, r
 			//this default value can be a request value that asks the whole threshold value if needed
 			request.Threshold = helper.IntUint64(DAYU_L7_HTTPS_SWITCH_ON_DEFAULT)
 		}
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseDayuClient().ModifyCCThreshold(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseDayuClient().ModifyCCThresholdWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1350,7 +1400,7 @@ func (me *DayuService) DescribeNewL4Rules(ctx context.Context, business string,
 		request.VirtualPort = common.Uint64Ptr(uint64(virtualPort.(int)))
 	}
 
-	response, errRet := me.client.UseDayuClient().DescribeNewL4Rules(request)
+	response, errRet := me.client.UseDayuClient().DescribeNewL4RulesWithContext(ctx, request)
 	if errRet != nil {
 		return
 	}
@@ -1377,8 +1427,10 @@ func (me *DayuService) DeleteNewL4Rules(ctx context.Context, business string, id
 		},
 	}
 	for {
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseDayuClient().DeleteNewL4Rules(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseDayuClient().DeleteNewL4RulesWithContext(ctx, request)
 
 		if err != nil {
 			if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -1438,8 +1490,10 @@ func (me *DayuService) ModifyNewL4Rule(ctx context.Context, business string, id
 	request.Rule = &tmpRule
 
 	for {
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseDayuClient().ModifyNewL4Rule(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseDayuClient().ModifyNewL4RuleWithContext(ctx, request)
 
 		if err != nil {
 			if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -1503,8 +1557,10 @@ func (me *DayuService) CreateNewL4Rules(ctx context.Context, business string, id
 	request.Rules = rules
 
 	for {
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseDayuClient().CreateNewL4Rules(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseDayuClient().CreateNewL4RulesWithContext(ctx, request)
 
 		if err != nil {
 			if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -1542,8 +1598,10 @@ func (me *DayuService) DescribeL4Rules(ctx context.Context, resourceType string,
 	request.Offset = &offset
 	request.Limit = &limit
 	for {
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseDayuClient().DescribleL4Rules(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseDayuClient().DescribleL4RulesWithContext(ctx, request)
 
 		if err != nil {
 			if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -1628,8 +1686,10 @@ func (me *DayuService) SetL4Health(ctx context.Context, resourceType string, res
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.HealthConfig = []*dayu.L4HealthConfig{&healthCheck}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().CreateL4HealthConfig(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().CreateL4HealthConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1661,8 +1721,10 @@ func (me *DayuService) DescribeL4Health(ctx context.Context, resourceType string
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.RuleIdList = []*string{&ruleId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().DescribeL4HealthConfig(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().DescribeL4HealthConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1697,8 +1759,10 @@ func (me *DayuService) CreateL4Rule(ctx context.Context, resourceType string, re
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.Rules = []*dayu.L4RuleEntry{&rule}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().CreateL4Rules(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().CreateL4RulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1743,8 +1807,10 @@ func (me *DayuService) ModifyL4Rule(ctx context.Context, resourceType string, re
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.Rule = &rule
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().ModifyL4Rules(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().ModifyL4RulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1774,8 +1840,10 @@ func (me *DayuService) DeleteL4Rule(ctx context.Context, resourceType string, re
 	request.Id = &resourceId
 	request.Business = &resourceType
 	request.RuleIdList = []*string{&ruleId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().DeleteL4Rules(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().DeleteL4RulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1807,8 +1875,10 @@ func (me *DayuService) SetSession(ctx context.Context, resourceType string, reso
 	request.RuleId = &ruleId
 	request.KeepEnable = helper.BoolToInt64Pointer(switchFlag)
 	request.KeepTime = helper.IntUint64(sessionTime)
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDayuClient().ModifyL4KeepTime(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDayuClient().ModifyL4KeepTimeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1865,8 +1935,10 @@ func (me *DayuService) DescribeL7RulesV2(ctx context.Context, business string, e
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseDayuClient().DescribleNewL7Rules(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseDayuClient().DescribleNewL7RulesWithContext(ctx, request)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1943,9 +2015,11 @@ func (me *DayuService) CreateL7RuleV2(ctx context.Context, business string, reso
 	request.VipList = []*string{&resourceIp}
 	request.Business = &business
 	request.Rules = []*dayu.L7RuleEntry{&ruleEntry}
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, errRet := me.client.UseDayuClient().CreateNewL7Rules(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, errRet := me.client.UseDayuClient().CreateNewL7RulesWithContext(ctx, request)
 
 		if e, ok := errRet.(*sdkError.TencentCloudSDKError); ok {
 			if e.GetCode() == "InternalError.ClusterNotFound" {
@@ -1976,9 +2050,11 @@ func (me *DayuService) ModifyL7RuleV2(ctx context.Context, resourceType string,
 	request.Business = &resourceType
 	request.Rule = rule
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, errRet := me.client.UseDayuClient().ModifyNewDomainRules(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, errRet := me.client.UseDayuClient().ModifyNewDomainRulesWithContext(ctx, request)
 
 		if e, ok := errRet.(*sdkError.TencentCloudSDKError); ok {
 			if e.GetCode() == "InternalError.ClusterNotFound" {
@@ -2014,9 +2090,11 @@ func (me *DayuService) DeleteL7RulesV2(ctx context.Context, resourceType string,
 			RuleIdList: []*string{&ruleId},
 		},
 	}
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, errRet := me.client.UseDayuClient().DeleteNewL7Rules(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, errRet := me.client.UseDayuClient().DeleteNewL7RulesWithContext(ctx, request)
 
 		if e, ok := errRet.(*sdkError.TencentCloudSDKError); ok {
 			if e.GetCode() == "InternalError.ClusterNotFound" {
@@ -61,7 +61,9 @@ func (me *DbbrainService) DescribeDbbrainSqlFiltersByFilter(ctx context.Context,
 			request.Statuses = v.([]*string)
 		}
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset int64 = 0
 	var pageSize int64 = 20
@@ -69,8 +71,10 @@ func (me *DbbrainService) DescribeDbbrainSqlFiltersByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseDbbrainClient().DescribeSqlFilters(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseDbbrainClient().DescribeSqlFiltersWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -110,8 +114,10 @@ func (me *DbbrainService) getSessionToken(ctx context.Context, instanceId, user,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDbbrainClient().VerifyUserAccount(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDbbrainClient().VerifyUserAccountWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -139,8 +145,10 @@ func (me *DbbrainService) DeleteDbbrainSqlFilterById(ctx context.Context, instan
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDbbrainClient().DeleteSqlFilters(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDbbrainClient().DeleteSqlFiltersWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -201,7 +209,9 @@ func (me *DbbrainService) DescribeDbbrainSecurityAuditLogExportTasksByFilter(ctx
 			request.AsyncRequestIds = v.([]*uint64)
 		}
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -209,8 +219,10 @@ func (me *DbbrainService) DescribeDbbrainSecurityAuditLogExportTasksByFilter(ctx
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseDbbrainClient().DescribeSecurityAuditLogExportTasks(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseDbbrainClient().DescribeSecurityAuditLogExportTasksWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -252,8 +264,10 @@ func (me *DbbrainService) DeleteDbbrainSecurityAuditLogExportTaskById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDbbrainClient().DeleteSecurityAuditLogExportTasks(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDbbrainClient().DeleteSecurityAuditLogExportTasksWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -292,7 +306,9 @@ func (me *DbbrainService) DescribeDbbrainDiagEventsByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -301,7 +317,7 @@ func (me *DbbrainService) DescribeDbbrainDiagEventsByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseDbbrainClient().DescribeDBDiagEvents(request)
+		response, err := me.client.UseDbbrainClient().DescribeDBDiagEventsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -346,9 +362,11 @@ func (me *DbbrainService) DescribeDbbrainDiagEventByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDbbrainClient().DescribeDBDiagEvent(request)
+	response, err := me.client.UseDbbrainClient().DescribeDBDiagEventWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -389,9 +407,11 @@ func (me *DbbrainService) DescribeDbbrainDiagHistoryByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDbbrainClient().DescribeDBDiagHistory(request)
+	response, err := me.client.UseDbbrainClient().DescribeDBDiagHistoryWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -429,9 +449,11 @@ func (me *DbbrainService) DescribeDbbrainSecurityAuditLogDownloadUrlsByFilter(ct
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDbbrainClient().DescribeSecurityAuditLogDownloadUrls(request)
+	response, err := me.client.UseDbbrainClient().DescribeSecurityAuditLogDownloadUrlsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -472,9 +494,11 @@ func (me *DbbrainService) DescribeDbbrainSlowLogTimeSeriesStatsByFilter(ctx cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDbbrainClient().DescribeSlowLogTimeSeriesStats(request)
+	response, err := me.client.UseDbbrainClient().DescribeSlowLogTimeSeriesStatsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -530,7 +554,9 @@ func (me *DbbrainService) DescribeDbbrainSlowLogTopSqlsByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -539,7 +565,7 @@ func (me *DbbrainService) DescribeDbbrainSlowLogTopSqlsByFilter(ctx context.Cont
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseDbbrainClient().DescribeSlowLogTopSqls(request)
+		response, err := me.client.UseDbbrainClient().DescribeSlowLogTopSqlsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -590,9 +616,11 @@ func (me *DbbrainService) DescribeDbbrainSlowLogUserHostStatsByFilter(ctx contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDbbrainClient().DescribeSlowLogUserHostStats(request)
+	response, err := me.client.UseDbbrainClient().DescribeSlowLogUserHostStatsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -633,9 +661,11 @@ func (me *DbbrainService) DescribeDbbrainSlowLogUserSqlAdviceByFilter(ctx contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDbbrainClient().DescribeUserSqlAdvice(request)
+	response, err := me.client.UseDbbrainClient().DescribeUserSqlAdviceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -662,9 +692,11 @@ func (me *DbbrainService) DescribeDbbrainDbDiagReportTaskById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDbbrainClient().DescribeDBDiagReportTasks(request)
+	response, err := me.client.UseDbbrainClient().DescribeDBDiagReportTasksWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -699,9 +731,11 @@ func (me *DbbrainService) DeleteDbbrainDbDiagReportTaskById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDbbrainClient().DeleteDBDiagReportTasks(request)
+	response, err := me.client.UseDbbrainClient().DeleteDBDiagReportTasksWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -738,9 +772,11 @@ func (me *DbbrainService) DescribeDbbrainTdsqlAuditLogById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDbbrainClient().DescribeAuditLogFiles(request)
+	response, err := me.client.UseDbbrainClient().DescribeAuditLogFilesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -779,9 +815,11 @@ func (me *DbbrainService) DeleteDbbrainTdsqlAuditLogById(ctx context.Context, as
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDbbrainClient().DeleteAuditLogFile(request)
+	response, err := me.client.UseDbbrainClient().DeleteAuditLogFileWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -815,9 +853,11 @@ func (me *DbbrainService) DescribeDbbrainHealthScoresByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDbbrainClient().DescribeHealthScore(request)
+	response, err := me.client.UseDbbrainClient().DescribeHealthScoreWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -877,7 +917,9 @@ func (me *DbbrainService) DescribeDbbrainSlowLogsByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -886,7 +928,7 @@ func (me *DbbrainService) DescribeDbbrainSlowLogsByFilter(ctx context.Context, p
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseDbbrainClient().DescribeSlowLogs(request)
+		response, err := me.client.UseDbbrainClient().DescribeSlowLogsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -931,9 +973,11 @@ func (me *DbbrainService) DescribeDbbrainDbSpaceStatusByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDbbrainClient().DescribeDBSpaceStatus(request)
+	response, err := me.client.UseDbbrainClient().DescribeDBSpaceStatusWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -974,9 +1018,11 @@ func (me *DbbrainService) DescribeDbbrainSqlTemplatesByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDbbrainClient().DescribeSqlTemplate(request)
+	response, err := me.client.UseDbbrainClient().DescribeSqlTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1017,8 +1063,10 @@ func (me *DbbrainService) DescribeDbbrainTopSpaceSchemasByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDbbrainClient().DescribeTopSpaceSchemas(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDbbrainClient().DescribeTopSpaceSchemasWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1067,8 +1115,10 @@ func (me *DbbrainService) DescribeDbbrainTopSpaceSchemaTimeSeriesByFilter(ctx co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDbbrainClient().DescribeTopSpaceSchemaTimeSeries(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDbbrainClient().DescribeTopSpaceSchemaTimeSeriesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1116,8 +1166,10 @@ func (me *DbbrainService) DescribeDbbrainTopSpaceTableTimeSeriesByFilter(ctx con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDbbrainClient().DescribeTopSpaceTableTimeSeries(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDbbrainClient().DescribeTopSpaceTableTimeSeriesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1159,9 +1211,11 @@ func (me *DbbrainService) DescribeDbbrainTopSpaceTablesByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDbbrainClient().DescribeTopSpaceTables(request)
+	response, err := me.client.UseDbbrainClient().DescribeTopSpaceTablesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1207,7 +1261,9 @@ func (me *DbbrainService) DescribeDbbrainDiagDbInstancesByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1216,7 +1272,7 @@ func (me *DbbrainService) DescribeDbbrainDiagDbInstancesByFilter(ctx context.Con
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseDbbrainClient().DescribeDiagDBInstances(request)
+		response, err := me.client.UseDbbrainClient().DescribeDiagDBInstancesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1282,13 +1338,15 @@ func (me *DbbrainService) DescribeDbbrainMysqlProcessListByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		limit uint64 = 20
 	)
 	request.Limit = &limit
-	response, err := me.client.UseDbbrainClient().DescribeMySqlProcessList(request)
+	response, err := me.client.UseDbbrainClient().DescribeMySqlProcessListWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1327,7 +1385,9 @@ func (me *DbbrainService) DescribeDbbrainNoPrimaryKeyTablesByFilter(ctx context.
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1336,7 +1396,7 @@ func (me *DbbrainService) DescribeDbbrainNoPrimaryKeyTablesByFilter(ctx context.
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseDbbrainClient().DescribeNoPrimaryKeyTables(request)
+		response, err := me.client.UseDbbrainClient().DescribeNoPrimaryKeyTablesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1388,13 +1448,15 @@ func (me *DbbrainService) DescribeDbbrainRedisTopBigKeysByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		limit int64 = 20
 	)
 	request.Limit = &limit
-	response, err := me.client.UseDbbrainClient().DescribeRedisTopBigKeys(request)
+	response, err := me.client.UseDbbrainClient().DescribeRedisTopBigKeysWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1433,13 +1495,15 @@ func (me *DbbrainService) DescribeDbbrainRedisTopKeyPrefixListByFilter(ctx conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		limit int64 = 20
 	)
 	request.Limit = &limit
-	response, err := me.client.UseDbbrainClient().DescribeRedisTopKeyPrefixList(request)
+	response, err := me.client.UseDbbrainClient().DescribeRedisTopKeyPrefixListWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -87,8 +87,10 @@ getMoreData:
 	}
 	request.Limit = &limit
 	request.Offset = &offset
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDcClient().DescribeDirectConnects(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDcClient().DescribeDirectConnectsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -166,8 +168,10 @@ getMoreData:
 	}
 	request.Limit = &limit
 	request.Offset = &offset
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDcClient().DescribeDirectConnectTunnels(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDcClient().DescribeDirectConnectTunnelsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -247,8 +251,10 @@ func (me *DcService) CreateDirectConnectTunnel(ctx context.Context, dcId, dcxNam
 	if customerAddress != "" {
 		request.CustomerAddress = &customerAddress
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDcClient().CreateDirectConnectTunnel(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDcClient().CreateDirectConnectTunnelWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -275,8 +281,10 @@ func (me *DcService) DeleteDirectConnectTunnel(ctx context.Context, dcxId string
 	}()
 
 	request.DirectConnectTunnelId = &dcxId
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseDcClient().DeleteDirectConnectTunnel(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseDcClient().DeleteDirectConnectTunnelWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 	}
@@ -327,8 +335,10 @@ func (me *DcService) ModifyDirectConnectTunnelAttribute(ctx context.Context, dcx
 			request.RouteFilterPrefixes = append(request.RouteFilterPrefixes, &dcPrefix)
 		}
 	}
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseDcClient().ModifyDirectConnectTunnelAttribute(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseDcClient().ModifyDirectConnectTunnelAttributeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 	}
@@ -347,9 +357,11 @@ func (me *DcService) DescribeDcShareDcxConfigById(ctx context.Context, directCon
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcClient().DescribeDirectConnectTunnels(request)
+	response, err := me.client.UseDcClient().DescribeDirectConnectTunnelsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -375,9 +387,11 @@ func (me *DcService) DescribeDcInternetAddressById(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcClient().DescribeInternetAddress(request)
+	response, err := me.client.UseDcClient().DescribeInternetAddressWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -405,9 +419,11 @@ func (me *DcService) DeleteDcInternetAddressById(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcClient().ReleaseInternetAddress(request)
+	response, err := me.client.UseDcClient().ReleaseInternetAddressWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -429,9 +445,11 @@ func (me *DcService) DescribeDcxExtraConfigById(ctx context.Context, directConne
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcClient().DescribeDirectConnectTunnelExtra(request)
+	response, err := me.client.UseDcClient().DescribeDirectConnectTunnelExtraWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -454,9 +472,11 @@ func (me *DcService) DescribeDcInternetAddressQuota(ctx context.Context) (Intern
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcClient().DescribeInternetAddressQuota(request)
+	response, err := me.client.UseDcClient().DescribeInternetAddressQuotaWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -480,9 +500,11 @@ func (me *DcService) DescribeDcInternetAddressStatistics(ctx context.Context) (s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcClient().DescribeInternetAddressStatistics(request)
+	response, err := me.client.UseDcClient().DescribeInternetAddressStatisticsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -514,7 +536,9 @@ func (me *DcService) DescribeDcPublicDirectConnectTunnelRoutesByFilter(ctx conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -523,7 +547,7 @@ func (me *DcService) DescribeDcPublicDirectConnectTunnelRoutesByFilter(ctx conte
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseDcClient().DescribePublicDirectConnectTunnelRoutes(request)
+		response, err := me.client.UseDcClient().DescribePublicDirectConnectTunnelRoutesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -556,9 +580,11 @@ func (me *DcService) DeleteDcInstanceById(ctx context.Context, directConnectId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcClient().DeleteDirectConnect(request)
+	response, err := me.client.UseDcClient().DeleteDirectConnectWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -586,7 +612,9 @@ func (me *DcService) DescribeDcAccessPointsByFilter(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -595,7 +623,7 @@ func (me *DcService) DescribeDcAccessPointsByFilter(ctx context.Context, param m
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseDcClient().DescribeAccessPoints(request)
+		response, err := me.client.UseDcClient().DescribeAccessPointsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
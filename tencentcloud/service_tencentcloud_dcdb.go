@@ -18,7 +18,7 @@ type DcdbService struct {
 	client *connectivity.TencentCloudClient
 }
 
-//dc_account
+// dc_account
 func (me *DcdbService) DescribeDcdbAccount(ctx context.Context, instanceId, userName string) (account *dcdb.DescribeAccountsResponseParams, errRet error) {
 	var (
 		logId   = getLogId(ctx)
@@ -33,7 +33,7 @@ func (me *DcdbService) DescribeDcdbAccount(ctx context.Context, instanceId, user
 	}()
 	request.InstanceId = &instanceId
 
-	response, err := me.client.UseDcdbClient().DescribeAccounts(request)
+	response, err := me.client.UseDcdbClient().DescribeAccountsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -78,8 +78,10 @@ func (me *DcdbService) DeleteDcdbAccountById(ctx context.Context, instanceId, us
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDcdbClient().DeleteAccount(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDcdbClient().DeleteAccountWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -90,7 +92,7 @@ func (me *DcdbService) DeleteDcdbAccountById(ctx context.Context, instanceId, us
 	return
 }
 
-//dc_db_instance
+// dc_db_instance
 func (me *DcdbService) DescribeDcdbDbInstance(ctx context.Context, instanceId string) (instances *dcdb.DescribeDCDBInstancesResponseParams, errRet error) {
 	params := make(map[string]interface{})
 	params["instance_ids"] = []*string{&instanceId}
@@ -121,7 +123,7 @@ func (me *DcdbService) InitDcdbDbInstance(ctx context.Context, instanceId string
 		}
 	}()
 
-	err := resource.Retry(15*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, 15*readRetryTimeout, func() *resource.RetryError {
 		dbInstances, errResp := me.DescribeDcdbDbInstance(ctx, instanceId)
 		if errResp != nil {
 			return retryError(errResp, InternalError)
@@ -141,8 +143,8 @@ func (me *DcdbService) InitDcdbDbInstance(ctx context.Context, instanceId string
 			iniRequest := dcdb.NewInitDCDBInstancesRequest()
 			iniRequest.InstanceIds = []*string{&instanceId}
 			iniRequest.Params = params
-			initErr := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := me.client.UseDcdbClient().InitDCDBInstances(iniRequest)
+			initErr := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+				result, e := me.client.UseDcdbClient().InitDCDBInstancesWithContext(ctx, iniRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -166,7 +168,7 @@ func (me *DcdbService) InitDcdbDbInstance(ctx context.Context, instanceId string
 	return true, flowId, nil
 }
 
-//dc_hourdb_instance
+// dc_hourdb_instance
 func (me *DcdbService) DescribeDcdbHourdbInstance(ctx context.Context, instanceId string) (hourdbInstance *dcdb.DescribeDCDBInstancesResponseParams, errRet error) {
 	return me.DescribeDcdbDbInstance(ctx, instanceId)
 }
@@ -185,8 +187,10 @@ func (me *DcdbService) DeleteDcdbHourdbInstanceById(ctx context.Context, instanc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDcdbClient().DestroyHourDCDBInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDcdbClient().DestroyHourDCDBInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -197,7 +201,7 @@ func (me *DcdbService) DeleteDcdbHourdbInstanceById(ctx context.Context, instanc
 	return
 }
 
-//dc_sg
+// dc_sg
 func (me *DcdbService) DescribeDcdbSecurityGroup(ctx context.Context, instanceId string) (securityGroup *dcdb.DescribeDBSecurityGroupsResponseParams, errRet error) {
 	var (
 		logId   = getLogId(ctx)
@@ -214,7 +218,7 @@ func (me *DcdbService) DescribeDcdbSecurityGroup(ctx context.Context, instanceId
 	request.InstanceId = &instanceId
 	request.Product = helper.String("dcdb") // api only use this fixed value
 
-	response, err := me.client.UseDcdbClient().DescribeDBSecurityGroups(request)
+	response, err := me.client.UseDcdbClient().DescribeDBSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -244,8 +248,10 @@ func (me *DcdbService) DeleteDcdbSecurityGroupAttachmentById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDcdbClient().DisassociateSecurityGroups(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDcdbClient().DisassociateSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -313,7 +319,9 @@ func (me *DcdbService) DescribeDcdbInstancesByFilter(ctx context.Context, params
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset int64 = 0
 	var pageSize int64 = 20
@@ -322,8 +330,10 @@ func (me *DcdbService) DescribeDcdbInstancesByFilter(ctx context.Context, params
 		request.Offset = &offset
 		request.Limit = &pageSize
 
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseDcdbClient().DescribeDCDBInstances(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseDcdbClient().DescribeDCDBInstancesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -364,14 +374,18 @@ func (me *DcdbService) DescribeDcdbAccountsByFilter(ctx context.Context, param m
 			request.InstanceId = v.(*string)
 		}
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset int64 = 0
 	var pageSize int64 = 20
 
 	for {
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseDcdbClient().DescribeAccounts(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseDcdbClient().DescribeAccountsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -412,14 +426,18 @@ func (me *DcdbService) DescribeDcdbDatabasesByFilter(ctx context.Context, param
 			request.InstanceId = v.(*string)
 		}
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset int64 = 0
 	var pageSize int64 = 20
 
 	for {
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseDcdbClient().DescribeDatabases(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseDcdbClient().DescribeDatabasesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -461,8 +479,10 @@ func (me *DcdbService) DescribeDcdbParametersByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDcdbClient().DescribeDBParameters(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDcdbClient().DescribeDBParametersWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -500,7 +520,9 @@ func (me *DcdbService) DescribeDcdbShardsByFilter(ctx context.Context, param map
 			request.ShardInstanceIds = v.([]*string)
 		}
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset int64 = 0
 	var pageSize int64 = 20
@@ -508,8 +530,10 @@ func (me *DcdbService) DescribeDcdbShardsByFilter(ctx context.Context, param map
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseDcdbClient().DescribeDCDBShards(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseDcdbClient().DescribeDCDBShardsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -552,8 +576,10 @@ func (me *DcdbService) DescribeDcdbSecurityGroupsByFilter(ctx context.Context, p
 	}
 	request.Product = helper.String("dcdb") // api only use this fixed value
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDcdbClient().DescribeDBSecurityGroups(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDcdbClient().DescribeDBSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -581,9 +607,11 @@ func (me *DcdbService) DeleteDcdbDbInstanceById(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().DestroyDCDBInstance(request)
+	response, err := me.client.UseDcdbClient().DestroyDCDBInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -605,9 +633,11 @@ func (me *DcdbService) DescribeDcnDetailById(ctx context.Context, instanceId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().DescribeDcnDetail(request)
+	response, err := me.client.UseDcdbClient().DescribeDcnDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -647,9 +677,11 @@ func (me *DcdbService) DescribeDcdbFlowById(ctx context.Context, flowId *int64)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().DescribeFlow(request)
+	response, err := me.client.UseDcdbClient().DescribeFlowWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -695,9 +727,9 @@ func (me *DcdbService) DescribeDcdbAccountPrivilegesById(ctx context.Context, id
 		}
 	}()
 
-	idSplit := strings.Split(ids, FILED_SP)
-	if len(idSplit) != 7 {
-		return nil, fmt.Errorf("[service_tc_dbdb]id is broken,%s", ids)
+	idSplit, err := helper.IdParseN(ids, 7)
+	if err != nil {
+		return nil, err
 	}
 
 	request.InstanceId = helper.String(idSplit[0])
@@ -730,9 +762,11 @@ func (me *DcdbService) DescribeDcdbAccountPrivilegesById(ctx context.Context, id
 		request.ColName = all
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().DescribeAccountPrivileges(request)
+	response, err := me.client.UseDcdbClient().DescribeAccountPrivilegesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -755,9 +789,11 @@ func (me *DcdbService) DescribeDcdbDatabases(ctx context.Context, instanceId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().DescribeDatabases(request)
+	response, err := me.client.UseDcdbClient().DescribeDatabasesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -782,9 +818,11 @@ func (me *DcdbService) DescribeDcdbDBTables(ctx context.Context, instanceId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().DescribeDatabaseTable(request)
+	response, err := me.client.UseDcdbClient().DescribeDatabaseTableWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -808,9 +846,11 @@ func (me *DcdbService) DescribeDcdbDBObjects(ctx context.Context, instanceId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().DescribeDatabaseObjects(request)
+	response, err := me.client.UseDcdbClient().DescribeDatabaseObjectsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -834,9 +874,11 @@ func (me *DcdbService) DescribeDcdbDbParametersById(ctx context.Context, instanc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().DescribeDBParameters(request)
+	response, err := me.client.UseDcdbClient().DescribeDBParametersWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -927,9 +969,11 @@ func (me *DcdbService) DescribeDcdbDbInstanceDetailById(ctx context.Context, ins
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().DescribeDCDBInstanceDetail(request)
+	response, err := me.client.UseDcdbClient().DescribeDCDBInstanceDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -952,9 +996,11 @@ func (me *DcdbService) DeleteDcdbDedicatedClusterDbInstanceById(ctx context.Cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().TerminateDedicatedDBInstance(request)
+	response, err := me.client.UseDcdbClient().TerminateDedicatedDBInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -976,9 +1022,11 @@ func (me *DcdbService) DescribeDcdbEncryptAttributesConfigById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().DescribeDBEncryptAttributes(request)
+	response, err := me.client.UseDcdbClient().DescribeDBEncryptAttributesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1001,9 +1049,11 @@ func (me *DcdbService) DescribeDcdbDbSyncModeConfigById(ctx context.Context, ins
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().DescribeDBSyncMode(request)
+	response, err := me.client.UseDcdbClient().DescribeDBSyncModeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1074,8 +1124,8 @@ func (me *DcdbService) SetDcdbExtranetAccess(ctx context.Context, instanceId str
 		request.InstanceId = &instanceId
 		request.Ipv6Flag = helper.IntInt64(ipv6Flag)
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := me.client.UseDcdbClient().OpenDBExtranetAccess(request)
+		err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+			result, e := me.client.UseDcdbClient().OpenDBExtranetAccessWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -1095,8 +1145,8 @@ func (me *DcdbService) SetDcdbExtranetAccess(ctx context.Context, instanceId str
 		request.InstanceId = &instanceId
 		request.Ipv6Flag = helper.IntInt64(ipv6Flag)
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			result, e := me.client.UseDcdbClient().CloseDBExtranetAccess(request)
+		err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+			result, e := me.client.UseDcdbClient().CloseDBExtranetAccessWithContext(ctx, request)
 			if e != nil {
 				return retryError(e)
 			} else {
@@ -1129,8 +1179,8 @@ func (me *DcdbService) SetRealServerAccessStrategy(ctx context.Context, instance
 	request.InstanceId = &instanceId
 	request.RsAccessStrategy = helper.IntInt64(rsAccessStrategy)
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := me.client.UseDcdbClient().ModifyRealServerAccessStrategy(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := me.client.UseDcdbClient().ModifyRealServerAccessStrategyWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1162,8 +1212,8 @@ func (me *DcdbService) SetNetworkVip(ctx context.Context, instanceId, vpcId, sub
 		request.Vipv6 = &vipv6
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := me.client.UseDcdbClient().ModifyInstanceNetwork(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := me.client.UseDcdbClient().ModifyInstanceNetworkWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1213,9 +1263,11 @@ func (me *DcdbService) DescribeDcdbFileDownloadUrlByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().DescribeFileDownloadUrl(request)
+	response, err := me.client.UseDcdbClient().DescribeFileDownloadUrlWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1254,9 +1306,11 @@ func (me *DcdbService) DescribeDcdbLogFilesByFilter(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().DescribeDBLogFiles(request)
+	response, err := me.client.UseDcdbClient().DescribeDBLogFilesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1289,7 +1343,9 @@ func (me *DcdbService) DescribeDcdbInstanceNodeInfoByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -1298,7 +1354,7 @@ func (me *DcdbService) DescribeDcdbInstanceNodeInfoByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseDcdbClient().DescribeDCDBInstanceNodeInfo(request)
+		response, err := me.client.UseDcdbClient().DescribeDCDBInstanceNodeInfoWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1337,9 +1393,11 @@ func (me *DcdbService) DescribeDcdbOrdersByFilter(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().DescribeOrders(request)
+	response, err := me.client.UseDcdbClient().DescribeOrdersWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1397,9 +1455,11 @@ func (me *DcdbService) DescribeDcdbPriceByFilter(ctx context.Context, param map[
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().DescribeDCDBPrice(request)
+	response, err := me.client.UseDcdbClient().DescribeDCDBPriceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1426,9 +1486,11 @@ func (me *DcdbService) DescribeDcdbProjectsByFilter(ctx context.Context) (projec
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().DescribeProjects(request)
+	response, err := me.client.UseDcdbClient().DescribeProjectsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1464,9 +1526,11 @@ func (me *DcdbService) DescribeDcdbProjectSecurityGroupsByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().DescribeProjectSecurityGroups(request)
+	response, err := me.client.UseDcdbClient().DescribeProjectSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1505,9 +1569,11 @@ func (me *DcdbService) DescribeDcdbRenewalPriceByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().DescribeDCDBRenewalPrice(request)
+	response, err := me.client.UseDcdbClient().DescribeDCDBRenewalPriceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1534,9 +1600,11 @@ func (me *DcdbService) DescribeDcdbSaleInfoByFilter(ctx context.Context, param m
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().DescribeDCDBSaleInfo(request)
+	response, err := me.client.UseDcdbClient().DescribeDCDBSaleInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1564,7 +1632,7 @@ func (me *DcdbService) DescribeDcdbShardSpecByFilter(ctx context.Context, param
 		}
 	}()
 
-	response, err := me.client.UseDcdbClient().DescribeShardSpec(request)
+	response, err := me.client.UseDcdbClient().DescribeShardSpecWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1619,7 +1687,9 @@ func (me *DcdbService) DescribeDcdbSlowLogsByFilter(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -1628,7 +1698,7 @@ func (me *DcdbService) DescribeDcdbSlowLogsByFilter(ctx context.Context, param m
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseDcdbClient().DescribeDBSlowLogs(request)
+		response, err := me.client.UseDcdbClient().DescribeDBSlowLogsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1683,9 +1753,11 @@ func (me *DcdbService) DescribeDcdbUpgradePriceByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDcdbClient().DescribeDCDBUpgradePrice(request)
+	response, err := me.client.UseDcdbClient().DescribeDCDBUpgradePriceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
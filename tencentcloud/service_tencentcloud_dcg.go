@@ -92,8 +92,10 @@ getMoreData:
 	}
 	request.Limit = &limit
 	request.Offset = &offset
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DescribeDirectConnectGateways(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DescribeDirectConnectGatewaysWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -257,8 +259,10 @@ getMoreData:
 	}
 	request.Limit = &limit
 	request.Offset = &offset
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DescribeDirectConnectGatewayCcnRoutes(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DescribeDirectConnectGatewayCcnRoutesWithContext(ctx, request)
 
 	if err != nil {
 		if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -326,8 +330,10 @@ func (me *VpcService) CreateDirectConnectGateway(ctx context.Context, name, netw
 	request.NetworkType = &networkType
 	request.NetworkInstanceId = &networkInstanceId
 	request.GatewayType = &gatewayType
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().CreateDirectConnectGateway(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().CreateDirectConnectGatewayWithContext(ctx, request)
 
 	defer func() {
 		if errRet != nil {
@@ -368,8 +374,10 @@ func (me *VpcService) ModifyDirectConnectGatewayAttribute(ctx context.Context, d
 	request := vpc.NewModifyDirectConnectGatewayAttributeRequest()
 	request.DirectConnectGatewayId = &dcgId
 	request.DirectConnectGatewayName = &name
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().ModifyDirectConnectGatewayAttribute(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().ModifyDirectConnectGatewayAttributeWithContext(ctx, request)
 
 	defer func() {
 		if errRet != nil {
@@ -395,8 +403,10 @@ func (me *VpcService) DeleteDirectConnectGateway(ctx context.Context, dcgId stri
 	logId := getLogId(ctx)
 	request := vpc.NewDeleteDirectConnectGatewayRequest()
 	request.DirectConnectGatewayId = &dcgId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DeleteDirectConnectGateway(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DeleteDirectConnectGatewayWithContext(ctx, request)
 
 	defer func() {
 		if errRet != nil {
@@ -432,8 +442,10 @@ func (me *VpcService) CreateDirectConnectGatewayCcnRoute(ctx context.Context, dc
 		ccnRoute.ASPath = append(ccnRoute.ASPath, &asPaths[index])
 	}
 	request.Routes = []*vpc.DirectConnectGatewayCcnRoute{&ccnRoute}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().CreateDirectConnectGatewayCcnRoutes(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().CreateDirectConnectGatewayCcnRoutesWithContext(ctx, request)
 
 	defer func() {
 		if errRet != nil {
@@ -487,8 +499,10 @@ func (me *VpcService) ReplaceDirectConnectGatewayCcnRoute(ctx context.Context, d
 		ccnRoute.ASPath = append(ccnRoute.ASPath, &asPaths[index])
 	}
 	request.Routes = []*vpc.DirectConnectGatewayCcnRoute{&ccnRoute}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().ReplaceDirectConnectGatewayCcnRoutes(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().ReplaceDirectConnectGatewayCcnRoutesWithContext(ctx, request)
 
 	defer func() {
 		if errRet != nil {
@@ -533,8 +547,10 @@ func (me *VpcService) DeleteDirectConnectGatewayCcnRoute(ctx context.Context, dc
 	request := vpc.NewDeleteDirectConnectGatewayCcnRoutesRequest()
 	request.DirectConnectGatewayId = &dcgId
 	request.RouteIds = []*string{&routeId}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DeleteDirectConnectGatewayCcnRoutes(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DeleteDirectConnectGatewayCcnRoutesWithContext(ctx, request)
 
 	defer func() {
 		if errRet != nil {
@@ -567,9 +583,11 @@ func (me *VpcService) DescribeDcGatewayAttachmentById(ctx context.Context, vpcId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeDirectConnectGateways(request)
+	response, err := me.client.UseVpcClient().DescribeDirectConnectGatewaysWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -599,9 +617,11 @@ func (me *VpcService) DeleteDcGatewayAttachmentById(ctx context.Context, vpcId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DisassociateDirectConnectGatewayNatGateway(request)
+	response, err := me.client.UseVpcClient().DisassociateDirectConnectGatewayNatGatewayWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
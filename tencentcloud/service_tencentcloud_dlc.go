@@ -26,9 +26,11 @@ func (me *DlcService) DescribeDlcWorkGroupById(ctx context.Context, workGroupId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDlcClient().DescribeWorkGroups(request)
+	response, err := me.client.UseDlcClient().DescribeWorkGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -55,9 +57,11 @@ func (me *DlcService) DeleteDlcWorkGroupById(ctx context.Context, workGroupId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDlcClient().DeleteWorkGroup(request)
+	response, err := me.client.UseDlcClient().DeleteWorkGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -79,9 +83,11 @@ func (me *DlcService) DescribeDlcUserById(ctx context.Context, userId string) (u
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDlcClient().DescribeUsers(request)
+	response, err := me.client.UseDlcClient().DescribeUsersWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -108,9 +114,11 @@ func (me *DlcService) DeleteDlcUserById(ctx context.Context, userId string) (err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDlcClient().DeleteUser(request)
+	response, err := me.client.UseDlcClient().DeleteUserWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -32,9 +32,11 @@ func (me *DnspodService) ModifyDnsPodDomainStatus(ctx context.Context, domain st
 	request.Domain = helper.String(domain)
 	request.Status = &status
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseDnsPodClient().ModifyDomainStatus(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseDnsPodClient().ModifyDomainStatusWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -58,9 +60,11 @@ func (me *DnspodService) ModifyDnsPodDomainRemark(ctx context.Context, domain st
 	request.Domain = helper.String(domain)
 	request.Remark = &remark
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseDnsPodClient().ModifyDomainRemark(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseDnsPodClient().ModifyDomainRemarkWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -86,7 +90,7 @@ func (me *DnspodService) DescribeDomain(ctx context.Context, domain string) (ret
 
 	request.Domain = helper.String(domain)
 
-	response, err := me.client.UseDnsPodClient().DescribeDomain(request)
+	response, err := me.client.UseDnsPodClient().DescribeDomainWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -104,10 +108,12 @@ func (me *DnspodService) DeleteDomain(ctx context.Context, domain string) (errRe
 
 	logId := getLogId(ctx)
 	request := dnspod.NewDeleteDomainRequest()
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 	request.Domain = helper.String(domain)
 
-	response, err := me.client.UseDnsPodClient().DeleteDomain(request)
+	response, err := me.client.UseDnsPodClient().DeleteDomainWithContext(ctx, request)
 
 	defer func() {
 		if errRet != nil {
@@ -137,8 +143,10 @@ func (me *DnspodService) DescribeRecordList(ctx context.Context, request *dnspod
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDnsPodClient().DescribeRecordList(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDnsPodClient().DescribeRecordListWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -22,8 +22,10 @@ func (me *DomainService) DescribeDomainNameList(ctx context.Context, request *do
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDomainClient().DescribeDomainNameList(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDomainClient().DescribeDomainNameListWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -98,7 +98,9 @@ func (me *DtsService) DescribeDtsSyncJobsByFilter(ctx context.Context, param map
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -106,8 +108,10 @@ func (me *DtsService) DescribeDtsSyncJobsByFilter(ctx context.Context, param map
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseDtsClient().DescribeSyncJobs(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseDtsClient().DescribeSyncJobsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -142,8 +146,10 @@ func (me *DtsService) IsolateDtsSyncJobById(ctx context.Context, jobId string) (
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDtsClient().IsolateSyncJob(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDtsClient().IsolateSyncJobWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -167,8 +173,10 @@ func (me *DtsService) DestroyDtsSyncJobById(ctx context.Context, jobId string) (
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDtsClient().DestroySyncJob(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDtsClient().DestroySyncJobWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -202,10 +210,12 @@ func (me *DtsService) DeleteDtsSyncJobById(ctx context.Context, jobId string) (e
 	//      return err
 	// }
 
-	ratelimit.Check(request.GetAction())
-	err = resource.Retry(3*writeRetryTimeout, func() *resource.RetryError {
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	err = retryContext(ctx, 3*writeRetryTimeout, func() *resource.RetryError {
 		request.JobId = helper.String(jobId)
-		_, err := me.client.UseDtsClient().DestroySyncJob(request)
+		_, err := me.client.UseDtsClient().DestroySyncJobWithContext(ctx, request)
 		if err != nil {
 			time.Sleep(10 * time.Second)
 			return resource.RetryableError(fmt.Errorf("destroy failed, retry... %v", err))
@@ -227,29 +237,25 @@ func (me *DtsService) DeleteDtsSyncJobById(ctx context.Context, jobId string) (e
 }
 
 func (me *DtsService) PollingSyncJobStatusUntil(ctx context.Context, jobId string, targetStatus string) error {
-	logId := getLogId(ctx)
-
-	err := resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
-		ret, err := me.DescribeDtsSyncJob(ctx, helper.String(jobId))
-		if err != nil {
-			return retryError(err)
-		}
-
-		if ret != nil && ret.Status != nil {
-			status := *ret.Status
-			if strings.Contains(targetStatus, status) {
-				return nil
+	waiter := &helper.StateWaiter{
+		Name:   fmt.Sprintf("DTS sync job[%s]", jobId),
+		Target: strings.Split(targetStatus, ","),
+		Refresh: func() (interface{}, string, error) {
+			ret, err := me.DescribeDtsSyncJob(ctx, helper.String(jobId))
+			if err != nil {
+				return nil, "", err
 			}
-			return resource.RetryableError(fmt.Errorf("DTS sync job[%s] status is still on [%s], retry...", jobId, status))
-		}
-
-		log.Printf("[DEBUG]%s sync job[%s] doesn't exist, exit retry...\n", logId, jobId)
-		return nil
-	})
-	if err != nil {
-		return err
+			if ret == nil || ret.Status == nil {
+				return nil, "", nil
+			}
+			return ret, *ret.Status, nil
+		},
+		NotFound:  helper.NotFoundTarget,
+		Retryable: retryableErrorFunc(ctx),
+		Timeout:   3 * readRetryTimeout,
 	}
-	return nil
+	_, err := waiter.WaitForStateContext(ctx)
+	return err
 }
 
 // compare task
@@ -271,7 +277,9 @@ func (me *DtsService) DescribeDtsCompareTasksByFilter(ctx context.Context, param
 			request.JobId = v.(*string)
 		}
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -279,8 +287,10 @@ func (me *DtsService) DescribeDtsCompareTasksByFilter(ctx context.Context, param
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseDtsClient().DescribeCompareTasks(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseDtsClient().DescribeCompareTasksWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -349,8 +359,10 @@ func (me *DtsService) StopDtsCompareById(ctx context.Context, jobId, compareTask
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDtsClient().StopCompare(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDtsClient().StopCompareWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -391,8 +403,10 @@ func (me *DtsService) DeleteDtsCompareTaskById(ctx context.Context, jobId, compa
 	request.JobId = helper.String(jobId)
 	request.CompareTaskId = helper.String(compareTaskId)
 
-	ratelimit.Check(request.GetAction())
-	response, err = me.client.UseDtsClient().DeleteCompareTask(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err = me.client.UseDtsClient().DeleteCompareTaskWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -405,29 +419,25 @@ func (me *DtsService) DeleteDtsCompareTaskById(ctx context.Context, jobId, compa
 }
 
 func (me *DtsService) PollingCompareTaskStatusUntil(ctx context.Context, jobId, compareTaskId, targetStatus string) error {
-	logId := getLogId(ctx)
-
-	err := resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
-		ret, err := me.DescribeDtsCompareTask(ctx, helper.String(jobId), helper.String(compareTaskId))
-		if err != nil {
-			return retryError(err)
-		}
-
-		if ret != nil && ret[0].Status != nil {
-			status := *ret[0].Status
-			if strings.Contains(targetStatus, status) {
-				return nil
+	waiter := &helper.StateWaiter{
+		Name:   fmt.Sprintf("DTS compare task [%s,%s]", jobId, compareTaskId),
+		Target: strings.Split(targetStatus, ","),
+		Refresh: func() (interface{}, string, error) {
+			ret, err := me.DescribeDtsCompareTask(ctx, helper.String(jobId), helper.String(compareTaskId))
+			if err != nil {
+				return nil, "", err
 			}
-			return resource.RetryableError(fmt.Errorf("DTS compare task [%s,%s] status is still on [%s], retry...", jobId, compareTaskId, status))
-		}
-
-		log.Printf("[DEBUG]%s compare task[%s,%s] doesn't exist, exit retry...\n", logId, jobId, compareTaskId)
-		return nil
-	})
-	if err != nil {
-		return err
+			if len(ret) == 0 || ret[0].Status == nil {
+				return nil, "", nil
+			}
+			return ret[0], *ret[0].Status, nil
+		},
+		NotFound:  helper.NotFoundTarget,
+		Retryable: retryableErrorFunc(ctx),
+		Timeout:   3 * readRetryTimeout,
 	}
-	return nil
+	_, err := waiter.WaitForStateContext(ctx)
+	return err
 }
 
 // migration job
@@ -502,7 +512,9 @@ func (me *DtsService) DescribeDtsMigrateJobsByFilter(ctx context.Context, param
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -510,8 +522,10 @@ func (me *DtsService) DescribeDtsMigrateJobsByFilter(ctx context.Context, param
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseDtsClient().DescribeMigrationJobs(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseDtsClient().DescribeMigrationJobsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -547,7 +561,7 @@ func (me *DtsService) DescribeDtsMigrateJobById(ctx context.Context, jobId strin
 	}()
 	request.JobId = helper.String(jobId)
 
-	response, err := me.client.UseDtsClient().DescribeMigrationDetail(request)
+	response, err := me.client.UseDtsClient().DescribeMigrationDetailWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -573,8 +587,10 @@ func (me *DtsService) IsolateDtsMigrateJobById(ctx context.Context, jobId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseDtsClient().IsolateMigrateJob(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseDtsClient().IsolateMigrateJobWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -610,10 +626,12 @@ func (me *DtsService) DeleteDtsMigrateServiceById(ctx context.Context, jobId str
 		return err
 	}
 
-	ratelimit.Check(request.GetAction())
-	err = resource.Retry(3*writeRetryTimeout, func() *resource.RetryError {
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	err = retryContext(ctx, 3*writeRetryTimeout, func() *resource.RetryError {
 		request.JobId = helper.String(jobId)
-		_, err := me.client.UseDtsClient().DestroyMigrateJob(request)
+		_, err := me.client.UseDtsClient().DestroyMigrateJobWithContext(ctx, request)
 		if err != nil {
 			time.Sleep(10 * time.Second)
 			return resource.RetryableError(fmt.Errorf("destroy failed, retry... %v", err))
@@ -636,44 +654,28 @@ func (me *DtsService) DeleteDtsMigrateServiceById(ctx context.Context, jobId str
 }
 
 func (me *DtsService) PollingMigrateJobStatusUntil(ctx context.Context, jobId, statusType string, targetStatus []string) error {
-	logId := getLogId(ctx)
-
-	err := resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
-		ret, err := me.DescribeDtsMigrateJobById(ctx, jobId)
-		if err != nil {
-			return retryError(err)
-		}
-
-		if statusType == DTSJobStatus {
-			if ret != nil && ret.Status != nil {
-				status := *ret.Status
-				for _, target := range targetStatus {
-					if strings.Contains(target, status) {
-						return nil
-					}
-				}
-				return resource.RetryableError(fmt.Errorf("DTS migrate job[%s] Status is still on [%s], retry...", jobId, status))
+	waiter := &helper.StateWaiter{
+		Name:   fmt.Sprintf("DTS migrate job[%s] %s", jobId, statusType),
+		Target: targetStatus,
+		Refresh: func() (interface{}, string, error) {
+			ret, err := me.DescribeDtsMigrateJobById(ctx, jobId)
+			if err != nil {
+				return nil, "", err
 			}
-		}
-		if statusType == DTSTradeStatus {
-			if ret != nil && ret.TradeInfo.TradeStatus != nil {
-				status := *ret.TradeInfo.TradeStatus
-				for _, target := range targetStatus {
-					if strings.Contains(target, status) {
-						return nil
-					}
-				}
-				return resource.RetryableError(fmt.Errorf("DTS migrate job[%s] TradeStatus is still on [%s], retry...", jobId, status))
+			if ret != nil && statusType == DTSJobStatus && ret.Status != nil {
+				return ret, *ret.Status, nil
 			}
-		}
-
-		log.Printf("[DEBUG]%s migrate job[%s] doesn't exist, exit retry...\n", logId, jobId)
-		return nil
-	})
-	if err != nil {
-		return err
+			if ret != nil && statusType == DTSTradeStatus && ret.TradeInfo.TradeStatus != nil {
+				return ret, *ret.TradeInfo.TradeStatus, nil
+			}
+			return nil, "", nil
+		},
+		NotFound:  helper.NotFoundTarget,
+		Retryable: retryableErrorFunc(ctx),
+		Timeout:   3 * readRetryTimeout,
 	}
-	return nil
+	_, err := waiter.WaitForStateContext(ctx)
+	return err
 }
 
 func (me *DtsService) DescribeDtsMigrateServiceById(ctx context.Context, jobId string) (migrateService *dts.DescribeMigrationDetailResponseParams, errRet error) {
@@ -688,9 +690,11 @@ func (me *DtsService) DescribeDtsMigrateServiceById(ctx context.Context, jobId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDtsClient().DescribeMigrationDetail(request)
+	response, err := me.client.UseDtsClient().DescribeMigrationDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -715,9 +719,11 @@ func (me *DtsService) DescribeDtsMigrateCheckById(ctx context.Context, jobId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDtsClient().DescribeMigrationCheckJob(request)
+	response, err := me.client.UseDtsClient().DescribeMigrationCheckJobWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -782,9 +788,11 @@ func (me *DtsService) DescribeDtsSyncCheckJobOperationById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDtsClient().DescribeCheckSyncJobResult(request)
+	response, err := me.client.UseDtsClient().DescribeCheckSyncJobResultWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -853,9 +861,11 @@ func (me *DtsService) DescribeDtsSyncJobResumeOperationById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDtsClient().DescribeSyncJobs(request)
+	response, err := me.client.UseDtsClient().DescribeSyncJobsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -909,9 +919,11 @@ func (me *DtsService) DescribeDtsCompareTaskStopOperationById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDtsClient().DescribeCompareReport(request)
+	response, err := me.client.UseDtsClient().DescribeCompareReportWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1020,7 +1032,9 @@ func (me *DtsService) DescribeDtsMigrateDbInstancesByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1029,7 +1043,7 @@ func (me *DtsService) DescribeDtsMigrateDbInstancesByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseDtsClient().DescribeMigrateDBInstances(request)
+		response, err := me.client.UseDtsClient().DescribeMigrateDBInstancesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1062,9 +1076,11 @@ func (me *DtsService) DescribeDtsSyncConfigById(ctx context.Context, jobId strin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseDtsClient().DescribeSyncJobs(request)
+	response, err := me.client.UseDtsClient().DescribeSyncJobsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
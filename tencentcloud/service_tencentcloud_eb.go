@@ -43,7 +43,9 @@ func (me *EbService) DescribeEbSearchByFilter(ctx context.Context, param map[str
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -52,7 +54,7 @@ func (me *EbService) DescribeEbSearchByFilter(ctx context.Context, param map[str
 	for {
 		request.Page = &offset
 		request.Limit = &limit
-		response, err := me.client.UseEbClient().DescribeLogTagValue(request)
+		response, err := me.client.UseEbClient().DescribeLogTagValueWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -106,7 +108,9 @@ func (me *EbService) DescribeEbSearchLogByFilter(ctx context.Context, param map[
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -115,7 +119,7 @@ func (me *EbService) DescribeEbSearchLogByFilter(ctx context.Context, param map[
 	for {
 		request.Page = &offset
 		request.Limit = &limit
-		response, err := me.client.UseEbClient().SearchLog(request)
+		response, err := me.client.UseEbClient().SearchLogWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -148,9 +152,11 @@ func (me *EbService) DescribeEbEventBusById(ctx context.Context, eventBusId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseEbClient().GetEventBus(request)
+	response, err := me.client.UseEbClient().GetEventBusWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -177,9 +183,11 @@ func (me *EbService) DeleteEbEventBusById(ctx context.Context, eventBusId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseEbClient().DeleteEventBus(request)
+	response, err := me.client.UseEbClient().DeleteEventBusWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -202,9 +210,11 @@ func (me *EbService) DescribeEbEventTargetById(ctx context.Context, eventBusId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseEbClient().ListTargets(request)
+	response, err := me.client.UseEbClient().ListTargetsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -239,9 +249,11 @@ func (me *EbService) DeleteEbEventTargetById(ctx context.Context, eventBusId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseEbClient().DeleteTarget(request)
+	response, err := me.client.UseEbClient().DeleteTargetWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -264,9 +276,11 @@ func (me *EbService) DescribeEbEventRuleById(ctx context.Context, eventBusId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseEbClient().GetRule(request)
+	response, err := me.client.UseEbClient().GetRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -294,9 +308,11 @@ func (me *EbService) DeleteEbEventRuleById(ctx context.Context, eventBusId strin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseEbClient().DeleteRule(request)
+	response, err := me.client.UseEbClient().DeleteRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -320,9 +336,11 @@ func (me *EbService) DescribeEbEventTransformById(ctx context.Context, eventBusI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseEbClient().GetTransformation(request)
+	response, err := me.client.UseEbClient().GetTransformationWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -351,9 +369,11 @@ func (me *EbService) DeleteEbEventTransformById(ctx context.Context, eventBusId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseEbClient().DeleteTransformation(request)
+	response, err := me.client.UseEbClient().DeleteTransformationWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -387,7 +407,9 @@ func (me *EbService) DescribeEbBusByFilter(ctx context.Context, param map[string
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -396,7 +418,7 @@ func (me *EbService) DescribeEbBusByFilter(ctx context.Context, param map[string
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseEbClient().ListEventBuses(request)
+		response, err := me.client.UseEbClient().ListEventBusesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -429,9 +451,11 @@ func (me *EbService) DescribeEbEventConnectorById(ctx context.Context, connectio
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseEbClient().ListConnections(request)
+	response, err := me.client.UseEbClient().ListConnectionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -464,9 +488,11 @@ func (me *EbService) DeleteEbEventConnectorById(ctx context.Context, connectionI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseEbClient().DeleteConnection(request)
+	response, err := me.client.UseEbClient().DeleteConnectionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -500,7 +526,9 @@ func (me *EbService) DescribeEbEventRulesByFilter(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -509,7 +537,7 @@ func (me *EbService) DescribeEbEventRulesByFilter(ctx context.Context, param map
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseEbClient().ListRules(request)
+		response, err := me.client.UseEbClient().ListRulesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
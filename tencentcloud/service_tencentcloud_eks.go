@@ -121,7 +121,7 @@ func (me *EksService) DescribeEKSClusters(ctx context.Context, id string, name s
 		request.Filters = []*tke.Filter{filter}
 	}
 
-	response, err := me.client.UseTkeClient().DescribeEKSClusters(request)
+	response, err := me.client.UseTkeClient().DescribeEKSClustersWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -156,8 +156,10 @@ func (me *EksService) DescribeEksCluster(ctx context.Context, id string) (cluste
 
 	request.ClusterIds = []*string{&id}
 
-	ratelimit.Check("DescribeEksCluster")
-	response, err := me.client.UseTkeClient().DescribeEKSClusters(request)
+	if errRet = ratelimit.CheckContext(ctx, "DescribeEksCluster"); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().DescribeEKSClustersWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -189,8 +191,10 @@ func (me *EksService) DescribeEksContainerInstanceById(ctx context.Context, id s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeEKSContainerInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().DescribeEKSContainerInstancesWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -230,8 +234,10 @@ func (me *EksService) DescribeEksContainerInstancesByFilter(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeEKSContainerInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().DescribeEKSContainerInstancesWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -262,8 +268,10 @@ func (me *EksService) DescribeEKSClusterCredential(ctx context.Context, request
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeEKSClusterCredential(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().DescribeEKSClusterCredentialWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -297,9 +305,11 @@ func (me *EksService) CreateEksCluster(ctx context.Context, request *tke.CreateE
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTkeClient().CreateEKSCluster(request)
+	response, err := me.client.UseTkeClient().CreateEKSClusterWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -322,8 +332,10 @@ func (me *EksService) CreateEksContainerInstances(ctx context.Context, request *
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().CreateEKSContainerInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().CreateEKSContainerInstancesWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -350,8 +362,10 @@ func (me *EksService) UpdateEksContainerInstances(ctx context.Context, request *
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().UpdateEKSContainerInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().UpdateEKSContainerInstanceWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -374,9 +388,11 @@ func (me *EksService) UpdateEksCluster(ctx context.Context, request *tke.UpdateE
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTkeClient().UpdateEKSCluster(request)
+	response, err := me.client.UseTkeClient().UpdateEKSClusterWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -399,9 +415,11 @@ func (me *EksService) DeleteEksCluster(ctx context.Context, request *tke.DeleteE
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTkeClient().DeleteEKSCluster(request)
+	response, err := me.client.UseTkeClient().DeleteEKSClusterWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -424,8 +442,10 @@ func (me *EksService) DeleteEksContainerInstance(ctx context.Context, request *t
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DeleteEKSContainerInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().DeleteEKSContainerInstancesWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -19,8 +19,10 @@ func (me *ElasticsearchService) DescribeInstanceById(ctx context.Context, instan
 	request := es.NewDescribeInstancesRequest()
 	request.InstanceIds = []*string{&instanceId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseEsClient().DescribeInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseEsClient().DescribeInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -59,8 +61,10 @@ func (me *ElasticsearchService) DescribeInstancesByFilter(ctx context.Context, i
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseEsClient().DescribeInstances(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseEsClient().DescribeInstancesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -84,8 +88,10 @@ func (me *ElasticsearchService) DeleteInstance(ctx context.Context, instanceId s
 	request := es.NewDeleteInstanceRequest()
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseEsClient().DeleteInstance(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseEsClient().DeleteInstanceWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -117,8 +123,10 @@ func (me *ElasticsearchService) UpdateInstance(ctx context.Context, instanceId,
 	if esAcl != nil {
 		request.EsAcl = esAcl
 	}
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseEsClient().UpdateInstance(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseEsClient().UpdateInstanceWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -133,8 +141,10 @@ func (me *ElasticsearchService) UpdateInstanceVersion(ctx context.Context, insta
 	request.InstanceId = &instanceId
 	request.EsVersion = &version
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseEsClient().UpgradeInstance(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseEsClient().UpgradeInstanceWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -149,8 +159,10 @@ func (me *ElasticsearchService) UpdateInstanceLicense(ctx context.Context, insta
 	request.InstanceId = &instanceId
 	request.LicenseType = &licenseType
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseEsClient().UpgradeLicense(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	_, err := me.client.UseEsClient().UpgradeLicenseWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -21,8 +21,10 @@ type EMRService struct {
 
 func (me *EMRService) UpdateInstance(ctx context.Context, request *emr.ScaleOutInstanceRequest) (id string, err error) {
 	logId := getLogId(ctx)
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseEmrClient().ScaleOutInstance(request)
+	if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return
+	}
+	response, err := me.client.UseEmrClient().ScaleOutInstanceWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -38,9 +40,11 @@ func (me *EMRService) DeleteInstance(ctx context.Context, d *schema.ResourceData
 	if v, ok := d.GetOk("instance_id"); ok {
 		request.InstanceId = common.StringPtr(v.(string))
 	}
-	ratelimit.Check(request.GetAction())
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
 	//API: https://cloud.tencent.com/document/api/589/34261
-	_, err := me.client.UseEmrClient().TerminateInstance(request)
+	_, err := me.client.UseEmrClient().TerminateInstanceWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -177,9 +181,11 @@ func (me *EMRService) CreateInstance(ctx context.Context, d *schema.ResourceData
 		request.Tags = emrTags
 	}
 
-	ratelimit.Check(request.GetAction())
+	if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return
+	}
 	//API: https://cloud.tencent.com/document/api/589/34261
-	response, err := me.client.UseEmrClient().CreateInstance(request)
+	response, err := me.client.UseEmrClient().CreateInstanceWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -193,7 +199,9 @@ func (me *EMRService) DescribeInstances(ctx context.Context, filters map[string]
 	logId := getLogId(ctx)
 	request := emr.NewDescribeInstancesRequest()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 	// API: https://cloud.tencent.com/document/api/589/41707
 	if v, ok := filters["instance_ids"]; ok {
 		instances := v.([]interface{})
@@ -208,7 +216,7 @@ func (me *EMRService) DescribeInstances(ctx context.Context, filters map[string]
 	if v, ok := filters["project_id"]; ok {
 		request.ProjectId = common.Int64Ptr(v.(int64))
 	}
-	response, err := me.client.UseEmrClient().DescribeInstances(request)
+	response, err := me.client.UseEmrClient().DescribeInstancesWithContext(ctx, request)
 
 	if err != nil {
 		if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -232,14 +240,16 @@ func (me *EMRService) DescribeInstancesById(ctx context.Context, instanceId stri
 	logId := getLogId(ctx)
 	request := emr.NewDescribeInstancesRequest()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 	// API: https://cloud.tencent.com/document/api/589/41707
 	request.ProjectId = helper.IntInt64(-1)
 	request.InstanceIds = make([]*string, 0)
 	request.InstanceIds = append(request.InstanceIds, common.StringPtr(instanceId))
 	request.DisplayStrategy = common.StringPtr(displayStrategy)
 
-	response, err := me.client.UseEmrClient().DescribeInstances(request)
+	response, err := me.client.UseEmrClient().DescribeInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -257,14 +267,16 @@ func (me *EMRService) DescribeClusterNodes(ctx context.Context, instanceId, node
 	logId := getLogId(ctx)
 	request := emr.NewDescribeClusterNodesRequest()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 	// API: https://cloud.tencent.com/document/api/589/41707
 	request.InstanceId = &instanceId
 	request.NodeFlag = &nodeFlag
 	request.HardwareResourceType = &hardwareResourceType
 	request.Limit = helper.IntInt64(limit)
 	request.Offset = helper.IntInt64(offset)
-	response, err := me.client.UseEmrClient().DescribeClusterNodes(request)
+	response, err := me.client.UseEmrClient().DescribeClusterNodesWithContext(ctx, request)
 
 	if err != nil {
 		if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -335,11 +347,13 @@ func (me *EMRService) ModifyResourcesTags(ctx context.Context, region string, in
 	}
 
 	request := emr.NewModifyResourcesTagsRequest()
-	ratelimit.Check(request.GetAction())
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
 	request.ModifyType = helper.String("Cluster")
 	request.ModifyResourceTagsInfoList = []*emr.ModifyResourceTags{modifyResourceTags}
 
-	response, err := me.client.UseEmrClient().ModifyResourcesTags(request)
+	response, err := me.client.UseEmrClient().ModifyResourcesTagsWithContext(ctx, request)
 	if err != nil {
 		return err
 	}
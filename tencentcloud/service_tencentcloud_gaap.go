@@ -53,10 +53,12 @@ func (me *GaapService) CreateRealserver(ctx context.Context, address, name strin
 	request.RealServerIP = []*string{&address}
 	request.ProjectId = helper.IntUint64(projectId)
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := me.client.UseGaapClient().AddRealServers(request)
+		response, err := me.client.UseGaapClient().AddRealServersWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -121,10 +123,12 @@ func (me *GaapService) DescribeRealservers(ctx context.Context, address, name *s
 	for count == 50 {
 		request.Offset = helper.IntUint64(offset)
 
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := me.client.UseGaapClient().DescribeRealServers(request)
+			response, err := me.client.UseGaapClient().DescribeRealServersWithContext(ctx, request)
 			if err != nil {
 				count = 0
 
@@ -155,10 +159,12 @@ func (me *GaapService) ModifyRealserverName(ctx context.Context, id, name string
 	request.RealServerId = &id
 	request.RealServerName = &name
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := me.client.UseGaapClient().ModifyRealServerName(request); err != nil {
+		if _, err := me.client.UseGaapClient().ModifyRealServerNameWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -177,10 +183,12 @@ func (me *GaapService) DeleteRealserver(ctx context.Context, id string) error {
 	request := gaap.NewRemoveRealServersRequest()
 	request.RealServerIds = []*string{&id}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := me.client.UseGaapClient().RemoveRealServers(request); err != nil {
+		if _, err := me.client.UseGaapClient().RemoveRealServersWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -203,10 +211,12 @@ func (me *GaapService) createCertificate(ctx context.Context, certificateType in
 	request.CertificateAlias = &name
 	request.CertificateKey = key
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := me.client.UseGaapClient().CreateCertificate(request)
+		response, err := me.client.UseGaapClient().CreateCertificateWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -235,10 +245,12 @@ func (me *GaapService) DescribeCertificateById(ctx context.Context, id string) (
 	request := gaap.NewDescribeCertificateDetailRequest()
 	request.CertificateId = &id
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := me.client.UseGaapClient().DescribeCertificateDetail(request)
+		response, err := me.client.UseGaapClient().DescribeCertificateDetailWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Message == "CertificateId not found" {
@@ -268,10 +280,12 @@ func (me *GaapService) ModifyCertificateName(ctx context.Context, id, name strin
 	request.CertificateId = &id
 	request.CertificateAlias = &name
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := me.client.UseGaapClient().ModifyCertificateAttributes(request); err != nil {
+		if _, err := me.client.UseGaapClient().ModifyCertificateAttributesWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -291,10 +305,12 @@ func (me *GaapService) DeleteCertificate(ctx context.Context, id string) error {
 	request := gaap.NewDeleteCertificateRequest()
 	request.CertificateId = &id
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := me.client.UseGaapClient().DeleteCertificate(request); err != nil {
+		if _, err := me.client.UseGaapClient().DeleteCertificateWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -335,11 +351,13 @@ func (me *GaapService) CreateProxy(
 		createRequest.NetworkType = helper.String(v.(string))
 	}
 
-	if err := resource.Retry(2*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(createRequest.GetAction())
+	if err := retryContext(ctx, 2*writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, createRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		createRequest.ClientToken = helper.String(helper.BuildToken())
 
-		response, err := client.CreateProxy(createRequest)
+		response, err := client.CreateProxyWithContext(ctx, createRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, createRequest.GetAction(), createRequest.ToJsonString(), err)
@@ -362,10 +380,12 @@ func (me *GaapService) CreateProxy(
 	describeRequest := gaap.NewDescribeProxiesRequest()
 	describeRequest.ProxyIds = []*string{&id}
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, describeRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.DescribeProxies(describeRequest)
+		response, err := client.DescribeProxiesWithContext(ctx, describeRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
@@ -417,11 +437,13 @@ func (me *GaapService) EnableProxy(ctx context.Context, id string) error {
 	enableRequest := gaap.NewOpenProxiesRequest()
 	enableRequest.ProxyIds = []*string{&id}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(enableRequest.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, enableRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		enableRequest.ClientToken = helper.String(helper.BuildToken())
 
-		response, err := client.OpenProxies(enableRequest)
+		response, err := client.OpenProxiesWithContext(ctx, enableRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, enableRequest.GetAction(), enableRequest.ToJsonString(), err)
@@ -449,10 +471,12 @@ func (me *GaapService) EnableProxy(ctx context.Context, id string) error {
 	describeRequest := gaap.NewDescribeProxiesRequest()
 	describeRequest.ProxyIds = []*string{&id}
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, describeRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.DescribeProxies(describeRequest)
+		response, err := client.DescribeProxiesWithContext(ctx, describeRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
@@ -496,11 +520,13 @@ func (me *GaapService) DisableProxy(ctx context.Context, id string) error {
 	disableRequest := gaap.NewCloseProxiesRequest()
 	disableRequest.ProxyIds = []*string{&id}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(disableRequest.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, disableRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		disableRequest.ClientToken = helper.String(helper.BuildToken())
 
-		response, err := client.CloseProxies(disableRequest)
+		response, err := client.CloseProxiesWithContext(ctx, disableRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, disableRequest.GetAction(), disableRequest.ToJsonString(), err)
@@ -528,10 +554,12 @@ func (me *GaapService) DisableProxy(ctx context.Context, id string) error {
 	describeRequest := gaap.NewDescribeProxiesRequest()
 	describeRequest.ProxyIds = []*string{&id}
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, describeRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.DescribeProxies(describeRequest)
+		response, err := client.DescribeProxiesWithContext(ctx, describeRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
@@ -614,10 +642,12 @@ func (me *GaapService) DescribeProxies(
 	for count == 100 {
 		request.Offset = helper.IntUint64(offset)
 
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := me.client.UseGaapClient().DescribeProxies(request)
+			response, err := me.client.UseGaapClient().DescribeProxiesWithContext(ctx, request)
 			if err != nil {
 				count = 0
 
@@ -654,11 +684,13 @@ func (me *GaapService) ModifyProxyName(ctx context.Context, id, name string) err
 	request.ProxyIds = []*string{&id}
 	request.ProxyName = &name
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		request.ClientToken = helper.String(helper.BuildToken())
 
-		if _, err := me.client.UseGaapClient().ModifyProxiesAttribute(request); err != nil {
+		if _, err := me.client.UseGaapClient().ModifyProxiesAttributeWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -679,11 +711,13 @@ func (me *GaapService) ModifyProxyProjectId(ctx context.Context, id string, proj
 	request.ProxyIds = []*string{&id}
 	request.ProjectId = helper.IntInt64(projectId)
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		request.ClientToken = helper.String(helper.BuildToken())
 
-		if _, err := me.client.UseGaapClient().ModifyProxiesProject(request); err != nil {
+		if _, err := me.client.UseGaapClient().ModifyProxiesProjectWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -710,11 +744,13 @@ func (me *GaapService) ModifyProxyConfiguration(ctx context.Context, id string,
 		modifyRequest.Concurrent = helper.IntUint64(*concurrent)
 	}
 
-	if err := resource.Retry(2*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(modifyRequest.GetAction())
+	if err := retryContext(ctx, 2*writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, modifyRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		modifyRequest.ClientToken = helper.String(helper.BuildToken())
 
-		if _, err := client.ModifyProxyConfiguration(modifyRequest); err != nil {
+		if _, err := client.ModifyProxyConfigurationWithContext(ctx, modifyRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, modifyRequest.GetAction(), modifyRequest.ToJsonString(), err)
 			return retryError(err)
@@ -728,10 +764,12 @@ func (me *GaapService) ModifyProxyConfiguration(ctx context.Context, id string,
 	describeRequest := gaap.NewDescribeProxiesRequest()
 	describeRequest.ProxyIds = []*string{&id}
 
-	if err := resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+	if err := retryContext(ctx, 3*readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, describeRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.DescribeProxies(describeRequest)
+		response, err := client.DescribeProxiesWithContext(ctx, describeRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
@@ -776,11 +814,13 @@ func (me *GaapService) DeleteProxy(ctx context.Context, id string) error {
 	deleteRequest.ProxyIds = []*string{&id}
 	deleteRequest.Force = helper.IntInt64(1)
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, deleteRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		deleteRequest.ClientToken = helper.String(helper.BuildToken())
 
-		response, err := client.DestroyProxies(deleteRequest)
+		response, err := client.DestroyProxiesWithContext(ctx, deleteRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
@@ -808,10 +848,12 @@ func (me *GaapService) DeleteProxy(ctx context.Context, id string) error {
 	describeRequest := gaap.NewDescribeProxiesRequest()
 	describeRequest.ProxyIds = []*string{&id}
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, describeRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.DescribeProxies(describeRequest)
+		response, err := client.DescribeProxiesWithContext(ctx, describeRequest)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceNotFound" {
@@ -863,10 +905,12 @@ func (me *GaapService) CreateTCPListener(
 	request.ConnectTimeout = helper.IntUint64(connectTimeout)
 	request.ClientIPMethod = helper.IntInt64(clientIPMethod)
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.CreateTCPListeners(request)
+		response, err := client.CreateTCPListenersWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -909,10 +953,12 @@ func (me *GaapService) CreateUDPListener(
 	request.ProxyId = &proxyId
 	request.Ports = []*uint64{helper.IntUint64(port)}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.CreateUDPListeners(request)
+		response, err := client.CreateUDPListenersWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -956,10 +1002,12 @@ func (me *GaapService) BindLayer4ListenerRealservers(ctx context.Context, id, pr
 		})
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.BindListenerRealServers(request); err != nil {
+		if _, err := client.BindListenerRealServersWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -1006,10 +1054,12 @@ func (me *GaapService) DescribeTCPListeners(ctx context.Context, proxyId, listen
 	for count == 50 {
 		request.Offset = helper.IntUint64(offset)
 
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := me.client.UseGaapClient().DescribeTCPListeners(request)
+			response, err := me.client.UseGaapClient().DescribeTCPListenersWithContext(ctx, request)
 			if err != nil {
 				count = 0
 
@@ -1067,10 +1117,12 @@ func (me *GaapService) DescribeUDPListeners(ctx context.Context, proxyId, id, na
 	for count == 50 {
 		request.Offset = helper.IntUint64(offset)
 
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := me.client.UseGaapClient().DescribeUDPListeners(request)
+			response, err := me.client.UseGaapClient().DescribeUDPListenersWithContext(ctx, request)
 			if err != nil {
 				count = 0
 
@@ -1125,10 +1177,12 @@ func (me *GaapService) ModifyTCPListenerAttribute(
 	request.DelayLoop = helper.IntUint64(interval)
 	request.ConnectTimeout = helper.IntUint64(connectTimeout)
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.ModifyTCPListenerAttribute(request); err != nil {
+		if _, err := client.ModifyTCPListenerAttributeWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -1161,10 +1215,12 @@ func (me *GaapService) ModifyUDPListenerAttribute(
 	request.ListenerName = name
 	request.Scheduler = scheduler
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.ModifyUDPListenerAttribute(request); err != nil {
+		if _, err := client.ModifyUDPListenerAttributeWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -1192,10 +1248,12 @@ func (me *GaapService) DeleteLayer4Listener(ctx context.Context, id, proxyId, pr
 	deleteRequest.ListenerIds = []*string{&id}
 	deleteRequest.Force = helper.IntUint64(0)
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, deleteRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.DeleteListeners(deleteRequest)
+		response, err := client.DeleteListenersWithContext(ctx, deleteRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
@@ -1232,10 +1290,12 @@ func (me *GaapService) DeleteLayer4Listener(ctx context.Context, id, proxyId, pr
 		describeRequest := gaap.NewDescribeTCPListenersRequest()
 		describeRequest.ListenerId = &id
 
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(describeRequest.GetAction())
+		if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, describeRequest.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := client.DescribeTCPListeners(describeRequest)
+			response, err := client.DescribeTCPListenersWithContext(ctx, describeRequest)
 			if err != nil {
 				if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 					if sdkError.Code == GAAPResourceNotFound || (sdkError.Code == "InvalidParameter" && sdkError.Message == fmt.Sprintf("ListenerId(%s) Not Exist.", id)) {
@@ -1264,10 +1324,12 @@ func (me *GaapService) DeleteLayer4Listener(ctx context.Context, id, proxyId, pr
 		describeRequest := gaap.NewDescribeUDPListenersRequest()
 		describeRequest.ListenerId = &id
 
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(describeRequest.GetAction())
+		if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, describeRequest.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := client.DescribeUDPListeners(describeRequest)
+			response, err := client.DescribeUDPListenersWithContext(ctx, describeRequest)
 			if err != nil {
 				if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 					if sdkError.Code == GAAPResourceNotFound || (sdkError.Code == "InvalidParameter" && sdkError.Message == fmt.Sprintf("ListenerId(%s) Not Exist.", id)) {
@@ -1303,10 +1365,12 @@ func (me *GaapService) CreateSecurityPolicy(ctx context.Context, proxyId, action
 	request.ProxyId = &proxyId
 	request.DefaultAction = &action
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := me.client.UseGaapClient().CreateSecurityPolicy(request)
+		response, err := me.client.UseGaapClient().CreateSecurityPolicyWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -1336,10 +1400,12 @@ func (me *GaapService) EnableSecurityPolicy(ctx context.Context, proxyId, policy
 	enableRequest := gaap.NewOpenSecurityPolicyRequest()
 	enableRequest.ProxyId = &proxyId
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(enableRequest.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, enableRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.OpenSecurityPolicy(enableRequest); err != nil {
+		if _, err := client.OpenSecurityPolicyWithContext(ctx, enableRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, enableRequest.GetAction(), enableRequest.ToJsonString(), err)
 			return retryError(err)
@@ -1353,10 +1419,12 @@ func (me *GaapService) EnableSecurityPolicy(ctx context.Context, proxyId, policy
 	describeRequest := gaap.NewDescribeSecurityPolicyDetailRequest()
 	describeRequest.PolicyId = &policyId
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, describeRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.DescribeSecurityPolicyDetail(describeRequest)
+		response, err := client.DescribeSecurityPolicyDetailWithContext(ctx, describeRequest)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceNotFound" || (sdkError.Code == "InvalidParameter" && strings.Contains(sdkError.Message, "PolicyId")) {
@@ -1396,10 +1464,12 @@ func (me *GaapService) DisableSecurityPolicy(ctx context.Context, proxyId, polic
 	disableRequest := gaap.NewCloseSecurityPolicyRequest()
 	disableRequest.ProxyId = &proxyId
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(disableRequest.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, disableRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.CloseSecurityPolicy(disableRequest); err != nil {
+		if _, err := client.CloseSecurityPolicyWithContext(ctx, disableRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, disableRequest.GetAction(), disableRequest.ToJsonString(), err)
 			return retryError(err)
@@ -1413,10 +1483,12 @@ func (me *GaapService) DisableSecurityPolicy(ctx context.Context, proxyId, polic
 	describeRequest := gaap.NewDescribeSecurityPolicyDetailRequest()
 	describeRequest.PolicyId = &policyId
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, describeRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.DescribeSecurityPolicyDetail(describeRequest)
+		response, err := client.DescribeSecurityPolicyDetailWithContext(ctx, describeRequest)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceNotFound" || (sdkError.Code == "InvalidParameter" && strings.Contains(sdkError.Message, "PolicyId")) {
@@ -1455,10 +1527,12 @@ func (me *GaapService) DescribeSecurityPolicy(ctx context.Context, id string) (p
 	request := gaap.NewDescribeSecurityPolicyDetailRequest()
 	request.PolicyId = &id
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := me.client.UseGaapClient().DescribeSecurityPolicyDetail(request)
+		response, err := me.client.UseGaapClient().DescribeSecurityPolicyDetailWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceNotFound" || (sdkError.Code == "InvalidParameter" && strings.Contains(sdkError.Message, "PolicyId")) {
@@ -1510,10 +1584,12 @@ func (me *GaapService) DeleteSecurityPolicy(ctx context.Context, id string) erro
 	deleteRequest := gaap.NewDeleteSecurityPolicyRequest()
 	deleteRequest.PolicyId = &id
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, deleteRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.DeleteSecurityPolicy(deleteRequest); err != nil {
+		if _, err := client.DeleteSecurityPolicyWithContext(ctx, deleteRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
 			return retryError(err)
@@ -1527,10 +1603,12 @@ func (me *GaapService) DeleteSecurityPolicy(ctx context.Context, id string) erro
 	describeRequest := gaap.NewDescribeSecurityPolicyDetailRequest()
 	describeRequest.PolicyId = &id
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, describeRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		_, err := client.DescribeSecurityPolicyDetail(describeRequest)
+		_, err := client.DescribeSecurityPolicyDetailWithContext(ctx, describeRequest)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceNotFound" || (sdkError.Code == "InvalidParameter" && strings.Contains(sdkError.Message, "PolicyId")) {
@@ -1571,10 +1649,12 @@ func (me *GaapService) CreateSecurityRule(
 		},
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := me.client.UseGaapClient().CreateSecurityRules(request)
+		response, err := me.client.UseGaapClient().CreateSecurityRulesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -1609,10 +1689,12 @@ func (me *GaapService) DescribeSecurityRule(ctx context.Context, id string) (sec
 	request := gaap.NewDescribeSecurityRulesRequest()
 	request.SecurityRuleIds = []*string{&id}
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := me.client.UseGaapClient().DescribeSecurityRules(request)
+		response, err := me.client.UseGaapClient().DescribeSecurityRulesWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceNotFound" || (sdkError.Code == "InvalidParameter" && strings.Contains(sdkError.Message, "SecurityRuleId")) {
@@ -1661,10 +1743,12 @@ func (me *GaapService) ModifySecurityRule(ctx context.Context, policyId, ruleId,
 
 	request.AliasName = &name
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := me.client.UseGaapClient().ModifySecurityRule(request); err != nil {
+		if _, err := me.client.UseGaapClient().ModifySecurityRuleWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -1685,10 +1769,12 @@ func (me *GaapService) DeleteSecurityRule(ctx context.Context, policyId, ruleId
 	request.PolicyId = &policyId
 	request.RuleIdList = []*string{&ruleId}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := me.client.UseGaapClient().DeleteSecurityRules(request); err != nil {
+		if _, err := me.client.UseGaapClient().DeleteSecurityRulesWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -1711,10 +1797,12 @@ func (me *GaapService) CreateHTTPListener(ctx context.Context, name, proxyId str
 	request.ListenerName = &name
 	request.Port = helper.IntUint64(port)
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.CreateHTTPListener(request)
+		response, err := client.CreateHTTPListenerWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -1760,10 +1848,12 @@ func (me *GaapService) CreateHTTPSListener(
 	request.AuthType = helper.IntUint64(authType)
 	request.PolyClientCertificateIds = helper.Strings(polyClientCertificateIds)
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.CreateHTTPSListener(request)
+		response, err := client.CreateHTTPSListenerWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -1823,10 +1913,12 @@ func (me *GaapService) DescribeHTTPListeners(
 	for count == 50 {
 		request.Offset = helper.IntUint64(offset)
 
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := me.client.UseGaapClient().DescribeHTTPListeners(request)
+			response, err := me.client.UseGaapClient().DescribeHTTPListenersWithContext(ctx, request)
 			if err != nil {
 				count = 0
 
@@ -1888,10 +1980,12 @@ func (me *GaapService) DescribeHTTPSListeners(
 	for count == 50 {
 		request.Offset = helper.IntUint64(offset)
 
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := me.client.UseGaapClient().DescribeHTTPSListeners(request)
+			response, err := me.client.UseGaapClient().DescribeHTTPSListenersWithContext(ctx, request)
 			if err != nil {
 				count = 0
 
@@ -1930,10 +2024,12 @@ func (me *GaapService) ModifyHTTPListener(ctx context.Context, id, proxyId, name
 	request.ListenerName = &name
 	request.ProxyId = &proxyId
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.ModifyHTTPListenerAttribute(request); err != nil {
+		if _, err := client.ModifyHTTPListenerAttributeWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -1969,10 +2065,12 @@ func (me *GaapService) ModifyHTTPSListener(
 	request.CertificateId = certificateId
 	request.PolyClientCertificateIds = helper.Strings(polyClientCertificateIds)
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.ModifyHTTPSListenerAttribute(request); err != nil {
+		if _, err := client.ModifyHTTPSListenerAttributeWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -2000,10 +2098,12 @@ func (me *GaapService) DeleteLayer7Listener(ctx context.Context, id, proxyId, pr
 	deleteRequest.ListenerIds = []*string{helper.String(id)}
 	deleteRequest.Force = helper.IntUint64(0)
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, deleteRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.DeleteListeners(deleteRequest)
+		response, err := client.DeleteListenersWithContext(ctx, deleteRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
@@ -2042,10 +2142,12 @@ func (me *GaapService) DeleteLayer7Listener(ctx context.Context, id, proxyId, pr
 		//describeRequest.ProxyId = &proxyId
 		describeRequest.ListenerId = &id
 
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(describeRequest.GetAction())
+		if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, describeRequest.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := client.DescribeHTTPListeners(describeRequest)
+			response, err := client.DescribeHTTPListenersWithContext(ctx, describeRequest)
 			if err != nil {
 				if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 					if sdkError.Code == GAAPResourceNotFound || (sdkError.Code == "InvalidParameter" && sdkError.Message == fmt.Sprintf("ListenerId(%s) Not Exist.", id)) {
@@ -2076,10 +2178,12 @@ func (me *GaapService) DeleteLayer7Listener(ctx context.Context, id, proxyId, pr
 		//describeRequest.ProxyId = &proxyId
 		describeRequest.ListenerId = &id
 
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(describeRequest.GetAction())
+		if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, describeRequest.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := client.DescribeHTTPSListeners(describeRequest)
+			response, err := client.DescribeHTTPSListenersWithContext(ctx, describeRequest)
 			if err != nil {
 				if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 					if sdkError.Code == GAAPResourceNotFound || (sdkError.Code == "InvalidParameter" && sdkError.Message == fmt.Sprintf("ListenerId(%s) Not Exist.", id)) {
@@ -2116,10 +2220,12 @@ func waitLayer4ListenerReady(ctx context.Context, client *gaap.Client, id, proto
 		request := gaap.NewDescribeTCPListenersRequest()
 		request.ListenerId = &id
 
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := client.DescribeTCPListeners(request)
+			response, err := client.DescribeTCPListenersWithContext(ctx, request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 					logId, request.GetAction(), request.ToJsonString(), err)
@@ -2160,10 +2266,12 @@ func waitLayer4ListenerReady(ctx context.Context, client *gaap.Client, id, proto
 		request := gaap.NewDescribeUDPListenersRequest()
 		request.ListenerId = &id
 
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := client.DescribeUDPListeners(request)
+			response, err := client.DescribeUDPListenersWithContext(ctx, request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 					logId, request.GetAction(), request.ToJsonString(), err)
@@ -2213,10 +2321,12 @@ func waitLayer7ListenerReady(ctx context.Context, client *gaap.Client, proxyId,
 		request.ProxyId = &proxyId
 		request.ListenerId = &id
 
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := client.DescribeHTTPListeners(request)
+			response, err := client.DescribeHTTPListenersWithContext(ctx, request)
 			if err != nil {
 				if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 					if sdkError.Code == GAAPResourceNotFound || (sdkError.Code == "InvalidParameter" && sdkError.Message == fmt.Sprintf("ListenerId(%s) Not Exist.", id)) {
@@ -2264,10 +2374,12 @@ func waitLayer7ListenerReady(ctx context.Context, client *gaap.Client, proxyId,
 		request.ProxyId = &proxyId
 		request.ListenerId = &id
 
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := client.DescribeHTTPSListeners(request)
+			response, err := client.DescribeHTTPSListenersWithContext(ctx, request)
 			if err != nil {
 				if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 					if sdkError.Code == GAAPResourceNotFound || (sdkError.Code == "InvalidParameter" && sdkError.Message == fmt.Sprintf("ListenerId(%s) Not Exist.", id)) {
@@ -2321,10 +2433,12 @@ func (me *GaapService) CreateHTTPDomain(ctx context.Context, listenerId, domain
 	createRequest.ListenerId = &listenerId
 	createRequest.Domain = &domain
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(createRequest.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, createRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.CreateDomain(createRequest); err != nil {
+		if _, err := client.CreateDomainWithContext(ctx, createRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, createRequest.GetAction(), createRequest.ToJsonString(), err)
 			return retryError(err)
@@ -2338,10 +2452,12 @@ func (me *GaapService) CreateHTTPDomain(ctx context.Context, listenerId, domain
 	describeRequest := gaap.NewDescribeRulesRequest()
 	describeRequest.ListenerId = &listenerId
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, describeRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.DescribeRules(describeRequest)
+		response, err := client.DescribeRulesWithContext(ctx, describeRequest)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceNotFound" || (sdkError.Code == "InvalidParameter" && strings.Contains(sdkError.Message, "ListenerId")) {
@@ -2393,10 +2509,12 @@ func (me *GaapService) CreateHTTPSDomain(
 		createRequest.PolyClientCertificateIds = append(createRequest.PolyClientCertificateIds, helper.String(polyId))
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(createRequest.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, createRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.CreateDomain(createRequest); err != nil {
+		if _, err := client.CreateDomainWithContext(ctx, createRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, createRequest.GetAction(), createRequest.ToJsonString(), err)
 			return retryError(err)
@@ -2410,10 +2528,12 @@ func (me *GaapService) CreateHTTPSDomain(
 	describeRequest := gaap.NewDescribeRulesRequest()
 	describeRequest.ListenerId = &listenerId
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, describeRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.DescribeRules(describeRequest)
+		response, err := client.DescribeRulesWithContext(ctx, describeRequest)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceNotFound" || (sdkError.Code == "InvalidParameter" && strings.Contains(sdkError.Message, "ListenerId")) {
@@ -2489,10 +2609,12 @@ func (me *GaapService) SetAdvancedAuth(
 
 	request.GaapCertificateId = gaapAuthId
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := me.client.UseGaapClient().SetAuthentication(request); err != nil {
+		if _, err := me.client.UseGaapClient().SetAuthenticationWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -2512,10 +2634,12 @@ func (me *GaapService) DescribeDomain(ctx context.Context, listenerId, domain st
 	request := gaap.NewDescribeRulesRequest()
 	request.ListenerId = &listenerId
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := me.client.UseGaapClient().DescribeRules(request)
+		response, err := me.client.UseGaapClient().DescribeRulesWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceNotFound" || (sdkError.Code == "InvalidParameter" && strings.Contains(sdkError.Message, "ListenerId")) {
@@ -2565,10 +2689,12 @@ func (me *GaapService) ModifyDomainCertificate(
 		request.PolyClientCertificateIds = append(request.PolyClientCertificateIds, helper.String(polyId))
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := me.client.UseGaapClient().ModifyCertificate(request); err != nil {
+		if _, err := me.client.UseGaapClient().ModifyCertificateWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -2591,10 +2717,12 @@ func (me *GaapService) DeleteDomain(ctx context.Context, listenerId, domain stri
 	deleteRequest.Domain = &domain
 	deleteRequest.Force = helper.IntUint64(0)
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, deleteRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.DeleteDomain(deleteRequest); err != nil {
+		if _, err := client.DeleteDomainWithContext(ctx, deleteRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
 			return retryError(err)
@@ -2608,10 +2736,12 @@ func (me *GaapService) DeleteDomain(ctx context.Context, listenerId, domain stri
 	describeRequest := gaap.NewDescribeRulesRequest()
 	describeRequest.ListenerId = &listenerId
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, describeRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.DescribeRules(describeRequest)
+		response, err := client.DescribeRulesWithContext(ctx, describeRequest)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceNotFound" || (sdkError.Code == "InvalidParameter" && strings.Contains(sdkError.Message, "ListenerId")) {
@@ -2681,10 +2811,12 @@ func (me *GaapService) CreateHttpRule(ctx context.Context, httpRule gaapHttpRule
 		request.ServerNameIndication = &httpRule.serverNameIndication
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.CreateRule(request)
+		response, err := client.CreateRuleWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -2729,10 +2861,12 @@ func (me *GaapService) BindHttpRuleRealservers(ctx context.Context, listenerId,
 		})
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.BindRuleRealServers(request); err != nil {
+		if _, err := client.BindRuleRealServersWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -2757,10 +2891,12 @@ func (me *GaapService) DescribeHttpRule(ctx context.Context, id string) (rule *g
 	request := gaap.NewDescribeRulesByRuleIdsRequest()
 	request.RuleIds = []*string{&id}
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := me.client.UseGaapClient().DescribeRulesByRuleIds(request)
+		response, err := me.client.UseGaapClient().DescribeRulesByRuleIdsWithContext(ctx, request)
 		if err != nil {
 			if sdkErr, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkErr.Code == GAAPResourceNotFound || (sdkErr.Code == "InvalidParameter" && strings.Contains(sdkErr.Message, "ruleId")) {
@@ -2823,10 +2959,12 @@ func (me *GaapService) ModifyHTTPRuleAttribute(
 		request.CheckParams.StatusCode = append(request.CheckParams.StatusCode, helper.IntUint64(code))
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.ModifyRuleAttribute(request); err != nil {
+		if _, err := client.ModifyRuleAttributeWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -2849,10 +2987,12 @@ func (me *GaapService) DeleteHttpRule(ctx context.Context, listenerId, ruleId st
 	deleteRequest.RuleId = &ruleId
 	deleteRequest.Force = helper.IntUint64(1)
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, deleteRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.DeleteRule(deleteRequest); err != nil {
+		if _, err := client.DeleteRuleWithContext(ctx, deleteRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
 			return retryError(err)
@@ -2866,10 +3006,12 @@ func (me *GaapService) DeleteHttpRule(ctx context.Context, listenerId, ruleId st
 	describeRequest := gaap.NewDescribeRulesRequest()
 	describeRequest.ListenerId = &listenerId
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, describeRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.DescribeRules(describeRequest)
+		response, err := client.DescribeRulesWithContext(ctx, describeRequest)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceNotFound" || (sdkError.Code == "InvalidParameter" && strings.Contains(sdkError.Message, "ListenerId")) {
@@ -2912,10 +3054,12 @@ func waitHttpRuleReady(ctx context.Context, client *gaap.Client, listenerId, rul
 	request := gaap.NewDescribeRulesRequest()
 	request.ListenerId = &listenerId
 
-	return resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	return retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.DescribeRules(request)
+		response, err := client.DescribeRulesWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceNotFound" || (sdkError.Code == "InvalidParameter" && strings.Contains(sdkError.Message, "ListenerId")) {
@@ -2964,10 +3108,12 @@ func (me *GaapService) DescribeDomains(ctx context.Context, listenerId, domain s
 	request := gaap.NewDescribeRulesRequest()
 	request.ListenerId = &listenerId
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := me.client.UseGaapClient().DescribeRules(request)
+		response, err := me.client.UseGaapClient().DescribeRulesWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceNotFound" || (sdkError.Code == "InvalidParameter" && strings.Contains(sdkError.Message, "ListenerId")) {
@@ -3006,10 +3152,12 @@ func (me *GaapService) DescribeSecurityRules(ctx context.Context, policyId strin
 	request := gaap.NewDescribeSecurityPolicyDetailRequest()
 	request.PolicyId = &policyId
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := me.client.UseGaapClient().DescribeSecurityPolicyDetail(request)
+		response, err := me.client.UseGaapClient().DescribeSecurityPolicyDetailWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceNotFound" || (sdkError.Code == "InvalidParameter" && strings.Contains(sdkError.Message, "PolicyId")) {
@@ -3050,10 +3198,12 @@ func (me *GaapService) DescribeCertificates(ctx context.Context, id, name *strin
 	for count == 50 {
 		request.Offset = helper.IntUint64(offset)
 
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := me.client.UseGaapClient().DescribeCertificates(request)
+			response, err := me.client.UseGaapClient().DescribeCertificatesWithContext(ctx, request)
 			if err != nil {
 				count = 0
 
@@ -3122,10 +3272,12 @@ func (me *GaapService) ModifyHTTPRuleForwardHost(ctx context.Context, listenerId
 	request.RuleId = &ruleId
 	request.ForwardHost = &forwardHost
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.ModifyRuleAttribute(request); err != nil {
+		if _, err := client.ModifyRuleAttributeWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -3173,8 +3325,8 @@ func (me *GaapService) CreateDomainErrorPageInfo(ctx context.Context,
 		})
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		resp, err := client.CreateDomainErrorPageInfo(request)
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		resp, err := client.CreateDomainErrorPageInfoWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok && sdkError.Code == "FailedOperation.DomainAlreadyExisted" {
 				return resource.NonRetryableError(helper.WrapErrorf(err, "", sdkError.RequestId, sdkError.Message))
@@ -3200,8 +3352,8 @@ func (me *GaapService) DescribeDomainErrorPageInfo(ctx context.Context, listener
 	request.ListenerId = &listenerId
 	request.Domain = &domain
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		resp, err := client.DescribeDomainErrorPageInfo(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		resp, err := client.DescribeDomainErrorPageInfoWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceNotFound" || (sdkError.Code == "InvalidParameter" && strings.Contains(sdkError.Message, "ListenerId")) {
@@ -3234,8 +3386,8 @@ func (me *GaapService) DescribeDomainErrorPageInfoList(ctx context.Context, list
 	request.ListenerId = &listenerId
 	request.Domain = &domain
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		resp, err := client.DescribeDomainErrorPageInfo(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		resp, err := client.DescribeDomainErrorPageInfoWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok && sdkError.Code == "ResourceNotFound" {
 				return nil
@@ -3260,8 +3412,8 @@ func (me *GaapService) DeleteDomainErrorPageInfo(ctx context.Context, id string)
 	request := gaap.NewDeleteDomainErrorPageInfoRequest()
 	request.ErrorPageId = &id
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		if _, err := client.DeleteDomainErrorPageInfo(request); err != nil {
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if _, err := client.DeleteDomainErrorPageInfoWithContext(ctx, request); err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok && sdkError.Code == "ResourceNotFound" {
 				return nil
 			}
@@ -62,8 +62,10 @@ func (me *KmsService) DescribeKeysByFilter(ctx context.Context, param map[string
 	for {
 		request.Offset = helper.Uint64(offset)
 		request.Limit = helper.Uint64(pageSize)
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseKmsClient().ListKeyDetail(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseKmsClient().ListKeyDetailWithContext(ctx, request)
 		if err != nil {
 			errRet = errors.WithStack(err)
 			return
@@ -88,9 +90,11 @@ func (me *KmsService) DescribeKeyById(ctx context.Context, keyId string) (key *k
 	logId := getLogId(ctx)
 	request := kms.NewDescribeKeyRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseKmsClient().DescribeKey(request)
+	response, err := me.client.UseKmsClient().DescribeKeyWithContext(ctx, request)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
@@ -113,9 +117,11 @@ func (me *KmsService) CreateKey(ctx context.Context, keyType uint64, alias, desc
 	if keyUsage != "" {
 		request.KeyUsage = helper.String(keyUsage)
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseKmsClient().CreateKey(request)
+	response, err := me.client.UseKmsClient().CreateKeyWithContext(ctx, request)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
@@ -131,9 +137,11 @@ func (me *KmsService) EnableKeyRotation(ctx context.Context, keyId string) (errR
 	logId := getLogId(ctx)
 	request := kms.NewEnableKeyRotationRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseKmsClient().EnableKeyRotation(request)
+	response, err := me.client.UseKmsClient().EnableKeyRotationWithContext(ctx, request)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
@@ -148,9 +156,11 @@ func (me *KmsService) DisableKeyRotation(ctx context.Context, keyId string) (err
 	logId := getLogId(ctx)
 	request := kms.NewDisableKeyRotationRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseKmsClient().DisableKeyRotation(request)
+	response, err := me.client.UseKmsClient().DisableKeyRotationWithContext(ctx, request)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
@@ -165,9 +175,11 @@ func (me *KmsService) EnableKey(ctx context.Context, keyId string) (errRet error
 	logId := getLogId(ctx)
 	request := kms.NewEnableKeyRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseKmsClient().EnableKey(request)
+	response, err := me.client.UseKmsClient().EnableKeyWithContext(ctx, request)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
@@ -182,9 +194,11 @@ func (me *KmsService) DisableKey(ctx context.Context, keyId string) (errRet erro
 	logId := getLogId(ctx)
 	request := kms.NewDisableKeyRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseKmsClient().DisableKey(request)
+	response, err := me.client.UseKmsClient().DisableKeyWithContext(ctx, request)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
@@ -199,9 +213,11 @@ func (me *KmsService) ArchiveKey(ctx context.Context, keyId string) (errRet erro
 	logId := getLogId(ctx)
 	request := kms.NewArchiveKeyRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseKmsClient().ArchiveKey(request)
+	response, err := me.client.UseKmsClient().ArchiveKeyWithContext(ctx, request)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
@@ -216,9 +232,11 @@ func (me *KmsService) CancelKeyArchive(ctx context.Context, keyId string) (errRe
 	logId := getLogId(ctx)
 	request := kms.NewCancelKeyArchiveRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseKmsClient().CancelKeyArchive(request)
+	response, err := me.client.UseKmsClient().CancelKeyArchiveWithContext(ctx, request)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
@@ -232,9 +250,11 @@ func (me *KmsService) CancelKeyDeletion(ctx context.Context, keyId string) (errR
 	logId := getLogId(ctx)
 	request := kms.NewCancelKeyDeletionRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseKmsClient().CancelKeyDeletion(request)
+	response, err := me.client.UseKmsClient().CancelKeyDeletionWithContext(ctx, request)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
@@ -250,9 +270,11 @@ func (me *KmsService) UpdateKeyDescription(ctx context.Context, keyId, descripti
 	request := kms.NewUpdateKeyDescriptionRequest()
 	request.KeyId = helper.String(keyId)
 	request.Description = helper.String(description)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseKmsClient().UpdateKeyDescription(request)
+	response, err := me.client.UseKmsClient().UpdateKeyDescriptionWithContext(ctx, request)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
@@ -268,9 +290,11 @@ func (me *KmsService) UpdateKeyAlias(ctx context.Context, keyId, alias string) (
 	request := kms.NewUpdateAliasRequest()
 	request.KeyId = helper.String(keyId)
 	request.Alias = helper.String(alias)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseKmsClient().UpdateAlias(request)
+	response, err := me.client.UseKmsClient().UpdateAliasWithContext(ctx, request)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
@@ -286,9 +310,11 @@ func (me *KmsService) DeleteKey(ctx context.Context, keyId string, pendingDelete
 	request := kms.NewScheduleKeyDeletionRequest()
 	request.KeyId = helper.String(keyId)
 	request.PendingWindowInDays = helper.Uint64(pendingDeleteWindowInDays)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseKmsClient().ScheduleKeyDeletion(request)
+	response, err := me.client.UseKmsClient().ScheduleKeyDeletionWithContext(ctx, request)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
@@ -326,9 +352,11 @@ func (me *KmsService) ImportKeyMaterial(ctx context.Context, param map[string]in
 	paramRequest.KeyId = helper.String(keyId)
 	paramRequest.WrappingAlgorithm = helper.String(wrappingAlgorithm)
 	paramRequest.WrappingKeySpec = helper.String(wrappingKeySpec)
-	ratelimit.Check(paramRequest.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, paramRequest.GetAction()); errRet != nil {
+		return
+	}
 
-	paramResponse, err := me.client.UseKmsClient().GetParametersForImport(paramRequest)
+	paramResponse, err := me.client.UseKmsClient().GetParametersForImportWithContext(ctx, paramRequest)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
@@ -375,9 +403,11 @@ func (me *KmsService) ImportKeyMaterial(ctx context.Context, param map[string]in
 	importRequest.ValidTo = helper.Uint64(validTo)
 	importRequest.ImportToken = paramResponse.Response.ImportToken
 	importRequest.EncryptedKeyMaterial = helper.String(encryptedKeyMaterial)
-	ratelimit.Check(importRequest.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, importRequest.GetAction()); errRet != nil {
+		return
+	}
 
-	importResponse, err := me.client.UseKmsClient().ImportKeyMaterial(importRequest)
+	importResponse, err := me.client.UseKmsClient().ImportKeyMaterialWithContext(ctx, importRequest)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
@@ -392,9 +422,11 @@ func (me *KmsService) DeleteImportKeyMaterial(ctx context.Context, keyId string)
 	logId := getLogId(ctx)
 	request := kms.NewDeleteImportedKeyMaterialRequest()
 	request.KeyId = helper.String(keyId)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseKmsClient().DeleteImportedKeyMaterial(request)
+	response, err := me.client.UseKmsClient().DeleteImportedKeyMaterialWithContext(ctx, request)
 	if err != nil {
 		errRet = errors.WithStack(err)
 		return
@@ -423,9 +455,11 @@ func (me *KmsService) DescribeKmsPublicKeyByFilter(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseKmsClient().GetPublicKey(request)
+	response, err := me.client.UseKmsClient().GetPublicKeyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -467,9 +501,11 @@ func (me *KmsService) DescribeKmsGetParametersForImportByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseKmsClient().GetParametersForImport(request)
+	response, err := me.client.UseKmsClient().GetParametersForImportWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -32,7 +32,9 @@ func (me *LightHouseService) DescribeLighthouseInstanceById(ctx context.Context,
 	}()
 
 	request.InstanceIds = append(request.InstanceIds, helper.String(instanceId))
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset int64 = 0
 	var pageSize int64 = 100
@@ -41,8 +43,10 @@ func (me *LightHouseService) DescribeLighthouseInstanceById(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseLighthouseClient().DescribeInstances(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseLighthouseClient().DescribeInstancesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -83,8 +87,10 @@ func (me *LightHouseService) DeleteLighthouseInstanceById(ctx context.Context, i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseLighthouseClient().TerminateInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseLighthouseClient().TerminateInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -109,8 +115,10 @@ func (me *LightHouseService) IsolateLighthouseInstanceById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseLighthouseClient().IsolateInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseLighthouseClient().IsolateInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -147,9 +155,11 @@ func (me *LightHouseService) DeleteLighthouseBlueprintById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DeleteBlueprints(request)
+	response, err := me.client.UseLighthouseClient().DeleteBlueprintsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -171,9 +181,11 @@ func (me *LightHouseService) DescribeLighthouseBlueprintById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DescribeBlueprints(request)
+	response, err := me.client.UseLighthouseClient().DescribeBlueprintsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -205,10 +217,12 @@ func (me *LightHouseService) DescribeLighthouseFirewallRuleById(ctx context.Cont
 	offset := 0
 	firewallRules = make([]*lighthouse.FirewallRuleInfo, 0)
 	for {
-		ratelimit.Check(request.GetAction())
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
 		request.Limit = helper.IntInt64(limit)
 		request.Offset = helper.IntInt64(offset)
-		response, err := me.client.UseLighthouseClient().DescribeFirewallRules(request)
+		response, err := me.client.UseLighthouseClient().DescribeFirewallRulesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -237,9 +251,11 @@ func (me *LightHouseService) DescribeLighthouseFirewallRulesTemplateByFilter(ctx
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DescribeFirewallRulesTemplate(request)
+	response, err := me.client.UseLighthouseClient().DescribeFirewallRulesTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -264,9 +280,11 @@ func (me *LightHouseService) DescribeLighthouseDiskBackupById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DescribeDiskBackups(request)
+	response, err := me.client.UseLighthouseClient().DescribeDiskBackupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -293,9 +311,11 @@ func (me *LightHouseService) DeleteLighthouseDiskBackupById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DeleteDiskBackups(request)
+	response, err := me.client.UseLighthouseClient().DeleteDiskBackupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -345,9 +365,11 @@ func (me *LightHouseService) DescribeLighthouseDiskById(ctx context.Context, dis
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DescribeDisks(request)
+	response, err := me.client.UseLighthouseClient().DescribeDisksWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -378,8 +400,10 @@ func (me *LightHouseService) DescribeLighthouseDisk(ctx context.Context, diskIds
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseLighthouseClient().DescribeDisks(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseLighthouseClient().DescribeDisksWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -456,9 +480,11 @@ func (me *LightHouseService) DeleteLighthouseDiskAttachmentById(ctx context.Cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DetachDisks(request)
+	response, err := me.client.UseLighthouseClient().DetachDisksWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -480,9 +506,11 @@ func (me *LightHouseService) DescribeLighthouseKeyPairById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DescribeKeyPairs(request)
+	response, err := me.client.UseLighthouseClient().DescribeKeyPairsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -509,9 +537,11 @@ func (me *LightHouseService) DeleteLighthouseKeyPairById(ctx context.Context, ke
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DeleteKeyPairs(request)
+	response, err := me.client.UseLighthouseClient().DeleteKeyPairsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -533,9 +563,11 @@ func (me *LightHouseService) DescribeLighthouseSnapshotById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DescribeSnapshots(request)
+	response, err := me.client.UseLighthouseClient().DescribeSnapshotsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -562,9 +594,11 @@ func (me *LightHouseService) DeleteLighthouseSnapshotById(ctx context.Context, s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DeleteSnapshots(request)
+	response, err := me.client.UseLighthouseClient().DeleteSnapshotsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -637,10 +671,12 @@ func (me *LightHouseService) DescribeLighthouseBundleByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 	request.Offset = helper.IntInt64(offset)
 	request.Limit = helper.IntInt64(limit)
-	response, err := me.client.UseLighthouseClient().DescribeBundles(request)
+	response, err := me.client.UseLighthouseClient().DescribeBundlesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -676,9 +712,11 @@ func (me *LightHouseService) DescribeLighthouseZoneByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DescribeZones(request)
+	response, err := me.client.UseLighthouseClient().DescribeZonesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -718,7 +756,9 @@ func (me *LightHouseService) DescribeLighthouseSceneByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -727,7 +767,7 @@ func (me *LightHouseService) DescribeLighthouseSceneByFilter(ctx context.Context
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseLighthouseClient().DescribeScenes(request)
+		response, err := me.client.UseLighthouseClient().DescribeScenesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -772,7 +812,9 @@ func (me *LightHouseService) DescribeLighthouseAllSceneByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -781,7 +823,7 @@ func (me *LightHouseService) DescribeLighthouseAllSceneByFilter(ctx context.Cont
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseLighthouseClient().DescribeAllScenes(request)
+		response, err := me.client.UseLighthouseClient().DescribeAllScenesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -833,11 +875,13 @@ func (me *LightHouseService) DescribeLighthouseResetInstanceBlueprintByFilter(ct
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	request.Offset = helper.IntInt64(offset)
 	request.Limit = helper.IntInt64(limit)
-	response, err := me.client.UseLighthouseClient().DescribeResetInstanceBlueprints(request)
+	response, err := me.client.UseLighthouseClient().DescribeResetInstanceBlueprintsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -865,9 +909,11 @@ func (me *LightHouseService) DescribeLighthouseRegionByFilter(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DescribeRegions(request)
+	response, err := me.client.UseLighthouseClient().DescribeRegionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -896,9 +942,11 @@ func (me *LightHouseService) DescribeLighthouseInstanceVncUrlByFilter(ctx contex
 
 	request.InstanceId = helper.String(instanceId)
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DescribeInstanceVncUrl(request)
+	response, err := me.client.UseLighthouseClient().DescribeInstanceVncUrlWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -945,12 +993,14 @@ func (me *LightHouseService) DescribeLighthouseInstanceTrafficPackageByFilter(ct
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	for {
 		request.Offset = helper.IntInt64(offset)
 		request.Limit = helper.IntInt64(limit)
-		response, err := me.client.UseLighthouseClient().DescribeInstancesTrafficPackages(request)
+		response, err := me.client.UseLighthouseClient().DescribeInstancesTrafficPackagesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -985,9 +1035,11 @@ func (me *LightHouseService) DescribeLighthouseInstanceDiskNum(ctx context.Conte
 
 	request.InstanceIds = helper.Strings(instanceIds)
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DescribeInstancesDiskNum(request)
+	response, err := me.client.UseLighthouseClient().DescribeInstancesDiskNumWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1017,9 +1069,11 @@ func (me *LightHouseService) DescribeLighthouseInstanceBlueprintByFilter(ctx con
 
 	request.InstanceIds = helper.Strings(instanceIds)
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DescribeBlueprintInstances(request)
+	response, err := me.client.UseLighthouseClient().DescribeBlueprintInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1053,9 +1107,11 @@ func (me *LightHouseService) DescribeLighthouseDiskConfigByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DescribeDiskConfigs(request)
+	response, err := me.client.UseLighthouseClient().DescribeDiskConfigsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1097,9 +1153,11 @@ func (me *LightHouseService) DescribeLighthouseKeyPairAttachmentById(ctx context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DescribeKeyPairs(request)
+	response, err := me.client.UseLighthouseClient().DescribeKeyPairsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1127,9 +1185,11 @@ func (me *LightHouseService) DeleteLighthouseKeyPairAttachmentById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DisassociateInstancesKeyPairs(request)
+	response, err := me.client.UseLighthouseClient().DisassociateInstancesKeyPairsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1151,9 +1211,11 @@ func (me *LightHouseService) IsolateLighthouseDiskById(ctx context.Context, disk
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().IsolateDisks(request)
+	response, err := me.client.UseLighthouseClient().IsolateDisksWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1175,9 +1237,11 @@ func (me *LightHouseService) TerminateLighthouseDiskById(ctx context.Context, di
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().TerminateDisks(request)
+	response, err := me.client.UseLighthouseClient().TerminateDisksWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1208,7 +1272,9 @@ func (me *LightHouseService) DescribeLighthouseModifyInstanceBundleByFilter(ctx
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1217,7 +1283,7 @@ func (me *LightHouseService) DescribeLighthouseModifyInstanceBundleByFilter(ctx
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseLighthouseClient().DescribeModifyInstanceBundles(request)
+		response, err := me.client.UseLighthouseClient().DescribeModifyInstanceBundlesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1251,9 +1317,11 @@ func (me *LightHouseService) ModifyFirewallRuleDescription(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().ModifyFirewallRuleDescription(request)
+	response, err := me.client.UseLighthouseClient().ModifyFirewallRuleDescriptionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1275,9 +1343,11 @@ func (me *LightHouseService) DescribeFirewallTemplateById(ctx context.Context, t
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DescribeFirewallTemplates(request)
+	response, err := me.client.UseLighthouseClient().DescribeFirewallTemplatesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1304,9 +1374,11 @@ func (me *LightHouseService) DeleteFirewallTemplateById(ctx context.Context, tem
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DeleteFirewallTemplate(request)
+	response, err := me.client.UseLighthouseClient().DeleteFirewallTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1328,9 +1400,11 @@ func (me *LightHouseService) DescribeFirewallTemplateRulesById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseLighthouseClient().DescribeFirewallTemplateRules(request)
+	response, err := me.client.UseLighthouseClient().DescribeFirewallTemplateRulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
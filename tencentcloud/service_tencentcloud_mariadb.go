@@ -31,7 +31,7 @@ func (me *MariadbService) InitDbInstance(ctx context.Context, instanceId string,
 		}
 	}()
 
-	err := resource.Retry(8*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, 8*readRetryTimeout, func() *resource.RetryError {
 		dbInstance, errResp := me.DescribeMariadbDbInstance(ctx, instanceId)
 		if errResp != nil {
 			return retryError(errResp, InternalError)
@@ -46,8 +46,8 @@ func (me *MariadbService) InitDbInstance(ctx context.Context, instanceId string,
 			iniRequest := mariadb.NewInitDBInstancesRequest()
 			iniRequest.InstanceIds = []*string{&instanceId}
 			iniRequest.Params = params
-			initErr := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				result, e := me.client.UseMariadbClient().InitDBInstances(iniRequest)
+			initErr := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+				result, e := me.client.UseMariadbClient().InitDBInstancesWithContext(ctx, iniRequest)
 				if e != nil {
 					return retryError(e)
 				} else {
@@ -112,7 +112,9 @@ func (me *MariadbService) DescribeMariadbDbInstancesByFilter(ctx context.Context
 			request.ExclusterIds = v.([]*string)
 		}
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset int64 = 0
 	var pageSize int64 = 20
@@ -120,8 +122,10 @@ func (me *MariadbService) DescribeMariadbDbInstancesByFilter(ctx context.Context
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseMariadbClient().DescribeDBInstances(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseMariadbClient().DescribeDBInstancesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -161,9 +165,11 @@ func (me *MariadbService) DescribeMariadbDcnDetailByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMariadbClient().DescribeDcnDetail(request)
+	response, err := me.client.UseMariadbClient().DescribeDcnDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -201,9 +207,11 @@ func (me *MariadbService) DescribeMariadbFileDownloadUrlByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMariadbClient().DescribeFileDownloadUrl(request)
+	response, err := me.client.UseMariadbClient().DescribeFileDownloadUrlWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -238,9 +246,11 @@ func (me *MariadbService) DescribeMariadbFlowByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMariadbClient().DescribeFlow(request)
+	response, err := me.client.UseMariadbClient().DescribeFlowWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -275,7 +285,9 @@ func (me *MariadbService) DescribeMariadbInstanceNodeInfoByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -285,7 +297,7 @@ func (me *MariadbService) DescribeMariadbInstanceNodeInfoByFilter(ctx context.Co
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMariadbClient().DescribeInstanceNodeInfo(request)
+		response, err := me.client.UseMariadbClient().DescribeInstanceNodeInfoWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -320,9 +332,11 @@ func (me *MariadbService) DescribeMariadbInstanceSpecsByFilter(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMariadbClient().DescribeDBInstanceSpecs(request)
+	response, err := me.client.UseMariadbClient().DescribeDBInstanceSpecsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -352,9 +366,11 @@ func (me *MariadbService) DescribeMariadbOrdersByFilter(ctx context.Context, dea
 	}()
 
 	request.DealNames = common.StringPtrs([]string{dealName})
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMariadbClient().DescribeOrders(request)
+	response, err := me.client.UseMariadbClient().DescribeOrdersWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -410,9 +426,11 @@ func (me *MariadbService) DescribeMariadbPriceByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMariadbClient().DescribePrice(request)
+	response, err := me.client.UseMariadbClient().DescribePriceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -450,9 +468,11 @@ func (me *MariadbService) DescribeMariadbProjectSecurityGroupsByFilter(ctx conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMariadbClient().DescribeProjectSecurityGroups(request)
+	response, err := me.client.UseMariadbClient().DescribeProjectSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -493,9 +513,11 @@ func (me *MariadbService) DescribeMariadbRenewalPriceByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMariadbClient().DescribeRenewalPrice(request)
+	response, err := me.client.UseMariadbClient().DescribeRenewalPriceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -524,9 +546,11 @@ func (me *MariadbService) DescribeMariadbSaleInfoByFilter(ctx context.Context) (
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMariadbClient().DescribeSaleInfo(request)
+	response, err := me.client.UseMariadbClient().DescribeSaleInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -579,7 +603,9 @@ func (me *MariadbService) DescribeMariadbSlowLogsByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -588,7 +614,7 @@ func (me *MariadbService) DescribeMariadbSlowLogsByFilter(ctx context.Context, p
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMariadbClient().DescribeDBSlowLogs(request)
+		response, err := me.client.UseMariadbClient().DescribeDBSlowLogsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -644,9 +670,11 @@ func (me *MariadbService) DescribeMariadbUpgradePriceByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMariadbClient().DescribeUpgradePrice(request)
+	response, err := me.client.UseMariadbClient().DescribeUpgradePriceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -684,9 +712,11 @@ func (me *MariadbService) DescribeMariadbLogFilesByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMariadbClient().DescribeDBLogFiles(request)
+	response, err := me.client.UseMariadbClient().DescribeDBLogFilesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -717,7 +747,7 @@ func (me *MariadbService) DescribeMariadbDbInstance(ctx context.Context, instanc
 	}()
 	request.InstanceIds = []*string{&instanceId}
 
-	response, err := me.client.UseMariadbClient().DescribeDBInstances(request)
+	response, err := me.client.UseMariadbClient().DescribeDBInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -748,7 +778,7 @@ func (me *MariadbService) DescribeMariadbDbInstanceDetail(ctx context.Context, i
 
 	request.InstanceId = &instanceId
 
-	response, err := me.client.UseMariadbClient().DescribeDBInstanceDetail(request)
+	response, err := me.client.UseMariadbClient().DescribeDBInstanceDetailWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -781,8 +811,10 @@ func (me *MariadbService) DeleteMariadbDbInstanceById(ctx context.Context, insta
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMariadbClient().DestroyDBInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMariadbClient().DestroyDBInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -807,8 +839,10 @@ func (me *MariadbService) DeleteMariadbHourDbInstanceById(ctx context.Context, i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMariadbClient().DestroyHourDBInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMariadbClient().DestroyHourDBInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -833,8 +867,10 @@ func (me *MariadbService) DeleteMariadbDbInstance(ctx context.Context, instanceI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMariadbClient().TerminateDedicatedDBInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMariadbClient().TerminateDedicatedDBInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -860,7 +896,7 @@ func (me *MariadbService) DescribeMariadbAccount(ctx context.Context, instanceId
 	request.InstanceId = &instanceId
 	// request.UserName = &userName
 
-	response, err := me.client.UseMariadbClient().DescribeAccounts(request)
+	response, err := me.client.UseMariadbClient().DescribeAccountsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -897,8 +933,10 @@ func (me *MariadbService) DeleteMariadbAccountById(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMariadbClient().DeleteAccount(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMariadbClient().DeleteAccountWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -923,7 +961,7 @@ func (me *MariadbService) DescribeMariadbParameters(ctx context.Context, instanc
 	}()
 	request.InstanceId = &instanceId
 
-	response, err := me.client.UseMariadbClient().DescribeDBParameters(request)
+	response, err := me.client.UseMariadbClient().DescribeDBParametersWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -950,7 +988,7 @@ func (me *MariadbService) DescribeMariadbLogFileRetentionPeriod(ctx context.Cont
 	}()
 	request.InstanceId = &instanceId
 
-	response, err := me.client.UseMariadbClient().DescribeLogFileRetentionPeriod(request)
+	response, err := me.client.UseMariadbClient().DescribeLogFileRetentionPeriodWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -978,7 +1016,7 @@ func (me *MariadbService) DescribeMariadbSecurityGroup(ctx context.Context, inst
 	request.InstanceId = &instanceId
 	request.Product = &product
 
-	response, err := me.client.UseMariadbClient().DescribeDBSecurityGroups(request)
+	response, err := me.client.UseMariadbClient().DescribeDBSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1015,8 +1053,10 @@ func (me *MariadbService) DeleteMariadbSecurityGroupsById(ctx context.Context, i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMariadbClient().DisassociateSecurityGroups(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMariadbClient().DisassociateSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -1046,8 +1086,10 @@ func (me *MariadbService) DescribeMariadbAccountsByFilter(ctx context.Context, p
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMariadbClient().DescribeAccounts(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMariadbClient().DescribeAccountsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1087,7 +1129,7 @@ func (me *MariadbService) DescribeMariadbSecurityGroupsByFilter(ctx context.Cont
 		}
 
 	}
-	response, err := me.client.UseMariadbClient().DescribeDBSecurityGroups(request)
+	response, err := me.client.UseMariadbClient().DescribeDBSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1116,9 +1158,11 @@ func (me *MariadbService) DescribeMariadbInstanceById(ctx context.Context, insta
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMariadbClient().DescribeDBInstances(request)
+	response, err := me.client.UseMariadbClient().DescribeDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1145,9 +1189,11 @@ func (me *MariadbService) IsolateDBInstanceById(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMariadbClient().IsolateDBInstance(request)
+	response, err := me.client.UseMariadbClient().IsolateDBInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1169,9 +1215,11 @@ func (me *MariadbService) DeleteMariadbInstanceById(ctx context.Context, instanc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMariadbClient().DestroyDBInstance(request)
+	response, err := me.client.UseMariadbClient().DestroyDBInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1196,8 +1244,10 @@ func (me *MariadbService) DescribeMariadbDatabaseObjectsByFilter(ctx context.Con
 	request.InstanceId = &instanceId
 	request.DbName = &dbName
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMariadbClient().DescribeDatabaseObjects(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMariadbClient().DescribeDatabaseObjectsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1225,8 +1275,10 @@ func (me *MariadbService) DescribeMariadbDatabasesByFilter(ctx context.Context,
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMariadbClient().DescribeDatabases(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMariadbClient().DescribeDatabasesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1265,8 +1317,10 @@ func (me *MariadbService) DescribeMariadbDatabaseTableByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMariadbClient().DescribeDatabaseTable(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMariadbClient().DescribeDatabaseTableWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1294,7 +1348,7 @@ func (me *MariadbService) DescribeDBEncryptAttributes(ctx context.Context, insta
 	}()
 	request.InstanceId = &instanceId
 
-	response, err := me.client.UseMariadbClient().DescribeDBEncryptAttributes(request)
+	response, err := me.client.UseMariadbClient().DescribeDBEncryptAttributesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1323,7 +1377,7 @@ func (me *MariadbService) DescribeFlowById(ctx context.Context, flowId int64) (f
 	}()
 
 	request.FlowId = &flowId
-	response, err := me.client.UseMariadbClient().DescribeFlow(request)
+	response, err := me.client.UseMariadbClient().DescribeFlowWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1354,9 +1408,11 @@ func (me *MariadbService) DescribeMariadbAccountPrivilegesById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMariadbClient().DescribeAccountPrivileges(request)
+	response, err := me.client.UseMariadbClient().DescribeAccountPrivilegesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1379,9 +1435,11 @@ func (me *MariadbService) DescribeMariadbBackupTimeById(ctx context.Context, ins
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMariadbClient().DescribeBackupTime(request)
+	response, err := me.client.UseMariadbClient().DescribeBackupTimeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1408,9 +1466,11 @@ func (me *MariadbService) DescribeDBInstanceDetailById(ctx context.Context, inst
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMariadbClient().DescribeDBInstanceDetail(request)
+	response, err := me.client.UseMariadbClient().DescribeDBInstanceDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -25,9 +25,11 @@ func (me *MdlService) DescribeMdlStreamLiveInputById(ctx context.Context, id str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMdlClient().DescribeStreamLiveInput(request)
+	response, err := me.client.UseMdlClient().DescribeStreamLiveInputWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -53,9 +55,11 @@ func (me *MdlService) DeleteMdlStreamLiveInputById(ctx context.Context, id strin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMdlClient().DeleteStreamLiveInput(request)
+	response, err := me.client.UseMdlClient().DeleteStreamLiveInputWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
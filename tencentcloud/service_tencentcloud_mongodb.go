@@ -23,9 +23,11 @@ func (me *MongodbService) DescribeInstanceById(ctx context.Context, instanceId s
 	request := mongodb.NewDescribeDBInstancesRequest()
 	request.InstanceIds = []*string{&instanceId}
 	var response *mongodb.DescribeDBInstancesResponse
-	err := resource.Retry(20*readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseMongodbClient().DescribeDBInstances(request)
+	err := retryContext(ctx, 20*readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := me.client.UseMongodbClient().DescribeDBInstancesWithContext(ctx, request)
 		if e != nil {
 			return resource.NonRetryableError(e)
 		}
@@ -64,8 +66,10 @@ func (me *MongodbService) ModifyInstanceName(ctx context.Context, instanceId, in
 	request := mongodb.NewRenameInstanceRequest()
 	request.InstanceId = &instanceId
 	request.NewName = &instanceName
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMongodbClient().RenameInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMongodbClient().RenameInstanceWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -83,9 +87,11 @@ func (me *MongodbService) ResetInstancePassword(ctx context.Context, instanceId,
 	request.UserName = &accountName
 	request.Password = &password
 	var response *mongodb.ResetDBInstancePasswordResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseMongodbClient().ResetDBInstancePassword(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := me.client.UseMongodbClient().ResetDBInstancePasswordWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), e.Error())
 			return resource.RetryableError(e)
@@ -116,9 +122,11 @@ func (me *MongodbService) UpgradeInstance(ctx context.Context, instanceId string
 	request.Volume = helper.IntUint64(volume)
 	var response *mongodb.ModifyDBInstanceSpecResponse
 	tradeError := false
-	err := resource.Retry(6*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseMongodbClient().ModifyDBInstanceSpec(request)
+	err := retryContext(ctx, 6*writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := me.client.UseMongodbClient().ModifyDBInstanceSpecWithContext(ctx, request)
 		if e != nil {
 			// request might be accepted between "InvalidParameterValue.InvalidTradeOperation" and "InvalidParameterValue.StatusAbnormal" error
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
@@ -151,8 +159,10 @@ func (me *MongodbService) ModifyProjectId(ctx context.Context, instanceId string
 	request := mongodb.NewAssignProjectRequest()
 	request.InstanceIds = []*string{&instanceId}
 	request.ProjectId = helper.IntUint64(projectId)
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMongodbClient().AssignProject(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMongodbClient().AssignProjectWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -169,8 +179,10 @@ func (me *MongodbService) DescribeSpecInfo(ctx context.Context, zone string) (in
 	if zone != "" {
 		request.Zone = &zone
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMongodbClient().DescribeSpecInfo(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMongodbClient().DescribeSpecInfoWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -189,8 +201,10 @@ func (me *MongodbService) ModifySecurityGroups(ctx context.Context, instanceId s
 	request := mongodb.NewModifyDBInstanceSecurityGroupRequest()
 	request.InstanceId = &instanceId
 	request.SecurityGroupIds = securityGroups
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMongodbClient().ModifyDBInstanceSecurityGroup(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMongodbClient().ModifyDBInstanceSecurityGroupWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -208,8 +222,10 @@ func (me *MongodbService) ModifyNetworkAddress(ctx context.Context, instanceId s
 	request.NewUniqVpcId = &vpcId
 	request.NewUniqSubnetId = &subnetId
 	request.OldIpExpiredTime = helper.Uint64(0)
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMongodbClient().ModifyDBInstanceNetworkAddress(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMongodbClient().ModifyDBInstanceNetworkAddressWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -244,8 +260,10 @@ func (me *MongodbService) DescribeInstancesByFilter(ctx context.Context, instanc
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseMongodbClient().DescribeDBInstances(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseMongodbClient().DescribeDBInstancesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -279,9 +297,11 @@ func (me *MongodbService) IsolateInstance(ctx context.Context, instanceId string
 		}
 	}()
 	var response *mongodb.IsolateDBInstanceResponse
-	err := resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseMongodbClient().IsolateDBInstance(request)
+	err := retryContext(ctx, 10*writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := me.client.UseMongodbClient().IsolateDBInstanceWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), e.Error())
 			return retryError(e)
@@ -308,9 +328,11 @@ func (me *MongodbService) TerminateDBInstances(ctx context.Context, instanceId s
 		}
 	}()
 	var response *mongodb.TerminateDBInstancesResponse
-	err := resource.Retry(10*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseMongodbClient().TerminateDBInstances(request)
+	err := retryContext(ctx, 10*writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := me.client.UseMongodbClient().TerminateDBInstancesWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), e.Error())
 			return resource.RetryableError(fmt.Errorf("Terminate instance %s error", instanceId))
@@ -339,9 +361,11 @@ func (me *MongodbService) ModifyAutoRenewFlag(ctx context.Context, instanceId st
 			log.Printf("[CRITAL]%s api[%s] fail,reason[%s]", logId, request.GetAction(), errRet.Error())
 		}
 	}()
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, e := me.client.UseMongodbClient().RenewDBInstances(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, e := me.client.UseMongodbClient().RenewDBInstancesWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), e.Error())
 			return retryError(e)
@@ -359,9 +383,11 @@ func (me *MongodbService) DescribeAsyncRequestInfo(ctx context.Context, asyncId
 	logId := getLogId(ctx)
 	request := mongodb.NewDescribeAsyncRequestInfoRequest()
 	request.AsyncRequestId = &asyncId
-	err := resource.Retry(timeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseMongodbClient().DescribeAsyncRequestInfo(request)
+	err := retryContext(ctx, timeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := me.client.UseMongodbClient().DescribeAsyncRequestInfoWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), e.Error())
 			return resource.RetryableError(e)
@@ -392,9 +418,11 @@ func (me *MongodbService) OfflineIsolatedDBInstance(ctx context.Context, instanc
 	}()
 	var response *mongodb.OfflineIsolatedDBInstanceResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = me.client.UseMongodbClient().OfflineIsolatedDBInstance(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = me.client.UseMongodbClient().OfflineIsolatedDBInstanceWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -411,9 +439,11 @@ func (me *MongodbService) OfflineIsolatedDBInstance(ctx context.Context, instanc
 
 	checkRequest := mongodb.NewDescribeDBInstancesRequest()
 	checkRequest.InstanceIds = []*string{&instanceId}
-	err = resource.Retry(20*readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(checkRequest.GetAction())
-		result, e := me.client.UseMongodbClient().DescribeDBInstances(checkRequest)
+	err = retryContext(ctx, 20*readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, checkRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := me.client.UseMongodbClient().DescribeDBInstancesWithContext(ctx, checkRequest)
 		if e != nil {
 			return resource.NonRetryableError(e)
 		}
@@ -436,8 +466,10 @@ func (me *MongodbService) DescribeSecurityGroup(ctx context.Context, instanceId
 	logId := getLogId(ctx)
 	request := mongodb.NewDescribeSecurityGroupRequest()
 	request.InstanceId = helper.String(instanceId)
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMongodbClient().DescribeSecurityGroup(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMongodbClient().DescribeSecurityGroupWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -455,8 +487,10 @@ func (me *MongodbService) DescribeDBInstanceNodeProperty(ctx context.Context, in
 	logId := getLogId(ctx)
 	request := mongodb.NewDescribeDBInstanceNodePropertyRequest()
 	request.InstanceId = helper.String(instanceId)
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMongodbClient().DescribeDBInstanceNodeProperty(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMongodbClient().DescribeDBInstanceNodePropertyWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -482,9 +516,11 @@ func (me *MongodbService) DescribeMongodbInstanceAccountById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMongodbClient().DescribeAccountUsers(request)
+	response, err := me.client.UseMongodbClient().DescribeAccountUsersWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -518,9 +554,11 @@ func (me *MongodbService) DeleteMongodbInstanceAccountById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMongodbClient().DeleteAccountUser(request)
+	response, err := me.client.UseMongodbClient().DeleteAccountUserWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -548,9 +586,11 @@ func (me *MongodbService) DescribeMongodbInstanceBackupDownloadTaskById(ctx cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMongodbClient().DescribeBackupDownloadTask(request)
+	response, err := me.client.UseMongodbClient().DescribeBackupDownloadTaskWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -586,7 +626,9 @@ func (me *MongodbService) DescribeMongodbInstanceBackupsByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -595,7 +637,7 @@ func (me *MongodbService) DescribeMongodbInstanceBackupsByFilter(ctx context.Con
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMongodbClient().DescribeDBBackups(request)
+		response, err := me.client.UseMongodbClient().DescribeDBBackupsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -634,7 +676,9 @@ func (me *MongodbService) DescribeMongodbInstanceConnectionsByFilter(ctx context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -643,7 +687,7 @@ func (me *MongodbService) DescribeMongodbInstanceConnectionsByFilter(ctx context
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMongodbClient().DescribeClientConnections(request)
+		response, err := me.client.UseMongodbClient().DescribeClientConnectionsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -703,7 +747,9 @@ func (me *MongodbService) DescribeMongodbInstanceCurrentOpByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -712,7 +758,7 @@ func (me *MongodbService) DescribeMongodbInstanceCurrentOpByFilter(ctx context.C
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMongodbClient().DescribeCurrentOp(request)
+		response, err := me.client.UseMongodbClient().DescribeCurrentOpWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -751,9 +797,11 @@ func (me *MongodbService) DescribeMongodbInstanceParams(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMongodbClient().DescribeInstanceParams(request)
+	response, err := me.client.UseMongodbClient().DescribeInstanceParamsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -795,7 +843,9 @@ func (me *MongodbService) DescribeMongodbInstanceSlowLogByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -804,7 +854,7 @@ func (me *MongodbService) DescribeMongodbInstanceSlowLogByFilter(ctx context.Con
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMongodbClient().DescribeSlowLogs(request)
+		response, err := me.client.UseMongodbClient().DescribeSlowLogsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -56,8 +56,10 @@ func (me *MonitorService) CheckCanCreateMysqlROInstance(ctx context.Context, mys
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().GetMonitorData(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().GetMonitorDataWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -74,11 +76,13 @@ func (me *MonitorService) CheckCanCreateMysqlROInstance(ctx context.Context, mys
 	return
 }
 
-func (me *MonitorService) FullRegions() (regions []string, errRet error) {
+func (me *MonitorService) FullRegions(ctx context.Context) (regions []string, errRet error) {
 	request := cvm.NewDescribeRegionsRequest()
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		if response, err := me.client.UseCvmClient().DescribeRegions(request); err != nil {
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if response, err := me.client.UseCvmClient().DescribeRegionsWithContext(ctx, request); err != nil {
 			return retryError(err, InternalError)
 		} else {
 			for _, region := range response.Response.RegionSet {
@@ -102,9 +106,11 @@ func (me *MonitorService) DescribePolicyGroupDetailInfo(ctx context.Context, gro
 	request.GroupId = &groupId
 	request.Module = helper.String("monitor")
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		if response, err = me.client.UseMonitorClient().DescribePolicyGroupInfo(request); err != nil {
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if response, err = me.client.UseMonitorClient().DescribePolicyGroupInfoWithContext(ctx, request); err != nil {
 			return retryError(err, InternalError)
 		}
 		return nil
@@ -124,8 +130,10 @@ func (me *MonitorService) DescribeAlarmPolicyById(ctx context.Context, policyId
 	request.Module = helper.String("monitor")
 	request.PolicyId = &policyId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().DescribeAlarmPolicy(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().DescribeAlarmPolicyWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -169,9 +177,11 @@ func (me *MonitorService) DescribeAlarmNoticeById(ctx context.Context, alarmmap
 		request.NoticeIds = v.([]*string)
 	}
 
-	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		if response, err = me.client.UseMonitorClient().DescribeAlarmNotices(request); err != nil {
+	if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if response, err = me.client.UseMonitorClient().DescribeAlarmNoticesWithContext(ctx, request); err != nil {
 			return retryError(err, InternalError)
 		}
 		noticeIds = response.Response.Notices
@@ -198,9 +208,11 @@ func (me *MonitorService) DescribePolicyGroup(ctx context.Context, groupId int64
 		if finish {
 			break
 		}
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			response, err := me.client.UseMonitorClient().DescribePolicyGroupList(request)
+		if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			response, err := me.client.UseMonitorClient().DescribePolicyGroupListWithContext(ctx, request)
 			if err != nil {
 				return retryError(err, InternalError)
 			}
@@ -246,9 +258,11 @@ func (me *MonitorService) DescribeBindingPolicyObjectList(ctx context.Context, g
 		if finish {
 			break
 		}
-		if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(requestList.GetAction())
-			if responseList, err = me.client.UseMonitorClient().DescribeBindingPolicyObjectList(requestList); err != nil {
+		if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, requestList.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if responseList, err = me.client.UseMonitorClient().DescribeBindingPolicyObjectListWithContext(ctx, requestList); err != nil {
 				return retryError(err, InternalError)
 			}
 			objects = append(objects, responseList.Response.List...)
@@ -287,9 +301,11 @@ func (me *MonitorService) DescribeBindingAlarmPolicyObjectList(ctx context.Conte
 		if finish {
 			break
 		}
-		if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(requestList.GetAction())
-			if responseList, err = me.client.UseMonitorClient().DescribeBindingPolicyObjectList(requestList); err != nil {
+		if err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, requestList.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if responseList, err = me.client.UseMonitorClient().DescribeBindingPolicyObjectListWithContext(ctx, requestList); err != nil {
 				return retryError(err, InternalError)
 			}
 			objects = append(objects, responseList.Response.List...)
@@ -330,7 +346,7 @@ func (me *MonitorService) DescribeMonitorTmpInstance(ctx context.Context, tmpIns
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMonitorClient().DescribePrometheusInstances(request)
+		response, err := me.client.UseMonitorClient().DescribePrometheusInstancesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -368,8 +384,10 @@ func (me *MonitorService) IsolateMonitorTmpInstanceById(ctx context.Context, tmp
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().TerminatePrometheusInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().TerminatePrometheusInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -393,8 +411,10 @@ func (me *MonitorService) DeleteMonitorTmpInstanceById(ctx context.Context, tmpI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().DestroyPrometheusInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().DestroyPrometheusInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -420,7 +440,7 @@ func (me *MonitorService) DescribeMonitorTmpCvmAgent(ctx context.Context, instan
 	request.InstanceId = &instanceId
 	request.AgentIds = []*string{&tmpCvmAgentId}
 
-	response, err := me.client.UseMonitorClient().DescribePrometheusAgents(request)
+	response, err := me.client.UseMonitorClient().DescribePrometheusAgentsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -450,13 +470,17 @@ func (me *MonitorService) DescribeMonitorTmpScrapeJob(ctx context.Context, tmpSc
 		}
 	}()
 
-	ids := strings.Split(tmpScrapeJobId, FILED_SP)
+	ids, err := helper.IdParseN(tmpScrapeJobId, 3)
+	if err != nil {
+		errRet = err
+		return
+	}
 
 	request.JobIds = []*string{&ids[0]}
 	request.InstanceId = &ids[1]
 	request.AgentId = &ids[2]
 
-	response, err := me.client.UseMonitorClient().DescribePrometheusScrapeJobs(request)
+	response, err := me.client.UseMonitorClient().DescribePrometheusScrapeJobsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -476,7 +500,10 @@ func (me *MonitorService) DescribeMonitorTmpScrapeJob(ctx context.Context, tmpSc
 func (me *MonitorService) DeleteMonitorTmpScrapeJobById(ctx context.Context, tmpScrapeJobId string) (errRet error) {
 	logId := getLogId(ctx)
 
-	ids := strings.Split(tmpScrapeJobId, FILED_SP)
+	ids, errRet := helper.IdParseN(tmpScrapeJobId, 3)
+	if errRet != nil {
+		return
+	}
 	request := monitor.NewDeletePrometheusScrapeJobsRequest()
 	request.JobIds = []*string{&ids[0]}
 	request.InstanceId = &ids[1]
@@ -489,8 +516,10 @@ func (me *MonitorService) DeleteMonitorTmpScrapeJobById(ctx context.Context, tmp
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().DeletePrometheusScrapeJobs(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().DeletePrometheusScrapeJobsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -508,9 +537,11 @@ func (me *MonitorService) DeleteMonitorAlarmNoticeById(ctx context.Context, Id s
 	var n = []*string{&noticeId}
 	request.NoticeIds = n
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseMonitorClient().DeleteAlarmNotices(request)
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseMonitorClient().DeleteAlarmNoticesWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -534,7 +565,7 @@ func (me *MonitorService) DescribeMonitorTmpExporterIntegration(ctx context.Cont
 		}
 	}()
 
-	ids := strings.Split(tmpExporterIntegrationId, FILED_SP)
+	ids := helper.IdParse(tmpExporterIntegrationId)
 	if ids[0] != "" {
 		request.Name = &ids[0]
 	}
@@ -544,7 +575,7 @@ func (me *MonitorService) DescribeMonitorTmpExporterIntegration(ctx context.Cont
 	request.ClusterId = &ids[3]
 	request.Kind = &ids[4]
 
-	response, err := me.client.UseMonitorClient().DescribeExporterIntegrations(request)
+	response, err := me.client.UseMonitorClient().DescribeExporterIntegrationsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -565,7 +596,7 @@ func (me *MonitorService) DeleteMonitorTmpExporterIntegrationById(ctx context.Co
 	logId := getLogId(ctx)
 
 	request := monitor.NewDeleteExporterIntegrationRequest()
-	ids := strings.Split(tmpExporterIntegrationId, FILED_SP)
+	ids := helper.IdParse(tmpExporterIntegrationId)
 
 	request.Name = &ids[0]
 	request.InstanceId = &ids[1]
@@ -581,8 +612,10 @@ func (me *MonitorService) DeleteMonitorTmpExporterIntegrationById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().DeleteExporterIntegration(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().DeleteExporterIntegrationWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -609,8 +642,10 @@ func (me *MonitorService) DescribeMonitorTmpAlertRuleById(ctx context.Context, i
 	request.InstanceId = &instanceId
 	request.RuleId = &tmpAlertRuleId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().DescribeAlertRules(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().DescribeAlertRulesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -642,8 +677,10 @@ func (me *MonitorService) DeleteMonitorTmpAlertRule(ctx context.Context, instanc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().DeleteAlertRules(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().DeleteAlertRulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -670,8 +707,10 @@ func (me *MonitorService) DescribeMonitorRecordingRuleById(ctx context.Context,
 	request.InstanceId = &instanceId
 	request.RuleId = &recordingRuleId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().DescribeRecordingRules(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().DescribeRecordingRulesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -703,8 +742,10 @@ func (me *MonitorService) DeleteMonitorRecordingRule(ctx context.Context, instan
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().DeleteRecordingRules(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().DeleteRecordingRulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -731,7 +772,7 @@ func (me *MonitorService) DescribeMonitorGrafanaInstance(ctx context.Context, in
 	request.Offset = helper.IntInt64(0)
 	request.Limit = helper.IntInt64(10)
 
-	response, err := me.client.UseMonitorClient().DescribeGrafanaInstances(request)
+	response, err := me.client.UseMonitorClient().DescribeGrafanaInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -763,8 +804,10 @@ func (me *MonitorService) DeleteMonitorGrafanaInstanceById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().DeleteGrafanaInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().DeleteGrafanaInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -789,8 +832,10 @@ func (me *MonitorService) CleanGrafanaInstanceById(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().CleanGrafanaInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().CleanGrafanaInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -816,7 +861,7 @@ func (me *MonitorService) DescribeMonitorGrafanaIntegration(ctx context.Context,
 	request.IntegrationId = &integrationId
 	request.InstanceId = &instanceId
 
-	response, err := me.client.UseMonitorClient().DescribeGrafanaIntegrations(request)
+	response, err := me.client.UseMonitorClient().DescribeGrafanaIntegrationsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -847,8 +892,10 @@ func (me *MonitorService) DeleteMonitorGrafanaIntegrationById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().DeleteGrafanaIntegration(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().DeleteGrafanaIntegrationWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -876,7 +923,7 @@ func (me *MonitorService) DescribeMonitorGrafanaNotificationChannel(ctx context.
 	request.ChannelIds = []*string{&channelId}
 	request.InstanceId = &instanceId
 
-	response, err := me.client.UseMonitorClient().DescribeGrafanaChannels(request)
+	response, err := me.client.UseMonitorClient().DescribeGrafanaChannelsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -908,8 +955,10 @@ func (me *MonitorService) DeleteMonitorGrafanaNotificationChannelById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().DeleteGrafanaNotificationChannel(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().DeleteGrafanaNotificationChannelWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -935,7 +984,7 @@ func (me *MonitorService) DescribeMonitorSsoAccount(ctx context.Context, instanc
 	request.InstanceId = &instanceId
 	request.UserId = &userId
 
-	response, err := me.client.UseMonitorClient().DescribeSSOAccount(request)
+	response, err := me.client.UseMonitorClient().DescribeSSOAccountWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -970,8 +1019,10 @@ func (me *MonitorService) DeleteMonitorSsoAccountById(ctx context.Context, insta
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().DeleteSSOAccount(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().DeleteSSOAccountWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -998,7 +1049,7 @@ func (me *MonitorService) DescribeMonitorGrafanaPlugin(ctx context.Context, inst
 	request.InstanceId = &instanceId
 	request.PluginId = &pluginId
 
-	response, err := me.client.UseMonitorClient().DescribeInstalledPlugins(request)
+	response, err := me.client.UseMonitorClient().DescribeInstalledPluginsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1030,8 +1081,10 @@ func (me *MonitorService) DeleteMonitorGrafanaPluginById(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().UninstallGrafanaPlugins(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().UninstallGrafanaPluginsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -1060,7 +1113,7 @@ func (me *MonitorService) DescribeTkeTmpAlertPolicy(ctx context.Context, instanc
 		Values: []*string{&tmpAlertPolicyId},
 	})
 
-	response, err := me.client.UseMonitorClient().DescribePrometheusAlertPolicy(request)
+	response, err := me.client.UseMonitorClient().DescribePrometheusAlertPolicyWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1091,8 +1144,10 @@ func (me *MonitorService) DeleteTkeTmpAlertPolicyById(ctx context.Context, insta
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().DeletePrometheusAlertPolicy(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().DeletePrometheusAlertPolicyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -1117,7 +1172,9 @@ func (me *MonitorService) DescribeTmpTkeClusterAgentsById(ctx context.Context, i
 	}()
 
 	request.InstanceId = &instanceId
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset uint64 = 0
 	var pageSize uint64 = 100
@@ -1125,8 +1182,10 @@ func (me *MonitorService) DescribeTmpTkeClusterAgentsById(ctx context.Context, i
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseMonitorClient().DescribePrometheusClusterAgents(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseMonitorClient().DescribePrometheusClusterAgentsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1170,8 +1229,10 @@ func (me *MonitorService) DeletePrometheusClusterAgent(ctx context.Context, inst
 		ClusterType: &clusterType,
 	})
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().DeletePrometheusClusterAgent(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().DeletePrometheusClusterAgentWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
@@ -1204,8 +1265,10 @@ func (me *MonitorService) DescribeTkeTmpConfigById(ctx context.Context, configId
 	request.ClusterType = &ids.ClusterType
 	request.InstanceId = &ids.InstanceId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().DescribePrometheusConfig(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().DescribePrometheusConfigWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail,ids [%s], request body [%s], reason[%s]\n",
 			logId, request.GetAction(), configId, request.ToJsonString(), err.Error())
@@ -1256,8 +1319,10 @@ func (me *MonitorService) DeleteTkeTmpConfigByName(ctx context.Context, configId
 		request.RawJobs = RawJobs
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().DeletePrometheusConfig(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().DeletePrometheusConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1269,9 +1334,9 @@ func (me *MonitorService) DeleteTkeTmpConfigByName(ctx context.Context, configId
 }
 
 func (me *MonitorService) parseConfigId(configId string) (ret *PrometheusConfigIds, err error) {
-	idSplit := strings.Split(configId, FILED_SP)
-	if len(idSplit) != 3 {
-		return nil, fmt.Errorf("id is broken,%s", configId)
+	idSplit, err := helper.IdParseN(configId, 3)
+	if err != nil {
+		return nil, err
 	}
 
 	instanceId := idSplit[0]
@@ -1305,7 +1370,9 @@ func (me *MonitorService) DescribeTmpTkeTemplateById(ctx context.Context, templa
 			Values: []*string{&templateId},
 		},
 	)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset uint64 = 0
 	var pageSize uint64 = 100
@@ -1314,8 +1381,10 @@ func (me *MonitorService) DescribeTmpTkeTemplateById(ctx context.Context, templa
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseMonitorClient().DescribePrometheusTemp(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseMonitorClient().DescribePrometheusTempWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1362,8 +1431,10 @@ func (me *MonitorService) DeleteTmpTkeTemplate(ctx context.Context, tempId strin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().DeletePrometheusTemp(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().DeletePrometheusTempWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -1388,8 +1459,10 @@ func (me *MonitorService) DeletePrometheusRecordRuleYaml(ctx context.Context, id
 	request.InstanceId = &id
 	request.Names = []*string{&name}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().DeletePrometheusRecordRuleYaml(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().DeletePrometheusRecordRuleYamlWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
@@ -1424,7 +1497,7 @@ func (me *MonitorService) DescribePrometheusRecordRuleByName(ctx context.Context
 		}
 	}
 
-	response, err := me.client.UseMonitorClient().DescribePrometheusRecordRules(request)
+	response, err := me.client.UseMonitorClient().DescribePrometheusRecordRulesWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1452,7 +1525,7 @@ func (me *MonitorService) DescribeTkeTmpGlobalNotification(ctx context.Context,
 	}()
 	request.InstanceId = &instanceId
 
-	response, err := me.client.UseMonitorClient().DescribePrometheusGlobalNotification(request)
+	response, err := me.client.UseMonitorClient().DescribePrometheusGlobalNotificationWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1484,8 +1557,10 @@ func (me *MonitorService) ModifyTkeTmpGlobalNotification(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().ModifyPrometheusGlobalNotification(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().ModifyPrometheusGlobalNotificationWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return nil, err
@@ -1510,9 +1585,11 @@ func (me *MonitorService) DescribePrometheusTempSync(ctx context.Context, templa
 	}()
 
 	request.TemplateId = &templateId
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMonitorClient().DescribePrometheusTempSync(request)
+	response, err := me.client.UseMonitorClient().DescribePrometheusTempSyncWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1548,9 +1625,11 @@ func (me *MonitorService) DescribeMonitorManageGrafanaAttachmentById(ctx context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMonitorClient().DescribePrometheusInstances(request)
+	response, err := me.client.UseMonitorClient().DescribePrometheusInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1584,9 +1663,11 @@ func (me *MonitorService) DeleteMonitorManageGrafanaAttachmentById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMonitorClient().UnbindPrometheusManagedGrafana(request)
+	response, err := me.client.UseMonitorClient().UnbindPrometheusManagedGrafanaWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1609,8 +1690,10 @@ func (me *MonitorService) DescribeTkeTmpBasicConfigById(ctx context.Context, clu
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMonitorClient().DescribePrometheusConfig(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMonitorClient().DescribePrometheusConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1738,9 +1821,11 @@ func (me *MonitorService) DescribeMonitorTmpGrafanaConfigById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMonitorClient().DescribeGrafanaConfig(request)
+	response, err := me.client.UseMonitorClient().DescribeGrafanaConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -26,9 +26,11 @@ func (me *MpsService) DescribeMpsWorkflowById(ctx context.Context, workflowId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DescribeWorkflows(request)
+	response, err := me.client.UseMpsClient().DescribeWorkflowsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -55,9 +57,11 @@ func (me *MpsService) DeleteMpsWorkflowById(ctx context.Context, workflowId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DeleteWorkflow(request)
+	response, err := me.client.UseMpsClient().DeleteWorkflowWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -79,9 +83,11 @@ func (me *MpsService) EnableWorkflow(ctx context.Context, workflowId int64) (err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().EnableWorkflow(request)
+	response, err := me.client.UseMpsClient().EnableWorkflowWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -103,9 +109,11 @@ func (me *MpsService) DisableWorkflow(ctx context.Context, workflowId int64) (er
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DisableWorkflow(request)
+	response, err := me.client.UseMpsClient().DisableWorkflowWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -127,9 +135,11 @@ func (me *MpsService) DescribeMpsTranscodeTemplateById(ctx context.Context, defi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DescribeTranscodeTemplates(request)
+	response, err := me.client.UseMpsClient().DescribeTranscodeTemplatesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -156,9 +166,11 @@ func (me *MpsService) DeleteMpsTranscodeTemplateById(ctx context.Context, defini
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DeleteTranscodeTemplate(request)
+	response, err := me.client.UseMpsClient().DeleteTranscodeTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -180,9 +192,11 @@ func (me *MpsService) DescribeMpsWatermarkTemplateById(ctx context.Context, defi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DescribeWatermarkTemplates(request)
+	response, err := me.client.UseMpsClient().DescribeWatermarkTemplatesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -209,9 +223,11 @@ func (me *MpsService) DeleteMpsWatermarkTemplateById(ctx context.Context, defini
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DeleteWatermarkTemplate(request)
+	response, err := me.client.UseMpsClient().DeleteWatermarkTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -233,9 +249,11 @@ func (me *MpsService) DescribeMpsImageSpriteTemplateById(ctx context.Context, de
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DescribeImageSpriteTemplates(request)
+	response, err := me.client.UseMpsClient().DescribeImageSpriteTemplatesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -262,9 +280,11 @@ func (me *MpsService) DeleteMpsImageSpriteTemplateById(ctx context.Context, defi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DeleteImageSpriteTemplate(request)
+	response, err := me.client.UseMpsClient().DeleteImageSpriteTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -286,9 +306,11 @@ func (me *MpsService) DescribeMpsSnapshotByTimeoffsetTemplateById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DescribeSnapshotByTimeOffsetTemplates(request)
+	response, err := me.client.UseMpsClient().DescribeSnapshotByTimeOffsetTemplatesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -315,9 +337,11 @@ func (me *MpsService) DeleteMpsSnapshotByTimeoffsetTemplateById(ctx context.Cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DeleteSnapshotByTimeOffsetTemplate(request)
+	response, err := me.client.UseMpsClient().DeleteSnapshotByTimeOffsetTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -339,9 +363,11 @@ func (me *MpsService) DescribeMpsSampleSnapshotTemplateById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DescribeSampleSnapshotTemplates(request)
+	response, err := me.client.UseMpsClient().DescribeSampleSnapshotTemplatesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -368,9 +394,11 @@ func (me *MpsService) DeleteMpsSampleSnapshotTemplateById(ctx context.Context, d
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DeleteSampleSnapshotTemplate(request)
+	response, err := me.client.UseMpsClient().DeleteSampleSnapshotTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -392,9 +420,11 @@ func (me *MpsService) DescribeMpsAnimatedGraphicsTemplateById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DescribeAnimatedGraphicsTemplates(request)
+	response, err := me.client.UseMpsClient().DescribeAnimatedGraphicsTemplatesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -421,9 +451,11 @@ func (me *MpsService) DeleteMpsAnimatedGraphicsTemplateById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DeleteAnimatedGraphicsTemplate(request)
+	response, err := me.client.UseMpsClient().DeleteAnimatedGraphicsTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -445,9 +477,11 @@ func (me *MpsService) DescribeMpsAdaptiveDynamicStreamingTemplateById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DescribeAdaptiveDynamicStreamingTemplates(request)
+	response, err := me.client.UseMpsClient().DescribeAdaptiveDynamicStreamingTemplatesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -474,9 +508,11 @@ func (me *MpsService) DeleteMpsAdaptiveDynamicStreamingTemplateById(ctx context.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DeleteAdaptiveDynamicStreamingTemplate(request)
+	response, err := me.client.UseMpsClient().DeleteAdaptiveDynamicStreamingTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -498,9 +534,11 @@ func (me *MpsService) DescribeMpsAiAnalysisTemplateById(ctx context.Context, def
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DescribeAIAnalysisTemplates(request)
+	response, err := me.client.UseMpsClient().DescribeAIAnalysisTemplatesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -527,9 +565,11 @@ func (me *MpsService) DeleteMpsAiAnalysisTemplateById(ctx context.Context, defin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DeleteAIAnalysisTemplate(request)
+	response, err := me.client.UseMpsClient().DeleteAIAnalysisTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -551,9 +591,11 @@ func (me *MpsService) DescribeMpsAiRecognitionTemplateById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DescribeAIRecognitionTemplates(request)
+	response, err := me.client.UseMpsClient().DescribeAIRecognitionTemplatesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -580,9 +622,11 @@ func (me *MpsService) DeleteMpsAiRecognitionTemplateById(ctx context.Context, de
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DeleteAIRecognitionTemplate(request)
+	response, err := me.client.UseMpsClient().DeleteAIRecognitionTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -604,9 +648,11 @@ func (me *MpsService) DescribeMpsPersonSampleById(ctx context.Context, personId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DescribePersonSamples(request)
+	response, err := me.client.UseMpsClient().DescribePersonSamplesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -633,9 +679,11 @@ func (me *MpsService) DeleteMpsPersonSampleById(ctx context.Context, personId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMpsClient().DeletePersonSample(request)
+	response, err := me.client.UseMpsClient().DeletePersonSampleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -68,9 +68,11 @@ needMoreItems:
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().DescribeBackups(request)
+	response, err := me.client.UseMysqlClient().DescribeBackupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -106,8 +108,10 @@ func (me *MysqlService) CreateBackup(ctx context.Context, mysqlId string) (backu
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().CreateBackup(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().CreateBackupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -127,8 +131,10 @@ func (me *MysqlService) DescribeDBZoneConfig(ctx context.Context) (sellConfigure
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeCdbZoneConfig(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeCdbZoneConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -149,8 +155,10 @@ func (me *MysqlService) DescribeBackupConfigByMysqlId(ctx context.Context, mysql
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeBackupConfig(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeBackupConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -176,8 +184,10 @@ func (me *MysqlService) ModifyBackupConfigByMysqlId(ctx context.Context, mysqlId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyBackupConfig(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().ModifyBackupConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -199,8 +209,10 @@ func (me *MysqlService) DescribeDefaultParameters(ctx context.Context, engineVer
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDefaultParams(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeDefaultParamsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -227,8 +239,10 @@ func (me *MysqlService) DescribeInstanceParameters(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeInstanceParams(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeInstanceParamsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -258,8 +272,10 @@ func (me *MysqlService) ModifyInstanceParam(ctx context.Context, instanceId stri
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyInstanceParam(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().ModifyInstanceParamWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -323,8 +339,10 @@ func (me *MysqlService) CreateAccount(ctx context.Context, mysqlId string,
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().CreateAccounts(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().CreateAccountsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -353,8 +371,10 @@ func (me *MysqlService) ModifyAccountPassword(ctx context.Context, mysqlId strin
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyAccountPassword(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().ModifyAccountPasswordWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -382,8 +402,10 @@ func (me *MysqlService) ModifyAccountMaxUserConnections(ctx context.Context, mys
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyAccountMaxUserConnections(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().ModifyAccountMaxUserConnectionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -412,8 +434,10 @@ func (me *MysqlService) UpgradeDBInstanceEngineVersion(ctx context.Context, mysq
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().UpgradeDBInstanceEngineVersion(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().UpgradeDBInstanceEngineVersionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -439,8 +463,10 @@ func (me *MysqlService) ModifyAccountHost(ctx context.Context, mysqlId, accountN
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyAccountHost(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().ModifyAccountHostWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -469,8 +495,10 @@ func (me *MysqlService) ModifyAccountDescription(ctx context.Context, mysqlId st
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyAccountDescription(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().ModifyAccountDescriptionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -498,8 +526,10 @@ func (me *MysqlService) DeleteAccount(ctx context.Context, mysqlId string,
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DeleteAccounts(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DeleteAccountsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -535,8 +565,10 @@ func (me *MysqlService) DescribeAccounts(ctx context.Context, mysqlId string) (a
 	}()
 
 needMoreItems:
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeAccounts(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeAccountsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -570,8 +602,10 @@ func (me *MysqlService) _innerDescribeAsyncRequestInfo(ctx context.Context, asyn
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeAsyncRequestInfo(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeAsyncRequestInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -608,6 +642,45 @@ func (me *MysqlService) DescribeAsyncRequestInfo(ctx context.Context, asyncReque
 	return
 }
 
+// MysqlAsyncRequestStateRefreshFunc returns the message and the status of the async request.
+func (me *MysqlService) MysqlAsyncRequestStateRefreshFunc(ctx context.Context, asyncRequestId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		status, message, err := me.DescribeAsyncRequestInfo(ctx, asyncRequestId)
+		if err != nil {
+			return nil, "", err
+		}
+		return message, status, nil
+	}
+}
+
+// WaitForAsyncRequest waits for the async request to succeed, the retryable errors are retried.
+func (me *MysqlService) WaitForAsyncRequest(ctx context.Context, asyncRequestId string, timeout time.Duration) error {
+	var (
+		message string
+		refresh = me.MysqlAsyncRequestStateRefreshFunc(ctx, asyncRequestId)
+	)
+	waiter := &helper.StateWaiter{
+		Name:    fmt.Sprintf("mysql task %s", asyncRequestId),
+		Pending: []string{MYSQL_TASK_STATUS_INITIAL, MYSQL_TASK_STATUS_RUNNING},
+		Target:  []string{MYSQL_TASK_STATUS_SUCCESS},
+		Failed:  []string{MYSQL_TASK_STATUS_FAILED, MYSQL_TASK_STATUS_REMOVED, MYSQL_TASK_STATUS_PAUSED},
+		Refresh: func() (interface{}, string, error) {
+			result, status, err := refresh()
+			if err == nil {
+				message, _ = result.(string)
+			}
+			return result, status, err
+		},
+		Retryable: retryableErrorFunc(ctx),
+		Timeout:   timeout,
+	}
+	_, err := waiter.WaitForStateContext(ctx)
+	if failedErr, ok := err.(*helper.StateFailedError); ok {
+		return fmt.Errorf("%w, we won't wait for it finish, it show message: %s", failedErr, message)
+	}
+	return err
+}
+
 func (me *MysqlService) ModifyAccountPrivileges(ctx context.Context, mysqlId string,
 	accountName, accountHost string, databaseNames []string, privileges []string) (asyncRequestId string, errRet error) {
 
@@ -638,8 +711,10 @@ func (me *MysqlService) ModifyAccountPrivileges(ctx context.Context, mysqlId str
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyAccountPrivileges(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().ModifyAccountPrivilegesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -670,8 +745,10 @@ func (me *MysqlService) DescribeAccountPrivileges(ctx context.Context, mysqlId s
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeAccountPrivileges(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeAccountPrivilegesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -757,8 +834,10 @@ func (me *MysqlService) DescribeIsolatedDBInstanceById(ctx context.Context, mysq
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDBInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -791,8 +870,10 @@ func (me *MysqlService) _innerDescribeDBInstanceById(ctx context.Context, mysqlI
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDBInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -826,8 +907,10 @@ func (me *MysqlService) DescribeRunningDBInstanceById(ctx context.Context, mysql
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDBInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -859,8 +942,10 @@ func (me *MysqlService) CheckDBGTIDOpen(ctx context.Context, mysqlId string) (op
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDBInstanceGTID(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeDBInstanceGTIDWithContext(ctx, request)
 	if err != nil {
 		sdkErr, ok := err.(*errors.TencentCloudSDKError)
 		if ok && sdkErr.Code == "CdbError" {
@@ -885,8 +970,10 @@ func (me *MysqlService) DescribeDBSecurityGroups(ctx context.Context, mysqlId st
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDBSecurityGroups(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeDBSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -932,8 +1019,10 @@ func (me *MysqlService) ModifyInstanceTag(ctx context.Context, mysqlId string, d
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyInstanceTag(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().ModifyInstanceTagWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -968,8 +1057,10 @@ again:
 	} else {
 		offset = offset + limit
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeTagsOfInstanceIds(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeTagsOfInstanceIdsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1005,8 +1096,10 @@ func (me *MysqlService) DescribeDBInstanceConfig(ctx context.Context, mysqlId st
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDBInstanceConfig(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeDBInstanceConfigWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1059,8 +1152,10 @@ func (me *MysqlService) InitDBInstances(ctx context.Context, mysqlId, password,
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().InitDBInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().InitDBInstancesWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1087,8 +1182,10 @@ func (me *MysqlService) OpenWanService(ctx context.Context, mysqlId string) (asy
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().OpenWanService(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().OpenWanServiceWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1109,8 +1206,10 @@ func (me *MysqlService) CloseWanService(ctx context.Context, mysqlId string) (as
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().CloseWanService(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().CloseWanServiceWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1131,8 +1230,10 @@ func (me *MysqlService) OpenDBInstanceGTID(ctx context.Context, mysqlId string)
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().OpenDBInstanceGTID(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().OpenDBInstanceGTIDWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1156,8 +1257,10 @@ func (me *MysqlService) ModifyDBInstanceName(ctx context.Context, mysqlId,
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, errRet := me.client.UseMysqlClient().ModifyDBInstanceName(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, errRet := me.client.UseMysqlClient().ModifyDBInstanceNameWithContext(ctx, request)
 
 	if errRet != nil {
 		return
@@ -1186,8 +1289,10 @@ func (me *MysqlService) ModifyDBInstanceVipVport(ctx context.Context, mysqlId, v
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, errRet := me.client.UseMysqlClient().ModifyDBInstanceVipVport(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, errRet := me.client.UseMysqlClient().ModifyDBInstanceVipVportWithContext(ctx, request)
 
 	if errRet != nil {
 		return
@@ -1226,8 +1331,10 @@ func (me *MysqlService) UpgradeDBInstance(ctx context.Context, mysqlId string,
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().UpgradeDBInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().UpgradeDBInstanceWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1251,8 +1358,10 @@ func (me *MysqlService) ModifyDBInstanceProject(ctx context.Context, mysqlId str
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyDBInstanceProject(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().ModifyDBInstanceProjectWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1282,8 +1391,10 @@ func (me *MysqlService) ModifyDBInstanceSecurityGroups(ctx context.Context, mysq
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyDBInstanceSecurityGroups(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().ModifyDBInstanceSecurityGroupsWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1308,8 +1419,10 @@ func (me *MysqlService) DisassociateSecurityGroup(ctx context.Context, mysqlId s
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DisassociateSecurityGroups(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DisassociateSecurityGroupsWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1334,8 +1447,10 @@ func (me *MysqlService) ModifyAutoRenewFlag(ctx context.Context, mysqlId string,
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().ModifyAutoRenewFlag(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().ModifyAutoRenewFlagWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1358,8 +1473,10 @@ func (me *MysqlService) IsolateDBInstance(ctx context.Context, mysqlId string) (
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().IsolateDBInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().IsolateDBInstanceWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1384,8 +1501,10 @@ func (me *MysqlService) OfflineIsolatedInstances(ctx context.Context, mysqlId st
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	_, errRet = me.client.UseMysqlClient().OfflineIsolatedInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, errRet = me.client.UseMysqlClient().OfflineIsolatedInstancesWithContext(ctx, request)
 
 	return
 }
@@ -1402,9 +1521,11 @@ func (me *MysqlService) DescribeMysqlTimeWindowById(ctx context.Context, instanc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().DescribeTimeWindow(request)
+	response, err := me.client.UseMysqlClient().DescribeTimeWindowWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1427,9 +1548,11 @@ func (me *MysqlService) DeleteMysqlTimeWindowById(ctx context.Context, instanceI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().DeleteTimeWindow(request)
+	response, err := me.client.UseMysqlClient().DeleteTimeWindowWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1451,9 +1574,11 @@ func (me *MysqlService) DescribeMysqlParamTemplateById(ctx context.Context, temp
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().DescribeParamTemplateInfo(request)
+	response, err := me.client.UseMysqlClient().DescribeParamTemplateInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1476,9 +1601,11 @@ func (me *MysqlService) DescribeMysqlParamTemplateInfoById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().DescribeParamTemplates(request)
+	response, err := me.client.UseMysqlClient().DescribeParamTemplatesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1505,9 +1632,11 @@ func (me *MysqlService) DeleteMysqlParamTemplateById(ctx context.Context, templa
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().DeleteParamTemplate(request)
+	response, err := me.client.UseMysqlClient().DeleteParamTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1529,7 +1658,9 @@ func (me *MysqlService) DescribeMysqlDeployGroupById(ctx context.Context, deploy
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1539,7 +1670,7 @@ func (me *MysqlService) DescribeMysqlDeployGroupById(ctx context.Context, deploy
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeDeployGroupList(request)
+		response, err := me.client.UseMysqlClient().DescribeDeployGroupListWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1576,9 +1707,11 @@ func (me *MysqlService) DeleteMysqlDeployGroupById(ctx context.Context, deployGr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().DeleteDeployGroups(request)
+	response, err := me.client.UseMysqlClient().DeleteDeployGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1600,9 +1733,11 @@ func (me *MysqlService) DescribeMysqlSecurityGroupsAttachmentById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().DescribeDBSecurityGroups(request)
+	response, err := me.client.UseMysqlClient().DescribeDBSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1635,9 +1770,11 @@ func (me *MysqlService) DeleteMysqlSecurityGroupsAttachmentById(ctx context.Cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().DisassociateSecurityGroups(request)
+	response, err := me.client.UseMysqlClient().DisassociateSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1659,9 +1796,11 @@ func (me *MysqlService) DescribeMysqlLocalBinlogConfigById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().DescribeLocalBinlogConfig(request)
+	response, err := me.client.UseMysqlClient().DescribeLocalBinlogConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1685,9 +1824,11 @@ func (me *MysqlService) DescribeMysqlAuditLogFileById(ctx context.Context, insta
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().DescribeAuditLogFiles(request)
+	response, err := me.client.UseMysqlClient().DescribeAuditLogFilesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1715,9 +1856,11 @@ func (me *MysqlService) DeleteMysqlAuditLogFileById(ctx context.Context, instanc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().DeleteAuditLogFile(request)
+	response, err := me.client.UseMysqlClient().DeleteAuditLogFileWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1759,9 +1902,11 @@ func (me *MysqlService) DescribeMysqlBackupOverviewByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().DescribeBackupOverview(request)
+	response, err := me.client.UseMysqlClient().DescribeBackupOverviewWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1799,7 +1944,9 @@ func (me *MysqlService) DescribeMysqlBackupSummariesByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1808,7 +1955,7 @@ func (me *MysqlService) DescribeMysqlBackupSummariesByFilter(ctx context.Context
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeBackupSummaries(request)
+		response, err := me.client.UseMysqlClient().DescribeBackupSummariesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1847,7 +1994,9 @@ func (me *MysqlService) DescribeMysqlBinLogByFilter(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1856,7 +2005,7 @@ func (me *MysqlService) DescribeMysqlBinLogByFilter(ctx context.Context, param m
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeBinlogs(request)
+		response, err := me.client.UseMysqlClient().DescribeBinlogsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1895,8 +2044,10 @@ func (me *MysqlService) DescribeMysqlBinlogBackupOverviewByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeBinlogBackupOverview(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeBinlogBackupOverviewWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1929,7 +2080,9 @@ func (me *MysqlService) DescribeMysqlCloneListByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1938,7 +2091,7 @@ func (me *MysqlService) DescribeMysqlCloneListByFilter(ctx context.Context, para
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeCloneList(request)
+		response, err := me.client.UseMysqlClient().DescribeCloneListWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1977,8 +2130,10 @@ func (me *MysqlService) DescribeMysqlDataBackupOverviewByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDataBackupOverview(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeDataBackupOverviewWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2012,8 +2167,10 @@ func (me *MysqlService) DescribeMysqlDbFeaturesByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDBFeatures(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeDBFeaturesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2053,7 +2210,9 @@ func (me *MysqlService) DescribeMysqlInstTablesByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -2063,7 +2222,7 @@ func (me *MysqlService) DescribeMysqlInstTablesByFilter(ctx context.Context, par
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeTables(request)
+		response, err := me.client.UseMysqlClient().DescribeTablesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2099,8 +2258,10 @@ func (me *MysqlService) DescribeMysqlInstanceCharsetByFilter(ctx context.Context
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDBInstanceCharset(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeDBInstanceCharsetWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2130,8 +2291,10 @@ func (me *MysqlService) DescribeMysqlInstanceInfoById(ctx context.Context, insta
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDBInstanceInfo(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeDBInstanceInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2165,7 +2328,9 @@ func (me *MysqlService) DescribeMysqlInstanceParamRecordByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset      int64 = 0
@@ -2175,7 +2340,7 @@ func (me *MysqlService) DescribeMysqlInstanceParamRecordByFilter(ctx context.Con
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeInstanceParamRecords(request)
+		response, err := me.client.UseMysqlClient().DescribeInstanceParamRecordsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2216,8 +2381,10 @@ func (me *MysqlService) DescribeMysqlInstanceRebootTimeByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeDBInstanceRebootTime(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeDBInstanceRebootTimeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2247,8 +2414,10 @@ func (me *MysqlService) DescribeMysqlProxyCustomById(ctx context.Context, instan
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeProxyCustomConf(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeProxyCustomConfWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2288,8 +2457,10 @@ func (me *MysqlService) DescribeMysqlRollbackRangeTimeByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeRollbackRangeTime(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeRollbackRangeTimeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2323,7 +2494,9 @@ func (me *MysqlService) DescribeMysqlSlowLogByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -2332,7 +2505,7 @@ func (me *MysqlService) DescribeMysqlSlowLogByFilter(ctx context.Context, param
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeSlowLogs(request)
+		response, err := me.client.UseMysqlClient().DescribeSlowLogsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2395,7 +2568,9 @@ func (me *MysqlService) DescribeMysqlSlowLogDataByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -2404,7 +2579,7 @@ func (me *MysqlService) DescribeMysqlSlowLogDataByFilter(ctx context.Context, pa
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeSlowLogData(request)
+		response, err := me.client.UseMysqlClient().DescribeSlowLogDataWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2439,8 +2614,10 @@ func (me *MysqlService) DescribeMysqlSupportedPrivilegesById(ctx context.Context
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeSupportedPrivileges(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeSupportedPrivilegesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2470,7 +2647,9 @@ func (me *MysqlService) DescribeMysqlSwitchRecordById(ctx context.Context, insta
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -2479,7 +2658,7 @@ func (me *MysqlService) DescribeMysqlSwitchRecordById(ctx context.Context, insta
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeDBSwitchRecords(request)
+		response, err := me.client.UseMysqlClient().DescribeDBSwitchRecordsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2518,7 +2697,9 @@ func (me *MysqlService) DescribeMysqlUploadedFilesByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -2527,7 +2708,7 @@ func (me *MysqlService) DescribeMysqlUploadedFilesByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeUploadedFiles(request)
+		response, err := me.client.UseMysqlClient().DescribeUploadedFilesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2593,7 +2774,9 @@ func (me *MysqlService) DescribeMysqlUserTaskByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -2602,7 +2785,7 @@ func (me *MysqlService) DescribeMysqlUserTaskByFilter(ctx context.Context, param
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeTasks(request)
+		response, err := me.client.UseMysqlClient().DescribeTasksWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2634,9 +2817,11 @@ func (me *MysqlService) DescribeMysqlBackupDownloadRestrictionById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().DescribeBackupDownloadRestriction(request)
+	response, err := me.client.UseMysqlClient().DescribeBackupDownloadRestrictionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2659,9 +2844,11 @@ func (me *MysqlService) DescribeMysqlBackupEncryptionStatusById(ctx context.Cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().DescribeBackupEncryptionStatus(request)
+	response, err := me.client.UseMysqlClient().DescribeBackupEncryptionStatusWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2684,7 +2871,9 @@ func (me *MysqlService) DescribeMysqlDbImportJobById(ctx context.Context, instan
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -2693,7 +2882,7 @@ func (me *MysqlService) DescribeMysqlDbImportJobById(ctx context.Context, instan
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeDBImportRecords(request)
+		response, err := me.client.UseMysqlClient().DescribeDBImportRecordsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2732,9 +2921,11 @@ func (me *MysqlService) DeleteMysqlDbImportJobById(ctx context.Context, asyncReq
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().StopDBImportJob(request)
+	response, err := me.client.UseMysqlClient().StopDBImportJobWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2756,9 +2947,11 @@ func (me *MysqlService) DeleteMysqlIsolateInstanceById(ctx context.Context, inst
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().ReleaseIsolatedDBInstances(request)
+	response, err := me.client.UseMysqlClient().ReleaseIsolatedDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2780,9 +2973,11 @@ func (me *MysqlService) DescribeMysqlPasswordComplexityById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().DescribeInstanceParams(request)
+	response, err := me.client.UseMysqlClient().DescribeInstanceParamsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2812,9 +3007,11 @@ func (me *MysqlService) DescribeMysqlProxyById(ctx context.Context, instanceId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().DescribeCdbProxyInfo(request)
+	response, err := me.client.UseMysqlClient().DescribeCdbProxyInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2846,9 +3043,11 @@ func (me *MysqlService) ModifyCdbProxyAddressVipAndVPort(ctx context.Context, pr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().ModifyCdbProxyAddressVipAndVPort(request)
+	response, err := me.client.UseMysqlClient().ModifyCdbProxyAddressVipAndVPortWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2872,9 +3071,11 @@ func (me *MysqlService) ModifyCdbProxyAddressDesc(ctx context.Context, proxyGrou
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().ModifyCdbProxyAddressDesc(request)
+	response, err := me.client.UseMysqlClient().ModifyCdbProxyAddressDescWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2900,9 +3101,11 @@ func (me *MysqlService) UpgradeCDBProxyVersion(ctx context.Context, instanceId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().UpgradeCDBProxyVersion(request)
+	response, err := me.client.UseMysqlClient().UpgradeCDBProxyVersionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2924,9 +3127,11 @@ func (me *MysqlService) DeleteMysqlProxyById(ctx context.Context, instanceId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().CloseCDBProxy(request)
+	response, err := me.client.UseMysqlClient().CloseCDBProxyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2948,9 +3153,11 @@ func (me *MysqlService) DescribeMysqlRemoteBackupConfigById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().DescribeRemoteBackupConfig(request)
+	response, err := me.client.UseMysqlClient().DescribeRemoteBackupConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2974,9 +3181,11 @@ func (me *MysqlService) DescribeMysqlRollbackById(ctx context.Context, instanceI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().DescribeRollbackTaskDetail(request)
+	response, err := me.client.UseMysqlClient().DescribeRollbackTaskDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -3002,9 +3211,11 @@ func (me *MysqlService) DeleteMysqlRollbackById(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().StopRollback(request)
+	response, err := me.client.UseMysqlClient().StopRollbackWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -3027,9 +3238,11 @@ func (me *MysqlService) DescribeMysqlRoGroupById(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseMysqlClient().DescribeRoGroups(request)
+	response, err := me.client.UseMysqlClient().DescribeRoGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -3080,7 +3293,9 @@ func (me *MysqlService) DescribeMysqlErrorLogByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -3089,7 +3304,7 @@ func (me *MysqlService) DescribeMysqlErrorLogByFilter(ctx context.Context, param
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeErrorLogData(request)
+		response, err := me.client.UseMysqlClient().DescribeErrorLogDataWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -3128,8 +3343,10 @@ func (me *MysqlService) DescribeMysqlProjectSecurityGroupByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeProjectSecurityGroups(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeProjectSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -3164,8 +3381,10 @@ func (me *MysqlService) DescribeMysqlRoMinScaleByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseMysqlClient().DescribeRoMinScale(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseMysqlClient().DescribeRoMinScaleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -3201,7 +3420,9 @@ func (me *MysqlService) DescribeMysqlDatabasesByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset   int64 = 0
@@ -3212,7 +3433,7 @@ func (me *MysqlService) DescribeMysqlDatabasesByFilter(ctx context.Context, para
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseMysqlClient().DescribeDatabases(request)
+		response, err := me.client.UseMysqlClient().DescribeDatabasesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -35,8 +35,10 @@ func (me *OrganizationService) DescribeOrganizationOrgNode(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseOrganizationClient().DescribeOrganizationNodes(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseOrganizationClient().DescribeOrganizationNodesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -83,8 +85,10 @@ func (me *OrganizationService) DeleteOrganizationOrgNodeById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseOrganizationClient().DeleteOrganizationNodes(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseOrganizationClient().DeleteOrganizationNodesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -108,7 +112,9 @@ func (me *OrganizationService) DescribeOrganizationOrgMember(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset uint64 = 0
 	var pageSize uint64 = 50
@@ -117,8 +123,10 @@ func (me *OrganizationService) DescribeOrganizationOrgMember(ctx context.Context
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseOrganizationClient().DescribeOrganizationMembers(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseOrganizationClient().DescribeOrganizationMembersWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -166,8 +174,10 @@ func (me *OrganizationService) DeleteOrganizationOrgMemberById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseOrganizationClient().DeleteOrganizationMembers(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseOrganizationClient().DeleteOrganizationMembersWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -195,7 +205,7 @@ func (me *OrganizationService) DescribeOrganizationPolicySubAccountAttachment(ct
 	request.Limit = helper.IntInt64(50)
 	request.Offset = helper.IntInt64(0)
 
-	response, err := me.client.UseOrganizationClient().DescribeOrganizationMemberAuthAccounts(request)
+	response, err := me.client.UseOrganizationClient().DescribeOrganizationMemberAuthAccountsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -227,8 +237,10 @@ func (me *OrganizationService) DeleteOrganizationPolicySubAccountAttachmentById(
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseOrganizationClient().CancelOrganizationMemberAuthAccount(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseOrganizationClient().CancelOrganizationMemberAuthAccountWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
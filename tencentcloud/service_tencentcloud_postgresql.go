@@ -87,8 +87,10 @@ func (me *PostgresqlService) CreatePostgresqlInstance(
 		request.VoucherIds = voucherIds
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UsePostgresqlClient().CreateInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UsePostgresqlClient().CreateInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -132,11 +134,13 @@ func (me *PostgresqlService) DescribeOrders(ctx context.Context, dealIds []*stri
 
 	request.DealNames = dealIds
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var response *postgresql.DescribeOrdersResponse
-	err := resource.Retry(readRetryTimeout*5, func() *resource.RetryError {
-		res, err := me.client.UsePostgresqlClient().DescribeOrders(request)
+	err := retryContext(ctx, readRetryTimeout*5, func() *resource.RetryError {
+		res, err := me.client.UsePostgresqlClient().DescribeOrdersWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -175,8 +179,10 @@ func (me *PostgresqlService) InitPostgresqlInstance(ctx context.Context, instanc
 	request.AdminPassword = &password
 	request.DBInstanceIdSet = []*string{&instanceId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UsePostgresqlClient().InitDBInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UsePostgresqlClient().InitDBInstancesWithContext(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -197,8 +203,10 @@ func (me *PostgresqlService) DescribeSpecinfos(ctx context.Context, zone string)
 	}()
 	request.Zone = &zone
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UsePostgresqlClient().DescribeProductConfig(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UsePostgresqlClient().DescribeProductConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -221,8 +229,10 @@ func (me *PostgresqlService) ModifyBackupPlan(ctx context.Context, request *post
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UsePostgresqlClient().ModifyBackupPlan(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UsePostgresqlClient().ModifyBackupPlanWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -244,8 +254,10 @@ func (me *PostgresqlService) DescribeBackupPlans(ctx context.Context, request *p
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UsePostgresqlClient().DescribeBackupPlans(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UsePostgresqlClient().DescribeBackupPlansWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -274,9 +286,11 @@ func (me *PostgresqlService) DescribeDBXlogs(ctx context.Context, request *postg
 	offset := 0
 	request.Limit = helper.IntInt64(100)
 	request.Offset = helper.IntInt64(offset)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UsePostgresqlClient().DescribeDBXlogs(request)
+	response, err := me.client.UsePostgresqlClient().DescribeDBXlogsWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -304,11 +318,13 @@ func (me *PostgresqlService) ModifyPublicService(ctx context.Context, openIntern
 	if openInternet {
 		request := postgresql.NewOpenDBExtranetAccessRequest()
 		request.DBInstanceId = &instanceId
-		ratelimit.Check(request.GetAction())
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
 
 		var response *postgresql.OpenDBExtranetAccessResponse
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			resp, err := me.client.UsePostgresqlClient().OpenDBExtranetAccess(request)
+		err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+			resp, err := me.client.UsePostgresqlClient().OpenDBExtranetAccessWithContext(ctx, request)
 			if err != nil {
 				return retryError(err, postgresql.OPERATIONDENIED_INSTANCESTATUSLIMITOPERROR)
 			}
@@ -328,7 +344,7 @@ func (me *PostgresqlService) ModifyPublicService(ctx context.Context, openIntern
 		startProgressRetries := 5
 
 		// check open or not
-		err = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, 3*readRetryTimeout, func() *resource.RetryError {
 			instance, has, err := me.DescribePostgresqlInstanceById(ctx, instanceId)
 			if err != nil {
 				return retryError(err)
@@ -366,11 +382,13 @@ func (me *PostgresqlService) ModifyPublicService(ctx context.Context, openIntern
 	} else {
 		request := postgresql.NewCloseDBExtranetAccessRequest()
 		request.DBInstanceId = &instanceId
-		ratelimit.Check(request.GetAction())
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
 
 		var response *postgresql.CloseDBExtranetAccessResponse
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			resp, err := me.client.UsePostgresqlClient().CloseDBExtranetAccess(request)
+		err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+			resp, err := me.client.UsePostgresqlClient().CloseDBExtranetAccessWithContext(ctx, request)
 			if err != nil {
 				return retryError(err, postgresql.OPERATIONDENIED_INSTANCESTATUSLIMITOPERROR)
 			}
@@ -386,7 +404,7 @@ func (me *PostgresqlService) ModifyPublicService(ctx context.Context, openIntern
 		}
 		startProgressRetries := 5
 		// check close or not
-		err = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, 3*readRetryTimeout, func() *resource.RetryError {
 			instance, has, err := me.DescribePostgresqlInstanceById(ctx, instanceId)
 			if err != nil {
 				return retryError(err)
@@ -432,8 +450,10 @@ func (me *PostgresqlService) DescribePostgresqlInstanceById(ctx context.Context,
 	}()
 	request.DBInstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UsePostgresqlClient().DescribeDBInstanceAttribute(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UsePostgresqlClient().DescribeDBInstanceAttributeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -465,8 +485,10 @@ func (me *PostgresqlService) DescribePostgresqlInstances(ctx context.Context, fi
 		request.Offset = &offset
 		request.Limit = &limit
 		request.Filters = filter
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UsePostgresqlClient().DescribeDBInstances(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UsePostgresqlClient().DescribeDBInstancesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -493,8 +515,10 @@ func (me *PostgresqlService) ModifyPostgresqlInstanceName(ctx context.Context, i
 	request.DBInstanceId = &instanceId
 	request.InstanceName = &name
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UsePostgresqlClient().ModifyDBInstanceName(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UsePostgresqlClient().ModifyDBInstanceNameWithContext(ctx, request)
 	return err
 }
 
@@ -510,8 +534,10 @@ func (me *PostgresqlService) UpgradePostgresqlInstance(ctx context.Context, inst
 	request.Storage = helper.IntUint64(storage)
 	request.Memory = helper.IntUint64(memory)
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UsePostgresqlClient().ModifyDBInstanceSpec(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UsePostgresqlClient().ModifyDBInstanceSpecWithContext(ctx, request)
 	return err
 }
 
@@ -526,8 +552,10 @@ func (me *PostgresqlService) ModifyPostgresqlInstanceProjectId(ctx context.Conte
 	request.DBInstanceIdSet = []*string{&instanceId}
 	request.ProjectId = helper.String(strconv.Itoa(projectId))
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UsePostgresqlClient().ModifyDBInstancesProject(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UsePostgresqlClient().ModifyDBInstancesProjectWithContext(ctx, request)
 	return err
 }
 
@@ -542,8 +570,10 @@ func (me *PostgresqlService) SetPostgresqlInstanceAutoRenewFlag(ctx context.Cont
 	request.DBInstanceIdSet = []*string{&instanceId}
 	request.AutoRenewFlag = helper.IntInt64(autoRenewFlag)
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UsePostgresqlClient().SetAutoRenewFlag(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UsePostgresqlClient().SetAutoRenewFlagWithContext(ctx, request)
 	return err
 }
 
@@ -552,15 +582,17 @@ func (me *PostgresqlService) IsolatePostgresqlInstance(ctx context.Context, inst
 
 	request := postgresql.NewIsolateDBInstancesRequest()
 	request.DBInstanceIdSet = []*string{&instanceId}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail,reason[%s]", logId, request.GetAction(), errRet.Error())
 		}
 	}()
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		_, errRet = me.client.UsePostgresqlClient().IsolateDBInstances(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		_, errRet = me.client.UsePostgresqlClient().IsolateDBInstancesWithContext(ctx, request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s describe account failed, reason: %v", logId, errRet)
 			return retryError(errRet)
@@ -580,8 +612,10 @@ func (me *PostgresqlService) DeletePostgresqlInstance(ctx context.Context, insta
 	}()
 	request.DBInstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UsePostgresqlClient().DestroyDBInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UsePostgresqlClient().DestroyDBInstanceWithContext(ctx, request)
 	return err
 }
 
@@ -597,8 +631,10 @@ func (me *PostgresqlService) SetPostgresqlInstanceRootPassword(ctx context.Conte
 	request.UserName = helper.String("root")
 	request.Password = &password
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UsePostgresqlClient().ResetAccountPassword(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UsePostgresqlClient().ResetAccountPasswordWithContext(ctx, request)
 	return err
 }
 
@@ -610,7 +646,7 @@ func (me *PostgresqlService) CheckDBInstanceStatus(ctx context.Context, instance
 		timeout = time.Minute * time.Duration(times)
 	}
 	// check status
-	err := resource.Retry(timeout, func() *resource.RetryError {
+	err := retryContext(ctx, timeout, func() *resource.RetryError {
 		instance, has, err := me.DescribePostgresqlInstanceById(ctx, instanceId)
 		if err != nil {
 			return retryError(err)
@@ -637,8 +673,8 @@ func (me *PostgresqlService) DescribeRootUser(ctx context.Context, instanceId st
 	request.OrderBy = &orderBy
 	request.OrderByType = &orderByType
 	var response *postgresql.DescribeAccountsResponse
-	errRet = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
-		response, errRet = me.client.UsePostgresqlClient().DescribeAccounts(request)
+	errRet = retryContext(ctx, 2*readRetryTimeout, func() *resource.RetryError {
+		response, errRet = me.client.UsePostgresqlClient().DescribeAccountsWithContext(ctx, request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s describe account failed, reason: %v", logId, errRet)
 			return retryError(errRet)
@@ -669,7 +705,7 @@ func (me *PostgresqlService) ModifyPgParams(ctx context.Context, instanceId stri
 			ExpectedValue: helper.String(value),
 		})
 	}
-	_, err = me.client.UsePostgresqlClient().ModifyDBInstanceParameters(request)
+	_, err = me.client.UsePostgresqlClient().ModifyDBInstanceParametersWithContext(ctx, request)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s modify pgInstance parameter failed, reason: %v", logId, err)
@@ -684,8 +720,8 @@ func (me *PostgresqlService) DescribePgParams(ctx context.Context, instanceId st
 	stateCheckRequest.DBInstanceId = &instanceId
 
 	// wait for param effective
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		stateCheckResponse, inErr := me.client.UsePostgresqlClient().DescribeParamsEvent(stateCheckRequest)
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		stateCheckResponse, inErr := me.client.UsePostgresqlClient().DescribeParamsEventWithContext(ctx, stateCheckRequest)
 		if inErr != nil {
 			ee, ok := inErr.(*sdkErrors.TencentCloudSDKError)
 			if ok && ee.GetCode() == "ResourceNotFound.InstanceNotFoundError" {
@@ -715,7 +751,7 @@ func (me *PostgresqlService) DescribePgParams(ctx context.Context, instanceId st
 	request := postgresql.NewDescribeDBInstanceParametersRequest()
 	request.DBInstanceId = &instanceId
 
-	response, err := me.client.UsePostgresqlClient().DescribeDBInstanceParameters(request)
+	response, err := me.client.UsePostgresqlClient().DescribeDBInstanceParametersWithContext(ctx, request)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s fetch pg instance parameter failed, reason: %v", logId, err)
@@ -739,8 +775,10 @@ func (me *PostgresqlService) ModifyDBInstanceDeployment(ctx context.Context, req
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UsePostgresqlClient().ModifyDBInstanceDeployment(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UsePostgresqlClient().ModifyDBInstanceDeploymentWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -762,8 +800,10 @@ func (me *PostgresqlService) DescribeDBInstanceAttribute(ctx context.Context, re
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UsePostgresqlClient().DescribeDBInstanceAttribute(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UsePostgresqlClient().DescribeDBInstanceAttributeWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -787,8 +827,10 @@ func (me *PostgresqlService) DescribeDBEncryptionKeys(ctx context.Context, reque
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UsePostgresqlClient().DescribeEncryptionKeys(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UsePostgresqlClient().DescribeEncryptionKeysWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -820,8 +862,10 @@ func (me *PostgresqlService) DescribePostgresqlReadonlyGroups(ctx context.Contex
 		request.PageNumber = &offset
 		request.PageSize = &limit
 		request.Filters = filter
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UsePostgresqlClient().DescribeReadOnlyGroups(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UsePostgresqlClient().DescribeReadOnlyGroupsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -859,8 +903,10 @@ func (me *PostgresqlService) DescribePostgresqlReadOnlyGroupById(ctx context.Con
 	for {
 		request.PageNumber = &offset
 		request.PageSize = &limit
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UsePostgresqlClient().DescribeReadOnlyGroups(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UsePostgresqlClient().DescribeReadOnlyGroupsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -886,8 +932,10 @@ func (me *PostgresqlService) DeletePostgresqlReadOnlyGroupById(ctx context.Conte
 	}()
 	request.ReadOnlyGroupId = &groupId
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UsePostgresqlClient().DeleteReadOnlyGroup(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UsePostgresqlClient().DeleteReadOnlyGroupWithContext(ctx, request)
 	return err
 }
 
@@ -915,7 +963,9 @@ func (me *PostgresqlService) DescribePostgresqlParameterTemplatesByFilter(ctx co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -924,7 +974,7 @@ func (me *PostgresqlService) DescribePostgresqlParameterTemplatesByFilter(ctx co
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UsePostgresqlClient().DescribeParameterTemplates(request)
+		response, err := me.client.UsePostgresqlClient().DescribeParameterTemplatesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -957,9 +1007,11 @@ func (me *PostgresqlService) DescribePostgresqlParameterTemplateById(ctx context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UsePostgresqlClient().DescribeParameterTemplateAttributes(request)
+	response, err := me.client.UsePostgresqlClient().DescribeParameterTemplateAttributesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -982,9 +1034,11 @@ func (me *PostgresqlService) DeletePostgresqlParameterTemplateById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UsePostgresqlClient().DeleteParameterTemplate(request)
+	response, err := me.client.UsePostgresqlClient().DeleteParameterTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1032,8 +1086,10 @@ func (me *PostgresqlService) DescribePostgresqlReadonlyGroupsByFilter(ctx contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UsePostgresqlClient().DescribeReadOnlyGroups(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UsePostgresqlClient().DescribeReadOnlyGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1060,9 +1116,11 @@ func (me *PostgresqlService) DescribePostgresqlBackupPlanConfigById(ctx context.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UsePostgresqlClient().DescribeBackupPlans(request)
+	response, err := me.client.UsePostgresqlClient().DescribeBackupPlansWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1088,9 +1146,11 @@ func (me *PostgresqlService) DescribePostgresqlBackupDownloadRestrictionConfigBy
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UsePostgresqlClient().DescribeBackupDownloadRestriction(request)
+	response, err := me.client.UsePostgresqlClient().DescribeBackupDownloadRestrictionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1119,9 +1179,11 @@ func (me *PostgresqlService) DescribePostgresqlSecurityGroupConfigById(ctx conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UsePostgresqlClient().DescribeDBInstanceSecurityGroups(request)
+	response, err := me.client.UsePostgresqlClient().DescribeDBInstanceSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1186,9 +1248,11 @@ func (me *PostgresqlService) DescribePostgresqlDBInstanceNetInfosById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UsePostgresqlClient().DescribeDBInstanceAttribute(request)
+	response, err := me.client.UsePostgresqlClient().DescribeDBInstanceAttributeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1218,9 +1282,11 @@ func (me *PostgresqlService) DeletePostgresqlDBInstanceNetworkAccessById(ctx con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UsePostgresqlClient().DeleteDBInstanceNetworkAccess(request)
+	response, err := me.client.UsePostgresqlClient().DeleteDBInstanceNetworkAccessWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1316,9 +1382,11 @@ func (me *PostgresqlService) DeletePostgresqlReadonlyGroupNetworkAccessById(ctx
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UsePostgresqlClient().DeleteReadOnlyGroupNetworkAccess(request)
+	response, err := me.client.UsePostgresqlClient().DeleteReadOnlyGroupNetworkAccessWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1425,8 +1493,10 @@ func (me *PostgresqlService) DescribePostgresqlBackupDownloadUrlsByFilter(ctx co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UsePostgresqlClient().DescribeBackupDownloadURL(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UsePostgresqlClient().DescribeBackupDownloadURLWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1471,7 +1541,9 @@ func (me *PostgresqlService) DescribePostgresqlBaseBackupsByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1480,7 +1552,7 @@ func (me *PostgresqlService) DescribePostgresqlBaseBackupsByFilter(ctx context.C
 	for {
 		request.Offset = helper.Int64Uint64(offset)
 		request.Limit = helper.Int64Uint64(limit)
-		response, err := me.client.UsePostgresqlClient().DescribeBaseBackups(request)
+		response, err := me.client.UsePostgresqlClient().DescribeBaseBackupsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1531,7 +1603,9 @@ func (me *PostgresqlService) DescribePostgresqlLogBackupsByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -1540,7 +1614,7 @@ func (me *PostgresqlService) DescribePostgresqlLogBackupsByFilter(ctx context.Co
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UsePostgresqlClient().DescribeLogBackups(request)
+		response, err := me.client.UsePostgresqlClient().DescribeLogBackupsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1585,9 +1659,11 @@ func (me *PostgresqlService) DescribePostgresqlDbInstanceClassesByFilter(ctx con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UsePostgresqlClient().DescribeClasses(request)
+	response, err := me.client.UsePostgresqlClient().DescribeClassesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1623,9 +1699,11 @@ func (me *PostgresqlService) DescribePostgresqlDefaultParametersByFilter(ctx con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UsePostgresqlClient().DescribeDefaultParameters(request)
+	response, err := me.client.UsePostgresqlClient().DescribeDefaultParametersWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1658,9 +1736,11 @@ func (me *PostgresqlService) DescribePostgresqlRecoveryTimeByFilter(ctx context.
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UsePostgresqlClient().DescribeAvailableRecoveryTime(request)
+	response, err := me.client.UsePostgresqlClient().DescribeAvailableRecoveryTimeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1687,9 +1767,11 @@ func (me *PostgresqlService) DescribePostgresqlRegionsByFilter(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UsePostgresqlClient().DescribeRegions(request)
+	response, err := me.client.UsePostgresqlClient().DescribeRegionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1716,9 +1798,11 @@ func (me *PostgresqlService) DescribePostgresqlDbInstanceVersionsByFilter(ctx co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UsePostgresqlClient().DescribeDBVersions(request)
+	response, err := me.client.UsePostgresqlClient().DescribeDBVersionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1745,9 +1829,11 @@ func (me *PostgresqlService) DescribePostgresqlZonesByFilter(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UsePostgresqlClient().DescribeZones(request)
+	response, err := me.client.UsePostgresqlClient().DescribeZonesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1802,10 +1888,12 @@ func (me *PostgresqlService) DeletePostgresqlBaseBackupById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	errRet = resource.Retry(3*writeRetryTimeout, func() *resource.RetryError {
-		response, e := me.client.UsePostgresqlClient().DeleteBaseBackup(request)
+	errRet = retryContext(ctx, 3*writeRetryTimeout, func() *resource.RetryError {
+		response, e := me.client.UsePostgresqlClient().DeleteBaseBackupWithContext(ctx, request)
 		if e != nil {
 			tcErr := e.(*sdkErrors.TencentCloudSDKError)
 
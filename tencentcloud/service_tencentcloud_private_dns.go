@@ -48,13 +48,17 @@ getMoreData:
 	}
 	var response *privatedns.DescribePrivateZoneRecordListResponse
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 	request.Limit = &limit
 	request.Offset = &offset
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, err := me.client.UsePrivateDnsClient().DescribePrivateZoneRecordList(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, err := me.client.UsePrivateDnsClient().DescribePrivateZoneRecordListWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -90,9 +94,11 @@ func (me *PrivateDnsService) DescribePrivateDnsZoneVpcAttachmentById(ctx context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UsePrivateDnsClient().DescribePrivateZone(request)
+	response, err := me.client.UsePrivateDnsClient().DescribePrivateZoneWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -137,9 +143,11 @@ func (me *PrivateDnsService) DeletePrivateDnsZoneVpcAttachmentById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UsePrivateDnsClient().DeleteSpecifyPrivateZoneVpc(request)
+	response, err := me.client.UsePrivateDnsClient().DeleteSpecifyPrivateZoneVpcWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
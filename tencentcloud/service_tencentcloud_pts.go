@@ -27,7 +27,7 @@ func (me *PtsService) DescribePtsProject(ctx context.Context, projectId string)
 	}()
 	request.ProjectIds = []*string{&projectId}
 
-	response, err := me.client.UsePtsClient().DescribeProjects(request)
+	response, err := me.client.UsePtsClient().DescribeProjectsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -57,8 +57,10 @@ func (me *PtsService) DeletePtsProjectById(ctx context.Context, projectId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UsePtsClient().DeleteProjects(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UsePtsClient().DeleteProjectsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -84,7 +86,7 @@ func (me *PtsService) DescribePtsAlertChannel(ctx context.Context, noticeId, pro
 	request.NoticeIds = []*string{&noticeId}
 	request.ProjectIds = []*string{&projectId}
 
-	response, err := me.client.UsePtsClient().DescribeAlertChannels(request)
+	response, err := me.client.UsePtsClient().DescribeAlertChannelsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -115,8 +117,10 @@ func (me *PtsService) DeletePtsAlertChannelById(ctx context.Context, noticeId, p
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UsePtsClient().DeleteAlertChannel(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UsePtsClient().DeleteAlertChannelWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -142,7 +146,7 @@ func (me *PtsService) DescribePtsScenario(ctx context.Context, projectId, scenar
 	request.ProjectIds = []*string{&projectId}
 	request.ScenarioIds = []*string{&scenarioId}
 
-	response, err := me.client.UsePtsClient().DescribeScenarios(request)
+	response, err := me.client.UsePtsClient().DescribeScenariosWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -173,8 +177,10 @@ func (me *PtsService) DeletePtsScenarioById(ctx context.Context, projectId, scen
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UsePtsClient().DeleteScenarios(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UsePtsClient().DeleteScenariosWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -200,7 +206,7 @@ func (me *PtsService) DescribePtsFile(ctx context.Context, projectId, fileIds st
 	request.ProjectIds = []*string{&projectId}
 	request.FileIds = []*string{&fileIds}
 
-	response, err := me.client.UsePtsClient().DescribeFiles(request)
+	response, err := me.client.UsePtsClient().DescribeFilesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -231,8 +237,10 @@ func (me *PtsService) DeletePtsFileById(ctx context.Context, projectId, fileIds
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UsePtsClient().DeleteFiles(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UsePtsClient().DeleteFilesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -259,7 +267,7 @@ func (me *PtsService) DescribePtsJob(ctx context.Context, projectId, scenarioId,
 	request.ScenarioIds = []*string{&scenarioId}
 	request.JobIds = []*string{&jobId}
 
-	response, err := me.client.UsePtsClient().DescribeJobs(request)
+	response, err := me.client.UsePtsClient().DescribeJobsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -291,8 +299,10 @@ func (me *PtsService) DeletePtsJobById(ctx context.Context, projectId, scenarioI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UsePtsClient().DeleteJobs(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UsePtsClient().DeleteJobsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -318,7 +328,7 @@ func (me *PtsService) DescribePtsCronJob(ctx context.Context, cronJobId, project
 	request.CronJobIds = []*string{&cronJobId}
 	request.ProjectIds = []*string{&projectId}
 
-	response, err := me.client.UsePtsClient().DescribeCronJobs(request)
+	response, err := me.client.UsePtsClient().DescribeCronJobsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -349,8 +359,10 @@ func (me *PtsService) DeletePtsCronJobById(ctx context.Context, cronJobId, proje
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UsePtsClient().DeleteCronJobs(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UsePtsClient().DeleteCronJobsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
@@ -42,14 +41,14 @@ type TencentCloudRedisDetail struct {
 	NodeInfo         []map[string]interface{}
 }
 
-func (me *RedisService) fullZoneId() (errRet error) {
+func (me *RedisService) fullZoneId(ctx context.Context) (errRet error) {
 	if me.zoneMap == nil {
 		me.zoneMap = make(map[int64]string)
 	}
 	if len(me.zoneMap) != 0 {
 		return
 	}
-	response, err := me.client.UseCvmClient().DescribeZones(cvm.NewDescribeZonesRequest())
+	response, err := me.client.UseCvmClient().DescribeZonesWithContext(ctx, cvm.NewDescribeZonesRequest())
 	if err != nil {
 		return err
 	}
@@ -64,8 +63,8 @@ func (me *RedisService) fullZoneId() (errRet error) {
 	return nil
 }
 
-func (me *RedisService) getZoneId(name string) (id int64, errRet error) {
-	if errRet = me.fullZoneId(); errRet != nil {
+func (me *RedisService) getZoneId(ctx context.Context, name string) (id int64, errRet error) {
+	if errRet = me.fullZoneId(ctx); errRet != nil {
 		return
 	}
 	for key, value := range me.zoneMap {
@@ -78,8 +77,8 @@ func (me *RedisService) getZoneId(name string) (id int64, errRet error) {
 	return
 }
 
-func (me *RedisService) getZoneName(id int64) (name string, errRet error) {
-	if errRet = me.fullZoneId(); errRet != nil {
+func (me *RedisService) getZoneName(ctx context.Context, id int64) (name string, errRet error) {
+	if errRet = me.fullZoneId(ctx); errRet != nil {
 		return
 	}
 	name = me.zoneMap[id]
@@ -98,8 +97,10 @@ func (me *RedisService) DescribeRedisZoneConfig(ctx context.Context) (sellConfig
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().DescribeProductInfo(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().DescribeProductInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -116,7 +117,7 @@ func (me *RedisService) DescribeInstances(ctx context.Context, zoneName, searchK
 	var zoneId int64 = -1
 
 	if zoneName != "" {
-		zoneId, errRet = me.getZoneId(zoneName)
+		zoneId, errRet = me.getZoneId(ctx, zoneName)
 		if errRet != nil {
 			return
 		}
@@ -150,8 +151,10 @@ needMoreItems:
 	if projectId >= 0 {
 		request.ProjectIds = []*int64{&projectId}
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().DescribeInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().DescribeInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -174,7 +177,7 @@ needMoreItems:
 			instance.Status = REDIS_STATUS[*item.Status]
 		}
 
-		name, err := me.getZoneName(*item.ZoneId)
+		name, err := me.getZoneName(ctx, *item.ZoneId)
 		if err != nil {
 			errRet = err
 			return
@@ -267,7 +270,7 @@ func (me *RedisService) CreateInstances(ctx context.Context,
 
 	// zone
 	var intZoneId int64
-	intZoneId, errRet = me.getZoneId(zoneName)
+	intZoneId, errRet = me.getZoneId(ctx, zoneName)
 	if errRet != nil {
 		return
 	}
@@ -338,8 +341,10 @@ func (me *RedisService) CreateInstances(ctx context.Context,
 	if paramsTemplateId != "" {
 		request.TemplateId = &paramsTemplateId
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().CreateInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().CreateInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -367,42 +372,32 @@ func (me *RedisService) CheckRedisOnlineOk(ctx context.Context, redisId string,
 	request.InstanceId = &redisId
 
 	// Post https://cdb.tencentcloudapi.com/: always get "Gateway Time-out"
-	var response *redis.DescribeInstancesResponse
-	err := resource.Retry(retryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseRedisClient().DescribeInstances(request)
-		if e != nil {
-			log.Printf("[CRITAL]%s CheckRedisOnlineOk fail, reason:%s\n", logId, e.Error())
-			return retryError(e)
-		}
-		response = result
-
-		if len(response.Response.InstanceSet) == 0 {
-			has = false
-			return resource.NonRetryableError(fmt.Errorf("instance %s not exist", redisId))
-		}
-
-		info = response.Response.InstanceSet[0]
-		has = true
-
-		if *info.Status == REDIS_STATUS_ONLINE {
-			online = true
-			return nil
-		}
-
-		if *info.Status == REDIS_STATUS_INIT || *info.Status == REDIS_STATUS_PROCESSING {
-			online = false
-			return resource.RetryableError(fmt.Errorf("istance %s status is %d, retrying", redisId, *info.Status))
-		}
-
-		return nil
-	})
-
-	if err != nil {
-		errRet = err
-		return
-	}
-
+	waiter := &helper.StateWaiter{
+		Name:    fmt.Sprintf("redis instance %s", redisId),
+		Pending: []string{REDIS_STATUS[REDIS_STATUS_INIT], REDIS_STATUS[REDIS_STATUS_PROCESSING]},
+		Target:  []string{REDIS_STATUS[REDIS_STATUS_ONLINE], REDIS_STATUS[REDIS_STATUS_ISOLATE], REDIS_STATUS[REDIS_STATUS_TODELETE]},
+		Refresh: func() (interface{}, string, error) {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return nil, "", err
+			}
+			result, e := me.client.UseRedisClient().DescribeInstancesWithContext(ctx, request)
+			if e != nil {
+				log.Printf("[CRITAL]%s CheckRedisOnlineOk fail, reason:%s\n", logId, e.Error())
+				return nil, "", e
+			}
+			if len(result.Response.InstanceSet) == 0 {
+				has = false
+				return nil, "", nil
+			}
+			info = result.Response.InstanceSet[0]
+			has = true
+			online = *info.Status == REDIS_STATUS_ONLINE
+			return info, redisStatusName(*info.Status), nil
+		},
+		Retryable: retryableErrorFunc(ctx),
+		Timeout:   retryTimeout,
+	}
+	_, errRet = waiter.WaitForStateContext(ctx)
 	return
 }
 
@@ -417,29 +412,43 @@ func (me *RedisService) CheckRedisUpdateOk(ctx context.Context, redisId string)
 		}
 	}()
 	request.InstanceId = &redisId
-	errRet = resource.Retry(readRetryTimeout*20, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, err := me.client.UseRedisClient().DescribeInstances(request)
-		if err != nil {
-			return retryError(err)
-		}
-		if len(result.Response.InstanceSet) == 0 {
-			return resource.NonRetryableError(fmt.Errorf("redis %s not exist", redisId))
-		}
-		info := result.Response.InstanceSet[0]
-		if !startUpdate && *info.Status == REDIS_STATUS_ONLINE {
-			return resource.RetryableError(fmt.Errorf("waiting for upgrade start"))
-		}
-		startUpdate = true
-		if *info.Status == REDIS_STATUS_PROCESSING || *info.Status == REDIS_STATUS_INIT {
-			return resource.RetryableError(fmt.Errorf("instance %s status is %d", redisId, *info.Status))
-		}
-		return nil
-	})
-
+	waiter := &helper.StateWaiter{
+		Name:    fmt.Sprintf("redis instance %s", redisId),
+		Pending: []string{"waiting for upgrade start", REDIS_STATUS[REDIS_STATUS_INIT], REDIS_STATUS[REDIS_STATUS_PROCESSING]},
+		Target:  []string{REDIS_STATUS[REDIS_STATUS_ONLINE], REDIS_STATUS[REDIS_STATUS_ISOLATE], REDIS_STATUS[REDIS_STATUS_TODELETE]},
+		Refresh: func() (interface{}, string, error) {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return nil, "", err
+			}
+			result, err := me.client.UseRedisClient().DescribeInstancesWithContext(ctx, request)
+			if err != nil {
+				return nil, "", err
+			}
+			if len(result.Response.InstanceSet) == 0 {
+				return nil, "", nil
+			}
+			info := result.Response.InstanceSet[0]
+			if !startUpdate && *info.Status == REDIS_STATUS_ONLINE {
+				return info, "waiting for upgrade start", nil
+			}
+			startUpdate = true
+			return info, redisStatusName(*info.Status), nil
+		},
+		Retryable: retryableErrorFunc(ctx),
+		Timeout:   readRetryTimeout * 20,
+	}
+	_, errRet = waiter.WaitForStateContext(ctx)
 	return
 }
 
+// redisStatusName returns the name of the instance status in REDIS_STATUS, or the status itself.
+func redisStatusName(status int64) string {
+	if name, ok := REDIS_STATUS[status]; ok {
+		return name
+	}
+	return helper.Int64ToStr(status)
+}
+
 func (me *RedisService) CheckRedisDestroyOk(ctx context.Context, redisId string) (has bool,
 	isolated bool,
 	errRet error) {
@@ -458,9 +467,11 @@ func (me *RedisService) CheckRedisDestroyOk(ctx context.Context, redisId string)
 
 	// Post https://cdb.tencentcloudapi.com/: always get "Gateway Time-out"
 	var response *redis.DescribeInstancesResponse
-	err := resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseRedisClient().DescribeInstances(request)
+	err := retryContext(ctx, 10*readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := me.client.UseRedisClient().DescribeInstancesWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s CheckRedisDestroyOk fail, reason:%s\n", logId, e.Error())
 			return retryError(e)
@@ -511,9 +522,11 @@ func (me *RedisService) DescribeInstanceDealDetail(ctx context.Context, dealId s
 
 	// Post https://cdb.tencentcloudapi.com/: always get "Gateway Time-out"
 	var response *redis.DescribeInstanceDealDetailResponse
-	err := resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseRedisClient().DescribeInstanceDealDetail(request)
+	err := retryContext(ctx, 10*readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := me.client.UseRedisClient().DescribeInstanceDealDetailWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s DescribeInstanceDealDetail fail, reason:%s\n", logId, e.Error())
 			return retryError(e)
@@ -567,8 +580,10 @@ func (me *RedisService) ModifyInstanceName(ctx context.Context, redisId string,
 	request.InstanceName = &name
 	request.Operation = &op
 	request.InstanceId = &redisId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().ModifyInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().ModifyInstanceWithContext(ctx, request)
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
@@ -591,8 +606,10 @@ func (me *RedisService) ModifyInstanceProjectId(ctx context.Context, redisId str
 	request.ProjectId = &projectId
 	request.Operation = &op
 	request.InstanceId = &redisId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().ModifyInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().ModifyInstanceWithContext(ctx, request)
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
@@ -611,8 +628,10 @@ func (me *RedisService) DescribeInstanceSecurityGroup(ctx context.Context, redis
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().DescribeInstanceSecurityGroup(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().DescribeInstanceSecurityGroupWithContext(ctx, request)
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
@@ -648,8 +667,10 @@ func (me *RedisService) DescribeDBSecurityGroups(ctx context.Context, product st
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().DescribeDBSecurityGroups(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().DescribeDBSecurityGroupsWithContext(ctx, request)
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
@@ -681,8 +702,10 @@ func (me *RedisService) ModifyDBInstanceSecurityGroups(ctx context.Context, prod
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().ModifyDBInstanceSecurityGroups(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().ModifyDBInstanceSecurityGroupsWithContext(ctx, request)
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
@@ -701,8 +724,10 @@ func (me *RedisService) DestroyPostpaidInstance(ctx context.Context, redisId str
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().DestroyPostpaidInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().DestroyPostpaidInstanceWithContext(ctx, request)
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
@@ -727,9 +752,11 @@ func (me *RedisService) DestroyPrepaidInstance(ctx context.Context, redisId stri
 	}()
 	// For prepaid instance, deal status synchronization will take some time so need to retry.
 	var response *redis.DestroyPrepaidInstanceResponse
-	err := resource.Retry(5*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseRedisClient().DestroyPrepaidInstance(request)
+	err := retryContext(ctx, 5*writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := me.client.UseRedisClient().DestroyPrepaidInstanceWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s DestroyPrepaidInstance fail, reason:%s\n", logId, e.Error())
 			return retryError(e)
@@ -759,9 +786,11 @@ func (me *RedisService) CleanUpInstance(ctx context.Context, redisId string) (ta
 	}()
 	// Cleaning up action for prepaid instances needs to retry.
 	var response *redis.CleanUpInstanceResponse
-	err := resource.Retry(6*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseRedisClient().CleanUpInstance(request)
+	err := retryContext(ctx, 6*writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := me.client.UseRedisClient().CleanUpInstanceWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s CleanUpInstance fail, reason:%s\n", logId, e.Error())
 			return retryError(e)
@@ -801,8 +830,10 @@ func (me *RedisService) UpgradeInstance(ctx context.Context, redisId string, new
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().UpgradeInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().UpgradeInstanceWithContext(ctx, request)
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
@@ -827,8 +858,10 @@ func (me *RedisService) DescribeTaskInfo(ctx context.Context, redisId string, ta
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().DescribeTaskInfo(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().DescribeTaskInfoWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -845,6 +878,39 @@ func (me *RedisService) DescribeTaskInfo(ctx context.Context, redisId string, ta
 	return
 }
 
+// RedisTaskStateRefreshFunc returns the status of the task, such as preparing, running and succeed.
+func (me *RedisService) RedisTaskStateRefreshFunc(ctx context.Context, taskId int64) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		var uintTaskId = uint64(taskId)
+		request := redis.NewDescribeTaskInfoRequest()
+		request.TaskId = &uintTaskId
+
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return nil, "", err
+		}
+		response, err := me.client.UseRedisClient().DescribeTaskInfoWithContext(ctx, request)
+		if err != nil {
+			return nil, "", err
+		}
+		return response.Response, *response.Response.Status, nil
+	}
+}
+
+// WaitForTask waits for the task to succeed, the retryable errors are retried.
+func (me *RedisService) WaitForTask(ctx context.Context, taskId int64, timeout time.Duration) error {
+	waiter := &helper.StateWaiter{
+		Name:      fmt.Sprintf("redis task %d", taskId),
+		Pending:   []string{REDIS_TASK_PREPARING, REDIS_TASK_RUNNING},
+		Target:    []string{REDIS_TASK_SUCCEED},
+		Failed:    []string{REDIS_TASK_FAILED, REDIS_TASK_ERROR},
+		Refresh:   me.RedisTaskStateRefreshFunc(ctx, taskId),
+		Retryable: retryableErrorFunc(ctx),
+		Timeout:   timeout,
+	}
+	_, err := waiter.WaitForStateContext(ctx)
+	return err
+}
+
 func (me *RedisService) ResetPassword(ctx context.Context, redisId string, newPassword string, noAuth bool) (taskId int64, errRet error) {
 	logId := getLogId(ctx)
 
@@ -858,9 +924,11 @@ func (me *RedisService) ResetPassword(ctx context.Context, redisId string, newPa
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseRedisClient().ResetPassword(request)
+	response, err := me.client.UseRedisClient().ResetPasswordWithContext(ctx, request)
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
@@ -890,8 +958,10 @@ func (me *RedisService) ModifyAutoBackupConfig(ctx context.Context, redisId stri
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().ModifyAutoBackupConfig(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().ModifyAutoBackupConfigWithContext(ctx, request)
 	errRet = err
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
@@ -913,8 +983,10 @@ func (me *RedisService) DescribeAutoBackupConfig(ctx context.Context, redisId st
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().DescribeAutoBackupConfig(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().DescribeAutoBackupConfigWithContext(ctx, request)
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
@@ -945,8 +1017,10 @@ func (me *RedisService) DescribeParamTemplates(ctx context.Context, request *red
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().DescribeParamTemplates(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().DescribeParamTemplatesWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -991,8 +1065,10 @@ func (me *RedisService) ApplyParamsTemplate(ctx context.Context, request *redis.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().ApplyParamsTemplate(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().ApplyParamsTemplateWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1019,8 +1095,10 @@ func (me *RedisService) DescribeParamTemplateInfo(ctx context.Context, templateI
 
 	request.TemplateId = &templateId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().DescribeParamTemplateInfo(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().DescribeParamTemplateInfoWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1044,8 +1122,10 @@ func (me *RedisService) CreateParamTemplate(ctx context.Context, request *redis.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().CreateParamTemplate(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().CreateParamTemplateWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1074,8 +1154,10 @@ func (me *RedisService) ModifyParamTemplate(ctx context.Context, request *redis.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().ModifyParamTemplate(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().ModifyParamTemplateWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1097,8 +1179,10 @@ func (me *RedisService) DeleteParamTemplate(ctx context.Context, request *redis.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().DeleteParamTemplate(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().DeleteParamTemplateWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1123,15 +1207,19 @@ func (me *RedisService) DescribeRedisAccountById(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset int64 = 0
 	var limit int64 = 50
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseRedisClient().DescribeInstanceAccount(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseRedisClient().DescribeInstanceAccountWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1172,9 +1260,11 @@ func (me *RedisService) DeleteRedisAccountById(ctx context.Context, instanceId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseRedisClient().DeleteInstanceAccount(request)
+	response, err := me.client.UseRedisClient().DeleteInstanceAccountWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1214,9 +1304,11 @@ func (me *RedisService) DescribeRedisInstanceById(ctx context.Context, instanceI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseRedisClient().DescribeInstances(request)
+	response, err := me.client.UseRedisClient().DescribeInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1243,9 +1335,11 @@ func (me *RedisService) DescribeRedisParamById(ctx context.Context, instanceId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseRedisClient().DescribeInstanceParams(request)
+	response, err := me.client.UseRedisClient().DescribeInstanceParamsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1298,9 +1392,11 @@ func (me *RedisService) DescribeRedisSslById(ctx context.Context, instanceId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseRedisClient().DescribeSSLStatus(request)
+	response, err := me.client.UseRedisClient().DescribeSSLStatusWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1323,9 +1419,11 @@ func (me *RedisService) DescribeRedisMaintenanceWindowById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseRedisClient().DescribeMaintenanceWindow(request)
+	response, err := me.client.UseRedisClient().DescribeMaintenanceWindowWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1372,9 +1470,11 @@ func (me *RedisService) DescribeRedisBackupDownloadInfoByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseRedisClient().DescribeBackupUrl(request)
+	response, err := me.client.UseRedisClient().DescribeBackupUrlWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1416,7 +1516,9 @@ func (me *RedisService) DescribeRedisBackupByFilter(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1425,7 +1527,7 @@ func (me *RedisService) DescribeRedisBackupByFilter(ctx context.Context, param m
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseRedisClient().DescribeInstanceBackups(request)
+		response, err := me.client.UseRedisClient().DescribeInstanceBackupsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1464,7 +1566,9 @@ func (me *RedisService) DescribeRedisParamRecordsByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset    uint64 = 0
@@ -1474,7 +1578,7 @@ func (me *RedisService) DescribeRedisParamRecordsByFilter(ctx context.Context, p
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseRedisClient().DescribeInstanceParamRecords(request)
+		response, err := me.client.UseRedisClient().DescribeInstanceParamRecordsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1520,8 +1624,10 @@ func (me *RedisService) DescribeRedisInstanceShardsByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().DescribeInstanceShards(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().DescribeInstanceShardsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1554,8 +1660,10 @@ func (me *RedisService) DescribeRedisInstanceZoneInfoByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().DescribeInstanceZoneInfo(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().DescribeInstanceZoneInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1612,7 +1720,9 @@ func (me *RedisService) DescribeRedisInstanceTaskListByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1621,7 +1731,7 @@ func (me *RedisService) DescribeRedisInstanceTaskListByFilter(ctx context.Contex
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseRedisClient().DescribeTaskList(request)
+		response, err := me.client.UseRedisClient().DescribeTaskListWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1653,8 +1763,8 @@ func (me *RedisService) AddReplicationInstance(ctx context.Context, groupId, ins
 	request.InstanceId = &instanceId
 	request.InstanceRole = &instanceRole
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		result, e := me.client.UseRedisClient().AddReplicationInstance(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		result, e := me.client.UseRedisClient().AddReplicationInstanceWithContext(ctx, request)
 		if e != nil {
 			return retryError(e)
 		} else {
@@ -1671,21 +1781,7 @@ func (me *RedisService) AddReplicationInstance(ctx context.Context, groupId, ins
 	taskId := *response.Response.TaskId
 
 	if taskId > 0 {
-		err := resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-			ok, err := me.DescribeTaskInfo(ctx, instanceId, taskId)
-			if err != nil {
-				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-			if ok {
-				return nil
-			} else {
-				return resource.RetryableError(fmt.Errorf("Add replication is processing"))
-			}
-		})
+		err := me.WaitForTask(ctx, taskId, 6*readRetryTimeout)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s redis add replication fail, reason:%s\n", logId, err.Error())
@@ -1708,7 +1804,9 @@ func (me *RedisService) DescribeRedisReplicateInstanceById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1716,7 +1814,7 @@ func (me *RedisService) DescribeRedisReplicateInstanceById(ctx context.Context,
 	)
 	request.Offset = &offset
 	request.Limit = &limit
-	response, err := me.client.UseRedisClient().DescribeReplicationGroup(request)
+	response, err := me.client.UseRedisClient().DescribeReplicationGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1743,9 +1841,11 @@ func (me *RedisService) DescribeRedisBackupDownloadRestrictionById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseRedisClient().DescribeBackupDownloadRestriction(request)
+	response, err := me.client.UseRedisClient().DescribeBackupDownloadRestrictionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1774,8 +1874,10 @@ func (me *RedisService) DescribeRedisInstanceNodeInfoByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRedisClient().DescribeInstanceNodeInfo(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRedisClient().DescribeInstanceNodeInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1798,9 +1900,11 @@ func (me *RedisService) DescribeBandwidthRangeById(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseRedisClient().DescribeBandwidthRange(request)
+	response, err := me.client.UseRedisClient().DescribeBandwidthRangeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1825,9 +1929,11 @@ func (me *RedisService) DeleteRedisReplicateAttachmentById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseRedisClient().RemoveReplicationInstance(request)
+	response, err := me.client.UseRedisClient().RemoveReplicationInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1837,21 +1943,7 @@ func (me *RedisService) DeleteRedisReplicateAttachmentById(ctx context.Context,
 	taskId := *response.Response.TaskId
 
 	if taskId > 0 {
-		err := resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
-			ok, err := me.DescribeTaskInfo(ctx, instanceId, taskId)
-			if err != nil {
-				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-			if ok {
-				return nil
-			} else {
-				return resource.RetryableError(fmt.Errorf("remove replication is processing"))
-			}
-		})
+		err := me.WaitForTask(ctx, taskId, 6*readRetryTimeout)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s redis remove replication fail, reason:%s\n", logId, err.Error())
@@ -1876,9 +1968,11 @@ func (me *RedisService) DescribeRedisSecurityGroupAttachmentById(ctx context.Con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseRedisClient().DescribeDBSecurityGroups(request)
+	response, err := me.client.UseRedisClient().DescribeDBSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1913,9 +2007,11 @@ func (me *RedisService) DeleteRedisSecurityGroupAttachmentById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseRedisClient().DisassociateSecurityGroups(request)
+	response, err := me.client.UseRedisClient().DisassociateSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -36,7 +36,7 @@ func (me *RumService) DescribeRumTawInstance(ctx context.Context, instanceId str
 		},
 	)
 
-	response, err := me.client.UseRumClient().DescribeTawInstances(request)
+	response, err := me.client.UseRumClient().DescribeTawInstancesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -66,8 +66,10 @@ func (me *RumService) DeleteRumTawInstanceById(ctx context.Context, instanceId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRumClient().DeleteInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRumClient().DeleteInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -98,7 +100,9 @@ func (me *RumService) DescribeRumProject(ctx context.Context, id string) (projec
 			Values: []*string{&id},
 		},
 	)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset uint64 = 0
 	var pageSize uint64 = 100
@@ -107,8 +111,10 @@ func (me *RumService) DescribeRumProject(ctx context.Context, id string) (projec
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseRumClient().DescribeProjects(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseRumClient().DescribeProjectsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -158,8 +164,10 @@ func (me *RumService) DeleteRumProjectById(ctx context.Context, id string) (errR
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRumClient().DeleteProject(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRumClient().DeleteProjectWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -184,7 +192,7 @@ func (me *RumService) DescribeRumWhitelist(ctx context.Context, instanceID, id s
 	}()
 	request.InstanceID = &instanceID
 
-	response, err := me.client.UseRumClient().DescribeWhitelists(request)
+	response, err := me.client.UseRumClient().DescribeWhitelistsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -222,8 +230,10 @@ func (me *RumService) DeleteRumWhitelistById(ctx context.Context, instanceID, id
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRumClient().DeleteWhitelist(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRumClient().DeleteWhitelistWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -248,7 +258,7 @@ func (me *RumService) DescribeRumOfflineLogConfigAttachment(ctx context.Context,
 	}()
 	request.ProjectKey = &projectKey
 
-	response, err := me.client.UseRumClient().DescribeOfflineLogConfigs(request)
+	response, err := me.client.UseRumClient().DescribeOfflineLogConfigsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -276,8 +286,10 @@ func (me *RumService) DeleteRumOfflineLogConfigAttachmentById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRumClient().DeleteOfflineLogConfig(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRumClient().DeleteOfflineLogConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -306,9 +318,11 @@ func (me *RumService) DescribeRumOfflineLogConfigByFilter(ctx context.Context, p
 			request.ProjectKey = v.(*string)
 		}
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseRumClient().DescribeOfflineLogConfigs(request)
+	response, err := me.client.UseRumClient().DescribeOfflineLogConfigsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -349,7 +363,9 @@ func (me *RumService) DescribeRumProjectByFilter(ctx context.Context, param map[
 			)
 		}
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -357,8 +373,10 @@ func (me *RumService) DescribeRumProjectByFilter(ctx context.Context, param map[
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseRumClient().DescribeProjects(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseRumClient().DescribeProjectsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -398,8 +416,10 @@ func (me *RumService) DescribeRumWhitelistByFilter(ctx context.Context, param ma
 			request.InstanceID = v.(*string)
 		}
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseRumClient().DescribeWhitelists(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseRumClient().DescribeWhitelistsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -452,7 +472,9 @@ func (me *RumService) DescribeRumTawInstanceByFilter(ctx context.Context, param
 			)
 		}
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset int64 = 0
 	var pageSize int64 = 20
@@ -460,8 +482,10 @@ func (me *RumService) DescribeRumTawInstanceByFilter(ctx context.Context, param
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseRumClient().DescribeTawInstances(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseRumClient().DescribeTawInstancesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
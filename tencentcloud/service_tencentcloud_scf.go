@@ -112,10 +112,12 @@ func (me *ScfService) CreateFunction(ctx context.Context, info scfFunctionInfo)
 		}
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.CreateFunction(request); err != nil {
+		if _, err := client.CreateFunctionWithContext(ctx, request); err != nil {
 			e, ok := err.(*sdkErrors.TencentCloudSDKError)
 			if ok && strings.Contains(e.Code, "ResourceInUse") {
 				return resource.NonRetryableError(err)
@@ -137,10 +139,12 @@ func (me *ScfService) DescribeFunction(ctx context.Context, name, namespace stri
 	request.FunctionName = &name
 	request.Namespace = &namespace
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.GetFunction(request)
+		response, err := client.GetFunctionWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				for _, code := range SCF_FUNCTIONS_NOT_FOUND_SET {
@@ -184,10 +188,12 @@ func (me *ScfService) DescribeFunctions(ctx context.Context, name, namespace, de
 
 	// at least run loop once
 	for count == SCF_FUNCTION_DESCRIBE_LIMIT {
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := client.ListFunctions(request)
+			response, err := client.ListFunctionsWithContext(ctx, request)
 			if err != nil {
 				return retryError(errors.WithStack(err))
 			}
@@ -221,10 +227,12 @@ func (me *ScfService) ModifyFunctionCode(ctx context.Context, info scfFunctionIn
 		ImageConfig:     info.imageConfig,
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.UpdateFunctionCode(request); err != nil {
+		if _, err := client.UpdateFunctionCodeWithContext(ctx, request); err != nil {
 			return retryError(errors.WithStack(err), InternalError)
 		}
 		return nil
@@ -289,10 +297,12 @@ func (me *ScfService) ModifyFunctionConfig(ctx context.Context, info scfFunction
 		}
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.UpdateFunctionConfiguration(request); err != nil {
+		if _, err := client.UpdateFunctionConfigurationWithContext(ctx, request); err != nil {
 			return retryError(errors.WithStack(err), InternalError)
 		}
 		return nil
@@ -310,10 +320,12 @@ func (me *ScfService) DeleteFunction(ctx context.Context, name, namespace string
 	deleteRequest.FunctionName = &name
 	deleteRequest.Namespace = &namespace
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, deleteRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.DeleteFunction(deleteRequest); err != nil {
+		if _, err := client.DeleteFunctionWithContext(ctx, deleteRequest); err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				for _, code := range SCF_FUNCTIONS_NOT_FOUND_SET {
 					if sdkError.Code == code {
@@ -333,10 +345,12 @@ func (me *ScfService) DeleteFunction(ctx context.Context, name, namespace string
 	descRequest.FunctionName = &name
 	descRequest.Namespace = &namespace
 
-	return resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(descRequest.GetAction())
+	return retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, descRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.GetFunction(descRequest); err == nil {
+		if _, err := client.GetFunctionWithContext(ctx, descRequest); err == nil {
 			return resource.RetryableError(errors.New("function still exists"))
 		} else {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -359,10 +373,12 @@ func (me *ScfService) CreateNamespace(ctx context.Context, namespace, desc strin
 	request.Namespace = &namespace
 	request.Description = &desc
 
-	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	return retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.CreateNamespace(request); err != nil {
+		if _, err := client.CreateNamespaceWithContext(ctx, request); err != nil {
 			return retryError(errors.WithStack(err))
 		}
 
@@ -383,10 +399,12 @@ func (me *ScfService) DescribeNamespace(ctx context.Context, namespace string) (
 
 	// at least run loop once
 	for count == SCF_NAMESPACE_DESCRIBE_LIMIT {
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := client.ListNamespaces(request)
+			response, err := client.ListNamespacesWithContext(ctx, request)
 			if err != nil {
 				return retryError(errors.WithStack(err))
 			}
@@ -427,10 +445,12 @@ func (me *ScfService) DescribeNamespaces(ctx context.Context) (nss []*scf.Namesp
 
 	// at least run loop once
 	for count == SCF_NAMESPACE_DESCRIBE_LIMIT {
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := client.ListNamespaces(request)
+			response, err := client.ListNamespacesWithContext(ctx, request)
 			if err != nil {
 				return retryError(errors.WithStack(err))
 			}
@@ -456,10 +476,12 @@ func (me *ScfService) ModifyNamespace(ctx context.Context, namespace, desc strin
 	request.Namespace = &namespace
 	request.Description = &desc
 
-	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	return retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.UpdateNamespace(request); err != nil {
+		if _, err := client.UpdateNamespaceWithContext(ctx, request); err != nil {
 			return retryError(errors.WithStack(err))
 		}
 		return nil
@@ -472,10 +494,12 @@ func (me *ScfService) DeleteNamespace(ctx context.Context, namespace string) err
 	request := scf.NewDeleteNamespaceRequest()
 	request.Namespace = &namespace
 
-	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	return retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.DeleteNamespace(request); err != nil {
+		if _, err := client.DeleteNamespaceWithContext(ctx, request); err != nil {
 			return retryError(errors.WithStack(err))
 		}
 
@@ -495,10 +519,12 @@ func (me *ScfService) CreateTriggers(ctx context.Context, functionName, namespac
 		request.Namespace = &namespace
 		request.Enable = helper.String("OPEN")
 
-		if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			if _, err := client.CreateTrigger(request); err != nil {
+			if _, err := client.CreateTriggerWithContext(ctx, request); err != nil {
 				return retryError(errors.WithStack(err))
 			}
 			return nil
@@ -521,10 +547,12 @@ func (me *ScfService) DeleteTriggers(ctx context.Context, functionName, namespac
 		request.Type = &trigger.triggerType
 		request.TriggerDesc = &trigger.triggerDesc
 
-		if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			if _, err := client.DeleteTrigger(request); err != nil {
+			if _, err := client.DeleteTriggerWithContext(ctx, request); err != nil {
 				return retryError(errors.WithStack(err))
 			}
 			return nil
@@ -558,10 +586,12 @@ func (me *ScfService) DescribeLogs(
 	request.StartTime = startTime
 	request.EndTime = endTime
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.GetFunctionLogs(request)
+		response, err := client.GetFunctionLogsWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				for _, code := range SCF_FUNCTIONS_NOT_FOUND_SET {
@@ -587,10 +617,12 @@ func waitScfFunctionReady(ctx context.Context, name, namespace string, client *s
 	request.FunctionName = &name
 	request.Namespace = &namespace
 
-	return resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	return retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.GetFunction(request)
+		response, err := client.GetFunctionWithContext(ctx, request)
 		if err != nil {
 			return retryError(errors.WithStack(err), InternalError)
 		}
@@ -622,9 +654,11 @@ func (me *ScfService) DescribeScfFunctionAliasById(ctx context.Context, namespac
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseScfClient().GetAlias(request)
+	response, err := me.client.UseScfClient().GetAliasWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -649,9 +683,11 @@ func (me *ScfService) DeleteScfFunctionAliasById(ctx context.Context, namespace
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseScfClient().DeleteAlias(request)
+	response, err := me.client.UseScfClient().DeleteAliasWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -675,9 +711,11 @@ func (me *ScfService) DescribeScfFunctionVersionById(ctx context.Context, functi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseScfClient().GetFunction(request)
+	response, err := me.client.UseScfClient().GetFunctionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -702,9 +740,11 @@ func (me *ScfService) DeleteScfFunctionVersionById(ctx context.Context, function
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseScfClient().DeleteFunction(request)
+	response, err := me.client.UseScfClient().DeleteFunctionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -727,9 +767,11 @@ func (me *ScfService) DescribeScfFunctionEventInvokeConfigById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseScfClient().GetFunctionEventInvokeConfig(request)
+	response, err := me.client.UseScfClient().GetFunctionEventInvokeConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -753,9 +795,11 @@ func (me *ScfService) DescribeScfReservedConcurrencyConfigById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseScfClient().GetReservedConcurrencyConfig(request)
+	response, err := me.client.UseScfClient().GetReservedConcurrencyConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -779,9 +823,11 @@ func (me *ScfService) DeleteScfReservedConcurrencyConfigById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseScfClient().DeleteReservedConcurrencyConfig(request)
+	response, err := me.client.UseScfClient().DeleteReservedConcurrencyConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -805,9 +851,11 @@ func (me *ScfService) DescribeScfProvisionedConcurrencyConfigById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseScfClient().GetProvisionedConcurrencyConfig(request)
+	response, err := me.client.UseScfClient().GetProvisionedConcurrencyConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -839,9 +887,11 @@ func (me *ScfService) DeleteScfProvisionedConcurrencyConfigById(ctx context.Cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseScfClient().DeleteProvisionedConcurrencyConfig(request)
+	response, err := me.client.UseScfClient().DeleteProvisionedConcurrencyConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -863,9 +913,11 @@ func (me *ScfService) DescribeScfAccountInfo(ctx context.Context) (accountInfo *
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseScfClient().GetAccount(request)
+	response, err := me.client.UseScfClient().GetAccountWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -916,7 +968,9 @@ func (me *ScfService) DescribeScfAsyncEventManagementByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -925,7 +979,7 @@ func (me *ScfService) DescribeScfAsyncEventManagementByFilter(ctx context.Contex
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseScfClient().ListAsyncEvents(request)
+		response, err := me.client.UseScfClient().ListAsyncEventsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -976,7 +1030,9 @@ func (me *ScfService) DescribeScfTriggersByFilter(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -985,7 +1041,7 @@ func (me *ScfService) DescribeScfTriggersByFilter(ctx context.Context, param map
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseScfClient().ListTriggers(request)
+		response, err := me.client.UseScfClient().ListTriggersWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1024,9 +1080,11 @@ func (me *ScfService) DescribeScfAsyncEventStatus(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseScfClient().GetAsyncEventStatus(request)
+	response, err := me.client.UseScfClient().GetAsyncEventStatusWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1062,9 +1120,11 @@ func (me *ScfService) DescribeScfFunctionAddress(ctx context.Context, param map[
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseScfClient().GetFunctionAddress(request)
+	response, err := me.client.UseScfClient().GetFunctionAddressWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1106,9 +1166,11 @@ func (me *ScfService) DescribeScfRequestStatusByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseScfClient().GetRequestStatus(request)
+	response, err := me.client.UseScfClient().GetRequestStatusWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1144,7 +1206,9 @@ func (me *ScfService) DescribeScfFunctionAliasesByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1153,7 +1217,7 @@ func (me *ScfService) DescribeScfFunctionAliasesByFilter(ctx context.Context, pa
 	for {
 		request.Offset = helper.Int64ToStrPoint(offset)
 		request.Limit = helper.Int64ToStrPoint(limit)
-		response, err := me.client.UseScfClient().ListAliases(request)
+		response, err := me.client.UseScfClient().ListAliasesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1195,9 +1259,11 @@ func (me *ScfService) DescribeScfLayerVersions(ctx context.Context, param map[st
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseScfClient().ListLayerVersions(request)
+	response, err := me.client.UseScfClient().ListLayerVersionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1230,7 +1296,9 @@ func (me *ScfService) DescribeScfLayersByFilter(ctx context.Context, param map[s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1239,7 +1307,7 @@ func (me *ScfService) DescribeScfLayersByFilter(ctx context.Context, param map[s
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseScfClient().ListLayers(request)
+		response, err := me.client.UseScfClient().ListLayersWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1287,7 +1355,9 @@ func (me *ScfService) DescribeScfFunctionVersionsByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -1296,7 +1366,7 @@ func (me *ScfService) DescribeScfFunctionVersionsByFilter(ctx context.Context, p
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseScfClient().ListVersionByFunction(request)
+		response, err := me.client.UseScfClient().ListVersionByFunctionWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1337,7 +1407,9 @@ func (me *ScfService) DescribeScfTriggerConfigById(ctx context.Context, function
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -1347,7 +1419,7 @@ func (me *ScfService) DescribeScfTriggerConfigById(ctx context.Context, function
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseScfClient().ListTriggers(request)
+		response, err := me.client.UseScfClient().ListTriggersWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
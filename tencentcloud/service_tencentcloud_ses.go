@@ -36,8 +36,10 @@ func (me *SesService) DescribeSesTemplateMetadata(ctx context.Context, templateI
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseSesClient().ListEmailTemplates(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseSesClient().ListEmailTemplatesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -85,7 +87,7 @@ func (me *SesService) DescribeSesTemplate(ctx context.Context, templateId uint64
 	}()
 	request.TemplateID = &templateId
 
-	response, err := me.client.UseSesClient().GetEmailTemplate(request)
+	response, err := me.client.UseSesClient().GetEmailTemplateWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -113,8 +115,10 @@ func (me *SesService) DeleteSesTemplateById(ctx context.Context, templateID uint
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSesClient().DeleteEmailTemplate(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSesClient().DeleteEmailTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -138,7 +142,7 @@ func (me *SesService) DescribeSesEmailAddress(ctx context.Context, emailAddress
 		}
 	}()
 
-	response, err := me.client.UseSesClient().ListEmailAddress(request)
+	response, err := me.client.UseSesClient().ListEmailAddressWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -175,8 +179,10 @@ func (me *SesService) DeleteSesEmail_addressById(ctx context.Context, emailAddre
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSesClient().DeleteEmailAddress(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSesClient().DeleteEmailAddressWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -201,7 +207,7 @@ func (me *SesService) DescribeSesDomain(ctx context.Context, emailIdentity strin
 	}()
 	request.EmailIdentity = &emailIdentity
 
-	response, err := me.client.UseSesClient().GetEmailIdentity(request)
+	response, err := me.client.UseSesClient().GetEmailIdentityWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -231,8 +237,10 @@ func (me *SesService) DeleteSesDomainById(ctx context.Context, emailIdentity str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSesClient().DeleteEmailIdentity(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSesClient().DeleteEmailIdentityWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -264,7 +272,9 @@ func (me *SesService) DescribeSesReceiversByFilter(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -273,7 +283,7 @@ func (me *SesService) DescribeSesReceiversByFilter(ctx context.Context, param ma
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseSesClient().ListReceivers(request)
+		response, err := me.client.UseSesClient().ListReceiversWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -318,7 +328,9 @@ func (me *SesService) DescribeSesSendTasksByFilter(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -327,7 +339,7 @@ func (me *SesService) DescribeSesSendTasksByFilter(ctx context.Context, param ma
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseSesClient().ListSendTasks(request)
+		response, err := me.client.UseSesClient().ListSendTasksWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -360,8 +372,10 @@ func (me *SesService) DescribeSesEmailIdentitiesByFilter(ctx context.Context) (e
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSesClient().ListEmailIdentities(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSesClient().ListEmailIdentitiesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -404,7 +418,9 @@ func (me *SesService) DescribeSesBlackEmailAddressByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -413,7 +429,7 @@ func (me *SesService) DescribeSesBlackEmailAddressByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseSesClient().ListBlackEmailAddress(request)
+		response, err := me.client.UseSesClient().ListBlackEmailAddressWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -461,8 +477,10 @@ func (me *SesService) DescribeSesStatisticsReportByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSesClient().GetStatisticsReport(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSesClient().GetStatisticsReportWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -502,7 +520,9 @@ func (me *SesService) DescribeSesSendEmailStatusByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -511,7 +531,7 @@ func (me *SesService) DescribeSesSendEmailStatusByFilter(ctx context.Context, pa
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseSesClient().GetSendEmailStatus(request)
+		response, err := me.client.UseSesClient().GetSendEmailStatusWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -550,7 +570,9 @@ func (me *SesService) DescribeSesReceiverById(ctx context.Context, receiverId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -559,7 +581,7 @@ func (me *SesService) DescribeSesReceiverById(ctx context.Context, receiverId st
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseSesClient().ListReceivers(request)
+		response, err := me.client.UseSesClient().ListReceiversWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -604,7 +626,9 @@ func (me *SesService) DescribeSesReceiverDetailById(ctx context.Context, receive
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -613,7 +637,7 @@ func (me *SesService) DescribeSesReceiverDetailById(ctx context.Context, receive
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseSesClient().ListReceiverDetails(request)
+		response, err := me.client.UseSesClient().ListReceiverDetailsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -648,9 +672,11 @@ func (me *SesService) DeleteSesReceiverById(ctx context.Context, receiverId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSesClient().DeleteReceiver(request)
+	response, err := me.client.UseSesClient().DeleteReceiverWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -672,9 +698,11 @@ func (me *SesService) DescribeSesVerifyDomainById(ctx context.Context, emailIden
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSesClient().GetEmailIdentity(request)
+	response, err := me.client.UseSesClient().GetEmailIdentityWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -692,7 +720,7 @@ func (me *SesService) DescribeSesVerifyDomainById(ctx context.Context, emailIden
 func (me *SesService) CheckEmailIdentityById(ctx context.Context, emailIdentity string) (errRet error) {
 	logId := getLogId(ctx)
 
-	err := resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, 3*readRetryTimeout, func() *resource.RetryError {
 		verifyDomain, e := me.DescribeSesVerifyDomainById(ctx, emailIdentity)
 		if e != nil {
 			return resource.NonRetryableError(e)
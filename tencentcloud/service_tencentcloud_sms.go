@@ -29,7 +29,7 @@ func (me *SmsService) DescribeSmsSign(ctx context.Context, signId string, intern
 	request.SignIdSet = []*uint64{helper.Uint64(helper.StrToUInt64(signId))}
 	request.International = helper.Uint64(helper.StrToUInt64(international))
 
-	response, err := me.client.UseSmsClient().DescribeSmsSignList(request)
+	response, err := me.client.UseSmsClient().DescribeSmsSignListWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -58,8 +58,10 @@ func (me *SmsService) DeleteSmsSignById(ctx context.Context, signId string) (err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSmsClient().DeleteSmsSign(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSmsClient().DeleteSmsSignWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -85,7 +87,7 @@ func (me *SmsService) DescribeSmsTemplate(ctx context.Context, templateId string
 	request.TemplateIdSet = []*uint64{helper.Uint64(helper.StrToUInt64(templateId))}
 	request.International = helper.Uint64(helper.StrToUInt64(international))
 
-	response, err := me.client.UseSmsClient().DescribeSmsTemplateList(request)
+	response, err := me.client.UseSmsClient().DescribeSmsTemplateListWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -115,8 +117,10 @@ func (me *SmsService) DeleteSmsTemplateById(ctx context.Context, templateId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSmsClient().DeleteSmsTemplate(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSmsClient().DeleteSmsTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -32,9 +31,11 @@ func (me *SqlserverService) DescribeZones(ctx context.Context) (zoneInfoList []*
 	}()
 
 	var response *sqlserver.DescribeZonesResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseSqlserverClient().DescribeZones(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := me.client.UseSqlserverClient().DescribeZonesWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s DescribeZones fail, reason:%s\n", logId, e.Error())
 			return retryError(e)
@@ -65,9 +66,11 @@ func (me *SqlserverService) DescribeProductConfig(ctx context.Context, zone stri
 	}()
 
 	var response *sqlserver.DescribeProductConfigResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseSqlserverClient().DescribeProductConfig(request)
+	err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := me.client.UseSqlserverClient().DescribeProductConfigWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s DescribeProductConfig fail, reason:%s\n", logId, e.Error())
 			return retryError(e)
@@ -93,8 +96,10 @@ func (me *SqlserverService) CreateSqlserverInstance(ctx context.Context, request
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().CreateDBInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSqlserverClient().CreateDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -130,8 +135,10 @@ func (me *SqlserverService) ModifySqlserverInstanceName(ctx context.Context, ins
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().ModifyDBInstanceName(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseSqlserverClient().ModifyDBInstanceNameWithContext(ctx, request)
 	return err
 }
 
@@ -146,8 +153,10 @@ func (me *SqlserverService) ModifySqlserverInstanceProjectId(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().ModifyDBInstanceProject(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseSqlserverClient().ModifyDBInstanceProjectWithContext(ctx, request)
 	return err
 }
 
@@ -170,15 +179,17 @@ func (me *SqlserverService) UpgradeSqlserverInstance(ctx context.Context, instan
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().UpgradeDBInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseSqlserverClient().UpgradeDBInstanceWithContext(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	startPending := false
 	//check status not expanding
-	errRet = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+	errRet = retryContext(ctx, 10*readRetryTimeout, func() *resource.RetryError {
 		instance, has, err := me.DescribeSqlserverInstanceById(ctx, instanceId)
 		if err != nil {
 			return retryError(err)
@@ -210,8 +221,10 @@ func (me *SqlserverService) RemoveSecurityGroup(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().DisassociateSecurityGroups(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseSqlserverClient().DisassociateSecurityGroupsWithContext(ctx, request)
 
 	return err
 }
@@ -228,8 +241,10 @@ func (me *SqlserverService) AddSecurityGroup(ctx context.Context, instanceId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().AssociateSecurityGroups(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseSqlserverClient().AssociateSecurityGroupsWithContext(ctx, request)
 	time.Sleep(10 * time.Second)
 	return err
 }
@@ -256,8 +271,10 @@ func (me *SqlserverService) ModifySqlserverInstanceMaintenanceSpan(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().ModifyMaintenanceSpan(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseSqlserverClient().ModifyMaintenanceSpanWithContext(ctx, request)
 
 	return err
 }
@@ -272,8 +289,10 @@ func (me *SqlserverService) TerminateSqlserverInstance(ctx context.Context, inst
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().TerminateDBInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseSqlserverClient().TerminateDBInstanceWithContext(ctx, request)
 	return err
 }
 
@@ -287,8 +306,10 @@ func (me *SqlserverService) DeleteSqlserverInstance(ctx context.Context, instanc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().DeleteDBInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseSqlserverClient().DeleteDBInstanceWithContext(ctx, request)
 	return err
 }
 
@@ -327,8 +348,10 @@ func (me *SqlserverService) DescribeSqlserverInstances(ctx context.Context, inst
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseSqlserverClient().DescribeDBInstancesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -373,8 +396,10 @@ func (me *SqlserverService) DescribeMaintenanceSpan(ctx context.Context, instanc
 			log.Printf("[CRITAL]%s api[%s] fail, reason[%s]", logId, request.GetAction(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().DescribeMaintenanceSpan(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSqlserverClient().DescribeMaintenanceSpanWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -402,8 +427,10 @@ func (me *SqlserverService) DescribeInstanceSecurityGroups(ctx context.Context,
 			log.Printf("[CRITAL]%s api[%s] fail, reason[%s]", logId, request.GetAction(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().DescribeDBSecurityGroups(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSqlserverClient().DescribeDBSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -441,8 +468,10 @@ func (me *SqlserverService) DescribeSqlserverBackups(ctx context.Context, instan
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseSqlserverClient().DescribeBackups(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseSqlserverClient().DescribeBackupsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -468,8 +497,10 @@ func (me *SqlserverService) DescribeReadonlyGroupList(ctx context.Context, insta
 			log.Printf("[CRITAL]%s api[%s] fail, reason[%s]", logId, request.GetAction(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().DescribeReadOnlyGroupList(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSqlserverClient().DescribeReadOnlyGroupListWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -492,8 +523,10 @@ func (me *SqlserverService) CreateSqlserverReadonlyInstance(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().CreateReadOnlyDBInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSqlserverClient().CreateReadOnlyDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -529,8 +562,10 @@ func (me *SqlserverService) DescribeReadonlyGroupListByReadonlyInstanceId(ctx co
 			log.Printf("[CRITAL]%s api[%s] fail, reason[%s]", logId, request.GetAction(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().DescribeReadOnlyGroupByReadOnlyInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSqlserverClient().DescribeReadOnlyGroupByReadOnlyInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -560,8 +595,10 @@ func (me *SqlserverService) CreateSqlserverAccount(ctx context.Context, instance
 	}
 	request.Accounts = []*sqlserver.AccountCreateInfo{&account}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().CreateAccount(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSqlserverClient().CreateAccountWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -595,8 +632,10 @@ func (me *SqlserverService) DescribeSqlserverAccounts(ctx context.Context, insta
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseSqlserverClient().DescribeAccounts(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseSqlserverClient().DescribeAccountsWithContext(ctx, request)
 		if err != nil {
 			ee, ok := err.(*SDKErrors.TencentCloudSDKError)
 			if !ok || ee.Code != "ResourceNotFound.InstanceNotFound" {
@@ -650,8 +689,10 @@ func (me *SqlserverService) ModifySqlserverAccountRemark(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().ModifyAccountRemark(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseSqlserverClient().ModifyAccountRemarkWithContext(ctx, request)
 	return err
 }
 
@@ -666,15 +707,17 @@ func (me *SqlserverService) ResetSqlserverAccountPassword(ctx context.Context, i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().ResetAccountPassword(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseSqlserverClient().ResetAccountPasswordWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
 	}
 
 	//check status not resetting
-	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	errRet = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		instance, has, err := me.DescribeSqlserverAccountById(ctx, instanceId, userName)
 		if err != nil {
 			return resource.NonRetryableError(errors.WithStack(err))
@@ -703,8 +746,10 @@ func (me *SqlserverService) DeleteSqlserverAccount(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().DeleteAccount(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseSqlserverClient().DeleteAccountWithContext(ctx, request)
 	if err != nil {
 		ee, ok := err.(*SDKErrors.TencentCloudSDKError)
 		if !ok || ee.Code != "ResourceNotFound.InstanceNotFound" {
@@ -715,7 +760,7 @@ func (me *SqlserverService) DeleteSqlserverAccount(ctx context.Context, instance
 	}
 
 	//check status not deleting
-	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	errRet = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		instance, has, err := me.DescribeSqlserverAccountById(ctx, instanceId, userName)
 		if err != nil {
 			return resource.NonRetryableError(errors.WithStack(err))
@@ -766,8 +811,10 @@ func (me *SqlserverService) ModifyAccountDBAttachment(ctx context.Context, insta
 		return fmt.Errorf("SQL Server DB %s , instance ID %s is not exist", dbName, instanceId)
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().ModifyAccountPrivilege(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSqlserverClient().ModifyAccountPrivilegeWithContext(ctx, request)
 	if response == nil || response.Response == nil {
 		errRet = fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
 		return
@@ -863,9 +910,11 @@ func (me *SqlserverService) GetInfoFromDeal(ctx context.Context, dealId string,
 	} else {
 		retryTimeout = readRetryTimeout * 20
 	}
-	outErr := resource.Retry(retryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseSqlserverClient().DescribeOrders(request)
+	outErr := retryContext(ctx, retryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := me.client.UseSqlserverClient().DescribeOrdersWithContext(ctx, request)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -915,9 +964,11 @@ func (me *SqlserverService) WaitForTaskFinish(ctx context.Context, flowId int64)
 		}
 	}()
 
-	errRet = resource.Retry(6*writeRetryTimeout, func() *resource.RetryError {
-		taskResponse, err := me.client.UseSqlserverClient().DescribeFlowStatus(request)
-		ratelimit.Check(request.GetAction())
+	errRet = retryContext(ctx, 6*writeRetryTimeout, func() *resource.RetryError {
+		taskResponse, err := me.client.UseSqlserverClient().DescribeFlowStatusWithContext(ctx, request)
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		if err != nil {
 			return resource.NonRetryableError(errors.WithStack(err))
 		}
@@ -955,9 +1006,11 @@ func (me *SqlserverService) CreateSqlserverDB(ctx context.Context, instanceID st
 	}()
 
 	var response *sqlserver.CreateDBResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseSqlserverClient().CreateDB(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := me.client.UseSqlserverClient().CreateDBWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s\n", logId, request.GetAction(), e.Error())
 			return retryError(e)
@@ -994,9 +1047,11 @@ func (me *SqlserverService) DescribeDBsOfInstance(ctx context.Context, instanceI
 		request.Offset = &offset
 		request.Limit = &limit
 		var response *sqlserver.DescribeDBsResponse
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			result, e := me.client.UseSqlserverClient().DescribeDBs(request)
+		err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			result, e := me.client.UseSqlserverClient().DescribeDBsWithContext(ctx, request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, reason:%s\n", logId, request.GetAction(), e.Error())
 				return retryError(e)
@@ -1027,7 +1082,7 @@ func (me *SqlserverService) DescribeDBsOfInstance(ctx context.Context, instanceI
 }
 
 func (me *SqlserverService) DescribeDBDetailsById(ctx context.Context, dbId string) (dbInfo *sqlserver.DBDetail, has bool, errRet error) {
-	idItem := strings.Split(dbId, FILED_SP)
+	idItem := helper.IdParse(dbId)
 	if len(idItem) < 2 {
 		errRet = fmt.Errorf("broken ID of SQLServer DB %s", dbId)
 		return
@@ -1067,9 +1122,11 @@ func (me *SqlserverService) ModifySqlserverDBRemark(ctx context.Context, instanc
 		}
 	}()
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, e := me.client.UseSqlserverClient().ModifyDBRemark(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, e := me.client.UseSqlserverClient().ModifyDBRemarkWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s\n", logId, request.GetAction(), e.Error())
 			return retryError(e)
@@ -1095,9 +1152,11 @@ func (me *SqlserverService) DeleteSqlserverDB(ctx context.Context, instanceId st
 	}()
 
 	var response *sqlserver.DeleteDBResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseSqlserverClient().DeleteDB(request)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, e := me.client.UseSqlserverClient().DeleteDBWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s %s fail, reason:%s", logId, request.GetAction(), e.Error())
 			return retryError(e)
@@ -1132,9 +1191,11 @@ func (me *SqlserverService) CreateSqlserverPublishSubscribe(ctx context.Context,
 	}
 
 	var response *sqlserver.CreatePublishSubscribeResponse
-	errRet = resource.Retry(2*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, errRet = me.client.UseSqlserverClient().CreatePublishSubscribe(request)
+	errRet = retryContext(ctx, 2*writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, errRet = me.client.UseSqlserverClient().CreatePublishSubscribeWithContext(ctx, request)
 		if errRet != nil {
 			if ee, ok := errRet.(*SDKErrors.TencentCloudSDKError); ok {
 				if ee.Code == INTERNALERROR_DBERROR || ee.Code == INSTANCE_STATUS_INVALID {
@@ -1230,12 +1291,16 @@ func (me *SqlserverService) DescribeSqlserverPublishSubscribes(ctx context.Conte
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
 		var response *sqlserver.DescribePublishSubscribeResponse
 		var err error
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			response, err = me.client.UseSqlserverClient().DescribePublishSubscribe(request)
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			response, err = me.client.UseSqlserverClient().DescribePublishSubscribeWithContext(ctx, request)
 			if err != nil {
 				log.Printf("[CRITAL]%s DescribePublishSubscribe fail, reason:%s", logId, err.Error())
 				return retryError(err)
@@ -1269,9 +1334,11 @@ func (me *SqlserverService) ModifyPublishSubscribeName(ctx context.Context, id u
 	}()
 	request.PublishSubscribeId = &id
 	request.PublishSubscribeName = &name
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, e := me.client.UseSqlserverClient().ModifyPublishSubscribeName(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, e := me.client.UseSqlserverClient().ModifyPublishSubscribeNameWithContext(ctx, request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), e.Error())
 			return retryError(e)
@@ -1293,9 +1360,11 @@ func (me *SqlserverService) DeletePublishSubscribe(ctx context.Context, publishS
 	request.DatabaseTupleSet = deleteDatabaseTuples
 	var response *sqlserver.DeletePublishSubscribeResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = me.client.UseSqlserverClient().DeletePublishSubscribe(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = me.client.UseSqlserverClient().DeletePublishSubscribeWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s %s fail, reason:%s", logId, request.GetAction(), err.Error())
 			return retryError(err)
@@ -1320,9 +1389,11 @@ func (me *SqlserverService) RecycleDBInstance(ctx context.Context, instanceId st
 		}
 	}()
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err = me.client.UseSqlserverClient().RecycleDBInstance(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err = me.client.UseSqlserverClient().RecycleDBInstanceWithContext(ctx, request)
 		if err != nil {
 			// FIXME: if action offline then kill this function
 			code := err.(*SDKErrors.TencentCloudSDKError).Code
@@ -1404,8 +1475,10 @@ func (me *SqlserverService) CreateSqlserverBasicInstance(ctx context.Context, pa
 		request.SecurityGroupList = append(request.SecurityGroupList, &v)
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().CreateBasicDBInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSqlserverClient().CreateBasicDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1443,15 +1516,17 @@ func (me *SqlserverService) UpgradeSqlserverBasicInstance(ctx context.Context, i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().UpgradeDBInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseSqlserverClient().UpgradeDBInstanceWithContext(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	startPending := false
 
-	errRet = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+	errRet = retryContext(ctx, 10*readRetryTimeout, func() *resource.RetryError {
 		instance, has, err := me.DescribeSqlserverInstanceById(ctx, instanceId)
 		if err != nil {
 			return retryError(err)
@@ -1488,8 +1563,10 @@ func (me *SqlserverService) NewModifyDBInstanceRenewFlag(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().ModifyDBInstanceRenewFlag(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseSqlserverClient().ModifyDBInstanceRenewFlagWithContext(ctx, request)
 
 	return err
 }
@@ -1506,9 +1583,11 @@ func (me *SqlserverService) DescribeSqlserverMigrationById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeMigrationDetail(request)
+	response, err := me.client.UseSqlserverClient().DescribeMigrationDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1531,9 +1610,11 @@ func (me *SqlserverService) DeleteSqlserverMigrationById(ctx context.Context, mi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DeleteMigration(request)
+	response, err := me.client.UseSqlserverClient().DeleteMigrationWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1574,7 +1655,9 @@ func (me *SqlserverService) DescribeSqlserverMigrationsByFilter(ctx context.Cont
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset int64 = 0
 	var pageSize int64 = 20
@@ -1582,8 +1665,10 @@ func (me *SqlserverService) DescribeSqlserverMigrationsByFilter(ctx context.Cont
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseSqlserverClient().DescribeMigrations(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseSqlserverClient().DescribeMigrationsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -1617,9 +1702,11 @@ func (me *SqlserverService) DescribeSqlserverConfigBackupStrategyById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1653,8 +1740,10 @@ func (me *SqlserverService) DescribeSqlserverBackupByBackupId(ctx context.Contex
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseSqlserverClient().DescribeBackups(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseSqlserverClient().DescribeBackupsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1687,8 +1776,10 @@ func (me *SqlserverService) DescribeBackupByFlowId(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().DescribeBackupByFlowId(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSqlserverClient().DescribeBackupByFlowIdWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1712,8 +1803,10 @@ func (me *SqlserverService) DescribeSqlserverBackupsById(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().DescribeBackupFiles(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSqlserverClient().DescribeBackupFilesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1741,9 +1834,11 @@ func (me *SqlserverService) DeleteSqlserverGeneralBackupsById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().RemoveBackups(request)
+	response, err := me.client.UseSqlserverClient().RemoveBackupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1780,9 +1875,11 @@ func (me *SqlserverService) DescribeSqlserverBackupCommand(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeBackupCommand(request)
+	response, err := me.client.UseSqlserverClient().DescribeBackupCommandWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1809,8 +1906,10 @@ func (me *SqlserverService) DescribeCloneStatusByFlowId(ctx context.Context, flo
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().DescribeFlowStatus(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSqlserverClient().DescribeFlowStatusWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1832,9 +1931,11 @@ func (me *SqlserverService) DescribeSqlserverGeneralCloneById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBsNormal(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBsNormalWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1861,8 +1962,10 @@ func (me *SqlserverService) DeleteSqlserverGeneralCloneDB(ctx context.Context, i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().DeleteDB(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSqlserverClient().DeleteDBWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1885,9 +1988,11 @@ func (me *SqlserverService) DescribeSqlserverFullBackupMigrationById(ctx context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeBackupMigration(request)
+	response, err := me.client.UseSqlserverClient().DescribeBackupMigrationWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1914,9 +2019,11 @@ func (me *SqlserverService) DeleteSqlserverFullBackupMigrationById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DeleteBackupMigration(request)
+	response, err := me.client.UseSqlserverClient().DeleteBackupMigrationWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1940,8 +2047,10 @@ func (me *SqlserverService) DescribeSqlserverIncreBackupMigrationById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().DescribeIncrementalMigration(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSqlserverClient().DescribeIncrementalMigrationWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1970,9 +2079,11 @@ func (me *SqlserverService) DeleteSqlserverIncreBackupMigrationById(ctx context.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DeleteIncrementalMigration(request)
+	response, err := me.client.UseSqlserverClient().DeleteIncrementalMigrationWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1996,9 +2107,11 @@ func (me *SqlserverService) DescribeSqlserverBusinessIntelligenceFileById(ctx co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeBusinessIntelligenceFile(request)
+	response, err := me.client.UseSqlserverClient().DescribeBusinessIntelligenceFileWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2026,9 +2139,11 @@ func (me *SqlserverService) DeleteSqlserverBusinessIntelligenceFileById(ctx cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DeleteBusinessIntelligenceFile(request)
+	response, err := me.client.UseSqlserverClient().DeleteBusinessIntelligenceFileWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2050,9 +2165,11 @@ func (me *SqlserverService) DescribeSqlserverBusinessIntelligenceInstanceById(ct
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2079,9 +2196,11 @@ func (me *SqlserverService) DescribeMaintenanceSpanById(ctx context.Context, ins
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeMaintenanceSpan(request)
+	response, err := me.client.UseSqlserverClient().DescribeMaintenanceSpanWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2108,8 +2227,10 @@ func (me *SqlserverService) TerminateSqlserverInstanceById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().TerminateDBInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSqlserverClient().TerminateDBInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2131,8 +2252,10 @@ func (me *SqlserverService) DeleteSqlserverInstanceById(ctx context.Context, ins
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().DeleteDBInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSqlserverClient().DeleteDBInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2157,9 +2280,11 @@ func (me *SqlserverService) DescribeSqlserverGeneralCommunicationById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBInstanceInter(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBInstanceInterWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2186,9 +2311,11 @@ func (me *SqlserverService) DeleteSqlserverGeneralCommunicationById(ctx context.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().CloseInterCommunication(request)
+	response, err := me.client.UseSqlserverClient().CloseInterCommunicationWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2227,8 +2354,10 @@ func (me *SqlserverService) DescribeSqlserverBackupUploadSizeByFilter(ctx contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().DescribeBackupUploadSize(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSqlserverClient().DescribeBackupUploadSizeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2261,9 +2390,11 @@ func (me *SqlserverService) DescribeSqlserverCrossRegionZoneByFilter(ctx context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeCrossRegionZone(request)
+	response, err := me.client.UseSqlserverClient().DescribeCrossRegionZoneWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2298,8 +2429,10 @@ func (me *SqlserverService) DescribeSqlserverDatasourceDBCharsetsByFilter(ctx co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().DescribeDBCharsets(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSqlserverClient().DescribeDBCharsetsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2333,7 +2466,9 @@ func (me *SqlserverService) DescribeSqlserverInstanceParamRecordsByFilter(ctx co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -2343,7 +2478,7 @@ func (me *SqlserverService) DescribeSqlserverInstanceParamRecordsByFilter(ctx co
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseSqlserverClient().DescribeInstanceParamRecords(request)
+		response, err := me.client.UseSqlserverClient().DescribeInstanceParamRecordsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2384,9 +2519,11 @@ func (me *SqlserverService) DescribeSqlserverProjectSecurityGroupsByFilter(ctx c
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeProjectSecurityGroups(request)
+	response, err := me.client.UseSqlserverClient().DescribeProjectSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2415,9 +2552,11 @@ func (me *SqlserverService) DescribeSqlserverDatasourceRegionsByFilter(ctx conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeRegions(request)
+	response, err := me.client.UseSqlserverClient().DescribeRegionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2455,9 +2594,11 @@ func (me *SqlserverService) DescribeSqlserverRollbackTimeByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeRollbackTime(request)
+	response, err := me.client.UseSqlserverClient().DescribeRollbackTimeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2498,7 +2639,9 @@ func (me *SqlserverService) DescribeSqlserverSlowlogsByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		limit  int64  = 100
@@ -2508,7 +2651,7 @@ func (me *SqlserverService) DescribeSqlserverSlowlogsByFilter(ctx context.Contex
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		response, err := me.client.UseSqlserverClient().DescribeSlowlogs(request)
+		response, err := me.client.UseSqlserverClient().DescribeSlowlogsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2552,9 +2695,11 @@ func (me *SqlserverService) DescribeSqlserverUploadBackupInfoByFilter(ctx contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeUploadBackupInfo(request)
+	response, err := me.client.UseSqlserverClient().DescribeUploadBackupInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2595,9 +2740,11 @@ func (me *SqlserverService) DescribeSqlserverUploadIncrementalInfoByFilter(ctx c
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeUploadIncrementalInfo(request)
+	response, err := me.client.UseSqlserverClient().DescribeUploadIncrementalInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2626,9 +2773,11 @@ func (me *SqlserverService) DescribeSqlserverConfigDatabaseCDCById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBsNormal(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBsNormalWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2656,9 +2805,11 @@ func (me *SqlserverService) DescribeSqlserverGeneralCloudInstanceById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2686,9 +2837,11 @@ func (me *SqlserverService) DescribeSqlserverConfigDatabaseCTById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBsNormal(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBsNormalWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2716,9 +2869,11 @@ func (me *SqlserverService) DescribeSqlserverConfigDatabaseMdfById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBsNormal(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBsNormalWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2746,9 +2901,11 @@ func (me *SqlserverService) DescribeSqlserverConfigInstanceNetworkById(ctx conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2776,9 +2933,11 @@ func (me *SqlserverService) DescribeSqlserverConfigInstanceParamById(ctx context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeInstanceParams(request)
+	response, err := me.client.UseSqlserverClient().DescribeInstanceParamsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2807,9 +2966,11 @@ func (me *SqlserverService) DescribeSqlserverConfigInstanceRoGroupById(ctx conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeReadOnlyGroupDetails(request)
+	response, err := me.client.UseSqlserverClient().DescribeReadOnlyGroupDetailsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2836,9 +2997,11 @@ func (me *SqlserverService) DescribeSqlserverConfigInstanceSecurityGroupsById(ct
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBSecurityGroups(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2866,9 +3029,11 @@ func (me *SqlserverService) DescribeSqlserverRenewDBInstanceById(ctx context.Con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2895,9 +3060,11 @@ func (me *SqlserverService) DescribeSqlserverRenewPostpaidDBInstanceById(ctx con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2924,9 +3091,11 @@ func (me *SqlserverService) DescribeSqlserverRestartDBInstanceById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2953,9 +3122,11 @@ func (me *SqlserverService) DescribeSqlserverRestoreInstanceById(ctx context.Con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBs(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2995,9 +3166,11 @@ func (me *SqlserverService) DescribeSqlserverRollbackInstanceById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBs(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -3037,9 +3210,11 @@ func (me *SqlserverService) DescribeSqlserverConfigTerminateDBInstanceById(ctx c
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -3067,9 +3242,11 @@ func (me *SqlserverService) DescribeSqlserverDBS(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBs(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -3097,9 +3274,11 @@ func (me *SqlserverService) DescribeSqlserverGeneralCloudRoInstanceById(ctx cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -3127,9 +3306,11 @@ func (me *SqlserverService) DeleteSqlserverGeneralCloudRoInstanceById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DeleteDBInstance(request)
+	response, err := me.client.UseSqlserverClient().DeleteDBInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -3166,7 +3347,9 @@ func (me *SqlserverService) DescribeSqlserverQueryXeventByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -3175,7 +3358,7 @@ func (me *SqlserverService) DescribeSqlserverQueryXeventByFilter(ctx context.Con
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseSqlserverClient().DescribeXEvents(request)
+		response, err := me.client.UseSqlserverClient().DescribeXEventsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -3214,9 +3397,11 @@ func (me *SqlserverService) DescribeSqlserverInsAttributeByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBInstancesAttribute(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBInstancesAttributeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -3245,9 +3430,11 @@ func (me *SqlserverService) DescribeSqlserverInstanceTDEById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBInstancesAttribute(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBInstancesAttributeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -3271,9 +3458,11 @@ func (me *SqlserverService) DescribeSqlserverDatabaseTDEById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSqlserverClient().DescribeDBs(request)
+	response, err := me.client.UseSqlserverClient().DescribeDBsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -30,8 +30,10 @@ func (me *SslService) CreateCertificate(ctx context.Context, certType, cert, nam
 	createRequest.Alias = &name
 	createRequest.Key = key
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(createRequest.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, createRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
 		response, err := client.UploadCert(createRequest)
 		if err != nil {
@@ -57,8 +59,10 @@ func (me *SslService) CreateCertificate(ctx context.Context, certType, cert, nam
 	describeRequest.ModuleType = helper.String(SSL_MODULE_TYPE)
 	describeRequest.Id = &id
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, describeRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
 		response, err := client.DescribeCertList(describeRequest)
 		if err != nil {
@@ -126,8 +130,10 @@ func (me *SslService) DescribeCertificates(ctx context.Context, id, name, certTy
 	// run loop at least once
 	count := 20
 	for count == 20 {
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
 			response, err := me.client.UseSslClient().DescribeCertList(request)
 			if err != nil {
@@ -161,8 +167,10 @@ func (me *SslService) DeleteCertificate(ctx context.Context, id string) error {
 	deleteRequest.ModuleType = helper.String(SSL_MODULE_TYPE)
 	deleteRequest.Id = &id
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, deleteRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
 		if _, err := client.DeleteCert(deleteRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
@@ -179,8 +187,10 @@ func (me *SslService) DeleteCertificate(ctx context.Context, id string) error {
 	describeRequest.ModuleType = helper.String(SSL_MODULE_TYPE)
 	describeRequest.Id = &id
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, describeRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
 		response, err := client.DescribeCertList(describeRequest)
 		if err != nil {
@@ -67,8 +67,10 @@ func (me *SsmService) DescribeSecretsByFilter(ctx context.Context, param map[str
 	for {
 		request.Offset = helper.Uint64(offset)
 		request.Limit = helper.Uint64(pageSize)
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseSsmClient().ListSecrets(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseSsmClient().ListSecretsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -93,9 +95,11 @@ func (me *SsmService) DescribeSecretByName(ctx context.Context, secretName strin
 	logId := getLogId(ctx)
 	request := ssm.NewDescribeSecretRequest()
 	request.SecretName = helper.String(secretName)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSsmClient().DescribeSecret(request)
+	response, err := me.client.UseSsmClient().DescribeSecretWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -122,9 +126,11 @@ func (me *SsmService) DescribeSecretVersionIdsByName(ctx context.Context, secret
 	logId := getLogId(ctx)
 	request := ssm.NewListSecretVersionIdsRequest()
 	request.SecretName = helper.String(secretName)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSsmClient().ListSecretVersionIds(request)
+	response, err := me.client.UseSsmClient().ListSecretVersionIdsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -144,9 +150,11 @@ func (me *SsmService) DescribeSecretVersion(ctx context.Context, secretName, ver
 	request := ssm.NewGetSecretValueRequest()
 	request.SecretName = helper.String(secretName)
 	request.VersionId = helper.String(versionId)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSsmClient().GetSecretValue(request)
+	response, err := me.client.UseSsmClient().GetSecretValueWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -194,8 +202,10 @@ func (me *SsmService) CreateSecret(ctx context.Context, param map[string]interfa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSsmClient().CreateSecret(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseSsmClient().CreateSecretWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -212,9 +222,11 @@ func (me *SsmService) UpdateSecretDescription(ctx context.Context, secretName, d
 	request := ssm.NewUpdateDescriptionRequest()
 	request.SecretName = helper.String(secretName)
 	request.Description = helper.String(description)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSsmClient().UpdateDescription(request)
+	response, err := me.client.UseSsmClient().UpdateDescriptionWithContext(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -241,9 +253,11 @@ func (me *SsmService) UpdateSecret(ctx context.Context, param map[string]interfa
 			request.SecretString = helper.String(v.(string))
 		}
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSsmClient().UpdateSecret(request)
+	response, err := me.client.UseSsmClient().UpdateSecretWithContext(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -257,9 +271,11 @@ func (me *SsmService) EnableSecret(ctx context.Context, secretName string) (errR
 	logId := getLogId(ctx)
 	request := ssm.NewEnableSecretRequest()
 	request.SecretName = helper.String(secretName)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSsmClient().EnableSecret(request)
+	response, err := me.client.UseSsmClient().EnableSecretWithContext(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -273,9 +289,11 @@ func (me *SsmService) DisableSecret(ctx context.Context, secretName string) (err
 	logId := getLogId(ctx)
 	request := ssm.NewDisableSecretRequest()
 	request.SecretName = helper.String(secretName)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSsmClient().DisableSecret(request)
+	response, err := me.client.UseSsmClient().DisableSecretWithContext(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -302,9 +320,11 @@ func (me *SsmService) PutSecretValue(ctx context.Context, param map[string]inter
 			request.SecretString = helper.String(v.(string))
 		}
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSsmClient().PutSecretValue(request)
+	response, err := me.client.UseSsmClient().PutSecretValueWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -322,9 +342,11 @@ func (me *SsmService) DeleteSecretVersion(ctx context.Context, secretName, versi
 	request := ssm.NewDeleteSecretVersionRequest()
 	request.SecretName = helper.String(secretName)
 	request.VersionId = helper.String(versionId)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSsmClient().DeleteSecretVersion(request)
+	response, err := me.client.UseSsmClient().DeleteSecretVersionWithContext(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -339,9 +361,11 @@ func (me *SsmService) DeleteSecret(ctx context.Context, secretName string, recov
 	request := ssm.NewDeleteSecretRequest()
 	request.SecretName = helper.String(secretName)
 	request.RecoveryWindowInDays = helper.Uint64(recoveryWindowInDays)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSsmClient().DeleteSecret(request)
+	response, err := me.client.UseSsmClient().DeleteSecretWithContext(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -364,7 +388,9 @@ func (me *SsmService) DescribeSecretById(ctx context.Context, secretName string,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -374,7 +400,7 @@ func (me *SsmService) DescribeSecretById(ctx context.Context, secretName string,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseSsmClient().ListSecrets(request)
+		response, err := me.client.UseSsmClient().ListSecretsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -415,9 +441,11 @@ func (me *SsmService) DeleteSsmSshKeyPairSecretById(ctx context.Context, secretN
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSsmClient().DeleteSecret(request)
+	response, err := me.client.UseSsmClient().DeleteSecretWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -439,9 +467,11 @@ func (me *SsmService) DeleteSsmProductSecretById(ctx context.Context, secretName
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSsmClient().DeleteSecret(request)
+	response, err := me.client.UseSsmClient().DeleteSecretWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -463,9 +493,11 @@ func (me *SsmService) DescribeAsyncRequestInfo(ctx context.Context, flowID int64
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseSsmClient().DescribeAsyncRequestInfo(request)
+	response, err := me.client.UseSsmClient().DescribeAsyncRequestInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -502,7 +534,7 @@ func (me *SsmService) DescribeSsmProductsByFilter(ctx context.Context) (products
 		}
 	}()
 
-	response, err := me.client.UseSsmClient().DescribeSupportedProducts(request)
+	response, err := me.client.UseSsmClient().DescribeSupportedProductsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
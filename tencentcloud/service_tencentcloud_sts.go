@@ -26,8 +26,10 @@ func (me *StsService) DescribeStsCallerIdentityByFilter(ctx context.Context) (ca
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseStsClient().GetCallerIdentity(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseStsClient().GetCallerIdentityWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -42,10 +42,12 @@ func (me *TagService) ModifyTags(ctx context.Context, resourceName string, repla
 		}
 	}
 
-	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	return retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := me.client.UseTagClient().ModifyResourceTags(request); err != nil {
+		if _, err := me.client.UseTagClient().ModifyResourceTagsWithContext(ctx, request); err != nil {
 			return retryError(errors.WithStack(err))
 		}
 
@@ -67,10 +69,12 @@ func (me *TagService) DescribeResourceTags(ctx context.Context, serviceType, res
 	// for run loop at least once
 	count := DESCRIBE_TAGS_LIMIT
 	for count == DESCRIBE_TAGS_LIMIT {
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := me.client.UseTagClient().DescribeResourceTagsByResourceIds(request)
+			response, err := me.client.UseTagClient().DescribeResourceTagsByResourceIdsWithContext(ctx, request)
 			if err != nil {
 				count = 0
 
@@ -131,7 +135,9 @@ func (me *TagService) DescribeProjectById(ctx context.Context, projectId uint64)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	// query enable project
 	request.AllList = helper.Uint64(0)
@@ -144,7 +150,7 @@ func (me *TagService) DescribeProjectById(ctx context.Context, projectId uint64)
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTagClient().DescribeProjects(request)
+		response, err := me.client.UseTagClient().DescribeProjectsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -182,7 +188,7 @@ func (me *TagService) DescribeProjectById(ctx context.Context, projectId uint64)
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTagClient().DescribeProjects(request)
+		response, err := me.client.UseTagClient().DescribeProjectsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -223,9 +229,11 @@ func (me *TagService) DisableProjectById(ctx context.Context, projectId uint64)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTagClient().UpdateProject(request)
+	response, err := me.client.UseTagClient().UpdateProjectWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -253,7 +261,9 @@ func (me *TagService) DescribeProjects(ctx context.Context, param map[string]int
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -262,7 +272,7 @@ func (me *TagService) DescribeProjects(ctx context.Context, param map[string]int
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTagClient().DescribeProjects(request)
+		response, err := me.client.UseTagClient().DescribeProjectsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -295,9 +305,11 @@ func (me *TagService) DescribeTagResourceById(ctx context.Context, tagKey string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTagClient().GetTags(request)
+	response, err := me.client.UseTagClient().GetTagsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -328,9 +340,11 @@ func (me *TagService) DeleteTagResourceById(ctx context.Context, tagKey string,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTagClient().DeleteTag(request)
+	response, err := me.client.UseTagClient().DeleteTagWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -351,9 +365,11 @@ func (me *TagService) DescribeTagTagAttachmentById(ctx context.Context, tagKey s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTagClient().GetResources(request)
+	response, err := me.client.UseTagClient().GetResourcesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -393,9 +409,11 @@ func (me *TagService) DeleteTagTagAttachmentById(ctx context.Context, tagKey str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTagClient().DeleteResourceTag(request)
+	response, err := me.client.UseTagClient().DeleteResourceTagWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
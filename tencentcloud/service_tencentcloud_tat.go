@@ -34,7 +34,9 @@ func (me *TatService) DescribeTatCommand(ctx context.Context, commandId string)
 			Values: []*string{&commandId},
 		},
 	)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset uint64 = 0
 	var pageSize uint64 = 100
@@ -43,8 +45,10 @@ func (me *TatService) DescribeTatCommand(ctx context.Context, commandId string)
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTatClient().DescribeCommands(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTatClient().DescribeCommandsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -87,8 +91,10 @@ func (me *TatService) DeleteTatCommandById(ctx context.Context, commandId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTatClient().DeleteCommand(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTatClient().DeleteCommandWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -119,7 +125,9 @@ func (me *TatService) DescribeTatInvoker(ctx context.Context, invokerId string)
 			Values: []*string{&invokerId},
 		},
 	)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset uint64 = 0
 	var pageSize uint64 = 100
@@ -128,8 +136,10 @@ func (me *TatService) DescribeTatInvoker(ctx context.Context, invokerId string)
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTatClient().DescribeInvokers(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTatClient().DescribeInvokersWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -172,8 +182,10 @@ func (me *TatService) DeleteTatInvokerById(ctx context.Context, invokerId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTatClient().DeleteInvoker(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTatClient().DeleteInvokerWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -239,7 +251,9 @@ func (me *TatService) DescribeTatCommandByFilter(ctx context.Context, param map[
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -247,8 +261,10 @@ func (me *TatService) DescribeTatCommandByFilter(ctx context.Context, param map[
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTatClient().DescribeCommands(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTatClient().DescribeCommandsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -314,7 +330,9 @@ func (me *TatService) DescribeTatInvokerByFilter(ctx context.Context, param map[
 			)
 		}
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -322,8 +340,10 @@ func (me *TatService) DescribeTatInvokerByFilter(ctx context.Context, param map[
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTatClient().DescribeInvokers(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTatClient().DescribeInvokersWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -366,7 +386,9 @@ func (me *TatService) DescribeTatAgentByFilter(ctx context.Context, param map[st
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -375,7 +397,7 @@ func (me *TatService) DescribeTatAgentByFilter(ctx context.Context, param map[st
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTatClient().DescribeAutomationAgentStatus(request)
+		response, err := me.client.UseTatClient().DescribeAutomationAgentStatusWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -414,7 +436,9 @@ func (me *TatService) DescribeTatInvokerRecordsByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -423,7 +447,7 @@ func (me *TatService) DescribeTatInvokerRecordsByFilter(ctx context.Context, par
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTatClient().DescribeInvokerRecords(request)
+		response, err := me.client.UseTatClient().DescribeInvokerRecordsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -456,7 +480,9 @@ func (me *TatService) DescribeTatInvokerConfigById(ctx context.Context, invokerI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -466,7 +492,7 @@ func (me *TatService) DescribeTatInvokerConfigById(ctx context.Context, invokerI
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTatClient().DescribeInvokers(request)
+		response, err := me.client.UseTatClient().DescribeInvokersWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -503,7 +529,9 @@ func (me *TatService) DescribeTatInvocationById(ctx context.Context, invocationI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -513,7 +541,7 @@ func (me *TatService) DescribeTatInvocationById(ctx context.Context, invocationI
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTatClient().DescribeInvocations(request)
+		response, err := me.client.UseTatClient().DescribeInvocationsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -551,9 +579,11 @@ func (me *TatService) DeleteTatInvocationById(ctx context.Context, invocationId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTatClient().CancelInvocation(request)
+	response, err := me.client.UseTatClient().CancelInvocationWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -587,7 +617,9 @@ func (me *TatService) DescribeTatInvocationTaskByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -596,7 +628,7 @@ func (me *TatService) DescribeTatInvocationTaskByFilter(ctx context.Context, par
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTatClient().DescribeInvocationTasks(request)
+		response, err := me.client.UseTatClient().DescribeInvocationTasksWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
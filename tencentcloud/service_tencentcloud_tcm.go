@@ -28,7 +28,7 @@ func (me *TcmService) DescribeTcmMesh(ctx context.Context, meshId string) (mesh
 	}()
 	request.MeshId = &meshId
 
-	response, err := me.client.UseTcmClient().DescribeMesh(request)
+	response, err := me.client.UseTcmClient().DescribeMeshWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -55,8 +55,10 @@ func (me *TcmService) DeleteTcmMeshById(ctx context.Context, meshId string) (err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTcmClient().DeleteMesh(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTcmClient().DeleteMeshWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -82,8 +84,10 @@ func (me *TcmService) DeleteTcmClusterAttachmentById(ctx context.Context, meshId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTcmClient().UnlinkCluster(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTcmClient().UnlinkClusterWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -108,8 +112,10 @@ func (me *TcmService) DeleteTcmPrometheusAttachmentById(ctx context.Context, mes
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTcmClient().UnlinkPrometheus(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTcmClient().UnlinkPrometheusWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -134,7 +140,7 @@ func (me *TcmService) DescribeTcmAccessLogConfig(ctx context.Context, meshName s
 	}()
 	request.MeshId = &meshName
 
-	response, err := me.client.UseTcmClient().DescribeAccessLogConfig(request)
+	response, err := me.client.UseTcmClient().DescribeAccessLogConfigWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -169,7 +175,9 @@ func (me *TcmService) DescribeTcmMeshByFilter(ctx context.Context, param map[str
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset int64 = 0
 	var pageSize int64 = 50
@@ -177,8 +185,10 @@ func (me *TcmService) DescribeTcmMeshByFilter(ctx context.Context, param map[str
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTcmClient().DescribeMeshList(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTcmClient().DescribeMeshListWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -28,9 +28,11 @@ func (me *TcmqService) DescribeTcmqTopicById(ctx context.Context, topicName stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DescribeCmqTopicDetail(request)
+	response, err := me.client.UseTdmqClient().DescribeCmqTopicDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -57,9 +59,11 @@ func (me *TcmqService) DeleteTcmqTopicById(ctx context.Context, topicName string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DeleteCmqTopic(request)
+	response, err := me.client.UseTdmqClient().DeleteCmqTopicWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -81,9 +85,11 @@ func (me *TcmqService) DescribeTcmqQueueById(ctx context.Context, queueName stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DescribeCmqQueueDetail(request)
+	response, err := me.client.UseTdmqClient().DescribeCmqQueueDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -142,9 +148,11 @@ func (me *TcmqService) DescribeTcmqQueueByFilter(ctx context.Context, paramMap m
 		request.Filters = filters
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DescribeCmqQueues(request)
+	response, err := me.client.UseTdmqClient().DescribeCmqQueuesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -167,9 +175,11 @@ func (me *TcmqService) DeleteTcmqQueueById(ctx context.Context, queueName string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DeleteCmqQueue(request)
+	response, err := me.client.UseTdmqClient().DeleteCmqQueueWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -227,9 +237,11 @@ func (me *TcmqService) DescribeTcmqTopicByFilter(ctx context.Context, paramMap m
 		request.Filters = filters
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DescribeCmqTopics(request)
+	response, err := me.client.UseTdmqClient().DescribeCmqTopicsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -253,9 +265,11 @@ func (me *TcmqService) DescribeTcmqSubscribeById(ctx context.Context, topicName
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DescribeCmqSubscriptionDetail(request)
+	response, err := me.client.UseTdmqClient().DescribeCmqSubscriptionDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -294,9 +308,11 @@ func (me *TcmqService) DescribeTcmqSubscribeByFilter(ctx context.Context, paramM
 		request.SubscriptionName = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DescribeCmqSubscriptionDetail(request)
+	response, err := me.client.UseTdmqClient().DescribeCmqSubscriptionDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -320,9 +336,11 @@ func (me *TcmqService) DeleteTcmqSubscribeById(ctx context.Context, topicName st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DeleteCmqSubscribe(request)
+	response, err := me.client.UseTdmqClient().DeleteCmqSubscribeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
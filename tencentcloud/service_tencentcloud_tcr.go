@@ -66,8 +66,10 @@ func (me *TCRService) CreateTCRInstance(ctx context.Context, name string, instan
 		request.TagSpecification = &tagSpec
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTCRClient().CreateInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTCRClient().CreateInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -91,8 +93,10 @@ func (me *TCRService) ManageTCRExternalEndpoint(ctx context.Context, instanceId,
 	request.Operation = &operation
 	request.RegistryId = &instanceId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTCRClient().ManageExternalEndpoint(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTCRClient().ManageExternalEndpointWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -114,8 +118,10 @@ func (me *TCRService) DescribeExternalEndpointStatus(ctx context.Context, instan
 	}()
 	request.RegistryId = &instanceId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTCRClient().DescribeExternalEndpointStatus(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTCRClient().DescribeExternalEndpointStatusWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -142,8 +148,10 @@ func (me *TCRService) DescribeSecurityPolicies(ctx context.Context, request *tcr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTCRClient().DescribeSecurityPolicies(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTCRClient().DescribeSecurityPoliciesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -169,8 +177,10 @@ func (me *TCRService) DescribeTCRInstanceById(ctx context.Context, instanceId st
 	}()
 	request.Registryids = []*string{&instanceId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTCRClient().DescribeInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTCRClient().DescribeInstancesWithContext(ctx, request)
 	if err != nil {
 		ee, ok := err.(*sdkErrors.TencentCloudSDKError)
 		if !ok {
@@ -217,8 +227,10 @@ func (me *TCRService) DescribeTCRInstances(ctx context.Context, instanceId strin
 		request.Offset = &offset
 		request.Limit = &limit
 
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTCRClient().DescribeInstances(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTCRClient().DescribeInstancesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -245,8 +257,10 @@ func (me *TCRService) DeleteTCRInstance(ctx context.Context, instanceId string,
 	request.RegistryId = &instanceId
 	request.DeleteBucket = &deleteBucket
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTCRClient().DeleteInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseTCRClient().DeleteInstanceWithContext(ctx, request)
 	return err
 }
 
@@ -281,8 +295,10 @@ func (me *TCRService) CreateTCRNameSpace(ctx context.Context, instanceId string,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTCRClient().CreateNamespace(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTCRClient().CreateNamespaceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -304,8 +320,10 @@ func (me *TCRService) ModifyInstance(ctx context.Context, registryId, registryTy
 	}()
 	request.RegistryId = helper.String(registryId)
 	request.RegistryType = helper.String(registryType)
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTCRClient().ModifyInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseTCRClient().ModifyInstanceWithContext(ctx, request)
 	return err
 }
 
@@ -337,8 +355,10 @@ func (me *TCRService) ModifyTCRNameSpace(ctx context.Context, instanceId string,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTCRClient().ModifyNamespace(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseTCRClient().ModifyNamespaceWithContext(ctx, request)
 	return err
 }
 
@@ -353,8 +373,10 @@ func (me *TCRService) DeleteTCRNameSpace(ctx context.Context, instanceId string,
 	request.RegistryId = &instanceId
 	request.NamespaceName = &name
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTCRClient().DeleteNamespace(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseTCRClient().DeleteNamespaceWithContext(ctx, request)
 	return err
 }
 
@@ -375,8 +397,10 @@ func (me *TCRService) DescribeTCRNameSpaces(ctx context.Context, instanceId stri
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTCRClient().DescribeNamespaces(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTCRClient().DescribeNamespacesWithContext(ctx, request)
 		if err != nil {
 			ee, ok := err.(*sdkErrors.TencentCloudSDKError)
 			if !ok {
@@ -442,8 +466,10 @@ func (me *TCRService) CreateTCRRepository(ctx context.Context, instanceId string
 		request.Description = &description
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTCRClient().CreateRepository(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTCRClient().CreateRepositoryWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -474,8 +500,10 @@ func (me *TCRService) ModifyTCRRepository(ctx context.Context, instanceId string
 		request.Description = &description
 	}
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTCRClient().ModifyRepository(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseTCRClient().ModifyRepositoryWithContext(ctx, request)
 	return err
 }
 
@@ -491,8 +519,10 @@ func (me *TCRService) DeleteTCRRepository(ctx context.Context, instanceId string
 	request.NamespaceName = &namespace
 	request.RepositoryName = &repositoryName
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTCRClient().DeleteRepository(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseTCRClient().DeleteRepositoryWithContext(ctx, request)
 	return err
 }
 
@@ -515,8 +545,10 @@ func (me *TCRService) DescribeTCRRepositories(ctx context.Context, instanceId st
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTCRClient().DescribeRepositories(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTCRClient().DescribeRepositoriesWithContext(ctx, request)
 		if err != nil {
 			ee, ok := err.(*sdkErrors.TencentCloudSDKError)
 			if !ok {
@@ -575,8 +607,10 @@ func (me *TCRService) CreateTCRLongTermToken(ctx context.Context, instanceId str
 	request.TokenType = helper.String("longterm")
 	request.Desc = &description
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTCRClient().CreateInstanceToken(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTCRClient().CreateInstanceTokenWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -603,8 +637,10 @@ func (me *TCRService) ModifyTCRLongTermToken(ctx context.Context, instanceId str
 	request.TokenId = &tokenId
 	request.Enable = &enable
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTCRClient().ModifyInstanceToken(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseTCRClient().ModifyInstanceTokenWithContext(ctx, request)
 	return err
 }
 
@@ -619,8 +655,10 @@ func (me *TCRService) DeleteTCRLongTermToken(ctx context.Context, instanceId str
 	request.RegistryId = &instanceId
 	request.TokenId = &tokenId
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTCRClient().DeleteInstanceToken(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseTCRClient().DeleteInstanceTokenWithContext(ctx, request)
 	return err
 }
 
@@ -638,8 +676,10 @@ func (me *TCRService) DescribeTCRTokens(ctx context.Context, instanceId string,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTCRClient().DescribeInstanceToken(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTCRClient().DescribeInstanceTokenWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -699,8 +739,10 @@ func (me *TCRService) CreateTCRVPCAttachment(ctx context.Context, instanceId str
 	request.RegionId = helper.Int64Uint64(regionId)
 	request.RegionName = &regionName
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTCRClient().ManageInternalEndpoint(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTCRClient().ManageInternalEndpointWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -728,8 +770,10 @@ func (me *TCRService) DeleteTCRVPCAttachment(ctx context.Context, instanceId str
 	request.RegionId = helper.IntUint64(regionId)
 	request.RegionName = &regionName
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTCRClient().ManageInternalEndpoint(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTCRClient().ManageInternalEndpointWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -762,8 +806,10 @@ func (me *TCRService) DescribeTCRVPCAttachments(ctx context.Context, instanceId
 	}()
 	request.RegistryId = &instanceId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTCRClient().DescribeInternalEndpoints(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTCRClient().DescribeInternalEndpointsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -817,8 +863,10 @@ func (me *TCRService) CreateTcrVpcDns(ctx context.Context, instanceId string, vp
 	request.UsePublicDomain = &usePublicDomain
 	request.RegionName = &regionName
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTCRClient().CreateInternalEndpointDns(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTCRClient().CreateInternalEndpointDnsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -843,8 +891,10 @@ func (me *TCRService) DeleteTcrVpcDns(ctx context.Context, instanceId string, vp
 	request.UsePublicDomain = &usePublicDomain
 	request.RegionName = &regionName
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTCRClient().DeleteInternalEndpointDns(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTCRClient().DeleteInternalEndpointDnsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -870,8 +920,10 @@ func (me *TCRService) DescribeTcrVpcDnsById(ctx context.Context, instanceId stri
 		UsePublicDomain: &usePublicDomain,
 	})
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTCRClient().DescribeInternalEndpointDnsStatus(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTCRClient().DescribeInternalEndpointDnsStatusWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -900,8 +952,10 @@ func (me *TCRService) CreateReplicationInstance(ctx context.Context, request *tc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTCRClient().CreateReplicationInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTCRClient().CreateReplicationInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -911,7 +965,7 @@ func (me *TCRService) CreateReplicationInstance(ctx context.Context, request *tc
 
 	startPolling := false
 
-	err = resource.Retry(readRetryTimeout*5, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout*5, func() *resource.RetryError {
 		req := tcr.NewDescribeReplicationInstancesRequest()
 		req.RegistryId = request.RegistryId
 		req.Limit = helper.IntInt64(100)
@@ -959,8 +1013,10 @@ func (me *TCRService) DeleteReplicationInstance(ctx context.Context, request *tc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTCRClient().DeleteReplicationInstance(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTCRClient().DeleteReplicationInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -968,7 +1024,7 @@ func (me *TCRService) DeleteReplicationInstance(ctx context.Context, request *tc
 
 	startPolling := false
 
-	err = resource.Retry(readRetryTimeout*3, func() *resource.RetryError {
+	err = retryContext(ctx, readRetryTimeout*3, func() *resource.RetryError {
 		req := tcr.NewDescribeReplicationInstancesRequest()
 		req.RegistryId = request.RegistryId
 		req.Limit = helper.IntInt64(100)
@@ -1017,8 +1073,10 @@ func (me *TCRService) DescribeReplicationInstances(ctx context.Context, request
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTCRClient().DescribeReplicationInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTCRClient().DescribeReplicationInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1045,9 +1103,11 @@ func (me *TCRService) DescribeTcrTagRetentionRuleById(ctx context.Context, regis
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTCRClient().DescribeTagRetentionRules(request)
+	response, err := me.client.UseTCRClient().DescribeTagRetentionRulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1085,9 +1145,11 @@ func (me *TCRService) DeleteTcrTagRetentionRuleById(ctx context.Context, registr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTCRClient().DeleteTagRetentionRule(request)
+	response, err := me.client.UseTCRClient().DeleteTagRetentionRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1110,9 +1172,11 @@ func (me *TCRService) DescribeTcrWebhookTriggerById(ctx context.Context, registr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTCRClient().DescribeWebhookTrigger(request)
+	response, err := me.client.UseTCRClient().DescribeWebhookTriggerWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1147,9 +1211,11 @@ func (me *TCRService) DeleteTcrWebhookTriggerById(ctx context.Context, registryI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTCRClient().DeleteWebhookTrigger(request)
+	response, err := me.client.UseTCRClient().DeleteWebhookTriggerWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1183,7 +1249,9 @@ func (me *TCRService) DescribeTcrWebhookTriggerLogByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1192,7 +1260,7 @@ func (me *TCRService) DescribeTcrWebhookTriggerLogByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTCRClient().DescribeWebhookTriggerLog(request)
+		response, err := me.client.UseTCRClient().DescribeWebhookTriggerLogWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1225,9 +1293,11 @@ func (me *TCRService) DescribeTcrCustomizedDomainById(ctx context.Context, regis
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTCRClient().DescribeInstanceCustomizedDomain(request)
+	response, err := me.client.UseTCRClient().DescribeInstanceCustomizedDomainWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1265,9 +1335,11 @@ func (me *TCRService) DeleteTcrCustomizedDomainById(ctx context.Context, registr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTCRClient().DeleteInstanceCustomizedDomain(request)
+	response, err := me.client.UseTCRClient().DeleteInstanceCustomizedDomainWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1302,9 +1374,11 @@ func (me *TCRService) DescribeTcrImmutableTagRuleById(ctx context.Context, regis
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTCRClient().DescribeImmutableTagRules(request)
+	response, err := me.client.UseTCRClient().DescribeImmutableTagRulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1355,9 +1429,11 @@ func (me *TCRService) DeleteTcrImmutableTagRuleById(ctx context.Context, registr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTCRClient().DeleteImmutableTagRules(request)
+	response, err := me.client.UseTCRClient().DeleteImmutableTagRulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1400,7 +1476,9 @@ func (me *TCRService) DescribeTcrImagesByFilter(ctx context.Context, param map[s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1409,7 +1487,7 @@ func (me *TCRService) DescribeTcrImagesByFilter(ctx context.Context, param map[s
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTCRClient().DescribeImages(request)
+		response, err := me.client.UseTCRClient().DescribeImagesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1457,9 +1535,11 @@ func (me *TCRService) DescribeTcrImageManifestsByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTCRClient().DescribeImageManifests(request)
+	response, err := me.client.UseTCRClient().DescribeImageManifestsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1498,7 +1578,9 @@ func (me *TCRService) DescribeTcrTagRetentionExecutionTasksByFilter(ctx context.
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1507,7 +1589,7 @@ func (me *TCRService) DescribeTcrTagRetentionExecutionTasksByFilter(ctx context.
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTCRClient().DescribeTagRetentionExecutionTask(request)
+		response, err := me.client.UseTCRClient().DescribeTagRetentionExecutionTaskWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1541,9 +1623,11 @@ func (me *TCRService) DescribeTcrTagRetentionExecutionConfigById(ctx context.Con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTCRClient().DescribeTagRetentionExecution(request)
+	response, err := me.client.UseTCRClient().DescribeTagRetentionExecutionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1611,9 +1695,11 @@ func (me *TCRService) DescribeTcrReplicationInstanceCreateTasksByFilter(ctx cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTCRClient().DescribeReplicationInstanceCreateTasks(request)
+	response, err := me.client.UseTCRClient().DescribeReplicationInstanceCreateTasksWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1655,7 +1741,9 @@ func (me *TCRService) DescribeTcrReplicationInstanceSyncStatusByFilter(ctx conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1664,7 +1752,7 @@ func (me *TCRService) DescribeTcrReplicationInstanceSyncStatusByFilter(ctx conte
 
 	request.Offset = &offset
 	request.Limit = &limit
-	response, err := me.client.UseTCRClient().DescribeReplicationInstanceSyncStatus(request)
+	response, err := me.client.UseTCRClient().DescribeReplicationInstanceSyncStatusWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1699,7 +1787,9 @@ func (me *TCRService) DescribeTcrTagRetentionExecutionsByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1708,7 +1798,7 @@ func (me *TCRService) DescribeTcrTagRetentionExecutionsByFilter(ctx context.Cont
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTCRClient().DescribeTagRetentionExecution(request)
+		response, err := me.client.UseTCRClient().DescribeTagRetentionExecutionWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1741,9 +1831,11 @@ func (me *TCRService) DescribeTcrServiceAccountById(ctx context.Context, registr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTCRClient().DescribeServiceAccounts(request)
+	response, err := me.client.UseTCRClient().DescribeServiceAccountsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1781,9 +1873,11 @@ func (me *TCRService) DeleteTcrServiceAccountById(ctx context.Context, registryI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTCRClient().DeleteServiceAccount(request)
+	response, err := me.client.UseTCRClient().DeleteServiceAccountWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
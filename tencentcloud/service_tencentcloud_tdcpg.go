@@ -70,8 +70,10 @@ func (me *TdcpgService) IsolateTdcpgInstanceById(ctx context.Context, clusterId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTdcpgClient().IsolateClusterInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTdcpgClient().IsolateClusterInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -90,7 +92,7 @@ func (me *TdcpgService) DeleteTdcpgClusterById(ctx context.Context, clusterId *s
 	}
 
 	// polling the cluster's status to isolated
-	err := resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, 3*readRetryTimeout, func() *resource.RetryError {
 		result, err := me.DescribeTdcpgCluster(ctx, clusterId)
 		if err != nil {
 			return retryError(err)
@@ -125,15 +127,17 @@ func (me *TdcpgService) DeleteTdcpgClusterById(ctx context.Context, clusterId *s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTdcpgClient().DeleteCluster(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTdcpgClient().DeleteClusterWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
 	}
 
 	// wait the cluster to be deleted
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, 5*readRetryTimeout, func() *resource.RetryError {
 		result, err := me.DescribeTdcpgCluster(ctx, clusterId)
 		if err != nil {
 			return retryError(err)
@@ -200,7 +204,7 @@ func (me *TdcpgService) DescribeTdcpgResourceByDealName(ctx context.Context, dea
 
 		log.Printf("[DEBUG]%s dealName:[%v]\n", logId, *dealname)
 
-		response, err := me.client.UseTdcpgClient().DescribeResourcesByDealName(request)
+		response, err := me.client.UseTdcpgClient().DescribeResourcesByDealNameWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITICAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -228,8 +232,10 @@ func (me *TdcpgService) IsolateTdcpgClusterById(ctx context.Context, clusterId *
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTdcpgClient().IsolateCluster(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTdcpgClient().IsolateClusterWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -248,7 +254,7 @@ func (me *TdcpgService) DeleteTdcpgInstanceById(ctx context.Context, clusterId,
 	}
 
 	// polling the instance's status to isolated
-	err := resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, 3*readRetryTimeout, func() *resource.RetryError {
 		result, err := me.DescribeTdcpgInstance(ctx, clusterId, instanceId)
 		if err != nil {
 			return retryError(err)
@@ -286,15 +292,17 @@ func (me *TdcpgService) DeleteTdcpgInstanceById(ctx context.Context, clusterId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTdcpgClient().DeleteClusterInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTdcpgClient().DeleteClusterInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
 	}
 
 	// wait the instance to be deleted
-	err = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err = retryContext(ctx, 3*readRetryTimeout, func() *resource.RetryError {
 		result, err := me.DescribeTdcpgInstance(ctx, clusterId, instanceId)
 		if err != nil {
 			return retryError(err)
@@ -382,13 +390,15 @@ func (me *TdcpgService) DescribeTdcpgClustersByFilter(ctx context.Context, param
 			continue
 		}
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	for {
 		request.PageNumber = helper.IntUint64(currNumber)
 		request.PageSize = helper.IntUint64(pageSize)
 
-		response, err := me.client.UseTdcpgClient().DescribeClusters(request)
+		response, err := me.client.UseTdcpgClient().DescribeClustersWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITICAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -465,14 +475,16 @@ func (me *TdcpgService) DescribeTdcpgInstancesByFilter(ctx context.Context, clus
 			continue
 		}
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	for {
 		request.PageNumber = helper.IntUint64(currNumber)
 		request.PageSize = helper.IntUint64(pageSize)
 		request.ClusterId = clusterId
 
-		response, err := me.client.UseTdcpgClient().DescribeClusterInstances(request)
+		response, err := me.client.UseTdcpgClient().DescribeClusterInstancesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITICAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
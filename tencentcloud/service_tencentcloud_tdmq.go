@@ -34,9 +34,11 @@ func (me *TdmqService) DescribeTdmqInstanceById(ctx context.Context,
 
 	var response *tdmq.DescribeClustersResponse
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, err := me.client.UseTdmqClient().DescribeClusters(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, err := me.client.UseTdmqClient().DescribeClustersWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -69,9 +71,11 @@ func (me *TdmqService) ModifyTdmqInstanceAttribute(ctx context.Context, clusterI
 	request.ClusterName = &clusterName
 	request.Remark = &remark
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseTdmqClient().ModifyCluster(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseTdmqClient().ModifyClusterWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -93,7 +97,7 @@ func (me *TdmqService) DeleteTdmqInstance(ctx context.Context, clusterId string)
 		}
 	}()
 	request.ClusterId = &clusterId
-	response, err := me.client.UseTdmqClient().DeleteCluster(request)
+	response, err := me.client.UseTdmqClient().DeleteClusterWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -122,9 +126,11 @@ func (me *TdmqService) CreateTdmqNamespace(ctx context.Context, environName stri
 	request.RetentionPolicy = &retentionPolicy
 
 	var response *tdmq.CreateEnvironmentResponse
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, err := me.client.UseTdmqClient().CreateEnvironment(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, err := me.client.UseTdmqClient().CreateEnvironmentWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -154,9 +160,11 @@ func (me *TdmqService) DescribeTdmqNamespaceById(ctx context.Context,
 
 	var response *tdmq.DescribeEnvironmentsResponse
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, err := me.client.UseTdmqClient().DescribeEnvironments(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, err := me.client.UseTdmqClient().DescribeEnvironmentsWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -191,9 +199,11 @@ func (me *TdmqService) ModifyTdmqNamespaceAttribute(ctx context.Context, environ
 	request.ClusterId = &clusterId
 	request.RetentionPolicy = retentionPolicy
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseTdmqClient().ModifyEnvironmentAttributes(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseTdmqClient().ModifyEnvironmentAttributesWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -216,9 +226,11 @@ func (me *TdmqService) DeleteTdmqNamespace(ctx context.Context, environId string
 	}()
 	request.EnvironmentIds = []*string{&environId}
 	request.ClusterId = &clusterId
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseTdmqClient().DeleteEnvironments(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseTdmqClient().DeleteEnvironmentsWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -254,9 +266,11 @@ func (me *TdmqService) CreateTdmqTopic(ctx context.Context, environId string, to
 		request.PulsarTopicType = &pulsarTopicType
 	}
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseTdmqClient().CreateTopic(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseTdmqClient().CreateTopicWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -285,9 +299,11 @@ func (me *TdmqService) DescribeTdmqTopicById(ctx context.Context,
 
 	var response *tdmq.DescribeTopicsResponse
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, err := me.client.UseTdmqClient().DescribeTopics(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, err := me.client.UseTdmqClient().DescribeTopicsWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -322,9 +338,11 @@ func (me *TdmqService) ModifyTdmqTopicAttribute(ctx context.Context, environId s
 	request.Remark = &remark
 	request.ClusterId = &clusterId
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseTdmqClient().ModifyTopic(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseTdmqClient().ModifyTopicWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -353,9 +371,11 @@ func (me *TdmqService) DeleteTdmqTopic(ctx context.Context, environId string, to
 	request.TopicSets = []*tdmq.TopicRecord{&topicRecord}
 	request.ClusterId = &clusterId
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseTdmqClient().DeleteTopics(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseTdmqClient().DeleteTopicsWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -384,9 +404,11 @@ func (me *TdmqService) CreateTdmqRole(ctx context.Context, roleName string, clus
 	request.Remark = &remark
 
 	var response *tdmq.CreateRoleResponse
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, err := me.client.UseTdmqClient().CreateRole(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, err := me.client.UseTdmqClient().CreateRoleWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -416,9 +438,11 @@ func (me *TdmqService) DescribeTdmqRoleById(ctx context.Context,
 
 	var response *tdmq.DescribeRolesResponse
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, err := me.client.UseTdmqClient().DescribeRoles(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, err := me.client.UseTdmqClient().DescribeRolesWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -451,9 +475,11 @@ func (me *TdmqService) ModifyTdmqRoleAttribute(ctx context.Context, roleName str
 	request.ClusterId = &clusterId
 	request.Remark = &remark
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseTdmqClient().ModifyRole(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseTdmqClient().ModifyRoleWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -478,9 +504,11 @@ func (me *TdmqService) DeleteTdmqRole(ctx context.Context, roleName string, clus
 	request.RoleNames = []*string{&roleName}
 	request.ClusterId = &cluserId
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseTdmqClient().DeleteRoles(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseTdmqClient().DeleteRolesWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -509,9 +537,11 @@ func (me *TdmqService) CreateTdmqNamespaceRoleAttachment(ctx context.Context, en
 	request.Permissions = permissions
 	request.ClusterId = &clusterId
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseTdmqClient().CreateEnvironmentRole(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseTdmqClient().CreateEnvironmentRoleWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -540,9 +570,11 @@ func (me *TdmqService) DescribeTdmqNamespaceRoleAttachment(ctx context.Context,
 
 	var response *tdmq.DescribeEnvironmentRolesResponse
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, err := me.client.UseTdmqClient().DescribeEnvironmentRoles(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, err := me.client.UseTdmqClient().DescribeEnvironmentRolesWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -576,9 +608,11 @@ func (me *TdmqService) ModifyTdmqNamespaceRoleAttachment(ctx context.Context,
 	request.ClusterId = &clusterId
 	request.Permissions = permissions
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseTdmqClient().ModifyEnvironmentRole(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseTdmqClient().ModifyEnvironmentRoleWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -605,9 +639,11 @@ func (me *TdmqService) DeleteTdmqNamespaceRoleAttachment(ctx context.Context, en
 	request.RoleNames = []*string{&roleName}
 	request.ClusterId = &cluserId
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseTdmqClient().DeleteEnvironmentRoles(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseTdmqClient().DeleteEnvironmentRolesWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
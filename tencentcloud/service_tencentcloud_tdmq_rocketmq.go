@@ -32,7 +32,7 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqCluster(ctx context.Context,
 	}()
 	request.ClusterId = &clusterId
 
-	response, err := me.client.UseTdmqClient().DescribeRocketMQCluster(request)
+	response, err := me.client.UseTdmqClient().DescribeRocketMQClusterWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -60,8 +60,10 @@ func (me *TdmqRocketmqService) DeleteTdmqRocketmqClusterById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTdmqClient().DeleteRocketMQCluster(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTdmqClient().DeleteRocketMQClusterWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -92,8 +94,10 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqNamespace(ctx context.Context
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTdmqClient().DescribeRocketMQNamespaces(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTdmqClient().DescribeRocketMQNamespacesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -129,8 +133,10 @@ func (me *TdmqRocketmqService) DeleteTdmqRocketmqNamespaceById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTdmqClient().DeleteRocketMQNamespace(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTdmqClient().DeleteRocketMQNamespaceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -162,7 +168,9 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqRole(ctx context.Context, clu
 			Values: []*string{&roleName},
 		},
 	)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset int64 = 0
 	var pageSize int64 = 100
@@ -171,8 +179,10 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqRole(ctx context.Context, clu
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTdmqClient().DescribeRoles(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTdmqClient().DescribeRolesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -216,8 +226,10 @@ func (me *TdmqRocketmqService) DeleteTdmqRocketmqRoleById(ctx context.Context, c
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTdmqClient().DeleteRoles(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTdmqClient().DeleteRolesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -251,8 +263,10 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqTopic(ctx context.Context, cl
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTdmqClient().DescribeRocketMQTopics(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTdmqClient().DescribeRocketMQTopicsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -291,8 +305,10 @@ func (me *TdmqRocketmqService) DeleteTdmqRocketmqTopicById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTdmqClient().DeleteRocketMQTopic(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTdmqClient().DeleteRocketMQTopicWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -326,8 +342,10 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqGroup(ctx context.Context, cl
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTdmqClient().DescribeRocketMQGroups(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTdmqClient().DescribeRocketMQGroupsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -376,8 +394,10 @@ func (me *TdmqRocketmqService) DeleteTdmqRocketmqGroupById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTdmqClient().DeleteRocketMQGroup(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTdmqClient().DeleteRocketMQGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -410,8 +430,10 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqEnvironmentRole(ctx context.C
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTdmqClient().DescribeEnvironmentRoles(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTdmqClient().DescribeEnvironmentRolesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -449,8 +471,10 @@ func (me *TdmqRocketmqService) DeleteTdmqRocketmqEnvironmentRoleById(ctx context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTdmqClient().DeleteEnvironmentRoles(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTdmqClient().DeleteEnvironmentRolesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -488,7 +512,9 @@ func (me *TdmqRocketmqService) DescribeRocketmqClusterByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -496,8 +522,10 @@ func (me *TdmqRocketmqService) DescribeRocketmqClusterByFilter(ctx context.Conte
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTdmqClient().DescribeRocketMQClusters(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTdmqClient().DescribeRocketMQClustersWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -542,7 +570,9 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqNamespaceByFilter(ctx context
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -550,8 +580,10 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqNamespaceByFilter(ctx context
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTdmqClient().DescribeRocketMQNamespaces(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTdmqClient().DescribeRocketMQNamespacesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -609,7 +641,9 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqTopicByFilter(ctx context.Con
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -617,8 +651,10 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqTopicByFilter(ctx context.Con
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTdmqClient().DescribeRocketMQTopics(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTdmqClient().DescribeRocketMQTopicsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -656,7 +692,9 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqRoleByFilter(ctx context.Cont
 	if v, ok := param["role_name"]; ok {
 		request.RoleName = helper.String(v.(string))
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset int64 = 0
 	var pageSize int64 = 20
@@ -664,8 +702,10 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqRoleByFilter(ctx context.Cont
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTdmqClient().DescribeRoles(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTdmqClient().DescribeRolesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -722,7 +762,9 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqGroupByFilter(ctx context.Con
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -730,8 +772,10 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqGroupByFilter(ctx context.Con
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTdmqClient().DescribeRocketMQGroups(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTdmqClient().DescribeRocketMQGroupsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -775,9 +819,11 @@ func (me *TdmqService) DescribeTdmqEnvironmentAttributesByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DescribeEnvironmentAttributes(request)
+	response, err := me.client.UseTdmqClient().DescribeEnvironmentAttributesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -817,9 +863,11 @@ func (me *TdmqService) DescribeTdmqPublisherSummaryByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DescribePublisherSummary(request)
+	response, err := me.client.UseTdmqClient().DescribePublisherSummaryWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -865,7 +913,9 @@ func (me *TdmqService) DescribeTdmqPublishersByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -874,7 +924,7 @@ func (me *TdmqService) DescribeTdmqPublishersByFilter(ctx context.Context, param
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTdmqClient().DescribePublishers(request)
+		response, err := me.client.UseTdmqClient().DescribePublishersWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -911,9 +961,11 @@ func (me *TdmqService) DescribeTdmqSubscriptionAttachmentById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DescribeSubscriptions(request)
+	response, err := me.client.UseTdmqClient().DescribeSubscriptionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -951,9 +1003,11 @@ func (me *TdmqService) GetTdmqTopicsAttachmentById(ctx context.Context, environm
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DescribeTopics(request)
+	response, err := me.client.UseTdmqClient().DescribeTopicsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -994,9 +1048,11 @@ func (me *TdmqService) DeleteTdmqTopicsAttachmentById(ctx context.Context, envir
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DeleteTopics(request)
+	response, err := me.client.UseTdmqClient().DeleteTopicsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1027,9 +1083,11 @@ func (me *TdmqService) DeleteTdmqSubscriptionAttachmentById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DeleteSubscriptions(request)
+	response, err := me.client.UseTdmqClient().DeleteSubscriptionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1061,7 +1119,9 @@ func (me *TdmqService) DescribeTdmqDeadLetterSourceQueueByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -1070,7 +1130,7 @@ func (me *TdmqService) DescribeTdmqDeadLetterSourceQueueByFilter(ctx context.Con
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTdmqClient().DescribeCmqDeadLetterSourceQueues(request)
+		response, err := me.client.UseTdmqClient().DescribeCmqDeadLetterSourceQueuesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1123,7 +1183,9 @@ func (me *TdmqService) DescribeTdmqRabbitmqNodeListByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -1133,7 +1195,7 @@ func (me *TdmqService) DescribeTdmqRabbitmqNodeListByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTdmqClient().DescribeRabbitMQNodeList(request)
+		response, err := me.client.UseTdmqClient().DescribeRabbitMQNodeListWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1174,7 +1236,9 @@ func (me *TdmqService) DescribeTdmqRabbitmqVipInstanceByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -1183,7 +1247,7 @@ func (me *TdmqService) DescribeTdmqRabbitmqVipInstanceByFilter(ctx context.Conte
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTdmqClient().DescribeRabbitMQVipInstances(request)
+		response, err := me.client.UseTdmqClient().DescribeRabbitMQVipInstancesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1224,9 +1288,11 @@ func (me *TdmqService) DescribeTdmqVipInstanceByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DescribeRocketMQVipInstanceDetail(request)
+	response, err := me.client.UseTdmqClient().DescribeRocketMQVipInstanceDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1260,9 +1326,11 @@ func (me *TdmqService) DescribeTdmqProInstanceDetailByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DescribePulsarProInstanceDetail(request)
+	response, err := me.client.UseTdmqClient().DescribePulsarProInstanceDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1297,7 +1365,9 @@ func (me *TdmqService) DescribeTdmqProInstancesByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -1307,7 +1377,7 @@ func (me *TdmqService) DescribeTdmqProInstancesByFilter(ctx context.Context, par
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTdmqClient().DescribePulsarProInstances(request)
+		response, err := me.client.UseTdmqClient().DescribePulsarProInstancesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1361,9 +1431,11 @@ func (me *TdmqService) DescribeTdmqMessageByFilter(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DescribeRocketMQMsg(request)
+	response, err := me.client.UseTdmqClient().DescribeRocketMQMsgWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1398,7 +1470,9 @@ func (me *TdmqService) DescribeTdmqRabbitmqVirtualHostListByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -1408,7 +1482,7 @@ func (me *TdmqService) DescribeTdmqRabbitmqVirtualHostListByFilter(ctx context.C
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTdmqClient().DescribeRabbitMQVirtualHostList(request)
+		response, err := me.client.UseTdmqClient().DescribeRabbitMQVirtualHostListWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1445,9 +1519,11 @@ func (me *TdmqService) DescribeTdmqRabbitmqUserById(ctx context.Context, instanc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DescribeRabbitMQUser(request)
+	response, err := me.client.UseTdmqClient().DescribeRabbitMQUserWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1475,9 +1551,11 @@ func (me *TdmqService) DeleteTdmqRabbitmqUserById(ctx context.Context, instanceI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DeleteRabbitMQUser(request)
+	response, err := me.client.UseTdmqClient().DeleteRabbitMQUserWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1503,9 +1581,11 @@ func (me *TdmqService) DescribeTdmqRabbitmqVirtualHostById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DescribeRabbitMQVirtualHost(request)
+	response, err := me.client.UseTdmqClient().DescribeRabbitMQVirtualHostWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1534,9 +1614,11 @@ func (me *TdmqService) DeleteTdmqRabbitmqVirtualHostById(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DeleteRabbitMQVirtualHost(request)
+	response, err := me.client.UseTdmqClient().DeleteRabbitMQVirtualHostWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1559,9 +1641,11 @@ func (me *TdmqService) DescribeTdmqRabbitmqVipInstanceById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DescribeRabbitMQVipInstance(request)
+	response, err := me.client.UseTdmqClient().DescribeRabbitMQVipInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1587,9 +1671,11 @@ func (me *TdmqService) DeleteTdmqRabbitmqVipInstanceById(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DeleteRabbitMQVipInstance(request)
+	response, err := me.client.UseTdmqClient().DeleteRabbitMQVipInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1611,9 +1697,11 @@ func (me *TdmqService) DescribeTdmqRocketmqVipInstanceById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DescribeRocketMQVipInstanceDetail(request)
+	response, err := me.client.UseTdmqClient().DescribeRocketMQVipInstanceDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1644,9 +1732,11 @@ func (me *TdmqService) DescribeTdmqRocketmqVipInstancesByFilter(ctx context.Cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DescribeRocketMQVipInstances(request)
+	response, err := me.client.UseTdmqClient().DescribeRocketMQVipInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1673,9 +1763,11 @@ func (me *TdmqService) DeleteTdmqRocketmqVipInstanceById(ctx context.Context, cl
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTdmqClient().DeleteRocketMQVipInstance(request)
+	response, err := me.client.UseTdmqClient().DeleteRocketMQVipInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -31,7 +31,7 @@ func (me *TemService) DescribeTemEnvironmentStatus(ctx context.Context, environm
 	}()
 	request.EnvironmentIds = []*string{&environmentId}
 
-	response, err := me.client.UseTemClient().DescribeEnvironmentStatus(request)
+	response, err := me.client.UseTemClient().DescribeEnvironmentStatusWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -61,7 +61,7 @@ func (me *TemService) DescribeTemEnvironment(ctx context.Context, environmentId
 	}()
 	request.EnvironmentId = &environmentId
 
-	response, err := me.client.UseTemClient().DescribeEnvironment(request)
+	response, err := me.client.UseTemClient().DescribeEnvironmentWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -87,8 +87,10 @@ func (me *TemService) DeleteTemEnvironmentById(ctx context.Context, environmentI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTemClient().DestroyEnvironment(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTemClient().DestroyEnvironmentWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -113,7 +115,7 @@ func (me *TemService) DescribeTemApplication(ctx context.Context, applicationId
 	}()
 	request.ApplicationId = &applicationId
 
-	response, err := me.client.UseTemClient().DescribeApplications(request)
+	response, err := me.client.UseTemClient().DescribeApplicationsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -141,8 +143,10 @@ func (me *TemService) DeleteTemApplicationById(ctx context.Context, applicationI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTemClient().DeleteApplication(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTemClient().DeleteApplicationWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -168,7 +172,7 @@ func (me *TemService) DescribeTemWorkload(ctx context.Context, environmentId str
 	request.EnvironmentId = &environmentId
 	request.ApplicationId = &applicationId
 
-	response, err := me.client.UseTemClient().DescribeApplicationInfo(request)
+	response, err := me.client.UseTemClient().DescribeApplicationInfoWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -195,8 +199,10 @@ func (me *TemService) DeleteTemWorkloadById(ctx context.Context, environmentId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTemClient().DeleteApplication(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTemClient().DeleteApplicationWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -222,7 +228,7 @@ func (me *TemService) DescribeTemAppConfig(ctx context.Context, environmentId st
 	request.EnvironmentId = &environmentId
 	request.Name = &name
 
-	response, err := me.client.UseTemClient().DescribeConfigData(request)
+	response, err := me.client.UseTemClient().DescribeConfigDataWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -249,8 +255,10 @@ func (me *TemService) DeleteTemAppConfigById(ctx context.Context, environmentId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTemClient().DestroyConfigData(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTemClient().DestroyConfigDataWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -277,7 +285,7 @@ func (me *TemService) DescribeTemLogConfig(ctx context.Context, environmentId st
 	request.ApplicationId = &applicationId
 	request.Name = &name
 
-	response, err := me.client.UseTemClient().DescribeLogConfig(request)
+	response, err := me.client.UseTemClient().DescribeLogConfigWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -305,8 +313,10 @@ func (me *TemService) DeleteTemLogConfigById(ctx context.Context, environmentId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTemClient().DestroyLogConfig(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTemClient().DestroyLogConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -332,7 +342,7 @@ func (me *TemService) DescribeTemScaleRule(ctx context.Context, environmentId st
 	request.EnvironmentId = &environmentId
 	request.ApplicationId = &applicationId
 
-	response, err := me.client.UseTemClient().DescribeApplicationAutoscalerList(request)
+	response, err := me.client.UseTemClient().DescribeApplicationAutoscalerListWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -366,8 +376,10 @@ func (me *TemService) DisableTemScaleRuleById(ctx context.Context, environmentId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTemClient().DisableApplicationAutoscaler(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTemClient().DisableApplicationAutoscalerWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -393,8 +405,10 @@ func (me *TemService) DeleteTemScaleRuleById(ctx context.Context, environmentId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTemClient().DeleteApplicationAutoscaler(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTemClient().DeleteApplicationAutoscalerWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -421,8 +435,8 @@ func (me *TemService) DescribeTemGateway(ctx context.Context, environmentId stri
 	request.IngressName = &ingressName
 	request.ClusterNamespace = helper.String("default")
 
-	err := resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
-		response, errRet := me.client.UseTemClient().DescribeIngress(request)
+	err := retryContext(ctx, 2*readRetryTimeout, func() *resource.RetryError {
+		response, errRet := me.client.UseTemClient().DescribeIngressWithContext(ctx, request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
 		}
@@ -458,8 +472,10 @@ func (me *TemService) DeleteTemGatewayById(ctx context.Context, environmentId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTemClient().DeleteIngress(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTemClient().DeleteIngressWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -483,9 +499,11 @@ func (me *TemService) DescribeTemApplicationServiceById(ctx context.Context, env
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTemClient().DescribeApplicationServiceList(request)
+	response, err := me.client.UseTemClient().DescribeApplicationServiceListWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -510,9 +528,11 @@ func (me *TemService) DeleteTemApplicationServiceById(ctx context.Context, envir
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTemClient().DeleteApplicationService(request)
+	response, err := me.client.UseTemClient().DeleteApplicationServiceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
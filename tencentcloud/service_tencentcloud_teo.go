@@ -39,7 +39,9 @@ func (me *TeoService) DescribeTeoZone(ctx context.Context, zoneId string) (zone
 		)
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset int64 = 0
 	var pageSize int64 = 100
@@ -48,8 +50,10 @@ func (me *TeoService) DescribeTeoZone(ctx context.Context, zoneId string) (zone
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTeoClient().DescribeZones(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTeoClient().DescribeZonesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -90,8 +94,10 @@ func (me *TeoService) DeleteTeoZoneById(ctx context.Context, zoneId string) (err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTeoClient().DeleteZone(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTeoClient().DeleteZoneWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -138,8 +144,10 @@ func (me *TeoService) DescribeTeoOriginGroup(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTeoClient().DescribeOriginGroup(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTeoClient().DescribeOriginGroupWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -181,8 +189,10 @@ func (me *TeoService) DeleteTeoOriginGroupById(ctx context.Context, zoneId, orig
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTeoClient().DeleteOriginGroup(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTeoClient().DeleteOriginGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -215,8 +225,10 @@ func (me *TeoService) DescribeTeoRuleEngine(ctx context.Context, zoneId, ruleId
 			Values: []*string{&ruleId},
 		},
 	)
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTeoClient().DescribeRules(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTeoClient().DescribeRulesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -254,8 +266,10 @@ func (me *TeoService) DescribeTeoRuleEngines(ctx context.Context, zoneId string)
 	}()
 
 	request.ZoneId = &zoneId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTeoClient().DescribeRules(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTeoClient().DescribeRulesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -288,8 +302,10 @@ func (me *TeoService) DeleteTeoRuleEngineById(ctx context.Context, zoneId, ruleI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTeoClient().DeleteRules(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTeoClient().DeleteRulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -334,7 +350,9 @@ func (me *TeoService) DescribeTeoApplicationProxy(ctx context.Context,
 		)
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var offset int64 = 0
 	var pageSize int64 = 100
@@ -343,8 +361,10 @@ func (me *TeoService) DescribeTeoApplicationProxy(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTeoClient().DescribeApplicationProxies(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTeoClient().DescribeApplicationProxiesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -387,8 +407,10 @@ func (me *TeoService) DeleteTeoApplicationProxyById(ctx context.Context, zoneId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTeoClient().DeleteApplicationProxy(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTeoClient().DeleteApplicationProxyWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -428,8 +450,10 @@ func (me *TeoService) DescribeTeoApplicationProxyRule(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTeoClient().DescribeApplicationProxies(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTeoClient().DescribeApplicationProxiesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -469,8 +493,10 @@ func (me *TeoService) DeleteTeoApplicationProxyRuleById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTeoClient().DeleteApplicationProxyRule(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTeoClient().DeleteApplicationProxyRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -496,7 +522,7 @@ func (me *TeoService) DescribeTeoZoneSetting(ctx context.Context, zoneId string)
 	}()
 	request.ZoneId = &zoneId
 
-	response, err := me.client.UseTeoClient().DescribeZoneSetting(request)
+	response, err := me.client.UseTeoClient().DescribeZoneSettingWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -538,8 +564,10 @@ func (me *TeoService) DescribeTeoDefaultCertificate(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseTeoClient().DescribeDefaultCertificates(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTeoClient().DescribeDefaultCertificatesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -586,7 +614,7 @@ func (me *TeoService) DescribeTeoZoneAvailablePlansByFilter(ctx context.Context)
 		}
 	}()
 
-	response, err := me.client.UseTeoClient().DescribeAvailablePlans(request)
+	response, err := me.client.UseTeoClient().DescribeAvailablePlansWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -617,7 +645,7 @@ func (me *TeoService) DescribeTeoRuleEnginePriority(ctx context.Context,
 	}()
 	request.ZoneId = &zoneId
 
-	response, err := me.client.UseTeoClient().DescribeRules(request)
+	response, err := me.client.UseTeoClient().DescribeRulesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -644,8 +672,10 @@ func (me *TeoService) DescribeTeoRuleEngineSettingsByFilter(ctx context.Context)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTeoClient().DescribeRulesSetting(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTeoClient().DescribeRulesSettingWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -697,7 +727,9 @@ func (me *TeoService) DescribeTeoAccelerationDomainById(ctx context.Context, zon
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -707,7 +739,7 @@ func (me *TeoService) DescribeTeoAccelerationDomainById(ctx context.Context, zon
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTeoClient().DescribeAccelerationDomains(request)
+		response, err := me.client.UseTeoClient().DescribeAccelerationDomainsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -745,9 +777,11 @@ func (me *TeoService) DeleteTeoAccelerationDomainById(ctx context.Context, zoneI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTeoClient().DeleteAccelerationDomains(request)
+	response, err := me.client.UseTeoClient().DeleteAccelerationDomainsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -776,7 +810,7 @@ func (me *TeoService) DescribeIdentifications(ctx context.Context, domain string
 		},
 	)
 
-	response, err := me.client.UseTeoClient().DescribeIdentifications(request)
+	response, err := me.client.UseTeoClient().DescribeIdentificationsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
 		errRet = err
@@ -793,13 +827,13 @@ func (me *TeoService) ModifyZoneStatus(ctx context.Context, zoneId string, pause
 
 	req := teo.NewModifyZoneStatusRequest()
 	req.ZoneId, req.Paused = &zoneId, helper.Bool(paused)
-	_, e := me.client.UseTeoClient().ModifyZoneStatus(req)
+	_, e := me.client.UseTeoClient().ModifyZoneStatusWithContext(ctx, req)
 	if e != nil {
 		log.Printf("[CRITAL]%s modify zone status failed, reason:%+v", logId, e)
 		return e
 	}
 
-	err := resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, 6*readRetryTimeout, func() *resource.RetryError {
 		instance, errRet := me.DescribeTeoZone(ctx, zoneId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -823,7 +857,7 @@ func (me *TeoService) ModifyZoneStatus(ctx context.Context, zoneId string, pause
 }
 
 func (me *TeoService) CheckAccelerationDomainStatus(ctx context.Context, zoneId, domainName, operate string) error {
-	err := resource.Retry(6*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, 6*readRetryTimeout, func() *resource.RetryError {
 		instance, errRet := me.DescribeTeoAccelerationDomainById(ctx, zoneId, domainName)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -140,8 +140,10 @@ getMoreData:
 	}
 	request.Limit = &limit
 	request.Offset = &offset
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeClusterInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().DescribeClusterInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -218,7 +220,7 @@ func (me *TkeService) DescribeClusters(ctx context.Context, id string, name stri
 		request.Filters = []*tke.Filter{filter}
 	}
 
-	response, err := me.client.UseTkeClient().DescribeClusters(request)
+	response, err := me.client.UseTkeClient().DescribeClustersWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -288,8 +290,10 @@ func (me *TkeService) DescribeCluster(ctx context.Context, id string) (
 
 	request.ClusterIds = []*string{&id}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeClusters(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().DescribeClustersWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -342,8 +346,10 @@ func (me *TkeService) DescribeClusterCommonNames(ctx context.Context, request *t
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeClusterCommonNames(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().DescribeClusterCommonNamesWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -371,8 +377,10 @@ func (me *TkeService) DescribeClusterLevelAttribute(ctx context.Context, id stri
 	if id != "" {
 		request.ClusterID = &id
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeClusterLevelAttribute(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().DescribeClusterLevelAttributeWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -404,8 +412,10 @@ func (me *TkeService) DescribeClusterConfig(ctx context.Context, id string, isPu
 
 	request.ClusterId = &id
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeClusterKubeconfig(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().DescribeClusterKubeconfigWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -437,8 +447,10 @@ func (me *TkeService) GetUpgradeInstanceResult(ctx context.Context, id string) (
 
 	request.ClusterId = &id
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().GetUpgradeInstanceProgress(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().GetUpgradeInstanceProgressWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -629,8 +641,10 @@ func (me *TkeService) CreateCluster(ctx context.Context,
 		request.ExistedInstancesForNode = existedInstance
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().CreateCluster(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().CreateClusterWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -658,8 +672,10 @@ func (me *TkeService) CreateClusterInstances(ctx context.Context,
 
 	request.InstanceAdvancedSettings = &iAdvanced
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().CreateClusterInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().CreateClusterInstancesWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -681,40 +697,42 @@ func (me *TkeService) CreateClusterInstances(ctx context.Context,
 }
 
 func (me *TkeService) CheckOneOfClusterNodeReady(ctx context.Context, clusterId string, mustHaveWorkers bool) error {
-	return resource.Retry(readRetryTimeout*5, func() *resource.RetryError {
-		_, workers, err := me.DescribeClusterInstances(ctx, clusterId)
-		if err != nil {
-			return retryError(err)
-		}
-
-		// check serverless node
-		virtualNodes, err := me.DescribeClusterVirtualNode(ctx, clusterId)
-		if err != nil {
-			return retryError(err)
-		}
+	waiter := &helper.StateWaiter{
+		Name:    fmt.Sprintf("one of the workers of cluster %s", clusterId),
+		Pending: []string{"waiting"},
+		Target:  []string{"ready"},
+		Refresh: func() (interface{}, string, error) {
+			_, workers, err := me.DescribeClusterInstances(ctx, clusterId)
+			if err != nil {
+				return nil, "", err
+			}
 
-		if len(workers) == 0 && len(virtualNodes) == 0 {
-			if mustHaveWorkers {
-				return resource.RetryableError(fmt.Errorf("waiting for workers created"))
+			// check serverless node
+			virtualNodes, err := me.DescribeClusterVirtualNode(ctx, clusterId)
+			if err != nil {
+				return nil, "", err
 			}
-			return nil
-		}
 
-		for i := range workers {
-			worker := workers[i]
-			if worker.InstanceState == "running" {
-				return nil
+			if len(workers) == 0 && len(virtualNodes) == 0 && !mustHaveWorkers {
+				return workers, "ready", nil
 			}
-		}
-		for i := range virtualNodes {
-			virtualNode := virtualNodes[i]
-			if virtualNode.Phase != nil && *virtualNode.Phase == "Running" {
-				return nil
+			for i := range workers {
+				if workers[i].InstanceState == "running" {
+					return workers, "ready", nil
+				}
 			}
-		}
-
-		return resource.RetryableError(fmt.Errorf("cluster %s waiting for one of the workers ready", clusterId))
-	})
+			for i := range virtualNodes {
+				if virtualNodes[i].Phase != nil && *virtualNodes[i].Phase == "Running" {
+					return workers, "ready", nil
+				}
+			}
+			return workers, "waiting", nil
+		},
+		Retryable: retryableErrorFunc(ctx),
+		Timeout:   readRetryTimeout * 5,
+	}
+	_, err := waiter.WaitForStateContext(ctx)
+	return err
 }
 
 /*
@@ -738,8 +756,10 @@ func (me *TkeService) DeleteClusterInstances(ctx context.Context, id string, ins
 	}
 
 	request.InstanceDeleteMode = helper.String("terminate")
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().DeleteClusterInstances(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseTkeClient().DeleteClusterInstancesWithContext(ctx, request)
 	return err
 }
 
@@ -757,8 +777,10 @@ func (me *TkeService) DeleteCluster(ctx context.Context, id string) (errRet erro
 	request.ClusterId = &id
 	request.InstanceDeleteMode = helper.String("terminate")
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().DeleteCluster(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseTkeClient().DeleteClusterWithContext(ctx, request)
 
 	return err
 }
@@ -776,7 +798,7 @@ func (me *TkeService) DescribeClusterSecurity(ctx context.Context, id string) (r
 	}()
 	request.ClusterId = &id
 
-	return me.client.UseTkeClient().DescribeClusterSecurity(request)
+	return me.client.UseTkeClient().DescribeClusterSecurityWithContext(ctx, request)
 }
 
 func (me *TkeService) CreateClusterAsGroup(ctx context.Context, id, groupPara, configPara string, labels []*tke.Label, iAdvanced InstanceAdvancedSettings) (asGroupId string, errRet error) {
@@ -790,8 +812,10 @@ func (me *TkeService) DescribeClusterAsGroupsByGroupId(ctx context.Context, id s
 	request.ClusterId = &id
 	request.AutoScalingGroupIds = []*string{&groupId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeClusterAsGroups(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().DescribeClusterAsGroupsWithContext(ctx, request)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, reason[%s]\n", logId, request.GetAction(), err.Error())
@@ -818,8 +842,10 @@ func (me *TkeService) DeleteClusterAsGroups(ctx context.Context, id, asGroupId s
 	request.ClusterId = &id
 	request.AutoScalingGroupIds = []*string{&asGroupId}
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().DeleteClusterAsGroups(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseTkeClient().DeleteClusterAsGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 	}
@@ -858,8 +884,10 @@ func (me *TkeService) CreateClusterEndpoint(ctx context.Context, id string, subn
 		request.ExtensiveParameters = helper.String(extensiveParameters)
 	}
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().CreateClusterEndpoint(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseTkeClient().CreateClusterEndpointWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 	}
@@ -878,9 +906,11 @@ func (me *TkeService) DescribeClusterEndpointStatus(ctx context.Context, id stri
 	request.ClusterId = &id
 	request.IsExtranet = &isExtranet
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTkeClient().DescribeClusterEndpointStatus(request)
+	response, err := me.client.UseTkeClient().DescribeClusterEndpointStatusWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -905,9 +935,11 @@ func (me *TkeService) DeleteClusterEndpoint(ctx context.Context, id string, isIn
 	request.ClusterId = &id
 	request.IsExtranet = &isInternet
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	_, err := me.client.UseTkeClient().DeleteClusterEndpoint(request)
+	_, err := me.client.UseTkeClient().DeleteClusterEndpointWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -926,9 +958,11 @@ func (me *TkeService) ModifyClusterEndpointSP(ctx context.Context, id string, se
 	request.ClusterId = &id
 	request.SecurityPolicies = helper.Strings(securityPolicies)
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	_, err := me.client.UseTkeClient().ModifyClusterEndpointSP(request)
+	_, err := me.client.UseTkeClient().ModifyClusterEndpointSPWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -947,9 +981,11 @@ func (me *TkeService) ModifyClusterEndpointSG(ctx context.Context, id string, se
 	request.ClusterId = &id
 	request.SecurityGroup = &securityGroup
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	_, err := me.client.UseTkeClient().ModifyClusterEndpointSP(request)
+	_, err := me.client.UseTkeClient().ModifyClusterEndpointSPWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -978,9 +1014,11 @@ func (me *TkeService) ModifyClusterAttribute(ctx context.Context, id string, pro
 		IsAutoUpgrade: &autoUpgradeClusterLevel,
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	_, err := me.client.UseTkeClient().ModifyClusterAttribute(request)
+	_, err := me.client.UseTkeClient().ModifyClusterAttributeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1037,9 +1075,11 @@ func (me *TkeService) ModifyClusterVersion(ctx context.Context, id string, clust
 	request.ClusterId = &id
 	request.DstVersion = &clusterVersion
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	_, err := me.client.UseTkeClient().UpdateClusterVersion(request)
+	_, err := me.client.UseTkeClient().UpdateClusterVersionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1068,9 +1108,11 @@ func (me *TkeService) DescribeKubernetesAvailableClusterVersionsByFilter(ctx con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTkeClient().DescribeAvailableClusterVersion(request)
+	response, err := me.client.UseTkeClient().DescribeAvailableClusterVersionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1092,9 +1134,11 @@ func (me *TkeService) CheckClusterVersion(ctx context.Context, id string, cluste
 		}
 	}()
 	request.ClusterId = &id
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	resp, err := me.client.UseTkeClient().DescribeAvailableClusterVersion(request)
+	resp, err := me.client.UseTkeClient().DescribeAvailableClusterVersionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1124,9 +1168,11 @@ func (me *TkeService) CheckInstancesUpgradeAble(ctx context.Context, id string,
 	}()
 	request.ClusterId = &id
 	request.UpgradeType = &upgradeType
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	resp, err := me.client.UseTkeClient().CheckInstancesUpgradeAble(request)
+	resp, err := me.client.UseTkeClient().CheckInstancesUpgradeAbleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1155,9 +1201,11 @@ func (me *TkeService) UpgradeClusterInstances(ctx context.Context, id string, up
 	request.ClusterId = &id
 	request.UpgradeType = &upgradeType
 	request.InstanceIds = helper.Strings(instanceIds)
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	_, err := me.client.UseTkeClient().UpgradeClusterInstances(request)
+	_, err := me.client.UseTkeClient().UpgradeClusterInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1175,8 +1223,10 @@ func (me *TkeService) DescribeImages(ctx context.Context) (imageIds []string, er
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeImages(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().DescribeImagesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1228,8 +1278,10 @@ func (me *TkeService) ModifyClusterAsGroupAttribute(ctx context.Context, id, asG
 		},
 	}
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().ModifyClusterAsGroupAttribute(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseTkeClient().ModifyClusterAsGroupAttributeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 	}
@@ -1265,8 +1317,10 @@ func (me *TkeService) CreateClusterNodePool(ctx context.Context, clusterId, name
 		request.Taints = taints
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().CreateClusterNodePool(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().CreateClusterNodePoolWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1317,8 +1371,10 @@ func (me *TkeService) ModifyClusterNodePool(ctx context.Context, clusterId, node
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().ModifyClusterNodePool(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseTkeClient().ModifyClusterNodePoolWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1339,8 +1395,10 @@ func (me *TkeService) ModifyClusterNodePoolDesiredCapacity(ctx context.Context,
 	request.NodePoolId = &nodePoolId
 	request.DesiredCapacity = &desiredCapacity
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().ModifyNodePoolDesiredCapacityAboutAsg(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseTkeClient().ModifyNodePoolDesiredCapacityAboutAsgWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1361,8 +1419,10 @@ func (me *TkeService) ModifyClusterNodePoolInstanceTypes(ctx context.Context, cl
 	request.NodePoolId = &nodePoolId
 	request.InstanceTypes = instanceTypes
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().ModifyNodePoolInstanceTypes(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseTkeClient().ModifyNodePoolInstanceTypesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1384,8 +1444,10 @@ func (me *TkeService) DeleteClusterNodePool(ctx context.Context, id, nodePoolId
 	request.NodePoolIds = []*string{&nodePoolId}
 	request.KeepInstance = &deleteKeepInstance
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().DeleteClusterNodePool(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseTkeClient().DeleteClusterNodePoolWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 	}
@@ -1422,8 +1484,10 @@ func (me *TkeService) DescribeNodePool(ctx context.Context, clusterId string, no
 	request.ClusterId = helper.String(clusterId)
 	request.NodePoolId = helper.String(nodePoolId)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeClusterNodePoolDetail(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().DescribeClusterNodePoolDetailWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1449,8 +1513,10 @@ func (me *TkeService) ModifyClusterNodePoolGlobalConfig(ctx context.Context, req
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().ModifyClusterAsGroupOptionAttribute(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseTkeClient().ModifyClusterAsGroupOptionAttributeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 	}
@@ -1485,8 +1551,10 @@ func (me *TkeService) DescribeClusterNodePoolGlobalConfig(ctx context.Context, c
 
 	request.ClusterId = helper.String(clusterId)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeClusterAsGroupOption(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().DescribeClusterAsGroupOptionWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1503,29 +1571,18 @@ func (me *TkeService) DescribeClusterNodePoolGlobalConfig(ctx context.Context, c
 }
 
 func (me *TkeService) WaitForAuthenticationOptionsUpdateSuccess(ctx context.Context, id string) (info *tke.ServiceAccountAuthenticationOptions, errRet error) {
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		options, state, _, err := me.DescribeClusterAuthenticationOptions(ctx, id)
-		info = options
-
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		if state == "Success" {
-			return nil
-		}
-
-		if state == "Updating" {
-			return resource.RetryableError(fmt.Errorf("state is %s, retry", state))
-		}
-
-		return resource.NonRetryableError(fmt.Errorf("update failed: %s", state))
-	})
-
-	if err != nil {
-		errRet = err
-		return
+	waiter := &helper.StateWaiter{
+		Name:    fmt.Sprintf("authentication options of cluster %s", id),
+		Pending: []string{"Updating"},
+		Target:  []string{"Success"},
+		Refresh: func() (interface{}, string, error) {
+			options, state, _, err := me.DescribeClusterAuthenticationOptions(ctx, id)
+			info = options
+			return options, state, err
+		},
+		Timeout: readRetryTimeout,
 	}
+	_, errRet = waiter.WaitForStateContext(ctx)
 	return
 }
 
@@ -1541,8 +1598,10 @@ func (me *TkeService) DescribeClusterAuthenticationOptions(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	res, err := me.client.UseTkeClient().DescribeClusterAuthenticationOptions(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	res, err := me.client.UseTkeClient().DescribeClusterAuthenticationOptionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 	}
@@ -1564,8 +1623,10 @@ func (me *TkeService) ModifyClusterAuthenticationOptions(ctx context.Context, re
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().ModifyClusterAuthenticationOptions(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().ModifyClusterAuthenticationOptionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 	}
@@ -1586,8 +1647,10 @@ func (me *TkeService) ModifyDeletionProtection(ctx context.Context, id string, e
 		request := tke.NewEnableClusterDeletionProtectionRequest()
 		request.ClusterId = &id
 		action = request.GetAction()
-		ratelimit.Check(action)
-		response, err := me.client.UseTkeClient().EnableClusterDeletionProtection(request)
+		if errRet = ratelimit.CheckContext(ctx, action); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTkeClient().EnableClusterDeletionProtectionWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1599,8 +1662,10 @@ func (me *TkeService) ModifyDeletionProtection(ctx context.Context, id string, e
 		request := tke.NewDisableClusterDeletionProtectionRequest()
 		request.ClusterId = &id
 		action = request.GetAction()
-		ratelimit.Check(action)
-		response, err := me.client.UseTkeClient().DisableClusterDeletionProtection(request)
+		if errRet = ratelimit.CheckContext(ctx, action); errRet != nil {
+			return
+		}
+		response, err := me.client.UseTkeClient().DisableClusterDeletionProtectionWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1632,8 +1697,10 @@ func (me *TkeService) AcquireClusterAdminRole(ctx context.Context, clusterId str
 
 	request.ClusterId = &clusterId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().AcquireClusterAdminRole(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().AcquireClusterAdminRoleWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1708,8 +1775,10 @@ func (me *TkeService) InstallLogAgent(ctx context.Context, request *tke.InstallL
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().InstallLogAgent(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().InstallLogAgentWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1731,8 +1800,10 @@ func (me *TkeService) UninstallLogAgent(ctx context.Context, request *tke.Uninst
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().UninstallLogAgent(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().UninstallLogAgentWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1754,8 +1825,10 @@ func (me *TkeService) EnableEventPersistence(ctx context.Context, request *tke.E
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().EnableEventPersistence(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().EnableEventPersistenceWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1777,8 +1850,10 @@ func (me *TkeService) DisableEventPersistence(ctx context.Context, request *tke.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DisableEventPersistence(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().DisableEventPersistenceWithContext(ctx, request)
 
 	if err != nil {
 		code := err.(*sdkErrors.TencentCloudSDKError).Code
@@ -1804,8 +1879,10 @@ func (me *TkeService) EnableClusterAudit(ctx context.Context, request *tke.Enabl
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().EnableClusterAudit(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().EnableClusterAuditWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1827,8 +1904,10 @@ func (me *TkeService) DisableClusterAudit(ctx context.Context, request *tke.Disa
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DisableClusterAudit(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().DisableClusterAuditWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1854,8 +1933,10 @@ func (me *TkeService) DescribeServerlessNodePoolByClusterIdAndNodePoolId(ctx con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeClusterVirtualNodePools(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().DescribeClusterVirtualNodePoolsWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1886,8 +1967,10 @@ func (me *TkeService) CreateClusterVirtualNodePool(ctx context.Context, request
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().CreateClusterVirtualNodePool(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().CreateClusterVirtualNodePoolWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1914,8 +1997,10 @@ func (me *TkeService) DeleteClusterVirtualNodePool(ctx context.Context, request
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DeleteClusterVirtualNodePool(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().DeleteClusterVirtualNodePoolWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1938,8 +2023,10 @@ func (me *TkeService) ModifyClusterVirtualNodePool(ctx context.Context, request
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().ModifyClusterVirtualNodePool(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().ModifyClusterVirtualNodePoolWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1965,8 +2052,10 @@ func (me *TkeService) DescribeClusterVirtualNode(ctx context.Context, clusterId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeClusterVirtualNode(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().DescribeClusterVirtualNodeWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -2030,7 +2119,7 @@ func ModifySecurityServiceOfCvmInNodePool(ctx context.Context, d *schema.Resourc
 				for _, ins := range instanceSet {
 					requestDeleteMachine := cwp.NewDeleteMachineRequest()
 					requestDeleteMachine.Uuid = ins.Uuid
-					if _, err := client.UseCwpClient().DeleteMachine(requestDeleteMachine); err != nil {
+					if _, err := client.UseCwpClient().DeleteMachineWithContext(ctx, requestDeleteMachine); err != nil {
 						log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 							logId, requestDeleteMachine.GetAction(), requestDeleteMachine.ToJsonString(), err.Error())
 						return err
@@ -2053,7 +2142,7 @@ func ModifySecurityServiceOfCvmInNodePool(ctx context.Context, d *schema.Resourc
 				requestInvokeCommand.CommandId = helper.String(InstallSecurityAgentCommandId)
 				requestInvokeCommand.Parameters = helper.String("{}")
 				requestInvokeCommand.Timeout = helper.Uint64(60)
-				_, err := client.UseTatClient().InvokeCommand(requestInvokeCommand)
+				_, err := client.UseTatClient().InvokeCommandWithContext(ctx, requestInvokeCommand)
 				if err != nil {
 					log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 						logId, requestInvokeCommand.GetAction(), requestInvokeCommand.ToJsonString(), err.Error())
@@ -2077,7 +2166,7 @@ func ModifyClusterInternetOrIntranetAccess(ctx context.Context, d *schema.Resour
 	}
 	// open access
 	if enable {
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 			inErr := tkeSvc.CreateClusterEndpoint(ctx, id, subnetId, sg, isInternet, domain, "")
 			if inErr != nil {
 				return retryError(inErr)
@@ -2087,7 +2176,7 @@ func ModifyClusterInternetOrIntranetAccess(ctx context.Context, d *schema.Resour
 		if err != nil {
 			return err
 		}
-		err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, 2*readRetryTimeout, func() *resource.RetryError {
 			status, message, inErr := tkeSvc.DescribeClusterEndpointStatus(ctx, id, isInternet)
 			if inErr != nil {
 				return retryError(inErr)
@@ -2106,7 +2195,7 @@ func ModifyClusterInternetOrIntranetAccess(ctx context.Context, d *schema.Resour
 			return err
 		}
 	} else { // close access
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 			inErr := tkeSvc.DeleteClusterEndpoint(ctx, id, isInternet)
 			if inErr != nil {
 				return retryError(inErr)
@@ -2116,7 +2205,7 @@ func ModifyClusterInternetOrIntranetAccess(ctx context.Context, d *schema.Resour
 		if err != nil {
 			return err
 		}
-		err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+		err = retryContext(ctx, 2*readRetryTimeout, func() *resource.RetryError {
 			status, message, inErr := tkeSvc.DescribeClusterEndpointStatus(ctx, id, isInternet)
 			if inErr != nil {
 				return retryError(inErr)
@@ -2148,8 +2237,10 @@ func (me *TkeService) createBackupStorageLocation(ctx context.Context, request *
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().CreateBackupStorageLocation(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().CreateBackupStorageLocationWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -2175,8 +2266,10 @@ func (me *TkeService) describeBackupStorageLocations(ctx context.Context, names
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().DescribeBackupStorageLocations(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().DescribeBackupStorageLocationsWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -2205,8 +2298,10 @@ func (me *TkeService) deleteBackupStorageLocation(ctx context.Context, name stri
 	}()
 	request.Name = common.StringPtr(name)
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseTkeClient().DeleteBackupStorageLocation(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseTkeClient().DeleteBackupStorageLocationWithContext(ctx, request)
 	return err
 }
 
@@ -2222,9 +2317,11 @@ func (me *TkeService) DescribeTkeEncryptionProtectionById(ctx context.Context, c
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTkeClient().DescribeEncryptionStatus(request)
+	response, err := me.client.UseTkeClient().DescribeEncryptionStatusWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2247,9 +2344,11 @@ func (me *TkeService) DeleteTkeEncryptionProtectionById(ctx context.Context, clu
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTkeClient().DisableEncryptionProtection(request)
+	response, err := me.client.UseTkeClient().DisableEncryptionProtectionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2276,7 +2375,7 @@ func (me *TkeService) TkeEncryptionProtectionStateRefreshFunc(clusterId string,
 			}
 		}()
 
-		object, err := me.client.UseTkeClient().DescribeEncryptionStatus(request)
+		object, err := me.client.UseTkeClient().DescribeEncryptionStatusWithContext(ctx, request)
 
 		if err != nil {
 			return nil, "", err
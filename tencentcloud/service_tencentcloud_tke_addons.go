@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
@@ -62,8 +61,10 @@ func (me *TkeService) GetTkeAppChartList(ctx context.Context, request *tke.GetTk
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().GetTkeAppChartList(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTkeClient().GetTkeAppChartListWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -84,35 +85,40 @@ func (me *TkeService) PollingAddonsPhase(ctx context.Context, clusterId, addonNa
 		phase    string
 		response string
 		has      bool
+		pending  = []string{"Upgrading", "Installing", "ChartFetched", "RollingBack", "Terminating"}
 	)
 
 	if addonResponseData == nil {
 		addonResponseData = &AddonResponseData{}
 	}
 
-	err = resource.Retry(readRetryTimeout*5, func() *resource.RetryError {
-		response, has, err = me.DescribeExtensionAddon(ctx, clusterId, addonName)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if err = json.Unmarshal([]byte(response), addonResponseData); err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		if addonResponseData.Status == nil {
-			return nil
-		}
-
-		if addonResponseData.Status["phase"] != nil {
-			phase = addonResponseData.Status["phase"].(string)
-		}
-
-		if phase == "Upgrading" || phase == "Installing" || phase == "ChartFetched" || phase == "RollingBack" || phase == "Terminating" {
-			return resource.RetryableError(fmt.Errorf("addon %s is %s, retrying", addonName, phase))
-		}
-
-		return nil
-	})
+	waiter := &helper.StateWaiter{
+		Name:    fmt.Sprintf("addon %s of cluster %s", addonName, clusterId),
+		Pending: pending,
+		Target:  []string{"Done"},
+		Refresh: func() (interface{}, string, error) {
+			response, has, err = me.DescribeExtensionAddon(ctx, clusterId, addonName)
+			if err != nil {
+				return nil, "", err
+			}
+			if err = json.Unmarshal([]byte(response), addonResponseData); err != nil {
+				return nil, "", err
+			}
+
+			if addonResponseData.Status == nil {
+				return addonResponseData, "Done", nil
+			}
+			if addonResponseData.Status["phase"] != nil {
+				phase = addonResponseData.Status["phase"].(string)
+			}
+			if helper.StringsContain(pending, phase) {
+				return addonResponseData, phase, nil
+			}
+			return addonResponseData, "Done", nil
+		},
+		Timeout: readRetryTimeout * 5,
+	}
+	_, err = waiter.WaitForStateContext(ctx)
 
 	return response, has, err
 }
@@ -126,8 +132,10 @@ func (me *TkeService) ProcessExtensionAddons(ctx context.Context, request *tke.F
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err = me.client.UseTkeClient().ForwardApplicationRequestV3(request)
+	if err = ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return
+	}
+	response, err = me.client.UseTkeClient().ForwardApplicationRequestV3WithContext(ctx, request)
 
 	if err != nil {
 		return
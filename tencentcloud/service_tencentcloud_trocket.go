@@ -27,9 +27,11 @@ func (me *TrocketService) DescribeTrocketRocketmqInstanceById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTrocketClient().DescribeInstance(request)
+	response, err := me.client.UseTrocketClient().DescribeInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -52,9 +54,11 @@ func (me *TrocketService) DeleteTrocketRocketmqInstanceById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTrocketClient().DeleteInstance(request)
+	response, err := me.client.UseTrocketClient().DeleteInstanceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -101,7 +105,9 @@ func (me *TrocketService) DescribeTrocketRocketmqTopicById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -111,7 +117,7 @@ func (me *TrocketService) DescribeTrocketRocketmqTopicById(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTrocketClient().DescribeTopicList(request)
+		response, err := me.client.UseTrocketClient().DescribeTopicListWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -149,9 +155,11 @@ func (me *TrocketService) DeleteTrocketRocketmqTopicById(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTrocketClient().DeleteTopic(request)
+	response, err := me.client.UseTrocketClient().DeleteTopicWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -174,9 +182,11 @@ func (me *TrocketService) DescribeTrocketRocketmqConsumerGroupById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTrocketClient().DescribeConsumerGroup(request)
+	response, err := me.client.UseTrocketClient().DescribeConsumerGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -200,9 +210,11 @@ func (me *TrocketService) DeleteTrocketRocketmqConsumerGroupById(ctx context.Con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTrocketClient().DeleteConsumerGroup(request)
+	response, err := me.client.UseTrocketClient().DeleteConsumerGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -229,7 +241,9 @@ func (me *TrocketService) DescribeTrocketRocketmqRoleById(ctx context.Context, i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -239,7 +253,7 @@ func (me *TrocketService) DescribeTrocketRocketmqRoleById(ctx context.Context, i
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTrocketClient().DescribeRoleList(request)
+		response, err := me.client.UseTrocketClient().DescribeRoleListWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -277,9 +291,11 @@ func (me *TrocketService) DeleteTrocketRocketmqRoleById(ctx context.Context, ins
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTrocketClient().DeleteRole(request)
+	response, err := me.client.UseTrocketClient().DeleteRoleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -34,9 +34,11 @@ func (me *TseService) DescribeTseInstanceById(ctx context.Context, instanceId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTseClient().DescribeSREInstances(request)
+	response, err := me.client.UseTseClient().DescribeSREInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -54,7 +56,7 @@ func (me *TseService) DescribeTseInstanceById(ctx context.Context, instanceId st
 func (me *TseService) CheckTseInstanceStatusById(ctx context.Context, instanceId, operate string) (errRet error) {
 	logId := getLogId(ctx)
 
-	err := resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, 7*readRetryTimeout, func() *resource.RetryError {
 		instance, e := me.DescribeTseInstanceById(ctx, instanceId)
 		if e != nil {
 			return resource.NonRetryableError(e)
@@ -120,9 +122,11 @@ func (me *TseService) DeleteTseInstanceById(ctx context.Context, instanceId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTseClient().DeleteEngine(request)
+	response, err := me.client.UseTseClient().DeleteEngineWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -162,8 +166,10 @@ func (me *TseService) DescribeTseAccessAddressByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTseClient().DescribeSREInstanceAccessAddress(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTseClient().DescribeSREInstanceAccessAddressWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -196,7 +202,9 @@ func (me *TseService) DescribeTseNacosReplicasByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -205,7 +213,7 @@ func (me *TseService) DescribeTseNacosReplicasByFilter(ctx context.Context, para
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTseClient().DescribeNacosReplicas(request)
+		response, err := me.client.UseTseClient().DescribeNacosReplicasWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -244,7 +252,9 @@ func (me *TseService) DescribeTseZookeeperReplicasByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -253,7 +263,7 @@ func (me *TseService) DescribeTseZookeeperReplicasByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTseClient().DescribeZookeeperReplicas(request)
+		response, err := me.client.UseTseClient().DescribeZookeeperReplicasWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -292,7 +302,9 @@ func (me *TseService) DescribeTseZookeeperServerInterfacesByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -301,7 +313,7 @@ func (me *TseService) DescribeTseZookeeperServerInterfacesByFilter(ctx context.C
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTseClient().DescribeZookeeperServerInterfaces(request)
+		response, err := me.client.UseTseClient().DescribeZookeeperServerInterfacesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -336,7 +348,9 @@ func (me *TseService) DescribeTseNacosServerInterfacesByFilter(ctx context.Conte
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -345,7 +359,7 @@ func (me *TseService) DescribeTseNacosServerInterfacesByFilter(ctx context.Conte
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTseClient().DescribeNacosServerInterfaces(request)
+		response, err := me.client.UseTseClient().DescribeNacosServerInterfacesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -387,7 +401,9 @@ func (me *TseService) DescribeTseGatewayNodesByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -396,7 +412,7 @@ func (me *TseService) DescribeTseGatewayNodesByFilter(ctx context.Context, param
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayNodes(request)
+		response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayNodesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -438,7 +454,9 @@ func (me *TseService) DescribeTseGatewayCanaryRulesByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -449,7 +467,7 @@ func (me *TseService) DescribeTseGatewayCanaryRulesByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayCanaryRules(request)
+		response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayCanaryRulesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -503,7 +521,9 @@ func (me *TseService) DescribeTseGatewayRoutesByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -514,7 +534,7 @@ func (me *TseService) DescribeTseGatewayRoutesByFilter(ctx context.Context, para
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayRoutes(request)
+		response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayRoutesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -562,7 +582,9 @@ func (me *TseService) DescribeTseGatewayServicesByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -573,7 +595,7 @@ func (me *TseService) DescribeTseGatewayServicesByFilter(ctx context.Context, pa
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayServices(request)
+		response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayServicesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -613,9 +635,11 @@ func (me *TseService) DescribeTseCngwServiceById(ctx context.Context, gatewayId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTseClient().DescribeOneCloudNativeAPIGatewayService(request)
+	response, err := me.client.UseTseClient().DescribeOneCloudNativeAPIGatewayServiceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -643,9 +667,11 @@ func (me *TseService) DeleteTseCngwServiceById(ctx context.Context, gatewayId, n
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayService(request)
+	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayServiceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -668,9 +694,11 @@ func (me *TseService) DescribeTseCngwServiceRateLimitById(ctx context.Context, g
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayServiceRateLimit(request)
+	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayServiceRateLimitWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -698,9 +726,11 @@ func (me *TseService) DeleteTseCngwServiceRateLimitById(ctx context.Context, gat
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayServiceRateLimit(request)
+	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayServiceRateLimitWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -723,9 +753,11 @@ func (me *TseService) DescribeTseCngwRouteById(ctx context.Context, gatewayId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayRoutes(request)
+	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayRoutesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -758,9 +790,11 @@ func (me *TseService) DeleteTseCngwRouteById(ctx context.Context, gatewayId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayRoute(request)
+	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayRouteWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -783,9 +817,11 @@ func (me *TseService) DescribeTseCngwRouteRateLimitById(ctx context.Context, gat
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayRouteRateLimit(request)
+	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayRouteRateLimitWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -813,9 +849,11 @@ func (me *TseService) DeleteTseCngwRouteRateLimitById(ctx context.Context, gatew
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayRouteRateLimit(request)
+	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayRouteRateLimitWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -843,9 +881,11 @@ func (me *TseService) DescribeTseCngwCanaryRuleById(ctx context.Context, gateway
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayCanaryRules(request)
+	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayCanaryRulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -885,9 +925,11 @@ func (me *TseService) DeleteTseCngwCanaryRuleById(ctx context.Context, gatewayId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayCanaryRule(request)
+	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayCanaryRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -909,9 +951,11 @@ func (me *TseService) DescribeTseCngwGatewayById(ctx context.Context, gatewayId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGateway(request)
+	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -938,9 +982,11 @@ func (me *TseService) DeleteTseCngwGatewayById(ctx context.Context, gatewayId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGateway(request)
+	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -953,7 +999,7 @@ func (me *TseService) DeleteTseCngwGatewayById(ctx context.Context, gatewayId st
 func (me *TseService) CheckTseNativeAPIGatewayStatusById(ctx context.Context, gatewayId, operate string) (errRet error) {
 	logId := getLogId(ctx)
 
-	err := resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, 7*readRetryTimeout, func() *resource.RetryError {
 		gateway, e := me.DescribeTseCngwGatewayById(ctx, gatewayId)
 		if e != nil && operate != "delete" {
 			return resource.NonRetryableError(e)
@@ -1032,9 +1078,11 @@ func (me *TseService) DescribeTseCngwGroupById(ctx context.Context, gatewayId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTseClient().DescribeNativeGatewayServerGroups(request)
+	response, err := me.client.UseTseClient().DescribeNativeGatewayServerGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1062,9 +1110,11 @@ func (me *TseService) DeleteTseCngwGroupById(ctx context.Context, gatewayId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTseClient().DeleteNativeGatewayServerGroup(request)
+	response, err := me.client.UseTseClient().DeleteNativeGatewayServerGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1092,7 +1142,9 @@ func (me *TseService) DescribeTseGatewaysByFilter(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1104,7 +1156,7 @@ func (me *TseService) DescribeTseGatewaysByFilter(ctx context.Context, param map
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTseClient().DescribeCloudNativeAPIGateways(request)
+		response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewaysWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1151,7 +1203,9 @@ func (me *TseService) DescribeTseGroupsByFilter(ctx context.Context, param map[s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -1163,7 +1217,7 @@ func (me *TseService) DescribeTseGroupsByFilter(ctx context.Context, param map[s
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTseClient().DescribeNativeGatewayServerGroups(request)
+		response, err := me.client.UseTseClient().DescribeNativeGatewayServerGroupsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1193,7 +1247,7 @@ func (me *TseService) DescribeTseGroupsByFilter(ctx context.Context, param map[s
 func (me *TseService) CheckTseNativeAPIGatewayGroupStatusById(ctx context.Context, gatewayId, groupId, operate string) (errRet error) {
 	logId := getLogId(ctx)
 
-	err := resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	err := retryContext(ctx, 7*readRetryTimeout, func() *resource.RetryError {
 		gateway, e := me.DescribeTseCngwGroupById(ctx, gatewayId, groupId)
 		if e != nil && operate != "delete" {
 			return resource.NonRetryableError(e)
@@ -1274,7 +1328,9 @@ func (me *TseService) DescribeTseGatewayCertificatesByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1285,7 +1341,7 @@ func (me *TseService) DescribeTseGatewayCertificatesByFilter(ctx context.Context
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayCertificates(request)
+		response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayCertificatesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1325,9 +1381,11 @@ func (me *TseService) DescribeTseCngwCertificateById(ctx context.Context, gatewa
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayCertificateDetails(request)
+	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayCertificateDetailsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1354,9 +1412,11 @@ func (me *TseService) DeleteTseCngwCertificateById(ctx context.Context, gatewayI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayCertificate(request)
+	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayCertificateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -28,7 +28,9 @@ func (me *TsfService) DescribeTsfClusterById(ctx context.Context, clusterId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -38,7 +40,7 @@ func (me *TsfService) DescribeTsfClusterById(ctx context.Context, clusterId stri
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribeClusters(request)
+		response, err := me.client.UseTsfClient().DescribeClustersWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -75,9 +77,11 @@ func (me *TsfService) DeleteTsfClusterById(ctx context.Context, clusterId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DeleteCluster(request)
+	response, err := me.client.UseTsfClient().DeleteClusterWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -123,9 +127,11 @@ func (me *TsfService) DescribeTsfApplicationConfigById(ctx context.Context, conf
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeConfigs(request)
+	response, err := me.client.UseTsfClient().DescribeConfigsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -156,9 +162,11 @@ func (me *TsfService) DeleteTsfApplicationConfigById(ctx context.Context, config
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DeleteConfig(request)
+	response, err := me.client.UseTsfClient().DeleteConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -190,9 +198,11 @@ func (me *TsfService) DescribeTsfMicroserviceById(ctx context.Context, namespace
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeMicroservices(request)
+	response, err := me.client.UseTsfClient().DescribeMicroservicesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -219,9 +229,11 @@ func (me *TsfService) DeleteTsfMicroserviceById(ctx context.Context, microservic
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DeleteMicroservice(request)
+	response, err := me.client.UseTsfClient().DeleteMicroserviceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -243,9 +255,11 @@ func (me *TsfService) DescribeTsfApiGroupById(ctx context.Context, groupId strin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeApiGroup(request)
+	response, err := me.client.UseTsfClient().DescribeApiGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -272,9 +286,11 @@ func (me *TsfService) DeleteTsfApiGroupById(ctx context.Context, groupId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DeleteApiGroup(request)
+	response, err := me.client.UseTsfClient().DeleteApiGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -296,9 +312,11 @@ func (me *TsfService) DescribeTsfApiRateLimitRuleById(ctx context.Context, apiId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeApiRateLimitRules(request)
+	response, err := me.client.UseTsfClient().DescribeApiRateLimitRulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -331,9 +349,11 @@ func (me *TsfService) DeleteTsfApiRateLimitRuleById(ctx context.Context, apiId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DeleteApiRateLimitRule(request)
+	response, err := me.client.UseTsfClient().DeleteApiRateLimitRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -355,9 +375,11 @@ func (me *TsfService) DescribeTsfConfigTemplateById(ctx context.Context, templat
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeConfigTemplate(request)
+	response, err := me.client.UseTsfClient().DescribeConfigTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -384,9 +406,11 @@ func (me *TsfService) DeleteTsfConfigTemplateById(ctx context.Context, templateI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DeleteConfigTemplate(request)
+	response, err := me.client.UseTsfClient().DeleteConfigTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -408,9 +432,11 @@ func (me *TsfService) DescribeTsfLaneById(ctx context.Context, laneId string) (l
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeLanes(request)
+	response, err := me.client.UseTsfClient().DescribeLanesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -437,9 +463,11 @@ func (me *TsfService) DeleteTsfLaneById(ctx context.Context, laneId string) (err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DeleteLane(request)
+	response, err := me.client.UseTsfClient().DeleteLaneWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -463,9 +491,11 @@ func (me *TsfService) DescribeTsfLaneRuleById(ctx context.Context, ruleId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeLaneRules(request)
+	response, err := me.client.UseTsfClient().DescribeLaneRulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -492,9 +522,11 @@ func (me *TsfService) DeleteTsfLaneRuleById(ctx context.Context, ruleId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DeleteLaneRule(request)
+	response, err := me.client.UseTsfClient().DeleteLaneRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -516,9 +548,11 @@ func (me *TsfService) DescribeTsfNamespaceById(ctx context.Context, namespaceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeSimpleNamespaces(request)
+	response, err := me.client.UseTsfClient().DescribeSimpleNamespacesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -545,9 +579,11 @@ func (me *TsfService) DeleteTsfNamespaceById(ctx context.Context, namespaceId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DeleteNamespace(request)
+	response, err := me.client.UseTsfClient().DeleteNamespaceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -568,9 +604,11 @@ func (me *TsfService) DescribeTsfPathRewriteById(ctx context.Context, pathRewrit
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribePathRewrite(request)
+	response, err := me.client.UseTsfClient().DescribePathRewriteWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -597,9 +635,11 @@ func (me *TsfService) DeleteTsfPathRewriteById(ctx context.Context, pathRewriteI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DeletePathRewrites(request)
+	response, err := me.client.UseTsfClient().DeletePathRewritesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -621,9 +661,11 @@ func (me *TsfService) DescribeTsfRepositoryById(ctx context.Context, repositoryI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeRepository(request)
+	response, err := me.client.UseTsfClient().DescribeRepositoryWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -650,9 +692,11 @@ func (me *TsfService) DeleteTsfRepositoryById(ctx context.Context, repositoryId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DeleteRepository(request)
+	response, err := me.client.UseTsfClient().DeleteRepositoryWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -674,9 +718,11 @@ func (me *TsfService) DescribeTsfTaskById(ctx context.Context, taskId string) (t
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeTaskDetail(request)
+	response, err := me.client.UseTsfClient().DescribeTaskDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -703,9 +749,11 @@ func (me *TsfService) DeleteTsfTaskById(ctx context.Context, taskId string) (err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DeleteTask(request)
+	response, err := me.client.UseTsfClient().DeleteTaskWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -727,9 +775,11 @@ func (me *TsfService) DescribeTsfUnitRuleById(ctx context.Context, id string) (u
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeUnitRule(request)
+	response, err := me.client.UseTsfClient().DescribeUnitRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -756,9 +806,11 @@ func (me *TsfService) DeleteTsfUnitRuleById(ctx context.Context, id string) (err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DeleteUnitRule(request)
+	response, err := me.client.UseTsfClient().DeleteUnitRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -779,9 +831,11 @@ func (me *TsfService) DescribeTsfContainGroupById(ctx context.Context, groupId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeContainerGroupDetail(request)
+	response, err := me.client.UseTsfClient().DescribeContainerGroupDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -808,9 +862,11 @@ func (me *TsfService) DeleteTsfContainGroupById(ctx context.Context, groupId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DeleteContainerGroup(request)
+	response, err := me.client.UseTsfClient().DeleteContainerGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -833,9 +889,11 @@ func (me *TsfService) DescribeTsfApplicationReleaseConfigById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeConfigReleases(request)
+	response, err := me.client.UseTsfClient().DescribeConfigReleasesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -862,9 +920,11 @@ func (me *TsfService) DeleteTsfApplicationReleaseConfigById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().RevocationConfig(request)
+	response, err := me.client.UseTsfClient().RevocationConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -886,9 +946,11 @@ func (me *TsfService) DescribeTsfGroupById(ctx context.Context, groupId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeGroup(request)
+	response, err := me.client.UseTsfClient().DescribeGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -915,9 +977,11 @@ func (me *TsfService) DeleteTsfGroupById(ctx context.Context, groupId string) (e
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DeleteGroup(request)
+	response, err := me.client.UseTsfClient().DeleteGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -939,9 +1003,11 @@ func (me *TsfService) DescribeTsfApplicationById(ctx context.Context, applicatio
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeApplication(request)
+	response, err := me.client.UseTsfClient().DescribeApplicationWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -968,9 +1034,11 @@ func (me *TsfService) DeleteTsfApplicationById(ctx context.Context, applicationI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DeleteApplication(request)
+	response, err := me.client.UseTsfClient().DeleteApplicationWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -993,9 +1061,11 @@ func (me *TsfService) DescribeTsfApplicationFileConfigReleaseById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeFileConfigReleases(request)
+	response, err := me.client.UseTsfClient().DescribeFileConfigReleasesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1029,9 +1099,11 @@ func (me *TsfService) DeleteTsfApplicationFileConfigReleaseById(ctx context.Cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().RevokeFileConfig(request)
+	response, err := me.client.UseTsfClient().RevokeFileConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1053,9 +1125,11 @@ func (me *TsfService) DescribeTsfApplicationPublicConfigById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribePublicConfig(request)
+	response, err := me.client.UseTsfClient().DescribePublicConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1082,9 +1156,11 @@ func (me *TsfService) DeleteTsfApplicationPublicConfigById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DeletePublicConfig(request)
+	response, err := me.client.UseTsfClient().DeletePublicConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1107,9 +1183,11 @@ func (me *TsfService) DescribeTsfApplicationPublicConfigReleaseById(ctx context.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribePublicConfigReleases(request)
+	response, err := me.client.UseTsfClient().DescribePublicConfigReleasesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1143,9 +1221,11 @@ func (me *TsfService) DeleteTsfApplicationPublicConfigReleaseById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().RevocationPublicConfig(request)
+	response, err := me.client.UseTsfClient().RevocationPublicConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1167,7 +1247,9 @@ func (me *TsfService) DescribeTsfInstancesAttachmentById(ctx context.Context, cl
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1176,7 +1258,7 @@ func (me *TsfService) DescribeTsfInstancesAttachmentById(ctx context.Context, cl
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribeClusterInstances(request)
+		response, err := me.client.UseTsfClient().DescribeClusterInstancesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1215,9 +1297,11 @@ func (me *TsfService) DeleteTsfInstancesAttachmentById(ctx context.Context, clus
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().RemoveInstances(request)
+	response, err := me.client.UseTsfClient().RemoveInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1254,7 +1338,9 @@ func (me *TsfService) DescribeTsfApplicationByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset  int64 = 0
@@ -1265,7 +1351,7 @@ func (me *TsfService) DescribeTsfApplicationByFilter(ctx context.Context, param
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribeApplications(request)
+		response, err := me.client.UseTsfClient().DescribeApplicationsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1321,7 +1407,9 @@ func (me *TsfService) DescribeTsfApplicationConfigByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1333,7 +1421,7 @@ func (me *TsfService) DescribeTsfApplicationConfigByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribeConfigs(request)
+		response, err := me.client.UseTsfClient().DescribeConfigsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1390,7 +1478,9 @@ func (me *TsfService) DescribeTsfApplicationFileConfigByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1401,7 +1491,7 @@ func (me *TsfService) DescribeTsfApplicationFileConfigByFilter(ctx context.Conte
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribeFileConfigs(request)
+		response, err := me.client.UseTsfClient().DescribeFileConfigsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1455,7 +1545,9 @@ func (me *TsfService) DescribeTsfApplicationPublicConfigByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1466,7 +1558,7 @@ func (me *TsfService) DescribeTsfApplicationPublicConfigByFilter(ctx context.Con
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribePublicConfigs(request)
+		response, err := me.client.UseTsfClient().DescribePublicConfigsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1520,7 +1612,9 @@ func (me *TsfService) DescribeTsfClusterByFilter(ctx context.Context, param map[
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset   int64 = 0
@@ -1531,7 +1625,7 @@ func (me *TsfService) DescribeTsfClusterByFilter(ctx context.Context, param map[
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribeSimpleClusters(request)
+		response, err := me.client.UseTsfClient().DescribeSimpleClustersWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1585,7 +1679,9 @@ func (me *TsfService) DescribeTsfMicroserviceByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1596,7 +1692,7 @@ func (me *TsfService) DescribeTsfMicroserviceByFilter(ctx context.Context, param
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribeMicroservices(request)
+		response, err := me.client.UseTsfClient().DescribeMicroservicesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1644,7 +1740,9 @@ func (me *TsfService) DescribeTsfUnitRulesByFilter(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1655,7 +1753,7 @@ func (me *TsfService) DescribeTsfUnitRulesByFilter(ctx context.Context, param ma
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribeUnitRulesV2(request)
+		response, err := me.client.UseTsfClient().DescribeUnitRulesV2WithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1718,7 +1816,9 @@ func (me *TsfService) DescribeTsfConfigSummaryByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1729,7 +1829,7 @@ func (me *TsfService) DescribeTsfConfigSummaryByFilter(ctx context.Context, para
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribeConfigSummary(request)
+		response, err := me.client.UseTsfClient().DescribeConfigSummaryWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1774,8 +1874,10 @@ func (me *TsfService) DescribeTsfDeliveryConfigByGroupIdByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTsfClient().DescribeDeliveryConfigByGroupId(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTsfClient().DescribeDeliveryConfigByGroupIdWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1809,7 +1911,9 @@ func (me *TsfService) DescribeTsfDeliveryConfigsByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset    int64 = 0
@@ -1820,7 +1924,7 @@ func (me *TsfService) DescribeTsfDeliveryConfigsByFilter(ctx context.Context, pa
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribeDeliveryConfigs(request)
+		response, err := me.client.UseTsfClient().DescribeDeliveryConfigsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1881,7 +1985,9 @@ func (me *TsfService) DescribeTsfPublicConfigSummaryByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset        int64 = 0
@@ -1892,7 +1998,7 @@ func (me *TsfService) DescribeTsfPublicConfigSummaryByFilter(ctx context.Context
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribePublicConfigSummary(request)
+		response, err := me.client.UseTsfClient().DescribePublicConfigSummaryWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -1955,7 +2061,9 @@ func (me *TsfService) DescribeTsfApiGroupByFilter(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -1966,7 +2074,7 @@ func (me *TsfService) DescribeTsfApiGroupByFilter(ctx context.Context, param map
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribeApiGroups(request)
+		response, err := me.client.UseTsfClient().DescribeApiGroupsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2011,8 +2119,10 @@ func (me *TsfService) DescribeTsfApplicationAttributeByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTsfClient().DescribeApplicationAttribute(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTsfClient().DescribeApplicationAttributeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2052,7 +2162,9 @@ func (me *TsfService) DescribeTsfBusinessLogConfigsByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -2063,7 +2175,7 @@ func (me *TsfService) DescribeTsfBusinessLogConfigsByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribeBusinessLogConfigs(request)
+		response, err := me.client.UseTsfClient().DescribeBusinessLogConfigsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2120,8 +2232,10 @@ func (me *TsfService) DescribeTsfApiDetailByFilter(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTsfClient().DescribeApiDetail(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTsfClient().DescribeApiDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2161,8 +2275,10 @@ func (me *TsfService) DescribeTsfMicroserviceApiVersionByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTsfClient().DescribeApiVersions(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTsfClient().DescribeApiVersionsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2190,7 +2306,9 @@ func (me *TsfService) DescribeTsfBindApiGroupById(ctx context.Context, groupId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -2199,7 +2317,7 @@ func (me *TsfService) DescribeTsfBindApiGroupById(ctx context.Context, groupId s
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribeGroupBindedGateways(request)
+		response, err := me.client.UseTsfClient().DescribeGroupBindedGatewaysWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2244,9 +2362,11 @@ func (me *TsfService) DeleteTsfBindApiGroupById(ctx context.Context, groupId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().UnbindApiGroup(request)
+	response, err := me.client.UseTsfClient().UnbindApiGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2277,7 +2397,9 @@ func (me *TsfService) DescribeTsfRepositoryByFilter(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset     uint64 = 0
@@ -2288,7 +2410,7 @@ func (me *TsfService) DescribeTsfRepositoryByFilter(ctx context.Context, param m
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribeRepositories(request)
+		response, err := me.client.UseTsfClient().DescribeRepositoriesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2327,9 +2449,11 @@ func (me *TsfService) DescribeTsfApplicationFileConfigById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeFileConfigs(request)
+	response, err := me.client.UseTsfClient().DescribeFileConfigsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2356,9 +2480,11 @@ func (me *TsfService) DeleteTsfApplicationFileConfigById(ctx context.Context, co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DeleteFileConfig(request)
+	response, err := me.client.UseTsfClient().DeleteFileConfigWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2380,9 +2506,11 @@ func (me *TsfService) DescribeTsfEnableUnitRuleById(ctx context.Context, id stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeUnitRule(request)
+	response, err := me.client.UseTsfClient().DescribeUnitRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2418,7 +2546,9 @@ func (me *TsfService) DescribeTsfDescribePodInstancesByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset    int64 = 0
@@ -2428,7 +2558,7 @@ func (me *TsfService) DescribeTsfDescribePodInstancesByFilter(ctx context.Contex
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribePodInstances(request)
+		response, err := me.client.UseTsfClient().DescribePodInstancesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2475,8 +2605,10 @@ func (me *TsfService) DescribeTsfGatewayAllGroupApisByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTsfClient().DescribeGatewayAllGroupApis(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTsfClient().DescribeGatewayAllGroupApisWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2513,7 +2645,9 @@ func (me *TsfService) DescribeTsfGroupGatewaysByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset   int64 = 0
@@ -2523,7 +2657,7 @@ func (me *TsfService) DescribeTsfGroupGatewaysByFilter(ctx context.Context, para
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribeGroupGateways(request)
+		response, err := me.client.UseTsfClient().DescribeGroupGatewaysWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2576,7 +2710,9 @@ func (me *TsfService) DescribeTsfGroupInstancesByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset    int64 = 0
@@ -2586,7 +2722,7 @@ func (me *TsfService) DescribeTsfGroupInstancesByFilter(ctx context.Context, par
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribeGroupInstances(request)
+		response, err := me.client.UseTsfClient().DescribeGroupInstancesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2630,7 +2766,9 @@ func (me *TsfService) DescribeTsfUsableUnitNamespacesByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset     int64 = 0
@@ -2640,7 +2778,7 @@ func (me *TsfService) DescribeTsfUsableUnitNamespacesByFilter(ctx context.Contex
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribeUsableUnitNamespaces(request)
+		response, err := me.client.UseTsfClient().DescribeUsableUnitNamespacesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -2684,8 +2822,10 @@ func (me *TsfService) DescribeTsfGroupConfigReleaseByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTsfClient().DescribeGroupRelease(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseTsfClient().DescribeGroupReleaseWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2712,9 +2852,11 @@ func (me *TsfService) DescribeTsfDeployVmGroupById(ctx context.Context, groupId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeGroup(request)
+	response, err := me.client.UseTsfClient().DescribeGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2741,9 +2883,11 @@ func (me *TsfService) DescribeTsfReleaseApiGroupById(ctx context.Context, groupI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeApiGroup(request)
+	response, err := me.client.UseTsfClient().DescribeApiGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2771,9 +2915,11 @@ func (me *TsfService) DescribeTsfStartContainerGroupById(ctx context.Context, gr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeContainerGroupAttribute(request)
+	response, err := me.client.UseTsfClient().DescribeContainerGroupAttributeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2801,9 +2947,11 @@ func (me *TsfService) DescribeTsfStartGroupById(ctx context.Context, groupId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeGroupAttribute(request)
+	response, err := me.client.UseTsfClient().DescribeGroupAttributeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2830,9 +2978,11 @@ func (me *TsfService) DescribeTsfUnitNamespaceById(ctx context.Context, gatewayI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeUnitNamespaces(request)
+	response, err := me.client.UseTsfClient().DescribeUnitNamespacesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2866,9 +3016,11 @@ func (me *TsfService) DeleteTsfUnitNamespaceById(ctx context.Context, gatewayIns
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DeleteUnitNamespaces(request)
+	response, err := me.client.UseTsfClient().DeleteUnitNamespacesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2890,9 +3042,11 @@ func (me *TsfService) DescribeTsfDeployContainerGroupById(ctx context.Context, g
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseTsfClient().DescribeContainerGroupDeployInfo(request)
+	response, err := me.client.UseTsfClient().DescribeContainerGroupDeployInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -2940,7 +3094,9 @@ func (me *TsfService) DescribeTsfDescriptionContainerGroupByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -2950,7 +3106,7 @@ func (me *TsfService) DescribeTsfDescriptionContainerGroupByFilter(ctx context.C
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribeContainerGroups(request)
+		response, err := me.client.UseTsfClient().DescribeContainerGroupsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -3018,7 +3174,9 @@ func (me *TsfService) DescribeTsfGroupsByFilter(ctx context.Context, param map[s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -3028,7 +3186,7 @@ func (me *TsfService) DescribeTsfGroupsByFilter(ctx context.Context, param map[s
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribeGroups(request)
+		response, err := me.client.UseTsfClient().DescribeGroupsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -3075,7 +3233,9 @@ func (me *TsfService) DescribeTsfMsApiListByFilter(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -3085,7 +3245,7 @@ func (me *TsfService) DescribeTsfMsApiListByFilter(ctx context.Context, param ma
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseTsfClient().DescribeMsApiList(request)
+		response, err := me.client.UseTsfClient().DescribeMsApiListWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -42,8 +42,10 @@ func (me *VodService) DescribeAdaptiveDynamicStreamingTemplatesByFilter(ctx cont
 	for {
 		var response *vod.DescribeAdaptiveDynamicStreamingTemplatesResponse
 		var err error
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 			response, err = me.client.UseVodClient().DescribeAdaptiveDynamicStreamingTemplates(request)
 			if err != nil {
 				return retryError(err)
@@ -99,8 +101,10 @@ func (me *VodService) DeleteAdaptiveDynamicStreamingTemplate(ctx context.Context
 		request.SubAppId = &subAppid
 	}
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		_, errRet = me.client.UseVodClient().DeleteAdaptiveDynamicStreamingTemplate(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -139,8 +143,10 @@ func (me *VodService) DescribeProcedureTemplatesByFilter(ctx context.Context, fi
 	for {
 		var response *vod.DescribeProcedureTemplatesResponse
 		var err error
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 			response, err = me.client.UseVodClient().DescribeProcedureTemplates(request)
 			if err != nil {
 				return retryError(err)
@@ -195,8 +201,10 @@ func (me *VodService) DeleteProcedureTemplate(ctx context.Context, templateId st
 		request.SubAppId = &subAppid
 	}
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		_, errRet = me.client.UseVodClient().DeleteProcedureTemplate(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -236,8 +244,10 @@ func (me *VodService) DescribeSnapshotByTimeOffsetTemplatesByFilter(ctx context.
 	for {
 		var response *vod.DescribeSnapshotByTimeOffsetTemplatesResponse
 		var err error
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 			response, err = me.client.UseVodClient().DescribeSnapshotByTimeOffsetTemplates(request)
 			if err != nil {
 				return retryError(err)
@@ -293,8 +303,10 @@ func (me *VodService) DeleteSnapshotByTimeOffsetTemplate(ctx context.Context, te
 		request.SubAppId = &subAppid
 	}
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		_, errRet = me.client.UseVodClient().DeleteSnapshotByTimeOffsetTemplate(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -334,8 +346,10 @@ func (me *VodService) DescribeImageSpriteTemplatesByFilter(ctx context.Context,
 	for {
 		var response *vod.DescribeImageSpriteTemplatesResponse
 		var err error
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 			response, err = me.client.UseVodClient().DescribeImageSpriteTemplates(request)
 			if err != nil {
 				return retryError(err)
@@ -391,8 +405,10 @@ func (me *VodService) DeleteImageSpriteTemplate(ctx context.Context, templateId
 		request.SubAppId = &subAppid
 	}
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		_, errRet = me.client.UseVodClient().DeleteImageSpriteTemplate(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
@@ -431,8 +447,10 @@ func (me *VodService) DescribeSuperPlayerConfigsByFilter(ctx context.Context, fi
 	for {
 		var response *vod.DescribeSuperPlayerConfigsResponse
 		var err error
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 			response, err = me.client.UseVodClient().DescribeSuperPlayerConfigs(request)
 			if err != nil {
 				return retryError(err)
@@ -486,8 +504,10 @@ func (me *VodService) DeleteSuperPlayerConfig(ctx context.Context, configId stri
 		request.SubAppId = &subAppid
 	}
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		_, errRet = me.client.UseVodClient().DeleteSuperPlayerConfig(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), errRet.Error())
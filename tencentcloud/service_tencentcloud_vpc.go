@@ -239,9 +239,11 @@ func (me *VpcService) CreateVpc(ctx context.Context, name, cidr string,
 	}
 
 	var response *vpc.CreateVpcResponse
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, err := me.client.UseVpcClient().CreateVpc(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, err := me.client.UseVpcClient().CreateVpcWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -256,6 +258,47 @@ func (me *VpcService) CreateVpc(ctx context.Context, name, cidr string,
 	return
 }
 
+// CreateDefaultVpc returns the default VPC of the region, which is created with a default subnet in
+// zone when the region has none.
+func (me *VpcService) CreateDefaultVpc(ctx context.Context, zone string) (vpcId, subnetId string, errRet error) {
+	logId := getLogId(ctx)
+	request := vpc.NewCreateDefaultVpcRequest()
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	if zone != "" {
+		request.Zone = &zone
+	}
+	// the default VPC is returned even if the region has a VPC which is not the default one
+	request.Force = helper.Bool(true)
+
+	var response *vpc.CreateDefaultVpcResponse
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, err := me.client.UseVpcClient().CreateDefaultVpcWithContext(ctx, request)
+		if err != nil {
+			return retryError(err)
+		}
+		response = result
+		return nil
+	}); err != nil {
+		errRet = err
+		return
+	}
+	if response.Response.Vpc == nil || response.Response.Vpc.VpcId == nil {
+		errRet = fmt.Errorf("api[%s] return vpc id is nil", request.GetAction())
+		return
+	}
+	vpcId, subnetId = *response.Response.Vpc.VpcId, helper.PString(response.Response.Vpc.SubnetId)
+	return
+}
+
 func (me *VpcService) DescribeVpc(ctx context.Context,
 	vpcId string,
 	tagKey string,
@@ -338,9 +381,11 @@ getMoreData:
 	var strOffset = fmt.Sprintf("%d", offset)
 	request.Offset = &strOffset
 	var response *vpc.DescribeVpcsResponse
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, err := me.client.UseVpcClient().DescribeVpcs(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, err := me.client.UseVpcClient().DescribeVpcsWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -495,9 +540,11 @@ getMoreData:
 	var strOffset = fmt.Sprintf("%d", offset)
 	request.Offset = &strOffset
 	var response *vpc.DescribeSubnetsResponse
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, err := me.client.UseVpcClient().DescribeSubnets(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, err := me.client.UseVpcClient().DescribeSubnetsWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -568,9 +615,11 @@ func (me *VpcService) ModifyVpcAttribute(ctx context.Context, vpcId, name string
 	var enableMulticast = map[bool]string{true: "true", false: "false"}[isMulticast]
 	request.EnableMulticast = &enableMulticast
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseVpcClient().ModifyVpcAttribute(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseVpcClient().ModifyVpcAttributeWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -599,9 +648,11 @@ func (me *VpcService) DeleteVpc(ctx context.Context, vpcId string) (errRet error
 
 	request.VpcId = &vpcId
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseVpcClient().DeleteVpc(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseVpcClient().DeleteVpcWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -644,9 +695,11 @@ func (me *VpcService) CreateSubnet(ctx context.Context, vpcId, name, cidr, zone
 	}
 
 	var response *vpc.CreateSubnetResponse
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, err := me.client.UseVpcClient().CreateSubnet(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		result, err := me.client.UseVpcClient().CreateSubnetWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -677,9 +730,11 @@ func (me *VpcService) ModifySubnetAttribute(ctx context.Context, subnetId, name
 	request.SubnetId = &subnetId
 	request.SubnetName = &name
 	request.EnableBroadcast = &enableMulticast
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseVpcClient().ModifySubnetAttribute(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseVpcClient().ModifySubnetAttributeWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -702,9 +757,11 @@ func (me *VpcService) DeleteSubnet(ctx context.Context, subnetId string) (errRet
 		}
 	}()
 	request.SubnetId = &subnetId
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseVpcClient().DeleteSubnet(request)
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseVpcClient().DeleteSubnetWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -728,8 +785,10 @@ func (me *VpcService) ReplaceRouteTableAssociation(ctx context.Context, subnetId
 	}()
 	request.SubnetId = &subnetId
 	request.RouteTableId = &routeTableId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().ReplaceRouteTableAssociation(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().ReplaceRouteTableAssociationWithContext(ctx, request)
 
 	errRet = err
 	if err == nil {
@@ -827,9 +886,11 @@ getMoreData:
 
 	var strOffset = fmt.Sprintf("%d", offset)
 	request.Offset = &strOffset
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeRouteTables(request)
+	response, err := me.client.UseVpcClient().DescribeRouteTablesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -910,8 +971,10 @@ func (me *VpcService) CreateRouteTable(ctx context.Context, name, vpcId string,
 			request.Tags = append(request.Tags, &tag)
 		}
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().CreateRouteTable(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().CreateRouteTableWithContext(ctx, request)
 	errRet = err
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
@@ -938,8 +1001,10 @@ func (me *VpcService) DeleteRouteTable(ctx context.Context, routeTableId string)
 		return
 	}
 	request.RouteTableId = &routeTableId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DeleteRouteTable(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DeleteRouteTableWithContext(ctx, request)
 	errRet = err
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
@@ -966,8 +1031,10 @@ func (me *VpcService) ModifyRouteTableAttribute(ctx context.Context, routeTableI
 	}
 	request.RouteTableId = &routeTableId
 	request.RouteTableName = &name
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().ModifyRouteTableAttribute(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().ModifyRouteTableAttributeWithContext(ctx, request)
 	errRet = err
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
@@ -1042,8 +1109,10 @@ func (me *VpcService) DeleteRoutes(ctx context.Context, routeTableId string, ent
 	var route vpc.Route
 	route.RouteId = &entryId
 	request.Routes = []*vpc.Route{&route}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DeleteRoutes(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DeleteRoutesWithContext(ctx, request)
 	errRet = err
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
@@ -1076,8 +1145,10 @@ func (me *VpcService) CreateRoutes(ctx context.Context,
 	route.GatewayId = &nextHub
 	route.Enabled = &enabled
 	request.Routes = []*vpc.Route{&route}
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().CreateRoutes(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().CreateRoutesWithContext(ctx, request)
 	errRet = err
 	if err == nil {
 		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
@@ -1143,8 +1214,10 @@ func (me *VpcService) EnableRoutes(ctx context.Context, request *vpc.EnableRoute
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().EnableRoutes(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().EnableRoutesWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1165,8 +1238,10 @@ func (me *VpcService) DisableRoutes(ctx context.Context, request *vpc.DisableRou
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DisableRoutes(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DisableRoutesWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1200,10 +1275,12 @@ func (me *VpcService) CreateSecurityGroup(ctx context.Context, name, desc string
 		}
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := me.client.UseVpcClient().CreateSecurityGroup(request)
+		response, err := me.client.UseVpcClient().CreateSecurityGroupWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -1226,16 +1303,100 @@ func (me *VpcService) CreateSecurityGroup(ctx context.Context, name, desc string
 	return
 }
 
+// CreateSecurityGroupWithPolicies creates the security group together with its policies, so that the
+// group is never left without them.
+func (me *VpcService) CreateSecurityGroupWithPolicies(ctx context.Context, name, desc string, projectId *int, policySet *vpc.SecurityGroupPolicySet) (id string, err error) {
+	logId := getLogId(ctx)
+
+	request := vpc.NewCreateSecurityGroupWithPoliciesRequest()
+
+	request.GroupName = &name
+	request.GroupDescription = &desc
+	request.SecurityGroupPolicySet = policySet
+
+	if projectId != nil {
+		request.ProjectId = helper.String(strconv.Itoa(*projectId))
+	}
+
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		response, err := me.client.UseVpcClient().CreateSecurityGroupWithPoliciesWithContext(ctx, request)
+		if err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
+				logId, request.GetAction(), request.ToJsonString(), err)
+			return retryError(err)
+		}
+
+		if response.Response.SecurityGroup == nil || response.Response.SecurityGroup.SecurityGroupId == nil {
+			err := fmt.Errorf("api[%s] return security group id is nil", request.GetAction())
+			log.Printf("[CRITAL]%s %v", logId, err)
+			return resource.NonRetryableError(err)
+		}
+
+		id = *response.Response.SecurityGroup.SecurityGroupId
+		return nil
+	}); err != nil {
+		log.Printf("[CRITAL]%s create security group with policies failed, reason: %v", logId, err)
+		return "", err
+	}
+
+	return
+}
+
+// CreateDefaultSecurityGroup returns the default security group of the project, which is created when
+// the project has none.
+func (me *VpcService) CreateDefaultSecurityGroup(ctx context.Context, projectId *int) (id string, err error) {
+	logId := getLogId(ctx)
+
+	request := vpc.NewCreateDefaultSecurityGroupRequest()
+
+	if projectId != nil {
+		request.ProjectId = helper.String(strconv.Itoa(*projectId))
+	}
+
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		response, err := me.client.UseVpcClient().CreateDefaultSecurityGroupWithContext(ctx, request)
+		if err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
+				logId, request.GetAction(), request.ToJsonString(), err)
+			return retryError(err)
+		}
+
+		if response.Response.SecurityGroup == nil || response.Response.SecurityGroup.SecurityGroupId == nil {
+			err := fmt.Errorf("api[%s] return security group id is nil", request.GetAction())
+			log.Printf("[CRITAL]%s %v", logId, err)
+			return resource.NonRetryableError(err)
+		}
+
+		id = *response.Response.SecurityGroup.SecurityGroupId
+		return nil
+	}); err != nil {
+		log.Printf("[CRITAL]%s create default security group failed, reason: %v", logId, err)
+		return "", err
+	}
+
+	return
+}
+
 func (me *VpcService) DescribeSecurityGroup(ctx context.Context, id string) (sg *vpc.SecurityGroup, err error) {
 	logId := getLogId(ctx)
 
 	request := vpc.NewDescribeSecurityGroupsRequest()
 	request.SecurityGroupIds = []*string{&id}
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := me.client.UseVpcClient().DescribeSecurityGroups(request)
+		response, err := me.client.UseVpcClient().DescribeSecurityGroupsWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceNotFound" {
@@ -1272,9 +1433,11 @@ func (me *VpcService) ModifySecurityGroup(ctx context.Context, id string, newNam
 	request.GroupName = newName
 	request.GroupDescription = newDesc
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err := me.client.UseVpcClient().ModifySecurityGroupAttribute(request)
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err := me.client.UseVpcClient().ModifySecurityGroupAttributeWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -1296,10 +1459,12 @@ func (me *VpcService) DeleteSecurityGroup(ctx context.Context, id string) error
 	request := vpc.NewDeleteSecurityGroupRequest()
 	request.SecurityGroupId = &id
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := me.client.UseVpcClient().DeleteSecurityGroup(request); err != nil {
+		if _, err := me.client.UseVpcClient().DeleteSecurityGroupWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -1319,8 +1484,10 @@ func (me *VpcService) DescribeSecurityGroupsAssociate(ctx context.Context, ids [
 
 	request := vpc.NewDescribeSecurityGroupAssociationStatisticsRequest()
 	request.SecurityGroupIds = common.StringPtrs(ids)
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DescribeSecurityGroupAssociationStatistics(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return nil, err
+	}
+	response, err := me.client.UseVpcClient().DescribeSecurityGroupAssociationStatisticsWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 			logId, request.GetAction(), request.ToJsonString(), err)
@@ -1374,8 +1541,10 @@ func (me *VpcService) CreateSecurityGroupPolicy(ctx context.Context, info securi
 	case "egress":
 		createRequest.SecurityGroupPolicySet.Egress = []*vpc.SecurityGroupPolicy{policy}
 	}
-	ratelimit.Check(createRequest.GetAction())
-	if _, err := me.client.UseVpcClient().CreateSecurityGroupPolicies(createRequest); err != nil {
+	if err = ratelimit.CheckContext(ctx, createRequest.GetAction()); err != nil {
+		return
+	}
+	if _, err := me.client.UseVpcClient().CreateSecurityGroupPoliciesWithContext(ctx, createRequest); err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 			logId, createRequest.GetAction(), createRequest.ToJsonString(), err)
 		return "", err
@@ -1411,8 +1580,10 @@ func (me *VpcService) CreateSecurityGroupPolicies(ctx context.Context, request *
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().CreateSecurityGroupPolicies(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().CreateSecurityGroupPoliciesWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1437,8 +1608,10 @@ func (me *VpcService) DescribeSecurityGroupPolicy(ctx context.Context, ruleId st
 
 	request := vpc.NewDescribeSecurityGroupPoliciesRequest()
 	request.SecurityGroupId = &info.SgId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DescribeSecurityGroupPolicies(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DescribeSecurityGroupPoliciesWithContext(ctx, request)
 	if err != nil {
 		if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 			// if security group does not exist, security group rule does not exist too
@@ -1496,8 +1669,10 @@ func (me *VpcService) DescribeSecurityGroupPolicies(ctx context.Context, sgId st
 	}()
 	request.SecurityGroupId = &sgId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DescribeSecurityGroupPolicies(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DescribeSecurityGroupPoliciesWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1574,8 +1749,10 @@ func (me *VpcService) DeleteSecurityGroupPolicy(ctx context.Context, ruleId stri
 	case "egress":
 		request.SecurityGroupPolicySet.Egress = []*vpc.SecurityGroupPolicy{policy}
 	}
-	ratelimit.Check(request.GetAction())
-	if _, err := me.client.UseVpcClient().DeleteSecurityGroupPolicies(request); err != nil {
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	if _, err := me.client.UseVpcClient().DeleteSecurityGroupPoliciesWithContext(ctx, request); err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 			logId, request.GetAction(), request.ToJsonString(), err)
 		return err
@@ -1593,8 +1770,10 @@ func (me *VpcService) DeleteSecurityGroupPolicies(ctx context.Context, request *
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DeleteSecurityGroupPolicies(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DeleteSecurityGroupPoliciesWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1623,8 +1802,10 @@ func (me *VpcService) DeleteSecurityGroupPolicyByPolicyIndex(ctx context.Context
 	case "egress":
 		request.SecurityGroupPolicySet.Egress = []*vpc.SecurityGroupPolicy{policy}
 	}
-	ratelimit.Check(request.GetAction())
-	if _, err := me.client.UseVpcClient().DeleteSecurityGroupPolicies(request); err != nil {
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	if _, err := me.client.UseVpcClient().DeleteSecurityGroupPoliciesWithContext(ctx, request); err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 			logId, request.GetAction(), request.ToJsonString(), err)
 		return err
@@ -1655,8 +1836,10 @@ func (me *VpcService) DeleteSecurityGroupPolicyByPolicyIndexList(ctx context.Con
 		request.SecurityGroupPolicySet.Egress = tmpList
 	}
 
-	ratelimit.Check(request.GetAction())
-	if _, err := me.client.UseVpcClient().DeleteSecurityGroupPolicies(request); err != nil {
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	if _, err := me.client.UseVpcClient().DeleteSecurityGroupPoliciesWithContext(ctx, request); err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 			logId, request.GetAction(), request.ToJsonString(), err)
 		return err
@@ -1694,8 +1877,10 @@ func (me *VpcService) ModifySecurityGroupPolicy(ctx context.Context, ruleId stri
 	case "egress":
 		request.SecurityGroupPolicySet.Egress = []*vpc.SecurityGroupPolicy{policy}
 	}
-	ratelimit.Check(request.GetAction())
-	if _, err := me.client.UseVpcClient().ReplaceSecurityGroupPolicy(request); err != nil {
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	if _, err := me.client.UseVpcClient().ReplaceSecurityGroupPolicyWithContext(ctx, request); err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 			logId, request.GetAction(), request.ToJsonString(), err)
 		return err
@@ -1713,8 +1898,10 @@ func (me *VpcService) ModifySecurityGroupPolicies(ctx context.Context, request *
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().ModifySecurityGroupPolicies(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().ModifySecurityGroupPoliciesWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -1727,6 +1914,69 @@ func (me *VpcService) ModifySecurityGroupPolicies(ctx context.Context, request *
 	return
 }
 
+// ReplaceSecurityGroupPolicies makes ingress and egress the policies of the security group in their
+// order, current is the policies of the group, whose policy indexes are used to clear a direction, and
+// whose version makes the modification fail if the policies are changed by another writer meanwhile.
+func (me *VpcService) ReplaceSecurityGroupPolicies(ctx context.Context, sgId string, ingress, egress []*vpc.SecurityGroupPolicy,
+	current *vpc.SecurityGroupPolicySet) error {
+	request := vpc.NewModifySecurityGroupPoliciesRequest()
+	request.SecurityGroupId = &sgId
+
+	// both directions are cleared by resetting the policies, and a single one by deleting its policies
+	if len(ingress) == 0 && len(egress) == 0 {
+		request.SecurityGroupPolicySet = &vpc.SecurityGroupPolicySet{
+			Version: helper.String("0"),
+			Ingress: []*vpc.SecurityGroupPolicy{},
+			Egress:  []*vpc.SecurityGroupPolicy{},
+		}
+		return me.ModifySecurityGroupPolicies(ctx, request)
+	}
+
+	clear := func(policies []*vpc.SecurityGroupPolicy, policyType string) error {
+		policyIndexList := make([]*int64, 0, len(policies))
+		for _, policy := range policies {
+			policyIndexList = append(policyIndexList, policy.PolicyIndex)
+		}
+		return me.DeleteSecurityGroupPolicyByPolicyIndexList(ctx, sgId, policyIndexList, policyType)
+	}
+	// the version expected after the modification, the API rejects the policies changed by another writer
+	var version *int64
+	if current != nil && current.Version != nil {
+		if ver, err := strconv.ParseInt(*current.Version, 10, 64); err == nil {
+			version = helper.Int64(ver + 1)
+		}
+	}
+	if current != nil && len(ingress) == 0 && len(current.Ingress) > 0 {
+		if err := clear(current.Ingress, "ingress"); err != nil {
+			return err
+		}
+		if version != nil {
+			*version += 1
+		}
+	}
+	if current != nil && len(egress) == 0 && len(current.Egress) > 0 {
+		if err := clear(current.Egress, "egress"); err != nil {
+			return err
+		}
+		if version != nil {
+			*version += 1
+		}
+	}
+
+	request.SecurityGroupPolicySet = &vpc.SecurityGroupPolicySet{}
+	if version != nil {
+		request.SecurityGroupPolicySet.Version = helper.String(strconv.FormatInt(*version, 10))
+	}
+	if len(ingress) > 0 {
+		request.SecurityGroupPolicySet.Ingress = ingress
+	}
+	if len(egress) > 0 {
+		request.SecurityGroupPolicySet.Egress = egress
+	}
+	request.SortPolicys = helper.Bool(true)
+	return me.ModifySecurityGroupPolicies(ctx, request)
+}
+
 func (me *VpcService) DescribeSecurityGroups(ctx context.Context, sgId, sgName *string, projectId *int, tags map[string]string) (sgs []*vpc.SecurityGroup, err error) {
 	logId := getLogId(ctx)
 
@@ -1765,10 +2015,12 @@ func (me *VpcService) DescribeSecurityGroups(ctx context.Context, sgId, sgName *
 	for count == DESCRIBE_SECURITY_GROUP_LIMIT {
 		request.Offset = helper.String(strconv.Itoa(offset))
 
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := me.client.UseVpcClient().DescribeSecurityGroups(request)
+			response, err := me.client.UseVpcClient().DescribeSecurityGroupsWithContext(ctx, request)
 			if err != nil {
 				count = 0
 
@@ -1808,10 +2060,12 @@ func (me *VpcService) modifyLiteRulesInSecurityGroup(ctx context.Context, sgId s
 	request.SecurityGroupPolicySet.Egress = getSecurityGroupPolicies(egress)
 	request.SecurityGroupPolicySet.Ingress = getSecurityGroupPolicies(ingress)
 
-	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	return retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := me.client.UseVpcClient().ModifySecurityGroupPolicies(request); err != nil {
+		if _, err := me.client.UseVpcClient().ModifySecurityGroupPoliciesWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -1834,10 +2088,12 @@ func (me *VpcService) DeleteLiteRules(ctx context.Context, sgId string, rules []
 		request.SecurityGroupPolicySet.Egress = getSecurityGroupPolicies(rules)
 	}
 
-	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	return retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := me.client.UseVpcClient().DeleteSecurityGroupPolicies(request); err != nil {
+		if _, err := me.client.UseVpcClient().DeleteSecurityGroupPoliciesWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 
@@ -1866,10 +2122,12 @@ func (me *VpcService) DescribeSecurityGroupPolices(ctx context.Context, sgId str
 	request := vpc.NewDescribeSecurityGroupPoliciesRequest()
 	request.SecurityGroupId = &sgId
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := me.client.UseVpcClient().DescribeSecurityGroupPolicies(request)
+		response, err := me.client.UseVpcClient().DescribeSecurityGroupPoliciesWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceNotFound" {
@@ -1978,10 +2236,12 @@ func (me *VpcService) DetachAllLiteRulesFromSecurityGroup(ctx context.Context, s
 		Version: helper.String("0"),
 	}
 
-	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	return retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := me.client.UseVpcClient().ModifySecurityGroupPolicies(request); err != nil {
+		if _, err := me.client.UseVpcClient().ModifySecurityGroupPoliciesWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -2271,8 +2531,10 @@ func (me *VpcService) DescribeEipById(ctx context.Context, eipId string) (eip *v
 	request := vpc.NewDescribeAddressesRequest()
 	request.AddressIds = []*string{&eipId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DescribeAddresses(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DescribeAddressesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -2304,8 +2566,10 @@ func (me *VpcService) DescribeEipByFilter(ctx context.Context, filters map[strin
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DescribeAddresses(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DescribeAddressesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -2325,8 +2589,10 @@ func (me *VpcService) ModifyEipName(ctx context.Context, eipId, eipName string)
 	request.AddressId = &eipId
 	request.AddressName = &eipName
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().ModifyAddressAttribute(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseVpcClient().ModifyAddressAttributeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -2344,8 +2610,10 @@ func (me *VpcService) ModifyEipBandwidthOut(ctx context.Context, eipId string, b
 	request.AddressIds = []*string{&eipId}
 	request.InternetMaxBandwidthOut = helper.IntInt64(bandwidthOut)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().ModifyAddressesBandwidth(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseVpcClient().ModifyAddressesBandwidthWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -2371,8 +2639,10 @@ func (me *VpcService) ModifyEipInternetChargeType(ctx context.Context, eipId str
 		request.AddressChargePrepaid = &addressChargePrepaid
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().ModifyAddressInternetChargeType(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseVpcClient().ModifyAddressInternetChargeTypeWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -2392,8 +2662,10 @@ func (me *VpcService) RenewAddress(ctx context.Context, eipId string, period int
 	addressChargePrepaid.AutoRenewFlag = helper.IntInt64(renewFlag)
 	addressChargePrepaid.Period = helper.IntInt64(period)
 	request.AddressChargePrepaid = &addressChargePrepaid
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().RenewAddresses(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseVpcClient().RenewAddressesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -2410,8 +2682,10 @@ func (me *VpcService) DeleteEip(ctx context.Context, eipId string) error {
 	request := vpc.NewReleaseAddressesRequest()
 	request.AddressIds = []*string{&eipId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().ReleaseAddresses(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseVpcClient().ReleaseAddressesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -2429,8 +2703,10 @@ func (me *VpcService) AttachEip(ctx context.Context, eipId, instanceId string) e
 	request.AddressId = &eipId
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().AssociateAddress(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseVpcClient().AssociateAddressWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -2453,8 +2729,10 @@ func (me *VpcService) DescribeNatGatewayById(ctx context.Context, natGateWayId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DescribeNatGateways(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DescribeNatGatewaysWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -2492,8 +2770,10 @@ func (me *VpcService) DescribeNatGatewayByFilter(ctx context.Context, filters ma
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseVpcClient().DescribeNatGateways(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseVpcClient().DescribeNatGatewaysWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -2525,9 +2805,11 @@ func (me *VpcService) DeleteNatGateway(ctx context.Context, natGatewayId string)
 	}()
 	request.NatGatewayId = &natGatewayId
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, errRet = me.client.UseVpcClient().DeleteNatGateway(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, errRet = me.client.UseVpcClient().DeleteNatGatewayWithContext(ctx, request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
 		}
@@ -2578,8 +2860,10 @@ func (me *VpcService) DisassociateNatGatewayAddress(ctx context.Context, request
 
 	request.PublicIpAddresses = candidates
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DisassociateNatGatewayAddress(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DisassociateNatGatewayAddressWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -2616,7 +2900,7 @@ func (me *VpcService) UnattachEip(ctx context.Context, eipId string) error {
 			return err
 		}
 
-		outErr := resource.Retry(readRetryTimeout*3, func() *resource.RetryError {
+		outErr := retryContext(ctx, readRetryTimeout*3, func() *resource.RetryError {
 			eip, err := me.DescribeEipById(ctx, eipId)
 			if err != nil {
 				return retryError(err)
@@ -2634,8 +2918,10 @@ func (me *VpcService) UnattachEip(ctx context.Context, eipId string) error {
 
 	request := vpc.NewDisassociateAddressRequest()
 	request.AddressId = &eipId
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DisassociateAddress(request)
+	if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+		return err
+	}
+	response, err := me.client.UseVpcClient().DisassociateAddressWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -2651,9 +2937,11 @@ func (me *VpcService) UnattachEip(ctx context.Context, eipId string) error {
 
 	taskRequest := vpc.NewDescribeTaskResultRequest()
 	taskRequest.TaskId = &taskId
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(taskRequest.GetAction())
-		taskResponse, err := me.client.UseVpcClient().DescribeTaskResult(taskRequest)
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, taskRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		taskResponse, err := me.client.UseVpcClient().DescribeTaskResultWithContext(ctx, taskRequest)
 		if err != nil {
 			return retryError(err)
 		}
@@ -2716,10 +3004,12 @@ func (me *VpcService) CreateEni(
 		})
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(createRequest.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, createRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.CreateNetworkInterface(createRequest)
+		response, err := client.CreateNetworkInterfaceWithContext(ctx, createRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, createRequest.GetAction(), createRequest.ToJsonString(), err)
@@ -2883,10 +3173,12 @@ func (me *VpcService) describeEnis(
 
 	count := ENI_DESCRIBE_LIMIT
 	for count == ENI_DESCRIBE_LIMIT {
-		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
+		if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
 
-			response, err := me.client.UseVpcClient().DescribeNetworkInterfaces(request)
+			response, err := me.client.UseVpcClient().DescribeNetworkInterfacesWithContext(ctx, request)
 			if err != nil {
 				count = 0
 
@@ -2931,10 +3223,12 @@ func (me *VpcService) ModifyEniAttribute(ctx context.Context, id string, name, d
 	request.NetworkInterfaceDescription = desc
 	request.SecurityGroupIds = common.StringPtrs(sgs)
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.ModifyNetworkInterfaceAttribute(request); err != nil {
+		if _, err := client.ModifyNetworkInterfaceAttributeWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -2967,10 +3261,12 @@ func (me *VpcService) UnAssignIpv4FromEni(ctx context.Context, id string, ipv4s
 		})
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.UnassignPrivateIpAddresses(request); err != nil {
+		if _, err := client.UnassignPrivateIpAddressesWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -3017,10 +3313,12 @@ func (me *VpcService) AssignIpv4ToEni(ctx context.Context, id string, ipv4s []Vp
 		}
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.AssignPrivateIpAddresses(request)
+		response, err := client.AssignPrivateIpAddressesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -3092,10 +3390,12 @@ func (me *VpcService) DeleteEni(ctx context.Context, id string) error {
 	deleteRequest := vpc.NewDeleteNetworkInterfaceRequest()
 	deleteRequest.NetworkInterfaceId = &id
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, deleteRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.DeleteNetworkInterface(deleteRequest); err != nil {
+		if _, err := client.DeleteNetworkInterfaceWithContext(ctx, deleteRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
 			return retryError(err)
@@ -3110,10 +3410,12 @@ func (me *VpcService) DeleteEni(ctx context.Context, id string) error {
 	describeRequest := vpc.NewDescribeNetworkInterfacesRequest()
 	describeRequest.NetworkInterfaceIds = []*string{&id}
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, describeRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.DescribeNetworkInterfaces(describeRequest)
+		response, err := client.DescribeNetworkInterfacesWithContext(ctx, describeRequest)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				if sdkError.Code == "ResourceNotFound" {
@@ -3157,10 +3459,12 @@ func (me *VpcService) AttachEniToCvm(ctx context.Context, eniId, cvmId string) e
 	attachRequest.NetworkInterfaceId = &eniId
 	attachRequest.InstanceId = &cvmId
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(attachRequest.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, attachRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.AttachNetworkInterface(attachRequest); err != nil {
+		if _, err := client.AttachNetworkInterfaceWithContext(ctx, attachRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, attachRequest.GetAction(), attachRequest.ToJsonString(), err)
 			return retryError(err)
@@ -3175,10 +3479,12 @@ func (me *VpcService) AttachEniToCvm(ctx context.Context, eniId, cvmId string) e
 	describeRequest := vpc.NewDescribeNetworkInterfacesRequest()
 	describeRequest.NetworkInterfaceIds = []*string{&eniId}
 
-	if err := resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
+	if err := retryContext(ctx, 2*readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, describeRequest.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.DescribeNetworkInterfaces(describeRequest)
+		response, err := client.DescribeNetworkInterfacesWithContext(ctx, describeRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, describeRequest.GetAction(), describeRequest.ToJsonString(), err)
@@ -3252,10 +3558,12 @@ func (me *VpcService) DetachEniFromCvm(ctx context.Context, eniId, cvmId string)
 	request.NetworkInterfaceId = &eniId
 	request.InstanceId = &cvmId
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.DetachNetworkInterface(request); err != nil {
+		if _, err := client.DetachNetworkInterfaceWithContext(ctx, request); err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				switch sdkError.Code {
 				case "UnsupportedOperation.InvalidState":
@@ -3299,10 +3607,12 @@ func (me *VpcService) ModifyEniPrimaryIpv4Desc(ctx context.Context, id, ip strin
 		},
 	}
 
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if _, err := client.ModifyPrivateIpAddressesAttribute(request); err != nil {
+		if _, err := client.ModifyPrivateIpAddressesAttributeWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -3350,8 +3660,10 @@ func (me *VpcService) DescribeHaVipByFilter(ctx context.Context, filters map[str
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseVpcClient().DescribeHaVips(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseVpcClient().DescribeHaVipsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -3377,7 +3689,7 @@ func (me *VpcService) DescribeHaVipEipById(ctx context.Context, haVipEipAttachme
 	logId := getLogId(ctx)
 	client := me.client.UseVpcClient()
 
-	items := strings.Split(haVipEipAttachmentId, "#")
+	items := helper.IdParse(haVipEipAttachmentId)
 	if len(items) != 2 {
 		errRet = fmt.Errorf("decode HA VIP EIP attachment ID error %s", haVipEipAttachmentId)
 		return
@@ -3389,10 +3701,12 @@ func (me *VpcService) DescribeHaVipEipById(ctx context.Context, haVipEipAttachme
 	request.HaVipIds = []*string{&haVipId}
 	eip = ""
 	haVip = ""
-	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if result, err := client.DescribeHaVips(request); err != nil {
+		if result, err := client.DescribeHaVipsWithContext(ctx, request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
 			return retryError(err)
@@ -3432,9 +3746,11 @@ func (me *VpcService) DeleteHaVip(ctx context.Context, haVipId string) (errRet e
 	}()
 	request.HaVipId = &haVipId
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, errRet = me.client.UseVpcClient().DeleteHaVip(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, errRet = me.client.UseVpcClient().DeleteHaVipWithContext(ctx, request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
 		}
@@ -3459,10 +3775,12 @@ func waitEniReady(ctx context.Context, id string, client *vpc.Client, wantIpv4s
 	request := vpc.NewDescribeNetworkInterfacesRequest()
 	request.NetworkInterfaceIds = []*string{helper.String(id)}
 
-	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	if err := retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.DescribeNetworkInterfaces(request)
+		response, err := client.DescribeNetworkInterfacesWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -3567,10 +3885,12 @@ func waitEniDetach(ctx context.Context, id string, client *vpc.Client) error {
 	request := vpc.NewDescribeNetworkInterfacesRequest()
 	request.NetworkInterfaceIds = []*string{helper.String(id)}
 
-	return resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	return retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		response, err := client.DescribeNetworkInterfaces(request)
+		response, err := client.DescribeNetworkInterfacesWithContext(ctx, request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok && sdkError.Code == "ResourceNotFound" {
 				return nil
@@ -3679,9 +3999,11 @@ func (me *VpcService) CreateVpcNetworkAcl(ctx context.Context, vpcID string, nam
 		}
 	}
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err = me.client.UseVpcClient().CreateNetworkAcl(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err = me.client.UseVpcClient().CreateNetworkAclWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -3749,27 +4071,118 @@ func (me *VpcService) ModifyNetWorkAclRules(ctx context.Context, aclID string, i
 	}
 
 	request.NetworkAclId = &aclID
-	request.NetworkAclEntrySet = &vpc.NetworkAclEntrySet{
-		Ingress: ingress,
-		Egress:  egress,
-	}
+	request.NetworkAclEntrySet = &vpc.NetworkAclEntrySet{
+		Ingress: ingress,
+		Egress:  egress,
+	}
+
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err = me.client.UseVpcClient().ModifyNetworkAclEntriesWithContext(ctx, request)
+		if err != nil {
+			return retryError(err, InternalError)
+		}
+		return nil
+	})
+
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
+			logId, request.GetAction(), request.ToJsonString(), err)
+		errRet = err
+		return
+	}
+
+	return
+}
+
+// CreateNetworkAclEntries appends the entries to the network ACL.
+func (me *VpcService) CreateNetworkAclEntries(ctx context.Context, aclID string, entrySet *vpc.NetworkAclEntrySet) (errRet error) {
+	var (
+		logId   = getLogId(ctx)
+		request = vpc.NewCreateNetworkAclEntriesRequest()
+	)
+	request.NetworkAclId = &aclID
+	request.NetworkAclEntrySet = entrySet
+
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, e := me.client.UseVpcClient().CreateNetworkAclEntriesWithContext(ctx, request)
+		if e != nil {
+			return retryError(e, InternalError)
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
+			logId, request.GetAction(), request.ToJsonString(), err)
+		errRet = err
+	}
+	return
+}
+
+// UpdateNetworkAclEntries patches the entries of the network ACL identified by their entry IDs,
+// unlike ModifyNetWorkAclRules the other entries are kept.
+func (me *VpcService) UpdateNetworkAclEntries(ctx context.Context, aclID string, entrySet *vpc.NetworkAclEntrySet) (errRet error) {
+	var (
+		logId   = getLogId(ctx)
+		request = vpc.NewModifyNetworkAclEntriesRequest()
+	)
+	request.NetworkAclId = &aclID
+	request.NetworkAclEntrySet = entrySet
+	request.EnableUpdateAclEntries = helper.Bool(true)
+
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, e := me.client.UseVpcClient().ModifyNetworkAclEntriesWithContext(ctx, request)
+		if e != nil {
+			return retryError(e, InternalError)
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
+			logId, request.GetAction(), request.ToJsonString(), err)
+		errRet = err
+	}
+	return
+}
+
+// DeleteNetworkAclEntries deletes the entries of the network ACL identified by their entry IDs.
+func (me *VpcService) DeleteNetworkAclEntries(ctx context.Context, aclID string, entrySet *vpc.NetworkAclEntrySet) (errRet error) {
+	var (
+		logId   = getLogId(ctx)
+		request = vpc.NewDeleteNetworkAclEntriesRequest()
+	)
+	request.NetworkAclId = &aclID
+	request.NetworkAclEntrySet = entrySet
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err = me.client.UseVpcClient().ModifyNetworkAclEntries(request)
-		if err != nil {
-			return retryError(err, InternalError)
+	err := retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
 		}
+		response, e := me.client.UseVpcClient().DeleteNetworkAclEntriesWithContext(ctx, request)
+		if e != nil {
+			return retryError(e, InternalError)
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 		return nil
 	})
-
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 			logId, request.GetAction(), request.ToJsonString(), err)
 		errRet = err
-		return
 	}
-
 	return
 }
 
@@ -3814,9 +4227,11 @@ func (me *VpcService) DeleteAcl(ctx context.Context, aclID string) (errRet error
 				requestSubnet.SubnetIds = append(requestSubnet.SubnetIds, subnets[i].SubnetId)
 			}
 
-			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				ratelimit.Check(request.GetAction())
-				_, err = me.client.UseVpcClient().DisassociateNetworkAclSubnets(requestSubnet)
+			err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+				if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+					return resource.NonRetryableError(err)
+				}
+				_, err = me.client.UseVpcClient().DisassociateNetworkAclSubnetsWithContext(ctx, requestSubnet)
 				if err != nil {
 					if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 						if ee.Code == VPCNotFound {
@@ -3838,9 +4253,11 @@ func (me *VpcService) DeleteAcl(ctx context.Context, aclID string) (errRet error
 
 	// delete acl
 	request.NetworkAclId = &aclID
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err = me.client.UseVpcClient().DeleteNetworkAcl(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err = me.client.UseVpcClient().DeleteNetworkAclWithContext(ctx, request)
 
 		if err != nil {
 			ee, ok := err.(*sdkErrors.TencentCloudSDKError)
@@ -3877,9 +4294,11 @@ func (me *VpcService) ModifyVpcNetworkAcl(ctx context.Context, id *string, name
 	request.NetworkAclId = id
 	request.NetworkAclName = name
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err = me.client.UseVpcClient().ModifyNetworkAclAttribute(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err = me.client.UseVpcClient().ModifyNetworkAclAttributeWithContext(ctx, request)
 		if err != nil {
 			ee, ok := err.(*sdkErrors.TencentCloudSDKError)
 			if !ok {
@@ -3920,9 +4339,11 @@ func (me *VpcService) AssociateAclSubnets(ctx context.Context, aclId string, sub
 	request.NetworkAclId = &aclId
 	request.SubnetIds = subIds
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err = me.client.UseVpcClient().AssociateNetworkAclSubnets(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err = me.client.UseVpcClient().AssociateNetworkAclSubnetsWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -3964,9 +4385,11 @@ func (me *VpcService) DescribeNetWorkAcls(ctx context.Context, aclID, vpcID, nam
 	request.Offset = &offset
 	request.Limit = &pageSize
 	for {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			response, err = me.client.UseVpcClient().DescribeNetworkAcls(request)
+		err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			response, err = me.client.UseVpcClient().DescribeNetworkAclsWithContext(ctx, request)
 			if err != nil {
 				if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 					if ee.Code == VPCNotFound {
@@ -4017,7 +4440,7 @@ func (me *VpcService) DescribeByAclId(ctx context.Context, attachmentAcl string)
 		return
 	}
 
-	aclId = strings.Split(attachmentAcl, "#")[0]
+	aclId = helper.IdParse(attachmentAcl)[0]
 
 	results, err := me.DescribeNetWorkAcls(ctx, aclId, "", "")
 	if err != nil {
@@ -4044,13 +4467,15 @@ func (me *VpcService) DeleteAclAttachment(ctx context.Context, attachmentAcl str
 		return
 	}
 
-	items := strings.Split(attachmentAcl, "#")
+	items := helper.IdParse(attachmentAcl)
 	request.NetworkAclId = &items[0]
 	request.SubnetIds = helper.Strings(items[1:])
 
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, err = me.client.UseVpcClient().DisassociateNetworkAclSubnets(request)
+	err = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, err = me.client.UseVpcClient().DisassociateNetworkAclSubnetsWithContext(ctx, request)
 		if err != nil {
 			return retryError(err, InternalError)
 		}
@@ -4073,8 +4498,8 @@ func (me *VpcService) DescribeVpngwById(ctx context.Context, vpngwId string) (ha
 		response *vpc.DescribeVpnGatewaysResponse
 	)
 	request.VpnGatewayIds = []*string{&vpngwId}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		response, err = me.client.UseVpcClient().DescribeVpnGateways(request)
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		response, err = me.client.UseVpcClient().DescribeVpnGatewaysWithContext(ctx, request)
 		if err != nil {
 			ee, ok := err.(*sdkErrors.TencentCloudSDKError)
 			if !ok {
@@ -4123,8 +4548,10 @@ func (me *VpcService) DescribeVpnGwByFilter(ctx context.Context, filters map[str
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseVpcClient().DescribeVpnGateways(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseVpcClient().DescribeVpnGatewaysWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -4156,9 +4583,11 @@ func (me *VpcService) DeleteVpnGateway(ctx context.Context, vpnGatewayId string)
 	}()
 	request.VpnGatewayId = &vpnGatewayId
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, errRet = me.client.UseVpcClient().DeleteVpnGateway(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, errRet = me.client.UseVpcClient().DeleteVpnGatewayWithContext(ctx, request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
 		}
@@ -4188,8 +4617,10 @@ func (me *VpcService) DescribeCustomerGatewayByFilter(ctx context.Context, filte
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseVpcClient().DescribeCustomerGateways(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseVpcClient().DescribeCustomerGatewaysWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -4221,9 +4652,11 @@ func (me *VpcService) DeleteCustomerGateway(ctx context.Context, customerGateway
 	}()
 	request.CustomerGatewayId = &customerGatewayId
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, errRet = me.client.UseVpcClient().DeleteCustomerGateway(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, errRet = me.client.UseVpcClient().DeleteCustomerGatewayWithContext(ctx, request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
 		}
@@ -4248,8 +4681,10 @@ func (me *VpcService) CreateAddressTemplate(ctx context.Context, name string, ad
 		request.Addresses[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().CreateAddressTemplate(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().CreateAddressTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -4299,8 +4734,10 @@ func (me *VpcService) DescribeAddressTemplates(ctx context.Context, filter []*vp
 		request.Offset = helper.String(strconv.Itoa(offset))
 		request.Limit = helper.String(strconv.Itoa(limit))
 
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseVpcClient().DescribeAddressTemplates(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseVpcClient().DescribeAddressTemplatesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -4333,8 +4770,10 @@ func (me *VpcService) ModifyAddressTemplate(ctx context.Context, templateId stri
 		request.Addresses[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseVpcClient().ModifyAddressTemplateAttribute(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseVpcClient().ModifyAddressTemplateAttributeWithContext(ctx, request)
 	return err
 }
 
@@ -4348,8 +4787,10 @@ func (me *VpcService) DeleteAddressTemplate(ctx context.Context, templateId stri
 	}()
 	request.AddressTemplateId = &templateId
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseVpcClient().DeleteAddressTemplate(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseVpcClient().DeleteAddressTemplateWithContext(ctx, request)
 	return err
 }
 
@@ -4369,8 +4810,10 @@ func (me *VpcService) CreateAddressTemplateGroup(ctx context.Context, name strin
 		request.AddressTemplateIds[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().CreateAddressTemplateGroup(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().CreateAddressTemplateGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -4401,8 +4844,10 @@ func (me *VpcService) ModifyAddressTemplateGroup(ctx context.Context, templateGr
 		request.AddressTemplateIds[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseVpcClient().ModifyAddressTemplateGroupAttribute(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseVpcClient().ModifyAddressTemplateGroupAttributeWithContext(ctx, request)
 	return err
 }
 
@@ -4442,8 +4887,10 @@ func (me *VpcService) DescribeAddressTemplateGroups(ctx context.Context, filter
 		request.Offset = helper.String(strconv.Itoa(offset))
 		request.Limit = helper.String(strconv.Itoa(limit))
 
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseVpcClient().DescribeAddressTemplateGroups(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseVpcClient().DescribeAddressTemplateGroupsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -4469,8 +4916,10 @@ func (me *VpcService) DeleteAddressTemplateGroup(ctx context.Context, templateGr
 	}()
 	request.AddressTemplateGroupId = &templateGroupId
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseVpcClient().DeleteAddressTemplateGroup(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseVpcClient().DeleteAddressTemplateGroupWithContext(ctx, request)
 	return err
 }
 
@@ -4490,8 +4939,10 @@ func (me *VpcService) CreateServiceTemplate(ctx context.Context, name string, se
 		request.Services[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().CreateServiceTemplate(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().CreateServiceTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -4522,8 +4973,10 @@ func (me *VpcService) ModifyServiceTemplate(ctx context.Context, templateId stri
 		request.Services[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseVpcClient().ModifyServiceTemplateAttribute(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseVpcClient().ModifyServiceTemplateAttributeWithContext(ctx, request)
 	return err
 }
 
@@ -4563,8 +5016,10 @@ func (me *VpcService) DescribeServiceTemplates(ctx context.Context, filter []*vp
 		request.Offset = helper.String(strconv.Itoa(offset))
 		request.Limit = helper.String(strconv.Itoa(limit))
 
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseVpcClient().DescribeServiceTemplates(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseVpcClient().DescribeServiceTemplatesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -4590,8 +5045,10 @@ func (me *VpcService) DeleteServiceTemplate(ctx context.Context, templateId stri
 	}()
 	request.ServiceTemplateId = &templateId
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseVpcClient().DeleteServiceTemplate(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseVpcClient().DeleteServiceTemplateWithContext(ctx, request)
 	return err
 }
 
@@ -4611,8 +5068,10 @@ func (me *VpcService) CreateServiceTemplateGroup(ctx context.Context, name strin
 		request.ServiceTemplateIds[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().CreateServiceTemplateGroup(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().CreateServiceTemplateGroupWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -4662,8 +5121,10 @@ func (me *VpcService) DescribeServiceTemplateGroups(ctx context.Context, filter
 		request.Offset = helper.String(strconv.Itoa(offset))
 		request.Limit = helper.String(strconv.Itoa(limit))
 
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseVpcClient().DescribeServiceTemplateGroups(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseVpcClient().DescribeServiceTemplateGroupsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -4696,8 +5157,10 @@ func (me *VpcService) ModifyServiceTemplateGroup(ctx context.Context, serviceGro
 		request.ServiceTemplateIds[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseVpcClient().ModifyServiceTemplateGroupAttribute(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseVpcClient().ModifyServiceTemplateGroupAttributeWithContext(ctx, request)
 	return err
 }
 
@@ -4711,8 +5174,10 @@ func (me *VpcService) DeleteServiceTemplateGroup(ctx context.Context, templateGr
 	}()
 	request.ServiceTemplateGroupId = &templateGroupId
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseVpcClient().DeleteServiceTemplateGroup(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	_, err := me.client.UseVpcClient().DeleteServiceTemplateGroupWithContext(ctx, request)
 	return err
 }
 
@@ -4728,9 +5193,11 @@ func (me *VpcService) CreateVpnGatewayRoute(ctx context.Context, vpnGatewayId st
 	request.Routes = vpnGwRoutes
 
 	var response *vpc.CreateVpnGatewayRoutesResponse
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, errRet = me.client.UseVpcClient().CreateVpnGatewayRoutes(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, errRet = me.client.UseVpcClient().CreateVpnGatewayRoutesWithContext(ctx, request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s create vpn gateway route failed, reason: %v", logId, errRet)
 			return retryError(errRet, InternalError)
@@ -4764,9 +5231,11 @@ func (me *VpcService) ModifyVpnGatewayRoute(ctx context.Context, vpnGatewayId, r
 	}}
 
 	var response *vpc.ModifyVpnGatewayRoutesResponse
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, errRet = me.client.UseVpcClient().ModifyVpnGatewayRoutes(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, errRet = me.client.UseVpcClient().ModifyVpnGatewayRoutesWithContext(ctx, request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
 		}
@@ -4795,9 +5264,11 @@ func (me *VpcService) DeleteVpnGatewayRoutes(ctx context.Context, vpnGatewayId s
 	request.VpnGatewayId = &vpnGatewayId
 	request.RouteIds = routeIds
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, errRet = me.client.UseVpcClient().DeleteVpnGatewayRoutes(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, errRet = me.client.UseVpcClient().DeleteVpnGatewayRoutesWithContext(ctx, request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
 		}
@@ -4825,9 +5296,11 @@ func (me *VpcService) DescribeVpnGatewayRoutes(ctx context.Context, vpnGatewayId
 		request.Offset = &offset
 		request.Limit = &limit
 		var response *vpc.DescribeVpnGatewayRoutesResponse
-		errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			response, errRet = me.client.UseVpcClient().DescribeVpnGatewayRoutes(request)
+		errRet = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			response, errRet = me.client.UseVpcClient().DescribeVpnGatewayRoutesWithContext(ctx, request)
 			if errRet != nil {
 				return retryError(errRet, InternalError)
 			}
@@ -4858,9 +5331,11 @@ func (me *VpcService) DescribeVpcTaskResult(ctx context.Context, taskId *string)
 		}
 	}()
 	request.TaskId = taskId
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseVpcClient().DescribeVpcTaskResult(request)
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := me.client.UseVpcClient().DescribeVpcTaskResultWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -4885,9 +5360,11 @@ func (me *VpcService) DescribeTaskResult(ctx context.Context, taskId *uint64) (r
 		}
 	}()
 	request.TaskId = taskId
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseVpcClient().DescribeTaskResult(request)
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, err := me.client.UseVpcClient().DescribeTaskResultWithContext(ctx, request)
 		if err != nil {
 			return retryError(err)
 		}
@@ -4907,8 +5384,8 @@ func (me *VpcService) DescribeVpnSslServerById(ctx context.Context, sslId string
 		response *vpc.DescribeVpnGatewaySslServersResponse
 	)
 	request.SslVpnServerIds = []*string{&sslId}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		response, err = me.client.UseVpcClient().DescribeVpnGatewaySslServers(request)
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		response, err = me.client.UseVpcClient().DescribeVpnGatewaySslServersWithContext(ctx, request)
 		if err != nil {
 			ee, ok := err.(*sdkErrors.TencentCloudSDKError)
 			if !ok {
@@ -4957,8 +5434,10 @@ func (me *VpcService) DescribeVpnGwSslServerByFilter(ctx context.Context, filter
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseVpcClient().DescribeVpnGatewaySslServers(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseVpcClient().DescribeVpnGatewaySslServersWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -4990,9 +5469,11 @@ func (me *VpcService) DeleteVpnGatewaySslServer(ctx context.Context, SslServerId
 	}()
 	request.SslVpnServerId = &SslServerId
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, errRet := me.client.UseVpcClient().DeleteVpnGatewaySslServer(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, errRet := me.client.UseVpcClient().DeleteVpnGatewaySslServerWithContext(ctx, request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
 		}
@@ -5009,8 +5490,8 @@ func (me *VpcService) DescribeVpnSslClientById(ctx context.Context, sslId string
 		response *vpc.DescribeVpnGatewaySslClientsResponse
 	)
 	request.SslVpnClientIds = []*string{&sslId}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		response, err = me.client.UseVpcClient().DescribeVpnGatewaySslClients(request)
+	err = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		response, err = me.client.UseVpcClient().DescribeVpnGatewaySslClientsWithContext(ctx, request)
 		if err != nil {
 			ee, ok := err.(*sdkErrors.TencentCloudSDKError)
 			if !ok {
@@ -5059,8 +5540,10 @@ func (me *VpcService) DescribeVpnGwSslClientByFilter(ctx context.Context, filter
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseVpcClient().DescribeVpnGatewaySslClients(request)
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseVpcClient().DescribeVpnGatewaySslClientsWithContext(ctx, request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -5092,9 +5575,11 @@ func (me *VpcService) DeleteVpnGatewaySslClient(ctx context.Context, SslClientId
 	}()
 	request.SslVpnClientId = &SslClientId
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, errRet := me.client.UseVpcClient().DeleteVpnGatewaySslClient(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, errRet := me.client.UseVpcClient().DeleteVpnGatewaySslClientWithContext(ctx, request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
 		}
@@ -5116,9 +5601,11 @@ func (me *VpcService) CreateNatGatewaySnat(ctx context.Context, natGatewayId str
 	request.SourceIpTranslationNatRules = []*vpc.SourceIpTranslationNatRule{snat}
 
 	var response *vpc.CreateNatGatewaySourceIpTranslationNatRuleResponse
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, errRet = me.client.UseVpcClient().CreateNatGatewaySourceIpTranslationNatRule(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, errRet = me.client.UseVpcClient().CreateNatGatewaySourceIpTranslationNatRuleWithContext(ctx, request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s create nat gateway source ip translation nat rule failed, reason: %v", logId, errRet)
 			return retryError(errRet, InternalError)
@@ -5147,9 +5634,11 @@ func (me *VpcService) ModifyNatGatewaySnat(ctx context.Context, natGatewayId str
 	request.SourceIpTranslationNatRule = snat
 
 	var response *vpc.ModifyNatGatewaySourceIpTranslationNatRuleResponse
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		response, errRet = me.client.UseVpcClient().ModifyNatGatewaySourceIpTranslationNatRule(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		response, errRet = me.client.UseVpcClient().ModifyNatGatewaySourceIpTranslationNatRuleWithContext(ctx, request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
 		}
@@ -5176,9 +5665,11 @@ func (me *VpcService) DeleteNatGatewaySnat(ctx context.Context, natGatewayId str
 	request.NatGatewayId = &natGatewayId
 	request.NatGatewaySnatIds = []*string{&snatId}
 
-	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		_, errRet = me.client.UseVpcClient().DeleteNatGatewaySourceIpTranslationNatRule(request)
+	errRet = retryContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		_, errRet = me.client.UseVpcClient().DeleteNatGatewaySourceIpTranslationNatRuleWithContext(ctx, request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
 		}
@@ -5206,9 +5697,11 @@ func (me *VpcService) DescribeNatGatewaySnats(ctx context.Context, natGatewayId
 		request.Offset = &offset
 		request.Limit = &limit
 		var response *vpc.DescribeNatGatewaySourceIpTranslationNatRulesResponse
-		errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-			response, errRet = me.client.UseVpcClient().DescribeNatGatewaySourceIpTranslationNatRules(request)
+		errRet = retryContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			if err := ratelimit.CheckContext(ctx, request.GetAction()); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			response, errRet = me.client.UseVpcClient().DescribeNatGatewaySourceIpTranslationNatRulesWithContext(ctx, request)
 			if errRet != nil {
 				return retryError(errRet, InternalError)
 			}
@@ -5242,8 +5735,10 @@ func (me *VpcService) DescribeAssistantCidr(ctx context.Context, vpcId string) (
 
 	request.VpcIds = []*string{&vpcId}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DescribeAssistantCidr(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DescribeAssistantCidrWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -5268,8 +5763,10 @@ func (me *VpcService) CheckAssistantCidr(ctx context.Context, request *vpc.Check
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().CheckAssistantCidr(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().CheckAssistantCidrWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -5293,8 +5790,10 @@ func (me *VpcService) CreateAssistantCidr(ctx context.Context, request *vpc.Crea
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().CreateAssistantCidr(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().CreateAssistantCidrWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -5318,8 +5817,10 @@ func (me *VpcService) ModifyAssistantCidr(ctx context.Context, request *vpc.Modi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().ModifyAssistantCidr(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().ModifyAssistantCidrWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -5341,8 +5842,10 @@ func (me *VpcService) DeleteAssistantCidr(ctx context.Context, request *vpc.Dele
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DeleteAssistantCidr(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DeleteAssistantCidrWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -5376,8 +5879,10 @@ func (me *VpcService) DescribeVpcBandwidthPackage(ctx context.Context, bandwidth
 	//		Values: []*string{&bandwidthPackageId},
 	//	},
 	//)
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DescribeBandwidthPackages(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DescribeBandwidthPackagesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -5408,8 +5913,10 @@ func (me *VpcService) DeleteVpcBandwidthPackageById(ctx context.Context, bandwid
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DeleteBandwidthPackage(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DeleteBandwidthPackageWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -5442,8 +5949,10 @@ func (me *VpcService) DescribeVpcBandwidthPackageAttachment(ctx context.Context,
 		},
 	)
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DescribeBandwidthPackageResources(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DescribeBandwidthPackageResourcesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -5483,8 +5992,10 @@ func (me *VpcService) DeleteVpcBandwidthPackageAttachmentById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().RemoveBandwidthPackageResources(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().RemoveBandwidthPackageResourcesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return err
@@ -5504,8 +6015,10 @@ func (me *VpcService) DescribeFlowLogs(ctx context.Context, request *vpc.Describ
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DescribeFlowLogs(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DescribeFlowLogsWithContext(ctx, request)
 
 	if err != nil {
 		errRet = err
@@ -5536,9 +6049,11 @@ func (me *VpcService) DescribeVpcFlowLogById(ctx context.Context, flowLogId, vpc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeFlowLog(request)
+	response, err := me.client.UseVpcClient().DescribeFlowLogWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -5568,9 +6083,11 @@ func (me *VpcService) DeleteVpcFlowLogById(ctx context.Context, flowLogId, vpcId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DeleteFlowLog(request)
+	response, err := me.client.UseVpcClient().DeleteFlowLogWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -5592,7 +6109,9 @@ func (me *VpcService) DescribeVpcEndPointServiceById(ctx context.Context, endPoi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -5602,7 +6121,7 @@ func (me *VpcService) DescribeVpcEndPointServiceById(ctx context.Context, endPoi
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseVpcClient().DescribeVpcEndPointService(request)
+		response, err := me.client.UseVpcClient().DescribeVpcEndPointServiceWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -5639,9 +6158,11 @@ func (me *VpcService) DeleteVpcEndPointServiceById(ctx context.Context, endPoint
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DeleteVpcEndPointService(request)
+	response, err := me.client.UseVpcClient().DeleteVpcEndPointServiceWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -5663,7 +6184,9 @@ func (me *VpcService) DescribeVpcEndPointById(ctx context.Context, endPointId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -5673,7 +6196,7 @@ func (me *VpcService) DescribeVpcEndPointById(ctx context.Context, endPointId st
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseVpcClient().DescribeVpcEndPoint(request)
+		response, err := me.client.UseVpcClient().DescribeVpcEndPointWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -5710,9 +6233,11 @@ func (me *VpcService) DeleteVpcEndPointById(ctx context.Context, endPointId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DeleteVpcEndPoint(request)
+	response, err := me.client.UseVpcClient().DeleteVpcEndPointWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -5749,7 +6274,9 @@ func (me *VpcService) DescribeVpcEndPointServiceWhiteListById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -5759,7 +6286,7 @@ func (me *VpcService) DescribeVpcEndPointServiceWhiteListById(ctx context.Contex
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseVpcClient().DescribeVpcEndPointServiceWhiteList(request)
+		response, err := me.client.UseVpcClient().DescribeVpcEndPointServiceWhiteListWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -5797,9 +6324,11 @@ func (me *VpcService) DeleteVpcEndPointServiceWhiteListById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DeleteVpcEndPointServiceWhiteList(request)
+	response, err := me.client.UseVpcClient().DeleteVpcEndPointServiceWhiteListWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -5829,8 +6358,10 @@ func (me *VpcService) DescribeVpcBandwidthPackageByEip(ctx context.Context, eipI
 			Values: []*string{&eipId},
 		},
 	)
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseVpcClient().DescribeBandwidthPackages(request)
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DescribeBandwidthPackagesWithContext(ctx, request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
@@ -5859,9 +6390,11 @@ func (me *VpcService) DescribeVpcCcnRoutesById(ctx context.Context, ccnId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeCcnRoutes(request)
+	response, err := me.client.UseVpcClient().DescribeCcnRoutesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -5945,7 +6478,9 @@ func (me *VpcService) DescribeCcnCrossBorderComplianceByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -5954,7 +6489,7 @@ func (me *VpcService) DescribeCcnCrossBorderComplianceByFilter(ctx context.Conte
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseVpcClient().DescribeCrossBorderCompliance(request)
+		response, err := me.client.UseVpcClient().DescribeCrossBorderComplianceWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -5996,7 +6531,9 @@ func (me *VpcService) DescribeTenantCcnByFilter(ctx context.Context, param map[s
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -6005,7 +6542,7 @@ func (me *VpcService) DescribeTenantCcnByFilter(ctx context.Context, param map[s
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseVpcClient().DescribeTenantCcns(request)
+		response, err := me.client.UseVpcClient().DescribeTenantCcnsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -6064,9 +6601,11 @@ func (me *VpcService) DescribeCcnCrossBorderFlowMonitorByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeCrossBorderFlowMonitor(request)
+	response, err := me.client.UseVpcClient().DescribeCrossBorderFlowMonitorWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6094,9 +6633,11 @@ func (me *VpcService) DescribeVpnCustomerGatewayVendors(ctx context.Context) (vp
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeCustomerGatewayVendors(request)
+	response, err := me.client.UseVpcClient().DescribeCustomerGatewayVendorsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6123,9 +6664,11 @@ func (me *VpcService) DescribeVpcVpnGatewayCcnRoutesById(ctx context.Context, vp
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeVpnGatewayCcnRoutes(request)
+	response, err := me.client.UseVpcClient().DescribeVpnGatewayCcnRoutesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6157,9 +6700,11 @@ func (me *VpcService) DescribeVpcIpv6AddressById(ctx context.Context, ip6Address
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeIp6Addresses(request)
+	response, err := me.client.UseVpcClient().DescribeIp6AddressesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6186,9 +6731,11 @@ func (me *VpcService) DeleteVpcIpv6AddressById(ctx context.Context, ip6AddressId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().ReleaseIp6AddressesBandwidth(request)
+	response, err := me.client.UseVpcClient().ReleaseIp6AddressesBandwidthWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6232,7 +6779,9 @@ func (me *VpcService) DescribeVpcCcnRegionBandwidthLimitsByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -6241,7 +6790,7 @@ func (me *VpcService) DescribeVpcCcnRegionBandwidthLimitsByFilter(ctx context.Co
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseVpcClient().DescribeCrossBorderCcnRegionBandwidthLimits(request)
+		response, err := me.client.UseVpcClient().DescribeCrossBorderCcnRegionBandwidthLimitsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -6283,7 +6832,9 @@ func (me *VpcService) DescribeNatDcRouteByFilter(ctx context.Context, param map[
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -6292,7 +6843,7 @@ func (me *VpcService) DescribeNatDcRouteByFilter(ctx context.Context, param map[
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseVpcClient().DescribeNatGatewayDirectConnectGatewayRoute(request)
+		response, err := me.client.UseVpcClient().DescribeNatGatewayDirectConnectGatewayRouteWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -6325,9 +6876,11 @@ func (me *VpcService) DescribeEipAddressQuota(ctx context.Context) (addressQuota
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeAddressQuota(request)
+	response, err := me.client.UseVpcClient().DescribeAddressQuotaWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6351,9 +6904,11 @@ func (me *VpcService) DescribeEipNetworkAccountType(ctx context.Context) (networ
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeNetworkAccountType(request)
+	response, err := me.client.UseVpcClient().DescribeNetworkAccountTypeWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6377,9 +6932,11 @@ func (me *VpcService) DescribeVpcBandwidthPackageQuota(ctx context.Context) (ban
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeBandwidthPackageQuota(request)
+	response, err := me.client.UseVpcClient().DescribeBandwidthPackageQuotaWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6409,9 +6966,11 @@ func (me *VpcService) DescribeVpcBandwidthPackageBillUsageByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeBandwidthPackageBillUsage(request)
+	response, err := me.client.UseVpcClient().DescribeBandwidthPackageBillUsageWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6435,9 +6994,11 @@ func (me *VpcService) DescribeVpcTrafficPackageById(ctx context.Context, traffic
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeTrafficPackages(request)
+	response, err := me.client.UseVpcClient().DescribeTrafficPackagesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6464,9 +7025,11 @@ func (me *VpcService) DeleteVpcTrafficPackageById(ctx context.Context, trafficPa
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DeleteTrafficPackages(request)
+	response, err := me.client.UseVpcClient().DeleteTrafficPackagesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6488,9 +7051,11 @@ func (me *VpcService) DescribeVpcSnapshotPoliciesById(ctx context.Context, snaps
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeSnapshotPolicies(request)
+	response, err := me.client.UseVpcClient().DescribeSnapshotPoliciesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6513,9 +7078,11 @@ func (me *VpcService) DeleteVpcSnapshotPoliciesById(ctx context.Context, snapsho
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DeleteSnapshotPolicies(request)
+	response, err := me.client.UseVpcClient().DeleteSnapshotPoliciesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6537,9 +7104,11 @@ func (me *VpcService) DescribeVpcSnapshotPolicyAttachmentById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeSnapshotAttachedInstances(request)
+	response, err := me.client.UseVpcClient().DescribeSnapshotAttachedInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6573,9 +7142,11 @@ func (me *VpcService) DeleteVpcSnapshotPolicyAttachmentById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DetachSnapshotInstances(request)
+	response, err := me.client.UseVpcClient().DetachSnapshotInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6597,9 +7168,11 @@ func (me *VpcService) DescribeVpcNetDetectById(ctx context.Context, netDetectId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeNetDetects(request)
+	response, err := me.client.UseVpcClient().DescribeNetDetectsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6626,9 +7199,11 @@ func (me *VpcService) DeleteVpcNetDetectById(ctx context.Context, netDetectId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DeleteNetDetect(request)
+	response, err := me.client.UseVpcClient().DeleteNetDetectWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6654,7 +7229,9 @@ func (me *VpcService) DescribeVpcClassicLinkAttachmentById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -6664,7 +7241,7 @@ func (me *VpcService) DescribeVpcClassicLinkAttachmentById(ctx context.Context,
 	for {
 		request.Offset = helper.Int64ToStrPoint(offset)
 		request.Limit = helper.Int64ToStrPoint(limit)
-		response, err := me.client.UseVpcClient().DescribeClassicLinkInstances(request)
+		response, err := me.client.UseVpcClient().DescribeClassicLinkInstancesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -6707,9 +7284,11 @@ func (me *VpcService) DeleteVpcClassicLinkAttachmentById(ctx context.Context, vp
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DetachClassicLinkVpc(request)
+	response, err := me.client.UseVpcClient().DetachClassicLinkVpcWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6731,9 +7310,11 @@ func (me *VpcService) DescribeVpcDhcpIpById(ctx context.Context, dhcpIpId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeDhcpIps(request)
+	response, err := me.client.UseVpcClient().DescribeDhcpIpsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6760,9 +7341,11 @@ func (me *VpcService) DeleteVpcDhcpIpById(ctx context.Context, dhcpIpId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DeleteDhcpIp(request)
+	response, err := me.client.UseVpcClient().DeleteDhcpIpWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6784,9 +7367,11 @@ func (me *VpcService) DescribeVpcDhcpAssociateAddressById(ctx context.Context, d
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeDhcpIps(request)
+	response, err := me.client.UseVpcClient().DescribeDhcpIpsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6818,9 +7403,11 @@ func (me *VpcService) DeleteVpcDhcpAssociateAddressById(ctx context.Context, dhc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DisassociateDhcpIpWithAddressIp(request)
+	response, err := me.client.UseVpcClient().DisassociateDhcpIpWithAddressIpWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6842,7 +7429,9 @@ func (me *VpcService) DescribeVpcById(ctx context.Context, vpcId string) (instan
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -6852,7 +7441,7 @@ func (me *VpcService) DescribeVpcById(ctx context.Context, vpcId string) (instan
 	for {
 		request.Offset = helper.Int64ToStrPoint(offset)
 		request.Limit = helper.Int64ToStrPoint(limit)
-		response, err := me.client.UseVpcClient().DescribeVpcs(request)
+		response, err := me.client.UseVpcClient().DescribeVpcsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -6889,9 +7478,11 @@ func (me *VpcService) DeleteVpcIpv6CidrBlockById(ctx context.Context, vpcId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().UnassignIpv6CidrBlock(request)
+	response, err := me.client.UseVpcClient().UnassignIpv6CidrBlockWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6913,7 +7504,9 @@ func (me *VpcService) DescribeSubnetById(ctx context.Context, subnetId string) (
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -6923,7 +7516,7 @@ func (me *VpcService) DescribeSubnetById(ctx context.Context, subnetId string) (
 	for {
 		request.Offset = helper.Int64ToStrPoint(offset)
 		request.Limit = helper.Int64ToStrPoint(limit)
-		response, err := me.client.UseVpcClient().DescribeSubnets(request)
+		response, err := me.client.UseVpcClient().DescribeSubnetsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -6964,9 +7557,11 @@ func (me *VpcService) DeleteVpcIpv6SubnetCidrBlockById(ctx context.Context, vpcI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().UnassignIpv6SubnetCidrBlock(request)
+	response, err := me.client.UseVpcClient().UnassignIpv6SubnetCidrBlockWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -6989,9 +7584,11 @@ func (me *VpcService) DescribeVpcIpv6EniAddressById(ctx context.Context, vpcId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeVpcIpv6Addresses(request)
+	response, err := me.client.UseVpcClient().DescribeVpcIpv6AddressesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -7021,9 +7618,11 @@ func (me *VpcService) DeleteVpcIpv6EniAddressById(ctx context.Context, networkIn
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().UnassignIpv6Addresses(request)
+	response, err := me.client.UseVpcClient().UnassignIpv6AddressesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -7051,9 +7650,11 @@ func (me *VpcService) DescribeVpcLocalGatewayById(ctx context.Context, localGate
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeLocalGateway(request)
+	response, err := me.client.UseVpcClient().DescribeLocalGatewayWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -7081,9 +7682,11 @@ func (me *VpcService) DeleteVpcLocalGatewayById(ctx context.Context, cdcId strin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DeleteLocalGateway(request)
+	response, err := me.client.UseVpcClient().DeleteLocalGatewayWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -7105,9 +7708,11 @@ func (me *VpcService) DescribeVpcAccountAttributes(ctx context.Context) (account
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeAccountAttributes(request)
+	response, err := me.client.UseVpcClient().DescribeAccountAttributesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -7137,7 +7742,9 @@ func (me *VpcService) DescribeVpcClassicLinkInstancesByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset int64 = 0
@@ -7146,7 +7753,7 @@ func (me *VpcService) DescribeVpcClassicLinkInstancesByFilter(ctx context.Contex
 	for {
 		request.Offset = helper.Int64ToStrPoint(offset)
 		request.Limit = helper.Int64ToStrPoint(limit)
-		response, err := me.client.UseVpcClient().DescribeClassicLinkInstances(request)
+		response, err := me.client.UseVpcClient().DescribeClassicLinkInstancesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -7203,7 +7810,9 @@ func (me *VpcService) DescribeVpcGatewayFlowMonitorDetailByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -7212,7 +7821,7 @@ func (me *VpcService) DescribeVpcGatewayFlowMonitorDetailByFilter(ctx context.Co
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseVpcClient().DescribeGatewayFlowMonitorDetail(request)
+		response, err := me.client.UseVpcClient().DescribeGatewayFlowMonitorDetailWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -7254,7 +7863,9 @@ func (me *VpcService) DescribeVpcGatewayFlowQosByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -7263,7 +7874,7 @@ func (me *VpcService) DescribeVpcGatewayFlowQosByFilter(ctx context.Context, par
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseVpcClient().DescribeGatewayFlowQos(request)
+		response, err := me.client.UseVpcClient().DescribeGatewayFlowQosWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -7302,7 +7913,9 @@ func (me *VpcService) DescribeVpcCvmInstancesByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -7311,7 +7924,7 @@ func (me *VpcService) DescribeVpcCvmInstancesByFilter(ctx context.Context, param
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseVpcClient().DescribeVpcInstances(request)
+		response, err := me.client.UseVpcClient().DescribeVpcInstancesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -7353,7 +7966,9 @@ func (me *VpcService) DescribeVpcNetDetectStatesByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -7362,7 +7977,7 @@ func (me *VpcService) DescribeVpcNetDetectStatesByFilter(ctx context.Context, pa
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseVpcClient().DescribeNetDetectStates(request)
+		response, err := me.client.UseVpcClient().DescribeNetDetectStatesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -7401,9 +8016,11 @@ func (me *VpcService) DescribeVpcNetworkInterfaceLimit(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeNetworkInterfaceLimit(request)
+	response, err := me.client.UseVpcClient().DescribeNetworkInterfaceLimitWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -7436,9 +8053,11 @@ func (me *VpcService) DescribeVpcPrivateIpAddresses(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeVpcPrivateIpAddresses(request)
+	response, err := me.client.UseVpcClient().DescribeVpcPrivateIpAddressesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -7468,9 +8087,11 @@ func (me *VpcService) DescribeVpcProductQuota(ctx context.Context, param map[str
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeProductQuota(request)
+	response, err := me.client.UseVpcClient().DescribeProductQuotaWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -7500,9 +8121,11 @@ func (me *VpcService) DescribeVpcResourceDashboard(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeVpcResourceDashboard(request)
+	response, err := me.client.UseVpcClient().DescribeVpcResourceDashboardWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -7535,9 +8158,11 @@ func (me *VpcService) DescribeVpcRouteConflicts(ctx context.Context, param map[s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeRouteConflicts(request)
+	response, err := me.client.UseVpcClient().DescribeRouteConflictsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -7561,9 +8186,11 @@ func (me *VpcService) DescribeVpcSecurityGroupLimits(ctx context.Context, param
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeSecurityGroupLimits(request)
+	response, err := me.client.UseVpcClient().DescribeSecurityGroupLimitsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -7593,9 +8220,11 @@ func (me *VpcService) DescribeVpcSecurityGroupReferences(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeSecurityGroupReferences(request)
+	response, err := me.client.UseVpcClient().DescribeSecurityGroupReferencesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -7631,9 +8260,11 @@ func (me *VpcService) DescribeVpcSgSnapshotFileContent(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeSgSnapshotFileContent(request)
+	response, err := me.client.UseVpcClient().DescribeSgSnapshotFileContentWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -7672,7 +8303,9 @@ func (me *VpcService) DescribeVpcSnapshotFilesByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -7681,7 +8314,7 @@ func (me *VpcService) DescribeVpcSnapshotFilesByFilter(ctx context.Context, para
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseVpcClient().DescribeSnapshotFiles(request)
+		response, err := me.client.UseVpcClient().DescribeSnapshotFilesWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -7720,9 +8353,11 @@ func (me *VpcService) DescribeVpcSubnetResourceDashboardByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeSubnetResourceDashboard(request)
+	response, err := me.client.UseVpcClient().DescribeSubnetResourceDashboardWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -7746,9 +8381,11 @@ func (me *VpcService) DescribeVpcTemplateLimits(ctx context.Context) (templateLi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeTemplateLimits(request)
+	response, err := me.client.UseVpcClient().DescribeTemplateLimitsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -7784,7 +8421,9 @@ func (me *VpcService) DescribeVpcUsedIpAddressByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -7793,7 +8432,7 @@ func (me *VpcService) DescribeVpcUsedIpAddressByFilter(ctx context.Context, para
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseVpcClient().DescribeUsedIpAddress(request)
+		response, err := me.client.UseVpcClient().DescribeUsedIpAddressWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -7832,9 +8471,11 @@ func (me *VpcService) DescribeVpcLimitsByFilter(ctx context.Context, param map[s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeVpcLimits(request)
+	response, err := me.client.UseVpcClient().DescribeVpcLimitsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -7858,9 +8499,11 @@ func (me *VpcService) DescribeVpcNetworkAclQuintupleById(ctx context.Context, ne
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeNetworkAclQuintupleEntries(request)
+	response, err := me.client.UseVpcClient().DescribeNetworkAclQuintupleEntriesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -7887,9 +8530,11 @@ func (me *VpcService) DeleteVpcNetworkAclQuintupleById(ctx context.Context, netw
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DeleteNetworkAclQuintupleEntries(request)
+	response, err := me.client.UseVpcClient().DeleteNetworkAclQuintupleEntriesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -7912,9 +8557,11 @@ func (me *VpcService) DeleteVpcEniSgAttachmentById(ctx context.Context, networkI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DisassociateNetworkInterfaceSecurityGroups(request)
+	response, err := me.client.UseVpcClient().DisassociateNetworkInterfaceSecurityGroupsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -7960,9 +8607,11 @@ func (me *VpcService) DescribeVpcNetDetectStateCheck(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().CheckNetDetectState(request)
+	response, err := me.client.UseVpcClient().CheckNetDetectStateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -7986,9 +8635,11 @@ func (me *VpcService) DescribeVpcNotifyRoutesById(ctx context.Context, routeTabl
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().DescribeRouteTables(request)
+	response, err := me.client.UseVpcClient().DescribeRouteTablesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -8023,9 +8674,11 @@ func (me *VpcService) DeleteVpcNotifyRoutesById(ctx context.Context, routeTableI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().WithdrawNotifyRoutes(request)
+	response, err := me.client.UseVpcClient().WithdrawNotifyRoutesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -8053,9 +8706,11 @@ func (me *VpcService) DescribeVpnDefaultHealthCheckIp(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseVpcClient().GenerateVpnConnectionDefaultHealthCheckIp(request)
+	response, err := me.client.UseVpcClient().GenerateVpnConnectionDefaultHealthCheckIpWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -0,0 +1,407 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
+)
+
+func (me *VpcService) CreateIp6Translator(ctx context.Context, name, isp string) (ip6TranslatorId string, errRet error) {
+	logId := getLogId(ctx)
+
+	request := vpc.NewCreateIp6TranslatorsRequest()
+	request.Ip6TranslatorName = &name
+	request.Ip6TranslatorCount = helper.Int64(1)
+	if isp != "" {
+		request.Ip6InternetServiceProvider = &isp
+	}
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().CreateIp6TranslatorsWithContext(ctx, request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if len(response.Response.Ip6TranslatorSet) != 1 || response.Response.Ip6TranslatorSet[0] == nil {
+		errRet = fmt.Errorf("CreateIp6Translators returns %d ipv6 translators", len(response.Response.Ip6TranslatorSet))
+		return
+	}
+	ip6TranslatorId = *response.Response.Ip6TranslatorSet[0]
+	return
+}
+
+func (me *VpcService) DescribeIp6Translators(ctx context.Context, ip6TranslatorIds []*string, filters []*vpc.Filter) (ip6Translators []*vpc.Ip6Translator, errRet error) {
+	logId := getLogId(ctx)
+
+	request := vpc.NewDescribeIp6TranslatorsRequest()
+	request.Ip6TranslatorIds = ip6TranslatorIds
+	request.Filters = filters
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	var (
+		offset int64 = 0
+		limit  int64 = VPC_IP6_TRANSLATOR_DESCRIBE_LIMIT
+	)
+	for {
+		request.Offset = &offset
+		request.Limit = &limit
+
+		if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+			return
+		}
+		response, err := me.client.UseVpcClient().DescribeIp6TranslatorsWithContext(ctx, request)
+		if err != nil {
+			errRet = err
+			return
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+		ip6Translators = append(ip6Translators, response.Response.Ip6TranslatorSet...)
+		if len(response.Response.Ip6TranslatorSet) < int(limit) {
+			break
+		}
+		offset += limit
+	}
+	return
+}
+
+func (me *VpcService) DescribeIp6TranslatorById(ctx context.Context, ip6TranslatorId string) (ip6Translator *vpc.Ip6Translator, errRet error) {
+	ip6Translators, err := me.DescribeIp6Translators(ctx, []*string{&ip6TranslatorId}, nil)
+	if err != nil {
+		if isExpectError(err, []string{VPCNotFound, VPC_IP6_TRANSLATOR_NOT_FOUND, "InvalidParameterValue.MalformedId"}) {
+			return nil, nil
+		}
+		errRet = err
+		return
+	}
+	for _, item := range ip6Translators {
+		if helper.PString(item.Ip6TranslatorId) == ip6TranslatorId {
+			ip6Translator = item
+			return
+		}
+	}
+	return
+}
+
+func (me *VpcService) ModifyIp6Translator(ctx context.Context, ip6TranslatorId, name string) (errRet error) {
+	logId := getLogId(ctx)
+
+	request := vpc.NewModifyIp6TranslatorRequest()
+	request.Ip6TranslatorId = &ip6TranslatorId
+	request.Ip6TranslatorName = &name
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().ModifyIp6TranslatorWithContext(ctx, request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	return
+}
+
+func (me *VpcService) DeleteIp6TranslatorById(ctx context.Context, ip6TranslatorId string) (errRet error) {
+	logId := getLogId(ctx)
+
+	request := vpc.NewDeleteIp6TranslatorsRequest()
+	request.Ip6TranslatorIds = []*string{&ip6TranslatorId}
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DeleteIp6TranslatorsWithContext(ctx, request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	return
+}
+
+// WaitForIp6TranslatorRunning waits for the ipv6 translator to be running, the ipv6 translator not
+// found is handled by notFound.
+func (me *VpcService) WaitForIp6TranslatorRunning(ctx context.Context, ip6TranslatorId string,
+	notFound helper.NotFoundPolicy, timeout time.Duration) (ip6Translator *vpc.Ip6Translator, errRet error) {
+	waiter := &helper.StateWaiter{
+		Name:   fmt.Sprintf("vpc ipv6 translator %s", ip6TranslatorId),
+		Target: []string{VPC_IP6_TRANSLATOR_STATUS_RUNNING},
+		Refresh: func() (interface{}, string, error) {
+			result, err := me.DescribeIp6TranslatorById(ctx, ip6TranslatorId)
+			if err != nil {
+				return nil, "", err
+			}
+			if result == nil {
+				return nil, "", nil
+			}
+			return result, helper.PString(result.TranslatorStatus), nil
+		},
+		NotFound:  notFound,
+		Retryable: retryableErrorFunc(ctx),
+		Timeout:   timeout,
+	}
+	result, err := waiter.WaitForStateContext(ctx)
+	if err != nil {
+		errRet = err
+		return
+	}
+	if result != nil {
+		ip6Translator, _ = result.(*vpc.Ip6Translator)
+	}
+	return
+}
+
+// WaitForIp6TranslatorDeleted waits for the ipv6 translator to be gone.
+func (me *VpcService) WaitForIp6TranslatorDeleted(ctx context.Context, ip6TranslatorId string, timeout time.Duration) error {
+	waiter := &helper.StateWaiter{
+		Name: fmt.Sprintf("vpc ipv6 translator %s", ip6TranslatorId),
+		Refresh: func() (interface{}, string, error) {
+			result, err := me.DescribeIp6TranslatorById(ctx, ip6TranslatorId)
+			if err != nil {
+				return nil, "", err
+			}
+			if result == nil {
+				return nil, "", nil
+			}
+			return result, helper.PString(result.TranslatorStatus), nil
+		},
+		NotFound:  helper.NotFoundTarget,
+		Retryable: retryableErrorFunc(ctx),
+		Timeout:   timeout,
+	}
+	_, err := waiter.WaitForStateContext(ctx)
+	return err
+}
+
+func (me *VpcService) AddIp6Rule(ctx context.Context, ip6TranslatorId, name string, ruleInfo *vpc.Ip6RuleInfo) (ip6RuleId string, errRet error) {
+	logId := getLogId(ctx)
+
+	request := vpc.NewAddIp6RulesRequest()
+	request.Ip6TranslatorId = &ip6TranslatorId
+	request.Ip6RuleInfos = []*vpc.Ip6RuleInfo{ruleInfo}
+	if name != "" {
+		request.Ip6RuleName = &name
+	}
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().AddIp6RulesWithContext(ctx, request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if len(response.Response.Ip6RuleSet) != 1 || response.Response.Ip6RuleSet[0] == nil {
+		errRet = fmt.Errorf("AddIp6Rules returns %d ipv6 translator rules", len(response.Response.Ip6RuleSet))
+		return
+	}
+	ip6RuleId = *response.Response.Ip6RuleSet[0]
+	return
+}
+
+// DescribeIp6RuleById returns the rule of the ipv6 translator, which is nil if the ipv6 translator or
+// the rule is not found.
+func (me *VpcService) DescribeIp6RuleById(ctx context.Context, ip6TranslatorId, ip6RuleId string) (ip6Rule *vpc.Ip6Rule, errRet error) {
+	ip6Translator, err := me.DescribeIp6TranslatorById(ctx, ip6TranslatorId)
+	if err != nil {
+		errRet = err
+		return
+	}
+	if ip6Translator == nil {
+		return
+	}
+	for _, item := range ip6Translator.IP6RuleSet {
+		if helper.PString(item.Ip6RuleId) == ip6RuleId {
+			ip6Rule = item
+			return
+		}
+	}
+	return
+}
+
+func (me *VpcService) ModifyIp6Rule(ctx context.Context, request *vpc.ModifyIp6RuleRequest) (errRet error) {
+	logId := getLogId(ctx)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().ModifyIp6RuleWithContext(ctx, request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	return
+}
+
+func (me *VpcService) RemoveIp6RuleById(ctx context.Context, ip6TranslatorId, ip6RuleId string) (errRet error) {
+	logId := getLogId(ctx)
+
+	request := vpc.NewRemoveIp6RulesRequest()
+	request.Ip6TranslatorId = &ip6TranslatorId
+	request.Ip6RuleIds = []*string{&ip6RuleId}
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().RemoveIp6RulesWithContext(ctx, request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	return
+}
+
+// WaitForIp6RuleRunning waits for the rule of the ipv6 translator to be running, the rule not found
+// is handled by notFound.
+func (me *VpcService) WaitForIp6RuleRunning(ctx context.Context, ip6TranslatorId, ip6RuleId string,
+	notFound helper.NotFoundPolicy, timeout time.Duration) (ip6Rule *vpc.Ip6Rule, errRet error) {
+	waiter := &helper.StateWaiter{
+		Name:   fmt.Sprintf("vpc ipv6 translator rule %s", ip6RuleId),
+		Target: []string{VPC_IP6_TRANSLATOR_STATUS_RUNNING},
+		Refresh: func() (interface{}, string, error) {
+			result, err := me.DescribeIp6RuleById(ctx, ip6TranslatorId, ip6RuleId)
+			if err != nil {
+				return nil, "", err
+			}
+			if result == nil {
+				return nil, "", nil
+			}
+			return result, helper.PString(result.RuleStatus), nil
+		},
+		NotFound:  notFound,
+		Retryable: retryableErrorFunc(ctx),
+		Timeout:   timeout,
+	}
+	result, err := waiter.WaitForStateContext(ctx)
+	if err != nil {
+		errRet = err
+		return
+	}
+	if result != nil {
+		ip6Rule, _ = result.(*vpc.Ip6Rule)
+	}
+	return
+}
+
+// WaitForIp6RuleDeleted waits for the rule of the ipv6 translator to be gone.
+func (me *VpcService) WaitForIp6RuleDeleted(ctx context.Context, ip6TranslatorId, ip6RuleId string, timeout time.Duration) error {
+	waiter := &helper.StateWaiter{
+		Name: fmt.Sprintf("vpc ipv6 translator rule %s", ip6RuleId),
+		Refresh: func() (interface{}, string, error) {
+			result, err := me.DescribeIp6RuleById(ctx, ip6TranslatorId, ip6RuleId)
+			if err != nil {
+				return nil, "", err
+			}
+			if result == nil {
+				return nil, "", nil
+			}
+			return result, helper.PString(result.RuleStatus), nil
+		},
+		NotFound:  helper.NotFoundTarget,
+		Retryable: retryableErrorFunc(ctx),
+		Timeout:   timeout,
+	}
+	_, err := waiter.WaitForStateContext(ctx)
+	return err
+}
+
+func (me *VpcService) DescribeIp6TranslatorQuota(ctx context.Context, ip6TranslatorIds []*string) (quotas []*vpc.Quota, errRet error) {
+	logId := getLogId(ctx)
+
+	request := vpc.NewDescribeIp6TranslatorQuotaRequest()
+	request.Ip6TranslatorIds = ip6TranslatorIds
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DescribeIp6TranslatorQuotaWithContext(ctx, request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	quotas = response.Response.QuotaSet
+	return
+}
@@ -0,0 +1,261 @@
+package tencentcloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	tchttp "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/http"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
+)
+
+// The models of CreateVpcPeeringConnection, ModifyVpcPeeringConnection and DescribeVpcPeeringConnections
+// in the vendored SDK have no fields, so they are called with common requests and the types below.
+
+// VpcPeeringConnection is the peering connection returned by DescribeVpcPeeringConnections.
+type VpcPeeringConnection struct {
+	PeeringConnectionId   *string `json:"PeeringConnectionId,omitempty"`
+	PeeringConnectionName *string `json:"PeeringConnectionName,omitempty"`
+	SourceVpcId           *string `json:"SourceVpcId,omitempty"`
+	PeerVpcId             *string `json:"PeerVpcId,omitempty"`
+	SourceRegion          *string `json:"SourceRegion,omitempty"`
+	DestinationRegion     *string `json:"DestinationRegion,omitempty"`
+	SourceUin             *int64  `json:"SourceUin,omitempty"`
+	DestinationUin        *int64  `json:"DestinationUin,omitempty"`
+	State                 *string `json:"State,omitempty"`
+	Bandwidth             *int64  `json:"Bandwidth,omitempty"`
+	Type                  *string `json:"Type,omitempty"`
+	ChargeType            *string `json:"ChargeType,omitempty"`
+	QosLevel              *string `json:"QosLevel,omitempty"`
+	CreateTime            *string `json:"CreateTime,omitempty"`
+}
+
+// CreateVpcPeeringConnectionParams are the parameters of CreateVpcPeeringConnection.
+type CreateVpcPeeringConnectionParams struct {
+	SourceVpcId           *string    `json:"SourceVpcId,omitempty"`
+	PeeringConnectionName *string    `json:"PeeringConnectionName,omitempty"`
+	DestinationVpcId      *string    `json:"DestinationVpcId,omitempty"`
+	DestinationUin        *string    `json:"DestinationUin,omitempty"`
+	DestinationRegion     *string    `json:"DestinationRegion,omitempty"`
+	Bandwidth             *int64     `json:"Bandwidth,omitempty"`
+	Type                  *string    `json:"Type,omitempty"`
+	ChargeType            *string    `json:"ChargeType,omitempty"`
+	QosLevel              *string    `json:"QosLevel,omitempty"`
+	Tags                  []*vpc.Tag `json:"Tags,omitempty"`
+}
+
+// ModifyVpcPeeringConnectionParams are the parameters of ModifyVpcPeeringConnection.
+type ModifyVpcPeeringConnectionParams struct {
+	PeeringConnectionId   *string `json:"PeeringConnectionId,omitempty"`
+	PeeringConnectionName *string `json:"PeeringConnectionName,omitempty"`
+	Bandwidth             *int64  `json:"Bandwidth,omitempty"`
+	ChargeType            *string `json:"ChargeType,omitempty"`
+}
+
+// DescribeVpcPeeringConnectionsParams are the parameters of DescribeVpcPeeringConnections.
+type DescribeVpcPeeringConnectionsParams struct {
+	PeeringConnectionIds []*string     `json:"PeeringConnectionIds,omitempty"`
+	Filters              []*vpc.Filter `json:"Filters,omitempty"`
+	Offset               *int64        `json:"Offset,omitempty"`
+	Limit                *int64        `json:"Limit,omitempty"`
+}
+
+// sendVpcPeeringRequest calls the peering connection API `action` of VPC, and decodes the
+// `Response` of it into result.
+func (me *VpcService) sendVpcPeeringRequest(ctx context.Context, action string, params interface{}, result interface{}) (errRet error) {
+	logId := getLogId(ctx)
+
+	body, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	request := tchttp.NewCommonRequest(VPC_SERVICE_TYPE, vpc.APIVersion, action)
+	request.SetContext(ctx)
+	if err := request.SetActionParameters(body); err != nil {
+		return err
+	}
+	response := tchttp.NewCommonResponse()
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, action, body, errRet.Error())
+		}
+	}()
+
+	if errRet = ratelimit.CheckContext(ctx, action); errRet != nil {
+		return
+	}
+	if err := me.client.UseVpcClient().Send(request, response); err != nil {
+		return err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, action, body, response.GetBody())
+
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(response.GetBody(), &struct {
+		Response interface{} `json:"Response"`
+	}{Response: result})
+}
+
+func (me *VpcService) CreateVpcPeeringConnection(ctx context.Context, params *CreateVpcPeeringConnectionParams) (peeringConnectionId string, errRet error) {
+	var result struct {
+		PeeringConnectionId *string `json:"PeeringConnectionId"`
+	}
+	if errRet = me.sendVpcPeeringRequest(ctx, "CreateVpcPeeringConnection", params, &result); errRet != nil {
+		return
+	}
+	if result.PeeringConnectionId == nil || *result.PeeringConnectionId == "" {
+		errRet = fmt.Errorf("CreateVpcPeeringConnection returns no peering connection id")
+		return
+	}
+	peeringConnectionId = *result.PeeringConnectionId
+	return
+}
+
+func (me *VpcService) DescribeVpcPeeringConnections(ctx context.Context, params *DescribeVpcPeeringConnectionsParams) (peeringConnections []*VpcPeeringConnection, errRet error) {
+	var (
+		offset int64 = 0
+		limit  int64 = VPC_PEERING_CONNECTION_DESCRIBE_LIMIT
+	)
+	for {
+		params.Offset = &offset
+		params.Limit = &limit
+
+		var result struct {
+			PeerConnectionSet []*VpcPeeringConnection `json:"PeerConnectionSet"`
+			TotalCount        *int64                  `json:"TotalCount"`
+		}
+		if errRet = me.sendVpcPeeringRequest(ctx, "DescribeVpcPeeringConnections", params, &result); errRet != nil {
+			return
+		}
+		peeringConnections = append(peeringConnections, result.PeerConnectionSet...)
+		if len(result.PeerConnectionSet) < int(limit) {
+			break
+		}
+		offset += limit
+	}
+	return
+}
+
+func (me *VpcService) DescribeVpcPeeringConnectionById(ctx context.Context, peeringConnectionId string) (peeringConnection *VpcPeeringConnection, errRet error) {
+	params := &DescribeVpcPeeringConnectionsParams{
+		PeeringConnectionIds: []*string{&peeringConnectionId},
+	}
+	peeringConnections, err := me.DescribeVpcPeeringConnections(ctx, params)
+	if err != nil {
+		if isExpectError(err, []string{VPCNotFound, "InvalidParameterValue.MalformedId"}) {
+			return nil, nil
+		}
+		errRet = err
+		return
+	}
+	for _, item := range peeringConnections {
+		if item.PeeringConnectionId != nil && *item.PeeringConnectionId == peeringConnectionId {
+			if item.State != nil && *item.State == VPC_PEERING_CONNECTION_STATE_DELETED {
+				return nil, nil
+			}
+			peeringConnection = item
+			return
+		}
+	}
+	return
+}
+
+func (me *VpcService) ModifyVpcPeeringConnection(ctx context.Context, params *ModifyVpcPeeringConnectionParams) (errRet error) {
+	return me.sendVpcPeeringRequest(ctx, "ModifyVpcPeeringConnection", params, nil)
+}
+
+func (me *VpcService) AcceptVpcPeeringConnection(ctx context.Context, peeringConnectionId string) (errRet error) {
+	logId := getLogId(ctx)
+
+	request := vpc.NewAcceptVpcPeeringConnectionRequest()
+	request.PeeringConnectionId = &peeringConnectionId
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().AcceptVpcPeeringConnectionWithContext(ctx, request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	return
+}
+
+func (me *VpcService) DeleteVpcPeeringConnectionById(ctx context.Context, peeringConnectionId string) (errRet error) {
+	logId := getLogId(ctx)
+
+	request := vpc.NewDeleteVpcPeeringConnectionRequest()
+	request.PeeringConnectionId = &peeringConnectionId
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
+	response, err := me.client.UseVpcClient().DeleteVpcPeeringConnectionWithContext(ctx, request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	return
+}
+
+// WaitForVpcPeeringConnectionState waits for the peering connection to be one of the target states,
+// the peering connection not found is handled by notFound.
+func (me *VpcService) WaitForVpcPeeringConnectionState(ctx context.Context, peeringConnectionId string, target, failed []string,
+	notFound helper.NotFoundPolicy, timeout time.Duration) (peeringConnection *VpcPeeringConnection, errRet error) {
+	waiter := &helper.StateWaiter{
+		Name:   fmt.Sprintf("vpc peering connection %s", peeringConnectionId),
+		Target: target,
+		Failed: failed,
+		Refresh: func() (interface{}, string, error) {
+			result, err := me.DescribeVpcPeeringConnectionById(ctx, peeringConnectionId)
+			if err != nil {
+				return nil, "", err
+			}
+			if result == nil {
+				return nil, "", nil
+			}
+			return result, helper.PString(result.State), nil
+		},
+		NotFound:  notFound,
+		Retryable: retryableErrorFunc(ctx),
+		Timeout:   timeout,
+	}
+	result, err := waiter.WaitForStateContext(ctx)
+	if err != nil {
+		errRet = err
+		return
+	}
+	if result != nil {
+		peeringConnection = result.(*VpcPeeringConnection)
+	}
+	return
+}
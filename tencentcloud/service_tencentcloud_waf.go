@@ -36,9 +36,11 @@ func (me *WafService) DescribeWafCustomRuleById(ctx context.Context, domain, rul
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DescribeCustomRuleList(request)
+	response, err := me.client.UseWafClient().DescribeCustomRuleListWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -67,9 +69,11 @@ func (me *WafService) DeleteWafCustomRuleById(ctx context.Context, domain, ruleI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DeleteCustomRule(request)
+	response, err := me.client.UseWafClient().DeleteCustomRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -101,9 +105,11 @@ func (me *WafService) DescribeWafCustomWhiteRuleById(ctx context.Context, domain
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DescribeCustomWhiteRule(request)
+	response, err := me.client.UseWafClient().DescribeCustomWhiteRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -133,9 +139,11 @@ func (me *WafService) DeleteWafCustomWhiteRuleById(ctx context.Context, domain,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DeleteCustomWhiteRule(request)
+	response, err := me.client.UseWafClient().DeleteCustomWhiteRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -158,9 +166,11 @@ func (me *WafService) DescribeWafCiphersByFilter(ctx context.Context) (ciphers [
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DescribeCiphersDetail(request)
+	response, err := me.client.UseWafClient().DescribeCiphersDetailWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -188,9 +198,11 @@ func (me *WafService) DescribeWafTlsVersionsByFilter(ctx context.Context) (tlsVe
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DescribeTlsVersion(request)
+	response, err := me.client.UseWafClient().DescribeTlsVersionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -231,9 +243,11 @@ func (me *WafService) DescribeDomainsById(ctx context.Context, instanceID, domai
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DescribeDomains(request)
+	response, err := me.client.UseWafClient().DescribeDomainsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -263,9 +277,11 @@ func (me *WafService) DescribeWafClbDomainById(ctx context.Context, instanceID,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DescribeDomainDetailsClb(request)
+	response, err := me.client.UseWafClient().DescribeDomainDetailsClbWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -299,9 +315,11 @@ func (me *WafService) DeleteWafClbDomainById(ctx context.Context, instanceID, do
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DeleteHost(request)
+	response, err := me.client.UseWafClient().DeleteHostWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -326,9 +344,11 @@ func (me *WafService) DescribeWafSaasDomainById(ctx context.Context, instanceID,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DescribeDomainDetailsSaas(request)
+	response, err := me.client.UseWafClient().DescribeDomainDetailsSaasWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -357,9 +377,11 @@ func (me *WafService) DeleteWafSaasDomainById(ctx context.Context, instanceID, d
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DeleteSpartaProtection(request)
+	response, err := me.client.UseWafClient().DeleteSpartaProtectionWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -401,7 +423,9 @@ func (me *WafService) DescribeWafDomainsByFilter(ctx context.Context, instanceID
 
 	request.Filters = tmpFilter
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 0
@@ -410,7 +434,7 @@ func (me *WafService) DescribeWafDomainsByFilter(ctx context.Context, instanceID
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseWafClient().DescribeDomains(request)
+		response, err := me.client.UseWafClient().DescribeDomainsWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -463,7 +487,9 @@ func (me *WafService) DescribeWafFindDomainsByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
 	var (
 		offset uint64 = 1
@@ -472,7 +498,7 @@ func (me *WafService) DescribeWafFindDomainsByFilter(ctx context.Context, param
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		response, err := me.client.UseWafClient().DescribeFindDomainList(request)
+		response, err := me.client.UseWafClient().DescribeFindDomainListWithContext(ctx, request)
 		if err != nil {
 			errRet = err
 			return
@@ -513,9 +539,11 @@ func (me *WafService) DescribeWafWafInfosByFilter(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DescribeWafInfo(request)
+	response, err := me.client.UseWafClient().DescribeWafInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -552,9 +580,11 @@ func (me *WafService) DescribeWafPortsByFilter(ctx context.Context, param map[st
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DescribePorts(request)
+	response, err := me.client.UseWafClient().DescribePortsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -581,9 +611,11 @@ func (me *WafService) DescribeWafUserDomainsByFilter(ctx context.Context) (userD
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DescribeUserDomainInfo(request)
+	response, err := me.client.UseWafClient().DescribeUserDomainInfoWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -619,9 +651,11 @@ func (me *WafService) DescribeWafInstanceById(ctx context.Context, instanceId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DescribeInstances(request)
+	response, err := me.client.UseWafClient().DescribeInstancesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -667,9 +701,11 @@ func (me *WafService) DescribeWafAttackLogHistogramByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().GetAttackHistogram(request)
+	response, err := me.client.UseWafClient().GetAttackHistogramWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -729,9 +765,11 @@ func (me *WafService) DescribeWafAttackLogListByFilter(ctx context.Context, para
 
 	request.Context = common.StringPtr("")
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().SearchAttackLog(request)
+	response, err := me.client.UseWafClient().SearchAttackLogWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -785,9 +823,11 @@ func (me *WafService) DescribeWafAttackOverviewByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DescribeAttackOverview(request)
+	response, err := me.client.UseWafClient().DescribeAttackOverviewWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -833,9 +873,11 @@ func (me *WafService) DescribeWafAttackTotalCountByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().GetAttackTotalCount(request)
+	response, err := me.client.UseWafClient().GetAttackTotalCountWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -888,9 +930,11 @@ func (me *WafService) DescribeWafPeakPointsByFilter(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DescribePeakPoints(request)
+	response, err := me.client.UseWafClient().DescribePeakPointsWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -927,9 +971,11 @@ func (me *WafService) DescribeWafAntiFakeById(ctx context.Context, id, domain st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DescribeAntiFakeRules(request)
+	response, err := me.client.UseWafClient().DescribeAntiFakeRulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -959,9 +1005,11 @@ func (me *WafService) DeleteWafAntiFakeById(ctx context.Context, id, domain stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DeleteAntiFakeUrl(request)
+	response, err := me.client.UseWafClient().DeleteAntiFakeUrlWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -984,9 +1032,11 @@ func (me *WafService) DescribeWafAntiInfoLeakById(ctx context.Context, ruleId, d
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DescribeAntiInfoLeakageRules(request)
+	response, err := me.client.UseWafClient().DescribeAntiInfoLeakageRulesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1023,9 +1073,11 @@ func (me *WafService) DeleteWafAntiInfoLeakById(ctx context.Context, ruleId, dom
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().DeleteAntiInfoLeakRule(request)
+	response, err := me.client.UseWafClient().DeleteAntiInfoLeakRuleWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -1058,9 +1110,11 @@ func (me *WafService) DescribeWafInstanceQpsLimitByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWafClient().GetInstanceQpsLimit(request)
+	response, err := me.client.UseWafClient().GetInstanceQpsLimitWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
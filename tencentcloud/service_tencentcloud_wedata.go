@@ -27,9 +27,11 @@ func (me *WedataService) DescribeWedataRuleTemplateById(ctx context.Context, pro
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWedataClient().DescribeRuleTemplate(request)
+	response, err := me.client.UseWedataClient().DescribeRuleTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -57,9 +59,11 @@ func (me *WedataService) DeleteWedataRuleTemplateById(ctx context.Context, proje
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWedataClient().DeleteRuleTemplate(request)
+	response, err := me.client.UseWedataClient().DeleteRuleTemplateWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
@@ -96,9 +100,11 @@ func (me *WedataService) DescribeWedataRuleTemplatesByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
+	if errRet = ratelimit.CheckContext(ctx, request.GetAction()); errRet != nil {
+		return
+	}
 
-	response, err := me.client.UseWedataClient().DescribeRuleTemplates(request)
+	response, err := me.client.UseWedataClient().DescribeRuleTemplatesWithContext(ctx, request)
 	if err != nil {
 		errRet = err
 		return
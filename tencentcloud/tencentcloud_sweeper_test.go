@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 )
 
@@ -30,7 +29,7 @@ func sharedClientForRegion(region string) (interface{}, error) {
 	domain := os.Getenv(PROVIDER_DOMAIN)
 
 	client := &connectivity.TencentCloudClient{
-		Credential: common.NewTokenCredential(
+		Credential: connectivity.NewCredential(
 			secretId,
 			secretKey,
 			securityToken,